@@ -12,6 +12,8 @@ export const runtimeEntryPoints = {
   runtime: "src/index.ts",
   preact: "src/frameworks/preact.ts",
   behaviors: "src/behaviors/index.ts",
+  client: "src/client/index.ts",
+  validate: "src/validate/index.ts",
 };
 
 export const buildOutput = {
@@ -23,6 +25,8 @@ export const buildOutput = {
 export const esbuildTarget = ["es2019"];
 export const iifeGlobalName = "FormgenRelationships";
 export const behaviorsGlobalName = "FormgenBehaviors";
+export const clientGlobalName = "FormgenClient";
+export const validateGlobalName = "FormgenValidate";
 
 export const banner = `/**
  * formgen relationship runtime
@@ -8,6 +8,8 @@ import {
   esbuildTarget,
   iifeGlobalName,
   behaviorsGlobalName,
+  clientGlobalName,
+  validateGlobalName,
   banner,
 } from "../build.config";
 
@@ -52,6 +54,30 @@ const runtimeAssetCopies = [
       resolve(repoRoot, "pkg", "runtime", "assets", "formgen-behaviors.min.js.map"),
     ],
   },
+  {
+    source: resolve(iifeOutDir, "formgen-client.min.js"),
+    targets: [
+      resolve(repoRoot, "pkg", "runtime", "assets", "formgen-client.min.js"),
+    ],
+  },
+  {
+    source: resolve(iifeOutDir, "formgen-client.min.js.map"),
+    targets: [
+      resolve(repoRoot, "pkg", "runtime", "assets", "formgen-client.min.js.map"),
+    ],
+  },
+  {
+    source: resolve(iifeOutDir, "formgen-validate.min.js"),
+    targets: [
+      resolve(repoRoot, "pkg", "runtime", "assets", "formgen-validate.min.js"),
+    ],
+  },
+  {
+    source: resolve(iifeOutDir, "formgen-validate.min.js.map"),
+    targets: [
+      resolve(repoRoot, "pkg", "runtime", "assets", "formgen-validate.min.js.map"),
+    ],
+  },
 ];
 
 const esmOptions: BuildOptions = {
@@ -116,6 +142,36 @@ const iifeBehaviorsOptions: BuildOptions = {
   banner: { js: banner },
 };
 
+const iifeClientOptions: BuildOptions = {
+  absWorkingDir: projectRoot,
+  entryPoints: [runtimeEntryPoints.client],
+  outfile: resolve(iifeOutDir, "formgen-client.min.js"),
+  bundle: true,
+  format: "iife",
+  sourcemap: true,
+  minify: true,
+  target: esbuildTarget,
+  platform: "browser",
+  globalName: clientGlobalName,
+  legalComments: "none",
+  banner: { js: banner },
+};
+
+const iifeValidateOptions: BuildOptions = {
+  absWorkingDir: projectRoot,
+  entryPoints: [runtimeEntryPoints.validate],
+  outfile: resolve(iifeOutDir, "formgen-validate.min.js"),
+  bundle: true,
+  format: "iife",
+  sourcemap: true,
+  minify: true,
+  target: esbuildTarget,
+  platform: "browser",
+  globalName: validateGlobalName,
+  legalComments: "none",
+  banner: { js: banner },
+};
+
 async function ensureOutDirs() {
   if (!watch) {
     await Promise.all([
@@ -166,6 +222,8 @@ async function run() {
   iifeRuntimeOptions.define = { ...(iifeRuntimeOptions.define ?? {}), ...define };
   iifePreactOptions.define = { ...(iifePreactOptions.define ?? {}), ...define };
   iifeBehaviorsOptions.define = { ...(iifeBehaviorsOptions.define ?? {}), ...define };
+  iifeClientOptions.define = { ...(iifeClientOptions.define ?? {}), ...define };
+  iifeValidateOptions.define = { ...(iifeValidateOptions.define ?? {}), ...define };
 
   if (watch) {
     const contexts = await Promise.all([
@@ -173,6 +231,8 @@ async function run() {
       context(iifeRuntimeOptions),
       context(iifePreactOptions),
       context(iifeBehaviorsOptions),
+      context(iifeClientOptions),
+      context(iifeValidateOptions),
     ]);
     await Promise.all(contexts.map((ctx) => ctx.watch()));
     console.log("Watching relationship runtime sources for changes…");
@@ -184,6 +244,8 @@ async function run() {
     { label: "runtime", options: iifeRuntimeOptions },
     { label: "preact", options: iifePreactOptions },
     { label: "behaviors", options: iifeBehaviorsOptions },
+    { label: "client", options: iifeClientOptions },
+    { label: "validate", options: iifeValidateOptions },
   ];
 
   for (const buildTarget of builds) {
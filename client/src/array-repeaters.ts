@@ -52,6 +52,7 @@ export function initArrayRepeaters(
     items.addEventListener("click", handleRemove);
     items.setAttribute(ARRAY_INITIALIZED_ATTR, "true");
     arrayRepeaterInstances.set(items, { button, handleAdd });
+    syncArrayItemLimits(items);
   }
 }
 
@@ -72,6 +73,10 @@ export function addArrayItem(items: HTMLElement): HTMLElement[] {
   if (!template) {
     return [];
   }
+  const { max } = readItemBounds(items);
+  if (max !== null && countActiveItems(items) >= max) {
+    return [];
+  }
 
   const nextIndex = readNextIndex(items);
   const arrayName = items.dataset.formgenArrayName ?? "";
@@ -95,9 +100,57 @@ export function addArrayItem(items: HTMLElement): HTMLElement[] {
   );
   items.insertBefore(fragment, template);
   items.dataset.formgenArrayNextIndex = String(nextIndex + 1);
+  syncArrayItemLimits(items);
   return added;
 }
 
+/**
+ * Disables the add action once the repeater holds maxItems rows and the
+ * remove actions while it holds minItems rows or fewer, as declared by the
+ * data-formgen-array-min-items/max-items attributes.
+ */
+export function syncArrayItemLimits(items: HTMLElement): void {
+  const { min, max } = readItemBounds(items);
+  if (min === null && max === null) {
+    return;
+  }
+  const count = countActiveItems(items);
+  const button = findAddButton(items);
+  if (button && max !== null) {
+    button.disabled = count >= max;
+  }
+  const locked = min !== null && count <= min;
+  for (const item of activeItems(items)) {
+    item.querySelectorAll<HTMLButtonElement>(ARRAY_REMOVE_ACTION_SELECTOR).forEach((remove) => {
+      if (remove.closest(`[${ARRAY_ITEM_ATTR}]`) === item) {
+        remove.disabled = locked;
+      }
+    });
+  }
+}
+
+function readItemBounds(items: HTMLElement): { min: number | null; max: number | null } {
+  const parse = (raw: string | undefined): number | null => {
+    const value = Number.parseInt(raw ?? "", 10);
+    return Number.isFinite(value) && value >= 0 ? value : null;
+  };
+  return {
+    min: parse(items.dataset.formgenArrayMinItems),
+    max: parse(items.dataset.formgenArrayMaxItems),
+  };
+}
+
+function activeItems(items: HTMLElement): HTMLElement[] {
+  return Array.from(items.children).filter(
+    (child): child is HTMLElement =>
+      child instanceof HTMLElement && !(child instanceof HTMLTemplateElement) && !child.hidden
+  );
+}
+
+function countActiveItems(items: HTMLElement): number {
+  return activeItems(items).length;
+}
+
 function collectArrayItemContainers(root: Document | HTMLElement): HTMLElement[] {
   const scope = root instanceof Document ? root : root;
   const selector = `[${ARRAY_ITEMS_ATTR}]`;
@@ -139,8 +192,21 @@ function handleRemove(event: Event): void {
   if (!button) {
     return;
   }
+  const items = event.currentTarget instanceof HTMLElement ? event.currentTarget : null;
+  if (items && button.closest(`[${ARRAY_ITEMS_ATTR}]`) !== items) {
+    return;
+  }
   event.preventDefault();
+  if (items) {
+    const { min } = readItemBounds(items);
+    if (min !== null && countActiveItems(items) <= min) {
+      return;
+    }
+  }
   removeArrayItem(button);
+  if (items) {
+    syncArrayItemLimits(items);
+  }
 }
 
 function removeArrayItem(button: HTMLButtonElement): void {
@@ -0,0 +1,84 @@
+import type { BehaviorFactory } from "./types";
+
+/**
+ * advancedToggle reveals and hides the fields and sections the vanilla
+ * renderer marked with `data-formgen-advanced` (UI schema `advanced: true`).
+ * The renderer emits the button with a count badge and attaches the behavior:
+ * `<button data-behavior="advancedToggle" aria-expanded="false">`.
+ *
+ * Advanced content that already holds an invalid control (server errors on
+ * re-render) is revealed immediately so the error is not hidden.
+ */
+
+export const ADVANCED_TOGGLE_EVENT = "formgen:advanced:toggle" as const;
+
+const ADVANCED_ATTR = "data-formgen-advanced";
+const LABEL_SELECTOR = "[data-formgen-advanced-label]";
+const INVALID_SELECTOR = '[aria-invalid="true"], [data-validation-state="invalid"]';
+
+interface AdvancedToggleConfig {
+  showLabel?: string;
+  hideLabel?: string;
+}
+
+export interface AdvancedToggleEventDetail {
+  expanded: boolean;
+  count: number;
+}
+
+export const advancedToggle: BehaviorFactory = ({ element, root, config }) => {
+  const options = normaliseConfig(config);
+  const scope = element.closest<HTMLElement>("form") ?? root;
+  const targets = Array.from(scope.querySelectorAll<HTMLElement>(`[${ADVANCED_ATTR}]`));
+  if (targets.length === 0) {
+    element.hidden = true;
+    return;
+  }
+  const label = element.querySelector<HTMLElement>(LABEL_SELECTOR);
+  const showLabel = options.showLabel ?? label?.textContent?.trim() ?? "";
+
+  const apply = (expanded: boolean) => {
+    for (const target of targets) {
+      target.hidden = !expanded;
+    }
+    element.setAttribute("aria-expanded", expanded ? "true" : "false");
+    if (label) {
+      label.textContent = expanded ? options.hideLabel ?? showLabel : showLabel;
+    }
+    element.dispatchEvent(
+      new CustomEvent<AdvancedToggleEventDetail>(ADVANCED_TOGGLE_EVENT, {
+        bubbles: true,
+        detail: { expanded, count: targets.length },
+      }),
+    );
+  };
+
+  const onClick = (event: Event) => {
+    event.preventDefault();
+    apply(element.getAttribute("aria-expanded") !== "true");
+  };
+
+  element.addEventListener("click", onClick);
+  if (targets.some((target) => target.querySelector(INVALID_SELECTOR))) {
+    apply(true);
+  }
+
+  return () => {
+    element.removeEventListener("click", onClick);
+  };
+};
+
+function normaliseConfig(config: unknown): AdvancedToggleConfig {
+  if (!config || typeof config !== "object") {
+    return {};
+  }
+  const record = config as Record<string, unknown>;
+  const options: AdvancedToggleConfig = {};
+  if (typeof record.showLabel === "string" && record.showLabel.trim() !== "") {
+    options.showLabel = record.showLabel;
+  }
+  if (typeof record.hideLabel === "string" && record.hideLabel.trim() !== "") {
+    options.hideLabel = record.hideLabel;
+  }
+  return options;
+}
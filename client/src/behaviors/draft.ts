@@ -0,0 +1,319 @@
+import type { BehaviorFactory } from "./types";
+import { SUBMISSION_FAILED_EVENT, SUBMISSION_QUEUED_EVENT, SUBMISSION_SENT_EVENT } from "./submit-queue";
+
+/**
+ * draft autosaves in-progress form values so accidental navigation does not
+ * lose work. Values are written to localStorage (or PUT to `endpoint`) after
+ * edits settle, restored when the form initialises, and cleared once the form
+ * is submitted. Hidden, password, and file inputs are never stored, nor are
+ * names listed in `exclude` (dotted paths, `[]` for array items; the Go
+ * renderer adds sensitive fields automatically).
+ *
+ * The vanilla renderer attaches it to the form root when the operation
+ * declares `x-formgen: {draft: {key: ...}}`; it can also be attached by hand:
+ * `data-behavior="draft" data-behavior-config='{"key":"article"}'`.
+ */
+
+export const DRAFT_RESTORED_EVENT = "formgen:draft:restored" as const;
+export const DRAFT_SAVED_EVENT = "formgen:draft:saved" as const;
+export const DRAFT_CLEARED_EVENT = "formgen:draft:cleared" as const;
+
+const STORAGE_PREFIX = "formgen:draft:";
+const DEFAULT_DEBOUNCE_MS = 500;
+const SKIPPED_TYPES = new Set(["hidden", "password", "file", "submit", "button", "reset", "image"]);
+
+interface DraftConfig {
+  key: string;
+  endpoint?: string;
+  debounceMs: number;
+  exclude: string[];
+}
+
+export interface DraftSnapshot {
+  savedAt: number;
+  values: Record<string, string[]>;
+}
+
+export interface DraftEventDetail {
+  key: string;
+  savedAt?: number;
+}
+
+type Control = HTMLInputElement | HTMLSelectElement | HTMLTextAreaElement;
+
+export const draft: BehaviorFactory = ({ element, config }) => {
+  const options = normaliseConfig(config);
+  if (!options) {
+    console.warn("[formgen:behaviors] draft requires a `key` in its config.");
+    return;
+  }
+  const form = element instanceof HTMLFormElement ? element : element.closest("form");
+  let timer: ReturnType<typeof setTimeout> | undefined;
+  let submitted = false;
+  let restoring = true;
+
+  const save = () => {
+    timer = undefined;
+    if (submitted) {
+      return;
+    }
+    const snapshot: DraftSnapshot = { savedAt: Date.now(), values: collect(element, options.exclude) };
+    void writeDraft(options, snapshot).then(() => {
+      dispatch(element, DRAFT_SAVED_EVENT, { key: options.key, savedAt: snapshot.savedAt });
+    });
+  };
+
+  const schedule = (event: Event) => {
+    if (restoring || submitted || !isDraftControl(event.target, options.exclude)) {
+      return;
+    }
+    if (timer !== undefined) {
+      clearTimeout(timer);
+    }
+    timer = setTimeout(save, options.debounceMs);
+  };
+
+  const flush = () => {
+    if (timer !== undefined) {
+      clearTimeout(timer);
+      save();
+    }
+  };
+
+  const clear = () => {
+    submitted = true;
+    if (timer !== undefined) {
+      clearTimeout(timer);
+      timer = undefined;
+    }
+    void clearDraft(options.key, options.endpoint).then(() => {
+      dispatch(element, DRAFT_CLEARED_EVENT, { key: options.key });
+    });
+  };
+
+  // A submit handled by fetch (submitQueue) calls preventDefault; wait for
+  // its outcome instead of clearing on the native navigation path.
+  const handleSubmit = (event: Event) => {
+    setTimeout(() => {
+      if (!event.defaultPrevented) {
+        clear();
+      }
+    }, 0);
+  };
+
+  const handleSent = (event: Event) => {
+    const response = (event as CustomEvent<{ response?: Response }>).detail?.response;
+    if (!response || response.ok) {
+      clear();
+    }
+  };
+
+  const handleRetained = () => {
+    submitted = false;
+  };
+
+  element.addEventListener("input", schedule);
+  element.addEventListener("change", schedule);
+  form?.addEventListener("submit", handleSubmit);
+  form?.addEventListener(SUBMISSION_SENT_EVENT, handleSent);
+  form?.addEventListener(SUBMISSION_QUEUED_EVENT, handleRetained);
+  form?.addEventListener(SUBMISSION_FAILED_EVENT, handleRetained);
+  window.addEventListener("pagehide", flush);
+
+  void readDraft(options).then((snapshot) => {
+    if (snapshot && !submitted) {
+      restore(element, snapshot, options.exclude);
+      dispatch(element, DRAFT_RESTORED_EVENT, { key: options.key, savedAt: snapshot.savedAt });
+    }
+    restoring = false;
+  });
+
+  return () => {
+    flush();
+    element.removeEventListener("input", schedule);
+    element.removeEventListener("change", schedule);
+    form?.removeEventListener("submit", handleSubmit);
+    form?.removeEventListener(SUBMISSION_SENT_EVENT, handleSent);
+    form?.removeEventListener(SUBMISSION_QUEUED_EVENT, handleRetained);
+    form?.removeEventListener(SUBMISSION_FAILED_EVENT, handleRetained);
+    window.removeEventListener("pagehide", flush);
+  };
+};
+
+/**
+ * clearDraft removes a stored draft, e.g. after the server confirmed a save
+ * made outside the behavior's submit handling.
+ */
+export async function clearDraft(key: string, endpoint?: string): Promise<void> {
+  if (endpoint) {
+    try {
+      await fetch(endpoint, { method: "DELETE", credentials: "same-origin" });
+    } catch (error) {
+      console.warn("[formgen:behaviors] draft: clearing remote draft failed", error);
+    }
+    return;
+  }
+  storage()?.removeItem(STORAGE_PREFIX + key);
+}
+
+async function readDraft(options: DraftConfig): Promise<DraftSnapshot | null> {
+  try {
+    if (options.endpoint) {
+      const response = await fetch(options.endpoint, {
+        headers: { Accept: "application/json" },
+        credentials: "same-origin",
+      });
+      if (!response.ok || response.status === 204) {
+        return null;
+      }
+      return parseSnapshot(await response.json());
+    }
+    const raw = storage()?.getItem(STORAGE_PREFIX + options.key);
+    return raw ? parseSnapshot(JSON.parse(raw)) : null;
+  } catch (error) {
+    console.warn("[formgen:behaviors] draft: ignoring unreadable draft", error);
+    return null;
+  }
+}
+
+async function writeDraft(options: DraftConfig, snapshot: DraftSnapshot): Promise<void> {
+  try {
+    if (options.endpoint) {
+      await fetch(options.endpoint, {
+        method: "PUT",
+        headers: { "Content-Type": "application/json" },
+        credentials: "same-origin",
+        body: JSON.stringify(snapshot),
+      });
+      return;
+    }
+    storage()?.setItem(STORAGE_PREFIX + options.key, JSON.stringify(snapshot));
+  } catch (error) {
+    console.warn("[formgen:behaviors] draft: saving failed", error);
+  }
+}
+
+function collect(root: HTMLElement, exclude: string[]): Record<string, string[]> {
+  const values: Record<string, string[]> = {};
+  for (const control of controls(root, exclude)) {
+    const list = values[control.name] ?? (values[control.name] = []);
+    if (control instanceof HTMLInputElement && (control.type === "checkbox" || control.type === "radio")) {
+      if (control.checked) {
+        list.push(control.value);
+      }
+    } else if (control instanceof HTMLSelectElement) {
+      for (const option of Array.from(control.selectedOptions)) {
+        list.push(option.value);
+      }
+    } else {
+      list.push(control.value);
+    }
+  }
+  return values;
+}
+
+function restore(root: HTMLElement, snapshot: DraftSnapshot, exclude: string[]): void {
+  const seen = new Map<string, number>();
+  for (const control of controls(root, exclude)) {
+    const list = snapshot.values[control.name];
+    if (!list) {
+      continue;
+    }
+    let changed = false;
+    if (control instanceof HTMLInputElement && (control.type === "checkbox" || control.type === "radio")) {
+      const checked = list.includes(control.value);
+      changed = control.checked !== checked;
+      control.checked = checked;
+    } else if (control instanceof HTMLSelectElement) {
+      for (const option of Array.from(control.options)) {
+        const selected = list.includes(option.value);
+        changed = changed || option.selected !== selected;
+        option.selected = selected;
+      }
+    } else {
+      const index = seen.get(control.name) ?? 0;
+      seen.set(control.name, index + 1);
+      if (index < list.length && control.value !== list[index]) {
+        control.value = list[index]!;
+        changed = true;
+      }
+    }
+    if (changed) {
+      control.dispatchEvent(new Event("input", { bubbles: true }));
+      control.dispatchEvent(new Event("change", { bubbles: true }));
+    }
+  }
+}
+
+function controls(root: HTMLElement, exclude: string[]): Control[] {
+  return Array.from(root.querySelectorAll<Control>("input[name], select[name], textarea[name]")).filter((control) =>
+    isDraftControl(control, exclude)
+  );
+}
+
+function isDraftControl(target: EventTarget | null, exclude: string[]): target is Control {
+  if (
+    !(target instanceof HTMLInputElement || target instanceof HTMLSelectElement || target instanceof HTMLTextAreaElement)
+  ) {
+    return false;
+  }
+  if (!target.name || target.disabled) {
+    return false;
+  }
+  if (target instanceof HTMLInputElement && SKIPPED_TYPES.has(target.type)) {
+    return false;
+  }
+  if (target.closest("[data-formgen-honeypot]")) {
+    return false;
+  }
+  const path = target.name.replace(/\[\d+\]/g, "[]");
+  return !exclude.some((entry) => path === entry || path.startsWith(entry + ".") || path.startsWith(entry + "["));
+}
+
+function parseSnapshot(value: unknown): DraftSnapshot | null {
+  if (!value || typeof value !== "object") {
+    return null;
+  }
+  const record = value as Record<string, unknown>;
+  if (!record.values || typeof record.values !== "object") {
+    return null;
+  }
+  const values: Record<string, string[]> = {};
+  for (const [name, entry] of Object.entries(record.values as Record<string, unknown>)) {
+    if (Array.isArray(entry)) {
+      values[name] = entry.filter((item): item is string => typeof item === "string");
+    }
+  }
+  return { savedAt: typeof record.savedAt === "number" ? record.savedAt : 0, values };
+}
+
+function normaliseConfig(config: unknown): DraftConfig | null {
+  const record = config && typeof config === "object" ? (config as Record<string, unknown>) : {};
+  const key = typeof record.key === "string" ? record.key.trim() : "";
+  if (!key) {
+    return null;
+  }
+  const endpoint = typeof record.endpoint === "string" && record.endpoint.trim() !== "" ? record.endpoint.trim() : undefined;
+  const debounce = Number(record.debounceMs);
+  const exclude = Array.isArray(record.exclude)
+    ? record.exclude.filter((entry): entry is string => typeof entry === "string" && entry.trim() !== "")
+    : [];
+  return {
+    key,
+    endpoint,
+    debounceMs: Number.isFinite(debounce) && debounce >= 0 ? debounce : DEFAULT_DEBOUNCE_MS,
+    exclude,
+  };
+}
+
+function storage(): Storage | null {
+  try {
+    return typeof localStorage === "undefined" ? null : localStorage;
+  } catch {
+    return null;
+  }
+}
+
+function dispatch(element: HTMLElement, type: string, detail: DraftEventDetail): void {
+  element.dispatchEvent(new CustomEvent(type, { bubbles: true, detail }));
+}
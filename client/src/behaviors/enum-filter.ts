@@ -0,0 +1,127 @@
+import type { BehaviorFactory } from "./types";
+import { evaluateExpression } from "./expression";
+
+interface EnumFilterConfig {
+  when: string;
+  allow: string[];
+}
+
+// enumFilter narrows the options of a select, radio group, or checkbox group
+// to `allow` while the `when` expression holds against the other form values.
+// Options outside the list are hidden and disabled; a selection that falls
+// outside it is cleared. The server enforces the same rule on submit.
+export const enumFilter: BehaviorFactory = ({ element, config, root }) => {
+  const options = normaliseConfig(config);
+  if (!options) {
+    console.warn("[formgen:behaviors] enumFilter config must define when and allow.");
+    return;
+  }
+
+  const apply = () => {
+    let active: boolean;
+    try {
+      active = evaluateExpression(options.when, (identifier) => readValue(root, identifier));
+    } catch (error) {
+      console.warn("[formgen:behaviors] enumFilter could not evaluate its condition:", error);
+      return;
+    }
+    const allowed = new Set(options.allow);
+    for (const option of collectOptions(element)) {
+      if (option.value === "") {
+        continue;
+      }
+      const blocked = active && !allowed.has(option.value);
+      toggleOption(option, blocked);
+    }
+  };
+
+  const handleChange = (event: Event) => {
+    if (event.target instanceof Node && element.contains(event.target)) {
+      return;
+    }
+    apply();
+  };
+
+  root.addEventListener("change", handleChange);
+  root.addEventListener("input", handleChange);
+  apply();
+
+  return () => {
+    root.removeEventListener("change", handleChange);
+    root.removeEventListener("input", handleChange);
+    for (const option of collectOptions(element)) {
+      toggleOption(option, false);
+    }
+  };
+};
+
+function collectOptions(element: HTMLElement): Array<HTMLOptionElement | HTMLInputElement> {
+  if (element instanceof HTMLSelectElement) {
+    return Array.from(element.options);
+  }
+  return Array.from(
+    element.querySelectorAll<HTMLInputElement>('input[type="radio"], input[type="checkbox"]'),
+  );
+}
+
+function toggleOption(option: HTMLOptionElement | HTMLInputElement, blocked: boolean): void {
+  const wasBlocked = option.hasAttribute("data-enum-filtered");
+  if (blocked === wasBlocked) {
+    return;
+  }
+  const container = option instanceof HTMLInputElement ? option.closest<HTMLElement>("label") ?? option : option;
+  if (blocked) {
+    option.setAttribute("data-enum-filtered", "");
+    option.disabled = true;
+    container.hidden = true;
+    const selected = option instanceof HTMLOptionElement ? option.selected : option.checked;
+    if (selected) {
+      if (option instanceof HTMLOptionElement) {
+        option.selected = false;
+        option.parentElement?.closest("select")?.dispatchEvent(new Event("change", { bubbles: true }));
+      } else {
+        option.checked = false;
+        option.dispatchEvent(new Event("change", { bubbles: true }));
+      }
+    }
+    return;
+  }
+  option.removeAttribute("data-enum-filtered");
+  option.disabled = false;
+  container.hidden = false;
+}
+
+function readValue(root: HTMLElement, name: string): unknown {
+  const controls = Array.from(root.querySelectorAll<HTMLElement>(`[name="${CSS.escape(name)}"]`));
+  if (controls.length === 0) {
+    return undefined;
+  }
+  const first = controls[0];
+  if (first instanceof HTMLInputElement && first.type === "radio") {
+    const checked = controls.find((control) => (control as HTMLInputElement).checked) as HTMLInputElement | undefined;
+    return checked?.value ?? null;
+  }
+  if (first instanceof HTMLInputElement && first.type === "checkbox") {
+    if (controls.length === 1) {
+      return first.checked;
+    }
+    return controls
+      .filter((control) => (control as HTMLInputElement).checked)
+      .map((control) => (control as HTMLInputElement).value);
+  }
+  if (first instanceof HTMLSelectElement && first.multiple) {
+    return Array.from(first.selectedOptions).map((option) => option.value);
+  }
+  return (first as HTMLInputElement | HTMLSelectElement | HTMLTextAreaElement).value;
+}
+
+function normaliseConfig(config: unknown): EnumFilterConfig | null {
+  if (!config || typeof config !== "object") {
+    return null;
+  }
+  const record = config as Record<string, unknown>;
+  if (typeof record.when !== "string" || !Array.isArray(record.allow)) {
+    return null;
+  }
+  return { when: record.when, allow: record.allow.map((value) => String(value)) };
+}
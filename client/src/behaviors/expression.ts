@@ -0,0 +1,194 @@
+// Client-side counterpart of pkg/visibility/expr. Supports identifiers,
+// `==` / `!=` comparisons against string, number, boolean and null literals,
+// `!`, `&&`, `||`, and parentheses.
+
+export type ExpressionLookup = (identifier: string) => unknown;
+
+type Token =
+  | { kind: "ident" | "string" | "number" | "bool" | "null"; raw: string }
+  | { kind: "eq" | "neq" | "and" | "or" | "not" | "lparen" | "rparen"; raw: string };
+
+type Node = (lookup: ExpressionLookup) => boolean;
+
+export function evaluateExpression(rule: string, lookup: ExpressionLookup): boolean {
+  const trimmed = rule.trim();
+  if (!trimmed) {
+    return true;
+  }
+  const tokens = tokenize(trimmed);
+  const stream = { tokens, pos: 0 };
+  const node = parseOr(stream);
+  if (stream.pos < tokens.length) {
+    throw new Error(`[formgen:expr] unexpected token "${tokens[stream.pos].raw}"`);
+  }
+  return node(lookup);
+}
+
+function tokenize(input: string): Token[] {
+  const tokens: Token[] = [];
+  let pos = 0;
+  while (pos < input.length) {
+    const ch = input[pos];
+    if (/\s/.test(ch)) {
+      pos++;
+      continue;
+    }
+    const pair = input.slice(pos, pos + 2);
+    if (pair === "==" || pair === "!=" || pair === "&&" || pair === "||") {
+      const kinds = { "==": "eq", "!=": "neq", "&&": "and", "||": "or" } as const;
+      tokens.push({ kind: kinds[pair], raw: pair });
+      pos += 2;
+      continue;
+    }
+    if (ch === "!" || ch === "(" || ch === ")") {
+      tokens.push({ kind: ch === "!" ? "not" : ch === "(" ? "lparen" : "rparen", raw: ch });
+      pos++;
+      continue;
+    }
+    if (ch === "=" || ch === "&" || ch === "|") {
+      throw new Error(`[formgen:expr] unexpected "${ch}"`);
+    }
+    if (ch === '"' || ch === "'") {
+      let end = pos + 1;
+      let value = "";
+      while (end < input.length && input[end] !== ch) {
+        if (input[end] === "\\" && end + 1 < input.length) {
+          end++;
+        }
+        value += input[end];
+        end++;
+      }
+      if (end >= input.length) {
+        throw new Error("[formgen:expr] unterminated string literal");
+      }
+      tokens.push({ kind: "string", raw: value });
+      pos = end + 1;
+      continue;
+    }
+    let end = pos;
+    while (end < input.length && !/[\s()!=&|]/.test(input[end])) {
+      end++;
+    }
+    tokens.push(bareToken(input.slice(pos, end)));
+    pos = end;
+  }
+  return tokens;
+}
+
+function bareToken(raw: string): Token {
+  const lower = raw.toLowerCase();
+  if (lower === "true" || lower === "false") {
+    return { kind: "bool", raw: lower };
+  }
+  if (lower === "null" || lower === "nil") {
+    return { kind: "null", raw: "null" };
+  }
+  if (/^[0-9+-]/.test(raw)) {
+    return { kind: "number", raw };
+  }
+  return { kind: "ident", raw };
+}
+
+interface Stream {
+  tokens: Token[];
+  pos: number;
+}
+
+function match(stream: Stream, kind: Token["kind"]): Token | null {
+  const token = stream.tokens[stream.pos];
+  if (!token || token.kind !== kind) {
+    return null;
+  }
+  stream.pos++;
+  return token;
+}
+
+function parseOr(stream: Stream): Node {
+  let left = parseAnd(stream);
+  while (match(stream, "or")) {
+    const l = left;
+    const right = parseAnd(stream);
+    left = (lookup) => l(lookup) || right(lookup);
+  }
+  return left;
+}
+
+function parseAnd(stream: Stream): Node {
+  let left = parseUnary(stream);
+  while (match(stream, "and")) {
+    const l = left;
+    const right = parseUnary(stream);
+    left = (lookup) => l(lookup) && right(lookup);
+  }
+  return left;
+}
+
+function parseUnary(stream: Stream): Node {
+  if (match(stream, "not")) {
+    const inner = parseUnary(stream);
+    return (lookup) => !inner(lookup);
+  }
+  return parsePrimary(stream);
+}
+
+function parsePrimary(stream: Stream): Node {
+  if (match(stream, "lparen")) {
+    const inner = parseOr(stream);
+    if (!match(stream, "rparen")) {
+      throw new Error("[formgen:expr] missing closing ')'");
+    }
+    return inner;
+  }
+  const ident = match(stream, "ident");
+  if (!ident) {
+    const next = stream.tokens[stream.pos];
+    throw new Error(next ? `[formgen:expr] expected identifier, got "${next.raw}"` : "[formgen:expr] empty expression");
+  }
+  const negate = match(stream, "neq") !== null;
+  if (!negate && !match(stream, "eq")) {
+    return (lookup) => truthy(lookup(ident.raw));
+  }
+  const literal = stream.tokens[stream.pos++];
+  if (!literal || !["string", "number", "bool", "null", "ident"].includes(literal.kind)) {
+    throw new Error("[formgen:expr] missing literal");
+  }
+  return (lookup) => compare(lookup(ident.raw), literal) !== negate;
+}
+
+function compare(value: unknown, literal: Token): boolean {
+  switch (literal.kind) {
+    case "null":
+      return value === null || value === undefined || value === "";
+    case "bool":
+      return coerceBool(value) === (literal.raw === "true");
+    case "number": {
+      const got = Number(value ?? 0);
+      return !Number.isNaN(got) && got === Number(literal.raw);
+    }
+    default:
+      return String(value ?? "") === literal.raw;
+  }
+}
+
+function coerceBool(value: unknown): boolean {
+  if (typeof value === "string") {
+    const trimmed = value.trim().toLowerCase();
+    if (trimmed === "true" || trimmed === "false") {
+      return trimmed === "true";
+    }
+  }
+  return truthy(value);
+}
+
+function truthy(value: unknown): boolean {
+  if (value === null || value === undefined) {
+    return false;
+  }
+  if (typeof value === "string") {
+    return value.trim() !== "";
+  }
+  if (Array.isArray(value)) {
+    return value.length > 0;
+  }
+  return Boolean(value);
+}
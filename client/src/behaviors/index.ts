@@ -1,5 +1,15 @@
 import { autoSlug } from "./auto-slug";
 import { autoResize } from "./auto-resize";
+import { enumFilter } from "./enum-filter";
+import { advancedToggle, ADVANCED_TOGGLE_EVENT } from "./advanced-toggle";
+import {
+  submitQueue,
+  SUBMISSION_FAILED_EVENT,
+  SUBMISSION_QUEUED_EVENT,
+  SUBMISSION_SENT_EVENT,
+  __resetSubmitQueueForTests,
+} from "./submit-queue";
+import { draft, clearDraft, DRAFT_CLEARED_EVENT, DRAFT_RESTORED_EVENT, DRAFT_SAVED_EVENT } from "./draft";
 import { initBehaviors as initBehaviorsCore, registerBehavior, resetBehaviorRegistry } from "./registry";
 import type { BehaviorInitResult } from "./registry";
 import { slugify } from "./utils";
@@ -11,6 +21,10 @@ registerDefaults();
 function registerDefaults(): void {
   registerBehavior("autoSlug", autoSlug);
   registerBehavior("autoResize", autoResize);
+  registerBehavior("enumFilter", enumFilter);
+  registerBehavior("submitQueue", submitQueue);
+  registerBehavior("advancedToggle", advancedToggle);
+  registerBehavior("draft", draft);
 }
 
 export function initBehaviors(root: Document | HTMLElement = document): BehaviorInitResult {
@@ -20,12 +34,36 @@ export function initBehaviors(root: Document | HTMLElement = document): Behavior
   return result;
 }
 
-export { registerBehavior, registerIconProvider, initIcons, initJSONEditors, slugify, autoSlug, autoResize };
+export {
+  registerBehavior,
+  registerIconProvider,
+  initIcons,
+  initJSONEditors,
+  slugify,
+  autoSlug,
+  autoResize,
+  enumFilter,
+  submitQueue,
+  advancedToggle,
+  draft,
+  clearDraft,
+  ADVANCED_TOGGLE_EVENT,
+  DRAFT_RESTORED_EVENT,
+  DRAFT_SAVED_EVENT,
+  DRAFT_CLEARED_EVENT,
+  SUBMISSION_SENT_EVENT,
+  SUBMISSION_QUEUED_EVENT,
+  SUBMISSION_FAILED_EVENT,
+};
 export type { BehaviorContext, BehaviorFactory } from "./types";
+export type { QueuedSubmission, SubmissionEventDetail } from "./submit-queue";
+export type { AdvancedToggleEventDetail } from "./advanced-toggle";
+export type { DraftEventDetail, DraftSnapshot } from "./draft";
 export type { BehaviorInitResult } from "./registry";
 
 export function __resetBehaviorsForTests(): void {
   resetBehaviorRegistry();
   __resetIconProvidersForTests();
+  __resetSubmitQueueForTests();
   registerDefaults();
 }
@@ -0,0 +1,312 @@
+import type { BehaviorFactory } from "./types";
+
+/**
+ * submitQueue sends a form with fetch and, when the network or a gateway
+ * fails, keeps the submission in IndexedDB and retries it with exponential
+ * backoff once connectivity returns. Every attempt carries the form's
+ * idempotency key (hidden field rendered by `render.IdempotencyKey` on the Go
+ * side) as an `Idempotency-Key` header so the server can drop duplicates.
+ *
+ * Attach it to the form or a wrapper: `data-behavior="submitQueue"`.
+ */
+
+export const SUBMISSION_SENT_EVENT = "formgen:submission:sent" as const;
+export const SUBMISSION_QUEUED_EVENT = "formgen:submission:queued" as const;
+export const SUBMISSION_FAILED_EVENT = "formgen:submission:failed" as const;
+
+const DEFAULT_KEY_FIELD = "_idempotency_key";
+const IDEMPOTENCY_HEADER = "Idempotency-Key";
+const DB_NAME = "formgen-submit-queue";
+const STORE_NAME = "submissions";
+const RETRYABLE_STATUSES = new Set([408, 425, 429]);
+
+interface SubmitQueueConfig {
+  keyField?: string;
+  maxAttempts?: number;
+  baseDelayMs?: number;
+  maxDelayMs?: number;
+}
+
+export interface QueuedSubmission {
+  /** Idempotency key, also the queue record key. */
+  id: string;
+  url: string;
+  method: string;
+  multipart: boolean;
+  entries: Array<[string, FormDataEntryValue]>;
+  attempts: number;
+  maxAttempts: number;
+  baseDelayMs: number;
+  maxDelayMs: number;
+  nextAttemptAt: number;
+}
+
+export interface SubmissionEventDetail {
+  submission: QueuedSubmission;
+  response?: Response;
+  error?: unknown;
+}
+
+interface SubmitQueueStore {
+  put(entry: QueuedSubmission): Promise<void>;
+  delete(id: string): Promise<void>;
+  list(): Promise<QueuedSubmission[]>;
+}
+
+class MemorySubmitQueueStore implements SubmitQueueStore {
+  private readonly entries = new Map<string, QueuedSubmission>();
+
+  async put(entry: QueuedSubmission): Promise<void> {
+    this.entries.set(entry.id, entry);
+  }
+
+  async delete(id: string): Promise<void> {
+    this.entries.delete(id);
+  }
+
+  async list(): Promise<QueuedSubmission[]> {
+    return Array.from(this.entries.values());
+  }
+}
+
+class IndexedDBSubmitQueueStore implements SubmitQueueStore {
+  private db: Promise<IDBDatabase> | null = null;
+
+  constructor(private readonly factory: IDBFactory) {}
+
+  async put(entry: QueuedSubmission): Promise<void> {
+    await this.run("readwrite", (store) => store.put(entry));
+  }
+
+  async delete(id: string): Promise<void> {
+    await this.run("readwrite", (store) => store.delete(id));
+  }
+
+  async list(): Promise<QueuedSubmission[]> {
+    const result = await this.run("readonly", (store) => store.getAll());
+    return Array.isArray(result) ? (result as QueuedSubmission[]) : [];
+  }
+
+  private open(): Promise<IDBDatabase> {
+    if (!this.db) {
+      this.db = new Promise((resolve, reject) => {
+        const request = this.factory.open(DB_NAME, 1);
+        request.onupgradeneeded = () => {
+          request.result.createObjectStore(STORE_NAME, { keyPath: "id" });
+        };
+        request.onsuccess = () => resolve(request.result);
+        request.onerror = () => reject(request.error);
+      });
+    }
+    return this.db;
+  }
+
+  private async run(mode: IDBTransactionMode, action: (store: IDBObjectStore) => IDBRequest): Promise<unknown> {
+    const db = await this.open();
+    return new Promise((resolve, reject) => {
+      const request = action(db.transaction(STORE_NAME, mode).objectStore(STORE_NAME));
+      request.onsuccess = () => resolve(request.result);
+      request.onerror = () => reject(request.error);
+    });
+  }
+}
+
+let queueStore: SubmitQueueStore | null = null;
+let flushing: Promise<void> | null = null;
+let retryTimer: ReturnType<typeof setTimeout> | undefined;
+
+function store(): SubmitQueueStore {
+  if (!queueStore) {
+    queueStore =
+      typeof indexedDB !== "undefined" ? new IndexedDBSubmitQueueStore(indexedDB) : new MemorySubmitQueueStore();
+  }
+  return queueStore;
+}
+
+export const submitQueue: BehaviorFactory = ({ element, config }) => {
+  const form =
+    element instanceof HTMLFormElement ? element : element.closest("form") ?? element.querySelector("form");
+  if (!form) {
+    console.warn("[formgen:behaviors] submitQueue requires a form target.");
+    return;
+  }
+
+  const options = normaliseConfig(config);
+
+  const handleSubmit = (event: SubmitEvent) => {
+    const method = (form.getAttribute("method") || "GET").toUpperCase();
+    if (event.defaultPrevented || method === "GET") {
+      return;
+    }
+    event.preventDefault();
+    void submit(form, method, options);
+  };
+
+  const handleOnline = () => {
+    void flushQueue(true);
+  };
+
+  form.addEventListener("submit", handleSubmit);
+  window.addEventListener("online", handleOnline);
+  void flushQueue(false);
+
+  return () => {
+    form.removeEventListener("submit", handleSubmit);
+    window.removeEventListener("online", handleOnline);
+  };
+};
+
+async function submit(form: HTMLFormElement, method: string, options: Required<SubmitQueueConfig>): Promise<void> {
+  const keyInput = form.querySelector<HTMLInputElement>(`input[name="${options.keyField}"]`);
+  const submission: QueuedSubmission = {
+    id: keyInput?.value || newIdempotencyKey(),
+    url: form.action || window.location.href,
+    method,
+    multipart: (form.getAttribute("enctype") || "").toLowerCase() === "multipart/form-data",
+    entries: Array.from(new FormData(form).entries()),
+    attempts: 0,
+    maxAttempts: options.maxAttempts,
+    baseDelayMs: options.baseDelayMs,
+    maxDelayMs: options.maxDelayMs,
+    nextAttemptAt: Date.now(),
+  };
+
+  const outcome = await attempt(submission, form);
+  if (outcome === "sent" && keyInput) {
+    // A later, deliberate resubmission of this form is a new request.
+    keyInput.value = newIdempotencyKey();
+  }
+}
+
+/**
+ * attempt sends one submission. Network errors and retryable statuses keep
+ * (or put) it in the queue with the next backoff delay; any other response
+ * removes it and is reported through the sent event.
+ */
+async function attempt(submission: QueuedSubmission, target: EventTarget): Promise<"sent" | "queued" | "failed"> {
+  let response: Response | undefined;
+  let error: unknown;
+  if (typeof navigator === "undefined" || navigator.onLine !== false) {
+    try {
+      response = await fetch(submission.url, {
+        method: submission.method,
+        headers: { Accept: "application/json", [IDEMPOTENCY_HEADER]: submission.id },
+        body: requestBody(submission),
+        credentials: "same-origin",
+      });
+    } catch (err) {
+      error = err;
+    }
+    submission.attempts += 1;
+  }
+
+  if (response && !isRetryable(response.status)) {
+    await store().delete(submission.id);
+    emit(target, SUBMISSION_SENT_EVENT, { submission, response });
+    return "sent";
+  }
+  if (submission.attempts >= submission.maxAttempts) {
+    await store().delete(submission.id);
+    emit(target, SUBMISSION_FAILED_EVENT, { submission, response, error });
+    return "failed";
+  }
+
+  submission.nextAttemptAt = Date.now() + backoffDelay(submission);
+  await store().put(submission);
+  emit(target, SUBMISSION_QUEUED_EVENT, { submission, response, error });
+  scheduleRetry(submission.nextAttemptAt);
+  return "queued";
+}
+
+/**
+ * flushQueue retries queued submissions that are due (or all of them when
+ * connectivity just returned). Concurrent calls share one pass.
+ */
+function flushQueue(force: boolean): Promise<void> {
+  if (flushing) {
+    return flushing;
+  }
+  flushing = (async () => {
+    try {
+      const now = Date.now();
+      for (const submission of await store().list()) {
+        if (force || submission.nextAttemptAt <= now) {
+          await attempt(submission, document);
+        } else {
+          scheduleRetry(submission.nextAttemptAt);
+        }
+      }
+    } catch (error) {
+      console.warn("[formgen:behaviors] submitQueue flush failed:", error);
+    } finally {
+      flushing = null;
+    }
+  })();
+  return flushing;
+}
+
+function scheduleRetry(at: number): void {
+  if (retryTimer !== undefined) {
+    clearTimeout(retryTimer);
+  }
+  retryTimer = setTimeout(() => {
+    retryTimer = undefined;
+    void flushQueue(false);
+  }, Math.max(0, at - Date.now()));
+}
+
+function backoffDelay(submission: QueuedSubmission): number {
+  const exponent = Math.max(0, submission.attempts - 1);
+  return Math.min(submission.baseDelayMs * 2 ** exponent, submission.maxDelayMs);
+}
+
+function isRetryable(status: number): boolean {
+  return RETRYABLE_STATUSES.has(status) || status >= 500;
+}
+
+function requestBody(submission: QueuedSubmission): BodyInit {
+  if (submission.multipart) {
+    const data = new FormData();
+    submission.entries.forEach(([name, value]) => data.append(name, value));
+    return data;
+  }
+  const params = new URLSearchParams();
+  submission.entries.forEach(([name, value]) => {
+    if (typeof value === "string") {
+      params.append(name, value);
+    }
+  });
+  return params;
+}
+
+function emit(target: EventTarget, name: string, detail: SubmissionEventDetail): void {
+  target.dispatchEvent(new CustomEvent<SubmissionEventDetail>(name, { bubbles: true, detail }));
+}
+
+function newIdempotencyKey(): string {
+  if (typeof crypto !== "undefined" && typeof crypto.randomUUID === "function") {
+    return crypto.randomUUID();
+  }
+  return `${Date.now().toString(16)}-${Math.random().toString(16).slice(2)}`;
+}
+
+function normaliseConfig(config: unknown): Required<SubmitQueueConfig> {
+  const record = config && typeof config === "object" ? (config as Record<string, unknown>) : {};
+  const positive = (value: unknown, fallback: number) =>
+    typeof value === "number" && Number.isFinite(value) && value > 0 ? value : fallback;
+  return {
+    keyField: typeof record.keyField === "string" && record.keyField ? record.keyField : DEFAULT_KEY_FIELD,
+    maxAttempts: positive(record.maxAttempts, 5),
+    baseDelayMs: positive(record.baseDelayMs, 1000),
+    maxDelayMs: positive(record.maxDelayMs, 30000),
+  };
+}
+
+export function __resetSubmitQueueForTests(): void {
+  if (retryTimer !== undefined) {
+    clearTimeout(retryTimer);
+    retryTimer = undefined;
+  }
+  queueStore = new MemorySubmitQueueStore();
+  flushing = null;
+}
@@ -0,0 +1,526 @@
+import type { GlobalConfig } from "../config";
+import type { ResolverRegistry } from "../registry";
+import { attachFormController, initFormgenRoot, type FormController } from "../index";
+import { datasetToFieldConfig } from "../relationship-config";
+import { readDataset } from "../dom";
+import { clearFieldError, renderFieldError } from "../errors";
+import { validateFieldValue, type ValidationValue } from "../validation";
+
+/**
+ * formgen-client renders the canonical FormModel JSON (as emitted by the Go
+ * `json` renderer) into a live form without server-rendered HTML. The markup
+ * mirrors the vanilla renderer's data-* contract so relationship resolvers,
+ * validation, and the Formgen controller work exactly as they do for
+ * server-rendered forms.
+ */
+
+export interface ModelRelationship {
+  kind: string;
+  target?: string;
+  foreignKey?: string;
+  cardinality?: string;
+  inverse?: string;
+}
+
+export interface ModelOption {
+  value: unknown;
+  label?: string;
+  disabled?: boolean;
+}
+
+export interface ModelValidationRule {
+  kind: string;
+  params?: Record<string, string>;
+}
+
+export interface ModelField {
+  name: string;
+  type: string;
+  format?: string;
+  required?: boolean;
+  disabled?: boolean;
+  readonly?: boolean;
+  label?: string;
+  placeholder?: string;
+  description?: string;
+  default?: unknown;
+  enum?: unknown[];
+  options?: ModelOption[];
+  nested?: ModelField[];
+  items?: ModelField;
+  validations?: ModelValidationRule[];
+  metadata?: Record<string, string>;
+  uiHints?: Record<string, string>;
+  relationship?: ModelRelationship;
+}
+
+export interface FormModel {
+  operationId: string;
+  endpoint: string;
+  method: string;
+  summary?: string;
+  description?: string;
+  fields: ModelField[];
+  metadata?: Record<string, string>;
+  uiHints?: Record<string, string>;
+}
+
+/** FormDescriptor is the envelope emitted by the json renderer by default. */
+export interface FormDescriptor {
+  version?: string;
+  form: FormModel;
+  values?: Record<string, unknown>;
+  errors?: Record<string, string[]>;
+  formErrors?: string[];
+  hiddenFields?: Array<{ name: string; value: string }>;
+}
+
+export interface RenderFormOptions {
+  /** Prefill values keyed by dotted field path; merged over descriptor values. */
+  values?: Record<string, unknown>;
+  /** Server errors keyed by dotted field path; merged over descriptor errors. */
+  errors?: Record<string, string | string[]>;
+  /** Runtime configuration forwarded to the relationship resolvers. */
+  runtime?: GlobalConfig;
+  /** Label for the generated submit button. Defaults to "Submit". */
+  submitLabel?: string;
+  /**
+   * Called with the collected values after client-side validation passes.
+   * When set, native form submission is prevented.
+   */
+  onSubmit?: (values: Record<string, unknown>, event: SubmitEvent) => void | Promise<void>;
+}
+
+export interface ClientForm {
+  element: HTMLFormElement;
+  controller: FormController;
+  registry: ResolverRegistry;
+  /** Runs client-side validation, rendering inline errors. Returns true when valid. */
+  validate(): boolean;
+  destroy(): void;
+}
+
+const CONTROL_ID_PREFIX = "fg-";
+const BROWSER_METHODS = new Set(["GET", "POST"]);
+
+/**
+ * renderForm builds a form from a FormModel (or json renderer descriptor),
+ * mounts it into target, and initialises the runtime within the new root.
+ */
+export async function renderForm(
+  target: HTMLElement | string,
+  source: FormModel | FormDescriptor,
+  options: RenderFormOptions = {}
+): Promise<ClientForm> {
+  const container = resolveTarget(target);
+  const descriptor = toDescriptor(source);
+  const form = buildForm(descriptor, options);
+
+  container.replaceChildren(form);
+
+  const registry = await initFormgenRoot(form, options.runtime ?? {});
+  const controller = attachFormController(form, { registry });
+
+  const values = { ...(descriptor.values ?? {}), ...(options.values ?? {}) };
+  if (Object.keys(values).length > 0) {
+    controller.setValues(values);
+  }
+  const errors = { ...(descriptor.errors ?? {}), ...(options.errors ?? {}) };
+  if (Object.keys(errors).length > 0) {
+    controller.setErrors(errors);
+  }
+
+  const validate = () => validateForm(form);
+  const handleSubmit = (event: SubmitEvent) => {
+    if (!validate()) {
+      event.preventDefault();
+      return;
+    }
+    if (options.onSubmit) {
+      event.preventDefault();
+      void options.onSubmit(controller.getValues({ includeHidden: true }), event);
+    }
+  };
+  form.addEventListener("submit", handleSubmit);
+
+  return {
+    element: form,
+    controller,
+    registry,
+    validate,
+    destroy() {
+      form.removeEventListener("submit", handleSubmit);
+      controller.destroy();
+      form.remove();
+    },
+  };
+}
+
+/**
+ * loadForm fetches a FormModel or descriptor from url (typically an endpoint
+ * backed by the json renderer) and renders it into target.
+ */
+export async function loadForm(
+  target: HTMLElement | string,
+  url: string,
+  options: RenderFormOptions & { request?: RequestInit } = {}
+): Promise<ClientForm> {
+  const response = await fetch(url, {
+    ...options.request,
+    headers: { Accept: "application/json", ...(options.request?.headers ?? {}) },
+  });
+  if (!response.ok) {
+    throw new Error(`formgen: failed to load form model from ${url} (${response.status})`);
+  }
+  const payload = (await response.json()) as FormModel | FormDescriptor;
+  return renderForm(target, payload, options);
+}
+
+function resolveTarget(target: HTMLElement | string): HTMLElement {
+  if (typeof target !== "string") {
+    return target;
+  }
+  const found = document.querySelector<HTMLElement>(target);
+  if (!found) {
+    throw new Error(`formgen: render target not found for selector ${target}`);
+  }
+  return found;
+}
+
+function toDescriptor(source: FormModel | FormDescriptor): FormDescriptor {
+  if (source && typeof source === "object" && "form" in source && source.form) {
+    return source;
+  }
+  return { form: source as FormModel };
+}
+
+function buildForm(descriptor: FormDescriptor, options: RenderFormOptions): HTMLFormElement {
+  const model = descriptor.form;
+  const form = document.createElement("form");
+  const method = (model.method || "POST").toUpperCase();
+  form.method = BROWSER_METHODS.has(method) ? method.toLowerCase() : "post";
+  form.action = model.endpoint ?? "";
+  form.noValidate = true;
+  form.dataset.formgenRenderMode = "client";
+  if (model.operationId) {
+    form.dataset.formgenOperation = model.operationId;
+  }
+
+  if (!BROWSER_METHODS.has(method)) {
+    form.append(hiddenInput("_method", method));
+  }
+  for (const field of descriptor.hiddenFields ?? []) {
+    form.append(hiddenInput(field.name, field.value));
+  }
+
+  const title = model.uiHints?.["layout.title"];
+  const subtitle = model.uiHints?.["layout.subtitle"];
+  if (title || subtitle) {
+    const header = document.createElement("header");
+    header.className = "formgen-header";
+    if (title) {
+      header.append(textElement("h1", title));
+    }
+    if (subtitle) {
+      header.append(textElement("p", subtitle));
+    }
+    form.append(header);
+  }
+
+  if (descriptor.formErrors && descriptor.formErrors.length > 0) {
+    const summary = document.createElement("div");
+    summary.className = "formgen-errors";
+    summary.setAttribute("role", "alert");
+    const list = document.createElement("ul");
+    descriptor.formErrors.forEach((message) => list.append(textElement("li", message)));
+    summary.append(list);
+    form.append(summary);
+  }
+
+  const grid = document.createElement("div");
+  grid.className = "formgen-grid";
+  for (const field of model.fields ?? []) {
+    const rendered = buildField(field, "");
+    if (rendered) {
+      grid.append(rendered);
+    }
+  }
+  form.append(grid);
+
+  const actions = document.createElement("div");
+  actions.className = "formgen-actions";
+  const submit = textElement("button", options.submitLabel ?? "Submit");
+  submit.setAttribute("type", "submit");
+  actions.append(submit);
+  form.append(actions);
+
+  return form;
+}
+
+function buildField(field: ModelField, parentPath: string): HTMLElement | null {
+  const path = parentPath ? `${parentPath}.${field.name}` : field.name;
+  const id = controlID(path);
+
+  if (field.type === "object" && field.nested && field.nested.length > 0 && !field.relationship) {
+    const fieldset = document.createElement("fieldset");
+    fieldset.dataset.formgenField = path;
+    fieldset.append(textElement("legend", field.label || field.name));
+    for (const child of field.nested) {
+      const rendered = buildField(child, path);
+      if (rendered) {
+        fieldset.append(rendered);
+      }
+    }
+    return fieldset;
+  }
+
+  const control = buildControl(field, path, id);
+  if (!control) {
+    return null;
+  }
+
+  const wrapper = document.createElement("div");
+  wrapper.dataset.formgenField = path;
+  if (field.type === "boolean") {
+    const label = document.createElement("label");
+    label.htmlFor = id;
+    label.append(control, document.createTextNode(` ${field.label || field.name}`));
+    wrapper.append(label);
+  } else {
+    const label = textElement("label", field.label || field.name);
+    (label as HTMLLabelElement).htmlFor = id;
+    wrapper.append(label, control);
+  }
+  if (field.description) {
+    const description = textElement("p", field.description);
+    description.id = `${id}-description`;
+    control.setAttribute("aria-describedby", description.id);
+    wrapper.append(description);
+  }
+  return wrapper;
+}
+
+function buildControl(field: ModelField, path: string, id: string): HTMLElement | null {
+  const options = fieldOptions(field);
+  const isArray = field.type === "array";
+  let control: HTMLInputElement | HTMLSelectElement | HTMLTextAreaElement;
+
+  if (field.relationship || options.length > 0) {
+    const select = document.createElement("select");
+    select.multiple = isArray || field.relationship?.cardinality === "many";
+    if (!select.multiple) {
+      select.append(new Option(field.placeholder ?? "", ""));
+    }
+    for (const option of options) {
+      const element = new Option(option.label ?? String(option.value), String(option.value));
+      element.disabled = Boolean(option.disabled);
+      select.append(element);
+    }
+    control = select;
+  } else if (isArray) {
+    // Repeaters for arrays of objects/scalars stay server-rendered for now.
+    return null;
+  } else if (isLongText(field)) {
+    control = document.createElement("textarea");
+  } else {
+    const input = document.createElement("input");
+    input.type = inputType(field);
+    if (field.type === "boolean") {
+      input.value = "true";
+      input.checked = field.default === true || field.default === "true";
+    } else if (field.default != null && typeof field.default !== "object") {
+      input.value = String(field.default);
+    }
+    if (field.type === "integer") {
+      input.step = "1";
+    }
+    control = input;
+  }
+
+  control.id = id;
+  control.name = path;
+  if (field.placeholder && !(control instanceof HTMLSelectElement)) {
+    control.placeholder = field.placeholder;
+  }
+  control.required = Boolean(field.required);
+  control.disabled = Boolean(field.disabled);
+  if (field.readonly && !(control instanceof HTMLSelectElement)) {
+    control.readOnly = true;
+  }
+  applyNativeConstraints(control, field.validations ?? []);
+  applyDataAttributes(control, field);
+  return control;
+}
+
+function fieldOptions(field: ModelField): ModelOption[] {
+  if (field.options && field.options.length > 0) {
+    return field.options;
+  }
+  const source = field.enum ?? field.items?.enum ?? [];
+  return source.map((value) => ({ value, label: String(value) }));
+}
+
+function isLongText(field: ModelField): boolean {
+  const candidates = [field.format, field.uiHints?.input, field.uiHints?.widget, field.metadata?.widget];
+  return candidates.some((value) => value === "textarea");
+}
+
+function inputType(field: ModelField): string {
+  switch (field.type) {
+    case "boolean":
+      return "checkbox";
+    case "integer":
+    case "number":
+      return "number";
+  }
+  switch (field.format) {
+    case "email":
+      return "email";
+    case "uri":
+    case "url":
+      return "url";
+    case "date":
+      return "date";
+    case "date-time":
+      return "datetime-local";
+    case "time":
+      return "time";
+    case "password":
+      return "password";
+  }
+  return field.uiHints?.inputType || "text";
+}
+
+function applyNativeConstraints(control: HTMLElement, rules: ModelValidationRule[]): void {
+  for (const rule of rules) {
+    const value = rule.params?.value;
+    if (value == null) {
+      continue;
+    }
+    switch (rule.kind) {
+      case "min":
+      case "max":
+        control.setAttribute(rule.kind, value);
+        break;
+      case "minLength":
+      case "maxLength":
+        control.setAttribute(rule.kind.toLowerCase(), value);
+        break;
+      case "pattern":
+        control.setAttribute("pattern", value);
+        break;
+    }
+  }
+}
+
+// applyDataAttributes mirrors the vanilla renderer's data-* contract so the
+// shared runtime reads relationship and validation config from the element.
+function applyDataAttributes(control: HTMLElement, field: ModelField): void {
+  const relationship = field.relationship;
+  if (relationship) {
+    setData(control, "data-relationship-type", relationship.kind);
+    setData(control, "data-relationship-target", relationship.target);
+    setData(control, "data-relationship-foreign-key", relationship.foreignKey);
+    setData(control, "data-relationship-cardinality", relationship.cardinality);
+    setData(control, "data-relationship-inverse", relationship.inverse);
+  }
+
+  for (const [key, value] of Object.entries(field.metadata ?? {})) {
+    if (key.startsWith("relationship.endpoint.auth.")) {
+      setData(control, `data-auth-${toKebab(key.slice("relationship.endpoint.auth.".length))}`, value);
+    } else if (key.startsWith("relationship.endpoint.")) {
+      setData(control, `data-endpoint-${toKebab(key.slice("relationship.endpoint.".length))}`, value);
+    } else if (key === "relationship.current") {
+      setData(control, "data-relationship-current", value);
+    } else if (key.startsWith("validation.")) {
+      setData(control, `data-validation-${toKebab(key.slice("validation.".length))}`, value);
+    }
+  }
+
+  if (field.validations && field.validations.length > 0) {
+    setData(control, "data-validation-rules", JSON.stringify(field.validations));
+  }
+  if (field.required) {
+    setData(control, "data-validation-required", "true");
+  }
+  setData(control, "data-validation-label", field.label);
+}
+
+function validateForm(form: HTMLFormElement): boolean {
+  let valid = true;
+  const controls = form.querySelectorAll<HTMLInputElement | HTMLSelectElement | HTMLTextAreaElement>(
+    "input[name]:not([type=hidden]), select[name], textarea[name]"
+  );
+  controls.forEach((control) => {
+    if (control.disabled) {
+      return;
+    }
+    const config = datasetToFieldConfig(control, readDataset(control));
+    const result = validateFieldValue(config, readControlValue(control));
+    if (result.valid) {
+      clearFieldError(control);
+      return;
+    }
+    valid = false;
+    renderFieldError(control, result.messages[0] ?? null, result.errors[0]?.code);
+  });
+  return valid;
+}
+
+function readControlValue(control: HTMLInputElement | HTMLSelectElement | HTMLTextAreaElement): ValidationValue {
+  if (control instanceof HTMLSelectElement && control.multiple) {
+    return Array.from(control.selectedOptions).map((option) => option.value);
+  }
+  if (control instanceof HTMLInputElement && control.type === "checkbox") {
+    return control.checked ? control.value : null;
+  }
+  return control.value === "" ? null : control.value;
+}
+
+function controlID(path: string): string {
+  return CONTROL_ID_PREFIX + path.replace(/[^A-Za-z0-9_-]+/g, "-");
+}
+
+// toKebab matches the vanilla renderer's metadata-to-attribute conversion.
+function toKebab(value: string): string {
+  let out = "";
+  for (const char of value) {
+    if (char === ".") {
+      out += "-";
+    } else if (char !== char.toLowerCase()) {
+      out += (out && !out.endsWith("-") ? "-" : "") + char.toLowerCase();
+    } else {
+      out += char;
+    }
+  }
+  return out;
+}
+
+function setData(element: HTMLElement, name: string, value: string | undefined): void {
+  if (value != null && value !== "") {
+    element.setAttribute(name, value);
+  }
+}
+
+function hiddenInput(name: string, value: string): HTMLInputElement {
+  const input = document.createElement("input");
+  input.type = "hidden";
+  input.name = name;
+  input.value = value;
+  return input;
+}
+
+function textElement(tag: string, text: string): HTMLElement {
+  const element = document.createElement(tag);
+  element.textContent = text;
+  return element;
+}
+
+export const FormgenClient = {
+  render: renderForm,
+  load: loadForm,
+};
+
+if (typeof globalThis !== "undefined") {
+  (globalThis as Record<string, unknown>).FormgenClient = FormgenClient;
+}
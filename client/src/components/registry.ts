@@ -118,6 +118,12 @@ function registerDefaultComponents(): void {
   if (!factories.has("datetime-range")) {
     factories.set("datetime-range", datetimeRangeFactory);
   }
+  if (!factories.has("daterange")) {
+    factories.set("daterange", dateRangeFactory);
+  }
+  if (!factories.has("duration")) {
+    factories.set("duration", durationFactory);
+  }
   if (!factories.has("media_picker")) {
     factories.set("media_picker", mediaPickerFactory);
   }
@@ -160,3 +166,91 @@ function datetimeRangeFactory({ element }: ComponentContext): Teardown {
 
   return cleanup;
 }
+
+function dateRangeFactory({ element }: ComponentContext): Teardown {
+  const start = element.querySelector<HTMLInputElement>("[data-formgen-daterange-bound='start']");
+  const end = element.querySelector<HTMLInputElement>("[data-formgen-daterange-bound='end']");
+  if (!start || !end) {
+    return;
+  }
+
+  const sync = () => {
+    end.min = start.value;
+    start.max = end.value;
+    if (start.value && end.value && end.value < start.value) {
+      end.value = start.value;
+      start.max = end.value;
+    }
+  };
+
+  start.addEventListener("change", sync);
+  end.addEventListener("change", sync);
+  sync();
+
+  return () => {
+    start.removeEventListener("change", sync);
+    end.removeEventListener("change", sync);
+  };
+}
+
+const durationDesignators: Record<string, [string, boolean]> = {
+  years: ["Y", false],
+  months: ["M", false],
+  weeks: ["W", false],
+  days: ["D", false],
+  hours: ["H", true],
+  minutes: ["M", true],
+  seconds: ["S", true],
+};
+
+// durationFactory composes the ISO-8601 value of the hidden input from the
+// part inputs. Clearing every part clears the value.
+function durationFactory({ element }: ComponentContext): Teardown {
+  const hidden = element.querySelector<HTMLInputElement>("[data-formgen-duration-value]");
+  const parts = Array.from(element.querySelectorAll<HTMLInputElement>("[data-formgen-duration-part]"));
+  if (!hidden || parts.length === 0) {
+    return;
+  }
+
+  const sync = () => {
+    let date = "";
+    let time = "";
+    let touched = false;
+    for (const part of Object.keys(durationDesignators)) {
+      const input = parts.find((candidate) => candidate.dataset.formgenDurationPart === part);
+      const raw = input?.value.trim() ?? "";
+      if (raw === "") {
+        continue;
+      }
+      touched = true;
+      const amount = Math.max(0, Math.trunc(Number(raw)));
+      if (!Number.isFinite(amount) || amount === 0) {
+        continue;
+      }
+      const [designator, isTime] = durationDesignators[part];
+      if (isTime) {
+        time += `${amount}${designator}`;
+      } else {
+        date += `${amount}${designator}`;
+      }
+    }
+    if (!touched) {
+      hidden.value = "";
+    } else if (!date && !time) {
+      hidden.value = "PT0S";
+    } else {
+      hidden.value = `P${date}${time ? `T${time}` : ""}`;
+    }
+    hidden.dispatchEvent(new Event("change", { bubbles: true }));
+  };
+
+  for (const part of parts) {
+    part.addEventListener("input", sync);
+  }
+
+  return () => {
+    for (const part of parts) {
+      part.removeEventListener("input", sync);
+    }
+  };
+}
@@ -60,6 +60,11 @@ export interface EndpointConfig {
   url?: string;
   method?: string;
   labelField?: string;
+  /**
+   * Composes option labels from several item fields, e.g.
+   * "{{item.first}} {{item.last}}". Takes precedence over labelField.
+   */
+  labelTemplate?: string;
   valueField?: string;
   resultsPath?: string;
   params?: Record<string, string>;
@@ -111,6 +116,28 @@ export interface FieldConfig {
   current?: RelationshipCurrent;
   refreshOn?: string[];
   refreshMode?: "auto" | "manual";
+  /**
+   * Periodically re-fetches the option list so long-lived pages pick up new
+   * options. New options are merged in and current selections are kept even
+   * when the server no longer returns them.
+   *
+   * Set via `data-endpoint-refresh-interval-ms="30000"`.
+   */
+  refreshIntervalMs?: number;
+  /**
+   * How long fetched options are reused, overriding the global `cache.ttlMs`.
+   *
+   * Set via `data-endpoint-cache-ttl="60000"` (milliseconds).
+   */
+  cacheTtlMs?: number;
+  /**
+   * Where fetched options are cached: `memory` uses the registry cache for
+   * the page, `session` persists them in `sessionStorage` across reloads and
+   * `none` fetches on every resolve.
+   *
+   * Set via `data-endpoint-cache-strategy="session"`.
+   */
+  cacheStrategy?: "memory" | "session" | "none";
   mode?: "default" | "search";
   /**
    * When true, search-based relationship widgets may offer an **inline create**
@@ -302,6 +329,18 @@ export interface ResolvedCacheConfig extends CacheConfig {
 /**
  * Global configuration shared across resolver instances.
  */
+/**
+ * FieldLinkConfig links a field in one generated form to a field in another
+ * form on the same page. References use `<formId>.<field>`, where formId
+ * matches the form's `data-formgen-form-id` attribute.
+ */
+export interface FieldLinkConfig {
+  from: string;
+  to: string;
+  /** "prefill" (default) copies until the user edits; "constrain" locks the target. */
+  mode?: "prefill" | "constrain";
+}
+
 export interface GlobalConfig {
   baseUrl?: string;
   buildHeaders?: (
@@ -376,6 +415,10 @@ export interface GlobalConfig {
   ) => Option | void | Promise<Option | void>;
   cache?: CacheConfig;
   logger?: Logger;
+  /** Default auth for relationship endpoints that do not declare their own. */
+  auth?: EndpointAuth;
+  /** Cross-form field links applied once runtime roots are initialized. */
+  links?: FieldLinkConfig[];
   searchThrottleMs?: number;
   searchDebounceMs?: number;
   retryAttempts?: number;
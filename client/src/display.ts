@@ -0,0 +1,189 @@
+/**
+ * Display formatters mirror pkg/display so read-only values updated in the
+ * browser format exactly as the server rendered them. The vanilla renderer
+ * emits `<p data-formgen-display="bytes" data-display-for="fg-size">` next to
+ * read-only controls whose field declares a `displayFormat` hint.
+ */
+
+export type DisplayFormatter = (value: string, arg: string, now: Date) => string | null;
+
+export const DISPLAY_ATTR = "data-formgen-display";
+export const DISPLAY_FOR_ATTR = "data-display-for";
+const BOUND_ATTR = "data-display-bound";
+
+export const DEFAULT_TRUNCATE_LENGTH = 80;
+
+const MONTHS = ["Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"];
+const BYTE_UNITS = ["B", "KB", "MB", "GB", "TB", "PB"];
+const RELATIVE_UNITS: Array<{ name: string; seconds: number; below: number }> = [
+  { name: "minute", seconds: 60, below: 45 * 60 },
+  { name: "hour", seconds: 3600, below: 22 * 3600 },
+  { name: "day", seconds: 86400, below: 26 * 86400 },
+  { name: "month", seconds: 30 * 86400, below: 320 * 86400 },
+  { name: "year", seconds: 365 * 86400, below: Infinity },
+];
+
+const formatters = new Map<string, DisplayFormatter>();
+
+/**
+ * parseDisplayTime reads RFC 3339 and ISO-8601 date strings. Strings without
+ * an offset are read as UTC, matching display.ParseTime.
+ */
+export function parseDisplayTime(value: string): Date | null {
+  let text = value.trim();
+  if (text === "") {
+    return null;
+  }
+  if (/^\d{4}-\d{2}-\d{2}$/.test(text)) {
+    text += "T00:00:00Z";
+  } else if (/^\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}$/.test(text)) {
+    text = text.replace(" ", "T") + "Z";
+  } else if (!/^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$/i.test(text)) {
+    return null;
+  }
+  const parsed = new Date(text);
+  return Number.isNaN(parsed.getTime()) ? null : parsed;
+}
+
+function pad(value: number): string {
+  return value < 10 ? `0${value}` : String(value);
+}
+
+function formatRelativeDate(value: string, _arg: string, now: Date): string | null {
+  const time = parseDisplayTime(value);
+  if (!time) {
+    return null;
+  }
+  const delta = (now.getTime() - time.getTime()) / 1000;
+  const seconds = Math.abs(delta);
+  if (seconds < 45) {
+    return "just now";
+  }
+  for (const unit of RELATIVE_UNITS) {
+    if (seconds >= unit.below) {
+      continue;
+    }
+    const count = Math.max(1, Math.round(seconds / unit.seconds));
+    const label = count === 1 ? unit.name : `${unit.name}s`;
+    return delta < 0 ? `in ${count} ${label}` : `${count} ${label} ago`;
+  }
+  return null;
+}
+
+function formatDate(value: string): string | null {
+  const time = parseDisplayTime(value);
+  if (!time) {
+    return null;
+  }
+  return `${MONTHS[time.getUTCMonth()]} ${time.getUTCDate()}, ${time.getUTCFullYear()}`;
+}
+
+function formatDateTime(value: string): string | null {
+  const date = formatDate(value);
+  const time = parseDisplayTime(value);
+  if (!date || !time) {
+    return null;
+  }
+  return `${date} ${pad(time.getUTCHours())}:${pad(time.getUTCMinutes())} UTC`;
+}
+
+function formatBytes(value: string): string | null {
+  if (value.trim() === "") {
+    return null;
+  }
+  let size = Number(value);
+  if (!Number.isFinite(size) || size < 0) {
+    return null;
+  }
+  let unit = 0;
+  while (size >= 1024 && unit < BYTE_UNITS.length - 1) {
+    size /= 1024;
+    unit++;
+  }
+  return `${Math.round(size * 10) / 10} ${BYTE_UNITS[unit]}`;
+}
+
+function formatTruncate(value: string, arg: string): string {
+  const parsed = Number.parseInt(arg, 10);
+  const limit = Number.isFinite(parsed) && parsed > 0 ? parsed : DEFAULT_TRUNCATE_LENGTH;
+  const chars = Array.from(value);
+  if (chars.length <= limit) {
+    return value;
+  }
+  return `${chars.slice(0, limit).join("").replace(/ +$/, "")}…`;
+}
+
+/**
+ * registerDisplayFormatter adds or replaces a formatter. Register the same
+ * name on the server (display.Registry.Register) to keep output consistent.
+ */
+export function registerDisplayFormatter(name: string, formatter: DisplayFormatter): void {
+  const trimmed = name.trim();
+  if (trimmed !== "") {
+    formatters.set(trimmed, formatter);
+  }
+}
+
+/**
+ * formatDisplay applies the formatter named by spec ("name" or "name:arg").
+ * It returns null for unknown formats and values the formatter rejects, so
+ * callers fall back to the raw value.
+ */
+export function formatDisplay(spec: string, value: string, now: Date = new Date()): string | null {
+  const [rawName, ...rest] = spec.trim().split(":");
+  const formatter = formatters.get(rawName.trim());
+  if (!formatter) {
+    return null;
+  }
+  return formatter(value, rest.join(":").trim(), now);
+}
+
+function updateDisplay(display: HTMLElement, control: HTMLInputElement | HTMLTextAreaElement | HTMLSelectElement): void {
+  const spec = display.getAttribute(DISPLAY_ATTR) ?? "";
+  const value = control.value;
+  display.textContent = value === "" ? "" : formatDisplay(spec, value) ?? value;
+}
+
+/**
+ * initDisplayFormats binds every display element under root to its control so
+ * the formatted text follows `input` and `change` events. Returns a cleanup
+ * that removes the listeners.
+ */
+export function initDisplayFormats(root: Document | HTMLElement = document): () => void {
+  const cleanups: Array<() => void> = [];
+  const displays = Array.from(root.querySelectorAll<HTMLElement>(`[${DISPLAY_ATTR}]`));
+  for (const display of displays) {
+    if (display.hasAttribute(BOUND_ATTR)) {
+      continue;
+    }
+    const id = display.getAttribute(DISPLAY_FOR_ATTR);
+    const control = id ? display.ownerDocument.getElementById(id) : null;
+    if (
+      !(control instanceof HTMLInputElement) &&
+      !(control instanceof HTMLTextAreaElement) &&
+      !(control instanceof HTMLSelectElement)
+    ) {
+      continue;
+    }
+    const listener = () => updateDisplay(display, control);
+    control.addEventListener("input", listener);
+    control.addEventListener("change", listener);
+    display.setAttribute(BOUND_ATTR, "true");
+    cleanups.push(() => {
+      control.removeEventListener("input", listener);
+      control.removeEventListener("change", listener);
+      display.removeAttribute(BOUND_ATTR);
+    });
+  }
+  return () => {
+    for (const cleanup of cleanups) {
+      cleanup();
+    }
+  };
+}
+
+registerDisplayFormatter("relativeDate", formatRelativeDate);
+registerDisplayFormatter("date", formatDate);
+registerDisplayFormatter("dateTime", formatDateTime);
+registerDisplayFormatter("bytes", formatBytes);
+registerDisplayFormatter("truncate", formatTruncate);
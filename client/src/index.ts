@@ -20,7 +20,12 @@ import { destroyComponents, initComponents } from "./components/registry";
 import { destroyArrayRepeaters, initArrayRepeaters } from "./array-repeaters";
 import { destroyRendererWidgets } from "./renderers/relationship-cleanup";
 import { clearFieldError, renderFieldError } from "./errors";
+import { extractServerErrors, findErrorMappings, type ServerErrors } from "./server-errors";
 import { emitRelationshipUpdate } from "./relationship-events";
+import { initFieldLinks } from "./links";
+import { initDisplayFormats } from "./display";
+import { initSectionNav } from "./section-nav";
+import { mergeRuntimeConfig, readRuntimeConfig } from "./runtime-config";
 import {
   normalizeRelationshipCurrentOptions,
   relationshipCurrentValuesNeedingResolution,
@@ -32,6 +37,7 @@ import {
  * runtime logic is developed across subsequent tasks.
  */
 let activeRegistry: ResolverRegistry | null = null;
+let teardownFieldLinks: (() => void) | null = null;
 
 interface RuntimeRootLifecycle {
   cleanups: Set<() => void>;
@@ -44,9 +50,14 @@ const runtimeRootLifecycles = new WeakMap<
 >();
 
 export async function initRelationships(
-  config: GlobalConfig = {}
+  options: GlobalConfig = {}
 ): Promise<ResolverRegistry> {
-  const hasOverrides = Object.keys(config ?? {}).length > 0;
+  // A registry created earlier already carries the renderer-emitted
+  // #formgen-config block; otherwise the block applies first and explicit
+  // options win key by key.
+  const reuse = activeRegistry !== null && Object.keys(options ?? {}).length === 0;
+  const config = reuse ? {} : mergeRuntimeConfig(readRuntimeConfig(), options ?? {});
+  const hasOverrides = Object.keys(config).length > 0;
   const reuseExisting = activeRegistry && !hasOverrides ? activeRegistry : null;
   const globalConfig = hasOverrides ? setGlobalConfig(config) : undefined;
   const registry = reuseExisting ?? new ResolverRegistry(globalConfig);
@@ -73,6 +84,13 @@ export async function initRelationships(
     await Promise.all(promises);
   }
 
+  if (config.links && config.links.length > 0) {
+    teardownFieldLinks?.();
+    teardownFieldLinks = initFieldLinks(config.links, (root, field, value) => {
+      hydrateFormValuesWithRegistry(root, { values: { [field]: value } }, registry);
+    });
+  }
+
   return registry;
 }
 
@@ -81,9 +99,11 @@ export async function initRelationships(
 // Formgen controller so controller teardown also aborts root-scoped resolvers.
 export async function initFormgenRoot(
   root: HTMLElement,
-  config: GlobalConfig = {}
+  options: GlobalConfig = {}
 ): Promise<ResolverRegistry> {
-  const hasOverrides = Object.keys(config ?? {}).length > 0;
+  const reuse = activeRegistry !== null && Object.keys(options ?? {}).length === 0;
+  const config = reuse ? {} : mergeRuntimeConfig(readRuntimeConfig(root.ownerDocument), options ?? {});
+  const hasOverrides = Object.keys(config).length > 0;
   const registry = activeRegistry && !hasOverrides
     ? activeRegistry
     : new ResolverRegistry(hasOverrides ? config : undefined);
@@ -102,6 +122,8 @@ export async function initFormgenRoot(
  */
 export function resetGlobalRegistry(): void {
   activeRegistry = null;
+  teardownFieldLinks?.();
+  teardownFieldLinks = null;
   delete (globalThis as Record<string, unknown>).formgenRelationships;
 }
 
@@ -118,7 +140,22 @@ export type {
   ValidationResult,
   CreateActionDetail,
   EditActionDetail,
+  FieldLinkConfig,
 } from "./config";
+export { initFieldLinks } from "./links";
+export {
+  RUNTIME_CONFIG_ELEMENT_ID,
+  mergeRuntimeConfig,
+  readRuntimeConfig,
+} from "./runtime-config";
+export type { RuntimeHookName } from "./runtime-config";
+export {
+  formatDisplay,
+  initDisplayFormats,
+  registerDisplayFormatter,
+  type DisplayFormatter,
+} from "./display";
+export { initSectionNav } from "./section-nav";
 export { ResolverRegistry } from "./registry";
 export {
   Resolver,
@@ -137,6 +174,12 @@ export {
 } from "./components/registry";
 export { initArrayRepeaters, destroyArrayRepeaters, addArrayItem } from "./array-repeaters";
 export { registerErrorRenderer } from "./errors";
+export {
+  extractServerErrors,
+  parseErrorMappings,
+  type ErrorMapping,
+  type ServerErrors,
+} from "./server-errors";
 export {
   registerThemeClasses,
   getThemeClasses,
@@ -202,6 +245,8 @@ function initializeRuntimeRoot(
       }
     },
   });
+  initDisplayFormats(root);
+  initSectionNav(root);
 
   const promises: Promise<void>[] = [];
   const fields = locateRelationshipFields(root);
@@ -232,6 +277,7 @@ function initializeRuntimeRoot(
 
     setupDependentRefresh(element, field, root, registry, lifecycle);
     setupManualRefresh(element, field, root, registry, lifecycle);
+    setupIntervalRefresh(element, field, registry, lifecycle);
     setupSearchMode(element, field, registry, lifecycle);
 
     if (shouldAutoResolve(field, endpoint)) {
@@ -372,6 +418,13 @@ export interface FormController {
   setValues(values: Record<string, unknown>): void;
   reset(): void;
   setErrors(errors: Record<string, string | string[]>): void;
+  /**
+   * Routes a failed submission response onto fields using the form's
+   * `data-formgen-error-mapping`. Returns the extracted errors (form-level
+   * messages are left for the host to display) or null when no mapping
+   * matched the status.
+   */
+  applyServerErrors(status: number, body: unknown): ServerErrors | null;
   clearErrors(names?: string[]): void;
   onChange(callback: (values: Record<string, unknown>, event: Event) => void): () => void;
   focus(name: string): boolean;
@@ -419,6 +472,16 @@ export function attachFormController(
         hydrateFormValuesWithRegistry(resolvedRoot, { errors }, controllerRegistry);
       }
     },
+    applyServerErrors(status, body) {
+      if (destroyed) {
+        return null;
+      }
+      const extracted = extractServerErrors(findErrorMappings(resolvedRoot), status, body);
+      if (extracted && Object.keys(extracted.fields).length > 0) {
+        controller.setErrors(extracted.fields);
+      }
+      return extracted;
+    },
     clearErrors(names) {
       if (destroyed) {
         return;
@@ -1192,6 +1255,39 @@ function setupDependentRefresh(
   });
 }
 
+function setupIntervalRefresh(
+  element: HTMLElement,
+  field: FieldConfig,
+  registry: ResolverRegistry,
+  lifecycle: RuntimeRootLifecycle
+): void {
+  const interval = field.refreshIntervalMs ?? 0;
+  if (!(interval > 0) || field.mode === "search") {
+    return;
+  }
+  if (element.dataset.relationshipIntervalBound === "true") {
+    return;
+  }
+  element.dataset.relationshipIntervalBound = "true";
+
+  const timer = setInterval(() => {
+    if (!element.isConnected) {
+      clearInterval(timer);
+      return;
+    }
+    const doc = element.ownerDocument ?? document;
+    if (doc.visibilityState === "hidden" || element.getAttribute("data-state") === "loading") {
+      return;
+    }
+    registry.refresh(element).catch(() => undefined);
+  }, interval);
+
+  lifecycle.cleanups.add(() => {
+    clearInterval(timer);
+    delete element.dataset.relationshipIntervalBound;
+  });
+}
+
 function setupManualRefresh(
   element: HTMLElement,
   field: FieldConfig,
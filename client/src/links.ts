@@ -0,0 +1,206 @@
+import type { FieldLinkConfig } from "./config";
+
+export const FORM_ID_ATTR = "data-formgen-form-id";
+export const LINK_LOCKED_ATTR = "data-formgen-link-locked";
+export const LINK_DIRTY_ATTR = "data-formgen-link-dirty";
+
+export type LinkedValue = string | string[] | null;
+
+/**
+ * ApplyLinkedValue writes a linked value into the target form root. The
+ * runtime passes a hydrate-based implementation so relationship widgets and
+ * mirrors stay in sync.
+ */
+export type ApplyLinkedValue = (root: HTMLElement, field: string, value: LinkedValue) => void;
+
+interface FieldRef {
+  form: string;
+  field: string;
+}
+
+interface ResolvedLink {
+  from: FieldRef;
+  to: FieldRef;
+  mode: "prefill" | "constrain";
+}
+
+type FieldElement = HTMLInputElement | HTMLSelectElement | HTMLTextAreaElement;
+
+/**
+ * initFieldLinks wires cross-form links declared in the runtime config. A
+ * change to the source field (`<formId>.<field>`) is copied into the target
+ * field of another form identified by `data-formgen-form-id`. Prefill links
+ * stop once the user edits the target; constrain links lock the target while
+ * the source holds a value. Returns a cleanup that removes the listeners.
+ */
+export function initFieldLinks(
+  links: FieldLinkConfig[] | undefined,
+  apply: ApplyLinkedValue,
+  doc: Document = document
+): () => void {
+  const resolved = (links ?? [])
+    .map(resolveLink)
+    .filter((link): link is ResolvedLink => link !== null);
+  if (resolved.length === 0) {
+    return () => {};
+  }
+
+  let applying = false;
+
+  const propagate = (link: ResolvedLink, source: FieldElement) => {
+    const targetRoot = findFormRoot(doc, link.to.form);
+    if (!targetRoot) {
+      return;
+    }
+    const targets = findFields(targetRoot, link.to.field);
+    if (targets.length === 0) {
+      return;
+    }
+    if (link.mode === "prefill" && targets.some((el) => el.hasAttribute(LINK_DIRTY_ATTR))) {
+      return;
+    }
+    const value = readValue(source);
+    applying = true;
+    try {
+      apply(targetRoot, link.to.field, value);
+    } finally {
+      applying = false;
+    }
+    if (link.mode === "constrain") {
+      targets.forEach((el) => setLocked(el, !isEmpty(value)));
+    }
+  };
+
+  const handler = (event: Event) => {
+    if (applying) {
+      return;
+    }
+    const element = event.target;
+    if (!isFieldElement(element)) {
+      return;
+    }
+    for (const link of resolved) {
+      if (matchesRef(element, link.from)) {
+        propagate(link, element);
+        continue;
+      }
+      if (!matchesRef(element, link.to)) {
+        continue;
+      }
+      if (link.mode === "constrain" && element.hasAttribute(LINK_LOCKED_ATTR)) {
+        // Locked targets revert user edits back to the source value.
+        const source = findSource(doc, link.from);
+        if (source) {
+          propagate(link, source);
+        }
+        continue;
+      }
+      element.setAttribute(LINK_DIRTY_ATTR, "true");
+    }
+  };
+
+  doc.addEventListener("change", handler, true);
+  doc.addEventListener("input", handler, true);
+
+  // Sources that already hold a value (server-rendered or hydrated) seed
+  // their targets immediately.
+  for (const link of resolved) {
+    const source = findSource(doc, link.from);
+    if (source && !isEmpty(readValue(source))) {
+      propagate(link, source);
+    }
+  }
+
+  return () => {
+    doc.removeEventListener("change", handler, true);
+    doc.removeEventListener("input", handler, true);
+  };
+}
+
+function resolveLink(link: FieldLinkConfig): ResolvedLink | null {
+  const from = parseRef(link?.from);
+  const to = parseRef(link?.to);
+  if (!from || !to) {
+    return null;
+  }
+  return { from, to, mode: link.mode === "constrain" ? "constrain" : "prefill" };
+}
+
+function parseRef(ref: string | undefined): FieldRef | null {
+  const trimmed = (ref ?? "").trim();
+  const dot = trimmed.indexOf(".");
+  if (dot <= 0 || dot === trimmed.length - 1) {
+    return null;
+  }
+  return { form: trimmed.slice(0, dot), field: trimmed.slice(dot + 1) };
+}
+
+function findFormRoot(doc: Document, formId: string): HTMLElement | null {
+  const roots = Array.from(doc.querySelectorAll<HTMLElement>(`[${FORM_ID_ATTR}]`));
+  return roots.find((root) => root.getAttribute(FORM_ID_ATTR) === formId) ?? null;
+}
+
+function findSource(doc: Document, ref: FieldRef): FieldElement | null {
+  const root = findFormRoot(doc, ref.form);
+  return root ? findFields(root, ref.field)[0] ?? null : null;
+}
+
+function findFields(root: HTMLElement, field: string): FieldElement[] {
+  return Array.from(root.querySelectorAll<FieldElement>("input, select, textarea")).filter(
+    (el) => !el.closest("[data-relationship-hidden]") && fieldMatches(el, field)
+  );
+}
+
+function matchesRef(element: FieldElement, ref: FieldRef): boolean {
+  const root = element.closest<HTMLElement>(`[${FORM_ID_ATTR}]`);
+  if (!root || root.getAttribute(FORM_ID_ATTR) !== ref.form) {
+    return false;
+  }
+  return !element.closest("[data-relationship-hidden]") && fieldMatches(element, ref.field);
+}
+
+function fieldMatches(element: FieldElement, field: string): boolean {
+  const name = element.name || element.getAttribute("data-field-name") || "";
+  return name === field || name === `${field}[]`;
+}
+
+function isFieldElement(target: EventTarget | null): target is FieldElement {
+  return (
+    target instanceof HTMLInputElement ||
+    target instanceof HTMLSelectElement ||
+    target instanceof HTMLTextAreaElement
+  );
+}
+
+function readValue(element: FieldElement): LinkedValue {
+  if (element instanceof HTMLSelectElement) {
+    const values = Array.from(element.selectedOptions)
+      .map((option) => option.value)
+      .filter((value) => value !== "");
+    if (element.multiple) {
+      return values;
+    }
+    return values[0] ?? null;
+  }
+  if (element instanceof HTMLInputElement && element.type.toLowerCase() === "checkbox") {
+    return element.checked ? element.value || "true" : null;
+  }
+  return element.value === "" ? null : element.value;
+}
+
+function isEmpty(value: LinkedValue): boolean {
+  return value == null || (Array.isArray(value) && value.length === 0);
+}
+
+function setLocked(element: FieldElement, locked: boolean): void {
+  if (locked) {
+    element.setAttribute(LINK_LOCKED_ATTR, "true");
+    element.setAttribute("aria-readonly", "true");
+  } else {
+    element.removeAttribute(LINK_LOCKED_ATTR);
+    element.removeAttribute("aria-readonly");
+  }
+  if (element instanceof HTMLInputElement || element instanceof HTMLTextAreaElement) {
+    element.readOnly = locked;
+  }
+}
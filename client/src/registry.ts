@@ -44,6 +44,75 @@ class MemoryCacheAdapter implements CacheAdapter {
   }
 }
 
+const SESSION_CACHE_PREFIX = "formgen:options:";
+
+/**
+ * SessionStorageCacheAdapter keeps options for fields declaring the `session`
+ * cache strategy. Storage failures (quota, disabled storage) degrade to a
+ * cache miss.
+ */
+class SessionStorageCacheAdapter implements CacheAdapter {
+  get(key: string): Option[] | undefined {
+    const storage = sessionStore();
+    if (!storage) {
+      return undefined;
+    }
+    try {
+      const raw = storage.getItem(SESSION_CACHE_PREFIX + key);
+      if (!raw) {
+        return undefined;
+      }
+      const entry = JSON.parse(raw) as { value?: Option[]; expiresAt?: number };
+      if (typeof entry.expiresAt === "number" && entry.expiresAt <= Date.now()) {
+        storage.removeItem(SESSION_CACHE_PREFIX + key);
+        return undefined;
+      }
+      return Array.isArray(entry.value) ? entry.value : undefined;
+    } catch {
+      return undefined;
+    }
+  }
+
+  set(key: string, value: Option[], context: { ttlMs?: number }): void {
+    const storage = sessionStore();
+    if (!storage) {
+      return;
+    }
+    const expiresAt =
+      typeof context.ttlMs === "number" && context.ttlMs > 0 ? Date.now() + context.ttlMs : undefined;
+    try {
+      storage.setItem(SESSION_CACHE_PREFIX + key, JSON.stringify({ value, expiresAt }));
+    } catch {
+      // Ignore quota and serialization failures; the next resolve refetches.
+    }
+  }
+
+  delete(key: string): void {
+    sessionStore()?.removeItem(SESSION_CACHE_PREFIX + key);
+  }
+
+  clear(): void {
+    const storage = sessionStore();
+    if (!storage) {
+      return;
+    }
+    for (let index = storage.length - 1; index >= 0; index -= 1) {
+      const key = storage.key(index);
+      if (key && key.startsWith(SESSION_CACHE_PREFIX)) {
+        storage.removeItem(key);
+      }
+    }
+  }
+}
+
+function sessionStore(): Storage | undefined {
+  try {
+    return typeof window !== "undefined" ? window.sessionStorage : undefined;
+  } catch {
+    return undefined;
+  }
+}
+
 function defaultRenderer(context: RendererContext): void {
   const { element, options, field } = context;
 
@@ -112,6 +181,7 @@ export class ResolverRegistry {
   private readonly customResolvers: CustomResolver[] = [];
   private readonly config: ResolvedGlobalConfig;
   private readonly cacheAdapter?: CacheAdapter;
+  private readonly sessionCacheAdapter = new SessionStorageCacheAdapter();
   private readonly cacheConfig: CacheConfig;
 
   constructor(config?: GlobalConfig) {
@@ -160,6 +230,7 @@ export class ResolverRegistry {
       endpoint,
       config: this.config,
       cacheAdapter: this.cacheAdapter,
+      sessionCacheAdapter: this.sessionCacheAdapter,
       cacheConfig: this.cacheConfig,
       dispatchEvent: this.dispatchEvent,
       renderers: this.renderers,
@@ -221,6 +292,14 @@ export class ResolverRegistry {
     await resolver.resolve();
   }
 
+  async refresh(element: HTMLElement): Promise<void> {
+    const resolver = this.resolvers.get(element);
+    if (!resolver) {
+      return;
+    }
+    await resolver.refresh();
+  }
+
   async validate(element: HTMLElement): Promise<ValidationResult | undefined> {
     const resolver = this.resolvers.get(element);
     if (!resolver) {
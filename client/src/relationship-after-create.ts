@@ -0,0 +1,100 @@
+import type { Option } from "./config";
+import type { ResolverRegistry } from "./registry";
+
+/**
+ * AfterCreateStep names one post-create action declared through
+ * `relationship.endpoint.afterCreate` (`data-endpoint-after-create`):
+ *
+ * - `select`: insert the created option and select it (the default).
+ * - `refetch`: re-fetch the field's options, bypassing the cache, so server
+ *   ordering and labels replace the locally inserted option.
+ * - `broadcast`: insert the option into every other relationship field that
+ *   points at the same target resource and refresh those fields.
+ */
+export type AfterCreateStep = "select" | "refetch" | "broadcast";
+
+const AFTER_CREATE_STEPS: ReadonlySet<string> = new Set(["select", "refetch", "broadcast"]);
+
+/**
+ * afterCreateSteps reads the declared steps for a relationship select. Fields
+ * without a declaration keep the historical insert-and-select behaviour.
+ */
+export function afterCreateSteps(select: HTMLSelectElement): Set<AfterCreateStep> {
+  const raw = select.dataset.endpointAfterCreate;
+  if (raw == null || raw.trim() === "") {
+    return new Set<AfterCreateStep>(["select"]);
+  }
+  const steps = new Set<AfterCreateStep>();
+  raw
+    .split(",")
+    .map((step) => step.trim().toLowerCase())
+    .forEach((step) => {
+      if (AFTER_CREATE_STEPS.has(step)) {
+        steps.add(step as AfterCreateStep);
+      }
+    });
+  return steps;
+}
+
+/**
+ * runAfterCreate applies the refetch and broadcast steps once a create action
+ * returned options. Selection is handled by the calling renderer because it
+ * owns the widget state.
+ */
+export async function runAfterCreate(
+  select: HTMLSelectElement,
+  options: Option[],
+  registry: ResolverRegistry,
+  steps: Set<AfterCreateStep> = afterCreateSteps(select)
+): Promise<void> {
+  const pending: Promise<void>[] = [];
+  if (steps.has("refetch")) {
+    pending.push(registry.refresh(select));
+  }
+  if (steps.has("broadcast")) {
+    for (const sibling of relationshipSiblings(select)) {
+      insertNativeOptions(sibling, options);
+      pending.push(registry.refresh(sibling));
+    }
+  }
+  if (pending.length > 0) {
+    await Promise.allSettled(pending);
+  }
+}
+
+/**
+ * relationshipSiblings returns the other relationship selects in the document
+ * that share the field's target resource (`data-relationship-target`, falling
+ * back to the endpoint URL).
+ */
+export function relationshipSiblings(select: HTMLSelectElement): HTMLSelectElement[] {
+  const key = resourceKey(select);
+  if (!key) {
+    return [];
+  }
+  const doc = select.ownerDocument ?? document;
+  return Array.from(doc.querySelectorAll<HTMLSelectElement>("select[data-endpoint-url]")).filter(
+    (candidate) => candidate !== select && resourceKey(candidate) === key
+  );
+}
+
+function resourceKey(select: HTMLSelectElement): string {
+  const target = select.getAttribute("data-relationship-target")?.trim();
+  if (target) {
+    return `target:${target}`;
+  }
+  const url = select.getAttribute("data-endpoint-url")?.trim();
+  return url ? `url:${url}` : "";
+}
+
+function insertNativeOptions(select: HTMLSelectElement, options: Option[]): void {
+  for (const option of options) {
+    if (Array.from(select.options).some((node) => node.value === option.value)) {
+      continue;
+    }
+    const node = select.ownerDocument.createElement("option");
+    node.value = option.value;
+    node.textContent = option.label ?? option.value;
+    select.appendChild(node);
+  }
+}
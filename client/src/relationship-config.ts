@@ -20,6 +20,9 @@ export function datasetToEndpoint(dataset: Record<string, string>): EndpointConf
   if (dataset.endpointLabelField) {
     endpoint.labelField = dataset.endpointLabelField;
   }
+  if (dataset.endpointLabelTemplate) {
+    endpoint.labelTemplate = dataset.endpointLabelTemplate;
+  }
   if (dataset.endpointValueField) {
     endpoint.valueField = dataset.endpointValueField;
   }
@@ -122,6 +125,19 @@ export function datasetToFieldConfig(
   if (dataset.endpointEditActionId) {
     field.editActionId = dataset.endpointEditActionId;
   }
+  if (dataset.endpointRefreshIntervalMs) {
+    field.refreshIntervalMs = toNumber(dataset.endpointRefreshIntervalMs);
+  }
+  if (dataset.endpointCacheTtl) {
+    field.cacheTtlMs = toNumber(dataset.endpointCacheTtl);
+  }
+  if (
+    dataset.endpointCacheStrategy === "memory" ||
+    dataset.endpointCacheStrategy === "session" ||
+    dataset.endpointCacheStrategy === "none"
+  ) {
+    field.cacheStrategy = dataset.endpointCacheStrategy;
+  }
   if (dataset.endpointThrottle) {
     field.throttleMs = toNumber(dataset.endpointThrottle);
   }
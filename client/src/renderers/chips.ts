@@ -16,6 +16,7 @@ import {
   buildHighlightedFragment,
 } from "./relationship-utils";
 import { registerRendererCleanup } from "./relationship-cleanup";
+import { afterCreateSteps, runAfterCreate } from "../relationship-after-create";
 import {
   addElementClasses,
   classesToString,
@@ -1499,6 +1500,7 @@ function applyCreatedOptions(
   options: Option[],
   selectBehavior: "append" | "replace"
 ): void {
+  const steps = afterCreateSteps(store.select);
   // Add options to the native select if not present
   for (const option of options) {
     const existing = Array.from(store.select.options).find(
@@ -1530,8 +1532,10 @@ function applyCreatedOptions(
     }
   }
 
-  // Update selection
-  updateSelected(store, newSelection);
+  if (steps.has("select")) {
+    updateSelected(store, newSelection);
+  }
+  void runAfterCreate(store.select, options, store.registry, steps);
 }
 
 /**
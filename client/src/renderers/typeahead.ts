@@ -24,6 +24,7 @@ import {
   getSelectedValues,
 } from "./relationship-utils";
 import { registerRendererCleanup } from "./relationship-cleanup";
+import { afterCreateSteps, runAfterCreate } from "../relationship-after-create";
 import {
   addElementClasses,
   classesToString,
@@ -1306,9 +1307,12 @@ function applyUpdatedOption(store: TypeaheadStore, option: Option): void {
 
 /**
  * Apply a created option from the create action hook.
- * For typeahead (single-select), this replaces the current selection.
+ * For typeahead (single-select), this replaces the current selection unless
+ * data-endpoint-after-create omits "select".
  */
 function applyCreatedOption(store: TypeaheadStore, option: Option): void {
+  const steps = afterCreateSteps(store.select);
+
   // Add option to the native select if not present
   const existing = Array.from(store.select.options).find(
     (node) => node.value === option.value
@@ -1325,8 +1329,10 @@ function applyCreatedOption(store: TypeaheadStore, option: Option): void {
     store.options = [...store.options, option];
   }
 
-  // Select the option
-  selectOption(store, option);
+  if (steps.has("select")) {
+    selectOption(store, option);
+  }
+  void runAfterCreate(store.select, [option], store.registry, steps);
 }
 
 function updateClearState(store: TypeaheadStore): void {
@@ -129,6 +129,8 @@ export interface ResolverOptions {
   endpoint: EndpointConfig;
   config: ResolvedGlobalConfig;
   cacheAdapter?: CacheAdapter;
+  /** Backs fields declaring `data-endpoint-cache-strategy="session"`. */
+  sessionCacheAdapter?: CacheAdapter;
   cacheConfig: CacheConfig;
   dispatchEvent: ResolverEventDispatcher;
   renderers: Map<string, Renderer>;
@@ -151,6 +153,7 @@ export class Resolver {
   private readonly endpoint: EndpointConfig;
   private readonly config: ResolvedGlobalConfig;
   private readonly cacheAdapter?: CacheAdapter;
+  private readonly sessionCacheAdapter?: CacheAdapter;
   private readonly cacheConfig: CacheConfig;
   private readonly dispatchEvent: ResolverEventDispatcher;
   private readonly renderers: Map<string, Renderer>;
@@ -162,6 +165,7 @@ export class Resolver {
   private lastContext: ResolverContext | null = null;
   private preserveServerValidation = false;
   private userInteracted = false;
+  private refreshing = false;
   private readonly interactionHandler = () => this.handleUserInteraction();
 
   constructor(options: ResolverOptions) {
@@ -170,6 +174,7 @@ export class Resolver {
     this.endpoint = options.endpoint;
     this.config = options.config;
     this.cacheAdapter = options.cacheAdapter;
+    this.sessionCacheAdapter = options.sessionCacheAdapter;
     this.cacheConfig = options.cacheConfig;
     this.dispatchEvent = options.dispatchEvent;
     this.renderers = options.renderers;
@@ -205,8 +210,10 @@ export class Resolver {
       fromCache: false,
     });
 
-    if (request.cacheKey && this.cacheAdapter) {
-      const cached = await this.cacheAdapter.get(request.cacheKey);
+    const cache = this.fieldCacheAdapter();
+
+    if (request.cacheKey && cache && !this.refreshing) {
+      const cached = await cache.get(request.cacheKey);
       if (Array.isArray(cached) && cached.length > 0) {
         options = cached;
         fromCache = true;
@@ -254,6 +261,10 @@ export class Resolver {
       options = await this.config.transformOptions(context, options);
     }
 
+    if (this.refreshing) {
+      options = mergeSelectedOptions(this.element, options);
+    }
+
     await this.renderOptions(options, fromCache);
 
     if (this.field.current != null) {
@@ -273,9 +284,9 @@ export class Resolver {
 
     this.dispatchEvent(this.element, "success", detail);
 
-    if (request.cacheKey && this.cacheAdapter && !fromCache) {
-      await this.cacheAdapter.set(request.cacheKey, options, {
-        ttlMs: this.cacheConfig.ttlMs,
+    if (request.cacheKey && cache && !fromCache) {
+      await cache.set(request.cacheKey, options, {
+        ttlMs: this.field.cacheTtlMs ?? this.cacheConfig.ttlMs,
         field: this.field,
         endpoint: this.endpoint,
       });
@@ -302,6 +313,20 @@ export class Resolver {
     return { options, fromCache };
   }
 
+  /**
+   * refresh re-fetches options for periodic delta updates. The cache is
+   * bypassed and selected options missing from the response are kept so the
+   * user's selection survives the re-render.
+   */
+  async refresh(): Promise<ResolverResult> {
+    this.refreshing = true;
+    try {
+      return await this.resolve();
+    } finally {
+      this.refreshing = false;
+    }
+  }
+
   async validate(): Promise<ValidationResult> {
     return this.runValidation("manual");
   }
@@ -601,7 +626,7 @@ export class Resolver {
       Accept: "application/json",
     };
 
-    Object.assign(headers, resolveAuthHeaders(this.endpoint.auth, this.element));
+    Object.assign(headers, resolveAuthHeaders(this.endpoint.auth ?? this.config.auth, this.element));
 
     this.abortController = new AbortController();
 
@@ -640,7 +665,7 @@ export class Resolver {
       "Content-Type": "application/json",
     };
 
-    Object.assign(headers, resolveAuthHeaders(this.endpoint.auth, this.element));
+    Object.assign(headers, resolveAuthHeaders(this.endpoint.auth ?? this.config.auth, this.element));
 
     const controller = new AbortController();
 
@@ -819,6 +844,17 @@ export class Resolver {
     return `${base}${relative}` || url;
   }
 
+  private fieldCacheAdapter(): CacheAdapter | undefined {
+    switch (this.field.cacheStrategy) {
+      case "none":
+        return undefined;
+      case "session":
+        return this.sessionCacheAdapter ?? this.cacheAdapter;
+      default:
+        return this.cacheAdapter;
+    }
+  }
+
   private computeCacheKey(method: string, url: string): string | undefined {
     if (typeof this.field.cacheKey === "string" && this.field.cacheKey.length > 0) {
       return this.field.cacheKey;
@@ -962,6 +998,13 @@ export class Resolver {
       }
     }
 
+    if (this.endpoint.labelTemplate) {
+      const rendered = renderLabelTemplate(this.endpoint.labelTemplate, item).trim();
+      if (rendered) {
+        label = rendered;
+      }
+    }
+
     const stringValue = value == null ? "" : String(value);
     const stringLabel = label == null ? stringValue : String(label);
 
@@ -1043,9 +1086,38 @@ function getByPath(source: any, path: string | undefined): unknown {
   return current;
 }
 
+const LABEL_TEMPLATE_PATTERN = /\{\{\s*item\.([^{}\s]+)\s*\}\}/g;
+
+/**
+ * Expands {{item.path}} placeholders against a response item. Missing paths
+ * expand to an empty string.
+ */
+function renderLabelTemplate(template: string, item: unknown): string {
+  return template.replace(LABEL_TEMPLATE_PATTERN, (_match, path: string) => {
+    const value = getByPath(item, path);
+    return value == null ? "" : String(value);
+  });
+}
+
 function shouldRetryStatus(status?: number): boolean {
   if (typeof status !== "number") {
     return false;
   }
   return status >= 500;
 }
+
+function mergeSelectedOptions(element: HTMLElement, options: Option[]): Option[] {
+  if (!(element instanceof HTMLSelectElement)) {
+    return options;
+  }
+  const known = new Set(options.map((option) => option.value));
+  const retained: Option[] = [];
+  for (const selected of Array.from(element.selectedOptions)) {
+    if (!selected.value || known.has(selected.value)) {
+      continue;
+    }
+    known.add(selected.value);
+    retained.push({ value: selected.value, label: selected.textContent ?? selected.value });
+  }
+  return retained.length > 0 ? [...retained, ...options] : options;
+}
@@ -0,0 +1,154 @@
+import type {
+  CacheConfig,
+  EndpointAuth,
+  FieldLinkConfig,
+  GlobalConfig,
+  ResolverContext,
+  ValidationError,
+} from "./config";
+import type { ResolverError } from "./errors";
+
+/**
+ * RUNTIME_CONFIG_ELEMENT_ID is the id of the JSON block the Go renderers emit
+ * from `render.RenderOptions.Runtime`:
+ * `<script type="application/json" id="formgen-config">`.
+ */
+export const RUNTIME_CONFIG_ELEMENT_ID = "formgen-config" as const;
+
+/** Built-in hooks the config block can enable by name. */
+export type RuntimeHookName = "validationError" | "error";
+
+/**
+ * readRuntimeConfig parses the renderer-emitted config block into a
+ * GlobalConfig. Missing or malformed blocks yield an empty object so pages
+ * without the block keep the runtime defaults.
+ */
+export function readRuntimeConfig(doc: Document | null = defaultDocument()): GlobalConfig {
+  const element = doc?.getElementById(RUNTIME_CONFIG_ELEMENT_ID);
+  const text = element?.textContent?.trim();
+  if (!text) {
+    return {};
+  }
+  let raw: unknown;
+  try {
+    raw = JSON.parse(text);
+  } catch (error) {
+    console.warn("[formgen] ignoring malformed #formgen-config block", error);
+    return {};
+  }
+  if (!raw || typeof raw !== "object" || Array.isArray(raw)) {
+    return {};
+  }
+  return normalizeRuntimeConfig(raw as Record<string, unknown>);
+}
+
+/**
+ * mergeRuntimeConfig layers explicit initRelationships options over the
+ * config block key by key; cache settings merge one level deeper.
+ */
+export function mergeRuntimeConfig(base: GlobalConfig, overrides: GlobalConfig = {}): GlobalConfig {
+  const merged: GlobalConfig = { ...base, ...overrides };
+  if (base.cache && overrides.cache) {
+    merged.cache = { ...base.cache, ...overrides.cache };
+  }
+  return merged;
+}
+
+function normalizeRuntimeConfig(raw: Record<string, unknown>): GlobalConfig {
+  const config: GlobalConfig = {};
+  if (typeof raw.baseUrl === "string" && raw.baseUrl.trim() !== "") {
+    config.baseUrl = raw.baseUrl.trim();
+  }
+  const cache = readCache(raw.cache);
+  if (cache) {
+    config.cache = cache;
+  }
+  const auth = readAuth(raw.auth);
+  if (auth) {
+    config.auth = auth;
+  }
+  if (isNonNegativeNumber(raw.searchDebounceMs)) {
+    config.searchDebounceMs = raw.searchDebounceMs;
+  }
+  if (isNonNegativeNumber(raw.searchThrottleMs)) {
+    config.searchThrottleMs = raw.searchThrottleMs;
+  }
+  if (Array.isArray(raw.links)) {
+    const links = raw.links.filter(isFieldLink);
+    if (links.length > 0) {
+      config.links = links;
+    }
+  }
+  if (Array.isArray(raw.hooks)) {
+    for (const hook of raw.hooks) {
+      installHook(config, hook);
+    }
+  }
+  return config;
+}
+
+function readCache(value: unknown): CacheConfig | undefined {
+  if (!value || typeof value !== "object") {
+    return undefined;
+  }
+  const record = value as Record<string, unknown>;
+  const cache: CacheConfig = {};
+  if (record.strategy === "memory" || record.strategy === "none") {
+    cache.strategy = record.strategy;
+  }
+  if (isNonNegativeNumber(record.ttlMs) && record.ttlMs > 0) {
+    cache.ttlMs = record.ttlMs;
+  }
+  return Object.keys(cache).length > 0 ? cache : undefined;
+}
+
+function readAuth(value: unknown): EndpointAuth | undefined {
+  if (!value || typeof value !== "object") {
+    return undefined;
+  }
+  const record = value as Record<string, unknown>;
+  const auth: EndpointAuth = {};
+  if (record.strategy === "header" || record.strategy === "cookie") {
+    auth.strategy = record.strategy;
+  }
+  for (const key of ["header", "source", "prefix"] as const) {
+    const entry = record[key];
+    if (typeof entry === "string" && entry.trim() !== "") {
+      auth[key] = entry.trim();
+    }
+  }
+  return Object.keys(auth).length > 0 ? auth : undefined;
+}
+
+function installHook(config: GlobalConfig, hook: unknown): void {
+  switch (hook as RuntimeHookName) {
+    case "validationError":
+      config.onValidationError = (context: ResolverContext, error: ValidationError) => {
+        const log = context.config.logger?.warn ?? console.warn;
+        log("[formgen] validation failed", context.field.name, error.message);
+      };
+      break;
+    case "error":
+      config.onError = (context: ResolverContext, error: ResolverError) => {
+        const log = context.config.logger?.error ?? console.error;
+        log("[formgen] relationship fetch failed", context.field.name, error.message);
+      };
+      break;
+  }
+}
+
+function isFieldLink(value: unknown): value is FieldLinkConfig {
+  if (!value || typeof value !== "object") {
+    return false;
+  }
+  const record = value as Record<string, unknown>;
+  return typeof record.from === "string" && typeof record.to === "string";
+}
+
+function isNonNegativeNumber(value: unknown): value is number {
+  return typeof value === "number" && Number.isFinite(value) && value >= 0;
+}
+
+function defaultDocument(): Document | null {
+  return typeof document === "undefined" ? null : document;
+}
@@ -0,0 +1,111 @@
+/**
+ * Scroll-spy for the section navigation the vanilla renderer emits when a form
+ * sets `layout.sectionNav`:
+ *
+ *   <nav data-formgen-section-nav>
+ *     <a href="#fg-section-general" data-formgen-section-link="general">General</a>
+ *   </nav>
+ *
+ * The link whose section is the last one scrolled past the activation line
+ * gets `aria-current="true"`.
+ */
+
+export const SECTION_NAV_ATTR = "data-formgen-section-nav";
+export const SECTION_LINK_ATTR = "data-formgen-section-link";
+const BOUND_ATTR = "data-section-nav-bound";
+
+/** Distance from the top of the viewport, in pixels, at which a section becomes active. */
+export const SECTION_ACTIVATION_OFFSET = 96;
+
+interface SectionTarget {
+  link: HTMLAnchorElement;
+  section: HTMLElement;
+}
+
+function collectTargets(nav: HTMLElement): SectionTarget[] {
+  const targets: SectionTarget[] = [];
+  const links = Array.from(nav.querySelectorAll<HTMLAnchorElement>(`a[${SECTION_LINK_ATTR}]`));
+  for (const link of links) {
+    const href = link.getAttribute("href") ?? "";
+    if (!href.startsWith("#")) {
+      continue;
+    }
+    const section = nav.ownerDocument.getElementById(decodeURIComponent(href.slice(1)));
+    if (section) {
+      targets.push({ link, section });
+    }
+  }
+  return targets;
+}
+
+/**
+ * activeSectionIndex returns the index of the last section whose top edge is
+ * at or above the activation offset, or 0 when none has been reached yet.
+ */
+export function activeSectionIndex(tops: number[], offset: number = SECTION_ACTIVATION_OFFSET): number {
+  let active = 0;
+  tops.forEach((top, index) => {
+    if (top <= offset) {
+      active = index;
+    }
+  });
+  return active;
+}
+
+function highlight(targets: SectionTarget[]): void {
+  const index = activeSectionIndex(targets.map((target) => target.section.getBoundingClientRect().top));
+  targets.forEach((target, i) => {
+    if (i === index) {
+      target.link.setAttribute("aria-current", "true");
+    } else {
+      target.link.removeAttribute("aria-current");
+    }
+  });
+}
+
+/**
+ * initSectionNav binds every section navigation under root. Highlighting
+ * follows window scroll and resize events, throttled to animation frames.
+ * Returns a cleanup that removes the listeners.
+ */
+export function initSectionNav(root: Document | HTMLElement = document): () => void {
+  const cleanups: Array<() => void> = [];
+  const navs = Array.from(root.querySelectorAll<HTMLElement>(`[${SECTION_NAV_ATTR}]`));
+  for (const nav of navs) {
+    if (nav.hasAttribute(BOUND_ATTR)) {
+      continue;
+    }
+    const targets = collectTargets(nav);
+    const view = nav.ownerDocument.defaultView;
+    if (targets.length === 0 || !view) {
+      continue;
+    }
+    let frame = 0;
+    const schedule = () => {
+      if (frame !== 0) {
+        return;
+      }
+      frame = view.requestAnimationFrame(() => {
+        frame = 0;
+        highlight(targets);
+      });
+    };
+    view.addEventListener("scroll", schedule, { passive: true });
+    view.addEventListener("resize", schedule);
+    nav.setAttribute(BOUND_ATTR, "true");
+    highlight(targets);
+    cleanups.push(() => {
+      view.removeEventListener("scroll", schedule);
+      view.removeEventListener("resize", schedule);
+      if (frame !== 0) {
+        view.cancelAnimationFrame(frame);
+      }
+      nav.removeAttribute(BOUND_ATTR);
+    });
+  }
+  return () => {
+    for (const cleanup of cleanups) {
+      cleanup();
+    }
+  };
+}
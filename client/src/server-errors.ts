@@ -0,0 +1,184 @@
+/**
+ * Routes server validation failures onto form fields using the error layouts
+ * the Go builder detected in the operation's 4xx response schemas
+ * (`errors.mapping` metadata, rendered as `data-formgen-error-mapping`).
+ */
+
+export const ERROR_MAPPING_ATTR = "data-formgen-error-mapping";
+
+export interface ErrorMapping {
+  statuses: string[];
+  shape: "list" | "map";
+  path: string;
+  fieldKey?: string;
+  messageKey?: string;
+  formMessageKey?: string;
+}
+
+export interface ServerErrors {
+  fields: Record<string, string[]>;
+  form: string[];
+}
+
+const WRAPPER_SEGMENTS = new Set(["body", "request", "payload", "data", "attributes"]);
+const FORM_LEVEL_KEYS = new Set(["", "form", "base", "__all__", "non_field_errors", "non-field-errors"]);
+
+export function parseErrorMappings(raw: string | null | undefined): ErrorMapping[] {
+  if (!raw) {
+    return [];
+  }
+  try {
+    const parsed = JSON.parse(raw);
+    return Array.isArray(parsed) ? (parsed as ErrorMapping[]) : [];
+  } catch (_err) {
+    return [];
+  }
+}
+
+export function findErrorMappings(root: Document | HTMLElement): ErrorMapping[] {
+  const host =
+    root instanceof HTMLElement && root.hasAttribute(ERROR_MAPPING_ATTR)
+      ? root
+      : root.querySelector<HTMLElement>(`[${ERROR_MAPPING_ATTR}]`);
+  return parseErrorMappings(host?.getAttribute(ERROR_MAPPING_ATTR));
+}
+
+export function matchesStatus(mapping: ErrorMapping, status: number): boolean {
+  const code = String(status);
+  return (mapping.statuses || []).some((candidate) => {
+    const normalized = String(candidate).trim().toUpperCase();
+    if (normalized === code) {
+      return true;
+    }
+    return normalized.length === 3 && normalized.endsWith("XX") && normalized[0] === code[0];
+  });
+}
+
+/**
+ * Extracts field and form errors from a decoded response body. Field paths
+ * are normalised to the dotted names used by the form controller: JSON
+ * pointers and bracket paths become dots, and leading wrapper segments such
+ * as `body` or JSON:API `data.attributes` are dropped.
+ */
+export function extractServerErrors(
+  mappings: ErrorMapping[],
+  status: number,
+  body: unknown
+): ServerErrors | null {
+  for (const mapping of mappings) {
+    if (!matchesStatus(mapping, status)) {
+      continue;
+    }
+    const result = extractWithMapping(mapping, body);
+    if (Object.keys(result.fields).length > 0 || result.form.length > 0) {
+      return result;
+    }
+  }
+  return null;
+}
+
+function extractWithMapping(mapping: ErrorMapping, body: unknown): ServerErrors {
+  const result: ServerErrors = { fields: {}, form: [] };
+  const collection = lookup(body, mapping.path);
+
+  const add = (rawField: string, message: string) => {
+    const field = normalizeFieldPath(rawField);
+    if (!field) {
+      result.form.push(message);
+      return;
+    }
+    if (!result.fields[field]) {
+      result.fields[field] = [];
+    }
+    result.fields[field]!.push(message);
+  };
+
+  if (mapping.shape === "list" && Array.isArray(collection)) {
+    collection.forEach((item) => {
+      const message = text(lookup(item, mapping.messageKey));
+      if (message) {
+        add(fieldPath(lookup(item, mapping.fieldKey)), message);
+      }
+    });
+  } else if (mapping.shape === "map" && isRecord(collection)) {
+    Object.keys(collection)
+      .sort()
+      .forEach((key) => {
+        messages(collection[key]).forEach((message) => add(key, message));
+      });
+  }
+
+  if (Object.keys(result.fields).length === 0 && result.form.length === 0 && mapping.formMessageKey) {
+    const summary = text(lookup(body, mapping.formMessageKey));
+    if (summary) {
+      result.form.push(summary);
+    }
+  }
+  return result;
+}
+
+function normalizeFieldPath(raw: string): string {
+  const trimmed = raw.trim();
+  if (FORM_LEVEL_KEYS.has(trimmed.toLowerCase())) {
+    return "";
+  }
+  const segments = trimmed
+    .replace(/^[#$]/, "")
+    .replace(/\[(\w*)\]/g, ".$1")
+    .split(/[./]/)
+    .map((segment) => segment.trim().replace(/~1/g, "/").replace(/~0/g, "~"))
+    .filter(Boolean);
+  while (segments.length > 0 && WRAPPER_SEGMENTS.has(segments[0]!.toLowerCase())) {
+    segments.shift();
+  }
+  return segments.filter((segment) => !/^\d+$/.test(segment)).join(".");
+}
+
+function lookup(value: unknown, path?: string): unknown {
+  if (!path) {
+    return path === "" ? value : undefined;
+  }
+  let current = value;
+  for (const segment of path.split(".")) {
+    if (!isRecord(current)) {
+      return undefined;
+    }
+    current = current[segment];
+  }
+  return current;
+}
+
+function fieldPath(value: unknown): string {
+  if (Array.isArray(value)) {
+    return value.map(text).filter(Boolean).join(".");
+  }
+  return text(value);
+}
+
+function messages(value: unknown): string[] {
+  if (Array.isArray(value)) {
+    return value
+      .map((item) => text(isRecord(item) ? item.message : item))
+      .filter(Boolean);
+  }
+  if (isRecord(value)) {
+    const message = text(value.message);
+    return message ? [message] : [];
+  }
+  const message = text(value);
+  return message ? [message] : [];
+}
+
+function text(value: unknown): string {
+  if (typeof value === "string") {
+    return value.trim();
+  }
+  if (typeof value === "number" || typeof value === "boolean") {
+    return String(value);
+  }
+  return "";
+}
+
+function isRecord(value: unknown): value is Record<string, unknown> {
+  return Boolean(value) && typeof value === "object" && !Array.isArray(value);
+}
@@ -0,0 +1,241 @@
+import { clearFieldError, renderFieldError } from "../errors";
+
+/**
+ * ValidateRules mirrors the `data-validate` payload emitted by the vanilla
+ * renderer when WithClientValidation is enabled.
+ */
+export interface ValidateRules {
+  label?: string;
+  required?: boolean;
+  min?: number;
+  exclusiveMin?: boolean;
+  max?: number;
+  exclusiveMax?: boolean;
+  minLength?: number;
+  maxLength?: number;
+  minItems?: number;
+  maxItems?: number;
+  pattern?: string;
+}
+
+export interface ValidateIssue {
+  code: "required" | "min" | "max" | "minLength" | "maxLength" | "minItems" | "maxItems" | "pattern";
+  message: string;
+}
+
+type FieldElement = HTMLInputElement | HTMLSelectElement | HTMLTextAreaElement;
+
+const FIELD_SELECTOR = "[data-validate]";
+const BOUND_ATTR = "data-validate-bound";
+
+/**
+ * validateValue checks one control against its rules and returns the first
+ * issue, or null when the value is valid. Empty optional values are valid.
+ */
+export function validateValue(rules: ValidateRules, value: string | string[]): ValidateIssue | null {
+  const label = rules.label || "This field";
+  const values = Array.isArray(value) ? value.filter((item) => item !== "") : [];
+  const empty = Array.isArray(value) ? values.length === 0 : value.trim() === "";
+
+  if (empty) {
+    if (rules.required) {
+      return { code: "required", message: `${label} is required.` };
+    }
+    return null;
+  }
+
+  if (Array.isArray(value)) {
+    if (rules.minItems != null && values.length < rules.minItems) {
+      return { code: "minItems", message: `Select at least ${rules.minItems} ${label.toLowerCase()}.` };
+    }
+    if (rules.maxItems != null && values.length > rules.maxItems) {
+      return { code: "maxItems", message: `Select at most ${rules.maxItems} ${label.toLowerCase()}.` };
+    }
+    return null;
+  }
+
+  if (rules.minLength != null && value.length < rules.minLength) {
+    return { code: "minLength", message: `${label} must be at least ${rules.minLength} characters.` };
+  }
+  if (rules.maxLength != null && value.length > rules.maxLength) {
+    return { code: "maxLength", message: `${label} must be at most ${rules.maxLength} characters.` };
+  }
+  if (rules.min != null || rules.max != null) {
+    const numeric = Number(value);
+    if (!Number.isNaN(numeric)) {
+      if (rules.min != null && (rules.exclusiveMin ? numeric <= rules.min : numeric < rules.min)) {
+        return {
+          code: "min",
+          message: `${label} must be ${rules.exclusiveMin ? "greater than" : "at least"} ${rules.min}.`,
+        };
+      }
+      if (rules.max != null && (rules.exclusiveMax ? numeric >= rules.max : numeric > rules.max)) {
+        return {
+          code: "max",
+          message: `${label} must be ${rules.exclusiveMax ? "less than" : "at most"} ${rules.max}.`,
+        };
+      }
+    }
+  }
+  if (rules.pattern && !matchesPattern(rules.pattern, value)) {
+    return { code: "pattern", message: `${label} has an invalid format.` };
+  }
+  return null;
+}
+
+/**
+ * validateField reads the control's rules and value, renders or clears the
+ * inline error, and reports whether the control is valid.
+ */
+export function validateField(element: FieldElement): boolean {
+  const rules = readRules(element);
+  if (!rules) {
+    return true;
+  }
+  const issue = validateValue(rules, readValue(element));
+  if (issue) {
+    renderFieldError(element, issue.message, issue.code);
+    return false;
+  }
+  clearFieldError(element);
+  return true;
+}
+
+/**
+ * validateForm validates every `[data-validate]` control inside root and
+ * focuses the first invalid one. Returns true when all controls pass.
+ */
+export function validateForm(root: HTMLElement): boolean {
+  let firstInvalid: FieldElement | null = null;
+  fields(root).forEach((element) => {
+    if (!validateField(element) && !firstInvalid) {
+      firstInvalid = element;
+    }
+  });
+  if (firstInvalid) {
+    (firstInvalid as FieldElement).focus();
+    return false;
+  }
+  return true;
+}
+
+/**
+ * initValidation binds blur and submit validation to forms containing
+ * `[data-validate]` controls. Forms are bound once; calling it again after
+ * inserting markup picks up new forms.
+ */
+export function initValidation(root: Document | HTMLElement = document): void {
+  const forms = new Set<HTMLFormElement>();
+  if (root instanceof HTMLFormElement) {
+    forms.add(root);
+  }
+  root.querySelectorAll<HTMLElement>(FIELD_SELECTOR).forEach((element) => {
+    const form = element.closest("form");
+    if (form) {
+      forms.add(form);
+    }
+  });
+  forms.forEach(bindForm);
+}
+
+function bindForm(form: HTMLFormElement): void {
+  if (form.hasAttribute(BOUND_ATTR)) {
+    return;
+  }
+  form.setAttribute(BOUND_ATTR, "true");
+
+  form.addEventListener("focusout", (event) => {
+    const target = event.target;
+    if (isFieldElement(target) && target.hasAttribute("data-validate")) {
+      validateField(target);
+    }
+  });
+  form.addEventListener("change", (event) => {
+    const target = event.target;
+    // Re-check invalid controls as soon as the user fixes them.
+    if (
+      isFieldElement(target) &&
+      target.hasAttribute("data-validate") &&
+      target.getAttribute("data-validation-state") === "invalid"
+    ) {
+      validateField(target);
+    }
+  });
+  form.addEventListener("submit", (event) => {
+    if (!validateForm(form)) {
+      event.preventDefault();
+      event.stopImmediatePropagation();
+    }
+  });
+}
+
+function fields(root: HTMLElement): FieldElement[] {
+  return Array.from(root.querySelectorAll<HTMLElement>(FIELD_SELECTOR)).filter(isFieldElement);
+}
+
+function readRules(element: FieldElement): ValidateRules | null {
+  const raw = element.getAttribute("data-validate");
+  if (!raw) {
+    return null;
+  }
+  try {
+    const parsed = JSON.parse(raw);
+    return parsed && typeof parsed === "object" ? (parsed as ValidateRules) : null;
+  } catch (_err) {
+    return null;
+  }
+}
+
+function readValue(element: FieldElement): string | string[] {
+  if (element instanceof HTMLSelectElement && element.multiple) {
+    return Array.from(element.selectedOptions).map((option) => option.value);
+  }
+  if (element instanceof HTMLInputElement) {
+    const type = element.type.toLowerCase();
+    if (type === "checkbox" || type === "radio") {
+      const form = element.form;
+      if (form && element.name) {
+        const group = Array.from(form.querySelectorAll<HTMLInputElement>(`input[name="${cssEscape(element.name)}"]`));
+        return group.filter((input) => input.checked).map((input) => input.value);
+      }
+      return element.checked ? [element.value] : [];
+    }
+    if (type === "file") {
+      return Array.from(element.files ?? []).map((file) => file.name);
+    }
+  }
+  return element.value;
+}
+
+function matchesPattern(pattern: string, value: string): boolean {
+  try {
+    return new RegExp(pattern, "u").test(value);
+  } catch (_err) {
+    // Patterns the browser cannot compile are left to server validation.
+    return true;
+  }
+}
+
+function isFieldElement(target: EventTarget | null): target is FieldElement {
+  return (
+    target instanceof HTMLInputElement ||
+    target instanceof HTMLSelectElement ||
+    target instanceof HTMLTextAreaElement
+  );
+}
+
+function cssEscape(value: string): string {
+  if (typeof CSS !== "undefined" && typeof CSS.escape === "function") {
+    return CSS.escape(value);
+  }
+  return value.replace(/["\\]/g, "\\$&");
+}
+
+// Auto-init on DOMContentLoaded
+if (typeof document !== "undefined") {
+  if (document.readyState === "loading") {
+    document.addEventListener("DOMContentLoaded", () => initValidation());
+  } else {
+    initValidation();
+  }
+}
@@ -251,4 +251,53 @@ describe("array repeaters", () => {
     expect(document.getElementById("section-0")).toBeNull();
     expect(document.querySelector<HTMLInputElement>("[name='sections[0].links[0]._delete']")?.value).toBeUndefined();
   });
+
+  it("enforces minItems and maxItems on add and remove actions", async () => {
+    document.body.innerHTML = `
+      <form data-formgen-auto-init="true">
+        <div
+          data-formgen-array-items="true"
+          data-formgen-array-name="tags"
+          data-formgen-array-next-index="1"
+          data-formgen-array-prototype-path="tags[1]"
+          data-formgen-array-prototype-id-prefix="fg-tags-1"
+          data-formgen-array-min-items="1"
+          data-formgen-array-max-items="2"
+        >
+          <div data-formgen-array-item="true" data-formgen-array-existing="true">
+            <input name="tags[0]" value="alpha">
+            <button type="button" data-formgen-array-action="remove">Remove</button>
+          </div>
+          <template data-formgen-array-prototype="true">
+            <div data-formgen-array-item="true" data-formgen-array-existing="false">
+              <input name="tags[1]" disabled data-formgen-prototype-disabled="true">
+              <button type="button" data-formgen-array-action="remove">Remove</button>
+            </div>
+          </template>
+        </div>
+        <button type="button" data-formgen-array-action="add">Add tag</button>
+      </form>
+    `;
+
+    await initRelationships();
+    const add = document.querySelector<HTMLButtonElement>("[data-formgen-array-action='add']")!;
+    const removeButtons = () =>
+      Array.from(document.querySelectorAll<HTMLButtonElement>("[data-formgen-array-item] [data-formgen-array-action='remove']"));
+
+    expect(add.disabled).toBe(false);
+    expect(removeButtons()[0]?.disabled).toBe(true);
+
+    add.click();
+    expect(document.querySelectorAll("[data-formgen-array-item]")).toHaveLength(2);
+    expect(add.disabled).toBe(true);
+    expect(removeButtons().every((button) => !button.disabled)).toBe(true);
+
+    const items = document.querySelector<HTMLElement>("[data-formgen-array-items]")!;
+    expect(addArrayItem(items)).toHaveLength(0);
+
+    removeButtons()[1]!.click();
+    expect(document.querySelectorAll("[data-formgen-array-item]")).toHaveLength(1);
+    expect(add.disabled).toBe(false);
+    expect(removeButtons()[0]?.disabled).toBe(true);
+  });
 });
@@ -1,5 +1,15 @@
 import { describe, it, beforeEach, afterEach, expect, vi } from "vitest";
-import { initBehaviors, registerBehavior, __resetBehaviorsForTests } from "../src/behaviors";
+import {
+  initBehaviors,
+  registerBehavior,
+  __resetBehaviorsForTests,
+  ADVANCED_TOGGLE_EVENT,
+  DRAFT_CLEARED_EVENT,
+  DRAFT_RESTORED_EVENT,
+  SUBMISSION_QUEUED_EVENT,
+  SUBMISSION_SENT_EVENT,
+  type SubmissionEventDetail,
+} from "../src/behaviors";
 
 beforeEach(() => {
   __resetBehaviorsForTests();
@@ -121,4 +131,212 @@ describe("behaviors runtime", () => {
     result.dispose();
     expect(teardown).toHaveBeenCalledTimes(1);
   });
+
+  it("submitQueue queues failed submissions and retries them when back online", async () => {
+    document.body.innerHTML = `
+      <form action="/api/pets" method="post" data-behavior="submitQueue" data-behavior-config='{"baseDelayMs":60000}'>
+        <input type="hidden" name="_idempotency_key" value="key-1">
+        <input name="name" value="Rex">
+      </form>
+    `;
+    const form = document.querySelector("form") as HTMLFormElement;
+    const fetchSpy = vi
+      .fn()
+      .mockRejectedValueOnce(new TypeError("network down"))
+      .mockResolvedValueOnce({ ok: true, status: 201 } as Response);
+    const originalFetch = globalThis.fetch;
+    (globalThis as any).fetch = fetchSpy;
+    const settle = async () => {
+      for (let i = 0; i < 5; i += 1) {
+        await new Promise((resolve) => setTimeout(resolve, 0));
+      }
+    };
+
+    const queued: SubmissionEventDetail[] = [];
+    const sent: SubmissionEventDetail[] = [];
+    form.addEventListener(SUBMISSION_QUEUED_EVENT, (event) => queued.push((event as CustomEvent).detail));
+    document.addEventListener(SUBMISSION_SENT_EVENT, (event) => sent.push((event as CustomEvent).detail));
+
+    const { dispose } = initBehaviors();
+    try {
+      const submit = new Event("submit", { cancelable: true });
+      form.dispatchEvent(submit);
+      expect(submit.defaultPrevented).toBe(true);
+      await settle();
+
+      expect(queued).toHaveLength(1);
+      expect(queued[0]!.submission.attempts).toBe(1);
+      expect(sent).toHaveLength(0);
+
+      window.dispatchEvent(new Event("online"));
+      await settle();
+
+      expect(fetchSpy).toHaveBeenCalledTimes(2);
+      for (const [url, init] of fetchSpy.mock.calls) {
+        expect(url).toContain("/api/pets");
+        expect((init as RequestInit).headers).toMatchObject({ "Idempotency-Key": "key-1" });
+        expect(String((init as RequestInit).body)).toBe("_idempotency_key=key-1&name=Rex");
+      }
+      expect(sent).toHaveLength(1);
+      expect(sent[0]!.response?.status).toBe(201);
+    } finally {
+      dispose();
+      (globalThis as any).fetch = originalFetch;
+    }
+  });
+
+  it("enumFilter narrows select options while its condition holds", () => {
+    document.body.innerHTML = `
+      <form data-formgen-auto-init="true">
+        <select id="fg-region" name="region">
+          <option value="US">US</option>
+          <option value="EU">EU</option>
+        </select>
+        <select
+          id="fg-currency"
+          name="currency"
+          data-behavior="enumFilter"
+          data-behavior-config='{"when":"region == EU","allow":["EUR","GBP"]}'
+        >
+          <option value="">Select an option</option>
+          <option value="USD" selected>USD</option>
+          <option value="EUR">EUR</option>
+          <option value="GBP">GBP</option>
+        </select>
+      </form>
+    `;
+
+    const { dispose } = initBehaviors();
+    const region = document.getElementById("fg-region") as HTMLSelectElement;
+    const currency = document.getElementById("fg-currency") as HTMLSelectElement;
+    const usd = currency.querySelector('option[value="USD"]') as HTMLOptionElement;
+
+    expect(usd.disabled).toBe(false);
+    expect(currency.value).toBe("USD");
+
+    region.value = "EU";
+    region.dispatchEvent(new Event("change", { bubbles: true }));
+    expect(usd.disabled).toBe(true);
+    expect(usd.hidden).toBe(true);
+    expect(currency.value).not.toBe("USD");
+
+    region.value = "US";
+    region.dispatchEvent(new Event("change", { bubbles: true }));
+    expect(usd.disabled).toBe(false);
+    expect(usd.hasAttribute("data-enum-filtered")).toBe(false);
+
+    dispose();
+  });
+
+  it("advancedToggle reveals advanced fields and swaps its label", () => {
+    document.body.innerHTML = `
+      <form data-formgen-auto-init="true">
+        <div><input id="fg-url" name="url"></div>
+        <div data-formgen-advanced hidden><input id="fg-secret" name="secret"></div>
+        <section data-formgen-advanced hidden><input id="fg-retries" name="retries"></section>
+        <button
+          type="button"
+          data-behavior="advancedToggle"
+          data-behavior-config='{"showLabel":"Show advanced options","hideLabel":"Hide advanced options"}'
+          aria-expanded="false"
+        ><span data-formgen-advanced-label>Show advanced options</span> <span data-formgen-advanced-count>2</span></button>
+      </form>
+    `;
+
+    const { dispose } = initBehaviors();
+    const button = document.querySelector("button") as HTMLButtonElement;
+    const label = button.querySelector("[data-formgen-advanced-label]") as HTMLElement;
+    const targets = Array.from(document.querySelectorAll<HTMLElement>("[data-formgen-advanced]"));
+    const events: boolean[] = [];
+    document.addEventListener(ADVANCED_TOGGLE_EVENT, (event) => {
+      events.push((event as CustomEvent<{ expanded: boolean }>).detail.expanded);
+    });
+
+    button.click();
+    expect(targets.every((target) => !target.hidden)).toBe(true);
+    expect(button.getAttribute("aria-expanded")).toBe("true");
+    expect(label.textContent).toBe("Hide advanced options");
+
+    button.click();
+    expect(targets.every((target) => target.hidden)).toBe(true);
+    expect(label.textContent).toBe("Show advanced options");
+    expect(events).toEqual([true, false]);
+
+    dispose();
+  });
+
+  it("advancedToggle starts expanded when an advanced control is invalid", () => {
+    document.body.innerHTML = `
+      <form>
+        <div data-formgen-advanced hidden><input id="fg-secret" name="secret" aria-invalid="true"></div>
+        <button type="button" data-behavior="advancedToggle" aria-expanded="false">
+          <span data-formgen-advanced-label>Show advanced options</span>
+        </button>
+      </form>
+    `;
+
+    const { dispose } = initBehaviors();
+    const target = document.querySelector("[data-formgen-advanced]") as HTMLElement;
+    expect(target.hidden).toBe(false);
+    expect(document.querySelector("button")?.getAttribute("aria-expanded")).toBe("true");
+
+    dispose();
+  });
+
+  it("draft saves edits, restores them on load, and clears on submit", async () => {
+    const markup = `
+      <form action="/articles" method="post" data-behavior="draft" data-behavior-config='{"key":"article","debounceMs":0,"exclude":["api_key"]}'>
+        <input type="hidden" name="_csrf" value="token">
+        <input name="title" value="">
+        <input name="api_key" value="">
+        <input type="password" name="password" value="">
+        <select name="tags" multiple>
+          <option value="go">go</option>
+          <option value="ts">ts</option>
+        </select>
+      </form>
+    `;
+    const settle = async () => {
+      for (let i = 0; i < 5; i += 1) {
+        await new Promise((resolve) => setTimeout(resolve, 0));
+      }
+    };
+    localStorage.removeItem("formgen:draft:article");
+
+    document.body.innerHTML = markup;
+    let result = initBehaviors();
+    await settle();
+    const title = document.querySelector('input[name="title"]') as HTMLInputElement;
+    title.value = "Draft title";
+    title.dispatchEvent(new Event("input", { bubbles: true }));
+    (document.querySelector('input[name="api_key"]') as HTMLInputElement).value = "secret";
+    (document.querySelector('input[name="password"]') as HTMLInputElement).value = "hunter2";
+    const tags = document.querySelector("select") as HTMLSelectElement;
+    tags.options[1]!.selected = true;
+    tags.dispatchEvent(new Event("change", { bubbles: true }));
+    await settle();
+
+    const stored = JSON.parse(localStorage.getItem("formgen:draft:article") ?? "{}");
+    expect(stored.values).toEqual({ title: ["Draft title"], tags: ["ts"] });
+    result.dispose();
+
+    document.body.innerHTML = markup;
+    const restored: string[] = [];
+    document.addEventListener(DRAFT_RESTORED_EVENT, (event) => restored.push((event as CustomEvent).detail.key), {
+      once: true,
+    });
+    result = initBehaviors();
+    await settle();
+    expect((document.querySelector('input[name="title"]') as HTMLInputElement).value).toBe("Draft title");
+    expect((document.querySelector("select") as HTMLSelectElement).options[1]!.selected).toBe(true);
+    expect(restored).toEqual(["article"]);
+
+    const cleared = vi.fn();
+    document.addEventListener(DRAFT_CLEARED_EVENT, cleared, { once: true });
+    (document.querySelector("form") as HTMLFormElement).dispatchEvent(new Event("submit", { cancelable: true }));
+    await settle();
+    expect(localStorage.getItem("formgen:draft:article")).toBeNull();
+    expect(cleared).toHaveBeenCalledTimes(1);
+    result.dispose();
+  });
 });
@@ -0,0 +1,108 @@
+import { describe, it, expect, beforeEach, afterEach, vi } from "vitest";
+import { renderForm, type FormDescriptor } from "../src/client";
+import { resetGlobalRegistry } from "../src/index";
+
+const fetchSpy = vi.fn();
+
+function mockResponse(data: unknown): Response {
+  return {
+    ok: true,
+    status: 200,
+    text: async () => JSON.stringify(data),
+  } as unknown as Response;
+}
+
+const descriptor: FormDescriptor = {
+  version: "formgen.descriptor/v1",
+  form: {
+    operationId: "updateBook",
+    endpoint: "/books/1",
+    method: "PATCH",
+    fields: [
+      {
+        name: "title",
+        type: "string",
+        required: true,
+        label: "Title",
+        validations: [{ kind: "minLength", params: { value: "3" } }],
+      },
+      {
+        name: "author",
+        type: "object",
+        label: "Author",
+        nested: [{ name: "email", type: "string", format: "email", label: "Email" }],
+      },
+      {
+        name: "publisher_id",
+        type: "string",
+        label: "Publisher",
+        relationship: { kind: "belongsTo", target: "#/components/schemas/Publisher", cardinality: "one" },
+        metadata: {
+          "relationship.endpoint.url": "/api/publishers",
+          "relationship.endpoint.labelField": "name",
+        },
+      },
+      { name: "published", type: "boolean", label: "Published" },
+    ],
+    uiHints: { "layout.title": "Edit Book" },
+  },
+  values: { "author.email": "ada@example.com" },
+  hiddenFields: [{ name: "csrf", value: "token" }],
+};
+
+describe("formgen client", () => {
+  beforeEach(() => {
+    document.body.innerHTML = `<div id="mount"></div>`;
+    fetchSpy.mockReset();
+    fetchSpy.mockResolvedValue(mockResponse([{ value: "p1", label: "Penguin" }]));
+    (globalThis as any).fetch = fetchSpy;
+  });
+
+  afterEach(() => {
+    resetGlobalRegistry();
+    document.body.innerHTML = "";
+  });
+
+  it("renders the form model with the vanilla data contract", async () => {
+    const client = await renderForm("#mount", descriptor);
+    const form = client.element;
+
+    expect(form.getAttribute("method")).toBe("post");
+    expect(form.querySelector<HTMLInputElement>('input[name="_method"]')?.value).toBe("PATCH");
+    expect(form.querySelector<HTMLInputElement>('input[name="csrf"]')?.value).toBe("token");
+    expect(form.querySelector("h1")?.textContent).toBe("Edit Book");
+
+    const title = form.querySelector<HTMLInputElement>("#fg-title")!;
+    expect(title.required).toBe(true);
+    expect(title.getAttribute("minlength")).toBe("3");
+    expect(title.dataset.validationRules).toContain("minLength");
+
+    const email = form.querySelector<HTMLInputElement>('input[name="author.email"]')!;
+    expect(email.type).toBe("email");
+    expect(email.value).toBe("ada@example.com");
+
+    const publisher = form.querySelector<HTMLSelectElement>('select[name="publisher_id"]')!;
+    expect(publisher.dataset.endpointUrl).toBe("/api/publishers");
+    expect(publisher.dataset.endpointLabelField).toBe("name");
+    expect(client.registry.get(publisher)).toBeDefined();
+    expect(fetchSpy).toHaveBeenCalled();
+
+    client.destroy();
+    expect(document.querySelector("form")).toBeNull();
+  });
+
+  it("validates before invoking onSubmit", async () => {
+    const onSubmit = vi.fn();
+    const client = await renderForm("#mount", descriptor, { onSubmit });
+    const title = client.element.querySelector<HTMLInputElement>("#fg-title")!;
+
+    client.element.dispatchEvent(new Event("submit", { cancelable: true }));
+    expect(onSubmit).not.toHaveBeenCalled();
+    expect(client.validate()).toBe(false);
+
+    title.value = "Dune";
+    client.element.dispatchEvent(new Event("submit", { cancelable: true }));
+    expect(onSubmit).toHaveBeenCalledTimes(1);
+    expect(onSubmit.mock.calls[0][0]).toMatchObject({ title: "Dune" });
+  });
+});
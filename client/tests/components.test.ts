@@ -63,6 +63,51 @@ describe("component registry", () => {
     expect(teardown).toHaveBeenCalledTimes(1);
   });
 
+  it("links daterange bounds", () => {
+    document.body.innerHTML = `
+      <div data-component="daterange">
+        <input type="date" data-formgen-daterange-bound="start" value="2026-03-10">
+        <input type="date" data-formgen-daterange-bound="end" value="2026-03-12">
+      </div>
+    `;
+    initComponents(document);
+
+    const start = document.querySelector<HTMLInputElement>("[data-formgen-daterange-bound='start']")!;
+    const end = document.querySelector<HTMLInputElement>("[data-formgen-daterange-bound='end']")!;
+    expect(end.min).toBe("2026-03-10");
+
+    start.value = "2026-03-20";
+    start.dispatchEvent(new Event("change"));
+    expect(end.value).toBe("2026-03-20");
+    expect(end.min).toBe("2026-03-20");
+  });
+
+  it("composes ISO-8601 durations from parts", () => {
+    document.body.innerHTML = `
+      <div data-component="duration">
+        <input type="hidden" name="timeout" value="" data-formgen-duration-value>
+        <input type="number" data-formgen-duration-part="days">
+        <input type="number" data-formgen-duration-part="hours">
+        <input type="number" data-formgen-duration-part="minutes">
+      </div>
+    `;
+    initComponents(document);
+
+    const hidden = document.querySelector<HTMLInputElement>("[data-formgen-duration-value]")!;
+    const days = document.querySelector<HTMLInputElement>("[data-formgen-duration-part='days']")!;
+    const minutes = document.querySelector<HTMLInputElement>("[data-formgen-duration-part='minutes']")!;
+
+    days.value = "1";
+    minutes.value = "30";
+    minutes.dispatchEvent(new Event("input"));
+    expect(hidden.value).toBe("P1DT30M");
+
+    days.value = "";
+    minutes.value = "";
+    minutes.dispatchEvent(new Event("input"));
+    expect(hidden.value).toBe("");
+  });
+
   it("ignores unknown component names", () => {
     document.body.innerHTML = `<div data-component="missing"></div>`;
     expect(() => initComponents(document)).not.toThrow();
@@ -0,0 +1,52 @@
+import { describe, it, expect, afterEach } from "vitest";
+import { formatDisplay, initDisplayFormats, registerDisplayFormatter } from "../src/display";
+
+describe("formatDisplay", () => {
+  const now = new Date("2026-10-16T12:00:00Z");
+
+  it("mirrors the Go built-in formatters", () => {
+    expect(formatDisplay("relativeDate", "2026-10-16T11:59:30Z", now)).toBe("just now");
+    expect(formatDisplay("relativeDate", "2026-10-16T11:00:00Z", now)).toBe("1 hour ago");
+    expect(formatDisplay("relativeDate", "2026-10-13T12:00:00Z", now)).toBe("3 days ago");
+    expect(formatDisplay("relativeDate", "2026-12-16T12:00:00Z", now)).toBe("in 2 months");
+    expect(formatDisplay("date", "2026-10-16T23:30:00-02:00", now)).toBe("Oct 17, 2026");
+    expect(formatDisplay("dateTime", "2026-10-16T08:05:00", now)).toBe("Oct 16, 2026 08:05 UTC");
+    expect(formatDisplay("bytes", "512", now)).toBe("512 B");
+    expect(formatDisplay("bytes", "1536", now)).toBe("1.5 KB");
+    expect(formatDisplay("truncate:5", "hello world", now)).toBe("hello…");
+    expect(formatDisplay("bytes", "many", now)).toBeNull();
+    expect(formatDisplay("unknown", "value", now)).toBeNull();
+  });
+
+  it("uses registered formatters", () => {
+    registerDisplayFormatter("shout", (value) => value.toUpperCase());
+    expect(formatDisplay("shout", "hi")).toBe("HI");
+  });
+});
+
+describe("initDisplayFormats", () => {
+  afterEach(() => {
+    document.body.innerHTML = "";
+  });
+
+  it("re-formats the display when the control changes", () => {
+    document.body.innerHTML = `
+      <form>
+        <input id="fg-size" name="size" value="1536" readonly />
+        <p data-formgen-display="bytes" data-display-for="fg-size">1.5 KB</p>
+      </form>
+    `;
+    const cleanup = initDisplayFormats();
+    const input = document.querySelector<HTMLInputElement>("input")!;
+    const display = document.querySelector<HTMLElement>("[data-formgen-display]")!;
+
+    input.value = "2097152";
+    input.dispatchEvent(new Event("change", { bubbles: true }));
+    expect(display.textContent).toBe("2 MB");
+
+    cleanup();
+    input.value = "1";
+    input.dispatchEvent(new Event("change", { bubbles: true }));
+    expect(display.textContent).toBe("2 MB");
+  });
+});
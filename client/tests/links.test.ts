@@ -0,0 +1,87 @@
+import { describe, it, expect, beforeEach, afterEach } from "vitest";
+import { initFieldLinks, LINK_LOCKED_ATTR } from "../src/links";
+
+function applyValue(root: HTMLElement, field: string, value: string | string[] | null): void {
+  const el = root.querySelector<HTMLInputElement | HTMLSelectElement>(`[name="${field}"]`);
+  if (!el) {
+    return;
+  }
+  el.value = Array.isArray(value) ? value[0] ?? "" : value ?? "";
+  el.dispatchEvent(new Event("change", { bubbles: true }));
+}
+
+function change(el: HTMLInputElement | HTMLSelectElement, value: string): void {
+  el.value = value;
+  el.dispatchEvent(new Event("change", { bubbles: true }));
+}
+
+describe("initFieldLinks", () => {
+  let cleanup: () => void = () => {};
+
+  beforeEach(() => {
+    document.body.innerHTML = `
+      <form data-formgen-form-id="mainForm">
+        <select name="publisher_id">
+          <option value=""></option>
+          <option value="p1">Acme</option>
+          <option value="p2">Globex</option>
+        </select>
+      </form>
+      <form data-formgen-form-id="authorModal">
+        <input name="publisher_id" />
+        <input name="email" />
+      </form>
+    `;
+  });
+
+  afterEach(() => {
+    cleanup();
+    document.body.innerHTML = "";
+  });
+
+  const main = () => document.querySelector<HTMLSelectElement>('[data-formgen-form-id="mainForm"] select')!;
+  const target = () =>
+    document.querySelector<HTMLInputElement>('[data-formgen-form-id="authorModal"] [name="publisher_id"]')!;
+
+  it("prefills the target until the user edits it", () => {
+    cleanup = initFieldLinks(
+      [{ from: "mainForm.publisher_id", to: "authorModal.publisher_id" }],
+      applyValue
+    );
+
+    change(main(), "p1");
+    expect(target().value).toBe("p1");
+
+    change(target(), "custom");
+    change(main(), "p2");
+    expect(target().value).toBe("custom");
+  });
+
+  it("locks constrained targets while the source has a value", () => {
+    cleanup = initFieldLinks(
+      [{ from: "mainForm.publisher_id", to: "authorModal.publisher_id", mode: "constrain" }],
+      applyValue
+    );
+
+    change(main(), "p2");
+    expect(target().value).toBe("p2");
+    expect(target().hasAttribute(LINK_LOCKED_ATTR)).toBe(true);
+    expect(target().readOnly).toBe(true);
+
+    change(target(), "other");
+    expect(target().value).toBe("p2");
+
+    change(main(), "");
+    expect(target().hasAttribute(LINK_LOCKED_ATTR)).toBe(false);
+    expect(target().readOnly).toBe(false);
+  });
+
+  it("seeds targets from sources that already hold a value", () => {
+    main().value = "p1";
+    cleanup = initFieldLinks(
+      [{ from: "mainForm.publisher_id", to: "authorModal.publisher_id" }],
+      applyValue
+    );
+    expect(target().value).toBe("p1");
+  });
+});
@@ -0,0 +1,67 @@
+import { describe, it, expect, afterEach, vi } from "vitest";
+import {
+  afterCreateSteps,
+  relationshipSiblings,
+  runAfterCreate,
+} from "../src/relationship-after-create";
+import type { ResolverRegistry } from "../src/registry";
+
+function markup(): void {
+  document.body.innerHTML = `
+    <form>
+      <select id="book_publisher" name="publisher_id" data-endpoint-url="/api/publishing-houses"
+        data-relationship-target="#/components/schemas/publishing-house"
+        data-endpoint-after-create="select, broadcast, bogus"></select>
+    </form>
+    <form>
+      <select id="author_publisher" name="publisher_id" data-endpoint-url="/api/publishing-houses?select=id"
+        data-relationship-target="#/components/schemas/publishing-house"></select>
+      <select id="author_tags" name="tags" data-endpoint-url="/api/tags"
+        data-relationship-target="#/components/schemas/tag"></select>
+    </form>
+  `;
+}
+
+describe("relationship after-create", () => {
+  afterEach(() => {
+    document.body.innerHTML = "";
+  });
+
+  it("defaults to select and ignores unknown steps", () => {
+    markup();
+    const book = document.getElementById("book_publisher") as HTMLSelectElement;
+    const tags = document.getElementById("author_tags") as HTMLSelectElement;
+
+    expect(Array.from(afterCreateSteps(book))).toEqual(["select", "broadcast"]);
+    expect(Array.from(afterCreateSteps(tags))).toEqual(["select"]);
+  });
+
+  it("broadcasts created options to fields sharing the target", async () => {
+    markup();
+    const book = document.getElementById("book_publisher") as HTMLSelectElement;
+    const author = document.getElementById("author_publisher") as HTMLSelectElement;
+    const refresh = vi.fn().mockResolvedValue(undefined);
+    const registry = { refresh } as unknown as ResolverRegistry;
+
+    expect(relationshipSiblings(book)).toEqual([author]);
+
+    await runAfterCreate(book, [{ value: "p9", label: "Initech" }], registry);
+
+    expect(author.querySelector('option[value="p9"]')?.textContent).toBe("Initech");
+    expect(refresh).toHaveBeenCalledTimes(1);
+    expect(refresh).toHaveBeenCalledWith(author);
+  });
+
+  it("refetches the originating field when requested", async () => {
+    markup();
+    const tags = document.getElementById("author_tags") as HTMLSelectElement;
+    tags.setAttribute("data-endpoint-after-create", "refetch");
+    const refresh = vi.fn().mockResolvedValue(undefined);
+    const registry = { refresh } as unknown as ResolverRegistry;
+
+    await runAfterCreate(tags, [{ value: "t1", label: "New" }], registry);
+
+    expect(refresh).toHaveBeenCalledWith(tags);
+    expect(tags.options.length).toBe(0);
+  });
+});
@@ -0,0 +1,65 @@
+import { describe, it, expect, afterEach, vi } from "vitest";
+import { initRelationships, resetGlobalRegistry } from "../src/index";
+import { mergeRuntimeConfig, readRuntimeConfig } from "../src/runtime-config";
+
+function mountConfig(payload: string): void {
+  const script = document.createElement("script");
+  script.type = "application/json";
+  script.id = "formgen-config";
+  script.textContent = payload;
+  document.body.appendChild(script);
+}
+
+describe("runtime config block", () => {
+  afterEach(() => {
+    document.body.innerHTML = "";
+    resetGlobalRegistry();
+    vi.restoreAllMocks();
+  });
+
+  it("returns an empty config when the block is missing or malformed", () => {
+    expect(readRuntimeConfig()).toEqual({});
+    const warn = vi.spyOn(console, "warn").mockImplementation(() => {});
+    mountConfig("{not json");
+    expect(readRuntimeConfig()).toEqual({});
+    expect(warn).toHaveBeenCalled();
+  });
+
+  it("reads cache, auth, timings, links, and hooks", () => {
+    mountConfig(
+      JSON.stringify({
+        cache: { strategy: "none" },
+        auth: { strategy: "header", header: "X-CSRF-Token", source: "meta:csrf-token" },
+        searchDebounceMs: 400,
+        searchThrottleMs: 100,
+        links: [{ from: "main.publisher_id", to: "modal.publisher_id", mode: "prefill" }],
+        hooks: ["validationError", "unknown"],
+      })
+    );
+
+    const config = readRuntimeConfig();
+    expect(config.cache).toEqual({ strategy: "none" });
+    expect(config.auth).toEqual({ strategy: "header", header: "X-CSRF-Token", source: "meta:csrf-token" });
+    expect(config.searchDebounceMs).toBe(400);
+    expect(config.searchThrottleMs).toBe(100);
+    expect(config.links).toHaveLength(1);
+    expect(typeof config.onValidationError).toBe("function");
+    expect(config.onError).toBeUndefined();
+  });
+
+  it("lets explicit options win key by key", () => {
+    const merged = mergeRuntimeConfig(
+      { cache: { strategy: "memory", ttlMs: 1000 }, searchDebounceMs: 400 },
+      { cache: { ttlMs: 50 } }
+    );
+    expect(merged.cache).toEqual({ strategy: "memory", ttlMs: 50 });
+    expect(merged.searchDebounceMs).toBe(400);
+  });
+
+  it("applies the block when initRelationships runs without options", async () => {
+    mountConfig(JSON.stringify({ searchDebounceMs: 900, cache: { strategy: "none" } }));
+    const registry = await initRelationships();
+    expect(registry.getConfig().searchDebounceMs).toBe(900);
+    expect(registry.getConfig().cache.strategy).toBe("none");
+  });
+});
@@ -248,6 +248,24 @@ describe("runtime resolver", () => {
     expect(option?.textContent).toBe("Alice");
   });
 
+  it("composes option labels from labelTemplate", async () => {
+    const field = createMarkup('data-endpoint-label-template="{{item.first}} {{ item.last }}"');
+    fetchSpy.mockResolvedValue(
+      mockResponse([
+        { id: 1, first: "Ada", last: "Lovelace" },
+        { id: 2, first: "Grace" },
+      ])
+    );
+
+    await initRelationships();
+
+    const options = Array.from(field.querySelectorAll<HTMLOptionElement>("option")).filter(
+      (option) => option.value !== ""
+    );
+    expect(options.map((option) => option.textContent)).toEqual(["Ada Lovelace", "Grace"]);
+    expect(options[0].value).toBe("1");
+  });
+
   it("emits lifecycle events with resolver detail", async () => {
     const field = createMarkup();
     fetchSpy.mockResolvedValue(mockResponse(fixtures.simplified));
@@ -284,6 +302,33 @@ describe("runtime resolver", () => {
     expect(fetchSpy).not.toHaveBeenCalled();
   });
 
+  it("honours per-field cache strategies and ttl", async () => {
+    const field = createMarkup('data-endpoint-cache-strategy="none"');
+    expect(datasetToFieldConfig(field, field.dataset as Record<string, string>).cacheStrategy).toBe("none");
+    fetchSpy.mockResolvedValue(mockResponse(fixtures.simplified));
+
+    const registry = await initRelationships({ cache: { strategy: "memory", ttlMs: 1000 } });
+    await registry.resolve(field);
+    expect(fetchSpy).toHaveBeenCalledTimes(2);
+
+    resetGlobalRegistry();
+    window.sessionStorage.clear();
+    fetchSpy.mockClear();
+    const sessionField = createMarkup('data-endpoint-cache-strategy="session" data-endpoint-cache-ttl="60000"');
+    const sessionRegistry = await initRelationships();
+    expect(fetchSpy).toHaveBeenCalledTimes(1);
+    const stored = Object.keys(window.sessionStorage).filter((key) => key.startsWith("formgen:options:"));
+    expect(stored).toHaveLength(1);
+    const entry = JSON.parse(window.sessionStorage.getItem(stored[0]!)!);
+    expect(entry.value[0].label).toBe("Alice");
+    expect(entry.expiresAt).toBeGreaterThan(Date.now() + 30000);
+
+    fetchSpy.mockClear();
+    await sessionRegistry.resolve(sessionField);
+    expect(fetchSpy).not.toHaveBeenCalled();
+    window.sessionStorage.clear();
+  });
+
   it("propagates search input to resolver requests", async () => {
     document.body.innerHTML = `
       <form data-formgen-auto-init="true">
@@ -1367,6 +1412,42 @@ describe("runtime resolver", () => {
     expect(fetchSpy.mock.calls[fetchSpy.mock.calls.length - 1][0]).toContain("tenant-id=2");
   });
 
+  it("refreshes options on an interval without dropping selections", async () => {
+    vi.useFakeTimers();
+    document.body.innerHTML = `
+      <form data-formgen-auto-init="true">
+        <select id="article_tags" name="article[tag_ids]" multiple
+          data-endpoint-url="/api/tags"
+          data-endpoint-refresh-interval-ms="1000"
+          data-relationship-cardinality="many">
+        </select>
+      </form>
+    `;
+
+    fetchSpy
+      .mockResolvedValueOnce(mockResponse([{ value: "alpha", label: "Alpha" }, { value: "beta", label: "Beta" }]))
+      .mockResolvedValueOnce(mockResponse([{ value: "beta", label: "Beta" }, { value: "gamma", label: "Gamma" }]));
+
+    const registry = await initRelationships();
+    const tags = document.getElementById("article_tags") as HTMLSelectElement;
+    expect(datasetToFieldConfig(tags, tags.dataset as Record<string, string>).refreshIntervalMs).toBe(1000);
+
+    tags.options[0].selected = true;
+
+    await vi.advanceTimersByTimeAsync(1000);
+    await flush();
+
+    expect(fetchSpy).toHaveBeenCalledTimes(2);
+    expect(Array.from(tags.options).map((option) => option.value)).toEqual(["alpha", "beta", "gamma"]);
+    expect(Array.from(tags.selectedOptions).map((option) => option.value)).toEqual(["alpha"]);
+
+    registry.destroy();
+    await vi.advanceTimersByTimeAsync(2000);
+    expect(fetchSpy).toHaveBeenCalledTimes(2);
+
+    vi.useRealTimers();
+  });
+
   it("throttles search mode requests", async () => {
     vi.useFakeTimers();
     document.body.innerHTML = `
@@ -1762,6 +1843,40 @@ describe("runtime resolver", () => {
       expect(changes).toHaveBeenCalledTimes(1);
     });
 
+    it("routes server validation failures through the rendered error mapping", () => {
+      const mapping = JSON.stringify([
+        { statuses: ["422"], shape: "list", path: "errors", fieldKey: "source.pointer", messageKey: "detail" },
+        { statuses: ["4XX"], shape: "map", path: "errors", formMessageKey: "detail" },
+      ]);
+      document.body.innerHTML = `
+        <form id="root" data-formgen-error-mapping='${mapping}'>
+          <input name="article[title]" value="" />
+          <input name="article[slug]" value="" />
+        </form>
+      `;
+
+      const controller = attachFormController(document.getElementById("root")!);
+      const title = document.querySelector<HTMLInputElement>('input[name="article[title]"]')!;
+      const slug = document.querySelector<HTMLInputElement>('input[name="article[slug]"]')!;
+
+      const listed = controller.applyServerErrors(422, {
+        errors: [
+          { source: { pointer: "/data/attributes/article/title" }, detail: "is required" },
+          { detail: "Try again later" },
+        ],
+      });
+      expect(listed).toEqual({ fields: { "article.title": ["is required"] }, form: ["Try again later"] });
+      expect(title.getAttribute("data-validation-state")).toBe("invalid");
+
+      const mapped = controller.applyServerErrors(400, { errors: { "article[slug]": ["taken"] } });
+      expect(mapped?.fields).toEqual({ "article.slug": ["taken"] });
+      expect(slug.getAttribute("data-validation-state")).toBe("invalid");
+
+      expect(controller.applyServerErrors(400, { detail: "Bad request" })).toEqual({ fields: {}, form: ["Bad request"] });
+      expect(controller.applyServerErrors(500, { errors: {} })).toBeNull();
+      controller.destroy();
+    });
+
     it("preserves relationship multi-select arrays instead of collecting native submission mirrors", async () => {
       document.body.innerHTML = `
         <div id="root" data-formgen-render-mode="fields">
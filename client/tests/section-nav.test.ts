@@ -0,0 +1,55 @@
+import { describe, it, expect, afterEach, vi } from "vitest";
+import { activeSectionIndex, initSectionNav } from "../src/section-nav";
+
+describe("activeSectionIndex", () => {
+  it("picks the last section scrolled past the offset", () => {
+    expect(activeSectionIndex([200, 900, 1600], 96)).toBe(0);
+    expect(activeSectionIndex([-400, 40, 700], 96)).toBe(1);
+    expect(activeSectionIndex([-1200, -500, 10], 96)).toBe(2);
+  });
+});
+
+describe("initSectionNav", () => {
+  afterEach(() => {
+    document.body.innerHTML = "";
+    vi.restoreAllMocks();
+  });
+
+  function mockTop(id: string, top: number) {
+    const element = document.getElementById(id)!;
+    vi.spyOn(element, "getBoundingClientRect").mockReturnValue({ top } as DOMRect);
+  }
+
+  it("marks the section in view as current", () => {
+    document.body.innerHTML = `
+      <form>
+        <nav data-formgen-section-nav>
+          <a href="#fg-section-general" data-formgen-section-link="general">General</a>
+          <a href="#fg-section-billing" data-formgen-section-link="billing">Billing</a>
+        </nav>
+        <section id="fg-section-general"></section>
+        <section id="fg-section-billing"></section>
+      </form>
+    `;
+    mockTop("fg-section-general", -300);
+    mockTop("fg-section-billing", 20);
+
+    const cleanup = initSectionNav();
+    const links = document.querySelectorAll("a");
+    expect(links[0].hasAttribute("aria-current")).toBe(false);
+    expect(links[1].getAttribute("aria-current")).toBe("true");
+
+    cleanup();
+    expect(document.querySelector("nav")!.hasAttribute("data-section-nav-bound")).toBe(false);
+  });
+
+  it("ignores links without a matching section", () => {
+    document.body.innerHTML = `
+      <nav data-formgen-section-nav>
+        <a href="#missing" data-formgen-section-link="missing">Missing</a>
+      </nav>
+    `;
+    initSectionNav();
+    expect(document.querySelector("nav")!.hasAttribute("data-section-nav-bound")).toBe(false);
+  });
+});
@@ -0,0 +1,53 @@
+import { describe, it, expect, afterEach } from "vitest";
+import { initValidation, validateValue } from "../src/validate";
+
+describe("validateValue", () => {
+  it("applies required, length, range, and pattern rules", () => {
+    expect(validateValue({ label: "Handle", required: true }, "")?.code).toBe("required");
+    expect(validateValue({ label: "Handle" }, "")).toBeNull();
+    expect(validateValue({ label: "Handle", minLength: 3 }, "ab")?.code).toBe("minLength");
+    expect(validateValue({ label: "Handle", pattern: "^[a-z]+$" }, "Ab")?.message).toBe(
+      "Handle has an invalid format."
+    );
+    expect(validateValue({ label: "Age", min: 0, exclusiveMin: true }, "0")?.code).toBe("min");
+    expect(validateValue({ label: "Age", max: 10 }, "10")).toBeNull();
+    expect(validateValue({ label: "Tags", minItems: 2 }, ["a"])?.code).toBe("minItems");
+  });
+});
+
+describe("initValidation", () => {
+  afterEach(() => {
+    document.body.innerHTML = "";
+  });
+
+  it("validates on blur and blocks invalid submits", () => {
+    document.body.innerHTML = `
+      <form>
+        <div data-component="input">
+          <input name="handle" data-validate='{"label":"Handle","required":true,"minLength":3}' />
+        </div>
+        <button type="submit">Save</button>
+      </form>
+    `;
+    initValidation();
+    const form = document.querySelector("form")!;
+    const input = form.querySelector<HTMLInputElement>("input")!;
+
+    input.value = "ab";
+    input.dispatchEvent(new FocusEvent("focusout", { bubbles: true }));
+    expect(input.getAttribute("aria-invalid")).toBe("true");
+    expect(form.textContent).toContain("Handle must be at least 3 characters.");
+
+    const submit = new Event("submit", { cancelable: true });
+    form.dispatchEvent(submit);
+    expect(submit.defaultPrevented).toBe(true);
+
+    input.value = "abc";
+    input.dispatchEvent(new Event("change", { bubbles: true }));
+    expect(input.hasAttribute("aria-invalid")).toBe(false);
+
+    const valid = new Event("submit", { cancelable: true });
+    form.dispatchEvent(valid);
+    expect(valid.defaultPrevented).toBe(false);
+  });
+});
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/renderers/tui"
+)
+
+const benchCommand = "bench"
+
+var benchStages = []string{
+	orchestrator.StageLoad,
+	orchestrator.StageParse,
+	orchestrator.StageBuild,
+	orchestrator.StageDecorate,
+	orchestrator.StageRender,
+}
+
+type benchStage struct {
+	Stage   string  `json:"stage"`
+	TotalNs int64   `json:"totalNs"`
+	NsPerOp int64   `json:"nsPerOp"`
+	Share   float64 `json:"share"`
+}
+
+type benchField struct {
+	Path      string `json:"path"`
+	Component string `json:"component"`
+	NsPerOp   int64  `json:"nsPerOp"`
+}
+
+type benchReport struct {
+	Operation   string       `json:"operation"`
+	Renderer    string       `json:"renderer"`
+	Iterations  int          `json:"iterations"`
+	TotalNs     int64        `json:"totalNs"`
+	NsPerOp     int64        `json:"nsPerOp"`
+	AllocsPerOp uint64       `json:"allocsPerOp"`
+	BytesPerOp  uint64       `json:"bytesPerOp"`
+	OutputBytes int          `json:"outputBytes"`
+	Stages      []benchStage `json:"stages"`
+	Fields      []benchField `json:"fields,omitempty"`
+}
+
+// runBench implements `formgen-cli bench`, which runs the full generate
+// pipeline n times and reports per-op cost plus a per-stage breakdown. Every
+// iteration reloads the source, so a large load share suggests caching the
+// parsed document.
+func runBench(args []string) {
+	flags := flag.NewFlagSet(benchCommand, flag.ExitOnError)
+	opID := flags.String("operation", "", "operation ID to benchmark (required)")
+	source := flags.String("source", "client/data/schema.json", "OpenAPI document path or URL")
+	rendererName := flags.String("renderer", "vanilla", "renderer to benchmark (vanilla, preact, react)")
+	iterations := flags.Int("n", 100, "number of iterations")
+	format := flags.String("format", "text", "report format (text, json)")
+	slowFields := flags.Int("fields", 0, "also report the N slowest fields by render time (vanilla only)")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "parse flags: %v\n", err)
+		os.Exit(2)
+	}
+	if *opID == "" || *iterations <= 0 || (*format != "text" && *format != "json") {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if *rendererName == "tui" {
+		fmt.Fprintln(os.Stderr, "the tui renderer is interactive and cannot be benchmarked")
+		os.Exit(2)
+	}
+
+	src := parseSource(*source)
+	if src == nil {
+		fmt.Fprintf(os.Stderr, "invalid source: %q\n", *source)
+		os.Exit(2)
+	}
+
+	registry, err := buildRegistry(*rendererName, tui.OutputFormatJSON, true, "")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if !registry.Has(*rendererName) {
+		fmt.Fprintf(os.Stderr, "renderer %q not registered (available: %v)\n", *rendererName, registry.List())
+		os.Exit(2)
+	}
+
+	stageTotals := make(map[string]time.Duration, len(benchStages))
+	fieldTotals := make(map[string]benchFieldTotal)
+	options := []orchestrator.Option{
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithStageObserver(func(_ context.Context, stage string, elapsed time.Duration) {
+			stageTotals[stage] += elapsed
+		}),
+	}
+	if *slowFields > 0 {
+		options = append(options, orchestrator.WithFieldTimings(func(_ context.Context, report orchestrator.FieldTimingReport) {
+			for _, field := range report.Fields {
+				total := fieldTotals[field.Path]
+				total.component = field.Component
+				total.elapsed += field.Duration
+				fieldTotals[field.Path] = total
+			}
+		}, false))
+	}
+	gen := orchestrator.New(options...)
+	req := orchestrator.Request{Source: src, OperationID: *opID, Renderer: *rendererName}
+	ctx := context.Background()
+
+	// Warm up once so one-off initialisation does not skew the numbers, and
+	// fail fast on configuration errors.
+	output, err := gen.Generate(ctx, req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate form: %v\n", err)
+		os.Exit(1)
+	}
+	clear(stageTotals)
+	clear(fieldTotals)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for range *iterations {
+		if _, err := gen.Generate(ctx, req); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to generate form: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	total := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	n := int64(*iterations)
+	report := benchReport{
+		Operation:   *opID,
+		Renderer:    *rendererName,
+		Iterations:  *iterations,
+		TotalNs:     total.Nanoseconds(),
+		NsPerOp:     total.Nanoseconds() / n,
+		AllocsPerOp: (after.Mallocs - before.Mallocs) / uint64(n),
+		BytesPerOp:  (after.TotalAlloc - before.TotalAlloc) / uint64(n),
+		OutputBytes: len(output),
+	}
+	for _, stage := range benchStages {
+		elapsed := stageTotals[stage]
+		entry := benchStage{Stage: stage, TotalNs: elapsed.Nanoseconds(), NsPerOp: elapsed.Nanoseconds() / n}
+		if total > 0 {
+			entry.Share = float64(elapsed) / float64(total)
+		}
+		report.Stages = append(report.Stages, entry)
+	}
+
+	report.Fields = slowestBenchFields(fieldTotals, n, *slowFields)
+
+	if *format == "json" {
+		encoded, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(encoded))
+		return
+	}
+	printBenchReport(os.Stdout, report)
+}
+
+func printBenchReport(w io.Writer, report benchReport) {
+	fmt.Fprintf(w, "%s (%s), %d iterations in %s\n", report.Operation, report.Renderer, report.Iterations, time.Duration(report.TotalNs))
+	fmt.Fprintf(w, "%d ns/op\t%d B/op\t%d allocs/op\t%d output bytes\n\n", report.NsPerOp, report.BytesPerOp, report.AllocsPerOp, report.OutputBytes)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "stage\tns/op\tshare")
+	for _, stage := range report.Stages {
+		fmt.Fprintf(tw, "%s\t%d\t%.1f%%\n", stage.Stage, stage.NsPerOp, stage.Share*100)
+	}
+	_ = tw.Flush()
+
+	if len(report.Fields) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "field\tcomponent\tns/op")
+	for _, field := range report.Fields {
+		fmt.Fprintf(tw, "%s\t%s\t%d\n", field.Path, field.Component, field.NsPerOp)
+	}
+	_ = tw.Flush()
+}
+
+type benchFieldTotal struct {
+	component string
+	elapsed   time.Duration
+}
+
+// slowestBenchFields averages the collected field timings over n iterations
+// and keeps the limit slowest.
+func slowestBenchFields(totals map[string]benchFieldTotal, n int64, limit int) []benchField {
+	if limit <= 0 || len(totals) == 0 {
+		return nil
+	}
+	fields := make([]benchField, 0, len(totals))
+	for path, total := range totals {
+		fields = append(fields, benchField{Path: path, Component: total.component, NsPerOp: total.elapsed.Nanoseconds() / n})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].NsPerOp != fields[j].NsPerOp {
+			return fields[i].NsPerOp > fields[j].NsPerOp
+		}
+		return fields[i].Path < fields[j].Path
+	})
+	if len(fields) > limit {
+		fields = fields[:limit]
+	}
+	return fields
+}
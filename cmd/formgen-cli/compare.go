@@ -0,0 +1,451 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html"
+	"html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/renderers/preact"
+	"github.com/goliatone/go-formgen/pkg/renderers/react"
+	"github.com/goliatone/go-formgen/pkg/renderers/tui"
+)
+
+const compareCommand = "compare"
+
+var (
+	controlTagPattern   = regexp.MustCompile(`(?is)<(input|select|textarea)\b(.*?)>`)
+	attributePattern    = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+))?`)
+	jsonScriptPattern   = regexp.MustCompile(`(?is)<script\b[^>]*type="application/json"[^>]*>(.*?)</script>`)
+	controlIndexPattern = regexp.MustCompile(`\[[^\]]*\]`)
+	emptyOptionPattern  = regexp.MustCompile(`(?is)<option\b[^>]*\bvalue=""[^>]*>(.*?)</option>`)
+)
+
+// consumedField is the renderer-neutral view of what a renderer used from a
+// model field: markup attributes for HTML renderers, the hydration payload for
+// client-side ones.
+type consumedField struct {
+	Required    bool     `json:"required"`
+	Label       string   `json:"label,omitempty"`
+	Placeholder string   `json:"placeholder,omitempty"`
+	Validations []string `json:"validations,omitempty"`
+}
+
+type compareDifference struct {
+	Field    string         `json:"field"`
+	Property string         `json:"property"`
+	Values   map[string]any `json:"values"`
+}
+
+type compareReport struct {
+	Operation   string                              `json:"operation"`
+	Renderers   []string                            `json:"renderers"`
+	Consumed    map[string]map[string]consumedField `json:"consumed"`
+	Differences []compareDifference                 `json:"differences"`
+}
+
+type comparePanel struct {
+	Renderer string
+	Output   string
+}
+
+// runCompare implements `formgen-cli compare`, which renders one operation
+// with several renderers and writes an HTML page showing the outputs side by
+// side plus a JSON diff of the fields each renderer consumed. It exists to
+// make renderer parity regressions visible during development.
+func runCompare(args []string) {
+	flags := flag.NewFlagSet(compareCommand, flag.ExitOnError)
+	opID := flags.String("operation", "", "operation ID to compare (required)")
+	source := flags.String("source", "client/data/schema.json", "OpenAPI document path or URL")
+	rendererList := flags.String("renderers", "vanilla,preact", "comma-separated renderers to compare")
+	output := flags.String("output", "compare.html", "HTML comparison page; renderer assets are copied next to it")
+	diffOutput := flags.String("diff", "", "write the JSON diff to this file (stdout if empty)")
+	uiSchemaDir := flags.String("ui-schema", "", "UI schema directory (defaults to the embedded UI schemas)")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "parse flags: %v\n", err)
+		os.Exit(2)
+	}
+	renderers := splitList(*rendererList)
+	if *opID == "" || len(renderers) < 2 || *output == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if slices.Contains(renderers, "tui") {
+		fmt.Fprintln(os.Stderr, "the tui renderer is interactive and cannot be compared")
+		os.Exit(2)
+	}
+
+	src := parseSource(*source)
+	if src == nil {
+		fmt.Fprintf(os.Stderr, "invalid source: %q\n", *source)
+		os.Exit(2)
+	}
+	registry, err := buildRegistry(renderers[0], tui.OutputFormatJSON, true, "")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	for _, name := range renderers {
+		if !registry.Has(name) {
+			fmt.Fprintf(os.Stderr, "renderer %q not registered (available: %v)\n", name, registry.List())
+			os.Exit(2)
+		}
+	}
+
+	options := []orchestrator.Option{orchestrator.WithRegistry(registry)}
+	if *uiSchemaDir != "" {
+		options = append(options, orchestrator.WithUISchemaFS(os.DirFS(*uiSchemaDir)))
+	}
+	gen := orchestrator.New(options...)
+	ctx := context.Background()
+	doc, err := gen.LoadDocument(ctx, src)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load source: %v\n", err)
+		os.Exit(1)
+	}
+
+	report := compareReport{Operation: *opID, Renderers: renderers, Consumed: map[string]map[string]consumedField{}}
+	panels := make([]comparePanel, 0, len(renderers))
+	for _, name := range renderers {
+		rendered, err := gen.Generate(ctx, orchestrator.Request{Document: &doc, OperationID: *opID, Renderer: name})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to generate form with %s: %v\n", name, err)
+			os.Exit(1)
+		}
+		report.Consumed[name] = consumedFields(rendered)
+		panels = append(panels, comparePanel{Renderer: name, Output: string(rendered)})
+	}
+	report.Differences = diffConsumed(renderers, report.Consumed)
+
+	encoded, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to encode diff: %v\n", err)
+		os.Exit(1)
+	}
+	if err := writeComparePage(*output, report, panels, string(encoded)); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write comparison page: %v\n", err)
+		os.Exit(1)
+	}
+	if *diffOutput != "" {
+		if err := safefile.WriteFile(*diffOutput, append(encoded, '\n')); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write diff: %v\n", err)
+			os.Exit(1)
+		}
+	} else {
+		fmt.Println(string(encoded))
+	}
+	fmt.Fprintf(os.Stderr, "Comparison written to %s (%d differences)\n", *output, len(report.Differences))
+}
+
+// consumedFields extracts fields from rendered output. Named form controls
+// win; outputs without controls (client-rendered bundles) fall back to the
+// embedded JSON form payload.
+func consumedFields(output []byte) map[string]consumedField {
+	fields := map[string]consumedField{}
+	for _, loc := range controlTagPattern.FindAllSubmatchIndex(output, -1) {
+		attrs := parseAttributes(string(output[loc[4]:loc[5]]))
+		name := attrs["name"]
+		if name == "" || strings.EqualFold(attrs["type"], "hidden") {
+			continue
+		}
+		path := strings.TrimSuffix(controlIndexPattern.ReplaceAllString(name, "[]"), "[]")
+		field := fields[path]
+		_, required := attrs["required"]
+		field.Required = field.Required || required
+		field.Label = firstNonEmpty(field.Label, attrs["data-validation-label"])
+		// Component widgets (wysiwyg, relationship selects) carry the
+		// placeholder as data-placeholder for their runtime.
+		field.Placeholder = firstNonEmpty(field.Placeholder, attrs["placeholder"], attrs["data-placeholder"])
+		if strings.EqualFold(string(output[loc[2]:loc[3]]), "select") {
+			field.Placeholder = firstNonEmpty(field.Placeholder, selectPlaceholder(output[loc[1]:]))
+		}
+		if field.Validations == nil {
+			field.Validations = decodeValidationRules(attrs["data-validation-rules"])
+		}
+		fields[path] = field
+	}
+	if len(fields) > 0 {
+		return fields
+	}
+
+	for _, match := range jsonScriptPattern.FindAllSubmatch(output, -1) {
+		var payload struct {
+			Fields []payloadField `json:"fields"`
+		}
+		if json.Unmarshal(match[1], &payload) != nil || len(payload.Fields) == 0 {
+			continue
+		}
+		collectPayloadFields(fields, "", payload.Fields)
+		break
+	}
+	return fields
+}
+
+// selectPlaceholder returns the text of the empty-value option that opens a
+// select body, if any.
+func selectPlaceholder(rest []byte) string {
+	if end := bytes.Index(bytes.ToLower(rest), []byte("</select>")); end >= 0 {
+		rest = rest[:end]
+	}
+	match := emptyOptionPattern.FindSubmatch(rest)
+	if match == nil {
+		return ""
+	}
+	return strings.TrimSpace(html.UnescapeString(string(match[1])))
+}
+
+type payloadField struct {
+	Name        string           `json:"name"`
+	Required    bool             `json:"required"`
+	Label       string           `json:"label"`
+	Placeholder string           `json:"placeholder"`
+	Validations []validationRule `json:"validations"`
+	Nested      []payloadField   `json:"nested"`
+	Items       *payloadField    `json:"items"`
+}
+
+type validationRule struct {
+	Kind   string            `json:"kind"`
+	Params map[string]string `json:"params"`
+}
+
+func collectPayloadFields(out map[string]consumedField, prefix string, fields []payloadField) {
+	for _, field := range fields {
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+		switch {
+		case len(field.Nested) > 0:
+			collectPayloadFields(out, path, field.Nested)
+		case field.Items != nil && len(field.Items.Nested) > 0:
+			collectPayloadFields(out, path+"[]", field.Items.Nested)
+		default:
+			out[path] = consumedField{
+				Required:    field.Required,
+				Label:       field.Label,
+				Placeholder: field.Placeholder,
+				Validations: formatValidationRules(field.Validations),
+			}
+		}
+	}
+}
+
+func decodeValidationRules(raw string) []string {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	var rules []validationRule
+	if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+		return nil
+	}
+	return formatValidationRules(rules)
+}
+
+func formatValidationRules(rules []validationRule) []string {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		value := rule.Params["value"]
+		if value == "" {
+			value = rule.Params["pattern"]
+		}
+		out = append(out, rule.Kind+"="+value)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func parseAttributes(raw string) map[string]string {
+	attrs := map[string]string{}
+	for _, match := range attributePattern.FindAllStringSubmatch(raw, -1) {
+		name := strings.ToLower(match[1])
+		if _, seen := attrs[name]; seen {
+			continue
+		}
+		value := match[2]
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') {
+			value = value[1 : len(value)-1]
+		}
+		attrs[name] = html.UnescapeString(value)
+	}
+	return attrs
+}
+
+// diffConsumed lists, per field and property, the renderers that disagree.
+func diffConsumed(renderers []string, consumed map[string]map[string]consumedField) []compareDifference {
+	pathSet := map[string]struct{}{}
+	for _, fields := range consumed {
+		for path := range fields {
+			pathSet[path] = struct{}{}
+		}
+	}
+	paths := make([]string, 0, len(pathSet))
+	for path := range pathSet {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	differences := []compareDifference{}
+	for _, path := range paths {
+		present := map[string]any{}
+		missing := false
+		for _, name := range renderers {
+			_, ok := consumed[name][path]
+			present[name] = ok
+			missing = missing || !ok
+		}
+		if missing {
+			differences = append(differences, compareDifference{Field: path, Property: "present", Values: present})
+			continue
+		}
+		properties := []struct {
+			name  string
+			value func(consumedField) any
+		}{
+			{"required", func(f consumedField) any { return f.Required }},
+			{"label", func(f consumedField) any { return f.Label }},
+			{"placeholder", func(f consumedField) any { return f.Placeholder }},
+			{"validations", func(f consumedField) any { return f.Validations }},
+		}
+		for _, property := range properties {
+			values := map[string]any{}
+			var first any
+			differs := false
+			for idx, name := range renderers {
+				value := property.value(consumed[name][path])
+				values[name] = value
+				if idx == 0 {
+					first = value
+				} else if !reflect.DeepEqual(first, value) {
+					differs = true
+				}
+			}
+			if differs {
+				differences = append(differences, compareDifference{Field: path, Property: property.name, Values: values})
+			}
+		}
+	}
+	return differences
+}
+
+var comparePageTemplate = template.Must(template.New("compare").Parse(`<!doctype html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>formgen compare: {{ .Report.Operation }}</title>
+  <style>
+    body { font-family: system-ui, sans-serif; margin: 1.5rem; }
+    .panels { display: grid; grid-template-columns: repeat({{ len .Panels }}, minmax(0, 1fr)); gap: 1rem; }
+    iframe { width: 100%; height: 70vh; border: 1px solid #d1d5db; border-radius: 0.5rem; }
+    table { border-collapse: collapse; margin-top: 1rem; }
+    th, td { border: 1px solid #d1d5db; padding: 0.25rem 0.5rem; text-align: left; vertical-align: top; font-size: 0.875rem; }
+    pre { background: #f3f4f6; padding: 1rem; overflow: auto; }
+  </style>
+</head>
+<body>
+  <h1>{{ .Report.Operation }}</h1>
+  <div class="panels">
+    {{- range .Panels }}
+    <section>
+      <h2>{{ .Renderer }}</h2>
+      <iframe title="{{ .Renderer }} output" srcdoc="{{ .Output }}"></iframe>
+    </section>
+    {{- end }}
+  </div>
+  <h2>Differences ({{ len .Report.Differences }})</h2>
+  {{- if .Report.Differences }}
+  <table>
+    <tr><th>Field</th><th>Property</th>{{ range .Report.Renderers }}<th>{{ . }}</th>{{ end }}</tr>
+    {{- range $diff := .Report.Differences }}
+    <tr><td>{{ $diff.Field }}</td><td>{{ $diff.Property }}</td>{{ range $.Report.Renderers }}<td>{{ index $diff.Values . }}</td>{{ end }}</tr>
+    {{- end }}
+  </table>
+  {{- else }}
+  <p>All renderers consumed the same fields.</p>
+  {{- end }}
+  <details>
+    <summary>JSON diff</summary>
+    <pre>{{ .JSON }}</pre>
+  </details>
+</body>
+</html>
+`))
+
+func writeComparePage(path string, report compareReport, panels []comparePanel, encoded string) error {
+	var buf bytes.Buffer
+	if err := comparePageTemplate.Execute(&buf, map[string]any{"Report": report, "Panels": panels, "JSON": encoded}); err != nil {
+		return err
+	}
+	if err := safefile.WriteFile(path, buf.Bytes()); err != nil {
+		return err
+	}
+	// Client-rendered outputs reference their bundles relative to the page.
+	assetsDir := filepath.Join(filepath.Dir(path), "assets")
+	for _, name := range report.Renderers {
+		var assets fs.FS
+		switch name {
+		case "preact":
+			assets = preact.AssetsFS()
+		case "react":
+			assets = react.AssetsFS()
+		default:
+			continue
+		}
+		if err := copyEmbeddedAssets(assets, assetsDir); err != nil {
+			return fmt.Errorf("copy %s assets: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func copyEmbeddedAssets(store fs.FS, dest string) error {
+	return fs.WalkDir(store, "assets", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, strings.TrimPrefix(strings.TrimPrefix(path, "assets"), "/"))
+		if d.IsDir() {
+			return safefile.MkdirAll(target)
+		}
+		data, err := fs.ReadFile(store, path)
+		if err != nil {
+			return err
+		}
+		return safefile.WriteFile(target, data)
+	})
+}
+
+func splitList(raw string) []string {
+	var out []string
+	for _, entry := range strings.Split(raw, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" && !slices.Contains(out, entry) {
+			out = append(out, entry)
+		}
+	}
+	return out
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
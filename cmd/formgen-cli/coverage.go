@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+)
+
+const coverageCommand = "coverage"
+
+// coverageLocationLimit caps the locations listed per keyword in text output;
+// the JSON report always carries all of them.
+const coverageLocationLimit = 5
+
+// runCoverage implements `formgen-cli coverage`, which builds every operation
+// in the source document and reports supported, unsupported, and ignored
+// schema keywords. It exits 1 when any operation fails to build.
+func runCoverage(args []string) {
+	flags := flag.NewFlagSet(coverageCommand, flag.ExitOnError)
+	source := flags.String("source", "client/data/schema.json", "OpenAPI document path or URL")
+	format := flags.String("format", "text", "report format (text, json)")
+	namespaces := flags.String("namespaces", "", "comma-separated extra extension namespaces treated as supported")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "parse flags: %v\n", err)
+		os.Exit(2)
+	}
+	if *format != "text" && *format != "json" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	src := parseSource(*source)
+	if src == nil {
+		fmt.Fprintf(os.Stderr, "invalid source: %q\n", *source)
+		os.Exit(2)
+	}
+
+	var options []pkgopenapi.CoverageOption
+	if *namespaces != "" {
+		options = append(options, pkgopenapi.WithCoverageExtensionNamespaces(strings.Split(*namespaces, ",")...))
+	}
+	report, err := orchestrator.New().Coverage(context.Background(), orchestrator.BuildRequest{Source: src}, options...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to compute coverage: %v\n", err)
+		os.Exit(3)
+	}
+
+	if *format == "json" {
+		encoded, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode report: %v\n", err)
+			os.Exit(3)
+		}
+		fmt.Println(string(encoded))
+	} else {
+		printCoverageReport(os.Stdout, report)
+	}
+
+	if len(report.Failures) > 0 {
+		os.Exit(1)
+	}
+}
+
+func printCoverageReport(w io.Writer, report pkgopenapi.CoverageReport) {
+	fmt.Fprintf(w, "operations: %d\n", report.Operations)
+	for _, status := range []pkgopenapi.KeywordStatus{pkgopenapi.KeywordSupported, pkgopenapi.KeywordUnsupported, pkgopenapi.KeywordIgnored} {
+		keywords := report.ByStatus(status)
+		fmt.Fprintf(w, "\n%s (%d)\n", status, len(keywords))
+		for _, keyword := range keywords {
+			fmt.Fprintf(w, "  %-24s %d\n", keyword.Keyword, keyword.Count)
+			if status == pkgopenapi.KeywordSupported {
+				continue
+			}
+			for i, location := range keyword.Locations {
+				if i == coverageLocationLimit {
+					fmt.Fprintf(w, "    ... %d more\n", len(keyword.Locations)-i)
+					break
+				}
+				fmt.Fprintf(w, "    %s\n", location)
+			}
+		}
+	}
+	if len(report.Failures) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(report.Failures))
+	for id := range report.Failures {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	fmt.Fprintf(w, "\nfailed operations (%d)\n", len(ids))
+	for _, id := range ids {
+		fmt.Fprintf(w, "  %s: %s\n", id, report.Failures[id])
+	}
+}
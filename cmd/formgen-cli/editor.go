@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/renderers/tui"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+)
+
+const editorCommand = "ui-editor"
+
+// runUIEditor implements `formgen-cli ui-editor`, an interactive prompt flow
+// that edits a scaffolded UI schema for one operation and writes it to disk.
+func runUIEditor(args []string) {
+	flags := flag.NewFlagSet(editorCommand, flag.ExitOnError)
+	opID := flags.String("operation", "", "operation ID to edit (required)")
+	source := flags.String("source", "client/data/schema.json", "OpenAPI document path or URL")
+	output := flags.String("output", "", "UI schema file to write (required)")
+	format := flags.String("format", "", "output format (yaml, json); defaults to the output extension")
+	if err := flags.Parse(args); err != nil {
+		log.Fatalf("parse flags: %v", err)
+	}
+	if *opID == "" || *output == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	src := parseSource(*source)
+	if src == nil {
+		log.Fatalf("invalid source: %q", *source)
+	}
+
+	ctx := context.Background()
+	form, err := orchestrator.New().BuildFormModel(ctx, orchestrator.BuildRequest{
+		Source:      src,
+		OperationID: *opID,
+	})
+	if err != nil {
+		log.Fatalf("Failed to build form model: %v", err)
+	}
+
+	driver, err := tui.NewSurveyDriver()
+	if err != nil {
+		log.Fatalf("Failed to start prompts: %v", err)
+	}
+	doc, err := tui.EditUISchema(ctx, driver, form, uischema.Scaffold(form))
+	if errors.Is(err, tui.ErrAborted) {
+		fmt.Println("UI schema discarded")
+		return
+	}
+	if err != nil {
+		log.Fatalf("Failed to edit UI schema: %v", err)
+	}
+
+	outputFormat := *format
+	if outputFormat == "" {
+		outputFormat = strings.TrimPrefix(strings.ToLower(filepath.Ext(*output)), ".")
+	}
+	payload, err := doc.Marshal(outputFormat)
+	if err != nil {
+		log.Fatalf("Failed to encode UI schema: %v", err)
+	}
+	if err := safefile.WriteFile(*output, payload); err != nil {
+		log.Fatalf("Failed to write output: %v", err)
+	}
+	fmt.Printf("UI schema written to %s\n", *output)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/render/template/gotemplate"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+)
+
+const lintTemplatesCommand = "lint-templates"
+
+// runLintTemplates implements `formgen-cli lint-templates`, which checks a
+// custom vanilla template bundle with gotemplate.Lint. It exits 1 when the
+// bundle has errors (or warnings with --strict), 2 on usage errors, and 3 when
+// the directory cannot be read.
+func runLintTemplates(args []string) {
+	flags := flag.NewFlagSet(lintTemplatesCommand, flag.ExitOnError)
+	dir := flags.String("templates", "", "template bundle directory (required)")
+	extension := flags.String("ext", ".tmpl", "template file extension")
+	funcs := flags.String("funcs", "", "comma-separated template functions supplied at render time")
+	format := flags.String("format", "text", "report format (text, json)")
+	strict := flags.Bool("strict", false, "treat warnings as failures")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "parse flags: %v\n", err)
+		os.Exit(2)
+	}
+	if *dir == "" || (*format != "text" && *format != "json") {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var names []string
+	if *funcs != "" {
+		names = strings.Split(*funcs, ",")
+	}
+	report, err := gotemplate.Lint(os.DirFS(*dir), *extension, vanilla.TemplateLintOptions(names...)...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to lint templates: %v\n", err)
+		os.Exit(3)
+	}
+
+	if *format == "json" {
+		encoded, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode report: %v\n", err)
+			os.Exit(3)
+		}
+		fmt.Println(string(encoded))
+	} else {
+		printLintReport(os.Stdout, report)
+	}
+
+	if report.HasErrors() || (*strict && len(report.Issues) > 0) {
+		os.Exit(1)
+	}
+}
+
+func printLintReport(w io.Writer, report gotemplate.LintReport) {
+	for _, issue := range report.Issues {
+		fmt.Fprintln(w, issue)
+	}
+	fmt.Fprintf(w, "%d templates, %d issues\n", len(report.Templates), len(report.Issues))
+}
@@ -6,24 +6,74 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
 	"strings"
+	"time"
 
 	"github.com/goliatone/go-formgen/internal/safefile"
 	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
 	"github.com/goliatone/go-formgen/pkg/orchestrator"
 	"github.com/goliatone/go-formgen/pkg/render"
 	"github.com/goliatone/go-formgen/pkg/renderers/preact"
+	"github.com/goliatone/go-formgen/pkg/renderers/react"
 	"github.com/goliatone/go-formgen/pkg/renderers/tui"
 	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case scaffoldCommand:
+			runScaffoldUI(os.Args[2:])
+			return
+		case validateCommand:
+			runValidate(os.Args[2:])
+			return
+		case sampleCommand:
+			runSample(os.Args[2:])
+			return
+		case benchCommand:
+			runBench(os.Args[2:])
+			return
+		case editorCommand:
+			runUIEditor(os.Args[2:])
+			return
+		case compareCommand:
+			runCompare(os.Args[2:])
+			return
+		case coverageCommand:
+			runCoverage(os.Args[2:])
+			return
+		case siteCommand:
+			runSite(os.Args[2:])
+			return
+		case lintTemplatesCommand:
+			runLintTemplates(os.Args[2:])
+			return
+		case templateManifestCommand:
+			runTemplateManifest(os.Args[2:])
+			return
+		}
+	}
+
 	opID := flag.String("operation", "createArticle", "operation ID to render")
-	renderer := flag.String("renderer", "vanilla", "renderer to use (vanilla, preact, tui)")
+	renderer := flag.String("renderer", "vanilla", "renderer to use (vanilla, preact, react, tui)")
 	output := flag.String("output", "", "output file (stdout if empty)")
 	source := flag.String("source", "client/data/schema.json", "OpenAPI document path or URL")
-	tuiFormat := flag.String("tui-format", "json", "TUI output format (json, form, pretty)")
+	tuiFormat := flag.String("tui-format", "json", "TUI output format (json, form, pretty, envelope)")
 	tuiNoFetch := flag.Bool("tui-no-fetch", false, "Disable relationship HTTP fetches for TUI")
+	showStats := flag.Bool("stats", false, "print form model complexity stats instead of rendering")
+	budgetSpec := flag.String("budget", "", "complexity budget, e.g. fields=80,depth=4,relationships=10,size=65536")
+	overlaySpec := flag.String("overlay", "", "comma-separated OpenAPI Overlay files applied to the source, in order")
+	mediaType := flag.String("media-type", "", "request body media type to render when the operation declares several")
+	uiSchemaDir := flag.String("ui-schema", "", "UI schema directory (defaults to the embedded UI schemas)")
+	templatesDir := flag.String("templates", "", "vanilla templates directory (defaults to the embedded templates)")
+	watch := flag.Bool("watch", false, "regenerate whenever the source, UI schema directory, or templates directory changes")
+	watchInterval := flag.Duration("watch-interval", time.Second, "how often --watch polls for changes")
+	serve := flag.String("serve", "", "with --watch, serve the output on this address with live reload, e.g. :8080")
+	manifestPath := flag.String("manifest", "", "manifest file updated when --output is set (defaults to manifest.json next to the output)")
+	noManifest := flag.Bool("no-manifest", false, "do not write a manifest when --output is set")
 	flag.Parse()
 
 	ctx := context.Background()
@@ -33,21 +83,87 @@ func main() {
 		log.Fatalf("invalid source: %q", *source)
 	}
 
-	registry := buildRegistry(*renderer, parseTUIFormat(*tuiFormat), *tuiNoFetch)
-
-	if !registry.Has(*renderer) {
-		log.Fatalf("renderer %q not registered (available: %v)", *renderer, registry.List())
+	budget, err := parseBudget(*budgetSpec)
+	if err != nil {
+		log.Fatalf("invalid budget: %v", err)
+	}
+	if *watch && *showStats {
+		log.Fatalf("--watch cannot be combined with --stats")
+	}
+	if *serve != "" && !*watch {
+		log.Fatalf("--serve requires --watch")
+	}
+	warnings := &budgetWarningPrinter{}
+	// Generators are rebuilt for every watch cycle so template and UI schema
+	// edits are picked up.
+	newGenerator := func() (*orchestrator.Orchestrator, error) {
+		registry, err := buildRegistry(*renderer, parseTUIFormat(*tuiFormat), *tuiNoFetch, *templatesDir)
+		if err != nil {
+			return nil, err
+		}
+		if !registry.Has(*renderer) {
+			return nil, fmt.Errorf("renderer %q not registered (available: %v)", *renderer, registry.List())
+		}
+		options := []orchestrator.Option{
+			orchestrator.WithRegistry(registry),
+			orchestrator.WithComplexityBudget(budget, warnings.handle),
+			orchestrator.WithOverlays(parseOverlays(*overlaySpec)...),
+		}
+		if *uiSchemaDir != "" {
+			options = append(options, orchestrator.WithUISchemaFS(os.DirFS(*uiSchemaDir)))
+		}
+		return orchestrator.New(options...), nil
 	}
-
-	gen := orchestrator.New(orchestrator.WithRegistry(registry))
-
 	req := orchestrator.Request{
 		Source:      src,
 		OperationID: *opID,
 		Renderer:    *renderer,
+		MediaType:   *mediaType,
 	}
 
-	outputHTML, err := gen.Generate(ctx, req)
+	if *watch {
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+		err := runWatch(ctx, watchConfig{
+			source:       *source,
+			uiSchemaDir:  *uiSchemaDir,
+			templatesDir: *templatesDir,
+			output:       *output,
+			serveAddr:    *serve,
+			interval:     *watchInterval,
+		}, func(ctx context.Context) ([]byte, error) {
+			gen, err := newGenerator()
+			if err != nil {
+				return nil, err
+			}
+			return gen.Generate(ctx, req)
+		})
+		if err != nil {
+			log.Fatalf("Watch failed: %v", err)
+		}
+		return
+	}
+
+	gen, err := newGenerator()
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	if *showStats {
+		form, err := gen.BuildFormModel(ctx, orchestrator.BuildRequest{Source: src, OperationID: *opID, MediaType: *mediaType})
+		if err != nil {
+			log.Fatalf("Failed to build form model: %v", err)
+		}
+		if err := printStats(form); err != nil {
+			log.Fatalf("Failed to encode stats: %v", err)
+		}
+		if warnings.exceeded {
+			os.Exit(1)
+		}
+		return
+	}
+
+	outputHTML, manifest, err := gen.GenerateWithManifest(ctx, req)
 	if err != nil {
 		log.Fatalf("Failed to generate form: %v", err)
 	}
@@ -57,6 +173,13 @@ func main() {
 			log.Fatalf("Failed to write output: %v", err)
 		}
 		fmt.Printf("Form written to %s\n", *output)
+		if !*noManifest {
+			path, err := writeManifest(*manifestPath, *output, manifest)
+			if err != nil {
+				log.Fatalf("Failed to write manifest: %v", err)
+			}
+			fmt.Printf("Manifest written to %s\n", path)
+		}
 	} else {
 		fmt.Println(string(outputHTML))
 	}
@@ -73,25 +196,44 @@ func parseSource(raw string) pkgopenapi.Source {
 	return pkgopenapi.SourceFromFile(path)
 }
 
+func parseOverlays(raw string) []pkgopenapi.Source {
+	var sources []pkgopenapi.Source
+	for _, entry := range strings.Split(raw, ",") {
+		if src := parseSource(entry); src != nil {
+			sources = append(sources, src)
+		}
+	}
+	return sources
+}
+
 func parseTUIFormat(raw string) tui.OutputFormat {
 	switch strings.ToLower(strings.TrimSpace(raw)) {
 	case "form", "url", "urlencoded":
 		return tui.OutputFormatFormURLEncoded
 	case "pretty", "text":
 		return tui.OutputFormatPrettyText
+	case "envelope":
+		return tui.OutputFormatEnvelope
 	default:
 		return tui.OutputFormatJSON
 	}
 }
 
-func buildRegistry(targetRenderer string, format tui.OutputFormat, noFetch bool) *render.Registry {
+func buildRegistry(targetRenderer string, format tui.OutputFormat, noFetch bool, templatesDir string) (*render.Registry, error) {
 	registry := render.NewRegistry()
-	if vanillaRenderer, err := vanilla.New(); err == nil {
+	vanillaRenderer, err := vanilla.New(vanilla.WithTemplatesDir(templatesDir))
+	switch {
+	case err == nil:
 		registry.MustRegister(vanillaRenderer)
+	case targetRenderer == "vanilla":
+		return nil, fmt.Errorf("vanilla renderer: %w", err)
 	}
 	if preactRenderer, err := preact.New(); err == nil {
 		registry.MustRegister(preactRenderer)
 	}
+	if reactRenderer, err := react.New(); err == nil {
+		registry.MustRegister(reactRenderer)
+	}
 	tuiOpts := []tui.Option{tui.WithOutputFormat(format)}
 	if !noFetch {
 		tuiOpts = append(tuiOpts, tui.WithHTTPClient(http.DefaultClient))
@@ -99,5 +241,5 @@ func buildRegistry(targetRenderer string, format tui.OutputFormat, noFetch bool)
 	if tuiRenderer, err := tui.New(tuiOpts...); err == nil {
 		registry.MustRegister(tuiRenderer)
 	}
-	return registry
+	return registry, nil
 }
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+)
+
+const defaultManifestName = "manifest.json"
+
+// writeManifest records the run in the manifest at path (manifest.json next
+// to output when empty). Existing manifests are updated rather than replaced,
+// so several invocations writing into one directory share a manifest: outputs
+// are keyed by path, inputs by kind and location. Output paths are stored
+// relative to the manifest.
+func writeManifest(path, output string, manifest orchestrator.Manifest) (string, error) {
+	if path == "" {
+		path = filepath.Join(filepath.Dir(output), defaultManifestName)
+	}
+	rel, err := filepath.Rel(filepath.Dir(path), output)
+	if err != nil {
+		rel = output
+	}
+	for i := range manifest.Outputs {
+		manifest.Outputs[i].Path = filepath.ToSlash(rel)
+	}
+
+	existing, err := safefile.ReadFile(path)
+	switch {
+	case err == nil:
+		var previous orchestrator.Manifest
+		if err := json.Unmarshal(existing, &previous); err != nil {
+			return "", fmt.Errorf("decode %s: %w", path, err)
+		}
+		manifest = mergeManifests(previous, manifest)
+	case !errors.Is(err, fs.ErrNotExist):
+		return "", err
+	}
+
+	encoded, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := safefile.WriteFile(path, append(encoded, '\n')); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func mergeManifests(previous, next orchestrator.Manifest) orchestrator.Manifest {
+	merged := next
+	merged.Inputs = append([]orchestrator.ManifestInput(nil), next.Inputs...)
+	for _, input := range previous.Inputs {
+		if !hasManifestInput(next.Inputs, input) {
+			merged.Inputs = append(merged.Inputs, input)
+		}
+	}
+	merged.Renderers = make(map[string]string, len(previous.Renderers)+len(next.Renderers))
+	for name, version := range previous.Renderers {
+		merged.Renderers[name] = version
+	}
+	for name, version := range next.Renderers {
+		merged.Renderers[name] = version
+	}
+	merged.Outputs = append([]orchestrator.ManifestOutput(nil), next.Outputs...)
+	for _, output := range previous.Outputs {
+		if !hasManifestOutput(next.Outputs, output.Path) {
+			merged.Outputs = append(merged.Outputs, output)
+		}
+	}
+	sort.Slice(merged.Outputs, func(i, j int) bool { return merged.Outputs[i].Path < merged.Outputs[j].Path })
+	return merged
+}
+
+func hasManifestInput(inputs []orchestrator.ManifestInput, input orchestrator.ManifestInput) bool {
+	for _, candidate := range inputs {
+		if candidate.Kind == input.Kind && candidate.Location == input.Location {
+			return true
+		}
+	}
+	return false
+}
+
+func hasManifestOutput(outputs []orchestrator.ManifestOutput, path string) bool {
+	for _, candidate := range outputs {
+		if candidate.Path == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/exampledata"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+)
+
+const sampleCommand = "sample"
+
+// runSample implements `formgen-cli sample`, which prints an example request
+// body synthesized from the form model. The sample is checked with the same
+// validation as `formgen-cli validate`; constraints the generator cannot
+// satisfy (e.g. patterns) are reported on stderr.
+func runSample(args []string) {
+	flags := flag.NewFlagSet(sampleCommand, flag.ExitOnError)
+	opID := flags.String("operation", "", "operation ID to sample (required)")
+	source := flags.String("source", "client/data/schema.json", "OpenAPI document path or URL")
+	output := flags.String("output", "", "output file (stdout if empty)")
+	requiredOnly := flags.Bool("required-only", false, "only include required fields")
+	noDefaults := flags.Bool("no-defaults", false, "ignore schema defaults")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "parse flags: %v\n", err)
+		os.Exit(2)
+	}
+	if *opID == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	src := parseSource(*source)
+	if src == nil {
+		fmt.Fprintf(os.Stderr, "invalid source: %q\n", *source)
+		os.Exit(2)
+	}
+
+	form, err := orchestrator.New().BuildFormModel(context.Background(), orchestrator.BuildRequest{
+		Source:      src,
+		OperationID: *opID,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build form model: %v\n", err)
+		os.Exit(1)
+	}
+
+	var options []exampledata.Option
+	if *requiredOnly {
+		options = append(options, exampledata.WithRequiredOnly())
+	}
+	if *noDefaults {
+		options = append(options, exampledata.WithoutDefaults())
+	}
+
+	encoded, err := json.MarshalIndent(exampledata.Generate(form, options...), "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to encode sample: %v\n", err)
+		os.Exit(1)
+	}
+
+	record := validatePayload(bodyForm(form), encoded)
+	for _, issue := range record.Issues {
+		fmt.Fprintf(os.Stderr, "warning: %s: %s (%s)\n", issue.Path, issue.Message, issue.Code)
+	}
+
+	encoded = append(encoded, '\n')
+	if *output == "" {
+		_, _ = os.Stdout.Write(encoded)
+		return
+	}
+	if err := safefile.WriteFile(*output, encoded); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write output: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// bodyForm drops parameter-backed fields, which are not part of the request
+// body the sample represents.
+func bodyForm(form model.FormModel) model.FormModel {
+	fields := make([]model.Field, 0, len(form.Fields))
+	for _, field := range form.Fields {
+		if field.Location == "" {
+			fields = append(fields, field)
+		}
+	}
+	form.Fields = fields
+	return form
+}
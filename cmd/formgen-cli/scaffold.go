@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+)
+
+const scaffoldCommand = "scaffold-ui"
+
+// runScaffoldUI implements `formgen-cli scaffold-ui`, which writes a starter
+// UI schema file for one operation.
+func runScaffoldUI(args []string) {
+	flags := flag.NewFlagSet(scaffoldCommand, flag.ExitOnError)
+	opID := flags.String("operation", "", "operation ID to scaffold (required)")
+	source := flags.String("source", "client/data/schema.json", "OpenAPI document path or URL")
+	format := flags.String("format", uischema.ScaffoldFormatYAML, "output format (yaml, json)")
+	output := flags.String("output", "", "output file (stdout if empty)")
+	if err := flags.Parse(args); err != nil {
+		log.Fatalf("parse flags: %v", err)
+	}
+	if *opID == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	src := parseSource(*source)
+	if src == nil {
+		log.Fatalf("invalid source: %q", *source)
+	}
+
+	form, err := orchestrator.New().BuildFormModel(context.Background(), orchestrator.BuildRequest{
+		Source:      src,
+		OperationID: *opID,
+	})
+	if err != nil {
+		log.Fatalf("Failed to build form model: %v", err)
+	}
+
+	payload, err := uischema.Scaffold(form).Marshal(*format)
+	if err != nil {
+		log.Fatalf("Failed to scaffold UI schema: %v", err)
+	}
+
+	if *output != "" {
+		if err := safefile.WriteFile(*output, payload); err != nil {
+			log.Fatalf("Failed to write output: %v", err)
+		}
+		fmt.Printf("UI schema scaffold written to %s\n", *output)
+		return
+	}
+	fmt.Print(string(payload))
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen"
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/tui"
+)
+
+const (
+	siteCommand     = "site"
+	siteUntaggedTag = "Untagged"
+)
+
+var sitePageNamePattern = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// siteOperation is one catalog entry.
+type siteOperation struct {
+	ID          string
+	Page        string
+	Model       string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Form        string
+	Fields      []siteField
+	JSON        string
+}
+
+// siteField is a row of the per-operation field reference.
+type siteField struct {
+	Path        string
+	Type        string
+	Required    bool
+	Label       string
+	Description string
+}
+
+type siteTag struct {
+	Name       string
+	Operations []siteOperation
+}
+
+// runSite implements `formgen-cli site`, which writes a static form catalog:
+// an index grouped by OpenAPI tag and one page per operation showing the
+// vanilla form in preview mode, a field reference, and the JSON form model
+// (also written as <operation>.json).
+func runSite(args []string) {
+	flags := flag.NewFlagSet(siteCommand, flag.ExitOnError)
+	source := flags.String("source", "client/data/schema.json", "OpenAPI document path or URL")
+	out := flags.String("out", "docs/forms", "output directory")
+	title := flags.String("title", "Form catalog", "index page title")
+	uiSchemaDir := flags.String("ui-schema", "", "UI schema directory (defaults to the embedded UI schemas)")
+	overlaySpec := flags.String("overlay", "", "comma-separated OpenAPI Overlay files applied to the source, in order")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "parse flags: %v\n", err)
+		os.Exit(2)
+	}
+	if strings.TrimSpace(*out) == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	src := parseSource(*source)
+	if src == nil {
+		fmt.Fprintf(os.Stderr, "invalid source: %q\n", *source)
+		os.Exit(2)
+	}
+	registry, err := buildRegistry("vanilla", tui.OutputFormatJSON, true, "")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	options := []orchestrator.Option{
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithOverlays(parseOverlays(*overlaySpec)...),
+	}
+	if *uiSchemaDir != "" {
+		options = append(options, orchestrator.WithUISchemaFS(os.DirFS(*uiSchemaDir)))
+	}
+	gen := orchestrator.New(options...)
+
+	tags, err := buildSite(context.Background(), gen, src)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build site: %v\n", err)
+		os.Exit(1)
+	}
+	count, err := writeSite(*out, *title, tags)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write site: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Form catalog with %d operations written to %s\n", count, *out)
+}
+
+func buildSite(ctx context.Context, gen *orchestrator.Orchestrator, src pkgopenapi.Source) ([]siteTag, error) {
+	doc, err := gen.LoadDocument(ctx, src)
+	if err != nil {
+		return nil, fmt.Errorf("load source: %w", err)
+	}
+	operations, err := formgen.NewParser().Operations(ctx, doc)
+	if err != nil {
+		return nil, fmt.Errorf("parse source: %w", err)
+	}
+	forms, err := gen.GenerateAll(ctx, orchestrator.Request{
+		Document: &doc,
+		Renderer: "vanilla",
+		RenderOptions: render.RenderOptions{
+			Preview: &render.PreviewOptions{Message: "Catalog preview: submissions are disabled."},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(forms))
+	for id := range forms {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	byTag := map[string][]siteOperation{}
+	for _, id := range ids {
+		form, err := gen.BuildFormModel(ctx, orchestrator.BuildRequest{Document: &doc, OperationID: id})
+		if err != nil {
+			return nil, fmt.Errorf("build %q: %w", id, err)
+		}
+		encoded, err := json.MarshalIndent(form, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encode %q: %w", id, err)
+		}
+		op := operations[id]
+		name := sitePageName(id)
+		entry := siteOperation{
+			ID:          id,
+			Page:        name + ".html",
+			Model:       name + ".json",
+			Method:      strings.ToUpper(firstNonEmpty(op.Method, form.Method)),
+			Path:        firstNonEmpty(op.Path, form.Endpoint),
+			Summary:     firstNonEmpty(op.Summary, form.Summary),
+			Description: firstNonEmpty(op.Description, form.Description),
+			Form:        string(forms[id]),
+			Fields:      siteFields(nil, "", form.Fields),
+			JSON:        string(encoded),
+		}
+		tags := op.Tags
+		if len(tags) == 0 {
+			tags = []string{siteUntaggedTag}
+		}
+		for _, tag := range tags {
+			byTag[tag] = append(byTag[tag], entry)
+		}
+	}
+
+	names := make([]string, 0, len(byTag))
+	for name := range byTag {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		// Untagged operations are listed last.
+		if (names[i] == siteUntaggedTag) != (names[j] == siteUntaggedTag) {
+			return names[j] == siteUntaggedTag
+		}
+		return names[i] < names[j]
+	})
+	tags := make([]siteTag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, siteTag{Name: name, Operations: byTag[name]})
+	}
+	return tags, nil
+}
+
+// siteFields flattens the model into dotted paths; array items use "[]".
+func siteFields(out []siteField, prefix string, fields []model.Field) []siteField {
+	for _, field := range fields {
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+		out = append(out, siteField{
+			Path:        path,
+			Type:        string(field.Type),
+			Required:    field.Required,
+			Label:       field.Label,
+			Description: field.Description,
+		})
+		out = siteFields(out, path, field.Nested)
+		if field.Items != nil {
+			out = siteFields(out, path+"[]", field.Items.Nested)
+		}
+	}
+	return out
+}
+
+func sitePageName(operationID string) string {
+	name := strings.Trim(sitePageNamePattern.ReplaceAllString(operationID, "-"), "-")
+	if name == "" {
+		return "operation"
+	}
+	return name
+}
+
+// writeSite writes the index, operation pages, and model files. Operations
+// listed under several tags are written once.
+func writeSite(dir, title string, tags []siteTag) (int, error) {
+	var index bytes.Buffer
+	if err := siteIndexTemplate.Execute(&index, map[string]any{"Title": title, "Tags": tags}); err != nil {
+		return 0, err
+	}
+	if err := safefile.WriteFile(filepath.Join(dir, "index.html"), index.Bytes()); err != nil {
+		return 0, err
+	}
+	written := map[string]bool{}
+	for _, tag := range tags {
+		for _, op := range tag.Operations {
+			if written[op.ID] {
+				continue
+			}
+			written[op.ID] = true
+			var page bytes.Buffer
+			if err := siteOperationTemplate.Execute(&page, map[string]any{"Title": title, "Op": op}); err != nil {
+				return 0, err
+			}
+			if err := safefile.WriteFile(filepath.Join(dir, op.Page), page.Bytes()); err != nil {
+				return 0, err
+			}
+			if err := safefile.WriteFile(filepath.Join(dir, op.Model), []byte(op.JSON+"\n")); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(written), nil
+}
+
+const siteStyles = `
+    body { font-family: system-ui, sans-serif; margin: 1.5rem auto; max-width: 72rem; padding: 0 1rem; color: #111827; }
+    a { color: #1d4ed8; }
+    code { background: #f3f4f6; padding: 0.1rem 0.3rem; border-radius: 0.25rem; }
+    .method { font-weight: 600; font-size: 0.75rem; padding: 0.1rem 0.4rem; border-radius: 0.25rem; background: #e0e7ff; }
+    iframe { width: 100%; height: 70vh; border: 1px solid #d1d5db; border-radius: 0.5rem; }
+    table { border-collapse: collapse; width: 100%; }
+    th, td { border: 1px solid #d1d5db; padding: 0.25rem 0.5rem; text-align: left; vertical-align: top; font-size: 0.875rem; }
+    pre { background: #f3f4f6; padding: 1rem; overflow: auto; }`
+
+var siteIndexTemplate = template.Must(template.New("site-index").Parse(`<!doctype html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>{{ .Title }}</title>
+  <style>` + siteStyles + `
+  </style>
+</head>
+<body>
+  <h1>{{ .Title }}</h1>
+  {{- range .Tags }}
+  <section>
+    <h2>{{ .Name }}</h2>
+    <ul>
+      {{- range .Operations }}
+      <li><span class="method">{{ .Method }}</span> <a href="{{ .Page }}">{{ .ID }}</a> <code>{{ .Path }}</code>{{ if .Summary }} — {{ .Summary }}{{ end }}</li>
+      {{- end }}
+    </ul>
+  </section>
+  {{- end }}
+</body>
+</html>
+`))
+
+var siteOperationTemplate = template.Must(template.New("site-operation").Parse(`<!doctype html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>{{ .Op.ID }} · {{ .Title }}</title>
+  <style>` + siteStyles + `
+  </style>
+</head>
+<body>
+  <p><a href="index.html">← {{ .Title }}</a></p>
+  <h1>{{ .Op.ID }}</h1>
+  <p><span class="method">{{ .Op.Method }}</span> <code>{{ .Op.Path }}</code></p>
+  {{- if .Op.Summary }}
+  <p>{{ .Op.Summary }}</p>
+  {{- end }}
+  {{- if .Op.Description }}
+  <p>{{ .Op.Description }}</p>
+  {{- end }}
+  <h2>Form</h2>
+  <iframe title="{{ .Op.ID }} form preview" srcdoc="{{ .Op.Form }}"></iframe>
+  <h2>Fields</h2>
+  <table>
+    <tr><th>Path</th><th>Type</th><th>Required</th><th>Label</th><th>Description</th></tr>
+    {{- range .Op.Fields }}
+    <tr><td><code>{{ .Path }}</code></td><td>{{ .Type }}</td><td>{{ if .Required }}yes{{ end }}</td><td>{{ .Label }}</td><td>{{ .Description }}</td></tr>
+    {{- end }}
+  </table>
+  <h2>Form model</h2>
+  <p><a href="{{ .Op.Model }}">{{ .Op.Model }}</a></p>
+  <details>
+    <summary>JSON</summary>
+    <pre>{{ .Op.JSON }}</pre>
+  </details>
+</body>
+</html>
+`))
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+)
+
+// parseBudget reads a comma-separated budget such as
+// "fields=80,depth=4,relationships=10,size=65536".
+func parseBudget(raw string) (model.StatsBudget, error) {
+	var budget model.StatsBudget
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return budget, fmt.Errorf("budget entry %q must be key=value", part)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || limit < 0 {
+			return budget, fmt.Errorf("budget entry %q must use a non-negative integer", part)
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "fields":
+			budget.MaxFields = limit
+		case "depth":
+			budget.MaxDepth = limit
+		case "relationships":
+			budget.MaxRelationships = limit
+		case "size":
+			budget.MaxEstimatedSize = limit
+		default:
+			return budget, fmt.Errorf("unknown budget metric %q (fields, depth, relationships, size)", key)
+		}
+	}
+	return budget, nil
+}
+
+// budgetWarningPrinter reports budget violations on stderr and records that
+// one happened so --stats can fail CI runs.
+type budgetWarningPrinter struct {
+	exceeded bool
+}
+
+func (p *budgetWarningPrinter) handle(_ context.Context, warning orchestrator.BudgetWarning) {
+	p.exceeded = true
+	for _, violation := range warning.Violations {
+		fmt.Fprintf(os.Stderr, "warning: %s: %s\n", warning.OperationID, violation)
+	}
+}
+
+func printStats(form model.FormModel) error {
+	payload := struct {
+		Operation string          `json:"operation"`
+		Stats     model.FormStats `json:"stats"`
+	}{
+		Operation: form.OperationID,
+		Stats:     model.Stats(form),
+	}
+	encoded, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(encoded))
+	return nil
+}
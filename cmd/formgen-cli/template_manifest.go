@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+)
+
+const templateManifestCommand = "template-manifest"
+
+// runTemplateManifest implements `formgen-cli template-manifest`, which prints
+// vanilla.TemplateManifest as JSON or as a Markdown reference for docs.
+func runTemplateManifest(args []string) {
+	flags := flag.NewFlagSet(templateManifestCommand, flag.ExitOnError)
+	format := flags.String("format", "markdown", "output format (markdown, json)")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "parse flags: %v\n", err)
+		os.Exit(2)
+	}
+	manifest := vanilla.TemplateManifest()
+	switch *format {
+	case "json":
+		encoded, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode manifest: %v\n", err)
+			os.Exit(3)
+		}
+		fmt.Println(string(encoded))
+	case "markdown":
+		printTemplateManifest(os.Stdout, manifest)
+	default:
+		flags.Usage()
+		os.Exit(2)
+	}
+}
+
+func printTemplateManifest(w io.Writer, manifest vanilla.TemplateBundle) {
+	fmt.Fprintln(w, "# Vanilla templates")
+	for _, doc := range manifest.Templates {
+		fmt.Fprintf(w, "\n## `%s`\n\n%s\n\n", doc.Name, doc.Description)
+		fmt.Fprintf(w, "- Kind: %s\n", doc.Kind)
+		if doc.ThemePartial != "" {
+			fmt.Fprintf(w, "- Theme partial: `%s`\n", doc.ThemePartial)
+		}
+		if len(doc.Components) > 0 {
+			fmt.Fprintf(w, "- Components: %s\n", codeList(doc.Components))
+		}
+		fmt.Fprintf(w, "- Data: %s\n", codeList(doc.DataKeys))
+		if len(doc.ContextKeys) > 0 {
+			fmt.Fprintf(w, "- Context: %s\n", codeList(doc.ContextKeys))
+		}
+	}
+	fmt.Fprintln(w, "\n## Components\n\n| Component | Template | Theme partial |\n| --- | --- | --- |")
+	for _, component := range manifest.Components {
+		fmt.Fprintf(w, "| `%s` | `%s` | `%s` |\n", component.Component, component.Template, component.ThemePartial)
+	}
+}
+
+func codeList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = "`" + value + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+const validateCommand = "validate"
+
+// Exit codes reported by `formgen-cli validate`.
+const (
+	validateExitOK      = 0
+	validateExitInvalid = 1
+	validateExitUsage   = 2
+	validateExitFailure = 3
+)
+
+// validateRecord is the per-payload result printed by the validate command.
+// Index is set when the input is an array of payloads.
+type validateRecord struct {
+	Index  *int               `json:"index,omitempty"`
+	Valid  bool               `json:"valid"`
+	Issues []submission.Issue `json:"issues"`
+}
+
+type validateReport struct {
+	Operation string           `json:"operation"`
+	Valid     bool             `json:"valid"`
+	Records   []validateRecord `json:"records"`
+}
+
+// runValidate implements `formgen-cli validate`, a dry run of server-side
+// submission validation against a JSON payload (or an array of payloads). It
+// exits 0 when every payload is valid, 1 when any payload has issues, 2 on
+// usage errors, and 3 when the spec or input cannot be loaded.
+func runValidate(args []string) {
+	flags := flag.NewFlagSet(validateCommand, flag.ExitOnError)
+	opID := flags.String("operation", "", "operation ID to validate against (required)")
+	source := flags.String("source", "client/data/schema.json", "OpenAPI document path or URL")
+	input := flags.String("input", "", "JSON payload file, or - for stdin (required)")
+	format := flags.String("format", "text", "report format (text, json)")
+	allowUnknown := flags.Bool("allow-unknown", false, "ignore payload keys that are not form fields")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "parse flags: %v\n", err)
+		os.Exit(validateExitUsage)
+	}
+	if *opID == "" || *input == "" || (*format != "text" && *format != "json") {
+		flags.Usage()
+		os.Exit(validateExitUsage)
+	}
+
+	src := parseSource(*source)
+	if src == nil {
+		fmt.Fprintf(os.Stderr, "invalid source: %q\n", *source)
+		os.Exit(validateExitUsage)
+	}
+
+	form, err := orchestrator.New().BuildFormModel(context.Background(), orchestrator.BuildRequest{
+		Source:      src,
+		OperationID: *opID,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build form model: %v\n", err)
+		os.Exit(validateExitFailure)
+	}
+
+	raw, err := readValidateInput(*input)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read input: %v\n", err)
+		os.Exit(validateExitFailure)
+	}
+
+	var options []submission.Option
+	if *allowUnknown {
+		options = append(options, submission.WithUnknownFields(submission.UnknownIgnore))
+	}
+	report := validatePayloads(form, raw, options...)
+
+	if *format == "json" {
+		encoded, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to encode report: %v\n", err)
+			os.Exit(validateExitFailure)
+		}
+		fmt.Println(string(encoded))
+	} else {
+		printValidateReport(os.Stdout, report)
+	}
+
+	if !report.Valid {
+		os.Exit(validateExitInvalid)
+	}
+	os.Exit(validateExitOK)
+}
+
+func readValidateInput(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return safefile.ReadFile(path)
+}
+
+// validatePayloads accepts a single JSON object or an array of objects (seed
+// data, fixtures) and validates each one against form.
+func validatePayloads(form model.FormModel, raw []byte, options ...submission.Option) validateReport {
+	report := validateReport{Operation: form.OperationID, Valid: true}
+
+	var list []json.RawMessage
+	if trimmed := strings.TrimSpace(string(raw)); strings.HasPrefix(trimmed, "[") && json.Unmarshal(raw, &list) == nil {
+		for i, item := range list {
+			index := i
+			record := validatePayload(form, item, options...)
+			record.Index = &index
+			report.Records = append(report.Records, record)
+		}
+	} else {
+		report.Records = append(report.Records, validatePayload(form, raw, options...))
+	}
+
+	for _, record := range report.Records {
+		if !record.Valid {
+			report.Valid = false
+		}
+	}
+	return report
+}
+
+func validatePayload(form model.FormModel, raw []byte, options ...submission.Option) validateRecord {
+	// ParseJSON only reports errors for unsupported bodies, which it already
+	// turns into issues.
+	result, _ := submission.ParseJSON(form, raw, options...)
+	issues := append([]submission.Issue(nil), result.Issues...)
+	if len(result.Issues) == 0 || !hasBodyIssue(result.Issues) {
+		issues = append(issues, submission.Validate(form, result.Values, options...)...)
+	}
+	issues = dedupeIssues(issues)
+	if issues == nil {
+		issues = []submission.Issue{}
+	}
+	return validateRecord{Valid: len(issues) == 0, Issues: issues}
+}
+
+// hasBodyIssue reports whether the payload itself could not be decoded, in
+// which case field validation would only add noise.
+func hasBodyIssue(issues []submission.Issue) bool {
+	for _, issue := range issues {
+		if issue.Path == "" && (issue.Code == submission.CodeInvalidJSON || issue.Code == submission.CodeType) {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupeIssues(issues []submission.Issue) []submission.Issue {
+	seen := make(map[string]struct{}, len(issues))
+	out := issues[:0]
+	for _, issue := range issues {
+		key := string(issue.Code) + "\x00" + issue.Path
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, issue)
+	}
+	return out
+}
+
+func printValidateReport(w io.Writer, report validateReport) {
+	for _, record := range report.Records {
+		prefix := ""
+		if record.Index != nil {
+			prefix = fmt.Sprintf("[%d] ", *record.Index)
+		}
+		if record.Valid {
+			fmt.Fprintf(w, "%sok\n", prefix)
+			continue
+		}
+		for _, issue := range record.Issues {
+			path := issue.Path
+			if path == "" {
+				path = "(payload)"
+			}
+			fmt.Fprintf(w, "%s%s: %s (%s)\n", prefix, path, issue.Message, issue.Code)
+		}
+	}
+	if report.Valid {
+		fmt.Fprintf(w, "%s: valid\n", report.Operation)
+	} else {
+		fmt.Fprintf(w, "%s: invalid\n", report.Operation)
+	}
+}
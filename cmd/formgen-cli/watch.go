@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/live"
+)
+
+const watchEventsPath = "/formgen/events"
+
+// watchConfig describes what `formgen-cli --watch` monitors and where each
+// regenerated form goes.
+type watchConfig struct {
+	source       string
+	uiSchemaDir  string
+	templatesDir string
+	output       string
+	serveAddr    string
+	interval     time.Duration
+}
+
+// runWatch regenerates the form whenever the source document, UI schema
+// directory, or templates directory changes, until ctx is cancelled. Failed
+// generations are logged and the previous output is kept. With serveAddr set,
+// the latest output is served with a script that reloads the page after each
+// successful regeneration.
+func runWatch(ctx context.Context, cfg watchConfig, generate func(context.Context) ([]byte, error)) error {
+	changes := live.NewBroker()
+	watcher := live.NewWatcher(changes, cfg.interval)
+	watched := 0
+	if fsys, ok := watchFileFS(cfg.source); ok {
+		watcher.Add("openapi", fsys)
+		watched++
+	} else {
+		log.Printf("watch: %s is not a local file; changes to it are not detected", cfg.source)
+	}
+	if cfg.uiSchemaDir != "" {
+		watcher.Add("uischema", os.DirFS(cfg.uiSchemaDir))
+		watched++
+	}
+	if cfg.templatesDir != "" {
+		watcher.Add("templates", os.DirFS(cfg.templatesDir))
+		watched++
+	}
+	if watched == 0 {
+		return errors.New("watch: nothing to watch; use a local --source, --ui-schema, or --templates")
+	}
+
+	events, cancel := changes.Subscribe()
+	defer cancel()
+
+	var site *watchSite
+	if cfg.serveAddr != "" {
+		site = newWatchSite()
+		server := &http.Server{Addr: cfg.serveAddr, Handler: site, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Printf("watch: serve: %v", err)
+			}
+		}()
+		defer func() {
+			shutdown, done := context.WithTimeout(context.Background(), 5*time.Second)
+			defer done()
+			_ = server.Shutdown(shutdown)
+		}()
+		log.Printf("watch: serving on http://%s", displayAddr(cfg.serveAddr))
+	}
+
+	runErr := make(chan error, 1)
+	go func() { runErr <- watcher.Run(ctx) }()
+
+	for {
+		select {
+		case err := <-runErr:
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		case event := <-events:
+			output, err := generate(ctx)
+			if err != nil {
+				log.Printf("watch: generate failed (%s changed): %v", event.Source, err)
+				continue
+			}
+			if err := writeWatchOutput(cfg, output); err != nil {
+				log.Printf("watch: %v", err)
+				continue
+			}
+			if site != nil {
+				site.update(output, event.Version)
+			}
+			log.Printf("watch: regenerated (%s changed)", event.Source)
+		}
+	}
+}
+
+func writeWatchOutput(cfg watchConfig, output []byte) error {
+	switch {
+	case cfg.output != "":
+		if err := safefile.WriteFile(cfg.output, output); err != nil {
+			return fmt.Errorf("write output: %w", err)
+		}
+	case cfg.serveAddr == "":
+		fmt.Println(string(output))
+	}
+	return nil
+}
+
+// watchFileFS exposes only the named local file so edits elsewhere in its
+// directory do not trigger regeneration.
+func watchFileFS(source string) (fs.FS, bool) {
+	path := strings.TrimSpace(source)
+	if path == "" || strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return nil, false
+	}
+	return singleFileFS{FS: os.DirFS(filepath.Dir(path)), name: filepath.Base(path)}, true
+}
+
+type singleFileFS struct {
+	fs.FS
+	name string
+}
+
+func (f singleFileFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name != "." {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	info, err := fs.Stat(f.FS, f.name)
+	if err != nil {
+		return nil, err
+	}
+	return []fs.DirEntry{fs.FileInfoToDirEntry(info)}, nil
+}
+
+// watchSite serves the latest generated page and announces new versions to
+// it. Versions are only published after a successful regeneration, so a
+// broken edit never reloads the browser into an error.
+type watchSite struct {
+	mux    *http.ServeMux
+	broker *live.Broker
+
+	mu      sync.RWMutex
+	page    []byte
+	version string
+}
+
+func newWatchSite() *watchSite {
+	site := &watchSite{mux: http.NewServeMux(), broker: live.NewBroker()}
+	site.mux.Handle(watchEventsPath, site.broker)
+	site.mux.HandleFunc("/{$}", site.servePage)
+	return site
+}
+
+func (s *watchSite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *watchSite) update(output []byte, version string) {
+	s.mu.Lock()
+	s.page = output
+	s.version = version
+	s.mu.Unlock()
+	s.broker.Publish(live.Event{Version: version})
+}
+
+func (s *watchSite) servePage(w http.ResponseWriter, _ *http.Request) {
+	s.mu.RLock()
+	page, version := s.page, s.version
+	s.mu.RUnlock()
+	if page == nil {
+		http.Error(w, "form has not been generated yet", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(injectReloadScript(page, version))
+}
+
+// injectReloadScript appends a script that reloads the page once the watch
+// server announces a version other than the one it was served with.
+func injectReloadScript(page []byte, version string) []byte {
+	encoded, _ := json.Marshal(version)
+	script := fmt.Sprintf(`<script>(function(){var version=%s;var events=new EventSource(%q);events.addEventListener(%q,function(event){try{if(JSON.parse(event.data).version!==version){events.close();location.reload();}}catch(_){}});})();</script>`,
+		encoded, watchEventsPath, live.EventName)
+	html := string(page)
+	if idx := strings.LastIndex(strings.ToLower(html), "</body>"); idx >= 0 {
+		return []byte(html[:idx] + script + html[idx:])
+	}
+	return []byte(html + script)
+}
+
+func displayAddr(addr string) string {
+	if strings.HasPrefix(addr, ":") {
+		return "localhost" + addr
+	}
+	return addr
+}
@@ -13,9 +13,10 @@ import (
 	internalmodel "github.com/goliatone/go-formgen/internal/model"
 	"github.com/goliatone/go-formgen/internal/safefile"
 	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/schema"
 )
 
-const extensionNamespace = "x-formgen"
+const extensionNamespace = schema.DefaultExtensionNamespace
 
 type violation struct {
 	file     string
@@ -32,6 +33,7 @@ func main() {
 			panic(err)
 		}
 	}
+	namespaces := flag.String("namespace", "", "comma separated vendor extension prefixes to lint alongside x-formgen, highest precedence first")
 	flag.Parse()
 
 	paths := flag.Args()
@@ -46,6 +48,7 @@ func main() {
 	parser := formgen.NewParser(
 		pkgopenapi.WithPartialDocuments(true),
 		pkgopenapi.WithReferenceResolution(false),
+		pkgopenapi.WithExtensionNamespace(strings.Split(*namespaces, ",")...),
 	)
 
 	var (
@@ -102,13 +105,14 @@ func lintFile(ctx context.Context, parser pkgopenapi.Parser, path string) ([]vio
 		}
 		result = append(result, lintSchema(path, append(base, "requestBody"), op.RequestBody)...)
 
-		codes := make([]string, 0, len(op.Responses))
-		for code := range op.Responses {
+		responses := op.ResponseSchemas()
+		codes := make([]string, 0, len(responses))
+		for code := range responses {
 			codes = append(codes, code)
 		}
 		sort.Strings(codes)
 		for _, code := range codes {
-			response := op.Responses[code]
+			response := responses[code]
 			result = append(result, lintExtensions(path, append(base, "responses", code), response.Extensions)...)
 			result = append(result, lintSchema(path, append(base, "responses", code), response)...)
 		}
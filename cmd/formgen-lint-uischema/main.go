@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing/fstest"
+
+	"github.com/goliatone/go-formgen"
+	"github.com/goliatone/go-formgen/internal/safefile"
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+)
+
+func main() {
+	flag.Usage = func() {
+		if _, err := fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-openapi spec.json] [paths...]\n", filepath.Base(os.Args[0])); err != nil {
+			panic(err)
+		}
+		if _, err := fmt.Fprintf(flag.CommandLine.Output(), "\nValidate UI schema files or directories against the published JSON Schema.\n"); err != nil {
+			panic(err)
+		}
+		flag.PrintDefaults()
+	}
+	source := flag.String("openapi", "", "OpenAPI document used to check operation ids and field paths")
+	printSchema := flag.Bool("print-schema", false, "print the UI schema JSON Schema and exit")
+	flag.Parse()
+
+	if *printSchema {
+		if _, err := os.Stdout.Write(uischema.JSONSchema()); err != nil {
+			fmt.Fprintf(os.Stderr, "print schema: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var options []uischema.ValidateOption
+	if *source != "" {
+		forms, err := loadForms(context.Background(), *source)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "load %s: %v\n", *source, err)
+			os.Exit(1)
+		}
+		options = append(options, uischema.WithFormModels(forms...))
+	}
+
+	failed := false
+	for _, path := range paths {
+		fsys, prefix, err := openPath(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "lint %s: %v\n", path, err)
+			os.Exit(1)
+		}
+		report, err := uischema.Validate(fsys, options...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "lint %s: %v\n", path, err)
+			os.Exit(1)
+		}
+		for _, diagnostic := range report.Diagnostics {
+			diagnostic.File = filepath.Join(prefix, diagnostic.File)
+			fmt.Fprintln(os.Stderr, diagnostic.String())
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// openPath returns a filesystem rooted at a directory argument, or a single
+// entry filesystem for a file argument, plus the prefix used in diagnostics.
+func openPath(path string) (fs.FS, string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if info.IsDir() {
+		return os.DirFS(path), path, nil
+	}
+	data, err := safefile.ReadFile(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("read file: %w", err)
+	}
+	return fstest.MapFS{filepath.Base(path): {Data: data}}, filepath.Dir(path), nil
+}
+
+func loadForms(ctx context.Context, path string) ([]pkgmodel.FormModel, error) {
+	raw, err := safefile.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile(path), raw)
+	if err != nil {
+		return nil, fmt.Errorf("construct document: %w", err)
+	}
+	operations, err := formgen.NewParser().Operations(ctx, doc)
+	if err != nil {
+		return nil, fmt.Errorf("parse operations: %w", err)
+	}
+
+	builder := pkgmodel.NewBuilder()
+	forms := make([]pkgmodel.FormModel, 0, len(operations))
+	for _, op := range operations {
+		form, err := builder.Build(pkgopenapi.FormFromOperation(op))
+		if err != nil {
+			return nil, fmt.Errorf("build form %s: %w", op.ID, err)
+		}
+		forms = append(forms, form)
+	}
+	return forms, nil
+}
@@ -0,0 +1,87 @@
+package formgen_test
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"testing"
+
+	"github.com/goliatone/go-formgen"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	theme "github.com/goliatone/go-theme"
+)
+
+// The declarations below pin the v1 top-level surface. A signature change
+// fails compilation here before it reaches downstream apps.
+var (
+	_ func(...pkgopenapi.LoaderOption) pkgopenapi.Loader                                                 = formgen.NewLoader
+	_ func(...pkgopenapi.ParserOption) pkgopenapi.Parser                                                 = formgen.NewParser
+	_ func(...orchestrator.Option) *orchestrator.Orchestrator                                            = formgen.NewOrchestrator
+	_ func(context.Context, pkgopenapi.Source, string, string, ...orchestrator.Option) ([]byte, error)   = formgen.GenerateHTML
+	_ func(context.Context, pkgopenapi.Document, string, string, ...orchestrator.Option) ([]byte, error) = formgen.GenerateHTMLFromDocument
+	_ func([]formgen.EndpointOverride) orchestrator.Option                                               = formgen.WithEndpointOverrides
+	_ func(theme.ThemeSelector) orchestrator.Option                                                      = formgen.WithThemeSelector
+	_ func(theme.ThemeProvider, string, string) orchestrator.Option                                      = formgen.WithThemeProvider
+	_ func(map[string]string) orchestrator.Option                                                        = formgen.WithThemeFallbacks
+	_ func() fs.FS                                                                                       = formgen.RuntimeAssetsFS
+	_ func() fs.FS                                                                                       = formgen.EmbeddedTemplates
+	_ func() formgen.API                                                                                 = formgen.V1
+	_ formgen.Generator                                                                                  = (*orchestrator.Orchestrator)(nil)
+	_ formgen.EndpointConfig                                                                             = orchestrator.EndpointConfig{}
+	_ formgen.EndpointMapping                                                                            = orchestrator.EndpointMapping{}
+	_ formgen.EndpointAuth                                                                               = orchestrator.EndpointAuth{}
+	_ formgen.EndpointOverride                                                                           = orchestrator.EndpointOverride{}
+	_ formgen.RenderOptions                                                                              = render.RenderOptions{}
+	_ formgen.FieldSubset                                                                                = render.FieldSubset{}
+)
+
+func TestV1FacadeMatchesTopLevelFunctions(t *testing.T) {
+	if formgen.APIVersion != "v1" {
+		t.Fatalf("unexpected API version %q", formgen.APIVersion)
+	}
+	ctx := context.Background()
+	api := formgen.V1()
+	source := pkgopenapi.SourceFromFile("examples/fixtures/petstore.json")
+
+	want, err := formgen.GenerateHTML(ctx, source, "createPet", "vanilla")
+	if err != nil {
+		t.Fatalf("GenerateHTML: %v", err)
+	}
+	got, err := api.GenerateHTML(ctx, source, "createPet", "vanilla")
+	if err != nil {
+		t.Fatalf("facade GenerateHTML: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("facade output differs from GenerateHTML")
+	}
+
+	doc, err := api.NewLoader().Load(ctx, source)
+	if err != nil {
+		t.Fatalf("facade loader: %v", err)
+	}
+	ops, err := api.NewParser().Operations(ctx, doc)
+	if err != nil {
+		t.Fatalf("facade parser: %v", err)
+	}
+	if _, ok := ops["createPet"]; !ok {
+		t.Fatalf("expected createPet operation, got %d operations", len(ops))
+	}
+
+	fromDoc, err := api.GenerateHTMLFromDocument(ctx, doc, "createPet", "vanilla")
+	if err != nil {
+		t.Fatalf("facade GenerateHTMLFromDocument: %v", err)
+	}
+	if !bytes.Equal(fromDoc, want) {
+		t.Fatalf("document output differs from GenerateHTML")
+	}
+
+	form, err := api.NewOrchestrator().BuildFormModel(ctx, orchestrator.BuildRequest{Document: &doc, OperationID: "createPet"})
+	if err != nil {
+		t.Fatalf("facade BuildFormModel: %v", err)
+	}
+	if form.OperationID != "createPet" || len(form.Fields) == 0 {
+		t.Fatalf("unexpected form model %+v", form)
+	}
+}
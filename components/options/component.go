@@ -0,0 +1,29 @@
+package options
+
+// Component is a small, extraction-friendly wrapper around the dataset
+// handlers, their configuration, and routing helpers.
+type Component struct {
+	opts Options
+}
+
+// New constructs a new component with default options plus any overrides.
+func New(fns ...OptionFn) *Component {
+	opts := NewOptions(fns...)
+	return &Component{opts: opts}
+}
+
+// Options returns a copy of the component configuration.
+func (c *Component) Options() Options {
+	if c == nil {
+		return DefaultOptions()
+	}
+	return NewOptions(func(o *Options) { *o = c.opts })
+}
+
+// RegisterRoutes registers a handler per dataset under basePath on mux.
+func (c *Component) RegisterRoutes(mux Mux, basePath string) ([]string, error) {
+	if c == nil {
+		return RegisterRoutes(mux, basePath)
+	}
+	return RegisterRoutesWithOptions(mux, basePath, c.opts)
+}
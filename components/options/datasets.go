@@ -0,0 +1,131 @@
+package options
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/goliatone/go-formgen/components/timezones"
+)
+
+//go:embed data/*.tsv
+var dataFS embed.FS
+
+var embeddedDatasets = map[string]string{
+	Countries:  "data/countries.tsv",
+	Locales:    "data/locales.tsv",
+	Currencies: "data/currencies.tsv",
+}
+
+var (
+	cacheMu sync.Mutex
+	cache   = map[string][]Option{}
+)
+
+// Names lists the built-in dataset names in sorted order.
+func Names() []string {
+	names := []string{Timezones}
+	for name := range embeddedDatasets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Dataset returns a copy of a built-in dataset sorted by value. Unknown names
+// return an error.
+func Dataset(name string) ([]Option, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if cached, ok := cache[name]; ok {
+		return append([]Option{}, cached...), nil
+	}
+
+	loaded, err := loadDataset(name)
+	if err != nil {
+		return nil, err
+	}
+	cache[name] = loaded
+	return append([]Option{}, loaded...), nil
+}
+
+// IsDataset reports whether name is a built-in dataset.
+func IsDataset(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == Timezones {
+		return true
+	}
+	_, ok := embeddedDatasets[name]
+	return ok
+}
+
+func loadDataset(name string) ([]Option, error) {
+	if name == Timezones {
+		zones, err := timezones.DefaultZones()
+		if err != nil {
+			return nil, err
+		}
+		out := make([]Option, 0, len(zones))
+		for _, zone := range zones {
+			out = append(out, Option{Value: zone, Label: zone})
+		}
+		return out, nil
+	}
+
+	path, ok := embeddedDatasets[name]
+	if !ok {
+		return nil, fmt.Errorf("options: unknown dataset %q", name)
+	}
+	f, err := dataFS.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return LoadOptions(f)
+}
+
+// LoadOptions reads tab-separated value/label lines, skipping blanks and
+// `#` comments. A line without a label uses the value as its label. Duplicate
+// values keep their first label and the result is sorted by value.
+func LoadOptions(r io.Reader) ([]Option, error) {
+	if r == nil {
+		return nil, fmt.Errorf("options: missing reader")
+	}
+
+	scanner := bufio.NewScanner(r)
+	out := make([]Option, 0, 256)
+	seen := map[string]struct{}{}
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		value, label, _ := strings.Cut(line, "\t")
+		value, label = strings.TrimSpace(value), strings.TrimSpace(label)
+		if value == "" {
+			continue
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		if label == "" {
+			label = value
+		}
+		out = append(out, Option{Value: value, Label: label})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Value < out[j].Value })
+	return out, nil
+}
@@ -0,0 +1,12 @@
+// Package options provides deterministic option datasets for common form
+// inputs (countries, locales, currencies, timezones), search helpers, and a
+// small net/http handler per dataset that returns JSON options.
+//
+// The built-in datasets are embedded: ISO 3166-1 alpha-2 countries, common
+// BCP 47 locale tags, ISO 4217 currencies, and the IANA timezones shipped by
+// components/timezones. Handlers respond to GET and HEAD requests and support
+// query and limit parameters, mirroring the timezones component.
+//
+// Schemas opt in with `x-formgen: {options: countries}`; the vanilla renderer
+// then renders a select seeded with the dataset and wired to its handler.
+package options
@@ -0,0 +1,44 @@
+package formgenwiring
+
+import (
+	"strconv"
+
+	"github.com/goliatone/go-formgen/components/options"
+	formgenorchestrator "github.com/goliatone/go-formgen/pkg/orchestrator"
+)
+
+// EndpointOverride returns a go-formgen orchestrator EndpointOverride that
+// wires a field to the handler of dataset, using the options component
+// defaults (and any provided overrides).
+//
+// The generated override:
+// - points at <basePath><RoutePrefix>/<dataset> (default: <basePath>/api/options/<dataset>)
+// - uses resultsPath "data" with value/label mapping
+// - includes "format=options" and a default limit param
+// - includes a dynamic search param mapped to "{{self}}"
+func EndpointOverride(dataset, operationID, fieldPath, basePath string, fns ...options.OptionFn) formgenorchestrator.EndpointOverride {
+	opts := options.NewOptions(fns...)
+
+	params := map[string]string{
+		"format":        "options",
+		opts.LimitParam: strconv.Itoa(opts.DefaultLimit),
+	}
+
+	return formgenorchestrator.EndpointOverride{
+		OperationID: operationID,
+		FieldPath:   fieldPath,
+		Endpoint: formgenorchestrator.EndpointConfig{
+			URL:         options.MountPath(basePath, dataset, fns...),
+			Method:      "GET",
+			ResultsPath: "data",
+			Params:      params,
+			DynamicParams: map[string]string{
+				opts.SearchParam: "{{self}}",
+			},
+			Mapping: formgenorchestrator.EndpointMapping{
+				Value: "value",
+				Label: "label",
+			},
+		},
+	}
+}
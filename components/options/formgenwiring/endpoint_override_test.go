@@ -0,0 +1,37 @@
+package formgenwiring
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/components/options"
+)
+
+func TestEndpointOverride_Defaults(t *testing.T) {
+	ov := EndpointOverride(options.Countries, "op", "address.country", "/admin")
+
+	if ov.OperationID != "op" || ov.FieldPath != "address.country" {
+		t.Fatalf("unexpected target: %q %q", ov.OperationID, ov.FieldPath)
+	}
+	if ov.Endpoint.URL != "/admin/api/options/countries" {
+		t.Fatalf("unexpected url: %q", ov.Endpoint.URL)
+	}
+	if ov.Endpoint.Method != "GET" || ov.Endpoint.ResultsPath != "data" {
+		t.Fatalf("unexpected endpoint: %#v", ov.Endpoint)
+	}
+	if got := ov.Endpoint.Params["limit"]; got != "50" {
+		t.Fatalf("unexpected limit param: %q", got)
+	}
+	if got := ov.Endpoint.DynamicParams["q"]; got != "{{self}}" {
+		t.Fatalf("unexpected dynamic q param: %q", got)
+	}
+}
+
+func TestEndpointOverride_UsesOptionOverrides(t *testing.T) {
+	ov := EndpointOverride(options.Currencies, "op", "currency", "", options.WithRoutePrefix("/lookup"), options.WithSearchParam("term"))
+	if ov.Endpoint.URL != "/lookup/currencies" {
+		t.Fatalf("unexpected url: %q", ov.Endpoint.URL)
+	}
+	if got := ov.Endpoint.DynamicParams["term"]; got != "{{self}}" {
+		t.Fatalf("unexpected dynamic term param: %q", got)
+	}
+}
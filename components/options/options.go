@@ -0,0 +1,185 @@
+package options
+
+import (
+	"maps"
+	"net/http"
+	"sort"
+)
+
+type EmptySearchMode string
+
+const (
+	EmptySearchNone EmptySearchMode = "none"
+	EmptySearchTop  EmptySearchMode = "top"
+)
+
+type GuardFunc func(r *http.Request) error
+
+type Options struct {
+	RoutePrefix     string
+	SearchParam     string
+	LimitParam      string
+	DefaultLimit    int
+	MaxLimit        int
+	EmptySearchMode EmptySearchMode
+	Guard           GuardFunc
+
+	// Datasets adds or replaces datasets by name. Entries here take
+	// precedence over the built-in datasets.
+	Datasets map[string][]Option
+}
+
+type OptionFn func(*Options)
+
+func DefaultOptions() Options {
+	return Options{
+		RoutePrefix:     "/api/options",
+		SearchParam:     "q",
+		LimitParam:      "limit",
+		DefaultLimit:    50,
+		MaxLimit:        200,
+		EmptySearchMode: EmptySearchNone,
+	}
+}
+
+func NewOptions(fns ...OptionFn) Options {
+	opts := DefaultOptions()
+	for _, fn := range fns {
+		if fn == nil {
+			continue
+		}
+		fn(&opts)
+	}
+	if opts.DefaultLimit <= 0 {
+		opts.DefaultLimit = 50
+	}
+	if opts.MaxLimit <= 0 {
+		opts.MaxLimit = 200
+	}
+	if opts.EmptySearchMode == "" {
+		opts.EmptySearchMode = EmptySearchNone
+	}
+	if opts.RoutePrefix == "" {
+		opts.RoutePrefix = "/api/options"
+	}
+	if opts.SearchParam == "" {
+		opts.SearchParam = "q"
+	}
+	if opts.LimitParam == "" {
+		opts.LimitParam = "limit"
+	}
+	if opts.Datasets != nil {
+		opts.Datasets = maps.Clone(opts.Datasets)
+	}
+	return opts
+}
+
+func WithRoutePrefix(prefix string) OptionFn {
+	return func(o *Options) {
+		if o == nil {
+			return
+		}
+		o.RoutePrefix = prefix
+	}
+}
+
+func WithSearchParam(name string) OptionFn {
+	return func(o *Options) {
+		if o == nil {
+			return
+		}
+		o.SearchParam = name
+	}
+}
+
+func WithLimitParam(name string) OptionFn {
+	return func(o *Options) {
+		if o == nil {
+			return
+		}
+		o.LimitParam = name
+	}
+}
+
+func WithDefaultLimit(limit int) OptionFn {
+	return func(o *Options) {
+		if o == nil {
+			return
+		}
+		o.DefaultLimit = limit
+	}
+}
+
+func WithMaxLimit(limit int) OptionFn {
+	return func(o *Options) {
+		if o == nil {
+			return
+		}
+		o.MaxLimit = limit
+	}
+}
+
+func WithEmptySearchMode(mode EmptySearchMode) OptionFn {
+	return func(o *Options) {
+		if o == nil {
+			return
+		}
+		o.EmptySearchMode = mode
+	}
+}
+
+func WithGuard(guard GuardFunc) OptionFn {
+	return func(o *Options) {
+		if o == nil {
+			return
+		}
+		o.Guard = guard
+	}
+}
+
+// WithDataset adds or replaces a dataset served under name.
+func WithDataset(name string, options []Option) OptionFn {
+	return func(o *Options) {
+		if o == nil || name == "" {
+			return
+		}
+		if o.Datasets == nil {
+			o.Datasets = make(map[string][]Option)
+		}
+		o.Datasets[name] = append([]Option{}, options...)
+	}
+}
+
+// datasetNames lists the built-in datasets plus any registered through
+// Options.Datasets, sorted and without duplicates.
+func (o Options) datasetNames() []string {
+	names := Names()
+	for name := range o.Datasets {
+		if !IsDataset(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolve returns the options served for name, preferring Options.Datasets.
+func (o Options) resolve(name string) ([]Option, error) {
+	if options, ok := o.Datasets[name]; ok {
+		return options, nil
+	}
+	return Dataset(name)
+}
+
+func clampLimit(limit int, opts Options) int {
+	if limit < 0 {
+		return 0
+	}
+	if limit == 0 {
+		limit = opts.DefaultLimit
+	}
+	if opts.MaxLimit > 0 && limit > opts.MaxLimit {
+		return opts.MaxLimit
+	}
+	return limit
+}
@@ -0,0 +1,147 @@
+package options
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDatasets_LoadEmbeddedData(t *testing.T) {
+	cases := map[string]struct {
+		count int
+		value string
+		label string
+	}{
+		Countries:  {count: 249, value: "FR", label: "France"},
+		Currencies: {value: "EUR", label: "Euro"},
+		Locales:    {value: "pt-BR", label: "Portuguese (Brazil)"},
+		Timezones:  {value: "Europe/Paris", label: "Europe/Paris"},
+	}
+	for name, tc := range cases {
+		data, err := Dataset(name)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if tc.count > 0 && len(data) != tc.count {
+			t.Fatalf("%s: expected %d options, got %d", name, tc.count, len(data))
+		}
+		found := false
+		for i, option := range data {
+			if i > 0 && data[i-1].Value >= option.Value {
+				t.Fatalf("%s: options not sorted at %q", name, option.Value)
+			}
+			if option.Value == tc.value {
+				found = option.Label == tc.label
+			}
+		}
+		if !found {
+			t.Fatalf("%s: expected %s=%s", name, tc.value, tc.label)
+		}
+	}
+
+	if _, err := Dataset("planets"); err == nil {
+		t.Fatalf("expected unknown dataset error")
+	}
+	if !reflect.DeepEqual(Names(), []string{Countries, Currencies, Locales, Timezones}) {
+		t.Fatalf("unexpected names %v", Names())
+	}
+}
+
+func TestLoadOptions_SkipsCommentsAndDuplicates(t *testing.T) {
+	got, err := LoadOptions(strings.NewReader("# header\nb\tBee\n\na\nb\tDuplicate\n"))
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	want := []Option{{Value: "a", Label: "a"}, {Value: "b", Label: "Bee"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected options %#v", got)
+	}
+}
+
+func TestSearch_MatchesValueOrLabelPrefixFirst(t *testing.T) {
+	data := []Option{
+		{Value: "GB", Label: "United Kingdom"},
+		{Value: "TZ", Label: "Tanzania"},
+		{Value: "US", Label: "United States"},
+		{Value: "UA", Label: "Ukraine"},
+	}
+	opts := NewOptions()
+
+	got := Search(data, "uni", 0, opts)
+	if len(got) != 2 || got[0].Value != "GB" || got[1].Value != "US" {
+		t.Fatalf("unexpected label search %#v", got)
+	}
+	got = Search(data, "t", 0, opts)
+	if len(got) != 3 || got[0].Value != "TZ" || got[1].Value != "GB" {
+		t.Fatalf("expected prefix matches first, got %#v", got)
+	}
+	if got := Search(data, "", 0, opts); got != nil {
+		t.Fatalf("expected no results for empty query, got %#v", got)
+	}
+	if got := Search(data, "", 2, NewOptions(WithEmptySearchMode(EmptySearchTop))); len(got) != 2 {
+		t.Fatalf("expected top results, got %#v", got)
+	}
+}
+
+func TestRegisterRoutes_ServesEachDataset(t *testing.T) {
+	mux := http.NewServeMux()
+	patterns, err := RegisterRoutes(mux, "/admin", WithDataset("plans", []Option{{Value: "pro", Label: "Professional"}}))
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	want := []string{
+		"/admin/api/options/countries",
+		"/admin/api/options/currencies",
+		"/admin/api/options/locales",
+		"/admin/api/options/plans",
+		"/admin/api/options/timezones",
+	}
+	if !reflect.DeepEqual(patterns, want) {
+		t.Fatalf("unexpected patterns %v", patterns)
+	}
+
+	for path, expected := range map[string]string{
+		"/admin/api/options/currencies?q=eur": "EUR",
+		"/admin/api/options/plans?q=prof":     "pro",
+	} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: expected status 200, got %d", path, rec.Code)
+		}
+		var payload optionsResponse
+		if err := json.NewDecoder(rec.Body).Decode(&payload); err != nil {
+			t.Fatalf("%s: decode: %v", path, err)
+		}
+		if len(payload.Data) == 0 || payload.Data[0].Value != expected {
+			t.Fatalf("%s: unexpected data %#v", path, payload.Data)
+		}
+	}
+}
+
+func TestHandler_UnknownDatasetAndMethod(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler("planets").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/options/planets?q=a", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	Handler(Countries).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/options/countries", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405, got %d", rec.Code)
+	}
+}
+
+func TestMountPath_JoinsBasePath(t *testing.T) {
+	if got := MountPath("", Countries); got != "/api/options/countries" {
+		t.Fatalf("unexpected mount path: %q", got)
+	}
+	if got := MountPath("admin/", Locales, WithRoutePrefix("lookup")); got != "/admin/lookup/locales" {
+		t.Fatalf("unexpected mount path: %q", got)
+	}
+}
@@ -0,0 +1,63 @@
+package options
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Mux is the minimal interface required to register a net/http handler.
+// It is satisfied by *http.ServeMux.
+type Mux interface {
+	Handle(pattern string, handler http.Handler)
+}
+
+// MountPath returns the full mount path of dataset under basePath.
+func MountPath(basePath, dataset string, fns ...OptionFn) string {
+	opts := NewOptions(fns...)
+	return mountPath(basePath, opts.RoutePrefix, dataset)
+}
+
+// RegisterRoutes registers one handler per dataset under basePath on mux and
+// returns the registered patterns.
+func RegisterRoutes(mux Mux, basePath string, fns ...OptionFn) ([]string, error) {
+	opts := NewOptions(fns...)
+	return RegisterRoutesWithOptions(mux, basePath, opts)
+}
+
+// RegisterRoutesWithOptions registers handlers under basePath using a pre-built Options value.
+// Callers are expected to pass an Options value produced by NewOptions (or equivalent) so defaults apply.
+func RegisterRoutesWithOptions(mux Mux, basePath string, opts Options) ([]string, error) {
+	if mux == nil {
+		return nil, fmt.Errorf("options: missing mux")
+	}
+	opts = NewOptions(func(o *Options) { *o = opts })
+	names := opts.datasetNames()
+	patterns := make([]string, 0, len(names))
+	for _, name := range names {
+		pattern := mountPath(basePath, opts.RoutePrefix, name)
+		mux.Handle(pattern, HandlerWithOptions(name, opts))
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+func mountPath(basePath, routePrefix, dataset string) string {
+	basePath = strings.TrimSpace(basePath)
+	routePrefix = strings.Trim(strings.TrimSpace(routePrefix), "/")
+	dataset = strings.Trim(strings.TrimSpace(dataset), "/")
+
+	routePath := "/" + dataset
+	if routePrefix != "" {
+		routePath = "/" + routePrefix + routePath
+	}
+
+	if basePath == "" || basePath == "/" {
+		return routePath
+	}
+	if !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	basePath = strings.TrimRight(basePath, "/")
+	return basePath + routePath
+}
@@ -0,0 +1,19 @@
+package options
+
+// Option is a single value/label pair returned by the handlers.
+type Option struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// Built-in dataset names.
+const (
+	Countries  = "countries"
+	Locales    = "locales"
+	Currencies = "currencies"
+	Timezones  = "timezones"
+)
+
+// HintKey is the x-formgen extension (and resulting field metadata key) that
+// selects a dataset for a field.
+const HintKey = "options"
@@ -124,7 +124,7 @@ func parseFlags() cliConfig {
 	outputFlag := flag.String("output", "", "Optional file path for the generated markup (stdout when empty)")
 	timeoutFlag := flag.Duration("timeout", 15*time.Second, "Generation timeout")
 	inspectFlag := flag.Bool("inspect", false, "Print form metadata/UI hints as JSON (stderr)")
-	tuiFormatFlag := flag.String("tui-format", "json", "TUI output format (json, form, pretty)")
+	tuiFormatFlag := flag.String("tui-format", "json", "TUI output format (json, form, pretty, envelope)")
 	tuiNoFetch := flag.Bool("tui-no-fetch", false, "Disable relationship HTTP fetches for TUI")
 	flag.Parse()
 
@@ -146,6 +146,8 @@ func parseTUIFormat(raw string) tui.OutputFormat {
 		return tui.OutputFormatFormURLEncoded
 	case "pretty", "text":
 		return tui.OutputFormatPrettyText
+	case "envelope":
+		return tui.OutputFormatEnvelope
 	default:
 		return tui.OutputFormatJSON
 	}
@@ -162,13 +164,9 @@ func writeFile(path string, data []byte) error {
 }
 
 func buildFormModel(ctx context.Context, parser pkgopenapi.Parser, builder model.Builder, document pkgopenapi.Document, operationID string) (model.FormModel, error) {
-	operations, err := parser.Operations(ctx, document)
+	op, err := pkgopenapi.ParseOperation(ctx, parser, document, operationID)
 	if err != nil {
-		return model.FormModel{}, fmt.Errorf("parse operations: %w", err)
-	}
-	op, ok := operations[operationID]
-	if !ok {
-		return model.FormModel{}, fmt.Errorf("operation %q not found", operationID)
+		return model.FormModel{}, fmt.Errorf("parse operation: %w", err)
 	}
 	form, err := builder.Build(pkgopenapi.FormFromOperation(op))
 	if err != nil {
@@ -24,6 +24,7 @@ func TestExampleBinariesBuild(t *testing.T) {
 		"cli":            {pkg: "./examples/cli"},
 		"http":           {dir: "examples/http", pkg: "."},
 		"multi-renderer": {pkg: "./examples/multi-renderer"},
+		"turbo":          {pkg: "./examples/turbo"},
 	}
 
 	for name, example := range examples {
@@ -32,6 +32,7 @@ var examplePackages = []struct {
 	{name: "cli", pkg: "./examples/cli"},
 	{name: "http", dir: "examples/http", pkg: "."},
 	{name: "multi-renderer", pkg: "./examples/multi-renderer"},
+	{name: "turbo", pkg: "./examples/turbo"},
 }
 
 func TestExamplesCompile(t *testing.T) {
@@ -11,7 +11,6 @@ import (
 	"io"
 	"io/fs"
 	"log"
-	"maps"
 	"net"
 	"net/http"
 	"net/url"
@@ -777,12 +776,10 @@ window.addEventListener('DOMContentLoaded', function () {
   }
   var modalRegistry = buildCreateModalRegistry();
   var editModalRegistry = buildEditModalRegistry();
-  var config = {
-    onValidationError: function (ctx, error) {
-      console.warn('[formgen] validation failed', ctx.field.name, error.message);
-    },
-    cache: { strategy: 'none' },
-  };
+  // Cache policy, hooks, and field links come from the renderer-emitted
+  // #formgen-config block (render.RenderOptions.Runtime); only callbacks
+  // that need page code are passed here.
+  var config = {};
   if (Object.keys(modalRegistry).length > 0) {
     config.onCreateAction = function (_context, detail) {
       if (!detail || !detail.actionId) {
@@ -827,10 +824,21 @@ window.addEventListener('DOMContentLoaded', function () {
 
 const advancedRendererName = "vanilla"
 const advancedMainOperation = "post-book:create"
+const advancedMainFormID = "mainForm"
+
+// advancedFieldLinks prefills the author modal's publisher with the publisher
+// picked on the main book form.
+var advancedFieldLinks = []render.FieldLink{
+	{From: advancedMainFormID + ".publisher_id", To: "authorModal.publisher_id"},
+}
+
+// advancedRenderConcurrency bounds the workers rendering the advanced page.
+const advancedRenderConcurrency = 4
 
 type createModalSpec struct {
 	ActionID     string
 	OperationID  string
+	FormID       string
 	ValueField   string
 	LabelField   string
 	PrefillField string
@@ -846,14 +854,17 @@ type editModalSpec struct {
 
 type advancedModalRenderSpec struct {
 	OperationID string
+	FormID      string
 	Tags        []string
-	Data        map[string]any
+	Exclude     []string
+	Modal       render.ModalOptions
 }
 
 var advancedCreateModals = []createModalSpec{
 	{
 		ActionID:     "author",
 		OperationID:  "post-author:create",
+		FormID:       "authorModal",
 		ValueField:   "id",
 		LabelField:   "full_name",
 		PrefillField: "full_name",
@@ -1409,9 +1420,20 @@ func renderOptionsForRequest(request formRequest) render.RenderOptions {
 	if request.methodOverride != "" {
 		renderOptions.Method = request.methodOverride
 	}
+	renderOptions.Runtime = exampleRuntimeConfig()
 	return renderOptions
 }
 
+// exampleRuntimeConfig disables the option cache so the demo's _delay
+// parameter is visible on every search, and logs rejected selections.
+func exampleRuntimeConfig(links ...render.FieldLink) *render.RuntimeConfig {
+	return &render.RuntimeConfig{
+		Cache: &render.RuntimeCacheConfig{Strategy: "none"},
+		Hooks: []render.RuntimeHook{render.RuntimeHookValidationError},
+		Links: links,
+	}
+}
+
 func (s *formServer) applySubmittedValues(w http.ResponseWriter, r *http.Request, document pkgopenapi.Document, operation string, renderOptions render.RenderOptions) (render.RenderOptions, bool) {
 	formModel, buildErr := s.buildFormModel(r.Context(), document, operation)
 	if buildErr != nil {
@@ -1493,26 +1515,16 @@ func (s *formServer) advancedHandler() http.Handler {
 			return
 		}
 
-		mainOutput, err := s.generator.Generate(r.Context(), orchestrator.Request{
-			Document:    &document,
-			OperationID: advancedMainOperation,
-			Renderer:    advancedRendererName,
-		})
-		if err != nil {
-			http.Error(w, fmt.Sprintf("generate main form: %v", err), http.StatusInternalServerError)
-			return
-		}
-
-		modals, err := s.generateAdvancedModals(r.Context(), document, advancedCreateModalRenderSpecs())
-		if err != nil {
-			http.Error(w, fmt.Sprintf("generate modal forms: %v", err), http.StatusInternalServerError)
-			return
-		}
-		editModals, err := s.generateAdvancedModals(r.Context(), document, advancedEditModalRenderSpecs())
+		createSpecs := advancedCreateModalRenderSpecs()
+		editSpecs := advancedEditModalRenderSpecs()
+		outputs, err := s.generateAdvancedForms(r.Context(), document, append(createSpecs, editSpecs...))
 		if err != nil {
-			http.Error(w, fmt.Sprintf("generate edit modal forms: %v", err), http.StatusInternalServerError)
+			http.Error(w, fmt.Sprintf("generate forms: %v", err), http.StatusInternalServerError)
 			return
 		}
+		mainOutput := outputs[0]
+		modals := advancedModals(outputs[1 : 1+len(createSpecs)])
+		editModals := advancedModals(outputs[1+len(createSpecs):])
 
 		page, err := s.templates.RenderTemplate("advanced", map[string]any{
 			"main_form_html":    string(mainOutput),
@@ -1537,12 +1549,14 @@ func advancedCreateModalRenderSpecs() []advancedModalRenderSpec {
 	for _, spec := range advancedCreateModals {
 		specs = append(specs, advancedModalRenderSpec{
 			OperationID: spec.OperationID,
+			FormID:      spec.FormID,
 			Tags:        []string{"modal-min"},
-			Data: map[string]any{
-				"action_id":     spec.ActionID,
-				"value_field":   spec.ValueField,
-				"label_field":   spec.LabelField,
-				"prefill_field": spec.PrefillField,
+			Exclude:     []string{"id"},
+			Modal: render.ModalOptions{
+				ActionID:     spec.ActionID,
+				ValueField:   spec.ValueField,
+				LabelField:   spec.LabelField,
+				PrefillField: spec.PrefillField,
 			},
 		})
 	}
@@ -1555,57 +1569,72 @@ func advancedEditModalRenderSpecs() []advancedModalRenderSpec {
 		specs = append(specs, advancedModalRenderSpec{
 			OperationID: spec.OperationID,
 			Tags:        []string{"modal-edit"},
-			Data: map[string]any{
-				"action_id":   spec.ActionID,
-				"value_field": spec.ValueField,
-				"label_field": spec.LabelField,
-				"record_url":  spec.RecordURL,
+			Modal: render.ModalOptions{
+				Mode:       render.ModalModeEdit,
+				ActionID:   spec.ActionID,
+				ValueField: spec.ValueField,
+				LabelField: spec.LabelField,
+				RecordURL:  spec.RecordURL,
 			},
 		})
 	}
 	return specs
 }
 
-func (s *formServer) generateAdvancedModals(
+// generateAdvancedForms renders the main form followed by one modal form per
+// spec; the modal variant supplies each dialog shell. The forms share a document, so they render in parallel through
+// GenerateMany; outputs keep the same order.
+func (s *formServer) generateAdvancedForms(
 	ctx context.Context,
 	document pkgopenapi.Document,
 	specs []advancedModalRenderSpec,
-) ([]map[string]any, error) {
-	modals := make([]map[string]any, 0, len(specs))
+) ([][]byte, error) {
+	reqs := make([]orchestrator.Request, 0, len(specs)+1)
+	reqs = append(reqs, orchestrator.Request{
+		Document:    &document,
+		OperationID: advancedMainOperation,
+		Renderer:    advancedRendererName,
+		RenderOptions: render.RenderOptions{
+			FormID:  advancedMainFormID,
+			Runtime: exampleRuntimeConfig(advancedFieldLinks...),
+		},
+	})
 	for _, spec := range specs {
-		modalOutput, err := s.generator.Generate(ctx, orchestrator.Request{
+		modal := spec.Modal
+		reqs = append(reqs, orchestrator.Request{
 			Document:    &document,
 			OperationID: spec.OperationID,
 			Renderer:    advancedRendererName,
 			RenderOptions: render.RenderOptions{
 				Subset: render.FieldSubset{
-					Tags: spec.Tags,
+					Tags:    spec.Tags,
+					Exclude: spec.Exclude,
 				},
 				OmitAssets: true, // Modals inherit assets from the parent page
+				FormID:     spec.FormID,
+				Variant:    render.VariantModal,
+				Modal:      &modal,
 			},
 		})
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", spec.OperationID, err)
-		}
-		modal := make(map[string]any, len(spec.Data)+1)
-		maps.Copy(modal, spec.Data)
-		modal["form_html"] = string(modalOutput)
-		modals = append(modals, modal)
 	}
-	return modals, nil
+	return s.generator.GenerateMany(ctx, reqs, advancedRenderConcurrency)
+}
+
+func advancedModals(outputs [][]byte) []string {
+	modals := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		modals = append(modals, string(output))
+	}
+	return modals
 }
 
 func (s *formServer) buildFormModel(ctx context.Context, document pkgopenapi.Document, operation string) (model.FormModel, error) {
 	if s.parser == nil || s.builder == nil {
 		return model.FormModel{}, fmt.Errorf("form server missing parser or builder")
 	}
-	operations, err := s.parser.Operations(ctx, document)
+	op, err := pkgopenapi.ParseOperation(ctx, s.parser, document, operation)
 	if err != nil {
-		return model.FormModel{}, fmt.Errorf("parse operations: %w", err)
-	}
-	op, ok := operations[operation]
-	if !ok {
-		return model.FormModel{}, fmt.Errorf("operation %q not found", operation)
+		return model.FormModel{}, fmt.Errorf("parse operation: %w", err)
 	}
 	form, err := s.builder.Build(pkgopenapi.FormFromOperation(op))
 	if err != nil {
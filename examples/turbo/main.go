@@ -0,0 +1,156 @@
+// Command turbo serves the petstore createPet form to Hotwire Turbo instead of
+// the bundled formgen runtime. The form renders inside a <turbo-frame>;
+// rejected submissions are answered by turbo.RespondInvalid with a 422 Turbo
+// Stream (or frame-wrapped HTML when the request did not come from Turbo) and
+// accepted ones redirect with 303 See Other, as Turbo expects.
+//
+//	go run ./examples/turbo --addr 127.0.0.1:8080
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"html"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-formgen"
+	"github.com/goliatone/go-formgen/examples/internal/exampleutil"
+	"github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/submission"
+	"github.com/goliatone/go-formgen/pkg/turbo"
+)
+
+const turboScript = "https://cdn.jsdelivr.net/npm/@hotwired/turbo@8/dist/turbo.es2017-esm.js"
+
+type server struct {
+	form     model.FormModel
+	renderer render.Renderer
+
+	mu    sync.Mutex
+	saved []string
+}
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8080", "listen address")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	srv, err := newServer(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", srv.handleIndex)
+	mux.HandleFunc("POST /pets", srv.handleCreate)
+
+	httpServer := &http.Server{Addr: *addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("listening on %s", listener.Addr())
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+	if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
+
+func newServer(ctx context.Context) (*server, error) {
+	renderer, err := vanilla.New(vanilla.WithTemplatesFS(formgen.EmbeddedTemplates()))
+	if err != nil {
+		return nil, err
+	}
+	form, err := formgen.NewOrchestrator().BuildFormModel(ctx, orchestrator.BuildRequest{
+		Source:      pkgopenapi.SourceFromFile(exampleutil.FixturePath("petstore.json")),
+		OperationID: "createPet",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &server{form: form, renderer: renderer}, nil
+}
+
+// renderOptions keeps the form inside its frame while breaking out to the
+// full page on success, so the 303 redirect reloads the pet list.
+func renderOptions() render.RenderOptions {
+	return render.RenderOptions{
+		RenderMode: render.RenderModeForm,
+		Turbo:      &render.TurboOptions{Target: "_top"},
+	}
+}
+
+func (s *server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	output, err := s.renderer.Render(r.Context(), s.form, renderOptions())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintf(w, "<!doctype html>\n<html><head><meta charset=\"utf-8\"><title>Turbo pets</title>"+
+		"<script type=\"module\" src=\"%s\"></script></head><body>\n", turboScript)
+	s.mu.Lock()
+	saved := append([]string(nil), s.saved...)
+	s.mu.Unlock()
+	fmt.Fprintf(w, "<h1>Pets (%d)</h1><ul>", len(saved))
+	for _, name := range saved {
+		fmt.Fprintf(w, "<li>%s</li>", html.EscapeString(name))
+	}
+	fmt.Fprintf(w, "</ul>\n%s\n</body></html>\n", output)
+}
+
+func (s *server) handleCreate(w http.ResponseWriter, r *http.Request) {
+	result, err := submission.ParseRequest(s.form, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	issues := append(result.Issues, submission.Validate(s.form, result.Values)...)
+	if len(issues) > 0 {
+		opts := renderOptions()
+		opts.Values = submittedValues(r)
+		opts.Errors, opts.FormErrors = submission.IssuesToFieldAndFormErrors(s.form, issues)
+		if err := turbo.RespondInvalid(r.Context(), w, r, s.renderer, s.form, opts); err != nil {
+			log.Printf("respond invalid: %v", err)
+		}
+		return
+	}
+
+	if name, ok := result.Values["name"].(string); ok {
+		s.mu.Lock()
+		s.saved = append(s.saved, name)
+		s.mu.Unlock()
+	}
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// submittedValues echoes the raw form post back into the re-rendered controls.
+func submittedValues(r *http.Request) map[string]any {
+	values := make(map[string]any, len(r.PostForm))
+	for key, list := range r.PostForm {
+		if len(list) > 0 {
+			values[key] = list[0]
+		}
+	}
+	return values
+}
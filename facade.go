@@ -0,0 +1,61 @@
+package formgen
+
+import (
+	"context"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+)
+
+// APIVersion identifies the frozen top-level API surface described by API.
+// A future formgen/v2 module will ship its own API implementation so callers
+// written against the interface can switch versions without other changes.
+const APIVersion = "v1"
+
+// API is the stable facade over the top-level helpers. Its method set only
+// grows in backwards-compatible ways within a major version; internal
+// packages may be reshaped underneath it, with shims reporting through
+// pkg/deprecation.
+type API interface {
+	NewLoader(options ...pkgopenapi.LoaderOption) pkgopenapi.Loader
+	NewParser(options ...pkgopenapi.ParserOption) pkgopenapi.Parser
+	NewOrchestrator(options ...orchestrator.Option) Generator
+	GenerateHTML(ctx context.Context, source pkgopenapi.Source, operationID, rendererName string, options ...orchestrator.Option) ([]byte, error)
+	GenerateHTMLFromDocument(ctx context.Context, doc pkgopenapi.Document, operationID, rendererName string, options ...orchestrator.Option) ([]byte, error)
+}
+
+// Generator is the orchestrator surface guaranteed by API. It is satisfied by
+// *orchestrator.Orchestrator; type-assert to it for the full method set.
+type Generator interface {
+	Generate(ctx context.Context, req orchestrator.Request) ([]byte, error)
+	BuildFormModel(ctx context.Context, req orchestrator.BuildRequest) (model.FormModel, error)
+	LoadDocument(ctx context.Context, src pkgopenapi.Source) (pkgopenapi.Document, error)
+}
+
+// V1 returns the API backed by the package-level functions.
+func V1() API {
+	return v1API{}
+}
+
+type v1API struct{}
+
+func (v1API) NewLoader(options ...pkgopenapi.LoaderOption) pkgopenapi.Loader {
+	return NewLoader(options...)
+}
+
+func (v1API) NewParser(options ...pkgopenapi.ParserOption) pkgopenapi.Parser {
+	return NewParser(options...)
+}
+
+func (v1API) NewOrchestrator(options ...orchestrator.Option) Generator {
+	return NewOrchestrator(options...)
+}
+
+func (v1API) GenerateHTML(ctx context.Context, source pkgopenapi.Source, operationID, rendererName string, options ...orchestrator.Option) ([]byte, error) {
+	return GenerateHTML(ctx, source, operationID, rendererName, options...)
+}
+
+func (v1API) GenerateHTMLFromDocument(ctx context.Context, doc pkgopenapi.Document, operationID, rendererName string, options ...orchestrator.Option) ([]byte, error) {
+	return GenerateHTMLFromDocument(ctx, doc, operationID, rendererName, options...)
+}
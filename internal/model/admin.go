@@ -0,0 +1,107 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// AdminMetadata is the typed view of the x-admin extension. The builder maps
+// recognised x-admin keys onto "admin.*" metadata entries; the accessors below
+// decode those entries so admin-generator integrations do not have to parse
+// raw strings.
+type AdminMetadata struct {
+	Label          string   `json:"label,omitempty"`
+	Group          string   `json:"group,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	Widget         string   `json:"widget,omitempty"`
+	Help           string   `json:"help,omitempty"`
+	Placeholder    string   `json:"placeholder,omitempty"`
+	VisibilityRule string   `json:"visibilityRule,omitempty"`
+	Order          *int     `json:"order,omitempty"`
+	Readonly       bool     `json:"readonly,omitempty"`
+	Hidden         bool     `json:"hidden,omitempty"`
+	List           bool     `json:"list,omitempty"`
+	Sortable       bool     `json:"sortable,omitempty"`
+	Filterable     bool     `json:"filterable,omitempty"`
+	Searchable     bool     `json:"searchable,omitempty"`
+}
+
+// AdminMetadata returns the x-admin metadata attached to the field. The
+// boolean reports whether any admin key was present.
+func (f Field) AdminMetadata() (AdminMetadata, bool) {
+	return adminMetadataFromMap(f.Metadata)
+}
+
+// AdminMetadata returns the x-admin metadata declared on the operation or
+// request body. The boolean reports whether any admin key was present.
+func (m FormModel) AdminMetadata() (AdminMetadata, bool) {
+	return adminMetadataFromMap(m.Metadata)
+}
+
+func adminMetadataFromMap(metadata map[string]string) (AdminMetadata, bool) {
+	var (
+		admin AdminMetadata
+		found bool
+	)
+	text := func(key string) string {
+		value, ok := metadata[key]
+		if ok {
+			found = true
+		}
+		return strings.TrimSpace(value)
+	}
+	flag := func(key string) bool {
+		return isTruthyString(text(key))
+	}
+
+	admin.Label = text("admin.label")
+	admin.Group = text("admin.group")
+	admin.Tags = adminTags(text("admin.tags"))
+	admin.Widget = text("admin.widget")
+	admin.Help = text("admin.help")
+	admin.Placeholder = text("admin.placeholder")
+	admin.VisibilityRule = text("admin.visibilityRule")
+	if raw := text("admin.order"); raw != "" {
+		if order, err := strconv.Atoi(raw); err == nil {
+			admin.Order = &order
+		}
+	}
+	admin.Readonly = flag("admin.readonly")
+	admin.Hidden = flag("admin.hidden")
+	admin.List = flag("admin.list")
+	admin.Sortable = flag("admin.sortable")
+	admin.Filterable = flag("admin.filterable")
+	admin.Searchable = flag("admin.searchable")
+	return admin, found
+}
+
+// adminTags accepts either a JSON array (the canonical form for list values)
+// or a comma separated string.
+func adminTags(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	var parts []string
+	if strings.HasPrefix(raw, "[") {
+		var parsed []any
+		if err := json.Unmarshal([]byte(raw), &parsed); err == nil {
+			for _, entry := range parsed {
+				parts = append(parts, toString(entry))
+			}
+		}
+	}
+	if parts == nil {
+		parts = strings.Split(raw, ",")
+	}
+	tags := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if tag := strings.TrimSpace(part); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"maps"
@@ -8,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/goliatone/go-formgen/pkg/schema"
 )
@@ -16,12 +18,15 @@ const (
 	extensionNamespace       = "x-formgen"
 	adminExtensionNamespace  = "x-admin"
 	endpointExtensionKey     = "x-endpoint"
+	validateEndpointKey      = "x-validate-endpoint"
 	currentValueExtensionKey = "x-current-value"
 )
 
 // Builder converts canonical schema forms into form models.
 type Builder struct {
-	opts Options
+	opts      Options
+	endpoints siblingEndpoints
+	ctx       context.Context
 }
 
 // New creates a Builder with the supplied options.
@@ -30,6 +35,16 @@ func New(options Options) *Builder {
 	if options.Labeler != nil {
 		opts.Labeler = options.Labeler
 	}
+	opts.ExtensionNamespaces = append([]string(nil), options.ExtensionNamespaces...)
+	opts.DeriveSiblingEndpoints = options.DeriveSiblingEndpoints
+	opts.TitledSections = options.TitledSections
+	opts.ParameterFields = options.ParameterFields
+	opts.EnumDisplay = options.EnumDisplay
+	opts.EnumDisplayMax = options.EnumDisplayMax
+	if options.RelationshipInference != nil {
+		conventions := options.RelationshipInference.withDefaults()
+		opts.RelationshipInference = &conventions
+	}
 	return &Builder{opts: opts}
 }
 
@@ -37,9 +52,50 @@ func New(options Options) *Builder {
 // rendering. It focuses on request bodies and metadata as described in the
 // README.
 func (b *Builder) Build(form schema.Form) (FormModel, error) {
+	return b.build(form)
+}
+
+// BuildWithSiblings builds form like Build and, when
+// Options.DeriveSiblingEndpoints is enabled, derives relationship endpoints
+// from GET collection operations among siblings (typically every form of the
+// same document).
+func (b *Builder) BuildWithSiblings(form schema.Form, siblings []schema.Form) (FormModel, error) {
+	if !b.opts.DeriveSiblingEndpoints || len(siblings) == 0 {
+		return b.build(form)
+	}
+	scoped := &Builder{opts: b.opts, endpoints: indexSiblingEndpoints(siblings)}
+	return scoped.build(form)
+}
+
+// BuildContext builds form like BuildWithSiblings (siblings may be nil) and
+// stops with ctx.Err() once ctx is done. The context is checked for every
+// schema node, so large or deeply nested schemas abort promptly.
+func (b *Builder) BuildContext(ctx context.Context, form schema.Form, siblings []schema.Form) (FormModel, error) {
+	scoped := &Builder{opts: b.opts, endpoints: b.endpoints, ctx: ctx}
+	if b.opts.DeriveSiblingEndpoints && len(siblings) > 0 {
+		scoped.endpoints = indexSiblingEndpoints(siblings)
+	}
+	return scoped.build(form)
+}
+
+func (b *Builder) ctxErr() error {
+	if b.ctx == nil {
+		return nil
+	}
+	return b.ctx.Err()
+}
+
+func (b *Builder) build(form schema.Form) (FormModel, error) {
+	if err := b.ctxErr(); err != nil {
+		return FormModel{}, err
+	}
 	if err := validateForm(form); err != nil {
 		return FormModel{}, err
 	}
+	if len(b.opts.ExtensionNamespaces) > 0 {
+		form.Extensions = schema.CanonicalExtensions(form.Extensions, b.opts.ExtensionNamespaces)
+		form.Schema = schema.CanonicalSchemaExtensions(form.Schema, b.opts.ExtensionNamespaces)
+	}
 
 	output := FormModel{
 		OperationID: form.ID,
@@ -47,6 +103,7 @@ func (b *Builder) Build(form schema.Form) (FormModel, error) {
 		Method:      strings.ToUpper(form.Method),
 		Summary:     form.Summary,
 		Description: form.Description,
+		MediaType:   form.MediaType,
 	}
 
 	if output.Metadata == nil {
@@ -64,12 +121,32 @@ func (b *Builder) Build(form schema.Form) (FormModel, error) {
 	mergeMetadata(output.Metadata, bodyMeta)
 	output.UIHints = mergeUIHints(output.UIHints, formHints)
 	output.UIHints = mergeUIHints(output.UIHints, bodyHints)
+	if err := applySecurityMetadata(output.Metadata, form); err != nil {
+		return FormModel{}, err
+	}
+	if err := applyErrorMappingMetadata(output.Metadata, form); err != nil {
+		return FormModel{}, err
+	}
 
 	fields, err := b.fieldsFromSchema("", form.Schema, true)
 	if err != nil {
 		return FormModel{}, err
 	}
 	output.Fields = fields
+	if b.opts.ParameterFields {
+		output.Fields, err = b.appendParameterFields(output.Fields, form.Parameters)
+		if err != nil {
+			return FormModel{}, err
+		}
+	}
+	if b.opts.TitledSections {
+		if err := applyTitledSections(&output, form.Schema); err != nil {
+			return FormModel{}, err
+		}
+	}
+	if b.opts.EnumDisplay != "" {
+		applyEnumDisplayDefaults(output.Fields, b.opts.EnumDisplay, b.opts.EnumDisplayMax)
+	}
 
 	if len(output.Metadata) == 0 {
 		output.Metadata = nil
@@ -82,6 +159,9 @@ func (b *Builder) Build(form schema.Form) (FormModel, error) {
 }
 
 func (b *Builder) fieldsFromSchema(name string, schema schema.Schema, required bool) ([]Field, error) {
+	if err := b.ctxErr(); err != nil {
+		return nil, err
+	}
 	if schema.Ref != "" && schema.Type == "" && len(schema.Properties) == 0 {
 		// Unresolved reference; capture metadata for consumers to handle.
 		field := Field{
@@ -97,6 +177,7 @@ func (b *Builder) fieldsFromSchema(name string, schema schema.Schema, required b
 		refMeta, refHints := ParseUIExtensions(schema.Extensions)
 		mergeMetadata(field.Metadata, refMeta)
 		field.Relationship = relationshipFromExtensions(schema.Extensions)
+		b.deriveSiblingEndpoint(&field)
 		field.UIHints = mergeUIHints(field.UIHints, refHints)
 		applyRelationshipHints(&field)
 		applyReadonlyAnnotation(&field, schema)
@@ -145,6 +226,9 @@ func (b *Builder) fieldsFromObject(name string, schema schema.Schema, required b
 		if err != nil {
 			return nil, err
 		}
+		if len(converted) == 1 {
+			applyEncoding(&converted[0], propSchema.Encoding)
+		}
 		fields = append(fields, converted...)
 	}
 
@@ -171,6 +255,7 @@ func (b *Builder) fieldsFromObject(name string, schema schema.Schema, required b
 		parentMeta, parentHints := ParseUIExtensions(schema.Extensions)
 		mergeMetadata(parent.ensureMetadata(), parentMeta)
 		parent.Relationship = relationshipFromExtensions(schema.Extensions)
+		b.deriveSiblingEndpoint(&parent)
 		parent.UIHints = mergeUIHints(parent.UIHints, parentHints)
 		applyRelationshipHints(&parent)
 		applyReadonlyAnnotation(&parent, schema)
@@ -307,7 +392,9 @@ func (b *Builder) fieldFromArray(name string, schema schema.Schema, required boo
 	arrayMeta, arrayHints := ParseUIExtensions(schema.Extensions)
 	mergeMetadata(field.ensureMetadata(), arrayMeta)
 	field.Relationship = relationshipFromExtensions(schema.Extensions)
+	b.deriveSiblingEndpoint(&field)
 	field.UIHints = mergeUIHints(field.UIHints, arrayHints)
+	applyMultipleFileHints(&field)
 	applyRelationshipHints(&field)
 	propagateRelationshipToItems(&field)
 	applyReadonlyAnnotation(&field, schema)
@@ -373,6 +460,7 @@ func (b *Builder) fieldFromPrimitive(name string, schema schema.Schema, required
 		Required:    required,
 		Default:     schema.Default,
 		Sensitive:   isSensitiveSchema(schema),
+		Nullable:    schema.Nullable,
 	}
 	if len(schema.Enum) > 0 {
 		field.Enum = append([]any(nil), schema.Enum...)
@@ -385,6 +473,8 @@ func (b *Builder) fieldFromPrimitive(name string, schema schema.Schema, required
 	primitiveMeta, primitiveHints := ParseUIExtensions(schema.Extensions)
 	mergeMetadata(field.ensureMetadata(), primitiveMeta)
 	field.Relationship = relationshipFromExtensions(schema.Extensions)
+	b.deriveSiblingEndpoint(&field)
+	inferRelationship(&field, b.opts.RelationshipInference)
 	field.UIHints = mergeUIHints(field.UIHints, primitiveHints)
 	applyFormatHints(&field)
 	applyRelationshipHints(&field)
@@ -590,6 +680,13 @@ func metadataFromExtensions(ext map[string]any) map[string]string {
 		mergeMetadata(result, endpointMeta)
 	}
 
+	if validateMeta := validateEndpointMetadataFromExtensions(ext); len(validateMeta) > 0 {
+		if len(result) == 0 {
+			result = make(map[string]string, len(validateMeta))
+		}
+		mergeMetadata(result, validateMeta)
+	}
+
 	if currentValue, ok := currentValueFromExtensions(ext); ok {
 		if len(result) == 0 {
 			result = make(map[string]string, 1)
@@ -710,6 +807,12 @@ var adminMetadataAliases = map[string][]string{
 	"placeholder":    {"admin.placeholder", "placeholder"},
 	"readonly":       {"admin.readonly", "readonly"},
 	"order":          {"admin.order", "order"},
+	"label":          {"admin.label"},
+	"hidden":         {"admin.hidden"},
+	"list":           {"admin.list"},
+	"sortable":       {"admin.sortable"},
+	"filterable":     {"admin.filterable"},
+	"searchable":     {"admin.searchable"},
 }
 
 func adminMetadataFromExtensions(ext map[string]any) map[string]string {
@@ -1230,12 +1333,18 @@ func endpointMetadataFromExtensions(ext map[string]any) map[string]string {
 		add("relationship.endpoint.method", strings.ToUpper(method))
 	}
 	add("relationship.endpoint.labelField", strings.TrimSpace(toString(endpointMap["labelField"])))
+	add("relationship.endpoint.labelTemplate", strings.TrimSpace(toString(endpointMap["labelTemplate"])))
 	add("relationship.endpoint.valueField", strings.TrimSpace(toString(endpointMap["valueField"])))
 	add("relationship.endpoint.resultsPath", strings.TrimSpace(toString(endpointMap["resultsPath"])))
 	add("relationship.endpoint.mode", strings.TrimSpace(toString(endpointMap["mode"])))
 	add("relationship.endpoint.searchParam", strings.TrimSpace(toString(endpointMap["searchParam"])))
 	add("relationship.endpoint.hydrateParam", strings.TrimSpace(toString(endpointMap["hydrateParam"])))
 	add("relationship.endpoint.submitAs", strings.TrimSpace(toString(endpointMap["submitAs"])))
+	add("relationship.endpoint.refreshIntervalMs", positiveIntString(endpointMap["refreshIntervalMs"]))
+	if cache := toAnyMap(endpointMap["cache"]); len(cache) > 0 {
+		add("relationship.endpoint.cache.ttl", cacheTTLMillis(cache["ttl"]))
+		add("relationship.endpoint.cache.strategy", cacheStrategy(cache["strategy"]))
+	}
 
 	addEndpointParams(meta, endpointMap)
 	addEndpointMapping(meta, endpointMap)
@@ -1247,6 +1356,74 @@ func endpointMetadataFromExtensions(ext map[string]any) map[string]string {
 	return meta
 }
 
+// positiveIntString normalises numeric extension values (JSON numbers decode
+// as float64) to a base-10 integer string, dropping non-positive values.
+func positiveIntString(value any) string {
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(toString(value)), 64)
+	if err != nil || parsed < 1 {
+		return ""
+	}
+	return strconv.FormatInt(int64(parsed), 10)
+}
+
+// cacheTTLMillis normalises a relationship cache TTL to milliseconds. Duration
+// strings ("30s", "5m") are parsed; bare numbers are taken as milliseconds.
+func cacheTTLMillis(value any) string {
+	raw := strings.TrimSpace(toString(value))
+	if duration, err := time.ParseDuration(raw); err == nil {
+		if duration < time.Millisecond {
+			return ""
+		}
+		return strconv.FormatInt(duration.Milliseconds(), 10)
+	}
+	return positiveIntString(raw)
+}
+
+// cacheStrategy keeps the relationship cache strategies the runtimes honour:
+// memory (per page or render), session (sessionStorage, or the renderer's
+// lifetime in the TUI) and none.
+func cacheStrategy(value any) string {
+	switch strategy := strings.ToLower(strings.TrimSpace(toString(value))); strategy {
+	case "memory", "session", "none":
+		return strategy
+	default:
+		return ""
+	}
+}
+
+// validateEndpointMetadataFromExtensions flattens x-validate-endpoint (remote
+// checks such as uniqueness) into validation.endpoint.* metadata.
+func validateEndpointMetadataFromExtensions(ext map[string]any) map[string]string {
+	if len(ext) == 0 {
+		return nil
+	}
+	endpointMap := toAnyMap(ext[validateEndpointKey])
+	if len(endpointMap) == 0 {
+		return nil
+	}
+	url := strings.TrimSpace(toString(endpointMap["url"]))
+	if url == "" {
+		return nil
+	}
+
+	meta := map[string]string{"validation.endpoint.url": url}
+	if method := strings.TrimSpace(toString(endpointMap["method"])); method != "" {
+		meta["validation.endpoint.method"] = strings.ToUpper(method)
+	}
+	if param := strings.TrimSpace(toString(endpointMap["param"])); param != "" {
+		meta["validation.endpoint.param"] = param
+	}
+	params := toStringMap(endpointMap["params"])
+	for _, key := range sortedKeys(params) {
+		meta["validation.endpoint.params."+key] = params[key]
+	}
+	dynamicParams := toStringMap(endpointMap["dynamicParams"])
+	for _, key := range sortedKeys(dynamicParams) {
+		meta["validation.endpoint.dynamicParams."+key] = dynamicParams[key]
+	}
+	return meta
+}
+
 func addEndpointParams(meta map[string]string, endpointMap map[string]any) {
 	params := toStringMap(endpointMap["params"])
 	for _, key := range sortedKeys(params) {
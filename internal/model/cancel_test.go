@@ -0,0 +1,55 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+// countdownContext reports cancellation after a number of Err calls, so tests
+// can cancel in the middle of a build.
+type countdownContext struct {
+	context.Context
+	remaining atomic.Int64
+}
+
+func (c *countdownContext) Err() error {
+	if c.remaining.Add(-1) < 0 {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestBuilderBuildContextStopsWhenCanceled(t *testing.T) {
+	properties := make(map[string]schema.Schema, 50)
+	for i := range 50 {
+		properties[fmt.Sprintf("field%02d", i)] = schema.Schema{
+			Type:       "object",
+			Properties: map[string]schema.Schema{"value": {Type: "string"}},
+		}
+	}
+	input := schema.Form{
+		ID:       "bulk",
+		Method:   "POST",
+		Endpoint: "/bulk",
+		Schema:   schema.Schema{Type: "object", Properties: properties},
+	}
+	builder := New(Options{})
+
+	if _, err := builder.BuildContext(context.Background(), input, nil); err != nil {
+		t.Fatalf("BuildContext: %v", err)
+	}
+
+	ctx := &countdownContext{Context: context.Background()}
+	ctx.remaining.Store(10)
+	if _, err := builder.BuildContext(ctx, input, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled mid-build, got %v", err)
+	}
+	if remaining := ctx.remaining.Load(); remaining != -1 {
+		t.Fatalf("expected the build to stop at the first canceled check, %d checks past it", -remaining-1)
+	}
+}
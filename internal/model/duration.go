@@ -0,0 +1,99 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Duration holds the components of an ISO-8601 duration such as "P1DT2H30M".
+// Components are kept as written rather than normalised, so "PT90M" stays 90
+// minutes.
+type Duration struct {
+	Years   int
+	Months  int
+	Weeks   int
+	Days    int
+	Hours   int
+	Minutes int
+	Seconds int
+}
+
+// DurationParts lists the Duration components in ISO-8601 order, using the
+// names accepted by the durationParts UI hint.
+var DurationParts = []string{"years", "months", "weeks", "days", "hours", "minutes", "seconds"}
+
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// ParseISODuration parses an ISO-8601 duration with whole-number components.
+func ParseISODuration(value string) (Duration, error) {
+	text := strings.ToUpper(strings.TrimSpace(value))
+	match := isoDurationPattern.FindStringSubmatch(text)
+	if match == nil || text == "P" || strings.HasSuffix(text, "T") {
+		return Duration{}, fmt.Errorf("model: invalid ISO-8601 duration %q", value)
+	}
+	var parts [7]int
+	for idx, raw := range match[1:] {
+		if raw == "" {
+			continue
+		}
+		number, err := strconv.Atoi(raw)
+		if err != nil {
+			return Duration{}, fmt.Errorf("model: invalid ISO-8601 duration %q: %w", value, err)
+		}
+		parts[idx] = number
+	}
+	return Duration{
+		Years: parts[0], Months: parts[1], Weeks: parts[2], Days: parts[3],
+		Hours: parts[4], Minutes: parts[5], Seconds: parts[6],
+	}, nil
+}
+
+// Part returns the component named by one of DurationParts.
+func (d Duration) Part(name string) int {
+	switch name {
+	case "years":
+		return d.Years
+	case "months":
+		return d.Months
+	case "weeks":
+		return d.Weeks
+	case "days":
+		return d.Days
+	case "hours":
+		return d.Hours
+	case "minutes":
+		return d.Minutes
+	case "seconds":
+		return d.Seconds
+	}
+	return 0
+}
+
+// String formats the duration in ISO-8601 form, omitting zero components.
+// The zero duration formats as "PT0S".
+func (d Duration) String() string {
+	var date, clock strings.Builder
+	writePart := func(builder *strings.Builder, value int, designator byte) {
+		if value != 0 {
+			builder.WriteString(strconv.Itoa(value))
+			builder.WriteByte(designator)
+		}
+	}
+	writePart(&date, d.Years, 'Y')
+	writePart(&date, d.Months, 'M')
+	writePart(&date, d.Weeks, 'W')
+	writePart(&date, d.Days, 'D')
+	writePart(&clock, d.Hours, 'H')
+	writePart(&clock, d.Minutes, 'M')
+	writePart(&clock, d.Seconds, 'S')
+	if date.Len() == 0 && clock.Len() == 0 {
+		return "PT0S"
+	}
+	out := "P" + date.String()
+	if clock.Len() > 0 {
+		out += "T" + clock.String()
+	}
+	return out
+}
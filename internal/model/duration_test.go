@@ -0,0 +1,30 @@
+package model
+
+import "testing"
+
+func TestParseISODurationRoundTrips(t *testing.T) {
+	cases := map[string]Duration{
+		"P1Y2M3W4DT5H6M7S": {Years: 1, Months: 2, Weeks: 3, Days: 4, Hours: 5, Minutes: 6, Seconds: 7},
+		"PT90M":            {Minutes: 90},
+		"P2D":              {Days: 2},
+		"PT0S":             {},
+	}
+	for input, want := range cases {
+		got, err := ParseISODuration(input)
+		if err != nil {
+			t.Fatalf("parse %q: %v", input, err)
+		}
+		if got != want {
+			t.Fatalf("parse %q = %+v, want %+v", input, got, want)
+		}
+		if got.String() != input {
+			t.Fatalf("format %+v = %q, want %q", got, got.String(), input)
+		}
+	}
+
+	for _, input := range []string{"", "P", "PT", "1D", "P1.5D", "P1DT"} {
+		if _, err := ParseISODuration(input); err == nil {
+			t.Fatalf("expected %q to be rejected", input)
+		}
+	}
+}
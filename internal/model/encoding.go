@@ -0,0 +1,115 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+const (
+	encodingContentTypeMetadataKey   = "encoding.contentType"
+	encodingHeadersMetadataKey       = "encoding.headers"
+	encodingStyleMetadataKey         = "encoding.style"
+	encodingExplodeMetadataKey       = "encoding.explode"
+	encodingAllowReservedMetadataKey = "encoding.allowReserved"
+)
+
+// Encoding returns the multipart/urlencoded part encoding declared for a
+// top-level body field. The builder records it as "encoding.*" metadata:
+// encoding.contentType, encoding.headers (comma separated), encoding.style,
+// encoding.explode, and encoding.allowReserved. The boolean reports whether
+// the field carries any encoding metadata.
+func (f Field) Encoding() (schema.Encoding, bool) {
+	var (
+		encoding schema.Encoding
+		found    bool
+	)
+	if value, ok := f.Metadata[encodingContentTypeMetadataKey]; ok {
+		encoding.ContentType = value
+		found = true
+	}
+	if value, ok := f.Metadata[encodingHeadersMetadataKey]; ok && value != "" {
+		encoding.Headers = strings.Split(value, ",")
+		found = true
+	}
+	if value, ok := f.Metadata[encodingStyleMetadataKey]; ok {
+		encoding.Style = value
+		found = true
+	}
+	if value, ok := f.Metadata[encodingExplodeMetadataKey]; ok {
+		explode := isTruthyString(value)
+		encoding.Explode = &explode
+		found = true
+	}
+	if value, ok := f.Metadata[encodingAllowReservedMetadataKey]; ok {
+		encoding.AllowReserved = isTruthyString(value)
+		found = true
+	}
+	return encoding, found
+}
+
+func applyEncoding(field *Field, encoding *schema.Encoding) {
+	if field == nil || encoding == nil {
+		return
+	}
+	metadata := field.ensureMetadata()
+	if encoding.ContentType != "" {
+		metadata[encodingContentTypeMetadataKey] = encoding.ContentType
+	}
+	if len(encoding.Headers) > 0 {
+		metadata[encodingHeadersMetadataKey] = strings.Join(encoding.Headers, ",")
+	}
+	if encoding.Style != "" {
+		metadata[encodingStyleMetadataKey] = encoding.Style
+	}
+	if encoding.Explode != nil {
+		metadata[encodingExplodeMetadataKey] = strconv.FormatBool(*encoding.Explode)
+	}
+	if encoding.AllowReserved {
+		metadata[encodingAllowReservedMetadataKey] = "true"
+	}
+	field.normalizeMetadata()
+
+	// File inputs without an explicit accept hint inherit the part content
+	// type so browsers filter the picker.
+	if field.UIHints["inputType"] != "file" || field.UIHints["accept"] != "" {
+		return
+	}
+	if accept := acceptFromContentType(encoding.ContentType); accept != "" {
+		field.UIHints["accept"] = accept
+	}
+}
+
+func acceptFromContentType(contentType string) string {
+	var accepted []string
+	for _, part := range strings.Split(contentType, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		switch part {
+		case "", "*/*", "application/octet-stream":
+			return ""
+		}
+		accepted = append(accepted, part)
+	}
+	return strings.Join(accepted, ",")
+}
+
+// applyMultipleFileHints renders arrays of binary strings as a single file
+// input that accepts several files.
+func applyMultipleFileHints(field *Field) {
+	if field == nil || field.Items == nil || field.Items.UIHints["inputType"] != "file" {
+		return
+	}
+	if field.UIHints == nil {
+		field.UIHints = make(map[string]string, 2)
+	}
+	if field.UIHints["inputType"] == "" {
+		field.UIHints["inputType"] = "file"
+	}
+	if field.UIHints["inputType"] == "file" && field.UIHints["multiple"] == "" {
+		field.UIHints["multiple"] = "true"
+	}
+	if field.UIHints["accept"] == "" && field.Items.UIHints["accept"] != "" {
+		field.UIHints["accept"] = field.Items.UIHints["accept"]
+	}
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+func TestBuildCopiesEndpointLabelTemplate(t *testing.T) {
+	form := schema.Form{
+		ID:       "createBook",
+		Method:   "POST",
+		Endpoint: "/books",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"author_id": {
+					Type: "string",
+					Extensions: map[string]any{
+						"x-endpoint": map[string]any{
+							"url":           "/authors",
+							"valueField":    "id",
+							"labelTemplate": " {{item.first}} {{item.last}} ",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	built, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if len(built.Fields) != 1 {
+		t.Fatalf("fields = %d, want 1", len(built.Fields))
+	}
+	if got := built.Fields[0].Metadata["relationship.endpoint.labelTemplate"]; got != "{{item.first}} {{item.last}}" {
+		t.Fatalf("labelTemplate = %q", got)
+	}
+}
+
+func TestBuildNormalisesEndpointCache(t *testing.T) {
+	endpoint := func(cache map[string]any) schema.Schema {
+		return schema.Schema{
+			Type: "string",
+			Extensions: map[string]any{
+				"x-endpoint": map[string]any{"url": "/authors", "cache": cache},
+			},
+		}
+	}
+	form := schema.Form{
+		ID:       "createBook",
+		Method:   "POST",
+		Endpoint: "/books",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"author_id":    endpoint(map[string]any{"ttl": "5m", "strategy": "Session"}),
+				"publisher_id": endpoint(map[string]any{"ttl": float64(1500), "strategy": "none"}),
+				"editor_id":    endpoint(map[string]any{"ttl": "soon", "strategy": "disk"}),
+			},
+		},
+	}
+
+	built, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	want := map[string][2]string{
+		"author_id":    {"300000", "session"},
+		"publisher_id": {"1500", "none"},
+		"editor_id":    {"", ""},
+	}
+	for _, field := range built.Fields {
+		expected := want[field.Name]
+		if got := field.Metadata["relationship.endpoint.cache.ttl"]; got != expected[0] {
+			t.Fatalf("%s cache.ttl = %q, want %q", field.Name, got, expected[0])
+		}
+		if got := field.Metadata["relationship.endpoint.cache.strategy"]; got != expected[1] {
+			t.Fatalf("%s cache.strategy = %q, want %q", field.Name, got, expected[1])
+		}
+	}
+}
+
+func TestBuildFlattensValidateEndpoint(t *testing.T) {
+	form := schema.Form{
+		ID:       "createArticle",
+		Method:   "POST",
+		Endpoint: "/articles",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"slug": {
+					Type: "string",
+					Extensions: map[string]any{
+						"x-validate-endpoint": map[string]any{
+							"url":           "/articles/slug-check",
+							"method":        "post",
+							"param":         "slug",
+							"params":        map[string]any{"scope": "articles"},
+							"dynamicParams": map[string]any{"tenant_id": "{{field:tenant_id}}"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	built, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	meta := built.Fields[0].Metadata
+	want := map[string]string{
+		"validation.endpoint.url":                     "/articles/slug-check",
+		"validation.endpoint.method":                  "POST",
+		"validation.endpoint.param":                   "slug",
+		"validation.endpoint.params.scope":            "articles",
+		"validation.endpoint.dynamicParams.tenant_id": "{{field:tenant_id}}",
+	}
+	for key, value := range want {
+		if meta[key] != value {
+			t.Fatalf("%s = %q, want %q (metadata %#v)", key, meta[key], value, meta)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+// EnumDisplay selects how enum fields render: as a select, or as a radio
+// group (single value) or checkbox group (array of values).
+type EnumDisplay string
+
+const (
+	// EnumDisplayAuto renders choice groups when the enum has at most
+	// enumDisplayMax options and a select otherwise.
+	EnumDisplayAuto EnumDisplay = "auto"
+	// EnumDisplaySelect always renders a select. This is the default.
+	EnumDisplaySelect EnumDisplay = "select"
+	// EnumDisplayRadio renders single-value enums as a radio group.
+	EnumDisplayRadio EnumDisplay = "radio"
+	// EnumDisplayCheckbox renders array enums as a checkbox group.
+	EnumDisplayCheckbox EnumDisplay = "checkbox"
+)
+
+const (
+	// EnumDisplayHintKey is the UI hint carrying the field's EnumDisplay.
+	EnumDisplayHintKey = "enumDisplay"
+	// EnumDisplayMaxHintKey is the UI hint carrying the option threshold used
+	// by EnumDisplayAuto.
+	EnumDisplayMaxHintKey = "enumDisplayMax"
+	// DefaultEnumDisplayMax is the EnumDisplayAuto threshold when the field
+	// does not set enumDisplayMax.
+	DefaultEnumDisplayMax = 5
+)
+
+// ResolveEnumDisplay reports whether a field should render as a radio group,
+// a checkbox group, or a select, based on its enumDisplay hint and number of
+// choices. Radio applies to single-value enums and checkbox to array enums; a
+// mismatched hint, a relationship field, or a field without static choices
+// resolves to "" so renderers keep their default control.
+func ResolveEnumDisplay(field Field) EnumDisplay {
+	choices, multiple := enumChoices(field)
+	if choices == 0 {
+		return ""
+	}
+	group := EnumDisplayRadio
+	if multiple {
+		group = EnumDisplayCheckbox
+	}
+
+	switch EnumDisplay(strings.ToLower(strings.TrimSpace(field.UIHints[EnumDisplayHintKey]))) {
+	case EnumDisplaySelect:
+		return EnumDisplaySelect
+	case EnumDisplayRadio:
+		if !multiple {
+			return EnumDisplayRadio
+		}
+	case EnumDisplayCheckbox:
+		if multiple {
+			return EnumDisplayCheckbox
+		}
+	case EnumDisplayAuto:
+		if choices <= enumDisplayMax(field) {
+			return group
+		}
+		return EnumDisplaySelect
+	}
+	return ""
+}
+
+// enumChoices returns the number of static choices a field offers and whether
+// several may be selected (array fields with enum items).
+func enumChoices(field Field) (int, bool) {
+	if field.Relationship != nil {
+		return 0, false
+	}
+	if field.Type == FieldTypeArray {
+		if field.Items == nil || field.Items.Relationship != nil {
+			return 0, true
+		}
+		return max(len(field.Items.Options), len(field.Items.Enum)), true
+	}
+	return max(len(field.Options), len(field.Enum)), false
+}
+
+func enumDisplayMax(field Field) int {
+	if raw := strings.TrimSpace(field.UIHints[EnumDisplayMaxHintKey]); raw != "" {
+		if value, err := strconv.Atoi(raw); err == nil && value > 0 {
+			return value
+		}
+	}
+	return DefaultEnumDisplayMax
+}
+
+// applyEnumDisplayDefaults sets the enumDisplay (and enumDisplayMax) hints on
+// enum fields that do not declare their own, recursing into nested objects
+// and array items.
+func applyEnumDisplayDefaults(fields []Field, display EnumDisplay, maxOptions int) {
+	for idx := range fields {
+		field := &fields[idx]
+		if choices, _ := enumChoices(*field); choices > 0 && strings.TrimSpace(field.UIHints[EnumDisplayHintKey]) == "" {
+			if field.UIHints == nil {
+				field.UIHints = make(map[string]string, 2)
+			}
+			field.UIHints[EnumDisplayHintKey] = string(display)
+			if maxOptions > 0 && strings.TrimSpace(field.UIHints[EnumDisplayMaxHintKey]) == "" {
+				field.UIHints[EnumDisplayMaxHintKey] = strconv.Itoa(maxOptions)
+			}
+		}
+		applyEnumDisplayDefaults(field.Nested, display, maxOptions)
+		if field.Items != nil && field.Type == FieldTypeArray {
+			applyEnumDisplayDefaults(field.Items.Nested, display, maxOptions)
+		}
+	}
+}
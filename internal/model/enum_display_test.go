@@ -0,0 +1,96 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+func TestResolveEnumDisplay(t *testing.T) {
+	small := Field{Name: "size", Type: FieldTypeString, Enum: []any{"s", "m", "l"}}
+	large := Field{Name: "country", Type: FieldTypeString, Enum: []any{"a", "b", "c", "d", "e", "f"}}
+	tags := Field{Name: "tags", Type: FieldTypeArray, Items: &Field{Type: FieldTypeString, Enum: []any{"x", "y"}}}
+	withHint := func(field Field, hints ...string) Field {
+		field.UIHints = map[string]string{}
+		for i := 0; i+1 < len(hints); i += 2 {
+			field.UIHints[hints[i]] = hints[i+1]
+		}
+		return field
+	}
+
+	cases := []struct {
+		name  string
+		field Field
+		want  EnumDisplay
+	}{
+		{"no hint", small, ""},
+		{"auto small", withHint(small, EnumDisplayHintKey, "auto"), EnumDisplayRadio},
+		{"auto large", withHint(large, EnumDisplayHintKey, "auto"), EnumDisplaySelect},
+		{"auto custom max", withHint(large, EnumDisplayHintKey, "auto", EnumDisplayMaxHintKey, "6"), EnumDisplayRadio},
+		{"auto array", withHint(tags, EnumDisplayHintKey, "auto"), EnumDisplayCheckbox},
+		{"radio", withHint(large, EnumDisplayHintKey, "radio"), EnumDisplayRadio},
+		{"radio on array", withHint(tags, EnumDisplayHintKey, "radio"), ""},
+		{"checkbox on scalar", withHint(small, EnumDisplayHintKey, "checkbox"), ""},
+		{"select array", withHint(tags, EnumDisplayHintKey, "select"), EnumDisplaySelect},
+		{"relationship", withHint(Field{Type: FieldTypeString, Enum: []any{"a"}, Relationship: &Relationship{Kind: RelationshipBelongsTo}}, EnumDisplayHintKey, "radio"), ""},
+		{"no choices", withHint(Field{Type: FieldTypeString}, EnumDisplayHintKey, "radio"), ""},
+	}
+	for _, tc := range cases {
+		if got := ResolveEnumDisplay(tc.field); got != tc.want {
+			t.Errorf("%s: want %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestBuilderEnumDisplayDefault(t *testing.T) {
+	input := schema.Form{
+		ID:       "createShirt",
+		Method:   "POST",
+		Endpoint: "/shirts",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"size": {Type: "string", Enum: []any{"s", "m"}},
+				"fit": {Type: "string", Enum: []any{"slim", "regular"}, Extensions: map[string]any{
+					"x-formgen": map[string]any{"enumDisplay": "select"},
+				}},
+				"name": {Type: "string"},
+				"print": {Type: "object", Properties: map[string]schema.Schema{
+					"colors": {Type: "array", Items: &schema.Schema{Type: "string", Enum: []any{"red", "blue"}}},
+				}},
+			},
+		},
+	}
+
+	plain, err := New(Options{}).Build(input)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	for _, field := range plain.Fields {
+		if field.Name == "size" && field.UIHints[EnumDisplayHintKey] != "" {
+			t.Fatalf("enum display default must be opt-in, got %+v", field.UIHints)
+		}
+	}
+
+	form, err := New(Options{EnumDisplay: EnumDisplayAuto, EnumDisplayMax: 3}).Build(input)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	byName := map[string]Field{}
+	for _, field := range form.Fields {
+		byName[field.Name] = field
+	}
+	if got := byName["size"].UIHints; got[EnumDisplayHintKey] != "auto" || got[EnumDisplayMaxHintKey] != "3" {
+		t.Fatalf("expected default hints on size, got %+v", got)
+	}
+	if got := byName["fit"].UIHints[EnumDisplayHintKey]; got != "select" {
+		t.Fatalf("expected schema hint to win, got %q", got)
+	}
+	if got := byName["name"].UIHints[EnumDisplayHintKey]; got != "" {
+		t.Fatalf("expected no hint on non-enum field, got %q", got)
+	}
+	colors := byName["print"].Nested[0]
+	if colors.UIHints[EnumDisplayHintKey] != "auto" || ResolveEnumDisplay(colors) != EnumDisplayCheckbox {
+		t.Fatalf("expected nested array enum to default to a checkbox group, got %+v", colors.UIHints)
+	}
+}
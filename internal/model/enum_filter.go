@@ -0,0 +1,36 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// EnumFilterMetadataKey is the field metadata key carrying a JSON-encoded
+// EnumFilter.
+const EnumFilterMetadataKey = "enumFilter"
+
+// EnumFilter narrows the allowed values of an enum field while its When
+// expression (visibility/expr syntax, evaluated against the other form
+// values) holds.
+type EnumFilter struct {
+	When  string `json:"when"`
+	Allow []any  `json:"allow"`
+}
+
+// FieldEnumFilter decodes the enum filter stored in the field metadata. It
+// reports false when the field has none or the payload is malformed.
+func FieldEnumFilter(field Field) (EnumFilter, bool) {
+	raw := strings.TrimSpace(field.Metadata[EnumFilterMetadataKey])
+	if raw == "" {
+		return EnumFilter{}, false
+	}
+	var filter EnumFilter
+	if err := json.Unmarshal([]byte(raw), &filter); err != nil {
+		return EnumFilter{}, false
+	}
+	filter.When = strings.TrimSpace(filter.When)
+	if filter.When == "" {
+		return EnumFilter{}, false
+	}
+	return filter, true
+}
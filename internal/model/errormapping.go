@@ -0,0 +1,318 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+const errorMappingMetadataKey = "errors.mapping"
+
+// ErrorShape names the conventional error payload layouts recognised in 4xx
+// response schemas.
+type ErrorShape string
+
+const (
+	// ErrorShapeList is an array of error objects, e.g.
+	// `errors: [{field, message}]` or JSON:API `errors: [{source: {pointer}, detail}]`.
+	ErrorShapeList ErrorShape = "list"
+	// ErrorShapeMap is an object keyed by field path whose values are a
+	// message or a list of messages, e.g. problem+json `errors: {name: [...]}`.
+	ErrorShapeMap ErrorShape = "map"
+)
+
+// ErrorMapping describes where an error response keeps field errors so hosts
+// and the client runtime can route server validation failures onto fields.
+// The builder records the mappings as JSON under the "errors.mapping" metadata
+// key. Paths and keys are dotted property paths within the response body.
+type ErrorMapping struct {
+	// Statuses lists the response codes using this layout ("422", "4XX").
+	Statuses []string   `json:"statuses"`
+	Shape    ErrorShape `json:"shape"`
+	// Path locates the error collection, e.g. "errors" or "error.details".
+	Path string `json:"path"`
+	// FieldKey and MessageKey locate the field path and message inside each
+	// list entry.
+	FieldKey   string `json:"fieldKey,omitempty"`
+	MessageKey string `json:"messageKey,omitempty"`
+	// FormMessageKey locates a human readable summary (problem+json detail)
+	// used as a form-level error when no field errors are present.
+	FormMessageKey string `json:"formMessageKey,omitempty"`
+}
+
+var (
+	errorCollectionKeys = []string{"errors", "fieldErrors", "validationErrors", "invalid-params", "invalidParams", "details", "detail"}
+	errorFieldKeys      = []string{"field", "path", "pointer", "source.pointer", "source.parameter", "name", "param", "loc", "property"}
+	errorMessageKeys    = []string{"message", "detail", "reason", "msg", "description", "title"}
+	errorSummaryKeys    = []string{"detail", "message"}
+)
+
+// ErrorMappings returns the error layouts declared by the operation's 4xx
+// responses. The boolean reports whether any were recognised.
+func (m FormModel) ErrorMappings() ([]ErrorMapping, bool) {
+	raw, ok := m.Metadata[errorMappingMetadataKey]
+	if !ok {
+		return nil, false
+	}
+	var mappings []ErrorMapping
+	if err := json.Unmarshal([]byte(raw), &mappings); err != nil || len(mappings) == 0 {
+		return nil, false
+	}
+	return mappings, true
+}
+
+// MatchesStatus reports whether the mapping applies to an HTTP status code,
+// honouring range codes such as "4XX".
+func (e ErrorMapping) MatchesStatus(status int) bool {
+	code := strconv.Itoa(status)
+	for _, candidate := range e.Statuses {
+		candidate = strings.ToUpper(strings.TrimSpace(candidate))
+		if candidate == code {
+			return true
+		}
+		if len(candidate) == 3 && strings.HasSuffix(candidate, "XX") && len(code) == 3 && candidate[0] == code[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract reads field errors from a decoded response body, keyed by the raw
+// field paths the server reported. Entries without a field, and the summary
+// message when no field errors are present, are keyed "form".
+func (e ErrorMapping) Extract(body any) map[string][]string {
+	payload := make(map[string][]string)
+	collection, _ := lookupErrorPath(body, e.Path)
+	switch e.Shape {
+	case ErrorShapeList:
+		items, _ := collection.([]any)
+		for _, item := range items {
+			message := errorText(lookupErrorPathValue(item, e.MessageKey))
+			if message == "" {
+				continue
+			}
+			field := errorFieldPath(lookupErrorPathValue(item, e.FieldKey))
+			if field == "" {
+				field = "form"
+			}
+			payload[field] = append(payload[field], message)
+		}
+	case ErrorShapeMap:
+		entries, _ := collection.(map[string]any)
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			payload[key] = append(payload[key], errorMessages(entries[key])...)
+		}
+	}
+	if len(payload) == 0 && e.FormMessageKey != "" {
+		if summary := errorText(lookupErrorPathValue(body, e.FormMessageKey)); summary != "" {
+			payload["form"] = []string{summary}
+		}
+	}
+	if len(payload) == 0 {
+		return nil
+	}
+	return payload
+}
+
+func applyErrorMappingMetadata(metadata map[string]string, form schema.Form) error {
+	responses := form.ResponseSchemas()
+	statuses := make([]string, 0, len(responses))
+	for status := range responses {
+		if strings.HasPrefix(strings.TrimSpace(status), "4") {
+			statuses = append(statuses, status)
+		}
+	}
+	if len(statuses) == 0 {
+		return nil
+	}
+	sort.Strings(statuses)
+
+	var mappings []ErrorMapping
+	for _, status := range statuses {
+		detected, ok := detectErrorMapping(responses[status])
+		if !ok {
+			continue
+		}
+		merged := false
+		for i := range mappings {
+			existing := mappings[i]
+			existing.Statuses = nil
+			if reflect.DeepEqual(existing, detected) {
+				mappings[i].Statuses = append(mappings[i].Statuses, status)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			detected.Statuses = []string{status}
+			mappings = append(mappings, detected)
+		}
+	}
+	if len(mappings) == 0 {
+		return nil
+	}
+	payload, err := json.Marshal(mappings)
+	if err != nil {
+		return fmt.Errorf("model builder: marshal error mappings: %w", err)
+	}
+	metadata[errorMappingMetadataKey] = string(payload)
+	return nil
+}
+
+// detectErrorMapping looks for a conventional error collection at the top of
+// the response body or under an "error" wrapper object.
+func detectErrorMapping(response schema.Schema) (ErrorMapping, bool) {
+	containers := []struct {
+		prefix string
+		schema schema.Schema
+	}{{"", response}}
+	if wrapper, ok := response.Properties["error"]; ok && len(wrapper.Properties) > 0 {
+		containers = append(containers, struct {
+			prefix string
+			schema schema.Schema
+		}{"error.", wrapper})
+	}
+
+	for _, container := range containers {
+		for _, key := range errorCollectionKeys {
+			collection, ok := container.schema.Properties[key]
+			if !ok {
+				continue
+			}
+			mapping := ErrorMapping{Path: container.prefix + key}
+			switch {
+			case collection.Type == "array" && collection.Items != nil:
+				mapping.Shape = ErrorShapeList
+				mapping.FieldKey = firstSchemaPath(*collection.Items, errorFieldKeys)
+				mapping.MessageKey = firstSchemaPath(*collection.Items, errorMessageKeys)
+				if mapping.FieldKey == "" || mapping.MessageKey == "" {
+					continue
+				}
+			case collection.Type == "object":
+				mapping.Shape = ErrorShapeMap
+			default:
+				continue
+			}
+			if summary := firstSchemaPath(container.schema, errorSummaryKeys); summary != "" {
+				mapping.FormMessageKey = container.prefix + summary
+			}
+			return mapping, true
+		}
+	}
+	return ErrorMapping{}, false
+}
+
+func firstSchemaPath(s schema.Schema, candidates []string) string {
+	for _, candidate := range candidates {
+		current := s
+		found := true
+		for _, segment := range strings.Split(candidate, ".") {
+			next, ok := current.Properties[segment]
+			if !ok {
+				found = false
+				break
+			}
+			current = next
+		}
+		if found {
+			return candidate
+		}
+	}
+	return ""
+}
+
+func lookupErrorPath(value any, path string) (any, bool) {
+	if path == "" {
+		return value, true
+	}
+	current := value
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func lookupErrorPathValue(value any, path string) any {
+	if path == "" {
+		return nil
+	}
+	found, _ := lookupErrorPath(value, path)
+	return found
+}
+
+// errorFieldPath stringifies a reported field location; array locations such
+// as FastAPI's ["body", "name"] are joined with dots.
+func errorFieldPath(value any) string {
+	switch typed := value.(type) {
+	case []any:
+		parts := make([]string, 0, len(typed))
+		for _, part := range typed {
+			if text := errorText(part); text != "" {
+				parts = append(parts, text)
+			}
+		}
+		return strings.Join(parts, ".")
+	default:
+		return errorText(value)
+	}
+}
+
+func errorMessages(value any) []string {
+	switch typed := value.(type) {
+	case []any:
+		messages := make([]string, 0, len(typed))
+		for _, item := range typed {
+			if object, ok := item.(map[string]any); ok {
+				item = object["message"]
+			}
+			if text := errorText(item); text != "" {
+				messages = append(messages, text)
+			}
+		}
+		return messages
+	case map[string]any:
+		if text := errorText(typed["message"]); text != "" {
+			return []string{text}
+		}
+		return nil
+	default:
+		if text := errorText(value); text != "" {
+			return []string{text}
+		}
+		return nil
+	}
+}
+
+func errorText(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case json.Number:
+		return typed.String()
+	case bool:
+		return strconv.FormatBool(typed)
+	default:
+		return ""
+	}
+}
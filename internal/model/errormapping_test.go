@@ -0,0 +1,105 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+func object(properties map[string]schema.Schema) schema.Schema {
+	return schema.Schema{Type: "object", Properties: properties}
+}
+
+func TestBuildDetectsErrorResponseLayouts(t *testing.T) {
+	str := schema.Schema{Type: "string"}
+	listErrors := object(map[string]schema.Schema{
+		"errors": {Type: "array", Items: &schema.Schema{Type: "object", Properties: map[string]schema.Schema{"field": str, "message": str}}},
+	})
+	form := schema.Form{
+		ID:       "createPet",
+		Method:   "POST",
+		Endpoint: "/pets",
+		Schema:   object(map[string]schema.Schema{"name": str}),
+		Responses: map[string]schema.Schema{
+			"201": object(map[string]schema.Schema{"id": str}),
+			"400": listErrors,
+			"409": object(map[string]schema.Schema{"message": str}),
+			"422": listErrors,
+			"4XX": object(map[string]schema.Schema{
+				"title":  str,
+				"detail": str,
+				"errors": {Type: "object"},
+			}),
+		},
+	}
+
+	built, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	mappings, ok := built.ErrorMappings()
+	if !ok {
+		t.Fatal("expected error mappings")
+	}
+	want := []ErrorMapping{
+		{Statuses: []string{"400", "422"}, Shape: ErrorShapeList, Path: "errors", FieldKey: "field", MessageKey: "message"},
+		{Statuses: []string{"4XX"}, Shape: ErrorShapeMap, Path: "errors", FormMessageKey: "detail"},
+	}
+	if diff := cmp.Diff(want, mappings); diff != "" {
+		t.Fatalf("mappings mismatch (-want +got):\n%s", diff)
+	}
+
+	form.Responses = map[string]schema.Schema{"200": str}
+	plain, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if _, ok := plain.ErrorMappings(); ok {
+		t.Fatal("expected no error mappings without 4xx responses")
+	}
+}
+
+func TestErrorMappingExtract(t *testing.T) {
+	decode := func(raw string) any {
+		var value any
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return value
+	}
+
+	jsonAPI := ErrorMapping{Statuses: []string{"422"}, Shape: ErrorShapeList, Path: "errors", FieldKey: "source.pointer", MessageKey: "detail"}
+	got := jsonAPI.Extract(decode(`{"errors":[
+		{"source":{"pointer":"/data/attributes/name"},"detail":"is required"},
+		{"source":{"pointer":"/data/attributes/name"},"detail":"is too short"},
+		{"detail":"rate limited"}
+	]}`))
+	want := map[string][]string{"/data/attributes/name": {"is required", "is too short"}, "form": {"rate limited"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("list extract mismatch (-want +got):\n%s", diff)
+	}
+
+	fastAPI := ErrorMapping{Shape: ErrorShapeList, Path: "detail", FieldKey: "loc", MessageKey: "msg"}
+	got = fastAPI.Extract(decode(`{"detail":[{"loc":["body","owner","email"],"msg":"invalid email"}]}`))
+	if diff := cmp.Diff(map[string][]string{"body.owner.email": {"invalid email"}}, got); diff != "" {
+		t.Fatalf("loc extract mismatch (-want +got):\n%s", diff)
+	}
+
+	problem := ErrorMapping{Shape: ErrorShapeMap, Path: "errors", FormMessageKey: "detail"}
+	got = problem.Extract(decode(`{"detail":"Validation failed","errors":{"name":["taken"],"age":"too young"}}`))
+	if diff := cmp.Diff(map[string][]string{"name": {"taken"}, "age": {"too young"}}, got); diff != "" {
+		t.Fatalf("map extract mismatch (-want +got):\n%s", diff)
+	}
+	got = problem.Extract(decode(`{"detail":"Validation failed"}`))
+	if diff := cmp.Diff(map[string][]string{"form": {"Validation failed"}}, got); diff != "" {
+		t.Fatalf("summary extract mismatch (-want +got):\n%s", diff)
+	}
+
+	ranged := ErrorMapping{Statuses: []string{"4XX", "500"}}
+	if !ranged.MatchesStatus(418) || !ranged.MatchesStatus(500) || ranged.MatchesStatus(503) {
+		t.Fatal("expected statuses to match exact codes and 4XX ranges only")
+	}
+}
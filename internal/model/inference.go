@@ -0,0 +1,167 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+)
+
+// RelationshipConventions drive the opt-in foreign-key inference heuristic.
+// String properties named after a related resource (e.g. "author_id") are
+// promoted to belongsTo relationships with a conventional options endpoint
+// when the schema does not declare relationship metadata itself.
+type RelationshipConventions struct {
+	// Suffixes identify foreign-key property names. Defaults to "_id" and "Id".
+	Suffixes []string
+	// EndpointTemplate builds the options endpoint URL. "{resource}" expands
+	// to the pluralised resource name and "{name}" to the singular one.
+	// Defaults to "/api/{resource}".
+	EndpointTemplate string
+	// TargetTemplate builds the relationship target. "{Model}" expands to the
+	// PascalCase resource name. Defaults to "#/components/schemas/{Model}".
+	TargetTemplate string
+	// LabelField and ValueField populate the endpoint mapping. Default to
+	// "name" and "id".
+	LabelField string
+	ValueField string
+	// Pluralize maps the singular resource name to the collection segment used
+	// by EndpointTemplate. Defaults to a simple English pluraliser.
+	Pluralize func(string) string
+}
+
+// DefaultRelationshipConventions returns the conventions used when inference
+// is enabled without overrides.
+func DefaultRelationshipConventions() RelationshipConventions {
+	return RelationshipConventions{
+		Suffixes:         []string{"_id", "Id"},
+		EndpointTemplate: "/api/{resource}",
+		TargetTemplate:   "#/components/schemas/{Model}",
+		LabelField:       "name",
+		ValueField:       "id",
+		Pluralize:        defaultPluralize,
+	}
+}
+
+func (c RelationshipConventions) withDefaults() RelationshipConventions {
+	defaults := DefaultRelationshipConventions()
+	if len(c.Suffixes) == 0 {
+		c.Suffixes = defaults.Suffixes
+	}
+	if strings.TrimSpace(c.EndpointTemplate) == "" {
+		c.EndpointTemplate = defaults.EndpointTemplate
+	}
+	if strings.TrimSpace(c.TargetTemplate) == "" {
+		c.TargetTemplate = defaults.TargetTemplate
+	}
+	if strings.TrimSpace(c.LabelField) == "" {
+		c.LabelField = defaults.LabelField
+	}
+	if strings.TrimSpace(c.ValueField) == "" {
+		c.ValueField = defaults.ValueField
+	}
+	if c.Pluralize == nil {
+		c.Pluralize = defaults.Pluralize
+	}
+	return c
+}
+
+// inferRelationship promotes foreign-key style string fields to belongsTo
+// relationships. Fields that already carry relationship metadata or endpoint
+// configuration are left untouched.
+func inferRelationship(field *Field, conventions *RelationshipConventions) {
+	if field == nil || conventions == nil {
+		return
+	}
+	if field.Type != FieldTypeString || field.Relationship != nil || len(field.Enum) > 0 {
+		return
+	}
+	if format := strings.ToLower(strings.TrimSpace(field.Format)); format != "" && format != "uuid" {
+		return
+	}
+	if hasRelationshipEndpoint(field.Metadata) {
+		return
+	}
+	resource := foreignKeyResource(field.Name, conventions.Suffixes)
+	if resource == "" {
+		return
+	}
+
+	modelName := pascalCase(resource)
+	collection := conventions.Pluralize(resource)
+	endpoint := strings.NewReplacer("{resource}", collection, "{name}", resource).Replace(conventions.EndpointTemplate)
+
+	field.Relationship = &Relationship{
+		Kind:        RelationshipBelongsTo,
+		Target:      strings.ReplaceAll(conventions.TargetTemplate, "{Model}", modelName),
+		ForeignKey:  field.Name,
+		Cardinality: "one",
+	}
+	metadata := field.ensureMetadata()
+	metadata["relationship.inferred"] = "true"
+	metadata["relationship.endpoint.url"] = endpoint
+	metadata["relationship.endpoint.method"] = "GET"
+	metadata["relationship.endpoint.labelField"] = conventions.LabelField
+	metadata["relationship.endpoint.valueField"] = conventions.ValueField
+}
+
+// foreignKeyResource strips the first matching suffix from name and returns
+// the snake_case resource name ("author_id" and "authorId" both yield
+// "author"). Suffix matching is case-sensitive.
+func foreignKeyResource(name string, suffixes []string) string {
+	for _, suffix := range suffixes {
+		if suffix == "" {
+			continue
+		}
+		base, ok := strings.CutSuffix(name, suffix)
+		if !ok {
+			continue
+		}
+		if base = strings.TrimRight(base, "_-"); base != "" {
+			return snakeCase(base)
+		}
+	}
+	return ""
+}
+
+func snakeCase(value string) string {
+	var builder strings.Builder
+	for idx, r := range value {
+		if unicode.IsUpper(r) {
+			if idx > 0 {
+				builder.WriteByte('_')
+			}
+			builder.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		if r == '-' {
+			r = '_'
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+func pascalCase(value string) string {
+	var builder strings.Builder
+	for _, part := range strings.Split(value, "_") {
+		if part == "" {
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		builder.WriteString(string(runes))
+	}
+	return builder.String()
+}
+
+func defaultPluralize(value string) string {
+	switch {
+	case value == "":
+		return value
+	case strings.HasSuffix(value, "y") && len(value) > 1 && !strings.ContainsRune("aeiou", rune(value[len(value)-2])):
+		return value[:len(value)-1] + "ies"
+	case strings.HasSuffix(value, "s"), strings.HasSuffix(value, "x"), strings.HasSuffix(value, "ch"), strings.HasSuffix(value, "sh"):
+		return value + "es"
+	default:
+		return value + "s"
+	}
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+func TestBuilderInfersRelationshipsFromForeignKeyNames(t *testing.T) {
+	input := schema.Form{
+		ID:       "createBook",
+		Method:   "POST",
+		Endpoint: "/books",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"author_id":   {Type: "string", Format: "uuid"},
+				"categoryId":  {Type: "string"},
+				"released_at": {Type: "string", Format: "date"},
+				"isbn_id":     {Type: "integer"},
+				"publisher_id": {
+					Type: "string",
+					Extensions: map[string]any{
+						"x-endpoint": map[string]any{"url": "/custom/publishers"},
+					},
+				},
+			},
+		},
+	}
+
+	plain, err := New(Options{}).Build(input)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	for _, field := range plain.Fields {
+		if field.Relationship != nil {
+			t.Fatalf("inference must be opt-in, got relationship on %q", field.Name)
+		}
+	}
+
+	form, err := New(Options{RelationshipInference: &RelationshipConventions{}}).Build(input)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	fields := make(map[string]Field, len(form.Fields))
+	for _, field := range form.Fields {
+		fields[field.Name] = field
+	}
+
+	author := fields["author_id"]
+	if author.Relationship == nil || author.Relationship.Kind != RelationshipBelongsTo {
+		t.Fatalf("author_id relationship = %+v", author.Relationship)
+	}
+	if author.Relationship.Target != "#/components/schemas/Author" || author.Relationship.Cardinality != "one" {
+		t.Fatalf("author_id relationship = %+v", author.Relationship)
+	}
+	if got := author.Metadata["relationship.endpoint.url"]; got != "/api/authors" {
+		t.Fatalf("endpoint url = %q", got)
+	}
+	if author.Metadata["relationship.inferred"] != "true" || author.Metadata["relationship.endpoint.labelField"] != "name" {
+		t.Fatalf("metadata = %#v", author.Metadata)
+	}
+	if author.UIHints["input"] == "" {
+		t.Fatalf("expected relationship input hint, got %#v", author.UIHints)
+	}
+
+	if got := fields["categoryId"].Metadata["relationship.endpoint.url"]; got != "/api/categories" {
+		t.Fatalf("categoryId endpoint url = %q", got)
+	}
+	if fields["released_at"].Relationship != nil || fields["isbn_id"].Relationship != nil {
+		t.Fatalf("expected non-string/uuid fields to be ignored")
+	}
+	publisher := fields["publisher_id"]
+	if publisher.Relationship != nil || publisher.Metadata["relationship.endpoint.url"] != "/custom/publishers" {
+		t.Fatalf("expected explicit endpoint to win, got %+v %#v", publisher.Relationship, publisher.Metadata)
+	}
+}
+
+func TestRelationshipConventionsCustomTemplates(t *testing.T) {
+	conventions := RelationshipConventions{
+		Suffixes:         []string{"Ref"},
+		EndpointTemplate: "/v2/{name}/lookup",
+		Pluralize:        func(value string) string { return value },
+	}
+	form, err := New(Options{RelationshipInference: &conventions}).Build(schema.Form{
+		ID:       "createBook",
+		Method:   "POST",
+		Endpoint: "/books",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"editorRef": {Type: "string"},
+				"author_id": {Type: "string"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	for _, field := range form.Fields {
+		switch field.Name {
+		case "editorRef":
+			if got := field.Metadata["relationship.endpoint.url"]; got != "/v2/editor/lookup" {
+				t.Fatalf("endpoint url = %q", got)
+			}
+		case "author_id":
+			if field.Relationship != nil {
+				t.Fatalf("custom suffixes should replace defaults")
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// UnitHintKey is the UI hint naming the unit a numeric field is measured
+	// in (for example "kg" or "%").
+	UnitHintKey = "unit"
+	// PrecisionHintKey is the UI hint carrying the number of decimal places a
+	// numeric field accepts.
+	PrecisionHintKey = "precision"
+
+	maxPrecision = 15
+)
+
+// FieldUnit returns the trimmed unit hint of a field, or "".
+func FieldUnit(field Field) string {
+	return strings.TrimSpace(field.UIHints[UnitHintKey])
+}
+
+// FieldPrecision returns the number of decimal places declared by the
+// precision hint. Missing, negative, or unparsable hints report false.
+func FieldPrecision(field Field) (int, bool) {
+	raw := strings.TrimSpace(field.UIHints[PrecisionHintKey])
+	if raw == "" {
+		return 0, false
+	}
+	precision, err := strconv.Atoi(raw)
+	if err != nil || precision < 0 {
+		return 0, false
+	}
+	return min(precision, maxPrecision), true
+}
+
+// PrecisionStep returns the input step matching a precision: "1" for zero
+// decimal places, "0.01" for two.
+func PrecisionStep(precision int) string {
+	if precision <= 0 {
+		return "1"
+	}
+	return "0." + strings.Repeat("0", precision-1) + "1"
+}
+
+// FormatNumber renders a numeric value (or numeric string) with exactly
+// precision decimal places. It reports false for values that are not numbers.
+func FormatNumber(value any, precision int) (string, bool) {
+	number, ok := numberFromValue(value)
+	if !ok {
+		return "", false
+	}
+	return strconv.FormatFloat(number, 'f', max(precision, 0), 64), true
+}
+
+// DecimalPlaces counts the decimal places of a number in its shortest
+// round-trip form, so binary float noise (0.1 stored as 0.1000000000000000055)
+// does not count as extra precision.
+func DecimalPlaces(value float64) int {
+	formatted := strconv.FormatFloat(value, 'f', -1, 64)
+	if idx := strings.IndexByte(formatted, '.'); idx >= 0 {
+		return len(formatted) - idx - 1
+	}
+	return 0
+}
+
+func numberFromValue(value any) (float64, bool) {
+	switch typed := value.(type) {
+	case nil:
+		return 0, false
+	case json.Number:
+		number, err := typed.Float64()
+		return number, err == nil
+	case string:
+		number, err := strconv.ParseFloat(strings.TrimSpace(typed), 64)
+		return number, err == nil
+	}
+	reflected := reflect.ValueOf(value)
+	for reflected.Kind() == reflect.Pointer || reflected.Kind() == reflect.Interface {
+		if reflected.IsNil() {
+			return 0, false
+		}
+		reflected = reflected.Elem()
+	}
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(reflected.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(reflected.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return reflected.Float(), true
+	}
+	return 0, false
+}
@@ -4,6 +4,32 @@ package model
 // the public adapter in pkg/model and passed into New.
 type Options struct {
 	Labeler func(string) string
+
+	// ExtensionNamespaces lists vendor extension prefixes rewritten onto
+	// x-formgen before building, highest precedence first.
+	ExtensionNamespaces []string
+
+	// RelationshipInference enables foreign-key relationship inference when
+	// non-nil. Zero-valued fields fall back to DefaultRelationshipConventions.
+	RelationshipInference *RelationshipConventions
+
+	// DeriveSiblingEndpoints lets BuildWithSiblings fill relationship endpoints
+	// from GET collection operations returning the relationship target.
+	DeriveSiblingEndpoints bool
+
+	// TitledSections lifts top-level object fields whose schema declares a
+	// title into implicit layout sections.
+	TitledSections bool
+
+	// ParameterFields appends path, query, and header parameters as fields
+	// tagged with Field.Location.
+	ParameterFields bool
+
+	// EnumDisplay, when set, becomes the enumDisplay hint of enum fields that
+	// do not declare one. EnumDisplayMax is recorded alongside it when
+	// positive.
+	EnumDisplay    EnumDisplay
+	EnumDisplayMax int
 }
 
 func defaultOptions() Options {
@@ -36,3 +36,50 @@ func TestBuilderPreservesRichOptionsFromFormgenExtensions(t *testing.T) {
 		t.Fatalf("option = %#v", option)
 	}
 }
+
+func TestBuilderReadsCustomExtensionNamespacesWithPrecedence(t *testing.T) {
+	builder := New(Options{ExtensionNamespaces: []string{"x-acme-ui", "x-legacy-ui"}})
+	form, err := builder.Build(schema.Form{
+		ID:       "namespaces",
+		Method:   "POST",
+		Endpoint: "/namespaces",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"title": {
+					Type: "string",
+					Extensions: map[string]any{
+						"x-acme-ui":   map[string]any{"placeholder": "Acme title"},
+						"x-legacy-ui": map[string]any{"placeholder": "Legacy title", "helpText": "Legacy help"},
+						"x-formgen":   map[string]any{"helpText": "Default help", "label": "Title"},
+					},
+				},
+				"notes": {
+					Type:       "string",
+					Extensions: map[string]any{"x-acme-ui-widget": "textarea"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	fields := make(map[string]Field, len(form.Fields))
+	for _, field := range form.Fields {
+		fields[field.Name] = field
+	}
+	title := fields["title"]
+	if got := title.UIHints["placeholder"]; got != "Acme title" {
+		t.Fatalf("placeholder = %q, want highest precedence namespace", got)
+	}
+	if got := title.UIHints["helpText"]; got != "Legacy help" {
+		t.Fatalf("helpText = %q, want configured namespace over x-formgen", got)
+	}
+	if got := title.UIHints["label"]; got != "Title" {
+		t.Fatalf("label = %q, want x-formgen fallback", got)
+	}
+	if got := fields["notes"].UIHints["widget"]; got != "textarea" {
+		t.Fatalf("widget = %q, want flat custom namespace key", got)
+	}
+}
@@ -0,0 +1,56 @@
+package model
+
+import "github.com/goliatone/go-formgen/pkg/schema"
+
+// appendParameterFields converts operation parameters into top-level fields
+// tagged with their location. Parameters whose name clashes with an existing
+// body field are skipped so the request body stays authoritative.
+func (b *Builder) appendParameterFields(fields []Field, params []schema.Parameter) ([]Field, error) {
+	if len(params) == 0 {
+		return fields, nil
+	}
+	taken := make(map[string]struct{}, len(fields)+len(params))
+	for _, field := range fields {
+		taken[field.Name] = struct{}{}
+	}
+	for _, param := range params {
+		location := parameterLocation(param.In)
+		if param.Name == "" || location == "" {
+			continue
+		}
+		if _, ok := taken[param.Name]; ok {
+			continue
+		}
+		paramSchema := param.Schema
+		if paramSchema.Description == "" {
+			paramSchema.Description = param.Description
+		}
+		if paramSchema.Type == "" && paramSchema.Ref == "" && len(paramSchema.Properties) == 0 {
+			paramSchema.Type = "string"
+		}
+		required := param.Required || location == LocationPath
+		converted, err := b.fieldsFromSchema(param.Name, paramSchema, required)
+		if err != nil {
+			return nil, err
+		}
+		for i := range converted {
+			converted[i].Location = location
+		}
+		fields = append(fields, converted...)
+		taken[param.Name] = struct{}{}
+	}
+	return fields, nil
+}
+
+func parameterLocation(in string) string {
+	switch in {
+	case schema.ParameterInPath:
+		return LocationPath
+	case schema.ParameterInQuery:
+		return LocationQuery
+	case schema.ParameterInHeader:
+		return LocationHeader
+	default:
+		return ""
+	}
+}
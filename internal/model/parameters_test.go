@@ -0,0 +1,58 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+func TestBuildParameterFields(t *testing.T) {
+	form := schema.Form{
+		ID:       "updatePet",
+		Method:   "PUT",
+		Endpoint: "/pets/{petId}",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"name":  {Type: "string"},
+				"trace": {Type: "string"},
+			},
+		},
+		Parameters: []schema.Parameter{
+			{Name: "petId", In: schema.ParameterInPath, Schema: schema.Schema{Type: "integer"}},
+			{Name: "trace", In: schema.ParameterInQuery, Schema: schema.Schema{Type: "string"}},
+			{Name: "X-Tenant", In: schema.ParameterInHeader, Description: "Tenant"},
+		},
+	}
+
+	plain, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if len(plain.Fields) != 2 {
+		t.Fatalf("parameter fields must be opt-in, got %d fields", len(plain.Fields))
+	}
+
+	built, err := New(Options{ParameterFields: true}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	byName := make(map[string]Field, len(built.Fields))
+	for _, field := range built.Fields {
+		byName[field.Name] = field
+	}
+	if len(built.Fields) != 4 {
+		t.Fatalf("fields = %d, want body fields plus petId and X-Tenant", len(built.Fields))
+	}
+	pet := byName["petId"]
+	if pet.Location != LocationPath || pet.Type != FieldTypeInteger || !pet.Required {
+		t.Fatalf("petId = %#v", pet)
+	}
+	if byName["trace"].Location != "" {
+		t.Fatalf("body field must win over same-named query parameter")
+	}
+	tenant := byName["X-Tenant"]
+	if tenant.Location != LocationHeader || tenant.Type != FieldTypeString || tenant.Description != "Tenant" {
+		t.Fatalf("X-Tenant = %#v", tenant)
+	}
+}
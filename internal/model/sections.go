@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+const (
+	layoutSectionsMetadataKey  = "layout.sections"
+	layoutSectionMetadataKey   = "layout.section"
+	implicitSectionMetadataKey = "layout.section.implicit"
+	flattenUIHintKey           = "flatten"
+)
+
+// implicitSection mirrors the layout.sections entries written by UI schema
+// decorators.
+type implicitSection struct {
+	ID          string `json:"id"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Order       int    `json:"order"`
+}
+
+// applyTitledSections turns top-level object fields whose schema carries a
+// title into sections. The object keeps its nested fields but is flagged to
+// render without its own fieldset chrome, since the section already provides
+// the heading. Forms that already declare sections are left alone.
+func applyTitledSections(form *FormModel, body schema.Schema) error {
+	if form == nil || strings.TrimSpace(form.Metadata[layoutSectionsMetadataKey]) != "" {
+		return nil
+	}
+
+	var sections []implicitSection
+	for idx := range form.Fields {
+		field := &form.Fields[idx]
+		if field.Type != FieldTypeObject || len(field.Nested) == 0 || field.Relationship != nil {
+			continue
+		}
+		if strings.TrimSpace(field.Metadata[layoutSectionMetadataKey]) != "" {
+			continue
+		}
+		title := strings.TrimSpace(body.Properties[field.Name].Title)
+		if title == "" {
+			continue
+		}
+
+		sections = append(sections, implicitSection{
+			ID:          field.Name,
+			Title:       title,
+			Description: field.Description,
+			Order:       len(sections),
+		})
+		metadata := field.ensureMetadata()
+		metadata[layoutSectionMetadataKey] = field.Name
+		metadata[implicitSectionMetadataKey] = "true"
+		if field.UIHints == nil {
+			field.UIHints = make(map[string]string)
+		}
+		field.UIHints[flattenUIHintKey] = "true"
+	}
+	if len(sections) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(sections)
+	if err != nil {
+		return fmt.Errorf("model builder: marshal titled sections: %w", err)
+	}
+	if form.Metadata == nil {
+		form.Metadata = make(map[string]string)
+	}
+	form.Metadata[layoutSectionsMetadataKey] = string(payload)
+	return nil
+}
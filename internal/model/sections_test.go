@@ -0,0 +1,73 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+func TestBuilderLiftsTitledObjectsIntoSections(t *testing.T) {
+	address := func(title string) schema.Schema {
+		return schema.Schema{
+			Type:        "object",
+			Title:       title,
+			Description: "Where to deliver",
+			Properties: map[string]schema.Schema{
+				"street": {Type: "string"},
+				"city":   {Type: "string"},
+			},
+		}
+	}
+	input := schema.Form{
+		ID:       "createOrder",
+		Method:   "POST",
+		Endpoint: "/orders",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"billing":  address("Billing address"),
+				"notes":    {Type: "string"},
+				"meta":     address(""),
+				"shipping": address("Shipping address"),
+			},
+		},
+	}
+
+	plain, err := New(Options{}).Build(input)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if _, ok := plain.Metadata[layoutSectionsMetadataKey]; ok {
+		t.Fatalf("titled sections must be opt-in")
+	}
+
+	form, err := New(Options{TitledSections: true}).Build(input)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	var sections []implicitSection
+	if err := json.Unmarshal([]byte(form.Metadata[layoutSectionsMetadataKey]), &sections); err != nil {
+		t.Fatalf("decode sections: %v", err)
+	}
+	if len(sections) != 2 || sections[0].ID != "billing" || sections[0].Title != "Billing address" || sections[1].ID != "shipping" {
+		t.Fatalf("unexpected sections: %+v", sections)
+	}
+	if sections[0].Description != "Where to deliver" || sections[1].Order != 1 {
+		t.Fatalf("unexpected section details: %+v", sections)
+	}
+
+	for _, field := range form.Fields {
+		switch field.Name {
+		case "billing", "shipping":
+			if field.Metadata[layoutSectionMetadataKey] != field.Name || field.UIHints[flattenUIHintKey] != "true" {
+				t.Fatalf("%s should be lifted into its section: %+v %+v", field.Name, field.Metadata, field.UIHints)
+			}
+		default:
+			if field.Metadata[layoutSectionMetadataKey] != "" {
+				t.Fatalf("%s should stay unsectioned", field.Name)
+			}
+		}
+	}
+}
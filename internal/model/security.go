@@ -0,0 +1,119 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+const (
+	securityRequiredMetadataKey     = "security.required"
+	securitySchemesMetadataKey      = "security.schemes"
+	securityRequirementsMetadataKey = "security.requirements"
+)
+
+// SecurityScheme is a scheme referenced by an operation security requirement,
+// together with the scopes that requirement asks for.
+type SecurityScheme struct {
+	Name         string   `json:"name"`
+	Type         string   `json:"type,omitempty"`
+	Scheme       string   `json:"scheme,omitempty"`
+	BearerFormat string   `json:"bearerFormat,omitempty"`
+	In           string   `json:"in,omitempty"`
+	ParamName    string   `json:"paramName,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+}
+
+// SecurityRequirement lists schemes that must all be satisfied. An empty
+// requirement permits anonymous access.
+type SecurityRequirement struct {
+	Schemes []SecurityScheme `json:"schemes"`
+}
+
+// Security is the typed view of the operation's auth requirements. The
+// builder records them as "security.*" metadata entries: security.required
+// ("true" unless an anonymous alternative exists), security.schemes (sorted,
+// comma separated), and security.requirements (JSON encoded Requirements).
+// Hosts use it to gate rendering or show login affordances and to pick the
+// credential strategy for submissions.
+type Security struct {
+	Required     bool                  `json:"required"`
+	Requirements []SecurityRequirement `json:"requirements"`
+}
+
+// Security returns the operation's security requirements. The boolean reports
+// whether the operation declared any.
+func (m FormModel) Security() (Security, bool) {
+	raw, ok := m.Metadata[securityRequirementsMetadataKey]
+	if !ok {
+		return Security{}, false
+	}
+	var security Security
+	if err := json.Unmarshal([]byte(raw), &security.Requirements); err != nil {
+		return Security{}, false
+	}
+	security.Required = isTruthyString(m.Metadata[securityRequiredMetadataKey])
+	return security, true
+}
+
+// SchemeNames returns the distinct scheme names across all requirements,
+// sorted.
+func (s Security) SchemeNames() []string {
+	seen := make(map[string]struct{})
+	for _, requirement := range s.Requirements {
+		for _, scheme := range requirement.Schemes {
+			seen[scheme.Name] = struct{}{}
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func applySecurityMetadata(metadata map[string]string, form schema.Form) error {
+	if len(form.Security) == 0 {
+		return nil
+	}
+	security := Security{Required: true}
+	for _, requirement := range form.Security {
+		if len(requirement) == 0 {
+			security.Required = false
+		}
+		names := make([]string, 0, len(requirement))
+		for name := range requirement {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		converted := SecurityRequirement{Schemes: make([]SecurityScheme, 0, len(names))}
+		for _, name := range names {
+			declared := form.SecuritySchemes[name]
+			converted.Schemes = append(converted.Schemes, SecurityScheme{
+				Name:         name,
+				Type:         declared.Type,
+				Scheme:       strings.ToLower(declared.Scheme),
+				BearerFormat: declared.BearerFormat,
+				In:           declared.In,
+				ParamName:    declared.Name,
+				Scopes:       append([]string(nil), requirement[name]...),
+			})
+		}
+		security.Requirements = append(security.Requirements, converted)
+	}
+
+	payload, err := json.Marshal(security.Requirements)
+	if err != nil {
+		return fmt.Errorf("model builder: marshal security requirements: %w", err)
+	}
+	metadata[securityRequirementsMetadataKey] = string(payload)
+	metadata[securityRequiredMetadataKey] = fmt.Sprintf("%t", security.Required)
+	if names := security.SchemeNames(); len(names) > 0 {
+		metadata[securitySchemesMetadataKey] = strings.Join(names, ",")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+func TestBuildSecurityMetadata(t *testing.T) {
+	form := schema.Form{
+		ID:       "createPet",
+		Method:   "POST",
+		Endpoint: "/pets",
+		Schema:   schema.Schema{Type: "object", Properties: map[string]schema.Schema{"name": {Type: "string"}}},
+		Security: []schema.SecurityRequirement{{"oauth": {"pets:write"}}, {"apiKey": nil}},
+		SecuritySchemes: map[string]schema.SecurityScheme{
+			"oauth":  {Type: "oauth2"},
+			"apiKey": {Type: "apiKey", In: "header", Name: "X-API-Key"},
+		},
+	}
+
+	built, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if built.Metadata["security.required"] != "true" || built.Metadata["security.schemes"] != "apiKey,oauth" {
+		t.Fatalf("unexpected security metadata: %v", built.Metadata)
+	}
+
+	security, ok := built.Security()
+	if !ok {
+		t.Fatal("expected security to be present")
+	}
+	want := Security{
+		Required: true,
+		Requirements: []SecurityRequirement{
+			{Schemes: []SecurityScheme{{Name: "oauth", Type: "oauth2", Scopes: []string{"pets:write"}}}},
+			{Schemes: []SecurityScheme{{Name: "apiKey", Type: "apiKey", In: "header", ParamName: "X-API-Key"}}},
+		},
+	}
+	if diff := cmp.Diff(want, security); diff != "" {
+		t.Fatalf("security mismatch (-want +got):\n%s", diff)
+	}
+
+	form.Security = append(form.Security, schema.SecurityRequirement{})
+	optional, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if security, _ := optional.Security(); security.Required {
+		t.Fatal("expected an anonymous alternative to make security optional")
+	}
+
+	form.Security = nil
+	open, err := New(Options{}).Build(form)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if _, ok := open.Security(); ok {
+		t.Fatalf("expected no security metadata, got %v", open.Metadata)
+	}
+}
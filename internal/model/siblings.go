@@ -0,0 +1,117 @@
+package model
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+// siblingEndpoint describes a GET collection operation returning a schema.
+type siblingEndpoint struct {
+	url         string
+	resultsPath string
+}
+
+// siblingEndpoints maps schema references to the collection operation that
+// lists them.
+type siblingEndpoints map[string]siblingEndpoint
+
+// indexSiblingEndpoints scans GET operations for success responses shaped as
+// an array of $ref items, either at the top level or under a single array
+// property (e.g. `{ "data": [ ... ] }`). When several operations list the same
+// schema, paths without template segments win, then the shortest path.
+func indexSiblingEndpoints(siblings []schema.Form) siblingEndpoints {
+	index := make(siblingEndpoints)
+	for _, sibling := range siblings {
+		if !strings.EqualFold(sibling.Method, "GET") || strings.TrimSpace(sibling.Endpoint) == "" {
+			continue
+		}
+		response, ok := successResponse(sibling.ResponseSchemas())
+		if !ok {
+			continue
+		}
+		ref, resultsPath, ok := collectionItemRef(response)
+		if !ok {
+			continue
+		}
+		candidate := siblingEndpoint{url: sibling.Endpoint, resultsPath: resultsPath}
+		if existing, ok := index[ref]; ok && !preferSiblingEndpoint(candidate, existing) {
+			continue
+		}
+		index[ref] = candidate
+	}
+	return index
+}
+
+func successResponse(responses map[string]schema.Schema) (schema.Schema, bool) {
+	if response, ok := responses["200"]; ok {
+		return response, true
+	}
+	codes := make([]string, 0, len(responses))
+	for code := range responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return schema.Schema{}, false
+	}
+	sort.Strings(codes)
+	return responses[codes[0]], true
+}
+
+func collectionItemRef(response schema.Schema) (string, string, bool) {
+	if response.Type == "array" && response.Items != nil && response.Items.Ref != "" {
+		return response.Items.Ref, "", true
+	}
+	var (
+		ref  string
+		path string
+	)
+	for name, property := range response.Properties {
+		if property.Type != "array" || property.Items == nil || property.Items.Ref == "" {
+			continue
+		}
+		if ref != "" {
+			// Ambiguous envelope with several collections.
+			return "", "", false
+		}
+		ref, path = property.Items.Ref, name
+	}
+	return ref, path, ref != ""
+}
+
+func preferSiblingEndpoint(candidate, existing siblingEndpoint) bool {
+	candidateTemplated := strings.Contains(candidate.url, "{")
+	existingTemplated := strings.Contains(existing.url, "{")
+	if candidateTemplated != existingTemplated {
+		return !candidateTemplated
+	}
+	if len(candidate.url) != len(existing.url) {
+		return len(candidate.url) < len(existing.url)
+	}
+	return candidate.url < existing.url
+}
+
+// deriveSiblingEndpoint fills relationship endpoint metadata from the sibling
+// index when the field declares a relationship target but no endpoint.
+func (b *Builder) deriveSiblingEndpoint(field *Field) {
+	if field == nil || field.Relationship == nil || len(b.endpoints) == 0 {
+		return
+	}
+	if hasRelationshipEndpoint(field.Metadata) {
+		return
+	}
+	endpoint, ok := b.endpoints[field.Relationship.Target]
+	if !ok {
+		return
+	}
+	metadata := field.ensureMetadata()
+	metadata["relationship.endpoint.url"] = endpoint.url
+	metadata["relationship.endpoint.method"] = "GET"
+	if endpoint.resultsPath != "" {
+		metadata["relationship.endpoint.resultsPath"] = endpoint.resultsPath
+	}
+	metadata["relationship.endpoint.derived"] = "true"
+}
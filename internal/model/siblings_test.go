@@ -0,0 +1,93 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+func TestBuildWithSiblingsDerivesRelationshipEndpoints(t *testing.T) {
+	const (
+		authorRef = "#/components/schemas/Author"
+		tagRef    = "#/components/schemas/Tag"
+	)
+	form := schema.Form{
+		ID:       "createBook",
+		Method:   "POST",
+		Endpoint: "/books",
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"author_id": {
+					Type:       "string",
+					Extensions: map[string]any{"x-relationships": map[string]any{"type": "belongsTo", "target": authorRef}},
+				},
+				"tags": {
+					Type:       "array",
+					Items:      &schema.Schema{Type: "string"},
+					Extensions: map[string]any{"x-relationships": map[string]any{"type": "hasMany", "target": tagRef}},
+				},
+				"editor_id": {
+					Type: "string",
+					Extensions: map[string]any{
+						"x-relationships": map[string]any{"type": "belongsTo", "target": authorRef},
+						"x-endpoint":      map[string]any{"url": "/editors"},
+					},
+				},
+			},
+		},
+	}
+	siblings := []schema.Form{
+		form,
+		{
+			ID: "listAuthorsByTeam", Method: "GET", Endpoint: "/teams/{team}/authors",
+			Responses: map[string]schema.Schema{"200": {Type: "array", Items: &schema.Schema{Ref: authorRef}}},
+		},
+		{
+			ID: "listAuthors", Method: "GET", Endpoint: "/authors",
+			Responses: map[string]schema.Schema{"200": {Type: "array", Items: &schema.Schema{Ref: authorRef}}},
+		},
+		{
+			ID: "listTags", Method: "GET", Endpoint: "/tags",
+			Responses: map[string]schema.Schema{"200": {
+				Type: "object",
+				Properties: map[string]schema.Schema{
+					"data":  {Type: "array", Items: &schema.Schema{Ref: tagRef}},
+					"total": {Type: "integer"},
+				},
+			}},
+		},
+	}
+
+	disabled, err := New(Options{}).BuildWithSiblings(form, siblings)
+	if err != nil {
+		t.Fatalf("BuildWithSiblings: %v", err)
+	}
+	for _, field := range disabled.Fields {
+		if field.Name == "author_id" && field.Metadata["relationship.endpoint.url"] != "" {
+			t.Fatalf("derivation must be opt-in")
+		}
+	}
+
+	built, err := New(Options{DeriveSiblingEndpoints: true}).BuildWithSiblings(form, siblings)
+	if err != nil {
+		t.Fatalf("BuildWithSiblings: %v", err)
+	}
+	fields := make(map[string]Field, len(built.Fields))
+	for _, field := range built.Fields {
+		fields[field.Name] = field
+	}
+	if got := fields["author_id"].Metadata["relationship.endpoint.url"]; got != "/authors" {
+		t.Fatalf("author_id endpoint = %q, want untemplated collection", got)
+	}
+	if got := fields["author_id"].Metadata["relationship.endpoint.resultsPath"]; got != "" {
+		t.Fatalf("author_id resultsPath = %q, want empty for top-level arrays", got)
+	}
+	tags := fields["tags"].Metadata
+	if tags["relationship.endpoint.url"] != "/tags" || tags["relationship.endpoint.resultsPath"] != "data" {
+		t.Fatalf("tags metadata = %#v", tags)
+	}
+	if got := fields["editor_id"].Metadata["relationship.endpoint.url"]; got != "/editors" {
+		t.Fatalf("editor_id endpoint = %q, want explicit x-endpoint", got)
+	}
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TransitionsMetadataKey is the field metadata key carrying a JSON-encoded
+// Transitions map, e.g. from `x-formgen: {transitions: {...}}`.
+const TransitionsMetadataKey = "transitions"
+
+// Transitions maps each state of a status-like enum to the states it may move
+// to next. States missing from the map are terminal.
+type Transitions map[string][]string
+
+// Allows reports whether a field holding from may change to to. Keeping the
+// current state is always allowed, as is any state when from is empty.
+func (t Transitions) Allows(from, to string) bool {
+	if from == "" || from == to {
+		return true
+	}
+	return slices.Contains(t[from], to)
+}
+
+// FieldTransitions decodes the transitions stored in the field metadata. It
+// reports false when the field has none or the payload is malformed.
+func FieldTransitions(field Field) (Transitions, bool) {
+	raw := strings.TrimSpace(field.Metadata[TransitionsMetadataKey])
+	if raw == "" {
+		return nil, false
+	}
+	var transitions Transitions
+	if err := json.Unmarshal([]byte(raw), &transitions); err != nil || len(transitions) == 0 {
+		return nil, false
+	}
+	return transitions, true
+}
+
+// TransitionState formats an enum value or submitted value as a transition
+// state key.
+func TransitionState(value any) string {
+	if value == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprint(value))
+}
@@ -81,6 +81,7 @@ type Field struct {
 	Description  string            `json:"description,omitempty"`
 	Default      any               `json:"default,omitempty"`
 	Sensitive    bool              `json:"sensitive,omitempty"`
+	Nullable     bool              `json:"nullable,omitempty"`
 	Enum         []any             `json:"enum,omitempty"`
 	Options      []Option          `json:"options,omitempty"`
 	Nested       []Field           `json:"nested,omitempty"`
@@ -90,8 +91,18 @@ type Field struct {
 	Metadata     map[string]string `json:"metadata,omitempty"`
 	UIHints      map[string]string `json:"uiHints,omitempty"`
 	Relationship *Relationship     `json:"relationship,omitempty"`
+	// Location is set on fields built from operation parameters (see the
+	// Location* constants). Request body fields leave it empty.
+	Location string `json:"location,omitempty"`
 }
 
+// Field locations for parameter-backed fields.
+const (
+	LocationPath   = "path"
+	LocationQuery  = "query"
+	LocationHeader = "header"
+)
+
 // FormModel is the top-level representation renderers consume, matching the
 // README structure in go-form-gen.md:111-158.
 type FormModel struct {
@@ -103,4 +114,7 @@ type FormModel struct {
 	Fields      []Field           `json:"fields"`
 	Metadata    map[string]string `json:"metadata,omitempty"`
 	UIHints     map[string]string `json:"uiHints,omitempty"`
+	// MediaType is the request body media type the fields were built from
+	// (e.g. "multipart/form-data"); renderers derive the form enctype from it.
+	MediaType string `json:"mediaType,omitempty"`
 }
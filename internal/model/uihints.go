@@ -9,13 +9,21 @@ import (
 
 var (
 	uiHintKeys = []string{
+		"accept",
 		"accordion",
 		"addText",
+		"advanced",
 		"badge",
+		"booleanStyle",
 		"cardinality",
 		"category",
 		"class",
 		"cssClass",
+		"displayFormat",
+		"durationParts",
+		"enumDisplay",
+		"enumDisplayMax",
+		"falseLabel",
 		"group",
 		"input",
 		"helpText",
@@ -23,6 +31,9 @@ var (
 		"hideLabel",
 		"inputType",
 		"label",
+		"lazy",
+		"multiple",
+		"nullLabel",
 		"order",
 		"placeholder",
 		"precision",
@@ -34,6 +45,7 @@ var (
 		"success-message",
 		"successMessage",
 		"tags",
+		"trueLabel",
 		"unit",
 		"visibilityRule",
 		"widget",
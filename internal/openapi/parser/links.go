@@ -0,0 +1,357 @@
+package parser
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	requestBodyExpressionPrefix  = "$request.body#"
+	responseBodyExpressionPrefix = "$response.body#"
+)
+
+// linkedOperation locates an operation inside the loaded document.
+type linkedOperation struct {
+	method    string
+	path      string
+	operation *openapi3.Operation
+}
+
+// applyLinkRelationships derives x-endpoint/x-relationships extensions from
+// OpenAPI links so specs that describe relationships through links get working
+// relationship widgets without vendor extensions. A link whose parameter reads
+// a request/response body field (e.g. `authorId: $request.body#/author_id`)
+// marks that field as a reference to the linked operation's resource; the
+// options endpoint is the linked collection (GET /authors for a link to
+// GET /authors/{authorId}), and other body-reading parameters become dynamic
+// endpoint params. Links straight to a collection GET must read exactly one
+// body field. Literal parameters become static endpoint params. Links on
+// operation responses take precedence over document-wide component links, and
+// fields that already declare x-endpoint or x-relationships are left untouched.
+func applyLinkRelationships(spec *openapi3.T) {
+	if spec == nil || spec.Paths == nil {
+		return
+	}
+	index := indexOperations(spec)
+	if len(index.byID) == 0 {
+		return
+	}
+
+	var componentLinks []*openapi3.Link
+	if spec.Components != nil {
+		componentLinks = sortedLinks(spec.Components.Links)
+	}
+
+	for _, source := range index.ordered {
+		body := requestBodySchema(source.operation)
+		if body == nil {
+			continue
+		}
+		var links []*openapi3.Link
+		if source.operation.Responses != nil {
+			codes := make([]string, 0, source.operation.Responses.Len())
+			for code := range source.operation.Responses.Map() {
+				codes = append(codes, code)
+			}
+			sort.Strings(codes)
+			for _, code := range codes {
+				response := source.operation.Responses.Value(code)
+				if response == nil || response.Value == nil {
+					continue
+				}
+				links = append(links, sortedLinks(response.Value.Links)...)
+			}
+		}
+		links = append(links, componentLinks...)
+		for _, link := range links {
+			applyLink(body, link, index)
+		}
+	}
+}
+
+type operationIndex struct {
+	byID    map[string]linkedOperation
+	byPath  map[string]map[string]linkedOperation
+	ordered []linkedOperation
+}
+
+func indexOperations(spec *openapi3.T) operationIndex {
+	index := operationIndex{
+		byID:   make(map[string]linkedOperation),
+		byPath: make(map[string]map[string]linkedOperation),
+	}
+	paths := make([]string, 0, spec.Paths.Len())
+	for path := range spec.Paths.Map() {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		item := spec.Paths.Value(path)
+		if item == nil {
+			continue
+		}
+		operations := item.Operations()
+		methods := make([]string, 0, len(operations))
+		for method := range operations {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			operation := operations[method]
+			if operation == nil {
+				continue
+			}
+			entry := linkedOperation{method: strings.ToUpper(method), path: path, operation: operation}
+			index.ordered = append(index.ordered, entry)
+			if operation.OperationID != "" {
+				index.byID[operation.OperationID] = entry
+			}
+			if index.byPath[path] == nil {
+				index.byPath[path] = make(map[string]linkedOperation)
+			}
+			index.byPath[path][entry.method] = entry
+		}
+	}
+	return index
+}
+
+// resolve finds the link target by operationId or by a local operationRef
+// ("#/paths/~1authors~1{id}/get").
+func (index operationIndex) resolve(link *openapi3.Link) (linkedOperation, bool) {
+	if link.OperationID != "" {
+		target, ok := index.byID[link.OperationID]
+		return target, ok
+	}
+	ref, ok := strings.CutPrefix(link.OperationRef, "#/paths/")
+	if !ok {
+		return linkedOperation{}, false
+	}
+	separator := strings.LastIndex(ref, "/")
+	if separator <= 0 {
+		return linkedOperation{}, false
+	}
+	path := strings.NewReplacer("~1", "/", "~0", "~").Replace(ref[:separator])
+	target, ok := index.byPath[path][strings.ToUpper(ref[separator+1:])]
+	return target, ok
+}
+
+func applyLink(body *openapi3.Schema, link *openapi3.Link, index operationIndex) {
+	if link == nil || len(link.Parameters) == 0 {
+		return
+	}
+	target, ok := index.resolve(link)
+	if !ok {
+		return
+	}
+
+	names := make([]string, 0, len(link.Parameters))
+	for name := range link.Parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lastParam := trailingPathParam(target.path)
+	if lastParam == "" && countBodyParameters(link.Parameters) != 1 {
+		// Collection targets are only unambiguous when a single parameter
+		// reads a body field.
+		return
+	}
+	for _, name := range names {
+		pointer, ok := bodyPointer(link.Parameters[name])
+		if !ok {
+			continue
+		}
+		endpoint, ok := linkEndpoint(target, name, lastParam, index)
+		if !ok {
+			continue
+		}
+		params := make(map[string]any)
+		dynamicParams := make(map[string]any)
+		for _, other := range names {
+			if other == name || other == lastParam {
+				continue
+			}
+			value := link.Parameters[other]
+			if otherPointer, ok := bodyPointer(value); ok {
+				dynamicParams[other] = "{{field:" + strings.ReplaceAll(strings.Trim(otherPointer, "/"), "/", ".") + "}}"
+				continue
+			}
+			if str, ok := toString(value); ok && !strings.HasPrefix(str, "$") {
+				params[other] = str
+			}
+		}
+		if len(params) > 0 {
+			endpoint["params"] = params
+		}
+		if len(dynamicParams) > 0 {
+			endpoint["dynamicParams"] = dynamicParams
+		}
+		relationship := map[string]any{
+			"type":       "belongsTo",
+			"target":     linkTarget(target),
+			"foreignKey": strings.Trim(pointer, "/"),
+		}
+		annotateLinkedProperty(body, pointer, endpoint, relationship)
+	}
+}
+
+// linkEndpoint returns the options endpoint for a link parameter. Links to a
+// single-resource operation (`/authors/{id}`) resolve to the sibling
+// collection GET; links to a GET without a trailing path parameter are used
+// as-is.
+func linkEndpoint(target linkedOperation, param, lastParam string, index operationIndex) (map[string]any, bool) {
+	if target.method != "GET" {
+		return nil, false
+	}
+	if lastParam == "" {
+		return map[string]any{"url": target.path, "method": "GET"}, true
+	}
+	if param != lastParam {
+		return nil, false
+	}
+	collection := target.path[:strings.LastIndex(target.path, "/")]
+	if collection == "" {
+		return nil, false
+	}
+	if _, ok := index.byPath[collection]["GET"]; !ok {
+		return nil, false
+	}
+	return map[string]any{"url": collection, "method": "GET", "valueField": "id"}, true
+}
+
+func trailingPathParam(path string) string {
+	segment := path[strings.LastIndex(path, "/")+1:]
+	if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		return segment[1 : len(segment)-1]
+	}
+	return ""
+}
+
+// linkTarget prefers the $ref of the linked operation's success response so
+// relationship targets match x-relationships conventions, falling back to the
+// operation identifier.
+func linkTarget(target linkedOperation) string {
+	if target.operation.Responses != nil {
+		for _, code := range []string{"200", "201", "default"} {
+			response := target.operation.Responses.Value(code)
+			if response == nil || response.Value == nil {
+				continue
+			}
+			if ref := preferredMediaTypeSchema(response.Value.Content); ref != nil && ref.Ref != "" {
+				return ref.Ref
+			}
+		}
+	}
+	if target.operation.OperationID != "" {
+		return target.operation.OperationID
+	}
+	return strings.ToLower(target.method) + ":" + target.path
+}
+
+func countBodyParameters(parameters map[string]any) int {
+	count := 0
+	for _, value := range parameters {
+		if _, ok := bodyPointer(value); ok {
+			count++
+		}
+	}
+	return count
+}
+
+func bodyPointer(value any) (string, bool) {
+	expression, ok := value.(string)
+	if !ok {
+		return "", false
+	}
+	expression = strings.TrimSpace(expression)
+	for _, prefix := range []string{requestBodyExpressionPrefix, responseBodyExpressionPrefix} {
+		if pointer, ok := strings.CutPrefix(expression, prefix); ok && strings.Trim(pointer, "/") != "" {
+			return pointer, true
+		}
+	}
+	return "", false
+}
+
+func requestBodySchema(operation *openapi3.Operation) *openapi3.Schema {
+	if operation == nil || operation.RequestBody == nil || operation.RequestBody.Value == nil {
+		return nil
+	}
+	ref := preferredMediaTypeSchema(operation.RequestBody.Value.Content)
+	if ref == nil {
+		return nil
+	}
+	return ref.Value
+}
+
+// annotateLinkedProperty installs the derived extensions on the property the
+// JSON pointer addresses. The property schema is copied before mutation so
+// shared $ref targets are not annotated for unrelated fields.
+func annotateLinkedProperty(root *openapi3.Schema, pointer string, endpoint, relationship map[string]any) {
+	segments := strings.Split(strings.Trim(pointer, "/"), "/")
+	parent := root
+	for idx, segment := range segments {
+		segment = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+		owner, ref := findProperty(parent, segment)
+		if ref == nil || ref.Value == nil {
+			return
+		}
+		if idx < len(segments)-1 {
+			parent = ref.Value
+			continue
+		}
+		if _, ok := ref.Value.Extensions[endpointExtensionKey]; ok {
+			return
+		}
+		if _, ok := ref.Value.Extensions[relationshipExtensionKey]; ok {
+			return
+		}
+		copied := *ref.Value
+		copied.Extensions = make(map[string]any, len(ref.Value.Extensions)+2)
+		for key, value := range ref.Value.Extensions {
+			copied.Extensions[key] = value
+		}
+		copied.Extensions[endpointExtensionKey] = endpoint
+		copied.Extensions[relationshipExtensionKey] = relationship
+		owner.Properties[segment] = &openapi3.SchemaRef{Ref: ref.Ref, Value: &copied}
+	}
+}
+
+// findProperty looks up a property on the schema or its allOf branches and
+// returns the schema owning it.
+func findProperty(schema *openapi3.Schema, name string) (*openapi3.Schema, *openapi3.SchemaRef) {
+	if schema == nil {
+		return nil, nil
+	}
+	if ref, ok := schema.Properties[name]; ok {
+		return schema, ref
+	}
+	for _, branch := range schema.AllOf {
+		if branch == nil {
+			continue
+		}
+		if owner, ref := findProperty(branch.Value, name); ref != nil {
+			return owner, ref
+		}
+	}
+	return nil, nil
+}
+
+func sortedLinks(links openapi3.Links) []*openapi3.Link {
+	if len(links) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(links))
+	for name := range links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]*openapi3.Link, 0, len(names))
+	for _, name := range names {
+		if ref := links[name]; ref != nil && ref.Value != nil {
+			result = append(result, ref.Value)
+		}
+	}
+	return result
+}
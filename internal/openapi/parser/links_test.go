@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+)
+
+func TestOperationsDeriveRelationshipsFromLinks(t *testing.T) {
+	t.Parallel()
+
+	const document = `{
+  "openapi": "3.0.0",
+  "info": { "title": "Links", "version": "1.0.0" },
+  "paths": {
+    "/authors": {
+      "get": {"operationId": "listAuthors", "responses": {"200": {"description": "ok"}}}
+    },
+    "/authors/{authorId}": {
+      "get": {
+        "operationId": "getAuthor",
+        "parameters": [{"name": "authorId", "in": "path", "required": true, "schema": {"type": "string"}}],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Author"}}}
+          }
+        }
+      }
+    },
+    "/publishers": {
+      "get": {"operationId": "listPublishers", "responses": {"200": {"description": "ok"}}}
+    },
+    "/books": {
+      "post": {
+        "operationId": "createBook",
+        "requestBody": {
+          "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Book"}}}
+        },
+        "responses": {
+          "201": {
+            "description": "created",
+            "links": {
+              "BookAuthor": {
+                "operationId": "getAuthor",
+                "parameters": {"authorId": "$request.body#/author_id", "tenant": "$request.body#/tenant_id"}
+              },
+              "BookPublisher": {"$ref": "#/components/links/BookPublisher"}
+            }
+          }
+        }
+      }
+    }
+  },
+  "components": {
+    "links": {
+      "BookPublisher": {
+        "operationRef": "#/paths/~1publishers/get",
+        "parameters": {
+          "publisher": "$request.body#/publisher_id",
+          "status": "active"
+        }
+      }
+    },
+    "schemas": {
+      "Author": {"type": "object", "properties": {"id": {"type": "string"}}},
+      "Book": {
+        "type": "object",
+        "properties": {
+          "title": {"type": "string"},
+          "author_id": {"type": "string"},
+          "publisher_id": {"type": "string"},
+          "tenant_id": {"type": "string"}
+        }
+      }
+    }
+  }
+}`
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(document))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	operations, err := New(pkgopenapi.NewParserOptions()).Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse operations: %v", err)
+	}
+	book := operations["createBook"].RequestBody
+
+	author := book.Properties["author_id"]
+	endpoint, ok := author.Extensions["x-endpoint"].(map[string]any)
+	if !ok || endpoint["url"] != "/authors" || endpoint["method"] != "GET" {
+		t.Fatalf("author_id x-endpoint = %#v", author.Extensions["x-endpoint"])
+	}
+	relationship, ok := author.Extensions["x-relationships"].(map[string]any)
+	if !ok || relationship["type"] != "belongsTo" || relationship["target"] != "#/components/schemas/Author" {
+		t.Fatalf("author_id x-relationships = %#v", author.Extensions["x-relationships"])
+	}
+
+	publisher := book.Properties["publisher_id"]
+	endpoint, ok = publisher.Extensions["x-endpoint"].(map[string]any)
+	if !ok || endpoint["url"] != "/publishers" {
+		t.Fatalf("publisher_id x-endpoint = %#v", publisher.Extensions["x-endpoint"])
+	}
+	if params, _ := endpoint["params"].(map[string]any); params["status"] != "active" {
+		t.Fatalf("publisher_id params = %#v", endpoint["params"])
+	}
+
+	authorEndpoint := author.Extensions["x-endpoint"].(map[string]any)
+	if dynamic, _ := authorEndpoint["dynamicParams"].(map[string]any); dynamic["tenant"] != "{{field:tenant_id}}" {
+		t.Fatalf("author_id dynamicParams = %#v", authorEndpoint["dynamicParams"])
+	}
+
+	for _, name := range []string{"title", "tenant_id"} {
+		if _, ok := book.Properties[name].Extensions["x-endpoint"]; ok {
+			t.Fatalf("%s must not receive an endpoint", name)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+// canonicaliseExtensionNamespaces rewrites custom vendor extension prefixes
+// onto x-formgen across the loaded document so the extraction helpers only
+// ever deal with the canonical namespace. The spec is mutated in place; it is
+// private to the current Operations call.
+func canonicaliseExtensionNamespaces(spec *openapi3.T, namespaces []string) {
+	if spec == nil || len(schema.ExtensionNamespaceOrder(namespaces)) == 1 {
+		return
+	}
+	visited := make(map[*openapi3.Schema]struct{})
+	if spec.Components != nil {
+		for _, ref := range spec.Components.Schemas {
+			canonicaliseSchemaRef(ref, namespaces, visited)
+		}
+	}
+	if spec.Paths == nil {
+		return
+	}
+	for _, item := range spec.Paths.Map() {
+		if item == nil {
+			continue
+		}
+		for _, operation := range item.Operations() {
+			if operation == nil {
+				continue
+			}
+			operation.Extensions = schema.CanonicalExtensions(operation.Extensions, namespaces)
+			if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+				canonicaliseContent(operation.RequestBody.Value.Content, namespaces, visited)
+			}
+			if operation.Responses == nil {
+				continue
+			}
+			for _, response := range operation.Responses.Map() {
+				if response != nil && response.Value != nil {
+					canonicaliseContent(response.Value.Content, namespaces, visited)
+				}
+			}
+		}
+	}
+}
+
+func canonicaliseContent(content openapi3.Content, namespaces []string, visited map[*openapi3.Schema]struct{}) {
+	for _, media := range content {
+		if media != nil {
+			canonicaliseSchemaRef(media.Schema, namespaces, visited)
+		}
+	}
+}
+
+func canonicaliseSchemaRef(ref *openapi3.SchemaRef, namespaces []string, visited map[*openapi3.Schema]struct{}) {
+	if ref == nil || ref.Value == nil {
+		return
+	}
+	value := ref.Value
+	if _, seen := visited[value]; seen {
+		return
+	}
+	visited[value] = struct{}{}
+
+	value.Extensions = schema.CanonicalExtensions(value.Extensions, namespaces)
+	for _, property := range value.Properties {
+		canonicaliseSchemaRef(property, namespaces, visited)
+	}
+	canonicaliseSchemaRef(value.Items, namespaces, visited)
+	canonicaliseSchemaRef(value.Not, namespaces, visited)
+	canonicaliseSchemaRef(value.AdditionalProperties.Schema, namespaces, visited)
+	for _, refs := range []openapi3.SchemaRefs{value.AllOf, value.AnyOf, value.OneOf} {
+		for _, item := range refs {
+			canonicaliseSchemaRef(item, namespaces, visited)
+		}
+	}
+}
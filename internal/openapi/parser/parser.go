@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"net/url"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"gopkg.in/yaml.v3"
@@ -19,8 +22,11 @@ type Parser struct {
 	options pkgopenapi.ParserOptions
 }
 
-// Ensure the implementation satisfies the public interface.
-var _ pkgopenapi.Parser = (*Parser)(nil)
+// Ensure the implementation satisfies the public interfaces.
+var (
+	_ pkgopenapi.Parser          = (*Parser)(nil)
+	_ pkgopenapi.OperationParser = (*Parser)(nil)
+)
 
 // New constructs a Parser with the given options.
 func New(options pkgopenapi.ParserOptions) pkgopenapi.Parser {
@@ -29,12 +35,48 @@ func New(options pkgopenapi.ParserOptions) pkgopenapi.Parser {
 
 // Operations converts a Document into a map keyed by operationId.
 func (p *Parser) Operations(ctx context.Context, doc pkgopenapi.Document) (map[string]pkgopenapi.Operation, error) {
+	spec, raw, err := p.loadSpec(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	presence := collectSchemaKeywordPresence(raw, spec)
+	security := newDocumentSecurity(spec)
+	renaming := p.options.OperationIDNormalizer != nil || len(p.options.OperationIDAliases) > 0
+	results := p.convertPaths(ctx, spec.Paths, presence, security)
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
+
+	operations := make(map[string]pkgopenapi.Operation)
+	for _, converted := range results {
+		for _, op := range converted {
+			if existing, ok := operations[op.ID]; ok && renaming {
+				return nil, collisionError(op, existing)
+			}
+			operations[op.ID] = p.options.TransformOperation(op)
+		}
+	}
+
+	if len(operations) == 0 && !p.options.AllowPartialDocuments {
+		return nil, errors.New("openapi parser: no operations extracted")
+	}
+
+	return operations, nil
+}
+
+// loadSpec loads, validates, and prepares the document shared by Operations
+// and Operation. It returns the raw payload read for it.
+func (p *Parser) loadSpec(ctx context.Context, doc pkgopenapi.Document) (*openapi3.T, []byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
 	raw := doc.Raw()
 	if len(raw) == 0 {
-		return nil, errors.New("openapi parser: document payload is empty")
+		if doc.Compacted() {
+			return nil, nil, errors.New("openapi parser: compacted document payload is unavailable (reload the source)")
+		}
+		return nil, nil, errors.New("openapi parser: document payload is empty")
 	}
 
 	loader := &openapi3.Loader{
@@ -42,44 +84,100 @@ func (p *Parser) Operations(ctx context.Context, doc pkgopenapi.Document) (map[s
 		IsExternalRefsAllowed: p.options.ResolveReferences,
 	}
 
-	spec, err := loader.LoadFromData(raw)
+	var location *url.URL
+	if p.options.ResolveReferences {
+		ws, root, err := newWorkspace(p.options.ExternalRefs, doc.Source())
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := ws.checkSize(doc.Location(), len(raw)); err != nil {
+			return nil, nil, err
+		}
+		loader.ReadFromURIFunc = ws.read
+		location = root
+	}
+
+	var spec *openapi3.T
+	var err error
+	if location != nil {
+		spec, err = loader.LoadFromDataWithPath(raw, location)
+	} else {
+		spec, err = loader.LoadFromData(raw)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("openapi parser: load document: %w", err)
+		return nil, nil, fmt.Errorf("openapi parser: load document: %w", err)
 	}
 
 	if spec.Paths == nil || spec.Paths.Len() == 0 {
 		if !p.options.AllowPartialDocuments {
-			return nil, errors.New("openapi parser: document does not contain any paths")
+			return nil, nil, errors.New("openapi parser: document does not contain any paths")
 		}
 	}
 
 	if err := p.resolveReferences(ctx, loader, spec); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	canonicaliseExtensionNamespaces(spec, p.options.ExtensionNamespaces)
+	applyLinkRelationships(spec)
+	return spec, raw, nil
+}
+
+// Operation locates and converts only the operation that resolves to
+// operationID, for servers that render one form per request from a large
+// document. The document is still loaded and validated, but no other
+// operation is converted. IDs resolve as in Operations (a renamed id shared by
+// two operations is an error) and OperationTransforms apply; a missing id
+// matches pkgopenapi.ErrOperationNotFound.
+func (p *Parser) Operation(ctx context.Context, doc pkgopenapi.Document, operationID string) (pkgopenapi.Operation, error) {
+	spec, raw, err := p.loadSpec(ctx, doc)
+	if err != nil {
+		return pkgopenapi.Operation{}, err
+	}
+	op, err := p.locateOperation(spec.Paths, operationID, collectSchemaKeywordPresence(raw, spec), newDocumentSecurity(spec))
+	if err != nil {
+		return pkgopenapi.Operation{}, err
 	}
+	return p.options.TransformOperation(op), nil
+}
 
-	presence := collectSchemaKeywordPresence(raw, spec)
-	operations := make(map[string]pkgopenapi.Operation)
-	if spec.Paths != nil {
-		for path, item := range spec.Paths.Map() {
-			if item == nil {
+// locateOperation converts only the operations resolving to operationID and
+// selects among them by the same rules as Operations: operations that fail to
+// convert are dropped, the last valid match in path order wins, and when ids
+// are renamed two valid matches are a collision.
+func (p *Parser) locateOperation(paths *openapi3.Paths, operationID string, presence schemaKeywordPresence, security documentSecurity) (pkgopenapi.Operation, error) {
+	var (
+		found    pkgopenapi.Operation
+		matched  bool
+		renaming = p.options.OperationIDNormalizer != nil || len(p.options.OperationIDAliases) > 0
+		items    map[string]*openapi3.PathItem
+	)
+	if paths != nil {
+		items = paths.Map()
+	}
+	for _, path := range slices.Sorted(maps.Keys(items)) {
+		item := items[path]
+		for _, entry := range pathItemOperations(item) {
+			if p.resolveID(entry, path) != operationID {
 				continue
 			}
-			p.collectOperation(ctx, operations, "GET", path, item.Get, presence)
-			p.collectOperation(ctx, operations, "PUT", path, item.Put, presence)
-			p.collectOperation(ctx, operations, "POST", path, item.Post, presence)
-			p.collectOperation(ctx, operations, "DELETE", path, item.Delete, presence)
-			p.collectOperation(ctx, operations, "PATCH", path, item.Patch, presence)
-			p.collectOperation(ctx, operations, "HEAD", path, item.Head, presence)
-			p.collectOperation(ctx, operations, "OPTIONS", path, item.Options, presence)
-			p.collectOperation(ctx, operations, "TRACE", path, item.Trace, presence)
+			op, ok := p.convertOperation(entry.method, path, item.Parameters, entry.operation, presence, security)
+			if !ok {
+				continue
+			}
+			if matched && renaming {
+				return pkgopenapi.Operation{}, collisionError(op, found)
+			}
+			found, matched = op, true
 		}
 	}
-
-	if len(operations) == 0 && !p.options.AllowPartialDocuments {
-		return nil, errors.New("openapi parser: no operations extracted")
+	if !matched {
+		return pkgopenapi.Operation{}, fmt.Errorf("openapi parser: operation %q: %w", operationID, pkgopenapi.ErrOperationNotFound)
 	}
+	return found, nil
+}
 
-	return operations, nil
+func collisionError(op, existing pkgopenapi.Operation) error {
+	return fmt.Errorf("openapi parser: operation %s %s resolves to id %q already used by %s %s", op.Method, op.Path, op.ID, existing.Method, existing.Path)
 }
 
 func (p *Parser) resolveReferences(ctx context.Context, loader *openapi3.Loader, spec *openapi3.T) error {
@@ -92,48 +190,243 @@ func (p *Parser) resolveReferences(ctx context.Context, loader *openapi3.Loader,
 	return nil
 }
 
-func (p *Parser) collectOperation(ctx context.Context, target map[string]pkgopenapi.Operation, method, path string, operation *openapi3.Operation, presence schemaKeywordPresence) {
-	if ctx.Err() != nil {
-		return
+// convertPaths converts every path item on up to options.Concurrency workers.
+// Results are indexed by sorted path so merging them does not depend on
+// scheduling. Conversion stops early once ctx is cancelled.
+func (p *Parser) convertPaths(ctx context.Context, paths *openapi3.Paths, presence schemaKeywordPresence, security documentSecurity) [][]pkgopenapi.Operation {
+	if paths == nil {
+		return nil
+	}
+	items := paths.Map()
+	keys := slices.Sorted(maps.Keys(items))
+	results := make([][]pkgopenapi.Operation, len(keys))
+	convert := func(idx int) {
+		if ctx.Err() != nil {
+			return
+		}
+		results[idx] = p.convertPathItem(keys[idx], items[keys[idx]], presence, security)
+	}
+
+	workers := min(p.options.Concurrency, len(keys))
+	if workers <= 1 {
+		for idx := range keys {
+			convert(idx)
+		}
+		return results
+	}
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for idx := range next {
+				convert(idx)
+			}
+		})
+	}
+	for idx := range keys {
+		next <- idx
+	}
+	close(next)
+	wg.Wait()
+	return results
+}
+
+func (p *Parser) convertPathItem(path string, item *openapi3.PathItem, presence schemaKeywordPresence, security documentSecurity) []pkgopenapi.Operation {
+	var operations []pkgopenapi.Operation
+	for _, entry := range pathItemOperations(item) {
+		if op, ok := p.convertOperation(entry.method, path, item.Parameters, entry.operation, presence, security); ok {
+			operations = append(operations, op)
+		}
 	}
+	return operations
+}
+
+type methodOperation struct {
+	method    string
+	operation *openapi3.Operation
+}
+
+// pathItemOperations lists the operations declared on item in method order.
+func pathItemOperations(item *openapi3.PathItem) []methodOperation {
+	if item == nil {
+		return nil
+	}
+	var operations []methodOperation
+	for _, entry := range []methodOperation{
+		{"GET", item.Get},
+		{"PUT", item.Put},
+		{"POST", item.Post},
+		{"DELETE", item.Delete},
+		{"PATCH", item.Patch},
+		{"HEAD", item.Head},
+		{"OPTIONS", item.Options},
+		{"TRACE", item.Trace},
+	} {
+		if entry.operation != nil {
+			operations = append(operations, entry)
+		}
+	}
+	return operations
+}
+
+func (p *Parser) resolveID(entry methodOperation, path string) string {
+	rawID := entry.operation.OperationID
+	if rawID == "" {
+		rawID = strings.ToLower(entry.method) + ":" + path
+	}
+	return p.options.ResolveOperationID(entry.method, path, rawID)
+}
+
+func (p *Parser) convertOperation(method, path string, shared openapi3.Parameters, operation *openapi3.Operation, presence schemaKeywordPresence, security documentSecurity) (pkgopenapi.Operation, bool) {
 	if operation == nil {
-		return
+		return pkgopenapi.Operation{}, false
 	}
-	opID := operation.OperationID
-	if opID == "" {
-		opID = strings.ToLower(method) + ":" + path
+	opID := p.resolveID(methodOperation{method, operation}, path)
+	requestSchema, requestMediaType, requestBodies := p.extractRequestSchema(operation.RequestBody, presence)
+	var responseSchemas map[string]pkgopenapi.Schema
+	if !p.options.LazyResponseSchemas {
+		responseSchemas = p.extractResponseSchemas(operation.Responses, presence)
 	}
-	requestSchema := p.extractRequestSchema(operation.RequestBody, presence)
-	responseSchemas := p.extractResponseSchemas(operation.Responses, presence)
 
 	op, err := pkgopenapi.NewOperation(opID, method, path, requestSchema, responseSchemas)
 	if err != nil {
 		// Invalid operations are skipped but noted by leaving them out.
-		return
+		return pkgopenapi.Operation{}, false
+	}
+	if p.options.LazyResponseSchemas {
+		responses := operation.Responses
+		op = op.WithLazyResponses(func() map[string]pkgopenapi.Schema {
+			return p.extractResponseSchemas(responses, presence)
+		})
 	}
+	op.RequestMediaType = requestMediaType
+	op.RequestBodies = requestBodies
 	op.Summary = operation.Summary
 	op.Description = operation.Description
+	if len(operation.Tags) > 0 {
+		op.Tags = append([]string(nil), operation.Tags...)
+	}
+	op.Parameters = extractParameters(shared, operation.Parameters, presence)
 	op.Extensions = extractExtensions(operation.Extensions)
-	target[opID] = op
+	security.apply(&op, operation)
+	return op, true
 }
 
-func (p *Parser) extractRequestSchema(requestBody *openapi3.RequestBodyRef, presence schemaKeywordPresence) pkgopenapi.Schema {
+// preferredRequestMediaTypes orders the media types picked as the default
+// request body variant; other media types follow alphabetically.
+var preferredRequestMediaTypes = []string{"application/json", "application/x-www-form-urlencoded", "multipart/form-data"}
+
+// extractRequestSchema returns the default request body schema, its media type,
+// and, when the body declares several media types, every variant keyed by
+// media type.
+func (p *Parser) extractRequestSchema(requestBody *openapi3.RequestBodyRef, presence schemaKeywordPresence) (pkgopenapi.Schema, string, map[string]pkgopenapi.Schema) {
 	if requestBody == nil {
-		return pkgopenapi.Schema{}
+		return pkgopenapi.Schema{}, "", nil
 	}
 	if requestBody.Value == nil {
-		return pkgopenapi.Schema{Ref: requestBody.Ref}
+		return pkgopenapi.Schema{Ref: requestBody.Ref}, "", nil
 	}
 	content := requestBody.Value.Content
-	for _, mediaType := range []string{"application/json", "application/x-www-form-urlencoded", "multipart/form-data"} {
-		if mt, ok := content[mediaType]; ok {
-			return convertSchemaWithPresence(mt.Schema, presence)
+	if len(content) == 0 {
+		return pkgopenapi.Schema{}, "", nil
+	}
+
+	mediaTypes := make([]string, 0, len(content))
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+	preferred := mediaTypes[0]
+	for _, candidate := range preferredRequestMediaTypes {
+		if _, ok := content[candidate]; ok {
+			preferred = candidate
+			break
 		}
 	}
-	for _, mt := range content {
-		return convertSchemaWithPresence(mt.Schema, presence)
+
+	if len(content) == 1 {
+		return convertMediaTypeSchema(content[preferred], presence), preferred, nil
+	}
+	variants := make(map[string]pkgopenapi.Schema, len(content))
+	for _, mediaType := range mediaTypes {
+		variants[mediaType] = convertMediaTypeSchema(content[mediaType], presence)
 	}
-	return pkgopenapi.Schema{}
+	return variants[preferred], preferred, variants
+}
+
+// convertMediaTypeSchema converts the media type schema and attaches its
+// `encoding` entries to the matching top-level properties.
+func convertMediaTypeSchema(mediaType *openapi3.MediaType, presence schemaKeywordPresence) pkgopenapi.Schema {
+	if mediaType == nil {
+		return pkgopenapi.Schema{}
+	}
+	converted := convertSchemaWithPresence(mediaType.Schema, presence)
+	if len(mediaType.Encoding) == 0 || len(converted.Properties) == 0 {
+		return converted
+	}
+	converted.Properties = maps.Clone(converted.Properties)
+	for name, encoding := range mediaType.Encoding {
+		property, ok := converted.Properties[name]
+		if !ok || encoding == nil {
+			continue
+		}
+		property.Encoding = &pkgopenapi.Encoding{
+			ContentType:   strings.TrimSpace(encoding.ContentType),
+			Style:         encoding.Style,
+			Explode:       encoding.Explode,
+			AllowReserved: encoding.AllowReserved,
+		}
+		for header := range encoding.Headers {
+			property.Encoding.Headers = append(property.Encoding.Headers, header)
+		}
+		sort.Strings(property.Encoding.Headers)
+		converted.Properties[name] = property
+	}
+	return converted
+}
+
+// extractParameters merges path-item parameters with operation parameters (the
+// operation wins on name+location clashes, per the OpenAPI spec). Cookie
+// parameters are skipped because forms cannot set them.
+func extractParameters(shared, own openapi3.Parameters, presence schemaKeywordPresence) []pkgopenapi.Parameter {
+	var result []pkgopenapi.Parameter
+	index := make(map[string]int)
+	for _, group := range []openapi3.Parameters{shared, own} {
+		for _, ref := range group {
+			if ref == nil || ref.Value == nil {
+				continue
+			}
+			param := ref.Value
+			in := strings.ToLower(strings.TrimSpace(param.In))
+			if param.Name == "" || (in != openapi3.ParameterInPath && in != openapi3.ParameterInQuery && in != openapi3.ParameterInHeader) {
+				continue
+			}
+			converted := pkgopenapi.Parameter{
+				Name:        param.Name,
+				In:          in,
+				Required:    param.Required || in == openapi3.ParameterInPath,
+				Description: param.Description,
+				Schema:      convertSchemaWithPresence(param.Schema, presence),
+			}
+			if converted.Schema.Type == "" && converted.Schema.Ref == "" {
+				converted.Schema.Type = "string"
+			}
+			if ext := extractExtensions(param.Extensions); len(ext) > 0 {
+				if converted.Schema.Extensions == nil {
+					converted.Schema.Extensions = make(map[string]any, len(ext))
+				}
+				maps.Copy(converted.Schema.Extensions, ext)
+			}
+			key := in + ":" + param.Name
+			if idx, ok := index[key]; ok {
+				result[idx] = converted
+				continue
+			}
+			index[key] = len(result)
+			result = append(result, converted)
+		}
+	}
+	return result
 }
 
 func (p *Parser) extractResponseSchemas(responses *openapi3.Responses, presence schemaKeywordPresence) map[string]pkgopenapi.Schema {
@@ -423,12 +716,14 @@ func convertSchemaWithState(
 }
 
 func baseSchemaFromOpenAPI(ref string, src *openapi3.Schema) pkgopenapi.Schema {
+	schemaType, nullable := nullableSchemaType(src.Type)
 	schema := pkgopenapi.Schema{
 		Ref:         ref,
-		Type:        firstSchemaType(src.Type),
+		Type:        schemaType,
 		Format:      src.Format,
 		Description: src.Description,
 		Default:     src.Default,
+		Nullable:    src.Nullable || nullable,
 	}
 	if len(src.Required) > 0 {
 		schema.Required = append([]string(nil), src.Required...)
@@ -687,10 +982,26 @@ func firstSchemaType(types *openapi3.Types) string {
 	}
 }
 
+// nullableSchemaType unwraps OpenAPI 3.1 type arrays that pair a single type
+// with "null" (e.g. ["boolean", "null"]). Other type arrays keep the joined
+// form produced by firstSchemaType.
+func nullableSchemaType(types *openapi3.Types) (string, bool) {
+	if types == nil || len(types.Slice()) != 2 || !types.Includes(openapi3.TypeNull) {
+		return firstSchemaType(types), false
+	}
+	for _, value := range types.Slice() {
+		if value != openapi3.TypeNull {
+			return value, true
+		}
+	}
+	return firstSchemaType(types), false
+}
+
 const (
 	extensionNamespace       = "x-formgen"
 	adminExtensionNamespace  = "x-admin"
 	endpointExtensionKey     = "x-endpoint"
+	validateEndpointKey      = "x-validate-endpoint"
 	currentValueExtensionKey = "x-current-value"
 )
 
@@ -713,7 +1024,7 @@ func extractExtensions(raw map[string]any) map[string]any {
 
 func normaliseExtensionValue(key string, value any) (any, bool) {
 	switch {
-	case key == extensionNamespace || key == adminExtensionNamespace || key == endpointExtensionKey:
+	case key == extensionNamespace || key == adminExtensionNamespace || key == endpointExtensionKey || key == validateEndpointKey:
 		mapped, ok := cloneMap(value)
 		return mapped, ok && len(mapped) > 0
 	case strings.HasPrefix(key, extensionNamespace+"-") || strings.HasPrefix(key, adminExtensionNamespace+"-"):
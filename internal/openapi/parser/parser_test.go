@@ -2,6 +2,12 @@ package parser
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -201,6 +207,29 @@ func TestConvertSchemaHandlesBooleanExclusiveBounds(t *testing.T) {
 	}
 }
 
+func TestConvertSchemaCapturesNullableBooleans(t *testing.T) {
+	t.Parallel()
+
+	const document = `{
+  "openapi": "3.0.0",
+  "info": { "title": "Nullable", "version": "1.0.0" },
+  "paths": {},
+  "components": {
+    "schemas": {
+      "Verified": { "type": "boolean", "nullable": true }
+    }
+  }
+}`
+
+	converted := loadConvertedComponent(t, document, "Verified")
+	if converted.Type != "boolean" {
+		t.Fatalf("type = %q, want boolean", converted.Type)
+	}
+	if !converted.Nullable {
+		t.Fatalf("nullable = false, want true")
+	}
+}
+
 func TestConvertSchemaHandlesNumericExclusiveBounds(t *testing.T) {
 	t.Parallel()
 
@@ -389,3 +418,475 @@ func loadConvertedComponent(t *testing.T, document, name string) pkgopenapi.Sche
 	}
 	return convertSchema(ref)
 }
+
+const operationIDDocument = `{
+  "openapi": "3.0.0",
+  "info": { "title": "Books", "version": "1.0.0" },
+  "paths": {
+    "/books": {
+      "post": {
+        "operationId": "post-book:create",
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {"type": "object", "properties": {"title": {"type": "string"}}}
+            }
+          }
+        },
+        "responses": {"200": {"description": "ok"}}
+      },
+      "get": {
+        "responses": {"200": {"description": "ok"}}
+      }
+    }
+  }
+}`
+
+func TestOperationsApplyOperationIDNormalizerAndAliases(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(operationIDDocument))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+
+	parser := New(pkgopenapi.NewParserOptions(
+		pkgopenapi.WithOperationIDNormalizer(pkgopenapi.CamelCaseOperationID),
+		pkgopenapi.WithOperationIDAliases(map[string]string{"get:/books": "listBooks"}),
+	))
+	operations, err := parser.Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse operations: %v", err)
+	}
+	if _, ok := operations["postBookCreate"]; !ok {
+		t.Fatalf("expected normalized operation postBookCreate, got %v", operationKeys(operations))
+	}
+	list, ok := operations["listBooks"]
+	if !ok {
+		t.Fatalf("expected aliased operation listBooks, got %v", operationKeys(operations))
+	}
+	if list.ID != "listBooks" || list.Method != "GET" {
+		t.Fatalf("unexpected aliased operation: %+v", list)
+	}
+	if len(operations) != 2 {
+		t.Fatalf("expected 2 operations, got %v", operationKeys(operations))
+	}
+}
+
+func TestOperationsRejectNormalizedIDCollisions(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(operationIDDocument))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+
+	parser := New(pkgopenapi.NewParserOptions(
+		pkgopenapi.WithOperationIDNormalizer(func(_, path, _ string) string { return path }),
+	))
+	if _, err := parser.Operations(context.Background(), doc); err == nil {
+		t.Fatalf("expected collision error")
+	}
+}
+
+func operationKeys(operations map[string]pkgopenapi.Operation) []string {
+	keys := make([]string, 0, len(operations))
+	for key := range operations {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestOperationsRewriteCustomExtensionNamespaces(t *testing.T) {
+	t.Parallel()
+
+	const document = `{
+  "openapi": "3.0.0",
+  "info": { "title": "Namespaces", "version": "1.0.0" },
+  "paths": {
+    "/books": {
+      "post": {
+        "operationId": "createBook",
+        "x-acme-ui": {"submitLabel": "Save book"},
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "properties": {
+                  "title": {"type": "string", "x-acme-ui-placeholder": "Title", "x-unrelated": true}
+                }
+              }
+            }
+          }
+        },
+        "responses": {"200": {"description": "ok"}}
+      }
+    }
+  }
+}`
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(document))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+
+	parser := New(pkgopenapi.NewParserOptions(pkgopenapi.WithExtensionNamespace("x-acme-ui")))
+	operations, err := parser.Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse operations: %v", err)
+	}
+	op := operations["createBook"]
+	nested, ok := op.Extensions["x-formgen"].(map[string]any)
+	if !ok || nested["submitLabel"] != "Save book" {
+		t.Fatalf("operation extensions = %#v, want rewritten x-formgen object", op.Extensions)
+	}
+	title := op.RequestBody.Properties["title"]
+	if got := title.Extensions["x-formgen-placeholder"]; got != "Title" {
+		t.Fatalf("title extensions = %#v, want rewritten flat key", title.Extensions)
+	}
+	if _, ok := title.Extensions["x-acme-ui-placeholder"]; ok {
+		t.Fatalf("expected custom namespace key to be rewritten")
+	}
+}
+
+func TestOperationsApplyOperationTransforms(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(operationIDDocument))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+
+	var order []string
+	parser := New(pkgopenapi.NewParserOptions(
+		pkgopenapi.WithOperationTransform(func(op pkgopenapi.Operation) pkgopenapi.Operation {
+			order = append(order, "first:"+op.ID)
+			if op.Method != "POST" {
+				return op
+			}
+			op.Summary = "Create a book"
+			if op.Extensions == nil {
+				op.Extensions = map[string]any{}
+			}
+			op.Extensions["x-formgen"] = map[string]any{"submitLabel": "Save"}
+			op.ID = "renamed"
+			return op
+		}),
+		pkgopenapi.WithOperationTransform(func(op pkgopenapi.Operation) pkgopenapi.Operation {
+			if op.Summary != "" {
+				op.Summary += "!"
+			}
+			return op
+		}),
+	))
+	operations, err := parser.Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse operations: %v", err)
+	}
+	create, ok := operations["post-book:create"]
+	if !ok {
+		t.Fatalf("expected operation to keep its id, got %v", operationKeys(operations))
+	}
+	if create.ID != "post-book:create" || create.Summary != "Create a book!" {
+		t.Fatalf("unexpected transformed operation: id=%q summary=%q", create.ID, create.Summary)
+	}
+	if nested, _ := create.Extensions["x-formgen"].(map[string]any); nested["submitLabel"] != "Save" {
+		t.Fatalf("extensions = %#v", create.Extensions)
+	}
+	if len(order) != 2 {
+		t.Fatalf("expected transform per operation, got %v", order)
+	}
+}
+
+func TestOperationsKeepValidateEndpointExtension(t *testing.T) {
+	t.Parallel()
+
+	const document = `{
+  "openapi": "3.0.0",
+  "info": { "title": "Validate", "version": "1.0.0" },
+  "paths": {
+    "/articles": {
+      "post": {
+        "operationId": "createArticle",
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "properties": {
+                  "slug": {"type": "string", "x-validate-endpoint": {"url": "/articles/slug-check", "param": "slug"}}
+                }
+              }
+            }
+          }
+        },
+        "responses": {"200": {"description": "ok"}}
+      }
+    }
+  }
+}`
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(document))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	operations, err := New(pkgopenapi.NewParserOptions()).Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse operations: %v", err)
+	}
+	slug := operations["createArticle"].RequestBody.Properties["slug"]
+	endpoint, ok := slug.Extensions["x-validate-endpoint"].(map[string]any)
+	if !ok || endpoint["url"] != "/articles/slug-check" {
+		t.Fatalf("slug extensions = %#v, want x-validate-endpoint", slug.Extensions)
+	}
+}
+
+func TestOperationsCollectParameters(t *testing.T) {
+	t.Parallel()
+
+	const document = `{
+  "openapi": "3.0.0",
+  "info": { "title": "Pets", "version": "1.0.0" },
+  "paths": {
+    "/pets/{petId}": {
+      "parameters": [
+        {"name": "petId", "in": "path", "required": true, "schema": {"type": "integer"}},
+        {"name": "trace", "in": "query", "schema": {"type": "string"}}
+      ],
+      "put": {
+        "operationId": "updatePet",
+        "parameters": [
+          {"name": "trace", "in": "query", "description": "Trace id", "schema": {"type": "string"}, "x-formgen-placeholder": "abc"},
+          {"name": "X-Tenant", "in": "header", "required": true, "schema": {}},
+          {"name": "session", "in": "cookie", "schema": {"type": "string"}}
+        ],
+        "requestBody": {
+          "content": {"application/json": {"schema": {"type": "object", "properties": {"name": {"type": "string"}}}}}
+        },
+        "responses": {"200": {"description": "ok"}}
+      }
+    }
+  }
+}`
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(document))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	operations, err := New(pkgopenapi.NewParserOptions()).Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse operations: %v", err)
+	}
+	params := operations["updatePet"].Parameters
+	if len(params) != 3 {
+		t.Fatalf("parameters = %#v, want path, query, and header entries", params)
+	}
+	if params[0].Name != "petId" || params[0].In != "path" || !params[0].Required || params[0].Schema.Type != "integer" {
+		t.Fatalf("path parameter = %#v", params[0])
+	}
+	if params[1].Name != "trace" || params[1].Description != "Trace id" || params[1].Schema.Extensions["x-formgen-placeholder"] != "abc" {
+		t.Fatalf("query parameter = %#v, want operation override", params[1])
+	}
+	if params[2].Name != "X-Tenant" || params[2].In != "header" || params[2].Schema.Type != "string" {
+		t.Fatalf("header parameter = %#v", params[2])
+	}
+}
+
+func TestOperationsReloadCompactedFileDocuments(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "spec.json")
+	if err := os.WriteFile(path, []byte(operationIDDocument), 0o600); err != nil {
+		t.Fatalf("write spec: %v", err)
+	}
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile(path), []byte(operationIDDocument))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	compacted := doc.Compact()
+	if !compacted.Compacted() {
+		t.Fatal("expected compacted document")
+	}
+	if compacted.Hash() != doc.Hash() || compacted.Hash() == "" {
+		t.Fatalf("expected hash to survive compaction, got %q vs %q", compacted.Hash(), doc.Hash())
+	}
+
+	operations, err := New(pkgopenapi.ParserOptions{}).Operations(context.Background(), compacted)
+	if err != nil {
+		t.Fatalf("parse compacted document: %v", err)
+	}
+	if len(operations) != 2 {
+		t.Fatalf("expected 2 operations, got %v", operationKeys(operations))
+	}
+
+	if err := os.WriteFile(path, []byte(`{"openapi":"3.0.0"}`), 0o600); err != nil {
+		t.Fatalf("rewrite spec: %v", err)
+	}
+	if raw := compacted.Raw(); raw != nil {
+		t.Fatalf("expected nil payload after the file changed, got %d bytes", len(raw))
+	}
+}
+
+func TestOperationsRejectCompactedInMemoryDocuments(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromBytes("inline.json"), []byte(operationIDDocument))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+
+	_, err = New(pkgopenapi.ParserOptions{}).Operations(context.Background(), doc.Compact())
+	if err == nil || !strings.Contains(err.Error(), "compacted") {
+		t.Fatalf("expected compacted payload error, got %v", err)
+	}
+}
+
+func largeOperationsDocument(paths int) string {
+	var builder strings.Builder
+	builder.WriteString(`{"openapi":"3.0.0","info":{"title":"Large","version":"1.0.0"},"paths":{`)
+	for idx := range paths {
+		if idx > 0 {
+			builder.WriteString(",")
+		}
+		fmt.Fprintf(&builder, `"/pets%d/{id}":{
+  "parameters":[{"name":"id","in":"path","required":true,"schema":{"type":"string"}}],
+  "get":{"operationId":"getPet%d","tags":["pets"],"responses":{"200":{"description":"ok","content":{"application/json":{"schema":{"$ref":"#/components/schemas/Pet"}}}}}},
+  "put":{"operationId":"updatePet%d","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Pet"}}}},"responses":{"204":{"description":"saved"}}}
+}`, idx, idx, idx)
+	}
+	builder.WriteString(`},"components":{"schemas":{"Pet":{"type":"object","required":["name"],"properties":{"name":{"type":"string","minLength":1},"tags":{"type":"array","items":{"type":"string"}}}}}}}`)
+	return builder.String()
+}
+
+func TestOperationsConcurrentConversionMatchesSequential(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(largeOperationsDocument(64)))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	sequential, err := New(pkgopenapi.NewParserOptions()).Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse sequentially: %v", err)
+	}
+	concurrent, err := New(pkgopenapi.NewParserOptions(pkgopenapi.WithConcurrency(8))).Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse concurrently: %v", err)
+	}
+	if len(sequential) != 128 || !reflect.DeepEqual(sequential, concurrent) {
+		t.Fatalf("expected identical output, got %d sequential and %d concurrent operations", len(sequential), len(concurrent))
+	}
+
+	collide := pkgopenapi.WithOperationIDNormalizer(func(method, _, _ string) string { return method })
+	var first string
+	for range 5 {
+		_, err := New(pkgopenapi.NewParserOptions(collide, pkgopenapi.WithConcurrency(8))).Operations(context.Background(), doc)
+		if err == nil {
+			t.Fatalf("expected collision error")
+		}
+		if first == "" {
+			first = err.Error()
+		} else if err.Error() != first {
+			t.Fatalf("expected deterministic collision error, got %q then %q", first, err.Error())
+		}
+	}
+}
+
+func TestOperationsReturnContextErrorWhenCancelledMidConversion(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(largeOperationsDocument(16)))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cancelling := pkgopenapi.WithOperationIDNormalizer(func(method, path, id string) string {
+		cancel()
+		return id
+	})
+	operations, err := New(pkgopenapi.NewParserOptions(cancelling, pkgopenapi.WithConcurrency(4))).Operations(ctx, doc)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if operations != nil {
+		t.Fatalf("expected no operations, got %d", len(operations))
+	}
+}
+
+func TestOperationsLazyResponseSchemas(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(largeOperationsDocument(2)))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	eager, err := New(pkgopenapi.NewParserOptions()).Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse eagerly: %v", err)
+	}
+	lazy, err := New(pkgopenapi.NewParserOptions(pkgopenapi.WithLazyResponseSchemas(true), pkgopenapi.WithConcurrency(2))).Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse lazily: %v", err)
+	}
+
+	op := lazy["getPet1"]
+	if op.Responses != nil {
+		t.Fatalf("expected responses to stay unconverted, got %+v", op.Responses)
+	}
+	if got, want := op.ResponseSchemas(), eager["getPet1"].Responses; !reflect.DeepEqual(got, want) {
+		t.Fatalf("lazy responses = %+v, want %+v", got, want)
+	}
+	if !op.HasResponse("200") {
+		t.Fatalf("expected HasResponse to resolve lazy responses")
+	}
+
+	form := pkgopenapi.FormFromOperation(lazy["getPet0"])
+	if form.Responses != nil {
+		t.Fatalf("expected form responses to stay unconverted")
+	}
+	if response, ok := form.ResponseSchemas()["200"]; !ok || response.Properties["name"].Type != "string" {
+		t.Fatalf("unexpected form responses %+v", form.ResponseSchemas())
+	}
+}
+
+func TestOperationConvertsSingleOperation(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pkgopenapi.NewDocument(pkgopenapi.SourceFromFile("inline.json"), []byte(largeOperationsDocument(8)))
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	parser := New(pkgopenapi.NewParserOptions(pkgopenapi.WithOperationTransform(func(op pkgopenapi.Operation) pkgopenapi.Operation {
+		op.Summary = "patched " + op.ID
+		return op
+	}))).(*Parser)
+	operations, err := parser.Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse operations: %v", err)
+	}
+	for _, id := range []string{"getPet0", "updatePet7"} {
+		op, err := parser.Operation(context.Background(), doc, id)
+		if err != nil {
+			t.Fatalf("operation %s: %v", id, err)
+		}
+		if !reflect.DeepEqual(op, operations[id]) {
+			t.Fatalf("operation %s = %+v, want %+v", id, op, operations[id])
+		}
+	}
+
+	if _, err := parser.Operation(context.Background(), doc, "deletePet0"); !errors.Is(err, pkgopenapi.ErrOperationNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	aliased := New(pkgopenapi.NewParserOptions(pkgopenapi.WithOperationIDAliases(map[string]string{"getPet1": "showPet"}))).(*Parser)
+	if op, err := aliased.Operation(context.Background(), doc, "showPet"); err != nil || op.Path != "/pets1/{id}" {
+		t.Fatalf("expected aliased operation, got %+v (%v)", op, err)
+	}
+	collide := New(pkgopenapi.NewParserOptions(pkgopenapi.WithOperationIDNormalizer(func(method, _, _ string) string { return method }))).(*Parser)
+	if _, err := collide.Operation(context.Background(), doc, "GET"); err == nil || errors.Is(err, pkgopenapi.ErrOperationNotFound) {
+		t.Fatalf("expected collision error, got %v", err)
+	}
+}
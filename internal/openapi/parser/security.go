@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+)
+
+// documentSecurity carries the document-level security default and the
+// declared schemes so each operation can resolve its effective requirements.
+type documentSecurity struct {
+	defaults openapi3.SecurityRequirements
+	schemes  openapi3.SecuritySchemes
+}
+
+func newDocumentSecurity(spec *openapi3.T) documentSecurity {
+	security := documentSecurity{defaults: spec.Security}
+	if spec.Components != nil {
+		security.schemes = spec.Components.SecuritySchemes
+	}
+	return security
+}
+
+// apply sets the operation's effective security requirements. An operation
+// level `security` (including an explicit empty list) overrides the document
+// default.
+func (d documentSecurity) apply(op *pkgopenapi.Operation, operation *openapi3.Operation) {
+	requirements := d.defaults
+	if operation.Security != nil {
+		requirements = *operation.Security
+	}
+	if len(requirements) == 0 {
+		return
+	}
+
+	op.Security = make([]pkgopenapi.SecurityRequirement, 0, len(requirements))
+	for _, requirement := range requirements {
+		converted := make(pkgopenapi.SecurityRequirement, len(requirement))
+		for name, scopes := range requirement {
+			converted[name] = append([]string{}, scopes...)
+			scheme, ok := d.scheme(name)
+			if !ok {
+				continue
+			}
+			if op.SecuritySchemes == nil {
+				op.SecuritySchemes = make(map[string]pkgopenapi.SecurityScheme)
+			}
+			op.SecuritySchemes[name] = scheme
+		}
+		op.Security = append(op.Security, converted)
+	}
+}
+
+func (d documentSecurity) scheme(name string) (pkgopenapi.SecurityScheme, bool) {
+	ref, ok := d.schemes[name]
+	if !ok || ref == nil || ref.Value == nil {
+		return pkgopenapi.SecurityScheme{}, false
+	}
+	value := ref.Value
+	return pkgopenapi.SecurityScheme{
+		Type:         value.Type,
+		Scheme:       value.Scheme,
+		BearerFormat: value.BearerFormat,
+		In:           value.In,
+		Name:         value.Name,
+		Description:  value.Description,
+	}, true
+}
@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+)
+
+const securityDocument = `openapi: 3.0.0
+info:
+  title: Secure
+  version: 1.0.0
+security:
+  - bearerAuth: []
+components:
+  securitySchemes:
+    bearerAuth:
+      type: http
+      scheme: bearer
+      bearerFormat: JWT
+    apiKey:
+      type: apiKey
+      in: header
+      name: X-API-Key
+    oauth:
+      type: oauth2
+      flows:
+        clientCredentials:
+          tokenUrl: https://auth.example.com/token
+          scopes:
+            pets:write: Modify pets
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      security: []
+      responses:
+        "200":
+          description: OK
+    post:
+      operationId: createPet
+      security:
+        - oauth: [pets:write]
+        - apiKey: []
+      responses:
+        "201":
+          description: Created
+    put:
+      operationId: replacePets
+      responses:
+        "200":
+          description: OK
+`
+
+func TestOperationsExtractSecurityRequirements(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("secure.yaml"), []byte(securityDocument))
+	operations, err := New(pkgopenapi.NewParserOptions()).Operations(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("parse operations: %v", err)
+	}
+
+	if list := operations["listPets"]; list.Security != nil || list.SecuritySchemes != nil {
+		t.Fatalf("expected explicit empty security to override the default, got %+v", list.Security)
+	}
+
+	replace := operations["replacePets"]
+	if diff := cmp.Diff([]pkgopenapi.SecurityRequirement{{"bearerAuth": {}}}, replace.Security); diff != "" {
+		t.Fatalf("expected document default security (-want +got):\n%s", diff)
+	}
+	if got := replace.SecuritySchemes["bearerAuth"]; got.Type != "http" || got.Scheme != "bearer" || got.BearerFormat != "JWT" {
+		t.Fatalf("unexpected bearer scheme: %+v", got)
+	}
+
+	create := operations["createPet"]
+	want := []pkgopenapi.SecurityRequirement{{"oauth": {"pets:write"}}, {"apiKey": {}}}
+	if diff := cmp.Diff(want, create.Security); diff != "" {
+		t.Fatalf("security mismatch (-want +got):\n%s", diff)
+	}
+	if got := create.SecuritySchemes["apiKey"]; got.In != "header" || got.Name != "X-API-Key" {
+		t.Fatalf("unexpected apiKey scheme: %+v", got)
+	}
+	if _, ok := create.SecuritySchemes["bearerAuth"]; ok {
+		t.Fatal("expected only referenced schemes to be attached")
+	}
+}
@@ -0,0 +1,184 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+)
+
+const (
+	defaultMaxDocumentBytes = int64(5 << 20)
+	defaultMaxDocuments     = 128
+)
+
+// workspace resolves external $refs for a single parse, enforcing the same
+// traversal and size guardrails as the JSON Schema resolver.
+type workspace struct {
+	opts      pkgopenapi.ExternalRefOptions
+	kind      pkgopenapi.SourceKind
+	rootDir   string
+	documents map[string][]byte
+}
+
+func newWorkspace(options pkgopenapi.ExternalRefOptions, src pkgopenapi.Source) (*workspace, *url.URL, error) {
+	if options.MaxDocumentBytes <= 0 {
+		options.MaxDocumentBytes = defaultMaxDocumentBytes
+	}
+	if options.MaxDocuments <= 0 {
+		options.MaxDocuments = defaultMaxDocuments
+	}
+	ws := &workspace{opts: options, documents: make(map[string][]byte)}
+	if src == nil {
+		return ws, nil, nil
+	}
+
+	ws.kind = src.Kind()
+	location := src.Location()
+	switch src.Kind() {
+	case pkgopenapi.SourceKindFile:
+		abs, err := filepath.Abs(location)
+		if err != nil {
+			return nil, nil, fmt.Errorf("openapi parser: resolve document path: %w", err)
+		}
+		ws.rootDir = filepath.Dir(abs)
+		return ws, &url.URL{Path: filepath.ToSlash(abs)}, nil
+	case pkgopenapi.SourceKindFS:
+		cleaned := path.Clean(strings.TrimPrefix(location, "/"))
+		ws.rootDir = path.Dir(cleaned)
+		return ws, &url.URL{Path: cleaned}, nil
+	case pkgopenapi.SourceKindURL:
+		parsed, err := url.Parse(location)
+		if err != nil {
+			return nil, nil, fmt.Errorf("openapi parser: parse document url: %w", err)
+		}
+		ws.rootDir = path.Dir(parsed.Path)
+		return ws, parsed, nil
+	default:
+		return ws, nil, nil
+	}
+}
+
+func (w *workspace) checkSize(location string, size int) error {
+	if int64(size) > w.opts.MaxDocumentBytes {
+		return fmt.Errorf("openapi parser: document too large (%s, %d bytes)", location, size)
+	}
+	return nil
+}
+
+// read implements openapi3.ReadFromURIFunc.
+func (w *workspace) read(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	key := location.String()
+	if cached, ok := w.documents[key]; ok {
+		return cached, nil
+	}
+	if len(w.documents) >= w.opts.MaxDocuments {
+		return nil, fmt.Errorf("openapi parser: exceeded max documents (%d)", w.opts.MaxDocuments)
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	switch {
+	case location.Scheme == "http" || location.Scheme == "https":
+		if !w.opts.AllowHTTPRefs {
+			return nil, fmt.Errorf("openapi parser: http refs disabled (%s)", key)
+		}
+		data, err = w.readHTTP(loader.Context, location)
+	case location.Scheme != "" && location.Scheme != "file":
+		return nil, fmt.Errorf("openapi parser: unsupported ref scheme %q", location.Scheme)
+	case w.kind == pkgopenapi.SourceKindFile:
+		data, err = w.readFile(location.Path)
+	case w.kind == pkgopenapi.SourceKindFS:
+		data, err = w.readFS(location.Path)
+	default:
+		return nil, fmt.Errorf("openapi parser: external refs unsupported for in-memory source (%s)", key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	w.documents[key] = data
+	return data, nil
+}
+
+func (w *workspace) readFile(refPath string) ([]byte, error) {
+	candidate := filepath.Clean(filepath.FromSlash(refPath))
+	if !w.opts.AllowPathTraversal {
+		rel, err := filepath.Rel(w.rootDir, candidate)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("openapi parser: ref path escapes root (%s)", refPath)
+		}
+	}
+	file, err := os.Open(candidate)
+	if err != nil {
+		return nil, fmt.Errorf("openapi parser: read ref: %w", err)
+	}
+	defer file.Close()
+	return w.readLimited(refPath, file)
+}
+
+func (w *workspace) readFS(refPath string) ([]byte, error) {
+	if w.opts.FileSystem == nil {
+		return nil, fmt.Errorf("openapi parser: no filesystem configured for ref %s", refPath)
+	}
+	candidate := path.Clean(strings.TrimPrefix(refPath, "/"))
+	if !w.opts.AllowPathTraversal && w.rootDir != "." {
+		if candidate != w.rootDir && !strings.HasPrefix(candidate, w.rootDir+"/") {
+			return nil, fmt.Errorf("openapi parser: ref path escapes root (%s)", refPath)
+		}
+	}
+	if !fs.ValidPath(candidate) {
+		return nil, fmt.Errorf("openapi parser: ref path escapes root (%s)", refPath)
+	}
+	file, err := w.opts.FileSystem.Open(candidate)
+	if err != nil {
+		return nil, fmt.Errorf("openapi parser: read ref: %w", err)
+	}
+	defer file.Close()
+	return w.readLimited(refPath, file)
+}
+
+func (w *workspace) readHTTP(ctx context.Context, location *url.URL) ([]byte, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	client := w.opts.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("openapi parser: fetch ref: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("openapi parser: fetch ref %s: status %d", location, resp.StatusCode)
+	}
+	return w.readLimited(location.String(), resp.Body)
+}
+
+func (w *workspace) readLimited(location string, r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, w.opts.MaxDocumentBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("openapi parser: read ref: %w", err)
+	}
+	if err := w.checkSize(location, len(data)); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,177 @@
+package parser
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+)
+
+const workspaceRootDocument = `openapi: 3.0.0
+info:
+  title: Workspace
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "REF"
+      responses:
+        "201":
+          description: Created
+`
+
+const workspacePetDocument = `Pet:
+  type: object
+  required: [name]
+  properties:
+    name:
+      $ref: "./common.yaml#/Name"
+    tag:
+      type: string
+`
+
+const workspaceCommonDocument = `Name:
+  type: string
+  minLength: 2
+`
+
+func writeWorkspace(t *testing.T, ref string) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"api/root.yaml":           strings.Replace(workspaceRootDocument, "REF", ref, 1),
+		"api/schemas/pet.yaml":    workspacePetDocument,
+		"api/schemas/common.yaml": workspaceCommonDocument,
+		"outside.yaml":            workspaceCommonDocument,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	return filepath.Join(dir, "api", "root.yaml")
+}
+
+func parseWorkspace(t *testing.T, src pkgopenapi.Source, raw []byte, options ...pkgopenapi.ParserOption) (map[string]pkgopenapi.Operation, error) {
+	t.Helper()
+	doc, err := pkgopenapi.NewDocument(src, raw)
+	if err != nil {
+		t.Fatalf("construct document: %v", err)
+	}
+	return New(pkgopenapi.NewParserOptions(options...)).Operations(context.Background(), doc)
+}
+
+func TestOperationsResolveRelativeRefsAcrossFiles(t *testing.T) {
+	t.Parallel()
+
+	rootPath := writeWorkspace(t, "./schemas/pet.yaml#/Pet")
+	raw, err := os.ReadFile(rootPath)
+	if err != nil {
+		t.Fatalf("read root: %v", err)
+	}
+
+	operations, err := parseWorkspace(t, pkgopenapi.SourceFromFile(rootPath), raw)
+	if err != nil {
+		t.Fatalf("parse workspace: %v", err)
+	}
+	body := operations["createPet"].RequestBody
+	name, ok := body.Properties["name"]
+	if !ok || name.Type != "string" || name.MinLength == nil || *name.MinLength != 2 {
+		t.Fatalf("expected name resolved from common.yaml, got %+v", name)
+	}
+	if len(body.Required) != 1 || body.Required[0] != "name" {
+		t.Fatalf("expected required from pet.yaml, got %v", body.Required)
+	}
+}
+
+func TestOperationsExternalRefGuardrails(t *testing.T) {
+	t.Parallel()
+
+	t.Run("path traversal", func(t *testing.T) {
+		rootPath := writeWorkspace(t, "../outside.yaml#/Name")
+		raw, _ := os.ReadFile(rootPath)
+		_, err := parseWorkspace(t, pkgopenapi.SourceFromFile(rootPath), raw)
+		if err == nil || !strings.Contains(err.Error(), "escapes root") {
+			t.Fatalf("expected traversal error, got %v", err)
+		}
+
+		_, err = parseWorkspace(t, pkgopenapi.SourceFromFile(rootPath), raw,
+			pkgopenapi.WithExternalRefs(pkgopenapi.ExternalRefOptions{AllowPathTraversal: true}))
+		if err != nil {
+			t.Fatalf("expected traversal to be allowed, got %v", err)
+		}
+	})
+
+	t.Run("document size", func(t *testing.T) {
+		rootPath := writeWorkspace(t, "./schemas/pet.yaml#/Pet")
+		raw, _ := os.ReadFile(rootPath)
+		padded := workspacePetDocument + "# " + strings.Repeat("x", len(raw)) + "\n"
+		if err := os.WriteFile(filepath.Join(filepath.Dir(rootPath), "schemas", "pet.yaml"), []byte(padded), 0o600); err != nil {
+			t.Fatalf("write pet: %v", err)
+		}
+		_, err := parseWorkspace(t, pkgopenapi.SourceFromFile(rootPath), raw,
+			pkgopenapi.WithExternalRefs(pkgopenapi.ExternalRefOptions{MaxDocumentBytes: int64(len(raw))}))
+		if err == nil || !strings.Contains(err.Error(), "too large") {
+			t.Fatalf("expected size error, got %v", err)
+		}
+	})
+
+	t.Run("document count", func(t *testing.T) {
+		rootPath := writeWorkspace(t, "./schemas/pet.yaml#/Pet")
+		raw, _ := os.ReadFile(rootPath)
+		_, err := parseWorkspace(t, pkgopenapi.SourceFromFile(rootPath), raw,
+			pkgopenapi.WithExternalRefs(pkgopenapi.ExternalRefOptions{MaxDocuments: 1}))
+		if err == nil || !strings.Contains(err.Error(), "max documents") {
+			t.Fatalf("expected document count error, got %v", err)
+		}
+	})
+
+	t.Run("http disabled", func(t *testing.T) {
+		raw := strings.Replace(workspaceRootDocument, "REF", "https://example.com/pet.yaml#/Pet", 1)
+		_, err := parseWorkspace(t, pkgopenapi.SourceFromBytes("inline.yaml"), []byte(raw))
+		if err == nil || !strings.Contains(err.Error(), "http refs disabled") {
+			t.Fatalf("expected http refs error, got %v", err)
+		}
+	})
+
+	t.Run("in-memory source", func(t *testing.T) {
+		raw := strings.Replace(workspaceRootDocument, "REF", "./schemas/pet.yaml#/Pet", 1)
+		_, err := parseWorkspace(t, pkgopenapi.SourceFromBytes("inline.yaml"), []byte(raw))
+		if err == nil || !strings.Contains(err.Error(), "in-memory source") {
+			t.Fatalf("expected in-memory source error, got %v", err)
+		}
+	})
+}
+
+func TestOperationsResolveRefsInsideFileSystem(t *testing.T) {
+	t.Parallel()
+
+	root := strings.Replace(workspaceRootDocument, "REF", "./schemas/pet.yaml#/Pet", 1)
+	fsys := fstest.MapFS{
+		"api/root.yaml":           {Data: []byte(root)},
+		"api/schemas/pet.yaml":    {Data: []byte(workspacePetDocument)},
+		"api/schemas/common.yaml": {Data: []byte(workspaceCommonDocument)},
+	}
+
+	operations, err := parseWorkspace(t, pkgopenapi.SourceFromFS("api/root.yaml"), []byte(root),
+		pkgopenapi.WithExternalRefs(pkgopenapi.ExternalRefOptions{FileSystem: fsys}))
+	if err != nil {
+		t.Fatalf("parse workspace: %v", err)
+	}
+	if _, ok := operations["createPet"].RequestBody.Properties["name"]; !ok {
+		t.Fatalf("expected name resolved through fs.FS, got %+v", operations["createPet"].RequestBody)
+	}
+}
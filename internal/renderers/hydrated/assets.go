@@ -0,0 +1,47 @@
+package hydrated
+
+import (
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// WithSharedFile returns assets with the file at name served from shared, so
+// flavors reuse one copy of a bundled file (the theme stylesheet) instead of
+// embedding it twice. Directory listings include the shared file.
+func WithSharedFile(assets, shared fs.FS, name string) fs.FS {
+	return sharedFileFS{FS: assets, shared: shared, name: name}
+}
+
+type sharedFileFS struct {
+	fs.FS
+	shared fs.FS
+	name   string
+}
+
+func (s sharedFileFS) Open(name string) (fs.File, error) {
+	if name == s.name {
+		return s.shared.Open(name)
+	}
+	return s.FS.Open(name)
+}
+
+func (s sharedFileFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries, err := fs.ReadDir(s.FS, name)
+	if err != nil || name != path.Dir(s.name) {
+		return entries, err
+	}
+	info, err := fs.Stat(s.shared, s.name)
+	if err != nil {
+		return nil, err
+	}
+	entries = slices.DeleteFunc(entries, func(entry fs.DirEntry) bool {
+		return entry.Name() == info.Name()
+	})
+	entries = append(entries, fs.FileInfoToDirEntry(info))
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return entries, nil
+}
@@ -0,0 +1,1005 @@
+// Package hydrated implements the renderers that emit a JSON form model for a
+// client-side component runtime to hydrate. pkg/renderers/preact and
+// pkg/renderers/react are thin flavors over it that differ only in names and
+// bundled assets.
+package hydrated
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	rendertemplate "github.com/goliatone/go-formgen/pkg/render/template"
+	gotemplate "github.com/goliatone/go-formgen/pkg/render/template/gotemplate"
+	"github.com/goliatone/go-formgen/pkg/submission"
+	"github.com/goliatone/go-formgen/pkg/widgets"
+)
+
+const templateName = "templates/page.tmpl"
+
+// Flavor describes one hydrated renderer: its registry name, which also names
+// the template's root and data elements and prefixes theme asset keys
+// ("<name>.vendor", "<name>.app", "<name>.stylesheet"), and its bundled
+// templates and assets.
+type Flavor struct {
+	Name      string
+	Templates fs.FS
+	Assets    fs.FS
+	Paths     AssetPaths
+}
+
+// Option customises the renderer configuration.
+type Option func(*config)
+
+type config struct {
+	templateFS       fs.FS
+	templateRenderer rendertemplate.TemplateRenderer
+	templateFuncs    map[string]any
+	assetsFS         fs.FS
+	assetPaths       AssetPaths
+	assetURLPrefix   string
+}
+
+type rendererTheme struct {
+	Name         string            `json:"name"`
+	Variant      string            `json:"variant"`
+	Partials     map[string]string `json:"partials,omitempty"`
+	Tokens       map[string]string `json:"tokens,omitempty"`
+	CSSVars      map[string]string `json:"cssVars,omitempty"`
+	CSSVarsStyle string            `json:"css_vars_style,omitempty"`
+	JSON         string            `json:"json,omitempty"`
+}
+
+// AssetPaths describes the URLs emitted by the HTML template. Custom bundles
+// should set all fields even when overriding a single path.
+type AssetPaths struct {
+	VendorScript string
+	AppScript    string
+	Stylesheet   string
+}
+
+// WithTemplatesFS supplies an alternate template bundle via fs.FS.
+func WithTemplatesFS(files fs.FS) Option {
+	return func(cfg *config) {
+		if files != nil {
+			cfg.templateFS = files
+		}
+	}
+}
+
+// WithTemplatesDir loads templates from a directory on disk.
+func WithTemplatesDir(path string) Option {
+	return func(cfg *config) {
+		if path == "" {
+			return
+		}
+		cfg.templateFS = os.DirFS(path)
+	}
+}
+
+// WithTemplateRenderer injects a custom template renderer implementation.
+func WithTemplateRenderer(renderer rendertemplate.TemplateRenderer) Option {
+	return func(cfg *config) {
+		if renderer != nil {
+			cfg.templateRenderer = renderer
+		}
+	}
+}
+
+// WithTemplateFuncs registers template helper functions on the built-in
+// go-template engine. It is a generic injection point for helpers such as i18n
+// translation, formatting, or other UI utilities.
+func WithTemplateFuncs(funcs map[string]any) Option {
+	return func(cfg *config) {
+		if len(funcs) == 0 {
+			return
+		}
+		if cfg.templateFuncs == nil {
+			cfg.templateFuncs = make(map[string]any, len(funcs))
+		}
+		for name, fn := range funcs {
+			if strings.TrimSpace(name) == "" || fn == nil {
+				continue
+			}
+			cfg.templateFuncs[name] = fn
+		}
+	}
+}
+
+// WithAssetsFS overrides the embedded asset bundle (scripts, styles).
+func WithAssetsFS(files fs.FS) Option {
+	return func(cfg *config) {
+		if files != nil {
+			cfg.assetsFS = files
+		}
+	}
+}
+
+// WithAssetsDir loads assets from a directory on disk.
+func WithAssetsDir(path string) Option {
+	return func(cfg *config) {
+		if path == "" {
+			return
+		}
+		cfg.assetsFS = os.DirFS(path)
+	}
+}
+
+// WithAssetPaths customises the relative paths injected into the rendered HTML.
+func WithAssetPaths(paths AssetPaths) Option {
+	return func(cfg *config) {
+		cfg.assetPaths = paths
+	}
+}
+
+// WithAssetURLPrefix prefixes emitted asset paths (e.g. "/static/formgen").
+func WithAssetURLPrefix(prefix string) Option {
+	return func(cfg *config) {
+		cfg.assetURLPrefix = prefix
+	}
+}
+
+// Renderer turns a FormModel into an HTML document hydrated by the flavor's
+// runtime.
+type Renderer struct {
+	name           string
+	templates      rendertemplate.TemplateRenderer
+	assetsFS       fs.FS
+	assetPaths     AssetPaths
+	assetURLPrefix string
+}
+
+// New constructs a renderer for flavor applying any provided options.
+func New(flavor Flavor, options ...Option) (*Renderer, error) {
+	cfg := config{
+		templateFS: flavor.Templates,
+		assetsFS:   flavor.Assets,
+	}
+
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt(&cfg)
+	}
+
+	if cfg.templateFS == nil {
+		cfg.templateFS = flavor.Templates
+	}
+	if err := ensureTemplate(flavor.Name, cfg.templateFS, templateName); err != nil {
+		return nil, err
+	}
+	if cfg.assetsFS == nil {
+		cfg.assetsFS = flavor.Assets
+	}
+
+	paths := normalizeAssetPaths(flavor.Paths, cfg.assetPaths)
+	if err := ensureAssetPaths(flavor.Name, paths); err != nil {
+		return nil, err
+	}
+
+	templateRenderer := cfg.templateRenderer
+	if templateRenderer == nil {
+		var templateFuncs map[string]any
+		if len(cfg.templateFuncs) > 0 {
+			templateFuncs = make(map[string]any, len(cfg.templateFuncs))
+			maps.Copy(templateFuncs, cfg.templateFuncs)
+		}
+
+		options := []gotemplate.Option{
+			gotemplate.WithFS(cfg.templateFS),
+			gotemplate.WithExtension(".tmpl"),
+		}
+		if len(templateFuncs) > 0 {
+			options = append(options, gotemplate.WithTemplateFunc(templateFuncs))
+		}
+
+		engine, err := gotemplate.New(
+			options...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s renderer: configure template renderer: %w", flavor.Name, err)
+		}
+		templateRenderer = engine
+	}
+
+	if err := ensureAssets(flavor.Name, cfg.assetsFS, paths); err != nil {
+		return nil, err
+	}
+
+	return &Renderer{
+		name:           flavor.Name,
+		templates:      templateRenderer,
+		assetsFS:       cfg.assetsFS,
+		assetPaths:     paths,
+		assetURLPrefix: cfg.assetURLPrefix,
+	}, nil
+}
+
+// Name identifies the renderer inside the registry.
+func (r *Renderer) Name() string {
+	return r.name
+}
+
+// ContentType returns the MIME type for generated documents.
+func (r *Renderer) ContentType() string {
+	return "text/html; charset=utf-8"
+}
+
+// Render produces hydrated HTML ready for delivery.
+func (r *Renderer) Render(_ context.Context, form model.FormModel, renderOptions render.RenderOptions) ([]byte, error) {
+	formWithPrefill := form
+	render.ApplySubset(&formWithPrefill, renderOptions.Subset)
+	render.ApplyFieldOverrides(&formWithPrefill, renderOptions.FieldOverrides)
+	render.ApplyParameterFields(&formWithPrefill, renderOptions.Method, renderOptions.Values)
+	applyPrefillValues(&formWithPrefill, renderOptions.Values)
+	render.ApplyTransitions(&formWithPrefill, renderOptions.Values)
+	render.ApplyConcurrency(&formWithPrefill, &renderOptions)
+	render.LocalizeFormModel(&formWithPrefill, renderOptions)
+	render.RedactSensitiveDefaults(&formWithPrefill, renderOptions.IncludeSensitiveDefaults)
+	ensureComponentMetadata(&formWithPrefill)
+
+	mappedErrors := render.MapErrorPayload(formWithPrefill, renderOptions.Errors)
+	formErrors := render.MergeFormErrors(renderOptions.FormErrors, mappedErrors.Form...)
+	hiddenFields := render.SortedHiddenFields(renderOptions.HiddenFields)
+
+	ordered := toOrderedFormModel(formWithPrefill, mappedErrors.Fields, formErrors, hiddenFields)
+	payload, err := json.Marshal(ordered)
+	if err != nil {
+		return nil, fmt.Errorf("%s renderer: marshal form model: %w", r.name, err)
+	}
+	if r.templates == nil {
+		return nil, fmt.Errorf("%s renderer: template renderer is nil", r.name)
+	}
+
+	themeCtx := buildThemeContext(renderOptions.Theme)
+	assetResolver := themeAssetResolver(renderOptions.Theme)
+	urls := r.assetURLs(assetResolver)
+	templateTheme := buildTemplateThemeContext(themeCtx, assetResolver)
+	data := map[string]any{
+		"locale":       renderOptions.Locale,
+		"form":         formWithPrefill,
+		"form_json":    string(payload),
+		"field_orders": fieldOrderPayload(formWithPrefill.Metadata),
+		"assets": map[string]string{
+			"vendorScript": urls.VendorScript,
+			"appScript":    urls.AppScript,
+			"stylesheet":   urls.Stylesheet,
+		},
+		"form_errors": formErrors,
+		"theme":       templateTheme,
+		"render_mode": renderMode(renderOptions.RenderMode),
+	}
+
+	rendered, err := r.templates.RenderTemplate(templateName, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s renderer: render template: %w", r.name, err)
+	}
+
+	if themeCtx.JSON == "" {
+		dataScript := "<script id=\"formgen-" + r.name + "-data\""
+		rendered = strings.Replace(rendered, "\n\n  "+dataScript, "\n  "+dataScript, 1)
+	}
+
+	return []byte(rendered), nil
+}
+
+func renderMode(mode render.RenderMode) render.RenderMode {
+	if mode == "" {
+		return render.RenderModeDocument
+	}
+	return mode
+}
+
+type orderedFormModel struct {
+	OperationID  string               `json:"operationId"`
+	Endpoint     string               `json:"endpoint"`
+	Method       string               `json:"method"`
+	MediaType    string               `json:"mediaType,omitempty"`
+	Summary      string               `json:"summary,omitempty"`
+	Description  string               `json:"description,omitempty"`
+	Fields       []orderedField       `json:"fields"`
+	Metadata     orderedMap           `json:"metadata,omitempty"`
+	UIHints      orderedMap           `json:"uiHints,omitempty"`
+	Errors       map[string][]string  `json:"errors,omitempty"`
+	FormErrors   []string             `json:"formErrors,omitempty"`
+	HiddenFields []render.HiddenField `json:"hiddenFields,omitempty"`
+}
+
+type orderedField struct {
+	Name         string              `json:"name"`
+	Type         model.FieldType     `json:"type"`
+	Format       string              `json:"format,omitempty"`
+	Required     bool                `json:"required"`
+	Disabled     bool                `json:"disabled,omitempty"`
+	Readonly     bool                `json:"readonly,omitempty"`
+	Label        string              `json:"label,omitempty"`
+	Placeholder  string              `json:"placeholder,omitempty"`
+	Description  string              `json:"description,omitempty"`
+	Default      any                 `json:"default,omitempty"`
+	Enum         []any               `json:"enum,omitempty"`
+	EnumOptions  []orderedEnumOption `json:"enumOptions,omitempty"`
+	Nested       []orderedField      `json:"nested,omitempty"`
+	Items        *orderedField       `json:"items,omitempty"`
+	OneOf        []orderedField      `json:"oneOf,omitempty"`
+	Validations  []orderedRule       `json:"validations,omitempty"`
+	Metadata     orderedMap          `json:"metadata,omitempty"`
+	UIHints      orderedMap          `json:"uiHints,omitempty"`
+	Relationship *model.Relationship `json:"relationship,omitempty"`
+	Location     string              `json:"location,omitempty"`
+}
+
+type orderedRule struct {
+	Kind   string     `json:"kind"`
+	Params orderedMap `json:"params,omitempty"`
+}
+
+type orderedEnumOption struct {
+	Value    string `json:"value"`
+	Label    string `json:"label"`
+	Selected bool   `json:"selected,omitempty"`
+}
+
+func toOrderedFormModel(form model.FormModel, errors map[string][]string, formErrors []string, hidden []render.HiddenField) orderedFormModel {
+	fields := make([]orderedField, len(form.Fields))
+	for i, field := range form.Fields {
+		fields[i] = toOrderedField(field)
+	}
+
+	ordered := orderedFormModel{
+		OperationID: form.OperationID,
+		Endpoint:    form.Endpoint,
+		Method:      form.Method,
+		MediaType:   form.MediaType,
+		Summary:     form.Summary,
+		Description: form.Description,
+		Fields:      fields,
+		Metadata:    newOrderedMap(form.Metadata),
+		UIHints:     newOrderedMap(form.UIHints),
+	}
+	if len(errors) > 0 {
+		ordered.Errors = errors
+	}
+	if len(formErrors) > 0 {
+		ordered.FormErrors = formErrors
+	}
+	if len(hidden) > 0 {
+		ordered.HiddenFields = hidden
+	}
+	return ordered
+}
+
+func toOrderedField(field model.Field) orderedField {
+	nested := make([]orderedField, len(field.Nested))
+	for i, f := range field.Nested {
+		nested[i] = toOrderedField(f)
+	}
+
+	var items *orderedField
+	if field.Items != nil {
+		v := toOrderedField(*field.Items)
+		items = &v
+	}
+
+	var oneOf []orderedField
+	if len(field.OneOf) > 0 {
+		oneOf = make([]orderedField, len(field.OneOf))
+		for i, option := range field.OneOf {
+			oneOf[i] = toOrderedField(option)
+		}
+	}
+
+	var rules []orderedRule
+	if len(field.Validations) > 0 {
+		rules = make([]orderedRule, len(field.Validations))
+		for i, rule := range field.Validations {
+			rules[i] = orderedRule{
+				Kind:   rule.Kind,
+				Params: newOrderedMap(rule.Params),
+			}
+		}
+	}
+
+	return orderedField{
+		Name:         field.Name,
+		Type:         field.Type,
+		Format:       field.Format,
+		Required:     field.Required,
+		Disabled:     field.Disabled,
+		Readonly:     field.Readonly,
+		Label:        field.Label,
+		Placeholder:  field.Placeholder,
+		Description:  field.Description,
+		Default:      field.Default,
+		Enum:         field.Enum,
+		EnumOptions:  toOrderedEnumOptions(field),
+		Nested:       nested,
+		Items:        items,
+		OneOf:        oneOf,
+		Validations:  rules,
+		Metadata:     newOrderedMap(field.Metadata),
+		UIHints:      newOrderedMap(field.UIHints),
+		Relationship: field.Relationship,
+		Location:     field.Location,
+	}
+}
+
+func toOrderedEnumOptions(field model.Field) []orderedEnumOption {
+	if len(field.Enum) == 0 {
+		return nil
+	}
+	out := make([]orderedEnumOption, 0, len(field.Enum))
+	for _, value := range field.Enum {
+		out = append(out, orderedEnumOption{
+			Value:    submission.EncodeEnumControlValue(value),
+			Label:    fmt.Sprint(value),
+			Selected: enumSelected(field.Default, value),
+		})
+	}
+	return out
+}
+
+func enumSelected(defaultValue, candidate any) bool {
+	switch defaults := defaultValue.(type) {
+	case []any:
+		for _, value := range defaults {
+			if reflect.DeepEqual(value, candidate) {
+				return true
+			}
+		}
+	case []string:
+		for _, value := range defaults {
+			if fmt.Sprint(candidate) == value {
+				return true
+			}
+		}
+	default:
+		return reflect.DeepEqual(defaultValue, candidate)
+	}
+	return false
+}
+
+type orderedMap map[string]string
+
+func newOrderedMap(values map[string]string) orderedMap {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(values))
+	maps.Copy(result, values)
+	return orderedMap(result)
+}
+
+func (m orderedMap) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return metadataLess(keys[i], keys[j])
+	})
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		keyPayload, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		valuePayload, err := json.Marshal(m[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(keyPayload)
+		buf.WriteByte(':')
+		buf.Write(valuePayload)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+var metadataSpecialOrder = map[string]int{
+	"widget":    0,
+	"hideLabel": 1,
+	"label":     2,
+}
+
+func metadataLess(a, b string) bool {
+	aAdmin := strings.HasPrefix(a, "admin.")
+	bAdmin := strings.HasPrefix(b, "admin.")
+	if aAdmin != bAdmin {
+		return aAdmin
+	}
+
+	aRank, aSpecial := metadataSpecialOrder[a]
+	bRank, bSpecial := metadataSpecialOrder[b]
+	if aSpecial && bSpecial && aRank != bRank {
+		return aRank < bRank
+	}
+	return a < b
+}
+
+func fieldOrderPayload(metadata map[string]string) string {
+	if len(metadata) == 0 {
+		return ""
+	}
+	orders := make(map[string][]string)
+	const prefix = "layout.fieldOrder."
+	for key, raw := range metadata {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		section := strings.TrimSpace(strings.TrimPrefix(key, prefix))
+		if section == "" {
+			continue
+		}
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		var items []string
+		if err := json.Unmarshal([]byte(raw), &items); err != nil {
+			continue
+		}
+		orders[section] = items
+	}
+	if len(orders) == 0 {
+		return ""
+	}
+	payload, err := json.Marshal(orders)
+	if err != nil {
+		return ""
+	}
+	return string(payload)
+}
+
+func buildThemeContext(cfg *render.ThemeConfig) rendererTheme {
+	if cfg == nil {
+		return rendererTheme{}
+	}
+	ctx := rendererTheme{
+		Name:     cfg.Theme,
+		Variant:  cfg.Variant,
+		Partials: copyStringMap(cfg.Partials),
+		Tokens:   copyStringMap(cfg.Tokens),
+		CSSVars:  copyStringMap(cfg.CSSVars),
+	}
+	ctx.CSSVarsStyle = cssVarsStyle(ctx.CSSVars)
+	ctx.JSON = themeJSON(ctx)
+	return ctx
+}
+
+func buildTemplateThemeContext(ctx rendererTheme, resolver func(string) string) map[string]any {
+	return map[string]any{
+		"name":           ctx.Name,
+		"variant":        ctx.Variant,
+		"partials":       ctx.Partials,
+		"tokens":         ctx.Tokens,
+		"cssVars":        ctx.CSSVars,
+		"css_vars_style": ctx.CSSVarsStyle,
+		"json":           ctx.JSON,
+		"assetURL": func(key any) string {
+			trimmed := strings.TrimSpace(anyToString(key))
+			if trimmed == "" {
+				return ""
+			}
+			if resolver == nil {
+				return ""
+			}
+			if strings.HasPrefix(trimmed, "http://") ||
+				strings.HasPrefix(trimmed, "https://") ||
+				strings.HasPrefix(trimmed, "//") ||
+				strings.HasPrefix(trimmed, "/") {
+				return trimmed
+			}
+			if resolved := strings.TrimSpace(resolver(trimmed)); resolved != "" {
+				return resolved
+			}
+			return trimmed
+		},
+	}
+}
+
+func themeAssetResolver(cfg *render.ThemeConfig) func(string) string {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.AssetURL
+}
+
+func anyToString(value any) string {
+	if value == nil {
+		return ""
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func copyStringMap(in map[string]string) map[string]string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	maps.Copy(out, in)
+	return out
+}
+
+func cssVarsStyle(vars map[string]string) string {
+	if len(vars) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(":root {\n")
+	for _, key := range keys {
+		b.WriteString(key)
+		b.WriteString(": ")
+		b.WriteString(vars[key])
+		b.WriteString(";\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func themeJSON(cfg rendererTheme) string {
+	payload := struct {
+		Name    string            `json:"name,omitempty"`
+		Variant string            `json:"variant,omitempty"`
+		Tokens  map[string]string `json:"tokens,omitempty"`
+		CSSVars map[string]string `json:"cssVars,omitempty"`
+	}{
+		Name:    cfg.Name,
+		Variant: cfg.Variant,
+		Tokens:  cfg.Tokens,
+		CSSVars: cfg.CSSVars,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func ensureAssets(name string, store fs.FS, paths AssetPaths) error {
+	required := []struct {
+		label string
+		path  string
+	}{
+		{label: "vendor script", path: paths.VendorScript},
+		{label: "app script", path: paths.AppScript},
+		{label: "stylesheet", path: paths.Stylesheet},
+	}
+	for _, item := range required {
+		if _, err := fs.Stat(store, item.path); err != nil {
+			return fmt.Errorf("%s renderer: %s %q not found: %w", name, item.label, item.path, err)
+		}
+	}
+	return nil
+}
+
+func ensureTemplate(renderer string, store fs.FS, name string) error {
+	if store == nil {
+		return fmt.Errorf("%s renderer: template file system is nil", renderer)
+	}
+	if name == "" {
+		return fmt.Errorf("%s renderer: template name required", renderer)
+	}
+	if _, err := fs.Stat(store, name); err != nil {
+		return fmt.Errorf("%s renderer: template %q not found: %w", renderer, name, err)
+	}
+	return nil
+}
+
+func ensureAssetPaths(name string, paths AssetPaths) error {
+	if paths.VendorScript == "" {
+		return fmt.Errorf("%s renderer: vendor script path required", name)
+	}
+	if paths.AppScript == "" {
+		return fmt.Errorf("%s renderer: app script path required", name)
+	}
+	if paths.Stylesheet == "" {
+		return fmt.Errorf("%s renderer: stylesheet path required", name)
+	}
+	return nil
+}
+
+func normalizeAssetPaths(defaults, paths AssetPaths) AssetPaths {
+	result := defaults
+	if paths.VendorScript != "" {
+		result.VendorScript = paths.VendorScript
+	}
+	if paths.AppScript != "" {
+		result.AppScript = paths.AppScript
+	}
+	if paths.Stylesheet != "" {
+		result.Stylesheet = paths.Stylesheet
+	}
+	return result
+}
+
+type assetURLs struct {
+	VendorScript string
+	AppScript    string
+	Stylesheet   string
+}
+
+func (r *Renderer) assetURLs(resolver func(string) string) assetURLs {
+	vendor := r.assetPaths.VendorScript
+	app := r.assetPaths.AppScript
+	css := r.assetPaths.Stylesheet
+
+	if resolver != nil {
+		if resolved := resolver(r.name + ".vendor"); strings.TrimSpace(resolved) != "" {
+			vendor = resolved
+		}
+		if resolved := resolver(r.name + ".app"); strings.TrimSpace(resolved) != "" {
+			app = resolved
+		}
+		if resolved := resolver(r.name + ".stylesheet"); strings.TrimSpace(resolved) != "" {
+			css = resolved
+		}
+	}
+
+	return assetURLs{
+		VendorScript: expandAssetURL(r.assetURLPrefix, vendor),
+		AppScript:    expandAssetURL(r.assetURLPrefix, app),
+		Stylesheet:   expandAssetURL(r.assetURLPrefix, css),
+	}
+}
+
+func expandAssetURL(prefix, name string) string {
+	if name == "" {
+		return ""
+	}
+	if strings.HasPrefix(name, "http://") ||
+		strings.HasPrefix(name, "https://") ||
+		strings.HasPrefix(name, "//") ||
+		strings.HasPrefix(name, "/") {
+		return name
+	}
+	if prefix == "" {
+		return name
+	}
+	p := strings.TrimRight(prefix, "/")
+	n := strings.TrimLeft(name, "/")
+	if p == "" {
+		return n
+	}
+	return p + "/" + n
+}
+
+func ensureComponentMetadata(form *model.FormModel) {
+	if form == nil {
+		return
+	}
+	form.Fields = ensureComponentMetadataFields(form.Fields)
+}
+
+func ensureComponentMetadataFields(fields []model.Field) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	out := make([]model.Field, len(fields))
+	for i, field := range fields {
+		out[i] = ensureComponentMetadataField(field)
+	}
+	return out
+}
+
+func ensureComponentMetadataField(field model.Field) model.Field {
+	name := ""
+	if field.Metadata != nil {
+		name = strings.TrimSpace(field.Metadata["component.name"])
+	}
+	if name == "" {
+		if widget := widgetHint(field); widget == widgets.WidgetJSONEditor {
+			if field.Metadata == nil {
+				field.Metadata = make(map[string]string, 1)
+			}
+			field.Metadata["component.name"] = "json_editor"
+		}
+	}
+
+	if field.Items != nil {
+		item := ensureComponentMetadataField(*field.Items)
+		field.Items = &item
+	}
+	if len(field.Nested) > 0 {
+		field.Nested = ensureComponentMetadataFields(field.Nested)
+	}
+	return field
+}
+
+func widgetHint(field model.Field) string {
+	if field.Metadata != nil {
+		if widget := strings.TrimSpace(field.Metadata["admin.widget"]); widget != "" {
+			return widget
+		}
+		if widget := strings.TrimSpace(field.Metadata["widget"]); widget != "" {
+			return widget
+		}
+	}
+	if field.UIHints != nil {
+		if widget := strings.TrimSpace(field.UIHints["widget"]); widget != "" {
+			return widget
+		}
+	}
+	return ""
+}
+
+type prefillValue struct {
+	value      any
+	provenance string
+	readonly   bool
+	disabled   bool
+}
+
+func applyPrefillValues(form *model.FormModel, values map[string]any) {
+	if form == nil || len(values) == 0 {
+		return
+	}
+
+	flattened := flattenPrefillValues(values)
+	if len(flattened) == 0 {
+		return
+	}
+
+	form.Fields = applyPrefillToFields(form.Fields, flattened, "")
+}
+
+func flattenPrefillValues(values map[string]any) map[string]prefillValue {
+	flattener := prefillFlattener{result: make(map[string]prefillValue)}
+	for key, value := range values {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		flattener.walk(key, value, prefillValue{})
+	}
+	return flattener.result
+}
+
+type prefillFlattener struct {
+	result map[string]prefillValue
+}
+
+func (f prefillFlattener) walk(prefix string, value any, meta prefillValue) {
+	switch typed := value.(type) {
+	case map[string]any:
+		f.walkAnyMap(prefix, typed, meta)
+	case map[string]string:
+		f.walkStringMap(prefix, typed, meta)
+	case render.ValueWithProvenance:
+		f.walk(prefix, typed.Value, prefillMetaFromValue(typed, meta))
+	case *render.ValueWithProvenance:
+		f.walk(prefix, typed.Value, prefillMetaFromValue(*typed, meta))
+	default:
+		if prefix != "" {
+			meta.value = typed
+			f.result[prefix] = meta
+		}
+	}
+}
+
+func (f prefillFlattener) walkAnyMap(prefix string, values map[string]any, meta prefillValue) {
+	for key, val := range values {
+		if key = strings.TrimSpace(key); key != "" {
+			f.walk(joinPath(prefix, key), val, meta)
+		}
+	}
+	if prefix != "" && (meta.provenance != "" || meta.readonly || meta.disabled) {
+		if _, exists := f.result[prefix]; !exists {
+			f.result[prefix] = meta
+		}
+	}
+}
+
+func (f prefillFlattener) walkStringMap(prefix string, values map[string]string, meta prefillValue) {
+	for key, val := range values {
+		if key = strings.TrimSpace(key); key != "" {
+			next := joinPath(prefix, key)
+			f.result[next] = prefillValue{value: val, provenance: meta.provenance, readonly: meta.readonly, disabled: meta.disabled}
+		}
+	}
+}
+
+func prefillMetaFromValue(value render.ValueWithProvenance, meta prefillValue) prefillValue {
+	meta.provenance = value.Provenance
+	meta.readonly = value.Readonly
+	meta.disabled = value.Disabled
+	return meta
+}
+
+func applyPrefillToFields(fields []model.Field, values map[string]prefillValue, parentPath string) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+
+	for i := range fields {
+		path := joinPath(parentPath, fields[i].Name)
+		if value, ok := values[path]; ok {
+			if value.value != nil {
+				assignPrefillValue(&fields[i], value.value)
+			}
+			applyPrefillMetadata(&fields[i], value)
+		}
+		if len(fields[i].Nested) > 0 {
+			fields[i].Nested = applyPrefillToFields(fields[i].Nested, values, path)
+		}
+	}
+
+	return fields
+}
+
+func assignPrefillValue(field *model.Field, value any) {
+	if field == nil || value == nil {
+		return
+	}
+	field.Default = value
+}
+
+func applyPrefillMetadata(field *model.Field, value prefillValue) {
+	if field == nil {
+		return
+	}
+	if value.provenance != "" {
+		if field.Metadata == nil {
+			field.Metadata = make(map[string]string, 2)
+		}
+		field.Metadata["prefill.provenance"] = value.provenance
+	}
+	if value.readonly {
+		if field.Metadata == nil {
+			field.Metadata = make(map[string]string, 1)
+		}
+		field.Metadata["readonly"] = "true"
+		field.Metadata["prefill.readonly"] = "true"
+		if field.UIHints == nil {
+			field.UIHints = make(map[string]string, 1)
+		}
+		field.UIHints["readonly"] = "true"
+		field.Readonly = true
+	}
+	if value.disabled {
+		if field.Metadata == nil {
+			field.Metadata = make(map[string]string, 1)
+		}
+		field.Metadata["disabled"] = "true"
+		field.Metadata["prefill.disabled"] = "true"
+		field.Disabled = true
+	}
+}
+
+func joinPath(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+	return parent + "." + child
+}
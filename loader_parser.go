@@ -7,10 +7,15 @@ import (
 )
 
 // NewLoader constructs a loader using the internal implementation while keeping
-// the concrete type hidden from consumers.
+// the concrete type hidden from consumers. Overlays configured with
+// pkgopenapi.WithOverlays are applied to every loaded document.
 func NewLoader(options ...pkgopenapi.LoaderOption) pkgopenapi.Loader {
 	cfg := pkgopenapi.NewLoaderOptions(options...)
-	return internalLoader.New(cfg)
+	loader := internalLoader.New(cfg)
+	if len(cfg.Overlays) > 0 {
+		return pkgopenapi.NewOverlayLoader(loader, cfg.Overlays...)
+	}
+	return loader
 }
 
 // NewParser constructs a parser backed by the internal implementation.
@@ -0,0 +1,204 @@
+// Package audit recognizes conventional audit fields (created_at, updatedBy,
+// ...) and adapts them per form so projects do not need their own decorator:
+// create forms (POST) drop them, edit forms (PUT/PATCH) render them read-only,
+// and Strip removes them from submitted values.
+//
+//	decorator := audit.New()
+//	orch := orchestrator.New(orchestrator.WithUIDecorators(decorator))
+//	result, _ := submission.ParseRequest(form, r)
+//	values := decorator.Strip(form, result.Values)
+package audit
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+// MetadataKey marks fields the decorator recognized as audit fields.
+const MetadataKey = "audit"
+
+// DefaultPatterns lists the audit field names recognized by default. Matching
+// ignores case, underscores, and dashes, so "created_at" also matches
+// "createdAt" and "CreatedAt".
+var DefaultPatterns = []string{
+	"created_at", "created_by", "created_on",
+	"updated_at", "updated_by", "updated_on",
+	"modified_at", "modified_by",
+	"deleted_at", "deleted_by",
+}
+
+// Option customizes a Decorator.
+type Option func(*Decorator)
+
+// WithPatterns replaces the recognized field names. Patterns use path.Match
+// globs (e.g. "*_by") and are normalized like field names.
+func WithPatterns(patterns ...string) Option {
+	return func(d *Decorator) {
+		d.patterns = normalizePatterns(patterns)
+	}
+}
+
+// WithExtraPatterns adds field names to the defaults.
+func WithExtraPatterns(patterns ...string) Option {
+	return func(d *Decorator) {
+		d.patterns = append(d.patterns, normalizePatterns(patterns)...)
+	}
+}
+
+// Decorator implements model.Decorator for audit fields.
+type Decorator struct {
+	patterns []string
+}
+
+// New constructs a Decorator using DefaultPatterns unless overridden.
+func New(options ...Option) *Decorator {
+	d := &Decorator{patterns: normalizePatterns(DefaultPatterns)}
+	for _, opt := range options {
+		if opt != nil {
+			opt(d)
+		}
+	}
+	return d
+}
+
+// Match reports whether name is an audit field name.
+func (d *Decorator) Match(name string) bool {
+	if d == nil {
+		return false
+	}
+	normalized := normalize(name)
+	if normalized == "" {
+		return false
+	}
+	for _, pattern := range d.patterns {
+		if ok, err := path.Match(pattern, normalized); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Decorate drops audit fields from create forms and marks them read-only on
+// edit forms. Other methods only tag the fields with MetadataKey.
+func (d *Decorator) Decorate(form *model.FormModel) error {
+	if d == nil || form == nil {
+		return nil
+	}
+	form.Fields = d.decorateFields(form.Fields, strings.ToUpper(strings.TrimSpace(form.Method)))
+	return nil
+}
+
+func (d *Decorator) decorateFields(fields []model.Field, method string) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	out := make([]model.Field, 0, len(fields))
+	for _, field := range fields {
+		if !d.Match(field.Name) {
+			if len(field.Nested) > 0 {
+				field.Nested = d.decorateFields(field.Nested, method)
+			}
+			if field.Items != nil {
+				item := *field.Items
+				item.Nested = d.decorateFields(item.Nested, method)
+				field.Items = &item
+			}
+			out = append(out, field)
+			continue
+		}
+		switch method {
+		case http.MethodPost:
+			continue
+		case http.MethodPut, http.MethodPatch:
+			field.Readonly = true
+			field.Required = false
+			field.Metadata = withEntries(field.Metadata, MetadataKey, "true", "readonly", "true")
+			field.UIHints = withEntries(field.UIHints, "readonly", "true")
+		default:
+			field.Metadata = withEntries(field.Metadata, MetadataKey, "true")
+		}
+		out = append(out, field)
+	}
+	return out
+}
+
+// Strip removes audit values using DefaultPatterns. See Decorator.Strip.
+func Strip(form model.FormModel, values submission.Values) submission.Values {
+	return New().Strip(form, values)
+}
+
+// Strip returns values without audit fields so clients cannot overwrite audit
+// columns. Keys are matched by name at any depth, including inside array
+// items, because create forms drop the fields and a client can still submit
+// them; fields tagged with MetadataKey are removed too. The input map is not
+// modified.
+func (d *Decorator) Strip(form model.FormModel, values submission.Values) submission.Values {
+	if values == nil {
+		return nil
+	}
+	return d.stripObject(form.Fields, values)
+}
+
+func (d *Decorator) stripObject(fields []model.Field, values map[string]any) map[string]any {
+	declared := make(map[string]model.Field, len(fields))
+	for _, field := range fields {
+		declared[field.Name] = field
+	}
+	out := make(map[string]any, len(values))
+	for key, value := range values {
+		field := declared[key]
+		if d.Match(key) || field.Metadata[MetadataKey] == "true" {
+			continue
+		}
+		out[key] = d.stripValue(field, value)
+	}
+	return out
+}
+
+func (d *Decorator) stripValue(field model.Field, value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		return d.stripObject(field.Nested, typed)
+	case []any:
+		var item model.Field
+		if field.Items != nil {
+			item = *field.Items
+		}
+		out := make([]any, len(typed))
+		for i, element := range typed {
+			out[i] = d.stripValue(item, element)
+		}
+		return out
+	}
+	return value
+}
+
+func withEntries(src map[string]string, pairs ...string) map[string]string {
+	out := make(map[string]string, len(src)+len(pairs)/2)
+	for key, value := range src {
+		out[key] = value
+	}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		out[pairs[i]] = pairs[i+1]
+	}
+	return out
+}
+
+func normalizePatterns(patterns []string) []string {
+	out := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		if normalized := normalize(pattern); normalized != "" {
+			out = append(out, normalized)
+		}
+	}
+	return out
+}
+
+func normalize(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer("_", "", "-", "").Replace(name)
+}
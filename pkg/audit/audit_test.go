@@ -0,0 +1,156 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/audit"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+func articleForm(method string) model.FormModel {
+	return model.FormModel{
+		OperationID: "article",
+		Method:      method,
+		Fields: []model.Field{
+			{Name: "title", Type: model.FieldTypeString, Required: true},
+			{Name: "createdAt", Type: model.FieldTypeString, Required: true, Metadata: map[string]string{"format": "date-time"}},
+			{Name: "updated_by", Type: model.FieldTypeString},
+			{Name: "meta", Type: model.FieldTypeObject, Nested: []model.Field{
+				{Name: "deleted_at", Type: model.FieldTypeString},
+				{Name: "note", Type: model.FieldTypeString},
+			}},
+		},
+	}
+}
+
+func fieldNames(fields []model.Field) []string {
+	var names []string
+	for _, field := range fields {
+		names = append(names, field.Name)
+		for _, nested := range field.Nested {
+			names = append(names, field.Name+"."+nested.Name)
+		}
+	}
+	return names
+}
+
+func TestDecoratorHidesAuditFieldsOnCreate(t *testing.T) {
+	form := articleForm("POST")
+	if err := audit.New().Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+	want := []string{"title", "meta", "meta.note"}
+	if diff := cmp.Diff(want, fieldNames(form.Fields)); diff != "" {
+		t.Fatalf("fields mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDecoratorMarksAuditFieldsReadonlyOnEdit(t *testing.T) {
+	form := articleForm("PATCH")
+	original := form.Fields[1].Metadata
+	if err := audit.New().Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+	created := form.Fields[1]
+	if !created.Readonly || created.Required {
+		t.Fatalf("expected createdAt readonly and optional, got %+v", created)
+	}
+	if created.Metadata[audit.MetadataKey] != "true" || created.Metadata["readonly"] != "true" {
+		t.Fatalf("unexpected metadata: %v", created.Metadata)
+	}
+	if created.UIHints["readonly"] != "true" {
+		t.Fatalf("unexpected ui hints: %v", created.UIHints)
+	}
+	if _, ok := original[audit.MetadataKey]; ok {
+		t.Fatalf("expected source metadata untouched")
+	}
+	if !form.Fields[3].Nested[0].Readonly {
+		t.Fatalf("expected nested deleted_at readonly")
+	}
+	if form.Fields[0].Readonly {
+		t.Fatalf("title must stay editable")
+	}
+}
+
+func TestWithPatternsReplacesDefaults(t *testing.T) {
+	decorator := audit.New(audit.WithPatterns("*_by"))
+	cases := map[string]bool{
+		"updatedBy":  true,
+		"owner-by":   true,
+		"created_at": false,
+	}
+	for name, want := range cases {
+		if got := decorator.Match(name); got != want {
+			t.Fatalf("Match(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if !audit.New(audit.WithExtraPatterns("archived_at")).Match("archivedAt") {
+		t.Fatalf("expected extra pattern to match")
+	}
+}
+
+func TestStripRemovesAuditValues(t *testing.T) {
+	form := articleForm("PUT")
+	if err := audit.New().Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+	values := submission.Values{
+		"title":      "Hello",
+		"createdAt":  "2020-01-01",
+		"updated_by": "mallory",
+		"meta":       map[string]any{"deleted_at": "now", "note": "x"},
+	}
+	got := audit.Strip(form, values)
+	want := submission.Values{
+		"title": "Hello",
+		"meta":  map[string]any{"note": "x"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("values mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := values["createdAt"]; !ok {
+		t.Fatalf("expected input values untouched")
+	}
+}
+
+func TestStripMatchesNamesOnCreateForms(t *testing.T) {
+	form := articleForm("POST")
+	form.Fields = append(form.Fields, model.Field{
+		Name: "revisions",
+		Type: model.FieldTypeArray,
+		Items: &model.Field{Type: model.FieldTypeObject, Nested: []model.Field{
+			{Name: "body", Type: model.FieldTypeString},
+			{Name: "created_by", Type: model.FieldTypeString},
+		}},
+	})
+	decorator := audit.New(audit.WithExtraPatterns("archived_at"))
+	if err := decorator.Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+	if items := form.Fields[len(form.Fields)-1].Items.Nested; len(items) != 1 || items[0].Name != "body" {
+		t.Fatalf("expected audit fields dropped from array items, got %+v", items)
+	}
+
+	values := submission.Values{
+		"title":       "Hello",
+		"created_by":  "mallory",
+		"archived_at": "now",
+		"meta":        map[string]any{"deletedAt": "now", "note": "x"},
+		"revisions":   []any{map[string]any{"body": "v1", "created_by": "mallory"}},
+	}
+	got := decorator.Strip(form, values)
+	want := submission.Values{
+		"title":     "Hello",
+		"meta":      map[string]any{"note": "x"},
+		"revisions": []any{map[string]any{"body": "v1"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("values mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := audit.Strip(form, values)["archived_at"]; !ok {
+		t.Fatalf("package Strip should only apply DefaultPatterns")
+	}
+}
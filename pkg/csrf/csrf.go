@@ -0,0 +1,343 @@
+// Package csrf protects form submissions with the double-submit cookie
+// pattern for apps that have no CSRF framework of their own. Middleware keeps
+// a random secret in a cookie and rejects unsafe requests whose hidden field
+// (or header) does not carry the same secret. HiddenField produces the
+// matching render.HiddenField; each call masks the secret with a fresh nonce
+// so the rendered value differs on every page while still verifying against
+// the cookie.
+package csrf
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const (
+	// DefaultCookieName stores the per-browser secret.
+	DefaultCookieName = "_formgen_csrf"
+	// DefaultFieldName is the hidden input carrying the masked token.
+	DefaultFieldName = "_csrf"
+	// DefaultHeaderName lets fetch-based submissions send the token without a
+	// form body.
+	DefaultHeaderName = "X-CSRF-Token"
+
+	tokenLength            = 32
+	defaultMaxAge          = 12 * 60 * 60
+	defaultMaxBodyBytes    = int64(64 << 20)
+	defaultMultipartMemory = int64(32 << 20)
+)
+
+var (
+	// ErrTokenMissing reports an unsafe request without a token.
+	ErrTokenMissing = errors.New("csrf: token missing")
+	// ErrTokenInvalid reports a token that does not match the cookie.
+	ErrTokenInvalid = errors.New("csrf: token invalid")
+)
+
+type contextKey struct{}
+
+// Protector issues and verifies double-submit tokens.
+type Protector struct {
+	cookieName   string
+	fieldName    string
+	headerName   string
+	path         string
+	domain       string
+	maxAge       int
+	secure       bool
+	sameSite     http.SameSite
+	maxBodyBytes int64
+	errorHandler func(http.ResponseWriter, *http.Request, error)
+}
+
+// Option customises a Protector.
+type Option func(*Protector)
+
+// WithCookieName overrides the secret cookie name.
+func WithCookieName(name string) Option {
+	return func(p *Protector) {
+		if name = strings.TrimSpace(name); name != "" {
+			p.cookieName = name
+		}
+	}
+}
+
+// WithFieldName overrides the hidden input name. Use the same name your
+// templates pass to render.CSRFToken when not using HiddenField.
+func WithFieldName(name string) Option {
+	return func(p *Protector) {
+		if name = strings.TrimSpace(name); name != "" {
+			p.fieldName = name
+		}
+	}
+}
+
+// WithHeaderName overrides the request header checked before the form field.
+func WithHeaderName(name string) Option {
+	return func(p *Protector) {
+		if name = strings.TrimSpace(name); name != "" {
+			p.headerName = name
+		}
+	}
+}
+
+// WithCookiePath sets the cookie Path. Defaults to "/".
+func WithCookiePath(path string) Option {
+	return func(p *Protector) {
+		if path = strings.TrimSpace(path); path != "" {
+			p.path = path
+		}
+	}
+}
+
+// WithCookieDomain sets the cookie Domain. Defaults to host-only.
+func WithCookieDomain(domain string) Option {
+	return func(p *Protector) {
+		p.domain = strings.TrimSpace(domain)
+	}
+}
+
+// WithMaxAge sets the cookie lifetime in seconds. Non-positive values make it
+// a session cookie. Defaults to 12 hours.
+func WithMaxAge(seconds int) Option {
+	return func(p *Protector) {
+		p.maxAge = seconds
+	}
+}
+
+// WithSecure marks the cookie Secure. Enable it for HTTPS deployments.
+func WithSecure(secure bool) Option {
+	return func(p *Protector) {
+		p.secure = secure
+	}
+}
+
+// WithSameSite sets the cookie SameSite mode. Defaults to Lax.
+func WithSameSite(mode http.SameSite) Option {
+	return func(p *Protector) {
+		p.sameSite = mode
+	}
+}
+
+// WithMaxBodyBytes caps the multipart body read while looking for the hidden
+// field. Defaults to 64 MiB, matching the submission package.
+func WithMaxBodyBytes(limit int64) Option {
+	return func(p *Protector) {
+		if limit > 0 {
+			p.maxBodyBytes = limit
+		}
+	}
+}
+
+// WithErrorHandler replaces the default 403 response for rejected requests.
+// err is ErrTokenMissing, ErrTokenInvalid, or a body parsing error.
+func WithErrorHandler(handler func(http.ResponseWriter, *http.Request, error)) Option {
+	return func(p *Protector) {
+		if handler != nil {
+			p.errorHandler = handler
+		}
+	}
+}
+
+// New constructs a Protector.
+func New(options ...Option) *Protector {
+	p := &Protector{
+		cookieName:   DefaultCookieName,
+		fieldName:    DefaultFieldName,
+		headerName:   DefaultHeaderName,
+		path:         "/",
+		maxAge:       defaultMaxAge,
+		sameSite:     http.SameSiteLaxMode,
+		maxBodyBytes: defaultMaxBodyBytes,
+		errorHandler: defaultErrorHandler,
+	}
+	for _, opt := range options {
+		if opt != nil {
+			opt(p)
+		}
+	}
+	return p
+}
+
+// FieldName returns the hidden input name the Protector verifies.
+func (p *Protector) FieldName() string {
+	return p.fieldName
+}
+
+// Middleware ensures every response carries the secret cookie and verifies
+// the token on unsafe methods (anything but GET, HEAD, OPTIONS, and TRACE).
+// The header is checked first; otherwise the form body is parsed for the
+// hidden field. Parsed bodies stay available to submission.ParseRequest.
+func (p *Protector) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret, ok := p.cookieSecret(r)
+		if !ok {
+			var err error
+			secret, err = newSecret()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			http.SetCookie(w, p.cookie(secret))
+		}
+		w.Header().Add("Vary", "Cookie")
+
+		if !safeMethod(r.Method) {
+			// A freshly minted secret cannot match anything the client sent.
+			if err := p.verify(r, secret, ok); err != nil {
+				p.errorHandler(w, r, err)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey{}, secret)))
+	})
+}
+
+// Token returns a masked token for the request, or "" when the request did
+// not pass through Middleware. Each call yields a different value.
+func Token(r *http.Request) string {
+	if r == nil {
+		return ""
+	}
+	secret, ok := r.Context().Value(contextKey{}).([]byte)
+	if !ok {
+		return ""
+	}
+	token, err := mask(secret)
+	if err != nil {
+		return ""
+	}
+	return token
+}
+
+// HiddenField returns the hidden input for the request, ready for
+// render.RenderOptions.HiddenFields via render.MergeHiddenFields.
+func (p *Protector) HiddenField(r *http.Request) render.HiddenField {
+	return render.CSRFToken(p.fieldName, Token(r))
+}
+
+func (p *Protector) verify(r *http.Request, secret []byte, known bool) error {
+	token := strings.TrimSpace(r.Header.Get(p.headerName))
+	if token == "" {
+		value, err := p.formToken(r)
+		if err != nil {
+			return err
+		}
+		token = value
+	}
+	if token == "" {
+		return ErrTokenMissing
+	}
+	sent, ok := unmask(token)
+	if !known || !ok || subtle.ConstantTimeCompare(sent, secret) != 1 {
+		return ErrTokenInvalid
+	}
+	return nil
+}
+
+func (p *Protector) formToken(r *http.Request) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch strings.ToLower(mediaType) {
+	case "multipart/form-data":
+		if r.MultipartForm == nil {
+			r.Body = http.MaxBytesReader(nil, r.Body, p.maxBodyBytes)
+			// #nosec G120 -- MaxBytesReader caps the total multipart body before parsing.
+			if err := r.ParseMultipartForm(defaultMultipartMemory); err != nil {
+				return "", fmt.Errorf("csrf: parse multipart form: %w", err)
+			}
+		}
+		if values := r.MultipartForm.Value[p.fieldName]; len(values) > 0 {
+			return strings.TrimSpace(values[0]), nil
+		}
+		return "", nil
+	case "application/x-www-form-urlencoded", "":
+		if err := r.ParseForm(); err != nil {
+			return "", fmt.Errorf("csrf: parse form: %w", err)
+		}
+		return strings.TrimSpace(r.PostForm.Get(p.fieldName)), nil
+	default:
+		return "", nil
+	}
+}
+
+func (p *Protector) cookieSecret(r *http.Request) ([]byte, bool) {
+	cookie, err := r.Cookie(p.cookieName)
+	if err != nil {
+		return nil, false
+	}
+	secret, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil || len(secret) != tokenLength {
+		return nil, false
+	}
+	return secret, true
+}
+
+func (p *Protector) cookie(secret []byte) *http.Cookie {
+	return &http.Cookie{
+		Name:     p.cookieName,
+		Value:    base64.RawURLEncoding.EncodeToString(secret),
+		Path:     p.path,
+		Domain:   p.domain,
+		MaxAge:   p.maxAge,
+		Secure:   p.secure,
+		HttpOnly: true,
+		SameSite: p.sameSite,
+	}
+}
+
+func newSecret() ([]byte, error) {
+	secret := make([]byte, tokenLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("csrf: generate secret: %w", err)
+	}
+	return secret, nil
+}
+
+// mask returns nonce || (nonce XOR secret), so the rendered token changes on
+// every call without changing the secret it proves.
+func mask(secret []byte) (string, error) {
+	out := make([]byte, 2*len(secret))
+	nonce := out[:len(secret)]
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("csrf: generate nonce: %w", err)
+	}
+	for i, b := range secret {
+		out[len(secret)+i] = nonce[i] ^ b
+	}
+	return base64.RawURLEncoding.EncodeToString(out), nil
+}
+
+func unmask(token string) ([]byte, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != 2*tokenLength {
+		return nil, false
+	}
+	secret := make([]byte, tokenLength)
+	for i := range secret {
+		secret[i] = raw[i] ^ raw[tokenLength+i]
+	}
+	return secret, true
+}
+
+func safeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+func defaultErrorHandler(w http.ResponseWriter, _ *http.Request, err error) {
+	http.Error(w, err.Error(), http.StatusForbidden)
+}
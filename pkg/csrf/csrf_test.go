@@ -0,0 +1,174 @@
+package csrf_test
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/csrf"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+type capture struct {
+	field  render.HiddenField
+	called bool
+	form   url.Values
+}
+
+func newHandler(p *csrf.Protector, got *capture) http.Handler {
+	return p.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.called = true
+		got.field = p.HiddenField(r)
+		got.form = r.PostForm
+	}))
+}
+
+// issue performs a GET and returns the cookie and hidden field it produced.
+func issue(t *testing.T, handler http.Handler, got *capture) (*http.Cookie, render.HiddenField) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/form", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET status = %d", rec.Code)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("expected one cookie, got %d", len(cookies))
+	}
+	if !cookies[0].HttpOnly || cookies[0].SameSite != http.SameSiteLaxMode {
+		t.Fatalf("unexpected cookie attributes: %+v", cookies[0])
+	}
+	return cookies[0], got.field
+}
+
+func post(handler http.Handler, cookie *http.Cookie, body url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/form", strings.NewReader(body.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddlewareAcceptsMatchingField(t *testing.T) {
+	got := &capture{}
+	handler := newHandler(csrf.New(), got)
+	cookie, field := issue(t, handler, got)
+	if field.Name != csrf.DefaultFieldName || field.Value == "" {
+		t.Fatalf("unexpected hidden field: %+v", field)
+	}
+
+	got.called = false
+	rec := post(handler, cookie, url.Values{field.Name: {field.Value}, "name": {"Rex"}})
+	if rec.Code != http.StatusOK || !got.called {
+		t.Fatalf("expected request to pass, status %d: %s", rec.Code, rec.Body.String())
+	}
+	if got.form.Get("name") != "Rex" {
+		t.Fatalf("expected parsed form to reach the handler, got %v", got.form)
+	}
+	if len(rec.Result().Cookies()) != 0 {
+		t.Fatalf("expected existing cookie to be reused")
+	}
+}
+
+func TestHiddenFieldIsMaskedPerCall(t *testing.T) {
+	got := &capture{}
+	handler := newHandler(csrf.New(), got)
+	cookie, first := issue(t, handler, got)
+
+	req := httptest.NewRequest(http.MethodGet, "/form", nil)
+	req.AddCookie(cookie)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	second := got.field
+	if first.Value == second.Value {
+		t.Fatalf("expected a fresh masked token per render")
+	}
+	for _, field := range []render.HiddenField{first, second} {
+		if rec := post(handler, cookie, url.Values{field.Name: {field.Value}}); rec.Code != http.StatusOK {
+			t.Fatalf("masked token rejected: %d", rec.Code)
+		}
+	}
+}
+
+func TestMiddlewareRejectsBadRequests(t *testing.T) {
+	got := &capture{}
+	var rejected error
+	p := csrf.New(csrf.WithErrorHandler(func(w http.ResponseWriter, _ *http.Request, err error) {
+		rejected = err
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	handler := newHandler(p, got)
+	cookie, field := issue(t, handler, got)
+
+	_, otherField := issue(t, newHandler(csrf.New(), got), got)
+
+	cases := []struct {
+		name   string
+		cookie *http.Cookie
+		body   url.Values
+		want   error
+	}{
+		{name: "missing field", cookie: cookie, body: url.Values{}, want: csrf.ErrTokenMissing},
+		{name: "missing cookie", body: url.Values{field.Name: {field.Value}}, want: csrf.ErrTokenInvalid},
+		{name: "other secret", cookie: cookie, body: url.Values{field.Name: {otherField.Value}}, want: csrf.ErrTokenInvalid},
+		{name: "garbage", cookie: cookie, body: url.Values{field.Name: {"not-a-token"}}, want: csrf.ErrTokenInvalid},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got.called = false
+			rejected = nil
+			rec := post(handler, tc.cookie, tc.body)
+			if rec.Code != http.StatusTeapot || got.called {
+				t.Fatalf("expected rejection, status %d", rec.Code)
+			}
+			if !errors.Is(rejected, tc.want) {
+				t.Fatalf("error = %v, want %v", rejected, tc.want)
+			}
+		})
+	}
+}
+
+func TestMiddlewareAcceptsHeaderAndMultipart(t *testing.T) {
+	got := &capture{}
+	p := csrf.New(csrf.WithFieldName("csrf_token"), csrf.WithHeaderName("X-Token"))
+	handler := newHandler(p, got)
+	cookie, field := issue(t, handler, got)
+	if field.Name != "csrf_token" {
+		t.Fatalf("field name = %q", field.Name)
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/form", nil)
+	req.Header.Set("X-Token", field.Value)
+	req.AddCookie(cookie)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("header token rejected: %d", rec.Code)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	_ = mw.WriteField("csrf_token", field.Value)
+	_ = mw.Close()
+	req = httptest.NewRequest(http.MethodPost, "/form", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.AddCookie(cookie)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("multipart token rejected: %d %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestTokenOutsideMiddleware(t *testing.T) {
+	if token := csrf.Token(httptest.NewRequest(http.MethodGet, "/", nil)); token != "" {
+		t.Fatalf("expected empty token, got %q", token)
+	}
+}
@@ -0,0 +1,119 @@
+// Package deprecation reports use of formgen APIs that are scheduled for
+// removal. Compatibility shims call Report when a deprecated symbol or option
+// is used; the active Handler decides what happens (log once by default, fail
+// in CI, collect for a migration report). Shims that can no longer honour a
+// call return an *Error so callers can detect it with errors.Is(err,
+// ErrDeprecated).
+package deprecation
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// ErrDeprecated matches every *Error.
+var ErrDeprecated = errors.New("formgen: deprecated API")
+
+// Notice describes one deprecated API.
+type Notice struct {
+	// Symbol names the deprecated API, e.g. "formgen.GenerateHTMLFromDocument".
+	Symbol string `json:"symbol"`
+	// Since is the release that deprecated it.
+	Since string `json:"since,omitempty"`
+	// RemovedIn is the release that will drop it, typically "v2".
+	RemovedIn string `json:"removedIn,omitempty"`
+	// Replacement tells callers what to use instead.
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// String renders the notice as a single log-friendly sentence.
+func (n Notice) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s is deprecated", n.Symbol)
+	if n.Since != "" {
+		fmt.Fprintf(&b, " since %s", n.Since)
+	}
+	if n.RemovedIn != "" {
+		fmt.Fprintf(&b, " and will be removed in %s", n.RemovedIn)
+	}
+	if n.Replacement != "" {
+		fmt.Fprintf(&b, "; use %s instead", n.Replacement)
+	}
+	return b.String()
+}
+
+// Error is returned by shims whose deprecated behaviour is no longer
+// available.
+type Error struct {
+	Notice Notice
+}
+
+func (e *Error) Error() string {
+	return "formgen: " + e.Notice.String()
+}
+
+// Is reports whether target is ErrDeprecated.
+func (e *Error) Is(target error) bool {
+	return target == ErrDeprecated
+}
+
+// Handler receives deprecation notices.
+type Handler func(Notice)
+
+var (
+	mu       sync.Mutex
+	handler  Handler = LogHandler(nil)
+	reported         = make(map[string]bool)
+)
+
+// SetHandler replaces the process-wide handler and returns the previous one.
+// A nil handler silences notices.
+func SetHandler(h Handler) Handler {
+	mu.Lock()
+	defer mu.Unlock()
+	previous := handler
+	handler = h
+	reported = make(map[string]bool)
+	return previous
+}
+
+// Report sends notice to the active handler once per symbol for the lifetime
+// of the handler, so hot paths can call it on every use.
+func Report(notice Notice) {
+	mu.Lock()
+	h := handler
+	if h == nil || reported[notice.Symbol] {
+		mu.Unlock()
+		return
+	}
+	reported[notice.Symbol] = true
+	mu.Unlock()
+	h(notice)
+}
+
+// LogHandler logs notices as warnings on logger, or slog.Default() when nil.
+func LogHandler(logger *slog.Logger) Handler {
+	return func(notice Notice) {
+		l := logger
+		if l == nil {
+			l = slog.Default()
+		}
+		l.Warn(notice.String(),
+			slog.String("symbol", notice.Symbol),
+			slog.String("since", notice.Since),
+			slog.String("removedIn", notice.RemovedIn),
+			slog.String("replacement", notice.Replacement),
+		)
+	}
+}
+
+// PanicHandler panics with an *Error on the first notice. Install it in tests
+// or CI to fail fast on deprecated usage.
+func PanicHandler() Handler {
+	return func(notice Notice) {
+		panic(&Error{Notice: notice})
+	}
+}
@@ -0,0 +1,63 @@
+package deprecation_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goliatone/go-formgen/pkg/deprecation"
+	"github.com/goliatone/go-formgen/pkg/render/template/gotemplate"
+)
+
+func TestDeprecationReportsOncePerSymbol(t *testing.T) {
+	var notices []deprecation.Notice
+	previous := deprecation.SetHandler(func(n deprecation.Notice) { notices = append(notices, n) })
+	t.Cleanup(func() { deprecation.SetHandler(previous) })
+
+	notice := deprecation.Notice{Symbol: "formgen.OldHelper", Since: "v1.4", RemovedIn: "v2", Replacement: "formgen.NewHelper"}
+	deprecation.Report(notice)
+	deprecation.Report(notice)
+	deprecation.Report(deprecation.Notice{Symbol: "formgen.OtherHelper"})
+	if len(notices) != 2 {
+		t.Fatalf("expected 2 notices, got %+v", notices)
+	}
+	if notices[0].String() != "formgen.OldHelper is deprecated since v1.4 and will be removed in v2; use formgen.NewHelper instead" {
+		t.Fatalf("unexpected notice text %q", notices[0].String())
+	}
+
+	err := fmt.Errorf("render: %w", &deprecation.Error{Notice: notice})
+	if !errors.Is(err, deprecation.ErrDeprecated) {
+		t.Fatalf("expected wrapped error to match ErrDeprecated")
+	}
+	var depErr *deprecation.Error
+	if !errors.As(err, &depErr) || depErr.Notice.Symbol != "formgen.OldHelper" {
+		t.Fatalf("expected *deprecation.Error, got %v", err)
+	}
+}
+
+func TestDeprecationPanicHandler(t *testing.T) {
+	previous := deprecation.SetHandler(deprecation.PanicHandler())
+	t.Cleanup(func() { deprecation.SetHandler(previous) })
+
+	defer func() {
+		recovered, ok := recover().(error)
+		if !ok || !errors.Is(recovered, deprecation.ErrDeprecated) {
+			t.Fatalf("expected deprecation panic, got %v", recovered)
+		}
+	}()
+	deprecation.Report(deprecation.Notice{Symbol: "formgen.Removed"})
+}
+
+func TestDeprecatedShimsReport(t *testing.T) {
+	var notices []deprecation.Notice
+	previous := deprecation.SetHandler(func(n deprecation.Notice) { notices = append(notices, n) })
+	t.Cleanup(func() { deprecation.SetHandler(previous) })
+
+	if _, err := gotemplate.New(gotemplate.WithFS(fstest.MapFS{}), gotemplate.WithGoTemplateOptions()); err != nil {
+		t.Fatalf("new engine: %v", err)
+	}
+	if len(notices) != 1 || notices[0].Symbol != "gotemplate.WithGoTemplateOptions" || notices[0].Replacement == "" {
+		t.Fatalf("expected WithGoTemplateOptions notice, got %+v", notices)
+	}
+}
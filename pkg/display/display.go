@@ -0,0 +1,260 @@
+// Package display formats field values for people rather than for form
+// controls. A field opts in with the UI hint `displayFormat` (e.g.
+// "relativeDate", "bytes", "truncate:40"); renderers apply it to read-only and
+// detail output, and Summary applies it when building plain-text summaries
+// such as confirmation emails. The client runtime mirrors the built-in
+// formatters (client/src/display) so values updated in the browser format the
+// same way.
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// HintKey is the UI hint naming a field's display format. The value is a
+// formatter name optionally followed by ":" and an argument.
+const HintKey = "displayFormat"
+
+// Built-in formatter names.
+const (
+	FormatRelativeDate = "relativeDate"
+	FormatDate         = "date"
+	FormatDateTime     = "dateTime"
+	FormatBytes        = "bytes"
+	FormatTruncate     = "truncate"
+)
+
+// DefaultTruncateLength is used by "truncate" without an argument.
+const DefaultTruncateLength = 80
+
+// Context carries per-render inputs to formatters.
+type Context struct {
+	// Now anchors relative formats. Zero values use time.Now.
+	Now time.Time
+}
+
+func (c Context) now() time.Time {
+	if c.Now.IsZero() {
+		return time.Now()
+	}
+	return c.Now
+}
+
+// Formatter turns a value into display text. arg is the text after ":" in the
+// hint, or empty. Formatters report false for values they cannot format so
+// callers fall back to the plain value.
+type Formatter func(ctx Context, value any, arg string) (string, bool)
+
+// Registry maps format names to formatters. It is safe for concurrent use.
+type Registry struct {
+	mu         sync.RWMutex
+	formatters map[string]Formatter
+}
+
+// NewRegistry constructs a registry with the built-in formatters registered.
+func NewRegistry() *Registry {
+	reg := &Registry{formatters: make(map[string]Formatter)}
+	reg.Register(FormatRelativeDate, formatRelativeDate)
+	reg.Register(FormatDate, formatDate)
+	reg.Register(FormatDateTime, formatDateTime)
+	reg.Register(FormatBytes, formatBytes)
+	reg.Register(FormatTruncate, formatTruncate)
+	return reg
+}
+
+// Register adds or replaces the formatter for name.
+func (r *Registry) Register(name string, formatter Formatter) {
+	name = strings.TrimSpace(name)
+	if r == nil || name == "" || formatter == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.formatters[name] = formatter
+}
+
+// Lookup returns the formatter registered for name.
+func (r *Registry) Lookup(name string) (Formatter, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	formatter, ok := r.formatters[strings.TrimSpace(name)]
+	return formatter, ok
+}
+
+// Format applies the formatter named by spec ("name" or "name:arg") to value.
+// Unknown formats, nil values, and values the formatter rejects report false.
+func (r *Registry) Format(ctx Context, spec string, value any) (string, bool) {
+	name, arg := ParseSpec(spec)
+	formatter, ok := r.Lookup(name)
+	if !ok || value == nil {
+		return "", false
+	}
+	return formatter(ctx, value, arg)
+}
+
+// ParseSpec splits a display format hint into its name and argument.
+func ParseSpec(spec string) (string, string) {
+	name, arg, _ := strings.Cut(strings.TrimSpace(spec), ":")
+	return strings.TrimSpace(name), strings.TrimSpace(arg)
+}
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// ParseTime accepts time.Time values and RFC 3339 or ISO-8601 date strings.
+// Strings without an offset are read as UTC.
+func ParseTime(value any) (time.Time, bool) {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed, !typed.IsZero()
+	case *time.Time:
+		if typed == nil {
+			return time.Time{}, false
+		}
+		return *typed, !typed.IsZero()
+	case string:
+		text := strings.TrimSpace(typed)
+		for _, layout := range timeLayouts {
+			if parsed, err := time.Parse(layout, text); err == nil {
+				return parsed, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+var relativeUnits = []struct {
+	name    string
+	seconds float64
+	below   float64
+}{
+	{"minute", 60, 45 * 60},
+	{"hour", 3600, 22 * 3600},
+	{"day", 86400, 26 * 86400},
+	{"month", 30 * 86400, 320 * 86400},
+	{"year", 365 * 86400, math.Inf(1)},
+}
+
+// formatRelativeDate renders "just now", "3 days ago", or "in 2 hours".
+func formatRelativeDate(ctx Context, value any, _ string) (string, bool) {
+	t, ok := ParseTime(value)
+	if !ok {
+		return "", false
+	}
+	delta := ctx.now().Sub(t).Seconds()
+	seconds := math.Abs(delta)
+	if seconds < 45 {
+		return "just now", true
+	}
+	for _, unit := range relativeUnits {
+		if seconds >= unit.below {
+			continue
+		}
+		count := int(math.Max(1, math.Round(seconds/unit.seconds)))
+		label := unit.name
+		if count != 1 {
+			label += "s"
+		}
+		if delta < 0 {
+			return fmt.Sprintf("in %d %s", count, label), true
+		}
+		return fmt.Sprintf("%d %s ago", count, label), true
+	}
+	return "", false
+}
+
+// formatDate renders "Jan 2, 2006" in UTC.
+func formatDate(_ Context, value any, _ string) (string, bool) {
+	t, ok := ParseTime(value)
+	if !ok {
+		return "", false
+	}
+	return t.UTC().Format("Jan 2, 2006"), true
+}
+
+// formatDateTime renders "Jan 2, 2006 15:04 UTC".
+func formatDateTime(_ Context, value any, _ string) (string, bool) {
+	t, ok := ParseTime(value)
+	if !ok {
+		return "", false
+	}
+	return t.UTC().Format("Jan 2, 2006 15:04") + " UTC", true
+}
+
+var byteUnits = []string{"B", "KB", "MB", "GB", "TB", "PB"}
+
+// formatBytes renders sizes with 1024-based units and one decimal, trimmed
+// when it is zero: "512 B", "1.5 KB", "2 MB".
+func formatBytes(_ Context, value any, _ string) (string, bool) {
+	size, ok := toFloat(value)
+	if !ok || size < 0 {
+		return "", false
+	}
+	unit := 0
+	for size >= 1024 && unit < len(byteUnits)-1 {
+		size /= 1024
+		unit++
+	}
+	text := strconv.FormatFloat(math.Round(size*10)/10, 'f', -1, 64)
+	return text + " " + byteUnits[unit], true
+}
+
+// formatTruncate shortens text to arg characters (DefaultTruncateLength when
+// unset), ending with an ellipsis.
+func formatTruncate(_ Context, value any, arg string) (string, bool) {
+	text, ok := value.(string)
+	if !ok {
+		text = fmt.Sprint(value)
+	}
+	limit := DefaultTruncateLength
+	if parsed, err := strconv.Atoi(arg); err == nil && parsed > 0 {
+		limit = parsed
+	}
+	if utf8.RuneCountInString(text) <= limit {
+		return text, true
+	}
+	runes := []rune(text)
+	return strings.TrimRight(string(runes[:limit]), " ") + "…", true
+}
+
+func toFloat(value any) (float64, bool) {
+	switch typed := value.(type) {
+	case int:
+		return float64(typed), true
+	case int32:
+		return float64(typed), true
+	case int64:
+		return float64(typed), true
+	case uint:
+		return float64(typed), true
+	case uint32:
+		return float64(typed), true
+	case uint64:
+		return float64(typed), true
+	case float32:
+		return float64(typed), true
+	case float64:
+		return typed, true
+	case json.Number:
+		parsed, err := typed.Float64()
+		return parsed, err == nil
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(typed), 64)
+		return parsed, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,84 @@
+package display_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/display"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+func TestRegistryFormat(t *testing.T) {
+	t.Parallel()
+
+	registry := display.NewRegistry()
+	ctx := display.Context{Now: time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)}
+	cases := []struct {
+		spec  string
+		value any
+		want  string
+	}{
+		{"relativeDate", "2026-10-16T11:59:30Z", "just now"},
+		{"relativeDate", "2026-10-16T11:00:00Z", "1 hour ago"},
+		{"relativeDate", "2026-10-13T12:00:00Z", "3 days ago"},
+		{"relativeDate", time.Date(2026, 12, 16, 12, 0, 0, 0, time.UTC), "in 2 months"},
+		{"relativeDate", "2024-10-16T12:00:00Z", "2 years ago"},
+		{"date", "2026-10-16T23:30:00-02:00", "Oct 17, 2026"},
+		{"dateTime", "2026-10-16T08:05:00Z", "Oct 16, 2026 08:05 UTC"},
+		{"bytes", 512, "512 B"},
+		{"bytes", "1536", "1.5 KB"},
+		{"bytes", 1073741824.0, "1 GB"},
+		{"truncate:5", "hello world", "hello…"},
+		{"truncate", "short", "short"},
+	}
+	for _, tc := range cases {
+		got, ok := registry.Format(ctx, tc.spec, tc.value)
+		if !ok || got != tc.want {
+			t.Fatalf("Format(%q, %v) = %q, %v; want %q", tc.spec, tc.value, got, ok, tc.want)
+		}
+	}
+	if _, ok := registry.Format(ctx, "bytes", "many"); ok {
+		t.Fatal("expected non-numeric bytes to be rejected")
+	}
+	if _, ok := registry.Format(ctx, "unknown", "value"); ok {
+		t.Fatal("expected unknown format to be rejected")
+	}
+}
+
+func TestRegistrySummary(t *testing.T) {
+	t.Parallel()
+
+	registry := display.NewRegistry()
+	form := model.FormModel{Fields: []model.Field{
+		{Name: "name", Label: "Name", Type: model.FieldTypeString},
+		{Name: "plan", Label: "Plan", Type: model.FieldTypeString, Options: []model.Option{{Value: "pro", Label: "Professional"}}},
+		{Name: "password", Label: "Password", Type: model.FieldTypeString, Sensitive: true},
+		{Name: "upload", Label: "Upload", Type: model.FieldTypeObject, Nested: []model.Field{
+			{Name: "size", Label: "Size", Type: model.FieldTypeInteger, UIHints: map[string]string{display.HintKey: "bytes"}},
+			{Name: "public", Label: "Public", Type: model.FieldTypeBoolean},
+		}},
+		{Name: "notes", Label: "Notes", Type: model.FieldTypeString},
+	}}
+	entries := registry.Summary(display.Context{}, form, map[string]any{
+		"name":     render.PrefillValue("Ada", "profile"),
+		"plan":     "pro",
+		"password": "hunter2",
+		"upload":   map[string]any{"size": 2048, "public": false},
+	})
+	want := []display.Entry{
+		{Path: "name", Label: "Name", Value: "Ada"},
+		{Path: "plan", Label: "Plan", Value: "Professional"},
+		{Path: "password", Label: "Password", Value: display.SensitiveMask},
+		{Path: "upload.size", Label: "Upload › Size", Value: "2 KB"},
+		{Path: "upload.public", Label: "Upload › Public", Value: "No"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %+v", len(want), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Fatalf("entry %d: expected %+v, got %+v", i, want[i], entries[i])
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// SensitiveMask replaces the values of sensitive fields in summaries.
+const SensitiveMask = "••••••••"
+
+// Entry is one line of a summary.
+type Entry struct {
+	// Path is the dotted field path, e.g. "author.name".
+	Path  string
+	Label string
+	Value string
+}
+
+// Summary flattens values into labelled display lines in field order, for
+// plain-text output such as confirmation emails. Nested objects contribute
+// their fields with dotted paths; missing and empty values are skipped.
+// Fields with a displayFormat hint are formatted through the registry,
+// sensitive fields are masked, and enum values use their option labels.
+func (r *Registry) Summary(ctx Context, form model.FormModel, values map[string]any) []Entry {
+	return r.summarize(ctx, form.Fields, values, "", "")
+}
+
+func (r *Registry) summarize(ctx Context, fields []model.Field, values map[string]any, parentPath, parentLabel string) []Entry {
+	var entries []Entry
+	for _, field := range fields {
+		path := field.Name
+		if parentPath != "" {
+			path = parentPath + "." + field.Name
+		}
+		label := strings.TrimSpace(field.Label)
+		if label == "" {
+			label = field.Name
+		}
+		if parentLabel != "" {
+			label = parentLabel + " › " + label
+		}
+		value := unwrap(values[field.Name])
+		if field.Type == model.FieldTypeObject && len(field.Nested) > 0 {
+			if nested, ok := value.(map[string]any); ok {
+				entries = append(entries, r.summarize(ctx, field.Nested, nested, path, label)...)
+			}
+			continue
+		}
+		if text := r.Value(ctx, field, value); text != "" {
+			entries = append(entries, Entry{Path: path, Label: label, Value: text})
+		}
+	}
+	return entries
+}
+
+// Value returns the display text for a single field value: the formatted
+// value when the field has a displayFormat hint the registry can apply, the
+// mask for sensitive fields, and otherwise the option label or plain text.
+func (r *Registry) Value(ctx Context, field model.Field, value any) string {
+	value = unwrap(value)
+	if value == nil || value == "" {
+		return ""
+	}
+	if field.Sensitive {
+		return SensitiveMask
+	}
+	if spec := strings.TrimSpace(field.UIHints[HintKey]); spec != "" {
+		if text, ok := r.Format(ctx, spec, value); ok {
+			return text
+		}
+	}
+	switch typed := value.(type) {
+	case bool:
+		if typed {
+			return "Yes"
+		}
+		return "No"
+	case []any:
+		item := field
+		if field.Items != nil {
+			item = *field.Items
+		}
+		parts := make([]string, 0, len(typed))
+		for _, entry := range typed {
+			if text := r.Value(ctx, item, entry); text != "" {
+				parts = append(parts, text)
+			}
+		}
+		return strings.Join(parts, ", ")
+	case []string:
+		return strings.Join(typed, ", ")
+	}
+	text := fmt.Sprint(value)
+	for _, option := range field.Options {
+		if fmt.Sprint(option.Value) == text && strings.TrimSpace(option.Label) != "" {
+			return option.Label
+		}
+	}
+	return text
+}
+
+func unwrap(value any) any {
+	switch typed := value.(type) {
+	case render.ValueWithProvenance:
+		return typed.Value
+	case *render.ValueWithProvenance:
+		if typed == nil {
+			return nil
+		}
+		return typed.Value
+	}
+	return value
+}
@@ -0,0 +1,223 @@
+// Package exampledata synthesizes deterministic example values from a form
+// model. Generated values respect enums, formats, and min/max constraints so
+// they can seed API tests, documentation samples, or render prefill.
+package exampledata
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// Option customises example generation.
+type Option func(*config)
+
+type config struct {
+	requiredOnly bool
+	useDefaults  bool
+}
+
+// WithRequiredOnly limits the output to required fields (recursively), which
+// yields the smallest payload the operation accepts.
+func WithRequiredOnly() Option {
+	return func(cfg *config) {
+		cfg.requiredOnly = true
+	}
+}
+
+// WithoutDefaults ignores schema defaults and always synthesizes values.
+func WithoutDefaults() Option {
+	return func(cfg *config) {
+		cfg.useDefaults = false
+	}
+}
+
+// Generate returns an example request body for form keyed by field name.
+// Parameter-backed fields (see Field.Location) and read-only fields are not
+// part of the body and are skipped.
+func Generate(form model.FormModel, options ...Option) map[string]any {
+	cfg := newConfig(options)
+	return generateFields(form.Fields, cfg)
+}
+
+// Value returns an example value for a single field.
+func Value(field model.Field, options ...Option) any {
+	return generateValue(field, newConfig(options))
+}
+
+func newConfig(options []Option) config {
+	cfg := config{useDefaults: true}
+	for _, opt := range options {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return cfg
+}
+
+func generateFields(fields []model.Field, cfg config) map[string]any {
+	out := make(map[string]any, len(fields))
+	for _, field := range fields {
+		if field.Name == "" || field.Location != "" || field.Readonly {
+			continue
+		}
+		if cfg.requiredOnly && !field.Required {
+			continue
+		}
+		out[field.Name] = generateValue(field, cfg)
+	}
+	return out
+}
+
+func generateValue(field model.Field, cfg config) any {
+	if cfg.useDefaults && field.Default != nil {
+		return field.Default
+	}
+	if len(field.Enum) > 0 {
+		return field.Enum[0]
+	}
+	for _, option := range field.Options {
+		if !option.Disabled && option.Value != nil {
+			return option.Value
+		}
+	}
+	if len(field.OneOf) > 0 {
+		return generateValue(field.OneOf[0], cfg)
+	}
+
+	switch field.Type {
+	case model.FieldTypeInteger:
+		return int64(numberInRange(field, 1, true))
+	case model.FieldTypeNumber:
+		return numberInRange(field, 1, false)
+	case model.FieldTypeBoolean:
+		return true
+	case model.FieldTypeArray:
+		return generateArray(field, cfg)
+	case model.FieldTypeObject:
+		return generateFields(field.Nested, cfg)
+	default:
+		return generateString(field)
+	}
+}
+
+func generateArray(field model.Field, cfg config) []any {
+	count := 1
+	if minItems, ok := intRule(field, model.ValidationRuleMinItems); ok && minItems > count {
+		count = minItems
+	}
+	if maxItems, ok := intRule(field, model.ValidationRuleMaxItems); ok && maxItems < count {
+		count = maxItems
+	}
+	if field.Items == nil {
+		return make([]any, 0)
+	}
+	items := make([]any, 0, count)
+	for range count {
+		items = append(items, generateValue(*field.Items, cfg))
+	}
+	return items
+}
+
+// numberInRange returns fallback clamped into the field's min/max bounds.
+// Integer bounds are normalised to inclusive whole numbers first; exclusive
+// number bounds step inward by one, or to the midpoint when the range is
+// narrower than that.
+func numberInRange(field model.Field, fallback float64, integer bool) float64 {
+	lo, loExclusive, hasLo := boundRule(field, model.ValidationRuleMin)
+	hi, hiExclusive, hasHi := boundRule(field, model.ValidationRuleMax)
+	if integer {
+		if hasLo {
+			if loExclusive {
+				lo = math.Floor(lo) + 1
+			} else {
+				lo = math.Ceil(lo)
+			}
+		}
+		if hasHi {
+			if hiExclusive {
+				hi = math.Ceil(hi) - 1
+			} else {
+				hi = math.Floor(hi)
+			}
+		}
+		loExclusive, hiExclusive = false, false
+	}
+
+	value := fallback
+	if hasLo && (value < lo || (loExclusive && value <= lo)) {
+		value = lo
+		if loExclusive {
+			value = lo + 1
+		}
+	}
+	if hasHi && (value > hi || (hiExclusive && value >= hi)) {
+		value = hi
+		if hiExclusive {
+			value = hi - 1
+		}
+	}
+	if hasLo && hasHi && (value < lo || (loExclusive && value <= lo)) {
+		value = (lo + hi) / 2
+	}
+	return value
+}
+
+var formatExamples = map[string]string{
+	"email":     "user@example.com",
+	"uri":       "https://example.com",
+	"url":       "https://example.com",
+	"hostname":  "example.com",
+	"uuid":      "00000000-0000-4000-8000-000000000000",
+	"date":      "2024-01-01",
+	"date-time": "2024-01-01T00:00:00Z",
+	"time":      "12:00:00",
+	"ipv4":      "192.0.2.1",
+	"ipv6":      "2001:db8::1",
+	"password":  "password",
+	"byte":      "ZXhhbXBsZQ==",
+}
+
+func generateString(field model.Field) string {
+	value, ok := formatExamples[strings.ToLower(strings.TrimSpace(field.Format))]
+	if !ok {
+		value = "example"
+	}
+	if minLength, ok := intRule(field, model.ValidationRuleMinLength); ok && len(value) < minLength {
+		value += strings.Repeat("x", minLength-len(value))
+	}
+	if maxLength, ok := intRule(field, model.ValidationRuleMaxLength); ok && len(value) > maxLength {
+		value = value[:maxLength]
+	}
+	return value
+}
+
+func intRule(field model.Field, kind string) (int, bool) {
+	for _, rule := range field.Validations {
+		if rule.Kind != kind {
+			continue
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(rule.Params["value"]))
+		if err != nil || value < 0 {
+			return 0, false
+		}
+		return value, true
+	}
+	return 0, false
+}
+
+func boundRule(field model.Field, kind string) (value float64, exclusive bool, ok bool) {
+	for _, rule := range field.Validations {
+		if rule.Kind != kind {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(rule.Params["value"]), 64)
+		if err != nil {
+			return 0, false, false
+		}
+		return parsed, rule.Params["exclusive"] == "true", true
+	}
+	return 0, false, false
+}
@@ -0,0 +1,94 @@
+package exampledata_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/exampledata"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+func rule(kind, value string, exclusive bool) model.ValidationRule {
+	params := map[string]string{"value": value}
+	if exclusive {
+		params["exclusive"] = "true"
+	}
+	return model.ValidationRule{Kind: kind, Params: params}
+}
+
+func sampleForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "createWidget",
+		Fields: []model.Field{
+			{Name: "id", Type: model.FieldTypeString, Location: model.LocationPath},
+			{Name: "email", Type: model.FieldTypeString, Format: "email", Required: true},
+			{Name: "code", Type: model.FieldTypeString, Required: true, Validations: []model.ValidationRule{
+				rule(model.ValidationRuleMinLength, "10", false),
+			}},
+			{Name: "tag", Type: model.FieldTypeString, Validations: []model.ValidationRule{
+				rule(model.ValidationRuleMaxLength, "3", false),
+			}},
+			{Name: "status", Type: model.FieldTypeString, Enum: []any{"draft", "published"}, Required: true},
+			{Name: "count", Type: model.FieldTypeInteger, Validations: []model.ValidationRule{
+				rule(model.ValidationRuleMin, "5", true),
+				rule(model.ValidationRuleMax, "10", false),
+			}},
+			{Name: "ratio", Type: model.FieldTypeNumber, Validations: []model.ValidationRule{
+				rule(model.ValidationRuleMin, "0", false),
+				rule(model.ValidationRuleMax, "0.5", true),
+			}},
+			{Name: "priority", Type: model.FieldTypeInteger, Default: int64(3)},
+			{Name: "createdAt", Type: model.FieldTypeString, Format: "date-time", Readonly: true},
+			{Name: "labels", Type: model.FieldTypeArray, Validations: []model.ValidationRule{
+				rule(model.ValidationRuleMinItems, "2", false),
+			}, Items: &model.Field{Name: "label", Type: model.FieldTypeString}},
+			{Name: "owner", Type: model.FieldTypeObject, Required: true, Nested: []model.Field{
+				{Name: "name", Type: model.FieldTypeString, Required: true},
+				{Name: "active", Type: model.FieldTypeBoolean},
+			}},
+		},
+	}
+}
+
+func TestGenerateRespectsConstraints(t *testing.T) {
+	form := sampleForm()
+
+	got := exampledata.Generate(form)
+	want := map[string]any{
+		"email":    "user@example.com",
+		"code":     "examplexxx",
+		"tag":      "exa",
+		"status":   "draft",
+		"count":    int64(6),
+		"ratio":    0.25,
+		"priority": int64(3),
+		"labels":   []any{"example", "example"},
+		"owner":    map[string]any{"name": "example", "active": true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("sample mismatch (-want +got):\n%s", diff)
+	}
+
+	if issues := submission.Validate(form, got); len(issues) != 0 {
+		t.Fatalf("expected generated sample to validate, got %+v", issues)
+	}
+}
+
+func TestGenerateRequiredOnlyWithoutDefaults(t *testing.T) {
+	form := sampleForm()
+	form.Fields = append(form.Fields, model.Field{Name: "kind", Type: model.FieldTypeString, Required: true, Default: "gadget"})
+
+	got := exampledata.Generate(form, exampledata.WithRequiredOnly(), exampledata.WithoutDefaults())
+	want := map[string]any{
+		"email":  "user@example.com",
+		"code":   "examplexxx",
+		"status": "draft",
+		"owner":  map[string]any{"name": "example"},
+		"kind":   "example",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("required-only sample mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,100 @@
+// Package filterpreset stores named filter presets for generated GET/search
+// forms and converts between form values and query strings. Presets render as
+// quick-select chips through render.RenderOptions.Presets:
+//
+//	presets, err := store.List(ctx, form.OperationID)
+//	values, issues := filterpreset.DecodeQuery(form, r.URL.Query())
+//	html, err := renderer.Render(ctx, form, render.RenderOptions{
+//		Values:  values,
+//		Presets: presets,
+//	})
+//
+// Saving the current search as a preset is the reverse: decode the query, then
+// Save a render.FilterPreset carrying the values.
+package filterpreset
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// ErrInvalidPreset is returned when a preset has no name.
+var ErrInvalidPreset = errors.New("filterpreset: preset name is required")
+
+// Store persists presets per form, keyed by form id (usually the operation
+// id). Implementations must be safe for concurrent use.
+type Store interface {
+	// List returns the presets of a form in the order they were first saved.
+	List(ctx context.Context, formID string) ([]render.FilterPreset, error)
+	// Save adds a preset or replaces the one with the same name.
+	Save(ctx context.Context, formID string, preset render.FilterPreset) error
+	// Delete removes a preset. Deleting a missing preset is not an error.
+	Delete(ctx context.Context, formID, name string) error
+}
+
+// MemoryStore is an in-process Store, suitable for tests and single-instance
+// deployments.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	presets map[string][]render.FilterPreset
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{presets: make(map[string][]render.FilterPreset)}
+}
+
+// List implements Store.
+func (s *MemoryStore) List(ctx context.Context, formID string) ([]render.FilterPreset, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]render.FilterPreset(nil), s.presets[formID]...), nil
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(ctx context.Context, formID string, preset render.FilterPreset) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	preset.Name = strings.TrimSpace(preset.Name)
+	if preset.Name == "" {
+		return ErrInvalidPreset
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := s.presets[formID]
+	for i := range list {
+		if list[i].Name == preset.Name {
+			list[i] = preset
+			return nil
+		}
+	}
+	s.presets[formID] = append(list, preset)
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(ctx context.Context, formID, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := s.presets[formID]
+	for i := range list {
+		if list[i].Name == name {
+			s.presets[formID] = append(list[:i:i], list[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
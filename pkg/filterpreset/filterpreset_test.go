@@ -0,0 +1,111 @@
+package filterpreset_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/filterpreset"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+func searchForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "searchOrders",
+		Endpoint:    "/orders",
+		Method:      "GET",
+		Fields: []model.Field{
+			{Name: "status", Type: model.FieldTypeString, Location: model.LocationQuery},
+			{Name: "minTotal", Type: model.FieldTypeNumber, Location: model.LocationQuery},
+			{Name: "paid", Type: model.FieldTypeBoolean, Location: model.LocationQuery},
+			{Name: "tags", Type: model.FieldTypeArray, Items: &model.Field{Type: model.FieldTypeString}, Location: model.LocationQuery},
+			{Name: "customer", Type: model.FieldTypeObject, Nested: []model.Field{
+				{Name: "country", Type: model.FieldTypeString},
+			}},
+		},
+	}
+}
+
+func TestQueryRoundTripsFormValues(t *testing.T) {
+	form := searchForm()
+	values := map[string]any{
+		"status":           "open",
+		"minTotal":         12.5,
+		"paid":             false,
+		"tags":             []string{"rush", "gift"},
+		"customer.country": "FR",
+		"unknown":          "dropped",
+		"empty":            "",
+	}
+
+	query := filterpreset.EncodeQuery(form, values)
+	want := url.Values{
+		"status":           {"open"},
+		"minTotal":         {"12.5"},
+		"paid":             {"false"},
+		"tags":             {"rush", "gift"},
+		"customer.country": {"FR"},
+	}
+	if diff := cmp.Diff(want, query); diff != "" {
+		t.Fatalf("query mismatch (-want +got):\n%s", diff)
+	}
+
+	decoded, issues := filterpreset.DecodeQuery(form, query)
+	if len(issues) > 0 {
+		t.Fatalf("decode issues: %+v", issues)
+	}
+	wantValues := map[string]any{
+		"status":   "open",
+		"minTotal": 12.5,
+		"paid":     false,
+		"tags":     []any{"rush", "gift"},
+		"customer": map[string]any{"country": "FR"},
+	}
+	if diff := cmp.Diff(wantValues, decoded); diff != "" {
+		t.Fatalf("decoded mismatch (-want +got):\n%s", diff)
+	}
+	if filterpreset.QueryString(form, decoded) != query.Encode() {
+		t.Fatalf("expected decoded values to re-encode to the same query")
+	}
+}
+
+func TestMemoryStoreSavesReplacesAndDeletes(t *testing.T) {
+	ctx := context.Background()
+	store := filterpreset.NewMemoryStore()
+
+	for _, preset := range []render.FilterPreset{
+		{Name: "open", Label: "Open", Values: map[string]any{"status": "open"}},
+		{Name: "paid", Values: map[string]any{"paid": true}},
+		{Name: "open", Label: "Open orders", Values: map[string]any{"status": "open"}},
+	} {
+		if err := store.Save(ctx, "searchOrders", preset); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+	if err := store.Save(ctx, "searchOrders", render.FilterPreset{}); !errors.Is(err, filterpreset.ErrInvalidPreset) {
+		t.Fatalf("expected ErrInvalidPreset, got %v", err)
+	}
+
+	list, err := store.List(ctx, "searchOrders")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list) != 2 || list[0].Label != "Open orders" || list[1].Name != "paid" {
+		t.Fatalf("unexpected presets %+v", list)
+	}
+
+	if err := store.Delete(ctx, "searchOrders", "open"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.Delete(ctx, "searchOrders", "missing"); err != nil {
+		t.Fatalf("delete missing: %v", err)
+	}
+	list, _ = store.List(ctx, "searchOrders")
+	if len(list) != 1 || list[0].Name != "paid" {
+		t.Fatalf("expected only paid preset, got %+v", list)
+	}
+}
@@ -0,0 +1,185 @@
+package filterpreset
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+// EncodeQuery serialises form values into query parameters using the field
+// names the form submits: nested objects become dotted keys, scalar arrays
+// repeat their key, and arrays of objects use indexed keys ("items[0].name").
+// Values for fields the form does not declare, and empty values, are dropped.
+// Dotted keys in values ("author.name") are accepted like prefill values.
+func EncodeQuery(form model.FormModel, values map[string]any) url.Values {
+	query := url.Values{}
+	encodeFields(query, form.Fields, expandValues(values), "")
+	return query
+}
+
+// DecodeQuery parses a query string back into typed form values, the inverse
+// of EncodeQuery. Issues report values that do not coerce to their field type.
+func DecodeQuery(form model.FormModel, query url.Values) (map[string]any, []submission.Issue) {
+	result := submission.ParseValues(form, query, submission.WithUnknownFields(submission.UnknownIgnore))
+	return map[string]any(result.Values), result.Issues
+}
+
+// QueryString returns the encoded query for values, sorted by key, without a
+// leading "?". Equal values always produce equal strings, so it can be used to
+// compare presets with the current search.
+func QueryString(form model.FormModel, values map[string]any) string {
+	return EncodeQuery(form, values).Encode()
+}
+
+func encodeFields(query url.Values, fields []model.Field, values map[string]any, prefix string) {
+	for _, field := range fields {
+		value, ok := values[field.Name]
+		if !ok {
+			continue
+		}
+		key := field.Name
+		if prefix != "" {
+			key = prefix + "." + field.Name
+		}
+		encodeValue(query, field, key, unwrap(value))
+	}
+}
+
+func encodeValue(query url.Values, field model.Field, key string, value any) {
+	if value == nil {
+		return
+	}
+	switch field.Type {
+	case model.FieldTypeObject:
+		if nested := asMap(value); nested != nil && len(field.Nested) > 0 {
+			encodeFields(query, field.Nested, nested, key)
+		}
+		return
+	case model.FieldTypeArray:
+		items := asSlice(value)
+		for idx, item := range items {
+			item = unwrap(item)
+			if field.Items != nil && field.Items.Type == model.FieldTypeObject {
+				if nested := asMap(item); nested != nil {
+					encodeFields(query, field.Items.Nested, nested, fmt.Sprintf("%s[%d]", key, idx))
+				}
+				continue
+			}
+			if text, ok := scalarString(item); ok {
+				query.Add(key, text)
+			}
+		}
+		return
+	}
+	if text, ok := scalarString(value); ok {
+		query.Set(key, text)
+	}
+}
+
+func scalarString(value any) (string, bool) {
+	switch typed := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		if strings.TrimSpace(typed) == "" {
+			return "", false
+		}
+		return typed, true
+	case bool:
+		return strconv.FormatBool(typed), true
+	case json.Number:
+		return typed.String(), true
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), true
+	case float32:
+		return strconv.FormatFloat(float64(typed), 'f', -1, 32), true
+	case fmt.Stringer:
+		return typed.String(), true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), true
+	case reflect.String:
+		return scalarString(rv.String())
+	}
+	return "", false
+}
+
+func unwrap(value any) any {
+	switch typed := value.(type) {
+	case render.ValueWithProvenance:
+		return typed.Value
+	case *render.ValueWithProvenance:
+		if typed == nil {
+			return nil
+		}
+		return typed.Value
+	}
+	return value
+}
+
+func asMap(value any) map[string]any {
+	switch typed := value.(type) {
+	case map[string]any:
+		return typed
+	case submission.Values:
+		return typed
+	case map[string]string:
+		out := make(map[string]any, len(typed))
+		for key, val := range typed {
+			out[key] = val
+		}
+		return out
+	}
+	return nil
+}
+
+func asSlice(value any) []any {
+	if items, ok := value.([]any); ok {
+		return items
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{value}
+	}
+	out := make([]any, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out
+}
+
+// expandValues nests dotted keys so callers can pass prefill-style maps.
+func expandValues(values map[string]any) map[string]any {
+	out := make(map[string]any, len(values))
+	for key, value := range values {
+		segments := strings.Split(strings.TrimSpace(key), ".")
+		target := out
+		for _, segment := range segments[:len(segments)-1] {
+			next := asMap(target[segment])
+			if next == nil {
+				next = make(map[string]any)
+			} else {
+				copied := make(map[string]any, len(next))
+				for k, v := range next {
+					copied[k] = v
+				}
+				next = copied
+			}
+			target[segment] = next
+			target = next
+		}
+		target[segments[len(segments)-1]] = value
+	}
+	return out
+}
@@ -0,0 +1,358 @@
+// Package formtest runs declarative acceptance cases against a form model:
+// given submitted values, assert which fields are visible, which validation
+// errors the server validator reports, and what the coerced values and
+// filtered enum options are. Spec authors can keep the cases next to the
+// OpenAPI document (see LoadSuite) so x-formgen visibility rules, enum
+// filters, and constraints stay executable.
+package formtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+	"github.com/goliatone/go-formgen/pkg/visibility"
+	"github.com/goliatone/go-formgen/pkg/visibility/expr"
+	"gopkg.in/yaml.v3"
+)
+
+// Case is one scenario. Given holds the submitted values as dotted paths or
+// nested maps; every other field is an expectation and is only checked when
+// set. Field paths use dots for objects and "[]" for array items, e.g.
+// "address.city" or "tags[]".
+type Case struct {
+	Name string `json:"name" yaml:"name"`
+	// Given is parsed like a JSON submission (submission.ParseMap).
+	Given map[string]any `json:"given,omitempty" yaml:"given"`
+	// Extras feeds visibility rules, e.g. roles or feature flags.
+	Extras map[string]any `json:"extras,omitempty" yaml:"extras"`
+	// Visible and Hidden list fields whose visibility rule must pass or fail.
+	Visible []string `json:"visible,omitempty" yaml:"visible"`
+	Hidden  []string `json:"hidden,omitempty" yaml:"hidden"`
+	// Errors maps issue paths (e.g. "email", "tags[1]") to the expected issue
+	// code; an empty code accepts any. When set, issues at other paths fail
+	// the case.
+	Errors map[string]submission.IssueCode `json:"errors,omitempty" yaml:"errors"`
+	// Valid asserts that the submission produces no issues at all.
+	Valid bool `json:"valid,omitempty" yaml:"valid"`
+	// Values compares coerced submission values by dotted path.
+	Values map[string]any `json:"values,omitempty" yaml:"values"`
+	// Options compares the enum options left after enum filters apply.
+	Options map[string][]any `json:"options,omitempty" yaml:"options"`
+}
+
+// Suite groups the cases for one operation, as stored in a cases file.
+type Suite struct {
+	Operation string `json:"operation" yaml:"operation"`
+	Cases     []Case `json:"cases" yaml:"cases"`
+}
+
+// LoadSuite decodes a YAML or JSON cases file.
+func LoadSuite(data []byte) (Suite, error) {
+	var suite Suite
+	if err := yaml.Unmarshal(data, &suite); err != nil {
+		return Suite{}, fmt.Errorf("formtest: decode suite: %w", err)
+	}
+	for i, c := range suite.Cases {
+		if strings.TrimSpace(c.Name) == "" {
+			suite.Cases[i].Name = fmt.Sprintf("case %d", i+1)
+		}
+	}
+	return suite, nil
+}
+
+// Option customises how cases are evaluated.
+type Option func(*config)
+
+type config struct {
+	evaluator  visibility.Evaluator
+	submission []submission.Option
+}
+
+// WithEvaluator replaces the default expression evaluator (package
+// visibility/expr) used for visibility rules.
+func WithEvaluator(evaluator visibility.Evaluator) Option {
+	return func(cfg *config) {
+		if evaluator != nil {
+			cfg.evaluator = evaluator
+		}
+	}
+}
+
+// WithSubmissionOptions forwards options to the submission parser and
+// validator, e.g. submission.WithCurrentValues for transition rules.
+func WithSubmissionOptions(options ...submission.Option) Option {
+	return func(cfg *config) {
+		cfg.submission = append(cfg.submission, options...)
+	}
+}
+
+// Run executes every case as a subtest of t.
+func Run(t *testing.T, form model.FormModel, cases []Case, options ...Option) {
+	t.Helper()
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			for _, failure := range Check(form, c, options...) {
+				t.Error(failure)
+			}
+		})
+	}
+}
+
+// Check evaluates one case and returns a message per unmet expectation, or
+// nil when the case passes. Hidden fields are dropped before validation, so
+// a required field behind a failing visibility rule is not reported.
+func Check(form model.FormModel, c Case, options ...Option) []string {
+	cfg := config{evaluator: expr.New()}
+	for _, opt := range options {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	parsed := submission.ParseMap(form, c.Given, cfg.submission...)
+	ctx := visibility.Context{Values: parsed.Values, Extras: c.Extras}
+
+	var failures []string
+	visible, visibleFields, err := visibleFieldPaths(form.Fields, "", cfg.evaluator, ctx)
+	if err != nil {
+		return []string{fmt.Sprintf("evaluate visibility: %v", err)}
+	}
+	for _, path := range c.Visible {
+		switch shown, known := visible[path]; {
+		case !known:
+			failures = append(failures, fmt.Sprintf("visible: unknown field %q", path))
+		case !shown:
+			failures = append(failures, fmt.Sprintf("expected %q to be visible", path))
+		}
+	}
+	for _, path := range c.Hidden {
+		switch shown, known := visible[path]; {
+		case !known:
+			failures = append(failures, fmt.Sprintf("hidden: unknown field %q", path))
+		case shown:
+			failures = append(failures, fmt.Sprintf("expected %q to be hidden", path))
+		}
+	}
+
+	visibleForm := form
+	visibleForm.Fields = visibleFields
+	issues := append(append([]submission.Issue(nil), parsed.Issues...), submission.Validate(visibleForm, parsed.Values, cfg.submission...)...)
+	if c.Valid && len(issues) > 0 {
+		failures = append(failures, fmt.Sprintf("expected no issues, got %s", formatIssues(issues)))
+	}
+	if c.Errors != nil {
+		failures = append(failures, checkErrors(c.Errors, issues)...)
+	}
+
+	for _, path := range sortedKeys(c.Values) {
+		got, ok := valueAt(parsed.Values, path)
+		if !ok {
+			failures = append(failures, fmt.Sprintf("value %q: missing, want %s", path, encode(c.Values[path])))
+			continue
+		}
+		if encode(got) != encode(c.Values[path]) {
+			failures = append(failures, fmt.Sprintf("value %q: got %s, want %s", path, encode(got), encode(c.Values[path])))
+		}
+	}
+	for _, path := range sortedKeys(c.Options) {
+		field, ok := model.FieldByPath(form.Fields, path)
+		if !ok {
+			failures = append(failures, fmt.Sprintf("options: unknown field %q", path))
+			continue
+		}
+		got, err := enumOptions(field, path, cfg.evaluator, ctx)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("options %q: %v", path, err))
+			continue
+		}
+		if encode(got) != encode(c.Options[path]) {
+			failures = append(failures, fmt.Sprintf("options %q: got %s, want %s", path, encode(got), encode(c.Options[path])))
+		}
+	}
+	return failures
+}
+
+func checkErrors(want map[string]submission.IssueCode, issues []submission.Issue) []string {
+	var failures []string
+	byPath := make(map[string][]submission.Issue)
+	for _, issue := range issues {
+		byPath[issue.Path] = append(byPath[issue.Path], issue)
+	}
+	for _, path := range sortedKeys(want) {
+		found := byPath[path]
+		if len(found) == 0 {
+			failures = append(failures, fmt.Sprintf("expected a %s error at %q, got none", codeLabel(want[path]), path))
+			continue
+		}
+		if want[path] == "" {
+			continue
+		}
+		matched := false
+		for _, issue := range found {
+			matched = matched || issue.Code == want[path]
+		}
+		if !matched {
+			failures = append(failures, fmt.Sprintf("expected a %s error at %q, got %s", want[path], path, formatIssues(found)))
+		}
+	}
+	for _, path := range sortedKeys(byPath) {
+		if _, expected := want[path]; !expected {
+			failures = append(failures, fmt.Sprintf("unexpected error at %q: %s", path, formatIssues(byPath[path])))
+		}
+	}
+	return failures
+}
+
+// visibleFieldPaths walks the model like the orchestrator's visibility pass:
+// it reports every path with whether it is shown and returns the fields that
+// remain visible. Descendants of hidden fields are reported hidden.
+func visibleFieldPaths(fields []model.Field, prefix string, evaluator visibility.Evaluator, ctx visibility.Context) (map[string]bool, []model.Field, error) {
+	paths := make(map[string]bool)
+	kept, err := walkVisible(fields, prefix, true, evaluator, ctx, paths)
+	return paths, kept, err
+}
+
+func walkVisible(fields []model.Field, prefix string, parentShown bool, evaluator visibility.Evaluator, ctx visibility.Context, paths map[string]bool) ([]model.Field, error) {
+	var kept []model.Field
+	for _, field := range fields {
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+		shown, err := fieldShown(field, path, parentShown, evaluator, ctx)
+		if err != nil {
+			return nil, err
+		}
+		paths[path] = shown
+		nested, err := walkVisible(field.Nested, path, shown, evaluator, ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		field.Nested = nested
+		if field.Items != nil {
+			item := *field.Items
+			itemPath := path + "[]"
+			itemShown, err := fieldShown(item, itemPath, shown, evaluator, ctx)
+			if err != nil {
+				return nil, err
+			}
+			paths[itemPath] = itemShown
+			if item.Nested, err = walkVisible(item.Nested, itemPath, itemShown, evaluator, ctx, paths); err != nil {
+				return nil, err
+			}
+			field.Items = &item
+		}
+		if shown {
+			kept = append(kept, field)
+		}
+	}
+	return kept, nil
+}
+
+func fieldShown(field model.Field, path string, parentShown bool, evaluator visibility.Evaluator, ctx visibility.Context) (bool, error) {
+	if !parentShown {
+		return false, nil
+	}
+	rule := visibilityRule(field)
+	if rule == "" {
+		return true, nil
+	}
+	shown, err := evaluator.Eval(path, rule, ctx)
+	if err != nil {
+		return false, fmt.Errorf("field %q: %w", path, err)
+	}
+	return shown, nil
+}
+
+// visibilityRule mirrors the keys the orchestrator reads.
+func visibilityRule(field model.Field) string {
+	for _, candidate := range []string{
+		field.Metadata["visibilityRule"],
+		field.Metadata["admin.visibilityRule"],
+		field.UIHints["visibilityRule"],
+	} {
+		if strings.TrimSpace(candidate) != "" {
+			return candidate
+		}
+	}
+	return ""
+}
+
+func enumOptions(field model.Field, path string, evaluator visibility.Evaluator, ctx visibility.Context) ([]any, error) {
+	options := field.Enum
+	if field.Items != nil && len(options) == 0 {
+		options = field.Items.Enum
+	}
+	filter, ok := model.FieldEnumFilter(field)
+	if !ok {
+		return options, nil
+	}
+	active, err := evaluator.Eval(path, filter.When, ctx)
+	if err != nil || !active {
+		return options, err
+	}
+	allowed := make(map[string]bool, len(filter.Allow))
+	for _, value := range filter.Allow {
+		allowed[encode(value)] = true
+	}
+	filtered := make([]any, 0, len(options))
+	for _, option := range options {
+		if allowed[encode(option)] {
+			filtered = append(filtered, option)
+		}
+	}
+	return filtered, nil
+}
+
+func valueAt(values map[string]any, path string) (any, bool) {
+	var current any = values
+	for _, segment := range strings.Split(path, ".") {
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = obj[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// encode compares values by their JSON form so int, int64, and float64
+// holding the same number are equal.
+func encode(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+func formatIssues(issues []submission.Issue) string {
+	parts := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		parts = append(parts, fmt.Sprintf("%s %s (%s)", issue.Path, issue.Code, issue.Message))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func codeLabel(code submission.IssueCode) string {
+	if code == "" {
+		return "validation"
+	}
+	return string(code)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
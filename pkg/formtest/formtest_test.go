@@ -0,0 +1,97 @@
+package formtest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/formtest"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+func shippingForm() model.FormModel {
+	return model.FormModel{OperationID: "createOrder", Fields: []model.Field{
+		{Name: "region", Type: model.FieldTypeString, Required: true, Enum: []any{"US", "EU"}},
+		{Name: "currency", Type: model.FieldTypeString, Enum: []any{"USD", "EUR", "GBP"}, Metadata: map[string]string{
+			model.EnumFilterMetadataKey: `{"when":"region == 'EU'","allow":["EUR","GBP"]}`,
+		}},
+		{Name: "gift", Type: model.FieldTypeBoolean},
+		{Name: "note", Type: model.FieldTypeObject, Metadata: map[string]string{"visibilityRule": "gift == true"}, Nested: []model.Field{
+			{Name: "message", Type: model.FieldTypeString, Required: true},
+		}},
+		{Name: "quantity", Type: model.FieldTypeInteger},
+	}}
+}
+
+const shippingSuite = `
+operation: createOrder
+cases:
+  - name: eu order narrows currencies
+    given: {region: EU, currency: EUR, quantity: "3"}
+    hidden: [note, note.message]
+    valid: true
+    values: {quantity: 3}
+    options: {currency: [EUR, GBP]}
+  - name: gift note is required once visible
+    given: {region: US, gift: true, note: {}}
+    visible: [note, note.message]
+    errors: {note.message: required}
+    options: {currency: [USD, EUR, GBP]}
+  - given: {region: EU, currency: USD}
+    errors: {currency: enum}
+`
+
+func TestRunSuite(t *testing.T) {
+	suite, err := formtest.LoadSuite([]byte(shippingSuite))
+	if err != nil {
+		t.Fatalf("load suite: %v", err)
+	}
+	if suite.Operation != "createOrder" || len(suite.Cases) != 3 {
+		t.Fatalf("unexpected suite %+v", suite)
+	}
+	if suite.Cases[2].Name != "case 3" {
+		t.Fatalf("expected default case name, got %q", suite.Cases[2].Name)
+	}
+	formtest.Run(t, shippingForm(), suite.Cases)
+}
+
+func TestCheckReportsUnmetExpectations(t *testing.T) {
+	failures := formtest.Check(shippingForm(), formtest.Case{
+		Given:   map[string]any{"region": "EU", "currency": "USD"},
+		Visible: []string{"note", "missing"},
+		Errors:  map[string]submission.IssueCode{"region": submission.CodeRequired},
+		Values:  map[string]any{"currency": "EUR"},
+		Options: map[string][]any{"currency": {"USD"}},
+	})
+
+	want := []string{
+		`visible: unknown field "missing"`,
+		`expected "note" to be visible`,
+		`expected a required error at "region", got none`,
+		`unexpected error at "currency"`,
+		`value "currency": got "USD", want "EUR"`,
+		`options "currency": got ["EUR","GBP"], want ["USD"]`,
+	}
+	if len(failures) != len(want) {
+		t.Fatalf("expected %d failures, got %d: %v", len(want), len(failures), failures)
+	}
+	for _, fragment := range want {
+		found := false
+		for _, failure := range failures {
+			found = found || strings.Contains(failure, fragment)
+		}
+		if !found {
+			t.Errorf("missing failure %q in %v", fragment, failures)
+		}
+	}
+}
+
+func TestCheckSkipsHiddenRequiredFields(t *testing.T) {
+	failures := formtest.Check(shippingForm(), formtest.Case{
+		Given: map[string]any{"region": "US", "gift": false},
+		Valid: true,
+	})
+	if len(failures) != 0 {
+		t.Fatalf("expected hidden note to be ignored, got %v", failures)
+	}
+}
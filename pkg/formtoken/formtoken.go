@@ -0,0 +1,249 @@
+// Package formtoken binds rendered forms to the session that requested them.
+// A Signer issues a signed, expiring token for an operation and session,
+// carried as a hidden field, and verifies it when the form is submitted, so a
+// form captured from one session cannot be replayed from another or after it
+// expires. Tokens may also carry small per-render state (e.g. the record
+// version shown to the user) that the server gets back tamper-proof.
+//
+// The token is base64url(JSON payload) "." base64url(HMAC-SHA256). The MAC
+// covers the payload (operation id, issued-at, state) and the session id; the
+// session id itself is never written into the page.
+package formtoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const (
+	// DefaultFieldName is the hidden input carrying the token.
+	DefaultFieldName = "_formgen_token"
+	// MinKeyLength is the shortest accepted signing key.
+	MinKeyLength = 32
+
+	defaultTTL             = time.Hour
+	defaultMaxBodyBytes    = int64(64 << 20)
+	defaultMultipartMemory = int64(32 << 20)
+)
+
+var (
+	// ErrTokenMissing reports a submission without a token.
+	ErrTokenMissing = errors.New("formtoken: token missing")
+	// ErrTokenInvalid reports a malformed or forged token, or one issued for a
+	// different operation or session.
+	ErrTokenInvalid = errors.New("formtoken: token invalid")
+	// ErrTokenExpired reports a token older than the Signer's TTL.
+	ErrTokenExpired = errors.New("formtoken: token expired")
+)
+
+// Claims are the verified contents of a token.
+type Claims struct {
+	OperationID string
+	IssuedAt    time.Time
+	// State is the per-render state passed to Issue, if any.
+	State map[string]string
+}
+
+type payload struct {
+	OperationID string            `json:"op"`
+	IssuedAt    int64             `json:"iat"`
+	State       map[string]string `json:"st,omitempty"`
+}
+
+// Signer issues and verifies form tokens.
+type Signer struct {
+	key          []byte
+	fieldName    string
+	ttl          time.Duration
+	now          func() time.Time
+	maxBodyBytes int64
+}
+
+// Option customises a Signer.
+type Option func(*Signer)
+
+// WithFieldName overrides the hidden input name.
+func WithFieldName(name string) Option {
+	return func(s *Signer) {
+		if name = strings.TrimSpace(name); name != "" {
+			s.fieldName = name
+		}
+	}
+}
+
+// WithTTL sets how long a token stays valid. Defaults to one hour.
+func WithTTL(ttl time.Duration) Option {
+	return func(s *Signer) {
+		if ttl > 0 {
+			s.ttl = ttl
+		}
+	}
+}
+
+// WithClock overrides the time source used to stamp and expire tokens.
+func WithClock(now func() time.Time) Option {
+	return func(s *Signer) {
+		if now != nil {
+			s.now = now
+		}
+	}
+}
+
+// WithMaxBodyBytes caps the multipart body read by VerifyRequest. Defaults to
+// 64 MiB, matching the submission package.
+func WithMaxBodyBytes(limit int64) Option {
+	return func(s *Signer) {
+		if limit > 0 {
+			s.maxBodyBytes = limit
+		}
+	}
+}
+
+// New constructs a Signer. key must be at least MinKeyLength bytes and should
+// come from a secret store; rotating it invalidates outstanding forms.
+func New(key []byte, options ...Option) (*Signer, error) {
+	if len(key) < MinKeyLength {
+		return nil, fmt.Errorf("formtoken: key must be at least %d bytes", MinKeyLength)
+	}
+	s := &Signer{
+		key:          append([]byte(nil), key...),
+		fieldName:    DefaultFieldName,
+		ttl:          defaultTTL,
+		now:          time.Now,
+		maxBodyBytes: defaultMaxBodyBytes,
+	}
+	for _, opt := range options {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	return s, nil
+}
+
+// FieldName returns the hidden input name the Signer verifies.
+func (s *Signer) FieldName() string {
+	return s.fieldName
+}
+
+// Issue returns a token binding operationID and sessionID, stamped with the
+// current time and carrying state.
+func (s *Signer) Issue(operationID, sessionID string, state map[string]string) (string, error) {
+	data, err := json.Marshal(payload{
+		OperationID: operationID,
+		IssuedAt:    s.now().Unix(),
+		State:       state,
+	})
+	if err != nil {
+		return "", fmt.Errorf("formtoken: encode payload: %w", err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded, sessionID)), nil
+}
+
+// HiddenField issues a token and wraps it as a hidden input, ready for
+// render.RenderOptions.HiddenFields via render.MergeHiddenFields.
+func (s *Signer) HiddenField(operationID, sessionID string, state map[string]string) (render.HiddenField, error) {
+	token, err := s.Issue(operationID, sessionID, state)
+	if err != nil {
+		return render.HiddenField{}, err
+	}
+	return render.Hidden(s.fieldName, token), nil
+}
+
+// Verify checks token against the operation and session handling the
+// submission and returns its claims.
+func (s *Signer) Verify(token, operationID, sessionID string) (Claims, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return Claims{}, ErrTokenMissing
+	}
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return Claims{}, ErrTokenInvalid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.sign(encoded, sessionID)) {
+		return Claims{}, ErrTokenInvalid
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Claims{}, ErrTokenInvalid
+	}
+	var decoded payload
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.OperationID != operationID {
+		return Claims{}, ErrTokenInvalid
+	}
+
+	issuedAt := time.Unix(decoded.IssuedAt, 0)
+	now := s.now()
+	// Allow a little clock skew between replicas for tokens from the future.
+	if now.Sub(issuedAt) > s.ttl || issuedAt.Sub(now) > time.Minute {
+		return Claims{}, ErrTokenExpired
+	}
+	return Claims{OperationID: decoded.OperationID, IssuedAt: issuedAt, State: decoded.State}, nil
+}
+
+// VerifyRequest reads the token from the submitted form body and verifies it.
+// Bodies parsed here stay available to submission.ParseRequest, and an
+// already parsed body (e.g. by submission.ParseRequest) is reused.
+func (s *Signer) VerifyRequest(r *http.Request, operationID, sessionID string) (Claims, error) {
+	if r == nil {
+		return Claims{}, ErrTokenMissing
+	}
+	token, err := s.formToken(r)
+	if err != nil {
+		return Claims{}, err
+	}
+	return s.Verify(token, operationID, sessionID)
+}
+
+func (s *Signer) formToken(r *http.Request) (string, error) {
+	if r.MultipartForm != nil {
+		if values := r.MultipartForm.Value[s.fieldName]; len(values) > 0 {
+			return values[0], nil
+		}
+		return "", nil
+	}
+	if r.PostForm != nil {
+		return r.PostForm.Get(s.fieldName), nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch strings.ToLower(mediaType) {
+	case "multipart/form-data":
+		r.Body = http.MaxBytesReader(nil, r.Body, s.maxBodyBytes)
+		// #nosec G120 -- MaxBytesReader caps the total multipart body before parsing.
+		if err := r.ParseMultipartForm(defaultMultipartMemory); err != nil {
+			return "", fmt.Errorf("formtoken: parse multipart form: %w", err)
+		}
+		if values := r.MultipartForm.Value[s.fieldName]; len(values) > 0 {
+			return values[0], nil
+		}
+		return "", nil
+	case "application/x-www-form-urlencoded", "":
+		if err := r.ParseForm(); err != nil {
+			return "", fmt.Errorf("formtoken: parse form: %w", err)
+		}
+		return r.PostForm.Get(s.fieldName), nil
+	default:
+		return "", nil
+	}
+}
+
+// sign MACs the encoded payload together with the session id. The session id
+// is length-prefixed so payload/session boundaries cannot be shifted.
+func (s *Signer) sign(encoded, sessionID string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%d:%s", len(sessionID), sessionID)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
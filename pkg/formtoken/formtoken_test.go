@@ -0,0 +1,124 @@
+package formtoken_test
+
+import (
+	"bytes"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/formtoken"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+var testKey = bytes.Repeat([]byte("k"), formtoken.MinKeyLength)
+
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func newSigner(t *testing.T, c *clock) *formtoken.Signer {
+	t.Helper()
+	signer, err := formtoken.New(testKey, formtoken.WithClock(c.Now), formtoken.WithTTL(30*time.Minute))
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+	return signer
+}
+
+func TestSignerRejectsShortKeys(t *testing.T) {
+	if _, err := formtoken.New([]byte("short")); err == nil {
+		t.Fatalf("expected short key to be rejected")
+	}
+}
+
+func TestVerifyBindsOperationSessionAndTime(t *testing.T) {
+	c := &clock{now: time.Unix(1_700_000_000, 0)}
+	signer := newSigner(t, c)
+	state := map[string]string{"version": "7"}
+
+	token, err := signer.Issue("createPet", "session-a", state)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if strings.Contains(token, "session-a") {
+		t.Fatalf("session id leaked into token %q", token)
+	}
+
+	claims, err := signer.Verify(token, "createPet", "session-a")
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if claims.OperationID != "createPet" || !claims.IssuedAt.Equal(c.now) || !maps.Equal(claims.State, state) {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+
+	other, err := formtoken.New(bytes.Repeat([]byte("x"), formtoken.MinKeyLength), formtoken.WithClock(c.Now))
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+	encoded, signature, _ := strings.Cut(token, ".")
+	cases := []struct {
+		name      string
+		signer    *formtoken.Signer
+		token     string
+		operation string
+		session   string
+		want      error
+	}{
+		{name: "missing", signer: signer, token: " ", operation: "createPet", session: "session-a", want: formtoken.ErrTokenMissing},
+		{name: "other session", signer: signer, token: token, operation: "createPet", session: "session-b", want: formtoken.ErrTokenInvalid},
+		{name: "other operation", signer: signer, token: token, operation: "deletePet", session: "session-a", want: formtoken.ErrTokenInvalid},
+		{name: "other key", signer: other, token: token, operation: "createPet", session: "session-a", want: formtoken.ErrTokenInvalid},
+		{name: "tampered payload", signer: signer, token: encoded + "x." + signature, operation: "createPet", session: "session-a", want: formtoken.ErrTokenInvalid},
+		{name: "malformed", signer: signer, token: "not-a-token", operation: "createPet", session: "session-a", want: formtoken.ErrTokenInvalid},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.signer.Verify(tc.token, tc.operation, tc.session); !errors.Is(err, tc.want) {
+				t.Fatalf("Verify error = %v, want %v", err, tc.want)
+			}
+		})
+	}
+
+	c.now = c.now.Add(31 * time.Minute)
+	if _, err := signer.Verify(token, "createPet", "session-a"); !errors.Is(err, formtoken.ErrTokenExpired) {
+		t.Fatalf("expected expired token, got %v", err)
+	}
+}
+
+func TestVerifyRequestReadsHiddenField(t *testing.T) {
+	c := &clock{now: time.Now()}
+	signer := newSigner(t, c)
+	field, err := signer.HiddenField("createPet", "session-a", nil)
+	if err != nil {
+		t.Fatalf("hidden field: %v", err)
+	}
+	if field.Name != formtoken.DefaultFieldName || field.Value == "" {
+		t.Fatalf("unexpected hidden field %+v", field)
+	}
+
+	body := url.Values{"name": {"Rex"}, field.Name: {field.Value}}.Encode()
+	req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if _, err := signer.VerifyRequest(req, "createPet", "session-a"); err != nil {
+		t.Fatalf("verify request: %v", err)
+	}
+
+	form := model.FormModel{Fields: []model.Field{{Name: "name", Type: model.FieldTypeString}}}
+	parsed, err := submission.ParseRequest(form, req, submission.WithUnknownFields(submission.UnknownIgnore))
+	if err != nil || parsed.Values["name"] != "Rex" {
+		t.Fatalf("expected body to remain parseable, got %+v (%v)", parsed, err)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader("name=Rex"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if _, err := signer.VerifyRequest(req, "createPet", "session-a"); !errors.Is(err, formtoken.ErrTokenMissing) {
+		t.Fatalf("expected missing token, got %v", err)
+	}
+}
@@ -0,0 +1,486 @@
+// Package httpform serves generated forms over HTTP. A Handler mounted under
+// a prefix (default "/forms/") answers
+//
+//	GET /forms/{operation}       the rendered form, negotiated via Accept
+//	GET /forms/{operation}.json  the form model as JSON
+//	POST /forms/{operation}      Options.Submit, after token verification
+//
+// The schema document is loaded once and cached, responses carry an ETag so
+// browsers and proxies can revalidate cheaply, and hooks let the host
+// authorize requests and add per-request render options (CSRF fields, values,
+// locale) without wrapping the handler. With Options.Tokens, rendered forms
+// carry a session-bound formtoken that is verified before submissions reach
+// the host.
+package httpform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/formtoken"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const (
+	// DefaultPrefix is the path the handler expects to be mounted under.
+	DefaultPrefix = "/forms/"
+	// DefaultCacheControl lets clients cache responses but revalidate them
+	// with the ETag on every use.
+	DefaultCacheControl = "private, no-cache"
+
+	jsonSuffix      = ".json"
+	jsonContentType = "application/json; charset=utf-8"
+)
+
+var (
+	// ErrUnauthorized can be returned by Options.Authorize to answer 401
+	// instead of 403.
+	ErrUnauthorized = errors.New("httpform: unauthorized")
+	// ErrForbidden classifies requests answered 403. Authorize errors that do
+	// not match ErrUnauthorized reach ErrorHandler wrapped with it.
+	ErrForbidden = errors.New("httpform: forbidden")
+	// ErrNotAcceptable reports that no allowed renderer produces a media type
+	// the client accepts.
+	ErrNotAcceptable = errors.New("httpform: no acceptable renderer")
+)
+
+// Options configures a Handler.
+type Options struct {
+	// Source is loaded (and cached) through the orchestrator's loader, so
+	// orchestrator overlays apply. Ignored when Document is set.
+	Source pkgopenapi.Source
+	// Document serves an already loaded document instead of Source.
+	Document *pkgopenapi.Document
+	// DocumentTTL reloads Source once the cached copy is older than the TTL.
+	// Zero caches it until Invalidate is called. A failed reload keeps
+	// serving the previous document.
+	DocumentTTL time.Duration
+	// Prefix is the mount path stripped from request paths. Defaults to
+	// DefaultPrefix.
+	Prefix string
+	// Renderers limits negotiation to these renderer names. Empty offers every
+	// renderer registered on the orchestrator. The orchestrator's default
+	// renderer wins ties (e.g. "Accept: */*"); otherwise the first listed does.
+	Renderers []string
+	// CacheControl is sent with every successful response. Defaults to
+	// DefaultCacheControl.
+	CacheControl string
+	// Authorize runs before anything is loaded or rendered. Returning an error
+	// matching ErrUnauthorized answers 401; any other error answers 403 and is
+	// wrapped with ErrForbidden.
+	Authorize func(r *http.Request, operationID string) error
+	// RenderOptions supplies per-request render options for HTML responses.
+	// Errors answer 500.
+	RenderOptions func(r *http.Request, operationID string) (render.RenderOptions, error)
+	// Tokens binds rendered forms to the requesting session: HTML responses
+	// carry a signed token as a hidden field, and POST requests (or
+	// Handler.Verify) reject a missing, forged, or expired token with
+	// ErrForbidden. Requires SessionID.
+	Tokens *formtoken.Signer
+	// SessionID returns the session tokens are bound to. Errors answer 500.
+	SessionID func(r *http.Request) (string, error)
+	// Submit handles POST /forms/{operation} once Authorize and token
+	// verification pass. Claims are zero when Tokens is nil. Without Submit
+	// only GET and HEAD are allowed.
+	Submit func(w http.ResponseWriter, r *http.Request, operationID string, claims formtoken.Claims)
+	// ErrorHandler writes error responses. The default writes the status text
+	// only, so internal errors are not leaked to clients.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, status int, err error)
+}
+
+// Handler serves rendered forms and form models for one schema document.
+type Handler struct {
+	orch *orchestrator.Orchestrator
+	opts Options
+	now  func() time.Time
+
+	mu       sync.Mutex
+	doc      *pkgopenapi.Document
+	loadedAt time.Time
+	loading  *documentLoad
+}
+
+// documentLoad is an in-flight Source load shared by concurrent requests.
+type documentLoad struct {
+	done chan struct{}
+	doc  *pkgopenapi.Document
+	err  error
+}
+
+// NewHandler constructs a Handler serving forms generated by orch.
+func NewHandler(orch *orchestrator.Orchestrator, opts Options) (*Handler, error) {
+	if orch == nil {
+		return nil, errors.New("httpform: orchestrator is required")
+	}
+	if opts.Document == nil && opts.Source == nil {
+		return nil, errors.New("httpform: Source or Document is required")
+	}
+	if opts.Tokens != nil && opts.SessionID == nil {
+		return nil, errors.New("httpform: SessionID is required with Tokens")
+	}
+	if strings.TrimSpace(opts.Prefix) == "" {
+		opts.Prefix = DefaultPrefix
+	}
+	if !strings.HasSuffix(opts.Prefix, "/") {
+		opts.Prefix += "/"
+	}
+	if opts.CacheControl == "" {
+		opts.CacheControl = DefaultCacheControl
+	}
+	if opts.ErrorHandler == nil {
+		opts.ErrorHandler = defaultErrorHandler
+	}
+	opts.Renderers = slices.Clone(opts.Renderers)
+	return &Handler{orch: orch, opts: opts, now: time.Now}, nil
+}
+
+// Invalidate drops the cached document so the next request reloads Source.
+func (h *Handler) Invalidate() {
+	h.mu.Lock()
+	h.doc = nil
+	h.loading = nil
+	h.mu.Unlock()
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	submit := r.Method == http.MethodPost && h.opts.Submit != nil
+	if r.Method != http.MethodGet && r.Method != http.MethodHead && !submit {
+		allow := "GET, HEAD"
+		if h.opts.Submit != nil {
+			allow += ", POST"
+		}
+		w.Header().Set("Allow", allow)
+		h.opts.ErrorHandler(w, r, http.StatusMethodNotAllowed, nil)
+		return
+	}
+	operationID, wantJSON, ok := h.route(r.URL.Path)
+	if !ok || (submit && wantJSON) {
+		h.opts.ErrorHandler(w, r, http.StatusNotFound, nil)
+		return
+	}
+
+	if h.opts.Authorize != nil {
+		if err := h.opts.Authorize(r, operationID); err != nil {
+			status := http.StatusForbidden
+			switch {
+			case errors.Is(err, ErrUnauthorized):
+				status = http.StatusUnauthorized
+			case !errors.Is(err, ErrForbidden):
+				err = fmt.Errorf("%w: %w", ErrForbidden, err)
+			}
+			h.opts.ErrorHandler(w, r, status, err)
+			return
+		}
+	}
+
+	if submit {
+		h.serveSubmit(w, r, operationID)
+		return
+	}
+
+	doc, err := h.document(r.Context())
+	if err != nil {
+		h.opts.ErrorHandler(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	if wantJSON {
+		h.serveModel(w, r, doc, operationID)
+		return
+	}
+	h.serveForm(w, r, doc, operationID)
+}
+
+func (h *Handler) serveModel(w http.ResponseWriter, r *http.Request, doc *pkgopenapi.Document, operationID string) {
+	form, err := h.orch.BuildFormModel(r.Context(), orchestrator.BuildRequest{Document: doc, OperationID: operationID})
+	if err != nil {
+		h.opts.ErrorHandler(w, r, statusForGenerateError(err), err)
+		return
+	}
+	payload, err := json.Marshal(form)
+	if err != nil {
+		h.opts.ErrorHandler(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.write(w, r, jsonContentType, false, payload)
+}
+
+func (h *Handler) serveForm(w http.ResponseWriter, r *http.Request, doc *pkgopenapi.Document, operationID string) {
+	renderer, err := h.negotiate(r)
+	if err != nil {
+		h.opts.ErrorHandler(w, r, http.StatusNotAcceptable, err)
+		return
+	}
+	var options render.RenderOptions
+	if h.opts.RenderOptions != nil {
+		options, err = h.opts.RenderOptions(r, operationID)
+		if err != nil {
+			h.opts.ErrorHandler(w, r, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	if h.opts.Tokens != nil {
+		sessionID, err := h.opts.SessionID(r)
+		if err != nil {
+			h.opts.ErrorHandler(w, r, http.StatusInternalServerError, err)
+			return
+		}
+		token, err := h.opts.Tokens.HiddenField(operationID, sessionID, nil)
+		if err != nil {
+			h.opts.ErrorHandler(w, r, http.StatusInternalServerError, err)
+			return
+		}
+		options.HiddenFields = render.MergeHiddenFields(options.HiddenFields, token)
+	}
+	output, err := h.orch.Generate(r.Context(), orchestrator.Request{
+		Document:      doc,
+		OperationID:   operationID,
+		Renderer:      renderer.Name(),
+		RenderOptions: options,
+	})
+	if err != nil {
+		h.opts.ErrorHandler(w, r, statusForGenerateError(err), err)
+		return
+	}
+	h.write(w, r, renderer.ContentType(), true, output)
+}
+
+func (h *Handler) serveSubmit(w http.ResponseWriter, r *http.Request, operationID string) {
+	claims, err := h.Verify(r, operationID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrForbidden) {
+			status = http.StatusForbidden
+		}
+		h.opts.ErrorHandler(w, r, status, err)
+		return
+	}
+	h.opts.Submit(w, r, operationID, claims)
+}
+
+// Verify checks the form token submitted with r against operationID and the
+// request's session, for hosts that handle submissions on their own routes.
+// Token failures match ErrForbidden and the formtoken error. It returns zero
+// claims and no error when Options.Tokens is nil.
+func (h *Handler) Verify(r *http.Request, operationID string) (formtoken.Claims, error) {
+	if h.opts.Tokens == nil {
+		return formtoken.Claims{}, nil
+	}
+	sessionID, err := h.opts.SessionID(r)
+	if err != nil {
+		return formtoken.Claims{}, err
+	}
+	claims, err := h.opts.Tokens.VerifyRequest(r, operationID, sessionID)
+	if err != nil {
+		return formtoken.Claims{}, fmt.Errorf("%w: %w", ErrForbidden, err)
+	}
+	return claims, nil
+}
+
+// write sends body with an ETag, answering 304 when the client already has it.
+func (h *Handler) write(w http.ResponseWriter, r *http.Request, contentType string, negotiated bool, body []byte) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	header := w.Header()
+	header.Set("ETag", etag)
+	header.Set("Cache-Control", h.opts.CacheControl)
+	if negotiated {
+		header.Add("Vary", "Accept")
+	}
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(body)
+	}
+}
+
+// route extracts the operation id from a request path. Nested paths are
+// rejected so the handler can share a prefix with other routes.
+func (h *Handler) route(path string) (string, bool, bool) {
+	rest, ok := strings.CutPrefix(path, h.opts.Prefix)
+	if !ok || rest == "" || strings.Contains(rest, "/") {
+		return "", false, false
+	}
+	operationID, wantJSON := strings.CutSuffix(rest, jsonSuffix)
+	if operationID == "" {
+		return "", false, false
+	}
+	return operationID, wantJSON, true
+}
+
+func (h *Handler) document(ctx context.Context) (*pkgopenapi.Document, error) {
+	if h.opts.Document != nil {
+		return h.opts.Document, nil
+	}
+	h.mu.Lock()
+	stale := h.doc
+	if stale != nil && (h.opts.DocumentTTL <= 0 || h.now().Sub(h.loadedAt) < h.opts.DocumentTTL) {
+		h.mu.Unlock()
+		return stale, nil
+	}
+	// Concurrent requests share one load. It runs detached from any single
+	// request, so a cancelled caller only abandons its own wait.
+	load := h.loading
+	if load == nil {
+		load = &documentLoad{done: make(chan struct{})}
+		h.loading = load
+		go h.load(context.WithoutCancel(ctx), load)
+	}
+	h.mu.Unlock()
+
+	select {
+	case <-load.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if load.err != nil {
+		if stale != nil {
+			return stale, nil
+		}
+		return nil, load.err
+	}
+	return load.doc, nil
+}
+
+func (h *Handler) load(ctx context.Context, load *documentLoad) {
+	doc, err := h.orch.LoadDocument(ctx, h.opts.Source)
+	h.mu.Lock()
+	if err == nil {
+		load.doc = &doc
+		// A load started before Invalidate must not repopulate the cache.
+		if h.loading == load {
+			h.doc = load.doc
+			h.loadedAt = h.now()
+		}
+	}
+	load.err = err
+	if h.loading == load {
+		h.loading = nil
+	}
+	h.mu.Unlock()
+	close(load.done)
+}
+
+// negotiate picks the renderer for the request from the Accept header.
+func (h *Handler) negotiate(r *http.Request) (render.Renderer, error) {
+	candidates := h.candidates()
+	if len(candidates) == 0 {
+		return nil, ErrNotAcceptable
+	}
+	accept := strings.TrimSpace(r.Header.Get("Accept"))
+	if accept == "" {
+		return candidates[0], nil
+	}
+	for _, mediaRange := range parseAccept(accept) {
+		for _, renderer := range candidates {
+			if mediaRange.matches(renderer.ContentType()) {
+				return renderer, nil
+			}
+		}
+	}
+	return nil, ErrNotAcceptable
+}
+
+// candidates returns the allowed renderers with the default first.
+func (h *Handler) candidates() []render.Renderer {
+	names := h.opts.Renderers
+	if len(names) == 0 {
+		names = h.orch.RendererNames()
+	}
+	out := make([]render.Renderer, 0, len(names))
+	for _, name := range names {
+		if renderer, err := h.orch.Renderer(name); err == nil {
+			out = append(out, renderer)
+		}
+	}
+	if fallback, err := h.orch.Renderer(""); err == nil {
+		if idx := slices.IndexFunc(out, func(r render.Renderer) bool { return r.Name() == fallback.Name() }); idx > 0 {
+			out[0], out[idx] = out[idx], out[0]
+		}
+	}
+	return out
+}
+
+type acceptRange struct {
+	mediaType string
+	q         float64
+}
+
+func (a acceptRange) matches(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch {
+	case a.mediaType == "*/*", a.mediaType == mediaType:
+		return true
+	case strings.HasSuffix(a.mediaType, "/*"):
+		return strings.HasPrefix(mediaType, strings.TrimSuffix(a.mediaType, "*"))
+	}
+	return false
+}
+
+// parseAccept returns the acceptable ranges ordered by preference; ranges
+// with q=0 are dropped.
+func parseAccept(header string) []acceptRange {
+	var ranges []acceptRange
+	for part := range strings.SplitSeq(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if raw, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(raw, 64); err == nil {
+				q = parsed
+			}
+		}
+		if q > 0 {
+			ranges = append(ranges, acceptRange{mediaType: mediaType, q: q})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+	return ranges
+}
+
+func etagMatches(header, etag string) bool {
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func statusForGenerateError(err error) int {
+	if errors.Is(err, orchestrator.ErrFormNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+func defaultErrorHandler(w http.ResponseWriter, _ *http.Request, status int, _ error) {
+	http.Error(w, http.StatusText(status), status)
+}
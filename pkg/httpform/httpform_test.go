@@ -0,0 +1,382 @@
+package httpform_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/formtoken"
+	"github.com/goliatone/go-formgen/pkg/httpform"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	jsonrenderer "github.com/goliatone/go-formgen/pkg/renderers/json"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+)
+
+const petDocument = `openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+      responses:
+        "201":
+          description: Created
+`
+
+type countingLoader struct {
+	loads atomic.Int32
+	// block, when set, holds every load until it is closed.
+	block chan struct{}
+}
+
+func (l *countingLoader) Load(_ context.Context, src pkgopenapi.Source) (pkgopenapi.Document, error) {
+	l.loads.Add(1)
+	if l.block != nil {
+		<-l.block
+	}
+	return pkgopenapi.NewDocument(src, []byte(petDocument))
+}
+
+func newHandler(t *testing.T, opts httpform.Options) (*httpform.Handler, *countingLoader) {
+	t.Helper()
+	vanillaRenderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("vanilla renderer: %v", err)
+	}
+	registry := render.NewRegistry()
+	registry.MustRegister(vanillaRenderer)
+	registry.MustRegister(jsonrenderer.New())
+
+	loader := &countingLoader{}
+	orch := orchestrator.New(
+		orchestrator.WithLoader(loader),
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithDefaultRenderer("vanilla"),
+		orchestrator.WithUISchemaFS(nil),
+	)
+	if opts.Source == nil && opts.Document == nil {
+		opts.Source = pkgopenapi.SourceFromFile("pets.yaml")
+	}
+	handler, err := httpform.NewHandler(orch, opts)
+	if err != nil {
+		t.Fatalf("new handler: %v", err)
+	}
+	return handler, loader
+}
+
+func serve(handler http.Handler, method, path string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandlerServesFormsAndModels(t *testing.T) {
+	handler, loader := newHandler(t, httpform.Options{
+		RenderOptions: func(_ *http.Request, operationID string) (render.RenderOptions, error) {
+			return render.RenderOptions{HiddenFields: map[string]string{"_op": operationID}}, nil
+		},
+	})
+
+	html := serve(handler, http.MethodGet, "/forms/createPet", nil)
+	if html.Code != http.StatusOK || !strings.HasPrefix(html.Header().Get("Content-Type"), "text/html") {
+		t.Fatalf("html: status %d, content type %q", html.Code, html.Header().Get("Content-Type"))
+	}
+	body := html.Body.String()
+	if !strings.Contains(body, `name="name"`) || !strings.Contains(body, `value="createPet"`) {
+		t.Fatalf("expected rendered form with hook hidden field, got: %s", body)
+	}
+	if html.Header().Get("ETag") == "" || html.Header().Get("Cache-Control") != httpform.DefaultCacheControl {
+		t.Fatalf("expected caching headers, got %v", html.Header())
+	}
+
+	model := serve(handler, http.MethodGet, "/forms/createPet.json", nil)
+	var form struct {
+		OperationID string
+		Fields      []struct{ Name string }
+	}
+	if err := json.Unmarshal(model.Body.Bytes(), &form); err != nil || form.OperationID != "createPet" || len(form.Fields) != 1 {
+		t.Fatalf("model: status %d, body %s (%v)", model.Code, model.Body, err)
+	}
+
+	revalidated := serve(handler, http.MethodGet, "/forms/createPet", map[string]string{"If-None-Match": html.Header().Get("ETag")})
+	if revalidated.Code != http.StatusNotModified || revalidated.Body.Len() != 0 {
+		t.Fatalf("expected 304 for matching ETag, got %d", revalidated.Code)
+	}
+
+	if loads := loader.loads.Load(); loads != 1 {
+		t.Fatalf("expected the document to load once, got %d", loads)
+	}
+	handler.Invalidate()
+	serve(handler, http.MethodGet, "/forms/createPet.json", nil)
+	if loads := loader.loads.Load(); loads != 2 {
+		t.Fatalf("expected Invalidate to force a reload, got %d loads", loads)
+	}
+}
+
+func TestHandlerSharesSlowLoadsAcrossRequests(t *testing.T) {
+	handler, loader := newHandler(t, httpform.Options{})
+	loader.block = make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan int)
+	go func() {
+		req := httptest.NewRequest(http.MethodGet, "/forms/createPet", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		cancelled <- rec.Code
+	}()
+	waiting := make(chan int)
+	go func() {
+		waiting <- serve(handler, http.MethodGet, "/forms/createPet.json", nil).Code
+	}()
+
+	for loader.loads.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	select {
+	case code := <-cancelled:
+		if code != http.StatusInternalServerError {
+			t.Fatalf("cancelled request: status %d, want 500", code)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("cancelled request stayed blocked on the shared load")
+	}
+
+	close(loader.block)
+	if code := <-waiting; code != http.StatusOK {
+		t.Fatalf("waiting request: status %d, want 200", code)
+	}
+	if loads := loader.loads.Load(); loads != 1 {
+		t.Fatalf("expected one shared load, got %d", loads)
+	}
+}
+
+func TestHandlerNegotiatesRenderer(t *testing.T) {
+	handler, _ := newHandler(t, httpform.Options{})
+
+	cases := []struct {
+		accept      string
+		status      int
+		contentType string
+	}{
+		{accept: "", status: http.StatusOK, contentType: "text/html"},
+		{accept: "*/*", status: http.StatusOK, contentType: "text/html"},
+		{accept: "application/json", status: http.StatusOK, contentType: "application/json"},
+		{accept: "text/html;q=0.5, application/json", status: http.StatusOK, contentType: "application/json"},
+		{accept: "text/*, application/json;q=0.9", status: http.StatusOK, contentType: "text/html"},
+		{accept: "image/png", status: http.StatusNotAcceptable},
+	}
+	for _, tc := range cases {
+		rec := serve(handler, http.MethodGet, "/forms/createPet", map[string]string{"Accept": tc.accept})
+		if rec.Code != tc.status {
+			t.Fatalf("Accept %q: status %d, want %d", tc.accept, rec.Code, tc.status)
+		}
+		if tc.contentType != "" && !strings.HasPrefix(rec.Header().Get("Content-Type"), tc.contentType) {
+			t.Fatalf("Accept %q: content type %q, want %s", tc.accept, rec.Header().Get("Content-Type"), tc.contentType)
+		}
+	}
+
+	htmlOnly, _ := newHandler(t, httpform.Options{Renderers: []string{"vanilla"}})
+	if rec := serve(htmlOnly, http.MethodGet, "/forms/createPet", map[string]string{"Accept": "application/json"}); rec.Code != http.StatusNotAcceptable {
+		t.Fatalf("expected restricted renderers to refuse json, got %d", rec.Code)
+	}
+}
+
+func TestHandlerErrorsAndAuthorization(t *testing.T) {
+	handler, loader := newHandler(t, httpform.Options{
+		Authorize: func(r *http.Request, operationID string) error {
+			switch r.Header.Get("Authorization") {
+			case "":
+				return httpform.ErrUnauthorized
+			case "viewer":
+				return errors.New("viewers cannot open " + operationID)
+			}
+			return nil
+		},
+	})
+	admin := map[string]string{"Authorization": "admin"}
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		header map[string]string
+		status int
+	}{
+		{name: "anonymous", method: http.MethodGet, path: "/forms/createPet", status: http.StatusUnauthorized},
+		{name: "forbidden", method: http.MethodGet, path: "/forms/createPet", header: map[string]string{"Authorization": "viewer"}, status: http.StatusForbidden},
+		{name: "unknown operation", method: http.MethodGet, path: "/forms/deletePet", header: admin, status: http.StatusNotFound},
+		{name: "unknown model", method: http.MethodGet, path: "/forms/deletePet.json", header: admin, status: http.StatusNotFound},
+		{name: "nested path", method: http.MethodGet, path: "/forms/createPet/extra", header: admin, status: http.StatusNotFound},
+		{name: "outside prefix", method: http.MethodGet, path: "/other/createPet", header: admin, status: http.StatusNotFound},
+		{name: "post", method: http.MethodPost, path: "/forms/createPet", header: admin, status: http.StatusMethodNotAllowed},
+		{name: "head", method: http.MethodHead, path: "/forms/createPet", header: admin, status: http.StatusOK},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := serve(handler, tc.method, tc.path, tc.header)
+			if rec.Code != tc.status {
+				t.Fatalf("status %d, want %d (%s)", rec.Code, tc.status, rec.Body)
+			}
+			if tc.method == http.MethodHead && rec.Body.Len() != 0 {
+				t.Fatalf("expected HEAD without body")
+			}
+		})
+	}
+	if strings.Contains(serve(handler, http.MethodGet, "/forms/createPet", map[string]string{"Authorization": "viewer"}).Body.String(), "viewers") {
+		t.Fatalf("expected default error handler not to leak error details")
+	}
+	if loads := loader.loads.Load(); loads != 1 {
+		t.Fatalf("expected rejected requests not to load the document, got %d loads", loads)
+	}
+
+	if _, err := httpform.NewHandler(orchestrator.New(), httpform.Options{}); err == nil {
+		t.Fatalf("expected missing source error")
+	}
+}
+
+func TestHandlerClassifiesAuthorizeErrors(t *testing.T) {
+	var got error
+	handler, _ := newHandler(t, httpform.Options{
+		Authorize: func(r *http.Request, _ string) error {
+			if r.Header.Get("Authorization") == "" {
+				return httpform.ErrUnauthorized
+			}
+			return errors.New("role mismatch")
+		},
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, status int, err error) {
+			got = err
+			w.WriteHeader(status)
+		},
+	})
+
+	if rec := serve(handler, http.MethodGet, "/forms/createPet", map[string]string{"Authorization": "viewer"}); rec.Code != http.StatusForbidden {
+		t.Fatalf("status %d, want 403", rec.Code)
+	}
+	if !errors.Is(got, httpform.ErrForbidden) || !strings.Contains(got.Error(), "role mismatch") {
+		t.Fatalf("expected ErrForbidden wrapping the authorize error, got %v", got)
+	}
+
+	serve(handler, http.MethodGet, "/forms/createPet", nil)
+	if !errors.Is(got, httpform.ErrUnauthorized) || errors.Is(got, httpform.ErrForbidden) {
+		t.Fatalf("expected ErrUnauthorized only, got %v", got)
+	}
+}
+
+func TestHandlerVerifiesFormTokens(t *testing.T) {
+	signer, err := formtoken.New([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatalf("signer: %v", err)
+	}
+	var submitted formtoken.Claims
+	var got error
+	handler, _ := newHandler(t, httpform.Options{
+		Tokens: signer,
+		SessionID: func(r *http.Request) (string, error) {
+			return r.Header.Get("X-Session"), nil
+		},
+		Submit: func(w http.ResponseWriter, _ *http.Request, _ string, claims formtoken.Claims) {
+			submitted = claims
+			w.WriteHeader(http.StatusCreated)
+		},
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, status int, err error) {
+			got = err
+			w.WriteHeader(status)
+		},
+	})
+
+	rec := serve(handler, http.MethodGet, "/forms/createPet", map[string]string{"X-Session": "alice"})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("get status %d", rec.Code)
+	}
+	match := regexp.MustCompile(`name="` + signer.FieldName() + `" value="([^"]+)"`).FindStringSubmatch(rec.Body.String())
+	if match == nil {
+		t.Fatalf("expected token hidden field in form:\n%s", rec.Body.String())
+	}
+
+	post := func(session string, form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/forms/createPet", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Session", session)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := post("alice", url.Values{signer.FieldName(): {match[1]}, "name": {"Rex"}}); rec.Code != http.StatusCreated {
+		t.Fatalf("valid token: status %d, err %v", rec.Code, got)
+	}
+	if submitted.OperationID != "createPet" || submitted.IssuedAt.IsZero() {
+		t.Fatalf("unexpected claims %+v", submitted)
+	}
+
+	if rec := post("mallory", url.Values{signer.FieldName(): {match[1]}}); rec.Code != http.StatusForbidden {
+		t.Fatalf("foreign session: status %d, want 403", rec.Code)
+	}
+	if !errors.Is(got, httpform.ErrForbidden) || !errors.Is(got, formtoken.ErrTokenInvalid) {
+		t.Fatalf("expected ErrForbidden wrapping ErrTokenInvalid, got %v", got)
+	}
+	if rec := post("alice", url.Values{"name": {"Rex"}}); rec.Code != http.StatusForbidden {
+		t.Fatalf("missing token: status %d, want 403", rec.Code)
+	}
+	if !errors.Is(got, formtoken.ErrTokenMissing) {
+		t.Fatalf("expected ErrTokenMissing, got %v", got)
+	}
+
+	if rec := serve(handler, http.MethodPut, "/forms/createPet", nil); rec.Code != http.StatusMethodNotAllowed || rec.Header().Get("Allow") != "GET, HEAD, POST" {
+		t.Fatalf("put: status %d, allow %q", rec.Code, rec.Header().Get("Allow"))
+	}
+	if _, err := httpform.NewHandler(orchestrator.New(), httpform.Options{Document: &pkgopenapi.Document{}, Tokens: signer}); err == nil {
+		t.Fatalf("expected error for Tokens without SessionID")
+	}
+}
+
+func TestPreviewEchoReturnsSubmittedValues(t *testing.T) {
+	body := "name=Rex&tags=a&tags=b&_method=patch"
+	req := httptest.NewRequest(http.MethodPost, render.PreviewEndpointPath, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	httpform.PreviewEcho(rec, req)
+
+	var got httpform.PreviewResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v (%s)", err, rec.Body)
+	}
+	if !got.Preview || got.Method != http.MethodPatch || got.Values["name"][0] != "Rex" || len(got.Values["tags"]) != 2 {
+		t.Fatalf("unexpected echo %+v", got)
+	}
+	if rec.Header().Get("Cache-Control") != "no-store" {
+		t.Fatalf("expected echo responses not to be cached")
+	}
+
+	rec = httptest.NewRecorder()
+	httpform.PreviewEcho(rec, httptest.NewRequest(http.MethodDelete, render.PreviewEndpointPath, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,84 @@
+package httpform
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	previewMaxBodyBytes    = int64(10 << 20)
+	previewMultipartMemory = int64(10 << 20)
+)
+
+// PreviewFile describes an uploaded file echoed by PreviewEcho. File contents
+// are never echoed.
+type PreviewFile struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+// PreviewResponse is the JSON body written by PreviewEcho.
+type PreviewResponse struct {
+	Preview bool                     `json:"preview"`
+	Method  string                   `json:"method"`
+	Values  map[string][]string      `json:"values"`
+	Files   map[string][]PreviewFile `json:"files,omitempty"`
+}
+
+// PreviewEcho answers submissions from forms rendered with
+// render.RenderOptions.Preview: it parses the body and echoes the submitted
+// values back as JSON without persisting anything. Mount it at
+// render.PreviewEndpointPath (or the configured PreviewOptions.Endpoint).
+func PreviewEcho(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost && r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	response := PreviewResponse{Preview: true, Method: r.Method, Values: map[string][]string{}}
+	if r.Method == http.MethodGet {
+		response.Values = r.URL.Query()
+	} else {
+		r.Body = http.MaxBytesReader(w, r.Body, previewMaxBodyBytes)
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		var err error
+		if strings.EqualFold(mediaType, "multipart/form-data") {
+			// #nosec G120 -- MaxBytesReader caps the total multipart body before parsing.
+			err = r.ParseMultipartForm(previewMultipartMemory)
+		} else {
+			err = r.ParseForm()
+		}
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		response.Values = r.PostForm
+		if r.MultipartForm != nil {
+			response.Values = r.MultipartForm.Value
+			response.Files = make(map[string][]PreviewFile, len(r.MultipartForm.File))
+			for name, headers := range r.MultipartForm.File {
+				for _, header := range headers {
+					response.Files[name] = append(response.Files[name], PreviewFile{Name: header.Filename, Size: header.Size})
+				}
+			}
+			_ = r.MultipartForm.RemoveAll()
+		}
+	}
+	if override := strings.ToUpper(strings.TrimSpace(firstValue(response.Values["_method"]))); override != "" {
+		response.Method = override
+	}
+
+	w.Header().Set("Content-Type", jsonContentType)
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,237 @@
+// Package importmap builds column-mapping forms for CSV imports. Given the
+// FormModel that describes an import target and the header row of an uploaded
+// file, Form returns a mapping form with one group per target field: a select
+// of source columns and a select of transforms. Parse reads the submitted
+// mapping back, and Mapping.Apply converts CSV rows into nested values shaped
+// like the target form's submissions:
+//
+//	form, err := importmap.Form(target, headers, importmap.WithEndpoint("/imports/42/mapping"))
+//	// render form, then on submit:
+//	parsed := submission.ParseValues(form, r.PostForm)
+//	mapping, err := importmap.Parse(form, parsed.Values)
+//	record, err := mapping.Apply(headers, row)
+//
+// Nested object fields are flattened to dotted paths ("author.email"). Array
+// fields, readonly fields, and objects without declared properties are
+// skipped. Columns whose
+// normalised header matches a field's name or label are preselected.
+package importmap
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	defaultOperationID = "importMapping"
+	defaultMethod      = "POST"
+
+	columnFieldName    = "column"
+	transformFieldName = "transform"
+	pathSeparator      = "__"
+
+	// TargetMetadataKey stores the target field path on each mapping group.
+	TargetMetadataKey = "import.target"
+)
+
+// Option customises the generated mapping form.
+type Option func(*options)
+
+type options struct {
+	endpoint    string
+	operationID string
+	method      string
+	skipLabel   string
+}
+
+// WithEndpoint sets the mapping form action.
+func WithEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.endpoint = strings.TrimSpace(endpoint)
+	}
+}
+
+// WithOperationID sets the mapping form operation id. Defaults to
+// "importMapping".
+func WithOperationID(id string) Option {
+	return func(o *options) {
+		o.operationID = strings.TrimSpace(id)
+	}
+}
+
+// WithMethod sets the HTTP method. Defaults to POST.
+func WithMethod(method string) Option {
+	return func(o *options) {
+		o.method = strings.ToUpper(strings.TrimSpace(method))
+	}
+}
+
+// WithSkipLabel sets the label of the empty "do not import" column option.
+// Defaults to "Skip".
+func WithSkipLabel(label string) Option {
+	return func(o *options) {
+		o.skipLabel = strings.TrimSpace(label)
+	}
+}
+
+// Form builds the mapping form for target and the CSV headers. Each mapped
+// target field becomes an object field named after its path (dots replaced
+// by "__") with nested "column" and "transform" selects. Required target
+// fields require a column.
+func Form(target model.FormModel, headers []string, opts ...Option) (model.FormModel, error) {
+	cfg := options{operationID: defaultOperationID, method: defaultMethod, skipLabel: "Skip"}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	if len(headers) == 0 {
+		return model.FormModel{}, fmt.Errorf("importmap: at least one CSV header is required")
+	}
+
+	columns := make([]model.Option, 0, len(headers)+1)
+	columns = append(columns, model.Option{Value: "", Label: cfg.skipLabel})
+	seen := make(map[string]struct{}, len(headers))
+	for _, header := range headers {
+		header = strings.TrimSpace(header)
+		if header == "" {
+			continue
+		}
+		if _, dup := seen[header]; dup {
+			return model.FormModel{}, fmt.Errorf("importmap: duplicate CSV header %q", header)
+		}
+		seen[header] = struct{}{}
+		columns = append(columns, model.Option{Value: header, Label: header})
+	}
+
+	targets := targetFields(target.Fields, "", "")
+	if len(targets) == 0 {
+		return model.FormModel{}, fmt.Errorf("importmap: target form %q has no importable fields", target.OperationID)
+	}
+
+	fields := make([]model.Field, 0, len(targets))
+	for _, t := range targets {
+		fields = append(fields, mappingField(t, columns, suggestColumn(t, headers)))
+	}
+
+	summary := "Map columns"
+	if title := strings.TrimSpace(target.Summary); title != "" {
+		summary = "Map columns: " + title
+	}
+	return model.FormModel{
+		OperationID: cfg.operationID,
+		Endpoint:    cfg.endpoint,
+		Method:      cfg.method,
+		Summary:     summary,
+		Fields:      fields,
+		UIHints:     map[string]string{"submitLabel": "Import"},
+	}, nil
+}
+
+type targetField struct {
+	path  string
+	label string
+	field model.Field
+}
+
+func targetFields(fields []model.Field, parentPath, parentLabel string) []targetField {
+	var out []targetField
+	for _, field := range fields {
+		if field.Readonly || strings.TrimSpace(field.Name) == "" {
+			continue
+		}
+		path := field.Name
+		if parentPath != "" {
+			path = parentPath + "." + field.Name
+		}
+		label := strings.TrimSpace(field.Label)
+		if label == "" {
+			label = field.Name
+		}
+		if parentLabel != "" {
+			label = parentLabel + " › " + label
+		}
+		switch field.Type {
+		case model.FieldTypeArray:
+			continue
+		case model.FieldTypeObject:
+			if len(field.Nested) > 0 {
+				out = append(out, targetFields(field.Nested, path, label)...)
+			}
+			continue
+		}
+		out = append(out, targetField{path: path, label: label, field: field})
+	}
+	return out
+}
+
+func mappingField(t targetField, columns []model.Option, suggested string) model.Field {
+	transforms := transformsFor(t.field)
+	options := make([]model.Option, len(transforms))
+	for i, transform := range transforms {
+		options[i] = model.Option{Value: string(transform), Label: transform.label()}
+	}
+
+	column := model.Field{
+		Name:     columnFieldName,
+		Label:    "Column",
+		Type:     model.FieldTypeString,
+		Required: t.field.Required,
+		Options:  columns,
+	}
+	if suggested != "" {
+		column.Default = suggested
+	}
+	return model.Field{
+		Name:        fieldName(t.path),
+		Label:       t.label,
+		Description: t.field.Description,
+		Type:        model.FieldTypeObject,
+		Required:    t.field.Required,
+		Nested: []model.Field{
+			column,
+			{
+				Name:    transformFieldName,
+				Label:   "Transform",
+				Type:    model.FieldTypeString,
+				Default: string(transforms[0]),
+				Options: options,
+			},
+		},
+		Metadata: map[string]string{TargetMetadataKey: t.path},
+	}
+}
+
+// suggestColumn preselects the header matching the field's name, path, or
+// label once case, spaces, and punctuation are ignored.
+func suggestColumn(t targetField, headers []string) string {
+	candidates := map[string]struct{}{
+		normalizeHeader(t.field.Name):  {},
+		normalizeHeader(t.path):        {},
+		normalizeHeader(t.field.Label): {},
+	}
+	delete(candidates, "")
+	for _, header := range headers {
+		if _, ok := candidates[normalizeHeader(header)]; ok {
+			return strings.TrimSpace(header)
+		}
+	}
+	return ""
+}
+
+func normalizeHeader(value string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+func fieldName(path string) string {
+	return strings.ReplaceAll(path, ".", pathSeparator)
+}
@@ -0,0 +1,122 @@
+package importmap_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/importmap"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+func importTarget() model.FormModel {
+	return model.FormModel{
+		OperationID: "createContact",
+		Summary:     "Contacts",
+		Fields: []model.Field{
+			{Name: "id", Type: model.FieldTypeString, Readonly: true},
+			{Name: "name", Label: "Full name", Type: model.FieldTypeString, Required: true},
+			{Name: "age", Type: model.FieldTypeInteger},
+			{Name: "active", Type: model.FieldTypeBoolean},
+			{Name: "tags", Type: model.FieldTypeArray, Items: &model.Field{Type: model.FieldTypeString}},
+			{Name: "address", Label: "Address", Type: model.FieldTypeObject, Nested: []model.Field{
+				{Name: "city", Label: "City", Type: model.FieldTypeString},
+			}},
+		},
+	}
+}
+
+func TestFormBuildsMappingGroups(t *testing.T) {
+	headers := []string{"Full Name", "Age", "Is Active", "City"}
+	form, err := importmap.Form(importTarget(), headers, importmap.WithEndpoint("/imports/1"))
+	if err != nil {
+		t.Fatalf("form: %v", err)
+	}
+
+	var names []string
+	for _, field := range form.Fields {
+		names = append(names, field.Name)
+	}
+	if diff := cmp.Diff([]string{"name", "age", "active", "address__city"}, names); diff != "" {
+		t.Fatalf("mapping fields mismatch (-want +got):\n%s", diff)
+	}
+	if form.Endpoint != "/imports/1" || form.Summary != "Map columns: Contacts" {
+		t.Fatalf("unexpected form header %+v", form)
+	}
+
+	name := form.Fields[0]
+	if !name.Required || !name.Nested[0].Required || name.Nested[0].Default != "Full Name" {
+		t.Fatalf("expected required name group with suggested column, got %+v", name)
+	}
+	if len(name.Nested[0].Options) != len(headers)+1 {
+		t.Fatalf("expected skip option plus headers, got %+v", name.Nested[0].Options)
+	}
+	if form.Fields[1].Nested[1].Default != string(importmap.TransformInteger) {
+		t.Fatalf("expected integer transform for age, got %+v", form.Fields[1].Nested[1])
+	}
+	if form.Fields[3].Label != "Address › City" || form.Fields[3].Nested[0].Default != "City" {
+		t.Fatalf("expected nested group label and suggestion, got %+v", form.Fields[3])
+	}
+
+	if _, err := importmap.Form(importTarget(), []string{"a", "a"}); err == nil {
+		t.Fatalf("expected duplicate header error")
+	}
+}
+
+func TestParseAndApplyMapping(t *testing.T) {
+	headers := []string{"Full Name", "Age", "Is Active", "City"}
+	form, err := importmap.Form(importTarget(), headers)
+	if err != nil {
+		t.Fatalf("form: %v", err)
+	}
+
+	parsed := submission.ParseValues(form, url.Values{
+		"name.column":             {"Full Name"},
+		"name.transform":          {"upper"},
+		"age.column":              {"Age"},
+		"age.transform":           {"integer"},
+		"active.column":           {"Is Active"},
+		"active.transform":        {"boolean"},
+		"address__city.column":    {"City"},
+		"address__city.transform": {"trim"},
+	})
+	if len(parsed.Issues) > 0 {
+		t.Fatalf("parse values: %+v", parsed.Issues)
+	}
+	mapping, err := importmap.Parse(form, parsed.Values)
+	if err != nil {
+		t.Fatalf("parse mapping: %v", err)
+	}
+
+	record, err := mapping.Apply(headers, []string{"ada lovelace", "36", "yes", " London "})
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	want := map[string]any{
+		"name":    "ADA LOVELACE",
+		"age":     int64(36),
+		"active":  true,
+		"address": map[string]any{"city": "London"},
+	}
+	if diff := cmp.Diff(want, record); diff != "" {
+		t.Fatalf("record mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := mapping.Apply(headers, []string{"Ada", "old", "", ""}); err == nil || !strings.Contains(err.Error(), `column "Age"`) {
+		t.Fatalf("expected transform error for Age, got %v", err)
+	}
+
+	if _, err := importmap.Parse(form, map[string]any{}); err == nil {
+		t.Fatalf("expected missing required column error")
+	}
+	duplicate := map[string]any{
+		"name": map[string]any{"column": "City"},
+		"age":  map[string]any{"column": "City", "transform": "integer"},
+	}
+	if _, err := importmap.Parse(form, duplicate); err == nil {
+		t.Fatalf("expected duplicate column error")
+	}
+}
@@ -0,0 +1,223 @@
+package importmap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// Transform converts a raw CSV cell before it is stored in a record.
+type Transform string
+
+const (
+	// TransformTrim trims surrounding whitespace. It is the default for string
+	// fields.
+	TransformTrim Transform = "trim"
+	// TransformNone keeps the cell as-is.
+	TransformNone Transform = "none"
+	// TransformLower trims and lowercases the cell.
+	TransformLower Transform = "lower"
+	// TransformUpper trims and uppercases the cell.
+	TransformUpper Transform = "upper"
+	// TransformInteger parses the cell as an int64.
+	TransformInteger Transform = "integer"
+	// TransformNumber parses the cell as a float64.
+	TransformNumber Transform = "number"
+	// TransformBoolean parses true/false, yes/no, y/n, on/off, and 1/0.
+	TransformBoolean Transform = "boolean"
+	// TransformDate parses common date layouts into YYYY-MM-DD.
+	TransformDate Transform = "date"
+)
+
+var dateLayouts = []string{"2006-01-02", time.RFC3339, "2006/01/02", "02/01/2006", "2 Jan 2006", "Jan 2, 2006"}
+
+func (t Transform) label() string {
+	switch t {
+	case TransformTrim:
+		return "Trim whitespace"
+	case TransformNone:
+		return "Keep as-is"
+	case TransformLower:
+		return "Lowercase"
+	case TransformUpper:
+		return "Uppercase"
+	case TransformInteger:
+		return "Integer"
+	case TransformNumber:
+		return "Number"
+	case TransformBoolean:
+		return "Yes/No"
+	case TransformDate:
+		return "Date"
+	}
+	return string(t)
+}
+
+// transformsFor lists the transforms offered for a target field, default
+// first.
+func transformsFor(field model.Field) []Transform {
+	switch field.Type {
+	case model.FieldTypeInteger:
+		return []Transform{TransformInteger}
+	case model.FieldTypeNumber:
+		return []Transform{TransformNumber}
+	case model.FieldTypeBoolean:
+		return []Transform{TransformBoolean}
+	}
+	if field.Format == "date" || field.Format == "date-time" {
+		return []Transform{TransformDate, TransformTrim, TransformNone}
+	}
+	return []Transform{TransformTrim, TransformNone, TransformLower, TransformUpper}
+}
+
+// Apply converts a single cell.
+func (t Transform) Apply(cell string) (any, error) {
+	switch t {
+	case TransformNone:
+		return cell, nil
+	case TransformLower:
+		return strings.ToLower(strings.TrimSpace(cell)), nil
+	case TransformUpper:
+		return strings.ToUpper(strings.TrimSpace(cell)), nil
+	case TransformInteger:
+		return strconv.ParseInt(strings.TrimSpace(cell), 10, 64)
+	case TransformNumber:
+		return strconv.ParseFloat(strings.TrimSpace(cell), 64)
+	case TransformBoolean:
+		switch strings.ToLower(strings.TrimSpace(cell)) {
+		case "true", "yes", "y", "on", "1":
+			return true, nil
+		case "false", "no", "n", "off", "0":
+			return false, nil
+		}
+		return nil, fmt.Errorf("invalid boolean %q", cell)
+	case TransformDate:
+		text := strings.TrimSpace(cell)
+		for _, layout := range dateLayouts {
+			if parsed, err := time.Parse(layout, text); err == nil {
+				return parsed.Format("2006-01-02"), nil
+			}
+		}
+		return nil, fmt.Errorf("invalid date %q", cell)
+	default:
+		return strings.TrimSpace(cell), nil
+	}
+}
+
+// Column maps one source column to a target field path.
+type Column struct {
+	Path      string
+	Column    string
+	Transform Transform
+}
+
+// Mapping is the parsed result of a submitted mapping form.
+type Mapping struct {
+	Columns []Column
+}
+
+// Parse reads submitted mapping values (as decoded by submission.ParseValues
+// against the mapping form) into a Mapping. Groups left on "Skip" are
+// omitted; a required target without a column, or a column mapped twice, is
+// an error.
+func Parse(form model.FormModel, values map[string]any) (Mapping, error) {
+	var mapping Mapping
+	used := make(map[string]string)
+	for _, field := range form.Fields {
+		path := strings.TrimSpace(field.Metadata[TargetMetadataKey])
+		if path == "" {
+			continue
+		}
+		group, _ := values[field.Name].(map[string]any)
+		column, _ := group[columnFieldName].(string)
+		column = strings.TrimSpace(column)
+		if column == "" {
+			if field.Required {
+				return Mapping{}, fmt.Errorf("importmap: %s requires a column", path)
+			}
+			continue
+		}
+		if other, dup := used[column]; dup {
+			return Mapping{}, fmt.Errorf("importmap: column %q mapped to both %s and %s", column, other, path)
+		}
+		used[column] = path
+
+		raw, _ := group[transformFieldName].(string)
+		transform := Transform(strings.TrimSpace(raw))
+		if transform == "" {
+			transform = defaultTransform(field)
+		}
+		if !allowedTransform(field, transform) {
+			return Mapping{}, fmt.Errorf("importmap: transform %q is not available for %s", transform, path)
+		}
+		mapping.Columns = append(mapping.Columns, Column{Path: path, Column: column, Transform: transform})
+	}
+	return mapping, nil
+}
+
+func defaultTransform(field model.Field) Transform {
+	for _, nested := range field.Nested {
+		if nested.Name == transformFieldName {
+			raw, _ := nested.Default.(string)
+			return Transform(raw)
+		}
+	}
+	return TransformTrim
+}
+
+func allowedTransform(field model.Field, transform Transform) bool {
+	for _, nested := range field.Nested {
+		if nested.Name != transformFieldName {
+			continue
+		}
+		for _, option := range nested.Options {
+			if fmt.Sprint(option.Value) == string(transform) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Apply converts one CSV row into nested values keyed like the target form
+// ("author.email" becomes {"author": {"email": ...}}). headers must be the
+// header row the row belongs to. Empty cells are left out.
+func (m Mapping) Apply(headers []string, row []string) (map[string]any, error) {
+	index := make(map[string]int, len(headers))
+	for i, header := range headers {
+		index[strings.TrimSpace(header)] = i
+	}
+
+	record := make(map[string]any)
+	for _, column := range m.Columns {
+		pos, ok := index[column.Column]
+		if !ok {
+			return nil, fmt.Errorf("importmap: column %q not found in headers", column.Column)
+		}
+		if pos >= len(row) || strings.TrimSpace(row[pos]) == "" {
+			continue
+		}
+		value, err := column.Transform.Apply(row[pos])
+		if err != nil {
+			return nil, fmt.Errorf("importmap: column %q: %w", column.Column, err)
+		}
+		setPath(record, column.Path, value)
+	}
+	return record, nil
+}
+
+func setPath(target map[string]any, path string, value any) {
+	segments := strings.Split(path, ".")
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := target[segment].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			target[segment] = next
+		}
+		target = next
+	}
+	target[segments[len(segments)-1]] = value
+}
@@ -98,6 +98,7 @@ func schemaFromJSONSchemaWithContext(node any, path string, ctx normalizeContext
 		Description: strings.TrimSpace(readString(payload, "description")),
 		Default:     payload["default"],
 		ReadOnly:    readOnly,
+		Nullable:    hasNullableSchema(payload),
 		Const:       payload["const"],
 		Format:      strings.TrimSpace(readString(payload, "format")),
 		Extensions:  extensions,
@@ -0,0 +1,223 @@
+// Package live broadcasts form model version changes to browsers so admin
+// pages can re-fetch rendered forms when an OpenAPI document or UI schema
+// changes. A Broker fans version events out to Server-Sent Events clients and
+// a Watcher publishes events when watched file systems change.
+package live
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// EventName is the SSE event type used for version events.
+	EventName = "formgen-version"
+	// FormParam filters the event stream to the listed operation ids. It may be
+	// repeated; events without Forms are always delivered.
+	FormParam = "form"
+
+	defaultHeartbeat = 25 * time.Second
+)
+
+// Event announces a new form model version.
+type Event struct {
+	// Version identifies the model revision, e.g. a content fingerprint.
+	Version string `json:"version"`
+	// Forms lists the affected operation ids. Empty means every form.
+	Forms []string `json:"forms,omitempty"`
+	// Source describes what changed (e.g. "openapi", "uischema").
+	Source    string    `json:"source,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Matches reports whether the event applies to any of the given operation
+// ids. An empty filter or an event without Forms always matches.
+func (e Event) Matches(forms []string) bool {
+	if len(forms) == 0 || len(e.Forms) == 0 {
+		return true
+	}
+	for _, form := range forms {
+		if slices.Contains(e.Forms, form) {
+			return true
+		}
+	}
+	return false
+}
+
+// Broker keeps the latest Event and delivers new ones to subscribers. It
+// implements http.Handler, serving the stream as Server-Sent Events.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[chan Event][]string
+	current     Event
+	hasCurrent  bool
+
+	heartbeat time.Duration
+	now       func() time.Time
+}
+
+// Option customises a Broker.
+type Option func(*Broker)
+
+// WithHeartbeat sets how often idle streams receive a keep-alive comment.
+// Non-positive values disable heartbeats. Defaults to 25s.
+func WithHeartbeat(interval time.Duration) Option {
+	return func(b *Broker) {
+		b.heartbeat = interval
+	}
+}
+
+// WithClock overrides the time source used to stamp events.
+func WithClock(now func() time.Time) Option {
+	return func(b *Broker) {
+		if now != nil {
+			b.now = now
+		}
+	}
+}
+
+// NewBroker constructs an empty Broker.
+func NewBroker(options ...Option) *Broker {
+	broker := &Broker{
+		subscribers: make(map[chan Event][]string),
+		heartbeat:   defaultHeartbeat,
+		now:         time.Now,
+	}
+	for _, opt := range options {
+		if opt != nil {
+			opt(broker)
+		}
+	}
+	return broker
+}
+
+// Publish records event as the current version and delivers it to every
+// subscriber whose filter it matches. Slow subscribers only ever see the
+// latest matching event. The stored event (with Timestamp filled in) is
+// returned.
+func (b *Broker) Publish(event Event) Event {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = b.now()
+	}
+	event.Forms = slices.Clone(event.Forms)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.current = event
+	b.hasCurrent = true
+	for ch, forms := range b.subscribers {
+		if !event.Matches(forms) {
+			continue
+		}
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- event
+	}
+	return event
+}
+
+// Current returns the most recently published event.
+func (b *Broker) Current() (Event, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.current, b.hasCurrent
+}
+
+// Subscribe registers a listener for events matching forms (every event when
+// empty). The returned cancel function must be called to release it.
+func (b *Broker) Subscribe(forms ...string) (<-chan Event, func()) {
+	ch := make(chan Event, 1)
+	b.mu.Lock()
+	b.subscribers[ch] = slices.Clone(forms)
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+		})
+	}
+}
+
+// ServeHTTP streams version events as Server-Sent Events. The current event
+// is sent immediately so reconnecting pages can detect missed updates.
+func (b *Broker) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "live: streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	forms := formFilter(req)
+
+	events, cancel := b.Subscribe(forms...)
+	defer cancel()
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if current, ok := b.Current(); ok && current.Matches(forms) {
+		if err := writeEvent(w, current); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	var heartbeat <-chan time.Time
+	if b.heartbeat > 0 {
+		ticker := time.NewTicker(b.heartbeat)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case event := <-events:
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-heartbeat:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func formFilter(req *http.Request) []string {
+	var forms []string
+	for _, value := range req.URL.Query()[FormParam] {
+		for part := range strings.SplitSeq(value, ",") {
+			if trimmed := strings.TrimSpace(part); trimmed != "" {
+				forms = append(forms, trimmed)
+			}
+		}
+	}
+	return forms
+}
+
+func writeEvent(w http.ResponseWriter, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("live: marshal event: %w", err)
+	}
+	id := strings.NewReplacer("\n", "", "\r", "").Replace(event.Version)
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", id, EventName, payload)
+	return err
+}
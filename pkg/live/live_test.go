@@ -0,0 +1,143 @@
+package live_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/live"
+)
+
+func TestBroker_PublishDeliversLatestEvent(t *testing.T) {
+	broker := live.NewBroker()
+	events, cancel := broker.Subscribe()
+	defer cancel()
+
+	broker.Publish(live.Event{Version: "v1"})
+	broker.Publish(live.Event{Version: "v2", Forms: []string{"createPet"}})
+
+	got := <-events
+	if got.Version != "v2" {
+		t.Fatalf("expected latest event, got %q", got.Version)
+	}
+	if got.Timestamp.IsZero() {
+		t.Fatalf("expected timestamp to be set")
+	}
+	if current, ok := broker.Current(); !ok || current.Version != "v2" {
+		t.Fatalf("unexpected current event: %#v", current)
+	}
+	if !got.Matches([]string{"createPet"}) || got.Matches([]string{"updatePet"}) {
+		t.Fatalf("unexpected form matching for %#v", got.Forms)
+	}
+}
+
+func TestBroker_PublishKeepsPendingMatchingEvent(t *testing.T) {
+	broker := live.NewBroker()
+	events, cancel := broker.Subscribe("formA")
+	defer cancel()
+
+	broker.Publish(live.Event{Version: "v1", Forms: []string{"formA"}})
+	broker.Publish(live.Event{Version: "v2", Forms: []string{"formB"}})
+
+	select {
+	case got := <-events:
+		if got.Version != "v1" {
+			t.Fatalf("expected the formA event, got %#v", got)
+		}
+	default:
+		t.Fatal("expected the pending formA event to survive a formB publish")
+	}
+}
+
+func TestBroker_ServeHTTPStreamsEvents(t *testing.T) {
+	broker := live.NewBroker(live.WithHeartbeat(0))
+	broker.Publish(live.Event{Version: "v1"})
+
+	server := httptest.NewServer(broker)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?form=createPet", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer resp.Body.Close()
+	if got := resp.Header.Get("Content-Type"); got != "text/event-stream" {
+		t.Fatalf("unexpected content type %q", got)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	if event := readEvent(t, reader); event.Version != "v1" {
+		t.Fatalf("expected current event first, got %#v", event)
+	}
+
+	broker.Publish(live.Event{Version: "v2", Forms: []string{"updatePet"}})
+	broker.Publish(live.Event{Version: "v3", Forms: []string{"createPet"}})
+	if event := readEvent(t, reader); event.Version != "v3" {
+		t.Fatalf("expected filtered event v3, got %#v", event)
+	}
+}
+
+func readEvent(t *testing.T, reader *bufio.Reader) live.Event {
+	t.Helper()
+	var name, data string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("read stream: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "" && data != "":
+			if name != live.EventName {
+				t.Fatalf("unexpected event name %q", name)
+			}
+			var event live.Event
+			if err := json.Unmarshal([]byte(data), &event); err != nil {
+				t.Fatalf("decode event: %v", err)
+			}
+			return event
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestWatcher_PublishesOnChange(t *testing.T) {
+	broker := live.NewBroker()
+	uiSchema := fstest.MapFS{"pets.yaml": {Data: []byte("operations: {}")}}
+	watcher := live.NewWatcher(broker, time.Millisecond).Add("uischema", uiSchema)
+
+	published, err := watcher.Check()
+	if err != nil || !published {
+		t.Fatalf("expected baseline event, published=%v err=%v", published, err)
+	}
+	baseline, _ := broker.Current()
+
+	if published, _ := watcher.Check(); published {
+		t.Fatalf("expected no event without changes")
+	}
+
+	uiSchema["pets.yaml"] = &fstest.MapFile{Data: []byte("operations: {createPet: {}}")}
+	published, err = watcher.Check()
+	if err != nil || !published {
+		t.Fatalf("expected change event, published=%v err=%v", published, err)
+	}
+	changed, _ := broker.Current()
+	if changed.Version == baseline.Version || changed.Source != "uischema" {
+		t.Fatalf("unexpected change event: %#v (baseline %q)", changed, baseline.Version)
+	}
+}
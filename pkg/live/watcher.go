@@ -0,0 +1,150 @@
+package live
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultPollInterval = time.Second
+
+// Watcher polls file systems holding OpenAPI documents or UI schema files and
+// publishes an Event to its Broker whenever their content changes. Polling
+// keeps the package dependency free and works with any fs.FS.
+type Watcher struct {
+	broker   *Broker
+	interval time.Duration
+
+	mu      sync.Mutex
+	sources []watchSource
+	prints  map[string]string
+	checked bool
+}
+
+type watchSource struct {
+	name string
+	fsys fs.FS
+}
+
+// NewWatcher constructs a Watcher publishing to broker. Non-positive
+// intervals default to one second.
+func NewWatcher(broker *Broker, interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	return &Watcher{
+		broker:   broker,
+		interval: interval,
+		prints:   make(map[string]string),
+	}
+}
+
+// Add registers a file system under name (used as the event Source). It
+// returns the watcher for chaining.
+func (w *Watcher) Add(name string, fsys fs.FS) *Watcher {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.sources = append(w.sources, watchSource{name: strings.TrimSpace(name), fsys: fsys})
+	return w
+}
+
+// Check fingerprints every source once. The first call establishes the
+// baseline and publishes the initial version; later calls publish only when
+// something changed. It reports whether an event was published.
+func (w *Watcher) Check() (bool, error) {
+	if w == nil || w.broker == nil {
+		return false, fmt.Errorf("live: watcher requires a broker")
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var changed []string
+	prints := make(map[string]string, len(w.sources))
+	for _, source := range w.sources {
+		digest, err := Fingerprint(source.fsys)
+		if err != nil {
+			return false, fmt.Errorf("live: fingerprint %s: %w", source.name, err)
+		}
+		prints[source.name] = digest
+		if previous, ok := w.prints[source.name]; !ok || previous != digest {
+			changed = append(changed, source.name)
+		}
+	}
+	if w.checked && len(changed) == 0 {
+		return false, nil
+	}
+	w.prints = prints
+	w.checked = true
+
+	w.broker.Publish(Event{
+		Version: combineFingerprints(prints),
+		Source:  strings.Join(changed, ","),
+	})
+	return true, nil
+}
+
+// Run polls until ctx is cancelled. Errors from individual checks (such as a
+// file being rewritten mid-read) are skipped and retried on the next tick;
+// only the initial check's error is returned.
+func (w *Watcher) Run(ctx context.Context) error {
+	if _, err := w.Check(); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			_, _ = w.Check()
+		}
+	}
+}
+
+// Fingerprint hashes the paths and contents of every regular file in fsys.
+func Fingerprint(fsys fs.FS) (string, error) {
+	if fsys == nil {
+		return "", fmt.Errorf("live: file system is nil")
+	}
+	hash := sha256.New()
+	err := fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(hash, "%s\x00%d\x00", path, len(data))
+		hash.Write(data)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func combineFingerprints(prints map[string]string) string {
+	names := make([]string, 0, len(prints))
+	for name := range prints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	hash := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(hash, "%s\x00%s\x00", name, prints[name])
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
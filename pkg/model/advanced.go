@@ -0,0 +1,57 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// AdvancedHintKey is the UI hint that marks a field, or a section in the
+// layout.sections metadata, as advanced. Renderers tuck advanced inputs away
+// behind a single opt-in instead of showing them by default.
+const AdvancedHintKey = "advanced"
+
+// AdvancedSections returns the ids of the sections whose uiHints mark them as
+// advanced. It returns nil when the form declares none.
+func AdvancedSections(form FormModel) map[string]bool {
+	raw := strings.TrimSpace(form.Metadata[layoutSectionsKey])
+	if raw == "" {
+		return nil
+	}
+	var sections []struct {
+		ID      string            `json:"id"`
+		UIHints map[string]string `json:"uiHints"`
+	}
+	if err := json.Unmarshal([]byte(raw), &sections); err != nil {
+		return nil
+	}
+	var advanced map[string]bool
+	for _, section := range sections {
+		id := strings.TrimSpace(section.ID)
+		if id == "" || !truthyHint(section.UIHints[AdvancedHintKey]) {
+			continue
+		}
+		if advanced == nil {
+			advanced = make(map[string]bool)
+		}
+		advanced[id] = true
+	}
+	return advanced
+}
+
+// IsAdvancedField reports whether field carries the advanced hint or belongs
+// to one of the advanced sections returned by AdvancedSections.
+func IsAdvancedField(field Field, sections map[string]bool) bool {
+	if truthyHint(field.UIHints[AdvancedHintKey]) {
+		return true
+	}
+	section := strings.TrimSpace(field.Metadata[layoutSectionFieldKey])
+	return section != "" && sections[section]
+}
+
+func truthyHint(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "1", "yes", "on":
+		return true
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"context"
 	"errors"
 
 	internalmodel "github.com/goliatone/go-formgen/internal/model"
@@ -14,12 +15,33 @@ type Builder interface {
 	Decorate(form *FormModel) error
 }
 
+// SiblingAwareBuilder is implemented by builders that can use the other forms
+// of the same document while building one of them. The orchestrator prefers it
+// over Build when available.
+type SiblingAwareBuilder interface {
+	BuildWithSiblings(form schema.Form, siblings []schema.Form) (FormModel, error)
+}
+
+// ContextBuilder is implemented by builders that stop once the request
+// context is canceled or its deadline passes. The orchestrator prefers it
+// over Build and BuildWithSiblings when available; siblings may be nil.
+type ContextBuilder interface {
+	BuildContext(ctx context.Context, form schema.Form, siblings []schema.Form) (FormModel, error)
+}
+
 // BuilderOption configures the builder behaviour.
 type BuilderOption func(*builderOptions)
 
 type builderOptions struct {
 	labeler    func(string) string
 	decorators []Decorator
+	namespaces []string
+	inference  *RelationshipConventions
+	siblings   bool
+	sections   bool
+	parameters bool
+	enums      EnumDisplay
+	enumsMax   int
 }
 
 // WithLabeler overrides the default label generation function.
@@ -29,6 +51,67 @@ func WithLabeler(labeler func(string) string) BuilderOption {
 	}
 }
 
+// WithExtensionNamespace reads UI extensions from the supplied vendor prefixes
+// (e.g. "x-acme-ui") in addition to x-formgen. Namespaces are listed highest
+// precedence first; x-formgen remains the lowest-precedence fallback unless it
+// is listed explicitly. Repeated calls append.
+func WithExtensionNamespace(namespaces ...string) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.namespaces = append(opts.namespaces, namespaces...)
+	}
+}
+
+// WithRelationshipInference promotes foreign-key style string fields (e.g.
+// "author_id") to belongsTo relationships with a conventional options endpoint
+// when the schema lacks relationship extensions. Zero-valued convention fields
+// fall back to DefaultRelationshipConventions.
+func WithRelationshipInference(conventions RelationshipConventions) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.inference = &conventions
+	}
+}
+
+// WithSiblingEndpointDerivation derives relationship endpoint URLs (and
+// resultsPath for enveloped responses) from GET collection operations in the
+// same document that return the relationship target schema. Explicit
+// x-endpoint metadata always wins.
+func WithSiblingEndpointDerivation(enabled bool) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.siblings = enabled
+	}
+}
+
+// WithTitledSections lifts top-level nested objects whose schema has a title
+// (e.g. "Shipping address") into layout sections rendered without an inner
+// fieldset. UI schema sections replace these implicit ones.
+func WithTitledSections(enabled bool) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.sections = enabled
+	}
+}
+
+// WithParameterFields appends the operation's path, query, and header
+// parameters (e.g. petId in PUT /pets/{petId}) as fields tagged with
+// Field.Location. Body properties win on name clashes. HTML renderers
+// interpolate path values into the form action instead of rendering controls.
+func WithParameterFields(enabled bool) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.parameters = enabled
+	}
+}
+
+// WithEnumDisplay sets the default enumDisplay hint for enum fields that do
+// not declare one in their schema extensions. With EnumDisplayAuto, enums of
+// at most maxOptions choices (DefaultEnumDisplayMax when maxOptions <= 0)
+// render as radio or checkbox groups and larger ones as selects. UI schema
+// uiHints still override the default.
+func WithEnumDisplay(display EnumDisplay, maxOptions int) BuilderOption {
+	return func(opts *builderOptions) {
+		opts.enums = display
+		opts.enumsMax = maxOptions
+	}
+}
+
 // WithDecorators registers decorators that should run when Decorate is called.
 func WithDecorators(decorators ...Decorator) BuilderOption {
 	return func(opts *builderOptions) {
@@ -53,6 +136,13 @@ func NewBuilder(options ...BuilderOption) Builder {
 	if cfg.labeler != nil {
 		internalOpts.Labeler = cfg.labeler
 	}
+	internalOpts.ExtensionNamespaces = cfg.namespaces
+	internalOpts.RelationshipInference = cfg.inference
+	internalOpts.DeriveSiblingEndpoints = cfg.siblings
+	internalOpts.TitledSections = cfg.sections
+	internalOpts.ParameterFields = cfg.parameters
+	internalOpts.EnumDisplay = cfg.enums
+	internalOpts.EnumDisplayMax = cfg.enumsMax
 
 	return &builder{
 		delegate:   internalmodel.New(internalOpts),
@@ -72,6 +162,20 @@ func (b *builder) Build(form schema.Form) (FormModel, error) {
 	return b.delegate.Build(form)
 }
 
+func (b *builder) BuildWithSiblings(form schema.Form, siblings []schema.Form) (FormModel, error) {
+	if b == nil || b.delegate == nil {
+		return FormModel{}, errors.New("model: builder delegate is nil")
+	}
+	return b.delegate.BuildWithSiblings(form, siblings)
+}
+
+func (b *builder) BuildContext(ctx context.Context, form schema.Form, siblings []schema.Form) (FormModel, error) {
+	if b == nil || b.delegate == nil {
+		return FormModel{}, errors.New("model: builder delegate is nil")
+	}
+	return b.delegate.BuildContext(ctx, form, siblings)
+}
+
 func (b *builder) Decorate(form *FormModel) error {
 	if form == nil {
 		return errors.New("model: form is nil")
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	// ConcurrencyMetadataKey carries optimistic concurrency settings declared on
+	// an operation, either as an object
+	// (`x-formgen: {concurrency: {field: version}}`) or the version field path.
+	ConcurrencyMetadataKey = "concurrency"
+	// ConcurrencyFieldMetadataKey is the flat form of Concurrency.Field
+	// (`x-formgen-concurrency.field` or `x-formgen: {"concurrency.field": version}`).
+	// It overrides the field in ConcurrencyMetadataKey.
+	ConcurrencyFieldMetadataKey = "concurrency.field"
+)
+
+// Concurrency names the field that carries the record version (a revision
+// counter, ETag, or updated_at timestamp) on edit forms. Renderers emit it as a
+// hidden input holding the version that was loaded, and handlers compare the
+// submitted value with the stored one before saving.
+type Concurrency struct {
+	Field string `json:"field"`
+}
+
+// FormConcurrency returns the concurrency settings declared on form. Field is a
+// dotted path. It reports false when the operation declares none.
+func FormConcurrency(form FormModel) (Concurrency, bool) {
+	var concurrency Concurrency
+	if raw := strings.TrimSpace(form.Metadata[ConcurrencyMetadataKey]); raw != "" {
+		if strings.HasPrefix(raw, "{") {
+			if err := json.Unmarshal([]byte(raw), &concurrency); err != nil {
+				return Concurrency{}, false
+			}
+		} else {
+			concurrency.Field = raw
+		}
+	}
+	if field := strings.TrimSpace(form.Metadata[ConcurrencyFieldMetadataKey]); field != "" {
+		concurrency.Field = field
+	}
+	concurrency.Field = strings.TrimSpace(concurrency.Field)
+	if concurrency.Field == "" {
+		return Concurrency{}, false
+	}
+	return concurrency, true
+}
@@ -0,0 +1,26 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestFormConcurrency(t *testing.T) {
+	for name, tc := range map[string]struct {
+		metadata map[string]string
+		want     string
+	}{
+		"disabled":   {metadata: nil},
+		"shorthand":  {metadata: map[string]string{"concurrency": "version"}, want: "version"},
+		"object":     {metadata: map[string]string{"concurrency": `{"field":"meta.updated_at"}`}, want: "meta.updated_at"},
+		"flat wins":  {metadata: map[string]string{"concurrency": `{"field":"rev"}`, "concurrency.field": "version"}, want: "version"},
+		"malformed":  {metadata: map[string]string{"concurrency": `{"field":`}},
+		"empty hint": {metadata: map[string]string{"concurrency.field": "  "}},
+	} {
+		concurrency, ok := model.FormConcurrency(model.FormModel{Metadata: tc.metadata})
+		if ok != (tc.want != "") || concurrency.Field != tc.want {
+			t.Fatalf("%s: got %+v (ok=%v), want field %q", name, concurrency, ok, tc.want)
+		}
+	}
+}
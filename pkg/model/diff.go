@@ -0,0 +1,287 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ChangeKind classifies a difference reported by Diff.
+type ChangeKind string
+
+const (
+	ChangeAdded        ChangeKind = "added"
+	ChangeRemoved      ChangeKind = "removed"
+	ChangeRetyped      ChangeKind = "retyped"
+	ChangeRequired     ChangeKind = "required"
+	ChangeEnum         ChangeKind = "enum"
+	ChangeValidation   ChangeKind = "validation"
+	ChangeRelationship ChangeKind = "relationship"
+)
+
+// Change describes a single field-level difference between two form models.
+// Path is dotted and reaches array item fields through an "items" segment, so
+// it can be passed to FieldByPath. Before/After hold the compared values: a
+// type label for retyped fields, a bool for required, the enum slice, the
+// ValidationRule (nil when absent), or the *Relationship.
+type Change struct {
+	Kind     ChangeKind `json:"kind"`
+	Path     string     `json:"path"`
+	Rule     string     `json:"rule,omitempty"`
+	Before   any        `json:"before,omitempty"`
+	After    any        `json:"after,omitempty"`
+	Breaking bool       `json:"breaking,omitempty"`
+}
+
+// Changes is the ordered result of Diff.
+type Changes []Change
+
+// Empty reports whether the models are equivalent for the compared aspects.
+func (c Changes) Empty() bool {
+	return len(c) == 0
+}
+
+// Breaking returns the changes that can reject payloads accepted before:
+// removed or retyped fields, newly required fields, removed enum values, and
+// retargeted relationships.
+func (c Changes) Breaking() Changes {
+	var out Changes
+	for _, change := range c {
+		if change.Breaking {
+			out = append(out, change)
+		}
+	}
+	return out
+}
+
+// String renders one line per change, suitable for migration notes.
+func (c Changes) String() string {
+	lines := make([]string, len(c))
+	for i, change := range c {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("added %s (%v)", c.Path, c.After)
+	case ChangeRemoved:
+		return fmt.Sprintf("removed %s (%v)", c.Path, c.Before)
+	case ChangeValidation:
+		return fmt.Sprintf("%s %s: %s -> %s", c.Path, c.Rule, describeRule(c.Before), describeRule(c.After))
+	case ChangeRelationship:
+		return fmt.Sprintf("%s relationship: %s -> %s", c.Path, describeRelationship(c.Before), describeRelationship(c.After))
+	default:
+		return fmt.Sprintf("%s %s: %v -> %v", c.Path, c.Kind, c.Before, c.After)
+	}
+}
+
+// Diff compares two form models field by field, recursing into nested object
+// fields and array items. It reports added/removed fields, type or format
+// changes, required flips, enum changes, validation rule changes, and
+// relationship changes. Presentation-only attributes (labels, metadata, UI
+// hints) are ignored. Changes are sorted by path.
+func Diff(before, after FormModel) Changes {
+	var changes Changes
+	diffFields(before.Fields, after.Fields, "", &changes)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func diffFields(before, after []Field, prefix string, changes *Changes) {
+	for _, field := range before {
+		if _, ok := fieldByName(after, field.Name); !ok {
+			*changes = append(*changes, Change{
+				Kind:     ChangeRemoved,
+				Path:     joinDiffPath(prefix, field.Name),
+				Before:   typeLabel(field),
+				Breaking: true,
+			})
+		}
+	}
+	for _, field := range after {
+		path := joinDiffPath(prefix, field.Name)
+		previous, ok := fieldByName(before, field.Name)
+		if !ok {
+			*changes = append(*changes, Change{
+				Kind:     ChangeAdded,
+				Path:     path,
+				After:    typeLabel(field),
+				Breaking: field.Required && field.Default == nil,
+			})
+			continue
+		}
+		diffField(*previous, field, path, changes)
+	}
+}
+
+func diffField(before, after Field, path string, changes *Changes) {
+	if before.Type != after.Type || before.Format != after.Format {
+		*changes = append(*changes, Change{
+			Kind:     ChangeRetyped,
+			Path:     path,
+			Before:   typeLabel(before),
+			After:    typeLabel(after),
+			Breaking: true,
+		})
+		return
+	}
+	if before.Required != after.Required {
+		*changes = append(*changes, Change{
+			Kind:     ChangeRequired,
+			Path:     path,
+			Before:   before.Required,
+			After:    after.Required,
+			Breaking: after.Required,
+		})
+	}
+	if !reflect.DeepEqual(before.Enum, after.Enum) {
+		*changes = append(*changes, Change{
+			Kind:     ChangeEnum,
+			Path:     path,
+			Before:   before.Enum,
+			After:    after.Enum,
+			Breaking: enumRemovesValues(before.Enum, after.Enum),
+		})
+	}
+	diffValidations(before.Validations, after.Validations, path, changes)
+	if !reflect.DeepEqual(before.Relationship, after.Relationship) {
+		*changes = append(*changes, Change{
+			Kind:     ChangeRelationship,
+			Path:     path,
+			Before:   before.Relationship,
+			After:    after.Relationship,
+			Breaking: before.Relationship != nil && (after.Relationship == nil || before.Relationship.Target != after.Relationship.Target),
+		})
+	}
+
+	diffFields(before.Nested, after.Nested, path, changes)
+	switch {
+	case before.Items != nil && after.Items != nil:
+		diffItems(*before.Items, *after.Items, joinDiffPath(path, "items"), changes)
+	case before.Items != nil:
+		*changes = append(*changes, Change{Kind: ChangeRemoved, Path: joinDiffPath(path, "items"), Before: typeLabel(*before.Items), Breaking: true})
+	case after.Items != nil:
+		*changes = append(*changes, Change{Kind: ChangeAdded, Path: joinDiffPath(path, "items"), After: typeLabel(*after.Items)})
+	}
+}
+
+// diffItems compares array item templates, whose names are not part of the
+// path and may differ between builds.
+func diffItems(before, after Field, path string, changes *Changes) {
+	after.Name = before.Name
+	diffField(before, after, path, changes)
+}
+
+func diffValidations(before, after []ValidationRule, path string, changes *Changes) {
+	beforeRules := rulesByKind(before)
+	afterRules := rulesByKind(after)
+	kinds := make([]string, 0, len(beforeRules)+len(afterRules))
+	for kind := range beforeRules {
+		kinds = append(kinds, kind)
+	}
+	for kind := range afterRules {
+		if _, ok := beforeRules[kind]; !ok {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+
+	for _, kind := range kinds {
+		previous, hadRule := beforeRules[kind]
+		current, hasRule := afterRules[kind]
+		if hadRule && hasRule && reflect.DeepEqual(normaliseParams(previous.Params), normaliseParams(current.Params)) {
+			continue
+		}
+		change := Change{Kind: ChangeValidation, Path: path, Rule: kind}
+		if hadRule {
+			change.Before = previous
+		}
+		if hasRule {
+			change.After = current
+		}
+		*changes = append(*changes, change)
+	}
+}
+
+func rulesByKind(rules []ValidationRule) map[string]ValidationRule {
+	out := make(map[string]ValidationRule, len(rules))
+	for _, rule := range rules {
+		out[rule.Kind] = rule
+	}
+	return out
+}
+
+func normaliseParams(params map[string]string) map[string]string {
+	if len(params) == 0 {
+		return nil
+	}
+	return params
+}
+
+func enumRemovesValues(before, after []any) bool {
+	if len(before) == 0 {
+		return len(after) > 0
+	}
+	if len(after) == 0 {
+		return false
+	}
+	for _, value := range before {
+		found := false
+		for _, candidate := range after {
+			if reflect.DeepEqual(value, candidate) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+	return false
+}
+
+func typeLabel(field Field) string {
+	label := string(field.Type)
+	if field.Format != "" {
+		label += "/" + field.Format
+	}
+	return label
+}
+
+func describeRule(value any) string {
+	rule, ok := value.(ValidationRule)
+	if !ok {
+		return "none"
+	}
+	keys := make([]string, 0, len(rule.Params))
+	for key := range rule.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, key+"="+rule.Params[key])
+	}
+	return strings.Join(parts, ",")
+}
+
+func describeRelationship(value any) string {
+	rel, ok := value.(*Relationship)
+	if !ok || rel == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%s %s", rel.Kind, rel.Target)
+}
+
+func joinDiffPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
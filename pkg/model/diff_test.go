@@ -0,0 +1,78 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestDiff(t *testing.T) {
+	before := model.FormModel{Fields: []model.Field{
+		{Name: "title", Type: model.FieldTypeString, Validations: []model.ValidationRule{
+			{Kind: model.ValidationRuleMaxLength, Params: map[string]string{"value": "120"}},
+		}},
+		{Name: "legacy", Type: model.FieldTypeString},
+		{Name: "count", Type: model.FieldTypeString},
+		{Name: "status", Type: model.FieldTypeString, Enum: []any{"draft", "published", "archived"}},
+		{Name: "author_id", Type: model.FieldTypeString, Relationship: &model.Relationship{Kind: model.RelationshipBelongsTo, Target: "#/components/schemas/Author"}},
+		{Name: "tags", Type: model.FieldTypeArray, Items: &model.Field{Name: "tag", Type: model.FieldTypeObject, Nested: []model.Field{
+			{Name: "label", Type: model.FieldTypeString},
+		}}},
+	}}
+	after := model.FormModel{Fields: []model.Field{
+		{Name: "title", Type: model.FieldTypeString, Required: true, Label: "Headline", Validations: []model.ValidationRule{
+			{Kind: model.ValidationRuleMaxLength, Params: map[string]string{"value": "80"}},
+			{Kind: model.ValidationRuleMinLength, Params: map[string]string{"value": "3"}},
+		}},
+		{Name: "count", Type: model.FieldTypeInteger},
+		{Name: "status", Type: model.FieldTypeString, Enum: []any{"draft", "published"}},
+		{Name: "author_id", Type: model.FieldTypeString, Relationship: &model.Relationship{Kind: model.RelationshipBelongsTo, Target: "#/components/schemas/User"}},
+		{Name: "tags", Type: model.FieldTypeArray, Items: &model.Field{Name: "tagsItem", Type: model.FieldTypeObject, Nested: []model.Field{
+			{Name: "label", Type: model.FieldTypeString},
+			{Name: "color", Type: model.FieldTypeString},
+		}}},
+		{Name: "slug", Type: model.FieldTypeString, Format: "slug", Required: true},
+	}}
+
+	changes := model.Diff(before, after)
+
+	type summary struct {
+		Kind     model.ChangeKind
+		Path     string
+		Rule     string
+		Breaking bool
+	}
+	got := make([]summary, len(changes))
+	for i, change := range changes {
+		got[i] = summary{change.Kind, change.Path, change.Rule, change.Breaking}
+	}
+	want := []summary{
+		{model.ChangeRelationship, "author_id", "", true},
+		{model.ChangeRetyped, "count", "", true},
+		{model.ChangeRemoved, "legacy", "", true},
+		{model.ChangeAdded, "slug", "", true},
+		{model.ChangeEnum, "status", "", true},
+		{model.ChangeAdded, "tags.items.color", "", false},
+		{model.ChangeRequired, "title", "", true},
+		{model.ChangeValidation, "title", model.ValidationRuleMaxLength, false},
+		{model.ChangeValidation, "title", model.ValidationRuleMinLength, false},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("changes mismatch (-want +got):\n%s", diff)
+	}
+
+	if len(changes.Breaking()) != 6 {
+		t.Fatalf("expected 6 breaking changes, got %d", len(changes.Breaking()))
+	}
+	if got := changes[1].String(); got != "count retyped: string -> integer" {
+		t.Fatalf("unexpected retyped string %q", got)
+	}
+	if got := changes[7].String(); got != "title maxLength: value=120 -> value=80" {
+		t.Fatalf("unexpected validation string %q", got)
+	}
+	if !model.Diff(before, before).Empty() {
+		t.Fatalf("expected identical models to produce no changes")
+	}
+}
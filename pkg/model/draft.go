@@ -0,0 +1,96 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DraftMetadataKey carries draft settings declared on an operation, either
+	// as an object (`x-formgen: {draft: {key: article, endpoint: /drafts/1}}`),
+	// a storage key string, or true to key drafts by operation id.
+	DraftMetadataKey = "draft"
+	// DraftKeyMetadataKey is the flat form of the draft storage key
+	// (`x-formgen-draft.key` or `x-formgen: {"draft.key": article}`). It
+	// overrides the key in DraftMetadataKey.
+	DraftKeyMetadataKey = "draft.key"
+	// DraftEndpointMetadataKey is the flat form of Draft.Endpoint.
+	DraftEndpointMetadataKey = "draft.endpoint"
+)
+
+// Draft configures autosaving a form's in-progress values so accidental
+// navigation does not lose work. Drafts are stored in localStorage under Key
+// unless Endpoint is set, in which case they are loaded with GET, saved with
+// PUT, and cleared with DELETE against it.
+type Draft struct {
+	Key        string   `json:"key"`
+	Endpoint   string   `json:"endpoint,omitempty"`
+	DebounceMs int      `json:"debounceMs,omitempty"`
+	Exclude    []string `json:"exclude,omitempty"`
+}
+
+// FormDraft returns the draft settings declared on form. Sensitive fields are
+// always added to Exclude so secrets never reach browser storage. It reports
+// false when drafts are not enabled.
+func FormDraft(form FormModel) (Draft, bool) {
+	var draft Draft
+	enabled := false
+	if raw := strings.TrimSpace(form.Metadata[DraftMetadataKey]); raw != "" {
+		switch {
+		case strings.HasPrefix(raw, "{"):
+			if err := json.Unmarshal([]byte(raw), &draft); err != nil {
+				return Draft{}, false
+			}
+			enabled = true
+		default:
+			if on, err := strconv.ParseBool(raw); err == nil {
+				enabled = on
+			} else {
+				draft.Key = raw
+				enabled = true
+			}
+		}
+	}
+	if key := strings.TrimSpace(form.Metadata[DraftKeyMetadataKey]); key != "" {
+		draft.Key = key
+		enabled = true
+	}
+	if endpoint := strings.TrimSpace(form.Metadata[DraftEndpointMetadataKey]); endpoint != "" {
+		draft.Endpoint = endpoint
+	}
+	if !enabled {
+		return Draft{}, false
+	}
+	draft.Key = strings.TrimSpace(draft.Key)
+	if draft.Key == "" {
+		draft.Key = form.OperationID
+	}
+	if draft.Key == "" {
+		return Draft{}, false
+	}
+	draft.Exclude = appendSensitivePaths(draft.Exclude, form.Fields, "")
+	return draft, true
+}
+
+func appendSensitivePaths(paths []string, fields []Field, prefix string) []string {
+	for _, field := range fields {
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+		if field.Sensitive {
+			paths = append(paths, path)
+			continue
+		}
+		paths = appendSensitivePaths(paths, field.Nested, path)
+		if field.Items != nil {
+			if field.Items.Sensitive {
+				paths = append(paths, path+"[]")
+				continue
+			}
+			paths = appendSensitivePaths(paths, field.Items.Nested, path+"[]")
+		}
+	}
+	return paths
+}
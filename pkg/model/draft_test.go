@@ -0,0 +1,42 @@
+package model_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestFormDraft(t *testing.T) {
+	fields := []model.Field{
+		{Name: "title"},
+		{Name: "password", Sensitive: true},
+		{Name: "keys", Items: &model.Field{Nested: []model.Field{{Name: "secret", Sensitive: true}}}},
+	}
+	for name, tc := range map[string]struct {
+		metadata map[string]string
+		want     model.Draft
+		ok       bool
+	}{
+		"disabled":  {metadata: nil},
+		"false":     {metadata: map[string]string{"draft": "false"}},
+		"true":      {metadata: map[string]string{"draft": "true"}, want: model.Draft{Key: "createArticle"}, ok: true},
+		"shorthand": {metadata: map[string]string{"draft": "article"}, want: model.Draft{Key: "article"}, ok: true},
+		"flat key":  {metadata: map[string]string{"draft.key": "article", "draft.endpoint": "/drafts/1"}, want: model.Draft{Key: "article", Endpoint: "/drafts/1"}, ok: true},
+		"object":    {metadata: map[string]string{"draft": `{"key":"article","debounceMs":250,"exclude":["notes"]}`}, want: model.Draft{Key: "article", DebounceMs: 250, Exclude: []string{"notes"}}, ok: true},
+		"key wins":  {metadata: map[string]string{"draft": `{"key":"old"}`, "draft.key": "new"}, want: model.Draft{Key: "new"}, ok: true},
+		"malformed": {metadata: map[string]string{"draft": `{"key":`}},
+	} {
+		draft, ok := model.FormDraft(model.FormModel{OperationID: "createArticle", Metadata: tc.metadata, Fields: fields})
+		if ok != tc.ok {
+			t.Fatalf("%s: ok = %v, want %v", name, ok, tc.ok)
+		}
+		if !ok {
+			continue
+		}
+		tc.want.Exclude = append(tc.want.Exclude, "password", "keys[].secret")
+		if !reflect.DeepEqual(draft, tc.want) {
+			t.Fatalf("%s: got %+v, want %+v", name, draft, tc.want)
+		}
+	}
+}
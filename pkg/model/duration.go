@@ -0,0 +1,15 @@
+package model
+
+import internalmodel "github.com/goliatone/go-formgen/internal/model"
+
+// Duration re-exports the components of an ISO-8601 duration.
+type Duration = internalmodel.Duration
+
+// DurationParts lists the duration components accepted by the durationParts
+// UI hint, in ISO-8601 order.
+var DurationParts = internalmodel.DurationParts
+
+// ParseISODuration parses an ISO-8601 duration such as "P1DT2H".
+func ParseISODuration(value string) (Duration, error) {
+	return internalmodel.ParseISODuration(value)
+}
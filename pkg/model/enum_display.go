@@ -0,0 +1,26 @@
+package model
+
+import internalmodel "github.com/goliatone/go-formgen/internal/model"
+
+// EnumDisplay re-exports the enum presentation modes set via the enumDisplay
+// UI hint (auto, select, radio, checkbox).
+type EnumDisplay = internalmodel.EnumDisplay
+
+const (
+	EnumDisplayAuto     = internalmodel.EnumDisplayAuto
+	EnumDisplaySelect   = internalmodel.EnumDisplaySelect
+	EnumDisplayRadio    = internalmodel.EnumDisplayRadio
+	EnumDisplayCheckbox = internalmodel.EnumDisplayCheckbox
+
+	EnumDisplayHintKey    = internalmodel.EnumDisplayHintKey
+	EnumDisplayMaxHintKey = internalmodel.EnumDisplayMaxHintKey
+	DefaultEnumDisplayMax = internalmodel.DefaultEnumDisplayMax
+)
+
+// ResolveEnumDisplay reports whether a field renders as a radio group
+// (EnumDisplayRadio), a checkbox group (EnumDisplayCheckbox), or a select
+// (EnumDisplaySelect). It returns "" when the field has no static choices or
+// its hint does not apply, leaving renderers on their default control.
+func ResolveEnumDisplay(field Field) EnumDisplay {
+	return internalmodel.ResolveEnumDisplay(field)
+}
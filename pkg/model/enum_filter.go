@@ -0,0 +1,14 @@
+package model
+
+import internalmodel "github.com/goliatone/go-formgen/internal/model"
+
+// EnumFilterMetadataKey is the field metadata key carrying an enum filter.
+const EnumFilterMetadataKey = internalmodel.EnumFilterMetadataKey
+
+// EnumFilter narrows an enum field to Allow while When holds.
+type EnumFilter = internalmodel.EnumFilter
+
+// FieldEnumFilter returns the enum filter declared on a field, if any.
+func FieldEnumFilter(field Field) (EnumFilter, bool) {
+	return internalmodel.FieldEnumFilter(field)
+}
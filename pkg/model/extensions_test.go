@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/schema"
 )
 
 func TestParseUIExtensions(t *testing.T) {
@@ -58,3 +59,69 @@ func TestParseUIExtensions(t *testing.T) {
 		t.Fatalf("expected widget uiHint, got %q", got)
 	}
 }
+
+func TestAdminMetadataAccessors(t *testing.T) {
+	builder := model.NewBuilder()
+	form, err := builder.Build(schema.Form{
+		ID:         "admin",
+		Method:     "POST",
+		Endpoint:   "/admin",
+		Extensions: map[string]any{"x-admin": map[string]any{"group": "Catalog"}},
+		Schema: schema.Schema{
+			Type: "object",
+			Properties: map[string]schema.Schema{
+				"title": {
+					Type: "string",
+					Extensions: map[string]any{
+						"x-admin": map[string]any{
+							"label":      "Book title",
+							"tags":       []any{"core", "seo"},
+							"order":      3,
+							"sortable":   true,
+							"filterable": "yes",
+						},
+						"x-admin-searchable": true,
+					},
+				},
+				"notes": {Type: "string"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	formAdmin, ok := form.AdminMetadata()
+	if !ok || formAdmin.Group != "Catalog" {
+		t.Fatalf("form admin metadata = %+v (ok=%v)", formAdmin, ok)
+	}
+
+	var title, notes model.Field
+	for _, field := range form.Fields {
+		switch field.Name {
+		case "title":
+			title = field
+		case "notes":
+			notes = field
+		}
+	}
+	admin, ok := title.AdminMetadata()
+	if !ok {
+		t.Fatalf("expected admin metadata on title")
+	}
+	if admin.Label != "Book title" || !admin.Sortable || !admin.Filterable || !admin.Searchable || admin.Hidden {
+		t.Fatalf("unexpected admin metadata: %+v", admin)
+	}
+	if admin.Order == nil || *admin.Order != 3 {
+		t.Fatalf("order = %v, want 3", admin.Order)
+	}
+	if len(admin.Tags) != 2 || admin.Tags[0] != "core" || admin.Tags[1] != "seo" {
+		t.Fatalf("tags = %v", admin.Tags)
+	}
+	if title.Label == "Book title" {
+		t.Fatalf("admin label must not override the form label")
+	}
+	if _, ok := notes.AdminMetadata(); ok {
+		t.Fatalf("expected no admin metadata on notes")
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import internalmodel "github.com/goliatone/go-formgen/internal/model"
+
+const (
+	UnitHintKey      = internalmodel.UnitHintKey
+	PrecisionHintKey = internalmodel.PrecisionHintKey
+)
+
+// FieldUnit returns the unit named by the field's unit UI hint, or "".
+func FieldUnit(field Field) string {
+	return internalmodel.FieldUnit(field)
+}
+
+// FieldPrecision returns the decimal places declared by the field's precision
+// UI hint, reporting false when the hint is missing or invalid.
+func FieldPrecision(field Field) (int, bool) {
+	return internalmodel.FieldPrecision(field)
+}
+
+// PrecisionStep returns the input step for a precision ("0.01" for 2).
+func PrecisionStep(precision int) string {
+	return internalmodel.PrecisionStep(precision)
+}
+
+// FormatNumber renders a numeric value with exactly precision decimal places.
+func FormatNumber(value any, precision int) (string, bool) {
+	return internalmodel.FormatNumber(value, precision)
+}
+
+// DecimalPlaces counts the decimal places of value in its shortest
+// round-trip form.
+func DecimalPlaces(value float64) int {
+	return internalmodel.DecimalPlaces(value)
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FieldByPath resolves a dotted field path (e.g. "author.address.street")
+// against the supplied fields. Array item fields are reached through an
+// "items" segment or a numeric index ("tags.0", "tags[0]"), mirroring the
+// control names emitted by renderers.
+func FieldByPath(fields []Field, path string) (Field, bool) {
+	segments := fieldPathSegments(path)
+	if len(segments) == 0 {
+		return Field{}, false
+	}
+
+	var current *Field
+	candidates := fields
+	for _, segment := range segments {
+		if current != nil && current.Items != nil && isItemSegment(segment) {
+			current = current.Items
+			candidates = current.Nested
+			continue
+		}
+		next, ok := fieldByName(candidates, segment)
+		if !ok {
+			return Field{}, false
+		}
+		current = next
+		candidates = current.Nested
+	}
+	if current == nil {
+		return Field{}, false
+	}
+	return *current, true
+}
+
+func fieldByName(fields []Field, name string) (*Field, bool) {
+	for idx := range fields {
+		if fields[idx].Name == name {
+			return &fields[idx], true
+		}
+	}
+	return nil, false
+}
+
+func fieldPathSegments(path string) []string {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil
+	}
+	replacer := strings.NewReplacer("[", ".", "]", "")
+	parts := strings.Split(replacer.Replace(path), ".")
+	segments := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			segments = append(segments, trimmed)
+		}
+	}
+	return segments
+}
+
+func isItemSegment(segment string) bool {
+	if segment == "items" {
+		return true
+	}
+	_, err := strconv.Atoi(segment)
+	return err == nil
+}
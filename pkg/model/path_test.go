@@ -0,0 +1,47 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestFieldByPath(t *testing.T) {
+	fields := []model.Field{
+		{
+			Name: "author",
+			Type: model.FieldTypeObject,
+			Nested: []model.Field{
+				{Name: "address", Type: model.FieldTypeObject, Nested: []model.Field{{Name: "street"}}},
+			},
+		},
+		{
+			Name: "tags",
+			Type: model.FieldTypeArray,
+			Items: &model.Field{
+				Name:   "tagsItem",
+				Type:   model.FieldTypeObject,
+				Nested: []model.Field{{Name: "label"}},
+			},
+		},
+	}
+
+	cases := map[string]string{
+		"author.address.street": "street",
+		"author.address":        "address",
+		"tags.items.label":      "label",
+		"tags[0].label":         "label",
+		"tags.0":                "tagsItem",
+	}
+	for path, want := range cases {
+		field, ok := model.FieldByPath(fields, path)
+		if !ok || field.Name != want {
+			t.Fatalf("FieldByPath(%q) = %q, %v; want %q", path, field.Name, ok, want)
+		}
+	}
+	for _, path := range []string{"", "author.missing", "title"} {
+		if _, ok := model.FieldByPath(fields, path); ok {
+			t.Fatalf("expected %q to be unresolved", path)
+		}
+	}
+}
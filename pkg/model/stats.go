@@ -0,0 +1,93 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FormStats summarises the complexity of a form model.
+type FormStats struct {
+	// Fields counts every field, including nested object fields and array item
+	// templates.
+	Fields int `json:"fields"`
+	// TopLevelFields counts the fields directly on the form.
+	TopLevelFields int `json:"topLevelFields"`
+	// MaxDepth is the deepest nesting level; a flat form has depth 1.
+	MaxDepth int `json:"maxDepth"`
+	// Relationships counts fields carrying relationship metadata.
+	Relationships int `json:"relationships"`
+	// EstimatedSize approximates the rendered payload in bytes using the size
+	// of the JSON-encoded model, which hydrated renderers embed verbatim.
+	EstimatedSize int `json:"estimatedSize"`
+}
+
+// Stats computes complexity metrics for form.
+func Stats(form FormModel) FormStats {
+	stats := FormStats{TopLevelFields: len(form.Fields)}
+	walkStats(form.Fields, 1, &stats)
+	if encoded, err := json.Marshal(form); err == nil {
+		stats.EstimatedSize = len(encoded)
+	}
+	return stats
+}
+
+func walkStats(fields []Field, depth int, stats *FormStats) {
+	for _, field := range fields {
+		stats.Fields++
+		if depth > stats.MaxDepth {
+			stats.MaxDepth = depth
+		}
+		if field.Relationship != nil {
+			stats.Relationships++
+		}
+		walkStats(field.Nested, depth+1, stats)
+		if field.Items != nil {
+			walkStats([]Field{*field.Items}, depth+1, stats)
+		}
+	}
+}
+
+// StatsBudget sets upper bounds for FormStats metrics. Zero disables a limit.
+type StatsBudget struct {
+	MaxFields        int `json:"maxFields,omitempty"`
+	MaxDepth         int `json:"maxDepth,omitempty"`
+	MaxRelationships int `json:"maxRelationships,omitempty"`
+	MaxEstimatedSize int `json:"maxEstimatedSize,omitempty"`
+}
+
+// BudgetViolation reports a metric that exceeded its budget.
+type BudgetViolation struct {
+	Metric string `json:"metric"`
+	Limit  int    `json:"limit"`
+	Actual int    `json:"actual"`
+}
+
+func (v BudgetViolation) String() string {
+	return fmt.Sprintf("%s %d exceeds budget %d", v.Metric, v.Actual, v.Limit)
+}
+
+// Empty reports whether the budget sets no limits.
+func (b StatsBudget) Empty() bool {
+	return b == StatsBudget{}
+}
+
+// Check returns the metrics in stats that exceed the budget, in a fixed order.
+func (b StatsBudget) Check(stats FormStats) []BudgetViolation {
+	checks := []struct {
+		metric string
+		limit  int
+		actual int
+	}{
+		{"fields", b.MaxFields, stats.Fields},
+		{"maxDepth", b.MaxDepth, stats.MaxDepth},
+		{"relationships", b.MaxRelationships, stats.Relationships},
+		{"estimatedSize", b.MaxEstimatedSize, stats.EstimatedSize},
+	}
+	var violations []BudgetViolation
+	for _, check := range checks {
+		if check.limit > 0 && check.actual > check.limit {
+			violations = append(violations, BudgetViolation{Metric: check.metric, Limit: check.limit, Actual: check.actual})
+		}
+	}
+	return violations
+}
@@ -0,0 +1,61 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestStats(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createArticle",
+		Fields: []model.Field{
+			{Name: "title", Type: model.FieldTypeString},
+			{Name: "author_id", Type: model.FieldTypeString, Relationship: &model.Relationship{Kind: model.RelationshipBelongsTo}},
+			{
+				Name: "author",
+				Type: model.FieldTypeObject,
+				Nested: []model.Field{
+					{Name: "address", Type: model.FieldTypeObject, Nested: []model.Field{{Name: "street"}}},
+				},
+			},
+			{
+				Name:  "tags",
+				Type:  model.FieldTypeArray,
+				Items: &model.Field{Name: "tag", Type: model.FieldTypeString},
+			},
+		},
+	}
+
+	stats := model.Stats(form)
+	if stats.EstimatedSize <= 0 {
+		t.Fatalf("expected estimated size, got %d", stats.EstimatedSize)
+	}
+	stats.EstimatedSize = 0
+	want := model.FormStats{Fields: 7, TopLevelFields: 4, MaxDepth: 3, Relationships: 1}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Fatalf("stats mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestStatsBudgetCheck(t *testing.T) {
+	stats := model.FormStats{Fields: 40, MaxDepth: 2, Relationships: 6, EstimatedSize: 9000}
+	budget := model.StatsBudget{MaxFields: 30, MaxDepth: 3, MaxEstimatedSize: 8000}
+
+	got := budget.Check(stats)
+	want := []model.BudgetViolation{
+		{Metric: "fields", Limit: 30, Actual: 40},
+		{Metric: "estimatedSize", Limit: 8000, Actual: 9000},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("violations mismatch (-want +got):\n%s", diff)
+	}
+	if got[0].String() != "fields 40 exceeds budget 30" {
+		t.Fatalf("unexpected violation string %q", got[0].String())
+	}
+	if (model.StatsBudget{}).Check(stats) != nil {
+		t.Fatalf("expected empty budget to report no violations")
+	}
+}
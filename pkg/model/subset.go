@@ -12,34 +12,60 @@ const (
 	layoutSectionFieldKey  = "layout.section"
 )
 
-// FieldSubset describes the allowed groups, tags, or sections for partial
-// model output. When all slices are empty the form is left untouched.
+// FieldSubset describes the allowed groups, tags, sections, or field paths for
+// partial model output. When all slices are empty the form is left untouched.
 type FieldSubset struct {
 	Groups   []string
 	Tags     []string
 	Sections []string
+	// Paths keeps fields at the listed dotted paths (e.g. "author.email").
+	// Selecting a nested path keeps its ancestors with only the selected
+	// children; selecting a parent keeps all of its children. Paths combine with
+	// Groups/Tags/Sections: a top-level field matching any filter is kept.
+	Paths []string
+	// Exclude removes fields at the listed dotted paths after the inclusion
+	// filters run. When it is the only filter, every other field is kept.
+	Exclude []string
 }
 
-// ApplySubset removes fields that do not match the supplied subset filters. It
-// operates on top-level fields and prunes section metadata so consumers do not
-// render empty sections after filtering. When subset is empty or form is nil,
-// the form is returned unchanged.
+// Empty reports whether the subset carries no filters.
+func (s FieldSubset) Empty() bool {
+	return newSubsetMatcher(s).empty() && newPathTree(s.Paths) == nil && newPathTree(s.Exclude) == nil
+}
+
+// ApplySubset removes fields that do not match the supplied subset filters.
+// Group, tag, and section filters operate on top-level fields while path
+// filters reach nested object fields. Section metadata is pruned so consumers
+// do not render empty sections after filtering. When subset is empty or form is
+// nil, the form is returned unchanged.
 func ApplySubset(form *FormModel, subset FieldSubset) {
 	if form == nil {
 		return
 	}
 
 	matcher := newSubsetMatcher(subset)
-	if matcher.empty() {
+	include := newPathTree(subset.Paths)
+	exclude := newPathTree(subset.Exclude)
+	if matcher.empty() && include == nil && exclude == nil {
 		return
 	}
 
-	filtered := make([]Field, 0, len(form.Fields))
-	for _, field := range form.Fields {
-		if matcher.matches(field) {
-			filtered = append(filtered, field)
+	filtered := form.Fields
+	if !matcher.empty() || include != nil {
+		filtered = make([]Field, 0, len(form.Fields))
+		for _, field := range form.Fields {
+			if matcher.matches(field) {
+				filtered = append(filtered, field)
+				continue
+			}
+			if selected, ok := include.selectField(field); ok {
+				filtered = append(filtered, selected)
+			}
 		}
 	}
+	if exclude != nil {
+		filtered = exclude.excludeFields(filtered)
+	}
 	form.Fields = filtered
 	if len(form.Fields) == 0 {
 		form.Fields = nil
@@ -48,6 +74,105 @@ func ApplySubset(form *FormModel, subset FieldSubset) {
 	pruneSectionMetadata(form, form.Fields)
 }
 
+// pathTree indexes dotted field paths by segment so nested selections can be
+// resolved while walking the field tree.
+type pathTree struct {
+	terminal bool
+	children map[string]*pathTree
+}
+
+func newPathTree(paths []string) *pathTree {
+	var root *pathTree
+	for _, path := range paths {
+		segments := fieldPathSegments(path)
+		if len(segments) == 0 {
+			continue
+		}
+		if root == nil {
+			root = &pathTree{}
+		}
+		node := root
+		for _, segment := range segments {
+			if node.children == nil {
+				node.children = make(map[string]*pathTree)
+			}
+			next, ok := node.children[segment]
+			if !ok {
+				next = &pathTree{}
+				node.children[segment] = next
+			}
+			node = next
+		}
+		node.terminal = true
+	}
+	return root
+}
+
+func (t *pathTree) child(name string) *pathTree {
+	if t == nil {
+		return nil
+	}
+	return t.children[name]
+}
+
+// itemChild returns the node addressing array items ("items" or an index).
+func (t *pathTree) itemChild() *pathTree {
+	if t == nil {
+		return nil
+	}
+	for segment, node := range t.children {
+		if isItemSegment(segment) {
+			return node
+		}
+	}
+	return nil
+}
+
+func (t *pathTree) selectField(field Field) (Field, bool) {
+	node := t.child(field.Name)
+	if node == nil {
+		return Field{}, false
+	}
+	if node.terminal || len(node.children) == 0 || len(field.Nested) == 0 {
+		return field, true
+	}
+	nested := make([]Field, 0, len(field.Nested))
+	for _, child := range field.Nested {
+		if selected, ok := node.selectField(child); ok {
+			nested = append(nested, selected)
+		}
+	}
+	if len(nested) == 0 {
+		return Field{}, false
+	}
+	field.Nested = nested
+	return field, true
+}
+
+func (t *pathTree) excludeFields(fields []Field) []Field {
+	out := make([]Field, 0, len(fields))
+	for _, field := range fields {
+		node := t.child(field.Name)
+		if node == nil {
+			out = append(out, field)
+			continue
+		}
+		if node.terminal {
+			continue
+		}
+		if len(field.Nested) > 0 {
+			field.Nested = node.excludeFields(field.Nested)
+		}
+		if item := node.itemChild(); item != nil && field.Items != nil && len(field.Items.Nested) > 0 {
+			items := *field.Items
+			items.Nested = item.excludeFields(items.Nested)
+			field.Items = &items
+		}
+		out = append(out, field)
+	}
+	return out
+}
+
 type subsetMatcher struct {
 	groups   map[string]struct{}
 	tags     map[string]struct{}
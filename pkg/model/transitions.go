@@ -0,0 +1,19 @@
+package model
+
+import internalmodel "github.com/goliatone/go-formgen/internal/model"
+
+// TransitionsMetadataKey is the field metadata key carrying state transitions.
+const TransitionsMetadataKey = internalmodel.TransitionsMetadataKey
+
+// Transitions maps each state to the states it may move to next.
+type Transitions = internalmodel.Transitions
+
+// FieldTransitions returns the transitions declared on a field, if any.
+func FieldTransitions(field Field) (Transitions, bool) {
+	return internalmodel.FieldTransitions(field)
+}
+
+// TransitionState formats a value as a transition state key.
+func TransitionState(value any) string {
+	return internalmodel.TransitionState(value)
+}
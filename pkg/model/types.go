@@ -28,6 +28,15 @@ const (
 // dotted keys for backward compatibility. See docs/adr/RELATIONSHIP_STRUCT_ADR.md.
 type Relationship = internalmodel.Relationship
 
+// RelationshipConventions configures WithRelationshipInference.
+type RelationshipConventions = internalmodel.RelationshipConventions
+
+// DefaultRelationshipConventions returns the conventions applied when
+// relationship inference is enabled without overrides.
+func DefaultRelationshipConventions() RelationshipConventions {
+	return internalmodel.DefaultRelationshipConventions()
+}
+
 // Validation rule identifiers mirror OpenAPI keyword semantics and are emitted
 // by the form model builder when schemas define matching constraints.
 const (
@@ -50,6 +59,40 @@ type ValidationRule = internalmodel.ValidationRule
 // compatibility.
 type Option = internalmodel.Option
 
+// AdminMetadata is the typed view of x-admin extensions returned by
+// Field.AdminMetadata and FormModel.AdminMetadata.
+type AdminMetadata = internalmodel.AdminMetadata
+
+// Field locations mark fields built from operation parameters; request body
+// fields leave Field.Location empty.
+const (
+	LocationPath   = internalmodel.LocationPath
+	LocationQuery  = internalmodel.LocationQuery
+	LocationHeader = internalmodel.LocationHeader
+)
+
 // Field mirrors internal model fields for renderer consumption.
 type Field = internalmodel.Field
 type FormModel = internalmodel.FormModel
+
+// Security is the typed view of operation auth requirements returned by
+// FormModel.Security.
+type Security = internalmodel.Security
+
+// SecurityRequirement lists schemes that must all be satisfied.
+type SecurityRequirement = internalmodel.SecurityRequirement
+
+// SecurityScheme describes a scheme referenced by a security requirement.
+type SecurityScheme = internalmodel.SecurityScheme
+
+// ErrorMapping describes where a 4xx response keeps field errors; see
+// FormModel.ErrorMappings.
+type ErrorMapping = internalmodel.ErrorMapping
+
+// ErrorShape names a recognised error payload layout.
+type ErrorShape = internalmodel.ErrorShape
+
+const (
+	ErrorShapeList = internalmodel.ErrorShapeList
+	ErrorShapeMap  = internalmodel.ErrorShapeMap
+)
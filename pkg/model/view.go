@@ -0,0 +1,35 @@
+package model
+
+// MetadataView is the form metadata key set on read-only detail view models.
+// Renderers that support views render values instead of inputs when it is
+// "true".
+const MetadataView = "view"
+
+// MarkView flags form as a detail view and marks every field readonly.
+func MarkView(form *FormModel) {
+	if form == nil {
+		return
+	}
+	if form.Metadata == nil {
+		form.Metadata = map[string]string{}
+	}
+	form.Metadata[MetadataView] = "true"
+	markReadonly(form.Fields)
+}
+
+// IsView reports whether form was built as a detail view.
+func IsView(form FormModel) bool {
+	return form.Metadata[MetadataView] == "true"
+}
+
+func markReadonly(fields []Field) {
+	for i := range fields {
+		fields[i].Readonly = true
+		markReadonly(fields[i].Nested)
+		markReadonly(fields[i].OneOf)
+		if fields[i].Items != nil {
+			fields[i].Items.Readonly = true
+			markReadonly(fields[i].Items.Nested)
+		}
+	}
+}
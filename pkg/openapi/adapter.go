@@ -50,7 +50,10 @@ func (a *Adapter) Load(ctx context.Context, src schema.Source) (schema.Document,
 }
 
 // Normalize parses operations and converts them into the canonical schema IR.
-func (a *Adapter) Normalize(ctx context.Context, doc schema.Document, _ schema.NormalizeOptions) (schema.SchemaIR, error) {
+// When opts.FormID is set, only that operation is parsed (see ParseOperation)
+// and the IR holds just its form; an unknown id falls back to every
+// operation so callers can list what is available.
+func (a *Adapter) Normalize(ctx context.Context, doc schema.Document, opts schema.NormalizeOptions) (schema.SchemaIR, error) {
 	if a == nil || a.parser == nil {
 		return schema.SchemaIR{}, errors.New("openapi adapter: parser is nil")
 	}
@@ -67,7 +70,7 @@ func (a *Adapter) Normalize(ctx context.Context, doc schema.Document, _ schema.N
 		openDoc = constructed
 	}
 
-	operations, err := a.parser.Operations(ctx, openDoc)
+	operations, err := a.operations(ctx, openDoc, opts.FormID)
 	if err != nil {
 		return schema.SchemaIR{}, err
 	}
@@ -87,6 +90,19 @@ func (a *Adapter) Normalize(ctx context.Context, doc schema.Document, _ schema.N
 	return ir, nil
 }
 
+func (a *Adapter) operations(ctx context.Context, doc Document, formID string) (map[string]Operation, error) {
+	if formID != "" {
+		op, err := ParseOperation(ctx, a.parser, doc, formID)
+		if err == nil {
+			return map[string]Operation{formID: op}, nil
+		}
+		if !errors.Is(err, ErrOperationNotFound) {
+			return nil, err
+		}
+	}
+	return a.parser.Operations(ctx, doc)
+}
+
 // Forms returns the list of operation-backed form references.
 func (a *Adapter) Forms(_ context.Context, ir schema.SchemaIR) ([]schema.FormRef, error) {
 	return ir.FormRefs(), nil
@@ -102,16 +118,60 @@ func FormFromOperation(op Operation) schema.Form {
 		Description: op.Description,
 		Schema:      schemaFromOpenAPISchema(op.RequestBody),
 		Extensions:  cloneExtensions(op.Extensions),
+		MediaType:   op.RequestMediaType,
 	}
-	if len(op.Responses) > 0 {
-		form.Responses = make(map[string]schema.Schema, len(op.Responses))
-		for code, response := range op.Responses {
-			form.Responses[code] = schemaFromOpenAPISchema(response)
+	if len(op.Tags) > 0 {
+		form.Tags = append([]string(nil), op.Tags...)
+	}
+	if len(op.RequestBodies) > 0 {
+		form.MediaTypes = make(map[string]schema.Schema, len(op.RequestBodies))
+		for mediaType, body := range op.RequestBodies {
+			form.MediaTypes[mediaType] = schemaFromOpenAPISchema(body)
+		}
+	}
+	if len(op.Security) > 0 {
+		form.Security = make([]schema.SecurityRequirement, 0, len(op.Security))
+		for _, requirement := range op.Security {
+			cloned := make(schema.SecurityRequirement, len(requirement))
+			for name, scopes := range requirement {
+				cloned[name] = append([]string{}, scopes...)
+			}
+			form.Security = append(form.Security, cloned)
 		}
+		form.SecuritySchemes = maps.Clone(op.SecuritySchemes)
 	}
+	if len(op.Parameters) > 0 {
+		form.Parameters = make([]schema.Parameter, 0, len(op.Parameters))
+		for _, param := range op.Parameters {
+			form.Parameters = append(form.Parameters, schema.Parameter{
+				Name:        param.Name,
+				In:          param.In,
+				Required:    param.Required,
+				Description: param.Description,
+				Schema:      schemaFromOpenAPISchema(param.Schema),
+			})
+		}
+	}
+	if op.Responses == nil && op.LazyResponses != nil {
+		return form.WithLazyResponses(func() map[string]schema.Schema {
+			return responsesFromOpenAPI(op.ResponseSchemas())
+		})
+	}
+	form.Responses = responsesFromOpenAPI(op.Responses)
 	return form
 }
 
+func responsesFromOpenAPI(responses map[string]Schema) map[string]schema.Schema {
+	if len(responses) == 0 {
+		return nil
+	}
+	out := make(map[string]schema.Schema, len(responses))
+	for code, response := range responses {
+		out[code] = schemaFromOpenAPISchema(response)
+	}
+	return out
+}
+
 func schemaFromOpenAPISchema(input Schema) schema.Schema {
 	out := schema.Schema{
 		Ref:              input.Ref,
@@ -119,6 +179,7 @@ func schemaFromOpenAPISchema(input Schema) schema.Schema {
 		Format:           input.Format,
 		Description:      input.Description,
 		Default:          input.Default,
+		Nullable:         input.Nullable,
 		Enum:             cloneEnum(input.Enum),
 		Required:         cloneStringSlice(input.Required),
 		Minimum:          cloneFloatPointer(input.Minimum),
@@ -131,6 +192,7 @@ func schemaFromOpenAPISchema(input Schema) schema.Schema {
 		MaxItems:         cloneIntPointer(input.MaxItems),
 		Pattern:          input.Pattern,
 		Extensions:       cloneExtensions(input.Extensions),
+		Encoding:         cloneEncoding(input.Encoding),
 	}
 	if len(input.Properties) > 0 {
 		out.Properties = make(map[string]schema.Schema, len(input.Properties))
@@ -0,0 +1,313 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// KeywordStatus describes how the form builder treats a schema keyword.
+type KeywordStatus string
+
+const (
+	// KeywordSupported keywords are mapped into the form model.
+	KeywordSupported KeywordStatus = "supported"
+	// KeywordUnsupported keywords change what a valid payload looks like but
+	// are dropped by the builder, so generated forms may accept or reject
+	// values the spec does not.
+	KeywordUnsupported KeywordStatus = "unsupported"
+	// KeywordIgnored keywords are annotations or unknown extensions without
+	// an effect on form fidelity.
+	KeywordIgnored KeywordStatus = "ignored"
+)
+
+// KeywordCoverage aggregates the occurrences of a single keyword. Locations
+// are JSON pointers to the schemas that declare it, e.g.
+// #/components/schemas/Pet.
+type KeywordCoverage struct {
+	Keyword   string        `json:"keyword"`
+	Status    KeywordStatus `json:"status"`
+	Count     int           `json:"count"`
+	Locations []string      `json:"locations"`
+}
+
+// CoverageReport lists the keywords found in the schemas reachable from the
+// document operations. Keywords are ordered by status (supported,
+// unsupported, ignored), then by descending count and name. Failures holds
+// operations that could not be built, keyed by operation id; it is filled by
+// callers that also build the forms, such as the orchestrator.
+type CoverageReport struct {
+	Operations int               `json:"operations"`
+	Keywords   []KeywordCoverage `json:"keywords"`
+	Failures   map[string]string `json:"failures,omitempty"`
+}
+
+// ByStatus returns the keywords with the given status, preserving order.
+func (r CoverageReport) ByStatus(status KeywordStatus) []KeywordCoverage {
+	var out []KeywordCoverage
+	for _, keyword := range r.Keywords {
+		if keyword.Status == status {
+			out = append(out, keyword)
+		}
+	}
+	return out
+}
+
+// CoverageOption customises Coverage.
+type CoverageOption func(*coverageConfig)
+
+type coverageConfig struct {
+	namespaces []string
+}
+
+// WithCoverageExtensionNamespaces marks additional vendor extensions (and
+// their "-" suffixed variants) as supported, matching namespaces registered
+// on the model builder.
+func WithCoverageExtensionNamespaces(namespaces ...string) CoverageOption {
+	return func(cfg *coverageConfig) {
+		for _, ns := range namespaces {
+			if ns = strings.TrimSpace(ns); ns != "" {
+				cfg.namespaces = append(cfg.namespaces, ns)
+			}
+		}
+	}
+}
+
+var supportedKeywords = map[string]struct{}{
+	"$ref": {}, "type": {}, "format": {}, "description": {}, "default": {},
+	"required": {}, "enum": {}, "properties": {}, "items": {}, "allOf": {},
+	"minimum": {}, "maximum": {}, "exclusiveMinimum": {}, "exclusiveMaximum": {},
+	"minLength": {}, "maxLength": {}, "pattern": {}, "minItems": {}, "maxItems": {},
+}
+
+var unsupportedKeywords = map[string]struct{}{
+	"oneOf": {}, "anyOf": {}, "not": {}, "const": {}, "readOnly": {}, "writeOnly": {},
+	"nullable": {}, "multipleOf": {}, "uniqueItems": {}, "additionalProperties": {},
+	"patternProperties": {}, "propertyNames": {}, "minProperties": {}, "maxProperties": {},
+	"dependentRequired": {}, "dependentSchemas": {}, "if": {}, "then": {}, "else": {},
+	"prefixItems": {}, "additionalItems": {}, "contains": {}, "minContains": {},
+	"maxContains": {}, "discriminator": {}, "unevaluatedProperties": {},
+	"unevaluatedItems": {}, "contentMediaType": {}, "contentEncoding": {},
+}
+
+var supportedExtensions = []string{"x-formgen", "x-admin", "x-endpoint", "x-validate-endpoint", "x-relationships", "x-current-value"}
+
+var coverageMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Coverage walks the request body and parameter schemas of every operation in
+// the raw OpenAPI document (JSON or YAML) and reports which keywords the form
+// builder maps, which it drops although they constrain payloads, and which it
+// ignores. Local $ref targets are followed and each schema is counted once;
+// external references are not resolved.
+func Coverage(raw []byte, opts ...CoverageOption) (CoverageReport, error) {
+	var cfg coverageConfig
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	var root map[string]any
+	if err := yaml.Unmarshal(raw, &root); err != nil {
+		return CoverageReport{}, fmt.Errorf("openapi coverage: decode: %w", err)
+	}
+	if root == nil {
+		return CoverageReport{}, fmt.Errorf("openapi coverage: empty document")
+	}
+
+	walker := &coverageWalker{
+		root:     root,
+		cfg:      cfg,
+		visited:  make(map[string]bool),
+		keywords: make(map[string]*KeywordCoverage),
+	}
+	report := CoverageReport{}
+
+	paths := coverageMap(root["paths"])
+	for _, path := range sortedKeys(paths) {
+		item, itemPtr := walker.deref(paths[path], "#/paths/"+escapePointer(path))
+		if item == nil {
+			continue
+		}
+		for _, method := range coverageMethods {
+			op := coverageMap(item[method])
+			if op == nil {
+				continue
+			}
+			report.Operations++
+			opPtr := itemPtr + "/" + method
+			walker.walkParameters(itemPtr+"/parameters", item["parameters"])
+			walker.walkParameters(opPtr+"/parameters", op["parameters"])
+			if body, bodyPtr := walker.deref(op["requestBody"], opPtr+"/requestBody"); body != nil {
+				walker.walkContent(bodyPtr+"/content", body["content"])
+			}
+		}
+	}
+
+	report.Keywords = walker.results()
+	return report, nil
+}
+
+type coverageWalker struct {
+	root     map[string]any
+	cfg      coverageConfig
+	visited  map[string]bool
+	keywords map[string]*KeywordCoverage
+}
+
+func (w *coverageWalker) walkParameters(ptr string, raw any) {
+	list, _ := raw.([]any)
+	for i, entry := range list {
+		param, paramPtr := w.deref(entry, fmt.Sprintf("%s/%d", ptr, i))
+		if param == nil || w.visited[paramPtr] {
+			continue
+		}
+		w.visited[paramPtr] = true
+		w.walkSchema(paramPtr+"/schema", param["schema"])
+		w.walkContent(paramPtr+"/content", param["content"])
+	}
+}
+
+func (w *coverageWalker) walkContent(ptr string, raw any) {
+	content := coverageMap(raw)
+	for _, mediaType := range sortedKeys(content) {
+		media := coverageMap(content[mediaType])
+		if media == nil {
+			continue
+		}
+		w.walkSchema(ptr+"/"+escapePointer(mediaType)+"/schema", media["schema"])
+	}
+}
+
+func (w *coverageWalker) walkSchema(ptr string, raw any) {
+	node := coverageMap(raw)
+	if node == nil || w.visited[ptr] {
+		return
+	}
+	w.visited[ptr] = true
+
+	for _, key := range sortedKeys(node) {
+		w.record(key, ptr)
+	}
+
+	if ref, ok := node["$ref"].(string); ok && strings.HasPrefix(ref, "#/") {
+		w.walkSchema(ref, w.lookup(ref))
+	}
+	for _, key := range []string{"properties", "patternProperties", "dependentSchemas", "$defs", "definitions"} {
+		children := coverageMap(node[key])
+		for _, name := range sortedKeys(children) {
+			w.walkSchema(ptr+"/"+key+"/"+escapePointer(name), children[name])
+		}
+	}
+	for _, key := range []string{"items", "prefixItems", "allOf", "anyOf", "oneOf"} {
+		if list, ok := node[key].([]any); ok {
+			for i, child := range list {
+				w.walkSchema(fmt.Sprintf("%s/%s/%d", ptr, key, i), child)
+			}
+			continue
+		}
+		w.walkSchema(ptr+"/"+key, node[key])
+	}
+	for _, key := range []string{"not", "additionalProperties", "additionalItems", "if", "then", "else", "contains", "propertyNames", "unevaluatedItems", "unevaluatedProperties"} {
+		w.walkSchema(ptr+"/"+key, node[key])
+	}
+}
+
+// deref resolves a local $ref on a non-schema object (path item, parameter,
+// request body) and returns the target along with its pointer.
+func (w *coverageWalker) deref(raw any, ptr string) (map[string]any, string) {
+	node := coverageMap(raw)
+	for depth := 0; node != nil && depth < 32; depth++ {
+		ref, ok := node["$ref"].(string)
+		if !ok || !strings.HasPrefix(ref, "#/") {
+			break
+		}
+		node, ptr = coverageMap(w.lookup(ref)), ref
+	}
+	return node, ptr
+}
+
+func (w *coverageWalker) lookup(ref string) any {
+	var current any = w.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch value := current.(type) {
+		case map[string]any:
+			current = value[token]
+		case []any:
+			var index int
+			if _, err := fmt.Sscanf(token, "%d", &index); err != nil || index < 0 || index >= len(value) {
+				return nil
+			}
+			current = value[index]
+		default:
+			return nil
+		}
+	}
+	return current
+}
+
+func (w *coverageWalker) record(keyword, ptr string) {
+	entry, ok := w.keywords[keyword]
+	if !ok {
+		entry = &KeywordCoverage{Keyword: keyword, Status: w.classify(keyword)}
+		w.keywords[keyword] = entry
+	}
+	entry.Count++
+	entry.Locations = append(entry.Locations, ptr)
+}
+
+func (w *coverageWalker) classify(keyword string) KeywordStatus {
+	if _, ok := supportedKeywords[keyword]; ok {
+		return KeywordSupported
+	}
+	if _, ok := unsupportedKeywords[keyword]; ok {
+		return KeywordUnsupported
+	}
+	if strings.HasPrefix(keyword, "x-") {
+		for _, ns := range append(append([]string(nil), supportedExtensions...), w.cfg.namespaces...) {
+			if keyword == ns || strings.HasPrefix(keyword, ns+"-") {
+				return KeywordSupported
+			}
+		}
+	}
+	return KeywordIgnored
+}
+
+func (w *coverageWalker) results() []KeywordCoverage {
+	rank := map[KeywordStatus]int{KeywordSupported: 0, KeywordUnsupported: 1, KeywordIgnored: 2}
+	out := make([]KeywordCoverage, 0, len(w.keywords))
+	for _, entry := range w.keywords {
+		out = append(out, *entry)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if rank[out[i].Status] != rank[out[j].Status] {
+			return rank[out[i].Status] < rank[out[j].Status]
+		}
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Keyword < out[j].Keyword
+	})
+	return out
+}
+
+func coverageMap(raw any) map[string]any {
+	node, _ := raw.(map[string]any)
+	return node
+}
+
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
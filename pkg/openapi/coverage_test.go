@@ -0,0 +1,134 @@
+package openapi_test
+
+import (
+	"strings"
+	"testing"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+)
+
+const coverageDocument = `openapi: 3.1.0
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    parameters:
+      - $ref: '#/components/parameters/PetID'
+    put:
+      operationId: updatePet
+      requestBody:
+        $ref: '#/components/requestBodies/Pet'
+      responses:
+        200:
+          description: OK
+    patch:
+      operationId: patchPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        200:
+          description: OK
+components:
+  parameters:
+    PetID:
+      name: id
+      in: path
+      required: true
+      schema:
+        type: string
+        format: uuid
+  requestBodies:
+    Pet:
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Pet'
+  schemas:
+    Pet:
+      type: object
+      title: Pet
+      additionalProperties: false
+      x-formgen:
+        label: Pet
+      x-internal: true
+      properties:
+        name:
+          type: string
+          minLength: 1
+        kind:
+          const: cat
+        owner:
+          oneOf:
+            - $ref: '#/components/schemas/Pet'
+            - type: string
+              x-acme-widget: lookup
+`
+
+func TestCoverageClassifiesKeywords(t *testing.T) {
+	report, err := pkgopenapi.Coverage([]byte(coverageDocument))
+	if err != nil {
+		t.Fatalf("coverage: %v", err)
+	}
+	if report.Operations != 2 {
+		t.Fatalf("expected 2 operations, got %d", report.Operations)
+	}
+
+	byKeyword := map[string]pkgopenapi.KeywordCoverage{}
+	for _, keyword := range report.Keywords {
+		byKeyword[keyword.Keyword] = keyword
+	}
+	expect := map[string]pkgopenapi.KeywordStatus{
+		"type":                 pkgopenapi.KeywordSupported,
+		"minLength":            pkgopenapi.KeywordSupported,
+		"x-formgen":            pkgopenapi.KeywordSupported,
+		"oneOf":                pkgopenapi.KeywordUnsupported,
+		"const":                pkgopenapi.KeywordUnsupported,
+		"additionalProperties": pkgopenapi.KeywordUnsupported,
+		"title":                pkgopenapi.KeywordIgnored,
+		"x-internal":           pkgopenapi.KeywordIgnored,
+		"x-acme-widget":        pkgopenapi.KeywordIgnored,
+	}
+	for keyword, status := range expect {
+		if got, ok := byKeyword[keyword]; !ok || got.Status != status {
+			t.Fatalf("expected %s to be %s, got %+v", keyword, status, got)
+		}
+	}
+
+	// The shared Pet schema is reached from both operations and from its own
+	// oneOf branch, but is counted once.
+	if got := byKeyword["additionalProperties"]; got.Count != 1 || got.Locations[0] != "#/components/schemas/Pet" {
+		t.Fatalf("expected a single Pet location, got %+v", got)
+	}
+	if got := byKeyword["const"].Locations; len(got) != 1 || got[0] != "#/components/schemas/Pet/properties/kind" {
+		t.Fatalf("unexpected const locations %v", got)
+	}
+	if got := byKeyword["format"].Locations; len(got) != 1 || got[0] != "#/components/parameters/PetID/schema" {
+		t.Fatalf("expected parameter schema to be walked, got %v", got)
+	}
+
+	var order []string
+	for _, keyword := range report.Keywords {
+		order = append(order, string(keyword.Status))
+	}
+	joined := strings.Join(order, ",")
+	if strings.Index(joined, "ignored") < strings.LastIndex(joined, "unsupported") {
+		t.Fatalf("expected keywords ordered by status, got %s", joined)
+	}
+}
+
+func TestCoverageExtensionNamespaces(t *testing.T) {
+	report, err := pkgopenapi.Coverage([]byte(coverageDocument), pkgopenapi.WithCoverageExtensionNamespaces("x-acme"))
+	if err != nil {
+		t.Fatalf("coverage: %v", err)
+	}
+	for _, keyword := range report.ByStatus(pkgopenapi.KeywordSupported) {
+		if keyword.Keyword == "x-acme-widget" {
+			return
+		}
+	}
+	t.Fatalf("expected x-acme-widget to be supported, got %+v", report.Keywords)
+}
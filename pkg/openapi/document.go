@@ -3,9 +3,15 @@ package openapi
 // This file defines the domain wrappers described in go-form-gen.md:25-159.
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"maps"
+	"sync"
+
+	"github.com/goliatone/go-formgen/internal/safefile"
+	"github.com/goliatone/go-formgen/pkg/schema"
 )
 
 // Document wraps the raw OpenAPI payload and its origin. By exposing this type
@@ -14,6 +20,8 @@ import (
 type Document struct {
 	source Source
 	raw    []byte
+	// hash is set once the raw payload has been released by Compact.
+	hash string
 }
 
 // NewDocument constructs a Document wrapper while validating the inputs.
@@ -43,11 +51,61 @@ func (d Document) Source() Source {
 	return d.source
 }
 
-// Raw returns a defensive copy of the OpenAPI payload.
+// Raw returns a defensive copy of the OpenAPI payload. For compacted
+// documents backed by a file, the payload is re-read from disk on each call
+// and returned only when it still matches Hash; otherwise Raw returns nil.
 func (d Document) Raw() []byte {
+	if d.raw == nil && d.hash != "" {
+		return d.reload()
+	}
 	return append([]byte(nil), d.raw...)
 }
 
+// Hash returns the hex-encoded SHA-256 of the payload. It stays available
+// after Compact, so caches can detect changed specs without holding the bytes.
+func (d Document) Hash() string {
+	if d.hash != "" {
+		return d.hash
+	}
+	if len(d.raw) == 0 {
+		return ""
+	}
+	return hashPayload(d.raw)
+}
+
+// Compact returns a copy of the document that drops the raw payload and keeps
+// its source and Hash. Servers caching many large specs can parse once and
+// retain the compacted document to reduce resident memory. File sources reload
+// their payload lazily from disk when Raw is called again; other sources report
+// an empty payload, so re-parsing them requires loading the document again.
+func (d Document) Compact() Document {
+	if d.raw == nil {
+		return d
+	}
+	return Document{source: d.source, hash: hashPayload(d.raw)}
+}
+
+// Compacted reports whether the raw payload was released by Compact.
+func (d Document) Compacted() bool {
+	return d.raw == nil && d.hash != ""
+}
+
+func (d Document) reload() []byte {
+	if d.source == nil || d.source.Kind() != SourceKindFile {
+		return nil
+	}
+	data, err := safefile.ReadFile(d.source.Location())
+	if err != nil || hashPayload(data) != d.hash {
+		return nil
+	}
+	return data
+}
+
+func hashPayload(raw []byte) string {
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
 // Location returns the string identifier for the origin.
 func (d Document) Location() string {
 	if d.source == nil {
@@ -65,8 +123,45 @@ type Operation struct {
 	Summary     string
 	Description string
 	RequestBody Schema
+	Parameters  []Parameter `json:"Parameters,omitempty"`
 	Responses   map[string]Schema
 	Extensions  map[string]any `json:"Extensions,omitempty"`
+	// Security holds the effective requirements: the operation's own security
+	// or, when absent, the document-level default.
+	Security        []SecurityRequirement     `json:"Security,omitempty"`
+	SecuritySchemes map[string]SecurityScheme `json:"SecuritySchemes,omitempty"`
+	// RequestMediaType is the media type RequestBody was taken from.
+	// RequestBodies holds every variant keyed by media type when the body
+	// declares more than one.
+	RequestMediaType string            `json:"RequestMediaType,omitempty"`
+	RequestBodies    map[string]Schema `json:"RequestBodies,omitempty"`
+	// Tags lists the operation's OpenAPI tags in declaration order.
+	Tags []string `json:"Tags,omitempty"`
+
+	// LazyResponses builds the response schemas on first use when Responses
+	// is nil. Set it with WithLazyResponses and read through ResponseSchemas.
+	LazyResponses func() map[string]Schema `json:"-"`
+}
+
+// SecurityRequirement maps scheme names to required scopes; see
+// schema.SecurityRequirement.
+type SecurityRequirement = schema.SecurityRequirement
+
+// SecurityScheme describes a components.securitySchemes entry.
+type SecurityScheme = schema.SecurityScheme
+
+// Encoding describes a request body media type `encoding` entry; see
+// schema.Encoding.
+type Encoding = schema.Encoding
+
+// Parameter describes a path, query, or header parameter declared on an
+// operation or inherited from its path item.
+type Parameter struct {
+	Name        string
+	In          string
+	Required    bool
+	Description string
+	Schema      Schema
 }
 
 // NewOperation validates core fields and initialises response maps.
@@ -104,10 +199,27 @@ func MustNewOperation(id, method, path string, request Schema, responses map[str
 
 // HasResponse reports whether a response code has a schema registered.
 func (op Operation) HasResponse(code string) bool {
-	_, ok := op.Responses[code]
+	_, ok := op.ResponseSchemas()[code]
 	return ok
 }
 
+// WithLazyResponses returns a copy of op whose response schemas are built by
+// resolve on the first ResponseSchemas call (see WithLazyResponseSchemas).
+func (op Operation) WithLazyResponses(resolve func() map[string]Schema) Operation {
+	op.Responses = nil
+	op.LazyResponses = sync.OnceValue(resolve)
+	return op
+}
+
+// ResponseSchemas returns the response schemas keyed by status code, resolving
+// lazy ones once. Read responses through it rather than the Responses field.
+func (op Operation) ResponseSchemas() map[string]Schema {
+	if op.Responses != nil || op.LazyResponses == nil {
+		return op.Responses
+	}
+	return op.LazyResponses()
+}
+
 // Schema represents request/response bodies and nested fields within an
 // operation, linked to the README description in go-form-gen.md:111-158.
 type Schema struct {
@@ -120,6 +232,7 @@ type Schema struct {
 	Enum             []any
 	Description      string
 	Default          any
+	Nullable         bool
 	Minimum          *float64
 	Maximum          *float64
 	ExclusiveMinimum bool
@@ -130,6 +243,7 @@ type Schema struct {
 	MaxItems         *int
 	Pattern          string
 	Extensions       map[string]any `json:"Extensions,omitempty"`
+	Encoding         *Encoding      `json:"Encoding,omitempty"`
 }
 
 // Clone creates a deep copy of the schema tree to avoid accidental mutation.
@@ -155,6 +269,7 @@ func (s Schema) Clone() Schema {
 		cloned.Extensions = make(map[string]any, len(s.Extensions))
 		maps.Copy(cloned.Extensions, s.Extensions)
 	}
+	cloned.Encoding = cloneEncoding(s.Encoding)
 	if s.Minimum != nil {
 		value := *s.Minimum
 		cloned.Minimum = &value
@@ -216,3 +331,16 @@ func (s Schema) DebugString() string {
 	}
 	return summary
 }
+
+func cloneEncoding(encoding *Encoding) *Encoding {
+	if encoding == nil {
+		return nil
+	}
+	cloned := *encoding
+	cloned.Headers = append([]string(nil), encoding.Headers...)
+	if encoding.Explode != nil {
+		explode := *encoding.Explode
+		cloned.Explode = &explode
+	}
+	return &cloned
+}
@@ -0,0 +1,396 @@
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a compiled subset of RFC 9535 JSONPath covering the selectors
+// overlay documents use in practice: child names (dot or bracket notation),
+// wildcards, array indexes, recursive descent, and simple filters comparing a
+// relative path to a literal (e.g. $.paths.*.post[?(@.operationId == 'x')]).
+type jsonPath struct {
+	segments []pathSegment
+}
+
+type pathSegment struct {
+	recursive bool
+	wildcard  bool
+	names     []string
+	indexes   []int
+	filter    *pathFilter
+}
+
+type pathFilter struct {
+	path    []string
+	op      string
+	literal any
+}
+
+// pathNode is a value selected by a jsonPath together with the means to
+// replace or remove it within its parent.
+type pathNode struct {
+	value  any
+	set    func(any)
+	remove func()
+}
+
+// removedValue marks array elements deleted by an overlay action until the
+// tree is compacted.
+type removedValue struct{}
+
+func compileJSONPath(expr string) (jsonPath, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "$") {
+		return jsonPath{}, fmt.Errorf("jsonpath %q must start with $", expr)
+	}
+	var segments []pathSegment
+	rest := expr[1:]
+	for rest != "" {
+		var (
+			segment pathSegment
+			err     error
+		)
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			segment.recursive = true
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				segment, rest, err = parseBracket(rest, segment)
+			} else {
+				segment, rest, err = parseDotName(rest, segment)
+			}
+		case strings.HasPrefix(rest, "."):
+			segment, rest, err = parseDotName(rest[1:], segment)
+		case strings.HasPrefix(rest, "["):
+			segment, rest, err = parseBracket(rest, segment)
+		default:
+			err = fmt.Errorf("unexpected %q", rest)
+		}
+		if err != nil {
+			return jsonPath{}, fmt.Errorf("jsonpath %q: %w", expr, err)
+		}
+		segments = append(segments, segment)
+	}
+	return jsonPath{segments: segments}, nil
+}
+
+func parseDotName(rest string, segment pathSegment) (pathSegment, string, error) {
+	end := strings.IndexAny(rest, ".[")
+	if end < 0 {
+		end = len(rest)
+	}
+	name := rest[:end]
+	switch name {
+	case "":
+		return segment, rest, errors.New("empty member name")
+	case "*":
+		segment.wildcard = true
+	default:
+		segment.names = []string{name}
+	}
+	return segment, rest[end:], nil
+}
+
+func parseBracket(rest string, segment pathSegment) (pathSegment, string, error) {
+	end := closingBracket(rest)
+	if end < 0 {
+		return segment, rest, errors.New("unterminated bracket")
+	}
+	body := strings.TrimSpace(rest[1:end])
+	rest = rest[end+1:]
+
+	switch {
+	case body == "*":
+		segment.wildcard = true
+	case strings.HasPrefix(body, "?"):
+		filter, err := parseFilter(body[1:])
+		if err != nil {
+			return segment, rest, err
+		}
+		segment.filter = &filter
+	default:
+		for _, part := range splitSelectors(body) {
+			part = strings.TrimSpace(part)
+			if name, ok := unquote(part); ok {
+				segment.names = append(segment.names, name)
+				continue
+			}
+			index, err := strconv.Atoi(part)
+			if err != nil {
+				return segment, rest, fmt.Errorf("invalid selector %q", part)
+			}
+			segment.indexes = append(segment.indexes, index)
+		}
+	}
+	return segment, rest, nil
+}
+
+// closingBracket returns the index of the bracket closing rest[0], skipping
+// quoted strings and nested brackets.
+func closingBracket(rest string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func splitSelectors(body string) []string {
+	var (
+		parts []string
+		quote byte
+		start int
+	)
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ',':
+			parts = append(parts, body[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, body[start:])
+}
+
+func unquote(value string) (string, bool) {
+	if len(value) < 2 {
+		return "", false
+	}
+	first, last := value[0], value[len(value)-1]
+	if (first != '\'' && first != '"') || first != last {
+		return "", false
+	}
+	inner := value[1 : len(value)-1]
+	inner = strings.ReplaceAll(inner, `\`+string(first), string(first))
+	return strings.ReplaceAll(inner, `\\`, `\`), true
+}
+
+func parseFilter(body string) (pathFilter, error) {
+	body = strings.TrimSpace(body)
+	if strings.HasPrefix(body, "(") && strings.HasSuffix(body, ")") {
+		body = strings.TrimSpace(body[1 : len(body)-1])
+	}
+	if !strings.HasPrefix(body, "@") {
+		return pathFilter{}, fmt.Errorf("unsupported filter %q", body)
+	}
+
+	var filter pathFilter
+	left := body
+	for _, op := range []string{"==", "!="} {
+		if idx := strings.Index(body, op); idx >= 0 {
+			filter.op = op
+			left = strings.TrimSpace(body[:idx])
+			literal, err := parseLiteral(strings.TrimSpace(body[idx+len(op):]))
+			if err != nil {
+				return pathFilter{}, err
+			}
+			filter.literal = literal
+			break
+		}
+	}
+
+	relative, err := compileJSONPath("$" + left[1:])
+	if err != nil {
+		return pathFilter{}, err
+	}
+	for _, segment := range relative.segments {
+		if segment.recursive || segment.wildcard || segment.filter != nil || len(segment.indexes) > 0 || len(segment.names) != 1 {
+			return pathFilter{}, fmt.Errorf("unsupported filter path %q", left)
+		}
+		filter.path = append(filter.path, segment.names[0])
+	}
+	return filter, nil
+}
+
+func parseLiteral(raw string) (any, error) {
+	if value, ok := unquote(raw); ok {
+		return value, nil
+	}
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return nil, fmt.Errorf("invalid filter literal %q", raw)
+	}
+	return value, nil
+}
+
+// selectNodes evaluates the path against the tree held by root.
+func (p jsonPath) selectNodes(root *any) []pathNode {
+	nodes := []pathNode{{
+		value:  *root,
+		set:    func(value any) { *root = value },
+		remove: func() { *root = nil },
+	}}
+	for _, segment := range p.segments {
+		var next []pathNode
+		for _, node := range nodes {
+			candidates := []pathNode{node}
+			if segment.recursive {
+				candidates = descendants(node)
+			}
+			for _, candidate := range candidates {
+				next = append(next, segment.selectChildren(candidate)...)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+func descendants(node pathNode) []pathNode {
+	out := []pathNode{node}
+	for _, child := range children(node) {
+		out = append(out, descendants(child)...)
+	}
+	return out
+}
+
+func children(node pathNode) []pathNode {
+	switch typed := node.value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		out := make([]pathNode, 0, len(keys))
+		for _, key := range keys {
+			out = append(out, mapChild(typed, key))
+		}
+		return out
+	case []any:
+		out := make([]pathNode, 0, len(typed))
+		for index := range typed {
+			out = append(out, sliceChild(typed, index))
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func mapChild(parent map[string]any, key string) pathNode {
+	return pathNode{
+		value:  parent[key],
+		set:    func(value any) { parent[key] = value },
+		remove: func() { delete(parent, key) },
+	}
+}
+
+func sliceChild(parent []any, index int) pathNode {
+	return pathNode{
+		value:  parent[index],
+		set:    func(value any) { parent[index] = value },
+		remove: func() { parent[index] = removedValue{} },
+	}
+}
+
+func (s pathSegment) selectChildren(node pathNode) []pathNode {
+	switch {
+	case s.wildcard:
+		return children(node)
+	case s.filter != nil:
+		var out []pathNode
+		for _, child := range children(node) {
+			if s.filter.matches(child.value) {
+				out = append(out, child)
+			}
+		}
+		return out
+	}
+
+	var out []pathNode
+	switch typed := node.value.(type) {
+	case map[string]any:
+		for _, name := range s.names {
+			if _, ok := typed[name]; ok {
+				out = append(out, mapChild(typed, name))
+			}
+		}
+	case []any:
+		for _, index := range s.indexes {
+			if index < 0 {
+				index += len(typed)
+			}
+			if index >= 0 && index < len(typed) {
+				out = append(out, sliceChild(typed, index))
+			}
+		}
+	}
+	return out
+}
+
+func (f pathFilter) matches(value any) bool {
+	for _, name := range f.path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		if value, ok = object[name]; !ok {
+			return false
+		}
+	}
+	switch f.op {
+	case "==":
+		return literalEqual(value, f.literal)
+	case "!=":
+		return !literalEqual(value, f.literal)
+	default:
+		return true
+	}
+}
+
+func literalEqual(value, literal any) bool {
+	if number, ok := toFloat(value); ok {
+		if want, ok := toFloat(literal); ok {
+			return number == want
+		}
+	}
+	return reflect.DeepEqual(value, literal)
+}
+
+func toFloat(value any) (float64, bool) {
+	switch typed := value.(type) {
+	case int:
+		return float64(typed), true
+	case int64:
+		return float64(typed), true
+	case uint64:
+		return float64(typed), true
+	case float64:
+		return typed, true
+	default:
+		return 0, false
+	}
+}
@@ -34,6 +34,10 @@ type LoaderOptions struct {
 
 	// RequestTimeout caps remote fetch durations when AllowHTTPFallback is true.
 	RequestTimeout time.Duration
+
+	// Overlays lists OpenAPI Overlay documents applied, in order, to every
+	// document the loader returns (see NewOverlayLoader).
+	Overlays []Source
 }
 
 // LoaderOption mutates LoaderOptions prior to construction.
@@ -62,6 +66,14 @@ func WithHTTPFallback(timeout time.Duration) LoaderOption {
 	}
 }
 
+// WithOverlays applies OpenAPI Overlay documents to every loaded document.
+// Overlays are read through the same loader, so they resolve like specs do.
+func WithOverlays(sources ...Source) LoaderOption {
+	return func(opts *LoaderOptions) {
+		opts.Overlays = append(opts.Overlays, sources...)
+	}
+}
+
 // WithDefaultSources enables the built-in HTTP loader using the default client
 // when no explicit client is provided. This mirrors the Quick Start examples in
 // the README.
@@ -0,0 +1,244 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Overlay is an OpenAPI Overlay document (https://spec.openapis.org/overlay/v1.0.0).
+// Overlays let UI metadata such as x-formgen extensions or tweaked descriptions
+// live outside a canonical spec owned by another team; they are merged into
+// the base document before parsing.
+type Overlay struct {
+	Overlay string          `json:"overlay" yaml:"overlay"`
+	Info    OverlayInfo     `json:"info" yaml:"info"`
+	Extends string          `json:"extends,omitempty" yaml:"extends,omitempty"`
+	Actions []OverlayAction `json:"actions" yaml:"actions"`
+}
+
+// OverlayInfo carries the overlay metadata block.
+type OverlayInfo struct {
+	Title   string `json:"title" yaml:"title"`
+	Version string `json:"version" yaml:"version"`
+}
+
+// OverlayAction updates or removes the nodes selected by Target, a JSONPath
+// expression. Update objects are merged recursively into object targets
+// (arrays are appended to, other values replaced) and appended to array
+// targets. Remove takes precedence over Update. Targets matching no nodes are
+// ignored, as the specification requires.
+//
+// Target supports the JSONPath subset used by overlays in practice: dot and
+// bracket member names, wildcards, array indexes, recursive descent, and
+// filters comparing a relative member path to a literal, e.g.
+// $.paths['/pets'].post or $.paths.*[?(@.operationId == 'createPet')].
+type OverlayAction struct {
+	Target      string `json:"target" yaml:"target"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Update      any    `json:"update,omitempty" yaml:"update,omitempty"`
+	Remove      bool   `json:"remove,omitempty" yaml:"remove,omitempty"`
+}
+
+// ParseOverlay decodes a JSON or YAML overlay document and validates its
+// version and action targets.
+func ParseOverlay(raw []byte) (Overlay, error) {
+	var overlay Overlay
+	if err := yaml.Unmarshal(raw, &overlay); err != nil {
+		return Overlay{}, fmt.Errorf("openapi overlay: decode: %w", err)
+	}
+	if !strings.HasPrefix(overlay.Overlay, "1.") {
+		return Overlay{}, fmt.Errorf("openapi overlay: unsupported overlay version %q", overlay.Overlay)
+	}
+	for i, action := range overlay.Actions {
+		if _, err := compileJSONPath(action.Target); err != nil {
+			return Overlay{}, fmt.Errorf("openapi overlay: action %d: %w", i, err)
+		}
+		overlay.Actions[i].Update = normaliseYAMLValue(action.Update)
+	}
+	return overlay, nil
+}
+
+// ApplyOverlays merges overlays, in order, into doc and returns a new document
+// with the same source. The merged payload is re-encoded as JSON.
+func ApplyOverlays(doc Document, overlays ...Overlay) (Document, error) {
+	if len(overlays) == 0 {
+		return doc, nil
+	}
+	raw := doc.Raw()
+	if len(raw) == 0 {
+		return Document{}, errors.New("openapi overlay: document payload is empty")
+	}
+
+	var tree any
+	if err := yaml.Unmarshal(raw, &tree); err != nil {
+		return Document{}, fmt.Errorf("openapi overlay: decode document: %w", err)
+	}
+	tree = normaliseYAMLValue(tree)
+
+	for _, overlay := range overlays {
+		for i, action := range overlay.Actions {
+			if err := applyOverlayAction(&tree, action); err != nil {
+				return Document{}, fmt.Errorf("openapi overlay: %s action %d: %w", overlay.Info.Title, i, err)
+			}
+		}
+	}
+
+	merged, err := json.Marshal(tree)
+	if err != nil {
+		return Document{}, fmt.Errorf("openapi overlay: encode document: %w", err)
+	}
+	return NewDocument(doc.Source(), merged)
+}
+
+func applyOverlayAction(tree *any, action OverlayAction) error {
+	path, err := compileJSONPath(action.Target)
+	if err != nil {
+		return err
+	}
+	nodes := path.selectNodes(tree)
+	for _, node := range nodes {
+		switch {
+		case action.Remove:
+			node.remove()
+		case action.Update != nil:
+			node.set(mergeOverlayValue(node.value, normaliseYAMLValue(action.Update)))
+		}
+	}
+	if action.Remove {
+		*tree = compactRemoved(*tree)
+	}
+	return nil
+}
+
+func mergeOverlayValue(target, update any) any {
+	switch current := target.(type) {
+	case map[string]any:
+		patch, ok := update.(map[string]any)
+		if !ok {
+			return cloneOverlayValue(update)
+		}
+		for key, value := range patch {
+			if existing, ok := current[key]; ok {
+				current[key] = mergeOverlayValue(existing, value)
+				continue
+			}
+			current[key] = cloneOverlayValue(value)
+		}
+		return current
+	case []any:
+		if items, ok := update.([]any); ok {
+			return append(current, cloneOverlayValue(items).([]any)...)
+		}
+		return append(current, cloneOverlayValue(update))
+	default:
+		return cloneOverlayValue(update)
+	}
+}
+
+// cloneOverlayValue copies update values so targets selected by the same
+// action do not share (and later co-mutate) maps or slices.
+func cloneOverlayValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(typed))
+		for key, item := range typed {
+			out[key] = cloneOverlayValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(typed))
+		for i, item := range typed {
+			out[i] = cloneOverlayValue(item)
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+func compactRemoved(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			typed[key] = compactRemoved(item)
+		}
+		return typed
+	case []any:
+		out := typed[:0]
+		for _, item := range typed {
+			if _, removed := item.(removedValue); removed {
+				continue
+			}
+			out = append(out, compactRemoved(item))
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// normaliseYAMLValue converts map[any]any nodes (produced by YAML mappings
+// with non-string keys such as unquoted response codes) into map[string]any so
+// the tree can be addressed by JSONPath and encoded as JSON.
+func normaliseYAMLValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			typed[key] = normaliseYAMLValue(item)
+		}
+		return typed
+	case map[any]any:
+		out := make(map[string]any, len(typed))
+		for key, item := range typed {
+			out[fmt.Sprint(key)] = normaliseYAMLValue(item)
+		}
+		return out
+	case []any:
+		for i, item := range typed {
+			typed[i] = normaliseYAMLValue(item)
+		}
+		return typed
+	default:
+		return value
+	}
+}
+
+// NewOverlayLoader wraps loader so every document it loads has the overlays
+// at the given sources applied, in order. Overlays are loaded through the same
+// loader on every call, so edits are picked up without restarting.
+func NewOverlayLoader(loader Loader, overlays ...Source) Loader {
+	return &overlayLoader{base: loader, overlays: append([]Source(nil), overlays...)}
+}
+
+type overlayLoader struct {
+	base     Loader
+	overlays []Source
+}
+
+func (l *overlayLoader) Load(ctx context.Context, src Source) (Document, error) {
+	if l.base == nil {
+		return Document{}, errors.New("openapi overlay: loader is nil")
+	}
+	doc, err := l.base.Load(ctx, src)
+	if err != nil {
+		return Document{}, err
+	}
+	overlays := make([]Overlay, 0, len(l.overlays))
+	for _, overlaySrc := range l.overlays {
+		raw, err := l.base.Load(ctx, overlaySrc)
+		if err != nil {
+			return Document{}, fmt.Errorf("openapi overlay: load %s: %w", overlaySrc.Location(), err)
+		}
+		overlay, err := ParseOverlay(raw.Raw())
+		if err != nil {
+			return Document{}, fmt.Errorf("%w (%s)", err, overlaySrc.Location())
+		}
+		overlays = append(overlays, overlay)
+	}
+	return ApplyOverlays(doc, overlays...)
+}
@@ -0,0 +1,198 @@
+package openapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	formgen "github.com/goliatone/go-formgen"
+	internalLoader "github.com/goliatone/go-formgen/internal/openapi/loader"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+)
+
+const overlayBaseDocument = `openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      description: Create a pet.
+      tags: [pets]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+                legacy:
+                  type: string
+      responses:
+        201:
+          description: Created
+    get:
+      operationId: listPets
+      responses:
+        200:
+          description: OK
+`
+
+const overlayDocument = `overlay: 1.0.0
+info:
+  title: Pet UI
+  version: 1.0.0
+actions:
+  - target: $.paths['/pets'].post.requestBody.content['application/json'].schema.properties.name
+    update:
+      x-formgen:
+        placeholder: Rex
+  - target: $.paths.*[?(@.operationId == 'createPet')]
+    update:
+      description: Register a new pet.
+      tags: [ui]
+  - target: $..properties.legacy
+    remove: true
+  - target: $.paths['/missing']
+    update:
+      description: ignored
+`
+
+func decodeDocument(t *testing.T, doc pkgopenapi.Document) map[string]any {
+	t.Helper()
+	var decoded map[string]any
+	if err := json.Unmarshal(doc.Raw(), &decoded); err != nil {
+		t.Fatalf("decode merged document: %v", err)
+	}
+	return decoded
+}
+
+func TestApplyOverlaysMergesUpdatesAndRemovals(t *testing.T) {
+	overlay, err := pkgopenapi.ParseOverlay([]byte(overlayDocument))
+	if err != nil {
+		t.Fatalf("parse overlay: %v", err)
+	}
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("pets.yaml"), []byte(overlayBaseDocument))
+
+	merged, err := pkgopenapi.ApplyOverlays(doc, overlay)
+	if err != nil {
+		t.Fatalf("apply overlay: %v", err)
+	}
+	if merged.Location() != doc.Location() {
+		t.Fatalf("expected source to be preserved, got %q", merged.Location())
+	}
+
+	decoded := decodeDocument(t, merged)
+	post := decoded["paths"].(map[string]any)["/pets"].(map[string]any)["post"].(map[string]any)
+	if post["description"] != "Register a new pet." {
+		t.Fatalf("expected filtered update to replace description, got %v", post["description"])
+	}
+	if diff := cmp.Diff([]any{"pets", "ui"}, post["tags"]); diff != "" {
+		t.Fatalf("expected update to append to arrays (-want +got):\n%s", diff)
+	}
+	properties := post["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"].(map[string]any)["properties"].(map[string]any)
+	want := map[string]any{
+		"name": map[string]any{
+			"type":      "string",
+			"x-formgen": map[string]any{"placeholder": "Rex"},
+		},
+	}
+	if diff := cmp.Diff(want, properties); diff != "" {
+		t.Fatalf("properties mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := decoded["paths"].(map[string]any)["/missing"]; ok {
+		t.Fatal("expected targets matching nothing to be ignored")
+	}
+	get := decoded["paths"].(map[string]any)["/pets"].(map[string]any)["get"].(map[string]any)
+	if _, ok := get["description"]; ok {
+		t.Fatal("expected filter to leave non-matching operations untouched")
+	}
+}
+
+func TestApplyOverlaysRemovesArrayElements(t *testing.T) {
+	overlay, err := pkgopenapi.ParseOverlay([]byte(`{
+  "overlay": "1.0.0",
+  "info": {"title": "tags", "version": "1"},
+  "actions": [{"target": "$.tags[?(@.name != 'keep')]", "remove": true}]
+}`))
+	if err != nil {
+		t.Fatalf("parse overlay: %v", err)
+	}
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("tags.json"), []byte(`{"tags":[{"name":"drop"},{"name":"keep"},{"name":"other"}]}`))
+
+	merged, err := pkgopenapi.ApplyOverlays(doc, overlay)
+	if err != nil {
+		t.Fatalf("apply overlay: %v", err)
+	}
+	if diff := cmp.Diff(map[string]any{"tags": []any{map[string]any{"name": "keep"}}}, decodeDocument(t, merged)); diff != "" {
+		t.Fatalf("merged document mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseOverlayRejectsInvalidDocuments(t *testing.T) {
+	cases := map[string]string{
+		"version": `{"overlay": "2.0.0", "actions": []}`,
+		"target":  `{"overlay": "1.0.0", "actions": [{"target": "paths", "remove": true}]}`,
+		"bracket": `{"overlay": "1.0.0", "actions": [{"target": "$.paths['/pets'", "remove": true}]}`,
+	}
+	for name, raw := range cases {
+		if _, err := pkgopenapi.ParseOverlay([]byte(raw)); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestOverlayLoaderAppliesOverlaySources(t *testing.T) {
+	dir := t.TempDir()
+	basePath := filepath.Join(dir, "pets.yaml")
+	overlayPath := filepath.Join(dir, "pets.overlay.yaml")
+	if err := os.WriteFile(basePath, []byte(overlayBaseDocument), 0o600); err != nil {
+		t.Fatalf("write base: %v", err)
+	}
+	if err := os.WriteFile(overlayPath, []byte(overlayDocument), 0o600); err != nil {
+		t.Fatalf("write overlay: %v", err)
+	}
+
+	loader := pkgopenapi.NewOverlayLoader(internalLoader.New(pkgopenapi.NewLoaderOptions()), pkgopenapi.SourceFromFile(overlayPath))
+	doc, err := loader.Load(context.Background(), pkgopenapi.SourceFromFile(basePath))
+	if err != nil {
+		t.Fatalf("load document: %v", err)
+	}
+	if !strings.Contains(string(doc.Raw()), `"placeholder":"Rex"`) {
+		t.Fatalf("expected overlay to be applied, got %s", doc.Raw())
+	}
+
+	missing := pkgopenapi.NewOverlayLoader(internalLoader.New(pkgopenapi.NewLoaderOptions()), pkgopenapi.SourceFromFile(filepath.Join(dir, "missing.yaml")))
+	if _, err := missing.Load(context.Background(), pkgopenapi.SourceFromFile(basePath)); err == nil {
+		t.Fatal("expected missing overlay to fail the load")
+	}
+}
+
+func TestNewLoaderAppliesOverlayOption(t *testing.T) {
+	dir := t.TempDir()
+	basePath := filepath.Join(dir, "pets.yaml")
+	overlayPath := filepath.Join(dir, "pets.overlay.yaml")
+	if err := os.WriteFile(basePath, []byte(overlayBaseDocument), 0o600); err != nil {
+		t.Fatalf("write base: %v", err)
+	}
+	if err := os.WriteFile(overlayPath, []byte(overlayDocument), 0o600); err != nil {
+		t.Fatalf("write overlay: %v", err)
+	}
+
+	loader := formgen.NewLoader(pkgopenapi.WithOverlays(pkgopenapi.SourceFromFile(overlayPath)))
+	doc, err := loader.Load(context.Background(), pkgopenapi.SourceFromFile(basePath))
+	if err != nil {
+		t.Fatalf("load document: %v", err)
+	}
+	raw := string(doc.Raw())
+	if !strings.Contains(raw, `"placeholder":"Rex"`) || strings.Contains(raw, "legacy") {
+		t.Fatalf("expected overlay updates and removals to be applied, got %s", raw)
+	}
+}
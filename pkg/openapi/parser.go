@@ -2,7 +2,15 @@ package openapi
 
 // Parser contracts bound to go-form-gen.md:304-357.
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strings"
+	"unicode"
+)
 
 // Parser normalises OpenAPI documents into operation wrappers that downstream
 // packages consume. See go-form-gen.md:82-159 for the unidirectional flow.
@@ -10,6 +18,39 @@ type Parser interface {
 	Operations(ctx context.Context, doc Document) (map[string]Operation, error)
 }
 
+// ErrOperationNotFound matches (via errors.Is) the error returned when a
+// document has no operation with the requested id.
+var ErrOperationNotFound = errors.New("openapi: operation not found")
+
+// OperationParser is implemented by parsers that can extract one operation
+// without converting the rest of the document, which is much cheaper for
+// servers rendering a single form per request from a large spec. Use
+// ParseOperation to take advantage of it when available.
+type OperationParser interface {
+	Operation(ctx context.Context, doc Document, operationID string) (Operation, error)
+}
+
+// ParseOperation returns the operation with operationID, using
+// OperationParser when parser implements it and Operations otherwise. Missing
+// operations match ErrOperationNotFound.
+func ParseOperation(ctx context.Context, parser Parser, doc Document, operationID string) (Operation, error) {
+	if parser == nil {
+		return Operation{}, errors.New("openapi: parser is nil")
+	}
+	if single, ok := parser.(OperationParser); ok {
+		return single.Operation(ctx, doc, operationID)
+	}
+	operations, err := parser.Operations(ctx, doc)
+	if err != nil {
+		return Operation{}, err
+	}
+	op, ok := operations[operationID]
+	if !ok {
+		return Operation{}, fmt.Errorf("openapi: operation %q: %w", operationID, ErrOperationNotFound)
+	}
+	return op, nil
+}
+
 // ParserOptions exposes toggles for future behaviour (e.g., dereferencing
 // $refs). For v1 most fields remain unused but documenting them up front keeps
 // progressive complexity low.
@@ -21,8 +62,72 @@ type ParserOptions struct {
 	// AllowPartialDocuments gates loading component-only inputs. Defaults to
 	// false per the README commitment to focus on full documents in v1.
 	AllowPartialDocuments bool
+
+	// OperationIDNormalizer rewrites operation identifiers after extraction so
+	// unstable generator output (e.g. "post-book:create") can be exposed under
+	// stable names. Returning an empty string keeps the original identifier.
+	OperationIDNormalizer OperationIDNormalizer
+
+	// OperationIDAliases maps raw operation identifiers (the spec operationId or
+	// the "method:path" fallback) to the names callers and UI schema files use.
+	// Aliases take precedence over OperationIDNormalizer.
+	OperationIDAliases map[string]string
+
+	// ExtensionNamespaces lists vendor extension prefixes (e.g. "x-acme-ui")
+	// rewritten onto x-formgen during parsing, highest precedence first.
+	// x-formgen stays the lowest-precedence fallback unless listed explicitly.
+	ExtensionNamespaces []string
+
+	// OperationTransforms run, in order, on every operation after parsing and
+	// before model building. See WithOperationTransform.
+	OperationTransforms []OperationTransform
+
+	// ExternalRefs guards how external $refs are resolved when
+	// ResolveReferences is enabled. See WithExternalRefs.
+	ExternalRefs ExternalRefOptions
+
+	// Concurrency bounds the workers converting path items in parallel. Zero
+	// or one converts them sequentially. See WithConcurrency.
+	Concurrency int
+
+	// LazyResponseSchemas defers converting response schemas until they are
+	// read through Operation.ResponseSchemas. See WithLazyResponseSchemas.
+	LazyResponseSchemas bool
+}
+
+// ExternalRefOptions configures resolution of components referenced across a
+// workspace of documents (e.g. "$ref: ./schemas/pet.yaml#/Pet"). Relative refs
+// resolve against the referencing document's source: its directory for file
+// sources, its path inside FileSystem for fs.FS sources, and its URL for URL
+// sources. In-memory sources cannot reference other documents. Zero limits
+// fall back to the JSON Schema resolver defaults (5 MiB per document, 128
+// documents); cycles are handled by the parser.
+type ExternalRefOptions struct {
+	// FileSystem serves referenced documents for fs.FS sources. Use the same
+	// filesystem given to the loader.
+	FileSystem fs.FS
+	// HTTPClient fetches URL refs when AllowHTTPRefs is true; defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+	// AllowHTTPRefs toggles HTTP/HTTPS ref resolution.
+	AllowHTTPRefs bool
+	// AllowPathTraversal permits refs to escape the root document's directory.
+	AllowPathTraversal bool
+	// MaxDocumentBytes caps the size of the root and any referenced document.
+	MaxDocumentBytes int64
+	// MaxDocuments caps the number of referenced documents loaded per parse.
+	MaxDocuments int
 }
 
+// OperationTransform patches a parsed operation, e.g. to inject extensions,
+// fix summaries, or add relationship endpoints for specs the caller does not
+// control. The returned operation replaces the input.
+type OperationTransform func(op Operation) Operation
+
+// OperationIDNormalizer derives the exposed operation identifier from the HTTP
+// method, path template, and raw identifier of an operation.
+type OperationIDNormalizer func(method, path, rawID string) string
+
 // ParserOption mutates ParserOptions during construction.
 type ParserOption func(*ParserOptions)
 
@@ -41,6 +146,142 @@ func WithPartialDocuments(enabled bool) ParserOption {
 	}
 }
 
+// WithOperationIDNormalizer installs a function that rewrites operation
+// identifiers during parsing.
+func WithOperationIDNormalizer(normalizer OperationIDNormalizer) ParserOption {
+	return func(opts *ParserOptions) {
+		opts.OperationIDNormalizer = normalizer
+	}
+}
+
+// WithOperationIDAliases registers raw→stable operation identifier aliases.
+// Repeated calls merge, with later entries winning.
+func WithOperationIDAliases(aliases map[string]string) ParserOption {
+	return func(opts *ParserOptions) {
+		if len(aliases) == 0 {
+			return
+		}
+		if opts.OperationIDAliases == nil {
+			opts.OperationIDAliases = make(map[string]string, len(aliases))
+		}
+		for raw, alias := range aliases {
+			raw = strings.TrimSpace(raw)
+			alias = strings.TrimSpace(alias)
+			if raw == "" || alias == "" {
+				continue
+			}
+			opts.OperationIDAliases[raw] = alias
+		}
+	}
+}
+
+// WithExtensionNamespace reads UI extensions from the supplied vendor prefixes
+// in addition to x-formgen. Namespaces are listed highest precedence first and
+// repeated calls append.
+func WithExtensionNamespace(namespaces ...string) ParserOption {
+	return func(opts *ParserOptions) {
+		opts.ExtensionNamespaces = append(opts.ExtensionNamespaces, namespaces...)
+	}
+}
+
+// WithOperationTransform appends post-parse operation transforms. Transforms
+// run in registration order after operation IDs are resolved; changes to the
+// operation ID are ignored so lookups stay stable (use WithOperationIDAliases
+// to rename operations).
+func WithOperationTransform(transforms ...OperationTransform) ParserOption {
+	return func(opts *ParserOptions) {
+		for _, transform := range transforms {
+			if transform != nil {
+				opts.OperationTransforms = append(opts.OperationTransforms, transform)
+			}
+		}
+	}
+}
+
+// WithExternalRefs configures the workspace used to resolve external $refs.
+func WithExternalRefs(options ExternalRefOptions) ParserOption {
+	return func(opts *ParserOptions) {
+		opts.ExternalRefs = options
+	}
+}
+
+// WithConcurrency converts path items on up to workers goroutines, which
+// speeds up documents with hundreds of operations. Output does not depend on
+// the worker count: path items are merged in path order and
+// OperationTransforms still run one operation at a time. Zero or one keeps
+// conversion sequential.
+func WithConcurrency(workers int) ParserOption {
+	return func(opts *ParserOptions) {
+		opts.Concurrency = workers
+	}
+}
+
+// WithLazyResponseSchemas toggles deferred response schema conversion.
+// Operations then leave Responses nil and convert them on the first
+// Operation.ResponseSchemas call, so response schemas of operations that are
+// never built or rendered cost nothing. Unresolved operations keep the parsed
+// document alive. Registering OperationTransforms resolves them eagerly so
+// transforms see the full operation.
+func WithLazyResponseSchemas(enabled bool) ParserOption {
+	return func(opts *ParserOptions) {
+		opts.LazyResponseSchemas = enabled
+	}
+}
+
+// TransformOperation applies the configured OperationTransforms to op. Parser
+// implementations should call it once per extracted operation.
+func (opts ParserOptions) TransformOperation(op Operation) Operation {
+	if len(opts.OperationTransforms) == 0 {
+		return op
+	}
+	id := op.ID
+	op.Responses = op.ResponseSchemas()
+	for _, transform := range opts.OperationTransforms {
+		op = transform(op)
+	}
+	op.ID = id
+	return op
+}
+
+// ResolveOperationID applies the configured aliases and normalizer to a raw
+// operation identifier. Parser implementations should call it so every parser
+// honours the same rules.
+func (opts ParserOptions) ResolveOperationID(method, path, rawID string) string {
+	if alias := strings.TrimSpace(opts.OperationIDAliases[rawID]); alias != "" {
+		return alias
+	}
+	if opts.OperationIDNormalizer != nil {
+		if normalized := strings.TrimSpace(opts.OperationIDNormalizer(method, path, rawID)); normalized != "" {
+			return normalized
+		}
+	}
+	return rawID
+}
+
+// CamelCaseOperationID is an OperationIDNormalizer that collapses separators
+// in raw identifiers into lower camel case ("post-book:create" becomes
+// "postBookCreate", "get:/books/{id}" becomes "getBooksId").
+func CamelCaseOperationID(_, _, rawID string) string {
+	words := strings.FieldsFunc(rawID, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+	var builder strings.Builder
+	builder.Grow(len(rawID))
+	for idx, word := range words {
+		runes := []rune(word)
+		if idx == 0 {
+			runes[0] = unicode.ToLower(runes[0])
+		} else {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		builder.WriteString(string(runes))
+	}
+	return builder.String()
+}
+
 // NewParserOptions applies ParserOption functions and returns the resulting
 // configuration. Implementations under internal/openapi should call this helper
 // to remain consistent.
@@ -0,0 +1,41 @@
+package openapi_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	formgen "github.com/goliatone/go-formgen"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+type mapParser map[string]pkgopenapi.Operation
+
+func (p mapParser) Operations(context.Context, pkgopenapi.Document) (map[string]pkgopenapi.Operation, error) {
+	return p, nil
+}
+
+func TestParseOperation(t *testing.T) {
+	ctx := context.Background()
+	doc := testsupport.LoadDocument(t, filepath.Join("..", "..", "examples", "fixtures", "petstore.json"))
+
+	if _, ok := formgen.NewParser().(pkgopenapi.OperationParser); !ok {
+		t.Fatalf("expected the default parser to extract single operations")
+	}
+	op, err := pkgopenapi.ParseOperation(ctx, formgen.NewParser(), doc, "createPet")
+	if err != nil || op.ID != "createPet" || op.Method != "POST" {
+		t.Fatalf("unexpected operation %+v (%v)", op, err)
+	}
+
+	fallback := mapParser{"listPets": pkgopenapi.MustNewOperation("listPets", "GET", "/pets", pkgopenapi.Schema{}, nil)}
+	if op, err := pkgopenapi.ParseOperation(ctx, fallback, doc, "listPets"); err != nil || op.Path != "/pets" {
+		t.Fatalf("unexpected fallback operation %+v (%v)", op, err)
+	}
+	for _, parser := range []pkgopenapi.Parser{formgen.NewParser(), fallback} {
+		if _, err := pkgopenapi.ParseOperation(ctx, parser, doc, "missing"); !errors.Is(err, pkgopenapi.ErrOperationNotFound) {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	}
+}
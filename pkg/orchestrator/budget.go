@@ -0,0 +1,47 @@
+package orchestrator
+
+import (
+	"context"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// BudgetWarning describes a built form whose complexity exceeded the budget
+// configured with WithComplexityBudget.
+type BudgetWarning struct {
+	OperationID string
+	Stats       model.FormStats
+	Violations  []model.BudgetViolation
+}
+
+// BudgetWarningHandler receives complexity budget warnings.
+type BudgetWarningHandler func(context.Context, BudgetWarning)
+
+// WithComplexityBudget checks every built form model against budget and calls
+// handler when a limit is exceeded. Builds still succeed; the handler decides
+// whether to log, emit metrics, or fail a CI job.
+func WithComplexityBudget(budget model.StatsBudget, handler BudgetWarningHandler) Option {
+	return func(o *Orchestrator) {
+		if handler == nil || budget.Empty() {
+			return
+		}
+		o.complexityBudget = budget
+		o.budgetHandler = handler
+	}
+}
+
+func (o *Orchestrator) checkComplexityBudget(ctx context.Context, form model.FormModel) {
+	if o.budgetHandler == nil {
+		return
+	}
+	stats := model.Stats(form)
+	violations := o.complexityBudget.Check(stats)
+	if len(violations) == 0 {
+		return
+	}
+	o.budgetHandler(ctx, BudgetWarning{
+		OperationID: form.OperationID,
+		Stats:       stats,
+		Violations:  violations,
+	})
+}
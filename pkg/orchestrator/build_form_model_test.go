@@ -1,15 +1,19 @@
 package orchestrator_test
 
 import (
+	"context"
+	"errors"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	internalparser "github.com/goliatone/go-formgen/internal/openapi/parser"
 	"github.com/goliatone/go-formgen/pkg/jsonschema"
 	"github.com/goliatone/go-formgen/pkg/model"
 	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
 	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
 	"github.com/goliatone/go-formgen/pkg/schema"
 	"github.com/goliatone/go-formgen/pkg/testsupport"
 	"github.com/goliatone/go-formgen/pkg/visibility"
@@ -121,6 +125,183 @@ func TestBuildFormModel_FormNotFoundListsAvailableForms(t *testing.T) {
 	if !strings.Contains(err.Error(), "available: createPet") {
 		t.Fatalf("missing available forms in error: %v", err)
 	}
+	if !errors.Is(err, orchestrator.ErrFormNotFound) {
+		t.Fatalf("expected ErrFormNotFound, got %v", err)
+	}
+}
+
+const uploadDocument = `openapi: 3.0.0
+info:
+  title: Uploads
+  version: 1.0.0
+paths:
+  /avatars:
+    post:
+      operationId: uploadAvatar
+      requestBody:
+        content:
+          multipart/form-data:
+            schema:
+              type: object
+              properties:
+                caption:
+                  type: string
+                file:
+                  type: string
+                  format: binary
+                attachments:
+                  type: array
+                  items:
+                    type: string
+                    format: binary
+            encoding:
+              file:
+                contentType: image/png, image/jpeg
+              attachments:
+                headers:
+                  X-Checksum:
+                    schema:
+                      type: string
+          application/json:
+            schema:
+              type: object
+              properties:
+                caption:
+                  type: string
+                url:
+                  type: string
+                  format: uri
+      responses:
+        "201":
+          description: Created
+        "422":
+          description: Validation failed
+          content:
+            application/problem+json:
+              schema:
+                type: object
+                properties:
+                  detail:
+                    type: string
+                  errors:
+                    type: array
+                    items:
+                      type: object
+                      properties:
+                        field:
+                          type: string
+                        message:
+                          type: string
+`
+
+func TestBuildFormModel_SingleOperationParsingConvertsOnlyRequestedOperation(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("batch.yaml"), []byte(batchDocument))
+	var converted []string
+	parser := internalparser.New(pkgopenapi.NewParserOptions(
+		pkgopenapi.WithOperationTransform(func(op pkgopenapi.Operation) pkgopenapi.Operation {
+			converted = append(converted, op.ID)
+			return op
+		}),
+	))
+	orch := orchestrator.New(
+		orchestrator.WithParser(parser),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithSingleOperationParsing(true),
+	)
+
+	form, err := orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{Document: &doc, OperationID: "updatePet"})
+	if err != nil {
+		t.Fatalf("build form model: %v", err)
+	}
+	if form.OperationID != "updatePet" || strings.Join(converted, ",") != "updatePet" {
+		t.Fatalf("expected only updatePet to be converted, got %q (converted %v)", form.OperationID, converted)
+	}
+
+	converted = nil
+	_, err = orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{Document: &doc, OperationID: "missing"})
+	if !errors.Is(err, orchestrator.ErrFormNotFound) || !strings.Contains(err.Error(), "createPet") {
+		t.Fatalf("expected not found error listing available forms, got %v", err)
+	}
+}
+
+func TestBuildFormModel_MediaTypeSelectsRequestBodyVariant(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("uploads.yaml"), []byte(uploadDocument))
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithDefaultRenderer("vanilla"),
+		orchestrator.WithUISchemaFS(nil),
+	)
+
+	form, err := orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{
+		Document:    &doc,
+		OperationID: "uploadAvatar",
+	})
+	if err != nil {
+		t.Fatalf("BuildFormModel: %v", err)
+	}
+	if form.MediaType != "application/json" || strings.Join(fieldNames(form.Fields), ",") != "caption,url" {
+		t.Fatalf("expected json variant by default, got %q %v", form.MediaType, fieldNames(form.Fields))
+	}
+
+	form, err = orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{
+		Document:    &doc,
+		OperationID: "uploadAvatar",
+		MediaType:   "Multipart/Form-Data; boundary=x",
+	})
+	if err != nil {
+		t.Fatalf("BuildFormModel multipart: %v", err)
+	}
+	if form.MediaType != "multipart/form-data" || strings.Join(fieldNames(form.Fields), ",") != "attachments,caption,file" {
+		t.Fatalf("expected multipart variant, got %q %v", form.MediaType, fieldNames(form.Fields))
+	}
+
+	file := findBuildField(form.Fields, "file")
+	if encoding, ok := file.Encoding(); !ok || encoding.ContentType != "image/png, image/jpeg" {
+		t.Fatalf("expected file part encoding, got %+v", file.Metadata)
+	}
+	if file.UIHints["inputType"] != "file" || file.UIHints["accept"] != "image/png,image/jpeg" {
+		t.Fatalf("expected file input accepting the part content types, got %+v", file.UIHints)
+	}
+	mappings, ok := form.ErrorMappings()
+	if !ok || len(mappings) != 1 || mappings[0].Path != "errors" || mappings[0].FieldKey != "field" || mappings[0].FormMessageKey != "detail" {
+		t.Fatalf("expected 422 error mapping, got %+v", mappings)
+	}
+	attachments := findBuildField(form.Fields, "attachments")
+	if attachments.UIHints["inputType"] != "file" || attachments.UIHints["multiple"] != "true" {
+		t.Fatalf("expected multiple file input for binary array, got %+v", attachments.UIHints)
+	}
+	if attachments.Metadata["encoding.headers"] != "X-Checksum" {
+		t.Fatalf("expected part header names in metadata, got %+v", attachments.Metadata)
+	}
+
+	_, err = orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{
+		Document:    &doc,
+		OperationID: "uploadAvatar",
+		MediaType:   "application/xml",
+	})
+	if err == nil || !strings.Contains(err.Error(), "available: application/json, multipart/form-data") {
+		t.Fatalf("expected unsupported media type error, got %v", err)
+	}
+
+	html, err := orch.Generate(testsupport.Context(), orchestrator.Request{
+		Document:    &doc,
+		OperationID: "uploadAvatar",
+		MediaType:   "multipart/form-data",
+	})
+	if err != nil {
+		t.Fatalf("Generate multipart: %v", err)
+	}
+	for _, want := range []string{`enctype="multipart/form-data"`, `type="file"`, "multiple", `accept="image/png,image/jpeg"`, "data-formgen-error-mapping="} {
+		if !strings.Contains(string(html), want) {
+			t.Fatalf("expected %s on rendered multipart form", want)
+		}
+	}
 }
 
 func TestOrchestratorImportGraphIsHeadless(t *testing.T) {
@@ -180,3 +361,33 @@ func findBuildField(fields []model.Field, name string) *model.Field {
 	}
 	return nil
 }
+
+func TestBuildFormModel_ComplexityBudgetWarning(t *testing.T) {
+	t.Parallel()
+
+	var warnings []orchestrator.BudgetWarning
+	orch := orchestrator.New(
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithComplexityBudget(model.StatsBudget{MaxFields: 1}, func(_ context.Context, warning orchestrator.BudgetWarning) {
+			warnings = append(warnings, warning)
+		}),
+	)
+	form, err := orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{
+		Source:      pkgopenapi.SourceFromFile(filepath.Join("testdata", "petstore.yaml")),
+		OperationID: "createPet",
+	})
+	if err != nil {
+		t.Fatalf("BuildFormModel: %v", err)
+	}
+
+	if len(warnings) != 1 {
+		t.Fatalf("expected one budget warning, got %d", len(warnings))
+	}
+	warning := warnings[0]
+	if warning.OperationID != "createPet" || warning.Stats != model.Stats(form) {
+		t.Fatalf("unexpected warning %+v", warning)
+	}
+	if len(warning.Violations) != 1 || warning.Violations[0].Metric != "fields" {
+		t.Fatalf("expected fields violation, got %+v", warning.Violations)
+	}
+}
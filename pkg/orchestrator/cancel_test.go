@@ -0,0 +1,50 @@
+package orchestrator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestGenerateStopsWhenContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("batch.yaml"), []byte(batchDocument))
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+
+	ctx, cancel := context.WithCancel(testsupport.Context())
+	defer cancel()
+	rendered := false
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithUISchemaFS(nil),
+		// Simulate load shedding arriving while the model is decorated.
+		orchestrator.WithUIDecorators(model.DecoratorFunc(func(*model.FormModel) error {
+			cancel()
+			return nil
+		})),
+		orchestrator.WithRenderOptionsResolver(func(_ context.Context, _ orchestrator.Request, _ model.FormModel, options render.RenderOptions) (render.RenderOptions, error) {
+			rendered = true
+			return options, nil
+		}),
+	)
+
+	_, err := orch.Generate(ctx, orchestrator.Request{Document: &doc, OperationID: "createPet"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if rendered {
+		t.Fatal("expected rendering to be skipped after cancellation")
+	}
+
+	if _, err := orch.GenerateAll(ctx, orchestrator.Request{Document: &doc}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected GenerateAll to stop, got %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+)
+
+// Coverage builds every form in an OpenAPI document and reports which schema
+// keywords the builder honoured and which it dropped or ignored, so teams can
+// judge how faithful the generated forms are to the spec. Forms that fail to
+// build are recorded in the report's Failures instead of aborting the run;
+// req.OperationID is ignored. Non-OpenAPI formats are rejected.
+func (o *Orchestrator) Coverage(ctx context.Context, req BuildRequest, opts ...pkgopenapi.CoverageOption) (pkgopenapi.CoverageReport, error) {
+	if err := o.ensureReady(ctx); err != nil {
+		return pkgopenapi.CoverageReport{}, err
+	}
+
+	start := time.Now()
+	adapter, err := o.resolveAdapter(ctx, req)
+	if err != nil {
+		return pkgopenapi.CoverageReport{}, err
+	}
+	if adapter.Name() != pkgopenapi.DefaultAdapterName {
+		return pkgopenapi.CoverageReport{}, fmt.Errorf("orchestrator: coverage requires an OpenAPI document, got format %q", adapter.Name())
+	}
+	doc, err := o.resolveSchemaDocument(ctx, req, adapter)
+	if err != nil {
+		return pkgopenapi.CoverageReport{}, err
+	}
+	o.observeStage(ctx, StageLoad, start)
+
+	start = time.Now()
+	ir, err := adapter.Normalize(ctx, doc, req.NormalizeOptions)
+	if err != nil {
+		return pkgopenapi.CoverageReport{}, fmt.Errorf("orchestrator: normalize schema: %w", err)
+	}
+	o.observeStage(ctx, StageParse, start)
+
+	ids := make([]string, 0, len(ir.Forms))
+	for id := range ir.Forms {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	failures := make(map[string]string)
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return pkgopenapi.CoverageReport{}, err
+		}
+		formBuild := req
+		formBuild.OperationID = id
+		formModel, err := o.buildFormModelFromIR(ctx, ir.Forms[id], ir, formBuild)
+		if err == nil {
+			err = o.decorateFormModel(ctx, formBuild, ir, &formModel)
+		}
+		if err != nil && !errors.Is(err, ErrFeatureDisabled) {
+			failures[id] = err.Error()
+		}
+	}
+
+	report, err := pkgopenapi.Coverage(doc.Raw(), opts...)
+	if err != nil {
+		return pkgopenapi.CoverageReport{}, fmt.Errorf("orchestrator: %w", err)
+	}
+	if len(failures) > 0 {
+		report.Failures = failures
+	}
+	return report, nil
+}
@@ -0,0 +1,37 @@
+package orchestrator_test
+
+import (
+	"strings"
+	"testing"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/schema"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestCoverageReportsKeywords(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("batch.yaml"), []byte(batchDocument))
+	orch := orchestrator.New(orchestrator.WithUISchemaFS(nil))
+
+	report, err := orch.Coverage(testsupport.Context(), orchestrator.BuildRequest{Document: &doc})
+	if err != nil {
+		t.Fatalf("Coverage: %v", err)
+	}
+	if report.Operations != 3 || len(report.Failures) != 0 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if len(report.ByStatus(pkgopenapi.KeywordUnsupported)) != 0 {
+		t.Fatalf("expected no unsupported keywords, got %+v", report.Keywords)
+	}
+
+	_, err = orch.Coverage(testsupport.Context(), orchestrator.BuildRequest{
+		RawJSONSchema: []byte(`{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"object"}`),
+		Source:        schema.SourceFromBytes("inline.json"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "requires an OpenAPI document") {
+		t.Fatalf("expected non-OpenAPI documents to be rejected, got %v", err)
+	}
+}
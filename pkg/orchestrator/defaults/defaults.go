@@ -86,16 +86,21 @@ func WithTheme(selector theme.ThemeSelector, fallbacks map[string]string) orches
 // by compatibility theme helpers.
 func DefaultThemeFallbacks() map[string]string {
 	return map[string]string{
-		"forms.form":          "templates/form.tmpl",
-		"forms.input":         "templates/components/input.tmpl",
-		"forms.select":        "templates/components/select.tmpl",
-		"forms.checkbox":      "templates/components/boolean.tmpl",
-		"forms.radio":         "templates/components/boolean.tmpl",
-		"forms.textarea":      "templates/components/textarea.tmpl",
-		"forms.media-picker":  "templates/components/media_picker.tmpl",
-		"forms.wysiwyg":       "templates/components/wysiwyg.tmpl",
-		"forms.json-editor":   "templates/components/json_editor.tmpl",
-		"forms.file-uploader": "templates/components/file_uploader.tmpl",
+		"forms.form":           "templates/form.tmpl",
+		"forms.input":          "templates/components/input.tmpl",
+		"forms.select":         "templates/components/select.tmpl",
+		"forms.checkbox":       "templates/components/boolean.tmpl",
+		"forms.radio":          "templates/components/boolean.tmpl",
+		"forms.radio-group":    "templates/components/radio_group.tmpl",
+		"forms.checkbox-group": "templates/components/checkbox_group.tmpl",
+		"forms.daterange":      "templates/components/daterange.tmpl",
+		"forms.duration":       "templates/components/duration.tmpl",
+		"forms.textarea":       "templates/components/textarea.tmpl",
+		"forms.timezone":       "templates/components/select.tmpl",
+		"forms.media-picker":   "templates/components/media_picker.tmpl",
+		"forms.wysiwyg":        "templates/components/wysiwyg.tmpl",
+		"forms.json-editor":    "templates/components/json_editor.tmpl",
+		"forms.file-uploader":  "templates/components/file_uploader.tmpl",
 	}
 }
 
@@ -6,7 +6,9 @@ import (
 	"maps"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
 )
@@ -27,6 +29,20 @@ type EndpointConfig struct {
 	Mapping       EndpointMapping
 	Auth          *EndpointAuth
 	SubmitAs      string
+	// RefreshIntervalMs re-fetches options periodically when positive.
+	RefreshIntervalMs int
+	// LabelTemplate composes option labels from several item fields, e.g.
+	// "{{item.first}} {{item.last}}". It takes precedence over LabelField.
+	LabelTemplate string
+	// Cache controls how runtimes reuse fetched options.
+	Cache EndpointCache
+}
+
+// EndpointCache configures relationship option caching. Strategy is one of
+// "memory", "session" or "none"; a zero TTL keeps the runtime default.
+type EndpointCache struct {
+	TTL      time.Duration
+	Strategy string
 }
 
 // EndpointMapping remaps response payload structures (value/label paths).
@@ -116,6 +132,11 @@ func validateEndpointOverride(override EndpointOverride) error {
 	if strings.TrimSpace(override.Endpoint.URL) == "" {
 		return fmt.Errorf("orchestrator: endpoint override %q for %s missing endpoint url", override.OperationID, override.FieldPath)
 	}
+	switch strings.ToLower(strings.TrimSpace(override.Endpoint.Cache.Strategy)) {
+	case "", "memory", "session", "none":
+	default:
+		return fmt.Errorf("orchestrator: endpoint override %q for %s has unknown cache strategy %q", override.OperationID, override.FieldPath, override.Endpoint.Cache.Strategy)
+	}
 	return nil
 }
 
@@ -241,12 +262,20 @@ func flattenEndpointConfig(cfg EndpointConfig) map[string]string {
 		add("relationship.endpoint.method", strings.ToUpper(cfg.Method))
 	}
 	add("relationship.endpoint.labelField", strings.TrimSpace(cfg.LabelField))
+	add("relationship.endpoint.labelTemplate", strings.TrimSpace(cfg.LabelTemplate))
 	add("relationship.endpoint.valueField", strings.TrimSpace(cfg.ValueField))
 	add("relationship.endpoint.resultsPath", strings.TrimSpace(cfg.ResultsPath))
 	add("relationship.endpoint.mode", strings.TrimSpace(cfg.Mode))
 	add("relationship.endpoint.searchParam", strings.TrimSpace(cfg.SearchParam))
 	add("relationship.endpoint.hydrateParam", strings.TrimSpace(cfg.HydrateParam))
 	add("relationship.endpoint.submitAs", strings.TrimSpace(cfg.SubmitAs))
+	if cfg.RefreshIntervalMs > 0 {
+		add("relationship.endpoint.refreshIntervalMs", strconv.Itoa(cfg.RefreshIntervalMs))
+	}
+	if ttl := cfg.Cache.TTL.Milliseconds(); ttl > 0 {
+		add("relationship.endpoint.cache.ttl", strconv.FormatInt(ttl, 10))
+	}
+	add("relationship.endpoint.cache.strategy", strings.ToLower(strings.TrimSpace(cfg.Cache.Strategy)))
 
 	if len(cfg.Params) > 0 {
 		for _, key := range sortedKeys(cfg.Params) {
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -31,13 +32,15 @@ func TestWithEndpointOverrides_AppliesMetadata(t *testing.T) {
 		OperationID: "createArticle",
 		FieldPath:   "author_id",
 		Endpoint: orchestrator.EndpointConfig{
-			URL:          "/api/authors",
-			Method:       "get",
-			LabelField:   "full_name",
-			ValueField:   "id",
-			Mode:         "search",
-			SearchParam:  "q",
-			HydrateParam: "ids",
+			URL:               "/api/authors",
+			Method:            "get",
+			LabelField:        "full_name",
+			ValueField:        "id",
+			Mode:              "search",
+			SearchParam:       "q",
+			HydrateParam:      "ids",
+			RefreshIntervalMs: 30000,
+			Cache:             orchestrator.EndpointCache{TTL: 2 * time.Minute, Strategy: "Session"},
 			Params: map[string]string{
 				"include": "profile",
 			},
@@ -101,6 +104,15 @@ func TestWithEndpointOverrides_AppliesMetadata(t *testing.T) {
 	if got := field.Metadata["relationship.endpoint.hydrateParam"]; got != "ids" {
 		t.Fatalf("relationship.endpoint.hydrateParam = %q, want %q", got, "ids")
 	}
+	if got := field.Metadata["relationship.endpoint.refreshIntervalMs"]; got != "30000" {
+		t.Fatalf("relationship.endpoint.refreshIntervalMs = %q, want %q", got, "30000")
+	}
+	if got := field.Metadata["relationship.endpoint.cache.ttl"]; got != "120000" {
+		t.Fatalf("relationship.endpoint.cache.ttl = %q, want %q", got, "120000")
+	}
+	if got := field.Metadata["relationship.endpoint.cache.strategy"]; got != "session" {
+		t.Fatalf("relationship.endpoint.cache.strategy = %q, want %q", got, "session")
+	}
 	if got := field.Metadata["relationship.endpoint.params.include"]; got != "profile" {
 		t.Fatalf("relationship.endpoint.params.include = %q, want %q", got, "profile")
 	}
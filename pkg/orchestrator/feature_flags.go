@@ -0,0 +1,164 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	// FeatureFlagMetadataKey holds the flag guarding an operation or field,
+	// declared as x-formgen: {flag: "new-billing"}.
+	FeatureFlagMetadataKey = "flag"
+	// FeatureFlagFallbackKey selects what happens when the flag is disabled:
+	// FeatureFlagFallbackDrop (the default) or FeatureFlagFallbackPlaceholder.
+	FeatureFlagFallbackKey = "flagFallback"
+	// FeatureFlagMessageKey overrides the placeholder text.
+	FeatureFlagMessageKey = "flagMessage"
+	// FeatureFlagPlaceholderKey is set on operations and fields rendered as
+	// placeholders. Its value is the placeholder text.
+	FeatureFlagPlaceholderKey = "flag.placeholder"
+
+	FeatureFlagFallbackDrop        = "drop"
+	FeatureFlagFallbackPlaceholder = "placeholder"
+
+	// DefaultFeatureFlagMessage is the placeholder text used when no
+	// flagMessage is declared.
+	DefaultFeatureFlagMessage = "Coming soon"
+)
+
+// ErrFeatureDisabled matches (via errors.Is) the error returned when an
+// operation is gated by a disabled feature flag and has no placeholder
+// fallback. GenerateAll skips such operations.
+var ErrFeatureDisabled = errors.New("orchestrator: operation disabled by feature flag")
+
+// FeatureFlagProvider reports whether a feature flag is enabled. The context
+// is the one passed to Generate/BuildFormModel, so providers can evaluate
+// flags per user or tenant.
+type FeatureFlagProvider interface {
+	Enabled(ctx context.Context, flag string) (bool, error)
+}
+
+// FeatureFlagFunc adapts a function to FeatureFlagProvider.
+type FeatureFlagFunc func(ctx context.Context, flag string) (bool, error)
+
+// Enabled implements FeatureFlagProvider.
+func (f FeatureFlagFunc) Enabled(ctx context.Context, flag string) (bool, error) {
+	return f(ctx, flag)
+}
+
+// WithFeatureFlags gates operations and fields that declare a flag. Disabled
+// fields are dropped or rendered as disabled "coming soon" placeholders;
+// disabled operations fail with ErrFeatureDisabled or render with every field
+// as a placeholder. Without a provider, flags are ignored.
+func WithFeatureFlags(provider FeatureFlagProvider) Option {
+	return func(o *Orchestrator) {
+		o.featureFlags = provider
+	}
+}
+
+func (o *Orchestrator) applyFeatureFlags(ctx context.Context, form *model.FormModel) error {
+	if o.featureFlags == nil || form == nil {
+		return nil
+	}
+	enabled, err := o.flagEnabled(ctx, form.Metadata)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		if !flagPlaceholder(form.Metadata) {
+			return fmt.Errorf("%w: %q (flag %q)", ErrFeatureDisabled, form.OperationID, form.Metadata[FeatureFlagMetadataKey])
+		}
+		message := flagMessage(form.Metadata)
+		form.Metadata = withMetadata(form.Metadata, FeatureFlagPlaceholderKey, message)
+		form.Fields = placeholderFields(form.Fields, message)
+		return nil
+	}
+	fields, err := o.gateFields(ctx, form.Fields)
+	if err != nil {
+		return err
+	}
+	form.Fields = fields
+	return nil
+}
+
+func (o *Orchestrator) gateFields(ctx context.Context, fields []model.Field) ([]model.Field, error) {
+	if len(fields) == 0 {
+		return fields, nil
+	}
+	result := make([]model.Field, 0, len(fields))
+	for _, field := range fields {
+		enabled, err := o.flagEnabled(ctx, field.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			if flagPlaceholder(field.Metadata) {
+				result = append(result, placeholderField(field, flagMessage(field.Metadata)))
+			}
+			continue
+		}
+		if field.Nested, err = o.gateFields(ctx, field.Nested); err != nil {
+			return nil, err
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+func (o *Orchestrator) flagEnabled(ctx context.Context, metadata map[string]string) (bool, error) {
+	flag := strings.TrimSpace(metadata[FeatureFlagMetadataKey])
+	if flag == "" {
+		return true, nil
+	}
+	enabled, err := o.featureFlags.Enabled(ctx, flag)
+	if err != nil {
+		return false, fmt.Errorf("orchestrator: feature flag %q: %w", flag, err)
+	}
+	return enabled, nil
+}
+
+func flagPlaceholder(metadata map[string]string) bool {
+	return strings.EqualFold(strings.TrimSpace(metadata[FeatureFlagFallbackKey]), FeatureFlagFallbackPlaceholder)
+}
+
+func flagMessage(metadata map[string]string) string {
+	if message := strings.TrimSpace(metadata[FeatureFlagMessageKey]); message != "" {
+		return message
+	}
+	return DefaultFeatureFlagMessage
+}
+
+func placeholderFields(fields []model.Field, message string) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	result := make([]model.Field, len(fields))
+	for i, field := range fields {
+		result[i] = placeholderField(field, message)
+	}
+	return result
+}
+
+// placeholderField disables a field and its children and clears the
+// constraints so the placeholder cannot block or shape a submission.
+func placeholderField(field model.Field, message string) model.Field {
+	field.Disabled = true
+	field.Required = false
+	field.Validations = nil
+	field.Metadata = withMetadata(field.Metadata, FeatureFlagPlaceholderKey, message)
+	field.UIHints = withMetadata(field.UIHints, "helpText", message)
+	field.Nested = placeholderFields(field.Nested, message)
+	return field
+}
+
+func withMetadata(src map[string]string, key, value string) map[string]string {
+	out := make(map[string]string, len(src)+1)
+	maps.Copy(out, src)
+	out[key] = value
+	return out
+}
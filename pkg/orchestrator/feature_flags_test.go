@@ -0,0 +1,138 @@
+package orchestrator_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+const featureFlagDocument = `openapi: 3.0.0
+info:
+  title: Flags
+  version: 1.0.0
+paths:
+  /accounts:
+    post:
+      operationId: createAccount
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [name, billing_plan]
+              properties:
+                name:
+                  type: string
+                billing_plan:
+                  type: string
+                  x-formgen:
+                    flag: new-billing
+                    flagFallback: placeholder
+                    flagMessage: Billing plans arrive in Q3
+                invoice_email:
+                  type: string
+                  x-formgen:
+                    flag: new-billing
+      responses:
+        "201":
+          description: Created
+  /invoices:
+    post:
+      operationId: createInvoice
+      x-formgen:
+        flag: invoicing
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                amount:
+                  type: number
+      responses:
+        "201":
+          description: Created
+`
+
+func featureFlagOrchestrator(t *testing.T, enabled map[string]bool) *orchestrator.Orchestrator {
+	t.Helper()
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	return orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithFeatureFlags(orchestrator.FeatureFlagFunc(func(_ context.Context, flag string) (bool, error) {
+			return enabled[flag], nil
+		})),
+	)
+}
+
+func TestFeatureFlagsGateFields(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("flags.yaml"), []byte(featureFlagDocument))
+	orch := featureFlagOrchestrator(t, nil)
+
+	form, err := orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{Document: &doc, OperationID: "createAccount"})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	names := make([]string, 0, len(form.Fields))
+	var plan model.Field
+	for _, field := range form.Fields {
+		names = append(names, field.Name)
+		if field.Name == "billing_plan" {
+			plan = field
+		}
+	}
+	if strings.Join(names, ",") != "billing_plan,name" {
+		t.Fatalf("expected invoice_email dropped, got %v", names)
+	}
+	if !plan.Disabled || plan.Required || plan.Metadata[orchestrator.FeatureFlagPlaceholderKey] != "Billing plans arrive in Q3" {
+		t.Fatalf("expected billing_plan placeholder, got %+v", plan)
+	}
+
+	output, err := orch.Generate(testsupport.Context(), orchestrator.Request{Document: &doc, OperationID: "createAccount"})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if !strings.Contains(string(output), `data-formgen-flag-placeholder="Billing plans arrive in Q3"`) {
+		t.Fatalf("expected placeholder attribute, got: %s", output)
+	}
+
+	enabled := featureFlagOrchestrator(t, map[string]bool{"new-billing": true})
+	form, err = enabled.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{Document: &doc, OperationID: "createAccount"})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if len(form.Fields) != 3 {
+		t.Fatalf("expected all fields when the flag is enabled, got %d", len(form.Fields))
+	}
+}
+
+func TestFeatureFlagsGateOperations(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("flags.yaml"), []byte(featureFlagDocument))
+	orch := featureFlagOrchestrator(t, nil)
+
+	_, err := orch.Generate(testsupport.Context(), orchestrator.Request{Document: &doc, OperationID: "createInvoice"})
+	if !errors.Is(err, orchestrator.ErrFeatureDisabled) {
+		t.Fatalf("expected ErrFeatureDisabled, got %v", err)
+	}
+
+	outputs, err := orch.GenerateAll(testsupport.Context(), orchestrator.Request{Document: &doc})
+	if err != nil {
+		t.Fatalf("generate all: %v", err)
+	}
+	if _, ok := outputs["createInvoice"]; ok || len(outputs) != 1 {
+		t.Fatalf("expected createInvoice skipped, got %d outputs", len(outputs))
+	}
+}
@@ -0,0 +1,92 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// FieldTimingReport lists how long each field took to render in one render
+// call. Fields are sorted slowest first; durations of object and array fields
+// include their nested fields.
+type FieldTimingReport struct {
+	OperationID string               `json:"operationId"`
+	Renderer    string               `json:"renderer"`
+	Total       time.Duration        `json:"totalNs"`
+	Fields      []render.FieldTiming `json:"fields"`
+}
+
+// Slowest returns at most n fields from the top of the report.
+func (r FieldTimingReport) Slowest(n int) []render.FieldTiming {
+	if n <= 0 || n >= len(r.Fields) {
+		return r.Fields
+	}
+	return r.Fields[:n]
+}
+
+// WriteText prints the report as an aligned table limited to the n slowest
+// fields; n <= 0 prints every field.
+func (r FieldTimingReport) WriteText(w io.Writer, n int) error {
+	if _, err := fmt.Fprintf(w, "%s (%s): %d fields rendered in %s\n", r.OperationID, r.Renderer, len(r.Fields), r.Total); err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "field\tcomponent\tduration\tshare")
+	for _, field := range r.Slowest(n) {
+		share := 0.0
+		if r.Total > 0 {
+			share = float64(field.Duration) / float64(r.Total) * 100
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.1f%%\n", field.Path, field.Component, field.Duration, share)
+	}
+	return tw.Flush()
+}
+
+// FieldTimingHandler receives a report after each render.
+type FieldTimingHandler func(context.Context, FieldTimingReport)
+
+// WithFieldTimings records per-field render durations and hands a report to
+// handler after every render, to pinpoint slow custom components or
+// templates on large forms. When comments is true the rendered HTML also
+// carries an annotation before each field (dev mode only; the output is no
+// longer deterministic). Only renderers that render fields individually
+// (vanilla) report timings; others produce empty reports.
+func WithFieldTimings(handler FieldTimingHandler, comments bool) Option {
+	return func(o *Orchestrator) {
+		if handler == nil {
+			return
+		}
+		o.fieldTimingHandler = handler
+		o.fieldTimingComments = comments
+	}
+}
+
+// withFieldTimer attaches a fresh timer to ctx when field timings are enabled.
+func (o *Orchestrator) withFieldTimer(ctx context.Context) (context.Context, *render.FieldTimer) {
+	if o.fieldTimingHandler == nil {
+		return ctx, nil
+	}
+	timer := &render.FieldTimer{Comments: o.fieldTimingComments}
+	return render.WithFieldTimer(ctx, timer), timer
+}
+
+func (o *Orchestrator) reportFieldTimings(ctx context.Context, timer *render.FieldTimer, operationID, renderer string, total time.Duration) {
+	if timer == nil {
+		return
+	}
+	fields := timer.Timings()
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Duration > fields[j].Duration
+	})
+	o.fieldTimingHandler(ctx, FieldTimingReport{
+		OperationID: operationID,
+		Renderer:    renderer,
+		Total:       total,
+		Fields:      fields,
+	})
+}
@@ -0,0 +1,71 @@
+package orchestrator_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestOrchestrator_FieldTimingsReport(t *testing.T) {
+	t.Parallel()
+
+	var reports []orchestrator.FieldTimingReport
+	orch := orchestrator.New(
+		orchestrator.WithRenderer(mustVanilla(t)),
+		orchestrator.WithDefaultRenderer("vanilla"),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithFieldTimings(func(_ context.Context, report orchestrator.FieldTimingReport) {
+			reports = append(reports, report)
+		}, true),
+	)
+
+	output, err := orch.Generate(testsupport.Context(), orchestrator.Request{
+		Source:      pkgopenapi.SourceFromFile(filepath.Join("testdata", "petstore.yaml")),
+		OperationID: "createPet",
+	})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected one report, got %d", len(reports))
+	}
+	report := reports[0]
+	if report.OperationID != "createPet" || report.Renderer != "vanilla" {
+		t.Fatalf("unexpected report header: %+v", report)
+	}
+	if len(report.Fields) == 0 {
+		t.Fatalf("expected field timings")
+	}
+	for i := 1; i < len(report.Fields); i++ {
+		if report.Fields[i].Duration > report.Fields[i-1].Duration {
+			t.Fatalf("expected fields sorted slowest first: %+v", report.Fields)
+		}
+	}
+	paths := make(map[string]bool, len(report.Fields))
+	for _, field := range report.Fields {
+		paths[field.Path] = true
+		if field.Component == "" {
+			t.Fatalf("expected component name for %q", field.Path)
+		}
+	}
+	if !paths["name"] {
+		t.Fatalf("expected a timing for name, got %+v", report.Fields)
+	}
+	if !strings.Contains(string(output), "<!-- formgen:field path=name component=") {
+		t.Fatalf("expected timing comments in output")
+	}
+
+	var text bytes.Buffer
+	if err := report.WriteText(&text, 2); err != nil {
+		t.Fatalf("write text: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(text.String()), "\n"); len(lines) != 4 {
+		t.Fatalf("expected header, column row, and two fields, got:\n%s", text.String())
+	}
+}
@@ -0,0 +1,116 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+// GenerateAllOption filters the forms rendered by GenerateAll.
+type GenerateAllOption func(*generateAllFilter)
+
+type generateAllFilter struct {
+	tags       []string
+	pathPrefix string
+}
+
+// WithGenerateTags keeps forms carrying at least one of the tags (OpenAPI
+// operation tags, compared case-insensitively).
+func WithGenerateTags(tags ...string) GenerateAllOption {
+	return func(filter *generateAllFilter) {
+		for _, tag := range tags {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				filter.tags = append(filter.tags, tag)
+			}
+		}
+	}
+}
+
+// WithGeneratePathPrefix keeps forms whose endpoint path starts with prefix.
+func WithGeneratePathPrefix(prefix string) GenerateAllOption {
+	return func(filter *generateAllFilter) {
+		filter.pathPrefix = strings.TrimSpace(prefix)
+	}
+}
+
+func (f generateAllFilter) matches(form schema.Form) bool {
+	if f.pathPrefix != "" && !strings.HasPrefix(form.Endpoint, f.pathPrefix) {
+		return false
+	}
+	if len(f.tags) == 0 {
+		return true
+	}
+	for _, want := range f.tags {
+		for _, tag := range form.Tags {
+			if strings.EqualFold(tag, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GenerateAll renders every form in the document, keyed by operation id. The
+// document is loaded and normalized once and each form goes through the same
+// build, decoration, and render steps as Generate; req.OperationID is ignored.
+// Filters narrow the set, and a document without matching forms yields an
+// empty map. Operations disabled by a feature flag (ErrFeatureDisabled) are
+// skipped. The first failing form aborts the batch with its id in the error.
+func (o *Orchestrator) GenerateAll(ctx context.Context, req Request, filters ...GenerateAllOption) (map[string][]byte, error) {
+	if err := o.ensureReady(ctx); err != nil {
+		return nil, err
+	}
+	var filter generateAllFilter
+	for _, opt := range filters {
+		if opt != nil {
+			opt(&filter)
+		}
+	}
+
+	req = o.withRenderDefaults(req)
+	build := buildRequestFromRequest(req)
+	_, ir, err := o.loadSchemaIR(ctx, build, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(ir.Forms))
+	for id, form := range ir.Forms {
+		if filter.matches(form) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	outputs := make(map[string][]byte, len(ids))
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		formReq := req
+		formReq.OperationID = id
+		formBuild := build
+		formBuild.OperationID = id
+
+		formModel, err := o.buildFormModelFromIR(ctx, ir.Forms[id], ir, formBuild)
+		if err == nil {
+			err = o.decorateFormModel(ctx, formBuild, ir, &formModel)
+		}
+		if errors.Is(err, ErrFeatureDisabled) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("orchestrator: generate %q: %w", id, err)
+		}
+		output, err := o.renderFormModel(ctx, formReq, formModel)
+		if err != nil {
+			return nil, fmt.Errorf("orchestrator: generate %q: %w", id, err)
+		}
+		outputs[id] = output
+	}
+	return outputs, nil
+}
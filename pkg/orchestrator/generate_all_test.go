@@ -0,0 +1,137 @@
+package orchestrator_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+const batchDocument = `openapi: 3.0.0
+info:
+  title: Batch
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      tags: [pets]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+      responses:
+        "201":
+          description: Created
+  /pets/{id}:
+    patch:
+      operationId: updatePet
+      tags: [Pets, admin]
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+      responses:
+        "201":
+          description: Created
+  /stores:
+    post:
+      operationId: createStore
+      tags: [stores]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                city:
+                  type: string
+      responses:
+        "201":
+          description: Created
+`
+
+func TestGenerateAll(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("batch.yaml"), []byte(batchDocument))
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	var loads int
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithDefaultRenderer("vanilla"),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithStageObserver(func(_ context.Context, stage string, _ time.Duration) {
+			if stage == orchestrator.StageLoad {
+				loads++
+			}
+		}),
+	)
+	req := orchestrator.Request{Document: &doc}
+
+	cases := []struct {
+		name    string
+		filters []orchestrator.GenerateAllOption
+		want    []string
+	}{
+		{name: "all", want: []string{"createPet", "createStore", "updatePet"}},
+		{name: "tag", filters: []orchestrator.GenerateAllOption{orchestrator.WithGenerateTags("pets")}, want: []string{"createPet", "updatePet"}},
+		{name: "tags", filters: []orchestrator.GenerateAllOption{orchestrator.WithGenerateTags("admin", "stores")}, want: []string{"createStore", "updatePet"}},
+		{name: "path", filters: []orchestrator.GenerateAllOption{orchestrator.WithGeneratePathPrefix("/pets/")}, want: []string{"updatePet"}},
+		{name: "none", filters: []orchestrator.GenerateAllOption{orchestrator.WithGenerateTags("missing")}, want: []string{}},
+	}
+	for _, tc := range cases {
+		loads = 0
+		outputs, err := orch.GenerateAll(testsupport.Context(), req, tc.filters...)
+		if err != nil {
+			t.Fatalf("%s: GenerateAll: %v", tc.name, err)
+		}
+		got := make([]string, 0, len(outputs))
+		for id := range outputs {
+			got = append(got, id)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, tc.want) {
+			t.Fatalf("%s: operations = %v, want %v", tc.name, got, tc.want)
+		}
+		if loads != 1 {
+			t.Fatalf("%s: expected the document to load once, got %d", tc.name, loads)
+		}
+	}
+
+	outputs, err := orch.GenerateAll(testsupport.Context(), req)
+	if err != nil {
+		t.Fatalf("GenerateAll: %v", err)
+	}
+	single, err := orch.Generate(testsupport.Context(), orchestrator.Request{Document: &doc, OperationID: "updatePet"})
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if string(outputs["updatePet"]) != string(single) {
+		t.Fatalf("expected batch output to match Generate")
+	}
+	if !strings.Contains(string(outputs["createStore"]), `name="city"`) {
+		t.Fatalf("expected createStore form, got %s", outputs["createStore"])
+	}
+}
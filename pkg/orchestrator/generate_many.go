@@ -0,0 +1,224 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"runtime"
+	"sync"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+// GenerateMany renders reqs with at most concurrency workers and returns the
+// outputs in request order. It suits pages that combine a main form with
+// several modal forms: requests sharing a document load and normalize it once,
+// and requests that build the same form (same document, operation id, media
+// type, mode, subset, and visibility inputs) share one decorated model, so only
+// rendering is repeated. Each render receives its own copy of the model.
+//
+// Concurrency below one uses GOMAXPROCS. The first failing request cancels
+// the rest and is returned with its index and operation id.
+func (o *Orchestrator) GenerateMany(ctx context.Context, reqs []Request, concurrency int) ([][]byte, error) {
+	if err := o.ensureReady(ctx); err != nil {
+		return nil, err
+	}
+	outputs := make([][]byte, len(reqs))
+	if len(reqs) == 0 {
+		return outputs, nil
+	}
+	if concurrency < 1 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	concurrency = min(concurrency, len(reqs))
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	batch := &generateBatch{o: o}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				output, err := batch.generate(ctx, reqs[index])
+				if err != nil {
+					cancel(fmt.Errorf("orchestrator: generate request %d (%q): %w", index, reqs[index].OperationID, err))
+					continue
+				}
+				outputs[index] = output
+			}
+		}()
+	}
+	for index := range reqs {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- index
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// generateBatch holds the document and model caches shared by the workers of
+// one GenerateMany call.
+type generateBatch struct {
+	o         *Orchestrator
+	mu        sync.Mutex
+	documents map[string]*batchEntry[schema.SchemaIR]
+	models    map[string]*batchEntry[model.FormModel]
+}
+
+type batchEntry[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// load returns the cached value for key, computing it once. Concurrent
+// callers for the same key wait for the first one.
+func load[T any](b *generateBatch, cache *map[string]*batchEntry[T], key string, compute func() (T, error)) (T, error) {
+	b.mu.Lock()
+	if *cache == nil {
+		*cache = map[string]*batchEntry[T]{}
+	}
+	entry, ok := (*cache)[key]
+	if !ok {
+		entry = &batchEntry[T]{done: make(chan struct{})}
+		(*cache)[key] = entry
+	}
+	b.mu.Unlock()
+
+	if !ok {
+		entry.value, entry.err = compute()
+		close(entry.done)
+	} else {
+		<-entry.done
+	}
+	return entry.value, entry.err
+}
+
+func (b *generateBatch) generate(ctx context.Context, req Request) ([]byte, error) {
+	if err := b.o.validateGenerateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	req = b.o.withRenderDefaults(req)
+	build := buildRequestFromRequest(req)
+	docKey := documentCacheKey(build)
+
+	formModel, err := load(b, &b.models, b.modelCacheKey(docKey, build), func() (model.FormModel, error) {
+		ir, err := load(b, &b.documents, docKey, func() (schema.SchemaIR, error) {
+			_, ir, err := b.o.loadSchemaIR(ctx, build, false)
+			return ir, err
+		})
+		if err != nil {
+			return model.FormModel{}, err
+		}
+		form, ok := ir.Form(build.OperationID)
+		if !ok {
+			adapter, err := b.o.resolveAdapter(ctx, build)
+			if err != nil {
+				return model.FormModel{}, err
+			}
+			return model.FormModel{}, b.o.formNotFoundError(ctx, adapter, ir, build.OperationID)
+		}
+		formModel, err := b.o.buildFormModelFromIR(ctx, form, ir, build)
+		if err != nil {
+			return model.FormModel{}, err
+		}
+		if err := b.o.decorateFormModel(ctx, build, ir, &formModel); err != nil {
+			return model.FormModel{}, err
+		}
+		return formModel, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b.o.renderFormModel(ctx, req, cloneFormModel(formModel))
+}
+
+// documentCacheKey identifies the normalized document a build reads.
+// In-memory documents are keyed by identity, sources by kind and location.
+func documentCacheKey(req BuildRequest) string {
+	var identity string
+	switch {
+	case req.SchemaDocument != nil:
+		identity = fmt.Sprintf("schema:%p", req.SchemaDocument)
+	case req.Document != nil:
+		identity = fmt.Sprintf("openapi:%p", req.Document)
+	case len(req.RawJSONSchema) > 0:
+		sum := sha256.Sum256(req.RawJSONSchema)
+		identity = "raw:" + hex.EncodeToString(sum[:])
+	case req.Source != nil:
+		identity = fmt.Sprintf("source:%s:%s", req.Source.Kind(), req.Source.Location())
+	}
+	return hashKey(identity, req.Format, req.NormalizeOptions)
+}
+
+// modelCacheKey identifies a decorated model. The visibility context only
+// matters when an evaluator is configured; otherwise requests differing only
+// in prefill values share a model.
+func (b *generateBatch) modelCacheKey(docKey string, req BuildRequest) string {
+	visibility := req.VisibilityContext
+	if b.o.visibilityEvaluator == nil {
+		visibility.Values, visibility.Extras = nil, nil
+	}
+	return hashKey(docKey, req.OperationID, req.MediaType, req.Mode, req.Subset, visibility)
+}
+
+func hashKey(parts ...any) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%#v", parts))
+	return hex.EncodeToString(sum[:])
+}
+
+// cloneFormModel deep-copies the parts of a model renderers rewrite (field
+// slices, hint and metadata maps) so cached models stay untouched.
+func cloneFormModel(form model.FormModel) model.FormModel {
+	form.Fields = cloneFields(form.Fields)
+	form.Metadata = maps.Clone(form.Metadata)
+	form.UIHints = maps.Clone(form.UIHints)
+	return form
+}
+
+func cloneFields(fields []model.Field) []model.Field {
+	if fields == nil {
+		return nil
+	}
+	out := make([]model.Field, len(fields))
+	for i, field := range fields {
+		field.Nested = cloneFields(field.Nested)
+		field.OneOf = cloneFields(field.OneOf)
+		if field.Items != nil {
+			items := cloneFields([]model.Field{*field.Items})
+			field.Items = &items[0]
+		}
+		if field.Relationship != nil {
+			relationship := *field.Relationship
+			field.Relationship = &relationship
+		}
+		field.Enum = append([]any(nil), field.Enum...)
+		field.Options = append([]model.Option(nil), field.Options...)
+		if field.Validations != nil {
+			validations := make([]model.ValidationRule, len(field.Validations))
+			for j, rule := range field.Validations {
+				rule.Params = maps.Clone(rule.Params)
+				validations[j] = rule
+			}
+			field.Validations = validations
+		}
+		field.Metadata = maps.Clone(field.Metadata)
+		field.UIHints = maps.Clone(field.UIHints)
+		out[i] = field
+	}
+	return out
+}
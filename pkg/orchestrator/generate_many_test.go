@@ -0,0 +1,86 @@
+package orchestrator_test
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestGenerateMany(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("batch.yaml"), []byte(batchDocument))
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	var loads, builds atomic.Int32
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithDefaultRenderer("vanilla"),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithStageObserver(func(_ context.Context, stage string, _ time.Duration) {
+			switch stage {
+			case orchestrator.StageLoad:
+				loads.Add(1)
+			case orchestrator.StageBuild:
+				builds.Add(1)
+			}
+		}),
+	)
+
+	prefilled := func(name string) orchestrator.Request {
+		return orchestrator.Request{
+			Document:      &doc,
+			OperationID:   "createPet",
+			RenderOptions: render.RenderOptions{Values: map[string]any{"name": name}},
+		}
+	}
+	reqs := []orchestrator.Request{
+		prefilled("Rex"),
+		{Document: &doc, OperationID: "updatePet"},
+		prefilled("Fido"),
+		{Document: &doc, OperationID: "createStore"},
+		prefilled("Luna"),
+	}
+	outputs, err := orch.GenerateMany(testsupport.Context(), reqs, 3)
+	if err != nil {
+		t.Fatalf("GenerateMany: %v", err)
+	}
+	if len(outputs) != len(reqs) {
+		t.Fatalf("expected %d outputs, got %d", len(reqs), len(outputs))
+	}
+	if loads.Load() != 1 || builds.Load() != 3 {
+		t.Fatalf("expected one load and one build per form, got %d loads and %d builds", loads.Load(), builds.Load())
+	}
+	for i, name := range map[int]string{0: "Rex", 2: "Fido", 4: "Luna"} {
+		html := string(outputs[i])
+		if !strings.Contains(html, `value="`+name+`"`) {
+			t.Fatalf("request %d: expected prefilled %s, got %s", i, name, html)
+		}
+		for _, other := range []string{"Rex", "Fido", "Luna"} {
+			if other != name && strings.Contains(html, `value="`+other+`"`) {
+				t.Fatalf("request %d: value %s leaked from a shared model", i, other)
+			}
+		}
+	}
+	for i, req := range reqs {
+		single, err := orch.Generate(testsupport.Context(), req)
+		if err != nil {
+			t.Fatalf("Generate %d: %v", i, err)
+		}
+		if string(outputs[i]) != string(single) {
+			t.Fatalf("request %d: expected batch output to match Generate", i)
+		}
+	}
+
+	_, err = orch.GenerateMany(testsupport.Context(), append(reqs, orchestrator.Request{Document: &doc, OperationID: "missing"}), 0)
+	if err == nil || !strings.Contains(err.Error(), `request 5 ("missing")`) {
+		t.Fatalf("expected failing request in error, got %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// Manifest input kinds.
+const (
+	ManifestInputDocument = "document"
+	ManifestInputOverlay  = "overlay"
+	ManifestInputUISchema = "uiSchema"
+)
+
+const formgenModulePath = "github.com/goliatone/go-formgen"
+
+// Manifest describes a generation run so downstream build systems can check
+// reproducibility and invalidate caches: the hashes of every input, the
+// formgen and renderer versions, and the hash of each output.
+type Manifest struct {
+	FormgenVersion string            `json:"formgenVersion"`
+	GeneratedAt    time.Time         `json:"generatedAt"`
+	Inputs         []ManifestInput   `json:"inputs"`
+	Renderers      map[string]string `json:"renderers"`
+	Outputs        []ManifestOutput  `json:"outputs"`
+}
+
+// ManifestInput records the SHA-256 of an input. Location is empty for inputs
+// without a single origin, such as the UI schema file set.
+type ManifestInput struct {
+	Kind     string `json:"kind"`
+	Location string `json:"location,omitempty"`
+	SHA256   string `json:"sha256"`
+}
+
+// ManifestOutput records a generated artifact. Path is left empty by
+// GenerateWithManifest and filled in by callers that write the output.
+type ManifestOutput struct {
+	Path        string `json:"path,omitempty"`
+	OperationID string `json:"operationId"`
+	Renderer    string `json:"renderer"`
+	ContentType string `json:"contentType"`
+	SHA256      string `json:"sha256"`
+	Size        int    `json:"size"`
+}
+
+// GenerateWithManifest behaves like Generate and also returns a manifest of
+// the run. The schema document is loaded once and hashed as loaded (after
+// overlays); overlays and the UI schema files are hashed separately so a
+// change to any of them is visible on its own. Renderers report their version
+// through render.Versioned and default to the formgen version.
+func (o *Orchestrator) GenerateWithManifest(ctx context.Context, req Request) ([]byte, Manifest, error) {
+	if err := o.validateGenerateRequest(ctx, req); err != nil {
+		return nil, Manifest{}, err
+	}
+	build := buildRequestFromRequest(req)
+	adapter, err := o.resolveAdapter(ctx, build)
+	if err != nil {
+		return nil, Manifest{}, err
+	}
+	doc, err := o.resolveSchemaDocument(ctx, build, adapter)
+	if err != nil {
+		return nil, Manifest{}, err
+	}
+	renderer, err := o.rendererFor(req.Renderer)
+	if err != nil {
+		return nil, Manifest{}, err
+	}
+
+	inputs := []ManifestInput{{Kind: ManifestInputDocument, Location: doc.Location(), SHA256: hashBytes(doc.Raw())}}
+	overlays, err := o.overlayInputs(ctx)
+	if err != nil {
+		return nil, Manifest{}, err
+	}
+	inputs = append(inputs, overlays...)
+	if o.uiSchemaFS != nil {
+		digest, err := hashFS(o.uiSchemaFS)
+		if err != nil {
+			return nil, Manifest{}, fmt.Errorf("orchestrator: hash ui schema: %w", err)
+		}
+		inputs = append(inputs, ManifestInput{Kind: ManifestInputUISchema, SHA256: digest})
+	}
+
+	// Pin the resolved document so Generate does not load it again.
+	req.SchemaDocument = &doc
+	req.Document = nil
+	req.RawJSONSchema = nil
+	req.Format = adapter.Name()
+	output, err := o.Generate(ctx, req)
+	if err != nil {
+		return nil, Manifest{}, err
+	}
+
+	version := formgenVersion()
+	rendererVersion := version
+	if versioned, ok := renderer.(render.Versioned); ok && versioned.Version() != "" {
+		rendererVersion = versioned.Version()
+	}
+	return output, Manifest{
+		FormgenVersion: version,
+		GeneratedAt:    o.now().UTC(),
+		Inputs:         inputs,
+		Renderers:      map[string]string{renderer.Name(): rendererVersion},
+		Outputs: []ManifestOutput{{
+			OperationID: req.OperationID,
+			Renderer:    renderer.Name(),
+			ContentType: renderer.ContentType(),
+			SHA256:      hashBytes(output),
+			Size:        len(output),
+		}},
+	}, nil
+}
+
+func (o *Orchestrator) overlayInputs(ctx context.Context) ([]ManifestInput, error) {
+	inputs := make([]ManifestInput, 0, len(o.overlays))
+	for _, src := range o.overlays {
+		doc, err := o.baseLoader.Load(ctx, src)
+		if err != nil {
+			return nil, fmt.Errorf("orchestrator: load overlay %s: %w", src.Location(), err)
+		}
+		inputs = append(inputs, ManifestInput{Kind: ManifestInputOverlay, Location: src.Location(), SHA256: doc.Hash()})
+	}
+	return inputs, nil
+}
+
+// hashFS digests every regular file in fsys, keyed by path, so renames count
+// as changes.
+func hashFS(fsys fs.FS) (string, error) {
+	var paths []string
+	err := fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(paths)
+	hash := sha256.New()
+	for _, path := range paths {
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(hash, "%s\x00%s\x00", path, hashBytes(data))
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func hashBytes(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// formgenVersion reports the module version compiled into the binary, or
+// "(devel)" for local builds.
+func formgenVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if info.Main.Path == formgenModulePath && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == formgenModulePath {
+			if dep.Replace != nil && dep.Replace.Version != "" {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return "(devel)"
+}
@@ -0,0 +1,94 @@
+package orchestrator_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestGenerateWithManifest(t *testing.T) {
+	t.Parallel()
+
+	overlayPath := filepath.Join(t.TempDir(), "labels.overlay.yaml")
+	overlay := []byte(`overlay: 1.0.0
+info:
+  title: Labels
+  version: 1.0.0
+actions:
+  - target: $.paths.*.post.requestBody.content['application/json'].schema.properties.name
+    update:
+      x-formgen-label: Pet name
+`)
+	if err := os.WriteFile(overlayPath, overlay, 0o600); err != nil {
+		t.Fatalf("write overlay: %v", err)
+	}
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithUISchemaFS(fstest.MapFS{"pets.json": {Data: []byte(`{"operations":{}}`)}}),
+		orchestrator.WithOverlays(pkgopenapi.SourceFromFile(overlayPath)),
+		orchestrator.WithClock(func() time.Time { return now }),
+	)
+	req := orchestrator.Request{
+		Source:      pkgopenapi.SourceFromFile(filepath.Join("testdata", "petstore.yaml")),
+		OperationID: "createPet",
+	}
+
+	output, manifest, err := orch.GenerateWithManifest(testsupport.Context(), req)
+	if err != nil {
+		t.Fatalf("GenerateWithManifest: %v", err)
+	}
+	plain, err := orch.Generate(testsupport.Context(), req)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if string(output) != string(plain) {
+		t.Fatalf("expected output to match Generate")
+	}
+
+	if !manifest.GeneratedAt.Equal(now) || manifest.FormgenVersion == "" || manifest.Renderers["vanilla"] == "" {
+		t.Fatalf("unexpected manifest header %+v", manifest)
+	}
+	if len(manifest.Outputs) != 1 {
+		t.Fatalf("expected one output, got %+v", manifest.Outputs)
+	}
+	out := manifest.Outputs[0]
+	if out.SHA256 != sha256Hex(output) || out.Size != len(output) || out.Renderer != "vanilla" || out.OperationID != "createPet" {
+		t.Fatalf("unexpected output entry %+v", out)
+	}
+
+	kinds := map[string]orchestrator.ManifestInput{}
+	for _, input := range manifest.Inputs {
+		kinds[input.Kind] = input
+	}
+	if got := kinds[orchestrator.ManifestInputOverlay]; got.Location != overlayPath || got.SHA256 != sha256Hex(overlay) {
+		t.Fatalf("unexpected overlay input %+v", got)
+	}
+	doc, err := orch.LoadDocument(testsupport.Context(), req.Source)
+	if err != nil {
+		t.Fatalf("LoadDocument: %v", err)
+	}
+	if got := kinds[orchestrator.ManifestInputDocument]; got.SHA256 != doc.Hash() {
+		t.Fatalf("expected document hash after overlays, got %+v", got)
+	}
+	if kinds[orchestrator.ManifestInputUISchema].SHA256 == "" {
+		t.Fatalf("expected ui schema digest, got %+v", manifest.Inputs)
+	}
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
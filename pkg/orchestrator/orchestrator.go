@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"sort"
 	"strings"
+	"time"
 
 	jsonschemaLoader "github.com/goliatone/go-formgen/internal/jsonschema/loader"
 	internalLoader "github.com/goliatone/go-formgen/internal/openapi/loader"
@@ -13,6 +15,7 @@ import (
 	pkgjsonschema "github.com/goliatone/go-formgen/pkg/jsonschema"
 	"github.com/goliatone/go-formgen/pkg/model"
 	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/outputsink"
 	"github.com/goliatone/go-formgen/pkg/render"
 	"github.com/goliatone/go-formgen/pkg/schema"
 	"github.com/goliatone/go-formgen/pkg/uischema"
@@ -30,6 +33,23 @@ func WithLoader(loader pkgopenapi.Loader) Option {
 	}
 }
 
+// WithOverlays applies OpenAPI Overlay documents, in order, to every OpenAPI
+// document loaded from a Source. Overlays are read through the configured
+// loader; documents passed directly via Request.Document are used as-is (see
+// pkgopenapi.ApplyOverlays).
+func WithOverlays(sources ...pkgopenapi.Source) Option {
+	return func(o *Orchestrator) {
+		o.overlays = append(o.overlays, sources...)
+	}
+}
+
+// WithClock overrides the time source used to stamp generation manifests.
+func WithClock(now func() time.Time) Option {
+	return func(o *Orchestrator) {
+		o.now = now
+	}
+}
+
 // WithParser injects a custom OpenAPI parser.
 func WithParser(parser pkgopenapi.Parser) Option {
 	return func(o *Orchestrator) {
@@ -37,6 +57,19 @@ func WithParser(parser pkgopenapi.Parser) Option {
 	}
 }
 
+// WithSingleOperationParsing makes Generate, GenerateSubform, and
+// BuildFormModel parse only the requested operation of an OpenAPI document
+// instead of every operation, which is much cheaper for servers rendering one
+// form per request from a large spec. The form is then built without its
+// siblings, so model.Options.DeriveSiblingEndpoints and UI schema actions
+// naming other operations see only that operation. Batch generation
+// (GenerateAll, GenerateMany) always parses the whole document.
+func WithSingleOperationParsing(enabled bool) Option {
+	return func(o *Orchestrator) {
+		o.singleOperation = enabled
+	}
+}
+
 // WithJSONSchemaLoader injects a custom JSON Schema loader.
 func WithJSONSchemaLoader(loader pkgjsonschema.Loader) Option {
 	return func(o *Orchestrator) {
@@ -145,6 +178,30 @@ func WithRenderOptionsResolver(resolver RenderOptionsResolver) Option {
 	}
 }
 
+// WithDefaultRenderOptions sets render options applied to every request.
+// Request.RenderOptions is layered on top (see render.MergeRenderOptions), so
+// settings such as OmitAssets, Method, or Subset can be configured once.
+func WithDefaultRenderOptions(options render.RenderOptions) Option {
+	return func(o *Orchestrator) {
+		o.defaultRenderOptions = options
+	}
+}
+
+// WithRendererRenderOptions sets render options for one renderer name. They
+// are layered over WithDefaultRenderOptions and under Request.RenderOptions.
+func WithRendererRenderOptions(renderer string, options render.RenderOptions) Option {
+	return func(o *Orchestrator) {
+		renderer = strings.TrimSpace(renderer)
+		if renderer == "" {
+			return
+		}
+		if o.rendererRenderOptions == nil {
+			o.rendererRenderOptions = map[string]render.RenderOptions{}
+		}
+		o.rendererRenderOptions[renderer] = options
+	}
+}
+
 // WithWidgetRegistry injects a custom widget registry used to resolve widgets
 // before rendering.
 func WithWidgetRegistry(registry *widgets.Registry) Option {
@@ -180,6 +237,15 @@ func WithUIDecorators(decorators ...model.Decorator) Option {
 	}
 }
 
+// WithAutoLayout enables heuristic grid layout for forms without UI schema
+// layout (see uischema.AutoLayout). It always runs after UI schema overlays so
+// configured forms keep their explicit layout.
+func WithAutoLayout(options ...uischema.AutoLayoutOption) Option {
+	return func(o *Orchestrator) {
+		o.decorators = append(o.decorators, uischema.NewAutoLayout(options...))
+	}
+}
+
 // WithUISchemaFS supplies an fs.FS holding UI schema documents. Pass nil to
 // disable the embedded defaults.
 func WithUISchemaFS(fsys fs.FS) Option {
@@ -205,6 +271,7 @@ func WithVisibilityEvaluator(evaluator visibility.Evaluator) Option {
 type Orchestrator struct {
 	loader                   pkgopenapi.Loader
 	parser                   pkgopenapi.Parser
+	singleOperation          bool
 	jsonSchemaLoader         pkgjsonschema.Loader
 	jsonSchemaResolveOptions pkgjsonschema.ResolveOptions
 	builder                  model.Builder
@@ -213,6 +280,8 @@ type Orchestrator struct {
 	registry                 *render.Registry
 	defaultRenderer          string
 	renderOptionsResolvers   []RenderOptionsResolver
+	defaultRenderOptions     render.RenderOptions
+	rendererRenderOptions    map[string]render.RenderOptions
 	widgetRegistry           *widgets.Registry
 	initialiseErr            error
 	defaultsApplied          bool
@@ -223,6 +292,16 @@ type Orchestrator struct {
 	uiDecoratorConfigured    bool
 	transformer              Transformer
 	visibilityEvaluator      visibility.Evaluator
+	featureFlags             FeatureFlagProvider
+	outputSinks              []outputsink.Sink
+	complexityBudget         model.StatsBudget
+	budgetHandler            BudgetWarningHandler
+	stageObservers           []StageObserver
+	fieldTimingHandler       FieldTimingHandler
+	fieldTimingComments      bool
+	overlays                 []pkgopenapi.Source
+	baseLoader               pkgopenapi.Loader
+	now                      func() time.Time
 }
 
 // New constructs an Orchestrator applying any provided options. Missing
@@ -271,6 +350,53 @@ func (o *Orchestrator) SetVisibilityEvaluator(evaluator visibility.Evaluator) {
 	o.visibilityEvaluator = evaluator
 }
 
+// LoadDocument loads an OpenAPI document through the configured loader,
+// applying any overlays, so servers can cache it and pass it back through
+// Request.Document.
+func (o *Orchestrator) LoadDocument(ctx context.Context, src pkgopenapi.Source) (pkgopenapi.Document, error) {
+	if err := o.ensureReady(ctx); err != nil {
+		return pkgopenapi.Document{}, err
+	}
+	if src == nil {
+		return pkgopenapi.Document{}, errors.New("orchestrator: source is required")
+	}
+	doc, err := o.loader.Load(ctx, src)
+	if err != nil {
+		return pkgopenapi.Document{}, fmt.Errorf("orchestrator: load document: %w", err)
+	}
+	return doc, nil
+}
+
+// Renderer resolves a registered renderer the way Generate does: an empty
+// name selects the default renderer, falling back to the first registered one.
+func (o *Orchestrator) Renderer(name string) (render.Renderer, error) {
+	if err := o.ensureReady(context.Background()); err != nil {
+		return nil, err
+	}
+	return o.rendererFor(name)
+}
+
+// RendererNames lists the registered renderer names, sorted.
+func (o *Orchestrator) RendererNames() []string {
+	if err := o.ensureReady(context.Background()); err != nil || o.registry == nil {
+		return nil
+	}
+	return o.registry.List()
+}
+
+// Mode selects what the orchestrator builds from an operation.
+type Mode string
+
+const (
+	// ModeEdit builds an input form from the operation's request body. This is
+	// the default.
+	ModeEdit Mode = "edit"
+	// ModeView builds a read-only detail model from the operation's success
+	// response (200, else the first other 2xx, else default). The model is
+	// flagged with model.MarkView so renderers show values instead of inputs.
+	ModeView Mode = "view"
+)
+
 // BuildRequest describes renderer-free inputs required to build a FormModel.
 type BuildRequest struct {
 	// Source identifies where the schema document lives. Optional when Document
@@ -298,14 +424,24 @@ type BuildRequest struct {
 	// defaults to the JSON Schema adapter and Source is used only as provenance.
 	RawJSONSchema []byte
 
-	// Subset restricts the returned model to fields whose group, tags, or
-	// section match the supplied tokens. Empty subsets leave the model unchanged.
+	// Subset restricts the returned model to fields whose group, tags, section,
+	// or dotted path match the supplied filters, minus any excluded paths. Empty
+	// subsets leave the model unchanged.
 	Subset model.FieldSubset
 
 	// VisibilityContext carries evaluator-specific inputs such as current form
 	// values or feature flags used to decide whether a field belongs in the
 	// returned model.
 	VisibilityContext visibility.Context
+
+	// MediaType selects the request body variant when an operation declares
+	// several (e.g. "multipart/form-data" instead of "application/json"). Empty
+	// keeps the default: JSON, then urlencoded, then multipart.
+	MediaType string
+
+	// Mode selects an edit form (the default) or a read-only detail view built
+	// from the success response schema.
+	Mode Mode
 }
 
 // BuildOption customizes convenience BuildFormModel helpers.
@@ -377,6 +513,14 @@ type Request struct {
 	// RenderOptions.VisibilityContext remains supported for compatibility.
 	VisibilityContext visibility.Context
 
+	// MediaType selects the request body variant; see BuildRequest.MediaType.
+	// Renderers emit the matching form enctype.
+	MediaType string
+
+	// Mode selects an edit form (the default) or a detail view; see
+	// BuildRequest.Mode. Views render the record passed in RenderOptions.Values.
+	Mode Mode
+
 	// Renderer names the renderer to use. If empty, the orchestrator falls back
 	// to the configured default renderer.
 	Renderer string
@@ -401,10 +545,19 @@ func (o *Orchestrator) Generate(ctx context.Context, req Request) ([]byte, error
 	if err := o.validateGenerateRequest(ctx, req); err != nil {
 		return nil, err
 	}
+	req = o.withRenderDefaults(req)
 	formModel, err := o.BuildFormModel(ctx, buildRequestFromRequest(req))
 	if err != nil {
 		return nil, err
 	}
+	return o.renderFormModel(ctx, req, formModel)
+}
+
+func (o *Orchestrator) renderFormModel(ctx context.Context, req Request, formModel model.FormModel) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	start := time.Now()
 	renderOptions, err := o.resolveRenderOptions(ctx, req, formModel)
 	if err != nil {
 		return nil, err
@@ -416,30 +569,131 @@ func (o *Orchestrator) Generate(ctx context.Context, req Request) ([]byte, error
 	if renderOptions.TopPadding == 0 && renderer.Name() == "vanilla" {
 		renderOptions.TopPadding = 5
 	}
-	output, err := renderer.Render(ctx, formModel, renderOptions)
+	renderCtx, timer := o.withFieldTimer(ctx)
+	renderStart := time.Now()
+	output, err := renderer.Render(renderCtx, formModel, renderOptions)
 	if err != nil {
 		return nil, fmt.Errorf("orchestrator: render output: %w", err)
 	}
+	o.reportFieldTimings(ctx, timer, formModel.OperationID, renderer.Name(), time.Since(renderStart))
+	o.observeStage(ctx, StageRender, start)
+	if err := o.publishOutput(ctx, formModel.OperationID, renderer.Name(), renderer.ContentType(), output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// GenerateSubform renders only the nested object field at fieldPath (dotted,
+// e.g. "author.address"). It backs the lazy subform endpoint described by
+// render.SubformEndpointPath: the fragment is emitted in fields mode without
+// assets so the runtime can swap it into an already rendered form. Deeper
+// deferred objects inside the fragment stay deferred.
+func (o *Orchestrator) GenerateSubform(ctx context.Context, req Request, fieldPath string) ([]byte, error) {
+	fieldPath = strings.TrimSpace(fieldPath)
+	if fieldPath == "" {
+		return nil, errors.New("orchestrator: subform path is required")
+	}
+	if err := o.validateGenerateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	req = o.withRenderDefaults(req)
+	build := buildRequestFromRequest(req)
+	build.Subset = model.FieldSubset{}
+	formModel, err := o.BuildFormModel(ctx, build)
+	if err != nil {
+		return nil, err
+	}
+	field, ok := model.FieldByPath(formModel.Fields, fieldPath)
+	if !ok {
+		return nil, fmt.Errorf("orchestrator: subform %q not found in form %q", fieldPath, req.OperationID)
+	}
+	if field.Type != model.FieldTypeObject || len(field.Nested) == 0 {
+		return nil, fmt.Errorf("orchestrator: field %q is not a nested object", fieldPath)
+	}
+	formModel.Fields = []model.Field{subformRoot(field, fieldPath)}
+	formModel.Metadata = nil
+
+	start := time.Now()
+	renderOptions, err := o.resolveRenderOptions(ctx, req, formModel)
+	if err != nil {
+		return nil, err
+	}
+	renderOptions.RenderMode = render.RenderModeFields
+	renderOptions.Subset = model.FieldSubset{}
+	renderOptions.OmitAssets = true
+	renderer, err := o.rendererFor(req.Renderer)
+	if err != nil {
+		return nil, err
+	}
+	output, err := renderer.Render(ctx, formModel, renderOptions)
+	if err != nil {
+		return nil, fmt.Errorf("orchestrator: render subform: %w", err)
+	}
+	o.observeStage(ctx, StageRender, start)
 	return output, nil
 }
 
+// subformRoot prepares a deferred field for standalone rendering. The name is
+// replaced with the full path so nested control names match the parent form,
+// and the lazy hint is dropped so the fragment carries the real controls.
+func subformRoot(field model.Field, fieldPath string) model.Field {
+	field.Name = fieldPath
+	if len(field.UIHints) > 0 {
+		hints := make(map[string]string, len(field.UIHints))
+		for key, value := range field.UIHints {
+			if key == render.SubformLazyHintKey {
+				continue
+			}
+			hints[key] = value
+		}
+		field.UIHints = hints
+	}
+	if len(field.Metadata) > 0 {
+		metadata := make(map[string]string, len(field.Metadata))
+		for key, value := range field.Metadata {
+			if key == render.SubformSourceMetadataKey || key == "layout.section" {
+				continue
+			}
+			metadata[key] = value
+		}
+		field.Metadata = metadata
+	}
+	return field
+}
+
 // BuildFormModel executes the renderer-free schema loading, normalization, and
 // model decoration pipeline.
 func (o *Orchestrator) BuildFormModel(ctx context.Context, req BuildRequest) (model.FormModel, error) {
 	if err := o.validateBuildRequest(ctx, req); err != nil {
 		return model.FormModel{}, err
 	}
-	formModel, err := o.generateFormModel(ctx, req)
+	formModel, ir, err := o.generateFormModel(ctx, req)
 	if err != nil {
 		return model.FormModel{}, err
 	}
-	o.applyEndpointOverrides(req.OperationID, &formModel)
-	if pipelineErr := o.applyFormPipeline(ctx, &formModel, req); pipelineErr != nil {
-		return model.FormModel{}, pipelineErr
+	if err := o.decorateFormModel(ctx, req, ir, &formModel); err != nil {
+		return model.FormModel{}, err
 	}
 	return formModel, nil
 }
 
+func (o *Orchestrator) decorateFormModel(ctx context.Context, req BuildRequest, ir schema.SchemaIR, formModel *model.FormModel) error {
+	start := time.Now()
+	o.applyEndpointOverrides(req.OperationID, formModel)
+	if err := o.applyFormPipeline(ctx, formModel, req); err != nil {
+		return err
+	}
+	if err := o.applyFeatureFlags(ctx, formModel); err != nil {
+		return err
+	}
+	if err := uischema.ResolveActionOperations(formModel, operationLookup(ir)); err != nil {
+		return fmt.Errorf("orchestrator: %w", err)
+	}
+	o.observeStage(ctx, StageDecorate, start)
+	o.checkComplexityBudget(ctx, *formModel)
+	return nil
+}
+
 // BuildFormModelFromJSONSchemaBytes builds a FormModel from raw JSON Schema
 // bytes without requiring a file, URL, or renderer.
 func (o *Orchestrator) BuildFormModelFromJSONSchemaBytes(ctx context.Context, raw []byte, operationID string, options ...BuildOption) (model.FormModel, error) {
@@ -480,6 +734,16 @@ func (o *Orchestrator) validateGenerateRequest(ctx context.Context, req Request)
 }
 
 func (o *Orchestrator) validateBuildRequest(ctx context.Context, req BuildRequest) error {
+	if err := o.ensureReady(ctx); err != nil {
+		return err
+	}
+	if req.OperationID == "" {
+		return errors.New("orchestrator: operation id is required")
+	}
+	return nil
+}
+
+func (o *Orchestrator) ensureReady(ctx context.Context) error {
 	if ctx == nil {
 		return errors.New("orchestrator: context is required")
 	}
@@ -495,9 +759,6 @@ func (o *Orchestrator) validateBuildRequest(ctx context.Context, req BuildReques
 			return err
 		}
 	}
-	if req.OperationID == "" {
-		return errors.New("orchestrator: operation id is required")
-	}
 	return nil
 }
 
@@ -512,6 +773,8 @@ func buildRequestFromRequest(req Request) BuildRequest {
 		RawJSONSchema:     req.RawJSONSchema,
 		Subset:            req.Subset,
 		VisibilityContext: req.VisibilityContext,
+		MediaType:         req.MediaType,
+		Mode:              req.Mode,
 	}
 	if build.Format == "" && len(build.RawJSONSchema) > 0 {
 		build.Format = pkgjsonschema.DefaultAdapterName
@@ -519,7 +782,7 @@ func buildRequestFromRequest(req Request) BuildRequest {
 	if build.Source == nil && len(build.RawJSONSchema) > 0 {
 		build.Source = schema.SourceFromBytes("jsonschema:raw")
 	}
-	if emptySubset(build.Subset) {
+	if build.Subset.Empty() {
 		build.Subset = req.RenderOptions.Subset
 	}
 	if build.VisibilityContext.Values == nil && len(build.VisibilityContext.Extras) == 0 {
@@ -528,47 +791,144 @@ func buildRequestFromRequest(req Request) BuildRequest {
 	return build
 }
 
-func emptySubset(subset model.FieldSubset) bool {
-	return len(subset.Groups) == 0 && len(subset.Tags) == 0 && len(subset.Sections) == 0
+func (o *Orchestrator) generateFormModel(ctx context.Context, req BuildRequest) (model.FormModel, schema.SchemaIR, error) {
+	adapter, ir, err := o.loadSchemaIR(ctx, req, o.singleOperation)
+	if err != nil {
+		return model.FormModel{}, schema.SchemaIR{}, err
+	}
+	form, ok := ir.Form(req.OperationID)
+	if !ok {
+		return model.FormModel{}, schema.SchemaIR{}, o.formNotFoundError(ctx, adapter, ir, req.OperationID)
+	}
+	formModel, err := o.buildFormModelFromIR(ctx, form, ir, req)
+	return formModel, ir, err
 }
 
-func (o *Orchestrator) generateFormModel(ctx context.Context, req BuildRequest) (model.FormModel, error) {
+// operationLookup resolves companion operations referenced by UI schema
+// actions (see uischema.ResolveActionOperations).
+func operationLookup(ir schema.SchemaIR) uischema.OperationLookup {
+	return func(operationID string) (string, string, bool) {
+		form, ok := ir.Form(operationID)
+		if !ok {
+			return "", "", false
+		}
+		return form.Method, form.Endpoint, true
+	}
+}
+
+// loadSchemaIR resolves the adapter, loads the document, and normalizes it.
+// With single set, OpenAPI documents are normalized for req.OperationID only.
+func (o *Orchestrator) loadSchemaIR(ctx context.Context, req BuildRequest, single bool) (schema.FormatAdapter, schema.SchemaIR, error) {
+	start := time.Now()
 	adapter, err := o.resolveAdapter(ctx, req)
 	if err != nil {
-		return model.FormModel{}, err
+		return nil, schema.SchemaIR{}, err
 	}
 	doc, err := o.resolveSchemaDocument(ctx, req, adapter)
 	if err != nil {
-		return model.FormModel{}, err
+		return nil, schema.SchemaIR{}, err
 	}
-	ir, err := adapter.Normalize(ctx, doc, req.NormalizeOptions)
+	o.observeStage(ctx, StageLoad, start)
+
+	start = time.Now()
+	normalize := req.NormalizeOptions
+	if single && normalize.FormID == "" && adapter.Name() == pkgopenapi.DefaultAdapterName {
+		normalize.FormID = req.OperationID
+	}
+	ir, err := adapter.Normalize(ctx, doc, normalize)
 	if err != nil {
-		return model.FormModel{}, fmt.Errorf("orchestrator: normalize schema: %w", err)
+		return nil, schema.SchemaIR{}, fmt.Errorf("orchestrator: normalize schema: %w", err)
 	}
-	form, ok := ir.Form(req.OperationID)
-	if !ok {
-		return model.FormModel{}, o.formNotFoundError(ctx, adapter, ir, req.OperationID)
+	o.observeStage(ctx, StageParse, start)
+	return adapter, ir, nil
+}
+
+func (o *Orchestrator) buildFormModelFromIR(ctx context.Context, form schema.Form, ir schema.SchemaIR, req BuildRequest) (model.FormModel, error) {
+	form, err := form.WithMediaType(req.MediaType)
+	if err != nil {
+		return model.FormModel{}, fmt.Errorf("orchestrator: %w", err)
 	}
-	formModel, err := o.builder.Build(form)
+	if req.Mode == ModeView {
+		if form, err = viewForm(form); err != nil {
+			return model.FormModel{}, err
+		}
+	}
+
+	start := time.Now()
+	formModel, err := o.buildForm(ctx, form, ir)
 	if err != nil {
 		return model.FormModel{}, fmt.Errorf("orchestrator: build form model: %w", err)
 	}
+	if req.Mode == ModeView {
+		model.MarkView(&formModel)
+	}
+	o.observeStage(ctx, StageBuild, start)
 	return formModel, nil
 }
 
+// buildForm hands the remaining forms of the document to builders that can use
+// them (see model.SiblingAwareBuilder).
+func (o *Orchestrator) buildForm(ctx context.Context, form schema.Form, ir schema.SchemaIR) (model.FormModel, error) {
+	if err := ctx.Err(); err != nil {
+		return model.FormModel{}, err
+	}
+	contextual, hasContext := o.builder.(model.ContextBuilder)
+	aware, hasSiblings := o.builder.(model.SiblingAwareBuilder)
+	if len(ir.Forms) < 2 || (!hasContext && !hasSiblings) {
+		if hasContext {
+			return contextual.BuildContext(ctx, form, nil)
+		}
+		return o.builder.Build(form)
+	}
+	ids := make([]string, 0, len(ir.Forms))
+	for id := range ir.Forms {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	siblings := make([]schema.Form, 0, len(ids))
+	for _, id := range ids {
+		siblings = append(siblings, ir.Forms[id])
+	}
+	if hasContext {
+		return contextual.BuildContext(ctx, form, siblings)
+	}
+	return aware.BuildWithSiblings(form, siblings)
+}
+
+// ErrFormNotFound matches (via errors.Is) the error returned when the
+// requested operation id is not part of the document.
+var ErrFormNotFound = errors.New("orchestrator: form not found")
+
+type formNotFoundError struct {
+	message string
+	cause   error
+}
+
+func (e *formNotFoundError) Error() string { return e.message }
+
+func (e *formNotFoundError) Unwrap() []error {
+	if e.cause == nil {
+		return []error{ErrFormNotFound}
+	}
+	return []error{ErrFormNotFound, e.cause}
+}
+
 func (o *Orchestrator) formNotFoundError(ctx context.Context, adapter schema.FormatAdapter, ir schema.SchemaIR, operationID string) error {
 	available, err := adapter.Forms(ctx, ir)
 	if err != nil {
-		return fmt.Errorf("orchestrator: form %q not found (list forms: %w)", operationID, err)
+		return &formNotFoundError{
+			message: fmt.Sprintf("orchestrator: form %q not found (list forms: %v)", operationID, err),
+			cause:   err,
+		}
 	}
-	return fmt.Errorf("orchestrator: form %q not found (available: %s)", operationID, formatFormRefs(available))
+	return &formNotFoundError{message: fmt.Sprintf("orchestrator: form %q not found (available: %s)", operationID, formatFormRefs(available))}
 }
 
 func (o *Orchestrator) applyFormPipeline(ctx context.Context, formModel *model.FormModel, req BuildRequest) error {
 	if transformErr := o.applyTransformer(ctx, formModel); transformErr != nil {
 		return transformErr
 	}
-	if decorateErr := o.applyDecorators(formModel); decorateErr != nil {
+	if decorateErr := o.applyDecorators(ctx, formModel); decorateErr != nil {
 		return decorateErr
 	}
 	model.ApplySubset(formModel, req.Subset)
@@ -578,6 +938,22 @@ func (o *Orchestrator) applyFormPipeline(ctx context.Context, formModel *model.F
 	return nil
 }
 
+// withRenderDefaults layers req.RenderOptions over the orchestrator-wide and
+// per-renderer defaults. It runs before the model is built so default subsets
+// and visibility inputs apply to the build stage as well.
+func (o *Orchestrator) withRenderDefaults(req Request) Request {
+	options := o.defaultRenderOptions
+	if len(o.rendererRenderOptions) > 0 {
+		if renderer, err := o.rendererFor(req.Renderer); err == nil {
+			if rendererOptions, ok := o.rendererRenderOptions[renderer.Name()]; ok {
+				options = render.MergeRenderOptions(options, rendererOptions)
+			}
+		}
+	}
+	req.RenderOptions = render.MergeRenderOptions(options, req.RenderOptions)
+	return req
+}
+
 func (o *Orchestrator) resolveRenderOptions(ctx context.Context, req Request, formModel model.FormModel) (render.RenderOptions, error) {
 	renderOptions := req.RenderOptions
 	render.LocalizeFormModel(&formModel, renderOptions)
@@ -629,7 +1005,7 @@ func (o *Orchestrator) rendererFor(name string) (render.Renderer, error) {
 	return renderer, nil
 }
 
-func (o *Orchestrator) applyDecorators(form *model.FormModel) error {
+func (o *Orchestrator) applyDecorators(ctx context.Context, form *model.FormModel) error {
 	if len(o.decorators) == 0 || form == nil {
 		return nil
 	}
@@ -637,6 +1013,9 @@ func (o *Orchestrator) applyDecorators(form *model.FormModel) error {
 		if decorator == nil {
 			continue
 		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if err := decorator.Decorate(form); err != nil {
 			return fmt.Errorf("orchestrator: decorate form: %w", err)
 		}
@@ -672,6 +1051,10 @@ func (o *Orchestrator) ensureCoreDefaults() {
 	if o.loader == nil {
 		o.loader = internalLoader.New(pkgopenapi.NewLoaderOptions())
 	}
+	o.baseLoader = o.loader
+	if len(o.overlays) > 0 {
+		o.loader = pkgopenapi.NewOverlayLoader(o.loader, o.overlays...)
+	}
 	if o.jsonSchemaLoader == nil {
 		o.jsonSchemaLoader = jsonschemaLoader.New(pkgjsonschema.NewLoaderOptions())
 	}
@@ -687,6 +1070,9 @@ func (o *Orchestrator) ensureCoreDefaults() {
 	if o.widgetRegistry == nil {
 		o.widgetRegistry = widgets.NewRegistry()
 	}
+	if o.now == nil {
+		o.now = time.Now
+	}
 }
 
 func (o *Orchestrator) ensureDefaultAdapters() {
@@ -745,15 +1131,20 @@ func (o *Orchestrator) ensureUIDecoratorOrder() {
 
 	var overlays []model.Decorator
 	var others []model.Decorator
+	var fallbacks []model.Decorator
 	for _, decorator := range o.decorators {
-		if _, ok := decorator.(*uischema.Decorator); ok {
+		switch decorator.(type) {
+		case *uischema.Decorator:
 			overlays = append(overlays, decorator)
-			continue
+		case *uischema.AutoLayout:
+			// Auto layout only fills in forms the overlays left unplaced.
+			fallbacks = append(fallbacks, decorator)
+		default:
+			others = append(others, decorator)
 		}
-		others = append(others, decorator)
 	}
-	if len(overlays) == 0 {
+	if len(overlays) == 0 && len(fallbacks) == 0 {
 		return
 	}
-	o.decorators = append(others, overlays...)
+	o.decorators = append(append(others, overlays...), fallbacks...)
 }
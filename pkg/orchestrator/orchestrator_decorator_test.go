@@ -3,6 +3,7 @@ package orchestrator_test
 import (
 	"context"
 	"testing"
+	"testing/fstest"
 
 	"github.com/goliatone/go-formgen/pkg/model"
 	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
@@ -60,6 +61,49 @@ func TestOrchestrator_AppliesUIDecorators(t *testing.T) {
 	}
 }
 
+func TestOrchestrator_AutoLayoutRunsAfterUISchema(t *testing.T) {
+	baseForm := model.FormModel{
+		OperationID: "post-book:create",
+		Endpoint:    "/book",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "title", Type: model.FieldTypeString},
+			{Name: "isbn", Type: model.FieldTypeString},
+		},
+	}
+	uiSchema := fstest.MapFS{"book.json": {Data: []byte(`{"operations":{"post-book:create":{"fields":{"title":{"grid":{"span":8}}}}}}`)}}
+
+	for name, tc := range map[string]struct {
+		fsys     fstest.MapFS
+		wantSpan string
+	}{
+		"without ui schema": {wantSpan: "6"},
+		"with ui schema":    {fsys: uiSchema, wantSpan: "8"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			form := baseForm
+			form.Fields = append([]model.Field(nil), baseForm.Fields...)
+			orch := orchestrator.New(
+				orchestrator.WithModelBuilder(&stubFormBuilder{form: form}),
+				orchestrator.WithParser(stubParser{operation: pkgopenapi.Operation{ID: baseForm.OperationID, Path: baseForm.Endpoint, Method: baseForm.Method}}),
+				orchestrator.WithAutoLayout(),
+				orchestrator.WithUISchemaFS(tc.fsys),
+			)
+
+			built, err := orch.BuildFormModel(context.Background(), orchestrator.BuildRequest{
+				Document:    &pkgopenapi.Document{},
+				OperationID: baseForm.OperationID,
+			})
+			if err != nil {
+				t.Fatalf("build: %v", err)
+			}
+			if got := built.Fields[0].UIHints["layout.span"]; got != tc.wantSpan {
+				t.Fatalf("title span = %q, want %q", got, tc.wantSpan)
+			}
+		})
+	}
+}
+
 type stubFormBuilder struct {
 	form model.FormModel
 }
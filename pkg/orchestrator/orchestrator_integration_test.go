@@ -2,9 +2,12 @@ package orchestrator_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
 	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
@@ -228,3 +231,84 @@ func mustPreact(t *testing.T) render.Renderer {
 	}
 	return r
 }
+
+func TestOrchestrator_StageObserverReportsPipelineStages(t *testing.T) {
+	t.Parallel()
+
+	var stages []string
+	orch := orchestrator.New(
+		orchestrator.WithRenderer(mustVanilla(t)),
+		orchestrator.WithDefaultRenderer("vanilla"),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithStageObserver(func(_ context.Context, stage string, elapsed time.Duration) {
+			if elapsed < 0 {
+				t.Errorf("stage %s reported negative duration %s", stage, elapsed)
+			}
+			stages = append(stages, stage)
+		}),
+	)
+
+	_, err := orch.Generate(testsupport.Context(), orchestrator.Request{
+		Source:      pkgopenapi.SourceFromFile(filepath.Join("testdata", "petstore.yaml")),
+		OperationID: "createPet",
+	})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	want := []string{
+		orchestrator.StageLoad,
+		orchestrator.StageParse,
+		orchestrator.StageBuild,
+		orchestrator.StageDecorate,
+		orchestrator.StageRender,
+	}
+	if len(stages) != len(want) {
+		t.Fatalf("stages = %v, want %v", stages, want)
+	}
+	for i := range want {
+		if stages[i] != want[i] {
+			t.Fatalf("stages = %v, want %v", stages, want)
+		}
+	}
+}
+
+func TestOrchestrator_WithOverlaysMergesBeforeParsing(t *testing.T) {
+	t.Parallel()
+
+	overlayPath := filepath.Join(t.TempDir(), "petstore.overlay.yaml")
+	overlay := `overlay: 1.0.0
+info:
+  title: Petstore UI
+  version: 1.0.0
+actions:
+  - target: $.paths.*[?(@.operationId == 'createPet')].requestBody.content['application/json'].schema.properties.name
+    update:
+      x-formgen-label: Pet name
+  - target: $.paths.*.post.requestBody.content['application/json'].schema.properties.tag
+    remove: true
+`
+	if err := os.WriteFile(overlayPath, []byte(overlay), 0o600); err != nil {
+		t.Fatalf("write overlay: %v", err)
+	}
+
+	orch := orchestrator.New(
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithOverlays(pkgopenapi.SourceFromFile(overlayPath)),
+	)
+	form, err := orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{
+		Source:      pkgopenapi.SourceFromFile(filepath.Join("testdata", "petstore.yaml")),
+		OperationID: "createPet",
+	})
+	if err != nil {
+		t.Fatalf("build form model: %v", err)
+	}
+
+	name, ok := pkgmodel.FieldByPath(form.Fields, "name")
+	if !ok || name.Label != "Pet name" {
+		t.Fatalf("expected overlay label on name, got %+v", name)
+	}
+	if _, ok := pkgmodel.FieldByPath(form.Fields, "tag"); ok {
+		t.Fatal("expected overlay to remove tag")
+	}
+}
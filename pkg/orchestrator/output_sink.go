@@ -0,0 +1,37 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goliatone/go-formgen/pkg/outputsink"
+)
+
+// WithOutputSink dual-writes every rendered form to the given sinks in
+// addition to returning it, so batch jobs built on Generate, GenerateAll, or
+// GenerateMany publish their output as they go. Artifacts are keyed by
+// renderer, operation id, and content hash (see outputsink.Artifact.Key). A
+// failed write fails the generation call; sinks must be safe for concurrent
+// use because GenerateMany renders in parallel.
+func WithOutputSink(sinks ...outputsink.Sink) Option {
+	return func(o *Orchestrator) {
+		for _, sink := range sinks {
+			if sink != nil {
+				o.outputSinks = append(o.outputSinks, sink)
+			}
+		}
+	}
+}
+
+func (o *Orchestrator) publishOutput(ctx context.Context, operationID, renderer, contentType string, output []byte) error {
+	if len(o.outputSinks) == 0 {
+		return nil
+	}
+	artifact := outputsink.NewArtifact(operationID, renderer, contentType, output)
+	for _, sink := range o.outputSinks {
+		if err := sink.Put(ctx, artifact); err != nil {
+			return fmt.Errorf("orchestrator: publish %s: %w", artifact.Key(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package orchestrator_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/outputsink"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+type recordingSink struct {
+	mu        sync.Mutex
+	artifacts []outputsink.Artifact
+	err       error
+}
+
+func (s *recordingSink) Put(_ context.Context, artifact outputsink.Artifact) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.artifacts = append(s.artifacts, artifact)
+	return s.err
+}
+
+func TestOutputSinkReceivesRenderedForms(t *testing.T) {
+	t.Parallel()
+
+	sink := &recordingSink{}
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithOutputSink(sink),
+	)
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("batch.yaml"), []byte(batchDocument))
+
+	outputs, err := orch.GenerateAll(testsupport.Context(), orchestrator.Request{Document: &doc})
+	if err != nil {
+		t.Fatalf("generate all: %v", err)
+	}
+	if len(sink.artifacts) != len(outputs) {
+		t.Fatalf("expected %d artifacts, got %d", len(outputs), len(sink.artifacts))
+	}
+	for _, artifact := range sink.artifacts {
+		if string(outputs[artifact.OperationID]) != string(artifact.Body) {
+			t.Fatalf("artifact %s does not match the returned output", artifact.OperationID)
+		}
+		want := outputsink.NewArtifact(artifact.OperationID, "vanilla", "text/html; charset=utf-8", artifact.Body).Key()
+		if artifact.Key() != want {
+			t.Fatalf("expected key %q, got %q", want, artifact.Key())
+		}
+	}
+}
+
+func TestOutputSinkErrorFailsGenerate(t *testing.T) {
+	t.Parallel()
+
+	failure := errors.New("bucket unavailable")
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithOutputSink(&recordingSink{err: failure}),
+	)
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("batch.yaml"), []byte(batchDocument))
+
+	_, err := orch.Generate(testsupport.Context(), orchestrator.Request{Document: &doc, OperationID: "createPet"})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected sink error, got %v", err)
+	}
+}
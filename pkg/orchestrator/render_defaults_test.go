@@ -0,0 +1,54 @@
+package orchestrator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestDefaultRenderOptionsMergeWithRequest(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("batch.yaml"), []byte(batchDocument))
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+
+	var captured render.RenderOptions
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithUISchemaFS(nil),
+		orchestrator.WithDefaultRenderOptions(render.RenderOptions{
+			Method:       "PUT",
+			HiddenFields: map[string]string{"csrf": "default", "tenant": "acme"},
+		}),
+		orchestrator.WithRendererRenderOptions("vanilla", render.RenderOptions{OmitAssets: true, Method: "PATCH"}),
+		orchestrator.WithRendererRenderOptions("preact", render.RenderOptions{Locale: "fr"}),
+		orchestrator.WithRenderOptionsResolver(func(_ context.Context, _ orchestrator.Request, _ model.FormModel, options render.RenderOptions) (render.RenderOptions, error) {
+			captured = options
+			return options, nil
+		}),
+	)
+
+	_, err := orch.Generate(testsupport.Context(), orchestrator.Request{
+		Document:      &doc,
+		OperationID:   "createPet",
+		RenderOptions: render.RenderOptions{HiddenFields: map[string]string{"csrf": "token"}},
+	})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if captured.Method != "PATCH" || !captured.OmitAssets {
+		t.Fatalf("expected vanilla defaults, got method=%q omitAssets=%v", captured.Method, captured.OmitAssets)
+	}
+	if captured.Locale != "" {
+		t.Fatalf("expected preact defaults to be ignored, got locale %q", captured.Locale)
+	}
+	if captured.HiddenFields["csrf"] != "token" || captured.HiddenFields["tenant"] != "acme" {
+		t.Fatalf("expected merged hidden fields, got %v", captured.HiddenFields)
+	}
+}
@@ -0,0 +1,41 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+)
+
+// Pipeline stages reported to a StageObserver.
+const (
+	StageLoad     = "load"
+	StageParse    = "parse"
+	StageBuild    = "build"
+	StageDecorate = "decorate"
+	StageRender   = "render"
+)
+
+// StageObserver receives the wall-clock duration of each pipeline stage. It is
+// called synchronously, so implementations should be cheap.
+type StageObserver func(ctx context.Context, stage string, elapsed time.Duration)
+
+// WithStageObserver registers an observer for pipeline stage timings, e.g. to
+// export metrics or profile slow schemas.
+func WithStageObserver(observer StageObserver) Option {
+	return func(o *Orchestrator) {
+		if observer != nil {
+			o.stageObservers = append(o.stageObservers, observer)
+		}
+	}
+}
+
+// observeStage reports the time elapsed since start; call it via defer or
+// right after the stage completes.
+func (o *Orchestrator) observeStage(ctx context.Context, stage string, start time.Time) {
+	if len(o.stageObservers) == 0 {
+		return
+	}
+	elapsed := time.Since(start)
+	for _, observer := range o.stageObservers {
+		observer(ctx, stage, elapsed)
+	}
+}
@@ -0,0 +1,116 @@
+package orchestrator_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+func lazySubformForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "createArticle",
+		Endpoint:    "/articles",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "title", Type: model.FieldTypeString},
+			{
+				Name:  "author",
+				Type:  model.FieldTypeObject,
+				Label: "Author",
+				Nested: []model.Field{
+					{Name: "name", Type: model.FieldTypeString},
+					{
+						Name:    "address",
+						Type:    model.FieldTypeObject,
+						Label:   "Address",
+						UIHints: map[string]string{render.SubformLazyHintKey: "true"},
+						Nested: []model.Field{
+							{Name: "street", Type: model.FieldTypeString, Label: "Street"},
+							{Name: "city", Type: model.FieldTypeString, Label: "City"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newSubformOrchestrator(t *testing.T, form model.FormModel) *orchestrator.Orchestrator {
+	t.Helper()
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	return orchestrator.New(
+		orchestrator.WithModelBuilder(&stubFormBuilder{form: form}),
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithParser(stubParser{operation: pkgopenapi.Operation{ID: form.OperationID}}),
+		orchestrator.WithUISchemaFS(nil),
+	)
+}
+
+func TestOrchestrator_GenerateRendersLazySubformPlaceholder(t *testing.T) {
+	form := lazySubformForm()
+	orch := newSubformOrchestrator(t, form)
+
+	output, err := orch.Generate(context.Background(), orchestrator.Request{
+		Document:    &pkgopenapi.Document{},
+		OperationID: form.OperationID,
+	})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	html := string(output)
+	if !strings.Contains(html, `data-formgen-subform-src="/formgen/subform?operation=createArticle&amp;path=author.address"`) {
+		t.Fatalf("expected deferred subform source, got:\n%s", html)
+	}
+	if strings.Contains(html, `name="author.address.street"`) {
+		t.Fatalf("expected deferred subform controls to be omitted")
+	}
+	if !strings.Contains(html, `name="author.name"`) {
+		t.Fatalf("expected eager nested controls to render")
+	}
+}
+
+func TestOrchestrator_GenerateSubform(t *testing.T) {
+	form := lazySubformForm()
+	orch := newSubformOrchestrator(t, form)
+
+	output, err := orch.GenerateSubform(context.Background(), orchestrator.Request{
+		Document:    &pkgopenapi.Document{},
+		OperationID: form.OperationID,
+	}, "author.address")
+	if err != nil {
+		t.Fatalf("generate subform: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{`id="fg-author-address"`, `name="author.address.street"`, `name="author.address.city"`} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected fragment to contain %s, got:\n%s", want, html)
+		}
+	}
+	for _, unwanted := range []string{"<form", `name="title"`, "data-formgen-subform-src"} {
+		if strings.Contains(html, unwanted) {
+			t.Fatalf("expected fragment to omit %s, got:\n%s", unwanted, html)
+		}
+	}
+}
+
+func TestOrchestrator_GenerateSubformRejectsUnknownPath(t *testing.T) {
+	form := lazySubformForm()
+	orch := newSubformOrchestrator(t, form)
+	req := orchestrator.Request{Document: &pkgopenapi.Document{}, OperationID: form.OperationID}
+
+	if _, err := orch.GenerateSubform(context.Background(), req, "author.missing"); err == nil {
+		t.Fatalf("expected error for unknown subform path")
+	}
+	if _, err := orch.GenerateSubform(context.Background(), req, "title"); err == nil {
+		t.Fatalf("expected error for non-object subform path")
+	}
+	if _, err := orch.GenerateSubform(context.Background(), req, " "); err == nil {
+		t.Fatalf("expected error for empty subform path")
+	}
+}
@@ -0,0 +1,52 @@
+package orchestrator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+// viewForm swaps the form's request body for its success response schema so
+// the builder emits a detail view of the returned record. Parameters and
+// request media types do not apply to views and are dropped.
+func viewForm(form schema.Form) (schema.Form, error) {
+	responses := form.ResponseSchemas()
+	code, ok := successResponse(responses)
+	if !ok {
+		return schema.Form{}, fmt.Errorf("orchestrator: form %q has no success response schema for a view", form.ID)
+	}
+	form.Schema = responses[code]
+	form.Method = "GET"
+	form.Parameters = nil
+	form.MediaType = ""
+	form.MediaTypes = nil
+	return form, nil
+}
+
+// successResponse picks 200, then the lowest other 2xx code (including the
+// "2XX" range), then default, skipping responses without a schema.
+func successResponse(responses map[string]schema.Schema) (string, bool) {
+	usable := func(code string) bool {
+		response, ok := responses[code]
+		return ok && (response.Type != "" || response.Ref != "" || len(response.Properties) > 0 || response.Items != nil)
+	}
+	if usable("200") {
+		return "200", true
+	}
+	codes := make([]string, 0, len(responses))
+	for code := range responses {
+		if strings.HasPrefix(code, "2") && usable(code) {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) > 0 {
+		sort.Strings(codes)
+		return codes[0], true
+	}
+	if usable("default") {
+		return "default", true
+	}
+	return "", false
+}
@@ -0,0 +1,150 @@
+package orchestrator_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	pkgopenapi "github.com/goliatone/go-formgen/pkg/openapi"
+	"github.com/goliatone/go-formgen/pkg/orchestrator"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+const viewDocument = `openapi: 3.0.0
+info:
+  title: Views
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      operationId: getPet
+      summary: Pet details
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  id:
+                    type: string
+                    readOnly: true
+                  name:
+                    type: string
+                  status:
+                    type: string
+                    enum: [available, sold]
+                  vaccinated:
+                    type: boolean
+                  owner:
+                    type: object
+                    properties:
+                      email:
+                        type: string
+                        format: email
+                  visits:
+                    type: array
+                    items:
+                      type: object
+                      properties:
+                        note:
+                          type: string
+    delete:
+      operationId: deletePet
+      responses:
+        "204":
+          description: Deleted
+`
+
+func TestBuildFormModelViewMode(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("views.yaml"), []byte(viewDocument))
+	orch := orchestrator.New(orchestrator.WithUISchemaFS(nil))
+
+	form, err := orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{
+		Document:    &doc,
+		OperationID: "getPet",
+		Mode:        orchestrator.ModeView,
+	})
+	if err != nil {
+		t.Fatalf("build view: %v", err)
+	}
+	if !model.IsView(form) || form.Method != "GET" {
+		t.Fatalf("expected GET view model, got %+v", form)
+	}
+	var names []string
+	for _, field := range form.Fields {
+		names = append(names, field.Name)
+		if !field.Readonly {
+			t.Fatalf("expected readonly view field, got %+v", field)
+		}
+	}
+	if got := strings.Join(names, ","); !strings.Contains(got, "owner") || !strings.Contains(got, "visits") || strings.Count(got, "id") != 1 {
+		t.Fatalf("expected response fields without path parameters, got %s", got)
+	}
+
+	_, err = orch.BuildFormModel(testsupport.Context(), orchestrator.BuildRequest{
+		Document:    &doc,
+		OperationID: "deletePet",
+		Mode:        orchestrator.ModeView,
+	})
+	if err == nil || !strings.Contains(err.Error(), "no success response schema") {
+		t.Fatalf("expected missing response error, got %v", err)
+	}
+}
+
+func TestGenerateViewModeRendersValues(t *testing.T) {
+	t.Parallel()
+
+	doc := pkgopenapi.MustNewDocument(pkgopenapi.SourceFromBytes("views.yaml"), []byte(viewDocument))
+	registry := render.NewRegistry()
+	registry.MustRegister(mustVanilla(t))
+	orch := orchestrator.New(
+		orchestrator.WithRegistry(registry),
+		orchestrator.WithDefaultRenderer("vanilla"),
+		orchestrator.WithUISchemaFS(nil),
+	)
+
+	output, err := orch.Generate(testsupport.Context(), orchestrator.Request{
+		Document:    &doc,
+		OperationID: "getPet",
+		Mode:        orchestrator.ModeView,
+		RenderOptions: render.RenderOptions{Values: map[string]any{
+			"id":          "p-1",
+			"name":        "Rex <3",
+			"vaccinated":  true,
+			"owner.email": "ann@example.com",
+			"visits":      []any{map[string]any{"note": "Checkup"}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("generate view: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`data-formgen-view="getPet"`,
+		`>Pet details</h2>`,
+		`Rex &lt;3`,
+		`>Yes`,
+		`href="mailto:ann@example.com"`,
+		`data-formgen-view-field="visits.0.note"`,
+		`Checkup`,
+		`data-formgen-view-field="status" data-depth="0"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s in view, got %s", want, html)
+		}
+	}
+	if strings.Contains(html, "<form") || strings.Contains(html, "<input") {
+		t.Fatalf("expected values instead of inputs, got %s", html)
+	}
+}
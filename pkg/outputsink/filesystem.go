@@ -0,0 +1,58 @@
+package outputsink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// FileSystem writes artifacts below a root directory, one file per key.
+type FileSystem struct {
+	root string
+}
+
+// NewFileSystem returns a sink rooted at dir. The directory is created on
+// first write.
+func NewFileSystem(dir string) *FileSystem {
+	return &FileSystem{root: dir}
+}
+
+// Put writes the artifact unless a file with its key already exists. Files are
+// written to a temporary name and renamed so readers never see partial output.
+func (s *FileSystem) Put(ctx context.Context, artifact Artifact) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	target := filepath.Join(s.root, filepath.FromSlash(artifact.Key()))
+	if _, err := os.Stat(target); err == nil {
+		return nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("outputsink: stat %s: %w", target, err)
+	}
+	dir := filepath.Dir(target)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("outputsink: create %s: %w", dir, err)
+	}
+	tmp, err := os.CreateTemp(dir, ".formgen-*")
+	if err != nil {
+		return fmt.Errorf("outputsink: write %s: %w", target, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(artifact.Body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("outputsink: write %s: %w", target, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("outputsink: write %s: %w", target, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("outputsink: write %s: %w", target, err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("outputsink: write %s: %w", target, err)
+	}
+	return nil
+}
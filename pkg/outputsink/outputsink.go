@@ -0,0 +1,84 @@
+// Package outputsink publishes generated artifacts to durable storage so batch
+// generation jobs can push rendered forms straight to a bucket or CDN origin.
+// Keys are content-addressed: the same output always lands on the same key and
+// a changed output never overwrites a published one.
+//
+//	sink, _ := outputsink.NewS3(outputsink.S3Config{Endpoint: "https://s3.eu-west-1.amazonaws.com", Region: "eu-west-1", Bucket: "forms"})
+//	orch := orchestrator.New(orchestrator.WithOutputSink(sink))
+package outputsink
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Artifact is a rendered output handed to a Sink.
+type Artifact struct {
+	OperationID string
+	Renderer    string
+	ContentType string
+	// SHA256 is the hex digest of Body. NewArtifact fills it in.
+	SHA256 string
+	Body   []byte
+}
+
+// NewArtifact builds an Artifact and computes its digest.
+func NewArtifact(operationID, renderer, contentType string, body []byte) Artifact {
+	sum := sha256.Sum256(body)
+	return Artifact{
+		OperationID: operationID,
+		Renderer:    renderer,
+		ContentType: contentType,
+		SHA256:      hex.EncodeToString(sum[:]),
+		Body:        body,
+	}
+}
+
+// Key returns the content-addressed key "<renderer>/<operationId>/<sha256><ext>".
+// Path separators in the renderer name and operation id are escaped so each
+// stays a single segment.
+func (a Artifact) Key() string {
+	renderer := url.PathEscape(strings.TrimSpace(a.Renderer))
+	if renderer == "" {
+		renderer = "default"
+	}
+	operation := url.PathEscape(strings.TrimSpace(a.OperationID))
+	if operation == "" {
+		operation = "form"
+	}
+	return path.Join(renderer, operation, a.SHA256+Extension(a.ContentType))
+}
+
+// Sink stores artifacts. Put must be safe for concurrent use; writing an
+// artifact whose key already exists should succeed.
+type Sink interface {
+	Put(ctx context.Context, artifact Artifact) error
+}
+
+// Extension maps a content type to the file extension used in keys, falling
+// back to ".bin" for unknown types.
+func Extension(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ".bin"
+	}
+	switch mediaType {
+	case "text/html":
+		return ".html"
+	case "application/json":
+		return ".json"
+	case "text/javascript", "application/javascript":
+		return ".js"
+	case "text/plain":
+		return ".txt"
+	}
+	if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+		return exts[0]
+	}
+	return ".bin"
+}
@@ -0,0 +1,134 @@
+package outputsink_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/outputsink"
+)
+
+func TestArtifactKey(t *testing.T) {
+	t.Parallel()
+
+	artifact := outputsink.NewArtifact("pets/create", "vanilla", "text/html; charset=utf-8", []byte("<form></form>"))
+	if len(artifact.SHA256) != 64 {
+		t.Fatalf("expected sha256 digest, got %q", artifact.SHA256)
+	}
+	want := "vanilla/pets%2Fcreate/" + artifact.SHA256 + ".html"
+	if artifact.Key() != want {
+		t.Fatalf("expected key %q, got %q", want, artifact.Key())
+	}
+	if ext := outputsink.Extension("application/json"); ext != ".json" {
+		t.Fatalf("expected .json, got %q", ext)
+	}
+	if ext := outputsink.Extension("not a type"); ext != ".bin" {
+		t.Fatalf("expected .bin, got %q", ext)
+	}
+}
+
+func TestFileSystemPut(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sink := outputsink.NewFileSystem(dir)
+	artifact := outputsink.NewArtifact("createPet", "json", "application/json", []byte(`{"ok":true}`))
+	for range 2 {
+		if err := sink.Put(context.Background(), artifact); err != nil {
+			t.Fatalf("put: %v", err)
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(artifact.Key())))
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(data) != `{"ok":true}` {
+		t.Fatalf("unexpected content %q", data)
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, "json", "createPet"))
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("expected one file without temporaries, got %v (%v)", entries, err)
+	}
+}
+
+func TestS3Put(t *testing.T) {
+	t.Parallel()
+
+	type received struct {
+		method, path, auth, contentType, cacheControl, payloadHash, body string
+	}
+	requests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{
+			method:       r.Method,
+			path:         r.URL.EscapedPath(),
+			auth:         r.Header.Get("Authorization"),
+			contentType:  r.Header.Get("Content-Type"),
+			cacheControl: r.Header.Get("Cache-Control"),
+			payloadHash:  r.Header.Get("X-Amz-Content-Sha256"),
+			body:         string(body),
+		}
+	}))
+	defer server.Close()
+
+	sink, err := outputsink.NewS3(outputsink.S3Config{
+		Endpoint:        server.URL,
+		Region:          "eu-west-1",
+		Bucket:          "forms",
+		Prefix:          "v1/",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		CacheControl:    "public, max-age=31536000, immutable",
+		Now:             func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) },
+	})
+	if err != nil {
+		t.Fatalf("new s3: %v", err)
+	}
+	artifact := outputsink.NewArtifact("createPet", "vanilla", "text/html; charset=utf-8", []byte("<form></form>"))
+	if err := sink.Put(context.Background(), artifact); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	got := <-requests
+	if got.method != http.MethodPut || got.path != "/forms/v1/"+artifact.Key() {
+		t.Fatalf("unexpected request %s %s", got.method, got.path)
+	}
+	if got.body != "<form></form>" || got.contentType != "text/html; charset=utf-8" || got.cacheControl == "" {
+		t.Fatalf("unexpected payload %+v", got)
+	}
+	if got.payloadHash != artifact.SHA256 {
+		t.Fatalf("expected payload hash %q, got %q", artifact.SHA256, got.payloadHash)
+	}
+	prefix := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20260102/eu-west-1/s3/aws4_request, SignedHeaders=cache-control;content-type;host;x-amz-content-sha256;x-amz-date, Signature="
+	if !strings.HasPrefix(got.auth, prefix) || len(got.auth) != len(prefix)+64 {
+		t.Fatalf("unexpected authorization %q", got.auth)
+	}
+}
+
+func TestS3PutReportsStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	sink, err := outputsink.NewS3(outputsink.S3Config{Endpoint: server.URL, Region: "us-east-1", Bucket: "forms", AccessKeyID: "id", SecretAccessKey: "secret"})
+	if err != nil {
+		t.Fatalf("new s3: %v", err)
+	}
+	err = sink.Put(context.Background(), outputsink.NewArtifact("createPet", "vanilla", "text/html", nil))
+	if err == nil || !strings.Contains(err.Error(), "403") || !strings.Contains(err.Error(), "AccessDenied") {
+		t.Fatalf("expected status error, got %v", err)
+	}
+	if _, err := outputsink.NewS3(outputsink.S3Config{Endpoint: server.URL}); err == nil {
+		t.Fatal("expected config error")
+	}
+}
@@ -0,0 +1,186 @@
+package outputsink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3Algorithm  = "AWS4-HMAC-SHA256"
+	s3Service    = "s3"
+	s3DateFormat = "20060102T150405Z"
+)
+
+// S3Config configures an S3-compatible sink (AWS S3, MinIO, Cloudflare R2,
+// and the like).
+type S3Config struct {
+	// Endpoint is the service base URL, e.g. "https://s3.eu-west-1.amazonaws.com"
+	// or "http://localhost:9000". Objects are addressed path-style:
+	// <endpoint>/<bucket>/<prefix><key>.
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Prefix is prepended to every key, e.g. "forms/".
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// CacheControl is sent with every object. Content-addressed keys never
+	// change content, so a long immutable max-age is usually safe.
+	CacheControl string
+	HTTPClient   *http.Client
+	// Now overrides the signing clock; intended for tests.
+	Now func() time.Time
+}
+
+// S3 uploads artifacts with SigV4-signed PUT requests.
+type S3 struct {
+	cfg      S3Config
+	endpoint *url.URL
+}
+
+// NewS3 validates cfg and returns the sink.
+func NewS3(cfg S3Config) (*S3, error) {
+	cfg.Endpoint = strings.TrimSpace(cfg.Endpoint)
+	cfg.Region = strings.TrimSpace(cfg.Region)
+	cfg.Bucket = strings.TrimSpace(cfg.Bucket)
+	if cfg.Endpoint == "" || cfg.Region == "" || cfg.Bucket == "" {
+		return nil, errors.New("outputsink: s3 endpoint, region, and bucket are required")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("outputsink: s3 credentials are required")
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("outputsink: invalid s3 endpoint %q", cfg.Endpoint)
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &S3{cfg: cfg, endpoint: endpoint}, nil
+}
+
+// Put uploads the artifact under Prefix + Key().
+func (s *S3) Put(ctx context.Context, artifact Artifact) error {
+	key := s.cfg.Prefix + artifact.Key()
+	objectPath := path.Join("/", s.endpoint.Path, s.cfg.Bucket, key)
+	target := *s.endpoint
+	target.Path = objectPath
+	target.RawPath = s3Escape(objectPath)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, target.String(), bytes.NewReader(artifact.Body))
+	if err != nil {
+		return fmt.Errorf("outputsink: s3 put %s: %w", key, err)
+	}
+	req.ContentLength = int64(len(artifact.Body))
+	if artifact.ContentType != "" {
+		req.Header.Set("Content-Type", artifact.ContentType)
+	}
+	if s.cfg.CacheControl != "" {
+		req.Header.Set("Cache-Control", s.cfg.CacheControl)
+	}
+	s.sign(req, artifact.Body)
+
+	resp, err := s.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("outputsink: s3 put %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("outputsink: s3 put %s: %s: %s", key, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// sign adds the SigV4 headers for a single-chunk payload.
+func (s *S3) sign(req *http.Request, body []byte) {
+	now := s.cfg.Now().UTC()
+	amzDate := now.Format(s3DateFormat)
+	day := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := day + "/" + s.cfg.Region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), day)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// s3Escape URI-encodes every byte except unreserved characters and '/', as
+// SigV4 requires for S3 object paths.
+func s3Escape(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
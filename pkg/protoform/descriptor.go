@@ -0,0 +1,168 @@
+package protoform
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// FieldBehavior mirrors google.api.FieldBehavior.
+type FieldBehavior int
+
+const (
+	BehaviorOptional        FieldBehavior = 1
+	BehaviorRequired        FieldBehavior = 2
+	BehaviorOutputOnly      FieldBehavior = 3
+	BehaviorInputOnly       FieldBehavior = 4
+	BehaviorImmutable       FieldBehavior = 5
+	BehaviorUnorderedList   FieldBehavior = 6
+	BehaviorNonEmptyDefault FieldBehavior = 7
+	BehaviorIdentifier      FieldBehavior = 8
+)
+
+// fieldBehaviorExtension is the FieldOptions extension number of
+// google.api.field_behavior.
+const fieldBehaviorExtension protowire.Number = 1052
+
+// ParseDescriptorSet decodes a serialized google.protobuf.FileDescriptorSet,
+// as written by `protoc --descriptor_set_out=set.pb --include_imports` or
+// `buf build -o set.pb`. Comments are picked up as descriptions when the set
+// was produced with --include_source_info. Types the set does not include
+// stay unresolved, so well-known types work without their imports.
+func ParseDescriptorSet(raw []byte) (*protoregistry.Files, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(raw, &set); err != nil {
+		return nil, fmt.Errorf("protoform: decode descriptor set: %w", err)
+	}
+	files, err := protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(&set)
+	if err != nil {
+		return nil, fmt.Errorf("protoform: resolve descriptor set: %w", err)
+	}
+	return files, nil
+}
+
+// FindMessage looks up a message by fully-qualified name (e.g.
+// "acme.pets.v1.CreatePetRequest"; a leading dot is ignored).
+func FindMessage(files *protoregistry.Files, name string) (protoreflect.MessageDescriptor, error) {
+	if files == nil {
+		return nil, errors.New("protoform: descriptors are nil")
+	}
+	name = strings.TrimPrefix(strings.TrimSpace(name), ".")
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("protoform: message %q not found", name)
+	}
+	msg, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("protoform: %q is not a message", name)
+	}
+	return msg, nil
+}
+
+// MessageNames lists the messages in files, sorted, skipping map entries.
+func MessageNames(files *protoregistry.Files) []string {
+	if files == nil {
+		return nil
+	}
+	var names []string
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		collectMessageNames(file.Messages(), &names)
+		return true
+	})
+	sort.Strings(names)
+	return names
+}
+
+func collectMessageNames(messages protoreflect.MessageDescriptors, names *[]string) {
+	for i := 0; i < messages.Len(); i++ {
+		msg := messages.Get(i)
+		if msg.IsMapEntry() {
+			continue
+		}
+		*names = append(*names, string(msg.FullName()))
+		collectMessageNames(msg.Messages(), names)
+	}
+}
+
+// FieldBehaviors returns the google.api.field_behavior annotations on field.
+// They are read from the registered extension when the googleapis annotations
+// are linked in, and decoded from the raw options otherwise.
+func FieldBehaviors(field protoreflect.FieldDescriptor) []FieldBehavior {
+	options, ok := field.Options().(*descriptorpb.FieldOptions)
+	if !ok || options == nil {
+		return nil
+	}
+	reflected := options.ProtoReflect()
+	var behaviors []FieldBehavior
+	if ext, err := protoregistry.GlobalTypes.FindExtensionByNumber(reflected.Descriptor().FullName(), fieldBehaviorExtension); err == nil {
+		if xd := ext.TypeDescriptor(); reflected.Has(xd) && xd.IsList() {
+			list := reflected.Get(xd).List()
+			for i := 0; i < list.Len(); i++ {
+				behaviors = append(behaviors, FieldBehavior(list.Get(i).Enum()))
+			}
+		}
+	}
+	for raw := reflected.GetUnknown(); len(raw) > 0; {
+		num, typ, n := protowire.ConsumeTag(raw)
+		if n < 0 {
+			break
+		}
+		raw = raw[n:]
+		value := raw
+		if n = protowire.ConsumeFieldValue(num, typ, raw); n < 0 {
+			break
+		}
+		raw = raw[n:]
+		if num != fieldBehaviorExtension {
+			continue
+		}
+		switch typ {
+		case protowire.VarintType:
+			v, _ := protowire.ConsumeVarint(value)
+			behaviors = append(behaviors, FieldBehavior(v))
+		case protowire.BytesType:
+			packed, _ := protowire.ConsumeBytes(value)
+			for len(packed) > 0 {
+				v, m := protowire.ConsumeVarint(packed)
+				if m < 0 {
+					break
+				}
+				behaviors = append(behaviors, FieldBehavior(v))
+				packed = packed[m:]
+			}
+		}
+	}
+	return behaviors
+}
+
+func hasBehavior(behaviors []FieldBehavior, b FieldBehavior) bool {
+	for _, behavior := range behaviors {
+		if behavior == b {
+			return true
+		}
+	}
+	return false
+}
+
+// leadingComment returns desc's leading comment, or "" when the descriptor
+// set carried no source info.
+func leadingComment(desc protoreflect.Descriptor) string {
+	file := desc.ParentFile()
+	if file == nil {
+		return ""
+	}
+	raw := file.SourceLocations().ByDescriptor(desc).LeadingComments
+	lines := strings.Split(strings.TrimSpace(raw), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.TrimSpace(strings.Join(lines, " "))
+}
@@ -0,0 +1,395 @@
+// Package protoform builds forms from protobuf message descriptors, so
+// gRPC-first services can generate admin forms without an OpenAPI document.
+// Messages are protoreflect descriptors, either from generated code or from a
+// serialized FileDescriptorSet:
+//
+//	raw, _ := os.ReadFile("set.pb") // protoc --include_imports --include_source_info --descriptor_set_out=set.pb
+//	files, err := protoform.ParseDescriptorSet(raw)
+//	msg, err := protoform.FindMessage(files, "acme.pets.v1.CreatePetRequest")
+//	form, err := protoform.Build(msg, protoform.WithEndpoint("/pets"))
+//
+// The message is converted to a schema.Form and built by the regular model
+// builder, mirroring the proto3 JSON mapping: properties use the JSON name
+// (lowerCamelCase unless overridden), scalars map to JSON Schema types,
+// 64-bit integers keep an int64/uint64 format, bytes become base64 strings,
+// enums become string enums of their value names (the zero *_UNSPECIFIED
+// value is dropped), repeated fields become arrays, and nested messages
+// become nested objects. google.protobuf.Timestamp, Duration, FieldMask, and
+// the wrapper types map to their JSON forms. Map fields, Struct, Value, Any,
+// and recursive messages are rejected.
+//
+// google.api.field_behavior annotations are honoured: REQUIRED marks the
+// field required, OUTPUT_ONLY fields are omitted (they cannot be submitted),
+// IDENTIFIER fields are read-only, and IMMUTABLE is exposed as "immutable"
+// field metadata. Proto2 required fields are required as well. Fields of a
+// oneof carry the oneof name as "oneof" metadata, and leading comments become
+// field descriptions.
+package protoform
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+const (
+	extensionNamespace = "x-formgen"
+	defaultMethod      = "POST"
+)
+
+// Option customises how a message is converted.
+type Option func(*options)
+
+type options struct {
+	operationID string
+	endpoint    string
+	method      string
+	summary     string
+	description string
+	protoNames  bool
+	builder     model.Builder
+}
+
+// WithOperationID sets the form operation id. Defaults to the message name
+// with a lower-case first letter (CreatePetRequest becomes
+// "createPetRequest").
+func WithOperationID(id string) Option {
+	return func(o *options) {
+		o.operationID = strings.TrimSpace(id)
+	}
+}
+
+// WithEndpoint sets the form action. Defaults to "/" plus the operation id.
+func WithEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.endpoint = strings.TrimSpace(endpoint)
+	}
+}
+
+// WithMethod sets the HTTP method. Defaults to POST.
+func WithMethod(method string) Option {
+	return func(o *options) {
+		o.method = strings.ToUpper(strings.TrimSpace(method))
+	}
+}
+
+// WithSummary sets the form title and description. The description defaults
+// to the message's leading comment.
+func WithSummary(summary, description string) Option {
+	return func(o *options) {
+		o.summary = summary
+		o.description = description
+	}
+}
+
+// WithProtoNames names properties after the original proto field names
+// (snake_case) instead of their JSON names, like protojson's UseProtoNames.
+func WithProtoNames() Option {
+	return func(o *options) {
+		o.protoNames = true
+	}
+}
+
+// WithBuilder overrides the model builder used by Build. Defaults to
+// model.NewBuilder().
+func WithBuilder(builder model.Builder) Option {
+	return func(o *options) {
+		if builder != nil {
+			o.builder = builder
+		}
+	}
+}
+
+// Build converts msg into a form model. Look messages up in a parsed
+// descriptor set with FindMessage, or pass the descriptor of a generated type
+// (`(&petsv1.CreatePetRequest{}).ProtoReflect().Descriptor()`).
+func Build(msg protoreflect.MessageDescriptor, opts ...Option) (model.FormModel, error) {
+	cfg := resolveOptions(opts)
+	form, err := formFor(msg, cfg)
+	if err != nil {
+		return model.FormModel{}, err
+	}
+	builder := cfg.builder
+	if builder == nil {
+		builder = model.NewBuilder()
+	}
+	result, err := builder.Build(form)
+	if err != nil {
+		return model.FormModel{}, fmt.Errorf("protoform: build form model: %w", err)
+	}
+	if err := builder.Decorate(&result); err != nil {
+		return model.FormModel{}, fmt.Errorf("protoform: decorate form model: %w", err)
+	}
+	return result, nil
+}
+
+// Form converts msg into the normalized schema form the model builder
+// consumes.
+func Form(msg protoreflect.MessageDescriptor, opts ...Option) (schema.Form, error) {
+	return formFor(msg, resolveOptions(opts))
+}
+
+func resolveOptions(opts []Option) options {
+	var cfg options
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return cfg
+}
+
+func formFor(msg protoreflect.MessageDescriptor, cfg options) (schema.Form, error) {
+	if msg == nil {
+		return schema.Form{}, errors.New("protoform: message descriptor is nil")
+	}
+	if msg.IsPlaceholder() {
+		return schema.Form{}, fmt.Errorf("protoform: message %q not found", msg.FullName())
+	}
+
+	id := cfg.operationID
+	if id == "" {
+		id = lowerFirst(string(msg.Name()))
+	}
+	endpoint := cfg.endpoint
+	if endpoint == "" {
+		endpoint = "/" + id
+	}
+	method := cfg.method
+	if method == "" {
+		method = defaultMethod
+	}
+	description := cfg.description
+	if description == "" {
+		description = leadingComment(msg)
+	}
+
+	c := converter{protoNames: cfg.protoNames, visiting: map[protoreflect.FullName]bool{}}
+	body, err := c.objectSchema(msg, "")
+	if err != nil {
+		return schema.Form{}, err
+	}
+	return schema.Form{
+		ID:          id,
+		Method:      method,
+		Endpoint:    endpoint,
+		Summary:     cfg.summary,
+		Description: description,
+		Schema:      body,
+	}, nil
+}
+
+type converter struct {
+	protoNames bool
+	visiting   map[protoreflect.FullName]bool
+}
+
+func (c *converter) objectSchema(msg protoreflect.MessageDescriptor, path string) (schema.Schema, error) {
+	if c.visiting[msg.FullName()] {
+		return schema.Schema{}, fmt.Errorf("protoform: %s: recursive message %s is not supported", displayPath(path), msg.FullName())
+	}
+	c.visiting[msg.FullName()] = true
+	defer delete(c.visiting, msg.FullName())
+
+	out := schema.Schema{Type: "object", Properties: map[string]schema.Schema{}}
+	order := 0
+	fields := msg.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		behaviors := FieldBehaviors(field)
+		if hasBehavior(behaviors, BehaviorOutputOnly) {
+			continue
+		}
+		name := c.propertyName(field)
+		fieldPath := joinPath(path, name)
+		prop, err := c.fieldSchema(field, behaviors, fieldPath)
+		if err != nil {
+			return schema.Schema{}, err
+		}
+		ext := formgenExtension(&prop)
+		ext["order"] = order
+		order++
+		out.Properties[name] = prop
+		if field.Cardinality() == protoreflect.Required || hasBehavior(behaviors, BehaviorRequired) {
+			out.Required = append(out.Required, name)
+		}
+	}
+	return out, nil
+}
+
+func (c *converter) propertyName(field protoreflect.FieldDescriptor) string {
+	if c.protoNames {
+		return string(field.Name())
+	}
+	return field.JSONName()
+}
+
+func (c *converter) fieldSchema(field protoreflect.FieldDescriptor, behaviors []FieldBehavior, path string) (schema.Schema, error) {
+	if field.IsMap() {
+		return schema.Schema{}, fmt.Errorf("protoform: %s: map fields are not supported", displayPath(path))
+	}
+	prop, err := c.kindSchema(field, path)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+	if field.IsList() {
+		items := prop
+		prop = schema.Schema{Type: "array", Items: &items}
+	} else if field.HasDefault() {
+		prop.Default = defaultValue(field)
+	}
+
+	prop.Description = leadingComment(field)
+	if hasBehavior(behaviors, BehaviorIdentifier) {
+		prop.ReadOnly = true
+	}
+	if hasBehavior(behaviors, BehaviorImmutable) {
+		formgenExtension(&prop)["immutable"] = true
+	}
+	if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		formgenExtension(&prop)["oneof"] = string(oneof.Name())
+	}
+	return prop, nil
+}
+
+func (c *converter) kindSchema(field protoreflect.FieldDescriptor, path string) (schema.Schema, error) {
+	switch field.Kind() {
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		return schema.Schema{Type: "number"}, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return schema.Schema{Type: "integer", Format: "int32"}, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return schema.Schema{Type: "integer", Format: "int64"}, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return schema.Schema{Type: "integer", Format: "int32", Minimum: float64Ptr(0)}, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return schema.Schema{Type: "integer", Format: "int64", Minimum: float64Ptr(0)}, nil
+	case protoreflect.BoolKind:
+		return schema.Schema{Type: "boolean"}, nil
+	case protoreflect.StringKind:
+		return schema.Schema{Type: "string"}, nil
+	case protoreflect.BytesKind:
+		return schema.Schema{Type: "string", Format: "byte"}, nil
+	case protoreflect.EnumKind:
+		return c.enumSchema(field.Enum(), path)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return c.messageSchema(field.Message(), path)
+	default:
+		return schema.Schema{}, fmt.Errorf("protoform: %s: unsupported field kind %s", displayPath(path), field.Kind())
+	}
+}
+
+func (c *converter) enumSchema(enum protoreflect.EnumDescriptor, path string) (schema.Schema, error) {
+	if enum.IsPlaceholder() {
+		return schema.Schema{}, fmt.Errorf("protoform: %s: enum %q not found", displayPath(path), enum.FullName())
+	}
+	prop := schema.Schema{Type: "string"}
+	values := enum.Values()
+	for i := 0; i < values.Len(); i++ {
+		value := values.Get(i)
+		if value.Number() == 0 && strings.HasSuffix(string(value.Name()), "_UNSPECIFIED") {
+			continue
+		}
+		prop.Enum = append(prop.Enum, string(value.Name()))
+	}
+	return prop, nil
+}
+
+// wellKnownTypes maps google.protobuf types to their proto3 JSON form.
+var wellKnownTypes = map[protoreflect.FullName]schema.Schema{
+	"google.protobuf.Timestamp":   {Type: "string", Format: "date-time"},
+	"google.protobuf.Duration":    {Type: "string"},
+	"google.protobuf.FieldMask":   {Type: "string"},
+	"google.protobuf.StringValue": {Type: "string"},
+	"google.protobuf.BytesValue":  {Type: "string", Format: "byte"},
+	"google.protobuf.BoolValue":   {Type: "boolean"},
+	"google.protobuf.DoubleValue": {Type: "number"},
+	"google.protobuf.FloatValue":  {Type: "number"},
+	"google.protobuf.Int32Value":  {Type: "integer", Format: "int32"},
+	"google.protobuf.Int64Value":  {Type: "integer", Format: "int64"},
+	"google.protobuf.UInt32Value": {Type: "integer", Format: "int32"},
+	"google.protobuf.UInt64Value": {Type: "integer", Format: "int64"},
+}
+
+func (c *converter) messageSchema(msg protoreflect.MessageDescriptor, path string) (schema.Schema, error) {
+	if known, ok := wellKnownTypes[msg.FullName()]; ok {
+		return known, nil
+	}
+	if msg.FullName().Parent() == "google.protobuf" {
+		return schema.Schema{}, fmt.Errorf("protoform: %s: unsupported type %s", displayPath(path), msg.FullName())
+	}
+	if msg.IsPlaceholder() {
+		return schema.Schema{}, fmt.Errorf("protoform: %s: message %q not found", displayPath(path), msg.FullName())
+	}
+	return c.objectSchema(msg, path)
+}
+
+// defaultValue converts a proto2 default to its proto3 JSON form: enums by
+// value name and bytes as base64.
+func defaultValue(field protoreflect.FieldDescriptor) any {
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		if value := field.DefaultEnumValue(); value != nil {
+			return string(value.Name())
+		}
+		return nil
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(field.Default().Bytes())
+	case protoreflect.FloatKind:
+		return float64(field.Default().Float())
+	}
+	switch value := field.Default().Interface().(type) {
+	case int32:
+		return int64(value)
+	case uint32:
+		return int64(value)
+	default:
+		return value
+	}
+}
+
+func formgenExtension(prop *schema.Schema) map[string]any {
+	if prop.Extensions == nil {
+		prop.Extensions = map[string]any{}
+	}
+	ext, ok := prop.Extensions[extensionNamespace].(map[string]any)
+	if !ok {
+		ext = map[string]any{}
+		prop.Extensions[extensionNamespace] = ext
+	}
+	return ext
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
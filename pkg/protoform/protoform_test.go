@@ -0,0 +1,216 @@
+package protoform_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/protoform"
+)
+
+func behaviors(values ...protoform.FieldBehavior) *descriptorpb.FieldOptions {
+	var packed []byte
+	for _, v := range values {
+		packed = protowire.AppendVarint(packed, uint64(v))
+	}
+	raw := protowire.AppendTag(nil, 1052, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, packed)
+	options := &descriptorpb.FieldOptions{}
+	options.ProtoReflect().SetUnknown(raw)
+	return options
+}
+
+func field(name string, number int32, label descriptorpb.FieldDescriptorProto_Label, kind descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+	f := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  label.Enum(),
+		Type:   kind.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	return f
+}
+
+const (
+	optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+)
+
+func petDescriptorSet(t *testing.T) []byte {
+	t.Helper()
+	displayName := field("display_name", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")
+	displayName.Options = behaviors(protoform.BehaviorRequired)
+	weight := field("weight_grams", 5, optional, descriptorpb.FieldDescriptorProto_TYPE_UINT64, "")
+	weight.JsonName = proto.String("weight")
+	createTime := field("create_time", 6, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp")
+	createTime.Options = behaviors(protoform.BehaviorOutputOnly)
+	chipID := field("chip_id", 8, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")
+	chipID.OneofIndex = proto.Int32(0)
+	tattoo := field("tattoo", 9, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")
+	tattoo.OneofIndex = proto.Int32(0)
+	email := field("email", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")
+	email.Options = behaviors(protoform.BehaviorRequired)
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("pets.proto"),
+		Package:    proto.String("acme.pets.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("CreatePetRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					displayName,
+					field("kind", 2, optional, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".acme.pets.v1.Kind"),
+					field("tags", 3, repeated, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("born_at", 4, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"),
+					weight,
+					createTime,
+					field("owner", 7, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".acme.pets.v1.Owner"),
+					chipID,
+					tattoo,
+				},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("identification")}},
+			},
+			{
+				Name:  proto.String("Owner"),
+				Field: []*descriptorpb.FieldDescriptorProto{email},
+			},
+			{
+				Name: proto.String("Labelled"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("labels", 1, repeated, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".acme.pets.v1.Labelled.LabelsEntry"),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{{
+					Name: proto.String("LabelsEntry"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						field("key", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+						field("value", 2, optional, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					},
+					Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+				}},
+			},
+			{
+				Name: proto.String("Node"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("next", 1, optional, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".acme.pets.v1.Node"),
+				},
+			},
+		},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Kind"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("KIND_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("CAT"), Number: proto.Int32(1)},
+				{Name: proto.String("DOG"), Number: proto.Int32(2)},
+			},
+		}},
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+			{Path: []int32{4, 0}, Span: []int32{3, 0, 10, 1}, LeadingComments: proto.String(" Creates a pet.\n")},
+			{Path: []int32{4, 0, 2, 0}, Span: []int32{4, 2, 30}, LeadingComments: proto.String(" Name shown on the tag.\n")},
+		}},
+	}
+	raw, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	if err != nil {
+		t.Fatalf("marshal descriptor set: %v", err)
+	}
+	return raw
+}
+
+func findMessage(t *testing.T, files *protoregistry.Files, name string) protoreflect.MessageDescriptor {
+	t.Helper()
+	msg, err := protoform.FindMessage(files, name)
+	if err != nil {
+		t.Fatalf("find %s: %v", name, err)
+	}
+	return msg
+}
+
+func TestBuildConvertsMessage(t *testing.T) {
+	files, err := protoform.ParseDescriptorSet(petDescriptorSet(t))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	createPet := findMessage(t, files, "acme.pets.v1.CreatePetRequest")
+	form, err := protoform.Build(createPet, protoform.WithEndpoint("/pets"))
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if form.OperationID != "createPetRequest" || form.Endpoint != "/pets" || form.Method != "POST" || form.Description != "Creates a pet." {
+		t.Fatalf("unexpected form header %+v", form)
+	}
+
+	var names []string
+	fields := map[string]model.Field{}
+	for _, f := range form.Fields {
+		names = append(names, f.Name)
+		fields[f.Name] = f
+	}
+	if got := strings.Join(names, ","); got != "displayName,kind,tags,bornAt,weight,owner,chipId,tattoo" {
+		t.Fatalf("expected declaration order without output-only fields, got %s", got)
+	}
+	if name := fields["displayName"]; !name.Required || name.Description != "Name shown on the tag." {
+		t.Fatalf("unexpected displayName field %+v", name)
+	}
+	if kind := fields["kind"]; len(kind.Enum) != 2 || kind.Enum[0] != "CAT" {
+		t.Fatalf("expected enum without the unspecified value, got %+v", kind.Enum)
+	}
+	if tags := fields["tags"]; tags.Type != model.FieldTypeArray || tags.Items == nil || tags.Items.Type != model.FieldTypeString {
+		t.Fatalf("unexpected tags field %+v", tags)
+	}
+	if born := fields["bornAt"]; born.UIHints["inputType"] != "datetime-local" {
+		t.Fatalf("expected Timestamp as date-time, got %+v", born)
+	}
+	if weight := fields["weight"]; weight.Type != model.FieldTypeInteger {
+		t.Fatalf("unexpected weight field %+v", weight)
+	}
+	owner := fields["owner"]
+	if owner.Type != model.FieldTypeObject || len(owner.Nested) != 1 || !owner.Nested[0].Required {
+		t.Fatalf("unexpected owner field %+v", owner)
+	}
+	if fields["chipId"].Metadata["oneof"] != "identification" {
+		t.Fatalf("expected oneof metadata, got %+v", fields["chipId"].Metadata)
+	}
+
+	protoNames, err := protoform.Form(createPet, protoform.WithProtoNames())
+	if err != nil {
+		t.Fatalf("form: %v", err)
+	}
+	if _, ok := protoNames.Schema.Properties["display_name"]; !ok {
+		t.Fatalf("expected proto field names, got %v", protoNames.Schema.Properties)
+	}
+}
+
+func TestBuildRejectsUnsupportedMessages(t *testing.T) {
+	files, err := protoform.ParseDescriptorSet(petDescriptorSet(t))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if names := protoform.MessageNames(files); !slices.Equal(names, []string{"acme.pets.v1.CreatePetRequest", "acme.pets.v1.Labelled", "acme.pets.v1.Node", "acme.pets.v1.Owner"}) {
+		t.Fatalf("unexpected message names %v", names)
+	}
+	if _, err := protoform.FindMessage(files, "acme.pets.v1.Missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected missing message error, got %v", err)
+	}
+	cases := map[string]string{
+		"acme.pets.v1.Node":     "recursive message",
+		"acme.pets.v1.Labelled": "map fields are not supported",
+	}
+	for message, want := range cases {
+		if _, err := protoform.Form(findMessage(t, files, message)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%s: expected error containing %q, got %v", message, want, err)
+		}
+	}
+	if _, err := protoform.ParseDescriptorSet([]byte{0x0a, 0x05, 0x01}); err == nil {
+		t.Fatal("expected truncated descriptor set to fail")
+	}
+}
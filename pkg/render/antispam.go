@@ -0,0 +1,66 @@
+package render
+
+import "strings"
+
+const (
+	// DefaultHoneypotField is the decoy input name used when
+	// AntiSpam.Honeypot is enabled without an explicit name.
+	DefaultHoneypotField = "website_url"
+	// DefaultSubmitTokenField is the hidden input carrying the
+	// minimum-submit-time token when AntiSpam.SubmitTokenField is empty.
+	DefaultSubmitTokenField = "_formgen_ts"
+	// CaptchaSlotAttribute marks the element CAPTCHA widgets mount into.
+	CaptchaSlotAttribute = "data-formgen-captcha"
+)
+
+// AntiSpam configures spam protection for public-facing forms. Build it with
+// submission.SpamGuard.AntiSpam, which pairs the render side with the
+// verification done on submit. Renderers that do not emit HTML ignore it.
+type AntiSpam struct {
+	// Honeypot names a decoy text input rendered off-screen, hidden from
+	// assistive technology, and skipped by keyboard focus. People leave it
+	// empty; bots that fill every input do not. Empty disables the honeypot.
+	Honeypot string `json:"honeypot,omitempty"`
+	// HoneypotLabel is the decoy label; defaults to "Leave this field empty".
+	HoneypotLabel string `json:"honeypotLabel,omitempty"`
+	// SubmitToken is a signed render timestamp emitted as a hidden input so
+	// the handler can reject submissions that arrive too quickly or from
+	// stale pages. Empty disables the check.
+	SubmitToken string `json:"submitToken,omitempty"`
+	// SubmitTokenField overrides DefaultSubmitTokenField.
+	SubmitTokenField string `json:"submitTokenField,omitempty"`
+	// Captcha reserves a slot for a CAPTCHA widget before the form actions.
+	Captcha *CaptchaSlot `json:"captcha,omitempty"`
+}
+
+// CaptchaSlot describes where and how a CAPTCHA widget should mount. formgen
+// renders only the slot and its data attributes; the host page loads the
+// provider script and the handler verifies the response token.
+type CaptchaSlot struct {
+	// Provider names the CAPTCHA service, e.g. "turnstile", "hcaptcha", or
+	// "recaptcha".
+	Provider string `json:"provider"`
+	// SiteKey is the provider's public site key.
+	SiteKey string `json:"siteKey,omitempty"`
+	// Action labels the form for providers that score by action.
+	Action string `json:"action,omitempty"`
+	// ResponseField is the input name the widget writes its response token
+	// to, e.g. "cf-turnstile-response".
+	ResponseField string `json:"responseField,omitempty"`
+}
+
+// HoneypotField returns the decoy input name, or "" when disabled.
+func (a *AntiSpam) HoneypotField() string {
+	if a == nil {
+		return ""
+	}
+	return strings.TrimSpace(a.Honeypot)
+}
+
+// TokenField returns the submit token input name.
+func (a *AntiSpam) TokenField() string {
+	if a == nil || strings.TrimSpace(a.SubmitTokenField) == "" {
+		return DefaultSubmitTokenField
+	}
+	return strings.TrimSpace(a.SubmitTokenField)
+}
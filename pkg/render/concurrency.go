@@ -0,0 +1,77 @@
+package render
+
+import (
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// ApplyConcurrency moves the version field declared with
+// `x-formgen: {concurrency: {field: ...}}` out of the visible schema and into
+// options.HiddenFields, carrying the loaded version from options.Values (or the
+// field default). Nothing is added when no version is known, as on create
+// forms. An explicit HiddenFields entry for the same name wins.
+func ApplyConcurrency(form *model.FormModel, options *RenderOptions) {
+	if form == nil || options == nil {
+		return
+	}
+	concurrency, ok := model.FormConcurrency(*form)
+	if !ok {
+		return
+	}
+	var field *model.Field
+	form.Fields, field = removeFieldPath(form.Fields, strings.Split(concurrency.Field, "."))
+
+	value, ok := lookupValue(options.Values, concurrency.Field)
+	if !ok && field != nil {
+		value, ok = field.Default, field.Default != nil
+	}
+	if !ok || value == nil {
+		return
+	}
+	if _, exists := options.HiddenFields[concurrency.Field]; exists {
+		return
+	}
+	hidden := make(map[string]string, len(options.HiddenFields)+1)
+	maps.Copy(hidden, options.HiddenFields)
+	hidden[concurrency.Field] = versionString(value)
+	options.HiddenFields = hidden
+}
+
+func removeFieldPath(fields []model.Field, segments []string) ([]model.Field, *model.Field) {
+	for idx, field := range fields {
+		if field.Name != segments[0] {
+			continue
+		}
+		out := make([]model.Field, 0, len(fields))
+		out = append(out, fields[:idx]...)
+		if len(segments) == 1 {
+			out = append(out, fields[idx+1:]...)
+			return out, &field
+		}
+		nested, removed := removeFieldPath(field.Nested, segments[1:])
+		if removed == nil {
+			return fields, nil
+		}
+		field.Nested = nested
+		out = append(out, field)
+		out = append(out, fields[idx+1:]...)
+		return out, removed
+	}
+	return fields, nil
+}
+
+func versionString(value any) string {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed.Format(time.RFC3339Nano)
+	case *time.Time:
+		if typed != nil {
+			return typed.Format(time.RFC3339Nano)
+		}
+		return ""
+	}
+	return Hidden("", value).Value
+}
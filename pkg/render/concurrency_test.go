@@ -0,0 +1,57 @@
+package render
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestApplyConcurrency(t *testing.T) {
+	newForm := func(field string) model.FormModel {
+		return model.FormModel{
+			Metadata: map[string]string{model.ConcurrencyFieldMetadataKey: field},
+			Fields: []model.Field{
+				{Name: "title", Type: model.FieldTypeString},
+				{Name: "version", Type: model.FieldTypeInteger},
+				{Name: "meta", Type: model.FieldTypeObject, Nested: []model.Field{
+					{Name: "owner", Type: model.FieldTypeString},
+					{Name: "updated_at", Type: model.FieldTypeString},
+				}},
+			},
+		}
+	}
+
+	form := newForm("version")
+	caller := map[string]string{"_csrf": "token"}
+	options := RenderOptions{Values: map[string]any{"version": PrefillValue(7, "record")}, HiddenFields: caller}
+	ApplyConcurrency(&form, &options)
+	if len(form.Fields) != 2 || form.Fields[1].Name != "meta" {
+		t.Fatalf("expected version field removed, got %+v", form.Fields)
+	}
+	if want := map[string]string{"_csrf": "token", "version": "7"}; !reflect.DeepEqual(options.HiddenFields, want) {
+		t.Fatalf("hidden fields = %v, want %v", options.HiddenFields, want)
+	}
+	if len(caller) != 1 {
+		t.Fatalf("expected caller hidden fields untouched, got %v", caller)
+	}
+
+	form = newForm("meta.updated_at")
+	stamp := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	options = RenderOptions{Values: map[string]any{"meta": map[string]any{"updated_at": stamp}}}
+	ApplyConcurrency(&form, &options)
+	if nested := form.Fields[2].Nested; len(nested) != 1 || nested[0].Name != "owner" {
+		t.Fatalf("expected nested version field removed, got %+v", nested)
+	}
+	if got := options.HiddenFields["meta.updated_at"]; got != "2026-03-01T12:30:00Z" {
+		t.Fatalf("updated_at hidden value = %q", got)
+	}
+
+	form = newForm("version")
+	options = RenderOptions{}
+	ApplyConcurrency(&form, &options)
+	if len(form.Fields) != 2 || options.HiddenFields != nil {
+		t.Fatalf("expected create form to drop the field without a hidden input, got %+v %v", form.Fields, options.HiddenFields)
+	}
+}
@@ -1,6 +1,7 @@
 package render
 
 import (
+	"encoding/json"
 	"strconv"
 	"strings"
 
@@ -59,6 +60,30 @@ func MapErrorPayload(form model.FormModel, payload map[string][]string) ErrorMap
 	return mapping
 }
 
+// MapServerErrors routes a failed submission response body onto fields using
+// the error layouts detected in the operation's 4xx response schemas (see
+// model.FormModel.ErrorMappings). The boolean reports whether a layout matched
+// the status and produced any errors.
+func MapServerErrors(form model.FormModel, status int, body []byte) (ErrorMapping, bool) {
+	mappings, ok := form.ErrorMappings()
+	if !ok {
+		return ErrorMapping{}, false
+	}
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return ErrorMapping{}, false
+	}
+	for _, candidate := range mappings {
+		if !candidate.MatchesStatus(status) {
+			continue
+		}
+		if payload := candidate.Extract(decoded); len(payload) > 0 {
+			return MapErrorPayload(form, payload), true
+		}
+	}
+	return ErrorMapping{}, false
+}
+
 func normalizeMessages(messages []string) []string {
 	if len(messages) == 0 {
 		return nil
@@ -98,3 +98,37 @@ func TestMergeFormErrors(t *testing.T) {
 		t.Fatalf("merged form errors mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestMapServerErrors_RoutesDeclaredErrorLayouts(t *testing.T) {
+	form := model.FormModel{
+		Fields: []model.Field{
+			{Name: "name", Type: model.FieldTypeString},
+			{Name: "owner", Type: model.FieldTypeObject, Nested: []model.Field{{Name: "email", Type: model.FieldTypeString}}},
+		},
+		Metadata: map[string]string{
+			"errors.mapping": `[{"statuses":["422"],"shape":"list","path":"errors","fieldKey":"source.pointer","messageKey":"detail"}]`,
+		},
+	}
+
+	mapping, ok := render.MapServerErrors(form, 422, []byte(`{"errors":[
+		{"source":{"pointer":"/data/attributes/owner/email"},"detail":"is invalid"},
+		{"source":{"pointer":"/data/attributes/unknown"},"detail":"unexpected"}
+	]}`))
+	if !ok {
+		t.Fatal("expected server errors to be mapped")
+	}
+	want := render.ErrorMapping{
+		Fields: map[string][]string{"owner.email": {"is invalid"}},
+		Form:   []string{"unexpected"},
+	}
+	if diff := cmp.Diff(want, mapping); diff != "" {
+		t.Fatalf("server error mapping mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, ok := render.MapServerErrors(form, 500, []byte(`{"errors":[]}`)); ok {
+		t.Fatal("expected undeclared statuses to be ignored")
+	}
+	if _, ok := render.MapServerErrors(form, 422, []byte(`not json`)); ok {
+		t.Fatal("expected invalid bodies to be ignored")
+	}
+}
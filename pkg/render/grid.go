@@ -0,0 +1,81 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+)
+
+// GridBreakpoints lists the responsive breakpoints recognised by layout hints
+// (`layout.span.<bp>`, `layout.start.<bp>`, `layout.row.<bp>`), from the
+// narrowest to the widest.
+var GridBreakpoints = []string{"sm", "md", "lg", "xl", "2xl"}
+
+// GridClasses maps field layout hints to utility class names so renderers can
+// emit classes (for example Tailwind's `md:col-span-6`) instead of inline grid
+// styles and generated media queries. Each pattern expands "{n}" to the hint
+// value; empty patterns skip that hint.
+type GridClasses struct {
+	// Span formats the column span class, e.g. "col-span-{n}".
+	Span string
+	// Start formats the column start class, e.g. "col-start-{n}".
+	Start string
+	// Row formats the row start class, e.g. "row-start-{n}".
+	Row string
+	// BreakpointPrefix formats the prefix of responsive classes; "{bp}"
+	// expands to the breakpoint name. Defaults to "{bp}:".
+	BreakpointPrefix string
+}
+
+// TailwindGridClasses returns the class patterns matching Tailwind CSS grid
+// utilities.
+func TailwindGridClasses() *GridClasses {
+	return &GridClasses{
+		Span:  "col-span-{n}",
+		Start: "col-start-{n}",
+		Row:   "row-start-{n}",
+	}
+}
+
+// ClassNames returns the classes for the supplied field layout hints. The base
+// span falls back to defaultSpan (typically the grid column count) so every
+// field gets an explicit span class.
+func (g *GridClasses) ClassNames(hints map[string]string, defaultSpan int) []string {
+	if g == nil {
+		return nil
+	}
+	var classes []string
+	appendClass := func(prefix, pattern string, value int) {
+		if pattern == "" || value <= 0 {
+			return
+		}
+		classes = append(classes, prefix+strings.ReplaceAll(pattern, "{n}", strconv.Itoa(value)))
+	}
+
+	span := positiveHint(hints, "layout.span")
+	if span == 0 {
+		span = defaultSpan
+	}
+	appendClass("", g.Span, span)
+	appendClass("", g.Start, positiveHint(hints, "layout.start"))
+	appendClass("", g.Row, positiveHint(hints, "layout.row"))
+
+	prefixPattern := g.BreakpointPrefix
+	if prefixPattern == "" {
+		prefixPattern = "{bp}:"
+	}
+	for _, breakpoint := range GridBreakpoints {
+		prefix := strings.ReplaceAll(prefixPattern, "{bp}", breakpoint)
+		appendClass(prefix, g.Span, positiveHint(hints, "layout.span."+breakpoint))
+		appendClass(prefix, g.Start, positiveHint(hints, "layout.start."+breakpoint))
+		appendClass(prefix, g.Row, positiveHint(hints, "layout.row."+breakpoint))
+	}
+	return classes
+}
+
+func positiveHint(hints map[string]string, key string) int {
+	value, err := strconv.Atoi(strings.TrimSpace(hints[key]))
+	if err != nil || value <= 0 {
+		return 0
+	}
+	return value
+}
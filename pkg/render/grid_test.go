@@ -0,0 +1,33 @@
+package render
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGridClassesClassNames(t *testing.T) {
+	hints := map[string]string{
+		"layout.span":    "12",
+		"layout.span.md": "6",
+		"layout.row.xl":  "2",
+		"layout.span.lg": "invalid",
+	}
+
+	got := TailwindGridClasses().ClassNames(hints, 12)
+	want := []string{"col-span-12", "md:col-span-6", "xl:row-start-2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected classes: %v", got)
+	}
+
+	custom := &GridClasses{Span: "span-{n}", BreakpointPrefix: "{bp}-"}
+	got = custom.ClassNames(map[string]string{"layout.span.sm": "4"}, 12)
+	want = []string{"span-12", "sm-span-4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected custom classes: %v", got)
+	}
+
+	var none *GridClasses
+	if classes := none.ClassNames(hints, 12); classes != nil {
+		t.Fatalf("nil grid classes should yield no classes, got %v", classes)
+	}
+}
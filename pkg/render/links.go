@@ -0,0 +1,77 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FieldLinkMode selects how a cross-form link applies the source value to the
+// target field.
+type FieldLinkMode string
+
+const (
+	// FieldLinkPrefill copies the source value into the target until the user
+	// edits the target directly.
+	FieldLinkPrefill FieldLinkMode = "prefill"
+	// FieldLinkConstrain copies the source value and locks the target to it
+	// while the source holds a value.
+	FieldLinkConstrain FieldLinkMode = "constrain"
+)
+
+// FieldLink connects fields across forms rendered on the same page. From and
+// To use "<formID>.<field path>", where formID matches RenderOptions.FormID
+// of the rendered form, e.g. {From: "mainForm.publisher_id", To:
+// "authorModal.publisher_id"}. An empty Mode means FieldLinkPrefill.
+type FieldLink struct {
+	From string        `json:"from"`
+	To   string        `json:"to"`
+	Mode FieldLinkMode `json:"mode,omitempty"`
+}
+
+// Validate reports whether both endpoints name a form and a field and the
+// mode is supported.
+func (l FieldLink) Validate() error {
+	if err := validateFieldLinkRef(l.From); err != nil {
+		return fmt.Errorf("render: field link from: %w", err)
+	}
+	if err := validateFieldLinkRef(l.To); err != nil {
+		return fmt.Errorf("render: field link to: %w", err)
+	}
+	if strings.TrimSpace(l.From) == strings.TrimSpace(l.To) {
+		return fmt.Errorf("render: field link %q links a field to itself", l.From)
+	}
+	switch l.Mode {
+	case "", FieldLinkPrefill, FieldLinkConstrain:
+		return nil
+	default:
+		return fmt.Errorf("render: field link mode %q is not supported", l.Mode)
+	}
+}
+
+// FieldLinksJSON validates links and encodes them for the runtime `links`
+// config option (initRelationships({links})). Modes default to prefill.
+func FieldLinksJSON(links []FieldLink) ([]byte, error) {
+	out := make([]FieldLink, 0, len(links))
+	for _, link := range links {
+		if err := link.Validate(); err != nil {
+			return nil, err
+		}
+		link.From = strings.TrimSpace(link.From)
+		link.To = strings.TrimSpace(link.To)
+		if link.Mode == "" {
+			link.Mode = FieldLinkPrefill
+		}
+		out = append(out, link)
+	}
+	return json.Marshal(out)
+}
+
+func validateFieldLinkRef(ref string) error {
+	ref = strings.TrimSpace(ref)
+	formID, path, ok := strings.Cut(ref, ".")
+	if !ok || strings.TrimSpace(formID) == "" || strings.TrimSpace(path) == "" {
+		return fmt.Errorf("%q must be <form>.<field>", ref)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFieldLinksJSON(t *testing.T) {
+	data, err := FieldLinksJSON([]FieldLink{
+		{From: " mainForm.publisher_id ", To: "authorModal.publisher_id"},
+		{From: "mainForm.author_id", To: "chapterModal.author_id", Mode: FieldLinkConstrain},
+	})
+	if err != nil {
+		t.Fatalf("FieldLinksJSON: %v", err)
+	}
+	want := `[{"from":"mainForm.publisher_id","to":"authorModal.publisher_id","mode":"prefill"},` +
+		`{"from":"mainForm.author_id","to":"chapterModal.author_id","mode":"constrain"}]`
+	if string(data) != want {
+		t.Fatalf("unexpected json:\n got %s\nwant %s", data, want)
+	}
+}
+
+func TestFieldLinkValidate(t *testing.T) {
+	cases := []struct {
+		link FieldLink
+		want string
+	}{
+		{FieldLink{From: "publisher_id", To: "authorModal.publisher_id"}, "must be <form>.<field>"},
+		{FieldLink{From: "mainForm.publisher_id", To: ".publisher_id"}, "must be <form>.<field>"},
+		{FieldLink{From: "mainForm.a", To: "mainForm.a"}, "links a field to itself"},
+		{FieldLink{From: "mainForm.a", To: "modal.a", Mode: "sync"}, `mode "sync" is not supported`},
+	}
+	for _, tc := range cases {
+		err := tc.link.Validate()
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("Validate(%+v) = %v, want error containing %q", tc.link, err, tc.want)
+		}
+	}
+}
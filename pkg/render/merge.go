@@ -0,0 +1,34 @@
+package render
+
+import "reflect"
+
+// MergeRenderOptions layers override on top of base: every non-zero field of
+// override replaces the base value, except maps, which are merged key by key
+// with override entries winning. Zero values (false, "", nil) never clear a
+// base value, so a default such as OmitAssets cannot be switched off per
+// request.
+func MergeRenderOptions(base, override RenderOptions) RenderOptions {
+	merged := base
+	target := reflect.ValueOf(&merged).Elem()
+	source := reflect.ValueOf(override)
+	for i := range source.NumField() {
+		value := source.Field(i)
+		if value.IsZero() {
+			continue
+		}
+		field := target.Field(i)
+		if value.Kind() == reflect.Map && !field.IsNil() {
+			combined := reflect.MakeMapWithSize(value.Type(), field.Len()+value.Len())
+			for _, entries := range []reflect.Value{field, value} {
+				iter := entries.MapRange()
+				for iter.Next() {
+					combined.SetMapIndex(iter.Key(), iter.Value())
+				}
+			}
+			field.Set(combined)
+			continue
+		}
+		field.Set(value)
+	}
+	return merged
+}
@@ -0,0 +1,40 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestMergeRenderOptionsOverlaysNonZeroFields(t *testing.T) {
+	base := RenderOptions{
+		Method:       "PATCH",
+		OmitAssets:   true,
+		Subset:       model.FieldSubset{Groups: []string{"main"}},
+		HiddenFields: map[string]string{"csrf": "default", "tenant": "acme"},
+		Locale:       "en",
+	}
+	override := RenderOptions{
+		Locale:       "fr",
+		HiddenFields: map[string]string{"csrf": "token"},
+		Values:       map[string]any{"name": "Ada"},
+	}
+
+	got := MergeRenderOptions(base, override)
+	want := RenderOptions{
+		Method:       "PATCH",
+		OmitAssets:   true,
+		Subset:       model.FieldSubset{Groups: []string{"main"}},
+		HiddenFields: map[string]string{"csrf": "token", "tenant": "acme"},
+		Locale:       "fr",
+		Values:       map[string]any{"name": "Ada"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("merged options mismatch (-want +got):\n%s", diff)
+	}
+	if base.HiddenFields["csrf"] != "default" {
+		t.Fatalf("expected base hidden fields untouched, got %v", base.HiddenFields)
+	}
+}
@@ -3,6 +3,7 @@ package render
 import (
 	"errors"
 	"strings"
+	"unicode"
 
 	"github.com/goliatone/go-formgen/pkg/visibility"
 )
@@ -41,6 +42,49 @@ type ChromeClasses struct {
 	Errors string
 	// Grid overrides the grid container used for field layouts.
 	Grid string
+	// Breadcrumbs overrides the breadcrumb navigation rendered in the header.
+	Breadcrumbs string
+}
+
+// HeaderPlacement selects where renderers emit the form header (title,
+// subtitle, breadcrumbs, and description).
+type HeaderPlacement string
+
+const (
+	// HeaderPlacementInside renders the header as the first visible element
+	// inside the form root. This is the default.
+	HeaderPlacementInside HeaderPlacement = "inside"
+	// HeaderPlacementOutside renders the header immediately before the form
+	// root so pages can style it independently of the form body.
+	HeaderPlacementOutside HeaderPlacement = "outside"
+	// HeaderPlacementNone suppresses the header entirely.
+	HeaderPlacementNone HeaderPlacement = "none"
+)
+
+// Breadcrumb is a single entry in the header breadcrumb trail. Entries
+// without an Href render as plain text; the last entry is marked as the
+// current page.
+type Breadcrumb struct {
+	Label string
+	Href  string
+}
+
+// HeaderOptions controls the form header chrome. The title and subtitle come
+// from the layout.title/layout.subtitle UI hints (set by UI schema
+// form.title/form.subtitle).
+type HeaderOptions struct {
+	// Placement selects where the header renders. Empty values default to
+	// HeaderPlacementInside.
+	Placement HeaderPlacement
+	// Breadcrumbs renders a breadcrumb trail above the title.
+	Breadcrumbs []Breadcrumb
+	// BreadcrumbsHTML fills the breadcrumb slot with trusted caller-provided
+	// markup. It takes precedence over Breadcrumbs.
+	BreadcrumbsHTML string
+	// ShowDescription renders the operation description (falling back to its
+	// summary) below the title. A small Markdown subset is supported:
+	// paragraphs, bullet lists, emphasis, inline code, and links.
+	ShowDescription bool
 }
 
 // RenderMode selects how much structural markup a renderer should emit.
@@ -76,6 +120,177 @@ type ThemeConfig struct {
 	AssetURL func(string) string
 }
 
+// LiveOptions opts a rendered form into live refreshes driven by model
+// version events (see package live). When a new version arrives the page
+// re-fetches the form markup and swaps it in, preserving entered values.
+type LiveOptions struct {
+	// EventsURL is the Server-Sent Events endpoint, typically a live.Broker.
+	EventsURL string
+	// FragmentURL returns the re-rendered form markup. Empty values re-fetch
+	// the current page URL.
+	FragmentURL string
+	// Version is the model version the page was rendered with. Events carrying
+	// the same version are ignored; when empty the first event received sets
+	// the baseline.
+	Version string
+}
+
+// TurboOptions renders Hotwire Turbo compatible markup: the form root is
+// wrapped in a <turbo-frame> so Turbo can swap the form in place when a
+// submission is rejected (see package turbo for the response helpers).
+type TurboOptions struct {
+	// FrameID names the wrapping <turbo-frame>. Empty values use
+	// TurboFrameID(form.OperationID).
+	FrameID string
+	// Target sets data-turbo-frame on the form, e.g. "_top" so the redirect
+	// after a successful submission replaces the whole page, not the frame.
+	Target string
+}
+
+// TurboFrameID derives a stable frame id from an operation id, e.g.
+// "createPet" becomes "formgen-create-pet".
+func TurboFrameID(operationID string) string {
+	var b strings.Builder
+	b.WriteString("formgen")
+	separate := true
+	var prev rune
+	for _, r := range operationID {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			separate = true
+			prev = 0
+			continue
+		}
+		if separate || (unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev))) {
+			b.WriteByte('-')
+		}
+		b.WriteRune(unicode.ToLower(r))
+		separate = false
+		prev = r
+	}
+	return b.String()
+}
+
+const (
+	// PreviewEndpointPath is the conventional route that echoes preview
+	// submissions back without persisting them (see httpform.PreviewEcho).
+	PreviewEndpointPath = "/formgen/preview"
+	// DefaultPreviewMessage is the banner text used when PreviewOptions.Message
+	// is empty.
+	DefaultPreviewMessage = "Preview: submissions are not saved."
+)
+
+// PreviewOptions renders a form for reviewing a draft spec: a visible banner
+// is shown, the form submits to an echo endpoint instead of the operation,
+// and destructive actions are disabled.
+type PreviewOptions struct {
+	// Message is the banner text. Empty values use DefaultPreviewMessage.
+	Message string
+	// Endpoint receives submissions in place of the operation endpoint. Empty
+	// values use PreviewEndpointPath.
+	Endpoint string
+}
+
+// Variant selects how a rendered form is presented on the page.
+type Variant string
+
+const (
+	// VariantInline renders the form in place. This is the default.
+	VariantInline Variant = "inline"
+	// VariantModal wraps the form in a hidden dialog shell (overlay, title,
+	// close button, and error container) carrying the data-fg-create-modal or
+	// data-fg-edit-modal attributes that page scripts use to open it from
+	// relationship create/edit actions (see examples/http).
+	VariantModal Variant = "modal"
+)
+
+// ModalMode selects which relationship action opens a modal form.
+type ModalMode string
+
+const (
+	// ModalModeCreate opens the modal from a relationship field's create
+	// action and selects the created record. This is the default.
+	ModalModeCreate ModalMode = "create"
+	// ModalModeEdit opens the modal from a relationship field's edit action
+	// and hydrates it from RecordURL.
+	ModalModeEdit ModalMode = "edit"
+)
+
+// ModalOptions configures VariantModal output. The zero value renders a
+// create modal keyed by the form's operation id.
+type ModalOptions struct {
+	// Mode selects create or edit wiring. Empty values use ModalModeCreate.
+	Mode ModalMode
+	// ActionID matches the relationship action that opens the modal. Empty
+	// values use the operation id.
+	ActionID string
+	// Title is the dialog heading. Empty values use "Create <action>" or
+	// "Edit <action>".
+	Title string
+	// ValueField and LabelField name the record properties used to select the
+	// saved record in the originating field. Empty values use "id" and
+	// "name".
+	ValueField string
+	LabelField string
+	// PrefillField receives the typed search query when a create modal opens.
+	// Empty values use LabelField.
+	PrefillField string
+	// RecordURL is the edit modal's record endpoint template, e.g.
+	// "/api/authors/{id}". Only used by edit modals.
+	RecordURL string
+	// Open renders the dialog visible instead of hidden.
+	Open bool
+}
+
+// DiffOptions renders a read-only, field-by-field comparison for approval
+// screens: RenderOptions.Values holds the current record and Proposed the
+// pending change.
+type DiffOptions struct {
+	// Proposed holds the proposed values, keyed like RenderOptions.Values.
+	Proposed map[string]any
+	// Title is the heading. Empty values use the form title.
+	Title string
+	// HideUnchanged omits unchanged fields instead of listing them in a
+	// collapsed section.
+	HideUnchanged bool
+}
+
+// FilterPreset is a named set of search values. HTML renderers show presets
+// as quick-select chips above GET forms, linking to the form endpoint with the
+// values encoded as a query string.
+type FilterPreset struct {
+	// Name identifies the preset and is emitted as data-formgen-preset.
+	Name string
+	// Label is the chip text. Empty values use Name.
+	Label string
+	// Values holds the field values keyed like RenderOptions.Values.
+	Values map[string]any
+}
+
+// Slot names a page-shell position around the rendered form where host pages
+// can inject navigation, notices, or other trusted markup.
+type Slot string
+
+const (
+	// SlotHeader renders first, ahead of the form header chrome.
+	SlotHeader Slot = "header"
+	// SlotBeforeForm renders immediately before the form root.
+	SlotBeforeForm Slot = "before_form"
+	// SlotAfterForm renders immediately after the form root.
+	SlotAfterForm Slot = "after_form"
+	// SlotFooter renders last, after any after-form content.
+	SlotFooter Slot = "footer"
+)
+
+// IsDestructiveAction reports whether an action kind ("danger", "destructive",
+// or "delete") should be disabled in preview mode.
+func IsDestructiveAction(kind string) bool {
+	switch strings.ToLower(strings.TrimSpace(kind)) {
+	case "danger", "destructive", "delete":
+		return true
+	}
+	return false
+}
+
 // RenderOptions describe per-request data that renderers can use to customise
 // their output without mutating the form model pipeline.
 type RenderOptions struct {
@@ -86,8 +301,9 @@ type RenderOptions struct {
 	// responsible for translating unsupported verbs (PATCH/PUT/DELETE) into
 	// browser-friendly POST submissions plus a hidden _method input when needed.
 	Method string
-	// Subset restricts rendering to fields whose group, tags, or section match
-	// the supplied tokens. Empty subsets leave the form unchanged.
+	// Subset restricts rendering to fields whose group, tags, section, or dotted
+	// path match the supplied filters, minus any excluded paths. Empty subsets
+	// leave the form unchanged.
 	Subset FieldSubset
 	// Values pre-populates rendered controls using dotted field paths (e.g.
 	// "author.email"). Values may be wrapped in ValueWithProvenance to attach
@@ -104,6 +320,10 @@ type RenderOptions struct {
 	// render at the top of the form. These often come from request-scoped or
 	// cross-field validation in the backend.
 	FormErrors []string
+	// FieldOverrides tweaks individual fields for this render, keyed by dotted
+	// field path. Overrides apply after decorators and subset filtering, e.g. to
+	// lock tenant_id to the session tenant for one response.
+	FieldOverrides map[string]FieldOverride
 	// HiddenFields injects name/value pairs as hidden inputs, useful for CSRF
 	// tokens, auth/session hints, optimistic locking versions, or other
 	// submission metadata that should travel with the form without showing up in
@@ -136,9 +356,66 @@ type RenderOptions struct {
 	// IncludeSensitiveDefaults allows descriptor and browser renderers to emit
 	// defaults for fields marked Sensitive. The default is to redact them.
 	IncludeSensitiveDefaults bool
+	// HTMLPolicy controls whether markup in labels, descriptions, and help text
+	// is escaped (the default), reduced to basic formatting, or trusted as-is.
+	HTMLPolicy HTMLPolicy
 	// ChromeClasses overrides high-level CSS class lists in renderer templates.
 	// When nil or empty, renderer defaults are used.
 	ChromeClasses *ChromeClasses
+	// GridClasses switches field grid placement from inline styles to utility
+	// classes (see TailwindGridClasses). When nil, renderers keep their inline
+	// grid styles and responsive CSS.
+	GridClasses *GridClasses
+	// Header controls header placement, breadcrumbs, and the rendered
+	// operation description. When nil, renderers emit the title/subtitle
+	// header inside the form as before.
+	Header *HeaderOptions
+	// Live subscribes the rendered form to model version events so it
+	// refreshes when the OpenAPI document or UI schema changes. Renderers that
+	// do not emit HTML ignore it.
+	Live *LiveOptions
+	// Turbo wraps the form in a Turbo frame for apps that use Hotwire Turbo
+	// instead of the bundled runtime. Renderers that do not emit HTML ignore
+	// it.
+	Turbo *TurboOptions
+	// Preview renders the form in spec-review mode: a banner, submissions
+	// rewritten to a non-persisting echo endpoint, and destructive actions
+	// disabled. Renderers that do not emit HTML ignore it.
+	Preview *PreviewOptions
+	// Variant selects inline (the default) or modal presentation. Renderers
+	// that do not emit HTML ignore it.
+	Variant Variant
+	// Modal configures the dialog shell when Variant is VariantModal.
+	Modal *ModalOptions
+	// Slots fills page-shell slots with trusted caller-provided markup, e.g.
+	// breadcrumbs in SlotHeader or a notice in SlotBeforeForm. Themes can
+	// replace a slot's markup with a "forms.slot.<name>" partial. Renderers
+	// that do not emit HTML ignore it.
+	Slots map[Slot]string
+	// Diff renders a read-only comparison of Values (current) against
+	// Diff.Proposed instead of form controls. Renderers that do not emit HTML
+	// ignore it.
+	Diff *DiffOptions
+	// Presets renders saved filter presets as chips above GET forms. The chip
+	// whose values match Values is marked current. Renderers that do not emit
+	// HTML ignore it.
+	Presets []FilterPreset
+	// FormID names the rendered form so FieldLink references can address it
+	// when several generated forms share a page. Empty values omit the
+	// identifier.
+	FormID string
+	// SubformEndpoint overrides the route used to fetch deferred subform
+	// fragments. Empty values fall back to SubformEndpointPath.
+	SubformEndpoint string
+	// Runtime emits a formgen-config JSON block (cache policy, hooks, endpoint
+	// auth, search timings, field links) that the runtime bundles read on
+	// init. Renderers that do not emit HTML ignore it, and OmitAssets skips it.
+	Runtime *RuntimeConfig
+	// AntiSpam injects a honeypot input, a minimum-submit-time token, and a
+	// CAPTCHA slot. Pair it with submission.SpamGuard, which issues the
+	// options and verifies submissions. Renderers that do not emit HTML
+	// ignore it.
+	AntiSpam *AntiSpam
 }
 
 func missingTranslationDefault(locale, key string, args []any, err error) string {
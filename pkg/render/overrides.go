@@ -0,0 +1,86 @@
+package render
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// FieldOverride tweaks a single field for one render without defining a
+// decorator. Zero values leave the corresponding field attribute unchanged.
+type FieldOverride struct {
+	// Label replaces the field label. Any labelKey hint is dropped so
+	// localization does not overwrite the override.
+	Label string
+	// Hidden renders the field as a hidden input. The value still submits, which
+	// suits server-controlled fields such as tenant identifiers.
+	Hidden bool
+	// Readonly locks the control while keeping it in the submission.
+	Readonly bool
+	// Default replaces the field default. Prefill values supplied through
+	// RenderOptions.Values still take precedence.
+	Default any
+}
+
+// ApplyFieldOverrides applies per-request overrides keyed by dotted field path
+// (e.g. "tenant_id" or "author.email"). Renderers call it after subset
+// filtering so overrides act on the decorated model they are about to emit.
+// Fields along an overridden path are copied, leaving the caller's model
+// untouched.
+func ApplyFieldOverrides(form *model.FormModel, overrides map[string]FieldOverride) {
+	if form == nil || len(overrides) == 0 {
+		return
+	}
+	normalized := make(map[string]FieldOverride, len(overrides))
+	for path, override := range overrides {
+		if path = strings.TrimSpace(path); path != "" {
+			normalized[path] = override
+		}
+	}
+	if len(normalized) == 0 {
+		return
+	}
+	form.Fields = applyFieldOverrides(form.Fields, "", normalized)
+}
+
+func applyFieldOverrides(fields []model.Field, parentPath string, overrides map[string]FieldOverride) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	out := make([]model.Field, len(fields))
+	for idx, field := range fields {
+		path := joinPath(parentPath, field.Name)
+		if override, ok := overrides[path]; ok {
+			applyFieldOverride(&field, override)
+		}
+		if len(field.Nested) > 0 {
+			field.Nested = applyFieldOverrides(field.Nested, path, overrides)
+		}
+		out[idx] = field
+	}
+	return out
+}
+
+func applyFieldOverride(field *model.Field, override FieldOverride) {
+	if label := strings.TrimSpace(override.Label); label != "" {
+		field.Label = label
+		if _, ok := field.UIHints[fieldLabelKeyHint]; ok {
+			hints := maps.Clone(field.UIHints)
+			delete(hints, fieldLabelKeyHint)
+			field.UIHints = hints
+		}
+	}
+	if override.Hidden {
+		hints := make(map[string]string, len(field.UIHints)+1)
+		maps.Copy(hints, field.UIHints)
+		hints["inputType"] = "hidden"
+		field.UIHints = hints
+	}
+	if override.Readonly {
+		field.Readonly = true
+	}
+	if override.Default != nil {
+		field.Default = override.Default
+	}
+}
@@ -0,0 +1,59 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestApplyFieldOverrides(t *testing.T) {
+	form := model.FormModel{
+		Fields: []model.Field{
+			{Name: "tenant_id", Type: model.FieldTypeString, Label: "Tenant"},
+			{
+				Name: "author",
+				Type: model.FieldTypeObject,
+				Nested: []model.Field{
+					{Name: "email", Type: model.FieldTypeString, Label: "Email", UIHints: map[string]string{"labelKey": "fields.email"}},
+				},
+			},
+		},
+	}
+	originalAuthor := form.Fields[1].Nested
+
+	ApplyFieldOverrides(&form, map[string]FieldOverride{
+		"tenant_id":    {Hidden: true, Default: "acme"},
+		"author.email": {Label: "Contact email", Readonly: true},
+		"missing":      {Label: "ignored"},
+	})
+
+	tenant := form.Fields[0]
+	if tenant.UIHints["inputType"] != "hidden" || tenant.Default != "acme" {
+		t.Fatalf("expected hidden tenant with default, got %+v", tenant)
+	}
+	email := form.Fields[1].Nested[0]
+	if email.Label != "Contact email" || !email.Readonly {
+		t.Fatalf("expected nested override to apply, got %+v", email)
+	}
+	if _, ok := email.UIHints["labelKey"]; ok {
+		t.Fatalf("expected labelKey to be dropped for overridden label")
+	}
+	if originalAuthor[0].Label != "Email" || originalAuthor[0].UIHints["labelKey"] == "" {
+		t.Fatalf("expected caller's nested fields to stay untouched, got %+v", originalAuthor[0])
+	}
+}
+
+func TestApplyFieldOverrides_LabelSurvivesLocalization(t *testing.T) {
+	form := model.FormModel{
+		Fields: []model.Field{
+			{Name: "title", Label: "Title", UIHints: map[string]string{"labelKey": "fields.title"}},
+		},
+	}
+
+	ApplyFieldOverrides(&form, map[string]FieldOverride{"title": {Label: "Headline"}})
+	LocalizeFormModel(&form, RenderOptions{Locale: "es"})
+
+	if got := form.Fields[0].Label; got != "Headline" {
+		t.Fatalf("expected override label to survive localization, got %q", got)
+	}
+}
@@ -0,0 +1,82 @@
+package render
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// ApplyParameterFields resolves parameter-backed fields (see Field.Location)
+// for HTML renderers, which can only submit a body or a query string:
+//   - path parameters are interpolated into form.Endpoint and removed;
+//   - header parameters are removed;
+//   - query parameters stay as controls on GET forms. On other methods their
+//     known values are appended to form.Endpoint and the fields removed.
+//
+// Values come from the prefill map and fall back to field defaults. Path
+// parameters without a value keep their {name} placeholder. method overrides
+// form.Method when non-empty, mirroring RenderOptions.Method.
+func ApplyParameterFields(form *model.FormModel, method string, values map[string]any) {
+	if form == nil || len(form.Fields) == 0 {
+		return
+	}
+	if strings.TrimSpace(method) == "" {
+		method = form.Method
+	}
+	isGet := strings.EqualFold(strings.TrimSpace(method), "GET")
+
+	endpoint := form.Endpoint
+	query := url.Values{}
+	kept := form.Fields[:0:0]
+	for _, field := range form.Fields {
+		switch field.Location {
+		case model.LocationPath:
+			if value, ok := parameterValue(field, values); ok {
+				endpoint = strings.ReplaceAll(endpoint, "{"+field.Name+"}", url.PathEscape(value))
+			}
+		case model.LocationHeader:
+		case model.LocationQuery:
+			if isGet {
+				kept = append(kept, field)
+				continue
+			}
+			if value, ok := parameterValue(field, values); ok {
+				query.Set(field.Name, value)
+			}
+		default:
+			kept = append(kept, field)
+		}
+	}
+
+	if len(query) > 0 {
+		base, rawQuery, _ := strings.Cut(endpoint, "?")
+		existing, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			existing = url.Values{}
+		}
+		for key, vals := range query {
+			existing[key] = vals
+		}
+		endpoint = base + "?" + existing.Encode()
+	}
+
+	form.Endpoint = endpoint
+	form.Fields = kept
+}
+
+func parameterValue(field model.Field, values map[string]any) (string, bool) {
+	value, ok := values[field.Name]
+	if wrapped, isWrapped := value.(ValueWithProvenance); ok && isWrapped {
+		value = wrapped.Value
+	}
+	if !ok || value == nil {
+		value = field.Default
+	}
+	if value == nil {
+		return "", false
+	}
+	str := strings.TrimSpace(fmt.Sprint(value))
+	return str, str != ""
+}
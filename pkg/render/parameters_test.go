@@ -0,0 +1,48 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func parameterForm(method string) model.FormModel {
+	return model.FormModel{
+		Method:   method,
+		Endpoint: "/tenants/{tenant}/pets/{petId}?v=1",
+		Fields: []model.Field{
+			{Name: "name"},
+			{Name: "tenant", Location: model.LocationPath, Default: "acme"},
+			{Name: "petId", Location: model.LocationPath},
+			{Name: "dryRun", Location: model.LocationQuery},
+			{Name: "X-Trace", Location: model.LocationHeader},
+		},
+	}
+}
+
+func TestApplyParameterFieldsInterpolatesAction(t *testing.T) {
+	form := parameterForm("PUT")
+	ApplyParameterFields(&form, "", map[string]any{
+		"petId":  PrefillValue("42 b", "db"),
+		"dryRun": true,
+	})
+
+	if form.Endpoint != "/tenants/acme/pets/42%20b?dryRun=true&v=1" {
+		t.Fatalf("endpoint = %q", form.Endpoint)
+	}
+	if len(form.Fields) != 1 || form.Fields[0].Name != "name" {
+		t.Fatalf("fields = %#v, want only body fields", form.Fields)
+	}
+}
+
+func TestApplyParameterFieldsKeepsQueryControlsOnGet(t *testing.T) {
+	form := parameterForm("POST")
+	ApplyParameterFields(&form, "get", nil)
+
+	if form.Endpoint != "/tenants/acme/pets/{petId}?v=1" {
+		t.Fatalf("endpoint = %q, want unresolved placeholder kept", form.Endpoint)
+	}
+	if len(form.Fields) != 2 || form.Fields[1].Name != "dryRun" {
+		t.Fatalf("fields = %#v, want body field plus query control", form.Fields)
+	}
+}
@@ -14,3 +14,10 @@ type Renderer interface {
 	ContentType() string
 	Render(ctx context.Context, model model.FormModel, options RenderOptions) ([]byte, error)
 }
+
+// Versioned is implemented by renderers that version independently of the
+// formgen module, e.g. renderers shipping their own runtime bundle. The
+// version is recorded in generation manifests.
+type Versioned interface {
+	Version() string
+}
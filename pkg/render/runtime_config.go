@@ -0,0 +1,137 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RuntimeConfigElementID is the id of the JSON block the runtime bundles read
+// on init.
+const RuntimeConfigElementID = "formgen-config"
+
+// RuntimeHook names a built-in runtime hook that RuntimeConfig can enable
+// without page JavaScript.
+type RuntimeHook string
+
+const (
+	// RuntimeHookValidationError logs relationship selections rejected by
+	// validation (onValidationError).
+	RuntimeHookValidationError RuntimeHook = "validationError"
+	// RuntimeHookError logs failed relationship option fetches (onError).
+	RuntimeHookError RuntimeHook = "error"
+)
+
+// RuntimeCacheConfig selects the relationship option cache policy. Strategy is
+// "memory" (the runtime default) or "none"; TTLMs applies to "memory" and
+// zero keeps the runtime default of five minutes.
+type RuntimeCacheConfig struct {
+	Strategy string `json:"strategy,omitempty"`
+	TTLMs    int    `json:"ttlMs,omitempty"`
+}
+
+// RuntimeAuth is the default endpoint auth mode for relationship fields whose
+// x-endpoint does not declare one. Strategy "header" reads a token from
+// Source (e.g. "meta:csrf-token") and sends it in Header with an optional
+// Prefix such as "Bearer".
+type RuntimeAuth struct {
+	Strategy string `json:"strategy,omitempty"`
+	Header   string `json:"header,omitempty"`
+	Source   string `json:"source,omitempty"`
+	Prefix   string `json:"prefix,omitempty"`
+}
+
+// RuntimeConfig is rendered as a <script type="application/json"
+// id="formgen-config"> block that initRelationships reads automatically, so
+// pages do not need a hand-written bootstrap script for these settings.
+// Options passed to initRelationships in JavaScript win key by key. Zero
+// values keep the runtime defaults.
+type RuntimeConfig struct {
+	// BaseURL prefixes relative relationship endpoint URLs.
+	BaseURL string `json:"baseUrl,omitempty"`
+	// Cache sets the relationship option cache policy.
+	Cache *RuntimeCacheConfig `json:"cache,omitempty"`
+	// Auth applies to relationship endpoints without their own auth block.
+	Auth *RuntimeAuth `json:"auth,omitempty"`
+	// Hooks enables built-in runtime hooks by name.
+	Hooks []RuntimeHook `json:"hooks,omitempty"`
+	// SearchDebounceMs and SearchThrottleMs tune how often typeahead and
+	// chips widgets query their endpoints while the user types.
+	SearchDebounceMs int `json:"searchDebounceMs,omitempty"`
+	SearchThrottleMs int `json:"searchThrottleMs,omitempty"`
+	// Links connects fields across forms on the page (see FieldLink).
+	Links []FieldLink `json:"links,omitempty"`
+}
+
+// Validate reports unsupported cache strategies, auth strategies, hooks,
+// negative timings, and invalid field links.
+func (c RuntimeConfig) Validate() error {
+	if c.Cache != nil {
+		switch strings.TrimSpace(c.Cache.Strategy) {
+		case "", "memory", "none":
+		default:
+			return fmt.Errorf("render: runtime cache strategy %q is not supported", c.Cache.Strategy)
+		}
+		if c.Cache.TTLMs < 0 {
+			return fmt.Errorf("render: runtime cache ttl must not be negative")
+		}
+	}
+	if c.Auth != nil {
+		switch strings.TrimSpace(c.Auth.Strategy) {
+		case "", "header", "cookie":
+		default:
+			return fmt.Errorf("render: runtime auth strategy %q is not supported", c.Auth.Strategy)
+		}
+	}
+	for _, hook := range c.Hooks {
+		switch hook {
+		case RuntimeHookValidationError, RuntimeHookError:
+		default:
+			return fmt.Errorf("render: runtime hook %q is not supported", hook)
+		}
+	}
+	if c.SearchDebounceMs < 0 || c.SearchThrottleMs < 0 {
+		return fmt.Errorf("render: runtime search timings must not be negative")
+	}
+	for _, link := range c.Links {
+		if err := link.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RuntimeConfigJSON validates cfg and encodes it for the formgen-config block.
+// The encoding escapes <, >, and & so it is safe inside a script element.
+func RuntimeConfigJSON(cfg RuntimeConfig) ([]byte, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg.BaseURL = strings.TrimSpace(cfg.BaseURL)
+	if cfg.Cache != nil {
+		cache := *cfg.Cache
+		cache.Strategy = strings.TrimSpace(cache.Strategy)
+		cfg.Cache = &cache
+	}
+	if cfg.Auth != nil {
+		auth := *cfg.Auth
+		auth.Strategy = strings.TrimSpace(auth.Strategy)
+		auth.Header = strings.TrimSpace(auth.Header)
+		auth.Source = strings.TrimSpace(auth.Source)
+		auth.Prefix = strings.TrimSpace(auth.Prefix)
+		cfg.Auth = &auth
+	}
+	if len(cfg.Links) > 0 {
+		links := make([]FieldLink, 0, len(cfg.Links))
+		for _, link := range cfg.Links {
+			link.From = strings.TrimSpace(link.From)
+			link.To = strings.TrimSpace(link.To)
+			if link.Mode == "" {
+				link.Mode = FieldLinkPrefill
+			}
+			links = append(links, link)
+		}
+		cfg.Links = links
+	}
+	return json.Marshal(cfg)
+}
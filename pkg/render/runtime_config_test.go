@@ -0,0 +1,50 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuntimeConfigJSON(t *testing.T) {
+	data, err := RuntimeConfigJSON(RuntimeConfig{
+		BaseURL: " https://api.example.com ",
+		Cache:   &RuntimeCacheConfig{Strategy: " memory ", TTLMs: 60000},
+		Auth:    &RuntimeAuth{Strategy: "header", Source: "meta:csrf-token", Prefix: "Bearer"},
+	})
+	if err != nil {
+		t.Fatalf("RuntimeConfigJSON: %v", err)
+	}
+	want := `{"baseUrl":"https://api.example.com","cache":{"strategy":"memory","ttlMs":60000},` +
+		`"auth":{"strategy":"header","source":"meta:csrf-token","prefix":"Bearer"}}`
+	if string(data) != want {
+		t.Fatalf("unexpected json:\n got %s\nwant %s", data, want)
+	}
+
+	escaped, err := RuntimeConfigJSON(RuntimeConfig{BaseURL: "</script>"})
+	if err != nil {
+		t.Fatalf("RuntimeConfigJSON: %v", err)
+	}
+	if strings.Contains(string(escaped), "</script>") {
+		t.Fatalf("expected script-safe encoding, got %s", escaped)
+	}
+}
+
+func TestRuntimeConfigValidate(t *testing.T) {
+	cases := []struct {
+		config RuntimeConfig
+		want   string
+	}{
+		{RuntimeConfig{Cache: &RuntimeCacheConfig{Strategy: "disk"}}, `cache strategy "disk" is not supported`},
+		{RuntimeConfig{Cache: &RuntimeCacheConfig{TTLMs: -1}}, "ttl must not be negative"},
+		{RuntimeConfig{Auth: &RuntimeAuth{Strategy: "oauth"}}, `auth strategy "oauth" is not supported`},
+		{RuntimeConfig{Hooks: []RuntimeHook{"beforeFetch"}}, `hook "beforeFetch" is not supported`},
+		{RuntimeConfig{SearchDebounceMs: -5}, "must not be negative"},
+		{RuntimeConfig{Links: []FieldLink{{From: "a", To: "modal.a"}}}, "must be <form>.<field>"},
+	}
+	for _, tc := range cases {
+		err := tc.config.Validate()
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("Validate(%+v) = %v, want error containing %q", tc.config, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package render
+
+import (
+	"html"
+	"strings"
+)
+
+// HTMLPolicy controls how markup in labels, descriptions, and help text (from
+// x-formgen extensions or UI schemas) reaches the rendered page.
+type HTMLPolicy string
+
+const (
+	// HTMLPolicyEscapeAll renders metadata as plain text. It is the default.
+	HTMLPolicyEscapeAll HTMLPolicy = "escape-all"
+	// HTMLPolicyBasicFormatting keeps an allowlist of inline formatting tags
+	// (b, strong, i, em, u, s, code, kbd, small, br, p, ul, ol, li, and links
+	// to http, https, mailto, or relative URLs) and drops everything else,
+	// including script and style content and all other attributes.
+	HTMLPolicyBasicFormatting HTMLPolicy = "basic-formatting"
+	// HTMLPolicyRawTrusted emits metadata unchanged. Use it only when every
+	// spec and UI schema author is trusted with script on the page.
+	HTMLPolicyRawTrusted HTMLPolicy = "raw-trusted"
+)
+
+// SanitizeHTML returns input as HTML that is safe to emit under policy.
+// Unknown policies behave like HTMLPolicyEscapeAll.
+func SanitizeHTML(policy HTMLPolicy, input string) string {
+	switch policy {
+	case HTMLPolicyRawTrusted:
+		return input
+	case HTMLPolicyBasicFormatting:
+		return sanitizeBasicHTML(input)
+	default:
+		return html.EscapeString(input)
+	}
+}
+
+// PolicyMarkup returns text sanitized under policy, ready to emit without
+// further escaping, and reports whether policy allows markup at all. Under
+// HTMLPolicyEscapeAll, the zero value, and unknown policies it reports false
+// and renderers keep escaping the plain text themselves.
+func PolicyMarkup(policy HTMLPolicy, text string) (string, bool) {
+	if policy != HTMLPolicyBasicFormatting && policy != HTMLPolicyRawTrusted {
+		return "", false
+	}
+	return SanitizeHTML(policy, text), true
+}
+
+var basicHTMLTags = map[string]bool{
+	"a": true, "b": true, "br": true, "code": true, "em": true, "i": true,
+	"kbd": true, "li": true, "ol": true, "p": true, "s": true, "small": true,
+	"strong": true, "u": true, "ul": true,
+}
+
+// droppedHTMLContent lists elements whose content is removed with the tag.
+var droppedHTMLContent = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+	"template": true, "textarea": true, "title": true, "noscript": true,
+	"svg": true, "math": true, "xmp": true, "noembed": true, "noframes": true,
+}
+
+// sanitizeBasicHTML rebuilds input from scratch: text is unescaped and
+// re-escaped, allowlisted tags are re-emitted with only vetted attributes, and
+// anything the scanner does not recognise is treated as text.
+func sanitizeBasicHTML(input string) string {
+	var out strings.Builder
+	var open []string
+	text := func(s string) {
+		out.WriteString(html.EscapeString(html.UnescapeString(s)))
+	}
+
+	for len(input) > 0 {
+		lt := strings.IndexByte(input, '<')
+		if lt < 0 {
+			text(input)
+			break
+		}
+		text(input[:lt])
+		input = input[lt:]
+
+		if strings.HasPrefix(input, "<!--") {
+			end := strings.Index(input[4:], "-->")
+			if end < 0 {
+				break
+			}
+			input = input[4+end+3:]
+			continue
+		}
+
+		tag, rest, ok := scanHTMLTag(input)
+		if !ok {
+			text("<")
+			input = input[1:]
+			continue
+		}
+		input = rest
+
+		switch {
+		case droppedHTMLContent[tag.name] && !tag.closing:
+			input = skipHTMLElement(input, tag.name)
+		case !basicHTMLTags[tag.name]:
+			// Unknown tags are dropped; their text content is kept.
+		case tag.closing:
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != tag.name {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					out.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		case tag.name == "br":
+			out.WriteString("<br>")
+		default:
+			out.WriteString("<" + tag.name)
+			if tag.name == "a" {
+				if href, ok := safeHref(tag.attrs["href"]); ok {
+					out.WriteString(` href="` + html.EscapeString(href) + `"`)
+				}
+				if title := tag.attrs["title"]; title != "" {
+					out.WriteString(` title="` + html.EscapeString(title) + `"`)
+				}
+				out.WriteString(` rel="noopener noreferrer"`)
+			}
+			out.WriteString(">")
+			if !tag.selfClosing {
+				open = append(open, tag.name)
+			}
+		}
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		out.WriteString("</" + open[i] + ">")
+	}
+	return out.String()
+}
+
+type htmlTag struct {
+	name        string
+	closing     bool
+	selfClosing bool
+	attrs       map[string]string
+}
+
+// scanHTMLTag parses the tag at the start of s (which begins with '<') and
+// returns the remaining input. Attribute values are entity-decoded.
+func scanHTMLTag(s string) (htmlTag, string, bool) {
+	var tag htmlTag
+	i := 1
+	if i < len(s) && s[i] == '/' {
+		tag.closing = true
+		i++
+	}
+	start := i
+	for i < len(s) && isHTMLNameByte(s[i]) {
+		i++
+	}
+	if i == start || !isHTMLLetter(s[start]) {
+		return htmlTag{}, s, false
+	}
+	tag.name = strings.ToLower(s[start:i])
+
+	for i < len(s) {
+		switch c := s[i]; {
+		case c == '>':
+			return tag, s[i+1:], true
+		case c == '/' || isHTMLSpace(c):
+			if c == '/' && i+1 < len(s) && s[i+1] == '>' {
+				tag.selfClosing = true
+			}
+			i++
+		default:
+			nameStart := i
+			for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '=' && s[i] != '>' && s[i] != '/' {
+				i++
+			}
+			name := strings.ToLower(s[nameStart:i])
+			for i < len(s) && isHTMLSpace(s[i]) {
+				i++
+			}
+			value := ""
+			if i < len(s) && s[i] == '=' {
+				i++
+				for i < len(s) && isHTMLSpace(s[i]) {
+					i++
+				}
+				if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+					quote := s[i]
+					end := strings.IndexByte(s[i+1:], quote)
+					if end < 0 {
+						return htmlTag{}, s, false
+					}
+					value = s[i+1 : i+1+end]
+					i += end + 2
+				} else {
+					valueStart := i
+					for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '>' {
+						i++
+					}
+					value = s[valueStart:i]
+				}
+			}
+			if tag.attrs == nil {
+				tag.attrs = make(map[string]string)
+			}
+			if _, seen := tag.attrs[name]; !seen && name != "" {
+				tag.attrs[name] = html.UnescapeString(value)
+			}
+		}
+	}
+	return htmlTag{}, s, false
+}
+
+// skipHTMLElement drops everything up to and including the closing tag of
+// name, or the rest of the input when it is never closed.
+func skipHTMLElement(s, name string) string {
+	lower := strings.ToLower(s)
+	closing := "</" + name
+	for offset := 0; ; {
+		idx := strings.Index(lower[offset:], closing)
+		if idx < 0 {
+			return ""
+		}
+		end := offset + idx + len(closing)
+		if end == len(s) || s[end] == '>' || isHTMLSpace(s[end]) || s[end] == '/' {
+			if gt := strings.IndexByte(s[end:], '>'); gt >= 0 {
+				return s[end+gt+1:]
+			}
+			return ""
+		}
+		offset = end
+	}
+}
+
+// safeHref accepts relative URLs and http, https, and mailto URLs. Browsers
+// ignore ASCII whitespace and control characters inside schemes, so they are
+// stripped before the scheme check.
+func safeHref(raw string) (string, bool) {
+	href := strings.TrimSpace(raw)
+	if href == "" {
+		return "", false
+	}
+	var compact strings.Builder
+	for _, r := range href {
+		if r > ' ' && r != 0x7f {
+			compact.WriteRune(r)
+		}
+	}
+	cleaned := compact.String()
+	colon := strings.IndexByte(cleaned, ':')
+	if colon < 0 || strings.ContainsAny(cleaned[:colon], "/?#") {
+		return href, true
+	}
+	switch strings.ToLower(cleaned[:colon]) {
+	case "http", "https", "mailto":
+		return href, true
+	default:
+		return "", false
+	}
+}
+
+func isHTMLLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isHTMLNameByte(c byte) bool {
+	return isHTMLLetter(c) || (c >= '0' && c <= '9') || c == '-'
+}
+
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
@@ -0,0 +1,60 @@
+package render
+
+import (
+	"testing"
+)
+
+func TestSanitizeHTMLBasicFormatting(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "plain text", input: "Tom & Jerry", want: "Tom &amp; Jerry"},
+		{name: "allowed tags", input: "Use <strong>bold</strong> and <em>em</em><br/>now", want: "Use <strong>bold</strong> and <em>em</em><br>now"},
+		{name: "script content dropped", input: "a<script>alert(1)</script>b", want: "ab"},
+		{name: "unclosed script dropped", input: "a<SCRIPT>alert(1)", want: "a"},
+		{name: "event handler stripped", input: `<b onclick="x()">hi</b><img src=x onerror=alert(1)>`, want: "<b>hi</b>"},
+		{name: "javascript href removed", input: `<a href=" java&#x09;script:alert(1)">x</a>`, want: `<a rel="noopener noreferrer">x</a>`},
+		{name: "safe href kept", input: `<a href="https://example.com/?a=1&amp;b=2" title="Docs" target="_blank">docs</a>`, want: `<a href="https://example.com/?a=1&amp;b=2" title="Docs" rel="noopener noreferrer">docs</a>`},
+		{name: "relative href kept", input: `<a href="/help#fields">help</a>`, want: `<a href="/help#fields" rel="noopener noreferrer">help</a>`},
+		{name: "unknown tags keep text", input: `<div class="x"><span>text</span></div>`, want: "text"},
+		{name: "comments dropped", input: "a<!-- <script>x</script> -->b", want: "ab"},
+		{name: "unclosed tags closed", input: "<ul><li><b>one", want: "<ul><li><b>one</b></li></ul>"},
+		{name: "misnested tags", input: "<b><i>x</b>y</i>", want: "<b><i>x</i></b>y"},
+		{name: "stray brackets escaped", input: "1 < 2 > 0 <3", want: "1 &lt; 2 &gt; 0 &lt;3"},
+		{name: "encoded markup stays text", input: "&lt;script&gt;", want: "&lt;script&gt;"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := SanitizeHTML(HTMLPolicyBasicFormatting, tc.input); got != tc.want {
+				t.Fatalf("SanitizeHTML(%q) = %q, want %q", tc.input, got, tc.want)
+			}
+		})
+	}
+
+	if got := SanitizeHTML(HTMLPolicyEscapeAll, "<b>x</b>"); got != "&lt;b&gt;x&lt;/b&gt;" {
+		t.Fatalf("escape-all should escape markup, got %q", got)
+	}
+	if got := SanitizeHTML("", "<b>x</b>"); got != "&lt;b&gt;x&lt;/b&gt;" {
+		t.Fatalf("zero policy should escape markup, got %q", got)
+	}
+	if got := SanitizeHTML(HTMLPolicyRawTrusted, "<b onclick=x>x</b>"); got != "<b onclick=x>x</b>" {
+		t.Fatalf("raw-trusted should pass markup through, got %q", got)
+	}
+}
+
+func TestPolicyMarkup(t *testing.T) {
+	payload := `<b>Short</b> <img src=x onerror=alert(1)>`
+	for _, policy := range []HTMLPolicy{"", HTMLPolicyEscapeAll, "unknown"} {
+		if markup, ok := PolicyMarkup(policy, payload); ok || markup != "" {
+			t.Fatalf("policy %q should not allow markup, got %q", policy, markup)
+		}
+	}
+	if markup, ok := PolicyMarkup(HTMLPolicyBasicFormatting, payload); !ok || markup != "<b>Short</b> " {
+		t.Fatalf("unexpected basic-formatting markup %q (%v)", markup, ok)
+	}
+	if markup, ok := PolicyMarkup(HTMLPolicyRawTrusted, payload); !ok || markup != payload {
+		t.Fatalf("unexpected raw-trusted markup %q (%v)", markup, ok)
+	}
+}
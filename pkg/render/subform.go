@@ -0,0 +1,118 @@
+package render
+
+import (
+	"errors"
+	"maps"
+	"net/url"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	// SubformEndpointPath is the conventional route that serves lazily loaded
+	// subform fragments. Hosts mount a handler here that calls
+	// Orchestrator.GenerateSubform.
+	SubformEndpointPath = "/formgen/subform"
+	// SubformOperationParam carries the operation/form identifier.
+	SubformOperationParam = "operation"
+	// SubformPathParam carries the dotted path of the deferred field.
+	SubformPathParam = "path"
+	// SubformSourceMetadataKey stores the fragment URL renderers attach to
+	// deferred object fields.
+	SubformSourceMetadataKey = "subform.src"
+	// SubformLazyHintKey marks an object field as deferred. Renderers emit a
+	// placeholder instead of nested controls and the runtime fetches the
+	// fragment when the user expands it.
+	SubformLazyHintKey = "lazy"
+)
+
+// SubformQuery describes a parsed lazy subform request.
+type SubformQuery struct {
+	OperationID string
+	FieldPath   string
+}
+
+// SubformURL builds the fragment URL for a deferred field. When endpoint is
+// empty SubformEndpointPath is used. Existing query parameters on endpoint are
+// preserved.
+func SubformURL(endpoint, operationID, fieldPath string) string {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		endpoint = SubformEndpointPath
+	}
+	query := url.Values{}
+	query.Set(SubformOperationParam, strings.TrimSpace(operationID))
+	query.Set(SubformPathParam, strings.TrimSpace(fieldPath))
+
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return endpoint + separator + query.Encode()
+}
+
+// ParseSubformQuery extracts the operation and field path from a subform
+// request query string.
+func ParseSubformQuery(values url.Values) (SubformQuery, error) {
+	query := SubformQuery{
+		OperationID: strings.TrimSpace(values.Get(SubformOperationParam)),
+		FieldPath:   strings.TrimSpace(values.Get(SubformPathParam)),
+	}
+	if query.OperationID == "" {
+		return SubformQuery{}, errors.New("render: subform operation is required")
+	}
+	if query.FieldPath == "" {
+		return SubformQuery{}, errors.New("render: subform path is required")
+	}
+	return query, nil
+}
+
+// IsLazySubform reports whether a field opted into deferred rendering. Only
+// object fields with nested children qualify; the hint is ignored elsewhere.
+func IsLazySubform(field model.Field) bool {
+	if field.Type != model.FieldTypeObject || len(field.Nested) == 0 {
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(field.UIHints[SubformLazyHintKey])) {
+	case "true", "1", "yes", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+// AnnotateLazySubforms stamps SubformSourceMetadataKey on every deferred
+// object field so renderers can emit a placeholder that points at the fragment
+// endpoint. Fields that already carry a source are left untouched. Array item
+// schemas are not traversed because repeaters clone their item templates
+// client-side.
+func AnnotateLazySubforms(form *model.FormModel, endpoint string) {
+	if form == nil || strings.TrimSpace(form.OperationID) == "" {
+		return
+	}
+	form.Fields = annotateLazySubforms(form.Fields, "", form.OperationID, endpoint)
+}
+
+func annotateLazySubforms(fields []model.Field, parentPath, operationID, endpoint string) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	out := make([]model.Field, len(fields))
+	for idx, field := range fields {
+		path := joinPath(parentPath, field.Name)
+		switch {
+		case IsLazySubform(field):
+			if strings.TrimSpace(field.Metadata[SubformSourceMetadataKey]) == "" {
+				metadata := make(map[string]string, len(field.Metadata)+1)
+				maps.Copy(metadata, field.Metadata)
+				metadata[SubformSourceMetadataKey] = SubformURL(endpoint, operationID, path)
+				field.Metadata = metadata
+			}
+		case len(field.Nested) > 0:
+			field.Nested = annotateLazySubforms(field.Nested, path, operationID, endpoint)
+		}
+		out[idx] = field
+	}
+	return out
+}
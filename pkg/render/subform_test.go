@@ -0,0 +1,69 @@
+package render
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestSubformURL(t *testing.T) {
+	if got := SubformURL("", "createPet", "owner.address"); got != "/formgen/subform?operation=createPet&path=owner.address" {
+		t.Fatalf("unexpected default url %q", got)
+	}
+	if got := SubformURL("/admin/fragments?tenant=a", "createPet", "owner"); got != "/admin/fragments?tenant=a&operation=createPet&path=owner" {
+		t.Fatalf("unexpected custom url %q", got)
+	}
+}
+
+func TestParseSubformQuery(t *testing.T) {
+	query, err := ParseSubformQuery(url.Values{"operation": {" createPet "}, "path": {"owner.address"}})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if query.OperationID != "createPet" || query.FieldPath != "owner.address" {
+		t.Fatalf("unexpected query %+v", query)
+	}
+	if _, err := ParseSubformQuery(url.Values{"operation": {"createPet"}}); err == nil {
+		t.Fatalf("expected missing path error")
+	}
+	if _, err := ParseSubformQuery(url.Values{"path": {"owner"}}); err == nil {
+		t.Fatalf("expected missing operation error")
+	}
+}
+
+func TestAnnotateLazySubforms(t *testing.T) {
+	lazy := map[string]string{SubformLazyHintKey: "true"}
+	form := model.FormModel{
+		OperationID: "createPet",
+		Fields: []model.Field{
+			{Name: "name", Type: model.FieldTypeString, UIHints: lazy},
+			{
+				Name: "owner",
+				Type: model.FieldTypeObject,
+				Nested: []model.Field{
+					{
+						Name:    "address",
+						Type:    model.FieldTypeObject,
+						UIHints: lazy,
+						Nested:  []model.Field{{Name: "street", Type: model.FieldTypeString}},
+					},
+				},
+			},
+		},
+	}
+	original := form.Fields[1].Nested[0].Metadata
+
+	AnnotateLazySubforms(&form, "")
+
+	if _, ok := form.Fields[0].Metadata[SubformSourceMetadataKey]; ok {
+		t.Fatalf("expected scalar fields to ignore the lazy hint")
+	}
+	got := form.Fields[1].Nested[0].Metadata[SubformSourceMetadataKey]
+	if got != "/formgen/subform?operation=createPet&path=owner.address" {
+		t.Fatalf("unexpected subform source %q", got)
+	}
+	if original != nil {
+		t.Fatalf("expected source metadata to be copied, not shared")
+	}
+}
@@ -1,11 +1,22 @@
 package render
 
 import (
+	"crypto/rand"
 	"fmt"
 	"sort"
 	"strings"
 )
 
+const (
+	// DefaultIdempotencyKeyField is the hidden input name used by
+	// IdempotencyKey when no name is supplied, and the field the client
+	// runtime's submitQueue behavior reads by default.
+	DefaultIdempotencyKeyField = "_idempotency_key"
+	// IdempotencyKeyHeader is the request header the submitQueue behavior
+	// sets from the hidden field on every attempt.
+	IdempotencyKeyHeader = "Idempotency-Key"
+)
+
 // HiddenField represents a hidden form input emitted alongside the visible
 // schema. Use the helpers (CSRFToken, AuthToken, VersionField) to add common
 // fields without repeating boilerplate.
@@ -41,6 +52,32 @@ func VersionField(name string, version any) HiddenField {
 	return Hidden(name, version)
 }
 
+// IdempotencyKey constructs a hidden field carrying a fresh random key. Render
+// it once per form instance so every retry of the same submission reuses the
+// key and the handler can discard duplicates. An empty name falls back to
+// DefaultIdempotencyKeyField.
+func IdempotencyKey(name string) (HiddenField, error) {
+	key, err := NewIdempotencyKey()
+	if err != nil {
+		return HiddenField{}, err
+	}
+	if strings.TrimSpace(name) == "" {
+		name = DefaultIdempotencyKeyField
+	}
+	return Hidden(name, key), nil
+}
+
+// NewIdempotencyKey returns a random RFC 4122 version 4 UUID.
+func NewIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("render: generate idempotency key: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
 // MergeHiddenFields returns a copy of base with the provided fields applied.
 // Empty names are ignored; later fields win on name collisions.
 func MergeHiddenFields(base map[string]string, fields ...HiddenField) map[string]string {
@@ -1,6 +1,7 @@
 package render_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -42,3 +43,24 @@ func TestMergeAndSortHiddenFields(t *testing.T) {
 		t.Fatalf("sorted hidden fields mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestIdempotencyKeyGeneratesUniqueHiddenFields(t *testing.T) {
+	first, err := render.IdempotencyKey("")
+	if err != nil {
+		t.Fatalf("IdempotencyKey: %v", err)
+	}
+	second, err := render.IdempotencyKey("request_id")
+	if err != nil {
+		t.Fatalf("IdempotencyKey: %v", err)
+	}
+	if first.Name != render.DefaultIdempotencyKeyField || second.Name != "request_id" {
+		t.Fatalf("unexpected field names %q, %q", first.Name, second.Name)
+	}
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if !uuid.MatchString(first.Value) || !uuid.MatchString(second.Value) {
+		t.Fatalf("expected v4 UUIDs, got %q and %q", first.Value, second.Value)
+	}
+	if first.Value == second.Value {
+		t.Fatal("expected distinct keys")
+	}
+}
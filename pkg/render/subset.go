@@ -2,8 +2,8 @@ package render
 
 import "github.com/goliatone/go-formgen/pkg/model"
 
-// FieldSubset describes the allowed groups, tags, or sections for partial
-// rendering. This is a compatibility alias to the renderer-free model type.
+// FieldSubset describes the allowed groups, tags, sections, or field paths for
+// partial rendering. This is a compatibility alias to the renderer-free model type.
 type FieldSubset = model.FieldSubset
 
 // ApplySubset removes fields that do not match the supplied subset filters.
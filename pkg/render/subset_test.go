@@ -94,6 +94,90 @@ func TestApplySubset_EmptyTokensNoop(t *testing.T) {
 	}
 }
 
+func TestApplySubset_ByPaths(t *testing.T) {
+	form := sampleFormModel()
+	form.Fields = append(form.Fields, model.Field{
+		Name: "author",
+		Type: model.FieldTypeObject,
+		Nested: []model.Field{
+			{Name: "id"},
+			{Name: "email"},
+			{Name: "address", Type: model.FieldTypeObject, Nested: []model.Field{{Name: "street"}, {Name: "city"}}},
+		},
+	})
+
+	ApplySubset(&form, FieldSubset{
+		Paths: []string{"name", "author.email", "author.address"},
+	})
+
+	if got := names(form.Fields); !reflect.DeepEqual(got, []string{"name", "author"}) {
+		t.Fatalf("expected name and author to remain, got %v", got)
+	}
+	author := form.Fields[1]
+	if got := names(author.Nested); !reflect.DeepEqual(got, []string{"email", "address"}) {
+		t.Fatalf("expected selected author children, got %v", got)
+	}
+	if got := names(author.Nested[1].Nested); !reflect.DeepEqual(got, []string{"street", "city"}) {
+		t.Fatalf("expected selected parent to keep all children, got %v", got)
+	}
+	sections := parseSectionsMetadata(t, form.Metadata["layout.sections"])
+	if !reflect.DeepEqual(sections, []string{"overview"}) {
+		t.Fatalf("expected overview section metadata, got %v", sections)
+	}
+}
+
+func TestApplySubset_PathsCombineWithTags(t *testing.T) {
+	form := sampleFormModel()
+
+	ApplySubset(&form, FieldSubset{
+		Tags:  []string{"list"},
+		Paths: []string{"untagged"},
+	})
+
+	if got := names(form.Fields); !reflect.DeepEqual(got, []string{"tags", "untagged"}) {
+		t.Fatalf("expected tag and path matches, got %v", got)
+	}
+}
+
+func TestApplySubset_ExcludeOnly(t *testing.T) {
+	form := sampleFormModel()
+	form.Fields = append(form.Fields, model.Field{
+		Name: "contributors",
+		Type: model.FieldTypeArray,
+		Items: &model.Field{
+			Type:   model.FieldTypeObject,
+			Nested: []model.Field{{Name: "id"}, {Name: "name"}},
+		},
+	})
+	original := form.Fields[4].Items
+
+	ApplySubset(&form, FieldSubset{
+		Exclude: []string{"settings", "contributors.items.id"},
+	})
+
+	if got := names(form.Fields); !reflect.DeepEqual(got, []string{"name", "tags", "untagged", "contributors"}) {
+		t.Fatalf("expected settings to be excluded, got %v", got)
+	}
+	if got := names(form.Fields[3].Items.Nested); !reflect.DeepEqual(got, []string{"name"}) {
+		t.Fatalf("expected item id to be excluded, got %v", got)
+	}
+	if got := names(original.Nested); !reflect.DeepEqual(got, []string{"id", "name"}) {
+		t.Fatalf("expected source item definition to stay untouched, got %v", got)
+	}
+	if _, ok := form.Metadata["layout.fieldOrder.advanced"]; ok {
+		t.Fatalf("unexpected layout.fieldOrder.advanced after excluding settings")
+	}
+}
+
+func TestFieldSubset_Empty(t *testing.T) {
+	if !(FieldSubset{Paths: []string{" "}}).Empty() {
+		t.Fatalf("expected blank paths to be treated as empty")
+	}
+	if (FieldSubset{Exclude: []string{"id"}}).Empty() {
+		t.Fatalf("expected exclude-only subset to be non-empty")
+	}
+}
+
 func sampleFormModel() model.FormModel {
 	metadata := map[string]string{
 		"layout.sections":            `[{"id":"overview","title":"Overview","order":0},{"id":"content","title":"Content","order":1},{"id":"advanced","title":"Advanced","order":2}]`,
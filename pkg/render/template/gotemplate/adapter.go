@@ -15,6 +15,7 @@ import (
 	"github.com/flosch/pongo2/v6"
 	gotemplatepkg "github.com/goliatone/go-template"
 
+	"github.com/goliatone/go-formgen/pkg/deprecation"
 	"github.com/goliatone/go-formgen/pkg/render/template"
 )
 
@@ -90,7 +91,16 @@ func WithGlobalData(data map[string]any) Option {
 
 // WithGoTemplateOptions exists for backward compatibility with earlier versions
 // of this adapter but is currently a no-op.
+//
+// Deprecated: the adapter no longer wraps go-template; use WithFS,
+// WithTemplateFunc, and WithGlobalData instead.
 func WithGoTemplateOptions(_ ...gotemplatepkg.Option) Option {
+	deprecation.Report(deprecation.Notice{
+		Symbol:      "gotemplate.WithGoTemplateOptions",
+		Since:       "v0.31",
+		RemovedIn:   "v2",
+		Replacement: "gotemplate.WithFS, WithTemplateFunc, and WithGlobalData",
+	})
 	return func(*config) {}
 }
 
@@ -0,0 +1,333 @@
+package gotemplate
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/flosch/pongo2/v6"
+)
+
+// LintSeverity ranks lint issues. Errors make a bundle unusable; warnings flag
+// likely mistakes that may still render.
+type LintSeverity string
+
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+)
+
+// Lint issue codes.
+const (
+	LintCodeParse             = "parse"
+	LintCodeMissingPartial    = "missing-partial"
+	LintCodeMissingEntrypoint = "missing-entrypoint"
+	LintCodeUnknownFilter     = "unknown-filter"
+	LintCodeUnknownFunction   = "unknown-function"
+	LintCodeUnused            = "unused"
+)
+
+// LintIssue is a single finding. Line is 1-based and zero when the issue does
+// not point at a location.
+type LintIssue struct {
+	Severity LintSeverity `json:"severity"`
+	Code     string       `json:"code"`
+	Template string       `json:"template"`
+	Line     int          `json:"line,omitempty"`
+	Message  string       `json:"message"`
+}
+
+func (i LintIssue) String() string {
+	location := i.Template
+	if i.Line > 0 {
+		location = fmt.Sprintf("%s:%d", i.Template, i.Line)
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", location, i.Severity, i.Message, i.Code)
+}
+
+// LintReport lists the templates checked and the issues found, ordered by
+// template and line.
+type LintReport struct {
+	Templates []string    `json:"templates"`
+	Issues    []LintIssue `json:"issues"`
+}
+
+// HasErrors reports whether any issue has error severity.
+func (r LintReport) HasErrors() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == LintError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err joins the error-severity issues, or returns nil when there are none.
+func (r LintReport) Err() error {
+	var errs []error
+	for _, issue := range r.Issues {
+		if issue.Severity == LintError {
+			errs = append(errs, errors.New(issue.String()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LintOption customizes Lint.
+type LintOption func(*lintConfig)
+
+type lintConfig struct {
+	entrypoints []string
+	roots       []string
+	functions   map[string]struct{}
+}
+
+// WithLintEntrypoints lists templates the bundle must contain, such as
+// "templates/form.tmpl". Entrypoints also count as used.
+func WithLintEntrypoints(names ...string) LintOption {
+	return func(cfg *lintConfig) {
+		cfg.entrypoints = append(cfg.entrypoints, names...)
+	}
+}
+
+// WithLintRoots lists templates rendered directly by code. They may be absent,
+// but when present they and everything they include count as used. Without
+// roots or entrypoints the unused check is skipped.
+func WithLintRoots(names ...string) LintOption {
+	return func(cfg *lintConfig) {
+		cfg.roots = append(cfg.roots, names...)
+	}
+}
+
+// WithLintFunctions declares functions supplied at render time (template
+// funcs or callables in the render data) so calls to them are not reported.
+func WithLintFunctions(names ...string) LintOption {
+	return func(cfg *lintConfig) {
+		for _, name := range names {
+			if name = strings.TrimSpace(name); name != "" {
+				cfg.functions[name] = struct{}{}
+			}
+		}
+	}
+}
+
+var (
+	lintBlockPattern     = regexp.MustCompile(`(?s)\{\{.*?\}\}|\{%.*?%\}`)
+	lintStringPattern    = regexp.MustCompile(`"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'`)
+	lintReferencePattern = regexp.MustCompile(`^\{%-?\s*(include|extends|import)\s+("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*')(.*?)-?%\}$`)
+	lintMacroPattern     = regexp.MustCompile(`^\{%-?\s*macro\s+([A-Za-z_]\w*)`)
+	lintFilterPattern    = regexp.MustCompile(`\|\s*([A-Za-z_]\w*)`)
+	lintCallPattern      = regexp.MustCompile(`(^|[^.\w])([A-Za-z_]\w*)\s*\(`)
+	lintCommentPattern   = regexp.MustCompile(`(?s)\{%-?\s*comment\s*-?%\}.*?\{%-?\s*endcomment\s*-?%\}|\{#.*?#\}`)
+	lintCallKeywords     = map[string]struct{}{
+		"and": {}, "or": {}, "not": {}, "in": {}, "is": {}, "if": {}, "elif": {},
+		"for": {}, "with": {}, "set": {}, "macro": {}, "return": {}, "else": {},
+	}
+)
+
+type lintTemplate struct {
+	name       string
+	source     string
+	references []lintReference
+}
+
+type lintReference struct {
+	target string
+	line   int
+}
+
+// Lint checks every template in fsys with the given extension (".tmpl" when
+// empty): it parses each one, verifies that included, extended, and imported
+// partials exist, reports unknown filters and function calls, checks that the
+// configured entrypoints are present, and lists templates not reachable from
+// the entrypoints and roots. The returned error covers only failures to read
+// fsys; findings are reported as issues.
+func Lint(fsys fs.FS, extension string, options ...LintOption) (LintReport, error) {
+	if fsys == nil {
+		return LintReport{}, errors.New("gotemplate: lint requires a template filesystem")
+	}
+	extension = strings.TrimSpace(extension)
+	if extension == "" {
+		extension = ".tmpl"
+	}
+	if !strings.HasPrefix(extension, ".") {
+		extension = "." + extension
+	}
+	cfg := &lintConfig{functions: make(map[string]struct{})}
+	for _, opt := range options {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	registerDefaultFilters()
+
+	templates := make(map[string]*lintTemplate)
+	var names []string
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() && strings.HasSuffix(name, extension) {
+			data, err := fs.ReadFile(fsys, name)
+			if err != nil {
+				return err
+			}
+			templates[name] = &lintTemplate{name: name, source: string(data)}
+			names = append(names, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return LintReport{}, fmt.Errorf("gotemplate: lint: %w", err)
+	}
+	sort.Strings(names)
+
+	report := LintReport{Templates: names}
+	set := pongo2.NewSet("formgen-lint", pongo2.NewFSLoader(fsys))
+	for _, name := range names {
+		tpl := templates[name]
+		issues := lintSource(tpl, templates, cfg)
+		if len(issues) == 0 {
+			// Scan findings explain most parse failures, so the parser's
+			// message is only reported for otherwise clean templates.
+			if _, err := set.FromFile(name); err != nil {
+				issues = append(issues, LintIssue{Severity: LintError, Code: LintCodeParse, Template: name, Line: parseErrorLine(err), Message: parseErrorMessage(err)})
+			}
+		}
+		report.Issues = append(report.Issues, issues...)
+	}
+
+	for _, entry := range cfg.entrypoints {
+		if _, ok := templates[entry]; !ok {
+			report.Issues = append(report.Issues, LintIssue{Severity: LintError, Code: LintCodeMissingEntrypoint, Template: entry, Message: "required entrypoint is missing"})
+		}
+	}
+	report.Issues = append(report.Issues, lintUnused(names, templates, cfg)...)
+
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		a, b := report.Issues[i], report.Issues[j]
+		if a.Template != b.Template {
+			return a.Template < b.Template
+		}
+		return a.Line < b.Line
+	})
+	return report, nil
+}
+
+func lintSource(tpl *lintTemplate, templates map[string]*lintTemplate, cfg *lintConfig) []LintIssue {
+	var issues []LintIssue
+	source := lintCommentPattern.ReplaceAllStringFunc(tpl.source, blankPreservingLines)
+	locals := make(map[string]struct{})
+	blocks := lintBlockPattern.FindAllStringIndex(source, -1)
+
+	for _, loc := range blocks {
+		block := source[loc[0]:loc[1]]
+		if match := lintMacroPattern.FindStringSubmatch(block); match != nil {
+			locals[match[1]] = struct{}{}
+		}
+		match := lintReferencePattern.FindStringSubmatch(block)
+		if match == nil {
+			continue
+		}
+		line := lineAt(source, loc[0])
+		target := path.Join(path.Dir(tpl.name), strings.Trim(match[2], `"'`))
+		tpl.references = append(tpl.references, lintReference{target: target, line: line})
+		if _, ok := templates[target]; !ok && !strings.Contains(match[3], "if_exists") {
+			issues = append(issues, LintIssue{Severity: LintError, Code: LintCodeMissingPartial, Template: tpl.name, Line: line, Message: fmt.Sprintf("%s references missing template %q", match[1], target)})
+		}
+		if match[1] == "import" {
+			for _, imported := range strings.Split(match[3], ",") {
+				fields := strings.Fields(imported)
+				if len(fields) > 0 {
+					locals[fields[len(fields)-1]] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for _, loc := range blocks {
+		block := source[loc[0]:loc[1]]
+		if lintReferencePattern.MatchString(block) || lintMacroPattern.MatchString(block) {
+			continue
+		}
+		line := lineAt(source, loc[0])
+		code := lintStringPattern.ReplaceAllString(block, `""`)
+		for _, match := range lintFilterPattern.FindAllStringSubmatch(code, -1) {
+			if !pongo2.FilterExists(match[1]) {
+				issues = append(issues, LintIssue{Severity: LintError, Code: LintCodeUnknownFilter, Template: tpl.name, Line: line, Message: fmt.Sprintf("unknown filter %q", match[1])})
+			}
+		}
+		for _, match := range lintCallPattern.FindAllStringSubmatch(code, -1) {
+			name := match[2]
+			if _, ok := lintCallKeywords[name]; ok {
+				continue
+			}
+			if _, ok := locals[name]; ok {
+				continue
+			}
+			if _, ok := cfg.functions[name]; ok {
+				continue
+			}
+			issues = append(issues, LintIssue{Severity: LintWarning, Code: LintCodeUnknownFunction, Template: tpl.name, Line: line, Message: fmt.Sprintf("call to unknown function %q", name)})
+		}
+	}
+	return issues
+}
+
+func lintUnused(names []string, templates map[string]*lintTemplate, cfg *lintConfig) []LintIssue {
+	if len(cfg.entrypoints) == 0 && len(cfg.roots) == 0 {
+		return nil
+	}
+	used := make(map[string]bool, len(names))
+	var visit func(name string)
+	visit = func(name string) {
+		tpl, ok := templates[name]
+		if !ok || used[name] {
+			return
+		}
+		used[name] = true
+		for _, ref := range tpl.references {
+			visit(ref.target)
+		}
+	}
+	for _, name := range append(append([]string(nil), cfg.entrypoints...), cfg.roots...) {
+		visit(name)
+	}
+	var issues []LintIssue
+	for _, name := range names {
+		if !used[name] {
+			issues = append(issues, LintIssue{Severity: LintWarning, Code: LintCodeUnused, Template: name, Message: "template is not an entrypoint and is never included"})
+		}
+	}
+	return issues
+}
+
+func parseErrorLine(err error) int {
+	var perr *pongo2.Error
+	if errors.As(err, &perr) {
+		return perr.Line
+	}
+	return 0
+}
+
+func parseErrorMessage(err error) string {
+	var perr *pongo2.Error
+	if errors.As(err, &perr) && perr.OrigError != nil {
+		return perr.OrigError.Error()
+	}
+	return err.Error()
+}
+
+func lineAt(source string, offset int) int {
+	return strings.Count(source[:offset], "\n") + 1
+}
+
+// blankPreservingLines replaces a comment with its newlines so line numbers
+// after it stay accurate.
+func blankPreservingLines(comment string) string {
+	return strings.Repeat("\n", strings.Count(comment, "\n"))
+}
@@ -0,0 +1,76 @@
+package template_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goliatone/go-formgen/pkg/render/template/gotemplate"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"templates/form.tmpl": {Data: []byte(`{% include "partials/header.tmpl" %}
+{# {{ ignored()|nope }} #}
+{{ title|upper }} {{ render_child(field)|safe }} {{ items.keys() }}
+{% include "partials/missing.tmpl" %}`)},
+		"templates/partials/header.tmpl": {Data: []byte(`{% macro badge(text) %}<b>{{ text }}</b>{% endmacro %}{{ badge("x") }}`)},
+		"templates/partials/orphan.tmpl": {Data: []byte(`{{ name|no_such_filter }}
+{{ helper("a|b") }}`)},
+		"templates/broken.tmpl": {Data: []byte(`{% if open %}`)},
+	}
+
+	report, err := gotemplate.Lint(fsys, "tmpl",
+		gotemplate.WithLintEntrypoints("templates/form.tmpl", "templates/modal.tmpl"),
+		gotemplate.WithLintRoots("templates/broken.tmpl"),
+		gotemplate.WithLintFunctions("render_child"),
+	)
+	if err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	if len(report.Templates) != 4 {
+		t.Fatalf("expected 4 templates, got %v", report.Templates)
+	}
+
+	var got []string
+	for _, issue := range report.Issues {
+		got = append(got, issue.String())
+	}
+	want := []string{
+		"templates/broken.tmpl:1: error:",
+		"templates/form.tmpl:4: error: include references missing template \"templates/partials/missing.tmpl\" (missing-partial)",
+		"templates/modal.tmpl: error: required entrypoint is missing (missing-entrypoint)",
+		"templates/partials/orphan.tmpl: warning: template is not an entrypoint and is never included (unused)",
+		"templates/partials/orphan.tmpl:1: error: unknown filter \"no_such_filter\" (unknown-filter)",
+		"templates/partials/orphan.tmpl:2: warning: call to unknown function \"helper\" (unknown-function)",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d issues, got:\n%s", len(want), strings.Join(got, "\n"))
+	}
+	for i := range want {
+		if !strings.HasPrefix(got[i], want[i]) {
+			t.Fatalf("issue %d: expected %q, got %q", i, want[i], got[i])
+		}
+	}
+	if !report.HasErrors() || report.Err() == nil {
+		t.Fatal("expected errors")
+	}
+}
+
+func TestLintCleanBundle(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"templates/form.tmpl": {Data: []byte(`{% extends "base.tmpl" %}{% block body %}{{ name|trim }}{% endblock %}`)},
+		"templates/base.tmpl": {Data: []byte(`<main>{% block body %}{% endblock %}</main>`)},
+	}
+	report, err := gotemplate.Lint(fsys, "", gotemplate.WithLintEntrypoints("templates/form.tmpl"))
+	if err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	if len(report.Issues) != 0 || report.Err() != nil {
+		t.Fatalf("expected a clean report, got %+v", report.Issues)
+	}
+}
@@ -0,0 +1,69 @@
+package render
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// FieldTiming is the render duration of one field. Duration includes nested
+// fields, which are also reported under their own paths.
+type FieldTiming struct {
+	Path      string        `json:"path"`
+	Component string        `json:"component"`
+	Duration  time.Duration `json:"durationNs"`
+}
+
+// FieldTimer collects per-field render durations. Renderers that support it
+// (vanilla) look it up with FieldTimerFromContext and call Record once per
+// rendered field. It is safe for concurrent use.
+type FieldTimer struct {
+	// Comments asks the renderer to prefix each field's markup with an HTML
+	// comment carrying its path, component, and duration. Meant for local
+	// development; the output is no longer deterministic.
+	Comments bool
+
+	mu      sync.Mutex
+	timings []FieldTiming
+}
+
+// Record stores one field timing.
+func (t *FieldTimer) Record(path, component string, elapsed time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.timings = append(t.timings, FieldTiming{Path: path, Component: component, Duration: elapsed})
+	t.mu.Unlock()
+}
+
+// Timings returns the recorded timings in render order.
+func (t *FieldTimer) Timings() []FieldTiming {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]FieldTiming(nil), t.timings...)
+}
+
+type fieldTimerKey struct{}
+
+// WithFieldTimer attaches timer to ctx so the renderer records field timings
+// into it.
+func WithFieldTimer(ctx context.Context, timer *FieldTimer) context.Context {
+	if timer == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, fieldTimerKey{}, timer)
+}
+
+// FieldTimerFromContext returns the timer attached with WithFieldTimer, or
+// nil when timings are not being collected.
+func FieldTimerFromContext(ctx context.Context) *FieldTimer {
+	if ctx == nil {
+		return nil
+	}
+	timer, _ := ctx.Value(fieldTimerKey{}).(*FieldTimer)
+	return timer
+}
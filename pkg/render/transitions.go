@@ -0,0 +1,93 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// ApplyTransitions narrows enum fields that declare transitions to the states
+// reachable from their prefilled value in values (dotted paths or nested
+// maps). The current state stays selectable. Fields without a prefilled value,
+// such as on create forms, keep every option.
+func ApplyTransitions(form *model.FormModel, values map[string]any) {
+	if form == nil || len(values) == 0 {
+		return
+	}
+	form.Fields = applyTransitions(form.Fields, values, "")
+}
+
+func applyTransitions(fields []model.Field, values map[string]any, parentPath string) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	out := make([]model.Field, len(fields))
+	for idx, field := range fields {
+		path := joinPath(parentPath, field.Name)
+		if len(field.Nested) > 0 {
+			field.Nested = applyTransitions(field.Nested, values, path)
+		} else if transitions, ok := model.FieldTransitions(field); ok {
+			if current, ok := lookupValue(values, path); ok {
+				if from := model.TransitionState(current); from != "" {
+					field.Enum = filterTransitionEnum(field.Enum, transitions, from)
+					field.Options = filterTransitionOptions(field.Options, transitions, from)
+				}
+			}
+		}
+		out[idx] = field
+	}
+	return out
+}
+
+func filterTransitionEnum(enum []any, transitions model.Transitions, from string) []any {
+	if len(enum) == 0 {
+		return enum
+	}
+	allowed := make([]any, 0, len(enum))
+	for _, value := range enum {
+		if transitions.Allows(from, model.TransitionState(value)) {
+			allowed = append(allowed, value)
+		}
+	}
+	return allowed
+}
+
+func filterTransitionOptions(options []model.Option, transitions model.Transitions, from string) []model.Option {
+	if len(options) == 0 {
+		return options
+	}
+	allowed := make([]model.Option, 0, len(options))
+	for _, option := range options {
+		if transitions.Allows(from, model.TransitionState(option.Value)) {
+			allowed = append(allowed, option)
+		}
+	}
+	return allowed
+}
+
+func lookupValue(values map[string]any, path string) (any, bool) {
+	if value, ok := values[path]; ok {
+		return unwrapProvenance(value), true
+	}
+	segments := strings.Split(path, ".")
+	var current any = values
+	for _, segment := range segments {
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = obj[segment]
+		if !ok {
+			return nil, false
+		}
+		current = unwrapProvenance(current)
+	}
+	return current, true
+}
+
+func unwrapProvenance(value any) any {
+	if wrapped, ok := value.(ValueWithProvenance); ok {
+		return wrapped.Value
+	}
+	return value
+}
@@ -0,0 +1,50 @@
+package render
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+func TestApplyTransitions(t *testing.T) {
+	transitions := map[string]string{
+		model.TransitionsMetadataKey: `{"draft":["in_review"],"in_review":["published","draft"]}`,
+	}
+	newForm := func() model.FormModel {
+		return model.FormModel{Fields: []model.Field{
+			{Name: "status", Type: model.FieldTypeString, Enum: []any{"draft", "in_review", "published"}, Metadata: transitions},
+			{Name: "review", Type: model.FieldTypeObject, Nested: []model.Field{
+				{Name: "state", Type: model.FieldTypeString, Metadata: transitions, Options: []model.Option{
+					{Value: "draft", Label: "Draft"},
+					{Value: "in_review", Label: "In review"},
+					{Value: "published", Label: "Published"},
+				}},
+			}},
+		}}
+	}
+
+	form := newForm()
+	ApplyTransitions(&form, map[string]any{
+		"status": PrefillValue("in_review", "record"),
+		"review": map[string]any{"state": "draft"},
+	})
+	if got, want := form.Fields[0].Enum, []any{"draft", "in_review", "published"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("status enum = %v, want %v", got, want)
+	}
+	if got := form.Fields[1].Nested[0].Options; len(got) != 2 || got[0].Value != "draft" || got[1].Value != "in_review" {
+		t.Fatalf("review.state options = %+v", got)
+	}
+
+	form = newForm()
+	ApplyTransitions(&form, map[string]any{"status": "published"})
+	if got, want := form.Fields[0].Enum, []any{"published"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("terminal status enum = %v, want %v", got, want)
+	}
+
+	form = newForm()
+	ApplyTransitions(&form, nil)
+	if len(form.Fields[0].Enum) != 3 {
+		t.Fatalf("expected create forms to keep every state, got %v", form.Fields[0].Enum)
+	}
+}
@@ -44,6 +44,9 @@ func (r *Renderer) ContentType() string {
 
 func (r *Renderer) Render(_ context.Context, form model.FormModel, options render.RenderOptions) ([]byte, error) {
 	render.ApplySubset(&form, options.Subset)
+	render.ApplyFieldOverrides(&form, options.FieldOverrides)
+	render.ApplyTransitions(&form, options.Values)
+	render.ApplyConcurrency(&form, &options)
 	render.LocalizeFormModel(&form, options)
 	render.RedactSensitiveDefaults(&form, options.IncludeSensitiveDefaults)
 
@@ -452,7 +452,8 @@
       return h("input", boolAttrs);
     }
 
-    if (type === "array" && field.items) {
+    var isFileInput = normalize(hints.inputType || hints.inputtype).toLowerCase() === "file";
+    if (type === "array" && field.items && !isFileInput) {
       return h("div", { class: "fg-preact-nested" }, buildFieldList(h, [field.items]));
     }
 
@@ -470,6 +471,14 @@
     if (attrs.type !== "file" && field["default"] != null) {
       attrs.value = text(field["default"]);
     }
+    if (attrs.type === "file") {
+      if (normalize(hints.multiple).toLowerCase() === "true") {
+        attrs.multiple = "multiple";
+      }
+      if (normalize(hints.accept)) {
+        attrs.accept = normalize(hints.accept);
+      }
+    }
 
     return h("input", attrs);
   }
@@ -576,6 +585,12 @@
               class: "fg-preact-form",
               noValidate: "noValidate",
               "data-formgen-auto-init": "true",
+              "data-formgen-error-mapping":
+                (model.metadata && model.metadata["errors.mapping"]) || undefined,
+              enctype:
+                model.mediaType === "multipart/form-data"
+                  ? "multipart/form-data"
+                  : undefined,
             },
             (function () {
               var nodes = [];
@@ -0,0 +1,748 @@
+(function (global) {
+  "use strict";
+
+  function byId(id) {
+    return document.getElementById(id);
+  }
+
+  function safeID(value) {
+    if (!value) {
+      return "field";
+    }
+    return String(value).replace(/[^a-zA-Z0-9-_:.]/g, "-");
+  }
+
+  function readPayload(node) {
+    if (!node) {
+      return null;
+    }
+    var text = node.textContent || node.innerText || "";
+    if (!text) {
+      return null;
+    }
+    try {
+      return JSON.parse(text);
+    } catch (err) {
+      console.error("formgen react: failed to parse payload", err);
+      return null;
+    }
+  }
+
+  function flattenChildren(children, out) {
+    for (var i = 0; i < children.length; i += 1) {
+      var child = children[i];
+      if (Array.isArray(child)) {
+        flattenChildren(child, out);
+      } else if (child !== undefined && child !== null && child !== false) {
+        out.push(child);
+      }
+    }
+    return out;
+  }
+
+  // toReactProps maps the DOM-style attributes used by the shared field
+  // builders onto React props. Form controls are uncontrolled so the
+  // relationship runtime can keep mutating them after mount.
+  function toReactProps(type, props, children, selectedOptions) {
+    var out = {};
+    var source = props || {};
+    for (var key in source) {
+      if (!Object.prototype.hasOwnProperty.call(source, key)) {
+        continue;
+      }
+      var value = source[key];
+      if (key === "class") {
+        out.className = value;
+      } else if (key === "for") {
+        out.htmlFor = value;
+      } else if (key === "required" || key === "multiple") {
+        out[key] = !!value;
+      } else if (key === "checked") {
+        out.defaultChecked = !!value;
+      } else if (key === "selected") {
+        continue;
+      } else if (key === "value" && type !== "option") {
+        out.defaultValue = value;
+      } else {
+        out[key] = value;
+      }
+    }
+    if (type === "textarea" && children.length > 0) {
+      out.defaultValue = children.map(text).join("");
+      children.length = 0;
+    }
+    if (type === "select") {
+      var selected = [];
+      for (var i = 0; i < children.length; i += 1) {
+        if (selectedOptions.has(children[i])) {
+          selected.push(text(children[i].props.value));
+        }
+      }
+      if (selected.length > 0) {
+        out.defaultValue = out.multiple ? selected : selected[0];
+      }
+    }
+    return out;
+  }
+
+  function ensureReact() {
+    var react = global.React || {};
+    var dom = global.ReactDOM || {};
+    if (typeof react.createElement !== "function" || (typeof dom.createRoot !== "function" && typeof dom.render !== "function")) {
+      console.warn("formgen react: window.React or window.ReactDOM missing; rendering fallback pre block");
+      return null;
+    }
+    var selectedOptions = typeof WeakSet === "function" ? new WeakSet() : { has: function () { return false; }, add: function () {} };
+    function h(type, props) {
+      var children = flattenChildren(Array.prototype.slice.call(arguments, 2), []);
+      var element = react.createElement.apply(
+        react,
+        [type, typeof type === "string" ? toReactProps(type, props, children, selectedOptions) : props].concat(children)
+      );
+      if (type === "option" && props && props.selected) {
+        selectedOptions.add(element);
+      }
+      return element;
+    }
+    function render(element, mount) {
+      if (typeof dom.createRoot === "function") {
+        var root = dom.createRoot(mount);
+        if (typeof dom.flushSync === "function") {
+          dom.flushSync(function () {
+            root.render(element);
+          });
+        } else {
+          root.render(element);
+        }
+        return;
+      }
+      dom.render(element, mount);
+    }
+    return { h: h, render: render };
+  }
+
+  function text(value) {
+    return value == null ? "" : String(value);
+  }
+
+  function normalize(value) {
+    return typeof value === "string" ? value.trim() : "";
+  }
+
+  function toKebab(input) {
+    if (!input) {
+      return "";
+    }
+    var result = "";
+    var last = "";
+    for (var i = 0; i < input.length; i += 1) {
+      var ch = input[i];
+      if (ch === ".") {
+        result += "-";
+        last = "-";
+        continue;
+      }
+      var lower = ch.toLowerCase();
+      if (ch !== lower) {
+        if (i > 0 && last !== "-" && last !== "") {
+          result += "-";
+        }
+        result += lower;
+        last = lower;
+        continue;
+      }
+      result += lower;
+      last = lower;
+    }
+    return result;
+  }
+
+  function parseCurrentValues(field) {
+    var metadata = field && field.metadata ? field.metadata : null;
+    if (!metadata) {
+      return [];
+    }
+    var current = metadata["relationship.current"];
+    if (!current) {
+      return [];
+    }
+    try {
+      var parsed = JSON.parse(current);
+      if (Array.isArray(parsed)) {
+        return parsed.map(text);
+      }
+      if (parsed == null) {
+        return [];
+      }
+      return [text(parsed)];
+    } catch (_err) {
+      return [text(current)];
+    }
+  }
+
+  function shouldRenderField(field) {
+    if (!field) {
+      return false;
+    }
+    var rel = field.relationship || null;
+    if (rel && rel.sourceField) {
+      return false;
+    }
+    return true;
+  }
+
+  function parseSections(metadata) {
+    if (!metadata || typeof metadata["layout.sections"] !== "string") {
+      return [];
+    }
+    try {
+      var parsed = JSON.parse(metadata["layout.sections"]);
+      return Array.isArray(parsed) ? parsed : [];
+    } catch (_err) {
+      return [];
+    }
+  }
+
+  function parseFieldOrders(raw) {
+    if (!raw) {
+      return {};
+    }
+    try {
+      var parsed = JSON.parse(raw);
+      return parsed && typeof parsed === "object" ? parsed : {};
+    } catch (_err) {
+      return {};
+    }
+  }
+
+  function orderEntries(entries, orderList) {
+    if (!Array.isArray(entries) || entries.length === 0) {
+      return [];
+    }
+    if (!Array.isArray(orderList) || orderList.length === 0) {
+      return entries
+        .slice()
+        .sort(function (a, b) {
+          return a.index - b.index;
+        });
+    }
+    var orderMap = new Map();
+    for (var i = 0; i < orderList.length; i += 1) {
+      var key = normalize(orderList[i]);
+      if (!key) continue;
+      orderMap.set(key, i);
+    }
+    return entries
+      .slice()
+      .sort(function (a, b) {
+        var ai = orderMap.has(a.path) ? orderMap.get(a.path) : Number.MAX_SAFE_INTEGER;
+        var bi = orderMap.has(b.path) ? orderMap.get(b.path) : Number.MAX_SAFE_INTEGER;
+        if (ai !== bi) {
+          return ai - bi;
+        }
+        return a.index - b.index;
+      });
+  }
+
+  function buildLayout(fields, sectionsMetadata, fieldOrders) {
+    var sections = [];
+    var sectionIndex = new Map();
+    for (var i = 0; i < sectionsMetadata.length; i += 1) {
+      var meta = sectionsMetadata[i];
+      if (!meta || !meta.id) {
+        continue;
+      }
+      var section = {
+        id: meta.id,
+        title: meta.title || "",
+        description: meta.description || "",
+        fieldset: !!meta.fieldset,
+        fields: [],
+      };
+      sections.push(section);
+      sectionIndex.set(meta.id, section);
+    }
+
+    var unsectioned = [];
+    var counter = 0;
+
+    (fields || []).forEach(function (field) {
+      if (!shouldRenderField(field)) {
+        return;
+      }
+      var path = normalize(field.name);
+      var entry = { field: field, path: path, index: counter++ };
+      var metadata = field.metadata || {};
+      var sectionID = metadata["layout.section"];
+      if (sectionID && sectionIndex.has(sectionID)) {
+        sectionIndex.get(sectionID).fields.push(entry);
+      } else {
+        unsectioned.push(entry);
+      }
+    });
+
+    sections.forEach(function (section) {
+      var order = fieldOrders[section.id];
+      var ordered = orderEntries(section.fields, order);
+      section.fields = ordered.map(function (entry) {
+        return entry.field;
+      });
+    });
+
+    var orderedUnsectioned = orderEntries(unsectioned, fieldOrders.__unsectioned || []);
+
+    return {
+      sections: sections.filter(function (section) {
+        return section.fields.length > 0;
+      }),
+      unsectioned: orderedUnsectioned.map(function (entry) {
+        return entry.field;
+      }),
+    };
+  }
+
+  function relationshipAttributes(field) {
+    var attrs = {};
+    if (!field) {
+      return attrs;
+    }
+    var rel = field.relationship || null;
+    if (rel) {
+      if (rel.kind) {
+        attrs["data-relationship-type"] = rel.kind;
+      }
+      if (rel.target) {
+        attrs["data-relationship-target"] = rel.target;
+      }
+      if (rel.foreignKey) {
+        attrs["data-relationship-foreign-key"] = rel.foreignKey;
+      }
+      if (rel.cardinality) {
+        attrs["data-relationship-cardinality"] = rel.cardinality;
+      }
+      if (rel.inverse) {
+        attrs["data-relationship-inverse"] = rel.inverse;
+      }
+    }
+
+    var metadata = field.metadata || null;
+    if (!metadata) {
+      return attrs;
+    }
+    var keys = Object.keys(metadata).sort();
+    for (var i = 0; i < keys.length; i += 1) {
+      var key = keys[i];
+      var value = metadata[key];
+      if (!value) {
+        continue;
+      }
+      if (key === "relationship.current") {
+        attrs["data-relationship-current"] = value;
+        continue;
+      }
+      if (key.indexOf("relationship.endpoint.") !== 0) {
+        continue;
+      }
+      var suffix = key.slice("relationship.endpoint.".length);
+      if (suffix.indexOf("auth.") === 0) {
+        var authSuffix = suffix.slice("auth.".length);
+        if (authSuffix) {
+          attrs["data-auth-" + toKebab(authSuffix)] = value;
+        }
+        continue;
+      }
+      if (suffix === "refreshOn") {
+        attrs["data-endpoint-refresh-on"] = value;
+        continue;
+      }
+      attrs["data-endpoint-" + toKebab(suffix)] = value;
+    }
+
+    return attrs;
+  }
+
+  function baseControlAttrs(field, id, hints) {
+    var attrs = {
+      id: id,
+      name: field.name || id,
+      class: "fg-react-input",
+    };
+    if (field.required) {
+      attrs.required = "required";
+    }
+    var placeholder = field.placeholder || normalize(hints && hints.placeholder);
+    if (placeholder) {
+      attrs.placeholder = placeholder;
+    }
+    return Object.assign(attrs, relationshipAttributes(field));
+  }
+
+  function selectEnumOptions(field) {
+    if (Array.isArray(field.enumOptions) && field.enumOptions.length > 0) {
+      return field.enumOptions.map(function(option) {
+        return {
+          value: text(option && option.value),
+          label: text(option && option.label),
+          selected: !!(option && option.selected),
+        };
+      });
+    }
+    var enumValues = Array.isArray(field.enum) ? field.enum : [];
+    return enumValues.map(function(value) {
+      var label = text(value);
+      return { value: label, label: label, selected: false };
+    });
+  }
+
+  function selectOptions(h, field, placeholder) {
+    var options = [];
+    var enumValues = selectEnumOptions(field);
+    var currentValues = parseCurrentValues(field);
+    if (placeholder && (!enumValues.length || currentValues.length === 0)) {
+      options.push(
+        h("option", { value: "" }, placeholder)
+      );
+    }
+    if (enumValues.length > 0) {
+      var current = text(field["default"]);
+      for (var i = 0; i < enumValues.length; i += 1) {
+        var option = enumValues[i];
+        var attrs = { value: option.value };
+        if (option.selected || option.value === current || option.label === current || currentValues.indexOf(option.value) >= 0 || currentValues.indexOf(option.label) >= 0) {
+          attrs.selected = "selected";
+        }
+        options.push(h("option", attrs, option.label));
+      }
+    } else if (currentValues.length > 0) {
+      for (var j = 0; j < currentValues.length; j += 1) {
+        var value = currentValues[j];
+        options.push(
+          h("option", { value: value, selected: "selected" }, value)
+        );
+      }
+    }
+    if (options.length === 0) {
+      options.push(
+        h("option", { value: "" }, placeholder || "Select an option")
+      );
+    }
+    return options;
+  }
+
+  function renderSelectControl(h, field, id, hints) {
+    var attrs = baseControlAttrs(field, id, hints);
+    delete attrs.placeholder;
+
+    var rel = field && field.relationship ? field.relationship : null;
+    var relCardinality = rel && rel.cardinality ? text(rel.cardinality) : "";
+    var widget = normalize(hints && hints.widget).toLowerCase();
+    var cardinality = normalize((hints && hints.cardinality) || relCardinality).toLowerCase();
+    if (widget === "chips") {
+      cardinality = "many";
+      if (!attrs["data-endpoint-renderer"]) {
+        attrs["data-endpoint-renderer"] = "chips";
+      }
+    } else if (widget === "typeahead") {
+      if (!attrs["data-endpoint-renderer"]) {
+        attrs["data-endpoint-renderer"] = "typeahead";
+      }
+    }
+    if (cardinality === "many") {
+      attrs.multiple = "multiple";
+      attrs["data-relationship-collection"] = "many";
+    }
+
+    var placeholder = field.placeholder || normalize(hints && hints.placeholder);
+    if (!placeholder && field.label) {
+      placeholder = "Select " + field.label;
+    }
+
+    return h("select", attrs, selectOptions(h, field, placeholder));
+  }
+
+  function renderTextarea(h, field, id, hints) {
+    var attrs = baseControlAttrs(field, id, hints);
+    return h("textarea", attrs, text(field["default"]));
+  }
+
+  function safeJSON(value) {
+    if (value == null) {
+      return "";
+    }
+    try {
+      return JSON.stringify(value, null, 2);
+    } catch (_err) {
+      return text(value);
+    }
+  }
+
+  function renderJSONEditor(h, field, id, hints) {
+    var attrs = baseControlAttrs(field, id, hints);
+    attrs.class = "fg-react-input fg-react-json-editor";
+    return h("textarea", attrs, safeJSON(field["default"]));
+  }
+
+  function controlForField(h, field, id) {
+    var hints = field.uiHints || {};
+    var inputHint = normalize(hints.input).toLowerCase();
+    var widget = normalize(hints.widget).toLowerCase();
+    var metadata = field.metadata || {};
+    var componentName = normalize(metadata["component.name"]).toLowerCase();
+
+    if (field.nested && field.nested.length) {
+      return h("div", { class: "fg-react-nested" }, buildFieldList(h, field.nested));
+    }
+
+    if (field.relationship) {
+      return renderSelectControl(h, field, id, hints);
+    }
+
+    if (widget === "select" || widget === "chips") {
+      return renderSelectControl(h, field, id, hints);
+    }
+
+    if (widget === "json-editor" || componentName === "json_editor") {
+      return renderJSONEditor(h, field, id, hints);
+    }
+
+    if (widget === "code-editor") {
+      return renderTextarea(h, field, id, hints);
+    }
+
+    if (inputHint === "select" || (Array.isArray(field.enumOptions) && field.enumOptions.length > 0) || (Array.isArray(field.enum) && field.enum.length > 0)) {
+      return renderSelectControl(h, field, id, hints);
+    }
+
+    var relCardinality = field.relationship && field.relationship.cardinality ? text(field.relationship.cardinality) : "";
+    var cardinality = normalize(hints.cardinality || relCardinality).toLowerCase();
+    if (inputHint === "collection" && cardinality === "many") {
+      return renderSelectControl(h, field, id, hints);
+    }
+
+    if (widget === "textarea") {
+      return renderTextarea(h, field, id, hints);
+    }
+
+    var type = String(field.type || "string").toLowerCase();
+    if (type === "boolean") {
+      var boolAttrs = baseControlAttrs(field, id, hints);
+      boolAttrs.type = "checkbox";
+      if (field["default"] === true) {
+        boolAttrs.checked = "checked";
+      }
+      if (widget === "toggle") {
+        boolAttrs["data-fg-switch"] = "true";
+      }
+      return h("input", boolAttrs);
+    }
+
+    var isFileInput = normalize(hints.inputType || hints.inputtype).toLowerCase() === "file";
+    if (type === "array" && field.items && !isFileInput) {
+      return h("div", { class: "fg-react-nested" }, buildFieldList(h, [field.items]));
+    }
+
+    var attrs = baseControlAttrs(field, id, hints);
+    var explicitType = normalize(hints.inputType || hints.inputtype);
+    if (explicitType) {
+      attrs.type = explicitType;
+    } else if (type === "integer" || type === "number") {
+      attrs.type = "number";
+      attrs.step = type === "integer" ? "1" : "any";
+    } else {
+      attrs.type = "text";
+    }
+
+    if (attrs.type !== "file" && field["default"] != null) {
+      attrs.value = text(field["default"]);
+    }
+    if (attrs.type === "file") {
+      if (normalize(hints.multiple).toLowerCase() === "true") {
+        attrs.multiple = "multiple";
+      }
+      if (normalize(hints.accept)) {
+        attrs.accept = normalize(hints.accept);
+      }
+    }
+
+    return h("input", attrs);
+  }
+
+  function buildFieldList(h, fields) {
+    if (!Array.isArray(fields)) {
+      return [];
+    }
+    return fields.filter(shouldRenderField).map(function (field) {
+      var id = "fg-" + safeID(field.name || field.label);
+      var label = field.label || field.name || "Field";
+      var children = [
+        h(
+          "label",
+          { class: "fg-react-label", htmlFor: id },
+          label,
+          field.required ? h("span", { class: "fg-react-required" }, " *") : null
+        ),
+      ];
+      children.push(controlForField(h, field, id));
+      if (field.description) {
+        children.push(h("p", { class: "fg-react-help" }, field.description));
+      }
+      return h("div", { class: "fg-react-field" }, children);
+    });
+  }
+
+  function mountFallback(target, data) {
+    target.textContent = JSON.stringify(data, null, 2);
+  }
+
+  function maybeInitRelationships() {
+    var api = global.FormgenRelationships;
+    if (!api || typeof api.initRelationships !== "function") {
+      return;
+    }
+    try {
+      var result = api.initRelationships();
+      if (result && typeof result.then === "function") {
+        result.catch(function (error) {
+          console.error("formgen react: initRelationships failed", error);
+        });
+      }
+    } catch (err) {
+      console.error("formgen react: initRelationships error", err);
+    }
+  }
+
+  function maybeInitSwitches(root) {
+    var api = global.FormgenRelationships;
+    if (!api || typeof api.renderSwitch !== "function") {
+      return;
+    }
+    if (typeof api.getThemeClasses !== "function") {
+      return;
+    }
+    if (!root || typeof root.querySelectorAll !== "function") {
+      return;
+    }
+    var theme = api.getThemeClasses().switch;
+    var inputs = root.querySelectorAll('input[type="checkbox"][data-fg-switch="true"]');
+    for (var i = 0; i < inputs.length; i += 1) {
+      try {
+        api.renderSwitch(inputs[i], theme);
+      } catch (err) {
+        // Keep the checkbox as-is if the runtime enhancer fails.
+      }
+    }
+  }
+
+  function bootstrap() {
+    var mount = byId("formgen-react-root");
+    var dataNode = byId("formgen-react-data");
+    if (!mount || !dataNode) {
+      return;
+    }
+    var model = readPayload(dataNode) || { operationId: "", fields: [] };
+    var api = ensureReact();
+    if (!api) {
+      mountFallback(mount, model);
+      return;
+    }
+    var h = api.h;
+    var render = api.render;
+    var fieldOrders = parseFieldOrders(mount.getAttribute("data-field-order"));
+    var layout = buildLayout(model.fields, parseSections(model.metadata || {}), fieldOrders);
+
+    function App() {
+      return h(
+        "section",
+        { class: "fg-react" },
+        [
+          h(
+            "h1",
+            { class: "fg-react-heading" },
+            model.summary || model.operationId || "Generated Form"
+          ),
+          model.description
+            ? h("p", { class: "fg-react-description" }, model.description)
+            : null,
+          h(
+            "form",
+            {
+              class: "fg-react-form",
+              noValidate: "noValidate",
+              "data-formgen-auto-init": "true",
+              "data-formgen-error-mapping":
+                (model.metadata && model.metadata["errors.mapping"]) || undefined,
+              encType:
+                model.mediaType === "multipart/form-data"
+                  ? "multipart/form-data"
+                  : undefined,
+            },
+            (function () {
+              var nodes = [];
+              if (layout.sections.length) {
+                layout.sections.forEach(function (section) {
+                  nodes.push(
+                    h(
+                      "section",
+                      { class: "fg-react-section", key: section.id },
+                      [
+                        (section.title || section.description)
+                          ? h(
+                              "header",
+                              { class: "fg-react-section-header" },
+                              [
+                                section.title
+                                  ? h("h2", { class: "fg-react-section-title" }, section.title)
+                                  : null,
+                                section.description
+                                  ? h(
+                                      "p",
+                                      { class: "fg-react-section-description" },
+                                      section.description
+                                    )
+                                  : null,
+                              ].filter(Boolean)
+                            )
+                          : null,
+                        section.fieldset
+                          ? h(
+                              "fieldset",
+                              null,
+                              buildFieldList(h, section.fields)
+                            )
+                          : h(
+                              "div",
+                              { class: "fg-react-section-fields" },
+                              buildFieldList(h, section.fields)
+                            ),
+                      ].filter(Boolean)
+                    )
+                  );
+                });
+              }
+              var unsectionedFields =
+                layout.unsectioned.length > 0
+                  ? layout.unsectioned
+                  : layout.sections.length === 0
+                  ? model.fields
+                  : [];
+              if (unsectionedFields.length) {
+                nodes = nodes.concat(buildFieldList(h, unsectionedFields));
+              }
+              return nodes;
+            })()
+          ),
+        ]
+      );
+    }
+
+    render(h(App, null), mount);
+    maybeInitRelationships();
+    maybeInitSwitches(mount);
+  }
+
+  if (document.readyState === "loading") {
+    document.addEventListener("DOMContentLoaded", bootstrap);
+  } else {
+    bootstrap();
+  }
+})(window);
@@ -0,0 +1,27 @@
+package react
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/goliatone/go-formgen/internal/renderers/hydrated"
+	"github.com/goliatone/go-formgen/pkg/renderers/preact"
+)
+
+//go:embed templates/*.tmpl
+var embeddedTemplates embed.FS
+
+//go:embed assets/*
+var embeddedAssets embed.FS
+
+// TemplatesFS exposes the embedded template bundle for consumers that want the
+// default React layout.
+func TemplatesFS() fs.FS {
+	return embeddedTemplates
+}
+
+// AssetsFS exposes the embedded script and stylesheet bundle to copy into
+// downstream distributions. The stylesheet is the one preact.AssetsFS() ships.
+func AssetsFS() fs.FS {
+	return hydrated.WithSharedFile(embeddedAssets, preact.AssetsFS(), defaultStylesheet)
+}
@@ -0,0 +1,106 @@
+package react
+
+import (
+	"context"
+	"io/fs"
+
+	"github.com/goliatone/go-formgen/internal/renderers/hydrated"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	rendertemplate "github.com/goliatone/go-formgen/pkg/render/template"
+)
+
+const (
+	defaultVendorScript = "assets/vendor/react.production.min.js"
+	defaultAppScript    = "assets/formgen-react.min.js"
+	// The theme stylesheet is shared with the preact renderer; AssetsFS serves
+	// it from preact.AssetsFS().
+	defaultStylesheet = "assets/formgen-preact.min.css"
+)
+
+// Option customises the renderer configuration.
+type Option = hydrated.Option
+
+// AssetPaths describes the URLs emitted by the HTML template. Custom bundles
+// should set all fields even when overriding a single path.
+type AssetPaths = hydrated.AssetPaths
+
+// WithTemplatesFS supplies an alternate template bundle via fs.FS.
+func WithTemplatesFS(files fs.FS) Option {
+	return hydrated.WithTemplatesFS(files)
+}
+
+// WithTemplatesDir loads templates from a directory on disk.
+func WithTemplatesDir(path string) Option {
+	return hydrated.WithTemplatesDir(path)
+}
+
+// WithTemplateRenderer injects a custom template renderer implementation.
+func WithTemplateRenderer(renderer rendertemplate.TemplateRenderer) Option {
+	return hydrated.WithTemplateRenderer(renderer)
+}
+
+// WithTemplateFuncs registers template helper functions on the built-in
+// go-template engine. It is a generic injection point for helpers such as i18n
+// translation, formatting, or other UI utilities.
+func WithTemplateFuncs(funcs map[string]any) Option {
+	return hydrated.WithTemplateFuncs(funcs)
+}
+
+// WithAssetsFS overrides the embedded asset bundle (scripts, styles).
+func WithAssetsFS(files fs.FS) Option {
+	return hydrated.WithAssetsFS(files)
+}
+
+// WithAssetsDir loads assets from a directory on disk.
+func WithAssetsDir(path string) Option {
+	return hydrated.WithAssetsDir(path)
+}
+
+// WithAssetPaths customises the relative paths injected into the rendered HTML.
+func WithAssetPaths(paths AssetPaths) Option {
+	return hydrated.WithAssetPaths(paths)
+}
+
+// WithAssetURLPrefix prefixes emitted asset paths (e.g. "/static/formgen").
+func WithAssetURLPrefix(prefix string) Option {
+	return hydrated.WithAssetURLPrefix(prefix)
+}
+
+// Renderer turns a FormModel into a hydrated React HTML document.
+type Renderer struct {
+	renderer *hydrated.Renderer
+}
+
+// New constructs a React renderer applying any provided options.
+func New(options ...Option) (*Renderer, error) {
+	renderer, err := hydrated.New(hydrated.Flavor{
+		Name:      "react",
+		Templates: TemplatesFS(),
+		Assets:    AssetsFS(),
+		Paths: AssetPaths{
+			VendorScript: defaultVendorScript,
+			AppScript:    defaultAppScript,
+			Stylesheet:   defaultStylesheet,
+		},
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Renderer{renderer: renderer}, nil
+}
+
+// Name identifies the renderer inside the registry.
+func (r *Renderer) Name() string {
+	return r.renderer.Name()
+}
+
+// ContentType returns the MIME type for generated documents.
+func (r *Renderer) ContentType() string {
+	return r.renderer.ContentType()
+}
+
+// Render produces hydrated HTML ready for delivery.
+func (r *Renderer) Render(ctx context.Context, form model.FormModel, renderOptions render.RenderOptions) ([]byte, error) {
+	return r.renderer.Render(ctx, form, renderOptions)
+}
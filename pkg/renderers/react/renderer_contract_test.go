@@ -0,0 +1,208 @@
+package react_test
+
+import (
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/preact"
+	"github.com/goliatone/go-formgen/pkg/renderers/react"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_RenderContract(t *testing.T) {
+	form := testsupport.MustLoadFormModel(t, filepath.Join("testdata", "form_model.json"))
+
+	renderer, err := react.New()
+	if err != nil {
+		t.Fatalf("react.New: %v", err)
+	}
+	if renderer.Name() != "react" {
+		t.Fatalf("unexpected renderer name %q", renderer.Name())
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Theme: testThemeConfig(),
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	goldenPath := filepath.Join("testdata", "form_output.golden.html")
+	if testsupport.WriteMaybeGolden(t, goldenPath, output) {
+		return
+	}
+
+	want := testsupport.MustReadGolden(t, goldenPath)
+	if diff := testsupport.CompareGolden(string(want), string(output)); diff != "" {
+		t.Fatalf("output mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRenderer_PayloadMatchesPreact(t *testing.T) {
+	form := testsupport.MustLoadFormModel(t, filepath.Join("testdata", "form_model.json"))
+
+	reactRenderer, err := react.New()
+	if err != nil {
+		t.Fatalf("react.New: %v", err)
+	}
+	preactRenderer, err := preact.New()
+	if err != nil {
+		t.Fatalf("preact.New: %v", err)
+	}
+
+	reactOut, err := reactRenderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("react render: %v", err)
+	}
+	preactOut, err := preactRenderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("preact render: %v", err)
+	}
+
+	got := extractPayload(t, reactOut, "formgen-react-data")
+	want := extractPayload(t, preactOut, "formgen-preact-data")
+	if got != want {
+		t.Fatalf("react payload diverges from preact payload:\nreact:  %s\npreact: %s", got, want)
+	}
+}
+
+func TestRenderer_RenderWithAssetURLPrefix(t *testing.T) {
+	form := model.FormModel{OperationID: "prefixed", Endpoint: "/prefixed", Method: "POST"}
+
+	renderer, err := react.New(react.WithAssetURLPrefix("/static/formgen"))
+	if err != nil {
+		t.Fatalf("react.New: %v", err)
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`src="/static/formgen/assets/vendor/react.production.min.js"`,
+		`src="/static/formgen/assets/formgen-react.min.js"`,
+		`href="/static/formgen/assets/formgen-preact.min.css"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s in output:\n%s", want, html)
+		}
+	}
+}
+
+func TestAssetsFSSharesPreactStylesheet(t *testing.T) {
+	const stylesheet = "assets/formgen-preact.min.css"
+	got, err := fs.ReadFile(react.AssetsFS(), stylesheet)
+	if err != nil {
+		t.Fatalf("read react stylesheet: %v", err)
+	}
+	want, err := fs.ReadFile(preact.AssetsFS(), stylesheet)
+	if err != nil {
+		t.Fatalf("read preact stylesheet: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("react stylesheet differs from the preact one")
+	}
+
+	var files []string
+	err = fs.WalkDir(react.AssetsFS(), ".", func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			files = append(files, path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("walk assets: %v", err)
+	}
+	if got := strings.Join(files, ","); got != "assets/formgen-preact.min.css,assets/formgen-react.min.js,assets/vendor/react.production.min.js" {
+		t.Fatalf("unexpected asset listing %s", got)
+	}
+}
+
+func TestRenderer_PartialModeMountsReactRoot(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "reactEmbed",
+		Endpoint:    "/embed",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "password", Type: model.FieldTypeString, Format: "password", Sensitive: true, Default: "secret"},
+		},
+	}
+	renderer, err := react.New()
+	if err != nil {
+		t.Fatalf("react.New: %v", err)
+	}
+	out, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		RenderMode: render.RenderModeForm,
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(out)
+	if strings.Contains(html, "<html") || !strings.Contains(html, `<div id="formgen-react-root" data-operation="reactEmbed" data-render-mode="form">`) {
+		t.Fatalf("expected partial react output:\n%s", html)
+	}
+	if strings.Contains(html, "secret") {
+		t.Fatalf("sensitive default leaked:\n%s", html)
+	}
+}
+
+func TestNew_WithAssetsFSMissingFiles(t *testing.T) {
+	custom := fstest.MapFS{
+		"bundle/app.js": &fstest.MapFile{Data: []byte("// app")},
+	}
+	_, err := react.New(
+		react.WithAssetsFS(custom),
+		react.WithAssetPaths(react.AssetPaths{
+			VendorScript: "bundle/vendor.js",
+			AppScript:    "bundle/app.js",
+			Stylesheet:   "styles/app.css",
+		}),
+	)
+	if err == nil {
+		t.Fatalf("expected error for missing assets")
+	}
+	if !strings.Contains(err.Error(), "react renderer: vendor script") {
+		t.Fatalf("expected vendor script error, got %v", err)
+	}
+}
+
+func extractPayload(t *testing.T, html []byte, id string) string {
+	t.Helper()
+
+	scriptPrefix := `<script id="` + id + `" type="application/json">`
+	start := strings.Index(string(html), scriptPrefix)
+	if start == -1 {
+		t.Fatalf("payload script %q not found", id)
+	}
+	start += len(scriptPrefix)
+	end := strings.Index(string(html[start:]), "</script>")
+	if end == -1 {
+		t.Fatalf("payload script closing tag not found")
+	}
+	return strings.TrimSpace(string(html[start : start+end]))
+}
+
+func testThemeConfig() *render.ThemeConfig {
+	return &render.ThemeConfig{
+		Theme:   "acme",
+		Variant: "dark",
+		CSSVars: map[string]string{
+			"--brand": "#123456",
+		},
+		AssetURL: func(key string) string {
+			switch key {
+			case "react.app":
+				return "theme/app.js"
+			default:
+				return ""
+			}
+		},
+	}
+}
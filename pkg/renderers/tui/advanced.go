@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// advancedFilter decides once per session whether fields marked advanced
+// (field or section uiHints `advanced: true`) are prompted. Skipped advanced
+// fields keep any prefilled value.
+func (r *Renderer) advancedFilter(ctx context.Context, form model.FormModel) (func(model.Field) bool, error) {
+	sections := model.AdvancedSections(form)
+	count := 0
+	for _, field := range form.Fields {
+		if model.IsAdvancedField(field, sections) {
+			count++
+		}
+	}
+	if count == 0 {
+		return func(model.Field) bool { return true }, nil
+	}
+
+	include := false
+	if r.includeAdvanced != nil {
+		include = *r.includeAdvanced
+	} else {
+		noun := "fields"
+		if count == 1 {
+			noun = "field"
+		}
+		resp, err := r.driver.Confirm(ctx, ConfirmConfig{
+			Message: fmt.Sprintf("Include %d advanced %s?", count, noun),
+			Default: false,
+			Help:    "Advanced fields are optional settings most submissions leave unchanged.",
+		})
+		if err != nil {
+			return nil, err
+		}
+		include = resp
+	}
+	return func(field model.Field) bool {
+		return include || !model.IsAdvancedField(field, sections)
+	}, nil
+}
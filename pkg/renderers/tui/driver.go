@@ -64,7 +64,9 @@ type PromptDriver interface {
 
 type surveyDriver struct{}
 
-func newSurveyDriver() (PromptDriver, error) {
+// NewSurveyDriver returns the terminal-backed PromptDriver used by the renderer
+// when no driver is injected.
+func NewSurveyDriver() (PromptDriver, error) {
 	return &surveyDriver{}, nil
 }
 
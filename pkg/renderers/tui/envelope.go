@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Envelope session statuses reported by OutputFormatEnvelope.
+const (
+	EnvelopeStatusComplete = "complete"
+	EnvelopeStatusAborted  = "aborted"
+)
+
+// Envelope is the machine-readable result emitted by OutputFormatEnvelope.
+// Values holds whatever was collected, including partial answers when the
+// session was aborted. Errors lists the validation messages shown while
+// prompting, keyed by dotted path; Skipped lists optional paths the user left
+// empty or declined.
+type Envelope struct {
+	Status     string              `json:"status"`
+	Values     map[string]any      `json:"values"`
+	Errors     map[string][]string `json:"errors"`
+	Skipped    []string            `json:"skipped"`
+	DurationMs int64               `json:"durationMs"`
+}
+
+func isSessionAbort(err error) bool {
+	return errors.Is(err, ErrAborted) || errors.Is(err, context.Canceled)
+}
+
+func (r *Renderer) envelope(status string, values map[string]any, state *State, started time.Time) ([]byte, error) {
+	env := Envelope{
+		Status:     status,
+		Values:     values,
+		Errors:     state.Issues(),
+		Skipped:    state.Skipped(),
+		DurationMs: r.now().Sub(started).Milliseconds(),
+	}
+	if env.Values == nil {
+		env.Values = map[string]any{}
+	}
+	if env.Errors == nil {
+		env.Errors = map[string][]string{}
+	}
+	if env.Skipped == nil {
+		env.Skipped = []string{}
+	}
+	return json.Marshal(env)
+}
@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/widgets"
+)
+
+const (
+	dateLayout   = "2006-01-02"
+	durationHelp = "ISO-8601 duration, e.g. P1DT2H30M"
+)
+
+func isDateRangeField(field model.Field) bool {
+	return field.Type == model.FieldTypeObject && strings.EqualFold(strings.TrimSpace(field.UIHints["widget"]), widgets.WidgetDateRange)
+}
+
+func isDurationField(field model.Field) bool {
+	return field.Type == model.FieldTypeString && strings.EqualFold(strings.TrimSpace(field.Format), "duration")
+}
+
+// promptDateRange asks for the start and end dates of a daterange field and
+// stores them under <path>.start and <path>.end (or the names of the declared
+// nested bounds), rejecting an end date before the start date.
+func (r *Renderer) promptDateRange(ctx context.Context, field model.Field, path string, state *State) error {
+	label := displayLabel(field)
+	help := displayHelp(field)
+	bounds := [2]model.Field{{Name: "start", Label: "Start"}, {Name: "end", Label: "End"}}
+	for _, nested := range field.Nested {
+		switch strings.ToLower(nested.Name) {
+		case "start", "from":
+			bounds[0] = nested
+		case "end", "to", "until":
+			bounds[1] = nested
+		}
+	}
+
+	var start time.Time
+	for idx, bound := range bounds {
+		boundPath := fmt.Sprintf("%s.%s", path, bound.Name)
+		required := field.Required || bound.Required
+		defaultVal := defaultStringValue(state, boundPath, bound.Default)
+		if defaultVal == "" {
+			if defaults, ok := field.Default.(map[string]any); ok {
+				defaultVal, _ = defaults[bound.Name].(string)
+			}
+		}
+		if len(defaultVal) > len(dateLayout) {
+			defaultVal = defaultVal[:len(dateLayout)]
+		}
+
+		for {
+			input, err := r.driver.Input(ctx, InputConfig{
+				Message: fmt.Sprintf("%s: %s (YYYY-MM-DD)", label, displayLabel(bound)),
+				Default: defaultVal,
+				Help:    help,
+			})
+			if err != nil {
+				return err
+			}
+			input = strings.TrimSpace(input)
+			if input == "" {
+				if required {
+					r.reportInvalid(ctx, state, boundPath, "required")
+					continue
+				}
+				state.markSkipped(boundPath)
+				break
+			}
+			parsed, err := time.Parse(dateLayout, input)
+			if err != nil {
+				r.reportInvalid(ctx, state, boundPath, "expected a date as YYYY-MM-DD")
+				continue
+			}
+			if idx == 1 && !start.IsZero() && parsed.Before(start) {
+				r.reportInvalid(ctx, state, boundPath, "must not be before the start date")
+				defaultVal = input
+				continue
+			}
+			if idx == 0 {
+				start = parsed
+			}
+			_ = state.SetValue(boundPath, input)
+			break
+		}
+	}
+	return nil
+}
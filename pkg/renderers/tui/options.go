@@ -12,6 +12,10 @@ const (
 	OutputFormatFormURLEncoded OutputFormat = "form"
 	// OutputFormatPrettyText emits a human-friendly text summary.
 	OutputFormatPrettyText OutputFormat = "pretty"
+	// OutputFormatEnvelope emits an Envelope JSON document
+	// ({status, values, errors, skipped, durationMs}). Aborted sessions still
+	// produce an envelope (status "aborted") instead of an error.
+	OutputFormatEnvelope OutputFormat = "envelope"
 )
 
 // Theme captures optional formatting hints the driver can apply when printing
@@ -54,6 +58,15 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
+// WithRelationshipPrefetch resolves every relationship endpoint up front,
+// before the first prompt, using a bounded worker pool with per-host limits.
+// Identical endpoints are fetched once. Requires WithHTTPClient.
+func WithRelationshipPrefetch(opts PrefetchOptions) Option {
+	return func(r *Renderer) {
+		r.prefetch = &opts
+	}
+}
+
 // WithSubmitTransformer allows callers to mutate collected values prior to
 // serialization.
 func WithSubmitTransformer(fn SubmitTransformer) Option {
@@ -68,3 +81,12 @@ func WithTheme(theme Theme) Option {
 		r.theme = theme
 	}
 }
+
+// WithAdvancedFields answers the "include advanced fields?" question up front
+// so fields marked advanced are always prompted (true) or always skipped
+// (false) without asking.
+func WithAdvancedFields(include bool) Option {
+	return func(r *Renderer) {
+		r.includeAdvanced = &include
+	}
+}
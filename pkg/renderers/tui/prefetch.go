@@ -0,0 +1,174 @@
+package tui
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	defaultPrefetchConcurrency = 4
+	defaultPrefetchPerHost     = 2
+)
+
+// PrefetchOptions bounds the relationship prefetch performed before prompting.
+// Zero values fall back to the defaults (4 workers, 2 requests per host).
+type PrefetchOptions struct {
+	// Concurrency caps the number of in-flight requests across all hosts.
+	Concurrency int
+	// PerHost caps the number of in-flight requests against a single host.
+	PerHost int
+}
+
+func (o PrefetchOptions) normalized() PrefetchOptions {
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultPrefetchConcurrency
+	}
+	if o.PerHost <= 0 {
+		o.PerHost = defaultPrefetchPerHost
+	}
+	if o.PerHost > o.Concurrency {
+		o.PerHost = o.Concurrency
+	}
+	return o
+}
+
+// prefetchRelationships resolves every distinct relationship endpoint in the
+// form through a bounded worker pool and seeds cache with the results. Failed
+// endpoints are left out of the cache so the prompt falls back to the regular
+// on-demand fetch (and its warning) when the field is reached.
+func (r *Renderer) prefetchRelationships(ctx context.Context, fields []model.Field, cache relCaches) {
+	if r.httpClient == nil || r.prefetch == nil {
+		return
+	}
+
+	jobs := make(map[string]relConfig)
+	collectRelConfigs(fields, jobs)
+	for key, cfg := range jobs {
+		// Uncached endpoints are fetched at the prompt; session entries may
+		// still be fresh from an earlier render.
+		store := cache.store(cfg)
+		if store == nil {
+			delete(jobs, key)
+		} else if _, ok := store.get(key); ok {
+			delete(jobs, key)
+		}
+	}
+	if len(jobs) == 0 {
+		return
+	}
+
+	opts := r.prefetch.normalized()
+	hosts := make(map[string]chan struct{})
+	for _, cfg := range jobs {
+		host := hostOf(cfg.url)
+		if _, ok := hosts[host]; !ok {
+			hosts[host] = make(chan struct{}, opts.PerHost)
+		}
+	}
+
+	queue := make(chan string)
+	var wg sync.WaitGroup
+	workers := min(opts.Concurrency, len(jobs))
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range queue {
+				cfg := jobs[key]
+				limit := hosts[hostOf(cfg.url)]
+				select {
+				case limit <- struct{}{}:
+				case <-ctx.Done():
+					continue
+				}
+				fetched, err := r.fetchShared(ctx, key, cfg)
+				<-limit
+				if err != nil {
+					continue
+				}
+				cache.store(cfg).set(key, fetched, cfg.cacheTTL)
+			}
+		}()
+	}
+
+	for key := range jobs {
+		queue <- key
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// fetchShared deduplicates concurrent fetches for the same endpoint so
+// overlapping renders share a single request.
+func (r *Renderer) fetchShared(ctx context.Context, key string, cfg relConfig) ([]relOption, error) {
+	if r.flights == nil {
+		return r.fetchRelationshipOptions(ctx, cfg)
+	}
+	return r.flights.do(key, func() ([]relOption, error) {
+		return r.fetchRelationshipOptions(ctx, cfg)
+	})
+}
+
+func collectRelConfigs(fields []model.Field, out map[string]relConfig) {
+	for _, field := range fields {
+		if field.Relationship != nil {
+			if cfg, ok := parseRelConfig(field.Metadata); ok {
+				out[cacheKeyFor(cfg)] = cfg
+			}
+		}
+		if len(field.Nested) > 0 {
+			collectRelConfigs(field.Nested, out)
+		}
+		if field.Items != nil {
+			collectRelConfigs([]model.Field{*field.Items}, out)
+		}
+	}
+}
+
+func hostOf(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return parsed.Host
+}
+
+// flightGroup is a minimal singleflight: callers asking for a key that is
+// already being fetched wait for and share the in-flight result.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	wg   sync.WaitGroup
+	opts []relOption
+	err  error
+}
+
+func (g *flightGroup) do(key string, fn func() ([]relOption, error)) ([]relOption, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.opts, call.err
+	}
+	call := &flightCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.opts, call.err = fn()
+	call.wg.Done()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	return call.opts, call.err
+}
@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	relCacheSession = "session"
+	relCacheNone    = "none"
+)
+
+// relCaches routes relationship options to the store selected by the
+// relationship.endpoint.cache.strategy metadata: memory entries live for a
+// single Render, session entries live as long as the Renderer, and none
+// bypasses caching so every prompt fetches fresh options.
+type relCaches struct {
+	memory  *relStore
+	session *relStore
+}
+
+func (c relCaches) store(cfg relConfig) *relStore {
+	switch cfg.cacheStrategy {
+	case relCacheNone:
+		return nil
+	case relCacheSession:
+		return c.session
+	default:
+		return c.memory
+	}
+}
+
+// relStore holds fetched options with an optional expiry.
+type relStore struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entries map[string]relStoreEntry
+}
+
+type relStoreEntry struct {
+	opts    []relOption
+	expires time.Time
+}
+
+func newRelStore(now func() time.Time) *relStore {
+	if now == nil {
+		now = time.Now
+	}
+	return &relStore{now: now, entries: make(map[string]relStoreEntry)}
+}
+
+func (c *relStore) get(key string) ([]relOption, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expires.IsZero() && !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.opts, true
+}
+
+// set stores opts under key; a non-positive ttl keeps the entry until the
+// store is discarded.
+func (c *relStore) set(key string, opts []relOption, ttl time.Duration) {
+	if c == nil {
+		return
+	}
+	entry := relStoreEntry{opts: opts}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ttl > 0 {
+		entry.expires = c.now().Add(ttl)
+	}
+	c.entries[key] = entry
+}
+
+func parseRelCacheTTL(raw string) time.Duration {
+	ms, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	if err != nil || ms <= 0 {
+		return 0
+	}
+	return time.Duration(ms) * time.Millisecond
+}
@@ -0,0 +1,203 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	remoteValidationRetry = "Retry check"
+	remoteValidationSkip  = "Skip check"
+	remoteValidationEdit  = "Edit value"
+
+	defaultRemoteValidationParam   = "value"
+	defaultRemoteValidationMessage = "value was rejected by the server"
+)
+
+var fieldReferencePattern = regexp.MustCompile(`\{\{\s*field:([^\}\s]+)\s*\}\}`)
+
+// remoteValidation mirrors the validation.endpoint.* metadata emitted for
+// x-validate-endpoint. Dynamic params reference other answers with
+// {{field:path}} so checks can depend on previously collected values.
+type remoteValidation struct {
+	url           string
+	method        string
+	param         string
+	params        map[string]string
+	dynamicParams map[string]string
+}
+
+// remoteVerdict is the outcome of a completed remote check.
+type remoteVerdict struct {
+	valid   bool
+	message string
+}
+
+func parseRemoteValidation(metadata map[string]string) (remoteValidation, bool) {
+	rawURL := strings.TrimSpace(metadata["validation.endpoint.url"])
+	if rawURL == "" {
+		return remoteValidation{}, false
+	}
+	cfg := remoteValidation{
+		url:           rawURL,
+		method:        strings.ToUpper(strings.TrimSpace(metadata["validation.endpoint.method"])),
+		param:         strings.TrimSpace(metadata["validation.endpoint.param"]),
+		params:        map[string]string{},
+		dynamicParams: map[string]string{},
+	}
+	if cfg.method == "" {
+		cfg.method = http.MethodGet
+	}
+	if cfg.param == "" {
+		cfg.param = defaultRemoteValidationParam
+	}
+	for key, value := range metadata {
+		if name, ok := strings.CutPrefix(key, "validation.endpoint.params."); ok && name != "" {
+			cfg.params[name] = value
+		}
+		if name, ok := strings.CutPrefix(key, "validation.endpoint.dynamicParams."); ok && name != "" {
+			cfg.dynamicParams[name] = value
+		}
+	}
+	return cfg, true
+}
+
+// validateRemote runs the field's remote check, if any, against value. It
+// returns true when the value should be accepted. On rejection the message is
+// reported and false is returned so the caller re-prompts. When the check
+// itself fails (network, unexpected status) the user chooses between retrying,
+// skipping the check, or editing the value.
+func (r *Renderer) validateRemote(ctx context.Context, metadata map[string]string, path string, value any, state *State) (bool, error) {
+	if r.httpClient == nil {
+		return true, nil
+	}
+	cfg, ok := parseRemoteValidation(metadata)
+	if !ok {
+		return true, nil
+	}
+
+	for {
+		verdict, err := r.checkRemote(ctx, cfg, value, state)
+		if err == nil {
+			if verdict.valid {
+				return true, nil
+			}
+			r.reportInvalid(ctx, state, path, verdict.message)
+			return false, nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+
+		choices := []string{remoteValidationRetry, remoteValidationSkip, remoteValidationEdit}
+		idx, selErr := r.driver.Select(ctx, SelectConfig{
+			Message: fmt.Sprintf("Could not verify %s (%v)", path, err),
+			Options: choices,
+		})
+		if selErr != nil {
+			return false, selErr
+		}
+		if idx < 0 || idx >= len(choices) {
+			return false, nil
+		}
+		switch choices[idx] {
+		case remoteValidationRetry:
+			continue
+		case remoteValidationSkip:
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+}
+
+func (r *Renderer) checkRemote(ctx context.Context, cfg remoteValidation, value any, state *State) (remoteVerdict, error) {
+	values := make(map[string]string, len(cfg.params)+len(cfg.dynamicParams)+1)
+	for key, param := range cfg.params {
+		values[key] = param
+	}
+	for key, tpl := range cfg.dynamicParams {
+		values[key] = resolveFieldReferences(tpl, state)
+	}
+	values[cfg.param] = fmt.Sprint(value)
+
+	reqURL, err := url.Parse(cfg.url)
+	if err != nil {
+		return remoteVerdict{}, fmt.Errorf("parse url: %w", err)
+	}
+	var body io.Reader
+	if cfg.method == http.MethodGet || cfg.method == http.MethodHead {
+		q := reqURL.Query()
+		for key, val := range values {
+			q.Set(key, val)
+		}
+		reqURL.RawQuery = q.Encode()
+	} else {
+		payload, err := json.Marshal(values)
+		if err != nil {
+			return remoteVerdict{}, fmt.Errorf("encode: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, cfg.method, reqURL.String(), body)
+	if err != nil {
+		return remoteVerdict{}, fmt.Errorf("request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return remoteVerdict{}, fmt.Errorf("do request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var payload struct {
+		Valid   *bool  `json:"valid"`
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	// Empty or non-JSON bodies are fine; the status code alone decides.
+	_ = json.NewDecoder(resp.Body).Decode(&payload)
+	message := strings.TrimSpace(payload.Message)
+	if message == "" {
+		message = strings.TrimSpace(payload.Error)
+	}
+	if message == "" {
+		message = defaultRemoteValidationMessage
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusUnprocessableEntity:
+		return remoteVerdict{message: message}, nil
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return remoteVerdict{}, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	case payload.Valid != nil && !*payload.Valid:
+		return remoteVerdict{message: message}, nil
+	default:
+		return remoteVerdict{valid: true}, nil
+	}
+}
+
+func resolveFieldReferences(tpl string, state *State) string {
+	return fieldReferencePattern.ReplaceAllStringFunc(tpl, func(match string) string {
+		path := fieldReferencePattern.FindStringSubmatch(match)[1]
+		value, ok := state.GetValue(path)
+		if !ok || value == nil {
+			return ""
+		}
+		return fmt.Sprint(value)
+	})
+}
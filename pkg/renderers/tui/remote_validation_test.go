@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+func remoteSlugForm(url string) model.FormModel {
+	return model.FormModel{
+		Fields: []model.Field{
+			{Name: "tenant", Type: model.FieldTypeString},
+			{
+				Name: "slug",
+				Type: model.FieldTypeString,
+				Metadata: map[string]string{
+					"validation.endpoint.url":                  url,
+					"validation.endpoint.param":                "slug",
+					"validation.endpoint.dynamicParams.tenant": "{{field:tenant}}",
+				},
+			},
+		},
+	}
+}
+
+func TestRender_RemoteValidationRejectsAndReprompts(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("slug") == "taken" {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message":"slug already exists"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"valid":true}`))
+	}))
+	defer server.Close()
+
+	driver := &stubDriver{inputs: []string{"acme", "taken", "fresh"}}
+	r, err := New(WithPromptDriver(driver), WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	out, err := r.Render(context.Background(), remoteSlugForm(server.URL), render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if payload["slug"] != "fresh" {
+		t.Fatalf("expected slug fresh, got %+v", payload)
+	}
+	if len(queries) != 2 || queries[0] != "slug=taken&tenant=acme" {
+		t.Fatalf("unexpected remote queries: %v", queries)
+	}
+	if len(driver.infoMessages) != 1 || !strings.Contains(driver.infoMessages[0], "slug already exists") {
+		t.Fatalf("expected rejection message, got %v", driver.infoMessages)
+	}
+}
+
+func TestRender_RemoteValidationRetryThenSkip(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	driver := &stubDriver{
+		inputs:    []string{"acme", "fresh"},
+		selectIdx: []int{0, 1}, // retry once, then skip the check
+	}
+	r, err := New(WithPromptDriver(driver), WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	out, err := r.Render(context.Background(), remoteSlugForm(server.URL), render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if payload["slug"] != "fresh" {
+		t.Fatalf("expected skipped check to keep value, got %+v", payload)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 remote calls (initial + retry), got %d", calls)
+	}
+}
+
+func TestRender_RemoteValidationOfflineByDefault(t *testing.T) {
+	driver := &stubDriver{inputs: []string{"acme", "taken"}}
+	r, err := New(WithPromptDriver(driver))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	if _, err := r.Render(context.Background(), remoteSlugForm("http://127.0.0.1:1/unused"), render.RenderOptions{}); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/goliatone/go-formgen/pkg/model"
 	"github.com/goliatone/go-formgen/pkg/render"
@@ -26,11 +27,16 @@ type Renderer struct {
 	httpClient        *http.Client
 	submitTransformer SubmitTransformer
 	theme             Theme
+	prefetch          *PrefetchOptions
+	flights           *flightGroup
+	relSession        *relStore
+	includeAdvanced   *bool
+	now               func() time.Time
 }
 
 // New constructs a TUI renderer with defaults (survey driver, JSON output).
 func New(options ...Option) (render.Renderer, error) {
-	driver, err := newSurveyDriver()
+	driver, err := NewSurveyDriver()
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +44,8 @@ func New(options ...Option) (render.Renderer, error) {
 	r := &Renderer{
 		driver:       driver,
 		outputFormat: OutputFormatJSON,
+		flights:      &flightGroup{},
+		now:          time.Now,
 	}
 
 	for _, opt := range options {
@@ -48,7 +56,7 @@ func New(options ...Option) (render.Renderer, error) {
 	}
 
 	if r.driver == nil {
-		r.driver, err = newSurveyDriver()
+		r.driver, err = NewSurveyDriver()
 		if err != nil {
 			return nil, err
 		}
@@ -86,41 +94,70 @@ func (r *Renderer) Render(ctx context.Context, form model.FormModel, opts render
 	if r.driver == nil {
 		return nil, errors.New("tui: prompt driver is nil")
 	}
+	if r.now == nil {
+		r.now = time.Now
+	}
+	started := r.now()
 
 	render.ApplySubset(&form, opts.Subset)
+	render.ApplyFieldOverrides(&form, opts.FieldOverrides)
+	render.ApplyConcurrency(&form, &opts)
 
 	state := NewState(opts.Values, opts.Errors)
 	rulesCache := make(map[string]validationRules)
-	relCache := make(map[string][]relOption)
+	if r.relSession == nil {
+		r.relSession = newRelStore(r.now)
+	}
+	relCache := relCaches{memory: newRelStore(r.now), session: r.relSession}
 	for _, field := range render.SortedHiddenFields(opts.HiddenFields) {
 		_ = state.SetValue(field.Name, field.Value)
 	}
+	r.prefetchRelationships(ctx, form.Fields, relCache)
+
+	prompted, err := r.advancedFilter(ctx, form)
+	if err != nil {
+		if r.outputFormat == OutputFormatEnvelope && isSessionAbort(err) {
+			return r.envelope(EnvelopeStatusAborted, state.Values(), state, started)
+		}
+		return nil, err
+	}
 
 	for _, field := range form.Fields {
+		if !prompted(field) {
+			continue
+		}
 		if err := r.promptField(ctx, field, field.Name, state, rulesCache, relCache); err != nil {
+			if r.outputFormat == OutputFormatEnvelope && isSessionAbort(err) {
+				return r.envelope(EnvelopeStatusAborted, state.Values(), state, started)
+			}
 			return nil, err
 		}
 	}
 
 	values := state.Values()
 	if r.submitTransformer != nil {
-		var err error
 		values, err = r.submitTransformer(values)
 		if err != nil {
 			return nil, fmt.Errorf("tui: submit transformer: %w", err)
 		}
 	}
 
+	if r.outputFormat == OutputFormatEnvelope {
+		return r.envelope(EnvelopeStatusComplete, values, state, started)
+	}
 	return r.serialize(values)
 }
 
-func (r *Renderer) promptField(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules, relCache map[string][]relOption) error {
+func (r *Renderer) promptField(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules, relCache relCaches) error {
 	if field.Relationship != nil {
 		return r.promptRelationship(ctx, field, path, state, rulesCache, relCache)
 	}
 	if len(field.Enum) > 0 && field.Type != model.FieldTypeArray {
 		return r.promptEnum(ctx, field, path, state, rulesCache)
 	}
+	if isDateRangeField(field) {
+		return r.promptDateRange(ctx, field, path, state)
+	}
 	switch field.Type {
 	case model.FieldTypeBoolean:
 		return r.promptBoolean(ctx, field, path, state, rulesCache)
@@ -139,6 +176,10 @@ func (r *Renderer) promptField(ctx context.Context, field model.Field, path stri
 func (r *Renderer) promptString(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules) error {
 	label := displayLabel(field)
 	help := displayHelp(field)
+	duration := isDurationField(field)
+	if duration && help == "" {
+		help = durationHelp
+	}
 
 	rules := collectValidationRules(field, rulesCache)
 	defaultVal := defaultStringValue(state, path, field.Default)
@@ -170,12 +211,25 @@ func (r *Renderer) promptString(ctx context.Context, field model.Field, path str
 		}
 
 		if !rules.required && strings.TrimSpace(response) == "" {
+			state.markSkipped(path)
 			_ = state.SetValue(path, response)
 			return nil
 		}
 
 		if err := rules.validateString(response); err != nil {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+			r.reportInvalid(ctx, state, path, fmt.Sprint(err))
+			continue
+		}
+		if duration {
+			if _, err := model.ParseISODuration(response); err != nil {
+				r.reportInvalid(ctx, state, path, "expected an "+durationHelp)
+				continue
+			}
+		}
+		if ok, err := r.validateRemote(ctx, field.Metadata, path, response, state); err != nil {
+			return err
+		} else if !ok {
+			defaultVal = response
 			continue
 		}
 
@@ -200,7 +254,7 @@ func (r *Renderer) promptBoolean(ctx context.Context, field model.Field, path st
 			return err
 		}
 		if err := rules.validateBool(resp); err != nil {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+			r.reportInvalid(ctx, state, path, fmt.Sprint(err))
 			continue
 		}
 		_ = state.SetValue(path, resp)
@@ -210,12 +264,19 @@ func (r *Renderer) promptBoolean(ctx context.Context, field model.Field, path st
 
 func (r *Renderer) promptNumber(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules) error {
 	label := displayLabel(field)
+	if unit := model.FieldUnit(field); unit != "" {
+		label = fmt.Sprintf("%s (%s)", label, unit)
+	}
 	help := displayHelp(field)
 	rules := collectValidationRules(field, rulesCache)
+	precision, hasPrecision := model.FieldPrecision(field)
 	defaultVal, hasDefault := defaultNumberValue(state, path, field.Default, field.Type == model.FieldTypeInteger)
 	defaultStr := ""
 	if hasDefault {
 		defaultStr = fmt.Sprint(defaultVal)
+		if formatted, ok := model.FormatNumber(defaultVal, precision); ok && hasPrecision && field.Type != model.FieldTypeInteger {
+			defaultStr = formatted
+		}
 	}
 
 	for {
@@ -230,9 +291,10 @@ func (r *Renderer) promptNumber(ctx context.Context, field model.Field, path str
 
 		if input == "" {
 			if rules.required {
-				_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: required", path))
+				r.reportInvalid(ctx, state, path, "required")
 				continue
 			}
+			state.markSkipped(path)
 			_ = state.SetValue(path, nil)
 			return nil
 		}
@@ -241,21 +303,31 @@ func (r *Renderer) promptNumber(ctx context.Context, field model.Field, path str
 		if field.Type == model.FieldTypeInteger {
 			i, err := strconv.ParseInt(strings.TrimSpace(input), 10, 64)
 			if err != nil {
-				_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+				r.reportInvalid(ctx, state, path, fmt.Sprint(err))
 				continue
 			}
 			parsed = i
 		} else {
 			f, err := strconv.ParseFloat(strings.TrimSpace(input), 64)
 			if err != nil {
-				_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+				r.reportInvalid(ctx, state, path, fmt.Sprint(err))
+				continue
+			}
+			if hasPrecision && model.DecimalPlaces(f) > precision {
+				r.reportInvalid(ctx, state, path, fmt.Sprintf("at most %d decimal places", precision))
 				continue
 			}
 			parsed = f
 		}
 
 		if err := rules.validateNumber(parsed); err != nil {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+			r.reportInvalid(ctx, state, path, fmt.Sprint(err))
+			continue
+		}
+		if ok, err := r.validateRemote(ctx, field.Metadata, path, parsed, state); err != nil {
+			return err
+		} else if !ok {
+			defaultStr = input
 			continue
 		}
 
@@ -286,12 +358,12 @@ func (r *Renderer) promptEnum(ctx context.Context, field model.Field, path strin
 			return err
 		}
 		if idx < 0 || idx >= len(options) {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s selection", path))
+			r.reportInvalid(ctx, state, path, "invalid selection")
 			continue
 		}
 		selected := field.Enum[idx]
 		if issues := validateSubmittedField(field, selected); len(issues) > 0 {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %s", path, issues[0].Message))
+			r.reportInvalid(ctx, state, path, issues[0].Message)
 			continue
 		}
 		_ = state.SetValue(path, selected)
@@ -299,7 +371,7 @@ func (r *Renderer) promptEnum(ctx context.Context, field model.Field, path strin
 	}
 }
 
-func (r *Renderer) promptArray(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules, relCache map[string][]relOption) error {
+func (r *Renderer) promptArray(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules, relCache relCaches) error {
 	if len(field.Enum) > 0 {
 		return r.promptEnumArray(ctx, field, path, state, rulesCache)
 	}
@@ -321,6 +393,7 @@ func (r *Renderer) promptArray(ctx context.Context, field model.Field, path stri
 			return err
 		}
 		if !add {
+			state.markSkipped(path)
 			return state.SetValue(path, items)
 		}
 	}
@@ -376,11 +449,11 @@ func (r *Renderer) promptEnumArray(ctx context.Context, field model.Field, path
 		}
 		selected := valuesFromEnumIndices(field.Enum, indices)
 		if err := rules.validateArray(selected); err != nil {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+			r.reportInvalid(ctx, state, path, fmt.Sprint(err))
 			continue
 		}
 		if issues := validateSubmittedField(field, selected); len(issues) > 0 {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %s", path, issues[0].Message))
+			r.reportInvalid(ctx, state, path, issues[0].Message)
 			continue
 		}
 		_ = state.SetValue(path, selected)
@@ -388,7 +461,7 @@ func (r *Renderer) promptEnumArray(ctx context.Context, field model.Field, path
 	}
 }
 
-func (r *Renderer) promptObject(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules, relCache map[string][]relOption) error {
+func (r *Renderer) promptObject(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules, relCache relCaches) error {
 	if submission.IsRawObjectField(field) {
 		return r.promptRawObject(ctx, field, path, state)
 	}
@@ -425,13 +498,19 @@ func (r *Renderer) promptRawObject(ctx context.Context, field model.Field, path
 		}
 		value, issues := submission.CoerceValue(field, input, submission.Options{}, path)
 		if len(issues) > 0 {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %s", path, issues[0].Message))
+			r.reportInvalid(ctx, state, path, issues[0].Message)
 			continue
 		}
 		return state.SetValue(path, value)
 	}
 }
 
+// reportInvalid shows a validation message and records it for the envelope.
+func (r *Renderer) reportInvalid(ctx context.Context, state *State, path, detail string) {
+	state.recordIssue(path, detail)
+	_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %s", path, detail))
+}
+
 func (r *Renderer) serialize(values map[string]any) ([]byte, error) {
 	switch r.outputFormat {
 	case OutputFormatFormURLEncoded:
@@ -605,9 +684,13 @@ type relConfig struct {
 	url        string
 	method     string
 	labelField string
+	labelTpl   string
 	valueField string
 	results    string
 	params     map[string]string
+	// cacheTTL and cacheStrategy come from relationship.endpoint.cache.*.
+	cacheTTL      time.Duration
+	cacheStrategy string
 }
 
 type relOption struct {
@@ -615,7 +698,7 @@ type relOption struct {
 	Value string
 }
 
-func (r *Renderer) promptRelationship(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules, relCache map[string][]relOption) error {
+func (r *Renderer) promptRelationship(ctx context.Context, field model.Field, path string, state *State, rulesCache map[string]validationRules, relCache relCaches) error {
 	rel := field.Relationship
 	if rel == nil {
 		return errors.New("tui: relationship metadata missing")
@@ -677,7 +760,7 @@ func (r *Renderer) promptManyRelationshipSelect(ctx context.Context, label, help
 		}
 		selected := valuesFromOptionIndices(options, indices)
 		if err := rules.validateArray(toAnySlice(selected)); err != nil {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+			r.reportInvalid(ctx, state, path, fmt.Sprint(err))
 			continue
 		}
 		return state.SetValue(path, toAnySlice(selected))
@@ -700,7 +783,7 @@ func (r *Renderer) promptManyRelationshipInput(ctx context.Context, label, help,
 		if val = strings.TrimSpace(val); val != "" {
 			entries = append(entries, val)
 		}
-		validationErr, err := validateRelationshipEntries(ctx, r, path, rules, entries)
+		validationErr, err := validateRelationshipEntries(ctx, r, path, state, rules, entries)
 		if err != nil {
 			return err
 		}
@@ -717,12 +800,12 @@ func (r *Renderer) promptManyRelationshipInput(ctx context.Context, label, help,
 	}
 }
 
-func validateRelationshipEntries(ctx context.Context, r *Renderer, path string, rules validationRules, entries []string) (error, error) {
+func validateRelationshipEntries(ctx context.Context, r *Renderer, path string, state *State, rules validationRules, entries []string) (error, error) {
 	if err := rules.validateArray(toAnySlice(entries)); err != nil {
 		if errors.Is(err, ErrAborted) {
 			return err, err
 		}
-		_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+		r.reportInvalid(ctx, state, path, fmt.Sprint(err))
 		return err, nil
 	}
 	return nil, nil
@@ -742,12 +825,12 @@ func (r *Renderer) promptSingleRelationshipSelect(ctx context.Context, label, he
 			return err
 		}
 		if idx < 0 || idx >= len(options) {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s selection", path))
+			r.reportInvalid(ctx, state, path, "invalid selection")
 			continue
 		}
 		selected := options[idx].Value
 		if err := rules.validateString(selected); err != nil {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+			r.reportInvalid(ctx, state, path, fmt.Sprint(err))
 			continue
 		}
 		return state.SetValue(path, selected)
@@ -762,9 +845,12 @@ func (r *Renderer) promptSingleRelationshipInput(ctx context.Context, label, hel
 		}
 		val = strings.TrimSpace(val)
 		if err := rules.validateString(val); err != nil {
-			_ = r.driver.Info(ctx, fmt.Sprintf("Invalid %s: %v", path, err))
+			r.reportInvalid(ctx, state, path, fmt.Sprint(err))
 			continue
 		}
+		if val == "" {
+			state.markSkipped(path)
+		}
 		return state.SetValue(path, val)
 	}
 }
@@ -849,9 +935,13 @@ func parseRelConfig(metadata map[string]string) (relConfig, bool) {
 		url:        url,
 		method:     strings.ToUpper(strings.TrimSpace(metadata["relationship.endpoint.method"])),
 		labelField: strings.TrimSpace(metadata["relationship.endpoint.labelField"]),
+		labelTpl:   strings.TrimSpace(metadata["relationship.endpoint.labelTemplate"]),
 		valueField: strings.TrimSpace(metadata["relationship.endpoint.valueField"]),
 		results:    strings.TrimSpace(metadata["relationship.endpoint.resultsPath"]),
 		params:     map[string]string{},
+
+		cacheTTL:      parseRelCacheTTL(metadata["relationship.endpoint.cache.ttl"]),
+		cacheStrategy: strings.ToLower(strings.TrimSpace(metadata["relationship.endpoint.cache.strategy"])),
 	}
 	if cfg.method == "" {
 		cfg.method = http.MethodGet
@@ -869,25 +959,30 @@ func parseRelConfig(metadata map[string]string) (relConfig, bool) {
 	return cfg, true
 }
 
-func (r *Renderer) relationshipOptions(ctx context.Context, cfg relConfig, cache map[string][]relOption) []relOption {
+func (r *Renderer) relationshipOptions(ctx context.Context, cfg relConfig, cache relCaches) []relOption {
 	// Enum or missing config handled before calling this; http optional.
 	if cfg.url == "" || r.httpClient == nil {
 		return nil
 	}
 
 	cacheKey := cacheKeyFor(cfg)
-	if opts, ok := cache[cacheKey]; ok {
+	store := cache.store(cfg)
+	if opts, ok := store.get(cacheKey); ok {
 		return opts
 	}
 
-	opts, err := r.fetchRelationshipOptions(ctx, cfg)
+	opts, err := r.fetchShared(ctx, cacheKey, cfg)
 	if err != nil {
 		_ = r.driver.Info(ctx, fmt.Sprintf("Warning: relationship options fetch failed (%v); falling back to manual input", err))
-		cache[cacheKey] = nil
+		// Failures are remembered for the current render only so a later
+		// render retries endpoints cached for the session.
+		if store != nil {
+			cache.memory.set(cacheKey, nil, cfg.cacheTTL)
+		}
 		return nil
 	}
 
-	cache[cacheKey] = opts
+	store.set(cacheKey, opts, cfg.cacheTTL)
 	return opts
 }
 
@@ -959,6 +1054,9 @@ func (r *Renderer) fetchRelationshipOptions(ctx context.Context, cfg relConfig)
 		}
 		val := pickValue(obj, cfg.valueField)
 		lbl := pickValue(obj, cfg.labelField)
+		if cfg.labelTpl != "" {
+			lbl = strings.TrimSpace(renderLabelTemplate(cfg.labelTpl, obj))
+		}
 		if val == "" {
 			continue
 		}
@@ -973,6 +1071,28 @@ func (r *Renderer) fetchRelationshipOptions(ctx context.Context, cfg relConfig)
 	return opts, nil
 }
 
+var labelTemplatePattern = regexp.MustCompile(`\{\{\s*item\.([^{}\s]+)\s*\}\}`)
+
+// renderLabelTemplate expands {{item.path}} placeholders against a response
+// item. Missing paths expand to an empty string.
+func renderLabelTemplate(tpl string, item map[string]any) string {
+	return labelTemplatePattern.ReplaceAllStringFunc(tpl, func(match string) string {
+		path := labelTemplatePattern.FindStringSubmatch(match)[1]
+		var cur any = item
+		for segment := range strings.SplitSeq(path, ".") {
+			node, ok := cur.(map[string]any)
+			if !ok {
+				return ""
+			}
+			cur = node[segment]
+		}
+		if cur == nil {
+			return ""
+		}
+		return fmt.Sprint(cur)
+	})
+}
+
 func extractResults(payload any, path string) []any {
 	if payload == nil {
 		return nil
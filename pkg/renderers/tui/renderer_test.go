@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/goliatone/go-formgen/pkg/model"
 	"github.com/goliatone/go-formgen/pkg/render"
@@ -248,6 +251,93 @@ func TestRender_NumberValidation(t *testing.T) {
 	}
 }
 
+type recordingDriver struct {
+	*stubDriver
+	configs []InputConfig
+}
+
+func (d *recordingDriver) Input(ctx context.Context, cfg InputConfig) (string, error) {
+	d.configs = append(d.configs, cfg)
+	return d.stubDriver.Input(ctx, cfg)
+}
+
+func TestRender_NumberUnitAndPrecision(t *testing.T) {
+	driver := &recordingDriver{stubDriver: &stubDriver{inputs: []string{"1.255", "1.25"}}}
+	r, err := New(WithPromptDriver(driver))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	form := model.FormModel{
+		Fields: []model.Field{
+			{
+				Name:    "weight",
+				Type:    model.FieldTypeNumber,
+				Label:   "Weight",
+				Default: 1.5,
+				UIHints: map[string]string{"unit": "kg", "precision": "2"},
+			},
+		},
+	}
+
+	out, err := r.Render(context.Background(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if got := driver.configs[0]; got.Message != "Weight (kg)" || got.Default != "1.50" {
+		t.Fatalf("expected unit label and formatted default, got %+v", got)
+	}
+	if len(driver.infoMessages) == 0 {
+		t.Fatalf("expected precision message for first input")
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if payload["weight"] != 1.25 {
+		t.Fatalf("expected weight 1.25, got %v", payload["weight"])
+	}
+}
+
+func TestRender_DateRangeAndDuration(t *testing.T) {
+	driver := &recordingDriver{stubDriver: &stubDriver{inputs: []string{
+		"2026-03-10", "2026-03-01", "2026-03-12", "90 minutes", "PT90M",
+	}}}
+	r, err := New(WithPromptDriver(driver))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	form := model.FormModel{
+		Fields: []model.Field{
+			{Name: "stay", Label: "Stay", Type: model.FieldTypeObject, UIHints: map[string]string{"widget": "daterange"}},
+			{Name: "timeout", Label: "Timeout", Type: model.FieldTypeString, Format: "duration"},
+		},
+	}
+
+	out, err := r.Render(context.Background(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if got := driver.configs[0].Message; got != "Stay: Start (YYYY-MM-DD)" {
+		t.Fatalf("unexpected start prompt %q", got)
+	}
+	if got := driver.configs[3].Help; got != durationHelp {
+		t.Fatalf("expected duration help, got %q", got)
+	}
+	if len(driver.infoMessages) != 2 {
+		t.Fatalf("expected end-before-start and duration messages, got %v", driver.infoMessages)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	stay, _ := payload["stay"].(map[string]any)
+	if stay["start"] != "2026-03-10" || stay["end"] != "2026-03-12" || payload["timeout"] != "PT90M" {
+		t.Fatalf("unexpected payload %v", payload)
+	}
+}
+
 func TestRender_RelationshipOptionsFetch(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		_, _ = w.Write([]byte(`[{"id":"1","label":"One"},{"id":"2","label":"Two"}]`))
@@ -441,3 +531,309 @@ func (abortDriver) MultiSelect(context.Context, SelectConfig) ([]int, error) { r
 func (abortDriver) TextArea(context.Context, TextAreaConfig) (string, error) { return "", ErrAborted }
 func (abortDriver) Repeat(context.Context, RepeatConfig) ([][]byte, error)   { return nil, ErrAborted }
 func (abortDriver) Info(context.Context, string) error                       { return ErrAborted }
+
+func TestRender_RelationshipPrefetchBoundsConcurrency(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		peak     int
+		requests int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		_, _ = w.Write([]byte(`[{"id":"1","label":"One"}]`))
+	}))
+	defer server.Close()
+
+	var fields []model.Field
+	for i, path := range []string{"/a", "/b", "/c", "/d", "/e", "/a"} {
+		fields = append(fields, model.Field{
+			Name:         fmt.Sprintf("rel_%d", i),
+			Type:         model.FieldTypeString,
+			Relationship: &model.Relationship{Kind: model.RelationshipBelongsTo, Cardinality: "one"},
+			Metadata: map[string]string{
+				"relationship.endpoint.url":        server.URL + path,
+				"relationship.endpoint.labelField": "label",
+				"relationship.endpoint.valueField": "id",
+			},
+		})
+	}
+
+	driver := &stubDriver{selectIdx: []int{0, 0, 0, 0, 0, 0}}
+	r, err := New(
+		WithPromptDriver(driver),
+		WithHTTPClient(server.Client()),
+		WithRelationshipPrefetch(PrefetchOptions{Concurrency: 4, PerHost: 2}),
+	)
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	if _, err := r.Render(context.Background(), model.FormModel{Fields: fields}, render.RenderOptions{}); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if requests != 5 {
+		t.Fatalf("expected 5 deduplicated requests, got %d", requests)
+	}
+	if peak > 2 {
+		t.Fatalf("expected at most 2 concurrent requests per host, got %d", peak)
+	}
+	if len(driver.infoMessages) != 0 {
+		t.Fatalf("unexpected warnings: %v", driver.infoMessages)
+	}
+}
+
+func TestRender_RelationshipLabelTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"id":"1","name":{"first":"Ada","last":"Lovelace"}},{"id":"2","name":{"first":"Grace"}}]}`))
+	}))
+	defer server.Close()
+
+	var seen []string
+	driver := &recordingSelectDriver{stubDriver: &stubDriver{selectIdx: []int{0}}, seen: &seen}
+	r, err := New(WithPromptDriver(driver), WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	form := model.FormModel{
+		Fields: []model.Field{
+			{
+				Name:         "author_id",
+				Type:         model.FieldTypeString,
+				Relationship: &model.Relationship{Kind: model.RelationshipBelongsTo, Cardinality: "one"},
+				Metadata: map[string]string{
+					"relationship.endpoint.url":           server.URL,
+					"relationship.endpoint.resultsPath":   "data",
+					"relationship.endpoint.valueField":    "id",
+					"relationship.endpoint.labelTemplate": "{{item.name.first}} {{ item.name.last }}",
+				},
+			},
+		},
+	}
+
+	if _, err := r.Render(context.Background(), form, render.RenderOptions{}); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if len(seen) != 2 || seen[0] != "Ada Lovelace" || seen[1] != "Grace" {
+		t.Fatalf("unexpected option labels: %q", seen)
+	}
+}
+
+func TestRender_RelationshipCacheStrategies(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		hits = map[string]int{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hits[r.URL.Path]++
+		mu.Unlock()
+		_, _ = w.Write([]byte(`[{"id":"1","label":"One"}]`))
+	}))
+	defer server.Close()
+
+	relField := func(name, path string, cache map[string]string) model.Field {
+		metadata := map[string]string{
+			"relationship.endpoint.url":        server.URL + path,
+			"relationship.endpoint.labelField": "label",
+			"relationship.endpoint.valueField": "id",
+		}
+		for key, value := range cache {
+			metadata["relationship.endpoint.cache."+key] = value
+		}
+		return model.Field{
+			Name:         name,
+			Type:         model.FieldTypeString,
+			Relationship: &model.Relationship{Kind: model.RelationshipBelongsTo, Cardinality: "one"},
+			Metadata:     metadata,
+		}
+	}
+	form := model.FormModel{Fields: []model.Field{
+		relField("a", "/none", map[string]string{"strategy": "none"}),
+		relField("b", "/none", map[string]string{"strategy": "none"}),
+		relField("c", "/session", map[string]string{"strategy": "session", "ttl": "60000"}),
+		relField("d", "/memory", nil),
+	}}
+
+	driver := &stubDriver{selectIdx: make([]int, 12)}
+	rendered, err := New(WithPromptDriver(driver), WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	clock := time.Unix(0, 0)
+	rendered.(*Renderer).now = func() time.Time { return clock }
+
+	expect := func(step string, want map[string]int) {
+		t.Helper()
+		if _, err := rendered.Render(context.Background(), form, render.RenderOptions{}); err != nil {
+			t.Fatalf("%s render: %v", step, err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for path, count := range want {
+			if hits[path] != count {
+				t.Fatalf("%s: %s fetched %d times, want %d", step, path, hits[path], count)
+			}
+		}
+	}
+
+	expect("first", map[string]int{"/none": 2, "/session": 1, "/memory": 1})
+	expect("second", map[string]int{"/none": 4, "/session": 1, "/memory": 2})
+	clock = clock.Add(2 * time.Minute)
+	expect("expired", map[string]int{"/none": 6, "/session": 2, "/memory": 3})
+}
+
+type recordingSelectDriver struct {
+	*stubDriver
+	seen *[]string
+}
+
+func (d *recordingSelectDriver) Select(ctx context.Context, cfg SelectConfig) (int, error) {
+	*d.seen = append(*d.seen, cfg.Options...)
+	return d.stubDriver.Select(ctx, cfg)
+}
+
+func TestRender_EnvelopeReportsSession(t *testing.T) {
+	driver := &stubDriver{inputs: []string{"x", "Ada", ""}}
+	r, err := New(WithPromptDriver(driver), WithOutputFormat(OutputFormatEnvelope))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	form := model.FormModel{
+		Fields: []model.Field{
+			{
+				Name:        "name",
+				Type:        model.FieldTypeString,
+				Required:    true,
+				Validations: []model.ValidationRule{{Kind: model.ValidationRuleMinLength, Params: map[string]string{"value": "2"}}},
+			},
+			{Name: "nickname", Type: model.FieldTypeString},
+		},
+	}
+
+	out, err := r.Render(context.Background(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	var env Envelope
+	if err := json.Unmarshal(out, &env); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if env.Status != EnvelopeStatusComplete || env.Values["name"] != "Ada" {
+		t.Fatalf("unexpected envelope: %+v", env)
+	}
+	if len(env.Errors["name"]) != 1 {
+		t.Fatalf("expected one recorded issue for name, got %+v", env.Errors)
+	}
+	if len(env.Skipped) != 1 || env.Skipped[0] != "nickname" {
+		t.Fatalf("expected nickname skipped, got %+v", env.Skipped)
+	}
+	if env.DurationMs < 0 {
+		t.Fatalf("negative duration: %d", env.DurationMs)
+	}
+}
+
+func TestRender_EnvelopeAbortedSession(t *testing.T) {
+	driver := &stubDriver{inputs: []string{"Ada"}}
+	r, err := New(WithPromptDriver(&abortingDriver{stubDriver: driver, after: 1}), WithOutputFormat(OutputFormatEnvelope))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	form := model.FormModel{
+		Fields: []model.Field{
+			{Name: "name", Type: model.FieldTypeString},
+			{Name: "email", Type: model.FieldTypeString},
+		},
+	}
+
+	out, err := r.Render(context.Background(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("aborted envelope sessions must not fail: %v", err)
+	}
+	var env Envelope
+	if err := json.Unmarshal(out, &env); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if env.Status != EnvelopeStatusAborted || env.Values["name"] != "Ada" {
+		t.Fatalf("unexpected envelope: %+v", env)
+	}
+	if _, ok := env.Values["email"]; ok {
+		t.Fatalf("unanswered field must be absent: %+v", env.Values)
+	}
+}
+
+type abortingDriver struct {
+	*stubDriver
+	after int
+	calls int
+}
+
+func (d *abortingDriver) Input(ctx context.Context, cfg InputConfig) (string, error) {
+	if d.calls >= d.after {
+		return "", ErrAborted
+	}
+	d.calls++
+	return d.stubDriver.Input(ctx, cfg)
+}
+
+func TestRender_AdvancedFieldsAskedOnce(t *testing.T) {
+	form := model.FormModel{
+		Metadata: map[string]string{
+			"layout.sections": `[{"id":"delivery","uiHints":{"advanced":"true"}}]`,
+		},
+		Fields: []model.Field{
+			{Name: "url", Type: model.FieldTypeString, Label: "URL"},
+			{Name: "secret", Type: model.FieldTypeString, Label: "Secret", UIHints: map[string]string{"advanced": "true"}},
+			{Name: "retries", Type: model.FieldTypeString, Label: "Retries", Metadata: map[string]string{"layout.section": "delivery"}},
+		},
+	}
+
+	skip := &stubDriver{inputs: []string{"https://example.com"}, confirm: []bool{false}}
+	r, err := New(WithPromptDriver(skip))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	out, err := r.Render(context.Background(), form, render.RenderOptions{Values: map[string]any{"retries": "3"}})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if payload["url"] != "https://example.com" || payload["retries"] != "3" || payload["secret"] != nil {
+		t.Fatalf("unexpected payload when skipping advanced fields: %+v", payload)
+	}
+	if skip.confirmPos != 1 || skip.inputPos != 1 {
+		t.Fatalf("expected one confirm and one input, got %d confirms and %d inputs", skip.confirmPos, skip.inputPos)
+	}
+
+	include := &stubDriver{inputs: []string{"https://example.com", "s3cr3t", "5"}}
+	r, err = New(WithPromptDriver(include), WithAdvancedFields(true))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	out, err = r.Render(context.Background(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render with advanced fields: %v", err)
+	}
+	payload = nil
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if payload["secret"] != "s3cr3t" || payload["retries"] != "5" || include.confirmPos != 0 {
+		t.Fatalf("unexpected payload when including advanced fields: %+v", payload)
+	}
+}
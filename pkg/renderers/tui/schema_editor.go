@@ -0,0 +1,354 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+	"github.com/goliatone/go-formgen/pkg/widgets"
+)
+
+const (
+	editorActionField = iota
+	editorActionSection
+	editorActionSave
+	editorActionDiscard
+)
+
+const (
+	fieldActionSection = iota
+	fieldActionOrder
+	fieldActionSpan
+	fieldActionLabel
+	fieldActionWidget
+	fieldActionBack
+)
+
+const (
+	editorNone        = "(none)"
+	editorCustomEntry = "other..."
+)
+
+var editorWidgets = []string{
+	widgets.WidgetSelect,
+	widgets.WidgetToggle,
+	widgets.WidgetChips,
+	widgets.WidgetCodeEditor,
+	widgets.WidgetJSONEditor,
+	widgets.WidgetKeyValue,
+}
+
+// EditUISchema walks the user through assigning sections, order, grid spans,
+// labels, and widgets to the fields of form, starting from doc (usually
+// uischema.Scaffold(form)). The edited document is validated against form
+// before it is returned; discarding the session returns ErrAborted.
+func EditUISchema(ctx context.Context, driver PromptDriver, form model.FormModel, doc uischema.ScaffoldDocument) (uischema.ScaffoldDocument, error) {
+	if driver == nil {
+		return uischema.ScaffoldDocument{}, errors.New("tui: editor requires a prompt driver")
+	}
+	source, ok := doc.Operations[form.OperationID]
+	if !ok {
+		return uischema.ScaffoldDocument{}, fmt.Errorf("tui: ui schema has no operation %q", form.OperationID)
+	}
+	editor := &schemaEditor{driver: driver, op: cloneScaffoldOperation(source)}
+
+	for {
+		action, err := driver.Select(ctx, SelectConfig{
+			Message: fmt.Sprintf("UI schema for %s", form.OperationID),
+			Options: []string{"Edit a field", "Add a section", "Save and exit", "Discard changes"},
+		})
+		if err != nil {
+			return uischema.ScaffoldDocument{}, err
+		}
+		switch action {
+		case editorActionField:
+			if err := editor.editField(ctx); err != nil {
+				return uischema.ScaffoldDocument{}, err
+			}
+		case editorActionSection:
+			if err := editor.addSection(ctx); err != nil {
+				return uischema.ScaffoldDocument{}, err
+			}
+		case editorActionSave:
+			result := uischema.ScaffoldDocument{Operations: make(map[string]uischema.ScaffoldOperation, len(doc.Operations))}
+			for id, op := range doc.Operations {
+				result.Operations[id] = op
+			}
+			result.Operations[form.OperationID] = editor.op
+			if err := result.Validate(form); err != nil {
+				if infoErr := driver.Info(ctx, fmt.Sprintf("UI schema is not valid yet: %v", err)); infoErr != nil {
+					return uischema.ScaffoldDocument{}, infoErr
+				}
+				continue
+			}
+			return result, nil
+		case editorActionDiscard:
+			return uischema.ScaffoldDocument{}, ErrAborted
+		}
+	}
+}
+
+type schemaEditor struct {
+	driver PromptDriver
+	op     uischema.ScaffoldOperation
+}
+
+func (e *schemaEditor) editField(ctx context.Context) error {
+	if len(e.op.Fields) == 0 {
+		return e.driver.Info(ctx, "The form has no fields to edit.")
+	}
+	options := make([]string, len(e.op.Fields))
+	for idx, field := range e.op.Fields {
+		options[idx] = describeScaffoldField(field)
+	}
+	idx, err := e.driver.Select(ctx, SelectConfig{Message: "Field", Options: options, PageSize: 15})
+	if err != nil {
+		return err
+	}
+	if idx < 0 || idx >= len(e.op.Fields) {
+		return nil
+	}
+	field := &e.op.Fields[idx]
+
+	for {
+		action, err := e.driver.Select(ctx, SelectConfig{
+			Message: field.Path,
+			Options: []string{
+				"Section: " + orNone(field.Section),
+				"Order: " + strconv.Itoa(field.Order),
+				"Grid span: " + spanLabel(field.Grid),
+				"Label: " + orNone(field.Label),
+				"Widget: " + orNone(field.Widget),
+				"Back",
+			},
+		})
+		if err != nil {
+			return err
+		}
+		switch action {
+		case fieldActionSection:
+			err = e.editFieldSection(ctx, field)
+		case fieldActionOrder:
+			err = e.editFieldOrder(ctx, field)
+		case fieldActionSpan:
+			err = e.editFieldSpan(ctx, field)
+		case fieldActionLabel:
+			field.Label, err = e.driver.Input(ctx, InputConfig{Message: "Label", Default: field.Label})
+			field.Label = strings.TrimSpace(field.Label)
+		case fieldActionWidget:
+			err = e.editFieldWidget(ctx, field)
+		default:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (e *schemaEditor) editFieldSection(ctx context.Context, field *uischema.ScaffoldField) error {
+	options := []string{editorNone}
+	current := 0
+	for idx, section := range e.op.Sections {
+		options = append(options, section.ID)
+		if section.ID == field.Section {
+			current = idx + 1
+		}
+	}
+	idx, err := e.driver.Select(ctx, SelectConfig{Message: "Section", Options: options, DefaultIndex: current})
+	if err != nil {
+		return err
+	}
+	switch {
+	case idx == 0:
+		field.Section = ""
+	case idx > 0 && idx < len(options):
+		field.Section = options[idx]
+	}
+	return nil
+}
+
+func (e *schemaEditor) editFieldOrder(ctx context.Context, field *uischema.ScaffoldField) error {
+	value, err := e.driver.Input(ctx, InputConfig{
+		Message:   "Order",
+		Default:   strconv.Itoa(field.Order),
+		Help:      "Position among sibling fields; lower values render first.",
+		Validator: validateEditorInt(0, 0),
+	})
+	if err != nil {
+		return err
+	}
+	order, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return fmt.Errorf("tui: invalid order %q: %w", value, err)
+	}
+	field.Order = order
+	return nil
+}
+
+func (e *schemaEditor) editFieldSpan(ctx context.Context, field *uischema.ScaffoldField) error {
+	columns := e.op.Form.Layout.GridColumns
+	current := ""
+	if field.Grid != nil && field.Grid.Span > 0 {
+		current = strconv.Itoa(field.Grid.Span)
+	}
+	value, err := e.driver.Input(ctx, InputConfig{
+		Message:   fmt.Sprintf("Grid span (1-%d, empty for full width)", columns),
+		Default:   current,
+		Validator: validateEditorInt(1, columns),
+	})
+	if err != nil {
+		return err
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		field.Grid = nil
+		return nil
+	}
+	span, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("tui: invalid grid span %q: %w", value, err)
+	}
+	grid := uischema.GridConfig{}
+	if field.Grid != nil {
+		grid = *field.Grid
+	}
+	grid.Span = span
+	field.Grid = &grid
+	return nil
+}
+
+func (e *schemaEditor) editFieldWidget(ctx context.Context, field *uischema.ScaffoldField) error {
+	options := append([]string{editorNone}, editorWidgets...)
+	options = append(options, editorCustomEntry)
+	current := 0
+	for idx, option := range options {
+		if option == field.Widget {
+			current = idx
+		}
+	}
+	idx, err := e.driver.Select(ctx, SelectConfig{Message: "Widget", Options: options, DefaultIndex: current})
+	if err != nil {
+		return err
+	}
+	switch {
+	case idx == 0:
+		field.Widget = ""
+	case idx == len(options)-1:
+		value, err := e.driver.Input(ctx, InputConfig{Message: "Widget name", Default: field.Widget})
+		if err != nil {
+			return err
+		}
+		field.Widget = strings.TrimSpace(value)
+	case idx > 0 && idx < len(options):
+		field.Widget = options[idx]
+	}
+	return nil
+}
+
+func (e *schemaEditor) addSection(ctx context.Context) error {
+	id, err := e.driver.Input(ctx, InputConfig{
+		Message: "Section ID",
+		Validator: func(value string) error {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return errors.New("section id is required")
+			}
+			for _, section := range e.op.Sections {
+				if section.ID == value {
+					return fmt.Errorf("section %q already exists", value)
+				}
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	id = strings.TrimSpace(id)
+	title, err := e.driver.Input(ctx, InputConfig{Message: "Section title", Default: id})
+	if err != nil {
+		return err
+	}
+	fieldset, err := e.driver.Confirm(ctx, ConfirmConfig{Message: "Render as a fieldset?"})
+	if err != nil {
+		return err
+	}
+	e.op.Sections = append(e.op.Sections, uischema.ScaffoldSection{
+		ID:       id,
+		Title:    strings.TrimSpace(title),
+		Order:    len(e.op.Sections),
+		Fieldset: fieldset,
+	})
+	return nil
+}
+
+func cloneScaffoldOperation(op uischema.ScaffoldOperation) uischema.ScaffoldOperation {
+	op.Sections = append([]uischema.ScaffoldSection(nil), op.Sections...)
+	op.Fields = append(uischema.ScaffoldFields(nil), op.Fields...)
+	for idx := range op.Fields {
+		if op.Fields[idx].Grid != nil {
+			grid := *op.Fields[idx].Grid
+			op.Fields[idx].Grid = &grid
+		}
+	}
+	if op.Form.Layout.GridColumns <= 0 {
+		op.Form.Layout.GridColumns = 12
+	}
+	return op
+}
+
+func describeScaffoldField(field uischema.ScaffoldField) string {
+	parts := []string{"order " + strconv.Itoa(field.Order)}
+	if field.Section != "" {
+		parts = append(parts, "section "+field.Section)
+	}
+	if field.Grid != nil && field.Grid.Span > 0 {
+		parts = append(parts, "span "+strconv.Itoa(field.Grid.Span))
+	}
+	if field.Widget != "" {
+		parts = append(parts, "widget "+field.Widget)
+	}
+	return fmt.Sprintf("%s (%s)", field.Path, strings.Join(parts, ", "))
+}
+
+func spanLabel(grid *uischema.GridConfig) string {
+	if grid == nil || grid.Span <= 0 {
+		return "full width"
+	}
+	return strconv.Itoa(grid.Span)
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return editorNone
+	}
+	return value
+}
+
+// validateEditorInt accepts integers within [minimum, maximum]; a zero maximum
+// leaves the upper bound open. Empty input is allowed when minimum is positive
+// so optional values can be cleared.
+func validateEditorInt(minimum, maximum int) func(string) error {
+	return func(value string) error {
+		value = strings.TrimSpace(value)
+		if value == "" && minimum > 0 {
+			return nil
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return errors.New("enter a whole number")
+		}
+		if number < minimum || (maximum > 0 && number > maximum) {
+			if maximum > 0 {
+				return fmt.Errorf("enter a number between %d and %d", minimum, maximum)
+			}
+			return fmt.Errorf("enter a number of at least %d", minimum)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+)
+
+func editorForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "createBook",
+		Fields: []model.Field{
+			{Name: "title", Type: model.FieldTypeString, Label: "Title"},
+			{Name: "author", Type: model.FieldTypeObject, Label: "Author", Nested: []model.Field{
+				{Name: "name", Type: model.FieldTypeString, Label: "Name"},
+			}},
+			{Name: "isbn", Type: model.FieldTypeString, Label: "ISBN"},
+		},
+	}
+}
+
+func TestEditUISchema_AssignsFieldSettings(t *testing.T) {
+	form := editorForm()
+	driver := &stubDriver{
+		selectIdx: []int{
+			editorActionSection,
+			editorActionField, 3, // isbn
+			fieldActionSection, 3, // meta
+			fieldActionSpan,
+			fieldActionWidget, 1, // select
+			fieldActionLabel,
+			fieldActionOrder,
+			fieldActionBack,
+			editorActionSave,
+		},
+		inputs:  []string{"meta", "Metadata", "6", "ISBN-13", "0"},
+		confirm: []bool{true},
+	}
+
+	doc, err := EditUISchema(context.Background(), driver, form, uischema.Scaffold(form))
+	if err != nil {
+		t.Fatalf("edit ui schema: %v", err)
+	}
+
+	op := doc.Operations["createBook"]
+	if len(op.Sections) != 3 || op.Sections[2].ID != "meta" || op.Sections[2].Title != "Metadata" || !op.Sections[2].Fieldset {
+		t.Fatalf("unexpected sections: %+v", op.Sections)
+	}
+	isbn := op.Fields[3]
+	if isbn.Path != "isbn" || isbn.Section != "meta" || isbn.Order != 0 || isbn.Label != "ISBN-13" || isbn.Widget != "select" {
+		t.Fatalf("unexpected isbn entry: %+v", isbn)
+	}
+	if isbn.Grid == nil || isbn.Grid.Span != 6 {
+		t.Fatalf("expected grid span 6, got %+v", isbn.Grid)
+	}
+	if err := doc.Validate(form); err != nil {
+		t.Fatalf("edited document should validate: %v", err)
+	}
+}
+
+func TestEditUISchema_RejectsInvalidDocumentBeforeSaving(t *testing.T) {
+	form := editorForm()
+	driver := &stubDriver{
+		selectIdx: []int{
+			editorActionField, 0, // title
+			fieldActionSpan,
+			fieldActionBack,
+			editorActionSave,
+			editorActionDiscard,
+		},
+		inputs: []string{"24"},
+	}
+
+	_, err := EditUISchema(context.Background(), driver, form, uischema.Scaffold(form))
+	if !errors.Is(err, ErrAborted) {
+		t.Fatalf("expected ErrAborted, got %v", err)
+	}
+	if len(driver.infoMessages) != 1 || !strings.Contains(driver.infoMessages[0], "exceeds layout columns") {
+		t.Fatalf("expected validation message, got %v", driver.infoMessages)
+	}
+}
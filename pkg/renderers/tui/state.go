@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -10,8 +11,10 @@ import (
 // paths. It is intentionally small; higher-level orchestration lives in the
 // renderer.
 type State struct {
-	values map[string]any
-	errors map[string][]string
+	values  map[string]any
+	errors  map[string][]string
+	issues  map[string][]string
+	skipped []string
 }
 
 // NewState seeds the state with prefilled values and errors.
@@ -66,6 +69,40 @@ func (s *State) SetValue(path string, value any) error {
 	return setPath(s.values, path, value)
 }
 
+// Issues returns the validation messages reported while prompting, keyed by
+// dotted path in the order they occurred.
+func (s *State) Issues() map[string][]string {
+	if s == nil {
+		return nil
+	}
+	return s.issues
+}
+
+// Skipped lists the optional paths the user left empty or declined.
+func (s *State) Skipped() []string {
+	if s == nil {
+		return nil
+	}
+	return s.skipped
+}
+
+func (s *State) recordIssue(path, message string) {
+	if s == nil {
+		return
+	}
+	if s.issues == nil {
+		s.issues = make(map[string][]string)
+	}
+	s.issues[path] = append(s.issues[path], message)
+}
+
+func (s *State) markSkipped(path string) {
+	if s == nil || slices.Contains(s.skipped, path) {
+		return
+	}
+	s.skipped = append(s.skipped, path)
+}
+
 func cloneValues(src map[string]any) map[string]any {
 	if len(src) == 0 {
 		return make(map[string]any)
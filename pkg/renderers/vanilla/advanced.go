@@ -0,0 +1,58 @@
+package vanilla
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	advancedAttributes  = ` data-formgen-advanced hidden`
+	advancedShowLabel   = "Show advanced options"
+	advancedHideLabel   = "Hide advanced options"
+	advancedBehaviorKey = "advancedToggle"
+)
+
+// markAdvancedField flags a rendered layout field carrying the advanced hint
+// and hides its wrapper until the advanced toggle reveals it.
+func markAdvancedField(field model.Field, item renderedField) renderedField {
+	if !truthySectionHint(field.UIHints, model.AdvancedHintKey) {
+		return item
+	}
+	item.Advanced = true
+	item.Style += advancedAttributes
+	return item
+}
+
+// buildAdvancedToggle returns the toggle data when the layout holds advanced
+// fields or sections, or nil otherwise. The count covers advanced fields plus
+// every field inside an advanced section.
+func buildAdvancedToggle(layout layoutContext) map[string]any {
+	count := 0
+	for _, field := range layout.Unsectioned {
+		if field.Advanced {
+			count++
+		}
+	}
+	for _, section := range layout.Sections {
+		for _, field := range section.Fields {
+			if section.Advanced || field.Advanced {
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	config, _ := json.Marshal(map[string]string{
+		"showLabel": advancedShowLabel,
+		"hideLabel": advancedHideLabel,
+	})
+	return map[string]any{
+		"count":    strconv.Itoa(count),
+		"label":    advancedShowLabel,
+		"behavior": advancedBehaviorKey,
+		"config":   string(config),
+	}
+}
@@ -0,0 +1,48 @@
+package vanilla
+
+import (
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const defaultHoneypotLabel = "Leave this field empty"
+
+// buildAntiSpamContext exposes RenderOptions.AntiSpam to the form template.
+// It returns nil when no protection is configured.
+func buildAntiSpamContext(options *render.AntiSpam, operationID string) map[string]any {
+	if options == nil {
+		return nil
+	}
+	ctx := map[string]any{}
+	if name := options.HoneypotField(); name != "" {
+		label := strings.TrimSpace(options.HoneypotLabel)
+		if label == "" {
+			label = defaultHoneypotLabel
+		}
+		ctx["honeypot"] = map[string]any{
+			"name":  name,
+			"id":    "fg-hp-" + sanitizeControlPath(operationID+"-"+name),
+			"label": label,
+		}
+	}
+	if token := strings.TrimSpace(options.SubmitToken); token != "" {
+		ctx["token"] = map[string]any{
+			"name":  options.TokenField(),
+			"value": token,
+		}
+	}
+	if captcha := options.Captcha; captcha != nil && strings.TrimSpace(captcha.Provider) != "" {
+		ctx["captcha"] = map[string]any{
+			"attribute":      render.CaptchaSlotAttribute,
+			"provider":       strings.TrimSpace(captcha.Provider),
+			"site_key":       strings.TrimSpace(captcha.SiteKey),
+			"action":         strings.TrimSpace(captcha.Action),
+			"response_field": strings.TrimSpace(captcha.ResponseField),
+		}
+	}
+	if len(ctx) == 0 {
+		return nil
+	}
+	return ctx
+}
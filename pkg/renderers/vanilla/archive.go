@@ -0,0 +1,140 @@
+package vanilla
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla/components"
+)
+
+const (
+	actionTypeArchive     = "archive"
+	defaultArchiveConfirm = "Archive this record?"
+)
+
+// prepareArchiveAction fills archive defaults: a confirmation prompt, the
+// DELETE method, and the danger kind so preview mode disables the button.
+func prepareArchiveAction(action *actionButton) {
+	action.Method = strings.ToUpper(strings.TrimSpace(action.Method))
+	if action.Method == "" {
+		action.Method = "DELETE"
+	}
+	if strings.TrimSpace(action.Confirm) == "" {
+		action.Confirm = defaultArchiveConfirm
+	}
+	if strings.TrimSpace(action.Kind) == "" {
+		action.Kind = "danger"
+	}
+	action.PayloadJSON = ""
+	if len(action.Payload) > 0 {
+		if payload, err := json.Marshal(action.Payload); err == nil {
+			action.PayloadJSON = string(payload)
+		}
+	}
+	if strings.TrimSpace(action.Endpoint) == "" {
+		// Without an endpoint the runtime has nothing to call.
+		action.Disabled = true
+	}
+}
+
+func hasArchiveAction(actions []actionButton) bool {
+	for _, action := range actions {
+		if action.Type == actionTypeArchive {
+			return true
+		}
+	}
+	return false
+}
+
+func archiveScript() components.Script {
+	return components.Script{Inline: archiveInlineScript, Defer: true}
+}
+
+// archiveInlineScript handles clicks on [data-formgen-action="archive"]
+// buttons. After the user confirms, it substitutes {param} placeholders in the
+// endpoint with the form's field values, sends the request (forwarding the
+// _csrf field as X-CSRF-Token), and dispatches a cancelable
+// formgen:archive-success event on the form. Unless a listener cancels it, the
+// configured redirect is followed. Failures dispatch formgen:archive-error.
+const archiveInlineScript = `(function () {
+  var BUTTON = '[data-formgen-action="archive"]';
+
+  function fieldValue(root, name) {
+    var el = root.querySelector('[name="' + (window.CSS && CSS.escape ? CSS.escape(name) : name) + '"]');
+    return el ? el.value : "";
+  }
+
+  function endpoint(root, template) {
+    var missing = false;
+    var url = template.replace(/\{([^}]+)\}/g, function (_, name) {
+      var value = fieldValue(root, name);
+      if (!value) missing = true;
+      return encodeURIComponent(value);
+    });
+    return missing ? "" : url;
+  }
+
+  function emit(root, name, detail, cancelable) {
+    var event = new CustomEvent(name, { bubbles: true, cancelable: !!cancelable, detail: detail });
+    return root.dispatchEvent(event);
+  }
+
+  function archive(button) {
+    var root = button.closest("form, [data-formgen-auto-init]") || document.body;
+    var message = button.getAttribute("data-archive-confirm");
+    if (message && !window.confirm(message)) return;
+    var method = button.getAttribute("data-archive-method") || "DELETE";
+    var url = endpoint(root, button.getAttribute("data-archive-endpoint") || "");
+    var detail = { method: method, endpoint: url };
+    if (!url) {
+      emit(root, "formgen:archive-error", Object.assign(detail, { status: 0, error: "archive endpoint could not be resolved" }));
+      return;
+    }
+    var headers = { Accept: "application/json" };
+    var csrf = fieldValue(root, "_csrf");
+    if (csrf) headers["X-CSRF-Token"] = csrf;
+    var init = { method: method, headers: headers, credentials: "same-origin" };
+    var payload = button.getAttribute("data-archive-payload");
+    if (payload) {
+      headers["Content-Type"] = "application/json";
+      init.body = payload;
+    }
+    button.disabled = true;
+    button.setAttribute("aria-busy", "true");
+    fetch(url, init)
+      .then(function (response) {
+        return response.text().then(function (text) {
+          var body = null;
+          try { body = text ? JSON.parse(text) : null; } catch (e) { body = text; }
+          if (!response.ok) {
+            var error = new Error("archive failed: " + response.status);
+            error.status = response.status;
+            error.body = body;
+            throw error;
+          }
+          return { status: response.status, body: body };
+        });
+      })
+      .then(function (result) {
+        button.removeAttribute("aria-busy");
+        root.setAttribute("data-formgen-archived", "true");
+        var proceed = emit(root, "formgen:archive-success", Object.assign(detail, { status: result.status, response: result.body }), true);
+        var redirect = button.getAttribute("data-archive-redirect");
+        if (proceed && redirect) window.location.assign(redirect);
+      })
+      .catch(function (error) {
+        button.disabled = false;
+        button.removeAttribute("aria-busy");
+        emit(root, "formgen:archive-error", Object.assign(detail, { status: error.status || 0, response: error.body, error: String(error) }));
+      });
+  }
+
+  if (typeof document === "undefined" || window.__formgenArchiveBound) return;
+  window.__formgenArchiveBound = true;
+  document.addEventListener("click", function (event) {
+    var button = event.target && event.target.closest ? event.target.closest(BUTTON) : null;
+    if (!button || button.disabled) return;
+    event.preventDefault();
+    archive(button);
+  });
+})();`
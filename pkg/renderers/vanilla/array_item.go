@@ -0,0 +1,116 @@
+package vanilla
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla/components"
+)
+
+const (
+	// ArrayItemPathParam carries the dotted path of the array field.
+	ArrayItemPathParam = "path"
+	// ArrayItemIndexParam carries the zero-based index of the row to render.
+	ArrayItemIndexParam = "index"
+	// ArrayItemValueParam optionally carries a JSON encoded row value.
+	ArrayItemValueParam = "value"
+)
+
+// RenderArrayItem renders one repeater row for the array field at fieldPath
+// (e.g. "contributors" or "author.links") using the supplied index. Control
+// names and ids match the rows the renderer emits for prefilled values, so the
+// fragment can be appended by htmx/ajax "add item" flows instead of relying on
+// client-side prototype cloning. A nil value renders an empty row. Like
+// Render, it stops with ctx.Err() once ctx is cancelled.
+func (r *Renderer) RenderArrayItem(ctx context.Context, form model.FormModel, fieldPath string, index int, value any) ([]byte, error) {
+	if r.templates == nil {
+		return nil, fmt.Errorf("vanilla renderer: template renderer is nil")
+	}
+	if err := contextErr(ctx); err != nil {
+		return nil, err
+	}
+	fieldPath = strings.TrimSpace(fieldPath)
+	if fieldPath == "" {
+		return nil, errors.New("vanilla renderer: array item path is required")
+	}
+
+	if r.clientValidation {
+		form.Fields = applyClientValidation(form.Fields)
+	}
+	decorated := decorateFormModel(form)
+	field, ok := model.FieldByPath(decorated.Fields, fieldPath)
+	if !ok {
+		return nil, fmt.Errorf("vanilla renderer: array field %q not found", fieldPath)
+	}
+	if field.Type != model.FieldTypeArray || field.Items == nil {
+		return nil, fmt.Errorf("vanilla renderer: field %q is not an array with item definition", fieldPath)
+	}
+	field = applyRenderPathMetadata(field, fieldPath)
+
+	renderer := newComponentRenderer(r.templates, r.components, r.overrides, rendererTheme{}, nil)
+	renderer.ctx = ctx
+	data := components.ComponentData{
+		Template:    r.templates,
+		RenderChild: renderer.childRenderer(fieldPath),
+		StyleMode:   string(renderer.styleMode),
+	}
+
+	var buf bytes.Buffer
+	err := components.RenderArrayItem(&buf, field, data, index, value)
+	if ctxErr := contextErr(ctx); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("vanilla renderer: render array item %q: %w", fieldPath, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ArrayItemHandler serves repeater row fragments. The form model is resolved
+// per request, the array path and row index are read from the "path" and
+// "index" query parameters, and an optional "value" parameter carries a JSON
+// encoded row value used to prefill the fragment. Resolver and render failures
+// are logged with slog and answered with a generic status body.
+func ArrayItemHandler(renderer *Renderer, resolve func(*http.Request) (model.FormModel, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if renderer == nil || resolve == nil {
+			http.Error(w, "array item renderer not configured", http.StatusInternalServerError)
+			return
+		}
+		query := req.URL.Query()
+		index, err := strconv.Atoi(strings.TrimSpace(query.Get(ArrayItemIndexParam)))
+		if err != nil || index < 0 {
+			http.Error(w, "invalid array item index", http.StatusBadRequest)
+			return
+		}
+		var value any
+		if raw := strings.TrimSpace(query.Get(ArrayItemValueParam)); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &value); err != nil {
+				http.Error(w, "invalid array item value", http.StatusBadRequest)
+				return
+			}
+		}
+		form, err := resolve(req)
+		if err != nil {
+			slog.ErrorContext(req.Context(), "vanilla renderer: resolve array item form", slog.Any("error", err))
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		output, err := renderer.RenderArrayItem(req.Context(), form, query.Get(ArrayItemPathParam), index, value)
+		if err != nil {
+			slog.ErrorContext(req.Context(), "vanilla renderer: render array item", slog.String("path", query.Get(ArrayItemPathParam)), slog.Any("error", err))
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", renderer.ContentType())
+		_, _ = w.Write(output)
+	})
+}
@@ -0,0 +1,137 @@
+package vanilla_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func arrayItemForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "arrays",
+		Endpoint:    "/arrays",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "title", Type: model.FieldTypeString},
+			{
+				Name:    "contributors",
+				Type:    model.FieldTypeArray,
+				Label:   "Contributors",
+				UIHints: map[string]string{"cardinality": "many"},
+				Items: &model.Field{
+					Type: model.FieldTypeObject,
+					Nested: []model.Field{
+						{Name: "name", Type: model.FieldTypeString, Label: "Name", Required: true},
+						{Name: "role", Type: model.FieldTypeString, Label: "Role"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderer_RenderArrayItem(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	output, err := renderer.RenderArrayItem(testsupport.Context(), arrayItemForm(), "contributors", 3, map[string]any{"name": "Ada"})
+	if err != nil {
+		t.Fatalf("render array item: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`id="fg-contributors-3-name"`,
+		`name="contributors[3].name"`,
+		`name="contributors[3].role"`,
+		`value="Ada"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected array item to contain %s:\n%s", want, html)
+		}
+	}
+	for _, unwanted := range []string{"<form", "<template", `name="title"`} {
+		if strings.Contains(html, unwanted) {
+			t.Fatalf("expected array item to omit %s:\n%s", unwanted, html)
+		}
+	}
+}
+
+func TestRenderer_RenderArrayItemRejectsInvalidTargets(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	ctx := testsupport.Context()
+	form := arrayItemForm()
+
+	if _, err := renderer.RenderArrayItem(ctx, form, "missing", 0, nil); err == nil {
+		t.Fatalf("expected error for unknown path")
+	}
+	if _, err := renderer.RenderArrayItem(ctx, form, "title", 0, nil); err == nil {
+		t.Fatalf("expected error for non-array field")
+	}
+	if _, err := renderer.RenderArrayItem(ctx, form, "contributors", -1, nil); err == nil {
+		t.Fatalf("expected error for negative index")
+	}
+}
+
+func TestArrayItemHandler(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	handler := vanilla.ArrayItemHandler(renderer, func(*http.Request) (model.FormModel, error) {
+		return arrayItemForm(), nil
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/formgen/array-item?path=contributors&index=1", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `name="contributors[1].name"`) {
+		t.Fatalf("expected indexed control name, got:\n%s", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/formgen/array-item?path=contributors&index=x", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid index, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/formgen/array-item?path=%3Cscript%3E&index=0", nil))
+	if rec.Code != http.StatusBadRequest || strings.Contains(rec.Body.String(), "script") {
+		t.Fatalf("expected generic 400 body, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	failing := vanilla.ArrayItemHandler(renderer, func(*http.Request) (model.FormModel, error) {
+		return model.FormModel{}, errors.New("lookup secret-table failed")
+	})
+	rec = httptest.NewRecorder()
+	failing.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/formgen/array-item?path=contributors&index=0", nil))
+	if rec.Code != http.StatusNotFound || strings.Contains(rec.Body.String(), "secret-table") {
+		t.Fatalf("expected generic 404 body, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestRenderer_RenderArrayItemHonorsContext(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := renderer.RenderArrayItem(ctx, arrayItemForm(), "contributors", 0, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -4,22 +4,24 @@ package vanilla
 type ChromeClass string
 
 const (
-	ClassForm     ChromeClass = "formgen-form"
-	ClassHeader   ChromeClass = "formgen-header"
-	ClassSection  ChromeClass = "formgen-section"
-	ClassFieldset ChromeClass = "formgen-fieldset"
-	ClassActions  ChromeClass = "formgen-actions"
-	ClassErrors   ChromeClass = "formgen-errors"
-	ClassGrid     ChromeClass = "formgen-grid"
+	ClassForm        ChromeClass = "formgen-form"
+	ClassHeader      ChromeClass = "formgen-header"
+	ClassSection     ChromeClass = "formgen-section"
+	ClassFieldset    ChromeClass = "formgen-fieldset"
+	ClassActions     ChromeClass = "formgen-actions"
+	ClassErrors      ChromeClass = "formgen-errors"
+	ClassGrid        ChromeClass = "formgen-grid"
+	ClassBreadcrumbs ChromeClass = "formgen-breadcrumbs"
 )
 
 // Default*Class values are applied when RenderOptions.ChromeClasses overrides are empty.
 const (
-	DefaultFormClass     = string(ClassForm)
-	DefaultHeaderClass   = string(ClassHeader)
-	DefaultSectionClass  = string(ClassSection)
-	DefaultFieldsetClass = string(ClassFieldset)
-	DefaultActionsClass  = string(ClassActions)
-	DefaultErrorsClass   = string(ClassErrors)
-	DefaultGridClass     = string(ClassGrid)
+	DefaultFormClass        = string(ClassForm)
+	DefaultHeaderClass      = string(ClassHeader)
+	DefaultSectionClass     = string(ClassSection)
+	DefaultFieldsetClass    = string(ClassFieldset)
+	DefaultActionsClass     = string(ClassActions)
+	DefaultErrorsClass      = string(ClassErrors)
+	DefaultGridClass        = string(ClassGrid)
+	DefaultBreadcrumbsClass = string(ClassBreadcrumbs)
 )
@@ -0,0 +1,131 @@
+package vanilla
+
+import (
+	"encoding/json"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla/components"
+)
+
+const (
+	// clientValidateMetadataKey carries the data-validate payload from the
+	// decorator to buildDataAttributes.
+	clientValidateMetadataKey = "__validate"
+	clientValidateScript      = "/runtime/formgen-validate.min.js"
+)
+
+// WithClientValidation emits a data-validate JSON payload (required, min/max,
+// length, item count, pattern) on every control and loads
+// formgen-validate.min.js, which validates on blur and submit and renders
+// inline errors. HTML5 constraint attributes are still emitted.
+func WithClientValidation() Option {
+	return func(cfg *config) {
+		cfg.clientValidation = true
+	}
+}
+
+// clientValidateRules is the data-validate payload consumed by the validate
+// runtime (client/src/validate).
+type clientValidateRules struct {
+	Label        string   `json:"label,omitempty"`
+	Required     bool     `json:"required,omitempty"`
+	Min          *float64 `json:"min,omitempty"`
+	ExclusiveMin bool     `json:"exclusiveMin,omitempty"`
+	Max          *float64 `json:"max,omitempty"`
+	ExclusiveMax bool     `json:"exclusiveMax,omitempty"`
+	MinLength    *int     `json:"minLength,omitempty"`
+	MaxLength    *int     `json:"maxLength,omitempty"`
+	MinItems     *int     `json:"minItems,omitempty"`
+	MaxItems     *int     `json:"maxItems,omitempty"`
+	Pattern      string   `json:"pattern,omitempty"`
+}
+
+func (r clientValidateRules) empty() bool {
+	return !r.Required && r.Min == nil && r.Max == nil && r.MinLength == nil &&
+		r.MaxLength == nil && r.MinItems == nil && r.MaxItems == nil && r.Pattern == ""
+}
+
+// applyClientValidation attaches the data-validate payload to fields that
+// declare at least one constraint. Field metadata maps are copied before they
+// are modified.
+func applyClientValidation(fields []model.Field) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	out := make([]model.Field, len(fields))
+	for i, field := range fields {
+		if payload := clientValidatePayload(field); payload != "" {
+			metadata := make(map[string]string, len(field.Metadata)+1)
+			maps.Copy(metadata, field.Metadata)
+			metadata[clientValidateMetadataKey] = payload
+			field.Metadata = metadata
+		}
+		if field.Items != nil {
+			items := applyClientValidation([]model.Field{*field.Items})
+			field.Items = &items[0]
+		}
+		if len(field.Nested) > 0 {
+			field.Nested = applyClientValidation(field.Nested)
+		}
+		out[i] = field
+	}
+	return out
+}
+
+func clientValidatePayload(field model.Field) string {
+	rules := clientValidateRules{Required: field.Required}
+	for _, rule := range field.Validations {
+		value := strings.TrimSpace(rule.Params["value"])
+		exclusive := rule.Params["exclusive"] == "true"
+		switch rule.Kind {
+		case model.ValidationRuleMin:
+			rules.Min = parseRuleFloat(value)
+			rules.ExclusiveMin = rules.Min != nil && exclusive
+		case model.ValidationRuleMax:
+			rules.Max = parseRuleFloat(value)
+			rules.ExclusiveMax = rules.Max != nil && exclusive
+		case model.ValidationRuleMinLength:
+			rules.MinLength = parseRuleInt(value)
+		case model.ValidationRuleMaxLength:
+			rules.MaxLength = parseRuleInt(value)
+		case model.ValidationRuleMinItems:
+			rules.MinItems = parseRuleInt(value)
+		case model.ValidationRuleMaxItems:
+			rules.MaxItems = parseRuleInt(value)
+		case model.ValidationRulePattern:
+			rules.Pattern = strings.TrimSpace(rule.Params["pattern"])
+		}
+	}
+	if rules.empty() {
+		return ""
+	}
+	rules.Label = strings.TrimSpace(field.Label)
+	payload, err := json.Marshal(rules)
+	if err != nil {
+		return ""
+	}
+	return string(payload)
+}
+
+func parseRuleFloat(value string) *float64 {
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+func parseRuleInt(value string) *int {
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+func clientValidationScript() components.Script {
+	return components.Script{Src: clientValidateScript, Defer: true}
+}
@@ -0,0 +1,81 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const booleanTemplatePartialKey = "forms.checkbox"
+
+// Boolean presentation styles selected with the booleanStyle UI hint.
+const (
+	BooleanStyleCheckbox  = "checkbox"
+	BooleanStyleSwitch    = "switch"
+	BooleanStyleSegmented = "segmented"
+)
+
+// booleanPresentation resolves the boolean template payload: the style, the
+// current state ("true", "false", or "" when unset), and the on/off/unset
+// labels taken from the trueLabel, falseLabel, and nullLabel UI hints.
+// Nullable fields rendered as segmented controls gain a third "unset" choice.
+func booleanPresentation(field model.Field) map[string]any {
+	style := BooleanStyle(field)
+	tristate := style == BooleanStyleSegmented && field.Nullable
+	trueLabel := hintOr(field, "trueLabel", "Yes")
+	falseLabel := hintOr(field, "falseLabel", "No")
+
+	choices := []map[string]string{
+		{"key": "true", "value": "true", "label": trueLabel},
+		{"key": "false", "value": "false", "label": falseLabel},
+	}
+	if tristate {
+		choices = append(choices, map[string]string{"key": "null", "value": "", "label": hintOr(field, "nullLabel", "Not set")})
+	}
+
+	return map[string]any{
+		"style":       style,
+		"state":       booleanState(field.Default),
+		"tristate":    tristate,
+		"true_label":  trueLabel,
+		"false_label": falseLabel,
+		"choices":     choices,
+	}
+}
+
+// BooleanStyle returns the presentation style requested by the field's
+// booleanStyle UI hint, defaulting to BooleanStyleCheckbox.
+func BooleanStyle(field model.Field) string {
+	style := strings.ToLower(strings.TrimSpace(field.UIHints["booleanStyle"]))
+	switch style {
+	case BooleanStyleSwitch, BooleanStyleSegmented:
+		return style
+	default:
+		return BooleanStyleCheckbox
+	}
+}
+
+func booleanState(value any) string {
+	switch typed := value.(type) {
+	case bool:
+		if typed {
+			return "true"
+		}
+		return "false"
+	case string:
+		switch strings.ToLower(strings.TrimSpace(typed)) {
+		case "true", "1", "on", "yes":
+			return "true"
+		case "false", "0", "off", "no":
+			return "false"
+		}
+	}
+	return ""
+}
+
+func hintOr(field model.Field, key, fallback string) string {
+	if value := strings.TrimSpace(field.UIHints[key]); value != "" {
+		return value
+	}
+	return fallback
+}
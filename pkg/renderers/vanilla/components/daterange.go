@@ -0,0 +1,138 @@
+package components
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	dateRangeTemplatePartialKey = "forms.daterange"
+	durationTemplatePartialKey  = "forms.duration"
+
+	defaultDurationParts = "days,hours,minutes"
+)
+
+// dateRangePresentation resolves the start and end inputs of a daterange
+// field. Bounds come from nested "start"/"end" fields when declared (falling
+// back to the first two nested fields), so labels, required flags, and
+// prefilled defaults follow the schema; values submit as <name>.start and
+// <name>.end.
+func dateRangePresentation(field model.Field) map[string]any {
+	start, end := dateRangeBounds(field)
+	name := componentControlName(field)
+	id := componentControlID(field)
+	defaults, _ := field.Default.(map[string]any)
+
+	bound := func(bound model.Field, key, fallbackLabel string) map[string]any {
+		if bound.Name != "" {
+			key = bound.Name
+		}
+		label := strings.TrimSpace(bound.Label)
+		if label == "" {
+			label = fallbackLabel
+		}
+		value := bound.Default
+		if value == nil {
+			value = defaults[key]
+		}
+		return map[string]any{
+			"key":      key,
+			"name":     name + "." + key,
+			"id":       id + "-" + key,
+			"label":    label,
+			"value":    dateInputValue(value),
+			"required": bound.Required || field.Required,
+		}
+	}
+	startBound, endBound := bound(start, "start", "Start"), bound(end, "end", "End")
+	// Link the inputs so browsers block an end date before the start date.
+	startBound["role"], startBound["max"] = "start", endBound["value"]
+	endBound["role"], endBound["min"] = "end", startBound["value"]
+	return map[string]any{
+		"bounds": []map[string]any{startBound, endBound},
+	}
+}
+
+func dateRangeBounds(field model.Field) (model.Field, model.Field) {
+	var start, end model.Field
+	for _, nested := range field.Nested {
+		switch strings.ToLower(nested.Name) {
+		case "start", "from":
+			start = nested
+		case "end", "to", "until":
+			end = nested
+		}
+	}
+	if start.Name == "" && end.Name == "" && len(field.Nested) >= 2 {
+		start, end = field.Nested[0], field.Nested[1]
+	}
+	return start, end
+}
+
+// dateInputValue trims date-time values to the YYYY-MM-DD form accepted by
+// date inputs.
+func dateInputValue(value any) string {
+	text, ok := formatControlValue(value)
+	if !ok {
+		return ""
+	}
+	text = strings.TrimSpace(text)
+	if len(text) > 10 && text[10] == 'T' {
+		return text[:10]
+	}
+	return text
+}
+
+// durationPresentation splits an ISO-8601 duration default into the number
+// inputs named by the durationParts hint (days, hours, and minutes by
+// default). Components present in the value but missing from the hint are
+// shown too so editing never drops them.
+func durationPresentation(field model.Field) map[string]any {
+	raw, _ := formatControlValue(field.Default)
+	raw = strings.TrimSpace(raw)
+	duration, err := model.ParseISODuration(raw)
+	valid := raw != "" && err == nil
+
+	requested := map[string]bool{}
+	for _, part := range strings.Split(hintOr(field, "durationParts", defaultDurationParts), ",") {
+		requested[strings.ToLower(strings.TrimSpace(part))] = true
+	}
+
+	id := componentControlID(field)
+	parts := make([]map[string]string, 0, len(model.DurationParts))
+	for _, part := range model.DurationParts {
+		amount := duration.Part(part)
+		if !requested[part] && amount == 0 {
+			continue
+		}
+		value := ""
+		if valid {
+			value = strconv.Itoa(amount)
+		}
+		parts = append(parts, map[string]string{
+			"key":   part,
+			"id":    fmt.Sprintf("%s-%s", id, part),
+			"label": strings.ToUpper(part[:1]) + part[1:],
+			"value": value,
+		})
+	}
+	if !valid {
+		raw = ""
+	}
+	return map[string]any{
+		"value": raw,
+		"parts": parts,
+	}
+}
+
+func componentControlName(field model.Field) string {
+	if field.Metadata != nil {
+		if name := strings.TrimSpace(field.Metadata[controlNameMetadataKey]); name != "" {
+			return name
+		}
+	}
+	return strings.TrimSpace(field.Name)
+}
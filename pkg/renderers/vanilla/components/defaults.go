@@ -19,7 +19,11 @@ const (
 	templatePrefix          = "templates/components/"
 	inputTemplatePartialKey = "forms.input"
 	runtimeScript           = "/runtime/formgen-relationships.min.js"
-	runtimeInit             = `(function(){function init(){var api=window.FormgenRelationships;if(api&&typeof api.initRelationships==="function"){try{api.initRelationships();}catch(e){}}}if(typeof document==="undefined"){return;}if(document.readyState==="loading"){window.addEventListener("DOMContentLoaded",init);}else{init();}})();`
+	styleModeUnstyled       = "unstyled"
+	layoutGridColumnsHint   = "layout.gridColumns"
+	// defaultNestedGridColumns matches the form grid default.
+	defaultNestedGridColumns = 12
+	runtimeInit              = `(function(){function init(){var api=window.FormgenRelationships;if(api&&typeof api.initRelationships==="function"){try{api.initRelationships();}catch(e){}}}if(typeof document==="undefined"){return;}if(document.readyState==="loading"){window.addEventListener("DOMContentLoaded",init);}else{init();}})();`
 )
 
 // NewDefaultRegistry constructs a registry pre-populated with the built-in
@@ -28,20 +32,27 @@ func NewDefaultRegistry() *Registry {
 	registry := New()
 
 	registry.MustRegister(NameInput, Descriptor{
-		Renderer: templateComponentRenderer(inputTemplatePartialKey, templatePrefix+"input.tmpl"),
+		Renderer: templateBindingRenderer(NameInput),
 	})
 	registry.MustRegister(NameTextarea, Descriptor{
-		Renderer: templateComponentRenderer("forms.textarea", templatePrefix+"textarea.tmpl"),
+		Renderer: templateBindingRenderer(NameTextarea),
 	})
 	registry.MustRegister(NameSelect, Descriptor{
-		Renderer: templateComponentRenderer("forms.select", templatePrefix+"select.tmpl"),
+		Renderer: templateBindingRenderer(NameSelect),
 	})
 	registry.MustRegister(NameBoolean, Descriptor{
-		Renderer: templateComponentRenderer("forms.checkbox", templatePrefix+"boolean.tmpl"),
+		Renderer: templateBindingRenderer(NameBoolean),
+	})
+	registry.MustRegister(NameRadioGroup, Descriptor{
+		Renderer: templateBindingRenderer(NameRadioGroup),
+	})
+	registry.MustRegister(NameCheckboxGroup, Descriptor{
+		Renderer: templateBindingRenderer(NameCheckboxGroup),
 	})
 	registry.MustRegister(NameObject, Descriptor{
 		Renderer: objectRenderer,
 	})
+	registry.MustRegister(NameSubform, subformDescriptor())
 	registry.MustRegister(NameArray, Descriptor{
 		Renderer: arrayRenderer,
 		Scripts: []Script{
@@ -52,22 +63,43 @@ func NewDefaultRegistry() *Registry {
 	registry.MustRegister(NameDatetimeRange, Descriptor{
 		Renderer: datetimeRangeRenderer,
 	})
+	registry.MustRegister(NameDateRange, Descriptor{
+		Renderer: templateBindingRenderer(NameDateRange),
+		Scripts: []Script{
+			{Src: runtimeScript, Defer: true},
+			{Inline: runtimeInit},
+		},
+	})
+	registry.MustRegister(NameDuration, Descriptor{
+		Renderer: templateBindingRenderer(NameDuration),
+		Scripts: []Script{
+			{Src: runtimeScript, Defer: true},
+			{Inline: runtimeInit},
+		},
+	})
+	registry.MustRegister(NameTimezone, Descriptor{
+		Renderer: templateBindingRenderer(NameTimezone),
+		Scripts: []Script{
+			{Src: runtimeScript, Defer: true},
+			{Inline: runtimeInit},
+		},
+	})
 	registry.MustRegister(NameMediaPicker, Descriptor{
-		Renderer: templateComponentRenderer("forms.media-picker", templatePrefix+"media_picker.tmpl"),
+		Renderer: templateBindingRenderer(NameMediaPicker),
 		Scripts: []Script{
 			{Src: runtimeScript, Defer: true},
 			{Inline: runtimeInit},
 		},
 	})
 	registry.MustRegister(NameWysiwyg, Descriptor{
-		Renderer: templateComponentRenderer("forms.wysiwyg", templatePrefix+"wysiwyg.tmpl"),
+		Renderer: templateBindingRenderer(NameWysiwyg),
 		Scripts: []Script{
 			{Src: runtimeScript, Defer: true},
 			{Inline: runtimeInit},
 		},
 	})
 	registry.MustRegister(NameFileUploader, Descriptor{
-		Renderer: templateComponentRenderer("forms.file-uploader", templatePrefix+"file_uploader.tmpl"),
+		Renderer: templateBindingRenderer(NameFileUploader),
 		Scripts: []Script{
 			{Src: runtimeScript, Defer: true},
 			{Inline: runtimeInit},
@@ -78,6 +110,17 @@ func NewDefaultRegistry() *Registry {
 	return registry
 }
 
+// RuntimeScripts returns the runtime bundle script and its initializer so
+// renderer features outside components can load the bundle the same way.
+func RuntimeScripts() []Script {
+	return []Script{
+		{Src: runtimeScript, Defer: true},
+		{Inline: runtimeInit},
+	}
+}
+
+var gridPlacementHints = []string{"layout.span", "layout.start", "layout.row"}
+
 func templateComponentRenderer(partialKey, templateName string) Renderer {
 	return func(buf *bytes.Buffer, field model.Field, data ComponentData) error {
 		if data.Template == nil {
@@ -104,6 +147,25 @@ func templateComponentRenderer(partialKey, templateName string) Renderer {
 			"control_value": controlValue,
 			"has_value":     hasValue,
 		}
+		if partialKey == booleanTemplatePartialKey {
+			payload["boolean"] = booleanPresentation(field)
+		}
+		switch partialKey {
+		case dateRangeTemplatePartialKey:
+			payload["range"] = dateRangePresentation(field)
+		case durationTemplatePartialKey:
+			payload["duration"] = durationPresentation(field)
+		case timezoneTemplatePartialKey:
+			payload["enum_options"] = timezoneOptions(field)
+		}
+		if partialKey == inputTemplatePartialKey {
+			if number := numberPresentation(field); number != nil {
+				payload["number"] = number
+				if formatted, ok := number["value"].(string); ok && hasValue {
+					payload["control_value"] = formatted
+				}
+			}
+		}
 		rendered, err := data.Template.RenderTemplate(resolvedTemplate, payload)
 		if err != nil {
 			return fmt.Errorf("components: render template %q: %w", templateName, err)
@@ -167,10 +229,16 @@ type enumOption struct {
 	Selected    bool
 }
 
+// enumOptions lists the field's static choices, falling back to the item
+// choices of array enums, with selection taken from the field default.
 func enumOptions(field model.Field) []enumOption {
-	if len(field.Options) > 0 {
-		out := make([]enumOption, 0, len(field.Options))
-		for _, option := range field.Options {
+	source := field
+	if field.Type == model.FieldTypeArray && field.Relationship == nil && field.Items != nil && len(field.Options) == 0 && len(field.Enum) == 0 {
+		source.Options, source.Enum = field.Items.Options, field.Items.Enum
+	}
+	if len(source.Options) > 0 {
+		out := make([]enumOption, 0, len(source.Options))
+		for _, option := range source.Options {
 			label := strings.TrimSpace(option.Label)
 			if label == "" {
 				label = fmt.Sprint(option.Value)
@@ -195,11 +263,11 @@ func enumOptions(field model.Field) []enumOption {
 	if field.Relationship != nil && len(field.Enum) == 0 {
 		return relationshipCurrentOptions(field)
 	}
-	if len(field.Enum) == 0 {
+	if len(source.Enum) == 0 {
 		return nil
 	}
-	out := make([]enumOption, 0, len(field.Enum))
-	for _, value := range field.Enum {
+	out := make([]enumOption, 0, len(source.Enum))
+	for _, value := range source.Enum {
 		out = append(out, enumOption{
 			Value:    submission.EncodeEnumControlValue(value),
 			Label:    fmt.Sprint(value),
@@ -354,6 +422,23 @@ func firstNonEmptyString(values ...string) string {
 
 func objectRenderer(buf *bytes.Buffer, field model.Field, data ComponentData) error {
 	var builder strings.Builder
+	if strings.TrimSpace(field.UIHints["flatten"]) == "true" {
+		// The enclosing section already renders the heading, so only the
+		// children are emitted.
+		builder.WriteString(`<div`)
+		if id := componentControlID(field); id != "" {
+			builder.WriteString(` id="`)
+			builder.WriteString(html.EscapeString(id))
+			builder.WriteString(`"`)
+		}
+		builder.WriteString(` data-formgen-flattened="true">`)
+		if err := writeObjectChildren(&builder, field, data); err != nil {
+			return err
+		}
+		builder.WriteString(`</div>`)
+		buf.WriteString(builder.String())
+		return nil
+	}
 	labelID := objectLabelID(field)
 
 	writeObjectStart(&builder, field, labelID)
@@ -437,7 +522,11 @@ func writeObjectCopy(builder *strings.Builder, field model.Field, labelID string
 }
 
 func writeObjectChildren(builder *strings.Builder, field model.Field, data ComponentData) error {
-	if data.RenderChild != nil {
+	if data.RenderChild == nil {
+		return nil
+	}
+	columns := nestedGridColumns(field)
+	if columns == 0 || data.GridItemAttrs == nil {
 		builder.WriteString(`<div class="space-y-4">`)
 		for _, nested := range field.Nested {
 			child, err := data.RenderChild(nested)
@@ -447,10 +536,57 @@ func writeObjectChildren(builder *strings.Builder, field model.Field, data Compo
 			builder.WriteString(child)
 		}
 		builder.WriteString(`</div>`)
+		return nil
 	}
+
+	// Nested grids mirror the form grid: the container fixes the column
+	// count and each child wrapper carries its own placement.
+	builder.WriteString(`<div`)
+	if data.StyleMode != styleModeUnstyled {
+		builder.WriteString(` class="formgen-grid" style="--formgen-grid-gap: 1rem; grid-template-columns: repeat(`)
+		builder.WriteString(strconv.Itoa(columns))
+		builder.WriteString(`, minmax(0, 1fr))"`)
+	}
+	builder.WriteString(` data-formgen-grid-columns="`)
+	builder.WriteString(strconv.Itoa(columns))
+	builder.WriteString(`">`)
+	for _, nested := range field.Nested {
+		child, err := data.RenderChild(nested)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(child) == "" {
+			continue
+		}
+		builder.WriteString(`<div`)
+		builder.WriteString(data.GridItemAttrs(nested, columns))
+		builder.WriteString(`>`)
+		builder.WriteString(child)
+		builder.WriteString(`</div>`)
+	}
+	builder.WriteString(`</div>`)
 	return nil
 }
 
+// nestedGridColumns returns the column count for an object's own grid: the
+// object's layout.gridColumns hint, or defaultNestedGridColumns when any child
+// carries a placement hint. Zero keeps the stacked layout.
+func nestedGridColumns(field model.Field) int {
+	if columns, err := strconv.Atoi(strings.TrimSpace(field.UIHints[layoutGridColumnsHint])); err == nil && columns > 0 {
+		return columns
+	}
+	for _, nested := range field.Nested {
+		for key := range nested.UIHints {
+			for _, prefix := range gridPlacementHints {
+				if key == prefix || strings.HasPrefix(key, prefix+".") {
+					return defaultNestedGridColumns
+				}
+			}
+		}
+	}
+	return 0
+}
+
 func arrayRenderer(buf *bytes.Buffer, field model.Field, data ComponentData) error {
 	var builder strings.Builder
 	label := strings.TrimSpace(field.Label)
@@ -535,6 +671,7 @@ func writeArrayItems(builder *strings.Builder, field model.Field, data Component
 	}
 	if repeatable {
 		writeArrayRepeaterAttributes(builder, field, len(itemValues))
+		writeArrayItemBoundAttributes(builder, field)
 	}
 	builder.WriteString(`>`)
 	if err := writeArrayItemFields(builder, field, data, itemValues, repeatable); err != nil {
@@ -547,7 +684,7 @@ func writeArrayItems(builder *strings.Builder, field model.Field, data Component
 	}
 	builder.WriteString(`</div>`)
 	if repeatable {
-		writeArrayAddButton(builder, field)
+		writeArrayAddButton(builder, field, len(itemValues))
 	}
 	return nil
 }
@@ -572,13 +709,47 @@ func writeArrayRepeaterAttributes(builder *strings.Builder, field model.Field, p
 	}
 }
 
+// writeArrayItemBoundAttributes exposes minItems/maxItems to the runtime,
+// which keeps the add and remove actions in sync as rows change.
+func writeArrayItemBoundAttributes(builder *strings.Builder, field model.Field) {
+	minItems, maxItems := arrayItemBounds(field)
+	if minItems > 0 {
+		builder.WriteString(` data-formgen-array-min-items="`)
+		builder.WriteString(strconv.Itoa(minItems))
+		builder.WriteString(`"`)
+	}
+	if maxItems > 0 {
+		builder.WriteString(` data-formgen-array-max-items="`)
+		builder.WriteString(strconv.Itoa(maxItems))
+		builder.WriteString(`"`)
+	}
+}
+
+// arrayItemBounds returns the field's minItems and maxItems validations. Zero
+// means the bound is not set.
+func arrayItemBounds(field model.Field) (minItems, maxItems int) {
+	for _, rule := range field.Validations {
+		value, err := strconv.Atoi(strings.TrimSpace(rule.Params["value"]))
+		if err != nil || value < 0 {
+			continue
+		}
+		switch rule.Kind {
+		case model.ValidationRuleMinItems:
+			minItems = value
+		case model.ValidationRuleMaxItems:
+			maxItems = value
+		}
+	}
+	return minItems, maxItems
+}
+
 func writeArrayPrototypeTemplate(builder *strings.Builder, field model.Field, data ComponentData, prototypeIndex int) error {
 	child, err := renderArrayTemplatePrototypeItem(field, data, prototypeIndex)
 	if err != nil {
 		return err
 	}
 	builder.WriteString(`<template data-formgen-array-prototype="true">`)
-	writeArrayItemFrame(builder, field, child, false, arrayItemControlPath(field, prototypeIndex), nil, prototypeIndex)
+	writeArrayItemFrame(builder, field, child, false, arrayItemControlPath(field, prototypeIndex), nil, prototypeIndex, false)
 	builder.WriteString(`</template>`)
 	return nil
 }
@@ -595,6 +766,8 @@ func writeArrayItemFields(builder *strings.Builder, field model.Field, data Comp
 		builder.WriteString(child)
 		return nil
 	}
+	minItems, _ := arrayItemBounds(field)
+	atMin := minItems > 0 && len(itemValues) <= minItems
 	for idx, value := range itemValues {
 		item := cloneField(*field.Items)
 		if path := arrayItemControlPath(field, idx); path != "" {
@@ -605,7 +778,7 @@ func writeArrayItemFields(builder *strings.Builder, field model.Field, data Comp
 			return err
 		}
 		if repeatable {
-			writeArrayItemFrame(builder, field, child, true, arrayItemControlPath(field, idx), value, idx)
+			writeArrayItemFrame(builder, field, child, true, arrayItemControlPath(field, idx), value, idx, atMin)
 		} else {
 			builder.WriteString(child)
 		}
@@ -630,8 +803,51 @@ func renderArrayTemplatePrototypeItem(field model.Field, data ComponentData, idx
 	return data.RenderChild(item)
 }
 
-func writeArrayAddButton(builder *strings.Builder, field model.Field) {
-	builder.WriteString(`<button type="button" class="py-3 px-4 inline-flex items-center gap-x-2 text-sm font-medium rounded-lg border border-gray-200 bg-white text-gray-800 shadow-sm hover:bg-gray-50 disabled:opacity-50 disabled:pointer-events-none dark:bg-slate-900 dark:border-gray-700 dark:text-white dark:hover:bg-gray-800" data-formgen-array-action="add" data-relationship-action="add">`)
+// RenderArrayItem writes a single repeater row for the array field at the
+// supplied index. The row matches what client-side prototype cloning would
+// produce (control names such as "tags[2].label") and is intended for servers
+// answering htmx/ajax "add item" requests. A nil value renders an empty row.
+func RenderArrayItem(buf *bytes.Buffer, field model.Field, data ComponentData, index int, value any) error {
+	if field.Items == nil {
+		return fmt.Errorf("components: array field %q requires item definition", field.Name)
+	}
+	if data.RenderChild == nil {
+		return fmt.Errorf("components: array field %q requires a child renderer", field.Name)
+	}
+	if index < 0 {
+		return fmt.Errorf("components: array item index %d is negative", index)
+	}
+	item := cloneField(*field.Items)
+	itemPath := arrayItemControlPath(field, index)
+	if itemPath != "" {
+		applyControlPath(&item, itemPath)
+	}
+	var payload any = item
+	if value != nil {
+		payload = WithFieldValue(item, value)
+	}
+	child, err := data.RenderChild(payload)
+	if err != nil {
+		return err
+	}
+	var builder strings.Builder
+	if arrayCardinality(field) == "many" {
+		writeArrayItemFrame(&builder, field, child, false, itemPath, value, index, false)
+	} else {
+		builder.WriteString(child)
+	}
+	buf.WriteString(builder.String())
+	return nil
+}
+
+// writeArrayAddButton renders the add action, disabled once count reaches
+// the field's maxItems.
+func writeArrayAddButton(builder *strings.Builder, field model.Field, count int) {
+	builder.WriteString(`<button type="button" class="py-3 px-4 inline-flex items-center gap-x-2 text-sm font-medium rounded-lg border border-gray-200 bg-white text-gray-800 shadow-sm hover:bg-gray-50 disabled:opacity-50 disabled:pointer-events-none dark:bg-slate-900 dark:border-gray-700 dark:text-white dark:hover:bg-gray-800" data-formgen-array-action="add" data-relationship-action="add"`)
+	if _, maxItems := arrayItemBounds(field); maxItems > 0 && count >= maxItems {
+		builder.WriteString(` disabled`)
+	}
+	builder.WriteString(`>`)
 	if label := strings.TrimSpace(field.UIHints["addText"]); label != "" {
 		builder.WriteString(html.EscapeString(label))
 	} else if label := strings.TrimSpace(field.UIHints["repeaterLabel"]); label != "" {
@@ -646,7 +862,9 @@ func writeArrayAddButton(builder *strings.Builder, field model.Field) {
 	builder.WriteString(`</button>`)
 }
 
-func writeArrayItemFrame(builder *strings.Builder, field model.Field, child string, existing bool, itemPath string, value any, index int) {
+// writeArrayItemFrame wraps a repeater row with its remove action. locked
+// disables removal, used while the row count is at the field's minItems.
+func writeArrayItemFrame(builder *strings.Builder, field model.Field, child string, existing bool, itemPath string, value any, index int, locked bool) {
 	if !arrayRemoveEnabled(field) {
 		builder.WriteString(child)
 		return
@@ -662,7 +880,7 @@ func writeArrayItemFrame(builder *strings.Builder, field model.Field, child stri
 		writeArrayRowIntentMarkers(builder, itemPath, existing, value, index)
 	}
 	builder.WriteString(child)
-	writeArrayRemoveButton(builder, field)
+	writeArrayRemoveButton(builder, field, locked)
 	builder.WriteString(`</div>`)
 }
 
@@ -714,8 +932,12 @@ func rowMapValue(value any, key string) string {
 	}
 }
 
-func writeArrayRemoveButton(builder *strings.Builder, field model.Field) {
-	builder.WriteString(`<button type="button" class="py-2 px-3 inline-flex items-center gap-x-2 text-xs font-medium rounded-lg border border-red-200 bg-white text-red-700 hover:bg-red-50 disabled:opacity-50 disabled:pointer-events-none dark:bg-slate-900 dark:border-red-800 dark:text-red-300 dark:hover:bg-red-950" data-formgen-array-action="remove" data-relationship-action="remove">`)
+func writeArrayRemoveButton(builder *strings.Builder, field model.Field, disabled bool) {
+	builder.WriteString(`<button type="button" class="py-2 px-3 inline-flex items-center gap-x-2 text-xs font-medium rounded-lg border border-red-200 bg-white text-red-700 hover:bg-red-50 disabled:opacity-50 disabled:pointer-events-none dark:bg-slate-900 dark:border-red-800 dark:text-red-300 dark:hover:bg-red-950" data-formgen-array-action="remove" data-relationship-action="remove"`)
+	if disabled {
+		builder.WriteString(` disabled`)
+	}
+	builder.WriteString(`>`)
 	builder.WriteString(html.EscapeString(arrayRemoveLabel(field)))
 	builder.WriteString(`</button>`)
 }
@@ -6,9 +6,15 @@ const (
 	NameTextarea      = "textarea"
 	NameSelect        = "select"
 	NameBoolean       = "boolean"
+	NameRadioGroup    = "radio-group"
+	NameCheckboxGroup = "checkbox-group"
 	NameObject        = "object"
+	NameSubform       = "subform"
 	NameArray         = "array"
 	NameDatetimeRange = "datetime-range"
+	NameDateRange     = "daterange"
+	NameDuration      = "duration"
+	NameTimezone      = "timezone"
 	NameMediaPicker   = "media_picker"
 	NameWysiwyg       = "wysiwyg"
 	NameFileUploader  = "file_uploader"
@@ -0,0 +1,26 @@
+package components
+
+import "github.com/goliatone/go-formgen/pkg/model"
+
+// numberPresentation resolves the unit suffix and precision-derived step for
+// numeric inputs, plus the default formatted to that precision. It returns nil
+// for non-numeric fields and numeric fields without unit or precision hints.
+func numberPresentation(field model.Field) map[string]any {
+	if field.Type != model.FieldTypeNumber && field.Type != model.FieldTypeInteger {
+		return nil
+	}
+	unit := model.FieldUnit(field)
+	precision, hasPrecision := model.FieldPrecision(field)
+	if unit == "" && !hasPrecision {
+		return nil
+	}
+
+	out := map[string]any{"unit": unit}
+	if hasPrecision {
+		out["step"] = model.PrecisionStep(precision)
+		if formatted, ok := model.FormatNumber(field.Default, precision); ok {
+			out["value"] = formatted
+		}
+	}
+	return out
+}
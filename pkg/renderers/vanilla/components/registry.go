@@ -43,6 +43,10 @@ type ComponentData struct {
 	ThemePartials map[string]string
 	Theme         map[string]any
 	StyleMode     string
+	// GridItemAttrs returns the attributes (leading space included) that
+	// place a nested field in a grid of the given column count, honoring its
+	// layout.span/start/row hints. Nil when the renderer has no grid support.
+	GridItemAttrs func(field model.Field, columns int) string
 }
 
 // Script describes JavaScript dependencies a component needs to emit once per
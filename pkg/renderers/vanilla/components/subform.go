@@ -0,0 +1,124 @@
+package components
+
+import (
+	"bytes"
+	"html"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const defaultSubformLoadLabel = "Show fields"
+
+func subformDescriptor() Descriptor {
+	return Descriptor{
+		Renderer: subformRenderer,
+		Scripts: []Script{
+			{
+				Inline: subformInlineScript,
+				Defer:  true,
+			},
+		},
+	}
+}
+
+// subformRenderer emits a placeholder for deferred object fields. The nested
+// controls are fetched from the fragment endpoint stored under
+// render.SubformSourceMetadataKey once the user expands the fieldset.
+func subformRenderer(buf *bytes.Buffer, field model.Field, _ ComponentData) error {
+	src := strings.TrimSpace(field.Metadata[render.SubformSourceMetadataKey])
+	if src == "" {
+		return objectRenderer(buf, field, ComponentData{})
+	}
+
+	var builder strings.Builder
+	labelID := objectLabelID(field)
+
+	writeObjectStart(&builder, field, labelID)
+	writeObjectCopy(&builder, field, labelID)
+
+	label := strings.TrimSpace(field.UIHints["subformLoadLabel"])
+	if label == "" {
+		label = defaultSubformLoadLabel
+	}
+	builder.WriteString(`<div class="space-y-4" data-formgen-subform="deferred" data-formgen-subform-src="`)
+	builder.WriteString(html.EscapeString(src))
+	builder.WriteString(`" data-formgen-subform-path="`)
+	builder.WriteString(html.EscapeString(componentControlPath(field)))
+	builder.WriteString(`">`)
+	builder.WriteString(`<button type="button" class="text-sm font-medium text-blue-600 hover:underline dark:text-blue-500" data-formgen-subform-load aria-expanded="false">`)
+	builder.WriteString(html.EscapeString(label))
+	builder.WriteString(`</button>`)
+	builder.WriteString(`</div>`)
+	builder.WriteString(`</fieldset>`)
+	buf.WriteString(builder.String())
+	return nil
+}
+
+// subformInlineScript fetches deferred subform fragments on demand and swaps
+// the placeholder fieldset for the rendered one. Inserted markup is handed to
+// FormgenRelationships.initFormgenRoot when the runtime bundle is present so
+// nested widgets initialise like the rest of the form.
+const subformInlineScript = `(function () {
+  var ROOT = '[data-formgen-subform="deferred"]';
+  var TRIGGER = "[data-formgen-subform-load]";
+
+  function init(root) {
+    if (root.getAttribute("data-formgen-subform-init") === "true") return;
+    root.setAttribute("data-formgen-subform-init", "true");
+    var trigger = root.querySelector(TRIGGER);
+    if (!trigger) return;
+    trigger.addEventListener("click", function () { load(root, trigger); });
+  }
+
+  function load(root, trigger) {
+    var src = root.getAttribute("data-formgen-subform-src");
+    var placeholder = root.closest("fieldset");
+    if (!src || !placeholder) return;
+    trigger.disabled = true;
+    trigger.setAttribute("aria-busy", "true");
+    fetch(src, { headers: { Accept: "text/html" }, credentials: "same-origin" })
+      .then(function (response) {
+        if (!response.ok) throw new Error("subform request failed: " + response.status);
+        return response.text();
+      })
+      .then(function (markup) {
+        var template = document.createElement("template");
+        template.innerHTML = markup;
+        var replacement = placeholder.id ? template.content.getElementById(placeholder.id) : null;
+        if (!replacement) replacement = template.content.querySelector("fieldset");
+        if (!replacement) throw new Error("subform fragment is empty");
+        placeholder.replaceWith(replacement);
+        var api = window.FormgenRelationships;
+        if (api && typeof api.initFormgenRoot === "function") {
+          try { api.initFormgenRoot(replacement); } catch (e) {}
+        }
+        replacement.querySelectorAll(ROOT).forEach(init);
+        replacement.dispatchEvent(new CustomEvent("formgen:subform-loaded", {
+          bubbles: true,
+          detail: { path: root.getAttribute("data-formgen-subform-path") || "" }
+        }));
+      })
+      .catch(function (error) {
+        root.setAttribute("data-formgen-subform-error", "true");
+        trigger.disabled = false;
+        trigger.removeAttribute("aria-busy");
+        root.dispatchEvent(new CustomEvent("formgen:subform-error", {
+          bubbles: true,
+          detail: { path: root.getAttribute("data-formgen-subform-path") || "", error: String(error) }
+        }));
+      });
+  }
+
+  function boot() {
+    document.querySelectorAll(ROOT).forEach(init);
+  }
+
+  if (typeof document === "undefined") return;
+  if (document.readyState === "loading") {
+    document.addEventListener("DOMContentLoaded", boot);
+  } else {
+    boot();
+  }
+})();`
@@ -0,0 +1,43 @@
+package components
+
+// TemplateBinding records the embedded template a built-in component renders
+// and the theme partial key that can replace it.
+type TemplateBinding struct {
+	Component    string
+	Template     string
+	ThemePartial string
+}
+
+var defaultTemplateBindings = []TemplateBinding{
+	{Component: NameInput, Template: templatePrefix + "input.tmpl", ThemePartial: inputTemplatePartialKey},
+	{Component: NameTextarea, Template: templatePrefix + "textarea.tmpl", ThemePartial: "forms.textarea"},
+	{Component: NameSelect, Template: templatePrefix + "select.tmpl", ThemePartial: "forms.select"},
+	{Component: NameBoolean, Template: templatePrefix + "boolean.tmpl", ThemePartial: booleanTemplatePartialKey},
+	{Component: NameRadioGroup, Template: templatePrefix + "radio_group.tmpl", ThemePartial: "forms.radio-group"},
+	{Component: NameCheckboxGroup, Template: templatePrefix + "checkbox_group.tmpl", ThemePartial: "forms.checkbox-group"},
+	{Component: NameDateRange, Template: templatePrefix + "daterange.tmpl", ThemePartial: dateRangeTemplatePartialKey},
+	{Component: NameDuration, Template: templatePrefix + "duration.tmpl", ThemePartial: durationTemplatePartialKey},
+	{Component: NameTimezone, Template: templatePrefix + "select.tmpl", ThemePartial: timezoneTemplatePartialKey},
+	{Component: NameMediaPicker, Template: templatePrefix + "media_picker.tmpl", ThemePartial: "forms.media-picker"},
+	{Component: NameWysiwyg, Template: templatePrefix + "wysiwyg.tmpl", ThemePartial: "forms.wysiwyg"},
+	{Component: NameFileUploader, Template: templatePrefix + "file_uploader.tmpl", ThemePartial: "forms.file-uploader"},
+	{Component: NameJSONEditor, Template: jsonEditorTemplate, ThemePartial: jsonEditorPartial},
+}
+
+// DefaultTemplateBindings lists the template-backed components registered by
+// NewDefaultRegistry. Components missing from the list (object, subform,
+// array, datetime-range) write their markup in Go.
+func DefaultTemplateBindings() []TemplateBinding {
+	return append([]TemplateBinding(nil), defaultTemplateBindings...)
+}
+
+// templateBindingRenderer returns the template renderer for a component listed
+// in defaultTemplateBindings.
+func templateBindingRenderer(name string) Renderer {
+	for _, binding := range defaultTemplateBindings {
+		if binding.Component == name {
+			return templateComponentRenderer(binding.ThemePartial, binding.Template)
+		}
+	}
+	panic("components: no template binding for " + name)
+}
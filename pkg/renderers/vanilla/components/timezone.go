@@ -0,0 +1,40 @@
+package components
+
+import (
+	"github.com/goliatone/go-formgen/components/timezones"
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const timezoneTemplatePartialKey = "forms.timezone"
+
+// timezoneOptions returns the field's own choices when declared and otherwise
+// the embedded IANA list, so the select still works before (or without) the
+// relationships runtime attaching its search UI.
+func timezoneOptions(field model.Field) []enumOption {
+	if len(field.Options) > 0 || len(field.Enum) > 0 {
+		return enumOptions(field)
+	}
+	zones, err := timezones.DefaultZones()
+	if err != nil {
+		return nil
+	}
+	options := make([]enumOption, 0, len(zones)+1)
+	current, _ := field.Default.(string)
+	if current == "" {
+		options = append(options, enumOption{Value: "", Label: timezonePlaceholder(field)})
+	}
+	for _, zone := range zones {
+		options = append(options, enumOption{Value: zone, Label: zone, Selected: zone == current})
+	}
+	return options
+}
+
+func timezonePlaceholder(field model.Field) string {
+	if placeholder := field.UIHints["placeholder"]; placeholder != "" {
+		return placeholder
+	}
+	if field.Label != "" {
+		return "Select " + field.Label
+	}
+	return "Select a timezone"
+}
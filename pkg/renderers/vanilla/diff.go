@@ -0,0 +1,135 @@
+package vanilla
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const (
+	diffTemplate       = "templates/diff.tmpl"
+	diffLabelSeparator = " › "
+
+	diffStatusAdded     = "added"
+	diffStatusRemoved   = "removed"
+	diffStatusChanged   = "changed"
+	diffStatusUnchanged = "unchanged"
+)
+
+// renderDiff renders a read-only comparison of RenderOptions.Values (current)
+// against Diff.Proposed. Changed fields are listed first as old → new pairs;
+// unchanged fields follow in a collapsed section unless HideUnchanged is set.
+func (r *Renderer) renderDiff(form model.FormModel, renderOptions render.RenderOptions) ([]byte, error) {
+	diff := renderOptions.Diff
+	unstyled := renderOptions.StyleMode == render.StyleModeUnstyled
+	var stylesheets []string
+	if !unstyled && !renderOptions.OmitAssets {
+		stylesheets = resolveAssets(append([]string(nil), r.stylesheets...), themeAssetResolver(renderOptions.Theme))
+	}
+	title := strings.TrimSpace(diff.Title)
+	if title == "" {
+		title = strings.TrimSpace(form.UIHints[layoutTitleHint])
+	}
+	if title == "" {
+		title = strings.TrimSpace(form.Summary)
+	}
+
+	rows := diffRows(form.Fields, expandViewValues(renderOptions.Values), expandViewValues(diff.Proposed), "", "", renderOptions.IncludeSensitiveDefaults)
+	var changed, unchanged []map[string]any
+	for _, row := range rows {
+		if row["status"] == diffStatusUnchanged {
+			unchanged = append(unchanged, row)
+			continue
+		}
+		changed = append(changed, row)
+	}
+	if diff.HideUnchanged {
+		unchanged = nil
+	}
+
+	result, err := r.templates.RenderTemplate(diffTemplate, map[string]any{
+		"form":            form,
+		"title":           title,
+		"changed":         changed,
+		"unchanged":       unchanged,
+		"changed_count":   strconv.Itoa(len(changed)),
+		"unchanged_count": strconv.Itoa(len(unchanged)),
+		"stylesheets":     stylesheets,
+		"unstyled":        unstyled,
+		"empty_value":     viewEmptyValue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("vanilla renderer: render diff: %w", err)
+	}
+	return []byte(result), nil
+}
+
+// diffRows flattens fields into comparison rows. Nested objects and array
+// items are walked on both sides so each leaf field yields one row labelled
+// with its ancestors ("Address › City").
+func diffRows(fields []model.Field, current, proposed map[string]any, parentPath, parentLabel string, includeSensitive bool) []map[string]any {
+	var rows []map[string]any
+	for _, field := range fields {
+		path := joinPath(parentPath, field.Name)
+		label := viewLabel(field)
+		if parentLabel != "" {
+			label = parentLabel + diffLabelSeparator + label
+		}
+		oldRaw := unwrapViewValue(current[field.Name])
+		newRaw := unwrapViewValue(proposed[field.Name])
+
+		switch {
+		case field.Type == model.FieldTypeObject && len(field.Nested) > 0:
+			rows = append(rows, diffRows(field.Nested, viewMap(oldRaw), viewMap(newRaw), path, label, includeSensitive)...)
+		case field.Type == model.FieldTypeArray && field.Items != nil && len(field.Items.Nested) > 0:
+			oldItems, _ := oldRaw.([]any)
+			newItems, _ := newRaw.([]any)
+			for idx := range max(len(oldItems), len(newItems)) {
+				rows = append(rows, diffRows(field.Items.Nested, viewMap(diffItem(oldItems, idx)), viewMap(diffItem(newItems, idx)),
+					fmt.Sprintf("%s.%d", path, idx), fmt.Sprintf("%s %d", label, idx+1), includeSensitive)...)
+			}
+		default:
+			rows = append(rows, diffRow(field, label, path, oldRaw, newRaw, includeSensitive))
+		}
+	}
+	return rows
+}
+
+func diffRow(field model.Field, label, path string, oldRaw, newRaw any, includeSensitive bool) map[string]any {
+	// Compare unmasked text so sensitive changes are detected even though both
+	// sides display the same mask.
+	oldText, _ := formatViewValue(field, oldRaw, true)
+	newText, _ := formatViewValue(field, newRaw, true)
+
+	status := diffStatusChanged
+	switch {
+	case oldText == newText:
+		status = diffStatusUnchanged
+	case oldText == "":
+		status = diffStatusAdded
+	case newText == "":
+		status = diffStatusRemoved
+	}
+
+	if field.Sensitive && !includeSensitive {
+		oldText, _ = formatViewValue(field, oldRaw, false)
+		newText, _ = formatViewValue(field, newRaw, false)
+	}
+	return map[string]any{
+		"label":  label,
+		"path":   path,
+		"status": status,
+		"old":    oldText,
+		"new":    newText,
+	}
+}
+
+func diffItem(items []any, idx int) any {
+	if idx < len(items) {
+		return items[idx]
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package vanilla
+
+import (
+	"html"
+	"maps"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/display"
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	// displayFormatMetadataKey and displayTextMetadataKey carry a read-only
+	// field's display format and formatted value to the field chrome.
+	displayFormatMetadataKey = "__display.format"
+	displayTextMetadataKey   = "__display.text"
+	displayIDSuffix          = "-display"
+)
+
+// WithDisplayFormatters replaces the registry used for displayFormat hints.
+// By default the built-in formatters of display.NewRegistry are available.
+func WithDisplayFormatters(registry *display.Registry) Option {
+	return func(cfg *config) {
+		cfg.displayFormatters = registry
+	}
+}
+
+// applyDisplayFormats marks read-only fields that declare a displayFormat hint
+// so the chrome renders the formatted value next to the control, which keeps
+// the raw value for submission. Field metadata maps are copied before they are
+// modified.
+func applyDisplayFormats(fields []model.Field, registry *display.Registry, ctx display.Context) []model.Field {
+	if len(fields) == 0 {
+		return fields
+	}
+	out := make([]model.Field, len(fields))
+	for i, field := range fields {
+		if spec := strings.TrimSpace(field.UIHints[display.HintKey]); spec != "" && fieldIsReadonly(field) && !field.Sensitive {
+			metadata := make(map[string]string, len(field.Metadata)+2)
+			maps.Copy(metadata, field.Metadata)
+			metadata[displayFormatMetadataKey] = spec
+			metadata[displayTextMetadataKey] = registry.Value(ctx, field, field.Default)
+			field.Metadata = metadata
+		}
+		if len(field.Nested) > 0 {
+			field.Nested = applyDisplayFormats(field.Nested, registry, ctx)
+		}
+		out[i] = field
+	}
+	return out
+}
+
+func fieldIsReadonly(field model.Field) bool {
+	return field.Readonly ||
+		strings.TrimSpace(field.UIHints["readonly"]) == "true" ||
+		strings.TrimSpace(field.Metadata["readonly"]) == "true"
+}
+
+// writeDisplayValue renders the formatted value of a read-only field. The
+// display runtime (client/src/display) re-formats it when the control changes.
+func writeDisplayValue(builder *strings.Builder, field model.Field, mode renderStyleMode) {
+	spec := stringFromMap(field.Metadata, displayFormatMetadataKey)
+	if spec == "" {
+		return
+	}
+	controlID := fieldControlID(field)
+	builder.WriteString(`    <p`)
+	writeDataAttr(builder, "id", controlID+displayIDSuffix)
+	writeDataAttr(builder, "data-formgen-display", spec)
+	writeDataAttr(builder, "data-display-for", controlID)
+	if mode != renderStyleUnstyled {
+		builder.WriteString(` class="text-sm text-gray-700 dark:text-gray-300"`)
+	}
+	builder.WriteString(`>`)
+	builder.WriteString(html.EscapeString(stringFromMap(field.Metadata, displayTextMetadataKey)))
+	builder.WriteString("</p>\n")
+}
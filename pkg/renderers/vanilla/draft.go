@@ -0,0 +1,26 @@
+package vanilla
+
+import (
+	"encoding/json"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const draftBehaviorKey = "draft"
+
+// buildDraftContext returns the draft behavior attributes for the form root
+// when the operation enables drafts (model.FormDraft), or nil otherwise.
+func buildDraftContext(form model.FormModel) map[string]any {
+	draft, ok := model.FormDraft(form)
+	if !ok {
+		return nil
+	}
+	config, err := json.Marshal(draft)
+	if err != nil {
+		return nil
+	}
+	return map[string]any{
+		"behavior": draftBehaviorKey,
+		"config":   string(config),
+	}
+}
@@ -2,14 +2,17 @@ package vanilla
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"html"
 	"maps"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
 	"github.com/goliatone/go-formgen/pkg/render/template"
 	"github.com/goliatone/go-formgen/pkg/renderers/vanilla/components"
 )
@@ -24,6 +27,12 @@ type componentRenderer struct {
 	templateTheme  map[string]any
 	assetResolver  func(string) string
 	styleMode      renderStyleMode
+	gridClasses    *render.GridClasses
+	ctx            context.Context
+	htmlPolicy     render.HTMLPolicy
+	// responsiveGrid records that a nested grid item used breakpoint hints,
+	// so the form needs the responsive grid stylesheet.
+	responsiveGrid bool
 }
 
 const (
@@ -58,10 +67,40 @@ func newComponentRenderer(templates template.TemplateRenderer, registry *compone
 }
 
 func (r *componentRenderer) render(field model.Field, path string) (string, error) {
+	if err := contextErr(r.ctx); err != nil {
+		return "", err
+	}
 	if skipRelationshipSource(field) {
 		return "", nil
 	}
+	timer := render.FieldTimerFromContext(r.ctx)
+	if timer == nil {
+		markup, _, err := r.renderComponent(field, path)
+		return markup, err
+	}
+	start := time.Now()
+	markup, componentName, err := r.renderComponent(field, path)
+	if err != nil {
+		return "", err
+	}
+	elapsed := time.Since(start)
+	timer.Record(path, componentName, elapsed)
+	if timer.Comments {
+		markup = fieldTimingComment(path, componentName, elapsed) + markup
+	}
+	return markup, nil
+}
 
+// fieldTimingComment renders the dev-mode timing annotation. "--" is not
+// allowed inside HTML comments, so it is collapsed.
+func fieldTimingComment(path, componentName string, elapsed time.Duration) string {
+	clean := func(value string) string {
+		return strings.ReplaceAll(value, "--", "-")
+	}
+	return fmt.Sprintf("<!-- formgen:field path=%s component=%s render=%s -->\n", clean(path), clean(componentName), elapsed)
+}
+
+func (r *componentRenderer) renderComponent(field model.Field, path string) (string, string, error) {
 	field = applyRenderPathMetadata(field, path)
 
 	componentName := r.overrideFor(path, field.Name)
@@ -74,12 +113,12 @@ func (r *componentRenderer) render(field model.Field, path string) (string, erro
 
 	descriptor, ok := r.registry.Descriptor(componentName)
 	if !ok {
-		return "", fmt.Errorf("component %q not registered for field %q", componentName, path)
+		return "", "", fmt.Errorf("component %q not registered for field %q", componentName, path)
 	}
 
 	config, err := parseComponentConfig(stringFromMap(field.Metadata, componentConfigMetadataKey))
 	if err != nil {
-		return "", fmt.Errorf("parse component config for field %q: %w", path, err)
+		return "", "", fmt.Errorf("parse component config for field %q: %w", path, err)
 	}
 
 	data := components.ComponentData{
@@ -89,16 +128,17 @@ func (r *componentRenderer) render(field model.Field, path string) (string, erro
 		ThemePartials: r.theme.Partials,
 		Theme:         r.templateTheme,
 		StyleMode:     string(r.styleMode),
+		GridItemAttrs: r.gridItemAttrs,
 	}
 
 	var control bytes.Buffer
 	if err := descriptor.Renderer(&control, field, data); err != nil {
-		return "", fmt.Errorf("render component %q for field %q: %w", componentName, path, err)
+		return "", "", fmt.Errorf("render component %q for field %q: %w", componentName, path, err)
 	}
 
 	r.usedComponents[componentName] = struct{}{}
 
-	return buildFieldMarkup(r.templates, field, componentName, control.String(), r.styleMode), nil
+	return buildFieldMarkup(r.templates, field, componentName, control.String(), r.htmlPolicy, r.styleMode), componentName, nil
 }
 
 func applyComponentFieldValue(field model.Field, value any) model.Field {
@@ -140,6 +180,17 @@ func (r *componentRenderer) childRenderer(parentPath string) func(any) (string,
 	}
 }
 
+// gridItemAttrs places a nested field inside an object's own grid, using the
+// same inline styles or utility classes as top-level fields.
+func (r *componentRenderer) gridItemAttrs(field model.Field, columns int) string {
+	if r.gridClasses != nil {
+		return gridClassAttribute(field, columns, r.gridClasses)
+	}
+	attrs, responsive := gridWrapperAttributes(field, columns)
+	r.responsiveGrid = r.responsiveGrid || responsive
+	return attrs
+}
+
 func (r *componentRenderer) assets() (stylesheets []string, scripts []components.Script) {
 	if r.registry == nil || len(r.usedComponents) == 0 {
 		return nil, nil
@@ -179,7 +230,7 @@ func skipRelationshipSource(field model.Field) bool {
 	return field.Relationship != nil && strings.TrimSpace(field.Relationship.SourceField) != ""
 }
 
-func buildFieldMarkup(templates template.TemplateRenderer, field model.Field, componentName, control string, styleMode ...renderStyleMode) string {
+func buildFieldMarkup(templates template.TemplateRenderer, field model.Field, componentName, control string, policy render.HTMLPolicy, styleMode ...renderStyleMode) string {
 	if strings.TrimSpace(control) == "" {
 		return ""
 	}
@@ -194,7 +245,7 @@ func buildFieldMarkup(templates template.TemplateRenderer, field model.Field, co
 	writeFieldWrapperStart(&builder, field, componentName, mode)
 	builder.WriteString(">\n")
 
-	context := buildChromeContext(field, componentName)
+	context := buildChromeContext(field, componentName, policy)
 	skipChrome := componentHandlesChrome(componentName)
 
 	writeFieldChromeBeforeControl(&builder, templates, field, context, componentName, skipChrome)
@@ -253,7 +304,7 @@ func writeFieldWrapperClass(builder *strings.Builder, field model.Field, mode re
 }
 
 func writeFieldChromeBeforeControl(builder *strings.Builder, templates template.TemplateRenderer, field model.Field, context map[string]any, componentName string, skipChrome bool) {
-	if skipChrome || !shouldRenderLabel(field) {
+	if skipChrome || componentRendersLegend(componentName, field) || !shouldRenderLabel(field) {
 		return
 	}
 	label := renderChromePartial(templates, chromeLabelTemplate, field, context, fallbackLabelMarkup)
@@ -275,6 +326,7 @@ func writeFieldChromeAfterControl(builder *strings.Builder, templates template.T
 		writeIndentedBlock(builder, help)
 	}
 
+	writeDisplayValue(builder, field, mode)
 	writeRelationshipError(builder, field, mode)
 }
 
@@ -339,7 +391,7 @@ func renderChromePartial(renderer template.TemplateRenderer, templateName string
 	return strings.TrimSpace(fallback(field, context))
 }
 
-func buildChromeContext(field model.Field, componentName string) map[string]any {
+func buildChromeContext(field model.Field, componentName string, policy render.HTMLPolicy) map[string]any {
 	controlID := fieldControlID(field)
 	context := map[string]any{
 		"controlID": controlID,
@@ -362,9 +414,34 @@ func buildChromeContext(field model.Field, componentName string) map[string]any
 		context["helpID"] = controlID + helpIDSuffix
 	}
 
+	// Markup allowed by RenderOptions.HTMLPolicy is derived from the field
+	// text here and never read from metadata, so specs and UI schemas cannot
+	// smuggle unsanitized markup into the chrome partials.
+	for key, text := range map[string]string{
+		"labelHTML":       field.Label,
+		"descriptionHTML": field.Description,
+		"helpHTML":        stringFromMap(field.UIHints, "helpText"),
+	} {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		if markup, ok := render.PolicyMarkup(policy, strings.TrimSpace(text)); ok {
+			context[key] = markup
+		}
+	}
+
 	return context
 }
 
+// chromeText returns the sanitized markup stored in context under key, or the
+// escaped plain text.
+func chromeText(context map[string]any, key, text string) string {
+	if markup, ok := context[key].(string); ok && markup != "" {
+		return markup
+	}
+	return html.EscapeString(text)
+}
+
 func fieldControlID(field model.Field) string {
 	if id := strings.TrimSpace(stringFromMap(field.Metadata, controlIDMetadataKey)); id != "" {
 		return id
@@ -463,7 +540,7 @@ func fallbackLabelMarkup(field model.Field, context map[string]any) string {
 		builder.WriteString(` sr-only`)
 	}
 	builder.WriteString(`">`)
-	builder.WriteString(html.EscapeString(field.Label))
+	builder.WriteString(chromeText(context, "labelHTML", field.Label))
 	if field.Required {
 		builder.WriteString(`<span class="text-red-500">*</span>`)
 	}
@@ -471,22 +548,22 @@ func fallbackLabelMarkup(field model.Field, context map[string]any) string {
 	return builder.String()
 }
 
-func fallbackDescriptionMarkup(field model.Field, _ map[string]any) string {
+func fallbackDescriptionMarkup(field model.Field, context map[string]any) string {
 	if desc := strings.TrimSpace(field.Description); desc != "" {
 		var builder strings.Builder
 		builder.WriteString(`<p data-formgen-chrome="description" class="text-xs text-gray-500 dark:text-gray-400">`)
-		builder.WriteString(html.EscapeString(desc))
+		builder.WriteString(chromeText(context, "descriptionHTML", desc))
 		builder.WriteString(`</p>`)
 		return builder.String()
 	}
 	return ""
 }
 
-func fallbackHelpMarkup(field model.Field, _ map[string]any) string {
+func fallbackHelpMarkup(field model.Field, context map[string]any) string {
 	if hint := strings.TrimSpace(stringFromMap(field.UIHints, "helpText")); hint != "" {
 		var builder strings.Builder
 		builder.WriteString(`<p data-formgen-chrome="help" class="text-xs text-gray-600 dark:text-gray-300">`)
-		builder.WriteString(html.EscapeString(hint))
+		builder.WriteString(chromeText(context, "helpHTML", hint))
 		builder.WriteString(`</p>`)
 		return builder.String()
 	}
@@ -27,7 +27,7 @@ func TestBuildFieldMarkupUsesChromePartials(t *testing.T) {
 		},
 	}
 
-	html := buildFieldMarkup(renderer, field, components.NameInput, `<input id="fg-title">`)
+	html := buildFieldMarkup(renderer, field, components.NameInput, `<input id="fg-title">`, "")
 	if !strings.Contains(html, "partial-label") {
 		t.Fatalf("expected label partial output, got:\n%s", html)
 	}
@@ -66,7 +66,7 @@ func TestBuildFieldMarkupFallsBackWhenPartialsFail(t *testing.T) {
 		},
 	}
 
-	html := buildFieldMarkup(renderer, field, components.NameInput, `<input id="fg-title">`)
+	html := buildFieldMarkup(renderer, field, components.NameInput, `<input id="fg-title">`, "")
 	if !strings.Contains(html, `for="fg-title"`) {
 		t.Fatalf("expected fallback label markup with control binding, got:\n%s", html)
 	}
@@ -106,7 +106,7 @@ func TestBuildFieldMarkupVisuallyHidesLabel(t *testing.T) {
 		},
 	}
 
-	html := buildFieldMarkup(renderer, field, components.NameInput, `<input id="fg-hidden">`)
+	html := buildFieldMarkup(renderer, field, components.NameInput, `<input id="fg-hidden">`, "")
 	if !strings.Contains(html, "sr-only") {
 		t.Fatalf("expected visually hidden class on label, got:\n%s", html)
 	}
@@ -121,7 +121,7 @@ func TestBuildFieldMarkupHonoursChromeSkipMetadata(t *testing.T) {
 		Metadata: map[string]string{componentChromeMetadataKey: componentChromeSkipKeyword},
 	}
 	control := `<input id="fg-raw">`
-	html := buildFieldMarkup(nil, field, components.NameInput, control)
+	html := buildFieldMarkup(nil, field, components.NameInput, control, "")
 	if html != control {
 		t.Fatalf("expected chrome skip to return control markup only, got: %q", html)
 	}
@@ -132,7 +132,7 @@ func TestBuildFieldMarkupIncludesErrorPlaceholder(t *testing.T) {
 		Name:  "title",
 		Label: "Title",
 	}
-	html := buildFieldMarkup(nil, field, components.NameInput, `<input id="fg-title">`)
+	html := buildFieldMarkup(nil, field, components.NameInput, `<input id="fg-title">`, "")
 	if !strings.Contains(html, `data-relationship-error="true"`) {
 		t.Fatalf("expected error placeholder markup, got:\n%s", html)
 	}
@@ -149,7 +149,7 @@ func TestBuildFieldMarkupIncludesComponentConfigAttributes(t *testing.T) {
 		},
 	}
 
-	html := buildFieldMarkup(nil, field, components.NameMediaPicker, `<input id="fg-asset">`)
+	html := buildFieldMarkup(nil, field, components.NameMediaPicker, `<input id="fg-asset">`, "")
 	if !strings.Contains(html, `data-component="media_picker"`) {
 		t.Fatalf("expected media picker component wrapper, got:\n%s", html)
 	}
@@ -164,7 +164,7 @@ func TestBuildFieldMarkupRendersInlineErrorMessage(t *testing.T) {
 		Label:    "Title",
 		Metadata: map[string]string{"validation.message": "Title is required"},
 	}
-	html := buildFieldMarkup(nil, field, components.NameInput, `<input id="fg-title">`)
+	html := buildFieldMarkup(nil, field, components.NameInput, `<input id="fg-title">`, "")
 	if !strings.Contains(html, "Title is required") {
 		t.Fatalf("expected inline error message, got:\n%s", html)
 	}
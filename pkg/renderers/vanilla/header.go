@@ -0,0 +1,209 @@
+package vanilla
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const (
+	chromeHeaderTemplate = chromeTemplatePrefix + "_header.tmpl"
+	layoutTitleHint      = "layout.title"
+	layoutSubtitleHint   = "layout.subtitle"
+)
+
+// renderHeader renders the form header chrome and reports where the form
+// template should place it. An empty string means no header is emitted.
+func (r *Renderer) renderHeader(form model.FormModel, options *render.HeaderOptions, chromeClasses map[string]string, styleMode renderStyleMode, theme *render.ThemeConfig) (string, render.HeaderPlacement, error) {
+	var opts render.HeaderOptions
+	if options != nil {
+		opts = *options
+	}
+	placement := opts.Placement
+	if placement == "" {
+		placement = render.HeaderPlacementInside
+	}
+	if placement == render.HeaderPlacementNone {
+		return "", placement, nil
+	}
+
+	header := map[string]any{
+		"placement":                 string(placement),
+		"class":                     chromeClasses["header"],
+		"default_class":             DefaultHeaderClass,
+		"breadcrumbs_class":         chromeClasses["breadcrumbs"],
+		"default_breadcrumbs_class": DefaultBreadcrumbsClass,
+	}
+	hasContent := false
+	if title := strings.TrimSpace(form.UIHints[layoutTitleHint]); title != "" {
+		header["title"] = title
+		hasContent = true
+	}
+	if subtitle := strings.TrimSpace(form.UIHints[layoutSubtitleHint]); subtitle != "" {
+		header["subtitle"] = subtitle
+		hasContent = true
+	}
+	if slot := strings.TrimSpace(opts.BreadcrumbsHTML); slot != "" {
+		header["breadcrumbs_html"] = slot
+		hasContent = true
+	} else if crumbs := breadcrumbPayload(opts.Breadcrumbs); len(crumbs) > 0 {
+		header["breadcrumbs"] = crumbs
+		hasContent = true
+	}
+	if opts.ShowDescription {
+		description := strings.TrimSpace(form.Description)
+		if description == "" {
+			description = strings.TrimSpace(form.Summary)
+		}
+		if rendered := renderMarkdown(description); rendered != "" {
+			header["description_html"] = rendered
+			hasContent = true
+		}
+	}
+	if !hasContent {
+		return "", placement, nil
+	}
+
+	rendered, err := r.templates.RenderTemplate(headerTemplateName(theme), map[string]any{
+		"header":   header,
+		"unstyled": styleMode == renderStyleUnstyled,
+	})
+	if err != nil {
+		return "", placement, fmt.Errorf("render header: %w", err)
+	}
+	return strings.TrimSpace(rendered), placement, nil
+}
+
+func headerTemplateName(cfg *render.ThemeConfig) string {
+	if cfg != nil {
+		if candidate := strings.TrimSpace(cfg.Partials["forms.header"]); candidate != "" {
+			return candidate
+		}
+	}
+	return chromeHeaderTemplate
+}
+
+func breadcrumbPayload(crumbs []render.Breadcrumb) []map[string]any {
+	out := make([]map[string]any, 0, len(crumbs))
+	for _, crumb := range crumbs {
+		label := strings.TrimSpace(crumb.Label)
+		if label == "" {
+			continue
+		}
+		out = append(out, map[string]any{
+			"label": label,
+			"href":  strings.TrimSpace(crumb.Href),
+		})
+	}
+	if len(out) > 0 {
+		out[len(out)-1]["current"] = true
+	}
+	return out
+}
+
+var (
+	markdownLinkPattern   = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	markdownStrongPattern = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	markdownEmPattern     = regexp.MustCompile(`\*([^*]+)\*|\b_([^_]+)_\b`)
+)
+
+// renderMarkdown converts the small Markdown subset used in operation
+// descriptions into HTML. Input is escaped first, so raw HTML in the source
+// is never emitted.
+func renderMarkdown(source string) string {
+	source = strings.TrimSpace(strings.ReplaceAll(source, "\r\n", "\n"))
+	if source == "" {
+		return ""
+	}
+
+	var blocks []string
+	var paragraph, list []string
+	flushParagraph := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, "<p>"+markdownInline(strings.Join(paragraph, " "))+"</p>")
+			paragraph = nil
+		}
+	}
+	flushList := func() {
+		if len(list) > 0 {
+			var b strings.Builder
+			b.WriteString("<ul>")
+			for _, item := range list {
+				b.WriteString("<li>" + markdownInline(item) + "</li>")
+			}
+			b.WriteString("</ul>")
+			blocks = append(blocks, b.String())
+			list = nil
+		}
+	}
+
+	for _, line := range strings.Split(source, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			flushParagraph()
+			flushList()
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* "):
+			flushParagraph()
+			list = append(list, strings.TrimSpace(trimmed[2:]))
+		default:
+			flushList()
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flushParagraph()
+	flushList()
+	return strings.Join(blocks, "\n")
+}
+
+// markdownInline escapes text and applies inline code, links, and emphasis.
+// Code spans are left untouched by the other rules.
+func markdownInline(text string) string {
+	parts := strings.Split(text, "`")
+	var b strings.Builder
+	for idx, part := range parts {
+		escaped := html.EscapeString(part)
+		if idx%2 == 1 && idx < len(parts)-1 {
+			b.WriteString("<code>" + escaped + "</code>")
+			continue
+		}
+		if idx%2 == 1 {
+			// Unmatched trailing backtick: keep it literal.
+			b.WriteString("`")
+		}
+		// Emphasis runs on link text and the text between links separately, so
+		// it never reaches into URLs or spans a closing </a>.
+		last := 0
+		for _, loc := range markdownLinkPattern.FindAllStringSubmatchIndex(escaped, -1) {
+			b.WriteString(markdownEmphasis(escaped[last:loc[0]]))
+			label, href := markdownEmphasis(escaped[loc[2]:loc[3]]), escaped[loc[4]:loc[5]]
+			if safeMarkdownURL(html.UnescapeString(href)) {
+				b.WriteString(`<a href="` + href + `">` + label + `</a>`)
+			} else {
+				b.WriteString(label)
+			}
+			last = loc[1]
+		}
+		b.WriteString(markdownEmphasis(escaped[last:]))
+	}
+	return b.String()
+}
+
+func markdownEmphasis(escaped string) string {
+	escaped = markdownStrongPattern.ReplaceAllString(escaped, "<strong>$1$2</strong>")
+	return markdownEmPattern.ReplaceAllString(escaped, "<em>$1$2</em>")
+}
+
+func safeMarkdownURL(raw string) bool {
+	lower := strings.ToLower(strings.TrimSpace(raw))
+	for _, prefix := range []string{"http://", "https://", "mailto:", "/", "#", "./", "../"} {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+	return !strings.Contains(lower, ":")
+}
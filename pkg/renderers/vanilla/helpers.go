@@ -3,6 +3,7 @@ package vanilla
 import (
 	"strings"
 
+	"github.com/goliatone/go-formgen/pkg/model"
 	"github.com/goliatone/go-formgen/pkg/renderers/vanilla/components"
 )
 
@@ -43,6 +44,20 @@ func componentHandlesChrome(componentName string) bool {
 	}
 }
 
+// componentRendersLegend returns true if a component labels itself with a
+// fieldset legend, so the shared label chrome is skipped while description,
+// help, and error chrome still render.
+func componentRendersLegend(componentName string, field model.Field) bool {
+	switch strings.TrimSpace(componentName) {
+	case components.NameRadioGroup, components.NameCheckboxGroup, components.NameDateRange, components.NameDuration:
+		return true
+	case components.NameBoolean:
+		return components.BooleanStyle(field) == components.BooleanStyleSegmented
+	default:
+		return false
+	}
+}
+
 // componentHandlesDescription returns true if a component handles its own description
 // (e.g., uses description as placeholder instead of rendering it separately).
 func componentHandlesDescription(componentName string) bool {
@@ -0,0 +1,58 @@
+package vanilla
+
+import (
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/render/template/gotemplate"
+)
+
+// FormTemplate is the entrypoint every vanilla template bundle must provide.
+const FormTemplate = "templates/form.tmpl"
+
+// renderChildFunc is the callable the component renderer passes to container
+// templates (array, object) for nested fields.
+const renderChildFunc = "render_child"
+
+// WithStrictTemplates lints the template bundle when the renderer is built and
+// fails New when gotemplate.Lint reports errors (parse failures, missing
+// partials or entrypoints, unknown filters). Warnings do not fail.
+func WithStrictTemplates() Option {
+	return func(cfg *config) {
+		cfg.strictTemplates = true
+	}
+}
+
+// TemplateLintOptions configures gotemplate.Lint for a vanilla bundle:
+// templates/form.tmpl is required, every template the renderer loads by name
+// counts as used, and render_child plus the given template funcs are known.
+func TemplateLintOptions(funcs ...string) []gotemplate.LintOption {
+	return []gotemplate.LintOption{
+		gotemplate.WithLintEntrypoints(FormTemplate),
+		gotemplate.WithLintRoots(builtinTemplateNames()...),
+		gotemplate.WithLintFunctions(append([]string{renderChildFunc}, funcs...)...),
+	}
+}
+
+func lintTemplates(cfg config) error {
+	report, err := gotemplate.Lint(cfg.templateFS, ".tmpl", TemplateLintOptions(slices.Collect(maps.Keys(cfg.templateFuncs))...)...)
+	if err != nil {
+		return err
+	}
+	return report.Err()
+}
+
+// builtinTemplateNames lists the embedded templates; custom bundles override
+// them by path.
+func builtinTemplateNames() []string {
+	var names []string
+	_ = fs.WalkDir(embeddedTemplates, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() && strings.HasSuffix(name, ".tmpl") {
+			names = append(names, name)
+		}
+		return nil
+	})
+	return names
+}
@@ -0,0 +1,143 @@
+package vanilla
+
+import (
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla/components"
+)
+
+// buildLiveContext exposes RenderOptions.Live to the form template. It
+// returns nil when live refreshes are not configured.
+func buildLiveContext(options *render.LiveOptions) map[string]any {
+	if options == nil {
+		return nil
+	}
+	events := strings.TrimSpace(options.EventsURL)
+	if events == "" {
+		return nil
+	}
+	return map[string]any{
+		"events":  events,
+		"src":     strings.TrimSpace(options.FragmentURL),
+		"version": strings.TrimSpace(options.Version),
+	}
+}
+
+func liveScript() components.Script {
+	return components.Script{Inline: liveInlineScript, Defer: true}
+}
+
+// liveInlineScript subscribes form roots carrying data-formgen-live-events to
+// the version stream. When a newer version arrives the form markup is
+// re-fetched, swapped in with the user's entered values restored, and handed
+// to FormgenRelationships.initFormgenRoot when the runtime bundle is present.
+const liveInlineScript = `(function () {
+  var ROOT = "[data-formgen-live-events]";
+  var EVENTS = "data-formgen-live-events";
+  var VERSION = "data-formgen-version";
+  var FORM = "data-formgen-live-form";
+  var streams = {};
+
+  function subscribe(url) {
+    if (streams[url] || typeof EventSource === "undefined") return;
+    var source = new EventSource(url);
+    streams[url] = source;
+    source.addEventListener("formgen-version", function (message) {
+      var event;
+      try { event = JSON.parse(message.data); } catch (e) { return; }
+      if (!event || !event.version) return;
+      document.querySelectorAll(ROOT).forEach(function (root) {
+        if (root.getAttribute(EVENTS) !== url) return;
+        var form = root.getAttribute(FORM) || "";
+        if (event.forms && event.forms.length && event.forms.indexOf(form) === -1) return;
+        var current = root.getAttribute(VERSION);
+        if (current === event.version) return;
+        if (!current) {
+          root.setAttribute(VERSION, event.version);
+          return;
+        }
+        refresh(root, event);
+      });
+    });
+  }
+
+  function snapshot(root) {
+    var values = {};
+    root.querySelectorAll("input[name], select[name], textarea[name]").forEach(function (el) {
+      if (el.type === "hidden" || el.type === "file") return;
+      var list = values[el.name] || (values[el.name] = []);
+      if (el.type === "checkbox" || el.type === "radio") {
+        if (el.checked) list.push(el.value);
+      } else if (el.tagName === "SELECT") {
+        Array.prototype.forEach.call(el.options, function (opt) { if (opt.selected) list.push(opt.value); });
+      } else {
+        list.push(el.value);
+      }
+    });
+    return values;
+  }
+
+  function restore(root, values) {
+    root.querySelectorAll("input[name], select[name], textarea[name]").forEach(function (el) {
+      if (el.type === "hidden" || el.type === "file" || !values.hasOwnProperty(el.name)) return;
+      var list = values[el.name];
+      if (el.type === "checkbox" || el.type === "radio") {
+        el.checked = list.indexOf(el.value) !== -1;
+      } else if (el.tagName === "SELECT") {
+        Array.prototype.forEach.call(el.options, function (opt) { opt.selected = list.indexOf(opt.value) !== -1; });
+      } else if (list.length) {
+        el.value = list[0];
+      }
+    });
+  }
+
+  function refresh(root, event) {
+    var src = root.getAttribute("data-formgen-live-src") || window.location.href;
+    var form = root.getAttribute(FORM) || "";
+    fetch(src, { headers: { Accept: "text/html" }, credentials: "same-origin" })
+      .then(function (response) {
+        if (!response.ok) throw new Error("live refresh failed: " + response.status);
+        return response.text();
+      })
+      .then(function (markup) {
+        var template = document.createElement("template");
+        template.innerHTML = markup;
+        var selector = ROOT;
+        if (form) selector += "[" + FORM + '="' + (window.CSS && CSS.escape ? CSS.escape(form) : form) + '"]';
+        var replacement = template.content.querySelector(selector) || template.content.querySelector(ROOT);
+        if (!replacement) throw new Error("live fragment has no form root");
+        var values = snapshot(root);
+        if (!replacement.getAttribute(VERSION)) replacement.setAttribute(VERSION, event.version);
+        root.replaceWith(replacement);
+        restore(replacement, values);
+        var api = window.FormgenRelationships;
+        if (api && typeof api.initFormgenRoot === "function") {
+          try { api.initFormgenRoot(replacement); } catch (e) {}
+        }
+        replacement.dispatchEvent(new CustomEvent("formgen:live-updated", {
+          bubbles: true,
+          detail: { version: event.version, source: event.source || "" }
+        }));
+      })
+      .catch(function (error) {
+        root.dispatchEvent(new CustomEvent("formgen:live-error", {
+          bubbles: true,
+          detail: { version: event.version, error: String(error) }
+        }));
+      });
+  }
+
+  function boot() {
+    document.querySelectorAll(ROOT).forEach(function (root) {
+      subscribe(root.getAttribute(EVENTS));
+    });
+  }
+
+  if (typeof document === "undefined") return;
+  if (document.readyState === "loading") {
+    document.addEventListener("DOMContentLoaded", boot);
+  } else {
+    boot();
+  }
+})();`
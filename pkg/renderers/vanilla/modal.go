@@ -0,0 +1,70 @@
+package vanilla
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// buildModalContext exposes the modal variant to the form template. It
+// returns nil unless RenderOptions.Variant is VariantModal.
+func buildModalContext(variant render.Variant, options *render.ModalOptions, operationID string) map[string]any {
+	if variant != render.VariantModal {
+		return nil
+	}
+	var opts render.ModalOptions
+	if options != nil {
+		opts = *options
+	}
+	mode := opts.Mode
+	if mode != render.ModalModeEdit {
+		mode = render.ModalModeCreate
+	}
+	actionID := strings.TrimSpace(opts.ActionID)
+	if actionID == "" {
+		actionID = operationID
+	}
+	valueField := firstNonEmpty(opts.ValueField, "id")
+	labelField := firstNonEmpty(opts.LabelField, "name")
+	title := strings.TrimSpace(opts.Title)
+	if title == "" {
+		verb := "Create"
+		if mode == render.ModalModeEdit {
+			verb = "Edit"
+		}
+		title = verb + " " + titleWords(actionID)
+	}
+	return map[string]any{
+		"mode":          string(mode),
+		"action_id":     actionID,
+		"title":         title,
+		"title_id":      "fg-modal-title-" + string(mode) + "-" + actionID,
+		"value_field":   valueField,
+		"label_field":   labelField,
+		"prefill_field": firstNonEmpty(opts.PrefillField, labelField),
+		"record_url":    strings.TrimSpace(opts.RecordURL),
+		"open":          opts.Open,
+	}
+}
+
+func firstNonEmpty(value, fallback string) string {
+	if value = strings.TrimSpace(value); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// titleWords turns an action id such as "publishing-house" into
+// "Publishing House".
+func titleWords(id string) string {
+	words := strings.FieldsFunc(id, func(r rune) bool {
+		return r == '-' || r == '_' || r == '.' || unicode.IsSpace(r)
+	})
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,122 @@
+package vanilla
+
+import (
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla/components"
+)
+
+const (
+	stickyActionsHintKey = "layout.stickyActions"
+	sectionNavHintKey    = "layout.sectionNav"
+	sectionAnchorPrefix  = controlIDPrefix + "section-"
+	sectionNavLabel      = "Form sections"
+)
+
+// longFormStyles backs the sticky action bar and the section navigation. It
+// is emitted only when one of them is enabled and the output is styled.
+const longFormStyles = `
+.formgen-sticky-actions {
+  position: sticky;
+  bottom: 0;
+  z-index: 10;
+  padding-bottom: 1rem;
+  background-color: var(--bg-primary, #fff);
+}
+.formgen-section-layout {
+  display: grid;
+  gap: 2rem;
+}
+.formgen-section-nav ol {
+  list-style: none;
+  margin: 0;
+  padding: 0;
+}
+.formgen-section-nav a {
+  display: block;
+  padding: 0.25rem 0.75rem;
+  border-left: 2px solid var(--border-color, #e5e7eb);
+  font-size: 0.875rem;
+  color: inherit;
+  text-decoration: none;
+}
+.formgen-section-nav a[aria-current="true"] {
+  border-left-color: var(--formgen-accent, #2563eb);
+  font-weight: 600;
+}
+@media (min-width: 1024px) {
+  .formgen-section-layout {
+    grid-template-columns: 12rem minmax(0, 1fr);
+    align-items: start;
+  }
+  .formgen-section-nav {
+    position: sticky;
+    top: 1rem;
+  }
+}
+@media (prefers-color-scheme: dark) {
+  .formgen-sticky-actions {
+    background-color: var(--bg-primary, #0f172a);
+  }
+}
+`
+
+// buildSectionNav lists the sections for the side navigation when the form
+// sets the layout.sectionNav hint, and assigns each section the anchor its
+// link targets. It returns nil when the hint is off or there are no sections.
+func buildSectionNav(form model.FormModel, layout *layoutContext) map[string]any {
+	if !truthySectionHint(form.UIHints, sectionNavHintKey) || len(layout.Sections) == 0 {
+		return nil
+	}
+	items := make([]map[string]any, 0, len(layout.Sections))
+	for i := range layout.Sections {
+		section := &layout.Sections[i]
+		if len(section.Fields) == 0 {
+			continue
+		}
+		section.Anchor = sectionAnchorPrefix + sanitizeControlPath(section.ID)
+		title := strings.TrimSpace(section.Title)
+		if title == "" {
+			title = section.ID
+		}
+		items = append(items, map[string]any{
+			"id":       section.ID,
+			"anchor":   section.Anchor,
+			"title":    title,
+			"titleKey": section.TitleKey,
+		})
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return map[string]any{
+		"label": sectionNavLabel,
+		"items": items,
+	}
+}
+
+// stickyActionsEnabled reports whether the form asks for the sticky action
+// bar via the layout.stickyActions hint.
+func stickyActionsEnabled(form model.FormModel) bool {
+	return truthySectionHint(form.UIHints, stickyActionsHintKey)
+}
+
+// appendRuntimeScripts adds the runtime bundle and its initializer, which
+// drive scroll-spy highlighting, unless a component already requested them.
+func appendRuntimeScripts(payloads []map[string]any, resolver func(string) string) []map[string]any {
+	for _, script := range components.RuntimeScripts() {
+		script = resolveScriptAsset(script, resolver)
+		present := false
+		for _, payload := range payloads {
+			if payload["src"] == script.Src && payload["inline"] == script.Inline {
+				present = true
+				break
+			}
+		}
+		if !present {
+			payloads = append(payloads, scriptPayloads([]components.Script{script})...)
+		}
+	}
+	return payloads
+}
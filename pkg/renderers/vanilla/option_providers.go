@@ -0,0 +1,86 @@
+package vanilla
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/components/options"
+	"github.com/goliatone/go-formgen/components/timezones"
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// applyTimezoneEndpoint points timezone fields at the timezones component
+// handler (mounted at its default route) with the same search wiring as
+// formgenwiring.TimezonesEndpointOverride. Fields that already declare an
+// endpoint, for example through that override, are left untouched.
+func applyTimezoneEndpoint(metadata map[string]string) map[string]string {
+	opts := timezones.DefaultOptions()
+	return applySearchEndpoint(metadata, opts.RoutePath, opts.SearchParam, opts.LimitParam, opts.DefaultLimit, "typeahead")
+}
+
+// applyOptionDataset handles fields naming an options dataset
+// (`x-formgen: {options: countries}`): fields without declared choices are
+// seeded with the embedded dataset so the select works without the runtime,
+// and the field is wired to the dataset handler at its default route. Unknown
+// dataset names are ignored.
+func applyOptionDataset(field model.Field) model.Field {
+	name := strings.TrimSpace(field.Metadata[options.HintKey])
+	if name == "" {
+		name = strings.TrimSpace(field.UIHints[options.HintKey])
+	}
+	if name == "" || !options.IsDataset(name) {
+		return field
+	}
+	if len(field.Options) == 0 && len(field.Enum) == 0 {
+		if dataset, err := options.Dataset(name); err == nil {
+			field.Options = datasetFieldOptions(field, dataset)
+		}
+	}
+
+	opts := options.DefaultOptions()
+	renderer := "typeahead"
+	if field.Type == model.FieldTypeArray {
+		renderer = "chips"
+	}
+	field.Metadata = applySearchEndpoint(cloneMetadata(field.Metadata), options.MountPath("", name), opts.SearchParam, opts.LimitParam, opts.DefaultLimit, renderer)
+	return field
+}
+
+func datasetFieldOptions(field model.Field, dataset []options.Option) []model.Option {
+	out := make([]model.Option, 0, len(dataset)+1)
+	if field.Type != model.FieldTypeArray && field.Default == nil {
+		label := "Select an option"
+		if field.Label != "" {
+			label = "Select " + field.Label
+		}
+		out = append(out, model.Option{Value: "", Label: label})
+	}
+	for _, option := range dataset {
+		out = append(out, model.Option{Value: option.Value, Label: option.Label})
+	}
+	return out
+}
+
+// applySearchEndpoint adds relationship endpoint metadata for a component
+// search handler unless the field already declares an endpoint.
+func applySearchEndpoint(metadata map[string]string, url, searchParam, limitParam string, limit int, renderer string) map[string]string {
+	for key := range metadata {
+		if strings.HasPrefix(key, "relationship.endpoint.") {
+			return metadata
+		}
+	}
+	if metadata == nil {
+		metadata = make(map[string]string, 10)
+	}
+	metadata["relationship.endpoint.url"] = url
+	metadata["relationship.endpoint.method"] = "GET"
+	metadata["relationship.endpoint.resultsPath"] = "data"
+	metadata["relationship.endpoint.params.format"] = "options"
+	metadata["relationship.endpoint.params."+limitParam] = strconv.Itoa(limit)
+	metadata["relationship.endpoint.dynamicParams."+searchParam] = "{{self}}"
+	metadata["relationship.endpoint.mapping.value"] = "value"
+	metadata["relationship.endpoint.mapping.label"] = "label"
+	metadata["relationship.endpoint.mode"] = "search"
+	metadata["relationship.endpoint.renderer"] = renderer
+	return metadata
+}
@@ -0,0 +1,44 @@
+package vanilla
+
+import (
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/filterpreset"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// buildPresetContext resolves filter preset chips for GET forms: each links to
+// the form endpoint with the preset values as its query string, and the one
+// matching the current values is marked current. Other methods get no chips.
+func buildPresetContext(form model.FormModel, method string, presets []render.FilterPreset, values map[string]any) []map[string]any {
+	if len(presets) == 0 || !strings.EqualFold(method, "GET") {
+		return nil
+	}
+	base, _, _ := strings.Cut(form.Endpoint, "?")
+	current := filterpreset.QueryString(form, values)
+
+	out := make([]map[string]any, 0, len(presets))
+	for _, preset := range presets {
+		name := strings.TrimSpace(preset.Name)
+		if name == "" {
+			continue
+		}
+		label := strings.TrimSpace(preset.Label)
+		if label == "" {
+			label = name
+		}
+		query := filterpreset.QueryString(form, preset.Values)
+		href := base
+		if query != "" {
+			href += "?" + query
+		}
+		out = append(out, map[string]any{
+			"name":   name,
+			"label":  label,
+			"href":   href,
+			"active": query == current,
+		})
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+package vanilla
+
+import (
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// buildPreviewContext exposes RenderOptions.Preview to the form template and
+// disables destructive actions. It returns nil when preview is not requested.
+func buildPreviewContext(options *render.PreviewOptions, actions []actionButton) map[string]any {
+	if options == nil {
+		return nil
+	}
+	message := strings.TrimSpace(options.Message)
+	if message == "" {
+		message = render.DefaultPreviewMessage
+	}
+	endpoint := strings.TrimSpace(options.Endpoint)
+	if endpoint == "" {
+		endpoint = render.PreviewEndpointPath
+	}
+	for i := range actions {
+		if render.IsDestructiveAction(actions[i].Kind) {
+			actions[i].Disabled = true
+		}
+	}
+	return map[string]any{
+		"message":  message,
+		"endpoint": endpoint,
+	}
+}
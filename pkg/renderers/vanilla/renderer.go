@@ -14,6 +14,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/goliatone/go-formgen/pkg/display"
 	"github.com/goliatone/go-formgen/pkg/model"
 	"github.com/goliatone/go-formgen/pkg/render"
 	rendertemplate "github.com/goliatone/go-formgen/pkg/render/template"
@@ -32,6 +33,9 @@ type config struct {
 	stylesheets        []string
 	componentRegistry  *components.Registry
 	componentOverrides map[string]string
+	clientValidation   bool
+	displayFormatters  *display.Registry
+	strictTemplates    bool
 }
 
 // WithTemplatesFS supplies an alternate template bundle via fs.FS.
@@ -158,6 +162,10 @@ type Renderer struct {
 	stylesheets []string
 	components  *components.Registry
 	overrides   map[string]string
+	// clientValidation emits data-validate payloads and the validate runtime.
+	clientValidation bool
+	// display formats read-only and view values with displayFormat hints.
+	display *display.Registry
 }
 
 type templateRenderOptions struct {
@@ -191,11 +199,13 @@ type rendererTheme struct {
 }
 
 const (
-	dataAttributesMetadataKey  = "__data_attrs"
-	layoutSectionsMetadataKey  = "layout.sections"
-	layoutSectionFieldKey      = "layout.section"
-	layoutFieldOrderPrefix     = "layout.fieldOrder."
-	layoutActionsMetadataKey   = "actions"
+	dataAttributesMetadataKey = "__data_attrs"
+	layoutSectionsMetadataKey = "layout.sections"
+	layoutSectionFieldKey     = "layout.section"
+	layoutFieldOrderPrefix    = "layout.fieldOrder."
+	layoutActionsMetadataKey  = "actions"
+	// flagPlaceholderMetadataKey mirrors orchestrator.FeatureFlagPlaceholderKey.
+	flagPlaceholderMetadataKey = "flag.placeholder"
 	layoutGridColumnsHintKey   = "layout.gridColumns"
 	layoutGutterHintKey        = "layout.gutter"
 	fieldLayoutSpanHintKey     = "layout.span"
@@ -223,20 +233,26 @@ type layoutContext struct {
 }
 
 type sectionGroup struct {
-	ID             string          `json:"id"`
-	Title          string          `json:"title"`
-	TitleKey       string          `json:"titleKey,omitempty"`
-	Description    string          `json:"description"`
-	DescriptionKey string          `json:"descriptionKey,omitempty"`
-	Fieldset       bool            `json:"fieldset"`
-	Collapsible    bool            `json:"collapsible,omitempty"`
-	Collapsed      bool            `json:"collapsed,omitempty"`
-	Fields         []renderedField `json:"fields"`
+	ID             string `json:"id"`
+	Title          string `json:"title"`
+	TitleKey       string `json:"titleKey,omitempty"`
+	Description    string `json:"description"`
+	DescriptionKey string `json:"descriptionKey,omitempty"`
+	Fieldset       bool   `json:"fieldset"`
+	Collapsible    bool   `json:"collapsible,omitempty"`
+	Collapsed      bool   `json:"collapsed,omitempty"`
+	// Anchor is the element id targeted by the section navigation; empty
+	// unless the navigation is enabled.
+	Anchor string `json:"anchor,omitempty"`
+	// Advanced hides the whole section behind the advanced options toggle.
+	Advanced bool            `json:"advanced,omitempty"`
+	Fields   []renderedField `json:"fields"`
 }
 
 type renderedField struct {
-	HTML  string `json:"html"`
-	Style string `json:"style"`
+	HTML     string `json:"html"`
+	Style    string `json:"style"`
+	Advanced bool   `json:"advanced,omitempty"`
 }
 
 type renderedSectionField struct {
@@ -270,6 +286,18 @@ type actionButton struct {
 	Href     string `json:"href,omitempty"`
 	Type     string `json:"type,omitempty"`
 	Icon     string `json:"icon,omitempty"`
+	// Endpoint, Method, Confirm, Payload, and Redirect configure archive
+	// actions (see archive.go).
+	Endpoint string         `json:"endpoint,omitempty"`
+	Method   string         `json:"method,omitempty"`
+	Confirm  string         `json:"confirm,omitempty"`
+	Payload  map[string]any `json:"payload,omitempty"`
+	Redirect string         `json:"redirect,omitempty"`
+	// PayloadJSON is Payload encoded for the data-archive-payload attribute.
+	PayloadJSON string `json:"payloadJSON,omitempty"`
+	// Disabled renders the action inert, e.g. destructive actions in preview
+	// mode.
+	Disabled bool `json:"disabled,omitempty"`
 }
 
 // New constructs the vanilla renderer applying any provided options.
@@ -311,6 +339,12 @@ func New(options ...Option) (*Renderer, error) {
 		renderer = engine
 	}
 
+	if cfg.strictTemplates {
+		if err := lintTemplates(cfg); err != nil {
+			return nil, fmt.Errorf("vanilla renderer: lint templates: %w", err)
+		}
+	}
+
 	registry := cfg.componentRegistry
 	if registry == nil {
 		registry = components.NewDefaultRegistry()
@@ -318,12 +352,20 @@ func New(options ...Option) (*Renderer, error) {
 		registry = registry.Clone()
 	}
 
+	displayFormatters := cfg.displayFormatters
+	if displayFormatters == nil {
+		displayFormatters = display.NewRegistry()
+	}
+
 	return &Renderer{
 		templates:   renderer,
 		inlineStyle: cfg.inlineStyles,
 		stylesheets: append([]string(nil), cfg.stylesheets...),
 		components:  registry,
 		overrides:   cloneStringMap(cfg.componentOverrides),
+
+		clientValidation: cfg.clientValidation,
+		display:          displayFormatters,
 	}, nil
 }
 
@@ -335,14 +377,28 @@ func (r *Renderer) ContentType() string {
 	return "text/html; charset=utf-8"
 }
 
-func (r *Renderer) Render(_ context.Context, form model.FormModel, renderOptions render.RenderOptions) ([]byte, error) {
+func (r *Renderer) Render(ctx context.Context, form model.FormModel, renderOptions render.RenderOptions) ([]byte, error) {
 	if r.templates == nil {
 		return nil, fmt.Errorf("vanilla renderer: template renderer is nil")
 	}
+	if err := contextErr(ctx); err != nil {
+		return nil, err
+	}
 
 	render.ApplySubset(&form, renderOptions.Subset)
+	render.ApplyFieldOverrides(&form, renderOptions.FieldOverrides)
+	render.ApplyParameterFields(&form, renderOptions.Method, renderOptions.Values)
+	render.ApplyTransitions(&form, renderOptions.Values)
+	render.ApplyConcurrency(&form, &renderOptions)
 	render.LocalizeFormModel(&form, renderOptions)
 	render.RedactSensitiveDefaults(&form, renderOptions.IncludeSensitiveDefaults)
+	render.AnnotateLazySubforms(&form, renderOptions.SubformEndpoint)
+	if renderOptions.Diff != nil {
+		return r.renderDiff(form, renderOptions)
+	}
+	if model.IsView(form) {
+		return r.renderView(form, renderOptions)
+	}
 
 	topPadding := renderOptions.TopPadding
 	if topPadding == 0 {
@@ -350,29 +406,81 @@ func (r *Renderer) Render(_ context.Context, form model.FormModel, renderOptions
 	}
 
 	templateOptions := prepareRenderContext(&form, renderOptions)
+	if r.clientValidation {
+		form.Fields = applyClientValidation(form.Fields)
+	}
+	form.Fields = applyDisplayFormats(form.Fields, r.display, display.Context{})
 	decorated := decorateFormModel(form)
 	themeCtx := buildThemeContext(renderOptions.Theme)
 	assetResolver := themeAssetResolver(renderOptions.Theme)
 
 	componentRenderer := newComponentRenderer(r.templates, r.components, r.overrides, themeCtx, assetResolver, templateOptions.StyleMode)
+	componentRenderer.gridClasses = renderOptions.GridClasses
+	componentRenderer.ctx = ctx
+	componentRenderer.htmlPolicy = renderOptions.HTMLPolicy
 	layout, err := buildLayoutContext(decorated, componentRenderer)
 	if err != nil {
 		return nil, fmt.Errorf("vanilla renderer: build layout: %w", err)
 	}
 	actions := parseActions(decorated.Metadata)
+	sectionNav := buildSectionNav(decorated, &layout)
+	stickyActions := stickyActionsEnabled(decorated)
+	advanced := buildAdvancedToggle(layout)
+	preview := buildPreviewContext(renderOptions.Preview, actions)
+	flagPlaceholder := strings.TrimSpace(decorated.Metadata[flagPlaceholderMetadataKey])
+	if flagPlaceholder != "" {
+		for i := range actions {
+			actions[i].Disabled = true
+		}
+	}
 	assets := r.renderAssets(componentRenderer, renderOptions, layout, assetResolver)
+	runtimeConfig, err := buildRuntimeConfig(renderOptions)
+	if err != nil {
+		return nil, fmt.Errorf("vanilla renderer: %w", err)
+	}
+	live := buildLiveContext(renderOptions.Live)
+	if live != nil && !renderOptions.OmitAssets {
+		assets.componentScripts = append(assets.componentScripts, scriptPayloads([]components.Script{liveScript()})...)
+	}
+	if hasArchiveAction(actions) && !renderOptions.OmitAssets {
+		assets.componentScripts = append(assets.componentScripts, scriptPayloads([]components.Script{archiveScript()})...)
+	}
+	if r.clientValidation && !renderOptions.OmitAssets {
+		assets.componentScripts = append(assets.componentScripts, scriptPayloads([]components.Script{clientValidationScript()})...)
+	}
+	layoutStyles := ""
+	if (sectionNav != nil || stickyActions) && !renderOptions.OmitAssets {
+		if sectionNav != nil {
+			assets.componentScripts = appendRuntimeScripts(assets.componentScripts, assetResolver)
+		}
+		if templateOptions.StyleMode != renderStyleUnstyled {
+			layoutStyles = strings.TrimSpace(longFormStyles)
+		}
+	}
 	formTemplateName := formTemplateName(renderOptions.Theme)
 	chromeClasses := chromeClassMap(renderOptions.ChromeClasses)
+	header, headerPlacement, err := r.renderHeader(decorated, renderOptions.Header, chromeClasses, templateOptions.StyleMode, renderOptions.Theme)
+	if err != nil {
+		return nil, fmt.Errorf("vanilla renderer: %w", err)
+	}
+	slots, err := r.renderSlots(decorated, renderOptions.Slots, templateOptions.StyleMode, renderOptions.Theme)
+	if err != nil {
+		return nil, fmt.Errorf("vanilla renderer: %w", err)
+	}
 
 	result, err := r.templates.RenderTemplate(formTemplateName, map[string]any{
 		"locale":                 renderOptions.Locale,
 		"form":                   decorated,
+		"form_id":                strings.TrimSpace(renderOptions.FormID),
 		"layout":                 layout,
 		"actions":                actions,
 		"stylesheets":            assets.stylesheets,
 		"inline_styles":          assets.inlineStyles,
 		"responsive_grid_styles": assets.responsiveGridStyles,
 		"component_scripts":      assets.componentScripts,
+		"runtime_config":         runtimeConfig,
+		"anti_spam":              buildAntiSpamContext(renderOptions.AntiSpam, decorated.OperationID),
+		"draft":                  buildDraftContext(decorated),
 		"theme":                  assets.templateTheme,
 		"top_padding":            strings.Repeat("\n", topPadding),
 		"default_form_class":     DefaultFormClass,
@@ -382,6 +490,19 @@ func (r *Renderer) Render(_ context.Context, form model.FormModel, renderOptions
 		"default_actions_class":  DefaultActionsClass,
 		"default_errors_class":   DefaultErrorsClass,
 		"default_grid_class":     DefaultGridClass,
+		"header_html":            header,
+		"live":                   live,
+		"turbo":                  buildTurboContext(renderOptions.Turbo, decorated.OperationID),
+		"preview":                preview,
+		"flag_placeholder":       flagPlaceholder,
+		"section_nav":            sectionNav,
+		"sticky_actions":         stickyActions,
+		"advanced":               advanced,
+		"layout_styles":          layoutStyles,
+		"modal":                  buildModalContext(renderOptions.Variant, renderOptions.Modal, decorated.OperationID),
+		"header_placement":       string(headerPlacement),
+		"slots":                  slots,
+		"presets":                buildPresetContext(decorated, decorated.Method, renderOptions.Presets, renderOptions.Values),
 		"render_mode":            templateOptions.RenderMode,
 		"style_mode":             templateOptions.StyleMode,
 		"render_options": map[string]any{
@@ -396,6 +517,11 @@ func (r *Renderer) Render(_ context.Context, form model.FormModel, renderOptions
 			"include_hidden":  templateOptions.IncludeHidden,
 		},
 	})
+	if ctxErr := contextErr(ctx); ctxErr != nil {
+		// Field renders nested in templates surface cancellation as template
+		// errors; report the context error itself.
+		return nil, ctxErr
+	}
 	if err != nil {
 		return nil, fmt.Errorf("vanilla renderer: render template: %w", err)
 	}
@@ -405,6 +531,14 @@ func (r *Renderer) Render(_ context.Context, form model.FormModel, renderOptions
 	return []byte(result), nil
 }
 
+// contextErr reports ctx.Err(), tolerating nil contexts from direct callers.
+func contextErr(ctx context.Context) error {
+	if ctx == nil {
+		return nil
+	}
+	return ctx.Err()
+}
+
 type renderAssetBundle struct {
 	stylesheets          []string
 	inlineStyles         string
@@ -459,6 +593,7 @@ func chromeClassMap(classes *render.ChromeClasses) map[string]string {
 	chromeClasses["actions"] = strings.TrimSpace(classes.Actions)
 	chromeClasses["errors"] = strings.TrimSpace(classes.Errors)
 	chromeClasses["grid"] = strings.TrimSpace(classes.Grid)
+	chromeClasses["breadcrumbs"] = strings.TrimSpace(classes.Breadcrumbs)
 	return chromeClasses
 }
 
@@ -1318,7 +1453,7 @@ func buildLayoutContext(form model.FormModel, renderer *componentRenderer) (layo
 			return layoutContext{}, err
 		}
 		ctx.Unsectioned = fields
-		ctx.HasResponsiveGrid = responsive
+		ctx.HasResponsiveGrid = responsive || renderer.responsiveGrid
 		return ctx, nil
 	}
 
@@ -1347,6 +1482,7 @@ func buildLayoutContext(form model.FormModel, renderer *componentRenderer) (layo
 		order := sectionOrders[id]
 		group.Fields = orderRenderedFields(sectionOutputs[id], order)
 	}
+	ctx.HasResponsiveGrid = ctx.HasResponsiveGrid || renderer.responsiveGrid
 
 	return ctx, nil
 }
@@ -1380,6 +1516,7 @@ func initialiseSections(ctx *layoutContext, metas []sectionMeta) map[string]*sec
 			Fieldset:       meta.Fieldset,
 			Collapsible:    truthySectionHint(meta.UIHints, "collapsible"),
 			Collapsed:      truthySectionHint(meta.UIHints, "collapsed"),
+			Advanced:       truthySectionHint(meta.UIHints, model.AdvancedHintKey),
 		}
 		index[meta.ID] = &ctx.Sections[i]
 	}
@@ -1443,8 +1580,11 @@ func renderLayoutField(renderer *componentRenderer, field model.Field, path stri
 	if strings.TrimSpace(rendered) == "" {
 		return renderedField{}, false, false, nil
 	}
+	if renderer.gridClasses != nil {
+		return markAdvancedField(field, renderedField{HTML: rendered, Style: gridClassAttribute(field, columns, renderer.gridClasses)}), false, true, nil
+	}
 	attrs, responsive := gridWrapperAttributes(field, columns)
-	return renderedField{HTML: rendered, Style: attrs}, responsive, true, nil
+	return markAdvancedField(field, renderedField{HTML: rendered, Style: attrs}), responsive, true, nil
 }
 
 func collectSectionedFields(fields []model.Field, parentPath string) []sectionedField {
@@ -1598,6 +1738,9 @@ func parseActions(metadata map[string]string) []actionButton {
 	}
 	for i := range actions {
 		actions[i].Type = normalizeActionType(actions[i].Type)
+		if actions[i].Type == actionTypeArchive {
+			prepareArchiveAction(&actions[i])
+		}
 	}
 	return actions
 }
@@ -1611,12 +1754,14 @@ func normalizeActionType(value string) string {
 		return "button"
 	case "reset":
 		return "reset"
+	case actionTypeArchive:
+		return actionTypeArchive
 	default:
 		return "submit"
 	}
 }
 
-var responsiveGridBreakpoints = []string{"sm", "md", "lg", "xl", "2xl"}
+var responsiveGridBreakpoints = render.GridBreakpoints
 
 const responsiveGridCSS = `
 @media (min-width: 640px) {
@@ -1695,6 +1840,16 @@ func gridWrapperAttributes(field model.Field, columns int) (string, bool) {
 	return ` class="fg-grid-responsive" style="` + strings.Join(parts, "; ") + `"`, true
 }
 
+// gridClassAttribute renders field placement as utility classes so no inline
+// styles or responsive CSS block are needed.
+func gridClassAttribute(field model.Field, columns int, classes *render.GridClasses) string {
+	names := classes.ClassNames(field.UIHints, columns)
+	if len(names) == 0 {
+		return ""
+	}
+	return ` class="` + html.EscapeString(strings.Join(names, " ")) + `"`
+}
+
 func responsiveGridParts(hints map[string]string) []string {
 	parts := make([]string, 0, len(responsiveGridBreakpoints))
 	for _, breakpoint := range responsiveGridBreakpoints {
@@ -1744,11 +1899,15 @@ func gridColumnsFromHints(hints map[string]string) int {
 // supply canonical names from components.* constants. Widget hints accept a
 // limited alias set (case-insensitive): textarea, json-editor, toggle, select,
 // chips, code-editor, wysiwyg, rich-text, rich_text, file_uploader,
-// media-picker, media_picker, datetime-range, datetime_range.
+// media-picker, media_picker, datetime-range, datetime_range, daterange,
+// date-range, date_range, duration.
 func resolveComponentName(field model.Field) string {
 	if name := explicitComponentName(field); name != "" {
 		return name
 	}
+	if name := componentNameFromEnumDisplay(field); name != "" {
+		return name
+	}
 	if name := componentNameFromWidget(widgetHint(field)); name != "" {
 		return name
 	}
@@ -1756,6 +1915,9 @@ func resolveComponentName(field model.Field) string {
 	if field.Type == model.FieldTypeObject && field.Relationship == nil && len(field.Nested) == 0 {
 		return components.NameJSONEditor
 	}
+	if field.Type == model.FieldTypeObject && stringFromMap(field.Metadata, render.SubformSourceMetadataKey) != "" {
+		return components.NameSubform
+	}
 	if name := componentNameFromFieldType(field); name != "" {
 		return name
 	}
@@ -1767,6 +1929,9 @@ func componentNameFromFieldType(field model.Field) string {
 	case model.FieldTypeObject:
 		return components.NameObject
 	case model.FieldTypeArray:
+		if uiHint(field, "inputType") == "file" {
+			return components.NameInput
+		}
 		renderer := uiHint(field, "collectionRenderer")
 		if renderer == components.NameSelect || renderer == widgets.WidgetChips {
 			return components.NameSelect
@@ -1774,6 +1939,31 @@ func componentNameFromFieldType(field model.Field) string {
 		return components.NameArray
 	case model.FieldTypeBoolean:
 		return components.NameBoolean
+	case model.FieldTypeString:
+		switch strings.ToLower(strings.TrimSpace(field.Format)) {
+		case "duration":
+			return components.NameDuration
+		case "timezone":
+			return components.NameTimezone
+		}
+		return ""
+	default:
+		return ""
+	}
+}
+
+// componentNameFromEnumDisplay applies the enumDisplay hint (see
+// model.ResolveEnumDisplay) ahead of widget hints, which the widget registry
+// sets on every enum field. A select on an array enum renders a multi-select
+// instead of the array repeater.
+func componentNameFromEnumDisplay(field model.Field) string {
+	switch model.ResolveEnumDisplay(field) {
+	case model.EnumDisplayRadio:
+		return components.NameRadioGroup
+	case model.EnumDisplayCheckbox:
+		return components.NameCheckboxGroup
+	case model.EnumDisplaySelect:
+		return components.NameSelect
 	default:
 		return ""
 	}
@@ -1820,6 +2010,11 @@ var widgetComponentAliases = map[string]string{
 	components.NameFileUploader:  components.NameFileUploader,
 	components.NameDatetimeRange: components.NameDatetimeRange,
 	"datetime_range":             components.NameDatetimeRange,
+	widgets.WidgetDateRange:      components.NameDateRange,
+	"date-range":                 components.NameDateRange,
+	"date_range":                 components.NameDateRange,
+	widgets.WidgetDuration:       components.NameDuration,
+	widgets.WidgetTimezone:       components.NameTimezone,
 }
 
 func componentNameFromWidget(widget string) string {
@@ -1866,10 +2061,16 @@ func decorateFields(fields []model.Field) []model.Field {
 }
 
 func decorateField(field model.Field) model.Field {
+	field = applyOptionDataset(field)
 	metadata := cloneMetadata(field.Metadata)
 
 	metadata = appendValidationMetadata(field, metadata)
 
+	componentName := resolveComponentName(field)
+	if componentName == components.NameTimezone {
+		metadata = applyTimezoneEndpoint(metadata)
+	}
+
 	if attrs := buildDataAttributes(metadata); attrs != "" {
 		if metadata == nil {
 			metadata = make(map[string]string, 1)
@@ -1879,7 +2080,6 @@ func decorateField(field model.Field) model.Field {
 		delete(metadata, dataAttributesMetadataKey)
 	}
 
-	componentName := resolveComponentName(field)
 	if componentName != "" {
 		if metadata == nil {
 			metadata = make(map[string]string, 1)
@@ -1961,6 +2161,10 @@ func addMetadataDataAttribute(attrs map[string]string, key, value string) {
 	switch {
 	case strings.HasPrefix(key, "relationship.endpoint."):
 		addEndpointDataAttribute(attrs, key, value)
+	case key == clientValidateMetadataKey:
+		addTrimmedAttribute(attrs, "data-validate", value)
+	case key == flagPlaceholderMetadataKey:
+		addTrimmedAttribute(attrs, "data-formgen-flag-placeholder", value)
 	case key == "icon":
 		addTrimmedAttribute(attrs, "data-icon", value)
 	case key == "icon.source":
@@ -0,0 +1,69 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_AdvancedFieldsToggle(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	form := model.FormModel{
+		OperationID: "createWebhook",
+		Method:      "POST",
+		Metadata: map[string]string{
+			"layout.sections": `[{"id":"main","title":"Main","order":0},{"id":"delivery","title":"Delivery","order":1,"uiHints":{"advanced":"true"}}]`,
+		},
+		Fields: []model.Field{
+			{Name: "url", Label: "URL", Type: model.FieldTypeString, Metadata: map[string]string{"layout.section": "main"}},
+			{Name: "secret", Label: "Secret", Type: model.FieldTypeString, UIHints: map[string]string{"advanced": "true"}, Metadata: map[string]string{"layout.section": "main"}},
+			{Name: "retries", Label: "Retries", Type: model.FieldTypeInteger, Metadata: map[string]string{"layout.section": "delivery"}},
+			{Name: "timeout", Label: "Timeout", Type: model.FieldTypeInteger, Metadata: map[string]string{"layout.section": "delivery"}},
+		},
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`<section data-formgen-advanced hidden class="formgen-section">`,
+		`<div data-formgen-advanced-toggle class="flex">`,
+		`data-behavior="advancedToggle"`,
+		`aria-expanded="false"`,
+		`<span data-formgen-advanced-label>Show advanced options</span>`,
+		`dark:text-gray-300">3</span>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+	if count := strings.Count(html, "data-formgen-advanced hidden"); count != 2 {
+		t.Errorf("expected the advanced field and section to be hidden, got %d markers", count)
+	}
+	wrapper := strings.Index(html, `span 12" data-formgen-advanced hidden>`)
+	if wrapper < 0 {
+		t.Fatalf("expected a hidden wrapper for the advanced field")
+	}
+	if label := strings.Index(html[wrapper:], `<label`); label < 0 || !strings.HasPrefix(html[wrapper+label:], `<label data-formgen-chrome="label" id="fg-secret-label"`) {
+		t.Errorf("expected the hidden wrapper to hold the secret field")
+	}
+
+	plain := form
+	plain.Metadata = nil
+	plain.Fields = form.Fields[:1]
+	output, err = renderer.Render(testsupport.Context(), plain, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render plain: %v", err)
+	}
+	if strings.Contains(string(output), "data-formgen-advanced") {
+		t.Errorf("expected no advanced toggle without advanced fields")
+	}
+}
@@ -0,0 +1,56 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_AntiSpam(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	form := model.FormModel{
+		OperationID: "createComment",
+		Method:      "POST",
+		Endpoint:    "/comments",
+		Fields:      []model.Field{{Name: "body", Label: "Comment", Type: model.FieldTypeString}},
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{AntiSpam: &render.AntiSpam{
+		Honeypot:    "website_url",
+		SubmitToken: "signed.token",
+		Captcha:     &render.CaptchaSlot{Provider: "turnstile", SiteKey: "0x4AAA", ResponseField: "cf-turnstile-response"},
+	}})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`<input type="hidden" name="_formgen_ts" value="signed.token">`,
+		`<div data-formgen-honeypot aria-hidden="true"`,
+		`<label for="fg-hp-createComment-website_url">Leave this field empty</label>`,
+		`<input type="text" id="fg-hp-createComment-website_url" name="website_url" value="" tabindex="-1" autocomplete="off">`,
+		`<div data-formgen-captcha="turnstile" data-sitekey="0x4AAA" data-response-field="cf-turnstile-response"></div>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s in output:\n%s", want, html)
+		}
+	}
+	if strings.Index(html, "data-formgen-captcha") > strings.Index(html, `type="submit"`) {
+		t.Fatalf("expected captcha slot before the actions")
+	}
+
+	plain, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render plain: %v", err)
+	}
+	if strings.Contains(string(plain), "data-formgen-honeypot") || strings.Contains(string(plain), "_formgen_ts") {
+		t.Fatalf("expected no anti-spam markup without options")
+	}
+}
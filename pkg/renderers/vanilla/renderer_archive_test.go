@@ -0,0 +1,54 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_ArchiveAction(t *testing.T) {
+	form := buildFormFromJSONSchema(t, minimalJSONSchema)
+	if form.Metadata == nil {
+		form.Metadata = map[string]string{}
+	}
+	form.Metadata["actions"] = `[{"label":"Save","kind":"primary"},` +
+		`{"label":"Archive","type":"archive","endpoint":"/widgets/{id}","method":"patch","payload":{"status":"archived"},"redirect":"/widgets"}]`
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	got := string(output)
+	for _, want := range []string{
+		`<button type="button" data-formgen-action="archive"`,
+		`data-archive-method="PATCH"`,
+		`data-archive-endpoint="/widgets/{id}"`,
+		`data-archive-confirm="Archive this record?"`,
+		`data-archive-payload="{&quot;status&quot;:&quot;archived&quot;}"`,
+		`data-archive-redirect="/widgets"`,
+		`formgen:archive-success`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %s", want, got)
+		}
+	}
+
+	preview, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{Preview: &render.PreviewOptions{}, OmitAssets: true})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(string(preview), "disabled>Archive</button>") {
+		t.Fatalf("expected archive disabled in preview, got: %s", preview)
+	}
+	if strings.Contains(string(preview), "formgen:archive-success") {
+		t.Fatalf("expected OmitAssets to skip the archive script, got: %s", preview)
+	}
+}
@@ -0,0 +1,71 @@
+package vanilla_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_ArrayItemBounds(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "tagged",
+		Endpoint:    "/tagged",
+		Method:      "POST",
+		Fields: []model.Field{{
+			Name:    "tags",
+			Type:    model.FieldTypeArray,
+			Label:   "Tags",
+			UIHints: map[string]string{"cardinality": "many", "removable": "true"},
+			Items:   &model.Field{Type: model.FieldTypeString},
+			Validations: []model.ValidationRule{
+				{Kind: model.ValidationRuleMinItems, Params: map[string]string{"value": "1"}},
+				{Kind: model.ValidationRuleMaxItems, Params: map[string]string{"value": "2"}},
+			},
+		}},
+	}
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	renderTags := func(values ...any) string {
+		t.Helper()
+		output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{Values: map[string]any{"tags": values}})
+		if err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return string(output)
+	}
+	addButton := regexp.MustCompile(`<button[^>]*data-formgen-array-action="add"[^>]*>`)
+	removeButton := regexp.MustCompile(`<button[^>]*data-formgen-array-action="remove"[^>]*>`)
+	disabled := func(tag string) bool { return strings.HasSuffix(tag, " disabled>") }
+	rows := func(html string) string {
+		// Ignore the prototype row, whose remove action the runtime manages.
+		return html[:strings.Index(html, "<template")]
+	}
+
+	one := renderTags("a")
+	if !strings.Contains(one, `data-formgen-array-min-items="1" data-formgen-array-max-items="2"`) {
+		t.Fatalf("expected bound attributes, got: %s", one)
+	}
+	if removes := removeButton.FindAllString(rows(one), -1); len(removes) != 1 || !disabled(removes[0]) {
+		t.Fatalf("expected remove disabled at minItems, got %v", removes)
+	}
+	if disabled(addButton.FindString(one)) {
+		t.Fatalf("expected add enabled below maxItems, got: %s", one)
+	}
+
+	two := renderTags("a", "b")
+	if !disabled(addButton.FindString(two)) {
+		t.Fatalf("expected add disabled at maxItems, got: %s", two)
+	}
+	for _, remove := range removeButton.FindAllString(rows(two), -1) {
+		if disabled(remove) {
+			t.Fatalf("expected remove enabled above minItems, got: %s", remove)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_BooleanStyles(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createAccount",
+		Endpoint:    "/accounts",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "active", Label: "Active", Type: model.FieldTypeBoolean, Default: true,
+				UIHints: map[string]string{"booleanStyle": "switch", "trueLabel": "On", "falseLabel": "Off"}},
+			{Name: "verified", Label: "Verified", Type: model.FieldTypeBoolean, Nullable: true,
+				UIHints: map[string]string{"booleanStyle": "segmented", "nullLabel": "Unknown"}},
+			{Name: "archived", Label: "Archived", Type: model.FieldTypeBoolean, Default: false,
+				UIHints: map[string]string{"booleanStyle": "segmented"}},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{StyleMode: render.StyleModeUnstyled})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := collapseWhitespace(string(output))
+
+	for _, want := range []string{
+		`role="switch" id="fg-active" name="active" value="true" data-formgen-boolean-style="switch" checked`,
+		`<span data-formgen-switch-state="off" aria-hidden="true">Off</span>`,
+		`<span data-formgen-switch-state="on" aria-hidden="true">On</span>`,
+		`<fieldset id="fg-verified" data-formgen-boolean-style="segmented" data-formgen-boolean-tristate="true"`,
+		`<legend>Verified</legend>`,
+		`<input type="radio" id="fg-verified-null" name="verified" value="" checked >`,
+		`<span>Unknown</span>`,
+		`<input type="radio" id="fg-archived-false" name="archived" value="false" checked >`,
+		`<span>Yes</span>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, `id="fg-archived-null"`) {
+		t.Fatalf("expected no unset option for non-nullable boolean, got: %s", html)
+	}
+	if strings.Count(html, `data-formgen-chrome="label"`) != 1 {
+		t.Fatalf("expected segmented controls to use legends, got: %s", html)
+	}
+}
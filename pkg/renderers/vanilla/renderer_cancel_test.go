@@ -0,0 +1,59 @@
+package vanilla_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+// countdownContext reports cancellation after a number of Err calls.
+type countdownContext struct {
+	context.Context
+	remaining atomic.Int64
+}
+
+func (c *countdownContext) Err() error {
+	if c.remaining.Add(-1) < 0 {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestRenderer_StopsWhenContextCanceled(t *testing.T) {
+	form := buildFormFromJSONSchema(t, `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "widget",
+  "type": "object",
+  "properties": {
+    "name": { "type": "string" },
+    "sku": { "type": "string" },
+    "price": { "type": "number" }
+  }
+}`)
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	if _, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{}); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	canceled, cancel := context.WithCancel(testsupport.Context())
+	cancel()
+	if _, err := renderer.Render(canceled, form, render.RenderOptions{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled before rendering, got %v", err)
+	}
+
+	// Cancel after the entry check and the first field.
+	ctx := &countdownContext{Context: testsupport.Context()}
+	ctx.remaining.Store(2)
+	if _, err := renderer.Render(ctx, form, render.RenderOptions{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled between fields, got %v", err)
+	}
+}
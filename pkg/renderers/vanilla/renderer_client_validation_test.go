@@ -0,0 +1,83 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_ClientValidationPayload(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createUser",
+		Endpoint:    "/users",
+		Method:      "POST",
+		Fields: []model.Field{
+			{
+				Name:     "handle",
+				Label:    "Handle",
+				Type:     model.FieldTypeString,
+				Required: true,
+				Validations: []model.ValidationRule{
+					{Kind: model.ValidationRuleMinLength, Params: map[string]string{"value": "3"}},
+					{Kind: model.ValidationRulePattern, Params: map[string]string{"pattern": `^[a-z]+$`}},
+				},
+			},
+			{
+				Name:  "age",
+				Label: "Age",
+				Type:  model.FieldTypeInteger,
+				Validations: []model.ValidationRule{
+					{Kind: model.ValidationRuleMin, Params: map[string]string{"value": "0", "exclusive": "true"}},
+				},
+			},
+			{Name: "nickname", Label: "Nickname", Type: model.FieldTypeString},
+		},
+	}
+
+	renderer, err := vanilla.New(vanilla.WithClientValidation())
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+
+	for _, want := range []string{
+		`data-validate="{&#34;label&#34;:&#34;Handle&#34;,&#34;required&#34;:true,&#34;minLength&#34;:3,&#34;pattern&#34;:&#34;^[a-z]+$&#34;}"`,
+		`data-validate="{&#34;label&#34;:&#34;Age&#34;,&#34;min&#34;:0,&#34;exclusiveMin&#34;:true}"`,
+		`src="/runtime/formgen-validate.min.js"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+	if strings.Count(html, "data-validate=") != 2 {
+		t.Fatalf("expected unconstrained fields to omit data-validate")
+	}
+
+	output, err = renderer.Render(testsupport.Context(), form, render.RenderOptions{OmitAssets: true})
+	if err != nil {
+		t.Fatalf("render without assets: %v", err)
+	}
+	if strings.Contains(string(output), "formgen-validate.min.js") {
+		t.Fatalf("expected OmitAssets to skip the validate runtime")
+	}
+
+	plain, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err = plain.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render default: %v", err)
+	}
+	if strings.Contains(string(output), "data-validate=") {
+		t.Fatalf("expected data-validate only with WithClientValidation")
+	}
+}
@@ -0,0 +1,45 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_ConcurrencyField(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	form := model.FormModel{
+		OperationID: "updateArticle",
+		Method:      "PUT",
+		Endpoint:    "/articles/1",
+		Metadata:    map[string]string{model.ConcurrencyFieldMetadataKey: "version"},
+		Fields: []model.Field{
+			{Name: "title", Label: "Title", Type: model.FieldTypeString},
+			{Name: "version", Label: "Version", Type: model.FieldTypeInteger},
+		},
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Values: map[string]any{"title": "Hello", "version": 4},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	if !strings.Contains(html, `<input type="hidden" name="version" value="4">`) {
+		t.Fatalf("expected hidden version input:\n%s", html)
+	}
+	if !strings.Contains(html, `name="title"`) {
+		t.Fatalf("expected title control:\n%s", html)
+	}
+	if strings.Contains(html, `type="number"`) || strings.Contains(html, ">Version<") {
+		t.Fatalf("expected version field to leave the visible schema:\n%s", html)
+	}
+}
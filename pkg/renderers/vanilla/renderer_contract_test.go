@@ -232,6 +232,83 @@ func TestRenderer_RenderContractResponsiveGrid(t *testing.T) {
 	}
 }
 
+func TestRenderer_RenderGridClasses(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "responsiveGrid",
+		Endpoint:    "/responsive",
+		Method:      "POST",
+		Fields: []model.Field{
+			{
+				Name:  "title",
+				Type:  model.FieldTypeString,
+				Label: "Title",
+				UIHints: map[string]string{
+					"layout.span.md":  "6",
+					"layout.start.lg": "7",
+				},
+			},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		GridClasses: render.TailwindGridClasses(),
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	html := string(output)
+	if !strings.Contains(html, `class="col-span-12 md:col-span-6 lg:col-start-7"`) {
+		t.Fatalf("expected utility grid classes, got:\n%s", html)
+	}
+	if strings.Contains(html, "data-formgen-responsive-grid") || strings.Contains(html, "--fg-span") {
+		t.Fatalf("grid classes should replace inline responsive styles, got:\n%s", html)
+	}
+}
+
+func TestRenderer_RenderFlattenedObjectSection(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createOrder",
+		Endpoint:    "/orders",
+		Method:      "POST",
+		Metadata:    map[string]string{"layout.sections": `[{"id":"shipping","title":"Shipping address","order":0}]`},
+		Fields: []model.Field{
+			{
+				Name:     "shipping",
+				Type:     model.FieldTypeObject,
+				Label:    "Shipping",
+				Metadata: map[string]string{"layout.section": "shipping"},
+				UIHints:  map[string]string{"flatten": "true"},
+				Nested: []model.Field{
+					{Name: "street", Type: model.FieldTypeString, Label: "Street"},
+				},
+			},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	html := string(output)
+	if !strings.Contains(html, "Shipping address") || !strings.Contains(html, `data-formgen-flattened="true"`) {
+		t.Fatalf("expected flattened object inside its section, got:\n%s", html)
+	}
+	if strings.Contains(html, ">Shipping</legend>") {
+		t.Fatalf("flattened object should not render its own legend, got:\n%s", html)
+	}
+}
+
 func TestRenderer_RenderWithDefaultStyles(t *testing.T) {
 	form := testsupport.MustLoadFormModel(t, filepath.Join("testdata", "form_model.json"))
 
@@ -297,6 +374,47 @@ func TestRenderer_RenderModes(t *testing.T) {
 	}
 }
 
+func TestRenderer_AppliesFieldOverrides(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "overrides",
+		Endpoint:    "/overrides",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "tenant_id", Type: model.FieldTypeString, Label: "Tenant"},
+			{Name: "title", Type: model.FieldTypeString, Label: "Title"},
+		},
+	}
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		RenderMode: render.RenderModeFields,
+		FieldOverrides: map[string]render.FieldOverride{
+			"tenant_id": {Hidden: true, Default: "acme"},
+			"title":     {Label: "Headline", Readonly: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	tenant := renderedControlTagByID(t, html, "fg-tenant_id")
+	if !strings.Contains(tenant, `type="hidden"`) || !strings.Contains(tenant, `value="acme"`) {
+		t.Fatalf("expected hidden tenant input with default, got:\n%s", tenant)
+	}
+	if strings.Contains(html, `id="fg-tenant_id-label"`) {
+		t.Fatalf("expected hidden field label to be omitted:\n%s", html)
+	}
+	if !strings.Contains(html, "Headline") {
+		t.Fatalf("expected overridden label:\n%s", html)
+	}
+	if title := renderedControlTagByID(t, html, "fg-title"); !strings.Contains(title, "readonly") {
+		t.Fatalf("expected readonly title control, got:\n%s", title)
+	}
+}
+
 func TestRenderer_UnstyledModeOmitsDefaultClasses(t *testing.T) {
 	form := model.FormModel{
 		OperationID: "unstyled",
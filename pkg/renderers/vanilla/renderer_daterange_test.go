@@ -0,0 +1,57 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_DateRangeAndDurationWidgets(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createBooking",
+		Endpoint:    "/bookings",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "stay", Label: "Stay", Type: model.FieldTypeObject, UIHints: map[string]string{"widget": "daterange"},
+				Nested: []model.Field{
+					{Name: "start", Label: "Check-in", Type: model.FieldTypeString, Format: "date", Required: true, Default: "2026-03-01"},
+					{Name: "end", Label: "Check-out", Type: model.FieldTypeString, Format: "date", Default: "2026-03-05T00:00:00Z"},
+				}},
+			{Name: "timeout", Label: "Timeout", Type: model.FieldTypeString, Format: "duration", Default: "P1DT90M",
+				UIHints: map[string]string{"durationParts": "hours,minutes"}},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{StyleMode: render.StyleModeUnstyled})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := collapseWhitespace(string(output))
+
+	for _, want := range []string{
+		`data-component="daterange"`,
+		`<legend>Stay</legend>`,
+		`<label for="fg-stay-start">Check-in</label>`,
+		`<input type="date" id="fg-stay-start" name="stay.start" data-formgen-daterange-bound="start" value="2026-03-01" max="2026-03-05" required >`,
+		`<input type="date" id="fg-stay-end" name="stay.end" data-formgen-daterange-bound="end" value="2026-03-05" min="2026-03-01" >`,
+		`data-component="duration"`,
+		`<input type="hidden" name="timeout" value="P1DT90M" data-formgen-duration-value >`,
+		`id="fg-timeout-days" min="0" step="1" inputmode="numeric" data-formgen-duration-part="days" value="1"`,
+		`id="fg-timeout-minutes" min="0" step="1" inputmode="numeric" data-formgen-duration-part="minutes" value="90"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, `data-formgen-duration-part="seconds"`) {
+		t.Fatalf("expected only requested or populated duration parts, got: %s", html)
+	}
+}
@@ -0,0 +1,73 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_DiffRendersChangedAndCollapsesUnchanged(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "updateAccount",
+		Method:      "PATCH",
+		Summary:     "Update account",
+		Fields: []model.Field{
+			{Name: "plan", Label: "Plan", Type: model.FieldTypeString, Options: []model.Option{{Value: "pro", Label: "Professional"}, {Value: "free", Label: "Free"}}},
+			{Name: "name", Label: "Name", Type: model.FieldTypeString},
+			{Name: "nickname", Label: "Nickname", Type: model.FieldTypeString},
+			{Name: "apiKey", Label: "API key", Type: model.FieldTypeString, Sensitive: true},
+			{Name: "address", Label: "Address", Type: model.FieldTypeObject, Nested: []model.Field{
+				{Name: "city", Label: "City", Type: model.FieldTypeString},
+			}},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	options := render.RenderOptions{
+		StyleMode: render.StyleModeUnstyled,
+		Values:    map[string]any{"plan": "free", "name": "Ada", "nickname": "ada", "apiKey": "old-key", "address.city": "Paris"},
+		Diff: &render.DiffOptions{Proposed: map[string]any{
+			"plan": "pro", "name": "Ada", "apiKey": "new-key", "address": map[string]any{"city": "Lyon"},
+		}},
+	}
+	output, err := renderer.Render(testsupport.Context(), form, options)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := collapseWhitespace(string(output))
+
+	for _, want := range []string{
+		`<h2>Update account</h2>`,
+		`data-formgen-diff-field="plan" data-diff="changed"`,
+		`<del>Free</del><span aria-hidden="true">→</span><ins>Professional</ins>`,
+		`data-formgen-diff-field="nickname" data-diff="removed"`,
+		`<dt>Address › City</dt>`,
+		`<del>Paris</del>`,
+		`data-formgen-diff-field="apiKey" data-diff="changed"`,
+		`<summary>1 unchanged field</summary>`,
+		`data-formgen-diff-field="name" data-diff="unchanged"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, "new-key") || strings.Contains(html, "<form") {
+		t.Fatalf("expected masked read-only diff, got: %s", html)
+	}
+
+	options.Diff.HideUnchanged = true
+	hidden, err := renderer.Render(testsupport.Context(), form, options)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(string(hidden), "data-formgen-diff-unchanged") {
+		t.Fatalf("expected unchanged section to be omitted, got: %s", hidden)
+	}
+}
@@ -0,0 +1,79 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/display"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func displayFormatFields() []model.Field {
+	return []model.Field{
+		{Name: "size", Label: "Size", Type: model.FieldTypeInteger, UIHints: map[string]string{display.HintKey: display.FormatBytes}},
+		{Name: "published", Label: "Published", Type: model.FieldTypeString, UIHints: map[string]string{display.HintKey: display.FormatDate}},
+		{Name: "note", Label: "Note", Type: model.FieldTypeString, UIHints: map[string]string{display.HintKey: "truncate:5"}},
+	}
+}
+
+func TestRenderer_DisplayFormatsReadonlyPrefill(t *testing.T) {
+	form := model.FormModel{OperationID: "updateFile", Method: "PATCH", Fields: displayFormatFields()}
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		StyleMode: render.StyleModeUnstyled,
+		Values: map[string]any{
+			"size":      render.ValueWithProvenance{Value: 1536, Readonly: true},
+			"published": render.ValueWithProvenance{Value: "2026-03-04T10:00:00Z", Readonly: true},
+			"note":      "editable text",
+		},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`<p id="fg-size-display" data-formgen-display="bytes" data-display-for="fg-size">1.5 KB</p>`,
+		`data-formgen-display="date" data-display-for="fg-published">Mar 4, 2026</p>`,
+		`value="1536"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, `data-display-for="fg-note"`) {
+		t.Fatalf("expected editable fields to keep the raw value only, got: %s", html)
+	}
+}
+
+func TestRenderer_DisplayFormatsView(t *testing.T) {
+	form := model.FormModel{OperationID: "getFile", Method: "GET", Fields: displayFormatFields()}
+	model.MarkView(&form)
+
+	registry := display.NewRegistry()
+	registry.Register(display.FormatTruncate, func(_ display.Context, value any, _ string) (string, bool) {
+		return "custom", true
+	})
+	renderer, err := vanilla.New(vanilla.WithDisplayFormatters(registry))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		StyleMode: render.StyleModeUnstyled,
+		Values:    map[string]any{"size": 2097152, "note": "a long note"},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{`<dd>2 MB</dd>`, `<dd>custom</dd>`, `<dd data-empty="true">—</dd>`} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+}
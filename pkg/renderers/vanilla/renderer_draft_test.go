@@ -0,0 +1,53 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_DraftBehavior(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	form := model.FormModel{
+		OperationID: "createArticle",
+		Method:      "POST",
+		Metadata:    map[string]string{"draft.key": "article-new"},
+		Fields: []model.Field{
+			{Name: "title", Label: "Title", Type: model.FieldTypeString},
+			{Name: "token", Label: "Token", Type: model.FieldTypeString, Sensitive: true},
+		},
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	want := `data-behavior="draft" data-behavior-config="{&quot;key&quot;:&quot;article-new&quot;,&quot;exclude&quot;:[&quot;token&quot;]}">`
+	if !strings.Contains(string(output), want) {
+		t.Fatalf("expected draft behavior on the form root %s, got:\n%s", want, output)
+	}
+
+	fields, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{RenderMode: render.RenderModeFields})
+	if err != nil {
+		t.Fatalf("render fields: %v", err)
+	}
+	if !strings.Contains(string(fields), `data-formgen-render-mode="fields" data-behavior="draft"`) {
+		t.Fatalf("expected draft behavior on the fields wrapper, got:\n%s", fields)
+	}
+
+	form.Metadata = nil
+	plain, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render plain: %v", err)
+	}
+	if strings.Contains(string(plain), `data-behavior="draft"`) {
+		t.Fatalf("expected no draft behavior without metadata")
+	}
+}
@@ -0,0 +1,64 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_EnumDisplayChoiceGroups(t *testing.T) {
+	auto := map[string]string{model.EnumDisplayHintKey: "auto", "widget": "select"}
+	form := model.FormModel{
+		OperationID: "createShirt",
+		Endpoint:    "/shirts",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "size", Label: "Size", Type: model.FieldTypeString, Required: true, Enum: []any{"s", "m"}, UIHints: auto},
+			{Name: "colors", Label: "Colors", Type: model.FieldTypeArray, Description: "Pick any", UIHints: map[string]string{model.EnumDisplayHintKey: "checkbox"},
+				Items: &model.Field{Type: model.FieldTypeString, Options: []model.Option{{Value: "red", Label: "Red"}, {Value: "blue", Label: "Blue"}}}},
+			{Name: "country", Label: "Country", Type: model.FieldTypeString, Enum: []any{"a", "b", "c"}, UIHints: map[string]string{model.EnumDisplayHintKey: "auto", model.EnumDisplayMaxHintKey: "2"}},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		StyleMode: render.StyleModeUnstyled,
+		Values:    map[string]any{"size": "m", "colors": []string{"blue"}},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := collapseWhitespace(string(output))
+
+	for _, want := range []string{
+		`data-component="radio-group"`,
+		`<fieldset id="fg-size" data-formgen-choice-group="radio"`,
+		`<legend>Size<span aria-hidden="true">*</span></legend>`,
+		`<input type="radio" id="fg-size-1" name="size" value="m" checked required >`,
+		`<label for="fg-size-0">s</label>`,
+		`data-component="checkbox-group"`,
+		`<fieldset id="fg-colors" data-formgen-choice-group="checkbox"`,
+		`<input type="checkbox" id="fg-colors-1" name="colors" value="blue" checked >`,
+		`<label for="fg-colors-0">Red</label>`,
+		`Pick any`,
+		`<select id="fg-country"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+	if strings.Count(html, `data-formgen-chrome="label"`) != 1 {
+		t.Fatalf("expected legends instead of field labels, got: %s", html)
+	}
+}
+
+func collapseWhitespace(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,124 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func headerTestForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "createBook",
+		Endpoint:    "/books",
+		Method:      "POST",
+		Summary:     "Create a book",
+		Description: "Adds a **new** book.\n\n- Use the `isbn` field\n- See [docs](https://example.com/docs) or [bad](javascript:alert(1))\n\n<script>x</script>",
+		Fields:      []model.Field{{Name: "title", Type: model.FieldTypeString}},
+		UIHints:     map[string]string{"layout.title": "New Book", "layout.subtitle": "Catalog"},
+	}
+}
+
+func renderHeaderTest(t *testing.T, form model.FormModel, header *render.HeaderOptions) string {
+	t.Helper()
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{Header: header})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	return string(output)
+}
+
+func TestRenderer_HeaderPlacement(t *testing.T) {
+	form := headerTestForm()
+
+	inside := renderHeaderTest(t, form, nil)
+	if idx := strings.Index(inside, "<header"); idx < strings.Index(inside, "<form") {
+		t.Fatalf("expected header inside the form by default, got: %s", inside)
+	}
+	if strings.Contains(inside, "formgen-breadcrumbs") || strings.Contains(inside, "form-description") {
+		t.Fatalf("expected breadcrumbs and description to be opt-in, got: %s", inside)
+	}
+
+	outside := renderHeaderTest(t, form, &render.HeaderOptions{Placement: render.HeaderPlacementOutside})
+	headerIdx := strings.Index(outside, `<header class="`+vanilla.DefaultHeaderClass+`"`)
+	if headerIdx < 0 || headerIdx > strings.Index(outside, "<form") {
+		t.Fatalf("expected header before the form root, got: %s", outside)
+	}
+
+	none := renderHeaderTest(t, form, &render.HeaderOptions{Placement: render.HeaderPlacementNone})
+	if strings.Contains(none, "New Book") {
+		t.Fatalf("expected header to be suppressed, got: %s", none)
+	}
+}
+
+func TestRenderer_HeaderBreadcrumbs(t *testing.T) {
+	form := headerTestForm()
+
+	output := renderHeaderTest(t, form, &render.HeaderOptions{
+		Breadcrumbs: []render.Breadcrumb{
+			{Label: "Books", Href: "/books"},
+			{Label: "New", Href: "/books/new"},
+		},
+	})
+	for _, want := range []string{
+		`<nav aria-label="Breadcrumb" class="` + vanilla.DefaultBreadcrumbsClass + `">`,
+		`<a href="/books">Books</a>`,
+		`<span aria-current="page">New</span>`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+
+	slot := renderHeaderTest(t, form, &render.HeaderOptions{
+		BreadcrumbsHTML: `<nav data-app-crumbs>Home</nav>`,
+		Breadcrumbs:     []render.Breadcrumb{{Label: "Ignored"}},
+	})
+	if !strings.Contains(slot, `<nav data-app-crumbs>Home</nav>`) || strings.Contains(slot, "Ignored") {
+		t.Fatalf("expected breadcrumb slot markup to take precedence, got: %s", slot)
+	}
+}
+
+func TestRenderer_HeaderDescriptionMarkdown(t *testing.T) {
+	form := headerTestForm()
+
+	output := renderHeaderTest(t, form, &render.HeaderOptions{ShowDescription: true})
+	for _, want := range []string{
+		`<p>Adds a <strong>new</strong> book.</p>`,
+		`<li>Use the <code>isbn</code> field</li>`,
+		`<a href="https://example.com/docs">docs</a> or bad`,
+		`&lt;script&gt;x&lt;/script&gt;`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output, got: %s", want, output)
+		}
+	}
+	if strings.Contains(output, "javascript:") {
+		t.Fatalf("expected unsafe link to be dropped, got: %s", output)
+	}
+
+	form.Description = ""
+	form.UIHints = nil
+	summary := renderHeaderTest(t, form, &render.HeaderOptions{ShowDescription: true})
+	if !strings.Contains(summary, `<p>Create a book</p>`) {
+		t.Fatalf("expected summary fallback, got: %s", summary)
+	}
+}
+
+func TestRenderer_HeaderDescriptionEmphasisSkipsLinks(t *testing.T) {
+	form := headerTestForm()
+	form.Description = "See [x](/__init__/) and [a*b](/x) and *c*"
+
+	output := renderHeaderTest(t, form, &render.HeaderOptions{ShowDescription: true})
+	want := `<p>See <a href="/__init__/">x</a> and <a href="/x">a*b</a> and <em>c</em></p>`
+	if !strings.Contains(output, want) {
+		t.Fatalf("expected %q in output, got: %s", want, output)
+	}
+}
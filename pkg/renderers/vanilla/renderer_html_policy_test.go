@@ -0,0 +1,101 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_HTMLPolicy(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createPet",
+		Endpoint:    "/pets",
+		Method:      "POST",
+		Fields: []model.Field{{
+			Name:        "name",
+			Type:        model.FieldTypeString,
+			Label:       "Pet <em>name</em>",
+			Description: `Shown <b>publicly</b><script>alert("desc")</script>`,
+			UIHints:     map[string]string{"helpText": `See <a href="javascript:alert(1)" onclick="x()">docs</a>`},
+		}},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	renderWith := func(policy render.HTMLPolicy) string {
+		t.Helper()
+		output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{HTMLPolicy: policy, OmitAssets: true})
+		if err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return string(output)
+	}
+
+	escaped := renderWith("")
+	if !strings.Contains(escaped, "Pet &lt;em&gt;name&lt;/em&gt;") || strings.Contains(escaped, `alert("desc")</script>`) {
+		t.Fatalf("expected default policy to escape metadata, got: %s", escaped)
+	}
+
+	basic := renderWith(render.HTMLPolicyBasicFormatting)
+	for _, want := range []string{"Pet <em>name</em>", "Shown <b>publicly</b>", `<a rel="noopener noreferrer">docs</a>`} {
+		if !strings.Contains(basic, want) {
+			t.Fatalf("expected %q in basic-formatting output, got: %s", want, basic)
+		}
+	}
+	for _, banned := range []string{"alert(", "javascript:", "onclick"} {
+		if strings.Contains(basic, banned) {
+			t.Fatalf("expected %q to be stripped, got: %s", banned, basic)
+		}
+	}
+
+	if trusted := renderWith(render.HTMLPolicyRawTrusted); !strings.Contains(trusted, `<script>alert("desc")</script>`) {
+		t.Fatalf("expected raw-trusted to emit markup unchanged, got: %s", trusted)
+	}
+}
+
+func TestRenderer_HTMLPolicyIgnoresMarkupMetadata(t *testing.T) {
+	// x-formgen copies arbitrary keys into field metadata; none of them may
+	// reach the chrome partials as trusted markup.
+	payload := `<img src=x onerror=alert(1)>`
+	form := model.FormModel{
+		OperationID: "createPet",
+		Endpoint:    "/pets",
+		Method:      "POST",
+		Fields: []model.Field{{
+			Name:        "name",
+			Type:        model.FieldTypeString,
+			Label:       "Name",
+			Description: "Shown publicly",
+			UIHints:     map[string]string{"helpText": "Pick one"},
+			Metadata: map[string]string{
+				"chrome.labelHTML":       payload,
+				"chrome.descriptionHTML": payload,
+				"chrome.helpHTML":        payload,
+			},
+		}},
+	}
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	for _, policy := range []render.HTMLPolicy{"", render.HTMLPolicyEscapeAll, render.HTMLPolicyBasicFormatting} {
+		output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{HTMLPolicy: policy, OmitAssets: true})
+		if err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		if strings.Contains(string(output), "<img") {
+			t.Fatalf("policy %q emitted markup from metadata: %s", policy, output)
+		}
+		for _, want := range []string{"Name", "Shown publicly", "Pick one"} {
+			if !strings.Contains(string(output), want) {
+				t.Fatalf("policy %q: expected %q in output, got: %s", policy, want, output)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_FormIDAttribute(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createAuthor",
+		Endpoint:    "/authors",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "publisher_id", Label: "Publisher", Type: model.FieldTypeString},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	for _, mode := range []render.RenderMode{render.RenderModeForm, render.RenderModeFields} {
+		output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+			RenderMode: mode,
+			FormID:     "authorModal",
+		})
+		if err != nil {
+			t.Fatalf("render %s: %v", mode, err)
+		}
+		if !strings.Contains(string(output), `data-formgen-form-id="authorModal"`) {
+			t.Fatalf("expected form id attribute in %s mode, got: %s", mode, output)
+		}
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(string(output), "data-formgen-form-id") {
+		t.Fatalf("expected no form id attribute without FormID")
+	}
+}
@@ -0,0 +1,42 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goliatone/go-formgen/pkg/render/template/gotemplate"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+)
+
+func TestStrictTemplatesAcceptsBuiltinBundle(t *testing.T) {
+	if _, err := vanilla.New(vanilla.WithStrictTemplates()); err != nil {
+		t.Fatalf("expected built-in templates to lint cleanly: %v", err)
+	}
+	report, err := gotemplate.Lint(vanilla.TemplatesFS(), ".tmpl", vanilla.TemplateLintOptions()...)
+	if err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	if len(report.Issues) != 0 {
+		t.Fatalf("expected no issues, got %v", report.Issues)
+	}
+}
+
+func TestStrictTemplatesRejectsBrokenBundle(t *testing.T) {
+	bundle := fstest.MapFS{
+		"templates/view.tmpl": {Data: []byte(`{{ title|missing_filter }}`)},
+	}
+	_, err := vanilla.New(vanilla.WithTemplatesFS(bundle), vanilla.WithStrictTemplates())
+	if err == nil {
+		t.Fatal("expected strict mode to reject the bundle")
+	}
+	for _, want := range []string{`templates/form.tmpl: error: required entrypoint is missing`, `unknown filter "missing_filter"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected %q in %v", want, err)
+		}
+	}
+
+	if _, err := vanilla.New(vanilla.WithTemplatesFS(bundle)); err != nil {
+		t.Fatalf("expected non-strict construction to succeed: %v", err)
+	}
+}
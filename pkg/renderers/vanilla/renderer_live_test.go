@@ -0,0 +1,50 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_LiveRefreshAttributes(t *testing.T) {
+	form := buildFormFromJSONSchema(t, minimalJSONSchema)
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Live: &render.LiveOptions{
+			EventsURL:   "/formgen/events",
+			FragmentURL: "/forms/widget",
+			Version:     "abc123",
+		},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	got := string(output)
+	for _, want := range []string{
+		`data-formgen-live-events="/formgen/events"`,
+		`data-formgen-live-form="` + form.OperationID + `"`,
+		`data-formgen-live-src="/forms/widget"`,
+		`data-formgen-version="abc123"`,
+		`formgen:live-updated`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %s", want, got)
+		}
+	}
+
+	plain, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(string(plain), "data-formgen-live") {
+		t.Fatalf("expected live attributes to be opt-in")
+	}
+}
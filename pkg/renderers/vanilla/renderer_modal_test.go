@@ -0,0 +1,79 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_ModalVariantWrapsForm(t *testing.T) {
+	form := buildFormFromJSONSchema(t, minimalJSONSchema)
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Variant: render.VariantModal,
+		Modal:   &render.ModalOptions{ActionID: "publishing-house", LabelField: "title"},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	got := string(output)
+	start := strings.Index(got, `data-fg-create-modal="publishing-house"`)
+	formStart := strings.Index(got, "<form")
+	if start < 0 || formStart < start || !strings.HasSuffix(strings.TrimSpace(got[strings.Index(got, "</form>"):]), "</div>") {
+		t.Fatalf("expected form inside the modal shell, got: %s", got)
+	}
+	for _, want := range []string{
+		`role="dialog"`,
+		`aria-labelledby="fg-modal-title-create-publishing-house"`,
+		`data-fg-create-value-field="id"`,
+		`data-fg-create-label-field="title"`,
+		`data-fg-create-prefill-field="title"`,
+		`data-fg-modal-overlay`,
+		`data-fg-modal-close`,
+		`data-fg-modal-error hidden`,
+		`>Create Publishing House</h2>`,
+	} {
+		if !strings.Contains(got[:formStart], want) {
+			t.Fatalf("expected %s before the form, got: %s", want, got)
+		}
+	}
+	if tag := got[start:]; !strings.HasSuffix(tag[:strings.Index(tag, ">")+1], " hidden>") {
+		t.Fatalf("expected modal to render hidden, got: %s", got)
+	}
+
+	edit, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Variant:   render.VariantModal,
+		Modal:     &render.ModalOptions{Mode: render.ModalModeEdit, ActionID: "author", RecordURL: "/api/authors/{id}", Title: "Edit writer", Open: true},
+		StyleMode: render.StyleModeUnstyled,
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	editHTML := string(edit)
+	for _, want := range []string{`data-fg-edit-modal="author"`, `data-fg-edit-record-url="/api/authors/{id}"`, `>Edit writer</h2>`} {
+		if !strings.Contains(editHTML, want) {
+			t.Fatalf("expected %s, got: %s", want, editHTML)
+		}
+	}
+	dialog := editHTML[strings.Index(editHTML, `role="dialog"`):]
+	dialog = dialog[:strings.Index(dialog, ">")]
+	if strings.Contains(editHTML, "data-fg-create") || strings.Contains(dialog, "hidden") || strings.Contains(editHTML, "bg-slate-900/50") {
+		t.Fatalf("expected open, unstyled edit modal, got: %s", editHTML)
+	}
+
+	plain, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{Modal: &render.ModalOptions{ActionID: "author"}})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(string(plain), "data-fg-") {
+		t.Fatalf("expected modal markup to require the modal variant")
+	}
+}
@@ -0,0 +1,87 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func longForm(hints map[string]string) model.FormModel {
+	return model.FormModel{
+		OperationID: "updateSettings",
+		Method:      "PATCH",
+		UIHints:     hints,
+		Metadata: map[string]string{
+			"layout.sections": `[{"id":"general","title":"General","order":0},{"id":"billing.plan","title":"Billing plan","order":1},{"id":"empty","title":"Empty","order":2}]`,
+		},
+		Fields: []model.Field{
+			{Name: "name", Label: "Name", Type: model.FieldTypeString, Metadata: map[string]string{"layout.section": "general"}},
+			{Name: "plan", Label: "Plan", Type: model.FieldTypeString, Metadata: map[string]string{"layout.section": "billing.plan"}},
+		},
+	}
+}
+
+func TestRenderer_SectionNavAndStickyActions(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), longForm(map[string]string{
+		"layout.sectionNav":    "true",
+		"layout.stickyActions": "true",
+	}), render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`<nav data-formgen-section-nav aria-label="Form sections" class="formgen-section-nav">`,
+		`<a href="#fg-section-general" data-formgen-section-link="general">General</a>`,
+		`<a href="#fg-section-billing-plan" data-formgen-section-link="billing.plan">Billing plan</a>`,
+		`<section id="fg-section-billing-plan" data-formgen-section="billing.plan"`,
+		`<div data-formgen-sticky-actions class="formgen-sticky-actions">`,
+		`<style data-formgen-layout>`,
+		`<script src="/runtime/formgen-relationships.min.js" defer></script>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+	if strings.Contains(html, `data-formgen-section-link="empty"`) {
+		t.Errorf("sections without fields should not be listed")
+	}
+	if count := strings.Count(html, "formgen-relationships.min.js"); count != 1 {
+		t.Errorf("expected the runtime bundle once, got %d", count)
+	}
+}
+
+func TestRenderer_LongFormLayoutIsOptIn(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), longForm(nil), render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, unwanted := range []string{"data-formgen-section-nav", "data-formgen-sticky-actions", "data-formgen-layout", `id="fg-section-`} {
+		if strings.Contains(html, unwanted) {
+			t.Errorf("expected no %q without layout hints", unwanted)
+		}
+	}
+
+	unstyled, err := renderer.Render(testsupport.Context(), longForm(map[string]string{"layout.stickyActions": "true"}), render.RenderOptions{
+		StyleMode: render.StyleModeUnstyled,
+	})
+	if err != nil {
+		t.Fatalf("render unstyled: %v", err)
+	}
+	if !strings.Contains(string(unstyled), "<div data-formgen-sticky-actions>") || strings.Contains(string(unstyled), "data-formgen-layout") {
+		t.Errorf("unstyled output should keep hooks without styles")
+	}
+}
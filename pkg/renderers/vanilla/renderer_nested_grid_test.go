@@ -0,0 +1,98 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func addressForm(objectHints map[string]string) model.FormModel {
+	return model.FormModel{
+		OperationID: "createCustomer",
+		Method:      "POST",
+		Fields: []model.Field{{
+			Name:    "address",
+			Label:   "Address",
+			Type:    model.FieldTypeObject,
+			UIHints: objectHints,
+			Nested: []model.Field{
+				{Name: "street", Label: "Street", Type: model.FieldTypeString},
+				{Name: "city", Label: "City", Type: model.FieldTypeString, UIHints: map[string]string{"layout.span": "1"}},
+				{Name: "zip", Label: "ZIP", Type: model.FieldTypeString, UIHints: map[string]string{"layout.span": "1", "layout.span.md": "1"}},
+			},
+		}},
+	}
+}
+
+func TestRenderer_ObjectChildrenUseNestedGrid(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), addressForm(map[string]string{"layout.gridColumns": "2"}), render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+
+	for _, want := range []string{
+		`class="formgen-grid" style="--formgen-grid-gap: 1rem; grid-template-columns: repeat(2, minmax(0, 1fr))" data-formgen-grid-columns="2"`,
+		// Children without a span fill the nested row.
+		`<div style="grid-column: span 2 / span 2"><div`,
+		`<div style="grid-column: span 1 / span 1"><div`,
+		`class="fg-grid-responsive" style="grid-column: span 1 / span 1; --fg-span: 1; --fg-start: auto; --fg-row: auto; --fg-span-md: 1"`,
+		".fg-grid-responsive",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+}
+
+func TestRenderer_ObjectChildrenStackWithoutLayoutHints(t *testing.T) {
+	form := addressForm(nil)
+	for i := range form.Fields[0].Nested {
+		form.Fields[0].Nested[i].UIHints = nil
+	}
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(string(output), "data-formgen-grid-columns") {
+		t.Fatalf("expected stacked children without layout hints")
+	}
+}
+
+func TestRenderer_ObjectNestedGridUsesGridClasses(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), addressForm(nil), render.RenderOptions{
+		GridClasses: render.TailwindGridClasses(),
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`data-formgen-grid-columns="12"`,
+		`<div class="col-span-12"><div`,
+		`<div class="col-span-1 md:col-span-1"><div`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+	if strings.Contains(html, "fg-grid-responsive") {
+		t.Errorf("grid classes should replace responsive inline styles")
+	}
+}
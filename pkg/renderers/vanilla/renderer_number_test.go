@@ -0,0 +1,49 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_NumberUnitAndPrecision(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createParcel",
+		Endpoint:    "/parcels",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "weight", Label: "Weight", Type: model.FieldTypeNumber, Default: 2.5,
+				UIHints: map[string]string{"unit": "kg", "precision": "2"}},
+			{Name: "count", Label: "Count", Type: model.FieldTypeInteger},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{StyleMode: render.StyleModeUnstyled})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := collapseWhitespace(string(output))
+
+	for _, want := range []string{
+		`<div data-formgen-adornment="unit">`,
+		`value="2.50"`,
+		`step="0.01"`,
+		`aria-describedby="fg-weight-unit"`,
+		`<span id="fg-weight-unit" data-formgen-unit>kg</span>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+	if strings.Count(html, "data-formgen-adornment") != 1 {
+		t.Fatalf("expected no adornment without unit hint, got: %s", html)
+	}
+}
@@ -0,0 +1,60 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_FilterPresetChips(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "searchOrders",
+		Endpoint:    "/orders",
+		Method:      "GET",
+		Fields: []model.Field{
+			{Name: "status", Label: "Status", Type: model.FieldTypeString, Location: model.LocationQuery},
+			{Name: "tags", Label: "Tags", Type: model.FieldTypeArray, Items: &model.Field{Type: model.FieldTypeString}, Location: model.LocationQuery},
+		},
+	}
+	presets := []render.FilterPreset{
+		{Name: "open", Label: "Open", Values: map[string]any{"status": "open"}},
+		{Name: "rush", Values: map[string]any{"status": "open", "tags": []string{"rush", "gift wrap"}}},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		StyleMode: render.StyleModeUnstyled,
+		Presets:   presets,
+		Values:    map[string]any{"status": "open"},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := collapseWhitespace(string(output))
+
+	for _, want := range []string{
+		`<nav data-formgen-presets aria-label="Saved filters">`,
+		`href="/orders?status=open" data-formgen-preset="open" aria-current="true">Open</a>`,
+		`href="/orders?status=open&amp;tags=rush&amp;tags=gift+wrap" data-formgen-preset="rush">rush</a>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+
+	form.Method = "POST"
+	output, err = renderer.Render(testsupport.Context(), form, render.RenderOptions{Presets: presets})
+	if err != nil {
+		t.Fatalf("render post: %v", err)
+	}
+	if strings.Contains(string(output), "data-formgen-presets") {
+		t.Fatalf("expected no preset chips on POST forms")
+	}
+}
@@ -0,0 +1,67 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_PreviewBannerAndEchoEndpoint(t *testing.T) {
+	form := buildFormFromJSONSchema(t, minimalJSONSchema)
+	form.Endpoint = "/widgets"
+	if form.Metadata == nil {
+		form.Metadata = map[string]string{}
+	}
+	form.Metadata["actions"] = `[{"label":"Save","kind":"primary"},{"label":"Delete","kind":"danger","type":"submit"},{"label":"Purge","kind":"destructive","href":"/widgets/purge"}]`
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Preview: &render.PreviewOptions{},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	got := string(output)
+	for _, want := range []string{
+		`action="` + render.PreviewEndpointPath + `"`,
+		`data-formgen-preview="true"`,
+		render.DefaultPreviewMessage,
+		`disabled>Delete</button>`,
+		`aria-disabled="true">Purge</a>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in preview output, got: %s", want, got)
+		}
+	}
+	if strings.Contains(got, `action="/widgets"`) || strings.Contains(got, `href="/widgets/purge"`) {
+		t.Fatalf("expected operation endpoints to be unreachable in preview, got: %s", got)
+	}
+	if strings.Contains(got, "disabled>Save</button>") {
+		t.Fatalf("expected non-destructive actions to stay enabled, got: %s", got)
+	}
+
+	custom, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Preview: &render.PreviewOptions{Message: "Draft spec v2", Endpoint: "/review/echo"},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(string(custom), "Draft spec v2") || !strings.Contains(string(custom), `action="/review/echo"`) {
+		t.Fatalf("expected custom banner and endpoint, got: %s", custom)
+	}
+
+	plain, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(string(plain), "data-formgen-preview") || !strings.Contains(string(plain), `action="/widgets"`) {
+		t.Fatalf("expected preview markup to be opt-in, got: %s", plain)
+	}
+}
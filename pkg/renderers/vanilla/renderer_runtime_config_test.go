@@ -0,0 +1,55 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_RuntimeConfigBlock(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	form := model.FormModel{
+		OperationID: "createArticle",
+		Method:      "POST",
+		Fields:      []model.Field{{Name: "title", Label: "Title", Type: model.FieldTypeString}},
+	}
+	runtime := &render.RuntimeConfig{
+		Cache:            &render.RuntimeCacheConfig{Strategy: "none"},
+		Auth:             &render.RuntimeAuth{Strategy: "header", Header: "X-CSRF-Token", Source: "meta:csrf-token"},
+		Hooks:            []render.RuntimeHook{render.RuntimeHookValidationError},
+		SearchDebounceMs: 400,
+		Links:            []render.FieldLink{{From: "main.publisher_id", To: "modal.publisher_id"}},
+	}
+
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{Runtime: runtime})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	want := `<script id="formgen-config" type="application/json">` +
+		`{"cache":{"strategy":"none"},"auth":{"strategy":"header","header":"X-CSRF-Token","source":"meta:csrf-token"},` +
+		`"hooks":["validationError"],"searchDebounceMs":400,` +
+		`"links":[{"from":"main.publisher_id","to":"modal.publisher_id","mode":"prefill"}]}</script>`
+	if !strings.Contains(string(output), want) {
+		t.Fatalf("expected runtime config block %s, got:\n%s", want, output)
+	}
+
+	fragment, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{Runtime: runtime, OmitAssets: true})
+	if err != nil {
+		t.Fatalf("render fragment: %v", err)
+	}
+	if strings.Contains(string(fragment), "formgen-config") {
+		t.Fatalf("expected OmitAssets to skip the runtime config block, got:\n%s", fragment)
+	}
+
+	_, err = renderer.Render(testsupport.Context(), form, render.RenderOptions{Runtime: &render.RuntimeConfig{Hooks: []render.RuntimeHook{"onSubmit"}}})
+	if err == nil || !strings.Contains(err.Error(), `runtime hook "onSubmit" is not supported`) {
+		t.Fatalf("expected unsupported hook error, got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package vanilla_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	gotemplate "github.com/goliatone/go-formgen/pkg/render/template/gotemplate"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func slotTestForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "createBook",
+		Endpoint:    "/books",
+		Method:      "POST",
+		Fields:      []model.Field{{Name: "title", Type: model.FieldTypeString}},
+		UIHints:     map[string]string{"layout.title": "New Book"},
+	}
+}
+
+func TestRenderer_SlotsRenderAroundForm(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), slotTestForm(), render.RenderOptions{
+		Header: &render.HeaderOptions{Placement: render.HeaderPlacementOutside},
+		Slots: map[render.Slot]string{
+			render.SlotHeader:     `<nav id="crumbs">Books</nav>`,
+			render.SlotBeforeForm: `<p id="notice">Draft saved</p>`,
+			render.SlotAfterForm:  `<p id="help">Need help?</p>`,
+			render.SlotFooter:     `<footer id="foot">v1</footer>`,
+		},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+
+	order := []string{
+		`<div data-formgen-slot="header"><nav id="crumbs">Books</nav></div>`,
+		`New Book`,
+		`<div data-formgen-slot="before_form"><p id="notice">Draft saved</p></div>`,
+		`<form`,
+		`</form>`,
+		`<div data-formgen-slot="after_form"><p id="help">Need help?</p></div>`,
+		`<div data-formgen-slot="footer"><footer id="foot">v1</footer></div>`,
+	}
+	last := -1
+	for _, want := range order {
+		idx := strings.Index(html, want)
+		if idx <= last {
+			t.Fatalf("expected %s after previous slot content, got: %s", want, html)
+		}
+		last = idx
+	}
+
+	plain, err := renderer.Render(testsupport.Context(), slotTestForm(), render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(string(plain), "data-formgen-slot") {
+		t.Fatalf("expected no slot wrappers without slot content, got: %s", plain)
+	}
+}
+
+func TestRenderer_SlotPartialOverride(t *testing.T) {
+	engine, err := gotemplate.New(gotemplate.WithFS(vanilla.TemplatesFS()), gotemplate.WithExtension(".tmpl"))
+	if err != nil {
+		t.Fatalf("template engine: %v", err)
+	}
+	var partialData map[string]any
+	stub := &stubTemplateRenderer{
+		renderTemplateFunc: func(name string, data any, out ...io.Writer) (string, error) {
+			if name == "themes/acme/footer.tmpl" {
+				partialData, _ = data.(map[string]any)
+				return `<small id="acme-footer">Acme</small>`, nil
+			}
+			return engine.RenderTemplate(name, data, out...)
+		},
+	}
+	renderer, err := vanilla.New(vanilla.WithTemplateRenderer(stub))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	output, err := renderer.Render(testsupport.Context(), slotTestForm(), render.RenderOptions{
+		Theme: &render.ThemeConfig{Partials: map[string]string{
+			"forms.slot.footer": "themes/acme/footer.tmpl",
+		}},
+		Slots: map[render.Slot]string{render.SlotFooter: "<p>caller footer</p>"},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	if !strings.Contains(html, `<div data-formgen-slot="footer"><small id="acme-footer">Acme</small></div>`) || strings.Contains(html, "caller footer") {
+		t.Fatalf("expected theme partial to replace footer slot, got: %s", html)
+	}
+	if partialData["content"] != "<p>caller footer</p>" || partialData["slot"] != "footer" {
+		t.Fatalf("expected partial to receive slot content, got %+v", partialData)
+	}
+}
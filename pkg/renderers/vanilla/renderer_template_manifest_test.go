@@ -0,0 +1,132 @@
+package vanilla_test
+
+import (
+	"io"
+	"io/fs"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	rendertemplate "github.com/goliatone/go-formgen/pkg/render/template"
+	"github.com/goliatone/go-formgen/pkg/render/template/gotemplate"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+type keyRecordingRenderer struct {
+	rendertemplate.TemplateRenderer
+	keys map[string]map[string]bool
+}
+
+func (r *keyRecordingRenderer) RenderTemplate(name string, data any, out ...io.Writer) (string, error) {
+	if payload, ok := data.(map[string]any); ok {
+		if r.keys[name] == nil {
+			r.keys[name] = make(map[string]bool)
+		}
+		for key := range payload {
+			r.keys[name][key] = true
+		}
+	}
+	return r.TemplateRenderer.RenderTemplate(name, data, out...)
+}
+
+func TestTemplateManifest_CoversEmbeddedTemplates(t *testing.T) {
+	manifest := vanilla.TemplateManifest()
+
+	var embedded []string
+	err := fs.WalkDir(vanilla.TemplatesFS(), ".", func(name string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() && strings.HasSuffix(name, ".tmpl") {
+			embedded = append(embedded, name)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("walk templates: %v", err)
+	}
+	var documented []string
+	for _, doc := range manifest.Templates {
+		documented = append(documented, doc.Name)
+		if doc.Description == "" || len(doc.DataKeys) == 0 {
+			t.Errorf("%s: missing description or data keys", doc.Name)
+		}
+	}
+	sort.Strings(embedded)
+	if !slices.Equal(embedded, documented) {
+		t.Fatalf("manifest templates %v, embedded %v", documented, embedded)
+	}
+
+	for _, component := range manifest.Components {
+		doc, ok := manifest.Template(component.Template)
+		if !ok || !slices.Contains(doc.Components, component.Component) {
+			t.Errorf("component %s: template %s does not list it", component.Component, component.Template)
+		}
+	}
+	selectDoc, _ := manifest.Template("templates/components/select.tmpl")
+	if !slices.Equal(selectDoc.Components, []string{"select", "timezone"}) {
+		t.Fatalf("select template components: %v", selectDoc.Components)
+	}
+}
+
+func TestTemplateManifest_MatchesRenderedData(t *testing.T) {
+	engine, err := gotemplate.New(gotemplate.WithFS(vanilla.TemplatesFS()), gotemplate.WithExtension(".tmpl"))
+	if err != nil {
+		t.Fatalf("template engine: %v", err)
+	}
+	recorder := &keyRecordingRenderer{TemplateRenderer: engine, keys: make(map[string]map[string]bool)}
+	renderer, err := vanilla.New(vanilla.WithTemplateRenderer(recorder))
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+
+	form := model.FormModel{
+		OperationID: "createAccount",
+		Method:      "POST",
+		Summary:     "Create account",
+		Fields: []model.Field{
+			{Name: "name", Label: "Name", Type: model.FieldTypeString, Description: "Display name", UIHints: map[string]string{"helpText": "Shown publicly"}},
+			{Name: "seats", Label: "Seats", Type: model.FieldTypeInteger},
+			{Name: "active", Label: "Active", Type: model.FieldTypeBoolean},
+			{Name: "plan", Label: "Plan", Type: model.FieldTypeString, Enum: []any{"free", "pro"}},
+		},
+	}
+	ctx := testsupport.Context()
+	if _, err := renderer.Render(ctx, form, render.RenderOptions{}); err != nil {
+		t.Fatalf("render form: %v", err)
+	}
+	if _, err := renderer.Render(ctx, form, render.RenderOptions{
+		Values: map[string]any{"name": "Ada"},
+		Diff:   &render.DiffOptions{Proposed: map[string]any{"name": "Grace"}},
+	}); err != nil {
+		t.Fatalf("render diff: %v", err)
+	}
+	view := form
+	model.MarkView(&view)
+	if _, err := renderer.Render(ctx, view, render.RenderOptions{Values: map[string]any{"name": "Ada"}}); err != nil {
+		t.Fatalf("render view: %v", err)
+	}
+
+	manifest := vanilla.TemplateManifest()
+	for _, name := range []string{vanilla.FormTemplate, "templates/view.tmpl", "templates/diff.tmpl", "templates/components/input.tmpl", "templates/components/chrome/_label.tmpl"} {
+		if recorder.keys[name] == nil {
+			t.Errorf("%s was not rendered", name)
+		}
+	}
+	for name, keys := range recorder.keys {
+		doc, ok := manifest.Template(name)
+		if !ok {
+			t.Errorf("%s rendered but not in manifest", name)
+			continue
+		}
+		for key := range keys {
+			if !slices.Contains(doc.DataKeys, key) {
+				t.Errorf("%s: data key %q missing from manifest %v", name, key, doc.DataKeys)
+			}
+		}
+		if doc.Kind == vanilla.TemplateKindPage && len(keys) != len(doc.DataKeys) {
+			t.Errorf("%s: manifest keys %v, rendered %v", name, doc.DataKeys, keys)
+		}
+	}
+}
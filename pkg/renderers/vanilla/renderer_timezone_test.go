@@ -0,0 +1,102 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_TimezoneFieldUsesSearchEndpointAndStaticOptions(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "updateProfile",
+		Endpoint:    "/profile",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "timezone", Label: "Timezone", Type: model.FieldTypeString, Format: "timezone"},
+			{Name: "officeZone", Label: "Office", Type: model.FieldTypeString, UIHints: map[string]string{"widget": "timezone"}, Metadata: map[string]string{
+				"relationship.endpoint.url": "/tenant/api/timezones",
+			}},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		StyleMode: render.StyleModeUnstyled,
+		Values:    map[string]any{"timezone": "Europe/Paris"},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := collapseWhitespace(string(output))
+
+	for _, want := range []string{
+		`data-component="timezone"`,
+		`data-endpoint-url="/api/timezones"`,
+		`data-endpoint-dynamic-params-q="{{self}}"`,
+		`data-endpoint-params-format="options"`,
+		`data-endpoint-renderer="typeahead"`,
+		`<option value="Europe/Paris" selected>Europe/Paris</option>`,
+		`<option value="">Select Office</option>`,
+		`data-endpoint-url="/tenant/api/timezones"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s in output", want)
+		}
+	}
+	if strings.Count(html, `data-endpoint-url="/api/timezones"`) != 1 {
+		t.Fatalf("expected the explicit endpoint to be preserved")
+	}
+}
+
+func TestRenderer_OptionDatasetHintSeedsSelectAndEndpoint(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "createAddress",
+		Endpoint:    "/addresses",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "country", Label: "Country", Type: model.FieldTypeString, Metadata: map[string]string{"options": "countries"}},
+			{Name: "currency", Label: "Currency", Type: model.FieldTypeString, Enum: []any{"EUR", "USD"}, Metadata: map[string]string{"options": "currencies"}},
+			{Name: "planet", Label: "Planet", Type: model.FieldTypeString, Metadata: map[string]string{"options": "planets"}},
+		},
+	}
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		StyleMode: render.StyleModeUnstyled,
+		Values:    map[string]any{"country": "FR"},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := collapseWhitespace(string(output))
+
+	for _, want := range []string{
+		`data-endpoint-url="/api/options/countries"`,
+		`<option value="FR" selected>France</option>`,
+		`data-endpoint-url="/api/options/currencies"`,
+		`<option value="USD">USD</option>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s in output", want)
+		}
+	}
+	if strings.Contains(html, "Select Country") {
+		t.Fatalf("expected no placeholder when a value is set")
+	}
+	if strings.Contains(html, "Bolívar Soberano") {
+		t.Fatalf("expected declared enum to replace the currency dataset")
+	}
+	if strings.Contains(html, "/api/options/planets") {
+		t.Fatalf("expected unknown datasets to be ignored")
+	}
+}
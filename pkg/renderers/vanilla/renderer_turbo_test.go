@@ -0,0 +1,56 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_TurboFrameWrapsForm(t *testing.T) {
+	form := buildFormFromJSONSchema(t, minimalJSONSchema)
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Turbo: &render.TurboOptions{Target: "_top"},
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	got := string(output)
+	open := `<turbo-frame id="` + render.TurboFrameID(form.OperationID) + `">`
+	start := strings.Index(got, open)
+	formStart := strings.Index(got, "<form")
+	end := strings.Index(got, "</turbo-frame>")
+	if start < 0 || end < 0 || formStart < start || strings.Index(got, "</form>") > end {
+		t.Fatalf("expected form wrapped in %s, got: %s", open, got)
+	}
+	if !strings.Contains(got[formStart:], `data-turbo-frame="_top"`) {
+		t.Fatalf("expected data-turbo-frame target on form, got: %s", got)
+	}
+
+	custom, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Turbo:      &render.TurboOptions{FrameID: "new_pet"},
+		RenderMode: render.RenderModeFields,
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(string(custom), `<turbo-frame id="new_pet">`) || strings.Contains(string(custom), "data-turbo-frame") {
+		t.Fatalf("expected custom frame id without target, got: %s", custom)
+	}
+
+	plain, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(string(plain), "turbo-frame") {
+		t.Fatalf("expected turbo markup to be opt-in")
+	}
+}
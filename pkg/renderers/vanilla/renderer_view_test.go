@@ -0,0 +1,67 @@
+package vanilla_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+)
+
+func TestRenderer_ViewRendersValues(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "getAccount",
+		Method:      "GET",
+		Fields: []model.Field{
+			{Name: "plan", Label: "Plan", Type: model.FieldTypeString, Options: []model.Option{{Value: "pro", Label: "Professional"}}},
+			{Name: "tags", Label: "Tags", Type: model.FieldTypeArray, Items: &model.Field{Type: model.FieldTypeString}},
+			{Name: "apiKey", Label: "API key", Type: model.FieldTypeString, Sensitive: true},
+			{Name: "website", Label: "Website", Type: model.FieldTypeString, Format: "uri"},
+			{Name: "notes", Label: "Notes", Type: model.FieldTypeString},
+		},
+	}
+	model.MarkView(&form)
+
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	values := map[string]any{
+		"plan":    render.PrefillValue("pro", "billing"),
+		"tags":    []string{"vip", "beta"},
+		"apiKey":  "secret-123",
+		"website": "https://example.com",
+	}
+	output, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{
+		Values:    values,
+		StyleMode: render.StyleModeUnstyled,
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	html := string(output)
+	for _, want := range []string{
+		`<dd>Professional</dd>`,
+		`<dd>vip, beta</dd>`,
+		`<dd>••••••••</dd>`,
+		`<a href="https://example.com">https://example.com</a>`,
+		`<dd data-empty="true">—</dd>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Fatalf("expected %s, got: %s", want, html)
+		}
+	}
+	if strings.Contains(html, "secret-123") || strings.Contains(html, "class=") {
+		t.Fatalf("expected masked, unstyled view, got: %s", html)
+	}
+
+	revealed, err := renderer.Render(testsupport.Context(), form, render.RenderOptions{Values: values, IncludeSensitiveDefaults: true})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.Contains(string(revealed), "secret-123") {
+		t.Fatalf("expected sensitive value when explicitly included")
+	}
+}
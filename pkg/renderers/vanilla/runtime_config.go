@@ -0,0 +1,17 @@
+package vanilla
+
+import "github.com/goliatone/go-formgen/pkg/render"
+
+// buildRuntimeConfig encodes RenderOptions.Runtime for the formgen-config
+// block. It returns an empty string when no runtime config is set or assets
+// are omitted, since embedded fragments rely on the host page's block.
+func buildRuntimeConfig(options render.RenderOptions) (string, error) {
+	if options.Runtime == nil || options.OmitAssets {
+		return "", nil
+	}
+	data, err := render.RuntimeConfigJSON(*options.Runtime)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
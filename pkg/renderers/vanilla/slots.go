@@ -0,0 +1,51 @@
+package vanilla
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const slotPartialPrefix = "forms.slot."
+
+var pageSlots = []render.Slot{
+	render.SlotHeader,
+	render.SlotBeforeForm,
+	render.SlotAfterForm,
+	render.SlotFooter,
+}
+
+// renderSlots resolves page-shell slot markup keyed by slot name. Content from
+// RenderOptions.Slots is trusted as-is; a "forms.slot.<name>" theme partial
+// replaces it and receives that content as "content". Empty slots are omitted.
+func (r *Renderer) renderSlots(form model.FormModel, slots map[render.Slot]string, styleMode renderStyleMode, theme *render.ThemeConfig) (map[string]string, error) {
+	out := make(map[string]string, len(pageSlots))
+	for _, slot := range pageSlots {
+		content := strings.TrimSpace(slots[slot])
+		if partial := slotTemplateName(theme, slot); partial != "" {
+			rendered, err := r.templates.RenderTemplate(partial, map[string]any{
+				"slot":     string(slot),
+				"content":  content,
+				"form":     form,
+				"unstyled": styleMode == renderStyleUnstyled,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("render %s slot: %w", slot, err)
+			}
+			content = strings.TrimSpace(rendered)
+		}
+		if content != "" {
+			out[string(slot)] = content
+		}
+	}
+	return out, nil
+}
+
+func slotTemplateName(cfg *render.ThemeConfig, slot render.Slot) string {
+	if cfg == nil {
+		return ""
+	}
+	return strings.TrimSpace(cfg.Partials[slotPartialPrefix+string(slot)])
+}
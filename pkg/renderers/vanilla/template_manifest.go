@@ -0,0 +1,203 @@
+package vanilla
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla/components"
+)
+
+// TemplateKind groups embedded templates by how the renderer uses them.
+type TemplateKind string
+
+const (
+	// TemplateKindPage templates produce a whole document (form, view, diff).
+	TemplateKindPage TemplateKind = "page"
+	// TemplateKindComponent templates render one field control.
+	TemplateKindComponent TemplateKind = "component"
+	// TemplateKindChrome templates render label, description, help, and header
+	// markup around controls.
+	TemplateKindChrome TemplateKind = "chrome"
+	// TemplateKindReference templates are not rendered by the built-in
+	// components; they are starting points for custom container components.
+	TemplateKindReference TemplateKind = "reference"
+)
+
+// TemplateDoc describes one embedded template and the data it receives.
+type TemplateDoc struct {
+	// Name is the path inside TemplatesFS, e.g. "templates/form.tmpl". Custom
+	// bundles override a template by providing the same path.
+	Name        string       `json:"name"`
+	Kind        TemplateKind `json:"kind"`
+	Description string       `json:"description"`
+	// ThemePartial is the render.ThemeConfig.Partials key that swaps in a
+	// different template, when one exists.
+	ThemePartial string `json:"themePartial,omitempty"`
+	// DataKeys lists the top-level keys of the template data, sorted.
+	DataKeys []string `json:"dataKeys"`
+	// ContextKeys lists the keys of the nested "context" map passed to chrome
+	// partials. Keys are only present when they apply to the field.
+	ContextKeys []string `json:"contextKeys,omitempty"`
+	// Components lists the component names rendered with this template.
+	Components []string `json:"components,omitempty"`
+}
+
+// ComponentTemplate maps a built-in component name to its template.
+type ComponentTemplate struct {
+	Component    string `json:"component"`
+	Template     string `json:"template"`
+	ThemePartial string `json:"themePartial,omitempty"`
+}
+
+// TemplateBundle documents the embedded template bundle.
+type TemplateBundle struct {
+	Templates []TemplateDoc `json:"templates"`
+	// Components maps template-backed component names to their templates.
+	// Components absent here write their markup in Go.
+	Components []ComponentTemplate `json:"components"`
+}
+
+// Template returns the entry for name.
+func (m TemplateBundle) Template(name string) (TemplateDoc, bool) {
+	for _, doc := range m.Templates {
+		if doc.Name == name {
+			return doc, true
+		}
+	}
+	return TemplateDoc{}, false
+}
+
+var componentDataKeys = []string{"config", "control_value", "enum_options", "field", "has_value", "style_mode", "theme"}
+
+// componentExtraKeys lists payload keys specific to one template.
+var componentExtraKeys = map[string][]string{
+	"templates/components/input.tmpl":     {"number"},
+	"templates/components/boolean.tmpl":   {"boolean"},
+	"templates/components/daterange.tmpl": {"range"},
+	"templates/components/duration.tmpl":  {"duration"},
+}
+
+var jsonEditorDataKeys = []string{
+	"active_view_from_payload", "collapsed", "config", "example", "field", "mode",
+	"schema_hint", "show_gui", "show_raw", "show_toggle", "theme", "valid", "value",
+}
+
+var chromeContextKeys = []string{
+	"controlID", "descriptionHTML", "descriptionID", "helpHTML", "helpID",
+	"labelHTML", "labelID", "labelTarget", "visuallyHiddenLabel",
+}
+
+var pageTemplateDocs = []TemplateDoc{
+	{
+		Name:         FormTemplate,
+		Kind:         TemplateKindPage,
+		Description:  "Form document: header, sections, fields, actions, and assets. Fields arrive pre-rendered in layout.",
+		ThemePartial: "forms.form",
+		DataKeys: []string{
+			"actions", "advanced", "anti_spam", "component_scripts", "default_actions_class", "default_errors_class",
+			"default_fieldset_class", "default_form_class", "default_grid_class",
+			"default_header_class", "default_section_class", "draft", "flag_placeholder", "form",
+			"form_id", "header_html", "header_placement", "inline_styles", "layout",
+			"layout_styles", "live", "locale", "modal", "presets", "preview", "render_mode",
+			"render_options", "responsive_grid_styles", "runtime_config", "section_nav", "slots",
+			"sticky_actions", "style_mode", "stylesheets", "theme", "top_padding", "turbo",
+		},
+	},
+	{
+		Name:        viewTemplate,
+		Kind:        TemplateKindPage,
+		Description: "Read-only detail view; rows are flattened label/value pairs.",
+		DataKeys:    []string{"empty_value", "form", "rows", "stylesheets", "title", "unstyled"},
+	},
+	{
+		Name:        diffTemplate,
+		Kind:        TemplateKindPage,
+		Description: "Change review comparing current and proposed values.",
+		DataKeys: []string{
+			"changed", "changed_count", "empty_value", "form", "stylesheets", "title",
+			"unchanged", "unchanged_count", "unstyled",
+		},
+	},
+}
+
+var chromeTemplateDocs = []TemplateDoc{
+	{Name: chromeLabelTemplate, Description: "Field label.", ContextKeys: chromeContextKeys},
+	{Name: chromeDescriptionTemplate, Description: "Field description below the label.", ContextKeys: chromeContextKeys},
+	{Name: chromeHelpTemplate, Description: "Help text from the helpText UI hint.", ContextKeys: chromeContextKeys},
+	{Name: chromeHeaderTemplate, Description: "Form header: title, subtitle, breadcrumbs, description.", ThemePartial: "forms.header"},
+}
+
+var referenceTemplateDocs = []TemplateDoc{
+	{Name: "templates/components/array.tmpl", Description: "Repeater markup; calls render_child(field.items)."},
+	{Name: "templates/components/object.tmpl", Description: "Fieldset markup; calls render_child for each nested field."},
+	{Name: "templates/components/datetime_range.tmpl", Description: "Start/end pair; calls render_child for each nested field."},
+}
+
+// TemplateManifest describes every embedded template: the data keys it
+// receives, the theme partial that replaces it, and which built-in components
+// render it. It documents the contract for custom template bundles.
+func TemplateManifest() TemplateBundle {
+	docs := make(map[string]*TemplateDoc)
+	var manifest TemplateBundle
+	for _, doc := range pageTemplateDocs {
+		doc.DataKeys = slices.Clone(doc.DataKeys)
+		manifest.Templates = append(manifest.Templates, doc)
+	}
+	for _, doc := range chromeTemplateDocs {
+		doc.Kind = TemplateKindChrome
+		doc.ContextKeys = slices.Clone(doc.ContextKeys)
+		if doc.Name == chromeHeaderTemplate {
+			doc.DataKeys = []string{"header", "unstyled"}
+		} else {
+			doc.DataKeys = []string{"context", "field"}
+		}
+		manifest.Templates = append(manifest.Templates, doc)
+	}
+
+	for _, binding := range components.DefaultTemplateBindings() {
+		manifest.Components = append(manifest.Components, ComponentTemplate{
+			Component:    binding.Component,
+			Template:     binding.Template,
+			ThemePartial: binding.ThemePartial,
+		})
+		doc, ok := docs[binding.Template]
+		if !ok {
+			doc = &TemplateDoc{Name: binding.Template, Kind: TemplateKindComponent, ThemePartial: binding.ThemePartial}
+			if binding.Component == components.NameJSONEditor {
+				doc.DataKeys = slices.Clone(jsonEditorDataKeys)
+			} else {
+				doc.DataKeys = append(slices.Clone(componentDataKeys), componentExtraKeys[binding.Template]...)
+				sort.Strings(doc.DataKeys)
+			}
+			docs[binding.Template] = doc
+		} else {
+			// Shared templates (select serves timezone too) have no single
+			// partial key.
+			doc.ThemePartial = ""
+		}
+		doc.Components = append(doc.Components, binding.Component)
+	}
+	for _, doc := range docs {
+		doc.Description = componentsDescription(doc.Components)
+		manifest.Templates = append(manifest.Templates, *doc)
+	}
+
+	for _, doc := range referenceTemplateDocs {
+		doc.Kind = TemplateKindReference
+		doc.DataKeys = []string{"field", "render_child"}
+		manifest.Templates = append(manifest.Templates, doc)
+	}
+
+	sort.SliceStable(manifest.Templates, func(i, j int) bool {
+		return manifest.Templates[i].Name < manifest.Templates[j].Name
+	})
+	return manifest
+}
+
+func componentsDescription(names []string) string {
+	if len(names) == 1 {
+		return "Control for the " + names[0] + " component."
+	}
+	return "Control for the " + strings.Join(names, " and ") + " components."
+}
@@ -0,0 +1,23 @@
+package vanilla
+
+import (
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// buildTurboContext exposes RenderOptions.Turbo to the form template. It
+// returns nil when Turbo output is not requested.
+func buildTurboContext(options *render.TurboOptions, operationID string) map[string]any {
+	if options == nil {
+		return nil
+	}
+	frameID := strings.TrimSpace(options.FrameID)
+	if frameID == "" {
+		frameID = render.TurboFrameID(operationID)
+	}
+	return map[string]any{
+		"frame_id": frameID,
+		"target":   strings.TrimSpace(options.Target),
+	}
+}
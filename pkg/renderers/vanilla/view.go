@@ -0,0 +1,229 @@
+package vanilla
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/display"
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const (
+	viewTemplate       = "templates/view.tmpl"
+	viewEmptyValue     = "—"
+	viewSensitiveValue = "••••••••"
+)
+
+// renderView renders a detail view model (see model.MarkView): a definition
+// list of the record in RenderOptions.Values instead of form controls.
+func (r *Renderer) renderView(form model.FormModel, renderOptions render.RenderOptions) ([]byte, error) {
+	unstyled := renderOptions.StyleMode == render.StyleModeUnstyled
+	var stylesheets []string
+	if !unstyled && !renderOptions.OmitAssets {
+		stylesheets = resolveAssets(append([]string(nil), r.stylesheets...), themeAssetResolver(renderOptions.Theme))
+	}
+	title := strings.TrimSpace(form.UIHints[layoutTitleHint])
+	if title == "" {
+		title = strings.TrimSpace(form.Summary)
+	}
+	values := expandViewValues(renderOptions.Values)
+	result, err := r.templates.RenderTemplate(viewTemplate, map[string]any{
+		"form":        form,
+		"title":       title,
+		"rows":        viewRows(form.Fields, values, "", 0, renderOptions.IncludeSensitiveDefaults, r.display),
+		"stylesheets": stylesheets,
+		"unstyled":    unstyled,
+		"empty_value": viewEmptyValue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("vanilla renderer: render view: %w", err)
+	}
+	return []byte(result), nil
+}
+
+// viewRows flattens fields into template rows. Nested objects and array items
+// become group rows followed by their fields one level deeper. Scalar values
+// with a displayFormat hint go through formatters.
+func viewRows(fields []model.Field, values map[string]any, parentPath string, depth int, includeSensitive bool, formatters *display.Registry) []map[string]any {
+	var rows []map[string]any
+	for _, field := range fields {
+		path := joinPath(parentPath, field.Name)
+		label := viewLabel(field)
+		raw := unwrapViewValue(values[field.Name])
+
+		switch {
+		case field.Type == model.FieldTypeObject && len(field.Nested) > 0:
+			nested := viewMap(raw)
+			rows = append(rows, viewGroup(label, path, depth, nested == nil))
+			if nested != nil {
+				rows = append(rows, viewRows(field.Nested, nested, path, depth+1, includeSensitive, formatters)...)
+			}
+		case field.Type == model.FieldTypeArray && field.Items != nil && len(field.Items.Nested) > 0:
+			items, _ := unwrapViewValue(raw).([]any)
+			rows = append(rows, viewGroup(label, path, depth, len(items) == 0))
+			for idx, item := range items {
+				itemPath := fmt.Sprintf("%s.%d", path, idx)
+				rows = append(rows, viewGroup(fmt.Sprintf("%s %d", label, idx+1), itemPath, depth+1, false))
+				rows = append(rows, viewRows(field.Items.Nested, viewMap(item), itemPath, depth+2, includeSensitive, formatters)...)
+			}
+		default:
+			text, href := formatViewValue(field, raw, includeSensitive)
+			if spec := strings.TrimSpace(field.UIHints[display.HintKey]); spec != "" && text != "" && text != viewSensitiveValue {
+				if formatted, ok := formatters.Format(display.Context{}, spec, unwrapViewValue(raw)); ok {
+					text = formatted
+				}
+			}
+			rows = append(rows, map[string]any{
+				"label": label,
+				"path":  path,
+				"value": text,
+				"href":  href,
+				"empty": text == "",
+				"depth": strconv.Itoa(depth),
+			})
+		}
+	}
+	return rows
+}
+
+func viewGroup(label, path string, depth int, empty bool) map[string]any {
+	return map[string]any{
+		"label": label,
+		"path":  path,
+		"group": true,
+		"empty": empty,
+		"depth": strconv.Itoa(depth),
+	}
+}
+
+func viewLabel(field model.Field) string {
+	if label := strings.TrimSpace(field.Label); label != "" {
+		return label
+	}
+	return field.Name
+}
+
+// formatViewValue returns the display text for a scalar or scalar array value
+// and, for uri and email fields, a link target.
+func formatViewValue(field model.Field, raw any, includeSensitive bool) (string, string) {
+	raw = unwrapViewValue(raw)
+	if raw == nil || raw == "" {
+		return "", ""
+	}
+	if field.Sensitive && !includeSensitive {
+		return viewSensitiveValue, ""
+	}
+	switch typed := raw.(type) {
+	case []any:
+		item := field
+		if field.Items != nil {
+			item = *field.Items
+		}
+		parts := make([]string, 0, len(typed))
+		for _, value := range typed {
+			if text, _ := formatViewValue(item, value, includeSensitive); text != "" {
+				parts = append(parts, text)
+			}
+		}
+		return strings.Join(parts, ", "), ""
+	case []string:
+		values := make([]any, len(typed))
+		for i, value := range typed {
+			values[i] = value
+		}
+		return formatViewValue(field, values, includeSensitive)
+	case bool:
+		if typed {
+			return "Yes", ""
+		}
+		return "No", ""
+	case map[string]any, map[string]string:
+		encoded, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed), ""
+		}
+		return string(encoded), ""
+	}
+
+	text := fmt.Sprint(raw)
+	for _, option := range field.Options {
+		if fmt.Sprint(option.Value) == text && strings.TrimSpace(option.Label) != "" {
+			return option.Label, ""
+		}
+	}
+	switch field.Format {
+	case "email":
+		return text, "mailto:" + text
+	case "uri", "url":
+		if strings.HasPrefix(text, "http://") || strings.HasPrefix(text, "https://") {
+			return text, text
+		}
+	}
+	return text, ""
+}
+
+func unwrapViewValue(value any) any {
+	switch typed := value.(type) {
+	case render.ValueWithProvenance:
+		return typed.Value
+	case *render.ValueWithProvenance:
+		if typed == nil {
+			return nil
+		}
+		return typed.Value
+	}
+	return value
+}
+
+func viewMap(value any) map[string]any {
+	switch typed := unwrapViewValue(value).(type) {
+	case map[string]any:
+		return typed
+	case map[string]string:
+		out := make(map[string]any, len(typed))
+		for key, val := range typed {
+			out[key] = val
+		}
+		return out
+	}
+	return nil
+}
+
+// expandViewValues turns dotted keys ("author.name") into nested maps so views
+// accept the same value shapes as prefill. Caller maps are copied, not
+// modified.
+func expandViewValues(values map[string]any) map[string]any {
+	out := make(map[string]any, len(values))
+	for key, value := range values {
+		segments := strings.Split(strings.TrimSpace(key), ".")
+		target := out
+		for _, segment := range segments[:len(segments)-1] {
+			next := copyViewMap(viewMap(target[segment]))
+			target[segment] = next
+			target = next
+		}
+		last := segments[len(segments)-1]
+		existing, incoming := viewMap(target[last]), viewMap(value)
+		if existing != nil && incoming != nil {
+			merged := copyViewMap(existing)
+			for k, v := range incoming {
+				merged[k] = v
+			}
+			target[last] = merged
+			continue
+		}
+		target[last] = value
+	}
+	return out
+}
+
+func copyViewMap(in map[string]any) map[string]any {
+	out := make(map[string]any, len(in))
+	for key, value := range in {
+		out[key] = value
+	}
+	return out
+}
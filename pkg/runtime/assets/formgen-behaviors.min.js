@@ -2,5 +2,5 @@
  * formgen relationship runtime
  * DO NOT EDIT: generated via `npm run build`
  */
-"use strict";var FormgenBehaviors=(()=>{var B=Object.defineProperty;var se=Object.getOwnPropertyDescriptor;var le=Object.getOwnPropertyNames;var ue=Object.prototype.hasOwnProperty;var de=(e,t)=>{for(var r in t)B(e,r,{get:t[r],enumerable:!0})},ce=(e,t,r,n)=>{if(t&&typeof t=="object"||typeof t=="function")for(let o of le(t))!ue.call(e,o)&&o!==r&&B(e,o,{get:()=>t[o],enumerable:!(n=se(t,o))||n.enumerable});return e};var fe=e=>ce(B({},"__esModule",{value:!0}),e);var De={};de(De,{__resetBehaviorsForTests:()=>Ve,autoResize:()=>F,autoSlug:()=>J,initBehaviors:()=>qe,initIcons:()=>j,initJSONEditors:()=>w,registerBehavior:()=>C,registerIconProvider:()=>D,slugify:()=>L});function L(e){return e?e.normalize("NFKD").replace(/[\u0300-\u036f]/g,"").replace(/[^a-zA-Z0-9\s-]/g," ").trim().replace(/[\s_-]+/g,"-").replace(/^-+|-+$/g,"").toLowerCase():""}function N(e){var t;return(t=e==null?void 0:e.trim().toLowerCase())!=null?t:""}function W(e){let t=(e instanceof Document,e),r=Array.from(t.querySelectorAll("[data-behavior]"));return e instanceof HTMLElement&&e.hasAttribute("data-behavior")&&r.unshift(e),r}function G(e){if(!e)return[];let t=e.split(/[\s,]+/).map(r=>N(r)).filter(Boolean);return Array.from(new Set(t))}function K(e){if(e)try{return JSON.parse(e)}catch(t){console.warn("[formgen:behaviors] failed to parse data-behavior-config:",t);return}}function X(e,t,r){if(e&&typeof e=="object"&&e!==null){let n=e;return Object.prototype.hasOwnProperty.call(n,t)?n[t]:r===1?e:void 0}if(r===1)return e}function Z(e,t){var n,o,i;let r=e.closest("[data-formgen-auto-init]");return r||(t instanceof HTMLElement?t:(i=(o=t.body)!=null?o:(n=e.ownerDocument)==null?void 0:n.body)!=null?i:e)}function pe(e){return!!e&&(e instanceof HTMLInputElement||e instanceof HTMLTextAreaElement)}function A(e){return pe(e)?e:e.querySelector("input, textarea")}function Y(e,t){var o;if(!t)return null;let r=`[name="${t}"]`,n=`#${me(t)}`;return(o=e.querySelector(r))!=null?o:e.querySelector(n)}function me(e){let t=e.replace(/[^a-zA-Z0-9_-]/g,"-");return t.startsWith("fg-")?t:`fg-${t}`}var J=({element:e,config:t,root:r})=>{let n=A(e);if(!n){console.warn("[formgen:behaviors] autoSlug requires an input or textarea target.");return}let o=ye(t);if(!o.source){console.warn("[formgen:behaviors] autoSlug config must define a source field.");return}let i=Y(r,o.source);if(!i){console.warn(`[formgen:behaviors] source field "${o.source}" not found for autoSlug.`);return}let p=!1,u=e.getAttribute("data-behavior-state")==="manual";!u&&n.value.trim().length>0&&(u=!0,e.setAttribute("data-behavior-state","manual"));let a=()=>{if(u)return;let d=L(i.value||"");d!==n.value&&(p=!0,n.value=d,n.dispatchEvent(new Event("input",{bubbles:!0})),p=!1)},s=()=>{a()},l=d=>{if(p)return;if(n.value.trim().length===0){u=!1,e.removeAttribute("data-behavior-state"),a();return}u=!0,e.setAttribute("data-behavior-state","manual")};return i.addEventListener("input",s),n.addEventListener("input",l),a(),()=>{i.removeEventListener("input",s),n.removeEventListener("input",l)}};function ye(e){if(typeof e=="string")return{source:e};if(e&&typeof e=="object"){let t=e;return{source:typeof t.source=="string"?t.source:void 0}}return{}}var F=({element:e,config:t})=>{let r=A(e);if(!(r instanceof HTMLTextAreaElement)){console.warn("[formgen:behaviors] autoResize requires a textarea target.");return}let n=ge(t),o=be(n),i=()=>{var x;let u=window.getComputedStyle(r),a=ve(u);if(!a)return;let s=parseFloat(u.paddingTop||"0")||0,l=parseFloat(u.paddingBottom||"0")||0,d=parseFloat(u.borderTopWidth||"0")||0,y=parseFloat(u.borderBottomWidth||"0")||0,f=s+l+d+y;r.style.height="auto";let b=(x=o.minRows)!=null?x:r.rows,g=o.maxRows,c=b?a*b+f:void 0,m=g?a*g+f:void 0,v=r.scrollHeight;c!==void 0&&v<c&&(v=c),m!==void 0&&v>m&&(v=m),r.style.height=`${Math.ceil(v)}px`,o.minRows!==void 0&&(r.rows=o.minRows)},p=()=>i();return r.addEventListener("input",p),i(),()=>{r.removeEventListener("input",p)}};function ge(e){if(!e||typeof e!="object")return{};let t=e;return{minRows:Q(t.minRows),maxRows:Q(t.maxRows)}}function Q(e){let t=typeof e=="number"?e:typeof e=="string"?Number.parseInt(e,10):NaN;if(!Number.isFinite(t))return;let r=Math.floor(t);if(!(r<=0))return r}function be(e){let t=e.minRows,r=e.maxRows;return t!==void 0&&r!==void 0&&r<t?{minRows:t,maxRows:t}:e}function ve(e){let t=e.lineHeight;if(t&&t!=="normal"){let n=Number.parseFloat(t);if(Number.isFinite(n)&&n>0)return n}let r=Number.parseFloat(e.fontSize||"");if(Number.isFinite(r)&&r>0)return r*1.2}var q=new Map,S=new WeakMap;function C(e,t){let r=N(e);!r||typeof t!="function"||q.set(r,t)}function U(e=document){let t=W(e),r=[];for(let n of t){let o=G(n.getAttribute("data-behavior"));if(o.length===0)continue;let i=K(n.getAttribute("data-behavior-config")),p=Z(n,e);for(let u of o){let a=N(u);if(!a||xe(n,a))continue;let s=q.get(a);if(!s){console.warn(`[formgen:behaviors] behavior "${a}" is not registered.`);continue}let l=X(i,a,o.length),d=he(s,{element:n,name:a,root:p,config:l});Se(n,a,d),r.push({element:n,name:a,dispose:d})}}return{records:r,dispose:()=>{for(let n of r.splice(0)){if(n.dispose)try{n.dispose()}catch(o){console.warn(`[formgen:behaviors] dispose failed for ${n.name}:`,o)}Te(n.element,n.name)}}}}function ee(){q.clear(),S=new WeakMap}function he(e,t){let r;try{r=e(t)}catch(n){console.warn(`[formgen:behaviors] factory for "${t.name}" failed:`,n);return}if(typeof r=="function")return r;if(r&&typeof r=="object"&&typeof r.dispose=="function")return()=>r.dispose()}function Ee(e){let t=S.get(e);return t||(t=new Map,S.set(e,t)),t}function xe(e,t){let r=S.get(e);return r?r.has(t):!1}function Se(e,t,r){Ee(e).set(t,r)}function Te(e,t){let r=S.get(e);r&&(r.delete(t),r.size===0&&S.delete(e))}var V=new Map;function D(e,t){let r=M(e);!r||typeof t!="function"||V.set(r,t)}function j(e=document){var n,o;let t=we(e),r=[];for(let i of t){let p=M(i.getAttribute("data-icon")),u=M(i.getAttribute("data-icon-source"));if(!p||!u)continue;if(M(i.getAttribute("data-icon-raw"))!==""){r.push({element:i,name:p,source:u,rendered:!1});continue}let s=V.get(u);if(!s){r.push({element:i,name:p,source:u,rendered:!1});continue}let l=Ne(s,p),d=Ae(l,(n=i.ownerDocument)!=null?n:document);if(!d){r.push({element:i,name:p,source:u,rendered:!1});continue}let y=Le(i);if(!y){r.push({element:i,name:p,source:u,rendered:!1});continue}for(;y.firstChild;)y.removeChild(y.firstChild);let f=((o=i.ownerDocument)!=null?o:document).createElement("span");f.className="inline-flex size-5 text-current",f.setAttribute("aria-hidden","true"),f.appendChild(d),y.appendChild(f),r.push({element:i,name:p,source:u,rendered:!0})}return{records:r}}function P(){V.clear()}function we(e){let t=(e instanceof Document,e),r=Array.from(t.querySelectorAll("[data-icon][data-icon-source]"));return e instanceof HTMLElement&&e.hasAttribute("data-icon")&&e.hasAttribute("data-icon-source")&&r.unshift(e),Array.from(new Set(r))}function Le(e){let t=e.parentElement;if(!t)return null;let r=e.previousElementSibling;return r instanceof HTMLElement&&r.tagName==="SPAN"&&r.getAttribute("aria-hidden")==="true"?r:t.querySelector(':scope > span[aria-hidden="true"]')}function Ne(e,t){var r;try{return(r=e(t))!=null?r:""}catch(n){return console.warn(`[formgen:icons] provider for "${t}" failed:`,n),""}}function Ae(e,t){let r=e==null?void 0:e.trim();if(!r||typeof DOMParser=="undefined")return null;let i=new DOMParser().parseFromString(r,"image/svg+xml").querySelector("svg");return i?(Ce(i),typeof t.importNode=="function"?t.importNode(i,!0):i):null}function Ce(e){e.querySelectorAll("script, foreignObject, iframe, object, embed").forEach(r=>{var n;(n=r.parentNode)==null||n.removeChild(r)});let t=[e,...Array.from(e.querySelectorAll("*"))];for(let r of t){let n=Array.from(r.attributes);for(let o of n){let i=o.name.toLowerCase(),p=o.value.trim().toLowerCase();if(i.startsWith("on")){r.removeAttribute(o.name);continue}(i==="href"||i==="xlink:href"||i==="src")&&(!(p===""||p.startsWith("#")||p.startsWith("data:image/"))||p.startsWith("javascript:"))&&r.removeAttribute(o.name)}}}function M(e){var t;return(t=e==null?void 0:e.trim().toLowerCase())!=null?t:""}var Me='[data-json-editor="true"]',te="data-json-editor-init",je=[{value:"string",label:"String"},{value:"number",label:"Number"},{value:"boolean",label:"Boolean"},{value:"null",label:"Null"},{value:"object",label:"Object"},{value:"array",label:"Array"}],Ie=0;function He(){return`json-row-${++Ie}`}function I(e){try{return JSON.parse(e)}catch{return}}function _(e){return JSON.stringify(e,null,2)}function H(e){return e===null?"null":Array.isArray(e)?"array":typeof e=="object"?"object":typeof e=="number"?"number":typeof e=="boolean"?"boolean":"string"}function re(e){return Array.isArray(e)?"array":"object"}function k(e){let t=e.trim();if(t==="")return{valid:!1,error:"Number required"};let r=Number(t);return Number.isNaN(r)?{valid:!1,error:"Invalid number"}:Number.isFinite(r)?{valid:!0,value:r}:{valid:!1,error:"Infinity not allowed"}}function Oe(e,t){switch(t){case"string":return e===null?"null":typeof e=="object"?JSON.stringify(e):String(e);case"number":{if(typeof e=="number")return e;if(typeof e=="string"){let r=k(e);return r.valid?r.value:0}return 0}case"boolean":return!!e;case"null":return null;case"object":return{};case"array":return[]}}function T(e,t,r,n,o,i,p=!1){let u=He(),a={id:u,key:t,value:r,type:n,element:null,depth:o,lastValidNumber:typeof r=="number"?r:0,hasError:!1},s=!e.readonly&&!e.disabled,l=document.createElement("div");l.className=`flex items-start gap-2 ${o>0?"ml-4 pl-2 border-l-2 border-gray-200 dark:border-gray-700":""}`,l.setAttribute("data-json-row-id",u);let d=document.createElement("input");d.type="text",d.value=t,p?(d.placeholder="idx",d.disabled=!0,d.readOnly=!0,d.className="flex-shrink-0 w-16 px-2 py-1.5 text-sm text-center border border-gray-200 rounded-md bg-gray-100 dark:bg-slate-700 dark:border-gray-600 dark:text-gray-300 opacity-60 cursor-not-allowed"):(d.placeholder="key",d.disabled=!s,d.readOnly=e.readonly,d.className="flex-shrink-0 w-32 px-2 py-1.5 text-sm border border-gray-200 rounded-md bg-white dark:bg-slate-800 dark:border-gray-600 dark:text-gray-200 focus:border-blue-500 focus:ring-1 focus:ring-blue-500"+(s?"":" opacity-60 cursor-not-allowed"),s&&d.addEventListener("input",()=>{a.key=d.value,i()}));let y=document.createElement("div");y.className="flex-1 min-w-0",ne(y,a,e,i);let f=document.createElement("select");f.disabled=!s,f.className="flex-shrink-0 w-24 px-2 py-1.5 text-sm border border-gray-200 rounded-md bg-white dark:bg-slate-800 dark:border-gray-600 dark:text-gray-200 focus:border-blue-500 focus:ring-1 focus:ring-blue-500"+(s?"":" opacity-60 cursor-not-allowed");for(let g of je){let c=document.createElement("option");c.value=g.value,c.textContent=g.label,c.selected=g.value===n,f.appendChild(c)}s&&f.addEventListener("change",()=>{var m,v;let g=f.value,c=a.value;if(a.type=g,a.hasError=!1,a.numberError=void 0,g==="number")if(typeof c=="number")a.value=c,a.lastValidNumber=c;else if(typeof c=="string"){let x=k(c);x.valid?(a.value=x.value,a.lastValidNumber=x.value):(a.value=(m=a.lastValidNumber)!=null?m:0,a.hasError=!0,a.numberError=x.error)}else a.value=(v=a.lastValidNumber)!=null?v:0;else a.value=Oe(c,g);y.innerHTML="",ne(y,a,e,i),i()});let b=document.createElement("div");if(b.className="flex items-center gap-1 flex-shrink-0",s){let g=z("\u2191","Move up",()=>{oe(e,a,-1),i()}),c=z("\u2193","Move down",()=>{oe(e,a,1),i()}),m=z("\xD7","Delete",()=>{Re(e,a),i()});m.classList.add("text-red-500","hover:text-red-700"),b.appendChild(g),b.appendChild(c),b.appendChild(m)}return l.appendChild(d),l.appendChild(y),l.appendChild(f),l.appendChild(b),a.element=l,a}function ne(e,t,r,n){var i,p,u,a;let o=!r.readonly&&!r.disabled;switch(t.type){case"boolean":{let s=document.createElement("div");s.className="flex items-center gap-2 py-1.5";let l=document.createElement("input");l.type="checkbox",l.checked=t.value===!0,l.disabled=!o,l.className="w-5 h-5 text-blue-600 border-gray-300 rounded focus:ring-blue-500"+(o?"":" opacity-60 cursor-not-allowed"),o&&l.addEventListener("change",()=>{t.value=l.checked,n()});let d=document.createElement("span");d.textContent=t.value?"true":"false",d.className="text-sm text-gray-600 dark:text-gray-400",o&&l.addEventListener("change",()=>{d.textContent=l.checked?"true":"false"}),s.appendChild(l),s.appendChild(d),e.appendChild(s);break}case"null":{let s=document.createElement("span");s.textContent="null",s.className="text-sm text-gray-400 italic py-1.5 block",e.appendChild(s);break}case"number":{let s=document.createElement("div");s.className="relative";let l=document.createElement("input");l.type="text",l.inputMode="decimal",l.value=String((i=t.value)!=null?i:0),l.disabled=!o,l.readOnly=r.readonly,l.className="w-full px-2 py-1.5 text-sm border rounded-md bg-white dark:bg-slate-800 dark:text-gray-200 focus:ring-1"+(t.hasError?" border-red-500 focus:border-red-500 focus:ring-red-500":" border-gray-200 dark:border-gray-600 focus:border-blue-500 focus:ring-blue-500")+(o?"":" opacity-60 cursor-not-allowed");let d=document.createElement("span");d.className="absolute right-2 top-1/2 -translate-y-1/2 text-xs text-red-500 hidden",l.dataset.lastValidNumber=String((p=t.lastValidNumber)!=null?p:0),t.hasError&&(d.textContent=(u=t.numberError)!=null?u:"Invalid number",d.classList.remove("hidden")),o&&(l.addEventListener("input",()=>{var f;let y=k(l.value);y.valid?(t.value=y.value,t.lastValidNumber=y.value,t.hasError=!1,t.numberError=void 0,l.dataset.lastValidNumber=String(y.value),l.classList.remove("border-red-500","focus:border-red-500","focus:ring-red-500"),l.classList.add("border-gray-200","dark:border-gray-600","focus:border-blue-500","focus:ring-blue-500"),d.classList.add("hidden"),n()):(t.value=(f=t.lastValidNumber)!=null?f:0,t.hasError=!0,t.numberError=y.error,l.classList.remove("border-gray-200","dark:border-gray-600","focus:border-blue-500","focus:ring-blue-500"),l.classList.add("border-red-500","focus:border-red-500","focus:ring-red-500"),d.textContent=y.error,d.classList.remove("hidden"))}),l.addEventListener("blur",()=>{var y,f;t.hasError&&(l.value=String((y=t.lastValidNumber)!=null?y:0),t.hasError=!1,t.numberError=void 0,l.dataset.lastValidNumber=String((f=t.lastValidNumber)!=null?f:0),l.classList.remove("border-red-500","focus:border-red-500","focus:ring-red-500"),l.classList.add("border-gray-200","dark:border-gray-600","focus:border-blue-500","focus:ring-blue-500"),d.classList.add("hidden"))})),s.appendChild(l),s.appendChild(d),e.appendChild(s);break}case"object":case"array":{let s=document.createElement("div");s.className="space-y-2 py-1";let l=document.createElement("span");l.textContent=t.type==="object"?"{ Object }":"[ Array ]",l.className="text-xs text-gray-500 dark:text-gray-400 font-medium block mb-1",s.appendChild(l);let d=document.createElement("div");if(d.className="space-y-2",t.type==="array"&&d.setAttribute("data-json-array","true"),t.type==="object"&&typeof t.value=="object"&&t.value!==null&&!Array.isArray(t.value))for(let[y,f]of Object.entries(t.value)){let b=T(r,y,f,H(f),t.depth+1,()=>{let g={};d.querySelectorAll(":scope > [data-json-row-id]").forEach(c=>{let m=c.querySelector('input[type="text"]');(m&&!m.disabled||m)&&(g[m.value]=h(c))}),t.value=g,n()},!1);d.appendChild(b.element)}else t.type==="array"&&Array.isArray(t.value)&&t.value.forEach((y,f)=>{let b=T(r,String(f),y,H(y),t.depth+1,()=>{let g=[];d.querySelectorAll(":scope > [data-json-row-id]").forEach(c=>{g.push(h(c))}),t.value=g,n()},!0);d.appendChild(b.element)});if(s.appendChild(d),o){let y=document.createElement("button");y.type="button",y.textContent=t.type==="array"?"+ Add Item":"+ Add Field",y.className="mt-1 text-xs text-blue-600 hover:text-blue-700 dark:text-blue-400",y.addEventListener("click",()=>{let f=t.type==="array",b=f?String(d.children.length):"",g=T(r,b,"","string",t.depth+1,()=>{if(t.type==="object"){let c={};d.querySelectorAll(":scope > [data-json-row-id]").forEach(m=>{let v=m.querySelector('input[type="text"]');v&&(c[v.value]=h(m))}),t.value=c}else{let c=[];d.querySelectorAll(":scope > [data-json-row-id]").forEach(m=>{c.push(h(m))}),t.value=c}t.type==="array"&&E(d),n()},f);if(d.appendChild(g.element),t.type==="object"){let c={};d.querySelectorAll(":scope > [data-json-row-id]").forEach(m=>{let v=m.querySelector('input[type="text"]');v&&(c[v.value]=h(m))}),t.value=c}else{let c=[];d.querySelectorAll(":scope > [data-json-row-id]").forEach(m=>{c.push(h(m))}),t.value=c}t.type==="array"&&E(d),n()}),s.appendChild(y)}e.appendChild(s);break}default:{let s=document.createElement("input");s.type="text",s.value=String((a=t.value)!=null?a:""),s.disabled=!o,s.readOnly=r.readonly,s.className="w-full px-2 py-1.5 text-sm border border-gray-200 rounded-md bg-white dark:bg-slate-800 dark:border-gray-600 dark:text-gray-200 focus:border-blue-500 focus:ring-1 focus:ring-blue-500"+(o?"":" opacity-60 cursor-not-allowed"),o&&s.addEventListener("input",()=>{t.value=s.value,n()}),e.appendChild(s)}}}function h(e){var n,o,i,p;let t=e.querySelector("select"),r=(t==null?void 0:t.value)||"string";switch(r){case"boolean":{let u=e.querySelector('input[type="checkbox"]');return(n=u==null?void 0:u.checked)!=null?n:!1}case"null":return null;case"number":{let u=e.querySelector('input[type="text"][inputmode="decimal"]');if(u){let s=k(u.value);if(s.valid)return s.value;let l=u.dataset.lastValidNumber;return l!==void 0&&l!==""?Number(l):0}let a=e.querySelector('input[type="number"]');return parseFloat((o=a==null?void 0:a.value)!=null?o:"0")||0}case"object":case"array":{let u=e.querySelectorAll(":scope > div > div > div > [data-json-row-id]");if(r==="object"){let a={};return u.forEach(s=>{let l=s.querySelector('input[type="text"]');l&&(a[l.value]=h(s))}),a}else{let a=[];return u.forEach(s=>a.push(h(s))),a}}default:{let u=e.querySelectorAll('input[type="text"]');for(let a=u.length-1;a>=0;a--){let s=u[a];if(a>0||!s.disabled&&s.placeholder!=="key"&&s.placeholder!=="idx")return(i=s.value)!=null?i:""}return u.length>1&&(p=u[1].value)!=null?p:""}}}function E(e){if(!e)return;Array.from(e.querySelectorAll(":scope > [data-json-row-id]")).forEach((r,n)=>{let o=r.querySelector('input[type="text"]');o&&(o.value=String(n))})}function z(e,t,r){let n=document.createElement("button");return n.type="button",n.textContent=e,n.title=t,n.className="w-6 h-6 flex items-center justify-center text-sm text-gray-500 hover:text-gray-700 dark:text-gray-400 dark:hover:text-gray-200 rounded hover:bg-gray-100 dark:hover:bg-gray-700",n.addEventListener("click",o=>{o.preventDefault(),r()}),n}function oe(e,t,r){let n=e.rows.indexOf(t);if(n!==-1){let a=n+r;if(a<0||a>=e.rows.length)return;if([e.rows[n],e.rows[a]]=[e.rows[a],e.rows[n]],e.rowsContainer){let s=Array.from(e.rowsContainer.children);r===-1&&n>0?e.rowsContainer.insertBefore(s[n],s[n-1]):r===1&&n<s.length-1&&e.rowsContainer.insertBefore(s[n+1],s[n]),e.rootType==="array"&&E(e.rowsContainer)}return}let o=t.element.parentElement;if(!o)return;let i=Array.from(o.querySelectorAll(":scope > [data-json-row-id]")),p=i.indexOf(t.element);if(p===-1)return;let u=p+r;u<0||u>=i.length||(r===-1?o.insertBefore(i[p],i[u]):o.insertBefore(i[u],i[p]),o.getAttribute("data-json-array")==="true"&&E(o))}function Re(e,t){let r=e.rows.indexOf(t);if(r!==-1){e.rows.splice(r,1),t.element.remove(),e.rootType==="array"&&E(e.rowsContainer);return}let n=t.element.parentElement;t.element.remove(),n&&n.getAttribute("data-json-array")==="true"&&E(n)}function ke(e,t){var i;if(e.readonly||e.disabled)return;let r=e.rootType==="array",n=r?String(e.rows.length):"",o=T(e,n,"","string",0,t,r);e.rows.push(o),(i=e.rowsContainer)==null||i.appendChild(o.element),r&&E(e.rowsContainer),t()}function Be(e){if(e.rootType==="array")return e.rows.map(r=>r.value);let t={};for(let r of e.rows)r.key.trim()&&(t[r.key]=r.value);return t}function $(e){let t=Be(e),r=_(t);e.textarea&&(e.textarea.value=r),e.preview&&(e.preview.textContent=r),e.parseError=null,e.root.setAttribute("data-json-editor-state","valid"),O(e)}function O(e){var n;if(!e.addButton)return;let t=e.addButton.querySelector("span")||e.addButton.lastChild,r=e.rootType==="array"?"Add Item":"Add Field";if(t&&t.nodeType===Node.TEXT_NODE)t.textContent=r;else if(t&&t instanceof HTMLElement)t.textContent=r;else for(let o of e.addButton.childNodes)if(o.nodeType===Node.TEXT_NODE&&((n=o.textContent)!=null&&n.trim())){o.textContent=` ${r}`;break}}function ie(e,t){var n;e.rows=[],e.rowsContainer&&(e.rowsContainer.innerHTML="");let r=()=>$(e);if(e.rowsContainer){if(Array.isArray(t))e.rootType="array",e.rowsContainer.setAttribute("data-json-array","true"),t.forEach((o,i)=>{var a;let p=H(o),u=T(e,String(i),o,p,0,r,!0);e.rows.push(u),(a=e.rowsContainer)==null||a.appendChild(u.element)}),E(e.rowsContainer);else if(t&&typeof t=="object"){e.rootType="object",e.rowsContainer.removeAttribute("data-json-array");for(let[o,i]of Object.entries(t)){let p=H(i),u=T(e,o,i,p,0,r,!1);e.rows.push(u),(n=e.rowsContainer)==null||n.appendChild(u.element)}}else e.rootType="object",e.rowsContainer.removeAttribute("data-json-array");O(e)}}function R(e,t){e.parseError=t,e.root.setAttribute("data-json-editor-state","invalid"),e.preview&&e.preview.setAttribute("data-state","invalid")}function Je(e,t){if(e.activeView=t,e.root.setAttribute("data-json-editor-active",t),e.guiContainer&&e.guiContainer.classList.toggle("hidden",t!=="gui"),e.textarea&&e.textarea.classList.toggle("hidden",t!=="raw"),e.preview&&e.preview.classList.add("hidden"),e.root.querySelectorAll("[data-json-editor-mode-btn]").forEach(n=>{let i=n.getAttribute("data-json-editor-mode-btn")===t;n.classList.toggle("bg-blue-600",i),n.classList.toggle("text-white",i),n.classList.toggle("border-blue-600",i),n.classList.toggle("hover:bg-blue-700",i),n.classList.toggle("bg-white",!i),n.classList.toggle("text-gray-700",!i),n.classList.toggle("border-gray-200",!i),n.classList.toggle("hover:bg-gray-50",!i)}),t==="gui"&&e.textarea){let n=I(e.textarea.value||"{}");n!==void 0?typeof n=="object"||Array.isArray(n)?(ie(e,n),e.parseError=null):R(e,"Root must be an object or array"):R(e,"Invalid JSON in raw editor")}else t==="raw"&&$(e)}function Fe(e){if(e.getAttribute(te)==="true")return;e.setAttribute(te,"true");let t=e.querySelector("[data-json-editor-input]"),r=e.querySelector("[data-json-editor-preview]"),n=e.querySelector("[data-json-editor-gui]"),o=e.querySelector("[data-json-editor-rows]"),i=e.querySelector("[data-json-editor-add-field]"),p=e.querySelector("[data-json-editor-mode-toggle]"),u=e.querySelector("[data-json-editor-format]"),a=e.querySelector("[data-json-editor-toggle]"),s=e.getAttribute("data-json-editor-mode")||"raw",l=e.getAttribute("data-json-editor-active")||"raw",d=e.getAttribute("data-json-editor-readonly")==="true",y=e.getAttribute("data-json-editor-disabled")==="true",f={root:e,textarea:t,preview:r,guiContainer:n,rowsContainer:o,addButton:i,rows:[],mode:s,activeView:l,readonly:d,disabled:y,rootType:"object",parseError:null},b="{}";t&&(b=t.value||"{}");let g=()=>$(f);if(n&&o){let c=I(b);c!==void 0?typeof c=="object"||Array.isArray(c)?(f.rootType=re(c),ie(f,c)):(f.rootType="object",R(f,"Root must be an object or array"),O(f)):(f.rootType="object",R(f,"Invalid initial JSON"),O(f))}p&&!y&&p.querySelectorAll("[data-json-editor-mode-btn]").forEach(c=>{c.addEventListener("click",m=>{m.preventDefault();let v=c.getAttribute("data-json-editor-mode-btn");Je(f,v)})}),!d&&!y&&(i&&i.addEventListener("click",c=>{c.preventDefault(),ke(f,g)}),t&&t.addEventListener("input",()=>{let c=I(t.value),m=c!==void 0;f.root.setAttribute("data-json-editor-state",m?"valid":"invalid"),m?(f.parseError=null,(typeof c=="object"||Array.isArray(c))&&(f.rootType=re(c))):f.parseError="Invalid JSON",r&&(r.textContent=m?_(c):t.value,r.setAttribute("data-state",m?"valid":"invalid"))}),u&&t&&u.addEventListener("click",c=>{c.preventDefault();let m=I(t.value);m!==void 0&&(t.value=_(m))})),a&&t&&r&&a.addEventListener("click",c=>{c.preventDefault();let m=e.classList.contains("json-editor--collapsed");e.classList.toggle("json-editor--collapsed",!m),t.classList.toggle("hidden",!m),r.classList.toggle("hidden",m),a.textContent=m?"Collapse":"Expand",a.setAttribute("aria-expanded",m?"true":"false")})}function w(){document.querySelectorAll(Me).forEach(Fe)}typeof document!="undefined"&&(document.readyState==="loading"?document.addEventListener("DOMContentLoaded",w):w());ae();function ae(){C("autoSlug",J),C("autoResize",F)}function qe(e=document){let t=U(e);return j(e),w(),t}function Ve(){ee(),P(),ae()}return fe(De);})();
+"use strict";var FormgenBehaviors=(()=>{var z=Object.defineProperty;var $e=Object.getOwnPropertyDescriptor;var Ue=Object.getOwnPropertyNames;var Ge=Object.prototype.hasOwnProperty;var We=(e,t)=>{for(var n in t)z(e,n,{get:t[n],enumerable:!0})},Ke=(e,t,n,r)=>{if(t&&typeof t=="object"||typeof t=="function")for(let o of Ue(t))!Ge.call(e,o)&&o!==n&&z(e,o,{get:()=>t[o],enumerable:!(r=$e(t,o))||r.enumerable});return e};var Ye=e=>Ke(z({},"__esModule",{value:!0}),e);var Zt={};We(Zt,{ADVANCED_TOGGLE_EVENT:()=>K,DRAFT_CLEARED_EVENT:()=>ae,DRAFT_RESTORED_EVENT:()=>oe,DRAFT_SAVED_EVENT:()=>ie,SUBMISSION_FAILED_EVENT:()=>M,SUBMISSION_QUEUED_EVENT:()=>N,SUBMISSION_SENT_EVENT:()=>A,__resetBehaviorsForTests:()=>Xt,advancedToggle:()=>Y,autoResize:()=>U,autoSlug:()=>$,clearDraft:()=>ue,draft:()=>le,enumFilter:()=>W,initBehaviors:()=>Yt,initIcons:()=>q,initJSONEditors:()=>I,registerBehavior:()=>S,registerIconProvider:()=>me,slugify:()=>D,submitQueue:()=>ne});function D(e){return e?e.normalize("NFKD").replace(/[\u0300-\u036f]/g,"").replace(/[^a-zA-Z0-9\s-]/g," ").trim().replace(/[\s_-]+/g,"-").replace(/^-+|-+$/g,"").toLowerCase():""}function H(e){var t;return(t=e==null?void 0:e.trim().toLowerCase())!=null?t:""}function ve(e){let t=(e instanceof Document,e),n=Array.from(t.querySelectorAll("[data-behavior]"));return e instanceof HTMLElement&&e.hasAttribute("data-behavior")&&n.unshift(e),n}function he(e){if(!e)return[];let t=e.split(/[\s,]+/).map(n=>H(n)).filter(Boolean);return Array.from(new Set(t))}function Ee(e){if(e)try{return JSON.parse(e)}catch(t){console.warn("[formgen:behaviors] failed to parse data-behavior-config:",t);return}}function Se(e,t,n){if(e&&typeof e=="object"&&e!==null){let r=e;return Object.prototype.hasOwnProperty.call(r,t)?r[t]:n===1?e:void 0}if(n===1)return e}function Te(e,t){var r,o,i;let n=e.closest("[data-formgen-auto-init]");return n||(t instanceof HTMLElement?t:(i=(o=t.body)!=null?o:(r=e.ownerDocument)==null?void 0:r.body)!=null?i:e)}function Xe(e){return!!e&&(e instanceof HTMLInputElement||e instanceof HTMLTextAreaElement)}function R(e){return Xe(e)?e:e.querySelector("input, textarea")}function we(e,t){var o;if(!t)return null;let n=`[name="${t}"]`,r=`#${Ze(t)}`;return(o=e.querySelector(n))!=null?o:e.querySelector(r)}function Ze(e){let t=e.replace(/[^a-zA-Z0-9_-]/g,"-");return t.startsWith("fg-")?t:`fg-${t}`}var $=({element:e,config:t,root:n})=>{let r=R(e);if(!r){console.warn("[formgen:behaviors] autoSlug requires an input or textarea target.");return}let o=et(t);if(!o.source){console.warn("[formgen:behaviors] autoSlug config must define a source field.");return}let i=we(n,o.source);if(!i){console.warn(`[formgen:behaviors] source field "${o.source}" not found for autoSlug.`);return}let s=!1,u=e.getAttribute("data-behavior-state")==="manual";!u&&r.value.trim().length>0&&(u=!0,e.setAttribute("data-behavior-state","manual"));let a=()=>{if(u)return;let d=D(i.value||"");d!==r.value&&(s=!0,r.value=d,r.dispatchEvent(new Event("input",{bubbles:!0})),s=!1)},l=()=>{a()},c=d=>{if(s)return;if(r.value.trim().length===0){u=!1,e.removeAttribute("data-behavior-state"),a();return}u=!0,e.setAttribute("data-behavior-state","manual")};return i.addEventListener("input",l),r.addEventListener("input",c),a(),()=>{i.removeEventListener("input",l),r.removeEventListener("input",c)}};function et(e){if(typeof e=="string")return{source:e};if(e&&typeof e=="object"){let t=e;return{source:typeof t.source=="string"?t.source:void 0}}return{}}var U=({element:e,config:t})=>{let n=R(e);if(!(n instanceof HTMLTextAreaElement)){console.warn("[formgen:behaviors] autoResize requires a textarea target.");return}let r=tt(t),o=nt(r),i=()=>{var w;let u=window.getComputedStyle(n),a=rt(u);if(!a)return;let l=parseFloat(u.paddingTop||"0")||0,c=parseFloat(u.paddingBottom||"0")||0,d=parseFloat(u.borderTopWidth||"0")||0,p=parseFloat(u.borderBottomWidth||"0")||0,m=l+c+d+p;n.style.height="auto";let g=(w=o.minRows)!=null?w:n.rows,b=o.maxRows,f=g?a*g+m:void 0,y=b?a*b+m:void 0,v=n.scrollHeight;f!==void 0&&v<f&&(v=f),y!==void 0&&v>y&&(v=y),n.style.height=`${Math.ceil(v)}px`,o.minRows!==void 0&&(n.rows=o.minRows)},s=()=>i();return n.addEventListener("input",s),i(),()=>{n.removeEventListener("input",s)}};function tt(e){if(!e||typeof e!="object")return{};let t=e;return{minRows:xe(t.minRows),maxRows:xe(t.maxRows)}}function xe(e){let t=typeof e=="number"?e:typeof e=="string"?Number.parseInt(e,10):NaN;if(!Number.isFinite(t))return;let n=Math.floor(t);if(!(n<=0))return n}function nt(e){let t=e.minRows,n=e.maxRows;return t!==void 0&&n!==void 0&&n<t?{minRows:t,maxRows:t}:e}function rt(e){let t=e.lineHeight;if(t&&t!=="normal"){let r=Number.parseFloat(t);if(Number.isFinite(r)&&r>0)return r}let n=Number.parseFloat(e.fontSize||"");if(Number.isFinite(n)&&n>0)return n*1.2}function Ae(e,t){let n=e.trim();if(!n)return!0;let r=ot(n),o={tokens:r,pos:0},i=Ne(o);if(o.pos<r.length)throw new Error(`[formgen:expr] unexpected token "${r[o.pos].raw}"`);return i(t)}function ot(e){let t=[],n=0;for(;n<e.length;){let r=e[n];if(/\s/.test(r)){n++;continue}let o=e.slice(n,n+2);if(o==="=="||o==="!="||o==="&&"||o==="||"){let s={"==":"eq","!=":"neq","&&":"and","||":"or"};t.push({kind:s[o],raw:o}),n+=2;continue}if(r==="!"||r==="("||r===")"){t.push({kind:r==="!"?"not":r==="("?"lparen":"rparen",raw:r}),n++;continue}if(r==="="||r==="&"||r==="|")throw new Error(`[formgen:expr] unexpected "${r}"`);if(r==='"'||r==="'"){let s=n+1,u="";for(;s<e.length&&e[s]!==r;)e[s]==="\\"&&s+1<e.length&&s++,u+=e[s],s++;if(s>=e.length)throw new Error("[formgen:expr] unterminated string literal");t.push({kind:"string",raw:u}),n=s+1;continue}let i=n;for(;i<e.length&&!/[\s()!=&|]/.test(e[i]);)i++;t.push(it(e.slice(n,i))),n=i}return t}function it(e){let t=e.toLowerCase();return t==="true"||t==="false"?{kind:"bool",raw:t}:t==="null"||t==="nil"?{kind:"null",raw:"null"}:/^[0-9+-]/.test(e)?{kind:"number",raw:e}:{kind:"ident",raw:e}}function E(e,t){let n=e.tokens[e.pos];return!n||n.kind!==t?null:(e.pos++,n)}function Ne(e){let t=Le(e);for(;E(e,"or");){let n=t,r=Le(e);t=o=>n(o)||r(o)}return t}function Le(e){let t=G(e);for(;E(e,"and");){let n=t,r=G(e);t=o=>n(o)&&r(o)}return t}function G(e){if(E(e,"not")){let t=G(e);return n=>!t(n)}return at(e)}function at(e){if(E(e,"lparen")){let o=Ne(e);if(!E(e,"rparen"))throw new Error("[formgen:expr] missing closing ')'");return o}let t=E(e,"ident");if(!t){let o=e.tokens[e.pos];throw new Error(o?`[formgen:expr] expected identifier, got "${o.raw}"`:"[formgen:expr] empty expression")}let n=E(e,"neq")!==null;if(!n&&!E(e,"eq"))return o=>Me(o(t.raw));let r=e.tokens[e.pos++];if(!r||!["string","number","bool","null","ident"].includes(r.kind))throw new Error("[formgen:expr] missing literal");return o=>st(o(t.raw),r)!==n}function st(e,t){switch(t.kind){case"null":return e==null||e==="";case"bool":return lt(e)===(t.raw==="true");case"number":{let n=Number(e!=null?e:0);return!Number.isNaN(n)&&n===Number(t.raw)}default:return String(e!=null?e:"")===t.raw}}function lt(e){if(typeof e=="string"){let t=e.trim().toLowerCase();if(t==="true"||t==="false")return t==="true"}return Me(e)}function Me(e){return e==null?!1:typeof e=="string"?e.trim()!=="":Array.isArray(e)?e.length>0:!!e}var W=({element:e,config:t,root:n})=>{let r=ct(t);if(!r){console.warn("[formgen:behaviors] enumFilter config must define when and allow.");return}let o=()=>{let s;try{s=Ae(r.when,a=>ut(n,a))}catch(a){console.warn("[formgen:behaviors] enumFilter could not evaluate its condition:",a);return}let u=new Set(r.allow);for(let a of ke(e)){if(a.value==="")continue;let l=s&&!u.has(a.value);Ce(a,l)}},i=s=>{s.target instanceof Node&&e.contains(s.target)||o()};return n.addEventListener("change",i),n.addEventListener("input",i),o(),()=>{n.removeEventListener("change",i),n.removeEventListener("input",i);for(let s of ke(e))Ce(s,!1)}};function ke(e){return e instanceof HTMLSelectElement?Array.from(e.options):Array.from(e.querySelectorAll('input[type="radio"], input[type="checkbox"]'))}function Ce(e,t){var o,i,s;let n=e.hasAttribute("data-enum-filtered");if(t===n)return;let r=e instanceof HTMLInputElement&&(o=e.closest("label"))!=null?o:e;if(t){e.setAttribute("data-enum-filtered",""),e.disabled=!0,r.hidden=!0,(e instanceof HTMLOptionElement?e.selected:e.checked)&&(e instanceof HTMLOptionElement?(e.selected=!1,(s=(i=e.parentElement)==null?void 0:i.closest("select"))==null||s.dispatchEvent(new Event("change",{bubbles:!0}))):(e.checked=!1,e.dispatchEvent(new Event("change",{bubbles:!0}))));return}e.removeAttribute("data-enum-filtered"),e.disabled=!1,r.hidden=!1}function ut(e,t){var o;let n=Array.from(e.querySelectorAll(`[name="${CSS.escape(t)}"]`));if(n.length===0)return;let r=n[0];if(r instanceof HTMLInputElement&&r.type==="radio"){let i=n.find(s=>s.checked);return(o=i==null?void 0:i.value)!=null?o:null}return r instanceof HTMLInputElement&&r.type==="checkbox"?n.length===1?r.checked:n.filter(i=>i.checked).map(i=>i.value):r instanceof HTMLSelectElement&&r.multiple?Array.from(r.selectedOptions).map(i=>i.value):r.value}function ct(e){if(!e||typeof e!="object")return null;let t=e;return typeof t.when!="string"||!Array.isArray(t.allow)?null:{when:t.when,allow:t.allow.map(n=>String(n))}}var K="formgen:advanced:toggle",dt="data-formgen-advanced",ft="[data-formgen-advanced-label]",mt='[aria-invalid="true"], [data-validation-state="invalid"]',Y=({element:e,root:t,config:n})=>{var c,d,p,m;let r=pt(n),o=(c=e.closest("form"))!=null?c:t,i=Array.from(o.querySelectorAll(`[${dt}]`));if(i.length===0){e.hidden=!0;return}let s=e.querySelector(ft),u=(m=(p=r.showLabel)!=null?p:(d=s==null?void 0:s.textContent)==null?void 0:d.trim())!=null?m:"",a=g=>{var b;for(let f of i)f.hidden=!g;e.setAttribute("aria-expanded",g?"true":"false"),s&&(s.textContent=g&&(b=r.hideLabel)!=null?b:u),e.dispatchEvent(new CustomEvent(K,{bubbles:!0,detail:{expanded:g,count:i.length}}))},l=g=>{g.preventDefault(),a(e.getAttribute("aria-expanded")!=="true")};return e.addEventListener("click",l),i.some(g=>g.querySelector(mt))&&a(!0),()=>{e.removeEventListener("click",l)}};function pt(e){if(!e||typeof e!="object")return{};let t=e,n={};return typeof t.showLabel=="string"&&t.showLabel.trim()!==""&&(n.showLabel=t.showLabel),typeof t.hideLabel=="string"&&t.hideLabel.trim()!==""&&(n.hideLabel=t.hideLabel),n}var A="formgen:submission:sent",N="formgen:submission:queued",M="formgen:submission:failed",yt="_idempotency_key",gt="Idempotency-Key",bt="formgen-submit-queue",X="submissions",vt=new Set([408,425,429]),j=class{constructor(){this.entries=new Map}async put(t){this.entries.set(t.id,t)}async delete(t){this.entries.delete(t)}async list(){return Array.from(this.entries.values())}},ee=class{constructor(t){this.factory=t;this.db=null}async put(t){await this.run("readwrite",n=>n.put(t))}async delete(t){await this.run("readwrite",n=>n.delete(t))}async list(){let t=await this.run("readonly",n=>n.getAll());return Array.isArray(t)?t:[]}open(){return this.db||(this.db=new Promise((t,n)=>{let r=this.factory.open(bt,1);r.onupgradeneeded=()=>{r.result.createObjectStore(X,{keyPath:"id"})},r.onsuccess=()=>t(r.result),r.onerror=()=>n(r.error)})),this.db}async run(t,n){let r=await this.open();return new Promise((o,i)=>{let s=n(r.transaction(X,t).objectStore(X));s.onsuccess=()=>o(s.result),s.onerror=()=>i(s.error)})}},O=null,L=null,x;function B(){return O||(O=typeof indexedDB!="undefined"?new ee(indexedDB):new j),O}var ne=({element:e,config:t})=>{var s;let n=e instanceof HTMLFormElement?e:(s=e.closest("form"))!=null?s:e.querySelector("form");if(!n){console.warn("[formgen:behaviors] submitQueue requires a form target.");return}let r=wt(t),o=u=>{let a=(n.getAttribute("method")||"GET").toUpperCase();u.defaultPrevented||a==="GET"||(u.preventDefault(),ht(n,a,r))},i=()=>{te(!0)};return n.addEventListener("submit",o),window.addEventListener("online",i),te(!1),()=>{n.removeEventListener("submit",o),window.removeEventListener("online",i)}};async function ht(e,t,n){let r=e.querySelector(`input[name="${n.keyField}"]`),o={id:(r==null?void 0:r.value)||Ie(),url:e.action||window.location.href,method:t,multipart:(e.getAttribute("enctype")||"").toLowerCase()==="multipart/form-data",entries:Array.from(new FormData(e).entries()),attempts:0,maxAttempts:n.maxAttempts,baseDelayMs:n.baseDelayMs,maxDelayMs:n.maxDelayMs,nextAttemptAt:Date.now()};await De(o,e)==="sent"&&r&&(r.value=Ie())}async function De(e,t){let n,r;if(typeof navigator=="undefined"||navigator.onLine!==!1){try{n=await fetch(e.url,{method:e.method,headers:{Accept:"application/json",[gt]:e.id},body:Tt(e),credentials:"same-origin"})}catch(o){r=o}e.attempts+=1}return n&&!St(n.status)?(await B().delete(e.id),Z(t,A,{submission:e,response:n}),"sent"):e.attempts>=e.maxAttempts?(await B().delete(e.id),Z(t,M,{submission:e,response:n,error:r}),"failed"):(e.nextAttemptAt=Date.now()+Et(e),await B().put(e),Z(t,N,{submission:e,response:n,error:r}),He(e.nextAttemptAt),"queued")}function te(e){return L||(L=(async()=>{try{let t=Date.now();for(let n of await B().list())e||n.nextAttemptAt<=t?await De(n,document):He(n.nextAttemptAt)}catch(t){console.warn("[formgen:behaviors] submitQueue flush failed:",t)}finally{L=null}})(),L)}function He(e){x!==void 0&&clearTimeout(x),x=setTimeout(()=>{x=void 0,te(!1)},Math.max(0,e-Date.now()))}function Et(e){let t=Math.max(0,e.attempts-1);return Math.min(e.baseDelayMs*2**t,e.maxDelayMs)}function St(e){return vt.has(e)||e>=500}function Tt(e){if(e.multipart){let n=new FormData;return e.entries.forEach(([r,o])=>n.append(r,o)),n}let t=new URLSearchParams;return e.entries.forEach(([n,r])=>{typeof r=="string"&&t.append(n,r)}),t}function Z(e,t,n){e.dispatchEvent(new CustomEvent(t,{bubbles:!0,detail:n}))}function Ie(){return typeof crypto!="undefined"&&typeof crypto.randomUUID=="function"?crypto.randomUUID():`${Date.now().toString(16)}-${Math.random().toString(16).slice(2)}`}function wt(e){let t=e&&typeof e=="object"?e:{},n=(r,o)=>typeof r=="number"&&Number.isFinite(r)&&r>0?r:o;return{keyField:typeof t.keyField=="string"&&t.keyField?t.keyField:yt,maxAttempts:n(t.maxAttempts,5),baseDelayMs:n(t.baseDelayMs,1e3),maxDelayMs:n(t.maxDelayMs,3e4)}}function Re(){x!==void 0&&(clearTimeout(x),x=void 0),O=new j,L=null}var oe="formgen:draft:restored",ie="formgen:draft:saved",ae="formgen:draft:cleared",se="formgen:draft:",xt=500,Lt=new Set(["hidden","password","file","submit","button","reset","image"]),le=({element:e,config:t})=>{let n=Ct(t);if(!n){console.warn("[formgen:behaviors] draft requires a `key` in its config.");return}let r=e instanceof HTMLFormElement?e:e.closest("form"),o,i=!1,s=!0,u=()=>{if(o=void 0,i)return;let g={savedAt:Date.now(),values:Mt(e,n.exclude)};Nt(n,g).then(()=>{re(e,ie,{key:n.key,savedAt:g.savedAt})})},a=g=>{s||i||!je(g.target,n.exclude)||(o!==void 0&&clearTimeout(o),o=setTimeout(u,n.debounceMs))},l=()=>{o!==void 0&&(clearTimeout(o),u())},c=()=>{i=!0,o!==void 0&&(clearTimeout(o),o=void 0),ue(n.key,n.endpoint).then(()=>{re(e,ae,{key:n.key})})},d=g=>{setTimeout(()=>{g.defaultPrevented||c()},0)},p=g=>{var f;let b=(f=g.detail)==null?void 0:f.response;(!b||b.ok)&&c()},m=()=>{i=!1};return e.addEventListener("input",a),e.addEventListener("change",a),r==null||r.addEventListener("submit",d),r==null||r.addEventListener(A,p),r==null||r.addEventListener(N,m),r==null||r.addEventListener(M,m),window.addEventListener("pagehide",l),At(n).then(g=>{g&&!i&&(kt(e,g,n.exclude),re(e,oe,{key:n.key,savedAt:g.savedAt})),s=!1}),()=>{l(),e.removeEventListener("input",a),e.removeEventListener("change",a),r==null||r.removeEventListener("submit",d),r==null||r.removeEventListener(A,p),r==null||r.removeEventListener(N,m),r==null||r.removeEventListener(M,m),window.removeEventListener("pagehide",l)}};async function ue(e,t){var n;if(t){try{await fetch(t,{method:"DELETE",credentials:"same-origin"})}catch(r){console.warn("[formgen:behaviors] draft: clearing remote draft failed",r)}return}(n=ce())==null||n.removeItem(se+e)}async function At(e){var t;try{if(e.endpoint){let r=await fetch(e.endpoint,{headers:{Accept:"application/json"},credentials:"same-origin"});return!r.ok||r.status===204?null:Oe(await r.json())}let n=(t=ce())==null?void 0:t.getItem(se+e.key);return n?Oe(JSON.parse(n)):null}catch(n){return console.warn("[formgen:behaviors] draft: ignoring unreadable draft",n),null}}async function Nt(e,t){var n;try{if(e.endpoint){await fetch(e.endpoint,{method:"PUT",headers:{"Content-Type":"application/json"},credentials:"same-origin",body:JSON.stringify(t)});return}(n=ce())==null||n.setItem(se+e.key,JSON.stringify(t))}catch(r){console.warn("[formgen:behaviors] draft: saving failed",r)}}function Mt(e,t){var r;let n={};for(let o of Be(e,t)){let i=(r=n[o.name])!=null?r:n[o.name]=[];if(o instanceof HTMLInputElement&&(o.type==="checkbox"||o.type==="radio"))o.checked&&i.push(o.value);else if(o instanceof HTMLSelectElement)for(let s of Array.from(o.selectedOptions))i.push(s.value);else i.push(o.value)}return n}function kt(e,t,n){var o;let r=new Map;for(let i of Be(e,n)){let s=t.values[i.name];if(!s)continue;let u=!1;if(i instanceof HTMLInputElement&&(i.type==="checkbox"||i.type==="radio")){let a=s.includes(i.value);u=i.checked!==a,i.checked=a}else if(i instanceof HTMLSelectElement)for(let a of Array.from(i.options)){let l=s.includes(a.value);u=u||a.selected!==l,a.selected=l}else{let a=(o=r.get(i.name))!=null?o:0;r.set(i.name,a+1),a<s.length&&i.value!==s[a]&&(i.value=s[a],u=!0)}u&&(i.dispatchEvent(new Event("input",{bubbles:!0})),i.dispatchEvent(new Event("change",{bubbles:!0})))}}function Be(e,t){return Array.from(e.querySelectorAll("input[name], select[name], textarea[name]")).filter(n=>je(n,t))}function je(e,t){if(!(e instanceof HTMLInputElement||e instanceof HTMLSelectElement||e instanceof HTMLTextAreaElement)||!e.name||e.disabled||e instanceof HTMLInputElement&&Lt.has(e.type)||e.closest("[data-formgen-honeypot]"))return!1;let n=e.name.replace(/\[\d+\]/g,"[]");return!t.some(r=>n===r||n.startsWith(r+".")||n.startsWith(r+"["))}function Oe(e){if(!e||typeof e!="object")return null;let t=e;if(!t.values||typeof t.values!="object")return null;let n={};for(let[r,o]of Object.entries(t.values))Array.isArray(o)&&(n[r]=o.filter(i=>typeof i=="string"));return{savedAt:typeof t.savedAt=="number"?t.savedAt:0,values:n}}function Ct(e){let t=e&&typeof e=="object"?e:{},n=typeof t.key=="string"?t.key.trim():"";if(!n)return null;let r=typeof t.endpoint=="string"&&t.endpoint.trim()!==""?t.endpoint.trim():void 0,o=Number(t.debounceMs),i=Array.isArray(t.exclude)?t.exclude.filter(s=>typeof s=="string"&&s.trim()!==""):[];return{key:n,endpoint:r,debounceMs:Number.isFinite(o)&&o>=0?o:xt,exclude:i}}function ce(){try{return typeof localStorage=="undefined"?null:localStorage}catch{return null}}function re(e,t,n){e.dispatchEvent(new CustomEvent(t,{bubbles:!0,detail:n}))}var de=new Map,k=new WeakMap;function S(e,t){let n=H(e);!n||typeof t!="function"||de.set(n,t)}function Fe(e=document){let t=ve(e),n=[];for(let r of t){let o=he(r.getAttribute("data-behavior"));if(o.length===0)continue;let i=Ee(r.getAttribute("data-behavior-config")),s=Te(r,e);for(let u of o){let a=H(u);if(!a||Ht(r,a))continue;let l=de.get(a);if(!l){console.warn(`[formgen:behaviors] behavior "${a}" is not registered.`);continue}let c=Se(i,a,o.length),d=It(l,{element:r,name:a,root:s,config:c});Rt(r,a,d),n.push({element:r,name:a,dispose:d})}}return{records:n,dispose:()=>{for(let r of n.splice(0)){if(r.dispose)try{r.dispose()}catch(o){console.warn(`[formgen:behaviors] dispose failed for ${r.name}:`,o)}Ot(r.element,r.name)}}}}function qe(){de.clear(),k=new WeakMap}function It(e,t){let n;try{n=e(t)}catch(r){console.warn(`[formgen:behaviors] factory for "${t.name}" failed:`,r);return}if(typeof n=="function")return n;if(n&&typeof n=="object"&&typeof n.dispose=="function")return()=>n.dispose()}function Dt(e){let t=k.get(e);return t||(t=new Map,k.set(e,t)),t}function Ht(e,t){let n=k.get(e);return n?n.has(t):!1}function Rt(e,t,n){Dt(e).set(t,n)}function Ot(e,t){let n=k.get(e);n&&(n.delete(t),n.size===0&&k.delete(e))}var fe=new Map;function me(e,t){let n=F(e);!n||typeof t!="function"||fe.set(n,t)}function q(e=document){var r,o;let t=Bt(e),n=[];for(let i of t){let s=F(i.getAttribute("data-icon")),u=F(i.getAttribute("data-icon-source"));if(!s||!u)continue;if(F(i.getAttribute("data-icon-raw"))!==""){n.push({element:i,name:s,source:u,rendered:!1});continue}let l=fe.get(u);if(!l){n.push({element:i,name:s,source:u,rendered:!1});continue}let c=Ft(l,s),d=qt(c,(r=i.ownerDocument)!=null?r:document);if(!d){n.push({element:i,name:s,source:u,rendered:!1});continue}let p=jt(i);if(!p){n.push({element:i,name:s,source:u,rendered:!1});continue}for(;p.firstChild;)p.removeChild(p.firstChild);let m=((o=i.ownerDocument)!=null?o:document).createElement("span");m.className="inline-flex size-5 text-current",m.setAttribute("aria-hidden","true"),m.appendChild(d),p.appendChild(m),n.push({element:i,name:s,source:u,rendered:!0})}return{records:n}}function pe(){fe.clear()}function Bt(e){let t=(e instanceof Document,e),n=Array.from(t.querySelectorAll("[data-icon][data-icon-source]"));return e instanceof HTMLElement&&e.hasAttribute("data-icon")&&e.hasAttribute("data-icon-source")&&n.unshift(e),Array.from(new Set(n))}function jt(e){let t=e.parentElement;if(!t)return null;let n=e.previousElementSibling;return n instanceof HTMLElement&&n.tagName==="SPAN"&&n.getAttribute("aria-hidden")==="true"?n:t.querySelector(':scope > span[aria-hidden="true"]')}function Ft(e,t){var n;try{return(n=e(t))!=null?n:""}catch(r){return console.warn(`[formgen:icons] provider for "${t}" failed:`,r),""}}function qt(e,t){let n=e==null?void 0:e.trim();if(!n||typeof DOMParser=="undefined")return null;let i=new DOMParser().parseFromString(n,"image/svg+xml").querySelector("svg");return i?(_t(i),typeof t.importNode=="function"?t.importNode(i,!0):i):null}function _t(e){e.querySelectorAll("script, foreignObject, iframe, object, embed").forEach(n=>{var r;(r=n.parentNode)==null||r.removeChild(n)});let t=[e,...Array.from(e.querySelectorAll("*"))];for(let n of t){let r=Array.from(n.attributes);for(let o of r){let i=o.name.toLowerCase(),s=o.value.trim().toLowerCase();if(i.startsWith("on")){n.removeAttribute(o.name);continue}(i==="href"||i==="xlink:href"||i==="src")&&(!(s===""||s.startsWith("#")||s.startsWith("data:image/"))||s.startsWith("javascript:"))&&n.removeAttribute(o.name)}}}function F(e){var t;return(t=e==null?void 0:e.trim().toLowerCase())!=null?t:""}var Vt='[data-json-editor="true"]',_e="data-json-editor-init",Pt=[{value:"string",label:"String"},{value:"number",label:"Number"},{value:"boolean",label:"Boolean"},{value:"null",label:"Null"},{value:"object",label:"Object"},{value:"array",label:"Array"}],Jt=0;function Qt(){return`json-row-${++Jt}`}function _(e){try{return JSON.parse(e)}catch{return}}function ge(e){return JSON.stringify(e,null,2)}function V(e){return e===null?"null":Array.isArray(e)?"array":typeof e=="object"?"object":typeof e=="number"?"number":typeof e=="boolean"?"boolean":"string"}function Ve(e){return Array.isArray(e)?"array":"object"}function Q(e){let t=e.trim();if(t==="")return{valid:!1,error:"Number required"};let n=Number(t);return Number.isNaN(n)?{valid:!1,error:"Invalid number"}:Number.isFinite(n)?{valid:!0,value:n}:{valid:!1,error:"Infinity not allowed"}}function zt(e,t){switch(t){case"string":return e===null?"null":typeof e=="object"?JSON.stringify(e):String(e);case"number":{if(typeof e=="number")return e;if(typeof e=="string"){let n=Q(e);return n.valid?n.value:0}return 0}case"boolean":return!!e;case"null":return null;case"object":return{};case"array":return[]}}function C(e,t,n,r,o,i,s=!1){let u=Qt(),a={id:u,key:t,value:n,type:r,element:null,depth:o,lastValidNumber:typeof n=="number"?n:0,hasError:!1},l=!e.readonly&&!e.disabled,c=document.createElement("div");c.className=`flex items-start gap-2 ${o>0?"ml-4 pl-2 border-l-2 border-gray-200 dark:border-gray-700":""}`,c.setAttribute("data-json-row-id",u);let d=document.createElement("input");d.type="text",d.value=t,s?(d.placeholder="idx",d.disabled=!0,d.readOnly=!0,d.className="flex-shrink-0 w-16 px-2 py-1.5 text-sm text-center border border-gray-200 rounded-md bg-gray-100 dark:bg-slate-700 dark:border-gray-600 dark:text-gray-300 opacity-60 cursor-not-allowed"):(d.placeholder="key",d.disabled=!l,d.readOnly=e.readonly,d.className="flex-shrink-0 w-32 px-2 py-1.5 text-sm border border-gray-200 rounded-md bg-white dark:bg-slate-800 dark:border-gray-600 dark:text-gray-200 focus:border-blue-500 focus:ring-1 focus:ring-blue-500"+(l?"":" opacity-60 cursor-not-allowed"),l&&d.addEventListener("input",()=>{a.key=d.value,i()}));let p=document.createElement("div");p.className="flex-1 min-w-0",Pe(p,a,e,i);let m=document.createElement("select");m.disabled=!l,m.className="flex-shrink-0 w-24 px-2 py-1.5 text-sm border border-gray-200 rounded-md bg-white dark:bg-slate-800 dark:border-gray-600 dark:text-gray-200 focus:border-blue-500 focus:ring-1 focus:ring-blue-500"+(l?"":" opacity-60 cursor-not-allowed");for(let b of Pt){let f=document.createElement("option");f.value=b.value,f.textContent=b.label,f.selected=b.value===r,m.appendChild(f)}l&&m.addEventListener("change",()=>{var y,v;let b=m.value,f=a.value;if(a.type=b,a.hasError=!1,a.numberError=void 0,b==="number")if(typeof f=="number")a.value=f,a.lastValidNumber=f;else if(typeof f=="string"){let w=Q(f);w.valid?(a.value=w.value,a.lastValidNumber=w.value):(a.value=(y=a.lastValidNumber)!=null?y:0,a.hasError=!0,a.numberError=w.error)}else a.value=(v=a.lastValidNumber)!=null?v:0;else a.value=zt(f,b);p.innerHTML="",Pe(p,a,e,i),i()});let g=document.createElement("div");if(g.className="flex items-center gap-1 flex-shrink-0",l){let b=ye("\u2191","Move up",()=>{Je(e,a,-1),i()}),f=ye("\u2193","Move down",()=>{Je(e,a,1),i()}),y=ye("\xD7","Delete",()=>{$t(e,a),i()});y.classList.add("text-red-500","hover:text-red-700"),g.appendChild(b),g.appendChild(f),g.appendChild(y)}return c.appendChild(d),c.appendChild(p),c.appendChild(m),c.appendChild(g),a.element=c,a}function Pe(e,t,n,r){var i,s,u,a;let o=!n.readonly&&!n.disabled;switch(t.type){case"boolean":{let l=document.createElement("div");l.className="flex items-center gap-2 py-1.5";let c=document.createElement("input");c.type="checkbox",c.checked=t.value===!0,c.disabled=!o,c.className="w-5 h-5 text-blue-600 border-gray-300 rounded focus:ring-blue-500"+(o?"":" opacity-60 cursor-not-allowed"),o&&c.addEventListener("change",()=>{t.value=c.checked,r()});let d=document.createElement("span");d.textContent=t.value?"true":"false",d.className="text-sm text-gray-600 dark:text-gray-400",o&&c.addEventListener("change",()=>{d.textContent=c.checked?"true":"false"}),l.appendChild(c),l.appendChild(d),e.appendChild(l);break}case"null":{let l=document.createElement("span");l.textContent="null",l.className="text-sm text-gray-400 italic py-1.5 block",e.appendChild(l);break}case"number":{let l=document.createElement("div");l.className="relative";let c=document.createElement("input");c.type="text",c.inputMode="decimal",c.value=String((i=t.value)!=null?i:0),c.disabled=!o,c.readOnly=n.readonly,c.className="w-full px-2 py-1.5 text-sm border rounded-md bg-white dark:bg-slate-800 dark:text-gray-200 focus:ring-1"+(t.hasError?" border-red-500 focus:border-red-500 focus:ring-red-500":" border-gray-200 dark:border-gray-600 focus:border-blue-500 focus:ring-blue-500")+(o?"":" opacity-60 cursor-not-allowed");let d=document.createElement("span");d.className="absolute right-2 top-1/2 -translate-y-1/2 text-xs text-red-500 hidden",c.dataset.lastValidNumber=String((s=t.lastValidNumber)!=null?s:0),t.hasError&&(d.textContent=(u=t.numberError)!=null?u:"Invalid number",d.classList.remove("hidden")),o&&(c.addEventListener("input",()=>{var m;let p=Q(c.value);p.valid?(t.value=p.value,t.lastValidNumber=p.value,t.hasError=!1,t.numberError=void 0,c.dataset.lastValidNumber=String(p.value),c.classList.remove("border-red-500","focus:border-red-500","focus:ring-red-500"),c.classList.add("border-gray-200","dark:border-gray-600","focus:border-blue-500","focus:ring-blue-500"),d.classList.add("hidden"),r()):(t.value=(m=t.lastValidNumber)!=null?m:0,t.hasError=!0,t.numberError=p.error,c.classList.remove("border-gray-200","dark:border-gray-600","focus:border-blue-500","focus:ring-blue-500"),c.classList.add("border-red-500","focus:border-red-500","focus:ring-red-500"),d.textContent=p.error,d.classList.remove("hidden"))}),c.addEventListener("blur",()=>{var p,m;t.hasError&&(c.value=String((p=t.lastValidNumber)!=null?p:0),t.hasError=!1,t.numberError=void 0,c.dataset.lastValidNumber=String((m=t.lastValidNumber)!=null?m:0),c.classList.remove("border-red-500","focus:border-red-500","focus:ring-red-500"),c.classList.add("border-gray-200","dark:border-gray-600","focus:border-blue-500","focus:ring-blue-500"),d.classList.add("hidden"))})),l.appendChild(c),l.appendChild(d),e.appendChild(l);break}case"object":case"array":{let l=document.createElement("div");l.className="space-y-2 py-1";let c=document.createElement("span");c.textContent=t.type==="object"?"{ Object }":"[ Array ]",c.className="text-xs text-gray-500 dark:text-gray-400 font-medium block mb-1",l.appendChild(c);let d=document.createElement("div");if(d.className="space-y-2",t.type==="array"&&d.setAttribute("data-json-array","true"),t.type==="object"&&typeof t.value=="object"&&t.value!==null&&!Array.isArray(t.value))for(let[p,m]of Object.entries(t.value)){let g=C(n,p,m,V(m),t.depth+1,()=>{let b={};d.querySelectorAll(":scope > [data-json-row-id]").forEach(f=>{let y=f.querySelector('input[type="text"]');(y&&!y.disabled||y)&&(b[y.value]=h(f))}),t.value=b,r()},!1);d.appendChild(g.element)}else t.type==="array"&&Array.isArray(t.value)&&t.value.forEach((p,m)=>{let g=C(n,String(m),p,V(p),t.depth+1,()=>{let b=[];d.querySelectorAll(":scope > [data-json-row-id]").forEach(f=>{b.push(h(f))}),t.value=b,r()},!0);d.appendChild(g.element)});if(l.appendChild(d),o){let p=document.createElement("button");p.type="button",p.textContent=t.type==="array"?"+ Add Item":"+ Add Field",p.className="mt-1 text-xs text-blue-600 hover:text-blue-700 dark:text-blue-400",p.addEventListener("click",()=>{let m=t.type==="array",g=m?String(d.children.length):"",b=C(n,g,"","string",t.depth+1,()=>{if(t.type==="object"){let f={};d.querySelectorAll(":scope > [data-json-row-id]").forEach(y=>{let v=y.querySelector('input[type="text"]');v&&(f[v.value]=h(y))}),t.value=f}else{let f=[];d.querySelectorAll(":scope > [data-json-row-id]").forEach(y=>{f.push(h(y))}),t.value=f}t.type==="array"&&T(d),r()},m);if(d.appendChild(b.element),t.type==="object"){let f={};d.querySelectorAll(":scope > [data-json-row-id]").forEach(y=>{let v=y.querySelector('input[type="text"]');v&&(f[v.value]=h(y))}),t.value=f}else{let f=[];d.querySelectorAll(":scope > [data-json-row-id]").forEach(y=>{f.push(h(y))}),t.value=f}t.type==="array"&&T(d),r()}),l.appendChild(p)}e.appendChild(l);break}default:{let l=document.createElement("input");l.type="text",l.value=String((a=t.value)!=null?a:""),l.disabled=!o,l.readOnly=n.readonly,l.className="w-full px-2 py-1.5 text-sm border border-gray-200 rounded-md bg-white dark:bg-slate-800 dark:border-gray-600 dark:text-gray-200 focus:border-blue-500 focus:ring-1 focus:ring-blue-500"+(o?"":" opacity-60 cursor-not-allowed"),o&&l.addEventListener("input",()=>{t.value=l.value,r()}),e.appendChild(l)}}}function h(e){var r,o,i,s;let t=e.querySelector("select"),n=(t==null?void 0:t.value)||"string";switch(n){case"boolean":{let u=e.querySelector('input[type="checkbox"]');return(r=u==null?void 0:u.checked)!=null?r:!1}case"null":return null;case"number":{let u=e.querySelector('input[type="text"][inputmode="decimal"]');if(u){let l=Q(u.value);if(l.valid)return l.value;let c=u.dataset.lastValidNumber;return c!==void 0&&c!==""?Number(c):0}let a=e.querySelector('input[type="number"]');return parseFloat((o=a==null?void 0:a.value)!=null?o:"0")||0}case"object":case"array":{let u=e.querySelectorAll(":scope > div > div > div > [data-json-row-id]");if(n==="object"){let a={};return u.forEach(l=>{let c=l.querySelector('input[type="text"]');c&&(a[c.value]=h(l))}),a}else{let a=[];return u.forEach(l=>a.push(h(l))),a}}default:{let u=e.querySelectorAll('input[type="text"]');for(let a=u.length-1;a>=0;a--){let l=u[a];if(a>0||!l.disabled&&l.placeholder!=="key"&&l.placeholder!=="idx")return(i=l.value)!=null?i:""}return u.length>1&&(s=u[1].value)!=null?s:""}}}function T(e){if(!e)return;Array.from(e.querySelectorAll(":scope > [data-json-row-id]")).forEach((n,r)=>{let o=n.querySelector('input[type="text"]');o&&(o.value=String(r))})}function ye(e,t,n){let r=document.createElement("button");return r.type="button",r.textContent=e,r.title=t,r.className="w-6 h-6 flex items-center justify-center text-sm text-gray-500 hover:text-gray-700 dark:text-gray-400 dark:hover:text-gray-200 rounded hover:bg-gray-100 dark:hover:bg-gray-700",r.addEventListener("click",o=>{o.preventDefault(),n()}),r}function Je(e,t,n){let r=e.rows.indexOf(t);if(r!==-1){let a=r+n;if(a<0||a>=e.rows.length)return;if([e.rows[r],e.rows[a]]=[e.rows[a],e.rows[r]],e.rowsContainer){let l=Array.from(e.rowsContainer.children);n===-1&&r>0?e.rowsContainer.insertBefore(l[r],l[r-1]):n===1&&r<l.length-1&&e.rowsContainer.insertBefore(l[r+1],l[r]),e.rootType==="array"&&T(e.rowsContainer)}return}let o=t.element.parentElement;if(!o)return;let i=Array.from(o.querySelectorAll(":scope > [data-json-row-id]")),s=i.indexOf(t.element);if(s===-1)return;let u=s+n;u<0||u>=i.length||(n===-1?o.insertBefore(i[s],i[u]):o.insertBefore(i[u],i[s]),o.getAttribute("data-json-array")==="true"&&T(o))}function $t(e,t){let n=e.rows.indexOf(t);if(n!==-1){e.rows.splice(n,1),t.element.remove(),e.rootType==="array"&&T(e.rowsContainer);return}let r=t.element.parentElement;t.element.remove(),r&&r.getAttribute("data-json-array")==="true"&&T(r)}function Ut(e,t){var i;if(e.readonly||e.disabled)return;let n=e.rootType==="array",r=n?String(e.rows.length):"",o=C(e,r,"","string",0,t,n);e.rows.push(o),(i=e.rowsContainer)==null||i.appendChild(o.element),n&&T(e.rowsContainer),t()}function Gt(e){if(e.rootType==="array")return e.rows.map(n=>n.value);let t={};for(let n of e.rows)n.key.trim()&&(t[n.key]=n.value);return t}function be(e){let t=Gt(e),n=ge(t);e.textarea&&(e.textarea.value=n),e.preview&&(e.preview.textContent=n),e.parseError=null,e.root.setAttribute("data-json-editor-state","valid"),P(e)}function P(e){var r;if(!e.addButton)return;let t=e.addButton.querySelector("span")||e.addButton.lastChild,n=e.rootType==="array"?"Add Item":"Add Field";if(t&&t.nodeType===Node.TEXT_NODE)t.textContent=n;else if(t&&t instanceof HTMLElement)t.textContent=n;else for(let o of e.addButton.childNodes)if(o.nodeType===Node.TEXT_NODE&&((r=o.textContent)!=null&&r.trim())){o.textContent=` ${n}`;break}}function Qe(e,t){var r;e.rows=[],e.rowsContainer&&(e.rowsContainer.innerHTML="");let n=()=>be(e);if(e.rowsContainer){if(Array.isArray(t))e.rootType="array",e.rowsContainer.setAttribute("data-json-array","true"),t.forEach((o,i)=>{var a;let s=V(o),u=C(e,String(i),o,s,0,n,!0);e.rows.push(u),(a=e.rowsContainer)==null||a.appendChild(u.element)}),T(e.rowsContainer);else if(t&&typeof t=="object"){e.rootType="object",e.rowsContainer.removeAttribute("data-json-array");for(let[o,i]of Object.entries(t)){let s=V(i),u=C(e,o,i,s,0,n,!1);e.rows.push(u),(r=e.rowsContainer)==null||r.appendChild(u.element)}}else e.rootType="object",e.rowsContainer.removeAttribute("data-json-array");P(e)}}function J(e,t){e.parseError=t,e.root.setAttribute("data-json-editor-state","invalid"),e.preview&&e.preview.setAttribute("data-state","invalid")}function Wt(e,t){if(e.activeView=t,e.root.setAttribute("data-json-editor-active",t),e.guiContainer&&e.guiContainer.classList.toggle("hidden",t!=="gui"),e.textarea&&e.textarea.classList.toggle("hidden",t!=="raw"),e.preview&&e.preview.classList.add("hidden"),e.root.querySelectorAll("[data-json-editor-mode-btn]").forEach(r=>{let i=r.getAttribute("data-json-editor-mode-btn")===t;r.classList.toggle("bg-blue-600",i),r.classList.toggle("text-white",i),r.classList.toggle("border-blue-600",i),r.classList.toggle("hover:bg-blue-700",i),r.classList.toggle("bg-white",!i),r.classList.toggle("text-gray-700",!i),r.classList.toggle("border-gray-200",!i),r.classList.toggle("hover:bg-gray-50",!i)}),t==="gui"&&e.textarea){let r=_(e.textarea.value||"{}");r!==void 0?typeof r=="object"||Array.isArray(r)?(Qe(e,r),e.parseError=null):J(e,"Root must be an object or array"):J(e,"Invalid JSON in raw editor")}else t==="raw"&&be(e)}function Kt(e){if(e.getAttribute(_e)==="true")return;e.setAttribute(_e,"true");let t=e.querySelector("[data-json-editor-input]"),n=e.querySelector("[data-json-editor-preview]"),r=e.querySelector("[data-json-editor-gui]"),o=e.querySelector("[data-json-editor-rows]"),i=e.querySelector("[data-json-editor-add-field]"),s=e.querySelector("[data-json-editor-mode-toggle]"),u=e.querySelector("[data-json-editor-format]"),a=e.querySelector("[data-json-editor-toggle]"),l=e.getAttribute("data-json-editor-mode")||"raw",c=e.getAttribute("data-json-editor-active")||"raw",d=e.getAttribute("data-json-editor-readonly")==="true",p=e.getAttribute("data-json-editor-disabled")==="true",m={root:e,textarea:t,preview:n,guiContainer:r,rowsContainer:o,addButton:i,rows:[],mode:l,activeView:c,readonly:d,disabled:p,rootType:"object",parseError:null},g="{}";t&&(g=t.value||"{}");let b=()=>be(m);if(r&&o){let f=_(g);f!==void 0?typeof f=="object"||Array.isArray(f)?(m.rootType=Ve(f),Qe(m,f)):(m.rootType="object",J(m,"Root must be an object or array"),P(m)):(m.rootType="object",J(m,"Invalid initial JSON"),P(m))}s&&!p&&s.querySelectorAll("[data-json-editor-mode-btn]").forEach(f=>{f.addEventListener("click",y=>{y.preventDefault();let v=f.getAttribute("data-json-editor-mode-btn");Wt(m,v)})}),!d&&!p&&(i&&i.addEventListener("click",f=>{f.preventDefault(),Ut(m,b)}),t&&t.addEventListener("input",()=>{let f=_(t.value),y=f!==void 0;m.root.setAttribute("data-json-editor-state",y?"valid":"invalid"),y?(m.parseError=null,(typeof f=="object"||Array.isArray(f))&&(m.rootType=Ve(f))):m.parseError="Invalid JSON",n&&(n.textContent=y?ge(f):t.value,n.setAttribute("data-state",y?"valid":"invalid"))}),u&&t&&u.addEventListener("click",f=>{f.preventDefault();let y=_(t.value);y!==void 0&&(t.value=ge(y))})),a&&t&&n&&a.addEventListener("click",f=>{f.preventDefault();let y=e.classList.contains("json-editor--collapsed");e.classList.toggle("json-editor--collapsed",!y),t.classList.toggle("hidden",!y),n.classList.toggle("hidden",y),a.textContent=y?"Collapse":"Expand",a.setAttribute("aria-expanded",y?"true":"false")})}function I(){document.querySelectorAll(Vt).forEach(Kt)}typeof document!="undefined"&&(document.readyState==="loading"?document.addEventListener("DOMContentLoaded",I):I());ze();function ze(){S("autoSlug",$),S("autoResize",U),S("enumFilter",W),S("submitQueue",ne),S("advancedToggle",Y),S("draft",le)}function Yt(e=document){let t=Fe(e);return q(e),I(),t}function Xt(){qe(),pe(),Re(),ze()}return Ye(Zt);})();
 //# sourceMappingURL=formgen-behaviors.min.js.map
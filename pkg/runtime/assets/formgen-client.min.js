@@ -0,0 +1,32 @@
+/**
+ * formgen relationship runtime
+ * DO NOT EDIT: generated via `npm run build`
+ */
+"use strict";var FormgenClient=(()=>{var Ti=Object.defineProperty;var ky=Object.getOwnPropertyDescriptor;var My=Object.getOwnPropertyNames;var wy=Object.prototype.hasOwnProperty;var Ay=(t,e,n)=>e in t?Ti(t,e,{enumerable:!0,configurable:!0,writable:!0,value:n}):t[e]=n;var Si=(t,e)=>()=>(t&&(e=t(t=0)),e);var ki=(t,e)=>{for(var n in e)Ti(t,n,{get:e[n],enumerable:!0})},Ry=(t,e,n,r)=>{if(e&&typeof e=="object"||typeof e=="function")for(let i of My(e))!wy.call(t,i)&&i!==n&&Ti(t,i,{get:()=>e[i],enumerable:!(r=ky(e,i))||r.enumerable});return t};var Oy=t=>Ry(Ti({},"__esModule",{value:!0}),t);var H=(t,e,n)=>(Ay(t,typeof e!="symbol"?e+"":e,n),n);function ji(t,e,n){return ae(t,De(e,n))}function $e(t,e){return typeof t=="function"?t(e):t}function Re(t){return t.split("-")[0]}function Ue(t){return t.split("-")[1]}function Wi(t){return t==="x"?"y":"x"}function qi(t){return t==="y"?"height":"width"}function _e(t){return fb.has(Re(t))?"y":"x"}function Ki(t){return Wi(_e(t))}function Zs(t,e,n){n===void 0&&(n=!1);let r=Ue(t),i=Ki(t),o=qi(i),s=i==="x"?r===(n?"end":"start")?"right":"left":r==="start"?"bottom":"top";return e.reference[o]>e.floating[o]&&(s=Nr(s)),[s,Nr(s)]}function tu(t){let e=Nr(t);return[Lr(t),e,Lr(e)]}function Lr(t){return t.replace(/start|end/g,e=>pb[e])}function gb(t,e,n){switch(t){case"top":case"bottom":return n?e?eu:Zd:e?Zd:eu;case"left":case"right":return e?hb:mb;default:return[]}}function nu(t,e,n,r){let i=Ue(t),o=gb(Re(t),n==="start",r);return i&&(o=o.map(s=>s+"-"+i),e&&(o=o.concat(o.map(Lr)))),o}function Nr(t){return t.replace(/left|right|bottom|top/g,e=>ub[e])}function yb(t){return{top:0,right:0,bottom:0,left:0,...t}}function Ji(t){return typeof t!="number"?yb(t):{top:t,right:t,bottom:t,left:t}}function bt(t){let{x:e,y:n,width:r,height:i}=t;return{width:r,height:i,top:n,left:e,right:e+r,bottom:n+i,x:e,y:n}}var Qs,Xd,Xs,De,ae,Ir,Pr,rt,ub,pb,fb,Zd,eu,hb,mb,Gi=Si(()=>{Qs=["top","right","bottom","left"],Xd=["start","end"],Xs=Qs.reduce((t,e)=>t.concat(e,e+"-"+Xd[0],e+"-"+Xd[1]),[]),De=Math.min,ae=Math.max,Ir=Math.round,Pr=Math.floor,rt=t=>({x:t,y:t}),ub={left:"right",right:"left",bottom:"top",top:"bottom"},pb={start:"end",end:"start"};fb=new Set(["top","bottom"]);Zd=["left","right"],eu=["right","left"],hb=["top","bottom"],mb=["bottom","top"]});function ru(t,e,n){let{reference:r,floating:i}=t,o=_e(e),s=Ki(e),a=qi(s),l=Re(e),c=o==="y",d=r.x+r.width/2-i.width/2,u=r.y+r.height/2-i.height/2,p=r[a]/2-i[a]/2,f;switch(l){case"top":f={x:d,y:r.y-i.height};break;case"bottom":f={x:d,y:r.y+r.height};break;case"right":f={x:r.x+r.width,y:u};break;case"left":f={x:r.x-i.width,y:u};break;default:f={x:r.x,y:r.y}}switch(Ue(e)){case"start":f[s]-=p*(n&&c?-1:1);break;case"end":f[s]+=p*(n&&c?-1:1);break}return f}async function Ht(t,e){var n;e===void 0&&(e={});let{x:r,y:i,platform:o,rects:s,elements:a,strategy:l}=t,{boundary:c="clippingAncestors",rootBoundary:d="viewport",elementContext:u="floating",altBoundary:p=!1,padding:f=0}=$e(e,t),h=Ji(f),g=a[p?u==="floating"?"reference":"floating":u],y=bt(await o.getClippingRect({element:(n=await(o.isElement==null?void 0:o.isElement(g)))==null||n?g:g.contextElement||await(o.getDocumentElement==null?void 0:o.getDocumentElement(a.floating)),boundary:c,rootBoundary:d,strategy:l})),x=u==="floating"?{x:r,y:i,width:s.floating.width,height:s.floating.height}:s.reference,C=await(o.getOffsetParent==null?void 0:o.getOffsetParent(a.floating)),M=await(o.isElement==null?void 0:o.isElement(C))?await(o.getScale==null?void 0:o.getScale(C))||{x:1,y:1}:{x:1,y:1},T=bt(o.convertOffsetParentRelativeRectToViewportRelativeRect?await o.convertOffsetParentRelativeRectToViewportRelativeRect({elements:a,rect:x,offsetParent:C,strategy:l}):x);return{top:(y.top-T.top+h.top)/M.y,bottom:(T.bottom-y.bottom+h.bottom)/M.y,left:(y.left-T.left+h.left)/M.x,right:(T.right-y.right+h.right)/M.x}}function bb(t,e,n){return(t?[...n.filter(i=>Ue(i)===t),...n.filter(i=>Ue(i)!==t)]:n.filter(i=>Re(i)===i)).filter(i=>t?Ue(i)===t||(e?Lr(i)!==i:!1):!0)}function iu(t,e){return{top:t.top-e.height,right:t.right-e.width,bottom:t.bottom-e.height,left:t.left-e.width}}function ou(t){return Qs.some(e=>t[e]>=0)}function uu(t){let e=De(...t.map(o=>o.left)),n=De(...t.map(o=>o.top)),r=ae(...t.map(o=>o.right)),i=ae(...t.map(o=>o.bottom));return{x:e,y:n,width:r-e,height:i-n}}function xb(t){let e=t.slice().sort((i,o)=>i.y-o.y),n=[],r=null;for(let i=0;i<e.length;i++){let o=e[i];!r||o.y-r.y>r.height/2?n.push([o]):n[n.length-1].push(o),r=o}return n.map(i=>bt(uu(i)))}async function Cb(t,e){let{placement:n,platform:r,elements:i}=t,o=await(r.isRTL==null?void 0:r.isRTL(i.floating)),s=Re(n),a=Ue(n),l=_e(n)==="y",c=fu.has(s)?-1:1,d=o&&l?-1:1,u=$e(e,t),{mainAxis:p,crossAxis:f,alignmentAxis:h}=typeof u=="number"?{mainAxis:u,crossAxis:0,alignmentAxis:null}:{mainAxis:u.mainAxis||0,crossAxis:u.crossAxis||0,alignmentAxis:u.alignmentAxis};return a&&typeof h=="number"&&(f=a==="end"?h*-1:h),l?{x:f*d,y:p*c}:{x:p*c,y:f*d}}var su,au,lu,cu,du,pu,fu,hu,mu,gu,yu,bu=Si(()=>{Gi();Gi();su=async(t,e,n)=>{let{placement:r="bottom",strategy:i="absolute",middleware:o=[],platform:s}=n,a=o.filter(Boolean),l=await(s.isRTL==null?void 0:s.isRTL(e)),c=await s.getElementRects({reference:t,floating:e,strategy:i}),{x:d,y:u}=ru(c,r,l),p=r,f={},h=0;for(let m=0;m<a.length;m++){let{name:g,fn:y}=a[m],{x,y:C,data:M,reset:T}=await y({x:d,y:u,initialPlacement:r,placement:p,strategy:i,middlewareData:f,rects:c,platform:s,elements:{reference:t,floating:e}});d=x!=null?x:d,u=C!=null?C:u,f={...f,[g]:{...f[g],...M}},T&&h<=50&&(h++,typeof T=="object"&&(T.placement&&(p=T.placement),T.rects&&(c=T.rects===!0?await s.getElementRects({reference:t,floating:e,strategy:i}):T.rects),{x:d,y:u}=ru(c,p,l)),m=-1)}return{x:d,y:u,placement:p,strategy:i,middlewareData:f}};au=t=>({name:"arrow",options:t,async fn(e){let{x:n,y:r,placement:i,rects:o,platform:s,elements:a,middlewareData:l}=e,{element:c,padding:d=0}=$e(t,e)||{};if(c==null)return{};let u=Ji(d),p={x:n,y:r},f=Ki(i),h=qi(f),m=await s.getDimensions(c),g=f==="y",y=g?"top":"left",x=g?"bottom":"right",C=g?"clientHeight":"clientWidth",M=o.reference[h]+o.reference[f]-p[f]-o.floating[h],T=p[f]-o.reference[f],R=await(s.getOffsetParent==null?void 0:s.getOffsetParent(c)),S=R?R[C]:0;(!S||!await(s.isElement==null?void 0:s.isElement(R)))&&(S=a.floating[C]||o.floating[h]);let P=M/2-T/2,D=S/2-m[h]/2-1,b=De(u[y],D),Y=De(u[x],D),w=b,N=S-m[h]-Y,B=S/2-m[h]/2+P,Q=ji(w,B,N),q=!l.arrow&&Ue(i)!=null&&B!==Q&&o.reference[h]/2-(B<w?b:Y)-m[h]/2<0,V=q?B<w?B-w:B-N:0;return{[f]:p[f]+V,data:{[f]:Q,centerOffset:B-Q-V,...q&&{alignmentOffset:V}},reset:q}}});lu=function(t){return t===void 0&&(t={}),{name:"autoPlacement",options:t,async fn(e){var n,r,i;let{rects:o,middlewareData:s,placement:a,platform:l,elements:c}=e,{crossAxis:d=!1,alignment:u,allowedPlacements:p=Xs,autoAlignment:f=!0,...h}=$e(t,e),m=u!==void 0||p===Xs?bb(u||null,f,p):p,g=await Ht(e,h),y=((n=s.autoPlacement)==null?void 0:n.index)||0,x=m[y];if(x==null)return{};let C=Zs(x,o,await(l.isRTL==null?void 0:l.isRTL(c.floating)));if(a!==x)return{reset:{placement:m[0]}};let M=[g[Re(x)],g[C[0]],g[C[1]]],T=[...((r=s.autoPlacement)==null?void 0:r.overflows)||[],{placement:x,overflows:M}],R=m[y+1];if(R)return{data:{index:y+1,overflows:T},reset:{placement:R}};let S=T.map(b=>{let Y=Ue(b.placement);return[b.placement,Y&&d?b.overflows.slice(0,2).reduce((w,N)=>w+N,0):b.overflows[0],b.overflows]}).sort((b,Y)=>b[1]-Y[1]),D=((i=S.filter(b=>b[2].slice(0,Ue(b[0])?2:3).every(Y=>Y<=0))[0])==null?void 0:i[0])||S[0][0];return D!==a?{data:{index:y+1,overflows:T},reset:{placement:D}}:{}}}},cu=function(t){return t===void 0&&(t={}),{name:"flip",options:t,async fn(e){var n,r;let{placement:i,middlewareData:o,rects:s,initialPlacement:a,platform:l,elements:c}=e,{mainAxis:d=!0,crossAxis:u=!0,fallbackPlacements:p,fallbackStrategy:f="bestFit",fallbackAxisSideDirection:h="none",flipAlignment:m=!0,...g}=$e(t,e);if((n=o.arrow)!=null&&n.alignmentOffset)return{};let y=Re(i),x=_e(a),C=Re(a)===a,M=await(l.isRTL==null?void 0:l.isRTL(c.floating)),T=p||(C||!m?[Nr(a)]:tu(a)),R=h!=="none";!p&&R&&T.push(...nu(a,m,h,M));let S=[a,...T],P=await Ht(e,g),D=[],b=((r=o.flip)==null?void 0:r.overflows)||[];if(d&&D.push(P[y]),u){let B=Zs(i,s,M);D.push(P[B[0]],P[B[1]])}if(b=[...b,{placement:i,overflows:D}],!D.every(B=>B<=0)){var Y,w;let B=(((Y=o.flip)==null?void 0:Y.index)||0)+1,Q=S[B];if(Q&&(!(u==="alignment"?x!==_e(Q):!1)||b.every(ne=>_e(ne.placement)===x?ne.overflows[0]>0:!0)))return{data:{index:B,overflows:b},reset:{placement:Q}};let q=(w=b.filter(V=>V.overflows[0]<=0).sort((V,ne)=>V.overflows[1]-ne.overflows[1])[0])==null?void 0:w.placement;if(!q)switch(f){case"bestFit":{var N;let V=(N=b.filter(ne=>{if(R){let Lt=_e(ne.placement);return Lt===x||Lt==="y"}return!0}).map(ne=>[ne.placement,ne.overflows.filter(Lt=>Lt>0).reduce((Lt,Sy)=>Lt+Sy,0)]).sort((ne,Lt)=>ne[1]-Lt[1])[0])==null?void 0:N[0];V&&(q=V);break}case"initialPlacement":q=a;break}if(i!==q)return{reset:{placement:q}}}return{}}}};du=function(t){return t===void 0&&(t={}),{name:"hide",options:t,async fn(e){let{rects:n}=e,{strategy:r="referenceHidden",...i}=$e(t,e);switch(r){case"referenceHidden":{let o=await Ht(e,{...i,elementContext:"reference"}),s=iu(o,n.reference);return{data:{referenceHiddenOffsets:s,referenceHidden:ou(s)}}}case"escaped":{let o=await Ht(e,{...i,altBoundary:!0}),s=iu(o,n.floating);return{data:{escapedOffsets:s,escaped:ou(s)}}}default:return{}}}}};pu=function(t){return t===void 0&&(t={}),{name:"inline",options:t,async fn(e){let{placement:n,elements:r,rects:i,platform:o,strategy:s}=e,{padding:a=2,x:l,y:c}=$e(t,e),d=Array.from(await(o.getClientRects==null?void 0:o.getClientRects(r.reference))||[]),u=xb(d),p=bt(uu(d)),f=Ji(a);function h(){if(u.length===2&&u[0].left>u[1].right&&l!=null&&c!=null)return u.find(g=>l>g.left-f.left&&l<g.right+f.right&&c>g.top-f.top&&c<g.bottom+f.bottom)||p;if(u.length>=2){if(_e(n)==="y"){let b=u[0],Y=u[u.length-1],w=Re(n)==="top",N=b.top,B=Y.bottom,Q=w?b.left:Y.left,q=w?b.right:Y.right,V=q-Q,ne=B-N;return{top:N,bottom:B,left:Q,right:q,width:V,height:ne,x:Q,y:N}}let g=Re(n)==="left",y=ae(...u.map(b=>b.right)),x=De(...u.map(b=>b.left)),C=u.filter(b=>g?b.left===x:b.right===y),M=C[0].top,T=C[C.length-1].bottom,R=x,S=y,P=S-R,D=T-M;return{top:M,bottom:T,left:R,right:S,width:P,height:D,x:R,y:M}}return p}let m=await o.getElementRects({reference:{getBoundingClientRect:h},floating:r.floating,strategy:s});return i.reference.x!==m.reference.x||i.reference.y!==m.reference.y||i.reference.width!==m.reference.width||i.reference.height!==m.reference.height?{reset:{rects:m}}:{}}}},fu=new Set(["left","top"]);hu=function(t){return t===void 0&&(t=0),{name:"offset",options:t,async fn(e){var n,r;let{x:i,y:o,placement:s,middlewareData:a}=e,l=await Cb(e,t);return s===((n=a.offset)==null?void 0:n.placement)&&(r=a.arrow)!=null&&r.alignmentOffset?{}:{x:i+l.x,y:o+l.y,data:{...l,placement:s}}}}},mu=function(t){return t===void 0&&(t={}),{name:"shift",options:t,async fn(e){let{x:n,y:r,placement:i}=e,{mainAxis:o=!0,crossAxis:s=!1,limiter:a={fn:g=>{let{x:y,y:x}=g;return{x:y,y:x}}},...l}=$e(t,e),c={x:n,y:r},d=await Ht(e,l),u=_e(Re(i)),p=Wi(u),f=c[p],h=c[u];if(o){let g=p==="y"?"top":"left",y=p==="y"?"bottom":"right",x=f+d[g],C=f-d[y];f=ji(x,f,C)}if(s){let g=u==="y"?"top":"left",y=u==="y"?"bottom":"right",x=h+d[g],C=h-d[y];h=ji(x,h,C)}let m=a.fn({...e,[p]:f,[u]:h});return{...m,data:{x:m.x-n,y:m.y-r,enabled:{[p]:o,[u]:s}}}}}},gu=function(t){return t===void 0&&(t={}),{options:t,fn(e){let{x:n,y:r,placement:i,rects:o,middlewareData:s}=e,{offset:a=0,mainAxis:l=!0,crossAxis:c=!0}=$e(t,e),d={x:n,y:r},u=_e(i),p=Wi(u),f=d[p],h=d[u],m=$e(a,e),g=typeof m=="number"?{mainAxis:m,crossAxis:0}:{mainAxis:0,crossAxis:0,...m};if(l){let C=p==="y"?"height":"width",M=o.reference[p]-o.floating[C]+g.mainAxis,T=o.reference[p]+o.reference[C]-g.mainAxis;f<M?f=M:f>T&&(f=T)}if(c){var y,x;let C=p==="y"?"width":"height",M=fu.has(Re(i)),T=o.reference[u]-o.floating[C]+(M&&((y=s.offset)==null?void 0:y[u])||0)+(M?0:g.crossAxis),R=o.reference[u]+o.reference[C]+(M?0:((x=s.offset)==null?void 0:x[u])||0)-(M?g.crossAxis:0);h<T?h=T:h>R&&(h=R)}return{[p]:f,[u]:h}}}},yu=function(t){return t===void 0&&(t={}),{name:"size",options:t,async fn(e){var n,r;let{placement:i,rects:o,platform:s,elements:a}=e,{apply:l=()=>{},...c}=$e(t,e),d=await Ht(e,c),u=Re(i),p=Ue(i),f=_e(i)==="y",{width:h,height:m}=o.floating,g,y;u==="top"||u==="bottom"?(g=u,y=p===(await(s.isRTL==null?void 0:s.isRTL(a.floating))?"start":"end")?"left":"right"):(y=u,g=p==="end"?"top":"bottom");let x=m-d.top-d.bottom,C=h-d.left-d.right,M=De(m-d[g],x),T=De(h-d[y],C),R=!e.middlewareData.shift,S=M,P=T;if((n=e.middlewareData.shift)!=null&&n.enabled.x&&(P=C),(r=e.middlewareData.shift)!=null&&r.enabled.y&&(S=x),R&&!p){let b=ae(d.left,0),Y=ae(d.right,0),w=ae(d.top,0),N=ae(d.bottom,0);f?P=h-2*(b!==0||Y!==0?b+Y:ae(d.left,d.right)):S=m-2*(w!==0||N!==0?w+N:ae(d.top,d.bottom))}await l({...e,availableWidth:P,availableHeight:S});let D=await s.getDimensions(a.floating);return h!==D.width||m!==D.height?{reset:{rects:!0}}:{}}}}});function Yi(){return typeof window!="undefined"}function sn(t){return Cu(t)?(t.nodeName||"").toLowerCase():"#document"}function Oe(t){var e;return(t==null||(e=t.ownerDocument)==null?void 0:e.defaultView)||window}function it(t){var e;return(e=(Cu(t)?t.ownerDocument:t.document)||window.document)==null?void 0:e.documentElement}function Cu(t){return Yi()?t instanceof Node||t instanceof Oe(t).Node:!1}function je(t){return Yi()?t instanceof Element||t instanceof Oe(t).Element:!1}function ot(t){return Yi()?t instanceof HTMLElement||t instanceof Oe(t).HTMLElement:!1}function xu(t){return!Yi()||typeof ShadowRoot=="undefined"?!1:t instanceof ShadowRoot||t instanceof Oe(t).ShadowRoot}function jn(t){let{overflow:e,overflowX:n,overflowY:r,display:i}=We(t);return/auto|scroll|overlay|hidden|clip/.test(e+r+n)&&!Eb.has(i)}function Eu(t){return vb.has(sn(t))}function Dr(t){return Tb.some(e=>{try{return t.matches(e)}catch{return!1}})}function Qi(t){let e=Xi(),n=je(t)?We(t):t;return Sb.some(r=>n[r]?n[r]!=="none":!1)||(n.containerType?n.containerType!=="normal":!1)||!e&&(n.backdropFilter?n.backdropFilter!=="none":!1)||!e&&(n.filter?n.filter!=="none":!1)||kb.some(r=>(n.willChange||"").includes(r))||Mb.some(r=>(n.contain||"").includes(r))}function vu(t){let e=xt(t);for(;ot(e)&&!an(e);){if(Qi(e))return e;if(Dr(e))return null;e=xt(e)}return null}function Xi(){return typeof CSS=="undefined"||!CSS.supports?!1:CSS.supports("-webkit-backdrop-filter","none")}function an(t){return wb.has(sn(t))}function We(t){return Oe(t).getComputedStyle(t)}function Hr(t){return je(t)?{scrollLeft:t.scrollLeft,scrollTop:t.scrollTop}:{scrollLeft:t.scrollX,scrollTop:t.scrollY}}function xt(t){if(sn(t)==="html")return t;let e=t.assignedSlot||t.parentNode||xu(t)&&t.host||it(t);return xu(e)?e.host:e}function Tu(t){let e=xt(t);return an(e)?t.ownerDocument?t.ownerDocument.body:t.body:ot(e)&&jn(e)?e:Tu(e)}function Ft(t,e,n){var r;e===void 0&&(e=[]),n===void 0&&(n=!0);let i=Tu(t),o=i===((r=t.ownerDocument)==null?void 0:r.body),s=Oe(i);if(o){let a=Zi(s);return e.concat(s,s.visualViewport||[],jn(i)?i:[],a&&n?Ft(a):[])}return e.concat(i,Ft(i,[],n))}function Zi(t){return t.parent&&Object.getPrototypeOf(t.parent)?t.frameElement:null}var Eb,vb,Tb,Sb,kb,Mb,wb,ea=Si(()=>{Eb=new Set(["inline","contents"]);vb=new Set(["table","td","th"]);Tb=[":popover-open",":modal"];Sb=["transform","translate","scale","rotate","perspective"],kb=["transform","translate","scale","rotate","perspective","filter"],Mb=["paint","layout","strict","content"];wb=new Set(["html","body","#document"])});var Pu={};ki(Pu,{arrow:()=>Qb,autoPlacement:()=>qb,autoUpdate:()=>_b,computePosition:()=>ex,detectOverflow:()=>jb,flip:()=>Jb,getOverflowAncestors:()=>Ft,hide:()=>Yb,inline:()=>Xb,limitShift:()=>Zb,offset:()=>Wb,platform:()=>Nu,shift:()=>Kb,size:()=>Gb});function wu(t){let e=We(t),n=parseFloat(e.width)||0,r=parseFloat(e.height)||0,i=ot(t),o=i?t.offsetWidth:n,s=i?t.offsetHeight:r,a=Ir(n)!==o||Ir(r)!==s;return a&&(n=o,r=s),{width:n,height:r,$:a}}function na(t){return je(t)?t:t.contextElement}function Wn(t){let e=na(t);if(!ot(e))return rt(1);let n=e.getBoundingClientRect(),{width:r,height:i,$:o}=wu(e),s=(o?Ir(n.width):n.width)/r,a=(o?Ir(n.height):n.height)/i;return(!s||!Number.isFinite(s))&&(s=1),(!a||!Number.isFinite(a))&&(a=1),{x:s,y:a}}function Au(t){let e=Oe(t);return!Xi()||!e.visualViewport?Ab:{x:e.visualViewport.offsetLeft,y:e.visualViewport.offsetTop}}function Rb(t,e,n){return e===void 0&&(e=!1),!n||e&&n!==Oe(t)?!1:e}function ln(t,e,n,r){e===void 0&&(e=!1),n===void 0&&(n=!1);let i=t.getBoundingClientRect(),o=na(t),s=rt(1);e&&(r?je(r)&&(s=Wn(r)):s=Wn(t));let a=Rb(o,n,r)?Au(o):rt(0),l=(i.left+a.x)/s.x,c=(i.top+a.y)/s.y,d=i.width/s.x,u=i.height/s.y;if(o){let p=Oe(o),f=r&&je(r)?Oe(r):r,h=p,m=Zi(h);for(;m&&r&&f!==h;){let g=Wn(m),y=m.getBoundingClientRect(),x=We(m),C=y.left+(m.clientLeft+parseFloat(x.paddingLeft))*g.x,M=y.top+(m.clientTop+parseFloat(x.paddingTop))*g.y;l*=g.x,c*=g.y,d*=g.x,u*=g.y,l+=C,c+=M,h=Oe(m),m=Zi(h)}}return bt({width:d,height:u,x:l,y:c})}function eo(t,e){let n=Hr(t).scrollLeft;return e?e.left+n:ln(it(t)).left+n}function Ru(t,e){let n=t.getBoundingClientRect(),r=n.left+e.scrollLeft-eo(t,n),i=n.top+e.scrollTop;return{x:r,y:i}}function Ob(t){let{elements:e,rect:n,offsetParent:r,strategy:i}=t,o=i==="fixed",s=it(r),a=e?Dr(e.floating):!1;if(r===s||a&&o)return n;let l={scrollLeft:0,scrollTop:0},c=rt(1),d=rt(0),u=ot(r);if((u||!u&&!o)&&((sn(r)!=="body"||jn(s))&&(l=Hr(r)),ot(r))){let f=ln(r);c=Wn(r),d.x=f.x+r.clientLeft,d.y=f.y+r.clientTop}let p=s&&!u&&!o?Ru(s,l):rt(0);return{width:n.width*c.x,height:n.height*c.y,x:n.x*c.x-l.scrollLeft*c.x+d.x+p.x,y:n.y*c.y-l.scrollTop*c.y+d.y+p.y}}function Lb(t){return Array.from(t.getClientRects())}function Nb(t){let e=it(t),n=Hr(t),r=t.ownerDocument.body,i=ae(e.scrollWidth,e.clientWidth,r.scrollWidth,r.clientWidth),o=ae(e.scrollHeight,e.clientHeight,r.scrollHeight,r.clientHeight),s=-n.scrollLeft+eo(t),a=-n.scrollTop;return We(r).direction==="rtl"&&(s+=ae(e.clientWidth,r.clientWidth)-i),{width:i,height:o,x:s,y:a}}function Ib(t,e){let n=Oe(t),r=it(t),i=n.visualViewport,o=r.clientWidth,s=r.clientHeight,a=0,l=0;if(i){o=i.width,s=i.height;let d=Xi();(!d||d&&e==="fixed")&&(a=i.offsetLeft,l=i.offsetTop)}let c=eo(r);if(c<=0){let d=r.ownerDocument,u=d.body,p=getComputedStyle(u),f=d.compatMode==="CSS1Compat"&&parseFloat(p.marginLeft)+parseFloat(p.marginRight)||0,h=Math.abs(r.clientWidth-u.clientWidth-f);h<=Su&&(o-=h)}else c<=Su&&(o+=c);return{width:o,height:s,x:a,y:l}}function Db(t,e){let n=ln(t,!0,e==="fixed"),r=n.top+t.clientTop,i=n.left+t.clientLeft,o=ot(t)?Wn(t):rt(1),s=t.clientWidth*o.x,a=t.clientHeight*o.y,l=i*o.x,c=r*o.y;return{width:s,height:a,x:l,y:c}}function ku(t,e,n){let r;if(e==="viewport")r=Ib(t,n);else if(e==="document")r=Nb(it(t));else if(je(e))r=Db(e,n);else{let i=Au(t);r={x:e.x-i.x,y:e.y-i.y,width:e.width,height:e.height}}return bt(r)}function Ou(t,e){let n=xt(t);return n===e||!je(n)||an(n)?!1:We(n).position==="fixed"||Ou(n,e)}function Hb(t,e){let n=e.get(t);if(n)return n;let r=Ft(t,[],!1).filter(a=>je(a)&&sn(a)!=="body"),i=null,o=We(t).position==="fixed",s=o?xt(t):t;for(;je(s)&&!an(s);){let a=We(s),l=Qi(s);!l&&a.position==="fixed"&&(i=null),(o?!l&&!i:!l&&a.position==="static"&&!!i&&Pb.has(i.position)||jn(s)&&!l&&Ou(t,s))?r=r.filter(d=>d!==s):i=a,s=xt(s)}return e.set(t,r),r}function Fb(t){let{element:e,boundary:n,rootBoundary:r,strategy:i}=t,s=[...n==="clippingAncestors"?Dr(e)?[]:Hb(e,this._c):[].concat(n),r],a=s[0],l=s.reduce((c,d)=>{let u=ku(e,d,i);return c.top=ae(u.top,c.top),c.right=De(u.right,c.right),c.bottom=De(u.bottom,c.bottom),c.left=ae(u.left,c.left),c},ku(e,a,i));return{width:l.right-l.left,height:l.bottom-l.top,x:l.left,y:l.top}}function Bb(t){let{width:e,height:n}=wu(t);return{width:e,height:n}}function Vb(t,e,n){let r=ot(e),i=it(e),o=n==="fixed",s=ln(t,!0,o,e),a={scrollLeft:0,scrollTop:0},l=rt(0);function c(){l.x=eo(i)}if(r||!r&&!o)if((sn(e)!=="body"||jn(i))&&(a=Hr(e)),r){let f=ln(e,!0,o,e);l.x=f.x+e.clientLeft,l.y=f.y+e.clientTop}else i&&c();o&&!r&&i&&c();let d=i&&!r&&!o?Ru(i,a):rt(0),u=s.left+a.scrollLeft-l.x-d.x,p=s.top+a.scrollTop-l.y-d.y;return{x:u,y:p,width:s.width,height:s.height}}function ta(t){return We(t).position==="static"}function Mu(t,e){if(!ot(t)||We(t).position==="fixed")return null;if(e)return e(t);let n=t.offsetParent;return it(t)===n&&(n=n.ownerDocument.body),n}function Lu(t,e){let n=Oe(t);if(Dr(t))return n;if(!ot(t)){let i=xt(t);for(;i&&!an(i);){if(je(i)&&!ta(i))return i;i=xt(i)}return n}let r=Mu(t,e);for(;r&&Eu(r)&&ta(r);)r=Mu(r,e);return r&&an(r)&&ta(r)&&!Qi(r)?n:r||vu(t)||n}function $b(t){return We(t).direction==="rtl"}function Iu(t,e){return t.x===e.x&&t.y===e.y&&t.width===e.width&&t.height===e.height}function Ub(t,e){let n=null,r,i=it(t);function o(){var a;clearTimeout(r),(a=n)==null||a.disconnect(),n=null}function s(a,l){a===void 0&&(a=!1),l===void 0&&(l=1),o();let c=t.getBoundingClientRect(),{left:d,top:u,width:p,height:f}=c;if(a||e(),!p||!f)return;let h=Pr(u),m=Pr(i.clientWidth-(d+p)),g=Pr(i.clientHeight-(u+f)),y=Pr(d),C={rootMargin:-h+"px "+-m+"px "+-g+"px "+-y+"px",threshold:ae(0,De(1,l))||1},M=!0;function T(R){let S=R[0].intersectionRatio;if(S!==l){if(!M)return s();S?s(!1,S):r=setTimeout(()=>{s(!1,1e-7)},1e3)}S===1&&!Iu(c,t.getBoundingClientRect())&&s(),M=!1}try{n=new IntersectionObserver(T,{...C,root:i.ownerDocument})}catch{n=new IntersectionObserver(T,C)}n.observe(t)}return s(!0),o}function _b(t,e,n,r){r===void 0&&(r={});let{ancestorScroll:i=!0,ancestorResize:o=!0,elementResize:s=typeof ResizeObserver=="function",layoutShift:a=typeof IntersectionObserver=="function",animationFrame:l=!1}=r,c=na(t),d=i||o?[...c?Ft(c):[],...Ft(e)]:[];d.forEach(y=>{i&&y.addEventListener("scroll",n,{passive:!0}),o&&y.addEventListener("resize",n)});let u=c&&a?Ub(c,n):null,p=-1,f=null;s&&(f=new ResizeObserver(y=>{let[x]=y;x&&x.target===c&&f&&(f.unobserve(e),cancelAnimationFrame(p),p=requestAnimationFrame(()=>{var C;(C=f)==null||C.observe(e)})),n()}),c&&!l&&f.observe(c),f.observe(e));let h,m=l?ln(t):null;l&&g();function g(){let y=ln(t);m&&!Iu(m,y)&&n(),m=y,h=requestAnimationFrame(g)}return n(),()=>{var y;d.forEach(x=>{i&&x.removeEventListener("scroll",n),o&&x.removeEventListener("resize",n)}),u==null||u(),(y=f)==null||y.disconnect(),f=null,l&&cancelAnimationFrame(h)}}var Ab,Su,Pb,zb,Nu,jb,Wb,qb,Kb,Jb,Gb,Yb,Qb,Xb,Zb,ex,Du=Si(()=>{bu();Gi();ea();ea();Ab=rt(0);Su=25;Pb=new Set(["absolute","fixed"]);zb=async function(t){let e=this.getOffsetParent||Lu,n=this.getDimensions,r=await n(t.floating);return{reference:Vb(t.reference,await e(t.floating),t.strategy),floating:{x:0,y:0,width:r.width,height:r.height}}};Nu={convertOffsetParentRelativeRectToViewportRelativeRect:Ob,getDocumentElement:it,getClippingRect:Fb,getOffsetParent:Lu,getElementRects:zb,getClientRects:Lb,getDimensions:Bb,getScale:Wn,isElement:je,isRTL:$b};jb=Ht,Wb=hu,qb=lu,Kb=mu,Jb=cu,Gb=yu,Yb=du,Qb=au,Xb=pu,Zb=gu,ex=(t,e,n)=>{let r=new Map,i={platform:Nu,...n},o={...i.platform,_c:r};return su(t,e,{...i,platform:o})}});var N0={};ki(N0,{FormgenClient:()=>Ty,loadForm:()=>Ey,renderForm:()=>ud});var fd="0.0.19",pd="__formgenRuntimeVersionAnnounced__";function Ly(){if(typeof globalThis=="undefined")return;let t=globalThis;if(t[pd]||(t[pd]=!0,typeof console=="undefined"))return;let e=typeof console.info=="function"?console.info:console.log;typeof e=="function"&&e(`[formgen-relationships] runtime version ${fd}`)}Ly();var Ps={strategy:"memory",ttlMs:3e5},Vn={baseUrl:"",cache:Ps,logger:void 0,searchThrottleMs:250,searchDebounceMs:250,retryAttempts:1,retryDelayMs:300},Ny=Vn;function hd(t={}){var n;let e={...Vn,...t,cache:{...Ps,...(n=t.cache)!=null?n:{}}};return e.cache.strategy||(e.cache.strategy="memory"),(typeof e.cache.ttlMs!="number"||Number.isNaN(e.cache.ttlMs))&&(e.cache.ttlMs=Ps.ttlMs),(typeof e.searchThrottleMs!="number"||e.searchThrottleMs<0)&&(e.searchThrottleMs=Vn.searchThrottleMs),(typeof e.searchDebounceMs!="number"||e.searchDebounceMs<0)&&(e.searchDebounceMs=Vn.searchDebounceMs),(typeof e.retryAttempts!="number"||e.retryAttempts<0)&&(e.retryAttempts=Vn.retryAttempts),(typeof e.retryDelayMs!="number"||e.retryDelayMs<0)&&(e.retryDelayMs=Vn.retryDelayMs),e}function md(){return Ny}var Mi=class{constructor(){this.store=new Map}get(e){let n=this.store.get(e);if(n){if(n.expiresAt&&n.expiresAt<=Date.now()){this.store.delete(e);return}return n.value}}set(e,n,r){let i=typeof r=="number"&&r>0?Date.now()+r:void 0;this.store.set(e,{value:n,expiresAt:i})}delete(e){this.store.delete(e)}clear(){this.store.clear()}};var se=class extends Error{constructor(e,n,r){super(e),this.name="ResolverError",this.status=n,this.detail=r}},nn=class extends Error{constructor(){super("Resolver request aborted"),this.name="ResolverAbortError"}},Iy="[data-relationship-type]",gd="data-relationship-error",Ds="inline",Hs=new Map;Hs.set(Ds,yd);function ze(t,e,n){var o,s;let r=t.dataset.validationRenderer||Ds;((s=(o=Hs.get(r))!=null?o:Hs.get(Ds))!=null?s:yd)({element:t,message:e,code:n})}function Me(t){ze(t,null)}function yd(t){var i,o;let e=(o=(i=t.element.closest(Iy))!=null?i:t.element.parentElement)!=null?o:t.element;if(!e)return;let n=e;e.classList.contains("relative")&&e.parentElement&&(n=e.parentElement);let r=n.querySelector(`[${gd}]`);r||(r=document.createElement("p"),r.setAttribute(gd,"true"),r.className="formgen-error text-xs text-red-600 mt-2 dark:text-red-400",r.setAttribute("role","status"),r.setAttribute("aria-live","polite"),r.setAttribute("aria-atomic","true"),e.classList.contains("relative")&&e.parentElement?e.parentElement.insertBefore(r,e.nextSibling):n.appendChild(r)),t.message&&t.message.trim()!==""?(r.textContent=t.message,r.removeAttribute("aria-hidden"),Py(t.element,t.message)):(r.textContent="",r.setAttribute("aria-hidden","true"),Dy(t.element))}function Py(t,e){t.setAttribute("aria-invalid","true"),t.setAttribute("data-validation-state","invalid"),t.setAttribute("data-validation-message",e),bd(t,!0)}function Dy(t){t.removeAttribute("aria-invalid"),t.removeAttribute("data-validation-state"),t.removeAttribute("data-validation-message"),bd(t,!1)}function bd(t,e){let n=t;if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement||t instanceof HTMLSelectElement||(n=t.querySelector("input, textarea, select")),!n)return;let r=["border-red-500","focus:border-red-500","focus:ring-red-500","dark:border-red-500"],i=["border-gray-200","focus:border-blue-500","focus:ring-blue-500","dark:border-gray-700","dark:focus:ring-gray-600"];e?(i.forEach(o=>n.classList.remove(o)),r.forEach(o=>n.classList.add(o))):(r.forEach(o=>n.classList.remove(o)),i.forEach(o=>n.classList.add(o)))}function Hy(t){return t.replace(/^data-/,"").split(/[-_:]/).filter(Boolean).map((e,n)=>n===0?e.toLowerCase():e.charAt(0).toUpperCase()+e.slice(1).toLowerCase()).join("")}function xd(t){var n;if(typeof document=="undefined")return;let e=document.querySelector(`meta[name="${t}"]`);return(n=e==null?void 0:e.getAttribute("content"))!=null?n:void 0}function Fs(t,e){var i;if(!t)return;if(t.hasAttribute(e))return(i=t.getAttribute(e))!=null?i:void 0;let n=Hy(e),r=t.dataset[n];return r!=null?r:void 0}function Fy(t,e){var n;if(t){if(t.startsWith("meta:"))return xd(t.slice(5));if(t.startsWith("data:"))return Fs(e,t.slice(5));if(t.startsWith("element:"))return Fs(e,t.slice(8));if(t.includes("=")){let[,r]=t.split("=");return r}return(n=Fs(e,t))!=null?n:xd(t)}}function Bs(t,e){var o,s;if(!t||t.strategy!=="header")return{};let n=(o=t.header)!=null?o:"Authorization",r=Fy((s=t.source)!=null?s:"data-auth-token",e);if(!r)return{};let i=t.prefix?`${t.prefix.trim()} ${r}`.trim():r;return{[n]:i}}var nt="formgen:relationship:update",Cd="formgen:relationship:create-action",Ed="formgen:relationship:edit-action";function fe(t,e){let n=e.kind==="selection"?Td(e.selectedValues):null;try{t.dispatchEvent(new CustomEvent(nt,{bubbles:!0,detail:e})),n&&vd.set(t,n)}catch{}}function Er(t,e){try{t.dispatchEvent(new CustomEvent(Cd,{bubbles:!0,detail:e}))}catch{}}function Vs(t,e){try{t.dispatchEvent(new CustomEvent(Ed,{bubbles:!0,detail:e}))}catch{}}function vr(t){if(!(t instanceof HTMLSelectElement))return;let e=t;e.dataset.formgenRelationshipSelectionBridge!=="true"&&(e.dataset.formgenRelationshipSelectionBridge="true",e.addEventListener("change",n=>{let r=By(e),i=Td(r);vd.get(e)!==i&&fe(e,{kind:"selection",origin:n.isTrusted?"ui":"program",selectedValues:r})}))}var vd=new WeakMap;function By(t){return Array.from(t.selectedOptions).map(e=>e.value).filter(e=>e!=="")}function Td(t){return t.slice().sort((e,n)=>e.localeCompare(n)).join("\0")}var Sd='[data-endpoint-url], [data-endpoint-renderer="chips"]',kd="data-relationship-hidden",Md="data-relationship-hidden-initialised",wd="data-relationship-json-initialised",Ad="data-relationship-json",wi="data-relationship-submit-mode",Tr="data-relationship-original-name";function zs(t=document){let e=(t instanceof Document,t),n=Array.from(e.querySelectorAll(Sd));return t instanceof HTMLElement&&t.matches(Sd)&&n.unshift(t),Array.from(new Set(n))}function It(t){let e={};for(let[n,r]of Object.entries(t.dataset))typeof r=="string"&&(e[n]=r);return e}function $s(t){return t instanceof HTMLSelectElement&&t.multiple}function Rd(t){t.getAttribute(wi)!=="json"&&(t.hasAttribute(Md)||(t.setAttribute(Md,"true"),t.setAttribute(wi,"hidden-array"),vr(t),rn(t),t.addEventListener(nt,e=>{e.detail.kind==="selection"&&rn(t)})))}function rn(t){if(t.getAttribute(wi)==="json"){Nt(t);return}let e=Od(t);for(;e.firstChild;)e.removeChild(e.firstChild);let n=t.name||t.id;if(!n)return;let r=n.endsWith("[]")?n:`${n}[]`;Array.from(t.selectedOptions).forEach(i=>{let o=document.createElement("input");o.type="hidden",o.name=r,o.value=i.value,e.appendChild(o)})}function Od(t){var r,i,o;let e=(r=t.parentElement)==null?void 0:r.querySelector(`[${kd}]`);if(e)return e;let n=document.createElement("div");return n.setAttribute(kd,"true"),n.style.display="none",t.parentElement?t.parentElement.appendChild(n):t.nextSibling?(i=t.parentNode)==null||i.insertBefore(n,t.nextSibling):(o=t.parentNode)==null||o.appendChild(n),n}function Ld(t){if(t.hasAttribute(wd))return;t.setAttribute(wd,"true"),t.setAttribute(wi,"json"),vr(t);let e=t.getAttribute("name");e&&(t.setAttribute(Tr,e),t.removeAttribute("name")),Nt(t),t.addEventListener(nt,n=>{n.detail.kind==="selection"&&Nt(t)}),t.addEventListener("blur",()=>Nt(t))}function Nt(t){var o,s;let e=Od(t),n=e.querySelector(`[${Ad}]`);n||(n=document.createElement("input"),n.type="hidden",n.setAttribute(Ad,"true"),e.appendChild(n));let r=(o=t.getAttribute(Tr))!=null?o:t.getAttribute("name");if(r){let a=r.endsWith("[]")?r.slice(0,r.length-2):r;n.name=a}let i=Array.from(t.selectedOptions).map(a=>a.value);if(t.multiple)n.value=JSON.stringify(i);else{let a=(s=i[0])!=null?s:"";n.value=a?JSON.stringify(a):"null"}}function Ai(t){if(!t)return null;if(t instanceof HTMLInputElement)return t.type==="checkbox"||t.type==="radio"?t.checked?t.value:null:t.value;if(t instanceof HTMLSelectElement){if(t.multiple)return Array.from(t.selectedOptions).map(n=>n.value);let e=t.selectedOptions[0];return e?e.value:null}return t instanceof HTMLTextAreaElement?t.value:t.textContent}function Li(t,e){var a;let n=[],r=zy(t),i={field:t,value:e};if($y(t)&&Hd(e)){let l=Vy(i,r);return l?(n.push(l),Ri(n)):(n.push({code:"required",message:`${r} is required.`,value:e}),Ri(n))}let o=Fd(e);t.cardinality==="one"&&o.length>1&&n.push({code:"cardinality",message:`Select only one ${r.toLowerCase()}.`,value:e});let s=(a=t.validations)!=null?a:[];for(let l of s){let c=Dd(l,i,r);c&&n.push(c)}return Ri(n)}function Vy(t,e){var n;for(let r of(n=t.field.validations)!=null?n:[]){if(r.kind!=="minItems")continue;let i=Dd(r,t,e);if(i)return i}return null}function Pd(...t){var n;let e=[];for(let r of t)!r||r.valid||e.push(...(n=r.errors)!=null?n:[]);return Ri(e)}function Dd(t,e,n){var i,o,s,a,l,c,d,u,p;let r=e.value;if(Hd(r)&&t.kind!=="minItems")return null;switch(t.kind){case"min":{let f=zn((i=t.params)==null?void 0:i.value),h=Nd(r);if(f==null||h==null)return null;let m=((o=t.params)==null?void 0:o.exclusive)==="true";if(m?h<=f:h<f)return{code:"min",message:`${n} must be ${m?"greater than":"at least"} ${f}.`,rule:t,value:r};break}case"max":{let f=zn((s=t.params)==null?void 0:s.value),h=Nd(r);if(f==null||h==null)return null;let m=((a=t.params)==null?void 0:a.exclusive)==="true";if(m?h>=f:h>f)return{code:"max",message:`${n} must be ${m?"less than":"no more than"} ${f}.`,rule:t,value:r};break}case"minLength":{let f=zn((l=t.params)==null?void 0:l.value),h=Oi(r);if(f==null||h==null)return null;if(h.length<f)return{code:"minLength",message:`${n} must be at least ${f} characters.`,rule:t,value:r};break}case"maxLength":{let f=zn((c=t.params)==null?void 0:c.value),h=Oi(r);if(f==null||h==null)return null;if(h.length>f)return{code:"maxLength",message:`${n} must be at most ${f} characters.`,rule:t,value:r};break}case"minItems":{let f=zn((d=t.params)==null?void 0:d.value),h=Id(r);if(f==null||h==null)return null;if(h<f)return{code:"minItems",message:`${n} must contain at least ${f} items.`,rule:t,value:r};break}case"maxItems":{let f=zn((u=t.params)==null?void 0:u.value),h=Id(r);if(f==null||h==null)return null;if(h>f)return{code:"maxItems",message:`${n} must contain at most ${f} items.`,rule:t,value:r};break}case"pattern":{let f=(p=t.params)==null?void 0:p.pattern,h=Oi(r);if(!f||h==null)return null;try{if(!new RegExp(f).test(h))return{code:"pattern",message:`Enter a valid ${n.toLowerCase()}.`,rule:t,value:r}}catch{return null}break}default:return null}return null}function Ri(t){return t.length===0?{valid:!0,messages:[],errors:[]}:{valid:!1,errors:t,messages:t.map(e=>e.message)}}function zy(t){return t.label&&t.label.trim()!==""?t.label.trim():t.name&&t.name.trim()!==""?t.name.trim():"This field"}function $y(t){return t.required===!0}function Hd(t){return t==null?!0:Array.isArray(t)?t.length===0||t.every(e=>e==null||e===""):String(t).trim()===""}function Fd(t){return t?Array.isArray(t)?t.filter(e=>e!=null&&e!==""):String(t)===""?[]:[String(t)]:[]}function Nd(t){let e=Oi(t);if(e==null||e.trim()==="")return null;let n=Number(e);return Number.isFinite(n)?n:null}function Oi(t){var e;return t==null?null:Array.isArray(t)?t.length===0?null:(e=t[0])!=null?e:null:String(t)}function Id(t){return t==null?null:Array.isArray(t)?Fd(t).length:String(t).trim()===""?0:1}function zn(t){if(t==null)return null;let e=Number(t);return Number.isFinite(e)?e:null}function Us(t){var e;return typeof t=="string"?t.trim():String((e=t.value)!=null?e:"").trim()}function Uy(t){var e;return typeof t!="string"&&String((e=t.label)!=null?e:"").trim()!==""}function _y(t){var r;if(typeof t=="string")return t?{value:t,label:t}:null;let e=Us(t),n=String((r=t.label)!=null?r:e).trim();return e?{value:e,label:n||e}:null}function _s(t,e){if(t==null)return[];let r=(Array.isArray(t)?t:[t]).map(_y).filter(i=>i!=null);return e?r:r.slice(0,1)}function Bd(t,e=!0){if(t==null)return[];let r=(Array.isArray(t)?t:[t]).map(Us).filter(i=>i.length>0);return e?r:r.slice(0,1)}function Ni(t){return t==null?[]:(Array.isArray(t)?t:[t]).filter(n=>!Uy(n)).map(Us).filter(n=>n.length>0)}var jy=/\{\{\s*([^}]+)\s*\}\}/g,Vd="Unable to load options.",js="formgen:resolver-superseded";function Ii(){return typeof performance!="undefined"&&typeof performance.now=="function"?performance.now():Date.now()}function zd(t){return new Promise(e=>{setTimeout(e,t)})}function Wy(t,e){if(!t)return!1;if(t===e||t===`${e}[]`||t.endsWith(`[${e}]`)||t.endsWith(`[${e}][]`))return!0;let n=t.replace(/[^a-z0-9]/gi,"").toLowerCase(),r=e.replace(/[^a-z0-9]/gi,"").toLowerCase();return n.endsWith(r)}function $d(t){if(t!=null)return Array.isArray(t)?t.length===0?void 0:t.join(","):t===""?void 0:t}function Ud(t){return!!t&&typeof t=="object"&&(t.name==="AbortError"||t instanceof nn)}function qy(){if(typeof DOMException=="function")return new DOMException(js,"AbortError");let t=new Error(js);return t.name="AbortError",t}var Sr=class{constructor(e){this.state={};this.abortController=null;this.lastRequest=null;this.lastContext=null;this.preserveServerValidation=!1;this.userInteracted=!1;this.refreshing=!1;this.interactionHandler=()=>this.handleUserInteraction();this.element=e.element,this.field=e.field,this.endpoint=e.endpoint,this.config=e.config,this.cacheAdapter=e.cacheAdapter,this.sessionCacheAdapter=e.sessionCacheAdapter,this.cacheConfig=e.cacheConfig,this.dispatchEvent=e.dispatchEvent,this.renderers=e.renderers,this.defaultRenderer=e.defaultRenderer,this.customResolvers=e.customResolvers,this.element instanceof HTMLSelectElement&&(vr(this.element),this.field.submitAs==="json"?Ld(this.element):$s(this.element)&&Rd(this.element)),this.captureServerValidationFromDom(),this.bindInteractionHandlers()}async resolve(){var c,d,u,p;this.cancelInFlight();let e=Ii(),n=await this.buildRequest(),r=!1,i;this.dispatchEvent(this.element,"loading",{element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:null,fromCache:!1});let o=this.fieldCacheAdapter();if(n.cacheKey&&o&&!this.refreshing){let f=await o.get(n.cacheKey);Array.isArray(f)&&f.length>0&&(i=f,r=!0)}if(!i){let f=this.createContext(n,!1);for(let h of this.customResolvers)try{if(h.matches(f)){let m=await h.resolve(f);if(Array.isArray(m)){i=m;break}}}catch(m){throw this.handleError(m,n,null,e,0),m}}let s=null;try{i||(s=await this.fetchOptions(n,e),i=s.options,r=s.fromCache)}catch(f){return f instanceof nn||Ud(f)?{options:[],fromCache:!1}:{options:[],fromCache:!1}}let a=this.createContext(n,r);this.config.transformOptions&&(i=await this.config.transformOptions(a,i)),this.refreshing&&(i=Yy(this.element,i)),await this.renderOptions(i,r),this.field.current!=null&&(this.field.current=null);let l={element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:(c=s==null?void 0:s.response)!=null?c:null,options:i,fromCache:r,durationMs:Ii()-e};return this.dispatchEvent(this.element,"success",l),n.cacheKey&&o&&!r&&await o.set(n.cacheKey,i,{ttlMs:(d=this.field.cacheTtlMs)!=null?d:this.cacheConfig.ttlMs,field:this.field,endpoint:this.endpoint}),s&&await((p=(u=this.config).afterFetch)==null?void 0:p.call(u,a,s)),this.element instanceof HTMLSelectElement?this.element.getAttribute("data-relationship-submit-mode")==="json"?Nt(this.element):this.element.multiple&&rn(this.element):$s(this.element)&&rn(this.element),this.abortController=null,await this.runValidation("auto"),{options:i,fromCache:r}}async refresh(){this.refreshing=!0;try{return await this.resolve()}finally{this.refreshing=!1}}async validate(){return this.runValidation("manual")}async create(e){let n=e.trim();if(!n)throw new se("Create request requires a non-empty query.");if(!this.config.createOption)throw new se("createOption is not configured.");let r=await this.buildCreateRequest(n),i=this.createContext(r,!1),o=await this.config.createOption(i,n);if(!o||typeof o!="object")throw new se("createOption returned an invalid option.");if(typeof o.value!="string"||typeof o.label!="string")throw new se("createOption must return an Option with string value/label.");return o}setCurrentValue(e){this.field.current=e!=null?e:null}setServerValidation(e){if(!e){this.state.validation=void 0,this.preserveServerValidation=!1;return}this.state.validation=e,this.preserveServerValidation=!e.valid,!e.valid&&(this.userInteracted=!1)}captureServerValidationFromDom(){var r,i,o;if(this.element.getAttribute("data-validation-state")!=="invalid")return;let n=((r=this.element.getAttribute("data-validation-message"))!=null?r:"").split(";").map(s=>s.trim()).filter(s=>s.length>0);if(n.length===0){let s=(o=(i=this.field.label)!=null?i:this.field.name)!=null?o:"This field";n.push(`${s} is invalid.`)}this.state.validation=this.buildServerValidationResult(n),this.preserveServerValidation=!0}bindInteractionHandlers(){this.element.addEventListener("input",this.interactionHandler),this.element.addEventListener("change",this.interactionHandler)}destroy(){this.cancelInFlight(),this.element.removeEventListener("input",this.interactionHandler),this.element.removeEventListener("change",this.interactionHandler)}handleUserInteraction(){this.preserveServerValidation&&(this.preserveServerValidation=!1),this.userInteracted=!0}cancelInFlight(){this.abortController&&(this.abortController.abort(qy()),this.abortController=null)}async runValidation(e="manual"){var o,s,a,l,c,d,u,p,f,h,m,g;if(e==="manual")this.preserveServerValidation=!1;else if(e==="auto"&&this.preserveServerValidation)return(a=this.state.validation)!=null?a:this.buildServerValidationResult([(s=(o=this.field.label)!=null?o:this.field.name)!=null?s:"This field is invalid."]);let n=Ai(this.element),r=Li(this.field,n);if(this.config.validateSelection)try{let y=await this.config.validateSelection(this.getActiveContext(),n);y&&(r=Pd(r,y))}catch(y){(c=(l=this.config.logger)==null?void 0:l.warn)==null||c.call(l,"resolver:validation:hook",y)}if(this.state.validation=r,r.valid)Me(this.element);else{let y=r.errors[0],x=(u=(d=y==null?void 0:y.message)!=null?d:r.messages[0])!=null?u:Vd;if(ze(this.element,x,y==null?void 0:y.code),r.errors.length>0){let C=this.getActiveContext();for(let M of r.errors)try{(f=(p=this.config).onValidationError)==null||f.call(p,C,M)}catch(T){(m=(h=this.config.logger)==null?void 0:h.warn)==null||m.call(h,"resolver:onValidationError",T)}}}let i={element:this.element,field:this.field,endpoint:this.endpoint,request:(g=this.lastRequest)!=null?g:this.buildRequestStub(),response:null,fromCache:!1,validation:r};return this.dispatchEvent(this.element,"validation",i),r}buildServerValidationResult(e){return e.length===0?{valid:!0,messages:[],errors:[]}:{valid:!1,messages:e,errors:e.map(n=>({code:"server",message:n}))}}async fetchOptions(e,n){var c,d,u,p,f,h,m,g;let r=this.createContext(e,!1),i=(c=globalThis.fetch)==null?void 0:c.bind(globalThis);if(!i){let y=new se("Fetch API unavailable in current environment");throw this.handleError(y,e,null,n,0),y}let o=Math.max(0,(d=this.config.retryAttempts)!=null?d:0)+1,s=Math.max(0,(u=this.config.retryDelayMs)!=null?u:0),a=0,l=null;for(;a<o;){if((p=r.request.init.signal)!=null&&p.aborted)throw new nn;await((h=(f=this.config).beforeFetch)==null?void 0:h.call(f,r));try{let y=await i(e.url,e.init);if(!y.ok){let M=await _d(y),T=new se(`Resolver request failed with status ${y.status}`,y.status,M);if(a<o-1&&Gy(y.status)){a+=1,l=T,await zd(s*a);continue}throw this.handleError(T,e,y,n,a),T}let x=await _d(y),C=this.extractOptions(x);return(g=(m=this.config.logger)==null?void 0:m.info)==null||g.call(m,"resolver:success",{field:this.field.name,url:e.url,status:y.status,durationMs:Ii()-n,attempt:a+1}),{response:y,payload:x,options:C,fromCache:!1}}catch(y){if(Ud(y))throw new nn;if(l=y,a<o-1){a+=1,await zd(s*a);continue}let x=y instanceof se?y:new se(y instanceof Error?y.message:String(y!=null?y:"Unknown error"),void 0,y instanceof Error?{name:y.name,stack:y.stack}:void 0);throw this.handleError(x,e,null,n,a),x}}throw l instanceof se?l:new se("Resolver exhausted retries",void 0,l)}async buildRequest(){var c,d,u;let e=(this.endpoint.method||"GET").toUpperCase(),n=new URLSearchParams((c=this.endpoint.params)!=null?c:{});if(this.applyDynamicParams(n),this.field.mode==="search"){let p=this.getSearchValue(),f=p!==void 0&&p.trim().length>0,h=f?void 0:this.getHydrateCurrentValue();h&&this.endpoint.hydrateParam?(n.set(this.endpoint.hydrateParam,h),this.field.searchParam&&n.delete(this.field.searchParam)):this.field.searchParam&&(f?n.set(this.field.searchParam,p):n.delete(this.field.searchParam))}!this.endpoint.resultsPath&&!this.endpoint.mapping&&(Array.from(n.keys()).some(f=>f.toLowerCase()==="format")||n.set("format","options"));let r=this.normaliseBaseUrl((d=this.endpoint.url)!=null?d:""),i=n.toString(),o=i?`${r}?${i}`:r,s={Accept:"application/json"};Object.assign(s,Bs((u=this.endpoint.auth)!=null?u:this.config.auth,this.element)),this.abortController=new AbortController;let a={url:o,cacheKey:this.computeCacheKey(e,o),init:{method:e,headers:s,signal:this.abortController.signal}},l=this.createContext(a,!1);if(this.config.buildHeaders){let p=await this.config.buildHeaders(l);p&&typeof p=="object"&&Object.assign(a.init.headers,p)}return a}async buildCreateRequest(e){var d,u,p;let n=new URLSearchParams((d=this.endpoint.params)!=null?d:{});this.applyDynamicParams(n);let r=this.normaliseBaseUrl((u=this.endpoint.url)!=null?u:""),i=n.toString(),o=i?`${r}?${i}`:r,s={Accept:"application/json","Content-Type":"application/json"};Object.assign(s,Bs((p=this.endpoint.auth)!=null?p:this.config.auth,this.element));let a=new AbortController,l={url:o,init:{method:"POST",headers:s,signal:a.signal}},c=this.createContext(l,!1);if(this.config.buildHeaders){let f=await this.config.buildHeaders(c);f&&typeof f=="object"&&Object.assign(l.init.headers,f)}return l.init.body=JSON.stringify({query:e}),l}applyDynamicParams(e){var r;let n=(r=this.endpoint.dynamicParams)!=null?r:{};Object.entries(n).forEach(([i,o])=>{let s=this.resolveDynamicTemplate(o);s==null||s===""?e.delete(i):e.set(i,s)})}resolveDynamicTemplate(e){if(!e)return;let n=!1,r=e.replace(jy,(o,s)=>{var l,c;n=!0;let a=s.trim();if(!a)return"";if(a.startsWith("field:")){let d=a.slice(6);return(l=this.getFieldReferenceValue(d))!=null?l:""}return(a==="self"||a==="value")&&(c=this.getSelfValue())!=null?c:""});if(!n)return e;let i=r.trim();return i.length>0?i:void 0}getSelfValue(){if(this.field.mode==="search"){let n=this.getSearchValue();if(n!==void 0)return n===""?void 0:n}let e=Ai(this.element);return $d(e)}getSearchValue(){if(!(this.element instanceof HTMLElement))return;let e=this.element.getAttribute("data-endpoint-search-value");if(e!=null)return e;let n=this.element.dataset.endpointSearchValue;return n!=null?n:void 0}getHydrateCurrentValue(){let e=this.field.current;if(e==null)return;let n=Ni(e);if(n.length!==0)return Array.from(new Set(n)).join(",")}getFieldReferenceValue(e){let n=this.findDependencyElements(e);for(let r of n){let i=Ai(r),o=$d(i);if(o!==void 0)return o}}findDependencyElements(e){var s;let n=[],r=new Set,i=this.element.closest("form"),o=[];if(i){let a=Array.from(i.elements);for(let l of a)l instanceof HTMLElement&&o.push(l)}else{let a=(s=this.element.ownerDocument)!=null?s:document;o.push(...Array.from(a.querySelectorAll("[name], [data-field-name], [id]")))}for(let a of o){if(r.has(a))continue;let l=a.dataset.fieldName;if(l&&l===e){n.push(a),r.add(a);continue}let c=a.name;if(Wy(c,e)){n.push(a),r.add(a);continue}let d=a.id;d&&d===e&&(n.push(a),r.add(a))}return n}normaliseBaseUrl(e){var i,o;if(/^[a-z][a-z0-9+.-]*:/i.test(e))return e;let n=(o=(i=this.config.baseUrl)==null?void 0:i.replace(/\/$/,""))!=null?o:"",r=e.startsWith("/")?e:`/${e}`;return`${n}${r}`||e}fieldCacheAdapter(){var e;switch(this.field.cacheStrategy){case"none":return;case"session":return(e=this.sessionCacheAdapter)!=null?e:this.cacheAdapter;default:return this.cacheAdapter}}computeCacheKey(e,n){var r;return typeof this.field.cacheKey=="string"&&this.field.cacheKey.length>0?this.field.cacheKey:(r=this.config.cache)!=null&&r.keyFactory?this.config.cache.keyFactory(this.createContext({url:n,init:{method:e}},!1)):`${e.toUpperCase()}::${n}`}createContext(e,n){let r={element:this.element,field:this.field,endpoint:this.endpoint,request:e,fromCache:n,config:this.config};return this.lastRequest=e,this.lastContext=r,r}getActiveContext(){if(this.lastContext)return this.lastContext;let e=this.buildRequestStub();return this.createContext(e,!1)}buildRequestStub(){var n;let e=(this.endpoint.method||"GET").toUpperCase();return{url:this.normaliseBaseUrl((n=this.endpoint.url)!=null?n:""),init:{method:e,headers:{}}}}resolveRenderer(){var r,i;let e=(r=this.field.renderer)!=null?r:this.defaultRenderer,n=(i=this.renderers.get(e))!=null?i:this.renderers.get(this.defaultRenderer);if(!n)throw new se(`Renderer '${e}' is not registered`);return n}async renderOptions(e,n){let r=this.resolveRenderer(),i={element:this.element,field:this.field,options:e,fromCache:n,config:this.config};await r(i)}extractOptions(e){return this.unwrapPayload(e).map(r=>this.mapOption(r))}unwrapPayload(e){if(!this.endpoint.resultsPath){if(Array.isArray(e))return e;if(e&&typeof e=="object"&&"data"in e){let i=e.data;if(Array.isArray(i))return i}throw new se("Resolver response payload is not an array")}let n=this.endpoint.resultsPath.split(".").filter(Boolean),r=e;for(let i of n){if(r==null)break;r=r[i]}if(!Array.isArray(r))throw new se("Resolver resultsPath did not resolve to an array");return r}mapOption(e){var u,p,f,h,m,g,y,x;if(e==null)return{value:"",label:""};if(typeof e!="object"){let C=String(e);return{value:C,label:C,raw:e}}let n=(f=(p=(u=this.endpoint.mapping)==null?void 0:u.value)!=null?p:this.endpoint.valueField)!=null?f:"value",r=(g=(m=(h=this.endpoint.mapping)==null?void 0:h.label)!=null?m:this.endpoint.labelField)!=null?g:"label",i=(y=this.endpoint.mapping)==null?void 0:y.meta,o=typeof e=="object"&&e!==null?e:null,s=Pi(e,n),a=Pi(e,r),l=i?Pi(e,i):(x=o==null?void 0:o.metadata)!=null?x:o==null?void 0:o.meta;if(s==null&&o&&(o.value!=null?s=o.value:o.id!=null&&(s=o.id)),a==null&&o&&(o.label!=null?a=o.label:o.name!=null&&(a=o.name)),this.endpoint.labelTemplate){let C=Jy(this.endpoint.labelTemplate,e).trim();C&&(a=C)}let c=s==null?"":String(s),d=a==null?c:String(a);return{value:c,label:d,description:typeof(o==null?void 0:o.description)=="string"?String(o.description):void 0,disabled:(o==null?void 0:o.disabled)===!0,meta:l,raw:e}}handleError(e,n,r,i,o){var l,c,d,u;let s=e instanceof se?e:new se(String(e||""));ze(this.element,s.message||Vd);let a={element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:r,error:s,fromCache:!1,durationMs:Ii()-i,attempt:o+1};(c=(l=this.config.logger)==null?void 0:l.error)==null||c.call(l,"resolver:error",{field:this.field.name,url:n.url,status:s.status,durationMs:a.durationMs,attempt:a.attempt,message:s.message}),(u=(d=this.config).onError)==null||u.call(d,this.createContext(n,!1),s),this.dispatchEvent(this.element,"error",a)}};async function _d(t){let e=await t.text();if(!e)return null;try{return JSON.parse(e)}catch(n){throw new se("Resolver received invalid JSON",t.status,{error:n,body:e})}}function Pi(t,e){if(!e)return;let n=e.split(".").filter(Boolean),r=t;for(let i of n){if(r==null)return;r=r[i]}return r}var Ky=/\{\{\s*item\.([^{}\s]+)\s*\}\}/g;function Jy(t,e){return t.replace(Ky,(n,r)=>{let i=Pi(e,r);return i==null?"":String(i)})}function Gy(t){return typeof t!="number"?!1:t>=500}function Yy(t,e){var i;if(!(t instanceof HTMLSelectElement))return e;let n=new Set(e.map(o=>o.value)),r=[];for(let o of Array.from(t.selectedOptions))!o.value||n.has(o.value)||(n.add(o.value),r.push({value:o.value,label:(i=o.textContent)!=null?i:o.value}));return r.length>0?[...r,...e]:e}var jd="default",qs=class{constructor(){this.cache=new Mi}get(e){return this.cache.get(e)}set(e,n,r){this.cache.set(e,n,r.ttlMs)}delete(e){this.cache.delete(e)}clear(){this.cache.clear()}},kr="formgen:options:",Ks=class{get(e){let n=Di();if(n)try{let r=n.getItem(kr+e);if(!r)return;let i=JSON.parse(r);if(typeof i.expiresAt=="number"&&i.expiresAt<=Date.now()){n.removeItem(kr+e);return}return Array.isArray(i.value)?i.value:void 0}catch{return}}set(e,n,r){let i=Di();if(!i)return;let o=typeof r.ttlMs=="number"&&r.ttlMs>0?Date.now()+r.ttlMs:void 0;try{i.setItem(kr+e,JSON.stringify({value:n,expiresAt:o}))}catch{}}delete(e){var n;(n=Di())==null||n.removeItem(kr+e)}clear(){let e=Di();if(e)for(let n=e.length-1;n>=0;n-=1){let r=e.key(n);r&&r.startsWith(kr)&&e.removeItem(r)}}};function Di(){try{return typeof window!="undefined"?window.sessionStorage:void 0}catch{return}}function Qy(t){let{element:e,options:n,field:r}=t;if(e instanceof HTMLSelectElement){let i=new Set(Array.from(e.selectedOptions).map(s=>s.value)),o=Xy(r,i);e.innerHTML="",n.forEach(s=>{let a=document.createElement("option");if(a.value=s.value,a.textContent=s.label,a.disabled=s.disabled===!0,s.description&&(a.dataset.optionDescription=s.description),s.meta!=null)try{a.dataset.optionMetadata=JSON.stringify(s.meta)}catch{}o.has(s.value)&&(a.selected=!0),e.appendChild(a)});return}if(e instanceof HTMLDataListElement){e.innerHTML="",n.forEach(i=>{let o=document.createElement("option");o.value=i.value,o.label=i.label,o.disabled=i.disabled===!0,e.appendChild(o)});return}e.textContent=n.map(i=>i.label).join(", ")}function Xy(t,e){return t.current==null?e:new Set(Bd(t.current))}function Ws(t,e){t.setAttribute("data-state",e)}var Mr=class{constructor(e){this.resolvers=new Map;this.rootCleanups=new Map;this.renderers=new Map;this.customResolvers=[];this.sessionCacheAdapter=new Ks;this.dispatchEvent=(e,n,r)=>{var i;n==="loading"?Ws(e,"loading"):n==="success"?Ws(e,"ready"):n==="error"&&Ws(e,"error");try{let o=new CustomEvent(`formgen:relationship:${n}`,{bubbles:!0,detail:r});e.dispatchEvent(o)}catch(o){(i=this.config.logger)!=null&&i.warn&&this.config.logger.warn("Failed to dispatch resolver event",o)}};var n;this.config=e?hd(e):md(),this.cacheConfig=(n=this.config.cache)!=null?n:{},this.cacheAdapter=this.resolveCacheAdapter(this.cacheConfig),this.registerRenderer(jd,Qy)}resolveCacheAdapter(e){if(e.strategy!=="none")return e.strategy==="custom"?e.adapter:new qs}getConfig(){return this.config}register(e,n){if(e instanceof HTMLElement)return this.attachField(e,n);this.registerResolver(e,n)}attachField(e,{field:n,endpoint:r}){let i=new Sr({element:e,field:n,endpoint:r,config:this.config,cacheAdapter:this.cacheAdapter,sessionCacheAdapter:this.sessionCacheAdapter,cacheConfig:this.cacheConfig,dispatchEvent:this.dispatchEvent,renderers:this.renderers,defaultRenderer:jd,customResolvers:this.customResolvers});return this.resolvers.set(e,i),i}get(e){return this.resolvers.get(e)}unregister(e){let n=this.resolvers.get(e);n==null||n.destroy(),this.resolvers.delete(e)}registerRootCleanup(e,n){let r=this.rootCleanups.get(e);r||(r=new Set,this.rootCleanups.set(e,r)),r.add(n)}destroy(e){var n,r;for(let[i,o]of this.resolvers)e&&i!==e&&!e.contains(i)||(o.destroy(),this.resolvers.delete(i));for(let[i,o]of this.rootCleanups)if(!(e&&i!==e&&!e.contains(i))){this.rootCleanups.delete(i);for(let s of o)try{s()}catch(a){(r=(n=this.config.logger)==null?void 0:n.warn)==null||r.call(n,"Failed to clean up formgen runtime root",a)}}}async resolve(e){let n=this.resolvers.get(e);n&&await n.resolve()}async refresh(e){let n=this.resolvers.get(e);n&&await n.refresh()}async validate(e){let n=this.resolvers.get(e);if(n)return n.validate()}async create(e,n){let r=this.resolvers.get(e);if(r)return r.create(n)}registerRenderer(e,n){this.renderers.set(e,n)}registerResolver(e,n){this.customResolvers.push({...n,name:e})}};function $n(t){let e={};t.endpointUrl&&(e.url=t.endpointUrl),t.endpointMethod&&(e.method=t.endpointMethod.toUpperCase()),t.endpointLabelField&&(e.labelField=t.endpointLabelField),t.endpointLabelTemplate&&(e.labelTemplate=t.endpointLabelTemplate),t.endpointValueField&&(e.valueField=t.endpointValueField),t.endpointResultsPath&&(e.resultsPath=t.endpointResultsPath),t.endpointMode&&(e.mode=t.endpointMode),t.endpointSearchParam&&(e.searchParam=t.endpointSearchParam),t.endpointHydrateParam&&(e.hydrateParam=t.endpointHydrateParam),t.endpointSubmitAs&&(e.submitAs=t.endpointSubmitAs);let n=Hi(t,"endpointParams");n&&(e.params=n);let r=Hi(t,"endpointDynamicParams");r&&(e.dynamicParams=r);let i=Hi(t,"endpointMapping");i&&(i.value||i.label)&&(e.mapping=i);let o=Hi(t,"endpointAuth");return o&&(o.source||o.header||o.strategy)&&(e.auth=o),e}function Pt(t,e){var i,o;let n={name:(o=(i=t.getAttribute("name"))!=null?i:t.getAttribute("id"))!=null?o:void 0};e.relationshipType&&(n.relationship=e.relationshipType),e.relationshipCardinality&&(n.cardinality=e.relationshipCardinality),e.endpointSubmitAs==="json"?n.submitAs="json":e.endpointSubmitAs&&(n.submitAs="default"),e.endpointCacheKey&&(n.cacheKey=e.endpointCacheKey),e.endpointRenderer&&(n.renderer=e.endpointRenderer),e.endpointRefresh&&(n.refreshMode=e.endpointRefresh==="manual"?"manual":"auto"),e.endpointRefreshOn&&(n.refreshOn=e.endpointRefreshOn.split(",").map(s=>s.trim()).filter(Boolean)),e.endpointMode==="search"&&(n.mode="search"),e.endpointAllowCreate==="true"&&(n.allowCreate=!0),e.endpointCreateAction==="true"&&(n.createAction=!0),e.endpointCreateActionLabel&&(n.createActionLabel=e.endpointCreateActionLabel),e.endpointCreateActionId&&(n.createActionId=e.endpointCreateActionId),(e.endpointCreateActionSelect==="append"||e.endpointCreateActionSelect==="replace")&&(n.createActionSelect=e.endpointCreateActionSelect),e.endpointEditAction==="true"&&(n.editAction=!0),e.endpointEditActionLabel&&(n.editActionLabel=e.endpointEditActionLabel),e.endpointEditActionId&&(n.editActionId=e.endpointEditActionId),e.endpointRefreshIntervalMs&&(n.refreshIntervalMs=Fi(e.endpointRefreshIntervalMs)),e.endpointCacheTtl&&(n.cacheTtlMs=Fi(e.endpointCacheTtl)),(e.endpointCacheStrategy==="memory"||e.endpointCacheStrategy==="session"||e.endpointCacheStrategy==="none")&&(n.cacheStrategy=e.endpointCacheStrategy),e.endpointThrottle&&(n.throttleMs=Fi(e.endpointThrottle)),e.endpointDebounce&&(n.debounceMs=Fi(e.endpointDebounce)),e.endpointSearchParam&&(n.searchParam=e.endpointSearchParam),e.relationshipCurrent&&(n.current=Zy(e.relationshipCurrent)),e.icon&&(n.icon=e.icon),e.iconSource&&(n.iconSource=e.iconSource),e.iconRaw&&(n.iconRaw=e.iconRaw),n.required=t.hasAttribute("required")||e.validationRequired==="true";let r=e.validationLabel||e.endpointFieldLabel||t.getAttribute("aria-label")||t.getAttribute("placeholder")||t.getAttribute("name")||t.id||void 0;if(r&&(n.label=r),e.validationRules)try{let s=JSON.parse(e.validationRules);Array.isArray(s)&&(n.validations=s.filter(nb))}catch{}return n.refreshMode||(n.refreshMode="auto"),n.mode||(n.mode="default"),n}function Zy(t){let e=t.trim();if(!e)return null;try{let n=JSON.parse(e);return Array.isArray(n)?n.map(Wd).filter(r=>r!=null):n==null?null:Wd(n)}catch{return e.includes(",")?e.split(",").map(r=>r.trim()).filter(Boolean):e}}function Wd(t){if(t==null)return null;if(typeof t=="object"){let e=t,n=eb(e);if(n)return n;let r=Js(e,["value","id","slug"]);return r!=null?String(r):null}return String(t)}function eb(t){let e=Js(t,["value","id","slug"]);if(e==null)return null;let n=Js(t,["label","name","title"]);if(n==null)return null;let r=String(e),i=String(n);return!r||!i?null:{value:r,label:i}}function Js(t,e){for(let n of e){let r=t[n];if(r!=null&&r!=="")return r}}function Hi(t,e){let n={},r=e.toLowerCase();return Object.entries(t).forEach(([i,o])=>{if(!o)return;if(i===e||i.toLowerCase()===r){n[""]=o;return}if(!i.startsWith(e))return;let s=i.slice(e.length);if(!s)return;let a=tb(s);a&&(n[a]=o)}),Object.keys(n).length>0?n:void 0}function tb(t){let e=t.trim();if(!e)return"";let n=e.split(""),r=[];return n.forEach((i,o)=>{/[A-Z]/.test(i)?(o!==0&&r.push("-"),r.push(i.toLowerCase())):r.push(i)}),r.join("").replace(/^-+/,"")}function nb(t){if(!t||typeof t!="object")return!1;let e=t;return typeof e.kind=="string"&&e.kind.length>0}function Fi(t){if(!t)return;let e=Number(t);return Number.isFinite(e)?e:void 0}function Bi(t){let e=t.cancel;typeof e=="function"&&e()}function qd(t,e){if(!e||e<=0){let l=(...c)=>t(...c);return l.cancel=()=>Bi(t),l}let n=0,r,i,o=(...l)=>{n=Date.now(),t(...l)},a=(...l)=>{let c=Date.now(),d=e-(c-n);if(d<=0){r!==void 0&&(clearTimeout(r),r=void 0),o(...l);return}i=l,r===void 0&&(r=setTimeout(()=>{r=void 0,i&&(o(...i),i=void 0)},d))};return a.cancel=()=>{r!==void 0&&(clearTimeout(r),r=void 0),i=void 0,Bi(t)},a}function Kd(t,e){if(!e||e<=0){let o=(...s)=>t(...s);return o.cancel=()=>Bi(t),o}let n,i=(...o)=>{n!==void 0&&clearTimeout(n),n=setTimeout(()=>{n=void 0,t(...o)},e)};return i.cancel=()=>{n!==void 0&&(clearTimeout(n),n=void 0),Bi(t)},i}function Un(t){let{select:e,options:n,placeholder:r}=t,i=new Map(Array.from(e.options).filter(a=>a.value!=="").map(a=>[a.value,a.textContent||a.value])),o=new Set(Array.from(e.options).filter(a=>a.selected&&a.value!=="").map(a=>a.value));if(e.innerHTML="",r){let a=document.createElement("option");a.value="",a.textContent=r,e.appendChild(a)}let s=new Map(n.map(a=>[a.value,a]));for(let a of o)if(!s.has(a)){let l=i.get(a)||a;s.set(a,{value:a,label:l})}for(let a of s.values()){let l=document.createElement("option");l.value=a.value,l.textContent=a.label,l.selected=o.has(a.value),e.appendChild(l)}return we(e)}function Vi(t){var n,r;let e=Array.from(t.options).find(i=>i.value==="");return e&&e.textContent?e.textContent:t.getAttribute("placeholder")?(n=t.getAttribute("placeholder"))!=null?n:"":t.getAttribute("aria-label")?(r=t.getAttribute("aria-label"))!=null?r:"":"Select an option"}function zi(t,e){var i,o,s,a;if(e)return e;let n=t.getAttribute("data-endpoint-search-placeholder");if(n)return n;let r=(a=(s=(o=(i=t.getAttribute("data-endpoint-field-label"))!=null?i:t.getAttribute("aria-label"))!=null?o:t.getAttribute("placeholder"))!=null?s:t.getAttribute("name"))!=null?a:t.id;return r?`Search ${r}`.trim():"Search options"}function we(t){return new Set(Array.from(t.options).filter(e=>e.selected&&e.value!=="").map(e=>e.value))}function wr(t,e,n){let r=document.createDocumentFragment(),i=t.toLowerCase(),o=e.toLowerCase();if(!o)return r.append(document.createTextNode(t)),r;let s=0,a=i.indexOf(o),l=o.length;for(;a!==-1;){a>s&&r.append(document.createTextNode(t.slice(s,a)));let c=t.slice(a,a+l),d=document.createElement("mark");d.className=n,d.textContent=c,r.append(d),s=a+l,a=i.indexOf(o,s)}return s<t.length&&r.append(document.createTextNode(t.slice(s))),r}var Ys=[],Gs=null;function $i(t,e,n){Ys.push({renderer:t,stores:e,onDestroy:n}),rb()}function Jd(t){for(let e of Ys)Gd(t,e)}function rb(){Gs||typeof window=="undefined"||typeof document=="undefined"||(Gs=new MutationObserver(t=>{for(let e of t)e.removedNodes.forEach(n=>ib(n))}),Gs.observe(document.documentElement,{childList:!0,subtree:!0}))}function ib(t){let e=typeof globalThis!="undefined"?globalThis.HTMLElement:void 0;if(!(typeof e!="function"||!(t instanceof e)))for(let n of Ys)Gd(t,n)}function Gd(t,e){let{renderer:n,stores:r,onDestroy:i}=e,o=ob(t,n);for(let s of o){let a=r.get(s);a&&(i(s,a),r.delete(s))}}function ob(t,e){let n=typeof globalThis!="undefined"?globalThis.HTMLSelectElement:void 0,r=new Set;return typeof n=="function"&&t instanceof n&&t.dataset.endpointRenderer===e&&r.add(t),t.querySelectorAll(`select[data-endpoint-renderer='${e}']`).forEach(i=>r.add(i)),Array.from(r)}var sb=new Set(["select","refetch","broadcast"]);function Ar(t){let e=t.dataset.endpointAfterCreate;if(e==null||e.trim()==="")return new Set(["select"]);let n=new Set;return e.split(",").map(r=>r.trim().toLowerCase()).forEach(r=>{sb.has(r)&&n.add(r)}),n}async function Ui(t,e,n,r=Ar(t)){let i=[];if(r.has("refetch")&&i.push(n.refresh(t)),r.has("broadcast"))for(let o of ab(t))lb(o,e),i.push(n.refresh(o));i.length>0&&await Promise.allSettled(i)}function ab(t){var r;let e=Yd(t);if(!e)return[];let n=(r=t.ownerDocument)!=null?r:document;return Array.from(n.querySelectorAll("select[data-endpoint-url]")).filter(i=>i!==t&&Yd(i)===e)}function Yd(t){var r,i;let e=(r=t.getAttribute("data-relationship-target"))==null?void 0:r.trim();if(e)return`target:${e}`;let n=(i=t.getAttribute("data-endpoint-url"))==null?void 0:i.trim();return n?`url:${n}`:""}function lb(t,e){var n;for(let r of e){if(Array.from(t.options).some(o=>o.value===r.value))continue;let i=t.ownerDocument.createElement("option");i.value=r.value,i.textContent=(n=r.label)!=null?n:r.value,t.appendChild(i)}}var cb={chips:{container:["relative","w-full","text-sm"],containerReady:["flex"],containerOpen:[],inner:["flex","w-full","items-stretch","gap-3","rounded-lg","border","border-gray-200","bg-white","py-3","ps-4","pe-9","transition","focus-within:border-blue-500","focus-within:ring-blue-500"],chips:["flex","flex-wrap","gap-2","grow","items-center"],chipsContent:["flex","flex-wrap","items-center","gap-2"],icon:["inline-flex","items-center","justify-center","text-slate-400","dark:text-slate-500"],placeholder:["text-slate-500"],search:["flex","items-center","gap-2","grow"],searchInput:["w-full","min-w-[6rem]","border-none","bg-transparent","px-0","py-0","text-sm","placeholder:text-slate-400","focus:outline-none","focus:ring-0"],searchHighlight:["bg-amber-100","font-semibold"],chip:["inline-flex","items-center","gap-2","max-w-full","rounded-md","border","border-slate-200","bg-slate-100","px-3","py-1.5","text-sm","font-medium","text-slate-700","shadow-sm"],chipLabel:["truncate"],chipRemove:["flex","h-7","w-7","items-center","justify-center","rounded-full","text-lg","text-slate-400","transition","hover:text-slate-600","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2"],chipAvatar:["size-5","rounded-full","object-cover","mr-1.5"],actions:["flex","items-center","gap-1","ml-auto"],action:["inline-flex","h-9","w-9","items-center","justify-center","rounded-md","border","border-slate-200","bg-white","text-slate-500","shadow-sm","transition-colors","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2"],actionClear:["hover:text-rose-500"],actionToggle:["text-base","text-slate-500"],menu:["absolute","top-full","left-0","right-0","z-20","mt-1","flex","flex-col","rounded-lg","border","border-gray-200","bg-white","shadow-xl","overflow-hidden"],menuSearch:["p-2","border-b","border-gray-200","dark:border-gray-700"],menuSearchInput:["w-full","px-3","py-2","text-sm","border","border-gray-300","rounded-md","focus:outline-none","focus:ring-2","focus:ring-blue-500","dark:bg-slate-800","dark:border-gray-600"],menuDivider:["border-t","border-gray-200","dark:border-gray-700","my-1"],menuList:["max-h-72","overflow-y-auto","space-y-0.5","p-1"],menuFooter:["border-t","border-gray-200","dark:border-gray-700","p-2"],menuFooterAction:["flex","items-center","gap-2","w-full","px-3","py-2","text-sm","text-blue-600","rounded-md","hover:bg-blue-50","focus:outline-none","focus:bg-blue-50"],menuFooterActionFocused:["bg-blue-50","ring-2","ring-blue-500","ring-inset"],menuItem:["flex","justify-between","items-center","gap-2","rounded-lg","px-4","py-2","w-full","text-left","text-sm","text-gray-800","cursor-pointer","transition","hover:bg-gray-100","focus:outline-none","focus:bg-gray-100"],menuItemHighlighted:["bg-blue-50","dark:bg-slate-700"],menuItemIcon:["shrink-0","size-8","rounded-full","overflow-hidden","mr-3","flex","items-center","justify-center"],menuItemAvatar:["w-full","h-full","object-cover"],menuItemText:["flex","flex-col","flex-1","min-w-0"],menuItemTitle:["font-medium","text-gray-900","dark:text-white","truncate"],menuItemDescription:["text-sm","text-gray-500","dark:text-gray-400","truncate"],menuEmpty:["px-3","py-2","text-sm","text-slate-500"],menuLoading:["flex","items-center","justify-center","gap-2","px-3","py-4","text-sm","text-slate-500"],menuLoadingSpinner:["size-5","animate-spin","text-blue-500"],nativeSelect:["hidden"]},typeahead:{container:["relative","w-full","text-sm"],containerReady:["block"],containerOpen:[],control:["flex","items-center","gap-x-2","relative","rounded-lg","border","border-gray-200","bg-white","py-3","ps-4","pe-9","transition","focus-within:border-blue-500","focus-within:ring-blue-500"],input:["w-full","min-w-0","border-none","bg-transparent","px-0","py-0","text-sm","text-slate-900","placeholder:text-slate-400","focus:outline-none","focus:ring-0"],inputWithIcon:["ps-10"],clear:["inline-flex","h-8","w-8","items-center","justify-center","rounded-md","text-slate-400","transition","hover:bg-slate-100","hover:text-slate-600","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2","disabled:cursor-default","disabled:opacity-40"],actions:["flex","items-center","gap-1"],action:["inline-flex","h-9","w-9","items-center","justify-center","rounded-md","border","border-slate-200","bg-white","text-slate-500","shadow-sm","transition-colors","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2"],actionClear:["hover:text-rose-500"],actionToggle:["text-base","text-slate-500"],dropdown:["absolute","left-0","right-0","z-20","mt-2","rounded-lg","border","border-gray-200","bg-white","p-1","shadow-xl","overflow-hidden","flex","flex-col"],dropdownList:["flex","flex-col","space-y-0.5","min-h-0","max-h-72","overflow-y-auto"],option:["flex","justify-between","items-center","gap-2","rounded-lg","px-4","py-2","w-full","text-left","text-sm","text-gray-800","cursor-pointer","transition","hover:bg-gray-100","focus:outline-none","focus:bg-gray-100"],optionActive:["bg-slate-100"],highlight:["bg-amber-100","font-semibold"],empty:["px-3","py-2","text-sm","text-slate-500"],loading:["flex","items-center","justify-center","gap-2","px-3","py-4","text-sm","text-slate-500"],loadingSpinner:["size-5","animate-spin","text-blue-500"],icon:["absolute","top-1/2","start-3","-translate-y-1/2","pointer-events-none","text-slate-400","dark:text-slate-500","flex","items-center","justify-center"],iconSvg:["size-5","text-current"],nativeSelect:["hidden"],createAction:["flex","items-center","gap-2","w-full","px-4","py-2","text-sm","text-blue-600","font-medium","rounded-lg","cursor-pointer","transition","border-t","border-gray-100","mt-1","hover:bg-blue-50","focus:outline-none","focus:bg-blue-50"],createActionFocused:["bg-blue-50","ring-2","ring-blue-500","ring-inset"]},switch:{container:["relative","inline-block","w-11","h-6","cursor-pointer"],input:["peer","sr-only"],track:["absolute","inset-0","bg-gray-200","rounded-full","transition-colors","duration-200","ease-in-out","peer-checked:bg-blue-600","dark:bg-neutral-700","dark:peer-checked:bg-blue-500","peer-disabled:opacity-50","peer-disabled:pointer-events-none"],toggle:["absolute","top-1/2","start-0.5","-translate-y-1/2","size-5","bg-white","rounded-full","shadow-xs","transition-transform","duration-200","ease-in-out","peer-checked:translate-x-full","dark:bg-neutral-400","dark:peer-checked:bg-white"]},wysiwyg:{wrapper:["wysiwyg-wrapper"],editor:["wysiwyg-editor","border","border-gray-200","rounded-lg","overflow-hidden","dark:border-neutral-700","focus-within:border-blue-500","focus-within:ring-1","focus-within:ring-blue-500"],content:["max-w-none","p-4","min-h-[200px]","focus:outline-none","text-sm","leading-relaxed","text-gray-800","dark:text-neutral-200"],toolbar:["wysiwyg-toolbar","flex","gap-1","p-2","border-b","border-gray-200","bg-gray-50","dark:border-neutral-700","dark:bg-neutral-800"]},fileUploader:{wrapper:["space-y-4"],dropzone:["border-2","border-dashed","border-gray-300","rounded-xl","px-6","py-10","text-center","text-sm","text-gray-600","cursor-pointer","hover:border-blue-500","hover:text-blue-600","transition"],button:["inline-flex","items-center","justify-center","gap-2","rounded-lg","border","border-gray-300","px-4","py-2.5","text-sm","font-medium","text-gray-700","hover:bg-gray-50","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","dark:text-gray-200","dark:border-gray-600"],preview:["w-full","h-48","object-cover","rounded-lg","border","border-gray-200","bg-gray-50","dark:border-gray-700"],fileList:["space-y-3"],fileItem:["border","border-gray-200","rounded-lg","p-3","flex","flex-col","gap-2","bg-white","dark:border-gray-700","dark:bg-gray-900"],fileMeta:["flex","items-center","justify-between","text-sm","text-gray-700","dark:text-gray-300"],fileName:["font-medium","truncate"],fileSize:["text-xs","text-gray-500"],fileActions:["flex","items-center","justify-between","text-xs","text-gray-500"],progress:["w-full","h-2","bg-gray-100","rounded-full","overflow-hidden"],status:["text-sm","text-gray-600"],error:["text-sm","text-red-600"]}},db=Qd(cb);function on(){return Qd(db)}function L(t,e){if(!e||e.length===0){t.className="";return}t.className=e.join(" ")}function j(t,e){!e||e.length===0||t.classList.add(...e)}function Ae(t,e){!e||e.length===0||t.classList.remove(...e)}function Dt(t){return!t||t.length===0?"":t.join(" ")}function _n(...t){let e=[],n=new Set;for(let r of t)if(r)for(let i of r)n.has(i)||(n.add(i),e.push(i));return e}function Qd(t){return{chips:Rr(t.chips),typeahead:Rr(t.typeahead),switch:Rr(t.switch),wysiwyg:Rr(t.wysiwyg),fileUploader:Rr(t.fileUploader)}}function Rr(t){let e={};return Object.keys(t).forEach(n=>{e[n]=[...t[n]]}),e}function _i(t){let{icon:e,iconSource:n,iconRaw:r}=t.dataset;if(!e&&!n&&!r)return null;let i={};return e&&(i.name=e),n&&(i.source=n),r&&(i.raw=r),!i.name&&!i.raw?null:i}function Or(t,e={}){var r;if(!t)return null;let n=document.createElement("span");if(n.setAttribute("aria-hidden","true"),L(n,(r=e.wrapperClasses)!=null?r:[]),t.raw){if(n.innerHTML=t.raw,e.svgClasses&&e.svgClasses.length>0){let i=n.querySelector("svg");i&&j(i,e.svgClasses)}return n}if(t.name){let i=document.createElement("span");return i.textContent=t.name.slice(0,2).toUpperCase(),i.className="text-xs font-semibold text-current",n.appendChild(i),n}return null}var to=null,no=null;async function tx(){return to||no||(no=(async()=>{try{let t=await Promise.resolve().then(()=>(Du(),Pu));return to={computePosition:t.computePosition,offset:t.offset,flip:t.flip,shift:t.shift},to}catch{return null}})(),no)}var nx="data-fg-chip-root",rx="data-fg-chip-value",ix="data-fg-chip-menu",ia=new WeakMap,ox=0;function Bu(t){t.registerRenderer("chips",e=>sx(e,t))}function Vu(t,e){if(!t.multiple)return;let n=zu(t);$u(n,e);let r=Un({select:n.select,options:n.options,placeholder:n.placeholder});fe(n.select,{kind:"options",origin:"hydrate",selectedValues:Array.from(r),query:n.searchValue}),ro(n,r),Vt(n,r),io(n,r)}var sx=(t,e)=>{let{element:n,options:r}=t;if(!(n instanceof HTMLSelectElement)||!n.multiple)return;let i=zu(n);$u(i,e,t),i.options=r;let o=Un({select:i.select,options:r,placeholder:i.placeholder});fe(i.select,{kind:"options",origin:"resolver",selectedValues:Array.from(o),query:i.searchValue}),ro(i,o),i.creatingQuery||Vt(i,o),i.isOpen||X(i,!1),io(i,o)};function zu(t){var Y;let e=ia.get(t);if(e)return e;let n=on().chips,r=document.createElement("div");L(r,n.container),r.style.width="100%",r.setAttribute(nx,"true"),r.hidden=!0;let i=document.createElement("div");L(i,n.chips);let o=document.createElement("div");L(o,n.chipsContent),i.appendChild(o);let s=_i(t),a=null;s&&(a=Or(s,{wrapperClasses:n.icon}),a&&i.insertBefore(a,o));let l=document.createElement("div");L(l,n.actions);let c=document.createElement("button");c.type="button",L(c,_n(n.action,n.actionClear)),c.setAttribute("aria-label","Clear selection"),c.innerHTML='<span aria-hidden="true">&times;</span>';let d=document.createElement("button");d.type="button",L(d,_n(n.action,n.actionToggle)),d.setAttribute("aria-haspopup","listbox"),d.setAttribute("aria-expanded","false"),d.innerHTML='<svg class="shrink-0 size-3.5 text-gray-500" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><path d="m7 15 5 5 5-5"/><path d="m7 9 5-5 5 5"/></svg>',l.append(c,d);let u=document.createElement("div");L(u,n.inner),u.append(i,l);let p=document.createElement("div");L(p,n.menu),p.hidden=!0,p.setAttribute(ix,"true");let f=document.createElement("div");L(f,n.menuList),f.setAttribute("role","listbox");let h=document.createElement("div");L(h,n.menuFooter),h.hidden=!0,r.append(u,p),t.insertAdjacentElement("beforebegin",r);let m=((Y=n.nativeSelect)!=null?Y:[]).filter(w=>!t.classList.contains(w)),g=t.hasAttribute("required");j(t,n.nativeSelect),bx(t,r);let y=Vi(t),x=t.dataset.endpointMode==="search",C=null,M;x&&(f.addEventListener("mousedown",w=>w.preventDefault()),C=document.createElement("div"),L(C,n.menuSearch),M=document.createElement("input"),M.type="text",L(M,n.menuSearchInput),M.setAttribute("placeholder",zi(t)),M.setAttribute("autocomplete","off"),M.setAttribute("aria-label","Search options"),C.appendChild(M)),C&&p.appendChild(C),p.appendChild(f),p.appendChild(h);let T=t.dataset.endpointUseFloatingUi==="true",R=t.dataset.endpointDynamicInput==="true",S=t.dataset.endpointFieldLabel||t.getAttribute("aria-label")||t.getAttribute("name")||t.id||void 0,P=t.dataset.endpointCreateActionLabel,D=S?`Create ${S}\u2026`:"Create new\u2026",b={select:t,container:r,inner:u,chips:i,chipsContent:o,menu:p,menuSearch:C,menuList:f,menuFooter:h,toggle:d,clear:c,placeholder:y,allowCreate:t.dataset.endpointAllowCreate==="true",createLabel:w=>`Create "${w}"`,createOption:void 0,options:[],lastRenderedSelectionKey:"",highlightedIndex:-1,creatingQuery:"",documentHandler:()=>{},isOpen:!1,searchMode:x,searchInput:M,searchValue:"",theme:n,nativeSelectClassesAdded:m,requiredAttributeRemoved:g,icon:s,iconElement:a,loading:!1,footerFocused:!1,animationInProcess:!1,useFloatingUI:T,dynamicInputWidth:R,instanceId:ox++,pendingToggle:null,createActionEnabled:t.dataset.endpointCreateAction==="true",createActionLabel:P||D,createActionId:t.dataset.endpointCreateActionId,createActionSelect:t.dataset.endpointCreateActionSelect==="replace"?"replace":"append",createActionElement:null,field:{},endpoint:{},registry:null};if(x&&M){t.setAttribute("data-endpoint-search-value","");let w=()=>{let N=M.value.trim();b.searchValue=N,t.setAttribute("data-endpoint-search-value",N),fe(t,{kind:"search",origin:"ui",query:N});let B=we(t);Vt(b,B);let Q=N.length>0||b.options.length>0;Q&&!b.isOpen?X(b,!0):!Q&&b.isOpen&&X(b,!1),t.dispatchEvent(new Event("input",{bubbles:!0}))};M.addEventListener("input",()=>{w(),b.dynamicInputWidth&&_u(b)}),M.addEventListener("keydown",N=>{let B=b.searchValue.trim();if(N.key==="Tab"){X(b,!1);return}if(N.key==="Escape"){X(b,!1,!0);return}if(N.key==="Home"){N.preventDefault(),le(b,0);return}if(N.key==="End"){N.preventDefault();let V=Le(b);st(b)?Bt(b):V.length>0&&le(b,V.length-1);return}if(N.key==="ArrowDown"||N.key==="ArrowUp"){b.isOpen||X(b,!0),N.preventDefault();let V=Le(b);N.key==="ArrowDown"?b.highlightedIndex===-1?V.length>0?le(b,0):st(b)&&Bt(b):b.highlightedIndex>=V.length-1?st(b)?Bt(b):le(b,0):le(b,b.highlightedIndex+1):b.highlightedIndex===-1?V.length>0&&le(b,V.length-1):b.highlightedIndex===0?qn(b):le(b,b.highlightedIndex-1);return}if(N.key!=="Enter")return;if(b.highlightedIndex>=0){N.preventDefault();let ne=Le(b)[b.highlightedIndex];ne&&ne.click();return}if(!B)return;let Q=we(t),q=ax(t,B);if(N.preventDefault(),q){let V=new Set(Q);V.add(q.value),Kn(b,V),X(b,!1);return}if(Uu(b)){X(b,!0),oa(b,B).catch(()=>{});return}b.createActionEnabled&&!lx(b,B)&&sa(b)})}return i.addEventListener("click",()=>{b.isOpen||X(b,!0)}),u.addEventListener("click",w=>{w.target.closest("button")||b.isOpen||X(b,!0)}),b.documentHandler=w=>{r.contains(w.target)||X(b,!1)},c.addEventListener("click",()=>{Kn(b,new Set)}),d.addEventListener("click",w=>{w.preventDefault(),X(b,p.hidden)}),d.addEventListener("keydown",w=>{if(w.key==="Enter"||w.key===" "){w.preventDefault(),X(b,!b.isOpen);return}if(w.key==="Escape"&&b.isOpen){w.preventDefault(),X(b,!1,!0);return}if(w.key==="ArrowDown"||w.key==="ArrowUp"){if(w.preventDefault(),b.isOpen||X(b,!0),!b.searchMode){let N=Le(b);if(N.length>0){let B=w.key==="ArrowDown"?0:N.length-1;le(b,B)}else st(b)&&w.key==="ArrowDown"&&Bt(b)}return}if(w.key==="Home"&&b.isOpen){w.preventDefault(),le(b,0);return}if(w.key==="End"&&b.isOpen){if(w.preventDefault(),st(b))Bt(b);else{let B=Le(b);B.length>0&&le(b,B.length-1)}return}}),document.addEventListener("click",b.documentHandler),f.addEventListener("keydown",w=>{if(w.key==="Escape"){w.preventDefault(),X(b,!1,!0);return}if(w.key==="Tab"){X(b,!1);return}if(w.key==="Home"){w.preventDefault(),le(b,0);return}if(w.key==="End"){if(w.preventDefault(),st(b))Bt(b);else{let ne=Le(b);ne.length>0&&le(b,ne.length-1)}return}if(w.key!=="ArrowDown"&&w.key!=="ArrowUp")return;w.preventDefault();let N=Le(b);if(N.length===0){w.key==="ArrowDown"&&st(b)&&Bt(b);return}let B=N.findIndex(V=>V===document.activeElement),Q=w.key==="ArrowDown"?1:-1,q;if(B===-1)q=Q>0?0:N.length-1;else{if(q=B+Q,q<0){b.searchMode&&b.searchInput?b.searchInput.focus():b.toggle.focus(),qn(b);return}if(q>=N.length){if(st(b)){Bt(b);return}q=0}}le(b,q)}),h.addEventListener("keydown",w=>{if(w.key==="Escape"){w.preventDefault(),X(b,!1,!0);return}if(w.key==="Tab"){X(b,!1);return}if(w.key==="Home"){w.preventDefault(),le(b,0);return}if(w.key==="ArrowUp"){w.preventDefault();let N=Le(b);N.length>0?le(b,N.length-1):(b.searchMode&&b.searchInput?b.searchInput.focus():b.toggle.focus(),qn(b));return}if(w.key==="ArrowDown"){w.preventDefault(),Le(b).length>0&&le(b,0);return}}),hx(b),gx(b),mx(b),ia.set(t,b),typeof requestAnimationFrame=="function"?requestAnimationFrame(()=>{r.hidden=!1,j(r,n.containerReady)}):(r.hidden=!1,j(r,n.containerReady)),b}function $u(t,e,n){var a,l;let r=(a=n==null?void 0:n.field.allowCreate)!=null?a:t.select.dataset.endpointAllowCreate==="true";t.allowCreate=r,t.createOption=async c=>e.create(t.select,c);let i=It(t.select),o=Pt(t.select,i),s=$n(i);t.registry=e,t.field=(l=n==null?void 0:n.field)!=null?l:o,t.endpoint=s,n&&(n.field.createAction!==void 0&&(t.createActionEnabled=n.field.createAction),n.field.createActionLabel&&(t.createActionLabel=n.field.createActionLabel),n.field.createActionId&&(t.createActionId=n.field.createActionId),n.field.createActionSelect&&(t.createActionSelect=n.field.createActionSelect))}function Uu(t){return t.allowCreate&&typeof t.createOption=="function"}function Le(t){return Array.from(t.menuList.querySelectorAll("[data-fg-chip-option='true']"))}function qn(t){let e=Le(t);for(let r of e)Ae(r,t.theme.menuItemHighlighted);t.highlightedIndex=-1,t.footerFocused=!1,t.searchInput&&t.searchInput.removeAttribute("aria-activedescendant");let n=st(t);n&&Ae(n,t.theme.menuFooterActionFocused)}function st(t){return t.menuFooter.querySelector("button")}function Bt(t){let e=st(t);if(!e)return;let n=Le(t);for(let r of n)Ae(r,t.theme.menuItemHighlighted);t.highlightedIndex=-1,t.searchInput&&t.searchInput.removeAttribute("aria-activedescendant"),j(e,t.theme.menuFooterActionFocused),e.focus(),t.footerFocused=!0}function le(t,e){let n=Le(t);if(n.length===0){t.highlightedIndex=-1;return}t.footerFocused=!1;let r=st(t);r&&Ae(r,t.theme.menuFooterActionFocused);for(let s of n)Ae(s,t.theme.menuItemHighlighted);let i=Math.max(0,Math.min(e,n.length-1));t.highlightedIndex=i;let o=n[i];if(o)if(j(o,t.theme.menuItemHighlighted),o.scrollIntoView({block:"nearest"}),t.searchMode&&t.searchInput){if(!o.id){let s=t.select.id?`${t.select.id}-${t.instanceId}`:`anon-${t.instanceId}`;o.id=`fg-chip-option-${s}-${i}`}t.searchInput.setAttribute("aria-activedescendant",o.id),document.activeElement!==t.searchInput&&t.searchInput.focus()}else o.focus()}function ax(t,e){var i;let n=e.trim();if(!n)return null;let r=n.toLowerCase();for(let o of Array.from(t.options)){if(!o.value)continue;let s=o.value,a=(i=o.textContent)!=null?i:s;if(s.toLowerCase()===r||a.toLowerCase()===r)return{value:s,label:a}}return null}function lx(t,e){let n=e.trim();if(!n)return!1;let r=n.toLowerCase();return t.options.some(i=>{var s;let o=(s=i.label)!=null?s:i.value;return i.value.toLowerCase().includes(r)||o.toLowerCase().includes(r)})}function Hu(t,e,n){let r=e.trim();if(!r||!Uu(t))return!1;let i=r.toLowerCase(),o=new Set([...Array.from(n),...t.options.map(s=>s.value)]);return!(Array.from(o).some(s=>s.toLowerCase()===i)||t.options.some(s=>{var a;return((a=s.label)!=null?a:s.value).toLowerCase()===i}))}async function oa(t,e){var i;let n=t.createOption;if(!n)return;let r=e.trim();if(r&&!t.creatingQuery){t.creatingQuery=r,t.highlightedIndex=-1,Vt(t,we(t.select));try{let o=await n(r);if(!o)return;if(!Array.from(t.select.options).find(c=>c.value===o.value)){let c=document.createElement("option");c.value=o.value,c.textContent=(i=o.label)!=null?i:o.value,t.select.appendChild(c)}t.options.some(c=>c.value===o.value)||(t.options=[...t.options,o]);let a=we(t.select),l=new Set(a);l.add(o.value),Kn(t,l),X(t,!1)}finally{t.creatingQuery=""}}}function ro(t,e){var c;let{chipsContent:n,select:r,placeholder:i,searchInput:o,theme:s}=t,a=cx(e);if(o&&document.activeElement===o&&a===t.lastRenderedSelectionKey)return;t.lastRenderedSelectionKey=a,n.innerHTML="";let l=Array.from(r.options).filter(d=>d.selected&&d.value!=="");if(l.length===0){let d=document.createElement("span");L(d,s.placeholder),d.textContent=i||"Select an option",n.appendChild(d)}else for(let d of l){let u=d.value,p=(c=d.textContent)!=null?c:u,f=document.createElement("span");L(f,s.chip),f.setAttribute(rx,u);let h=t.options.find(y=>y.value===u);if(h!=null&&h.avatar){let y=document.createElement("img");y.src=h.avatar,y.alt="",y.loading="lazy",L(y,s.chipAvatar),f.appendChild(y)}let m=document.createElement("span");L(m,s.chipLabel),m.textContent=p;let g=document.createElement("button");g.type="button",L(g,s.chipRemove),g.setAttribute("aria-label",`Remove ${p}`),g.innerHTML="&times;",g.addEventListener("click",()=>{let y=new Set(e);y.delete(u),Kn(t,y)}),f.append(m,g),n.appendChild(f)}}function cx(t){return Array.from(t).slice().sort((e,n)=>e.localeCompare(n)).join("\0")}function Vt(t,e){var d;let{menuList:n,options:r,searchMode:i,searchValue:o,theme:s}=t;if(qn(t),n.innerHTML="",t.creatingQuery){let u=document.createElement("button");u.type="button",L(u,s.menuItem),u.setAttribute("role","option"),u.setAttribute("data-fg-chip-option","true"),u.setAttribute("data-fg-create-option","true"),u.disabled=!0,u.textContent=`Creating "${t.creatingQuery}"\u2026`,n.appendChild(u);return}if(t.loading&&r.length===0){let u=i?o.trim():"";if(Hu(t,u,e)){let m=document.createElement("button");m.type="button",L(m,s.menuItem),m.setAttribute("role","option"),m.setAttribute("data-fg-chip-option","true"),m.setAttribute("data-fg-create-option","true"),m.textContent=t.createLabel(u),m.addEventListener("click",()=>{oa(t,u).catch(()=>{})}),n.appendChild(m)}let p=document.createElement("div");L(p,s.menuLoading),p.setAttribute("aria-live","polite"),p.setAttribute("role","status");let f=document.createElement("span");L(f,s.menuLoadingSpinner),f.innerHTML='<svg xmlns="http://www.w3.org/2000/svg" fill="none" viewBox="0 0 24 24"><circle class="opacity-25" cx="12" cy="12" r="10" stroke="currentColor" stroke-width="4"></circle><path class="opacity-75" fill="currentColor" d="M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z"></path></svg>',p.appendChild(f);let h=document.createElement("span");h.textContent="Loading\u2026",p.appendChild(h),n.appendChild(p),ra(t);return}let a=i?o.trim():"",l=a.toLowerCase(),c=r.filter(u=>!e.has(u.value)).filter(u=>{var f;return l?((f=u.label)!=null?f:u.value).toLowerCase().includes(l)||u.value.toLowerCase().includes(l):!0});if(Hu(t,a,e)){let u=document.createElement("button");u.type="button",L(u,s.menuItem),u.setAttribute("role","option"),u.setAttribute("data-fg-chip-option","true"),u.setAttribute("data-fg-create-option","true"),u.textContent=t.createLabel(a),u.addEventListener("click",()=>{oa(t,a).catch(()=>{})}),n.appendChild(u)}if(c.length===0){let u=document.createElement("div");L(u,s.menuEmpty),u.textContent=l?"No matches":"No more options",n.appendChild(u),ra(t);return}for(let u of c){let p=document.createElement("button");p.type="button",L(p,s.menuItem),p.setAttribute("role","option"),p.dataset.value=u.value,p.setAttribute("data-fg-chip-option","true"),p.disabled=u.disabled===!0;let f=(d=u.label)!=null?d:u.value;if(u.avatar||u.icon||u.description){if(u.avatar||u.icon){let x=document.createElement("span");if(L(x,s.menuItemIcon),u.avatar){let C=document.createElement("img");C.src=u.avatar,C.alt="",C.loading="lazy",L(C,s.menuItemAvatar),x.appendChild(C)}else if(u.icon){let C=Or({name:u.icon},{wrapperClasses:[],svgClasses:s.menuItemAvatar});if(C)for(;C.firstChild;)x.appendChild(C.firstChild)}p.appendChild(x)}let g=document.createElement("div");L(g,s.menuItemText);let y=document.createElement("span");if(L(y,s.menuItemTitle),y.appendChild(wr(f,l,Dt(s.searchHighlight))),g.appendChild(y),u.description){let x=document.createElement("span");L(x,s.menuItemDescription),x.textContent=u.description,g.appendChild(x)}p.appendChild(g)}else{let g=document.createElement("span");g.appendChild(wr(f,l,Dt(s.searchHighlight))),p.appendChild(g)}if(e.has(u.value)){p.setAttribute("aria-selected","true");let g=document.createElement("span");g.innerHTML='<svg class="shrink-0 size-3.5 text-blue-600" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><polyline points="20 6 9 17 4 12"/></svg>',p.appendChild(g)}p.addEventListener("click",()=>{let g=new Set(e);g.add(u.value),Kn(t,g),X(t,!1)}),n.appendChild(p)}ra(t)}function Kn(t,e){let{select:n}=t;for(let i of Array.from(n.options))i.selected=e.has(i.value);let r=we(n);fe(n,{kind:"selection",origin:"ui",selectedValues:Array.from(r)}),n.dispatchEvent(new Event("change",{bubbles:!0})),ro(t,r),t.searchMode&&(t.searchInput&&(t.searchInput.value=""),t.searchValue="",n.setAttribute("data-endpoint-search-value","")),Vt(t,r),io(t,r),t.searchMode&&n.dispatchEvent(new Event("input",{bubbles:!0}))}function io(t,e){t.clear.disabled=e.size===0}var dx="data-fg-chips-create-action";function ra(t){let{menuFooter:e,theme:n}=t;if(e.innerHTML="",t.createActionElement=null,!t.createActionEnabled){e.hidden=!0;return}e.hidden=!1;let r=document.createElement("button");r.type="button",L(r,n.menuFooterAction),r.setAttribute("role","button"),r.setAttribute(dx,"true"),r.setAttribute("tabindex","-1");let i=document.createElement("span");i.innerHTML='<svg class="shrink-0 size-4" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><line x1="12" y1="5" x2="12" y2="19"/><line x1="5" y1="12" x2="19" y2="12"/></svg>',r.appendChild(i);let o=document.createElement("span");o.textContent=t.createActionLabel,r.appendChild(o),r.addEventListener("click",()=>{sa(t)}),r.addEventListener("keydown",s=>{if(s.key==="Enter"||s.key===" ")s.preventDefault(),sa(t);else if(s.key==="ArrowUp"){s.preventDefault(),t.footerFocused=!1;let a=Le(t);a.length>0?le(t,a.length-1):(t.searchMode&&t.searchInput?t.searchInput.focus():t.toggle.focus(),qn(t))}else s.key==="ArrowDown"?(s.preventDefault(),Le(t).length>0&&(t.footerFocused=!1,le(t,0))):s.key==="Escape"&&(s.preventDefault(),X(t,!1,!0))}),e.appendChild(r),t.createActionElement=r}async function sa(t){var i;let e=t.registry.getConfig(),n=t.searchValue.trim(),r={query:n,actionId:t.createActionId,mode:"chips",selectBehavior:t.createActionSelect};if(X(t,!1),t.searchMode&&t.searchInput&&(t.searchInput.value="",t.searchValue="",t.select.setAttribute("data-endpoint-search-value","")),e.onCreateAction)try{let o={element:t.select,field:t.field,endpoint:t.endpoint,request:{url:(i=t.endpoint.url)!=null?i:"",init:{}},fromCache:!1,config:e},s=await e.onCreateAction(o,r);if(s){let a=Array.isArray(s)?s:[s];a.length>0&&ux(t,a,t.createActionSelect)}}catch{}else Er(t.select,{element:t.select,field:t.field,endpoint:t.endpoint,query:n,actionId:t.createActionId,mode:"chips",selectBehavior:t.createActionSelect})}function ux(t,e,n){var o;let r=Ar(t.select);for(let s of e){if(!Array.from(t.select.options).find(l=>l.value===s.value)){let l=document.createElement("option");l.value=s.value,l.textContent=(o=s.label)!=null?o:s.value,t.select.appendChild(l)}t.options.some(l=>l.value===s.value)||(t.options=[...t.options,s])}let i;if(n==="replace")i=new Set(e.map(s=>s.value));else{i=we(t.select);for(let s of e)i.add(s.value)}r.has("select")&&Kn(t,i),Ui(t.select,e,t.registry,r)}async function px(t){if(!t.useFloatingUI)return;let e=await tx();if(!e)return;let{computePosition:n,offset:r,flip:i,shift:o}=e;try{let{x:s,y:a}=await n(t.inner,t.menu,{placement:"bottom-start",middleware:[r(4),i(),o({padding:8})]});Object.assign(t.menu.style,{position:"absolute",left:`${s}px`,top:`${a}px`})}catch{}}function _u(t){var s;if(!t.dynamicInputWidth||!t.searchInput)return;t.widthMeasurer||(t.widthMeasurer=document.createElement("span"),t.widthMeasurer.style.cssText=`
+      position: absolute;
+      visibility: hidden;
+      white-space: pre;
+      font: inherit;
+      pointer-events: none;
+    `,(s=t.searchInput.parentElement)==null||s.appendChild(t.widthMeasurer));let e=t.searchInput.value||t.searchInput.placeholder||"";t.widthMeasurer.textContent=e;let n=t.widthMeasurer.offsetWidth,o=Math.max(50,n+16);t.searchInput.style.width=`${o}px`}function X(t,e,n=!1){if(t.animationInProcess){if(t.isOpen===e)return;t.pendingToggle={open:e,restoreFocus:n};return}if(t.isOpen===e)return;t.animationInProcess=!0,t.menu.hidden=!e,t.toggle.setAttribute("aria-expanded",e?"true":"false"),e?(j(t.container,t.theme.containerOpen),t.useFloatingUI&&px(t).catch(()=>{}),t.searchMode&&t.searchInput&&(t.searchInput.focus(),t.dynamicInputWidth&&_u(t))):(Ae(t.container,t.theme.containerOpen),qn(t),n&&t.toggle.focus()),t.isOpen=e;let r=()=>{if(t.animationInProcess=!1,t.pendingToggle&&t.pendingToggle.open!==t.isOpen){let o=t.pendingToggle;t.pendingToggle=null,X(t,o.open,o.restoreFocus);return}t.pendingToggle=null},i=fx(t.menu);i>0&&typeof setTimeout=="function"?setTimeout(r,i):typeof queueMicrotask=="function"?queueMicrotask(r):Promise.resolve().then(r)}function fx(t){var s,a,l,c;if(typeof window=="undefined"||typeof window.getComputedStyle!="function")return 0;let e=window.getComputedStyle(t),n=e.transitionDuration.split(","),r=e.transitionDelay.split(","),i=Math.max(n.length,r.length),o=0;for(let d=0;d<i;d+=1){let u=Fu((a=(s=n[d])!=null?s:n[0])!=null?a:"0s"),p=Fu((c=(l=r[d])!=null?l:r[0])!=null?c:"0s"),f=u+p;f>o&&(o=f)}return o}function Fu(t){let e=t.trim();if(!e)return 0;let n=parseFloat(e);return Number.isNaN(n)?0:e.endsWith("ms")?n:n*1e3}function hx(t){let e=()=>{t.select.getAttribute("data-validation-state")==="invalid"?(t.container.setAttribute("data-validation-state","invalid"),t.container.setAttribute("aria-invalid","true")):(t.container.removeAttribute("data-validation-state"),t.container.removeAttribute("aria-invalid"))};t.validationHandler=()=>e(),t.select.addEventListener("formgen:relationship:validation",t.validationHandler),typeof MutationObserver!="undefined"&&(t.validationObserver=new MutationObserver(()=>e()),t.validationObserver.observe(t.select,{attributes:!0,attributeFilter:["data-validation-state"]})),e()}function mx(t){let e=n=>{let r=n.detail;if(r.kind!=="selection"||r.origin==="ui")return;let i=we(t.select);ro(t,i),Vt(t,i),io(t,i)};t.updateHandler=e,t.select.addEventListener(nt,e)}function gx(t){let e=()=>{t.loading=!0;let r=we(t.select);Vt(t,r)},n=()=>{let r=t.searchInput&&document.activeElement===t.searchInput;t.loading=!1;let i=we(t.select);Vt(t,i),r&&t.searchInput&&t.searchInput.focus()};t.loadingHandler=e,t.successHandler=n,t.select.addEventListener("formgen:relationship:loading",e),t.select.addEventListener("formgen:relationship:success",n),t.select.addEventListener("formgen:relationship:error",n)}function yx(t){var e;document.removeEventListener("click",t.documentHandler),t.validationHandler&&t.select.removeEventListener("formgen:relationship:validation",t.validationHandler),(e=t.validationObserver)==null||e.disconnect(),t.updateHandler&&t.select.removeEventListener(nt,t.updateHandler),t.loadingHandler&&t.select.removeEventListener("formgen:relationship:loading",t.loadingHandler),t.successHandler&&(t.select.removeEventListener("formgen:relationship:success",t.successHandler),t.select.removeEventListener("formgen:relationship:error",t.successHandler)),t.container.remove(),Ae(t.select,t.nativeSelectClassesAdded),xx(t.select,t.requiredAttributeRemoved)}function bx(t,e){t.hasAttribute("required")&&(t.dataset.validationRequiredNative="true",t.removeAttribute("required"),e.setAttribute("aria-required","true"))}function xx(t,e){e&&(t.setAttribute("required",""),delete t.dataset.validationRequiredNative)}$i("chips",ia,(t,e)=>{yx(e)});var Cx="data-fg-typeahead-root",ca="data-fg-typeahead-option",da=new WeakMap;function Ku(t){t.registerRenderer("typeahead",e=>Ex(e,t))}function Ju(t,e){if(t.multiple)return;let n=Gu(t);Yu(n,e);let r=Un({select:n.select,options:n.options,placeholder:n.placeholder});fe(n.select,{kind:"options",origin:"hydrate",selectedValues:Array.from(r),query:n.searchQuery}),ua(n,r),$t(n)}var Ex=(t,e)=>{let{element:n,options:r}=t;if(!(n instanceof HTMLSelectElement)||n.multiple)return;let i=Gu(n);Yu(i,e,t),i.options=r;let o=Un({select:i.select,options:r,placeholder:i.placeholder});fe(i.select,{kind:"options",origin:"resolver",selectedValues:Array.from(o),query:i.searchQuery}),ua(i,o),$t(i)};function Gu(t){var P;let e=da.get(t);if(e)return e;let n=on().typeahead,r=document.createElement("div");L(r,n.container),r.setAttribute(Cx,"true"),r.hidden=!0;let i=document.createElement("div");L(i,n.control),i.setAttribute("role","combobox"),i.setAttribute("aria-haspopup","listbox"),i.setAttribute("aria-expanded","false");let o=document.createElement("input");o.type="text",L(o,n.input),o.autocomplete="off",o.setAttribute("aria-autocomplete","list");let s=document.createElement("div");L(s,n.actions);let a=document.createElement("button");a.type="button",L(a,_n(n.action,n.actionClear)),a.setAttribute("aria-label","Clear selection"),a.innerHTML='<span aria-hidden="true">&times;</span>',a.disabled=!0;let l=document.createElement("button");l.type="button",L(l,_n(n.action,n.actionToggle)),l.setAttribute("aria-haspopup","listbox"),l.setAttribute("aria-expanded","false"),l.innerHTML='<svg class="shrink-0 size-3.5 text-gray-500" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><path d="m7 15 5 5 5-5"/><path d="m7 9 5-5 5 5"/></svg>',s.append(a,l),i.append(o,s);let c=document.createElement("div");L(c,n.dropdown),c.hidden=!0;let d=t.id?`${t.id}__typeahead`:`fg-typeahead-${Math.random().toString(36).slice(2)}`,u=document.createElement("div");L(u,n.dropdownList),u.setAttribute("role","listbox"),u.id=d,i.setAttribute("aria-controls",d),o.setAttribute("aria-controls",d),c.appendChild(u),r.append(i,c),t.insertAdjacentElement("beforebegin",r);let p=((P=n.nativeSelect)!=null?P:[]).filter(D=>!t.classList.contains(D)),f=t.hasAttribute("required");j(t,n.nativeSelect),Hx(t,i,o);let h=t.dataset.endpointPlaceholder||Vi(t),m=zi(t,t.dataset.endpointSearchPlaceholder),g=t.dataset.endpointFieldLabel||t.getAttribute("aria-label")||t.getAttribute("name")||t.id||void 0;o.placeholder=h,o.setAttribute("aria-label",g!=null?g:"Related record");let y=_i(t),x=t.dataset.endpointCreateActionLabel,C=g?`Create ${g}\u2026`:"Create new\u2026",M=t.dataset.endpointEditActionLabel,T=g?`Edit ${g}`:"Edit selected",R={select:t,container:r,control:i,input:o,actions:s,clear:a,toggle:l,dropdown:c,dropdownList:u,options:[],filtered:[],placeholder:h,searchPlaceholder:m,allowCreate:t.dataset.endpointAllowCreate==="true",createLabel:D=>`Create "${D}"`,createOption:void 0,label:g,highlightedIndex:-1,isOpen:!1,searchMode:t.dataset.endpointMode==="search",searchQuery:"",documentHandler:()=>{},theme:n,nativeSelectClassesAdded:p,requiredAttributeRemoved:f,icon:y,iconElement:null,loading:!1,createActionEnabled:t.dataset.endpointCreateAction==="true",createActionLabel:x||C,createActionId:t.dataset.endpointCreateActionId,createActionSelect:t.dataset.endpointCreateActionSelect==="append"?"append":"replace",createActionFocused:!1,createActionElement:null,editActionEnabled:t.dataset.endpointEditAction==="true",editActionLabel:M||T,editActionId:t.dataset.endpointEditActionId,editActionFocused:!1,editActionElement:null,field:{},endpoint:{},registry:null};o.placeholder=R.searchMode?R.searchPlaceholder:R.placeholder;let S=Or(y,{wrapperClasses:n.icon,svgClasses:n.iconSvg});return S&&(i.insertBefore(S,o),R.iconElement=S,j(o,n.inputWithIcon)),vx(R),Nx(R),Px(R),Ix(R),da.set(t,R),cn(R),typeof requestAnimationFrame=="function"?requestAnimationFrame(()=>{r.hidden=!1,j(r,n.containerReady)}):(r.hidden=!1,j(r,n.containerReady)),R}function vx(t){let{input:e,clear:n,toggle:r,dropdown:i}=t;e.addEventListener("focus",()=>{zt(t),e.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder}),e.addEventListener("click",()=>{zt(t)}),e.addEventListener("input",()=>Tx(t)),e.addEventListener("keydown",o=>Sx(t,o)),n.addEventListener("click",()=>{kx(t)}),r.addEventListener("click",o=>{o.preventDefault(),o.stopPropagation(),t.isOpen?(at(t),He(t)):(zt(t),e.focus())}),r.addEventListener("keydown",o=>{if(o.key==="Enter"||o.key===" "){o.preventDefault(),t.isOpen?(at(t),He(t)):(zt(t),e.focus());return}if(o.key==="Escape"&&t.isOpen){o.preventDefault(),at(t),He(t);return}if(o.key==="ArrowDown"||o.key==="ArrowUp"){o.preventDefault(),t.isOpen||zt(t),e.focus();return}}),i.addEventListener("mousedown",o=>{o.preventDefault()}),t.documentHandler=o=>{t.container.contains(o.target)||(at(t),He(t))},document.addEventListener("click",t.documentHandler)}function ua(t,e){var s,a;let{select:n,input:r}=t;if(document.activeElement===r&&t.searchQuery)return;let i=(s=Array.from(e)[0])!=null?s:"";t.highlightedIndex=-1;let o=i?Array.from(n.options).find(l=>l.value===i):void 0;r.value=(a=o==null?void 0:o.textContent)!=null?a:"",oo(t,i,r.value),i||He(t),cn(t)}function Tx(t){let{input:e,select:n}=t,r=e.value.trim();t.highlightedIndex=-1,t.createActionFocused=!1,t.editActionFocused=!1,t.searchQuery=r,n.setAttribute("data-endpoint-search-value",r),fe(n,{kind:"search",origin:"ui",query:r}),$t(t),zt(t),t.searchMode&&n.dispatchEvent(new Event("input",{bubbles:!0})),cn(t)}function Sx(t,e){if(!new Set(["ArrowDown","ArrowUp","Enter","Escape","Tab"]).has(e.key))return;let{filtered:r}=t;if(e.key==="Escape"){at(t),He(t);return}if(e.key==="Tab"){if(t.isOpen&&t.highlightedIndex>=0){let i=r[t.highlightedIndex];i&&(e.preventDefault(),Jn(t,i))}at(t),He(t);return}if(e.preventDefault(),e.key==="Enter"){if(t.highlightedIndex>=0){let s=r[t.highlightedIndex];s&&Jn(t,s);return}if(r.length===1){Jn(t,r[0]);return}let i=t.searchQuery.trim(),o=t.createOption;t.searchMode&&t.allowCreate&&o&&Qu(t,i)&&Xu(t,i).catch(()=>{});return}if(e.key==="ArrowDown"){t.isOpen||zt(t),ju(t,1);return}if(e.key==="ArrowUp"){t.isOpen||zt(t),ju(t,-1);return}}function Yu(t,e,n){var a,l;let r=(a=n==null?void 0:n.field.allowCreate)!=null?a:t.select.dataset.endpointAllowCreate==="true";t.allowCreate=r,t.createOption=async c=>e.create(t.select,c);let i=It(t.select),o=Pt(t.select,i),s=$n(i);t.registry=e,t.field=(l=n==null?void 0:n.field)!=null?l:o,t.endpoint=s,n&&(n.field.createAction!==void 0&&(t.createActionEnabled=n.field.createAction),n.field.createActionLabel&&(t.createActionLabel=n.field.createActionLabel),n.field.createActionId&&(t.createActionId=n.field.createActionId),n.field.createActionSelect&&(t.createActionSelect=n.field.createActionSelect),n.field.editAction!==void 0&&(t.editActionEnabled=n.field.editAction),n.field.editActionLabel&&(t.editActionLabel=n.field.editActionLabel),n.field.editActionId&&(t.editActionId=n.field.editActionId))}function Qu(t,e){let n=e.trim();if(!n||!t.allowCreate||!t.createOption)return!1;let r=n.toLowerCase();return!(t.options.some(i=>i.value.toLowerCase()===r)||t.options.some(i=>{var o;return((o=i.label)!=null?o:i.value).toLowerCase()===r}))}async function Xu(t,e){var l;let n=t.createOption;if(!n)return;let r=e.trim();if(!r)return;let o=t.dropdown.querySelector("[data-fg-create-option='true']");o&&(o.disabled=!0,o.textContent="Creating\u2026");let s=await n(r);if(!s)return;if(!Array.from(t.select.options).find(c=>c.value===s.value)){let c=document.createElement("option");c.value=s.value,c.textContent=(l=s.label)!=null?l:s.value,t.select.appendChild(c)}t.options.some(c=>c.value===s.value)||(t.options=[...t.options,s]),Jn(t,s)}function ju(t,e){let{filtered:n,createActionEnabled:r,createActionElement:i,editActionElement:o}=t,s=r&&i,a=!!o;if(t.createActionFocused){e<0&&(t.createActionFocused=!1,a?(t.editActionFocused=!0,o==null||o.focus()):(n.length>0&&(t.highlightedIndex=n.length-1),t.input.focus()),Ne(t));return}if(t.editActionFocused){e<0?(t.editActionFocused=!1,n.length>0&&(t.highlightedIndex=n.length-1),t.input.focus(),Ne(t)):s&&(t.editActionFocused=!1,t.createActionFocused=!0,i.focus(),Ne(t));return}if(n.length===0){a&&e>0?(t.highlightedIndex=-1,t.editActionFocused=!0,o==null||o.focus(),Ne(t)):s&&e>0&&(t.highlightedIndex=-1,t.createActionFocused=!0,i.focus(),Ne(t));return}let l;if(t.highlightedIndex===-1?l=e>0?0:n.length-1:l=t.highlightedIndex+e,l>=n.length&&a&&e>0){t.highlightedIndex=-1,t.editActionFocused=!0,o==null||o.focus(),Ne(t);return}if(l>=n.length&&s&&e>0){t.highlightedIndex=-1,t.createActionFocused=!0,i.focus(),Ne(t);return}l<0?l=n.length-1:l>=n.length&&(l=0),t.highlightedIndex=l,t.createActionFocused=!1,t.editActionFocused=!1,Ne(t)}function Jn(t,e){var i;if(e.disabled)return;let{select:n,input:r}=t;for(let o of Array.from(n.options))o.selected=o.value===e.value;r.value=(i=e.label)!=null?i:e.value,oo(t,e.value,r.value),He(t),at(t),t.highlightedIndex=-1,t.searchQuery="",n.setAttribute("data-endpoint-search-value",""),fe(n,{kind:"selection",origin:"ui",selectedValues:Array.from(we(n))}),n.dispatchEvent(new Event("change",{bubbles:!0})),cn(t)}function kx(t){let{select:e,input:n}=t;for(let r of Array.from(e.options))r.selected=!1;n.value="",oo(t,"",""),He(t),t.highlightedIndex=-1,t.searchQuery="",e.setAttribute("data-endpoint-search-value",""),fe(e,{kind:"selection",origin:"ui",selectedValues:[]}),e.dispatchEvent(new Event("change",{bubbles:!0})),$t(t),cn(t),t.searchMode&&e.dispatchEvent(new Event("input",{bubbles:!0}))}function oo(t,e,n){let r=e.trim(),i=n.trim();for(let o of[t.container,t.input])r?(o.dataset.selectedValue=r,o.dataset.selectedId=r,o.dataset.relationshipValue=r):(delete o.dataset.selectedValue,delete o.dataset.selectedId,delete o.dataset.relationshipValue),i?o.dataset.selectedLabel=i:delete o.dataset.selectedLabel}function zt(t){if(!t.isOpen){if(t.input.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder,$t(t),t.searchMode&&t.filtered.length===0&&!t.searchQuery){t.dropdown.hidden=!0,t.control.setAttribute("aria-expanded","false"),t.toggle.setAttribute("aria-expanded","false");return}t.dropdown.hidden=!1,t.control.setAttribute("aria-expanded","true"),t.toggle.setAttribute("aria-expanded","true"),j(t.container,t.theme.containerOpen),t.isOpen=!0,t.highlightedIndex===-1&&t.filtered.length>0&&(t.highlightedIndex=0),Ne(t)}}function at(t){t.isOpen&&(t.dropdown.hidden=!0,t.control.setAttribute("aria-expanded","false"),t.toggle.setAttribute("aria-expanded","false"),Ae(t.container,t.theme.containerOpen),t.isOpen=!1,t.highlightedIndex=-1,t.createActionFocused=!1,t.editActionFocused=!1,Ne(t))}function $t(t){var a;let{dropdownList:e,select:n,theme:r}=t;e.innerHTML="";let i=t.searchQuery.trim().toLowerCase(),o=t.options;if(i&&(o=o.filter(l=>{var d;return((d=l.label)!=null?d:l.value).toLowerCase().includes(i)||l.value.toLowerCase().includes(i)})),t.filtered=o,o.length===0){if(t.loading){let d=document.createElement("div");L(d,r.loading),d.setAttribute("aria-live","polite"),d.setAttribute("role","status");let u=document.createElement("span");L(u,r.loadingSpinner),u.innerHTML='<svg xmlns="http://www.w3.org/2000/svg" fill="none" viewBox="0 0 24 24"><circle class="opacity-25" cx="12" cy="12" r="10" stroke="currentColor" stroke-width="4"></circle><path class="opacity-75" fill="currentColor" d="M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z"></path></svg>',d.appendChild(u);let p=document.createElement("span");p.textContent="Loading\u2026",d.appendChild(p),e.appendChild(d),aa(t),la(t);return}let l=t.searchQuery.trim();if(t.searchMode&&Qu(t,l)){let d=document.createElement("button");d.type="button",L(d,r.option),d.setAttribute("role","option"),d.setAttribute(ca,"true"),d.setAttribute("data-fg-create-option","true"),d.textContent=t.createLabel(l),d.addEventListener("click",()=>{Xu(t,l).catch(()=>{})}),e.appendChild(d)}let c=document.createElement("div");L(c,r.empty),c.textContent=i?"No matches":"No options",e.appendChild(c),aa(t),la(t);return}let s=(a=Array.from(n.options).find(l=>l.selected))==null?void 0:a.value;o.forEach((l,c)=>{var p;let d=document.createElement("button");d.type="button",L(d,r.option),d.setAttribute("role","option"),d.dataset.value=l.value,d.setAttribute(ca,"true"),d.disabled=l.disabled===!0,d.dataset.selected=l.value===s?"true":"false";let u=document.createElement("span");if(u.appendChild(wr((p=l.label)!=null?p:l.value,i,Dt(r.highlight))),d.appendChild(u),l.value===s){d.setAttribute("aria-selected","true");let f=document.createElement("span");f.innerHTML='<svg class="shrink-0 size-3.5 text-blue-600" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><polyline points="20 6 9 17 4 12"/></svg>',d.appendChild(f)}d.addEventListener("click",()=>Jn(t,l)),e.appendChild(d),t.highlightedIndex===-1&&l.value===s&&(t.highlightedIndex=c)}),aa(t),la(t),Ne(t)}function Ne(t){let{dropdownList:e,highlightedIndex:n,theme:r}=t;Array.from(e.querySelectorAll(`[${ca}]`)).forEach((o,s)=>{let a=s===n;a?j(o,r.optionActive):Ae(o,r.optionActive),o.setAttribute("aria-selected",a||o.dataset.selected==="true"?"true":"false"),a&&typeof o.scrollIntoView=="function"&&o.scrollIntoView({block:"nearest"})}),Ax(t),Rx(t)}var Mx="data-fg-typeahead-edit-action",wx="data-fg-typeahead-create-action";function aa(t){if(t.editActionElement&&(t.editActionElement.remove(),t.editActionElement=null),!t.editActionEnabled)return;let e=pa(t);if(!e){t.editActionFocused=!1;return}let{dropdown:n,theme:r}=t,i=document.createElement("button");i.type="button",L(i,r.createAction),i.setAttribute("role","button"),i.setAttribute(Mx,"true"),i.setAttribute("tabindex","-1"),i.dataset.value=e.value;let o=document.createElement("span");o.innerHTML='<svg class="shrink-0 size-4" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><path d="M12 20h9"/><path d="M16.5 3.5a2.12 2.12 0 0 1 3 3L7 19l-4 1 1-4Z"/></svg>',i.appendChild(o);let s=document.createElement("span");s.textContent=t.editActionLabel,i.appendChild(s),i.addEventListener("click",()=>{qu(t)}),i.addEventListener("keydown",a=>{a.key==="Enter"||a.key===" "?(a.preventDefault(),qu(t)):a.key==="ArrowUp"?(a.preventDefault(),t.editActionFocused=!1,t.filtered.length>0&&(t.highlightedIndex=t.filtered.length-1),t.input.focus(),Ne(t)):a.key==="ArrowDown"?(a.preventDefault(),t.createActionElement&&(t.editActionFocused=!1,t.createActionFocused=!0,t.createActionElement.focus(),Ne(t))):a.key==="Escape"&&(a.preventDefault(),at(t),He(t))}),n.appendChild(i),t.editActionElement=i}function la(t){if(t.createActionElement&&(t.createActionElement.remove(),t.createActionElement=null),!t.createActionEnabled)return;let{dropdown:e,theme:n}=t,r=document.createElement("button");r.type="button",L(r,n.createAction),r.setAttribute("role","button"),r.setAttribute(wx,"true"),r.setAttribute("tabindex","-1");let i=document.createElement("span");i.innerHTML='<svg class="shrink-0 size-4" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><line x1="12" y1="5" x2="12" y2="19"/><line x1="5" y1="12" x2="19" y2="12"/></svg>',r.appendChild(i);let o=document.createElement("span");o.textContent=t.createActionLabel,r.appendChild(o),r.addEventListener("click",()=>{Wu(t)}),r.addEventListener("keydown",s=>{s.key==="Enter"||s.key===" "?(s.preventDefault(),Wu(t)):s.key==="ArrowUp"?(s.preventDefault(),t.createActionFocused=!1,t.filtered.length>0&&(t.highlightedIndex=t.filtered.length-1),t.input.focus(),Ne(t)):s.key==="Escape"&&(s.preventDefault(),at(t),He(t))}),e.appendChild(r),t.createActionElement=r}function Ax(t){let{editActionElement:e,editActionFocused:n,theme:r}=t;e&&(n?(j(e,r.createActionFocused),e.setAttribute("aria-current","true")):(Ae(e,r.createActionFocused),e.removeAttribute("aria-current")))}function Rx(t){let{createActionElement:e,createActionFocused:n,theme:r}=t;e&&(n?(j(e,r.createActionFocused),e.setAttribute("aria-current","true")):(Ae(e,r.createActionFocused),e.removeAttribute("aria-current")))}async function Wu(t){var i;let e=t.registry.getConfig(),n=t.searchQuery.trim(),r={query:n,actionId:t.createActionId,mode:"typeahead",selectBehavior:t.createActionSelect};if(at(t),He(t),e.onCreateAction)try{let o={element:t.select,field:t.field,endpoint:t.endpoint,request:{url:(i=t.endpoint.url)!=null?i:"",init:{}},fromCache:!1,config:e},s=await e.onCreateAction(o,r);if(s){let a=Array.isArray(s)?s[0]:s;a&&Lx(t,a)}}catch{}else Er(t.select,{element:t.select,field:t.field,endpoint:t.endpoint,query:n,actionId:t.createActionId,mode:"typeahead",selectBehavior:t.createActionSelect})}async function qu(t){var i;let e=pa(t);if(!e)return;let n=t.registry.getConfig(),r={selectedValue:e.value,selectedLabel:e.label,actionId:t.editActionId,mode:"typeahead"};if(at(t),He(t),n.onEditAction)try{let o={element:t.select,field:t.field,endpoint:t.endpoint,request:{url:(i=t.endpoint.url)!=null?i:"",init:{}},fromCache:!1,config:n},s=await n.onEditAction(o,r);s&&Ox(t,s)}catch{}else Vs(t.select,{element:t.select,field:t.field,endpoint:t.endpoint,selectedValue:e.value,selectedLabel:e.label,actionId:t.editActionId,mode:"typeahead"})}function pa(t){var i;let e=Array.from(t.select.options).find(o=>o.selected&&o.value!=="");if(!e)return null;let n=e.value,r=t.options.find(o=>o.value===n);return{...r!=null?r:{},value:n,label:((i=e.textContent)==null?void 0:i.trim())||(r==null?void 0:r.label)||t.input.value||n}}function Ox(t,e){let n=pa(t);if(!n)return;let r=e.value||n.value,i=e.label||r,o=Array.from(t.select.options).find(a=>a.value===r);if(o)o.textContent=i,o.selected=!0;else{let a=document.createElement("option");a.value=r,a.textContent=i,a.selected=!0,t.select.appendChild(a)}for(let a of Array.from(t.select.options))a.value!==r&&(a.selected=!1);let s=t.options.findIndex(a=>a.value===r);s>=0?t.options=t.options.map((a,l)=>l===s?{...a,...e,value:r,label:i}:a):t.options=[...t.options,{...e,value:r,label:i}],t.input.value=i,oo(t,r,i),t.highlightedIndex=-1,t.searchQuery="",t.select.setAttribute("data-endpoint-search-value",""),fe(t.select,{kind:"selection",origin:"ui",selectedValues:[r]}),t.select.dispatchEvent(new Event("change",{bubbles:!0})),$t(t),cn(t)}function Lx(t,e){var i;let n=Ar(t.select);if(!Array.from(t.select.options).find(o=>o.value===e.value)){let o=document.createElement("option");o.value=e.value,o.textContent=(i=e.label)!=null?i:e.value,t.select.appendChild(o)}t.options.some(o=>o.value===e.value)||(t.options=[...t.options,e]),n.has("select")&&Jn(t,e),Ui(t.select,[e],t.registry,n)}function cn(t){let{input:e,select:n,clear:r}=t,i=e.value.trim()!=="",o=Array.from(n.options).some(s=>s.selected&&s.value!=="");r.disabled=!(i||o)}function He(t){t.input.value||(t.input.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder)}function Nx(t){let e=()=>{t.select.getAttribute("data-validation-state")==="invalid"?(t.container.setAttribute("data-validation-state","invalid"),t.control.setAttribute("aria-invalid","true"),t.input.setAttribute("aria-invalid","true")):(t.container.removeAttribute("data-validation-state"),t.control.removeAttribute("aria-invalid"),t.input.removeAttribute("aria-invalid"))};t.validationHandler=()=>e(),t.select.addEventListener("formgen:relationship:validation",t.validationHandler),typeof MutationObserver!="undefined"&&(t.validationObserver=new MutationObserver(()=>e()),t.validationObserver.observe(t.select,{attributes:!0,attributeFilter:["data-validation-state"]})),e()}function Ix(t){let e=n=>{let r=n.detail;if(r.kind!=="selection"||r.origin==="ui")return;let i=we(t.select);ua(t,i),cn(t)};t.updateHandler=e,t.select.addEventListener(nt,e)}function Px(t){let e=()=>{t.loading=!0,$t(t)},n=()=>{let r=t.createActionFocused,i=document.activeElement===t.input;t.loading=!1,$t(t),r&&t.createActionElement?t.createActionElement.focus():i&&t.input.focus()};t.loadingHandler=e,t.successHandler=n,t.select.addEventListener("formgen:relationship:loading",e),t.select.addEventListener("formgen:relationship:success",n),t.select.addEventListener("formgen:relationship:error",n)}function Dx(t){var e;document.removeEventListener("click",t.documentHandler),t.validationHandler&&t.select.removeEventListener("formgen:relationship:validation",t.validationHandler),(e=t.validationObserver)==null||e.disconnect(),t.updateHandler&&t.select.removeEventListener(nt,t.updateHandler),t.loadingHandler&&t.select.removeEventListener("formgen:relationship:loading",t.loadingHandler),t.successHandler&&(t.select.removeEventListener("formgen:relationship:success",t.successHandler),t.select.removeEventListener("formgen:relationship:error",t.successHandler)),t.container.remove(),Ae(t.select,t.nativeSelectClassesAdded),Fx(t.select,t.requiredAttributeRemoved)}function Hx(t,e,n){t.hasAttribute("required")&&(t.dataset.validationRequiredNative="true",t.removeAttribute("required"),e.setAttribute("aria-required","true"),n.setAttribute("aria-required","true"))}function Fx(t,e){e&&(t.setAttribute("required",""),delete t.dataset.validationRequiredNative)}$i("typeahead",da,(t,e)=>{Dx(e)});var tp="POST",Bx=25*1024*1024,np=({element:t,config:e})=>{let n=t.querySelector("input, textarea, select");if(!n){console.warn("formgen:file-uploader \u2013 unable to find input element");return}let r=new fa(t,n,Wx(e));return()=>r.destroy()},fa=class{constructor(e,n,r){this.entries=[];this.destroying=!1;this.submitting=!1;this.triggerFileDialog=()=>{this.fileInput.click()};this.handleFileInputChange=()=>{this.fileInput.files&&(this.handleSelectedFiles(this.fileInput.files),this.fileInput.value="")};this.handleFormReset=()=>{this.clearEntries(),this.serializeFiles(),this.refreshUI(),this.statusMessage.textContent="",Me(this.element)};this.handleFormSubmit=async e=>{var r;if(this.config.autoUpload||this.submitting)return;let n=this.entries.filter(i=>i.status!=="uploaded");if(n.length!==0){e.preventDefault(),this.submitting=!0;try{for(let i of n)if(await this.uploadEntry(i),i.status!=="uploaded")throw new Error("Unable to upload file.");this.serializeFiles(),(r=this.form)==null||r.submit()}catch(i){this.showError(i instanceof Error?i.message:"Upload failed")}finally{this.submitting=!1}}};var s,a,l;this.element=e,this.input=n,this.config=r,this.theme=on().fileUploader,this.form=n.form,this.originalName=n.name;let i=this.collectHydratedUrls();this.input.type="hidden",this.input.value="",this.hiddenContainer=document.createElement("div"),this.hiddenContainer.dataset.fgUploaderHidden="true",this.hiddenContainer.style.display="none",this.input.after(this.hiddenContainer),this.widget=document.createElement("div"),j(this.widget,this.theme.wrapper),this.fileInput=document.createElement("input"),this.fileInput.type="file",this.fileInput.multiple=this.config.multiple,this.config.allowedTypes.length>0&&(this.fileInput.accept=this.config.allowedTypes.join(",")),this.fileInput.className="sr-only",this.widget.appendChild(this.fileInput);let o=this.createControl();this.widget.appendChild(o),this.previewImage=this.config.variant==="image"?this.createPreview():void 0,this.previewImage&&this.widget.appendChild(this.previewImage),this.fileList=document.createElement("div"),j(this.fileList,this.theme.fileList),this.widget.appendChild(this.fileList),this.statusMessage=document.createElement("p"),j(this.statusMessage,(s=this.theme.status)!=null?s:[]),this.statusMessage.setAttribute("aria-live","polite"),this.widget.appendChild(this.statusMessage),e.appendChild(this.widget),this.fileInput.addEventListener("change",this.handleFileInputChange),(a=this.form)==null||a.addEventListener("reset",this.handleFormReset),this.config.autoUpload||(l=this.form)==null||l.addEventListener("submit",this.handleFormSubmit),i.length>0&&this.hydrateUrls(i),this.config.uploadEndpoint||this.showError("Upload endpoint is not configured.")}destroy(){var e,n;this.destroying=!0,this.fileInput.removeEventListener("change",this.handleFileInputChange),(e=this.form)==null||e.removeEventListener("reset",this.handleFormReset),(n=this.form)==null||n.removeEventListener("submit",this.handleFormSubmit),this.clearEntries()}createControl(){if(this.config.variant==="dropzone"){let n=document.createElement("div");j(n,this.theme.dropzone),n.textContent="Drag & drop files or click to browse",n.role="button",n.tabIndex=0,n.addEventListener("click",this.triggerFileDialog),n.addEventListener("keydown",i=>{(i.key==="Enter"||i.key===" ")&&(i.preventDefault(),this.triggerFileDialog())});let r=i=>{i.preventDefault(),i.stopPropagation()};return n.addEventListener("dragover",r),n.addEventListener("dragenter",r),n.addEventListener("drop",i=>{var o;r(i),(o=i.dataTransfer)!=null&&o.files&&this.handleSelectedFiles(i.dataTransfer.files)}),this.dropzone=n,n}let e=document.createElement("button");return e.type="button",j(e,this.theme.button),e.textContent=this.config.variant==="image"?"Choose image":"Choose files",e.addEventListener("click",this.triggerFileDialog),e}createPreview(){let e=document.createElement("img");return j(e,this.theme.preview),e.alt="Selected image preview",e.hidden=!0,e}handleSelectedFiles(e){let n=Array.from(e);if(n.length){this.config.multiple||this.clearEntries();for(let r of n){let i=this.validateFile(r);if(i){this.showError(i);continue}this.addEntry(r)}}}validateFile(e){return e.size>this.config.maxSize?`File "${e.name}" exceeds maximum size of ${ep(this.config.maxSize)}.`:this.config.allowedTypes.length>0&&!this.config.allowedTypes.some(r=>Ux(e,r))?`File "${e.name}" is not an allowed type.`:null}addEntry(e){let n={id:`${Date.now()}-${Math.random().toString(36).slice(2)}`,file:e,status:this.config.autoUpload?"uploading":"pending",progress:0,previewUrl:this.config.preview&&e.type.startsWith("image/")?URL.createObjectURL(e):void 0};this.entries.push(n),this.refreshUI(),this.config.autoUpload&&this.uploadEntry(n)}async uploadEntry(e){e.status="uploading",e.progress=0,this.refreshUI();try{let n=await this.sendUpload(e.file);e.uploaded=n,e.status="uploaded",e.progress=100,e.error=void 0,this.serializeFiles(),Me(this.element)}catch(n){e.status="error",e.error=n instanceof Error?n.message:"Upload failed",e.progress=0,this.showError(e.error)}finally{this.refreshUI()}}async sendUpload(e){var s,a,l,c,d,u;if(!this.config.uploadEndpoint)throw new Error("Upload endpoint is not configured.");let n=Vx(this.config.headers,this.element),r=new FormData;r.append("file",e,e.name);let i=await fetch(this.config.uploadEndpoint,{method:(s=this.config.uploadMethod)!=null?s:tp,headers:n,body:r});if(!i.ok)throw new Error(`Upload failed (${i.status})`);let o=await i.json();if(!o||typeof o.url!="string")throw new Error("Upload response missing url.");return{id:typeof o.id=="string"?o.id:void 0,name:(a=o.name)!=null?a:o.url,originalName:(l=o.originalName)!=null?l:e.name,size:(c=o.size)!=null?c:e.size,contentType:(u=(d=typeof o.contentType=="string"?o.contentType:void 0)!=null?d:typeof o.mime_type=="string"?o.mime_type:void 0)!=null?u:e.type,url:o.url,thumbnail:o.thumbnail,type:typeof o.type=="string"?o.type:void 0,status:typeof o.status=="string"?o.status:void 0,workflowStatus:typeof o.workflow_status=="string"?o.workflow_status:void 0,workflowError:typeof o.workflow_error=="string"?o.workflow_error:void 0,metadata:o.metadata&&typeof o.metadata=="object"?o.metadata:void 0,createdAt:typeof o.created_at=="string"?o.created_at:void 0}}refreshUI(){this.renderFileList(),this.updatePreview()}renderFileList(){var e,n,r,i;this.fileList.innerHTML="";for(let o of this.entries){let s=document.createElement("div");j(s,this.theme.fileItem);let a=document.createElement("div");j(a,(e=this.theme.fileMeta)!=null?e:[]),a.innerHTML=`<span class="${Dt((n=this.theme.fileName)!=null?n:[])}">${o.file.name}</span>
+        <span class="${Dt((r=this.theme.fileSize)!=null?r:[])}">${ep(o.file.size)}</span>`,s.appendChild(a);let l=document.createElement("div");j(l,(i=this.theme.fileActions)!=null?i:[]);let c=document.createElement("span");c.textContent=qx(o),l.appendChild(c);let d=document.createElement("button");d.type="button",d.textContent="Remove",d.className="text-xs text-red-500 hover:text-red-600",d.addEventListener("click",()=>this.removeEntry(o.id)),l.appendChild(d),s.appendChild(l);let u=document.createElement("div");j(u,this.theme.progress);let p=document.createElement("div");if(p.className="bg-blue-500 h-full transition-all duration-200",p.style.width=`${o.progress}%`,u.appendChild(p),s.appendChild(u),o.error){let f=document.createElement("p");j(f,this.theme.error),f.textContent=o.error,s.appendChild(f)}this.fileList.appendChild(s)}}removeEntry(e){let n=this.entries.findIndex(i=>i.id===e);if(n===-1)return;let[r]=this.entries.splice(n,1);r.previewUrl&&URL.revokeObjectURL(r.previewUrl),this.serializeFiles(),this.refreshUI()}updatePreview(){var r,i,o,s,a,l;if(!this.previewImage)return;let e=(r=this.entries.find(c=>{var d;return((d=c.uploaded)==null?void 0:d.thumbnail)||c.previewUrl}))!=null?r:this.entries[0];if(!e){this.previewImage.hidden=!0,this.previewImage.src="";return}let n=(l=(a=(s=(i=e.uploaded)==null?void 0:i.thumbnail)!=null?s:(o=e.uploaded)==null?void 0:o.url)!=null?a:e.previewUrl)!=null?l:"";if(!n){this.previewImage.hidden=!0;return}this.previewImage.hidden=!1,this.previewImage.src=n}clearEntries(){for(let e of this.entries)e.previewUrl&&URL.revokeObjectURL(e.previewUrl);this.entries=[],this.hiddenContainer.innerHTML="",this.input.value="",this.input.name=this.originalName}collectHydratedUrls(){var a;let e=l=>l.trim();if(!this.config.multiple){let l=e((a=this.input.value)!=null?a:"");return l?[l]:[]}let r=`${this.originalName.endsWith("[]")?this.originalName.slice(0,-2):this.originalName}[]`,i=[`input[name="${Zu(this.originalName)}"]`,`input[name="${Zu(r)}"]`].join(", "),o=Array.from(this.element.querySelectorAll(i)),s=o.map(l=>{var c;return e((c=l.value)!=null?c:"")}).filter(Boolean);for(let l of o)l!==this.input&&l.remove();return s}hydrateUrls(e){for(let n of e)this.entries.push(_x(n));this.serializeFiles(),this.refreshUI()}serializeFiles(){let e=this.entries.filter(r=>r.uploaded).map(r=>r.uploaded);if(typeof this.config.serialize=="function"){try{this.config.serialize({files:e,input:this.input,fieldName:this.input.name,form:this.form})}catch(r){console.warn("formgen:file-uploader \u2013 serialize hook failed",r)}return}if(this.hiddenContainer.innerHTML="",!e.length){this.input.name=this.originalName,this.input.value="";return}if(!this.config.multiple){this.input.name=this.originalName,this.input.value=e[0].url;return}let n=this.originalName.endsWith("[]")?this.originalName:`${this.originalName}[]`;this.input.name=n,this.input.value=e[0].url;for(let r=1;r<e.length;r+=1){let i=document.createElement("input");i.type="hidden",i.name=n,i.value=e[r].url,this.hiddenContainer.appendChild(i)}}showError(e){this.statusMessage.textContent=e,ze(this.element,e)}};function Vx(t,e){let n={};for(let[r,i]of Object.entries(t!=null?t:{})){let o=zx(i,e);o&&(n[r]=o)}return n}function zx(t,e){var n,r;if(t){if(t.startsWith("meta:")){let i=document.querySelector(`meta[name="${t.slice(5)}"]`);return(n=i==null?void 0:i.getAttribute("content"))!=null?n:void 0}if(t.startsWith("data:")){let i=t.slice(5);if(e.hasAttribute(i))return(r=e.getAttribute(i))!=null?r:void 0;let o=$x(i);return e.dataset[o]}return t}}function $x(t){return t.replace(/^data-/,"").split(/[-_:]/).filter(Boolean).map((e,n)=>n===0?e.toLowerCase():e.charAt(0).toUpperCase()+e.slice(1).toLowerCase()).join("")}function Ux(t,e){if(!e)return!0;if(e.startsWith("."))return t.name.toLowerCase().endsWith(e.toLowerCase());if(e.endsWith("/*")){let n=e.split("/")[0];return t.type.startsWith(`${n}/`)}return t.type===e}function Zu(t){var e;return typeof globalThis!="undefined"&&typeof((e=globalThis.CSS)==null?void 0:e.escape)=="function"?globalThis.CSS.escape(t):t.replace(/["\\]/g,"\\$1")}function _x(t){let e=jx(t),n=new File([],e);return{id:`${Date.now()}-${Math.random().toString(36).slice(2)}`,file:n,status:"uploaded",progress:100,uploaded:{url:t,name:e,originalName:e,size:0,contentType:""}}}function jx(t){var r;let n=t.split("#")[0].split("?")[0].split("/").filter(Boolean);return(r=n[n.length-1])!=null?r:t}function Wx(t){var o,s,a;let e=t!=null?t:{},n=(l,c=!1)=>typeof l=="boolean"?l:typeof l=="string"?l.toLowerCase()==="true":c,r=(l,c)=>{if(typeof l=="number")return l;if(typeof l=="string"&&l.trim()!==""){let d=Number(l);return Number.isFinite(d)?d:c}return c},i={};return e.headers&&typeof e.headers=="object"&&Object.entries(e.headers).forEach(([l,c])=>{c!=null&&(i[l]=String(c))}),{variant:(o=e.variant)!=null?o:"input",maxSize:r(e.maxSize,Bx),allowedTypes:Array.isArray(e.allowedTypes)?e.allowedTypes.filter(l=>typeof l=="string"):typeof e.allowedTypes=="string"?e.allowedTypes.split(",").map(l=>l.trim()).filter(Boolean):[],multiple:n(e.multiple,!1),uploadEndpoint:(s=e.uploadEndpoint)!=null?s:"",uploadMethod:(a=e.uploadMethod)!=null?a:tp,autoUpload:e.autoUpload!==void 0?n(e.autoUpload,!0):!0,preview:e.preview!==void 0?n(e.preview,e.variant==="image"):e.variant==="image",headers:i,serialize:typeof e.serialize=="function"?e.serialize:void 0}}function ep(t){return Number.isFinite(t)?t<1024?`${t} B`:t<1024*1024?`${(t/1024).toFixed(1)} KB`:`${(t/(1024*1024)).toFixed(1)} MB`:"0 B"}function qx(t){switch(t.status){case"pending":return"Pending upload";case"uploading":return`Uploading\u2026 ${t.progress}%`;case"uploaded":return"Uploaded";case"error":return"Error";default:return"Unknown"}}var Kx=25*1024*1024,Ca=({element:t,config:e})=>{let n=t.querySelector("input, textarea, select");if(!n){console.warn("formgen:media-picker - unable to find input element");return}let r=new ya(t,n,Gx(e));return()=>r.destroy()},ya=class{constructor(e,n,r){this.selections=[];this.candidateSelections=[];this.focusedItem=null;this.capabilities={};this.uploadMode="none";this.libraryItems=[];this.searchToken=0;this.destroyed=!1;this.openModal=async()=>{var e;this.candidateSelections=this.selections.slice(),this.focusedItem=(e=this.candidateSelections[0])!=null?e:null,this.modalError.textContent="",this.modalError.hidden=!0,this.modalSearch.value="",this.overlay.hidden=!1,this.overlay.classList.add("flex"),await this.loadLibrary(""),this.renderModal()};this.closeModal=()=>{this.overlay.hidden=!0,this.overlay.classList.remove("flex"),this.modalUploadInput.value=""};this.handleBrowse=()=>{this.openModal()};this.handleUploadTrigger=()=>{this.uploadMode!=="none"&&this.uploadInput.click()};this.handleInlineUpload=async()=>{let e=this.uploadInput.files;if(this.uploadInput.value="",!(!e||e.length===0))try{await this.uploadFiles(e)}catch(n){this.showError(n instanceof Error?n.message:"Upload failed.")}};this.handleClear=()=>{this.selections=[],this.serializeSelections(),this.renderSelections(),this.setStatus(""),Me(this.element)};this.handleOverlayClick=e=>{e.target===this.overlay&&this.closeModal()};this.handleApplySelection=()=>{this.selections=ha(this.candidateSelections,this.effectiveValueMode),!this.config.multiple&&this.selections.length>1&&(this.selections=this.selections.slice(0,1)),this.serializeSelections(),this.renderSelections(),this.setStatus(""),Me(this.element),this.closeModal()};this.handleModalClear=()=>{this.candidateSelections=[],this.focusedItem=null,this.renderModal()};this.handleModalUploadTrigger=()=>{this.uploadMode!=="none"&&this.modalUploadInput.click()};this.handleModalUpload=async()=>{var n;let e=this.modalUploadInput.files;if(this.modalUploadInput.value="",!(!e||e.length===0))try{let r=await this.uploadFiles(e);!this.config.multiple&&r.length>0&&(this.focusedItem=(n=r[0])!=null?n:null),await this.loadLibrary(this.modalSearch.value),this.renderModal()}catch(r){this.modalError.textContent=r instanceof Error?r.message:"Upload failed.",this.modalError.hidden=!1}};this.handleModalSearch=()=>{this.loadLibrary(this.modalSearch.value).then(()=>this.renderModal())};this.element=e,this.input=n,this.config=r,this.form=n.form,this.originalName=n.name,this.effectiveValueMode=r.valueMode;let i=this.collectHydratedValues();this.input.type="hidden",this.hiddenContainer=document.createElement("div"),this.hiddenContainer.dataset.mediaPickerHidden="true",this.hiddenContainer.style.display="none",this.input.after(this.hiddenContainer),this.shell=this.resolveShell(),this.selectionRoot=document.createElement("div"),this.selectionRoot.dataset.mediaPickerSelections="true",this.selectionRoot.className="space-y-3",this.emptyState=document.createElement("p"),this.emptyState.dataset.mediaPickerEmpty="true",this.emptyState.className="rounded-xl border border-dashed border-gray-300 bg-gray-50 px-4 py-6 text-sm text-gray-500",this.emptyState.textContent=(this.config.multiple,"No media selected.");let o=document.createElement("div");o.className="flex flex-wrap gap-3",this.browseButton=dn("Browse Library","secondary"),this.browseButton.dataset.mediaPickerBrowse="true",this.browseButton.addEventListener("click",this.handleBrowse),this.uploadButton=dn(this.config.multiple?"Upload Files":"Upload File","secondary"),this.uploadButton.dataset.mediaPickerUpload="true",this.uploadButton.addEventListener("click",this.handleUploadTrigger),this.clearButton=dn("Clear","secondary"),this.clearButton.dataset.mediaPickerClear="true",this.clearButton.addEventListener("click",this.handleClear),this.uploadInput=document.createElement("input"),this.uploadInput.type="file",this.uploadInput.className="sr-only",this.uploadInput.multiple=this.config.multiple,this.uploadInput.addEventListener("change",this.handleInlineUpload),this.statusMessage=document.createElement("p"),this.statusMessage.dataset.mediaPickerStatus="true",this.statusMessage.className="text-sm text-gray-500",this.statusMessage.setAttribute("aria-live","polite"),o.append(this.browseButton,this.uploadButton,this.clearButton,this.uploadInput),this.shell.replaceChildren(this.selectionRoot,this.emptyState,o,this.statusMessage);let s=Jx();this.overlay=s.overlay,this.modalSearch=s.search,this.modalResults=s.results,this.modalPreview=s.preview,this.modalName=s.name,this.modalMeta=s.meta,this.modalError=s.error,this.modalApply=s.apply,this.modalClear=s.clear,this.modalUpload=s.upload,this.modalUploadInput=s.uploadInput,this.modalClose=s.close,this.modalClose.addEventListener("click",this.closeModal),this.modalApply.addEventListener("click",this.handleApplySelection),this.modalClear.addEventListener("click",this.handleModalClear),this.modalUpload.addEventListener("click",this.handleModalUploadTrigger),this.modalUploadInput.addEventListener("change",this.handleModalUpload),this.modalSearch.addEventListener("input",this.handleModalSearch),this.overlay.addEventListener("click",this.handleOverlayClick),document.body.appendChild(this.overlay),this.renderSelections(),this.bootstrap(i)}destroy(){this.destroyed=!0,this.browseButton.removeEventListener("click",this.handleBrowse),this.uploadButton.removeEventListener("click",this.handleUploadTrigger),this.clearButton.removeEventListener("click",this.handleClear),this.uploadInput.removeEventListener("change",this.handleInlineUpload),this.modalClose.removeEventListener("click",this.closeModal),this.modalApply.removeEventListener("click",this.handleApplySelection),this.modalClear.removeEventListener("click",this.handleModalClear),this.modalUpload.removeEventListener("click",this.handleModalUploadTrigger),this.modalUploadInput.removeEventListener("change",this.handleModalUpload),this.modalSearch.removeEventListener("input",this.handleModalSearch),this.overlay.removeEventListener("click",this.handleOverlayClick),this.overlay.remove()}async bootstrap(e){this.setStatus("Loading media configuration...");try{this.capabilities=await this.loadCapabilities(),this.effectiveValueMode=Xx(this.config.valueMode,this.capabilities.picker),this.uploadMode=Zx(this.config,this.capabilities),this.configureUploadInputs(),this.updateCapabilityAffordances(),e.length>0&&(this.selections=(await Promise.all(e.map(n=>this.resolveValue(n)))).filter(n=>n!==null)),this.serializeSelections(),this.renderSelections(),this.setStatus("")}catch(n){this.setStatus(n instanceof Error?n.message:"Unable to load media picker.")}}resolveShell(){let e=this.element.querySelector("[data-media-picker-root]");if(e)return e.className="space-y-3",e;let n=document.createElement("div");return n.dataset.mediaPickerRoot="true",n.className="space-y-3",this.element.appendChild(n),n}configureUploadInputs(){let n=this.resolvedAcceptedTypes().join(",");this.uploadInput.accept=n,this.modalUploadInput.accept=n}updateCapabilityAffordances(){let e=this.uploadMode!=="none";this.uploadButton.hidden=!e,this.modalUpload.hidden=!e,this.clearButton.disabled=this.selections.length===0}async loadCapabilities(){let e=Yx(this.config);if(!this.config.capabilitiesEndpoint)return e;try{let n=await Ut(this.config.capabilitiesEndpoint,{headers:Fr(this.config.headers,this.element)});return Qx(n,e)}catch(n){return console.warn("formgen:media-picker - failed to load capabilities",n),e}}collectHydratedValues(){var a;let e=l=>l.trim();if(!this.config.multiple){let l=e((a=this.input.value)!=null?a:"");return l?[l]:[]}let r=`${this.originalName.endsWith("[]")?this.originalName.slice(0,-2):this.originalName}[]`,i=[`input[name="${ip(this.originalName)}"]`,`input[name="${ip(r)}"]`].join(", "),o=Array.from(this.element.querySelectorAll(i)),s=o.map(l=>{var c;return e((c=l.value)!=null?c:"")}).filter(Boolean);for(let l of o)l!==this.input&&l.remove();return s}async resolveValue(e){let n=e.trim();if(!n)return null;try{if(this.effectiveValueMode==="id"){if(this.config.itemEndpoint){let r=tC(this.config.itemEndpoint,"id",n);return Gn(await Ut(r,{headers:Fr(this.config.headers,this.element)}))}if(this.config.resolveEndpoint)return Gn(await Ut(this.config.resolveEndpoint,{method:"POST",headers:so(this.config.headers,this.element),body:JSON.stringify({id:n})}))}else if(this.config.resolveEndpoint)return Gn(await Ut(this.config.resolveEndpoint,{method:"POST",headers:so(this.config.headers,this.element),body:JSON.stringify({url:n})}))}catch(r){console.warn("formgen:media-picker - hydrate failed",r)}return eC(n,this.effectiveValueMode)}renderSelections(){if(this.selectionRoot.innerHTML="",this.emptyState.hidden=this.selections.length>0,this.clearButton.disabled=this.selections.length===0,this.selections.length===0)return;let e=this.config.multiple?this.selections:this.selections.slice(0,1);for(let n of e)this.selectionRoot.appendChild(this.renderSelectionCard(n))}renderSelectionCard(e){let n=document.createElement("div");n.dataset.mediaPickerSelection="true",n.className="flex items-center gap-3 rounded-xl border border-gray-200 bg-white p-3 shadow-sm";let r=document.createElement("div");r.className="flex h-16 w-16 shrink-0 items-center justify-center overflow-hidden rounded-lg border border-gray-200 bg-gray-50",r.appendChild(ma(e,"h-full w-full object-cover"));let i=document.createElement("div");i.className="min-w-0 flex-1";let o=document.createElement("div");o.className="truncate text-sm font-medium text-gray-900",o.textContent=e.name||e.url||e.id||"Media";let s=document.createElement("div");s.className="mt-1 text-xs text-gray-500",s.textContent=ga(e,this.effectiveValueMode),i.append(o,s);let a=document.createElement("button");return a.type="button",a.dataset.mediaPickerRemove=Ct(e,this.effectiveValueMode),a.className="inline-flex h-9 w-9 items-center justify-center rounded-md border border-slate-200 bg-white text-slate-500 shadow-sm transition hover:text-rose-500 focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-blue-500 focus-visible:ring-offset-2",a.textContent="\xD7",a.addEventListener("click",()=>this.removeSelection(e)),n.append(r,i,a),n}removeSelection(e){let n=Ct(e,this.effectiveValueMode);this.selections=this.selections.filter(r=>Ct(r,this.effectiveValueMode)!==n),this.serializeSelections(),this.renderSelections(),Me(this.element)}serializeSelections(){var r,i,o;this.hiddenContainer.innerHTML="";let e=this.selections.map(s=>Ct(s,this.effectiveValueMode)).filter(Boolean);if(e.length===0){this.input.name=this.originalName,this.input.value="";return}if(!this.config.multiple){this.input.name=this.originalName,this.input.value=(r=e[0])!=null?r:"";return}let n=this.originalName.endsWith("[]")?this.originalName:`${this.originalName}[]`;this.input.name=n,this.input.value=(i=e[0])!=null?i:"";for(let s=1;s<e.length;s+=1){let a=document.createElement("input");a.type="hidden",a.name=n,a.value=(o=e[s])!=null?o:"",this.hiddenContainer.appendChild(a)}}setStatus(e){this.statusMessage.textContent=e}showError(e){this.setStatus(e),ze(this.element,e)}async uploadFiles(e){if(this.uploadMode==="none")throw new Error("Uploads are not available for this picker.");let n=Array.from(e),r=this.config.multiple?n:n.slice(0,1),i=[];for(let o of r){let s=this.validateFile(o);if(s)throw new Error(s);let a=await this.uploadFile(o);i.push(a)}return this.config.multiple?this.selections=ha([...this.selections,...i],this.effectiveValueMode):this.selections=i.slice(0,1),this.serializeSelections(),this.renderSelections(),Me(this.element),i}validateFile(e){var o,s,a;let n=(a=(s=(o=this.capabilities.upload)==null?void 0:o.max_size)!=null?s:this.config.maxSize)!=null?a:Kx;if(n>0&&e.size>n)return`File "${e.name}" exceeds maximum size of ${cC(n)}.`;let r=this.resolvedAcceptedTypes();if(r.length>0&&!r.some(l=>sC(e,l)))return`File "${e.name}" is not an allowed type.`;let i=this.resolvedAcceptedKinds();return i.length>0&&!i.some(l=>aC(e,l))?`File "${e.name}" is not an allowed media kind.`:null}resolvedAcceptedTypes(){var r,i;let e=this.config.accept,n=(i=(r=this.capabilities.upload)==null?void 0:r.accepted_mime_types)!=null?i:[];return e.length>0?e:n.filter(Boolean)}resolvedAcceptedKinds(){var n,r;let e=(r=(n=this.capabilities.upload)==null?void 0:n.accepted_kinds)!=null?r:[];return this.config.acceptedKinds.length>0?this.config.acceptedKinds:e.filter(Boolean)}async uploadFile(e){if(this.setStatus(`Uploading ${e.name}...`),this.uploadMode==="presign"){let i=rC(await Ut(this.config.presignEndpoint,{method:"POST",headers:so(this.config.headers,this.element),body:JSON.stringify({name:e.name,file_name:e.name,content_type:e.type||"application/octet-stream",size:e.size})}));await nC(e,i);let o=await Ut(this.config.confirmEndpoint,{method:"POST",headers:so(this.config.headers,this.element),body:JSON.stringify({upload_id:i.upload_id,name:e.name,file_name:e.name,content_type:e.type||"application/octet-stream",size:e.size})});return this.setStatus(""),Gn(o)}let n=new FormData;n.append("file",e,e.name);let r=await Ut(this.config.uploadEndpoint,{method:"POST",headers:Fr(this.config.headers,this.element),body:n});return this.setStatus(""),Gn(r)}async loadLibrary(e){var a;if(!this.config.libraryPath){this.libraryItems=[];return}let n=++this.searchToken,r=new URL(this.config.libraryPath,window.location.origin);e.trim()&&r.searchParams.set("search",e.trim()),r.searchParams.set("limit","48");let i=this.resolvedAcceptedKinds();i.length===1&&r.searchParams.set("type",(a=i[0])!=null?a:"");let o=await Ut(r.toString(),{headers:Fr(this.config.headers,this.element)});if(n!==this.searchToken)return;let s=Array.isArray(o)?o:Array.isArray(o==null?void 0:o.items)?o.items:[];this.libraryItems=s.map(l=>Gn(l)).filter(l=>this.matchesLibraryFilters(l))}matchesLibraryFilters(e){let n=this.resolvedAcceptedKinds();return n.length===0||!e.type?!0:n.includes(e.type)}renderModal(){var r,i;this.modalResults.innerHTML="";let e=this.libraryItems;if(e.length===0){let o=document.createElement("p");o.dataset.mediaPickerModalEmpty="true",o.className="col-span-full rounded-xl border border-dashed border-gray-300 px-4 py-10 text-center text-sm text-gray-500",o.textContent="No media matched this filter.",this.modalResults.appendChild(o)}for(let o of e){let s=Ct(o,this.effectiveValueMode),a=this.candidateSelections.some(f=>Ct(f,this.effectiveValueMode)===s),l=document.createElement("button");l.type="button",l.dataset.mediaPickerOption=s,l.className=["overflow-hidden rounded-xl border bg-white text-left shadow-sm transition",a?"border-blue-500 ring-2 ring-blue-500/30":"border-gray-200 hover:border-gray-400"].join(" "),l.addEventListener("click",()=>{this.focusedItem=o,this.config.multiple?this.toggleCandidateSelection(o):this.candidateSelections=[o],this.renderModal()});let c=document.createElement("div");c.className="h-28 bg-gray-50",c.appendChild(ma(o,"h-full w-full object-cover"));let d=document.createElement("div");d.className="space-y-1 p-3";let u=document.createElement("div");u.className="truncate text-sm font-medium text-gray-900",u.textContent=o.name||o.url||o.id||"Media";let p=document.createElement("div");p.className="truncate text-xs text-gray-500",p.textContent=ga(o,this.effectiveValueMode),d.append(u,p),l.append(c,d),this.modalResults.appendChild(l)}let n=(i=(r=this.focusedItem)!=null?r:this.candidateSelections[0])!=null?i:null;this.modalPreview.replaceChildren(ma(n,"h-full w-full object-cover")),this.modalName.textContent=(n==null?void 0:n.name)||(n==null?void 0:n.url)||(n==null?void 0:n.id)||"No media selected",this.modalMeta.textContent=n?ga(n,this.effectiveValueMode):"",this.modalApply.textContent=this.config.multiple?"Use Selection":"Select",this.modalClear.disabled=this.candidateSelections.length===0}toggleCandidateSelection(e){let n=Ct(e,this.effectiveValueMode),r=this.candidateSelections.findIndex(i=>Ct(i,this.effectiveValueMode)===n);if(r>=0){this.candidateSelections=[...this.candidateSelections.slice(0,r),...this.candidateSelections.slice(r+1)];return}this.candidateSelections=ha([...this.candidateSelections,e],this.effectiveValueMode)}};function Jx(){let t=document.createElement("div");t.dataset.mediaPickerModal="true",t.hidden=!0,t.className="fixed inset-0 z-[80] hidden items-center justify-center bg-black/50 p-6";let e=document.createElement("div");e.className="grid h-[min(80vh,760px)] w-[min(1100px,95vw)] grid-cols-[minmax(0,1fr)_320px] overflow-hidden rounded-2xl bg-white shadow-2xl";let n=document.createElement("div");n.className="flex min-h-0 flex-col";let r=document.createElement("div");r.className="border-b border-gray-200 p-4";let i=document.createElement("input");i.dataset.mediaPickerModalSearch="true",i.type="search",i.placeholder="Search media",i.className="w-full rounded-lg border border-gray-300 px-4 py-3 text-sm",r.appendChild(i);let o=document.createElement("div");o.dataset.mediaPickerModalResults="true",o.className="grid flex-1 grid-cols-[repeat(auto-fill,minmax(160px,1fr))] gap-3 overflow-y-auto p-4",n.append(r,o);let s=document.createElement("div");s.className="border-l border-gray-200 p-4";let a=document.createElement("div");a.dataset.mediaPickerModalPreview="true",a.className="mb-4 flex min-h-[220px] items-center justify-center overflow-hidden rounded-2xl border border-gray-200 bg-gray-50";let l=document.createElement("h3");l.dataset.mediaPickerModalName="true",l.className="text-lg font-semibold text-gray-900",l.textContent="No media selected";let c=document.createElement("p");c.dataset.mediaPickerModalMeta="true",c.className="mt-1 text-sm text-gray-500";let d=document.createElement("div");d.className="mt-4 flex flex-wrap gap-3";let u=dn("Select","primary");u.dataset.mediaPickerModalApply="true";let p=dn("Clear","secondary");p.dataset.mediaPickerModalClear="true";let f=dn("Upload","secondary");f.dataset.mediaPickerModalUpload="true";let h=dn("Close","secondary");h.dataset.mediaPickerModalClose="true";let m=document.createElement("input");m.dataset.mediaPickerModalUploadInput="true",m.type="file",m.className="sr-only";let g=document.createElement("p");return g.dataset.mediaPickerModalError="true",g.hidden=!0,g.className="mt-4 text-sm text-red-600",d.append(u,p,f,h,m),s.append(a,l,c,d,g),e.append(n,s),t.appendChild(e),{overlay:t,search:i,results:o,preview:a,name:l,meta:c,error:g,apply:u,clear:p,upload:f,uploadInput:m,close:h}}function dn(t,e){let n=document.createElement("button");return n.type="button",n.textContent=t,n.className=e==="primary"?"inline-flex items-center justify-center rounded-lg bg-blue-600 px-4 py-2 text-sm font-medium text-white transition hover:bg-blue-700 focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-blue-500 focus-visible:ring-offset-2":"inline-flex items-center justify-center rounded-lg border border-gray-200 bg-white px-4 py-2 text-sm font-medium text-gray-700 transition hover:border-gray-300 hover:bg-gray-50 focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-blue-500 focus-visible:ring-offset-2",n}function Gx(t){let e=t!=null?t:{},n=(o,s=!1)=>typeof o=="boolean"?o:typeof o=="string"?o.toLowerCase()==="true":s,r=o=>{if(typeof o=="number"&&Number.isFinite(o))return o;if(typeof o=="string"&&o.trim()!==""){let s=Number(o);return Number.isFinite(s)?s:void 0}},i={};return e.headers&&typeof e.headers=="object"&&Object.entries(e.headers).forEach(([o,s])=>{s!=null&&(i[o]=String(s))}),{variant:typeof e.variant=="string"&&e.variant.trim()?e.variant.trim():"media-picker",multiple:n(e.multiple,!1),valueMode:e.valueMode==="id"?"id":"url",libraryPath:te(e.libraryPath),itemEndpoint:te(e.itemEndpoint),resolveEndpoint:te(e.resolveEndpoint),uploadEndpoint:te(e.uploadEndpoint),presignEndpoint:te(e.presignEndpoint),confirmEndpoint:te(e.confirmEndpoint),capabilitiesEndpoint:te(e.capabilitiesEndpoint),maxSize:r(e.maxSize),acceptedKinds:rp(e.acceptedKinds),accept:rp(e.accept),headers:i}}function te(t){return typeof t=="string"?t.trim():""}function rp(t){return Array.isArray(t)?t.filter(e=>typeof e=="string").map(e=>e.trim()).filter(Boolean):typeof t=="string"?t.split(",").map(e=>e.trim()).filter(Boolean):[]}function Yx(t){let e=!!(t.itemEndpoint||t.resolveEndpoint);return{operations:{list:!!t.libraryPath,get:!!t.itemEndpoint,resolve:!!t.resolveEndpoint,upload:!!t.uploadEndpoint,presign:!!t.presignEndpoint,confirm:!!t.confirmEndpoint},upload:{direct_upload:!!t.uploadEndpoint,presign:!!(t.presignEndpoint&&t.confirmEndpoint),max_size:t.maxSize,accepted_kinds:t.acceptedKinds,accepted_mime_types:t.accept},picker:{value_modes:e?["url","id"]:["url"],default_value_mode:t.valueMode==="id"&&e?"id":"url"}}}function Qx(t,e){var n,r,i,o,s;return{operations:{...e.operations,...(n=t.operations)!=null?n:{}},upload:{...e.upload,...(r=t.upload)!=null?r:{}},picker:{...e.picker,...(i=t.picker)!=null?i:{},value_modes:op((o=t.picker)==null?void 0:o.value_modes,(s=e.picker)==null?void 0:s.value_modes)}}}function op(t,e){let r=(Array.isArray(t)&&t.length>0?t:e!=null?e:["url"]).filter(i=>i==="url"||i==="id");return r.length>0?Array.from(new Set(r)):["url"]}function Xx(t,e){var r;let n=op(e==null?void 0:e.value_modes,["url"]);return n.includes(t)?t:e!=null&&e.default_value_mode&&n.includes(e.default_value_mode)?e.default_value_mode:n.includes("url")?"url":(r=n[0])!=null?r:"url"}function Zx(t,e){var i,o;let n=(i=e.operations)!=null?i:{},r=(o=e.upload)!=null?o:{};return r.presign&&n.presign&&n.confirm&&t.presignEndpoint&&t.confirmEndpoint?"presign":r.direct_upload&&n.upload&&t.uploadEndpoint?"multipart":"none"}function Gn(t){let e=t&&typeof t=="object"?t:{},n=e.metadata&&typeof e.metadata=="object"?e.metadata:void 0;return{id:te(e.id),name:te(e.name)||te(e.filename),url:te(e.url),thumbnail:te(e.thumbnail)||te(e.thumbnail_url)||te(n==null?void 0:n.thumbnail_url),type:te(e.type)||te(n==null?void 0:n.type),mime_type:te(e.mime_type)||te(e.content_type)||te(n==null?void 0:n.mime_type),size:typeof e.size=="number"?e.size:void 0,status:te(e.status),workflow_status:te(e.workflow_status),workflow_error:te(e.workflow_error),metadata:n,created_at:te(e.created_at)}}function eC(t,e){return e==="id"?{id:t,name:t}:{url:t,name:lC(t),thumbnail:xa(t)?t:"",type:xa(t)?"image":""}}function Ct(t,e){var n,r,i,o;return e==="id"?(r=(n=t.id)==null?void 0:n.trim())!=null?r:"":(o=(i=t.url)==null?void 0:i.trim())!=null?o:""}function ha(t,e){let n=new Set,r=[];for(let i of t){let o=Ct(i,e);!o||n.has(o)||(n.add(o),r.push(i))}return r}function ma(t,e){if((t==null?void 0:t.type)==="image"||xa((t==null?void 0:t.thumbnail)||(t==null?void 0:t.url)||"")){let r=document.createElement("img");return r.className=e,r.src=(t==null?void 0:t.thumbnail)||(t==null?void 0:t.url)||"",r.alt=(t==null?void 0:t.name)||"Media preview",r}let n=document.createElement("div");return n.className="flex h-full w-full items-center justify-center bg-gray-100 text-sm text-gray-500",n.textContent=(t==null?void 0:t.type)||"Media",n}function ga(t,e){return[t.type,t.mime_type,e==="id"?t.id:t.url].filter(Boolean).join(" \xB7 ")}function tC(t,e,n){let r=encodeURIComponent(n);return t.replace(`:${e}`,r)}async function nC(t,e){var o;if(!e.upload_url||!e.upload_id)throw new Error("Upload session initialization failed.");let n=(e.method||"PUT").toUpperCase(),r={...(o=e.headers)!=null?o:{}};if(n==="POST"&&e.fields&&Object.keys(e.fields).length>0){let s=new FormData;for(let[l,c]of Object.entries(e.fields))s.append(l,c);s.append("file",t,t.name);let a=await fetch(e.upload_url,{method:n,body:s});if(!a.ok)throw new Error(`Upload failed (${a.status})`);return}let i=await fetch(e.upload_url,{method:n,headers:r,body:t});if(!i.ok)throw new Error(`Upload failed (${i.status})`)}function rC(t){var e,n,r;return{upload_url:(e=t.upload_url)==null?void 0:e.trim(),method:((n=t.method)==null?void 0:n.trim())||"PUT",headers:t.headers&&typeof t.headers=="object"?Object.fromEntries(Object.entries(t.headers).map(([i,o])=>[i,String(o)])):void 0,fields:t.fields&&typeof t.fields=="object"?Object.fromEntries(Object.entries(t.fields).map(([i,o])=>[i,String(o)])):void 0,upload_id:(r=t.upload_id)==null?void 0:r.trim()}}async function Ut(t,e={}){var s;let n=await fetch(t,{credentials:"same-origin",headers:{Accept:"application/json",...(s=e.headers)!=null?s:{}},...e}),r=String(n.headers.get("content-type")||"").toLowerCase();if(!(r.includes("application/json")||r.includes("+json")))throw n.ok?new Error("Unexpected server response: expected JSON."):new Error(`Request failed (${n.status})`);let o=await n.json().catch(()=>null);if(!n.ok){let a=ba(o)||`Request failed (${n.status})`;throw new Error(a)}if(o==null)throw new Error("Unexpected server response: expected JSON.");return o}function so(t,e){return{"Content-Type":"application/json",...Fr(t,e)}}function Fr(t,e){let n={};for(let[r,i]of Object.entries(t!=null?t:{})){let o=iC(i,e);o&&(n[r]=o)}return n}function iC(t,e){var n,r;if(t){if(t.startsWith("meta:")){let i=document.querySelector(`meta[name="${t.slice(5)}"]`);return(n=i==null?void 0:i.getAttribute("content"))!=null?n:void 0}if(t.startsWith("data:")){let i=t.slice(5);if(e.hasAttribute(i))return(r=e.getAttribute(i))!=null?r:void 0;let o=oC(i);return e.dataset[o]}return t}}function oC(t){return t.replace(/^data-/,"").split(/[-_:]/).filter(Boolean).map((e,n)=>n===0?e.toLowerCase():e.charAt(0).toUpperCase()+e.slice(1).toLowerCase()).join("")}function ba(t){if(!t)return"";if(typeof t=="string")return t.trim();if(Array.isArray(t)){for(let n of t){let r=ba(n);if(r)return r}return""}if(typeof t!="object")return"";let e=t;for(let n of["error","message","detail","reason","description"]){let r=ba(e[n]);if(r)return r}return""}function sC(t,e){if(!e)return!0;if(e.startsWith("."))return t.name.toLowerCase().endsWith(e.toLowerCase());if(e.endsWith("/*")){let n=e.split("/")[0];return t.type.startsWith(`${n}/`)}return t.type===e}function aC(t,e){let n=e.trim().toLowerCase();if(!n)return!0;switch(n){case"image":return t.type.startsWith("image/");case"audio":return t.type.startsWith("audio/");case"video":return t.type.startsWith("video/");case"document":return/pdf|msword|officedocument|text\//.test(t.type);default:return t.type.startsWith(`${n}/`)}}function ip(t){var e;return typeof globalThis!="undefined"&&typeof((e=globalThis.CSS)==null?void 0:e.escape)=="function"?globalThis.CSS.escape(t):t.replace(/["\\]/g,"\\$1")}function xa(t){return/\.(avif|gif|jpe?g|png|svg|webp)$/i.test(t.split("?")[0]||"")}function lC(t){var r;let n=t.split("#")[0].split("?")[0].split("/").filter(Boolean);return(r=n[n.length-1])!=null?r:t}function cC(t){return Number.isFinite(t)?t<1024?`${t} B`:t<1024*1024?`${(t/1024).toFixed(1)} KB`:`${(t/(1024*1024)).toFixed(1)} MB`:"0 B"}var Fe=new Map,Br=new WeakMap;fC();function Ea(t=document){var n;let e=ap(t);for(let r of e){let i=pC((n=r.dataset.component)!=null?n:"");if(!i)continue;let o=Br.get(r);if(o&&o.name===i)continue;o!=null&&o.teardown&&(o.teardown(),Br.delete(r));let s=Fe.get(i);if(!s)continue;let a=dC(r.getAttribute("data-component-config")),l=uC(r,t),c=s({element:r,config:a,root:l});Br.set(r,{name:i,teardown:typeof c=="function"?c:void 0})}}function va(t=document){var e;for(let n of ap(t)){let r=Br.get(n);(e=r==null?void 0:r.teardown)==null||e.call(r),Br.delete(n)}}function ap(t){let e=(t instanceof Document,t),n=Array.from(e.querySelectorAll("[data-component]"));return t instanceof HTMLElement&&t.hasAttribute("data-component")&&n.unshift(t),n}function dC(t){if(t)try{let e=JSON.parse(t);return typeof e=="object"&&e!==null?e:void 0}catch(e){console.warn("formgen: failed to parse component config",e);return}}function uC(t,e){var r,i,o;let n=t.closest("[data-formgen-auto-init]");return n||(e instanceof HTMLElement?e:(o=(i=e.body)!=null?i:(r=t.ownerDocument)==null?void 0:r.body)!=null?o:t)}function pC(t){return t.trim().toLowerCase()}function fC(){Fe.has("datetime-range")||Fe.set("datetime-range",hC),Fe.has("daterange")||Fe.set("daterange",mC),Fe.has("duration")||Fe.set("duration",gC),Fe.has("media_picker")||Fe.set("media_picker",Ca),Fe.has("media-picker")||Fe.set("media-picker",Ca),Fe.has("file_uploader")||Fe.set("file_uploader",np)}function hC({element:t}){let e=Array.from(t.querySelectorAll("input[type='datetime-local'], input[type='date'], input[type='time']"));if(e.length<2)return;let[n,r]=e,i=()=>{n.value&&(!r.value||r.value<n.value)&&(r.value=n.value)},o=()=>{n.removeEventListener("change",i),r.removeEventListener("change",i)};return n.addEventListener("change",i),r.addEventListener("change",i),i(),o}function mC({element:t}){let e=t.querySelector("[data-formgen-daterange-bound='start']"),n=t.querySelector("[data-formgen-daterange-bound='end']");if(!e||!n)return;let r=()=>{n.min=e.value,e.max=n.value,e.value&&n.value&&n.value<e.value&&(n.value=e.value,e.max=n.value)};return e.addEventListener("change",r),n.addEventListener("change",r),r(),()=>{e.removeEventListener("change",r),n.removeEventListener("change",r)}}var sp={years:["Y",!1],months:["M",!1],weeks:["W",!1],days:["D",!1],hours:["H",!0],minutes:["M",!0],seconds:["S",!0]};function gC({element:t}){let e=t.querySelector("[data-formgen-duration-value]"),n=Array.from(t.querySelectorAll("[data-formgen-duration-part]"));if(!e||n.length===0)return;let r=()=>{var a;let i="",o="",s=!1;for(let l of Object.keys(sp)){let c=n.find(h=>h.dataset.formgenDurationPart===l),d=(a=c==null?void 0:c.value.trim())!=null?a:"";if(d==="")continue;s=!0;let u=Math.max(0,Math.trunc(Number(d)));if(!Number.isFinite(u)||u===0)continue;let[p,f]=sp[l];f?o+=`${u}${p}`:i+=`${u}${p}`}s?!i&&!o?e.value="PT0S":e.value=`P${i}${o?`T${o}`:""}`:e.value="",e.dispatchEvent(new Event("change",{bubbles:!0}))};for(let i of n)i.addEventListener("input",r);return()=>{for(let i of n)i.removeEventListener("input",r)}}var Ta="data-formgen-array-items",yC="data-formgen-array-prototype",bC='[data-formgen-array-action="add"]',pp='[data-formgen-array-action="remove"]',Sa="data-formgen-array-initialized",ao="data-formgen-array-item",fp="data-formgen-array-existing",lp="data-formgen-prototype-disabled",ka=new WeakMap,cp=0;function Ma(t=document,e={}){for(let n of gp(t)){if(n.getAttribute(Sa)==="true")continue;let r=yp(n),i=bp(n);if(!r||!i)continue;let o=()=>{var a;let s=hp(n);for(let l of s)(a=e.onItemAdded)==null||a.call(e,l)};i.addEventListener("click",o),n.addEventListener("click",xp),n.setAttribute(Sa,"true"),ka.set(n,{button:i,handleAdd:o}),Aa(n)}}function wa(t=document){for(let e of gp(t)){let n=ka.get(e);n&&(n.button.removeEventListener("click",n.handleAdd),e.removeEventListener("click",xp),ka.delete(e)),e.removeAttribute(Sa)}}function hp(t){var u,p,f;let e=yp(t);if(!e)return[];let{max:n}=Ra(t);if(n!==null&&Oa(t)>=n)return[];let r=wC(t),i=(u=t.dataset.formgenArrayName)!=null?u:"",o=(p=t.dataset.formgenArrayPrototypePath)!=null?p:i?`${i}[${r}]`:"",s=i?`${i}[${r}]`:o,a=(f=t.dataset.formgenArrayPrototypeIdPrefix)!=null?f:o?up(o):"",l=s?up(s):a,c=e.content.cloneNode(!0);RC(c,{prototypePath:o,targetPath:s,prototypeIDPrefix:a,targetIDPrefix:l}),NC(c);let d=Array.from(c.children).filter(h=>h instanceof HTMLElement);return t.insertBefore(c,e),t.dataset.formgenArrayNextIndex=String(r+1),Aa(t),d}function Aa(t){let{min:e,max:n}=Ra(t);if(e===null&&n===null)return;let r=Oa(t),i=bp(t);i&&n!==null&&(i.disabled=r>=n);let o=e!==null&&r<=e;for(let s of mp(t))s.querySelectorAll(pp).forEach(a=>{a.closest(`[${ao}]`)===s&&(a.disabled=o)})}function Ra(t){let e=n=>{let r=Number.parseInt(n!=null?n:"",10);return Number.isFinite(r)&&r>=0?r:null};return{min:e(t.dataset.formgenArrayMinItems),max:e(t.dataset.formgenArrayMaxItems)}}function mp(t){return Array.from(t.children).filter(e=>e instanceof HTMLElement&&!(e instanceof HTMLTemplateElement)&&!e.hidden)}function Oa(t){return mp(t).length}function gp(t){let e=(t instanceof Document,t),n=`[${Ta}]`,r=Array.from(e.querySelectorAll(n));return t instanceof HTMLElement&&t.hasAttribute(Ta)&&r.unshift(t),Array.from(new Set(r))}function yp(t){for(let e of Array.from(t.children))if(e instanceof HTMLTemplateElement&&e.hasAttribute(yC))return e;return null}function bp(t){let e=t.parentElement;if(!e)return null;for(let n of Array.from(e.children))if(n instanceof HTMLButtonElement&&n.matches(bC))return n;return null}function xp(t){let e=t.target;if(!(e instanceof Element))return;let n=e.closest(pp);if(!n)return;let r=t.currentTarget instanceof HTMLElement?t.currentTarget:null;if(!(r&&n.closest(`[${Ta}]`)!==r)){if(t.preventDefault(),r){let{min:i}=Ra(r);if(i!==null&&Oa(r)<=i)return}xC(n),r&&Aa(r)}}function xC(t){let e=t.closest(`[${ao}]`);if(e){if(CC(e)&&EC(e)){e.hidden=!0,e.setAttribute("aria-hidden","true");return}e.remove()}}function CC(t){return t.getAttribute(fp)==="true"}function EC(t){let e=vC(t);return e?(TC(e),SC(t,e),!0):!1}function vC(t){var n;return(n=Array.from(t.querySelectorAll("input")).find(r=>r.closest(`[${ao}]`)===t&&La(r)))!=null?n:null}function La(t){var r,i,o;let e=(r=t.getAttribute("name"))!=null?r:"",n=(o=(i=t.getAttribute("data-field-path"))!=null?i:t.dataset.fieldName)!=null?o:"";return e.endsWith("._delete")||e.endsWith("[_delete]")||n.endsWith("._delete")||n.endsWith("[_delete]")}function TC(t){if(t.disabled=!1,t.type==="checkbox"||t.type==="radio"){t.checked=!0,t.value="true";return}t.value="true"}function SC(t,e){t.querySelectorAll("input, select, textarea, button").forEach(n=>{n===e||kC(n)||(n.disabled=!0)})}function kC(t){return t instanceof HTMLInputElement?La(t)||MC(t):!1}function MC(t){var r,i,o;let e=(r=t.getAttribute("name"))!=null?r:"",n=(o=(i=t.getAttribute("data-field-path"))!=null?i:t.dataset.fieldName)!=null?o:"";return dp(e)||dp(n)}function dp(t){return t.endsWith("._present")||t.endsWith("[_present]")||t.endsWith("._row_state")||t.endsWith("[_row_state]")||t.endsWith("._row_key")||t.endsWith("[_row_key]")}function wC(t){var n;let e=Number.parseInt((n=t.dataset.formgenArrayNextIndex)!=null?n:"",10);return Number.isFinite(e)&&e>=0?e:AC(t)}function AC(t){return Array.from(t.children).filter(e=>!(e instanceof HTMLTemplateElement)).length}function RC(t,e){for(let n of Cp(t))OC(n,e)}function OC(t,e){for(let n of Array.from(t.attributes)){let r=LC(n.value,e);r!==n.value&&t.setAttribute(n.name,r)}}function LC(t,e){let n=t;return e.prototypePath&&e.targetPath&&(n=n.split(e.prototypePath).join(e.targetPath)),e.prototypeIDPrefix&&e.targetIDPrefix&&(n=n.split(e.prototypeIDPrefix).join(e.targetIDPrefix)),n}function NC(t){for(let e of Cp(t)){let n=e.getAttribute(lp)==="true";if(e.removeAttribute(lp),e.removeAttribute("data-relationship-current"),e.removeAttribute("data-relationship-current-applied"),e.hasAttribute(ao)&&e.setAttribute(fp,"false"),e instanceof HTMLInputElement){if(n&&(e.disabled=!1),IC(e))continue;e.type==="checkbox"||e.type==="radio"?e.checked=!1:e.value="";continue}if(e instanceof HTMLSelectElement){n&&(e.disabled=!1),Array.from(e.options).forEach((r,i)=>{r.selected=!e.multiple&&i===0});continue}if(e instanceof HTMLTextAreaElement){n&&(e.disabled=!1),e.value="";continue}(e instanceof HTMLButtonElement||e instanceof HTMLFieldSetElement||e instanceof HTMLOptGroupElement)&&n&&(e.disabled=!1)}}function IC(t){var n;let e=(n=t.getAttribute("name"))!=null?n:"";return e.endsWith("._present")||e.endsWith("[_present]")?(t.value="true",!0):e.endsWith("._row_state")||e.endsWith("[_row_state]")?(t.value="new",!0):e.endsWith("._row_key")||e.endsWith("[_row_key]")?(t.value=PC(),!0):La(t)?(t.value="false",(t.type==="checkbox"||t.type==="radio")&&(t.checked=!1),!0):!1}function PC(){return cp+=1,`new-${Date.now().toString(36)}-${cp}`}function Cp(t){let e=[];for(let n of Array.from(t.children))e.push(n),e.push(...Array.from(n.querySelectorAll("*")));return e}function up(t){return`fg-${DC(t.split("[]").join(".item"))}`}function DC(t){let e="",n=!1;for(let r of t.trim()){if(/^[A-Za-z0-9_-]$/.test(r)){e+=r,n=!1;continue}n||(e+="-",n=!0)}return e.replace(/^-+|-+$/g,"")}var Na="data-formgen-error-mapping",HC=new Set(["body","request","payload","data","attributes"]),FC=new Set(["","form","base","__all__","non_field_errors","non-field-errors"]);function Ep(t){if(!t)return[];try{let e=JSON.parse(t);return Array.isArray(e)?e:[]}catch{return[]}}function vp(t){let e=t instanceof HTMLElement&&t.hasAttribute(Na)?t:t.querySelector(`[${Na}]`);return Ep(e==null?void 0:e.getAttribute(Na))}function BC(t,e){let n=String(e);return(t.statuses||[]).some(r=>{let i=String(r).trim().toUpperCase();return i===n?!0:i.length===3&&i.endsWith("XX")&&i[0]===n[0]})}function Ia(t,e,n){for(let r of t){if(!BC(r,e))continue;let i=VC(r,n);if(Object.keys(i.fields).length>0||i.form.length>0)return i}return null}function VC(t,e){let n={fields:{},form:[]},r=lo(e,t.path),i=(o,s)=>{let a=zC(o);if(!a){n.form.push(s);return}n.fields[a]||(n.fields[a]=[]),n.fields[a].push(s)};if(t.shape==="list"&&Array.isArray(r)?r.forEach(o=>{let s=un(lo(o,t.messageKey));s&&i($C(lo(o,t.fieldKey)),s)}):t.shape==="map"&&co(r)&&Object.keys(r).sort().forEach(o=>{UC(r[o]).forEach(s=>i(o,s))}),Object.keys(n.fields).length===0&&n.form.length===0&&t.formMessageKey){let o=un(lo(e,t.formMessageKey));o&&n.form.push(o)}return n}function zC(t){let e=t.trim();if(FC.has(e.toLowerCase()))return"";let n=e.replace(/^[#$]/,"").replace(/\[(\w*)\]/g,".$1").split(/[./]/).map(r=>r.trim().replace(/~1/g,"/").replace(/~0/g,"~")).filter(Boolean);for(;n.length>0&&HC.has(n[0].toLowerCase());)n.shift();return n.filter(r=>!/^\d+$/.test(r)).join(".")}function lo(t,e){if(!e)return e===""?t:void 0;let n=t;for(let r of e.split(".")){if(!co(n))return;n=n[r]}return n}function $C(t){return Array.isArray(t)?t.map(un).filter(Boolean).join("."):un(t)}function UC(t){if(Array.isArray(t))return t.map(n=>un(co(n)?n.message:n)).filter(Boolean);if(co(t)){let n=un(t.message);return n?[n]:[]}let e=un(t);return e?[e]:[]}function un(t){return typeof t=="string"?t.trim():typeof t=="number"||typeof t=="boolean"?String(t):""}function co(t){return!!t&&typeof t=="object"&&!Array.isArray(t)}var kp="data-formgen-display",_C="data-display-for",Pa="data-display-bound";var jC=["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"],Tp=["B","KB","MB","GB","TB","PB"],WC=[{name:"minute",seconds:60,below:2700},{name:"hour",seconds:3600,below:79200},{name:"day",seconds:86400,below:2246400},{name:"month",seconds:2592e3,below:27648e3},{name:"year",seconds:31536e3,below:1/0}],Mp=new Map;function Da(t){let e=t.trim();if(e==="")return null;if(/^\d{4}-\d{2}-\d{2}$/.test(e))e+="T00:00:00Z";else if(/^\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}$/.test(e))e=e.replace(" ","T")+"Z";else if(!/^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$/i.test(e))return null;let n=new Date(e);return Number.isNaN(n.getTime())?null:n}function Sp(t){return t<10?`0${t}`:String(t)}function qC(t,e,n){let r=Da(t);if(!r)return null;let i=(n.getTime()-r.getTime())/1e3,o=Math.abs(i);if(o<45)return"just now";for(let s of WC){if(o>=s.below)continue;let a=Math.max(1,Math.round(o/s.seconds)),l=a===1?s.name:`${s.name}s`;return i<0?`in ${a} ${l}`:`${a} ${l} ago`}return null}function wp(t){let e=Da(t);return e?`${jC[e.getUTCMonth()]} ${e.getUTCDate()}, ${e.getUTCFullYear()}`:null}function KC(t){let e=wp(t),n=Da(t);return!e||!n?null:`${e} ${Sp(n.getUTCHours())}:${Sp(n.getUTCMinutes())} UTC`}function JC(t){if(t.trim()==="")return null;let e=Number(t);if(!Number.isFinite(e)||e<0)return null;let n=0;for(;e>=1024&&n<Tp.length-1;)e/=1024,n++;return`${Math.round(e*10)/10} ${Tp[n]}`}function GC(t,e){let n=Number.parseInt(e,10),r=Number.isFinite(n)&&n>0?n:80,i=Array.from(t);return i.length<=r?t:`${i.slice(0,r).join("").replace(/ +$/,"")}\u2026`}function Yn(t,e){let n=t.trim();n!==""&&Mp.set(n,e)}function Ap(t,e,n=new Date){let[r,...i]=t.trim().split(":"),o=Mp.get(r.trim());return o?o(e,i.join(":").trim(),n):null}function YC(t,e){var i,o;let n=(i=t.getAttribute(kp))!=null?i:"",r=e.value;t.textContent=r===""?"":(o=Ap(n,r))!=null?o:r}function Ha(t=document){let e=[],n=Array.from(t.querySelectorAll(`[${kp}]`));for(let r of n){if(r.hasAttribute(Pa))continue;let i=r.getAttribute(_C),o=i?r.ownerDocument.getElementById(i):null;if(!(o instanceof HTMLInputElement)&&!(o instanceof HTMLTextAreaElement)&&!(o instanceof HTMLSelectElement))continue;let s=()=>YC(r,o);o.addEventListener("input",s),o.addEventListener("change",s),r.setAttribute(Pa,"true"),e.push(()=>{o.removeEventListener("input",s),o.removeEventListener("change",s),r.removeAttribute(Pa)})}return()=>{for(let r of e)r()}}Yn("relativeDate",qC);Yn("date",wp);Yn("dateTime",KC);Yn("bytes",JC);Yn("truncate",GC);var QC="data-formgen-section-nav",XC="data-formgen-section-link",Fa="data-section-nav-bound";function ZC(t){var r;let e=[],n=Array.from(t.querySelectorAll(`a[${XC}]`));for(let i of n){let o=(r=i.getAttribute("href"))!=null?r:"";if(!o.startsWith("#"))continue;let s=t.ownerDocument.getElementById(decodeURIComponent(o.slice(1)));s&&e.push({link:i,section:s})}return e}function eE(t,e=96){let n=0;return t.forEach((r,i)=>{r<=e&&(n=i)}),n}function Rp(t){let e=eE(t.map(n=>n.section.getBoundingClientRect().top));t.forEach((n,r)=>{r===e?n.link.setAttribute("aria-current","true"):n.link.removeAttribute("aria-current")})}function Ba(t=document){let e=[],n=Array.from(t.querySelectorAll(`[${QC}]`));for(let r of n){if(r.hasAttribute(Fa))continue;let i=ZC(r),o=r.ownerDocument.defaultView;if(i.length===0||!o)continue;let s=0,a=()=>{s===0&&(s=o.requestAnimationFrame(()=>{s=0,Rp(i)}))};o.addEventListener("scroll",a,{passive:!0}),o.addEventListener("resize",a),r.setAttribute(Fa,"true"),Rp(i),e.push(()=>{o.removeEventListener("scroll",a),o.removeEventListener("resize",a),s!==0&&o.cancelAnimationFrame(s),r.removeAttribute(Fa)})}return()=>{for(let r of e)r()}}var Op="formgen-config";function za(t=sE()){var i;let e=t==null?void 0:t.getElementById(Op),n=(i=e==null?void 0:e.textContent)==null?void 0:i.trim();if(!n)return{};let r;try{r=JSON.parse(n)}catch(o){return console.warn("[formgen] ignoring malformed #formgen-config block",o),{}}return!r||typeof r!="object"||Array.isArray(r)?{}:tE(r)}function $a(t,e={}){let n={...t,...e};return t.cache&&e.cache&&(n.cache={...t.cache,...e.cache}),n}function tE(t){let e={};typeof t.baseUrl=="string"&&t.baseUrl.trim()!==""&&(e.baseUrl=t.baseUrl.trim());let n=nE(t.cache);n&&(e.cache=n);let r=rE(t.auth);if(r&&(e.auth=r),Va(t.searchDebounceMs)&&(e.searchDebounceMs=t.searchDebounceMs),Va(t.searchThrottleMs)&&(e.searchThrottleMs=t.searchThrottleMs),Array.isArray(t.links)){let i=t.links.filter(oE);i.length>0&&(e.links=i)}if(Array.isArray(t.hooks))for(let i of t.hooks)iE(e,i);return e}function nE(t){if(!t||typeof t!="object")return;let e=t,n={};return(e.strategy==="memory"||e.strategy==="none")&&(n.strategy=e.strategy),Va(e.ttlMs)&&e.ttlMs>0&&(n.ttlMs=e.ttlMs),Object.keys(n).length>0?n:void 0}function rE(t){if(!t||typeof t!="object")return;let e=t,n={};(e.strategy==="header"||e.strategy==="cookie")&&(n.strategy=e.strategy);for(let r of["header","source","prefix"]){let i=e[r];typeof i=="string"&&i.trim()!==""&&(n[r]=i.trim())}return Object.keys(n).length>0?n:void 0}function iE(t,e){switch(e){case"validationError":t.onValidationError=(n,r)=>{var o,s;((s=(o=n.config.logger)==null?void 0:o.warn)!=null?s:console.warn)("[formgen] validation failed",n.field.name,r.message)};break;case"error":t.onError=(n,r)=>{var o,s;((s=(o=n.config.logger)==null?void 0:o.error)!=null?s:console.error)("[formgen] relationship fetch failed",n.field.name,r.message)};break}}function oE(t){if(!t||typeof t!="object")return!1;let e=t;return typeof e.from=="string"&&typeof e.to=="string"}function Va(t){return typeof t=="number"&&Number.isFinite(t)&&t>=0}function sE(){return typeof document=="undefined"?null:document}function Qn(t){let{state:e,transaction:n}=t,{selection:r}=n,{doc:i}=n,{storedMarks:o}=n;return{...e,apply:e.apply.bind(e),applyTransaction:e.applyTransaction.bind(e),plugins:e.plugins,schema:e.schema,reconfigure:e.reconfigure.bind(e),toJSON:e.toJSON.bind(e),get storedMarks(){return o},get selection(){return r},get doc(){return i},get tr(){return r=n.selection,i=n.doc,o=n.storedMarks,n}}}var pn=class{constructor(e){H(this,"editor");H(this,"rawCommands");H(this,"customState");this.editor=e.editor,this.rawCommands=this.editor.extensionManager.commands,this.customState=e.state}get hasCustomState(){return!!this.customState}get state(){return this.customState||this.editor.state}get commands(){let{rawCommands:e,editor:n,state:r}=this,{view:i}=n,{tr:o}=r,s=this.buildProps(o);return Object.fromEntries(Object.entries(e).map(([a,l])=>[a,(...d)=>{let u=l(...d)(s);return!o.getMeta("preventDispatch")&&!this.hasCustomState&&i.dispatch(o),u}]))}get chain(){return()=>this.createChain()}get can(){return()=>this.createCan()}createChain(e,n=!0){let{rawCommands:r,editor:i,state:o}=this,{view:s}=i,a=[],l=!!e,c=e||o.tr,d=()=>(!l&&n&&!c.getMeta("preventDispatch")&&!this.hasCustomState&&s.dispatch(c),a.every(p=>p===!0)),u={...Object.fromEntries(Object.entries(r).map(([p,f])=>[p,(...m)=>{let g=this.buildProps(c,n),y=f(...m)(g);return a.push(y),u}])),run:d};return u}createCan(e){let{rawCommands:n,state:r}=this,i=!1,o=e||r.tr,s=this.buildProps(o,i);return{...Object.fromEntries(Object.entries(n).map(([l,c])=>[l,(...d)=>c(...d)({...s,dispatch:void 0})])),chain:()=>this.createChain(o,i)}}buildProps(e,n=!0){let{rawCommands:r,editor:i,state:o}=this,{view:s}=i,a={tr:e,editor:i,view:s,state:Qn({state:o,transaction:e}),dispatch:n?()=>{}:void 0,chain:()=>this.createChain(e,n),can:()=>this.createCan(e),get commands(){return Object.fromEntries(Object.entries(r).map(([l,c])=>[l,(...d)=>c(...d)(a)]))}};return a}};function he(t){this.content=t}he.prototype={constructor:he,find:function(t){for(var e=0;e<this.content.length;e+=2)if(this.content[e]===t)return e;return-1},get:function(t){var e=this.find(t);return e==-1?void 0:this.content[e+1]},update:function(t,e,n){var r=n&&n!=t?this.remove(n):this,i=r.find(t),o=r.content.slice();return i==-1?o.push(n||t,e):(o[i+1]=e,n&&(o[i]=n)),new he(o)},remove:function(t){var e=this.find(t);if(e==-1)return this;var n=this.content.slice();return n.splice(e,2),new he(n)},addToStart:function(t,e){return new he([t,e].concat(this.remove(t).content))},addToEnd:function(t,e){var n=this.remove(t).content.slice();return n.push(t,e),new he(n)},addBefore:function(t,e,n){var r=this.remove(e),i=r.content.slice(),o=r.find(t);return i.splice(o==-1?i.length:o,0,e,n),new he(i)},forEach:function(t){for(var e=0;e<this.content.length;e+=2)t(this.content[e],this.content[e+1])},prepend:function(t){return t=he.from(t),t.size?new he(t.content.concat(this.subtract(t).content)):this},append:function(t){return t=he.from(t),t.size?new he(this.subtract(t).content.concat(t.content)):this},subtract:function(t){var e=this;t=he.from(t);for(var n=0;n<t.content.length;n+=2)e=e.remove(t.content[n]);return e},toObject:function(){var t={};return this.forEach(function(e,n){t[e]=n}),t},get size(){return this.content.length>>1}};he.from=function(t){if(t instanceof he)return t;var e=[];if(t)for(var n in t)e.push(n,t[n]);return new he(e)};var Ua=he;function zp(t,e,n){for(let r=0;;r++){if(r==t.childCount||r==e.childCount)return t.childCount==e.childCount?null:n;let i=t.child(r),o=e.child(r);if(i==o){n+=i.nodeSize;continue}if(!i.sameMarkup(o))return n;if(i.isText&&i.text!=o.text){for(let s=0;i.text[s]==o.text[s];s++)n++;return n}if(i.content.size||o.content.size){let s=zp(i.content,o.content,n+1);if(s!=null)return s}n+=i.nodeSize}}function $p(t,e,n,r){for(let i=t.childCount,o=e.childCount;;){if(i==0||o==0)return i==o?null:{a:n,b:r};let s=t.child(--i),a=e.child(--o),l=s.nodeSize;if(s==a){n-=l,r-=l;continue}if(!s.sameMarkup(a))return{a:n,b:r};if(s.isText&&s.text!=a.text){let c=0,d=Math.min(s.text.length,a.text.length);for(;c<d&&s.text[s.text.length-c-1]==a.text[a.text.length-c-1];)c++,n--,r--;return{a:n,b:r}}if(s.content.size||a.content.size){let c=$p(s.content,a.content,n-1,r-1);if(c)return c}n-=l,r-=l}}var E=class t{constructor(e,n){if(this.content=e,this.size=n||0,n==null)for(let r=0;r<e.length;r++)this.size+=e[r].nodeSize}nodesBetween(e,n,r,i=0,o){for(let s=0,a=0;a<n;s++){let l=this.content[s],c=a+l.nodeSize;if(c>e&&r(l,i+a,o||null,s)!==!1&&l.content.size){let d=a+1;l.nodesBetween(Math.max(0,e-d),Math.min(l.content.size,n-d),r,i+d)}a=c}}descendants(e){this.nodesBetween(0,this.size,e)}textBetween(e,n,r,i){let o="",s=!0;return this.nodesBetween(e,n,(a,l)=>{let c=a.isText?a.text.slice(Math.max(e,l)-l,n-l):a.isLeaf?i?typeof i=="function"?i(a):i:a.type.spec.leafText?a.type.spec.leafText(a):"":"";a.isBlock&&(a.isLeaf&&c||a.isTextblock)&&r&&(s?s=!1:o+=r),o+=c},0),o}append(e){if(!e.size)return this;if(!this.size)return e;let n=this.lastChild,r=e.firstChild,i=this.content.slice(),o=0;for(n.isText&&n.sameMarkup(r)&&(i[i.length-1]=n.withText(n.text+r.text),o=1);o<e.content.length;o++)i.push(e.content[o]);return new t(i,this.size+e.size)}cut(e,n=this.size){if(e==0&&n==this.size)return this;let r=[],i=0;if(n>e)for(let o=0,s=0;s<n;o++){let a=this.content[o],l=s+a.nodeSize;l>e&&((s<e||l>n)&&(a.isText?a=a.cut(Math.max(0,e-s),Math.min(a.text.length,n-s)):a=a.cut(Math.max(0,e-s-1),Math.min(a.content.size,n-s-1))),r.push(a),i+=a.nodeSize),s=l}return new t(r,i)}cutByIndex(e,n){return e==n?t.empty:e==0&&n==this.content.length?this:new t(this.content.slice(e,n))}replaceChild(e,n){let r=this.content[e];if(r==n)return this;let i=this.content.slice(),o=this.size+n.nodeSize-r.nodeSize;return i[e]=n,new t(i,o)}addToStart(e){return new t([e].concat(this.content),this.size+e.nodeSize)}addToEnd(e){return new t(this.content.concat(e),this.size+e.nodeSize)}eq(e){if(this.content.length!=e.content.length)return!1;for(let n=0;n<this.content.length;n++)if(!this.content[n].eq(e.content[n]))return!1;return!0}get firstChild(){return this.content.length?this.content[0]:null}get lastChild(){return this.content.length?this.content[this.content.length-1]:null}get childCount(){return this.content.length}child(e){let n=this.content[e];if(!n)throw new RangeError("Index "+e+" out of range for "+this);return n}maybeChild(e){return this.content[e]||null}forEach(e){for(let n=0,r=0;n<this.content.length;n++){let i=this.content[n];e(i,r,n),r+=i.nodeSize}}findDiffStart(e,n=0){return zp(this,e,n)}findDiffEnd(e,n=this.size,r=e.size){return $p(this,e,n,r)}findIndex(e){if(e==0)return uo(0,e);if(e==this.size)return uo(this.content.length,e);if(e>this.size||e<0)throw new RangeError(`Position ${e} outside of fragment (${this})`);for(let n=0,r=0;;n++){let i=this.child(n),o=r+i.nodeSize;if(o>=e)return o==e?uo(n+1,o):uo(n,r);r=o}}toString(){return"<"+this.toStringInner()+">"}toStringInner(){return this.content.join(", ")}toJSON(){return this.content.length?this.content.map(e=>e.toJSON()):null}static fromJSON(e,n){if(!n)return t.empty;if(!Array.isArray(n))throw new RangeError("Invalid input for Fragment.fromJSON");return new t(n.map(e.nodeFromJSON))}static fromArray(e){if(!e.length)return t.empty;let n,r=0;for(let i=0;i<e.length;i++){let o=e[i];r+=o.nodeSize,i&&o.isText&&e[i-1].sameMarkup(o)?(n||(n=e.slice(0,i)),n[n.length-1]=o.withText(n[n.length-1].text+o.text)):n&&n.push(o)}return new t(n||e,r)}static from(e){if(!e)return t.empty;if(e instanceof t)return e;if(Array.isArray(e))return this.fromArray(e);if(e.attrs)return new t([e],e.nodeSize);throw new RangeError("Can not convert "+e+" to a Fragment"+(e.nodesBetween?" (looks like multiple versions of prosemirror-model were loaded)":""))}};E.empty=new E([],0);var _a={index:0,offset:0};function uo(t,e){return _a.index=t,_a.offset=e,_a}function fo(t,e){if(t===e)return!0;if(!(t&&typeof t=="object")||!(e&&typeof e=="object"))return!1;let n=Array.isArray(t);if(Array.isArray(e)!=n)return!1;if(n){if(t.length!=e.length)return!1;for(let r=0;r<t.length;r++)if(!fo(t[r],e[r]))return!1}else{for(let r in t)if(!(r in e)||!fo(t[r],e[r]))return!1;for(let r in e)if(!(r in t))return!1}return!0}var W=class t{constructor(e,n){this.type=e,this.attrs=n}addToSet(e){let n,r=!1;for(let i=0;i<e.length;i++){let o=e[i];if(this.eq(o))return e;if(this.type.excludes(o.type))n||(n=e.slice(0,i));else{if(o.type.excludes(this.type))return e;!r&&o.type.rank>this.type.rank&&(n||(n=e.slice(0,i)),n.push(this),r=!0),n&&n.push(o)}}return n||(n=e.slice()),r||n.push(this),n}removeFromSet(e){for(let n=0;n<e.length;n++)if(this.eq(e[n]))return e.slice(0,n).concat(e.slice(n+1));return e}isInSet(e){for(let n=0;n<e.length;n++)if(this.eq(e[n]))return!0;return!1}eq(e){return this==e||this.type==e.type&&fo(this.attrs,e.attrs)}toJSON(){let e={type:this.type.name};for(let n in this.attrs){e.attrs=this.attrs;break}return e}static fromJSON(e,n){if(!n)throw new RangeError("Invalid input for Mark.fromJSON");let r=e.marks[n.type];if(!r)throw new RangeError(`There is no mark type ${n.type} in this schema`);let i=r.create(n.attrs);return r.checkAttrs(i.attrs),i}static sameSet(e,n){if(e==n)return!0;if(e.length!=n.length)return!1;for(let r=0;r<e.length;r++)if(!e[r].eq(n[r]))return!1;return!0}static setFrom(e){if(!e||Array.isArray(e)&&e.length==0)return t.none;if(e instanceof t)return[e];let n=e.slice();return n.sort((r,i)=>r.type.rank-i.type.rank),n}};W.none=[];var mn=class extends Error{},k=class t{constructor(e,n,r){this.content=e,this.openStart=n,this.openEnd=r}get size(){return this.content.size-this.openStart-this.openEnd}insertAt(e,n){let r=_p(this.content,e+this.openStart,n);return r&&new t(r,this.openStart,this.openEnd)}removeBetween(e,n){return new t(Up(this.content,e+this.openStart,n+this.openStart),this.openStart,this.openEnd)}eq(e){return this.content.eq(e.content)&&this.openStart==e.openStart&&this.openEnd==e.openEnd}toString(){return this.content+"("+this.openStart+","+this.openEnd+")"}toJSON(){if(!this.content.size)return null;let e={content:this.content.toJSON()};return this.openStart>0&&(e.openStart=this.openStart),this.openEnd>0&&(e.openEnd=this.openEnd),e}static fromJSON(e,n){if(!n)return t.empty;let r=n.openStart||0,i=n.openEnd||0;if(typeof r!="number"||typeof i!="number")throw new RangeError("Invalid input for Slice.fromJSON");return new t(E.fromJSON(e,n.content),r,i)}static maxOpen(e,n=!0){let r=0,i=0;for(let o=e.firstChild;o&&!o.isLeaf&&(n||!o.type.spec.isolating);o=o.firstChild)r++;for(let o=e.lastChild;o&&!o.isLeaf&&(n||!o.type.spec.isolating);o=o.lastChild)i++;return new t(e,r,i)}};k.empty=new k(E.empty,0,0);function Up(t,e,n){let{index:r,offset:i}=t.findIndex(e),o=t.maybeChild(r),{index:s,offset:a}=t.findIndex(n);if(i==e||o.isText){if(a!=n&&!t.child(s).isText)throw new RangeError("Removing non-flat range");return t.cut(0,e).append(t.cut(n))}if(r!=s)throw new RangeError("Removing non-flat range");return t.replaceChild(r,o.copy(Up(o.content,e-i-1,n-i-1)))}function _p(t,e,n,r){let{index:i,offset:o}=t.findIndex(e),s=t.maybeChild(i);if(o==e||s.isText)return r&&!r.canReplace(i,i,n)?null:t.cut(0,e).append(n).append(t.cut(e));let a=_p(s.content,e-o-1,n,s);return a&&t.replaceChild(i,s.copy(a))}function aE(t,e,n){if(n.openStart>t.depth)throw new mn("Inserted content deeper than insertion position");if(t.depth-n.openStart!=e.depth-n.openEnd)throw new mn("Inconsistent open depths");return jp(t,e,n,0)}function jp(t,e,n,r){let i=t.index(r),o=t.node(r);if(i==e.index(r)&&r<t.depth-n.openStart){let s=jp(t,e,n,r+1);return o.copy(o.content.replaceChild(i,s))}else if(n.content.size)if(!n.openStart&&!n.openEnd&&t.depth==r&&e.depth==r){let s=t.parent,a=s.content;return hn(s,a.cut(0,t.parentOffset).append(n.content).append(a.cut(e.parentOffset)))}else{let{start:s,end:a}=lE(n,t);return hn(o,qp(t,s,a,e,r))}else return hn(o,ho(t,e,r))}function Wp(t,e){if(!e.type.compatibleContent(t.type))throw new mn("Cannot join "+e.type.name+" onto "+t.type.name)}function Wa(t,e,n){let r=t.node(n);return Wp(r,e.node(n)),r}function fn(t,e){let n=e.length-1;n>=0&&t.isText&&t.sameMarkup(e[n])?e[n]=t.withText(e[n].text+t.text):e.push(t)}function Vr(t,e,n,r){let i=(e||t).node(n),o=0,s=e?e.index(n):i.childCount;t&&(o=t.index(n),t.depth>n?o++:t.textOffset&&(fn(t.nodeAfter,r),o++));for(let a=o;a<s;a++)fn(i.child(a),r);e&&e.depth==n&&e.textOffset&&fn(e.nodeBefore,r)}function hn(t,e){return t.type.checkContent(e),t.copy(e)}function qp(t,e,n,r,i){let o=t.depth>i&&Wa(t,e,i+1),s=r.depth>i&&Wa(n,r,i+1),a=[];return Vr(null,t,i,a),o&&s&&e.index(i)==n.index(i)?(Wp(o,s),fn(hn(o,qp(t,e,n,r,i+1)),a)):(o&&fn(hn(o,ho(t,e,i+1)),a),Vr(e,n,i,a),s&&fn(hn(s,ho(n,r,i+1)),a)),Vr(r,null,i,a),new E(a)}function ho(t,e,n){let r=[];if(Vr(null,t,n,r),t.depth>n){let i=Wa(t,e,n+1);fn(hn(i,ho(t,e,n+1)),r)}return Vr(e,null,n,r),new E(r)}function lE(t,e){let n=e.depth-t.openStart,i=e.node(n).copy(t.content);for(let o=n-1;o>=0;o--)i=e.node(o).copy(E.from(i));return{start:i.resolveNoCache(t.openStart+n),end:i.resolveNoCache(i.content.size-t.openEnd-n)}}var mo=class t{constructor(e,n,r){this.pos=e,this.path=n,this.parentOffset=r,this.depth=n.length/3-1}resolveDepth(e){return e==null?this.depth:e<0?this.depth+e:e}get parent(){return this.node(this.depth)}get doc(){return this.node(0)}node(e){return this.path[this.resolveDepth(e)*3]}index(e){return this.path[this.resolveDepth(e)*3+1]}indexAfter(e){return e=this.resolveDepth(e),this.index(e)+(e==this.depth&&!this.textOffset?0:1)}start(e){return e=this.resolveDepth(e),e==0?0:this.path[e*3-1]+1}end(e){return e=this.resolveDepth(e),this.start(e)+this.node(e).content.size}before(e){if(e=this.resolveDepth(e),!e)throw new RangeError("There is no position before the top-level node");return e==this.depth+1?this.pos:this.path[e*3-1]}after(e){if(e=this.resolveDepth(e),!e)throw new RangeError("There is no position after the top-level node");return e==this.depth+1?this.pos:this.path[e*3-1]+this.path[e*3].nodeSize}get textOffset(){return this.pos-this.path[this.path.length-1]}get nodeAfter(){let e=this.parent,n=this.index(this.depth);if(n==e.childCount)return null;let r=this.pos-this.path[this.path.length-1],i=e.child(n);return r?e.child(n).cut(r):i}get nodeBefore(){let e=this.index(this.depth),n=this.pos-this.path[this.path.length-1];return n?this.parent.child(e).cut(0,n):e==0?null:this.parent.child(e-1)}posAtIndex(e,n){n=this.resolveDepth(n);let r=this.path[n*3],i=n==0?0:this.path[n*3-1]+1;for(let o=0;o<e;o++)i+=r.child(o).nodeSize;return i}marks(){let e=this.parent,n=this.index();if(e.content.size==0)return W.none;if(this.textOffset)return e.child(n).marks;let r=e.maybeChild(n-1),i=e.maybeChild(n);if(!r){let a=r;r=i,i=a}let o=r.marks;for(var s=0;s<o.length;s++)o[s].type.spec.inclusive===!1&&(!i||!o[s].isInSet(i.marks))&&(o=o[s--].removeFromSet(o));return o}marksAcross(e){let n=this.parent.maybeChild(this.index());if(!n||!n.isInline)return null;let r=n.marks,i=e.parent.maybeChild(e.index());for(var o=0;o<r.length;o++)r[o].type.spec.inclusive===!1&&(!i||!r[o].isInSet(i.marks))&&(r=r[o--].removeFromSet(r));return r}sharedDepth(e){for(let n=this.depth;n>0;n--)if(this.start(n)<=e&&this.end(n)>=e)return n;return 0}blockRange(e=this,n){if(e.pos<this.pos)return e.blockRange(this);for(let r=this.depth-(this.parent.inlineContent||this.pos==e.pos?1:0);r>=0;r--)if(e.pos<=this.end(r)&&(!n||n(this.node(r))))return new gn(this,e,r);return null}sameParent(e){return this.pos-this.parentOffset==e.pos-e.parentOffset}max(e){return e.pos>this.pos?e:this}min(e){return e.pos<this.pos?e:this}toString(){let e="";for(let n=1;n<=this.depth;n++)e+=(e?"/":"")+this.node(n).type.name+"_"+this.index(n-1);return e+":"+this.parentOffset}static resolve(e,n){if(!(n>=0&&n<=e.content.size))throw new RangeError("Position "+n+" out of range");let r=[],i=0,o=n;for(let s=e;;){let{index:a,offset:l}=s.content.findIndex(o),c=o-l;if(r.push(s,a,i+l),!c||(s=s.child(a),s.isText))break;o=c-1,i+=l+1}return new t(n,r,o)}static resolveCached(e,n){let r=Lp.get(e);if(r)for(let o=0;o<r.elts.length;o++){let s=r.elts[o];if(s.pos==n)return s}else Lp.set(e,r=new qa);let i=r.elts[r.i]=t.resolve(e,n);return r.i=(r.i+1)%cE,i}},qa=class{constructor(){this.elts=[],this.i=0}},cE=12,Lp=new WeakMap,gn=class{constructor(e,n,r){this.$from=e,this.$to=n,this.depth=r}get start(){return this.$from.before(this.depth+1)}get end(){return this.$to.after(this.depth+1)}get parent(){return this.$from.node(this.depth)}get startIndex(){return this.$from.index(this.depth)}get endIndex(){return this.$to.indexAfter(this.depth)}},dE=Object.create(null),Be=class t{constructor(e,n,r,i=W.none){this.type=e,this.attrs=n,this.marks=i,this.content=r||E.empty}get children(){return this.content.content}get nodeSize(){return this.isLeaf?1:2+this.content.size}get childCount(){return this.content.childCount}child(e){return this.content.child(e)}maybeChild(e){return this.content.maybeChild(e)}forEach(e){this.content.forEach(e)}nodesBetween(e,n,r,i=0){this.content.nodesBetween(e,n,r,i,this)}descendants(e){this.nodesBetween(0,this.content.size,e)}get textContent(){return this.isLeaf&&this.type.spec.leafText?this.type.spec.leafText(this):this.textBetween(0,this.content.size,"")}textBetween(e,n,r,i){return this.content.textBetween(e,n,r,i)}get firstChild(){return this.content.firstChild}get lastChild(){return this.content.lastChild}eq(e){return this==e||this.sameMarkup(e)&&this.content.eq(e.content)}sameMarkup(e){return this.hasMarkup(e.type,e.attrs,e.marks)}hasMarkup(e,n,r){return this.type==e&&fo(this.attrs,n||e.defaultAttrs||dE)&&W.sameSet(this.marks,r||W.none)}copy(e=null){return e==this.content?this:new t(this.type,this.attrs,e,this.marks)}mark(e){return e==this.marks?this:new t(this.type,this.attrs,this.content,e)}cut(e,n=this.content.size){return e==0&&n==this.content.size?this:this.copy(this.content.cut(e,n))}slice(e,n=this.content.size,r=!1){if(e==n)return k.empty;let i=this.resolve(e),o=this.resolve(n),s=r?0:i.sharedDepth(n),a=i.start(s),c=i.node(s).content.cut(i.pos-a,o.pos-a);return new k(c,i.depth-s,o.depth-s)}replace(e,n,r){return aE(this.resolve(e),this.resolve(n),r)}nodeAt(e){for(let n=this;;){let{index:r,offset:i}=n.content.findIndex(e);if(n=n.maybeChild(r),!n)return null;if(i==e||n.isText)return n;e-=i+1}}childAfter(e){let{index:n,offset:r}=this.content.findIndex(e);return{node:this.content.maybeChild(n),index:n,offset:r}}childBefore(e){if(e==0)return{node:null,index:0,offset:0};let{index:n,offset:r}=this.content.findIndex(e);if(r<e)return{node:this.content.child(n),index:n,offset:r};let i=this.content.child(n-1);return{node:i,index:n-1,offset:r-i.nodeSize}}resolve(e){return mo.resolveCached(this,e)}resolveNoCache(e){return mo.resolve(this,e)}rangeHasMark(e,n,r){let i=!1;return n>e&&this.nodesBetween(e,n,o=>(r.isInSet(o.marks)&&(i=!0),!i)),i}get isBlock(){return this.type.isBlock}get isTextblock(){return this.type.isTextblock}get inlineContent(){return this.type.inlineContent}get isInline(){return this.type.isInline}get isText(){return this.type.isText}get isLeaf(){return this.type.isLeaf}get isAtom(){return this.type.isAtom}toString(){if(this.type.spec.toDebugString)return this.type.spec.toDebugString(this);let e=this.type.name;return this.content.size&&(e+="("+this.content.toStringInner()+")"),Kp(this.marks,e)}contentMatchAt(e){let n=this.type.contentMatch.matchFragment(this.content,0,e);if(!n)throw new Error("Called contentMatchAt on a node with invalid content");return n}canReplace(e,n,r=E.empty,i=0,o=r.childCount){let s=this.contentMatchAt(e).matchFragment(r,i,o),a=s&&s.matchFragment(this.content,n);if(!a||!a.validEnd)return!1;for(let l=i;l<o;l++)if(!this.type.allowsMarks(r.child(l).marks))return!1;return!0}canReplaceWith(e,n,r,i){if(i&&!this.type.allowsMarks(i))return!1;let o=this.contentMatchAt(e).matchType(r),s=o&&o.matchFragment(this.content,n);return s?s.validEnd:!1}canAppend(e){return e.content.size?this.canReplace(this.childCount,this.childCount,e.content):this.type.compatibleContent(e.type)}check(){this.type.checkContent(this.content),this.type.checkAttrs(this.attrs);let e=W.none;for(let n=0;n<this.marks.length;n++){let r=this.marks[n];r.type.checkAttrs(r.attrs),e=r.addToSet(e)}if(!W.sameSet(e,this.marks))throw new RangeError(`Invalid collection of marks for node ${this.type.name}: ${this.marks.map(n=>n.type.name)}`);this.content.forEach(n=>n.check())}toJSON(){let e={type:this.type.name};for(let n in this.attrs){e.attrs=this.attrs;break}return this.content.size&&(e.content=this.content.toJSON()),this.marks.length&&(e.marks=this.marks.map(n=>n.toJSON())),e}static fromJSON(e,n){if(!n)throw new RangeError("Invalid input for Node.fromJSON");let r;if(n.marks){if(!Array.isArray(n.marks))throw new RangeError("Invalid mark data for Node.fromJSON");r=n.marks.map(e.markFromJSON)}if(n.type=="text"){if(typeof n.text!="string")throw new RangeError("Invalid text node in JSON");return e.text(n.text,r)}let i=E.fromJSON(e,n.content),o=e.nodeType(n.type).create(n.attrs,i,r);return o.type.checkAttrs(o.attrs),o}};Be.prototype.text=void 0;var Ka=class t extends Be{constructor(e,n,r,i){if(super(e,n,null,i),!r)throw new RangeError("Empty text nodes are not allowed");this.text=r}toString(){return this.type.spec.toDebugString?this.type.spec.toDebugString(this):Kp(this.marks,JSON.stringify(this.text))}get textContent(){return this.text}textBetween(e,n){return this.text.slice(e,n)}get nodeSize(){return this.text.length}mark(e){return e==this.marks?this:new t(this.type,this.attrs,this.text,e)}withText(e){return e==this.text?this:new t(this.type,this.attrs,e,this.marks)}cut(e=0,n=this.text.length){return e==0&&n==this.text.length?this:this.withText(this.text.slice(e,n))}eq(e){return this.sameMarkup(e)&&this.text==e.text}toJSON(){let e=super.toJSON();return e.text=this.text,e}};function Kp(t,e){for(let n=t.length-1;n>=0;n--)e=t[n].type.name+"("+e+")";return e}var yn=class t{constructor(e){this.validEnd=e,this.next=[],this.wrapCache=[]}static parse(e,n){let r=new Ja(e,n);if(r.next==null)return t.empty;let i=Jp(r);r.next&&r.err("Unexpected trailing text");let o=yE(gE(i));return bE(o,r),o}matchType(e){for(let n=0;n<this.next.length;n++)if(this.next[n].type==e)return this.next[n].next;return null}matchFragment(e,n=0,r=e.childCount){let i=this;for(let o=n;i&&o<r;o++)i=i.matchType(e.child(o).type);return i}get inlineContent(){return this.next.length!=0&&this.next[0].type.isInline}get defaultType(){for(let e=0;e<this.next.length;e++){let{type:n}=this.next[e];if(!(n.isText||n.hasRequiredAttrs()))return n}return null}compatible(e){for(let n=0;n<this.next.length;n++)for(let r=0;r<e.next.length;r++)if(this.next[n].type==e.next[r].type)return!0;return!1}fillBefore(e,n=!1,r=0){let i=[this];function o(s,a){let l=s.matchFragment(e,r);if(l&&(!n||l.validEnd))return E.from(a.map(c=>c.createAndFill()));for(let c=0;c<s.next.length;c++){let{type:d,next:u}=s.next[c];if(!(d.isText||d.hasRequiredAttrs())&&i.indexOf(u)==-1){i.push(u);let p=o(u,a.concat(d));if(p)return p}}return null}return o(this,[])}findWrapping(e){for(let r=0;r<this.wrapCache.length;r+=2)if(this.wrapCache[r]==e)return this.wrapCache[r+1];let n=this.computeWrapping(e);return this.wrapCache.push(e,n),n}computeWrapping(e){let n=Object.create(null),r=[{match:this,type:null,via:null}];for(;r.length;){let i=r.shift(),o=i.match;if(o.matchType(e)){let s=[];for(let a=i;a.type;a=a.via)s.push(a.type);return s.reverse()}for(let s=0;s<o.next.length;s++){let{type:a,next:l}=o.next[s];!a.isLeaf&&!a.hasRequiredAttrs()&&!(a.name in n)&&(!i.type||l.validEnd)&&(r.push({match:a.contentMatch,type:a,via:i}),n[a.name]=!0)}}return null}get edgeCount(){return this.next.length}edge(e){if(e>=this.next.length)throw new RangeError(`There's no ${e}th edge in this content match`);return this.next[e]}toString(){let e=[];function n(r){e.push(r);for(let i=0;i<r.next.length;i++)e.indexOf(r.next[i].next)==-1&&n(r.next[i].next)}return n(this),e.map((r,i)=>{let o=i+(r.validEnd?"*":" ")+" ";for(let s=0;s<r.next.length;s++)o+=(s?", ":"")+r.next[s].type.name+"->"+e.indexOf(r.next[s].next);return o}).join(`
+`)}};yn.empty=new yn(!0);var Ja=class{constructor(e,n){this.string=e,this.nodeTypes=n,this.inline=null,this.pos=0,this.tokens=e.split(/\s*(?=\b|\W|$)/),this.tokens[this.tokens.length-1]==""&&this.tokens.pop(),this.tokens[0]==""&&this.tokens.shift()}get next(){return this.tokens[this.pos]}eat(e){return this.next==e&&(this.pos++||!0)}err(e){throw new SyntaxError(e+" (in content expression '"+this.string+"')")}};function Jp(t){let e=[];do e.push(uE(t));while(t.eat("|"));return e.length==1?e[0]:{type:"choice",exprs:e}}function uE(t){let e=[];do e.push(pE(t));while(t.next&&t.next!=")"&&t.next!="|");return e.length==1?e[0]:{type:"seq",exprs:e}}function pE(t){let e=mE(t);for(;;)if(t.eat("+"))e={type:"plus",expr:e};else if(t.eat("*"))e={type:"star",expr:e};else if(t.eat("?"))e={type:"opt",expr:e};else if(t.eat("{"))e=fE(t,e);else break;return e}function Np(t){/\D/.test(t.next)&&t.err("Expected number, got '"+t.next+"'");let e=Number(t.next);return t.pos++,e}function fE(t,e){let n=Np(t),r=n;return t.eat(",")&&(t.next!="}"?r=Np(t):r=-1),t.eat("}")||t.err("Unclosed braced range"),{type:"range",min:n,max:r,expr:e}}function hE(t,e){let n=t.nodeTypes,r=n[e];if(r)return[r];let i=[];for(let o in n){let s=n[o];s.isInGroup(e)&&i.push(s)}return i.length==0&&t.err("No node type or group '"+e+"' found"),i}function mE(t){if(t.eat("(")){let e=Jp(t);return t.eat(")")||t.err("Missing closing paren"),e}else if(/\W/.test(t.next))t.err("Unexpected token '"+t.next+"'");else{let e=hE(t,t.next).map(n=>(t.inline==null?t.inline=n.isInline:t.inline!=n.isInline&&t.err("Mixing inline and block content"),{type:"name",value:n}));return t.pos++,e.length==1?e[0]:{type:"choice",exprs:e}}}function gE(t){let e=[[]];return i(o(t,0),n()),e;function n(){return e.push([])-1}function r(s,a,l){let c={term:l,to:a};return e[s].push(c),c}function i(s,a){s.forEach(l=>l.to=a)}function o(s,a){if(s.type=="choice")return s.exprs.reduce((l,c)=>l.concat(o(c,a)),[]);if(s.type=="seq")for(let l=0;;l++){let c=o(s.exprs[l],a);if(l==s.exprs.length-1)return c;i(c,a=n())}else if(s.type=="star"){let l=n();return r(a,l),i(o(s.expr,l),l),[r(l)]}else if(s.type=="plus"){let l=n();return i(o(s.expr,a),l),i(o(s.expr,l),l),[r(l)]}else{if(s.type=="opt")return[r(a)].concat(o(s.expr,a));if(s.type=="range"){let l=a;for(let c=0;c<s.min;c++){let d=n();i(o(s.expr,l),d),l=d}if(s.max==-1)i(o(s.expr,l),l);else for(let c=s.min;c<s.max;c++){let d=n();r(l,d),i(o(s.expr,l),d),l=d}return[r(l)]}else{if(s.type=="name")return[r(a,void 0,s.value)];throw new Error("Unknown expr type")}}}}function Gp(t,e){return e-t}function Ip(t,e){let n=[];return r(e),n.sort(Gp);function r(i){let o=t[i];if(o.length==1&&!o[0].term)return r(o[0].to);n.push(i);for(let s=0;s<o.length;s++){let{term:a,to:l}=o[s];!a&&n.indexOf(l)==-1&&r(l)}}}function yE(t){let e=Object.create(null);return n(Ip(t,0));function n(r){let i=[];r.forEach(s=>{t[s].forEach(({term:a,to:l})=>{if(!a)return;let c;for(let d=0;d<i.length;d++)i[d][0]==a&&(c=i[d][1]);Ip(t,l).forEach(d=>{c||i.push([a,c=[]]),c.indexOf(d)==-1&&c.push(d)})})});let o=e[r.join(",")]=new yn(r.indexOf(t.length-1)>-1);for(let s=0;s<i.length;s++){let a=i[s][1].sort(Gp);o.next.push({type:i[s][0],next:e[a.join(",")]||n(a)})}return o}}function bE(t,e){for(let n=0,r=[t];n<r.length;n++){let i=r[n],o=!i.validEnd,s=[];for(let a=0;a<i.next.length;a++){let{type:l,next:c}=i.next[a];s.push(l.name),o&&!(l.isText||l.hasRequiredAttrs())&&(o=!1),r.indexOf(c)==-1&&r.push(c)}o&&e.err("Only non-generatable nodes ("+s.join(", ")+") in a required position (see https://prosemirror.net/docs/guide/#generatable)")}}function Yp(t){let e=Object.create(null);for(let n in t){let r=t[n];if(!r.hasDefault)return null;e[n]=r.default}return e}function Qp(t,e){let n=Object.create(null);for(let r in t){let i=e&&e[r];if(i===void 0){let o=t[r];if(o.hasDefault)i=o.default;else throw new RangeError("No value supplied for attribute "+r)}n[r]=i}return n}function Xp(t,e,n,r){for(let i in e)if(!(i in t))throw new RangeError(`Unsupported attribute ${i} for ${n} of type ${i}`);for(let i in t){let o=t[i];o.validate&&o.validate(e[i])}}function Zp(t,e){let n=Object.create(null);if(e)for(let r in e)n[r]=new Ga(t,r,e[r]);return n}var go=class t{constructor(e,n,r){this.name=e,this.schema=n,this.spec=r,this.markSet=null,this.groups=r.group?r.group.split(" "):[],this.attrs=Zp(e,r.attrs),this.defaultAttrs=Yp(this.attrs),this.contentMatch=null,this.inlineContent=null,this.isBlock=!(r.inline||e=="text"),this.isText=e=="text"}get isInline(){return!this.isBlock}get isTextblock(){return this.isBlock&&this.inlineContent}get isLeaf(){return this.contentMatch==yn.empty}get isAtom(){return this.isLeaf||!!this.spec.atom}isInGroup(e){return this.groups.indexOf(e)>-1}get whitespace(){return this.spec.whitespace||(this.spec.code?"pre":"normal")}hasRequiredAttrs(){for(let e in this.attrs)if(this.attrs[e].isRequired)return!0;return!1}compatibleContent(e){return this==e||this.contentMatch.compatible(e.contentMatch)}computeAttrs(e){return!e&&this.defaultAttrs?this.defaultAttrs:Qp(this.attrs,e)}create(e=null,n,r){if(this.isText)throw new Error("NodeType.create can't construct text nodes");return new Be(this,this.computeAttrs(e),E.from(n),W.setFrom(r))}createChecked(e=null,n,r){return n=E.from(n),this.checkContent(n),new Be(this,this.computeAttrs(e),n,W.setFrom(r))}createAndFill(e=null,n,r){if(e=this.computeAttrs(e),n=E.from(n),n.size){let s=this.contentMatch.fillBefore(n);if(!s)return null;n=s.append(n)}let i=this.contentMatch.matchFragment(n),o=i&&i.fillBefore(E.empty,!0);return o?new Be(this,e,n.append(o),W.setFrom(r)):null}validContent(e){let n=this.contentMatch.matchFragment(e);if(!n||!n.validEnd)return!1;for(let r=0;r<e.childCount;r++)if(!this.allowsMarks(e.child(r).marks))return!1;return!0}checkContent(e){if(!this.validContent(e))throw new RangeError(`Invalid content for node ${this.name}: ${e.toString().slice(0,50)}`)}checkAttrs(e){Xp(this.attrs,e,"node",this.name)}allowsMarkType(e){return this.markSet==null||this.markSet.indexOf(e)>-1}allowsMarks(e){if(this.markSet==null)return!0;for(let n=0;n<e.length;n++)if(!this.allowsMarkType(e[n].type))return!1;return!0}allowedMarks(e){if(this.markSet==null)return e;let n;for(let r=0;r<e.length;r++)this.allowsMarkType(e[r].type)?n&&n.push(e[r]):n||(n=e.slice(0,r));return n?n.length?n:W.none:e}static compile(e,n){let r=Object.create(null);e.forEach((o,s)=>r[o]=new t(o,n,s));let i=n.spec.topNode||"doc";if(!r[i])throw new RangeError("Schema is missing its top node type ('"+i+"')");if(!r.text)throw new RangeError("Every schema needs a 'text' type");for(let o in r.text.attrs)throw new RangeError("The text node type should not have attributes");return r}};function xE(t,e,n){let r=n.split("|");return i=>{let o=i===null?"null":typeof i;if(r.indexOf(o)<0)throw new RangeError(`Expected value of type ${r} for attribute ${e} on type ${t}, got ${o}`)}}var Ga=class{constructor(e,n,r){this.hasDefault=Object.prototype.hasOwnProperty.call(r,"default"),this.default=r.default,this.validate=typeof r.validate=="string"?xE(e,n,r.validate):r.validate}get isRequired(){return!this.hasDefault}},$r=class t{constructor(e,n,r,i){this.name=e,this.rank=n,this.schema=r,this.spec=i,this.attrs=Zp(e,i.attrs),this.excluded=null;let o=Yp(this.attrs);this.instance=o?new W(this,o):null}create(e=null){return!e&&this.instance?this.instance:new W(this,Qp(this.attrs,e))}static compile(e,n){let r=Object.create(null),i=0;return e.forEach((o,s)=>r[o]=new t(o,i++,n,s)),r}removeFromSet(e){for(var n=0;n<e.length;n++)e[n].type==this&&(e=e.slice(0,n).concat(e.slice(n+1)),n--);return e}isInSet(e){for(let n=0;n<e.length;n++)if(e[n].type==this)return e[n]}checkAttrs(e){Xp(this.attrs,e,"mark",this.name)}excludes(e){return this.excluded.indexOf(e)>-1}},Ur=class{constructor(e){this.linebreakReplacement=null,this.cached=Object.create(null);let n=this.spec={};for(let i in e)n[i]=e[i];n.nodes=Ua.from(e.nodes),n.marks=Ua.from(e.marks||{}),this.nodes=go.compile(this.spec.nodes,this),this.marks=$r.compile(this.spec.marks,this);let r=Object.create(null);for(let i in this.nodes){if(i in this.marks)throw new RangeError(i+" can not be both a node and a mark");let o=this.nodes[i],s=o.spec.content||"",a=o.spec.marks;if(o.contentMatch=r[s]||(r[s]=yn.parse(s,this.nodes)),o.inlineContent=o.contentMatch.inlineContent,o.spec.linebreakReplacement){if(this.linebreakReplacement)throw new RangeError("Multiple linebreak nodes defined");if(!o.isInline||!o.isLeaf)throw new RangeError("Linebreak replacement nodes must be inline leaf nodes");this.linebreakReplacement=o}o.markSet=a=="_"?null:a?Pp(this,a.split(" ")):a==""||!o.inlineContent?[]:null}for(let i in this.marks){let o=this.marks[i],s=o.spec.excludes;o.excluded=s==null?[o]:s==""?[]:Pp(this,s.split(" "))}this.nodeFromJSON=i=>Be.fromJSON(this,i),this.markFromJSON=i=>W.fromJSON(this,i),this.topNodeType=this.nodes[this.spec.topNode||"doc"],this.cached.wrappings=Object.create(null)}node(e,n=null,r,i){if(typeof e=="string")e=this.nodeType(e);else if(e instanceof go){if(e.schema!=this)throw new RangeError("Node type from different schema used ("+e.name+")")}else throw new RangeError("Invalid node type: "+e);return e.createChecked(n,r,i)}text(e,n){let r=this.nodes.text;return new Ka(r,r.defaultAttrs,e,W.setFrom(n))}mark(e,n){return typeof e=="string"&&(e=this.marks[e]),e.create(n)}nodeType(e){let n=this.nodes[e];if(!n)throw new RangeError("Unknown node type: "+e);return n}};function Pp(t,e){let n=[];for(let r=0;r<e.length;r++){let i=e[r],o=t.marks[i],s=o;if(o)n.push(o);else for(let a in t.marks){let l=t.marks[a];(i=="_"||l.spec.group&&l.spec.group.split(" ").indexOf(i)>-1)&&n.push(s=l)}if(!s)throw new SyntaxError("Unknown mark type: '"+e[r]+"'")}return n}function CE(t){return t.tag!=null}function EE(t){return t.style!=null}var dt=class t{constructor(e,n){this.schema=e,this.rules=n,this.tags=[],this.styles=[];let r=this.matchedStyles=[];n.forEach(i=>{if(CE(i))this.tags.push(i);else if(EE(i)){let o=/[^=]*/.exec(i.style)[0];r.indexOf(o)<0&&r.push(o),this.styles.push(i)}}),this.normalizeLists=!this.tags.some(i=>{if(!/^(ul|ol)\b/.test(i.tag)||!i.node)return!1;let o=e.nodes[i.node];return o.contentMatch.matchType(o)})}parse(e,n={}){let r=new yo(this,n,!1);return r.addAll(e,W.none,n.from,n.to),r.finish()}parseSlice(e,n={}){let r=new yo(this,n,!0);return r.addAll(e,W.none,n.from,n.to),k.maxOpen(r.finish())}matchTag(e,n,r){for(let i=r?this.tags.indexOf(r)+1:0;i<this.tags.length;i++){let o=this.tags[i];if(SE(e,o.tag)&&(o.namespace===void 0||e.namespaceURI==o.namespace)&&(!o.context||n.matchesContext(o.context))){if(o.getAttrs){let s=o.getAttrs(e);if(s===!1)continue;o.attrs=s||void 0}return o}}}matchStyle(e,n,r,i){for(let o=i?this.styles.indexOf(i)+1:0;o<this.styles.length;o++){let s=this.styles[o],a=s.style;if(!(a.indexOf(e)!=0||s.context&&!r.matchesContext(s.context)||a.length>e.length&&(a.charCodeAt(e.length)!=61||a.slice(e.length+1)!=n))){if(s.getAttrs){let l=s.getAttrs(n);if(l===!1)continue;s.attrs=l||void 0}return s}}}static schemaRules(e){let n=[];function r(i){let o=i.priority==null?50:i.priority,s=0;for(;s<n.length;s++){let a=n[s];if((a.priority==null?50:a.priority)<o)break}n.splice(s,0,i)}for(let i in e.marks){let o=e.marks[i].spec.parseDOM;o&&o.forEach(s=>{r(s=Hp(s)),s.mark||s.ignore||s.clearMark||(s.mark=i)})}for(let i in e.nodes){let o=e.nodes[i].spec.parseDOM;o&&o.forEach(s=>{r(s=Hp(s)),s.node||s.ignore||s.mark||(s.node=i)})}return n}static fromSchema(e){return e.cached.domParser||(e.cached.domParser=new t(e,t.schemaRules(e)))}},ef={address:!0,article:!0,aside:!0,blockquote:!0,canvas:!0,dd:!0,div:!0,dl:!0,fieldset:!0,figcaption:!0,figure:!0,footer:!0,form:!0,h1:!0,h2:!0,h3:!0,h4:!0,h5:!0,h6:!0,header:!0,hgroup:!0,hr:!0,li:!0,noscript:!0,ol:!0,output:!0,p:!0,pre:!0,section:!0,table:!0,tfoot:!0,ul:!0},vE={head:!0,noscript:!0,object:!0,script:!0,style:!0,title:!0},tf={ol:!0,ul:!0},_r=1,Ya=2,zr=4;function Dp(t,e,n){return e!=null?(e?_r:0)|(e==="full"?Ya:0):t&&t.whitespace=="pre"?_r|Ya:n&~zr}var Xn=class{constructor(e,n,r,i,o,s){this.type=e,this.attrs=n,this.marks=r,this.solid=i,this.options=s,this.content=[],this.activeMarks=W.none,this.match=o||(s&zr?null:e.contentMatch)}findWrapping(e){if(!this.match){if(!this.type)return[];let n=this.type.contentMatch.fillBefore(E.from(e));if(n)this.match=this.type.contentMatch.matchFragment(n);else{let r=this.type.contentMatch,i;return(i=r.findWrapping(e.type))?(this.match=r,i):null}}return this.match.findWrapping(e.type)}finish(e){if(!(this.options&_r)){let r=this.content[this.content.length-1],i;if(r&&r.isText&&(i=/[ \t\r\n\u000c]+$/.exec(r.text))){let o=r;r.text.length==i[0].length?this.content.pop():this.content[this.content.length-1]=o.withText(o.text.slice(0,o.text.length-i[0].length))}}let n=E.from(this.content);return!e&&this.match&&(n=n.append(this.match.fillBefore(E.empty,!0))),this.type?this.type.create(this.attrs,n,this.marks):n}inlineContext(e){return this.type?this.type.inlineContent:this.content.length?this.content[0].isInline:e.parentNode&&!ef.hasOwnProperty(e.parentNode.nodeName.toLowerCase())}},yo=class{constructor(e,n,r){this.parser=e,this.options=n,this.isOpen=r,this.open=0,this.localPreserveWS=!1;let i=n.topNode,o,s=Dp(null,n.preserveWhitespace,0)|(r?zr:0);i?o=new Xn(i.type,i.attrs,W.none,!0,n.topMatch||i.type.contentMatch,s):r?o=new Xn(null,null,W.none,!0,null,s):o=new Xn(e.schema.topNodeType,null,W.none,!0,null,s),this.nodes=[o],this.find=n.findPositions,this.needsBlock=!1}get top(){return this.nodes[this.open]}addDOM(e,n){e.nodeType==3?this.addTextNode(e,n):e.nodeType==1&&this.addElement(e,n)}addTextNode(e,n){let r=e.nodeValue,i=this.top,o=i.options&Ya?"full":this.localPreserveWS||(i.options&_r)>0,{schema:s}=this.parser;if(o==="full"||i.inlineContext(e)||/[^ \t\r\n\u000c]/.test(r)){if(o)if(o==="full")r=r.replace(/\r\n?/g,`
+`);else if(s.linebreakReplacement&&/[\r\n]/.test(r)&&this.top.findWrapping(s.linebreakReplacement.create())){let a=r.split(/\r?\n|\r/);for(let l=0;l<a.length;l++)l&&this.insertNode(s.linebreakReplacement.create(),n,!0),a[l]&&this.insertNode(s.text(a[l]),n,!/\S/.test(a[l]));r=""}else r=r.replace(/\r?\n|\r/g," ");else if(r=r.replace(/[ \t\r\n\u000c]+/g," "),/^[ \t\r\n\u000c]/.test(r)&&this.open==this.nodes.length-1){let a=i.content[i.content.length-1],l=e.previousSibling;(!a||l&&l.nodeName=="BR"||a.isText&&/[ \t\r\n\u000c]$/.test(a.text))&&(r=r.slice(1))}r&&this.insertNode(s.text(r),n,!/\S/.test(r)),this.findInText(e)}else this.findInside(e)}addElement(e,n,r){let i=this.localPreserveWS,o=this.top;(e.tagName=="PRE"||/pre/.test(e.style&&e.style.whiteSpace))&&(this.localPreserveWS=!0);let s=e.nodeName.toLowerCase(),a;tf.hasOwnProperty(s)&&this.parser.normalizeLists&&TE(e);let l=this.options.ruleFromNode&&this.options.ruleFromNode(e)||(a=this.parser.matchTag(e,this,r));e:if(l?l.ignore:vE.hasOwnProperty(s))this.findInside(e),this.ignoreFallback(e,n);else if(!l||l.skip||l.closeParent){l&&l.closeParent?this.open=Math.max(0,this.open-1):l&&l.skip.nodeType&&(e=l.skip);let c,d=this.needsBlock;if(ef.hasOwnProperty(s))o.content.length&&o.content[0].isInline&&this.open&&(this.open--,o=this.top),c=!0,o.type||(this.needsBlock=!0);else if(!e.firstChild){this.leafFallback(e,n);break e}let u=l&&l.skip?n:this.readStyles(e,n);u&&this.addAll(e,u),c&&this.sync(o),this.needsBlock=d}else{let c=this.readStyles(e,n);c&&this.addElementByRule(e,l,c,l.consuming===!1?a:void 0)}this.localPreserveWS=i}leafFallback(e,n){e.nodeName=="BR"&&this.top.type&&this.top.type.inlineContent&&this.addTextNode(e.ownerDocument.createTextNode(`
+`),n)}ignoreFallback(e,n){e.nodeName=="BR"&&(!this.top.type||!this.top.type.inlineContent)&&this.findPlace(this.parser.schema.text("-"),n,!0)}readStyles(e,n){let r=e.style;if(r&&r.length)for(let i=0;i<this.parser.matchedStyles.length;i++){let o=this.parser.matchedStyles[i],s=r.getPropertyValue(o);if(s)for(let a=void 0;;){let l=this.parser.matchStyle(o,s,this,a);if(!l)break;if(l.ignore)return null;if(l.clearMark?n=n.filter(c=>!l.clearMark(c)):n=n.concat(this.parser.schema.marks[l.mark].create(l.attrs)),l.consuming===!1)a=l;else break}}return n}addElementByRule(e,n,r,i){let o,s;if(n.node)if(s=this.parser.schema.nodes[n.node],s.isLeaf)this.insertNode(s.create(n.attrs),r,e.nodeName=="BR")||this.leafFallback(e,r);else{let l=this.enter(s,n.attrs||null,r,n.preserveWhitespace);l&&(o=!0,r=l)}else{let l=this.parser.schema.marks[n.mark];r=r.concat(l.create(n.attrs))}let a=this.top;if(s&&s.isLeaf)this.findInside(e);else if(i)this.addElement(e,r,i);else if(n.getContent)this.findInside(e),n.getContent(e,this.parser.schema).forEach(l=>this.insertNode(l,r,!1));else{let l=e;typeof n.contentElement=="string"?l=e.querySelector(n.contentElement):typeof n.contentElement=="function"?l=n.contentElement(e):n.contentElement&&(l=n.contentElement),this.findAround(e,l,!0),this.addAll(l,r),this.findAround(e,l,!1)}o&&this.sync(a)&&this.open--}addAll(e,n,r,i){let o=r||0;for(let s=r?e.childNodes[r]:e.firstChild,a=i==null?null:e.childNodes[i];s!=a;s=s.nextSibling,++o)this.findAtPoint(e,o),this.addDOM(s,n);this.findAtPoint(e,o)}findPlace(e,n,r){let i,o;for(let s=this.open,a=0;s>=0;s--){let l=this.nodes[s],c=l.findWrapping(e);if(c&&(!i||i.length>c.length+a)&&(i=c,o=l,!c.length))break;if(l.solid){if(r)break;a+=2}}if(!i)return null;this.sync(o);for(let s=0;s<i.length;s++)n=this.enterInner(i[s],null,n,!1);return n}insertNode(e,n,r){if(e.isInline&&this.needsBlock&&!this.top.type){let o=this.textblockFromContext();o&&(n=this.enterInner(o,null,n))}let i=this.findPlace(e,n,r);if(i){this.closeExtra();let o=this.top;o.match&&(o.match=o.match.matchType(e.type));let s=W.none;for(let a of i.concat(e.marks))(o.type?o.type.allowsMarkType(a.type):Fp(a.type,e.type))&&(s=a.addToSet(s));return o.content.push(e.mark(s)),!0}return!1}enter(e,n,r,i){let o=this.findPlace(e.create(n),r,!1);return o&&(o=this.enterInner(e,n,r,!0,i)),o}enterInner(e,n,r,i=!1,o){this.closeExtra();let s=this.top;s.match=s.match&&s.match.matchType(e);let a=Dp(e,o,s.options);s.options&zr&&s.content.length==0&&(a|=zr);let l=W.none;return r=r.filter(c=>(s.type?s.type.allowsMarkType(c.type):Fp(c.type,e))?(l=c.addToSet(l),!1):!0),this.nodes.push(new Xn(e,n,l,i,null,a)),this.open++,r}closeExtra(e=!1){let n=this.nodes.length-1;if(n>this.open){for(;n>this.open;n--)this.nodes[n-1].content.push(this.nodes[n].finish(e));this.nodes.length=this.open+1}}finish(){return this.open=0,this.closeExtra(this.isOpen),this.nodes[0].finish(!!(this.isOpen||this.options.topOpen))}sync(e){for(let n=this.open;n>=0;n--){if(this.nodes[n]==e)return this.open=n,!0;this.localPreserveWS&&(this.nodes[n].options|=_r)}return!1}get currentPos(){this.closeExtra();let e=0;for(let n=this.open;n>=0;n--){let r=this.nodes[n].content;for(let i=r.length-1;i>=0;i--)e+=r[i].nodeSize;n&&e++}return e}findAtPoint(e,n){if(this.find)for(let r=0;r<this.find.length;r++)this.find[r].node==e&&this.find[r].offset==n&&(this.find[r].pos=this.currentPos)}findInside(e){if(this.find)for(let n=0;n<this.find.length;n++)this.find[n].pos==null&&e.nodeType==1&&e.contains(this.find[n].node)&&(this.find[n].pos=this.currentPos)}findAround(e,n,r){if(e!=n&&this.find)for(let i=0;i<this.find.length;i++)this.find[i].pos==null&&e.nodeType==1&&e.contains(this.find[i].node)&&n.compareDocumentPosition(this.find[i].node)&(r?2:4)&&(this.find[i].pos=this.currentPos)}findInText(e){if(this.find)for(let n=0;n<this.find.length;n++)this.find[n].node==e&&(this.find[n].pos=this.currentPos-(e.nodeValue.length-this.find[n].offset))}matchesContext(e){if(e.indexOf("|")>-1)return e.split(/\s*\|\s*/).some(this.matchesContext,this);let n=e.split("/"),r=this.options.context,i=!this.isOpen&&(!r||r.parent.type==this.nodes[0].type),o=-(r?r.depth+1:0)+(i?0:1),s=(a,l)=>{for(;a>=0;a--){let c=n[a];if(c==""){if(a==n.length-1||a==0)continue;for(;l>=o;l--)if(s(a-1,l))return!0;return!1}else{let d=l>0||l==0&&i?this.nodes[l].type:r&&l>=o?r.node(l-o).type:null;if(!d||d.name!=c&&!d.isInGroup(c))return!1;l--}}return!0};return s(n.length-1,this.open)}textblockFromContext(){let e=this.options.context;if(e)for(let n=e.depth;n>=0;n--){let r=e.node(n).contentMatchAt(e.indexAfter(n)).defaultType;if(r&&r.isTextblock&&r.defaultAttrs)return r}for(let n in this.parser.schema.nodes){let r=this.parser.schema.nodes[n];if(r.isTextblock&&r.defaultAttrs)return r}}};function TE(t){for(let e=t.firstChild,n=null;e;e=e.nextSibling){let r=e.nodeType==1?e.nodeName.toLowerCase():null;r&&tf.hasOwnProperty(r)&&n?(n.appendChild(e),e=n):r=="li"?n=e:r&&(n=null)}}function SE(t,e){return(t.matches||t.msMatchesSelector||t.webkitMatchesSelector||t.mozMatchesSelector).call(t,e)}function Hp(t){let e={};for(let n in t)e[n]=t[n];return e}function Fp(t,e){let n=e.schema.nodes;for(let r in n){let i=n[r];if(!i.allowsMarkType(t))continue;let o=[],s=a=>{o.push(a);for(let l=0;l<a.edgeCount;l++){let{type:c,next:d}=a.edge(l);if(c==e||o.indexOf(d)<0&&s(d))return!0}};if(s(i.contentMatch))return!0}}var _t=class t{constructor(e,n){this.nodes=e,this.marks=n}serializeFragment(e,n={},r){r||(r=ja(n).createDocumentFragment());let i=r,o=[];return e.forEach(s=>{if(o.length||s.marks.length){let a=0,l=0;for(;a<o.length&&l<s.marks.length;){let c=s.marks[l];if(!this.marks[c.type.name]){l++;continue}if(!c.eq(o[a][0])||c.type.spec.spanning===!1)break;a++,l++}for(;a<o.length;)i=o.pop()[1];for(;l<s.marks.length;){let c=s.marks[l++],d=this.serializeMark(c,s.isInline,n);d&&(o.push([c,i]),i.appendChild(d.dom),i=d.contentDOM||d.dom)}}i.appendChild(this.serializeNodeInner(s,n))}),r}serializeNodeInner(e,n){let{dom:r,contentDOM:i}=po(ja(n),this.nodes[e.type.name](e),null,e.attrs);if(i){if(e.isLeaf)throw new RangeError("Content hole not allowed in a leaf node spec");this.serializeFragment(e.content,n,i)}return r}serializeNode(e,n={}){let r=this.serializeNodeInner(e,n);for(let i=e.marks.length-1;i>=0;i--){let o=this.serializeMark(e.marks[i],e.isInline,n);o&&((o.contentDOM||o.dom).appendChild(r),r=o.dom)}return r}serializeMark(e,n,r={}){let i=this.marks[e.type.name];return i&&po(ja(r),i(e,n),null,e.attrs)}static renderSpec(e,n,r=null,i){return po(e,n,r,i)}static fromSchema(e){return e.cached.domSerializer||(e.cached.domSerializer=new t(this.nodesFromSchema(e),this.marksFromSchema(e)))}static nodesFromSchema(e){let n=Bp(e.nodes);return n.text||(n.text=r=>r.text),n}static marksFromSchema(e){return Bp(e.marks)}};function Bp(t){let e={};for(let n in t){let r=t[n].spec.toDOM;r&&(e[n]=r)}return e}function ja(t){return t.document||window.document}var Vp=new WeakMap;function kE(t){let e=Vp.get(t);return e===void 0&&Vp.set(t,e=ME(t)),e}function ME(t){let e=null;function n(r){if(r&&typeof r=="object")if(Array.isArray(r))if(typeof r[0]=="string")e||(e=[]),e.push(r);else for(let i=0;i<r.length;i++)n(r[i]);else for(let i in r)n(r[i])}return n(t),e}function po(t,e,n,r){if(typeof e=="string")return{dom:t.createTextNode(e)};if(e.nodeType!=null)return{dom:e};if(e.dom&&e.dom.nodeType!=null)return e;let i=e[0],o;if(typeof i!="string")throw new RangeError("Invalid array passed to renderSpec");if(r&&(o=kE(r))&&o.indexOf(e)>-1)throw new RangeError("Using an array from an attribute object as a DOM spec. This may be an attempted cross site scripting attack.");let s=i.indexOf(" ");s>0&&(n=i.slice(0,s),i=i.slice(s+1));let a,l=n?t.createElementNS(n,i):t.createElement(i),c=e[1],d=1;if(c&&typeof c=="object"&&c.nodeType==null&&!Array.isArray(c)){d=2;for(let u in c)if(c[u]!=null){let p=u.indexOf(" ");p>0?l.setAttributeNS(u.slice(0,p),u.slice(p+1),c[u]):u=="style"&&l.style?l.style.cssText=c[u]:l.setAttribute(u,c[u])}}for(let u=d;u<e.length;u++){let p=e[u];if(p===0){if(u<e.length-1||u>d)throw new RangeError("Content hole must be the only child of its parent node");return{dom:l,contentDOM:l}}else{let{dom:f,contentDOM:h}=po(t,p,n,r);if(l.appendChild(f),h){if(a)throw new RangeError("Multiple content holes");a=h}}}return{dom:l,contentDOM:a}}var of=65535,sf=Math.pow(2,16);function wE(t,e){return t+e*sf}function nf(t){return t&of}function AE(t){return(t-(t&of))/sf}var af=1,lf=2,bo=4,cf=8,qr=class{constructor(e,n,r){this.pos=e,this.delInfo=n,this.recover=r}get deleted(){return(this.delInfo&cf)>0}get deletedBefore(){return(this.delInfo&(af|bo))>0}get deletedAfter(){return(this.delInfo&(lf|bo))>0}get deletedAcross(){return(this.delInfo&bo)>0}},Et=class t{constructor(e,n=!1){if(this.ranges=e,this.inverted=n,!e.length&&t.empty)return t.empty}recover(e){let n=0,r=nf(e);if(!this.inverted)for(let i=0;i<r;i++)n+=this.ranges[i*3+2]-this.ranges[i*3+1];return this.ranges[r*3]+n+AE(e)}mapResult(e,n=1){return this._map(e,n,!1)}map(e,n=1){return this._map(e,n,!0)}_map(e,n,r){let i=0,o=this.inverted?2:1,s=this.inverted?1:2;for(let a=0;a<this.ranges.length;a+=3){let l=this.ranges[a]-(this.inverted?i:0);if(l>e)break;let c=this.ranges[a+o],d=this.ranges[a+s],u=l+c;if(e<=u){let p=c?e==l?-1:e==u?1:n:n,f=l+i+(p<0?0:d);if(r)return f;let h=e==(n<0?l:u)?null:wE(a/3,e-l),m=e==l?lf:e==u?af:bo;return(n<0?e!=l:e!=u)&&(m|=cf),new qr(f,m,h)}i+=d-c}return r?e+i:new qr(e+i,0,null)}touches(e,n){let r=0,i=nf(n),o=this.inverted?2:1,s=this.inverted?1:2;for(let a=0;a<this.ranges.length;a+=3){let l=this.ranges[a]-(this.inverted?r:0);if(l>e)break;let c=this.ranges[a+o],d=l+c;if(e<=d&&a==i*3)return!0;r+=this.ranges[a+s]-c}return!1}forEach(e){let n=this.inverted?2:1,r=this.inverted?1:2;for(let i=0,o=0;i<this.ranges.length;i+=3){let s=this.ranges[i],a=s-(this.inverted?o:0),l=s+(this.inverted?0:o),c=this.ranges[i+n],d=this.ranges[i+r];e(a,a+c,l,l+d),o+=d-c}}invert(){return new t(this.ranges,!this.inverted)}toString(){return(this.inverted?"-":"")+JSON.stringify(this.ranges)}static offset(e){return e==0?t.empty:new t(e<0?[0,-e,0]:[0,0,e])}};Et.empty=new Et([]);var Kr=class t{constructor(e,n,r=0,i=e?e.length:0){this.mirror=n,this.from=r,this.to=i,this._maps=e||[],this.ownData=!(e||n)}get maps(){return this._maps}slice(e=0,n=this.maps.length){return new t(this._maps,this.mirror,e,n)}appendMap(e,n){this.ownData||(this._maps=this._maps.slice(),this.mirror=this.mirror&&this.mirror.slice(),this.ownData=!0),this.to=this._maps.push(e),n!=null&&this.setMirror(this._maps.length-1,n)}appendMapping(e){for(let n=0,r=this._maps.length;n<e._maps.length;n++){let i=e.getMirror(n);this.appendMap(e._maps[n],i!=null&&i<n?r+i:void 0)}}getMirror(e){if(this.mirror){for(let n=0;n<this.mirror.length;n++)if(this.mirror[n]==e)return this.mirror[n+(n%2?-1:1)]}}setMirror(e,n){this.mirror||(this.mirror=[]),this.mirror.push(e,n)}appendMappingInverted(e){for(let n=e.maps.length-1,r=this._maps.length+e._maps.length;n>=0;n--){let i=e.getMirror(n);this.appendMap(e._maps[n].invert(),i!=null&&i>n?r-i-1:void 0)}}invert(){let e=new t;return e.appendMappingInverted(this),e}map(e,n=1){if(this.mirror)return this._map(e,n,!0);for(let r=this.from;r<this.to;r++)e=this._maps[r].map(e,n);return e}mapResult(e,n=1){return this._map(e,n,!1)}_map(e,n,r){let i=0;for(let o=this.from;o<this.to;o++){let s=this._maps[o],a=s.mapResult(e,n);if(a.recover!=null){let l=this.getMirror(o);if(l!=null&&l>o&&l<this.to){o=l,e=this._maps[l].recover(a.recover);continue}}i|=a.delInfo,e=a.pos}return r?e:new qr(e,i,null)}},Qa=Object.create(null),ce=class{getMap(){return Et.empty}merge(e){return null}static fromJSON(e,n){if(!n||!n.stepType)throw new RangeError("Invalid input for Step.fromJSON");let r=Qa[n.stepType];if(!r)throw new RangeError(`No step type ${n.stepType} defined`);return r.fromJSON(e,n)}static jsonID(e,n){if(e in Qa)throw new RangeError("Duplicate use of step JSON ID "+e);return Qa[e]=n,n.prototype.jsonID=e,n}},de=class t{constructor(e,n){this.doc=e,this.failed=n}static ok(e){return new t(e,null)}static fail(e){return new t(null,e)}static fromReplace(e,n,r,i){try{return t.ok(e.replace(n,r,i))}catch(o){if(o instanceof mn)return t.fail(o.message);throw o}}};function nl(t,e,n){let r=[];for(let i=0;i<t.childCount;i++){let o=t.child(i);o.content.size&&(o=o.copy(nl(o.content,e,o))),o.isInline&&(o=e(o,n,i)),r.push(o)}return E.fromArray(r)}var Jr=class t extends ce{constructor(e,n,r){super(),this.from=e,this.to=n,this.mark=r}apply(e){let n=e.slice(this.from,this.to),r=e.resolve(this.from),i=r.node(r.sharedDepth(this.to)),o=new k(nl(n.content,(s,a)=>!s.isAtom||!a.type.allowsMarkType(this.mark.type)?s:s.mark(this.mark.addToSet(s.marks)),i),n.openStart,n.openEnd);return de.fromReplace(e,this.from,this.to,o)}invert(){return new bn(this.from,this.to,this.mark)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deleted&&r.deleted||n.pos>=r.pos?null:new t(n.pos,r.pos,this.mark)}merge(e){return e instanceof t&&e.mark.eq(this.mark)&&this.from<=e.to&&this.to>=e.from?new t(Math.min(this.from,e.from),Math.max(this.to,e.to),this.mark):null}toJSON(){return{stepType:"addMark",mark:this.mark.toJSON(),from:this.from,to:this.to}}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for AddMarkStep.fromJSON");return new t(n.from,n.to,e.markFromJSON(n.mark))}};ce.jsonID("addMark",Jr);var bn=class t extends ce{constructor(e,n,r){super(),this.from=e,this.to=n,this.mark=r}apply(e){let n=e.slice(this.from,this.to),r=new k(nl(n.content,i=>i.mark(this.mark.removeFromSet(i.marks)),e),n.openStart,n.openEnd);return de.fromReplace(e,this.from,this.to,r)}invert(){return new Jr(this.from,this.to,this.mark)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deleted&&r.deleted||n.pos>=r.pos?null:new t(n.pos,r.pos,this.mark)}merge(e){return e instanceof t&&e.mark.eq(this.mark)&&this.from<=e.to&&this.to>=e.from?new t(Math.min(this.from,e.from),Math.max(this.to,e.to),this.mark):null}toJSON(){return{stepType:"removeMark",mark:this.mark.toJSON(),from:this.from,to:this.to}}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for RemoveMarkStep.fromJSON");return new t(n.from,n.to,e.markFromJSON(n.mark))}};ce.jsonID("removeMark",bn);var Gr=class t extends ce{constructor(e,n){super(),this.pos=e,this.mark=n}apply(e){let n=e.nodeAt(this.pos);if(!n)return de.fail("No node at mark step's position");let r=n.type.create(n.attrs,null,this.mark.addToSet(n.marks));return de.fromReplace(e,this.pos,this.pos+1,new k(E.from(r),0,n.isLeaf?0:1))}invert(e){let n=e.nodeAt(this.pos);if(n){let r=this.mark.addToSet(n.marks);if(r.length==n.marks.length){for(let i=0;i<n.marks.length;i++)if(!n.marks[i].isInSet(r))return new t(this.pos,n.marks[i]);return new t(this.pos,this.mark)}}return new Zn(this.pos,this.mark)}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.mark)}toJSON(){return{stepType:"addNodeMark",pos:this.pos,mark:this.mark.toJSON()}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for AddNodeMarkStep.fromJSON");return new t(n.pos,e.markFromJSON(n.mark))}};ce.jsonID("addNodeMark",Gr);var Zn=class t extends ce{constructor(e,n){super(),this.pos=e,this.mark=n}apply(e){let n=e.nodeAt(this.pos);if(!n)return de.fail("No node at mark step's position");let r=n.type.create(n.attrs,null,this.mark.removeFromSet(n.marks));return de.fromReplace(e,this.pos,this.pos+1,new k(E.from(r),0,n.isLeaf?0:1))}invert(e){let n=e.nodeAt(this.pos);return!n||!this.mark.isInSet(n.marks)?this:new Gr(this.pos,this.mark)}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.mark)}toJSON(){return{stepType:"removeNodeMark",pos:this.pos,mark:this.mark.toJSON()}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for RemoveNodeMarkStep.fromJSON");return new t(n.pos,e.markFromJSON(n.mark))}};ce.jsonID("removeNodeMark",Zn);var me=class t extends ce{constructor(e,n,r,i=!1){super(),this.from=e,this.to=n,this.slice=r,this.structure=i}apply(e){return this.structure&&el(e,this.from,this.to)?de.fail("Structure replace would overwrite content"):de.fromReplace(e,this.from,this.to,this.slice)}getMap(){return new Et([this.from,this.to-this.from,this.slice.size])}invert(e){return new t(this.from,this.from+this.slice.size,e.slice(this.from,this.to))}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deletedAcross&&r.deletedAcross?null:new t(n.pos,Math.max(n.pos,r.pos),this.slice,this.structure)}merge(e){if(!(e instanceof t)||e.structure||this.structure)return null;if(this.from+this.slice.size==e.from&&!this.slice.openEnd&&!e.slice.openStart){let n=this.slice.size+e.slice.size==0?k.empty:new k(this.slice.content.append(e.slice.content),this.slice.openStart,e.slice.openEnd);return new t(this.from,this.to+(e.to-e.from),n,this.structure)}else if(e.to==this.from&&!this.slice.openStart&&!e.slice.openEnd){let n=this.slice.size+e.slice.size==0?k.empty:new k(e.slice.content.append(this.slice.content),e.slice.openStart,this.slice.openEnd);return new t(e.from,this.to,n,this.structure)}else return null}toJSON(){let e={stepType:"replace",from:this.from,to:this.to};return this.slice.size&&(e.slice=this.slice.toJSON()),this.structure&&(e.structure=!0),e}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for ReplaceStep.fromJSON");return new t(n.from,n.to,k.fromJSON(e,n.slice),!!n.structure)}};ce.jsonID("replace",me);var ie=class t extends ce{constructor(e,n,r,i,o,s,a=!1){super(),this.from=e,this.to=n,this.gapFrom=r,this.gapTo=i,this.slice=o,this.insert=s,this.structure=a}apply(e){if(this.structure&&(el(e,this.from,this.gapFrom)||el(e,this.gapTo,this.to)))return de.fail("Structure gap-replace would overwrite content");let n=e.slice(this.gapFrom,this.gapTo);if(n.openStart||n.openEnd)return de.fail("Gap is not a flat range");let r=this.slice.insertAt(this.insert,n.content);return r?de.fromReplace(e,this.from,this.to,r):de.fail("Content does not fit in gap")}getMap(){return new Et([this.from,this.gapFrom-this.from,this.insert,this.gapTo,this.to-this.gapTo,this.slice.size-this.insert])}invert(e){let n=this.gapTo-this.gapFrom;return new t(this.from,this.from+this.slice.size+n,this.from+this.insert,this.from+this.insert+n,e.slice(this.from,this.to).removeBetween(this.gapFrom-this.from,this.gapTo-this.from),this.gapFrom-this.from,this.structure)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1),i=this.from==this.gapFrom?n.pos:e.map(this.gapFrom,-1),o=this.to==this.gapTo?r.pos:e.map(this.gapTo,1);return n.deletedAcross&&r.deletedAcross||i<n.pos||o>r.pos?null:new t(n.pos,r.pos,i,o,this.slice,this.insert,this.structure)}toJSON(){let e={stepType:"replaceAround",from:this.from,to:this.to,gapFrom:this.gapFrom,gapTo:this.gapTo,insert:this.insert};return this.slice.size&&(e.slice=this.slice.toJSON()),this.structure&&(e.structure=!0),e}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number"||typeof n.gapFrom!="number"||typeof n.gapTo!="number"||typeof n.insert!="number")throw new RangeError("Invalid input for ReplaceAroundStep.fromJSON");return new t(n.from,n.to,n.gapFrom,n.gapTo,k.fromJSON(e,n.slice),n.insert,!!n.structure)}};ce.jsonID("replaceAround",ie);function el(t,e,n){let r=t.resolve(e),i=n-e,o=r.depth;for(;i>0&&o>0&&r.indexAfter(o)==r.node(o).childCount;)o--,i--;if(i>0){let s=r.node(o).maybeChild(r.indexAfter(o));for(;i>0;){if(!s||s.isLeaf)return!0;s=s.firstChild,i--}}return!1}function RE(t,e,n,r){let i=[],o=[],s,a;t.doc.nodesBetween(e,n,(l,c,d)=>{if(!l.isInline)return;let u=l.marks;if(!r.isInSet(u)&&d.type.allowsMarkType(r.type)){let p=Math.max(c,e),f=Math.min(c+l.nodeSize,n),h=r.addToSet(u);for(let m=0;m<u.length;m++)u[m].isInSet(h)||(s&&s.to==p&&s.mark.eq(u[m])?s.to=f:i.push(s=new bn(p,f,u[m])));a&&a.to==p?a.to=f:o.push(a=new Jr(p,f,r))}}),i.forEach(l=>t.step(l)),o.forEach(l=>t.step(l))}function OE(t,e,n,r){let i=[],o=0;t.doc.nodesBetween(e,n,(s,a)=>{if(!s.isInline)return;o++;let l=null;if(r instanceof $r){let c=s.marks,d;for(;d=r.isInSet(c);)(l||(l=[])).push(d),c=d.removeFromSet(c)}else r?r.isInSet(s.marks)&&(l=[r]):l=s.marks;if(l&&l.length){let c=Math.min(a+s.nodeSize,n);for(let d=0;d<l.length;d++){let u=l[d],p;for(let f=0;f<i.length;f++){let h=i[f];h.step==o-1&&u.eq(i[f].style)&&(p=h)}p?(p.to=c,p.step=o):i.push({style:u,from:Math.max(a,e),to:c,step:o})}}}),i.forEach(s=>t.step(new bn(s.from,s.to,s.style)))}function rl(t,e,n,r=n.contentMatch,i=!0){let o=t.doc.nodeAt(e),s=[],a=e+1;for(let l=0;l<o.childCount;l++){let c=o.child(l),d=a+c.nodeSize,u=r.matchType(c.type);if(!u)s.push(new me(a,d,k.empty));else{r=u;for(let p=0;p<c.marks.length;p++)n.allowsMarkType(c.marks[p].type)||t.step(new bn(a,d,c.marks[p]));if(i&&c.isText&&n.whitespace!="pre"){let p,f=/\r?\n|\r/g,h;for(;p=f.exec(c.text);)h||(h=new k(E.from(n.schema.text(" ",n.allowedMarks(c.marks))),0,0)),s.push(new me(a+p.index,a+p.index+p[0].length,h))}}a=d}if(!r.validEnd){let l=r.fillBefore(E.empty,!0);t.replace(a,a,new k(l,0,0))}for(let l=s.length-1;l>=0;l--)t.step(s[l])}function LE(t,e,n){return(e==0||t.canReplace(e,t.childCount))&&(n==t.childCount||t.canReplace(0,n))}function vt(t){let n=t.parent.content.cutByIndex(t.startIndex,t.endIndex);for(let r=t.depth;;--r){let i=t.$from.node(r),o=t.$from.index(r),s=t.$to.indexAfter(r);if(r<t.depth&&i.canReplace(o,s,n))return r;if(r==0||i.type.spec.isolating||!LE(i,o,s))break}return null}function NE(t,e,n){let{$from:r,$to:i,depth:o}=e,s=r.before(o+1),a=i.after(o+1),l=s,c=a,d=E.empty,u=0;for(let h=o,m=!1;h>n;h--)m||r.index(h)>0?(m=!0,d=E.from(r.node(h).copy(d)),u++):l--;let p=E.empty,f=0;for(let h=o,m=!1;h>n;h--)m||i.after(h+1)<i.end(h)?(m=!0,p=E.from(i.node(h).copy(p)),f++):c++;t.step(new ie(l,c,s,a,new k(d.append(p),u,f),d.size-u,!0))}function tr(t,e,n=null,r=t){let i=IE(t,e),o=i&&PE(r,e);return o?i.map(rf).concat({type:e,attrs:n}).concat(o.map(rf)):null}function rf(t){return{type:t,attrs:null}}function IE(t,e){let{parent:n,startIndex:r,endIndex:i}=t,o=n.contentMatchAt(r).findWrapping(e);if(!o)return null;let s=o.length?o[0]:e;return n.canReplaceWith(r,i,s)?o:null}function PE(t,e){let{parent:n,startIndex:r,endIndex:i}=t,o=n.child(r),s=e.contentMatch.findWrapping(o.type);if(!s)return null;let l=(s.length?s[s.length-1]:e).contentMatch;for(let c=r;l&&c<i;c++)l=l.matchType(n.child(c).type);return!l||!l.validEnd?null:s}function DE(t,e,n){let r=E.empty;for(let s=n.length-1;s>=0;s--){if(r.size){let a=n[s].type.contentMatch.matchFragment(r);if(!a||!a.validEnd)throw new RangeError("Wrapper type given to Transform.wrap does not form valid content of its parent wrapper")}r=E.from(n[s].type.create(n[s].attrs,r))}let i=e.start,o=e.end;t.step(new ie(i,o,i,o,new k(r,0,0),n.length,!0))}function HE(t,e,n,r,i){if(!r.isTextblock)throw new RangeError("Type given to setBlockType should be a textblock");let o=t.steps.length;t.doc.nodesBetween(e,n,(s,a)=>{let l=typeof i=="function"?i(s):i;if(s.isTextblock&&!s.hasMarkup(r,l)&&FE(t.doc,t.mapping.slice(o).map(a),r)){let c=null;if(r.schema.linebreakReplacement){let f=r.whitespace=="pre",h=!!r.contentMatch.matchType(r.schema.linebreakReplacement);f&&!h?c=!1:!f&&h&&(c=!0)}c===!1&&uf(t,s,a,o),rl(t,t.mapping.slice(o).map(a,1),r,void 0,c===null);let d=t.mapping.slice(o),u=d.map(a,1),p=d.map(a+s.nodeSize,1);return t.step(new ie(u,p,u+1,p-1,new k(E.from(r.create(l,null,s.marks)),0,0),1,!0)),c===!0&&df(t,s,a,o),!1}})}function df(t,e,n,r){e.forEach((i,o)=>{if(i.isText){let s,a=/\r?\n|\r/g;for(;s=a.exec(i.text);){let l=t.mapping.slice(r).map(n+1+o+s.index);t.replaceWith(l,l+1,e.type.schema.linebreakReplacement.create())}}})}function uf(t,e,n,r){e.forEach((i,o)=>{if(i.type==i.type.schema.linebreakReplacement){let s=t.mapping.slice(r).map(n+1+o);t.replaceWith(s,s+1,e.type.schema.text(`
+`))}})}function FE(t,e,n){let r=t.resolve(e),i=r.index();return r.parent.canReplaceWith(i,i+1,n)}function BE(t,e,n,r,i){let o=t.doc.nodeAt(e);if(!o)throw new RangeError("No node at given position");n||(n=o.type);let s=n.create(r,null,i||o.marks);if(o.isLeaf)return t.replaceWith(e,e+o.nodeSize,s);if(!n.validContent(o.content))throw new RangeError("Invalid content for node type "+n.name);t.step(new ie(e,e+o.nodeSize,e+1,e+o.nodeSize-1,new k(E.from(s),0,0),1,!0))}function Ve(t,e,n=1,r){let i=t.resolve(e),o=i.depth-n,s=r&&r[r.length-1]||i.parent;if(o<0||i.parent.type.spec.isolating||!i.parent.canReplace(i.index(),i.parent.childCount)||!s.type.validContent(i.parent.content.cutByIndex(i.index(),i.parent.childCount)))return!1;for(let c=i.depth-1,d=n-2;c>o;c--,d--){let u=i.node(c),p=i.index(c);if(u.type.spec.isolating)return!1;let f=u.content.cutByIndex(p,u.childCount),h=r&&r[d+1];h&&(f=f.replaceChild(0,h.type.create(h.attrs)));let m=r&&r[d]||u;if(!u.canReplace(p+1,u.childCount)||!m.type.validContent(f))return!1}let a=i.indexAfter(o),l=r&&r[0];return i.node(o).canReplaceWith(a,a,l?l.type:i.node(o+1).type)}function VE(t,e,n=1,r){let i=t.doc.resolve(e),o=E.empty,s=E.empty;for(let a=i.depth,l=i.depth-n,c=n-1;a>l;a--,c--){o=E.from(i.node(a).copy(o));let d=r&&r[c];s=E.from(d?d.type.create(d.attrs,s):i.node(a).copy(s))}t.step(new me(e,e,new k(o.append(s),n,n),!0))}function qe(t,e){let n=t.resolve(e),r=n.index();return pf(n.nodeBefore,n.nodeAfter)&&n.parent.canReplace(r,r+1)}function zE(t,e){e.content.size||t.type.compatibleContent(e.type);let n=t.contentMatchAt(t.childCount),{linebreakReplacement:r}=t.type.schema;for(let i=0;i<e.childCount;i++){let o=e.child(i),s=o.type==r?t.type.schema.nodes.text:o.type;if(n=n.matchType(s),!n||!t.type.allowsMarks(o.marks))return!1}return n.validEnd}function pf(t,e){return!!(t&&e&&!t.isLeaf&&zE(t,e))}function xn(t,e,n=-1){let r=t.resolve(e);for(let i=r.depth;;i--){let o,s,a=r.index(i);if(i==r.depth?(o=r.nodeBefore,s=r.nodeAfter):n>0?(o=r.node(i+1),a++,s=r.node(i).maybeChild(a)):(o=r.node(i).maybeChild(a-1),s=r.node(i+1)),o&&!o.isTextblock&&pf(o,s)&&r.node(i).canReplace(a,a+1))return e;if(i==0)break;e=n<0?r.before(i):r.after(i)}}function $E(t,e,n){let r=null,{linebreakReplacement:i}=t.doc.type.schema,o=t.doc.resolve(e-n),s=o.node().type;if(i&&s.inlineContent){let d=s.whitespace=="pre",u=!!s.contentMatch.matchType(i);d&&!u?r=!1:!d&&u&&(r=!0)}let a=t.steps.length;if(r===!1){let d=t.doc.resolve(e+n);uf(t,d.node(),d.before(),a)}s.inlineContent&&rl(t,e+n-1,s,o.node().contentMatchAt(o.index()),r==null);let l=t.mapping.slice(a),c=l.map(e-n);if(t.step(new me(c,l.map(e+n,-1),k.empty,!0)),r===!0){let d=t.doc.resolve(c);df(t,d.node(),d.before(),t.steps.length)}return t}function UE(t,e,n){let r=t.resolve(e);if(r.parent.canReplaceWith(r.index(),r.index(),n))return e;if(r.parentOffset==0)for(let i=r.depth-1;i>=0;i--){let o=r.index(i);if(r.node(i).canReplaceWith(o,o,n))return r.before(i+1);if(o>0)return null}if(r.parentOffset==r.parent.content.size)for(let i=r.depth-1;i>=0;i--){let o=r.indexAfter(i);if(r.node(i).canReplaceWith(o,o,n))return r.after(i+1);if(o<r.node(i).childCount)return null}return null}function Eo(t,e,n){let r=t.resolve(e);if(!n.content.size)return e;let i=n.content;for(let o=0;o<n.openStart;o++)i=i.firstChild.content;for(let o=1;o<=(n.openStart==0&&n.size?2:1);o++)for(let s=r.depth;s>=0;s--){let a=s==r.depth?0:r.pos<=(r.start(s+1)+r.end(s+1))/2?-1:1,l=r.index(s)+(a>0?1:0),c=r.node(s),d=!1;if(o==1)d=c.canReplace(l,l,i);else{let u=c.contentMatchAt(l).findWrapping(i.firstChild.type);d=u&&c.canReplaceWith(l,l,u[0])}if(d)return a==0?r.pos:a<0?r.before(s+1):r.after(s+1)}return null}function Qr(t,e,n=e,r=k.empty){if(e==n&&!r.size)return null;let i=t.resolve(e),o=t.resolve(n);return ff(i,o,r)?new me(e,n,r):new tl(i,o,r).fit()}function ff(t,e,n){return!n.openStart&&!n.openEnd&&t.start()==e.start()&&t.parent.canReplace(t.index(),e.index(),n.content)}var tl=class{constructor(e,n,r){this.$from=e,this.$to=n,this.unplaced=r,this.frontier=[],this.placed=E.empty;for(let i=0;i<=e.depth;i++){let o=e.node(i);this.frontier.push({type:o.type,match:o.contentMatchAt(e.indexAfter(i))})}for(let i=e.depth;i>0;i--)this.placed=E.from(e.node(i).copy(this.placed))}get depth(){return this.frontier.length-1}fit(){for(;this.unplaced.size;){let c=this.findFittable();c?this.placeNodes(c):this.openMore()||this.dropNode()}let e=this.mustMoveInline(),n=this.placed.size-this.depth-this.$from.depth,r=this.$from,i=this.close(e<0?this.$to:r.doc.resolve(e));if(!i)return null;let o=this.placed,s=r.depth,a=i.depth;for(;s&&a&&o.childCount==1;)o=o.firstChild.content,s--,a--;let l=new k(o,s,a);return e>-1?new ie(r.pos,e,this.$to.pos,this.$to.end(),l,n):l.size||r.pos!=this.$to.pos?new me(r.pos,i.pos,l):null}findFittable(){let e=this.unplaced.openStart;for(let n=this.unplaced.content,r=0,i=this.unplaced.openEnd;r<e;r++){let o=n.firstChild;if(n.childCount>1&&(i=0),o.type.spec.isolating&&i<=r){e=r;break}n=o.content}for(let n=1;n<=2;n++)for(let r=n==1?e:this.unplaced.openStart;r>=0;r--){let i,o=null;r?(o=Xa(this.unplaced.content,r-1).firstChild,i=o.content):i=this.unplaced.content;let s=i.firstChild;for(let a=this.depth;a>=0;a--){let{type:l,match:c}=this.frontier[a],d,u=null;if(n==1&&(s?c.matchType(s.type)||(u=c.fillBefore(E.from(s),!1)):o&&l.compatibleContent(o.type)))return{sliceDepth:r,frontierDepth:a,parent:o,inject:u};if(n==2&&s&&(d=c.findWrapping(s.type)))return{sliceDepth:r,frontierDepth:a,parent:o,wrap:d};if(o&&c.matchType(o.type))break}}}openMore(){let{content:e,openStart:n,openEnd:r}=this.unplaced,i=Xa(e,n);return!i.childCount||i.firstChild.isLeaf?!1:(this.unplaced=new k(e,n+1,Math.max(r,i.size+n>=e.size-r?n+1:0)),!0)}dropNode(){let{content:e,openStart:n,openEnd:r}=this.unplaced,i=Xa(e,n);if(i.childCount<=1&&n>0){let o=e.size-n<=n+i.size;this.unplaced=new k(jr(e,n-1,1),n-1,o?n-1:r)}else this.unplaced=new k(jr(e,n,1),n,r)}placeNodes({sliceDepth:e,frontierDepth:n,parent:r,inject:i,wrap:o}){for(;this.depth>n;)this.closeFrontierNode();if(o)for(let m=0;m<o.length;m++)this.openFrontierNode(o[m]);let s=this.unplaced,a=r?r.content:s.content,l=s.openStart-e,c=0,d=[],{match:u,type:p}=this.frontier[n];if(i){for(let m=0;m<i.childCount;m++)d.push(i.child(m));u=u.matchFragment(i)}let f=a.size+e-(s.content.size-s.openEnd);for(;c<a.childCount;){let m=a.child(c),g=u.matchType(m.type);if(!g)break;c++,(c>1||l==0||m.content.size)&&(u=g,d.push(hf(m.mark(p.allowedMarks(m.marks)),c==1?l:0,c==a.childCount?f:-1)))}let h=c==a.childCount;h||(f=-1),this.placed=Wr(this.placed,n,E.from(d)),this.frontier[n].match=u,h&&f<0&&r&&r.type==this.frontier[this.depth].type&&this.frontier.length>1&&this.closeFrontierNode();for(let m=0,g=a;m<f;m++){let y=g.lastChild;this.frontier.push({type:y.type,match:y.contentMatchAt(y.childCount)}),g=y.content}this.unplaced=h?e==0?k.empty:new k(jr(s.content,e-1,1),e-1,f<0?s.openEnd:e-1):new k(jr(s.content,e,c),s.openStart,s.openEnd)}mustMoveInline(){if(!this.$to.parent.isTextblock)return-1;let e=this.frontier[this.depth],n;if(!e.type.isTextblock||!Za(this.$to,this.$to.depth,e.type,e.match,!1)||this.$to.depth==this.depth&&(n=this.findCloseLevel(this.$to))&&n.depth==this.depth)return-1;let{depth:r}=this.$to,i=this.$to.after(r);for(;r>1&&i==this.$to.end(--r);)++i;return i}findCloseLevel(e){e:for(let n=Math.min(this.depth,e.depth);n>=0;n--){let{match:r,type:i}=this.frontier[n],o=n<e.depth&&e.end(n+1)==e.pos+(e.depth-(n+1)),s=Za(e,n,i,r,o);if(s){for(let a=n-1;a>=0;a--){let{match:l,type:c}=this.frontier[a],d=Za(e,a,c,l,!0);if(!d||d.childCount)continue e}return{depth:n,fit:s,move:o?e.doc.resolve(e.after(n+1)):e}}}}close(e){let n=this.findCloseLevel(e);if(!n)return null;for(;this.depth>n.depth;)this.closeFrontierNode();n.fit.childCount&&(this.placed=Wr(this.placed,n.depth,n.fit)),e=n.move;for(let r=n.depth+1;r<=e.depth;r++){let i=e.node(r),o=i.type.contentMatch.fillBefore(i.content,!0,e.index(r));this.openFrontierNode(i.type,i.attrs,o)}return e}openFrontierNode(e,n=null,r){let i=this.frontier[this.depth];i.match=i.match.matchType(e),this.placed=Wr(this.placed,this.depth,E.from(e.create(n,r))),this.frontier.push({type:e,match:e.contentMatch})}closeFrontierNode(){let n=this.frontier.pop().match.fillBefore(E.empty,!0);n.childCount&&(this.placed=Wr(this.placed,this.frontier.length,n))}};function jr(t,e,n){return e==0?t.cutByIndex(n,t.childCount):t.replaceChild(0,t.firstChild.copy(jr(t.firstChild.content,e-1,n)))}function Wr(t,e,n){return e==0?t.append(n):t.replaceChild(t.childCount-1,t.lastChild.copy(Wr(t.lastChild.content,e-1,n)))}function Xa(t,e){for(let n=0;n<e;n++)t=t.firstChild.content;return t}function hf(t,e,n){if(e<=0)return t;let r=t.content;return e>1&&(r=r.replaceChild(0,hf(r.firstChild,e-1,r.childCount==1?n-1:0))),e>0&&(r=t.type.contentMatch.fillBefore(r).append(r),n<=0&&(r=r.append(t.type.contentMatch.matchFragment(r).fillBefore(E.empty,!0)))),t.copy(r)}function Za(t,e,n,r,i){let o=t.node(e),s=i?t.indexAfter(e):t.index(e);if(s==o.childCount&&!n.compatibleContent(o.type))return null;let a=r.fillBefore(o.content,!0,s);return a&&!_E(n,o.content,s)?a:null}function _E(t,e,n){for(let r=n;r<e.childCount;r++)if(!t.allowsMarks(e.child(r).marks))return!0;return!1}function jE(t){return t.spec.defining||t.spec.definingForContent}function WE(t,e,n,r){if(!r.size)return t.deleteRange(e,n);let i=t.doc.resolve(e),o=t.doc.resolve(n);if(ff(i,o,r))return t.step(new me(e,n,r));let s=gf(i,t.doc.resolve(n));s[s.length-1]==0&&s.pop();let a=-(i.depth+1);s.unshift(a);for(let p=i.depth,f=i.pos-1;p>0;p--,f--){let h=i.node(p).type.spec;if(h.defining||h.definingAsContext||h.isolating)break;s.indexOf(p)>-1?a=p:i.before(p)==f&&s.splice(1,0,-p)}let l=s.indexOf(a),c=[],d=r.openStart;for(let p=r.content,f=0;;f++){let h=p.firstChild;if(c.push(h),f==r.openStart)break;p=h.content}for(let p=d-1;p>=0;p--){let f=c[p],h=jE(f.type);if(h&&!f.sameMarkup(i.node(Math.abs(a)-1)))d=p;else if(h||!f.type.isTextblock)break}for(let p=r.openStart;p>=0;p--){let f=(p+d+1)%(r.openStart+1),h=c[f];if(h)for(let m=0;m<s.length;m++){let g=s[(m+l)%s.length],y=!0;g<0&&(y=!1,g=-g);let x=i.node(g-1),C=i.index(g-1);if(x.canReplaceWith(C,C,h.type,h.marks))return t.replace(i.before(g),y?o.after(g):n,new k(mf(r.content,0,r.openStart,f),f,r.openEnd))}}let u=t.steps.length;for(let p=s.length-1;p>=0&&(t.replace(e,n,r),!(t.steps.length>u));p--){let f=s[p];f<0||(e=i.before(f),n=o.after(f))}}function mf(t,e,n,r,i){if(e<n){let o=t.firstChild;t=t.replaceChild(0,o.copy(mf(o.content,e+1,n,r,o)))}if(e>r){let o=i.contentMatchAt(0),s=o.fillBefore(t).append(t);t=s.append(o.matchFragment(s).fillBefore(E.empty,!0))}return t}function qE(t,e,n,r){if(!r.isInline&&e==n&&t.doc.resolve(e).parent.content.size){let i=UE(t.doc,e,r.type);i!=null&&(e=n=i)}t.replaceRange(e,n,new k(E.from(r),0,0))}function KE(t,e,n){let r=t.doc.resolve(e),i=t.doc.resolve(n),o=gf(r,i);for(let s=0;s<o.length;s++){let a=o[s],l=s==o.length-1;if(l&&a==0||r.node(a).type.contentMatch.validEnd)return t.delete(r.start(a),i.end(a));if(a>0&&(l||r.node(a-1).canReplace(r.index(a-1),i.indexAfter(a-1))))return t.delete(r.before(a),i.after(a))}for(let s=1;s<=r.depth&&s<=i.depth;s++)if(e-r.start(s)==r.depth-s&&n>r.end(s)&&i.end(s)-n!=i.depth-s&&r.start(s-1)==i.start(s-1)&&r.node(s-1).canReplace(r.index(s-1),i.index(s-1)))return t.delete(r.before(s),n);t.delete(e,n)}function gf(t,e){let n=[],r=Math.min(t.depth,e.depth);for(let i=r;i>=0;i--){let o=t.start(i);if(o<t.pos-(t.depth-i)||e.end(i)>e.pos+(e.depth-i)||t.node(i).type.spec.isolating||e.node(i).type.spec.isolating)break;(o==e.start(i)||i==t.depth&&i==e.depth&&t.parent.inlineContent&&e.parent.inlineContent&&i&&e.start(i-1)==o-1)&&n.push(i)}return n}var xo=class t extends ce{constructor(e,n,r){super(),this.pos=e,this.attr=n,this.value=r}apply(e){let n=e.nodeAt(this.pos);if(!n)return de.fail("No node at attribute step's position");let r=Object.create(null);for(let o in n.attrs)r[o]=n.attrs[o];r[this.attr]=this.value;let i=n.type.create(r,null,n.marks);return de.fromReplace(e,this.pos,this.pos+1,new k(E.from(i),0,n.isLeaf?0:1))}getMap(){return Et.empty}invert(e){return new t(this.pos,this.attr,e.nodeAt(this.pos).attrs[this.attr])}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.attr,this.value)}toJSON(){return{stepType:"attr",pos:this.pos,attr:this.attr,value:this.value}}static fromJSON(e,n){if(typeof n.pos!="number"||typeof n.attr!="string")throw new RangeError("Invalid input for AttrStep.fromJSON");return new t(n.pos,n.attr,n.value)}};ce.jsonID("attr",xo);var Co=class t extends ce{constructor(e,n){super(),this.attr=e,this.value=n}apply(e){let n=Object.create(null);for(let i in e.attrs)n[i]=e.attrs[i];n[this.attr]=this.value;let r=e.type.create(n,e.content,e.marks);return de.ok(r)}getMap(){return Et.empty}invert(e){return new t(this.attr,e.attrs[this.attr])}map(e){return this}toJSON(){return{stepType:"docAttr",attr:this.attr,value:this.value}}static fromJSON(e,n){if(typeof n.attr!="string")throw new RangeError("Invalid input for DocAttrStep.fromJSON");return new t(n.attr,n.value)}};ce.jsonID("docAttr",Co);var er=class extends Error{};er=function t(e){let n=Error.call(this,e);return n.__proto__=t.prototype,n};er.prototype=Object.create(Error.prototype);er.prototype.constructor=er;er.prototype.name="TransformError";var Yr=class{constructor(e){this.doc=e,this.steps=[],this.docs=[],this.mapping=new Kr}get before(){return this.docs.length?this.docs[0]:this.doc}step(e){let n=this.maybeStep(e);if(n.failed)throw new er(n.failed);return this}maybeStep(e){let n=e.apply(this.doc);return n.failed||this.addStep(e,n.doc),n}get docChanged(){return this.steps.length>0}addStep(e,n){this.docs.push(this.doc),this.steps.push(e),this.mapping.appendMap(e.getMap()),this.doc=n}replace(e,n=e,r=k.empty){let i=Qr(this.doc,e,n,r);return i&&this.step(i),this}replaceWith(e,n,r){return this.replace(e,n,new k(E.from(r),0,0))}delete(e,n){return this.replace(e,n,k.empty)}insert(e,n){return this.replaceWith(e,e,n)}replaceRange(e,n,r){return WE(this,e,n,r),this}replaceRangeWith(e,n,r){return qE(this,e,n,r),this}deleteRange(e,n){return KE(this,e,n),this}lift(e,n){return NE(this,e,n),this}join(e,n=1){return $E(this,e,n),this}wrap(e,n){return DE(this,e,n),this}setBlockType(e,n=e,r,i=null){return HE(this,e,n,r,i),this}setNodeMarkup(e,n,r=null,i){return BE(this,e,n,r,i),this}setNodeAttribute(e,n,r){return this.step(new xo(e,n,r)),this}setDocAttribute(e,n){return this.step(new Co(e,n)),this}addNodeMark(e,n){return this.step(new Gr(e,n)),this}removeNodeMark(e,n){let r=this.doc.nodeAt(e);if(!r)throw new RangeError("No node at position "+e);if(n instanceof W)n.isInSet(r.marks)&&this.step(new Zn(e,n));else{let i=r.marks,o,s=[];for(;o=n.isInSet(i);)s.push(new Zn(e,o)),i=o.removeFromSet(i);for(let a=s.length-1;a>=0;a--)this.step(s[a])}return this}split(e,n=1,r){return VE(this,e,n,r),this}addMark(e,n,r){return RE(this,e,n,r),this}removeMark(e,n,r){return OE(this,e,n,r),this}clearIncompatible(e,n,r){return rl(this,e,n,r),this}};var il=Object.create(null),I=class{constructor(e,n,r){this.$anchor=e,this.$head=n,this.ranges=r||[new vo(e.min(n),e.max(n))]}get anchor(){return this.$anchor.pos}get head(){return this.$head.pos}get from(){return this.$from.pos}get to(){return this.$to.pos}get $from(){return this.ranges[0].$from}get $to(){return this.ranges[0].$to}get empty(){let e=this.ranges;for(let n=0;n<e.length;n++)if(e[n].$from.pos!=e[n].$to.pos)return!1;return!0}content(){return this.$from.doc.slice(this.from,this.to,!0)}replace(e,n=k.empty){let r=n.content.lastChild,i=null;for(let a=0;a<n.openEnd;a++)i=r,r=r.lastChild;let o=e.steps.length,s=this.ranges;for(let a=0;a<s.length;a++){let{$from:l,$to:c}=s[a],d=e.mapping.slice(o);e.replaceRange(d.map(l.pos),d.map(c.pos),a?k.empty:n),a==0&&xf(e,o,(r?r.isInline:i&&i.isTextblock)?-1:1)}}replaceWith(e,n){let r=e.steps.length,i=this.ranges;for(let o=0;o<i.length;o++){let{$from:s,$to:a}=i[o],l=e.mapping.slice(r),c=l.map(s.pos),d=l.map(a.pos);o?e.deleteRange(c,d):(e.replaceRangeWith(c,d,n),xf(e,r,n.isInline?-1:1))}}static findFrom(e,n,r=!1){let i=e.parent.inlineContent?new O(e):nr(e.node(0),e.parent,e.pos,e.index(),n,r);if(i)return i;for(let o=e.depth-1;o>=0;o--){let s=n<0?nr(e.node(0),e.node(o),e.before(o+1),e.index(o),n,r):nr(e.node(0),e.node(o),e.after(o+1),e.index(o)+1,n,r);if(s)return s}return null}static near(e,n=1){return this.findFrom(e,n)||this.findFrom(e,-n)||new Ee(e.node(0))}static atStart(e){return nr(e,e,0,0,1)||new Ee(e)}static atEnd(e){return nr(e,e,e.content.size,e.childCount,-1)||new Ee(e)}static fromJSON(e,n){if(!n||!n.type)throw new RangeError("Invalid input for Selection.fromJSON");let r=il[n.type];if(!r)throw new RangeError(`No selection type ${n.type} defined`);return r.fromJSON(e,n)}static jsonID(e,n){if(e in il)throw new RangeError("Duplicate use of selection JSON ID "+e);return il[e]=n,n.prototype.jsonID=e,n}getBookmark(){return O.between(this.$anchor,this.$head).getBookmark()}};I.prototype.visible=!0;var vo=class{constructor(e,n){this.$from=e,this.$to=n}},yf=!1;function bf(t){!yf&&!t.parent.inlineContent&&(yf=!0,console.warn("TextSelection endpoint not pointing into a node with inline content ("+t.parent.type.name+")"))}var O=class t extends I{constructor(e,n=e){bf(e),bf(n),super(e,n)}get $cursor(){return this.$anchor.pos==this.$head.pos?this.$head:null}map(e,n){let r=e.resolve(n.map(this.head));if(!r.parent.inlineContent)return I.near(r);let i=e.resolve(n.map(this.anchor));return new t(i.parent.inlineContent?i:r,r)}replace(e,n=k.empty){if(super.replace(e,n),n==k.empty){let r=this.$from.marksAcross(this.$to);r&&e.ensureMarks(r)}}eq(e){return e instanceof t&&e.anchor==this.anchor&&e.head==this.head}getBookmark(){return new To(this.anchor,this.head)}toJSON(){return{type:"text",anchor:this.anchor,head:this.head}}static fromJSON(e,n){if(typeof n.anchor!="number"||typeof n.head!="number")throw new RangeError("Invalid input for TextSelection.fromJSON");return new t(e.resolve(n.anchor),e.resolve(n.head))}static create(e,n,r=n){let i=e.resolve(n);return new this(i,r==n?i:e.resolve(r))}static between(e,n,r){let i=e.pos-n.pos;if((!r||i)&&(r=i>=0?1:-1),!n.parent.inlineContent){let o=I.findFrom(n,r,!0)||I.findFrom(n,-r,!0);if(o)n=o.$head;else return I.near(n,r)}return e.parent.inlineContent||(i==0?e=n:(e=(I.findFrom(e,-r,!0)||I.findFrom(e,r,!0)).$anchor,e.pos<n.pos!=i<0&&(e=n))),new t(e,n)}};I.jsonID("text",O);var To=class t{constructor(e,n){this.anchor=e,this.head=n}map(e){return new t(e.map(this.anchor),e.map(this.head))}resolve(e){return O.between(e.resolve(this.anchor),e.resolve(this.head))}},A=class t extends I{constructor(e){let n=e.nodeAfter,r=e.node(0).resolve(e.pos+n.nodeSize);super(e,r),this.node=n}map(e,n){let{deleted:r,pos:i}=n.mapResult(this.anchor),o=e.resolve(i);return r?I.near(o):new t(o)}content(){return new k(E.from(this.node),0,0)}eq(e){return e instanceof t&&e.anchor==this.anchor}toJSON(){return{type:"node",anchor:this.anchor}}getBookmark(){return new sl(this.anchor)}static fromJSON(e,n){if(typeof n.anchor!="number")throw new RangeError("Invalid input for NodeSelection.fromJSON");return new t(e.resolve(n.anchor))}static create(e,n){return new t(e.resolve(n))}static isSelectable(e){return!e.isText&&e.type.spec.selectable!==!1}};A.prototype.visible=!1;I.jsonID("node",A);var sl=class t{constructor(e){this.anchor=e}map(e){let{deleted:n,pos:r}=e.mapResult(this.anchor);return n?new To(r,r):new t(r)}resolve(e){let n=e.resolve(this.anchor),r=n.nodeAfter;return r&&A.isSelectable(r)?new A(n):I.near(n)}},Ee=class t extends I{constructor(e){super(e.resolve(0),e.resolve(e.content.size))}replace(e,n=k.empty){if(n==k.empty){e.delete(0,e.doc.content.size);let r=I.atStart(e.doc);r.eq(e.selection)||e.setSelection(r)}else super.replace(e,n)}toJSON(){return{type:"all"}}static fromJSON(e){return new t(e)}map(e){return new t(e)}eq(e){return e instanceof t}getBookmark(){return JE}};I.jsonID("all",Ee);var JE={map(){return this},resolve(t){return new Ee(t)}};function nr(t,e,n,r,i,o=!1){if(e.inlineContent)return O.create(t,n);for(let s=r-(i>0?0:1);i>0?s<e.childCount:s>=0;s+=i){let a=e.child(s);if(a.isAtom){if(!o&&A.isSelectable(a))return A.create(t,n-(i<0?a.nodeSize:0))}else{let l=nr(t,a,n+i,i<0?a.childCount:0,i,o);if(l)return l}n+=a.nodeSize*i}return null}function xf(t,e,n){let r=t.steps.length-1;if(r<e)return;let i=t.steps[r];if(!(i instanceof me||i instanceof ie))return;let o=t.mapping.maps[r],s;o.forEach((a,l,c,d)=>{s==null&&(s=d)}),t.setSelection(I.near(t.doc.resolve(s),n))}function Cf(t,e){return!e||!t?t:t.bind(e)}var rr=class{constructor(e,n,r){this.name=e,this.init=Cf(n.init,r),this.apply=Cf(n.apply,r)}},uM=[new rr("doc",{init(t){return t.doc||t.schema.topNodeType.createAndFill()},apply(t){return t.doc}}),new rr("selection",{init(t,e){return t.selection||I.atStart(e.doc)},apply(t){return t.selection}}),new rr("storedMarks",{init(t){return t.storedMarks||null},apply(t,e,n,r){return r.selection.$cursor?t.storedMarks:null}}),new rr("scrollToSelection",{init(){return 0},apply(t,e){return t.scrolledIntoView?e+1:e}})];function Ef(t,e,n){for(let r in t){let i=t[r];i instanceof Function?i=i.bind(e):r=="handleDOMEvents"&&(i=Ef(i,e,{})),n[r]=i}return n}var z=class{constructor(e){this.spec=e,this.props={},e.props&&Ef(e.props,this,this.props),this.key=e.key?e.key.key:vf("plugin")}getState(e){return e[this.key]}},ol=Object.create(null);function vf(t){return t in ol?t+"$"+ ++ol[t]:(ol[t]=0,t+"$")}var K=class{constructor(e="key"){this.key=vf(e)}get(e){return e.config.pluginsByKey[this.key]}getState(e){return e[this.key]}};var ue=function(t){for(var e=0;;e++)if(t=t.previousSibling,!t)return e},lr=function(t){let e=t.assignedSlot||t.parentNode;return e&&e.nodeType==11?e.host:e},pl=null,St=function(t,e,n){let r=pl||(pl=document.createRange());return r.setEnd(t,n==null?t.nodeValue.length:n),r.setStart(t,e||0),r},GE=function(){pl=null},Mn=function(t,e,n,r){return n&&(Tf(t,e,n,r,-1)||Tf(t,e,n,r,1))},YE=/^(img|br|input|textarea|hr)$/i;function Tf(t,e,n,r,i){for(var o;;){if(t==n&&e==r)return!0;if(e==(i<0?0:Je(t))){let s=t.parentNode;if(!s||s.nodeType!=1||ii(t)||YE.test(t.nodeName)||t.contentEditable=="false")return!1;e=ue(t)+(i<0?0:1),t=s}else if(t.nodeType==1){let s=t.childNodes[e+(i<0?-1:0)];if(s.nodeType==1&&s.contentEditable=="false")if(!((o=s.pmViewDesc)===null||o===void 0)&&o.ignoreForSelection)e+=i;else return!1;else t=s,e=i<0?Je(t):0}else return!1}}function Je(t){return t.nodeType==3?t.nodeValue.length:t.childNodes.length}function QE(t,e){for(;;){if(t.nodeType==3&&e)return t;if(t.nodeType==1&&e>0){if(t.contentEditable=="false")return null;t=t.childNodes[e-1],e=Je(t)}else if(t.parentNode&&!ii(t))e=ue(t),t=t.parentNode;else return null}}function XE(t,e){for(;;){if(t.nodeType==3&&e<t.nodeValue.length)return t;if(t.nodeType==1&&e<t.childNodes.length){if(t.contentEditable=="false")return null;t=t.childNodes[e],e=0}else if(t.parentNode&&!ii(t))e=ue(t)+1,t=t.parentNode;else return null}}function ZE(t,e,n){for(let r=e==0,i=e==Je(t);r||i;){if(t==n)return!0;let o=ue(t);if(t=t.parentNode,!t)return!1;r=r&&o==0,i=i&&o==Je(t)}}function ii(t){let e;for(let n=t;n&&!(e=n.pmViewDesc);n=n.parentNode);return e&&e.node&&e.node.isBlock&&(e.dom==t||e.contentDOM==t)}var Do=function(t){return t.focusNode&&Mn(t.focusNode,t.focusOffset,t.anchorNode,t.anchorOffset)};function Cn(t,e){let n=document.createEvent("Event");return n.initEvent("keydown",!0,!0),n.keyCode=t,n.key=n.code=e,n}function ev(t){let e=t.activeElement;for(;e&&e.shadowRoot;)e=e.shadowRoot.activeElement;return e}function tv(t,e,n){if(t.caretPositionFromPoint)try{let r=t.caretPositionFromPoint(e,n);if(r)return{node:r.offsetNode,offset:Math.min(Je(r.offsetNode),r.offset)}}catch{}if(t.caretRangeFromPoint){let r=t.caretRangeFromPoint(e,n);if(r)return{node:r.startContainer,offset:Math.min(Je(r.startContainer),r.startOffset)}}}var ut=typeof navigator!="undefined"?navigator:null,Sf=typeof document!="undefined"?document:null,Gt=ut&&ut.userAgent||"",fl=/Edge\/(\d+)/.exec(Gt),nh=/MSIE \d/.exec(Gt),hl=/Trident\/(?:[7-9]|\d{2,})\..*rv:(\d+)/.exec(Gt),Ie=!!(nh||hl||fl),Kt=nh?document.documentMode:hl?+hl[1]:fl?+fl[1]:0,Ge=!Ie&&/gecko\/(\d+)/i.test(Gt);Ge&&+(/Firefox\/(\d+)/.exec(Gt)||[0,0])[1];var ml=!Ie&&/Chrome\/(\d+)/.exec(Gt),ye=!!ml,rh=ml?+ml[1]:0,ve=!Ie&&!!ut&&/Apple Computer/.test(ut.vendor),cr=ve&&(/Mobile\/\w+/.test(Gt)||!!ut&&ut.maxTouchPoints>2),Ke=cr||(ut?/Mac/.test(ut.platform):!1),nv=ut?/Win/.test(ut.platform):!1,kt=/Android \d/.test(Gt),oi=!!Sf&&"webkitFontSmoothing"in Sf.documentElement.style,rv=oi?+(/\bAppleWebKit\/(\d+)/.exec(navigator.userAgent)||[0,0])[1]:0;function iv(t){let e=t.defaultView&&t.defaultView.visualViewport;return e?{left:0,right:e.width,top:0,bottom:e.height}:{left:0,right:t.documentElement.clientWidth,top:0,bottom:t.documentElement.clientHeight}}function Tt(t,e){return typeof t=="number"?t:t[e]}function ov(t){let e=t.getBoundingClientRect(),n=e.width/t.offsetWidth||1,r=e.height/t.offsetHeight||1;return{left:e.left,right:e.left+t.clientWidth*n,top:e.top,bottom:e.top+t.clientHeight*r}}function kf(t,e,n){let r=t.someProp("scrollThreshold")||0,i=t.someProp("scrollMargin")||5,o=t.dom.ownerDocument;for(let s=n||t.dom;s;){if(s.nodeType!=1){s=lr(s);continue}let a=s,l=a==o.body,c=l?iv(o):ov(a),d=0,u=0;if(e.top<c.top+Tt(r,"top")?u=-(c.top-e.top+Tt(i,"top")):e.bottom>c.bottom-Tt(r,"bottom")&&(u=e.bottom-e.top>c.bottom-c.top?e.top+Tt(i,"top")-c.top:e.bottom-c.bottom+Tt(i,"bottom")),e.left<c.left+Tt(r,"left")?d=-(c.left-e.left+Tt(i,"left")):e.right>c.right-Tt(r,"right")&&(d=e.right-c.right+Tt(i,"right")),d||u)if(l)o.defaultView.scrollBy(d,u);else{let f=a.scrollLeft,h=a.scrollTop;u&&(a.scrollTop+=u),d&&(a.scrollLeft+=d);let m=a.scrollLeft-f,g=a.scrollTop-h;e={left:e.left-m,top:e.top-g,right:e.right-m,bottom:e.bottom-g}}let p=l?"fixed":getComputedStyle(s).position;if(/^(fixed|sticky)$/.test(p))break;s=p=="absolute"?s.offsetParent:lr(s)}}function sv(t){let e=t.dom.getBoundingClientRect(),n=Math.max(0,e.top),r,i;for(let o=(e.left+e.right)/2,s=n+1;s<Math.min(innerHeight,e.bottom);s+=5){let a=t.root.elementFromPoint(o,s);if(!a||a==t.dom||!t.dom.contains(a))continue;let l=a.getBoundingClientRect();if(l.top>=n-20){r=a,i=l.top;break}}return{refDOM:r,refTop:i,stack:ih(t.dom)}}function ih(t){let e=[],n=t.ownerDocument;for(let r=t;r&&(e.push({dom:r,top:r.scrollTop,left:r.scrollLeft}),t!=n);r=lr(r));return e}function av({refDOM:t,refTop:e,stack:n}){let r=t?t.getBoundingClientRect().top:0;oh(n,r==0?0:r-e)}function oh(t,e){for(let n=0;n<t.length;n++){let{dom:r,top:i,left:o}=t[n];r.scrollTop!=i+e&&(r.scrollTop=i+e),r.scrollLeft!=o&&(r.scrollLeft=o)}}var ir=null;function lv(t){if(t.setActive)return t.setActive();if(ir)return t.focus(ir);let e=ih(t);t.focus(ir==null?{get preventScroll(){return ir={preventScroll:!0},!0}}:void 0),ir||(ir=!1,oh(e,0))}function sh(t,e){let n,r=2e8,i,o=0,s=e.top,a=e.top,l,c;for(let d=t.firstChild,u=0;d;d=d.nextSibling,u++){let p;if(d.nodeType==1)p=d.getClientRects();else if(d.nodeType==3)p=St(d).getClientRects();else continue;for(let f=0;f<p.length;f++){let h=p[f];if(h.top<=s&&h.bottom>=a){s=Math.max(h.bottom,s),a=Math.min(h.top,a);let m=h.left>e.left?h.left-e.left:h.right<e.left?e.left-h.right:0;if(m<r){n=d,r=m,i=m&&n.nodeType==3?{left:h.right<e.left?h.right:h.left,top:e.top}:e,d.nodeType==1&&m&&(o=u+(e.left>=(h.left+h.right)/2?1:0));continue}}else h.top>e.top&&!l&&h.left<=e.left&&h.right>=e.left&&(l=d,c={left:Math.max(h.left,Math.min(h.right,e.left)),top:h.top});!n&&(e.left>=h.right&&e.top>=h.top||e.left>=h.left&&e.top>=h.bottom)&&(o=u+1)}}return!n&&l&&(n=l,i=c,r=0),n&&n.nodeType==3?cv(n,i):!n||r&&n.nodeType==1?{node:t,offset:o}:sh(n,i)}function cv(t,e){let n=t.nodeValue.length,r=document.createRange();for(let i=0;i<n;i++){r.setEnd(t,i+1),r.setStart(t,i);let o=jt(r,1);if(o.top!=o.bottom&&Ol(e,o))return{node:t,offset:i+(e.left>=(o.left+o.right)/2?1:0)}}return{node:t,offset:0}}function Ol(t,e){return t.left>=e.left-1&&t.left<=e.right+1&&t.top>=e.top-1&&t.top<=e.bottom+1}function dv(t,e){let n=t.parentNode;return n&&/^li$/i.test(n.nodeName)&&e.left<t.getBoundingClientRect().left?n:t}function uv(t,e,n){let{node:r,offset:i}=sh(e,n),o=-1;if(r.nodeType==1&&!r.firstChild){let s=r.getBoundingClientRect();o=s.left!=s.right&&n.left>(s.left+s.right)/2?1:-1}return t.docView.posFromDOM(r,i,o)}function pv(t,e,n,r){let i=-1;for(let o=e,s=!1;o!=t.dom;){let a=t.docView.nearestDesc(o,!0),l;if(!a)return null;if(a.dom.nodeType==1&&(a.node.isBlock&&a.parent||!a.contentDOM)&&((l=a.dom.getBoundingClientRect()).width||l.height)&&(a.node.isBlock&&a.parent&&!/^T(R|BODY|HEAD|FOOT)$/.test(a.dom.nodeName)&&(!s&&l.left>r.left||l.top>r.top?i=a.posBefore:(!s&&l.right<r.left||l.bottom<r.top)&&(i=a.posAfter),s=!0),!a.contentDOM&&i<0&&!a.node.isText))return(a.node.isBlock?r.top<(l.top+l.bottom)/2:r.left<(l.left+l.right)/2)?a.posBefore:a.posAfter;o=a.dom.parentNode}return i>-1?i:t.docView.posFromDOM(e,n,-1)}function ah(t,e,n){let r=t.childNodes.length;if(r&&n.top<n.bottom)for(let i=Math.max(0,Math.min(r-1,Math.floor(r*(e.top-n.top)/(n.bottom-n.top))-2)),o=i;;){let s=t.childNodes[o];if(s.nodeType==1){let a=s.getClientRects();for(let l=0;l<a.length;l++){let c=a[l];if(Ol(e,c))return ah(s,e,c)}}if((o=(o+1)%r)==i)break}return t}function fv(t,e){let n=t.dom.ownerDocument,r,i=0,o=tv(n,e.left,e.top);o&&({node:r,offset:i}=o);let s=(t.root.elementFromPoint?t.root:n).elementFromPoint(e.left,e.top),a;if(!s||!t.dom.contains(s.nodeType!=1?s.parentNode:s)){let c=t.dom.getBoundingClientRect();if(!Ol(e,c)||(s=ah(t.dom,e,c),!s))return null}if(ve)for(let c=s;r&&c;c=lr(c))c.draggable&&(r=void 0);if(s=dv(s,e),r){if(Ge&&r.nodeType==1&&(i=Math.min(i,r.childNodes.length),i<r.childNodes.length)){let d=r.childNodes[i],u;d.nodeName=="IMG"&&(u=d.getBoundingClientRect()).right<=e.left&&u.bottom>e.top&&i++}let c;oi&&i&&r.nodeType==1&&(c=r.childNodes[i-1]).nodeType==1&&c.contentEditable=="false"&&c.getBoundingClientRect().top>=e.top&&i--,r==t.dom&&i==r.childNodes.length-1&&r.lastChild.nodeType==1&&e.top>r.lastChild.getBoundingClientRect().bottom?a=t.state.doc.content.size:(i==0||r.nodeType!=1||r.childNodes[i-1].nodeName!="BR")&&(a=pv(t,r,i,e))}a==null&&(a=uv(t,s,e));let l=t.docView.nearestDesc(s,!0);return{pos:a,inside:l?l.posAtStart-l.border:-1}}function Mf(t){return t.top<t.bottom||t.left<t.right}function jt(t,e){let n=t.getClientRects();if(n.length){let r=n[e<0?0:n.length-1];if(Mf(r))return r}return Array.prototype.find.call(n,Mf)||t.getBoundingClientRect()}var hv=/[\u0590-\u05f4\u0600-\u06ff\u0700-\u08ac]/;function lh(t,e,n){let{node:r,offset:i,atom:o}=t.docView.domFromPos(e,n<0?-1:1),s=oi||Ge;if(r.nodeType==3)if(s&&(hv.test(r.nodeValue)||(n<0?!i:i==r.nodeValue.length))){let l=jt(St(r,i,i),n);if(Ge&&i&&/\s/.test(r.nodeValue[i-1])&&i<r.nodeValue.length){let c=jt(St(r,i-1,i-1),-1);if(c.top==l.top){let d=jt(St(r,i,i+1),-1);if(d.top!=l.top)return Xr(d,d.left<c.left)}}return l}else{let l=i,c=i,d=n<0?1:-1;return n<0&&!i?(c++,d=-1):n>=0&&i==r.nodeValue.length?(l--,d=1):n<0?l--:c++,Xr(jt(St(r,l,c),d),d<0)}if(!t.state.doc.resolve(e-(o||0)).parent.inlineContent){if(o==null&&i&&(n<0||i==Je(r))){let l=r.childNodes[i-1];if(l.nodeType==1)return al(l.getBoundingClientRect(),!1)}if(o==null&&i<Je(r)){let l=r.childNodes[i];if(l.nodeType==1)return al(l.getBoundingClientRect(),!0)}return al(r.getBoundingClientRect(),n>=0)}if(o==null&&i&&(n<0||i==Je(r))){let l=r.childNodes[i-1],c=l.nodeType==3?St(l,Je(l)-(s?0:1)):l.nodeType==1&&(l.nodeName!="BR"||!l.nextSibling)?l:null;if(c)return Xr(jt(c,1),!1)}if(o==null&&i<Je(r)){let l=r.childNodes[i];for(;l.pmViewDesc&&l.pmViewDesc.ignoreForCoords;)l=l.nextSibling;let c=l?l.nodeType==3?St(l,0,s?0:1):l.nodeType==1?l:null:null;if(c)return Xr(jt(c,-1),!0)}return Xr(jt(r.nodeType==3?St(r):r,-n),n>=0)}function Xr(t,e){if(t.width==0)return t;let n=e?t.left:t.right;return{top:t.top,bottom:t.bottom,left:n,right:n}}function al(t,e){if(t.height==0)return t;let n=e?t.top:t.bottom;return{top:n,bottom:n,left:t.left,right:t.right}}function ch(t,e,n){let r=t.state,i=t.root.activeElement;r!=e&&t.updateState(e),i!=t.dom&&t.focus();try{return n()}finally{r!=e&&t.updateState(r),i!=t.dom&&i&&i.focus()}}function mv(t,e,n){let r=e.selection,i=n=="up"?r.$from:r.$to;return ch(t,e,()=>{let{node:o}=t.docView.domFromPos(i.pos,n=="up"?-1:1);for(;;){let a=t.docView.nearestDesc(o,!0);if(!a)break;if(a.node.isBlock){o=a.contentDOM||a.dom;break}o=a.dom.parentNode}let s=lh(t,i.pos,1);for(let a=o.firstChild;a;a=a.nextSibling){let l;if(a.nodeType==1)l=a.getClientRects();else if(a.nodeType==3)l=St(a,0,a.nodeValue.length).getClientRects();else continue;for(let c=0;c<l.length;c++){let d=l[c];if(d.bottom>d.top+1&&(n=="up"?s.top-d.top>(d.bottom-s.top)*2:d.bottom-s.bottom>(s.bottom-d.top)*2))return!1}}return!0})}var gv=/[\u0590-\u08ac]/;function yv(t,e,n){let{$head:r}=e.selection;if(!r.parent.isTextblock)return!1;let i=r.parentOffset,o=!i,s=i==r.parent.content.size,a=t.domSelection();return a?!gv.test(r.parent.textContent)||!a.modify?n=="left"||n=="backward"?o:s:ch(t,e,()=>{let{focusNode:l,focusOffset:c,anchorNode:d,anchorOffset:u}=t.domSelectionRange(),p=a.caretBidiLevel;a.modify("move",n,"character");let f=r.depth?t.docView.domAfterPos(r.before()):t.dom,{focusNode:h,focusOffset:m}=t.domSelectionRange(),g=h&&!f.contains(h.nodeType==1?h:h.parentNode)||l==h&&c==m;try{a.collapse(d,u),l&&(l!=d||c!=u)&&a.extend&&a.extend(l,c)}catch{}return p!=null&&(a.caretBidiLevel=p),g}):r.pos==r.start()||r.pos==r.end()}var wf=null,Af=null,Rf=!1;function bv(t,e,n){return wf==e&&Af==n?Rf:(wf=e,Af=n,Rf=n=="up"||n=="down"?mv(t,e,n):yv(t,e,n))}var Ye=0,Of=1,En=2,pt=3,wn=class{constructor(e,n,r,i){this.parent=e,this.children=n,this.dom=r,this.contentDOM=i,this.dirty=Ye,r.pmViewDesc=this}matchesWidget(e){return!1}matchesMark(e){return!1}matchesNode(e,n,r){return!1}matchesHack(e){return!1}parseRule(){return null}stopEvent(e){return!1}get size(){let e=0;for(let n=0;n<this.children.length;n++)e+=this.children[n].size;return e}get border(){return 0}destroy(){this.parent=void 0,this.dom.pmViewDesc==this&&(this.dom.pmViewDesc=void 0);for(let e=0;e<this.children.length;e++)this.children[e].destroy()}posBeforeChild(e){for(let n=0,r=this.posAtStart;;n++){let i=this.children[n];if(i==e)return r;r+=i.size}}get posBefore(){return this.parent.posBeforeChild(this)}get posAtStart(){return this.parent?this.parent.posBeforeChild(this)+this.border:0}get posAfter(){return this.posBefore+this.size}get posAtEnd(){return this.posAtStart+this.size-2*this.border}localPosFromDOM(e,n,r){if(this.contentDOM&&this.contentDOM.contains(e.nodeType==1?e:e.parentNode))if(r<0){let o,s;if(e==this.contentDOM)o=e.childNodes[n-1];else{for(;e.parentNode!=this.contentDOM;)e=e.parentNode;o=e.previousSibling}for(;o&&!((s=o.pmViewDesc)&&s.parent==this);)o=o.previousSibling;return o?this.posBeforeChild(s)+s.size:this.posAtStart}else{let o,s;if(e==this.contentDOM)o=e.childNodes[n];else{for(;e.parentNode!=this.contentDOM;)e=e.parentNode;o=e.nextSibling}for(;o&&!((s=o.pmViewDesc)&&s.parent==this);)o=o.nextSibling;return o?this.posBeforeChild(s):this.posAtEnd}let i;if(e==this.dom&&this.contentDOM)i=n>ue(this.contentDOM);else if(this.contentDOM&&this.contentDOM!=this.dom&&this.dom.contains(this.contentDOM))i=e.compareDocumentPosition(this.contentDOM)&2;else if(this.dom.firstChild){if(n==0)for(let o=e;;o=o.parentNode){if(o==this.dom){i=!1;break}if(o.previousSibling)break}if(i==null&&n==e.childNodes.length)for(let o=e;;o=o.parentNode){if(o==this.dom){i=!0;break}if(o.nextSibling)break}}return(i==null?r>0:i)?this.posAtEnd:this.posAtStart}nearestDesc(e,n=!1){for(let r=!0,i=e;i;i=i.parentNode){let o=this.getDesc(i),s;if(o&&(!n||o.node))if(r&&(s=o.nodeDOM)&&!(s.nodeType==1?s.contains(e.nodeType==1?e:e.parentNode):s==e))r=!1;else return o}}getDesc(e){let n=e.pmViewDesc;for(let r=n;r;r=r.parent)if(r==this)return n}posFromDOM(e,n,r){for(let i=e;i;i=i.parentNode){let o=this.getDesc(i);if(o)return o.localPosFromDOM(e,n,r)}return-1}descAt(e){for(let n=0,r=0;n<this.children.length;n++){let i=this.children[n],o=r+i.size;if(r==e&&o!=r){for(;!i.border&&i.children.length;)for(let s=0;s<i.children.length;s++){let a=i.children[s];if(a.size){i=a;break}}return i}if(e<o)return i.descAt(e-r-i.border);r=o}}domFromPos(e,n){if(!this.contentDOM)return{node:this.dom,offset:0,atom:e+1};let r=0,i=0;for(let o=0;r<this.children.length;r++){let s=this.children[r],a=o+s.size;if(a>e||s instanceof Mo){i=e-o;break}o=a}if(i)return this.children[r].domFromPos(i-this.children[r].border,n);for(let o;r&&!(o=this.children[r-1]).size&&o instanceof So&&o.side>=0;r--);if(n<=0){let o,s=!0;for(;o=r?this.children[r-1]:null,!(!o||o.dom.parentNode==this.contentDOM);r--,s=!1);return o&&n&&s&&!o.border&&!o.domAtom?o.domFromPos(o.size,n):{node:this.contentDOM,offset:o?ue(o.dom)+1:0}}else{let o,s=!0;for(;o=r<this.children.length?this.children[r]:null,!(!o||o.dom.parentNode==this.contentDOM);r++,s=!1);return o&&s&&!o.border&&!o.domAtom?o.domFromPos(0,n):{node:this.contentDOM,offset:o?ue(o.dom):this.contentDOM.childNodes.length}}}parseRange(e,n,r=0){if(this.children.length==0)return{node:this.contentDOM,from:e,to:n,fromOffset:0,toOffset:this.contentDOM.childNodes.length};let i=-1,o=-1;for(let s=r,a=0;;a++){let l=this.children[a],c=s+l.size;if(i==-1&&e<=c){let d=s+l.border;if(e>=d&&n<=c-l.border&&l.node&&l.contentDOM&&this.contentDOM.contains(l.contentDOM))return l.parseRange(e,n,d);e=s;for(let u=a;u>0;u--){let p=this.children[u-1];if(p.size&&p.dom.parentNode==this.contentDOM&&!p.emptyChildAt(1)){i=ue(p.dom)+1;break}e-=p.size}i==-1&&(i=0)}if(i>-1&&(c>n||a==this.children.length-1)){n=c;for(let d=a+1;d<this.children.length;d++){let u=this.children[d];if(u.size&&u.dom.parentNode==this.contentDOM&&!u.emptyChildAt(-1)){o=ue(u.dom);break}n+=u.size}o==-1&&(o=this.contentDOM.childNodes.length);break}s=c}return{node:this.contentDOM,from:e,to:n,fromOffset:i,toOffset:o}}emptyChildAt(e){if(this.border||!this.contentDOM||!this.children.length)return!1;let n=this.children[e<0?0:this.children.length-1];return n.size==0||n.emptyChildAt(e)}domAfterPos(e){let{node:n,offset:r}=this.domFromPos(e,0);if(n.nodeType!=1||r==n.childNodes.length)throw new RangeError("No node after pos "+e);return n.childNodes[r]}setSelection(e,n,r,i=!1){let o=Math.min(e,n),s=Math.max(e,n);for(let f=0,h=0;f<this.children.length;f++){let m=this.children[f],g=h+m.size;if(o>h&&s<g)return m.setSelection(e-h-m.border,n-h-m.border,r,i);h=g}let a=this.domFromPos(e,e?-1:1),l=n==e?a:this.domFromPos(n,n?-1:1),c=r.root.getSelection(),d=r.domSelectionRange(),u=!1;if((Ge||ve)&&e==n){let{node:f,offset:h}=a;if(f.nodeType==3){if(u=!!(h&&f.nodeValue[h-1]==`
+`),u&&h==f.nodeValue.length)for(let m=f,g;m;m=m.parentNode){if(g=m.nextSibling){g.nodeName=="BR"&&(a=l={node:g.parentNode,offset:ue(g)+1});break}let y=m.pmViewDesc;if(y&&y.node&&y.node.isBlock)break}}else{let m=f.childNodes[h-1];u=m&&(m.nodeName=="BR"||m.contentEditable=="false")}}if(Ge&&d.focusNode&&d.focusNode!=l.node&&d.focusNode.nodeType==1){let f=d.focusNode.childNodes[d.focusOffset];f&&f.contentEditable=="false"&&(i=!0)}if(!(i||u&&ve)&&Mn(a.node,a.offset,d.anchorNode,d.anchorOffset)&&Mn(l.node,l.offset,d.focusNode,d.focusOffset))return;let p=!1;if((c.extend||e==n)&&!(u&&Ge)){c.collapse(a.node,a.offset);try{e!=n&&c.extend(l.node,l.offset),p=!0}catch{}}if(!p){if(e>n){let h=a;a=l,l=h}let f=document.createRange();f.setEnd(l.node,l.offset),f.setStart(a.node,a.offset),c.removeAllRanges(),c.addRange(f)}}ignoreMutation(e){return!this.contentDOM&&e.type!="selection"}get contentLost(){return this.contentDOM&&this.contentDOM!=this.dom&&!this.dom.contains(this.contentDOM)}markDirty(e,n){for(let r=0,i=0;i<this.children.length;i++){let o=this.children[i],s=r+o.size;if(r==s?e<=s&&n>=r:e<s&&n>r){let a=r+o.border,l=s-o.border;if(e>=a&&n<=l){this.dirty=e==r||n==s?En:Of,e==a&&n==l&&(o.contentLost||o.dom.parentNode!=this.contentDOM)?o.dirty=pt:o.markDirty(e-a,n-a);return}else o.dirty=o.dom==o.contentDOM&&o.dom.parentNode==this.contentDOM&&!o.children.length?En:pt}r=s}this.dirty=En}markParentsDirty(){let e=1;for(let n=this.parent;n;n=n.parent,e++){let r=e==1?En:Of;n.dirty<r&&(n.dirty=r)}}get domAtom(){return!1}get ignoreForCoords(){return!1}get ignoreForSelection(){return!1}isText(e){return!1}},So=class extends wn{constructor(e,n,r,i){let o,s=n.type.toDOM;if(typeof s=="function"&&(s=s(r,()=>{if(!o)return i;if(o.parent)return o.parent.posBeforeChild(o)})),!n.type.spec.raw){if(s.nodeType!=1){let a=document.createElement("span");a.appendChild(s),s=a}s.contentEditable="false",s.classList.add("ProseMirror-widget")}super(e,[],s,null),this.widget=n,this.widget=n,o=this}matchesWidget(e){return this.dirty==Ye&&e.type.eq(this.widget.type)}parseRule(){return{ignore:!0}}stopEvent(e){let n=this.widget.spec.stopEvent;return n?n(e):!1}ignoreMutation(e){return e.type!="selection"||this.widget.spec.ignoreSelection}destroy(){this.widget.type.destroy(this.dom),super.destroy()}get domAtom(){return!0}get ignoreForSelection(){return!!this.widget.type.spec.relaxedSide}get side(){return this.widget.type.side}},gl=class extends wn{constructor(e,n,r,i){super(e,[],n,null),this.textDOM=r,this.text=i}get size(){return this.text.length}localPosFromDOM(e,n){return e!=this.textDOM?this.posAtStart+(n?this.size:0):this.posAtStart+n}domFromPos(e){return{node:this.textDOM,offset:e}}ignoreMutation(e){return e.type==="characterData"&&e.target.nodeValue==e.oldValue}},dr=class t extends wn{constructor(e,n,r,i,o){super(e,[],r,i),this.mark=n,this.spec=o}static create(e,n,r,i){let o=i.nodeViews[n.type.name],s=o&&o(n,i,r);return(!s||!s.dom)&&(s=_t.renderSpec(document,n.type.spec.toDOM(n,r),null,n.attrs)),new t(e,n,s.dom,s.contentDOM||s.dom,s)}parseRule(){return this.dirty&pt||this.mark.type.spec.reparseInView?null:{mark:this.mark.type.name,attrs:this.mark.attrs,contentElement:this.contentDOM}}matchesMark(e){return this.dirty!=pt&&this.mark.eq(e)}markDirty(e,n){if(super.markDirty(e,n),this.dirty!=Ye){let r=this.parent;for(;!r.node;)r=r.parent;r.dirty<this.dirty&&(r.dirty=this.dirty),this.dirty=Ye}}slice(e,n,r){let i=t.create(this.parent,this.mark,!0,r),o=this.children,s=this.size;n<s&&(o=Cl(o,n,s,r)),e>0&&(o=Cl(o,0,e,r));for(let a=0;a<o.length;a++)o[a].parent=i;return i.children=o,i}ignoreMutation(e){return this.spec.ignoreMutation?this.spec.ignoreMutation(e):super.ignoreMutation(e)}destroy(){this.spec.destroy&&this.spec.destroy(),super.destroy()}},Jt=class t extends wn{constructor(e,n,r,i,o,s,a,l,c){super(e,[],o,s),this.node=n,this.outerDeco=r,this.innerDeco=i,this.nodeDOM=a}static create(e,n,r,i,o,s){let a=o.nodeViews[n.type.name],l,c=a&&a(n,o,()=>{if(!l)return s;if(l.parent)return l.parent.posBeforeChild(l)},r,i),d=c&&c.dom,u=c&&c.contentDOM;if(n.isText){if(!d)d=document.createTextNode(n.text);else if(d.nodeType!=3)throw new RangeError("Text must be rendered as a DOM text node")}else d||({dom:d,contentDOM:u}=_t.renderSpec(document,n.type.spec.toDOM(n),null,n.attrs));!u&&!n.isText&&d.nodeName!="BR"&&(d.hasAttribute("contenteditable")||(d.contentEditable="false"),n.type.spec.draggable&&(d.draggable=!0));let p=d;return d=ph(d,r,n),c?l=new yl(e,n,r,i,d,u||null,p,c,o,s+1):n.isText?new ko(e,n,r,i,d,p,o):new t(e,n,r,i,d,u||null,p,o,s+1)}parseRule(){if(this.node.type.spec.reparseInView)return null;let e={node:this.node.type.name,attrs:this.node.attrs};if(this.node.type.whitespace=="pre"&&(e.preserveWhitespace="full"),!this.contentDOM)e.getContent=()=>this.node.content;else if(!this.contentLost)e.contentElement=this.contentDOM;else{for(let n=this.children.length-1;n>=0;n--){let r=this.children[n];if(this.dom.contains(r.dom.parentNode)){e.contentElement=r.dom.parentNode;break}}e.contentElement||(e.getContent=()=>E.empty)}return e}matchesNode(e,n,r){return this.dirty==Ye&&e.eq(this.node)&&wo(n,this.outerDeco)&&r.eq(this.innerDeco)}get size(){return this.node.nodeSize}get border(){return this.node.isLeaf?0:1}updateChildren(e,n){let r=this.node.inlineContent,i=n,o=e.composing?this.localCompositionInfo(e,n):null,s=o&&o.pos>-1?o:null,a=o&&o.pos<0,l=new xl(this,s&&s.node,e);vv(this.node,this.innerDeco,(c,d,u)=>{c.spec.marks?l.syncToMarks(c.spec.marks,r,e):c.type.side>=0&&!u&&l.syncToMarks(d==this.node.childCount?W.none:this.node.child(d).marks,r,e),l.placeWidget(c,e,i)},(c,d,u,p)=>{l.syncToMarks(c.marks,r,e);let f;l.findNodeMatch(c,d,u,p)||a&&e.state.selection.from>i&&e.state.selection.to<i+c.nodeSize&&(f=l.findIndexWithChild(o.node))>-1&&l.updateNodeAt(c,d,u,f,e)||l.updateNextNode(c,d,u,e,p,i)||l.addNode(c,d,u,e,i),i+=c.nodeSize}),l.syncToMarks([],r,e),this.node.isTextblock&&l.addTextblockHacks(),l.destroyRest(),(l.changed||this.dirty==En)&&(s&&this.protectLocalComposition(e,s),dh(this.contentDOM,this.children,e),cr&&Tv(this.dom))}localCompositionInfo(e,n){let{from:r,to:i}=e.state.selection;if(!(e.state.selection instanceof O)||r<n||i>n+this.node.content.size)return null;let o=e.input.compositionNode;if(!o||!this.dom.contains(o.parentNode))return null;if(this.node.inlineContent){let s=o.nodeValue,a=Sv(this.node.content,s,r-n,i-n);return a<0?null:{node:o,pos:a,text:s}}else return{node:o,pos:-1,text:""}}protectLocalComposition(e,{node:n,pos:r,text:i}){if(this.getDesc(n))return;let o=n;for(;o.parentNode!=this.contentDOM;o=o.parentNode){for(;o.previousSibling;)o.parentNode.removeChild(o.previousSibling);for(;o.nextSibling;)o.parentNode.removeChild(o.nextSibling);o.pmViewDesc&&(o.pmViewDesc=void 0)}let s=new gl(this,o,n,i);e.input.compositionNodes.push(s),this.children=Cl(this.children,r,r+i.length,e,s)}update(e,n,r,i){return this.dirty==pt||!e.sameMarkup(this.node)?!1:(this.updateInner(e,n,r,i),!0)}updateInner(e,n,r,i){this.updateOuterDeco(n),this.node=e,this.innerDeco=r,this.contentDOM&&this.updateChildren(i,this.posAtStart),this.dirty=Ye}updateOuterDeco(e){if(wo(e,this.outerDeco))return;let n=this.nodeDOM.nodeType!=1,r=this.dom;this.dom=uh(this.dom,this.nodeDOM,bl(this.outerDeco,this.node,n),bl(e,this.node,n)),this.dom!=r&&(r.pmViewDesc=void 0,this.dom.pmViewDesc=this),this.outerDeco=e}selectNode(){this.nodeDOM.nodeType==1&&(this.nodeDOM.classList.add("ProseMirror-selectednode"),(this.contentDOM||!this.node.type.spec.draggable)&&(this.nodeDOM.draggable=!0))}deselectNode(){this.nodeDOM.nodeType==1&&(this.nodeDOM.classList.remove("ProseMirror-selectednode"),(this.contentDOM||!this.node.type.spec.draggable)&&this.nodeDOM.removeAttribute("draggable"))}get domAtom(){return this.node.isAtom}};function Lf(t,e,n,r,i){ph(r,e,t);let o=new Jt(void 0,t,e,n,r,r,r,i,0);return o.contentDOM&&o.updateChildren(i,0),o}var ko=class t extends Jt{constructor(e,n,r,i,o,s,a){super(e,n,r,i,o,null,s,a,0)}parseRule(){let e=this.nodeDOM.parentNode;for(;e&&e!=this.dom&&!e.pmIsDeco;)e=e.parentNode;return{skip:e||!0}}update(e,n,r,i){return this.dirty==pt||this.dirty!=Ye&&!this.inParent()||!e.sameMarkup(this.node)?!1:(this.updateOuterDeco(n),(this.dirty!=Ye||e.text!=this.node.text)&&e.text!=this.nodeDOM.nodeValue&&(this.nodeDOM.nodeValue=e.text,i.trackWrites==this.nodeDOM&&(i.trackWrites=null)),this.node=e,this.dirty=Ye,!0)}inParent(){let e=this.parent.contentDOM;for(let n=this.nodeDOM;n;n=n.parentNode)if(n==e)return!0;return!1}domFromPos(e){return{node:this.nodeDOM,offset:e}}localPosFromDOM(e,n,r){return e==this.nodeDOM?this.posAtStart+Math.min(n,this.node.text.length):super.localPosFromDOM(e,n,r)}ignoreMutation(e){return e.type!="characterData"&&e.type!="selection"}slice(e,n,r){let i=this.node.cut(e,n),o=document.createTextNode(i.text);return new t(this.parent,i,this.outerDeco,this.innerDeco,o,o,r)}markDirty(e,n){super.markDirty(e,n),this.dom!=this.nodeDOM&&(e==0||n==this.nodeDOM.nodeValue.length)&&(this.dirty=pt)}get domAtom(){return!1}isText(e){return this.node.text==e}},Mo=class extends wn{parseRule(){return{ignore:!0}}matchesHack(e){return this.dirty==Ye&&this.dom.nodeName==e}get domAtom(){return!0}get ignoreForCoords(){return this.dom.nodeName=="IMG"}},yl=class extends Jt{constructor(e,n,r,i,o,s,a,l,c,d){super(e,n,r,i,o,s,a,c,d),this.spec=l}update(e,n,r,i){if(this.dirty==pt)return!1;if(this.spec.update&&(this.node.type==e.type||this.spec.multiType)){let o=this.spec.update(e,n,r);return o&&this.updateInner(e,n,r,i),o}else return!this.contentDOM&&!e.isLeaf?!1:super.update(e,n,r,i)}selectNode(){this.spec.selectNode?this.spec.selectNode():super.selectNode()}deselectNode(){this.spec.deselectNode?this.spec.deselectNode():super.deselectNode()}setSelection(e,n,r,i){this.spec.setSelection?this.spec.setSelection(e,n,r.root):super.setSelection(e,n,r,i)}destroy(){this.spec.destroy&&this.spec.destroy(),super.destroy()}stopEvent(e){return this.spec.stopEvent?this.spec.stopEvent(e):!1}ignoreMutation(e){return this.spec.ignoreMutation?this.spec.ignoreMutation(e):super.ignoreMutation(e)}};function dh(t,e,n){let r=t.firstChild,i=!1;for(let o=0;o<e.length;o++){let s=e[o],a=s.dom;if(a.parentNode==t){for(;a!=r;)r=Nf(r),i=!0;r=r.nextSibling}else i=!0,t.insertBefore(a,r);if(s instanceof dr){let l=r?r.previousSibling:t.lastChild;dh(s.contentDOM,s.children,n),r=l?l.nextSibling:t.firstChild}}for(;r;)r=Nf(r),i=!0;i&&n.trackWrites==t&&(n.trackWrites=null)}var Zr=function(t){t&&(this.nodeName=t)};Zr.prototype=Object.create(null);var vn=[new Zr];function bl(t,e,n){if(t.length==0)return vn;let r=n?vn[0]:new Zr,i=[r];for(let o=0;o<t.length;o++){let s=t[o].type.attrs;if(s){s.nodeName&&i.push(r=new Zr(s.nodeName));for(let a in s){let l=s[a];l!=null&&(n&&i.length==1&&i.push(r=new Zr(e.isInline?"span":"div")),a=="class"?r.class=(r.class?r.class+" ":"")+l:a=="style"?r.style=(r.style?r.style+";":"")+l:a!="nodeName"&&(r[a]=l))}}}return i}function uh(t,e,n,r){if(n==vn&&r==vn)return e;let i=e;for(let o=0;o<r.length;o++){let s=r[o],a=n[o];if(o){let l;a&&a.nodeName==s.nodeName&&i!=t&&(l=i.parentNode)&&l.nodeName.toLowerCase()==s.nodeName||(l=document.createElement(s.nodeName),l.pmIsDeco=!0,l.appendChild(i),a=vn[0]),i=l}xv(i,a||vn[0],s)}return i}function xv(t,e,n){for(let r in e)r!="class"&&r!="style"&&r!="nodeName"&&!(r in n)&&t.removeAttribute(r);for(let r in n)r!="class"&&r!="style"&&r!="nodeName"&&n[r]!=e[r]&&t.setAttribute(r,n[r]);if(e.class!=n.class){let r=e.class?e.class.split(" ").filter(Boolean):[],i=n.class?n.class.split(" ").filter(Boolean):[];for(let o=0;o<r.length;o++)i.indexOf(r[o])==-1&&t.classList.remove(r[o]);for(let o=0;o<i.length;o++)r.indexOf(i[o])==-1&&t.classList.add(i[o]);t.classList.length==0&&t.removeAttribute("class")}if(e.style!=n.style){if(e.style){let r=/\s*([\w\-\xa1-\uffff]+)\s*:(?:"(?:\\.|[^"])*"|'(?:\\.|[^'])*'|\(.*?\)|[^;])*/g,i;for(;i=r.exec(e.style);)t.style.removeProperty(i[1])}n.style&&(t.style.cssText+=n.style)}}function ph(t,e,n){return uh(t,t,vn,bl(e,n,t.nodeType!=1))}function wo(t,e){if(t.length!=e.length)return!1;for(let n=0;n<t.length;n++)if(!t[n].type.eq(e[n].type))return!1;return!0}function Nf(t){let e=t.nextSibling;return t.parentNode.removeChild(t),e}var xl=class{constructor(e,n,r){this.lock=n,this.view=r,this.index=0,this.stack=[],this.changed=!1,this.top=e,this.preMatch=Cv(e.node.content,e)}destroyBetween(e,n){if(e!=n){for(let r=e;r<n;r++)this.top.children[r].destroy();this.top.children.splice(e,n-e),this.changed=!0}}destroyRest(){this.destroyBetween(this.index,this.top.children.length)}syncToMarks(e,n,r){let i=0,o=this.stack.length>>1,s=Math.min(o,e.length);for(;i<s&&(i==o-1?this.top:this.stack[i+1<<1]).matchesMark(e[i])&&e[i].type.spec.spanning!==!1;)i++;for(;i<o;)this.destroyRest(),this.top.dirty=Ye,this.index=this.stack.pop(),this.top=this.stack.pop(),o--;for(;o<e.length;){this.stack.push(this.top,this.index+1);let a=-1;for(let l=this.index;l<Math.min(this.index+3,this.top.children.length);l++){let c=this.top.children[l];if(c.matchesMark(e[o])&&!this.isLocked(c.dom)){a=l;break}}if(a>-1)a>this.index&&(this.changed=!0,this.destroyBetween(this.index,a)),this.top=this.top.children[this.index];else{let l=dr.create(this.top,e[o],n,r);this.top.children.splice(this.index,0,l),this.top=l,this.changed=!0}this.index=0,o++}}findNodeMatch(e,n,r,i){let o=-1,s;if(i>=this.preMatch.index&&(s=this.preMatch.matches[i-this.preMatch.index]).parent==this.top&&s.matchesNode(e,n,r))o=this.top.children.indexOf(s,this.index);else for(let a=this.index,l=Math.min(this.top.children.length,a+5);a<l;a++){let c=this.top.children[a];if(c.matchesNode(e,n,r)&&!this.preMatch.matched.has(c)){o=a;break}}return o<0?!1:(this.destroyBetween(this.index,o),this.index++,!0)}updateNodeAt(e,n,r,i,o){let s=this.top.children[i];return s.dirty==pt&&s.dom==s.contentDOM&&(s.dirty=En),s.update(e,n,r,o)?(this.destroyBetween(this.index,i),this.index++,!0):!1}findIndexWithChild(e){for(;;){let n=e.parentNode;if(!n)return-1;if(n==this.top.contentDOM){let r=e.pmViewDesc;if(r){for(let i=this.index;i<this.top.children.length;i++)if(this.top.children[i]==r)return i}return-1}e=n}}updateNextNode(e,n,r,i,o,s){for(let a=this.index;a<this.top.children.length;a++){let l=this.top.children[a];if(l instanceof Jt){let c=this.preMatch.matched.get(l);if(c!=null&&c!=o)return!1;let d=l.dom,u,p=this.isLocked(d)&&!(e.isText&&l.node&&l.node.isText&&l.nodeDOM.nodeValue==e.text&&l.dirty!=pt&&wo(n,l.outerDeco));if(!p&&l.update(e,n,r,i))return this.destroyBetween(this.index,a),l.dom!=d&&(this.changed=!0),this.index++,!0;if(!p&&(u=this.recreateWrapper(l,e,n,r,i,s)))return this.destroyBetween(this.index,a),this.top.children[this.index]=u,u.contentDOM&&(u.dirty=En,u.updateChildren(i,s+1),u.dirty=Ye),this.changed=!0,this.index++,!0;break}}return!1}recreateWrapper(e,n,r,i,o,s){if(e.dirty||n.isAtom||!e.children.length||!e.node.content.eq(n.content)||!wo(r,e.outerDeco)||!i.eq(e.innerDeco))return null;let a=Jt.create(this.top,n,r,i,o,s);if(a.contentDOM){a.children=e.children,e.children=[];for(let l of a.children)l.parent=a}return e.destroy(),a}addNode(e,n,r,i,o){let s=Jt.create(this.top,e,n,r,i,o);s.contentDOM&&s.updateChildren(i,o+1),this.top.children.splice(this.index++,0,s),this.changed=!0}placeWidget(e,n,r){let i=this.index<this.top.children.length?this.top.children[this.index]:null;if(i&&i.matchesWidget(e)&&(e==i.widget||!i.widget.type.toDOM.parentNode))this.index++;else{let o=new So(this.top,e,n,r);this.top.children.splice(this.index++,0,o),this.changed=!0}}addTextblockHacks(){let e=this.top.children[this.index-1],n=this.top;for(;e instanceof dr;)n=e,e=n.children[n.children.length-1];(!e||!(e instanceof ko)||/\n$/.test(e.node.text)||this.view.requiresGeckoHackNode&&/\s$/.test(e.node.text))&&((ve||ye)&&e&&e.dom.contentEditable=="false"&&this.addHackNode("IMG",n),this.addHackNode("BR",this.top))}addHackNode(e,n){if(n==this.top&&this.index<n.children.length&&n.children[this.index].matchesHack(e))this.index++;else{let r=document.createElement(e);e=="IMG"&&(r.className="ProseMirror-separator",r.alt=""),e=="BR"&&(r.className="ProseMirror-trailingBreak");let i=new Mo(this.top,[],r,null);n!=this.top?n.children.push(i):n.children.splice(this.index++,0,i),this.changed=!0}}isLocked(e){return this.lock&&(e==this.lock||e.nodeType==1&&e.contains(this.lock.parentNode))}};function Cv(t,e){let n=e,r=n.children.length,i=t.childCount,o=new Map,s=[];e:for(;i>0;){let a;for(;;)if(r){let c=n.children[r-1];if(c instanceof dr)n=c,r=c.children.length;else{a=c,r--;break}}else{if(n==e)break e;r=n.parent.children.indexOf(n),n=n.parent}let l=a.node;if(l){if(l!=t.child(i-1))break;--i,o.set(a,i),s.push(a)}}return{index:i,matched:o,matches:s.reverse()}}function Ev(t,e){return t.type.side-e.type.side}function vv(t,e,n,r){let i=e.locals(t),o=0;if(i.length==0){for(let c=0;c<t.childCount;c++){let d=t.child(c);r(d,i,e.forChild(o,d),c),o+=d.nodeSize}return}let s=0,a=[],l=null;for(let c=0;;){let d,u;for(;s<i.length&&i[s].to==o;){let g=i[s++];g.widget&&(d?(u||(u=[d])).push(g):d=g)}if(d)if(u){u.sort(Ev);for(let g=0;g<u.length;g++)n(u[g],c,!!l)}else n(d,c,!!l);let p,f;if(l)f=-1,p=l,l=null;else if(c<t.childCount)f=c,p=t.child(c++);else break;for(let g=0;g<a.length;g++)a[g].to<=o&&a.splice(g--,1);for(;s<i.length&&i[s].from<=o&&i[s].to>o;)a.push(i[s++]);let h=o+p.nodeSize;if(p.isText){let g=h;s<i.length&&i[s].from<g&&(g=i[s].from);for(let y=0;y<a.length;y++)a[y].to<g&&(g=a[y].to);g<h&&(l=p.cut(g-o),p=p.cut(0,g-o),h=g,f=-1)}else for(;s<i.length&&i[s].to<h;)s++;let m=p.isInline&&!p.isLeaf?a.filter(g=>!g.inline):a.slice();r(p,m,e.forChild(o,p),f),o=h}}function Tv(t){if(t.nodeName=="UL"||t.nodeName=="OL"){let e=t.style.cssText;t.style.cssText=e+"; list-style: square !important",window.getComputedStyle(t).listStyle,t.style.cssText=e}}function Sv(t,e,n,r){for(let i=0,o=0;i<t.childCount&&o<=r;){let s=t.child(i++),a=o;if(o+=s.nodeSize,!s.isText)continue;let l=s.text;for(;i<t.childCount;){let c=t.child(i++);if(o+=c.nodeSize,!c.isText)break;l+=c.text}if(o>=n){if(o>=r&&l.slice(r-e.length-a,r-a)==e)return r-e.length;let c=a<r?l.lastIndexOf(e,r-a-1):-1;if(c>=0&&c+e.length+a>=n)return a+c;if(n==r&&l.length>=r+e.length-a&&l.slice(r-a,r-a+e.length)==e)return r}}return-1}function Cl(t,e,n,r,i){let o=[];for(let s=0,a=0;s<t.length;s++){let l=t[s],c=a,d=a+=l.size;c>=n||d<=e?o.push(l):(c<e&&o.push(l.slice(0,e-c,r)),i&&(o.push(i),i=void 0),d>n&&o.push(l.slice(n-c,l.size,r)))}return o}function Ll(t,e=null){let n=t.domSelectionRange(),r=t.state.doc;if(!n.focusNode)return null;let i=t.docView.nearestDesc(n.focusNode),o=i&&i.size==0,s=t.docView.posFromDOM(n.focusNode,n.focusOffset,1);if(s<0)return null;let a=r.resolve(s),l,c;if(Do(n)){for(l=s;i&&!i.node;)i=i.parent;let u=i.node;if(i&&u.isAtom&&A.isSelectable(u)&&i.parent&&!(u.isInline&&ZE(n.focusNode,n.focusOffset,i.dom))){let p=i.posBefore;c=new A(s==p?a:r.resolve(p))}}else{if(n instanceof t.dom.ownerDocument.defaultView.Selection&&n.rangeCount>1){let u=s,p=s;for(let f=0;f<n.rangeCount;f++){let h=n.getRangeAt(f);u=Math.min(u,t.docView.posFromDOM(h.startContainer,h.startOffset,1)),p=Math.max(p,t.docView.posFromDOM(h.endContainer,h.endOffset,-1))}if(u<0)return null;[l,s]=p==t.state.selection.anchor?[p,u]:[u,p],a=r.resolve(s)}else l=t.docView.posFromDOM(n.anchorNode,n.anchorOffset,1);if(l<0)return null}let d=r.resolve(l);if(!c){let u=e=="pointer"||t.state.selection.head<a.pos&&!o?1:-1;c=Nl(t,d,a,u)}return c}function fh(t){return t.editable?t.hasFocus():mh(t)&&document.activeElement&&document.activeElement.contains(t.dom)}function Mt(t,e=!1){let n=t.state.selection;if(hh(t,n),!!fh(t)){if(!e&&t.input.mouseDown&&t.input.mouseDown.allowDefault&&ye){let r=t.domSelectionRange(),i=t.domObserver.currentSelection;if(r.anchorNode&&i.anchorNode&&Mn(r.anchorNode,r.anchorOffset,i.anchorNode,i.anchorOffset)){t.input.mouseDown.delayedSelectionSync=!0,t.domObserver.setCurSelection();return}}if(t.domObserver.disconnectSelection(),t.cursorWrapper)Mv(t);else{let{anchor:r,head:i}=n,o,s;If&&!(n instanceof O)&&(n.$from.parent.inlineContent||(o=Pf(t,n.from)),!n.empty&&!n.$from.parent.inlineContent&&(s=Pf(t,n.to))),t.docView.setSelection(r,i,t,e),If&&(o&&Df(o),s&&Df(s)),n.visible?t.dom.classList.remove("ProseMirror-hideselection"):(t.dom.classList.add("ProseMirror-hideselection"),"onselectionchange"in document&&kv(t))}t.domObserver.setCurSelection(),t.domObserver.connectSelection()}}var If=ve||ye&&rh<63;function Pf(t,e){let{node:n,offset:r}=t.docView.domFromPos(e,0),i=r<n.childNodes.length?n.childNodes[r]:null,o=r?n.childNodes[r-1]:null;if(ve&&i&&i.contentEditable=="false")return ll(i);if((!i||i.contentEditable=="false")&&(!o||o.contentEditable=="false")){if(i)return ll(i);if(o)return ll(o)}}function ll(t){return t.contentEditable="true",ve&&t.draggable&&(t.draggable=!1,t.wasDraggable=!0),t}function Df(t){t.contentEditable="false",t.wasDraggable&&(t.draggable=!0,t.wasDraggable=null)}function kv(t){let e=t.dom.ownerDocument;e.removeEventListener("selectionchange",t.input.hideSelectionGuard);let n=t.domSelectionRange(),r=n.anchorNode,i=n.anchorOffset;e.addEventListener("selectionchange",t.input.hideSelectionGuard=()=>{(n.anchorNode!=r||n.anchorOffset!=i)&&(e.removeEventListener("selectionchange",t.input.hideSelectionGuard),setTimeout(()=>{(!fh(t)||t.state.selection.visible)&&t.dom.classList.remove("ProseMirror-hideselection")},20))})}function Mv(t){let e=t.domSelection();if(!e)return;let n=t.cursorWrapper.dom,r=n.nodeName=="IMG";r?e.collapse(n.parentNode,ue(n)+1):e.collapse(n,0),!r&&!t.state.selection.visible&&Ie&&Kt<=11&&(n.disabled=!0,n.disabled=!1)}function hh(t,e){if(e instanceof A){let n=t.docView.descAt(e.from);n!=t.lastSelectedViewDesc&&(Hf(t),n&&n.selectNode(),t.lastSelectedViewDesc=n)}else Hf(t)}function Hf(t){t.lastSelectedViewDesc&&(t.lastSelectedViewDesc.parent&&t.lastSelectedViewDesc.deselectNode(),t.lastSelectedViewDesc=void 0)}function Nl(t,e,n,r){return t.someProp("createSelectionBetween",i=>i(t,e,n))||O.between(e,n,r)}function Ff(t){return t.editable&&!t.hasFocus()?!1:mh(t)}function mh(t){let e=t.domSelectionRange();if(!e.anchorNode)return!1;try{return t.dom.contains(e.anchorNode.nodeType==3?e.anchorNode.parentNode:e.anchorNode)&&(t.editable||t.dom.contains(e.focusNode.nodeType==3?e.focusNode.parentNode:e.focusNode))}catch{return!1}}function wv(t){let e=t.docView.domFromPos(t.state.selection.anchor,0),n=t.domSelectionRange();return Mn(e.node,e.offset,n.anchorNode,n.anchorOffset)}function El(t,e){let{$anchor:n,$head:r}=t.selection,i=e>0?n.max(r):n.min(r),o=i.parent.inlineContent?i.depth?t.doc.resolve(e>0?i.after():i.before()):null:i;return o&&I.findFrom(o,e)}function Wt(t,e){return t.dispatch(t.state.tr.setSelection(e).scrollIntoView()),!0}function Bf(t,e,n){let r=t.state.selection;if(r instanceof O)if(n.indexOf("s")>-1){let{$head:i}=r,o=i.textOffset?null:e<0?i.nodeBefore:i.nodeAfter;if(!o||o.isText||!o.isLeaf)return!1;let s=t.state.doc.resolve(i.pos+o.nodeSize*(e<0?-1:1));return Wt(t,new O(r.$anchor,s))}else if(r.empty){if(t.endOfTextblock(e>0?"forward":"backward")){let i=El(t.state,e);return i&&i instanceof A?Wt(t,i):!1}else if(!(Ke&&n.indexOf("m")>-1)){let i=r.$head,o=i.textOffset?null:e<0?i.nodeBefore:i.nodeAfter,s;if(!o||o.isText)return!1;let a=e<0?i.pos-o.nodeSize:i.pos;return o.isAtom||(s=t.docView.descAt(a))&&!s.contentDOM?A.isSelectable(o)?Wt(t,new A(e<0?t.state.doc.resolve(i.pos-o.nodeSize):i)):oi?Wt(t,new O(t.state.doc.resolve(e<0?a:a+o.nodeSize))):!1:!1}}else return!1;else{if(r instanceof A&&r.node.isInline)return Wt(t,new O(e>0?r.$to:r.$from));{let i=El(t.state,e);return i?Wt(t,i):!1}}}function Ao(t){return t.nodeType==3?t.nodeValue.length:t.childNodes.length}function ei(t,e){let n=t.pmViewDesc;return n&&n.size==0&&(e<0||t.nextSibling||t.nodeName!="BR")}function or(t,e){return e<0?Av(t):Rv(t)}function Av(t){let e=t.domSelectionRange(),n=e.focusNode,r=e.focusOffset;if(!n)return;let i,o,s=!1;for(Ge&&n.nodeType==1&&r<Ao(n)&&ei(n.childNodes[r],-1)&&(s=!0);;)if(r>0){if(n.nodeType!=1)break;{let a=n.childNodes[r-1];if(ei(a,-1))i=n,o=--r;else if(a.nodeType==3)n=a,r=n.nodeValue.length;else break}}else{if(gh(n))break;{let a=n.previousSibling;for(;a&&ei(a,-1);)i=n.parentNode,o=ue(a),a=a.previousSibling;if(a)n=a,r=Ao(n);else{if(n=n.parentNode,n==t.dom)break;r=0}}}s?vl(t,n,r):i&&vl(t,i,o)}function Rv(t){let e=t.domSelectionRange(),n=e.focusNode,r=e.focusOffset;if(!n)return;let i=Ao(n),o,s;for(;;)if(r<i){if(n.nodeType!=1)break;let a=n.childNodes[r];if(ei(a,1))o=n,s=++r;else break}else{if(gh(n))break;{let a=n.nextSibling;for(;a&&ei(a,1);)o=a.parentNode,s=ue(a)+1,a=a.nextSibling;if(a)n=a,r=0,i=Ao(n);else{if(n=n.parentNode,n==t.dom)break;r=i=0}}}o&&vl(t,o,s)}function gh(t){let e=t.pmViewDesc;return e&&e.node&&e.node.isBlock}function Ov(t,e){for(;t&&e==t.childNodes.length&&!ii(t);)e=ue(t)+1,t=t.parentNode;for(;t&&e<t.childNodes.length;){let n=t.childNodes[e];if(n.nodeType==3)return n;if(n.nodeType==1&&n.contentEditable=="false")break;t=n,e=0}}function Lv(t,e){for(;t&&!e&&!ii(t);)e=ue(t),t=t.parentNode;for(;t&&e;){let n=t.childNodes[e-1];if(n.nodeType==3)return n;if(n.nodeType==1&&n.contentEditable=="false")break;t=n,e=t.childNodes.length}}function vl(t,e,n){if(e.nodeType!=3){let o,s;(s=Ov(e,n))?(e=s,n=0):(o=Lv(e,n))&&(e=o,n=o.nodeValue.length)}let r=t.domSelection();if(!r)return;if(Do(r)){let o=document.createRange();o.setEnd(e,n),o.setStart(e,n),r.removeAllRanges(),r.addRange(o)}else r.extend&&r.extend(e,n);t.domObserver.setCurSelection();let{state:i}=t;setTimeout(()=>{t.state==i&&Mt(t)},50)}function Vf(t,e){let n=t.state.doc.resolve(e);if(!(ye||nv)&&n.parent.inlineContent){let i=t.coordsAtPos(e);if(e>n.start()){let o=t.coordsAtPos(e-1),s=(o.top+o.bottom)/2;if(s>i.top&&s<i.bottom&&Math.abs(o.left-i.left)>1)return o.left<i.left?"ltr":"rtl"}if(e<n.end()){let o=t.coordsAtPos(e+1),s=(o.top+o.bottom)/2;if(s>i.top&&s<i.bottom&&Math.abs(o.left-i.left)>1)return o.left>i.left?"ltr":"rtl"}}return getComputedStyle(t.dom).direction=="rtl"?"rtl":"ltr"}function zf(t,e,n){let r=t.state.selection;if(r instanceof O&&!r.empty||n.indexOf("s")>-1||Ke&&n.indexOf("m")>-1)return!1;let{$from:i,$to:o}=r;if(!i.parent.inlineContent||t.endOfTextblock(e<0?"up":"down")){let s=El(t.state,e);if(s&&s instanceof A)return Wt(t,s)}if(!i.parent.inlineContent){let s=e<0?i:o,a=r instanceof Ee?I.near(s,e):I.findFrom(s,e);return a?Wt(t,a):!1}return!1}function $f(t,e){if(!(t.state.selection instanceof O))return!0;let{$head:n,$anchor:r,empty:i}=t.state.selection;if(!n.sameParent(r))return!0;if(!i)return!1;if(t.endOfTextblock(e>0?"forward":"backward"))return!0;let o=!n.textOffset&&(e<0?n.nodeBefore:n.nodeAfter);if(o&&!o.isText){let s=t.state.tr;return e<0?s.delete(n.pos-o.nodeSize,n.pos):s.delete(n.pos,n.pos+o.nodeSize),t.dispatch(s),!0}return!1}function Uf(t,e,n){t.domObserver.stop(),e.contentEditable=n,t.domObserver.start()}function Nv(t){if(!ve||t.state.selection.$head.parentOffset>0)return!1;let{focusNode:e,focusOffset:n}=t.domSelectionRange();if(e&&e.nodeType==1&&n==0&&e.firstChild&&e.firstChild.contentEditable=="false"){let r=e.firstChild;Uf(t,r,"true"),setTimeout(()=>Uf(t,r,"false"),20)}return!1}function Iv(t){let e="";return t.ctrlKey&&(e+="c"),t.metaKey&&(e+="m"),t.altKey&&(e+="a"),t.shiftKey&&(e+="s"),e}function Pv(t,e){let n=e.keyCode,r=Iv(e);if(n==8||Ke&&n==72&&r=="c")return $f(t,-1)||or(t,-1);if(n==46&&!e.shiftKey||Ke&&n==68&&r=="c")return $f(t,1)||or(t,1);if(n==13||n==27)return!0;if(n==37||Ke&&n==66&&r=="c"){let i=n==37?Vf(t,t.state.selection.from)=="ltr"?-1:1:-1;return Bf(t,i,r)||or(t,i)}else if(n==39||Ke&&n==70&&r=="c"){let i=n==39?Vf(t,t.state.selection.from)=="ltr"?1:-1:1;return Bf(t,i,r)||or(t,i)}else{if(n==38||Ke&&n==80&&r=="c")return zf(t,-1,r)||or(t,-1);if(n==40||Ke&&n==78&&r=="c")return Nv(t)||zf(t,1,r)||or(t,1);if(r==(Ke?"m":"c")&&(n==66||n==73||n==89||n==90))return!0}return!1}function Il(t,e){t.someProp("transformCopied",f=>{e=f(e,t)});let n=[],{content:r,openStart:i,openEnd:o}=e;for(;i>1&&o>1&&r.childCount==1&&r.firstChild.childCount==1;){i--,o--;let f=r.firstChild;n.push(f.type.name,f.attrs!=f.type.defaultAttrs?f.attrs:null),r=f.content}let s=t.someProp("clipboardSerializer")||_t.fromSchema(t.state.schema),a=vh(),l=a.createElement("div");l.appendChild(s.serializeFragment(r,{document:a}));let c=l.firstChild,d,u=0;for(;c&&c.nodeType==1&&(d=Eh[c.nodeName.toLowerCase()]);){for(let f=d.length-1;f>=0;f--){let h=a.createElement(d[f]);for(;l.firstChild;)h.appendChild(l.firstChild);l.appendChild(h),u++}c=l.firstChild}c&&c.nodeType==1&&c.setAttribute("data-pm-slice",`${i} ${o}${u?` -${u}`:""} ${JSON.stringify(n)}`);let p=t.someProp("clipboardTextSerializer",f=>f(e,t))||e.content.textBetween(0,e.content.size,`
+
+`);return{dom:l,text:p,slice:e}}function yh(t,e,n,r,i){let o=i.parent.type.spec.code,s,a;if(!n&&!e)return null;let l=!!e&&(r||o||!n);if(l){if(t.someProp("transformPastedText",p=>{e=p(e,o||r,t)}),o)return a=new k(E.from(t.state.schema.text(e.replace(/\r\n?/g,`
+`))),0,0),t.someProp("transformPasted",p=>{a=p(a,t,!0)}),a;let u=t.someProp("clipboardTextParser",p=>p(e,i,r,t));if(u)a=u;else{let p=i.marks(),{schema:f}=t.state,h=_t.fromSchema(f);s=document.createElement("div"),e.split(/(?:\r\n?|\n)+/).forEach(m=>{let g=s.appendChild(document.createElement("p"));m&&g.appendChild(h.serializeNode(f.text(m,p)))})}}else t.someProp("transformPastedHTML",u=>{n=u(n,t)}),s=Bv(n),oi&&Vv(s);let c=s&&s.querySelector("[data-pm-slice]"),d=c&&/^(\d+) (\d+)(?: -(\d+))? (.*)/.exec(c.getAttribute("data-pm-slice")||"");if(d&&d[3])for(let u=+d[3];u>0;u--){let p=s.firstChild;for(;p&&p.nodeType!=1;)p=p.nextSibling;if(!p)break;s=p}if(a||(a=(t.someProp("clipboardParser")||t.someProp("domParser")||dt.fromSchema(t.state.schema)).parseSlice(s,{preserveWhitespace:!!(l||d),context:i,ruleFromNode(p){return p.nodeName=="BR"&&!p.nextSibling&&p.parentNode&&!Dv.test(p.parentNode.nodeName)?{ignore:!0}:null}})),d)a=zv(_f(a,+d[1],+d[2]),d[4]);else if(a=k.maxOpen(Hv(a.content,i),!0),a.openStart||a.openEnd){let u=0,p=0;for(let f=a.content.firstChild;u<a.openStart&&!f.type.spec.isolating;u++,f=f.firstChild);for(let f=a.content.lastChild;p<a.openEnd&&!f.type.spec.isolating;p++,f=f.lastChild);a=_f(a,u,p)}return t.someProp("transformPasted",u=>{a=u(a,t,l)}),a}var Dv=/^(a|abbr|acronym|b|cite|code|del|em|i|ins|kbd|label|output|q|ruby|s|samp|span|strong|sub|sup|time|u|tt|var)$/i;function Hv(t,e){if(t.childCount<2)return t;for(let n=e.depth;n>=0;n--){let i=e.node(n).contentMatchAt(e.index(n)),o,s=[];if(t.forEach(a=>{if(!s)return;let l=i.findWrapping(a.type),c;if(!l)return s=null;if(c=s.length&&o.length&&xh(l,o,a,s[s.length-1],0))s[s.length-1]=c;else{s.length&&(s[s.length-1]=Ch(s[s.length-1],o.length));let d=bh(a,l);s.push(d),i=i.matchType(d.type),o=l}}),s)return E.from(s)}return t}function bh(t,e,n=0){for(let r=e.length-1;r>=n;r--)t=e[r].create(null,E.from(t));return t}function xh(t,e,n,r,i){if(i<t.length&&i<e.length&&t[i]==e[i]){let o=xh(t,e,n,r.lastChild,i+1);if(o)return r.copy(r.content.replaceChild(r.childCount-1,o));if(r.contentMatchAt(r.childCount).matchType(i==t.length-1?n.type:t[i+1]))return r.copy(r.content.append(E.from(bh(n,t,i+1))))}}function Ch(t,e){if(e==0)return t;let n=t.content.replaceChild(t.childCount-1,Ch(t.lastChild,e-1)),r=t.contentMatchAt(t.childCount).fillBefore(E.empty,!0);return t.copy(n.append(r))}function Tl(t,e,n,r,i,o){let s=e<0?t.firstChild:t.lastChild,a=s.content;return t.childCount>1&&(o=0),i<r-1&&(a=Tl(a,e,n,r,i+1,o)),i>=n&&(a=e<0?s.contentMatchAt(0).fillBefore(a,o<=i).append(a):a.append(s.contentMatchAt(s.childCount).fillBefore(E.empty,!0))),t.replaceChild(e<0?0:t.childCount-1,s.copy(a))}function _f(t,e,n){return e<t.openStart&&(t=new k(Tl(t.content,-1,e,t.openStart,0,t.openEnd),e,t.openEnd)),n<t.openEnd&&(t=new k(Tl(t.content,1,n,t.openEnd,0,0),t.openStart,n)),t}var Eh={thead:["table"],tbody:["table"],tfoot:["table"],caption:["table"],colgroup:["table"],col:["table","colgroup"],tr:["table","tbody"],td:["table","tbody","tr"],th:["table","tbody","tr"]},jf=null;function vh(){return jf||(jf=document.implementation.createHTMLDocument("title"))}var cl=null;function Fv(t){let e=window.trustedTypes;return e?(cl||(cl=e.defaultPolicy||e.createPolicy("ProseMirrorClipboard",{createHTML:n=>n})),cl.createHTML(t)):t}function Bv(t){let e=/^(\s*<meta [^>]*>)*/.exec(t);e&&(t=t.slice(e[0].length));let n=vh().createElement("div"),r=/<([a-z][^>\s]+)/i.exec(t),i;if((i=r&&Eh[r[1].toLowerCase()])&&(t=i.map(o=>"<"+o+">").join("")+t+i.map(o=>"</"+o+">").reverse().join("")),n.innerHTML=Fv(t),i)for(let o=0;o<i.length;o++)n=n.querySelector(i[o])||n;return n}function Vv(t){let e=t.querySelectorAll(ye?"span:not([class]):not([style])":"span.Apple-converted-space");for(let n=0;n<e.length;n++){let r=e[n];r.childNodes.length==1&&r.textContent=="\xA0"&&r.parentNode&&r.parentNode.replaceChild(t.ownerDocument.createTextNode(" "),r)}}function zv(t,e){if(!t.size)return t;let n=t.content.firstChild.type.schema,r;try{r=JSON.parse(e)}catch{return t}let{content:i,openStart:o,openEnd:s}=t;for(let a=r.length-2;a>=0;a-=2){let l=n.nodes[r[a]];if(!l||l.hasRequiredAttrs())break;i=E.from(l.create(r[a+1],i)),o++,s++}return new k(i,o,s)}var Te={},Se={},$v={touchstart:!0,touchmove:!0},Sl=class{constructor(){this.shiftKey=!1,this.mouseDown=null,this.lastKeyCode=null,this.lastKeyCodeTime=0,this.lastClick={time:0,x:0,y:0,type:"",button:0},this.lastSelectionOrigin=null,this.lastSelectionTime=0,this.lastIOSEnter=0,this.lastIOSEnterFallbackTimeout=-1,this.lastFocus=0,this.lastTouch=0,this.lastChromeDelete=0,this.composing=!1,this.compositionNode=null,this.composingTimeout=-1,this.compositionNodes=[],this.compositionEndedAt=-2e8,this.compositionID=1,this.compositionPendingChanges=0,this.domChangeCount=0,this.eventHandlers=Object.create(null),this.hideSelectionGuard=null}};function Uv(t){for(let e in Te){let n=Te[e];t.dom.addEventListener(e,t.input.eventHandlers[e]=r=>{jv(t,r)&&!Pl(t,r)&&(t.editable||!(r.type in Se))&&n(t,r)},$v[e]?{passive:!0}:void 0)}ve&&t.dom.addEventListener("input",()=>null),kl(t)}function qt(t,e){t.input.lastSelectionOrigin=e,t.input.lastSelectionTime=Date.now()}function _v(t){t.domObserver.stop();for(let e in t.input.eventHandlers)t.dom.removeEventListener(e,t.input.eventHandlers[e]);clearTimeout(t.input.composingTimeout),clearTimeout(t.input.lastIOSEnterFallbackTimeout)}function kl(t){t.someProp("handleDOMEvents",e=>{for(let n in e)t.input.eventHandlers[n]||t.dom.addEventListener(n,t.input.eventHandlers[n]=r=>Pl(t,r))})}function Pl(t,e){return t.someProp("handleDOMEvents",n=>{let r=n[e.type];return r?r(t,e)||e.defaultPrevented:!1})}function jv(t,e){if(!e.bubbles)return!0;if(e.defaultPrevented)return!1;for(let n=e.target;n!=t.dom;n=n.parentNode)if(!n||n.nodeType==11||n.pmViewDesc&&n.pmViewDesc.stopEvent(e))return!1;return!0}function Wv(t,e){!Pl(t,e)&&Te[e.type]&&(t.editable||!(e.type in Se))&&Te[e.type](t,e)}Se.keydown=(t,e)=>{let n=e;if(t.input.shiftKey=n.keyCode==16||n.shiftKey,!Sh(t,n)&&(t.input.lastKeyCode=n.keyCode,t.input.lastKeyCodeTime=Date.now(),!(kt&&ye&&n.keyCode==13)))if(n.keyCode!=229&&t.domObserver.forceFlush(),cr&&n.keyCode==13&&!n.ctrlKey&&!n.altKey&&!n.metaKey){let r=Date.now();t.input.lastIOSEnter=r,t.input.lastIOSEnterFallbackTimeout=setTimeout(()=>{t.input.lastIOSEnter==r&&(t.someProp("handleKeyDown",i=>i(t,Cn(13,"Enter"))),t.input.lastIOSEnter=0)},200)}else t.someProp("handleKeyDown",r=>r(t,n))||Pv(t,n)?n.preventDefault():qt(t,"key")};Se.keyup=(t,e)=>{e.keyCode==16&&(t.input.shiftKey=!1)};Se.keypress=(t,e)=>{let n=e;if(Sh(t,n)||!n.charCode||n.ctrlKey&&!n.altKey||Ke&&n.metaKey)return;if(t.someProp("handleKeyPress",i=>i(t,n))){n.preventDefault();return}let r=t.state.selection;if(!(r instanceof O)||!r.$from.sameParent(r.$to)){let i=String.fromCharCode(n.charCode),o=()=>t.state.tr.insertText(i).scrollIntoView();!/[\r\n]/.test(i)&&!t.someProp("handleTextInput",s=>s(t,r.$from.pos,r.$to.pos,i,o))&&t.dispatch(o()),n.preventDefault()}};function Ho(t){return{left:t.clientX,top:t.clientY}}function qv(t,e){let n=e.x-t.clientX,r=e.y-t.clientY;return n*n+r*r<100}function Dl(t,e,n,r,i){if(r==-1)return!1;let o=t.state.doc.resolve(r);for(let s=o.depth+1;s>0;s--)if(t.someProp(e,a=>s>o.depth?a(t,n,o.nodeAfter,o.before(s),i,!0):a(t,n,o.node(s),o.before(s),i,!1)))return!0;return!1}function ar(t,e,n){if(t.focused||t.focus(),t.state.selection.eq(e))return;let r=t.state.tr.setSelection(e);n=="pointer"&&r.setMeta("pointer",!0),t.dispatch(r)}function Kv(t,e){if(e==-1)return!1;let n=t.state.doc.resolve(e),r=n.nodeAfter;return r&&r.isAtom&&A.isSelectable(r)?(ar(t,new A(n),"pointer"),!0):!1}function Jv(t,e){if(e==-1)return!1;let n=t.state.selection,r,i;n instanceof A&&(r=n.node);let o=t.state.doc.resolve(e);for(let s=o.depth+1;s>0;s--){let a=s>o.depth?o.nodeAfter:o.node(s);if(A.isSelectable(a)){r&&n.$from.depth>0&&s>=n.$from.depth&&o.before(n.$from.depth+1)==n.$from.pos?i=o.before(n.$from.depth):i=o.before(s);break}}return i!=null?(ar(t,A.create(t.state.doc,i),"pointer"),!0):!1}function Gv(t,e,n,r,i){return Dl(t,"handleClickOn",e,n,r)||t.someProp("handleClick",o=>o(t,e,r))||(i?Jv(t,n):Kv(t,n))}function Yv(t,e,n,r){return Dl(t,"handleDoubleClickOn",e,n,r)||t.someProp("handleDoubleClick",i=>i(t,e,r))}function Qv(t,e,n,r){return Dl(t,"handleTripleClickOn",e,n,r)||t.someProp("handleTripleClick",i=>i(t,e,r))||Xv(t,n,r)}function Xv(t,e,n){if(n.button!=0)return!1;let r=t.state.doc;if(e==-1)return r.inlineContent?(ar(t,O.create(r,0,r.content.size),"pointer"),!0):!1;let i=r.resolve(e);for(let o=i.depth+1;o>0;o--){let s=o>i.depth?i.nodeAfter:i.node(o),a=i.before(o);if(s.inlineContent)ar(t,O.create(r,a+1,a+1+s.content.size),"pointer");else if(A.isSelectable(s))ar(t,A.create(r,a),"pointer");else continue;return!0}}function Hl(t){return Ro(t)}var Th=Ke?"metaKey":"ctrlKey";Te.mousedown=(t,e)=>{let n=e;t.input.shiftKey=n.shiftKey;let r=Hl(t),i=Date.now(),o="singleClick";i-t.input.lastClick.time<500&&qv(n,t.input.lastClick)&&!n[Th]&&t.input.lastClick.button==n.button&&(t.input.lastClick.type=="singleClick"?o="doubleClick":t.input.lastClick.type=="doubleClick"&&(o="tripleClick")),t.input.lastClick={time:i,x:n.clientX,y:n.clientY,type:o,button:n.button};let s=t.posAtCoords(Ho(n));s&&(o=="singleClick"?(t.input.mouseDown&&t.input.mouseDown.done(),t.input.mouseDown=new Ml(t,s,n,!!r)):(o=="doubleClick"?Yv:Qv)(t,s.pos,s.inside,n)?n.preventDefault():qt(t,"pointer"))};var Ml=class{constructor(e,n,r,i){this.view=e,this.pos=n,this.event=r,this.flushed=i,this.delayedSelectionSync=!1,this.mightDrag=null,this.startDoc=e.state.doc,this.selectNode=!!r[Th],this.allowDefault=r.shiftKey;let o,s;if(n.inside>-1)o=e.state.doc.nodeAt(n.inside),s=n.inside;else{let d=e.state.doc.resolve(n.pos);o=d.parent,s=d.depth?d.before():0}let a=i?null:r.target,l=a?e.docView.nearestDesc(a,!0):null;this.target=l&&l.nodeDOM.nodeType==1?l.nodeDOM:null;let{selection:c}=e.state;(r.button==0&&o.type.spec.draggable&&o.type.spec.selectable!==!1||c instanceof A&&c.from<=s&&c.to>s)&&(this.mightDrag={node:o,pos:s,addAttr:!!(this.target&&!this.target.draggable),setUneditable:!!(this.target&&Ge&&!this.target.hasAttribute("contentEditable"))}),this.target&&this.mightDrag&&(this.mightDrag.addAttr||this.mightDrag.setUneditable)&&(this.view.domObserver.stop(),this.mightDrag.addAttr&&(this.target.draggable=!0),this.mightDrag.setUneditable&&setTimeout(()=>{this.view.input.mouseDown==this&&this.target.setAttribute("contentEditable","false")},20),this.view.domObserver.start()),e.root.addEventListener("mouseup",this.up=this.up.bind(this)),e.root.addEventListener("mousemove",this.move=this.move.bind(this)),qt(e,"pointer")}done(){this.view.root.removeEventListener("mouseup",this.up),this.view.root.removeEventListener("mousemove",this.move),this.mightDrag&&this.target&&(this.view.domObserver.stop(),this.mightDrag.addAttr&&this.target.removeAttribute("draggable"),this.mightDrag.setUneditable&&this.target.removeAttribute("contentEditable"),this.view.domObserver.start()),this.delayedSelectionSync&&setTimeout(()=>Mt(this.view)),this.view.input.mouseDown=null}up(e){if(this.done(),!this.view.dom.contains(e.target))return;let n=this.pos;this.view.state.doc!=this.startDoc&&(n=this.view.posAtCoords(Ho(e))),this.updateAllowDefault(e),this.allowDefault||!n?qt(this.view,"pointer"):Gv(this.view,n.pos,n.inside,e,this.selectNode)?e.preventDefault():e.button==0&&(this.flushed||ve&&this.mightDrag&&!this.mightDrag.node.isAtom||ye&&!this.view.state.selection.visible&&Math.min(Math.abs(n.pos-this.view.state.selection.from),Math.abs(n.pos-this.view.state.selection.to))<=2)?(ar(this.view,I.near(this.view.state.doc.resolve(n.pos)),"pointer"),e.preventDefault()):qt(this.view,"pointer")}move(e){this.updateAllowDefault(e),qt(this.view,"pointer"),e.buttons==0&&this.done()}updateAllowDefault(e){!this.allowDefault&&(Math.abs(this.event.x-e.clientX)>4||Math.abs(this.event.y-e.clientY)>4)&&(this.allowDefault=!0)}};Te.touchstart=t=>{t.input.lastTouch=Date.now(),Hl(t),qt(t,"pointer")};Te.touchmove=t=>{t.input.lastTouch=Date.now(),qt(t,"pointer")};Te.contextmenu=t=>Hl(t);function Sh(t,e){return t.composing?!0:ve&&Math.abs(e.timeStamp-t.input.compositionEndedAt)<500?(t.input.compositionEndedAt=-2e8,!0):!1}var Zv=kt?5e3:-1;Se.compositionstart=Se.compositionupdate=t=>{if(!t.composing){t.domObserver.flush();let{state:e}=t,n=e.selection.$to;if(e.selection instanceof O&&(e.storedMarks||!n.textOffset&&n.parentOffset&&n.nodeBefore.marks.some(r=>r.type.spec.inclusive===!1)))t.markCursor=t.state.storedMarks||n.marks(),Ro(t,!0),t.markCursor=null;else if(Ro(t,!e.selection.empty),Ge&&e.selection.empty&&n.parentOffset&&!n.textOffset&&n.nodeBefore.marks.length){let r=t.domSelectionRange();for(let i=r.focusNode,o=r.focusOffset;i&&i.nodeType==1&&o!=0;){let s=o<0?i.lastChild:i.childNodes[o-1];if(!s)break;if(s.nodeType==3){let a=t.domSelection();a&&a.collapse(s,s.nodeValue.length);break}else i=s,o=-1}}t.input.composing=!0}kh(t,Zv)};Se.compositionend=(t,e)=>{t.composing&&(t.input.composing=!1,t.input.compositionEndedAt=e.timeStamp,t.input.compositionPendingChanges=t.domObserver.pendingRecords().length?t.input.compositionID:0,t.input.compositionNode=null,t.input.compositionPendingChanges&&Promise.resolve().then(()=>t.domObserver.flush()),t.input.compositionID++,kh(t,20))};function kh(t,e){clearTimeout(t.input.composingTimeout),e>-1&&(t.input.composingTimeout=setTimeout(()=>Ro(t),e))}function Mh(t){for(t.composing&&(t.input.composing=!1,t.input.compositionEndedAt=tT());t.input.compositionNodes.length>0;)t.input.compositionNodes.pop().markParentsDirty()}function eT(t){let e=t.domSelectionRange();if(!e.focusNode)return null;let n=QE(e.focusNode,e.focusOffset),r=XE(e.focusNode,e.focusOffset);if(n&&r&&n!=r){let i=r.pmViewDesc,o=t.domObserver.lastChangedTextNode;if(n==o||r==o)return o;if(!i||!i.isText(r.nodeValue))return r;if(t.input.compositionNode==r){let s=n.pmViewDesc;if(!(!s||!s.isText(n.nodeValue)))return r}}return n||r}function tT(){let t=document.createEvent("Event");return t.initEvent("event",!0,!0),t.timeStamp}function Ro(t,e=!1){if(!(kt&&t.domObserver.flushingSoon>=0)){if(t.domObserver.forceFlush(),Mh(t),e||t.docView&&t.docView.dirty){let n=Ll(t),r=t.state.selection;return n&&!n.eq(r)?t.dispatch(t.state.tr.setSelection(n)):(t.markCursor||e)&&!r.$from.node(r.$from.sharedDepth(r.to)).inlineContent?t.dispatch(t.state.tr.deleteSelection()):t.updateState(t.state),!0}return!1}}function nT(t,e){if(!t.dom.parentNode)return;let n=t.dom.parentNode.appendChild(document.createElement("div"));n.appendChild(e),n.style.cssText="position: fixed; left: -10000px; top: 10px";let r=getSelection(),i=document.createRange();i.selectNodeContents(e),t.dom.blur(),r.removeAllRanges(),r.addRange(i),setTimeout(()=>{n.parentNode&&n.parentNode.removeChild(n),t.focus()},50)}var ti=Ie&&Kt<15||cr&&rv<604;Te.copy=Se.cut=(t,e)=>{let n=e,r=t.state.selection,i=n.type=="cut";if(r.empty)return;let o=ti?null:n.clipboardData,s=r.content(),{dom:a,text:l}=Il(t,s);o?(n.preventDefault(),o.clearData(),o.setData("text/html",a.innerHTML),o.setData("text/plain",l)):nT(t,a),i&&t.dispatch(t.state.tr.deleteSelection().scrollIntoView().setMeta("uiEvent","cut"))};function rT(t){return t.openStart==0&&t.openEnd==0&&t.content.childCount==1?t.content.firstChild:null}function iT(t,e){if(!t.dom.parentNode)return;let n=t.input.shiftKey||t.state.selection.$from.parent.type.spec.code,r=t.dom.parentNode.appendChild(document.createElement(n?"textarea":"div"));n||(r.contentEditable="true"),r.style.cssText="position: fixed; left: -10000px; top: 10px",r.focus();let i=t.input.shiftKey&&t.input.lastKeyCode!=45;setTimeout(()=>{t.focus(),r.parentNode&&r.parentNode.removeChild(r),n?ni(t,r.value,null,i,e):ni(t,r.textContent,r.innerHTML,i,e)},50)}function ni(t,e,n,r,i){let o=yh(t,e,n,r,t.state.selection.$from);if(t.someProp("handlePaste",l=>l(t,i,o||k.empty)))return!0;if(!o)return!1;let s=rT(o),a=s?t.state.tr.replaceSelectionWith(s,r):t.state.tr.replaceSelection(o);return t.dispatch(a.scrollIntoView().setMeta("paste",!0).setMeta("uiEvent","paste")),!0}function wh(t){let e=t.getData("text/plain")||t.getData("Text");if(e)return e;let n=t.getData("text/uri-list");return n?n.replace(/\r?\n/g," "):""}Se.paste=(t,e)=>{let n=e;if(t.composing&&!kt)return;let r=ti?null:n.clipboardData,i=t.input.shiftKey&&t.input.lastKeyCode!=45;r&&ni(t,wh(r),r.getData("text/html"),i,n)?n.preventDefault():iT(t,n)};var Oo=class{constructor(e,n,r){this.slice=e,this.move=n,this.node=r}},oT=Ke?"altKey":"ctrlKey";function Ah(t,e){let n=t.someProp("dragCopies",r=>!r(e));return n!=null?n:!e[oT]}Te.dragstart=(t,e)=>{let n=e,r=t.input.mouseDown;if(r&&r.done(),!n.dataTransfer)return;let i=t.state.selection,o=i.empty?null:t.posAtCoords(Ho(n)),s;if(!(o&&o.pos>=i.from&&o.pos<=(i instanceof A?i.to-1:i.to))){if(r&&r.mightDrag)s=A.create(t.state.doc,r.mightDrag.pos);else if(n.target&&n.target.nodeType==1){let u=t.docView.nearestDesc(n.target,!0);u&&u.node.type.spec.draggable&&u!=t.docView&&(s=A.create(t.state.doc,u.posBefore))}}let a=(s||t.state.selection).content(),{dom:l,text:c,slice:d}=Il(t,a);(!n.dataTransfer.files.length||!ye||rh>120)&&n.dataTransfer.clearData(),n.dataTransfer.setData(ti?"Text":"text/html",l.innerHTML),n.dataTransfer.effectAllowed="copyMove",ti||n.dataTransfer.setData("text/plain",c),t.dragging=new Oo(d,Ah(t,n),s)};Te.dragend=t=>{let e=t.dragging;window.setTimeout(()=>{t.dragging==e&&(t.dragging=null)},50)};Se.dragover=Se.dragenter=(t,e)=>e.preventDefault();Se.drop=(t,e)=>{let n=e,r=t.dragging;if(t.dragging=null,!n.dataTransfer)return;let i=t.posAtCoords(Ho(n));if(!i)return;let o=t.state.doc.resolve(i.pos),s=r&&r.slice;s?t.someProp("transformPasted",h=>{s=h(s,t,!1)}):s=yh(t,wh(n.dataTransfer),ti?null:n.dataTransfer.getData("text/html"),!1,o);let a=!!(r&&Ah(t,n));if(t.someProp("handleDrop",h=>h(t,n,s||k.empty,a))){n.preventDefault();return}if(!s)return;n.preventDefault();let l=s?Eo(t.state.doc,o.pos,s):o.pos;l==null&&(l=o.pos);let c=t.state.tr;if(a){let{node:h}=r;h?h.replace(c):c.deleteSelection()}let d=c.mapping.map(l),u=s.openStart==0&&s.openEnd==0&&s.content.childCount==1,p=c.doc;if(u?c.replaceRangeWith(d,d,s.content.firstChild):c.replaceRange(d,d,s),c.doc.eq(p))return;let f=c.doc.resolve(d);if(u&&A.isSelectable(s.content.firstChild)&&f.nodeAfter&&f.nodeAfter.sameMarkup(s.content.firstChild))c.setSelection(new A(f));else{let h=c.mapping.map(l);c.mapping.maps[c.mapping.maps.length-1].forEach((m,g,y,x)=>h=x),c.setSelection(Nl(t,f,c.doc.resolve(h)))}t.focus(),t.dispatch(c.setMeta("uiEvent","drop"))};Te.focus=t=>{t.input.lastFocus=Date.now(),t.focused||(t.domObserver.stop(),t.dom.classList.add("ProseMirror-focused"),t.domObserver.start(),t.focused=!0,setTimeout(()=>{t.docView&&t.hasFocus()&&!t.domObserver.currentSelection.eq(t.domSelectionRange())&&Mt(t)},20))};Te.blur=(t,e)=>{let n=e;t.focused&&(t.domObserver.stop(),t.dom.classList.remove("ProseMirror-focused"),t.domObserver.start(),n.relatedTarget&&t.dom.contains(n.relatedTarget)&&t.domObserver.currentSelection.clear(),t.focused=!1)};Te.beforeinput=(t,e)=>{if(ye&&kt&&e.inputType=="deleteContentBackward"){t.domObserver.flushSoon();let{domChangeCount:r}=t.input;setTimeout(()=>{if(t.input.domChangeCount!=r||(t.dom.blur(),t.focus(),t.someProp("handleKeyDown",o=>o(t,Cn(8,"Backspace")))))return;let{$cursor:i}=t.state.selection;i&&i.pos>0&&t.dispatch(t.state.tr.delete(i.pos-1,i.pos).scrollIntoView())},50)}};for(let t in Se)Te[t]=Se[t];function ri(t,e){if(t==e)return!0;for(let n in t)if(t[n]!==e[n])return!1;for(let n in e)if(!(n in t))return!1;return!0}var Lo=class t{constructor(e,n){this.toDOM=e,this.spec=n||Sn,this.side=this.spec.side||0}map(e,n,r,i){let{pos:o,deleted:s}=e.mapResult(n.from+i,this.side<0?-1:1);return s?null:new Qe(o-r,o-r,this)}valid(){return!0}eq(e){return this==e||e instanceof t&&(this.spec.key&&this.spec.key==e.spec.key||this.toDOM==e.toDOM&&ri(this.spec,e.spec))}destroy(e){this.spec.destroy&&this.spec.destroy(e)}},Tn=class t{constructor(e,n){this.attrs=e,this.spec=n||Sn}map(e,n,r,i){let o=e.map(n.from+i,this.spec.inclusiveStart?-1:1)-r,s=e.map(n.to+i,this.spec.inclusiveEnd?1:-1)-r;return o>=s?null:new Qe(o,s,this)}valid(e,n){return n.from<n.to}eq(e){return this==e||e instanceof t&&ri(this.attrs,e.attrs)&&ri(this.spec,e.spec)}static is(e){return e.type instanceof t}destroy(){}},wl=class t{constructor(e,n){this.attrs=e,this.spec=n||Sn}map(e,n,r,i){let o=e.mapResult(n.from+i,1);if(o.deleted)return null;let s=e.mapResult(n.to+i,-1);return s.deleted||s.pos<=o.pos?null:new Qe(o.pos-r,s.pos-r,this)}valid(e,n){let{index:r,offset:i}=e.content.findIndex(n.from),o;return i==n.from&&!(o=e.child(r)).isText&&i+o.nodeSize==n.to}eq(e){return this==e||e instanceof t&&ri(this.attrs,e.attrs)&&ri(this.spec,e.spec)}destroy(){}},Qe=class t{constructor(e,n,r){this.from=e,this.to=n,this.type=r}copy(e,n){return new t(e,n,this.type)}eq(e,n=0){return this.type.eq(e.type)&&this.from+n==e.from&&this.to+n==e.to}map(e,n,r){return this.type.map(e,this,n,r)}static widget(e,n,r){return new t(e,e,new Lo(n,r))}static inline(e,n,r,i){return new t(e,n,new Tn(r,i))}static node(e,n,r,i){return new t(e,n,new wl(r,i))}get spec(){return this.type.spec}get inline(){return this.type instanceof Tn}get widget(){return this.type instanceof Lo}},sr=[],Sn={},be=class t{constructor(e,n){this.local=e.length?e:sr,this.children=n.length?n:sr}static create(e,n){return n.length?Io(n,e,0,Sn):ge}find(e,n,r){let i=[];return this.findInner(e==null?0:e,n==null?1e9:n,i,0,r),i}findInner(e,n,r,i,o){for(let s=0;s<this.local.length;s++){let a=this.local[s];a.from<=n&&a.to>=e&&(!o||o(a.spec))&&r.push(a.copy(a.from+i,a.to+i))}for(let s=0;s<this.children.length;s+=3)if(this.children[s]<n&&this.children[s+1]>e){let a=this.children[s]+1;this.children[s+2].findInner(e-a,n-a,r,i+a,o)}}map(e,n,r){return this==ge||e.maps.length==0?this:this.mapInner(e,n,0,0,r||Sn)}mapInner(e,n,r,i,o){let s;for(let a=0;a<this.local.length;a++){let l=this.local[a].map(e,r,i);l&&l.type.valid(n,l)?(s||(s=[])).push(l):o.onRemove&&o.onRemove(this.local[a].spec)}return this.children.length?sT(this.children,s||[],e,n,r,i,o):s?new t(s.sort(kn),sr):ge}add(e,n){return n.length?this==ge?t.create(e,n):this.addInner(e,n,0):this}addInner(e,n,r){let i,o=0;e.forEach((a,l)=>{let c=l+r,d;if(d=Oh(n,a,c)){for(i||(i=this.children.slice());o<i.length&&i[o]<l;)o+=3;i[o]==l?i[o+2]=i[o+2].addInner(a,d,c+1):i.splice(o,0,l,l+a.nodeSize,Io(d,a,c+1,Sn)),o+=3}});let s=Rh(o?Lh(n):n,-r);for(let a=0;a<s.length;a++)s[a].type.valid(e,s[a])||s.splice(a--,1);return new t(s.length?this.local.concat(s).sort(kn):this.local,i||this.children)}remove(e){return e.length==0||this==ge?this:this.removeInner(e,0)}removeInner(e,n){let r=this.children,i=this.local;for(let o=0;o<r.length;o+=3){let s,a=r[o]+n,l=r[o+1]+n;for(let d=0,u;d<e.length;d++)(u=e[d])&&u.from>a&&u.to<l&&(e[d]=null,(s||(s=[])).push(u));if(!s)continue;r==this.children&&(r=this.children.slice());let c=r[o+2].removeInner(s,a+1);c!=ge?r[o+2]=c:(r.splice(o,3),o-=3)}if(i.length){for(let o=0,s;o<e.length;o++)if(s=e[o])for(let a=0;a<i.length;a++)i[a].eq(s,n)&&(i==this.local&&(i=this.local.slice()),i.splice(a--,1))}return r==this.children&&i==this.local?this:i.length||r.length?new t(i,r):ge}forChild(e,n){if(this==ge)return this;if(n.isLeaf)return t.empty;let r,i;for(let a=0;a<this.children.length;a+=3)if(this.children[a]>=e){this.children[a]==e&&(r=this.children[a+2]);break}let o=e+1,s=o+n.content.size;for(let a=0;a<this.local.length;a++){let l=this.local[a];if(l.from<s&&l.to>o&&l.type instanceof Tn){let c=Math.max(o,l.from)-o,d=Math.min(s,l.to)-o;c<d&&(i||(i=[])).push(l.copy(c,d))}}if(i){let a=new t(i.sort(kn),sr);return r?new No([a,r]):a}return r||ge}eq(e){if(this==e)return!0;if(!(e instanceof t)||this.local.length!=e.local.length||this.children.length!=e.children.length)return!1;for(let n=0;n<this.local.length;n++)if(!this.local[n].eq(e.local[n]))return!1;for(let n=0;n<this.children.length;n+=3)if(this.children[n]!=e.children[n]||this.children[n+1]!=e.children[n+1]||!this.children[n+2].eq(e.children[n+2]))return!1;return!0}locals(e){return Fl(this.localsInner(e))}localsInner(e){if(this==ge)return sr;if(e.inlineContent||!this.local.some(Tn.is))return this.local;let n=[];for(let r=0;r<this.local.length;r++)this.local[r].type instanceof Tn||n.push(this.local[r]);return n}forEachSet(e){e(this)}};be.empty=new be([],[]);be.removeOverlap=Fl;var ge=be.empty,No=class t{constructor(e){this.members=e}map(e,n){let r=this.members.map(i=>i.map(e,n,Sn));return t.from(r)}forChild(e,n){if(n.isLeaf)return be.empty;let r=[];for(let i=0;i<this.members.length;i++){let o=this.members[i].forChild(e,n);o!=ge&&(o instanceof t?r=r.concat(o.members):r.push(o))}return t.from(r)}eq(e){if(!(e instanceof t)||e.members.length!=this.members.length)return!1;for(let n=0;n<this.members.length;n++)if(!this.members[n].eq(e.members[n]))return!1;return!0}locals(e){let n,r=!0;for(let i=0;i<this.members.length;i++){let o=this.members[i].localsInner(e);if(o.length)if(!n)n=o;else{r&&(n=n.slice(),r=!1);for(let s=0;s<o.length;s++)n.push(o[s])}}return n?Fl(r?n:n.sort(kn)):sr}static from(e){switch(e.length){case 0:return ge;case 1:return e[0];default:return new t(e.every(n=>n instanceof be)?e:e.reduce((n,r)=>n.concat(r instanceof be?r:r.members),[]))}}forEachSet(e){for(let n=0;n<this.members.length;n++)this.members[n].forEachSet(e)}};function sT(t,e,n,r,i,o,s){let a=t.slice();for(let c=0,d=o;c<n.maps.length;c++){let u=0;n.maps[c].forEach((p,f,h,m)=>{let g=m-h-(f-p);for(let y=0;y<a.length;y+=3){let x=a[y+1];if(x<0||p>x+d-u)continue;let C=a[y]+d-u;f>=C?a[y+1]=p<=C?-2:-1:p>=d&&g&&(a[y]+=g,a[y+1]+=g)}u+=g}),d=n.maps[c].map(d,-1)}let l=!1;for(let c=0;c<a.length;c+=3)if(a[c+1]<0){if(a[c+1]==-2){l=!0,a[c+1]=-1;continue}let d=n.map(t[c]+o),u=d-i;if(u<0||u>=r.content.size){l=!0;continue}let p=n.map(t[c+1]+o,-1),f=p-i,{index:h,offset:m}=r.content.findIndex(u),g=r.maybeChild(h);if(g&&m==u&&m+g.nodeSize==f){let y=a[c+2].mapInner(n,g,d+1,t[c]+o+1,s);y!=ge?(a[c]=u,a[c+1]=f,a[c+2]=y):(a[c+1]=-2,l=!0)}else l=!0}if(l){let c=aT(a,t,e,n,i,o,s),d=Io(c,r,0,s);e=d.local;for(let u=0;u<a.length;u+=3)a[u+1]<0&&(a.splice(u,3),u-=3);for(let u=0,p=0;u<d.children.length;u+=3){let f=d.children[u];for(;p<a.length&&a[p]<f;)p+=3;a.splice(p,0,d.children[u],d.children[u+1],d.children[u+2])}}return new be(e.sort(kn),a)}function Rh(t,e){if(!e||!t.length)return t;let n=[];for(let r=0;r<t.length;r++){let i=t[r];n.push(new Qe(i.from+e,i.to+e,i.type))}return n}function aT(t,e,n,r,i,o,s){function a(l,c){for(let d=0;d<l.local.length;d++){let u=l.local[d].map(r,i,c);u?n.push(u):s.onRemove&&s.onRemove(l.local[d].spec)}for(let d=0;d<l.children.length;d+=3)a(l.children[d+2],l.children[d]+c+1)}for(let l=0;l<t.length;l+=3)t[l+1]==-1&&a(t[l+2],e[l]+o+1);return n}function Oh(t,e,n){if(e.isLeaf)return null;let r=n+e.nodeSize,i=null;for(let o=0,s;o<t.length;o++)(s=t[o])&&s.from>n&&s.to<r&&((i||(i=[])).push(s),t[o]=null);return i}function Lh(t){let e=[];for(let n=0;n<t.length;n++)t[n]!=null&&e.push(t[n]);return e}function Io(t,e,n,r){let i=[],o=!1;e.forEach((a,l)=>{let c=Oh(t,a,l+n);if(c){o=!0;let d=Io(c,a,n+l+1,r);d!=ge&&i.push(l,l+a.nodeSize,d)}});let s=Rh(o?Lh(t):t,-n).sort(kn);for(let a=0;a<s.length;a++)s[a].type.valid(e,s[a])||(r.onRemove&&r.onRemove(s[a].spec),s.splice(a--,1));return s.length||i.length?new be(s,i):ge}function kn(t,e){return t.from-e.from||t.to-e.to}function Fl(t){let e=t;for(let n=0;n<e.length-1;n++){let r=e[n];if(r.from!=r.to)for(let i=n+1;i<e.length;i++){let o=e[i];if(o.from==r.from){o.to!=r.to&&(e==t&&(e=t.slice()),e[i]=o.copy(o.from,r.to),Wf(e,i+1,o.copy(r.to,o.to)));continue}else{o.from<r.to&&(e==t&&(e=t.slice()),e[n]=r.copy(r.from,o.from),Wf(e,i,r.copy(o.from,r.to)));break}}}return e}function Wf(t,e,n){for(;e<t.length&&kn(n,t[e])>0;)e++;t.splice(e,0,n)}function dl(t){let e=[];return t.someProp("decorations",n=>{let r=n(t.state);r&&r!=ge&&e.push(r)}),t.cursorWrapper&&e.push(be.create(t.state.doc,[t.cursorWrapper.deco])),No.from(e)}var lT={childList:!0,characterData:!0,characterDataOldValue:!0,attributes:!0,attributeOldValue:!0,subtree:!0},cT=Ie&&Kt<=11,Al=class{constructor(){this.anchorNode=null,this.anchorOffset=0,this.focusNode=null,this.focusOffset=0}set(e){this.anchorNode=e.anchorNode,this.anchorOffset=e.anchorOffset,this.focusNode=e.focusNode,this.focusOffset=e.focusOffset}clear(){this.anchorNode=this.focusNode=null}eq(e){return e.anchorNode==this.anchorNode&&e.anchorOffset==this.anchorOffset&&e.focusNode==this.focusNode&&e.focusOffset==this.focusOffset}},Rl=class{constructor(e,n){this.view=e,this.handleDOMChange=n,this.queue=[],this.flushingSoon=-1,this.observer=null,this.currentSelection=new Al,this.onCharData=null,this.suppressingSelectionUpdates=!1,this.lastChangedTextNode=null,this.observer=window.MutationObserver&&new window.MutationObserver(r=>{for(let i=0;i<r.length;i++)this.queue.push(r[i]);Ie&&Kt<=11&&r.some(i=>i.type=="childList"&&i.removedNodes.length||i.type=="characterData"&&i.oldValue.length>i.target.nodeValue.length)?this.flushSoon():this.flush()}),cT&&(this.onCharData=r=>{this.queue.push({target:r.target,type:"characterData",oldValue:r.prevValue}),this.flushSoon()}),this.onSelectionChange=this.onSelectionChange.bind(this)}flushSoon(){this.flushingSoon<0&&(this.flushingSoon=window.setTimeout(()=>{this.flushingSoon=-1,this.flush()},20))}forceFlush(){this.flushingSoon>-1&&(window.clearTimeout(this.flushingSoon),this.flushingSoon=-1,this.flush())}start(){this.observer&&(this.observer.takeRecords(),this.observer.observe(this.view.dom,lT)),this.onCharData&&this.view.dom.addEventListener("DOMCharacterDataModified",this.onCharData),this.connectSelection()}stop(){if(this.observer){let e=this.observer.takeRecords();if(e.length){for(let n=0;n<e.length;n++)this.queue.push(e[n]);window.setTimeout(()=>this.flush(),20)}this.observer.disconnect()}this.onCharData&&this.view.dom.removeEventListener("DOMCharacterDataModified",this.onCharData),this.disconnectSelection()}connectSelection(){this.view.dom.ownerDocument.addEventListener("selectionchange",this.onSelectionChange)}disconnectSelection(){this.view.dom.ownerDocument.removeEventListener("selectionchange",this.onSelectionChange)}suppressSelectionUpdates(){this.suppressingSelectionUpdates=!0,setTimeout(()=>this.suppressingSelectionUpdates=!1,50)}onSelectionChange(){if(Ff(this.view)){if(this.suppressingSelectionUpdates)return Mt(this.view);if(Ie&&Kt<=11&&!this.view.state.selection.empty){let e=this.view.domSelectionRange();if(e.focusNode&&Mn(e.focusNode,e.focusOffset,e.anchorNode,e.anchorOffset))return this.flushSoon()}this.flush()}}setCurSelection(){this.currentSelection.set(this.view.domSelectionRange())}ignoreSelectionChange(e){if(!e.focusNode)return!0;let n=new Set,r;for(let o=e.focusNode;o;o=lr(o))n.add(o);for(let o=e.anchorNode;o;o=lr(o))if(n.has(o)){r=o;break}let i=r&&this.view.docView.nearestDesc(r);if(i&&i.ignoreMutation({type:"selection",target:r.nodeType==3?r.parentNode:r}))return this.setCurSelection(),!0}pendingRecords(){if(this.observer)for(let e of this.observer.takeRecords())this.queue.push(e);return this.queue}flush(){let{view:e}=this;if(!e.docView||this.flushingSoon>-1)return;let n=this.pendingRecords();n.length&&(this.queue=[]);let r=e.domSelectionRange(),i=!this.suppressingSelectionUpdates&&!this.currentSelection.eq(r)&&Ff(e)&&!this.ignoreSelectionChange(r),o=-1,s=-1,a=!1,l=[];if(e.editable)for(let d=0;d<n.length;d++){let u=this.registerMutation(n[d],l);u&&(o=o<0?u.from:Math.min(u.from,o),s=s<0?u.to:Math.max(u.to,s),u.typeOver&&(a=!0))}if(Ge&&l.length){let d=l.filter(u=>u.nodeName=="BR");if(d.length==2){let[u,p]=d;u.parentNode&&u.parentNode.parentNode==p.parentNode?p.remove():u.remove()}else{let{focusNode:u}=this.currentSelection;for(let p of d){let f=p.parentNode;f&&f.nodeName=="LI"&&(!u||pT(e,u)!=f)&&p.remove()}}}let c=null;o<0&&i&&e.input.lastFocus>Date.now()-200&&Math.max(e.input.lastTouch,e.input.lastClick.time)<Date.now()-300&&Do(r)&&(c=Ll(e))&&c.eq(I.near(e.state.doc.resolve(0),1))?(e.input.lastFocus=0,Mt(e),this.currentSelection.set(r),e.scrollToSelection()):(o>-1||i)&&(o>-1&&(e.docView.markDirty(o,s),dT(e)),this.handleDOMChange(o,s,a,l),e.docView&&e.docView.dirty?e.updateState(e.state):this.currentSelection.eq(r)||Mt(e),this.currentSelection.set(r))}registerMutation(e,n){if(n.indexOf(e.target)>-1)return null;let r=this.view.docView.nearestDesc(e.target);if(e.type=="attributes"&&(r==this.view.docView||e.attributeName=="contenteditable"||e.attributeName=="style"&&!e.oldValue&&!e.target.getAttribute("style"))||!r||r.ignoreMutation(e))return null;if(e.type=="childList"){for(let d=0;d<e.addedNodes.length;d++){let u=e.addedNodes[d];n.push(u),u.nodeType==3&&(this.lastChangedTextNode=u)}if(r.contentDOM&&r.contentDOM!=r.dom&&!r.contentDOM.contains(e.target))return{from:r.posBefore,to:r.posAfter};let i=e.previousSibling,o=e.nextSibling;if(Ie&&Kt<=11&&e.addedNodes.length)for(let d=0;d<e.addedNodes.length;d++){let{previousSibling:u,nextSibling:p}=e.addedNodes[d];(!u||Array.prototype.indexOf.call(e.addedNodes,u)<0)&&(i=u),(!p||Array.prototype.indexOf.call(e.addedNodes,p)<0)&&(o=p)}let s=i&&i.parentNode==e.target?ue(i)+1:0,a=r.localPosFromDOM(e.target,s,-1),l=o&&o.parentNode==e.target?ue(o):e.target.childNodes.length,c=r.localPosFromDOM(e.target,l,1);return{from:a,to:c}}else return e.type=="attributes"?{from:r.posAtStart-r.border,to:r.posAtEnd+r.border}:(this.lastChangedTextNode=e.target,{from:r.posAtStart,to:r.posAtEnd,typeOver:e.target.nodeValue==e.oldValue})}},qf=new WeakMap,Kf=!1;function dT(t){if(!qf.has(t)&&(qf.set(t,null),["normal","nowrap","pre-line"].indexOf(getComputedStyle(t.dom).whiteSpace)!==-1)){if(t.requiresGeckoHackNode=Ge,Kf)return;console.warn("ProseMirror expects the CSS white-space property to be set, preferably to 'pre-wrap'. It is recommended to load style/prosemirror.css from the prosemirror-view package."),Kf=!0}}function Jf(t,e){let n=e.startContainer,r=e.startOffset,i=e.endContainer,o=e.endOffset,s=t.domAtPos(t.state.selection.anchor);return Mn(s.node,s.offset,i,o)&&([n,r,i,o]=[i,o,n,r]),{anchorNode:n,anchorOffset:r,focusNode:i,focusOffset:o}}function uT(t,e){if(e.getComposedRanges){let i=e.getComposedRanges(t.root)[0];if(i)return Jf(t,i)}let n;function r(i){i.preventDefault(),i.stopImmediatePropagation(),n=i.getTargetRanges()[0]}return t.dom.addEventListener("beforeinput",r,!0),document.execCommand("indent"),t.dom.removeEventListener("beforeinput",r,!0),n?Jf(t,n):null}function pT(t,e){for(let n=e.parentNode;n&&n!=t.dom;n=n.parentNode){let r=t.docView.nearestDesc(n,!0);if(r&&r.node.isBlock)return n}return null}function fT(t,e,n){let{node:r,fromOffset:i,toOffset:o,from:s,to:a}=t.docView.parseRange(e,n),l=t.domSelectionRange(),c,d=l.anchorNode;if(d&&t.dom.contains(d.nodeType==1?d:d.parentNode)&&(c=[{node:d,offset:l.anchorOffset}],Do(l)||c.push({node:l.focusNode,offset:l.focusOffset})),ye&&t.input.lastKeyCode===8)for(let g=o;g>i;g--){let y=r.childNodes[g-1],x=y.pmViewDesc;if(y.nodeName=="BR"&&!x){o=g;break}if(!x||x.size)break}let u=t.state.doc,p=t.someProp("domParser")||dt.fromSchema(t.state.schema),f=u.resolve(s),h=null,m=p.parse(r,{topNode:f.parent,topMatch:f.parent.contentMatchAt(f.index()),topOpen:!0,from:i,to:o,preserveWhitespace:f.parent.type.whitespace=="pre"?"full":!0,findPositions:c,ruleFromNode:hT,context:f});if(c&&c[0].pos!=null){let g=c[0].pos,y=c[1]&&c[1].pos;y==null&&(y=g),h={anchor:g+s,head:y+s}}return{doc:m,sel:h,from:s,to:a}}function hT(t){let e=t.pmViewDesc;if(e)return e.parseRule();if(t.nodeName=="BR"&&t.parentNode){if(ve&&/^(ul|ol)$/i.test(t.parentNode.nodeName)){let n=document.createElement("div");return n.appendChild(document.createElement("li")),{skip:n}}else if(t.parentNode.lastChild==t||ve&&/^(tr|table)$/i.test(t.parentNode.nodeName))return{ignore:!0}}else if(t.nodeName=="IMG"&&t.getAttribute("mark-placeholder"))return{ignore:!0};return null}var mT=/^(a|abbr|acronym|b|bd[io]|big|br|button|cite|code|data(list)?|del|dfn|em|i|img|ins|kbd|label|map|mark|meter|output|q|ruby|s|samp|small|span|strong|su[bp]|time|u|tt|var)$/i;function gT(t,e,n,r,i){let o=t.input.compositionPendingChanges||(t.composing?t.input.compositionID:0);if(t.input.compositionPendingChanges=0,e<0){let S=t.input.lastSelectionTime>Date.now()-50?t.input.lastSelectionOrigin:null,P=Ll(t,S);if(P&&!t.state.selection.eq(P)){if(ye&&kt&&t.input.lastKeyCode===13&&Date.now()-100<t.input.lastKeyCodeTime&&t.someProp("handleKeyDown",b=>b(t,Cn(13,"Enter"))))return;let D=t.state.tr.setSelection(P);S=="pointer"?D.setMeta("pointer",!0):S=="key"&&D.scrollIntoView(),o&&D.setMeta("composition",o),t.dispatch(D)}return}let s=t.state.doc.resolve(e),a=s.sharedDepth(n);e=s.before(a+1),n=t.state.doc.resolve(n).after(a+1);let l=t.state.selection,c=fT(t,e,n),d=t.state.doc,u=d.slice(c.from,c.to),p,f;t.input.lastKeyCode===8&&Date.now()-100<t.input.lastKeyCodeTime?(p=t.state.selection.to,f="end"):(p=t.state.selection.from,f="start"),t.input.lastKeyCode=null;let h=xT(u.content,c.doc.content,c.from,p,f);if(h&&t.input.domChangeCount++,(cr&&t.input.lastIOSEnter>Date.now()-225||kt)&&i.some(S=>S.nodeType==1&&!mT.test(S.nodeName))&&(!h||h.endA>=h.endB)&&t.someProp("handleKeyDown",S=>S(t,Cn(13,"Enter")))){t.input.lastIOSEnter=0;return}if(!h)if(r&&l instanceof O&&!l.empty&&l.$head.sameParent(l.$anchor)&&!t.composing&&!(c.sel&&c.sel.anchor!=c.sel.head))h={start:l.from,endA:l.to,endB:l.to};else{if(c.sel){let S=Gf(t,t.state.doc,c.sel);if(S&&!S.eq(t.state.selection)){let P=t.state.tr.setSelection(S);o&&P.setMeta("composition",o),t.dispatch(P)}}return}t.state.selection.from<t.state.selection.to&&h.start==h.endB&&t.state.selection instanceof O&&(h.start>t.state.selection.from&&h.start<=t.state.selection.from+2&&t.state.selection.from>=c.from?h.start=t.state.selection.from:h.endA<t.state.selection.to&&h.endA>=t.state.selection.to-2&&t.state.selection.to<=c.to&&(h.endB+=t.state.selection.to-h.endA,h.endA=t.state.selection.to)),Ie&&Kt<=11&&h.endB==h.start+1&&h.endA==h.start&&h.start>c.from&&c.doc.textBetween(h.start-c.from-1,h.start-c.from+1)==" \xA0"&&(h.start--,h.endA--,h.endB--);let m=c.doc.resolveNoCache(h.start-c.from),g=c.doc.resolveNoCache(h.endB-c.from),y=d.resolve(h.start),x=m.sameParent(g)&&m.parent.inlineContent&&y.end()>=h.endA;if((cr&&t.input.lastIOSEnter>Date.now()-225&&(!x||i.some(S=>S.nodeName=="DIV"||S.nodeName=="P"))||!x&&m.pos<c.doc.content.size&&(!m.sameParent(g)||!m.parent.inlineContent)&&m.pos<g.pos&&!/\S/.test(c.doc.textBetween(m.pos,g.pos,"","")))&&t.someProp("handleKeyDown",S=>S(t,Cn(13,"Enter")))){t.input.lastIOSEnter=0;return}if(t.state.selection.anchor>h.start&&bT(d,h.start,h.endA,m,g)&&t.someProp("handleKeyDown",S=>S(t,Cn(8,"Backspace")))){kt&&ye&&t.domObserver.suppressSelectionUpdates();return}ye&&h.endB==h.start&&(t.input.lastChromeDelete=Date.now()),kt&&!x&&m.start()!=g.start()&&g.parentOffset==0&&m.depth==g.depth&&c.sel&&c.sel.anchor==c.sel.head&&c.sel.head==h.endA&&(h.endB-=2,g=c.doc.resolveNoCache(h.endB-c.from),setTimeout(()=>{t.someProp("handleKeyDown",function(S){return S(t,Cn(13,"Enter"))})},20));let C=h.start,M=h.endA,T=S=>{let P=S||t.state.tr.replace(C,M,c.doc.slice(h.start-c.from,h.endB-c.from));if(c.sel){let D=Gf(t,P.doc,c.sel);D&&!(ye&&t.composing&&D.empty&&(h.start!=h.endB||t.input.lastChromeDelete<Date.now()-100)&&(D.head==C||D.head==P.mapping.map(M)-1)||Ie&&D.empty&&D.head==C)&&P.setSelection(D)}return o&&P.setMeta("composition",o),P.scrollIntoView()},R;if(x)if(m.pos==g.pos){Ie&&Kt<=11&&m.parentOffset==0&&(t.domObserver.suppressSelectionUpdates(),setTimeout(()=>Mt(t),20));let S=T(t.state.tr.delete(C,M)),P=d.resolve(h.start).marksAcross(d.resolve(h.endA));P&&S.ensureMarks(P),t.dispatch(S)}else if(h.endA==h.endB&&(R=yT(m.parent.content.cut(m.parentOffset,g.parentOffset),y.parent.content.cut(y.parentOffset,h.endA-y.start())))){let S=T(t.state.tr);R.type=="add"?S.addMark(C,M,R.mark):S.removeMark(C,M,R.mark),t.dispatch(S)}else if(m.parent.child(m.index()).isText&&m.index()==g.index()-(g.textOffset?0:1)){let S=m.parent.textBetween(m.parentOffset,g.parentOffset),P=()=>T(t.state.tr.insertText(S,C,M));t.someProp("handleTextInput",D=>D(t,C,M,S,P))||t.dispatch(P())}else t.dispatch(T());else t.dispatch(T())}function Gf(t,e,n){return Math.max(n.anchor,n.head)>e.content.size?null:Nl(t,e.resolve(n.anchor),e.resolve(n.head))}function yT(t,e){let n=t.firstChild.marks,r=e.firstChild.marks,i=n,o=r,s,a,l;for(let d=0;d<r.length;d++)i=r[d].removeFromSet(i);for(let d=0;d<n.length;d++)o=n[d].removeFromSet(o);if(i.length==1&&o.length==0)a=i[0],s="add",l=d=>d.mark(a.addToSet(d.marks));else if(i.length==0&&o.length==1)a=o[0],s="remove",l=d=>d.mark(a.removeFromSet(d.marks));else return null;let c=[];for(let d=0;d<e.childCount;d++)c.push(l(e.child(d)));if(E.from(c).eq(t))return{mark:a,type:s}}function bT(t,e,n,r,i){if(n-e<=i.pos-r.pos||ul(r,!0,!1)<i.pos)return!1;let o=t.resolve(e);if(!r.parent.isTextblock){let a=o.nodeAfter;return a!=null&&n==e+a.nodeSize}if(o.parentOffset<o.parent.content.size||!o.parent.isTextblock)return!1;let s=t.resolve(ul(o,!0,!0));return!s.parent.isTextblock||s.pos>n||ul(s,!0,!1)<n?!1:r.parent.content.cut(r.parentOffset).eq(s.parent.content)}function ul(t,e,n){let r=t.depth,i=e?t.end():t.pos;for(;r>0&&(e||t.indexAfter(r)==t.node(r).childCount);)r--,i++,e=!1;if(n){let o=t.node(r).maybeChild(t.indexAfter(r));for(;o&&!o.isLeaf;)o=o.firstChild,i++}return i}function xT(t,e,n,r,i){let o=t.findDiffStart(e,n);if(o==null)return null;let{a:s,b:a}=t.findDiffEnd(e,n+t.size,n+e.size);if(i=="end"){let l=Math.max(0,o-Math.min(s,a));r-=s+l-o}if(s<o&&t.size<e.size){let l=r<=o&&r>=s?o-r:0;o-=l,o&&o<e.size&&Yf(e.textBetween(o-1,o+1))&&(o+=l?1:-1),a=o+(a-s),s=o}else if(a<o){let l=r<=o&&r>=a?o-r:0;o-=l,o&&o<t.size&&Yf(t.textBetween(o-1,o+1))&&(o+=l?1:-1),s=o+(s-a),a=o}return{start:o,endA:s,endB:a}}function Yf(t){if(t.length!=2)return!1;let e=t.charCodeAt(0),n=t.charCodeAt(1);return e>=56320&&e<=57343&&n>=55296&&n<=56319}var Po=class{constructor(e,n){this._root=null,this.focused=!1,this.trackWrites=null,this.mounted=!1,this.markCursor=null,this.cursorWrapper=null,this.lastSelectedViewDesc=void 0,this.input=new Sl,this.prevDirectPlugins=[],this.pluginViews=[],this.requiresGeckoHackNode=!1,this.dragging=null,this._props=n,this.state=n.state,this.directPlugins=n.plugins||[],this.directPlugins.forEach(th),this.dispatch=this.dispatch.bind(this),this.dom=e&&e.mount||document.createElement("div"),e&&(e.appendChild?e.appendChild(this.dom):typeof e=="function"?e(this.dom):e.mount&&(this.mounted=!0)),this.editable=Zf(this),Xf(this),this.nodeViews=eh(this),this.docView=Lf(this.state.doc,Qf(this),dl(this),this.dom,this),this.domObserver=new Rl(this,(r,i,o,s)=>gT(this,r,i,o,s)),this.domObserver.start(),Uv(this),this.updatePluginViews()}get composing(){return this.input.composing}get props(){if(this._props.state!=this.state){let e=this._props;this._props={};for(let n in e)this._props[n]=e[n];this._props.state=this.state}return this._props}update(e){e.handleDOMEvents!=this._props.handleDOMEvents&&kl(this);let n=this._props;this._props=e,e.plugins&&(e.plugins.forEach(th),this.directPlugins=e.plugins),this.updateStateInner(e.state,n)}setProps(e){let n={};for(let r in this._props)n[r]=this._props[r];n.state=this.state;for(let r in e)n[r]=e[r];this.update(n)}updateState(e){this.updateStateInner(e,this._props)}updateStateInner(e,n){var r;let i=this.state,o=!1,s=!1;e.storedMarks&&this.composing&&(Mh(this),s=!0),this.state=e;let a=i.plugins!=e.plugins||this._props.plugins!=n.plugins;if(a||this._props.plugins!=n.plugins||this._props.nodeViews!=n.nodeViews){let f=eh(this);ET(f,this.nodeViews)&&(this.nodeViews=f,o=!0)}(a||n.handleDOMEvents!=this._props.handleDOMEvents)&&kl(this),this.editable=Zf(this),Xf(this);let l=dl(this),c=Qf(this),d=i.plugins!=e.plugins&&!i.doc.eq(e.doc)?"reset":e.scrollToSelection>i.scrollToSelection?"to selection":"preserve",u=o||!this.docView.matchesNode(e.doc,c,l);(u||!e.selection.eq(i.selection))&&(s=!0);let p=d=="preserve"&&s&&this.dom.style.overflowAnchor==null&&sv(this);if(s){this.domObserver.stop();let f=u&&(Ie||ye)&&!this.composing&&!i.selection.empty&&!e.selection.empty&&CT(i.selection,e.selection);if(u){let h=ye?this.trackWrites=this.domSelectionRange().focusNode:null;this.composing&&(this.input.compositionNode=eT(this)),(o||!this.docView.update(e.doc,c,l,this))&&(this.docView.updateOuterDeco(c),this.docView.destroy(),this.docView=Lf(e.doc,c,l,this.dom,this)),h&&!this.trackWrites&&(f=!0)}f||!(this.input.mouseDown&&this.domObserver.currentSelection.eq(this.domSelectionRange())&&wv(this))?Mt(this,f):(hh(this,e.selection),this.domObserver.setCurSelection()),this.domObserver.start()}this.updatePluginViews(i),!((r=this.dragging)===null||r===void 0)&&r.node&&!i.doc.eq(e.doc)&&this.updateDraggedNode(this.dragging,i),d=="reset"?this.dom.scrollTop=0:d=="to selection"?this.scrollToSelection():p&&av(p)}scrollToSelection(){let e=this.domSelectionRange().focusNode;if(!(!e||!this.dom.contains(e.nodeType==1?e:e.parentNode))){if(!this.someProp("handleScrollToSelection",n=>n(this)))if(this.state.selection instanceof A){let n=this.docView.domAfterPos(this.state.selection.from);n.nodeType==1&&kf(this,n.getBoundingClientRect(),e)}else kf(this,this.coordsAtPos(this.state.selection.head,1),e)}}destroyPluginViews(){let e;for(;e=this.pluginViews.pop();)e.destroy&&e.destroy()}updatePluginViews(e){if(!e||e.plugins!=this.state.plugins||this.directPlugins!=this.prevDirectPlugins){this.prevDirectPlugins=this.directPlugins,this.destroyPluginViews();for(let n=0;n<this.directPlugins.length;n++){let r=this.directPlugins[n];r.spec.view&&this.pluginViews.push(r.spec.view(this))}for(let n=0;n<this.state.plugins.length;n++){let r=this.state.plugins[n];r.spec.view&&this.pluginViews.push(r.spec.view(this))}}else for(let n=0;n<this.pluginViews.length;n++){let r=this.pluginViews[n];r.update&&r.update(this,e)}}updateDraggedNode(e,n){let r=e.node,i=-1;if(this.state.doc.nodeAt(r.from)==r.node)i=r.from;else{let o=r.from+(this.state.doc.content.size-n.doc.content.size);(o>0&&this.state.doc.nodeAt(o))==r.node&&(i=o)}this.dragging=new Oo(e.slice,e.move,i<0?void 0:A.create(this.state.doc,i))}someProp(e,n){let r=this._props&&this._props[e],i;if(r!=null&&(i=n?n(r):r))return i;for(let s=0;s<this.directPlugins.length;s++){let a=this.directPlugins[s].props[e];if(a!=null&&(i=n?n(a):a))return i}let o=this.state.plugins;if(o)for(let s=0;s<o.length;s++){let a=o[s].props[e];if(a!=null&&(i=n?n(a):a))return i}}hasFocus(){if(Ie){let e=this.root.activeElement;if(e==this.dom)return!0;if(!e||!this.dom.contains(e))return!1;for(;e&&this.dom!=e&&this.dom.contains(e);){if(e.contentEditable=="false")return!1;e=e.parentElement}return!0}return this.root.activeElement==this.dom}focus(){this.domObserver.stop(),this.editable&&lv(this.dom),Mt(this),this.domObserver.start()}get root(){let e=this._root;if(e==null){for(let n=this.dom.parentNode;n;n=n.parentNode)if(n.nodeType==9||n.nodeType==11&&n.host)return n.getSelection||(Object.getPrototypeOf(n).getSelection=()=>n.ownerDocument.getSelection()),this._root=n}return e||document}updateRoot(){this._root=null}posAtCoords(e){return fv(this,e)}coordsAtPos(e,n=1){return lh(this,e,n)}domAtPos(e,n=0){return this.docView.domFromPos(e,n)}nodeDOM(e){let n=this.docView.descAt(e);return n?n.nodeDOM:null}posAtDOM(e,n,r=-1){let i=this.docView.posFromDOM(e,n,r);if(i==null)throw new RangeError("DOM position not inside the editor");return i}endOfTextblock(e,n){return bv(this,n||this.state,e)}pasteHTML(e,n){return ni(this,"",e,!1,n||new ClipboardEvent("paste"))}pasteText(e,n){return ni(this,e,null,!0,n||new ClipboardEvent("paste"))}serializeForClipboard(e){return Il(this,e)}destroy(){this.docView&&(_v(this),this.destroyPluginViews(),this.mounted?(this.docView.update(this.state.doc,[],dl(this),this),this.dom.textContent=""):this.dom.parentNode&&this.dom.parentNode.removeChild(this.dom),this.docView.destroy(),this.docView=null,GE())}get isDestroyed(){return this.docView==null}dispatchEvent(e){return Wv(this,e)}domSelectionRange(){let e=this.domSelection();return e?ve&&this.root.nodeType===11&&ev(this.dom.ownerDocument)==this.dom&&uT(this,e)||e:{focusNode:null,focusOffset:0,anchorNode:null,anchorOffset:0}}domSelection(){return this.root.getSelection()}};Po.prototype.dispatch=function(t){let e=this._props.dispatchTransaction;e?e.call(this,t):this.updateState(this.state.apply(t))};function Qf(t){let e=Object.create(null);return e.class="ProseMirror",e.contenteditable=String(t.editable),t.someProp("attributes",n=>{if(typeof n=="function"&&(n=n(t.state)),n)for(let r in n)r=="class"?e.class+=" "+n[r]:r=="style"?e.style=(e.style?e.style+";":"")+n[r]:!e[r]&&r!="contenteditable"&&r!="nodeName"&&(e[r]=String(n[r]))}),e.translate||(e.translate="no"),[Qe.node(0,t.state.doc.content.size,e)]}function Xf(t){if(t.markCursor){let e=document.createElement("img");e.className="ProseMirror-separator",e.setAttribute("mark-placeholder","true"),e.setAttribute("alt",""),t.cursorWrapper={dom:e,deco:Qe.widget(t.state.selection.from,e,{raw:!0,marks:t.markCursor})}}else t.cursorWrapper=null}function Zf(t){return!t.someProp("editable",e=>e(t.state)===!1)}function CT(t,e){let n=Math.min(t.$anchor.sharedDepth(t.head),e.$anchor.sharedDepth(e.head));return t.$anchor.start(n)!=e.$anchor.start(n)}function eh(t){let e=Object.create(null);function n(r){for(let i in r)Object.prototype.hasOwnProperty.call(e,i)||(e[i]=r[i])}return t.someProp("nodeViews",n),t.someProp("markViews",n),e}function ET(t,e){let n=0,r=0;for(let i in t){if(t[i]!=e[i])return!0;n++}for(let i in e)r++;return n!=r}function th(t){if(t.spec.state||t.spec.filterTransaction||t.spec.appendTransaction)throw new RangeError("Plugins passed directly to the view must not have a state component")}var wt={8:"Backspace",9:"Tab",10:"Enter",12:"NumLock",13:"Enter",16:"Shift",17:"Control",18:"Alt",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",44:"PrintScreen",45:"Insert",46:"Delete",59:";",61:"=",91:"Meta",92:"Meta",106:"*",107:"+",108:",",109:"-",110:".",111:"/",144:"NumLock",145:"ScrollLock",160:"Shift",161:"Shift",162:"Control",163:"Control",164:"Alt",165:"Alt",173:"-",186:";",187:"=",188:",",189:"-",190:".",191:"/",192:"`",219:"[",220:"\\",221:"]",222:"'"},Bo={48:")",49:"!",50:"@",51:"#",52:"$",53:"%",54:"^",55:"&",56:"*",57:"(",59:":",61:"+",173:"_",186:":",187:"+",188:"<",189:"_",190:">",191:"?",192:"~",219:"{",220:"|",221:"}",222:'"'},vT=typeof navigator!="undefined"&&/Mac/.test(navigator.platform),TT=typeof navigator!="undefined"&&/MSIE \d|Trident\/(?:[7-9]|\d{2,})\..*rv:(\d+)/.exec(navigator.userAgent);for(oe=0;oe<10;oe++)wt[48+oe]=wt[96+oe]=String(oe);var oe;for(oe=1;oe<=24;oe++)wt[oe+111]="F"+oe;var oe;for(oe=65;oe<=90;oe++)wt[oe]=String.fromCharCode(oe+32),Bo[oe]=String.fromCharCode(oe);var oe;for(Fo in wt)Bo.hasOwnProperty(Fo)||(Bo[Fo]=wt[Fo]);var Fo;function Nh(t){var e=vT&&t.metaKey&&t.shiftKey&&!t.ctrlKey&&!t.altKey||TT&&t.shiftKey&&t.key&&t.key.length==1||t.key=="Unidentified",n=!e&&t.key||(t.shiftKey?Bo:wt)[t.keyCode]||t.key||"Unidentified";return n=="Esc"&&(n="Escape"),n=="Del"&&(n="Delete"),n=="Left"&&(n="ArrowLeft"),n=="Up"&&(n="ArrowUp"),n=="Right"&&(n="ArrowRight"),n=="Down"&&(n="ArrowDown"),n}var ST=typeof navigator!="undefined"&&/Mac|iP(hone|[oa]d)/.test(navigator.platform),kT=typeof navigator!="undefined"&&/Win/.test(navigator.platform);function MT(t){let e=t.split(/-(?!$)/),n=e[e.length-1];n=="Space"&&(n=" ");let r,i,o,s;for(let a=0;a<e.length-1;a++){let l=e[a];if(/^(cmd|meta|m)$/i.test(l))s=!0;else if(/^a(lt)?$/i.test(l))r=!0;else if(/^(c|ctrl|control)$/i.test(l))i=!0;else if(/^s(hift)?$/i.test(l))o=!0;else if(/^mod$/i.test(l))ST?s=!0:i=!0;else throw new Error("Unrecognized modifier name: "+l)}return r&&(n="Alt-"+n),i&&(n="Ctrl-"+n),s&&(n="Meta-"+n),o&&(n="Shift-"+n),n}function wT(t){let e=Object.create(null);for(let n in t)e[MT(n)]=t[n];return e}function Bl(t,e,n=!0){return e.altKey&&(t="Alt-"+t),e.ctrlKey&&(t="Ctrl-"+t),e.metaKey&&(t="Meta-"+t),n&&e.shiftKey&&(t="Shift-"+t),t}function Ih(t){let e=wT(t);return function(n,r){let i=Nh(r),o,s=e[Bl(i,r)];if(s&&s(n.state,n.dispatch,n))return!0;if(i.length==1&&i!=" "){if(r.shiftKey){let a=e[Bl(i,r,!1)];if(a&&a(n.state,n.dispatch,n))return!0}if((r.altKey||r.metaKey||r.ctrlKey)&&!(kT&&r.ctrlKey&&r.altKey)&&(o=wt[r.keyCode])&&o!=i){let a=e[Bl(o,r)];if(a&&a(n.state,n.dispatch,n))return!0}}return!1}}function Z(t,e,n){return t.config[e]===void 0&&t.parent?Z(t.parent,e,n):typeof t.config[e]=="function"?t.config[e].bind({...n,parent:t.parent?Z(t.parent,e,n):null}):t.config[e]}function si(t){let e=t.filter(i=>i.type==="extension"),n=t.filter(i=>i.type==="node"),r=t.filter(i=>i.type==="mark");return{baseExtensions:e,nodeExtensions:n,markExtensions:r}}function $(t,e){if(typeof t=="string"){if(!e.nodes[t])throw Error(`There is no node type named '${t}'. Maybe you forgot to add the extension?`);return e.nodes[t]}return t}function U(...t){return t.filter(e=>!!e).reduce((e,n)=>{let r={...e};return Object.entries(n).forEach(([i,o])=>{if(!r[i]){r[i]=o;return}if(i==="class"){let a=o?String(o).split(" "):[],l=r[i]?r[i].split(" "):[],c=a.filter(d=>!l.includes(d));r[i]=[...l,...c].join(" ")}else if(i==="style"){let a=o?o.split(";").map(d=>d.trim()).filter(Boolean):[],l=r[i]?r[i].split(";").map(d=>d.trim()).filter(Boolean):[],c=new Map;l.forEach(d=>{let[u,p]=d.split(":").map(f=>f.trim());c.set(u,p)}),a.forEach(d=>{let[u,p]=d.split(":").map(f=>f.trim());c.set(u,p)}),r[i]=Array.from(c.entries()).map(([d,u])=>`${d}: ${u}`).join("; ")}else r[i]=o}),r},{})}function Vl(t){return typeof t=="function"}function _(t,e=void 0,...n){return Vl(t)?e?t.bind(e)(...n):t(...n):t}function Vo(t){return Object.prototype.toString.call(t)==="[object RegExp]"}var lt=class{constructor(e){H(this,"find");H(this,"handler");this.find=e.find,this.handler=e.handler}};function OT(t){return Object.prototype.toString.call(t).slice(8,-1)}function ai(t){return OT(t)!=="Object"?!1:t.constructor===Object&&Object.getPrototypeOf(t)===Object.prototype}function An(t,e){let n={...t};return ai(t)&&ai(e)&&Object.keys(e).forEach(r=>{ai(e[r])&&ai(t[r])?n[r]=An(t[r],e[r]):n[r]=e[r]}),n}var ke=class t{constructor(e={}){H(this,"type","mark");H(this,"name","mark");H(this,"parent",null);H(this,"child",null);H(this,"options");H(this,"storage");H(this,"config",{name:this.name,defaultOptions:{}});this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=_(Z(this,"addOptions",{name:this.name}))),this.storage=_(Z(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>An(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t(e);return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=_(Z(n,"addOptions",{name:n.name})),n.storage=_(Z(n,"addStorage",{name:n.name,options:n.options})),n}static handleExit({editor:e,mark:n}){let{tr:r}=e.state,i=e.state.selection.$from;if(i.pos===i.end()){let s=i.marks();if(!!!s.find(c=>(c==null?void 0:c.type.name)===n.name))return!1;let l=s.find(c=>(c==null?void 0:c.type.name)===n.name);return l&&r.removeStoredMark(l),r.insertText(" ",i.pos),e.view.dispatch(r),!0}return!1}};var ur=class{constructor(e){H(this,"find");H(this,"handler");this.find=e.find,this.handler=e.handler}};var ci={};ki(ci,{ClipboardTextSerializer:()=>Ph,Commands:()=>mg,Drop:()=>gg,Editable:()=>yg,FocusEvents:()=>xg,Keymap:()=>Cg,Paste:()=>Eg,Tabindex:()=>vg,blur:()=>Dh,clearContent:()=>Hh,clearNodes:()=>Fh,command:()=>Bh,createParagraphNear:()=>Xh,cut:()=>Zh,deleteCurrentNode:()=>em,deleteNode:()=>tm,deleteRange:()=>nm,deleteSelection:()=>rm,enter:()=>im,exitCode:()=>om,extendMarkRange:()=>lm,first:()=>cm,focus:()=>dm,focusEventsPluginKey:()=>bg,forEach:()=>um,insertContent:()=>pm,insertContentAt:()=>mm,joinBackward:()=>bm,joinDown:()=>ym,joinForward:()=>xm,joinItemBackward:()=>Cm,joinItemForward:()=>Em,joinTextblockBackward:()=>vm,joinTextblockForward:()=>Tm,joinUp:()=>gm,keyboardShortcut:()=>Sm,lift:()=>km,liftEmptyBlock:()=>Mm,liftListItem:()=>Om,newlineInCode:()=>Lm,resetAttributes:()=>Nm,scrollIntoView:()=>Im,selectAll:()=>Pm,selectNodeBackward:()=>Dm,selectNodeForward:()=>Hm,selectParentNode:()=>Fm,selectTextblockEnd:()=>Bm,selectTextblockStart:()=>Vm,setContent:()=>zm,setMark:()=>Ym,setMeta:()=>Qm,setNode:()=>Xm,setNodeSelection:()=>Zm,setTextSelection:()=>eg,sinkListItem:()=>tg,splitBlock:()=>rg,splitListItem:()=>ig,toggleList:()=>og,toggleMark:()=>sg,toggleNode:()=>ag,toggleWrap:()=>lg,undoInputRule:()=>cg,unsetAllMarks:()=>dg,unsetMark:()=>ug,updateAttributes:()=>pg,wrapIn:()=>fg,wrapInList:()=>hg});var J=class t{constructor(e={}){H(this,"type","extension");H(this,"name","extension");H(this,"parent",null);H(this,"child",null);H(this,"options");H(this,"storage");H(this,"config",{name:this.name,defaultOptions:{}});this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=_(Z(this,"addOptions",{name:this.name}))),this.storage=_(Z(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>An(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t({...this.config,...e});return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=_(Z(n,"addOptions",{name:n.name})),n.storage=_(Z(n,"addStorage",{name:n.name,options:n.options})),n}};function $l(t,e,n){let{from:r,to:i}=e,{blockSeparator:o=`
+
+`,textSerializers:s={}}=n||{},a="";return t.nodesBetween(r,i,(l,c,d,u)=>{var f;l.isBlock&&c>r&&(a+=o);let p=s==null?void 0:s[l.type.name];if(p)return d&&(a+=p({node:l,pos:c,parent:d,index:u,range:e})),!1;l.isText&&(a+=(f=l==null?void 0:l.text)==null?void 0:f.slice(Math.max(r,c)-c,i-c))}),a}function zo(t){return Object.fromEntries(Object.entries(t.nodes).filter(([,e])=>e.spec.toText).map(([e,n])=>[e,n.spec.toText]))}var Ph=J.create({name:"clipboardTextSerializer",addOptions(){return{blockSeparator:void 0}},addProseMirrorPlugins(){return[new z({key:new K("clipboardTextSerializer"),props:{clipboardTextSerializer:()=>{let{editor:t}=this,{state:e,schema:n}=t,{doc:r,selection:i}=e,{ranges:o}=i,s=Math.min(...o.map(d=>d.$from.pos)),a=Math.max(...o.map(d=>d.$to.pos)),l=zo(n);return $l(r,{from:s,to:a},{...this.options.blockSeparator!==void 0?{blockSeparator:this.options.blockSeparator}:{},textSerializers:l})}}})]}});var fc={};ki(fc,{blur:()=>Dh,clearContent:()=>Hh,clearNodes:()=>Fh,command:()=>Bh,createParagraphNear:()=>Xh,cut:()=>Zh,deleteCurrentNode:()=>em,deleteNode:()=>tm,deleteRange:()=>nm,deleteSelection:()=>rm,enter:()=>im,exitCode:()=>om,extendMarkRange:()=>lm,first:()=>cm,focus:()=>dm,forEach:()=>um,insertContent:()=>pm,insertContentAt:()=>mm,joinBackward:()=>bm,joinDown:()=>ym,joinForward:()=>xm,joinItemBackward:()=>Cm,joinItemForward:()=>Em,joinTextblockBackward:()=>vm,joinTextblockForward:()=>Tm,joinUp:()=>gm,keyboardShortcut:()=>Sm,lift:()=>km,liftEmptyBlock:()=>Mm,liftListItem:()=>Om,newlineInCode:()=>Lm,resetAttributes:()=>Nm,scrollIntoView:()=>Im,selectAll:()=>Pm,selectNodeBackward:()=>Dm,selectNodeForward:()=>Hm,selectParentNode:()=>Fm,selectTextblockEnd:()=>Bm,selectTextblockStart:()=>Vm,setContent:()=>zm,setMark:()=>Ym,setMeta:()=>Qm,setNode:()=>Xm,setNodeSelection:()=>Zm,setTextSelection:()=>eg,sinkListItem:()=>tg,splitBlock:()=>rg,splitListItem:()=>ig,toggleList:()=>og,toggleMark:()=>sg,toggleNode:()=>ag,toggleWrap:()=>lg,undoInputRule:()=>cg,unsetAllMarks:()=>dg,unsetMark:()=>ug,updateAttributes:()=>pg,wrapIn:()=>fg,wrapInList:()=>hg});var Dh=()=>({editor:t,view:e})=>(requestAnimationFrame(()=>{var n;t.isDestroyed||(e.dom.blur(),(n=window==null?void 0:window.getSelection())==null||n.removeAllRanges())}),!0);var Hh=(t=!1)=>({commands:e})=>e.setContent("",t);var Fh=()=>({state:t,tr:e,dispatch:n})=>{let{selection:r}=e,{ranges:i}=r;return n&&i.forEach(({$from:o,$to:s})=>{t.doc.nodesBetween(o.pos,s.pos,(a,l)=>{if(a.type.isText)return;let{doc:c,mapping:d}=e,u=c.resolve(d.map(l)),p=c.resolve(d.map(l+a.nodeSize)),f=u.blockRange(p);if(!f)return;let h=vt(f);if(a.type.isTextblock){let{defaultType:m}=u.parent.contentMatchAt(u.index());e.setNodeMarkup(f.start,m)}(h||h===0)&&e.lift(f,h)})}),!0};var Bh=t=>e=>t(e);var $o=(t,e)=>t.selection.empty?!1:(e&&e(t.tr.deleteSelection().scrollIntoView()),!0);function zh(t,e){let{$cursor:n}=t.selection;return!n||(e?!e.endOfTextblock("backward",t):n.parentOffset>0)?null:n}var _l=(t,e,n)=>{let r=zh(t,n);if(!r)return!1;let i=Wl(r);if(!i){let s=r.blockRange(),a=s&&vt(s);return a==null?!1:(e&&e(t.tr.lift(s,a).scrollIntoView()),!0)}let o=i.nodeBefore;if(Gh(t,i,e,-1))return!0;if(r.parent.content.size==0&&(pr(o,"end")||A.isSelectable(o)))for(let s=r.depth;;s--){let a=Qr(t.doc,r.before(s),r.after(s),k.empty);if(a&&a.slice.size<a.to-a.from){if(e){let l=t.tr.step(a);l.setSelection(pr(o,"end")?I.findFrom(l.doc.resolve(l.mapping.map(i.pos,-1)),-1):A.create(l.doc,i.pos-o.nodeSize)),e(l.scrollIntoView())}return!0}if(s==1||r.node(s-1).childCount>1)break}return o.isAtom&&i.depth==r.depth-1?(e&&e(t.tr.delete(i.pos-o.nodeSize,i.pos).scrollIntoView()),!0):!1},$h=(t,e,n)=>{let r=zh(t,n);if(!r)return!1;let i=Wl(r);return i?_h(t,i,e):!1},Uh=(t,e,n)=>{let r=jh(t,n);if(!r)return!1;let i=Jl(r);return i?_h(t,i,e):!1};function _h(t,e,n){let r=e.nodeBefore,i=r,o=e.pos-1;for(;!i.isTextblock;o--){if(i.type.spec.isolating)return!1;let d=i.lastChild;if(!d)return!1;i=d}let s=e.nodeAfter,a=s,l=e.pos+1;for(;!a.isTextblock;l++){if(a.type.spec.isolating)return!1;let d=a.firstChild;if(!d)return!1;a=d}let c=Qr(t.doc,o,l,k.empty);if(!c||c.from!=o||c instanceof me&&c.slice.size>=l-o)return!1;if(n){let d=t.tr.step(c);d.setSelection(O.create(d.doc,o)),n(d.scrollIntoView())}return!0}function pr(t,e,n=!1){for(let r=t;r;r=e=="start"?r.firstChild:r.lastChild){if(r.isTextblock)return!0;if(n&&r.childCount!=1)return!1}return!1}var jl=(t,e,n)=>{let{$head:r,empty:i}=t.selection,o=r;if(!i)return!1;if(r.parent.isTextblock){if(n?!n.endOfTextblock("backward",t):r.parentOffset>0)return!1;o=Wl(r)}let s=o&&o.nodeBefore;return!s||!A.isSelectable(s)?!1:(e&&e(t.tr.setSelection(A.create(t.doc,o.pos-s.nodeSize)).scrollIntoView()),!0)};function Wl(t){if(!t.parent.type.spec.isolating)for(let e=t.depth-1;e>=0;e--){if(t.index(e)>0)return t.doc.resolve(t.before(e+1));if(t.node(e).type.spec.isolating)break}return null}function jh(t,e){let{$cursor:n}=t.selection;return!n||(e?!e.endOfTextblock("forward",t):n.parentOffset<n.parent.content.size)?null:n}var ql=(t,e,n)=>{let r=jh(t,n);if(!r)return!1;let i=Jl(r);if(!i)return!1;let o=i.nodeAfter;if(Gh(t,i,e,1))return!0;if(r.parent.content.size==0&&(pr(o,"start")||A.isSelectable(o))){let s=Qr(t.doc,r.before(),r.after(),k.empty);if(s&&s.slice.size<s.to-s.from){if(e){let a=t.tr.step(s);a.setSelection(pr(o,"start")?I.findFrom(a.doc.resolve(a.mapping.map(i.pos)),1):A.create(a.doc,a.mapping.map(i.pos))),e(a.scrollIntoView())}return!0}}return o.isAtom&&i.depth==r.depth-1?(e&&e(t.tr.delete(i.pos,i.pos+o.nodeSize).scrollIntoView()),!0):!1},Kl=(t,e,n)=>{let{$head:r,empty:i}=t.selection,o=r;if(!i)return!1;if(r.parent.isTextblock){if(n?!n.endOfTextblock("forward",t):r.parentOffset<r.parent.content.size)return!1;o=Jl(r)}let s=o&&o.nodeAfter;return!s||!A.isSelectable(s)?!1:(e&&e(t.tr.setSelection(A.create(t.doc,o.pos)).scrollIntoView()),!0)};function Jl(t){if(!t.parent.type.spec.isolating)for(let e=t.depth-1;e>=0;e--){let n=t.node(e);if(t.index(e)+1<n.childCount)return t.doc.resolve(t.after(e+1));if(n.type.spec.isolating)break}return null}var Wh=(t,e)=>{let n=t.selection,r=n instanceof A,i;if(r){if(n.node.isTextblock||!qe(t.doc,n.from))return!1;i=n.from}else if(i=xn(t.doc,n.from,-1),i==null)return!1;if(e){let o=t.tr.join(i);r&&o.setSelection(A.create(o.doc,i-t.doc.resolve(i).nodeBefore.nodeSize)),e(o.scrollIntoView())}return!0},qh=(t,e)=>{let n=t.selection,r;if(n instanceof A){if(n.node.isTextblock||!qe(t.doc,n.to))return!1;r=n.to}else if(r=xn(t.doc,n.to,1),r==null)return!1;return e&&e(t.tr.join(r).scrollIntoView()),!0},Kh=(t,e)=>{let{$from:n,$to:r}=t.selection,i=n.blockRange(r),o=i&&vt(i);return o==null?!1:(e&&e(t.tr.lift(i,o).scrollIntoView()),!0)},Gl=(t,e)=>{let{$head:n,$anchor:r}=t.selection;return!n.parent.type.spec.code||!n.sameParent(r)?!1:(e&&e(t.tr.insertText(`
+`).scrollIntoView()),!0)};function Yl(t){for(let e=0;e<t.edgeCount;e++){let{type:n}=t.edge(e);if(n.isTextblock&&!n.hasRequiredAttrs())return n}return null}var Ql=(t,e)=>{let{$head:n,$anchor:r}=t.selection;if(!n.parent.type.spec.code||!n.sameParent(r))return!1;let i=n.node(-1),o=n.indexAfter(-1),s=Yl(i.contentMatchAt(o));if(!s||!i.canReplaceWith(o,o,s))return!1;if(e){let a=n.after(),l=t.tr.replaceWith(a,a,s.createAndFill());l.setSelection(I.near(l.doc.resolve(a),1)),e(l.scrollIntoView())}return!0},Xl=(t,e)=>{let n=t.selection,{$from:r,$to:i}=n;if(n instanceof Ee||r.parent.inlineContent||i.parent.inlineContent)return!1;let o=Yl(i.parent.contentMatchAt(i.indexAfter()));if(!o||!o.isTextblock)return!1;if(e){let s=(!r.parentOffset&&i.index()<i.parent.childCount?r:i).pos,a=t.tr.insert(s,o.createAndFill());a.setSelection(O.create(a.doc,s+1)),e(a.scrollIntoView())}return!0},Zl=(t,e)=>{let{$cursor:n}=t.selection;if(!n||n.parent.content.size)return!1;if(n.depth>1&&n.after()!=n.end(-1)){let o=n.before();if(Ve(t.doc,o))return e&&e(t.tr.split(o).scrollIntoView()),!0}let r=n.blockRange(),i=r&&vt(r);return i==null?!1:(e&&e(t.tr.lift(r,i).scrollIntoView()),!0)};function NT(t){return(e,n)=>{let{$from:r,$to:i}=e.selection;if(e.selection instanceof A&&e.selection.node.isBlock)return!r.parentOffset||!Ve(e.doc,r.pos)?!1:(n&&n(e.tr.split(r.pos).scrollIntoView()),!0);if(!r.depth)return!1;let o=[],s,a,l=!1,c=!1;for(let f=r.depth;;f--)if(r.node(f).isBlock){l=r.end(f)==r.pos+(r.depth-f),c=r.start(f)==r.pos-(r.depth-f),a=Yl(r.node(f-1).contentMatchAt(r.indexAfter(f-1)));let m=t&&t(i.parent,l,r);o.unshift(m||(l&&a?{type:a}:null)),s=f;break}else{if(f==1)return!1;o.unshift(null)}let d=e.tr;(e.selection instanceof O||e.selection instanceof Ee)&&d.deleteSelection();let u=d.mapping.map(r.pos),p=Ve(d.doc,u,o.length,o);if(p||(o[0]=a?{type:a}:null,p=Ve(d.doc,u,o.length,o)),!p)return!1;if(d.split(u,o.length,o),!l&&c&&r.node(s).type!=a){let f=d.mapping.map(r.before(s)),h=d.doc.resolve(f);a&&r.node(s-1).canReplaceWith(h.index(),h.index()+1,a)&&d.setNodeMarkup(d.mapping.map(r.before(s)),a)}return n&&n(d.scrollIntoView()),!0}}var IT=NT();var Jh=(t,e)=>{let{$from:n,to:r}=t.selection,i,o=n.sharedDepth(r);return o==0?!1:(i=n.before(o),e&&e(t.tr.setSelection(A.create(t.doc,i))),!0)},PT=(t,e)=>(e&&e(t.tr.setSelection(new Ee(t.doc))),!0);function DT(t,e,n){let r=e.nodeBefore,i=e.nodeAfter,o=e.index();return!r||!i||!r.type.compatibleContent(i.type)?!1:!r.content.size&&e.parent.canReplace(o-1,o)?(n&&n(t.tr.delete(e.pos-r.nodeSize,e.pos).scrollIntoView()),!0):!e.parent.canReplace(o,o+1)||!(i.isTextblock||qe(t.doc,e.pos))?!1:(n&&n(t.tr.join(e.pos).scrollIntoView()),!0)}function Gh(t,e,n,r){let i=e.nodeBefore,o=e.nodeAfter,s,a,l=i.type.spec.isolating||o.type.spec.isolating;if(!l&&DT(t,e,n))return!0;let c=!l&&e.parent.canReplace(e.index(),e.index()+1);if(c&&(s=(a=i.contentMatchAt(i.childCount)).findWrapping(o.type))&&a.matchType(s[0]||o.type).validEnd){if(n){let f=e.pos+o.nodeSize,h=E.empty;for(let y=s.length-1;y>=0;y--)h=E.from(s[y].create(null,h));h=E.from(i.copy(h));let m=t.tr.step(new ie(e.pos-1,f,e.pos,f,new k(h,1,0),s.length,!0)),g=m.doc.resolve(f+2*s.length);g.nodeAfter&&g.nodeAfter.type==i.type&&qe(m.doc,g.pos)&&m.join(g.pos),n(m.scrollIntoView())}return!0}let d=o.type.spec.isolating||r>0&&l?null:I.findFrom(e,1),u=d&&d.$from.blockRange(d.$to),p=u&&vt(u);if(p!=null&&p>=e.depth)return n&&n(t.tr.lift(u,p).scrollIntoView()),!0;if(c&&pr(o,"start",!0)&&pr(i,"end")){let f=i,h=[];for(;h.push(f),!f.isTextblock;)f=f.lastChild;let m=o,g=1;for(;!m.isTextblock;m=m.firstChild)g++;if(f.canReplace(f.childCount,f.childCount,m.content)){if(n){let y=E.empty;for(let C=h.length-1;C>=0;C--)y=E.from(h[C].copy(y));let x=t.tr.step(new ie(e.pos-h.length,e.pos+o.nodeSize,e.pos+g,e.pos+o.nodeSize-g,new k(y,h.length,0),0,!0));n(x.scrollIntoView())}return!0}}return!1}function Yh(t){return function(e,n){let r=e.selection,i=t<0?r.$from:r.$to,o=i.depth;for(;i.node(o).isInline;){if(!o)return!1;o--}return i.node(o).isTextblock?(n&&n(e.tr.setSelection(O.create(e.doc,t<0?i.start(o):i.end(o)))),!0):!1}}var ec=Yh(-1),tc=Yh(1);function Qh(t,e=null){return function(n,r){let{$from:i,$to:o}=n.selection,s=i.blockRange(o),a=s&&tr(s,t,e);return a?(r&&r(n.tr.wrap(s,a).scrollIntoView()),!0):!1}}function nc(t,e=null){return function(n,r){let i=!1;for(let o=0;o<n.selection.ranges.length&&!i;o++){let{$from:{pos:s},$to:{pos:a}}=n.selection.ranges[o];n.doc.nodesBetween(s,a,(l,c)=>{if(i)return!1;if(!(!l.isTextblock||l.hasMarkup(t,e)))if(l.type==t)i=!0;else{let d=n.doc.resolve(c),u=d.index();i=d.parent.canReplaceWith(u,u+1,t)}})}if(!i)return!1;if(r){let o=n.tr;for(let s=0;s<n.selection.ranges.length;s++){let{$from:{pos:a},$to:{pos:l}}=n.selection.ranges[s];o.setBlockType(a,l,t,e)}r(o.scrollIntoView())}return!0}}function rc(...t){return function(e,n,r){for(let i=0;i<t.length;i++)if(t[i](e,n,r))return!0;return!1}}var Ul=rc($o,_l,jl),Vh=rc($o,ql,Kl),Yt={Enter:rc(Gl,Xl,Zl,IT),"Mod-Enter":Ql,Backspace:Ul,"Mod-Backspace":Ul,"Shift-Backspace":Ul,Delete:Vh,"Mod-Delete":Vh,"Mod-a":PT},HT={"Ctrl-h":Yt.Backspace,"Alt-Backspace":Yt["Mod-Backspace"],"Ctrl-d":Yt.Delete,"Ctrl-Alt-Backspace":Yt["Mod-Delete"],"Alt-Delete":Yt["Mod-Delete"],"Alt-d":Yt["Mod-Delete"],"Ctrl-a":ec,"Ctrl-e":tc};for(let t in Yt)HT[t]=Yt[t];var IA=typeof navigator!="undefined"?/Mac|iP(hone|[oa]d)/.test(navigator.platform):typeof os!="undefined"&&os.platform?os.platform()=="darwin":!1;var Xh=()=>({state:t,dispatch:e})=>Xl(t,e);var Zh=(t,e)=>({editor:n,tr:r})=>{let{state:i}=n,o=i.doc.slice(t.from,t.to);r.deleteRange(t.from,t.to);let s=r.mapping.map(e);return r.insert(s,o.content),r.setSelection(new O(r.doc.resolve(Math.max(s-1,0)))),!0};var em=()=>({tr:t,dispatch:e})=>{let{selection:n}=t,r=n.$anchor.node();if(r.content.size>0)return!1;let i=t.selection.$anchor;for(let o=i.depth;o>0;o-=1)if(i.node(o).type===r.type){if(e){let a=i.before(o),l=i.after(o);t.delete(a,l).scrollIntoView()}return!0}return!1};var tm=t=>({tr:e,state:n,dispatch:r})=>{let i=$(t,n.schema),o=e.selection.$anchor;for(let s=o.depth;s>0;s-=1)if(o.node(s).type===i){if(r){let l=o.before(s),c=o.after(s);e.delete(l,c).scrollIntoView()}return!0}return!1};var nm=t=>({tr:e,dispatch:n})=>{let{from:r,to:i}=t;return n&&e.delete(r,i),!0};var rm=()=>({state:t,dispatch:e})=>$o(t,e);var im=()=>({commands:t})=>t.keyboardShortcut("Enter");var om=()=>({state:t,dispatch:e})=>Ql(t,e);function Rn(t,e,n={strict:!0}){let r=Object.keys(e);return r.length?r.every(i=>n.strict?e[i]===t[i]:Vo(e[i])?e[i].test(t[i]):e[i]===t[i]):!0}function am(t,e,n={}){return t.find(r=>r.type===e&&Rn(Object.fromEntries(Object.keys(n).map(i=>[i,r.attrs[i]])),n))}function sm(t,e,n={}){return!!am(t,e,n)}function fr(t,e,n){var c;if(!t||!e)return;let r=t.parent.childAfter(t.parentOffset);if((!r.node||!r.node.marks.some(d=>d.type===e))&&(r=t.parent.childBefore(t.parentOffset)),!r.node||!r.node.marks.some(d=>d.type===e)||(n=n||((c=r.node.marks[0])==null?void 0:c.attrs),!am([...r.node.marks],e,n)))return;let o=r.index,s=t.start()+r.offset,a=o+1,l=s+r.node.nodeSize;for(;o>0&&sm([...t.parent.child(o-1).marks],e,n);)o-=1,s-=t.parent.child(o).nodeSize;for(;a<t.parent.childCount&&sm([...t.parent.child(a).marks],e,n);)l+=t.parent.child(a).nodeSize,a+=1;return{from:s,to:l}}function xe(t,e){if(typeof t=="string"){if(!e.marks[t])throw Error(`There is no mark type named '${t}'. Maybe you forgot to add the extension?`);return e.marks[t]}return t}var lm=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let o=xe(t,r.schema),{doc:s,selection:a}=n,{$from:l,from:c,to:d}=a;if(i){let u=fr(l,o,e);if(u&&u.from<=c&&u.to>=d){let p=O.create(s,u.from,u.to);n.setSelection(p)}}return!0};var cm=t=>e=>{let n=typeof t=="function"?t(e):t;for(let r=0;r<n.length;r+=1)if(n[r](e))return!0;return!1};function Uo(t){return t instanceof O}function ct(t=0,e=0,n=0){return Math.min(Math.max(t,e),n)}function ic(t,e=null){if(!e)return null;let n=I.atStart(t),r=I.atEnd(t);if(e==="start"||e===!0)return n;if(e==="end")return r;let i=n.from,o=r.to;return e==="all"?O.create(t,ct(0,i,o),ct(t.content.size,i,o)):O.create(t,ct(e,i,o),ct(e,i,o))}function oc(){return navigator.platform==="Android"||/android/i.test(navigator.userAgent)}function On(){return["iPad Simulator","iPhone Simulator","iPod Simulator","iPad","iPhone","iPod"].includes(navigator.platform)||navigator.userAgent.includes("Mac")&&"ontouchend"in document}var dm=(t=null,e={})=>({editor:n,view:r,tr:i,dispatch:o})=>{e={scrollIntoView:!0,...e};let s=()=>{(On()||oc())&&r.dom.focus(),requestAnimationFrame(()=>{n.isDestroyed||(r.focus(),e!=null&&e.scrollIntoView&&n.commands.scrollIntoView())})};if(r.hasFocus()&&t===null||t===!1)return!0;if(o&&t===null&&!Uo(n.state.selection))return s(),!0;let a=ic(i.doc,t)||n.state.selection,l=n.state.selection.eq(a);return o&&(l||i.setSelection(a),l&&i.storedMarks&&i.setStoredMarks(i.storedMarks),s()),!0};var um=(t,e)=>n=>t.every((r,i)=>e(r,{...n,index:i}));var pm=(t,e)=>({tr:n,commands:r})=>r.insertContentAt({from:n.selection.from,to:n.selection.to},t,e);var fm=t=>{let e=t.childNodes;for(let n=e.length-1;n>=0;n-=1){let r=e[n];r.nodeType===3&&r.nodeValue&&/^(\n\s\s|\n)$/.test(r.nodeValue)?t.removeChild(r):r.nodeType===1&&fm(r)}return t};function hr(t){let e=`<body>${t}</body>`,n=new window.DOMParser().parseFromString(e,"text/html").body;return fm(n)}function Ln(t,e,n){if(t instanceof Be||t instanceof E)return t;n={slice:!0,parseOptions:{},...n};let r=typeof t=="object"&&t!==null,i=typeof t=="string";if(r)try{if(Array.isArray(t)&&t.length>0)return E.fromArray(t.map(a=>e.nodeFromJSON(a)));let s=e.nodeFromJSON(t);return n.errorOnInvalidContent&&s.check(),s}catch(o){if(n.errorOnInvalidContent)throw new Error("[tiptap error]: Invalid JSON content",{cause:o});return console.warn("[tiptap warn]: Invalid content.","Passed value:",t,"Error:",o),Ln("",e,n)}if(i){if(n.errorOnInvalidContent){let s=!1,a="",l=new Ur({topNode:e.spec.topNode,marks:e.spec.marks,nodes:e.spec.nodes.append({__tiptap__private__unknown__catch__all__node:{content:"inline*",group:"block",parseDOM:[{tag:"*",getAttrs:c=>(s=!0,a=typeof c=="string"?c:c.outerHTML,null)}]}})});if(n.slice?dt.fromSchema(l).parseSlice(hr(t),n.parseOptions):dt.fromSchema(l).parse(hr(t),n.parseOptions),n.errorOnInvalidContent&&s)throw new Error("[tiptap error]: Invalid HTML content",{cause:new Error(`Invalid element found: ${a}`)})}let o=dt.fromSchema(e);return n.slice?o.parseSlice(hr(t),n.parseOptions).content:o.parse(hr(t),n.parseOptions)}return Ln("",e,n)}function hm(t,e,n){let r=t.steps.length-1;if(r<e)return;let i=t.steps[r];if(!(i instanceof me||i instanceof ie))return;let o=t.mapping.maps[r],s=0;o.forEach((a,l,c,d)=>{s===0&&(s=d)}),t.setSelection(I.near(t.doc.resolve(s),n))}var FT=t=>!("type"in t),mm=(t,e,n)=>({tr:r,dispatch:i,editor:o})=>{var s;if(i){n={parseOptions:o.options.parseOptions,updateSelection:!0,applyInputRules:!1,applyPasteRules:!1,...n};let a,l=g=>{o.emit("contentError",{editor:o,error:g,disableCollaboration:()=>{o.storage.collaboration&&(o.storage.collaboration.isDisabled=!0)}})},c={preserveWhitespace:"full",...n.parseOptions};if(!n.errorOnInvalidContent&&!o.options.enableContentCheck&&o.options.emitContentError)try{Ln(e,o.schema,{parseOptions:c,errorOnInvalidContent:!0})}catch(g){l(g)}try{a=Ln(e,o.schema,{parseOptions:c,errorOnInvalidContent:(s=n.errorOnInvalidContent)!=null?s:o.options.enableContentCheck})}catch(g){return l(g),!1}let{from:d,to:u}=typeof t=="number"?{from:t,to:t}:{from:t.from,to:t.to},p=!0,f=!0;if((FT(a)?a:[a]).forEach(g=>{g.check(),p=p?g.isText&&g.marks.length===0:!1,f=f?g.isBlock:!1}),d===u&&f){let{parent:g}=r.doc.resolve(d);g.isTextblock&&!g.type.spec.code&&!g.childCount&&(d-=1,u+=1)}let m;if(p){if(Array.isArray(e))m=e.map(g=>g.text||"").join("");else if(e instanceof E){let g="";e.forEach(y=>{y.text&&(g+=y.text)}),m=g}else typeof e=="object"&&e&&e.text?m=e.text:m=e;r.insertText(m,d,u)}else m=a,r.replaceWith(d,u,m);n.updateSelection&&hm(r,r.steps.length-1,-1),n.applyInputRules&&r.setMeta("applyInputRules",{from:d,text:m}),n.applyPasteRules&&r.setMeta("applyPasteRules",{from:d,text:m})}return!0};var gm=()=>({state:t,dispatch:e})=>Wh(t,e),ym=()=>({state:t,dispatch:e})=>qh(t,e),bm=()=>({state:t,dispatch:e})=>_l(t,e),xm=()=>({state:t,dispatch:e})=>ql(t,e);var Cm=()=>({state:t,dispatch:e,tr:n})=>{try{let r=xn(t.doc,t.selection.$from.pos,-1);return r==null?!1:(n.join(r,2),e&&e(n),!0)}catch{return!1}};var Em=()=>({state:t,dispatch:e,tr:n})=>{try{let r=xn(t.doc,t.selection.$from.pos,1);return r==null?!1:(n.join(r,2),e&&e(n),!0)}catch{return!1}};var vm=()=>({state:t,dispatch:e})=>$h(t,e);var Tm=()=>({state:t,dispatch:e})=>Uh(t,e);function _o(){return typeof navigator!="undefined"?/Mac/.test(navigator.platform):!1}function BT(t){let e=t.split(/-(?!$)/),n=e[e.length-1];n==="Space"&&(n=" ");let r,i,o,s;for(let a=0;a<e.length-1;a+=1){let l=e[a];if(/^(cmd|meta|m)$/i.test(l))s=!0;else if(/^a(lt)?$/i.test(l))r=!0;else if(/^(c|ctrl|control)$/i.test(l))i=!0;else if(/^s(hift)?$/i.test(l))o=!0;else if(/^mod$/i.test(l))On()||_o()?s=!0:i=!0;else throw new Error(`Unrecognized modifier name: ${l}`)}return r&&(n=`Alt-${n}`),i&&(n=`Ctrl-${n}`),s&&(n=`Meta-${n}`),o&&(n=`Shift-${n}`),n}var Sm=t=>({editor:e,view:n,tr:r,dispatch:i})=>{let o=BT(t).split(/-(?!$)/),s=o.find(c=>!["Alt","Ctrl","Meta","Shift"].includes(c)),a=new KeyboardEvent("keydown",{key:s==="Space"?" ":s,altKey:o.includes("Alt"),ctrlKey:o.includes("Ctrl"),metaKey:o.includes("Meta"),shiftKey:o.includes("Shift"),bubbles:!0,cancelable:!0}),l=e.captureTransaction(()=>{n.someProp("handleKeyDown",c=>c(n,a))});return l==null||l.steps.forEach(c=>{let d=c.map(r.mapping);d&&i&&r.maybeStep(d)}),!0};function Nn(t,e,n={}){let{from:r,to:i,empty:o}=t.selection,s=e?$(e,t.schema):null,a=[];t.doc.nodesBetween(r,i,(u,p)=>{if(u.isText)return;let f=Math.max(r,p),h=Math.min(i,p+u.nodeSize);a.push({node:u,from:f,to:h})});let l=i-r,c=a.filter(u=>s?s.name===u.node.type.name:!0).filter(u=>Rn(u.node.attrs,n,{strict:!1}));return o?!!c.length:c.reduce((u,p)=>u+p.to-p.from,0)>=l}var km=(t,e={})=>({state:n,dispatch:r})=>{let i=$(t,n.schema);return Nn(n,i,e)?Kh(n,r):!1};var Mm=()=>({state:t,dispatch:e})=>Zl(t,e);function wm(t,e=null){return function(n,r){let{$from:i,$to:o}=n.selection,s=i.blockRange(o);if(!s)return!1;let a=r?n.tr:null;return VT(a,s,t,e)?(r&&r(a.scrollIntoView()),!0):!1}}function VT(t,e,n,r=null){let i=!1,o=e,s=e.$from.doc;if(e.depth>=2&&e.$from.node(e.depth-1).type.compatibleContent(n)&&e.startIndex==0){if(e.$from.index(e.depth-1)==0)return!1;let l=s.resolve(e.start-2);o=new gn(l,l,e.depth),e.endIndex<e.parent.childCount&&(e=new gn(e.$from,s.resolve(e.$to.end(e.depth)),e.depth)),i=!0}let a=tr(o,n,r,e);return a?(t&&zT(t,e,a,i,n),!0):!1}function zT(t,e,n,r,i){let o=E.empty;for(let d=n.length-1;d>=0;d--)o=E.from(n[d].type.create(n[d].attrs,o));t.step(new ie(e.start-(r?2:0),e.end,e.start,e.end,new k(o,0,0),n.length,!0));let s=0;for(let d=0;d<n.length;d++)n[d].type==i&&(s=d+1);let a=n.length-s,l=e.start+n.length-(r?2:0),c=e.parent;for(let d=e.startIndex,u=e.endIndex,p=!0;d<u;d++,p=!1)!p&&Ve(t.doc,l,a)&&(t.split(l,a),l+=2*a),l+=c.child(d).nodeSize;return t}function Am(t){return function(e,n){let{$from:r,$to:i}=e.selection,o=r.blockRange(i,s=>s.childCount>0&&s.firstChild.type==t);return o?n?r.node(o.depth-1).type==t?$T(e,n,t,o):UT(e,n,o):!0:!1}}function $T(t,e,n,r){let i=t.tr,o=r.end,s=r.$to.end(r.depth);o<s&&(i.step(new ie(o-1,s,o,s,new k(E.from(n.create(null,r.parent.copy())),1,0),1,!0)),r=new gn(i.doc.resolve(r.$from.pos),i.doc.resolve(s),r.depth));let a=vt(r);if(a==null)return!1;i.lift(r,a);let l=i.doc.resolve(i.mapping.map(o,-1)-1);return qe(i.doc,l.pos)&&l.nodeBefore.type==l.nodeAfter.type&&i.join(l.pos),e(i.scrollIntoView()),!0}function UT(t,e,n){let r=t.tr,i=n.parent;for(let f=n.end,h=n.endIndex-1,m=n.startIndex;h>m;h--)f-=i.child(h).nodeSize,r.delete(f-1,f+1);let o=r.doc.resolve(n.start),s=o.nodeAfter;if(r.mapping.map(n.end)!=n.start+o.nodeAfter.nodeSize)return!1;let a=n.startIndex==0,l=n.endIndex==i.childCount,c=o.node(-1),d=o.index(-1);if(!c.canReplace(d+(a?0:1),d+1,s.content.append(l?E.empty:E.from(i))))return!1;let u=o.pos,p=u+s.nodeSize;return r.step(new ie(u-(a?1:0),p+(l?1:0),u+1,p-1,new k((a?E.empty:E.from(i.copy(E.empty))).append(l?E.empty:E.from(i.copy(E.empty))),a?0:1,l?0:1),a?0:1)),e(r.scrollIntoView()),!0}function Rm(t){return function(e,n){let{$from:r,$to:i}=e.selection,o=r.blockRange(i,c=>c.childCount>0&&c.firstChild.type==t);if(!o)return!1;let s=o.startIndex;if(s==0)return!1;let a=o.parent,l=a.child(s-1);if(l.type!=t)return!1;if(n){let c=l.lastChild&&l.lastChild.type==a.type,d=E.from(c?t.create():null),u=new k(E.from(t.create(null,E.from(a.type.create(null,d)))),c?3:1,0),p=o.start,f=o.end;n(e.tr.step(new ie(p-(c?3:1),f,p,f,u,1,!0)).scrollIntoView())}return!0}}var Om=t=>({state:e,dispatch:n})=>{let r=$(t,e.schema);return Am(r)(e,n)};var Lm=()=>({state:t,dispatch:e})=>Gl(t,e);function In(t,e){return e.nodes[t]?"node":e.marks[t]?"mark":null}function sc(t,e){let n=typeof e=="string"?[e]:e;return Object.keys(t).reduce((r,i)=>(n.includes(i)||(r[i]=t[i]),r),{})}var Nm=(t,e)=>({tr:n,state:r,dispatch:i})=>{let o=null,s=null,a=In(typeof t=="string"?t:t.name,r.schema);return a?(a==="node"&&(o=$(t,r.schema)),a==="mark"&&(s=xe(t,r.schema)),i&&n.selection.ranges.forEach(l=>{r.doc.nodesBetween(l.$from.pos,l.$to.pos,(c,d)=>{o&&o===c.type&&n.setNodeMarkup(d,void 0,sc(c.attrs,e)),s&&c.marks.length&&c.marks.forEach(u=>{s===u.type&&n.addMark(d,d+c.nodeSize,s.create(sc(u.attrs,e)))})})}),!0):!1};var Im=()=>({tr:t,dispatch:e})=>(e&&t.scrollIntoView(),!0);var Pm=()=>({tr:t,dispatch:e})=>{if(e){let n=new Ee(t.doc);t.setSelection(n)}return!0};var Dm=()=>({state:t,dispatch:e})=>jl(t,e);var Hm=()=>({state:t,dispatch:e})=>Kl(t,e);var Fm=()=>({state:t,dispatch:e})=>Jh(t,e);var Bm=()=>({state:t,dispatch:e})=>tc(t,e);var Vm=()=>({state:t,dispatch:e})=>ec(t,e);function ac(t,e,n={},r={}){return Ln(t,e,{slice:!1,parseOptions:n,errorOnInvalidContent:r.errorOnInvalidContent})}var zm=(t,e=!1,n={},r={})=>({editor:i,tr:o,dispatch:s,commands:a})=>{var c,d;let{doc:l}=o;if(n.preserveWhitespace!=="full"){let u=ac(t,i.schema,n,{errorOnInvalidContent:(c=r.errorOnInvalidContent)!=null?c:i.options.enableContentCheck});return s&&o.replaceWith(0,l.content.size,u).setMeta("preventUpdate",!e),!0}return s&&o.setMeta("preventUpdate",!e),a.insertContentAt({from:0,to:l.content.size},t,{parseOptions:n,errorOnInvalidContent:(d=r.errorOnInvalidContent)!=null?d:i.options.enableContentCheck})};function jo(t,e){let n=xe(e,t.schema),{from:r,to:i,empty:o}=t.selection,s=[];o?(t.storedMarks&&s.push(...t.storedMarks),s.push(...t.selection.$head.marks())):t.doc.nodesBetween(r,i,l=>{s.push(...l.marks)});let a=s.find(l=>l.type.name===n.name);return a?{...a.attrs}:{}}function $m(t,e){let n=new Yr(t);return e.forEach(r=>{r.steps.forEach(i=>{n.step(i)})}),n}function Um(t){for(let e=0;e<t.edgeCount;e+=1){let{type:n}=t.edge(e);if(n.isTextblock&&!n.hasRequiredAttrs())return n}return null}function _m(t,e,n){let r=[];return t.nodesBetween(e.from,e.to,(i,o)=>{n(i)&&r.push({node:i,pos:o})}),r}function jm(t,e){for(let n=t.depth;n>0;n-=1){let r=t.node(n);if(e(r))return{pos:n>0?t.before(n):0,start:t.start(n),depth:n,node:r}}}function li(t){return e=>jm(e.$from,t)}function qm(t,e){let n=$(e,t.schema),{from:r,to:i}=t.selection,o=[];t.doc.nodesBetween(r,i,a=>{o.push(a)});let s=o.reverse().find(a=>a.type.name===n.name);return s?{...s.attrs}:{}}function lc(t,e){let n=In(typeof e=="string"?e:e.name,t.schema);return n==="node"?qm(t,e):n==="mark"?jo(t,e):{}}function Km(t,e=JSON.stringify){let n={};return t.filter(r=>{let i=e(r);return Object.prototype.hasOwnProperty.call(n,i)?!1:n[i]=!0})}function WT(t){let e=Km(t);return e.length===1?e:e.filter((n,r)=>!e.filter((o,s)=>s!==r).some(o=>n.oldRange.from>=o.oldRange.from&&n.oldRange.to<=o.oldRange.to&&n.newRange.from>=o.newRange.from&&n.newRange.to<=o.newRange.to))}function Jm(t){let{mapping:e,steps:n}=t,r=[];return e.maps.forEach((i,o)=>{let s=[];if(i.ranges.length)i.forEach((a,l)=>{s.push({from:a,to:l})});else{let{from:a,to:l}=n[o];if(a===void 0||l===void 0)return;s.push({from:a,to:l})}s.forEach(({from:a,to:l})=>{let c=e.slice(o).map(a,-1),d=e.slice(o).map(l),u=e.invert().map(c,-1),p=e.invert().map(d);r.push({oldRange:{from:u,to:p},newRange:{from:c,to:d}})})}),WT(r)}function mr(t,e,n){let r=[];return t===e?n.resolve(t).marks().forEach(i=>{let o=n.resolve(t),s=fr(o,i.type);s&&r.push({mark:i,...s})}):n.nodesBetween(t,e,(i,o)=>{!i||(i==null?void 0:i.nodeSize)===void 0||r.push(...i.marks.map(s=>({from:o,to:o+i.nodeSize,mark:s})))}),r}function gr(t,e,n){return Object.fromEntries(Object.entries(n).filter(([r])=>{let i=t.find(o=>o.type===e&&o.name===r);return i?i.attribute.keepOnSplit:!1}))}function cc(t,e,n={}){let{empty:r,ranges:i}=t.selection,o=e?xe(e,t.schema):null;if(r)return!!(t.storedMarks||t.selection.$from.marks()).filter(u=>o?o.name===u.type.name:!0).find(u=>Rn(u.attrs,n,{strict:!1}));let s=0,a=[];if(i.forEach(({$from:u,$to:p})=>{let f=u.pos,h=p.pos;t.doc.nodesBetween(f,h,(m,g)=>{if(!m.isText&&!m.marks.length)return;let y=Math.max(f,g),x=Math.min(h,g+m.nodeSize),C=x-y;s+=C,a.push(...m.marks.map(M=>({mark:M,from:y,to:x})))})}),s===0)return!1;let l=a.filter(u=>o?o.name===u.mark.type.name:!0).filter(u=>Rn(u.mark.attrs,n,{strict:!1})).reduce((u,p)=>u+p.to-p.from,0),c=a.filter(u=>o?u.mark.type!==o&&u.mark.type.excludes(o):!0).reduce((u,p)=>u+p.to-p.from,0);return(l>0?l+c:l)>=s}function dc(t,e){let{nodeExtensions:n}=si(e),r=n.find(s=>s.name===t);if(!r)return!1;let i={name:r.name,options:r.options,storage:r.storage},o=_(Z(r,"group",i));return typeof o!="string"?!1:o.split(" ").includes("list")}function yr(t,{checkChildren:e=!0,ignoreWhitespace:n=!1}={}){var r;if(n){if(t.type.name==="hardBreak")return!0;if(t.isText)return/^\s*$/m.test((r=t.text)!=null?r:"")}if(t.isText)return!t.text;if(t.isAtom||t.isLeaf)return!1;if(t.content.childCount===0)return!0;if(e){let i=!0;return t.content.forEach(o=>{i!==!1&&(yr(o,{ignoreWhitespace:n,checkChildren:e})||(i=!1))}),i}return!1}function Gm(t){return t instanceof A}function qT(t,e,n){var s;let{selection:r}=e,i=null;if(Uo(r)&&(i=r.$cursor),i){let a=(s=t.storedMarks)!=null?s:i.marks();return!!n.isInSet(a)||!a.some(l=>l.type.excludes(n))}let{ranges:o}=r;return o.some(({$from:a,$to:l})=>{let c=a.depth===0?t.doc.inlineContent&&t.doc.type.allowsMarkType(n):!1;return t.doc.nodesBetween(a.pos,l.pos,(d,u,p)=>{if(c)return!1;if(d.isInline){let f=!p||p.type.allowsMarkType(n),h=!!n.isInSet(d.marks)||!d.marks.some(m=>m.type.excludes(n));c=f&&h}return!c}),c})}var Ym=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let{selection:o}=n,{empty:s,ranges:a}=o,l=xe(t,r.schema);if(i)if(s){let c=jo(r,l);n.addStoredMark(l.create({...c,...e}))}else a.forEach(c=>{let d=c.$from.pos,u=c.$to.pos;r.doc.nodesBetween(d,u,(p,f)=>{let h=Math.max(f,d),m=Math.min(f+p.nodeSize,u);p.marks.find(y=>y.type===l)?p.marks.forEach(y=>{l===y.type&&n.addMark(h,m,l.create({...y.attrs,...e}))}):n.addMark(h,m,l.create(e))})});return qT(r,n,l)};var Qm=(t,e)=>({tr:n})=>(n.setMeta(t,e),!0);var Xm=(t,e={})=>({state:n,dispatch:r,chain:i})=>{let o=$(t,n.schema),s;return n.selection.$anchor.sameParent(n.selection.$head)&&(s=n.selection.$anchor.parent.attrs),o.isTextblock?i().command(({commands:a})=>nc(o,{...s,...e})(n)?!0:a.clearNodes()).command(({state:a})=>nc(o,{...s,...e})(a,r)).run():(console.warn('[tiptap warn]: Currently "setNode()" only supports text block nodes.'),!1)};var Zm=t=>({tr:e,dispatch:n})=>{if(n){let{doc:r}=e,i=ct(t,0,r.content.size),o=A.create(r,i);e.setSelection(o)}return!0};var eg=t=>({tr:e,dispatch:n})=>{if(n){let{doc:r}=e,{from:i,to:o}=typeof t=="number"?{from:t,to:t}:t,s=O.atStart(r).from,a=O.atEnd(r).to,l=ct(i,s,a),c=ct(o,s,a),d=O.create(r,l,c);e.setSelection(d)}return!0};var tg=t=>({state:e,dispatch:n})=>{let r=$(t,e.schema);return Rm(r)(e,n)};function ng(t,e){let n=t.storedMarks||t.selection.$to.parentOffset&&t.selection.$from.marks();if(n){let r=n.filter(i=>e==null?void 0:e.includes(i.type.name));t.tr.ensureMarks(r)}}var rg=({keepMarks:t=!0}={})=>({tr:e,state:n,dispatch:r,editor:i})=>{let{selection:o,doc:s}=e,{$from:a,$to:l}=o,c=i.extensionManager.attributes,d=gr(c,a.node().type.name,a.node().attrs);if(o instanceof A&&o.node.isBlock)return!a.parentOffset||!Ve(s,a.pos)?!1:(r&&(t&&ng(n,i.extensionManager.splittableMarks),e.split(a.pos).scrollIntoView()),!0);if(!a.parent.isBlock)return!1;let u=l.parentOffset===l.parent.content.size,p=a.depth===0?void 0:Um(a.node(-1).contentMatchAt(a.indexAfter(-1))),f=u&&p?[{type:p,attrs:d}]:void 0,h=Ve(e.doc,e.mapping.map(a.pos),1,f);if(!f&&!h&&Ve(e.doc,e.mapping.map(a.pos),1,p?[{type:p}]:void 0)&&(h=!0,f=p?[{type:p,attrs:d}]:void 0),r){if(h&&(o instanceof O&&e.deleteSelection(),e.split(e.mapping.map(a.pos),1,f),p&&!u&&!a.parentOffset&&a.parent.type!==p)){let m=e.mapping.map(a.before()),g=e.doc.resolve(m);a.node(-1).canReplaceWith(g.index(),g.index()+1,p)&&e.setNodeMarkup(e.mapping.map(a.before()),p)}t&&ng(n,i.extensionManager.splittableMarks),e.scrollIntoView()}return h};var ig=(t,e={})=>({tr:n,state:r,dispatch:i,editor:o})=>{var g;let s=$(t,r.schema),{$from:a,$to:l}=r.selection,c=r.selection.node;if(c&&c.isBlock||a.depth<2||!a.sameParent(l))return!1;let d=a.node(-1);if(d.type!==s)return!1;let u=o.extensionManager.attributes;if(a.parent.content.size===0&&a.node(-1).childCount===a.indexAfter(-1)){if(a.depth===2||a.node(-3).type!==s||a.index(-2)!==a.node(-2).childCount-1)return!1;if(i){let y=E.empty,x=a.index(-1)?1:a.index(-2)?2:3;for(let P=a.depth-x;P>=a.depth-3;P-=1)y=E.from(a.node(P).copy(y));let C=a.indexAfter(-1)<a.node(-2).childCount?1:a.indexAfter(-2)<a.node(-3).childCount?2:3,M={...gr(u,a.node().type.name,a.node().attrs),...e},T=((g=s.contentMatch.defaultType)==null?void 0:g.createAndFill(M))||void 0;y=y.append(E.from(s.createAndFill(null,T)||void 0));let R=a.before(a.depth-(x-1));n.replace(R,a.after(-C),new k(y,4-x,0));let S=-1;n.doc.nodesBetween(R,n.doc.content.size,(P,D)=>{if(S>-1)return!1;P.isTextblock&&P.content.size===0&&(S=D+1)}),S>-1&&n.setSelection(O.near(n.doc.resolve(S))),n.scrollIntoView()}return!0}let p=l.pos===a.end()?d.contentMatchAt(0).defaultType:null,f={...gr(u,d.type.name,d.attrs),...e},h={...gr(u,a.node().type.name,a.node().attrs),...e};n.delete(a.pos,l.pos);let m=p?[{type:s,attrs:f},{type:p,attrs:h}]:[{type:s,attrs:f}];if(!Ve(n.doc,a.pos,2))return!1;if(i){let{selection:y,storedMarks:x}=r,{splittableMarks:C}=o.extensionManager,M=x||y.$to.parentOffset&&y.$from.marks();if(n.split(a.pos,2,m).scrollIntoView(),!M||!i)return!0;let T=M.filter(R=>C.includes(R.type.name));n.ensureMarks(T)}return!0};var uc=(t,e)=>{let n=li(s=>s.type===e)(t.selection);if(!n)return!0;let r=t.doc.resolve(Math.max(0,n.pos-1)).before(n.depth);if(r===void 0)return!0;let i=t.doc.nodeAt(r);return n.node.type===(i==null?void 0:i.type)&&qe(t.doc,n.pos)&&t.join(n.pos),!0},pc=(t,e)=>{let n=li(s=>s.type===e)(t.selection);if(!n)return!0;let r=t.doc.resolve(n.start).after(n.depth);if(r===void 0)return!0;let i=t.doc.nodeAt(r);return n.node.type===(i==null?void 0:i.type)&&qe(t.doc,r)&&t.join(r),!0},og=(t,e,n,r={})=>({editor:i,tr:o,state:s,dispatch:a,chain:l,commands:c,can:d})=>{let{extensions:u,splittableMarks:p}=i.extensionManager,f=$(t,s.schema),h=$(e,s.schema),{selection:m,storedMarks:g}=s,{$from:y,$to:x}=m,C=y.blockRange(x),M=g||m.$to.parentOffset&&m.$from.marks();if(!C)return!1;let T=li(R=>dc(R.type.name,u))(m);if(C.depth>=1&&T&&C.depth-T.depth<=1){if(T.node.type===f)return c.liftListItem(h);if(dc(T.node.type.name,u)&&f.validContent(T.node.content)&&a)return l().command(()=>(o.setNodeMarkup(T.pos,f),!0)).command(()=>uc(o,f)).command(()=>pc(o,f)).run()}return!n||!M||!a?l().command(()=>d().wrapInList(f,r)?!0:c.clearNodes()).wrapInList(f,r).command(()=>uc(o,f)).command(()=>pc(o,f)).run():l().command(()=>{let R=d().wrapInList(f,r),S=M.filter(P=>p.includes(P.type.name));return o.ensureMarks(S),R?!0:c.clearNodes()}).wrapInList(f,r).command(()=>uc(o,f)).command(()=>pc(o,f)).run()};var sg=(t,e={},n={})=>({state:r,commands:i})=>{let{extendEmptyMarkRange:o=!1}=n,s=xe(t,r.schema);return cc(r,s,e)?i.unsetMark(s,{extendEmptyMarkRange:o}):i.setMark(s,e)};var ag=(t,e,n={})=>({state:r,commands:i})=>{let o=$(t,r.schema),s=$(e,r.schema),a=Nn(r,o,n),l;return r.selection.$anchor.sameParent(r.selection.$head)&&(l=r.selection.$anchor.parent.attrs),a?i.setNode(s,l):i.setNode(o,{...l,...n})};var lg=(t,e={})=>({state:n,commands:r})=>{let i=$(t,n.schema);return Nn(n,i,e)?r.lift(i):r.wrapIn(i,e)};var cg=()=>({state:t,dispatch:e})=>{let n=t.plugins;for(let r=0;r<n.length;r+=1){let i=n[r],o;if(i.spec.isInputRules&&(o=i.getState(t))){if(e){let s=t.tr,a=o.transform;for(let l=a.steps.length-1;l>=0;l-=1)s.step(a.steps[l].invert(a.docs[l]));if(o.text){let l=s.doc.resolve(o.from).marks();s.replaceWith(o.from,o.to,t.schema.text(o.text,l))}else s.delete(o.from,o.to)}return!0}}return!1};var dg=()=>({tr:t,dispatch:e})=>{let{selection:n}=t,{empty:r,ranges:i}=n;return r||e&&i.forEach(o=>{t.removeMark(o.$from.pos,o.$to.pos)}),!0};var ug=(t,e={})=>({tr:n,state:r,dispatch:i})=>{var u;let{extendEmptyMarkRange:o=!1}=e,{selection:s}=n,a=xe(t,r.schema),{$from:l,empty:c,ranges:d}=s;if(!i)return!0;if(c&&o){let{from:p,to:f}=s,h=(u=l.marks().find(g=>g.type===a))==null?void 0:u.attrs,m=fr(l,a,h);m&&(p=m.from,f=m.to),n.removeMark(p,f,a)}else d.forEach(p=>{n.removeMark(p.$from.pos,p.$to.pos,a)});return n.removeStoredMark(a),!0};var pg=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let o=null,s=null,a=In(typeof t=="string"?t:t.name,r.schema);return a?(a==="node"&&(o=$(t,r.schema)),a==="mark"&&(s=xe(t,r.schema)),i&&n.selection.ranges.forEach(l=>{let c=l.$from.pos,d=l.$to.pos,u,p,f,h;n.selection.empty?r.doc.nodesBetween(c,d,(m,g)=>{o&&o===m.type&&(f=Math.max(g,c),h=Math.min(g+m.nodeSize,d),u=g,p=m)}):r.doc.nodesBetween(c,d,(m,g)=>{g<c&&o&&o===m.type&&(f=Math.max(g,c),h=Math.min(g+m.nodeSize,d),u=g,p=m),g>=c&&g<=d&&(o&&o===m.type&&n.setNodeMarkup(g,void 0,{...m.attrs,...e}),s&&m.marks.length&&m.marks.forEach(y=>{if(s===y.type){let x=Math.max(g,c),C=Math.min(g+m.nodeSize,d);n.addMark(x,C,s.create({...y.attrs,...e}))}}))}),p&&(u!==void 0&&n.setNodeMarkup(u,void 0,{...p.attrs,...e}),s&&p.marks.length&&p.marks.forEach(m=>{s===m.type&&n.addMark(f,h,s.create({...m.attrs,...e}))}))}),!0):!1};var fg=(t,e={})=>({state:n,dispatch:r})=>{let i=$(t,n.schema);return Qh(i,e)(n,r)};var hg=(t,e={})=>({state:n,dispatch:r})=>{let i=$(t,n.schema);return wm(i,e)(n,r)};var mg=J.create({name:"commands",addCommands(){return{...fc}}});var gg=J.create({name:"drop",addProseMirrorPlugins(){return[new z({key:new K("tiptapDrop"),props:{handleDrop:(t,e,n,r)=>{this.editor.emit("drop",{editor:this.editor,event:e,slice:n,moved:r})}}})]}});var yg=J.create({name:"editable",addProseMirrorPlugins(){return[new z({key:new K("editable"),props:{editable:()=>this.editor.options.editable}})]}});var bg=new K("focusEvents"),xg=J.create({name:"focusEvents",addProseMirrorPlugins(){let{editor:t}=this;return[new z({key:bg,props:{handleDOMEvents:{focus:(e,n)=>{t.isFocused=!0;let r=t.state.tr.setMeta("focus",{event:n}).setMeta("addToHistory",!1);return e.dispatch(r),!1},blur:(e,n)=>{t.isFocused=!1;let r=t.state.tr.setMeta("blur",{event:n}).setMeta("addToHistory",!1);return e.dispatch(r),!1}}}})]}});var Cg=J.create({name:"keymap",addKeyboardShortcuts(){let t=()=>this.editor.commands.first(({commands:s})=>[()=>s.undoInputRule(),()=>s.command(({tr:a})=>{let{selection:l,doc:c}=a,{empty:d,$anchor:u}=l,{pos:p,parent:f}=u,h=u.parent.isTextblock&&p>0?a.doc.resolve(p-1):u,m=h.parent.type.spec.isolating,g=u.pos-u.parentOffset,y=m&&h.parent.childCount===1?g===u.pos:I.atStart(c).from===p;return!d||!f.type.isTextblock||f.textContent.length||!y||y&&u.parent.type.name==="paragraph"?!1:s.clearNodes()}),()=>s.deleteSelection(),()=>s.joinBackward(),()=>s.selectNodeBackward()]),e=()=>this.editor.commands.first(({commands:s})=>[()=>s.deleteSelection(),()=>s.deleteCurrentNode(),()=>s.joinForward(),()=>s.selectNodeForward()]),r={Enter:()=>this.editor.commands.first(({commands:s})=>[()=>s.newlineInCode(),()=>s.createParagraphNear(),()=>s.liftEmptyBlock(),()=>s.splitBlock()]),"Mod-Enter":()=>this.editor.commands.exitCode(),Backspace:t,"Mod-Backspace":t,"Shift-Backspace":t,Delete:e,"Mod-Delete":e,"Mod-a":()=>this.editor.commands.selectAll()},i={...r},o={...r,"Ctrl-h":t,"Alt-Backspace":t,"Ctrl-d":e,"Ctrl-Alt-Backspace":e,"Alt-Delete":e,"Alt-d":e,"Ctrl-a":()=>this.editor.commands.selectTextblockStart(),"Ctrl-e":()=>this.editor.commands.selectTextblockEnd()};return On()||_o()?o:i},addProseMirrorPlugins(){return[new z({key:new K("clearDocument"),appendTransaction:(t,e,n)=>{if(t.some(m=>m.getMeta("composition")))return;let r=t.some(m=>m.docChanged)&&!e.doc.eq(n.doc),i=t.some(m=>m.getMeta("preventClearDocument"));if(!r||i)return;let{empty:o,from:s,to:a}=e.selection,l=I.atStart(e.doc).from,c=I.atEnd(e.doc).to;if(o||!(s===l&&a===c)||!yr(n.doc))return;let p=n.tr,f=Qn({state:n,transaction:p}),{commands:h}=new pn({editor:this.editor,state:f});if(h.clearNodes(),!!p.steps.length)return p}})]}});var Eg=J.create({name:"paste",addProseMirrorPlugins(){return[new z({key:new K("tiptapPaste"),props:{handlePaste:(t,e,n)=>{this.editor.emit("paste",{editor:this.editor,event:e,slice:n})}}})]}});var vg=J.create({name:"tabindex",addProseMirrorPlugins(){return[new z({key:new K("tabindex"),props:{attributes:()=>this.editor.isEditable?{tabindex:"0"}:{}}})]}});var ee=class t{constructor(e={}){H(this,"type","node");H(this,"name","node");H(this,"parent",null);H(this,"child",null);H(this,"options");H(this,"storage");H(this,"config",{name:this.name,defaultOptions:{}});this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=_(Z(this,"addOptions",{name:this.name}))),this.storage=_(Z(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>An(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t(e);return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=_(Z(n,"addOptions",{name:n.name})),n.storage=_(Z(n,"addStorage",{name:n.name,options:n.options})),n}};function ft(t){return new lt({find:t.find,handler:({state:e,range:n,match:r})=>{let i=_(t.getAttributes,void 0,r);if(i===!1||i===null)return null;let{tr:o}=e,s=r[r.length-1],a=r[0];if(s){let l=a.search(/\S/),c=n.from+a.indexOf(s),d=c+s.length;if(mr(n.from,n.to,e.doc).filter(f=>f.mark.type.excluded.find(m=>m===t.type&&m!==f.mark.type)).filter(f=>f.to>c).length)return null;d<n.to&&o.delete(d,n.to),c>n.from&&o.delete(n.from+l,c);let p=n.from+l+s.length;o.addMark(n.from+l,p,t.type.create(i||{})),o.removeStoredMark(t.type)}}})}function Tg(t){return new lt({find:t.find,handler:({state:e,range:n,match:r})=>{let i=_(t.getAttributes,void 0,r)||{},{tr:o}=e,s=n.from,a=n.to,l=t.type.create(i);if(r[1]){let c=r[0].lastIndexOf(r[1]),d=s+c;d>a?d=a:a=d+r[1].length;let u=r[0][r[0].length-1];o.insertText(u,s+r[0].length-1),o.replaceWith(d,a,l)}else if(r[0]){let c=t.type.isInline?s:s-1;o.insert(c,t.type.create(i)).delete(o.mapping.map(s),o.mapping.map(a))}o.scrollIntoView()}})}function di(t){return new lt({find:t.find,handler:({state:e,range:n,match:r})=>{let i=e.doc.resolve(n.from),o=_(t.getAttributes,void 0,r)||{};if(!i.node(-1).canReplaceWith(i.index(-1),i.indexAfter(-1),t.type))return null;e.tr.delete(n.from,n.to).setBlockType(n.from,n.from,t.type,o)}})}function Qt(t){return new lt({find:t.find,handler:({state:e,range:n,match:r,chain:i})=>{let o=_(t.getAttributes,void 0,r)||{},s=e.tr.delete(n.from,n.to),l=s.doc.resolve(n.from).blockRange(),c=l&&tr(l,t.type,o);if(!c)return null;if(s.wrap(l,c),t.keepMarks&&t.editor){let{selection:u,storedMarks:p}=e,{splittableMarks:f}=t.editor.extensionManager,h=p||u.$to.parentOffset&&u.$from.marks();if(h){let m=h.filter(g=>f.includes(g.type.name));s.ensureMarks(m)}}if(t.keepAttributes){let u=t.type.name==="bulletList"||t.type.name==="orderedList"?"listItem":"taskList";i().updateAttributes(u,o).run()}let d=s.doc.resolve(n.from-1).nodeBefore;d&&d.type===t.type&&qe(s.doc,n.from-1)&&(!t.joinPredicate||t.joinPredicate(r,d))&&s.join(n.from-1)}})}function Xe(t){return new ur({find:t.find,handler:({state:e,range:n,match:r,pasteEvent:i})=>{let o=_(t.getAttributes,void 0,r,i);if(o===!1||o===null)return null;let{tr:s}=e,a=r[r.length-1],l=r[0],c=n.to;if(a){let d=l.search(/\S/),u=n.from+l.indexOf(a),p=u+a.length;if(mr(n.from,n.to,e.doc).filter(h=>h.mark.type.excluded.find(g=>g===t.type&&g!==h.mark.type)).filter(h=>h.to>u).length)return null;p<n.to&&s.delete(p,n.to),u>n.from&&s.delete(n.from+d,u),c=n.from+d+a.length,s.addMark(n.from+d,c,t.type.create(o||{})),s.removeStoredMark(t.type)}}})}function Sg(t,e){let{selection:n}=t,{$from:r}=n;if(n instanceof A){let o=r.index();return r.parent.canReplaceWith(o,o+1,e)}let i=r.depth;for(;i>=0;){let o=r.index(i);if(r.node(i).contentMatchAt(o).matchType(e))return!0;i-=1}return!1}var KT=/^\s*>\s$/,hc=ee.create({name:"blockquote",addOptions(){return{HTMLAttributes:{}}},content:"block+",group:"block",defining:!0,parseHTML(){return[{tag:"blockquote"}]},renderHTML({HTMLAttributes:t}){return["blockquote",U(this.options.HTMLAttributes,t),0]},addCommands(){return{setBlockquote:()=>({commands:t})=>t.wrapIn(this.name),toggleBlockquote:()=>({commands:t})=>t.toggleWrap(this.name),unsetBlockquote:()=>({commands:t})=>t.lift(this.name)}},addKeyboardShortcuts(){return{"Mod-Shift-b":()=>this.editor.commands.toggleBlockquote()}},addInputRules(){return[Qt({find:KT,type:this.type})]}});var JT=/(?:^|\s)(\*\*(?!\s+\*\*)((?:[^*]+))\*\*(?!\s+\*\*))$/,GT=/(?:^|\s)(\*\*(?!\s+\*\*)((?:[^*]+))\*\*(?!\s+\*\*))/g,YT=/(?:^|\s)(__(?!\s+__)((?:[^_]+))__(?!\s+__))$/,QT=/(?:^|\s)(__(?!\s+__)((?:[^_]+))__(?!\s+__))/g,mc=ke.create({name:"bold",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"strong"},{tag:"b",getAttrs:t=>t.style.fontWeight!=="normal"&&null},{style:"font-weight=400",clearMark:t=>t.type.name===this.name},{style:"font-weight",getAttrs:t=>/^(bold(er)?|[5-9]\d{2,})$/.test(t)&&null}]},renderHTML({HTMLAttributes:t}){return["strong",U(this.options.HTMLAttributes,t),0]},addCommands(){return{setBold:()=>({commands:t})=>t.setMark(this.name),toggleBold:()=>({commands:t})=>t.toggleMark(this.name),unsetBold:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-b":()=>this.editor.commands.toggleBold(),"Mod-B":()=>this.editor.commands.toggleBold()}},addInputRules(){return[ft({find:JT,type:this.type}),ft({find:YT,type:this.type})]},addPasteRules(){return[Xe({find:GT,type:this.type}),Xe({find:QT,type:this.type})]}});var XT="listItem",kg="textStyle",Mg=/^\s*([-+*])\s$/,gc=ee.create({name:"bulletList",addOptions(){return{itemTypeName:"listItem",HTMLAttributes:{},keepMarks:!1,keepAttributes:!1}},group:"block list",content(){return`${this.options.itemTypeName}+`},parseHTML(){return[{tag:"ul"}]},renderHTML({HTMLAttributes:t}){return["ul",U(this.options.HTMLAttributes,t),0]},addCommands(){return{toggleBulletList:()=>({commands:t,chain:e})=>this.options.keepAttributes?e().toggleList(this.name,this.options.itemTypeName,this.options.keepMarks).updateAttributes(XT,this.editor.getAttributes(kg)).run():t.toggleList(this.name,this.options.itemTypeName,this.options.keepMarks)}},addKeyboardShortcuts(){return{"Mod-Shift-8":()=>this.editor.commands.toggleBulletList()}},addInputRules(){let t=Qt({find:Mg,type:this.type});return(this.options.keepMarks||this.options.keepAttributes)&&(t=Qt({find:Mg,type:this.type,keepMarks:this.options.keepMarks,keepAttributes:this.options.keepAttributes,getAttributes:()=>this.editor.getAttributes(kg),editor:this.editor})),[t]}});var ZT=/(^|[^`])`([^`]+)`(?!`)/,eS=/(^|[^`])`([^`]+)`(?!`)/g,yc=ke.create({name:"code",addOptions(){return{HTMLAttributes:{}}},excludes:"_",code:!0,exitable:!0,parseHTML(){return[{tag:"code"}]},renderHTML({HTMLAttributes:t}){return["code",U(this.options.HTMLAttributes,t),0]},addCommands(){return{setCode:()=>({commands:t})=>t.setMark(this.name),toggleCode:()=>({commands:t})=>t.toggleMark(this.name),unsetCode:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-e":()=>this.editor.commands.toggleCode()}},addInputRules(){return[ft({find:ZT,type:this.type})]},addPasteRules(){return[Xe({find:eS,type:this.type})]}});var tS=/^```([a-z]+)?[\s\n]$/,nS=/^~~~([a-z]+)?[\s\n]$/,bc=ee.create({name:"codeBlock",addOptions(){return{languageClassPrefix:"language-",exitOnTripleEnter:!0,exitOnArrowDown:!0,defaultLanguage:null,HTMLAttributes:{}}},content:"text*",marks:"",group:"block",code:!0,defining:!0,addAttributes(){return{language:{default:this.options.defaultLanguage,parseHTML:t=>{var o;let{languageClassPrefix:e}=this.options,i=[...((o=t.firstElementChild)==null?void 0:o.classList)||[]].filter(s=>s.startsWith(e)).map(s=>s.replace(e,""))[0];return i||null},rendered:!1}}},parseHTML(){return[{tag:"pre",preserveWhitespace:"full"}]},renderHTML({node:t,HTMLAttributes:e}){return["pre",U(this.options.HTMLAttributes,e),["code",{class:t.attrs.language?this.options.languageClassPrefix+t.attrs.language:null},0]]},addCommands(){return{setCodeBlock:t=>({commands:e})=>e.setNode(this.name,t),toggleCodeBlock:t=>({commands:e})=>e.toggleNode(this.name,"paragraph",t)}},addKeyboardShortcuts(){return{"Mod-Alt-c":()=>this.editor.commands.toggleCodeBlock(),Backspace:()=>{let{empty:t,$anchor:e}=this.editor.state.selection,n=e.pos===1;return!t||e.parent.type.name!==this.name?!1:n||!e.parent.textContent.length?this.editor.commands.clearNodes():!1},Enter:({editor:t})=>{if(!this.options.exitOnTripleEnter)return!1;let{state:e}=t,{selection:n}=e,{$from:r,empty:i}=n;if(!i||r.parent.type!==this.type)return!1;let o=r.parentOffset===r.parent.nodeSize-2,s=r.parent.textContent.endsWith(`
+
+`);return!o||!s?!1:t.chain().command(({tr:a})=>(a.delete(r.pos-2,r.pos),!0)).exitCode().run()},ArrowDown:({editor:t})=>{if(!this.options.exitOnArrowDown)return!1;let{state:e}=t,{selection:n,doc:r}=e,{$from:i,empty:o}=n;if(!o||i.parent.type!==this.type||!(i.parentOffset===i.parent.nodeSize-2))return!1;let a=i.after();return a===void 0?!1:r.nodeAt(a)?t.commands.command(({tr:c})=>(c.setSelection(I.near(r.resolve(a))),!0)):t.commands.exitCode()}}},addInputRules(){return[di({find:tS,type:this.type,getAttributes:t=>({language:t[1]})}),di({find:nS,type:this.type,getAttributes:t=>({language:t[1]})})]},addProseMirrorPlugins(){return[new z({key:new K("codeBlockVSCodeHandler"),props:{handlePaste:(t,e)=>{if(!e.clipboardData||this.editor.isActive(this.type.name))return!1;let n=e.clipboardData.getData("text/plain"),r=e.clipboardData.getData("vscode-editor-data"),i=r?JSON.parse(r):void 0,o=i==null?void 0:i.mode;if(!n||!o)return!1;let{tr:s,schema:a}=t.state,l=a.text(n.replace(/\r\n?/g,`
+`));return s.replaceSelectionWith(this.type.create({language:o},l)),s.selection.$from.parent.type!==this.type&&s.setSelection(O.near(s.doc.resolve(Math.max(0,s.selection.from-2)))),s.setMeta("paste",!0),t.dispatch(s),!0}}})]}});var xc=ee.create({name:"doc",topNode:!0,content:"block+"});function wg(t={}){return new z({view(e){return new Cc(e,t)}})}var Cc=class{constructor(e,n){var r;this.editorView=e,this.cursorPos=null,this.element=null,this.timeout=-1,this.width=(r=n.width)!==null&&r!==void 0?r:1,this.color=n.color===!1?void 0:n.color||"black",this.class=n.class,this.handlers=["dragover","dragend","drop","dragleave"].map(i=>{let o=s=>{this[i](s)};return e.dom.addEventListener(i,o),{name:i,handler:o}})}destroy(){this.handlers.forEach(({name:e,handler:n})=>this.editorView.dom.removeEventListener(e,n))}update(e,n){this.cursorPos!=null&&n.doc!=e.state.doc&&(this.cursorPos>e.state.doc.content.size?this.setCursor(null):this.updateOverlay())}setCursor(e){e!=this.cursorPos&&(this.cursorPos=e,e==null?(this.element.parentNode.removeChild(this.element),this.element=null):this.updateOverlay())}updateOverlay(){let e=this.editorView.state.doc.resolve(this.cursorPos),n=!e.parent.inlineContent,r,i=this.editorView.dom,o=i.getBoundingClientRect(),s=o.width/i.offsetWidth,a=o.height/i.offsetHeight;if(n){let u=e.nodeBefore,p=e.nodeAfter;if(u||p){let f=this.editorView.nodeDOM(this.cursorPos-(u?u.nodeSize:0));if(f){let h=f.getBoundingClientRect(),m=u?h.bottom:h.top;u&&p&&(m=(m+this.editorView.nodeDOM(this.cursorPos).getBoundingClientRect().top)/2);let g=this.width/2*a;r={left:h.left,right:h.right,top:m-g,bottom:m+g}}}}if(!r){let u=this.editorView.coordsAtPos(this.cursorPos),p=this.width/2*s;r={left:u.left-p,right:u.left+p,top:u.top,bottom:u.bottom}}let l=this.editorView.dom.offsetParent;this.element||(this.element=l.appendChild(document.createElement("div")),this.class&&(this.element.className=this.class),this.element.style.cssText="position: absolute; z-index: 50; pointer-events: none;",this.color&&(this.element.style.backgroundColor=this.color)),this.element.classList.toggle("prosemirror-dropcursor-block",n),this.element.classList.toggle("prosemirror-dropcursor-inline",!n);let c,d;if(!l||l==document.body&&getComputedStyle(l).position=="static")c=-pageXOffset,d=-pageYOffset;else{let u=l.getBoundingClientRect(),p=u.width/l.offsetWidth,f=u.height/l.offsetHeight;c=u.left-l.scrollLeft*p,d=u.top-l.scrollTop*f}this.element.style.left=(r.left-c)/s+"px",this.element.style.top=(r.top-d)/a+"px",this.element.style.width=(r.right-r.left)/s+"px",this.element.style.height=(r.bottom-r.top)/a+"px"}scheduleRemoval(e){clearTimeout(this.timeout),this.timeout=setTimeout(()=>this.setCursor(null),e)}dragover(e){if(!this.editorView.editable)return;let n=this.editorView.posAtCoords({left:e.clientX,top:e.clientY}),r=n&&n.inside>=0&&this.editorView.state.doc.nodeAt(n.inside),i=r&&r.type.spec.disableDropCursor,o=typeof i=="function"?i(this.editorView,n,e):i;if(n&&!o){let s=n.pos;if(this.editorView.dragging&&this.editorView.dragging.slice){let a=Eo(this.editorView.state.doc,s,this.editorView.dragging.slice);a!=null&&(s=a)}this.setCursor(s),this.scheduleRemoval(5e3)}}dragend(){this.scheduleRemoval(20)}drop(){this.scheduleRemoval(20)}dragleave(e){this.editorView.dom.contains(e.relatedTarget)||this.setCursor(null)}};var Ec=J.create({name:"dropCursor",addOptions(){return{color:"currentColor",width:1,class:void 0}},addProseMirrorPlugins(){return[wg(this.options)]}});var Ce=class t extends I{constructor(e){super(e,e)}map(e,n){let r=e.resolve(n.map(this.head));return t.valid(r)?new t(r):I.near(r)}content(){return k.empty}eq(e){return e instanceof t&&e.head==this.head}toJSON(){return{type:"gapcursor",pos:this.head}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for GapCursor.fromJSON");return new t(e.resolve(n.pos))}getBookmark(){return new vc(this.anchor)}static valid(e){let n=e.parent;if(n.isTextblock||!rS(e)||!iS(e))return!1;let r=n.type.spec.allowGapCursor;if(r!=null)return r;let i=n.contentMatchAt(e.index()).defaultType;return i&&i.isTextblock}static findGapCursorFrom(e,n,r=!1){e:for(;;){if(!r&&t.valid(e))return e;let i=e.pos,o=null;for(let s=e.depth;;s--){let a=e.node(s);if(n>0?e.indexAfter(s)<a.childCount:e.index(s)>0){o=a.child(n>0?e.indexAfter(s):e.index(s)-1);break}else if(s==0)return null;i+=n;let l=e.doc.resolve(i);if(t.valid(l))return l}for(;;){let s=n>0?o.firstChild:o.lastChild;if(!s){if(o.isAtom&&!o.isText&&!A.isSelectable(o)){e=e.doc.resolve(i+o.nodeSize*n),r=!1;continue e}break}o=s,i+=n;let a=e.doc.resolve(i);if(t.valid(a))return a}return null}}};Ce.prototype.visible=!1;Ce.findFrom=Ce.findGapCursorFrom;I.jsonID("gapcursor",Ce);var vc=class t{constructor(e){this.pos=e}map(e){return new t(e.map(this.pos))}resolve(e){let n=e.resolve(this.pos);return Ce.valid(n)?new Ce(n):I.near(n)}};function Ag(t){return t.isAtom||t.spec.isolating||t.spec.createGapCursor}function rS(t){for(let e=t.depth;e>=0;e--){let n=t.index(e),r=t.node(e);if(n==0){if(r.type.spec.isolating)return!0;continue}for(let i=r.child(n-1);;i=i.lastChild){if(i.childCount==0&&!i.inlineContent||Ag(i.type))return!0;if(i.inlineContent)return!1}}return!0}function iS(t){for(let e=t.depth;e>=0;e--){let n=t.indexAfter(e),r=t.node(e);if(n==r.childCount){if(r.type.spec.isolating)return!0;continue}for(let i=r.child(n);;i=i.firstChild){if(i.childCount==0&&!i.inlineContent||Ag(i.type))return!0;if(i.inlineContent)return!1}}return!0}function Rg(){return new z({props:{decorations:lS,createSelectionBetween(t,e,n){return e.pos==n.pos&&Ce.valid(n)?new Ce(n):null},handleClick:sS,handleKeyDown:oS,handleDOMEvents:{beforeinput:aS}}})}var oS=Ih({ArrowLeft:Wo("horiz",-1),ArrowRight:Wo("horiz",1),ArrowUp:Wo("vert",-1),ArrowDown:Wo("vert",1)});function Wo(t,e){let n=t=="vert"?e>0?"down":"up":e>0?"right":"left";return function(r,i,o){let s=r.selection,a=e>0?s.$to:s.$from,l=s.empty;if(s instanceof O){if(!o.endOfTextblock(n)||a.depth==0)return!1;l=!1,a=r.doc.resolve(e>0?a.after():a.before())}let c=Ce.findGapCursorFrom(a,e,l);return c?(i&&i(r.tr.setSelection(new Ce(c))),!0):!1}}function sS(t,e,n){if(!t||!t.editable)return!1;let r=t.state.doc.resolve(e);if(!Ce.valid(r))return!1;let i=t.posAtCoords({left:n.clientX,top:n.clientY});return i&&i.inside>-1&&A.isSelectable(t.state.doc.nodeAt(i.inside))?!1:(t.dispatch(t.state.tr.setSelection(new Ce(r))),!0)}function aS(t,e){if(e.inputType!="insertCompositionText"||!(t.state.selection instanceof Ce))return!1;let{$from:n}=t.state.selection,r=n.parent.contentMatchAt(n.index()).findWrapping(t.state.schema.nodes.text);if(!r)return!1;let i=E.empty;for(let s=r.length-1;s>=0;s--)i=E.from(r[s].createAndFill(null,i));let o=t.state.tr.replace(n.pos,n.pos,new k(i,0,0));return o.setSelection(O.near(o.doc.resolve(n.pos+1))),t.dispatch(o),!1}function lS(t){if(!(t.selection instanceof Ce))return null;let e=document.createElement("div");return e.className="ProseMirror-gapcursor",be.create(t.doc,[Qe.widget(t.selection.head,e,{key:"gapcursor"})])}var Tc=J.create({name:"gapCursor",addProseMirrorPlugins(){return[Rg()]},extendNodeSchema(t){var n;let e={name:t.name,options:t.options,storage:t.storage};return{allowGapCursor:(n=_(Z(t,"allowGapCursor",e)))!=null?n:null}}});var Sc=ee.create({name:"hardBreak",addOptions(){return{keepMarks:!0,HTMLAttributes:{}}},inline:!0,group:"inline",selectable:!1,linebreakReplacement:!0,parseHTML(){return[{tag:"br"}]},renderHTML({HTMLAttributes:t}){return["br",U(this.options.HTMLAttributes,t)]},renderText(){return`
+`},addCommands(){return{setHardBreak:()=>({commands:t,chain:e,state:n,editor:r})=>t.first([()=>t.exitCode(),()=>t.command(()=>{let{selection:i,storedMarks:o}=n;if(i.$from.parent.type.spec.isolating)return!1;let{keepMarks:s}=this.options,{splittableMarks:a}=r.extensionManager,l=o||i.$to.parentOffset&&i.$from.marks();return e().insertContent({type:this.name}).command(({tr:c,dispatch:d})=>{if(d&&l&&s){let u=l.filter(p=>a.includes(p.type.name));c.ensureMarks(u)}return!0}).run()})])}},addKeyboardShortcuts(){return{"Mod-Enter":()=>this.editor.commands.setHardBreak(),"Shift-Enter":()=>this.editor.commands.setHardBreak()}}});var kc=ee.create({name:"heading",addOptions(){return{levels:[1,2,3,4,5,6],HTMLAttributes:{}}},content:"inline*",group:"block",defining:!0,addAttributes(){return{level:{default:1,rendered:!1}}},parseHTML(){return this.options.levels.map(t=>({tag:`h${t}`,attrs:{level:t}}))},renderHTML({node:t,HTMLAttributes:e}){return[`h${this.options.levels.includes(t.attrs.level)?t.attrs.level:this.options.levels[0]}`,U(this.options.HTMLAttributes,e),0]},addCommands(){return{setHeading:t=>({commands:e})=>this.options.levels.includes(t.level)?e.setNode(this.name,t):!1,toggleHeading:t=>({commands:e})=>this.options.levels.includes(t.level)?e.toggleNode(this.name,"paragraph",t):!1}},addKeyboardShortcuts(){return this.options.levels.reduce((t,e)=>({...t,[`Mod-Alt-${e}`]:()=>this.editor.commands.toggleHeading({level:e})}),{})},addInputRules(){return this.options.levels.map(t=>di({find:new RegExp(`^(#{${Math.min(...this.options.levels)},${t}})\\s$`),type:this.type,getAttributes:{level:t}}))}});var qo=200,pe=function(){};pe.prototype.append=function(e){return e.length?(e=pe.from(e),!this.length&&e||e.length<qo&&this.leafAppend(e)||this.length<qo&&e.leafPrepend(this)||this.appendInner(e)):this};pe.prototype.prepend=function(e){return e.length?pe.from(e).append(this):this};pe.prototype.appendInner=function(e){return new cS(this,e)};pe.prototype.slice=function(e,n){return e===void 0&&(e=0),n===void 0&&(n=this.length),e>=n?pe.empty:this.sliceInner(Math.max(0,e),Math.min(this.length,n))};pe.prototype.get=function(e){if(!(e<0||e>=this.length))return this.getInner(e)};pe.prototype.forEach=function(e,n,r){n===void 0&&(n=0),r===void 0&&(r=this.length),n<=r?this.forEachInner(e,n,r,0):this.forEachInvertedInner(e,n,r,0)};pe.prototype.map=function(e,n,r){n===void 0&&(n=0),r===void 0&&(r=this.length);var i=[];return this.forEach(function(o,s){return i.push(e(o,s))},n,r),i};pe.from=function(e){return e instanceof pe?e:e&&e.length?new Og(e):pe.empty};var Og=function(t){function e(r){t.call(this),this.values=r}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e;var n={length:{configurable:!0},depth:{configurable:!0}};return e.prototype.flatten=function(){return this.values},e.prototype.sliceInner=function(i,o){return i==0&&o==this.length?this:new e(this.values.slice(i,o))},e.prototype.getInner=function(i){return this.values[i]},e.prototype.forEachInner=function(i,o,s,a){for(var l=o;l<s;l++)if(i(this.values[l],a+l)===!1)return!1},e.prototype.forEachInvertedInner=function(i,o,s,a){for(var l=o-1;l>=s;l--)if(i(this.values[l],a+l)===!1)return!1},e.prototype.leafAppend=function(i){if(this.length+i.length<=qo)return new e(this.values.concat(i.flatten()))},e.prototype.leafPrepend=function(i){if(this.length+i.length<=qo)return new e(i.flatten().concat(this.values))},n.length.get=function(){return this.values.length},n.depth.get=function(){return 0},Object.defineProperties(e.prototype,n),e}(pe);pe.empty=new Og([]);var cS=function(t){function e(n,r){t.call(this),this.left=n,this.right=r,this.length=n.length+r.length,this.depth=Math.max(n.depth,r.depth)+1}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.flatten=function(){return this.left.flatten().concat(this.right.flatten())},e.prototype.getInner=function(r){return r<this.left.length?this.left.get(r):this.right.get(r-this.left.length)},e.prototype.forEachInner=function(r,i,o,s){var a=this.left.length;if(i<a&&this.left.forEachInner(r,i,Math.min(o,a),s)===!1||o>a&&this.right.forEachInner(r,Math.max(i-a,0),Math.min(this.length,o)-a,s+a)===!1)return!1},e.prototype.forEachInvertedInner=function(r,i,o,s){var a=this.left.length;if(i>a&&this.right.forEachInvertedInner(r,i-a,Math.max(o,a)-a,s+a)===!1||o<a&&this.left.forEachInvertedInner(r,Math.min(i,a),o,s)===!1)return!1},e.prototype.sliceInner=function(r,i){if(r==0&&i==this.length)return this;var o=this.left.length;return i<=o?this.left.slice(r,i):r>=o?this.right.slice(r-o,i-o):this.left.slice(r,o).append(this.right.slice(0,i-o))},e.prototype.leafAppend=function(r){var i=this.right.leafAppend(r);if(i)return new e(this.left,i)},e.prototype.leafPrepend=function(r){var i=this.left.leafPrepend(r);if(i)return new e(i,this.right)},e.prototype.appendInner=function(r){return this.left.depth>=Math.max(this.right.depth,r.depth)+1?new e(this.left,new e(this.right,r)):new e(this,r)},e}(pe),Mc=pe;var dS=500,Dn=class t{constructor(e,n){this.items=e,this.eventCount=n}popEvent(e,n){if(this.eventCount==0)return null;let r=this.items.length;for(;;r--)if(this.items.get(r-1).selection){--r;break}let i,o;n&&(i=this.remapping(r,this.items.length),o=i.maps.length);let s=e.tr,a,l,c=[],d=[];return this.items.forEach((u,p)=>{if(!u.step){i||(i=this.remapping(r,p+1),o=i.maps.length),o--,d.push(u);return}if(i){d.push(new ht(u.map));let f=u.step.map(i.slice(o)),h;f&&s.maybeStep(f).doc&&(h=s.mapping.maps[s.mapping.maps.length-1],c.push(new ht(h,void 0,void 0,c.length+d.length))),o--,h&&i.appendMap(h,o)}else s.maybeStep(u.step);if(u.selection)return a=i?u.selection.map(i.slice(o)):u.selection,l=new t(this.items.slice(0,r).append(d.reverse().concat(c)),this.eventCount-1),!1},this.items.length,0),{remaining:l,transform:s,selection:a}}addTransform(e,n,r,i){let o=[],s=this.eventCount,a=this.items,l=!i&&a.length?a.get(a.length-1):null;for(let d=0;d<e.steps.length;d++){let u=e.steps[d].invert(e.docs[d]),p=new ht(e.mapping.maps[d],u,n),f;(f=l&&l.merge(p))&&(p=f,d?o.pop():a=a.slice(0,a.length-1)),o.push(p),n&&(s++,n=void 0),i||(l=p)}let c=s-r.depth;return c>pS&&(a=uS(a,c),s-=c),new t(a.append(o),s)}remapping(e,n){let r=new Kr;return this.items.forEach((i,o)=>{let s=i.mirrorOffset!=null&&o-i.mirrorOffset>=e?r.maps.length-i.mirrorOffset:void 0;r.appendMap(i.map,s)},e,n),r}addMaps(e){return this.eventCount==0?this:new t(this.items.append(e.map(n=>new ht(n))),this.eventCount)}rebased(e,n){if(!this.eventCount)return this;let r=[],i=Math.max(0,this.items.length-n),o=e.mapping,s=e.steps.length,a=this.eventCount;this.items.forEach(p=>{p.selection&&a--},i);let l=n;this.items.forEach(p=>{let f=o.getMirror(--l);if(f==null)return;s=Math.min(s,f);let h=o.maps[f];if(p.step){let m=e.steps[f].invert(e.docs[f]),g=p.selection&&p.selection.map(o.slice(l+1,f));g&&a++,r.push(new ht(h,m,g))}else r.push(new ht(h))},i);let c=[];for(let p=n;p<s;p++)c.push(new ht(o.maps[p]));let d=this.items.slice(0,i).append(c).append(r),u=new t(d,a);return u.emptyItemCount()>dS&&(u=u.compress(this.items.length-r.length)),u}emptyItemCount(){let e=0;return this.items.forEach(n=>{n.step||e++}),e}compress(e=this.items.length){let n=this.remapping(0,e),r=n.maps.length,i=[],o=0;return this.items.forEach((s,a)=>{if(a>=e)i.push(s),s.selection&&o++;else if(s.step){let l=s.step.map(n.slice(r)),c=l&&l.getMap();if(r--,c&&n.appendMap(c,r),l){let d=s.selection&&s.selection.map(n.slice(r));d&&o++;let u=new ht(c.invert(),l,d),p,f=i.length-1;(p=i.length&&i[f].merge(u))?i[f]=p:i.push(u)}}else s.map&&r--},this.items.length,0),new t(Mc.from(i.reverse()),o)}};Dn.empty=new Dn(Mc.empty,0);function uS(t,e){let n;return t.forEach((r,i)=>{if(r.selection&&e--==0)return n=i,!1}),t.slice(n)}var ht=class t{constructor(e,n,r,i){this.map=e,this.step=n,this.selection=r,this.mirrorOffset=i}merge(e){if(this.step&&e.step&&!e.selection){let n=e.step.merge(this.step);if(n)return new t(n.getMap().invert(),n,this.selection)}}},mt=class{constructor(e,n,r,i,o){this.done=e,this.undone=n,this.prevRanges=r,this.prevTime=i,this.prevComposition=o}},pS=20;function fS(t,e,n,r){let i=n.getMeta(Pn),o;if(i)return i.historyState;n.getMeta(gS)&&(t=new mt(t.done,t.undone,null,0,-1));let s=n.getMeta("appendedTransaction");if(n.steps.length==0)return t;if(s&&s.getMeta(Pn))return s.getMeta(Pn).redo?new mt(t.done.addTransform(n,void 0,r,Ko(e)),t.undone,Lg(n.mapping.maps),t.prevTime,t.prevComposition):new mt(t.done,t.undone.addTransform(n,void 0,r,Ko(e)),null,t.prevTime,t.prevComposition);if(n.getMeta("addToHistory")!==!1&&!(s&&s.getMeta("addToHistory")===!1)){let a=n.getMeta("composition"),l=t.prevTime==0||!s&&t.prevComposition!=a&&(t.prevTime<(n.time||0)-r.newGroupDelay||!hS(n,t.prevRanges)),c=s?wc(t.prevRanges,n.mapping):Lg(n.mapping.maps);return new mt(t.done.addTransform(n,l?e.selection.getBookmark():void 0,r,Ko(e)),Dn.empty,c,n.time,a==null?t.prevComposition:a)}else return(o=n.getMeta("rebased"))?new mt(t.done.rebased(n,o),t.undone.rebased(n,o),wc(t.prevRanges,n.mapping),t.prevTime,t.prevComposition):new mt(t.done.addMaps(n.mapping.maps),t.undone.addMaps(n.mapping.maps),wc(t.prevRanges,n.mapping),t.prevTime,t.prevComposition)}function hS(t,e){if(!e)return!1;if(!t.docChanged)return!0;let n=!1;return t.mapping.maps[0].forEach((r,i)=>{for(let o=0;o<e.length;o+=2)r<=e[o+1]&&i>=e[o]&&(n=!0)}),n}function Lg(t){let e=[];for(let n=t.length-1;n>=0&&e.length==0;n--)t[n].forEach((r,i,o,s)=>e.push(o,s));return e}function wc(t,e){if(!t)return null;let n=[];for(let r=0;r<t.length;r+=2){let i=e.map(t[r],1),o=e.map(t[r+1],-1);i<=o&&n.push(i,o)}return n}function mS(t,e,n){let r=Ko(e),i=Pn.get(e).spec.config,o=(n?t.undone:t.done).popEvent(e,r);if(!o)return null;let s=o.selection.resolve(o.transform.doc),a=(n?t.done:t.undone).addTransform(o.transform,e.selection.getBookmark(),i,r),l=new mt(n?a:o.remaining,n?o.remaining:a,null,0,-1);return o.transform.setSelection(s).setMeta(Pn,{redo:n,historyState:l})}var Ac=!1,Ng=null;function Ko(t){let e=t.plugins;if(Ng!=e){Ac=!1,Ng=e;for(let n=0;n<e.length;n++)if(e[n].spec.historyPreserveItems){Ac=!0;break}}return Ac}var Pn=new K("history"),gS=new K("closeHistory");function Ig(t={}){return t={depth:t.depth||100,newGroupDelay:t.newGroupDelay||500},new z({key:Pn,state:{init(){return new mt(Dn.empty,Dn.empty,null,0,-1)},apply(e,n,r){return fS(n,r,e,t)}},config:t,props:{handleDOMEvents:{beforeinput(e,n){let r=n.inputType,i=r=="historyUndo"?Rc:r=="historyRedo"?Oc:null;return i?(n.preventDefault(),i(e.state,e.dispatch)):!1}}}})}function Jo(t,e){return(n,r)=>{let i=Pn.getState(n);if(!i||(t?i.undone:i.done).eventCount==0)return!1;if(r){let o=mS(i,n,t);o&&r(e?o.scrollIntoView():o)}return!0}}var Rc=Jo(!1,!0),Oc=Jo(!0,!0),f2=Jo(!1,!1),h2=Jo(!0,!1);var Lc=J.create({name:"history",addOptions(){return{depth:100,newGroupDelay:500}},addCommands(){return{undo:()=>({state:t,dispatch:e})=>Rc(t,e),redo:()=>({state:t,dispatch:e})=>Oc(t,e)}},addProseMirrorPlugins(){return[Ig(this.options)]},addKeyboardShortcuts(){return{"Mod-z":()=>this.editor.commands.undo(),"Shift-Mod-z":()=>this.editor.commands.redo(),"Mod-y":()=>this.editor.commands.redo(),"Mod-\u044F":()=>this.editor.commands.undo(),"Shift-Mod-\u044F":()=>this.editor.commands.redo()}}});var Nc=ee.create({name:"horizontalRule",addOptions(){return{HTMLAttributes:{}}},group:"block",parseHTML(){return[{tag:"hr"}]},renderHTML({HTMLAttributes:t}){return["hr",U(this.options.HTMLAttributes,t)]},addCommands(){return{setHorizontalRule:()=>({chain:t,state:e})=>{if(!Sg(e,e.schema.nodes[this.name]))return!1;let{selection:n}=e,{$from:r,$to:i}=n,o=t();return r.parentOffset===0?o.insertContentAt({from:Math.max(r.pos-1,0),to:i.pos},{type:this.name}):Gm(n)?o.insertContentAt(i.pos,{type:this.name}):o.insertContent({type:this.name}),o.command(({tr:s,dispatch:a})=>{var l;if(a){let{$to:c}=s.selection,d=c.end();if(c.nodeAfter)c.nodeAfter.isTextblock?s.setSelection(O.create(s.doc,c.pos+1)):c.nodeAfter.isBlock?s.setSelection(A.create(s.doc,c.pos)):s.setSelection(O.create(s.doc,c.pos));else{let u=(l=c.parent.type.contentMatch.defaultType)==null?void 0:l.create();u&&(s.insert(d,u),s.setSelection(O.create(s.doc,d+1)))}s.scrollIntoView()}return!0}).run()}}},addInputRules(){return[Tg({find:/^(?:---|—-|___\s|\*\*\*\s)$/,type:this.type})]}});var yS=/(?:^|\s)(\*(?!\s+\*)((?:[^*]+))\*(?!\s+\*))$/,bS=/(?:^|\s)(\*(?!\s+\*)((?:[^*]+))\*(?!\s+\*))/g,xS=/(?:^|\s)(_(?!\s+_)((?:[^_]+))_(?!\s+_))$/,CS=/(?:^|\s)(_(?!\s+_)((?:[^_]+))_(?!\s+_))/g,Ic=ke.create({name:"italic",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"em"},{tag:"i",getAttrs:t=>t.style.fontStyle!=="normal"&&null},{style:"font-style=normal",clearMark:t=>t.type.name===this.name},{style:"font-style=italic"}]},renderHTML({HTMLAttributes:t}){return["em",U(this.options.HTMLAttributes,t),0]},addCommands(){return{setItalic:()=>({commands:t})=>t.setMark(this.name),toggleItalic:()=>({commands:t})=>t.toggleMark(this.name),unsetItalic:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-i":()=>this.editor.commands.toggleItalic(),"Mod-I":()=>this.editor.commands.toggleItalic()}},addInputRules(){return[ft({find:yS,type:this.type}),ft({find:xS,type:this.type})]},addPasteRules(){return[Xe({find:bS,type:this.type}),Xe({find:CS,type:this.type})]}});var Pc=ee.create({name:"listItem",addOptions(){return{HTMLAttributes:{},bulletListTypeName:"bulletList",orderedListTypeName:"orderedList"}},content:"paragraph block*",defining:!0,parseHTML(){return[{tag:"li"}]},renderHTML({HTMLAttributes:t}){return["li",U(this.options.HTMLAttributes,t),0]},addKeyboardShortcuts(){return{Enter:()=>this.editor.commands.splitListItem(this.name),Tab:()=>this.editor.commands.sinkListItem(this.name),"Shift-Tab":()=>this.editor.commands.liftListItem(this.name)}}});var ES="listItem",Pg="textStyle",Dg=/^(\d+)\.\s$/,Dc=ee.create({name:"orderedList",addOptions(){return{itemTypeName:"listItem",HTMLAttributes:{},keepMarks:!1,keepAttributes:!1}},group:"block list",content(){return`${this.options.itemTypeName}+`},addAttributes(){return{start:{default:1,parseHTML:t=>t.hasAttribute("start")?parseInt(t.getAttribute("start")||"",10):1},type:{default:null,parseHTML:t=>t.getAttribute("type")}}},parseHTML(){return[{tag:"ol"}]},renderHTML({HTMLAttributes:t}){let{start:e,...n}=t;return e===1?["ol",U(this.options.HTMLAttributes,n),0]:["ol",U(this.options.HTMLAttributes,t),0]},addCommands(){return{toggleOrderedList:()=>({commands:t,chain:e})=>this.options.keepAttributes?e().toggleList(this.name,this.options.itemTypeName,this.options.keepMarks).updateAttributes(ES,this.editor.getAttributes(Pg)).run():t.toggleList(this.name,this.options.itemTypeName,this.options.keepMarks)}},addKeyboardShortcuts(){return{"Mod-Shift-7":()=>this.editor.commands.toggleOrderedList()}},addInputRules(){let t=Qt({find:Dg,type:this.type,getAttributes:e=>({start:+e[1]}),joinPredicate:(e,n)=>n.childCount+n.attrs.start===+e[1]});return(this.options.keepMarks||this.options.keepAttributes)&&(t=Qt({find:Dg,type:this.type,keepMarks:this.options.keepMarks,keepAttributes:this.options.keepAttributes,getAttributes:e=>({start:+e[1],...this.editor.getAttributes(Pg)}),joinPredicate:(e,n)=>n.childCount+n.attrs.start===+e[1],editor:this.editor})),[t]}});var Hc=ee.create({name:"paragraph",priority:1e3,addOptions(){return{HTMLAttributes:{}}},group:"block",content:"inline*",parseHTML(){return[{tag:"p"}]},renderHTML({HTMLAttributes:t}){return["p",U(this.options.HTMLAttributes,t),0]},addCommands(){return{setParagraph:()=>({commands:t})=>t.setNode(this.name)}},addKeyboardShortcuts(){return{"Mod-Alt-0":()=>this.editor.commands.setParagraph()}}});var vS=/(?:^|\s)(~~(?!\s+~~)((?:[^~]+))~~(?!\s+~~))$/,TS=/(?:^|\s)(~~(?!\s+~~)((?:[^~]+))~~(?!\s+~~))/g,Fc=ke.create({name:"strike",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"s"},{tag:"del"},{tag:"strike"},{style:"text-decoration",consuming:!1,getAttrs:t=>t.includes("line-through")?{}:!1}]},renderHTML({HTMLAttributes:t}){return["s",U(this.options.HTMLAttributes,t),0]},addCommands(){return{setStrike:()=>({commands:t})=>t.setMark(this.name),toggleStrike:()=>({commands:t})=>t.toggleMark(this.name),unsetStrike:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-Shift-s":()=>this.editor.commands.toggleStrike()}},addInputRules(){return[ft({find:vS,type:this.type})]},addPasteRules(){return[Xe({find:TS,type:this.type})]}});var Bc=ee.create({name:"text",group:"inline"});var Hg=J.create({name:"starterKit",addExtensions(){let t=[];return this.options.bold!==!1&&t.push(mc.configure(this.options.bold)),this.options.blockquote!==!1&&t.push(hc.configure(this.options.blockquote)),this.options.bulletList!==!1&&t.push(gc.configure(this.options.bulletList)),this.options.code!==!1&&t.push(yc.configure(this.options.code)),this.options.codeBlock!==!1&&t.push(bc.configure(this.options.codeBlock)),this.options.document!==!1&&t.push(xc.configure(this.options.document)),this.options.dropcursor!==!1&&t.push(Ec.configure(this.options.dropcursor)),this.options.gapcursor!==!1&&t.push(Tc.configure(this.options.gapcursor)),this.options.hardBreak!==!1&&t.push(Sc.configure(this.options.hardBreak)),this.options.heading!==!1&&t.push(kc.configure(this.options.heading)),this.options.history!==!1&&t.push(Lc.configure(this.options.history)),this.options.horizontalRule!==!1&&t.push(Nc.configure(this.options.horizontalRule)),this.options.italic!==!1&&t.push(Ic.configure(this.options.italic)),this.options.listItem!==!1&&t.push(Pc.configure(this.options.listItem)),this.options.orderedList!==!1&&t.push(Dc.configure(this.options.orderedList)),this.options.paragraph!==!1&&t.push(Hc.configure(this.options.paragraph)),this.options.strike!==!1&&t.push(Fc.configure(this.options.strike)),this.options.text!==!1&&t.push(Bc.configure(this.options.text)),t}});var Fg=J.create({name:"placeholder",addOptions(){return{emptyEditorClass:"is-editor-empty",emptyNodeClass:"is-empty",placeholder:"Write something \u2026",showOnlyWhenEditable:!0,showOnlyCurrent:!0,includeChildren:!1}},addProseMirrorPlugins(){return[new z({key:new K("placeholder"),props:{decorations:({doc:t,selection:e})=>{let n=this.editor.isEditable||!this.options.showOnlyWhenEditable,{anchor:r}=e,i=[];if(!n)return null;let o=this.editor.isEmpty;return t.descendants((s,a)=>{let l=r>=a&&r<=a+s.nodeSize,c=!s.isLeaf&&yr(s);if((l||!this.options.showOnlyCurrent)&&c){let d=[this.options.emptyNodeClass];o&&d.push(this.options.emptyEditorClass);let u=Qe.node(a,a+s.nodeSize,{class:d.join(" "),"data-placeholder":typeof this.options.placeholder=="function"?this.options.placeholder({editor:this.editor,node:s,pos:a,hasAnchor:l}):this.options.placeholder});i.push(u)}return this.options.includeChildren}),be.create(t,i)}}})]}});var SS="aaa1rp3bb0ott3vie4c1le2ogado5udhabi7c0ademy5centure6ountant0s9o1tor4d0s1ult4e0g1ro2tna4f0l1rica5g0akhan5ency5i0g1rbus3force5tel5kdn3l0ibaba4pay4lfinanz6state5y2sace3tom5m0azon4ericanexpress7family11x2fam3ica3sterdam8nalytics7droid5quan4z2o0l2partments8p0le4q0uarelle8r0ab1mco4chi3my2pa2t0e3s0da2ia2sociates9t0hleta5torney7u0ction5di0ble3o3spost5thor3o0s4w0s2x0a2z0ure5ba0by2idu3namex4d1k2r0celona5laycard4s5efoot5gains6seball5ketball8uhaus5yern5b0c1t1va3cg1n2d1e0ats2uty4er2rlin4st0buy5t2f1g1h0arti5i0ble3d1ke2ng0o3o1z2j1lack0friday9ockbuster8g1omberg7ue3m0s1w2n0pparibas9o0ats3ehringer8fa2m1nd2o0k0ing5sch2tik2on4t1utique6x2r0adesco6idgestone9oadway5ker3ther5ussels7s1t1uild0ers6siness6y1zz3v1w1y1z0h3ca0b1fe2l0l1vinklein9m0era3p2non3petown5ital0one8r0avan4ds2e0er0s4s2sa1e1h1ino4t0ering5holic7ba1n1re3c1d1enter4o1rn3f0a1d2g1h0anel2nel4rity4se2t2eap3intai5ristmas6ome4urch5i0priani6rcle4sco3tadel4i0c2y3k1l0aims4eaning6ick2nic1que6othing5ud3ub0med6m1n1o0ach3des3ffee4llege4ogne5m0mbank4unity6pany2re3uter5sec4ndos3struction8ulting7tact3ractors9oking4l1p2rsica5untry4pon0s4rses6pa2r0edit0card4union9icket5own3s1uise0s6u0isinella9v1w1x1y0mru3ou3z2dad1nce3ta1e1ing3sun4y2clk3ds2e0al0er2s3gree4livery5l1oitte5ta3mocrat6ntal2ist5si0gn4v2hl2iamonds6et2gital5rect0ory7scount3ver5h2y2j1k1m1np2o0cs1tor4g1mains5t1wnload7rive4tv2ubai3nlop4pont4rban5vag2r2z2earth3t2c0o2deka3u0cation8e1g1mail3erck5nergy4gineer0ing9terprises10pson4quipment8r0icsson6ni3s0q1tate5t1u0rovision8s2vents5xchange6pert3osed4ress5traspace10fage2il1rwinds6th3mily4n0s2rm0ers5shion4t3edex3edback6rrari3ero6i0delity5o2lm2nal1nce1ial7re0stone6mdale6sh0ing5t0ness6j1k1lickr3ghts4r2orist4wers5y2m1o0o0d1tball6rd1ex2sale4um3undation8x2r0ee1senius7l1ogans4ntier7tr2ujitsu5n0d2rniture7tbol5yi3ga0l0lery3o1up4me0s3p1rden4y2b0iz3d0n2e0a1nt0ing5orge5f1g0ee3h1i0ft0s3ves2ing5l0ass3e1obal2o4m0ail3bh2o1x2n1odaddy5ld0point6f2o0dyear5g0le4p1t1v2p1q1r0ainger5phics5tis4een3ipe3ocery4up4s1t1u0cci3ge2ide2tars5ru3w1y2hair2mburg5ngout5us3bo2dfc0bank7ealth0care8lp1sinki6re1mes5iphop4samitsu7tachi5v2k0t2m1n1ockey4ldings5iday5medepot5goods5s0ense7nda3rse3spital5t0ing5t0els3mail5use3w2r1sbc3t1u0ghes5yatt3undai7ibm2cbc2e1u2d1e0ee3fm2kano4l1m0amat4db2mo0bilien9n0c1dustries8finiti5o2g1k1stitute6urance4e4t0ernational10uit4vestments10o1piranga7q1r0ish4s0maili5t0anbul7t0au2v3jaguar4va3cb2e0ep2tzt3welry6io2ll2m0p2nj2o0bs1urg4t1y2p0morgan6rs3uegos4niper7kaufen5ddi3e0rryhotels6properties14fh2g1h1i0a1ds2m1ndle4tchen5wi3m1n1oeln3matsu5sher5p0mg2n2r0d1ed3uokgroup8w1y0oto4z2la0caixa5mborghini8er3nd0rover6xess5salle5t0ino3robe5w0yer5b1c1ds2ease3clerc5frak4gal2o2xus4gbt3i0dl2fe0insurance9style7ghting6ke2lly3mited4o2ncoln4k2ve1ing5k1lc1p2oan0s3cker3us3l1ndon4tte1o3ve3pl0financial11r1s1t0d0a3u0ndbeck6xe1ury5v1y2ma0drid4if1son4keup4n0agement7go3p1rket0ing3s4riott5shalls7ttel5ba2c0kinsey7d1e0d0ia3et2lbourne7me1orial6n0u2rckmsd7g1h1iami3crosoft7l1ni1t2t0subishi9k1l0b1s2m0a2n1o0bi0le4da2e1i1m1nash3ey2ster5rmon3tgage6scow4to0rcycles9v0ie4p1q1r1s0d2t0n1r2u0seum3ic4v1w1x1y1z2na0b1goya4me2vy3ba2c1e0c1t0bank4flix4work5ustar5w0s2xt0direct7us4f0l2g0o2hk2i0co2ke1on3nja3ssan1y5l1o0kia3rton4w0ruz3tv4p1r0a1w2tt2u1yc2z2obi1server7ffice5kinawa6layan0group9lo3m0ega4ne1g1l0ine5oo2pen3racle3nge4g0anic5igins6saka4tsuka4t2vh3pa0ge2nasonic7ris2s1tners4s1y3y2ccw3e0t2f0izer5g1h0armacy6d1ilips5one2to0graphy6s4ysio5ics1tet2ures6d1n0g1k2oneer5zza4k1l0ace2y0station9umbing5s3m1n0c2ohl2ker3litie5rn2st3r0axi3ess3ime3o0d0uctions8f1gressive8mo2perties3y5tection8u0dential9s1t1ub2w0c2y2qa1pon3uebec3st5racing4dio4e0ad1lestate6tor2y4cipes5d0stone5umbrella9hab3ise0n3t2liance6n0t0als5pair3ort3ublican8st0aurant8view0s5xroth6ich0ardli6oh3l1o1p2o0cks3deo3gers4om3s0vp3u0gby3hr2n2w0e2yukyu6sa0arland6fe0ty4kura4le1on3msclub4ung5ndvik0coromant12ofi4p1rl2s1ve2xo3b0i1s2c0b1haeffler7midt4olarships8ol3ule3warz5ience5ot3d1e0arch3t2cure1ity6ek2lect4ner3rvices6ven3w1x0y3fr2g1h0angrila6rp3ell3ia1ksha5oes2p0ping5uji3w3i0lk2na1gles5te3j1k0i0n2y0pe4l0ing4m0art3ile4n0cf3o0ccer3ial4ftbank4ware6hu2lar2utions7ng1y2y2pa0ce3ort2t3r0l2s1t0ada2ples4r1tebank4farm7c0group6ockholm6rage3e3ream4udio2y3yle4u0cks3pplies3y2ort5rf1gery5zuki5v1watch4iss4x1y0dney4stems6z2tab1ipei4lk2obao4rget4tamotors6r2too4x0i3c0i2d0k2eam2ch0nology8l1masek5nnis4va3f1g1h0d1eater2re6iaa2ckets5enda4ps2res2ol4j0maxx4x2k0maxx5l1m0all4n1o0day3kyo3ols3p1ray3shiba5tal3urs3wn2yota3s3r0ade1ing4ining5vel0ers0insurance16ust3v2t1ube2i1nes3shu4v0s2w1z2ua1bank3s2g1k1nicom3versity8o2ol2ps2s1y1z2va0cations7na1guard7c1e0gas3ntures6risign5m\xF6gensberater2ung14sicherung10t2g1i0ajes4deo3g1king4llas4n1p1rgin4sa1ion4va1o3laanderen9n1odka3lvo3te1ing3o2yage5u2wales2mart4ter4ng0gou5tch0es6eather0channel12bcam3er2site5d0ding5ibo2r3f1hoswho6ien2ki2lliamhill9n0dows4e1ners6me2olterskluwer11odside6rk0s2ld3w2s1tc1f3xbox3erox4ihuan4n2xx2yz3yachts4hoo3maxun5ndex5e1odobashi7ga2kohama6u0tube6t1un3za0ppos4ra3ero3ip2m1one3uerich6w2",kS="\u03B5\u03BB1\u03C52\u0431\u04331\u0435\u043B3\u0434\u0435\u0442\u04384\u0435\u044E2\u043A\u0430\u0442\u043E\u043B\u0438\u043A6\u043E\u043C3\u043C\u043A\u04342\u043E\u043D1\u0441\u043A\u0432\u04306\u043E\u043D\u043B\u0430\u0439\u043D5\u0440\u04333\u0440\u0443\u04412\u04442\u0441\u0430\u0439\u04423\u0440\u04313\u0443\u043A\u04403\u049B\u0430\u04373\u0570\u0561\u05753\u05D9\u05E9\u05E8\u05D0\u05DC5\u05E7\u05D5\u05DD3\u0627\u0628\u0648\u0638\u0628\u064A5\u0631\u0627\u0645\u0643\u06485\u0644\u0627\u0631\u062F\u06464\u0628\u062D\u0631\u064A\u06465\u062C\u0632\u0627\u0626\u06315\u0633\u0639\u0648\u062F\u064A\u06296\u0639\u0644\u064A\u0627\u06465\u0645\u063A\u0631\u06285\u0645\u0627\u0631\u0627\u062A5\u06CC\u0631\u0627\u06465\u0628\u0627\u0631\u062A2\u0632\u0627\u06314\u064A\u062A\u06433\u06BE\u0627\u0631\u062A5\u062A\u0648\u0646\u06334\u0633\u0648\u062F\u0627\u06463\u0631\u064A\u06295\u0634\u0628\u0643\u06294\u0639\u0631\u0627\u06422\u06282\u0645\u0627\u06464\u0641\u0644\u0633\u0637\u064A\u06466\u0642\u0637\u06313\u0643\u0627\u062B\u0648\u0644\u064A\u06436\u0648\u06453\u0645\u0635\u06312\u0644\u064A\u0633\u064A\u06275\u0648\u0631\u064A\u062A\u0627\u0646\u064A\u06277\u0642\u06394\u0647\u0645\u0631\u0627\u06475\u067E\u0627\u06A9\u0633\u062A\u0627\u06467\u0680\u0627\u0631\u062A4\u0915\u0949\u092E3\u0928\u0947\u091F3\u092D\u093E\u0930\u09240\u092E\u094D3\u094B\u09245\u0938\u0902\u0917\u0920\u09285\u09AC\u09BE\u0982\u09B2\u09BE5\u09AD\u09BE\u09B0\u09A42\u09F0\u09A44\u0A2D\u0A3E\u0A30\u0A244\u0AAD\u0ABE\u0AB0\u0AA44\u0B2D\u0B3E\u0B30\u0B244\u0B87\u0BA8\u0BCD\u0BA4\u0BBF\u0BAF\u0BBE6\u0BB2\u0B99\u0BCD\u0B95\u0BC86\u0B9A\u0BBF\u0B99\u0BCD\u0B95\u0BAA\u0BCD\u0BAA\u0BC2\u0BB0\u0BCD11\u0C2D\u0C3E\u0C30\u0C24\u0C4D5\u0CAD\u0CBE\u0CB0\u0CA44\u0D2D\u0D3E\u0D30\u0D24\u0D025\u0DBD\u0D82\u0D9A\u0DCF4\u0E04\u0E2D\u0E213\u0E44\u0E17\u0E223\u0EA5\u0EB2\u0EA73\u10D2\u10D42\u307F\u3093\u306A3\u30A2\u30DE\u30BE\u30F34\u30AF\u30E9\u30A6\u30C94\u30B0\u30FC\u30B0\u30EB4\u30B3\u30E02\u30B9\u30C8\u30A23\u30BB\u30FC\u30EB3\u30D5\u30A1\u30C3\u30B7\u30E7\u30F36\u30DD\u30A4\u30F3\u30C84\u4E16\u754C2\u4E2D\u4FE11\u56FD1\u570B1\u6587\u7F513\u4E9A\u9A6C\u900A3\u4F01\u4E1A2\u4F5B\u5C712\u4FE1\u606F2\u5065\u5EB72\u516B\u53662\u516C\u53F81\u76CA2\u53F0\u6E7E1\u70632\u5546\u57CE1\u5E971\u68072\u5609\u91CC0\u5927\u9152\u5E975\u5728\u7EBF2\u5927\u62FF2\u5929\u4E3B\u65593\u5A31\u4E502\u5BB6\u96FB2\u5E7F\u4E1C2\u5FAE\u535A2\u6148\u55842\u6211\u7231\u4F603\u624B\u673A2\u62DB\u80582\u653F\u52A11\u5E9C2\u65B0\u52A0\u57612\u95FB2\u65F6\u5C1A2\u66F8\u7C4D2\u673A\u67842\u6DE1\u9A6C\u95213\u6E38\u620F2\u6FB3\u95802\u70B9\u770B2\u79FB\u52A82\u7EC4\u7EC7\u673A\u67844\u7F51\u57401\u5E971\u7AD91\u7EDC2\u8054\u901A2\u8C37\u6B4C2\u8D2D\u72692\u901A\u8CA92\u96C6\u56E22\u96FB\u8A0A\u76C8\u79D14\u98DE\u5229\u6D663\u98DF\u54C12\u9910\u53852\u9999\u683C\u91CC\u62C93\u6E2F2\uB2F7\uB1371\uCEF42\uC0BC\uC1312\uD55C\uAD6D2",Wc="numeric",qc="ascii",Kc="alpha",fi="asciinumeric",pi="alphanumeric",Jc="domain",jg="emoji",MS="scheme",wS="slashscheme",Vc="whitespace";function AS(t,e){return t in e||(e[t]=[]),e[t]}function Hn(t,e,n){e[Wc]&&(e[fi]=!0,e[pi]=!0),e[qc]&&(e[fi]=!0,e[Kc]=!0),e[fi]&&(e[pi]=!0),e[Kc]&&(e[pi]=!0),e[pi]&&(e[Jc]=!0),e[jg]&&(e[Jc]=!0);for(let r in e){let i=AS(r,n);i.indexOf(t)<0&&i.push(t)}}function RS(t,e){let n={};for(let r in e)e[r].indexOf(t)>=0&&(n[r]=!0);return n}function Pe(t=null){this.j={},this.jr=[],this.jd=null,this.t=t}Pe.groups={};Pe.prototype={accepts(){return!!this.t},go(t){let e=this,n=e.j[t];if(n)return n;for(let r=0;r<e.jr.length;r++){let i=e.jr[r][0],o=e.jr[r][1];if(o&&i.test(t))return o}return e.jd},has(t,e=!1){return e?t in this.j:!!this.go(t)},ta(t,e,n,r){for(let i=0;i<t.length;i++)this.tt(t[i],e,n,r)},tr(t,e,n,r){r=r||Pe.groups;let i;return e&&e.j?i=e:(i=new Pe(e),n&&r&&Hn(e,n,r)),this.jr.push([t,i]),i},ts(t,e,n,r){let i=this,o=t.length;if(!o)return i;for(let s=0;s<o-1;s++)i=i.tt(t[s]);return i.tt(t[o-1],e,n,r)},tt(t,e,n,r){r=r||Pe.groups;let i=this;if(e&&e.j)return i.j[t]=e,e;let o=e,s,a=i.go(t);if(a?(s=new Pe,Object.assign(s.j,a.j),s.jr.push.apply(s.jr,a.jr),s.jd=a.jd,s.t=a.t):s=new Pe,o){if(r)if(s.t&&typeof s.t=="string"){let l=Object.assign(RS(s.t,r),n);Hn(o,l,r)}else n&&Hn(o,n,r);s.t=o}return i.j[t]=s,s}};var F=(t,e,n,r,i)=>t.ta(e,n,r,i),re=(t,e,n,r,i)=>t.tr(e,n,r,i),Bg=(t,e,n,r,i)=>t.ts(e,n,r,i),v=(t,e,n,r,i)=>t.tt(e,n,r,i),Ot="WORD",Gc="UWORD",Wg="ASCIINUMERICAL",qg="ALPHANUMERICAL",xi="LOCALHOST",Yc="TLD",Qc="UTLD",Xo="SCHEME",br="SLASH_SCHEME",Zc="NUM",Xc="WS",ed="NL",hi="OPENBRACE",mi="CLOSEBRACE",Zo="OPENBRACKET",es="CLOSEBRACKET",ts="OPENPAREN",ns="CLOSEPAREN",rs="OPENANGLEBRACKET",is="CLOSEANGLEBRACKET",ss="FULLWIDTHLEFTPAREN",as="FULLWIDTHRIGHTPAREN",ls="LEFTCORNERBRACKET",cs="RIGHTCORNERBRACKET",ds="LEFTWHITECORNERBRACKET",us="RIGHTWHITECORNERBRACKET",ps="FULLWIDTHLESSTHAN",fs="FULLWIDTHGREATERTHAN",hs="AMPERSAND",ms="APOSTROPHE",gs="ASTERISK",Zt="AT",ys="BACKSLASH",bs="BACKTICK",xs="CARET",en="COLON",td="COMMA",Cs="DOLLAR",gt="DOT",Es="EQUALS",nd="EXCLAMATION",et="HYPHEN",gi="PERCENT",vs="PIPE",Ts="PLUS",Ss="POUND",yi="QUERY",rd="QUOTE",Kg="FULLWIDTHMIDDLEDOT",id="SEMI",yt="SLASH",bi="TILDE",ks="UNDERSCORE",Jg="EMOJI",Ms="SYM",Gg=Object.freeze({__proto__:null,ALPHANUMERICAL:qg,AMPERSAND:hs,APOSTROPHE:ms,ASCIINUMERICAL:Wg,ASTERISK:gs,AT:Zt,BACKSLASH:ys,BACKTICK:bs,CARET:xs,CLOSEANGLEBRACKET:is,CLOSEBRACE:mi,CLOSEBRACKET:es,CLOSEPAREN:ns,COLON:en,COMMA:td,DOLLAR:Cs,DOT:gt,EMOJI:Jg,EQUALS:Es,EXCLAMATION:nd,FULLWIDTHGREATERTHAN:fs,FULLWIDTHLEFTPAREN:ss,FULLWIDTHLESSTHAN:ps,FULLWIDTHMIDDLEDOT:Kg,FULLWIDTHRIGHTPAREN:as,HYPHEN:et,LEFTCORNERBRACKET:ls,LEFTWHITECORNERBRACKET:ds,LOCALHOST:xi,NL:ed,NUM:Zc,OPENANGLEBRACKET:rs,OPENBRACE:hi,OPENBRACKET:Zo,OPENPAREN:ts,PERCENT:gi,PIPE:vs,PLUS:Ts,POUND:Ss,QUERY:yi,QUOTE:rd,RIGHTCORNERBRACKET:cs,RIGHTWHITECORNERBRACKET:us,SCHEME:Xo,SEMI:id,SLASH:yt,SLASH_SCHEME:br,SYM:Ms,TILDE:bi,TLD:Yc,UNDERSCORE:ks,UTLD:Qc,UWORD:Gc,WORD:Ot,WS:Xc}),At=/[a-z]/,ui=/\p{L}/u,zc=/\p{Emoji}/u;var Rt=/\d/,$c=/\s/;var Vg="\r",Uc=`
+`,OS="\uFE0F",LS="\u200D",_c="\uFFFC",Go=null,Yo=null;function NS(t=[]){let e={};Pe.groups=e;let n=new Pe;Go==null&&(Go=zg(SS)),Yo==null&&(Yo=zg(kS)),v(n,"'",ms),v(n,"{",hi),v(n,"}",mi),v(n,"[",Zo),v(n,"]",es),v(n,"(",ts),v(n,")",ns),v(n,"<",rs),v(n,">",is),v(n,"\uFF08",ss),v(n,"\uFF09",as),v(n,"\u300C",ls),v(n,"\u300D",cs),v(n,"\u300E",ds),v(n,"\u300F",us),v(n,"\uFF1C",ps),v(n,"\uFF1E",fs),v(n,"&",hs),v(n,"*",gs),v(n,"@",Zt),v(n,"`",bs),v(n,"^",xs),v(n,":",en),v(n,",",td),v(n,"$",Cs),v(n,".",gt),v(n,"=",Es),v(n,"!",nd),v(n,"-",et),v(n,"%",gi),v(n,"|",vs),v(n,"+",Ts),v(n,"#",Ss),v(n,"?",yi),v(n,'"',rd),v(n,"/",yt),v(n,";",id),v(n,"~",bi),v(n,"_",ks),v(n,"\\",ys),v(n,"\u30FB",Kg);let r=re(n,Rt,Zc,{[Wc]:!0});re(r,Rt,r);let i=re(r,At,Wg,{[fi]:!0}),o=re(r,ui,qg,{[pi]:!0}),s=re(n,At,Ot,{[qc]:!0});re(s,Rt,i),re(s,At,s),re(i,Rt,i),re(i,At,i);let a=re(n,ui,Gc,{[Kc]:!0});re(a,At),re(a,Rt,o),re(a,ui,a),re(o,Rt,o),re(o,At),re(o,ui,o);let l=v(n,Uc,ed,{[Vc]:!0}),c=v(n,Vg,Xc,{[Vc]:!0}),d=re(n,$c,Xc,{[Vc]:!0});v(n,_c,d),v(c,Uc,l),v(c,_c,d),re(c,$c,d),v(d,Vg),v(d,Uc),re(d,$c,d),v(d,_c,d);let u=re(n,zc,Jg,{[jg]:!0});v(u,"#"),re(u,zc,u),v(u,OS,u);let p=v(u,LS);v(p,"#"),re(p,zc,u);let f=[[At,s],[Rt,i]],h=[[At,null],[ui,a],[Rt,o]];for(let m=0;m<Go.length;m++)Xt(n,Go[m],Yc,Ot,f);for(let m=0;m<Yo.length;m++)Xt(n,Yo[m],Qc,Gc,h);Hn(Yc,{tld:!0,ascii:!0},e),Hn(Qc,{utld:!0,alpha:!0},e),Xt(n,"file",Xo,Ot,f),Xt(n,"mailto",Xo,Ot,f),Xt(n,"http",br,Ot,f),Xt(n,"https",br,Ot,f),Xt(n,"ftp",br,Ot,f),Xt(n,"ftps",br,Ot,f),Hn(Xo,{scheme:!0,ascii:!0},e),Hn(br,{slashscheme:!0,ascii:!0},e),t=t.sort((m,g)=>m[0]>g[0]?1:-1);for(let m=0;m<t.length;m++){let g=t[m][0],x=t[m][1]?{[MS]:!0}:{[wS]:!0};g.indexOf("-")>=0?x[Jc]=!0:At.test(g)?Rt.test(g)?x[fi]=!0:x[qc]=!0:x[Wc]=!0,Bg(n,g,g,x)}return Bg(n,"localhost",xi,{ascii:!0}),n.jd=new Pe(Ms),{start:n,tokens:Object.assign({groups:e},Gg)}}function Yg(t,e){let n=IS(e.replace(/[A-Z]/g,a=>a.toLowerCase())),r=n.length,i=[],o=0,s=0;for(;s<r;){let a=t,l=null,c=0,d=null,u=-1,p=-1;for(;s<r&&(l=a.go(n[s]));)a=l,a.accepts()?(u=0,p=0,d=a):u>=0&&(u+=n[s].length,p++),c+=n[s].length,o+=n[s].length,s++;o-=u,s-=p,c-=u,i.push({t:d.t,v:e.slice(o-c,o),s:o-c,e:o})}return i}function IS(t){let e=[],n=t.length,r=0;for(;r<n;){let i=t.charCodeAt(r),o,s=i<55296||i>56319||r+1===n||(o=t.charCodeAt(r+1))<56320||o>57343?t[r]:t.slice(r,r+2);e.push(s),r+=s.length}return e}function Xt(t,e,n,r,i){let o,s=e.length;for(let a=0;a<s-1;a++){let l=e[a];t.j[l]?o=t.j[l]:(o=new Pe(r),o.jr=i.slice(),t.j[l]=o),t=o}return o=new Pe(n),o.jr=i.slice(),t.j[e[s-1]]=o,o}function zg(t){let e=[],n=[],r=0,i="0123456789";for(;r<t.length;){let o=0;for(;i.indexOf(t[r+o])>=0;)o++;if(o>0){e.push(n.join(""));for(let s=parseInt(t.substring(r,r+o),10);s>0;s--)n.pop();r+=o}else n.push(t[r]),r++}return e}var Ci={defaultProtocol:"http",events:null,format:$g,formatHref:$g,nl2br:!1,tagName:"a",target:null,rel:null,validate:!0,truncate:1/0,className:null,attributes:null,ignoreTags:[],render:null};function od(t,e=null){let n=Object.assign({},Ci);t&&(n=Object.assign(n,t instanceof od?t.o:t));let r=n.ignoreTags,i=[];for(let o=0;o<r.length;o++)i.push(r[o].toUpperCase());this.o=n,e&&(this.defaultRender=e),this.ignoreTags=i}od.prototype={o:Ci,ignoreTags:[],defaultRender(t){return t},check(t){return this.get("validate",t.toString(),t)},get(t,e,n){let r=e!=null,i=this.o[t];return i&&(typeof i=="object"?(i=n.t in i?i[n.t]:Ci[t],typeof i=="function"&&r&&(i=i(e,n))):typeof i=="function"&&r&&(i=i(e,n.t,n)),i)},getObj(t,e,n){let r=this.o[t];return typeof r=="function"&&e!=null&&(r=r(e,n.t,n)),r},render(t){let e=t.render(this);return(this.get("render",null,t)||this.defaultRender)(e,t.t,t)}};function $g(t){return t}function Qg(t,e){this.t="token",this.v=t,this.tk=e}Qg.prototype={isLink:!1,toString(){return this.v},toHref(t){return this.toString()},toFormattedString(t){let e=this.toString(),n=t.get("truncate",e,this),r=t.get("format",e,this);return n&&r.length>n?r.substring(0,n)+"\u2026":r},toFormattedHref(t){return t.get("formatHref",this.toHref(t.get("defaultProtocol")),this)},startIndex(){return this.tk[0].s},endIndex(){return this.tk[this.tk.length-1].e},toObject(t=Ci.defaultProtocol){return{type:this.t,value:this.toString(),isLink:this.isLink,href:this.toHref(t),start:this.startIndex(),end:this.endIndex()}},toFormattedObject(t){return{type:this.t,value:this.toFormattedString(t),isLink:this.isLink,href:this.toFormattedHref(t),start:this.startIndex(),end:this.endIndex()}},validate(t){return t.get("validate",this.toString(),this)},render(t){let e=this,n=this.toHref(t.get("defaultProtocol")),r=t.get("formatHref",n,this),i=t.get("tagName",n,e),o=this.toFormattedString(t),s={},a=t.get("className",n,e),l=t.get("target",n,e),c=t.get("rel",n,e),d=t.getObj("attributes",n,e),u=t.getObj("events",n,e);return s.href=r,a&&(s.class=a),l&&(s.target=l),c&&(s.rel=c),d&&Object.assign(s,d),{tagName:i,attributes:s,content:o,eventListeners:u}}};function ws(t,e){class n extends Qg{constructor(i,o){super(i,o),this.t=t}}for(let r in e)n.prototype[r]=e[r];return n.t=t,n}var Ug=ws("email",{isLink:!0,toHref(){return"mailto:"+this.toString()}}),_g=ws("text"),PS=ws("nl"),Qo=ws("url",{isLink:!0,toHref(t=Ci.defaultProtocol){return this.hasProtocol()?this.v:`${t}://${this.v}`},hasProtocol(){let t=this.tk;return t.length>=2&&t[0].t!==xi&&t[1].t===en}});var Ze=t=>new Pe(t);function DS({groups:t}){let e=t.domain.concat([hs,gs,Zt,ys,bs,xs,Cs,Es,et,Zc,gi,vs,Ts,Ss,yt,Ms,bi,ks]),n=[ms,en,td,gt,nd,gi,yi,rd,id,rs,is,hi,mi,es,Zo,ts,ns,ss,as,ls,cs,ds,us,ps,fs],r=[hs,ms,gs,ys,bs,xs,Cs,Es,et,hi,mi,gi,vs,Ts,Ss,yi,yt,Ms,bi,ks],i=Ze(),o=v(i,bi);F(o,r,o),F(o,t.domain,o);let s=Ze(),a=Ze(),l=Ze();F(i,t.domain,s),F(i,t.scheme,a),F(i,t.slashscheme,l),F(s,r,o),F(s,t.domain,s);let c=v(s,Zt);v(o,Zt,c),v(a,Zt,c),v(l,Zt,c);let d=v(o,gt);F(d,r,o),F(d,t.domain,o);let u=Ze();F(c,t.domain,u),F(u,t.domain,u);let p=v(u,gt);F(p,t.domain,u);let f=Ze(Ug);F(p,t.tld,f),F(p,t.utld,f),v(c,xi,f);let h=v(u,et);v(h,et,h),F(h,t.domain,u),F(f,t.domain,u),v(f,gt,p),v(f,et,h);let m=v(f,en);F(m,t.numeric,Ug);let g=v(s,et),y=v(s,gt);v(g,et,g),F(g,t.domain,s),F(y,r,o),F(y,t.domain,s);let x=Ze(Qo);F(y,t.tld,x),F(y,t.utld,x),F(x,t.domain,s),F(x,r,o),v(x,gt,y),v(x,et,g),v(x,Zt,c);let C=v(x,en),M=Ze(Qo);F(C,t.numeric,M);let T=Ze(Qo),R=Ze();F(T,e,T),F(T,n,R),F(R,e,T),F(R,n,R),v(x,yt,T),v(M,yt,T);let S=v(a,en),P=v(l,en),D=v(P,yt),b=v(D,yt);F(a,t.domain,s),v(a,gt,y),v(a,et,g),F(l,t.domain,s),v(l,gt,y),v(l,et,g),F(S,t.domain,T),v(S,yt,T),v(S,yi,T),F(b,t.domain,T),F(b,e,T),v(b,yt,T);let Y=[[hi,mi],[Zo,es],[ts,ns],[rs,is],[ss,as],[ls,cs],[ds,us],[ps,fs]];for(let w=0;w<Y.length;w++){let[N,B]=Y[w],Q=v(T,N);v(R,N,Q),v(Q,B,T);let q=Ze(Qo);F(Q,e,q);let V=Ze();F(Q,n),F(q,e,q),F(q,n,V),F(V,e,q),F(V,n,V),v(q,B,T),v(V,B,T)}return v(i,xi,x),v(i,ed,PS),{start:i,tokens:Gg}}function HS(t,e,n){let r=n.length,i=0,o=[],s=[];for(;i<r;){let a=t,l=null,c=null,d=0,u=null,p=-1;for(;i<r&&!(l=a.go(n[i].t));)s.push(n[i++]);for(;i<r&&(c=l||a.go(n[i].t));)l=null,a=c,a.accepts()?(p=0,u=a):p>=0&&p++,i++,d++;if(p<0)i-=d,i<r&&(s.push(n[i]),i++);else{s.length>0&&(o.push(jc(_g,e,s)),s=[]),i-=p,d-=p;let f=u.t,h=n.slice(i-d,i);o.push(jc(f,e,h))}}return s.length>0&&o.push(jc(_g,e,s)),o}function jc(t,e,n){let r=n[0].s,i=n[n.length-1].e,o=e.slice(r,i);return new t(o,n)}var FS=typeof console!="undefined"&&console&&console.warn||(()=>{}),BS="until manual call of linkify.init(). Register all schemes and plugins before invoking linkify the first time.",G={scanner:null,parser:null,tokenQueue:[],pluginQueue:[],customSchemes:[],initialized:!1};function Xg(){return Pe.groups={},G.scanner=null,G.parser=null,G.tokenQueue=[],G.pluginQueue=[],G.customSchemes=[],G.initialized=!1,G}function sd(t,e=!1){if(G.initialized&&FS(`linkifyjs: already initialized - will not register custom scheme "${t}" ${BS}`),!/^[0-9a-z]+(-[0-9a-z]+)*$/.test(t))throw new Error(`linkifyjs: incorrect scheme format.
+1. Must only contain digits, lowercase ASCII letters or "-"
+2. Cannot start or end with "-"
+3. "-" cannot repeat`);G.customSchemes.push([t,e])}function VS(){G.scanner=NS(G.customSchemes);for(let t=0;t<G.tokenQueue.length;t++)G.tokenQueue[t][1]({scanner:G.scanner});G.parser=DS(G.scanner.tokens);for(let t=0;t<G.pluginQueue.length;t++)G.pluginQueue[t][1]({scanner:G.scanner,parser:G.parser});return G.initialized=!0,G}function As(t){return G.initialized||VS(),HS(G.parser.start,t,Yg(G.scanner.start,t))}As.scan=Yg;function Rs(t,e=null,n=null){if(e&&typeof e=="object"){if(n)throw Error(`linkifyjs: Invalid link type ${e}; must be a string`);n=e,e=null}let r=new od(n),i=As(t),o=[];for(let s=0;s<i.length;s++){let a=i[s];a.isLink&&(!e||a.t===e)&&r.check(a)&&o.push(a.toFormattedObject(r))}return o}var ad="[\0- \xA0\u1680\u180E\u2000-\u2029\u205F\u3000]",Zg=new RegExp(ad),ey=new RegExp(`${ad}$`),ty=new RegExp(ad,"g");function zS(t){return t.length===1?t[0].isLink:t.length===3&&t[1].isLink?["()","[]"].includes(t[0].value+t[2].value):!1}function ny(t){return new z({key:new K("autolink"),appendTransaction:(e,n,r)=>{let i=e.some(c=>c.docChanged)&&!n.doc.eq(r.doc),o=e.some(c=>c.getMeta("preventAutolink"));if(!i||o)return;let{tr:s}=r,a=$m(n.doc,[...e]);if(Jm(a).forEach(({newRange:c})=>{let d=_m(r.doc,c,f=>f.isTextblock),u,p;if(d.length>1)u=d[0],p=r.doc.textBetween(u.pos,u.pos+u.node.nodeSize,void 0," ");else if(d.length){let f=r.doc.textBetween(c.from,c.to," "," ");if(!ey.test(f))return;u=d[0],p=r.doc.textBetween(u.pos,c.to,void 0," ")}if(u&&p){let f=p.split(Zg).filter(Boolean);if(f.length<=0)return!1;let h=f[f.length-1],m=u.pos+p.lastIndexOf(h);if(!h)return!1;let g=As(h).map(y=>y.toObject(t.defaultProtocol));if(!zS(g))return!1;g.filter(y=>y.isLink).map(y=>({...y,from:m+y.start+1,to:m+y.end+1})).filter(y=>r.schema.marks.code?!r.doc.rangeHasMark(y.from,y.to,r.schema.marks.code):!0).filter(y=>t.validate(y.value)).filter(y=>t.shouldAutoLink(y.value)).forEach(y=>{mr(y.from,y.to,r.doc).some(x=>x.mark.type===t.type)||s.addMark(y.from,y.to,t.type.create({href:y.href}))})}}),!!s.steps.length)return s}})}function ry(t){return new z({key:new K("handleClickLink"),props:{handleClick:(e,n,r)=>{var d,u;if(r.button!==0||!e.editable)return!1;let i=r.target,o=[];for(;i.nodeName!=="DIV";)o.push(i),i=i.parentNode;if(!o.find(p=>p.nodeName==="A"))return!1;let s=lc(e.state,t.type.name),a=r.target,l=(d=a==null?void 0:a.href)!=null?d:s.href,c=(u=a==null?void 0:a.target)!=null?u:s.target;return a&&l?(window.open(l,c),!0):!1}}})}function iy(t){return new z({key:new K("handlePasteLink"),props:{handlePaste:(e,n,r)=>{let{state:i}=e,{selection:o}=i,{empty:s}=o;if(s)return!1;let a="";r.content.forEach(c=>{a+=c.textContent});let l=Rs(a,{defaultProtocol:t.defaultProtocol}).find(c=>c.isLink&&c.value===a);return!a||!l?!1:t.editor.commands.setMark(t.type,{href:l.href})}}})}function Fn(t,e){let n=["http","https","ftp","ftps","mailto","tel","callto","sms","cid","xmpp"];return e&&e.forEach(r=>{let i=typeof r=="string"?r:r.scheme;i&&n.push(i)}),!t||t.replace(ty,"").match(new RegExp(`^(?:(?:${n.join("|")}):|[^a-z]|[a-z0-9+.-]+(?:[^a-z+.-:]|$))`,"i"))}var oy=ke.create({name:"link",priority:1e3,keepOnSplit:!1,exitable:!0,onCreate(){this.options.validate&&!this.options.shouldAutoLink&&(this.options.shouldAutoLink=this.options.validate,console.warn("The `validate` option is deprecated. Rename to the `shouldAutoLink` option instead.")),this.options.protocols.forEach(t=>{if(typeof t=="string"){sd(t);return}sd(t.scheme,t.optionalSlashes)})},onDestroy(){Xg()},inclusive(){return this.options.autolink},addOptions(){return{openOnClick:!0,linkOnPaste:!0,autolink:!0,protocols:[],defaultProtocol:"http",HTMLAttributes:{target:"_blank",rel:"noopener noreferrer nofollow",class:null},isAllowedUri:(t,e)=>!!Fn(t,e.protocols),validate:t=>!!t,shouldAutoLink:t=>!!t}},addAttributes(){return{href:{default:null,parseHTML(t){return t.getAttribute("href")}},target:{default:this.options.HTMLAttributes.target},rel:{default:this.options.HTMLAttributes.rel},class:{default:this.options.HTMLAttributes.class}}},parseHTML(){return[{tag:"a[href]",getAttrs:t=>{let e=t.getAttribute("href");return!e||!this.options.isAllowedUri(e,{defaultValidate:n=>!!Fn(n,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?!1:null}}]},renderHTML({HTMLAttributes:t}){return this.options.isAllowedUri(t.href,{defaultValidate:e=>!!Fn(e,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?["a",U(this.options.HTMLAttributes,t),0]:["a",U(this.options.HTMLAttributes,{...t,href:""}),0]},addCommands(){return{setLink:t=>({chain:e})=>{let{href:n}=t;return this.options.isAllowedUri(n,{defaultValidate:r=>!!Fn(r,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?e().setMark(this.name,t).setMeta("preventAutolink",!0).run():!1},toggleLink:t=>({chain:e})=>{let{href:n}=t;return this.options.isAllowedUri(n,{defaultValidate:r=>!!Fn(r,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?e().toggleMark(this.name,t,{extendEmptyMarkRange:!0}).setMeta("preventAutolink",!0).run():!1},unsetLink:()=>({chain:t})=>t().unsetMark(this.name,{extendEmptyMarkRange:!0}).setMeta("preventAutolink",!0).run()}},addPasteRules(){return[Xe({find:t=>{let e=[];if(t){let{protocols:n,defaultProtocol:r}=this.options,i=Rs(t).filter(o=>o.isLink&&this.options.isAllowedUri(o.value,{defaultValidate:s=>!!Fn(s,n),protocols:n,defaultProtocol:r}));i.length&&i.forEach(o=>e.push({text:o.value,data:{href:o.href},index:o.start}))}return e},type:this.type,getAttributes:t=>{var e;return{href:(e=t.data)==null?void 0:e.href}}})]},addProseMirrorPlugins(){let t=[],{protocols:e,defaultProtocol:n}=this.options;return this.options.autolink&&t.push(ny({type:this.type,defaultProtocol:this.options.defaultProtocol,validate:r=>this.options.isAllowedUri(r,{defaultValidate:i=>!!Fn(i,e),protocols:e,defaultProtocol:n}),shouldAutoLink:this.options.shouldAutoLink})),this.options.openOnClick===!0&&t.push(ry({type:this.type})),this.options.linkOnPaste&&t.push(iy({editor:this.editor,defaultProtocol:this.options.defaultProtocol,type:this.type})),t}});var sy=ke.create({name:"underline",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"u"},{style:"text-decoration",consuming:!1,getAttrs:t=>t.includes("underline")?{}:!1}]},renderHTML({HTMLAttributes:t}){return["u",U(this.options.HTMLAttributes,t),0]},addCommands(){return{setUnderline:()=>({commands:t})=>t.setMark(this.name),toggleUnderline:()=>({commands:t})=>t.toggleMark(this.name),unsetUnderline:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-u":()=>this.editor.commands.toggleUnderline(),"Mod-U":()=>this.editor.commands.toggleUnderline()}}});var Ei=null;var ay=new WeakMap;async function py(t,e={}){let r=Ei!==null&&Object.keys(e!=null?e:{}).length===0?{}:$a(za(t.ownerDocument),e!=null?e:{}),i=Object.keys(r).length>0,o=Ei&&!i?Ei:new Mr(i?r:void 0);Bu(o),Ku(o);let s=fy(t,o,r);return s.length>0&&await Promise.all(s),o}function US(t,e){var n,r;if(!(!e||e.current==null)&&t.dataset.relationshipCurrentApplied!=="true"){if(t instanceof HTMLSelectElement){let i=_s(e.current,t.multiple);hy(t,i)&&(my(t,e.submitAs),t.dataset.relationshipCurrentApplied="true");return}if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement){let i=_s(e.current,!1);i.length>0&&(t.value=(r=(n=i[0])==null?void 0:n.value)!=null?r:"",t.dataset.relationshipCurrentApplied="true")}}}function fy(t,e,n){let r=_S(t,e);Ea(t),Ma(t,{onItemAdded:s=>{let a=fy(s,e,n);a.length>0&&Promise.all(a)}}),Ha(t),Ba(t);let i=[],o=zs(t);for(let s of o){let a=It(s),l=$n(a),c=Pt(s,a);US(s,c),e.get(s)||e.register(s,{field:c,endpoint:l}),c.renderer==="chips"&&s instanceof HTMLSelectElement&&s.multiple&&Vu(s,e),c.renderer==="typeahead"&&s instanceof HTMLSelectElement&&!s.multiple&&Ju(s,e),p0(s,c,t,e,r),h0(s,c,t,e,r),f0(s,c,e,r),m0(s,c,e,r),jS(c,l)&&i.push(e.resolve(s))}return i}function _S(t,e){let n=ay.get(e);n||(n=new WeakMap,ay.set(e,n));let r=n.get(t);if(r&&!r.disposed)return r;let i={cleanups:new Set,disposed:!1};return n.set(t,i),e.registerRootCleanup(t,()=>{if(!i.disposed){i.disposed=!0;for(let o of i.cleanups)o();i.cleanups.clear(),Jd(t),wa(t),va(t),n==null||n.delete(t)}}),i}function hy(t,e){let n=new Map;for(let o of e)if(!(!o.value||n.has(o.value))&&(n.set(o.value,o),!t.multiple))break;let r=new Set(n.keys()),i=!1;return Array.from(t.options).forEach(o=>{let s=r.has(o.value);if(o.selected!==s&&(o.selected=s,i=!0),s){let a=n.get(o.value);a&&o.textContent!==a.label&&(o.textContent=a.label,i=!0),r.delete(o.value)}}),r.forEach(o=>{var a,l;if(!o)return;let s=document.createElement("option");s.value=o,s.textContent=(l=(a=n.get(o))==null?void 0:a.label)!=null?l:o,s.selected=!0,t.appendChild(s),i=!0}),!t.multiple&&n.size===0&&t.value!==""&&(t.value="",i=!0),i}function my(t,e){if(e==="json"){Nt(t);return}t.multiple&&rn(t)}function jS(t,e){return!e.url||t.refreshMode==="manual"?!1:t.mode==="search"?WS(t.current):!0}function WS(t){return Ni(t).length>0}var Os=new WeakMap;function ld(t=document,e={}){var s;let n=JS(t),r=(s=e.registry)!=null?s:Ei,i=!1,o={root:n,getValues(a={}){var l,c;return i?{}:ly(n,{includeHidden:(c=(l=a.includeHidden)!=null?l:e.includeHidden)!=null?c:!0})},setValues(a){i||xr(n,{values:a},r)},reset(){var l;if(i)return;GS(n);let a=ly(n,{includeHidden:(l=e.includeHidden)!=null?l:!0});xr(n,{values:a},r),xr(n,{errors:cy(n)},r)},setErrors(a){i||xr(n,{errors:a},r)},applyServerErrors(a,l){if(i)return null;let c=Ia(vp(n),a,l);return c&&Object.keys(c.fields).length>0&&o.setErrors(c.fields),c},clearErrors(a){if(i)return;let l=cy(n,a);xr(n,{errors:l},r)},onChange(a){var d;if(i)return()=>{};let l=u=>{a(o.getValues(),u)};n.addEventListener("input",l,!0),n.addEventListener("change",l,!0);let c=()=>{n.removeEventListener("input",l,!0),n.removeEventListener("change",l,!0)};return(d=Os.get(o))==null||d.push(c),c},focus(a){if(i)return!1;let l=ZS(n,a);return!l||typeof l.focus!="function"?!1:(l.focus(),!0)},destroy(){var l;if(i)return;i=!0,((l=Os.get(o))!=null?l:[]).forEach(c=>c()),Os.delete(o),r==null||r.destroy(n)}};return Os.set(o,[]),o}var qS={attach:ld,hydrate:KS};typeof globalThis!="undefined"&&(globalThis.Formgen=qS);function KS(t=document,e={}){xr(t,e,Ei)}function xr(t,e,n){let r=t!=null?t:document,i=e0(r);if(i.length===0)return;let o=uy(e.values),s=uy(e.errors);i.forEach(a=>{r0(a,o,n),a0(a,s,n)})}function JS(t){if(typeof t=="string"){let e=document.querySelector(t);if(!e)throw new Error(`formgen: controller root not found for selector ${t}`);return e}return t!=null?t:document}function ly(t,e){let n={};return Is(t).forEach(r=>{if(!YS(r,e))return;let i=cd(r);if(!i)return;let o=QS(r);o!==void 0&&XS(n,Cr(tn(i)),o)}),n}function Is(t){let e=new Set;return(t instanceof HTMLInputElement||t instanceof HTMLSelectElement||t instanceof HTMLTextAreaElement)&&e.add(t),t.querySelectorAll("input, select, textarea").forEach(n=>e.add(n)),Array.from(e)}function GS(t){Is(t).forEach(e=>{if(e instanceof HTMLInputElement){let n=e.type.toLowerCase();n==="checkbox"||n==="radio"?e.checked=e.defaultChecked:n!=="file"&&(e.value=e.defaultValue);return}if(e instanceof HTMLTextAreaElement){e.value=e.defaultValue;return}Array.from(e.options).forEach(n=>{n.selected=n.defaultSelected}),!e.multiple&&e.selectedIndex<0&&e.options.length>0&&(e.selectedIndex=0)})}function YS(t,e){if(t.disabled)return!1;if(t instanceof HTMLInputElement){if(t.closest("[data-relationship-hidden]"))return!1;let n=t.type.toLowerCase();if(["button","submit","reset","file"].includes(n)||n==="hidden"&&e.includeHidden===!1)return!1}return!!cd(t)}function cd(t){return(t.getAttribute("name")||t.getAttribute(Tr)||t.getAttribute("data-field-path")||t.dataset.fieldName||t.id||"").trim()}function QS(t){if(t instanceof HTMLSelectElement)return t.multiple?Array.from(t.selectedOptions).map(e=>e.value):t.value;if(t instanceof HTMLInputElement){let e=t.type.toLowerCase();if(e==="checkbox")return t.name.endsWith("[]")?t.checked?[t.value]:void 0:t.checked;if(e==="radio")return t.checked?t.value:void 0}return t.value}function XS(t,e,n){let r=vi(e);if(r.length===0)return;let i=t;r.forEach((o,s)=>{if(s===r.length-1){let a=Object.prototype.hasOwnProperty.call(i,o)?i[o]:void 0;Array.isArray(a)&&Array.isArray(n)?i[o]=a.concat(n):i[o]=n;return}(!Object.prototype.hasOwnProperty.call(i,o)||!i[o]||typeof i[o]!="object"||Array.isArray(i[o]))&&(i[o]={}),i=i[o]})}function cy(t,e){let n={};return(e&&e.length>0?e:Is(t).map(cd)).forEach(i=>{let o=i.trim(),s=Cr(tn(o));vi(s).length>0&&(n[s]=[])}),n}function ZS(t,e){let n=new Set,r=e.trim();if(!r)return null;let i=Cr(tn(r));if(vi(i).length===0)return null;[r,i,Ls(i),`${Ls(i)}[]`].forEach(o=>{o&&n.add(o)});for(let o of Is(t))if(yy(o).some(a=>n.has(a)))return o;return null}function e0(t){let e=new Set,n=(t instanceof Document,t);return t instanceof HTMLElement&&dy(t)&&e.add(t),n.querySelectorAll("input, select, textarea, [data-field-name], [data-field-path]").forEach(i=>{dy(i)&&e.add(i)}),zs(n).forEach(i=>e.add(i)),Array.from(e)}function dy(t){return t instanceof HTMLInputElement?t.type==="hidden"?t.hasAttribute("data-relationship-hidden")||t.hasAttribute("data-relationship-json")?!1:!!(t.dataset.fieldName||t.dataset.fieldPath):!0:t instanceof HTMLSelectElement||t instanceof HTMLTextAreaElement}function uy(t){let e=new Map;if(!t)return e;let n=new Map;return gy(t,n,""),n.forEach((r,i)=>{t0(e,i,r)}),e}function gy(t,e,n){Object.entries(t).forEach(([r,i])=>{let o=r.trim();if(!o)return;let s=n?`${n}.${o}`:o;if(vi(s).length!==0){if(i&&typeof i=="object"&&!Array.isArray(i)){gy(i,e,s);return}e.set(s,i)}})}function t0(t,e,n){let r=new Set,i=e.trim();if(!i)return;let o=tn(i),s=Cr(o),a=Ls(s);[i,o,s,a].forEach(l=>{l&&r.add(l)}),Array.isArray(n)&&[i,o,s,a].forEach(l=>{l&&r.add(`${l}[]`)}),r.forEach(l=>{l&&t.set(l,n)})}function tn(t){return t.endsWith("[]")?t.slice(0,-2):t}function Cr(t){return t.includes("[")?t.replace(/\[\]/g,"").replace(/\[([^\]]+)\]/g,".$1").replace(/^\./,""):t.replace(/\[\]/g,"")}var n0=new Set(["__proto__","constructor","prototype"]);function vi(t){let n=Cr(tn(t)).split(".").map(r=>r.trim()).filter(Boolean);return n.length===0||n.some(r=>n0.has(r))?[]:n}function Ls(t){if(!t.includes("."))return t;let e=t.split(".").filter(Boolean);if(e.length===0)return t;let[n,...r]=e;return`${n}${r.map(i=>`[${i}]`).join("")}`}function yy(t){let e=new Set;return[t.getAttribute("name"),t.getAttribute(Tr),t.dataset.fieldName,t.getAttribute("data-field-path"),t.id].forEach(r=>{if(!r)return;let i=Cr(tn(r));vi(i).length!==0&&(e.add(r),e.add(tn(r)),e.add(i),e.add(tn(i)),e.add(Ls(i)))}),Array.from(e).filter(Boolean)}function by(t,e){let n=yy(e);for(let r of n)if(t.has(r))return{found:!0,value:t.get(r)};return{found:!1,value:void 0}}function r0(t,e,n){var s;let r=by(e,t);if(!r.found)return;let i=l0(r.value,t);if(t instanceof HTMLSelectElement){let a=Array.isArray(i)?i:i!=null?[i]:[],l=hy(t,a.map(d=>({value:d,label:d}))),c=t.getAttribute("data-relationship-submit-mode")==="json"||t.dataset.endpointSubmitAs==="json"?"json":void 0;l&&(my(t,c),fe(t,{kind:"selection",origin:"hydrate",selectedValues:Array.from(t.selectedOptions).map(d=>d.value).filter(d=>d!=="")}),t.dispatchEvent(new Event("change",{bubbles:!0})))}else t instanceof HTMLInputElement?i0(t,r.value,i):t instanceof HTMLTextAreaElement&&(Array.isArray(i)?t.value=(s=i[0])!=null?s:"":i==null?t.value="":t.value=i,t.dispatchEvent(new Event("input",{bubbles:!0})));c0(t,i);let o=n==null?void 0:n.get(t);o&&o.setCurrentValue(i)}function i0(t,e,n){var i;let r=t.type.toLowerCase();if(r==="checkbox"){t.checked=o0(t,e,n),t.dispatchEvent(new Event("input",{bubbles:!0})),t.dispatchEvent(new Event("change",{bubbles:!0}));return}if(r==="radio"){t.checked=s0(t,e,n),t.dispatchEvent(new Event("change",{bubbles:!0}));return}Array.isArray(n)?t.value=(i=n[0])!=null?i:"":n==null?t.value="":t.value=n,t.dispatchEvent(new Event("input",{bubbles:!0}))}function o0(t,e,n){if(Array.isArray(e))return e.map(Ns).includes(t.value);if(Array.isArray(n))return n.includes(t.value);if(typeof e=="boolean")return e;if(typeof e=="number")return e!==0;if(typeof e=="string"){let r=e.trim().toLowerCase();if(["true","1","yes","on"].includes(r))return!0;if(["false","0","no","off",""].includes(r))return!1}return n!=null&&n===t.value}function s0(t,e,n){return Array.isArray(e)?e.map(Ns).includes(t.value):Array.isArray(n)?n.includes(t.value):n!=null&&n===t.value}function a0(t,e,n){let r=by(e,t);if(!r.found)return;let i=u0(r.value),o=n==null?void 0:n.get(t);if(i.length===0){Me(t),t.removeAttribute("data-validation-state"),t.removeAttribute("data-validation-message"),o==null||o.setServerValidation(void 0);return}let s=i[0];ze(t,s),t.setAttribute("data-validation-state","invalid"),t.setAttribute("data-validation-message",i.join("; ")),o==null||o.setServerValidation({valid:!1,messages:i,errors:i.map(a=>({code:"server",message:a}))})}function l0(t,e){var r;if(t==null)return null;if(Array.isArray(t)){let i=t.map(o=>Ns(o)).filter(o=>typeof o=="string"&&o!=="");return e instanceof HTMLSelectElement&&e.multiple?i:(r=i[0])!=null?r:null}let n=Ns(t);return n!=null?n:null}function Ns(t){if(t==null)return null;if(typeof t=="object"){let e=t;for(let n of["value","id","slug"]){let r=e[n];if(r!=null)return String(r)}return null}return String(t)}function c0(t,e){if(e==null||Array.isArray(e)&&e.length===0){t.removeAttribute("data-relationship-current");return}let n=d0(e);n&&t.setAttribute("data-relationship-current",n)}function d0(t){if(t==null)return;if(Array.isArray(t)){if(t.length===0)return;try{return JSON.stringify(t)}catch{return}}return String(t).trim()||void 0}function u0(t){if(Array.isArray(t))return t.map(e=>typeof e=="string"?e.trim():"").filter(e=>e.length>0);if(typeof t=="string"){let e=t.trim();return e.includes(";")?e.split(";").map(n=>n.trim()).filter(n=>n.length>0):e?[e]:[]}return[]}function p0(t,e,n,r,i){var c,d;if(!e.refreshOn||e.refreshOn.length===0||e.refreshMode==="manual"||t.dataset.relationshipRefreshBound==="true")return;t.dataset.relationshipRefreshBound="true";let o=t.closest("form"),s=(d=(c=o!=null?o:n)!=null?c:t.ownerDocument)!=null?d:document,a=()=>{r.resolve(t).catch(()=>{})},l=[];e.refreshOn.forEach(u=>{g0(s,u).forEach(f=>{let h=f instanceof HTMLInputElement&&f.type==="text"?"input":"change";f.addEventListener(h,a),l.push({target:f,eventType:h})})}),i.cleanups.add(()=>{for(let u of l)u.target.removeEventListener(u.eventType,a);delete t.dataset.relationshipRefreshBound})}function f0(t,e,n,r){var s;let i=(s=e.refreshIntervalMs)!=null?s:0;if(!(i>0)||e.mode==="search"||t.dataset.relationshipIntervalBound==="true")return;t.dataset.relationshipIntervalBound="true";let o=setInterval(()=>{var l;if(!t.isConnected){clearInterval(o);return}((l=t.ownerDocument)!=null?l:document).visibilityState==="hidden"||t.getAttribute("data-state")==="loading"||n.refresh(t).catch(()=>{})},i);r.cleanups.add(()=>{clearInterval(o),delete t.dataset.relationshipIntervalBound})}function h0(t,e,n,r,i){var p,f,h,m;if(e.refreshMode!=="manual")return;let o=(p=e.name)!=null?p:t.getAttribute("id");if(!o||t.dataset.relationshipManualBound==="true")return;t.dataset.relationshipManualBound="true";let s=b0(o),a=(h=(f=n.ownerDocument)!=null?f:t.ownerDocument)!=null?h:document,l=new Set;[`[data-endpoint-refresh-target="${s}"]`,`[data-endpoint-refresh-for="${s}"]`].forEach(g=>{a.querySelectorAll(g).forEach(y=>l.add(y))});let d=(m=t.closest("[data-relationship-type]"))!=null?m:t.parentElement;d==null||d.querySelectorAll("[data-endpoint-refresh-trigger]").forEach(g=>l.add(g));let u=[];l.forEach(g=>{if(g.dataset.relationshipRefreshListener==="true")return;g.dataset.relationshipRefreshListener="true";let y=x=>{x.preventDefault(),r.resolve(t).catch(()=>{})};g.addEventListener("click",y),u.push({trigger:g,handler:y})}),i.cleanups.add(()=>{for(let g of u)g.trigger.removeEventListener("click",g.handler),delete g.trigger.dataset.relationshipRefreshListener;delete t.dataset.relationshipManualBound})}function m0(t,e,n,r){var u,p;if(e.mode!=="search"||t.dataset.relationshipSearchBound==="true")return;t.dataset.relationshipSearchBound="true";let i=n.getConfig(),o=(u=e.throttleMs)!=null?u:i.searchThrottleMs,s=(p=e.debounceMs)!=null?p:i.searchDebounceMs,l=Kd(()=>{n.resolve(t).catch(()=>{})},s);l=qd(l,o);let c=()=>{if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement){let f=t.value.trim();t.setAttribute("data-endpoint-search-value",f)}},d=()=>{c(),l()};t.addEventListener("input",d),(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement)&&t.addEventListener("change",d),r.cleanups.add(()=>{t.removeEventListener("input",d),(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement)&&t.removeEventListener("change",d),l.cancel(),delete t.dataset.relationshipSearchBound})}function g0(t,e){var a;let n=[],r=new Set,i=t instanceof HTMLFormElement?t:t instanceof HTMLElement?t.closest("form"):null,o=t instanceof Document?t:(a=t.ownerDocument)!=null?a:document,s=[];return i?Array.from(i.elements).forEach(c=>{c instanceof HTMLElement&&s.push(c)}):s.push(...Array.from(o.querySelectorAll("[name], [data-field-name], [id]"))),s.forEach(l=>{if(r.has(l))return;let c=l.dataset.fieldName;if(c&&c===e){n.push(l),r.add(l);return}let d=l.name;if(y0(d,e)){n.push(l),r.add(l);return}let u=l.id;u&&u===e&&(n.push(l),r.add(l))}),n}function y0(t,e){if(!t)return!1;if(t===e||t===`${e}[]`||t.endsWith(`[${e}]`)||t.endsWith(`[${e}][]`))return!0;let n=t.replace(/[^a-z0-9]/gi,"").toLowerCase(),r=e.replace(/[^a-z0-9]/gi,"").toLowerCase();return n.endsWith(r)}function b0(t){return typeof CSS!="undefined"&&typeof CSS.escape=="function"?CSS.escape(t):t.replace(/(["\\])/g,"\\$1")}var x0="fg-",xy=new Set(["GET","POST"]);async function ud(t,e,n={}){var p,f,h,m,g;let r=C0(t),i=E0(e),o=v0(i,n);r.replaceChildren(o);let s=await py(o,(p=n.runtime)!=null?p:{}),a=ld(o,{registry:s}),l={...(f=i.values)!=null?f:{},...(h=n.values)!=null?h:{}};Object.keys(l).length>0&&a.setValues(l);let c={...(m=i.errors)!=null?m:{},...(g=n.errors)!=null?g:{}};Object.keys(c).length>0&&a.setErrors(c);let d=()=>R0(o),u=y=>{if(!d()){y.preventDefault();return}n.onSubmit&&(y.preventDefault(),n.onSubmit(a.getValues({includeHidden:!0}),y))};return o.addEventListener("submit",u),{element:o,controller:a,registry:s,validate:d,destroy(){o.removeEventListener("submit",u),a.destroy(),o.remove()}}}async function Ey(t,e,n={}){var o,s;let r=await fetch(e,{...n.request,headers:{Accept:"application/json",...(s=(o=n.request)==null?void 0:o.headers)!=null?s:{}}});if(!r.ok)throw new Error(`formgen: failed to load form model from ${e} (${r.status})`);let i=await r.json();return ud(t,i,n)}function C0(t){if(typeof t!="string")return t;let e=document.querySelector(t);if(!e)throw new Error(`formgen: render target not found for selector ${t}`);return e}function E0(t){return t&&typeof t=="object"&&"form"in t&&t.form?t:{form:t}}function v0(t,e){var d,u,p,f,h,m;let n=t.form,r=document.createElement("form"),i=(n.method||"POST").toUpperCase();r.method=xy.has(i)?i.toLowerCase():"post",r.action=(d=n.endpoint)!=null?d:"",r.noValidate=!0,r.dataset.formgenRenderMode="client",n.operationId&&(r.dataset.formgenOperation=n.operationId),xy.has(i)||r.append(Cy("_method",i));for(let g of(u=t.hiddenFields)!=null?u:[])r.append(Cy(g.name,g.value));let o=(p=n.uiHints)==null?void 0:p["layout.title"],s=(f=n.uiHints)==null?void 0:f["layout.subtitle"];if(o||s){let g=document.createElement("header");g.className="formgen-header",o&&g.append(Bn("h1",o)),s&&g.append(Bn("p",s)),r.append(g)}if(t.formErrors&&t.formErrors.length>0){let g=document.createElement("div");g.className="formgen-errors",g.setAttribute("role","alert");let y=document.createElement("ul");t.formErrors.forEach(x=>y.append(Bn("li",x))),g.append(y),r.append(g)}let a=document.createElement("div");a.className="formgen-grid";for(let g of(h=n.fields)!=null?h:[]){let y=vy(g,"");y&&a.append(y)}r.append(a);let l=document.createElement("div");l.className="formgen-actions";let c=Bn("button",(m=e.submitLabel)!=null?m:"Submit");return c.setAttribute("type","submit"),l.append(c),r.append(l),r}function vy(t,e){let n=e?`${e}.${t.name}`:t.name,r=L0(n);if(t.type==="object"&&t.nested&&t.nested.length>0&&!t.relationship){let s=document.createElement("fieldset");s.dataset.formgenField=n,s.append(Bn("legend",t.label||t.name));for(let a of t.nested){let l=vy(a,n);l&&s.append(l)}return s}let i=T0(t,n,r);if(!i)return null;let o=document.createElement("div");if(o.dataset.formgenField=n,t.type==="boolean"){let s=document.createElement("label");s.htmlFor=r,s.append(i,document.createTextNode(` ${t.label||t.name}`)),o.append(s)}else{let s=Bn("label",t.label||t.name);s.htmlFor=r,o.append(s,i)}if(t.description){let s=Bn("p",t.description);s.id=`${r}-description`,i.setAttribute("aria-describedby",s.id),o.append(s)}return o}function T0(t,e,n){var s,a,l,c;let r=S0(t),i=t.type==="array",o;if(t.relationship||r.length>0){let d=document.createElement("select");d.multiple=i||((s=t.relationship)==null?void 0:s.cardinality)==="many",d.multiple||d.append(new Option((a=t.placeholder)!=null?a:"",""));for(let u of r){let p=new Option((l=u.label)!=null?l:String(u.value),String(u.value));p.disabled=!!u.disabled,d.append(p)}o=d}else{if(i)return null;if(k0(t))o=document.createElement("textarea");else{let d=document.createElement("input");d.type=M0(t),t.type==="boolean"?(d.value="true",d.checked=t.default===!0||t.default==="true"):t.default!=null&&typeof t.default!="object"&&(d.value=String(t.default)),t.type==="integer"&&(d.step="1"),o=d}}return o.id=n,o.name=e,t.placeholder&&!(o instanceof HTMLSelectElement)&&(o.placeholder=t.placeholder),o.required=!!t.required,o.disabled=!!t.disabled,t.readonly&&!(o instanceof HTMLSelectElement)&&(o.readOnly=!0),w0(o,(c=t.validations)!=null?c:[]),A0(o,t),o}function S0(t){var n,r,i;return t.options&&t.options.length>0?t.options:((i=(r=t.enum)!=null?r:(n=t.items)==null?void 0:n.enum)!=null?i:[]).map(o=>({value:o,label:String(o)}))}function k0(t){var n,r,i;return[t.format,(n=t.uiHints)==null?void 0:n.input,(r=t.uiHints)==null?void 0:r.widget,(i=t.metadata)==null?void 0:i.widget].some(o=>o==="textarea")}function M0(t){var e;switch(t.type){case"boolean":return"checkbox";case"integer":case"number":return"number"}switch(t.format){case"email":return"email";case"uri":case"url":return"url";case"date":return"date";case"date-time":return"datetime-local";case"time":return"time";case"password":return"password"}return((e=t.uiHints)==null?void 0:e.inputType)||"text"}function w0(t,e){var n;for(let r of e){let i=(n=r.params)==null?void 0:n.value;if(i!=null)switch(r.kind){case"min":case"max":t.setAttribute(r.kind,i);break;case"minLength":case"maxLength":t.setAttribute(r.kind.toLowerCase(),i);break;case"pattern":t.setAttribute("pattern",i);break}}}function A0(t,e){var r;let n=e.relationship;n&&(tt(t,"data-relationship-type",n.kind),tt(t,"data-relationship-target",n.target),tt(t,"data-relationship-foreign-key",n.foreignKey),tt(t,"data-relationship-cardinality",n.cardinality),tt(t,"data-relationship-inverse",n.inverse));for(let[i,o]of Object.entries((r=e.metadata)!=null?r:{}))i.startsWith("relationship.endpoint.auth.")?tt(t,`data-auth-${dd(i.slice(27))}`,o):i.startsWith("relationship.endpoint.")?tt(t,`data-endpoint-${dd(i.slice(22))}`,o):i==="relationship.current"?tt(t,"data-relationship-current",o):i.startsWith("validation.")&&tt(t,`data-validation-${dd(i.slice(11))}`,o);e.validations&&e.validations.length>0&&tt(t,"data-validation-rules",JSON.stringify(e.validations)),e.required&&tt(t,"data-validation-required","true"),tt(t,"data-validation-label",e.label)}function R0(t){let e=!0;return t.querySelectorAll("input[name]:not([type=hidden]), select[name], textarea[name]").forEach(r=>{var s,a;if(r.disabled)return;let i=Pt(r,It(r)),o=Li(i,O0(r));if(o.valid){Me(r);return}e=!1,ze(r,(s=o.messages[0])!=null?s:null,(a=o.errors[0])==null?void 0:a.code)}),e}function O0(t){return t instanceof HTMLSelectElement&&t.multiple?Array.from(t.selectedOptions).map(e=>e.value):t instanceof HTMLInputElement&&t.type==="checkbox"?t.checked?t.value:null:t.value===""?null:t.value}function L0(t){return x0+t.replace(/[^A-Za-z0-9_-]+/g,"-")}function dd(t){let e="";for(let n of t)n==="."?e+="-":n!==n.toLowerCase()?e+=(e&&!e.endsWith("-")?"-":"")+n.toLowerCase():e+=n;return e}function tt(t,e,n){n!=null&&n!==""&&t.setAttribute(e,n)}function Cy(t,e){let n=document.createElement("input");return n.type="hidden",n.name=t,n.value=e,n}function Bn(t,e){let n=document.createElement(t);return n.textContent=e,n}var Ty={render:ud,load:Ey};typeof globalThis!="undefined"&&(globalThis.FormgenClient=Ty);return Oy(N0);})();
+//# sourceMappingURL=formgen-client.min.js.map
@@ -0,0 +1,6 @@
+/**
+ * formgen relationship runtime
+ * DO NOT EDIT: generated via `npm run build`
+ */
+"use strict";var FormgenValidate=(()=>{var o=Object.defineProperty;var T=Object.getOwnPropertyDescriptor;var A=Object.getOwnPropertyNames;var M=Object.prototype.hasOwnProperty;var y=(e,t)=>{for(var r in t)o(e,r,{get:t[r],enumerable:!0})},H=(e,t,r,n)=>{if(t&&typeof t=="object"||typeof t=="function")for(let i of A(t))!M.call(e,i)&&i!==r&&o(e,i,{get:()=>t[i],enumerable:!(n=T(t,i))||n.enumerable});return e};var R=e=>H(o({},"__esModule",{value:!0}),e);var w={};y(w,{initValidation:()=>u,validateField:()=>s,validateForm:()=>h,validateValue:()=>v});var F="[data-relationship-type]",E="data-relationship-error",l="inline",d=new Map;d.set(l,p);function m(e,t,r){var a,f;let n=e.dataset.validationRenderer||l;((f=(a=d.get(n))!=null?a:d.get(l))!=null?f:p)({element:e,message:t,code:r})}function g(e){m(e,null)}function p(e){var i,a;let t=(a=(i=e.element.closest(F))!=null?i:e.element.parentElement)!=null?a:e.element;if(!t)return;let r=t;t.classList.contains("relative")&&t.parentElement&&(r=t.parentElement);let n=r.querySelector(`[${E}]`);n||(n=document.createElement("p"),n.setAttribute(E,"true"),n.className="formgen-error text-xs text-red-600 mt-2 dark:text-red-400",n.setAttribute("role","status"),n.setAttribute("aria-live","polite"),n.setAttribute("aria-atomic","true"),t.classList.contains("relative")&&t.parentElement?t.parentElement.insertBefore(n,t.nextSibling):r.appendChild(n)),e.message&&e.message.trim()!==""?(n.textContent=e.message,n.removeAttribute("aria-hidden"),I(e.element,e.message)):(n.textContent="",n.setAttribute("aria-hidden","true"),S(e.element))}function I(e,t){e.setAttribute("aria-invalid","true"),e.setAttribute("data-validation-state","invalid"),e.setAttribute("data-validation-message",t),L(e,!0)}function S(e){e.removeAttribute("aria-invalid"),e.removeAttribute("data-validation-state"),e.removeAttribute("data-validation-message"),L(e,!1)}function L(e,t){let r=e;if(e instanceof HTMLInputElement||e instanceof HTMLTextAreaElement||e instanceof HTMLSelectElement||(r=e.querySelector("input, textarea, select")),!r)return;let n=["border-red-500","focus:border-red-500","focus:ring-red-500","dark:border-red-500"],i=["border-gray-200","focus:border-blue-500","focus:ring-blue-500","dark:border-gray-700","dark:focus:ring-gray-600"];t?(i.forEach(a=>r.classList.remove(a)),n.forEach(a=>r.classList.add(a))):(n.forEach(a=>r.classList.remove(a)),i.forEach(a=>r.classList.add(a)))}var x="[data-validate]",b="data-validate-bound";function v(e,t){let r=e.label||"This field",n=Array.isArray(t)?t.filter(a=>a!==""):[];if(Array.isArray(t)?n.length===0:t.trim()==="")return e.required?{code:"required",message:`${r} is required.`}:null;if(Array.isArray(t))return e.minItems!=null&&n.length<e.minItems?{code:"minItems",message:`Select at least ${e.minItems} ${r.toLowerCase()}.`}:e.maxItems!=null&&n.length>e.maxItems?{code:"maxItems",message:`Select at most ${e.maxItems} ${r.toLowerCase()}.`}:null;if(e.minLength!=null&&t.length<e.minLength)return{code:"minLength",message:`${r} must be at least ${e.minLength} characters.`};if(e.maxLength!=null&&t.length>e.maxLength)return{code:"maxLength",message:`${r} must be at most ${e.maxLength} characters.`};if(e.min!=null||e.max!=null){let a=Number(t);if(!Number.isNaN(a)){if(e.min!=null&&(e.exclusiveMin?a<=e.min:a<e.min))return{code:"min",message:`${r} must be ${e.exclusiveMin?"greater than":"at least"} ${e.min}.`};if(e.max!=null&&(e.exclusiveMax?a>=e.max:a>e.max))return{code:"max",message:`${r} must be ${e.exclusiveMax?"less than":"at most"} ${e.max}.`}}}return e.pattern&&!k(e.pattern,t)?{code:"pattern",message:`${r} has an invalid format.`}:null}function s(e){let t=q(e);if(!t)return!0;let r=v(t,N(e));return r?(m(e,r.message,r.code),!1):(g(e),!0)}function h(e){let t=null;return $(e).forEach(r=>{!s(r)&&!t&&(t=r)}),t?(t.focus(),!1):!0}function u(e=document){let t=new Set;e instanceof HTMLFormElement&&t.add(e),e.querySelectorAll(x).forEach(r=>{let n=r.closest("form");n&&t.add(n)}),t.forEach(C)}function C(e){e.hasAttribute(b)||(e.setAttribute(b,"true"),e.addEventListener("focusout",t=>{let r=t.target;c(r)&&r.hasAttribute("data-validate")&&s(r)}),e.addEventListener("change",t=>{let r=t.target;c(r)&&r.hasAttribute("data-validate")&&r.getAttribute("data-validation-state")==="invalid"&&s(r)}),e.addEventListener("submit",t=>{h(e)||(t.preventDefault(),t.stopImmediatePropagation())}))}function $(e){return Array.from(e.querySelectorAll(x)).filter(c)}function q(e){let t=e.getAttribute("data-validate");if(!t)return null;try{let r=JSON.parse(t);return r&&typeof r=="object"?r:null}catch{return null}}function N(e){var t;if(e instanceof HTMLSelectElement&&e.multiple)return Array.from(e.selectedOptions).map(r=>r.value);if(e instanceof HTMLInputElement){let r=e.type.toLowerCase();if(r==="checkbox"||r==="radio"){let n=e.form;return n&&e.name?Array.from(n.querySelectorAll(`input[name="${V(e.name)}"]`)).filter(a=>a.checked).map(a=>a.value):e.checked?[e.value]:[]}if(r==="file")return Array.from((t=e.files)!=null?t:[]).map(n=>n.name)}return e.value}function k(e,t){try{return new RegExp(e,"u").test(t)}catch{return!0}}function c(e){return e instanceof HTMLInputElement||e instanceof HTMLSelectElement||e instanceof HTMLTextAreaElement}function V(e){return typeof CSS!="undefined"&&typeof CSS.escape=="function"?CSS.escape(e):e.replace(/["\\]/g,"\\$&")}typeof document!="undefined"&&(document.readyState==="loading"?document.addEventListener("DOMContentLoaded",()=>u()):u());return R(w);})();
+//# sourceMappingURL=formgen-validate.min.js.map
@@ -2,25 +2,31 @@
  * formgen relationship runtime
  * DO NOT EDIT: generated via `npm run build`
  */
-"use strict";var FormgenRelationshipsPreact=(()=>{var Bi=Object.defineProperty;var xf=Object.getOwnPropertyDescriptor;var Cf=Object.getOwnPropertyNames;var Ef=Object.prototype.hasOwnProperty;var Sf=(t,e)=>{for(var n in e)Bi(t,n,{get:e[n],enumerable:!0})},wf=(t,e,n,r)=>{if(e&&typeof e=="object"||typeof e=="function")for(let i of Cf(e))!Ef.call(t,i)&&i!==n&&Bi(t,i,{get:()=>e[i],enumerable:!(r=xf(e,i))||r.enumerable});return t};var Mf=t=>wf(Bi({},"__esModule",{value:!0}),t);var o0={};Sf(o0,{useRelationshipOptions:()=>i0});var Tl,F,Al,Tf,at,El,Ol,Rl,Nl,Wi,Vi,$i,Af,ji={},Ll=[],Of=/acit|ex(?:s|g|n|p|$)|rph|grid|ows|mnc|ntw|ine[ch]|zoo|^ord|itera/i,Qn=Array.isArray;function Pe(t,e){for(var n in e)t[n]=e[n];return t}function qi(t){t&&t.parentNode&&t.parentNode.removeChild(t)}function zi(t,e,n,r,i){var o={type:t,props:e,key:n,ref:r,__k:null,__:null,__b:0,__e:null,__c:null,constructor:void 0,__v:i==null?++Al:i,__i:-1,__u:0};return i==null&&F.vnode!=null&&F.vnode(o),o}function Ki(t){return t.children}function Gn(t,e){this.props=t,this.context=e}function Dt(t,e){if(e==null)return t.__?Dt(t.__,t.__i+1):null;for(var n;e<t.__k.length;e++)if((n=t.__k[e])!=null&&n.__e!=null)return n.__e;return typeof t.type=="function"?Dt(t):null}function _l(t){var e,n;if((t=t.__)!=null&&t.__c!=null){for(t.__e=t.__c.base=null,e=0;e<t.__k.length;e++)if((n=t.__k[e])!=null&&n.__e!=null){t.__e=t.__c.base=n.__e;break}return _l(t)}}function Sl(t){(!t.__d&&(t.__d=!0)&&at.push(t)&&!Yn.__r++||El!=F.debounceRendering)&&((El=F.debounceRendering)||Ol)(Yn)}function Yn(){for(var t,e,n,r,i,o,s,l=1;at.length;)at.length>l&&at.sort(Rl),t=at.shift(),l=at.length,t.__d&&(n=void 0,r=void 0,i=(r=(e=t).__v).__e,o=[],s=[],e.__P&&((n=Pe({},r)).__v=r.__v+1,F.vnode&&F.vnode(n),Pl(e.__P,n,r,e.__n,e.__P.namespaceURI,32&r.__u?[i]:null,o,i==null?Dt(r):i,!!(32&r.__u),s),n.__v=r.__v,n.__.__k[n.__i]=n,Lf(o,n,s),r.__e=r.__=null,n.__e!=i&&_l(n)));Yn.__r=0}function Il(t,e,n,r,i,o,s,l,a,c,d){var u,f,h,p,m,g,y,k=r&&r.__k||Ll,E=e.length;for(a=Rf(n,e,k,a,E),u=0;u<E;u++)(h=n.__k[u])!=null&&(f=h.__i==-1?ji:k[h.__i]||ji,h.__i=u,g=Pl(t,h,f,i,o,s,l,a,c,d),p=h.__e,h.ref&&f.ref!=h.ref&&(f.ref&&Ji(f.ref,null,h),d.push(h.ref,h.__c||p,h)),m==null&&p!=null&&(m=p),(y=!!(4&h.__u))||f.__k===h.__k?a=Dl(h,a,t,y):typeof h.type=="function"&&g!==void 0?a=g:p&&(a=p.nextSibling),h.__u&=-7);return n.__e=m,a}function Rf(t,e,n,r,i){var o,s,l,a,c,d=n.length,u=d,f=0;for(t.__k=new Array(i),o=0;o<i;o++)(s=e[o])!=null&&typeof s!="boolean"&&typeof s!="function"?(a=o+f,(s=t.__k[o]=typeof s=="string"||typeof s=="number"||typeof s=="bigint"||s.constructor==String?zi(null,s,null,null,null):Qn(s)?zi(Ki,{children:s},null,null,null):s.constructor==null&&s.__b>0?zi(s.type,s.props,s.key,s.ref?s.ref:null,s.__v):s).__=t,s.__b=t.__b+1,l=null,(c=s.__i=Nf(s,n,a,u))!=-1&&(u--,(l=n[c])&&(l.__u|=2)),l==null||l.__v==null?(c==-1&&(i>d?f--:i<d&&f++),typeof s.type!="function"&&(s.__u|=4)):c!=a&&(c==a-1?f--:c==a+1?f++:(c>a?f--:f++,s.__u|=4))):t.__k[o]=null;if(u)for(o=0;o<d;o++)(l=n[o])!=null&&!(2&l.__u)&&(l.__e==r&&(r=Dt(l)),Fl(l,l));return r}function Dl(t,e,n,r){var i,o;if(typeof t.type=="function"){for(i=t.__k,o=0;i&&o<i.length;o++)i[o]&&(i[o].__=t,e=Dl(i[o],e,n,r));return e}t.__e!=e&&(r&&(e&&t.type&&!e.parentNode&&(e=Dt(t)),n.insertBefore(t.__e,e||null)),e=t.__e);do e=e&&e.nextSibling;while(e!=null&&e.nodeType==8);return e}function Nf(t,e,n,r){var i,o,s,l=t.key,a=t.type,c=e[n],d=c!=null&&(2&c.__u)==0;if(c===null&&t.key==null||d&&l==c.key&&a==c.type)return n;if(r>(d?1:0)){for(i=n-1,o=n+1;i>=0||o<e.length;)if((c=e[s=i>=0?i--:o++])!=null&&!(2&c.__u)&&l==c.key&&a==c.type)return s}return-1}function wl(t,e,n){e[0]=="-"?t.setProperty(e,n==null?"":n):t[e]=n==null?"":typeof n!="number"||Of.test(e)?n:n+"px"}function Jn(t,e,n,r,i){var o,s;e:if(e=="style")if(typeof n=="string")t.style.cssText=n;else{if(typeof r=="string"&&(t.style.cssText=r=""),r)for(e in r)n&&e in n||wl(t.style,e,"");if(n)for(e in n)r&&n[e]==r[e]||wl(t.style,e,n[e])}else if(e[0]=="o"&&e[1]=="n")o=e!=(e=e.replace(Nl,"$1")),s=e.toLowerCase(),e=s in t||e=="onFocusOut"||e=="onFocusIn"?s.slice(2):e.slice(2),t.l||(t.l={}),t.l[e+o]=n,n?r?n.u=r.u:(n.u=Wi,t.addEventListener(e,o?$i:Vi,o)):t.removeEventListener(e,o?$i:Vi,o);else{if(i=="http://www.w3.org/2000/svg")e=e.replace(/xlink(H|:h)/,"h").replace(/sName$/,"s");else if(e!="width"&&e!="height"&&e!="href"&&e!="list"&&e!="form"&&e!="tabIndex"&&e!="download"&&e!="rowSpan"&&e!="colSpan"&&e!="role"&&e!="popover"&&e in t)try{t[e]=n==null?"":n;break e}catch{}typeof n=="function"||(n==null||n===!1&&e[4]!="-"?t.removeAttribute(e):t.setAttribute(e,e=="popover"&&n==1?"":n))}}function Ml(t){return function(e){if(this.l){var n=this.l[e.type+t];if(e.t==null)e.t=Wi++;else if(e.t<n.u)return;return n(F.event?F.event(e):e)}}}function Pl(t,e,n,r,i,o,s,l,a,c){var d,u,f,h,p,m,g,y,k,E,O,T,I,w,R,H,De,me=e.type;if(e.constructor!=null)return null;128&n.__u&&(a=!!(32&n.__u),o=[l=e.__e=n.__e]),(d=F.__b)&&d(e);e:if(typeof me=="function")try{if(y=e.props,k="prototype"in me&&me.prototype.render,E=(d=me.contextType)&&r[d.__c],O=d?E?E.props.value:d.__:r,n.__c?g=(u=e.__c=n.__c).__=u.__E:(k?e.__c=u=new me(y,O):(e.__c=u=new Gn(y,O),u.constructor=me,u.render=If),E&&E.sub(u),u.props=y,u.state||(u.state={}),u.context=O,u.__n=r,f=u.__d=!0,u.__h=[],u._sb=[]),k&&u.__s==null&&(u.__s=u.state),k&&me.getDerivedStateFromProps!=null&&(u.__s==u.state&&(u.__s=Pe({},u.__s)),Pe(u.__s,me.getDerivedStateFromProps(y,u.__s))),h=u.props,p=u.state,u.__v=e,f)k&&me.getDerivedStateFromProps==null&&u.componentWillMount!=null&&u.componentWillMount(),k&&u.componentDidMount!=null&&u.__h.push(u.componentDidMount);else{if(k&&me.getDerivedStateFromProps==null&&y!==h&&u.componentWillReceiveProps!=null&&u.componentWillReceiveProps(y,O),!u.__e&&u.shouldComponentUpdate!=null&&u.shouldComponentUpdate(y,u.__s,O)===!1||e.__v==n.__v){for(e.__v!=n.__v&&(u.props=y,u.state=u.__s,u.__d=!1),e.__e=n.__e,e.__k=n.__k,e.__k.some(function(Te){Te&&(Te.__=e)}),T=0;T<u._sb.length;T++)u.__h.push(u._sb[T]);u._sb=[],u.__h.length&&s.push(u);break e}u.componentWillUpdate!=null&&u.componentWillUpdate(y,u.__s,O),k&&u.componentDidUpdate!=null&&u.__h.push(function(){u.componentDidUpdate(h,p,m)})}if(u.context=O,u.props=y,u.__P=t,u.__e=!1,I=F.__r,w=0,k){for(u.state=u.__s,u.__d=!1,I&&I(e),d=u.render(u.props,u.state,u.context),R=0;R<u._sb.length;R++)u.__h.push(u._sb[R]);u._sb=[]}else do u.__d=!1,I&&I(e),d=u.render(u.props,u.state,u.context),u.state=u.__s;while(u.__d&&++w<25);u.state=u.__s,u.getChildContext!=null&&(r=Pe(Pe({},r),u.getChildContext())),k&&!f&&u.getSnapshotBeforeUpdate!=null&&(m=u.getSnapshotBeforeUpdate(h,p)),H=d,d!=null&&d.type===Ki&&d.key==null&&(H=Hl(d.props.children)),l=Il(t,Qn(H)?H:[H],e,n,r,i,o,s,l,a,c),u.base=e.__e,e.__u&=-161,u.__h.length&&s.push(u),g&&(u.__E=u.__=null)}catch(Te){if(e.__v=null,a||o!=null)if(Te.then){for(e.__u|=a?160:128;l&&l.nodeType==8&&l.nextSibling;)l=l.nextSibling;o[o.indexOf(l)]=null,e.__e=l}else{for(De=o.length;De--;)qi(o[De]);Ui(e)}else e.__e=n.__e,e.__k=n.__k,Te.then||Ui(e);F.__e(Te,e,n)}else o==null&&e.__v==n.__v?(e.__k=n.__k,e.__e=n.__e):l=e.__e=_f(n.__e,e,n,r,i,o,s,a,c);return(d=F.diffed)&&d(e),128&e.__u?void 0:l}function Ui(t){t&&t.__c&&(t.__c.__e=!0),t&&t.__k&&t.__k.forEach(Ui)}function Lf(t,e,n){for(var r=0;r<n.length;r++)Ji(n[r],n[++r],n[++r]);F.__c&&F.__c(e,t),t.some(function(i){try{t=i.__h,i.__h=[],t.some(function(o){o.call(i)})}catch(o){F.__e(o,i.__v)}})}function Hl(t){return typeof t!="object"||t==null||t.__b&&t.__b>0?t:Qn(t)?t.map(Hl):Pe({},t)}function _f(t,e,n,r,i,o,s,l,a){var c,d,u,f,h,p,m,g=n.props,y=e.props,k=e.type;if(k=="svg"?i="http://www.w3.org/2000/svg":k=="math"?i="http://www.w3.org/1998/Math/MathML":i||(i="http://www.w3.org/1999/xhtml"),o!=null){for(c=0;c<o.length;c++)if((h=o[c])&&"setAttribute"in h==!!k&&(k?h.localName==k:h.nodeType==3)){t=h,o[c]=null;break}}if(t==null){if(k==null)return document.createTextNode(y);t=document.createElementNS(i,k,y.is&&y),l&&(F.__m&&F.__m(e,o),l=!1),o=null}if(k==null)g===y||l&&t.data==y||(t.data=y);else{if(o=o&&Tl.call(t.childNodes),g=n.props||ji,!l&&o!=null)for(g={},c=0;c<t.attributes.length;c++)g[(h=t.attributes[c]).name]=h.value;for(c in g)if(h=g[c],c!="children"){if(c=="dangerouslySetInnerHTML")u=h;else if(!(c in y)){if(c=="value"&&"defaultValue"in y||c=="checked"&&"defaultChecked"in y)continue;Jn(t,c,null,h,i)}}for(c in y)h=y[c],c=="children"?f=h:c=="dangerouslySetInnerHTML"?d=h:c=="value"?p=h:c=="checked"?m=h:l&&typeof h!="function"||g[c]===h||Jn(t,c,h,g[c],i);if(d)l||u&&(d.__html==u.__html||d.__html==t.innerHTML)||(t.innerHTML=d.__html),e.__k=[];else if(u&&(t.innerHTML=""),Il(e.type=="template"?t.content:t,Qn(f)?f:[f],e,n,r,k=="foreignObject"?"http://www.w3.org/1999/xhtml":i,o,s,o?o[0]:n.__k&&Dt(n,0),l,a),o!=null)for(c=o.length;c--;)qi(o[c]);l||(c="value",k=="progress"&&p==null?t.removeAttribute("value"):p!=null&&(p!==t[c]||k=="progress"&&!p||k=="option"&&p!=g[c])&&Jn(t,c,p,g[c],i),c="checked",m!=null&&m!=t[c]&&Jn(t,c,m,g[c],i))}return t}function Ji(t,e,n){try{if(typeof t=="function"){var r=typeof t.__u=="function";r&&t.__u(),r&&e==null||(t.__u=t(e))}else t.current=e}catch(i){F.__e(i,n)}}function Fl(t,e,n){var r,i;if(F.unmount&&F.unmount(t),(r=t.ref)&&(r.current&&r.current!=t.__e||Ji(r,null,e)),(r=t.__c)!=null){if(r.componentWillUnmount)try{r.componentWillUnmount()}catch(o){F.__e(o,e)}r.base=r.__P=null}if(r=t.__k)for(i=0;i<r.length;i++)r[i]&&Fl(r[i],e,n||typeof t.type!="function");n||qi(t.__e),t.__c=t.__=t.__e=void 0}function If(t,e,n){return this.constructor(t,n)}Tl=Ll.slice,F={__e:function(t,e,n,r){for(var i,o,s;e=e.__;)if((i=e.__c)&&!i.__)try{if((o=i.constructor)&&o.getDerivedStateFromError!=null&&(i.setState(o.getDerivedStateFromError(t)),s=i.__d),i.componentDidCatch!=null&&(i.componentDidCatch(t,r||{}),s=i.__d),s)return i.__E=i}catch(l){t=l}throw t}},Al=0,Tf=function(t){return t!=null&&t.constructor==null},Gn.prototype.setState=function(t,e){var n;n=this.__s!=null&&this.__s!=this.state?this.__s:this.__s=Pe({},this.state),typeof t=="function"&&(t=t(Pe({},n),this.props)),t&&Pe(n,t),t!=null&&this.__v&&(e&&this._sb.push(e),Sl(this))},Gn.prototype.forceUpdate=function(t){this.__v&&(this.__e=!0,t&&this.__h.push(t),Sl(this))},Gn.prototype.render=Ki,at=[],Ol=typeof Promise=="function"?Promise.prototype.then.bind(Promise.resolve()):setTimeout,Rl=function(t,e){return t.__v.__b-e.__v.__b},Yn.__r=0,Nl=/(PointerCapture)$|Capture$/i,Wi=0,Vi=Ml(!1),$i=Ml(!0),Af=0;var Zn,$,Gi,Bl,Yi=0,Kl=[],U=F,zl=U.__b,Vl=U.__r,$l=U.diffed,jl=U.__c,Ul=U.unmount,Wl=U.__;function Jl(t,e){U.__h&&U.__h($,t,Yi||e),Yi=0;var n=$.__H||($.__H={__:[],__h:[]});return t>=n.__.length&&n.__.push({}),n.__[t]}function Xi(t){return Yi=1,Df(Gl,t)}function Df(t,e,n){var r=Jl(Zn++,2);if(r.t=t,!r.__c&&(r.__=[n?n(e):Gl(void 0,e),function(l){var a=r.__N?r.__N[0]:r.__[0],c=r.t(a,l);a!==c&&(r.__N=[c,r.__[1]],r.__c.setState({}))}],r.__c=$,!$.__f)){var i=function(l,a,c){if(!r.__c.__H)return!0;var d=r.__c.__H.__.filter(function(f){return!!f.__c});if(d.every(function(f){return!f.__N}))return!o||o.call(this,l,a,c);var u=r.__c.props!==l;return d.forEach(function(f){if(f.__N){var h=f.__[0];f.__=f.__N,f.__N=void 0,h!==f.__[0]&&(u=!0)}}),o&&o.call(this,l,a,c)||u};$.__f=!0;var o=$.shouldComponentUpdate,s=$.componentWillUpdate;$.componentWillUpdate=function(l,a,c){if(this.__e){var d=o;o=void 0,i(l,a,c),o=d}s&&s.call(this,l,a,c)},$.shouldComponentUpdate=i}return r.__N||r.__}function Zi(t,e){var n=Jl(Zn++,3);!U.__s&&Ff(n.__H,e)&&(n.__=t,n.u=e,$.__H.__h.push(n))}function Pf(){for(var t;t=Kl.shift();)if(t.__P&&t.__H)try{t.__H.__h.forEach(Xn),t.__H.__h.forEach(Qi),t.__H.__h=[]}catch(e){t.__H.__h=[],U.__e(e,t.__v)}}U.__b=function(t){$=null,zl&&zl(t)},U.__=function(t,e){t&&e.__k&&e.__k.__m&&(t.__m=e.__k.__m),Wl&&Wl(t,e)},U.__r=function(t){Vl&&Vl(t),Zn=0;var e=($=t.__c).__H;e&&(Gi===$?(e.__h=[],$.__h=[],e.__.forEach(function(n){n.__N&&(n.__=n.__N),n.u=n.__N=void 0})):(e.__h.forEach(Xn),e.__h.forEach(Qi),e.__h=[],Zn=0)),Gi=$},U.diffed=function(t){$l&&$l(t);var e=t.__c;e&&e.__H&&(e.__H.__h.length&&(Kl.push(e)!==1&&Bl===U.requestAnimationFrame||((Bl=U.requestAnimationFrame)||Hf)(Pf)),e.__H.__.forEach(function(n){n.u&&(n.__H=n.u),n.u=void 0})),Gi=$=null},U.__c=function(t,e){e.some(function(n){try{n.__h.forEach(Xn),n.__h=n.__h.filter(function(r){return!r.__||Qi(r)})}catch(r){e.some(function(i){i.__h&&(i.__h=[])}),e=[],U.__e(r,n.__v)}}),jl&&jl(t,e)},U.unmount=function(t){Ul&&Ul(t);var e,n=t.__c;n&&n.__H&&(n.__H.__.forEach(function(r){try{Xn(r)}catch(i){e=i}}),n.__H=void 0,e&&U.__e(e,n.__v))};var ql=typeof requestAnimationFrame=="function";function Hf(t){var e,n=function(){clearTimeout(r),ql&&cancelAnimationFrame(e),setTimeout(t)},r=setTimeout(n,35);ql&&(e=requestAnimationFrame(n))}function Xn(t){var e=$,n=t.__c;typeof n=="function"&&(t.__c=void 0,n()),$=e}function Qi(t){var e=$;t.__c=t.__(),$=e}function Ff(t,e){return!t||t.length!==e.length||e.some(function(n,r){return n!==t[r]})}function Gl(t,e){return typeof e=="function"?e(t):e}var Ql="0.0.16",Yl="__formgenRuntimeVersionAnnounced__";function Bf(){if(typeof globalThis=="undefined")return;let t=globalThis;if(t[Yl]||(t[Yl]=!0,typeof console=="undefined"))return;let e=typeof console.info=="function"?console.info:console.log;typeof e=="function"&&e(`[formgen-relationships] runtime version ${Ql}`)}Bf();var eo={strategy:"memory",ttlMs:3e5},Pt={baseUrl:"",cache:eo,logger:void 0,searchThrottleMs:250,searchDebounceMs:250,retryAttempts:1,retryDelayMs:300},to=Pt;function no(t={}){var n;let e={...Pt,...t,cache:{...eo,...(n=t.cache)!=null?n:{}}};return e.cache.strategy||(e.cache.strategy="memory"),(typeof e.cache.ttlMs!="number"||Number.isNaN(e.cache.ttlMs))&&(e.cache.ttlMs=eo.ttlMs),(typeof e.searchThrottleMs!="number"||e.searchThrottleMs<0)&&(e.searchThrottleMs=Pt.searchThrottleMs),(typeof e.searchDebounceMs!="number"||e.searchDebounceMs<0)&&(e.searchDebounceMs=Pt.searchDebounceMs),(typeof e.retryAttempts!="number"||e.retryAttempts<0)&&(e.retryAttempts=Pt.retryAttempts),(typeof e.retryDelayMs!="number"||e.retryDelayMs<0)&&(e.retryDelayMs=Pt.retryDelayMs),e}function Xl(t={}){return to=no(t),to}function Zl(){return to}var er=class{constructor(){this.store=new Map}get(e){let n=this.store.get(e);if(n){if(n.expiresAt&&n.expiresAt<=Date.now()){this.store.delete(e);return}return n.value}}set(e,n,r){let i=typeof r=="number"&&r>0?Date.now()+r:void 0;this.store.set(e,{value:n,expiresAt:i})}delete(e){this.store.delete(e)}clear(){this.store.clear()}};var le=class extends Error{constructor(e,n,r){super(e),this.name="ResolverError",this.status=n,this.detail=r}},ct=class extends Error{constructor(){super("Resolver request aborted"),this.name="ResolverAbortError"}},zf="[data-relationship-type]",ea="data-relationship-error",ro="inline",io=new Map;io.set(ro,ta);function dt(t,e,n){var o,s;let r=t.dataset.validationRenderer||ro;((s=(o=io.get(r))!=null?o:io.get(ro))!=null?s:ta)({element:t,message:e,code:n})}function Ht(t){dt(t,null)}function ta(t){var i,o;let e=(o=(i=t.element.closest(zf))!=null?i:t.element.parentElement)!=null?o:t.element;if(!e)return;let n=e;e.classList.contains("relative")&&e.parentElement&&(n=e.parentElement);let r=n.querySelector(`[${ea}]`);r||(r=document.createElement("p"),r.setAttribute(ea,"true"),r.className="formgen-error text-xs text-red-600 mt-2 dark:text-red-400",r.setAttribute("role","status"),r.setAttribute("aria-live","polite"),r.setAttribute("aria-atomic","true"),e.classList.contains("relative")&&e.parentElement?e.parentElement.insertBefore(r,e.nextSibling):n.appendChild(r)),t.message&&t.message.trim()!==""?(r.textContent=t.message,r.removeAttribute("aria-hidden"),Vf(t.element,t.message)):(r.textContent="",r.setAttribute("aria-hidden","true"),$f(t.element))}function Vf(t,e){t.setAttribute("aria-invalid","true"),t.setAttribute("data-validation-state","invalid"),t.setAttribute("data-validation-message",e),na(t,!0)}function $f(t){t.removeAttribute("aria-invalid"),t.removeAttribute("data-validation-state"),t.removeAttribute("data-validation-message"),na(t,!1)}function na(t,e){let n=t;if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement||t instanceof HTMLSelectElement||(n=t.querySelector("input, textarea, select")),!n)return;let r=["border-red-500","focus:border-red-500","focus:ring-red-500","dark:border-red-500"],i=["border-gray-200","focus:border-blue-500","focus:ring-blue-500","dark:border-gray-700","dark:focus:ring-gray-600"];e?(i.forEach(o=>n.classList.remove(o)),r.forEach(o=>n.classList.add(o))):(r.forEach(o=>n.classList.remove(o)),i.forEach(o=>n.classList.add(o)))}function jf(t){return t.replace(/^data-/,"").split(/[-_:]/).filter(Boolean).map((e,n)=>n===0?e.toLowerCase():e.charAt(0).toUpperCase()+e.slice(1).toLowerCase()).join("")}function ra(t){var n;if(typeof document=="undefined")return;let e=document.querySelector(`meta[name="${t}"]`);return(n=e==null?void 0:e.getAttribute("content"))!=null?n:void 0}function oo(t,e){var i;if(!t)return;if(t.hasAttribute(e))return(i=t.getAttribute(e))!=null?i:void 0;let n=jf(e),r=t.dataset[n];return r!=null?r:void 0}function Uf(t,e){var n;if(t){if(t.startsWith("meta:"))return ra(t.slice(5));if(t.startsWith("data:"))return oo(e,t.slice(5));if(t.startsWith("element:"))return oo(e,t.slice(8));if(t.includes("=")){let[,r]=t.split("=");return r}return(n=oo(e,t))!=null?n:ra(t)}}function ia(t,e){var o,s;if(!t||t.strategy!=="header")return{};let n=(o=t.header)!=null?o:"Authorization",r=Uf((s=t.source)!=null?s:"data-auth-token",e);if(!r)return{};let i=t.prefix?`${t.prefix.trim()} ${r}`.trim():r;return{[n]:i}}var oa="[data-endpoint-url]",sa="data-relationship-hidden",la="data-relationship-hidden-initialised",aa="data-relationship-json-initialised",ca="data-relationship-json",tr="data-relationship-submit-mode",da="data-relationship-original-name";function ua(t=document){let e=(t instanceof Document,t),n=Array.from(e.querySelectorAll(oa));return t instanceof HTMLElement&&t.matches(oa)&&n.unshift(t),Array.from(new Set(n))}function fa(t){let e={};for(let[n,r]of Object.entries(t.dataset))typeof r=="string"&&(e[n]=r);return e}function so(t){return t instanceof HTMLSelectElement&&t.multiple}function ha(t){t.getAttribute(tr)!=="json"&&(t.hasAttribute(la)||(t.setAttribute(la,"true"),t.setAttribute(tr,"hidden-array"),ut(t),t.addEventListener("change",()=>ut(t))))}function ut(t){if(t.getAttribute(tr)==="json"){Je(t);return}let e=pa(t);for(;e.firstChild;)e.removeChild(e.firstChild);let n=t.name||t.id;if(!n)return;let r=n.endsWith("[]")?n:`${n}[]`;Array.from(t.selectedOptions).forEach(i=>{let o=document.createElement("input");o.type="hidden",o.name=r,o.value=i.value,e.appendChild(o)})}function pa(t){var r,i,o;let e=(r=t.parentElement)==null?void 0:r.querySelector(`[${sa}]`);if(e)return e;let n=document.createElement("div");return n.setAttribute(sa,"true"),n.style.display="none",t.parentElement?t.parentElement.appendChild(n):t.nextSibling?(i=t.parentNode)==null||i.insertBefore(n,t.nextSibling):(o=t.parentNode)==null||o.appendChild(n),n}function ma(t){if(t.hasAttribute(aa))return;t.setAttribute(aa,"true"),t.setAttribute(tr,"json");let e=t.getAttribute("name");e&&(t.setAttribute(da,e),t.removeAttribute("name")),Je(t),t.addEventListener("change",()=>Je(t)),t.addEventListener("blur",()=>Je(t))}function Je(t){var o,s;let e=pa(t),n=e.querySelector(`[${ca}]`);n||(n=document.createElement("input"),n.type="hidden",n.setAttribute(ca,"true"),e.appendChild(n));let r=(o=t.getAttribute(da))!=null?o:t.getAttribute("name");if(r){let l=r.endsWith("[]")?r.slice(0,r.length-2):r;n.name=l}let i=Array.from(t.selectedOptions).map(l=>l.value);if(t.multiple)n.value=JSON.stringify(i);else{let l=(s=i[0])!=null?s:"";n.value=l?JSON.stringify(l):"null"}}function nr(t){if(!t)return null;if(t instanceof HTMLInputElement)return t.type==="checkbox"||t.type==="radio"?t.checked?t.value:null:t.value;if(t instanceof HTMLSelectElement){if(t.multiple)return Array.from(t.selectedOptions).map(n=>n.value);let e=t.selectedOptions[0];return e?e.value:null}return t instanceof HTMLTextAreaElement?t.value:t.textContent}function ya(t,e){var l;let n=[],r=qf(t),i={field:t,value:e};if(Kf(t)&&va(e))return n.push({code:"required",message:`${r} is required.`,value:e}),lo(n);let o=Jf(e);t.cardinality==="one"&&o.length>1&&n.push({code:"cardinality",message:`Select only one ${r.toLowerCase()}.`,value:e});let s=(l=t.validations)!=null?l:[];for(let a of s){let c=Wf(a,i,r);c&&n.push(c)}return lo(n)}function ba(...t){var n;let e=[];for(let r of t)!r||r.valid||e.push(...(n=r.errors)!=null?n:[]);return lo(e)}function Wf(t,e,n){var i,o,s,l,a,c,d;let r=e.value;if(va(r))return null;switch(t.kind){case"min":{let u=rr((i=t.params)==null?void 0:i.value),f=ga(r);if(u==null||f==null)return null;let h=((o=t.params)==null?void 0:o.exclusive)==="true";if(h?f<=u:f<u)return{code:"min",message:`${n} must be ${h?"greater than":"at least"} ${u}.`,rule:t,value:r};break}case"max":{let u=rr((s=t.params)==null?void 0:s.value),f=ga(r);if(u==null||f==null)return null;let h=((l=t.params)==null?void 0:l.exclusive)==="true";if(h?f>=u:f>u)return{code:"max",message:`${n} must be ${h?"less than":"no more than"} ${u}.`,rule:t,value:r};break}case"minLength":{let u=rr((a=t.params)==null?void 0:a.value),f=ir(r);if(u==null||f==null)return null;if(f.length<u)return{code:"minLength",message:`${n} must be at least ${u} characters.`,rule:t,value:r};break}case"maxLength":{let u=rr((c=t.params)==null?void 0:c.value),f=ir(r);if(u==null||f==null)return null;if(f.length>u)return{code:"maxLength",message:`${n} must be at most ${u} characters.`,rule:t,value:r};break}case"pattern":{let u=(d=t.params)==null?void 0:d.pattern,f=ir(r);if(!u||f==null)return null;try{if(!new RegExp(u).test(f))return{code:"pattern",message:`Enter a valid ${n.toLowerCase()}.`,rule:t,value:r}}catch{return null}break}default:return null}return null}function lo(t){return t.length===0?{valid:!0,messages:[],errors:[]}:{valid:!1,errors:t,messages:t.map(e=>e.message)}}function qf(t){return t.label&&t.label.trim()!==""?t.label.trim():t.name&&t.name.trim()!==""?t.name.trim():"This field"}function Kf(t){return t.required===!0}function va(t){return t==null?!0:Array.isArray(t)?t.length===0||t.every(e=>e==null||e===""):String(t).trim()===""}function Jf(t){return t?Array.isArray(t)?t.filter(e=>e!=null&&e!==""):String(t)===""?[]:[String(t)]:[]}function ga(t){let e=ir(t);if(e==null||e.trim()==="")return null;let n=Number(e);return Number.isFinite(n)?n:null}function ir(t){var e;return t==null?null:Array.isArray(t)?t.length===0?null:(e=t[0])!=null?e:null:String(t)}function rr(t){if(t==null)return null;let e=Number(t);return Number.isFinite(e)?e:null}var Gf=/\{\{\s*([^}]+)\s*\}\}/g,ka="Unable to load options.";function or(){return typeof performance!="undefined"&&typeof performance.now=="function"?performance.now():Date.now()}function xa(t){return new Promise(e=>{setTimeout(e,t)})}function Yf(t,e){if(!t)return!1;if(t===e||t===`${e}[]`||t.endsWith(`[${e}]`)||t.endsWith(`[${e}][]`))return!0;let n=t.replace(/[^a-z0-9]/gi,"").toLowerCase(),r=e.replace(/[^a-z0-9]/gi,"").toLowerCase();return n.endsWith(r)}function Ca(t){if(t!=null)return Array.isArray(t)?t.length===0?void 0:t.join(","):t===""?void 0:t}function Ea(t){return!!t&&typeof t=="object"&&(t.name==="AbortError"||t instanceof ct)}var sn=class{constructor(e){this.state={};this.abortController=null;this.lastRequest=null;this.lastContext=null;this.preserveServerValidation=!1;this.userInteracted=!1;this.element=e.element,this.field=e.field,this.endpoint=e.endpoint,this.config=e.config,this.cacheAdapter=e.cacheAdapter,this.cacheConfig=e.cacheConfig,this.dispatchEvent=e.dispatchEvent,this.renderers=e.renderers,this.defaultRenderer=e.defaultRenderer,this.customResolvers=e.customResolvers,this.element instanceof HTMLSelectElement&&(this.field.submitAs==="json"?ma(this.element):so(this.element)&&ha(this.element)),this.captureServerValidationFromDom(),this.bindInteractionHandlers()}async resolve(){var a,c,d;this.cancelInFlight();let e=or(),n=await this.buildRequest(),r=!1,i;if(this.dispatchEvent(this.element,"loading",{element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:null,fromCache:!1}),n.cacheKey&&this.cacheAdapter){let u=await this.cacheAdapter.get(n.cacheKey);Array.isArray(u)&&u.length>0&&(i=u,r=!0)}if(!i){let u=this.createContext(n,!1);for(let f of this.customResolvers)try{if(f.matches(u)){let h=await f.resolve(u);if(Array.isArray(h)){i=h;break}}}catch(h){throw this.handleError(h,n,null,e,0),h}}let o=null;try{i||(o=await this.fetchOptions(n,e),i=o.options,r=o.fromCache)}catch(u){return u instanceof ct||Ea(u)?{options:[],fromCache:!1}:{options:[],fromCache:!1}}let s=this.createContext(n,r);this.config.transformOptions&&(i=await this.config.transformOptions(s,i)),await this.renderOptions(i,r),this.field.current!=null&&(this.field.current=null);let l={element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:(a=o==null?void 0:o.response)!=null?a:null,options:i,fromCache:r,durationMs:or()-e};return this.dispatchEvent(this.element,"success",l),n.cacheKey&&this.cacheAdapter&&!r&&await this.cacheAdapter.set(n.cacheKey,i,{ttlMs:this.cacheConfig.ttlMs,field:this.field,endpoint:this.endpoint}),o&&await((d=(c=this.config).afterFetch)==null?void 0:d.call(c,s,o)),this.element instanceof HTMLSelectElement?this.element.getAttribute("data-relationship-submit-mode")==="json"?Je(this.element):this.element.multiple&&ut(this.element):so(this.element)&&ut(this.element),this.abortController=null,await this.runValidation("auto"),{options:i,fromCache:r}}async validate(){return this.runValidation("manual")}setCurrentValue(e){this.field.current=e!=null?e:null}setServerValidation(e){if(!e){this.state.validation=void 0,this.preserveServerValidation=!1;return}this.state.validation=e,this.preserveServerValidation=!e.valid,!e.valid&&(this.userInteracted=!1)}captureServerValidationFromDom(){var r,i,o;if(this.element.getAttribute("data-validation-state")!=="invalid")return;let n=((r=this.element.getAttribute("data-validation-message"))!=null?r:"").split(";").map(s=>s.trim()).filter(s=>s.length>0);if(n.length===0){let s=(o=(i=this.field.label)!=null?i:this.field.name)!=null?o:"This field";n.push(`${s} is invalid.`)}this.state.validation=this.buildServerValidationResult(n),this.preserveServerValidation=!0}bindInteractionHandlers(){let e=()=>this.handleUserInteraction();this.element.addEventListener("input",e),this.element.addEventListener("change",e)}handleUserInteraction(){this.preserveServerValidation&&(this.preserveServerValidation=!1),this.userInteracted=!0}cancelInFlight(){this.abortController&&(this.abortController.abort(),this.abortController=null)}async runValidation(e="manual"){var o,s,l,a,c,d,u,f,h,p,m,g;if(e==="manual")this.preserveServerValidation=!1;else if(e==="auto"&&this.preserveServerValidation)return(l=this.state.validation)!=null?l:this.buildServerValidationResult([(s=(o=this.field.label)!=null?o:this.field.name)!=null?s:"This field is invalid."]);let n=nr(this.element),r=ya(this.field,n);if(this.config.validateSelection)try{let y=await this.config.validateSelection(this.getActiveContext(),n);y&&(r=ba(r,y))}catch(y){(c=(a=this.config.logger)==null?void 0:a.warn)==null||c.call(a,"resolver:validation:hook",y)}if(this.state.validation=r,r.valid)Ht(this.element);else{let y=r.errors[0],k=(u=(d=y==null?void 0:y.message)!=null?d:r.messages[0])!=null?u:ka;if(dt(this.element,k,y==null?void 0:y.code),r.errors.length>0){let E=this.getActiveContext();for(let O of r.errors)try{(h=(f=this.config).onValidationError)==null||h.call(f,E,O)}catch(T){(m=(p=this.config.logger)==null?void 0:p.warn)==null||m.call(p,"resolver:onValidationError",T)}}}let i={element:this.element,field:this.field,endpoint:this.endpoint,request:(g=this.lastRequest)!=null?g:this.buildRequestStub(),response:null,fromCache:!1,validation:r};return this.dispatchEvent(this.element,"validation",i),r}buildServerValidationResult(e){return e.length===0?{valid:!0,messages:[],errors:[]}:{valid:!1,messages:e,errors:e.map(n=>({code:"server",message:n}))}}async fetchOptions(e,n){var c,d,u,f,h,p,m,g;let r=this.createContext(e,!1),i=(c=globalThis.fetch)==null?void 0:c.bind(globalThis);if(!i){let y=new le("Fetch API unavailable in current environment");throw this.handleError(y,e,null,n,0),y}let o=Math.max(0,(d=this.config.retryAttempts)!=null?d:0)+1,s=Math.max(0,(u=this.config.retryDelayMs)!=null?u:0),l=0,a=null;for(;l<o;){if((f=r.request.init.signal)!=null&&f.aborted)throw new ct;await((p=(h=this.config).beforeFetch)==null?void 0:p.call(h,r));try{let y=await i(e.url,e.init);if(!y.ok){let O=await Sa(y),T=new le(`Resolver request failed with status ${y.status}`,y.status,O);if(l<o-1&&Qf(y.status)){l+=1,a=T,await xa(s*l);continue}throw this.handleError(T,e,y,n,l),T}let k=await Sa(y),E=this.extractOptions(k);return(g=(m=this.config.logger)==null?void 0:m.info)==null||g.call(m,"resolver:success",{field:this.field.name,url:e.url,status:y.status,durationMs:or()-n,attempt:l+1}),{response:y,payload:k,options:E,fromCache:!1}}catch(y){if(Ea(y))throw new ct;if(a=y,l<o-1){l+=1,await xa(s*l);continue}let k=y instanceof le?y:new le(y instanceof Error?y.message:String(y!=null?y:"Unknown error"),void 0,y instanceof Error?{name:y.name,stack:y.stack}:void 0);throw this.handleError(k,e,null,n,l),k}}throw a instanceof le?a:new le("Resolver exhausted retries",void 0,a)}async buildRequest(){var c,d;let e=(this.endpoint.method||"GET").toUpperCase(),n=new URLSearchParams((c=this.endpoint.params)!=null?c:{});if(this.applyDynamicParams(n),this.field.mode==="search"){let u=this.getSearchValue();this.field.searchParam&&(u&&u.length>0?n.set(this.field.searchParam,u):n.delete(this.field.searchParam))}!this.endpoint.resultsPath&&!this.endpoint.mapping&&(Array.from(n.keys()).some(f=>f.toLowerCase()==="format")||n.set("format","options"));let r=this.normaliseBaseUrl((d=this.endpoint.url)!=null?d:""),i=n.toString(),o=i?`${r}?${i}`:r,s={Accept:"application/json"};Object.assign(s,ia(this.endpoint.auth,this.element)),this.abortController=new AbortController;let l={url:o,cacheKey:this.computeCacheKey(e,o),init:{method:e,headers:s,signal:this.abortController.signal}},a=this.createContext(l,!1);if(this.config.buildHeaders){let u=await this.config.buildHeaders(a);u&&typeof u=="object"&&Object.assign(l.init.headers,u)}return l}applyDynamicParams(e){var r;let n=(r=this.endpoint.dynamicParams)!=null?r:{};Object.entries(n).forEach(([i,o])=>{let s=this.resolveDynamicTemplate(o);s==null||s===""?e.delete(i):e.set(i,s)})}resolveDynamicTemplate(e){if(!e)return;let n=!1,r=e.replace(Gf,(o,s)=>{var a,c;n=!0;let l=s.trim();if(!l)return"";if(l.startsWith("field:")){let d=l.slice(6);return(a=this.getFieldReferenceValue(d))!=null?a:""}return(l==="self"||l==="value")&&(c=this.getSelfValue())!=null?c:""});if(!n)return e;let i=r.trim();return i.length>0?i:void 0}getSelfValue(){if(this.field.mode==="search"){let n=this.getSearchValue();if(n!==void 0)return n===""?void 0:n}let e=nr(this.element);return Ca(e)}getSearchValue(){if(!(this.element instanceof HTMLElement))return;let e=this.element.getAttribute("data-endpoint-search-value");if(e!=null)return e;let n=this.element.dataset.endpointSearchValue;return n!=null?n:void 0}getFieldReferenceValue(e){let n=this.findDependencyElements(e);for(let r of n){let i=nr(r),o=Ca(i);if(o!==void 0)return o}}findDependencyElements(e){var s;let n=[],r=new Set,i=this.element.closest("form"),o=[];if(i){let l=Array.from(i.elements);for(let a of l)a instanceof HTMLElement&&o.push(a)}else{let l=(s=this.element.ownerDocument)!=null?s:document;o.push(...Array.from(l.querySelectorAll("[name], [data-field-name], [id]")))}for(let l of o){if(r.has(l))continue;let a=l.dataset.fieldName;if(a&&a===e){n.push(l),r.add(l);continue}let c=l.name;if(Yf(c,e)){n.push(l),r.add(l);continue}let d=l.id;d&&d===e&&(n.push(l),r.add(l))}return n}normaliseBaseUrl(e){var i,o;if(/^https?:/i.test(e))return e;let n=(o=(i=this.config.baseUrl)==null?void 0:i.replace(/\/$/,""))!=null?o:"",r=e.startsWith("/")?e:`/${e}`;return`${n}${r}`||e}computeCacheKey(e,n){var r;return typeof this.field.cacheKey=="string"&&this.field.cacheKey.length>0?this.field.cacheKey:(r=this.config.cache)!=null&&r.keyFactory?this.config.cache.keyFactory(this.createContext({url:n,init:{method:e}},!1)):`${e.toUpperCase()}::${n}`}createContext(e,n){let r={element:this.element,field:this.field,endpoint:this.endpoint,request:e,fromCache:n,config:this.config};return this.lastRequest=e,this.lastContext=r,r}getActiveContext(){if(this.lastContext)return this.lastContext;let e=this.buildRequestStub();return this.createContext(e,!1)}buildRequestStub(){var n;let e=(this.endpoint.method||"GET").toUpperCase();return{url:this.normaliseBaseUrl((n=this.endpoint.url)!=null?n:""),init:{method:e,headers:{}}}}resolveRenderer(){var r,i;let e=(r=this.field.renderer)!=null?r:this.defaultRenderer,n=(i=this.renderers.get(e))!=null?i:this.renderers.get(this.defaultRenderer);if(!n)throw new le(`Renderer '${e}' is not registered`);return n}async renderOptions(e,n){let r=this.resolveRenderer(),i={element:this.element,field:this.field,options:e,fromCache:n,config:this.config};await r(i)}extractOptions(e){return this.unwrapPayload(e).map(r=>this.mapOption(r))}unwrapPayload(e){if(!this.endpoint.resultsPath){if(Array.isArray(e))return e;if(e&&typeof e=="object"&&"data"in e){let i=e.data;if(Array.isArray(i))return i}throw new le("Resolver response payload is not an array")}let n=this.endpoint.resultsPath.split(".").filter(Boolean),r=e;for(let i of n){if(r==null)break;r=r[i]}if(!Array.isArray(r))throw new le("Resolver resultsPath did not resolve to an array");return r}mapOption(e){var u,f,h,p,m,g,y;if(e==null)return{value:"",label:""};if(typeof e!="object"){let k=String(e);return{value:k,label:k,raw:e}}let n=(h=(f=(u=this.endpoint.mapping)==null?void 0:u.value)!=null?f:this.endpoint.valueField)!=null?h:"value",r=(g=(m=(p=this.endpoint.mapping)==null?void 0:p.label)!=null?m:this.endpoint.labelField)!=null?g:"label",i=(y=this.endpoint.mapping)==null?void 0:y.meta,o=typeof e=="object"&&e!==null?e:null,s=ao(e,n),l=ao(e,r),a=i?ao(e,i):void 0;s==null&&o&&(o.value!=null?s=o.value:o.id!=null&&(s=o.id)),l==null&&o&&(o.label!=null?l=o.label:o.name!=null&&(l=o.name));let c=s==null?"":String(s),d=l==null?c:String(l);return{value:c,label:d,meta:a,raw:e}}handleError(e,n,r,i,o){var a,c,d,u;let s=e instanceof le?e:new le(String(e||""));dt(this.element,s.message||ka);let l={element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:r,error:s,fromCache:!1,durationMs:or()-i,attempt:o+1};(c=(a=this.config.logger)==null?void 0:a.error)==null||c.call(a,"resolver:error",{field:this.field.name,url:n.url,status:s.status,durationMs:l.durationMs,attempt:l.attempt,message:s.message}),(u=(d=this.config).onError)==null||u.call(d,this.createContext(n,!1),s),this.dispatchEvent(this.element,"error",l)}};async function Sa(t){let e=await t.text();if(!e)return null;try{return JSON.parse(e)}catch(n){throw new le("Resolver received invalid JSON",t.status,{error:n,body:e})}}function ao(t,e){if(!e)return;let n=e.split(".").filter(Boolean),r=t;for(let i of n){if(r==null)return;r=r[i]}return r}function Qf(t){return typeof t!="number"?!1:t>=500}var wa="default",uo=class{constructor(){this.cache=new er}get(e){return this.cache.get(e)}set(e,n,r){this.cache.set(e,n,r.ttlMs)}delete(e){this.cache.delete(e)}clear(){this.cache.clear()}};function Xf(t){let{element:e,options:n,field:r}=t;if(e instanceof HTMLSelectElement){let i=new Set(Array.from(e.selectedOptions).map(s=>s.value)),o=Zf(r,i);e.innerHTML="",n.forEach(s=>{let l=document.createElement("option");l.value=s.value,l.textContent=s.label,o.has(s.value)&&(l.selected=!0),e.appendChild(l)});return}if(e instanceof HTMLDataListElement){e.innerHTML="",n.forEach(i=>{let o=document.createElement("option");o.value=i.value,o.label=i.label,e.appendChild(o)});return}e.textContent=n.map(i=>i.label).join(", ")}function Zf(t,e){if(t.current==null)return e;let n=new Set;return Array.isArray(t.current)?t.current.forEach(r=>n.add(String(r))):n.add(String(t.current)),n}function co(t,e){t.setAttribute("data-state",e)}var ln=class{constructor(e){this.resolvers=new Map;this.renderers=new Map;this.customResolvers=[];this.dispatchEvent=(e,n,r)=>{var i;n==="loading"?co(e,"loading"):n==="success"?co(e,"ready"):n==="error"&&co(e,"error");try{let o=new CustomEvent(`formgen:relationship:${n}`,{bubbles:!0,detail:r});e.dispatchEvent(o)}catch(o){(i=this.config.logger)!=null&&i.warn&&this.config.logger.warn("Failed to dispatch resolver event",o)}};var n;this.config=e?no(e):Zl(),e&&Xl(this.config),this.cacheConfig=(n=this.config.cache)!=null?n:{},this.cacheAdapter=this.resolveCacheAdapter(this.cacheConfig),this.registerRenderer(wa,Xf)}resolveCacheAdapter(e){if(e.strategy!=="none")return e.strategy==="custom"?e.adapter:new uo}getConfig(){return this.config}register(e,n){if(e instanceof HTMLElement)return this.attachField(e,n);this.registerResolver(e,n)}attachField(e,{field:n,endpoint:r}){let i=new sn({element:e,field:n,endpoint:r,config:this.config,cacheAdapter:this.cacheAdapter,cacheConfig:this.cacheConfig,dispatchEvent:this.dispatchEvent,renderers:this.renderers,defaultRenderer:wa,customResolvers:this.customResolvers});return this.resolvers.set(e,i),i}get(e){return this.resolvers.get(e)}async resolve(e){let n=this.resolvers.get(e);n&&await n.resolve()}async validate(e){let n=this.resolvers.get(e);if(n)return n.validate()}registerRenderer(e,n){this.renderers.set(e,n)}registerResolver(e,n){this.customResolvers.push({...n,name:e})}};function Ma(t,e){if(!e||e<=0)return t;let n=0,r,i,o=(...l)=>{n=Date.now(),t(...l)};return(...l)=>{let a=Date.now(),c=e-(a-n);if(c<=0){r!==void 0&&(clearTimeout(r),r=void 0),o(...l);return}i=l,r===void 0&&(r=setTimeout(()=>{r=void 0,i&&(o(...i),i=void 0)},c))}}function Ta(t,e){if(!e||e<=0)return t;let n;return(...i)=>{n!==void 0&&clearTimeout(n),n=setTimeout(()=>{n=void 0,t(...i)},e)}}function Ft(t){let{select:e,options:n,placeholder:r}=t,i=new Map(Array.from(e.options).filter(l=>l.value!=="").map(l=>[l.value,l.textContent||l.value])),o=new Set(Array.from(e.options).filter(l=>l.selected&&l.value!=="").map(l=>l.value));if(e.innerHTML="",r){let l=document.createElement("option");l.value="",l.textContent=r,e.appendChild(l)}let s=new Map(n.map(l=>[l.value,l]));for(let l of o)if(!s.has(l)){let a=i.get(l)||l;s.set(l,{value:l,label:a})}for(let l of s.values()){let a=document.createElement("option");a.value=l.value,a.textContent=l.label,a.selected=o.has(l.value),e.appendChild(a)}return e.dispatchEvent(new Event("change",{bubbles:!0})),ft(e)}function sr(t){var n,r;let e=Array.from(t.options).find(i=>i.value==="");return e&&e.textContent?e.textContent:t.getAttribute("placeholder")?(n=t.getAttribute("placeholder"))!=null?n:"":t.getAttribute("aria-label")?(r=t.getAttribute("aria-label"))!=null?r:"":"Select an option"}function lr(t,e){var i,o,s,l;if(e)return e;let n=t.getAttribute("data-endpoint-search-placeholder");if(n)return n;let r=(l=(s=(o=(i=t.getAttribute("data-endpoint-field-label"))!=null?i:t.getAttribute("aria-label"))!=null?o:t.getAttribute("placeholder"))!=null?s:t.getAttribute("name"))!=null?l:t.id;return r?`Search ${r}`.trim():"Search options"}function ft(t){return new Set(Array.from(t.options).filter(e=>e.selected&&e.value!=="").map(e=>e.value))}function ar(t,e,n){let r=document.createDocumentFragment(),i=t.toLowerCase(),o=e.toLowerCase();if(!o)return r.append(document.createTextNode(t)),r;let s=0,l=i.indexOf(o),a=o.length;for(;l!==-1;){l>s&&r.append(document.createTextNode(t.slice(s,l)));let c=t.slice(l,l+a),d=document.createElement("mark");d.className=n,d.textContent=c,r.append(d),s=l+a,l=i.indexOf(o,s)}return s<t.length&&r.append(document.createTextNode(t.slice(s))),r}var Aa=[],eh=typeof HTMLElement!="undefined",fo=null;function cr(t,e,n){Aa.push({renderer:t,stores:e,onDestroy:n}),th()}function th(){fo||typeof window=="undefined"||typeof document=="undefined"||(fo=new MutationObserver(t=>{for(let e of t)e.removedNodes.forEach(n=>nh(n))}),fo.observe(document.documentElement,{childList:!0,subtree:!0}))}function nh(t){if(!(!eh||!(t instanceof HTMLElement)))for(let e of Aa){let{renderer:n,stores:r,onDestroy:i}=e,o=rh(t,n);for(let s of o){let l=r.get(s);l&&(i(s,l),r.delete(s))}}}function rh(t,e){let n=new Set;return t instanceof HTMLSelectElement&&t.dataset.endpointRenderer===e&&n.add(t),t.querySelectorAll(`select[data-endpoint-renderer='${e}']`).forEach(r=>n.add(r)),Array.from(n)}var ih={chips:{container:["relative","w-full","text-sm"],containerReady:["flex"],containerOpen:[],inner:["flex","w-full","items-stretch","gap-3","rounded-lg","border","border-gray-200","bg-white","py-3","ps-4","pe-9","transition","focus-within:border-blue-500","focus-within:ring-blue-500"],chips:["flex","flex-wrap","gap-2","grow","items-center"],chipsContent:["flex","flex-wrap","items-center","gap-2"],icon:["inline-flex","items-center","justify-center","text-slate-400","dark:text-slate-500"],placeholder:["text-slate-500"],search:["flex","items-center","gap-2","grow"],searchInput:["w-full","min-w-[6rem]","border-none","bg-transparent","px-0","py-0","text-sm","placeholder:text-slate-400","focus:outline-none","focus:ring-0"],searchHighlight:["bg-amber-100","font-semibold"],chip:["inline-flex","items-center","gap-2","max-w-full","rounded-md","border","border-slate-200","bg-slate-100","px-3","py-1.5","text-sm","font-medium","text-slate-700","shadow-sm"],chipLabel:["truncate"],chipRemove:["flex","h-7","w-7","items-center","justify-center","rounded-full","text-lg","text-slate-400","transition","hover:text-slate-600","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2"],actions:["flex","items-center","gap-1","ml-auto"],action:["inline-flex","h-9","w-9","items-center","justify-center","rounded-md","border","border-slate-200","bg-white","text-slate-500","shadow-sm","transition-colors","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2"],actionClear:["hover:text-rose-500"],actionToggle:["text-base","text-slate-500"],menu:["absolute","left-0","right-0","z-20","mt-2","flex","flex-col","space-y-0.5","rounded-lg","border","border-gray-200","bg-white","p-1","shadow-xl","max-h-72","overflow-hidden","overflow-y-auto"],menuItem:["flex","justify-between","items-center","gap-2","rounded-lg","px-4","py-2","w-full","text-left","text-sm","text-gray-800","cursor-pointer","transition","hover:bg-gray-100","focus:outline-none","focus:bg-gray-100"],menuEmpty:["px-3","py-2","text-sm","text-slate-500"],nativeSelect:["hidden"]},typeahead:{container:["relative","w-full","text-sm"],containerReady:["block"],containerOpen:[],control:["flex","items-center","gap-x-2","relative","rounded-lg","border","border-gray-200","bg-white","py-3","ps-4","pe-9","transition","focus-within:border-blue-500","focus-within:ring-blue-500"],input:["w-full","min-w-0","border-none","bg-transparent","px-0","py-0","text-sm","text-slate-900","placeholder:text-slate-400","focus:outline-none","focus:ring-0"],inputWithIcon:["ps-10"],clear:["inline-flex","h-8","w-8","items-center","justify-center","rounded-md","text-slate-400","transition","hover:bg-slate-100","hover:text-slate-600","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2","disabled:cursor-default","disabled:opacity-40"],dropdown:["absolute","left-0","right-0","z-20","mt-2","flex","flex-col","space-y-0.5","rounded-lg","border","border-gray-200","bg-white","p-1","shadow-xl","max-h-72","overflow-hidden","overflow-y-auto"],option:["flex","justify-between","items-center","gap-2","rounded-lg","px-4","py-2","w-full","text-left","text-sm","text-gray-800","cursor-pointer","transition","hover:bg-gray-100","focus:outline-none","focus:bg-gray-100"],optionActive:["bg-slate-100"],highlight:["bg-amber-100","font-semibold"],empty:["px-3","py-2","text-sm","text-slate-500"],icon:["absolute","top-1/2","start-3","-translate-y-1/2","pointer-events-none","text-slate-400","dark:text-slate-500","flex","items-center","justify-center"],iconSvg:["size-5","text-current"],nativeSelect:["hidden"]},switch:{container:["relative","inline-block","w-11","h-6","cursor-pointer"],input:["peer","sr-only"],track:["absolute","inset-0","bg-gray-200","rounded-full","transition-colors","duration-200","ease-in-out","peer-checked:bg-blue-600","dark:bg-neutral-700","dark:peer-checked:bg-blue-500","peer-disabled:opacity-50","peer-disabled:pointer-events-none"],toggle:["absolute","top-1/2","start-0.5","-translate-y-1/2","size-5","bg-white","rounded-full","shadow-xs","transition-transform","duration-200","ease-in-out","peer-checked:translate-x-full","dark:bg-neutral-400","dark:peer-checked:bg-white"]},wysiwyg:{wrapper:["wysiwyg-wrapper"],editor:["wysiwyg-editor","border","border-gray-200","rounded-lg","overflow-hidden","dark:border-neutral-700","focus-within:border-blue-500","focus-within:ring-1","focus-within:ring-blue-500"],content:["max-w-none","p-4","min-h-[200px]","focus:outline-none","text-sm","leading-relaxed","text-gray-800","dark:text-neutral-200"],toolbar:["wysiwyg-toolbar","flex","gap-1","p-2","border-b","border-gray-200","bg-gray-50","dark:border-neutral-700","dark:bg-neutral-800"]},fileUploader:{wrapper:["space-y-4"],dropzone:["border-2","border-dashed","border-gray-300","rounded-xl","px-6","py-10","text-center","text-sm","text-gray-600","cursor-pointer","hover:border-blue-500","hover:text-blue-600","transition"],button:["inline-flex","items-center","justify-center","gap-2","rounded-lg","border","border-gray-300","px-4","py-2.5","text-sm","font-medium","text-gray-700","hover:bg-gray-50","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","dark:text-gray-200","dark:border-gray-600"],preview:["w-full","h-48","object-cover","rounded-lg","border","border-gray-200","bg-gray-50","dark:border-gray-700"],fileList:["space-y-3"],fileItem:["border","border-gray-200","rounded-lg","p-3","flex","flex-col","gap-2","bg-white","dark:border-gray-700","dark:bg-gray-900"],fileMeta:["flex","items-center","justify-between","text-sm","text-gray-700","dark:text-gray-300"],fileName:["font-medium","truncate"],fileSize:["text-xs","text-gray-500"],fileActions:["flex","items-center","justify-between","text-xs","text-gray-500"],progress:["w-full","h-2","bg-gray-100","rounded-full","overflow-hidden"],status:["text-sm","text-gray-600"],error:["text-sm","text-red-600"]}},oh=Oa(ih);function ht(){return Oa(oh)}function _(t,e){if(!e||e.length===0){t.className="";return}t.className=e.join(" ")}function D(t,e){!e||e.length===0||t.classList.add(...e)}function cn(t,e){!e||e.length===0||t.classList.remove(...e)}function pt(t){return!t||t.length===0?"":t.join(" ")}function ho(...t){let e=[],n=new Set;for(let r of t)if(r)for(let i of r)n.has(i)||(n.add(i),e.push(i));return e}function Oa(t){return{chips:an(t.chips),typeahead:an(t.typeahead),switch:an(t.switch),wysiwyg:an(t.wysiwyg),fileUploader:an(t.fileUploader)}}function an(t){let e={};return Object.keys(t).forEach(n=>{e[n]=[...t[n]]}),e}function dr(t){let{icon:e,iconSource:n,iconRaw:r}=t.dataset;if(!e&&!n&&!r)return null;let i={};return e&&(i.name=e),n&&(i.source=n),r&&(i.raw=r),!i.name&&!i.raw?null:i}function ur(t,e={}){var r;if(!t)return null;let n=document.createElement("span");if(n.setAttribute("aria-hidden","true"),_(n,(r=e.wrapperClasses)!=null?r:[]),t.raw){if(n.innerHTML=t.raw,e.svgClasses&&e.svgClasses.length>0){let i=n.querySelector("svg");i&&D(i,e.svgClasses)}return n}if(t.name){let i=document.createElement("span");return i.textContent=t.name.slice(0,2).toUpperCase(),i.className="text-xs font-semibold text-current",n.appendChild(i),n}return null}var sh="data-fg-chip-root",lh="data-fg-chip-value",po=new WeakMap;function Ra(t){t.registerRenderer("chips",ah)}function Na(t){if(!t.multiple)return;let e=La(t),n=Ft({select:e.select,options:e.options,placeholder:e.placeholder});fr(e,n),un(e,n),hr(e,n)}var ah=t=>{let{element:e,options:n}=t;if(!(e instanceof HTMLSelectElement)||!e.multiple)return;let r=La(e);r.options=n;let i=Ft({select:r.select,options:n,placeholder:r.placeholder});fr(r,i),un(r,i),r.isOpen||dn(r,!1),hr(r,i)};function La(t){let e=po.get(t);if(e)return e;let n=ht().chips,r=document.createElement("div");_(r,n.container),r.style.width="100%",r.setAttribute(sh,"true"),r.hidden=!0;let i=document.createElement("div");_(i,n.chips);let o=document.createElement("div");_(o,n.chipsContent),i.appendChild(o);let s=dr(t),l=null;s&&(l=ur(s,{wrapperClasses:n.icon}),l&&i.insertBefore(l,o));let a=document.createElement("div");_(a,n.actions);let c=document.createElement("button");c.type="button",_(c,ho(n.action,n.actionClear)),c.setAttribute("aria-label","Clear selection"),c.innerHTML='<span aria-hidden="true">&times;</span>';let d=document.createElement("button");d.type="button",_(d,ho(n.action,n.actionToggle)),d.setAttribute("aria-haspopup","listbox"),d.setAttribute("aria-expanded","false"),d.innerHTML='<svg class="shrink-0 size-3.5 text-gray-500" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><path d="m7 15 5 5 5-5"/><path d="m7 9 5-5 5 5"/></svg>',a.append(c,d);let u=document.createElement("div");_(u,n.inner),u.append(i,a);let f=document.createElement("div");_(f,n.menu),f.hidden=!0,r.append(u,f),t.insertAdjacentElement("beforebegin",r),D(t,n.nativeSelect),fh(t,r);let h=sr(t),p=t.dataset.endpointMode==="search",m={select:t,container:r,chips:i,chipsContent:o,menu:f,toggle:d,clear:c,placeholder:h,options:[],documentHandler:()=>{},isOpen:!1,searchMode:p,searchValue:"",theme:n,icon:s,iconElement:l};if(p){let g=document.createElement("div");_(g,n.search);let y=document.createElement("input");y.type="search",_(y,n.searchInput),y.setAttribute("placeholder",lr(t)),y.setAttribute("autocomplete","off"),y.setAttribute("aria-label","Search options"),g.appendChild(y),i.appendChild(g),m.searchInput=y,m.searchValue="",t.setAttribute("data-endpoint-search-value","");let k=()=>{let E=y.value.trim();m.searchValue=E,t.setAttribute("data-endpoint-search-value",E);let O=ft(t);un(m,O),t.dispatchEvent(new Event("input",{bubbles:!0}))};y.addEventListener("input",()=>k()),y.addEventListener("focus",()=>{let E=m.searchValue.trim().length>0,O=m.options.length>0;(E||O)&&dn(m,!0)}),i.addEventListener("click",()=>{y.focus()})}return m.documentHandler=g=>{r.contains(g.target)||dn(m,!1)},c.addEventListener("click",()=>{mo(m,new Set)}),d.addEventListener("click",g=>{g.preventDefault(),dn(m,f.hidden)}),document.addEventListener("click",m.documentHandler),ch(m),dh(m),po.set(t,m),typeof requestAnimationFrame=="function"?requestAnimationFrame(()=>{r.hidden=!1,D(r,n.containerReady)}):(r.hidden=!1,D(r,n.containerReady)),m}function fr(t,e){var c;let{chipsContent:n,chips:r,select:i,placeholder:o,searchInput:s,theme:l}=t;if(s&&document.activeElement===s)return;n.innerHTML="";let a=Array.from(i.options).filter(d=>d.selected&&d.value!=="");if(a.length===0){let d=document.createElement("span");_(d,l.placeholder),d.textContent=o||"Select an option",n.appendChild(d)}else for(let d of a){let u=d.value,f=(c=d.textContent)!=null?c:u,h=document.createElement("span");_(h,l.chip),h.setAttribute(lh,u);let p=document.createElement("span");_(p,l.chipLabel),p.textContent=f;let m=document.createElement("button");m.type="button",_(m,l.chipRemove),m.setAttribute("aria-label",`Remove ${f}`),m.innerHTML="&times;",m.addEventListener("click",()=>{let g=new Set(e);g.delete(u),mo(t,g)}),h.append(p,m),n.appendChild(h)}if(t.searchMode&&s&&s.parentElement){let d=s.parentElement;d.parentElement!==r&&r.appendChild(d)}}function un(t,e){var c;let{menu:n,options:r,searchMode:i,searchValue:o,theme:s}=t;n.innerHTML="",n.setAttribute("role","listbox");let l=i?o.trim().toLowerCase():"",a=r.filter(d=>!e.has(d.value)).filter(d=>{var f;return l?((f=d.label)!=null?f:d.value).toLowerCase().includes(l)||d.value.toLowerCase().includes(l):!0});if(a.length===0){let d=document.createElement("div");_(d,s.menuEmpty),d.textContent=l?"No matches":"No more options",n.appendChild(d);return}for(let d of a){let u=document.createElement("button");u.type="button",_(u,s.menuItem),u.setAttribute("role","option"),u.dataset.value=d.value;let f=document.createElement("span"),h=(c=d.label)!=null?c:d.value;if(f.appendChild(ar(h,l,pt(s.searchHighlight))),u.appendChild(f),e.has(d.value)){u.setAttribute("aria-selected","true");let m=document.createElement("span");m.innerHTML='<svg class="shrink-0 size-3.5 text-blue-600" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><polyline points="20 6 9 17 4 12"/></svg>',u.appendChild(m)}u.addEventListener("click",()=>{let m=new Set(e);m.add(d.value),mo(t,m),dn(t,!1)}),n.appendChild(u)}}function mo(t,e){let{select:n}=t;for(let i of Array.from(n.options))i.selected=e.has(i.value);t.syncingFromSelect=!0;try{n.dispatchEvent(new Event("change",{bubbles:!0}))}finally{t.syncingFromSelect=!1}let r=ft(n);fr(t,r),t.searchMode&&(t.searchInput&&(t.searchInput.value=""),t.searchValue="",n.setAttribute("data-endpoint-search-value","")),un(t,r),hr(t,r),t.searchMode&&n.dispatchEvent(new Event("input",{bubbles:!0}))}function hr(t,e){t.clear.disabled=e.size===0}function dn(t,e){t.menu.hidden=!e,t.toggle.setAttribute("aria-expanded",e?"true":"false"),e?(D(t.container,t.theme.containerOpen),t.searchMode&&t.searchInput&&t.searchInput.focus()):cn(t.container,t.theme.containerOpen),t.isOpen=e}function ch(t){let e=()=>{t.select.getAttribute("data-validation-state")==="invalid"?(t.container.setAttribute("data-validation-state","invalid"),t.container.setAttribute("aria-invalid","true")):(t.container.removeAttribute("data-validation-state"),t.container.removeAttribute("aria-invalid"))};t.validationHandler=()=>e(),t.select.addEventListener("formgen:relationship:validation",t.validationHandler),typeof MutationObserver!="undefined"&&(t.validationObserver=new MutationObserver(()=>e()),t.validationObserver.observe(t.select,{attributes:!0,attributeFilter:["data-validation-state"]})),e()}function dh(t){let e=()=>{if(t.syncingFromSelect)return;let n=ft(t.select);fr(t,n),un(t,n),hr(t,n)};t.changeHandler=e,t.select.addEventListener("change",e)}function uh(t){var e;document.removeEventListener("click",t.documentHandler),t.validationHandler&&t.select.removeEventListener("formgen:relationship:validation",t.validationHandler),(e=t.validationObserver)==null||e.disconnect(),t.changeHandler&&t.select.removeEventListener("change",t.changeHandler)}function fh(t,e){t.hasAttribute("required")&&(t.dataset.validationRequiredNative="true",t.removeAttribute("required"),e.setAttribute("aria-required","true"))}cr("chips",po,(t,e)=>{uh(e)});var hh="data-fg-typeahead-root",Ia="data-fg-typeahead-option",go=new WeakMap;function Da(t){t.registerRenderer("typeahead",ph)}function Pa(t){if(t.multiple)return;let e=Ha(t),n=Ft({select:e.select,options:e.options,placeholder:e.placeholder});yo(e,n),hn(e)}var ph=t=>{let{element:e,options:n}=t;if(!(e instanceof HTMLSelectElement)||e.multiple)return;let r=Ha(e);r.options=n;let i=Ft({select:r.select,options:n,placeholder:r.placeholder});yo(r,i),hn(r)};function Ha(t){let e=go.get(t);if(e)return e;let n=ht().typeahead,r=document.createElement("div");_(r,n.container),r.setAttribute(hh,"true"),r.hidden=!0;let i=document.createElement("div");_(i,n.control),i.setAttribute("role","combobox"),i.setAttribute("aria-haspopup","listbox"),i.setAttribute("aria-expanded","false");let o=document.createElement("input");o.type="text",_(o,n.input),o.autocomplete="off",o.setAttribute("aria-autocomplete","list");let s=document.createElement("button");s.type="button",_(s,n.clear),s.setAttribute("aria-label","Clear selection"),s.innerHTML='<span aria-hidden="true">&times;</span>',s.disabled=!0,i.append(o,s);let l=document.createElement("div");_(l,n.dropdown),l.setAttribute("role","listbox"),l.hidden=!0;let a=t.id?`${t.id}__typeahead`:`fg-typeahead-${Math.random().toString(36).slice(2)}`;l.id=a,i.setAttribute("aria-controls",a),o.setAttribute("aria-controls",a);let c=document.createElement("div");c.className="absolute top-1/2 end-3 -translate-y-1/2 pointer-events-none",c.innerHTML='<svg class="shrink-0 size-3.5 text-gray-500" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><path d="m7 15 5 5 5-5"/><path d="m7 9 5-5 5 5"/></svg>',r.append(i,c,l),t.insertAdjacentElement("beforebegin",r),D(t,n.nativeSelect),Ch(t,i,o);let d=t.dataset.endpointPlaceholder||sr(t),u=lr(t,t.dataset.endpointSearchPlaceholder),f=t.dataset.endpointFieldLabel||t.getAttribute("aria-label")||t.getAttribute("name")||t.id||void 0;o.placeholder=d,o.setAttribute("aria-label",f!=null?f:"Related record");let h=dr(t),p={select:t,container:r,control:i,input:o,clear:s,dropdown:l,options:[],filtered:[],placeholder:d,searchPlaceholder:u,label:f,highlightedIndex:-1,isOpen:!1,searchMode:t.dataset.endpointMode==="search",searchQuery:"",documentHandler:()=>{},theme:n,icon:h,iconElement:null};o.placeholder=p.searchMode?p.searchPlaceholder:p.placeholder;let m=ur(h,{wrapperClasses:n.icon,svgClasses:n.iconSvg});return m&&(i.insertBefore(m,o),p.iconElement=m,D(o,n.inputWithIcon)),mh(p),vh(p),kh(p),go.set(t,p),zt(p),typeof requestAnimationFrame=="function"?requestAnimationFrame(()=>{r.hidden=!1,D(r,n.containerReady)}):(r.hidden=!1,D(r,n.containerReady)),p}function mh(t){let{input:e,clear:n,dropdown:r}=t;e.addEventListener("focus",()=>{fn(t),e.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder}),e.addEventListener("click",()=>{fn(t)}),e.addEventListener("input",()=>gh(t)),e.addEventListener("keydown",i=>yh(t,i)),n.addEventListener("click",()=>{bh(t)}),r.addEventListener("mousedown",i=>{i.preventDefault()}),t.documentHandler=i=>{t.container.contains(i.target)||(mr(t),Bt(t))},document.addEventListener("click",t.documentHandler)}function yo(t,e){var l,a;let{select:n,input:r}=t,i=e.size>0;if(document.activeElement===r&&t.searchQuery&&!i)return;let o=(l=Array.from(e)[0])!=null?l:"";t.highlightedIndex=-1;let s=o?Array.from(n.options).find(c=>c.value===o):void 0;r.value=(a=s==null?void 0:s.textContent)!=null?a:"",o||Bt(t),zt(t)}function gh(t){let{input:e,select:n}=t,r=e.value.trim();t.highlightedIndex=-1,t.searchQuery=r,n.setAttribute("data-endpoint-search-value",r),hn(t),fn(t),t.searchMode&&n.dispatchEvent(new Event("input",{bubbles:!0})),zt(t)}function yh(t,e){if(!new Set(["ArrowDown","ArrowUp","Enter","Escape","Tab"]).has(e.key))return;let{filtered:r}=t;if(e.key==="Escape"){mr(t),Bt(t);return}if(e.key==="Tab"){if(t.isOpen&&t.highlightedIndex>=0){let i=r[t.highlightedIndex];i&&(e.preventDefault(),pr(t,i))}mr(t),Bt(t);return}if(e.preventDefault(),e.key==="ArrowDown"){t.isOpen||fn(t),_a(t,1);return}if(e.key==="ArrowUp"){t.isOpen||fn(t),_a(t,-1);return}if(e.key==="Enter")if(t.highlightedIndex>=0){let i=r[t.highlightedIndex];i&&pr(t,i)}else r.length===1&&pr(t,r[0])}function _a(t,e){let{filtered:n}=t;if(n.length===0){t.highlightedIndex=-1;return}let r=t.highlightedIndex===-1?e>0?0:n.length-1:(t.highlightedIndex+e+n.length)%n.length;t.highlightedIndex=r,gr(t)}function pr(t,e){var i;let{select:n,input:r}=t;for(let o of Array.from(n.options))o.selected=o.value===e.value;r.value=(i=e.label)!=null?i:e.value,Bt(t),t.syncingFromSelect=!0;try{n.dispatchEvent(new Event("change",{bubbles:!0}))}finally{t.syncingFromSelect=!1}mr(t),t.highlightedIndex=-1,t.searchQuery="",n.setAttribute("data-endpoint-search-value",""),zt(t)}function bh(t){let{select:e,input:n}=t;for(let r of Array.from(e.options))r.selected=!1;n.value="",Bt(t),t.syncingFromSelect=!0;try{e.dispatchEvent(new Event("change",{bubbles:!0}))}finally{t.syncingFromSelect=!1}t.highlightedIndex=-1,t.searchQuery="",e.setAttribute("data-endpoint-search-value",""),hn(t),zt(t),t.searchMode&&e.dispatchEvent(new Event("input",{bubbles:!0}))}function fn(t){if(!t.isOpen){if(t.input.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder,hn(t),t.searchMode&&t.filtered.length===0&&!t.searchQuery){t.dropdown.hidden=!0,t.control.setAttribute("aria-expanded","false");return}t.dropdown.hidden=!1,t.control.setAttribute("aria-expanded","true"),D(t.container,t.theme.containerOpen),t.isOpen=!0,t.highlightedIndex===-1&&t.filtered.length>0&&(t.highlightedIndex=0),gr(t)}}function mr(t){t.isOpen&&(t.dropdown.hidden=!0,t.control.setAttribute("aria-expanded","false"),cn(t.container,t.theme.containerOpen),t.isOpen=!1,t.highlightedIndex=-1,gr(t))}function hn(t){var l;let{dropdown:e,select:n,theme:r}=t;e.innerHTML="";let i=t.searchQuery.trim().toLowerCase(),o=t.options;if(i&&(o=o.filter(a=>{var d;return((d=a.label)!=null?d:a.value).toLowerCase().includes(i)||a.value.toLowerCase().includes(i)})),t.filtered=o,o.length===0){let a=document.createElement("div");_(a,r.empty),a.textContent=i?"No matches":"No options",e.appendChild(a);return}let s=(l=Array.from(n.options).find(a=>a.selected))==null?void 0:l.value;o.forEach((a,c)=>{var f;let d=document.createElement("button");d.type="button",_(d,r.option),d.setAttribute("role","option"),d.dataset.value=a.value,d.setAttribute(Ia,"true"),d.dataset.selected=a.value===s?"true":"false";let u=document.createElement("span");if(u.appendChild(ar((f=a.label)!=null?f:a.value,i,pt(r.highlight))),d.appendChild(u),a.value===s){d.setAttribute("aria-selected","true");let h=document.createElement("span");h.innerHTML='<svg class="shrink-0 size-3.5 text-blue-600" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><polyline points="20 6 9 17 4 12"/></svg>',d.appendChild(h)}d.addEventListener("click",()=>pr(t,a)),e.appendChild(d),t.highlightedIndex===-1&&a.value===s&&(t.highlightedIndex=c)}),gr(t)}function gr(t){let{dropdown:e,highlightedIndex:n,theme:r}=t;Array.from(e.querySelectorAll(`[${Ia}]`)).forEach((o,s)=>{let l=s===n;l?D(o,r.optionActive):cn(o,r.optionActive),o.setAttribute("aria-selected",l||o.dataset.selected==="true"?"true":"false"),l&&typeof o.scrollIntoView=="function"&&o.scrollIntoView({block:"nearest"})})}function zt(t){let{input:e,select:n,clear:r}=t,i=e.value.trim()!=="",o=Array.from(n.options).some(s=>s.selected&&s.value!=="");r.disabled=!(i||o)}function Bt(t){t.input.value||(t.input.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder)}function vh(t){let e=()=>{t.select.getAttribute("data-validation-state")==="invalid"?(t.container.setAttribute("data-validation-state","invalid"),t.control.setAttribute("aria-invalid","true"),t.input.setAttribute("aria-invalid","true")):(t.container.removeAttribute("data-validation-state"),t.control.removeAttribute("aria-invalid"),t.input.removeAttribute("aria-invalid"))};t.validationHandler=()=>e(),t.select.addEventListener("formgen:relationship:validation",t.validationHandler),typeof MutationObserver!="undefined"&&(t.validationObserver=new MutationObserver(()=>e()),t.validationObserver.observe(t.select,{attributes:!0,attributeFilter:["data-validation-state"]})),e()}function kh(t){let e=()=>{if(t.syncingFromSelect)return;let n=ft(t.select);yo(t,n),zt(t)};t.changeHandler=e,t.select.addEventListener("change",e)}function xh(t){var e;document.removeEventListener("click",t.documentHandler),t.validationHandler&&t.select.removeEventListener("formgen:relationship:validation",t.validationHandler),(e=t.validationObserver)==null||e.disconnect(),t.changeHandler&&t.select.removeEventListener("change",t.changeHandler)}function Ch(t,e,n){t.hasAttribute("required")&&(t.dataset.validationRequiredNative="true",t.removeAttribute("required"),e.setAttribute("aria-required","true"),n.setAttribute("aria-required","true"))}cr("typeahead",go,(t,e)=>{xh(e)});var za="POST",Eh=25*1024*1024,Va=({element:t,config:e})=>{let n=t.querySelector("input, textarea, select");if(!n){console.warn("formgen:file-uploader \u2013 unable to find input element");return}let r=new bo(t,n,Rh(e));return()=>r.destroy()},bo=class{constructor(e,n,r){this.entries=[];this.destroying=!1;this.submitting=!1;this.triggerFileDialog=()=>{this.fileInput.click()};this.handleFileInputChange=()=>{this.fileInput.files&&(this.handleSelectedFiles(this.fileInput.files),this.fileInput.value="")};this.handleFormReset=()=>{this.clearEntries(),this.serializeFiles(),this.refreshUI(),this.statusMessage.textContent="",Ht(this.element)};this.handleFormSubmit=async e=>{var r;if(this.config.autoUpload||this.submitting)return;let n=this.entries.filter(i=>i.status!=="uploaded");if(n.length!==0){e.preventDefault(),this.submitting=!0;try{for(let i of n)if(await this.uploadEntry(i),i.status!=="uploaded")throw new Error("Unable to upload file.");this.serializeFiles(),(r=this.form)==null||r.submit()}catch(i){this.showError(i instanceof Error?i.message:"Upload failed")}finally{this.submitting=!1}}};var s,l,a;this.element=e,this.input=n,this.config=r,this.theme=ht().fileUploader,this.form=n.form,this.originalName=n.name;let i=this.collectHydratedUrls();this.input.type="hidden",this.input.value="",this.hiddenContainer=document.createElement("div"),this.hiddenContainer.dataset.fgUploaderHidden="true",this.hiddenContainer.style.display="none",this.input.after(this.hiddenContainer),this.widget=document.createElement("div"),D(this.widget,this.theme.wrapper),this.fileInput=document.createElement("input"),this.fileInput.type="file",this.fileInput.multiple=this.config.multiple,this.config.allowedTypes.length>0&&(this.fileInput.accept=this.config.allowedTypes.join(",")),this.fileInput.className="sr-only",this.widget.appendChild(this.fileInput);let o=this.createControl();this.widget.appendChild(o),this.previewImage=this.config.variant==="image"?this.createPreview():void 0,this.previewImage&&this.widget.appendChild(this.previewImage),this.fileList=document.createElement("div"),D(this.fileList,this.theme.fileList),this.widget.appendChild(this.fileList),this.statusMessage=document.createElement("p"),D(this.statusMessage,(s=this.theme.status)!=null?s:[]),this.statusMessage.setAttribute("aria-live","polite"),this.widget.appendChild(this.statusMessage),e.appendChild(this.widget),this.fileInput.addEventListener("change",this.handleFileInputChange),(l=this.form)==null||l.addEventListener("reset",this.handleFormReset),this.config.autoUpload||(a=this.form)==null||a.addEventListener("submit",this.handleFormSubmit),i.length>0&&this.hydrateUrls(i),this.config.uploadEndpoint||this.showError("Upload endpoint is not configured.")}destroy(){var e,n;this.destroying=!0,this.fileInput.removeEventListener("change",this.handleFileInputChange),(e=this.form)==null||e.removeEventListener("reset",this.handleFormReset),(n=this.form)==null||n.removeEventListener("submit",this.handleFormSubmit),this.clearEntries()}createControl(){if(this.config.variant==="dropzone"){let n=document.createElement("div");D(n,this.theme.dropzone),n.textContent="Drag & drop files or click to browse",n.role="button",n.tabIndex=0,n.addEventListener("click",this.triggerFileDialog),n.addEventListener("keydown",i=>{(i.key==="Enter"||i.key===" ")&&(i.preventDefault(),this.triggerFileDialog())});let r=i=>{i.preventDefault(),i.stopPropagation()};return n.addEventListener("dragover",r),n.addEventListener("dragenter",r),n.addEventListener("drop",i=>{var o;r(i),(o=i.dataTransfer)!=null&&o.files&&this.handleSelectedFiles(i.dataTransfer.files)}),this.dropzone=n,n}let e=document.createElement("button");return e.type="button",D(e,this.theme.button),e.textContent=this.config.variant==="image"?"Choose image":"Choose files",e.addEventListener("click",this.triggerFileDialog),e}createPreview(){let e=document.createElement("img");return D(e,this.theme.preview),e.alt="Selected image preview",e.hidden=!0,e}handleSelectedFiles(e){let n=Array.from(e);if(n.length){this.config.multiple||this.clearEntries();for(let r of n){let i=this.validateFile(r);if(i){this.showError(i);continue}this.addEntry(r)}}}validateFile(e){return e.size>this.config.maxSize?`File "${e.name}" exceeds maximum size of ${Ba(this.config.maxSize)}.`:this.config.allowedTypes.length>0&&!this.config.allowedTypes.some(r=>Th(e,r))?`File "${e.name}" is not an allowed type.`:null}addEntry(e){let n={id:`${Date.now()}-${Math.random().toString(36).slice(2)}`,file:e,status:this.config.autoUpload?"uploading":"pending",progress:0,previewUrl:this.config.preview&&e.type.startsWith("image/")?URL.createObjectURL(e):void 0};this.entries.push(n),this.refreshUI(),this.config.autoUpload&&this.uploadEntry(n)}async uploadEntry(e){e.status="uploading",e.progress=0,this.refreshUI();try{let n=await this.sendUpload(e.file);e.uploaded=n,e.status="uploaded",e.progress=100,e.error=void 0,this.serializeFiles(),Ht(this.element)}catch(n){e.status="error",e.error=n instanceof Error?n.message:"Upload failed",e.progress=0,this.showError(e.error)}finally{this.refreshUI()}}async sendUpload(e){var s,l,a,c,d;if(!this.config.uploadEndpoint)throw new Error("Upload endpoint is not configured.");let n=Sh(this.config.headers,this.element),r=new FormData;r.append("file",e,e.name);let i=await fetch(this.config.uploadEndpoint,{method:(s=this.config.uploadMethod)!=null?s:za,headers:n,body:r});if(!i.ok)throw new Error(`Upload failed (${i.status})`);let o=await i.json();if(!o||typeof o.url!="string")throw new Error("Upload response missing url.");return{name:(l=o.name)!=null?l:o.url,originalName:(a=o.originalName)!=null?a:e.name,size:(c=o.size)!=null?c:e.size,contentType:(d=o.contentType)!=null?d:e.type,url:o.url,thumbnail:o.thumbnail}}refreshUI(){this.renderFileList(),this.updatePreview()}renderFileList(){var e,n,r,i;this.fileList.innerHTML="";for(let o of this.entries){let s=document.createElement("div");D(s,this.theme.fileItem);let l=document.createElement("div");D(l,(e=this.theme.fileMeta)!=null?e:[]),l.innerHTML=`<span class="${pt((n=this.theme.fileName)!=null?n:[])}">${o.file.name}</span>
-        <span class="${pt((r=this.theme.fileSize)!=null?r:[])}">${Ba(o.file.size)}</span>`,s.appendChild(l);let a=document.createElement("div");D(a,(i=this.theme.fileActions)!=null?i:[]);let c=document.createElement("span");c.textContent=Nh(o),a.appendChild(c);let d=document.createElement("button");d.type="button",d.textContent="Remove",d.className="text-xs text-red-500 hover:text-red-600",d.addEventListener("click",()=>this.removeEntry(o.id)),a.appendChild(d),s.appendChild(a);let u=document.createElement("div");D(u,this.theme.progress);let f=document.createElement("div");if(f.className="bg-blue-500 h-full transition-all duration-200",f.style.width=`${o.progress}%`,u.appendChild(f),s.appendChild(u),o.error){let h=document.createElement("p");D(h,this.theme.error),h.textContent=o.error,s.appendChild(h)}this.fileList.appendChild(s)}}removeEntry(e){let n=this.entries.findIndex(i=>i.id===e);if(n===-1)return;let[r]=this.entries.splice(n,1);r.previewUrl&&URL.revokeObjectURL(r.previewUrl),this.serializeFiles(),this.refreshUI()}updatePreview(){var r,i,o,s,l,a;if(!this.previewImage)return;let e=(r=this.entries.find(c=>{var d;return((d=c.uploaded)==null?void 0:d.thumbnail)||c.previewUrl}))!=null?r:this.entries[0];if(!e){this.previewImage.hidden=!0,this.previewImage.src="";return}let n=(a=(l=(s=(i=e.uploaded)==null?void 0:i.thumbnail)!=null?s:(o=e.uploaded)==null?void 0:o.url)!=null?l:e.previewUrl)!=null?a:"";if(!n){this.previewImage.hidden=!0;return}this.previewImage.hidden=!1,this.previewImage.src=n}clearEntries(){for(let e of this.entries)e.previewUrl&&URL.revokeObjectURL(e.previewUrl);this.entries=[],this.hiddenContainer.innerHTML="",this.input.value="",this.input.name=this.originalName}collectHydratedUrls(){var l;let e=a=>a.trim();if(!this.config.multiple){let a=e((l=this.input.value)!=null?l:"");return a?[a]:[]}let r=`${this.originalName.endsWith("[]")?this.originalName.slice(0,-2):this.originalName}[]`,i=[`input[name="${Fa(this.originalName)}"]`,`input[name="${Fa(r)}"]`].join(", "),o=Array.from(this.element.querySelectorAll(i)),s=o.map(a=>{var c;return e((c=a.value)!=null?c:"")}).filter(Boolean);for(let a of o)a!==this.input&&a.remove();return s}hydrateUrls(e){for(let n of e)this.entries.push(Ah(n));this.serializeFiles(),this.refreshUI()}serializeFiles(){let e=this.entries.filter(r=>r.uploaded).map(r=>r.uploaded);if(typeof this.config.serialize=="function"){try{this.config.serialize({files:e,input:this.input,fieldName:this.input.name,form:this.form})}catch(r){console.warn("formgen:file-uploader \u2013 serialize hook failed",r)}return}if(this.hiddenContainer.innerHTML="",!e.length){this.input.name=this.originalName,this.input.value="";return}if(!this.config.multiple){this.input.name=this.originalName,this.input.value=e[0].url;return}let n=this.originalName.endsWith("[]")?this.originalName:`${this.originalName}[]`;this.input.name=n,this.input.value=e[0].url;for(let r=1;r<e.length;r+=1){let i=document.createElement("input");i.type="hidden",i.name=n,i.value=e[r].url,this.hiddenContainer.appendChild(i)}}showError(e){this.statusMessage.textContent=e,dt(this.element,e)}};function Sh(t,e){let n={};for(let[r,i]of Object.entries(t!=null?t:{})){let o=wh(i,e);o&&(n[r]=o)}return n}function wh(t,e){var n,r;if(t){if(t.startsWith("meta:")){let i=document.querySelector(`meta[name="${t.slice(5)}"]`);return(n=i==null?void 0:i.getAttribute("content"))!=null?n:void 0}if(t.startsWith("data:")){let i=t.slice(5);if(e.hasAttribute(i))return(r=e.getAttribute(i))!=null?r:void 0;let o=Mh(i);return e.dataset[o]}return t}}function Mh(t){return t.replace(/^data-/,"").split(/[-_:]/).filter(Boolean).map((e,n)=>n===0?e.toLowerCase():e.charAt(0).toUpperCase()+e.slice(1).toLowerCase()).join("")}function Th(t,e){if(!e)return!0;if(e.startsWith("."))return t.name.toLowerCase().endsWith(e.toLowerCase());if(e.endsWith("/*")){let n=e.split("/")[0];return t.type.startsWith(`${n}/`)}return t.type===e}function Fa(t){var e;return typeof globalThis!="undefined"&&typeof((e=globalThis.CSS)==null?void 0:e.escape)=="function"?globalThis.CSS.escape(t):t.replace(/["\\]/g,"\\$1")}function Ah(t){let e=Oh(t),n=new File([],e);return{id:`${Date.now()}-${Math.random().toString(36).slice(2)}`,file:n,status:"uploaded",progress:100,uploaded:{url:t,name:e,originalName:e,size:0,contentType:""}}}function Oh(t){var r;let n=t.split("#")[0].split("?")[0].split("/").filter(Boolean);return(r=n[n.length-1])!=null?r:t}function Rh(t){var o,s,l;let e=t!=null?t:{},n=(a,c=!1)=>typeof a=="boolean"?a:typeof a=="string"?a.toLowerCase()==="true":c,r=(a,c)=>{if(typeof a=="number")return a;if(typeof a=="string"&&a.trim()!==""){let d=Number(a);return Number.isFinite(d)?d:c}return c},i={};return e.headers&&typeof e.headers=="object"&&Object.entries(e.headers).forEach(([a,c])=>{c!=null&&(i[a]=String(c))}),{variant:(o=e.variant)!=null?o:"input",maxSize:r(e.maxSize,Eh),allowedTypes:Array.isArray(e.allowedTypes)?e.allowedTypes.filter(a=>typeof a=="string"):typeof e.allowedTypes=="string"?e.allowedTypes.split(",").map(a=>a.trim()).filter(Boolean):[],multiple:n(e.multiple,!1),uploadEndpoint:(s=e.uploadEndpoint)!=null?s:"",uploadMethod:(l=e.uploadMethod)!=null?l:za,autoUpload:e.autoUpload!==void 0?n(e.autoUpload,!0):!0,preview:e.preview!==void 0?n(e.preview,e.variant==="image"):e.variant==="image",headers:i,serialize:typeof e.serialize=="function"?e.serialize:void 0}}function Ba(t){return Number.isFinite(t)?t<1024?`${t} B`:t<1024*1024?`${(t/1024).toFixed(1)} KB`:`${(t/(1024*1024)).toFixed(1)} MB`:"0 B"}function Nh(t){switch(t.status){case"pending":return"Pending upload";case"uploading":return`Uploading\u2026 ${t.progress}%`;case"uploaded":return"Uploaded";case"error":return"Error";default:return"Unknown"}}var pn=new Map,vo=new WeakMap;Ph();function ko(t=document){var n;let e=Lh(t);for(let r of e){let i=Dh((n=r.dataset.component)!=null?n:"");if(!i)continue;let o=vo.get(r);if(o&&o.name===i)continue;o!=null&&o.teardown&&(o.teardown(),vo.delete(r));let s=pn.get(i);if(!s)continue;let l=_h(r.getAttribute("data-component-config")),a=Ih(r,t),c=s({element:r,config:l,root:a});vo.set(r,{name:i,teardown:typeof c=="function"?c:void 0})}}function Lh(t){let e=(t instanceof Document,t),n=Array.from(e.querySelectorAll("[data-component]"));return t instanceof HTMLElement&&t.hasAttribute("data-component")&&n.unshift(t),n}function _h(t){if(t)try{let e=JSON.parse(t);return typeof e=="object"&&e!==null?e:void 0}catch(e){console.warn("formgen: failed to parse component config",e);return}}function Ih(t,e){var r,i,o;let n=t.closest("[data-formgen-auto-init]");return n||(e instanceof HTMLElement?e:(o=(i=e.body)!=null?i:(r=t.ownerDocument)==null?void 0:r.body)!=null?o:t)}function Dh(t){return t.trim().toLowerCase()}function Ph(){pn.has("datetime-range")||pn.set("datetime-range",Hh),pn.has("file_uploader")||pn.set("file_uploader",Va)}function Hh({element:t}){let e=Array.from(t.querySelectorAll("input[type='datetime-local'], input[type='date'], input[type='time']"));if(e.length<2)return;let[n,r]=e,i=()=>{n.value&&(!r.value||r.value<n.value)&&(r.value=n.value)},o=()=>{n.removeEventListener("change",i),r.removeEventListener("change",i)};return n.addEventListener("change",i),r.addEventListener("change",i),i(),o}function te(t){this.content=t}te.prototype={constructor:te,find:function(t){for(var e=0;e<this.content.length;e+=2)if(this.content[e]===t)return e;return-1},get:function(t){var e=this.find(t);return e==-1?void 0:this.content[e+1]},update:function(t,e,n){var r=n&&n!=t?this.remove(n):this,i=r.find(t),o=r.content.slice();return i==-1?o.push(n||t,e):(o[i+1]=e,n&&(o[i]=n)),new te(o)},remove:function(t){var e=this.find(t);if(e==-1)return this;var n=this.content.slice();return n.splice(e,2),new te(n)},addToStart:function(t,e){return new te([t,e].concat(this.remove(t).content))},addToEnd:function(t,e){var n=this.remove(t).content.slice();return n.push(t,e),new te(n)},addBefore:function(t,e,n){var r=this.remove(e),i=r.content.slice(),o=r.find(t);return i.splice(o==-1?i.length:o,0,e,n),new te(i)},forEach:function(t){for(var e=0;e<this.content.length;e+=2)t(this.content[e],this.content[e+1])},prepend:function(t){return t=te.from(t),t.size?new te(t.content.concat(this.subtract(t).content)):this},append:function(t){return t=te.from(t),t.size?new te(this.subtract(t).content.concat(t.content)):this},subtract:function(t){var e=this;t=te.from(t);for(var n=0;n<t.content.length;n+=2)e=e.remove(t.content[n]);return e},toObject:function(){var t={};return this.forEach(function(e,n){t[e]=n}),t},get size(){return this.content.length>>1}};te.from=function(t){if(t instanceof te)return t;var e=[];if(t)for(var n in t)e.push(n,t[n]);return new te(e)};var xo=te;function Qa(t,e,n){for(let r=0;;r++){if(r==t.childCount||r==e.childCount)return t.childCount==e.childCount?null:n;let i=t.child(r),o=e.child(r);if(i==o){n+=i.nodeSize;continue}if(!i.sameMarkup(o))return n;if(i.isText&&i.text!=o.text){for(let s=0;i.text[s]==o.text[s];s++)n++;return n}if(i.content.size||o.content.size){let s=Qa(i.content,o.content,n+1);if(s!=null)return s}n+=i.nodeSize}}function Xa(t,e,n,r){for(let i=t.childCount,o=e.childCount;;){if(i==0||o==0)return i==o?null:{a:n,b:r};let s=t.child(--i),l=e.child(--o),a=s.nodeSize;if(s==l){n-=a,r-=a;continue}if(!s.sameMarkup(l))return{a:n,b:r};if(s.isText&&s.text!=l.text){let c=0,d=Math.min(s.text.length,l.text.length);for(;c<d&&s.text[s.text.length-c-1]==l.text[l.text.length-c-1];)c++,n--,r--;return{a:n,b:r}}if(s.content.size||l.content.size){let c=Xa(s.content,l.content,n-1,r-1);if(c)return c}n-=a,r-=a}}var v=class t{constructor(e,n){if(this.content=e,this.size=n||0,n==null)for(let r=0;r<e.length;r++)this.size+=e[r].nodeSize}nodesBetween(e,n,r,i=0,o){for(let s=0,l=0;l<n;s++){let a=this.content[s],c=l+a.nodeSize;if(c>e&&r(a,i+l,o||null,s)!==!1&&a.content.size){let d=l+1;a.nodesBetween(Math.max(0,e-d),Math.min(a.content.size,n-d),r,i+d)}l=c}}descendants(e){this.nodesBetween(0,this.size,e)}textBetween(e,n,r,i){let o="",s=!0;return this.nodesBetween(e,n,(l,a)=>{let c=l.isText?l.text.slice(Math.max(e,a)-a,n-a):l.isLeaf?i?typeof i=="function"?i(l):i:l.type.spec.leafText?l.type.spec.leafText(l):"":"";l.isBlock&&(l.isLeaf&&c||l.isTextblock)&&r&&(s?s=!1:o+=r),o+=c},0),o}append(e){if(!e.size)return this;if(!this.size)return e;let n=this.lastChild,r=e.firstChild,i=this.content.slice(),o=0;for(n.isText&&n.sameMarkup(r)&&(i[i.length-1]=n.withText(n.text+r.text),o=1);o<e.content.length;o++)i.push(e.content[o]);return new t(i,this.size+e.size)}cut(e,n=this.size){if(e==0&&n==this.size)return this;let r=[],i=0;if(n>e)for(let o=0,s=0;s<n;o++){let l=this.content[o],a=s+l.nodeSize;a>e&&((s<e||a>n)&&(l.isText?l=l.cut(Math.max(0,e-s),Math.min(l.text.length,n-s)):l=l.cut(Math.max(0,e-s-1),Math.min(l.content.size,n-s-1))),r.push(l),i+=l.nodeSize),s=a}return new t(r,i)}cutByIndex(e,n){return e==n?t.empty:e==0&&n==this.content.length?this:new t(this.content.slice(e,n))}replaceChild(e,n){let r=this.content[e];if(r==n)return this;let i=this.content.slice(),o=this.size+n.nodeSize-r.nodeSize;return i[e]=n,new t(i,o)}addToStart(e){return new t([e].concat(this.content),this.size+e.nodeSize)}addToEnd(e){return new t(this.content.concat(e),this.size+e.nodeSize)}eq(e){if(this.content.length!=e.content.length)return!1;for(let n=0;n<this.content.length;n++)if(!this.content[n].eq(e.content[n]))return!1;return!0}get firstChild(){return this.content.length?this.content[0]:null}get lastChild(){return this.content.length?this.content[this.content.length-1]:null}get childCount(){return this.content.length}child(e){let n=this.content[e];if(!n)throw new RangeError("Index "+e+" out of range for "+this);return n}maybeChild(e){return this.content[e]||null}forEach(e){for(let n=0,r=0;n<this.content.length;n++){let i=this.content[n];e(i,r,n),r+=i.nodeSize}}findDiffStart(e,n=0){return Qa(this,e,n)}findDiffEnd(e,n=this.size,r=e.size){return Xa(this,e,n,r)}findIndex(e){if(e==0)return yr(0,e);if(e==this.size)return yr(this.content.length,e);if(e>this.size||e<0)throw new RangeError(`Position ${e} outside of fragment (${this})`);for(let n=0,r=0;;n++){let i=this.child(n),o=r+i.nodeSize;if(o>=e)return o==e?yr(n+1,o):yr(n,r);r=o}}toString(){return"<"+this.toStringInner()+">"}toStringInner(){return this.content.join(", ")}toJSON(){return this.content.length?this.content.map(e=>e.toJSON()):null}static fromJSON(e,n){if(!n)return t.empty;if(!Array.isArray(n))throw new RangeError("Invalid input for Fragment.fromJSON");return new t(n.map(e.nodeFromJSON))}static fromArray(e){if(!e.length)return t.empty;let n,r=0;for(let i=0;i<e.length;i++){let o=e[i];r+=o.nodeSize,i&&o.isText&&e[i-1].sameMarkup(o)?(n||(n=e.slice(0,i)),n[n.length-1]=o.withText(n[n.length-1].text+o.text)):n&&n.push(o)}return new t(n||e,r)}static from(e){if(!e)return t.empty;if(e instanceof t)return e;if(Array.isArray(e))return this.fromArray(e);if(e.attrs)return new t([e],e.nodeSize);throw new RangeError("Can not convert "+e+" to a Fragment"+(e.nodesBetween?" (looks like multiple versions of prosemirror-model were loaded)":""))}};v.empty=new v([],0);var Co={index:0,offset:0};function yr(t,e){return Co.index=t,Co.offset=e,Co}function vr(t,e){if(t===e)return!0;if(!(t&&typeof t=="object")||!(e&&typeof e=="object"))return!1;let n=Array.isArray(t);if(Array.isArray(e)!=n)return!1;if(n){if(t.length!=e.length)return!1;for(let r=0;r<t.length;r++)if(!vr(t[r],e[r]))return!1}else{for(let r in t)if(!(r in e)||!vr(t[r],e[r]))return!1;for(let r in e)if(!(r in t))return!1}return!0}var L=class t{constructor(e,n){this.type=e,this.attrs=n}addToSet(e){let n,r=!1;for(let i=0;i<e.length;i++){let o=e[i];if(this.eq(o))return e;if(this.type.excludes(o.type))n||(n=e.slice(0,i));else{if(o.type.excludes(this.type))return e;!r&&o.type.rank>this.type.rank&&(n||(n=e.slice(0,i)),n.push(this),r=!0),n&&n.push(o)}}return n||(n=e.slice()),r||n.push(this),n}removeFromSet(e){for(let n=0;n<e.length;n++)if(this.eq(e[n]))return e.slice(0,n).concat(e.slice(n+1));return e}isInSet(e){for(let n=0;n<e.length;n++)if(this.eq(e[n]))return!0;return!1}eq(e){return this==e||this.type==e.type&&vr(this.attrs,e.attrs)}toJSON(){let e={type:this.type.name};for(let n in this.attrs){e.attrs=this.attrs;break}return e}static fromJSON(e,n){if(!n)throw new RangeError("Invalid input for Mark.fromJSON");let r=e.marks[n.type];if(!r)throw new RangeError(`There is no mark type ${n.type} in this schema`);let i=r.create(n.attrs);return r.checkAttrs(i.attrs),i}static sameSet(e,n){if(e==n)return!0;if(e.length!=n.length)return!1;for(let r=0;r<e.length;r++)if(!e[r].eq(n[r]))return!1;return!0}static setFrom(e){if(!e||Array.isArray(e)&&e.length==0)return t.none;if(e instanceof t)return[e];let n=e.slice();return n.sort((r,i)=>r.type.rank-i.type.rank),n}};L.none=[];var yt=class extends Error{},x=class t{constructor(e,n,r){this.content=e,this.openStart=n,this.openEnd=r}get size(){return this.content.size-this.openStart-this.openEnd}insertAt(e,n){let r=ec(this.content,e+this.openStart,n);return r&&new t(r,this.openStart,this.openEnd)}removeBetween(e,n){return new t(Za(this.content,e+this.openStart,n+this.openStart),this.openStart,this.openEnd)}eq(e){return this.content.eq(e.content)&&this.openStart==e.openStart&&this.openEnd==e.openEnd}toString(){return this.content+"("+this.openStart+","+this.openEnd+")"}toJSON(){if(!this.content.size)return null;let e={content:this.content.toJSON()};return this.openStart>0&&(e.openStart=this.openStart),this.openEnd>0&&(e.openEnd=this.openEnd),e}static fromJSON(e,n){if(!n)return t.empty;let r=n.openStart||0,i=n.openEnd||0;if(typeof r!="number"||typeof i!="number")throw new RangeError("Invalid input for Slice.fromJSON");return new t(v.fromJSON(e,n.content),r,i)}static maxOpen(e,n=!0){let r=0,i=0;for(let o=e.firstChild;o&&!o.isLeaf&&(n||!o.type.spec.isolating);o=o.firstChild)r++;for(let o=e.lastChild;o&&!o.isLeaf&&(n||!o.type.spec.isolating);o=o.lastChild)i++;return new t(e,r,i)}};x.empty=new x(v.empty,0,0);function Za(t,e,n){let{index:r,offset:i}=t.findIndex(e),o=t.maybeChild(r),{index:s,offset:l}=t.findIndex(n);if(i==e||o.isText){if(l!=n&&!t.child(s).isText)throw new RangeError("Removing non-flat range");return t.cut(0,e).append(t.cut(n))}if(r!=s)throw new RangeError("Removing non-flat range");return t.replaceChild(r,o.copy(Za(o.content,e-i-1,n-i-1)))}function ec(t,e,n,r){let{index:i,offset:o}=t.findIndex(e),s=t.maybeChild(i);if(o==e||s.isText)return r&&!r.canReplace(i,i,n)?null:t.cut(0,e).append(n).append(t.cut(e));let l=ec(s.content,e-o-1,n,s);return l&&t.replaceChild(i,s.copy(l))}function Fh(t,e,n){if(n.openStart>t.depth)throw new yt("Inserted content deeper than insertion position");if(t.depth-n.openStart!=e.depth-n.openEnd)throw new yt("Inconsistent open depths");return tc(t,e,n,0)}function tc(t,e,n,r){let i=t.index(r),o=t.node(r);if(i==e.index(r)&&r<t.depth-n.openStart){let s=tc(t,e,n,r+1);return o.copy(o.content.replaceChild(i,s))}else if(n.content.size)if(!n.openStart&&!n.openEnd&&t.depth==r&&e.depth==r){let s=t.parent,l=s.content;return gt(s,l.cut(0,t.parentOffset).append(n.content).append(l.cut(e.parentOffset)))}else{let{start:s,end:l}=Bh(n,t);return gt(o,rc(t,s,l,e,r))}else return gt(o,kr(t,e,r))}function nc(t,e){if(!e.type.compatibleContent(t.type))throw new yt("Cannot join "+e.type.name+" onto "+t.type.name)}function So(t,e,n){let r=t.node(n);return nc(r,e.node(n)),r}function mt(t,e){let n=e.length-1;n>=0&&t.isText&&t.sameMarkup(e[n])?e[n]=t.withText(e[n].text+t.text):e.push(t)}function mn(t,e,n,r){let i=(e||t).node(n),o=0,s=e?e.index(n):i.childCount;t&&(o=t.index(n),t.depth>n?o++:t.textOffset&&(mt(t.nodeAfter,r),o++));for(let l=o;l<s;l++)mt(i.child(l),r);e&&e.depth==n&&e.textOffset&&mt(e.nodeBefore,r)}function gt(t,e){return t.type.checkContent(e),t.copy(e)}function rc(t,e,n,r,i){let o=t.depth>i&&So(t,e,i+1),s=r.depth>i&&So(n,r,i+1),l=[];return mn(null,t,i,l),o&&s&&e.index(i)==n.index(i)?(nc(o,s),mt(gt(o,rc(t,e,n,r,i+1)),l)):(o&&mt(gt(o,kr(t,e,i+1)),l),mn(e,n,i,l),s&&mt(gt(s,kr(n,r,i+1)),l)),mn(r,null,i,l),new v(l)}function kr(t,e,n){let r=[];if(mn(null,t,n,r),t.depth>n){let i=So(t,e,n+1);mt(gt(i,kr(t,e,n+1)),r)}return mn(e,null,n,r),new v(r)}function Bh(t,e){let n=e.depth-t.openStart,i=e.node(n).copy(t.content);for(let o=n-1;o>=0;o--)i=e.node(o).copy(v.from(i));return{start:i.resolveNoCache(t.openStart+n),end:i.resolveNoCache(i.content.size-t.openEnd-n)}}var xr=class t{constructor(e,n,r){this.pos=e,this.path=n,this.parentOffset=r,this.depth=n.length/3-1}resolveDepth(e){return e==null?this.depth:e<0?this.depth+e:e}get parent(){return this.node(this.depth)}get doc(){return this.node(0)}node(e){return this.path[this.resolveDepth(e)*3]}index(e){return this.path[this.resolveDepth(e)*3+1]}indexAfter(e){return e=this.resolveDepth(e),this.index(e)+(e==this.depth&&!this.textOffset?0:1)}start(e){return e=this.resolveDepth(e),e==0?0:this.path[e*3-1]+1}end(e){return e=this.resolveDepth(e),this.start(e)+this.node(e).content.size}before(e){if(e=this.resolveDepth(e),!e)throw new RangeError("There is no position before the top-level node");return e==this.depth+1?this.pos:this.path[e*3-1]}after(e){if(e=this.resolveDepth(e),!e)throw new RangeError("There is no position after the top-level node");return e==this.depth+1?this.pos:this.path[e*3-1]+this.path[e*3].nodeSize}get textOffset(){return this.pos-this.path[this.path.length-1]}get nodeAfter(){let e=this.parent,n=this.index(this.depth);if(n==e.childCount)return null;let r=this.pos-this.path[this.path.length-1],i=e.child(n);return r?e.child(n).cut(r):i}get nodeBefore(){let e=this.index(this.depth),n=this.pos-this.path[this.path.length-1];return n?this.parent.child(e).cut(0,n):e==0?null:this.parent.child(e-1)}posAtIndex(e,n){n=this.resolveDepth(n);let r=this.path[n*3],i=n==0?0:this.path[n*3-1]+1;for(let o=0;o<e;o++)i+=r.child(o).nodeSize;return i}marks(){let e=this.parent,n=this.index();if(e.content.size==0)return L.none;if(this.textOffset)return e.child(n).marks;let r=e.maybeChild(n-1),i=e.maybeChild(n);if(!r){let l=r;r=i,i=l}let o=r.marks;for(var s=0;s<o.length;s++)o[s].type.spec.inclusive===!1&&(!i||!o[s].isInSet(i.marks))&&(o=o[s--].removeFromSet(o));return o}marksAcross(e){let n=this.parent.maybeChild(this.index());if(!n||!n.isInline)return null;let r=n.marks,i=e.parent.maybeChild(e.index());for(var o=0;o<r.length;o++)r[o].type.spec.inclusive===!1&&(!i||!r[o].isInSet(i.marks))&&(r=r[o--].removeFromSet(r));return r}sharedDepth(e){for(let n=this.depth;n>0;n--)if(this.start(n)<=e&&this.end(n)>=e)return n;return 0}blockRange(e=this,n){if(e.pos<this.pos)return e.blockRange(this);for(let r=this.depth-(this.parent.inlineContent||this.pos==e.pos?1:0);r>=0;r--)if(e.pos<=this.end(r)&&(!n||n(this.node(r))))return new bt(this,e,r);return null}sameParent(e){return this.pos-this.parentOffset==e.pos-e.parentOffset}max(e){return e.pos>this.pos?e:this}min(e){return e.pos<this.pos?e:this}toString(){let e="";for(let n=1;n<=this.depth;n++)e+=(e?"/":"")+this.node(n).type.name+"_"+this.index(n-1);return e+":"+this.parentOffset}static resolve(e,n){if(!(n>=0&&n<=e.content.size))throw new RangeError("Position "+n+" out of range");let r=[],i=0,o=n;for(let s=e;;){let{index:l,offset:a}=s.content.findIndex(o),c=o-a;if(r.push(s,l,i+a),!c||(s=s.child(l),s.isText))break;o=c-1,i+=a+1}return new t(n,r,o)}static resolveCached(e,n){let r=$a.get(e);if(r)for(let o=0;o<r.elts.length;o++){let s=r.elts[o];if(s.pos==n)return s}else $a.set(e,r=new wo);let i=r.elts[r.i]=t.resolve(e,n);return r.i=(r.i+1)%zh,i}},wo=class{constructor(){this.elts=[],this.i=0}},zh=12,$a=new WeakMap,bt=class{constructor(e,n,r){this.$from=e,this.$to=n,this.depth=r}get start(){return this.$from.before(this.depth+1)}get end(){return this.$to.after(this.depth+1)}get parent(){return this.$from.node(this.depth)}get startIndex(){return this.$from.index(this.depth)}get endIndex(){return this.$to.indexAfter(this.depth)}},Vh=Object.create(null),we=class t{constructor(e,n,r,i=L.none){this.type=e,this.attrs=n,this.marks=i,this.content=r||v.empty}get children(){return this.content.content}get nodeSize(){return this.isLeaf?1:2+this.content.size}get childCount(){return this.content.childCount}child(e){return this.content.child(e)}maybeChild(e){return this.content.maybeChild(e)}forEach(e){this.content.forEach(e)}nodesBetween(e,n,r,i=0){this.content.nodesBetween(e,n,r,i,this)}descendants(e){this.nodesBetween(0,this.content.size,e)}get textContent(){return this.isLeaf&&this.type.spec.leafText?this.type.spec.leafText(this):this.textBetween(0,this.content.size,"")}textBetween(e,n,r,i){return this.content.textBetween(e,n,r,i)}get firstChild(){return this.content.firstChild}get lastChild(){return this.content.lastChild}eq(e){return this==e||this.sameMarkup(e)&&this.content.eq(e.content)}sameMarkup(e){return this.hasMarkup(e.type,e.attrs,e.marks)}hasMarkup(e,n,r){return this.type==e&&vr(this.attrs,n||e.defaultAttrs||Vh)&&L.sameSet(this.marks,r||L.none)}copy(e=null){return e==this.content?this:new t(this.type,this.attrs,e,this.marks)}mark(e){return e==this.marks?this:new t(this.type,this.attrs,this.content,e)}cut(e,n=this.content.size){return e==0&&n==this.content.size?this:this.copy(this.content.cut(e,n))}slice(e,n=this.content.size,r=!1){if(e==n)return x.empty;let i=this.resolve(e),o=this.resolve(n),s=r?0:i.sharedDepth(n),l=i.start(s),c=i.node(s).content.cut(i.pos-l,o.pos-l);return new x(c,i.depth-s,o.depth-s)}replace(e,n,r){return Fh(this.resolve(e),this.resolve(n),r)}nodeAt(e){for(let n=this;;){let{index:r,offset:i}=n.content.findIndex(e);if(n=n.maybeChild(r),!n)return null;if(i==e||n.isText)return n;e-=i+1}}childAfter(e){let{index:n,offset:r}=this.content.findIndex(e);return{node:this.content.maybeChild(n),index:n,offset:r}}childBefore(e){if(e==0)return{node:null,index:0,offset:0};let{index:n,offset:r}=this.content.findIndex(e);if(r<e)return{node:this.content.child(n),index:n,offset:r};let i=this.content.child(n-1);return{node:i,index:n-1,offset:r-i.nodeSize}}resolve(e){return xr.resolveCached(this,e)}resolveNoCache(e){return xr.resolve(this,e)}rangeHasMark(e,n,r){let i=!1;return n>e&&this.nodesBetween(e,n,o=>(r.isInSet(o.marks)&&(i=!0),!i)),i}get isBlock(){return this.type.isBlock}get isTextblock(){return this.type.isTextblock}get inlineContent(){return this.type.inlineContent}get isInline(){return this.type.isInline}get isText(){return this.type.isText}get isLeaf(){return this.type.isLeaf}get isAtom(){return this.type.isAtom}toString(){if(this.type.spec.toDebugString)return this.type.spec.toDebugString(this);let e=this.type.name;return this.content.size&&(e+="("+this.content.toStringInner()+")"),ic(this.marks,e)}contentMatchAt(e){let n=this.type.contentMatch.matchFragment(this.content,0,e);if(!n)throw new Error("Called contentMatchAt on a node with invalid content");return n}canReplace(e,n,r=v.empty,i=0,o=r.childCount){let s=this.contentMatchAt(e).matchFragment(r,i,o),l=s&&s.matchFragment(this.content,n);if(!l||!l.validEnd)return!1;for(let a=i;a<o;a++)if(!this.type.allowsMarks(r.child(a).marks))return!1;return!0}canReplaceWith(e,n,r,i){if(i&&!this.type.allowsMarks(i))return!1;let o=this.contentMatchAt(e).matchType(r),s=o&&o.matchFragment(this.content,n);return s?s.validEnd:!1}canAppend(e){return e.content.size?this.canReplace(this.childCount,this.childCount,e.content):this.type.compatibleContent(e.type)}check(){this.type.checkContent(this.content),this.type.checkAttrs(this.attrs);let e=L.none;for(let n=0;n<this.marks.length;n++){let r=this.marks[n];r.type.checkAttrs(r.attrs),e=r.addToSet(e)}if(!L.sameSet(e,this.marks))throw new RangeError(`Invalid collection of marks for node ${this.type.name}: ${this.marks.map(n=>n.type.name)}`);this.content.forEach(n=>n.check())}toJSON(){let e={type:this.type.name};for(let n in this.attrs){e.attrs=this.attrs;break}return this.content.size&&(e.content=this.content.toJSON()),this.marks.length&&(e.marks=this.marks.map(n=>n.toJSON())),e}static fromJSON(e,n){if(!n)throw new RangeError("Invalid input for Node.fromJSON");let r;if(n.marks){if(!Array.isArray(n.marks))throw new RangeError("Invalid mark data for Node.fromJSON");r=n.marks.map(e.markFromJSON)}if(n.type=="text"){if(typeof n.text!="string")throw new RangeError("Invalid text node in JSON");return e.text(n.text,r)}let i=v.fromJSON(e,n.content),o=e.nodeType(n.type).create(n.attrs,i,r);return o.type.checkAttrs(o.attrs),o}};we.prototype.text=void 0;var Mo=class t extends we{constructor(e,n,r,i){if(super(e,n,null,i),!r)throw new RangeError("Empty text nodes are not allowed");this.text=r}toString(){return this.type.spec.toDebugString?this.type.spec.toDebugString(this):ic(this.marks,JSON.stringify(this.text))}get textContent(){return this.text}textBetween(e,n){return this.text.slice(e,n)}get nodeSize(){return this.text.length}mark(e){return e==this.marks?this:new t(this.type,this.attrs,this.text,e)}withText(e){return e==this.text?this:new t(this.type,this.attrs,e,this.marks)}cut(e=0,n=this.text.length){return e==0&&n==this.text.length?this:this.withText(this.text.slice(e,n))}eq(e){return this.sameMarkup(e)&&this.text==e.text}toJSON(){let e=super.toJSON();return e.text=this.text,e}};function ic(t,e){for(let n=t.length-1;n>=0;n--)e=t[n].type.name+"("+e+")";return e}var vt=class t{constructor(e){this.validEnd=e,this.next=[],this.wrapCache=[]}static parse(e,n){let r=new To(e,n);if(r.next==null)return t.empty;let i=oc(r);r.next&&r.err("Unexpected trailing text");let o=Jh(Kh(i));return Gh(o,r),o}matchType(e){for(let n=0;n<this.next.length;n++)if(this.next[n].type==e)return this.next[n].next;return null}matchFragment(e,n=0,r=e.childCount){let i=this;for(let o=n;i&&o<r;o++)i=i.matchType(e.child(o).type);return i}get inlineContent(){return this.next.length!=0&&this.next[0].type.isInline}get defaultType(){for(let e=0;e<this.next.length;e++){let{type:n}=this.next[e];if(!(n.isText||n.hasRequiredAttrs()))return n}return null}compatible(e){for(let n=0;n<this.next.length;n++)for(let r=0;r<e.next.length;r++)if(this.next[n].type==e.next[r].type)return!0;return!1}fillBefore(e,n=!1,r=0){let i=[this];function o(s,l){let a=s.matchFragment(e,r);if(a&&(!n||a.validEnd))return v.from(l.map(c=>c.createAndFill()));for(let c=0;c<s.next.length;c++){let{type:d,next:u}=s.next[c];if(!(d.isText||d.hasRequiredAttrs())&&i.indexOf(u)==-1){i.push(u);let f=o(u,l.concat(d));if(f)return f}}return null}return o(this,[])}findWrapping(e){for(let r=0;r<this.wrapCache.length;r+=2)if(this.wrapCache[r]==e)return this.wrapCache[r+1];let n=this.computeWrapping(e);return this.wrapCache.push(e,n),n}computeWrapping(e){let n=Object.create(null),r=[{match:this,type:null,via:null}];for(;r.length;){let i=r.shift(),o=i.match;if(o.matchType(e)){let s=[];for(let l=i;l.type;l=l.via)s.push(l.type);return s.reverse()}for(let s=0;s<o.next.length;s++){let{type:l,next:a}=o.next[s];!l.isLeaf&&!l.hasRequiredAttrs()&&!(l.name in n)&&(!i.type||a.validEnd)&&(r.push({match:l.contentMatch,type:l,via:i}),n[l.name]=!0)}}return null}get edgeCount(){return this.next.length}edge(e){if(e>=this.next.length)throw new RangeError(`There's no ${e}th edge in this content match`);return this.next[e]}toString(){let e=[];function n(r){e.push(r);for(let i=0;i<r.next.length;i++)e.indexOf(r.next[i].next)==-1&&n(r.next[i].next)}return n(this),e.map((r,i)=>{let o=i+(r.validEnd?"*":" ")+" ";for(let s=0;s<r.next.length;s++)o+=(s?", ":"")+r.next[s].type.name+"->"+e.indexOf(r.next[s].next);return o}).join(`
-`)}};vt.empty=new vt(!0);var To=class{constructor(e,n){this.string=e,this.nodeTypes=n,this.inline=null,this.pos=0,this.tokens=e.split(/\s*(?=\b|\W|$)/),this.tokens[this.tokens.length-1]==""&&this.tokens.pop(),this.tokens[0]==""&&this.tokens.shift()}get next(){return this.tokens[this.pos]}eat(e){return this.next==e&&(this.pos++||!0)}err(e){throw new SyntaxError(e+" (in content expression '"+this.string+"')")}};function oc(t){let e=[];do e.push($h(t));while(t.eat("|"));return e.length==1?e[0]:{type:"choice",exprs:e}}function $h(t){let e=[];do e.push(jh(t));while(t.next&&t.next!=")"&&t.next!="|");return e.length==1?e[0]:{type:"seq",exprs:e}}function jh(t){let e=qh(t);for(;;)if(t.eat("+"))e={type:"plus",expr:e};else if(t.eat("*"))e={type:"star",expr:e};else if(t.eat("?"))e={type:"opt",expr:e};else if(t.eat("{"))e=Uh(t,e);else break;return e}function ja(t){/\D/.test(t.next)&&t.err("Expected number, got '"+t.next+"'");let e=Number(t.next);return t.pos++,e}function Uh(t,e){let n=ja(t),r=n;return t.eat(",")&&(t.next!="}"?r=ja(t):r=-1),t.eat("}")||t.err("Unclosed braced range"),{type:"range",min:n,max:r,expr:e}}function Wh(t,e){let n=t.nodeTypes,r=n[e];if(r)return[r];let i=[];for(let o in n){let s=n[o];s.isInGroup(e)&&i.push(s)}return i.length==0&&t.err("No node type or group '"+e+"' found"),i}function qh(t){if(t.eat("(")){let e=oc(t);return t.eat(")")||t.err("Missing closing paren"),e}else if(/\W/.test(t.next))t.err("Unexpected token '"+t.next+"'");else{let e=Wh(t,t.next).map(n=>(t.inline==null?t.inline=n.isInline:t.inline!=n.isInline&&t.err("Mixing inline and block content"),{type:"name",value:n}));return t.pos++,e.length==1?e[0]:{type:"choice",exprs:e}}}function Kh(t){let e=[[]];return i(o(t,0),n()),e;function n(){return e.push([])-1}function r(s,l,a){let c={term:a,to:l};return e[s].push(c),c}function i(s,l){s.forEach(a=>a.to=l)}function o(s,l){if(s.type=="choice")return s.exprs.reduce((a,c)=>a.concat(o(c,l)),[]);if(s.type=="seq")for(let a=0;;a++){let c=o(s.exprs[a],l);if(a==s.exprs.length-1)return c;i(c,l=n())}else if(s.type=="star"){let a=n();return r(l,a),i(o(s.expr,a),a),[r(a)]}else if(s.type=="plus"){let a=n();return i(o(s.expr,l),a),i(o(s.expr,a),a),[r(a)]}else{if(s.type=="opt")return[r(l)].concat(o(s.expr,l));if(s.type=="range"){let a=l;for(let c=0;c<s.min;c++){let d=n();i(o(s.expr,a),d),a=d}if(s.max==-1)i(o(s.expr,a),a);else for(let c=s.min;c<s.max;c++){let d=n();r(a,d),i(o(s.expr,a),d),a=d}return[r(a)]}else{if(s.type=="name")return[r(l,void 0,s.value)];throw new Error("Unknown expr type")}}}}function sc(t,e){return e-t}function Ua(t,e){let n=[];return r(e),n.sort(sc);function r(i){let o=t[i];if(o.length==1&&!o[0].term)return r(o[0].to);n.push(i);for(let s=0;s<o.length;s++){let{term:l,to:a}=o[s];!l&&n.indexOf(a)==-1&&r(a)}}}function Jh(t){let e=Object.create(null);return n(Ua(t,0));function n(r){let i=[];r.forEach(s=>{t[s].forEach(({term:l,to:a})=>{if(!l)return;let c;for(let d=0;d<i.length;d++)i[d][0]==l&&(c=i[d][1]);Ua(t,a).forEach(d=>{c||i.push([l,c=[]]),c.indexOf(d)==-1&&c.push(d)})})});let o=e[r.join(",")]=new vt(r.indexOf(t.length-1)>-1);for(let s=0;s<i.length;s++){let l=i[s][1].sort(sc);o.next.push({type:i[s][0],next:e[l.join(",")]||n(l)})}return o}}function Gh(t,e){for(let n=0,r=[t];n<r.length;n++){let i=r[n],o=!i.validEnd,s=[];for(let l=0;l<i.next.length;l++){let{type:a,next:c}=i.next[l];s.push(a.name),o&&!(a.isText||a.hasRequiredAttrs())&&(o=!1),r.indexOf(c)==-1&&r.push(c)}o&&e.err("Only non-generatable nodes ("+s.join(", ")+") in a required position (see https://prosemirror.net/docs/guide/#generatable)")}}function lc(t){let e=Object.create(null);for(let n in t){let r=t[n];if(!r.hasDefault)return null;e[n]=r.default}return e}function ac(t,e){let n=Object.create(null);for(let r in t){let i=e&&e[r];if(i===void 0){let o=t[r];if(o.hasDefault)i=o.default;else throw new RangeError("No value supplied for attribute "+r)}n[r]=i}return n}function cc(t,e,n,r){for(let i in e)if(!(i in t))throw new RangeError(`Unsupported attribute ${i} for ${n} of type ${i}`);for(let i in t){let o=t[i];o.validate&&o.validate(e[i])}}function dc(t,e){let n=Object.create(null);if(e)for(let r in e)n[r]=new Ao(t,r,e[r]);return n}var Cr=class t{constructor(e,n,r){this.name=e,this.schema=n,this.spec=r,this.markSet=null,this.groups=r.group?r.group.split(" "):[],this.attrs=dc(e,r.attrs),this.defaultAttrs=lc(this.attrs),this.contentMatch=null,this.inlineContent=null,this.isBlock=!(r.inline||e=="text"),this.isText=e=="text"}get isInline(){return!this.isBlock}get isTextblock(){return this.isBlock&&this.inlineContent}get isLeaf(){return this.contentMatch==vt.empty}get isAtom(){return this.isLeaf||!!this.spec.atom}isInGroup(e){return this.groups.indexOf(e)>-1}get whitespace(){return this.spec.whitespace||(this.spec.code?"pre":"normal")}hasRequiredAttrs(){for(let e in this.attrs)if(this.attrs[e].isRequired)return!0;return!1}compatibleContent(e){return this==e||this.contentMatch.compatible(e.contentMatch)}computeAttrs(e){return!e&&this.defaultAttrs?this.defaultAttrs:ac(this.attrs,e)}create(e=null,n,r){if(this.isText)throw new Error("NodeType.create can't construct text nodes");return new we(this,this.computeAttrs(e),v.from(n),L.setFrom(r))}createChecked(e=null,n,r){return n=v.from(n),this.checkContent(n),new we(this,this.computeAttrs(e),n,L.setFrom(r))}createAndFill(e=null,n,r){if(e=this.computeAttrs(e),n=v.from(n),n.size){let s=this.contentMatch.fillBefore(n);if(!s)return null;n=s.append(n)}let i=this.contentMatch.matchFragment(n),o=i&&i.fillBefore(v.empty,!0);return o?new we(this,e,n.append(o),L.setFrom(r)):null}validContent(e){let n=this.contentMatch.matchFragment(e);if(!n||!n.validEnd)return!1;for(let r=0;r<e.childCount;r++)if(!this.allowsMarks(e.child(r).marks))return!1;return!0}checkContent(e){if(!this.validContent(e))throw new RangeError(`Invalid content for node ${this.name}: ${e.toString().slice(0,50)}`)}checkAttrs(e){cc(this.attrs,e,"node",this.name)}allowsMarkType(e){return this.markSet==null||this.markSet.indexOf(e)>-1}allowsMarks(e){if(this.markSet==null)return!0;for(let n=0;n<e.length;n++)if(!this.allowsMarkType(e[n].type))return!1;return!0}allowedMarks(e){if(this.markSet==null)return e;let n;for(let r=0;r<e.length;r++)this.allowsMarkType(e[r].type)?n&&n.push(e[r]):n||(n=e.slice(0,r));return n?n.length?n:L.none:e}static compile(e,n){let r=Object.create(null);e.forEach((o,s)=>r[o]=new t(o,n,s));let i=n.spec.topNode||"doc";if(!r[i])throw new RangeError("Schema is missing its top node type ('"+i+"')");if(!r.text)throw new RangeError("Every schema needs a 'text' type");for(let o in r.text.attrs)throw new RangeError("The text node type should not have attributes");return r}};function Yh(t,e,n){let r=n.split("|");return i=>{let o=i===null?"null":typeof i;if(r.indexOf(o)<0)throw new RangeError(`Expected value of type ${r} for attribute ${e} on type ${t}, got ${o}`)}}var Ao=class{constructor(e,n,r){this.hasDefault=Object.prototype.hasOwnProperty.call(r,"default"),this.default=r.default,this.validate=typeof r.validate=="string"?Yh(e,n,r.validate):r.validate}get isRequired(){return!this.hasDefault}},yn=class t{constructor(e,n,r,i){this.name=e,this.rank=n,this.schema=r,this.spec=i,this.attrs=dc(e,i.attrs),this.excluded=null;let o=lc(this.attrs);this.instance=o?new L(this,o):null}create(e=null){return!e&&this.instance?this.instance:new L(this,ac(this.attrs,e))}static compile(e,n){let r=Object.create(null),i=0;return e.forEach((o,s)=>r[o]=new t(o,i++,n,s)),r}removeFromSet(e){for(var n=0;n<e.length;n++)e[n].type==this&&(e=e.slice(0,n).concat(e.slice(n+1)),n--);return e}isInSet(e){for(let n=0;n<e.length;n++)if(e[n].type==this)return e[n]}checkAttrs(e){cc(this.attrs,e,"mark",this.name)}excludes(e){return this.excluded.indexOf(e)>-1}},Er=class{constructor(e){this.linebreakReplacement=null,this.cached=Object.create(null);let n=this.spec={};for(let i in e)n[i]=e[i];n.nodes=xo.from(e.nodes),n.marks=xo.from(e.marks||{}),this.nodes=Cr.compile(this.spec.nodes,this),this.marks=yn.compile(this.spec.marks,this);let r=Object.create(null);for(let i in this.nodes){if(i in this.marks)throw new RangeError(i+" can not be both a node and a mark");let o=this.nodes[i],s=o.spec.content||"",l=o.spec.marks;if(o.contentMatch=r[s]||(r[s]=vt.parse(s,this.nodes)),o.inlineContent=o.contentMatch.inlineContent,o.spec.linebreakReplacement){if(this.linebreakReplacement)throw new RangeError("Multiple linebreak nodes defined");if(!o.isInline||!o.isLeaf)throw new RangeError("Linebreak replacement nodes must be inline leaf nodes");this.linebreakReplacement=o}o.markSet=l=="_"?null:l?Wa(this,l.split(" ")):l==""||!o.inlineContent?[]:null}for(let i in this.marks){let o=this.marks[i],s=o.spec.excludes;o.excluded=s==null?[o]:s==""?[]:Wa(this,s.split(" "))}this.nodeFromJSON=i=>we.fromJSON(this,i),this.markFromJSON=i=>L.fromJSON(this,i),this.topNodeType=this.nodes[this.spec.topNode||"doc"],this.cached.wrappings=Object.create(null)}node(e,n=null,r,i){if(typeof e=="string")e=this.nodeType(e);else if(e instanceof Cr){if(e.schema!=this)throw new RangeError("Node type from different schema used ("+e.name+")")}else throw new RangeError("Invalid node type: "+e);return e.createChecked(n,r,i)}text(e,n){let r=this.nodes.text;return new Mo(r,r.defaultAttrs,e,L.setFrom(n))}mark(e,n){return typeof e=="string"&&(e=this.marks[e]),e.create(n)}nodeType(e){let n=this.nodes[e];if(!n)throw new RangeError("Unknown node type: "+e);return n}};function Wa(t,e){let n=[];for(let r=0;r<e.length;r++){let i=e[r],o=t.marks[i],s=o;if(o)n.push(o);else for(let l in t.marks){let a=t.marks[l];(i=="_"||a.spec.group&&a.spec.group.split(" ").indexOf(i)>-1)&&n.push(s=a)}if(!s)throw new SyntaxError("Unknown mark type: '"+e[r]+"'")}return n}function Qh(t){return t.tag!=null}function Xh(t){return t.style!=null}var He=class t{constructor(e,n){this.schema=e,this.rules=n,this.tags=[],this.styles=[];let r=this.matchedStyles=[];n.forEach(i=>{if(Qh(i))this.tags.push(i);else if(Xh(i)){let o=/[^=]*/.exec(i.style)[0];r.indexOf(o)<0&&r.push(o),this.styles.push(i)}}),this.normalizeLists=!this.tags.some(i=>{if(!/^(ul|ol)\b/.test(i.tag)||!i.node)return!1;let o=e.nodes[i.node];return o.contentMatch.matchType(o)})}parse(e,n={}){let r=new Sr(this,n,!1);return r.addAll(e,L.none,n.from,n.to),r.finish()}parseSlice(e,n={}){let r=new Sr(this,n,!0);return r.addAll(e,L.none,n.from,n.to),x.maxOpen(r.finish())}matchTag(e,n,r){for(let i=r?this.tags.indexOf(r)+1:0;i<this.tags.length;i++){let o=this.tags[i];if(tp(e,o.tag)&&(o.namespace===void 0||e.namespaceURI==o.namespace)&&(!o.context||n.matchesContext(o.context))){if(o.getAttrs){let s=o.getAttrs(e);if(s===!1)continue;o.attrs=s||void 0}return o}}}matchStyle(e,n,r,i){for(let o=i?this.styles.indexOf(i)+1:0;o<this.styles.length;o++){let s=this.styles[o],l=s.style;if(!(l.indexOf(e)!=0||s.context&&!r.matchesContext(s.context)||l.length>e.length&&(l.charCodeAt(e.length)!=61||l.slice(e.length+1)!=n))){if(s.getAttrs){let a=s.getAttrs(n);if(a===!1)continue;s.attrs=a||void 0}return s}}}static schemaRules(e){let n=[];function r(i){let o=i.priority==null?50:i.priority,s=0;for(;s<n.length;s++){let l=n[s];if((l.priority==null?50:l.priority)<o)break}n.splice(s,0,i)}for(let i in e.marks){let o=e.marks[i].spec.parseDOM;o&&o.forEach(s=>{r(s=Ka(s)),s.mark||s.ignore||s.clearMark||(s.mark=i)})}for(let i in e.nodes){let o=e.nodes[i].spec.parseDOM;o&&o.forEach(s=>{r(s=Ka(s)),s.node||s.ignore||s.mark||(s.node=i)})}return n}static fromSchema(e){return e.cached.domParser||(e.cached.domParser=new t(e,t.schemaRules(e)))}},uc={address:!0,article:!0,aside:!0,blockquote:!0,canvas:!0,dd:!0,div:!0,dl:!0,fieldset:!0,figcaption:!0,figure:!0,footer:!0,form:!0,h1:!0,h2:!0,h3:!0,h4:!0,h5:!0,h6:!0,header:!0,hgroup:!0,hr:!0,li:!0,noscript:!0,ol:!0,output:!0,p:!0,pre:!0,section:!0,table:!0,tfoot:!0,ul:!0},Zh={head:!0,noscript:!0,object:!0,script:!0,style:!0,title:!0},fc={ol:!0,ul:!0},bn=1,Oo=2,gn=4;function qa(t,e,n){return e!=null?(e?bn:0)|(e==="full"?Oo:0):t&&t.whitespace=="pre"?bn|Oo:n&~gn}var Vt=class{constructor(e,n,r,i,o,s){this.type=e,this.attrs=n,this.marks=r,this.solid=i,this.options=s,this.content=[],this.activeMarks=L.none,this.match=o||(s&gn?null:e.contentMatch)}findWrapping(e){if(!this.match){if(!this.type)return[];let n=this.type.contentMatch.fillBefore(v.from(e));if(n)this.match=this.type.contentMatch.matchFragment(n);else{let r=this.type.contentMatch,i;return(i=r.findWrapping(e.type))?(this.match=r,i):null}}return this.match.findWrapping(e.type)}finish(e){if(!(this.options&bn)){let r=this.content[this.content.length-1],i;if(r&&r.isText&&(i=/[ \t\r\n\u000c]+$/.exec(r.text))){let o=r;r.text.length==i[0].length?this.content.pop():this.content[this.content.length-1]=o.withText(o.text.slice(0,o.text.length-i[0].length))}}let n=v.from(this.content);return!e&&this.match&&(n=n.append(this.match.fillBefore(v.empty,!0))),this.type?this.type.create(this.attrs,n,this.marks):n}inlineContext(e){return this.type?this.type.inlineContent:this.content.length?this.content[0].isInline:e.parentNode&&!uc.hasOwnProperty(e.parentNode.nodeName.toLowerCase())}},Sr=class{constructor(e,n,r){this.parser=e,this.options=n,this.isOpen=r,this.open=0,this.localPreserveWS=!1;let i=n.topNode,o,s=qa(null,n.preserveWhitespace,0)|(r?gn:0);i?o=new Vt(i.type,i.attrs,L.none,!0,n.topMatch||i.type.contentMatch,s):r?o=new Vt(null,null,L.none,!0,null,s):o=new Vt(e.schema.topNodeType,null,L.none,!0,null,s),this.nodes=[o],this.find=n.findPositions,this.needsBlock=!1}get top(){return this.nodes[this.open]}addDOM(e,n){e.nodeType==3?this.addTextNode(e,n):e.nodeType==1&&this.addElement(e,n)}addTextNode(e,n){let r=e.nodeValue,i=this.top,o=i.options&Oo?"full":this.localPreserveWS||(i.options&bn)>0,{schema:s}=this.parser;if(o==="full"||i.inlineContext(e)||/[^ \t\r\n\u000c]/.test(r)){if(o)if(o==="full")r=r.replace(/\r\n?/g,`
-`);else if(s.linebreakReplacement&&/[\r\n]/.test(r)&&this.top.findWrapping(s.linebreakReplacement.create())){let l=r.split(/\r?\n|\r/);for(let a=0;a<l.length;a++)a&&this.insertNode(s.linebreakReplacement.create(),n,!0),l[a]&&this.insertNode(s.text(l[a]),n,!/\S/.test(l[a]));r=""}else r=r.replace(/\r?\n|\r/g," ");else if(r=r.replace(/[ \t\r\n\u000c]+/g," "),/^[ \t\r\n\u000c]/.test(r)&&this.open==this.nodes.length-1){let l=i.content[i.content.length-1],a=e.previousSibling;(!l||a&&a.nodeName=="BR"||l.isText&&/[ \t\r\n\u000c]$/.test(l.text))&&(r=r.slice(1))}r&&this.insertNode(s.text(r),n,!/\S/.test(r)),this.findInText(e)}else this.findInside(e)}addElement(e,n,r){let i=this.localPreserveWS,o=this.top;(e.tagName=="PRE"||/pre/.test(e.style&&e.style.whiteSpace))&&(this.localPreserveWS=!0);let s=e.nodeName.toLowerCase(),l;fc.hasOwnProperty(s)&&this.parser.normalizeLists&&ep(e);let a=this.options.ruleFromNode&&this.options.ruleFromNode(e)||(l=this.parser.matchTag(e,this,r));e:if(a?a.ignore:Zh.hasOwnProperty(s))this.findInside(e),this.ignoreFallback(e,n);else if(!a||a.skip||a.closeParent){a&&a.closeParent?this.open=Math.max(0,this.open-1):a&&a.skip.nodeType&&(e=a.skip);let c,d=this.needsBlock;if(uc.hasOwnProperty(s))o.content.length&&o.content[0].isInline&&this.open&&(this.open--,o=this.top),c=!0,o.type||(this.needsBlock=!0);else if(!e.firstChild){this.leafFallback(e,n);break e}let u=a&&a.skip?n:this.readStyles(e,n);u&&this.addAll(e,u),c&&this.sync(o),this.needsBlock=d}else{let c=this.readStyles(e,n);c&&this.addElementByRule(e,a,c,a.consuming===!1?l:void 0)}this.localPreserveWS=i}leafFallback(e,n){e.nodeName=="BR"&&this.top.type&&this.top.type.inlineContent&&this.addTextNode(e.ownerDocument.createTextNode(`
-`),n)}ignoreFallback(e,n){e.nodeName=="BR"&&(!this.top.type||!this.top.type.inlineContent)&&this.findPlace(this.parser.schema.text("-"),n,!0)}readStyles(e,n){let r=e.style;if(r&&r.length)for(let i=0;i<this.parser.matchedStyles.length;i++){let o=this.parser.matchedStyles[i],s=r.getPropertyValue(o);if(s)for(let l=void 0;;){let a=this.parser.matchStyle(o,s,this,l);if(!a)break;if(a.ignore)return null;if(a.clearMark?n=n.filter(c=>!a.clearMark(c)):n=n.concat(this.parser.schema.marks[a.mark].create(a.attrs)),a.consuming===!1)l=a;else break}}return n}addElementByRule(e,n,r,i){let o,s;if(n.node)if(s=this.parser.schema.nodes[n.node],s.isLeaf)this.insertNode(s.create(n.attrs),r,e.nodeName=="BR")||this.leafFallback(e,r);else{let a=this.enter(s,n.attrs||null,r,n.preserveWhitespace);a&&(o=!0,r=a)}else{let a=this.parser.schema.marks[n.mark];r=r.concat(a.create(n.attrs))}let l=this.top;if(s&&s.isLeaf)this.findInside(e);else if(i)this.addElement(e,r,i);else if(n.getContent)this.findInside(e),n.getContent(e,this.parser.schema).forEach(a=>this.insertNode(a,r,!1));else{let a=e;typeof n.contentElement=="string"?a=e.querySelector(n.contentElement):typeof n.contentElement=="function"?a=n.contentElement(e):n.contentElement&&(a=n.contentElement),this.findAround(e,a,!0),this.addAll(a,r),this.findAround(e,a,!1)}o&&this.sync(l)&&this.open--}addAll(e,n,r,i){let o=r||0;for(let s=r?e.childNodes[r]:e.firstChild,l=i==null?null:e.childNodes[i];s!=l;s=s.nextSibling,++o)this.findAtPoint(e,o),this.addDOM(s,n);this.findAtPoint(e,o)}findPlace(e,n,r){let i,o;for(let s=this.open,l=0;s>=0;s--){let a=this.nodes[s],c=a.findWrapping(e);if(c&&(!i||i.length>c.length+l)&&(i=c,o=a,!c.length))break;if(a.solid){if(r)break;l+=2}}if(!i)return null;this.sync(o);for(let s=0;s<i.length;s++)n=this.enterInner(i[s],null,n,!1);return n}insertNode(e,n,r){if(e.isInline&&this.needsBlock&&!this.top.type){let o=this.textblockFromContext();o&&(n=this.enterInner(o,null,n))}let i=this.findPlace(e,n,r);if(i){this.closeExtra();let o=this.top;o.match&&(o.match=o.match.matchType(e.type));let s=L.none;for(let l of i.concat(e.marks))(o.type?o.type.allowsMarkType(l.type):Ja(l.type,e.type))&&(s=l.addToSet(s));return o.content.push(e.mark(s)),!0}return!1}enter(e,n,r,i){let o=this.findPlace(e.create(n),r,!1);return o&&(o=this.enterInner(e,n,r,!0,i)),o}enterInner(e,n,r,i=!1,o){this.closeExtra();let s=this.top;s.match=s.match&&s.match.matchType(e);let l=qa(e,o,s.options);s.options&gn&&s.content.length==0&&(l|=gn);let a=L.none;return r=r.filter(c=>(s.type?s.type.allowsMarkType(c.type):Ja(c.type,e))?(a=c.addToSet(a),!1):!0),this.nodes.push(new Vt(e,n,a,i,null,l)),this.open++,r}closeExtra(e=!1){let n=this.nodes.length-1;if(n>this.open){for(;n>this.open;n--)this.nodes[n-1].content.push(this.nodes[n].finish(e));this.nodes.length=this.open+1}}finish(){return this.open=0,this.closeExtra(this.isOpen),this.nodes[0].finish(!!(this.isOpen||this.options.topOpen))}sync(e){for(let n=this.open;n>=0;n--){if(this.nodes[n]==e)return this.open=n,!0;this.localPreserveWS&&(this.nodes[n].options|=bn)}return!1}get currentPos(){this.closeExtra();let e=0;for(let n=this.open;n>=0;n--){let r=this.nodes[n].content;for(let i=r.length-1;i>=0;i--)e+=r[i].nodeSize;n&&e++}return e}findAtPoint(e,n){if(this.find)for(let r=0;r<this.find.length;r++)this.find[r].node==e&&this.find[r].offset==n&&(this.find[r].pos=this.currentPos)}findInside(e){if(this.find)for(let n=0;n<this.find.length;n++)this.find[n].pos==null&&e.nodeType==1&&e.contains(this.find[n].node)&&(this.find[n].pos=this.currentPos)}findAround(e,n,r){if(e!=n&&this.find)for(let i=0;i<this.find.length;i++)this.find[i].pos==null&&e.nodeType==1&&e.contains(this.find[i].node)&&n.compareDocumentPosition(this.find[i].node)&(r?2:4)&&(this.find[i].pos=this.currentPos)}findInText(e){if(this.find)for(let n=0;n<this.find.length;n++)this.find[n].node==e&&(this.find[n].pos=this.currentPos-(e.nodeValue.length-this.find[n].offset))}matchesContext(e){if(e.indexOf("|")>-1)return e.split(/\s*\|\s*/).some(this.matchesContext,this);let n=e.split("/"),r=this.options.context,i=!this.isOpen&&(!r||r.parent.type==this.nodes[0].type),o=-(r?r.depth+1:0)+(i?0:1),s=(l,a)=>{for(;l>=0;l--){let c=n[l];if(c==""){if(l==n.length-1||l==0)continue;for(;a>=o;a--)if(s(l-1,a))return!0;return!1}else{let d=a>0||a==0&&i?this.nodes[a].type:r&&a>=o?r.node(a-o).type:null;if(!d||d.name!=c&&!d.isInGroup(c))return!1;a--}}return!0};return s(n.length-1,this.open)}textblockFromContext(){let e=this.options.context;if(e)for(let n=e.depth;n>=0;n--){let r=e.node(n).contentMatchAt(e.indexAfter(n)).defaultType;if(r&&r.isTextblock&&r.defaultAttrs)return r}for(let n in this.parser.schema.nodes){let r=this.parser.schema.nodes[n];if(r.isTextblock&&r.defaultAttrs)return r}}};function ep(t){for(let e=t.firstChild,n=null;e;e=e.nextSibling){let r=e.nodeType==1?e.nodeName.toLowerCase():null;r&&fc.hasOwnProperty(r)&&n?(n.appendChild(e),e=n):r=="li"?n=e:r&&(n=null)}}function tp(t,e){return(t.matches||t.msMatchesSelector||t.webkitMatchesSelector||t.mozMatchesSelector).call(t,e)}function Ka(t){let e={};for(let n in t)e[n]=t[n];return e}function Ja(t,e){let n=e.schema.nodes;for(let r in n){let i=n[r];if(!i.allowsMarkType(t))continue;let o=[],s=l=>{o.push(l);for(let a=0;a<l.edgeCount;a++){let{type:c,next:d}=l.edge(a);if(c==e||o.indexOf(d)<0&&s(d))return!0}};if(s(i.contentMatch))return!0}}var Ge=class t{constructor(e,n){this.nodes=e,this.marks=n}serializeFragment(e,n={},r){r||(r=Eo(n).createDocumentFragment());let i=r,o=[];return e.forEach(s=>{if(o.length||s.marks.length){let l=0,a=0;for(;l<o.length&&a<s.marks.length;){let c=s.marks[a];if(!this.marks[c.type.name]){a++;continue}if(!c.eq(o[l][0])||c.type.spec.spanning===!1)break;l++,a++}for(;l<o.length;)i=o.pop()[1];for(;a<s.marks.length;){let c=s.marks[a++],d=this.serializeMark(c,s.isInline,n);d&&(o.push([c,i]),i.appendChild(d.dom),i=d.contentDOM||d.dom)}}i.appendChild(this.serializeNodeInner(s,n))}),r}serializeNodeInner(e,n){let{dom:r,contentDOM:i}=br(Eo(n),this.nodes[e.type.name](e),null,e.attrs);if(i){if(e.isLeaf)throw new RangeError("Content hole not allowed in a leaf node spec");this.serializeFragment(e.content,n,i)}return r}serializeNode(e,n={}){let r=this.serializeNodeInner(e,n);for(let i=e.marks.length-1;i>=0;i--){let o=this.serializeMark(e.marks[i],e.isInline,n);o&&((o.contentDOM||o.dom).appendChild(r),r=o.dom)}return r}serializeMark(e,n,r={}){let i=this.marks[e.type.name];return i&&br(Eo(r),i(e,n),null,e.attrs)}static renderSpec(e,n,r=null,i){return br(e,n,r,i)}static fromSchema(e){return e.cached.domSerializer||(e.cached.domSerializer=new t(this.nodesFromSchema(e),this.marksFromSchema(e)))}static nodesFromSchema(e){let n=Ga(e.nodes);return n.text||(n.text=r=>r.text),n}static marksFromSchema(e){return Ga(e.marks)}};function Ga(t){let e={};for(let n in t){let r=t[n].spec.toDOM;r&&(e[n]=r)}return e}function Eo(t){return t.document||window.document}var Ya=new WeakMap;function np(t){let e=Ya.get(t);return e===void 0&&Ya.set(t,e=rp(t)),e}function rp(t){let e=null;function n(r){if(r&&typeof r=="object")if(Array.isArray(r))if(typeof r[0]=="string")e||(e=[]),e.push(r);else for(let i=0;i<r.length;i++)n(r[i]);else for(let i in r)n(r[i])}return n(t),e}function br(t,e,n,r){if(typeof e=="string")return{dom:t.createTextNode(e)};if(e.nodeType!=null)return{dom:e};if(e.dom&&e.dom.nodeType!=null)return e;let i=e[0],o;if(typeof i!="string")throw new RangeError("Invalid array passed to renderSpec");if(r&&(o=np(r))&&o.indexOf(e)>-1)throw new RangeError("Using an array from an attribute object as a DOM spec. This may be an attempted cross site scripting attack.");let s=i.indexOf(" ");s>0&&(n=i.slice(0,s),i=i.slice(s+1));let l,a=n?t.createElementNS(n,i):t.createElement(i),c=e[1],d=1;if(c&&typeof c=="object"&&c.nodeType==null&&!Array.isArray(c)){d=2;for(let u in c)if(c[u]!=null){let f=u.indexOf(" ");f>0?a.setAttributeNS(u.slice(0,f),u.slice(f+1),c[u]):u=="style"&&a.style?a.style.cssText=c[u]:a.setAttribute(u,c[u])}}for(let u=d;u<e.length;u++){let f=e[u];if(f===0){if(u<e.length-1||u>d)throw new RangeError("Content hole must be the only child of its parent node");return{dom:a,contentDOM:a}}else{let{dom:h,contentDOM:p}=br(t,f,n,r);if(a.appendChild(h),p){if(l)throw new RangeError("Multiple content holes");l=p}}}return{dom:a,contentDOM:l}}var mc=65535,gc=Math.pow(2,16);function ip(t,e){return t+e*gc}function hc(t){return t&mc}function op(t){return(t-(t&mc))/gc}var yc=1,bc=2,wr=4,vc=8,xn=class{constructor(e,n,r){this.pos=e,this.delInfo=n,this.recover=r}get deleted(){return(this.delInfo&vc)>0}get deletedBefore(){return(this.delInfo&(yc|wr))>0}get deletedAfter(){return(this.delInfo&(bc|wr))>0}get deletedAcross(){return(this.delInfo&wr)>0}},Fe=class t{constructor(e,n=!1){if(this.ranges=e,this.inverted=n,!e.length&&t.empty)return t.empty}recover(e){let n=0,r=hc(e);if(!this.inverted)for(let i=0;i<r;i++)n+=this.ranges[i*3+2]-this.ranges[i*3+1];return this.ranges[r*3]+n+op(e)}mapResult(e,n=1){return this._map(e,n,!1)}map(e,n=1){return this._map(e,n,!0)}_map(e,n,r){let i=0,o=this.inverted?2:1,s=this.inverted?1:2;for(let l=0;l<this.ranges.length;l+=3){let a=this.ranges[l]-(this.inverted?i:0);if(a>e)break;let c=this.ranges[l+o],d=this.ranges[l+s],u=a+c;if(e<=u){let f=c?e==a?-1:e==u?1:n:n,h=a+i+(f<0?0:d);if(r)return h;let p=e==(n<0?a:u)?null:ip(l/3,e-a),m=e==a?bc:e==u?yc:wr;return(n<0?e!=a:e!=u)&&(m|=vc),new xn(h,m,p)}i+=d-c}return r?e+i:new xn(e+i,0,null)}touches(e,n){let r=0,i=hc(n),o=this.inverted?2:1,s=this.inverted?1:2;for(let l=0;l<this.ranges.length;l+=3){let a=this.ranges[l]-(this.inverted?r:0);if(a>e)break;let c=this.ranges[l+o],d=a+c;if(e<=d&&l==i*3)return!0;r+=this.ranges[l+s]-c}return!1}forEach(e){let n=this.inverted?2:1,r=this.inverted?1:2;for(let i=0,o=0;i<this.ranges.length;i+=3){let s=this.ranges[i],l=s-(this.inverted?o:0),a=s+(this.inverted?0:o),c=this.ranges[i+n],d=this.ranges[i+r];e(l,l+c,a,a+d),o+=d-c}}invert(){return new t(this.ranges,!this.inverted)}toString(){return(this.inverted?"-":"")+JSON.stringify(this.ranges)}static offset(e){return e==0?t.empty:new t(e<0?[0,-e,0]:[0,0,e])}};Fe.empty=new Fe([]);var Cn=class t{constructor(e,n,r=0,i=e?e.length:0){this.mirror=n,this.from=r,this.to=i,this._maps=e||[],this.ownData=!(e||n)}get maps(){return this._maps}slice(e=0,n=this.maps.length){return new t(this._maps,this.mirror,e,n)}appendMap(e,n){this.ownData||(this._maps=this._maps.slice(),this.mirror=this.mirror&&this.mirror.slice(),this.ownData=!0),this.to=this._maps.push(e),n!=null&&this.setMirror(this._maps.length-1,n)}appendMapping(e){for(let n=0,r=this._maps.length;n<e._maps.length;n++){let i=e.getMirror(n);this.appendMap(e._maps[n],i!=null&&i<n?r+i:void 0)}}getMirror(e){if(this.mirror){for(let n=0;n<this.mirror.length;n++)if(this.mirror[n]==e)return this.mirror[n+(n%2?-1:1)]}}setMirror(e,n){this.mirror||(this.mirror=[]),this.mirror.push(e,n)}appendMappingInverted(e){for(let n=e.maps.length-1,r=this._maps.length+e._maps.length;n>=0;n--){let i=e.getMirror(n);this.appendMap(e._maps[n].invert(),i!=null&&i>n?r-i-1:void 0)}}invert(){let e=new t;return e.appendMappingInverted(this),e}map(e,n=1){if(this.mirror)return this._map(e,n,!0);for(let r=this.from;r<this.to;r++)e=this._maps[r].map(e,n);return e}mapResult(e,n=1){return this._map(e,n,!1)}_map(e,n,r){let i=0;for(let o=this.from;o<this.to;o++){let s=this._maps[o],l=s.mapResult(e,n);if(l.recover!=null){let a=this.getMirror(o);if(a!=null&&a>o&&a<this.to){o=a,e=this._maps[a].recover(l.recover);continue}}i|=l.delInfo,e=l.pos}return r?e:new xn(e,i,null)}},Ro=Object.create(null),G=class{getMap(){return Fe.empty}merge(e){return null}static fromJSON(e,n){if(!n||!n.stepType)throw new RangeError("Invalid input for Step.fromJSON");let r=Ro[n.stepType];if(!r)throw new RangeError(`No step type ${n.stepType} defined`);return r.fromJSON(e,n)}static jsonID(e,n){if(e in Ro)throw new RangeError("Duplicate use of step JSON ID "+e);return Ro[e]=n,n.prototype.jsonID=e,n}},Y=class t{constructor(e,n){this.doc=e,this.failed=n}static ok(e){return new t(e,null)}static fail(e){return new t(null,e)}static fromReplace(e,n,r,i){try{return t.ok(e.replace(n,r,i))}catch(o){if(o instanceof yt)return t.fail(o.message);throw o}}};function Do(t,e,n){let r=[];for(let i=0;i<t.childCount;i++){let o=t.child(i);o.content.size&&(o=o.copy(Do(o.content,e,o))),o.isInline&&(o=e(o,n,i)),r.push(o)}return v.fromArray(r)}var En=class t extends G{constructor(e,n,r){super(),this.from=e,this.to=n,this.mark=r}apply(e){let n=e.slice(this.from,this.to),r=e.resolve(this.from),i=r.node(r.sharedDepth(this.to)),o=new x(Do(n.content,(s,l)=>!s.isAtom||!l.type.allowsMarkType(this.mark.type)?s:s.mark(this.mark.addToSet(s.marks)),i),n.openStart,n.openEnd);return Y.fromReplace(e,this.from,this.to,o)}invert(){return new kt(this.from,this.to,this.mark)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deleted&&r.deleted||n.pos>=r.pos?null:new t(n.pos,r.pos,this.mark)}merge(e){return e instanceof t&&e.mark.eq(this.mark)&&this.from<=e.to&&this.to>=e.from?new t(Math.min(this.from,e.from),Math.max(this.to,e.to),this.mark):null}toJSON(){return{stepType:"addMark",mark:this.mark.toJSON(),from:this.from,to:this.to}}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for AddMarkStep.fromJSON");return new t(n.from,n.to,e.markFromJSON(n.mark))}};G.jsonID("addMark",En);var kt=class t extends G{constructor(e,n,r){super(),this.from=e,this.to=n,this.mark=r}apply(e){let n=e.slice(this.from,this.to),r=new x(Do(n.content,i=>i.mark(this.mark.removeFromSet(i.marks)),e),n.openStart,n.openEnd);return Y.fromReplace(e,this.from,this.to,r)}invert(){return new En(this.from,this.to,this.mark)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deleted&&r.deleted||n.pos>=r.pos?null:new t(n.pos,r.pos,this.mark)}merge(e){return e instanceof t&&e.mark.eq(this.mark)&&this.from<=e.to&&this.to>=e.from?new t(Math.min(this.from,e.from),Math.max(this.to,e.to),this.mark):null}toJSON(){return{stepType:"removeMark",mark:this.mark.toJSON(),from:this.from,to:this.to}}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for RemoveMarkStep.fromJSON");return new t(n.from,n.to,e.markFromJSON(n.mark))}};G.jsonID("removeMark",kt);var Sn=class t extends G{constructor(e,n){super(),this.pos=e,this.mark=n}apply(e){let n=e.nodeAt(this.pos);if(!n)return Y.fail("No node at mark step's position");let r=n.type.create(n.attrs,null,this.mark.addToSet(n.marks));return Y.fromReplace(e,this.pos,this.pos+1,new x(v.from(r),0,n.isLeaf?0:1))}invert(e){let n=e.nodeAt(this.pos);if(n){let r=this.mark.addToSet(n.marks);if(r.length==n.marks.length){for(let i=0;i<n.marks.length;i++)if(!n.marks[i].isInSet(r))return new t(this.pos,n.marks[i]);return new t(this.pos,this.mark)}}return new $t(this.pos,this.mark)}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.mark)}toJSON(){return{stepType:"addNodeMark",pos:this.pos,mark:this.mark.toJSON()}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for AddNodeMarkStep.fromJSON");return new t(n.pos,e.markFromJSON(n.mark))}};G.jsonID("addNodeMark",Sn);var $t=class t extends G{constructor(e,n){super(),this.pos=e,this.mark=n}apply(e){let n=e.nodeAt(this.pos);if(!n)return Y.fail("No node at mark step's position");let r=n.type.create(n.attrs,null,this.mark.removeFromSet(n.marks));return Y.fromReplace(e,this.pos,this.pos+1,new x(v.from(r),0,n.isLeaf?0:1))}invert(e){let n=e.nodeAt(this.pos);return!n||!this.mark.isInSet(n.marks)?this:new Sn(this.pos,this.mark)}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.mark)}toJSON(){return{stepType:"removeNodeMark",pos:this.pos,mark:this.mark.toJSON()}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for RemoveNodeMarkStep.fromJSON");return new t(n.pos,e.markFromJSON(n.mark))}};G.jsonID("removeNodeMark",$t);var ne=class t extends G{constructor(e,n,r,i=!1){super(),this.from=e,this.to=n,this.slice=r,this.structure=i}apply(e){return this.structure&&_o(e,this.from,this.to)?Y.fail("Structure replace would overwrite content"):Y.fromReplace(e,this.from,this.to,this.slice)}getMap(){return new Fe([this.from,this.to-this.from,this.slice.size])}invert(e){return new t(this.from,this.from+this.slice.size,e.slice(this.from,this.to))}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deletedAcross&&r.deletedAcross?null:new t(n.pos,Math.max(n.pos,r.pos),this.slice,this.structure)}merge(e){if(!(e instanceof t)||e.structure||this.structure)return null;if(this.from+this.slice.size==e.from&&!this.slice.openEnd&&!e.slice.openStart){let n=this.slice.size+e.slice.size==0?x.empty:new x(this.slice.content.append(e.slice.content),this.slice.openStart,e.slice.openEnd);return new t(this.from,this.to+(e.to-e.from),n,this.structure)}else if(e.to==this.from&&!this.slice.openStart&&!e.slice.openEnd){let n=this.slice.size+e.slice.size==0?x.empty:new x(e.slice.content.append(this.slice.content),e.slice.openStart,this.slice.openEnd);return new t(e.from,this.to,n,this.structure)}else return null}toJSON(){let e={stepType:"replace",from:this.from,to:this.to};return this.slice.size&&(e.slice=this.slice.toJSON()),this.structure&&(e.structure=!0),e}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for ReplaceStep.fromJSON");return new t(n.from,n.to,x.fromJSON(e,n.slice),!!n.structure)}};G.jsonID("replace",ne);var W=class t extends G{constructor(e,n,r,i,o,s,l=!1){super(),this.from=e,this.to=n,this.gapFrom=r,this.gapTo=i,this.slice=o,this.insert=s,this.structure=l}apply(e){if(this.structure&&(_o(e,this.from,this.gapFrom)||_o(e,this.gapTo,this.to)))return Y.fail("Structure gap-replace would overwrite content");let n=e.slice(this.gapFrom,this.gapTo);if(n.openStart||n.openEnd)return Y.fail("Gap is not a flat range");let r=this.slice.insertAt(this.insert,n.content);return r?Y.fromReplace(e,this.from,this.to,r):Y.fail("Content does not fit in gap")}getMap(){return new Fe([this.from,this.gapFrom-this.from,this.insert,this.gapTo,this.to-this.gapTo,this.slice.size-this.insert])}invert(e){let n=this.gapTo-this.gapFrom;return new t(this.from,this.from+this.slice.size+n,this.from+this.insert,this.from+this.insert+n,e.slice(this.from,this.to).removeBetween(this.gapFrom-this.from,this.gapTo-this.from),this.gapFrom-this.from,this.structure)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1),i=this.from==this.gapFrom?n.pos:e.map(this.gapFrom,-1),o=this.to==this.gapTo?r.pos:e.map(this.gapTo,1);return n.deletedAcross&&r.deletedAcross||i<n.pos||o>r.pos?null:new t(n.pos,r.pos,i,o,this.slice,this.insert,this.structure)}toJSON(){let e={stepType:"replaceAround",from:this.from,to:this.to,gapFrom:this.gapFrom,gapTo:this.gapTo,insert:this.insert};return this.slice.size&&(e.slice=this.slice.toJSON()),this.structure&&(e.structure=!0),e}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number"||typeof n.gapFrom!="number"||typeof n.gapTo!="number"||typeof n.insert!="number")throw new RangeError("Invalid input for ReplaceAroundStep.fromJSON");return new t(n.from,n.to,n.gapFrom,n.gapTo,x.fromJSON(e,n.slice),n.insert,!!n.structure)}};G.jsonID("replaceAround",W);function _o(t,e,n){let r=t.resolve(e),i=n-e,o=r.depth;for(;i>0&&o>0&&r.indexAfter(o)==r.node(o).childCount;)o--,i--;if(i>0){let s=r.node(o).maybeChild(r.indexAfter(o));for(;i>0;){if(!s||s.isLeaf)return!0;s=s.firstChild,i--}}return!1}function sp(t,e,n,r){let i=[],o=[],s,l;t.doc.nodesBetween(e,n,(a,c,d)=>{if(!a.isInline)return;let u=a.marks;if(!r.isInSet(u)&&d.type.allowsMarkType(r.type)){let f=Math.max(c,e),h=Math.min(c+a.nodeSize,n),p=r.addToSet(u);for(let m=0;m<u.length;m++)u[m].isInSet(p)||(s&&s.to==f&&s.mark.eq(u[m])?s.to=h:i.push(s=new kt(f,h,u[m])));l&&l.to==f?l.to=h:o.push(l=new En(f,h,r))}}),i.forEach(a=>t.step(a)),o.forEach(a=>t.step(a))}function lp(t,e,n,r){let i=[],o=0;t.doc.nodesBetween(e,n,(s,l)=>{if(!s.isInline)return;o++;let a=null;if(r instanceof yn){let c=s.marks,d;for(;d=r.isInSet(c);)(a||(a=[])).push(d),c=d.removeFromSet(c)}else r?r.isInSet(s.marks)&&(a=[r]):a=s.marks;if(a&&a.length){let c=Math.min(l+s.nodeSize,n);for(let d=0;d<a.length;d++){let u=a[d],f;for(let h=0;h<i.length;h++){let p=i[h];p.step==o-1&&u.eq(i[h].style)&&(f=p)}f?(f.to=c,f.step=o):i.push({style:u,from:Math.max(l,e),to:c,step:o})}}}),i.forEach(s=>t.step(new kt(s.from,s.to,s.style)))}function Po(t,e,n,r=n.contentMatch,i=!0){let o=t.doc.nodeAt(e),s=[],l=e+1;for(let a=0;a<o.childCount;a++){let c=o.child(a),d=l+c.nodeSize,u=r.matchType(c.type);if(!u)s.push(new ne(l,d,x.empty));else{r=u;for(let f=0;f<c.marks.length;f++)n.allowsMarkType(c.marks[f].type)||t.step(new kt(l,d,c.marks[f]));if(i&&c.isText&&n.whitespace!="pre"){let f,h=/\r?\n|\r/g,p;for(;f=h.exec(c.text);)p||(p=new x(v.from(n.schema.text(" ",n.allowedMarks(c.marks))),0,0)),s.push(new ne(l+f.index,l+f.index+f[0].length,p))}}l=d}if(!r.validEnd){let a=r.fillBefore(v.empty,!0);t.replace(l,l,new x(a,0,0))}for(let a=s.length-1;a>=0;a--)t.step(s[a])}function ap(t,e,n){return(e==0||t.canReplace(e,t.childCount))&&(n==t.childCount||t.canReplace(0,n))}function Be(t){let n=t.parent.content.cutByIndex(t.startIndex,t.endIndex);for(let r=t.depth;;--r){let i=t.$from.node(r),o=t.$from.index(r),s=t.$to.indexAfter(r);if(r<t.depth&&i.canReplace(o,s,n))return r;if(r==0||i.type.spec.isolating||!ap(i,o,s))break}return null}function cp(t,e,n){let{$from:r,$to:i,depth:o}=e,s=r.before(o+1),l=i.after(o+1),a=s,c=l,d=v.empty,u=0;for(let p=o,m=!1;p>n;p--)m||r.index(p)>0?(m=!0,d=v.from(r.node(p).copy(d)),u++):a--;let f=v.empty,h=0;for(let p=o,m=!1;p>n;p--)m||i.after(p+1)<i.end(p)?(m=!0,f=v.from(i.node(p).copy(f)),h++):c++;t.step(new W(a,c,s,l,new x(d.append(f),u,h),d.size-u,!0))}function Ut(t,e,n=null,r=t){let i=dp(t,e),o=i&&up(r,e);return o?i.map(pc).concat({type:e,attrs:n}).concat(o.map(pc)):null}function pc(t){return{type:t,attrs:null}}function dp(t,e){let{parent:n,startIndex:r,endIndex:i}=t,o=n.contentMatchAt(r).findWrapping(e);if(!o)return null;let s=o.length?o[0]:e;return n.canReplaceWith(r,i,s)?o:null}function up(t,e){let{parent:n,startIndex:r,endIndex:i}=t,o=n.child(r),s=e.contentMatch.findWrapping(o.type);if(!s)return null;let a=(s.length?s[s.length-1]:e).contentMatch;for(let c=r;a&&c<i;c++)a=a.matchType(n.child(c).type);return!a||!a.validEnd?null:s}function fp(t,e,n){let r=v.empty;for(let s=n.length-1;s>=0;s--){if(r.size){let l=n[s].type.contentMatch.matchFragment(r);if(!l||!l.validEnd)throw new RangeError("Wrapper type given to Transform.wrap does not form valid content of its parent wrapper")}r=v.from(n[s].type.create(n[s].attrs,r))}let i=e.start,o=e.end;t.step(new W(i,o,i,o,new x(r,0,0),n.length,!0))}function hp(t,e,n,r,i){if(!r.isTextblock)throw new RangeError("Type given to setBlockType should be a textblock");let o=t.steps.length;t.doc.nodesBetween(e,n,(s,l)=>{let a=typeof i=="function"?i(s):i;if(s.isTextblock&&!s.hasMarkup(r,a)&&pp(t.doc,t.mapping.slice(o).map(l),r)){let c=null;if(r.schema.linebreakReplacement){let h=r.whitespace=="pre",p=!!r.contentMatch.matchType(r.schema.linebreakReplacement);h&&!p?c=!1:!h&&p&&(c=!0)}c===!1&&xc(t,s,l,o),Po(t,t.mapping.slice(o).map(l,1),r,void 0,c===null);let d=t.mapping.slice(o),u=d.map(l,1),f=d.map(l+s.nodeSize,1);return t.step(new W(u,f,u+1,f-1,new x(v.from(r.create(a,null,s.marks)),0,0),1,!0)),c===!0&&kc(t,s,l,o),!1}})}function kc(t,e,n,r){e.forEach((i,o)=>{if(i.isText){let s,l=/\r?\n|\r/g;for(;s=l.exec(i.text);){let a=t.mapping.slice(r).map(n+1+o+s.index);t.replaceWith(a,a+1,e.type.schema.linebreakReplacement.create())}}})}function xc(t,e,n,r){e.forEach((i,o)=>{if(i.type==i.type.schema.linebreakReplacement){let s=t.mapping.slice(r).map(n+1+o);t.replaceWith(s,s+1,e.type.schema.text(`
-`))}})}function pp(t,e,n){let r=t.resolve(e),i=r.index();return r.parent.canReplaceWith(i,i+1,n)}function mp(t,e,n,r,i){let o=t.doc.nodeAt(e);if(!o)throw new RangeError("No node at given position");n||(n=o.type);let s=n.create(r,null,i||o.marks);if(o.isLeaf)return t.replaceWith(e,e+o.nodeSize,s);if(!n.validContent(o.content))throw new RangeError("Invalid content for node type "+n.name);t.step(new W(e,e+o.nodeSize,e+1,e+o.nodeSize-1,new x(v.from(s),0,0),1,!0))}function ge(t,e,n=1,r){let i=t.resolve(e),o=i.depth-n,s=r&&r[r.length-1]||i.parent;if(o<0||i.parent.type.spec.isolating||!i.parent.canReplace(i.index(),i.parent.childCount)||!s.type.validContent(i.parent.content.cutByIndex(i.index(),i.parent.childCount)))return!1;for(let c=i.depth-1,d=n-2;c>o;c--,d--){let u=i.node(c),f=i.index(c);if(u.type.spec.isolating)return!1;let h=u.content.cutByIndex(f,u.childCount),p=r&&r[d+1];p&&(h=h.replaceChild(0,p.type.create(p.attrs)));let m=r&&r[d]||u;if(!u.canReplace(f+1,u.childCount)||!m.type.validContent(h))return!1}let l=i.indexAfter(o),a=r&&r[0];return i.node(o).canReplaceWith(l,l,a?a.type:i.node(o+1).type)}function gp(t,e,n=1,r){let i=t.doc.resolve(e),o=v.empty,s=v.empty;for(let l=i.depth,a=i.depth-n,c=n-1;l>a;l--,c--){o=v.from(i.node(l).copy(o));let d=r&&r[c];s=v.from(d?d.type.create(d.attrs,s):i.node(l).copy(s))}t.step(new ne(e,e,new x(o.append(s),n,n),!0))}function Me(t,e){let n=t.resolve(e),r=n.index();return Cc(n.nodeBefore,n.nodeAfter)&&n.parent.canReplace(r,r+1)}function yp(t,e){e.content.size||t.type.compatibleContent(e.type);let n=t.contentMatchAt(t.childCount),{linebreakReplacement:r}=t.type.schema;for(let i=0;i<e.childCount;i++){let o=e.child(i),s=o.type==r?t.type.schema.nodes.text:o.type;if(n=n.matchType(s),!n||!t.type.allowsMarks(o.marks))return!1}return n.validEnd}function Cc(t,e){return!!(t&&e&&!t.isLeaf&&yp(t,e))}function Wt(t,e,n=-1){let r=t.resolve(e);for(let i=r.depth;;i--){let o,s,l=r.index(i);if(i==r.depth?(o=r.nodeBefore,s=r.nodeAfter):n>0?(o=r.node(i+1),l++,s=r.node(i).maybeChild(l)):(o=r.node(i).maybeChild(l-1),s=r.node(i+1)),o&&!o.isTextblock&&Cc(o,s)&&r.node(i).canReplace(l,l+1))return e;if(i==0)break;e=n<0?r.before(i):r.after(i)}}function bp(t,e,n){let r=null,{linebreakReplacement:i}=t.doc.type.schema,o=t.doc.resolve(e-n),s=o.node().type;if(i&&s.inlineContent){let d=s.whitespace=="pre",u=!!s.contentMatch.matchType(i);d&&!u?r=!1:!d&&u&&(r=!0)}let l=t.steps.length;if(r===!1){let d=t.doc.resolve(e+n);xc(t,d.node(),d.before(),l)}s.inlineContent&&Po(t,e+n-1,s,o.node().contentMatchAt(o.index()),r==null);let a=t.mapping.slice(l),c=a.map(e-n);if(t.step(new ne(c,a.map(e+n,-1),x.empty,!0)),r===!0){let d=t.doc.resolve(c);kc(t,d.node(),d.before(),t.steps.length)}return t}function vp(t,e,n){let r=t.resolve(e);if(r.parent.canReplaceWith(r.index(),r.index(),n))return e;if(r.parentOffset==0)for(let i=r.depth-1;i>=0;i--){let o=r.index(i);if(r.node(i).canReplaceWith(o,o,n))return r.before(i+1);if(o>0)return null}if(r.parentOffset==r.parent.content.size)for(let i=r.depth-1;i>=0;i--){let o=r.indexAfter(i);if(r.node(i).canReplaceWith(o,o,n))return r.after(i+1);if(o<r.node(i).childCount)return null}return null}function Ar(t,e,n){let r=t.resolve(e);if(!n.content.size)return e;let i=n.content;for(let o=0;o<n.openStart;o++)i=i.firstChild.content;for(let o=1;o<=(n.openStart==0&&n.size?2:1);o++)for(let s=r.depth;s>=0;s--){let l=s==r.depth?0:r.pos<=(r.start(s+1)+r.end(s+1))/2?-1:1,a=r.index(s)+(l>0?1:0),c=r.node(s),d=!1;if(o==1)d=c.canReplace(a,a,i);else{let u=c.contentMatchAt(a).findWrapping(i.firstChild.type);d=u&&c.canReplaceWith(a,a,u[0])}if(d)return l==0?r.pos:l<0?r.before(s+1):r.after(s+1)}return null}function Mn(t,e,n=e,r=x.empty){if(e==n&&!r.size)return null;let i=t.resolve(e),o=t.resolve(n);return Ec(i,o,r)?new ne(e,n,r):new Io(i,o,r).fit()}function Ec(t,e,n){return!n.openStart&&!n.openEnd&&t.start()==e.start()&&t.parent.canReplace(t.index(),e.index(),n.content)}var Io=class{constructor(e,n,r){this.$from=e,this.$to=n,this.unplaced=r,this.frontier=[],this.placed=v.empty;for(let i=0;i<=e.depth;i++){let o=e.node(i);this.frontier.push({type:o.type,match:o.contentMatchAt(e.indexAfter(i))})}for(let i=e.depth;i>0;i--)this.placed=v.from(e.node(i).copy(this.placed))}get depth(){return this.frontier.length-1}fit(){for(;this.unplaced.size;){let c=this.findFittable();c?this.placeNodes(c):this.openMore()||this.dropNode()}let e=this.mustMoveInline(),n=this.placed.size-this.depth-this.$from.depth,r=this.$from,i=this.close(e<0?this.$to:r.doc.resolve(e));if(!i)return null;let o=this.placed,s=r.depth,l=i.depth;for(;s&&l&&o.childCount==1;)o=o.firstChild.content,s--,l--;let a=new x(o,s,l);return e>-1?new W(r.pos,e,this.$to.pos,this.$to.end(),a,n):a.size||r.pos!=this.$to.pos?new ne(r.pos,i.pos,a):null}findFittable(){let e=this.unplaced.openStart;for(let n=this.unplaced.content,r=0,i=this.unplaced.openEnd;r<e;r++){let o=n.firstChild;if(n.childCount>1&&(i=0),o.type.spec.isolating&&i<=r){e=r;break}n=o.content}for(let n=1;n<=2;n++)for(let r=n==1?e:this.unplaced.openStart;r>=0;r--){let i,o=null;r?(o=No(this.unplaced.content,r-1).firstChild,i=o.content):i=this.unplaced.content;let s=i.firstChild;for(let l=this.depth;l>=0;l--){let{type:a,match:c}=this.frontier[l],d,u=null;if(n==1&&(s?c.matchType(s.type)||(u=c.fillBefore(v.from(s),!1)):o&&a.compatibleContent(o.type)))return{sliceDepth:r,frontierDepth:l,parent:o,inject:u};if(n==2&&s&&(d=c.findWrapping(s.type)))return{sliceDepth:r,frontierDepth:l,parent:o,wrap:d};if(o&&c.matchType(o.type))break}}}openMore(){let{content:e,openStart:n,openEnd:r}=this.unplaced,i=No(e,n);return!i.childCount||i.firstChild.isLeaf?!1:(this.unplaced=new x(e,n+1,Math.max(r,i.size+n>=e.size-r?n+1:0)),!0)}dropNode(){let{content:e,openStart:n,openEnd:r}=this.unplaced,i=No(e,n);if(i.childCount<=1&&n>0){let o=e.size-n<=n+i.size;this.unplaced=new x(vn(e,n-1,1),n-1,o?n-1:r)}else this.unplaced=new x(vn(e,n,1),n,r)}placeNodes({sliceDepth:e,frontierDepth:n,parent:r,inject:i,wrap:o}){for(;this.depth>n;)this.closeFrontierNode();if(o)for(let m=0;m<o.length;m++)this.openFrontierNode(o[m]);let s=this.unplaced,l=r?r.content:s.content,a=s.openStart-e,c=0,d=[],{match:u,type:f}=this.frontier[n];if(i){for(let m=0;m<i.childCount;m++)d.push(i.child(m));u=u.matchFragment(i)}let h=l.size+e-(s.content.size-s.openEnd);for(;c<l.childCount;){let m=l.child(c),g=u.matchType(m.type);if(!g)break;c++,(c>1||a==0||m.content.size)&&(u=g,d.push(Sc(m.mark(f.allowedMarks(m.marks)),c==1?a:0,c==l.childCount?h:-1)))}let p=c==l.childCount;p||(h=-1),this.placed=kn(this.placed,n,v.from(d)),this.frontier[n].match=u,p&&h<0&&r&&r.type==this.frontier[this.depth].type&&this.frontier.length>1&&this.closeFrontierNode();for(let m=0,g=l;m<h;m++){let y=g.lastChild;this.frontier.push({type:y.type,match:y.contentMatchAt(y.childCount)}),g=y.content}this.unplaced=p?e==0?x.empty:new x(vn(s.content,e-1,1),e-1,h<0?s.openEnd:e-1):new x(vn(s.content,e,c),s.openStart,s.openEnd)}mustMoveInline(){if(!this.$to.parent.isTextblock)return-1;let e=this.frontier[this.depth],n;if(!e.type.isTextblock||!Lo(this.$to,this.$to.depth,e.type,e.match,!1)||this.$to.depth==this.depth&&(n=this.findCloseLevel(this.$to))&&n.depth==this.depth)return-1;let{depth:r}=this.$to,i=this.$to.after(r);for(;r>1&&i==this.$to.end(--r);)++i;return i}findCloseLevel(e){e:for(let n=Math.min(this.depth,e.depth);n>=0;n--){let{match:r,type:i}=this.frontier[n],o=n<e.depth&&e.end(n+1)==e.pos+(e.depth-(n+1)),s=Lo(e,n,i,r,o);if(s){for(let l=n-1;l>=0;l--){let{match:a,type:c}=this.frontier[l],d=Lo(e,l,c,a,!0);if(!d||d.childCount)continue e}return{depth:n,fit:s,move:o?e.doc.resolve(e.after(n+1)):e}}}}close(e){let n=this.findCloseLevel(e);if(!n)return null;for(;this.depth>n.depth;)this.closeFrontierNode();n.fit.childCount&&(this.placed=kn(this.placed,n.depth,n.fit)),e=n.move;for(let r=n.depth+1;r<=e.depth;r++){let i=e.node(r),o=i.type.contentMatch.fillBefore(i.content,!0,e.index(r));this.openFrontierNode(i.type,i.attrs,o)}return e}openFrontierNode(e,n=null,r){let i=this.frontier[this.depth];i.match=i.match.matchType(e),this.placed=kn(this.placed,this.depth,v.from(e.create(n,r))),this.frontier.push({type:e,match:e.contentMatch})}closeFrontierNode(){let n=this.frontier.pop().match.fillBefore(v.empty,!0);n.childCount&&(this.placed=kn(this.placed,this.frontier.length,n))}};function vn(t,e,n){return e==0?t.cutByIndex(n,t.childCount):t.replaceChild(0,t.firstChild.copy(vn(t.firstChild.content,e-1,n)))}function kn(t,e,n){return e==0?t.append(n):t.replaceChild(t.childCount-1,t.lastChild.copy(kn(t.lastChild.content,e-1,n)))}function No(t,e){for(let n=0;n<e;n++)t=t.firstChild.content;return t}function Sc(t,e,n){if(e<=0)return t;let r=t.content;return e>1&&(r=r.replaceChild(0,Sc(r.firstChild,e-1,r.childCount==1?n-1:0))),e>0&&(r=t.type.contentMatch.fillBefore(r).append(r),n<=0&&(r=r.append(t.type.contentMatch.matchFragment(r).fillBefore(v.empty,!0)))),t.copy(r)}function Lo(t,e,n,r,i){let o=t.node(e),s=i?t.indexAfter(e):t.index(e);if(s==o.childCount&&!n.compatibleContent(o.type))return null;let l=r.fillBefore(o.content,!0,s);return l&&!kp(n,o.content,s)?l:null}function kp(t,e,n){for(let r=n;r<e.childCount;r++)if(!t.allowsMarks(e.child(r).marks))return!0;return!1}function xp(t){return t.spec.defining||t.spec.definingForContent}function Cp(t,e,n,r){if(!r.size)return t.deleteRange(e,n);let i=t.doc.resolve(e),o=t.doc.resolve(n);if(Ec(i,o,r))return t.step(new ne(e,n,r));let s=Mc(i,t.doc.resolve(n));s[s.length-1]==0&&s.pop();let l=-(i.depth+1);s.unshift(l);for(let f=i.depth,h=i.pos-1;f>0;f--,h--){let p=i.node(f).type.spec;if(p.defining||p.definingAsContext||p.isolating)break;s.indexOf(f)>-1?l=f:i.before(f)==h&&s.splice(1,0,-f)}let a=s.indexOf(l),c=[],d=r.openStart;for(let f=r.content,h=0;;h++){let p=f.firstChild;if(c.push(p),h==r.openStart)break;f=p.content}for(let f=d-1;f>=0;f--){let h=c[f],p=xp(h.type);if(p&&!h.sameMarkup(i.node(Math.abs(l)-1)))d=f;else if(p||!h.type.isTextblock)break}for(let f=r.openStart;f>=0;f--){let h=(f+d+1)%(r.openStart+1),p=c[h];if(p)for(let m=0;m<s.length;m++){let g=s[(m+a)%s.length],y=!0;g<0&&(y=!1,g=-g);let k=i.node(g-1),E=i.index(g-1);if(k.canReplaceWith(E,E,p.type,p.marks))return t.replace(i.before(g),y?o.after(g):n,new x(wc(r.content,0,r.openStart,h),h,r.openEnd))}}let u=t.steps.length;for(let f=s.length-1;f>=0&&(t.replace(e,n,r),!(t.steps.length>u));f--){let h=s[f];h<0||(e=i.before(h),n=o.after(h))}}function wc(t,e,n,r,i){if(e<n){let o=t.firstChild;t=t.replaceChild(0,o.copy(wc(o.content,e+1,n,r,o)))}if(e>r){let o=i.contentMatchAt(0),s=o.fillBefore(t).append(t);t=s.append(o.matchFragment(s).fillBefore(v.empty,!0))}return t}function Ep(t,e,n,r){if(!r.isInline&&e==n&&t.doc.resolve(e).parent.content.size){let i=vp(t.doc,e,r.type);i!=null&&(e=n=i)}t.replaceRange(e,n,new x(v.from(r),0,0))}function Sp(t,e,n){let r=t.doc.resolve(e),i=t.doc.resolve(n),o=Mc(r,i);for(let s=0;s<o.length;s++){let l=o[s],a=s==o.length-1;if(a&&l==0||r.node(l).type.contentMatch.validEnd)return t.delete(r.start(l),i.end(l));if(l>0&&(a||r.node(l-1).canReplace(r.index(l-1),i.indexAfter(l-1))))return t.delete(r.before(l),i.after(l))}for(let s=1;s<=r.depth&&s<=i.depth;s++)if(e-r.start(s)==r.depth-s&&n>r.end(s)&&i.end(s)-n!=i.depth-s&&r.start(s-1)==i.start(s-1)&&r.node(s-1).canReplace(r.index(s-1),i.index(s-1)))return t.delete(r.before(s),n);t.delete(e,n)}function Mc(t,e){let n=[],r=Math.min(t.depth,e.depth);for(let i=r;i>=0;i--){let o=t.start(i);if(o<t.pos-(t.depth-i)||e.end(i)>e.pos+(e.depth-i)||t.node(i).type.spec.isolating||e.node(i).type.spec.isolating)break;(o==e.start(i)||i==t.depth&&i==e.depth&&t.parent.inlineContent&&e.parent.inlineContent&&i&&e.start(i-1)==o-1)&&n.push(i)}return n}var Mr=class t extends G{constructor(e,n,r){super(),this.pos=e,this.attr=n,this.value=r}apply(e){let n=e.nodeAt(this.pos);if(!n)return Y.fail("No node at attribute step's position");let r=Object.create(null);for(let o in n.attrs)r[o]=n.attrs[o];r[this.attr]=this.value;let i=n.type.create(r,null,n.marks);return Y.fromReplace(e,this.pos,this.pos+1,new x(v.from(i),0,n.isLeaf?0:1))}getMap(){return Fe.empty}invert(e){return new t(this.pos,this.attr,e.nodeAt(this.pos).attrs[this.attr])}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.attr,this.value)}toJSON(){return{stepType:"attr",pos:this.pos,attr:this.attr,value:this.value}}static fromJSON(e,n){if(typeof n.pos!="number"||typeof n.attr!="string")throw new RangeError("Invalid input for AttrStep.fromJSON");return new t(n.pos,n.attr,n.value)}};G.jsonID("attr",Mr);var Tr=class t extends G{constructor(e,n){super(),this.attr=e,this.value=n}apply(e){let n=Object.create(null);for(let i in e.attrs)n[i]=e.attrs[i];n[this.attr]=this.value;let r=e.type.create(n,e.content,e.marks);return Y.ok(r)}getMap(){return Fe.empty}invert(e){return new t(this.attr,e.attrs[this.attr])}map(e){return this}toJSON(){return{stepType:"docAttr",attr:this.attr,value:this.value}}static fromJSON(e,n){if(typeof n.attr!="string")throw new RangeError("Invalid input for DocAttrStep.fromJSON");return new t(n.attr,n.value)}};G.jsonID("docAttr",Tr);var jt=class extends Error{};jt=function t(e){let n=Error.call(this,e);return n.__proto__=t.prototype,n};jt.prototype=Object.create(Error.prototype);jt.prototype.constructor=jt;jt.prototype.name="TransformError";var wn=class{constructor(e){this.doc=e,this.steps=[],this.docs=[],this.mapping=new Cn}get before(){return this.docs.length?this.docs[0]:this.doc}step(e){let n=this.maybeStep(e);if(n.failed)throw new jt(n.failed);return this}maybeStep(e){let n=e.apply(this.doc);return n.failed||this.addStep(e,n.doc),n}get docChanged(){return this.steps.length>0}addStep(e,n){this.docs.push(this.doc),this.steps.push(e),this.mapping.appendMap(e.getMap()),this.doc=n}replace(e,n=e,r=x.empty){let i=Mn(this.doc,e,n,r);return i&&this.step(i),this}replaceWith(e,n,r){return this.replace(e,n,new x(v.from(r),0,0))}delete(e,n){return this.replace(e,n,x.empty)}insert(e,n){return this.replaceWith(e,e,n)}replaceRange(e,n,r){return Cp(this,e,n,r),this}replaceRangeWith(e,n,r){return Ep(this,e,n,r),this}deleteRange(e,n){return Sp(this,e,n),this}lift(e,n){return cp(this,e,n),this}join(e,n=1){return bp(this,e,n),this}wrap(e,n){return fp(this,e,n),this}setBlockType(e,n=e,r,i=null){return hp(this,e,n,r,i),this}setNodeMarkup(e,n,r=null,i){return mp(this,e,n,r,i),this}setNodeAttribute(e,n,r){return this.step(new Mr(e,n,r)),this}setDocAttribute(e,n){return this.step(new Tr(e,n)),this}addNodeMark(e,n){return this.step(new Sn(e,n)),this}removeNodeMark(e,n){let r=this.doc.nodeAt(e);if(!r)throw new RangeError("No node at position "+e);if(n instanceof L)n.isInSet(r.marks)&&this.step(new $t(e,n));else{let i=r.marks,o,s=[];for(;o=n.isInSet(i);)s.push(new $t(e,o)),i=o.removeFromSet(i);for(let l=s.length-1;l>=0;l--)this.step(s[l])}return this}split(e,n=1,r){return gp(this,e,n,r),this}addMark(e,n,r){return sp(this,e,n,r),this}removeMark(e,n,r){return lp(this,e,n,r),this}clearIncompatible(e,n,r){return Po(this,e,n,r),this}};var Ho=Object.create(null),M=class{constructor(e,n,r){this.$anchor=e,this.$head=n,this.ranges=r||[new Or(e.min(n),e.max(n))]}get anchor(){return this.$anchor.pos}get head(){return this.$head.pos}get from(){return this.$from.pos}get to(){return this.$to.pos}get $from(){return this.ranges[0].$from}get $to(){return this.ranges[0].$to}get empty(){let e=this.ranges;for(let n=0;n<e.length;n++)if(e[n].$from.pos!=e[n].$to.pos)return!1;return!0}content(){return this.$from.doc.slice(this.from,this.to,!0)}replace(e,n=x.empty){let r=n.content.lastChild,i=null;for(let l=0;l<n.openEnd;l++)i=r,r=r.lastChild;let o=e.steps.length,s=this.ranges;for(let l=0;l<s.length;l++){let{$from:a,$to:c}=s[l],d=e.mapping.slice(o);e.replaceRange(d.map(a.pos),d.map(c.pos),l?x.empty:n),l==0&&Oc(e,o,(r?r.isInline:i&&i.isTextblock)?-1:1)}}replaceWith(e,n){let r=e.steps.length,i=this.ranges;for(let o=0;o<i.length;o++){let{$from:s,$to:l}=i[o],a=e.mapping.slice(r),c=a.map(s.pos),d=a.map(l.pos);o?e.deleteRange(c,d):(e.replaceRangeWith(c,d,n),Oc(e,r,n.isInline?-1:1))}}static findFrom(e,n,r=!1){let i=e.parent.inlineContent?new S(e):qt(e.node(0),e.parent,e.pos,e.index(),n,r);if(i)return i;for(let o=e.depth-1;o>=0;o--){let s=n<0?qt(e.node(0),e.node(o),e.before(o+1),e.index(o),n,r):qt(e.node(0),e.node(o),e.after(o+1),e.index(o)+1,n,r);if(s)return s}return null}static near(e,n=1){return this.findFrom(e,n)||this.findFrom(e,-n)||new ae(e.node(0))}static atStart(e){return qt(e,e,0,0,1)||new ae(e)}static atEnd(e){return qt(e,e,e.content.size,e.childCount,-1)||new ae(e)}static fromJSON(e,n){if(!n||!n.type)throw new RangeError("Invalid input for Selection.fromJSON");let r=Ho[n.type];if(!r)throw new RangeError(`No selection type ${n.type} defined`);return r.fromJSON(e,n)}static jsonID(e,n){if(e in Ho)throw new RangeError("Duplicate use of selection JSON ID "+e);return Ho[e]=n,n.prototype.jsonID=e,n}getBookmark(){return S.between(this.$anchor,this.$head).getBookmark()}};M.prototype.visible=!0;var Or=class{constructor(e,n){this.$from=e,this.$to=n}},Tc=!1;function Ac(t){!Tc&&!t.parent.inlineContent&&(Tc=!0,console.warn("TextSelection endpoint not pointing into a node with inline content ("+t.parent.type.name+")"))}var S=class t extends M{constructor(e,n=e){Ac(e),Ac(n),super(e,n)}get $cursor(){return this.$anchor.pos==this.$head.pos?this.$head:null}map(e,n){let r=e.resolve(n.map(this.head));if(!r.parent.inlineContent)return M.near(r);let i=e.resolve(n.map(this.anchor));return new t(i.parent.inlineContent?i:r,r)}replace(e,n=x.empty){if(super.replace(e,n),n==x.empty){let r=this.$from.marksAcross(this.$to);r&&e.ensureMarks(r)}}eq(e){return e instanceof t&&e.anchor==this.anchor&&e.head==this.head}getBookmark(){return new Rr(this.anchor,this.head)}toJSON(){return{type:"text",anchor:this.anchor,head:this.head}}static fromJSON(e,n){if(typeof n.anchor!="number"||typeof n.head!="number")throw new RangeError("Invalid input for TextSelection.fromJSON");return new t(e.resolve(n.anchor),e.resolve(n.head))}static create(e,n,r=n){let i=e.resolve(n);return new this(i,r==n?i:e.resolve(r))}static between(e,n,r){let i=e.pos-n.pos;if((!r||i)&&(r=i>=0?1:-1),!n.parent.inlineContent){let o=M.findFrom(n,r,!0)||M.findFrom(n,-r,!0);if(o)n=o.$head;else return M.near(n,r)}return e.parent.inlineContent||(i==0?e=n:(e=(M.findFrom(e,-r,!0)||M.findFrom(e,r,!0)).$anchor,e.pos<n.pos!=i<0&&(e=n))),new t(e,n)}};M.jsonID("text",S);var Rr=class t{constructor(e,n){this.anchor=e,this.head=n}map(e){return new t(e.map(this.anchor),e.map(this.head))}resolve(e){return S.between(e.resolve(this.anchor),e.resolve(this.head))}},C=class t extends M{constructor(e){let n=e.nodeAfter,r=e.node(0).resolve(e.pos+n.nodeSize);super(e,r),this.node=n}map(e,n){let{deleted:r,pos:i}=n.mapResult(this.anchor),o=e.resolve(i);return r?M.near(o):new t(o)}content(){return new x(v.from(this.node),0,0)}eq(e){return e instanceof t&&e.anchor==this.anchor}toJSON(){return{type:"node",anchor:this.anchor}}getBookmark(){return new Bo(this.anchor)}static fromJSON(e,n){if(typeof n.anchor!="number")throw new RangeError("Invalid input for NodeSelection.fromJSON");return new t(e.resolve(n.anchor))}static create(e,n){return new t(e.resolve(n))}static isSelectable(e){return!e.isText&&e.type.spec.selectable!==!1}};C.prototype.visible=!1;M.jsonID("node",C);var Bo=class t{constructor(e){this.anchor=e}map(e){let{deleted:n,pos:r}=e.mapResult(this.anchor);return n?new Rr(r,r):new t(r)}resolve(e){let n=e.resolve(this.anchor),r=n.nodeAfter;return r&&C.isSelectable(r)?new C(n):M.near(n)}},ae=class t extends M{constructor(e){super(e.resolve(0),e.resolve(e.content.size))}replace(e,n=x.empty){if(n==x.empty){e.delete(0,e.doc.content.size);let r=M.atStart(e.doc);r.eq(e.selection)||e.setSelection(r)}else super.replace(e,n)}toJSON(){return{type:"all"}}static fromJSON(e){return new t(e)}map(e){return new t(e)}eq(e){return e instanceof t}getBookmark(){return wp}};M.jsonID("all",ae);var wp={map(){return this},resolve(t){return new ae(t)}};function qt(t,e,n,r,i,o=!1){if(e.inlineContent)return S.create(t,n);for(let s=r-(i>0?0:1);i>0?s<e.childCount:s>=0;s+=i){let l=e.child(s);if(l.isAtom){if(!o&&C.isSelectable(l))return C.create(t,n-(i<0?l.nodeSize:0))}else{let a=qt(t,l,n+i,i<0?l.childCount:0,i,o);if(a)return a}n+=l.nodeSize*i}return null}function Oc(t,e,n){let r=t.steps.length-1;if(r<e)return;let i=t.steps[r];if(!(i instanceof ne||i instanceof W))return;let o=t.mapping.maps[r],s;o.forEach((l,a,c,d)=>{s==null&&(s=d)}),t.setSelection(M.near(t.doc.resolve(s),n))}function Rc(t,e){return!e||!t?t:t.bind(e)}var Kt=class{constructor(e,n,r){this.name=e,this.init=Rc(n.init,r),this.apply=Rc(n.apply,r)}},rv=[new Kt("doc",{init(t){return t.doc||t.schema.topNodeType.createAndFill()},apply(t){return t.doc}}),new Kt("selection",{init(t,e){return t.selection||M.atStart(e.doc)},apply(t){return t.selection}}),new Kt("storedMarks",{init(t){return t.storedMarks||null},apply(t,e,n,r){return r.selection.$cursor?t.storedMarks:null}}),new Kt("scrollToSelection",{init(){return 0},apply(t,e){return t.scrolledIntoView?e+1:e}})];function Nc(t,e,n){for(let r in t){let i=t[r];i instanceof Function?i=i.bind(e):r=="handleDOMEvents"&&(i=Nc(i,e,{})),n[r]=i}return n}var B=class{constructor(e){this.spec=e,this.props={},e.props&&Nc(e.props,this,this.props),this.key=e.key?e.key.key:Lc("plugin")}getState(e){return e[this.key]}},Fo=Object.create(null);function Lc(t){return t in Fo?t+"$"+ ++Fo[t]:(Fo[t]=0,t+"$")}var j=class{constructor(e="key"){this.key=Lc(e)}get(e){return e.config.pluginsByKey[this.key]}getState(e){return e[this.key]}};var Q=function(t){for(var e=0;;e++)if(t=t.previousSibling,!t)return e},Xt=function(t){let e=t.assignedSlot||t.parentNode;return e&&e.nodeType==11?e.host:e},Wo=null,Ve=function(t,e,n){let r=Wo||(Wo=document.createRange());return r.setEnd(t,n==null?t.nodeValue.length:n),r.setStart(t,e||0),r},Mp=function(){Wo=null},Tt=function(t,e,n,r){return n&&(_c(t,e,n,r,-1)||_c(t,e,n,r,1))},Tp=/^(img|br|input|textarea|hr)$/i;function _c(t,e,n,r,i){for(var o;;){if(t==n&&e==r)return!0;if(e==(i<0?0:be(t))){let s=t.parentNode;if(!s||s.nodeType!=1||_n(t)||Tp.test(t.nodeName)||t.contentEditable=="false")return!1;e=Q(t)+(i<0?0:1),t=s}else if(t.nodeType==1){let s=t.childNodes[e+(i<0?-1:0)];if(s.nodeType==1&&s.contentEditable=="false")if(!((o=s.pmViewDesc)===null||o===void 0)&&o.ignoreForSelection)e+=i;else return!1;else t=s,e=i<0?be(t):0}else return!1}}function be(t){return t.nodeType==3?t.nodeValue.length:t.childNodes.length}function Ap(t,e){for(;;){if(t.nodeType==3&&e)return t;if(t.nodeType==1&&e>0){if(t.contentEditable=="false")return null;t=t.childNodes[e-1],e=be(t)}else if(t.parentNode&&!_n(t))e=Q(t),t=t.parentNode;else return null}}function Op(t,e){for(;;){if(t.nodeType==3&&e<t.nodeValue.length)return t;if(t.nodeType==1&&e<t.childNodes.length){if(t.contentEditable=="false")return null;t=t.childNodes[e],e=0}else if(t.parentNode&&!_n(t))e=Q(t)+1,t=t.parentNode;else return null}}function Rp(t,e,n){for(let r=e==0,i=e==be(t);r||i;){if(t==n)return!0;let o=Q(t);if(t=t.parentNode,!t)return!1;r=r&&o==0,i=i&&o==be(t)}}function _n(t){let e;for(let n=t;n&&!(e=n.pmViewDesc);n=n.parentNode);return e&&e.node&&e.node.isBlock&&(e.dom==t||e.contentDOM==t)}var $r=function(t){return t.focusNode&&Tt(t.focusNode,t.focusOffset,t.anchorNode,t.anchorOffset)};function xt(t,e){let n=document.createEvent("Event");return n.initEvent("keydown",!0,!0),n.keyCode=t,n.key=n.code=e,n}function Np(t){let e=t.activeElement;for(;e&&e.shadowRoot;)e=e.shadowRoot.activeElement;return e}function Lp(t,e,n){if(t.caretPositionFromPoint)try{let r=t.caretPositionFromPoint(e,n);if(r)return{node:r.offsetNode,offset:Math.min(be(r.offsetNode),r.offset)}}catch{}if(t.caretRangeFromPoint){let r=t.caretRangeFromPoint(e,n);if(r)return{node:r.startContainer,offset:Math.min(be(r.startContainer),r.startOffset)}}}var Ae=typeof navigator!="undefined"?navigator:null,Ic=typeof document!="undefined"?document:null,tt=Ae&&Ae.userAgent||"",qo=/Edge\/(\d+)/.exec(tt),fd=/MSIE \d/.exec(tt),Ko=/Trident\/(?:[7-9]|\d{2,})\..*rv:(\d+)/.exec(tt),fe=!!(fd||Ko||qo),Ze=fd?document.documentMode:Ko?+Ko[1]:qo?+qo[1]:0,ve=!fe&&/gecko\/(\d+)/i.test(tt);ve&&+(/Firefox\/(\d+)/.exec(tt)||[0,0])[1];var Jo=!fe&&/Chrome\/(\d+)/.exec(tt),ie=!!Jo,hd=Jo?+Jo[1]:0,ce=!fe&&!!Ae&&/Apple Computer/.test(Ae.vendor),Zt=ce&&(/Mobile\/\w+/.test(tt)||!!Ae&&Ae.maxTouchPoints>2),ye=Zt||(Ae?/Mac/.test(Ae.platform):!1),_p=Ae?/Win/.test(Ae.platform):!1,$e=/Android \d/.test(tt),In=!!Ic&&"webkitFontSmoothing"in Ic.documentElement.style,Ip=In?+(/\bAppleWebKit\/(\d+)/.exec(navigator.userAgent)||[0,0])[1]:0;function Dp(t){let e=t.defaultView&&t.defaultView.visualViewport;return e?{left:0,right:e.width,top:0,bottom:e.height}:{left:0,right:t.documentElement.clientWidth,top:0,bottom:t.documentElement.clientHeight}}function ze(t,e){return typeof t=="number"?t:t[e]}function Pp(t){let e=t.getBoundingClientRect(),n=e.width/t.offsetWidth||1,r=e.height/t.offsetHeight||1;return{left:e.left,right:e.left+t.clientWidth*n,top:e.top,bottom:e.top+t.clientHeight*r}}function Dc(t,e,n){let r=t.someProp("scrollThreshold")||0,i=t.someProp("scrollMargin")||5,o=t.dom.ownerDocument;for(let s=n||t.dom;s;){if(s.nodeType!=1){s=Xt(s);continue}let l=s,a=l==o.body,c=a?Dp(o):Pp(l),d=0,u=0;if(e.top<c.top+ze(r,"top")?u=-(c.top-e.top+ze(i,"top")):e.bottom>c.bottom-ze(r,"bottom")&&(u=e.bottom-e.top>c.bottom-c.top?e.top+ze(i,"top")-c.top:e.bottom-c.bottom+ze(i,"bottom")),e.left<c.left+ze(r,"left")?d=-(c.left-e.left+ze(i,"left")):e.right>c.right-ze(r,"right")&&(d=e.right-c.right+ze(i,"right")),d||u)if(a)o.defaultView.scrollBy(d,u);else{let h=l.scrollLeft,p=l.scrollTop;u&&(l.scrollTop+=u),d&&(l.scrollLeft+=d);let m=l.scrollLeft-h,g=l.scrollTop-p;e={left:e.left-m,top:e.top-g,right:e.right-m,bottom:e.bottom-g}}let f=a?"fixed":getComputedStyle(s).position;if(/^(fixed|sticky)$/.test(f))break;s=f=="absolute"?s.offsetParent:Xt(s)}}function Hp(t){let e=t.dom.getBoundingClientRect(),n=Math.max(0,e.top),r,i;for(let o=(e.left+e.right)/2,s=n+1;s<Math.min(innerHeight,e.bottom);s+=5){let l=t.root.elementFromPoint(o,s);if(!l||l==t.dom||!t.dom.contains(l))continue;let a=l.getBoundingClientRect();if(a.top>=n-20){r=l,i=a.top;break}}return{refDOM:r,refTop:i,stack:pd(t.dom)}}function pd(t){let e=[],n=t.ownerDocument;for(let r=t;r&&(e.push({dom:r,top:r.scrollTop,left:r.scrollLeft}),t!=n);r=Xt(r));return e}function Fp({refDOM:t,refTop:e,stack:n}){let r=t?t.getBoundingClientRect().top:0;md(n,r==0?0:r-e)}function md(t,e){for(let n=0;n<t.length;n++){let{dom:r,top:i,left:o}=t[n];r.scrollTop!=i+e&&(r.scrollTop=i+e),r.scrollLeft!=o&&(r.scrollLeft=o)}}var Jt=null;function Bp(t){if(t.setActive)return t.setActive();if(Jt)return t.focus(Jt);let e=pd(t);t.focus(Jt==null?{get preventScroll(){return Jt={preventScroll:!0},!0}}:void 0),Jt||(Jt=!1,md(e,0))}function gd(t,e){let n,r=2e8,i,o=0,s=e.top,l=e.top,a,c;for(let d=t.firstChild,u=0;d;d=d.nextSibling,u++){let f;if(d.nodeType==1)f=d.getClientRects();else if(d.nodeType==3)f=Ve(d).getClientRects();else continue;for(let h=0;h<f.length;h++){let p=f[h];if(p.top<=s&&p.bottom>=l){s=Math.max(p.bottom,s),l=Math.min(p.top,l);let m=p.left>e.left?p.left-e.left:p.right<e.left?e.left-p.right:0;if(m<r){n=d,r=m,i=m&&n.nodeType==3?{left:p.right<e.left?p.right:p.left,top:e.top}:e,d.nodeType==1&&m&&(o=u+(e.left>=(p.left+p.right)/2?1:0));continue}}else p.top>e.top&&!a&&p.left<=e.left&&p.right>=e.left&&(a=d,c={left:Math.max(p.left,Math.min(p.right,e.left)),top:p.top});!n&&(e.left>=p.right&&e.top>=p.top||e.left>=p.left&&e.top>=p.bottom)&&(o=u+1)}}return!n&&a&&(n=a,i=c,r=0),n&&n.nodeType==3?zp(n,i):!n||r&&n.nodeType==1?{node:t,offset:o}:gd(n,i)}function zp(t,e){let n=t.nodeValue.length,r=document.createRange();for(let i=0;i<n;i++){r.setEnd(t,i+1),r.setStart(t,i);let o=Ye(r,1);if(o.top!=o.bottom&&ds(e,o))return{node:t,offset:i+(e.left>=(o.left+o.right)/2?1:0)}}return{node:t,offset:0}}function ds(t,e){return t.left>=e.left-1&&t.left<=e.right+1&&t.top>=e.top-1&&t.top<=e.bottom+1}function Vp(t,e){let n=t.parentNode;return n&&/^li$/i.test(n.nodeName)&&e.left<t.getBoundingClientRect().left?n:t}function $p(t,e,n){let{node:r,offset:i}=gd(e,n),o=-1;if(r.nodeType==1&&!r.firstChild){let s=r.getBoundingClientRect();o=s.left!=s.right&&n.left>(s.left+s.right)/2?1:-1}return t.docView.posFromDOM(r,i,o)}function jp(t,e,n,r){let i=-1;for(let o=e,s=!1;o!=t.dom;){let l=t.docView.nearestDesc(o,!0),a;if(!l)return null;if(l.dom.nodeType==1&&(l.node.isBlock&&l.parent||!l.contentDOM)&&((a=l.dom.getBoundingClientRect()).width||a.height)&&(l.node.isBlock&&l.parent&&!/^T(R|BODY|HEAD|FOOT)$/.test(l.dom.nodeName)&&(!s&&a.left>r.left||a.top>r.top?i=l.posBefore:(!s&&a.right<r.left||a.bottom<r.top)&&(i=l.posAfter),s=!0),!l.contentDOM&&i<0&&!l.node.isText))return(l.node.isBlock?r.top<(a.top+a.bottom)/2:r.left<(a.left+a.right)/2)?l.posBefore:l.posAfter;o=l.dom.parentNode}return i>-1?i:t.docView.posFromDOM(e,n,-1)}function yd(t,e,n){let r=t.childNodes.length;if(r&&n.top<n.bottom)for(let i=Math.max(0,Math.min(r-1,Math.floor(r*(e.top-n.top)/(n.bottom-n.top))-2)),o=i;;){let s=t.childNodes[o];if(s.nodeType==1){let l=s.getClientRects();for(let a=0;a<l.length;a++){let c=l[a];if(ds(e,c))return yd(s,e,c)}}if((o=(o+1)%r)==i)break}return t}function Up(t,e){let n=t.dom.ownerDocument,r,i=0,o=Lp(n,e.left,e.top);o&&({node:r,offset:i}=o);let s=(t.root.elementFromPoint?t.root:n).elementFromPoint(e.left,e.top),l;if(!s||!t.dom.contains(s.nodeType!=1?s.parentNode:s)){let c=t.dom.getBoundingClientRect();if(!ds(e,c)||(s=yd(t.dom,e,c),!s))return null}if(ce)for(let c=s;r&&c;c=Xt(c))c.draggable&&(r=void 0);if(s=Vp(s,e),r){if(ve&&r.nodeType==1&&(i=Math.min(i,r.childNodes.length),i<r.childNodes.length)){let d=r.childNodes[i],u;d.nodeName=="IMG"&&(u=d.getBoundingClientRect()).right<=e.left&&u.bottom>e.top&&i++}let c;In&&i&&r.nodeType==1&&(c=r.childNodes[i-1]).nodeType==1&&c.contentEditable=="false"&&c.getBoundingClientRect().top>=e.top&&i--,r==t.dom&&i==r.childNodes.length-1&&r.lastChild.nodeType==1&&e.top>r.lastChild.getBoundingClientRect().bottom?l=t.state.doc.content.size:(i==0||r.nodeType!=1||r.childNodes[i-1].nodeName!="BR")&&(l=jp(t,r,i,e))}l==null&&(l=$p(t,s,e));let a=t.docView.nearestDesc(s,!0);return{pos:l,inside:a?a.posAtStart-a.border:-1}}function Pc(t){return t.top<t.bottom||t.left<t.right}function Ye(t,e){let n=t.getClientRects();if(n.length){let r=n[e<0?0:n.length-1];if(Pc(r))return r}return Array.prototype.find.call(n,Pc)||t.getBoundingClientRect()}var Wp=/[\u0590-\u05f4\u0600-\u06ff\u0700-\u08ac]/;function bd(t,e,n){let{node:r,offset:i,atom:o}=t.docView.domFromPos(e,n<0?-1:1),s=In||ve;if(r.nodeType==3)if(s&&(Wp.test(r.nodeValue)||(n<0?!i:i==r.nodeValue.length))){let a=Ye(Ve(r,i,i),n);if(ve&&i&&/\s/.test(r.nodeValue[i-1])&&i<r.nodeValue.length){let c=Ye(Ve(r,i-1,i-1),-1);if(c.top==a.top){let d=Ye(Ve(r,i,i+1),-1);if(d.top!=a.top)return Tn(d,d.left<c.left)}}return a}else{let a=i,c=i,d=n<0?1:-1;return n<0&&!i?(c++,d=-1):n>=0&&i==r.nodeValue.length?(a--,d=1):n<0?a--:c++,Tn(Ye(Ve(r,a,c),d),d<0)}if(!t.state.doc.resolve(e-(o||0)).parent.inlineContent){if(o==null&&i&&(n<0||i==be(r))){let a=r.childNodes[i-1];if(a.nodeType==1)return zo(a.getBoundingClientRect(),!1)}if(o==null&&i<be(r)){let a=r.childNodes[i];if(a.nodeType==1)return zo(a.getBoundingClientRect(),!0)}return zo(r.getBoundingClientRect(),n>=0)}if(o==null&&i&&(n<0||i==be(r))){let a=r.childNodes[i-1],c=a.nodeType==3?Ve(a,be(a)-(s?0:1)):a.nodeType==1&&(a.nodeName!="BR"||!a.nextSibling)?a:null;if(c)return Tn(Ye(c,1),!1)}if(o==null&&i<be(r)){let a=r.childNodes[i];for(;a.pmViewDesc&&a.pmViewDesc.ignoreForCoords;)a=a.nextSibling;let c=a?a.nodeType==3?Ve(a,0,s?0:1):a.nodeType==1?a:null:null;if(c)return Tn(Ye(c,-1),!0)}return Tn(Ye(r.nodeType==3?Ve(r):r,-n),n>=0)}function Tn(t,e){if(t.width==0)return t;let n=e?t.left:t.right;return{top:t.top,bottom:t.bottom,left:n,right:n}}function zo(t,e){if(t.height==0)return t;let n=e?t.top:t.bottom;return{top:n,bottom:n,left:t.left,right:t.right}}function vd(t,e,n){let r=t.state,i=t.root.activeElement;r!=e&&t.updateState(e),i!=t.dom&&t.focus();try{return n()}finally{r!=e&&t.updateState(r),i!=t.dom&&i&&i.focus()}}function qp(t,e,n){let r=e.selection,i=n=="up"?r.$from:r.$to;return vd(t,e,()=>{let{node:o}=t.docView.domFromPos(i.pos,n=="up"?-1:1);for(;;){let l=t.docView.nearestDesc(o,!0);if(!l)break;if(l.node.isBlock){o=l.contentDOM||l.dom;break}o=l.dom.parentNode}let s=bd(t,i.pos,1);for(let l=o.firstChild;l;l=l.nextSibling){let a;if(l.nodeType==1)a=l.getClientRects();else if(l.nodeType==3)a=Ve(l,0,l.nodeValue.length).getClientRects();else continue;for(let c=0;c<a.length;c++){let d=a[c];if(d.bottom>d.top+1&&(n=="up"?s.top-d.top>(d.bottom-s.top)*2:d.bottom-s.bottom>(s.bottom-d.top)*2))return!1}}return!0})}var Kp=/[\u0590-\u08ac]/;function Jp(t,e,n){let{$head:r}=e.selection;if(!r.parent.isTextblock)return!1;let i=r.parentOffset,o=!i,s=i==r.parent.content.size,l=t.domSelection();return l?!Kp.test(r.parent.textContent)||!l.modify?n=="left"||n=="backward"?o:s:vd(t,e,()=>{let{focusNode:a,focusOffset:c,anchorNode:d,anchorOffset:u}=t.domSelectionRange(),f=l.caretBidiLevel;l.modify("move",n,"character");let h=r.depth?t.docView.domAfterPos(r.before()):t.dom,{focusNode:p,focusOffset:m}=t.domSelectionRange(),g=p&&!h.contains(p.nodeType==1?p:p.parentNode)||a==p&&c==m;try{l.collapse(d,u),a&&(a!=d||c!=u)&&l.extend&&l.extend(a,c)}catch{}return f!=null&&(l.caretBidiLevel=f),g}):r.pos==r.start()||r.pos==r.end()}var Hc=null,Fc=null,Bc=!1;function Gp(t,e,n){return Hc==e&&Fc==n?Bc:(Hc=e,Fc=n,Bc=n=="up"||n=="down"?qp(t,e,n):Jp(t,e,n))}var ke=0,zc=1,Ct=2,Oe=3,At=class{constructor(e,n,r,i){this.parent=e,this.children=n,this.dom=r,this.contentDOM=i,this.dirty=ke,r.pmViewDesc=this}matchesWidget(e){return!1}matchesMark(e){return!1}matchesNode(e,n,r){return!1}matchesHack(e){return!1}parseRule(){return null}stopEvent(e){return!1}get size(){let e=0;for(let n=0;n<this.children.length;n++)e+=this.children[n].size;return e}get border(){return 0}destroy(){this.parent=void 0,this.dom.pmViewDesc==this&&(this.dom.pmViewDesc=void 0);for(let e=0;e<this.children.length;e++)this.children[e].destroy()}posBeforeChild(e){for(let n=0,r=this.posAtStart;;n++){let i=this.children[n];if(i==e)return r;r+=i.size}}get posBefore(){return this.parent.posBeforeChild(this)}get posAtStart(){return this.parent?this.parent.posBeforeChild(this)+this.border:0}get posAfter(){return this.posBefore+this.size}get posAtEnd(){return this.posAtStart+this.size-2*this.border}localPosFromDOM(e,n,r){if(this.contentDOM&&this.contentDOM.contains(e.nodeType==1?e:e.parentNode))if(r<0){let o,s;if(e==this.contentDOM)o=e.childNodes[n-1];else{for(;e.parentNode!=this.contentDOM;)e=e.parentNode;o=e.previousSibling}for(;o&&!((s=o.pmViewDesc)&&s.parent==this);)o=o.previousSibling;return o?this.posBeforeChild(s)+s.size:this.posAtStart}else{let o,s;if(e==this.contentDOM)o=e.childNodes[n];else{for(;e.parentNode!=this.contentDOM;)e=e.parentNode;o=e.nextSibling}for(;o&&!((s=o.pmViewDesc)&&s.parent==this);)o=o.nextSibling;return o?this.posBeforeChild(s):this.posAtEnd}let i;if(e==this.dom&&this.contentDOM)i=n>Q(this.contentDOM);else if(this.contentDOM&&this.contentDOM!=this.dom&&this.dom.contains(this.contentDOM))i=e.compareDocumentPosition(this.contentDOM)&2;else if(this.dom.firstChild){if(n==0)for(let o=e;;o=o.parentNode){if(o==this.dom){i=!1;break}if(o.previousSibling)break}if(i==null&&n==e.childNodes.length)for(let o=e;;o=o.parentNode){if(o==this.dom){i=!0;break}if(o.nextSibling)break}}return(i==null?r>0:i)?this.posAtEnd:this.posAtStart}nearestDesc(e,n=!1){for(let r=!0,i=e;i;i=i.parentNode){let o=this.getDesc(i),s;if(o&&(!n||o.node))if(r&&(s=o.nodeDOM)&&!(s.nodeType==1?s.contains(e.nodeType==1?e:e.parentNode):s==e))r=!1;else return o}}getDesc(e){let n=e.pmViewDesc;for(let r=n;r;r=r.parent)if(r==this)return n}posFromDOM(e,n,r){for(let i=e;i;i=i.parentNode){let o=this.getDesc(i);if(o)return o.localPosFromDOM(e,n,r)}return-1}descAt(e){for(let n=0,r=0;n<this.children.length;n++){let i=this.children[n],o=r+i.size;if(r==e&&o!=r){for(;!i.border&&i.children.length;)for(let s=0;s<i.children.length;s++){let l=i.children[s];if(l.size){i=l;break}}return i}if(e<o)return i.descAt(e-r-i.border);r=o}}domFromPos(e,n){if(!this.contentDOM)return{node:this.dom,offset:0,atom:e+1};let r=0,i=0;for(let o=0;r<this.children.length;r++){let s=this.children[r],l=o+s.size;if(l>e||s instanceof _r){i=e-o;break}o=l}if(i)return this.children[r].domFromPos(i-this.children[r].border,n);for(let o;r&&!(o=this.children[r-1]).size&&o instanceof Nr&&o.side>=0;r--);if(n<=0){let o,s=!0;for(;o=r?this.children[r-1]:null,!(!o||o.dom.parentNode==this.contentDOM);r--,s=!1);return o&&n&&s&&!o.border&&!o.domAtom?o.domFromPos(o.size,n):{node:this.contentDOM,offset:o?Q(o.dom)+1:0}}else{let o,s=!0;for(;o=r<this.children.length?this.children[r]:null,!(!o||o.dom.parentNode==this.contentDOM);r++,s=!1);return o&&s&&!o.border&&!o.domAtom?o.domFromPos(0,n):{node:this.contentDOM,offset:o?Q(o.dom):this.contentDOM.childNodes.length}}}parseRange(e,n,r=0){if(this.children.length==0)return{node:this.contentDOM,from:e,to:n,fromOffset:0,toOffset:this.contentDOM.childNodes.length};let i=-1,o=-1;for(let s=r,l=0;;l++){let a=this.children[l],c=s+a.size;if(i==-1&&e<=c){let d=s+a.border;if(e>=d&&n<=c-a.border&&a.node&&a.contentDOM&&this.contentDOM.contains(a.contentDOM))return a.parseRange(e,n,d);e=s;for(let u=l;u>0;u--){let f=this.children[u-1];if(f.size&&f.dom.parentNode==this.contentDOM&&!f.emptyChildAt(1)){i=Q(f.dom)+1;break}e-=f.size}i==-1&&(i=0)}if(i>-1&&(c>n||l==this.children.length-1)){n=c;for(let d=l+1;d<this.children.length;d++){let u=this.children[d];if(u.size&&u.dom.parentNode==this.contentDOM&&!u.emptyChildAt(-1)){o=Q(u.dom);break}n+=u.size}o==-1&&(o=this.contentDOM.childNodes.length);break}s=c}return{node:this.contentDOM,from:e,to:n,fromOffset:i,toOffset:o}}emptyChildAt(e){if(this.border||!this.contentDOM||!this.children.length)return!1;let n=this.children[e<0?0:this.children.length-1];return n.size==0||n.emptyChildAt(e)}domAfterPos(e){let{node:n,offset:r}=this.domFromPos(e,0);if(n.nodeType!=1||r==n.childNodes.length)throw new RangeError("No node after pos "+e);return n.childNodes[r]}setSelection(e,n,r,i=!1){let o=Math.min(e,n),s=Math.max(e,n);for(let h=0,p=0;h<this.children.length;h++){let m=this.children[h],g=p+m.size;if(o>p&&s<g)return m.setSelection(e-p-m.border,n-p-m.border,r,i);p=g}let l=this.domFromPos(e,e?-1:1),a=n==e?l:this.domFromPos(n,n?-1:1),c=r.root.getSelection(),d=r.domSelectionRange(),u=!1;if((ve||ce)&&e==n){let{node:h,offset:p}=l;if(h.nodeType==3){if(u=!!(p&&h.nodeValue[p-1]==`
-`),u&&p==h.nodeValue.length)for(let m=h,g;m;m=m.parentNode){if(g=m.nextSibling){g.nodeName=="BR"&&(l=a={node:g.parentNode,offset:Q(g)+1});break}let y=m.pmViewDesc;if(y&&y.node&&y.node.isBlock)break}}else{let m=h.childNodes[p-1];u=m&&(m.nodeName=="BR"||m.contentEditable=="false")}}if(ve&&d.focusNode&&d.focusNode!=a.node&&d.focusNode.nodeType==1){let h=d.focusNode.childNodes[d.focusOffset];h&&h.contentEditable=="false"&&(i=!0)}if(!(i||u&&ce)&&Tt(l.node,l.offset,d.anchorNode,d.anchorOffset)&&Tt(a.node,a.offset,d.focusNode,d.focusOffset))return;let f=!1;if((c.extend||e==n)&&!(u&&ve)){c.collapse(l.node,l.offset);try{e!=n&&c.extend(a.node,a.offset),f=!0}catch{}}if(!f){if(e>n){let p=l;l=a,a=p}let h=document.createRange();h.setEnd(a.node,a.offset),h.setStart(l.node,l.offset),c.removeAllRanges(),c.addRange(h)}}ignoreMutation(e){return!this.contentDOM&&e.type!="selection"}get contentLost(){return this.contentDOM&&this.contentDOM!=this.dom&&!this.dom.contains(this.contentDOM)}markDirty(e,n){for(let r=0,i=0;i<this.children.length;i++){let o=this.children[i],s=r+o.size;if(r==s?e<=s&&n>=r:e<s&&n>r){let l=r+o.border,a=s-o.border;if(e>=l&&n<=a){this.dirty=e==r||n==s?Ct:zc,e==l&&n==a&&(o.contentLost||o.dom.parentNode!=this.contentDOM)?o.dirty=Oe:o.markDirty(e-l,n-l);return}else o.dirty=o.dom==o.contentDOM&&o.dom.parentNode==this.contentDOM&&!o.children.length?Ct:Oe}r=s}this.dirty=Ct}markParentsDirty(){let e=1;for(let n=this.parent;n;n=n.parent,e++){let r=e==1?Ct:zc;n.dirty<r&&(n.dirty=r)}}get domAtom(){return!1}get ignoreForCoords(){return!1}get ignoreForSelection(){return!1}isText(e){return!1}},Nr=class extends At{constructor(e,n,r,i){let o,s=n.type.toDOM;if(typeof s=="function"&&(s=s(r,()=>{if(!o)return i;if(o.parent)return o.parent.posBeforeChild(o)})),!n.type.spec.raw){if(s.nodeType!=1){let l=document.createElement("span");l.appendChild(s),s=l}s.contentEditable="false",s.classList.add("ProseMirror-widget")}super(e,[],s,null),this.widget=n,this.widget=n,o=this}matchesWidget(e){return this.dirty==ke&&e.type.eq(this.widget.type)}parseRule(){return{ignore:!0}}stopEvent(e){let n=this.widget.spec.stopEvent;return n?n(e):!1}ignoreMutation(e){return e.type!="selection"||this.widget.spec.ignoreSelection}destroy(){this.widget.type.destroy(this.dom),super.destroy()}get domAtom(){return!0}get ignoreForSelection(){return!!this.widget.type.spec.relaxedSide}get side(){return this.widget.type.side}},Go=class extends At{constructor(e,n,r,i){super(e,[],n,null),this.textDOM=r,this.text=i}get size(){return this.text.length}localPosFromDOM(e,n){return e!=this.textDOM?this.posAtStart+(n?this.size:0):this.posAtStart+n}domFromPos(e){return{node:this.textDOM,offset:e}}ignoreMutation(e){return e.type==="characterData"&&e.target.nodeValue==e.oldValue}},en=class t extends At{constructor(e,n,r,i,o){super(e,[],r,i),this.mark=n,this.spec=o}static create(e,n,r,i){let o=i.nodeViews[n.type.name],s=o&&o(n,i,r);return(!s||!s.dom)&&(s=Ge.renderSpec(document,n.type.spec.toDOM(n,r),null,n.attrs)),new t(e,n,s.dom,s.contentDOM||s.dom,s)}parseRule(){return this.dirty&Oe||this.mark.type.spec.reparseInView?null:{mark:this.mark.type.name,attrs:this.mark.attrs,contentElement:this.contentDOM}}matchesMark(e){return this.dirty!=Oe&&this.mark.eq(e)}markDirty(e,n){if(super.markDirty(e,n),this.dirty!=ke){let r=this.parent;for(;!r.node;)r=r.parent;r.dirty<this.dirty&&(r.dirty=this.dirty),this.dirty=ke}}slice(e,n,r){let i=t.create(this.parent,this.mark,!0,r),o=this.children,s=this.size;n<s&&(o=Zo(o,n,s,r)),e>0&&(o=Zo(o,0,e,r));for(let l=0;l<o.length;l++)o[l].parent=i;return i.children=o,i}ignoreMutation(e){return this.spec.ignoreMutation?this.spec.ignoreMutation(e):super.ignoreMutation(e)}destroy(){this.spec.destroy&&this.spec.destroy(),super.destroy()}},et=class t extends At{constructor(e,n,r,i,o,s,l,a,c){super(e,[],o,s),this.node=n,this.outerDeco=r,this.innerDeco=i,this.nodeDOM=l}static create(e,n,r,i,o,s){let l=o.nodeViews[n.type.name],a,c=l&&l(n,o,()=>{if(!a)return s;if(a.parent)return a.parent.posBeforeChild(a)},r,i),d=c&&c.dom,u=c&&c.contentDOM;if(n.isText){if(!d)d=document.createTextNode(n.text);else if(d.nodeType!=3)throw new RangeError("Text must be rendered as a DOM text node")}else d||({dom:d,contentDOM:u}=Ge.renderSpec(document,n.type.spec.toDOM(n),null,n.attrs));!u&&!n.isText&&d.nodeName!="BR"&&(d.hasAttribute("contenteditable")||(d.contentEditable="false"),n.type.spec.draggable&&(d.draggable=!0));let f=d;return d=Cd(d,r,n),c?a=new Yo(e,n,r,i,d,u||null,f,c,o,s+1):n.isText?new Lr(e,n,r,i,d,f,o):new t(e,n,r,i,d,u||null,f,o,s+1)}parseRule(){if(this.node.type.spec.reparseInView)return null;let e={node:this.node.type.name,attrs:this.node.attrs};if(this.node.type.whitespace=="pre"&&(e.preserveWhitespace="full"),!this.contentDOM)e.getContent=()=>this.node.content;else if(!this.contentLost)e.contentElement=this.contentDOM;else{for(let n=this.children.length-1;n>=0;n--){let r=this.children[n];if(this.dom.contains(r.dom.parentNode)){e.contentElement=r.dom.parentNode;break}}e.contentElement||(e.getContent=()=>v.empty)}return e}matchesNode(e,n,r){return this.dirty==ke&&e.eq(this.node)&&Ir(n,this.outerDeco)&&r.eq(this.innerDeco)}get size(){return this.node.nodeSize}get border(){return this.node.isLeaf?0:1}updateChildren(e,n){let r=this.node.inlineContent,i=n,o=e.composing?this.localCompositionInfo(e,n):null,s=o&&o.pos>-1?o:null,l=o&&o.pos<0,a=new Xo(this,s&&s.node,e);Zp(this.node,this.innerDeco,(c,d,u)=>{c.spec.marks?a.syncToMarks(c.spec.marks,r,e):c.type.side>=0&&!u&&a.syncToMarks(d==this.node.childCount?L.none:this.node.child(d).marks,r,e),a.placeWidget(c,e,i)},(c,d,u,f)=>{a.syncToMarks(c.marks,r,e);let h;a.findNodeMatch(c,d,u,f)||l&&e.state.selection.from>i&&e.state.selection.to<i+c.nodeSize&&(h=a.findIndexWithChild(o.node))>-1&&a.updateNodeAt(c,d,u,h,e)||a.updateNextNode(c,d,u,e,f,i)||a.addNode(c,d,u,e,i),i+=c.nodeSize}),a.syncToMarks([],r,e),this.node.isTextblock&&a.addTextblockHacks(),a.destroyRest(),(a.changed||this.dirty==Ct)&&(s&&this.protectLocalComposition(e,s),kd(this.contentDOM,this.children,e),Zt&&em(this.dom))}localCompositionInfo(e,n){let{from:r,to:i}=e.state.selection;if(!(e.state.selection instanceof S)||r<n||i>n+this.node.content.size)return null;let o=e.input.compositionNode;if(!o||!this.dom.contains(o.parentNode))return null;if(this.node.inlineContent){let s=o.nodeValue,l=tm(this.node.content,s,r-n,i-n);return l<0?null:{node:o,pos:l,text:s}}else return{node:o,pos:-1,text:""}}protectLocalComposition(e,{node:n,pos:r,text:i}){if(this.getDesc(n))return;let o=n;for(;o.parentNode!=this.contentDOM;o=o.parentNode){for(;o.previousSibling;)o.parentNode.removeChild(o.previousSibling);for(;o.nextSibling;)o.parentNode.removeChild(o.nextSibling);o.pmViewDesc&&(o.pmViewDesc=void 0)}let s=new Go(this,o,n,i);e.input.compositionNodes.push(s),this.children=Zo(this.children,r,r+i.length,e,s)}update(e,n,r,i){return this.dirty==Oe||!e.sameMarkup(this.node)?!1:(this.updateInner(e,n,r,i),!0)}updateInner(e,n,r,i){this.updateOuterDeco(n),this.node=e,this.innerDeco=r,this.contentDOM&&this.updateChildren(i,this.posAtStart),this.dirty=ke}updateOuterDeco(e){if(Ir(e,this.outerDeco))return;let n=this.nodeDOM.nodeType!=1,r=this.dom;this.dom=xd(this.dom,this.nodeDOM,Qo(this.outerDeco,this.node,n),Qo(e,this.node,n)),this.dom!=r&&(r.pmViewDesc=void 0,this.dom.pmViewDesc=this),this.outerDeco=e}selectNode(){this.nodeDOM.nodeType==1&&(this.nodeDOM.classList.add("ProseMirror-selectednode"),(this.contentDOM||!this.node.type.spec.draggable)&&(this.nodeDOM.draggable=!0))}deselectNode(){this.nodeDOM.nodeType==1&&(this.nodeDOM.classList.remove("ProseMirror-selectednode"),(this.contentDOM||!this.node.type.spec.draggable)&&this.nodeDOM.removeAttribute("draggable"))}get domAtom(){return this.node.isAtom}};function Vc(t,e,n,r,i){Cd(r,e,t);let o=new et(void 0,t,e,n,r,r,r,i,0);return o.contentDOM&&o.updateChildren(i,0),o}var Lr=class t extends et{constructor(e,n,r,i,o,s,l){super(e,n,r,i,o,null,s,l,0)}parseRule(){let e=this.nodeDOM.parentNode;for(;e&&e!=this.dom&&!e.pmIsDeco;)e=e.parentNode;return{skip:e||!0}}update(e,n,r,i){return this.dirty==Oe||this.dirty!=ke&&!this.inParent()||!e.sameMarkup(this.node)?!1:(this.updateOuterDeco(n),(this.dirty!=ke||e.text!=this.node.text)&&e.text!=this.nodeDOM.nodeValue&&(this.nodeDOM.nodeValue=e.text,i.trackWrites==this.nodeDOM&&(i.trackWrites=null)),this.node=e,this.dirty=ke,!0)}inParent(){let e=this.parent.contentDOM;for(let n=this.nodeDOM;n;n=n.parentNode)if(n==e)return!0;return!1}domFromPos(e){return{node:this.nodeDOM,offset:e}}localPosFromDOM(e,n,r){return e==this.nodeDOM?this.posAtStart+Math.min(n,this.node.text.length):super.localPosFromDOM(e,n,r)}ignoreMutation(e){return e.type!="characterData"&&e.type!="selection"}slice(e,n,r){let i=this.node.cut(e,n),o=document.createTextNode(i.text);return new t(this.parent,i,this.outerDeco,this.innerDeco,o,o,r)}markDirty(e,n){super.markDirty(e,n),this.dom!=this.nodeDOM&&(e==0||n==this.nodeDOM.nodeValue.length)&&(this.dirty=Oe)}get domAtom(){return!1}isText(e){return this.node.text==e}},_r=class extends At{parseRule(){return{ignore:!0}}matchesHack(e){return this.dirty==ke&&this.dom.nodeName==e}get domAtom(){return!0}get ignoreForCoords(){return this.dom.nodeName=="IMG"}},Yo=class extends et{constructor(e,n,r,i,o,s,l,a,c,d){super(e,n,r,i,o,s,l,c,d),this.spec=a}update(e,n,r,i){if(this.dirty==Oe)return!1;if(this.spec.update&&(this.node.type==e.type||this.spec.multiType)){let o=this.spec.update(e,n,r);return o&&this.updateInner(e,n,r,i),o}else return!this.contentDOM&&!e.isLeaf?!1:super.update(e,n,r,i)}selectNode(){this.spec.selectNode?this.spec.selectNode():super.selectNode()}deselectNode(){this.spec.deselectNode?this.spec.deselectNode():super.deselectNode()}setSelection(e,n,r,i){this.spec.setSelection?this.spec.setSelection(e,n,r.root):super.setSelection(e,n,r,i)}destroy(){this.spec.destroy&&this.spec.destroy(),super.destroy()}stopEvent(e){return this.spec.stopEvent?this.spec.stopEvent(e):!1}ignoreMutation(e){return this.spec.ignoreMutation?this.spec.ignoreMutation(e):super.ignoreMutation(e)}};function kd(t,e,n){let r=t.firstChild,i=!1;for(let o=0;o<e.length;o++){let s=e[o],l=s.dom;if(l.parentNode==t){for(;l!=r;)r=$c(r),i=!0;r=r.nextSibling}else i=!0,t.insertBefore(l,r);if(s instanceof en){let a=r?r.previousSibling:t.lastChild;kd(s.contentDOM,s.children,n),r=a?a.nextSibling:t.firstChild}}for(;r;)r=$c(r),i=!0;i&&n.trackWrites==t&&(n.trackWrites=null)}var An=function(t){t&&(this.nodeName=t)};An.prototype=Object.create(null);var Et=[new An];function Qo(t,e,n){if(t.length==0)return Et;let r=n?Et[0]:new An,i=[r];for(let o=0;o<t.length;o++){let s=t[o].type.attrs;if(s){s.nodeName&&i.push(r=new An(s.nodeName));for(let l in s){let a=s[l];a!=null&&(n&&i.length==1&&i.push(r=new An(e.isInline?"span":"div")),l=="class"?r.class=(r.class?r.class+" ":"")+a:l=="style"?r.style=(r.style?r.style+";":"")+a:l!="nodeName"&&(r[l]=a))}}}return i}function xd(t,e,n,r){if(n==Et&&r==Et)return e;let i=e;for(let o=0;o<r.length;o++){let s=r[o],l=n[o];if(o){let a;l&&l.nodeName==s.nodeName&&i!=t&&(a=i.parentNode)&&a.nodeName.toLowerCase()==s.nodeName||(a=document.createElement(s.nodeName),a.pmIsDeco=!0,a.appendChild(i),l=Et[0]),i=a}Yp(i,l||Et[0],s)}return i}function Yp(t,e,n){for(let r in e)r!="class"&&r!="style"&&r!="nodeName"&&!(r in n)&&t.removeAttribute(r);for(let r in n)r!="class"&&r!="style"&&r!="nodeName"&&n[r]!=e[r]&&t.setAttribute(r,n[r]);if(e.class!=n.class){let r=e.class?e.class.split(" ").filter(Boolean):[],i=n.class?n.class.split(" ").filter(Boolean):[];for(let o=0;o<r.length;o++)i.indexOf(r[o])==-1&&t.classList.remove(r[o]);for(let o=0;o<i.length;o++)r.indexOf(i[o])==-1&&t.classList.add(i[o]);t.classList.length==0&&t.removeAttribute("class")}if(e.style!=n.style){if(e.style){let r=/\s*([\w\-\xa1-\uffff]+)\s*:(?:"(?:\\.|[^"])*"|'(?:\\.|[^'])*'|\(.*?\)|[^;])*/g,i;for(;i=r.exec(e.style);)t.style.removeProperty(i[1])}n.style&&(t.style.cssText+=n.style)}}function Cd(t,e,n){return xd(t,t,Et,Qo(e,n,t.nodeType!=1))}function Ir(t,e){if(t.length!=e.length)return!1;for(let n=0;n<t.length;n++)if(!t[n].type.eq(e[n].type))return!1;return!0}function $c(t){let e=t.nextSibling;return t.parentNode.removeChild(t),e}var Xo=class{constructor(e,n,r){this.lock=n,this.view=r,this.index=0,this.stack=[],this.changed=!1,this.top=e,this.preMatch=Qp(e.node.content,e)}destroyBetween(e,n){if(e!=n){for(let r=e;r<n;r++)this.top.children[r].destroy();this.top.children.splice(e,n-e),this.changed=!0}}destroyRest(){this.destroyBetween(this.index,this.top.children.length)}syncToMarks(e,n,r){let i=0,o=this.stack.length>>1,s=Math.min(o,e.length);for(;i<s&&(i==o-1?this.top:this.stack[i+1<<1]).matchesMark(e[i])&&e[i].type.spec.spanning!==!1;)i++;for(;i<o;)this.destroyRest(),this.top.dirty=ke,this.index=this.stack.pop(),this.top=this.stack.pop(),o--;for(;o<e.length;){this.stack.push(this.top,this.index+1);let l=-1;for(let a=this.index;a<Math.min(this.index+3,this.top.children.length);a++){let c=this.top.children[a];if(c.matchesMark(e[o])&&!this.isLocked(c.dom)){l=a;break}}if(l>-1)l>this.index&&(this.changed=!0,this.destroyBetween(this.index,l)),this.top=this.top.children[this.index];else{let a=en.create(this.top,e[o],n,r);this.top.children.splice(this.index,0,a),this.top=a,this.changed=!0}this.index=0,o++}}findNodeMatch(e,n,r,i){let o=-1,s;if(i>=this.preMatch.index&&(s=this.preMatch.matches[i-this.preMatch.index]).parent==this.top&&s.matchesNode(e,n,r))o=this.top.children.indexOf(s,this.index);else for(let l=this.index,a=Math.min(this.top.children.length,l+5);l<a;l++){let c=this.top.children[l];if(c.matchesNode(e,n,r)&&!this.preMatch.matched.has(c)){o=l;break}}return o<0?!1:(this.destroyBetween(this.index,o),this.index++,!0)}updateNodeAt(e,n,r,i,o){let s=this.top.children[i];return s.dirty==Oe&&s.dom==s.contentDOM&&(s.dirty=Ct),s.update(e,n,r,o)?(this.destroyBetween(this.index,i),this.index++,!0):!1}findIndexWithChild(e){for(;;){let n=e.parentNode;if(!n)return-1;if(n==this.top.contentDOM){let r=e.pmViewDesc;if(r){for(let i=this.index;i<this.top.children.length;i++)if(this.top.children[i]==r)return i}return-1}e=n}}updateNextNode(e,n,r,i,o,s){for(let l=this.index;l<this.top.children.length;l++){let a=this.top.children[l];if(a instanceof et){let c=this.preMatch.matched.get(a);if(c!=null&&c!=o)return!1;let d=a.dom,u,f=this.isLocked(d)&&!(e.isText&&a.node&&a.node.isText&&a.nodeDOM.nodeValue==e.text&&a.dirty!=Oe&&Ir(n,a.outerDeco));if(!f&&a.update(e,n,r,i))return this.destroyBetween(this.index,l),a.dom!=d&&(this.changed=!0),this.index++,!0;if(!f&&(u=this.recreateWrapper(a,e,n,r,i,s)))return this.destroyBetween(this.index,l),this.top.children[this.index]=u,u.contentDOM&&(u.dirty=Ct,u.updateChildren(i,s+1),u.dirty=ke),this.changed=!0,this.index++,!0;break}}return!1}recreateWrapper(e,n,r,i,o,s){if(e.dirty||n.isAtom||!e.children.length||!e.node.content.eq(n.content)||!Ir(r,e.outerDeco)||!i.eq(e.innerDeco))return null;let l=et.create(this.top,n,r,i,o,s);if(l.contentDOM){l.children=e.children,e.children=[];for(let a of l.children)a.parent=l}return e.destroy(),l}addNode(e,n,r,i,o){let s=et.create(this.top,e,n,r,i,o);s.contentDOM&&s.updateChildren(i,o+1),this.top.children.splice(this.index++,0,s),this.changed=!0}placeWidget(e,n,r){let i=this.index<this.top.children.length?this.top.children[this.index]:null;if(i&&i.matchesWidget(e)&&(e==i.widget||!i.widget.type.toDOM.parentNode))this.index++;else{let o=new Nr(this.top,e,n,r);this.top.children.splice(this.index++,0,o),this.changed=!0}}addTextblockHacks(){let e=this.top.children[this.index-1],n=this.top;for(;e instanceof en;)n=e,e=n.children[n.children.length-1];(!e||!(e instanceof Lr)||/\n$/.test(e.node.text)||this.view.requiresGeckoHackNode&&/\s$/.test(e.node.text))&&((ce||ie)&&e&&e.dom.contentEditable=="false"&&this.addHackNode("IMG",n),this.addHackNode("BR",this.top))}addHackNode(e,n){if(n==this.top&&this.index<n.children.length&&n.children[this.index].matchesHack(e))this.index++;else{let r=document.createElement(e);e=="IMG"&&(r.className="ProseMirror-separator",r.alt=""),e=="BR"&&(r.className="ProseMirror-trailingBreak");let i=new _r(this.top,[],r,null);n!=this.top?n.children.push(i):n.children.splice(this.index++,0,i),this.changed=!0}}isLocked(e){return this.lock&&(e==this.lock||e.nodeType==1&&e.contains(this.lock.parentNode))}};function Qp(t,e){let n=e,r=n.children.length,i=t.childCount,o=new Map,s=[];e:for(;i>0;){let l;for(;;)if(r){let c=n.children[r-1];if(c instanceof en)n=c,r=c.children.length;else{l=c,r--;break}}else{if(n==e)break e;r=n.parent.children.indexOf(n),n=n.parent}let a=l.node;if(a){if(a!=t.child(i-1))break;--i,o.set(l,i),s.push(l)}}return{index:i,matched:o,matches:s.reverse()}}function Xp(t,e){return t.type.side-e.type.side}function Zp(t,e,n,r){let i=e.locals(t),o=0;if(i.length==0){for(let c=0;c<t.childCount;c++){let d=t.child(c);r(d,i,e.forChild(o,d),c),o+=d.nodeSize}return}let s=0,l=[],a=null;for(let c=0;;){let d,u;for(;s<i.length&&i[s].to==o;){let g=i[s++];g.widget&&(d?(u||(u=[d])).push(g):d=g)}if(d)if(u){u.sort(Xp);for(let g=0;g<u.length;g++)n(u[g],c,!!a)}else n(d,c,!!a);let f,h;if(a)h=-1,f=a,a=null;else if(c<t.childCount)h=c,f=t.child(c++);else break;for(let g=0;g<l.length;g++)l[g].to<=o&&l.splice(g--,1);for(;s<i.length&&i[s].from<=o&&i[s].to>o;)l.push(i[s++]);let p=o+f.nodeSize;if(f.isText){let g=p;s<i.length&&i[s].from<g&&(g=i[s].from);for(let y=0;y<l.length;y++)l[y].to<g&&(g=l[y].to);g<p&&(a=f.cut(g-o),f=f.cut(0,g-o),p=g,h=-1)}else for(;s<i.length&&i[s].to<p;)s++;let m=f.isInline&&!f.isLeaf?l.filter(g=>!g.inline):l.slice();r(f,m,e.forChild(o,f),h),o=p}}function em(t){if(t.nodeName=="UL"||t.nodeName=="OL"){let e=t.style.cssText;t.style.cssText=e+"; list-style: square !important",window.getComputedStyle(t).listStyle,t.style.cssText=e}}function tm(t,e,n,r){for(let i=0,o=0;i<t.childCount&&o<=r;){let s=t.child(i++),l=o;if(o+=s.nodeSize,!s.isText)continue;let a=s.text;for(;i<t.childCount;){let c=t.child(i++);if(o+=c.nodeSize,!c.isText)break;a+=c.text}if(o>=n){if(o>=r&&a.slice(r-e.length-l,r-l)==e)return r-e.length;let c=l<r?a.lastIndexOf(e,r-l-1):-1;if(c>=0&&c+e.length+l>=n)return l+c;if(n==r&&a.length>=r+e.length-l&&a.slice(r-l,r-l+e.length)==e)return r}}return-1}function Zo(t,e,n,r,i){let o=[];for(let s=0,l=0;s<t.length;s++){let a=t[s],c=l,d=l+=a.size;c>=n||d<=e?o.push(a):(c<e&&o.push(a.slice(0,e-c,r)),i&&(o.push(i),i=void 0),d>n&&o.push(a.slice(n-c,a.size,r)))}return o}function us(t,e=null){let n=t.domSelectionRange(),r=t.state.doc;if(!n.focusNode)return null;let i=t.docView.nearestDesc(n.focusNode),o=i&&i.size==0,s=t.docView.posFromDOM(n.focusNode,n.focusOffset,1);if(s<0)return null;let l=r.resolve(s),a,c;if($r(n)){for(a=s;i&&!i.node;)i=i.parent;let u=i.node;if(i&&u.isAtom&&C.isSelectable(u)&&i.parent&&!(u.isInline&&Rp(n.focusNode,n.focusOffset,i.dom))){let f=i.posBefore;c=new C(s==f?l:r.resolve(f))}}else{if(n instanceof t.dom.ownerDocument.defaultView.Selection&&n.rangeCount>1){let u=s,f=s;for(let h=0;h<n.rangeCount;h++){let p=n.getRangeAt(h);u=Math.min(u,t.docView.posFromDOM(p.startContainer,p.startOffset,1)),f=Math.max(f,t.docView.posFromDOM(p.endContainer,p.endOffset,-1))}if(u<0)return null;[a,s]=f==t.state.selection.anchor?[f,u]:[u,f],l=r.resolve(s)}else a=t.docView.posFromDOM(n.anchorNode,n.anchorOffset,1);if(a<0)return null}let d=r.resolve(a);if(!c){let u=e=="pointer"||t.state.selection.head<l.pos&&!o?1:-1;c=fs(t,d,l,u)}return c}function Ed(t){return t.editable?t.hasFocus():wd(t)&&document.activeElement&&document.activeElement.contains(t.dom)}function je(t,e=!1){let n=t.state.selection;if(Sd(t,n),!!Ed(t)){if(!e&&t.input.mouseDown&&t.input.mouseDown.allowDefault&&ie){let r=t.domSelectionRange(),i=t.domObserver.currentSelection;if(r.anchorNode&&i.anchorNode&&Tt(r.anchorNode,r.anchorOffset,i.anchorNode,i.anchorOffset)){t.input.mouseDown.delayedSelectionSync=!0,t.domObserver.setCurSelection();return}}if(t.domObserver.disconnectSelection(),t.cursorWrapper)rm(t);else{let{anchor:r,head:i}=n,o,s;jc&&!(n instanceof S)&&(n.$from.parent.inlineContent||(o=Uc(t,n.from)),!n.empty&&!n.$from.parent.inlineContent&&(s=Uc(t,n.to))),t.docView.setSelection(r,i,t,e),jc&&(o&&Wc(o),s&&Wc(s)),n.visible?t.dom.classList.remove("ProseMirror-hideselection"):(t.dom.classList.add("ProseMirror-hideselection"),"onselectionchange"in document&&nm(t))}t.domObserver.setCurSelection(),t.domObserver.connectSelection()}}var jc=ce||ie&&hd<63;function Uc(t,e){let{node:n,offset:r}=t.docView.domFromPos(e,0),i=r<n.childNodes.length?n.childNodes[r]:null,o=r?n.childNodes[r-1]:null;if(ce&&i&&i.contentEditable=="false")return Vo(i);if((!i||i.contentEditable=="false")&&(!o||o.contentEditable=="false")){if(i)return Vo(i);if(o)return Vo(o)}}function Vo(t){return t.contentEditable="true",ce&&t.draggable&&(t.draggable=!1,t.wasDraggable=!0),t}function Wc(t){t.contentEditable="false",t.wasDraggable&&(t.draggable=!0,t.wasDraggable=null)}function nm(t){let e=t.dom.ownerDocument;e.removeEventListener("selectionchange",t.input.hideSelectionGuard);let n=t.domSelectionRange(),r=n.anchorNode,i=n.anchorOffset;e.addEventListener("selectionchange",t.input.hideSelectionGuard=()=>{(n.anchorNode!=r||n.anchorOffset!=i)&&(e.removeEventListener("selectionchange",t.input.hideSelectionGuard),setTimeout(()=>{(!Ed(t)||t.state.selection.visible)&&t.dom.classList.remove("ProseMirror-hideselection")},20))})}function rm(t){let e=t.domSelection();if(!e)return;let n=t.cursorWrapper.dom,r=n.nodeName=="IMG";r?e.collapse(n.parentNode,Q(n)+1):e.collapse(n,0),!r&&!t.state.selection.visible&&fe&&Ze<=11&&(n.disabled=!0,n.disabled=!1)}function Sd(t,e){if(e instanceof C){let n=t.docView.descAt(e.from);n!=t.lastSelectedViewDesc&&(qc(t),n&&n.selectNode(),t.lastSelectedViewDesc=n)}else qc(t)}function qc(t){t.lastSelectedViewDesc&&(t.lastSelectedViewDesc.parent&&t.lastSelectedViewDesc.deselectNode(),t.lastSelectedViewDesc=void 0)}function fs(t,e,n,r){return t.someProp("createSelectionBetween",i=>i(t,e,n))||S.between(e,n,r)}function Kc(t){return t.editable&&!t.hasFocus()?!1:wd(t)}function wd(t){let e=t.domSelectionRange();if(!e.anchorNode)return!1;try{return t.dom.contains(e.anchorNode.nodeType==3?e.anchorNode.parentNode:e.anchorNode)&&(t.editable||t.dom.contains(e.focusNode.nodeType==3?e.focusNode.parentNode:e.focusNode))}catch{return!1}}function im(t){let e=t.docView.domFromPos(t.state.selection.anchor,0),n=t.domSelectionRange();return Tt(e.node,e.offset,n.anchorNode,n.anchorOffset)}function es(t,e){let{$anchor:n,$head:r}=t.selection,i=e>0?n.max(r):n.min(r),o=i.parent.inlineContent?i.depth?t.doc.resolve(e>0?i.after():i.before()):null:i;return o&&M.findFrom(o,e)}function Qe(t,e){return t.dispatch(t.state.tr.setSelection(e).scrollIntoView()),!0}function Jc(t,e,n){let r=t.state.selection;if(r instanceof S)if(n.indexOf("s")>-1){let{$head:i}=r,o=i.textOffset?null:e<0?i.nodeBefore:i.nodeAfter;if(!o||o.isText||!o.isLeaf)return!1;let s=t.state.doc.resolve(i.pos+o.nodeSize*(e<0?-1:1));return Qe(t,new S(r.$anchor,s))}else if(r.empty){if(t.endOfTextblock(e>0?"forward":"backward")){let i=es(t.state,e);return i&&i instanceof C?Qe(t,i):!1}else if(!(ye&&n.indexOf("m")>-1)){let i=r.$head,o=i.textOffset?null:e<0?i.nodeBefore:i.nodeAfter,s;if(!o||o.isText)return!1;let l=e<0?i.pos-o.nodeSize:i.pos;return o.isAtom||(s=t.docView.descAt(l))&&!s.contentDOM?C.isSelectable(o)?Qe(t,new C(e<0?t.state.doc.resolve(i.pos-o.nodeSize):i)):In?Qe(t,new S(t.state.doc.resolve(e<0?l:l+o.nodeSize))):!1:!1}}else return!1;else{if(r instanceof C&&r.node.isInline)return Qe(t,new S(e>0?r.$to:r.$from));{let i=es(t.state,e);return i?Qe(t,i):!1}}}function Dr(t){return t.nodeType==3?t.nodeValue.length:t.childNodes.length}function On(t,e){let n=t.pmViewDesc;return n&&n.size==0&&(e<0||t.nextSibling||t.nodeName!="BR")}function Gt(t,e){return e<0?om(t):sm(t)}function om(t){let e=t.domSelectionRange(),n=e.focusNode,r=e.focusOffset;if(!n)return;let i,o,s=!1;for(ve&&n.nodeType==1&&r<Dr(n)&&On(n.childNodes[r],-1)&&(s=!0);;)if(r>0){if(n.nodeType!=1)break;{let l=n.childNodes[r-1];if(On(l,-1))i=n,o=--r;else if(l.nodeType==3)n=l,r=n.nodeValue.length;else break}}else{if(Md(n))break;{let l=n.previousSibling;for(;l&&On(l,-1);)i=n.parentNode,o=Q(l),l=l.previousSibling;if(l)n=l,r=Dr(n);else{if(n=n.parentNode,n==t.dom)break;r=0}}}s?ts(t,n,r):i&&ts(t,i,o)}function sm(t){let e=t.domSelectionRange(),n=e.focusNode,r=e.focusOffset;if(!n)return;let i=Dr(n),o,s;for(;;)if(r<i){if(n.nodeType!=1)break;let l=n.childNodes[r];if(On(l,1))o=n,s=++r;else break}else{if(Md(n))break;{let l=n.nextSibling;for(;l&&On(l,1);)o=l.parentNode,s=Q(l)+1,l=l.nextSibling;if(l)n=l,r=0,i=Dr(n);else{if(n=n.parentNode,n==t.dom)break;r=i=0}}}o&&ts(t,o,s)}function Md(t){let e=t.pmViewDesc;return e&&e.node&&e.node.isBlock}function lm(t,e){for(;t&&e==t.childNodes.length&&!_n(t);)e=Q(t)+1,t=t.parentNode;for(;t&&e<t.childNodes.length;){let n=t.childNodes[e];if(n.nodeType==3)return n;if(n.nodeType==1&&n.contentEditable=="false")break;t=n,e=0}}function am(t,e){for(;t&&!e&&!_n(t);)e=Q(t),t=t.parentNode;for(;t&&e;){let n=t.childNodes[e-1];if(n.nodeType==3)return n;if(n.nodeType==1&&n.contentEditable=="false")break;t=n,e=t.childNodes.length}}function ts(t,e,n){if(e.nodeType!=3){let o,s;(s=lm(e,n))?(e=s,n=0):(o=am(e,n))&&(e=o,n=o.nodeValue.length)}let r=t.domSelection();if(!r)return;if($r(r)){let o=document.createRange();o.setEnd(e,n),o.setStart(e,n),r.removeAllRanges(),r.addRange(o)}else r.extend&&r.extend(e,n);t.domObserver.setCurSelection();let{state:i}=t;setTimeout(()=>{t.state==i&&je(t)},50)}function Gc(t,e){let n=t.state.doc.resolve(e);if(!(ie||_p)&&n.parent.inlineContent){let i=t.coordsAtPos(e);if(e>n.start()){let o=t.coordsAtPos(e-1),s=(o.top+o.bottom)/2;if(s>i.top&&s<i.bottom&&Math.abs(o.left-i.left)>1)return o.left<i.left?"ltr":"rtl"}if(e<n.end()){let o=t.coordsAtPos(e+1),s=(o.top+o.bottom)/2;if(s>i.top&&s<i.bottom&&Math.abs(o.left-i.left)>1)return o.left>i.left?"ltr":"rtl"}}return getComputedStyle(t.dom).direction=="rtl"?"rtl":"ltr"}function Yc(t,e,n){let r=t.state.selection;if(r instanceof S&&!r.empty||n.indexOf("s")>-1||ye&&n.indexOf("m")>-1)return!1;let{$from:i,$to:o}=r;if(!i.parent.inlineContent||t.endOfTextblock(e<0?"up":"down")){let s=es(t.state,e);if(s&&s instanceof C)return Qe(t,s)}if(!i.parent.inlineContent){let s=e<0?i:o,l=r instanceof ae?M.near(s,e):M.findFrom(s,e);return l?Qe(t,l):!1}return!1}function Qc(t,e){if(!(t.state.selection instanceof S))return!0;let{$head:n,$anchor:r,empty:i}=t.state.selection;if(!n.sameParent(r))return!0;if(!i)return!1;if(t.endOfTextblock(e>0?"forward":"backward"))return!0;let o=!n.textOffset&&(e<0?n.nodeBefore:n.nodeAfter);if(o&&!o.isText){let s=t.state.tr;return e<0?s.delete(n.pos-o.nodeSize,n.pos):s.delete(n.pos,n.pos+o.nodeSize),t.dispatch(s),!0}return!1}function Xc(t,e,n){t.domObserver.stop(),e.contentEditable=n,t.domObserver.start()}function cm(t){if(!ce||t.state.selection.$head.parentOffset>0)return!1;let{focusNode:e,focusOffset:n}=t.domSelectionRange();if(e&&e.nodeType==1&&n==0&&e.firstChild&&e.firstChild.contentEditable=="false"){let r=e.firstChild;Xc(t,r,"true"),setTimeout(()=>Xc(t,r,"false"),20)}return!1}function dm(t){let e="";return t.ctrlKey&&(e+="c"),t.metaKey&&(e+="m"),t.altKey&&(e+="a"),t.shiftKey&&(e+="s"),e}function um(t,e){let n=e.keyCode,r=dm(e);if(n==8||ye&&n==72&&r=="c")return Qc(t,-1)||Gt(t,-1);if(n==46&&!e.shiftKey||ye&&n==68&&r=="c")return Qc(t,1)||Gt(t,1);if(n==13||n==27)return!0;if(n==37||ye&&n==66&&r=="c"){let i=n==37?Gc(t,t.state.selection.from)=="ltr"?-1:1:-1;return Jc(t,i,r)||Gt(t,i)}else if(n==39||ye&&n==70&&r=="c"){let i=n==39?Gc(t,t.state.selection.from)=="ltr"?1:-1:1;return Jc(t,i,r)||Gt(t,i)}else{if(n==38||ye&&n==80&&r=="c")return Yc(t,-1,r)||Gt(t,-1);if(n==40||ye&&n==78&&r=="c")return cm(t)||Yc(t,1,r)||Gt(t,1);if(r==(ye?"m":"c")&&(n==66||n==73||n==89||n==90))return!0}return!1}function hs(t,e){t.someProp("transformCopied",h=>{e=h(e,t)});let n=[],{content:r,openStart:i,openEnd:o}=e;for(;i>1&&o>1&&r.childCount==1&&r.firstChild.childCount==1;){i--,o--;let h=r.firstChild;n.push(h.type.name,h.attrs!=h.type.defaultAttrs?h.attrs:null),r=h.content}let s=t.someProp("clipboardSerializer")||Ge.fromSchema(t.state.schema),l=Ld(),a=l.createElement("div");a.appendChild(s.serializeFragment(r,{document:l}));let c=a.firstChild,d,u=0;for(;c&&c.nodeType==1&&(d=Nd[c.nodeName.toLowerCase()]);){for(let h=d.length-1;h>=0;h--){let p=l.createElement(d[h]);for(;a.firstChild;)p.appendChild(a.firstChild);a.appendChild(p),u++}c=a.firstChild}c&&c.nodeType==1&&c.setAttribute("data-pm-slice",`${i} ${o}${u?` -${u}`:""} ${JSON.stringify(n)}`);let f=t.someProp("clipboardTextSerializer",h=>h(e,t))||e.content.textBetween(0,e.content.size,`
+"use strict";var FormgenRelationshipsPreact=(()=>{var Bi=Object.defineProperty;var Ub=Object.getOwnPropertyDescriptor;var $b=Object.getOwnPropertyNames;var jb=Object.prototype.hasOwnProperty;var Wb=(t,e,n)=>e in t?Bi(t,e,{enumerable:!0,configurable:!0,writable:!0,value:n}):t[e]=n;var zi=(t,e)=>()=>(t&&(e=t(t=0)),e);var Vi=(t,e)=>{for(var n in e)Bi(t,n,{get:e[n],enumerable:!0})},qb=(t,e,n,r)=>{if(e&&typeof e=="object"||typeof e=="function")for(let i of $b(e))!jb.call(t,i)&&i!==n&&Bi(t,i,{get:()=>e[i],enumerable:!(r=Ub(e,i))||r.enumerable});return t};var Kb=t=>qb(Bi({},"__esModule",{value:!0}),t);var F=(t,e,n)=>(Wb(t,typeof e!="symbol"?e+"":e,n),n);function mo(t,e,n){return ue(t,_e(e,n))}function We(t,e){return typeof t=="function"?t(e):t}function Ne(t){return t.split("-")[0]}function qe(t){return t.split("-")[1]}function go(t){return t==="x"?"y":"x"}function yo(t){return t==="y"?"height":"width"}function Ke(t){return qE.has(Ne(t))?"y":"x"}function bo(t){return go(Ke(t))}function za(t,e,n){n===void 0&&(n=!1);let r=qe(t),i=bo(t),o=yo(i),s=i==="x"?r===(n?"end":"start")?"right":"left":r==="start"?"bottom":"top";return e.reference[o]>e.floating[o]&&(s=Gr(s)),[s,Gr(s)]}function uf(t){let e=Gr(t);return[Jr(t),e,Jr(e)]}function Jr(t){return t.replace(/start|end/g,e=>WE[e])}function GE(t,e,n){switch(t){case"top":case"bottom":return n?e?df:cf:e?cf:df;case"left":case"right":return e?KE:JE;default:return[]}}function ff(t,e,n,r){let i=qe(t),o=GE(Ne(t),n==="start",r);return i&&(o=o.map(s=>s+"-"+i),e&&(o=o.concat(o.map(Jr)))),o}function Gr(t){return t.replace(/left|right|bottom|top/g,e=>jE[e])}function YE(t){return{top:0,right:0,bottom:0,left:0,...t}}function Eo(t){return typeof t!="number"?YE(t):{top:t,right:t,bottom:t,left:t}}function Tt(t){let{x:e,y:n,width:r,height:i}=t;return{width:r,height:i,top:n,left:e,right:e+r,bottom:n+i,x:e,y:n}}var _a,lf,Ba,_e,ue,Yr,Xr,st,jE,WE,qE,cf,df,KE,JE,xo=zi(()=>{_a=["top","right","bottom","left"],lf=["start","end"],Ba=_a.reduce((t,e)=>t.concat(e,e+"-"+lf[0],e+"-"+lf[1]),[]),_e=Math.min,ue=Math.max,Yr=Math.round,Xr=Math.floor,st=t=>({x:t,y:t}),jE={left:"right",right:"left",bottom:"top",top:"bottom"},WE={start:"end",end:"start"};qE=new Set(["top","bottom"]);cf=["left","right"],df=["right","left"],KE=["top","bottom"],JE=["bottom","top"]});function pf(t,e,n){let{reference:r,floating:i}=t,o=Ke(e),s=bo(e),a=yo(s),l=Ne(e),c=o==="y",d=r.x+r.width/2-i.width/2,u=r.y+r.height/2-i.height/2,f=r[a]/2-i[a]/2,p;switch(l){case"top":p={x:d,y:r.y-i.height};break;case"bottom":p={x:d,y:r.y+r.height};break;case"right":p={x:r.x+r.width,y:u};break;case"left":p={x:r.x-i.width,y:u};break;default:p={x:r.x,y:r.y}}switch(qe(e)){case"start":p[s]-=f*(n&&c?-1:1);break;case"end":p[s]+=f*(n&&c?-1:1);break}return p}async function Vt(t,e){var n;e===void 0&&(e={});let{x:r,y:i,platform:o,rects:s,elements:a,strategy:l}=t,{boundary:c="clippingAncestors",rootBoundary:d="viewport",elementContext:u="floating",altBoundary:f=!1,padding:p=0}=We(e,t),h=Eo(p),g=a[f?u==="floating"?"reference":"floating":u],y=Tt(await o.getClippingRect({element:(n=await(o.isElement==null?void 0:o.isElement(g)))==null||n?g:g.contextElement||await(o.getDocumentElement==null?void 0:o.getDocumentElement(a.floating)),boundary:c,rootBoundary:d,strategy:l})),E=u==="floating"?{x:r,y:i,width:s.floating.width,height:s.floating.height}:s.reference,x=await(o.getOffsetParent==null?void 0:o.getOffsetParent(a.floating)),k=await(o.isElement==null?void 0:o.isElement(x))?await(o.getScale==null?void 0:o.getScale(x))||{x:1,y:1}:{x:1,y:1},S=Tt(o.convertOffsetParentRelativeRectToViewportRelativeRect?await o.convertOffsetParentRelativeRectToViewportRelativeRect({elements:a,rect:E,offsetParent:x,strategy:l}):E);return{top:(y.top-S.top+h.top)/k.y,bottom:(S.bottom-y.bottom+h.bottom)/k.y,left:(y.left-S.left+h.left)/k.x,right:(S.right-y.right+h.right)/k.x}}function XE(t,e,n){return(t?[...n.filter(i=>qe(i)===t),...n.filter(i=>qe(i)!==t)]:n.filter(i=>Ne(i)===i)).filter(i=>t?qe(i)===t||(e?Jr(i)!==i:!1):!0)}function hf(t,e){return{top:t.top-e.height,right:t.right-e.width,bottom:t.bottom-e.height,left:t.left-e.width}}function mf(t){return _a.some(e=>t[e]>=0)}function Cf(t){let e=_e(...t.map(o=>o.left)),n=_e(...t.map(o=>o.top)),r=ue(...t.map(o=>o.right)),i=ue(...t.map(o=>o.bottom));return{x:e,y:n,width:r-e,height:i-n}}function QE(t){let e=t.slice().sort((i,o)=>i.y-o.y),n=[],r=null;for(let i=0;i<e.length;i++){let o=e[i];!r||o.y-r.y>r.height/2?n.push([o]):n[n.length-1].push(o),r=o}return n.map(i=>Tt(Cf(i)))}async function ZE(t,e){let{placement:n,platform:r,elements:i}=t,o=await(r.isRTL==null?void 0:r.isRTL(i.floating)),s=Ne(n),a=qe(n),l=Ke(n)==="y",c=Tf.has(s)?-1:1,d=o&&l?-1:1,u=We(e,t),{mainAxis:f,crossAxis:p,alignmentAxis:h}=typeof u=="number"?{mainAxis:u,crossAxis:0,alignmentAxis:null}:{mainAxis:u.mainAxis||0,crossAxis:u.crossAxis||0,alignmentAxis:u.alignmentAxis};return a&&typeof h=="number"&&(p=a==="end"?h*-1:h),l?{x:p*d,y:f*c}:{x:f*c,y:p*d}}var gf,yf,bf,Ef,xf,vf,Tf,Sf,kf,Mf,wf,Af=zi(()=>{xo();xo();gf=async(t,e,n)=>{let{placement:r="bottom",strategy:i="absolute",middleware:o=[],platform:s}=n,a=o.filter(Boolean),l=await(s.isRTL==null?void 0:s.isRTL(e)),c=await s.getElementRects({reference:t,floating:e,strategy:i}),{x:d,y:u}=pf(c,r,l),f=r,p={},h=0;for(let m=0;m<a.length;m++){let{name:g,fn:y}=a[m],{x:E,y:x,data:k,reset:S}=await y({x:d,y:u,initialPlacement:r,placement:f,strategy:i,middlewareData:p,rects:c,platform:s,elements:{reference:t,floating:e}});d=E!=null?E:d,u=x!=null?x:u,p={...p,[g]:{...p[g],...k}},S&&h<=50&&(h++,typeof S=="object"&&(S.placement&&(f=S.placement),S.rects&&(c=S.rects===!0?await s.getElementRects({reference:t,floating:e,strategy:i}):S.rects),{x:d,y:u}=pf(c,f,l)),m=-1)}return{x:d,y:u,placement:f,strategy:i,middlewareData:p}};yf=t=>({name:"arrow",options:t,async fn(e){let{x:n,y:r,placement:i,rects:o,platform:s,elements:a,middlewareData:l}=e,{element:c,padding:d=0}=We(t,e)||{};if(c==null)return{};let u=Eo(d),f={x:n,y:r},p=bo(i),h=yo(p),m=await s.getDimensions(c),g=p==="y",y=g?"top":"left",E=g?"bottom":"right",x=g?"clientHeight":"clientWidth",k=o.reference[h]+o.reference[p]-f[p]-o.floating[h],S=f[p]-o.reference[p],R=await(s.getOffsetParent==null?void 0:s.getOffsetParent(c)),M=R?R[x]:0;(!M||!await(s.isElement==null?void 0:s.isElement(R)))&&(M=a.floating[x]||o.floating[h]);let I=k/2-S/2,H=M/2-m[h]/2-1,b=_e(u[y],H),Q=_e(u[E],H),A=b,P=M-m[h]-Q,B=M/2-m[h]/2+I,Z=mo(A,B,P),K=!l.arrow&&qe(i)!=null&&B!==Z&&o.reference[h]/2-(B<A?b:Q)-m[h]/2<0,z=K?B<A?B-A:B-P:0;return{[p]:f[p]+z,data:{[p]:Z,centerOffset:B-Z-z,...K&&{alignmentOffset:z}},reset:K}}});bf=function(t){return t===void 0&&(t={}),{name:"autoPlacement",options:t,async fn(e){var n,r,i;let{rects:o,middlewareData:s,placement:a,platform:l,elements:c}=e,{crossAxis:d=!1,alignment:u,allowedPlacements:f=Ba,autoAlignment:p=!0,...h}=We(t,e),m=u!==void 0||f===Ba?XE(u||null,p,f):f,g=await Vt(e,h),y=((n=s.autoPlacement)==null?void 0:n.index)||0,E=m[y];if(E==null)return{};let x=za(E,o,await(l.isRTL==null?void 0:l.isRTL(c.floating)));if(a!==E)return{reset:{placement:m[0]}};let k=[g[Ne(E)],g[x[0]],g[x[1]]],S=[...((r=s.autoPlacement)==null?void 0:r.overflows)||[],{placement:E,overflows:k}],R=m[y+1];if(R)return{data:{index:y+1,overflows:S},reset:{placement:R}};let M=S.map(b=>{let Q=qe(b.placement);return[b.placement,Q&&d?b.overflows.slice(0,2).reduce((A,P)=>A+P,0):b.overflows[0],b.overflows]}).sort((b,Q)=>b[1]-Q[1]),H=((i=M.filter(b=>b[2].slice(0,qe(b[0])?2:3).every(Q=>Q<=0))[0])==null?void 0:i[0])||M[0][0];return H!==a?{data:{index:y+1,overflows:S},reset:{placement:H}}:{}}}},Ef=function(t){return t===void 0&&(t={}),{name:"flip",options:t,async fn(e){var n,r;let{placement:i,middlewareData:o,rects:s,initialPlacement:a,platform:l,elements:c}=e,{mainAxis:d=!0,crossAxis:u=!0,fallbackPlacements:f,fallbackStrategy:p="bestFit",fallbackAxisSideDirection:h="none",flipAlignment:m=!0,...g}=We(t,e);if((n=o.arrow)!=null&&n.alignmentOffset)return{};let y=Ne(i),E=Ke(a),x=Ne(a)===a,k=await(l.isRTL==null?void 0:l.isRTL(c.floating)),S=f||(x||!m?[Gr(a)]:uf(a)),R=h!=="none";!f&&R&&S.push(...ff(a,m,h,k));let M=[a,...S],I=await Vt(e,g),H=[],b=((r=o.flip)==null?void 0:r.overflows)||[];if(d&&H.push(I[y]),u){let B=za(i,s,k);H.push(I[B[0]],I[B[1]])}if(b=[...b,{placement:i,overflows:H}],!H.every(B=>B<=0)){var Q,A;let B=(((Q=o.flip)==null?void 0:Q.index)||0)+1,Z=M[B];if(Z&&(!(u==="alignment"?E!==Ke(Z):!1)||b.every(ie=>Ke(ie.placement)===E?ie.overflows[0]>0:!0)))return{data:{index:B,overflows:b},reset:{placement:Z}};let K=(A=b.filter(z=>z.overflows[0]<=0).sort((z,ie)=>z.overflows[1]-ie.overflows[1])[0])==null?void 0:A.placement;if(!K)switch(p){case"bestFit":{var P;let z=(P=b.filter(ie=>{if(R){let Ht=Ke(ie.placement);return Ht===E||Ht==="y"}return!0}).map(ie=>[ie.placement,ie.overflows.filter(Ht=>Ht>0).reduce((Ht,Vb)=>Ht+Vb,0)]).sort((ie,Ht)=>ie[1]-Ht[1])[0])==null?void 0:P[0];z&&(K=z);break}case"initialPlacement":K=a;break}if(i!==K)return{reset:{placement:K}}}return{}}}};xf=function(t){return t===void 0&&(t={}),{name:"hide",options:t,async fn(e){let{rects:n}=e,{strategy:r="referenceHidden",...i}=We(t,e);switch(r){case"referenceHidden":{let o=await Vt(e,{...i,elementContext:"reference"}),s=hf(o,n.reference);return{data:{referenceHiddenOffsets:s,referenceHidden:mf(s)}}}case"escaped":{let o=await Vt(e,{...i,altBoundary:!0}),s=hf(o,n.floating);return{data:{escapedOffsets:s,escaped:mf(s)}}}default:return{}}}}};vf=function(t){return t===void 0&&(t={}),{name:"inline",options:t,async fn(e){let{placement:n,elements:r,rects:i,platform:o,strategy:s}=e,{padding:a=2,x:l,y:c}=We(t,e),d=Array.from(await(o.getClientRects==null?void 0:o.getClientRects(r.reference))||[]),u=QE(d),f=Tt(Cf(d)),p=Eo(a);function h(){if(u.length===2&&u[0].left>u[1].right&&l!=null&&c!=null)return u.find(g=>l>g.left-p.left&&l<g.right+p.right&&c>g.top-p.top&&c<g.bottom+p.bottom)||f;if(u.length>=2){if(Ke(n)==="y"){let b=u[0],Q=u[u.length-1],A=Ne(n)==="top",P=b.top,B=Q.bottom,Z=A?b.left:Q.left,K=A?b.right:Q.right,z=K-Z,ie=B-P;return{top:P,bottom:B,left:Z,right:K,width:z,height:ie,x:Z,y:P}}let g=Ne(n)==="left",y=ue(...u.map(b=>b.right)),E=_e(...u.map(b=>b.left)),x=u.filter(b=>g?b.left===E:b.right===y),k=x[0].top,S=x[x.length-1].bottom,R=E,M=y,I=M-R,H=S-k;return{top:k,bottom:S,left:R,right:M,width:I,height:H,x:R,y:k}}return f}let m=await o.getElementRects({reference:{getBoundingClientRect:h},floating:r.floating,strategy:s});return i.reference.x!==m.reference.x||i.reference.y!==m.reference.y||i.reference.width!==m.reference.width||i.reference.height!==m.reference.height?{reset:{rects:m}}:{}}}},Tf=new Set(["left","top"]);Sf=function(t){return t===void 0&&(t=0),{name:"offset",options:t,async fn(e){var n,r;let{x:i,y:o,placement:s,middlewareData:a}=e,l=await ZE(e,t);return s===((n=a.offset)==null?void 0:n.placement)&&(r=a.arrow)!=null&&r.alignmentOffset?{}:{x:i+l.x,y:o+l.y,data:{...l,placement:s}}}}},kf=function(t){return t===void 0&&(t={}),{name:"shift",options:t,async fn(e){let{x:n,y:r,placement:i}=e,{mainAxis:o=!0,crossAxis:s=!1,limiter:a={fn:g=>{let{x:y,y:E}=g;return{x:y,y:E}}},...l}=We(t,e),c={x:n,y:r},d=await Vt(e,l),u=Ke(Ne(i)),f=go(u),p=c[f],h=c[u];if(o){let g=f==="y"?"top":"left",y=f==="y"?"bottom":"right",E=p+d[g],x=p-d[y];p=mo(E,p,x)}if(s){let g=u==="y"?"top":"left",y=u==="y"?"bottom":"right",E=h+d[g],x=h-d[y];h=mo(E,h,x)}let m=a.fn({...e,[f]:p,[u]:h});return{...m,data:{x:m.x-n,y:m.y-r,enabled:{[f]:o,[u]:s}}}}}},Mf=function(t){return t===void 0&&(t={}),{options:t,fn(e){let{x:n,y:r,placement:i,rects:o,middlewareData:s}=e,{offset:a=0,mainAxis:l=!0,crossAxis:c=!0}=We(t,e),d={x:n,y:r},u=Ke(i),f=go(u),p=d[f],h=d[u],m=We(a,e),g=typeof m=="number"?{mainAxis:m,crossAxis:0}:{mainAxis:0,crossAxis:0,...m};if(l){let x=f==="y"?"height":"width",k=o.reference[f]-o.floating[x]+g.mainAxis,S=o.reference[f]+o.reference[x]-g.mainAxis;p<k?p=k:p>S&&(p=S)}if(c){var y,E;let x=f==="y"?"width":"height",k=Tf.has(Ne(i)),S=o.reference[u]-o.floating[x]+(k&&((y=s.offset)==null?void 0:y[u])||0)+(k?0:g.crossAxis),R=o.reference[u]+o.reference[x]+(k?0:((E=s.offset)==null?void 0:E[u])||0)-(k?g.crossAxis:0);h<S?h=S:h>R&&(h=R)}return{[f]:p,[u]:h}}}},wf=function(t){return t===void 0&&(t={}),{name:"size",options:t,async fn(e){var n,r;let{placement:i,rects:o,platform:s,elements:a}=e,{apply:l=()=>{},...c}=We(t,e),d=await Vt(e,c),u=Ne(i),f=qe(i),p=Ke(i)==="y",{width:h,height:m}=o.floating,g,y;u==="top"||u==="bottom"?(g=u,y=f===(await(s.isRTL==null?void 0:s.isRTL(a.floating))?"start":"end")?"left":"right"):(y=u,g=f==="end"?"top":"bottom");let E=m-d.top-d.bottom,x=h-d.left-d.right,k=_e(m-d[g],E),S=_e(h-d[y],x),R=!e.middlewareData.shift,M=k,I=S;if((n=e.middlewareData.shift)!=null&&n.enabled.x&&(I=x),(r=e.middlewareData.shift)!=null&&r.enabled.y&&(M=E),R&&!f){let b=ue(d.left,0),Q=ue(d.right,0),A=ue(d.top,0),P=ue(d.bottom,0);p?I=h-2*(b!==0||Q!==0?b+Q:ue(d.left,d.right)):M=m-2*(A!==0||P!==0?A+P:ue(d.top,d.bottom))}await l({...e,availableWidth:I,availableHeight:M});let H=await s.getDimensions(a.floating);return h!==H.width||m!==H.height?{reset:{rects:!0}}:{}}}}});function Co(){return typeof window!="undefined"}function un(t){return Of(t)?(t.nodeName||"").toLowerCase():"#document"}function Ie(t){var e;return(t==null||(e=t.ownerDocument)==null?void 0:e.defaultView)||window}function at(t){var e;return(e=(Of(t)?t.ownerDocument:t.document)||window.document)==null?void 0:e.documentElement}function Of(t){return Co()?t instanceof Node||t instanceof Ie(t).Node:!1}function Je(t){return Co()?t instanceof Element||t instanceof Ie(t).Element:!1}function lt(t){return Co()?t instanceof HTMLElement||t instanceof Ie(t).HTMLElement:!1}function Rf(t){return!Co()||typeof ShadowRoot=="undefined"?!1:t instanceof ShadowRoot||t instanceof Ie(t).ShadowRoot}function tr(t){let{overflow:e,overflowX:n,overflowY:r,display:i}=Ge(t);return/auto|scroll|overlay|hidden|clip/.test(e+r+n)&&!ex.has(i)}function Lf(t){return tx.has(un(t))}function Qr(t){return nx.some(e=>{try{return t.matches(e)}catch{return!1}})}function vo(t){let e=To(),n=Je(t)?Ge(t):t;return rx.some(r=>n[r]?n[r]!=="none":!1)||(n.containerType?n.containerType!=="normal":!1)||!e&&(n.backdropFilter?n.backdropFilter!=="none":!1)||!e&&(n.filter?n.filter!=="none":!1)||ix.some(r=>(n.willChange||"").includes(r))||ox.some(r=>(n.contain||"").includes(r))}function Nf(t){let e=St(t);for(;lt(e)&&!fn(e);){if(vo(e))return e;if(Qr(e))return null;e=St(e)}return null}function To(){return typeof CSS=="undefined"||!CSS.supports?!1:CSS.supports("-webkit-backdrop-filter","none")}function fn(t){return sx.has(un(t))}function Ge(t){return Ie(t).getComputedStyle(t)}function Zr(t){return Je(t)?{scrollLeft:t.scrollLeft,scrollTop:t.scrollTop}:{scrollLeft:t.scrollX,scrollTop:t.scrollY}}function St(t){if(un(t)==="html")return t;let e=t.assignedSlot||t.parentNode||Rf(t)&&t.host||at(t);return Rf(e)?e.host:e}function If(t){let e=St(t);return fn(e)?t.ownerDocument?t.ownerDocument.body:t.body:lt(e)&&tr(e)?e:If(e)}function Ut(t,e,n){var r;e===void 0&&(e=[]),n===void 0&&(n=!0);let i=If(t),o=i===((r=t.ownerDocument)==null?void 0:r.body),s=Ie(i);if(o){let a=So(s);return e.concat(s,s.visualViewport||[],tr(i)?i:[],a&&n?Ut(a):[])}return e.concat(i,Ut(i,[],n))}function So(t){return t.parent&&Object.getPrototypeOf(t.parent)?t.frameElement:null}var ex,tx,nx,rx,ix,ox,sx,Va=zi(()=>{ex=new Set(["inline","contents"]);tx=new Set(["table","td","th"]);nx=[":popover-open",":modal"];rx=["transform","translate","scale","rotate","perspective"],ix=["transform","translate","scale","rotate","perspective","filter"],ox=["paint","layout","strict","content"];sx=new Set(["html","body","#document"])});var jf={};Vi(jf,{arrow:()=>Ox,autoPlacement:()=>kx,autoUpdate:()=>vx,computePosition:()=>Ix,detectOverflow:()=>Tx,flip:()=>wx,getOverflowAncestors:()=>Ut,hide:()=>Rx,inline:()=>Lx,limitShift:()=>Nx,offset:()=>Sx,platform:()=>Uf,shift:()=>Mx,size:()=>Ax});function Ff(t){let e=Ge(t),n=parseFloat(e.width)||0,r=parseFloat(e.height)||0,i=lt(t),o=i?t.offsetWidth:n,s=i?t.offsetHeight:r,a=Yr(n)!==o||Yr(r)!==s;return a&&(n=o,r=s),{width:n,height:r,$:a}}function $a(t){return Je(t)?t:t.contextElement}function nr(t){let e=$a(t);if(!lt(e))return st(1);let n=e.getBoundingClientRect(),{width:r,height:i,$:o}=Ff(e),s=(o?Yr(n.width):n.width)/r,a=(o?Yr(n.height):n.height)/i;return(!s||!Number.isFinite(s))&&(s=1),(!a||!Number.isFinite(a))&&(a=1),{x:s,y:a}}function _f(t){let e=Ie(t);return!To()||!e.visualViewport?ax:{x:e.visualViewport.offsetLeft,y:e.visualViewport.offsetTop}}function lx(t,e,n){return e===void 0&&(e=!1),!n||e&&n!==Ie(t)?!1:e}function pn(t,e,n,r){e===void 0&&(e=!1),n===void 0&&(n=!1);let i=t.getBoundingClientRect(),o=$a(t),s=st(1);e&&(r?Je(r)&&(s=nr(r)):s=nr(t));let a=lx(o,n,r)?_f(o):st(0),l=(i.left+a.x)/s.x,c=(i.top+a.y)/s.y,d=i.width/s.x,u=i.height/s.y;if(o){let f=Ie(o),p=r&&Je(r)?Ie(r):r,h=f,m=So(h);for(;m&&r&&p!==h;){let g=nr(m),y=m.getBoundingClientRect(),E=Ge(m),x=y.left+(m.clientLeft+parseFloat(E.paddingLeft))*g.x,k=y.top+(m.clientTop+parseFloat(E.paddingTop))*g.y;l*=g.x,c*=g.y,d*=g.x,u*=g.y,l+=x,c+=k,h=Ie(m),m=So(h)}}return Tt({width:d,height:u,x:l,y:c})}function ko(t,e){let n=Zr(t).scrollLeft;return e?e.left+n:pn(at(t)).left+n}function Bf(t,e){let n=t.getBoundingClientRect(),r=n.left+e.scrollLeft-ko(t,n),i=n.top+e.scrollTop;return{x:r,y:i}}function cx(t){let{elements:e,rect:n,offsetParent:r,strategy:i}=t,o=i==="fixed",s=at(r),a=e?Qr(e.floating):!1;if(r===s||a&&o)return n;let l={scrollLeft:0,scrollTop:0},c=st(1),d=st(0),u=lt(r);if((u||!u&&!o)&&((un(r)!=="body"||tr(s))&&(l=Zr(r)),lt(r))){let p=pn(r);c=nr(r),d.x=p.x+r.clientLeft,d.y=p.y+r.clientTop}let f=s&&!u&&!o?Bf(s,l):st(0);return{width:n.width*c.x,height:n.height*c.y,x:n.x*c.x-l.scrollLeft*c.x+d.x+f.x,y:n.y*c.y-l.scrollTop*c.y+d.y+f.y}}function dx(t){return Array.from(t.getClientRects())}function ux(t){let e=at(t),n=Zr(t),r=t.ownerDocument.body,i=ue(e.scrollWidth,e.clientWidth,r.scrollWidth,r.clientWidth),o=ue(e.scrollHeight,e.clientHeight,r.scrollHeight,r.clientHeight),s=-n.scrollLeft+ko(t),a=-n.scrollTop;return Ge(r).direction==="rtl"&&(s+=ue(e.clientWidth,r.clientWidth)-i),{width:i,height:o,x:s,y:a}}function fx(t,e){let n=Ie(t),r=at(t),i=n.visualViewport,o=r.clientWidth,s=r.clientHeight,a=0,l=0;if(i){o=i.width,s=i.height;let d=To();(!d||d&&e==="fixed")&&(a=i.offsetLeft,l=i.offsetTop)}let c=ko(r);if(c<=0){let d=r.ownerDocument,u=d.body,f=getComputedStyle(u),p=d.compatMode==="CSS1Compat"&&parseFloat(f.marginLeft)+parseFloat(f.marginRight)||0,h=Math.abs(r.clientWidth-u.clientWidth-p);h<=Pf&&(o-=h)}else c<=Pf&&(o+=c);return{width:o,height:s,x:a,y:l}}function hx(t,e){let n=pn(t,!0,e==="fixed"),r=n.top+t.clientTop,i=n.left+t.clientLeft,o=lt(t)?nr(t):st(1),s=t.clientWidth*o.x,a=t.clientHeight*o.y,l=i*o.x,c=r*o.y;return{width:s,height:a,x:l,y:c}}function Df(t,e,n){let r;if(e==="viewport")r=fx(t,n);else if(e==="document")r=ux(at(t));else if(Je(e))r=hx(e,n);else{let i=_f(t);r={x:e.x-i.x,y:e.y-i.y,width:e.width,height:e.height}}return Tt(r)}function zf(t,e){let n=St(t);return n===e||!Je(n)||fn(n)?!1:Ge(n).position==="fixed"||zf(n,e)}function mx(t,e){let n=e.get(t);if(n)return n;let r=Ut(t,[],!1).filter(a=>Je(a)&&un(a)!=="body"),i=null,o=Ge(t).position==="fixed",s=o?St(t):t;for(;Je(s)&&!fn(s);){let a=Ge(s),l=vo(s);!l&&a.position==="fixed"&&(i=null),(o?!l&&!i:!l&&a.position==="static"&&!!i&&px.has(i.position)||tr(s)&&!l&&zf(t,s))?r=r.filter(d=>d!==s):i=a,s=St(s)}return e.set(t,r),r}function gx(t){let{element:e,boundary:n,rootBoundary:r,strategy:i}=t,s=[...n==="clippingAncestors"?Qr(e)?[]:mx(e,this._c):[].concat(n),r],a=s[0],l=s.reduce((c,d)=>{let u=Df(e,d,i);return c.top=ue(u.top,c.top),c.right=_e(u.right,c.right),c.bottom=_e(u.bottom,c.bottom),c.left=ue(u.left,c.left),c},Df(e,a,i));return{width:l.right-l.left,height:l.bottom-l.top,x:l.left,y:l.top}}function yx(t){let{width:e,height:n}=Ff(t);return{width:e,height:n}}function bx(t,e,n){let r=lt(e),i=at(e),o=n==="fixed",s=pn(t,!0,o,e),a={scrollLeft:0,scrollTop:0},l=st(0);function c(){l.x=ko(i)}if(r||!r&&!o)if((un(e)!=="body"||tr(i))&&(a=Zr(e)),r){let p=pn(e,!0,o,e);l.x=p.x+e.clientLeft,l.y=p.y+e.clientTop}else i&&c();o&&!r&&i&&c();let d=i&&!r&&!o?Bf(i,a):st(0),u=s.left+a.scrollLeft-l.x-d.x,f=s.top+a.scrollTop-l.y-d.y;return{x:u,y:f,width:s.width,height:s.height}}function Ua(t){return Ge(t).position==="static"}function Hf(t,e){if(!lt(t)||Ge(t).position==="fixed")return null;if(e)return e(t);let n=t.offsetParent;return at(t)===n&&(n=n.ownerDocument.body),n}function Vf(t,e){let n=Ie(t);if(Qr(t))return n;if(!lt(t)){let i=St(t);for(;i&&!fn(i);){if(Je(i)&&!Ua(i))return i;i=St(i)}return n}let r=Hf(t,e);for(;r&&Lf(r)&&Ua(r);)r=Hf(r,e);return r&&fn(r)&&Ua(r)&&!vo(r)?n:r||Nf(t)||n}function xx(t){return Ge(t).direction==="rtl"}function $f(t,e){return t.x===e.x&&t.y===e.y&&t.width===e.width&&t.height===e.height}function Cx(t,e){let n=null,r,i=at(t);function o(){var a;clearTimeout(r),(a=n)==null||a.disconnect(),n=null}function s(a,l){a===void 0&&(a=!1),l===void 0&&(l=1),o();let c=t.getBoundingClientRect(),{left:d,top:u,width:f,height:p}=c;if(a||e(),!f||!p)return;let h=Xr(u),m=Xr(i.clientWidth-(d+f)),g=Xr(i.clientHeight-(u+p)),y=Xr(d),x={rootMargin:-h+"px "+-m+"px "+-g+"px "+-y+"px",threshold:ue(0,_e(1,l))||1},k=!0;function S(R){let M=R[0].intersectionRatio;if(M!==l){if(!k)return s();M?s(!1,M):r=setTimeout(()=>{s(!1,1e-7)},1e3)}M===1&&!$f(c,t.getBoundingClientRect())&&s(),k=!1}try{n=new IntersectionObserver(S,{...x,root:i.ownerDocument})}catch{n=new IntersectionObserver(S,x)}n.observe(t)}return s(!0),o}function vx(t,e,n,r){r===void 0&&(r={});let{ancestorScroll:i=!0,ancestorResize:o=!0,elementResize:s=typeof ResizeObserver=="function",layoutShift:a=typeof IntersectionObserver=="function",animationFrame:l=!1}=r,c=$a(t),d=i||o?[...c?Ut(c):[],...Ut(e)]:[];d.forEach(y=>{i&&y.addEventListener("scroll",n,{passive:!0}),o&&y.addEventListener("resize",n)});let u=c&&a?Cx(c,n):null,f=-1,p=null;s&&(p=new ResizeObserver(y=>{let[E]=y;E&&E.target===c&&p&&(p.unobserve(e),cancelAnimationFrame(f),f=requestAnimationFrame(()=>{var x;(x=p)==null||x.observe(e)})),n()}),c&&!l&&p.observe(c),p.observe(e));let h,m=l?pn(t):null;l&&g();function g(){let y=pn(t);m&&!$f(m,y)&&n(),m=y,h=requestAnimationFrame(g)}return n(),()=>{var y;d.forEach(E=>{i&&E.removeEventListener("scroll",n),o&&E.removeEventListener("resize",n)}),u==null||u(),(y=p)==null||y.disconnect(),p=null,l&&cancelAnimationFrame(h)}}var ax,Pf,px,Ex,Uf,Tx,Sx,kx,Mx,wx,Ax,Rx,Ox,Lx,Nx,Ix,Wf=zi(()=>{Af();xo();Va();Va();ax=st(0);Pf=25;px=new Set(["absolute","fixed"]);Ex=async function(t){let e=this.getOffsetParent||Vf,n=this.getDimensions,r=await n(t.floating);return{reference:bx(t.reference,await e(t.floating),t.strategy),floating:{x:0,y:0,width:r.width,height:r.height}}};Uf={convertOffsetParentRelativeRectToViewportRelativeRect:cx,getDocumentElement:at,getClippingRect:gx,getOffsetParent:Vf,getElementRects:Ex,getClientRects:dx,getDimensions:yx,getScale:nr,isElement:Je,isRTL:xx};Tx=Vt,Sx=Sf,kx=bf,Mx=kf,wx=Ef,Ax=wf,Rx=xf,Ox=yf,Lx=vf,Nx=Mf,Ix=(t,e,n)=>{let r=new Map,i={platform:Uf,...n},o={...i.platform,_c:r};return gf(t,e,{...i,platform:o})}});var nM={};Vi(nM,{useRelationshipOptions:()=>tM});var Ui="http://www.w3.org/2000/svg",sa="http://www.w3.org/1999/xhtml",Qd="http://www.w3.org/1998/Math/MathML";var jn={},$i=[],Zd=/acit|ex(?:s|g|n|p|$)|rph|grid|ows|mnc|ntw|ine[ch]|zoo|^ord|itera/i;var Wn=Array.isArray;function $e(t,e){for(let n in e)t[n]=e[n];return t}function ji(t){t&&t.parentNode&&t.parentNode.removeChild(t)}var Nr=$i.slice;function eu(t,e,n,r){let i,o,s;for(;e=e._parent;)if((i=e._component)&&!i._processingException)try{if(o=i.constructor,o&&o.getDerivedStateFromError!=null&&(i.setState(o.getDerivedStateFromError(t)),s=i._dirty),i.componentDidCatch!=null&&(i.componentDidCatch(t,r||{}),s=i._dirty),s)return i._pendingError=i}catch(a){t=a}throw t}var Jb={_catchError:eu},J=Jb;var Gb=0;function Ir(t,e,n,r,i){let o={type:t,props:e,key:n,ref:r,_children:null,_parent:null,_depth:0,_dom:null,_component:null,constructor:void 0,_original:i==null?++Gb:i,_index:-1,_flags:0};return i==null&&J.vnode!=null&&J.vnode(o),o}function Ft(t){return t.children}function Kn(t,e){this.props=t,this.context=e}Kn.prototype.setState=function(t,e){let n;this._nextState!=null&&this._nextState!=this.state?n=this._nextState:n=this._nextState=$e({},this.state),typeof t=="function"&&(t=t($e({},n),this.props)),t&&$e(n,t),t!=null&&this._vnode&&(e&&this._stateCallbacks.push(e),la(this))};Kn.prototype.forceUpdate=function(t){this._vnode&&(this._force=!0,t&&this._renderCallbacks.push(t),la(this))};Kn.prototype.render=Ft;function _t(t,e){if(e==null)return t._parent?_t(t._parent,t._index+1):null;let n;for(;e<t._children.length;e++)if(n=t._children[e],n!=null&&n._dom!=null)return n._dom;return typeof t.type=="function"?_t(t):null}function Yb(t){let e=t._vnode,n=e._dom,r=[],i=[];if(t._parentDom){let o=$e({},e);o._original=e._original+1,J.vnode&&J.vnode(o),Dr(t._parentDom,o,e,t._globalContext,t._parentDom.namespaceURI,e._flags&32?[n]:null,r,n==null?_t(e):n,!!(e._flags&32),i),o._original=e._original,o._parent._children[o._index]=o,ca(r,o,i),e._dom=e._parent=null,o._dom!=n&&tu(o)}}function tu(t){if((t=t._parent)!=null&&t._component!=null){t._dom=t._component.base=null;for(let e=0;e<t._children.length;e++){let n=t._children[e];if(n!=null&&n._dom!=null){t._dom=t._component.base=n._dom;break}}return tu(t)}}var qn=[],aa,Xb=typeof Promise=="function"?Promise.prototype.then.bind(Promise.resolve()):setTimeout;function la(t){(!t._dirty&&(t._dirty=!0)&&qn.push(t)&&!Wi._rerenderCount++||aa!=J.debounceRendering)&&(aa=J.debounceRendering,(aa||Xb)(Wi))}var Qb=(t,e)=>t._vnode._depth-e._vnode._depth;function Wi(){let t,e=1;for(;qn.length;)qn.length>e&&qn.sort(Qb),t=qn.shift(),e=qn.length,t._dirty&&Yb(t);Wi._rerenderCount=0}Wi._rerenderCount=0;function da(t,e,n,r,i,o,s,a,l,c,d){let u,f,p,h,m,g=r&&r._children||$i,y=e.length;for(l=Zb(n,e,g,l,y),u=0;u<y;u++){if(p=n._children[u],p==null)continue;p._index==-1?f=jn:f=g[p._index]||jn,p._index=u;let E=Dr(t,p,f,i,o,s,a,l,c,d);h=p._dom,p.ref&&f.ref!=p.ref&&(f.ref&&Ki(f.ref,null,p),d.push(p.ref,p._component||h,p)),m==null&&h!=null&&(m=h);let x=!!(p._flags&4);x||f._children===p._children?l=nu(p,l,t,x):typeof p.type=="function"&&E!==void 0?l=E:h&&(l=h.nextSibling),p._flags&=-7}return n._dom=m,l}function Zb(t,e,n,r,i){let o,s,a,l=n.length,c=l,d=0;for(t._children=new Array(i),o=0;o<i;o++){if(s=e[o],s==null||typeof s=="boolean"||typeof s=="function"){t._children[o]=null;continue}else typeof s=="string"||typeof s=="number"||typeof s=="bigint"||s.constructor==String?s=t._children[o]=Ir(null,s,null,null,null):Wn(s)?s=t._children[o]=Ir(Ft,{children:s},null,null,null):s.constructor==void 0&&s._depth>0?s=t._children[o]=Ir(s.type,s.props,s.key,s.ref?s.ref:null,s._original):s=t._children[o]=s;let u=o+d;s._parent=t,s._depth=t._depth+1;let f=s._index=eE(s,n,u,c);a=null,f!=-1&&(a=n[f],c--,a&&(a._flags|=2)),a==null||a._original==null?(f==-1&&(i>l?d--:i<l&&d++),typeof s.type!="function"&&(s._flags|=4)):f!=u&&(f==u-1?d--:f==u+1?d++:(f>u?d--:d++,s._flags|=4))}if(c)for(o=0;o<l;o++)a=n[o],a!=null&&!(a._flags&2)&&(a._dom==r&&(r=_t(a)),ua(a,a));return r}function nu(t,e,n,r){if(typeof t.type=="function"){let i=t._children;for(let o=0;i&&o<i.length;o++)i[o]&&(i[o]._parent=t,e=nu(i[o],e,n,r));return e}else t._dom!=e&&(r&&(e&&t.type&&!e.parentNode&&(e=_t(t)),n.insertBefore(t._dom,e||null)),e=t._dom);do e=e&&e.nextSibling;while(e!=null&&e.nodeType==8);return e}function eE(t,e,n,r){let i=t.key,o=t.type,s=e[n],a=s!=null&&(s._flags&2)==0,l=r>(a?1:0);if(s===null&&t.key==null||a&&i==s.key&&o==s.type)return n;if(l){let c=n-1,d=n+1;for(;c>=0||d<e.length;){let u=c>=0?c--:d++;if(s=e[u],s!=null&&!(s._flags&2)&&i==s.key&&o==s.type)return u}}return-1}function ru(t,e,n){e[0]=="-"?t.setProperty(e,n==null?"":n):n==null?t[e]="":typeof n!="number"||Zd.test(e)?t[e]=n:t[e]=n+"px"}var tE=/(PointerCapture)$|Capture$/i,su=0;function Fr(t,e,n,r,i){let o;e:if(e=="style")if(typeof n=="string")t.style.cssText=n;else{if(typeof r=="string"&&(t.style.cssText=r=""),r)for(e in r)n&&e in n||ru(t.style,e,"");if(n)for(e in n)(!r||n[e]!=r[e])&&ru(t.style,e,n[e])}else if(e[0]=="o"&&e[1]=="n"){o=e!=(e=e.replace(tE,"$1"));let s=e.toLowerCase();s in t||e=="onFocusOut"||e=="onFocusIn"?e=s.slice(2):e=e.slice(2),t._listeners||(t._listeners={}),t._listeners[e+o]=n,n?r?n._attached=r._attached:(n._attached=su,t.addEventListener(e,o?ou:iu,o)):t.removeEventListener(e,o?ou:iu,o)}else{if(i==Ui)e=e.replace(/xlink(H|:h)/,"h").replace(/sName$/,"s");else if(e!="width"&&e!="height"&&e!="href"&&e!="list"&&e!="form"&&e!="tabIndex"&&e!="download"&&e!="rowSpan"&&e!="colSpan"&&e!="role"&&e!="popover"&&e in t)try{t[e]=n==null?"":n;break e}catch{}typeof n=="function"||(n!=null&&(n!==!1||e[4]=="-")?t.setAttribute(e,e=="popover"&&n==!0?"":n):t.removeAttribute(e))}}function au(t){return function(e){if(this._listeners){let n=this._listeners[e.type+t];if(e._dispatched==null)e._dispatched=su++;else if(e._dispatched<n._attached)return;return n(J.event?J.event(e):e)}}}var iu=au(!1),ou=au(!0);function Dr(t,e,n,r,i,o,s,a,l,c){let d,u=e.type;if(e.constructor!=void 0)return null;n._flags&128&&(l=!!(n._flags&32),a=e._dom=n._dom,o=[a]),(d=J._diff)&&d(e);e:if(typeof u=="function")try{let f,p,h,m,g,y,E=e.props,x="prototype"in u&&u.prototype.render;d=u.contextType;let k=d&&r[d._id],S=d?k?k.props.value:d._defaultValue:r;if(n._component?(f=e._component=n._component,y=f._processingException=f._pendingError):(x?e._component=f=new u(E,S):(e._component=f=new Kn(E,S),f.constructor=u,f.render=iE),k&&k.sub(f),f.props=E,f.state||(f.state={}),f.context=S,f._globalContext=r,p=f._dirty=!0,f._renderCallbacks=[],f._stateCallbacks=[]),x&&f._nextState==null&&(f._nextState=f.state),x&&u.getDerivedStateFromProps!=null&&(f._nextState==f.state&&(f._nextState=$e({},f._nextState)),$e(f._nextState,u.getDerivedStateFromProps(E,f._nextState))),h=f.props,m=f.state,f._vnode=e,p)x&&u.getDerivedStateFromProps==null&&f.componentWillMount!=null&&f.componentWillMount(),x&&f.componentDidMount!=null&&f._renderCallbacks.push(f.componentDidMount);else{if(x&&u.getDerivedStateFromProps==null&&E!==h&&f.componentWillReceiveProps!=null&&f.componentWillReceiveProps(E,S),!f._force&&f.shouldComponentUpdate!=null&&f.shouldComponentUpdate(E,f._nextState,S)===!1||e._original==n._original){e._original!=n._original&&(f.props=E,f.state=f._nextState,f._dirty=!1),e._dom=n._dom,e._children=n._children,e._children.some(b=>{b&&(b._parent=e)});for(let b=0;b<f._stateCallbacks.length;b++)f._renderCallbacks.push(f._stateCallbacks[b]);f._stateCallbacks=[],f._renderCallbacks.length&&s.push(f);break e}f.componentWillUpdate!=null&&f.componentWillUpdate(E,f._nextState,S),x&&f.componentDidUpdate!=null&&f._renderCallbacks.push(()=>{f.componentDidUpdate(h,m,g)})}f.context=S,f.props=E,f._parentDom=t,f._force=!1;let R=J._render,M=0;if(x){f.state=f._nextState,f._dirty=!1,R&&R(e),d=f.render(f.props,f.state,f.context);for(let b=0;b<f._stateCallbacks.length;b++)f._renderCallbacks.push(f._stateCallbacks[b]);f._stateCallbacks=[]}else do f._dirty=!1,R&&R(e),d=f.render(f.props,f.state,f.context),f.state=f._nextState;while(f._dirty&&++M<25);f.state=f._nextState,f.getChildContext!=null&&(r=$e($e({},r),f.getChildContext())),x&&!p&&f.getSnapshotBeforeUpdate!=null&&(g=f.getSnapshotBeforeUpdate(h,m));let I=d!=null&&d.type===Ft&&d.key==null,H=d;I&&(H=lu(d.props.children)),a=da(t,Wn(H)?H:[H],e,n,r,i,o,s,a,l,c),f.base=e._dom,e._flags&=-161,f._renderCallbacks.length&&s.push(f),y&&(f._pendingError=f._processingException=null)}catch(f){if(e._original=null,l||o!=null)if(f.then){for(e._flags|=l?160:128;a&&a.nodeType==8&&a.nextSibling;)a=a.nextSibling;o[o.indexOf(a)]=null,e._dom=a}else{for(let p=o.length;p--;)ji(o[p]);fa(e)}else e._dom=n._dom,e._children=n._children,f.then||fa(e);J._catchError(f,e,n)}else o==null&&e._original==n._original?(e._children=n._children,e._dom=n._dom):a=e._dom=rE(n._dom,e,n,r,i,o,s,l,c);return(d=J.diffed)&&d(e),e._flags&128?void 0:a}function fa(t){t&&t._component&&(t._component._force=!0),t&&t._children&&t._children.forEach(fa)}function ca(t,e,n){for(let r=0;r<n.length;r++)Ki(n[r],n[++r],n[++r]);J._commit&&J._commit(e,t),t.some(r=>{try{t=r._renderCallbacks,r._renderCallbacks=[],t.some(i=>{i.call(r)})}catch(i){J._catchError(i,r._vnode)}})}function lu(t){return typeof t!="object"||t==null||t._depth&&t._depth>0?t:Wn(t)?t.map(lu):$e({},t)}function rE(t,e,n,r,i,o,s,a,l){let c=n.props,d=e.props,u=e.type,f,p,h,m,g,y,E;if(u=="svg"?i=Ui:u=="math"?i=Qd:i||(i=sa),o!=null){for(f=0;f<o.length;f++)if(g=o[f],g&&"setAttribute"in g==!!u&&(u?g.localName==u:g.nodeType==3)){t=g,o[f]=null;break}}if(t==null){if(u==null)return document.createTextNode(d);t=document.createElementNS(i,u,d.is&&d),a&&(J._hydrationMismatch&&J._hydrationMismatch(e,o),a=!1),o=null}if(u==null)c!==d&&(!a||t.data!=d)&&(t.data=d);else{if(o=o&&Nr.call(t.childNodes),c=n.props||jn,!a&&o!=null)for(c={},f=0;f<t.attributes.length;f++)g=t.attributes[f],c[g.name]=g.value;for(f in c)if(g=c[f],f!="children"){if(f=="dangerouslySetInnerHTML")h=g;else if(!(f in d)){if(f=="value"&&"defaultValue"in d||f=="checked"&&"defaultChecked"in d)continue;Fr(t,f,null,g,i)}}for(f in d)g=d[f],f=="children"?m=g:f=="dangerouslySetInnerHTML"?p=g:f=="value"?y=g:f=="checked"?E=g:(!a||typeof g=="function")&&c[f]!==g&&Fr(t,f,g,c[f],i);if(p)!a&&(!h||p.__html!=h.__html&&p.__html!=t.innerHTML)&&(t.innerHTML=p.__html),e._children=[];else if(h&&(t.innerHTML=""),da(e.type=="template"?t.content:t,Wn(m)?m:[m],e,n,r,u=="foreignObject"?sa:i,o,s,o?o[0]:n._children&&_t(n,0),a,l),o!=null)for(f=o.length;f--;)ji(o[f]);a||(f="value",u=="progress"&&y==null?t.removeAttribute("value"):y!=void 0&&(y!==t[f]||u=="progress"&&!y||u=="option"&&y!=c[f])&&Fr(t,f,y,c[f],i),f="checked",E!=void 0&&E!=t[f]&&Fr(t,f,E,c[f],i))}return t}function Ki(t,e,n){try{if(typeof t=="function"){let r=typeof t._unmount=="function";r&&t._unmount(),(!r||e!=null)&&(t._unmount=t(e))}else t.current=e}catch(r){J._catchError(r,n)}}function ua(t,e,n){let r;if(J.unmount&&J.unmount(t),(r=t.ref)&&(!r.current||r.current==t._dom)&&Ki(r,null,e),(r=t._component)!=null){if(r.componentWillUnmount)try{r.componentWillUnmount()}catch(i){J._catchError(i,e)}r.base=r._parentDom=null}if(r=t._children)for(let i=0;i<r.length;i++)r[i]&&ua(r[i],e,n||typeof t.type!="function");n||ji(t._dom),t._component=t._parent=t._dom=void 0}function iE(t,e,n){return this.constructor(t,n)}var Gi,oe,ha,ma=0,yu=[],ae=J,du=ae._diff,uu=ae._render,fu=ae.diffed,pu=ae._commit,hu=ae.unmount,mu=ae._root,oE=35,pa;ae._diff=t=>{oe=null,du&&du(t)};ae._root=(t,e)=>{t&&e._children&&e._children._mask&&(t._mask=e._children._mask),mu&&mu(t,e)};ae._render=t=>{uu&&uu(t),oe=t._component,Gi=0;let e=oe.__hooks;e&&(ha===oe?(e._pendingEffects=[],oe._renderCallbacks=[],e._list.forEach(n=>{n._nextValue&&(n._value=n._nextValue),n._pendingArgs=n._nextValue=void 0})):(e._pendingEffects.forEach(Yi),e._pendingEffects.forEach(ba),e._pendingEffects=[],Gi=0)),ha=oe};ae.diffed=t=>{fu&&fu(t);let e=t._component;e&&e.__hooks&&(e.__hooks._pendingEffects.length&&cE(yu.push(e)),e.__hooks._list.forEach(n=>{n._pendingArgs&&(n._args=n._pendingArgs),n._pendingArgs=void 0})),ha=oe=null};ae._commit=(t,e)=>{e.some(n=>{try{n._renderCallbacks.forEach(Yi),n._renderCallbacks=n._renderCallbacks.filter(r=>r._value?ba(r):!0)}catch(r){e.some(i=>{i._renderCallbacks&&(i._renderCallbacks=[])}),e=[],ae._catchError(r,n._vnode)}}),pu&&pu(t,e)};ae.unmount=t=>{hu&&hu(t);let e=t._component;if(e&&e.__hooks){let n;e.__hooks._list.forEach(r=>{try{Yi(r)}catch(i){n=i}}),e.__hooks=void 0,n&&ae._catchError(n,e._vnode)}};function bu(t,e){ae._hook&&ae._hook(oe,t,ma||e),ma=0;let n=oe.__hooks||(oe.__hooks={_list:[],_pendingEffects:[]});return t>=n._list.length&&n._list.push({}),n._list[t]}function ga(t){return ma=1,sE(Eu,t)}function sE(t,e,n){let r=bu(Gi++,2);if(r._reducer=t,!r._component&&(r._value=[n?n(e):Eu(void 0,e),i=>{let o=r._nextValue?r._nextValue[0]:r._value[0],s=r._reducer(o,i);o!==s&&(r._nextValue=[s,r._value[1]],r._component.setState({}))}],r._component=oe,!oe._hasScuFromHooks)){let s=function(a,l,c){if(!r._component.__hooks)return!0;let d=h=>!!h._component,u=r._component.__hooks._list.filter(d);if(u.every(h=>!h._nextValue))return i?i.call(this,a,l,c):!0;let p=r._component.props!==a;return u.forEach(h=>{if(h._nextValue){let m=h._value[0];h._value=h._nextValue,h._nextValue=void 0,m!==h._value[0]&&(p=!0)}}),i&&i.call(this,a,l,c)||p};oe._hasScuFromHooks=!0;let i=oe.shouldComponentUpdate,o=oe.componentWillUpdate;oe.componentWillUpdate=function(a,l,c){if(this._force){let d=i;i=void 0,s(a,l,c),i=d}o&&o.call(this,a,l,c)},oe.shouldComponentUpdate=s}return r._nextValue||r._value}function ya(t,e){let n=bu(Gi++,3);!ae._skipEffects&&dE(n._args,e)&&(n._value=t,n._pendingArgs=e,oe.__hooks._pendingEffects.push(n))}function aE(){let t;for(;t=yu.shift();)if(!(!t._parentDom||!t.__hooks))try{t.__hooks._pendingEffects.forEach(Yi),t.__hooks._pendingEffects.forEach(ba),t.__hooks._pendingEffects=[]}catch(e){t.__hooks._pendingEffects=[],ae._catchError(e,t._vnode)}}var gu=typeof requestAnimationFrame=="function";function lE(t){let e=()=>{clearTimeout(n),gu&&cancelAnimationFrame(r),setTimeout(t)},n=setTimeout(e,oE),r;gu&&(r=requestAnimationFrame(e))}function cE(t){(t===1||pa!==ae.requestAnimationFrame)&&(pa=ae.requestAnimationFrame,(pa||lE)(aE))}function Yi(t){let e=oe,n=t._cleanup;typeof n=="function"&&(t._cleanup=void 0,n()),oe=e}function ba(t){let e=oe;t._cleanup=t._value(),oe=e}function dE(t,e){return!t||t.length!==e.length||e.some((n,r)=>n!==t[r])}function Eu(t,e){return typeof e=="function"?e(t):e}var Cu="0.0.19",xu="__formgenRuntimeVersionAnnounced__";function uE(){if(typeof globalThis=="undefined")return;let t=globalThis;if(t[xu]||(t[xu]=!0,typeof console=="undefined"))return;let e=typeof console.info=="function"?console.info:console.log;typeof e=="function"&&e(`[formgen-relationships] runtime version ${Cu}`)}uE();var Ea={strategy:"memory",ttlMs:3e5},Jn={baseUrl:"",cache:Ea,logger:void 0,searchThrottleMs:250,searchDebounceMs:250,retryAttempts:1,retryDelayMs:300},xa=Jn;function Ca(t={}){var n;let e={...Jn,...t,cache:{...Ea,...(n=t.cache)!=null?n:{}}};return e.cache.strategy||(e.cache.strategy="memory"),(typeof e.cache.ttlMs!="number"||Number.isNaN(e.cache.ttlMs))&&(e.cache.ttlMs=Ea.ttlMs),(typeof e.searchThrottleMs!="number"||e.searchThrottleMs<0)&&(e.searchThrottleMs=Jn.searchThrottleMs),(typeof e.searchDebounceMs!="number"||e.searchDebounceMs<0)&&(e.searchDebounceMs=Jn.searchDebounceMs),(typeof e.retryAttempts!="number"||e.retryAttempts<0)&&(e.retryAttempts=Jn.retryAttempts),(typeof e.retryDelayMs!="number"||e.retryDelayMs<0)&&(e.retryDelayMs=Jn.retryDelayMs),e}function vu(t={}){return xa=Ca(t),xa}function Tu(){return xa}var Xi=class{constructor(){this.store=new Map}get(e){let n=this.store.get(e);if(n){if(n.expiresAt&&n.expiresAt<=Date.now()){this.store.delete(e);return}return n.value}}set(e,n,r){let i=typeof r=="number"&&r>0?Date.now()+r:void 0;this.store.set(e,{value:n,expiresAt:i})}delete(e){this.store.delete(e)}clear(){this.store.clear()}};var de=class extends Error{constructor(e,n,r){super(e),this.name="ResolverError",this.status=n,this.detail=r}},ln=class extends Error{constructor(){super("Resolver request aborted"),this.name="ResolverAbortError"}},fE="[data-relationship-type]",Su="data-relationship-error",va="inline",Ta=new Map;Ta.set(va,ku);function ht(t,e,n){var o,s;let r=t.dataset.validationRenderer||va;((s=(o=Ta.get(r))!=null?o:Ta.get(va))!=null?s:ku)({element:t,message:e,code:n})}function je(t){ht(t,null)}function ku(t){var i,o;let e=(o=(i=t.element.closest(fE))!=null?i:t.element.parentElement)!=null?o:t.element;if(!e)return;let n=e;e.classList.contains("relative")&&e.parentElement&&(n=e.parentElement);let r=n.querySelector(`[${Su}]`);r||(r=document.createElement("p"),r.setAttribute(Su,"true"),r.className="formgen-error text-xs text-red-600 mt-2 dark:text-red-400",r.setAttribute("role","status"),r.setAttribute("aria-live","polite"),r.setAttribute("aria-atomic","true"),e.classList.contains("relative")&&e.parentElement?e.parentElement.insertBefore(r,e.nextSibling):n.appendChild(r)),t.message&&t.message.trim()!==""?(r.textContent=t.message,r.removeAttribute("aria-hidden"),pE(t.element,t.message)):(r.textContent="",r.setAttribute("aria-hidden","true"),hE(t.element))}function pE(t,e){t.setAttribute("aria-invalid","true"),t.setAttribute("data-validation-state","invalid"),t.setAttribute("data-validation-message",e),Mu(t,!0)}function hE(t){t.removeAttribute("aria-invalid"),t.removeAttribute("data-validation-state"),t.removeAttribute("data-validation-message"),Mu(t,!1)}function Mu(t,e){let n=t;if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement||t instanceof HTMLSelectElement||(n=t.querySelector("input, textarea, select")),!n)return;let r=["border-red-500","focus:border-red-500","focus:ring-red-500","dark:border-red-500"],i=["border-gray-200","focus:border-blue-500","focus:ring-blue-500","dark:border-gray-700","dark:focus:ring-gray-600"];e?(i.forEach(o=>n.classList.remove(o)),r.forEach(o=>n.classList.add(o))):(r.forEach(o=>n.classList.remove(o)),i.forEach(o=>n.classList.add(o)))}function mE(t){return t.replace(/^data-/,"").split(/[-_:]/).filter(Boolean).map((e,n)=>n===0?e.toLowerCase():e.charAt(0).toUpperCase()+e.slice(1).toLowerCase()).join("")}function wu(t){var n;if(typeof document=="undefined")return;let e=document.querySelector(`meta[name="${t}"]`);return(n=e==null?void 0:e.getAttribute("content"))!=null?n:void 0}function Sa(t,e){var i;if(!t)return;if(t.hasAttribute(e))return(i=t.getAttribute(e))!=null?i:void 0;let n=mE(e),r=t.dataset[n];return r!=null?r:void 0}function gE(t,e){var n;if(t){if(t.startsWith("meta:"))return wu(t.slice(5));if(t.startsWith("data:"))return Sa(e,t.slice(5));if(t.startsWith("element:"))return Sa(e,t.slice(8));if(t.includes("=")){let[,r]=t.split("=");return r}return(n=Sa(e,t))!=null?n:wu(t)}}function ka(t,e){var o,s;if(!t||t.strategy!=="header")return{};let n=(o=t.header)!=null?o:"Authorization",r=gE((s=t.source)!=null?s:"data-auth-token",e);if(!r)return{};let i=t.prefix?`${t.prefix.trim()} ${r}`.trim():r;return{[n]:i}}var ot="formgen:relationship:update",Au="formgen:relationship:create-action",Ru="formgen:relationship:edit-action";function ye(t,e){let n=e.kind==="selection"?Lu(e.selectedValues):null;try{t.dispatchEvent(new CustomEvent(ot,{bubbles:!0,detail:e})),n&&Ou.set(t,n)}catch{}}function _r(t,e){try{t.dispatchEvent(new CustomEvent(Au,{bubbles:!0,detail:e}))}catch{}}function Ma(t,e){try{t.dispatchEvent(new CustomEvent(Ru,{bubbles:!0,detail:e}))}catch{}}function Br(t){if(!(t instanceof HTMLSelectElement))return;let e=t;e.dataset.formgenRelationshipSelectionBridge!=="true"&&(e.dataset.formgenRelationshipSelectionBridge="true",e.addEventListener("change",n=>{let r=yE(e),i=Lu(r);Ou.get(e)!==i&&ye(e,{kind:"selection",origin:n.isTrusted?"ui":"program",selectedValues:r})}))}var Ou=new WeakMap;function yE(t){return Array.from(t.selectedOptions).map(e=>e.value).filter(e=>e!=="")}function Lu(t){return t.slice().sort((e,n)=>e.localeCompare(n)).join("\0")}var Nu='[data-endpoint-url], [data-endpoint-renderer="chips"]',Iu="data-relationship-hidden",Pu="data-relationship-hidden-initialised",Du="data-relationship-json-initialised",Hu="data-relationship-json",Qi="data-relationship-submit-mode",zr="data-relationship-original-name";function wa(t=document){let e=(t instanceof Document,t),n=Array.from(e.querySelectorAll(Nu));return t instanceof HTMLElement&&t.matches(Nu)&&n.unshift(t),Array.from(new Set(n))}function Gn(t){let e={};for(let[n,r]of Object.entries(t.dataset))typeof r=="string"&&(e[n]=r);return e}function Aa(t){return t instanceof HTMLSelectElement&&t.multiple}function Fu(t){t.getAttribute(Qi)!=="json"&&(t.hasAttribute(Pu)||(t.setAttribute(Pu,"true"),t.setAttribute(Qi,"hidden-array"),Br(t),cn(t),t.addEventListener(ot,e=>{e.detail.kind==="selection"&&cn(t)})))}function cn(t){if(t.getAttribute(Qi)==="json"){Bt(t);return}let e=_u(t);for(;e.firstChild;)e.removeChild(e.firstChild);let n=t.name||t.id;if(!n)return;let r=n.endsWith("[]")?n:`${n}[]`;Array.from(t.selectedOptions).forEach(i=>{let o=document.createElement("input");o.type="hidden",o.name=r,o.value=i.value,e.appendChild(o)})}function _u(t){var r,i,o;let e=(r=t.parentElement)==null?void 0:r.querySelector(`[${Iu}]`);if(e)return e;let n=document.createElement("div");return n.setAttribute(Iu,"true"),n.style.display="none",t.parentElement?t.parentElement.appendChild(n):t.nextSibling?(i=t.parentNode)==null||i.insertBefore(n,t.nextSibling):(o=t.parentNode)==null||o.appendChild(n),n}function Bu(t){if(t.hasAttribute(Du))return;t.setAttribute(Du,"true"),t.setAttribute(Qi,"json"),Br(t);let e=t.getAttribute("name");e&&(t.setAttribute(zr,e),t.removeAttribute("name")),Bt(t),t.addEventListener(ot,n=>{n.detail.kind==="selection"&&Bt(t)}),t.addEventListener("blur",()=>Bt(t))}function Bt(t){var o,s;let e=_u(t),n=e.querySelector(`[${Hu}]`);n||(n=document.createElement("input"),n.type="hidden",n.setAttribute(Hu,"true"),e.appendChild(n));let r=(o=t.getAttribute(zr))!=null?o:t.getAttribute("name");if(r){let a=r.endsWith("[]")?r.slice(0,r.length-2):r;n.name=a}let i=Array.from(t.selectedOptions).map(a=>a.value);if(t.multiple)n.value=JSON.stringify(i);else{let a=(s=i[0])!=null?s:"";n.value=a?JSON.stringify(a):"null"}}function Zi(t){if(!t)return null;if(t instanceof HTMLInputElement)return t.type==="checkbox"||t.type==="radio"?t.checked?t.value:null:t.value;if(t instanceof HTMLSelectElement){if(t.multiple)return Array.from(t.selectedOptions).map(n=>n.value);let e=t.selectedOptions[0];return e?e.value:null}return t instanceof HTMLTextAreaElement?t.value:t.textContent}function Uu(t,e){var a;let n=[],r=EE(t),i={field:t,value:e};if(xE(t)&&Wu(e)){let l=bE(i,r);return l?(n.push(l),eo(n)):(n.push({code:"required",message:`${r} is required.`,value:e}),eo(n))}let o=qu(e);t.cardinality==="one"&&o.length>1&&n.push({code:"cardinality",message:`Select only one ${r.toLowerCase()}.`,value:e});let s=(a=t.validations)!=null?a:[];for(let l of s){let c=ju(l,i,r);c&&n.push(c)}return eo(n)}function bE(t,e){var n;for(let r of(n=t.field.validations)!=null?n:[]){if(r.kind!=="minItems")continue;let i=ju(r,t,e);if(i)return i}return null}function $u(...t){var n;let e=[];for(let r of t)!r||r.valid||e.push(...(n=r.errors)!=null?n:[]);return eo(e)}function ju(t,e,n){var i,o,s,a,l,c,d,u,f;let r=e.value;if(Wu(r)&&t.kind!=="minItems")return null;switch(t.kind){case"min":{let p=Yn((i=t.params)==null?void 0:i.value),h=zu(r);if(p==null||h==null)return null;let m=((o=t.params)==null?void 0:o.exclusive)==="true";if(m?h<=p:h<p)return{code:"min",message:`${n} must be ${m?"greater than":"at least"} ${p}.`,rule:t,value:r};break}case"max":{let p=Yn((s=t.params)==null?void 0:s.value),h=zu(r);if(p==null||h==null)return null;let m=((a=t.params)==null?void 0:a.exclusive)==="true";if(m?h>=p:h>p)return{code:"max",message:`${n} must be ${m?"less than":"no more than"} ${p}.`,rule:t,value:r};break}case"minLength":{let p=Yn((l=t.params)==null?void 0:l.value),h=to(r);if(p==null||h==null)return null;if(h.length<p)return{code:"minLength",message:`${n} must be at least ${p} characters.`,rule:t,value:r};break}case"maxLength":{let p=Yn((c=t.params)==null?void 0:c.value),h=to(r);if(p==null||h==null)return null;if(h.length>p)return{code:"maxLength",message:`${n} must be at most ${p} characters.`,rule:t,value:r};break}case"minItems":{let p=Yn((d=t.params)==null?void 0:d.value),h=Vu(r);if(p==null||h==null)return null;if(h<p)return{code:"minItems",message:`${n} must contain at least ${p} items.`,rule:t,value:r};break}case"maxItems":{let p=Yn((u=t.params)==null?void 0:u.value),h=Vu(r);if(p==null||h==null)return null;if(h>p)return{code:"maxItems",message:`${n} must contain at most ${p} items.`,rule:t,value:r};break}case"pattern":{let p=(f=t.params)==null?void 0:f.pattern,h=to(r);if(!p||h==null)return null;try{if(!new RegExp(p).test(h))return{code:"pattern",message:`Enter a valid ${n.toLowerCase()}.`,rule:t,value:r}}catch{return null}break}default:return null}return null}function eo(t){return t.length===0?{valid:!0,messages:[],errors:[]}:{valid:!1,errors:t,messages:t.map(e=>e.message)}}function EE(t){return t.label&&t.label.trim()!==""?t.label.trim():t.name&&t.name.trim()!==""?t.name.trim():"This field"}function xE(t){return t.required===!0}function Wu(t){return t==null?!0:Array.isArray(t)?t.length===0||t.every(e=>e==null||e===""):String(t).trim()===""}function qu(t){return t?Array.isArray(t)?t.filter(e=>e!=null&&e!==""):String(t)===""?[]:[String(t)]:[]}function zu(t){let e=to(t);if(e==null||e.trim()==="")return null;let n=Number(e);return Number.isFinite(n)?n:null}function to(t){var e;return t==null?null:Array.isArray(t)?t.length===0?null:(e=t[0])!=null?e:null:String(t)}function Vu(t){return t==null?null:Array.isArray(t)?qu(t).length:String(t).trim()===""?0:1}function Yn(t){if(t==null)return null;let e=Number(t);return Number.isFinite(e)?e:null}function Ra(t){var e;return typeof t=="string"?t.trim():String((e=t.value)!=null?e:"").trim()}function CE(t){var e;return typeof t!="string"&&String((e=t.label)!=null?e:"").trim()!==""}function vE(t){var r;if(typeof t=="string")return t?{value:t,label:t}:null;let e=Ra(t),n=String((r=t.label)!=null?r:e).trim();return e?{value:e,label:n||e}:null}function Oa(t,e){if(t==null)return[];let r=(Array.isArray(t)?t:[t]).map(vE).filter(i=>i!=null);return e?r:r.slice(0,1)}function Ku(t,e=!0){if(t==null)return[];let r=(Array.isArray(t)?t:[t]).map(Ra).filter(i=>i.length>0);return e?r:r.slice(0,1)}function no(t){return t==null?[]:(Array.isArray(t)?t:[t]).filter(n=>!CE(n)).map(Ra).filter(n=>n.length>0)}var TE=/\{\{\s*([^}]+)\s*\}\}/g,Ju="Unable to load options.",La="formgen:resolver-superseded";function ro(){return typeof performance!="undefined"&&typeof performance.now=="function"?performance.now():Date.now()}function Gu(t){return new Promise(e=>{setTimeout(e,t)})}function SE(t,e){if(!t)return!1;if(t===e||t===`${e}[]`||t.endsWith(`[${e}]`)||t.endsWith(`[${e}][]`))return!0;let n=t.replace(/[^a-z0-9]/gi,"").toLowerCase(),r=e.replace(/[^a-z0-9]/gi,"").toLowerCase();return n.endsWith(r)}function Yu(t){if(t!=null)return Array.isArray(t)?t.length===0?void 0:t.join(","):t===""?void 0:t}function Xu(t){return!!t&&typeof t=="object"&&(t.name==="AbortError"||t instanceof ln)}function kE(){if(typeof DOMException=="function")return new DOMException(La,"AbortError");let t=new Error(La);return t.name="AbortError",t}var Vr=class{constructor(e){this.state={};this.abortController=null;this.lastRequest=null;this.lastContext=null;this.preserveServerValidation=!1;this.userInteracted=!1;this.refreshing=!1;this.interactionHandler=()=>this.handleUserInteraction();this.element=e.element,this.field=e.field,this.endpoint=e.endpoint,this.config=e.config,this.cacheAdapter=e.cacheAdapter,this.sessionCacheAdapter=e.sessionCacheAdapter,this.cacheConfig=e.cacheConfig,this.dispatchEvent=e.dispatchEvent,this.renderers=e.renderers,this.defaultRenderer=e.defaultRenderer,this.customResolvers=e.customResolvers,this.element instanceof HTMLSelectElement&&(Br(this.element),this.field.submitAs==="json"?Bu(this.element):Aa(this.element)&&Fu(this.element)),this.captureServerValidationFromDom(),this.bindInteractionHandlers()}async resolve(){var c,d,u,f;this.cancelInFlight();let e=ro(),n=await this.buildRequest(),r=!1,i;this.dispatchEvent(this.element,"loading",{element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:null,fromCache:!1});let o=this.fieldCacheAdapter();if(n.cacheKey&&o&&!this.refreshing){let p=await o.get(n.cacheKey);Array.isArray(p)&&p.length>0&&(i=p,r=!0)}if(!i){let p=this.createContext(n,!1);for(let h of this.customResolvers)try{if(h.matches(p)){let m=await h.resolve(p);if(Array.isArray(m)){i=m;break}}}catch(m){throw this.handleError(m,n,null,e,0),m}}let s=null;try{i||(s=await this.fetchOptions(n,e),i=s.options,r=s.fromCache)}catch(p){return p instanceof ln||Xu(p)?{options:[],fromCache:!1}:{options:[],fromCache:!1}}let a=this.createContext(n,r);this.config.transformOptions&&(i=await this.config.transformOptions(a,i)),this.refreshing&&(i=RE(this.element,i)),await this.renderOptions(i,r),this.field.current!=null&&(this.field.current=null);let l={element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:(c=s==null?void 0:s.response)!=null?c:null,options:i,fromCache:r,durationMs:ro()-e};return this.dispatchEvent(this.element,"success",l),n.cacheKey&&o&&!r&&await o.set(n.cacheKey,i,{ttlMs:(d=this.field.cacheTtlMs)!=null?d:this.cacheConfig.ttlMs,field:this.field,endpoint:this.endpoint}),s&&await((f=(u=this.config).afterFetch)==null?void 0:f.call(u,a,s)),this.element instanceof HTMLSelectElement?this.element.getAttribute("data-relationship-submit-mode")==="json"?Bt(this.element):this.element.multiple&&cn(this.element):Aa(this.element)&&cn(this.element),this.abortController=null,await this.runValidation("auto"),{options:i,fromCache:r}}async refresh(){this.refreshing=!0;try{return await this.resolve()}finally{this.refreshing=!1}}async validate(){return this.runValidation("manual")}async create(e){let n=e.trim();if(!n)throw new de("Create request requires a non-empty query.");if(!this.config.createOption)throw new de("createOption is not configured.");let r=await this.buildCreateRequest(n),i=this.createContext(r,!1),o=await this.config.createOption(i,n);if(!o||typeof o!="object")throw new de("createOption returned an invalid option.");if(typeof o.value!="string"||typeof o.label!="string")throw new de("createOption must return an Option with string value/label.");return o}setCurrentValue(e){this.field.current=e!=null?e:null}setServerValidation(e){if(!e){this.state.validation=void 0,this.preserveServerValidation=!1;return}this.state.validation=e,this.preserveServerValidation=!e.valid,!e.valid&&(this.userInteracted=!1)}captureServerValidationFromDom(){var r,i,o;if(this.element.getAttribute("data-validation-state")!=="invalid")return;let n=((r=this.element.getAttribute("data-validation-message"))!=null?r:"").split(";").map(s=>s.trim()).filter(s=>s.length>0);if(n.length===0){let s=(o=(i=this.field.label)!=null?i:this.field.name)!=null?o:"This field";n.push(`${s} is invalid.`)}this.state.validation=this.buildServerValidationResult(n),this.preserveServerValidation=!0}bindInteractionHandlers(){this.element.addEventListener("input",this.interactionHandler),this.element.addEventListener("change",this.interactionHandler)}destroy(){this.cancelInFlight(),this.element.removeEventListener("input",this.interactionHandler),this.element.removeEventListener("change",this.interactionHandler)}handleUserInteraction(){this.preserveServerValidation&&(this.preserveServerValidation=!1),this.userInteracted=!0}cancelInFlight(){this.abortController&&(this.abortController.abort(kE()),this.abortController=null)}async runValidation(e="manual"){var o,s,a,l,c,d,u,f,p,h,m,g;if(e==="manual")this.preserveServerValidation=!1;else if(e==="auto"&&this.preserveServerValidation)return(a=this.state.validation)!=null?a:this.buildServerValidationResult([(s=(o=this.field.label)!=null?o:this.field.name)!=null?s:"This field is invalid."]);let n=Zi(this.element),r=Uu(this.field,n);if(this.config.validateSelection)try{let y=await this.config.validateSelection(this.getActiveContext(),n);y&&(r=$u(r,y))}catch(y){(c=(l=this.config.logger)==null?void 0:l.warn)==null||c.call(l,"resolver:validation:hook",y)}if(this.state.validation=r,r.valid)je(this.element);else{let y=r.errors[0],E=(u=(d=y==null?void 0:y.message)!=null?d:r.messages[0])!=null?u:Ju;if(ht(this.element,E,y==null?void 0:y.code),r.errors.length>0){let x=this.getActiveContext();for(let k of r.errors)try{(p=(f=this.config).onValidationError)==null||p.call(f,x,k)}catch(S){(m=(h=this.config.logger)==null?void 0:h.warn)==null||m.call(h,"resolver:onValidationError",S)}}}let i={element:this.element,field:this.field,endpoint:this.endpoint,request:(g=this.lastRequest)!=null?g:this.buildRequestStub(),response:null,fromCache:!1,validation:r};return this.dispatchEvent(this.element,"validation",i),r}buildServerValidationResult(e){return e.length===0?{valid:!0,messages:[],errors:[]}:{valid:!1,messages:e,errors:e.map(n=>({code:"server",message:n}))}}async fetchOptions(e,n){var c,d,u,f,p,h,m,g;let r=this.createContext(e,!1),i=(c=globalThis.fetch)==null?void 0:c.bind(globalThis);if(!i){let y=new de("Fetch API unavailable in current environment");throw this.handleError(y,e,null,n,0),y}let o=Math.max(0,(d=this.config.retryAttempts)!=null?d:0)+1,s=Math.max(0,(u=this.config.retryDelayMs)!=null?u:0),a=0,l=null;for(;a<o;){if((f=r.request.init.signal)!=null&&f.aborted)throw new ln;await((h=(p=this.config).beforeFetch)==null?void 0:h.call(p,r));try{let y=await i(e.url,e.init);if(!y.ok){let k=await Qu(y),S=new de(`Resolver request failed with status ${y.status}`,y.status,k);if(a<o-1&&AE(y.status)){a+=1,l=S,await Gu(s*a);continue}throw this.handleError(S,e,y,n,a),S}let E=await Qu(y),x=this.extractOptions(E);return(g=(m=this.config.logger)==null?void 0:m.info)==null||g.call(m,"resolver:success",{field:this.field.name,url:e.url,status:y.status,durationMs:ro()-n,attempt:a+1}),{response:y,payload:E,options:x,fromCache:!1}}catch(y){if(Xu(y))throw new ln;if(l=y,a<o-1){a+=1,await Gu(s*a);continue}let E=y instanceof de?y:new de(y instanceof Error?y.message:String(y!=null?y:"Unknown error"),void 0,y instanceof Error?{name:y.name,stack:y.stack}:void 0);throw this.handleError(E,e,null,n,a),E}}throw l instanceof de?l:new de("Resolver exhausted retries",void 0,l)}async buildRequest(){var c,d,u;let e=(this.endpoint.method||"GET").toUpperCase(),n=new URLSearchParams((c=this.endpoint.params)!=null?c:{});if(this.applyDynamicParams(n),this.field.mode==="search"){let f=this.getSearchValue(),p=f!==void 0&&f.trim().length>0,h=p?void 0:this.getHydrateCurrentValue();h&&this.endpoint.hydrateParam?(n.set(this.endpoint.hydrateParam,h),this.field.searchParam&&n.delete(this.field.searchParam)):this.field.searchParam&&(p?n.set(this.field.searchParam,f):n.delete(this.field.searchParam))}!this.endpoint.resultsPath&&!this.endpoint.mapping&&(Array.from(n.keys()).some(p=>p.toLowerCase()==="format")||n.set("format","options"));let r=this.normaliseBaseUrl((d=this.endpoint.url)!=null?d:""),i=n.toString(),o=i?`${r}?${i}`:r,s={Accept:"application/json"};Object.assign(s,ka((u=this.endpoint.auth)!=null?u:this.config.auth,this.element)),this.abortController=new AbortController;let a={url:o,cacheKey:this.computeCacheKey(e,o),init:{method:e,headers:s,signal:this.abortController.signal}},l=this.createContext(a,!1);if(this.config.buildHeaders){let f=await this.config.buildHeaders(l);f&&typeof f=="object"&&Object.assign(a.init.headers,f)}return a}async buildCreateRequest(e){var d,u,f;let n=new URLSearchParams((d=this.endpoint.params)!=null?d:{});this.applyDynamicParams(n);let r=this.normaliseBaseUrl((u=this.endpoint.url)!=null?u:""),i=n.toString(),o=i?`${r}?${i}`:r,s={Accept:"application/json","Content-Type":"application/json"};Object.assign(s,ka((f=this.endpoint.auth)!=null?f:this.config.auth,this.element));let a=new AbortController,l={url:o,init:{method:"POST",headers:s,signal:a.signal}},c=this.createContext(l,!1);if(this.config.buildHeaders){let p=await this.config.buildHeaders(c);p&&typeof p=="object"&&Object.assign(l.init.headers,p)}return l.init.body=JSON.stringify({query:e}),l}applyDynamicParams(e){var r;let n=(r=this.endpoint.dynamicParams)!=null?r:{};Object.entries(n).forEach(([i,o])=>{let s=this.resolveDynamicTemplate(o);s==null||s===""?e.delete(i):e.set(i,s)})}resolveDynamicTemplate(e){if(!e)return;let n=!1,r=e.replace(TE,(o,s)=>{var l,c;n=!0;let a=s.trim();if(!a)return"";if(a.startsWith("field:")){let d=a.slice(6);return(l=this.getFieldReferenceValue(d))!=null?l:""}return(a==="self"||a==="value")&&(c=this.getSelfValue())!=null?c:""});if(!n)return e;let i=r.trim();return i.length>0?i:void 0}getSelfValue(){if(this.field.mode==="search"){let n=this.getSearchValue();if(n!==void 0)return n===""?void 0:n}let e=Zi(this.element);return Yu(e)}getSearchValue(){if(!(this.element instanceof HTMLElement))return;let e=this.element.getAttribute("data-endpoint-search-value");if(e!=null)return e;let n=this.element.dataset.endpointSearchValue;return n!=null?n:void 0}getHydrateCurrentValue(){let e=this.field.current;if(e==null)return;let n=no(e);if(n.length!==0)return Array.from(new Set(n)).join(",")}getFieldReferenceValue(e){let n=this.findDependencyElements(e);for(let r of n){let i=Zi(r),o=Yu(i);if(o!==void 0)return o}}findDependencyElements(e){var s;let n=[],r=new Set,i=this.element.closest("form"),o=[];if(i){let a=Array.from(i.elements);for(let l of a)l instanceof HTMLElement&&o.push(l)}else{let a=(s=this.element.ownerDocument)!=null?s:document;o.push(...Array.from(a.querySelectorAll("[name], [data-field-name], [id]")))}for(let a of o){if(r.has(a))continue;let l=a.dataset.fieldName;if(l&&l===e){n.push(a),r.add(a);continue}let c=a.name;if(SE(c,e)){n.push(a),r.add(a);continue}let d=a.id;d&&d===e&&(n.push(a),r.add(a))}return n}normaliseBaseUrl(e){var i,o;if(/^[a-z][a-z0-9+.-]*:/i.test(e))return e;let n=(o=(i=this.config.baseUrl)==null?void 0:i.replace(/\/$/,""))!=null?o:"",r=e.startsWith("/")?e:`/${e}`;return`${n}${r}`||e}fieldCacheAdapter(){var e;switch(this.field.cacheStrategy){case"none":return;case"session":return(e=this.sessionCacheAdapter)!=null?e:this.cacheAdapter;default:return this.cacheAdapter}}computeCacheKey(e,n){var r;return typeof this.field.cacheKey=="string"&&this.field.cacheKey.length>0?this.field.cacheKey:(r=this.config.cache)!=null&&r.keyFactory?this.config.cache.keyFactory(this.createContext({url:n,init:{method:e}},!1)):`${e.toUpperCase()}::${n}`}createContext(e,n){let r={element:this.element,field:this.field,endpoint:this.endpoint,request:e,fromCache:n,config:this.config};return this.lastRequest=e,this.lastContext=r,r}getActiveContext(){if(this.lastContext)return this.lastContext;let e=this.buildRequestStub();return this.createContext(e,!1)}buildRequestStub(){var n;let e=(this.endpoint.method||"GET").toUpperCase();return{url:this.normaliseBaseUrl((n=this.endpoint.url)!=null?n:""),init:{method:e,headers:{}}}}resolveRenderer(){var r,i;let e=(r=this.field.renderer)!=null?r:this.defaultRenderer,n=(i=this.renderers.get(e))!=null?i:this.renderers.get(this.defaultRenderer);if(!n)throw new de(`Renderer '${e}' is not registered`);return n}async renderOptions(e,n){let r=this.resolveRenderer(),i={element:this.element,field:this.field,options:e,fromCache:n,config:this.config};await r(i)}extractOptions(e){return this.unwrapPayload(e).map(r=>this.mapOption(r))}unwrapPayload(e){if(!this.endpoint.resultsPath){if(Array.isArray(e))return e;if(e&&typeof e=="object"&&"data"in e){let i=e.data;if(Array.isArray(i))return i}throw new de("Resolver response payload is not an array")}let n=this.endpoint.resultsPath.split(".").filter(Boolean),r=e;for(let i of n){if(r==null)break;r=r[i]}if(!Array.isArray(r))throw new de("Resolver resultsPath did not resolve to an array");return r}mapOption(e){var u,f,p,h,m,g,y,E;if(e==null)return{value:"",label:""};if(typeof e!="object"){let x=String(e);return{value:x,label:x,raw:e}}let n=(p=(f=(u=this.endpoint.mapping)==null?void 0:u.value)!=null?f:this.endpoint.valueField)!=null?p:"value",r=(g=(m=(h=this.endpoint.mapping)==null?void 0:h.label)!=null?m:this.endpoint.labelField)!=null?g:"label",i=(y=this.endpoint.mapping)==null?void 0:y.meta,o=typeof e=="object"&&e!==null?e:null,s=io(e,n),a=io(e,r),l=i?io(e,i):(E=o==null?void 0:o.metadata)!=null?E:o==null?void 0:o.meta;if(s==null&&o&&(o.value!=null?s=o.value:o.id!=null&&(s=o.id)),a==null&&o&&(o.label!=null?a=o.label:o.name!=null&&(a=o.name)),this.endpoint.labelTemplate){let x=wE(this.endpoint.labelTemplate,e).trim();x&&(a=x)}let c=s==null?"":String(s),d=a==null?c:String(a);return{value:c,label:d,description:typeof(o==null?void 0:o.description)=="string"?String(o.description):void 0,disabled:(o==null?void 0:o.disabled)===!0,meta:l,raw:e}}handleError(e,n,r,i,o){var l,c,d,u;let s=e instanceof de?e:new de(String(e||""));ht(this.element,s.message||Ju);let a={element:this.element,field:this.field,endpoint:this.endpoint,request:n,response:r,error:s,fromCache:!1,durationMs:ro()-i,attempt:o+1};(c=(l=this.config.logger)==null?void 0:l.error)==null||c.call(l,"resolver:error",{field:this.field.name,url:n.url,status:s.status,durationMs:a.durationMs,attempt:a.attempt,message:s.message}),(u=(d=this.config).onError)==null||u.call(d,this.createContext(n,!1),s),this.dispatchEvent(this.element,"error",a)}};async function Qu(t){let e=await t.text();if(!e)return null;try{return JSON.parse(e)}catch(n){throw new de("Resolver received invalid JSON",t.status,{error:n,body:e})}}function io(t,e){if(!e)return;let n=e.split(".").filter(Boolean),r=t;for(let i of n){if(r==null)return;r=r[i]}return r}var ME=/\{\{\s*item\.([^{}\s]+)\s*\}\}/g;function wE(t,e){return t.replace(ME,(n,r)=>{let i=io(e,r);return i==null?"":String(i)})}function AE(t){return typeof t!="number"?!1:t>=500}function RE(t,e){var i;if(!(t instanceof HTMLSelectElement))return e;let n=new Set(e.map(o=>o.value)),r=[];for(let o of Array.from(t.selectedOptions))!o.value||n.has(o.value)||(n.add(o.value),r.push({value:o.value,label:(i=o.textContent)!=null?i:o.value}));return r.length>0?[...r,...e]:e}var Zu="default",Ia=class{constructor(){this.cache=new Xi}get(e){return this.cache.get(e)}set(e,n,r){this.cache.set(e,n,r.ttlMs)}delete(e){this.cache.delete(e)}clear(){this.cache.clear()}},Ur="formgen:options:",Pa=class{get(e){let n=oo();if(n)try{let r=n.getItem(Ur+e);if(!r)return;let i=JSON.parse(r);if(typeof i.expiresAt=="number"&&i.expiresAt<=Date.now()){n.removeItem(Ur+e);return}return Array.isArray(i.value)?i.value:void 0}catch{return}}set(e,n,r){let i=oo();if(!i)return;let o=typeof r.ttlMs=="number"&&r.ttlMs>0?Date.now()+r.ttlMs:void 0;try{i.setItem(Ur+e,JSON.stringify({value:n,expiresAt:o}))}catch{}}delete(e){var n;(n=oo())==null||n.removeItem(Ur+e)}clear(){let e=oo();if(e)for(let n=e.length-1;n>=0;n-=1){let r=e.key(n);r&&r.startsWith(Ur)&&e.removeItem(r)}}};function oo(){try{return typeof window!="undefined"?window.sessionStorage:void 0}catch{return}}function OE(t){let{element:e,options:n,field:r}=t;if(e instanceof HTMLSelectElement){let i=new Set(Array.from(e.selectedOptions).map(s=>s.value)),o=LE(r,i);e.innerHTML="",n.forEach(s=>{let a=document.createElement("option");if(a.value=s.value,a.textContent=s.label,a.disabled=s.disabled===!0,s.description&&(a.dataset.optionDescription=s.description),s.meta!=null)try{a.dataset.optionMetadata=JSON.stringify(s.meta)}catch{}o.has(s.value)&&(a.selected=!0),e.appendChild(a)});return}if(e instanceof HTMLDataListElement){e.innerHTML="",n.forEach(i=>{let o=document.createElement("option");o.value=i.value,o.label=i.label,o.disabled=i.disabled===!0,e.appendChild(o)});return}e.textContent=n.map(i=>i.label).join(", ")}function LE(t,e){return t.current==null?e:new Set(Ku(t.current))}function Na(t,e){t.setAttribute("data-state",e)}var $r=class{constructor(e){this.resolvers=new Map;this.rootCleanups=new Map;this.renderers=new Map;this.customResolvers=[];this.sessionCacheAdapter=new Pa;this.dispatchEvent=(e,n,r)=>{var i;n==="loading"?Na(e,"loading"):n==="success"?Na(e,"ready"):n==="error"&&Na(e,"error");try{let o=new CustomEvent(`formgen:relationship:${n}`,{bubbles:!0,detail:r});e.dispatchEvent(o)}catch(o){(i=this.config.logger)!=null&&i.warn&&this.config.logger.warn("Failed to dispatch resolver event",o)}};var n;this.config=e?Ca(e):Tu(),this.cacheConfig=(n=this.config.cache)!=null?n:{},this.cacheAdapter=this.resolveCacheAdapter(this.cacheConfig),this.registerRenderer(Zu,OE)}resolveCacheAdapter(e){if(e.strategy!=="none")return e.strategy==="custom"?e.adapter:new Ia}getConfig(){return this.config}register(e,n){if(e instanceof HTMLElement)return this.attachField(e,n);this.registerResolver(e,n)}attachField(e,{field:n,endpoint:r}){let i=new Vr({element:e,field:n,endpoint:r,config:this.config,cacheAdapter:this.cacheAdapter,sessionCacheAdapter:this.sessionCacheAdapter,cacheConfig:this.cacheConfig,dispatchEvent:this.dispatchEvent,renderers:this.renderers,defaultRenderer:Zu,customResolvers:this.customResolvers});return this.resolvers.set(e,i),i}get(e){return this.resolvers.get(e)}unregister(e){let n=this.resolvers.get(e);n==null||n.destroy(),this.resolvers.delete(e)}registerRootCleanup(e,n){let r=this.rootCleanups.get(e);r||(r=new Set,this.rootCleanups.set(e,r)),r.add(n)}destroy(e){var n,r;for(let[i,o]of this.resolvers)e&&i!==e&&!e.contains(i)||(o.destroy(),this.resolvers.delete(i));for(let[i,o]of this.rootCleanups)if(!(e&&i!==e&&!e.contains(i))){this.rootCleanups.delete(i);for(let s of o)try{s()}catch(a){(r=(n=this.config.logger)==null?void 0:n.warn)==null||r.call(n,"Failed to clean up formgen runtime root",a)}}}async resolve(e){let n=this.resolvers.get(e);n&&await n.resolve()}async refresh(e){let n=this.resolvers.get(e);n&&await n.refresh()}async validate(e){let n=this.resolvers.get(e);if(n)return n.validate()}async create(e,n){let r=this.resolvers.get(e);if(r)return r.create(n)}registerRenderer(e,n){this.renderers.set(e,n)}registerResolver(e,n){this.customResolvers.push({...n,name:e})}};function Xn(t){let e={};t.endpointUrl&&(e.url=t.endpointUrl),t.endpointMethod&&(e.method=t.endpointMethod.toUpperCase()),t.endpointLabelField&&(e.labelField=t.endpointLabelField),t.endpointLabelTemplate&&(e.labelTemplate=t.endpointLabelTemplate),t.endpointValueField&&(e.valueField=t.endpointValueField),t.endpointResultsPath&&(e.resultsPath=t.endpointResultsPath),t.endpointMode&&(e.mode=t.endpointMode),t.endpointSearchParam&&(e.searchParam=t.endpointSearchParam),t.endpointHydrateParam&&(e.hydrateParam=t.endpointHydrateParam),t.endpointSubmitAs&&(e.submitAs=t.endpointSubmitAs);let n=so(t,"endpointParams");n&&(e.params=n);let r=so(t,"endpointDynamicParams");r&&(e.dynamicParams=r);let i=so(t,"endpointMapping");i&&(i.value||i.label)&&(e.mapping=i);let o=so(t,"endpointAuth");return o&&(o.source||o.header||o.strategy)&&(e.auth=o),e}function Qn(t,e){var i,o;let n={name:(o=(i=t.getAttribute("name"))!=null?i:t.getAttribute("id"))!=null?o:void 0};e.relationshipType&&(n.relationship=e.relationshipType),e.relationshipCardinality&&(n.cardinality=e.relationshipCardinality),e.endpointSubmitAs==="json"?n.submitAs="json":e.endpointSubmitAs&&(n.submitAs="default"),e.endpointCacheKey&&(n.cacheKey=e.endpointCacheKey),e.endpointRenderer&&(n.renderer=e.endpointRenderer),e.endpointRefresh&&(n.refreshMode=e.endpointRefresh==="manual"?"manual":"auto"),e.endpointRefreshOn&&(n.refreshOn=e.endpointRefreshOn.split(",").map(s=>s.trim()).filter(Boolean)),e.endpointMode==="search"&&(n.mode="search"),e.endpointAllowCreate==="true"&&(n.allowCreate=!0),e.endpointCreateAction==="true"&&(n.createAction=!0),e.endpointCreateActionLabel&&(n.createActionLabel=e.endpointCreateActionLabel),e.endpointCreateActionId&&(n.createActionId=e.endpointCreateActionId),(e.endpointCreateActionSelect==="append"||e.endpointCreateActionSelect==="replace")&&(n.createActionSelect=e.endpointCreateActionSelect),e.endpointEditAction==="true"&&(n.editAction=!0),e.endpointEditActionLabel&&(n.editActionLabel=e.endpointEditActionLabel),e.endpointEditActionId&&(n.editActionId=e.endpointEditActionId),e.endpointRefreshIntervalMs&&(n.refreshIntervalMs=ao(e.endpointRefreshIntervalMs)),e.endpointCacheTtl&&(n.cacheTtlMs=ao(e.endpointCacheTtl)),(e.endpointCacheStrategy==="memory"||e.endpointCacheStrategy==="session"||e.endpointCacheStrategy==="none")&&(n.cacheStrategy=e.endpointCacheStrategy),e.endpointThrottle&&(n.throttleMs=ao(e.endpointThrottle)),e.endpointDebounce&&(n.debounceMs=ao(e.endpointDebounce)),e.endpointSearchParam&&(n.searchParam=e.endpointSearchParam),e.relationshipCurrent&&(n.current=NE(e.relationshipCurrent)),e.icon&&(n.icon=e.icon),e.iconSource&&(n.iconSource=e.iconSource),e.iconRaw&&(n.iconRaw=e.iconRaw),n.required=t.hasAttribute("required")||e.validationRequired==="true";let r=e.validationLabel||e.endpointFieldLabel||t.getAttribute("aria-label")||t.getAttribute("placeholder")||t.getAttribute("name")||t.id||void 0;if(r&&(n.label=r),e.validationRules)try{let s=JSON.parse(e.validationRules);Array.isArray(s)&&(n.validations=s.filter(DE))}catch{}return n.refreshMode||(n.refreshMode="auto"),n.mode||(n.mode="default"),n}function NE(t){let e=t.trim();if(!e)return null;try{let n=JSON.parse(e);return Array.isArray(n)?n.map(ef).filter(r=>r!=null):n==null?null:ef(n)}catch{return e.includes(",")?e.split(",").map(r=>r.trim()).filter(Boolean):e}}function ef(t){if(t==null)return null;if(typeof t=="object"){let e=t,n=IE(e);if(n)return n;let r=Da(e,["value","id","slug"]);return r!=null?String(r):null}return String(t)}function IE(t){let e=Da(t,["value","id","slug"]);if(e==null)return null;let n=Da(t,["label","name","title"]);if(n==null)return null;let r=String(e),i=String(n);return!r||!i?null:{value:r,label:i}}function Da(t,e){for(let n of e){let r=t[n];if(r!=null&&r!=="")return r}}function so(t,e){let n={},r=e.toLowerCase();return Object.entries(t).forEach(([i,o])=>{if(!o)return;if(i===e||i.toLowerCase()===r){n[""]=o;return}if(!i.startsWith(e))return;let s=i.slice(e.length);if(!s)return;let a=PE(s);a&&(n[a]=o)}),Object.keys(n).length>0?n:void 0}function PE(t){let e=t.trim();if(!e)return"";let n=e.split(""),r=[];return n.forEach((i,o)=>{/[A-Z]/.test(i)?(o!==0&&r.push("-"),r.push(i.toLowerCase())):r.push(i)}),r.join("").replace(/^-+/,"")}function DE(t){if(!t||typeof t!="object")return!1;let e=t;return typeof e.kind=="string"&&e.kind.length>0}function ao(t){if(!t)return;let e=Number(t);return Number.isFinite(e)?e:void 0}function lo(t){let e=t.cancel;typeof e=="function"&&e()}function tf(t,e){if(!e||e<=0){let l=(...c)=>t(...c);return l.cancel=()=>lo(t),l}let n=0,r,i,o=(...l)=>{n=Date.now(),t(...l)},a=(...l)=>{let c=Date.now(),d=e-(c-n);if(d<=0){r!==void 0&&(clearTimeout(r),r=void 0),o(...l);return}i=l,r===void 0&&(r=setTimeout(()=>{r=void 0,i&&(o(...i),i=void 0)},d))};return a.cancel=()=>{r!==void 0&&(clearTimeout(r),r=void 0),i=void 0,lo(t)},a}function nf(t,e){if(!e||e<=0){let o=(...s)=>t(...s);return o.cancel=()=>lo(t),o}let n,i=(...o)=>{n!==void 0&&clearTimeout(n),n=setTimeout(()=>{n=void 0,t(...o)},e)};return i.cancel=()=>{n!==void 0&&(clearTimeout(n),n=void 0),lo(t)},i}function Zn(t){let{select:e,options:n,placeholder:r}=t,i=new Map(Array.from(e.options).filter(a=>a.value!=="").map(a=>[a.value,a.textContent||a.value])),o=new Set(Array.from(e.options).filter(a=>a.selected&&a.value!=="").map(a=>a.value));if(e.innerHTML="",r){let a=document.createElement("option");a.value="",a.textContent=r,e.appendChild(a)}let s=new Map(n.map(a=>[a.value,a]));for(let a of o)if(!s.has(a)){let l=i.get(a)||a;s.set(a,{value:a,label:l})}for(let a of s.values()){let l=document.createElement("option");l.value=a.value,l.textContent=a.label,l.selected=o.has(a.value),e.appendChild(l)}return Oe(e)}function co(t){var n,r;let e=Array.from(t.options).find(i=>i.value==="");return e&&e.textContent?e.textContent:t.getAttribute("placeholder")?(n=t.getAttribute("placeholder"))!=null?n:"":t.getAttribute("aria-label")?(r=t.getAttribute("aria-label"))!=null?r:"":"Select an option"}function uo(t,e){var i,o,s,a;if(e)return e;let n=t.getAttribute("data-endpoint-search-placeholder");if(n)return n;let r=(a=(s=(o=(i=t.getAttribute("data-endpoint-field-label"))!=null?i:t.getAttribute("aria-label"))!=null?o:t.getAttribute("placeholder"))!=null?s:t.getAttribute("name"))!=null?a:t.id;return r?`Search ${r}`.trim():"Search options"}function Oe(t){return new Set(Array.from(t.options).filter(e=>e.selected&&e.value!=="").map(e=>e.value))}function jr(t,e,n){let r=document.createDocumentFragment(),i=t.toLowerCase(),o=e.toLowerCase();if(!o)return r.append(document.createTextNode(t)),r;let s=0,a=i.indexOf(o),l=o.length;for(;a!==-1;){a>s&&r.append(document.createTextNode(t.slice(s,a)));let c=t.slice(a,a+l),d=document.createElement("mark");d.className=n,d.textContent=c,r.append(d),s=a+l,a=i.indexOf(o,s)}return s<t.length&&r.append(document.createTextNode(t.slice(s))),r}var Fa=[],Ha=null;function fo(t,e,n){Fa.push({renderer:t,stores:e,onDestroy:n}),HE()}function rf(t){for(let e of Fa)of(t,e)}function HE(){Ha||typeof window=="undefined"||typeof document=="undefined"||(Ha=new MutationObserver(t=>{for(let e of t)e.removedNodes.forEach(n=>FE(n))}),Ha.observe(document.documentElement,{childList:!0,subtree:!0}))}function FE(t){let e=typeof globalThis!="undefined"?globalThis.HTMLElement:void 0;if(!(typeof e!="function"||!(t instanceof e)))for(let n of Fa)of(t,n)}function of(t,e){let{renderer:n,stores:r,onDestroy:i}=e,o=_E(t,n);for(let s of o){let a=r.get(s);a&&(i(s,a),r.delete(s))}}function _E(t,e){let n=typeof globalThis!="undefined"?globalThis.HTMLSelectElement:void 0,r=new Set;return typeof n=="function"&&t instanceof n&&t.dataset.endpointRenderer===e&&r.add(t),t.querySelectorAll(`select[data-endpoint-renderer='${e}']`).forEach(i=>r.add(i)),Array.from(r)}var BE=new Set(["select","refetch","broadcast"]);function Wr(t){let e=t.dataset.endpointAfterCreate;if(e==null||e.trim()==="")return new Set(["select"]);let n=new Set;return e.split(",").map(r=>r.trim().toLowerCase()).forEach(r=>{BE.has(r)&&n.add(r)}),n}async function po(t,e,n,r=Wr(t)){let i=[];if(r.has("refetch")&&i.push(n.refresh(t)),r.has("broadcast"))for(let o of zE(t))VE(o,e),i.push(n.refresh(o));i.length>0&&await Promise.allSettled(i)}function zE(t){var r;let e=sf(t);if(!e)return[];let n=(r=t.ownerDocument)!=null?r:document;return Array.from(n.querySelectorAll("select[data-endpoint-url]")).filter(i=>i!==t&&sf(i)===e)}function sf(t){var r,i;let e=(r=t.getAttribute("data-relationship-target"))==null?void 0:r.trim();if(e)return`target:${e}`;let n=(i=t.getAttribute("data-endpoint-url"))==null?void 0:i.trim();return n?`url:${n}`:""}function VE(t,e){var n;for(let r of e){if(Array.from(t.options).some(o=>o.value===r.value))continue;let i=t.ownerDocument.createElement("option");i.value=r.value,i.textContent=(n=r.label)!=null?n:r.value,t.appendChild(i)}}var UE={chips:{container:["relative","w-full","text-sm"],containerReady:["flex"],containerOpen:[],inner:["flex","w-full","items-stretch","gap-3","rounded-lg","border","border-gray-200","bg-white","py-3","ps-4","pe-9","transition","focus-within:border-blue-500","focus-within:ring-blue-500"],chips:["flex","flex-wrap","gap-2","grow","items-center"],chipsContent:["flex","flex-wrap","items-center","gap-2"],icon:["inline-flex","items-center","justify-center","text-slate-400","dark:text-slate-500"],placeholder:["text-slate-500"],search:["flex","items-center","gap-2","grow"],searchInput:["w-full","min-w-[6rem]","border-none","bg-transparent","px-0","py-0","text-sm","placeholder:text-slate-400","focus:outline-none","focus:ring-0"],searchHighlight:["bg-amber-100","font-semibold"],chip:["inline-flex","items-center","gap-2","max-w-full","rounded-md","border","border-slate-200","bg-slate-100","px-3","py-1.5","text-sm","font-medium","text-slate-700","shadow-sm"],chipLabel:["truncate"],chipRemove:["flex","h-7","w-7","items-center","justify-center","rounded-full","text-lg","text-slate-400","transition","hover:text-slate-600","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2"],chipAvatar:["size-5","rounded-full","object-cover","mr-1.5"],actions:["flex","items-center","gap-1","ml-auto"],action:["inline-flex","h-9","w-9","items-center","justify-center","rounded-md","border","border-slate-200","bg-white","text-slate-500","shadow-sm","transition-colors","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2"],actionClear:["hover:text-rose-500"],actionToggle:["text-base","text-slate-500"],menu:["absolute","top-full","left-0","right-0","z-20","mt-1","flex","flex-col","rounded-lg","border","border-gray-200","bg-white","shadow-xl","overflow-hidden"],menuSearch:["p-2","border-b","border-gray-200","dark:border-gray-700"],menuSearchInput:["w-full","px-3","py-2","text-sm","border","border-gray-300","rounded-md","focus:outline-none","focus:ring-2","focus:ring-blue-500","dark:bg-slate-800","dark:border-gray-600"],menuDivider:["border-t","border-gray-200","dark:border-gray-700","my-1"],menuList:["max-h-72","overflow-y-auto","space-y-0.5","p-1"],menuFooter:["border-t","border-gray-200","dark:border-gray-700","p-2"],menuFooterAction:["flex","items-center","gap-2","w-full","px-3","py-2","text-sm","text-blue-600","rounded-md","hover:bg-blue-50","focus:outline-none","focus:bg-blue-50"],menuFooterActionFocused:["bg-blue-50","ring-2","ring-blue-500","ring-inset"],menuItem:["flex","justify-between","items-center","gap-2","rounded-lg","px-4","py-2","w-full","text-left","text-sm","text-gray-800","cursor-pointer","transition","hover:bg-gray-100","focus:outline-none","focus:bg-gray-100"],menuItemHighlighted:["bg-blue-50","dark:bg-slate-700"],menuItemIcon:["shrink-0","size-8","rounded-full","overflow-hidden","mr-3","flex","items-center","justify-center"],menuItemAvatar:["w-full","h-full","object-cover"],menuItemText:["flex","flex-col","flex-1","min-w-0"],menuItemTitle:["font-medium","text-gray-900","dark:text-white","truncate"],menuItemDescription:["text-sm","text-gray-500","dark:text-gray-400","truncate"],menuEmpty:["px-3","py-2","text-sm","text-slate-500"],menuLoading:["flex","items-center","justify-center","gap-2","px-3","py-4","text-sm","text-slate-500"],menuLoadingSpinner:["size-5","animate-spin","text-blue-500"],nativeSelect:["hidden"]},typeahead:{container:["relative","w-full","text-sm"],containerReady:["block"],containerOpen:[],control:["flex","items-center","gap-x-2","relative","rounded-lg","border","border-gray-200","bg-white","py-3","ps-4","pe-9","transition","focus-within:border-blue-500","focus-within:ring-blue-500"],input:["w-full","min-w-0","border-none","bg-transparent","px-0","py-0","text-sm","text-slate-900","placeholder:text-slate-400","focus:outline-none","focus:ring-0"],inputWithIcon:["ps-10"],clear:["inline-flex","h-8","w-8","items-center","justify-center","rounded-md","text-slate-400","transition","hover:bg-slate-100","hover:text-slate-600","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2","disabled:cursor-default","disabled:opacity-40"],actions:["flex","items-center","gap-1"],action:["inline-flex","h-9","w-9","items-center","justify-center","rounded-md","border","border-slate-200","bg-white","text-slate-500","shadow-sm","transition-colors","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","focus-visible:ring-offset-2"],actionClear:["hover:text-rose-500"],actionToggle:["text-base","text-slate-500"],dropdown:["absolute","left-0","right-0","z-20","mt-2","rounded-lg","border","border-gray-200","bg-white","p-1","shadow-xl","overflow-hidden","flex","flex-col"],dropdownList:["flex","flex-col","space-y-0.5","min-h-0","max-h-72","overflow-y-auto"],option:["flex","justify-between","items-center","gap-2","rounded-lg","px-4","py-2","w-full","text-left","text-sm","text-gray-800","cursor-pointer","transition","hover:bg-gray-100","focus:outline-none","focus:bg-gray-100"],optionActive:["bg-slate-100"],highlight:["bg-amber-100","font-semibold"],empty:["px-3","py-2","text-sm","text-slate-500"],loading:["flex","items-center","justify-center","gap-2","px-3","py-4","text-sm","text-slate-500"],loadingSpinner:["size-5","animate-spin","text-blue-500"],icon:["absolute","top-1/2","start-3","-translate-y-1/2","pointer-events-none","text-slate-400","dark:text-slate-500","flex","items-center","justify-center"],iconSvg:["size-5","text-current"],nativeSelect:["hidden"],createAction:["flex","items-center","gap-2","w-full","px-4","py-2","text-sm","text-blue-600","font-medium","rounded-lg","cursor-pointer","transition","border-t","border-gray-100","mt-1","hover:bg-blue-50","focus:outline-none","focus:bg-blue-50"],createActionFocused:["bg-blue-50","ring-2","ring-blue-500","ring-inset"]},switch:{container:["relative","inline-block","w-11","h-6","cursor-pointer"],input:["peer","sr-only"],track:["absolute","inset-0","bg-gray-200","rounded-full","transition-colors","duration-200","ease-in-out","peer-checked:bg-blue-600","dark:bg-neutral-700","dark:peer-checked:bg-blue-500","peer-disabled:opacity-50","peer-disabled:pointer-events-none"],toggle:["absolute","top-1/2","start-0.5","-translate-y-1/2","size-5","bg-white","rounded-full","shadow-xs","transition-transform","duration-200","ease-in-out","peer-checked:translate-x-full","dark:bg-neutral-400","dark:peer-checked:bg-white"]},wysiwyg:{wrapper:["wysiwyg-wrapper"],editor:["wysiwyg-editor","border","border-gray-200","rounded-lg","overflow-hidden","dark:border-neutral-700","focus-within:border-blue-500","focus-within:ring-1","focus-within:ring-blue-500"],content:["max-w-none","p-4","min-h-[200px]","focus:outline-none","text-sm","leading-relaxed","text-gray-800","dark:text-neutral-200"],toolbar:["wysiwyg-toolbar","flex","gap-1","p-2","border-b","border-gray-200","bg-gray-50","dark:border-neutral-700","dark:bg-neutral-800"]},fileUploader:{wrapper:["space-y-4"],dropzone:["border-2","border-dashed","border-gray-300","rounded-xl","px-6","py-10","text-center","text-sm","text-gray-600","cursor-pointer","hover:border-blue-500","hover:text-blue-600","transition"],button:["inline-flex","items-center","justify-center","gap-2","rounded-lg","border","border-gray-300","px-4","py-2.5","text-sm","font-medium","text-gray-700","hover:bg-gray-50","focus-visible:outline-none","focus-visible:ring-2","focus-visible:ring-blue-500","dark:text-gray-200","dark:border-gray-600"],preview:["w-full","h-48","object-cover","rounded-lg","border","border-gray-200","bg-gray-50","dark:border-gray-700"],fileList:["space-y-3"],fileItem:["border","border-gray-200","rounded-lg","p-3","flex","flex-col","gap-2","bg-white","dark:border-gray-700","dark:bg-gray-900"],fileMeta:["flex","items-center","justify-between","text-sm","text-gray-700","dark:text-gray-300"],fileName:["font-medium","truncate"],fileSize:["text-xs","text-gray-500"],fileActions:["flex","items-center","justify-between","text-xs","text-gray-500"],progress:["w-full","h-2","bg-gray-100","rounded-full","overflow-hidden"],status:["text-sm","text-gray-600"],error:["text-sm","text-red-600"]}},$E=af(UE);function dn(){return af($E)}function N(t,e){if(!e||e.length===0){t.className="";return}t.className=e.join(" ")}function W(t,e){!e||e.length===0||t.classList.add(...e)}function Le(t,e){!e||e.length===0||t.classList.remove(...e)}function zt(t){return!t||t.length===0?"":t.join(" ")}function er(...t){let e=[],n=new Set;for(let r of t)if(r)for(let i of r)n.has(i)||(n.add(i),e.push(i));return e}function af(t){return{chips:qr(t.chips),typeahead:qr(t.typeahead),switch:qr(t.switch),wysiwyg:qr(t.wysiwyg),fileUploader:qr(t.fileUploader)}}function qr(t){let e={};return Object.keys(t).forEach(n=>{e[n]=[...t[n]]}),e}function ho(t){let{icon:e,iconSource:n,iconRaw:r}=t.dataset;if(!e&&!n&&!r)return null;let i={};return e&&(i.name=e),n&&(i.source=n),r&&(i.raw=r),!i.name&&!i.raw?null:i}function Kr(t,e={}){var r;if(!t)return null;let n=document.createElement("span");if(n.setAttribute("aria-hidden","true"),N(n,(r=e.wrapperClasses)!=null?r:[]),t.raw){if(n.innerHTML=t.raw,e.svgClasses&&e.svgClasses.length>0){let i=n.querySelector("svg");i&&W(i,e.svgClasses)}return n}if(t.name){let i=document.createElement("span");return i.textContent=t.name.slice(0,2).toUpperCase(),i.className="text-xs font-semibold text-current",n.appendChild(i),n}return null}var Mo=null,wo=null;async function Px(){return Mo||wo||(wo=(async()=>{try{let t=await Promise.resolve().then(()=>(Wf(),jf));return Mo={computePosition:t.computePosition,offset:t.offset,flip:t.flip,shift:t.shift},Mo}catch{return null}})(),wo)}var Dx="data-fg-chip-root",Hx="data-fg-chip-value",Fx="data-fg-chip-menu",Wa=new WeakMap,_x=0;function Jf(t){t.registerRenderer("chips",e=>Bx(e,t))}function Gf(t,e){if(!t.multiple)return;let n=Yf(t);Xf(n,e);let r=Zn({select:n.select,options:n.options,placeholder:n.placeholder});ye(n.select,{kind:"options",origin:"hydrate",selectedValues:Array.from(r),query:n.searchValue}),Ao(n,r),jt(n,r),Ro(n,r)}var Bx=(t,e)=>{let{element:n,options:r}=t;if(!(n instanceof HTMLSelectElement)||!n.multiple)return;let i=Yf(n);Xf(i,e,t),i.options=r;let o=Zn({select:i.select,options:r,placeholder:i.placeholder});ye(i.select,{kind:"options",origin:"resolver",selectedValues:Array.from(o),query:i.searchValue}),Ao(i,o),i.creatingQuery||jt(i,o),i.isOpen||ee(i,!1),Ro(i,o)};function Yf(t){var Q;let e=Wa.get(t);if(e)return e;let n=dn().chips,r=document.createElement("div");N(r,n.container),r.style.width="100%",r.setAttribute(Dx,"true"),r.hidden=!0;let i=document.createElement("div");N(i,n.chips);let o=document.createElement("div");N(o,n.chipsContent),i.appendChild(o);let s=ho(t),a=null;s&&(a=Kr(s,{wrapperClasses:n.icon}),a&&i.insertBefore(a,o));let l=document.createElement("div");N(l,n.actions);let c=document.createElement("button");c.type="button",N(c,er(n.action,n.actionClear)),c.setAttribute("aria-label","Clear selection"),c.innerHTML='<span aria-hidden="true">&times;</span>';let d=document.createElement("button");d.type="button",N(d,er(n.action,n.actionToggle)),d.setAttribute("aria-haspopup","listbox"),d.setAttribute("aria-expanded","false"),d.innerHTML='<svg class="shrink-0 size-3.5 text-gray-500" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><path d="m7 15 5 5 5-5"/><path d="m7 9 5-5 5 5"/></svg>',l.append(c,d);let u=document.createElement("div");N(u,n.inner),u.append(i,l);let f=document.createElement("div");N(f,n.menu),f.hidden=!0,f.setAttribute(Fx,"true");let p=document.createElement("div");N(p,n.menuList),p.setAttribute("role","listbox");let h=document.createElement("div");N(h,n.menuFooter),h.hidden=!0,r.append(u,f),t.insertAdjacentElement("beforebegin",r);let m=((Q=n.nativeSelect)!=null?Q:[]).filter(A=>!t.classList.contains(A)),g=t.hasAttribute("required");W(t,n.nativeSelect),Xx(t,r);let y=co(t),E=t.dataset.endpointMode==="search",x=null,k;E&&(p.addEventListener("mousedown",A=>A.preventDefault()),x=document.createElement("div"),N(x,n.menuSearch),k=document.createElement("input"),k.type="text",N(k,n.menuSearchInput),k.setAttribute("placeholder",uo(t)),k.setAttribute("autocomplete","off"),k.setAttribute("aria-label","Search options"),x.appendChild(k)),x&&f.appendChild(x),f.appendChild(p),f.appendChild(h);let S=t.dataset.endpointUseFloatingUi==="true",R=t.dataset.endpointDynamicInput==="true",M=t.dataset.endpointFieldLabel||t.getAttribute("aria-label")||t.getAttribute("name")||t.id||void 0,I=t.dataset.endpointCreateActionLabel,H=M?`Create ${M}\u2026`:"Create new\u2026",b={select:t,container:r,inner:u,chips:i,chipsContent:o,menu:f,menuSearch:x,menuList:p,menuFooter:h,toggle:d,clear:c,placeholder:y,allowCreate:t.dataset.endpointAllowCreate==="true",createLabel:A=>`Create "${A}"`,createOption:void 0,options:[],lastRenderedSelectionKey:"",highlightedIndex:-1,creatingQuery:"",documentHandler:()=>{},isOpen:!1,searchMode:E,searchInput:k,searchValue:"",theme:n,nativeSelectClassesAdded:m,requiredAttributeRemoved:g,icon:s,iconElement:a,loading:!1,footerFocused:!1,animationInProcess:!1,useFloatingUI:S,dynamicInputWidth:R,instanceId:_x++,pendingToggle:null,createActionEnabled:t.dataset.endpointCreateAction==="true",createActionLabel:I||H,createActionId:t.dataset.endpointCreateActionId,createActionSelect:t.dataset.endpointCreateActionSelect==="replace"?"replace":"append",createActionElement:null,field:{},endpoint:{},registry:null};if(E&&k){t.setAttribute("data-endpoint-search-value","");let A=()=>{let P=k.value.trim();b.searchValue=P,t.setAttribute("data-endpoint-search-value",P),ye(t,{kind:"search",origin:"ui",query:P});let B=Oe(t);jt(b,B);let Z=P.length>0||b.options.length>0;Z&&!b.isOpen?ee(b,!0):!Z&&b.isOpen&&ee(b,!1),t.dispatchEvent(new Event("input",{bubbles:!0}))};k.addEventListener("input",()=>{A(),b.dynamicInputWidth&&Zf(b)}),k.addEventListener("keydown",P=>{let B=b.searchValue.trim();if(P.key==="Tab"){ee(b,!1);return}if(P.key==="Escape"){ee(b,!1,!0);return}if(P.key==="Home"){P.preventDefault(),fe(b,0);return}if(P.key==="End"){P.preventDefault();let z=Pe(b);ct(b)?$t(b):z.length>0&&fe(b,z.length-1);return}if(P.key==="ArrowDown"||P.key==="ArrowUp"){b.isOpen||ee(b,!0),P.preventDefault();let z=Pe(b);P.key==="ArrowDown"?b.highlightedIndex===-1?z.length>0?fe(b,0):ct(b)&&$t(b):b.highlightedIndex>=z.length-1?ct(b)?$t(b):fe(b,0):fe(b,b.highlightedIndex+1):b.highlightedIndex===-1?z.length>0&&fe(b,z.length-1):b.highlightedIndex===0?rr(b):fe(b,b.highlightedIndex-1);return}if(P.key!=="Enter")return;if(b.highlightedIndex>=0){P.preventDefault();let ie=Pe(b)[b.highlightedIndex];ie&&ie.click();return}if(!B)return;let Z=Oe(t),K=zx(t,B);if(P.preventDefault(),K){let z=new Set(Z);z.add(K.value),ir(b,z),ee(b,!1);return}if(Qf(b)){ee(b,!0),qa(b,B).catch(()=>{});return}b.createActionEnabled&&!Vx(b,B)&&Ka(b)})}return i.addEventListener("click",()=>{b.isOpen||ee(b,!0)}),u.addEventListener("click",A=>{A.target.closest("button")||b.isOpen||ee(b,!0)}),b.documentHandler=A=>{r.contains(A.target)||ee(b,!1)},c.addEventListener("click",()=>{ir(b,new Set)}),d.addEventListener("click",A=>{A.preventDefault(),ee(b,f.hidden)}),d.addEventListener("keydown",A=>{if(A.key==="Enter"||A.key===" "){A.preventDefault(),ee(b,!b.isOpen);return}if(A.key==="Escape"&&b.isOpen){A.preventDefault(),ee(b,!1,!0);return}if(A.key==="ArrowDown"||A.key==="ArrowUp"){if(A.preventDefault(),b.isOpen||ee(b,!0),!b.searchMode){let P=Pe(b);if(P.length>0){let B=A.key==="ArrowDown"?0:P.length-1;fe(b,B)}else ct(b)&&A.key==="ArrowDown"&&$t(b)}return}if(A.key==="Home"&&b.isOpen){A.preventDefault(),fe(b,0);return}if(A.key==="End"&&b.isOpen){if(A.preventDefault(),ct(b))$t(b);else{let B=Pe(b);B.length>0&&fe(b,B.length-1)}return}}),document.addEventListener("click",b.documentHandler),p.addEventListener("keydown",A=>{if(A.key==="Escape"){A.preventDefault(),ee(b,!1,!0);return}if(A.key==="Tab"){ee(b,!1);return}if(A.key==="Home"){A.preventDefault(),fe(b,0);return}if(A.key==="End"){if(A.preventDefault(),ct(b))$t(b);else{let ie=Pe(b);ie.length>0&&fe(b,ie.length-1)}return}if(A.key!=="ArrowDown"&&A.key!=="ArrowUp")return;A.preventDefault();let P=Pe(b);if(P.length===0){A.key==="ArrowDown"&&ct(b)&&$t(b);return}let B=P.findIndex(z=>z===document.activeElement),Z=A.key==="ArrowDown"?1:-1,K;if(B===-1)K=Z>0?0:P.length-1;else{if(K=B+Z,K<0){b.searchMode&&b.searchInput?b.searchInput.focus():b.toggle.focus(),rr(b);return}if(K>=P.length){if(ct(b)){$t(b);return}K=0}}fe(b,K)}),h.addEventListener("keydown",A=>{if(A.key==="Escape"){A.preventDefault(),ee(b,!1,!0);return}if(A.key==="Tab"){ee(b,!1);return}if(A.key==="Home"){A.preventDefault(),fe(b,0);return}if(A.key==="ArrowUp"){A.preventDefault();let P=Pe(b);P.length>0?fe(b,P.length-1):(b.searchMode&&b.searchInput?b.searchInput.focus():b.toggle.focus(),rr(b));return}if(A.key==="ArrowDown"){A.preventDefault(),Pe(b).length>0&&fe(b,0);return}}),Kx(b),Gx(b),Jx(b),Wa.set(t,b),typeof requestAnimationFrame=="function"?requestAnimationFrame(()=>{r.hidden=!1,W(r,n.containerReady)}):(r.hidden=!1,W(r,n.containerReady)),b}function Xf(t,e,n){var a,l;let r=(a=n==null?void 0:n.field.allowCreate)!=null?a:t.select.dataset.endpointAllowCreate==="true";t.allowCreate=r,t.createOption=async c=>e.create(t.select,c);let i=Gn(t.select),o=Qn(t.select,i),s=Xn(i);t.registry=e,t.field=(l=n==null?void 0:n.field)!=null?l:o,t.endpoint=s,n&&(n.field.createAction!==void 0&&(t.createActionEnabled=n.field.createAction),n.field.createActionLabel&&(t.createActionLabel=n.field.createActionLabel),n.field.createActionId&&(t.createActionId=n.field.createActionId),n.field.createActionSelect&&(t.createActionSelect=n.field.createActionSelect))}function Qf(t){return t.allowCreate&&typeof t.createOption=="function"}function Pe(t){return Array.from(t.menuList.querySelectorAll("[data-fg-chip-option='true']"))}function rr(t){let e=Pe(t);for(let r of e)Le(r,t.theme.menuItemHighlighted);t.highlightedIndex=-1,t.footerFocused=!1,t.searchInput&&t.searchInput.removeAttribute("aria-activedescendant");let n=ct(t);n&&Le(n,t.theme.menuFooterActionFocused)}function ct(t){return t.menuFooter.querySelector("button")}function $t(t){let e=ct(t);if(!e)return;let n=Pe(t);for(let r of n)Le(r,t.theme.menuItemHighlighted);t.highlightedIndex=-1,t.searchInput&&t.searchInput.removeAttribute("aria-activedescendant"),W(e,t.theme.menuFooterActionFocused),e.focus(),t.footerFocused=!0}function fe(t,e){let n=Pe(t);if(n.length===0){t.highlightedIndex=-1;return}t.footerFocused=!1;let r=ct(t);r&&Le(r,t.theme.menuFooterActionFocused);for(let s of n)Le(s,t.theme.menuItemHighlighted);let i=Math.max(0,Math.min(e,n.length-1));t.highlightedIndex=i;let o=n[i];if(o)if(W(o,t.theme.menuItemHighlighted),o.scrollIntoView({block:"nearest"}),t.searchMode&&t.searchInput){if(!o.id){let s=t.select.id?`${t.select.id}-${t.instanceId}`:`anon-${t.instanceId}`;o.id=`fg-chip-option-${s}-${i}`}t.searchInput.setAttribute("aria-activedescendant",o.id),document.activeElement!==t.searchInput&&t.searchInput.focus()}else o.focus()}function zx(t,e){var i;let n=e.trim();if(!n)return null;let r=n.toLowerCase();for(let o of Array.from(t.options)){if(!o.value)continue;let s=o.value,a=(i=o.textContent)!=null?i:s;if(s.toLowerCase()===r||a.toLowerCase()===r)return{value:s,label:a}}return null}function Vx(t,e){let n=e.trim();if(!n)return!1;let r=n.toLowerCase();return t.options.some(i=>{var s;let o=(s=i.label)!=null?s:i.value;return i.value.toLowerCase().includes(r)||o.toLowerCase().includes(r)})}function qf(t,e,n){let r=e.trim();if(!r||!Qf(t))return!1;let i=r.toLowerCase(),o=new Set([...Array.from(n),...t.options.map(s=>s.value)]);return!(Array.from(o).some(s=>s.toLowerCase()===i)||t.options.some(s=>{var a;return((a=s.label)!=null?a:s.value).toLowerCase()===i}))}async function qa(t,e){var i;let n=t.createOption;if(!n)return;let r=e.trim();if(r&&!t.creatingQuery){t.creatingQuery=r,t.highlightedIndex=-1,jt(t,Oe(t.select));try{let o=await n(r);if(!o)return;if(!Array.from(t.select.options).find(c=>c.value===o.value)){let c=document.createElement("option");c.value=o.value,c.textContent=(i=o.label)!=null?i:o.value,t.select.appendChild(c)}t.options.some(c=>c.value===o.value)||(t.options=[...t.options,o]);let a=Oe(t.select),l=new Set(a);l.add(o.value),ir(t,l),ee(t,!1)}finally{t.creatingQuery=""}}}function Ao(t,e){var c;let{chipsContent:n,select:r,placeholder:i,searchInput:o,theme:s}=t,a=Ux(e);if(o&&document.activeElement===o&&a===t.lastRenderedSelectionKey)return;t.lastRenderedSelectionKey=a,n.innerHTML="";let l=Array.from(r.options).filter(d=>d.selected&&d.value!=="");if(l.length===0){let d=document.createElement("span");N(d,s.placeholder),d.textContent=i||"Select an option",n.appendChild(d)}else for(let d of l){let u=d.value,f=(c=d.textContent)!=null?c:u,p=document.createElement("span");N(p,s.chip),p.setAttribute(Hx,u);let h=t.options.find(y=>y.value===u);if(h!=null&&h.avatar){let y=document.createElement("img");y.src=h.avatar,y.alt="",y.loading="lazy",N(y,s.chipAvatar),p.appendChild(y)}let m=document.createElement("span");N(m,s.chipLabel),m.textContent=f;let g=document.createElement("button");g.type="button",N(g,s.chipRemove),g.setAttribute("aria-label",`Remove ${f}`),g.innerHTML="&times;",g.addEventListener("click",()=>{let y=new Set(e);y.delete(u),ir(t,y)}),p.append(m,g),n.appendChild(p)}}function Ux(t){return Array.from(t).slice().sort((e,n)=>e.localeCompare(n)).join("\0")}function jt(t,e){var d;let{menuList:n,options:r,searchMode:i,searchValue:o,theme:s}=t;if(rr(t),n.innerHTML="",t.creatingQuery){let u=document.createElement("button");u.type="button",N(u,s.menuItem),u.setAttribute("role","option"),u.setAttribute("data-fg-chip-option","true"),u.setAttribute("data-fg-create-option","true"),u.disabled=!0,u.textContent=`Creating "${t.creatingQuery}"\u2026`,n.appendChild(u);return}if(t.loading&&r.length===0){let u=i?o.trim():"";if(qf(t,u,e)){let m=document.createElement("button");m.type="button",N(m,s.menuItem),m.setAttribute("role","option"),m.setAttribute("data-fg-chip-option","true"),m.setAttribute("data-fg-create-option","true"),m.textContent=t.createLabel(u),m.addEventListener("click",()=>{qa(t,u).catch(()=>{})}),n.appendChild(m)}let f=document.createElement("div");N(f,s.menuLoading),f.setAttribute("aria-live","polite"),f.setAttribute("role","status");let p=document.createElement("span");N(p,s.menuLoadingSpinner),p.innerHTML='<svg xmlns="http://www.w3.org/2000/svg" fill="none" viewBox="0 0 24 24"><circle class="opacity-25" cx="12" cy="12" r="10" stroke="currentColor" stroke-width="4"></circle><path class="opacity-75" fill="currentColor" d="M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z"></path></svg>',f.appendChild(p);let h=document.createElement("span");h.textContent="Loading\u2026",f.appendChild(h),n.appendChild(f),ja(t);return}let a=i?o.trim():"",l=a.toLowerCase(),c=r.filter(u=>!e.has(u.value)).filter(u=>{var p;return l?((p=u.label)!=null?p:u.value).toLowerCase().includes(l)||u.value.toLowerCase().includes(l):!0});if(qf(t,a,e)){let u=document.createElement("button");u.type="button",N(u,s.menuItem),u.setAttribute("role","option"),u.setAttribute("data-fg-chip-option","true"),u.setAttribute("data-fg-create-option","true"),u.textContent=t.createLabel(a),u.addEventListener("click",()=>{qa(t,a).catch(()=>{})}),n.appendChild(u)}if(c.length===0){let u=document.createElement("div");N(u,s.menuEmpty),u.textContent=l?"No matches":"No more options",n.appendChild(u),ja(t);return}for(let u of c){let f=document.createElement("button");f.type="button",N(f,s.menuItem),f.setAttribute("role","option"),f.dataset.value=u.value,f.setAttribute("data-fg-chip-option","true"),f.disabled=u.disabled===!0;let p=(d=u.label)!=null?d:u.value;if(u.avatar||u.icon||u.description){if(u.avatar||u.icon){let E=document.createElement("span");if(N(E,s.menuItemIcon),u.avatar){let x=document.createElement("img");x.src=u.avatar,x.alt="",x.loading="lazy",N(x,s.menuItemAvatar),E.appendChild(x)}else if(u.icon){let x=Kr({name:u.icon},{wrapperClasses:[],svgClasses:s.menuItemAvatar});if(x)for(;x.firstChild;)E.appendChild(x.firstChild)}f.appendChild(E)}let g=document.createElement("div");N(g,s.menuItemText);let y=document.createElement("span");if(N(y,s.menuItemTitle),y.appendChild(jr(p,l,zt(s.searchHighlight))),g.appendChild(y),u.description){let E=document.createElement("span");N(E,s.menuItemDescription),E.textContent=u.description,g.appendChild(E)}f.appendChild(g)}else{let g=document.createElement("span");g.appendChild(jr(p,l,zt(s.searchHighlight))),f.appendChild(g)}if(e.has(u.value)){f.setAttribute("aria-selected","true");let g=document.createElement("span");g.innerHTML='<svg class="shrink-0 size-3.5 text-blue-600" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><polyline points="20 6 9 17 4 12"/></svg>',f.appendChild(g)}f.addEventListener("click",()=>{let g=new Set(e);g.add(u.value),ir(t,g),ee(t,!1)}),n.appendChild(f)}ja(t)}function ir(t,e){let{select:n}=t;for(let i of Array.from(n.options))i.selected=e.has(i.value);let r=Oe(n);ye(n,{kind:"selection",origin:"ui",selectedValues:Array.from(r)}),n.dispatchEvent(new Event("change",{bubbles:!0})),Ao(t,r),t.searchMode&&(t.searchInput&&(t.searchInput.value=""),t.searchValue="",n.setAttribute("data-endpoint-search-value","")),jt(t,r),Ro(t,r),t.searchMode&&n.dispatchEvent(new Event("input",{bubbles:!0}))}function Ro(t,e){t.clear.disabled=e.size===0}var $x="data-fg-chips-create-action";function ja(t){let{menuFooter:e,theme:n}=t;if(e.innerHTML="",t.createActionElement=null,!t.createActionEnabled){e.hidden=!0;return}e.hidden=!1;let r=document.createElement("button");r.type="button",N(r,n.menuFooterAction),r.setAttribute("role","button"),r.setAttribute($x,"true"),r.setAttribute("tabindex","-1");let i=document.createElement("span");i.innerHTML='<svg class="shrink-0 size-4" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><line x1="12" y1="5" x2="12" y2="19"/><line x1="5" y1="12" x2="19" y2="12"/></svg>',r.appendChild(i);let o=document.createElement("span");o.textContent=t.createActionLabel,r.appendChild(o),r.addEventListener("click",()=>{Ka(t)}),r.addEventListener("keydown",s=>{if(s.key==="Enter"||s.key===" ")s.preventDefault(),Ka(t);else if(s.key==="ArrowUp"){s.preventDefault(),t.footerFocused=!1;let a=Pe(t);a.length>0?fe(t,a.length-1):(t.searchMode&&t.searchInput?t.searchInput.focus():t.toggle.focus(),rr(t))}else s.key==="ArrowDown"?(s.preventDefault(),Pe(t).length>0&&(t.footerFocused=!1,fe(t,0))):s.key==="Escape"&&(s.preventDefault(),ee(t,!1,!0))}),e.appendChild(r),t.createActionElement=r}async function Ka(t){var i;let e=t.registry.getConfig(),n=t.searchValue.trim(),r={query:n,actionId:t.createActionId,mode:"chips",selectBehavior:t.createActionSelect};if(ee(t,!1),t.searchMode&&t.searchInput&&(t.searchInput.value="",t.searchValue="",t.select.setAttribute("data-endpoint-search-value","")),e.onCreateAction)try{let o={element:t.select,field:t.field,endpoint:t.endpoint,request:{url:(i=t.endpoint.url)!=null?i:"",init:{}},fromCache:!1,config:e},s=await e.onCreateAction(o,r);if(s){let a=Array.isArray(s)?s:[s];a.length>0&&jx(t,a,t.createActionSelect)}}catch{}else _r(t.select,{element:t.select,field:t.field,endpoint:t.endpoint,query:n,actionId:t.createActionId,mode:"chips",selectBehavior:t.createActionSelect})}function jx(t,e,n){var o;let r=Wr(t.select);for(let s of e){if(!Array.from(t.select.options).find(l=>l.value===s.value)){let l=document.createElement("option");l.value=s.value,l.textContent=(o=s.label)!=null?o:s.value,t.select.appendChild(l)}t.options.some(l=>l.value===s.value)||(t.options=[...t.options,s])}let i;if(n==="replace")i=new Set(e.map(s=>s.value));else{i=Oe(t.select);for(let s of e)i.add(s.value)}r.has("select")&&ir(t,i),po(t.select,e,t.registry,r)}async function Wx(t){if(!t.useFloatingUI)return;let e=await Px();if(!e)return;let{computePosition:n,offset:r,flip:i,shift:o}=e;try{let{x:s,y:a}=await n(t.inner,t.menu,{placement:"bottom-start",middleware:[r(4),i(),o({padding:8})]});Object.assign(t.menu.style,{position:"absolute",left:`${s}px`,top:`${a}px`})}catch{}}function Zf(t){var s;if(!t.dynamicInputWidth||!t.searchInput)return;t.widthMeasurer||(t.widthMeasurer=document.createElement("span"),t.widthMeasurer.style.cssText=`
+      position: absolute;
+      visibility: hidden;
+      white-space: pre;
+      font: inherit;
+      pointer-events: none;
+    `,(s=t.searchInput.parentElement)==null||s.appendChild(t.widthMeasurer));let e=t.searchInput.value||t.searchInput.placeholder||"";t.widthMeasurer.textContent=e;let n=t.widthMeasurer.offsetWidth,o=Math.max(50,n+16);t.searchInput.style.width=`${o}px`}function ee(t,e,n=!1){if(t.animationInProcess){if(t.isOpen===e)return;t.pendingToggle={open:e,restoreFocus:n};return}if(t.isOpen===e)return;t.animationInProcess=!0,t.menu.hidden=!e,t.toggle.setAttribute("aria-expanded",e?"true":"false"),e?(W(t.container,t.theme.containerOpen),t.useFloatingUI&&Wx(t).catch(()=>{}),t.searchMode&&t.searchInput&&(t.searchInput.focus(),t.dynamicInputWidth&&Zf(t))):(Le(t.container,t.theme.containerOpen),rr(t),n&&t.toggle.focus()),t.isOpen=e;let r=()=>{if(t.animationInProcess=!1,t.pendingToggle&&t.pendingToggle.open!==t.isOpen){let o=t.pendingToggle;t.pendingToggle=null,ee(t,o.open,o.restoreFocus);return}t.pendingToggle=null},i=qx(t.menu);i>0&&typeof setTimeout=="function"?setTimeout(r,i):typeof queueMicrotask=="function"?queueMicrotask(r):Promise.resolve().then(r)}function qx(t){var s,a,l,c;if(typeof window=="undefined"||typeof window.getComputedStyle!="function")return 0;let e=window.getComputedStyle(t),n=e.transitionDuration.split(","),r=e.transitionDelay.split(","),i=Math.max(n.length,r.length),o=0;for(let d=0;d<i;d+=1){let u=Kf((a=(s=n[d])!=null?s:n[0])!=null?a:"0s"),f=Kf((c=(l=r[d])!=null?l:r[0])!=null?c:"0s"),p=u+f;p>o&&(o=p)}return o}function Kf(t){let e=t.trim();if(!e)return 0;let n=parseFloat(e);return Number.isNaN(n)?0:e.endsWith("ms")?n:n*1e3}function Kx(t){let e=()=>{t.select.getAttribute("data-validation-state")==="invalid"?(t.container.setAttribute("data-validation-state","invalid"),t.container.setAttribute("aria-invalid","true")):(t.container.removeAttribute("data-validation-state"),t.container.removeAttribute("aria-invalid"))};t.validationHandler=()=>e(),t.select.addEventListener("formgen:relationship:validation",t.validationHandler),typeof MutationObserver!="undefined"&&(t.validationObserver=new MutationObserver(()=>e()),t.validationObserver.observe(t.select,{attributes:!0,attributeFilter:["data-validation-state"]})),e()}function Jx(t){let e=n=>{let r=n.detail;if(r.kind!=="selection"||r.origin==="ui")return;let i=Oe(t.select);Ao(t,i),jt(t,i),Ro(t,i)};t.updateHandler=e,t.select.addEventListener(ot,e)}function Gx(t){let e=()=>{t.loading=!0;let r=Oe(t.select);jt(t,r)},n=()=>{let r=t.searchInput&&document.activeElement===t.searchInput;t.loading=!1;let i=Oe(t.select);jt(t,i),r&&t.searchInput&&t.searchInput.focus()};t.loadingHandler=e,t.successHandler=n,t.select.addEventListener("formgen:relationship:loading",e),t.select.addEventListener("formgen:relationship:success",n),t.select.addEventListener("formgen:relationship:error",n)}function Yx(t){var e;document.removeEventListener("click",t.documentHandler),t.validationHandler&&t.select.removeEventListener("formgen:relationship:validation",t.validationHandler),(e=t.validationObserver)==null||e.disconnect(),t.updateHandler&&t.select.removeEventListener(ot,t.updateHandler),t.loadingHandler&&t.select.removeEventListener("formgen:relationship:loading",t.loadingHandler),t.successHandler&&(t.select.removeEventListener("formgen:relationship:success",t.successHandler),t.select.removeEventListener("formgen:relationship:error",t.successHandler)),t.container.remove(),Le(t.select,t.nativeSelectClassesAdded),Qx(t.select,t.requiredAttributeRemoved)}function Xx(t,e){t.hasAttribute("required")&&(t.dataset.validationRequiredNative="true",t.removeAttribute("required"),e.setAttribute("aria-required","true"))}function Qx(t,e){e&&(t.setAttribute("required",""),delete t.dataset.validationRequiredNative)}fo("chips",Wa,(t,e)=>{Yx(e)});var Zx="data-fg-typeahead-root",Ya="data-fg-typeahead-option",Xa=new WeakMap;function rp(t){t.registerRenderer("typeahead",e=>eC(e,t))}function ip(t,e){if(t.multiple)return;let n=op(t);sp(n,e);let r=Zn({select:n.select,options:n.options,placeholder:n.placeholder});ye(n.select,{kind:"options",origin:"hydrate",selectedValues:Array.from(r),query:n.searchQuery}),Qa(n,r),qt(n)}var eC=(t,e)=>{let{element:n,options:r}=t;if(!(n instanceof HTMLSelectElement)||n.multiple)return;let i=op(n);sp(i,e,t),i.options=r;let o=Zn({select:i.select,options:r,placeholder:i.placeholder});ye(i.select,{kind:"options",origin:"resolver",selectedValues:Array.from(o),query:i.searchQuery}),Qa(i,o),qt(i)};function op(t){var I;let e=Xa.get(t);if(e)return e;let n=dn().typeahead,r=document.createElement("div");N(r,n.container),r.setAttribute(Zx,"true"),r.hidden=!0;let i=document.createElement("div");N(i,n.control),i.setAttribute("role","combobox"),i.setAttribute("aria-haspopup","listbox"),i.setAttribute("aria-expanded","false");let o=document.createElement("input");o.type="text",N(o,n.input),o.autocomplete="off",o.setAttribute("aria-autocomplete","list");let s=document.createElement("div");N(s,n.actions);let a=document.createElement("button");a.type="button",N(a,er(n.action,n.actionClear)),a.setAttribute("aria-label","Clear selection"),a.innerHTML='<span aria-hidden="true">&times;</span>',a.disabled=!0;let l=document.createElement("button");l.type="button",N(l,er(n.action,n.actionToggle)),l.setAttribute("aria-haspopup","listbox"),l.setAttribute("aria-expanded","false"),l.innerHTML='<svg class="shrink-0 size-3.5 text-gray-500" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><path d="m7 15 5 5 5-5"/><path d="m7 9 5-5 5 5"/></svg>',s.append(a,l),i.append(o,s);let c=document.createElement("div");N(c,n.dropdown),c.hidden=!0;let d=t.id?`${t.id}__typeahead`:`fg-typeahead-${Math.random().toString(36).slice(2)}`,u=document.createElement("div");N(u,n.dropdownList),u.setAttribute("role","listbox"),u.id=d,i.setAttribute("aria-controls",d),o.setAttribute("aria-controls",d),c.appendChild(u),r.append(i,c),t.insertAdjacentElement("beforebegin",r);let f=((I=n.nativeSelect)!=null?I:[]).filter(H=>!t.classList.contains(H)),p=t.hasAttribute("required");W(t,n.nativeSelect),mC(t,i,o);let h=t.dataset.endpointPlaceholder||co(t),m=uo(t,t.dataset.endpointSearchPlaceholder),g=t.dataset.endpointFieldLabel||t.getAttribute("aria-label")||t.getAttribute("name")||t.id||void 0;o.placeholder=h,o.setAttribute("aria-label",g!=null?g:"Related record");let y=ho(t),E=t.dataset.endpointCreateActionLabel,x=g?`Create ${g}\u2026`:"Create new\u2026",k=t.dataset.endpointEditActionLabel,S=g?`Edit ${g}`:"Edit selected",R={select:t,container:r,control:i,input:o,actions:s,clear:a,toggle:l,dropdown:c,dropdownList:u,options:[],filtered:[],placeholder:h,searchPlaceholder:m,allowCreate:t.dataset.endpointAllowCreate==="true",createLabel:H=>`Create "${H}"`,createOption:void 0,label:g,highlightedIndex:-1,isOpen:!1,searchMode:t.dataset.endpointMode==="search",searchQuery:"",documentHandler:()=>{},theme:n,nativeSelectClassesAdded:f,requiredAttributeRemoved:p,icon:y,iconElement:null,loading:!1,createActionEnabled:t.dataset.endpointCreateAction==="true",createActionLabel:E||x,createActionId:t.dataset.endpointCreateActionId,createActionSelect:t.dataset.endpointCreateActionSelect==="append"?"append":"replace",createActionFocused:!1,createActionElement:null,editActionEnabled:t.dataset.endpointEditAction==="true",editActionLabel:k||S,editActionId:t.dataset.endpointEditActionId,editActionFocused:!1,editActionElement:null,field:{},endpoint:{},registry:null};o.placeholder=R.searchMode?R.searchPlaceholder:R.placeholder;let M=Kr(y,{wrapperClasses:n.icon,svgClasses:n.iconSvg});return M&&(i.insertBefore(M,o),R.iconElement=M,W(o,n.inputWithIcon)),tC(R),uC(R),pC(R),fC(R),Xa.set(t,R),hn(R),typeof requestAnimationFrame=="function"?requestAnimationFrame(()=>{r.hidden=!1,W(r,n.containerReady)}):(r.hidden=!1,W(r,n.containerReady)),R}function tC(t){let{input:e,clear:n,toggle:r,dropdown:i}=t;e.addEventListener("focus",()=>{Wt(t),e.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder}),e.addEventListener("click",()=>{Wt(t)}),e.addEventListener("input",()=>nC(t)),e.addEventListener("keydown",o=>rC(t,o)),n.addEventListener("click",()=>{iC(t)}),r.addEventListener("click",o=>{o.preventDefault(),o.stopPropagation(),t.isOpen?(dt(t),Be(t)):(Wt(t),e.focus())}),r.addEventListener("keydown",o=>{if(o.key==="Enter"||o.key===" "){o.preventDefault(),t.isOpen?(dt(t),Be(t)):(Wt(t),e.focus());return}if(o.key==="Escape"&&t.isOpen){o.preventDefault(),dt(t),Be(t);return}if(o.key==="ArrowDown"||o.key==="ArrowUp"){o.preventDefault(),t.isOpen||Wt(t),e.focus();return}}),i.addEventListener("mousedown",o=>{o.preventDefault()}),t.documentHandler=o=>{t.container.contains(o.target)||(dt(t),Be(t))},document.addEventListener("click",t.documentHandler)}function Qa(t,e){var s,a;let{select:n,input:r}=t;if(document.activeElement===r&&t.searchQuery)return;let i=(s=Array.from(e)[0])!=null?s:"";t.highlightedIndex=-1;let o=i?Array.from(n.options).find(l=>l.value===i):void 0;r.value=(a=o==null?void 0:o.textContent)!=null?a:"",Oo(t,i,r.value),i||Be(t),hn(t)}function nC(t){let{input:e,select:n}=t,r=e.value.trim();t.highlightedIndex=-1,t.createActionFocused=!1,t.editActionFocused=!1,t.searchQuery=r,n.setAttribute("data-endpoint-search-value",r),ye(n,{kind:"search",origin:"ui",query:r}),qt(t),Wt(t),t.searchMode&&n.dispatchEvent(new Event("input",{bubbles:!0})),hn(t)}function rC(t,e){if(!new Set(["ArrowDown","ArrowUp","Enter","Escape","Tab"]).has(e.key))return;let{filtered:r}=t;if(e.key==="Escape"){dt(t),Be(t);return}if(e.key==="Tab"){if(t.isOpen&&t.highlightedIndex>=0){let i=r[t.highlightedIndex];i&&(e.preventDefault(),or(t,i))}dt(t),Be(t);return}if(e.preventDefault(),e.key==="Enter"){if(t.highlightedIndex>=0){let s=r[t.highlightedIndex];s&&or(t,s);return}if(r.length===1){or(t,r[0]);return}let i=t.searchQuery.trim(),o=t.createOption;t.searchMode&&t.allowCreate&&o&&ap(t,i)&&lp(t,i).catch(()=>{});return}if(e.key==="ArrowDown"){t.isOpen||Wt(t),ep(t,1);return}if(e.key==="ArrowUp"){t.isOpen||Wt(t),ep(t,-1);return}}function sp(t,e,n){var a,l;let r=(a=n==null?void 0:n.field.allowCreate)!=null?a:t.select.dataset.endpointAllowCreate==="true";t.allowCreate=r,t.createOption=async c=>e.create(t.select,c);let i=Gn(t.select),o=Qn(t.select,i),s=Xn(i);t.registry=e,t.field=(l=n==null?void 0:n.field)!=null?l:o,t.endpoint=s,n&&(n.field.createAction!==void 0&&(t.createActionEnabled=n.field.createAction),n.field.createActionLabel&&(t.createActionLabel=n.field.createActionLabel),n.field.createActionId&&(t.createActionId=n.field.createActionId),n.field.createActionSelect&&(t.createActionSelect=n.field.createActionSelect),n.field.editAction!==void 0&&(t.editActionEnabled=n.field.editAction),n.field.editActionLabel&&(t.editActionLabel=n.field.editActionLabel),n.field.editActionId&&(t.editActionId=n.field.editActionId))}function ap(t,e){let n=e.trim();if(!n||!t.allowCreate||!t.createOption)return!1;let r=n.toLowerCase();return!(t.options.some(i=>i.value.toLowerCase()===r)||t.options.some(i=>{var o;return((o=i.label)!=null?o:i.value).toLowerCase()===r}))}async function lp(t,e){var l;let n=t.createOption;if(!n)return;let r=e.trim();if(!r)return;let o=t.dropdown.querySelector("[data-fg-create-option='true']");o&&(o.disabled=!0,o.textContent="Creating\u2026");let s=await n(r);if(!s)return;if(!Array.from(t.select.options).find(c=>c.value===s.value)){let c=document.createElement("option");c.value=s.value,c.textContent=(l=s.label)!=null?l:s.value,t.select.appendChild(c)}t.options.some(c=>c.value===s.value)||(t.options=[...t.options,s]),or(t,s)}function ep(t,e){let{filtered:n,createActionEnabled:r,createActionElement:i,editActionElement:o}=t,s=r&&i,a=!!o;if(t.createActionFocused){e<0&&(t.createActionFocused=!1,a?(t.editActionFocused=!0,o==null||o.focus()):(n.length>0&&(t.highlightedIndex=n.length-1),t.input.focus()),De(t));return}if(t.editActionFocused){e<0?(t.editActionFocused=!1,n.length>0&&(t.highlightedIndex=n.length-1),t.input.focus(),De(t)):s&&(t.editActionFocused=!1,t.createActionFocused=!0,i.focus(),De(t));return}if(n.length===0){a&&e>0?(t.highlightedIndex=-1,t.editActionFocused=!0,o==null||o.focus(),De(t)):s&&e>0&&(t.highlightedIndex=-1,t.createActionFocused=!0,i.focus(),De(t));return}let l;if(t.highlightedIndex===-1?l=e>0?0:n.length-1:l=t.highlightedIndex+e,l>=n.length&&a&&e>0){t.highlightedIndex=-1,t.editActionFocused=!0,o==null||o.focus(),De(t);return}if(l>=n.length&&s&&e>0){t.highlightedIndex=-1,t.createActionFocused=!0,i.focus(),De(t);return}l<0?l=n.length-1:l>=n.length&&(l=0),t.highlightedIndex=l,t.createActionFocused=!1,t.editActionFocused=!1,De(t)}function or(t,e){var i;if(e.disabled)return;let{select:n,input:r}=t;for(let o of Array.from(n.options))o.selected=o.value===e.value;r.value=(i=e.label)!=null?i:e.value,Oo(t,e.value,r.value),Be(t),dt(t),t.highlightedIndex=-1,t.searchQuery="",n.setAttribute("data-endpoint-search-value",""),ye(n,{kind:"selection",origin:"ui",selectedValues:Array.from(Oe(n))}),n.dispatchEvent(new Event("change",{bubbles:!0})),hn(t)}function iC(t){let{select:e,input:n}=t;for(let r of Array.from(e.options))r.selected=!1;n.value="",Oo(t,"",""),Be(t),t.highlightedIndex=-1,t.searchQuery="",e.setAttribute("data-endpoint-search-value",""),ye(e,{kind:"selection",origin:"ui",selectedValues:[]}),e.dispatchEvent(new Event("change",{bubbles:!0})),qt(t),hn(t),t.searchMode&&e.dispatchEvent(new Event("input",{bubbles:!0}))}function Oo(t,e,n){let r=e.trim(),i=n.trim();for(let o of[t.container,t.input])r?(o.dataset.selectedValue=r,o.dataset.selectedId=r,o.dataset.relationshipValue=r):(delete o.dataset.selectedValue,delete o.dataset.selectedId,delete o.dataset.relationshipValue),i?o.dataset.selectedLabel=i:delete o.dataset.selectedLabel}function Wt(t){if(!t.isOpen){if(t.input.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder,qt(t),t.searchMode&&t.filtered.length===0&&!t.searchQuery){t.dropdown.hidden=!0,t.control.setAttribute("aria-expanded","false"),t.toggle.setAttribute("aria-expanded","false");return}t.dropdown.hidden=!1,t.control.setAttribute("aria-expanded","true"),t.toggle.setAttribute("aria-expanded","true"),W(t.container,t.theme.containerOpen),t.isOpen=!0,t.highlightedIndex===-1&&t.filtered.length>0&&(t.highlightedIndex=0),De(t)}}function dt(t){t.isOpen&&(t.dropdown.hidden=!0,t.control.setAttribute("aria-expanded","false"),t.toggle.setAttribute("aria-expanded","false"),Le(t.container,t.theme.containerOpen),t.isOpen=!1,t.highlightedIndex=-1,t.createActionFocused=!1,t.editActionFocused=!1,De(t))}function qt(t){var a;let{dropdownList:e,select:n,theme:r}=t;e.innerHTML="";let i=t.searchQuery.trim().toLowerCase(),o=t.options;if(i&&(o=o.filter(l=>{var d;return((d=l.label)!=null?d:l.value).toLowerCase().includes(i)||l.value.toLowerCase().includes(i)})),t.filtered=o,o.length===0){if(t.loading){let d=document.createElement("div");N(d,r.loading),d.setAttribute("aria-live","polite"),d.setAttribute("role","status");let u=document.createElement("span");N(u,r.loadingSpinner),u.innerHTML='<svg xmlns="http://www.w3.org/2000/svg" fill="none" viewBox="0 0 24 24"><circle class="opacity-25" cx="12" cy="12" r="10" stroke="currentColor" stroke-width="4"></circle><path class="opacity-75" fill="currentColor" d="M4 12a8 8 0 018-8V0C5.373 0 0 5.373 0 12h4zm2 5.291A7.962 7.962 0 014 12H0c0 3.042 1.135 5.824 3 7.938l3-2.647z"></path></svg>',d.appendChild(u);let f=document.createElement("span");f.textContent="Loading\u2026",d.appendChild(f),e.appendChild(d),Ja(t),Ga(t);return}let l=t.searchQuery.trim();if(t.searchMode&&ap(t,l)){let d=document.createElement("button");d.type="button",N(d,r.option),d.setAttribute("role","option"),d.setAttribute(Ya,"true"),d.setAttribute("data-fg-create-option","true"),d.textContent=t.createLabel(l),d.addEventListener("click",()=>{lp(t,l).catch(()=>{})}),e.appendChild(d)}let c=document.createElement("div");N(c,r.empty),c.textContent=i?"No matches":"No options",e.appendChild(c),Ja(t),Ga(t);return}let s=(a=Array.from(n.options).find(l=>l.selected))==null?void 0:a.value;o.forEach((l,c)=>{var f;let d=document.createElement("button");d.type="button",N(d,r.option),d.setAttribute("role","option"),d.dataset.value=l.value,d.setAttribute(Ya,"true"),d.disabled=l.disabled===!0,d.dataset.selected=l.value===s?"true":"false";let u=document.createElement("span");if(u.appendChild(jr((f=l.label)!=null?f:l.value,i,zt(r.highlight))),d.appendChild(u),l.value===s){d.setAttribute("aria-selected","true");let p=document.createElement("span");p.innerHTML='<svg class="shrink-0 size-3.5 text-blue-600" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><polyline points="20 6 9 17 4 12"/></svg>',d.appendChild(p)}d.addEventListener("click",()=>or(t,l)),e.appendChild(d),t.highlightedIndex===-1&&l.value===s&&(t.highlightedIndex=c)}),Ja(t),Ga(t),De(t)}function De(t){let{dropdownList:e,highlightedIndex:n,theme:r}=t;Array.from(e.querySelectorAll(`[${Ya}]`)).forEach((o,s)=>{let a=s===n;a?W(o,r.optionActive):Le(o,r.optionActive),o.setAttribute("aria-selected",a||o.dataset.selected==="true"?"true":"false"),a&&typeof o.scrollIntoView=="function"&&o.scrollIntoView({block:"nearest"})}),aC(t),lC(t)}var oC="data-fg-typeahead-edit-action",sC="data-fg-typeahead-create-action";function Ja(t){if(t.editActionElement&&(t.editActionElement.remove(),t.editActionElement=null),!t.editActionEnabled)return;let e=Za(t);if(!e){t.editActionFocused=!1;return}let{dropdown:n,theme:r}=t,i=document.createElement("button");i.type="button",N(i,r.createAction),i.setAttribute("role","button"),i.setAttribute(oC,"true"),i.setAttribute("tabindex","-1"),i.dataset.value=e.value;let o=document.createElement("span");o.innerHTML='<svg class="shrink-0 size-4" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><path d="M12 20h9"/><path d="M16.5 3.5a2.12 2.12 0 0 1 3 3L7 19l-4 1 1-4Z"/></svg>',i.appendChild(o);let s=document.createElement("span");s.textContent=t.editActionLabel,i.appendChild(s),i.addEventListener("click",()=>{np(t)}),i.addEventListener("keydown",a=>{a.key==="Enter"||a.key===" "?(a.preventDefault(),np(t)):a.key==="ArrowUp"?(a.preventDefault(),t.editActionFocused=!1,t.filtered.length>0&&(t.highlightedIndex=t.filtered.length-1),t.input.focus(),De(t)):a.key==="ArrowDown"?(a.preventDefault(),t.createActionElement&&(t.editActionFocused=!1,t.createActionFocused=!0,t.createActionElement.focus(),De(t))):a.key==="Escape"&&(a.preventDefault(),dt(t),Be(t))}),n.appendChild(i),t.editActionElement=i}function Ga(t){if(t.createActionElement&&(t.createActionElement.remove(),t.createActionElement=null),!t.createActionEnabled)return;let{dropdown:e,theme:n}=t,r=document.createElement("button");r.type="button",N(r,n.createAction),r.setAttribute("role","button"),r.setAttribute(sC,"true"),r.setAttribute("tabindex","-1");let i=document.createElement("span");i.innerHTML='<svg class="shrink-0 size-4" xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="round"><line x1="12" y1="5" x2="12" y2="19"/><line x1="5" y1="12" x2="19" y2="12"/></svg>',r.appendChild(i);let o=document.createElement("span");o.textContent=t.createActionLabel,r.appendChild(o),r.addEventListener("click",()=>{tp(t)}),r.addEventListener("keydown",s=>{s.key==="Enter"||s.key===" "?(s.preventDefault(),tp(t)):s.key==="ArrowUp"?(s.preventDefault(),t.createActionFocused=!1,t.filtered.length>0&&(t.highlightedIndex=t.filtered.length-1),t.input.focus(),De(t)):s.key==="Escape"&&(s.preventDefault(),dt(t),Be(t))}),e.appendChild(r),t.createActionElement=r}function aC(t){let{editActionElement:e,editActionFocused:n,theme:r}=t;e&&(n?(W(e,r.createActionFocused),e.setAttribute("aria-current","true")):(Le(e,r.createActionFocused),e.removeAttribute("aria-current")))}function lC(t){let{createActionElement:e,createActionFocused:n,theme:r}=t;e&&(n?(W(e,r.createActionFocused),e.setAttribute("aria-current","true")):(Le(e,r.createActionFocused),e.removeAttribute("aria-current")))}async function tp(t){var i;let e=t.registry.getConfig(),n=t.searchQuery.trim(),r={query:n,actionId:t.createActionId,mode:"typeahead",selectBehavior:t.createActionSelect};if(dt(t),Be(t),e.onCreateAction)try{let o={element:t.select,field:t.field,endpoint:t.endpoint,request:{url:(i=t.endpoint.url)!=null?i:"",init:{}},fromCache:!1,config:e},s=await e.onCreateAction(o,r);if(s){let a=Array.isArray(s)?s[0]:s;a&&dC(t,a)}}catch{}else _r(t.select,{element:t.select,field:t.field,endpoint:t.endpoint,query:n,actionId:t.createActionId,mode:"typeahead",selectBehavior:t.createActionSelect})}async function np(t){var i;let e=Za(t);if(!e)return;let n=t.registry.getConfig(),r={selectedValue:e.value,selectedLabel:e.label,actionId:t.editActionId,mode:"typeahead"};if(dt(t),Be(t),n.onEditAction)try{let o={element:t.select,field:t.field,endpoint:t.endpoint,request:{url:(i=t.endpoint.url)!=null?i:"",init:{}},fromCache:!1,config:n},s=await n.onEditAction(o,r);s&&cC(t,s)}catch{}else Ma(t.select,{element:t.select,field:t.field,endpoint:t.endpoint,selectedValue:e.value,selectedLabel:e.label,actionId:t.editActionId,mode:"typeahead"})}function Za(t){var i;let e=Array.from(t.select.options).find(o=>o.selected&&o.value!=="");if(!e)return null;let n=e.value,r=t.options.find(o=>o.value===n);return{...r!=null?r:{},value:n,label:((i=e.textContent)==null?void 0:i.trim())||(r==null?void 0:r.label)||t.input.value||n}}function cC(t,e){let n=Za(t);if(!n)return;let r=e.value||n.value,i=e.label||r,o=Array.from(t.select.options).find(a=>a.value===r);if(o)o.textContent=i,o.selected=!0;else{let a=document.createElement("option");a.value=r,a.textContent=i,a.selected=!0,t.select.appendChild(a)}for(let a of Array.from(t.select.options))a.value!==r&&(a.selected=!1);let s=t.options.findIndex(a=>a.value===r);s>=0?t.options=t.options.map((a,l)=>l===s?{...a,...e,value:r,label:i}:a):t.options=[...t.options,{...e,value:r,label:i}],t.input.value=i,Oo(t,r,i),t.highlightedIndex=-1,t.searchQuery="",t.select.setAttribute("data-endpoint-search-value",""),ye(t.select,{kind:"selection",origin:"ui",selectedValues:[r]}),t.select.dispatchEvent(new Event("change",{bubbles:!0})),qt(t),hn(t)}function dC(t,e){var i;let n=Wr(t.select);if(!Array.from(t.select.options).find(o=>o.value===e.value)){let o=document.createElement("option");o.value=e.value,o.textContent=(i=e.label)!=null?i:e.value,t.select.appendChild(o)}t.options.some(o=>o.value===e.value)||(t.options=[...t.options,e]),n.has("select")&&or(t,e),po(t.select,[e],t.registry,n)}function hn(t){let{input:e,select:n,clear:r}=t,i=e.value.trim()!=="",o=Array.from(n.options).some(s=>s.selected&&s.value!=="");r.disabled=!(i||o)}function Be(t){t.input.value||(t.input.placeholder=t.searchMode?t.searchPlaceholder:t.placeholder)}function uC(t){let e=()=>{t.select.getAttribute("data-validation-state")==="invalid"?(t.container.setAttribute("data-validation-state","invalid"),t.control.setAttribute("aria-invalid","true"),t.input.setAttribute("aria-invalid","true")):(t.container.removeAttribute("data-validation-state"),t.control.removeAttribute("aria-invalid"),t.input.removeAttribute("aria-invalid"))};t.validationHandler=()=>e(),t.select.addEventListener("formgen:relationship:validation",t.validationHandler),typeof MutationObserver!="undefined"&&(t.validationObserver=new MutationObserver(()=>e()),t.validationObserver.observe(t.select,{attributes:!0,attributeFilter:["data-validation-state"]})),e()}function fC(t){let e=n=>{let r=n.detail;if(r.kind!=="selection"||r.origin==="ui")return;let i=Oe(t.select);Qa(t,i),hn(t)};t.updateHandler=e,t.select.addEventListener(ot,e)}function pC(t){let e=()=>{t.loading=!0,qt(t)},n=()=>{let r=t.createActionFocused,i=document.activeElement===t.input;t.loading=!1,qt(t),r&&t.createActionElement?t.createActionElement.focus():i&&t.input.focus()};t.loadingHandler=e,t.successHandler=n,t.select.addEventListener("formgen:relationship:loading",e),t.select.addEventListener("formgen:relationship:success",n),t.select.addEventListener("formgen:relationship:error",n)}function hC(t){var e;document.removeEventListener("click",t.documentHandler),t.validationHandler&&t.select.removeEventListener("formgen:relationship:validation",t.validationHandler),(e=t.validationObserver)==null||e.disconnect(),t.updateHandler&&t.select.removeEventListener(ot,t.updateHandler),t.loadingHandler&&t.select.removeEventListener("formgen:relationship:loading",t.loadingHandler),t.successHandler&&(t.select.removeEventListener("formgen:relationship:success",t.successHandler),t.select.removeEventListener("formgen:relationship:error",t.successHandler)),t.container.remove(),Le(t.select,t.nativeSelectClassesAdded),gC(t.select,t.requiredAttributeRemoved)}function mC(t,e,n){t.hasAttribute("required")&&(t.dataset.validationRequiredNative="true",t.removeAttribute("required"),e.setAttribute("aria-required","true"),n.setAttribute("aria-required","true"))}function gC(t,e){e&&(t.setAttribute("required",""),delete t.dataset.validationRequiredNative)}fo("typeahead",Xa,(t,e)=>{hC(e)});var up="POST",yC=25*1024*1024,fp=({element:t,config:e})=>{let n=t.querySelector("input, textarea, select");if(!n){console.warn("formgen:file-uploader \u2013 unable to find input element");return}let r=new el(t,n,SC(e));return()=>r.destroy()},el=class{constructor(e,n,r){this.entries=[];this.destroying=!1;this.submitting=!1;this.triggerFileDialog=()=>{this.fileInput.click()};this.handleFileInputChange=()=>{this.fileInput.files&&(this.handleSelectedFiles(this.fileInput.files),this.fileInput.value="")};this.handleFormReset=()=>{this.clearEntries(),this.serializeFiles(),this.refreshUI(),this.statusMessage.textContent="",je(this.element)};this.handleFormSubmit=async e=>{var r;if(this.config.autoUpload||this.submitting)return;let n=this.entries.filter(i=>i.status!=="uploaded");if(n.length!==0){e.preventDefault(),this.submitting=!0;try{for(let i of n)if(await this.uploadEntry(i),i.status!=="uploaded")throw new Error("Unable to upload file.");this.serializeFiles(),(r=this.form)==null||r.submit()}catch(i){this.showError(i instanceof Error?i.message:"Upload failed")}finally{this.submitting=!1}}};var s,a,l;this.element=e,this.input=n,this.config=r,this.theme=dn().fileUploader,this.form=n.form,this.originalName=n.name;let i=this.collectHydratedUrls();this.input.type="hidden",this.input.value="",this.hiddenContainer=document.createElement("div"),this.hiddenContainer.dataset.fgUploaderHidden="true",this.hiddenContainer.style.display="none",this.input.after(this.hiddenContainer),this.widget=document.createElement("div"),W(this.widget,this.theme.wrapper),this.fileInput=document.createElement("input"),this.fileInput.type="file",this.fileInput.multiple=this.config.multiple,this.config.allowedTypes.length>0&&(this.fileInput.accept=this.config.allowedTypes.join(",")),this.fileInput.className="sr-only",this.widget.appendChild(this.fileInput);let o=this.createControl();this.widget.appendChild(o),this.previewImage=this.config.variant==="image"?this.createPreview():void 0,this.previewImage&&this.widget.appendChild(this.previewImage),this.fileList=document.createElement("div"),W(this.fileList,this.theme.fileList),this.widget.appendChild(this.fileList),this.statusMessage=document.createElement("p"),W(this.statusMessage,(s=this.theme.status)!=null?s:[]),this.statusMessage.setAttribute("aria-live","polite"),this.widget.appendChild(this.statusMessage),e.appendChild(this.widget),this.fileInput.addEventListener("change",this.handleFileInputChange),(a=this.form)==null||a.addEventListener("reset",this.handleFormReset),this.config.autoUpload||(l=this.form)==null||l.addEventListener("submit",this.handleFormSubmit),i.length>0&&this.hydrateUrls(i),this.config.uploadEndpoint||this.showError("Upload endpoint is not configured.")}destroy(){var e,n;this.destroying=!0,this.fileInput.removeEventListener("change",this.handleFileInputChange),(e=this.form)==null||e.removeEventListener("reset",this.handleFormReset),(n=this.form)==null||n.removeEventListener("submit",this.handleFormSubmit),this.clearEntries()}createControl(){if(this.config.variant==="dropzone"){let n=document.createElement("div");W(n,this.theme.dropzone),n.textContent="Drag & drop files or click to browse",n.role="button",n.tabIndex=0,n.addEventListener("click",this.triggerFileDialog),n.addEventListener("keydown",i=>{(i.key==="Enter"||i.key===" ")&&(i.preventDefault(),this.triggerFileDialog())});let r=i=>{i.preventDefault(),i.stopPropagation()};return n.addEventListener("dragover",r),n.addEventListener("dragenter",r),n.addEventListener("drop",i=>{var o;r(i),(o=i.dataTransfer)!=null&&o.files&&this.handleSelectedFiles(i.dataTransfer.files)}),this.dropzone=n,n}let e=document.createElement("button");return e.type="button",W(e,this.theme.button),e.textContent=this.config.variant==="image"?"Choose image":"Choose files",e.addEventListener("click",this.triggerFileDialog),e}createPreview(){let e=document.createElement("img");return W(e,this.theme.preview),e.alt="Selected image preview",e.hidden=!0,e}handleSelectedFiles(e){let n=Array.from(e);if(n.length){this.config.multiple||this.clearEntries();for(let r of n){let i=this.validateFile(r);if(i){this.showError(i);continue}this.addEntry(r)}}}validateFile(e){return e.size>this.config.maxSize?`File "${e.name}" exceeds maximum size of ${dp(this.config.maxSize)}.`:this.config.allowedTypes.length>0&&!this.config.allowedTypes.some(r=>CC(e,r))?`File "${e.name}" is not an allowed type.`:null}addEntry(e){let n={id:`${Date.now()}-${Math.random().toString(36).slice(2)}`,file:e,status:this.config.autoUpload?"uploading":"pending",progress:0,previewUrl:this.config.preview&&e.type.startsWith("image/")?URL.createObjectURL(e):void 0};this.entries.push(n),this.refreshUI(),this.config.autoUpload&&this.uploadEntry(n)}async uploadEntry(e){e.status="uploading",e.progress=0,this.refreshUI();try{let n=await this.sendUpload(e.file);e.uploaded=n,e.status="uploaded",e.progress=100,e.error=void 0,this.serializeFiles(),je(this.element)}catch(n){e.status="error",e.error=n instanceof Error?n.message:"Upload failed",e.progress=0,this.showError(e.error)}finally{this.refreshUI()}}async sendUpload(e){var s,a,l,c,d,u;if(!this.config.uploadEndpoint)throw new Error("Upload endpoint is not configured.");let n=bC(this.config.headers,this.element),r=new FormData;r.append("file",e,e.name);let i=await fetch(this.config.uploadEndpoint,{method:(s=this.config.uploadMethod)!=null?s:up,headers:n,body:r});if(!i.ok)throw new Error(`Upload failed (${i.status})`);let o=await i.json();if(!o||typeof o.url!="string")throw new Error("Upload response missing url.");return{id:typeof o.id=="string"?o.id:void 0,name:(a=o.name)!=null?a:o.url,originalName:(l=o.originalName)!=null?l:e.name,size:(c=o.size)!=null?c:e.size,contentType:(u=(d=typeof o.contentType=="string"?o.contentType:void 0)!=null?d:typeof o.mime_type=="string"?o.mime_type:void 0)!=null?u:e.type,url:o.url,thumbnail:o.thumbnail,type:typeof o.type=="string"?o.type:void 0,status:typeof o.status=="string"?o.status:void 0,workflowStatus:typeof o.workflow_status=="string"?o.workflow_status:void 0,workflowError:typeof o.workflow_error=="string"?o.workflow_error:void 0,metadata:o.metadata&&typeof o.metadata=="object"?o.metadata:void 0,createdAt:typeof o.created_at=="string"?o.created_at:void 0}}refreshUI(){this.renderFileList(),this.updatePreview()}renderFileList(){var e,n,r,i;this.fileList.innerHTML="";for(let o of this.entries){let s=document.createElement("div");W(s,this.theme.fileItem);let a=document.createElement("div");W(a,(e=this.theme.fileMeta)!=null?e:[]),a.innerHTML=`<span class="${zt((n=this.theme.fileName)!=null?n:[])}">${o.file.name}</span>
+        <span class="${zt((r=this.theme.fileSize)!=null?r:[])}">${dp(o.file.size)}</span>`,s.appendChild(a);let l=document.createElement("div");W(l,(i=this.theme.fileActions)!=null?i:[]);let c=document.createElement("span");c.textContent=kC(o),l.appendChild(c);let d=document.createElement("button");d.type="button",d.textContent="Remove",d.className="text-xs text-red-500 hover:text-red-600",d.addEventListener("click",()=>this.removeEntry(o.id)),l.appendChild(d),s.appendChild(l);let u=document.createElement("div");W(u,this.theme.progress);let f=document.createElement("div");if(f.className="bg-blue-500 h-full transition-all duration-200",f.style.width=`${o.progress}%`,u.appendChild(f),s.appendChild(u),o.error){let p=document.createElement("p");W(p,this.theme.error),p.textContent=o.error,s.appendChild(p)}this.fileList.appendChild(s)}}removeEntry(e){let n=this.entries.findIndex(i=>i.id===e);if(n===-1)return;let[r]=this.entries.splice(n,1);r.previewUrl&&URL.revokeObjectURL(r.previewUrl),this.serializeFiles(),this.refreshUI()}updatePreview(){var r,i,o,s,a,l;if(!this.previewImage)return;let e=(r=this.entries.find(c=>{var d;return((d=c.uploaded)==null?void 0:d.thumbnail)||c.previewUrl}))!=null?r:this.entries[0];if(!e){this.previewImage.hidden=!0,this.previewImage.src="";return}let n=(l=(a=(s=(i=e.uploaded)==null?void 0:i.thumbnail)!=null?s:(o=e.uploaded)==null?void 0:o.url)!=null?a:e.previewUrl)!=null?l:"";if(!n){this.previewImage.hidden=!0;return}this.previewImage.hidden=!1,this.previewImage.src=n}clearEntries(){for(let e of this.entries)e.previewUrl&&URL.revokeObjectURL(e.previewUrl);this.entries=[],this.hiddenContainer.innerHTML="",this.input.value="",this.input.name=this.originalName}collectHydratedUrls(){var a;let e=l=>l.trim();if(!this.config.multiple){let l=e((a=this.input.value)!=null?a:"");return l?[l]:[]}let r=`${this.originalName.endsWith("[]")?this.originalName.slice(0,-2):this.originalName}[]`,i=[`input[name="${cp(this.originalName)}"]`,`input[name="${cp(r)}"]`].join(", "),o=Array.from(this.element.querySelectorAll(i)),s=o.map(l=>{var c;return e((c=l.value)!=null?c:"")}).filter(Boolean);for(let l of o)l!==this.input&&l.remove();return s}hydrateUrls(e){for(let n of e)this.entries.push(vC(n));this.serializeFiles(),this.refreshUI()}serializeFiles(){let e=this.entries.filter(r=>r.uploaded).map(r=>r.uploaded);if(typeof this.config.serialize=="function"){try{this.config.serialize({files:e,input:this.input,fieldName:this.input.name,form:this.form})}catch(r){console.warn("formgen:file-uploader \u2013 serialize hook failed",r)}return}if(this.hiddenContainer.innerHTML="",!e.length){this.input.name=this.originalName,this.input.value="";return}if(!this.config.multiple){this.input.name=this.originalName,this.input.value=e[0].url;return}let n=this.originalName.endsWith("[]")?this.originalName:`${this.originalName}[]`;this.input.name=n,this.input.value=e[0].url;for(let r=1;r<e.length;r+=1){let i=document.createElement("input");i.type="hidden",i.name=n,i.value=e[r].url,this.hiddenContainer.appendChild(i)}}showError(e){this.statusMessage.textContent=e,ht(this.element,e)}};function bC(t,e){let n={};for(let[r,i]of Object.entries(t!=null?t:{})){let o=EC(i,e);o&&(n[r]=o)}return n}function EC(t,e){var n,r;if(t){if(t.startsWith("meta:")){let i=document.querySelector(`meta[name="${t.slice(5)}"]`);return(n=i==null?void 0:i.getAttribute("content"))!=null?n:void 0}if(t.startsWith("data:")){let i=t.slice(5);if(e.hasAttribute(i))return(r=e.getAttribute(i))!=null?r:void 0;let o=xC(i);return e.dataset[o]}return t}}function xC(t){return t.replace(/^data-/,"").split(/[-_:]/).filter(Boolean).map((e,n)=>n===0?e.toLowerCase():e.charAt(0).toUpperCase()+e.slice(1).toLowerCase()).join("")}function CC(t,e){if(!e)return!0;if(e.startsWith("."))return t.name.toLowerCase().endsWith(e.toLowerCase());if(e.endsWith("/*")){let n=e.split("/")[0];return t.type.startsWith(`${n}/`)}return t.type===e}function cp(t){var e;return typeof globalThis!="undefined"&&typeof((e=globalThis.CSS)==null?void 0:e.escape)=="function"?globalThis.CSS.escape(t):t.replace(/["\\]/g,"\\$1")}function vC(t){let e=TC(t),n=new File([],e);return{id:`${Date.now()}-${Math.random().toString(36).slice(2)}`,file:n,status:"uploaded",progress:100,uploaded:{url:t,name:e,originalName:e,size:0,contentType:""}}}function TC(t){var r;let n=t.split("#")[0].split("?")[0].split("/").filter(Boolean);return(r=n[n.length-1])!=null?r:t}function SC(t){var o,s,a;let e=t!=null?t:{},n=(l,c=!1)=>typeof l=="boolean"?l:typeof l=="string"?l.toLowerCase()==="true":c,r=(l,c)=>{if(typeof l=="number")return l;if(typeof l=="string"&&l.trim()!==""){let d=Number(l);return Number.isFinite(d)?d:c}return c},i={};return e.headers&&typeof e.headers=="object"&&Object.entries(e.headers).forEach(([l,c])=>{c!=null&&(i[l]=String(c))}),{variant:(o=e.variant)!=null?o:"input",maxSize:r(e.maxSize,yC),allowedTypes:Array.isArray(e.allowedTypes)?e.allowedTypes.filter(l=>typeof l=="string"):typeof e.allowedTypes=="string"?e.allowedTypes.split(",").map(l=>l.trim()).filter(Boolean):[],multiple:n(e.multiple,!1),uploadEndpoint:(s=e.uploadEndpoint)!=null?s:"",uploadMethod:(a=e.uploadMethod)!=null?a:up,autoUpload:e.autoUpload!==void 0?n(e.autoUpload,!0):!0,preview:e.preview!==void 0?n(e.preview,e.variant==="image"):e.variant==="image",headers:i,serialize:typeof e.serialize=="function"?e.serialize:void 0}}function dp(t){return Number.isFinite(t)?t<1024?`${t} B`:t<1024*1024?`${(t/1024).toFixed(1)} KB`:`${(t/(1024*1024)).toFixed(1)} MB`:"0 B"}function kC(t){switch(t.status){case"pending":return"Pending upload";case"uploading":return`Uploading\u2026 ${t.progress}%`;case"uploaded":return"Uploaded";case"error":return"Error";default:return"Unknown"}}var MC=25*1024*1024,al=({element:t,config:e})=>{let n=t.querySelector("input, textarea, select");if(!n){console.warn("formgen:media-picker - unable to find input element");return}let r=new il(t,n,AC(e));return()=>r.destroy()},il=class{constructor(e,n,r){this.selections=[];this.candidateSelections=[];this.focusedItem=null;this.capabilities={};this.uploadMode="none";this.libraryItems=[];this.searchToken=0;this.destroyed=!1;this.openModal=async()=>{var e;this.candidateSelections=this.selections.slice(),this.focusedItem=(e=this.candidateSelections[0])!=null?e:null,this.modalError.textContent="",this.modalError.hidden=!0,this.modalSearch.value="",this.overlay.hidden=!1,this.overlay.classList.add("flex"),await this.loadLibrary(""),this.renderModal()};this.closeModal=()=>{this.overlay.hidden=!0,this.overlay.classList.remove("flex"),this.modalUploadInput.value=""};this.handleBrowse=()=>{this.openModal()};this.handleUploadTrigger=()=>{this.uploadMode!=="none"&&this.uploadInput.click()};this.handleInlineUpload=async()=>{let e=this.uploadInput.files;if(this.uploadInput.value="",!(!e||e.length===0))try{await this.uploadFiles(e)}catch(n){this.showError(n instanceof Error?n.message:"Upload failed.")}};this.handleClear=()=>{this.selections=[],this.serializeSelections(),this.renderSelections(),this.setStatus(""),je(this.element)};this.handleOverlayClick=e=>{e.target===this.overlay&&this.closeModal()};this.handleApplySelection=()=>{this.selections=tl(this.candidateSelections,this.effectiveValueMode),!this.config.multiple&&this.selections.length>1&&(this.selections=this.selections.slice(0,1)),this.serializeSelections(),this.renderSelections(),this.setStatus(""),je(this.element),this.closeModal()};this.handleModalClear=()=>{this.candidateSelections=[],this.focusedItem=null,this.renderModal()};this.handleModalUploadTrigger=()=>{this.uploadMode!=="none"&&this.modalUploadInput.click()};this.handleModalUpload=async()=>{var n;let e=this.modalUploadInput.files;if(this.modalUploadInput.value="",!(!e||e.length===0))try{let r=await this.uploadFiles(e);!this.config.multiple&&r.length>0&&(this.focusedItem=(n=r[0])!=null?n:null),await this.loadLibrary(this.modalSearch.value),this.renderModal()}catch(r){this.modalError.textContent=r instanceof Error?r.message:"Upload failed.",this.modalError.hidden=!1}};this.handleModalSearch=()=>{this.loadLibrary(this.modalSearch.value).then(()=>this.renderModal())};this.element=e,this.input=n,this.config=r,this.form=n.form,this.originalName=n.name,this.effectiveValueMode=r.valueMode;let i=this.collectHydratedValues();this.input.type="hidden",this.hiddenContainer=document.createElement("div"),this.hiddenContainer.dataset.mediaPickerHidden="true",this.hiddenContainer.style.display="none",this.input.after(this.hiddenContainer),this.shell=this.resolveShell(),this.selectionRoot=document.createElement("div"),this.selectionRoot.dataset.mediaPickerSelections="true",this.selectionRoot.className="space-y-3",this.emptyState=document.createElement("p"),this.emptyState.dataset.mediaPickerEmpty="true",this.emptyState.className="rounded-xl border border-dashed border-gray-300 bg-gray-50 px-4 py-6 text-sm text-gray-500",this.emptyState.textContent=(this.config.multiple,"No media selected.");let o=document.createElement("div");o.className="flex flex-wrap gap-3",this.browseButton=mn("Browse Library","secondary"),this.browseButton.dataset.mediaPickerBrowse="true",this.browseButton.addEventListener("click",this.handleBrowse),this.uploadButton=mn(this.config.multiple?"Upload Files":"Upload File","secondary"),this.uploadButton.dataset.mediaPickerUpload="true",this.uploadButton.addEventListener("click",this.handleUploadTrigger),this.clearButton=mn("Clear","secondary"),this.clearButton.dataset.mediaPickerClear="true",this.clearButton.addEventListener("click",this.handleClear),this.uploadInput=document.createElement("input"),this.uploadInput.type="file",this.uploadInput.className="sr-only",this.uploadInput.multiple=this.config.multiple,this.uploadInput.addEventListener("change",this.handleInlineUpload),this.statusMessage=document.createElement("p"),this.statusMessage.dataset.mediaPickerStatus="true",this.statusMessage.className="text-sm text-gray-500",this.statusMessage.setAttribute("aria-live","polite"),o.append(this.browseButton,this.uploadButton,this.clearButton,this.uploadInput),this.shell.replaceChildren(this.selectionRoot,this.emptyState,o,this.statusMessage);let s=wC();this.overlay=s.overlay,this.modalSearch=s.search,this.modalResults=s.results,this.modalPreview=s.preview,this.modalName=s.name,this.modalMeta=s.meta,this.modalError=s.error,this.modalApply=s.apply,this.modalClear=s.clear,this.modalUpload=s.upload,this.modalUploadInput=s.uploadInput,this.modalClose=s.close,this.modalClose.addEventListener("click",this.closeModal),this.modalApply.addEventListener("click",this.handleApplySelection),this.modalClear.addEventListener("click",this.handleModalClear),this.modalUpload.addEventListener("click",this.handleModalUploadTrigger),this.modalUploadInput.addEventListener("change",this.handleModalUpload),this.modalSearch.addEventListener("input",this.handleModalSearch),this.overlay.addEventListener("click",this.handleOverlayClick),document.body.appendChild(this.overlay),this.renderSelections(),this.bootstrap(i)}destroy(){this.destroyed=!0,this.browseButton.removeEventListener("click",this.handleBrowse),this.uploadButton.removeEventListener("click",this.handleUploadTrigger),this.clearButton.removeEventListener("click",this.handleClear),this.uploadInput.removeEventListener("change",this.handleInlineUpload),this.modalClose.removeEventListener("click",this.closeModal),this.modalApply.removeEventListener("click",this.handleApplySelection),this.modalClear.removeEventListener("click",this.handleModalClear),this.modalUpload.removeEventListener("click",this.handleModalUploadTrigger),this.modalUploadInput.removeEventListener("change",this.handleModalUpload),this.modalSearch.removeEventListener("input",this.handleModalSearch),this.overlay.removeEventListener("click",this.handleOverlayClick),this.overlay.remove()}async bootstrap(e){this.setStatus("Loading media configuration...");try{this.capabilities=await this.loadCapabilities(),this.effectiveValueMode=LC(this.config.valueMode,this.capabilities.picker),this.uploadMode=NC(this.config,this.capabilities),this.configureUploadInputs(),this.updateCapabilityAffordances(),e.length>0&&(this.selections=(await Promise.all(e.map(n=>this.resolveValue(n)))).filter(n=>n!==null)),this.serializeSelections(),this.renderSelections(),this.setStatus("")}catch(n){this.setStatus(n instanceof Error?n.message:"Unable to load media picker.")}}resolveShell(){let e=this.element.querySelector("[data-media-picker-root]");if(e)return e.className="space-y-3",e;let n=document.createElement("div");return n.dataset.mediaPickerRoot="true",n.className="space-y-3",this.element.appendChild(n),n}configureUploadInputs(){let n=this.resolvedAcceptedTypes().join(",");this.uploadInput.accept=n,this.modalUploadInput.accept=n}updateCapabilityAffordances(){let e=this.uploadMode!=="none";this.uploadButton.hidden=!e,this.modalUpload.hidden=!e,this.clearButton.disabled=this.selections.length===0}async loadCapabilities(){let e=RC(this.config);if(!this.config.capabilitiesEndpoint)return e;try{let n=await Kt(this.config.capabilitiesEndpoint,{headers:ei(this.config.headers,this.element)});return OC(n,e)}catch(n){return console.warn("formgen:media-picker - failed to load capabilities",n),e}}collectHydratedValues(){var a;let e=l=>l.trim();if(!this.config.multiple){let l=e((a=this.input.value)!=null?a:"");return l?[l]:[]}let r=`${this.originalName.endsWith("[]")?this.originalName.slice(0,-2):this.originalName}[]`,i=[`input[name="${hp(this.originalName)}"]`,`input[name="${hp(r)}"]`].join(", "),o=Array.from(this.element.querySelectorAll(i)),s=o.map(l=>{var c;return e((c=l.value)!=null?c:"")}).filter(Boolean);for(let l of o)l!==this.input&&l.remove();return s}async resolveValue(e){let n=e.trim();if(!n)return null;try{if(this.effectiveValueMode==="id"){if(this.config.itemEndpoint){let r=PC(this.config.itemEndpoint,"id",n);return sr(await Kt(r,{headers:ei(this.config.headers,this.element)}))}if(this.config.resolveEndpoint)return sr(await Kt(this.config.resolveEndpoint,{method:"POST",headers:Lo(this.config.headers,this.element),body:JSON.stringify({id:n})}))}else if(this.config.resolveEndpoint)return sr(await Kt(this.config.resolveEndpoint,{method:"POST",headers:Lo(this.config.headers,this.element),body:JSON.stringify({url:n})}))}catch(r){console.warn("formgen:media-picker - hydrate failed",r)}return IC(n,this.effectiveValueMode)}renderSelections(){if(this.selectionRoot.innerHTML="",this.emptyState.hidden=this.selections.length>0,this.clearButton.disabled=this.selections.length===0,this.selections.length===0)return;let e=this.config.multiple?this.selections:this.selections.slice(0,1);for(let n of e)this.selectionRoot.appendChild(this.renderSelectionCard(n))}renderSelectionCard(e){let n=document.createElement("div");n.dataset.mediaPickerSelection="true",n.className="flex items-center gap-3 rounded-xl border border-gray-200 bg-white p-3 shadow-sm";let r=document.createElement("div");r.className="flex h-16 w-16 shrink-0 items-center justify-center overflow-hidden rounded-lg border border-gray-200 bg-gray-50",r.appendChild(nl(e,"h-full w-full object-cover"));let i=document.createElement("div");i.className="min-w-0 flex-1";let o=document.createElement("div");o.className="truncate text-sm font-medium text-gray-900",o.textContent=e.name||e.url||e.id||"Media";let s=document.createElement("div");s.className="mt-1 text-xs text-gray-500",s.textContent=rl(e,this.effectiveValueMode),i.append(o,s);let a=document.createElement("button");return a.type="button",a.dataset.mediaPickerRemove=kt(e,this.effectiveValueMode),a.className="inline-flex h-9 w-9 items-center justify-center rounded-md border border-slate-200 bg-white text-slate-500 shadow-sm transition hover:text-rose-500 focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-blue-500 focus-visible:ring-offset-2",a.textContent="\xD7",a.addEventListener("click",()=>this.removeSelection(e)),n.append(r,i,a),n}removeSelection(e){let n=kt(e,this.effectiveValueMode);this.selections=this.selections.filter(r=>kt(r,this.effectiveValueMode)!==n),this.serializeSelections(),this.renderSelections(),je(this.element)}serializeSelections(){var r,i,o;this.hiddenContainer.innerHTML="";let e=this.selections.map(s=>kt(s,this.effectiveValueMode)).filter(Boolean);if(e.length===0){this.input.name=this.originalName,this.input.value="";return}if(!this.config.multiple){this.input.name=this.originalName,this.input.value=(r=e[0])!=null?r:"";return}let n=this.originalName.endsWith("[]")?this.originalName:`${this.originalName}[]`;this.input.name=n,this.input.value=(i=e[0])!=null?i:"";for(let s=1;s<e.length;s+=1){let a=document.createElement("input");a.type="hidden",a.name=n,a.value=(o=e[s])!=null?o:"",this.hiddenContainer.appendChild(a)}}setStatus(e){this.statusMessage.textContent=e}showError(e){this.setStatus(e),ht(this.element,e)}async uploadFiles(e){if(this.uploadMode==="none")throw new Error("Uploads are not available for this picker.");let n=Array.from(e),r=this.config.multiple?n:n.slice(0,1),i=[];for(let o of r){let s=this.validateFile(o);if(s)throw new Error(s);let a=await this.uploadFile(o);i.push(a)}return this.config.multiple?this.selections=tl([...this.selections,...i],this.effectiveValueMode):this.selections=i.slice(0,1),this.serializeSelections(),this.renderSelections(),je(this.element),i}validateFile(e){var o,s,a;let n=(a=(s=(o=this.capabilities.upload)==null?void 0:o.max_size)!=null?s:this.config.maxSize)!=null?a:MC;if(n>0&&e.size>n)return`File "${e.name}" exceeds maximum size of ${UC(n)}.`;let r=this.resolvedAcceptedTypes();if(r.length>0&&!r.some(l=>BC(e,l)))return`File "${e.name}" is not an allowed type.`;let i=this.resolvedAcceptedKinds();return i.length>0&&!i.some(l=>zC(e,l))?`File "${e.name}" is not an allowed media kind.`:null}resolvedAcceptedTypes(){var r,i;let e=this.config.accept,n=(i=(r=this.capabilities.upload)==null?void 0:r.accepted_mime_types)!=null?i:[];return e.length>0?e:n.filter(Boolean)}resolvedAcceptedKinds(){var n,r;let e=(r=(n=this.capabilities.upload)==null?void 0:n.accepted_kinds)!=null?r:[];return this.config.acceptedKinds.length>0?this.config.acceptedKinds:e.filter(Boolean)}async uploadFile(e){if(this.setStatus(`Uploading ${e.name}...`),this.uploadMode==="presign"){let i=HC(await Kt(this.config.presignEndpoint,{method:"POST",headers:Lo(this.config.headers,this.element),body:JSON.stringify({name:e.name,file_name:e.name,content_type:e.type||"application/octet-stream",size:e.size})}));await DC(e,i);let o=await Kt(this.config.confirmEndpoint,{method:"POST",headers:Lo(this.config.headers,this.element),body:JSON.stringify({upload_id:i.upload_id,name:e.name,file_name:e.name,content_type:e.type||"application/octet-stream",size:e.size})});return this.setStatus(""),sr(o)}let n=new FormData;n.append("file",e,e.name);let r=await Kt(this.config.uploadEndpoint,{method:"POST",headers:ei(this.config.headers,this.element),body:n});return this.setStatus(""),sr(r)}async loadLibrary(e){var a;if(!this.config.libraryPath){this.libraryItems=[];return}let n=++this.searchToken,r=new URL(this.config.libraryPath,window.location.origin);e.trim()&&r.searchParams.set("search",e.trim()),r.searchParams.set("limit","48");let i=this.resolvedAcceptedKinds();i.length===1&&r.searchParams.set("type",(a=i[0])!=null?a:"");let o=await Kt(r.toString(),{headers:ei(this.config.headers,this.element)});if(n!==this.searchToken)return;let s=Array.isArray(o)?o:Array.isArray(o==null?void 0:o.items)?o.items:[];this.libraryItems=s.map(l=>sr(l)).filter(l=>this.matchesLibraryFilters(l))}matchesLibraryFilters(e){let n=this.resolvedAcceptedKinds();return n.length===0||!e.type?!0:n.includes(e.type)}renderModal(){var r,i;this.modalResults.innerHTML="";let e=this.libraryItems;if(e.length===0){let o=document.createElement("p");o.dataset.mediaPickerModalEmpty="true",o.className="col-span-full rounded-xl border border-dashed border-gray-300 px-4 py-10 text-center text-sm text-gray-500",o.textContent="No media matched this filter.",this.modalResults.appendChild(o)}for(let o of e){let s=kt(o,this.effectiveValueMode),a=this.candidateSelections.some(p=>kt(p,this.effectiveValueMode)===s),l=document.createElement("button");l.type="button",l.dataset.mediaPickerOption=s,l.className=["overflow-hidden rounded-xl border bg-white text-left shadow-sm transition",a?"border-blue-500 ring-2 ring-blue-500/30":"border-gray-200 hover:border-gray-400"].join(" "),l.addEventListener("click",()=>{this.focusedItem=o,this.config.multiple?this.toggleCandidateSelection(o):this.candidateSelections=[o],this.renderModal()});let c=document.createElement("div");c.className="h-28 bg-gray-50",c.appendChild(nl(o,"h-full w-full object-cover"));let d=document.createElement("div");d.className="space-y-1 p-3";let u=document.createElement("div");u.className="truncate text-sm font-medium text-gray-900",u.textContent=o.name||o.url||o.id||"Media";let f=document.createElement("div");f.className="truncate text-xs text-gray-500",f.textContent=rl(o,this.effectiveValueMode),d.append(u,f),l.append(c,d),this.modalResults.appendChild(l)}let n=(i=(r=this.focusedItem)!=null?r:this.candidateSelections[0])!=null?i:null;this.modalPreview.replaceChildren(nl(n,"h-full w-full object-cover")),this.modalName.textContent=(n==null?void 0:n.name)||(n==null?void 0:n.url)||(n==null?void 0:n.id)||"No media selected",this.modalMeta.textContent=n?rl(n,this.effectiveValueMode):"",this.modalApply.textContent=this.config.multiple?"Use Selection":"Select",this.modalClear.disabled=this.candidateSelections.length===0}toggleCandidateSelection(e){let n=kt(e,this.effectiveValueMode),r=this.candidateSelections.findIndex(i=>kt(i,this.effectiveValueMode)===n);if(r>=0){this.candidateSelections=[...this.candidateSelections.slice(0,r),...this.candidateSelections.slice(r+1)];return}this.candidateSelections=tl([...this.candidateSelections,e],this.effectiveValueMode)}};function wC(){let t=document.createElement("div");t.dataset.mediaPickerModal="true",t.hidden=!0,t.className="fixed inset-0 z-[80] hidden items-center justify-center bg-black/50 p-6";let e=document.createElement("div");e.className="grid h-[min(80vh,760px)] w-[min(1100px,95vw)] grid-cols-[minmax(0,1fr)_320px] overflow-hidden rounded-2xl bg-white shadow-2xl";let n=document.createElement("div");n.className="flex min-h-0 flex-col";let r=document.createElement("div");r.className="border-b border-gray-200 p-4";let i=document.createElement("input");i.dataset.mediaPickerModalSearch="true",i.type="search",i.placeholder="Search media",i.className="w-full rounded-lg border border-gray-300 px-4 py-3 text-sm",r.appendChild(i);let o=document.createElement("div");o.dataset.mediaPickerModalResults="true",o.className="grid flex-1 grid-cols-[repeat(auto-fill,minmax(160px,1fr))] gap-3 overflow-y-auto p-4",n.append(r,o);let s=document.createElement("div");s.className="border-l border-gray-200 p-4";let a=document.createElement("div");a.dataset.mediaPickerModalPreview="true",a.className="mb-4 flex min-h-[220px] items-center justify-center overflow-hidden rounded-2xl border border-gray-200 bg-gray-50";let l=document.createElement("h3");l.dataset.mediaPickerModalName="true",l.className="text-lg font-semibold text-gray-900",l.textContent="No media selected";let c=document.createElement("p");c.dataset.mediaPickerModalMeta="true",c.className="mt-1 text-sm text-gray-500";let d=document.createElement("div");d.className="mt-4 flex flex-wrap gap-3";let u=mn("Select","primary");u.dataset.mediaPickerModalApply="true";let f=mn("Clear","secondary");f.dataset.mediaPickerModalClear="true";let p=mn("Upload","secondary");p.dataset.mediaPickerModalUpload="true";let h=mn("Close","secondary");h.dataset.mediaPickerModalClose="true";let m=document.createElement("input");m.dataset.mediaPickerModalUploadInput="true",m.type="file",m.className="sr-only";let g=document.createElement("p");return g.dataset.mediaPickerModalError="true",g.hidden=!0,g.className="mt-4 text-sm text-red-600",d.append(u,f,p,h,m),s.append(a,l,c,d,g),e.append(n,s),t.appendChild(e),{overlay:t,search:i,results:o,preview:a,name:l,meta:c,error:g,apply:u,clear:f,upload:p,uploadInput:m,close:h}}function mn(t,e){let n=document.createElement("button");return n.type="button",n.textContent=t,n.className=e==="primary"?"inline-flex items-center justify-center rounded-lg bg-blue-600 px-4 py-2 text-sm font-medium text-white transition hover:bg-blue-700 focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-blue-500 focus-visible:ring-offset-2":"inline-flex items-center justify-center rounded-lg border border-gray-200 bg-white px-4 py-2 text-sm font-medium text-gray-700 transition hover:border-gray-300 hover:bg-gray-50 focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-blue-500 focus-visible:ring-offset-2",n}function AC(t){let e=t!=null?t:{},n=(o,s=!1)=>typeof o=="boolean"?o:typeof o=="string"?o.toLowerCase()==="true":s,r=o=>{if(typeof o=="number"&&Number.isFinite(o))return o;if(typeof o=="string"&&o.trim()!==""){let s=Number(o);return Number.isFinite(s)?s:void 0}},i={};return e.headers&&typeof e.headers=="object"&&Object.entries(e.headers).forEach(([o,s])=>{s!=null&&(i[o]=String(s))}),{variant:typeof e.variant=="string"&&e.variant.trim()?e.variant.trim():"media-picker",multiple:n(e.multiple,!1),valueMode:e.valueMode==="id"?"id":"url",libraryPath:re(e.libraryPath),itemEndpoint:re(e.itemEndpoint),resolveEndpoint:re(e.resolveEndpoint),uploadEndpoint:re(e.uploadEndpoint),presignEndpoint:re(e.presignEndpoint),confirmEndpoint:re(e.confirmEndpoint),capabilitiesEndpoint:re(e.capabilitiesEndpoint),maxSize:r(e.maxSize),acceptedKinds:pp(e.acceptedKinds),accept:pp(e.accept),headers:i}}function re(t){return typeof t=="string"?t.trim():""}function pp(t){return Array.isArray(t)?t.filter(e=>typeof e=="string").map(e=>e.trim()).filter(Boolean):typeof t=="string"?t.split(",").map(e=>e.trim()).filter(Boolean):[]}function RC(t){let e=!!(t.itemEndpoint||t.resolveEndpoint);return{operations:{list:!!t.libraryPath,get:!!t.itemEndpoint,resolve:!!t.resolveEndpoint,upload:!!t.uploadEndpoint,presign:!!t.presignEndpoint,confirm:!!t.confirmEndpoint},upload:{direct_upload:!!t.uploadEndpoint,presign:!!(t.presignEndpoint&&t.confirmEndpoint),max_size:t.maxSize,accepted_kinds:t.acceptedKinds,accepted_mime_types:t.accept},picker:{value_modes:e?["url","id"]:["url"],default_value_mode:t.valueMode==="id"&&e?"id":"url"}}}function OC(t,e){var n,r,i,o,s;return{operations:{...e.operations,...(n=t.operations)!=null?n:{}},upload:{...e.upload,...(r=t.upload)!=null?r:{}},picker:{...e.picker,...(i=t.picker)!=null?i:{},value_modes:mp((o=t.picker)==null?void 0:o.value_modes,(s=e.picker)==null?void 0:s.value_modes)}}}function mp(t,e){let r=(Array.isArray(t)&&t.length>0?t:e!=null?e:["url"]).filter(i=>i==="url"||i==="id");return r.length>0?Array.from(new Set(r)):["url"]}function LC(t,e){var r;let n=mp(e==null?void 0:e.value_modes,["url"]);return n.includes(t)?t:e!=null&&e.default_value_mode&&n.includes(e.default_value_mode)?e.default_value_mode:n.includes("url")?"url":(r=n[0])!=null?r:"url"}function NC(t,e){var i,o;let n=(i=e.operations)!=null?i:{},r=(o=e.upload)!=null?o:{};return r.presign&&n.presign&&n.confirm&&t.presignEndpoint&&t.confirmEndpoint?"presign":r.direct_upload&&n.upload&&t.uploadEndpoint?"multipart":"none"}function sr(t){let e=t&&typeof t=="object"?t:{},n=e.metadata&&typeof e.metadata=="object"?e.metadata:void 0;return{id:re(e.id),name:re(e.name)||re(e.filename),url:re(e.url),thumbnail:re(e.thumbnail)||re(e.thumbnail_url)||re(n==null?void 0:n.thumbnail_url),type:re(e.type)||re(n==null?void 0:n.type),mime_type:re(e.mime_type)||re(e.content_type)||re(n==null?void 0:n.mime_type),size:typeof e.size=="number"?e.size:void 0,status:re(e.status),workflow_status:re(e.workflow_status),workflow_error:re(e.workflow_error),metadata:n,created_at:re(e.created_at)}}function IC(t,e){return e==="id"?{id:t,name:t}:{url:t,name:VC(t),thumbnail:sl(t)?t:"",type:sl(t)?"image":""}}function kt(t,e){var n,r,i,o;return e==="id"?(r=(n=t.id)==null?void 0:n.trim())!=null?r:"":(o=(i=t.url)==null?void 0:i.trim())!=null?o:""}function tl(t,e){let n=new Set,r=[];for(let i of t){let o=kt(i,e);!o||n.has(o)||(n.add(o),r.push(i))}return r}function nl(t,e){if((t==null?void 0:t.type)==="image"||sl((t==null?void 0:t.thumbnail)||(t==null?void 0:t.url)||"")){let r=document.createElement("img");return r.className=e,r.src=(t==null?void 0:t.thumbnail)||(t==null?void 0:t.url)||"",r.alt=(t==null?void 0:t.name)||"Media preview",r}let n=document.createElement("div");return n.className="flex h-full w-full items-center justify-center bg-gray-100 text-sm text-gray-500",n.textContent=(t==null?void 0:t.type)||"Media",n}function rl(t,e){return[t.type,t.mime_type,e==="id"?t.id:t.url].filter(Boolean).join(" \xB7 ")}function PC(t,e,n){let r=encodeURIComponent(n);return t.replace(`:${e}`,r)}async function DC(t,e){var o;if(!e.upload_url||!e.upload_id)throw new Error("Upload session initialization failed.");let n=(e.method||"PUT").toUpperCase(),r={...(o=e.headers)!=null?o:{}};if(n==="POST"&&e.fields&&Object.keys(e.fields).length>0){let s=new FormData;for(let[l,c]of Object.entries(e.fields))s.append(l,c);s.append("file",t,t.name);let a=await fetch(e.upload_url,{method:n,body:s});if(!a.ok)throw new Error(`Upload failed (${a.status})`);return}let i=await fetch(e.upload_url,{method:n,headers:r,body:t});if(!i.ok)throw new Error(`Upload failed (${i.status})`)}function HC(t){var e,n,r;return{upload_url:(e=t.upload_url)==null?void 0:e.trim(),method:((n=t.method)==null?void 0:n.trim())||"PUT",headers:t.headers&&typeof t.headers=="object"?Object.fromEntries(Object.entries(t.headers).map(([i,o])=>[i,String(o)])):void 0,fields:t.fields&&typeof t.fields=="object"?Object.fromEntries(Object.entries(t.fields).map(([i,o])=>[i,String(o)])):void 0,upload_id:(r=t.upload_id)==null?void 0:r.trim()}}async function Kt(t,e={}){var s;let n=await fetch(t,{credentials:"same-origin",headers:{Accept:"application/json",...(s=e.headers)!=null?s:{}},...e}),r=String(n.headers.get("content-type")||"").toLowerCase();if(!(r.includes("application/json")||r.includes("+json")))throw n.ok?new Error("Unexpected server response: expected JSON."):new Error(`Request failed (${n.status})`);let o=await n.json().catch(()=>null);if(!n.ok){let a=ol(o)||`Request failed (${n.status})`;throw new Error(a)}if(o==null)throw new Error("Unexpected server response: expected JSON.");return o}function Lo(t,e){return{"Content-Type":"application/json",...ei(t,e)}}function ei(t,e){let n={};for(let[r,i]of Object.entries(t!=null?t:{})){let o=FC(i,e);o&&(n[r]=o)}return n}function FC(t,e){var n,r;if(t){if(t.startsWith("meta:")){let i=document.querySelector(`meta[name="${t.slice(5)}"]`);return(n=i==null?void 0:i.getAttribute("content"))!=null?n:void 0}if(t.startsWith("data:")){let i=t.slice(5);if(e.hasAttribute(i))return(r=e.getAttribute(i))!=null?r:void 0;let o=_C(i);return e.dataset[o]}return t}}function _C(t){return t.replace(/^data-/,"").split(/[-_:]/).filter(Boolean).map((e,n)=>n===0?e.toLowerCase():e.charAt(0).toUpperCase()+e.slice(1).toLowerCase()).join("")}function ol(t){if(!t)return"";if(typeof t=="string")return t.trim();if(Array.isArray(t)){for(let n of t){let r=ol(n);if(r)return r}return""}if(typeof t!="object")return"";let e=t;for(let n of["error","message","detail","reason","description"]){let r=ol(e[n]);if(r)return r}return""}function BC(t,e){if(!e)return!0;if(e.startsWith("."))return t.name.toLowerCase().endsWith(e.toLowerCase());if(e.endsWith("/*")){let n=e.split("/")[0];return t.type.startsWith(`${n}/`)}return t.type===e}function zC(t,e){let n=e.trim().toLowerCase();if(!n)return!0;switch(n){case"image":return t.type.startsWith("image/");case"audio":return t.type.startsWith("audio/");case"video":return t.type.startsWith("video/");case"document":return/pdf|msword|officedocument|text\//.test(t.type);default:return t.type.startsWith(`${n}/`)}}function hp(t){var e;return typeof globalThis!="undefined"&&typeof((e=globalThis.CSS)==null?void 0:e.escape)=="function"?globalThis.CSS.escape(t):t.replace(/["\\]/g,"\\$1")}function sl(t){return/\.(avif|gif|jpe?g|png|svg|webp)$/i.test(t.split("?")[0]||"")}function VC(t){var r;let n=t.split("#")[0].split("?")[0].split("/").filter(Boolean);return(r=n[n.length-1])!=null?r:t}function UC(t){return Number.isFinite(t)?t<1024?`${t} B`:t<1024*1024?`${(t/1024).toFixed(1)} KB`:`${(t/(1024*1024)).toFixed(1)} MB`:"0 B"}var ze=new Map,ti=new WeakMap;qC();function ll(t=document){var n;let e=yp(t);for(let r of e){let i=WC((n=r.dataset.component)!=null?n:"");if(!i)continue;let o=ti.get(r);if(o&&o.name===i)continue;o!=null&&o.teardown&&(o.teardown(),ti.delete(r));let s=ze.get(i);if(!s)continue;let a=$C(r.getAttribute("data-component-config")),l=jC(r,t),c=s({element:r,config:a,root:l});ti.set(r,{name:i,teardown:typeof c=="function"?c:void 0})}}function cl(t=document){var e;for(let n of yp(t)){let r=ti.get(n);(e=r==null?void 0:r.teardown)==null||e.call(r),ti.delete(n)}}function yp(t){let e=(t instanceof Document,t),n=Array.from(e.querySelectorAll("[data-component]"));return t instanceof HTMLElement&&t.hasAttribute("data-component")&&n.unshift(t),n}function $C(t){if(t)try{let e=JSON.parse(t);return typeof e=="object"&&e!==null?e:void 0}catch(e){console.warn("formgen: failed to parse component config",e);return}}function jC(t,e){var r,i,o;let n=t.closest("[data-formgen-auto-init]");return n||(e instanceof HTMLElement?e:(o=(i=e.body)!=null?i:(r=t.ownerDocument)==null?void 0:r.body)!=null?o:t)}function WC(t){return t.trim().toLowerCase()}function qC(){ze.has("datetime-range")||ze.set("datetime-range",KC),ze.has("daterange")||ze.set("daterange",JC),ze.has("duration")||ze.set("duration",GC),ze.has("media_picker")||ze.set("media_picker",al),ze.has("media-picker")||ze.set("media-picker",al),ze.has("file_uploader")||ze.set("file_uploader",fp)}function KC({element:t}){let e=Array.from(t.querySelectorAll("input[type='datetime-local'], input[type='date'], input[type='time']"));if(e.length<2)return;let[n,r]=e,i=()=>{n.value&&(!r.value||r.value<n.value)&&(r.value=n.value)},o=()=>{n.removeEventListener("change",i),r.removeEventListener("change",i)};return n.addEventListener("change",i),r.addEventListener("change",i),i(),o}function JC({element:t}){let e=t.querySelector("[data-formgen-daterange-bound='start']"),n=t.querySelector("[data-formgen-daterange-bound='end']");if(!e||!n)return;let r=()=>{n.min=e.value,e.max=n.value,e.value&&n.value&&n.value<e.value&&(n.value=e.value,e.max=n.value)};return e.addEventListener("change",r),n.addEventListener("change",r),r(),()=>{e.removeEventListener("change",r),n.removeEventListener("change",r)}}var gp={years:["Y",!1],months:["M",!1],weeks:["W",!1],days:["D",!1],hours:["H",!0],minutes:["M",!0],seconds:["S",!0]};function GC({element:t}){let e=t.querySelector("[data-formgen-duration-value]"),n=Array.from(t.querySelectorAll("[data-formgen-duration-part]"));if(!e||n.length===0)return;let r=()=>{var a;let i="",o="",s=!1;for(let l of Object.keys(gp)){let c=n.find(h=>h.dataset.formgenDurationPart===l),d=(a=c==null?void 0:c.value.trim())!=null?a:"";if(d==="")continue;s=!0;let u=Math.max(0,Math.trunc(Number(d)));if(!Number.isFinite(u)||u===0)continue;let[f,p]=gp[l];p?o+=`${u}${f}`:i+=`${u}${f}`}s?!i&&!o?e.value="PT0S":e.value=`P${i}${o?`T${o}`:""}`:e.value="",e.dispatchEvent(new Event("change",{bubbles:!0}))};for(let i of n)i.addEventListener("input",r);return()=>{for(let i of n)i.removeEventListener("input",r)}}var dl="data-formgen-array-items",YC="data-formgen-array-prototype",XC='[data-formgen-array-action="add"]',vp='[data-formgen-array-action="remove"]',ul="data-formgen-array-initialized",No="data-formgen-array-item",Tp="data-formgen-array-existing",bp="data-formgen-prototype-disabled",fl=new WeakMap,Ep=0;function pl(t=document,e={}){for(let n of Mp(t)){if(n.getAttribute(ul)==="true")continue;let r=wp(n),i=Ap(n);if(!r||!i)continue;let o=()=>{var a;let s=Sp(n);for(let l of s)(a=e.onItemAdded)==null||a.call(e,l)};i.addEventListener("click",o),n.addEventListener("click",Rp),n.setAttribute(ul,"true"),fl.set(n,{button:i,handleAdd:o}),ml(n)}}function hl(t=document){for(let e of Mp(t)){let n=fl.get(e);n&&(n.button.removeEventListener("click",n.handleAdd),e.removeEventListener("click",Rp),fl.delete(e)),e.removeAttribute(ul)}}function Sp(t){var u,f,p;let e=wp(t);if(!e)return[];let{max:n}=gl(t);if(n!==null&&yl(t)>=n)return[];let r=sv(t),i=(u=t.dataset.formgenArrayName)!=null?u:"",o=(f=t.dataset.formgenArrayPrototypePath)!=null?f:i?`${i}[${r}]`:"",s=i?`${i}[${r}]`:o,a=(p=t.dataset.formgenArrayPrototypeIdPrefix)!=null?p:o?Cp(o):"",l=s?Cp(s):a,c=e.content.cloneNode(!0);lv(c,{prototypePath:o,targetPath:s,prototypeIDPrefix:a,targetIDPrefix:l}),uv(c);let d=Array.from(c.children).filter(h=>h instanceof HTMLElement);return t.insertBefore(c,e),t.dataset.formgenArrayNextIndex=String(r+1),ml(t),d}function ml(t){let{min:e,max:n}=gl(t);if(e===null&&n===null)return;let r=yl(t),i=Ap(t);i&&n!==null&&(i.disabled=r>=n);let o=e!==null&&r<=e;for(let s of kp(t))s.querySelectorAll(vp).forEach(a=>{a.closest(`[${No}]`)===s&&(a.disabled=o)})}function gl(t){let e=n=>{let r=Number.parseInt(n!=null?n:"",10);return Number.isFinite(r)&&r>=0?r:null};return{min:e(t.dataset.formgenArrayMinItems),max:e(t.dataset.formgenArrayMaxItems)}}function kp(t){return Array.from(t.children).filter(e=>e instanceof HTMLElement&&!(e instanceof HTMLTemplateElement)&&!e.hidden)}function yl(t){return kp(t).length}function Mp(t){let e=(t instanceof Document,t),n=`[${dl}]`,r=Array.from(e.querySelectorAll(n));return t instanceof HTMLElement&&t.hasAttribute(dl)&&r.unshift(t),Array.from(new Set(r))}function wp(t){for(let e of Array.from(t.children))if(e instanceof HTMLTemplateElement&&e.hasAttribute(YC))return e;return null}function Ap(t){let e=t.parentElement;if(!e)return null;for(let n of Array.from(e.children))if(n instanceof HTMLButtonElement&&n.matches(XC))return n;return null}function Rp(t){let e=t.target;if(!(e instanceof Element))return;let n=e.closest(vp);if(!n)return;let r=t.currentTarget instanceof HTMLElement?t.currentTarget:null;if(!(r&&n.closest(`[${dl}]`)!==r)){if(t.preventDefault(),r){let{min:i}=gl(r);if(i!==null&&yl(r)<=i)return}QC(n),r&&ml(r)}}function QC(t){let e=t.closest(`[${No}]`);if(e){if(ZC(e)&&ev(e)){e.hidden=!0,e.setAttribute("aria-hidden","true");return}e.remove()}}function ZC(t){return t.getAttribute(Tp)==="true"}function ev(t){let e=tv(t);return e?(nv(e),rv(t,e),!0):!1}function tv(t){var n;return(n=Array.from(t.querySelectorAll("input")).find(r=>r.closest(`[${No}]`)===t&&bl(r)))!=null?n:null}function bl(t){var r,i,o;let e=(r=t.getAttribute("name"))!=null?r:"",n=(o=(i=t.getAttribute("data-field-path"))!=null?i:t.dataset.fieldName)!=null?o:"";return e.endsWith("._delete")||e.endsWith("[_delete]")||n.endsWith("._delete")||n.endsWith("[_delete]")}function nv(t){if(t.disabled=!1,t.type==="checkbox"||t.type==="radio"){t.checked=!0,t.value="true";return}t.value="true"}function rv(t,e){t.querySelectorAll("input, select, textarea, button").forEach(n=>{n===e||iv(n)||(n.disabled=!0)})}function iv(t){return t instanceof HTMLInputElement?bl(t)||ov(t):!1}function ov(t){var r,i,o;let e=(r=t.getAttribute("name"))!=null?r:"",n=(o=(i=t.getAttribute("data-field-path"))!=null?i:t.dataset.fieldName)!=null?o:"";return xp(e)||xp(n)}function xp(t){return t.endsWith("._present")||t.endsWith("[_present]")||t.endsWith("._row_state")||t.endsWith("[_row_state]")||t.endsWith("._row_key")||t.endsWith("[_row_key]")}function sv(t){var n;let e=Number.parseInt((n=t.dataset.formgenArrayNextIndex)!=null?n:"",10);return Number.isFinite(e)&&e>=0?e:av(t)}function av(t){return Array.from(t.children).filter(e=>!(e instanceof HTMLTemplateElement)).length}function lv(t,e){for(let n of Op(t))cv(n,e)}function cv(t,e){for(let n of Array.from(t.attributes)){let r=dv(n.value,e);r!==n.value&&t.setAttribute(n.name,r)}}function dv(t,e){let n=t;return e.prototypePath&&e.targetPath&&(n=n.split(e.prototypePath).join(e.targetPath)),e.prototypeIDPrefix&&e.targetIDPrefix&&(n=n.split(e.prototypeIDPrefix).join(e.targetIDPrefix)),n}function uv(t){for(let e of Op(t)){let n=e.getAttribute(bp)==="true";if(e.removeAttribute(bp),e.removeAttribute("data-relationship-current"),e.removeAttribute("data-relationship-current-applied"),e.hasAttribute(No)&&e.setAttribute(Tp,"false"),e instanceof HTMLInputElement){if(n&&(e.disabled=!1),fv(e))continue;e.type==="checkbox"||e.type==="radio"?e.checked=!1:e.value="";continue}if(e instanceof HTMLSelectElement){n&&(e.disabled=!1),Array.from(e.options).forEach((r,i)=>{r.selected=!e.multiple&&i===0});continue}if(e instanceof HTMLTextAreaElement){n&&(e.disabled=!1),e.value="";continue}(e instanceof HTMLButtonElement||e instanceof HTMLFieldSetElement||e instanceof HTMLOptGroupElement)&&n&&(e.disabled=!1)}}function fv(t){var n;let e=(n=t.getAttribute("name"))!=null?n:"";return e.endsWith("._present")||e.endsWith("[_present]")?(t.value="true",!0):e.endsWith("._row_state")||e.endsWith("[_row_state]")?(t.value="new",!0):e.endsWith("._row_key")||e.endsWith("[_row_key]")?(t.value=pv(),!0):bl(t)?(t.value="false",(t.type==="checkbox"||t.type==="radio")&&(t.checked=!1),!0):!1}function pv(){return Ep+=1,`new-${Date.now().toString(36)}-${Ep}`}function Op(t){let e=[];for(let n of Array.from(t.children))e.push(n),e.push(...Array.from(n.querySelectorAll("*")));return e}function Cp(t){return`fg-${hv(t.split("[]").join(".item"))}`}function hv(t){let e="",n=!1;for(let r of t.trim()){if(/^[A-Za-z0-9_-]$/.test(r)){e+=r,n=!1;continue}n||(e+="-",n=!0)}return e.replace(/^-+|-+$/g,"")}var El="data-formgen-error-mapping",mv=new Set(["body","request","payload","data","attributes"]),gv=new Set(["","form","base","__all__","non_field_errors","non-field-errors"]);function Lp(t){if(!t)return[];try{let e=JSON.parse(t);return Array.isArray(e)?e:[]}catch{return[]}}function Np(t){let e=t instanceof HTMLElement&&t.hasAttribute(El)?t:t.querySelector(`[${El}]`);return Lp(e==null?void 0:e.getAttribute(El))}function yv(t,e){let n=String(e);return(t.statuses||[]).some(r=>{let i=String(r).trim().toUpperCase();return i===n?!0:i.length===3&&i.endsWith("XX")&&i[0]===n[0]})}function xl(t,e,n){for(let r of t){if(!yv(r,e))continue;let i=bv(r,n);if(Object.keys(i.fields).length>0||i.form.length>0)return i}return null}function bv(t,e){let n={fields:{},form:[]},r=Io(e,t.path),i=(o,s)=>{let a=Ev(o);if(!a){n.form.push(s);return}n.fields[a]||(n.fields[a]=[]),n.fields[a].push(s)};if(t.shape==="list"&&Array.isArray(r)?r.forEach(o=>{let s=gn(Io(o,t.messageKey));s&&i(xv(Io(o,t.fieldKey)),s)}):t.shape==="map"&&Po(r)&&Object.keys(r).sort().forEach(o=>{Cv(r[o]).forEach(s=>i(o,s))}),Object.keys(n.fields).length===0&&n.form.length===0&&t.formMessageKey){let o=gn(Io(e,t.formMessageKey));o&&n.form.push(o)}return n}function Ev(t){let e=t.trim();if(gv.has(e.toLowerCase()))return"";let n=e.replace(/^[#$]/,"").replace(/\[(\w*)\]/g,".$1").split(/[./]/).map(r=>r.trim().replace(/~1/g,"/").replace(/~0/g,"~")).filter(Boolean);for(;n.length>0&&mv.has(n[0].toLowerCase());)n.shift();return n.filter(r=>!/^\d+$/.test(r)).join(".")}function Io(t,e){if(!e)return e===""?t:void 0;let n=t;for(let r of e.split(".")){if(!Po(n))return;n=n[r]}return n}function xv(t){return Array.isArray(t)?t.map(gn).filter(Boolean).join("."):gn(t)}function Cv(t){if(Array.isArray(t))return t.map(n=>gn(Po(n)?n.message:n)).filter(Boolean);if(Po(t)){let n=gn(t.message);return n?[n]:[]}let e=gn(t);return e?[e]:[]}function gn(t){return typeof t=="string"?t.trim():typeof t=="number"||typeof t=="boolean"?String(t):""}function Po(t){return!!t&&typeof t=="object"&&!Array.isArray(t)}var Do="data-formgen-form-id",Cl="data-formgen-link-locked",Ip="data-formgen-link-dirty";function vl(t,e,n=document){let r=(t!=null?t:[]).map(vv).filter(a=>a!==null);if(r.length===0)return()=>{};let i=!1,o=(a,l)=>{let c=Bp(n,a.to.form);if(!c)return;let d=zp(c,a.to.field);if(d.length===0||a.mode==="prefill"&&d.some(f=>f.hasAttribute(Ip)))return;let u=Fp(l);i=!0;try{e(c,a.to.field,u)}finally{i=!1}a.mode==="constrain"&&d.forEach(f=>Sv(f,!_p(u)))},s=a=>{if(i)return;let l=a.target;if(Tv(l))for(let c of r){if(Hp(l,c.from)){o(c,l);continue}if(Hp(l,c.to)){if(c.mode==="constrain"&&l.hasAttribute(Cl)){let d=Dp(n,c.from);d&&o(c,d);continue}l.setAttribute(Ip,"true")}}};n.addEventListener("change",s,!0),n.addEventListener("input",s,!0);for(let a of r){let l=Dp(n,a.from);l&&!_p(Fp(l))&&o(a,l)}return()=>{n.removeEventListener("change",s,!0),n.removeEventListener("input",s,!0)}}function vv(t){let e=Pp(t==null?void 0:t.from),n=Pp(t==null?void 0:t.to);return!e||!n?null:{from:e,to:n,mode:t.mode==="constrain"?"constrain":"prefill"}}function Pp(t){let e=(t!=null?t:"").trim(),n=e.indexOf(".");return n<=0||n===e.length-1?null:{form:e.slice(0,n),field:e.slice(n+1)}}function Bp(t,e){var r;return(r=Array.from(t.querySelectorAll(`[${Do}]`)).find(i=>i.getAttribute(Do)===e))!=null?r:null}function Dp(t,e){var r;let n=Bp(t,e.form);return n&&(r=zp(n,e.field)[0])!=null?r:null}function zp(t,e){return Array.from(t.querySelectorAll("input, select, textarea")).filter(n=>!n.closest("[data-relationship-hidden]")&&Vp(n,e))}function Hp(t,e){let n=t.closest(`[${Do}]`);return!n||n.getAttribute(Do)!==e.form?!1:!t.closest("[data-relationship-hidden]")&&Vp(t,e.field)}function Vp(t,e){let n=t.name||t.getAttribute("data-field-name")||"";return n===e||n===`${e}[]`}function Tv(t){return t instanceof HTMLInputElement||t instanceof HTMLSelectElement||t instanceof HTMLTextAreaElement}function Fp(t){var e;if(t instanceof HTMLSelectElement){let n=Array.from(t.selectedOptions).map(r=>r.value).filter(r=>r!=="");return t.multiple?n:(e=n[0])!=null?e:null}return t instanceof HTMLInputElement&&t.type.toLowerCase()==="checkbox"?t.checked?t.value||"true":null:t.value===""?null:t.value}function _p(t){return t==null||Array.isArray(t)&&t.length===0}function Sv(t,e){e?(t.setAttribute(Cl,"true"),t.setAttribute("aria-readonly","true")):(t.removeAttribute(Cl),t.removeAttribute("aria-readonly")),(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement)&&(t.readOnly=e)}var jp="data-formgen-display",kv="data-display-for",Tl="data-display-bound";var Mv=["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"],Up=["B","KB","MB","GB","TB","PB"],wv=[{name:"minute",seconds:60,below:2700},{name:"hour",seconds:3600,below:79200},{name:"day",seconds:86400,below:2246400},{name:"month",seconds:2592e3,below:27648e3},{name:"year",seconds:31536e3,below:1/0}],Wp=new Map;function Sl(t){let e=t.trim();if(e==="")return null;if(/^\d{4}-\d{2}-\d{2}$/.test(e))e+="T00:00:00Z";else if(/^\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}$/.test(e))e=e.replace(" ","T")+"Z";else if(!/^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$/i.test(e))return null;let n=new Date(e);return Number.isNaN(n.getTime())?null:n}function $p(t){return t<10?`0${t}`:String(t)}function Av(t,e,n){let r=Sl(t);if(!r)return null;let i=(n.getTime()-r.getTime())/1e3,o=Math.abs(i);if(o<45)return"just now";for(let s of wv){if(o>=s.below)continue;let a=Math.max(1,Math.round(o/s.seconds)),l=a===1?s.name:`${s.name}s`;return i<0?`in ${a} ${l}`:`${a} ${l} ago`}return null}function qp(t){let e=Sl(t);return e?`${Mv[e.getUTCMonth()]} ${e.getUTCDate()}, ${e.getUTCFullYear()}`:null}function Rv(t){let e=qp(t),n=Sl(t);return!e||!n?null:`${e} ${$p(n.getUTCHours())}:${$p(n.getUTCMinutes())} UTC`}function Ov(t){if(t.trim()==="")return null;let e=Number(t);if(!Number.isFinite(e)||e<0)return null;let n=0;for(;e>=1024&&n<Up.length-1;)e/=1024,n++;return`${Math.round(e*10)/10} ${Up[n]}`}function Lv(t,e){let n=Number.parseInt(e,10),r=Number.isFinite(n)&&n>0?n:80,i=Array.from(t);return i.length<=r?t:`${i.slice(0,r).join("").replace(/ +$/,"")}\u2026`}function ar(t,e){let n=t.trim();n!==""&&Wp.set(n,e)}function Kp(t,e,n=new Date){let[r,...i]=t.trim().split(":"),o=Wp.get(r.trim());return o?o(e,i.join(":").trim(),n):null}function Nv(t,e){var i,o;let n=(i=t.getAttribute(jp))!=null?i:"",r=e.value;t.textContent=r===""?"":(o=Kp(n,r))!=null?o:r}function kl(t=document){let e=[],n=Array.from(t.querySelectorAll(`[${jp}]`));for(let r of n){if(r.hasAttribute(Tl))continue;let i=r.getAttribute(kv),o=i?r.ownerDocument.getElementById(i):null;if(!(o instanceof HTMLInputElement)&&!(o instanceof HTMLTextAreaElement)&&!(o instanceof HTMLSelectElement))continue;let s=()=>Nv(r,o);o.addEventListener("input",s),o.addEventListener("change",s),r.setAttribute(Tl,"true"),e.push(()=>{o.removeEventListener("input",s),o.removeEventListener("change",s),r.removeAttribute(Tl)})}return()=>{for(let r of e)r()}}ar("relativeDate",Av);ar("date",qp);ar("dateTime",Rv);ar("bytes",Ov);ar("truncate",Lv);var Iv="data-formgen-section-nav",Pv="data-formgen-section-link",Ml="data-section-nav-bound";function Dv(t){var r;let e=[],n=Array.from(t.querySelectorAll(`a[${Pv}]`));for(let i of n){let o=(r=i.getAttribute("href"))!=null?r:"";if(!o.startsWith("#"))continue;let s=t.ownerDocument.getElementById(decodeURIComponent(o.slice(1)));s&&e.push({link:i,section:s})}return e}function Hv(t,e=96){let n=0;return t.forEach((r,i)=>{r<=e&&(n=i)}),n}function Jp(t){let e=Hv(t.map(n=>n.section.getBoundingClientRect().top));t.forEach((n,r)=>{r===e?n.link.setAttribute("aria-current","true"):n.link.removeAttribute("aria-current")})}function wl(t=document){let e=[],n=Array.from(t.querySelectorAll(`[${Iv}]`));for(let r of n){if(r.hasAttribute(Ml))continue;let i=Dv(r),o=r.ownerDocument.defaultView;if(i.length===0||!o)continue;let s=0,a=()=>{s===0&&(s=o.requestAnimationFrame(()=>{s=0,Jp(i)}))};o.addEventListener("scroll",a,{passive:!0}),o.addEventListener("resize",a),r.setAttribute(Ml,"true"),Jp(i),e.push(()=>{o.removeEventListener("scroll",a),o.removeEventListener("resize",a),s!==0&&o.cancelAnimationFrame(s),r.removeAttribute(Ml)})}return()=>{for(let r of e)r()}}var Gp="formgen-config";function Rl(t=Uv()){var i;let e=t==null?void 0:t.getElementById(Gp),n=(i=e==null?void 0:e.textContent)==null?void 0:i.trim();if(!n)return{};let r;try{r=JSON.parse(n)}catch(o){return console.warn("[formgen] ignoring malformed #formgen-config block",o),{}}return!r||typeof r!="object"||Array.isArray(r)?{}:Fv(r)}function Ol(t,e={}){let n={...t,...e};return t.cache&&e.cache&&(n.cache={...t.cache,...e.cache}),n}function Fv(t){let e={};typeof t.baseUrl=="string"&&t.baseUrl.trim()!==""&&(e.baseUrl=t.baseUrl.trim());let n=_v(t.cache);n&&(e.cache=n);let r=Bv(t.auth);if(r&&(e.auth=r),Al(t.searchDebounceMs)&&(e.searchDebounceMs=t.searchDebounceMs),Al(t.searchThrottleMs)&&(e.searchThrottleMs=t.searchThrottleMs),Array.isArray(t.links)){let i=t.links.filter(Vv);i.length>0&&(e.links=i)}if(Array.isArray(t.hooks))for(let i of t.hooks)zv(e,i);return e}function _v(t){if(!t||typeof t!="object")return;let e=t,n={};return(e.strategy==="memory"||e.strategy==="none")&&(n.strategy=e.strategy),Al(e.ttlMs)&&e.ttlMs>0&&(n.ttlMs=e.ttlMs),Object.keys(n).length>0?n:void 0}function Bv(t){if(!t||typeof t!="object")return;let e=t,n={};(e.strategy==="header"||e.strategy==="cookie")&&(n.strategy=e.strategy);for(let r of["header","source","prefix"]){let i=e[r];typeof i=="string"&&i.trim()!==""&&(n[r]=i.trim())}return Object.keys(n).length>0?n:void 0}function zv(t,e){switch(e){case"validationError":t.onValidationError=(n,r)=>{var o,s;((s=(o=n.config.logger)==null?void 0:o.warn)!=null?s:console.warn)("[formgen] validation failed",n.field.name,r.message)};break;case"error":t.onError=(n,r)=>{var o,s;((s=(o=n.config.logger)==null?void 0:o.error)!=null?s:console.error)("[formgen] relationship fetch failed",n.field.name,r.message)};break}}function Vv(t){if(!t||typeof t!="object")return!1;let e=t;return typeof e.from=="string"&&typeof e.to=="string"}function Al(t){return typeof t=="number"&&Number.isFinite(t)&&t>=0}function Uv(){return typeof document=="undefined"?null:document}function lr(t){let{state:e,transaction:n}=t,{selection:r}=n,{doc:i}=n,{storedMarks:o}=n;return{...e,apply:e.apply.bind(e),applyTransaction:e.applyTransaction.bind(e),plugins:e.plugins,schema:e.schema,reconfigure:e.reconfigure.bind(e),toJSON:e.toJSON.bind(e),get storedMarks(){return o},get selection(){return r},get doc(){return i},get tr(){return r=n.selection,i=n.doc,o=n.storedMarks,n}}}var yn=class{constructor(e){F(this,"editor");F(this,"rawCommands");F(this,"customState");this.editor=e.editor,this.rawCommands=this.editor.extensionManager.commands,this.customState=e.state}get hasCustomState(){return!!this.customState}get state(){return this.customState||this.editor.state}get commands(){let{rawCommands:e,editor:n,state:r}=this,{view:i}=n,{tr:o}=r,s=this.buildProps(o);return Object.fromEntries(Object.entries(e).map(([a,l])=>[a,(...d)=>{let u=l(...d)(s);return!o.getMeta("preventDispatch")&&!this.hasCustomState&&i.dispatch(o),u}]))}get chain(){return()=>this.createChain()}get can(){return()=>this.createCan()}createChain(e,n=!0){let{rawCommands:r,editor:i,state:o}=this,{view:s}=i,a=[],l=!!e,c=e||o.tr,d=()=>(!l&&n&&!c.getMeta("preventDispatch")&&!this.hasCustomState&&s.dispatch(c),a.every(f=>f===!0)),u={...Object.fromEntries(Object.entries(r).map(([f,p])=>[f,(...m)=>{let g=this.buildProps(c,n),y=p(...m)(g);return a.push(y),u}])),run:d};return u}createCan(e){let{rawCommands:n,state:r}=this,i=!1,o=e||r.tr,s=this.buildProps(o,i);return{...Object.fromEntries(Object.entries(n).map(([l,c])=>[l,(...d)=>c(...d)({...s,dispatch:void 0})])),chain:()=>this.createChain(o,i)}}buildProps(e,n=!0){let{rawCommands:r,editor:i,state:o}=this,{view:s}=i,a={tr:e,editor:i,view:s,state:lr({state:o,transaction:e}),dispatch:n?()=>{}:void 0,chain:()=>this.createChain(e,n),can:()=>this.createCan(e),get commands(){return Object.fromEntries(Object.entries(r).map(([l,c])=>[l,(...d)=>c(...d)(a)]))}};return a}};function be(t){this.content=t}be.prototype={constructor:be,find:function(t){for(var e=0;e<this.content.length;e+=2)if(this.content[e]===t)return e;return-1},get:function(t){var e=this.find(t);return e==-1?void 0:this.content[e+1]},update:function(t,e,n){var r=n&&n!=t?this.remove(n):this,i=r.find(t),o=r.content.slice();return i==-1?o.push(n||t,e):(o[i+1]=e,n&&(o[i]=n)),new be(o)},remove:function(t){var e=this.find(t);if(e==-1)return this;var n=this.content.slice();return n.splice(e,2),new be(n)},addToStart:function(t,e){return new be([t,e].concat(this.remove(t).content))},addToEnd:function(t,e){var n=this.remove(t).content.slice();return n.push(t,e),new be(n)},addBefore:function(t,e,n){var r=this.remove(e),i=r.content.slice(),o=r.find(t);return i.splice(o==-1?i.length:o,0,e,n),new be(i)},forEach:function(t){for(var e=0;e<this.content.length;e+=2)t(this.content[e],this.content[e+1])},prepend:function(t){return t=be.from(t),t.size?new be(t.content.concat(this.subtract(t).content)):this},append:function(t){return t=be.from(t),t.size?new be(this.subtract(t).content.concat(t.content)):this},subtract:function(t){var e=this;t=be.from(t);for(var n=0;n<t.content.length;n+=2)e=e.remove(t.content[n]);return e},toObject:function(){var t={};return this.forEach(function(e,n){t[e]=n}),t},get size(){return this.content.length>>1}};be.from=function(t){if(t instanceof be)return t;var e=[];if(t)for(var n in t)e.push(n,t[n]);return new be(e)};var Ll=be;function oh(t,e,n){for(let r=0;;r++){if(r==t.childCount||r==e.childCount)return t.childCount==e.childCount?null:n;let i=t.child(r),o=e.child(r);if(i==o){n+=i.nodeSize;continue}if(!i.sameMarkup(o))return n;if(i.isText&&i.text!=o.text){for(let s=0;i.text[s]==o.text[s];s++)n++;return n}if(i.content.size||o.content.size){let s=oh(i.content,o.content,n+1);if(s!=null)return s}n+=i.nodeSize}}function sh(t,e,n,r){for(let i=t.childCount,o=e.childCount;;){if(i==0||o==0)return i==o?null:{a:n,b:r};let s=t.child(--i),a=e.child(--o),l=s.nodeSize;if(s==a){n-=l,r-=l;continue}if(!s.sameMarkup(a))return{a:n,b:r};if(s.isText&&s.text!=a.text){let c=0,d=Math.min(s.text.length,a.text.length);for(;c<d&&s.text[s.text.length-c-1]==a.text[a.text.length-c-1];)c++,n--,r--;return{a:n,b:r}}if(s.content.size||a.content.size){let c=sh(s.content,a.content,n-1,r-1);if(c)return c}n-=l,r-=l}}var C=class t{constructor(e,n){if(this.content=e,this.size=n||0,n==null)for(let r=0;r<e.length;r++)this.size+=e[r].nodeSize}nodesBetween(e,n,r,i=0,o){for(let s=0,a=0;a<n;s++){let l=this.content[s],c=a+l.nodeSize;if(c>e&&r(l,i+a,o||null,s)!==!1&&l.content.size){let d=a+1;l.nodesBetween(Math.max(0,e-d),Math.min(l.content.size,n-d),r,i+d)}a=c}}descendants(e){this.nodesBetween(0,this.size,e)}textBetween(e,n,r,i){let o="",s=!0;return this.nodesBetween(e,n,(a,l)=>{let c=a.isText?a.text.slice(Math.max(e,l)-l,n-l):a.isLeaf?i?typeof i=="function"?i(a):i:a.type.spec.leafText?a.type.spec.leafText(a):"":"";a.isBlock&&(a.isLeaf&&c||a.isTextblock)&&r&&(s?s=!1:o+=r),o+=c},0),o}append(e){if(!e.size)return this;if(!this.size)return e;let n=this.lastChild,r=e.firstChild,i=this.content.slice(),o=0;for(n.isText&&n.sameMarkup(r)&&(i[i.length-1]=n.withText(n.text+r.text),o=1);o<e.content.length;o++)i.push(e.content[o]);return new t(i,this.size+e.size)}cut(e,n=this.size){if(e==0&&n==this.size)return this;let r=[],i=0;if(n>e)for(let o=0,s=0;s<n;o++){let a=this.content[o],l=s+a.nodeSize;l>e&&((s<e||l>n)&&(a.isText?a=a.cut(Math.max(0,e-s),Math.min(a.text.length,n-s)):a=a.cut(Math.max(0,e-s-1),Math.min(a.content.size,n-s-1))),r.push(a),i+=a.nodeSize),s=l}return new t(r,i)}cutByIndex(e,n){return e==n?t.empty:e==0&&n==this.content.length?this:new t(this.content.slice(e,n))}replaceChild(e,n){let r=this.content[e];if(r==n)return this;let i=this.content.slice(),o=this.size+n.nodeSize-r.nodeSize;return i[e]=n,new t(i,o)}addToStart(e){return new t([e].concat(this.content),this.size+e.nodeSize)}addToEnd(e){return new t(this.content.concat(e),this.size+e.nodeSize)}eq(e){if(this.content.length!=e.content.length)return!1;for(let n=0;n<this.content.length;n++)if(!this.content[n].eq(e.content[n]))return!1;return!0}get firstChild(){return this.content.length?this.content[0]:null}get lastChild(){return this.content.length?this.content[this.content.length-1]:null}get childCount(){return this.content.length}child(e){let n=this.content[e];if(!n)throw new RangeError("Index "+e+" out of range for "+this);return n}maybeChild(e){return this.content[e]||null}forEach(e){for(let n=0,r=0;n<this.content.length;n++){let i=this.content[n];e(i,r,n),r+=i.nodeSize}}findDiffStart(e,n=0){return oh(this,e,n)}findDiffEnd(e,n=this.size,r=e.size){return sh(this,e,n,r)}findIndex(e){if(e==0)return Ho(0,e);if(e==this.size)return Ho(this.content.length,e);if(e>this.size||e<0)throw new RangeError(`Position ${e} outside of fragment (${this})`);for(let n=0,r=0;;n++){let i=this.child(n),o=r+i.nodeSize;if(o>=e)return o==e?Ho(n+1,o):Ho(n,r);r=o}}toString(){return"<"+this.toStringInner()+">"}toStringInner(){return this.content.join(", ")}toJSON(){return this.content.length?this.content.map(e=>e.toJSON()):null}static fromJSON(e,n){if(!n)return t.empty;if(!Array.isArray(n))throw new RangeError("Invalid input for Fragment.fromJSON");return new t(n.map(e.nodeFromJSON))}static fromArray(e){if(!e.length)return t.empty;let n,r=0;for(let i=0;i<e.length;i++){let o=e[i];r+=o.nodeSize,i&&o.isText&&e[i-1].sameMarkup(o)?(n||(n=e.slice(0,i)),n[n.length-1]=o.withText(n[n.length-1].text+o.text)):n&&n.push(o)}return new t(n||e,r)}static from(e){if(!e)return t.empty;if(e instanceof t)return e;if(Array.isArray(e))return this.fromArray(e);if(e.attrs)return new t([e],e.nodeSize);throw new RangeError("Can not convert "+e+" to a Fragment"+(e.nodesBetween?" (looks like multiple versions of prosemirror-model were loaded)":""))}};C.empty=new C([],0);var Nl={index:0,offset:0};function Ho(t,e){return Nl.index=t,Nl.offset=e,Nl}function _o(t,e){if(t===e)return!0;if(!(t&&typeof t=="object")||!(e&&typeof e=="object"))return!1;let n=Array.isArray(t);if(Array.isArray(e)!=n)return!1;if(n){if(t.length!=e.length)return!1;for(let r=0;r<t.length;r++)if(!_o(t[r],e[r]))return!1}else{for(let r in t)if(!(r in e)||!_o(t[r],e[r]))return!1;for(let r in e)if(!(r in t))return!1}return!0}var q=class t{constructor(e,n){this.type=e,this.attrs=n}addToSet(e){let n,r=!1;for(let i=0;i<e.length;i++){let o=e[i];if(this.eq(o))return e;if(this.type.excludes(o.type))n||(n=e.slice(0,i));else{if(o.type.excludes(this.type))return e;!r&&o.type.rank>this.type.rank&&(n||(n=e.slice(0,i)),n.push(this),r=!0),n&&n.push(o)}}return n||(n=e.slice()),r||n.push(this),n}removeFromSet(e){for(let n=0;n<e.length;n++)if(this.eq(e[n]))return e.slice(0,n).concat(e.slice(n+1));return e}isInSet(e){for(let n=0;n<e.length;n++)if(this.eq(e[n]))return!0;return!1}eq(e){return this==e||this.type==e.type&&_o(this.attrs,e.attrs)}toJSON(){let e={type:this.type.name};for(let n in this.attrs){e.attrs=this.attrs;break}return e}static fromJSON(e,n){if(!n)throw new RangeError("Invalid input for Mark.fromJSON");let r=e.marks[n.type];if(!r)throw new RangeError(`There is no mark type ${n.type} in this schema`);let i=r.create(n.attrs);return r.checkAttrs(i.attrs),i}static sameSet(e,n){if(e==n)return!0;if(e.length!=n.length)return!1;for(let r=0;r<e.length;r++)if(!e[r].eq(n[r]))return!1;return!0}static setFrom(e){if(!e||Array.isArray(e)&&e.length==0)return t.none;if(e instanceof t)return[e];let n=e.slice();return n.sort((r,i)=>r.type.rank-i.type.rank),n}};q.none=[];var xn=class extends Error{},w=class t{constructor(e,n,r){this.content=e,this.openStart=n,this.openEnd=r}get size(){return this.content.size-this.openStart-this.openEnd}insertAt(e,n){let r=lh(this.content,e+this.openStart,n);return r&&new t(r,this.openStart,this.openEnd)}removeBetween(e,n){return new t(ah(this.content,e+this.openStart,n+this.openStart),this.openStart,this.openEnd)}eq(e){return this.content.eq(e.content)&&this.openStart==e.openStart&&this.openEnd==e.openEnd}toString(){return this.content+"("+this.openStart+","+this.openEnd+")"}toJSON(){if(!this.content.size)return null;let e={content:this.content.toJSON()};return this.openStart>0&&(e.openStart=this.openStart),this.openEnd>0&&(e.openEnd=this.openEnd),e}static fromJSON(e,n){if(!n)return t.empty;let r=n.openStart||0,i=n.openEnd||0;if(typeof r!="number"||typeof i!="number")throw new RangeError("Invalid input for Slice.fromJSON");return new t(C.fromJSON(e,n.content),r,i)}static maxOpen(e,n=!0){let r=0,i=0;for(let o=e.firstChild;o&&!o.isLeaf&&(n||!o.type.spec.isolating);o=o.firstChild)r++;for(let o=e.lastChild;o&&!o.isLeaf&&(n||!o.type.spec.isolating);o=o.lastChild)i++;return new t(e,r,i)}};w.empty=new w(C.empty,0,0);function ah(t,e,n){let{index:r,offset:i}=t.findIndex(e),o=t.maybeChild(r),{index:s,offset:a}=t.findIndex(n);if(i==e||o.isText){if(a!=n&&!t.child(s).isText)throw new RangeError("Removing non-flat range");return t.cut(0,e).append(t.cut(n))}if(r!=s)throw new RangeError("Removing non-flat range");return t.replaceChild(r,o.copy(ah(o.content,e-i-1,n-i-1)))}function lh(t,e,n,r){let{index:i,offset:o}=t.findIndex(e),s=t.maybeChild(i);if(o==e||s.isText)return r&&!r.canReplace(i,i,n)?null:t.cut(0,e).append(n).append(t.cut(e));let a=lh(s.content,e-o-1,n,s);return a&&t.replaceChild(i,s.copy(a))}function $v(t,e,n){if(n.openStart>t.depth)throw new xn("Inserted content deeper than insertion position");if(t.depth-n.openStart!=e.depth-n.openEnd)throw new xn("Inconsistent open depths");return ch(t,e,n,0)}function ch(t,e,n,r){let i=t.index(r),o=t.node(r);if(i==e.index(r)&&r<t.depth-n.openStart){let s=ch(t,e,n,r+1);return o.copy(o.content.replaceChild(i,s))}else if(n.content.size)if(!n.openStart&&!n.openEnd&&t.depth==r&&e.depth==r){let s=t.parent,a=s.content;return En(s,a.cut(0,t.parentOffset).append(n.content).append(a.cut(e.parentOffset)))}else{let{start:s,end:a}=jv(n,t);return En(o,uh(t,s,a,e,r))}else return En(o,Bo(t,e,r))}function dh(t,e){if(!e.type.compatibleContent(t.type))throw new xn("Cannot join "+e.type.name+" onto "+t.type.name)}function Pl(t,e,n){let r=t.node(n);return dh(r,e.node(n)),r}function bn(t,e){let n=e.length-1;n>=0&&t.isText&&t.sameMarkup(e[n])?e[n]=t.withText(e[n].text+t.text):e.push(t)}function ni(t,e,n,r){let i=(e||t).node(n),o=0,s=e?e.index(n):i.childCount;t&&(o=t.index(n),t.depth>n?o++:t.textOffset&&(bn(t.nodeAfter,r),o++));for(let a=o;a<s;a++)bn(i.child(a),r);e&&e.depth==n&&e.textOffset&&bn(e.nodeBefore,r)}function En(t,e){return t.type.checkContent(e),t.copy(e)}function uh(t,e,n,r,i){let o=t.depth>i&&Pl(t,e,i+1),s=r.depth>i&&Pl(n,r,i+1),a=[];return ni(null,t,i,a),o&&s&&e.index(i)==n.index(i)?(dh(o,s),bn(En(o,uh(t,e,n,r,i+1)),a)):(o&&bn(En(o,Bo(t,e,i+1)),a),ni(e,n,i,a),s&&bn(En(s,Bo(n,r,i+1)),a)),ni(r,null,i,a),new C(a)}function Bo(t,e,n){let r=[];if(ni(null,t,n,r),t.depth>n){let i=Pl(t,e,n+1);bn(En(i,Bo(t,e,n+1)),r)}return ni(e,null,n,r),new C(r)}function jv(t,e){let n=e.depth-t.openStart,i=e.node(n).copy(t.content);for(let o=n-1;o>=0;o--)i=e.node(o).copy(C.from(i));return{start:i.resolveNoCache(t.openStart+n),end:i.resolveNoCache(i.content.size-t.openEnd-n)}}var zo=class t{constructor(e,n,r){this.pos=e,this.path=n,this.parentOffset=r,this.depth=n.length/3-1}resolveDepth(e){return e==null?this.depth:e<0?this.depth+e:e}get parent(){return this.node(this.depth)}get doc(){return this.node(0)}node(e){return this.path[this.resolveDepth(e)*3]}index(e){return this.path[this.resolveDepth(e)*3+1]}indexAfter(e){return e=this.resolveDepth(e),this.index(e)+(e==this.depth&&!this.textOffset?0:1)}start(e){return e=this.resolveDepth(e),e==0?0:this.path[e*3-1]+1}end(e){return e=this.resolveDepth(e),this.start(e)+this.node(e).content.size}before(e){if(e=this.resolveDepth(e),!e)throw new RangeError("There is no position before the top-level node");return e==this.depth+1?this.pos:this.path[e*3-1]}after(e){if(e=this.resolveDepth(e),!e)throw new RangeError("There is no position after the top-level node");return e==this.depth+1?this.pos:this.path[e*3-1]+this.path[e*3].nodeSize}get textOffset(){return this.pos-this.path[this.path.length-1]}get nodeAfter(){let e=this.parent,n=this.index(this.depth);if(n==e.childCount)return null;let r=this.pos-this.path[this.path.length-1],i=e.child(n);return r?e.child(n).cut(r):i}get nodeBefore(){let e=this.index(this.depth),n=this.pos-this.path[this.path.length-1];return n?this.parent.child(e).cut(0,n):e==0?null:this.parent.child(e-1)}posAtIndex(e,n){n=this.resolveDepth(n);let r=this.path[n*3],i=n==0?0:this.path[n*3-1]+1;for(let o=0;o<e;o++)i+=r.child(o).nodeSize;return i}marks(){let e=this.parent,n=this.index();if(e.content.size==0)return q.none;if(this.textOffset)return e.child(n).marks;let r=e.maybeChild(n-1),i=e.maybeChild(n);if(!r){let a=r;r=i,i=a}let o=r.marks;for(var s=0;s<o.length;s++)o[s].type.spec.inclusive===!1&&(!i||!o[s].isInSet(i.marks))&&(o=o[s--].removeFromSet(o));return o}marksAcross(e){let n=this.parent.maybeChild(this.index());if(!n||!n.isInline)return null;let r=n.marks,i=e.parent.maybeChild(e.index());for(var o=0;o<r.length;o++)r[o].type.spec.inclusive===!1&&(!i||!r[o].isInSet(i.marks))&&(r=r[o--].removeFromSet(r));return r}sharedDepth(e){for(let n=this.depth;n>0;n--)if(this.start(n)<=e&&this.end(n)>=e)return n;return 0}blockRange(e=this,n){if(e.pos<this.pos)return e.blockRange(this);for(let r=this.depth-(this.parent.inlineContent||this.pos==e.pos?1:0);r>=0;r--)if(e.pos<=this.end(r)&&(!n||n(this.node(r))))return new Cn(this,e,r);return null}sameParent(e){return this.pos-this.parentOffset==e.pos-e.parentOffset}max(e){return e.pos>this.pos?e:this}min(e){return e.pos<this.pos?e:this}toString(){let e="";for(let n=1;n<=this.depth;n++)e+=(e?"/":"")+this.node(n).type.name+"_"+this.index(n-1);return e+":"+this.parentOffset}static resolve(e,n){if(!(n>=0&&n<=e.content.size))throw new RangeError("Position "+n+" out of range");let r=[],i=0,o=n;for(let s=e;;){let{index:a,offset:l}=s.content.findIndex(o),c=o-l;if(r.push(s,a,i+l),!c||(s=s.child(a),s.isText))break;o=c-1,i+=l+1}return new t(n,r,o)}static resolveCached(e,n){let r=Yp.get(e);if(r)for(let o=0;o<r.elts.length;o++){let s=r.elts[o];if(s.pos==n)return s}else Yp.set(e,r=new Dl);let i=r.elts[r.i]=t.resolve(e,n);return r.i=(r.i+1)%Wv,i}},Dl=class{constructor(){this.elts=[],this.i=0}},Wv=12,Yp=new WeakMap,Cn=class{constructor(e,n,r){this.$from=e,this.$to=n,this.depth=r}get start(){return this.$from.before(this.depth+1)}get end(){return this.$to.after(this.depth+1)}get parent(){return this.$from.node(this.depth)}get startIndex(){return this.$from.index(this.depth)}get endIndex(){return this.$to.indexAfter(this.depth)}},qv=Object.create(null),Ve=class t{constructor(e,n,r,i=q.none){this.type=e,this.attrs=n,this.marks=i,this.content=r||C.empty}get children(){return this.content.content}get nodeSize(){return this.isLeaf?1:2+this.content.size}get childCount(){return this.content.childCount}child(e){return this.content.child(e)}maybeChild(e){return this.content.maybeChild(e)}forEach(e){this.content.forEach(e)}nodesBetween(e,n,r,i=0){this.content.nodesBetween(e,n,r,i,this)}descendants(e){this.nodesBetween(0,this.content.size,e)}get textContent(){return this.isLeaf&&this.type.spec.leafText?this.type.spec.leafText(this):this.textBetween(0,this.content.size,"")}textBetween(e,n,r,i){return this.content.textBetween(e,n,r,i)}get firstChild(){return this.content.firstChild}get lastChild(){return this.content.lastChild}eq(e){return this==e||this.sameMarkup(e)&&this.content.eq(e.content)}sameMarkup(e){return this.hasMarkup(e.type,e.attrs,e.marks)}hasMarkup(e,n,r){return this.type==e&&_o(this.attrs,n||e.defaultAttrs||qv)&&q.sameSet(this.marks,r||q.none)}copy(e=null){return e==this.content?this:new t(this.type,this.attrs,e,this.marks)}mark(e){return e==this.marks?this:new t(this.type,this.attrs,this.content,e)}cut(e,n=this.content.size){return e==0&&n==this.content.size?this:this.copy(this.content.cut(e,n))}slice(e,n=this.content.size,r=!1){if(e==n)return w.empty;let i=this.resolve(e),o=this.resolve(n),s=r?0:i.sharedDepth(n),a=i.start(s),c=i.node(s).content.cut(i.pos-a,o.pos-a);return new w(c,i.depth-s,o.depth-s)}replace(e,n,r){return $v(this.resolve(e),this.resolve(n),r)}nodeAt(e){for(let n=this;;){let{index:r,offset:i}=n.content.findIndex(e);if(n=n.maybeChild(r),!n)return null;if(i==e||n.isText)return n;e-=i+1}}childAfter(e){let{index:n,offset:r}=this.content.findIndex(e);return{node:this.content.maybeChild(n),index:n,offset:r}}childBefore(e){if(e==0)return{node:null,index:0,offset:0};let{index:n,offset:r}=this.content.findIndex(e);if(r<e)return{node:this.content.child(n),index:n,offset:r};let i=this.content.child(n-1);return{node:i,index:n-1,offset:r-i.nodeSize}}resolve(e){return zo.resolveCached(this,e)}resolveNoCache(e){return zo.resolve(this,e)}rangeHasMark(e,n,r){let i=!1;return n>e&&this.nodesBetween(e,n,o=>(r.isInSet(o.marks)&&(i=!0),!i)),i}get isBlock(){return this.type.isBlock}get isTextblock(){return this.type.isTextblock}get inlineContent(){return this.type.inlineContent}get isInline(){return this.type.isInline}get isText(){return this.type.isText}get isLeaf(){return this.type.isLeaf}get isAtom(){return this.type.isAtom}toString(){if(this.type.spec.toDebugString)return this.type.spec.toDebugString(this);let e=this.type.name;return this.content.size&&(e+="("+this.content.toStringInner()+")"),fh(this.marks,e)}contentMatchAt(e){let n=this.type.contentMatch.matchFragment(this.content,0,e);if(!n)throw new Error("Called contentMatchAt on a node with invalid content");return n}canReplace(e,n,r=C.empty,i=0,o=r.childCount){let s=this.contentMatchAt(e).matchFragment(r,i,o),a=s&&s.matchFragment(this.content,n);if(!a||!a.validEnd)return!1;for(let l=i;l<o;l++)if(!this.type.allowsMarks(r.child(l).marks))return!1;return!0}canReplaceWith(e,n,r,i){if(i&&!this.type.allowsMarks(i))return!1;let o=this.contentMatchAt(e).matchType(r),s=o&&o.matchFragment(this.content,n);return s?s.validEnd:!1}canAppend(e){return e.content.size?this.canReplace(this.childCount,this.childCount,e.content):this.type.compatibleContent(e.type)}check(){this.type.checkContent(this.content),this.type.checkAttrs(this.attrs);let e=q.none;for(let n=0;n<this.marks.length;n++){let r=this.marks[n];r.type.checkAttrs(r.attrs),e=r.addToSet(e)}if(!q.sameSet(e,this.marks))throw new RangeError(`Invalid collection of marks for node ${this.type.name}: ${this.marks.map(n=>n.type.name)}`);this.content.forEach(n=>n.check())}toJSON(){let e={type:this.type.name};for(let n in this.attrs){e.attrs=this.attrs;break}return this.content.size&&(e.content=this.content.toJSON()),this.marks.length&&(e.marks=this.marks.map(n=>n.toJSON())),e}static fromJSON(e,n){if(!n)throw new RangeError("Invalid input for Node.fromJSON");let r;if(n.marks){if(!Array.isArray(n.marks))throw new RangeError("Invalid mark data for Node.fromJSON");r=n.marks.map(e.markFromJSON)}if(n.type=="text"){if(typeof n.text!="string")throw new RangeError("Invalid text node in JSON");return e.text(n.text,r)}let i=C.fromJSON(e,n.content),o=e.nodeType(n.type).create(n.attrs,i,r);return o.type.checkAttrs(o.attrs),o}};Ve.prototype.text=void 0;var Hl=class t extends Ve{constructor(e,n,r,i){if(super(e,n,null,i),!r)throw new RangeError("Empty text nodes are not allowed");this.text=r}toString(){return this.type.spec.toDebugString?this.type.spec.toDebugString(this):fh(this.marks,JSON.stringify(this.text))}get textContent(){return this.text}textBetween(e,n){return this.text.slice(e,n)}get nodeSize(){return this.text.length}mark(e){return e==this.marks?this:new t(this.type,this.attrs,this.text,e)}withText(e){return e==this.text?this:new t(this.type,this.attrs,e,this.marks)}cut(e=0,n=this.text.length){return e==0&&n==this.text.length?this:this.withText(this.text.slice(e,n))}eq(e){return this.sameMarkup(e)&&this.text==e.text}toJSON(){let e=super.toJSON();return e.text=this.text,e}};function fh(t,e){for(let n=t.length-1;n>=0;n--)e=t[n].type.name+"("+e+")";return e}var vn=class t{constructor(e){this.validEnd=e,this.next=[],this.wrapCache=[]}static parse(e,n){let r=new Fl(e,n);if(r.next==null)return t.empty;let i=ph(r);r.next&&r.err("Unexpected trailing text");let o=Zv(Qv(i));return eT(o,r),o}matchType(e){for(let n=0;n<this.next.length;n++)if(this.next[n].type==e)return this.next[n].next;return null}matchFragment(e,n=0,r=e.childCount){let i=this;for(let o=n;i&&o<r;o++)i=i.matchType(e.child(o).type);return i}get inlineContent(){return this.next.length!=0&&this.next[0].type.isInline}get defaultType(){for(let e=0;e<this.next.length;e++){let{type:n}=this.next[e];if(!(n.isText||n.hasRequiredAttrs()))return n}return null}compatible(e){for(let n=0;n<this.next.length;n++)for(let r=0;r<e.next.length;r++)if(this.next[n].type==e.next[r].type)return!0;return!1}fillBefore(e,n=!1,r=0){let i=[this];function o(s,a){let l=s.matchFragment(e,r);if(l&&(!n||l.validEnd))return C.from(a.map(c=>c.createAndFill()));for(let c=0;c<s.next.length;c++){let{type:d,next:u}=s.next[c];if(!(d.isText||d.hasRequiredAttrs())&&i.indexOf(u)==-1){i.push(u);let f=o(u,a.concat(d));if(f)return f}}return null}return o(this,[])}findWrapping(e){for(let r=0;r<this.wrapCache.length;r+=2)if(this.wrapCache[r]==e)return this.wrapCache[r+1];let n=this.computeWrapping(e);return this.wrapCache.push(e,n),n}computeWrapping(e){let n=Object.create(null),r=[{match:this,type:null,via:null}];for(;r.length;){let i=r.shift(),o=i.match;if(o.matchType(e)){let s=[];for(let a=i;a.type;a=a.via)s.push(a.type);return s.reverse()}for(let s=0;s<o.next.length;s++){let{type:a,next:l}=o.next[s];!a.isLeaf&&!a.hasRequiredAttrs()&&!(a.name in n)&&(!i.type||l.validEnd)&&(r.push({match:a.contentMatch,type:a,via:i}),n[a.name]=!0)}}return null}get edgeCount(){return this.next.length}edge(e){if(e>=this.next.length)throw new RangeError(`There's no ${e}th edge in this content match`);return this.next[e]}toString(){let e=[];function n(r){e.push(r);for(let i=0;i<r.next.length;i++)e.indexOf(r.next[i].next)==-1&&n(r.next[i].next)}return n(this),e.map((r,i)=>{let o=i+(r.validEnd?"*":" ")+" ";for(let s=0;s<r.next.length;s++)o+=(s?", ":"")+r.next[s].type.name+"->"+e.indexOf(r.next[s].next);return o}).join(`
+`)}};vn.empty=new vn(!0);var Fl=class{constructor(e,n){this.string=e,this.nodeTypes=n,this.inline=null,this.pos=0,this.tokens=e.split(/\s*(?=\b|\W|$)/),this.tokens[this.tokens.length-1]==""&&this.tokens.pop(),this.tokens[0]==""&&this.tokens.shift()}get next(){return this.tokens[this.pos]}eat(e){return this.next==e&&(this.pos++||!0)}err(e){throw new SyntaxError(e+" (in content expression '"+this.string+"')")}};function ph(t){let e=[];do e.push(Kv(t));while(t.eat("|"));return e.length==1?e[0]:{type:"choice",exprs:e}}function Kv(t){let e=[];do e.push(Jv(t));while(t.next&&t.next!=")"&&t.next!="|");return e.length==1?e[0]:{type:"seq",exprs:e}}function Jv(t){let e=Xv(t);for(;;)if(t.eat("+"))e={type:"plus",expr:e};else if(t.eat("*"))e={type:"star",expr:e};else if(t.eat("?"))e={type:"opt",expr:e};else if(t.eat("{"))e=Gv(t,e);else break;return e}function Xp(t){/\D/.test(t.next)&&t.err("Expected number, got '"+t.next+"'");let e=Number(t.next);return t.pos++,e}function Gv(t,e){let n=Xp(t),r=n;return t.eat(",")&&(t.next!="}"?r=Xp(t):r=-1),t.eat("}")||t.err("Unclosed braced range"),{type:"range",min:n,max:r,expr:e}}function Yv(t,e){let n=t.nodeTypes,r=n[e];if(r)return[r];let i=[];for(let o in n){let s=n[o];s.isInGroup(e)&&i.push(s)}return i.length==0&&t.err("No node type or group '"+e+"' found"),i}function Xv(t){if(t.eat("(")){let e=ph(t);return t.eat(")")||t.err("Missing closing paren"),e}else if(/\W/.test(t.next))t.err("Unexpected token '"+t.next+"'");else{let e=Yv(t,t.next).map(n=>(t.inline==null?t.inline=n.isInline:t.inline!=n.isInline&&t.err("Mixing inline and block content"),{type:"name",value:n}));return t.pos++,e.length==1?e[0]:{type:"choice",exprs:e}}}function Qv(t){let e=[[]];return i(o(t,0),n()),e;function n(){return e.push([])-1}function r(s,a,l){let c={term:l,to:a};return e[s].push(c),c}function i(s,a){s.forEach(l=>l.to=a)}function o(s,a){if(s.type=="choice")return s.exprs.reduce((l,c)=>l.concat(o(c,a)),[]);if(s.type=="seq")for(let l=0;;l++){let c=o(s.exprs[l],a);if(l==s.exprs.length-1)return c;i(c,a=n())}else if(s.type=="star"){let l=n();return r(a,l),i(o(s.expr,l),l),[r(l)]}else if(s.type=="plus"){let l=n();return i(o(s.expr,a),l),i(o(s.expr,l),l),[r(l)]}else{if(s.type=="opt")return[r(a)].concat(o(s.expr,a));if(s.type=="range"){let l=a;for(let c=0;c<s.min;c++){let d=n();i(o(s.expr,l),d),l=d}if(s.max==-1)i(o(s.expr,l),l);else for(let c=s.min;c<s.max;c++){let d=n();r(l,d),i(o(s.expr,l),d),l=d}return[r(l)]}else{if(s.type=="name")return[r(a,void 0,s.value)];throw new Error("Unknown expr type")}}}}function hh(t,e){return e-t}function Qp(t,e){let n=[];return r(e),n.sort(hh);function r(i){let o=t[i];if(o.length==1&&!o[0].term)return r(o[0].to);n.push(i);for(let s=0;s<o.length;s++){let{term:a,to:l}=o[s];!a&&n.indexOf(l)==-1&&r(l)}}}function Zv(t){let e=Object.create(null);return n(Qp(t,0));function n(r){let i=[];r.forEach(s=>{t[s].forEach(({term:a,to:l})=>{if(!a)return;let c;for(let d=0;d<i.length;d++)i[d][0]==a&&(c=i[d][1]);Qp(t,l).forEach(d=>{c||i.push([a,c=[]]),c.indexOf(d)==-1&&c.push(d)})})});let o=e[r.join(",")]=new vn(r.indexOf(t.length-1)>-1);for(let s=0;s<i.length;s++){let a=i[s][1].sort(hh);o.next.push({type:i[s][0],next:e[a.join(",")]||n(a)})}return o}}function eT(t,e){for(let n=0,r=[t];n<r.length;n++){let i=r[n],o=!i.validEnd,s=[];for(let a=0;a<i.next.length;a++){let{type:l,next:c}=i.next[a];s.push(l.name),o&&!(l.isText||l.hasRequiredAttrs())&&(o=!1),r.indexOf(c)==-1&&r.push(c)}o&&e.err("Only non-generatable nodes ("+s.join(", ")+") in a required position (see https://prosemirror.net/docs/guide/#generatable)")}}function mh(t){let e=Object.create(null);for(let n in t){let r=t[n];if(!r.hasDefault)return null;e[n]=r.default}return e}function gh(t,e){let n=Object.create(null);for(let r in t){let i=e&&e[r];if(i===void 0){let o=t[r];if(o.hasDefault)i=o.default;else throw new RangeError("No value supplied for attribute "+r)}n[r]=i}return n}function yh(t,e,n,r){for(let i in e)if(!(i in t))throw new RangeError(`Unsupported attribute ${i} for ${n} of type ${i}`);for(let i in t){let o=t[i];o.validate&&o.validate(e[i])}}function bh(t,e){let n=Object.create(null);if(e)for(let r in e)n[r]=new _l(t,r,e[r]);return n}var Vo=class t{constructor(e,n,r){this.name=e,this.schema=n,this.spec=r,this.markSet=null,this.groups=r.group?r.group.split(" "):[],this.attrs=bh(e,r.attrs),this.defaultAttrs=mh(this.attrs),this.contentMatch=null,this.inlineContent=null,this.isBlock=!(r.inline||e=="text"),this.isText=e=="text"}get isInline(){return!this.isBlock}get isTextblock(){return this.isBlock&&this.inlineContent}get isLeaf(){return this.contentMatch==vn.empty}get isAtom(){return this.isLeaf||!!this.spec.atom}isInGroup(e){return this.groups.indexOf(e)>-1}get whitespace(){return this.spec.whitespace||(this.spec.code?"pre":"normal")}hasRequiredAttrs(){for(let e in this.attrs)if(this.attrs[e].isRequired)return!0;return!1}compatibleContent(e){return this==e||this.contentMatch.compatible(e.contentMatch)}computeAttrs(e){return!e&&this.defaultAttrs?this.defaultAttrs:gh(this.attrs,e)}create(e=null,n,r){if(this.isText)throw new Error("NodeType.create can't construct text nodes");return new Ve(this,this.computeAttrs(e),C.from(n),q.setFrom(r))}createChecked(e=null,n,r){return n=C.from(n),this.checkContent(n),new Ve(this,this.computeAttrs(e),n,q.setFrom(r))}createAndFill(e=null,n,r){if(e=this.computeAttrs(e),n=C.from(n),n.size){let s=this.contentMatch.fillBefore(n);if(!s)return null;n=s.append(n)}let i=this.contentMatch.matchFragment(n),o=i&&i.fillBefore(C.empty,!0);return o?new Ve(this,e,n.append(o),q.setFrom(r)):null}validContent(e){let n=this.contentMatch.matchFragment(e);if(!n||!n.validEnd)return!1;for(let r=0;r<e.childCount;r++)if(!this.allowsMarks(e.child(r).marks))return!1;return!0}checkContent(e){if(!this.validContent(e))throw new RangeError(`Invalid content for node ${this.name}: ${e.toString().slice(0,50)}`)}checkAttrs(e){yh(this.attrs,e,"node",this.name)}allowsMarkType(e){return this.markSet==null||this.markSet.indexOf(e)>-1}allowsMarks(e){if(this.markSet==null)return!0;for(let n=0;n<e.length;n++)if(!this.allowsMarkType(e[n].type))return!1;return!0}allowedMarks(e){if(this.markSet==null)return e;let n;for(let r=0;r<e.length;r++)this.allowsMarkType(e[r].type)?n&&n.push(e[r]):n||(n=e.slice(0,r));return n?n.length?n:q.none:e}static compile(e,n){let r=Object.create(null);e.forEach((o,s)=>r[o]=new t(o,n,s));let i=n.spec.topNode||"doc";if(!r[i])throw new RangeError("Schema is missing its top node type ('"+i+"')");if(!r.text)throw new RangeError("Every schema needs a 'text' type");for(let o in r.text.attrs)throw new RangeError("The text node type should not have attributes");return r}};function tT(t,e,n){let r=n.split("|");return i=>{let o=i===null?"null":typeof i;if(r.indexOf(o)<0)throw new RangeError(`Expected value of type ${r} for attribute ${e} on type ${t}, got ${o}`)}}var _l=class{constructor(e,n,r){this.hasDefault=Object.prototype.hasOwnProperty.call(r,"default"),this.default=r.default,this.validate=typeof r.validate=="string"?tT(e,n,r.validate):r.validate}get isRequired(){return!this.hasDefault}},ii=class t{constructor(e,n,r,i){this.name=e,this.rank=n,this.schema=r,this.spec=i,this.attrs=bh(e,i.attrs),this.excluded=null;let o=mh(this.attrs);this.instance=o?new q(this,o):null}create(e=null){return!e&&this.instance?this.instance:new q(this,gh(this.attrs,e))}static compile(e,n){let r=Object.create(null),i=0;return e.forEach((o,s)=>r[o]=new t(o,i++,n,s)),r}removeFromSet(e){for(var n=0;n<e.length;n++)e[n].type==this&&(e=e.slice(0,n).concat(e.slice(n+1)),n--);return e}isInSet(e){for(let n=0;n<e.length;n++)if(e[n].type==this)return e[n]}checkAttrs(e){yh(this.attrs,e,"mark",this.name)}excludes(e){return this.excluded.indexOf(e)>-1}},oi=class{constructor(e){this.linebreakReplacement=null,this.cached=Object.create(null);let n=this.spec={};for(let i in e)n[i]=e[i];n.nodes=Ll.from(e.nodes),n.marks=Ll.from(e.marks||{}),this.nodes=Vo.compile(this.spec.nodes,this),this.marks=ii.compile(this.spec.marks,this);let r=Object.create(null);for(let i in this.nodes){if(i in this.marks)throw new RangeError(i+" can not be both a node and a mark");let o=this.nodes[i],s=o.spec.content||"",a=o.spec.marks;if(o.contentMatch=r[s]||(r[s]=vn.parse(s,this.nodes)),o.inlineContent=o.contentMatch.inlineContent,o.spec.linebreakReplacement){if(this.linebreakReplacement)throw new RangeError("Multiple linebreak nodes defined");if(!o.isInline||!o.isLeaf)throw new RangeError("Linebreak replacement nodes must be inline leaf nodes");this.linebreakReplacement=o}o.markSet=a=="_"?null:a?Zp(this,a.split(" ")):a==""||!o.inlineContent?[]:null}for(let i in this.marks){let o=this.marks[i],s=o.spec.excludes;o.excluded=s==null?[o]:s==""?[]:Zp(this,s.split(" "))}this.nodeFromJSON=i=>Ve.fromJSON(this,i),this.markFromJSON=i=>q.fromJSON(this,i),this.topNodeType=this.nodes[this.spec.topNode||"doc"],this.cached.wrappings=Object.create(null)}node(e,n=null,r,i){if(typeof e=="string")e=this.nodeType(e);else if(e instanceof Vo){if(e.schema!=this)throw new RangeError("Node type from different schema used ("+e.name+")")}else throw new RangeError("Invalid node type: "+e);return e.createChecked(n,r,i)}text(e,n){let r=this.nodes.text;return new Hl(r,r.defaultAttrs,e,q.setFrom(n))}mark(e,n){return typeof e=="string"&&(e=this.marks[e]),e.create(n)}nodeType(e){let n=this.nodes[e];if(!n)throw new RangeError("Unknown node type: "+e);return n}};function Zp(t,e){let n=[];for(let r=0;r<e.length;r++){let i=e[r],o=t.marks[i],s=o;if(o)n.push(o);else for(let a in t.marks){let l=t.marks[a];(i=="_"||l.spec.group&&l.spec.group.split(" ").indexOf(i)>-1)&&n.push(s=l)}if(!s)throw new SyntaxError("Unknown mark type: '"+e[r]+"'")}return n}function nT(t){return t.tag!=null}function rT(t){return t.style!=null}var mt=class t{constructor(e,n){this.schema=e,this.rules=n,this.tags=[],this.styles=[];let r=this.matchedStyles=[];n.forEach(i=>{if(nT(i))this.tags.push(i);else if(rT(i)){let o=/[^=]*/.exec(i.style)[0];r.indexOf(o)<0&&r.push(o),this.styles.push(i)}}),this.normalizeLists=!this.tags.some(i=>{if(!/^(ul|ol)\b/.test(i.tag)||!i.node)return!1;let o=e.nodes[i.node];return o.contentMatch.matchType(o)})}parse(e,n={}){let r=new Uo(this,n,!1);return r.addAll(e,q.none,n.from,n.to),r.finish()}parseSlice(e,n={}){let r=new Uo(this,n,!0);return r.addAll(e,q.none,n.from,n.to),w.maxOpen(r.finish())}matchTag(e,n,r){for(let i=r?this.tags.indexOf(r)+1:0;i<this.tags.length;i++){let o=this.tags[i];if(sT(e,o.tag)&&(o.namespace===void 0||e.namespaceURI==o.namespace)&&(!o.context||n.matchesContext(o.context))){if(o.getAttrs){let s=o.getAttrs(e);if(s===!1)continue;o.attrs=s||void 0}return o}}}matchStyle(e,n,r,i){for(let o=i?this.styles.indexOf(i)+1:0;o<this.styles.length;o++){let s=this.styles[o],a=s.style;if(!(a.indexOf(e)!=0||s.context&&!r.matchesContext(s.context)||a.length>e.length&&(a.charCodeAt(e.length)!=61||a.slice(e.length+1)!=n))){if(s.getAttrs){let l=s.getAttrs(n);if(l===!1)continue;s.attrs=l||void 0}return s}}}static schemaRules(e){let n=[];function r(i){let o=i.priority==null?50:i.priority,s=0;for(;s<n.length;s++){let a=n[s];if((a.priority==null?50:a.priority)<o)break}n.splice(s,0,i)}for(let i in e.marks){let o=e.marks[i].spec.parseDOM;o&&o.forEach(s=>{r(s=th(s)),s.mark||s.ignore||s.clearMark||(s.mark=i)})}for(let i in e.nodes){let o=e.nodes[i].spec.parseDOM;o&&o.forEach(s=>{r(s=th(s)),s.node||s.ignore||s.mark||(s.node=i)})}return n}static fromSchema(e){return e.cached.domParser||(e.cached.domParser=new t(e,t.schemaRules(e)))}},Eh={address:!0,article:!0,aside:!0,blockquote:!0,canvas:!0,dd:!0,div:!0,dl:!0,fieldset:!0,figcaption:!0,figure:!0,footer:!0,form:!0,h1:!0,h2:!0,h3:!0,h4:!0,h5:!0,h6:!0,header:!0,hgroup:!0,hr:!0,li:!0,noscript:!0,ol:!0,output:!0,p:!0,pre:!0,section:!0,table:!0,tfoot:!0,ul:!0},iT={head:!0,noscript:!0,object:!0,script:!0,style:!0,title:!0},xh={ol:!0,ul:!0},si=1,Bl=2,ri=4;function eh(t,e,n){return e!=null?(e?si:0)|(e==="full"?Bl:0):t&&t.whitespace=="pre"?si|Bl:n&~ri}var cr=class{constructor(e,n,r,i,o,s){this.type=e,this.attrs=n,this.marks=r,this.solid=i,this.options=s,this.content=[],this.activeMarks=q.none,this.match=o||(s&ri?null:e.contentMatch)}findWrapping(e){if(!this.match){if(!this.type)return[];let n=this.type.contentMatch.fillBefore(C.from(e));if(n)this.match=this.type.contentMatch.matchFragment(n);else{let r=this.type.contentMatch,i;return(i=r.findWrapping(e.type))?(this.match=r,i):null}}return this.match.findWrapping(e.type)}finish(e){if(!(this.options&si)){let r=this.content[this.content.length-1],i;if(r&&r.isText&&(i=/[ \t\r\n\u000c]+$/.exec(r.text))){let o=r;r.text.length==i[0].length?this.content.pop():this.content[this.content.length-1]=o.withText(o.text.slice(0,o.text.length-i[0].length))}}let n=C.from(this.content);return!e&&this.match&&(n=n.append(this.match.fillBefore(C.empty,!0))),this.type?this.type.create(this.attrs,n,this.marks):n}inlineContext(e){return this.type?this.type.inlineContent:this.content.length?this.content[0].isInline:e.parentNode&&!Eh.hasOwnProperty(e.parentNode.nodeName.toLowerCase())}},Uo=class{constructor(e,n,r){this.parser=e,this.options=n,this.isOpen=r,this.open=0,this.localPreserveWS=!1;let i=n.topNode,o,s=eh(null,n.preserveWhitespace,0)|(r?ri:0);i?o=new cr(i.type,i.attrs,q.none,!0,n.topMatch||i.type.contentMatch,s):r?o=new cr(null,null,q.none,!0,null,s):o=new cr(e.schema.topNodeType,null,q.none,!0,null,s),this.nodes=[o],this.find=n.findPositions,this.needsBlock=!1}get top(){return this.nodes[this.open]}addDOM(e,n){e.nodeType==3?this.addTextNode(e,n):e.nodeType==1&&this.addElement(e,n)}addTextNode(e,n){let r=e.nodeValue,i=this.top,o=i.options&Bl?"full":this.localPreserveWS||(i.options&si)>0,{schema:s}=this.parser;if(o==="full"||i.inlineContext(e)||/[^ \t\r\n\u000c]/.test(r)){if(o)if(o==="full")r=r.replace(/\r\n?/g,`
+`);else if(s.linebreakReplacement&&/[\r\n]/.test(r)&&this.top.findWrapping(s.linebreakReplacement.create())){let a=r.split(/\r?\n|\r/);for(let l=0;l<a.length;l++)l&&this.insertNode(s.linebreakReplacement.create(),n,!0),a[l]&&this.insertNode(s.text(a[l]),n,!/\S/.test(a[l]));r=""}else r=r.replace(/\r?\n|\r/g," ");else if(r=r.replace(/[ \t\r\n\u000c]+/g," "),/^[ \t\r\n\u000c]/.test(r)&&this.open==this.nodes.length-1){let a=i.content[i.content.length-1],l=e.previousSibling;(!a||l&&l.nodeName=="BR"||a.isText&&/[ \t\r\n\u000c]$/.test(a.text))&&(r=r.slice(1))}r&&this.insertNode(s.text(r),n,!/\S/.test(r)),this.findInText(e)}else this.findInside(e)}addElement(e,n,r){let i=this.localPreserveWS,o=this.top;(e.tagName=="PRE"||/pre/.test(e.style&&e.style.whiteSpace))&&(this.localPreserveWS=!0);let s=e.nodeName.toLowerCase(),a;xh.hasOwnProperty(s)&&this.parser.normalizeLists&&oT(e);let l=this.options.ruleFromNode&&this.options.ruleFromNode(e)||(a=this.parser.matchTag(e,this,r));e:if(l?l.ignore:iT.hasOwnProperty(s))this.findInside(e),this.ignoreFallback(e,n);else if(!l||l.skip||l.closeParent){l&&l.closeParent?this.open=Math.max(0,this.open-1):l&&l.skip.nodeType&&(e=l.skip);let c,d=this.needsBlock;if(Eh.hasOwnProperty(s))o.content.length&&o.content[0].isInline&&this.open&&(this.open--,o=this.top),c=!0,o.type||(this.needsBlock=!0);else if(!e.firstChild){this.leafFallback(e,n);break e}let u=l&&l.skip?n:this.readStyles(e,n);u&&this.addAll(e,u),c&&this.sync(o),this.needsBlock=d}else{let c=this.readStyles(e,n);c&&this.addElementByRule(e,l,c,l.consuming===!1?a:void 0)}this.localPreserveWS=i}leafFallback(e,n){e.nodeName=="BR"&&this.top.type&&this.top.type.inlineContent&&this.addTextNode(e.ownerDocument.createTextNode(`
+`),n)}ignoreFallback(e,n){e.nodeName=="BR"&&(!this.top.type||!this.top.type.inlineContent)&&this.findPlace(this.parser.schema.text("-"),n,!0)}readStyles(e,n){let r=e.style;if(r&&r.length)for(let i=0;i<this.parser.matchedStyles.length;i++){let o=this.parser.matchedStyles[i],s=r.getPropertyValue(o);if(s)for(let a=void 0;;){let l=this.parser.matchStyle(o,s,this,a);if(!l)break;if(l.ignore)return null;if(l.clearMark?n=n.filter(c=>!l.clearMark(c)):n=n.concat(this.parser.schema.marks[l.mark].create(l.attrs)),l.consuming===!1)a=l;else break}}return n}addElementByRule(e,n,r,i){let o,s;if(n.node)if(s=this.parser.schema.nodes[n.node],s.isLeaf)this.insertNode(s.create(n.attrs),r,e.nodeName=="BR")||this.leafFallback(e,r);else{let l=this.enter(s,n.attrs||null,r,n.preserveWhitespace);l&&(o=!0,r=l)}else{let l=this.parser.schema.marks[n.mark];r=r.concat(l.create(n.attrs))}let a=this.top;if(s&&s.isLeaf)this.findInside(e);else if(i)this.addElement(e,r,i);else if(n.getContent)this.findInside(e),n.getContent(e,this.parser.schema).forEach(l=>this.insertNode(l,r,!1));else{let l=e;typeof n.contentElement=="string"?l=e.querySelector(n.contentElement):typeof n.contentElement=="function"?l=n.contentElement(e):n.contentElement&&(l=n.contentElement),this.findAround(e,l,!0),this.addAll(l,r),this.findAround(e,l,!1)}o&&this.sync(a)&&this.open--}addAll(e,n,r,i){let o=r||0;for(let s=r?e.childNodes[r]:e.firstChild,a=i==null?null:e.childNodes[i];s!=a;s=s.nextSibling,++o)this.findAtPoint(e,o),this.addDOM(s,n);this.findAtPoint(e,o)}findPlace(e,n,r){let i,o;for(let s=this.open,a=0;s>=0;s--){let l=this.nodes[s],c=l.findWrapping(e);if(c&&(!i||i.length>c.length+a)&&(i=c,o=l,!c.length))break;if(l.solid){if(r)break;a+=2}}if(!i)return null;this.sync(o);for(let s=0;s<i.length;s++)n=this.enterInner(i[s],null,n,!1);return n}insertNode(e,n,r){if(e.isInline&&this.needsBlock&&!this.top.type){let o=this.textblockFromContext();o&&(n=this.enterInner(o,null,n))}let i=this.findPlace(e,n,r);if(i){this.closeExtra();let o=this.top;o.match&&(o.match=o.match.matchType(e.type));let s=q.none;for(let a of i.concat(e.marks))(o.type?o.type.allowsMarkType(a.type):nh(a.type,e.type))&&(s=a.addToSet(s));return o.content.push(e.mark(s)),!0}return!1}enter(e,n,r,i){let o=this.findPlace(e.create(n),r,!1);return o&&(o=this.enterInner(e,n,r,!0,i)),o}enterInner(e,n,r,i=!1,o){this.closeExtra();let s=this.top;s.match=s.match&&s.match.matchType(e);let a=eh(e,o,s.options);s.options&ri&&s.content.length==0&&(a|=ri);let l=q.none;return r=r.filter(c=>(s.type?s.type.allowsMarkType(c.type):nh(c.type,e))?(l=c.addToSet(l),!1):!0),this.nodes.push(new cr(e,n,l,i,null,a)),this.open++,r}closeExtra(e=!1){let n=this.nodes.length-1;if(n>this.open){for(;n>this.open;n--)this.nodes[n-1].content.push(this.nodes[n].finish(e));this.nodes.length=this.open+1}}finish(){return this.open=0,this.closeExtra(this.isOpen),this.nodes[0].finish(!!(this.isOpen||this.options.topOpen))}sync(e){for(let n=this.open;n>=0;n--){if(this.nodes[n]==e)return this.open=n,!0;this.localPreserveWS&&(this.nodes[n].options|=si)}return!1}get currentPos(){this.closeExtra();let e=0;for(let n=this.open;n>=0;n--){let r=this.nodes[n].content;for(let i=r.length-1;i>=0;i--)e+=r[i].nodeSize;n&&e++}return e}findAtPoint(e,n){if(this.find)for(let r=0;r<this.find.length;r++)this.find[r].node==e&&this.find[r].offset==n&&(this.find[r].pos=this.currentPos)}findInside(e){if(this.find)for(let n=0;n<this.find.length;n++)this.find[n].pos==null&&e.nodeType==1&&e.contains(this.find[n].node)&&(this.find[n].pos=this.currentPos)}findAround(e,n,r){if(e!=n&&this.find)for(let i=0;i<this.find.length;i++)this.find[i].pos==null&&e.nodeType==1&&e.contains(this.find[i].node)&&n.compareDocumentPosition(this.find[i].node)&(r?2:4)&&(this.find[i].pos=this.currentPos)}findInText(e){if(this.find)for(let n=0;n<this.find.length;n++)this.find[n].node==e&&(this.find[n].pos=this.currentPos-(e.nodeValue.length-this.find[n].offset))}matchesContext(e){if(e.indexOf("|")>-1)return e.split(/\s*\|\s*/).some(this.matchesContext,this);let n=e.split("/"),r=this.options.context,i=!this.isOpen&&(!r||r.parent.type==this.nodes[0].type),o=-(r?r.depth+1:0)+(i?0:1),s=(a,l)=>{for(;a>=0;a--){let c=n[a];if(c==""){if(a==n.length-1||a==0)continue;for(;l>=o;l--)if(s(a-1,l))return!0;return!1}else{let d=l>0||l==0&&i?this.nodes[l].type:r&&l>=o?r.node(l-o).type:null;if(!d||d.name!=c&&!d.isInGroup(c))return!1;l--}}return!0};return s(n.length-1,this.open)}textblockFromContext(){let e=this.options.context;if(e)for(let n=e.depth;n>=0;n--){let r=e.node(n).contentMatchAt(e.indexAfter(n)).defaultType;if(r&&r.isTextblock&&r.defaultAttrs)return r}for(let n in this.parser.schema.nodes){let r=this.parser.schema.nodes[n];if(r.isTextblock&&r.defaultAttrs)return r}}};function oT(t){for(let e=t.firstChild,n=null;e;e=e.nextSibling){let r=e.nodeType==1?e.nodeName.toLowerCase():null;r&&xh.hasOwnProperty(r)&&n?(n.appendChild(e),e=n):r=="li"?n=e:r&&(n=null)}}function sT(t,e){return(t.matches||t.msMatchesSelector||t.webkitMatchesSelector||t.mozMatchesSelector).call(t,e)}function th(t){let e={};for(let n in t)e[n]=t[n];return e}function nh(t,e){let n=e.schema.nodes;for(let r in n){let i=n[r];if(!i.allowsMarkType(t))continue;let o=[],s=a=>{o.push(a);for(let l=0;l<a.edgeCount;l++){let{type:c,next:d}=a.edge(l);if(c==e||o.indexOf(d)<0&&s(d))return!0}};if(s(i.contentMatch))return!0}}var Jt=class t{constructor(e,n){this.nodes=e,this.marks=n}serializeFragment(e,n={},r){r||(r=Il(n).createDocumentFragment());let i=r,o=[];return e.forEach(s=>{if(o.length||s.marks.length){let a=0,l=0;for(;a<o.length&&l<s.marks.length;){let c=s.marks[l];if(!this.marks[c.type.name]){l++;continue}if(!c.eq(o[a][0])||c.type.spec.spanning===!1)break;a++,l++}for(;a<o.length;)i=o.pop()[1];for(;l<s.marks.length;){let c=s.marks[l++],d=this.serializeMark(c,s.isInline,n);d&&(o.push([c,i]),i.appendChild(d.dom),i=d.contentDOM||d.dom)}}i.appendChild(this.serializeNodeInner(s,n))}),r}serializeNodeInner(e,n){let{dom:r,contentDOM:i}=Fo(Il(n),this.nodes[e.type.name](e),null,e.attrs);if(i){if(e.isLeaf)throw new RangeError("Content hole not allowed in a leaf node spec");this.serializeFragment(e.content,n,i)}return r}serializeNode(e,n={}){let r=this.serializeNodeInner(e,n);for(let i=e.marks.length-1;i>=0;i--){let o=this.serializeMark(e.marks[i],e.isInline,n);o&&((o.contentDOM||o.dom).appendChild(r),r=o.dom)}return r}serializeMark(e,n,r={}){let i=this.marks[e.type.name];return i&&Fo(Il(r),i(e,n),null,e.attrs)}static renderSpec(e,n,r=null,i){return Fo(e,n,r,i)}static fromSchema(e){return e.cached.domSerializer||(e.cached.domSerializer=new t(this.nodesFromSchema(e),this.marksFromSchema(e)))}static nodesFromSchema(e){let n=rh(e.nodes);return n.text||(n.text=r=>r.text),n}static marksFromSchema(e){return rh(e.marks)}};function rh(t){let e={};for(let n in t){let r=t[n].spec.toDOM;r&&(e[n]=r)}return e}function Il(t){return t.document||window.document}var ih=new WeakMap;function aT(t){let e=ih.get(t);return e===void 0&&ih.set(t,e=lT(t)),e}function lT(t){let e=null;function n(r){if(r&&typeof r=="object")if(Array.isArray(r))if(typeof r[0]=="string")e||(e=[]),e.push(r);else for(let i=0;i<r.length;i++)n(r[i]);else for(let i in r)n(r[i])}return n(t),e}function Fo(t,e,n,r){if(typeof e=="string")return{dom:t.createTextNode(e)};if(e.nodeType!=null)return{dom:e};if(e.dom&&e.dom.nodeType!=null)return e;let i=e[0],o;if(typeof i!="string")throw new RangeError("Invalid array passed to renderSpec");if(r&&(o=aT(r))&&o.indexOf(e)>-1)throw new RangeError("Using an array from an attribute object as a DOM spec. This may be an attempted cross site scripting attack.");let s=i.indexOf(" ");s>0&&(n=i.slice(0,s),i=i.slice(s+1));let a,l=n?t.createElementNS(n,i):t.createElement(i),c=e[1],d=1;if(c&&typeof c=="object"&&c.nodeType==null&&!Array.isArray(c)){d=2;for(let u in c)if(c[u]!=null){let f=u.indexOf(" ");f>0?l.setAttributeNS(u.slice(0,f),u.slice(f+1),c[u]):u=="style"&&l.style?l.style.cssText=c[u]:l.setAttribute(u,c[u])}}for(let u=d;u<e.length;u++){let f=e[u];if(f===0){if(u<e.length-1||u>d)throw new RangeError("Content hole must be the only child of its parent node");return{dom:l,contentDOM:l}}else{let{dom:p,contentDOM:h}=Fo(t,f,n,r);if(l.appendChild(p),h){if(a)throw new RangeError("Multiple content holes");a=h}}}return{dom:l,contentDOM:a}}var Th=65535,Sh=Math.pow(2,16);function cT(t,e){return t+e*Sh}function Ch(t){return t&Th}function dT(t){return(t-(t&Th))/Sh}var kh=1,Mh=2,$o=4,wh=8,ci=class{constructor(e,n,r){this.pos=e,this.delInfo=n,this.recover=r}get deleted(){return(this.delInfo&wh)>0}get deletedBefore(){return(this.delInfo&(kh|$o))>0}get deletedAfter(){return(this.delInfo&(Mh|$o))>0}get deletedAcross(){return(this.delInfo&$o)>0}},Mt=class t{constructor(e,n=!1){if(this.ranges=e,this.inverted=n,!e.length&&t.empty)return t.empty}recover(e){let n=0,r=Ch(e);if(!this.inverted)for(let i=0;i<r;i++)n+=this.ranges[i*3+2]-this.ranges[i*3+1];return this.ranges[r*3]+n+dT(e)}mapResult(e,n=1){return this._map(e,n,!1)}map(e,n=1){return this._map(e,n,!0)}_map(e,n,r){let i=0,o=this.inverted?2:1,s=this.inverted?1:2;for(let a=0;a<this.ranges.length;a+=3){let l=this.ranges[a]-(this.inverted?i:0);if(l>e)break;let c=this.ranges[a+o],d=this.ranges[a+s],u=l+c;if(e<=u){let f=c?e==l?-1:e==u?1:n:n,p=l+i+(f<0?0:d);if(r)return p;let h=e==(n<0?l:u)?null:cT(a/3,e-l),m=e==l?Mh:e==u?kh:$o;return(n<0?e!=l:e!=u)&&(m|=wh),new ci(p,m,h)}i+=d-c}return r?e+i:new ci(e+i,0,null)}touches(e,n){let r=0,i=Ch(n),o=this.inverted?2:1,s=this.inverted?1:2;for(let a=0;a<this.ranges.length;a+=3){let l=this.ranges[a]-(this.inverted?r:0);if(l>e)break;let c=this.ranges[a+o],d=l+c;if(e<=d&&a==i*3)return!0;r+=this.ranges[a+s]-c}return!1}forEach(e){let n=this.inverted?2:1,r=this.inverted?1:2;for(let i=0,o=0;i<this.ranges.length;i+=3){let s=this.ranges[i],a=s-(this.inverted?o:0),l=s+(this.inverted?0:o),c=this.ranges[i+n],d=this.ranges[i+r];e(a,a+c,l,l+d),o+=d-c}}invert(){return new t(this.ranges,!this.inverted)}toString(){return(this.inverted?"-":"")+JSON.stringify(this.ranges)}static offset(e){return e==0?t.empty:new t(e<0?[0,-e,0]:[0,0,e])}};Mt.empty=new Mt([]);var di=class t{constructor(e,n,r=0,i=e?e.length:0){this.mirror=n,this.from=r,this.to=i,this._maps=e||[],this.ownData=!(e||n)}get maps(){return this._maps}slice(e=0,n=this.maps.length){return new t(this._maps,this.mirror,e,n)}appendMap(e,n){this.ownData||(this._maps=this._maps.slice(),this.mirror=this.mirror&&this.mirror.slice(),this.ownData=!0),this.to=this._maps.push(e),n!=null&&this.setMirror(this._maps.length-1,n)}appendMapping(e){for(let n=0,r=this._maps.length;n<e._maps.length;n++){let i=e.getMirror(n);this.appendMap(e._maps[n],i!=null&&i<n?r+i:void 0)}}getMirror(e){if(this.mirror){for(let n=0;n<this.mirror.length;n++)if(this.mirror[n]==e)return this.mirror[n+(n%2?-1:1)]}}setMirror(e,n){this.mirror||(this.mirror=[]),this.mirror.push(e,n)}appendMappingInverted(e){for(let n=e.maps.length-1,r=this._maps.length+e._maps.length;n>=0;n--){let i=e.getMirror(n);this.appendMap(e._maps[n].invert(),i!=null&&i>n?r-i-1:void 0)}}invert(){let e=new t;return e.appendMappingInverted(this),e}map(e,n=1){if(this.mirror)return this._map(e,n,!0);for(let r=this.from;r<this.to;r++)e=this._maps[r].map(e,n);return e}mapResult(e,n=1){return this._map(e,n,!1)}_map(e,n,r){let i=0;for(let o=this.from;o<this.to;o++){let s=this._maps[o],a=s.mapResult(e,n);if(a.recover!=null){let l=this.getMirror(o);if(l!=null&&l>o&&l<this.to){o=l,e=this._maps[l].recover(a.recover);continue}}i|=a.delInfo,e=a.pos}return r?e:new ci(e,i,null)}},zl=Object.create(null),pe=class{getMap(){return Mt.empty}merge(e){return null}static fromJSON(e,n){if(!n||!n.stepType)throw new RangeError("Invalid input for Step.fromJSON");let r=zl[n.stepType];if(!r)throw new RangeError(`No step type ${n.stepType} defined`);return r.fromJSON(e,n)}static jsonID(e,n){if(e in zl)throw new RangeError("Duplicate use of step JSON ID "+e);return zl[e]=n,n.prototype.jsonID=e,n}},he=class t{constructor(e,n){this.doc=e,this.failed=n}static ok(e){return new t(e,null)}static fail(e){return new t(null,e)}static fromReplace(e,n,r,i){try{return t.ok(e.replace(n,r,i))}catch(o){if(o instanceof xn)return t.fail(o.message);throw o}}};function Wl(t,e,n){let r=[];for(let i=0;i<t.childCount;i++){let o=t.child(i);o.content.size&&(o=o.copy(Wl(o.content,e,o))),o.isInline&&(o=e(o,n,i)),r.push(o)}return C.fromArray(r)}var ui=class t extends pe{constructor(e,n,r){super(),this.from=e,this.to=n,this.mark=r}apply(e){let n=e.slice(this.from,this.to),r=e.resolve(this.from),i=r.node(r.sharedDepth(this.to)),o=new w(Wl(n.content,(s,a)=>!s.isAtom||!a.type.allowsMarkType(this.mark.type)?s:s.mark(this.mark.addToSet(s.marks)),i),n.openStart,n.openEnd);return he.fromReplace(e,this.from,this.to,o)}invert(){return new Tn(this.from,this.to,this.mark)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deleted&&r.deleted||n.pos>=r.pos?null:new t(n.pos,r.pos,this.mark)}merge(e){return e instanceof t&&e.mark.eq(this.mark)&&this.from<=e.to&&this.to>=e.from?new t(Math.min(this.from,e.from),Math.max(this.to,e.to),this.mark):null}toJSON(){return{stepType:"addMark",mark:this.mark.toJSON(),from:this.from,to:this.to}}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for AddMarkStep.fromJSON");return new t(n.from,n.to,e.markFromJSON(n.mark))}};pe.jsonID("addMark",ui);var Tn=class t extends pe{constructor(e,n,r){super(),this.from=e,this.to=n,this.mark=r}apply(e){let n=e.slice(this.from,this.to),r=new w(Wl(n.content,i=>i.mark(this.mark.removeFromSet(i.marks)),e),n.openStart,n.openEnd);return he.fromReplace(e,this.from,this.to,r)}invert(){return new ui(this.from,this.to,this.mark)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deleted&&r.deleted||n.pos>=r.pos?null:new t(n.pos,r.pos,this.mark)}merge(e){return e instanceof t&&e.mark.eq(this.mark)&&this.from<=e.to&&this.to>=e.from?new t(Math.min(this.from,e.from),Math.max(this.to,e.to),this.mark):null}toJSON(){return{stepType:"removeMark",mark:this.mark.toJSON(),from:this.from,to:this.to}}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for RemoveMarkStep.fromJSON");return new t(n.from,n.to,e.markFromJSON(n.mark))}};pe.jsonID("removeMark",Tn);var fi=class t extends pe{constructor(e,n){super(),this.pos=e,this.mark=n}apply(e){let n=e.nodeAt(this.pos);if(!n)return he.fail("No node at mark step's position");let r=n.type.create(n.attrs,null,this.mark.addToSet(n.marks));return he.fromReplace(e,this.pos,this.pos+1,new w(C.from(r),0,n.isLeaf?0:1))}invert(e){let n=e.nodeAt(this.pos);if(n){let r=this.mark.addToSet(n.marks);if(r.length==n.marks.length){for(let i=0;i<n.marks.length;i++)if(!n.marks[i].isInSet(r))return new t(this.pos,n.marks[i]);return new t(this.pos,this.mark)}}return new dr(this.pos,this.mark)}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.mark)}toJSON(){return{stepType:"addNodeMark",pos:this.pos,mark:this.mark.toJSON()}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for AddNodeMarkStep.fromJSON");return new t(n.pos,e.markFromJSON(n.mark))}};pe.jsonID("addNodeMark",fi);var dr=class t extends pe{constructor(e,n){super(),this.pos=e,this.mark=n}apply(e){let n=e.nodeAt(this.pos);if(!n)return he.fail("No node at mark step's position");let r=n.type.create(n.attrs,null,this.mark.removeFromSet(n.marks));return he.fromReplace(e,this.pos,this.pos+1,new w(C.from(r),0,n.isLeaf?0:1))}invert(e){let n=e.nodeAt(this.pos);return!n||!this.mark.isInSet(n.marks)?this:new fi(this.pos,this.mark)}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.mark)}toJSON(){return{stepType:"removeNodeMark",pos:this.pos,mark:this.mark.toJSON()}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for RemoveNodeMarkStep.fromJSON");return new t(n.pos,e.markFromJSON(n.mark))}};pe.jsonID("removeNodeMark",dr);var Ee=class t extends pe{constructor(e,n,r,i=!1){super(),this.from=e,this.to=n,this.slice=r,this.structure=i}apply(e){return this.structure&&$l(e,this.from,this.to)?he.fail("Structure replace would overwrite content"):he.fromReplace(e,this.from,this.to,this.slice)}getMap(){return new Mt([this.from,this.to-this.from,this.slice.size])}invert(e){return new t(this.from,this.from+this.slice.size,e.slice(this.from,this.to))}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1);return n.deletedAcross&&r.deletedAcross?null:new t(n.pos,Math.max(n.pos,r.pos),this.slice,this.structure)}merge(e){if(!(e instanceof t)||e.structure||this.structure)return null;if(this.from+this.slice.size==e.from&&!this.slice.openEnd&&!e.slice.openStart){let n=this.slice.size+e.slice.size==0?w.empty:new w(this.slice.content.append(e.slice.content),this.slice.openStart,e.slice.openEnd);return new t(this.from,this.to+(e.to-e.from),n,this.structure)}else if(e.to==this.from&&!this.slice.openStart&&!e.slice.openEnd){let n=this.slice.size+e.slice.size==0?w.empty:new w(e.slice.content.append(this.slice.content),e.slice.openStart,this.slice.openEnd);return new t(e.from,this.to,n,this.structure)}else return null}toJSON(){let e={stepType:"replace",from:this.from,to:this.to};return this.slice.size&&(e.slice=this.slice.toJSON()),this.structure&&(e.structure=!0),e}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number")throw new RangeError("Invalid input for ReplaceStep.fromJSON");return new t(n.from,n.to,w.fromJSON(e,n.slice),!!n.structure)}};pe.jsonID("replace",Ee);var le=class t extends pe{constructor(e,n,r,i,o,s,a=!1){super(),this.from=e,this.to=n,this.gapFrom=r,this.gapTo=i,this.slice=o,this.insert=s,this.structure=a}apply(e){if(this.structure&&($l(e,this.from,this.gapFrom)||$l(e,this.gapTo,this.to)))return he.fail("Structure gap-replace would overwrite content");let n=e.slice(this.gapFrom,this.gapTo);if(n.openStart||n.openEnd)return he.fail("Gap is not a flat range");let r=this.slice.insertAt(this.insert,n.content);return r?he.fromReplace(e,this.from,this.to,r):he.fail("Content does not fit in gap")}getMap(){return new Mt([this.from,this.gapFrom-this.from,this.insert,this.gapTo,this.to-this.gapTo,this.slice.size-this.insert])}invert(e){let n=this.gapTo-this.gapFrom;return new t(this.from,this.from+this.slice.size+n,this.from+this.insert,this.from+this.insert+n,e.slice(this.from,this.to).removeBetween(this.gapFrom-this.from,this.gapTo-this.from),this.gapFrom-this.from,this.structure)}map(e){let n=e.mapResult(this.from,1),r=e.mapResult(this.to,-1),i=this.from==this.gapFrom?n.pos:e.map(this.gapFrom,-1),o=this.to==this.gapTo?r.pos:e.map(this.gapTo,1);return n.deletedAcross&&r.deletedAcross||i<n.pos||o>r.pos?null:new t(n.pos,r.pos,i,o,this.slice,this.insert,this.structure)}toJSON(){let e={stepType:"replaceAround",from:this.from,to:this.to,gapFrom:this.gapFrom,gapTo:this.gapTo,insert:this.insert};return this.slice.size&&(e.slice=this.slice.toJSON()),this.structure&&(e.structure=!0),e}static fromJSON(e,n){if(typeof n.from!="number"||typeof n.to!="number"||typeof n.gapFrom!="number"||typeof n.gapTo!="number"||typeof n.insert!="number")throw new RangeError("Invalid input for ReplaceAroundStep.fromJSON");return new t(n.from,n.to,n.gapFrom,n.gapTo,w.fromJSON(e,n.slice),n.insert,!!n.structure)}};pe.jsonID("replaceAround",le);function $l(t,e,n){let r=t.resolve(e),i=n-e,o=r.depth;for(;i>0&&o>0&&r.indexAfter(o)==r.node(o).childCount;)o--,i--;if(i>0){let s=r.node(o).maybeChild(r.indexAfter(o));for(;i>0;){if(!s||s.isLeaf)return!0;s=s.firstChild,i--}}return!1}function uT(t,e,n,r){let i=[],o=[],s,a;t.doc.nodesBetween(e,n,(l,c,d)=>{if(!l.isInline)return;let u=l.marks;if(!r.isInSet(u)&&d.type.allowsMarkType(r.type)){let f=Math.max(c,e),p=Math.min(c+l.nodeSize,n),h=r.addToSet(u);for(let m=0;m<u.length;m++)u[m].isInSet(h)||(s&&s.to==f&&s.mark.eq(u[m])?s.to=p:i.push(s=new Tn(f,p,u[m])));a&&a.to==f?a.to=p:o.push(a=new ui(f,p,r))}}),i.forEach(l=>t.step(l)),o.forEach(l=>t.step(l))}function fT(t,e,n,r){let i=[],o=0;t.doc.nodesBetween(e,n,(s,a)=>{if(!s.isInline)return;o++;let l=null;if(r instanceof ii){let c=s.marks,d;for(;d=r.isInSet(c);)(l||(l=[])).push(d),c=d.removeFromSet(c)}else r?r.isInSet(s.marks)&&(l=[r]):l=s.marks;if(l&&l.length){let c=Math.min(a+s.nodeSize,n);for(let d=0;d<l.length;d++){let u=l[d],f;for(let p=0;p<i.length;p++){let h=i[p];h.step==o-1&&u.eq(i[p].style)&&(f=h)}f?(f.to=c,f.step=o):i.push({style:u,from:Math.max(a,e),to:c,step:o})}}}),i.forEach(s=>t.step(new Tn(s.from,s.to,s.style)))}function ql(t,e,n,r=n.contentMatch,i=!0){let o=t.doc.nodeAt(e),s=[],a=e+1;for(let l=0;l<o.childCount;l++){let c=o.child(l),d=a+c.nodeSize,u=r.matchType(c.type);if(!u)s.push(new Ee(a,d,w.empty));else{r=u;for(let f=0;f<c.marks.length;f++)n.allowsMarkType(c.marks[f].type)||t.step(new Tn(a,d,c.marks[f]));if(i&&c.isText&&n.whitespace!="pre"){let f,p=/\r?\n|\r/g,h;for(;f=p.exec(c.text);)h||(h=new w(C.from(n.schema.text(" ",n.allowedMarks(c.marks))),0,0)),s.push(new Ee(a+f.index,a+f.index+f[0].length,h))}}a=d}if(!r.validEnd){let l=r.fillBefore(C.empty,!0);t.replace(a,a,new w(l,0,0))}for(let l=s.length-1;l>=0;l--)t.step(s[l])}function pT(t,e,n){return(e==0||t.canReplace(e,t.childCount))&&(n==t.childCount||t.canReplace(0,n))}function wt(t){let n=t.parent.content.cutByIndex(t.startIndex,t.endIndex);for(let r=t.depth;;--r){let i=t.$from.node(r),o=t.$from.index(r),s=t.$to.indexAfter(r);if(r<t.depth&&i.canReplace(o,s,n))return r;if(r==0||i.type.spec.isolating||!pT(i,o,s))break}return null}function hT(t,e,n){let{$from:r,$to:i,depth:o}=e,s=r.before(o+1),a=i.after(o+1),l=s,c=a,d=C.empty,u=0;for(let h=o,m=!1;h>n;h--)m||r.index(h)>0?(m=!0,d=C.from(r.node(h).copy(d)),u++):l--;let f=C.empty,p=0;for(let h=o,m=!1;h>n;h--)m||i.after(h+1)<i.end(h)?(m=!0,f=C.from(i.node(h).copy(f)),p++):c++;t.step(new le(l,c,s,a,new w(d.append(f),u,p),d.size-u,!0))}function fr(t,e,n=null,r=t){let i=mT(t,e),o=i&&gT(r,e);return o?i.map(vh).concat({type:e,attrs:n}).concat(o.map(vh)):null}function vh(t){return{type:t,attrs:null}}function mT(t,e){let{parent:n,startIndex:r,endIndex:i}=t,o=n.contentMatchAt(r).findWrapping(e);if(!o)return null;let s=o.length?o[0]:e;return n.canReplaceWith(r,i,s)?o:null}function gT(t,e){let{parent:n,startIndex:r,endIndex:i}=t,o=n.child(r),s=e.contentMatch.findWrapping(o.type);if(!s)return null;let l=(s.length?s[s.length-1]:e).contentMatch;for(let c=r;l&&c<i;c++)l=l.matchType(n.child(c).type);return!l||!l.validEnd?null:s}function yT(t,e,n){let r=C.empty;for(let s=n.length-1;s>=0;s--){if(r.size){let a=n[s].type.contentMatch.matchFragment(r);if(!a||!a.validEnd)throw new RangeError("Wrapper type given to Transform.wrap does not form valid content of its parent wrapper")}r=C.from(n[s].type.create(n[s].attrs,r))}let i=e.start,o=e.end;t.step(new le(i,o,i,o,new w(r,0,0),n.length,!0))}function bT(t,e,n,r,i){if(!r.isTextblock)throw new RangeError("Type given to setBlockType should be a textblock");let o=t.steps.length;t.doc.nodesBetween(e,n,(s,a)=>{let l=typeof i=="function"?i(s):i;if(s.isTextblock&&!s.hasMarkup(r,l)&&ET(t.doc,t.mapping.slice(o).map(a),r)){let c=null;if(r.schema.linebreakReplacement){let p=r.whitespace=="pre",h=!!r.contentMatch.matchType(r.schema.linebreakReplacement);p&&!h?c=!1:!p&&h&&(c=!0)}c===!1&&Rh(t,s,a,o),ql(t,t.mapping.slice(o).map(a,1),r,void 0,c===null);let d=t.mapping.slice(o),u=d.map(a,1),f=d.map(a+s.nodeSize,1);return t.step(new le(u,f,u+1,f-1,new w(C.from(r.create(l,null,s.marks)),0,0),1,!0)),c===!0&&Ah(t,s,a,o),!1}})}function Ah(t,e,n,r){e.forEach((i,o)=>{if(i.isText){let s,a=/\r?\n|\r/g;for(;s=a.exec(i.text);){let l=t.mapping.slice(r).map(n+1+o+s.index);t.replaceWith(l,l+1,e.type.schema.linebreakReplacement.create())}}})}function Rh(t,e,n,r){e.forEach((i,o)=>{if(i.type==i.type.schema.linebreakReplacement){let s=t.mapping.slice(r).map(n+1+o);t.replaceWith(s,s+1,e.type.schema.text(`
+`))}})}function ET(t,e,n){let r=t.resolve(e),i=r.index();return r.parent.canReplaceWith(i,i+1,n)}function xT(t,e,n,r,i){let o=t.doc.nodeAt(e);if(!o)throw new RangeError("No node at given position");n||(n=o.type);let s=n.create(r,null,i||o.marks);if(o.isLeaf)return t.replaceWith(e,e+o.nodeSize,s);if(!n.validContent(o.content))throw new RangeError("Invalid content for node type "+n.name);t.step(new le(e,e+o.nodeSize,e+1,e+o.nodeSize-1,new w(C.from(s),0,0),1,!0))}function Ue(t,e,n=1,r){let i=t.resolve(e),o=i.depth-n,s=r&&r[r.length-1]||i.parent;if(o<0||i.parent.type.spec.isolating||!i.parent.canReplace(i.index(),i.parent.childCount)||!s.type.validContent(i.parent.content.cutByIndex(i.index(),i.parent.childCount)))return!1;for(let c=i.depth-1,d=n-2;c>o;c--,d--){let u=i.node(c),f=i.index(c);if(u.type.spec.isolating)return!1;let p=u.content.cutByIndex(f,u.childCount),h=r&&r[d+1];h&&(p=p.replaceChild(0,h.type.create(h.attrs)));let m=r&&r[d]||u;if(!u.canReplace(f+1,u.childCount)||!m.type.validContent(p))return!1}let a=i.indexAfter(o),l=r&&r[0];return i.node(o).canReplaceWith(a,a,l?l.type:i.node(o+1).type)}function CT(t,e,n=1,r){let i=t.doc.resolve(e),o=C.empty,s=C.empty;for(let a=i.depth,l=i.depth-n,c=n-1;a>l;a--,c--){o=C.from(i.node(a).copy(o));let d=r&&r[c];s=C.from(d?d.type.create(d.attrs,s):i.node(a).copy(s))}t.step(new Ee(e,e,new w(o.append(s),n,n),!0))}function Ye(t,e){let n=t.resolve(e),r=n.index();return Oh(n.nodeBefore,n.nodeAfter)&&n.parent.canReplace(r,r+1)}function vT(t,e){e.content.size||t.type.compatibleContent(e.type);let n=t.contentMatchAt(t.childCount),{linebreakReplacement:r}=t.type.schema;for(let i=0;i<e.childCount;i++){let o=e.child(i),s=o.type==r?t.type.schema.nodes.text:o.type;if(n=n.matchType(s),!n||!t.type.allowsMarks(o.marks))return!1}return n.validEnd}function Oh(t,e){return!!(t&&e&&!t.isLeaf&&vT(t,e))}function Sn(t,e,n=-1){let r=t.resolve(e);for(let i=r.depth;;i--){let o,s,a=r.index(i);if(i==r.depth?(o=r.nodeBefore,s=r.nodeAfter):n>0?(o=r.node(i+1),a++,s=r.node(i).maybeChild(a)):(o=r.node(i).maybeChild(a-1),s=r.node(i+1)),o&&!o.isTextblock&&Oh(o,s)&&r.node(i).canReplace(a,a+1))return e;if(i==0)break;e=n<0?r.before(i):r.after(i)}}function TT(t,e,n){let r=null,{linebreakReplacement:i}=t.doc.type.schema,o=t.doc.resolve(e-n),s=o.node().type;if(i&&s.inlineContent){let d=s.whitespace=="pre",u=!!s.contentMatch.matchType(i);d&&!u?r=!1:!d&&u&&(r=!0)}let a=t.steps.length;if(r===!1){let d=t.doc.resolve(e+n);Rh(t,d.node(),d.before(),a)}s.inlineContent&&ql(t,e+n-1,s,o.node().contentMatchAt(o.index()),r==null);let l=t.mapping.slice(a),c=l.map(e-n);if(t.step(new Ee(c,l.map(e+n,-1),w.empty,!0)),r===!0){let d=t.doc.resolve(c);Ah(t,d.node(),d.before(),t.steps.length)}return t}function ST(t,e,n){let r=t.resolve(e);if(r.parent.canReplaceWith(r.index(),r.index(),n))return e;if(r.parentOffset==0)for(let i=r.depth-1;i>=0;i--){let o=r.index(i);if(r.node(i).canReplaceWith(o,o,n))return r.before(i+1);if(o>0)return null}if(r.parentOffset==r.parent.content.size)for(let i=r.depth-1;i>=0;i--){let o=r.indexAfter(i);if(r.node(i).canReplaceWith(o,o,n))return r.after(i+1);if(o<r.node(i).childCount)return null}return null}function qo(t,e,n){let r=t.resolve(e);if(!n.content.size)return e;let i=n.content;for(let o=0;o<n.openStart;o++)i=i.firstChild.content;for(let o=1;o<=(n.openStart==0&&n.size?2:1);o++)for(let s=r.depth;s>=0;s--){let a=s==r.depth?0:r.pos<=(r.start(s+1)+r.end(s+1))/2?-1:1,l=r.index(s)+(a>0?1:0),c=r.node(s),d=!1;if(o==1)d=c.canReplace(l,l,i);else{let u=c.contentMatchAt(l).findWrapping(i.firstChild.type);d=u&&c.canReplaceWith(l,l,u[0])}if(d)return a==0?r.pos:a<0?r.before(s+1):r.after(s+1)}return null}function hi(t,e,n=e,r=w.empty){if(e==n&&!r.size)return null;let i=t.resolve(e),o=t.resolve(n);return Lh(i,o,r)?new Ee(e,n,r):new jl(i,o,r).fit()}function Lh(t,e,n){return!n.openStart&&!n.openEnd&&t.start()==e.start()&&t.parent.canReplace(t.index(),e.index(),n.content)}var jl=class{constructor(e,n,r){this.$from=e,this.$to=n,this.unplaced=r,this.frontier=[],this.placed=C.empty;for(let i=0;i<=e.depth;i++){let o=e.node(i);this.frontier.push({type:o.type,match:o.contentMatchAt(e.indexAfter(i))})}for(let i=e.depth;i>0;i--)this.placed=C.from(e.node(i).copy(this.placed))}get depth(){return this.frontier.length-1}fit(){for(;this.unplaced.size;){let c=this.findFittable();c?this.placeNodes(c):this.openMore()||this.dropNode()}let e=this.mustMoveInline(),n=this.placed.size-this.depth-this.$from.depth,r=this.$from,i=this.close(e<0?this.$to:r.doc.resolve(e));if(!i)return null;let o=this.placed,s=r.depth,a=i.depth;for(;s&&a&&o.childCount==1;)o=o.firstChild.content,s--,a--;let l=new w(o,s,a);return e>-1?new le(r.pos,e,this.$to.pos,this.$to.end(),l,n):l.size||r.pos!=this.$to.pos?new Ee(r.pos,i.pos,l):null}findFittable(){let e=this.unplaced.openStart;for(let n=this.unplaced.content,r=0,i=this.unplaced.openEnd;r<e;r++){let o=n.firstChild;if(n.childCount>1&&(i=0),o.type.spec.isolating&&i<=r){e=r;break}n=o.content}for(let n=1;n<=2;n++)for(let r=n==1?e:this.unplaced.openStart;r>=0;r--){let i,o=null;r?(o=Vl(this.unplaced.content,r-1).firstChild,i=o.content):i=this.unplaced.content;let s=i.firstChild;for(let a=this.depth;a>=0;a--){let{type:l,match:c}=this.frontier[a],d,u=null;if(n==1&&(s?c.matchType(s.type)||(u=c.fillBefore(C.from(s),!1)):o&&l.compatibleContent(o.type)))return{sliceDepth:r,frontierDepth:a,parent:o,inject:u};if(n==2&&s&&(d=c.findWrapping(s.type)))return{sliceDepth:r,frontierDepth:a,parent:o,wrap:d};if(o&&c.matchType(o.type))break}}}openMore(){let{content:e,openStart:n,openEnd:r}=this.unplaced,i=Vl(e,n);return!i.childCount||i.firstChild.isLeaf?!1:(this.unplaced=new w(e,n+1,Math.max(r,i.size+n>=e.size-r?n+1:0)),!0)}dropNode(){let{content:e,openStart:n,openEnd:r}=this.unplaced,i=Vl(e,n);if(i.childCount<=1&&n>0){let o=e.size-n<=n+i.size;this.unplaced=new w(ai(e,n-1,1),n-1,o?n-1:r)}else this.unplaced=new w(ai(e,n,1),n,r)}placeNodes({sliceDepth:e,frontierDepth:n,parent:r,inject:i,wrap:o}){for(;this.depth>n;)this.closeFrontierNode();if(o)for(let m=0;m<o.length;m++)this.openFrontierNode(o[m]);let s=this.unplaced,a=r?r.content:s.content,l=s.openStart-e,c=0,d=[],{match:u,type:f}=this.frontier[n];if(i){for(let m=0;m<i.childCount;m++)d.push(i.child(m));u=u.matchFragment(i)}let p=a.size+e-(s.content.size-s.openEnd);for(;c<a.childCount;){let m=a.child(c),g=u.matchType(m.type);if(!g)break;c++,(c>1||l==0||m.content.size)&&(u=g,d.push(Nh(m.mark(f.allowedMarks(m.marks)),c==1?l:0,c==a.childCount?p:-1)))}let h=c==a.childCount;h||(p=-1),this.placed=li(this.placed,n,C.from(d)),this.frontier[n].match=u,h&&p<0&&r&&r.type==this.frontier[this.depth].type&&this.frontier.length>1&&this.closeFrontierNode();for(let m=0,g=a;m<p;m++){let y=g.lastChild;this.frontier.push({type:y.type,match:y.contentMatchAt(y.childCount)}),g=y.content}this.unplaced=h?e==0?w.empty:new w(ai(s.content,e-1,1),e-1,p<0?s.openEnd:e-1):new w(ai(s.content,e,c),s.openStart,s.openEnd)}mustMoveInline(){if(!this.$to.parent.isTextblock)return-1;let e=this.frontier[this.depth],n;if(!e.type.isTextblock||!Ul(this.$to,this.$to.depth,e.type,e.match,!1)||this.$to.depth==this.depth&&(n=this.findCloseLevel(this.$to))&&n.depth==this.depth)return-1;let{depth:r}=this.$to,i=this.$to.after(r);for(;r>1&&i==this.$to.end(--r);)++i;return i}findCloseLevel(e){e:for(let n=Math.min(this.depth,e.depth);n>=0;n--){let{match:r,type:i}=this.frontier[n],o=n<e.depth&&e.end(n+1)==e.pos+(e.depth-(n+1)),s=Ul(e,n,i,r,o);if(s){for(let a=n-1;a>=0;a--){let{match:l,type:c}=this.frontier[a],d=Ul(e,a,c,l,!0);if(!d||d.childCount)continue e}return{depth:n,fit:s,move:o?e.doc.resolve(e.after(n+1)):e}}}}close(e){let n=this.findCloseLevel(e);if(!n)return null;for(;this.depth>n.depth;)this.closeFrontierNode();n.fit.childCount&&(this.placed=li(this.placed,n.depth,n.fit)),e=n.move;for(let r=n.depth+1;r<=e.depth;r++){let i=e.node(r),o=i.type.contentMatch.fillBefore(i.content,!0,e.index(r));this.openFrontierNode(i.type,i.attrs,o)}return e}openFrontierNode(e,n=null,r){let i=this.frontier[this.depth];i.match=i.match.matchType(e),this.placed=li(this.placed,this.depth,C.from(e.create(n,r))),this.frontier.push({type:e,match:e.contentMatch})}closeFrontierNode(){let n=this.frontier.pop().match.fillBefore(C.empty,!0);n.childCount&&(this.placed=li(this.placed,this.frontier.length,n))}};function ai(t,e,n){return e==0?t.cutByIndex(n,t.childCount):t.replaceChild(0,t.firstChild.copy(ai(t.firstChild.content,e-1,n)))}function li(t,e,n){return e==0?t.append(n):t.replaceChild(t.childCount-1,t.lastChild.copy(li(t.lastChild.content,e-1,n)))}function Vl(t,e){for(let n=0;n<e;n++)t=t.firstChild.content;return t}function Nh(t,e,n){if(e<=0)return t;let r=t.content;return e>1&&(r=r.replaceChild(0,Nh(r.firstChild,e-1,r.childCount==1?n-1:0))),e>0&&(r=t.type.contentMatch.fillBefore(r).append(r),n<=0&&(r=r.append(t.type.contentMatch.matchFragment(r).fillBefore(C.empty,!0)))),t.copy(r)}function Ul(t,e,n,r,i){let o=t.node(e),s=i?t.indexAfter(e):t.index(e);if(s==o.childCount&&!n.compatibleContent(o.type))return null;let a=r.fillBefore(o.content,!0,s);return a&&!kT(n,o.content,s)?a:null}function kT(t,e,n){for(let r=n;r<e.childCount;r++)if(!t.allowsMarks(e.child(r).marks))return!0;return!1}function MT(t){return t.spec.defining||t.spec.definingForContent}function wT(t,e,n,r){if(!r.size)return t.deleteRange(e,n);let i=t.doc.resolve(e),o=t.doc.resolve(n);if(Lh(i,o,r))return t.step(new Ee(e,n,r));let s=Ph(i,t.doc.resolve(n));s[s.length-1]==0&&s.pop();let a=-(i.depth+1);s.unshift(a);for(let f=i.depth,p=i.pos-1;f>0;f--,p--){let h=i.node(f).type.spec;if(h.defining||h.definingAsContext||h.isolating)break;s.indexOf(f)>-1?a=f:i.before(f)==p&&s.splice(1,0,-f)}let l=s.indexOf(a),c=[],d=r.openStart;for(let f=r.content,p=0;;p++){let h=f.firstChild;if(c.push(h),p==r.openStart)break;f=h.content}for(let f=d-1;f>=0;f--){let p=c[f],h=MT(p.type);if(h&&!p.sameMarkup(i.node(Math.abs(a)-1)))d=f;else if(h||!p.type.isTextblock)break}for(let f=r.openStart;f>=0;f--){let p=(f+d+1)%(r.openStart+1),h=c[p];if(h)for(let m=0;m<s.length;m++){let g=s[(m+l)%s.length],y=!0;g<0&&(y=!1,g=-g);let E=i.node(g-1),x=i.index(g-1);if(E.canReplaceWith(x,x,h.type,h.marks))return t.replace(i.before(g),y?o.after(g):n,new w(Ih(r.content,0,r.openStart,p),p,r.openEnd))}}let u=t.steps.length;for(let f=s.length-1;f>=0&&(t.replace(e,n,r),!(t.steps.length>u));f--){let p=s[f];p<0||(e=i.before(p),n=o.after(p))}}function Ih(t,e,n,r,i){if(e<n){let o=t.firstChild;t=t.replaceChild(0,o.copy(Ih(o.content,e+1,n,r,o)))}if(e>r){let o=i.contentMatchAt(0),s=o.fillBefore(t).append(t);t=s.append(o.matchFragment(s).fillBefore(C.empty,!0))}return t}function AT(t,e,n,r){if(!r.isInline&&e==n&&t.doc.resolve(e).parent.content.size){let i=ST(t.doc,e,r.type);i!=null&&(e=n=i)}t.replaceRange(e,n,new w(C.from(r),0,0))}function RT(t,e,n){let r=t.doc.resolve(e),i=t.doc.resolve(n),o=Ph(r,i);for(let s=0;s<o.length;s++){let a=o[s],l=s==o.length-1;if(l&&a==0||r.node(a).type.contentMatch.validEnd)return t.delete(r.start(a),i.end(a));if(a>0&&(l||r.node(a-1).canReplace(r.index(a-1),i.indexAfter(a-1))))return t.delete(r.before(a),i.after(a))}for(let s=1;s<=r.depth&&s<=i.depth;s++)if(e-r.start(s)==r.depth-s&&n>r.end(s)&&i.end(s)-n!=i.depth-s&&r.start(s-1)==i.start(s-1)&&r.node(s-1).canReplace(r.index(s-1),i.index(s-1)))return t.delete(r.before(s),n);t.delete(e,n)}function Ph(t,e){let n=[],r=Math.min(t.depth,e.depth);for(let i=r;i>=0;i--){let o=t.start(i);if(o<t.pos-(t.depth-i)||e.end(i)>e.pos+(e.depth-i)||t.node(i).type.spec.isolating||e.node(i).type.spec.isolating)break;(o==e.start(i)||i==t.depth&&i==e.depth&&t.parent.inlineContent&&e.parent.inlineContent&&i&&e.start(i-1)==o-1)&&n.push(i)}return n}var jo=class t extends pe{constructor(e,n,r){super(),this.pos=e,this.attr=n,this.value=r}apply(e){let n=e.nodeAt(this.pos);if(!n)return he.fail("No node at attribute step's position");let r=Object.create(null);for(let o in n.attrs)r[o]=n.attrs[o];r[this.attr]=this.value;let i=n.type.create(r,null,n.marks);return he.fromReplace(e,this.pos,this.pos+1,new w(C.from(i),0,n.isLeaf?0:1))}getMap(){return Mt.empty}invert(e){return new t(this.pos,this.attr,e.nodeAt(this.pos).attrs[this.attr])}map(e){let n=e.mapResult(this.pos,1);return n.deletedAfter?null:new t(n.pos,this.attr,this.value)}toJSON(){return{stepType:"attr",pos:this.pos,attr:this.attr,value:this.value}}static fromJSON(e,n){if(typeof n.pos!="number"||typeof n.attr!="string")throw new RangeError("Invalid input for AttrStep.fromJSON");return new t(n.pos,n.attr,n.value)}};pe.jsonID("attr",jo);var Wo=class t extends pe{constructor(e,n){super(),this.attr=e,this.value=n}apply(e){let n=Object.create(null);for(let i in e.attrs)n[i]=e.attrs[i];n[this.attr]=this.value;let r=e.type.create(n,e.content,e.marks);return he.ok(r)}getMap(){return Mt.empty}invert(e){return new t(this.attr,e.attrs[this.attr])}map(e){return this}toJSON(){return{stepType:"docAttr",attr:this.attr,value:this.value}}static fromJSON(e,n){if(typeof n.attr!="string")throw new RangeError("Invalid input for DocAttrStep.fromJSON");return new t(n.attr,n.value)}};pe.jsonID("docAttr",Wo);var ur=class extends Error{};ur=function t(e){let n=Error.call(this,e);return n.__proto__=t.prototype,n};ur.prototype=Object.create(Error.prototype);ur.prototype.constructor=ur;ur.prototype.name="TransformError";var pi=class{constructor(e){this.doc=e,this.steps=[],this.docs=[],this.mapping=new di}get before(){return this.docs.length?this.docs[0]:this.doc}step(e){let n=this.maybeStep(e);if(n.failed)throw new ur(n.failed);return this}maybeStep(e){let n=e.apply(this.doc);return n.failed||this.addStep(e,n.doc),n}get docChanged(){return this.steps.length>0}addStep(e,n){this.docs.push(this.doc),this.steps.push(e),this.mapping.appendMap(e.getMap()),this.doc=n}replace(e,n=e,r=w.empty){let i=hi(this.doc,e,n,r);return i&&this.step(i),this}replaceWith(e,n,r){return this.replace(e,n,new w(C.from(r),0,0))}delete(e,n){return this.replace(e,n,w.empty)}insert(e,n){return this.replaceWith(e,e,n)}replaceRange(e,n,r){return wT(this,e,n,r),this}replaceRangeWith(e,n,r){return AT(this,e,n,r),this}deleteRange(e,n){return RT(this,e,n),this}lift(e,n){return hT(this,e,n),this}join(e,n=1){return TT(this,e,n),this}wrap(e,n){return yT(this,e,n),this}setBlockType(e,n=e,r,i=null){return bT(this,e,n,r,i),this}setNodeMarkup(e,n,r=null,i){return xT(this,e,n,r,i),this}setNodeAttribute(e,n,r){return this.step(new jo(e,n,r)),this}setDocAttribute(e,n){return this.step(new Wo(e,n)),this}addNodeMark(e,n){return this.step(new fi(e,n)),this}removeNodeMark(e,n){let r=this.doc.nodeAt(e);if(!r)throw new RangeError("No node at position "+e);if(n instanceof q)n.isInSet(r.marks)&&this.step(new dr(e,n));else{let i=r.marks,o,s=[];for(;o=n.isInSet(i);)s.push(new dr(e,o)),i=o.removeFromSet(i);for(let a=s.length-1;a>=0;a--)this.step(s[a])}return this}split(e,n=1,r){return CT(this,e,n,r),this}addMark(e,n,r){return uT(this,e,n,r),this}removeMark(e,n,r){return fT(this,e,n,r),this}clearIncompatible(e,n,r){return ql(this,e,n,r),this}};var Kl=Object.create(null),D=class{constructor(e,n,r){this.$anchor=e,this.$head=n,this.ranges=r||[new Ko(e.min(n),e.max(n))]}get anchor(){return this.$anchor.pos}get head(){return this.$head.pos}get from(){return this.$from.pos}get to(){return this.$to.pos}get $from(){return this.ranges[0].$from}get $to(){return this.ranges[0].$to}get empty(){let e=this.ranges;for(let n=0;n<e.length;n++)if(e[n].$from.pos!=e[n].$to.pos)return!1;return!0}content(){return this.$from.doc.slice(this.from,this.to,!0)}replace(e,n=w.empty){let r=n.content.lastChild,i=null;for(let a=0;a<n.openEnd;a++)i=r,r=r.lastChild;let o=e.steps.length,s=this.ranges;for(let a=0;a<s.length;a++){let{$from:l,$to:c}=s[a],d=e.mapping.slice(o);e.replaceRange(d.map(l.pos),d.map(c.pos),a?w.empty:n),a==0&&Fh(e,o,(r?r.isInline:i&&i.isTextblock)?-1:1)}}replaceWith(e,n){let r=e.steps.length,i=this.ranges;for(let o=0;o<i.length;o++){let{$from:s,$to:a}=i[o],l=e.mapping.slice(r),c=l.map(s.pos),d=l.map(a.pos);o?e.deleteRange(c,d):(e.replaceRangeWith(c,d,n),Fh(e,r,n.isInline?-1:1))}}static findFrom(e,n,r=!1){let i=e.parent.inlineContent?new L(e):pr(e.node(0),e.parent,e.pos,e.index(),n,r);if(i)return i;for(let o=e.depth-1;o>=0;o--){let s=n<0?pr(e.node(0),e.node(o),e.before(o+1),e.index(o),n,r):pr(e.node(0),e.node(o),e.after(o+1),e.index(o)+1,n,r);if(s)return s}return null}static near(e,n=1){return this.findFrom(e,n)||this.findFrom(e,-n)||new ke(e.node(0))}static atStart(e){return pr(e,e,0,0,1)||new ke(e)}static atEnd(e){return pr(e,e,e.content.size,e.childCount,-1)||new ke(e)}static fromJSON(e,n){if(!n||!n.type)throw new RangeError("Invalid input for Selection.fromJSON");let r=Kl[n.type];if(!r)throw new RangeError(`No selection type ${n.type} defined`);return r.fromJSON(e,n)}static jsonID(e,n){if(e in Kl)throw new RangeError("Duplicate use of selection JSON ID "+e);return Kl[e]=n,n.prototype.jsonID=e,n}getBookmark(){return L.between(this.$anchor,this.$head).getBookmark()}};D.prototype.visible=!0;var Ko=class{constructor(e,n){this.$from=e,this.$to=n}},Dh=!1;function Hh(t){!Dh&&!t.parent.inlineContent&&(Dh=!0,console.warn("TextSelection endpoint not pointing into a node with inline content ("+t.parent.type.name+")"))}var L=class t extends D{constructor(e,n=e){Hh(e),Hh(n),super(e,n)}get $cursor(){return this.$anchor.pos==this.$head.pos?this.$head:null}map(e,n){let r=e.resolve(n.map(this.head));if(!r.parent.inlineContent)return D.near(r);let i=e.resolve(n.map(this.anchor));return new t(i.parent.inlineContent?i:r,r)}replace(e,n=w.empty){if(super.replace(e,n),n==w.empty){let r=this.$from.marksAcross(this.$to);r&&e.ensureMarks(r)}}eq(e){return e instanceof t&&e.anchor==this.anchor&&e.head==this.head}getBookmark(){return new Jo(this.anchor,this.head)}toJSON(){return{type:"text",anchor:this.anchor,head:this.head}}static fromJSON(e,n){if(typeof n.anchor!="number"||typeof n.head!="number")throw new RangeError("Invalid input for TextSelection.fromJSON");return new t(e.resolve(n.anchor),e.resolve(n.head))}static create(e,n,r=n){let i=e.resolve(n);return new this(i,r==n?i:e.resolve(r))}static between(e,n,r){let i=e.pos-n.pos;if((!r||i)&&(r=i>=0?1:-1),!n.parent.inlineContent){let o=D.findFrom(n,r,!0)||D.findFrom(n,-r,!0);if(o)n=o.$head;else return D.near(n,r)}return e.parent.inlineContent||(i==0?e=n:(e=(D.findFrom(e,-r,!0)||D.findFrom(e,r,!0)).$anchor,e.pos<n.pos!=i<0&&(e=n))),new t(e,n)}};D.jsonID("text",L);var Jo=class t{constructor(e,n){this.anchor=e,this.head=n}map(e){return new t(e.map(this.anchor),e.map(this.head))}resolve(e){return L.between(e.resolve(this.anchor),e.resolve(this.head))}},O=class t extends D{constructor(e){let n=e.nodeAfter,r=e.node(0).resolve(e.pos+n.nodeSize);super(e,r),this.node=n}map(e,n){let{deleted:r,pos:i}=n.mapResult(this.anchor),o=e.resolve(i);return r?D.near(o):new t(o)}content(){return new w(C.from(this.node),0,0)}eq(e){return e instanceof t&&e.anchor==this.anchor}toJSON(){return{type:"node",anchor:this.anchor}}getBookmark(){return new Gl(this.anchor)}static fromJSON(e,n){if(typeof n.anchor!="number")throw new RangeError("Invalid input for NodeSelection.fromJSON");return new t(e.resolve(n.anchor))}static create(e,n){return new t(e.resolve(n))}static isSelectable(e){return!e.isText&&e.type.spec.selectable!==!1}};O.prototype.visible=!1;D.jsonID("node",O);var Gl=class t{constructor(e){this.anchor=e}map(e){let{deleted:n,pos:r}=e.mapResult(this.anchor);return n?new Jo(r,r):new t(r)}resolve(e){let n=e.resolve(this.anchor),r=n.nodeAfter;return r&&O.isSelectable(r)?new O(n):D.near(n)}},ke=class t extends D{constructor(e){super(e.resolve(0),e.resolve(e.content.size))}replace(e,n=w.empty){if(n==w.empty){e.delete(0,e.doc.content.size);let r=D.atStart(e.doc);r.eq(e.selection)||e.setSelection(r)}else super.replace(e,n)}toJSON(){return{type:"all"}}static fromJSON(e){return new t(e)}map(e){return new t(e)}eq(e){return e instanceof t}getBookmark(){return OT}};D.jsonID("all",ke);var OT={map(){return this},resolve(t){return new ke(t)}};function pr(t,e,n,r,i,o=!1){if(e.inlineContent)return L.create(t,n);for(let s=r-(i>0?0:1);i>0?s<e.childCount:s>=0;s+=i){let a=e.child(s);if(a.isAtom){if(!o&&O.isSelectable(a))return O.create(t,n-(i<0?a.nodeSize:0))}else{let l=pr(t,a,n+i,i<0?a.childCount:0,i,o);if(l)return l}n+=a.nodeSize*i}return null}function Fh(t,e,n){let r=t.steps.length-1;if(r<e)return;let i=t.steps[r];if(!(i instanceof Ee||i instanceof le))return;let o=t.mapping.maps[r],s;o.forEach((a,l,c,d)=>{s==null&&(s=d)}),t.setSelection(D.near(t.doc.resolve(s),n))}function _h(t,e){return!e||!t?t:t.bind(e)}var hr=class{constructor(e,n,r){this.name=e,this.init=_h(n.init,r),this.apply=_h(n.apply,r)}},WA=[new hr("doc",{init(t){return t.doc||t.schema.topNodeType.createAndFill()},apply(t){return t.doc}}),new hr("selection",{init(t,e){return t.selection||D.atStart(e.doc)},apply(t){return t.selection}}),new hr("storedMarks",{init(t){return t.storedMarks||null},apply(t,e,n,r){return r.selection.$cursor?t.storedMarks:null}}),new hr("scrollToSelection",{init(){return 0},apply(t,e){return t.scrolledIntoView?e+1:e}})];function Bh(t,e,n){for(let r in t){let i=t[r];i instanceof Function?i=i.bind(e):r=="handleDOMEvents"&&(i=Bh(i,e,{})),n[r]=i}return n}var V=class{constructor(e){this.spec=e,this.props={},e.props&&Bh(e.props,this,this.props),this.key=e.key?e.key.key:zh("plugin")}getState(e){return e[this.key]}},Jl=Object.create(null);function zh(t){return t in Jl?t+"$"+ ++Jl[t]:(Jl[t]=0,t+"$")}var G=class{constructor(e="key"){this.key=zh(e)}get(e){return e.config.pluginsByKey[this.key]}getState(e){return e[this.key]}};var me=function(t){for(var e=0;;e++)if(t=t.previousSibling,!t)return e},Er=function(t){let e=t.assignedSlot||t.parentNode;return e&&e.nodeType==11?e.host:e},tc=null,Rt=function(t,e,n){let r=tc||(tc=document.createRange());return r.setEnd(t,n==null?t.nodeValue.length:n),r.setStart(t,e||0),r},LT=function(){tc=null},Ln=function(t,e,n,r){return n&&(Vh(t,e,n,r,-1)||Vh(t,e,n,r,1))},NT=/^(img|br|input|textarea|hr)$/i;function Vh(t,e,n,r,i){for(var o;;){if(t==n&&e==r)return!0;if(e==(i<0?0:Qe(t))){let s=t.parentNode;if(!s||s.nodeType!=1||Ci(t)||NT.test(t.nodeName)||t.contentEditable=="false")return!1;e=me(t)+(i<0?0:1),t=s}else if(t.nodeType==1){let s=t.childNodes[e+(i<0?-1:0)];if(s.nodeType==1&&s.contentEditable=="false")if(!((o=s.pmViewDesc)===null||o===void 0)&&o.ignoreForSelection)e+=i;else return!1;else t=s,e=i<0?Qe(t):0}else return!1}}function Qe(t){return t.nodeType==3?t.nodeValue.length:t.childNodes.length}function IT(t,e){for(;;){if(t.nodeType==3&&e)return t;if(t.nodeType==1&&e>0){if(t.contentEditable=="false")return null;t=t.childNodes[e-1],e=Qe(t)}else if(t.parentNode&&!Ci(t))e=me(t),t=t.parentNode;else return null}}function PT(t,e){for(;;){if(t.nodeType==3&&e<t.nodeValue.length)return t;if(t.nodeType==1&&e<t.childNodes.length){if(t.contentEditable=="false")return null;t=t.childNodes[e],e=0}else if(t.parentNode&&!Ci(t))e=me(t)+1,t=t.parentNode;else return null}}function DT(t,e,n){for(let r=e==0,i=e==Qe(t);r||i;){if(t==n)return!0;let o=me(t);if(t=t.parentNode,!t)return!1;r=r&&o==0,i=i&&o==Qe(t)}}function Ci(t){let e;for(let n=t;n&&!(e=n.pmViewDesc);n=n.parentNode);return e&&e.node&&e.node.isBlock&&(e.dom==t||e.contentDOM==t)}var as=function(t){return t.focusNode&&Ln(t.focusNode,t.focusOffset,t.anchorNode,t.anchorOffset)};function kn(t,e){let n=document.createEvent("Event");return n.initEvent("keydown",!0,!0),n.keyCode=t,n.key=n.code=e,n}function HT(t){let e=t.activeElement;for(;e&&e.shadowRoot;)e=e.shadowRoot.activeElement;return e}function FT(t,e,n){if(t.caretPositionFromPoint)try{let r=t.caretPositionFromPoint(e,n);if(r)return{node:r.offsetNode,offset:Math.min(Qe(r.offsetNode),r.offset)}}catch{}if(t.caretRangeFromPoint){let r=t.caretRangeFromPoint(e,n);if(r)return{node:r.startContainer,offset:Math.min(Qe(r.startContainer),r.startOffset)}}}var gt=typeof navigator!="undefined"?navigator:null,Uh=typeof document!="undefined"?document:null,en=gt&&gt.userAgent||"",nc=/Edge\/(\d+)/.exec(en),xm=/MSIE \d/.exec(en),rc=/Trident\/(?:[7-9]|\d{2,})\..*rv:(\d+)/.exec(en),He=!!(xm||rc||nc),Qt=xm?document.documentMode:rc?+rc[1]:nc?+nc[1]:0,Ze=!He&&/gecko\/(\d+)/i.test(en);Ze&&+(/Firefox\/(\d+)/.exec(en)||[0,0])[1];var ic=!He&&/Chrome\/(\d+)/.exec(en),Ce=!!ic,Cm=ic?+ic[1]:0,Me=!He&&!!gt&&/Apple Computer/.test(gt.vendor),xr=Me&&(/Mobile\/\w+/.test(en)||!!gt&&gt.maxTouchPoints>2),Xe=xr||(gt?/Mac/.test(gt.platform):!1),_T=gt?/Win/.test(gt.platform):!1,Ot=/Android \d/.test(en),vi=!!Uh&&"webkitFontSmoothing"in Uh.documentElement.style,BT=vi?+(/\bAppleWebKit\/(\d+)/.exec(navigator.userAgent)||[0,0])[1]:0;function zT(t){let e=t.defaultView&&t.defaultView.visualViewport;return e?{left:0,right:e.width,top:0,bottom:e.height}:{left:0,right:t.documentElement.clientWidth,top:0,bottom:t.documentElement.clientHeight}}function At(t,e){return typeof t=="number"?t:t[e]}function VT(t){let e=t.getBoundingClientRect(),n=e.width/t.offsetWidth||1,r=e.height/t.offsetHeight||1;return{left:e.left,right:e.left+t.clientWidth*n,top:e.top,bottom:e.top+t.clientHeight*r}}function $h(t,e,n){let r=t.someProp("scrollThreshold")||0,i=t.someProp("scrollMargin")||5,o=t.dom.ownerDocument;for(let s=n||t.dom;s;){if(s.nodeType!=1){s=Er(s);continue}let a=s,l=a==o.body,c=l?zT(o):VT(a),d=0,u=0;if(e.top<c.top+At(r,"top")?u=-(c.top-e.top+At(i,"top")):e.bottom>c.bottom-At(r,"bottom")&&(u=e.bottom-e.top>c.bottom-c.top?e.top+At(i,"top")-c.top:e.bottom-c.bottom+At(i,"bottom")),e.left<c.left+At(r,"left")?d=-(c.left-e.left+At(i,"left")):e.right>c.right-At(r,"right")&&(d=e.right-c.right+At(i,"right")),d||u)if(l)o.defaultView.scrollBy(d,u);else{let p=a.scrollLeft,h=a.scrollTop;u&&(a.scrollTop+=u),d&&(a.scrollLeft+=d);let m=a.scrollLeft-p,g=a.scrollTop-h;e={left:e.left-m,top:e.top-g,right:e.right-m,bottom:e.bottom-g}}let f=l?"fixed":getComputedStyle(s).position;if(/^(fixed|sticky)$/.test(f))break;s=f=="absolute"?s.offsetParent:Er(s)}}function UT(t){let e=t.dom.getBoundingClientRect(),n=Math.max(0,e.top),r,i;for(let o=(e.left+e.right)/2,s=n+1;s<Math.min(innerHeight,e.bottom);s+=5){let a=t.root.elementFromPoint(o,s);if(!a||a==t.dom||!t.dom.contains(a))continue;let l=a.getBoundingClientRect();if(l.top>=n-20){r=a,i=l.top;break}}return{refDOM:r,refTop:i,stack:vm(t.dom)}}function vm(t){let e=[],n=t.ownerDocument;for(let r=t;r&&(e.push({dom:r,top:r.scrollTop,left:r.scrollLeft}),t!=n);r=Er(r));return e}function $T({refDOM:t,refTop:e,stack:n}){let r=t?t.getBoundingClientRect().top:0;Tm(n,r==0?0:r-e)}function Tm(t,e){for(let n=0;n<t.length;n++){let{dom:r,top:i,left:o}=t[n];r.scrollTop!=i+e&&(r.scrollTop=i+e),r.scrollLeft!=o&&(r.scrollLeft=o)}}var mr=null;function jT(t){if(t.setActive)return t.setActive();if(mr)return t.focus(mr);let e=vm(t);t.focus(mr==null?{get preventScroll(){return mr={preventScroll:!0},!0}}:void 0),mr||(mr=!1,Tm(e,0))}function Sm(t,e){let n,r=2e8,i,o=0,s=e.top,a=e.top,l,c;for(let d=t.firstChild,u=0;d;d=d.nextSibling,u++){let f;if(d.nodeType==1)f=d.getClientRects();else if(d.nodeType==3)f=Rt(d).getClientRects();else continue;for(let p=0;p<f.length;p++){let h=f[p];if(h.top<=s&&h.bottom>=a){s=Math.max(h.bottom,s),a=Math.min(h.top,a);let m=h.left>e.left?h.left-e.left:h.right<e.left?e.left-h.right:0;if(m<r){n=d,r=m,i=m&&n.nodeType==3?{left:h.right<e.left?h.right:h.left,top:e.top}:e,d.nodeType==1&&m&&(o=u+(e.left>=(h.left+h.right)/2?1:0));continue}}else h.top>e.top&&!l&&h.left<=e.left&&h.right>=e.left&&(l=d,c={left:Math.max(h.left,Math.min(h.right,e.left)),top:h.top});!n&&(e.left>=h.right&&e.top>=h.top||e.left>=h.left&&e.top>=h.bottom)&&(o=u+1)}}return!n&&l&&(n=l,i=c,r=0),n&&n.nodeType==3?WT(n,i):!n||r&&n.nodeType==1?{node:t,offset:o}:Sm(n,i)}function WT(t,e){let n=t.nodeValue.length,r=document.createRange();for(let i=0;i<n;i++){r.setEnd(t,i+1),r.setStart(t,i);let o=Gt(r,1);if(o.top!=o.bottom&&Ec(e,o))return{node:t,offset:i+(e.left>=(o.left+o.right)/2?1:0)}}return{node:t,offset:0}}function Ec(t,e){return t.left>=e.left-1&&t.left<=e.right+1&&t.top>=e.top-1&&t.top<=e.bottom+1}function qT(t,e){let n=t.parentNode;return n&&/^li$/i.test(n.nodeName)&&e.left<t.getBoundingClientRect().left?n:t}function KT(t,e,n){let{node:r,offset:i}=Sm(e,n),o=-1;if(r.nodeType==1&&!r.firstChild){let s=r.getBoundingClientRect();o=s.left!=s.right&&n.left>(s.left+s.right)/2?1:-1}return t.docView.posFromDOM(r,i,o)}function JT(t,e,n,r){let i=-1;for(let o=e,s=!1;o!=t.dom;){let a=t.docView.nearestDesc(o,!0),l;if(!a)return null;if(a.dom.nodeType==1&&(a.node.isBlock&&a.parent||!a.contentDOM)&&((l=a.dom.getBoundingClientRect()).width||l.height)&&(a.node.isBlock&&a.parent&&!/^T(R|BODY|HEAD|FOOT)$/.test(a.dom.nodeName)&&(!s&&l.left>r.left||l.top>r.top?i=a.posBefore:(!s&&l.right<r.left||l.bottom<r.top)&&(i=a.posAfter),s=!0),!a.contentDOM&&i<0&&!a.node.isText))return(a.node.isBlock?r.top<(l.top+l.bottom)/2:r.left<(l.left+l.right)/2)?a.posBefore:a.posAfter;o=a.dom.parentNode}return i>-1?i:t.docView.posFromDOM(e,n,-1)}function km(t,e,n){let r=t.childNodes.length;if(r&&n.top<n.bottom)for(let i=Math.max(0,Math.min(r-1,Math.floor(r*(e.top-n.top)/(n.bottom-n.top))-2)),o=i;;){let s=t.childNodes[o];if(s.nodeType==1){let a=s.getClientRects();for(let l=0;l<a.length;l++){let c=a[l];if(Ec(e,c))return km(s,e,c)}}if((o=(o+1)%r)==i)break}return t}function GT(t,e){let n=t.dom.ownerDocument,r,i=0,o=FT(n,e.left,e.top);o&&({node:r,offset:i}=o);let s=(t.root.elementFromPoint?t.root:n).elementFromPoint(e.left,e.top),a;if(!s||!t.dom.contains(s.nodeType!=1?s.parentNode:s)){let c=t.dom.getBoundingClientRect();if(!Ec(e,c)||(s=km(t.dom,e,c),!s))return null}if(Me)for(let c=s;r&&c;c=Er(c))c.draggable&&(r=void 0);if(s=qT(s,e),r){if(Ze&&r.nodeType==1&&(i=Math.min(i,r.childNodes.length),i<r.childNodes.length)){let d=r.childNodes[i],u;d.nodeName=="IMG"&&(u=d.getBoundingClientRect()).right<=e.left&&u.bottom>e.top&&i++}let c;vi&&i&&r.nodeType==1&&(c=r.childNodes[i-1]).nodeType==1&&c.contentEditable=="false"&&c.getBoundingClientRect().top>=e.top&&i--,r==t.dom&&i==r.childNodes.length-1&&r.lastChild.nodeType==1&&e.top>r.lastChild.getBoundingClientRect().bottom?a=t.state.doc.content.size:(i==0||r.nodeType!=1||r.childNodes[i-1].nodeName!="BR")&&(a=JT(t,r,i,e))}a==null&&(a=KT(t,s,e));let l=t.docView.nearestDesc(s,!0);return{pos:a,inside:l?l.posAtStart-l.border:-1}}function jh(t){return t.top<t.bottom||t.left<t.right}function Gt(t,e){let n=t.getClientRects();if(n.length){let r=n[e<0?0:n.length-1];if(jh(r))return r}return Array.prototype.find.call(n,jh)||t.getBoundingClientRect()}var YT=/[\u0590-\u05f4\u0600-\u06ff\u0700-\u08ac]/;function Mm(t,e,n){let{node:r,offset:i,atom:o}=t.docView.domFromPos(e,n<0?-1:1),s=vi||Ze;if(r.nodeType==3)if(s&&(YT.test(r.nodeValue)||(n<0?!i:i==r.nodeValue.length))){let l=Gt(Rt(r,i,i),n);if(Ze&&i&&/\s/.test(r.nodeValue[i-1])&&i<r.nodeValue.length){let c=Gt(Rt(r,i-1,i-1),-1);if(c.top==l.top){let d=Gt(Rt(r,i,i+1),-1);if(d.top!=l.top)return mi(d,d.left<c.left)}}return l}else{let l=i,c=i,d=n<0?1:-1;return n<0&&!i?(c++,d=-1):n>=0&&i==r.nodeValue.length?(l--,d=1):n<0?l--:c++,mi(Gt(Rt(r,l,c),d),d<0)}if(!t.state.doc.resolve(e-(o||0)).parent.inlineContent){if(o==null&&i&&(n<0||i==Qe(r))){let l=r.childNodes[i-1];if(l.nodeType==1)return Yl(l.getBoundingClientRect(),!1)}if(o==null&&i<Qe(r)){let l=r.childNodes[i];if(l.nodeType==1)return Yl(l.getBoundingClientRect(),!0)}return Yl(r.getBoundingClientRect(),n>=0)}if(o==null&&i&&(n<0||i==Qe(r))){let l=r.childNodes[i-1],c=l.nodeType==3?Rt(l,Qe(l)-(s?0:1)):l.nodeType==1&&(l.nodeName!="BR"||!l.nextSibling)?l:null;if(c)return mi(Gt(c,1),!1)}if(o==null&&i<Qe(r)){let l=r.childNodes[i];for(;l.pmViewDesc&&l.pmViewDesc.ignoreForCoords;)l=l.nextSibling;let c=l?l.nodeType==3?Rt(l,0,s?0:1):l.nodeType==1?l:null:null;if(c)return mi(Gt(c,-1),!0)}return mi(Gt(r.nodeType==3?Rt(r):r,-n),n>=0)}function mi(t,e){if(t.width==0)return t;let n=e?t.left:t.right;return{top:t.top,bottom:t.bottom,left:n,right:n}}function Yl(t,e){if(t.height==0)return t;let n=e?t.top:t.bottom;return{top:n,bottom:n,left:t.left,right:t.right}}function wm(t,e,n){let r=t.state,i=t.root.activeElement;r!=e&&t.updateState(e),i!=t.dom&&t.focus();try{return n()}finally{r!=e&&t.updateState(r),i!=t.dom&&i&&i.focus()}}function XT(t,e,n){let r=e.selection,i=n=="up"?r.$from:r.$to;return wm(t,e,()=>{let{node:o}=t.docView.domFromPos(i.pos,n=="up"?-1:1);for(;;){let a=t.docView.nearestDesc(o,!0);if(!a)break;if(a.node.isBlock){o=a.contentDOM||a.dom;break}o=a.dom.parentNode}let s=Mm(t,i.pos,1);for(let a=o.firstChild;a;a=a.nextSibling){let l;if(a.nodeType==1)l=a.getClientRects();else if(a.nodeType==3)l=Rt(a,0,a.nodeValue.length).getClientRects();else continue;for(let c=0;c<l.length;c++){let d=l[c];if(d.bottom>d.top+1&&(n=="up"?s.top-d.top>(d.bottom-s.top)*2:d.bottom-s.bottom>(s.bottom-d.top)*2))return!1}}return!0})}var QT=/[\u0590-\u08ac]/;function ZT(t,e,n){let{$head:r}=e.selection;if(!r.parent.isTextblock)return!1;let i=r.parentOffset,o=!i,s=i==r.parent.content.size,a=t.domSelection();return a?!QT.test(r.parent.textContent)||!a.modify?n=="left"||n=="backward"?o:s:wm(t,e,()=>{let{focusNode:l,focusOffset:c,anchorNode:d,anchorOffset:u}=t.domSelectionRange(),f=a.caretBidiLevel;a.modify("move",n,"character");let p=r.depth?t.docView.domAfterPos(r.before()):t.dom,{focusNode:h,focusOffset:m}=t.domSelectionRange(),g=h&&!p.contains(h.nodeType==1?h:h.parentNode)||l==h&&c==m;try{a.collapse(d,u),l&&(l!=d||c!=u)&&a.extend&&a.extend(l,c)}catch{}return f!=null&&(a.caretBidiLevel=f),g}):r.pos==r.start()||r.pos==r.end()}var Wh=null,qh=null,Kh=!1;function eS(t,e,n){return Wh==e&&qh==n?Kh:(Wh=e,qh=n,Kh=n=="up"||n=="down"?XT(t,e,n):ZT(t,e,n))}var et=0,Jh=1,Mn=2,yt=3,Nn=class{constructor(e,n,r,i){this.parent=e,this.children=n,this.dom=r,this.contentDOM=i,this.dirty=et,r.pmViewDesc=this}matchesWidget(e){return!1}matchesMark(e){return!1}matchesNode(e,n,r){return!1}matchesHack(e){return!1}parseRule(){return null}stopEvent(e){return!1}get size(){let e=0;for(let n=0;n<this.children.length;n++)e+=this.children[n].size;return e}get border(){return 0}destroy(){this.parent=void 0,this.dom.pmViewDesc==this&&(this.dom.pmViewDesc=void 0);for(let e=0;e<this.children.length;e++)this.children[e].destroy()}posBeforeChild(e){for(let n=0,r=this.posAtStart;;n++){let i=this.children[n];if(i==e)return r;r+=i.size}}get posBefore(){return this.parent.posBeforeChild(this)}get posAtStart(){return this.parent?this.parent.posBeforeChild(this)+this.border:0}get posAfter(){return this.posBefore+this.size}get posAtEnd(){return this.posAtStart+this.size-2*this.border}localPosFromDOM(e,n,r){if(this.contentDOM&&this.contentDOM.contains(e.nodeType==1?e:e.parentNode))if(r<0){let o,s;if(e==this.contentDOM)o=e.childNodes[n-1];else{for(;e.parentNode!=this.contentDOM;)e=e.parentNode;o=e.previousSibling}for(;o&&!((s=o.pmViewDesc)&&s.parent==this);)o=o.previousSibling;return o?this.posBeforeChild(s)+s.size:this.posAtStart}else{let o,s;if(e==this.contentDOM)o=e.childNodes[n];else{for(;e.parentNode!=this.contentDOM;)e=e.parentNode;o=e.nextSibling}for(;o&&!((s=o.pmViewDesc)&&s.parent==this);)o=o.nextSibling;return o?this.posBeforeChild(s):this.posAtEnd}let i;if(e==this.dom&&this.contentDOM)i=n>me(this.contentDOM);else if(this.contentDOM&&this.contentDOM!=this.dom&&this.dom.contains(this.contentDOM))i=e.compareDocumentPosition(this.contentDOM)&2;else if(this.dom.firstChild){if(n==0)for(let o=e;;o=o.parentNode){if(o==this.dom){i=!1;break}if(o.previousSibling)break}if(i==null&&n==e.childNodes.length)for(let o=e;;o=o.parentNode){if(o==this.dom){i=!0;break}if(o.nextSibling)break}}return(i==null?r>0:i)?this.posAtEnd:this.posAtStart}nearestDesc(e,n=!1){for(let r=!0,i=e;i;i=i.parentNode){let o=this.getDesc(i),s;if(o&&(!n||o.node))if(r&&(s=o.nodeDOM)&&!(s.nodeType==1?s.contains(e.nodeType==1?e:e.parentNode):s==e))r=!1;else return o}}getDesc(e){let n=e.pmViewDesc;for(let r=n;r;r=r.parent)if(r==this)return n}posFromDOM(e,n,r){for(let i=e;i;i=i.parentNode){let o=this.getDesc(i);if(o)return o.localPosFromDOM(e,n,r)}return-1}descAt(e){for(let n=0,r=0;n<this.children.length;n++){let i=this.children[n],o=r+i.size;if(r==e&&o!=r){for(;!i.border&&i.children.length;)for(let s=0;s<i.children.length;s++){let a=i.children[s];if(a.size){i=a;break}}return i}if(e<o)return i.descAt(e-r-i.border);r=o}}domFromPos(e,n){if(!this.contentDOM)return{node:this.dom,offset:0,atom:e+1};let r=0,i=0;for(let o=0;r<this.children.length;r++){let s=this.children[r],a=o+s.size;if(a>e||s instanceof Xo){i=e-o;break}o=a}if(i)return this.children[r].domFromPos(i-this.children[r].border,n);for(let o;r&&!(o=this.children[r-1]).size&&o instanceof Go&&o.side>=0;r--);if(n<=0){let o,s=!0;for(;o=r?this.children[r-1]:null,!(!o||o.dom.parentNode==this.contentDOM);r--,s=!1);return o&&n&&s&&!o.border&&!o.domAtom?o.domFromPos(o.size,n):{node:this.contentDOM,offset:o?me(o.dom)+1:0}}else{let o,s=!0;for(;o=r<this.children.length?this.children[r]:null,!(!o||o.dom.parentNode==this.contentDOM);r++,s=!1);return o&&s&&!o.border&&!o.domAtom?o.domFromPos(0,n):{node:this.contentDOM,offset:o?me(o.dom):this.contentDOM.childNodes.length}}}parseRange(e,n,r=0){if(this.children.length==0)return{node:this.contentDOM,from:e,to:n,fromOffset:0,toOffset:this.contentDOM.childNodes.length};let i=-1,o=-1;for(let s=r,a=0;;a++){let l=this.children[a],c=s+l.size;if(i==-1&&e<=c){let d=s+l.border;if(e>=d&&n<=c-l.border&&l.node&&l.contentDOM&&this.contentDOM.contains(l.contentDOM))return l.parseRange(e,n,d);e=s;for(let u=a;u>0;u--){let f=this.children[u-1];if(f.size&&f.dom.parentNode==this.contentDOM&&!f.emptyChildAt(1)){i=me(f.dom)+1;break}e-=f.size}i==-1&&(i=0)}if(i>-1&&(c>n||a==this.children.length-1)){n=c;for(let d=a+1;d<this.children.length;d++){let u=this.children[d];if(u.size&&u.dom.parentNode==this.contentDOM&&!u.emptyChildAt(-1)){o=me(u.dom);break}n+=u.size}o==-1&&(o=this.contentDOM.childNodes.length);break}s=c}return{node:this.contentDOM,from:e,to:n,fromOffset:i,toOffset:o}}emptyChildAt(e){if(this.border||!this.contentDOM||!this.children.length)return!1;let n=this.children[e<0?0:this.children.length-1];return n.size==0||n.emptyChildAt(e)}domAfterPos(e){let{node:n,offset:r}=this.domFromPos(e,0);if(n.nodeType!=1||r==n.childNodes.length)throw new RangeError("No node after pos "+e);return n.childNodes[r]}setSelection(e,n,r,i=!1){let o=Math.min(e,n),s=Math.max(e,n);for(let p=0,h=0;p<this.children.length;p++){let m=this.children[p],g=h+m.size;if(o>h&&s<g)return m.setSelection(e-h-m.border,n-h-m.border,r,i);h=g}let a=this.domFromPos(e,e?-1:1),l=n==e?a:this.domFromPos(n,n?-1:1),c=r.root.getSelection(),d=r.domSelectionRange(),u=!1;if((Ze||Me)&&e==n){let{node:p,offset:h}=a;if(p.nodeType==3){if(u=!!(h&&p.nodeValue[h-1]==`
+`),u&&h==p.nodeValue.length)for(let m=p,g;m;m=m.parentNode){if(g=m.nextSibling){g.nodeName=="BR"&&(a=l={node:g.parentNode,offset:me(g)+1});break}let y=m.pmViewDesc;if(y&&y.node&&y.node.isBlock)break}}else{let m=p.childNodes[h-1];u=m&&(m.nodeName=="BR"||m.contentEditable=="false")}}if(Ze&&d.focusNode&&d.focusNode!=l.node&&d.focusNode.nodeType==1){let p=d.focusNode.childNodes[d.focusOffset];p&&p.contentEditable=="false"&&(i=!0)}if(!(i||u&&Me)&&Ln(a.node,a.offset,d.anchorNode,d.anchorOffset)&&Ln(l.node,l.offset,d.focusNode,d.focusOffset))return;let f=!1;if((c.extend||e==n)&&!(u&&Ze)){c.collapse(a.node,a.offset);try{e!=n&&c.extend(l.node,l.offset),f=!0}catch{}}if(!f){if(e>n){let h=a;a=l,l=h}let p=document.createRange();p.setEnd(l.node,l.offset),p.setStart(a.node,a.offset),c.removeAllRanges(),c.addRange(p)}}ignoreMutation(e){return!this.contentDOM&&e.type!="selection"}get contentLost(){return this.contentDOM&&this.contentDOM!=this.dom&&!this.dom.contains(this.contentDOM)}markDirty(e,n){for(let r=0,i=0;i<this.children.length;i++){let o=this.children[i],s=r+o.size;if(r==s?e<=s&&n>=r:e<s&&n>r){let a=r+o.border,l=s-o.border;if(e>=a&&n<=l){this.dirty=e==r||n==s?Mn:Jh,e==a&&n==l&&(o.contentLost||o.dom.parentNode!=this.contentDOM)?o.dirty=yt:o.markDirty(e-a,n-a);return}else o.dirty=o.dom==o.contentDOM&&o.dom.parentNode==this.contentDOM&&!o.children.length?Mn:yt}r=s}this.dirty=Mn}markParentsDirty(){let e=1;for(let n=this.parent;n;n=n.parent,e++){let r=e==1?Mn:Jh;n.dirty<r&&(n.dirty=r)}}get domAtom(){return!1}get ignoreForCoords(){return!1}get ignoreForSelection(){return!1}isText(e){return!1}},Go=class extends Nn{constructor(e,n,r,i){let o,s=n.type.toDOM;if(typeof s=="function"&&(s=s(r,()=>{if(!o)return i;if(o.parent)return o.parent.posBeforeChild(o)})),!n.type.spec.raw){if(s.nodeType!=1){let a=document.createElement("span");a.appendChild(s),s=a}s.contentEditable="false",s.classList.add("ProseMirror-widget")}super(e,[],s,null),this.widget=n,this.widget=n,o=this}matchesWidget(e){return this.dirty==et&&e.type.eq(this.widget.type)}parseRule(){return{ignore:!0}}stopEvent(e){let n=this.widget.spec.stopEvent;return n?n(e):!1}ignoreMutation(e){return e.type!="selection"||this.widget.spec.ignoreSelection}destroy(){this.widget.type.destroy(this.dom),super.destroy()}get domAtom(){return!0}get ignoreForSelection(){return!!this.widget.type.spec.relaxedSide}get side(){return this.widget.type.side}},oc=class extends Nn{constructor(e,n,r,i){super(e,[],n,null),this.textDOM=r,this.text=i}get size(){return this.text.length}localPosFromDOM(e,n){return e!=this.textDOM?this.posAtStart+(n?this.size:0):this.posAtStart+n}domFromPos(e){return{node:this.textDOM,offset:e}}ignoreMutation(e){return e.type==="characterData"&&e.target.nodeValue==e.oldValue}},Cr=class t extends Nn{constructor(e,n,r,i,o){super(e,[],r,i),this.mark=n,this.spec=o}static create(e,n,r,i){let o=i.nodeViews[n.type.name],s=o&&o(n,i,r);return(!s||!s.dom)&&(s=Jt.renderSpec(document,n.type.spec.toDOM(n,r),null,n.attrs)),new t(e,n,s.dom,s.contentDOM||s.dom,s)}parseRule(){return this.dirty&yt||this.mark.type.spec.reparseInView?null:{mark:this.mark.type.name,attrs:this.mark.attrs,contentElement:this.contentDOM}}matchesMark(e){return this.dirty!=yt&&this.mark.eq(e)}markDirty(e,n){if(super.markDirty(e,n),this.dirty!=et){let r=this.parent;for(;!r.node;)r=r.parent;r.dirty<this.dirty&&(r.dirty=this.dirty),this.dirty=et}}slice(e,n,r){let i=t.create(this.parent,this.mark,!0,r),o=this.children,s=this.size;n<s&&(o=cc(o,n,s,r)),e>0&&(o=cc(o,0,e,r));for(let a=0;a<o.length;a++)o[a].parent=i;return i.children=o,i}ignoreMutation(e){return this.spec.ignoreMutation?this.spec.ignoreMutation(e):super.ignoreMutation(e)}destroy(){this.spec.destroy&&this.spec.destroy(),super.destroy()}},Zt=class t extends Nn{constructor(e,n,r,i,o,s,a,l,c){super(e,[],o,s),this.node=n,this.outerDeco=r,this.innerDeco=i,this.nodeDOM=a}static create(e,n,r,i,o,s){let a=o.nodeViews[n.type.name],l,c=a&&a(n,o,()=>{if(!l)return s;if(l.parent)return l.parent.posBeforeChild(l)},r,i),d=c&&c.dom,u=c&&c.contentDOM;if(n.isText){if(!d)d=document.createTextNode(n.text);else if(d.nodeType!=3)throw new RangeError("Text must be rendered as a DOM text node")}else d||({dom:d,contentDOM:u}=Jt.renderSpec(document,n.type.spec.toDOM(n),null,n.attrs));!u&&!n.isText&&d.nodeName!="BR"&&(d.hasAttribute("contenteditable")||(d.contentEditable="false"),n.type.spec.draggable&&(d.draggable=!0));let f=d;return d=Om(d,r,n),c?l=new sc(e,n,r,i,d,u||null,f,c,o,s+1):n.isText?new Yo(e,n,r,i,d,f,o):new t(e,n,r,i,d,u||null,f,o,s+1)}parseRule(){if(this.node.type.spec.reparseInView)return null;let e={node:this.node.type.name,attrs:this.node.attrs};if(this.node.type.whitespace=="pre"&&(e.preserveWhitespace="full"),!this.contentDOM)e.getContent=()=>this.node.content;else if(!this.contentLost)e.contentElement=this.contentDOM;else{for(let n=this.children.length-1;n>=0;n--){let r=this.children[n];if(this.dom.contains(r.dom.parentNode)){e.contentElement=r.dom.parentNode;break}}e.contentElement||(e.getContent=()=>C.empty)}return e}matchesNode(e,n,r){return this.dirty==et&&e.eq(this.node)&&Qo(n,this.outerDeco)&&r.eq(this.innerDeco)}get size(){return this.node.nodeSize}get border(){return this.node.isLeaf?0:1}updateChildren(e,n){let r=this.node.inlineContent,i=n,o=e.composing?this.localCompositionInfo(e,n):null,s=o&&o.pos>-1?o:null,a=o&&o.pos<0,l=new lc(this,s&&s.node,e);iS(this.node,this.innerDeco,(c,d,u)=>{c.spec.marks?l.syncToMarks(c.spec.marks,r,e):c.type.side>=0&&!u&&l.syncToMarks(d==this.node.childCount?q.none:this.node.child(d).marks,r,e),l.placeWidget(c,e,i)},(c,d,u,f)=>{l.syncToMarks(c.marks,r,e);let p;l.findNodeMatch(c,d,u,f)||a&&e.state.selection.from>i&&e.state.selection.to<i+c.nodeSize&&(p=l.findIndexWithChild(o.node))>-1&&l.updateNodeAt(c,d,u,p,e)||l.updateNextNode(c,d,u,e,f,i)||l.addNode(c,d,u,e,i),i+=c.nodeSize}),l.syncToMarks([],r,e),this.node.isTextblock&&l.addTextblockHacks(),l.destroyRest(),(l.changed||this.dirty==Mn)&&(s&&this.protectLocalComposition(e,s),Am(this.contentDOM,this.children,e),xr&&oS(this.dom))}localCompositionInfo(e,n){let{from:r,to:i}=e.state.selection;if(!(e.state.selection instanceof L)||r<n||i>n+this.node.content.size)return null;let o=e.input.compositionNode;if(!o||!this.dom.contains(o.parentNode))return null;if(this.node.inlineContent){let s=o.nodeValue,a=sS(this.node.content,s,r-n,i-n);return a<0?null:{node:o,pos:a,text:s}}else return{node:o,pos:-1,text:""}}protectLocalComposition(e,{node:n,pos:r,text:i}){if(this.getDesc(n))return;let o=n;for(;o.parentNode!=this.contentDOM;o=o.parentNode){for(;o.previousSibling;)o.parentNode.removeChild(o.previousSibling);for(;o.nextSibling;)o.parentNode.removeChild(o.nextSibling);o.pmViewDesc&&(o.pmViewDesc=void 0)}let s=new oc(this,o,n,i);e.input.compositionNodes.push(s),this.children=cc(this.children,r,r+i.length,e,s)}update(e,n,r,i){return this.dirty==yt||!e.sameMarkup(this.node)?!1:(this.updateInner(e,n,r,i),!0)}updateInner(e,n,r,i){this.updateOuterDeco(n),this.node=e,this.innerDeco=r,this.contentDOM&&this.updateChildren(i,this.posAtStart),this.dirty=et}updateOuterDeco(e){if(Qo(e,this.outerDeco))return;let n=this.nodeDOM.nodeType!=1,r=this.dom;this.dom=Rm(this.dom,this.nodeDOM,ac(this.outerDeco,this.node,n),ac(e,this.node,n)),this.dom!=r&&(r.pmViewDesc=void 0,this.dom.pmViewDesc=this),this.outerDeco=e}selectNode(){this.nodeDOM.nodeType==1&&(this.nodeDOM.classList.add("ProseMirror-selectednode"),(this.contentDOM||!this.node.type.spec.draggable)&&(this.nodeDOM.draggable=!0))}deselectNode(){this.nodeDOM.nodeType==1&&(this.nodeDOM.classList.remove("ProseMirror-selectednode"),(this.contentDOM||!this.node.type.spec.draggable)&&this.nodeDOM.removeAttribute("draggable"))}get domAtom(){return this.node.isAtom}};function Gh(t,e,n,r,i){Om(r,e,t);let o=new Zt(void 0,t,e,n,r,r,r,i,0);return o.contentDOM&&o.updateChildren(i,0),o}var Yo=class t extends Zt{constructor(e,n,r,i,o,s,a){super(e,n,r,i,o,null,s,a,0)}parseRule(){let e=this.nodeDOM.parentNode;for(;e&&e!=this.dom&&!e.pmIsDeco;)e=e.parentNode;return{skip:e||!0}}update(e,n,r,i){return this.dirty==yt||this.dirty!=et&&!this.inParent()||!e.sameMarkup(this.node)?!1:(this.updateOuterDeco(n),(this.dirty!=et||e.text!=this.node.text)&&e.text!=this.nodeDOM.nodeValue&&(this.nodeDOM.nodeValue=e.text,i.trackWrites==this.nodeDOM&&(i.trackWrites=null)),this.node=e,this.dirty=et,!0)}inParent(){let e=this.parent.contentDOM;for(let n=this.nodeDOM;n;n=n.parentNode)if(n==e)return!0;return!1}domFromPos(e){return{node:this.nodeDOM,offset:e}}localPosFromDOM(e,n,r){return e==this.nodeDOM?this.posAtStart+Math.min(n,this.node.text.length):super.localPosFromDOM(e,n,r)}ignoreMutation(e){return e.type!="characterData"&&e.type!="selection"}slice(e,n,r){let i=this.node.cut(e,n),o=document.createTextNode(i.text);return new t(this.parent,i,this.outerDeco,this.innerDeco,o,o,r)}markDirty(e,n){super.markDirty(e,n),this.dom!=this.nodeDOM&&(e==0||n==this.nodeDOM.nodeValue.length)&&(this.dirty=yt)}get domAtom(){return!1}isText(e){return this.node.text==e}},Xo=class extends Nn{parseRule(){return{ignore:!0}}matchesHack(e){return this.dirty==et&&this.dom.nodeName==e}get domAtom(){return!0}get ignoreForCoords(){return this.dom.nodeName=="IMG"}},sc=class extends Zt{constructor(e,n,r,i,o,s,a,l,c,d){super(e,n,r,i,o,s,a,c,d),this.spec=l}update(e,n,r,i){if(this.dirty==yt)return!1;if(this.spec.update&&(this.node.type==e.type||this.spec.multiType)){let o=this.spec.update(e,n,r);return o&&this.updateInner(e,n,r,i),o}else return!this.contentDOM&&!e.isLeaf?!1:super.update(e,n,r,i)}selectNode(){this.spec.selectNode?this.spec.selectNode():super.selectNode()}deselectNode(){this.spec.deselectNode?this.spec.deselectNode():super.deselectNode()}setSelection(e,n,r,i){this.spec.setSelection?this.spec.setSelection(e,n,r.root):super.setSelection(e,n,r,i)}destroy(){this.spec.destroy&&this.spec.destroy(),super.destroy()}stopEvent(e){return this.spec.stopEvent?this.spec.stopEvent(e):!1}ignoreMutation(e){return this.spec.ignoreMutation?this.spec.ignoreMutation(e):super.ignoreMutation(e)}};function Am(t,e,n){let r=t.firstChild,i=!1;for(let o=0;o<e.length;o++){let s=e[o],a=s.dom;if(a.parentNode==t){for(;a!=r;)r=Yh(r),i=!0;r=r.nextSibling}else i=!0,t.insertBefore(a,r);if(s instanceof Cr){let l=r?r.previousSibling:t.lastChild;Am(s.contentDOM,s.children,n),r=l?l.nextSibling:t.firstChild}}for(;r;)r=Yh(r),i=!0;i&&n.trackWrites==t&&(n.trackWrites=null)}var gi=function(t){t&&(this.nodeName=t)};gi.prototype=Object.create(null);var wn=[new gi];function ac(t,e,n){if(t.length==0)return wn;let r=n?wn[0]:new gi,i=[r];for(let o=0;o<t.length;o++){let s=t[o].type.attrs;if(s){s.nodeName&&i.push(r=new gi(s.nodeName));for(let a in s){let l=s[a];l!=null&&(n&&i.length==1&&i.push(r=new gi(e.isInline?"span":"div")),a=="class"?r.class=(r.class?r.class+" ":"")+l:a=="style"?r.style=(r.style?r.style+";":"")+l:a!="nodeName"&&(r[a]=l))}}}return i}function Rm(t,e,n,r){if(n==wn&&r==wn)return e;let i=e;for(let o=0;o<r.length;o++){let s=r[o],a=n[o];if(o){let l;a&&a.nodeName==s.nodeName&&i!=t&&(l=i.parentNode)&&l.nodeName.toLowerCase()==s.nodeName||(l=document.createElement(s.nodeName),l.pmIsDeco=!0,l.appendChild(i),a=wn[0]),i=l}tS(i,a||wn[0],s)}return i}function tS(t,e,n){for(let r in e)r!="class"&&r!="style"&&r!="nodeName"&&!(r in n)&&t.removeAttribute(r);for(let r in n)r!="class"&&r!="style"&&r!="nodeName"&&n[r]!=e[r]&&t.setAttribute(r,n[r]);if(e.class!=n.class){let r=e.class?e.class.split(" ").filter(Boolean):[],i=n.class?n.class.split(" ").filter(Boolean):[];for(let o=0;o<r.length;o++)i.indexOf(r[o])==-1&&t.classList.remove(r[o]);for(let o=0;o<i.length;o++)r.indexOf(i[o])==-1&&t.classList.add(i[o]);t.classList.length==0&&t.removeAttribute("class")}if(e.style!=n.style){if(e.style){let r=/\s*([\w\-\xa1-\uffff]+)\s*:(?:"(?:\\.|[^"])*"|'(?:\\.|[^'])*'|\(.*?\)|[^;])*/g,i;for(;i=r.exec(e.style);)t.style.removeProperty(i[1])}n.style&&(t.style.cssText+=n.style)}}function Om(t,e,n){return Rm(t,t,wn,ac(e,n,t.nodeType!=1))}function Qo(t,e){if(t.length!=e.length)return!1;for(let n=0;n<t.length;n++)if(!t[n].type.eq(e[n].type))return!1;return!0}function Yh(t){let e=t.nextSibling;return t.parentNode.removeChild(t),e}var lc=class{constructor(e,n,r){this.lock=n,this.view=r,this.index=0,this.stack=[],this.changed=!1,this.top=e,this.preMatch=nS(e.node.content,e)}destroyBetween(e,n){if(e!=n){for(let r=e;r<n;r++)this.top.children[r].destroy();this.top.children.splice(e,n-e),this.changed=!0}}destroyRest(){this.destroyBetween(this.index,this.top.children.length)}syncToMarks(e,n,r){let i=0,o=this.stack.length>>1,s=Math.min(o,e.length);for(;i<s&&(i==o-1?this.top:this.stack[i+1<<1]).matchesMark(e[i])&&e[i].type.spec.spanning!==!1;)i++;for(;i<o;)this.destroyRest(),this.top.dirty=et,this.index=this.stack.pop(),this.top=this.stack.pop(),o--;for(;o<e.length;){this.stack.push(this.top,this.index+1);let a=-1;for(let l=this.index;l<Math.min(this.index+3,this.top.children.length);l++){let c=this.top.children[l];if(c.matchesMark(e[o])&&!this.isLocked(c.dom)){a=l;break}}if(a>-1)a>this.index&&(this.changed=!0,this.destroyBetween(this.index,a)),this.top=this.top.children[this.index];else{let l=Cr.create(this.top,e[o],n,r);this.top.children.splice(this.index,0,l),this.top=l,this.changed=!0}this.index=0,o++}}findNodeMatch(e,n,r,i){let o=-1,s;if(i>=this.preMatch.index&&(s=this.preMatch.matches[i-this.preMatch.index]).parent==this.top&&s.matchesNode(e,n,r))o=this.top.children.indexOf(s,this.index);else for(let a=this.index,l=Math.min(this.top.children.length,a+5);a<l;a++){let c=this.top.children[a];if(c.matchesNode(e,n,r)&&!this.preMatch.matched.has(c)){o=a;break}}return o<0?!1:(this.destroyBetween(this.index,o),this.index++,!0)}updateNodeAt(e,n,r,i,o){let s=this.top.children[i];return s.dirty==yt&&s.dom==s.contentDOM&&(s.dirty=Mn),s.update(e,n,r,o)?(this.destroyBetween(this.index,i),this.index++,!0):!1}findIndexWithChild(e){for(;;){let n=e.parentNode;if(!n)return-1;if(n==this.top.contentDOM){let r=e.pmViewDesc;if(r){for(let i=this.index;i<this.top.children.length;i++)if(this.top.children[i]==r)return i}return-1}e=n}}updateNextNode(e,n,r,i,o,s){for(let a=this.index;a<this.top.children.length;a++){let l=this.top.children[a];if(l instanceof Zt){let c=this.preMatch.matched.get(l);if(c!=null&&c!=o)return!1;let d=l.dom,u,f=this.isLocked(d)&&!(e.isText&&l.node&&l.node.isText&&l.nodeDOM.nodeValue==e.text&&l.dirty!=yt&&Qo(n,l.outerDeco));if(!f&&l.update(e,n,r,i))return this.destroyBetween(this.index,a),l.dom!=d&&(this.changed=!0),this.index++,!0;if(!f&&(u=this.recreateWrapper(l,e,n,r,i,s)))return this.destroyBetween(this.index,a),this.top.children[this.index]=u,u.contentDOM&&(u.dirty=Mn,u.updateChildren(i,s+1),u.dirty=et),this.changed=!0,this.index++,!0;break}}return!1}recreateWrapper(e,n,r,i,o,s){if(e.dirty||n.isAtom||!e.children.length||!e.node.content.eq(n.content)||!Qo(r,e.outerDeco)||!i.eq(e.innerDeco))return null;let a=Zt.create(this.top,n,r,i,o,s);if(a.contentDOM){a.children=e.children,e.children=[];for(let l of a.children)l.parent=a}return e.destroy(),a}addNode(e,n,r,i,o){let s=Zt.create(this.top,e,n,r,i,o);s.contentDOM&&s.updateChildren(i,o+1),this.top.children.splice(this.index++,0,s),this.changed=!0}placeWidget(e,n,r){let i=this.index<this.top.children.length?this.top.children[this.index]:null;if(i&&i.matchesWidget(e)&&(e==i.widget||!i.widget.type.toDOM.parentNode))this.index++;else{let o=new Go(this.top,e,n,r);this.top.children.splice(this.index++,0,o),this.changed=!0}}addTextblockHacks(){let e=this.top.children[this.index-1],n=this.top;for(;e instanceof Cr;)n=e,e=n.children[n.children.length-1];(!e||!(e instanceof Yo)||/\n$/.test(e.node.text)||this.view.requiresGeckoHackNode&&/\s$/.test(e.node.text))&&((Me||Ce)&&e&&e.dom.contentEditable=="false"&&this.addHackNode("IMG",n),this.addHackNode("BR",this.top))}addHackNode(e,n){if(n==this.top&&this.index<n.children.length&&n.children[this.index].matchesHack(e))this.index++;else{let r=document.createElement(e);e=="IMG"&&(r.className="ProseMirror-separator",r.alt=""),e=="BR"&&(r.className="ProseMirror-trailingBreak");let i=new Xo(this.top,[],r,null);n!=this.top?n.children.push(i):n.children.splice(this.index++,0,i),this.changed=!0}}isLocked(e){return this.lock&&(e==this.lock||e.nodeType==1&&e.contains(this.lock.parentNode))}};function nS(t,e){let n=e,r=n.children.length,i=t.childCount,o=new Map,s=[];e:for(;i>0;){let a;for(;;)if(r){let c=n.children[r-1];if(c instanceof Cr)n=c,r=c.children.length;else{a=c,r--;break}}else{if(n==e)break e;r=n.parent.children.indexOf(n),n=n.parent}let l=a.node;if(l){if(l!=t.child(i-1))break;--i,o.set(a,i),s.push(a)}}return{index:i,matched:o,matches:s.reverse()}}function rS(t,e){return t.type.side-e.type.side}function iS(t,e,n,r){let i=e.locals(t),o=0;if(i.length==0){for(let c=0;c<t.childCount;c++){let d=t.child(c);r(d,i,e.forChild(o,d),c),o+=d.nodeSize}return}let s=0,a=[],l=null;for(let c=0;;){let d,u;for(;s<i.length&&i[s].to==o;){let g=i[s++];g.widget&&(d?(u||(u=[d])).push(g):d=g)}if(d)if(u){u.sort(rS);for(let g=0;g<u.length;g++)n(u[g],c,!!l)}else n(d,c,!!l);let f,p;if(l)p=-1,f=l,l=null;else if(c<t.childCount)p=c,f=t.child(c++);else break;for(let g=0;g<a.length;g++)a[g].to<=o&&a.splice(g--,1);for(;s<i.length&&i[s].from<=o&&i[s].to>o;)a.push(i[s++]);let h=o+f.nodeSize;if(f.isText){let g=h;s<i.length&&i[s].from<g&&(g=i[s].from);for(let y=0;y<a.length;y++)a[y].to<g&&(g=a[y].to);g<h&&(l=f.cut(g-o),f=f.cut(0,g-o),h=g,p=-1)}else for(;s<i.length&&i[s].to<h;)s++;let m=f.isInline&&!f.isLeaf?a.filter(g=>!g.inline):a.slice();r(f,m,e.forChild(o,f),p),o=h}}function oS(t){if(t.nodeName=="UL"||t.nodeName=="OL"){let e=t.style.cssText;t.style.cssText=e+"; list-style: square !important",window.getComputedStyle(t).listStyle,t.style.cssText=e}}function sS(t,e,n,r){for(let i=0,o=0;i<t.childCount&&o<=r;){let s=t.child(i++),a=o;if(o+=s.nodeSize,!s.isText)continue;let l=s.text;for(;i<t.childCount;){let c=t.child(i++);if(o+=c.nodeSize,!c.isText)break;l+=c.text}if(o>=n){if(o>=r&&l.slice(r-e.length-a,r-a)==e)return r-e.length;let c=a<r?l.lastIndexOf(e,r-a-1):-1;if(c>=0&&c+e.length+a>=n)return a+c;if(n==r&&l.length>=r+e.length-a&&l.slice(r-a,r-a+e.length)==e)return r}}return-1}function cc(t,e,n,r,i){let o=[];for(let s=0,a=0;s<t.length;s++){let l=t[s],c=a,d=a+=l.size;c>=n||d<=e?o.push(l):(c<e&&o.push(l.slice(0,e-c,r)),i&&(o.push(i),i=void 0),d>n&&o.push(l.slice(n-c,l.size,r)))}return o}function xc(t,e=null){let n=t.domSelectionRange(),r=t.state.doc;if(!n.focusNode)return null;let i=t.docView.nearestDesc(n.focusNode),o=i&&i.size==0,s=t.docView.posFromDOM(n.focusNode,n.focusOffset,1);if(s<0)return null;let a=r.resolve(s),l,c;if(as(n)){for(l=s;i&&!i.node;)i=i.parent;let u=i.node;if(i&&u.isAtom&&O.isSelectable(u)&&i.parent&&!(u.isInline&&DT(n.focusNode,n.focusOffset,i.dom))){let f=i.posBefore;c=new O(s==f?a:r.resolve(f))}}else{if(n instanceof t.dom.ownerDocument.defaultView.Selection&&n.rangeCount>1){let u=s,f=s;for(let p=0;p<n.rangeCount;p++){let h=n.getRangeAt(p);u=Math.min(u,t.docView.posFromDOM(h.startContainer,h.startOffset,1)),f=Math.max(f,t.docView.posFromDOM(h.endContainer,h.endOffset,-1))}if(u<0)return null;[l,s]=f==t.state.selection.anchor?[f,u]:[u,f],a=r.resolve(s)}else l=t.docView.posFromDOM(n.anchorNode,n.anchorOffset,1);if(l<0)return null}let d=r.resolve(l);if(!c){let u=e=="pointer"||t.state.selection.head<a.pos&&!o?1:-1;c=Cc(t,d,a,u)}return c}function Lm(t){return t.editable?t.hasFocus():Im(t)&&document.activeElement&&document.activeElement.contains(t.dom)}function Lt(t,e=!1){let n=t.state.selection;if(Nm(t,n),!!Lm(t)){if(!e&&t.input.mouseDown&&t.input.mouseDown.allowDefault&&Ce){let r=t.domSelectionRange(),i=t.domObserver.currentSelection;if(r.anchorNode&&i.anchorNode&&Ln(r.anchorNode,r.anchorOffset,i.anchorNode,i.anchorOffset)){t.input.mouseDown.delayedSelectionSync=!0,t.domObserver.setCurSelection();return}}if(t.domObserver.disconnectSelection(),t.cursorWrapper)lS(t);else{let{anchor:r,head:i}=n,o,s;Xh&&!(n instanceof L)&&(n.$from.parent.inlineContent||(o=Qh(t,n.from)),!n.empty&&!n.$from.parent.inlineContent&&(s=Qh(t,n.to))),t.docView.setSelection(r,i,t,e),Xh&&(o&&Zh(o),s&&Zh(s)),n.visible?t.dom.classList.remove("ProseMirror-hideselection"):(t.dom.classList.add("ProseMirror-hideselection"),"onselectionchange"in document&&aS(t))}t.domObserver.setCurSelection(),t.domObserver.connectSelection()}}var Xh=Me||Ce&&Cm<63;function Qh(t,e){let{node:n,offset:r}=t.docView.domFromPos(e,0),i=r<n.childNodes.length?n.childNodes[r]:null,o=r?n.childNodes[r-1]:null;if(Me&&i&&i.contentEditable=="false")return Xl(i);if((!i||i.contentEditable=="false")&&(!o||o.contentEditable=="false")){if(i)return Xl(i);if(o)return Xl(o)}}function Xl(t){return t.contentEditable="true",Me&&t.draggable&&(t.draggable=!1,t.wasDraggable=!0),t}function Zh(t){t.contentEditable="false",t.wasDraggable&&(t.draggable=!0,t.wasDraggable=null)}function aS(t){let e=t.dom.ownerDocument;e.removeEventListener("selectionchange",t.input.hideSelectionGuard);let n=t.domSelectionRange(),r=n.anchorNode,i=n.anchorOffset;e.addEventListener("selectionchange",t.input.hideSelectionGuard=()=>{(n.anchorNode!=r||n.anchorOffset!=i)&&(e.removeEventListener("selectionchange",t.input.hideSelectionGuard),setTimeout(()=>{(!Lm(t)||t.state.selection.visible)&&t.dom.classList.remove("ProseMirror-hideselection")},20))})}function lS(t){let e=t.domSelection();if(!e)return;let n=t.cursorWrapper.dom,r=n.nodeName=="IMG";r?e.collapse(n.parentNode,me(n)+1):e.collapse(n,0),!r&&!t.state.selection.visible&&He&&Qt<=11&&(n.disabled=!0,n.disabled=!1)}function Nm(t,e){if(e instanceof O){let n=t.docView.descAt(e.from);n!=t.lastSelectedViewDesc&&(em(t),n&&n.selectNode(),t.lastSelectedViewDesc=n)}else em(t)}function em(t){t.lastSelectedViewDesc&&(t.lastSelectedViewDesc.parent&&t.lastSelectedViewDesc.deselectNode(),t.lastSelectedViewDesc=void 0)}function Cc(t,e,n,r){return t.someProp("createSelectionBetween",i=>i(t,e,n))||L.between(e,n,r)}function tm(t){return t.editable&&!t.hasFocus()?!1:Im(t)}function Im(t){let e=t.domSelectionRange();if(!e.anchorNode)return!1;try{return t.dom.contains(e.anchorNode.nodeType==3?e.anchorNode.parentNode:e.anchorNode)&&(t.editable||t.dom.contains(e.focusNode.nodeType==3?e.focusNode.parentNode:e.focusNode))}catch{return!1}}function cS(t){let e=t.docView.domFromPos(t.state.selection.anchor,0),n=t.domSelectionRange();return Ln(e.node,e.offset,n.anchorNode,n.anchorOffset)}function dc(t,e){let{$anchor:n,$head:r}=t.selection,i=e>0?n.max(r):n.min(r),o=i.parent.inlineContent?i.depth?t.doc.resolve(e>0?i.after():i.before()):null:i;return o&&D.findFrom(o,e)}function Yt(t,e){return t.dispatch(t.state.tr.setSelection(e).scrollIntoView()),!0}function nm(t,e,n){let r=t.state.selection;if(r instanceof L)if(n.indexOf("s")>-1){let{$head:i}=r,o=i.textOffset?null:e<0?i.nodeBefore:i.nodeAfter;if(!o||o.isText||!o.isLeaf)return!1;let s=t.state.doc.resolve(i.pos+o.nodeSize*(e<0?-1:1));return Yt(t,new L(r.$anchor,s))}else if(r.empty){if(t.endOfTextblock(e>0?"forward":"backward")){let i=dc(t.state,e);return i&&i instanceof O?Yt(t,i):!1}else if(!(Xe&&n.indexOf("m")>-1)){let i=r.$head,o=i.textOffset?null:e<0?i.nodeBefore:i.nodeAfter,s;if(!o||o.isText)return!1;let a=e<0?i.pos-o.nodeSize:i.pos;return o.isAtom||(s=t.docView.descAt(a))&&!s.contentDOM?O.isSelectable(o)?Yt(t,new O(e<0?t.state.doc.resolve(i.pos-o.nodeSize):i)):vi?Yt(t,new L(t.state.doc.resolve(e<0?a:a+o.nodeSize))):!1:!1}}else return!1;else{if(r instanceof O&&r.node.isInline)return Yt(t,new L(e>0?r.$to:r.$from));{let i=dc(t.state,e);return i?Yt(t,i):!1}}}function Zo(t){return t.nodeType==3?t.nodeValue.length:t.childNodes.length}function yi(t,e){let n=t.pmViewDesc;return n&&n.size==0&&(e<0||t.nextSibling||t.nodeName!="BR")}function gr(t,e){return e<0?dS(t):uS(t)}function dS(t){let e=t.domSelectionRange(),n=e.focusNode,r=e.focusOffset;if(!n)return;let i,o,s=!1;for(Ze&&n.nodeType==1&&r<Zo(n)&&yi(n.childNodes[r],-1)&&(s=!0);;)if(r>0){if(n.nodeType!=1)break;{let a=n.childNodes[r-1];if(yi(a,-1))i=n,o=--r;else if(a.nodeType==3)n=a,r=n.nodeValue.length;else break}}else{if(Pm(n))break;{let a=n.previousSibling;for(;a&&yi(a,-1);)i=n.parentNode,o=me(a),a=a.previousSibling;if(a)n=a,r=Zo(n);else{if(n=n.parentNode,n==t.dom)break;r=0}}}s?uc(t,n,r):i&&uc(t,i,o)}function uS(t){let e=t.domSelectionRange(),n=e.focusNode,r=e.focusOffset;if(!n)return;let i=Zo(n),o,s;for(;;)if(r<i){if(n.nodeType!=1)break;let a=n.childNodes[r];if(yi(a,1))o=n,s=++r;else break}else{if(Pm(n))break;{let a=n.nextSibling;for(;a&&yi(a,1);)o=a.parentNode,s=me(a)+1,a=a.nextSibling;if(a)n=a,r=0,i=Zo(n);else{if(n=n.parentNode,n==t.dom)break;r=i=0}}}o&&uc(t,o,s)}function Pm(t){let e=t.pmViewDesc;return e&&e.node&&e.node.isBlock}function fS(t,e){for(;t&&e==t.childNodes.length&&!Ci(t);)e=me(t)+1,t=t.parentNode;for(;t&&e<t.childNodes.length;){let n=t.childNodes[e];if(n.nodeType==3)return n;if(n.nodeType==1&&n.contentEditable=="false")break;t=n,e=0}}function pS(t,e){for(;t&&!e&&!Ci(t);)e=me(t),t=t.parentNode;for(;t&&e;){let n=t.childNodes[e-1];if(n.nodeType==3)return n;if(n.nodeType==1&&n.contentEditable=="false")break;t=n,e=t.childNodes.length}}function uc(t,e,n){if(e.nodeType!=3){let o,s;(s=fS(e,n))?(e=s,n=0):(o=pS(e,n))&&(e=o,n=o.nodeValue.length)}let r=t.domSelection();if(!r)return;if(as(r)){let o=document.createRange();o.setEnd(e,n),o.setStart(e,n),r.removeAllRanges(),r.addRange(o)}else r.extend&&r.extend(e,n);t.domObserver.setCurSelection();let{state:i}=t;setTimeout(()=>{t.state==i&&Lt(t)},50)}function rm(t,e){let n=t.state.doc.resolve(e);if(!(Ce||_T)&&n.parent.inlineContent){let i=t.coordsAtPos(e);if(e>n.start()){let o=t.coordsAtPos(e-1),s=(o.top+o.bottom)/2;if(s>i.top&&s<i.bottom&&Math.abs(o.left-i.left)>1)return o.left<i.left?"ltr":"rtl"}if(e<n.end()){let o=t.coordsAtPos(e+1),s=(o.top+o.bottom)/2;if(s>i.top&&s<i.bottom&&Math.abs(o.left-i.left)>1)return o.left>i.left?"ltr":"rtl"}}return getComputedStyle(t.dom).direction=="rtl"?"rtl":"ltr"}function im(t,e,n){let r=t.state.selection;if(r instanceof L&&!r.empty||n.indexOf("s")>-1||Xe&&n.indexOf("m")>-1)return!1;let{$from:i,$to:o}=r;if(!i.parent.inlineContent||t.endOfTextblock(e<0?"up":"down")){let s=dc(t.state,e);if(s&&s instanceof O)return Yt(t,s)}if(!i.parent.inlineContent){let s=e<0?i:o,a=r instanceof ke?D.near(s,e):D.findFrom(s,e);return a?Yt(t,a):!1}return!1}function om(t,e){if(!(t.state.selection instanceof L))return!0;let{$head:n,$anchor:r,empty:i}=t.state.selection;if(!n.sameParent(r))return!0;if(!i)return!1;if(t.endOfTextblock(e>0?"forward":"backward"))return!0;let o=!n.textOffset&&(e<0?n.nodeBefore:n.nodeAfter);if(o&&!o.isText){let s=t.state.tr;return e<0?s.delete(n.pos-o.nodeSize,n.pos):s.delete(n.pos,n.pos+o.nodeSize),t.dispatch(s),!0}return!1}function sm(t,e,n){t.domObserver.stop(),e.contentEditable=n,t.domObserver.start()}function hS(t){if(!Me||t.state.selection.$head.parentOffset>0)return!1;let{focusNode:e,focusOffset:n}=t.domSelectionRange();if(e&&e.nodeType==1&&n==0&&e.firstChild&&e.firstChild.contentEditable=="false"){let r=e.firstChild;sm(t,r,"true"),setTimeout(()=>sm(t,r,"false"),20)}return!1}function mS(t){let e="";return t.ctrlKey&&(e+="c"),t.metaKey&&(e+="m"),t.altKey&&(e+="a"),t.shiftKey&&(e+="s"),e}function gS(t,e){let n=e.keyCode,r=mS(e);if(n==8||Xe&&n==72&&r=="c")return om(t,-1)||gr(t,-1);if(n==46&&!e.shiftKey||Xe&&n==68&&r=="c")return om(t,1)||gr(t,1);if(n==13||n==27)return!0;if(n==37||Xe&&n==66&&r=="c"){let i=n==37?rm(t,t.state.selection.from)=="ltr"?-1:1:-1;return nm(t,i,r)||gr(t,i)}else if(n==39||Xe&&n==70&&r=="c"){let i=n==39?rm(t,t.state.selection.from)=="ltr"?1:-1:1;return nm(t,i,r)||gr(t,i)}else{if(n==38||Xe&&n==80&&r=="c")return im(t,-1,r)||gr(t,-1);if(n==40||Xe&&n==78&&r=="c")return hS(t)||im(t,1,r)||gr(t,1);if(r==(Xe?"m":"c")&&(n==66||n==73||n==89||n==90))return!0}return!1}function vc(t,e){t.someProp("transformCopied",p=>{e=p(e,t)});let n=[],{content:r,openStart:i,openEnd:o}=e;for(;i>1&&o>1&&r.childCount==1&&r.firstChild.childCount==1;){i--,o--;let p=r.firstChild;n.push(p.type.name,p.attrs!=p.type.defaultAttrs?p.attrs:null),r=p.content}let s=t.someProp("clipboardSerializer")||Jt.fromSchema(t.state.schema),a=zm(),l=a.createElement("div");l.appendChild(s.serializeFragment(r,{document:a}));let c=l.firstChild,d,u=0;for(;c&&c.nodeType==1&&(d=Bm[c.nodeName.toLowerCase()]);){for(let p=d.length-1;p>=0;p--){let h=a.createElement(d[p]);for(;l.firstChild;)h.appendChild(l.firstChild);l.appendChild(h),u++}c=l.firstChild}c&&c.nodeType==1&&c.setAttribute("data-pm-slice",`${i} ${o}${u?` -${u}`:""} ${JSON.stringify(n)}`);let f=t.someProp("clipboardTextSerializer",p=>p(e,t))||e.content.textBetween(0,e.content.size,`
 
-`);return{dom:a,text:f,slice:e}}function Td(t,e,n,r,i){let o=i.parent.type.spec.code,s,l;if(!n&&!e)return null;let a=!!e&&(r||o||!n);if(a){if(t.someProp("transformPastedText",f=>{e=f(e,o||r,t)}),o)return l=new x(v.from(t.state.schema.text(e.replace(/\r\n?/g,`
-`))),0,0),t.someProp("transformPasted",f=>{l=f(l,t,!0)}),l;let u=t.someProp("clipboardTextParser",f=>f(e,i,r,t));if(u)l=u;else{let f=i.marks(),{schema:h}=t.state,p=Ge.fromSchema(h);s=document.createElement("div"),e.split(/(?:\r\n?|\n)+/).forEach(m=>{let g=s.appendChild(document.createElement("p"));m&&g.appendChild(p.serializeNode(h.text(m,f)))})}}else t.someProp("transformPastedHTML",u=>{n=u(n,t)}),s=mm(n),In&&gm(s);let c=s&&s.querySelector("[data-pm-slice]"),d=c&&/^(\d+) (\d+)(?: -(\d+))? (.*)/.exec(c.getAttribute("data-pm-slice")||"");if(d&&d[3])for(let u=+d[3];u>0;u--){let f=s.firstChild;for(;f&&f.nodeType!=1;)f=f.nextSibling;if(!f)break;s=f}if(l||(l=(t.someProp("clipboardParser")||t.someProp("domParser")||He.fromSchema(t.state.schema)).parseSlice(s,{preserveWhitespace:!!(a||d),context:i,ruleFromNode(f){return f.nodeName=="BR"&&!f.nextSibling&&f.parentNode&&!fm.test(f.parentNode.nodeName)?{ignore:!0}:null}})),d)l=ym(Zc(l,+d[1],+d[2]),d[4]);else if(l=x.maxOpen(hm(l.content,i),!0),l.openStart||l.openEnd){let u=0,f=0;for(let h=l.content.firstChild;u<l.openStart&&!h.type.spec.isolating;u++,h=h.firstChild);for(let h=l.content.lastChild;f<l.openEnd&&!h.type.spec.isolating;f++,h=h.lastChild);l=Zc(l,u,f)}return t.someProp("transformPasted",u=>{l=u(l,t,a)}),l}var fm=/^(a|abbr|acronym|b|cite|code|del|em|i|ins|kbd|label|output|q|ruby|s|samp|span|strong|sub|sup|time|u|tt|var)$/i;function hm(t,e){if(t.childCount<2)return t;for(let n=e.depth;n>=0;n--){let i=e.node(n).contentMatchAt(e.index(n)),o,s=[];if(t.forEach(l=>{if(!s)return;let a=i.findWrapping(l.type),c;if(!a)return s=null;if(c=s.length&&o.length&&Od(a,o,l,s[s.length-1],0))s[s.length-1]=c;else{s.length&&(s[s.length-1]=Rd(s[s.length-1],o.length));let d=Ad(l,a);s.push(d),i=i.matchType(d.type),o=a}}),s)return v.from(s)}return t}function Ad(t,e,n=0){for(let r=e.length-1;r>=n;r--)t=e[r].create(null,v.from(t));return t}function Od(t,e,n,r,i){if(i<t.length&&i<e.length&&t[i]==e[i]){let o=Od(t,e,n,r.lastChild,i+1);if(o)return r.copy(r.content.replaceChild(r.childCount-1,o));if(r.contentMatchAt(r.childCount).matchType(i==t.length-1?n.type:t[i+1]))return r.copy(r.content.append(v.from(Ad(n,t,i+1))))}}function Rd(t,e){if(e==0)return t;let n=t.content.replaceChild(t.childCount-1,Rd(t.lastChild,e-1)),r=t.contentMatchAt(t.childCount).fillBefore(v.empty,!0);return t.copy(n.append(r))}function ns(t,e,n,r,i,o){let s=e<0?t.firstChild:t.lastChild,l=s.content;return t.childCount>1&&(o=0),i<r-1&&(l=ns(l,e,n,r,i+1,o)),i>=n&&(l=e<0?s.contentMatchAt(0).fillBefore(l,o<=i).append(l):l.append(s.contentMatchAt(s.childCount).fillBefore(v.empty,!0))),t.replaceChild(e<0?0:t.childCount-1,s.copy(l))}function Zc(t,e,n){return e<t.openStart&&(t=new x(ns(t.content,-1,e,t.openStart,0,t.openEnd),e,t.openEnd)),n<t.openEnd&&(t=new x(ns(t.content,1,n,t.openEnd,0,0),t.openStart,n)),t}var Nd={thead:["table"],tbody:["table"],tfoot:["table"],caption:["table"],colgroup:["table"],col:["table","colgroup"],tr:["table","tbody"],td:["table","tbody","tr"],th:["table","tbody","tr"]},ed=null;function Ld(){return ed||(ed=document.implementation.createHTMLDocument("title"))}var $o=null;function pm(t){let e=window.trustedTypes;return e?($o||($o=e.defaultPolicy||e.createPolicy("ProseMirrorClipboard",{createHTML:n=>n})),$o.createHTML(t)):t}function mm(t){let e=/^(\s*<meta [^>]*>)*/.exec(t);e&&(t=t.slice(e[0].length));let n=Ld().createElement("div"),r=/<([a-z][^>\s]+)/i.exec(t),i;if((i=r&&Nd[r[1].toLowerCase()])&&(t=i.map(o=>"<"+o+">").join("")+t+i.map(o=>"</"+o+">").reverse().join("")),n.innerHTML=pm(t),i)for(let o=0;o<i.length;o++)n=n.querySelector(i[o])||n;return n}function gm(t){let e=t.querySelectorAll(ie?"span:not([class]):not([style])":"span.Apple-converted-space");for(let n=0;n<e.length;n++){let r=e[n];r.childNodes.length==1&&r.textContent=="\xA0"&&r.parentNode&&r.parentNode.replaceChild(t.ownerDocument.createTextNode(" "),r)}}function ym(t,e){if(!t.size)return t;let n=t.content.firstChild.type.schema,r;try{r=JSON.parse(e)}catch{return t}let{content:i,openStart:o,openEnd:s}=t;for(let l=r.length-2;l>=0;l-=2){let a=n.nodes[r[l]];if(!a||a.hasRequiredAttrs())break;i=v.from(a.create(r[l+1],i)),o++,s++}return new x(i,o,s)}var de={},ue={},bm={touchstart:!0,touchmove:!0},rs=class{constructor(){this.shiftKey=!1,this.mouseDown=null,this.lastKeyCode=null,this.lastKeyCodeTime=0,this.lastClick={time:0,x:0,y:0,type:"",button:0},this.lastSelectionOrigin=null,this.lastSelectionTime=0,this.lastIOSEnter=0,this.lastIOSEnterFallbackTimeout=-1,this.lastFocus=0,this.lastTouch=0,this.lastChromeDelete=0,this.composing=!1,this.compositionNode=null,this.composingTimeout=-1,this.compositionNodes=[],this.compositionEndedAt=-2e8,this.compositionID=1,this.compositionPendingChanges=0,this.domChangeCount=0,this.eventHandlers=Object.create(null),this.hideSelectionGuard=null}};function vm(t){for(let e in de){let n=de[e];t.dom.addEventListener(e,t.input.eventHandlers[e]=r=>{xm(t,r)&&!ps(t,r)&&(t.editable||!(r.type in ue))&&n(t,r)},bm[e]?{passive:!0}:void 0)}ce&&t.dom.addEventListener("input",()=>null),is(t)}function Xe(t,e){t.input.lastSelectionOrigin=e,t.input.lastSelectionTime=Date.now()}function km(t){t.domObserver.stop();for(let e in t.input.eventHandlers)t.dom.removeEventListener(e,t.input.eventHandlers[e]);clearTimeout(t.input.composingTimeout),clearTimeout(t.input.lastIOSEnterFallbackTimeout)}function is(t){t.someProp("handleDOMEvents",e=>{for(let n in e)t.input.eventHandlers[n]||t.dom.addEventListener(n,t.input.eventHandlers[n]=r=>ps(t,r))})}function ps(t,e){return t.someProp("handleDOMEvents",n=>{let r=n[e.type];return r?r(t,e)||e.defaultPrevented:!1})}function xm(t,e){if(!e.bubbles)return!0;if(e.defaultPrevented)return!1;for(let n=e.target;n!=t.dom;n=n.parentNode)if(!n||n.nodeType==11||n.pmViewDesc&&n.pmViewDesc.stopEvent(e))return!1;return!0}function Cm(t,e){!ps(t,e)&&de[e.type]&&(t.editable||!(e.type in ue))&&de[e.type](t,e)}ue.keydown=(t,e)=>{let n=e;if(t.input.shiftKey=n.keyCode==16||n.shiftKey,!Id(t,n)&&(t.input.lastKeyCode=n.keyCode,t.input.lastKeyCodeTime=Date.now(),!($e&&ie&&n.keyCode==13)))if(n.keyCode!=229&&t.domObserver.forceFlush(),Zt&&n.keyCode==13&&!n.ctrlKey&&!n.altKey&&!n.metaKey){let r=Date.now();t.input.lastIOSEnter=r,t.input.lastIOSEnterFallbackTimeout=setTimeout(()=>{t.input.lastIOSEnter==r&&(t.someProp("handleKeyDown",i=>i(t,xt(13,"Enter"))),t.input.lastIOSEnter=0)},200)}else t.someProp("handleKeyDown",r=>r(t,n))||um(t,n)?n.preventDefault():Xe(t,"key")};ue.keyup=(t,e)=>{e.keyCode==16&&(t.input.shiftKey=!1)};ue.keypress=(t,e)=>{let n=e;if(Id(t,n)||!n.charCode||n.ctrlKey&&!n.altKey||ye&&n.metaKey)return;if(t.someProp("handleKeyPress",i=>i(t,n))){n.preventDefault();return}let r=t.state.selection;if(!(r instanceof S)||!r.$from.sameParent(r.$to)){let i=String.fromCharCode(n.charCode),o=()=>t.state.tr.insertText(i).scrollIntoView();!/[\r\n]/.test(i)&&!t.someProp("handleTextInput",s=>s(t,r.$from.pos,r.$to.pos,i,o))&&t.dispatch(o()),n.preventDefault()}};function jr(t){return{left:t.clientX,top:t.clientY}}function Em(t,e){let n=e.x-t.clientX,r=e.y-t.clientY;return n*n+r*r<100}function ms(t,e,n,r,i){if(r==-1)return!1;let o=t.state.doc.resolve(r);for(let s=o.depth+1;s>0;s--)if(t.someProp(e,l=>s>o.depth?l(t,n,o.nodeAfter,o.before(s),i,!0):l(t,n,o.node(s),o.before(s),i,!1)))return!0;return!1}function Qt(t,e,n){if(t.focused||t.focus(),t.state.selection.eq(e))return;let r=t.state.tr.setSelection(e);n=="pointer"&&r.setMeta("pointer",!0),t.dispatch(r)}function Sm(t,e){if(e==-1)return!1;let n=t.state.doc.resolve(e),r=n.nodeAfter;return r&&r.isAtom&&C.isSelectable(r)?(Qt(t,new C(n),"pointer"),!0):!1}function wm(t,e){if(e==-1)return!1;let n=t.state.selection,r,i;n instanceof C&&(r=n.node);let o=t.state.doc.resolve(e);for(let s=o.depth+1;s>0;s--){let l=s>o.depth?o.nodeAfter:o.node(s);if(C.isSelectable(l)){r&&n.$from.depth>0&&s>=n.$from.depth&&o.before(n.$from.depth+1)==n.$from.pos?i=o.before(n.$from.depth):i=o.before(s);break}}return i!=null?(Qt(t,C.create(t.state.doc,i),"pointer"),!0):!1}function Mm(t,e,n,r,i){return ms(t,"handleClickOn",e,n,r)||t.someProp("handleClick",o=>o(t,e,r))||(i?wm(t,n):Sm(t,n))}function Tm(t,e,n,r){return ms(t,"handleDoubleClickOn",e,n,r)||t.someProp("handleDoubleClick",i=>i(t,e,r))}function Am(t,e,n,r){return ms(t,"handleTripleClickOn",e,n,r)||t.someProp("handleTripleClick",i=>i(t,e,r))||Om(t,n,r)}function Om(t,e,n){if(n.button!=0)return!1;let r=t.state.doc;if(e==-1)return r.inlineContent?(Qt(t,S.create(r,0,r.content.size),"pointer"),!0):!1;let i=r.resolve(e);for(let o=i.depth+1;o>0;o--){let s=o>i.depth?i.nodeAfter:i.node(o),l=i.before(o);if(s.inlineContent)Qt(t,S.create(r,l+1,l+1+s.content.size),"pointer");else if(C.isSelectable(s))Qt(t,C.create(r,l),"pointer");else continue;return!0}}function gs(t){return Pr(t)}var _d=ye?"metaKey":"ctrlKey";de.mousedown=(t,e)=>{let n=e;t.input.shiftKey=n.shiftKey;let r=gs(t),i=Date.now(),o="singleClick";i-t.input.lastClick.time<500&&Em(n,t.input.lastClick)&&!n[_d]&&t.input.lastClick.button==n.button&&(t.input.lastClick.type=="singleClick"?o="doubleClick":t.input.lastClick.type=="doubleClick"&&(o="tripleClick")),t.input.lastClick={time:i,x:n.clientX,y:n.clientY,type:o,button:n.button};let s=t.posAtCoords(jr(n));s&&(o=="singleClick"?(t.input.mouseDown&&t.input.mouseDown.done(),t.input.mouseDown=new ss(t,s,n,!!r)):(o=="doubleClick"?Tm:Am)(t,s.pos,s.inside,n)?n.preventDefault():Xe(t,"pointer"))};var ss=class{constructor(e,n,r,i){this.view=e,this.pos=n,this.event=r,this.flushed=i,this.delayedSelectionSync=!1,this.mightDrag=null,this.startDoc=e.state.doc,this.selectNode=!!r[_d],this.allowDefault=r.shiftKey;let o,s;if(n.inside>-1)o=e.state.doc.nodeAt(n.inside),s=n.inside;else{let d=e.state.doc.resolve(n.pos);o=d.parent,s=d.depth?d.before():0}let l=i?null:r.target,a=l?e.docView.nearestDesc(l,!0):null;this.target=a&&a.nodeDOM.nodeType==1?a.nodeDOM:null;let{selection:c}=e.state;(r.button==0&&o.type.spec.draggable&&o.type.spec.selectable!==!1||c instanceof C&&c.from<=s&&c.to>s)&&(this.mightDrag={node:o,pos:s,addAttr:!!(this.target&&!this.target.draggable),setUneditable:!!(this.target&&ve&&!this.target.hasAttribute("contentEditable"))}),this.target&&this.mightDrag&&(this.mightDrag.addAttr||this.mightDrag.setUneditable)&&(this.view.domObserver.stop(),this.mightDrag.addAttr&&(this.target.draggable=!0),this.mightDrag.setUneditable&&setTimeout(()=>{this.view.input.mouseDown==this&&this.target.setAttribute("contentEditable","false")},20),this.view.domObserver.start()),e.root.addEventListener("mouseup",this.up=this.up.bind(this)),e.root.addEventListener("mousemove",this.move=this.move.bind(this)),Xe(e,"pointer")}done(){this.view.root.removeEventListener("mouseup",this.up),this.view.root.removeEventListener("mousemove",this.move),this.mightDrag&&this.target&&(this.view.domObserver.stop(),this.mightDrag.addAttr&&this.target.removeAttribute("draggable"),this.mightDrag.setUneditable&&this.target.removeAttribute("contentEditable"),this.view.domObserver.start()),this.delayedSelectionSync&&setTimeout(()=>je(this.view)),this.view.input.mouseDown=null}up(e){if(this.done(),!this.view.dom.contains(e.target))return;let n=this.pos;this.view.state.doc!=this.startDoc&&(n=this.view.posAtCoords(jr(e))),this.updateAllowDefault(e),this.allowDefault||!n?Xe(this.view,"pointer"):Mm(this.view,n.pos,n.inside,e,this.selectNode)?e.preventDefault():e.button==0&&(this.flushed||ce&&this.mightDrag&&!this.mightDrag.node.isAtom||ie&&!this.view.state.selection.visible&&Math.min(Math.abs(n.pos-this.view.state.selection.from),Math.abs(n.pos-this.view.state.selection.to))<=2)?(Qt(this.view,M.near(this.view.state.doc.resolve(n.pos)),"pointer"),e.preventDefault()):Xe(this.view,"pointer")}move(e){this.updateAllowDefault(e),Xe(this.view,"pointer"),e.buttons==0&&this.done()}updateAllowDefault(e){!this.allowDefault&&(Math.abs(this.event.x-e.clientX)>4||Math.abs(this.event.y-e.clientY)>4)&&(this.allowDefault=!0)}};de.touchstart=t=>{t.input.lastTouch=Date.now(),gs(t),Xe(t,"pointer")};de.touchmove=t=>{t.input.lastTouch=Date.now(),Xe(t,"pointer")};de.contextmenu=t=>gs(t);function Id(t,e){return t.composing?!0:ce&&Math.abs(e.timeStamp-t.input.compositionEndedAt)<500?(t.input.compositionEndedAt=-2e8,!0):!1}var Rm=$e?5e3:-1;ue.compositionstart=ue.compositionupdate=t=>{if(!t.composing){t.domObserver.flush();let{state:e}=t,n=e.selection.$to;if(e.selection instanceof S&&(e.storedMarks||!n.textOffset&&n.parentOffset&&n.nodeBefore.marks.some(r=>r.type.spec.inclusive===!1)))t.markCursor=t.state.storedMarks||n.marks(),Pr(t,!0),t.markCursor=null;else if(Pr(t,!e.selection.empty),ve&&e.selection.empty&&n.parentOffset&&!n.textOffset&&n.nodeBefore.marks.length){let r=t.domSelectionRange();for(let i=r.focusNode,o=r.focusOffset;i&&i.nodeType==1&&o!=0;){let s=o<0?i.lastChild:i.childNodes[o-1];if(!s)break;if(s.nodeType==3){let l=t.domSelection();l&&l.collapse(s,s.nodeValue.length);break}else i=s,o=-1}}t.input.composing=!0}Dd(t,Rm)};ue.compositionend=(t,e)=>{t.composing&&(t.input.composing=!1,t.input.compositionEndedAt=e.timeStamp,t.input.compositionPendingChanges=t.domObserver.pendingRecords().length?t.input.compositionID:0,t.input.compositionNode=null,t.input.compositionPendingChanges&&Promise.resolve().then(()=>t.domObserver.flush()),t.input.compositionID++,Dd(t,20))};function Dd(t,e){clearTimeout(t.input.composingTimeout),e>-1&&(t.input.composingTimeout=setTimeout(()=>Pr(t),e))}function Pd(t){for(t.composing&&(t.input.composing=!1,t.input.compositionEndedAt=Lm());t.input.compositionNodes.length>0;)t.input.compositionNodes.pop().markParentsDirty()}function Nm(t){let e=t.domSelectionRange();if(!e.focusNode)return null;let n=Ap(e.focusNode,e.focusOffset),r=Op(e.focusNode,e.focusOffset);if(n&&r&&n!=r){let i=r.pmViewDesc,o=t.domObserver.lastChangedTextNode;if(n==o||r==o)return o;if(!i||!i.isText(r.nodeValue))return r;if(t.input.compositionNode==r){let s=n.pmViewDesc;if(!(!s||!s.isText(n.nodeValue)))return r}}return n||r}function Lm(){let t=document.createEvent("Event");return t.initEvent("event",!0,!0),t.timeStamp}function Pr(t,e=!1){if(!($e&&t.domObserver.flushingSoon>=0)){if(t.domObserver.forceFlush(),Pd(t),e||t.docView&&t.docView.dirty){let n=us(t),r=t.state.selection;return n&&!n.eq(r)?t.dispatch(t.state.tr.setSelection(n)):(t.markCursor||e)&&!r.$from.node(r.$from.sharedDepth(r.to)).inlineContent?t.dispatch(t.state.tr.deleteSelection()):t.updateState(t.state),!0}return!1}}function _m(t,e){if(!t.dom.parentNode)return;let n=t.dom.parentNode.appendChild(document.createElement("div"));n.appendChild(e),n.style.cssText="position: fixed; left: -10000px; top: 10px";let r=getSelection(),i=document.createRange();i.selectNodeContents(e),t.dom.blur(),r.removeAllRanges(),r.addRange(i),setTimeout(()=>{n.parentNode&&n.parentNode.removeChild(n),t.focus()},50)}var Rn=fe&&Ze<15||Zt&&Ip<604;de.copy=ue.cut=(t,e)=>{let n=e,r=t.state.selection,i=n.type=="cut";if(r.empty)return;let o=Rn?null:n.clipboardData,s=r.content(),{dom:l,text:a}=hs(t,s);o?(n.preventDefault(),o.clearData(),o.setData("text/html",l.innerHTML),o.setData("text/plain",a)):_m(t,l),i&&t.dispatch(t.state.tr.deleteSelection().scrollIntoView().setMeta("uiEvent","cut"))};function Im(t){return t.openStart==0&&t.openEnd==0&&t.content.childCount==1?t.content.firstChild:null}function Dm(t,e){if(!t.dom.parentNode)return;let n=t.input.shiftKey||t.state.selection.$from.parent.type.spec.code,r=t.dom.parentNode.appendChild(document.createElement(n?"textarea":"div"));n||(r.contentEditable="true"),r.style.cssText="position: fixed; left: -10000px; top: 10px",r.focus();let i=t.input.shiftKey&&t.input.lastKeyCode!=45;setTimeout(()=>{t.focus(),r.parentNode&&r.parentNode.removeChild(r),n?Nn(t,r.value,null,i,e):Nn(t,r.textContent,r.innerHTML,i,e)},50)}function Nn(t,e,n,r,i){let o=Td(t,e,n,r,t.state.selection.$from);if(t.someProp("handlePaste",a=>a(t,i,o||x.empty)))return!0;if(!o)return!1;let s=Im(o),l=s?t.state.tr.replaceSelectionWith(s,r):t.state.tr.replaceSelection(o);return t.dispatch(l.scrollIntoView().setMeta("paste",!0).setMeta("uiEvent","paste")),!0}function Hd(t){let e=t.getData("text/plain")||t.getData("Text");if(e)return e;let n=t.getData("text/uri-list");return n?n.replace(/\r?\n/g," "):""}ue.paste=(t,e)=>{let n=e;if(t.composing&&!$e)return;let r=Rn?null:n.clipboardData,i=t.input.shiftKey&&t.input.lastKeyCode!=45;r&&Nn(t,Hd(r),r.getData("text/html"),i,n)?n.preventDefault():Dm(t,n)};var Hr=class{constructor(e,n,r){this.slice=e,this.move=n,this.node=r}},Pm=ye?"altKey":"ctrlKey";function Fd(t,e){let n=t.someProp("dragCopies",r=>!r(e));return n!=null?n:!e[Pm]}de.dragstart=(t,e)=>{let n=e,r=t.input.mouseDown;if(r&&r.done(),!n.dataTransfer)return;let i=t.state.selection,o=i.empty?null:t.posAtCoords(jr(n)),s;if(!(o&&o.pos>=i.from&&o.pos<=(i instanceof C?i.to-1:i.to))){if(r&&r.mightDrag)s=C.create(t.state.doc,r.mightDrag.pos);else if(n.target&&n.target.nodeType==1){let u=t.docView.nearestDesc(n.target,!0);u&&u.node.type.spec.draggable&&u!=t.docView&&(s=C.create(t.state.doc,u.posBefore))}}let l=(s||t.state.selection).content(),{dom:a,text:c,slice:d}=hs(t,l);(!n.dataTransfer.files.length||!ie||hd>120)&&n.dataTransfer.clearData(),n.dataTransfer.setData(Rn?"Text":"text/html",a.innerHTML),n.dataTransfer.effectAllowed="copyMove",Rn||n.dataTransfer.setData("text/plain",c),t.dragging=new Hr(d,Fd(t,n),s)};de.dragend=t=>{let e=t.dragging;window.setTimeout(()=>{t.dragging==e&&(t.dragging=null)},50)};ue.dragover=ue.dragenter=(t,e)=>e.preventDefault();ue.drop=(t,e)=>{let n=e,r=t.dragging;if(t.dragging=null,!n.dataTransfer)return;let i=t.posAtCoords(jr(n));if(!i)return;let o=t.state.doc.resolve(i.pos),s=r&&r.slice;s?t.someProp("transformPasted",p=>{s=p(s,t,!1)}):s=Td(t,Hd(n.dataTransfer),Rn?null:n.dataTransfer.getData("text/html"),!1,o);let l=!!(r&&Fd(t,n));if(t.someProp("handleDrop",p=>p(t,n,s||x.empty,l))){n.preventDefault();return}if(!s)return;n.preventDefault();let a=s?Ar(t.state.doc,o.pos,s):o.pos;a==null&&(a=o.pos);let c=t.state.tr;if(l){let{node:p}=r;p?p.replace(c):c.deleteSelection()}let d=c.mapping.map(a),u=s.openStart==0&&s.openEnd==0&&s.content.childCount==1,f=c.doc;if(u?c.replaceRangeWith(d,d,s.content.firstChild):c.replaceRange(d,d,s),c.doc.eq(f))return;let h=c.doc.resolve(d);if(u&&C.isSelectable(s.content.firstChild)&&h.nodeAfter&&h.nodeAfter.sameMarkup(s.content.firstChild))c.setSelection(new C(h));else{let p=c.mapping.map(a);c.mapping.maps[c.mapping.maps.length-1].forEach((m,g,y,k)=>p=k),c.setSelection(fs(t,h,c.doc.resolve(p)))}t.focus(),t.dispatch(c.setMeta("uiEvent","drop"))};de.focus=t=>{t.input.lastFocus=Date.now(),t.focused||(t.domObserver.stop(),t.dom.classList.add("ProseMirror-focused"),t.domObserver.start(),t.focused=!0,setTimeout(()=>{t.docView&&t.hasFocus()&&!t.domObserver.currentSelection.eq(t.domSelectionRange())&&je(t)},20))};de.blur=(t,e)=>{let n=e;t.focused&&(t.domObserver.stop(),t.dom.classList.remove("ProseMirror-focused"),t.domObserver.start(),n.relatedTarget&&t.dom.contains(n.relatedTarget)&&t.domObserver.currentSelection.clear(),t.focused=!1)};de.beforeinput=(t,e)=>{if(ie&&$e&&e.inputType=="deleteContentBackward"){t.domObserver.flushSoon();let{domChangeCount:r}=t.input;setTimeout(()=>{if(t.input.domChangeCount!=r||(t.dom.blur(),t.focus(),t.someProp("handleKeyDown",o=>o(t,xt(8,"Backspace")))))return;let{$cursor:i}=t.state.selection;i&&i.pos>0&&t.dispatch(t.state.tr.delete(i.pos-1,i.pos).scrollIntoView())},50)}};for(let t in ue)de[t]=ue[t];function Ln(t,e){if(t==e)return!0;for(let n in t)if(t[n]!==e[n])return!1;for(let n in e)if(!(n in t))return!1;return!0}var Fr=class t{constructor(e,n){this.toDOM=e,this.spec=n||wt,this.side=this.spec.side||0}map(e,n,r,i){let{pos:o,deleted:s}=e.mapResult(n.from+i,this.side<0?-1:1);return s?null:new xe(o-r,o-r,this)}valid(){return!0}eq(e){return this==e||e instanceof t&&(this.spec.key&&this.spec.key==e.spec.key||this.toDOM==e.toDOM&&Ln(this.spec,e.spec))}destroy(e){this.spec.destroy&&this.spec.destroy(e)}},St=class t{constructor(e,n){this.attrs=e,this.spec=n||wt}map(e,n,r,i){let o=e.map(n.from+i,this.spec.inclusiveStart?-1:1)-r,s=e.map(n.to+i,this.spec.inclusiveEnd?1:-1)-r;return o>=s?null:new xe(o,s,this)}valid(e,n){return n.from<n.to}eq(e){return this==e||e instanceof t&&Ln(this.attrs,e.attrs)&&Ln(this.spec,e.spec)}static is(e){return e.type instanceof t}destroy(){}},ls=class t{constructor(e,n){this.attrs=e,this.spec=n||wt}map(e,n,r,i){let o=e.mapResult(n.from+i,1);if(o.deleted)return null;let s=e.mapResult(n.to+i,-1);return s.deleted||s.pos<=o.pos?null:new xe(o.pos-r,s.pos-r,this)}valid(e,n){let{index:r,offset:i}=e.content.findIndex(n.from),o;return i==n.from&&!(o=e.child(r)).isText&&i+o.nodeSize==n.to}eq(e){return this==e||e instanceof t&&Ln(this.attrs,e.attrs)&&Ln(this.spec,e.spec)}destroy(){}},xe=class t{constructor(e,n,r){this.from=e,this.to=n,this.type=r}copy(e,n){return new t(e,n,this.type)}eq(e,n=0){return this.type.eq(e.type)&&this.from+n==e.from&&this.to+n==e.to}map(e,n,r){return this.type.map(e,this,n,r)}static widget(e,n,r){return new t(e,e,new Fr(n,r))}static inline(e,n,r,i){return new t(e,n,new St(r,i))}static node(e,n,r,i){return new t(e,n,new ls(r,i))}get spec(){return this.type.spec}get inline(){return this.type instanceof St}get widget(){return this.type instanceof Fr}},Yt=[],wt={},oe=class t{constructor(e,n){this.local=e.length?e:Yt,this.children=n.length?n:Yt}static create(e,n){return n.length?zr(n,e,0,wt):re}find(e,n,r){let i=[];return this.findInner(e==null?0:e,n==null?1e9:n,i,0,r),i}findInner(e,n,r,i,o){for(let s=0;s<this.local.length;s++){let l=this.local[s];l.from<=n&&l.to>=e&&(!o||o(l.spec))&&r.push(l.copy(l.from+i,l.to+i))}for(let s=0;s<this.children.length;s+=3)if(this.children[s]<n&&this.children[s+1]>e){let l=this.children[s]+1;this.children[s+2].findInner(e-l,n-l,r,i+l,o)}}map(e,n,r){return this==re||e.maps.length==0?this:this.mapInner(e,n,0,0,r||wt)}mapInner(e,n,r,i,o){let s;for(let l=0;l<this.local.length;l++){let a=this.local[l].map(e,r,i);a&&a.type.valid(n,a)?(s||(s=[])).push(a):o.onRemove&&o.onRemove(this.local[l].spec)}return this.children.length?Hm(this.children,s||[],e,n,r,i,o):s?new t(s.sort(Mt),Yt):re}add(e,n){return n.length?this==re?t.create(e,n):this.addInner(e,n,0):this}addInner(e,n,r){let i,o=0;e.forEach((l,a)=>{let c=a+r,d;if(d=zd(n,l,c)){for(i||(i=this.children.slice());o<i.length&&i[o]<a;)o+=3;i[o]==a?i[o+2]=i[o+2].addInner(l,d,c+1):i.splice(o,0,a,a+l.nodeSize,zr(d,l,c+1,wt)),o+=3}});let s=Bd(o?Vd(n):n,-r);for(let l=0;l<s.length;l++)s[l].type.valid(e,s[l])||s.splice(l--,1);return new t(s.length?this.local.concat(s).sort(Mt):this.local,i||this.children)}remove(e){return e.length==0||this==re?this:this.removeInner(e,0)}removeInner(e,n){let r=this.children,i=this.local;for(let o=0;o<r.length;o+=3){let s,l=r[o]+n,a=r[o+1]+n;for(let d=0,u;d<e.length;d++)(u=e[d])&&u.from>l&&u.to<a&&(e[d]=null,(s||(s=[])).push(u));if(!s)continue;r==this.children&&(r=this.children.slice());let c=r[o+2].removeInner(s,l+1);c!=re?r[o+2]=c:(r.splice(o,3),o-=3)}if(i.length){for(let o=0,s;o<e.length;o++)if(s=e[o])for(let l=0;l<i.length;l++)i[l].eq(s,n)&&(i==this.local&&(i=this.local.slice()),i.splice(l--,1))}return r==this.children&&i==this.local?this:i.length||r.length?new t(i,r):re}forChild(e,n){if(this==re)return this;if(n.isLeaf)return t.empty;let r,i;for(let l=0;l<this.children.length;l+=3)if(this.children[l]>=e){this.children[l]==e&&(r=this.children[l+2]);break}let o=e+1,s=o+n.content.size;for(let l=0;l<this.local.length;l++){let a=this.local[l];if(a.from<s&&a.to>o&&a.type instanceof St){let c=Math.max(o,a.from)-o,d=Math.min(s,a.to)-o;c<d&&(i||(i=[])).push(a.copy(c,d))}}if(i){let l=new t(i.sort(Mt),Yt);return r?new Br([l,r]):l}return r||re}eq(e){if(this==e)return!0;if(!(e instanceof t)||this.local.length!=e.local.length||this.children.length!=e.children.length)return!1;for(let n=0;n<this.local.length;n++)if(!this.local[n].eq(e.local[n]))return!1;for(let n=0;n<this.children.length;n+=3)if(this.children[n]!=e.children[n]||this.children[n+1]!=e.children[n+1]||!this.children[n+2].eq(e.children[n+2]))return!1;return!0}locals(e){return ys(this.localsInner(e))}localsInner(e){if(this==re)return Yt;if(e.inlineContent||!this.local.some(St.is))return this.local;let n=[];for(let r=0;r<this.local.length;r++)this.local[r].type instanceof St||n.push(this.local[r]);return n}forEachSet(e){e(this)}};oe.empty=new oe([],[]);oe.removeOverlap=ys;var re=oe.empty,Br=class t{constructor(e){this.members=e}map(e,n){let r=this.members.map(i=>i.map(e,n,wt));return t.from(r)}forChild(e,n){if(n.isLeaf)return oe.empty;let r=[];for(let i=0;i<this.members.length;i++){let o=this.members[i].forChild(e,n);o!=re&&(o instanceof t?r=r.concat(o.members):r.push(o))}return t.from(r)}eq(e){if(!(e instanceof t)||e.members.length!=this.members.length)return!1;for(let n=0;n<this.members.length;n++)if(!this.members[n].eq(e.members[n]))return!1;return!0}locals(e){let n,r=!0;for(let i=0;i<this.members.length;i++){let o=this.members[i].localsInner(e);if(o.length)if(!n)n=o;else{r&&(n=n.slice(),r=!1);for(let s=0;s<o.length;s++)n.push(o[s])}}return n?ys(r?n:n.sort(Mt)):Yt}static from(e){switch(e.length){case 0:return re;case 1:return e[0];default:return new t(e.every(n=>n instanceof oe)?e:e.reduce((n,r)=>n.concat(r instanceof oe?r:r.members),[]))}}forEachSet(e){for(let n=0;n<this.members.length;n++)this.members[n].forEachSet(e)}};function Hm(t,e,n,r,i,o,s){let l=t.slice();for(let c=0,d=o;c<n.maps.length;c++){let u=0;n.maps[c].forEach((f,h,p,m)=>{let g=m-p-(h-f);for(let y=0;y<l.length;y+=3){let k=l[y+1];if(k<0||f>k+d-u)continue;let E=l[y]+d-u;h>=E?l[y+1]=f<=E?-2:-1:f>=d&&g&&(l[y]+=g,l[y+1]+=g)}u+=g}),d=n.maps[c].map(d,-1)}let a=!1;for(let c=0;c<l.length;c+=3)if(l[c+1]<0){if(l[c+1]==-2){a=!0,l[c+1]=-1;continue}let d=n.map(t[c]+o),u=d-i;if(u<0||u>=r.content.size){a=!0;continue}let f=n.map(t[c+1]+o,-1),h=f-i,{index:p,offset:m}=r.content.findIndex(u),g=r.maybeChild(p);if(g&&m==u&&m+g.nodeSize==h){let y=l[c+2].mapInner(n,g,d+1,t[c]+o+1,s);y!=re?(l[c]=u,l[c+1]=h,l[c+2]=y):(l[c+1]=-2,a=!0)}else a=!0}if(a){let c=Fm(l,t,e,n,i,o,s),d=zr(c,r,0,s);e=d.local;for(let u=0;u<l.length;u+=3)l[u+1]<0&&(l.splice(u,3),u-=3);for(let u=0,f=0;u<d.children.length;u+=3){let h=d.children[u];for(;f<l.length&&l[f]<h;)f+=3;l.splice(f,0,d.children[u],d.children[u+1],d.children[u+2])}}return new oe(e.sort(Mt),l)}function Bd(t,e){if(!e||!t.length)return t;let n=[];for(let r=0;r<t.length;r++){let i=t[r];n.push(new xe(i.from+e,i.to+e,i.type))}return n}function Fm(t,e,n,r,i,o,s){function l(a,c){for(let d=0;d<a.local.length;d++){let u=a.local[d].map(r,i,c);u?n.push(u):s.onRemove&&s.onRemove(a.local[d].spec)}for(let d=0;d<a.children.length;d+=3)l(a.children[d+2],a.children[d]+c+1)}for(let a=0;a<t.length;a+=3)t[a+1]==-1&&l(t[a+2],e[a]+o+1);return n}function zd(t,e,n){if(e.isLeaf)return null;let r=n+e.nodeSize,i=null;for(let o=0,s;o<t.length;o++)(s=t[o])&&s.from>n&&s.to<r&&((i||(i=[])).push(s),t[o]=null);return i}function Vd(t){let e=[];for(let n=0;n<t.length;n++)t[n]!=null&&e.push(t[n]);return e}function zr(t,e,n,r){let i=[],o=!1;e.forEach((l,a)=>{let c=zd(t,l,a+n);if(c){o=!0;let d=zr(c,l,n+a+1,r);d!=re&&i.push(a,a+l.nodeSize,d)}});let s=Bd(o?Vd(t):t,-n).sort(Mt);for(let l=0;l<s.length;l++)s[l].type.valid(e,s[l])||(r.onRemove&&r.onRemove(s[l].spec),s.splice(l--,1));return s.length||i.length?new oe(s,i):re}function Mt(t,e){return t.from-e.from||t.to-e.to}function ys(t){let e=t;for(let n=0;n<e.length-1;n++){let r=e[n];if(r.from!=r.to)for(let i=n+1;i<e.length;i++){let o=e[i];if(o.from==r.from){o.to!=r.to&&(e==t&&(e=t.slice()),e[i]=o.copy(o.from,r.to),td(e,i+1,o.copy(r.to,o.to)));continue}else{o.from<r.to&&(e==t&&(e=t.slice()),e[n]=r.copy(r.from,o.from),td(e,i,r.copy(o.from,r.to)));break}}}return e}function td(t,e,n){for(;e<t.length&&Mt(n,t[e])>0;)e++;t.splice(e,0,n)}function jo(t){let e=[];return t.someProp("decorations",n=>{let r=n(t.state);r&&r!=re&&e.push(r)}),t.cursorWrapper&&e.push(oe.create(t.state.doc,[t.cursorWrapper.deco])),Br.from(e)}var Bm={childList:!0,characterData:!0,characterDataOldValue:!0,attributes:!0,attributeOldValue:!0,subtree:!0},zm=fe&&Ze<=11,as=class{constructor(){this.anchorNode=null,this.anchorOffset=0,this.focusNode=null,this.focusOffset=0}set(e){this.anchorNode=e.anchorNode,this.anchorOffset=e.anchorOffset,this.focusNode=e.focusNode,this.focusOffset=e.focusOffset}clear(){this.anchorNode=this.focusNode=null}eq(e){return e.anchorNode==this.anchorNode&&e.anchorOffset==this.anchorOffset&&e.focusNode==this.focusNode&&e.focusOffset==this.focusOffset}},cs=class{constructor(e,n){this.view=e,this.handleDOMChange=n,this.queue=[],this.flushingSoon=-1,this.observer=null,this.currentSelection=new as,this.onCharData=null,this.suppressingSelectionUpdates=!1,this.lastChangedTextNode=null,this.observer=window.MutationObserver&&new window.MutationObserver(r=>{for(let i=0;i<r.length;i++)this.queue.push(r[i]);fe&&Ze<=11&&r.some(i=>i.type=="childList"&&i.removedNodes.length||i.type=="characterData"&&i.oldValue.length>i.target.nodeValue.length)?this.flushSoon():this.flush()}),zm&&(this.onCharData=r=>{this.queue.push({target:r.target,type:"characterData",oldValue:r.prevValue}),this.flushSoon()}),this.onSelectionChange=this.onSelectionChange.bind(this)}flushSoon(){this.flushingSoon<0&&(this.flushingSoon=window.setTimeout(()=>{this.flushingSoon=-1,this.flush()},20))}forceFlush(){this.flushingSoon>-1&&(window.clearTimeout(this.flushingSoon),this.flushingSoon=-1,this.flush())}start(){this.observer&&(this.observer.takeRecords(),this.observer.observe(this.view.dom,Bm)),this.onCharData&&this.view.dom.addEventListener("DOMCharacterDataModified",this.onCharData),this.connectSelection()}stop(){if(this.observer){let e=this.observer.takeRecords();if(e.length){for(let n=0;n<e.length;n++)this.queue.push(e[n]);window.setTimeout(()=>this.flush(),20)}this.observer.disconnect()}this.onCharData&&this.view.dom.removeEventListener("DOMCharacterDataModified",this.onCharData),this.disconnectSelection()}connectSelection(){this.view.dom.ownerDocument.addEventListener("selectionchange",this.onSelectionChange)}disconnectSelection(){this.view.dom.ownerDocument.removeEventListener("selectionchange",this.onSelectionChange)}suppressSelectionUpdates(){this.suppressingSelectionUpdates=!0,setTimeout(()=>this.suppressingSelectionUpdates=!1,50)}onSelectionChange(){if(Kc(this.view)){if(this.suppressingSelectionUpdates)return je(this.view);if(fe&&Ze<=11&&!this.view.state.selection.empty){let e=this.view.domSelectionRange();if(e.focusNode&&Tt(e.focusNode,e.focusOffset,e.anchorNode,e.anchorOffset))return this.flushSoon()}this.flush()}}setCurSelection(){this.currentSelection.set(this.view.domSelectionRange())}ignoreSelectionChange(e){if(!e.focusNode)return!0;let n=new Set,r;for(let o=e.focusNode;o;o=Xt(o))n.add(o);for(let o=e.anchorNode;o;o=Xt(o))if(n.has(o)){r=o;break}let i=r&&this.view.docView.nearestDesc(r);if(i&&i.ignoreMutation({type:"selection",target:r.nodeType==3?r.parentNode:r}))return this.setCurSelection(),!0}pendingRecords(){if(this.observer)for(let e of this.observer.takeRecords())this.queue.push(e);return this.queue}flush(){let{view:e}=this;if(!e.docView||this.flushingSoon>-1)return;let n=this.pendingRecords();n.length&&(this.queue=[]);let r=e.domSelectionRange(),i=!this.suppressingSelectionUpdates&&!this.currentSelection.eq(r)&&Kc(e)&&!this.ignoreSelectionChange(r),o=-1,s=-1,l=!1,a=[];if(e.editable)for(let d=0;d<n.length;d++){let u=this.registerMutation(n[d],a);u&&(o=o<0?u.from:Math.min(u.from,o),s=s<0?u.to:Math.max(u.to,s),u.typeOver&&(l=!0))}if(ve&&a.length){let d=a.filter(u=>u.nodeName=="BR");if(d.length==2){let[u,f]=d;u.parentNode&&u.parentNode.parentNode==f.parentNode?f.remove():u.remove()}else{let{focusNode:u}=this.currentSelection;for(let f of d){let h=f.parentNode;h&&h.nodeName=="LI"&&(!u||jm(e,u)!=h)&&f.remove()}}}let c=null;o<0&&i&&e.input.lastFocus>Date.now()-200&&Math.max(e.input.lastTouch,e.input.lastClick.time)<Date.now()-300&&$r(r)&&(c=us(e))&&c.eq(M.near(e.state.doc.resolve(0),1))?(e.input.lastFocus=0,je(e),this.currentSelection.set(r),e.scrollToSelection()):(o>-1||i)&&(o>-1&&(e.docView.markDirty(o,s),Vm(e)),this.handleDOMChange(o,s,l,a),e.docView&&e.docView.dirty?e.updateState(e.state):this.currentSelection.eq(r)||je(e),this.currentSelection.set(r))}registerMutation(e,n){if(n.indexOf(e.target)>-1)return null;let r=this.view.docView.nearestDesc(e.target);if(e.type=="attributes"&&(r==this.view.docView||e.attributeName=="contenteditable"||e.attributeName=="style"&&!e.oldValue&&!e.target.getAttribute("style"))||!r||r.ignoreMutation(e))return null;if(e.type=="childList"){for(let d=0;d<e.addedNodes.length;d++){let u=e.addedNodes[d];n.push(u),u.nodeType==3&&(this.lastChangedTextNode=u)}if(r.contentDOM&&r.contentDOM!=r.dom&&!r.contentDOM.contains(e.target))return{from:r.posBefore,to:r.posAfter};let i=e.previousSibling,o=e.nextSibling;if(fe&&Ze<=11&&e.addedNodes.length)for(let d=0;d<e.addedNodes.length;d++){let{previousSibling:u,nextSibling:f}=e.addedNodes[d];(!u||Array.prototype.indexOf.call(e.addedNodes,u)<0)&&(i=u),(!f||Array.prototype.indexOf.call(e.addedNodes,f)<0)&&(o=f)}let s=i&&i.parentNode==e.target?Q(i)+1:0,l=r.localPosFromDOM(e.target,s,-1),a=o&&o.parentNode==e.target?Q(o):e.target.childNodes.length,c=r.localPosFromDOM(e.target,a,1);return{from:l,to:c}}else return e.type=="attributes"?{from:r.posAtStart-r.border,to:r.posAtEnd+r.border}:(this.lastChangedTextNode=e.target,{from:r.posAtStart,to:r.posAtEnd,typeOver:e.target.nodeValue==e.oldValue})}},nd=new WeakMap,rd=!1;function Vm(t){if(!nd.has(t)&&(nd.set(t,null),["normal","nowrap","pre-line"].indexOf(getComputedStyle(t.dom).whiteSpace)!==-1)){if(t.requiresGeckoHackNode=ve,rd)return;console.warn("ProseMirror expects the CSS white-space property to be set, preferably to 'pre-wrap'. It is recommended to load style/prosemirror.css from the prosemirror-view package."),rd=!0}}function id(t,e){let n=e.startContainer,r=e.startOffset,i=e.endContainer,o=e.endOffset,s=t.domAtPos(t.state.selection.anchor);return Tt(s.node,s.offset,i,o)&&([n,r,i,o]=[i,o,n,r]),{anchorNode:n,anchorOffset:r,focusNode:i,focusOffset:o}}function $m(t,e){if(e.getComposedRanges){let i=e.getComposedRanges(t.root)[0];if(i)return id(t,i)}let n;function r(i){i.preventDefault(),i.stopImmediatePropagation(),n=i.getTargetRanges()[0]}return t.dom.addEventListener("beforeinput",r,!0),document.execCommand("indent"),t.dom.removeEventListener("beforeinput",r,!0),n?id(t,n):null}function jm(t,e){for(let n=e.parentNode;n&&n!=t.dom;n=n.parentNode){let r=t.docView.nearestDesc(n,!0);if(r&&r.node.isBlock)return n}return null}function Um(t,e,n){let{node:r,fromOffset:i,toOffset:o,from:s,to:l}=t.docView.parseRange(e,n),a=t.domSelectionRange(),c,d=a.anchorNode;if(d&&t.dom.contains(d.nodeType==1?d:d.parentNode)&&(c=[{node:d,offset:a.anchorOffset}],$r(a)||c.push({node:a.focusNode,offset:a.focusOffset})),ie&&t.input.lastKeyCode===8)for(let g=o;g>i;g--){let y=r.childNodes[g-1],k=y.pmViewDesc;if(y.nodeName=="BR"&&!k){o=g;break}if(!k||k.size)break}let u=t.state.doc,f=t.someProp("domParser")||He.fromSchema(t.state.schema),h=u.resolve(s),p=null,m=f.parse(r,{topNode:h.parent,topMatch:h.parent.contentMatchAt(h.index()),topOpen:!0,from:i,to:o,preserveWhitespace:h.parent.type.whitespace=="pre"?"full":!0,findPositions:c,ruleFromNode:Wm,context:h});if(c&&c[0].pos!=null){let g=c[0].pos,y=c[1]&&c[1].pos;y==null&&(y=g),p={anchor:g+s,head:y+s}}return{doc:m,sel:p,from:s,to:l}}function Wm(t){let e=t.pmViewDesc;if(e)return e.parseRule();if(t.nodeName=="BR"&&t.parentNode){if(ce&&/^(ul|ol)$/i.test(t.parentNode.nodeName)){let n=document.createElement("div");return n.appendChild(document.createElement("li")),{skip:n}}else if(t.parentNode.lastChild==t||ce&&/^(tr|table)$/i.test(t.parentNode.nodeName))return{ignore:!0}}else if(t.nodeName=="IMG"&&t.getAttribute("mark-placeholder"))return{ignore:!0};return null}var qm=/^(a|abbr|acronym|b|bd[io]|big|br|button|cite|code|data(list)?|del|dfn|em|i|img|ins|kbd|label|map|mark|meter|output|q|ruby|s|samp|small|span|strong|su[bp]|time|u|tt|var)$/i;function Km(t,e,n,r,i){let o=t.input.compositionPendingChanges||(t.composing?t.input.compositionID:0);if(t.input.compositionPendingChanges=0,e<0){let w=t.input.lastSelectionTime>Date.now()-50?t.input.lastSelectionOrigin:null,R=us(t,w);if(R&&!t.state.selection.eq(R)){if(ie&&$e&&t.input.lastKeyCode===13&&Date.now()-100<t.input.lastKeyCodeTime&&t.someProp("handleKeyDown",De=>De(t,xt(13,"Enter"))))return;let H=t.state.tr.setSelection(R);w=="pointer"?H.setMeta("pointer",!0):w=="key"&&H.scrollIntoView(),o&&H.setMeta("composition",o),t.dispatch(H)}return}let s=t.state.doc.resolve(e),l=s.sharedDepth(n);e=s.before(l+1),n=t.state.doc.resolve(n).after(l+1);let a=t.state.selection,c=Um(t,e,n),d=t.state.doc,u=d.slice(c.from,c.to),f,h;t.input.lastKeyCode===8&&Date.now()-100<t.input.lastKeyCodeTime?(f=t.state.selection.to,h="end"):(f=t.state.selection.from,h="start"),t.input.lastKeyCode=null;let p=Ym(u.content,c.doc.content,c.from,f,h);if(p&&t.input.domChangeCount++,(Zt&&t.input.lastIOSEnter>Date.now()-225||$e)&&i.some(w=>w.nodeType==1&&!qm.test(w.nodeName))&&(!p||p.endA>=p.endB)&&t.someProp("handleKeyDown",w=>w(t,xt(13,"Enter")))){t.input.lastIOSEnter=0;return}if(!p)if(r&&a instanceof S&&!a.empty&&a.$head.sameParent(a.$anchor)&&!t.composing&&!(c.sel&&c.sel.anchor!=c.sel.head))p={start:a.from,endA:a.to,endB:a.to};else{if(c.sel){let w=od(t,t.state.doc,c.sel);if(w&&!w.eq(t.state.selection)){let R=t.state.tr.setSelection(w);o&&R.setMeta("composition",o),t.dispatch(R)}}return}t.state.selection.from<t.state.selection.to&&p.start==p.endB&&t.state.selection instanceof S&&(p.start>t.state.selection.from&&p.start<=t.state.selection.from+2&&t.state.selection.from>=c.from?p.start=t.state.selection.from:p.endA<t.state.selection.to&&p.endA>=t.state.selection.to-2&&t.state.selection.to<=c.to&&(p.endB+=t.state.selection.to-p.endA,p.endA=t.state.selection.to)),fe&&Ze<=11&&p.endB==p.start+1&&p.endA==p.start&&p.start>c.from&&c.doc.textBetween(p.start-c.from-1,p.start-c.from+1)==" \xA0"&&(p.start--,p.endA--,p.endB--);let m=c.doc.resolveNoCache(p.start-c.from),g=c.doc.resolveNoCache(p.endB-c.from),y=d.resolve(p.start),k=m.sameParent(g)&&m.parent.inlineContent&&y.end()>=p.endA;if((Zt&&t.input.lastIOSEnter>Date.now()-225&&(!k||i.some(w=>w.nodeName=="DIV"||w.nodeName=="P"))||!k&&m.pos<c.doc.content.size&&(!m.sameParent(g)||!m.parent.inlineContent)&&m.pos<g.pos&&!/\S/.test(c.doc.textBetween(m.pos,g.pos,"","")))&&t.someProp("handleKeyDown",w=>w(t,xt(13,"Enter")))){t.input.lastIOSEnter=0;return}if(t.state.selection.anchor>p.start&&Gm(d,p.start,p.endA,m,g)&&t.someProp("handleKeyDown",w=>w(t,xt(8,"Backspace")))){$e&&ie&&t.domObserver.suppressSelectionUpdates();return}ie&&p.endB==p.start&&(t.input.lastChromeDelete=Date.now()),$e&&!k&&m.start()!=g.start()&&g.parentOffset==0&&m.depth==g.depth&&c.sel&&c.sel.anchor==c.sel.head&&c.sel.head==p.endA&&(p.endB-=2,g=c.doc.resolveNoCache(p.endB-c.from),setTimeout(()=>{t.someProp("handleKeyDown",function(w){return w(t,xt(13,"Enter"))})},20));let E=p.start,O=p.endA,T=w=>{let R=w||t.state.tr.replace(E,O,c.doc.slice(p.start-c.from,p.endB-c.from));if(c.sel){let H=od(t,R.doc,c.sel);H&&!(ie&&t.composing&&H.empty&&(p.start!=p.endB||t.input.lastChromeDelete<Date.now()-100)&&(H.head==E||H.head==R.mapping.map(O)-1)||fe&&H.empty&&H.head==E)&&R.setSelection(H)}return o&&R.setMeta("composition",o),R.scrollIntoView()},I;if(k)if(m.pos==g.pos){fe&&Ze<=11&&m.parentOffset==0&&(t.domObserver.suppressSelectionUpdates(),setTimeout(()=>je(t),20));let w=T(t.state.tr.delete(E,O)),R=d.resolve(p.start).marksAcross(d.resolve(p.endA));R&&w.ensureMarks(R),t.dispatch(w)}else if(p.endA==p.endB&&(I=Jm(m.parent.content.cut(m.parentOffset,g.parentOffset),y.parent.content.cut(y.parentOffset,p.endA-y.start())))){let w=T(t.state.tr);I.type=="add"?w.addMark(E,O,I.mark):w.removeMark(E,O,I.mark),t.dispatch(w)}else if(m.parent.child(m.index()).isText&&m.index()==g.index()-(g.textOffset?0:1)){let w=m.parent.textBetween(m.parentOffset,g.parentOffset),R=()=>T(t.state.tr.insertText(w,E,O));t.someProp("handleTextInput",H=>H(t,E,O,w,R))||t.dispatch(R())}else t.dispatch(T());else t.dispatch(T())}function od(t,e,n){return Math.max(n.anchor,n.head)>e.content.size?null:fs(t,e.resolve(n.anchor),e.resolve(n.head))}function Jm(t,e){let n=t.firstChild.marks,r=e.firstChild.marks,i=n,o=r,s,l,a;for(let d=0;d<r.length;d++)i=r[d].removeFromSet(i);for(let d=0;d<n.length;d++)o=n[d].removeFromSet(o);if(i.length==1&&o.length==0)l=i[0],s="add",a=d=>d.mark(l.addToSet(d.marks));else if(i.length==0&&o.length==1)l=o[0],s="remove",a=d=>d.mark(l.removeFromSet(d.marks));else return null;let c=[];for(let d=0;d<e.childCount;d++)c.push(a(e.child(d)));if(v.from(c).eq(t))return{mark:l,type:s}}function Gm(t,e,n,r,i){if(n-e<=i.pos-r.pos||Uo(r,!0,!1)<i.pos)return!1;let o=t.resolve(e);if(!r.parent.isTextblock){let l=o.nodeAfter;return l!=null&&n==e+l.nodeSize}if(o.parentOffset<o.parent.content.size||!o.parent.isTextblock)return!1;let s=t.resolve(Uo(o,!0,!0));return!s.parent.isTextblock||s.pos>n||Uo(s,!0,!1)<n?!1:r.parent.content.cut(r.parentOffset).eq(s.parent.content)}function Uo(t,e,n){let r=t.depth,i=e?t.end():t.pos;for(;r>0&&(e||t.indexAfter(r)==t.node(r).childCount);)r--,i++,e=!1;if(n){let o=t.node(r).maybeChild(t.indexAfter(r));for(;o&&!o.isLeaf;)o=o.firstChild,i++}return i}function Ym(t,e,n,r,i){let o=t.findDiffStart(e,n);if(o==null)return null;let{a:s,b:l}=t.findDiffEnd(e,n+t.size,n+e.size);if(i=="end"){let a=Math.max(0,o-Math.min(s,l));r-=s+a-o}if(s<o&&t.size<e.size){let a=r<=o&&r>=s?o-r:0;o-=a,o&&o<e.size&&sd(e.textBetween(o-1,o+1))&&(o+=a?1:-1),l=o+(l-s),s=o}else if(l<o){let a=r<=o&&r>=l?o-r:0;o-=a,o&&o<t.size&&sd(t.textBetween(o-1,o+1))&&(o+=a?1:-1),s=o+(s-l),l=o}return{start:o,endA:s,endB:l}}function sd(t){if(t.length!=2)return!1;let e=t.charCodeAt(0),n=t.charCodeAt(1);return e>=56320&&e<=57343&&n>=55296&&n<=56319}var Vr=class{constructor(e,n){this._root=null,this.focused=!1,this.trackWrites=null,this.mounted=!1,this.markCursor=null,this.cursorWrapper=null,this.lastSelectedViewDesc=void 0,this.input=new rs,this.prevDirectPlugins=[],this.pluginViews=[],this.requiresGeckoHackNode=!1,this.dragging=null,this._props=n,this.state=n.state,this.directPlugins=n.plugins||[],this.directPlugins.forEach(ud),this.dispatch=this.dispatch.bind(this),this.dom=e&&e.mount||document.createElement("div"),e&&(e.appendChild?e.appendChild(this.dom):typeof e=="function"?e(this.dom):e.mount&&(this.mounted=!0)),this.editable=cd(this),ad(this),this.nodeViews=dd(this),this.docView=Vc(this.state.doc,ld(this),jo(this),this.dom,this),this.domObserver=new cs(this,(r,i,o,s)=>Km(this,r,i,o,s)),this.domObserver.start(),vm(this),this.updatePluginViews()}get composing(){return this.input.composing}get props(){if(this._props.state!=this.state){let e=this._props;this._props={};for(let n in e)this._props[n]=e[n];this._props.state=this.state}return this._props}update(e){e.handleDOMEvents!=this._props.handleDOMEvents&&is(this);let n=this._props;this._props=e,e.plugins&&(e.plugins.forEach(ud),this.directPlugins=e.plugins),this.updateStateInner(e.state,n)}setProps(e){let n={};for(let r in this._props)n[r]=this._props[r];n.state=this.state;for(let r in e)n[r]=e[r];this.update(n)}updateState(e){this.updateStateInner(e,this._props)}updateStateInner(e,n){var r;let i=this.state,o=!1,s=!1;e.storedMarks&&this.composing&&(Pd(this),s=!0),this.state=e;let l=i.plugins!=e.plugins||this._props.plugins!=n.plugins;if(l||this._props.plugins!=n.plugins||this._props.nodeViews!=n.nodeViews){let h=dd(this);Xm(h,this.nodeViews)&&(this.nodeViews=h,o=!0)}(l||n.handleDOMEvents!=this._props.handleDOMEvents)&&is(this),this.editable=cd(this),ad(this);let a=jo(this),c=ld(this),d=i.plugins!=e.plugins&&!i.doc.eq(e.doc)?"reset":e.scrollToSelection>i.scrollToSelection?"to selection":"preserve",u=o||!this.docView.matchesNode(e.doc,c,a);(u||!e.selection.eq(i.selection))&&(s=!0);let f=d=="preserve"&&s&&this.dom.style.overflowAnchor==null&&Hp(this);if(s){this.domObserver.stop();let h=u&&(fe||ie)&&!this.composing&&!i.selection.empty&&!e.selection.empty&&Qm(i.selection,e.selection);if(u){let p=ie?this.trackWrites=this.domSelectionRange().focusNode:null;this.composing&&(this.input.compositionNode=Nm(this)),(o||!this.docView.update(e.doc,c,a,this))&&(this.docView.updateOuterDeco(c),this.docView.destroy(),this.docView=Vc(e.doc,c,a,this.dom,this)),p&&!this.trackWrites&&(h=!0)}h||!(this.input.mouseDown&&this.domObserver.currentSelection.eq(this.domSelectionRange())&&im(this))?je(this,h):(Sd(this,e.selection),this.domObserver.setCurSelection()),this.domObserver.start()}this.updatePluginViews(i),!((r=this.dragging)===null||r===void 0)&&r.node&&!i.doc.eq(e.doc)&&this.updateDraggedNode(this.dragging,i),d=="reset"?this.dom.scrollTop=0:d=="to selection"?this.scrollToSelection():f&&Fp(f)}scrollToSelection(){let e=this.domSelectionRange().focusNode;if(!(!e||!this.dom.contains(e.nodeType==1?e:e.parentNode))){if(!this.someProp("handleScrollToSelection",n=>n(this)))if(this.state.selection instanceof C){let n=this.docView.domAfterPos(this.state.selection.from);n.nodeType==1&&Dc(this,n.getBoundingClientRect(),e)}else Dc(this,this.coordsAtPos(this.state.selection.head,1),e)}}destroyPluginViews(){let e;for(;e=this.pluginViews.pop();)e.destroy&&e.destroy()}updatePluginViews(e){if(!e||e.plugins!=this.state.plugins||this.directPlugins!=this.prevDirectPlugins){this.prevDirectPlugins=this.directPlugins,this.destroyPluginViews();for(let n=0;n<this.directPlugins.length;n++){let r=this.directPlugins[n];r.spec.view&&this.pluginViews.push(r.spec.view(this))}for(let n=0;n<this.state.plugins.length;n++){let r=this.state.plugins[n];r.spec.view&&this.pluginViews.push(r.spec.view(this))}}else for(let n=0;n<this.pluginViews.length;n++){let r=this.pluginViews[n];r.update&&r.update(this,e)}}updateDraggedNode(e,n){let r=e.node,i=-1;if(this.state.doc.nodeAt(r.from)==r.node)i=r.from;else{let o=r.from+(this.state.doc.content.size-n.doc.content.size);(o>0&&this.state.doc.nodeAt(o))==r.node&&(i=o)}this.dragging=new Hr(e.slice,e.move,i<0?void 0:C.create(this.state.doc,i))}someProp(e,n){let r=this._props&&this._props[e],i;if(r!=null&&(i=n?n(r):r))return i;for(let s=0;s<this.directPlugins.length;s++){let l=this.directPlugins[s].props[e];if(l!=null&&(i=n?n(l):l))return i}let o=this.state.plugins;if(o)for(let s=0;s<o.length;s++){let l=o[s].props[e];if(l!=null&&(i=n?n(l):l))return i}}hasFocus(){if(fe){let e=this.root.activeElement;if(e==this.dom)return!0;if(!e||!this.dom.contains(e))return!1;for(;e&&this.dom!=e&&this.dom.contains(e);){if(e.contentEditable=="false")return!1;e=e.parentElement}return!0}return this.root.activeElement==this.dom}focus(){this.domObserver.stop(),this.editable&&Bp(this.dom),je(this),this.domObserver.start()}get root(){let e=this._root;if(e==null){for(let n=this.dom.parentNode;n;n=n.parentNode)if(n.nodeType==9||n.nodeType==11&&n.host)return n.getSelection||(Object.getPrototypeOf(n).getSelection=()=>n.ownerDocument.getSelection()),this._root=n}return e||document}updateRoot(){this._root=null}posAtCoords(e){return Up(this,e)}coordsAtPos(e,n=1){return bd(this,e,n)}domAtPos(e,n=0){return this.docView.domFromPos(e,n)}nodeDOM(e){let n=this.docView.descAt(e);return n?n.nodeDOM:null}posAtDOM(e,n,r=-1){let i=this.docView.posFromDOM(e,n,r);if(i==null)throw new RangeError("DOM position not inside the editor");return i}endOfTextblock(e,n){return Gp(this,n||this.state,e)}pasteHTML(e,n){return Nn(this,"",e,!1,n||new ClipboardEvent("paste"))}pasteText(e,n){return Nn(this,e,null,!0,n||new ClipboardEvent("paste"))}serializeForClipboard(e){return hs(this,e)}destroy(){this.docView&&(km(this),this.destroyPluginViews(),this.mounted?(this.docView.update(this.state.doc,[],jo(this),this),this.dom.textContent=""):this.dom.parentNode&&this.dom.parentNode.removeChild(this.dom),this.docView.destroy(),this.docView=null,Mp())}get isDestroyed(){return this.docView==null}dispatchEvent(e){return Cm(this,e)}domSelectionRange(){let e=this.domSelection();return e?ce&&this.root.nodeType===11&&Np(this.dom.ownerDocument)==this.dom&&$m(this,e)||e:{focusNode:null,focusOffset:0,anchorNode:null,anchorOffset:0}}domSelection(){return this.root.getSelection()}};Vr.prototype.dispatch=function(t){let e=this._props.dispatchTransaction;e?e.call(this,t):this.updateState(this.state.apply(t))};function ld(t){let e=Object.create(null);return e.class="ProseMirror",e.contenteditable=String(t.editable),t.someProp("attributes",n=>{if(typeof n=="function"&&(n=n(t.state)),n)for(let r in n)r=="class"?e.class+=" "+n[r]:r=="style"?e.style=(e.style?e.style+";":"")+n[r]:!e[r]&&r!="contenteditable"&&r!="nodeName"&&(e[r]=String(n[r]))}),e.translate||(e.translate="no"),[xe.node(0,t.state.doc.content.size,e)]}function ad(t){if(t.markCursor){let e=document.createElement("img");e.className="ProseMirror-separator",e.setAttribute("mark-placeholder","true"),e.setAttribute("alt",""),t.cursorWrapper={dom:e,deco:xe.widget(t.state.selection.from,e,{raw:!0,marks:t.markCursor})}}else t.cursorWrapper=null}function cd(t){return!t.someProp("editable",e=>e(t.state)===!1)}function Qm(t,e){let n=Math.min(t.$anchor.sharedDepth(t.head),e.$anchor.sharedDepth(e.head));return t.$anchor.start(n)!=e.$anchor.start(n)}function dd(t){let e=Object.create(null);function n(r){for(let i in r)Object.prototype.hasOwnProperty.call(e,i)||(e[i]=r[i])}return t.someProp("nodeViews",n),t.someProp("markViews",n),e}function Xm(t,e){let n=0,r=0;for(let i in t){if(t[i]!=e[i])return!0;n++}for(let i in e)r++;return n!=r}function ud(t){if(t.spec.state||t.spec.filterTransaction||t.spec.appendTransaction)throw new RangeError("Plugins passed directly to the view must not have a state component")}var Ue={8:"Backspace",9:"Tab",10:"Enter",12:"NumLock",13:"Enter",16:"Shift",17:"Control",18:"Alt",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",44:"PrintScreen",45:"Insert",46:"Delete",59:";",61:"=",91:"Meta",92:"Meta",106:"*",107:"+",108:",",109:"-",110:".",111:"/",144:"NumLock",145:"ScrollLock",160:"Shift",161:"Shift",162:"Control",163:"Control",164:"Alt",165:"Alt",173:"-",186:";",187:"=",188:",",189:"-",190:".",191:"/",192:"`",219:"[",220:"\\",221:"]",222:"'"},Wr={48:")",49:"!",50:"@",51:"#",52:"$",53:"%",54:"^",55:"&",56:"*",57:"(",59:":",61:"+",173:"_",186:":",187:"+",188:"<",189:"_",190:">",191:"?",192:"~",219:"{",220:"|",221:"}",222:'"'},Zm=typeof navigator!="undefined"&&/Mac/.test(navigator.platform),eg=typeof navigator!="undefined"&&/MSIE \d|Trident\/(?:[7-9]|\d{2,})\..*rv:(\d+)/.exec(navigator.userAgent);for(q=0;q<10;q++)Ue[48+q]=Ue[96+q]=String(q);var q;for(q=1;q<=24;q++)Ue[q+111]="F"+q;var q;for(q=65;q<=90;q++)Ue[q]=String.fromCharCode(q+32),Wr[q]=String.fromCharCode(q);var q;for(Ur in Ue)Wr.hasOwnProperty(Ur)||(Wr[Ur]=Ue[Ur]);var Ur;function $d(t){var e=Zm&&t.metaKey&&t.shiftKey&&!t.ctrlKey&&!t.altKey||eg&&t.shiftKey&&t.key&&t.key.length==1||t.key=="Unidentified",n=!e&&t.key||(t.shiftKey?Wr:Ue)[t.keyCode]||t.key||"Unidentified";return n=="Esc"&&(n="Escape"),n=="Del"&&(n="Delete"),n=="Left"&&(n="ArrowLeft"),n=="Up"&&(n="ArrowUp"),n=="Right"&&(n="ArrowRight"),n=="Down"&&(n="ArrowDown"),n}var tg=typeof navigator!="undefined"&&/Mac|iP(hone|[oa]d)/.test(navigator.platform),ng=typeof navigator!="undefined"&&/Win/.test(navigator.platform);function rg(t){let e=t.split(/-(?!$)/),n=e[e.length-1];n=="Space"&&(n=" ");let r,i,o,s;for(let l=0;l<e.length-1;l++){let a=e[l];if(/^(cmd|meta|m)$/i.test(a))s=!0;else if(/^a(lt)?$/i.test(a))r=!0;else if(/^(c|ctrl|control)$/i.test(a))i=!0;else if(/^s(hift)?$/i.test(a))o=!0;else if(/^mod$/i.test(a))tg?s=!0:i=!0;else throw new Error("Unrecognized modifier name: "+a)}return r&&(n="Alt-"+n),i&&(n="Ctrl-"+n),s&&(n="Meta-"+n),o&&(n="Shift-"+n),n}function ig(t){let e=Object.create(null);for(let n in t)e[rg(n)]=t[n];return e}function bs(t,e,n=!0){return e.altKey&&(t="Alt-"+t),e.ctrlKey&&(t="Ctrl-"+t),e.metaKey&&(t="Meta-"+t),n&&e.shiftKey&&(t="Shift-"+t),t}function jd(t){let e=ig(t);return function(n,r){let i=$d(r),o,s=e[bs(i,r)];if(s&&s(n.state,n.dispatch,n))return!0;if(i.length==1&&i!=" "){if(r.shiftKey){let l=e[bs(i,r,!1)];if(l&&l(n.state,n.dispatch,n))return!0}if((r.altKey||r.metaKey||r.ctrlKey)&&!(ng&&r.ctrlKey&&r.altKey)&&(o=Ue[r.keyCode])&&o!=i){let l=e[bs(o,r)];if(l&&l(n.state,n.dispatch,n))return!0}}return!1}}var qr=(t,e)=>t.selection.empty?!1:(e&&e(t.tr.deleteSelection().scrollIntoView()),!0);function Wd(t,e){let{$cursor:n}=t.selection;return!n||(e?!e.endOfTextblock("backward",t):n.parentOffset>0)?null:n}var ks=(t,e,n)=>{let r=Wd(t,n);if(!r)return!1;let i=Cs(r);if(!i){let s=r.blockRange(),l=s&&Be(s);return l==null?!1:(e&&e(t.tr.lift(s,l).scrollIntoView()),!0)}let o=i.nodeBefore;if(eu(t,i,e,-1))return!0;if(r.parent.content.size==0&&(tn(o,"end")||C.isSelectable(o)))for(let s=r.depth;;s--){let l=Mn(t.doc,r.before(s),r.after(s),x.empty);if(l&&l.slice.size<l.to-l.from){if(e){let a=t.tr.step(l);a.setSelection(tn(o,"end")?M.findFrom(a.doc.resolve(a.mapping.map(i.pos,-1)),-1):C.create(a.doc,i.pos-o.nodeSize)),e(a.scrollIntoView())}return!0}if(s==1||r.node(s-1).childCount>1)break}return o.isAtom&&i.depth==r.depth-1?(e&&e(t.tr.delete(i.pos-o.nodeSize,i.pos).scrollIntoView()),!0):!1},qd=(t,e,n)=>{let r=Wd(t,n);if(!r)return!1;let i=Cs(r);return i?Jd(t,i,e):!1},Kd=(t,e,n)=>{let r=Gd(t,n);if(!r)return!1;let i=ws(r);return i?Jd(t,i,e):!1};function Jd(t,e,n){let r=e.nodeBefore,i=r,o=e.pos-1;for(;!i.isTextblock;o--){if(i.type.spec.isolating)return!1;let d=i.lastChild;if(!d)return!1;i=d}let s=e.nodeAfter,l=s,a=e.pos+1;for(;!l.isTextblock;a++){if(l.type.spec.isolating)return!1;let d=l.firstChild;if(!d)return!1;l=d}let c=Mn(t.doc,o,a,x.empty);if(!c||c.from!=o||c instanceof ne&&c.slice.size>=a-o)return!1;if(n){let d=t.tr.step(c);d.setSelection(S.create(d.doc,o)),n(d.scrollIntoView())}return!0}function tn(t,e,n=!1){for(let r=t;r;r=e=="start"?r.firstChild:r.lastChild){if(r.isTextblock)return!0;if(n&&r.childCount!=1)return!1}return!1}var xs=(t,e,n)=>{let{$head:r,empty:i}=t.selection,o=r;if(!i)return!1;if(r.parent.isTextblock){if(n?!n.endOfTextblock("backward",t):r.parentOffset>0)return!1;o=Cs(r)}let s=o&&o.nodeBefore;return!s||!C.isSelectable(s)?!1:(e&&e(t.tr.setSelection(C.create(t.doc,o.pos-s.nodeSize)).scrollIntoView()),!0)};function Cs(t){if(!t.parent.type.spec.isolating)for(let e=t.depth-1;e>=0;e--){if(t.index(e)>0)return t.doc.resolve(t.before(e+1));if(t.node(e).type.spec.isolating)break}return null}function Gd(t,e){let{$cursor:n}=t.selection;return!n||(e?!e.endOfTextblock("forward",t):n.parentOffset<n.parent.content.size)?null:n}var Es=(t,e,n)=>{let r=Gd(t,n);if(!r)return!1;let i=ws(r);if(!i)return!1;let o=i.nodeAfter;if(eu(t,i,e,1))return!0;if(r.parent.content.size==0&&(tn(o,"start")||C.isSelectable(o))){let s=Mn(t.doc,r.before(),r.after(),x.empty);if(s&&s.slice.size<s.to-s.from){if(e){let l=t.tr.step(s);l.setSelection(tn(o,"start")?M.findFrom(l.doc.resolve(l.mapping.map(i.pos)),1):C.create(l.doc,l.mapping.map(i.pos))),e(l.scrollIntoView())}return!0}}return o.isAtom&&i.depth==r.depth-1?(e&&e(t.tr.delete(i.pos,i.pos+o.nodeSize).scrollIntoView()),!0):!1},Ss=(t,e,n)=>{let{$head:r,empty:i}=t.selection,o=r;if(!i)return!1;if(r.parent.isTextblock){if(n?!n.endOfTextblock("forward",t):r.parentOffset<r.parent.content.size)return!1;o=ws(r)}let s=o&&o.nodeAfter;return!s||!C.isSelectable(s)?!1:(e&&e(t.tr.setSelection(C.create(t.doc,o.pos)).scrollIntoView()),!0)};function ws(t){if(!t.parent.type.spec.isolating)for(let e=t.depth-1;e>=0;e--){let n=t.node(e);if(t.index(e)+1<n.childCount)return t.doc.resolve(t.after(e+1));if(n.type.spec.isolating)break}return null}var Yd=(t,e)=>{let n=t.selection,r=n instanceof C,i;if(r){if(n.node.isTextblock||!Me(t.doc,n.from))return!1;i=n.from}else if(i=Wt(t.doc,n.from,-1),i==null)return!1;if(e){let o=t.tr.join(i);r&&o.setSelection(C.create(o.doc,i-t.doc.resolve(i).nodeBefore.nodeSize)),e(o.scrollIntoView())}return!0},Qd=(t,e)=>{let n=t.selection,r;if(n instanceof C){if(n.node.isTextblock||!Me(t.doc,n.to))return!1;r=n.to}else if(r=Wt(t.doc,n.to,1),r==null)return!1;return e&&e(t.tr.join(r).scrollIntoView()),!0},Xd=(t,e)=>{let{$from:n,$to:r}=t.selection,i=n.blockRange(r),o=i&&Be(i);return o==null?!1:(e&&e(t.tr.lift(i,o).scrollIntoView()),!0)},Ms=(t,e)=>{let{$head:n,$anchor:r}=t.selection;return!n.parent.type.spec.code||!n.sameParent(r)?!1:(e&&e(t.tr.insertText(`
-`).scrollIntoView()),!0)};function Ts(t){for(let e=0;e<t.edgeCount;e++){let{type:n}=t.edge(e);if(n.isTextblock&&!n.hasRequiredAttrs())return n}return null}var As=(t,e)=>{let{$head:n,$anchor:r}=t.selection;if(!n.parent.type.spec.code||!n.sameParent(r))return!1;let i=n.node(-1),o=n.indexAfter(-1),s=Ts(i.contentMatchAt(o));if(!s||!i.canReplaceWith(o,o,s))return!1;if(e){let l=n.after(),a=t.tr.replaceWith(l,l,s.createAndFill());a.setSelection(M.near(a.doc.resolve(l),1)),e(a.scrollIntoView())}return!0},Os=(t,e)=>{let n=t.selection,{$from:r,$to:i}=n;if(n instanceof ae||r.parent.inlineContent||i.parent.inlineContent)return!1;let o=Ts(i.parent.contentMatchAt(i.indexAfter()));if(!o||!o.isTextblock)return!1;if(e){let s=(!r.parentOffset&&i.index()<i.parent.childCount?r:i).pos,l=t.tr.insert(s,o.createAndFill());l.setSelection(S.create(l.doc,s+1)),e(l.scrollIntoView())}return!0},Rs=(t,e)=>{let{$cursor:n}=t.selection;if(!n||n.parent.content.size)return!1;if(n.depth>1&&n.after()!=n.end(-1)){let o=n.before();if(ge(t.doc,o))return e&&e(t.tr.split(o).scrollIntoView()),!0}let r=n.blockRange(),i=r&&Be(r);return i==null?!1:(e&&e(t.tr.lift(r,i).scrollIntoView()),!0)};function og(t){return(e,n)=>{let{$from:r,$to:i}=e.selection;if(e.selection instanceof C&&e.selection.node.isBlock)return!r.parentOffset||!ge(e.doc,r.pos)?!1:(n&&n(e.tr.split(r.pos).scrollIntoView()),!0);if(!r.depth)return!1;let o=[],s,l,a=!1,c=!1;for(let h=r.depth;;h--)if(r.node(h).isBlock){a=r.end(h)==r.pos+(r.depth-h),c=r.start(h)==r.pos-(r.depth-h),l=Ts(r.node(h-1).contentMatchAt(r.indexAfter(h-1)));let m=t&&t(i.parent,a,r);o.unshift(m||(a&&l?{type:l}:null)),s=h;break}else{if(h==1)return!1;o.unshift(null)}let d=e.tr;(e.selection instanceof S||e.selection instanceof ae)&&d.deleteSelection();let u=d.mapping.map(r.pos),f=ge(d.doc,u,o.length,o);if(f||(o[0]=l?{type:l}:null,f=ge(d.doc,u,o.length,o)),!f)return!1;if(d.split(u,o.length,o),!a&&c&&r.node(s).type!=l){let h=d.mapping.map(r.before(s)),p=d.doc.resolve(h);l&&r.node(s-1).canReplaceWith(p.index(),p.index()+1,l)&&d.setNodeMarkup(d.mapping.map(r.before(s)),l)}return n&&n(d.scrollIntoView()),!0}}var sg=og();var Zd=(t,e)=>{let{$from:n,to:r}=t.selection,i,o=n.sharedDepth(r);return o==0?!1:(i=n.before(o),e&&e(t.tr.setSelection(C.create(t.doc,i))),!0)},lg=(t,e)=>(e&&e(t.tr.setSelection(new ae(t.doc))),!0);function ag(t,e,n){let r=e.nodeBefore,i=e.nodeAfter,o=e.index();return!r||!i||!r.type.compatibleContent(i.type)?!1:!r.content.size&&e.parent.canReplace(o-1,o)?(n&&n(t.tr.delete(e.pos-r.nodeSize,e.pos).scrollIntoView()),!0):!e.parent.canReplace(o,o+1)||!(i.isTextblock||Me(t.doc,e.pos))?!1:(n&&n(t.tr.join(e.pos).scrollIntoView()),!0)}function eu(t,e,n,r){let i=e.nodeBefore,o=e.nodeAfter,s,l,a=i.type.spec.isolating||o.type.spec.isolating;if(!a&&ag(t,e,n))return!0;let c=!a&&e.parent.canReplace(e.index(),e.index()+1);if(c&&(s=(l=i.contentMatchAt(i.childCount)).findWrapping(o.type))&&l.matchType(s[0]||o.type).validEnd){if(n){let h=e.pos+o.nodeSize,p=v.empty;for(let y=s.length-1;y>=0;y--)p=v.from(s[y].create(null,p));p=v.from(i.copy(p));let m=t.tr.step(new W(e.pos-1,h,e.pos,h,new x(p,1,0),s.length,!0)),g=m.doc.resolve(h+2*s.length);g.nodeAfter&&g.nodeAfter.type==i.type&&Me(m.doc,g.pos)&&m.join(g.pos),n(m.scrollIntoView())}return!0}let d=o.type.spec.isolating||r>0&&a?null:M.findFrom(e,1),u=d&&d.$from.blockRange(d.$to),f=u&&Be(u);if(f!=null&&f>=e.depth)return n&&n(t.tr.lift(u,f).scrollIntoView()),!0;if(c&&tn(o,"start",!0)&&tn(i,"end")){let h=i,p=[];for(;p.push(h),!h.isTextblock;)h=h.lastChild;let m=o,g=1;for(;!m.isTextblock;m=m.firstChild)g++;if(h.canReplace(h.childCount,h.childCount,m.content)){if(n){let y=v.empty;for(let E=p.length-1;E>=0;E--)y=v.from(p[E].copy(y));let k=t.tr.step(new W(e.pos-p.length,e.pos+o.nodeSize,e.pos+g,e.pos+o.nodeSize-g,new x(y,p.length,0),0,!0));n(k.scrollIntoView())}return!0}}return!1}function tu(t){return function(e,n){let r=e.selection,i=t<0?r.$from:r.$to,o=i.depth;for(;i.node(o).isInline;){if(!o)return!1;o--}return i.node(o).isTextblock?(n&&n(e.tr.setSelection(S.create(e.doc,t<0?i.start(o):i.end(o)))),!0):!1}}var Ns=tu(-1),Ls=tu(1);function nu(t,e=null){return function(n,r){let{$from:i,$to:o}=n.selection,s=i.blockRange(o),l=s&&Ut(s,t,e);return l?(r&&r(n.tr.wrap(s,l).scrollIntoView()),!0):!1}}function _s(t,e=null){return function(n,r){let i=!1;for(let o=0;o<n.selection.ranges.length&&!i;o++){let{$from:{pos:s},$to:{pos:l}}=n.selection.ranges[o];n.doc.nodesBetween(s,l,(a,c)=>{if(i)return!1;if(!(!a.isTextblock||a.hasMarkup(t,e)))if(a.type==t)i=!0;else{let d=n.doc.resolve(c),u=d.index();i=d.parent.canReplaceWith(u,u+1,t)}})}if(!i)return!1;if(r){let o=n.tr;for(let s=0;s<n.selection.ranges.length;s++){let{$from:{pos:l},$to:{pos:a}}=n.selection.ranges[s];o.setBlockType(l,a,t,e)}r(o.scrollIntoView())}return!0}}function Is(...t){return function(e,n,r){for(let i=0;i<t.length;i++)if(t[i](e,n,r))return!0;return!1}}var vs=Is(qr,ks,xs),Ud=Is(qr,Es,Ss),nt={Enter:Is(Ms,Os,Rs,sg),"Mod-Enter":As,Backspace:vs,"Mod-Backspace":vs,"Shift-Backspace":vs,Delete:Ud,"Mod-Delete":Ud,"Mod-a":lg},cg={"Ctrl-h":nt.Backspace,"Alt-Backspace":nt["Mod-Backspace"],"Ctrl-d":nt.Delete,"Ctrl-Alt-Backspace":nt["Mod-Delete"],"Alt-Delete":nt["Mod-Delete"],"Alt-d":nt["Mod-Delete"],"Ctrl-a":Ns,"Ctrl-e":Ls};for(let t in nt)cg[t]=nt[t];var wv=typeof navigator!="undefined"?/Mac|iP(hone|[oa]d)/.test(navigator.platform):typeof os!="undefined"&&os.platform?os.platform()=="darwin":!1;function ru(t,e=null){return function(n,r){let{$from:i,$to:o}=n.selection,s=i.blockRange(o);if(!s)return!1;let l=r?n.tr:null;return dg(l,s,t,e)?(r&&r(l.scrollIntoView()),!0):!1}}function dg(t,e,n,r=null){let i=!1,o=e,s=e.$from.doc;if(e.depth>=2&&e.$from.node(e.depth-1).type.compatibleContent(n)&&e.startIndex==0){if(e.$from.index(e.depth-1)==0)return!1;let a=s.resolve(e.start-2);o=new bt(a,a,e.depth),e.endIndex<e.parent.childCount&&(e=new bt(e.$from,s.resolve(e.$to.end(e.depth)),e.depth)),i=!0}let l=Ut(o,n,r,e);return l?(t&&ug(t,e,l,i,n),!0):!1}function ug(t,e,n,r,i){let o=v.empty;for(let d=n.length-1;d>=0;d--)o=v.from(n[d].type.create(n[d].attrs,o));t.step(new W(e.start-(r?2:0),e.end,e.start,e.end,new x(o,0,0),n.length,!0));let s=0;for(let d=0;d<n.length;d++)n[d].type==i&&(s=d+1);let l=n.length-s,a=e.start+n.length-(r?2:0),c=e.parent;for(let d=e.startIndex,u=e.endIndex,f=!0;d<u;d++,f=!1)!f&&ge(t.doc,a,l)&&(t.split(a,l),a+=2*l),a+=c.child(d).nodeSize;return t}function iu(t){return function(e,n){let{$from:r,$to:i}=e.selection,o=r.blockRange(i,s=>s.childCount>0&&s.firstChild.type==t);return o?n?r.node(o.depth-1).type==t?fg(e,n,t,o):hg(e,n,o):!0:!1}}function fg(t,e,n,r){let i=t.tr,o=r.end,s=r.$to.end(r.depth);o<s&&(i.step(new W(o-1,s,o,s,new x(v.from(n.create(null,r.parent.copy())),1,0),1,!0)),r=new bt(i.doc.resolve(r.$from.pos),i.doc.resolve(s),r.depth));let l=Be(r);if(l==null)return!1;i.lift(r,l);let a=i.doc.resolve(i.mapping.map(o,-1)-1);return Me(i.doc,a.pos)&&a.nodeBefore.type==a.nodeAfter.type&&i.join(a.pos),e(i.scrollIntoView()),!0}function hg(t,e,n){let r=t.tr,i=n.parent;for(let h=n.end,p=n.endIndex-1,m=n.startIndex;p>m;p--)h-=i.child(p).nodeSize,r.delete(h-1,h+1);let o=r.doc.resolve(n.start),s=o.nodeAfter;if(r.mapping.map(n.end)!=n.start+o.nodeAfter.nodeSize)return!1;let l=n.startIndex==0,a=n.endIndex==i.childCount,c=o.node(-1),d=o.index(-1);if(!c.canReplace(d+(l?0:1),d+1,s.content.append(a?v.empty:v.from(i))))return!1;let u=o.pos,f=u+s.nodeSize;return r.step(new W(u-(l?1:0),f+(a?1:0),u+1,f-1,new x((l?v.empty:v.from(i.copy(v.empty))).append(a?v.empty:v.from(i.copy(v.empty))),l?0:1,a?0:1),l?0:1)),e(r.scrollIntoView()),!0}function ou(t){return function(e,n){let{$from:r,$to:i}=e.selection,o=r.blockRange(i,c=>c.childCount>0&&c.firstChild.type==t);if(!o)return!1;let s=o.startIndex;if(s==0)return!1;let l=o.parent,a=l.child(s-1);if(a.type!=t)return!1;if(n){let c=a.lastChild&&a.lastChild.type==l.type,d=v.from(c?t.create():null),u=new x(v.from(t.create(null,v.from(l.type.create(null,d)))),c?3:1,0),f=o.start,h=o.end;n(e.tr.step(new W(f-(c?3:1),h,f,h,u,1,!0)).scrollIntoView())}return!0}}function du(t){let{state:e,transaction:n}=t,{selection:r}=n,{doc:i}=n,{storedMarks:o}=n;return{...e,apply:e.apply.bind(e),applyTransaction:e.applyTransaction.bind(e),plugins:e.plugins,schema:e.schema,reconfigure:e.reconfigure.bind(e),toJSON:e.toJSON.bind(e),get storedMarks(){return o},get selection(){return r},get doc(){return i},get tr(){return r=n.selection,i=n.doc,o=n.storedMarks,n}}}var Hs=class{constructor(e){this.editor=e.editor,this.rawCommands=this.editor.extensionManager.commands,this.customState=e.state}get hasCustomState(){return!!this.customState}get state(){return this.customState||this.editor.state}get commands(){let{rawCommands:e,editor:n,state:r}=this,{view:i}=n,{tr:o}=r,s=this.buildProps(o);return Object.fromEntries(Object.entries(e).map(([l,a])=>[l,(...d)=>{let u=a(...d)(s);return!o.getMeta("preventDispatch")&&!this.hasCustomState&&i.dispatch(o),u}]))}get chain(){return()=>this.createChain()}get can(){return()=>this.createCan()}createChain(e,n=!0){let{rawCommands:r,editor:i,state:o}=this,{view:s}=i,l=[],a=!!e,c=e||o.tr,d=()=>(!a&&n&&!c.getMeta("preventDispatch")&&!this.hasCustomState&&s.dispatch(c),l.every(f=>f===!0)),u={...Object.fromEntries(Object.entries(r).map(([f,h])=>[f,(...m)=>{let g=this.buildProps(c,n),y=h(...m)(g);return l.push(y),u}])),run:d};return u}createCan(e){let{rawCommands:n,state:r}=this,i=!1,o=e||r.tr,s=this.buildProps(o,i);return{...Object.fromEntries(Object.entries(n).map(([a,c])=>[a,(...d)=>c(...d)({...s,dispatch:void 0})])),chain:()=>this.createChain(o,i)}}buildProps(e,n=!0){let{rawCommands:r,editor:i,state:o}=this,{view:s}=i,l={tr:e,editor:i,view:s,state:du({state:o,transaction:e}),dispatch:n?()=>{}:void 0,chain:()=>this.createChain(e,n),can:()=>this.createCan(e),get commands(){return Object.fromEntries(Object.entries(r).map(([a,c])=>[a,(...d)=>c(...d)(l)]))}};return l}};function X(t,e,n){return t.config[e]===void 0&&t.parent?X(t.parent,e,n):typeof t.config[e]=="function"?t.config[e].bind({...n,parent:t.parent?X(t.parent,e,n):null}):t.config[e]}function pg(t){let e=t.filter(i=>i.type==="extension"),n=t.filter(i=>i.type==="node"),r=t.filter(i=>i.type==="mark");return{baseExtensions:e,nodeExtensions:n,markExtensions:r}}function Z(t,e){if(typeof t=="string"){if(!e.nodes[t])throw Error(`There is no node type named '${t}'. Maybe you forgot to add the extension?`);return e.nodes[t]}return t}function N(...t){return t.filter(e=>!!e).reduce((e,n)=>{let r={...e};return Object.entries(n).forEach(([i,o])=>{if(!r[i]){r[i]=o;return}if(i==="class"){let l=o?String(o).split(" "):[],a=r[i]?r[i].split(" "):[],c=l.filter(d=>!a.includes(d));r[i]=[...a,...c].join(" ")}else if(i==="style"){let l=o?o.split(";").map(d=>d.trim()).filter(Boolean):[],a=r[i]?r[i].split(";").map(d=>d.trim()).filter(Boolean):[],c=new Map;a.forEach(d=>{let[u,f]=d.split(":").map(h=>h.trim());c.set(u,f)}),l.forEach(d=>{let[u,f]=d.split(":").map(h=>h.trim());c.set(u,f)}),r[i]=Array.from(c.entries()).map(([d,u])=>`${d}: ${u}`).join("; ")}else r[i]=o}),r},{})}function mg(t){return typeof t=="function"}function K(t,e=void 0,...n){return mg(t)?e?t.bind(e)(...n):t(...n):t}function gg(t){return Object.prototype.toString.call(t)==="[object RegExp]"}var nn=class{constructor(e){this.find=e.find,this.handler=e.handler}};function yg(t){return Object.prototype.toString.call(t).slice(8,-1)}function Kr(t){return yg(t)!=="Object"?!1:t.constructor===Object&&Object.getPrototypeOf(t)===Object.prototype}function Qr(t,e){let n={...t};return Kr(t)&&Kr(e)&&Object.keys(e).forEach(r=>{Kr(e[r])&&Kr(t[r])?n[r]=Qr(t[r],e[r]):n[r]=e[r]}),n}var he=class t{constructor(e={}){this.type="mark",this.name="mark",this.parent=null,this.child=null,this.config={name:this.name,defaultOptions:{}},this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=K(X(this,"addOptions",{name:this.name}))),this.storage=K(X(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>Qr(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t(e);return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=K(X(n,"addOptions",{name:n.name})),n.storage=K(X(n,"addStorage",{name:n.name,options:n.options})),n}static handleExit({editor:e,mark:n}){let{tr:r}=e.state,i=e.state.selection.$from;if(i.pos===i.end()){let s=i.marks();if(!!!s.find(c=>(c==null?void 0:c.type.name)===n.name))return!1;let a=s.find(c=>(c==null?void 0:c.type.name)===n.name);return a&&r.removeStoredMark(a),r.insertText(" ",i.pos),e.view.dispatch(r),!0}return!1}};var Fs=class{constructor(e){this.find=e.find,this.handler=e.handler}};var J=class t{constructor(e={}){this.type="extension",this.name="extension",this.parent=null,this.child=null,this.config={name:this.name,defaultOptions:{}},this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=K(X(this,"addOptions",{name:this.name}))),this.storage=K(X(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>Qr(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t({...this.config,...e});return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=K(X(n,"addOptions",{name:n.name})),n.storage=K(X(n,"addStorage",{name:n.name,options:n.options})),n}};function bg(t,e,n){let{from:r,to:i}=e,{blockSeparator:o=`
+`);return{dom:l,text:f,slice:e}}function Dm(t,e,n,r,i){let o=i.parent.type.spec.code,s,a;if(!n&&!e)return null;let l=!!e&&(r||o||!n);if(l){if(t.someProp("transformPastedText",f=>{e=f(e,o||r,t)}),o)return a=new w(C.from(t.state.schema.text(e.replace(/\r\n?/g,`
+`))),0,0),t.someProp("transformPasted",f=>{a=f(a,t,!0)}),a;let u=t.someProp("clipboardTextParser",f=>f(e,i,r,t));if(u)a=u;else{let f=i.marks(),{schema:p}=t.state,h=Jt.fromSchema(p);s=document.createElement("div"),e.split(/(?:\r\n?|\n)+/).forEach(m=>{let g=s.appendChild(document.createElement("p"));m&&g.appendChild(h.serializeNode(p.text(m,f)))})}}else t.someProp("transformPastedHTML",u=>{n=u(n,t)}),s=xS(n),vi&&CS(s);let c=s&&s.querySelector("[data-pm-slice]"),d=c&&/^(\d+) (\d+)(?: -(\d+))? (.*)/.exec(c.getAttribute("data-pm-slice")||"");if(d&&d[3])for(let u=+d[3];u>0;u--){let f=s.firstChild;for(;f&&f.nodeType!=1;)f=f.nextSibling;if(!f)break;s=f}if(a||(a=(t.someProp("clipboardParser")||t.someProp("domParser")||mt.fromSchema(t.state.schema)).parseSlice(s,{preserveWhitespace:!!(l||d),context:i,ruleFromNode(f){return f.nodeName=="BR"&&!f.nextSibling&&f.parentNode&&!yS.test(f.parentNode.nodeName)?{ignore:!0}:null}})),d)a=vS(am(a,+d[1],+d[2]),d[4]);else if(a=w.maxOpen(bS(a.content,i),!0),a.openStart||a.openEnd){let u=0,f=0;for(let p=a.content.firstChild;u<a.openStart&&!p.type.spec.isolating;u++,p=p.firstChild);for(let p=a.content.lastChild;f<a.openEnd&&!p.type.spec.isolating;f++,p=p.lastChild);a=am(a,u,f)}return t.someProp("transformPasted",u=>{a=u(a,t,l)}),a}var yS=/^(a|abbr|acronym|b|cite|code|del|em|i|ins|kbd|label|output|q|ruby|s|samp|span|strong|sub|sup|time|u|tt|var)$/i;function bS(t,e){if(t.childCount<2)return t;for(let n=e.depth;n>=0;n--){let i=e.node(n).contentMatchAt(e.index(n)),o,s=[];if(t.forEach(a=>{if(!s)return;let l=i.findWrapping(a.type),c;if(!l)return s=null;if(c=s.length&&o.length&&Fm(l,o,a,s[s.length-1],0))s[s.length-1]=c;else{s.length&&(s[s.length-1]=_m(s[s.length-1],o.length));let d=Hm(a,l);s.push(d),i=i.matchType(d.type),o=l}}),s)return C.from(s)}return t}function Hm(t,e,n=0){for(let r=e.length-1;r>=n;r--)t=e[r].create(null,C.from(t));return t}function Fm(t,e,n,r,i){if(i<t.length&&i<e.length&&t[i]==e[i]){let o=Fm(t,e,n,r.lastChild,i+1);if(o)return r.copy(r.content.replaceChild(r.childCount-1,o));if(r.contentMatchAt(r.childCount).matchType(i==t.length-1?n.type:t[i+1]))return r.copy(r.content.append(C.from(Hm(n,t,i+1))))}}function _m(t,e){if(e==0)return t;let n=t.content.replaceChild(t.childCount-1,_m(t.lastChild,e-1)),r=t.contentMatchAt(t.childCount).fillBefore(C.empty,!0);return t.copy(n.append(r))}function fc(t,e,n,r,i,o){let s=e<0?t.firstChild:t.lastChild,a=s.content;return t.childCount>1&&(o=0),i<r-1&&(a=fc(a,e,n,r,i+1,o)),i>=n&&(a=e<0?s.contentMatchAt(0).fillBefore(a,o<=i).append(a):a.append(s.contentMatchAt(s.childCount).fillBefore(C.empty,!0))),t.replaceChild(e<0?0:t.childCount-1,s.copy(a))}function am(t,e,n){return e<t.openStart&&(t=new w(fc(t.content,-1,e,t.openStart,0,t.openEnd),e,t.openEnd)),n<t.openEnd&&(t=new w(fc(t.content,1,n,t.openEnd,0,0),t.openStart,n)),t}var Bm={thead:["table"],tbody:["table"],tfoot:["table"],caption:["table"],colgroup:["table"],col:["table","colgroup"],tr:["table","tbody"],td:["table","tbody","tr"],th:["table","tbody","tr"]},lm=null;function zm(){return lm||(lm=document.implementation.createHTMLDocument("title"))}var Ql=null;function ES(t){let e=window.trustedTypes;return e?(Ql||(Ql=e.defaultPolicy||e.createPolicy("ProseMirrorClipboard",{createHTML:n=>n})),Ql.createHTML(t)):t}function xS(t){let e=/^(\s*<meta [^>]*>)*/.exec(t);e&&(t=t.slice(e[0].length));let n=zm().createElement("div"),r=/<([a-z][^>\s]+)/i.exec(t),i;if((i=r&&Bm[r[1].toLowerCase()])&&(t=i.map(o=>"<"+o+">").join("")+t+i.map(o=>"</"+o+">").reverse().join("")),n.innerHTML=ES(t),i)for(let o=0;o<i.length;o++)n=n.querySelector(i[o])||n;return n}function CS(t){let e=t.querySelectorAll(Ce?"span:not([class]):not([style])":"span.Apple-converted-space");for(let n=0;n<e.length;n++){let r=e[n];r.childNodes.length==1&&r.textContent=="\xA0"&&r.parentNode&&r.parentNode.replaceChild(t.ownerDocument.createTextNode(" "),r)}}function vS(t,e){if(!t.size)return t;let n=t.content.firstChild.type.schema,r;try{r=JSON.parse(e)}catch{return t}let{content:i,openStart:o,openEnd:s}=t;for(let a=r.length-2;a>=0;a-=2){let l=n.nodes[r[a]];if(!l||l.hasRequiredAttrs())break;i=C.from(l.create(r[a+1],i)),o++,s++}return new w(i,o,s)}var we={},Ae={},TS={touchstart:!0,touchmove:!0},pc=class{constructor(){this.shiftKey=!1,this.mouseDown=null,this.lastKeyCode=null,this.lastKeyCodeTime=0,this.lastClick={time:0,x:0,y:0,type:"",button:0},this.lastSelectionOrigin=null,this.lastSelectionTime=0,this.lastIOSEnter=0,this.lastIOSEnterFallbackTimeout=-1,this.lastFocus=0,this.lastTouch=0,this.lastChromeDelete=0,this.composing=!1,this.compositionNode=null,this.composingTimeout=-1,this.compositionNodes=[],this.compositionEndedAt=-2e8,this.compositionID=1,this.compositionPendingChanges=0,this.domChangeCount=0,this.eventHandlers=Object.create(null),this.hideSelectionGuard=null}};function SS(t){for(let e in we){let n=we[e];t.dom.addEventListener(e,t.input.eventHandlers[e]=r=>{MS(t,r)&&!Tc(t,r)&&(t.editable||!(r.type in Ae))&&n(t,r)},TS[e]?{passive:!0}:void 0)}Me&&t.dom.addEventListener("input",()=>null),hc(t)}function Xt(t,e){t.input.lastSelectionOrigin=e,t.input.lastSelectionTime=Date.now()}function kS(t){t.domObserver.stop();for(let e in t.input.eventHandlers)t.dom.removeEventListener(e,t.input.eventHandlers[e]);clearTimeout(t.input.composingTimeout),clearTimeout(t.input.lastIOSEnterFallbackTimeout)}function hc(t){t.someProp("handleDOMEvents",e=>{for(let n in e)t.input.eventHandlers[n]||t.dom.addEventListener(n,t.input.eventHandlers[n]=r=>Tc(t,r))})}function Tc(t,e){return t.someProp("handleDOMEvents",n=>{let r=n[e.type];return r?r(t,e)||e.defaultPrevented:!1})}function MS(t,e){if(!e.bubbles)return!0;if(e.defaultPrevented)return!1;for(let n=e.target;n!=t.dom;n=n.parentNode)if(!n||n.nodeType==11||n.pmViewDesc&&n.pmViewDesc.stopEvent(e))return!1;return!0}function wS(t,e){!Tc(t,e)&&we[e.type]&&(t.editable||!(e.type in Ae))&&we[e.type](t,e)}Ae.keydown=(t,e)=>{let n=e;if(t.input.shiftKey=n.keyCode==16||n.shiftKey,!Um(t,n)&&(t.input.lastKeyCode=n.keyCode,t.input.lastKeyCodeTime=Date.now(),!(Ot&&Ce&&n.keyCode==13)))if(n.keyCode!=229&&t.domObserver.forceFlush(),xr&&n.keyCode==13&&!n.ctrlKey&&!n.altKey&&!n.metaKey){let r=Date.now();t.input.lastIOSEnter=r,t.input.lastIOSEnterFallbackTimeout=setTimeout(()=>{t.input.lastIOSEnter==r&&(t.someProp("handleKeyDown",i=>i(t,kn(13,"Enter"))),t.input.lastIOSEnter=0)},200)}else t.someProp("handleKeyDown",r=>r(t,n))||gS(t,n)?n.preventDefault():Xt(t,"key")};Ae.keyup=(t,e)=>{e.keyCode==16&&(t.input.shiftKey=!1)};Ae.keypress=(t,e)=>{let n=e;if(Um(t,n)||!n.charCode||n.ctrlKey&&!n.altKey||Xe&&n.metaKey)return;if(t.someProp("handleKeyPress",i=>i(t,n))){n.preventDefault();return}let r=t.state.selection;if(!(r instanceof L)||!r.$from.sameParent(r.$to)){let i=String.fromCharCode(n.charCode),o=()=>t.state.tr.insertText(i).scrollIntoView();!/[\r\n]/.test(i)&&!t.someProp("handleTextInput",s=>s(t,r.$from.pos,r.$to.pos,i,o))&&t.dispatch(o()),n.preventDefault()}};function ls(t){return{left:t.clientX,top:t.clientY}}function AS(t,e){let n=e.x-t.clientX,r=e.y-t.clientY;return n*n+r*r<100}function Sc(t,e,n,r,i){if(r==-1)return!1;let o=t.state.doc.resolve(r);for(let s=o.depth+1;s>0;s--)if(t.someProp(e,a=>s>o.depth?a(t,n,o.nodeAfter,o.before(s),i,!0):a(t,n,o.node(s),o.before(s),i,!1)))return!0;return!1}function br(t,e,n){if(t.focused||t.focus(),t.state.selection.eq(e))return;let r=t.state.tr.setSelection(e);n=="pointer"&&r.setMeta("pointer",!0),t.dispatch(r)}function RS(t,e){if(e==-1)return!1;let n=t.state.doc.resolve(e),r=n.nodeAfter;return r&&r.isAtom&&O.isSelectable(r)?(br(t,new O(n),"pointer"),!0):!1}function OS(t,e){if(e==-1)return!1;let n=t.state.selection,r,i;n instanceof O&&(r=n.node);let o=t.state.doc.resolve(e);for(let s=o.depth+1;s>0;s--){let a=s>o.depth?o.nodeAfter:o.node(s);if(O.isSelectable(a)){r&&n.$from.depth>0&&s>=n.$from.depth&&o.before(n.$from.depth+1)==n.$from.pos?i=o.before(n.$from.depth):i=o.before(s);break}}return i!=null?(br(t,O.create(t.state.doc,i),"pointer"),!0):!1}function LS(t,e,n,r,i){return Sc(t,"handleClickOn",e,n,r)||t.someProp("handleClick",o=>o(t,e,r))||(i?OS(t,n):RS(t,n))}function NS(t,e,n,r){return Sc(t,"handleDoubleClickOn",e,n,r)||t.someProp("handleDoubleClick",i=>i(t,e,r))}function IS(t,e,n,r){return Sc(t,"handleTripleClickOn",e,n,r)||t.someProp("handleTripleClick",i=>i(t,e,r))||PS(t,n,r)}function PS(t,e,n){if(n.button!=0)return!1;let r=t.state.doc;if(e==-1)return r.inlineContent?(br(t,L.create(r,0,r.content.size),"pointer"),!0):!1;let i=r.resolve(e);for(let o=i.depth+1;o>0;o--){let s=o>i.depth?i.nodeAfter:i.node(o),a=i.before(o);if(s.inlineContent)br(t,L.create(r,a+1,a+1+s.content.size),"pointer");else if(O.isSelectable(s))br(t,O.create(r,a),"pointer");else continue;return!0}}function kc(t){return es(t)}var Vm=Xe?"metaKey":"ctrlKey";we.mousedown=(t,e)=>{let n=e;t.input.shiftKey=n.shiftKey;let r=kc(t),i=Date.now(),o="singleClick";i-t.input.lastClick.time<500&&AS(n,t.input.lastClick)&&!n[Vm]&&t.input.lastClick.button==n.button&&(t.input.lastClick.type=="singleClick"?o="doubleClick":t.input.lastClick.type=="doubleClick"&&(o="tripleClick")),t.input.lastClick={time:i,x:n.clientX,y:n.clientY,type:o,button:n.button};let s=t.posAtCoords(ls(n));s&&(o=="singleClick"?(t.input.mouseDown&&t.input.mouseDown.done(),t.input.mouseDown=new mc(t,s,n,!!r)):(o=="doubleClick"?NS:IS)(t,s.pos,s.inside,n)?n.preventDefault():Xt(t,"pointer"))};var mc=class{constructor(e,n,r,i){this.view=e,this.pos=n,this.event=r,this.flushed=i,this.delayedSelectionSync=!1,this.mightDrag=null,this.startDoc=e.state.doc,this.selectNode=!!r[Vm],this.allowDefault=r.shiftKey;let o,s;if(n.inside>-1)o=e.state.doc.nodeAt(n.inside),s=n.inside;else{let d=e.state.doc.resolve(n.pos);o=d.parent,s=d.depth?d.before():0}let a=i?null:r.target,l=a?e.docView.nearestDesc(a,!0):null;this.target=l&&l.nodeDOM.nodeType==1?l.nodeDOM:null;let{selection:c}=e.state;(r.button==0&&o.type.spec.draggable&&o.type.spec.selectable!==!1||c instanceof O&&c.from<=s&&c.to>s)&&(this.mightDrag={node:o,pos:s,addAttr:!!(this.target&&!this.target.draggable),setUneditable:!!(this.target&&Ze&&!this.target.hasAttribute("contentEditable"))}),this.target&&this.mightDrag&&(this.mightDrag.addAttr||this.mightDrag.setUneditable)&&(this.view.domObserver.stop(),this.mightDrag.addAttr&&(this.target.draggable=!0),this.mightDrag.setUneditable&&setTimeout(()=>{this.view.input.mouseDown==this&&this.target.setAttribute("contentEditable","false")},20),this.view.domObserver.start()),e.root.addEventListener("mouseup",this.up=this.up.bind(this)),e.root.addEventListener("mousemove",this.move=this.move.bind(this)),Xt(e,"pointer")}done(){this.view.root.removeEventListener("mouseup",this.up),this.view.root.removeEventListener("mousemove",this.move),this.mightDrag&&this.target&&(this.view.domObserver.stop(),this.mightDrag.addAttr&&this.target.removeAttribute("draggable"),this.mightDrag.setUneditable&&this.target.removeAttribute("contentEditable"),this.view.domObserver.start()),this.delayedSelectionSync&&setTimeout(()=>Lt(this.view)),this.view.input.mouseDown=null}up(e){if(this.done(),!this.view.dom.contains(e.target))return;let n=this.pos;this.view.state.doc!=this.startDoc&&(n=this.view.posAtCoords(ls(e))),this.updateAllowDefault(e),this.allowDefault||!n?Xt(this.view,"pointer"):LS(this.view,n.pos,n.inside,e,this.selectNode)?e.preventDefault():e.button==0&&(this.flushed||Me&&this.mightDrag&&!this.mightDrag.node.isAtom||Ce&&!this.view.state.selection.visible&&Math.min(Math.abs(n.pos-this.view.state.selection.from),Math.abs(n.pos-this.view.state.selection.to))<=2)?(br(this.view,D.near(this.view.state.doc.resolve(n.pos)),"pointer"),e.preventDefault()):Xt(this.view,"pointer")}move(e){this.updateAllowDefault(e),Xt(this.view,"pointer"),e.buttons==0&&this.done()}updateAllowDefault(e){!this.allowDefault&&(Math.abs(this.event.x-e.clientX)>4||Math.abs(this.event.y-e.clientY)>4)&&(this.allowDefault=!0)}};we.touchstart=t=>{t.input.lastTouch=Date.now(),kc(t),Xt(t,"pointer")};we.touchmove=t=>{t.input.lastTouch=Date.now(),Xt(t,"pointer")};we.contextmenu=t=>kc(t);function Um(t,e){return t.composing?!0:Me&&Math.abs(e.timeStamp-t.input.compositionEndedAt)<500?(t.input.compositionEndedAt=-2e8,!0):!1}var DS=Ot?5e3:-1;Ae.compositionstart=Ae.compositionupdate=t=>{if(!t.composing){t.domObserver.flush();let{state:e}=t,n=e.selection.$to;if(e.selection instanceof L&&(e.storedMarks||!n.textOffset&&n.parentOffset&&n.nodeBefore.marks.some(r=>r.type.spec.inclusive===!1)))t.markCursor=t.state.storedMarks||n.marks(),es(t,!0),t.markCursor=null;else if(es(t,!e.selection.empty),Ze&&e.selection.empty&&n.parentOffset&&!n.textOffset&&n.nodeBefore.marks.length){let r=t.domSelectionRange();for(let i=r.focusNode,o=r.focusOffset;i&&i.nodeType==1&&o!=0;){let s=o<0?i.lastChild:i.childNodes[o-1];if(!s)break;if(s.nodeType==3){let a=t.domSelection();a&&a.collapse(s,s.nodeValue.length);break}else i=s,o=-1}}t.input.composing=!0}$m(t,DS)};Ae.compositionend=(t,e)=>{t.composing&&(t.input.composing=!1,t.input.compositionEndedAt=e.timeStamp,t.input.compositionPendingChanges=t.domObserver.pendingRecords().length?t.input.compositionID:0,t.input.compositionNode=null,t.input.compositionPendingChanges&&Promise.resolve().then(()=>t.domObserver.flush()),t.input.compositionID++,$m(t,20))};function $m(t,e){clearTimeout(t.input.composingTimeout),e>-1&&(t.input.composingTimeout=setTimeout(()=>es(t),e))}function jm(t){for(t.composing&&(t.input.composing=!1,t.input.compositionEndedAt=FS());t.input.compositionNodes.length>0;)t.input.compositionNodes.pop().markParentsDirty()}function HS(t){let e=t.domSelectionRange();if(!e.focusNode)return null;let n=IT(e.focusNode,e.focusOffset),r=PT(e.focusNode,e.focusOffset);if(n&&r&&n!=r){let i=r.pmViewDesc,o=t.domObserver.lastChangedTextNode;if(n==o||r==o)return o;if(!i||!i.isText(r.nodeValue))return r;if(t.input.compositionNode==r){let s=n.pmViewDesc;if(!(!s||!s.isText(n.nodeValue)))return r}}return n||r}function FS(){let t=document.createEvent("Event");return t.initEvent("event",!0,!0),t.timeStamp}function es(t,e=!1){if(!(Ot&&t.domObserver.flushingSoon>=0)){if(t.domObserver.forceFlush(),jm(t),e||t.docView&&t.docView.dirty){let n=xc(t),r=t.state.selection;return n&&!n.eq(r)?t.dispatch(t.state.tr.setSelection(n)):(t.markCursor||e)&&!r.$from.node(r.$from.sharedDepth(r.to)).inlineContent?t.dispatch(t.state.tr.deleteSelection()):t.updateState(t.state),!0}return!1}}function _S(t,e){if(!t.dom.parentNode)return;let n=t.dom.parentNode.appendChild(document.createElement("div"));n.appendChild(e),n.style.cssText="position: fixed; left: -10000px; top: 10px";let r=getSelection(),i=document.createRange();i.selectNodeContents(e),t.dom.blur(),r.removeAllRanges(),r.addRange(i),setTimeout(()=>{n.parentNode&&n.parentNode.removeChild(n),t.focus()},50)}var bi=He&&Qt<15||xr&&BT<604;we.copy=Ae.cut=(t,e)=>{let n=e,r=t.state.selection,i=n.type=="cut";if(r.empty)return;let o=bi?null:n.clipboardData,s=r.content(),{dom:a,text:l}=vc(t,s);o?(n.preventDefault(),o.clearData(),o.setData("text/html",a.innerHTML),o.setData("text/plain",l)):_S(t,a),i&&t.dispatch(t.state.tr.deleteSelection().scrollIntoView().setMeta("uiEvent","cut"))};function BS(t){return t.openStart==0&&t.openEnd==0&&t.content.childCount==1?t.content.firstChild:null}function zS(t,e){if(!t.dom.parentNode)return;let n=t.input.shiftKey||t.state.selection.$from.parent.type.spec.code,r=t.dom.parentNode.appendChild(document.createElement(n?"textarea":"div"));n||(r.contentEditable="true"),r.style.cssText="position: fixed; left: -10000px; top: 10px",r.focus();let i=t.input.shiftKey&&t.input.lastKeyCode!=45;setTimeout(()=>{t.focus(),r.parentNode&&r.parentNode.removeChild(r),n?Ei(t,r.value,null,i,e):Ei(t,r.textContent,r.innerHTML,i,e)},50)}function Ei(t,e,n,r,i){let o=Dm(t,e,n,r,t.state.selection.$from);if(t.someProp("handlePaste",l=>l(t,i,o||w.empty)))return!0;if(!o)return!1;let s=BS(o),a=s?t.state.tr.replaceSelectionWith(s,r):t.state.tr.replaceSelection(o);return t.dispatch(a.scrollIntoView().setMeta("paste",!0).setMeta("uiEvent","paste")),!0}function Wm(t){let e=t.getData("text/plain")||t.getData("Text");if(e)return e;let n=t.getData("text/uri-list");return n?n.replace(/\r?\n/g," "):""}Ae.paste=(t,e)=>{let n=e;if(t.composing&&!Ot)return;let r=bi?null:n.clipboardData,i=t.input.shiftKey&&t.input.lastKeyCode!=45;r&&Ei(t,Wm(r),r.getData("text/html"),i,n)?n.preventDefault():zS(t,n)};var ts=class{constructor(e,n,r){this.slice=e,this.move=n,this.node=r}},VS=Xe?"altKey":"ctrlKey";function qm(t,e){let n=t.someProp("dragCopies",r=>!r(e));return n!=null?n:!e[VS]}we.dragstart=(t,e)=>{let n=e,r=t.input.mouseDown;if(r&&r.done(),!n.dataTransfer)return;let i=t.state.selection,o=i.empty?null:t.posAtCoords(ls(n)),s;if(!(o&&o.pos>=i.from&&o.pos<=(i instanceof O?i.to-1:i.to))){if(r&&r.mightDrag)s=O.create(t.state.doc,r.mightDrag.pos);else if(n.target&&n.target.nodeType==1){let u=t.docView.nearestDesc(n.target,!0);u&&u.node.type.spec.draggable&&u!=t.docView&&(s=O.create(t.state.doc,u.posBefore))}}let a=(s||t.state.selection).content(),{dom:l,text:c,slice:d}=vc(t,a);(!n.dataTransfer.files.length||!Ce||Cm>120)&&n.dataTransfer.clearData(),n.dataTransfer.setData(bi?"Text":"text/html",l.innerHTML),n.dataTransfer.effectAllowed="copyMove",bi||n.dataTransfer.setData("text/plain",c),t.dragging=new ts(d,qm(t,n),s)};we.dragend=t=>{let e=t.dragging;window.setTimeout(()=>{t.dragging==e&&(t.dragging=null)},50)};Ae.dragover=Ae.dragenter=(t,e)=>e.preventDefault();Ae.drop=(t,e)=>{let n=e,r=t.dragging;if(t.dragging=null,!n.dataTransfer)return;let i=t.posAtCoords(ls(n));if(!i)return;let o=t.state.doc.resolve(i.pos),s=r&&r.slice;s?t.someProp("transformPasted",h=>{s=h(s,t,!1)}):s=Dm(t,Wm(n.dataTransfer),bi?null:n.dataTransfer.getData("text/html"),!1,o);let a=!!(r&&qm(t,n));if(t.someProp("handleDrop",h=>h(t,n,s||w.empty,a))){n.preventDefault();return}if(!s)return;n.preventDefault();let l=s?qo(t.state.doc,o.pos,s):o.pos;l==null&&(l=o.pos);let c=t.state.tr;if(a){let{node:h}=r;h?h.replace(c):c.deleteSelection()}let d=c.mapping.map(l),u=s.openStart==0&&s.openEnd==0&&s.content.childCount==1,f=c.doc;if(u?c.replaceRangeWith(d,d,s.content.firstChild):c.replaceRange(d,d,s),c.doc.eq(f))return;let p=c.doc.resolve(d);if(u&&O.isSelectable(s.content.firstChild)&&p.nodeAfter&&p.nodeAfter.sameMarkup(s.content.firstChild))c.setSelection(new O(p));else{let h=c.mapping.map(l);c.mapping.maps[c.mapping.maps.length-1].forEach((m,g,y,E)=>h=E),c.setSelection(Cc(t,p,c.doc.resolve(h)))}t.focus(),t.dispatch(c.setMeta("uiEvent","drop"))};we.focus=t=>{t.input.lastFocus=Date.now(),t.focused||(t.domObserver.stop(),t.dom.classList.add("ProseMirror-focused"),t.domObserver.start(),t.focused=!0,setTimeout(()=>{t.docView&&t.hasFocus()&&!t.domObserver.currentSelection.eq(t.domSelectionRange())&&Lt(t)},20))};we.blur=(t,e)=>{let n=e;t.focused&&(t.domObserver.stop(),t.dom.classList.remove("ProseMirror-focused"),t.domObserver.start(),n.relatedTarget&&t.dom.contains(n.relatedTarget)&&t.domObserver.currentSelection.clear(),t.focused=!1)};we.beforeinput=(t,e)=>{if(Ce&&Ot&&e.inputType=="deleteContentBackward"){t.domObserver.flushSoon();let{domChangeCount:r}=t.input;setTimeout(()=>{if(t.input.domChangeCount!=r||(t.dom.blur(),t.focus(),t.someProp("handleKeyDown",o=>o(t,kn(8,"Backspace")))))return;let{$cursor:i}=t.state.selection;i&&i.pos>0&&t.dispatch(t.state.tr.delete(i.pos-1,i.pos).scrollIntoView())},50)}};for(let t in Ae)we[t]=Ae[t];function xi(t,e){if(t==e)return!0;for(let n in t)if(t[n]!==e[n])return!1;for(let n in e)if(!(n in t))return!1;return!0}var ns=class t{constructor(e,n){this.toDOM=e,this.spec=n||Rn,this.side=this.spec.side||0}map(e,n,r,i){let{pos:o,deleted:s}=e.mapResult(n.from+i,this.side<0?-1:1);return s?null:new tt(o-r,o-r,this)}valid(){return!0}eq(e){return this==e||e instanceof t&&(this.spec.key&&this.spec.key==e.spec.key||this.toDOM==e.toDOM&&xi(this.spec,e.spec))}destroy(e){this.spec.destroy&&this.spec.destroy(e)}},An=class t{constructor(e,n){this.attrs=e,this.spec=n||Rn}map(e,n,r,i){let o=e.map(n.from+i,this.spec.inclusiveStart?-1:1)-r,s=e.map(n.to+i,this.spec.inclusiveEnd?1:-1)-r;return o>=s?null:new tt(o,s,this)}valid(e,n){return n.from<n.to}eq(e){return this==e||e instanceof t&&xi(this.attrs,e.attrs)&&xi(this.spec,e.spec)}static is(e){return e.type instanceof t}destroy(){}},gc=class t{constructor(e,n){this.attrs=e,this.spec=n||Rn}map(e,n,r,i){let o=e.mapResult(n.from+i,1);if(o.deleted)return null;let s=e.mapResult(n.to+i,-1);return s.deleted||s.pos<=o.pos?null:new tt(o.pos-r,s.pos-r,this)}valid(e,n){let{index:r,offset:i}=e.content.findIndex(n.from),o;return i==n.from&&!(o=e.child(r)).isText&&i+o.nodeSize==n.to}eq(e){return this==e||e instanceof t&&xi(this.attrs,e.attrs)&&xi(this.spec,e.spec)}destroy(){}},tt=class t{constructor(e,n,r){this.from=e,this.to=n,this.type=r}copy(e,n){return new t(e,n,this.type)}eq(e,n=0){return this.type.eq(e.type)&&this.from+n==e.from&&this.to+n==e.to}map(e,n,r){return this.type.map(e,this,n,r)}static widget(e,n,r){return new t(e,e,new ns(n,r))}static inline(e,n,r,i){return new t(e,n,new An(r,i))}static node(e,n,r,i){return new t(e,n,new gc(r,i))}get spec(){return this.type.spec}get inline(){return this.type instanceof An}get widget(){return this.type instanceof ns}},yr=[],Rn={},ve=class t{constructor(e,n){this.local=e.length?e:yr,this.children=n.length?n:yr}static create(e,n){return n.length?is(n,e,0,Rn):xe}find(e,n,r){let i=[];return this.findInner(e==null?0:e,n==null?1e9:n,i,0,r),i}findInner(e,n,r,i,o){for(let s=0;s<this.local.length;s++){let a=this.local[s];a.from<=n&&a.to>=e&&(!o||o(a.spec))&&r.push(a.copy(a.from+i,a.to+i))}for(let s=0;s<this.children.length;s+=3)if(this.children[s]<n&&this.children[s+1]>e){let a=this.children[s]+1;this.children[s+2].findInner(e-a,n-a,r,i+a,o)}}map(e,n,r){return this==xe||e.maps.length==0?this:this.mapInner(e,n,0,0,r||Rn)}mapInner(e,n,r,i,o){let s;for(let a=0;a<this.local.length;a++){let l=this.local[a].map(e,r,i);l&&l.type.valid(n,l)?(s||(s=[])).push(l):o.onRemove&&o.onRemove(this.local[a].spec)}return this.children.length?US(this.children,s||[],e,n,r,i,o):s?new t(s.sort(On),yr):xe}add(e,n){return n.length?this==xe?t.create(e,n):this.addInner(e,n,0):this}addInner(e,n,r){let i,o=0;e.forEach((a,l)=>{let c=l+r,d;if(d=Jm(n,a,c)){for(i||(i=this.children.slice());o<i.length&&i[o]<l;)o+=3;i[o]==l?i[o+2]=i[o+2].addInner(a,d,c+1):i.splice(o,0,l,l+a.nodeSize,is(d,a,c+1,Rn)),o+=3}});let s=Km(o?Gm(n):n,-r);for(let a=0;a<s.length;a++)s[a].type.valid(e,s[a])||s.splice(a--,1);return new t(s.length?this.local.concat(s).sort(On):this.local,i||this.children)}remove(e){return e.length==0||this==xe?this:this.removeInner(e,0)}removeInner(e,n){let r=this.children,i=this.local;for(let o=0;o<r.length;o+=3){let s,a=r[o]+n,l=r[o+1]+n;for(let d=0,u;d<e.length;d++)(u=e[d])&&u.from>a&&u.to<l&&(e[d]=null,(s||(s=[])).push(u));if(!s)continue;r==this.children&&(r=this.children.slice());let c=r[o+2].removeInner(s,a+1);c!=xe?r[o+2]=c:(r.splice(o,3),o-=3)}if(i.length){for(let o=0,s;o<e.length;o++)if(s=e[o])for(let a=0;a<i.length;a++)i[a].eq(s,n)&&(i==this.local&&(i=this.local.slice()),i.splice(a--,1))}return r==this.children&&i==this.local?this:i.length||r.length?new t(i,r):xe}forChild(e,n){if(this==xe)return this;if(n.isLeaf)return t.empty;let r,i;for(let a=0;a<this.children.length;a+=3)if(this.children[a]>=e){this.children[a]==e&&(r=this.children[a+2]);break}let o=e+1,s=o+n.content.size;for(let a=0;a<this.local.length;a++){let l=this.local[a];if(l.from<s&&l.to>o&&l.type instanceof An){let c=Math.max(o,l.from)-o,d=Math.min(s,l.to)-o;c<d&&(i||(i=[])).push(l.copy(c,d))}}if(i){let a=new t(i.sort(On),yr);return r?new rs([a,r]):a}return r||xe}eq(e){if(this==e)return!0;if(!(e instanceof t)||this.local.length!=e.local.length||this.children.length!=e.children.length)return!1;for(let n=0;n<this.local.length;n++)if(!this.local[n].eq(e.local[n]))return!1;for(let n=0;n<this.children.length;n+=3)if(this.children[n]!=e.children[n]||this.children[n+1]!=e.children[n+1]||!this.children[n+2].eq(e.children[n+2]))return!1;return!0}locals(e){return Mc(this.localsInner(e))}localsInner(e){if(this==xe)return yr;if(e.inlineContent||!this.local.some(An.is))return this.local;let n=[];for(let r=0;r<this.local.length;r++)this.local[r].type instanceof An||n.push(this.local[r]);return n}forEachSet(e){e(this)}};ve.empty=new ve([],[]);ve.removeOverlap=Mc;var xe=ve.empty,rs=class t{constructor(e){this.members=e}map(e,n){let r=this.members.map(i=>i.map(e,n,Rn));return t.from(r)}forChild(e,n){if(n.isLeaf)return ve.empty;let r=[];for(let i=0;i<this.members.length;i++){let o=this.members[i].forChild(e,n);o!=xe&&(o instanceof t?r=r.concat(o.members):r.push(o))}return t.from(r)}eq(e){if(!(e instanceof t)||e.members.length!=this.members.length)return!1;for(let n=0;n<this.members.length;n++)if(!this.members[n].eq(e.members[n]))return!1;return!0}locals(e){let n,r=!0;for(let i=0;i<this.members.length;i++){let o=this.members[i].localsInner(e);if(o.length)if(!n)n=o;else{r&&(n=n.slice(),r=!1);for(let s=0;s<o.length;s++)n.push(o[s])}}return n?Mc(r?n:n.sort(On)):yr}static from(e){switch(e.length){case 0:return xe;case 1:return e[0];default:return new t(e.every(n=>n instanceof ve)?e:e.reduce((n,r)=>n.concat(r instanceof ve?r:r.members),[]))}}forEachSet(e){for(let n=0;n<this.members.length;n++)this.members[n].forEachSet(e)}};function US(t,e,n,r,i,o,s){let a=t.slice();for(let c=0,d=o;c<n.maps.length;c++){let u=0;n.maps[c].forEach((f,p,h,m)=>{let g=m-h-(p-f);for(let y=0;y<a.length;y+=3){let E=a[y+1];if(E<0||f>E+d-u)continue;let x=a[y]+d-u;p>=x?a[y+1]=f<=x?-2:-1:f>=d&&g&&(a[y]+=g,a[y+1]+=g)}u+=g}),d=n.maps[c].map(d,-1)}let l=!1;for(let c=0;c<a.length;c+=3)if(a[c+1]<0){if(a[c+1]==-2){l=!0,a[c+1]=-1;continue}let d=n.map(t[c]+o),u=d-i;if(u<0||u>=r.content.size){l=!0;continue}let f=n.map(t[c+1]+o,-1),p=f-i,{index:h,offset:m}=r.content.findIndex(u),g=r.maybeChild(h);if(g&&m==u&&m+g.nodeSize==p){let y=a[c+2].mapInner(n,g,d+1,t[c]+o+1,s);y!=xe?(a[c]=u,a[c+1]=p,a[c+2]=y):(a[c+1]=-2,l=!0)}else l=!0}if(l){let c=$S(a,t,e,n,i,o,s),d=is(c,r,0,s);e=d.local;for(let u=0;u<a.length;u+=3)a[u+1]<0&&(a.splice(u,3),u-=3);for(let u=0,f=0;u<d.children.length;u+=3){let p=d.children[u];for(;f<a.length&&a[f]<p;)f+=3;a.splice(f,0,d.children[u],d.children[u+1],d.children[u+2])}}return new ve(e.sort(On),a)}function Km(t,e){if(!e||!t.length)return t;let n=[];for(let r=0;r<t.length;r++){let i=t[r];n.push(new tt(i.from+e,i.to+e,i.type))}return n}function $S(t,e,n,r,i,o,s){function a(l,c){for(let d=0;d<l.local.length;d++){let u=l.local[d].map(r,i,c);u?n.push(u):s.onRemove&&s.onRemove(l.local[d].spec)}for(let d=0;d<l.children.length;d+=3)a(l.children[d+2],l.children[d]+c+1)}for(let l=0;l<t.length;l+=3)t[l+1]==-1&&a(t[l+2],e[l]+o+1);return n}function Jm(t,e,n){if(e.isLeaf)return null;let r=n+e.nodeSize,i=null;for(let o=0,s;o<t.length;o++)(s=t[o])&&s.from>n&&s.to<r&&((i||(i=[])).push(s),t[o]=null);return i}function Gm(t){let e=[];for(let n=0;n<t.length;n++)t[n]!=null&&e.push(t[n]);return e}function is(t,e,n,r){let i=[],o=!1;e.forEach((a,l)=>{let c=Jm(t,a,l+n);if(c){o=!0;let d=is(c,a,n+l+1,r);d!=xe&&i.push(l,l+a.nodeSize,d)}});let s=Km(o?Gm(t):t,-n).sort(On);for(let a=0;a<s.length;a++)s[a].type.valid(e,s[a])||(r.onRemove&&r.onRemove(s[a].spec),s.splice(a--,1));return s.length||i.length?new ve(s,i):xe}function On(t,e){return t.from-e.from||t.to-e.to}function Mc(t){let e=t;for(let n=0;n<e.length-1;n++){let r=e[n];if(r.from!=r.to)for(let i=n+1;i<e.length;i++){let o=e[i];if(o.from==r.from){o.to!=r.to&&(e==t&&(e=t.slice()),e[i]=o.copy(o.from,r.to),cm(e,i+1,o.copy(r.to,o.to)));continue}else{o.from<r.to&&(e==t&&(e=t.slice()),e[n]=r.copy(r.from,o.from),cm(e,i,r.copy(o.from,r.to)));break}}}return e}function cm(t,e,n){for(;e<t.length&&On(n,t[e])>0;)e++;t.splice(e,0,n)}function Zl(t){let e=[];return t.someProp("decorations",n=>{let r=n(t.state);r&&r!=xe&&e.push(r)}),t.cursorWrapper&&e.push(ve.create(t.state.doc,[t.cursorWrapper.deco])),rs.from(e)}var jS={childList:!0,characterData:!0,characterDataOldValue:!0,attributes:!0,attributeOldValue:!0,subtree:!0},WS=He&&Qt<=11,yc=class{constructor(){this.anchorNode=null,this.anchorOffset=0,this.focusNode=null,this.focusOffset=0}set(e){this.anchorNode=e.anchorNode,this.anchorOffset=e.anchorOffset,this.focusNode=e.focusNode,this.focusOffset=e.focusOffset}clear(){this.anchorNode=this.focusNode=null}eq(e){return e.anchorNode==this.anchorNode&&e.anchorOffset==this.anchorOffset&&e.focusNode==this.focusNode&&e.focusOffset==this.focusOffset}},bc=class{constructor(e,n){this.view=e,this.handleDOMChange=n,this.queue=[],this.flushingSoon=-1,this.observer=null,this.currentSelection=new yc,this.onCharData=null,this.suppressingSelectionUpdates=!1,this.lastChangedTextNode=null,this.observer=window.MutationObserver&&new window.MutationObserver(r=>{for(let i=0;i<r.length;i++)this.queue.push(r[i]);He&&Qt<=11&&r.some(i=>i.type=="childList"&&i.removedNodes.length||i.type=="characterData"&&i.oldValue.length>i.target.nodeValue.length)?this.flushSoon():this.flush()}),WS&&(this.onCharData=r=>{this.queue.push({target:r.target,type:"characterData",oldValue:r.prevValue}),this.flushSoon()}),this.onSelectionChange=this.onSelectionChange.bind(this)}flushSoon(){this.flushingSoon<0&&(this.flushingSoon=window.setTimeout(()=>{this.flushingSoon=-1,this.flush()},20))}forceFlush(){this.flushingSoon>-1&&(window.clearTimeout(this.flushingSoon),this.flushingSoon=-1,this.flush())}start(){this.observer&&(this.observer.takeRecords(),this.observer.observe(this.view.dom,jS)),this.onCharData&&this.view.dom.addEventListener("DOMCharacterDataModified",this.onCharData),this.connectSelection()}stop(){if(this.observer){let e=this.observer.takeRecords();if(e.length){for(let n=0;n<e.length;n++)this.queue.push(e[n]);window.setTimeout(()=>this.flush(),20)}this.observer.disconnect()}this.onCharData&&this.view.dom.removeEventListener("DOMCharacterDataModified",this.onCharData),this.disconnectSelection()}connectSelection(){this.view.dom.ownerDocument.addEventListener("selectionchange",this.onSelectionChange)}disconnectSelection(){this.view.dom.ownerDocument.removeEventListener("selectionchange",this.onSelectionChange)}suppressSelectionUpdates(){this.suppressingSelectionUpdates=!0,setTimeout(()=>this.suppressingSelectionUpdates=!1,50)}onSelectionChange(){if(tm(this.view)){if(this.suppressingSelectionUpdates)return Lt(this.view);if(He&&Qt<=11&&!this.view.state.selection.empty){let e=this.view.domSelectionRange();if(e.focusNode&&Ln(e.focusNode,e.focusOffset,e.anchorNode,e.anchorOffset))return this.flushSoon()}this.flush()}}setCurSelection(){this.currentSelection.set(this.view.domSelectionRange())}ignoreSelectionChange(e){if(!e.focusNode)return!0;let n=new Set,r;for(let o=e.focusNode;o;o=Er(o))n.add(o);for(let o=e.anchorNode;o;o=Er(o))if(n.has(o)){r=o;break}let i=r&&this.view.docView.nearestDesc(r);if(i&&i.ignoreMutation({type:"selection",target:r.nodeType==3?r.parentNode:r}))return this.setCurSelection(),!0}pendingRecords(){if(this.observer)for(let e of this.observer.takeRecords())this.queue.push(e);return this.queue}flush(){let{view:e}=this;if(!e.docView||this.flushingSoon>-1)return;let n=this.pendingRecords();n.length&&(this.queue=[]);let r=e.domSelectionRange(),i=!this.suppressingSelectionUpdates&&!this.currentSelection.eq(r)&&tm(e)&&!this.ignoreSelectionChange(r),o=-1,s=-1,a=!1,l=[];if(e.editable)for(let d=0;d<n.length;d++){let u=this.registerMutation(n[d],l);u&&(o=o<0?u.from:Math.min(u.from,o),s=s<0?u.to:Math.max(u.to,s),u.typeOver&&(a=!0))}if(Ze&&l.length){let d=l.filter(u=>u.nodeName=="BR");if(d.length==2){let[u,f]=d;u.parentNode&&u.parentNode.parentNode==f.parentNode?f.remove():u.remove()}else{let{focusNode:u}=this.currentSelection;for(let f of d){let p=f.parentNode;p&&p.nodeName=="LI"&&(!u||JS(e,u)!=p)&&f.remove()}}}let c=null;o<0&&i&&e.input.lastFocus>Date.now()-200&&Math.max(e.input.lastTouch,e.input.lastClick.time)<Date.now()-300&&as(r)&&(c=xc(e))&&c.eq(D.near(e.state.doc.resolve(0),1))?(e.input.lastFocus=0,Lt(e),this.currentSelection.set(r),e.scrollToSelection()):(o>-1||i)&&(o>-1&&(e.docView.markDirty(o,s),qS(e)),this.handleDOMChange(o,s,a,l),e.docView&&e.docView.dirty?e.updateState(e.state):this.currentSelection.eq(r)||Lt(e),this.currentSelection.set(r))}registerMutation(e,n){if(n.indexOf(e.target)>-1)return null;let r=this.view.docView.nearestDesc(e.target);if(e.type=="attributes"&&(r==this.view.docView||e.attributeName=="contenteditable"||e.attributeName=="style"&&!e.oldValue&&!e.target.getAttribute("style"))||!r||r.ignoreMutation(e))return null;if(e.type=="childList"){for(let d=0;d<e.addedNodes.length;d++){let u=e.addedNodes[d];n.push(u),u.nodeType==3&&(this.lastChangedTextNode=u)}if(r.contentDOM&&r.contentDOM!=r.dom&&!r.contentDOM.contains(e.target))return{from:r.posBefore,to:r.posAfter};let i=e.previousSibling,o=e.nextSibling;if(He&&Qt<=11&&e.addedNodes.length)for(let d=0;d<e.addedNodes.length;d++){let{previousSibling:u,nextSibling:f}=e.addedNodes[d];(!u||Array.prototype.indexOf.call(e.addedNodes,u)<0)&&(i=u),(!f||Array.prototype.indexOf.call(e.addedNodes,f)<0)&&(o=f)}let s=i&&i.parentNode==e.target?me(i)+1:0,a=r.localPosFromDOM(e.target,s,-1),l=o&&o.parentNode==e.target?me(o):e.target.childNodes.length,c=r.localPosFromDOM(e.target,l,1);return{from:a,to:c}}else return e.type=="attributes"?{from:r.posAtStart-r.border,to:r.posAtEnd+r.border}:(this.lastChangedTextNode=e.target,{from:r.posAtStart,to:r.posAtEnd,typeOver:e.target.nodeValue==e.oldValue})}},dm=new WeakMap,um=!1;function qS(t){if(!dm.has(t)&&(dm.set(t,null),["normal","nowrap","pre-line"].indexOf(getComputedStyle(t.dom).whiteSpace)!==-1)){if(t.requiresGeckoHackNode=Ze,um)return;console.warn("ProseMirror expects the CSS white-space property to be set, preferably to 'pre-wrap'. It is recommended to load style/prosemirror.css from the prosemirror-view package."),um=!0}}function fm(t,e){let n=e.startContainer,r=e.startOffset,i=e.endContainer,o=e.endOffset,s=t.domAtPos(t.state.selection.anchor);return Ln(s.node,s.offset,i,o)&&([n,r,i,o]=[i,o,n,r]),{anchorNode:n,anchorOffset:r,focusNode:i,focusOffset:o}}function KS(t,e){if(e.getComposedRanges){let i=e.getComposedRanges(t.root)[0];if(i)return fm(t,i)}let n;function r(i){i.preventDefault(),i.stopImmediatePropagation(),n=i.getTargetRanges()[0]}return t.dom.addEventListener("beforeinput",r,!0),document.execCommand("indent"),t.dom.removeEventListener("beforeinput",r,!0),n?fm(t,n):null}function JS(t,e){for(let n=e.parentNode;n&&n!=t.dom;n=n.parentNode){let r=t.docView.nearestDesc(n,!0);if(r&&r.node.isBlock)return n}return null}function GS(t,e,n){let{node:r,fromOffset:i,toOffset:o,from:s,to:a}=t.docView.parseRange(e,n),l=t.domSelectionRange(),c,d=l.anchorNode;if(d&&t.dom.contains(d.nodeType==1?d:d.parentNode)&&(c=[{node:d,offset:l.anchorOffset}],as(l)||c.push({node:l.focusNode,offset:l.focusOffset})),Ce&&t.input.lastKeyCode===8)for(let g=o;g>i;g--){let y=r.childNodes[g-1],E=y.pmViewDesc;if(y.nodeName=="BR"&&!E){o=g;break}if(!E||E.size)break}let u=t.state.doc,f=t.someProp("domParser")||mt.fromSchema(t.state.schema),p=u.resolve(s),h=null,m=f.parse(r,{topNode:p.parent,topMatch:p.parent.contentMatchAt(p.index()),topOpen:!0,from:i,to:o,preserveWhitespace:p.parent.type.whitespace=="pre"?"full":!0,findPositions:c,ruleFromNode:YS,context:p});if(c&&c[0].pos!=null){let g=c[0].pos,y=c[1]&&c[1].pos;y==null&&(y=g),h={anchor:g+s,head:y+s}}return{doc:m,sel:h,from:s,to:a}}function YS(t){let e=t.pmViewDesc;if(e)return e.parseRule();if(t.nodeName=="BR"&&t.parentNode){if(Me&&/^(ul|ol)$/i.test(t.parentNode.nodeName)){let n=document.createElement("div");return n.appendChild(document.createElement("li")),{skip:n}}else if(t.parentNode.lastChild==t||Me&&/^(tr|table)$/i.test(t.parentNode.nodeName))return{ignore:!0}}else if(t.nodeName=="IMG"&&t.getAttribute("mark-placeholder"))return{ignore:!0};return null}var XS=/^(a|abbr|acronym|b|bd[io]|big|br|button|cite|code|data(list)?|del|dfn|em|i|img|ins|kbd|label|map|mark|meter|output|q|ruby|s|samp|small|span|strong|su[bp]|time|u|tt|var)$/i;function QS(t,e,n,r,i){let o=t.input.compositionPendingChanges||(t.composing?t.input.compositionID:0);if(t.input.compositionPendingChanges=0,e<0){let M=t.input.lastSelectionTime>Date.now()-50?t.input.lastSelectionOrigin:null,I=xc(t,M);if(I&&!t.state.selection.eq(I)){if(Ce&&Ot&&t.input.lastKeyCode===13&&Date.now()-100<t.input.lastKeyCodeTime&&t.someProp("handleKeyDown",b=>b(t,kn(13,"Enter"))))return;let H=t.state.tr.setSelection(I);M=="pointer"?H.setMeta("pointer",!0):M=="key"&&H.scrollIntoView(),o&&H.setMeta("composition",o),t.dispatch(H)}return}let s=t.state.doc.resolve(e),a=s.sharedDepth(n);e=s.before(a+1),n=t.state.doc.resolve(n).after(a+1);let l=t.state.selection,c=GS(t,e,n),d=t.state.doc,u=d.slice(c.from,c.to),f,p;t.input.lastKeyCode===8&&Date.now()-100<t.input.lastKeyCodeTime?(f=t.state.selection.to,p="end"):(f=t.state.selection.from,p="start"),t.input.lastKeyCode=null;let h=t0(u.content,c.doc.content,c.from,f,p);if(h&&t.input.domChangeCount++,(xr&&t.input.lastIOSEnter>Date.now()-225||Ot)&&i.some(M=>M.nodeType==1&&!XS.test(M.nodeName))&&(!h||h.endA>=h.endB)&&t.someProp("handleKeyDown",M=>M(t,kn(13,"Enter")))){t.input.lastIOSEnter=0;return}if(!h)if(r&&l instanceof L&&!l.empty&&l.$head.sameParent(l.$anchor)&&!t.composing&&!(c.sel&&c.sel.anchor!=c.sel.head))h={start:l.from,endA:l.to,endB:l.to};else{if(c.sel){let M=pm(t,t.state.doc,c.sel);if(M&&!M.eq(t.state.selection)){let I=t.state.tr.setSelection(M);o&&I.setMeta("composition",o),t.dispatch(I)}}return}t.state.selection.from<t.state.selection.to&&h.start==h.endB&&t.state.selection instanceof L&&(h.start>t.state.selection.from&&h.start<=t.state.selection.from+2&&t.state.selection.from>=c.from?h.start=t.state.selection.from:h.endA<t.state.selection.to&&h.endA>=t.state.selection.to-2&&t.state.selection.to<=c.to&&(h.endB+=t.state.selection.to-h.endA,h.endA=t.state.selection.to)),He&&Qt<=11&&h.endB==h.start+1&&h.endA==h.start&&h.start>c.from&&c.doc.textBetween(h.start-c.from-1,h.start-c.from+1)==" \xA0"&&(h.start--,h.endA--,h.endB--);let m=c.doc.resolveNoCache(h.start-c.from),g=c.doc.resolveNoCache(h.endB-c.from),y=d.resolve(h.start),E=m.sameParent(g)&&m.parent.inlineContent&&y.end()>=h.endA;if((xr&&t.input.lastIOSEnter>Date.now()-225&&(!E||i.some(M=>M.nodeName=="DIV"||M.nodeName=="P"))||!E&&m.pos<c.doc.content.size&&(!m.sameParent(g)||!m.parent.inlineContent)&&m.pos<g.pos&&!/\S/.test(c.doc.textBetween(m.pos,g.pos,"","")))&&t.someProp("handleKeyDown",M=>M(t,kn(13,"Enter")))){t.input.lastIOSEnter=0;return}if(t.state.selection.anchor>h.start&&e0(d,h.start,h.endA,m,g)&&t.someProp("handleKeyDown",M=>M(t,kn(8,"Backspace")))){Ot&&Ce&&t.domObserver.suppressSelectionUpdates();return}Ce&&h.endB==h.start&&(t.input.lastChromeDelete=Date.now()),Ot&&!E&&m.start()!=g.start()&&g.parentOffset==0&&m.depth==g.depth&&c.sel&&c.sel.anchor==c.sel.head&&c.sel.head==h.endA&&(h.endB-=2,g=c.doc.resolveNoCache(h.endB-c.from),setTimeout(()=>{t.someProp("handleKeyDown",function(M){return M(t,kn(13,"Enter"))})},20));let x=h.start,k=h.endA,S=M=>{let I=M||t.state.tr.replace(x,k,c.doc.slice(h.start-c.from,h.endB-c.from));if(c.sel){let H=pm(t,I.doc,c.sel);H&&!(Ce&&t.composing&&H.empty&&(h.start!=h.endB||t.input.lastChromeDelete<Date.now()-100)&&(H.head==x||H.head==I.mapping.map(k)-1)||He&&H.empty&&H.head==x)&&I.setSelection(H)}return o&&I.setMeta("composition",o),I.scrollIntoView()},R;if(E)if(m.pos==g.pos){He&&Qt<=11&&m.parentOffset==0&&(t.domObserver.suppressSelectionUpdates(),setTimeout(()=>Lt(t),20));let M=S(t.state.tr.delete(x,k)),I=d.resolve(h.start).marksAcross(d.resolve(h.endA));I&&M.ensureMarks(I),t.dispatch(M)}else if(h.endA==h.endB&&(R=ZS(m.parent.content.cut(m.parentOffset,g.parentOffset),y.parent.content.cut(y.parentOffset,h.endA-y.start())))){let M=S(t.state.tr);R.type=="add"?M.addMark(x,k,R.mark):M.removeMark(x,k,R.mark),t.dispatch(M)}else if(m.parent.child(m.index()).isText&&m.index()==g.index()-(g.textOffset?0:1)){let M=m.parent.textBetween(m.parentOffset,g.parentOffset),I=()=>S(t.state.tr.insertText(M,x,k));t.someProp("handleTextInput",H=>H(t,x,k,M,I))||t.dispatch(I())}else t.dispatch(S());else t.dispatch(S())}function pm(t,e,n){return Math.max(n.anchor,n.head)>e.content.size?null:Cc(t,e.resolve(n.anchor),e.resolve(n.head))}function ZS(t,e){let n=t.firstChild.marks,r=e.firstChild.marks,i=n,o=r,s,a,l;for(let d=0;d<r.length;d++)i=r[d].removeFromSet(i);for(let d=0;d<n.length;d++)o=n[d].removeFromSet(o);if(i.length==1&&o.length==0)a=i[0],s="add",l=d=>d.mark(a.addToSet(d.marks));else if(i.length==0&&o.length==1)a=o[0],s="remove",l=d=>d.mark(a.removeFromSet(d.marks));else return null;let c=[];for(let d=0;d<e.childCount;d++)c.push(l(e.child(d)));if(C.from(c).eq(t))return{mark:a,type:s}}function e0(t,e,n,r,i){if(n-e<=i.pos-r.pos||ec(r,!0,!1)<i.pos)return!1;let o=t.resolve(e);if(!r.parent.isTextblock){let a=o.nodeAfter;return a!=null&&n==e+a.nodeSize}if(o.parentOffset<o.parent.content.size||!o.parent.isTextblock)return!1;let s=t.resolve(ec(o,!0,!0));return!s.parent.isTextblock||s.pos>n||ec(s,!0,!1)<n?!1:r.parent.content.cut(r.parentOffset).eq(s.parent.content)}function ec(t,e,n){let r=t.depth,i=e?t.end():t.pos;for(;r>0&&(e||t.indexAfter(r)==t.node(r).childCount);)r--,i++,e=!1;if(n){let o=t.node(r).maybeChild(t.indexAfter(r));for(;o&&!o.isLeaf;)o=o.firstChild,i++}return i}function t0(t,e,n,r,i){let o=t.findDiffStart(e,n);if(o==null)return null;let{a:s,b:a}=t.findDiffEnd(e,n+t.size,n+e.size);if(i=="end"){let l=Math.max(0,o-Math.min(s,a));r-=s+l-o}if(s<o&&t.size<e.size){let l=r<=o&&r>=s?o-r:0;o-=l,o&&o<e.size&&hm(e.textBetween(o-1,o+1))&&(o+=l?1:-1),a=o+(a-s),s=o}else if(a<o){let l=r<=o&&r>=a?o-r:0;o-=l,o&&o<t.size&&hm(t.textBetween(o-1,o+1))&&(o+=l?1:-1),s=o+(s-a),a=o}return{start:o,endA:s,endB:a}}function hm(t){if(t.length!=2)return!1;let e=t.charCodeAt(0),n=t.charCodeAt(1);return e>=56320&&e<=57343&&n>=55296&&n<=56319}var ss=class{constructor(e,n){this._root=null,this.focused=!1,this.trackWrites=null,this.mounted=!1,this.markCursor=null,this.cursorWrapper=null,this.lastSelectedViewDesc=void 0,this.input=new pc,this.prevDirectPlugins=[],this.pluginViews=[],this.requiresGeckoHackNode=!1,this.dragging=null,this._props=n,this.state=n.state,this.directPlugins=n.plugins||[],this.directPlugins.forEach(Em),this.dispatch=this.dispatch.bind(this),this.dom=e&&e.mount||document.createElement("div"),e&&(e.appendChild?e.appendChild(this.dom):typeof e=="function"?e(this.dom):e.mount&&(this.mounted=!0)),this.editable=ym(this),gm(this),this.nodeViews=bm(this),this.docView=Gh(this.state.doc,mm(this),Zl(this),this.dom,this),this.domObserver=new bc(this,(r,i,o,s)=>QS(this,r,i,o,s)),this.domObserver.start(),SS(this),this.updatePluginViews()}get composing(){return this.input.composing}get props(){if(this._props.state!=this.state){let e=this._props;this._props={};for(let n in e)this._props[n]=e[n];this._props.state=this.state}return this._props}update(e){e.handleDOMEvents!=this._props.handleDOMEvents&&hc(this);let n=this._props;this._props=e,e.plugins&&(e.plugins.forEach(Em),this.directPlugins=e.plugins),this.updateStateInner(e.state,n)}setProps(e){let n={};for(let r in this._props)n[r]=this._props[r];n.state=this.state;for(let r in e)n[r]=e[r];this.update(n)}updateState(e){this.updateStateInner(e,this._props)}updateStateInner(e,n){var r;let i=this.state,o=!1,s=!1;e.storedMarks&&this.composing&&(jm(this),s=!0),this.state=e;let a=i.plugins!=e.plugins||this._props.plugins!=n.plugins;if(a||this._props.plugins!=n.plugins||this._props.nodeViews!=n.nodeViews){let p=bm(this);r0(p,this.nodeViews)&&(this.nodeViews=p,o=!0)}(a||n.handleDOMEvents!=this._props.handleDOMEvents)&&hc(this),this.editable=ym(this),gm(this);let l=Zl(this),c=mm(this),d=i.plugins!=e.plugins&&!i.doc.eq(e.doc)?"reset":e.scrollToSelection>i.scrollToSelection?"to selection":"preserve",u=o||!this.docView.matchesNode(e.doc,c,l);(u||!e.selection.eq(i.selection))&&(s=!0);let f=d=="preserve"&&s&&this.dom.style.overflowAnchor==null&&UT(this);if(s){this.domObserver.stop();let p=u&&(He||Ce)&&!this.composing&&!i.selection.empty&&!e.selection.empty&&n0(i.selection,e.selection);if(u){let h=Ce?this.trackWrites=this.domSelectionRange().focusNode:null;this.composing&&(this.input.compositionNode=HS(this)),(o||!this.docView.update(e.doc,c,l,this))&&(this.docView.updateOuterDeco(c),this.docView.destroy(),this.docView=Gh(e.doc,c,l,this.dom,this)),h&&!this.trackWrites&&(p=!0)}p||!(this.input.mouseDown&&this.domObserver.currentSelection.eq(this.domSelectionRange())&&cS(this))?Lt(this,p):(Nm(this,e.selection),this.domObserver.setCurSelection()),this.domObserver.start()}this.updatePluginViews(i),!((r=this.dragging)===null||r===void 0)&&r.node&&!i.doc.eq(e.doc)&&this.updateDraggedNode(this.dragging,i),d=="reset"?this.dom.scrollTop=0:d=="to selection"?this.scrollToSelection():f&&$T(f)}scrollToSelection(){let e=this.domSelectionRange().focusNode;if(!(!e||!this.dom.contains(e.nodeType==1?e:e.parentNode))){if(!this.someProp("handleScrollToSelection",n=>n(this)))if(this.state.selection instanceof O){let n=this.docView.domAfterPos(this.state.selection.from);n.nodeType==1&&$h(this,n.getBoundingClientRect(),e)}else $h(this,this.coordsAtPos(this.state.selection.head,1),e)}}destroyPluginViews(){let e;for(;e=this.pluginViews.pop();)e.destroy&&e.destroy()}updatePluginViews(e){if(!e||e.plugins!=this.state.plugins||this.directPlugins!=this.prevDirectPlugins){this.prevDirectPlugins=this.directPlugins,this.destroyPluginViews();for(let n=0;n<this.directPlugins.length;n++){let r=this.directPlugins[n];r.spec.view&&this.pluginViews.push(r.spec.view(this))}for(let n=0;n<this.state.plugins.length;n++){let r=this.state.plugins[n];r.spec.view&&this.pluginViews.push(r.spec.view(this))}}else for(let n=0;n<this.pluginViews.length;n++){let r=this.pluginViews[n];r.update&&r.update(this,e)}}updateDraggedNode(e,n){let r=e.node,i=-1;if(this.state.doc.nodeAt(r.from)==r.node)i=r.from;else{let o=r.from+(this.state.doc.content.size-n.doc.content.size);(o>0&&this.state.doc.nodeAt(o))==r.node&&(i=o)}this.dragging=new ts(e.slice,e.move,i<0?void 0:O.create(this.state.doc,i))}someProp(e,n){let r=this._props&&this._props[e],i;if(r!=null&&(i=n?n(r):r))return i;for(let s=0;s<this.directPlugins.length;s++){let a=this.directPlugins[s].props[e];if(a!=null&&(i=n?n(a):a))return i}let o=this.state.plugins;if(o)for(let s=0;s<o.length;s++){let a=o[s].props[e];if(a!=null&&(i=n?n(a):a))return i}}hasFocus(){if(He){let e=this.root.activeElement;if(e==this.dom)return!0;if(!e||!this.dom.contains(e))return!1;for(;e&&this.dom!=e&&this.dom.contains(e);){if(e.contentEditable=="false")return!1;e=e.parentElement}return!0}return this.root.activeElement==this.dom}focus(){this.domObserver.stop(),this.editable&&jT(this.dom),Lt(this),this.domObserver.start()}get root(){let e=this._root;if(e==null){for(let n=this.dom.parentNode;n;n=n.parentNode)if(n.nodeType==9||n.nodeType==11&&n.host)return n.getSelection||(Object.getPrototypeOf(n).getSelection=()=>n.ownerDocument.getSelection()),this._root=n}return e||document}updateRoot(){this._root=null}posAtCoords(e){return GT(this,e)}coordsAtPos(e,n=1){return Mm(this,e,n)}domAtPos(e,n=0){return this.docView.domFromPos(e,n)}nodeDOM(e){let n=this.docView.descAt(e);return n?n.nodeDOM:null}posAtDOM(e,n,r=-1){let i=this.docView.posFromDOM(e,n,r);if(i==null)throw new RangeError("DOM position not inside the editor");return i}endOfTextblock(e,n){return eS(this,n||this.state,e)}pasteHTML(e,n){return Ei(this,"",e,!1,n||new ClipboardEvent("paste"))}pasteText(e,n){return Ei(this,e,null,!0,n||new ClipboardEvent("paste"))}serializeForClipboard(e){return vc(this,e)}destroy(){this.docView&&(kS(this),this.destroyPluginViews(),this.mounted?(this.docView.update(this.state.doc,[],Zl(this),this),this.dom.textContent=""):this.dom.parentNode&&this.dom.parentNode.removeChild(this.dom),this.docView.destroy(),this.docView=null,LT())}get isDestroyed(){return this.docView==null}dispatchEvent(e){return wS(this,e)}domSelectionRange(){let e=this.domSelection();return e?Me&&this.root.nodeType===11&&HT(this.dom.ownerDocument)==this.dom&&KS(this,e)||e:{focusNode:null,focusOffset:0,anchorNode:null,anchorOffset:0}}domSelection(){return this.root.getSelection()}};ss.prototype.dispatch=function(t){let e=this._props.dispatchTransaction;e?e.call(this,t):this.updateState(this.state.apply(t))};function mm(t){let e=Object.create(null);return e.class="ProseMirror",e.contenteditable=String(t.editable),t.someProp("attributes",n=>{if(typeof n=="function"&&(n=n(t.state)),n)for(let r in n)r=="class"?e.class+=" "+n[r]:r=="style"?e.style=(e.style?e.style+";":"")+n[r]:!e[r]&&r!="contenteditable"&&r!="nodeName"&&(e[r]=String(n[r]))}),e.translate||(e.translate="no"),[tt.node(0,t.state.doc.content.size,e)]}function gm(t){if(t.markCursor){let e=document.createElement("img");e.className="ProseMirror-separator",e.setAttribute("mark-placeholder","true"),e.setAttribute("alt",""),t.cursorWrapper={dom:e,deco:tt.widget(t.state.selection.from,e,{raw:!0,marks:t.markCursor})}}else t.cursorWrapper=null}function ym(t){return!t.someProp("editable",e=>e(t.state)===!1)}function n0(t,e){let n=Math.min(t.$anchor.sharedDepth(t.head),e.$anchor.sharedDepth(e.head));return t.$anchor.start(n)!=e.$anchor.start(n)}function bm(t){let e=Object.create(null);function n(r){for(let i in r)Object.prototype.hasOwnProperty.call(e,i)||(e[i]=r[i])}return t.someProp("nodeViews",n),t.someProp("markViews",n),e}function r0(t,e){let n=0,r=0;for(let i in t){if(t[i]!=e[i])return!0;n++}for(let i in e)r++;return n!=r}function Em(t){if(t.spec.state||t.spec.filterTransaction||t.spec.appendTransaction)throw new RangeError("Plugins passed directly to the view must not have a state component")}var Nt={8:"Backspace",9:"Tab",10:"Enter",12:"NumLock",13:"Enter",16:"Shift",17:"Control",18:"Alt",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",44:"PrintScreen",45:"Insert",46:"Delete",59:";",61:"=",91:"Meta",92:"Meta",106:"*",107:"+",108:",",109:"-",110:".",111:"/",144:"NumLock",145:"ScrollLock",160:"Shift",161:"Shift",162:"Control",163:"Control",164:"Alt",165:"Alt",173:"-",186:";",187:"=",188:",",189:"-",190:".",191:"/",192:"`",219:"[",220:"\\",221:"]",222:"'"},ds={48:")",49:"!",50:"@",51:"#",52:"$",53:"%",54:"^",55:"&",56:"*",57:"(",59:":",61:"+",173:"_",186:":",187:"+",188:"<",189:"_",190:">",191:"?",192:"~",219:"{",220:"|",221:"}",222:'"'},i0=typeof navigator!="undefined"&&/Mac/.test(navigator.platform),o0=typeof navigator!="undefined"&&/MSIE \d|Trident\/(?:[7-9]|\d{2,})\..*rv:(\d+)/.exec(navigator.userAgent);for(ce=0;ce<10;ce++)Nt[48+ce]=Nt[96+ce]=String(ce);var ce;for(ce=1;ce<=24;ce++)Nt[ce+111]="F"+ce;var ce;for(ce=65;ce<=90;ce++)Nt[ce]=String.fromCharCode(ce+32),ds[ce]=String.fromCharCode(ce);var ce;for(cs in Nt)ds.hasOwnProperty(cs)||(ds[cs]=Nt[cs]);var cs;function Ym(t){var e=i0&&t.metaKey&&t.shiftKey&&!t.ctrlKey&&!t.altKey||o0&&t.shiftKey&&t.key&&t.key.length==1||t.key=="Unidentified",n=!e&&t.key||(t.shiftKey?ds:Nt)[t.keyCode]||t.key||"Unidentified";return n=="Esc"&&(n="Escape"),n=="Del"&&(n="Delete"),n=="Left"&&(n="ArrowLeft"),n=="Up"&&(n="ArrowUp"),n=="Right"&&(n="ArrowRight"),n=="Down"&&(n="ArrowDown"),n}var s0=typeof navigator!="undefined"&&/Mac|iP(hone|[oa]d)/.test(navigator.platform),a0=typeof navigator!="undefined"&&/Win/.test(navigator.platform);function l0(t){let e=t.split(/-(?!$)/),n=e[e.length-1];n=="Space"&&(n=" ");let r,i,o,s;for(let a=0;a<e.length-1;a++){let l=e[a];if(/^(cmd|meta|m)$/i.test(l))s=!0;else if(/^a(lt)?$/i.test(l))r=!0;else if(/^(c|ctrl|control)$/i.test(l))i=!0;else if(/^s(hift)?$/i.test(l))o=!0;else if(/^mod$/i.test(l))s0?s=!0:i=!0;else throw new Error("Unrecognized modifier name: "+l)}return r&&(n="Alt-"+n),i&&(n="Ctrl-"+n),s&&(n="Meta-"+n),o&&(n="Shift-"+n),n}function c0(t){let e=Object.create(null);for(let n in t)e[l0(n)]=t[n];return e}function wc(t,e,n=!0){return e.altKey&&(t="Alt-"+t),e.ctrlKey&&(t="Ctrl-"+t),e.metaKey&&(t="Meta-"+t),n&&e.shiftKey&&(t="Shift-"+t),t}function Xm(t){let e=c0(t);return function(n,r){let i=Ym(r),o,s=e[wc(i,r)];if(s&&s(n.state,n.dispatch,n))return!0;if(i.length==1&&i!=" "){if(r.shiftKey){let a=e[wc(i,r,!1)];if(a&&a(n.state,n.dispatch,n))return!0}if((r.altKey||r.metaKey||r.ctrlKey)&&!(a0&&r.ctrlKey&&r.altKey)&&(o=Nt[r.keyCode])&&o!=i){let a=e[wc(o,r)];if(a&&a(n.state,n.dispatch,n))return!0}}return!1}}function te(t,e,n){return t.config[e]===void 0&&t.parent?te(t.parent,e,n):typeof t.config[e]=="function"?t.config[e].bind({...n,parent:t.parent?te(t.parent,e,n):null}):t.config[e]}function Ti(t){let e=t.filter(i=>i.type==="extension"),n=t.filter(i=>i.type==="node"),r=t.filter(i=>i.type==="mark");return{baseExtensions:e,nodeExtensions:n,markExtensions:r}}function U(t,e){if(typeof t=="string"){if(!e.nodes[t])throw Error(`There is no node type named '${t}'. Maybe you forgot to add the extension?`);return e.nodes[t]}return t}function $(...t){return t.filter(e=>!!e).reduce((e,n)=>{let r={...e};return Object.entries(n).forEach(([i,o])=>{if(!r[i]){r[i]=o;return}if(i==="class"){let a=o?String(o).split(" "):[],l=r[i]?r[i].split(" "):[],c=a.filter(d=>!l.includes(d));r[i]=[...l,...c].join(" ")}else if(i==="style"){let a=o?o.split(";").map(d=>d.trim()).filter(Boolean):[],l=r[i]?r[i].split(";").map(d=>d.trim()).filter(Boolean):[],c=new Map;l.forEach(d=>{let[u,f]=d.split(":").map(p=>p.trim());c.set(u,f)}),a.forEach(d=>{let[u,f]=d.split(":").map(p=>p.trim());c.set(u,f)}),r[i]=Array.from(c.entries()).map(([d,u])=>`${d}: ${u}`).join("; ")}else r[i]=o}),r},{})}function Ac(t){return typeof t=="function"}function j(t,e=void 0,...n){return Ac(t)?e?t.bind(e)(...n):t(...n):t}function us(t){return Object.prototype.toString.call(t)==="[object RegExp]"}var ut=class{constructor(e){F(this,"find");F(this,"handler");this.find=e.find,this.handler=e.handler}};function f0(t){return Object.prototype.toString.call(t).slice(8,-1)}function Si(t){return f0(t)!=="Object"?!1:t.constructor===Object&&Object.getPrototypeOf(t)===Object.prototype}function In(t,e){let n={...t};return Si(t)&&Si(e)&&Object.keys(e).forEach(r=>{Si(e[r])&&Si(t[r])?n[r]=In(t[r],e[r]):n[r]=e[r]}),n}var Re=class t{constructor(e={}){F(this,"type","mark");F(this,"name","mark");F(this,"parent",null);F(this,"child",null);F(this,"options");F(this,"storage");F(this,"config",{name:this.name,defaultOptions:{}});this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=j(te(this,"addOptions",{name:this.name}))),this.storage=j(te(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>In(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t(e);return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=j(te(n,"addOptions",{name:n.name})),n.storage=j(te(n,"addStorage",{name:n.name,options:n.options})),n}static handleExit({editor:e,mark:n}){let{tr:r}=e.state,i=e.state.selection.$from;if(i.pos===i.end()){let s=i.marks();if(!!!s.find(c=>(c==null?void 0:c.type.name)===n.name))return!1;let l=s.find(c=>(c==null?void 0:c.type.name)===n.name);return l&&r.removeStoredMark(l),r.insertText(" ",i.pos),e.view.dispatch(r),!0}return!1}};var vr=class{constructor(e){F(this,"find");F(this,"handler");this.find=e.find,this.handler=e.handler}};var Mi={};Vi(Mi,{ClipboardTextSerializer:()=>Qm,Commands:()=>Iy,Drop:()=>Py,Editable:()=>Dy,FocusEvents:()=>Fy,Keymap:()=>_y,Paste:()=>By,Tabindex:()=>zy,blur:()=>Zm,clearContent:()=>eg,clearNodes:()=>tg,command:()=>ng,createParagraphNear:()=>gg,cut:()=>yg,deleteCurrentNode:()=>bg,deleteNode:()=>Eg,deleteRange:()=>xg,deleteSelection:()=>Cg,enter:()=>vg,exitCode:()=>Tg,extendMarkRange:()=>Mg,first:()=>wg,focus:()=>Ag,focusEventsPluginKey:()=>Hy,forEach:()=>Rg,insertContent:()=>Og,insertContentAt:()=>Ig,joinBackward:()=>Hg,joinDown:()=>Dg,joinForward:()=>Fg,joinItemBackward:()=>_g,joinItemForward:()=>Bg,joinTextblockBackward:()=>zg,joinTextblockForward:()=>Vg,joinUp:()=>Pg,keyboardShortcut:()=>Ug,lift:()=>$g,liftEmptyBlock:()=>jg,liftListItem:()=>Jg,newlineInCode:()=>Gg,resetAttributes:()=>Yg,scrollIntoView:()=>Xg,selectAll:()=>Qg,selectNodeBackward:()=>Zg,selectNodeForward:()=>ey,selectParentNode:()=>ty,selectTextblockEnd:()=>ny,selectTextblockStart:()=>ry,setContent:()=>iy,setMark:()=>hy,setMeta:()=>my,setNode:()=>gy,setNodeSelection:()=>yy,setTextSelection:()=>by,sinkListItem:()=>Ey,splitBlock:()=>Cy,splitListItem:()=>vy,toggleList:()=>Ty,toggleMark:()=>Sy,toggleNode:()=>ky,toggleWrap:()=>My,undoInputRule:()=>wy,unsetAllMarks:()=>Ay,unsetMark:()=>Ry,updateAttributes:()=>Oy,wrapIn:()=>Ly,wrapInList:()=>Ny});var Y=class t{constructor(e={}){F(this,"type","extension");F(this,"name","extension");F(this,"parent",null);F(this,"child",null);F(this,"options");F(this,"storage");F(this,"config",{name:this.name,defaultOptions:{}});this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=j(te(this,"addOptions",{name:this.name}))),this.storage=j(te(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>In(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t({...this.config,...e});return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=j(te(n,"addOptions",{name:n.name})),n.storage=j(te(n,"addStorage",{name:n.name,options:n.options})),n}};function Oc(t,e,n){let{from:r,to:i}=e,{blockSeparator:o=`
 
-`,textSerializers:s={}}=n||{},l="";return t.nodesBetween(r,i,(a,c,d,u)=>{var f;a.isBlock&&c>r&&(l+=o);let h=s==null?void 0:s[a.type.name];if(h)return d&&(l+=h({node:a,pos:c,parent:d,index:u,range:e})),!1;a.isText&&(l+=(f=a==null?void 0:a.text)===null||f===void 0?void 0:f.slice(Math.max(r,c)-c,i-c))}),l}function vg(t){return Object.fromEntries(Object.entries(t.nodes).filter(([,e])=>e.spec.toText).map(([e,n])=>[e,n.spec.toText]))}var jv=J.create({name:"clipboardTextSerializer",addOptions(){return{blockSeparator:void 0}},addProseMirrorPlugins(){return[new B({key:new j("clipboardTextSerializer"),props:{clipboardTextSerializer:()=>{let{editor:t}=this,{state:e,schema:n}=t,{doc:r,selection:i}=e,{ranges:o}=i,s=Math.min(...o.map(d=>d.$from.pos)),l=Math.max(...o.map(d=>d.$to.pos)),a=vg(n);return bg(r,{from:s,to:l},{...this.options.blockSeparator!==void 0?{blockSeparator:this.options.blockSeparator}:{},textSerializers:a})}}})]}}),kg=()=>({editor:t,view:e})=>(requestAnimationFrame(()=>{var n;t.isDestroyed||(e.dom.blur(),(n=window==null?void 0:window.getSelection())===null||n===void 0||n.removeAllRanges())}),!0),xg=(t=!1)=>({commands:e})=>e.setContent("",t),Cg=()=>({state:t,tr:e,dispatch:n})=>{let{selection:r}=e,{ranges:i}=r;return n&&i.forEach(({$from:o,$to:s})=>{t.doc.nodesBetween(o.pos,s.pos,(l,a)=>{if(l.type.isText)return;let{doc:c,mapping:d}=e,u=c.resolve(d.map(a)),f=c.resolve(d.map(a+l.nodeSize)),h=u.blockRange(f);if(!h)return;let p=Be(h);if(l.type.isTextblock){let{defaultType:m}=u.parent.contentMatchAt(u.index());e.setNodeMarkup(h.start,m)}(p||p===0)&&e.lift(h,p)})}),!0},Eg=t=>e=>t(e),Sg=()=>({state:t,dispatch:e})=>Os(t,e),wg=(t,e)=>({editor:n,tr:r})=>{let{state:i}=n,o=i.doc.slice(t.from,t.to);r.deleteRange(t.from,t.to);let s=r.mapping.map(e);return r.insert(s,o.content),r.setSelection(new S(r.doc.resolve(Math.max(s-1,0)))),!0},Mg=()=>({tr:t,dispatch:e})=>{let{selection:n}=t,r=n.$anchor.node();if(r.content.size>0)return!1;let i=t.selection.$anchor;for(let o=i.depth;o>0;o-=1)if(i.node(o).type===r.type){if(e){let l=i.before(o),a=i.after(o);t.delete(l,a).scrollIntoView()}return!0}return!1},Tg=t=>({tr:e,state:n,dispatch:r})=>{let i=Z(t,n.schema),o=e.selection.$anchor;for(let s=o.depth;s>0;s-=1)if(o.node(s).type===i){if(r){let a=o.before(s),c=o.after(s);e.delete(a,c).scrollIntoView()}return!0}return!1},Ag=t=>({tr:e,dispatch:n})=>{let{from:r,to:i}=t;return n&&e.delete(r,i),!0},Og=()=>({state:t,dispatch:e})=>qr(t,e),Rg=()=>({commands:t})=>t.keyboardShortcut("Enter"),Ng=()=>({state:t,dispatch:e})=>As(t,e);function Yr(t,e,n={strict:!0}){let r=Object.keys(e);return r.length?r.every(i=>n.strict?e[i]===t[i]:gg(e[i])?e[i].test(t[i]):e[i]===t[i]):!0}function uu(t,e,n={}){return t.find(r=>r.type===e&&Yr(Object.fromEntries(Object.keys(n).map(i=>[i,r.attrs[i]])),n))}function su(t,e,n={}){return!!uu(t,e,n)}function Bs(t,e,n){var r;if(!t||!e)return;let i=t.parent.childAfter(t.parentOffset);if((!i.node||!i.node.marks.some(d=>d.type===e))&&(i=t.parent.childBefore(t.parentOffset)),!i.node||!i.node.marks.some(d=>d.type===e)||(n=n||((r=i.node.marks[0])===null||r===void 0?void 0:r.attrs),!uu([...i.node.marks],e,n)))return;let s=i.index,l=t.start()+i.offset,a=s+1,c=l+i.node.nodeSize;for(;s>0&&su([...t.parent.child(s-1).marks],e,n);)s-=1,l-=t.parent.child(s).nodeSize;for(;a<t.parent.childCount&&su([...t.parent.child(a).marks],e,n);)c+=t.parent.child(a).nodeSize,a+=1;return{from:l,to:c}}function rt(t,e){if(typeof t=="string"){if(!e.marks[t])throw Error(`There is no mark type named '${t}'. Maybe you forgot to add the extension?`);return e.marks[t]}return t}var Lg=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let o=rt(t,r.schema),{doc:s,selection:l}=n,{$from:a,from:c,to:d}=l;if(i){let u=Bs(a,o,e);if(u&&u.from<=c&&u.to>=d){let f=S.create(s,u.from,u.to);n.setSelection(f)}}return!0},_g=t=>e=>{let n=typeof t=="function"?t(e):t;for(let r=0;r<n.length;r+=1)if(n[r](e))return!0;return!1};function fu(t){return t instanceof S}function Ot(t=0,e=0,n=0){return Math.min(Math.max(t,e),n)}function Ig(t,e=null){if(!e)return null;let n=M.atStart(t),r=M.atEnd(t);if(e==="start"||e===!0)return n;if(e==="end")return r;let i=n.from,o=r.to;return e==="all"?S.create(t,Ot(0,i,o),Ot(t.content.size,i,o)):S.create(t,Ot(e,i,o),Ot(e,i,o))}function Dg(){return navigator.platform==="Android"||/android/i.test(navigator.userAgent)}function zs(){return["iPad Simulator","iPhone Simulator","iPod Simulator","iPad","iPhone","iPod"].includes(navigator.platform)||navigator.userAgent.includes("Mac")&&"ontouchend"in document}var Pg=(t=null,e={})=>({editor:n,view:r,tr:i,dispatch:o})=>{e={scrollIntoView:!0,...e};let s=()=>{(zs()||Dg())&&r.dom.focus(),requestAnimationFrame(()=>{n.isDestroyed||(r.focus(),e!=null&&e.scrollIntoView&&n.commands.scrollIntoView())})};if(r.hasFocus()&&t===null||t===!1)return!0;if(o&&t===null&&!fu(n.state.selection))return s(),!0;let l=Ig(i.doc,t)||n.state.selection,a=n.state.selection.eq(l);return o&&(a||i.setSelection(l),a&&i.storedMarks&&i.setStoredMarks(i.storedMarks),s()),!0},Hg=(t,e)=>n=>t.every((r,i)=>e(r,{...n,index:i})),Fg=(t,e)=>({tr:n,commands:r})=>r.insertContentAt({from:n.selection.from,to:n.selection.to},t,e),hu=t=>{let e=t.childNodes;for(let n=e.length-1;n>=0;n-=1){let r=e[n];r.nodeType===3&&r.nodeValue&&/^(\n\s\s|\n)$/.test(r.nodeValue)?t.removeChild(r):r.nodeType===1&&hu(r)}return t};function Jr(t){let e=`<body>${t}</body>`,n=new window.DOMParser().parseFromString(e,"text/html").body;return hu(n)}function Dn(t,e,n){if(t instanceof we||t instanceof v)return t;n={slice:!0,parseOptions:{},...n};let r=typeof t=="object"&&t!==null,i=typeof t=="string";if(r)try{if(Array.isArray(t)&&t.length>0)return v.fromArray(t.map(l=>e.nodeFromJSON(l)));let s=e.nodeFromJSON(t);return n.errorOnInvalidContent&&s.check(),s}catch(o){if(n.errorOnInvalidContent)throw new Error("[tiptap error]: Invalid JSON content",{cause:o});return console.warn("[tiptap warn]: Invalid content.","Passed value:",t,"Error:",o),Dn("",e,n)}if(i){if(n.errorOnInvalidContent){let s=!1,l="",a=new Er({topNode:e.spec.topNode,marks:e.spec.marks,nodes:e.spec.nodes.append({__tiptap__private__unknown__catch__all__node:{content:"inline*",group:"block",parseDOM:[{tag:"*",getAttrs:c=>(s=!0,l=typeof c=="string"?c:c.outerHTML,null)}]}})});if(n.slice?He.fromSchema(a).parseSlice(Jr(t),n.parseOptions):He.fromSchema(a).parse(Jr(t),n.parseOptions),n.errorOnInvalidContent&&s)throw new Error("[tiptap error]: Invalid HTML content",{cause:new Error(`Invalid element found: ${l}`)})}let o=He.fromSchema(e);return n.slice?o.parseSlice(Jr(t),n.parseOptions).content:o.parse(Jr(t),n.parseOptions)}return Dn("",e,n)}function Bg(t,e,n){let r=t.steps.length-1;if(r<e)return;let i=t.steps[r];if(!(i instanceof ne||i instanceof W))return;let o=t.mapping.maps[r],s=0;o.forEach((l,a,c,d)=>{s===0&&(s=d)}),t.setSelection(M.near(t.doc.resolve(s),n))}var zg=t=>!("type"in t),Vg=(t,e,n)=>({tr:r,dispatch:i,editor:o})=>{var s;if(i){n={parseOptions:o.options.parseOptions,updateSelection:!0,applyInputRules:!1,applyPasteRules:!1,...n};let l,a=g=>{o.emit("contentError",{editor:o,error:g,disableCollaboration:()=>{o.storage.collaboration&&(o.storage.collaboration.isDisabled=!0)}})},c={preserveWhitespace:"full",...n.parseOptions};if(!n.errorOnInvalidContent&&!o.options.enableContentCheck&&o.options.emitContentError)try{Dn(e,o.schema,{parseOptions:c,errorOnInvalidContent:!0})}catch(g){a(g)}try{l=Dn(e,o.schema,{parseOptions:c,errorOnInvalidContent:(s=n.errorOnInvalidContent)!==null&&s!==void 0?s:o.options.enableContentCheck})}catch(g){return a(g),!1}let{from:d,to:u}=typeof t=="number"?{from:t,to:t}:{from:t.from,to:t.to},f=!0,h=!0;if((zg(l)?l:[l]).forEach(g=>{g.check(),f=f?g.isText&&g.marks.length===0:!1,h=h?g.isBlock:!1}),d===u&&h){let{parent:g}=r.doc.resolve(d);g.isTextblock&&!g.type.spec.code&&!g.childCount&&(d-=1,u+=1)}let m;if(f){if(Array.isArray(e))m=e.map(g=>g.text||"").join("");else if(e instanceof v){let g="";e.forEach(y=>{y.text&&(g+=y.text)}),m=g}else typeof e=="object"&&e&&e.text?m=e.text:m=e;r.insertText(m,d,u)}else m=l,r.replaceWith(d,u,m);n.updateSelection&&Bg(r,r.steps.length-1,-1),n.applyInputRules&&r.setMeta("applyInputRules",{from:d,text:m}),n.applyPasteRules&&r.setMeta("applyPasteRules",{from:d,text:m})}return!0},$g=()=>({state:t,dispatch:e})=>Yd(t,e),jg=()=>({state:t,dispatch:e})=>Qd(t,e),Ug=()=>({state:t,dispatch:e})=>ks(t,e),Wg=()=>({state:t,dispatch:e})=>Es(t,e),qg=()=>({state:t,dispatch:e,tr:n})=>{try{let r=Wt(t.doc,t.selection.$from.pos,-1);return r==null?!1:(n.join(r,2),e&&e(n),!0)}catch{return!1}},Kg=()=>({state:t,dispatch:e,tr:n})=>{try{let r=Wt(t.doc,t.selection.$from.pos,1);return r==null?!1:(n.join(r,2),e&&e(n),!0)}catch{return!1}},Jg=()=>({state:t,dispatch:e})=>qd(t,e),Gg=()=>({state:t,dispatch:e})=>Kd(t,e);function pu(){return typeof navigator!="undefined"?/Mac/.test(navigator.platform):!1}function Yg(t){let e=t.split(/-(?!$)/),n=e[e.length-1];n==="Space"&&(n=" ");let r,i,o,s;for(let l=0;l<e.length-1;l+=1){let a=e[l];if(/^(cmd|meta|m)$/i.test(a))s=!0;else if(/^a(lt)?$/i.test(a))r=!0;else if(/^(c|ctrl|control)$/i.test(a))i=!0;else if(/^s(hift)?$/i.test(a))o=!0;else if(/^mod$/i.test(a))zs()||pu()?s=!0:i=!0;else throw new Error(`Unrecognized modifier name: ${a}`)}return r&&(n=`Alt-${n}`),i&&(n=`Ctrl-${n}`),s&&(n=`Meta-${n}`),o&&(n=`Shift-${n}`),n}var Qg=t=>({editor:e,view:n,tr:r,dispatch:i})=>{let o=Yg(t).split(/-(?!$)/),s=o.find(c=>!["Alt","Ctrl","Meta","Shift"].includes(c)),l=new KeyboardEvent("keydown",{key:s==="Space"?" ":s,altKey:o.includes("Alt"),ctrlKey:o.includes("Ctrl"),metaKey:o.includes("Meta"),shiftKey:o.includes("Shift"),bubbles:!0,cancelable:!0}),a=e.captureTransaction(()=>{n.someProp("handleKeyDown",c=>c(n,l))});return a==null||a.steps.forEach(c=>{let d=c.map(r.mapping);d&&i&&r.maybeStep(d)}),!0};function Vs(t,e,n={}){let{from:r,to:i,empty:o}=t.selection,s=e?Z(e,t.schema):null,l=[];t.doc.nodesBetween(r,i,(u,f)=>{if(u.isText)return;let h=Math.max(r,f),p=Math.min(i,f+u.nodeSize);l.push({node:u,from:h,to:p})});let a=i-r,c=l.filter(u=>s?s.name===u.node.type.name:!0).filter(u=>Yr(u.node.attrs,n,{strict:!1}));return o?!!c.length:c.reduce((u,f)=>u+f.to-f.from,0)>=a}var Xg=(t,e={})=>({state:n,dispatch:r})=>{let i=Z(t,n.schema);return Vs(n,i,e)?Xd(n,r):!1},Zg=()=>({state:t,dispatch:e})=>Rs(t,e),ey=t=>({state:e,dispatch:n})=>{let r=Z(t,e.schema);return iu(r)(e,n)},ty=()=>({state:t,dispatch:e})=>Ms(t,e);function $s(t,e){return e.nodes[t]?"node":e.marks[t]?"mark":null}function lu(t,e){let n=typeof e=="string"?[e]:e;return Object.keys(t).reduce((r,i)=>(n.includes(i)||(r[i]=t[i]),r),{})}var ny=(t,e)=>({tr:n,state:r,dispatch:i})=>{let o=null,s=null,l=$s(typeof t=="string"?t:t.name,r.schema);return l?(l==="node"&&(o=Z(t,r.schema)),l==="mark"&&(s=rt(t,r.schema)),i&&n.selection.ranges.forEach(a=>{r.doc.nodesBetween(a.$from.pos,a.$to.pos,(c,d)=>{o&&o===c.type&&n.setNodeMarkup(d,void 0,lu(c.attrs,e)),s&&c.marks.length&&c.marks.forEach(u=>{s===u.type&&n.addMark(d,d+c.nodeSize,s.create(lu(u.attrs,e)))})})}),!0):!1},ry=()=>({tr:t,dispatch:e})=>(e&&t.scrollIntoView(),!0),iy=()=>({tr:t,dispatch:e})=>{if(e){let n=new ae(t.doc);t.setSelection(n)}return!0},oy=()=>({state:t,dispatch:e})=>xs(t,e),sy=()=>({state:t,dispatch:e})=>Ss(t,e),ly=()=>({state:t,dispatch:e})=>Zd(t,e),ay=()=>({state:t,dispatch:e})=>Ls(t,e),cy=()=>({state:t,dispatch:e})=>Ns(t,e);function dy(t,e,n={},r={}){return Dn(t,e,{slice:!1,parseOptions:n,errorOnInvalidContent:r.errorOnInvalidContent})}var uy=(t,e=!1,n={},r={})=>({editor:i,tr:o,dispatch:s,commands:l})=>{var a,c;let{doc:d}=o;if(n.preserveWhitespace!=="full"){let u=dy(t,i.schema,n,{errorOnInvalidContent:(a=r.errorOnInvalidContent)!==null&&a!==void 0?a:i.options.enableContentCheck});return s&&o.replaceWith(0,d.content.size,u).setMeta("preventUpdate",!e),!0}return s&&o.setMeta("preventUpdate",!e),l.insertContentAt({from:0,to:d.content.size},t,{parseOptions:n,errorOnInvalidContent:(c=r.errorOnInvalidContent)!==null&&c!==void 0?c:i.options.enableContentCheck})};function mu(t,e){let n=rt(e,t.schema),{from:r,to:i,empty:o}=t.selection,s=[];o?(t.storedMarks&&s.push(...t.storedMarks),s.push(...t.selection.$head.marks())):t.doc.nodesBetween(r,i,a=>{s.push(...a.marks)});let l=s.find(a=>a.type.name===n.name);return l?{...l.attrs}:{}}function gu(t,e){let n=new wn(t);return e.forEach(r=>{r.steps.forEach(i=>{n.step(i)})}),n}function fy(t){for(let e=0;e<t.edgeCount;e+=1){let{type:n}=t.edge(e);if(n.isTextblock&&!n.hasRequiredAttrs())return n}return null}function yu(t,e,n){let r=[];return t.nodesBetween(e.from,e.to,(i,o)=>{n(i)&&r.push({node:i,pos:o})}),r}function hy(t,e){for(let n=t.depth;n>0;n-=1){let r=t.node(n);if(e(r))return{pos:n>0?t.before(n):0,start:t.start(n),depth:n,node:r}}}function js(t){return e=>hy(e.$from,t)}function py(t,e){let n=Z(e,t.schema),{from:r,to:i}=t.selection,o=[];t.doc.nodesBetween(r,i,l=>{o.push(l)});let s=o.reverse().find(l=>l.type.name===n.name);return s?{...s.attrs}:{}}function bu(t,e){let n=$s(typeof e=="string"?e:e.name,t.schema);return n==="node"?py(t,e):n==="mark"?mu(t,e):{}}function my(t,e=JSON.stringify){let n={};return t.filter(r=>{let i=e(r);return Object.prototype.hasOwnProperty.call(n,i)?!1:n[i]=!0})}function gy(t){let e=my(t);return e.length===1?e:e.filter((n,r)=>!e.filter((o,s)=>s!==r).some(o=>n.oldRange.from>=o.oldRange.from&&n.oldRange.to<=o.oldRange.to&&n.newRange.from>=o.newRange.from&&n.newRange.to<=o.newRange.to))}function vu(t){let{mapping:e,steps:n}=t,r=[];return e.maps.forEach((i,o)=>{let s=[];if(i.ranges.length)i.forEach((l,a)=>{s.push({from:l,to:a})});else{let{from:l,to:a}=n[o];if(l===void 0||a===void 0)return;s.push({from:l,to:a})}s.forEach(({from:l,to:a})=>{let c=e.slice(o).map(l,-1),d=e.slice(o).map(a),u=e.invert().map(c,-1),f=e.invert().map(d);r.push({oldRange:{from:u,to:f},newRange:{from:c,to:d}})})}),gy(r)}function Xr(t,e,n){let r=[];return t===e?n.resolve(t).marks().forEach(i=>{let o=n.resolve(t),s=Bs(o,i.type);s&&r.push({mark:i,...s})}):n.nodesBetween(t,e,(i,o)=>{!i||(i==null?void 0:i.nodeSize)===void 0||r.push(...i.marks.map(s=>({from:o,to:o+i.nodeSize,mark:s})))}),r}function Gr(t,e,n){return Object.fromEntries(Object.entries(n).filter(([r])=>{let i=t.find(o=>o.type===e&&o.name===r);return i?i.attribute.keepOnSplit:!1}))}function yy(t,e,n={}){let{empty:r,ranges:i}=t.selection,o=e?rt(e,t.schema):null;if(r)return!!(t.storedMarks||t.selection.$from.marks()).filter(u=>o?o.name===u.type.name:!0).find(u=>Yr(u.attrs,n,{strict:!1}));let s=0,l=[];if(i.forEach(({$from:u,$to:f})=>{let h=u.pos,p=f.pos;t.doc.nodesBetween(h,p,(m,g)=>{if(!m.isText&&!m.marks.length)return;let y=Math.max(h,g),k=Math.min(p,g+m.nodeSize),E=k-y;s+=E,l.push(...m.marks.map(O=>({mark:O,from:y,to:k})))})}),s===0)return!1;let a=l.filter(u=>o?o.name===u.mark.type.name:!0).filter(u=>Yr(u.mark.attrs,n,{strict:!1})).reduce((u,f)=>u+f.to-f.from,0),c=l.filter(u=>o?u.mark.type!==o&&u.mark.type.excludes(o):!0).reduce((u,f)=>u+f.to-f.from,0);return(a>0?a+c:a)>=s}function au(t,e){let{nodeExtensions:n}=pg(e),r=n.find(s=>s.name===t);if(!r)return!1;let i={name:r.name,options:r.options,storage:r.storage},o=K(X(r,"group",i));return typeof o!="string"?!1:o.split(" ").includes("list")}function Zr(t,{checkChildren:e=!0,ignoreWhitespace:n=!1}={}){var r;if(n){if(t.type.name==="hardBreak")return!0;if(t.isText)return/^\s*$/m.test((r=t.text)!==null&&r!==void 0?r:"")}if(t.isText)return!t.text;if(t.isAtom||t.isLeaf)return!1;if(t.content.childCount===0)return!0;if(e){let i=!0;return t.content.forEach(o=>{i!==!1&&(Zr(o,{ignoreWhitespace:n,checkChildren:e})||(i=!1))}),i}return!1}function ku(t){return t instanceof C}function by(t,e,n){var r;let{selection:i}=e,o=null;if(fu(i)&&(o=i.$cursor),o){let l=(r=t.storedMarks)!==null&&r!==void 0?r:o.marks();return!!n.isInSet(l)||!l.some(a=>a.type.excludes(n))}let{ranges:s}=i;return s.some(({$from:l,$to:a})=>{let c=l.depth===0?t.doc.inlineContent&&t.doc.type.allowsMarkType(n):!1;return t.doc.nodesBetween(l.pos,a.pos,(d,u,f)=>{if(c)return!1;if(d.isInline){let h=!f||f.type.allowsMarkType(n),p=!!n.isInSet(d.marks)||!d.marks.some(m=>m.type.excludes(n));c=h&&p}return!c}),c})}var vy=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let{selection:o}=n,{empty:s,ranges:l}=o,a=rt(t,r.schema);if(i)if(s){let c=mu(r,a);n.addStoredMark(a.create({...c,...e}))}else l.forEach(c=>{let d=c.$from.pos,u=c.$to.pos;r.doc.nodesBetween(d,u,(f,h)=>{let p=Math.max(h,d),m=Math.min(h+f.nodeSize,u);f.marks.find(y=>y.type===a)?f.marks.forEach(y=>{a===y.type&&n.addMark(p,m,a.create({...y.attrs,...e}))}):n.addMark(p,m,a.create(e))})});return by(r,n,a)},ky=(t,e)=>({tr:n})=>(n.setMeta(t,e),!0),xy=(t,e={})=>({state:n,dispatch:r,chain:i})=>{let o=Z(t,n.schema),s;return n.selection.$anchor.sameParent(n.selection.$head)&&(s=n.selection.$anchor.parent.attrs),o.isTextblock?i().command(({commands:l})=>_s(o,{...s,...e})(n)?!0:l.clearNodes()).command(({state:l})=>_s(o,{...s,...e})(l,r)).run():(console.warn('[tiptap warn]: Currently "setNode()" only supports text block nodes.'),!1)},Cy=t=>({tr:e,dispatch:n})=>{if(n){let{doc:r}=e,i=Ot(t,0,r.content.size),o=C.create(r,i);e.setSelection(o)}return!0},Ey=t=>({tr:e,dispatch:n})=>{if(n){let{doc:r}=e,{from:i,to:o}=typeof t=="number"?{from:t,to:t}:t,s=S.atStart(r).from,l=S.atEnd(r).to,a=Ot(i,s,l),c=Ot(o,s,l),d=S.create(r,a,c);e.setSelection(d)}return!0},Sy=t=>({state:e,dispatch:n})=>{let r=Z(t,e.schema);return ou(r)(e,n)};function cu(t,e){let n=t.storedMarks||t.selection.$to.parentOffset&&t.selection.$from.marks();if(n){let r=n.filter(i=>e==null?void 0:e.includes(i.type.name));t.tr.ensureMarks(r)}}var wy=({keepMarks:t=!0}={})=>({tr:e,state:n,dispatch:r,editor:i})=>{let{selection:o,doc:s}=e,{$from:l,$to:a}=o,c=i.extensionManager.attributes,d=Gr(c,l.node().type.name,l.node().attrs);if(o instanceof C&&o.node.isBlock)return!l.parentOffset||!ge(s,l.pos)?!1:(r&&(t&&cu(n,i.extensionManager.splittableMarks),e.split(l.pos).scrollIntoView()),!0);if(!l.parent.isBlock)return!1;let u=a.parentOffset===a.parent.content.size,f=l.depth===0?void 0:fy(l.node(-1).contentMatchAt(l.indexAfter(-1))),h=u&&f?[{type:f,attrs:d}]:void 0,p=ge(e.doc,e.mapping.map(l.pos),1,h);if(!h&&!p&&ge(e.doc,e.mapping.map(l.pos),1,f?[{type:f}]:void 0)&&(p=!0,h=f?[{type:f,attrs:d}]:void 0),r){if(p&&(o instanceof S&&e.deleteSelection(),e.split(e.mapping.map(l.pos),1,h),f&&!u&&!l.parentOffset&&l.parent.type!==f)){let m=e.mapping.map(l.before()),g=e.doc.resolve(m);l.node(-1).canReplaceWith(g.index(),g.index()+1,f)&&e.setNodeMarkup(e.mapping.map(l.before()),f)}t&&cu(n,i.extensionManager.splittableMarks),e.scrollIntoView()}return p},My=(t,e={})=>({tr:n,state:r,dispatch:i,editor:o})=>{var s;let l=Z(t,r.schema),{$from:a,$to:c}=r.selection,d=r.selection.node;if(d&&d.isBlock||a.depth<2||!a.sameParent(c))return!1;let u=a.node(-1);if(u.type!==l)return!1;let f=o.extensionManager.attributes;if(a.parent.content.size===0&&a.node(-1).childCount===a.indexAfter(-1)){if(a.depth===2||a.node(-3).type!==l||a.index(-2)!==a.node(-2).childCount-1)return!1;if(i){let y=v.empty,k=a.index(-1)?1:a.index(-2)?2:3;for(let R=a.depth-k;R>=a.depth-3;R-=1)y=v.from(a.node(R).copy(y));let E=a.indexAfter(-1)<a.node(-2).childCount?1:a.indexAfter(-2)<a.node(-3).childCount?2:3,O={...Gr(f,a.node().type.name,a.node().attrs),...e},T=((s=l.contentMatch.defaultType)===null||s===void 0?void 0:s.createAndFill(O))||void 0;y=y.append(v.from(l.createAndFill(null,T)||void 0));let I=a.before(a.depth-(k-1));n.replace(I,a.after(-E),new x(y,4-k,0));let w=-1;n.doc.nodesBetween(I,n.doc.content.size,(R,H)=>{if(w>-1)return!1;R.isTextblock&&R.content.size===0&&(w=H+1)}),w>-1&&n.setSelection(S.near(n.doc.resolve(w))),n.scrollIntoView()}return!0}let h=c.pos===a.end()?u.contentMatchAt(0).defaultType:null,p={...Gr(f,u.type.name,u.attrs),...e},m={...Gr(f,a.node().type.name,a.node().attrs),...e};n.delete(a.pos,c.pos);let g=h?[{type:l,attrs:p},{type:h,attrs:m}]:[{type:l,attrs:p}];if(!ge(n.doc,a.pos,2))return!1;if(i){let{selection:y,storedMarks:k}=r,{splittableMarks:E}=o.extensionManager,O=k||y.$to.parentOffset&&y.$from.marks();if(n.split(a.pos,2,g).scrollIntoView(),!O||!i)return!0;let T=O.filter(I=>E.includes(I.type.name));n.ensureMarks(T)}return!0},Ds=(t,e)=>{let n=js(s=>s.type===e)(t.selection);if(!n)return!0;let r=t.doc.resolve(Math.max(0,n.pos-1)).before(n.depth);if(r===void 0)return!0;let i=t.doc.nodeAt(r);return n.node.type===(i==null?void 0:i.type)&&Me(t.doc,n.pos)&&t.join(n.pos),!0},Ps=(t,e)=>{let n=js(s=>s.type===e)(t.selection);if(!n)return!0;let r=t.doc.resolve(n.start).after(n.depth);if(r===void 0)return!0;let i=t.doc.nodeAt(r);return n.node.type===(i==null?void 0:i.type)&&Me(t.doc,r)&&t.join(r),!0},Ty=(t,e,n,r={})=>({editor:i,tr:o,state:s,dispatch:l,chain:a,commands:c,can:d})=>{let{extensions:u,splittableMarks:f}=i.extensionManager,h=Z(t,s.schema),p=Z(e,s.schema),{selection:m,storedMarks:g}=s,{$from:y,$to:k}=m,E=y.blockRange(k),O=g||m.$to.parentOffset&&m.$from.marks();if(!E)return!1;let T=js(I=>au(I.type.name,u))(m);if(E.depth>=1&&T&&E.depth-T.depth<=1){if(T.node.type===h)return c.liftListItem(p);if(au(T.node.type.name,u)&&h.validContent(T.node.content)&&l)return a().command(()=>(o.setNodeMarkup(T.pos,h),!0)).command(()=>Ds(o,h)).command(()=>Ps(o,h)).run()}return!n||!O||!l?a().command(()=>d().wrapInList(h,r)?!0:c.clearNodes()).wrapInList(h,r).command(()=>Ds(o,h)).command(()=>Ps(o,h)).run():a().command(()=>{let I=d().wrapInList(h,r),w=O.filter(R=>f.includes(R.type.name));return o.ensureMarks(w),I?!0:c.clearNodes()}).wrapInList(h,r).command(()=>Ds(o,h)).command(()=>Ps(o,h)).run()},Ay=(t,e={},n={})=>({state:r,commands:i})=>{let{extendEmptyMarkRange:o=!1}=n,s=rt(t,r.schema);return yy(r,s,e)?i.unsetMark(s,{extendEmptyMarkRange:o}):i.setMark(s,e)},Oy=(t,e,n={})=>({state:r,commands:i})=>{let o=Z(t,r.schema),s=Z(e,r.schema),l=Vs(r,o,n),a;return r.selection.$anchor.sameParent(r.selection.$head)&&(a=r.selection.$anchor.parent.attrs),l?i.setNode(s,a):i.setNode(o,{...a,...n})},Ry=(t,e={})=>({state:n,commands:r})=>{let i=Z(t,n.schema);return Vs(n,i,e)?r.lift(i):r.wrapIn(i,e)},Ny=()=>({state:t,dispatch:e})=>{let n=t.plugins;for(let r=0;r<n.length;r+=1){let i=n[r],o;if(i.spec.isInputRules&&(o=i.getState(t))){if(e){let s=t.tr,l=o.transform;for(let a=l.steps.length-1;a>=0;a-=1)s.step(l.steps[a].invert(l.docs[a]));if(o.text){let a=s.doc.resolve(o.from).marks();s.replaceWith(o.from,o.to,t.schema.text(o.text,a))}else s.delete(o.from,o.to)}return!0}}return!1},Ly=()=>({tr:t,dispatch:e})=>{let{selection:n}=t,{empty:r,ranges:i}=n;return r||e&&i.forEach(o=>{t.removeMark(o.$from.pos,o.$to.pos)}),!0},_y=(t,e={})=>({tr:n,state:r,dispatch:i})=>{var o;let{extendEmptyMarkRange:s=!1}=e,{selection:l}=n,a=rt(t,r.schema),{$from:c,empty:d,ranges:u}=l;if(!i)return!0;if(d&&s){let{from:f,to:h}=l,p=(o=c.marks().find(g=>g.type===a))===null||o===void 0?void 0:o.attrs,m=Bs(c,a,p);m&&(f=m.from,h=m.to),n.removeMark(f,h,a)}else u.forEach(f=>{n.removeMark(f.$from.pos,f.$to.pos,a)});return n.removeStoredMark(a),!0},Iy=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let o=null,s=null,l=$s(typeof t=="string"?t:t.name,r.schema);return l?(l==="node"&&(o=Z(t,r.schema)),l==="mark"&&(s=rt(t,r.schema)),i&&n.selection.ranges.forEach(a=>{let c=a.$from.pos,d=a.$to.pos,u,f,h,p;n.selection.empty?r.doc.nodesBetween(c,d,(m,g)=>{o&&o===m.type&&(h=Math.max(g,c),p=Math.min(g+m.nodeSize,d),u=g,f=m)}):r.doc.nodesBetween(c,d,(m,g)=>{g<c&&o&&o===m.type&&(h=Math.max(g,c),p=Math.min(g+m.nodeSize,d),u=g,f=m),g>=c&&g<=d&&(o&&o===m.type&&n.setNodeMarkup(g,void 0,{...m.attrs,...e}),s&&m.marks.length&&m.marks.forEach(y=>{if(s===y.type){let k=Math.max(g,c),E=Math.min(g+m.nodeSize,d);n.addMark(k,E,s.create({...y.attrs,...e}))}}))}),f&&(u!==void 0&&n.setNodeMarkup(u,void 0,{...f.attrs,...e}),s&&f.marks.length&&f.marks.forEach(m=>{s===m.type&&n.addMark(h,p,s.create({...m.attrs,...e}))}))}),!0):!1},Dy=(t,e={})=>({state:n,dispatch:r})=>{let i=Z(t,n.schema);return nu(i,e)(n,r)},Py=(t,e={})=>({state:n,dispatch:r})=>{let i=Z(t,n.schema);return ru(i,e)(n,r)},Hy=Object.freeze({__proto__:null,blur:kg,clearContent:xg,clearNodes:Cg,command:Eg,createParagraphNear:Sg,cut:wg,deleteCurrentNode:Mg,deleteNode:Tg,deleteRange:Ag,deleteSelection:Og,enter:Rg,exitCode:Ng,extendMarkRange:Lg,first:_g,focus:Pg,forEach:Hg,insertContent:Fg,insertContentAt:Vg,joinBackward:Ug,joinDown:jg,joinForward:Wg,joinItemBackward:qg,joinItemForward:Kg,joinTextblockBackward:Jg,joinTextblockForward:Gg,joinUp:$g,keyboardShortcut:Qg,lift:Xg,liftEmptyBlock:Zg,liftListItem:ey,newlineInCode:ty,resetAttributes:ny,scrollIntoView:ry,selectAll:iy,selectNodeBackward:oy,selectNodeForward:sy,selectParentNode:ly,selectTextblockEnd:ay,selectTextblockStart:cy,setContent:uy,setMark:vy,setMeta:ky,setNode:xy,setNodeSelection:Cy,setTextSelection:Ey,sinkListItem:Sy,splitBlock:wy,splitListItem:My,toggleList:Ty,toggleMark:Ay,toggleNode:Oy,toggleWrap:Ry,undoInputRule:Ny,unsetAllMarks:Ly,unsetMark:_y,updateAttributes:Iy,wrapIn:Dy,wrapInList:Py}),Uv=J.create({name:"commands",addCommands(){return{...Hy}}}),Wv=J.create({name:"drop",addProseMirrorPlugins(){return[new B({key:new j("tiptapDrop"),props:{handleDrop:(t,e,n,r)=>{this.editor.emit("drop",{editor:this.editor,event:e,slice:n,moved:r})}}})]}}),qv=J.create({name:"editable",addProseMirrorPlugins(){return[new B({key:new j("editable"),props:{editable:()=>this.editor.options.editable}})]}}),Fy=new j("focusEvents"),Kv=J.create({name:"focusEvents",addProseMirrorPlugins(){let{editor:t}=this;return[new B({key:Fy,props:{handleDOMEvents:{focus:(e,n)=>{t.isFocused=!0;let r=t.state.tr.setMeta("focus",{event:n}).setMeta("addToHistory",!1);return e.dispatch(r),!1},blur:(e,n)=>{t.isFocused=!1;let r=t.state.tr.setMeta("blur",{event:n}).setMeta("addToHistory",!1);return e.dispatch(r),!1}}}})]}}),Jv=J.create({name:"keymap",addKeyboardShortcuts(){let t=()=>this.editor.commands.first(({commands:s})=>[()=>s.undoInputRule(),()=>s.command(({tr:l})=>{let{selection:a,doc:c}=l,{empty:d,$anchor:u}=a,{pos:f,parent:h}=u,p=u.parent.isTextblock&&f>0?l.doc.resolve(f-1):u,m=p.parent.type.spec.isolating,g=u.pos-u.parentOffset,y=m&&p.parent.childCount===1?g===u.pos:M.atStart(c).from===f;return!d||!h.type.isTextblock||h.textContent.length||!y||y&&u.parent.type.name==="paragraph"?!1:s.clearNodes()}),()=>s.deleteSelection(),()=>s.joinBackward(),()=>s.selectNodeBackward()]),e=()=>this.editor.commands.first(({commands:s})=>[()=>s.deleteSelection(),()=>s.deleteCurrentNode(),()=>s.joinForward(),()=>s.selectNodeForward()]),r={Enter:()=>this.editor.commands.first(({commands:s})=>[()=>s.newlineInCode(),()=>s.createParagraphNear(),()=>s.liftEmptyBlock(),()=>s.splitBlock()]),"Mod-Enter":()=>this.editor.commands.exitCode(),Backspace:t,"Mod-Backspace":t,"Shift-Backspace":t,Delete:e,"Mod-Delete":e,"Mod-a":()=>this.editor.commands.selectAll()},i={...r},o={...r,"Ctrl-h":t,"Alt-Backspace":t,"Ctrl-d":e,"Ctrl-Alt-Backspace":e,"Alt-Delete":e,"Alt-d":e,"Ctrl-a":()=>this.editor.commands.selectTextblockStart(),"Ctrl-e":()=>this.editor.commands.selectTextblockEnd()};return zs()||pu()?o:i},addProseMirrorPlugins(){return[new B({key:new j("clearDocument"),appendTransaction:(t,e,n)=>{if(t.some(m=>m.getMeta("composition")))return;let r=t.some(m=>m.docChanged)&&!e.doc.eq(n.doc),i=t.some(m=>m.getMeta("preventClearDocument"));if(!r||i)return;let{empty:o,from:s,to:l}=e.selection,a=M.atStart(e.doc).from,c=M.atEnd(e.doc).to;if(o||!(s===a&&l===c)||!Zr(n.doc))return;let f=n.tr,h=du({state:n,transaction:f}),{commands:p}=new Hs({editor:this.editor,state:h});if(p.clearNodes(),!!f.steps.length)return f}})]}}),Gv=J.create({name:"paste",addProseMirrorPlugins(){return[new B({key:new j("tiptapPaste"),props:{handlePaste:(t,e,n)=>{this.editor.emit("paste",{editor:this.editor,event:e,slice:n})}}})]}}),Yv=J.create({name:"tabindex",addProseMirrorPlugins(){return[new B({key:new j("tabindex"),props:{attributes:()=>this.editor.isEditable?{tabindex:"0"}:{}}})]}});function Re(t){return new nn({find:t.find,handler:({state:e,range:n,match:r})=>{let i=K(t.getAttributes,void 0,r);if(i===!1||i===null)return null;let{tr:o}=e,s=r[r.length-1],l=r[0];if(s){let a=l.search(/\S/),c=n.from+l.indexOf(s),d=c+s.length;if(Xr(n.from,n.to,e.doc).filter(h=>h.mark.type.excluded.find(m=>m===t.type&&m!==h.mark.type)).filter(h=>h.to>c).length)return null;d<n.to&&o.delete(d,n.to),c>n.from&&o.delete(n.from+a,c);let f=n.from+a+s.length;o.addMark(n.from+a,f,t.type.create(i||{})),o.removeStoredMark(t.type)}}})}function xu(t){return new nn({find:t.find,handler:({state:e,range:n,match:r})=>{let i=K(t.getAttributes,void 0,r)||{},{tr:o}=e,s=n.from,l=n.to,a=t.type.create(i);if(r[1]){let c=r[0].lastIndexOf(r[1]),d=s+c;d>l?d=l:l=d+r[1].length;let u=r[0][r[0].length-1];o.insertText(u,s+r[0].length-1),o.replaceWith(d,l,a)}else if(r[0]){let c=t.type.isInline?s:s-1;o.insert(c,t.type.create(i)).delete(o.mapping.map(s),o.mapping.map(l))}o.scrollIntoView()}})}function Pn(t){return new nn({find:t.find,handler:({state:e,range:n,match:r})=>{let i=e.doc.resolve(n.from),o=K(t.getAttributes,void 0,r)||{};if(!i.node(-1).canReplaceWith(i.index(-1),i.indexAfter(-1),t.type))return null;e.tr.delete(n.from,n.to).setBlockType(n.from,n.from,t.type,o)}})}function it(t){return new nn({find:t.find,handler:({state:e,range:n,match:r,chain:i})=>{let o=K(t.getAttributes,void 0,r)||{},s=e.tr.delete(n.from,n.to),a=s.doc.resolve(n.from).blockRange(),c=a&&Ut(a,t.type,o);if(!c)return null;if(s.wrap(a,c),t.keepMarks&&t.editor){let{selection:u,storedMarks:f}=e,{splittableMarks:h}=t.editor.extensionManager,p=f||u.$to.parentOffset&&u.$from.marks();if(p){let m=p.filter(g=>h.includes(g.type.name));s.ensureMarks(m)}}if(t.keepAttributes){let u=t.type.name==="bulletList"||t.type.name==="orderedList"?"listItem":"taskList";i().updateAttributes(u,o).run()}let d=s.doc.resolve(n.from-1).nodeBefore;d&&d.type===t.type&&Me(s.doc,n.from-1)&&(!t.joinPredicate||t.joinPredicate(r,d))&&s.join(n.from-1)}})}var z=class t{constructor(e={}){this.type="node",this.name="node",this.parent=null,this.child=null,this.config={name:this.name,defaultOptions:{}},this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=K(X(this,"addOptions",{name:this.name}))),this.storage=K(X(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>Qr(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t(e);return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=K(X(n,"addOptions",{name:n.name})),n.storage=K(X(n,"addStorage",{name:n.name,options:n.options})),n}};function Ce(t){return new Fs({find:t.find,handler:({state:e,range:n,match:r,pasteEvent:i})=>{let o=K(t.getAttributes,void 0,r,i);if(o===!1||o===null)return null;let{tr:s}=e,l=r[r.length-1],a=r[0],c=n.to;if(l){let d=a.search(/\S/),u=n.from+a.indexOf(l),f=u+l.length;if(Xr(n.from,n.to,e.doc).filter(p=>p.mark.type.excluded.find(g=>g===t.type&&g!==p.mark.type)).filter(p=>p.to>u).length)return null;f<n.to&&s.delete(f,n.to),u>n.from&&s.delete(n.from+d,u),c=n.from+d+l.length,s.addMark(n.from+d,c,t.type.create(o||{})),s.removeStoredMark(t.type)}}})}function Cu(t,e){let{selection:n}=t,{$from:r}=n;if(n instanceof C){let o=r.index();return r.parent.canReplaceWith(o,o+1,e)}let i=r.depth;for(;i>=0;){let o=r.index(i);if(r.node(i).contentMatchAt(o).matchType(e))return!0;i-=1}return!1}var By=/^\s*>\s$/,Eu=z.create({name:"blockquote",addOptions(){return{HTMLAttributes:{}}},content:"block+",group:"block",defining:!0,parseHTML(){return[{tag:"blockquote"}]},renderHTML({HTMLAttributes:t}){return["blockquote",N(this.options.HTMLAttributes,t),0]},addCommands(){return{setBlockquote:()=>({commands:t})=>t.wrapIn(this.name),toggleBlockquote:()=>({commands:t})=>t.toggleWrap(this.name),unsetBlockquote:()=>({commands:t})=>t.lift(this.name)}},addKeyboardShortcuts(){return{"Mod-Shift-b":()=>this.editor.commands.toggleBlockquote()}},addInputRules(){return[it({find:By,type:this.type})]}});var zy=/(?:^|\s)(\*\*(?!\s+\*\*)((?:[^*]+))\*\*(?!\s+\*\*))$/,Vy=/(?:^|\s)(\*\*(?!\s+\*\*)((?:[^*]+))\*\*(?!\s+\*\*))/g,$y=/(?:^|\s)(__(?!\s+__)((?:[^_]+))__(?!\s+__))$/,jy=/(?:^|\s)(__(?!\s+__)((?:[^_]+))__(?!\s+__))/g,Su=he.create({name:"bold",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"strong"},{tag:"b",getAttrs:t=>t.style.fontWeight!=="normal"&&null},{style:"font-weight=400",clearMark:t=>t.type.name===this.name},{style:"font-weight",getAttrs:t=>/^(bold(er)?|[5-9]\d{2,})$/.test(t)&&null}]},renderHTML({HTMLAttributes:t}){return["strong",N(this.options.HTMLAttributes,t),0]},addCommands(){return{setBold:()=>({commands:t})=>t.setMark(this.name),toggleBold:()=>({commands:t})=>t.toggleMark(this.name),unsetBold:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-b":()=>this.editor.commands.toggleBold(),"Mod-B":()=>this.editor.commands.toggleBold()}},addInputRules(){return[Re({find:zy,type:this.type}),Re({find:$y,type:this.type})]},addPasteRules(){return[Ce({find:Vy,type:this.type}),Ce({find:jy,type:this.type})]}});var Uy="listItem",wu="textStyle",Mu=/^\s*([-+*])\s$/,Tu=z.create({name:"bulletList",addOptions(){return{itemTypeName:"listItem",HTMLAttributes:{},keepMarks:!1,keepAttributes:!1}},group:"block list",content(){return`${this.options.itemTypeName}+`},parseHTML(){return[{tag:"ul"}]},renderHTML({HTMLAttributes:t}){return["ul",N(this.options.HTMLAttributes,t),0]},addCommands(){return{toggleBulletList:()=>({commands:t,chain:e})=>this.options.keepAttributes?e().toggleList(this.name,this.options.itemTypeName,this.options.keepMarks).updateAttributes(Uy,this.editor.getAttributes(wu)).run():t.toggleList(this.name,this.options.itemTypeName,this.options.keepMarks)}},addKeyboardShortcuts(){return{"Mod-Shift-8":()=>this.editor.commands.toggleBulletList()}},addInputRules(){let t=it({find:Mu,type:this.type});return(this.options.keepMarks||this.options.keepAttributes)&&(t=it({find:Mu,type:this.type,keepMarks:this.options.keepMarks,keepAttributes:this.options.keepAttributes,getAttributes:()=>this.editor.getAttributes(wu),editor:this.editor})),[t]}});var Wy=/(^|[^`])`([^`]+)`(?!`)/,qy=/(^|[^`])`([^`]+)`(?!`)/g,Au=he.create({name:"code",addOptions(){return{HTMLAttributes:{}}},excludes:"_",code:!0,exitable:!0,parseHTML(){return[{tag:"code"}]},renderHTML({HTMLAttributes:t}){return["code",N(this.options.HTMLAttributes,t),0]},addCommands(){return{setCode:()=>({commands:t})=>t.setMark(this.name),toggleCode:()=>({commands:t})=>t.toggleMark(this.name),unsetCode:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-e":()=>this.editor.commands.toggleCode()}},addInputRules(){return[Re({find:Wy,type:this.type})]},addPasteRules(){return[Ce({find:qy,type:this.type})]}});var Ky=/^```([a-z]+)?[\s\n]$/,Jy=/^~~~([a-z]+)?[\s\n]$/,Ou=z.create({name:"codeBlock",addOptions(){return{languageClassPrefix:"language-",exitOnTripleEnter:!0,exitOnArrowDown:!0,defaultLanguage:null,HTMLAttributes:{}}},content:"text*",marks:"",group:"block",code:!0,defining:!0,addAttributes(){return{language:{default:this.options.defaultLanguage,parseHTML:t=>{var e;let{languageClassPrefix:n}=this.options,o=[...((e=t.firstElementChild)===null||e===void 0?void 0:e.classList)||[]].filter(s=>s.startsWith(n)).map(s=>s.replace(n,""))[0];return o||null},rendered:!1}}},parseHTML(){return[{tag:"pre",preserveWhitespace:"full"}]},renderHTML({node:t,HTMLAttributes:e}){return["pre",N(this.options.HTMLAttributes,e),["code",{class:t.attrs.language?this.options.languageClassPrefix+t.attrs.language:null},0]]},addCommands(){return{setCodeBlock:t=>({commands:e})=>e.setNode(this.name,t),toggleCodeBlock:t=>({commands:e})=>e.toggleNode(this.name,"paragraph",t)}},addKeyboardShortcuts(){return{"Mod-Alt-c":()=>this.editor.commands.toggleCodeBlock(),Backspace:()=>{let{empty:t,$anchor:e}=this.editor.state.selection,n=e.pos===1;return!t||e.parent.type.name!==this.name?!1:n||!e.parent.textContent.length?this.editor.commands.clearNodes():!1},Enter:({editor:t})=>{if(!this.options.exitOnTripleEnter)return!1;let{state:e}=t,{selection:n}=e,{$from:r,empty:i}=n;if(!i||r.parent.type!==this.type)return!1;let o=r.parentOffset===r.parent.nodeSize-2,s=r.parent.textContent.endsWith(`
+`,textSerializers:s={}}=n||{},a="";return t.nodesBetween(r,i,(l,c,d,u)=>{var p;l.isBlock&&c>r&&(a+=o);let f=s==null?void 0:s[l.type.name];if(f)return d&&(a+=f({node:l,pos:c,parent:d,index:u,range:e})),!1;l.isText&&(a+=(p=l==null?void 0:l.text)==null?void 0:p.slice(Math.max(r,c)-c,i-c))}),a}function fs(t){return Object.fromEntries(Object.entries(t.nodes).filter(([,e])=>e.spec.toText).map(([e,n])=>[e,n.spec.toText]))}var Qm=Y.create({name:"clipboardTextSerializer",addOptions(){return{blockSeparator:void 0}},addProseMirrorPlugins(){return[new V({key:new G("clipboardTextSerializer"),props:{clipboardTextSerializer:()=>{let{editor:t}=this,{state:e,schema:n}=t,{doc:r,selection:i}=e,{ranges:o}=i,s=Math.min(...o.map(d=>d.$from.pos)),a=Math.max(...o.map(d=>d.$to.pos)),l=fs(n);return Oc(r,{from:s,to:a},{...this.options.blockSeparator!==void 0?{blockSeparator:this.options.blockSeparator}:{},textSerializers:l})}}})]}});var nd={};Vi(nd,{blur:()=>Zm,clearContent:()=>eg,clearNodes:()=>tg,command:()=>ng,createParagraphNear:()=>gg,cut:()=>yg,deleteCurrentNode:()=>bg,deleteNode:()=>Eg,deleteRange:()=>xg,deleteSelection:()=>Cg,enter:()=>vg,exitCode:()=>Tg,extendMarkRange:()=>Mg,first:()=>wg,focus:()=>Ag,forEach:()=>Rg,insertContent:()=>Og,insertContentAt:()=>Ig,joinBackward:()=>Hg,joinDown:()=>Dg,joinForward:()=>Fg,joinItemBackward:()=>_g,joinItemForward:()=>Bg,joinTextblockBackward:()=>zg,joinTextblockForward:()=>Vg,joinUp:()=>Pg,keyboardShortcut:()=>Ug,lift:()=>$g,liftEmptyBlock:()=>jg,liftListItem:()=>Jg,newlineInCode:()=>Gg,resetAttributes:()=>Yg,scrollIntoView:()=>Xg,selectAll:()=>Qg,selectNodeBackward:()=>Zg,selectNodeForward:()=>ey,selectParentNode:()=>ty,selectTextblockEnd:()=>ny,selectTextblockStart:()=>ry,setContent:()=>iy,setMark:()=>hy,setMeta:()=>my,setNode:()=>gy,setNodeSelection:()=>yy,setTextSelection:()=>by,sinkListItem:()=>Ey,splitBlock:()=>Cy,splitListItem:()=>vy,toggleList:()=>Ty,toggleMark:()=>Sy,toggleNode:()=>ky,toggleWrap:()=>My,undoInputRule:()=>wy,unsetAllMarks:()=>Ay,unsetMark:()=>Ry,updateAttributes:()=>Oy,wrapIn:()=>Ly,wrapInList:()=>Ny});var Zm=()=>({editor:t,view:e})=>(requestAnimationFrame(()=>{var n;t.isDestroyed||(e.dom.blur(),(n=window==null?void 0:window.getSelection())==null||n.removeAllRanges())}),!0);var eg=(t=!1)=>({commands:e})=>e.setContent("",t);var tg=()=>({state:t,tr:e,dispatch:n})=>{let{selection:r}=e,{ranges:i}=r;return n&&i.forEach(({$from:o,$to:s})=>{t.doc.nodesBetween(o.pos,s.pos,(a,l)=>{if(a.type.isText)return;let{doc:c,mapping:d}=e,u=c.resolve(d.map(l)),f=c.resolve(d.map(l+a.nodeSize)),p=u.blockRange(f);if(!p)return;let h=wt(p);if(a.type.isTextblock){let{defaultType:m}=u.parent.contentMatchAt(u.index());e.setNodeMarkup(p.start,m)}(h||h===0)&&e.lift(p,h)})}),!0};var ng=t=>e=>t(e);var ps=(t,e)=>t.selection.empty?!1:(e&&e(t.tr.deleteSelection().scrollIntoView()),!0);function ig(t,e){let{$cursor:n}=t.selection;return!n||(e?!e.endOfTextblock("backward",t):n.parentOffset>0)?null:n}var Nc=(t,e,n)=>{let r=ig(t,n);if(!r)return!1;let i=Pc(r);if(!i){let s=r.blockRange(),a=s&&wt(s);return a==null?!1:(e&&e(t.tr.lift(s,a).scrollIntoView()),!0)}let o=i.nodeBefore;if(pg(t,i,e,-1))return!0;if(r.parent.content.size==0&&(Tr(o,"end")||O.isSelectable(o)))for(let s=r.depth;;s--){let a=hi(t.doc,r.before(s),r.after(s),w.empty);if(a&&a.slice.size<a.to-a.from){if(e){let l=t.tr.step(a);l.setSelection(Tr(o,"end")?D.findFrom(l.doc.resolve(l.mapping.map(i.pos,-1)),-1):O.create(l.doc,i.pos-o.nodeSize)),e(l.scrollIntoView())}return!0}if(s==1||r.node(s-1).childCount>1)break}return o.isAtom&&i.depth==r.depth-1?(e&&e(t.tr.delete(i.pos-o.nodeSize,i.pos).scrollIntoView()),!0):!1},og=(t,e,n)=>{let r=ig(t,n);if(!r)return!1;let i=Pc(r);return i?ag(t,i,e):!1},sg=(t,e,n)=>{let r=lg(t,n);if(!r)return!1;let i=Fc(r);return i?ag(t,i,e):!1};function ag(t,e,n){let r=e.nodeBefore,i=r,o=e.pos-1;for(;!i.isTextblock;o--){if(i.type.spec.isolating)return!1;let d=i.lastChild;if(!d)return!1;i=d}let s=e.nodeAfter,a=s,l=e.pos+1;for(;!a.isTextblock;l++){if(a.type.spec.isolating)return!1;let d=a.firstChild;if(!d)return!1;a=d}let c=hi(t.doc,o,l,w.empty);if(!c||c.from!=o||c instanceof Ee&&c.slice.size>=l-o)return!1;if(n){let d=t.tr.step(c);d.setSelection(L.create(d.doc,o)),n(d.scrollIntoView())}return!0}function Tr(t,e,n=!1){for(let r=t;r;r=e=="start"?r.firstChild:r.lastChild){if(r.isTextblock)return!0;if(n&&r.childCount!=1)return!1}return!1}var Ic=(t,e,n)=>{let{$head:r,empty:i}=t.selection,o=r;if(!i)return!1;if(r.parent.isTextblock){if(n?!n.endOfTextblock("backward",t):r.parentOffset>0)return!1;o=Pc(r)}let s=o&&o.nodeBefore;return!s||!O.isSelectable(s)?!1:(e&&e(t.tr.setSelection(O.create(t.doc,o.pos-s.nodeSize)).scrollIntoView()),!0)};function Pc(t){if(!t.parent.type.spec.isolating)for(let e=t.depth-1;e>=0;e--){if(t.index(e)>0)return t.doc.resolve(t.before(e+1));if(t.node(e).type.spec.isolating)break}return null}function lg(t,e){let{$cursor:n}=t.selection;return!n||(e?!e.endOfTextblock("forward",t):n.parentOffset<n.parent.content.size)?null:n}var Dc=(t,e,n)=>{let r=lg(t,n);if(!r)return!1;let i=Fc(r);if(!i)return!1;let o=i.nodeAfter;if(pg(t,i,e,1))return!0;if(r.parent.content.size==0&&(Tr(o,"start")||O.isSelectable(o))){let s=hi(t.doc,r.before(),r.after(),w.empty);if(s&&s.slice.size<s.to-s.from){if(e){let a=t.tr.step(s);a.setSelection(Tr(o,"start")?D.findFrom(a.doc.resolve(a.mapping.map(i.pos)),1):O.create(a.doc,a.mapping.map(i.pos))),e(a.scrollIntoView())}return!0}}return o.isAtom&&i.depth==r.depth-1?(e&&e(t.tr.delete(i.pos,i.pos+o.nodeSize).scrollIntoView()),!0):!1},Hc=(t,e,n)=>{let{$head:r,empty:i}=t.selection,o=r;if(!i)return!1;if(r.parent.isTextblock){if(n?!n.endOfTextblock("forward",t):r.parentOffset<r.parent.content.size)return!1;o=Fc(r)}let s=o&&o.nodeAfter;return!s||!O.isSelectable(s)?!1:(e&&e(t.tr.setSelection(O.create(t.doc,o.pos)).scrollIntoView()),!0)};function Fc(t){if(!t.parent.type.spec.isolating)for(let e=t.depth-1;e>=0;e--){let n=t.node(e);if(t.index(e)+1<n.childCount)return t.doc.resolve(t.after(e+1));if(n.type.spec.isolating)break}return null}var cg=(t,e)=>{let n=t.selection,r=n instanceof O,i;if(r){if(n.node.isTextblock||!Ye(t.doc,n.from))return!1;i=n.from}else if(i=Sn(t.doc,n.from,-1),i==null)return!1;if(e){let o=t.tr.join(i);r&&o.setSelection(O.create(o.doc,i-t.doc.resolve(i).nodeBefore.nodeSize)),e(o.scrollIntoView())}return!0},dg=(t,e)=>{let n=t.selection,r;if(n instanceof O){if(n.node.isTextblock||!Ye(t.doc,n.to))return!1;r=n.to}else if(r=Sn(t.doc,n.to,1),r==null)return!1;return e&&e(t.tr.join(r).scrollIntoView()),!0},ug=(t,e)=>{let{$from:n,$to:r}=t.selection,i=n.blockRange(r),o=i&&wt(i);return o==null?!1:(e&&e(t.tr.lift(i,o).scrollIntoView()),!0)},_c=(t,e)=>{let{$head:n,$anchor:r}=t.selection;return!n.parent.type.spec.code||!n.sameParent(r)?!1:(e&&e(t.tr.insertText(`
+`).scrollIntoView()),!0)};function Bc(t){for(let e=0;e<t.edgeCount;e++){let{type:n}=t.edge(e);if(n.isTextblock&&!n.hasRequiredAttrs())return n}return null}var zc=(t,e)=>{let{$head:n,$anchor:r}=t.selection;if(!n.parent.type.spec.code||!n.sameParent(r))return!1;let i=n.node(-1),o=n.indexAfter(-1),s=Bc(i.contentMatchAt(o));if(!s||!i.canReplaceWith(o,o,s))return!1;if(e){let a=n.after(),l=t.tr.replaceWith(a,a,s.createAndFill());l.setSelection(D.near(l.doc.resolve(a),1)),e(l.scrollIntoView())}return!0},Vc=(t,e)=>{let n=t.selection,{$from:r,$to:i}=n;if(n instanceof ke||r.parent.inlineContent||i.parent.inlineContent)return!1;let o=Bc(i.parent.contentMatchAt(i.indexAfter()));if(!o||!o.isTextblock)return!1;if(e){let s=(!r.parentOffset&&i.index()<i.parent.childCount?r:i).pos,a=t.tr.insert(s,o.createAndFill());a.setSelection(L.create(a.doc,s+1)),e(a.scrollIntoView())}return!0},Uc=(t,e)=>{let{$cursor:n}=t.selection;if(!n||n.parent.content.size)return!1;if(n.depth>1&&n.after()!=n.end(-1)){let o=n.before();if(Ue(t.doc,o))return e&&e(t.tr.split(o).scrollIntoView()),!0}let r=n.blockRange(),i=r&&wt(r);return i==null?!1:(e&&e(t.tr.lift(r,i).scrollIntoView()),!0)};function h0(t){return(e,n)=>{let{$from:r,$to:i}=e.selection;if(e.selection instanceof O&&e.selection.node.isBlock)return!r.parentOffset||!Ue(e.doc,r.pos)?!1:(n&&n(e.tr.split(r.pos).scrollIntoView()),!0);if(!r.depth)return!1;let o=[],s,a,l=!1,c=!1;for(let p=r.depth;;p--)if(r.node(p).isBlock){l=r.end(p)==r.pos+(r.depth-p),c=r.start(p)==r.pos-(r.depth-p),a=Bc(r.node(p-1).contentMatchAt(r.indexAfter(p-1)));let m=t&&t(i.parent,l,r);o.unshift(m||(l&&a?{type:a}:null)),s=p;break}else{if(p==1)return!1;o.unshift(null)}let d=e.tr;(e.selection instanceof L||e.selection instanceof ke)&&d.deleteSelection();let u=d.mapping.map(r.pos),f=Ue(d.doc,u,o.length,o);if(f||(o[0]=a?{type:a}:null,f=Ue(d.doc,u,o.length,o)),!f)return!1;if(d.split(u,o.length,o),!l&&c&&r.node(s).type!=a){let p=d.mapping.map(r.before(s)),h=d.doc.resolve(p);a&&r.node(s-1).canReplaceWith(h.index(),h.index()+1,a)&&d.setNodeMarkup(d.mapping.map(r.before(s)),a)}return n&&n(d.scrollIntoView()),!0}}var m0=h0();var fg=(t,e)=>{let{$from:n,to:r}=t.selection,i,o=n.sharedDepth(r);return o==0?!1:(i=n.before(o),e&&e(t.tr.setSelection(O.create(t.doc,i))),!0)},g0=(t,e)=>(e&&e(t.tr.setSelection(new ke(t.doc))),!0);function y0(t,e,n){let r=e.nodeBefore,i=e.nodeAfter,o=e.index();return!r||!i||!r.type.compatibleContent(i.type)?!1:!r.content.size&&e.parent.canReplace(o-1,o)?(n&&n(t.tr.delete(e.pos-r.nodeSize,e.pos).scrollIntoView()),!0):!e.parent.canReplace(o,o+1)||!(i.isTextblock||Ye(t.doc,e.pos))?!1:(n&&n(t.tr.join(e.pos).scrollIntoView()),!0)}function pg(t,e,n,r){let i=e.nodeBefore,o=e.nodeAfter,s,a,l=i.type.spec.isolating||o.type.spec.isolating;if(!l&&y0(t,e,n))return!0;let c=!l&&e.parent.canReplace(e.index(),e.index()+1);if(c&&(s=(a=i.contentMatchAt(i.childCount)).findWrapping(o.type))&&a.matchType(s[0]||o.type).validEnd){if(n){let p=e.pos+o.nodeSize,h=C.empty;for(let y=s.length-1;y>=0;y--)h=C.from(s[y].create(null,h));h=C.from(i.copy(h));let m=t.tr.step(new le(e.pos-1,p,e.pos,p,new w(h,1,0),s.length,!0)),g=m.doc.resolve(p+2*s.length);g.nodeAfter&&g.nodeAfter.type==i.type&&Ye(m.doc,g.pos)&&m.join(g.pos),n(m.scrollIntoView())}return!0}let d=o.type.spec.isolating||r>0&&l?null:D.findFrom(e,1),u=d&&d.$from.blockRange(d.$to),f=u&&wt(u);if(f!=null&&f>=e.depth)return n&&n(t.tr.lift(u,f).scrollIntoView()),!0;if(c&&Tr(o,"start",!0)&&Tr(i,"end")){let p=i,h=[];for(;h.push(p),!p.isTextblock;)p=p.lastChild;let m=o,g=1;for(;!m.isTextblock;m=m.firstChild)g++;if(p.canReplace(p.childCount,p.childCount,m.content)){if(n){let y=C.empty;for(let x=h.length-1;x>=0;x--)y=C.from(h[x].copy(y));let E=t.tr.step(new le(e.pos-h.length,e.pos+o.nodeSize,e.pos+g,e.pos+o.nodeSize-g,new w(y,h.length,0),0,!0));n(E.scrollIntoView())}return!0}}return!1}function hg(t){return function(e,n){let r=e.selection,i=t<0?r.$from:r.$to,o=i.depth;for(;i.node(o).isInline;){if(!o)return!1;o--}return i.node(o).isTextblock?(n&&n(e.tr.setSelection(L.create(e.doc,t<0?i.start(o):i.end(o)))),!0):!1}}var $c=hg(-1),jc=hg(1);function mg(t,e=null){return function(n,r){let{$from:i,$to:o}=n.selection,s=i.blockRange(o),a=s&&fr(s,t,e);return a?(r&&r(n.tr.wrap(s,a).scrollIntoView()),!0):!1}}function Wc(t,e=null){return function(n,r){let i=!1;for(let o=0;o<n.selection.ranges.length&&!i;o++){let{$from:{pos:s},$to:{pos:a}}=n.selection.ranges[o];n.doc.nodesBetween(s,a,(l,c)=>{if(i)return!1;if(!(!l.isTextblock||l.hasMarkup(t,e)))if(l.type==t)i=!0;else{let d=n.doc.resolve(c),u=d.index();i=d.parent.canReplaceWith(u,u+1,t)}})}if(!i)return!1;if(r){let o=n.tr;for(let s=0;s<n.selection.ranges.length;s++){let{$from:{pos:a},$to:{pos:l}}=n.selection.ranges[s];o.setBlockType(a,l,t,e)}r(o.scrollIntoView())}return!0}}function qc(...t){return function(e,n,r){for(let i=0;i<t.length;i++)if(t[i](e,n,r))return!0;return!1}}var Lc=qc(ps,Nc,Ic),rg=qc(ps,Dc,Hc),tn={Enter:qc(_c,Vc,Uc,m0),"Mod-Enter":zc,Backspace:Lc,"Mod-Backspace":Lc,"Shift-Backspace":Lc,Delete:rg,"Mod-Delete":rg,"Mod-a":g0},b0={"Ctrl-h":tn.Backspace,"Alt-Backspace":tn["Mod-Backspace"],"Ctrl-d":tn.Delete,"Ctrl-Alt-Backspace":tn["Mod-Delete"],"Alt-Delete":tn["Mod-Delete"],"Alt-d":tn["Mod-Delete"],"Ctrl-a":$c,"Ctrl-e":jc};for(let t in tn)b0[t]=tn[t];var p1=typeof navigator!="undefined"?/Mac|iP(hone|[oa]d)/.test(navigator.platform):typeof os!="undefined"&&os.platform?os.platform()=="darwin":!1;var gg=()=>({state:t,dispatch:e})=>Vc(t,e);var yg=(t,e)=>({editor:n,tr:r})=>{let{state:i}=n,o=i.doc.slice(t.from,t.to);r.deleteRange(t.from,t.to);let s=r.mapping.map(e);return r.insert(s,o.content),r.setSelection(new L(r.doc.resolve(Math.max(s-1,0)))),!0};var bg=()=>({tr:t,dispatch:e})=>{let{selection:n}=t,r=n.$anchor.node();if(r.content.size>0)return!1;let i=t.selection.$anchor;for(let o=i.depth;o>0;o-=1)if(i.node(o).type===r.type){if(e){let a=i.before(o),l=i.after(o);t.delete(a,l).scrollIntoView()}return!0}return!1};var Eg=t=>({tr:e,state:n,dispatch:r})=>{let i=U(t,n.schema),o=e.selection.$anchor;for(let s=o.depth;s>0;s-=1)if(o.node(s).type===i){if(r){let l=o.before(s),c=o.after(s);e.delete(l,c).scrollIntoView()}return!0}return!1};var xg=t=>({tr:e,dispatch:n})=>{let{from:r,to:i}=t;return n&&e.delete(r,i),!0};var Cg=()=>({state:t,dispatch:e})=>ps(t,e);var vg=()=>({commands:t})=>t.keyboardShortcut("Enter");var Tg=()=>({state:t,dispatch:e})=>zc(t,e);function Pn(t,e,n={strict:!0}){let r=Object.keys(e);return r.length?r.every(i=>n.strict?e[i]===t[i]:us(e[i])?e[i].test(t[i]):e[i]===t[i]):!0}function kg(t,e,n={}){return t.find(r=>r.type===e&&Pn(Object.fromEntries(Object.keys(n).map(i=>[i,r.attrs[i]])),n))}function Sg(t,e,n={}){return!!kg(t,e,n)}function Sr(t,e,n){var c;if(!t||!e)return;let r=t.parent.childAfter(t.parentOffset);if((!r.node||!r.node.marks.some(d=>d.type===e))&&(r=t.parent.childBefore(t.parentOffset)),!r.node||!r.node.marks.some(d=>d.type===e)||(n=n||((c=r.node.marks[0])==null?void 0:c.attrs),!kg([...r.node.marks],e,n)))return;let o=r.index,s=t.start()+r.offset,a=o+1,l=s+r.node.nodeSize;for(;o>0&&Sg([...t.parent.child(o-1).marks],e,n);)o-=1,s-=t.parent.child(o).nodeSize;for(;a<t.parent.childCount&&Sg([...t.parent.child(a).marks],e,n);)l+=t.parent.child(a).nodeSize,a+=1;return{from:s,to:l}}function Te(t,e){if(typeof t=="string"){if(!e.marks[t])throw Error(`There is no mark type named '${t}'. Maybe you forgot to add the extension?`);return e.marks[t]}return t}var Mg=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let o=Te(t,r.schema),{doc:s,selection:a}=n,{$from:l,from:c,to:d}=a;if(i){let u=Sr(l,o,e);if(u&&u.from<=c&&u.to>=d){let f=L.create(s,u.from,u.to);n.setSelection(f)}}return!0};var wg=t=>e=>{let n=typeof t=="function"?t(e):t;for(let r=0;r<n.length;r+=1)if(n[r](e))return!0;return!1};function hs(t){return t instanceof L}function ft(t=0,e=0,n=0){return Math.min(Math.max(t,e),n)}function Kc(t,e=null){if(!e)return null;let n=D.atStart(t),r=D.atEnd(t);if(e==="start"||e===!0)return n;if(e==="end")return r;let i=n.from,o=r.to;return e==="all"?L.create(t,ft(0,i,o),ft(t.content.size,i,o)):L.create(t,ft(e,i,o),ft(e,i,o))}function Jc(){return navigator.platform==="Android"||/android/i.test(navigator.userAgent)}function Dn(){return["iPad Simulator","iPhone Simulator","iPod Simulator","iPad","iPhone","iPod"].includes(navigator.platform)||navigator.userAgent.includes("Mac")&&"ontouchend"in document}var Ag=(t=null,e={})=>({editor:n,view:r,tr:i,dispatch:o})=>{e={scrollIntoView:!0,...e};let s=()=>{(Dn()||Jc())&&r.dom.focus(),requestAnimationFrame(()=>{n.isDestroyed||(r.focus(),e!=null&&e.scrollIntoView&&n.commands.scrollIntoView())})};if(r.hasFocus()&&t===null||t===!1)return!0;if(o&&t===null&&!hs(n.state.selection))return s(),!0;let a=Kc(i.doc,t)||n.state.selection,l=n.state.selection.eq(a);return o&&(l||i.setSelection(a),l&&i.storedMarks&&i.setStoredMarks(i.storedMarks),s()),!0};var Rg=(t,e)=>n=>t.every((r,i)=>e(r,{...n,index:i}));var Og=(t,e)=>({tr:n,commands:r})=>r.insertContentAt({from:n.selection.from,to:n.selection.to},t,e);var Lg=t=>{let e=t.childNodes;for(let n=e.length-1;n>=0;n-=1){let r=e[n];r.nodeType===3&&r.nodeValue&&/^(\n\s\s|\n)$/.test(r.nodeValue)?t.removeChild(r):r.nodeType===1&&Lg(r)}return t};function kr(t){let e=`<body>${t}</body>`,n=new window.DOMParser().parseFromString(e,"text/html").body;return Lg(n)}function Hn(t,e,n){if(t instanceof Ve||t instanceof C)return t;n={slice:!0,parseOptions:{},...n};let r=typeof t=="object"&&t!==null,i=typeof t=="string";if(r)try{if(Array.isArray(t)&&t.length>0)return C.fromArray(t.map(a=>e.nodeFromJSON(a)));let s=e.nodeFromJSON(t);return n.errorOnInvalidContent&&s.check(),s}catch(o){if(n.errorOnInvalidContent)throw new Error("[tiptap error]: Invalid JSON content",{cause:o});return console.warn("[tiptap warn]: Invalid content.","Passed value:",t,"Error:",o),Hn("",e,n)}if(i){if(n.errorOnInvalidContent){let s=!1,a="",l=new oi({topNode:e.spec.topNode,marks:e.spec.marks,nodes:e.spec.nodes.append({__tiptap__private__unknown__catch__all__node:{content:"inline*",group:"block",parseDOM:[{tag:"*",getAttrs:c=>(s=!0,a=typeof c=="string"?c:c.outerHTML,null)}]}})});if(n.slice?mt.fromSchema(l).parseSlice(kr(t),n.parseOptions):mt.fromSchema(l).parse(kr(t),n.parseOptions),n.errorOnInvalidContent&&s)throw new Error("[tiptap error]: Invalid HTML content",{cause:new Error(`Invalid element found: ${a}`)})}let o=mt.fromSchema(e);return n.slice?o.parseSlice(kr(t),n.parseOptions).content:o.parse(kr(t),n.parseOptions)}return Hn("",e,n)}function Ng(t,e,n){let r=t.steps.length-1;if(r<e)return;let i=t.steps[r];if(!(i instanceof Ee||i instanceof le))return;let o=t.mapping.maps[r],s=0;o.forEach((a,l,c,d)=>{s===0&&(s=d)}),t.setSelection(D.near(t.doc.resolve(s),n))}var E0=t=>!("type"in t),Ig=(t,e,n)=>({tr:r,dispatch:i,editor:o})=>{var s;if(i){n={parseOptions:o.options.parseOptions,updateSelection:!0,applyInputRules:!1,applyPasteRules:!1,...n};let a,l=g=>{o.emit("contentError",{editor:o,error:g,disableCollaboration:()=>{o.storage.collaboration&&(o.storage.collaboration.isDisabled=!0)}})},c={preserveWhitespace:"full",...n.parseOptions};if(!n.errorOnInvalidContent&&!o.options.enableContentCheck&&o.options.emitContentError)try{Hn(e,o.schema,{parseOptions:c,errorOnInvalidContent:!0})}catch(g){l(g)}try{a=Hn(e,o.schema,{parseOptions:c,errorOnInvalidContent:(s=n.errorOnInvalidContent)!=null?s:o.options.enableContentCheck})}catch(g){return l(g),!1}let{from:d,to:u}=typeof t=="number"?{from:t,to:t}:{from:t.from,to:t.to},f=!0,p=!0;if((E0(a)?a:[a]).forEach(g=>{g.check(),f=f?g.isText&&g.marks.length===0:!1,p=p?g.isBlock:!1}),d===u&&p){let{parent:g}=r.doc.resolve(d);g.isTextblock&&!g.type.spec.code&&!g.childCount&&(d-=1,u+=1)}let m;if(f){if(Array.isArray(e))m=e.map(g=>g.text||"").join("");else if(e instanceof C){let g="";e.forEach(y=>{y.text&&(g+=y.text)}),m=g}else typeof e=="object"&&e&&e.text?m=e.text:m=e;r.insertText(m,d,u)}else m=a,r.replaceWith(d,u,m);n.updateSelection&&Ng(r,r.steps.length-1,-1),n.applyInputRules&&r.setMeta("applyInputRules",{from:d,text:m}),n.applyPasteRules&&r.setMeta("applyPasteRules",{from:d,text:m})}return!0};var Pg=()=>({state:t,dispatch:e})=>cg(t,e),Dg=()=>({state:t,dispatch:e})=>dg(t,e),Hg=()=>({state:t,dispatch:e})=>Nc(t,e),Fg=()=>({state:t,dispatch:e})=>Dc(t,e);var _g=()=>({state:t,dispatch:e,tr:n})=>{try{let r=Sn(t.doc,t.selection.$from.pos,-1);return r==null?!1:(n.join(r,2),e&&e(n),!0)}catch{return!1}};var Bg=()=>({state:t,dispatch:e,tr:n})=>{try{let r=Sn(t.doc,t.selection.$from.pos,1);return r==null?!1:(n.join(r,2),e&&e(n),!0)}catch{return!1}};var zg=()=>({state:t,dispatch:e})=>og(t,e);var Vg=()=>({state:t,dispatch:e})=>sg(t,e);function ms(){return typeof navigator!="undefined"?/Mac/.test(navigator.platform):!1}function x0(t){let e=t.split(/-(?!$)/),n=e[e.length-1];n==="Space"&&(n=" ");let r,i,o,s;for(let a=0;a<e.length-1;a+=1){let l=e[a];if(/^(cmd|meta|m)$/i.test(l))s=!0;else if(/^a(lt)?$/i.test(l))r=!0;else if(/^(c|ctrl|control)$/i.test(l))i=!0;else if(/^s(hift)?$/i.test(l))o=!0;else if(/^mod$/i.test(l))Dn()||ms()?s=!0:i=!0;else throw new Error(`Unrecognized modifier name: ${l}`)}return r&&(n=`Alt-${n}`),i&&(n=`Ctrl-${n}`),s&&(n=`Meta-${n}`),o&&(n=`Shift-${n}`),n}var Ug=t=>({editor:e,view:n,tr:r,dispatch:i})=>{let o=x0(t).split(/-(?!$)/),s=o.find(c=>!["Alt","Ctrl","Meta","Shift"].includes(c)),a=new KeyboardEvent("keydown",{key:s==="Space"?" ":s,altKey:o.includes("Alt"),ctrlKey:o.includes("Ctrl"),metaKey:o.includes("Meta"),shiftKey:o.includes("Shift"),bubbles:!0,cancelable:!0}),l=e.captureTransaction(()=>{n.someProp("handleKeyDown",c=>c(n,a))});return l==null||l.steps.forEach(c=>{let d=c.map(r.mapping);d&&i&&r.maybeStep(d)}),!0};function Fn(t,e,n={}){let{from:r,to:i,empty:o}=t.selection,s=e?U(e,t.schema):null,a=[];t.doc.nodesBetween(r,i,(u,f)=>{if(u.isText)return;let p=Math.max(r,f),h=Math.min(i,f+u.nodeSize);a.push({node:u,from:p,to:h})});let l=i-r,c=a.filter(u=>s?s.name===u.node.type.name:!0).filter(u=>Pn(u.node.attrs,n,{strict:!1}));return o?!!c.length:c.reduce((u,f)=>u+f.to-f.from,0)>=l}var $g=(t,e={})=>({state:n,dispatch:r})=>{let i=U(t,n.schema);return Fn(n,i,e)?ug(n,r):!1};var jg=()=>({state:t,dispatch:e})=>Uc(t,e);function Wg(t,e=null){return function(n,r){let{$from:i,$to:o}=n.selection,s=i.blockRange(o);if(!s)return!1;let a=r?n.tr:null;return C0(a,s,t,e)?(r&&r(a.scrollIntoView()),!0):!1}}function C0(t,e,n,r=null){let i=!1,o=e,s=e.$from.doc;if(e.depth>=2&&e.$from.node(e.depth-1).type.compatibleContent(n)&&e.startIndex==0){if(e.$from.index(e.depth-1)==0)return!1;let l=s.resolve(e.start-2);o=new Cn(l,l,e.depth),e.endIndex<e.parent.childCount&&(e=new Cn(e.$from,s.resolve(e.$to.end(e.depth)),e.depth)),i=!0}let a=fr(o,n,r,e);return a?(t&&v0(t,e,a,i,n),!0):!1}function v0(t,e,n,r,i){let o=C.empty;for(let d=n.length-1;d>=0;d--)o=C.from(n[d].type.create(n[d].attrs,o));t.step(new le(e.start-(r?2:0),e.end,e.start,e.end,new w(o,0,0),n.length,!0));let s=0;for(let d=0;d<n.length;d++)n[d].type==i&&(s=d+1);let a=n.length-s,l=e.start+n.length-(r?2:0),c=e.parent;for(let d=e.startIndex,u=e.endIndex,f=!0;d<u;d++,f=!1)!f&&Ue(t.doc,l,a)&&(t.split(l,a),l+=2*a),l+=c.child(d).nodeSize;return t}function qg(t){return function(e,n){let{$from:r,$to:i}=e.selection,o=r.blockRange(i,s=>s.childCount>0&&s.firstChild.type==t);return o?n?r.node(o.depth-1).type==t?T0(e,n,t,o):S0(e,n,o):!0:!1}}function T0(t,e,n,r){let i=t.tr,o=r.end,s=r.$to.end(r.depth);o<s&&(i.step(new le(o-1,s,o,s,new w(C.from(n.create(null,r.parent.copy())),1,0),1,!0)),r=new Cn(i.doc.resolve(r.$from.pos),i.doc.resolve(s),r.depth));let a=wt(r);if(a==null)return!1;i.lift(r,a);let l=i.doc.resolve(i.mapping.map(o,-1)-1);return Ye(i.doc,l.pos)&&l.nodeBefore.type==l.nodeAfter.type&&i.join(l.pos),e(i.scrollIntoView()),!0}function S0(t,e,n){let r=t.tr,i=n.parent;for(let p=n.end,h=n.endIndex-1,m=n.startIndex;h>m;h--)p-=i.child(h).nodeSize,r.delete(p-1,p+1);let o=r.doc.resolve(n.start),s=o.nodeAfter;if(r.mapping.map(n.end)!=n.start+o.nodeAfter.nodeSize)return!1;let a=n.startIndex==0,l=n.endIndex==i.childCount,c=o.node(-1),d=o.index(-1);if(!c.canReplace(d+(a?0:1),d+1,s.content.append(l?C.empty:C.from(i))))return!1;let u=o.pos,f=u+s.nodeSize;return r.step(new le(u-(a?1:0),f+(l?1:0),u+1,f-1,new w((a?C.empty:C.from(i.copy(C.empty))).append(l?C.empty:C.from(i.copy(C.empty))),a?0:1,l?0:1),a?0:1)),e(r.scrollIntoView()),!0}function Kg(t){return function(e,n){let{$from:r,$to:i}=e.selection,o=r.blockRange(i,c=>c.childCount>0&&c.firstChild.type==t);if(!o)return!1;let s=o.startIndex;if(s==0)return!1;let a=o.parent,l=a.child(s-1);if(l.type!=t)return!1;if(n){let c=l.lastChild&&l.lastChild.type==a.type,d=C.from(c?t.create():null),u=new w(C.from(t.create(null,C.from(a.type.create(null,d)))),c?3:1,0),f=o.start,p=o.end;n(e.tr.step(new le(f-(c?3:1),p,f,p,u,1,!0)).scrollIntoView())}return!0}}var Jg=t=>({state:e,dispatch:n})=>{let r=U(t,e.schema);return qg(r)(e,n)};var Gg=()=>({state:t,dispatch:e})=>_c(t,e);function _n(t,e){return e.nodes[t]?"node":e.marks[t]?"mark":null}function Gc(t,e){let n=typeof e=="string"?[e]:e;return Object.keys(t).reduce((r,i)=>(n.includes(i)||(r[i]=t[i]),r),{})}var Yg=(t,e)=>({tr:n,state:r,dispatch:i})=>{let o=null,s=null,a=_n(typeof t=="string"?t:t.name,r.schema);return a?(a==="node"&&(o=U(t,r.schema)),a==="mark"&&(s=Te(t,r.schema)),i&&n.selection.ranges.forEach(l=>{r.doc.nodesBetween(l.$from.pos,l.$to.pos,(c,d)=>{o&&o===c.type&&n.setNodeMarkup(d,void 0,Gc(c.attrs,e)),s&&c.marks.length&&c.marks.forEach(u=>{s===u.type&&n.addMark(d,d+c.nodeSize,s.create(Gc(u.attrs,e)))})})}),!0):!1};var Xg=()=>({tr:t,dispatch:e})=>(e&&t.scrollIntoView(),!0);var Qg=()=>({tr:t,dispatch:e})=>{if(e){let n=new ke(t.doc);t.setSelection(n)}return!0};var Zg=()=>({state:t,dispatch:e})=>Ic(t,e);var ey=()=>({state:t,dispatch:e})=>Hc(t,e);var ty=()=>({state:t,dispatch:e})=>fg(t,e);var ny=()=>({state:t,dispatch:e})=>jc(t,e);var ry=()=>({state:t,dispatch:e})=>$c(t,e);function Yc(t,e,n={},r={}){return Hn(t,e,{slice:!1,parseOptions:n,errorOnInvalidContent:r.errorOnInvalidContent})}var iy=(t,e=!1,n={},r={})=>({editor:i,tr:o,dispatch:s,commands:a})=>{var c,d;let{doc:l}=o;if(n.preserveWhitespace!=="full"){let u=Yc(t,i.schema,n,{errorOnInvalidContent:(c=r.errorOnInvalidContent)!=null?c:i.options.enableContentCheck});return s&&o.replaceWith(0,l.content.size,u).setMeta("preventUpdate",!e),!0}return s&&o.setMeta("preventUpdate",!e),a.insertContentAt({from:0,to:l.content.size},t,{parseOptions:n,errorOnInvalidContent:(d=r.errorOnInvalidContent)!=null?d:i.options.enableContentCheck})};function gs(t,e){let n=Te(e,t.schema),{from:r,to:i,empty:o}=t.selection,s=[];o?(t.storedMarks&&s.push(...t.storedMarks),s.push(...t.selection.$head.marks())):t.doc.nodesBetween(r,i,l=>{s.push(...l.marks)});let a=s.find(l=>l.type.name===n.name);return a?{...a.attrs}:{}}function oy(t,e){let n=new pi(t);return e.forEach(r=>{r.steps.forEach(i=>{n.step(i)})}),n}function sy(t){for(let e=0;e<t.edgeCount;e+=1){let{type:n}=t.edge(e);if(n.isTextblock&&!n.hasRequiredAttrs())return n}return null}function ay(t,e,n){let r=[];return t.nodesBetween(e.from,e.to,(i,o)=>{n(i)&&r.push({node:i,pos:o})}),r}function ly(t,e){for(let n=t.depth;n>0;n-=1){let r=t.node(n);if(e(r))return{pos:n>0?t.before(n):0,start:t.start(n),depth:n,node:r}}}function ki(t){return e=>ly(e.$from,t)}function dy(t,e){let n=U(e,t.schema),{from:r,to:i}=t.selection,o=[];t.doc.nodesBetween(r,i,a=>{o.push(a)});let s=o.reverse().find(a=>a.type.name===n.name);return s?{...s.attrs}:{}}function Xc(t,e){let n=_n(typeof e=="string"?e:e.name,t.schema);return n==="node"?dy(t,e):n==="mark"?gs(t,e):{}}function uy(t,e=JSON.stringify){let n={};return t.filter(r=>{let i=e(r);return Object.prototype.hasOwnProperty.call(n,i)?!1:n[i]=!0})}function w0(t){let e=uy(t);return e.length===1?e:e.filter((n,r)=>!e.filter((o,s)=>s!==r).some(o=>n.oldRange.from>=o.oldRange.from&&n.oldRange.to<=o.oldRange.to&&n.newRange.from>=o.newRange.from&&n.newRange.to<=o.newRange.to))}function fy(t){let{mapping:e,steps:n}=t,r=[];return e.maps.forEach((i,o)=>{let s=[];if(i.ranges.length)i.forEach((a,l)=>{s.push({from:a,to:l})});else{let{from:a,to:l}=n[o];if(a===void 0||l===void 0)return;s.push({from:a,to:l})}s.forEach(({from:a,to:l})=>{let c=e.slice(o).map(a,-1),d=e.slice(o).map(l),u=e.invert().map(c,-1),f=e.invert().map(d);r.push({oldRange:{from:u,to:f},newRange:{from:c,to:d}})})}),w0(r)}function Mr(t,e,n){let r=[];return t===e?n.resolve(t).marks().forEach(i=>{let o=n.resolve(t),s=Sr(o,i.type);s&&r.push({mark:i,...s})}):n.nodesBetween(t,e,(i,o)=>{!i||(i==null?void 0:i.nodeSize)===void 0||r.push(...i.marks.map(s=>({from:o,to:o+i.nodeSize,mark:s})))}),r}function wr(t,e,n){return Object.fromEntries(Object.entries(n).filter(([r])=>{let i=t.find(o=>o.type===e&&o.name===r);return i?i.attribute.keepOnSplit:!1}))}function Qc(t,e,n={}){let{empty:r,ranges:i}=t.selection,o=e?Te(e,t.schema):null;if(r)return!!(t.storedMarks||t.selection.$from.marks()).filter(u=>o?o.name===u.type.name:!0).find(u=>Pn(u.attrs,n,{strict:!1}));let s=0,a=[];if(i.forEach(({$from:u,$to:f})=>{let p=u.pos,h=f.pos;t.doc.nodesBetween(p,h,(m,g)=>{if(!m.isText&&!m.marks.length)return;let y=Math.max(p,g),E=Math.min(h,g+m.nodeSize),x=E-y;s+=x,a.push(...m.marks.map(k=>({mark:k,from:y,to:E})))})}),s===0)return!1;let l=a.filter(u=>o?o.name===u.mark.type.name:!0).filter(u=>Pn(u.mark.attrs,n,{strict:!1})).reduce((u,f)=>u+f.to-f.from,0),c=a.filter(u=>o?u.mark.type!==o&&u.mark.type.excludes(o):!0).reduce((u,f)=>u+f.to-f.from,0);return(l>0?l+c:l)>=s}function Zc(t,e){let{nodeExtensions:n}=Ti(e),r=n.find(s=>s.name===t);if(!r)return!1;let i={name:r.name,options:r.options,storage:r.storage},o=j(te(r,"group",i));return typeof o!="string"?!1:o.split(" ").includes("list")}function Ar(t,{checkChildren:e=!0,ignoreWhitespace:n=!1}={}){var r;if(n){if(t.type.name==="hardBreak")return!0;if(t.isText)return/^\s*$/m.test((r=t.text)!=null?r:"")}if(t.isText)return!t.text;if(t.isAtom||t.isLeaf)return!1;if(t.content.childCount===0)return!0;if(e){let i=!0;return t.content.forEach(o=>{i!==!1&&(Ar(o,{ignoreWhitespace:n,checkChildren:e})||(i=!1))}),i}return!1}function py(t){return t instanceof O}function A0(t,e,n){var s;let{selection:r}=e,i=null;if(hs(r)&&(i=r.$cursor),i){let a=(s=t.storedMarks)!=null?s:i.marks();return!!n.isInSet(a)||!a.some(l=>l.type.excludes(n))}let{ranges:o}=r;return o.some(({$from:a,$to:l})=>{let c=a.depth===0?t.doc.inlineContent&&t.doc.type.allowsMarkType(n):!1;return t.doc.nodesBetween(a.pos,l.pos,(d,u,f)=>{if(c)return!1;if(d.isInline){let p=!f||f.type.allowsMarkType(n),h=!!n.isInSet(d.marks)||!d.marks.some(m=>m.type.excludes(n));c=p&&h}return!c}),c})}var hy=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let{selection:o}=n,{empty:s,ranges:a}=o,l=Te(t,r.schema);if(i)if(s){let c=gs(r,l);n.addStoredMark(l.create({...c,...e}))}else a.forEach(c=>{let d=c.$from.pos,u=c.$to.pos;r.doc.nodesBetween(d,u,(f,p)=>{let h=Math.max(p,d),m=Math.min(p+f.nodeSize,u);f.marks.find(y=>y.type===l)?f.marks.forEach(y=>{l===y.type&&n.addMark(h,m,l.create({...y.attrs,...e}))}):n.addMark(h,m,l.create(e))})});return A0(r,n,l)};var my=(t,e)=>({tr:n})=>(n.setMeta(t,e),!0);var gy=(t,e={})=>({state:n,dispatch:r,chain:i})=>{let o=U(t,n.schema),s;return n.selection.$anchor.sameParent(n.selection.$head)&&(s=n.selection.$anchor.parent.attrs),o.isTextblock?i().command(({commands:a})=>Wc(o,{...s,...e})(n)?!0:a.clearNodes()).command(({state:a})=>Wc(o,{...s,...e})(a,r)).run():(console.warn('[tiptap warn]: Currently "setNode()" only supports text block nodes.'),!1)};var yy=t=>({tr:e,dispatch:n})=>{if(n){let{doc:r}=e,i=ft(t,0,r.content.size),o=O.create(r,i);e.setSelection(o)}return!0};var by=t=>({tr:e,dispatch:n})=>{if(n){let{doc:r}=e,{from:i,to:o}=typeof t=="number"?{from:t,to:t}:t,s=L.atStart(r).from,a=L.atEnd(r).to,l=ft(i,s,a),c=ft(o,s,a),d=L.create(r,l,c);e.setSelection(d)}return!0};var Ey=t=>({state:e,dispatch:n})=>{let r=U(t,e.schema);return Kg(r)(e,n)};function xy(t,e){let n=t.storedMarks||t.selection.$to.parentOffset&&t.selection.$from.marks();if(n){let r=n.filter(i=>e==null?void 0:e.includes(i.type.name));t.tr.ensureMarks(r)}}var Cy=({keepMarks:t=!0}={})=>({tr:e,state:n,dispatch:r,editor:i})=>{let{selection:o,doc:s}=e,{$from:a,$to:l}=o,c=i.extensionManager.attributes,d=wr(c,a.node().type.name,a.node().attrs);if(o instanceof O&&o.node.isBlock)return!a.parentOffset||!Ue(s,a.pos)?!1:(r&&(t&&xy(n,i.extensionManager.splittableMarks),e.split(a.pos).scrollIntoView()),!0);if(!a.parent.isBlock)return!1;let u=l.parentOffset===l.parent.content.size,f=a.depth===0?void 0:sy(a.node(-1).contentMatchAt(a.indexAfter(-1))),p=u&&f?[{type:f,attrs:d}]:void 0,h=Ue(e.doc,e.mapping.map(a.pos),1,p);if(!p&&!h&&Ue(e.doc,e.mapping.map(a.pos),1,f?[{type:f}]:void 0)&&(h=!0,p=f?[{type:f,attrs:d}]:void 0),r){if(h&&(o instanceof L&&e.deleteSelection(),e.split(e.mapping.map(a.pos),1,p),f&&!u&&!a.parentOffset&&a.parent.type!==f)){let m=e.mapping.map(a.before()),g=e.doc.resolve(m);a.node(-1).canReplaceWith(g.index(),g.index()+1,f)&&e.setNodeMarkup(e.mapping.map(a.before()),f)}t&&xy(n,i.extensionManager.splittableMarks),e.scrollIntoView()}return h};var vy=(t,e={})=>({tr:n,state:r,dispatch:i,editor:o})=>{var g;let s=U(t,r.schema),{$from:a,$to:l}=r.selection,c=r.selection.node;if(c&&c.isBlock||a.depth<2||!a.sameParent(l))return!1;let d=a.node(-1);if(d.type!==s)return!1;let u=o.extensionManager.attributes;if(a.parent.content.size===0&&a.node(-1).childCount===a.indexAfter(-1)){if(a.depth===2||a.node(-3).type!==s||a.index(-2)!==a.node(-2).childCount-1)return!1;if(i){let y=C.empty,E=a.index(-1)?1:a.index(-2)?2:3;for(let I=a.depth-E;I>=a.depth-3;I-=1)y=C.from(a.node(I).copy(y));let x=a.indexAfter(-1)<a.node(-2).childCount?1:a.indexAfter(-2)<a.node(-3).childCount?2:3,k={...wr(u,a.node().type.name,a.node().attrs),...e},S=((g=s.contentMatch.defaultType)==null?void 0:g.createAndFill(k))||void 0;y=y.append(C.from(s.createAndFill(null,S)||void 0));let R=a.before(a.depth-(E-1));n.replace(R,a.after(-x),new w(y,4-E,0));let M=-1;n.doc.nodesBetween(R,n.doc.content.size,(I,H)=>{if(M>-1)return!1;I.isTextblock&&I.content.size===0&&(M=H+1)}),M>-1&&n.setSelection(L.near(n.doc.resolve(M))),n.scrollIntoView()}return!0}let f=l.pos===a.end()?d.contentMatchAt(0).defaultType:null,p={...wr(u,d.type.name,d.attrs),...e},h={...wr(u,a.node().type.name,a.node().attrs),...e};n.delete(a.pos,l.pos);let m=f?[{type:s,attrs:p},{type:f,attrs:h}]:[{type:s,attrs:p}];if(!Ue(n.doc,a.pos,2))return!1;if(i){let{selection:y,storedMarks:E}=r,{splittableMarks:x}=o.extensionManager,k=E||y.$to.parentOffset&&y.$from.marks();if(n.split(a.pos,2,m).scrollIntoView(),!k||!i)return!0;let S=k.filter(R=>x.includes(R.type.name));n.ensureMarks(S)}return!0};var ed=(t,e)=>{let n=ki(s=>s.type===e)(t.selection);if(!n)return!0;let r=t.doc.resolve(Math.max(0,n.pos-1)).before(n.depth);if(r===void 0)return!0;let i=t.doc.nodeAt(r);return n.node.type===(i==null?void 0:i.type)&&Ye(t.doc,n.pos)&&t.join(n.pos),!0},td=(t,e)=>{let n=ki(s=>s.type===e)(t.selection);if(!n)return!0;let r=t.doc.resolve(n.start).after(n.depth);if(r===void 0)return!0;let i=t.doc.nodeAt(r);return n.node.type===(i==null?void 0:i.type)&&Ye(t.doc,r)&&t.join(r),!0},Ty=(t,e,n,r={})=>({editor:i,tr:o,state:s,dispatch:a,chain:l,commands:c,can:d})=>{let{extensions:u,splittableMarks:f}=i.extensionManager,p=U(t,s.schema),h=U(e,s.schema),{selection:m,storedMarks:g}=s,{$from:y,$to:E}=m,x=y.blockRange(E),k=g||m.$to.parentOffset&&m.$from.marks();if(!x)return!1;let S=ki(R=>Zc(R.type.name,u))(m);if(x.depth>=1&&S&&x.depth-S.depth<=1){if(S.node.type===p)return c.liftListItem(h);if(Zc(S.node.type.name,u)&&p.validContent(S.node.content)&&a)return l().command(()=>(o.setNodeMarkup(S.pos,p),!0)).command(()=>ed(o,p)).command(()=>td(o,p)).run()}return!n||!k||!a?l().command(()=>d().wrapInList(p,r)?!0:c.clearNodes()).wrapInList(p,r).command(()=>ed(o,p)).command(()=>td(o,p)).run():l().command(()=>{let R=d().wrapInList(p,r),M=k.filter(I=>f.includes(I.type.name));return o.ensureMarks(M),R?!0:c.clearNodes()}).wrapInList(p,r).command(()=>ed(o,p)).command(()=>td(o,p)).run()};var Sy=(t,e={},n={})=>({state:r,commands:i})=>{let{extendEmptyMarkRange:o=!1}=n,s=Te(t,r.schema);return Qc(r,s,e)?i.unsetMark(s,{extendEmptyMarkRange:o}):i.setMark(s,e)};var ky=(t,e,n={})=>({state:r,commands:i})=>{let o=U(t,r.schema),s=U(e,r.schema),a=Fn(r,o,n),l;return r.selection.$anchor.sameParent(r.selection.$head)&&(l=r.selection.$anchor.parent.attrs),a?i.setNode(s,l):i.setNode(o,{...l,...n})};var My=(t,e={})=>({state:n,commands:r})=>{let i=U(t,n.schema);return Fn(n,i,e)?r.lift(i):r.wrapIn(i,e)};var wy=()=>({state:t,dispatch:e})=>{let n=t.plugins;for(let r=0;r<n.length;r+=1){let i=n[r],o;if(i.spec.isInputRules&&(o=i.getState(t))){if(e){let s=t.tr,a=o.transform;for(let l=a.steps.length-1;l>=0;l-=1)s.step(a.steps[l].invert(a.docs[l]));if(o.text){let l=s.doc.resolve(o.from).marks();s.replaceWith(o.from,o.to,t.schema.text(o.text,l))}else s.delete(o.from,o.to)}return!0}}return!1};var Ay=()=>({tr:t,dispatch:e})=>{let{selection:n}=t,{empty:r,ranges:i}=n;return r||e&&i.forEach(o=>{t.removeMark(o.$from.pos,o.$to.pos)}),!0};var Ry=(t,e={})=>({tr:n,state:r,dispatch:i})=>{var u;let{extendEmptyMarkRange:o=!1}=e,{selection:s}=n,a=Te(t,r.schema),{$from:l,empty:c,ranges:d}=s;if(!i)return!0;if(c&&o){let{from:f,to:p}=s,h=(u=l.marks().find(g=>g.type===a))==null?void 0:u.attrs,m=Sr(l,a,h);m&&(f=m.from,p=m.to),n.removeMark(f,p,a)}else d.forEach(f=>{n.removeMark(f.$from.pos,f.$to.pos,a)});return n.removeStoredMark(a),!0};var Oy=(t,e={})=>({tr:n,state:r,dispatch:i})=>{let o=null,s=null,a=_n(typeof t=="string"?t:t.name,r.schema);return a?(a==="node"&&(o=U(t,r.schema)),a==="mark"&&(s=Te(t,r.schema)),i&&n.selection.ranges.forEach(l=>{let c=l.$from.pos,d=l.$to.pos,u,f,p,h;n.selection.empty?r.doc.nodesBetween(c,d,(m,g)=>{o&&o===m.type&&(p=Math.max(g,c),h=Math.min(g+m.nodeSize,d),u=g,f=m)}):r.doc.nodesBetween(c,d,(m,g)=>{g<c&&o&&o===m.type&&(p=Math.max(g,c),h=Math.min(g+m.nodeSize,d),u=g,f=m),g>=c&&g<=d&&(o&&o===m.type&&n.setNodeMarkup(g,void 0,{...m.attrs,...e}),s&&m.marks.length&&m.marks.forEach(y=>{if(s===y.type){let E=Math.max(g,c),x=Math.min(g+m.nodeSize,d);n.addMark(E,x,s.create({...y.attrs,...e}))}}))}),f&&(u!==void 0&&n.setNodeMarkup(u,void 0,{...f.attrs,...e}),s&&f.marks.length&&f.marks.forEach(m=>{s===m.type&&n.addMark(p,h,s.create({...m.attrs,...e}))}))}),!0):!1};var Ly=(t,e={})=>({state:n,dispatch:r})=>{let i=U(t,n.schema);return mg(i,e)(n,r)};var Ny=(t,e={})=>({state:n,dispatch:r})=>{let i=U(t,n.schema);return Wg(i,e)(n,r)};var Iy=Y.create({name:"commands",addCommands(){return{...nd}}});var Py=Y.create({name:"drop",addProseMirrorPlugins(){return[new V({key:new G("tiptapDrop"),props:{handleDrop:(t,e,n,r)=>{this.editor.emit("drop",{editor:this.editor,event:e,slice:n,moved:r})}}})]}});var Dy=Y.create({name:"editable",addProseMirrorPlugins(){return[new V({key:new G("editable"),props:{editable:()=>this.editor.options.editable}})]}});var Hy=new G("focusEvents"),Fy=Y.create({name:"focusEvents",addProseMirrorPlugins(){let{editor:t}=this;return[new V({key:Hy,props:{handleDOMEvents:{focus:(e,n)=>{t.isFocused=!0;let r=t.state.tr.setMeta("focus",{event:n}).setMeta("addToHistory",!1);return e.dispatch(r),!1},blur:(e,n)=>{t.isFocused=!1;let r=t.state.tr.setMeta("blur",{event:n}).setMeta("addToHistory",!1);return e.dispatch(r),!1}}}})]}});var _y=Y.create({name:"keymap",addKeyboardShortcuts(){let t=()=>this.editor.commands.first(({commands:s})=>[()=>s.undoInputRule(),()=>s.command(({tr:a})=>{let{selection:l,doc:c}=a,{empty:d,$anchor:u}=l,{pos:f,parent:p}=u,h=u.parent.isTextblock&&f>0?a.doc.resolve(f-1):u,m=h.parent.type.spec.isolating,g=u.pos-u.parentOffset,y=m&&h.parent.childCount===1?g===u.pos:D.atStart(c).from===f;return!d||!p.type.isTextblock||p.textContent.length||!y||y&&u.parent.type.name==="paragraph"?!1:s.clearNodes()}),()=>s.deleteSelection(),()=>s.joinBackward(),()=>s.selectNodeBackward()]),e=()=>this.editor.commands.first(({commands:s})=>[()=>s.deleteSelection(),()=>s.deleteCurrentNode(),()=>s.joinForward(),()=>s.selectNodeForward()]),r={Enter:()=>this.editor.commands.first(({commands:s})=>[()=>s.newlineInCode(),()=>s.createParagraphNear(),()=>s.liftEmptyBlock(),()=>s.splitBlock()]),"Mod-Enter":()=>this.editor.commands.exitCode(),Backspace:t,"Mod-Backspace":t,"Shift-Backspace":t,Delete:e,"Mod-Delete":e,"Mod-a":()=>this.editor.commands.selectAll()},i={...r},o={...r,"Ctrl-h":t,"Alt-Backspace":t,"Ctrl-d":e,"Ctrl-Alt-Backspace":e,"Alt-Delete":e,"Alt-d":e,"Ctrl-a":()=>this.editor.commands.selectTextblockStart(),"Ctrl-e":()=>this.editor.commands.selectTextblockEnd()};return Dn()||ms()?o:i},addProseMirrorPlugins(){return[new V({key:new G("clearDocument"),appendTransaction:(t,e,n)=>{if(t.some(m=>m.getMeta("composition")))return;let r=t.some(m=>m.docChanged)&&!e.doc.eq(n.doc),i=t.some(m=>m.getMeta("preventClearDocument"));if(!r||i)return;let{empty:o,from:s,to:a}=e.selection,l=D.atStart(e.doc).from,c=D.atEnd(e.doc).to;if(o||!(s===l&&a===c)||!Ar(n.doc))return;let f=n.tr,p=lr({state:n,transaction:f}),{commands:h}=new yn({editor:this.editor,state:p});if(h.clearNodes(),!!f.steps.length)return f}})]}});var By=Y.create({name:"paste",addProseMirrorPlugins(){return[new V({key:new G("tiptapPaste"),props:{handlePaste:(t,e,n)=>{this.editor.emit("paste",{editor:this.editor,event:e,slice:n})}}})]}});var zy=Y.create({name:"tabindex",addProseMirrorPlugins(){return[new V({key:new G("tabindex"),props:{attributes:()=>this.editor.isEditable?{tabindex:"0"}:{}}})]}});var ne=class t{constructor(e={}){F(this,"type","node");F(this,"name","node");F(this,"parent",null);F(this,"child",null);F(this,"options");F(this,"storage");F(this,"config",{name:this.name,defaultOptions:{}});this.config={...this.config,...e},this.name=this.config.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${this.name}".`),this.options=this.config.defaultOptions,this.config.addOptions&&(this.options=j(te(this,"addOptions",{name:this.name}))),this.storage=j(te(this,"addStorage",{name:this.name,options:this.options}))||{}}static create(e={}){return new t(e)}configure(e={}){let n=this.extend({...this.config,addOptions:()=>In(this.options,e)});return n.name=this.name,n.parent=this.parent,n}extend(e={}){let n=new t(e);return n.parent=this,this.child=n,n.name=e.name?e.name:n.parent.name,e.defaultOptions&&Object.keys(e.defaultOptions).length>0&&console.warn(`[tiptap warn]: BREAKING CHANGE: "defaultOptions" is deprecated. Please use "addOptions" instead. Found in extension: "${n.name}".`),n.options=j(te(n,"addOptions",{name:n.name})),n.storage=j(te(n,"addStorage",{name:n.name,options:n.options})),n}};function bt(t){return new ut({find:t.find,handler:({state:e,range:n,match:r})=>{let i=j(t.getAttributes,void 0,r);if(i===!1||i===null)return null;let{tr:o}=e,s=r[r.length-1],a=r[0];if(s){let l=a.search(/\S/),c=n.from+a.indexOf(s),d=c+s.length;if(Mr(n.from,n.to,e.doc).filter(p=>p.mark.type.excluded.find(m=>m===t.type&&m!==p.mark.type)).filter(p=>p.to>c).length)return null;d<n.to&&o.delete(d,n.to),c>n.from&&o.delete(n.from+l,c);let f=n.from+l+s.length;o.addMark(n.from+l,f,t.type.create(i||{})),o.removeStoredMark(t.type)}}})}function Vy(t){return new ut({find:t.find,handler:({state:e,range:n,match:r})=>{let i=j(t.getAttributes,void 0,r)||{},{tr:o}=e,s=n.from,a=n.to,l=t.type.create(i);if(r[1]){let c=r[0].lastIndexOf(r[1]),d=s+c;d>a?d=a:a=d+r[1].length;let u=r[0][r[0].length-1];o.insertText(u,s+r[0].length-1),o.replaceWith(d,a,l)}else if(r[0]){let c=t.type.isInline?s:s-1;o.insert(c,t.type.create(i)).delete(o.mapping.map(s),o.mapping.map(a))}o.scrollIntoView()}})}function wi(t){return new ut({find:t.find,handler:({state:e,range:n,match:r})=>{let i=e.doc.resolve(n.from),o=j(t.getAttributes,void 0,r)||{};if(!i.node(-1).canReplaceWith(i.index(-1),i.indexAfter(-1),t.type))return null;e.tr.delete(n.from,n.to).setBlockType(n.from,n.from,t.type,o)}})}function nn(t){return new ut({find:t.find,handler:({state:e,range:n,match:r,chain:i})=>{let o=j(t.getAttributes,void 0,r)||{},s=e.tr.delete(n.from,n.to),l=s.doc.resolve(n.from).blockRange(),c=l&&fr(l,t.type,o);if(!c)return null;if(s.wrap(l,c),t.keepMarks&&t.editor){let{selection:u,storedMarks:f}=e,{splittableMarks:p}=t.editor.extensionManager,h=f||u.$to.parentOffset&&u.$from.marks();if(h){let m=h.filter(g=>p.includes(g.type.name));s.ensureMarks(m)}}if(t.keepAttributes){let u=t.type.name==="bulletList"||t.type.name==="orderedList"?"listItem":"taskList";i().updateAttributes(u,o).run()}let d=s.doc.resolve(n.from-1).nodeBefore;d&&d.type===t.type&&Ye(s.doc,n.from-1)&&(!t.joinPredicate||t.joinPredicate(r,d))&&s.join(n.from-1)}})}function nt(t){return new vr({find:t.find,handler:({state:e,range:n,match:r,pasteEvent:i})=>{let o=j(t.getAttributes,void 0,r,i);if(o===!1||o===null)return null;let{tr:s}=e,a=r[r.length-1],l=r[0],c=n.to;if(a){let d=l.search(/\S/),u=n.from+l.indexOf(a),f=u+a.length;if(Mr(n.from,n.to,e.doc).filter(h=>h.mark.type.excluded.find(g=>g===t.type&&g!==h.mark.type)).filter(h=>h.to>u).length)return null;f<n.to&&s.delete(f,n.to),u>n.from&&s.delete(n.from+d,u),c=n.from+d+a.length,s.addMark(n.from+d,c,t.type.create(o||{})),s.removeStoredMark(t.type)}}})}function Uy(t,e){let{selection:n}=t,{$from:r}=n;if(n instanceof O){let o=r.index();return r.parent.canReplaceWith(o,o+1,e)}let i=r.depth;for(;i>=0;){let o=r.index(i);if(r.node(i).contentMatchAt(o).matchType(e))return!0;i-=1}return!1}var R0=/^\s*>\s$/,rd=ne.create({name:"blockquote",addOptions(){return{HTMLAttributes:{}}},content:"block+",group:"block",defining:!0,parseHTML(){return[{tag:"blockquote"}]},renderHTML({HTMLAttributes:t}){return["blockquote",$(this.options.HTMLAttributes,t),0]},addCommands(){return{setBlockquote:()=>({commands:t})=>t.wrapIn(this.name),toggleBlockquote:()=>({commands:t})=>t.toggleWrap(this.name),unsetBlockquote:()=>({commands:t})=>t.lift(this.name)}},addKeyboardShortcuts(){return{"Mod-Shift-b":()=>this.editor.commands.toggleBlockquote()}},addInputRules(){return[nn({find:R0,type:this.type})]}});var O0=/(?:^|\s)(\*\*(?!\s+\*\*)((?:[^*]+))\*\*(?!\s+\*\*))$/,L0=/(?:^|\s)(\*\*(?!\s+\*\*)((?:[^*]+))\*\*(?!\s+\*\*))/g,N0=/(?:^|\s)(__(?!\s+__)((?:[^_]+))__(?!\s+__))$/,I0=/(?:^|\s)(__(?!\s+__)((?:[^_]+))__(?!\s+__))/g,id=Re.create({name:"bold",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"strong"},{tag:"b",getAttrs:t=>t.style.fontWeight!=="normal"&&null},{style:"font-weight=400",clearMark:t=>t.type.name===this.name},{style:"font-weight",getAttrs:t=>/^(bold(er)?|[5-9]\d{2,})$/.test(t)&&null}]},renderHTML({HTMLAttributes:t}){return["strong",$(this.options.HTMLAttributes,t),0]},addCommands(){return{setBold:()=>({commands:t})=>t.setMark(this.name),toggleBold:()=>({commands:t})=>t.toggleMark(this.name),unsetBold:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-b":()=>this.editor.commands.toggleBold(),"Mod-B":()=>this.editor.commands.toggleBold()}},addInputRules(){return[bt({find:O0,type:this.type}),bt({find:N0,type:this.type})]},addPasteRules(){return[nt({find:L0,type:this.type}),nt({find:I0,type:this.type})]}});var P0="listItem",$y="textStyle",jy=/^\s*([-+*])\s$/,od=ne.create({name:"bulletList",addOptions(){return{itemTypeName:"listItem",HTMLAttributes:{},keepMarks:!1,keepAttributes:!1}},group:"block list",content(){return`${this.options.itemTypeName}+`},parseHTML(){return[{tag:"ul"}]},renderHTML({HTMLAttributes:t}){return["ul",$(this.options.HTMLAttributes,t),0]},addCommands(){return{toggleBulletList:()=>({commands:t,chain:e})=>this.options.keepAttributes?e().toggleList(this.name,this.options.itemTypeName,this.options.keepMarks).updateAttributes(P0,this.editor.getAttributes($y)).run():t.toggleList(this.name,this.options.itemTypeName,this.options.keepMarks)}},addKeyboardShortcuts(){return{"Mod-Shift-8":()=>this.editor.commands.toggleBulletList()}},addInputRules(){let t=nn({find:jy,type:this.type});return(this.options.keepMarks||this.options.keepAttributes)&&(t=nn({find:jy,type:this.type,keepMarks:this.options.keepMarks,keepAttributes:this.options.keepAttributes,getAttributes:()=>this.editor.getAttributes($y),editor:this.editor})),[t]}});var D0=/(^|[^`])`([^`]+)`(?!`)/,H0=/(^|[^`])`([^`]+)`(?!`)/g,sd=Re.create({name:"code",addOptions(){return{HTMLAttributes:{}}},excludes:"_",code:!0,exitable:!0,parseHTML(){return[{tag:"code"}]},renderHTML({HTMLAttributes:t}){return["code",$(this.options.HTMLAttributes,t),0]},addCommands(){return{setCode:()=>({commands:t})=>t.setMark(this.name),toggleCode:()=>({commands:t})=>t.toggleMark(this.name),unsetCode:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-e":()=>this.editor.commands.toggleCode()}},addInputRules(){return[bt({find:D0,type:this.type})]},addPasteRules(){return[nt({find:H0,type:this.type})]}});var F0=/^```([a-z]+)?[\s\n]$/,_0=/^~~~([a-z]+)?[\s\n]$/,ad=ne.create({name:"codeBlock",addOptions(){return{languageClassPrefix:"language-",exitOnTripleEnter:!0,exitOnArrowDown:!0,defaultLanguage:null,HTMLAttributes:{}}},content:"text*",marks:"",group:"block",code:!0,defining:!0,addAttributes(){return{language:{default:this.options.defaultLanguage,parseHTML:t=>{var o;let{languageClassPrefix:e}=this.options,i=[...((o=t.firstElementChild)==null?void 0:o.classList)||[]].filter(s=>s.startsWith(e)).map(s=>s.replace(e,""))[0];return i||null},rendered:!1}}},parseHTML(){return[{tag:"pre",preserveWhitespace:"full"}]},renderHTML({node:t,HTMLAttributes:e}){return["pre",$(this.options.HTMLAttributes,e),["code",{class:t.attrs.language?this.options.languageClassPrefix+t.attrs.language:null},0]]},addCommands(){return{setCodeBlock:t=>({commands:e})=>e.setNode(this.name,t),toggleCodeBlock:t=>({commands:e})=>e.toggleNode(this.name,"paragraph",t)}},addKeyboardShortcuts(){return{"Mod-Alt-c":()=>this.editor.commands.toggleCodeBlock(),Backspace:()=>{let{empty:t,$anchor:e}=this.editor.state.selection,n=e.pos===1;return!t||e.parent.type.name!==this.name?!1:n||!e.parent.textContent.length?this.editor.commands.clearNodes():!1},Enter:({editor:t})=>{if(!this.options.exitOnTripleEnter)return!1;let{state:e}=t,{selection:n}=e,{$from:r,empty:i}=n;if(!i||r.parent.type!==this.type)return!1;let o=r.parentOffset===r.parent.nodeSize-2,s=r.parent.textContent.endsWith(`
 
-`);return!o||!s?!1:t.chain().command(({tr:l})=>(l.delete(r.pos-2,r.pos),!0)).exitCode().run()},ArrowDown:({editor:t})=>{if(!this.options.exitOnArrowDown)return!1;let{state:e}=t,{selection:n,doc:r}=e,{$from:i,empty:o}=n;if(!o||i.parent.type!==this.type||!(i.parentOffset===i.parent.nodeSize-2))return!1;let l=i.after();return l===void 0?!1:r.nodeAt(l)?t.commands.command(({tr:c})=>(c.setSelection(M.near(r.resolve(l))),!0)):t.commands.exitCode()}}},addInputRules(){return[Pn({find:Ky,type:this.type,getAttributes:t=>({language:t[1]})}),Pn({find:Jy,type:this.type,getAttributes:t=>({language:t[1]})})]},addProseMirrorPlugins(){return[new B({key:new j("codeBlockVSCodeHandler"),props:{handlePaste:(t,e)=>{if(!e.clipboardData||this.editor.isActive(this.type.name))return!1;let n=e.clipboardData.getData("text/plain"),r=e.clipboardData.getData("vscode-editor-data"),i=r?JSON.parse(r):void 0,o=i==null?void 0:i.mode;if(!n||!o)return!1;let{tr:s,schema:l}=t.state,a=l.text(n.replace(/\r\n?/g,`
-`));return s.replaceSelectionWith(this.type.create({language:o},a)),s.selection.$from.parent.type!==this.type&&s.setSelection(S.near(s.doc.resolve(Math.max(0,s.selection.from-2)))),s.setMeta("paste",!0),t.dispatch(s),!0}}})]}});var Ru=z.create({name:"doc",topNode:!0,content:"block+"});function Nu(t={}){return new B({view(e){return new Us(e,t)}})}var Us=class{constructor(e,n){var r;this.editorView=e,this.cursorPos=null,this.element=null,this.timeout=-1,this.width=(r=n.width)!==null&&r!==void 0?r:1,this.color=n.color===!1?void 0:n.color||"black",this.class=n.class,this.handlers=["dragover","dragend","drop","dragleave"].map(i=>{let o=s=>{this[i](s)};return e.dom.addEventListener(i,o),{name:i,handler:o}})}destroy(){this.handlers.forEach(({name:e,handler:n})=>this.editorView.dom.removeEventListener(e,n))}update(e,n){this.cursorPos!=null&&n.doc!=e.state.doc&&(this.cursorPos>e.state.doc.content.size?this.setCursor(null):this.updateOverlay())}setCursor(e){e!=this.cursorPos&&(this.cursorPos=e,e==null?(this.element.parentNode.removeChild(this.element),this.element=null):this.updateOverlay())}updateOverlay(){let e=this.editorView.state.doc.resolve(this.cursorPos),n=!e.parent.inlineContent,r,i=this.editorView.dom,o=i.getBoundingClientRect(),s=o.width/i.offsetWidth,l=o.height/i.offsetHeight;if(n){let u=e.nodeBefore,f=e.nodeAfter;if(u||f){let h=this.editorView.nodeDOM(this.cursorPos-(u?u.nodeSize:0));if(h){let p=h.getBoundingClientRect(),m=u?p.bottom:p.top;u&&f&&(m=(m+this.editorView.nodeDOM(this.cursorPos).getBoundingClientRect().top)/2);let g=this.width/2*l;r={left:p.left,right:p.right,top:m-g,bottom:m+g}}}}if(!r){let u=this.editorView.coordsAtPos(this.cursorPos),f=this.width/2*s;r={left:u.left-f,right:u.left+f,top:u.top,bottom:u.bottom}}let a=this.editorView.dom.offsetParent;this.element||(this.element=a.appendChild(document.createElement("div")),this.class&&(this.element.className=this.class),this.element.style.cssText="position: absolute; z-index: 50; pointer-events: none;",this.color&&(this.element.style.backgroundColor=this.color)),this.element.classList.toggle("prosemirror-dropcursor-block",n),this.element.classList.toggle("prosemirror-dropcursor-inline",!n);let c,d;if(!a||a==document.body&&getComputedStyle(a).position=="static")c=-pageXOffset,d=-pageYOffset;else{let u=a.getBoundingClientRect(),f=u.width/a.offsetWidth,h=u.height/a.offsetHeight;c=u.left-a.scrollLeft*f,d=u.top-a.scrollTop*h}this.element.style.left=(r.left-c)/s+"px",this.element.style.top=(r.top-d)/l+"px",this.element.style.width=(r.right-r.left)/s+"px",this.element.style.height=(r.bottom-r.top)/l+"px"}scheduleRemoval(e){clearTimeout(this.timeout),this.timeout=setTimeout(()=>this.setCursor(null),e)}dragover(e){if(!this.editorView.editable)return;let n=this.editorView.posAtCoords({left:e.clientX,top:e.clientY}),r=n&&n.inside>=0&&this.editorView.state.doc.nodeAt(n.inside),i=r&&r.type.spec.disableDropCursor,o=typeof i=="function"?i(this.editorView,n,e):i;if(n&&!o){let s=n.pos;if(this.editorView.dragging&&this.editorView.dragging.slice){let l=Ar(this.editorView.state.doc,s,this.editorView.dragging.slice);l!=null&&(s=l)}this.setCursor(s),this.scheduleRemoval(5e3)}}dragend(){this.scheduleRemoval(20)}drop(){this.scheduleRemoval(20)}dragleave(e){this.editorView.dom.contains(e.relatedTarget)||this.setCursor(null)}};var Lu=J.create({name:"dropCursor",addOptions(){return{color:"currentColor",width:1,class:void 0}},addProseMirrorPlugins(){return[Nu(this.options)]}});var se=class t extends M{constructor(e){super(e,e)}map(e,n){let r=e.resolve(n.map(this.head));return t.valid(r)?new t(r):M.near(r)}content(){return x.empty}eq(e){return e instanceof t&&e.head==this.head}toJSON(){return{type:"gapcursor",pos:this.head}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for GapCursor.fromJSON");return new t(e.resolve(n.pos))}getBookmark(){return new Ws(this.anchor)}static valid(e){let n=e.parent;if(n.isTextblock||!Gy(e)||!Yy(e))return!1;let r=n.type.spec.allowGapCursor;if(r!=null)return r;let i=n.contentMatchAt(e.index()).defaultType;return i&&i.isTextblock}static findGapCursorFrom(e,n,r=!1){e:for(;;){if(!r&&t.valid(e))return e;let i=e.pos,o=null;for(let s=e.depth;;s--){let l=e.node(s);if(n>0?e.indexAfter(s)<l.childCount:e.index(s)>0){o=l.child(n>0?e.indexAfter(s):e.index(s)-1);break}else if(s==0)return null;i+=n;let a=e.doc.resolve(i);if(t.valid(a))return a}for(;;){let s=n>0?o.firstChild:o.lastChild;if(!s){if(o.isAtom&&!o.isText&&!C.isSelectable(o)){e=e.doc.resolve(i+o.nodeSize*n),r=!1;continue e}break}o=s,i+=n;let l=e.doc.resolve(i);if(t.valid(l))return l}return null}}};se.prototype.visible=!1;se.findFrom=se.findGapCursorFrom;M.jsonID("gapcursor",se);var Ws=class t{constructor(e){this.pos=e}map(e){return new t(e.map(this.pos))}resolve(e){let n=e.resolve(this.pos);return se.valid(n)?new se(n):M.near(n)}};function _u(t){return t.isAtom||t.spec.isolating||t.spec.createGapCursor}function Gy(t){for(let e=t.depth;e>=0;e--){let n=t.index(e),r=t.node(e);if(n==0){if(r.type.spec.isolating)return!0;continue}for(let i=r.child(n-1);;i=i.lastChild){if(i.childCount==0&&!i.inlineContent||_u(i.type))return!0;if(i.inlineContent)return!1}}return!0}function Yy(t){for(let e=t.depth;e>=0;e--){let n=t.indexAfter(e),r=t.node(e);if(n==r.childCount){if(r.type.spec.isolating)return!0;continue}for(let i=r.child(n);;i=i.firstChild){if(i.childCount==0&&!i.inlineContent||_u(i.type))return!0;if(i.inlineContent)return!1}}return!0}function Iu(){return new B({props:{decorations:eb,createSelectionBetween(t,e,n){return e.pos==n.pos&&se.valid(n)?new se(n):null},handleClick:Xy,handleKeyDown:Qy,handleDOMEvents:{beforeinput:Zy}}})}var Qy=jd({ArrowLeft:ei("horiz",-1),ArrowRight:ei("horiz",1),ArrowUp:ei("vert",-1),ArrowDown:ei("vert",1)});function ei(t,e){let n=t=="vert"?e>0?"down":"up":e>0?"right":"left";return function(r,i,o){let s=r.selection,l=e>0?s.$to:s.$from,a=s.empty;if(s instanceof S){if(!o.endOfTextblock(n)||l.depth==0)return!1;a=!1,l=r.doc.resolve(e>0?l.after():l.before())}let c=se.findGapCursorFrom(l,e,a);return c?(i&&i(r.tr.setSelection(new se(c))),!0):!1}}function Xy(t,e,n){if(!t||!t.editable)return!1;let r=t.state.doc.resolve(e);if(!se.valid(r))return!1;let i=t.posAtCoords({left:n.clientX,top:n.clientY});return i&&i.inside>-1&&C.isSelectable(t.state.doc.nodeAt(i.inside))?!1:(t.dispatch(t.state.tr.setSelection(new se(r))),!0)}function Zy(t,e){if(e.inputType!="insertCompositionText"||!(t.state.selection instanceof se))return!1;let{$from:n}=t.state.selection,r=n.parent.contentMatchAt(n.index()).findWrapping(t.state.schema.nodes.text);if(!r)return!1;let i=v.empty;for(let s=r.length-1;s>=0;s--)i=v.from(r[s].createAndFill(null,i));let o=t.state.tr.replace(n.pos,n.pos,new x(i,0,0));return o.setSelection(S.near(o.doc.resolve(n.pos+1))),t.dispatch(o),!1}function eb(t){if(!(t.selection instanceof se))return null;let e=document.createElement("div");return e.className="ProseMirror-gapcursor",oe.create(t.doc,[xe.widget(t.selection.head,e,{key:"gapcursor"})])}var Du=J.create({name:"gapCursor",addProseMirrorPlugins(){return[Iu()]},extendNodeSchema(t){var e;let n={name:t.name,options:t.options,storage:t.storage};return{allowGapCursor:(e=K(X(t,"allowGapCursor",n)))!==null&&e!==void 0?e:null}}});var Pu=z.create({name:"hardBreak",addOptions(){return{keepMarks:!0,HTMLAttributes:{}}},inline:!0,group:"inline",selectable:!1,linebreakReplacement:!0,parseHTML(){return[{tag:"br"}]},renderHTML({HTMLAttributes:t}){return["br",N(this.options.HTMLAttributes,t)]},renderText(){return`
-`},addCommands(){return{setHardBreak:()=>({commands:t,chain:e,state:n,editor:r})=>t.first([()=>t.exitCode(),()=>t.command(()=>{let{selection:i,storedMarks:o}=n;if(i.$from.parent.type.spec.isolating)return!1;let{keepMarks:s}=this.options,{splittableMarks:l}=r.extensionManager,a=o||i.$to.parentOffset&&i.$from.marks();return e().insertContent({type:this.name}).command(({tr:c,dispatch:d})=>{if(d&&a&&s){let u=a.filter(f=>l.includes(f.type.name));c.ensureMarks(u)}return!0}).run()})])}},addKeyboardShortcuts(){return{"Mod-Enter":()=>this.editor.commands.setHardBreak(),"Shift-Enter":()=>this.editor.commands.setHardBreak()}}});var Hu=z.create({name:"heading",addOptions(){return{levels:[1,2,3,4,5,6],HTMLAttributes:{}}},content:"inline*",group:"block",defining:!0,addAttributes(){return{level:{default:1,rendered:!1}}},parseHTML(){return this.options.levels.map(t=>({tag:`h${t}`,attrs:{level:t}}))},renderHTML({node:t,HTMLAttributes:e}){return[`h${this.options.levels.includes(t.attrs.level)?t.attrs.level:this.options.levels[0]}`,N(this.options.HTMLAttributes,e),0]},addCommands(){return{setHeading:t=>({commands:e})=>this.options.levels.includes(t.level)?e.setNode(this.name,t):!1,toggleHeading:t=>({commands:e})=>this.options.levels.includes(t.level)?e.toggleNode(this.name,"paragraph",t):!1}},addKeyboardShortcuts(){return this.options.levels.reduce((t,e)=>({...t,[`Mod-Alt-${e}`]:()=>this.editor.commands.toggleHeading({level:e})}),{})},addInputRules(){return this.options.levels.map(t=>Pn({find:new RegExp(`^(#{${Math.min(...this.options.levels)},${t}})\\s$`),type:this.type,getAttributes:{level:t}}))}});var ti=200,ee=function(){};ee.prototype.append=function(e){return e.length?(e=ee.from(e),!this.length&&e||e.length<ti&&this.leafAppend(e)||this.length<ti&&e.leafPrepend(this)||this.appendInner(e)):this};ee.prototype.prepend=function(e){return e.length?ee.from(e).append(this):this};ee.prototype.appendInner=function(e){return new tb(this,e)};ee.prototype.slice=function(e,n){return e===void 0&&(e=0),n===void 0&&(n=this.length),e>=n?ee.empty:this.sliceInner(Math.max(0,e),Math.min(this.length,n))};ee.prototype.get=function(e){if(!(e<0||e>=this.length))return this.getInner(e)};ee.prototype.forEach=function(e,n,r){n===void 0&&(n=0),r===void 0&&(r=this.length),n<=r?this.forEachInner(e,n,r,0):this.forEachInvertedInner(e,n,r,0)};ee.prototype.map=function(e,n,r){n===void 0&&(n=0),r===void 0&&(r=this.length);var i=[];return this.forEach(function(o,s){return i.push(e(o,s))},n,r),i};ee.from=function(e){return e instanceof ee?e:e&&e.length?new Fu(e):ee.empty};var Fu=function(t){function e(r){t.call(this),this.values=r}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e;var n={length:{configurable:!0},depth:{configurable:!0}};return e.prototype.flatten=function(){return this.values},e.prototype.sliceInner=function(i,o){return i==0&&o==this.length?this:new e(this.values.slice(i,o))},e.prototype.getInner=function(i){return this.values[i]},e.prototype.forEachInner=function(i,o,s,l){for(var a=o;a<s;a++)if(i(this.values[a],l+a)===!1)return!1},e.prototype.forEachInvertedInner=function(i,o,s,l){for(var a=o-1;a>=s;a--)if(i(this.values[a],l+a)===!1)return!1},e.prototype.leafAppend=function(i){if(this.length+i.length<=ti)return new e(this.values.concat(i.flatten()))},e.prototype.leafPrepend=function(i){if(this.length+i.length<=ti)return new e(i.flatten().concat(this.values))},n.length.get=function(){return this.values.length},n.depth.get=function(){return 0},Object.defineProperties(e.prototype,n),e}(ee);ee.empty=new Fu([]);var tb=function(t){function e(n,r){t.call(this),this.left=n,this.right=r,this.length=n.length+r.length,this.depth=Math.max(n.depth,r.depth)+1}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.flatten=function(){return this.left.flatten().concat(this.right.flatten())},e.prototype.getInner=function(r){return r<this.left.length?this.left.get(r):this.right.get(r-this.left.length)},e.prototype.forEachInner=function(r,i,o,s){var l=this.left.length;if(i<l&&this.left.forEachInner(r,i,Math.min(o,l),s)===!1||o>l&&this.right.forEachInner(r,Math.max(i-l,0),Math.min(this.length,o)-l,s+l)===!1)return!1},e.prototype.forEachInvertedInner=function(r,i,o,s){var l=this.left.length;if(i>l&&this.right.forEachInvertedInner(r,i-l,Math.max(o,l)-l,s+l)===!1||o<l&&this.left.forEachInvertedInner(r,Math.min(i,l),o,s)===!1)return!1},e.prototype.sliceInner=function(r,i){if(r==0&&i==this.length)return this;var o=this.left.length;return i<=o?this.left.slice(r,i):r>=o?this.right.slice(r-o,i-o):this.left.slice(r,o).append(this.right.slice(0,i-o))},e.prototype.leafAppend=function(r){var i=this.right.leafAppend(r);if(i)return new e(this.left,i)},e.prototype.leafPrepend=function(r){var i=this.left.leafPrepend(r);if(i)return new e(i,this.right)},e.prototype.appendInner=function(r){return this.left.depth>=Math.max(this.right.depth,r.depth)+1?new e(this.left,new e(this.right,r)):new e(this,r)},e}(ee),qs=ee;var nb=500,Nt=class t{constructor(e,n){this.items=e,this.eventCount=n}popEvent(e,n){if(this.eventCount==0)return null;let r=this.items.length;for(;;r--)if(this.items.get(r-1).selection){--r;break}let i,o;n&&(i=this.remapping(r,this.items.length),o=i.maps.length);let s=e.tr,l,a,c=[],d=[];return this.items.forEach((u,f)=>{if(!u.step){i||(i=this.remapping(r,f+1),o=i.maps.length),o--,d.push(u);return}if(i){d.push(new Ne(u.map));let h=u.step.map(i.slice(o)),p;h&&s.maybeStep(h).doc&&(p=s.mapping.maps[s.mapping.maps.length-1],c.push(new Ne(p,void 0,void 0,c.length+d.length))),o--,p&&i.appendMap(p,o)}else s.maybeStep(u.step);if(u.selection)return l=i?u.selection.map(i.slice(o)):u.selection,a=new t(this.items.slice(0,r).append(d.reverse().concat(c)),this.eventCount-1),!1},this.items.length,0),{remaining:a,transform:s,selection:l}}addTransform(e,n,r,i){let o=[],s=this.eventCount,l=this.items,a=!i&&l.length?l.get(l.length-1):null;for(let d=0;d<e.steps.length;d++){let u=e.steps[d].invert(e.docs[d]),f=new Ne(e.mapping.maps[d],u,n),h;(h=a&&a.merge(f))&&(f=h,d?o.pop():l=l.slice(0,l.length-1)),o.push(f),n&&(s++,n=void 0),i||(a=f)}let c=s-r.depth;return c>ib&&(l=rb(l,c),s-=c),new t(l.append(o),s)}remapping(e,n){let r=new Cn;return this.items.forEach((i,o)=>{let s=i.mirrorOffset!=null&&o-i.mirrorOffset>=e?r.maps.length-i.mirrorOffset:void 0;r.appendMap(i.map,s)},e,n),r}addMaps(e){return this.eventCount==0?this:new t(this.items.append(e.map(n=>new Ne(n))),this.eventCount)}rebased(e,n){if(!this.eventCount)return this;let r=[],i=Math.max(0,this.items.length-n),o=e.mapping,s=e.steps.length,l=this.eventCount;this.items.forEach(f=>{f.selection&&l--},i);let a=n;this.items.forEach(f=>{let h=o.getMirror(--a);if(h==null)return;s=Math.min(s,h);let p=o.maps[h];if(f.step){let m=e.steps[h].invert(e.docs[h]),g=f.selection&&f.selection.map(o.slice(a+1,h));g&&l++,r.push(new Ne(p,m,g))}else r.push(new Ne(p))},i);let c=[];for(let f=n;f<s;f++)c.push(new Ne(o.maps[f]));let d=this.items.slice(0,i).append(c).append(r),u=new t(d,l);return u.emptyItemCount()>nb&&(u=u.compress(this.items.length-r.length)),u}emptyItemCount(){let e=0;return this.items.forEach(n=>{n.step||e++}),e}compress(e=this.items.length){let n=this.remapping(0,e),r=n.maps.length,i=[],o=0;return this.items.forEach((s,l)=>{if(l>=e)i.push(s),s.selection&&o++;else if(s.step){let a=s.step.map(n.slice(r)),c=a&&a.getMap();if(r--,c&&n.appendMap(c,r),a){let d=s.selection&&s.selection.map(n.slice(r));d&&o++;let u=new Ne(c.invert(),a,d),f,h=i.length-1;(f=i.length&&i[h].merge(u))?i[h]=f:i.push(u)}}else s.map&&r--},this.items.length,0),new t(qs.from(i.reverse()),o)}};Nt.empty=new Nt(qs.empty,0);function rb(t,e){let n;return t.forEach((r,i)=>{if(r.selection&&e--==0)return n=i,!1}),t.slice(n)}var Ne=class t{constructor(e,n,r,i){this.map=e,this.step=n,this.selection=r,this.mirrorOffset=i}merge(e){if(this.step&&e.step&&!e.selection){let n=e.step.merge(this.step);if(n)return new t(n.getMap().invert(),n,this.selection)}}},Le=class{constructor(e,n,r,i,o){this.done=e,this.undone=n,this.prevRanges=r,this.prevTime=i,this.prevComposition=o}},ib=20;function ob(t,e,n,r){let i=n.getMeta(Rt),o;if(i)return i.historyState;n.getMeta(ab)&&(t=new Le(t.done,t.undone,null,0,-1));let s=n.getMeta("appendedTransaction");if(n.steps.length==0)return t;if(s&&s.getMeta(Rt))return s.getMeta(Rt).redo?new Le(t.done.addTransform(n,void 0,r,ni(e)),t.undone,Bu(n.mapping.maps),t.prevTime,t.prevComposition):new Le(t.done,t.undone.addTransform(n,void 0,r,ni(e)),null,t.prevTime,t.prevComposition);if(n.getMeta("addToHistory")!==!1&&!(s&&s.getMeta("addToHistory")===!1)){let l=n.getMeta("composition"),a=t.prevTime==0||!s&&t.prevComposition!=l&&(t.prevTime<(n.time||0)-r.newGroupDelay||!sb(n,t.prevRanges)),c=s?Ks(t.prevRanges,n.mapping):Bu(n.mapping.maps);return new Le(t.done.addTransform(n,a?e.selection.getBookmark():void 0,r,ni(e)),Nt.empty,c,n.time,l==null?t.prevComposition:l)}else return(o=n.getMeta("rebased"))?new Le(t.done.rebased(n,o),t.undone.rebased(n,o),Ks(t.prevRanges,n.mapping),t.prevTime,t.prevComposition):new Le(t.done.addMaps(n.mapping.maps),t.undone.addMaps(n.mapping.maps),Ks(t.prevRanges,n.mapping),t.prevTime,t.prevComposition)}function sb(t,e){if(!e)return!1;if(!t.docChanged)return!0;let n=!1;return t.mapping.maps[0].forEach((r,i)=>{for(let o=0;o<e.length;o+=2)r<=e[o+1]&&i>=e[o]&&(n=!0)}),n}function Bu(t){let e=[];for(let n=t.length-1;n>=0&&e.length==0;n--)t[n].forEach((r,i,o,s)=>e.push(o,s));return e}function Ks(t,e){if(!t)return null;let n=[];for(let r=0;r<t.length;r+=2){let i=e.map(t[r],1),o=e.map(t[r+1],-1);i<=o&&n.push(i,o)}return n}function lb(t,e,n){let r=ni(e),i=Rt.get(e).spec.config,o=(n?t.undone:t.done).popEvent(e,r);if(!o)return null;let s=o.selection.resolve(o.transform.doc),l=(n?t.done:t.undone).addTransform(o.transform,e.selection.getBookmark(),i,r),a=new Le(n?l:o.remaining,n?o.remaining:l,null,0,-1);return o.transform.setSelection(s).setMeta(Rt,{redo:n,historyState:a})}var Js=!1,zu=null;function ni(t){let e=t.plugins;if(zu!=e){Js=!1,zu=e;for(let n=0;n<e.length;n++)if(e[n].spec.historyPreserveItems){Js=!0;break}}return Js}var Rt=new j("history"),ab=new j("closeHistory");function Vu(t={}){return t={depth:t.depth||100,newGroupDelay:t.newGroupDelay||500},new B({key:Rt,state:{init(){return new Le(Nt.empty,Nt.empty,null,0,-1)},apply(e,n,r){return ob(n,r,e,t)}},config:t,props:{handleDOMEvents:{beforeinput(e,n){let r=n.inputType,i=r=="historyUndo"?Gs:r=="historyRedo"?Ys:null;return i?(n.preventDefault(),i(e.state,e.dispatch)):!1}}}})}function ri(t,e){return(n,r)=>{let i=Rt.getState(n);if(!i||(t?i.undone:i.done).eventCount==0)return!1;if(r){let o=lb(i,n,t);o&&r(e?o.scrollIntoView():o)}return!0}}var Gs=ri(!1,!0),Ys=ri(!0,!0),Hk=ri(!1,!1),Fk=ri(!0,!1);var $u=J.create({name:"history",addOptions(){return{depth:100,newGroupDelay:500}},addCommands(){return{undo:()=>({state:t,dispatch:e})=>Gs(t,e),redo:()=>({state:t,dispatch:e})=>Ys(t,e)}},addProseMirrorPlugins(){return[Vu(this.options)]},addKeyboardShortcuts(){return{"Mod-z":()=>this.editor.commands.undo(),"Shift-Mod-z":()=>this.editor.commands.redo(),"Mod-y":()=>this.editor.commands.redo(),"Mod-\u044F":()=>this.editor.commands.undo(),"Shift-Mod-\u044F":()=>this.editor.commands.redo()}}});var ju=z.create({name:"horizontalRule",addOptions(){return{HTMLAttributes:{}}},group:"block",parseHTML(){return[{tag:"hr"}]},renderHTML({HTMLAttributes:t}){return["hr",N(this.options.HTMLAttributes,t)]},addCommands(){return{setHorizontalRule:()=>({chain:t,state:e})=>{if(!Cu(e,e.schema.nodes[this.name]))return!1;let{selection:n}=e,{$from:r,$to:i}=n,o=t();return r.parentOffset===0?o.insertContentAt({from:Math.max(r.pos-1,0),to:i.pos},{type:this.name}):ku(n)?o.insertContentAt(i.pos,{type:this.name}):o.insertContent({type:this.name}),o.command(({tr:s,dispatch:l})=>{var a;if(l){let{$to:c}=s.selection,d=c.end();if(c.nodeAfter)c.nodeAfter.isTextblock?s.setSelection(S.create(s.doc,c.pos+1)):c.nodeAfter.isBlock?s.setSelection(C.create(s.doc,c.pos)):s.setSelection(S.create(s.doc,c.pos));else{let u=(a=c.parent.type.contentMatch.defaultType)===null||a===void 0?void 0:a.create();u&&(s.insert(d,u),s.setSelection(S.create(s.doc,d+1)))}s.scrollIntoView()}return!0}).run()}}},addInputRules(){return[xu({find:/^(?:---|—-|___\s|\*\*\*\s)$/,type:this.type})]}});var cb=/(?:^|\s)(\*(?!\s+\*)((?:[^*]+))\*(?!\s+\*))$/,db=/(?:^|\s)(\*(?!\s+\*)((?:[^*]+))\*(?!\s+\*))/g,ub=/(?:^|\s)(_(?!\s+_)((?:[^_]+))_(?!\s+_))$/,fb=/(?:^|\s)(_(?!\s+_)((?:[^_]+))_(?!\s+_))/g,Uu=he.create({name:"italic",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"em"},{tag:"i",getAttrs:t=>t.style.fontStyle!=="normal"&&null},{style:"font-style=normal",clearMark:t=>t.type.name===this.name},{style:"font-style=italic"}]},renderHTML({HTMLAttributes:t}){return["em",N(this.options.HTMLAttributes,t),0]},addCommands(){return{setItalic:()=>({commands:t})=>t.setMark(this.name),toggleItalic:()=>({commands:t})=>t.toggleMark(this.name),unsetItalic:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-i":()=>this.editor.commands.toggleItalic(),"Mod-I":()=>this.editor.commands.toggleItalic()}},addInputRules(){return[Re({find:cb,type:this.type}),Re({find:ub,type:this.type})]},addPasteRules(){return[Ce({find:db,type:this.type}),Ce({find:fb,type:this.type})]}});var Wu=z.create({name:"listItem",addOptions(){return{HTMLAttributes:{},bulletListTypeName:"bulletList",orderedListTypeName:"orderedList"}},content:"paragraph block*",defining:!0,parseHTML(){return[{tag:"li"}]},renderHTML({HTMLAttributes:t}){return["li",N(this.options.HTMLAttributes,t),0]},addKeyboardShortcuts(){return{Enter:()=>this.editor.commands.splitListItem(this.name),Tab:()=>this.editor.commands.sinkListItem(this.name),"Shift-Tab":()=>this.editor.commands.liftListItem(this.name)}}});var hb="listItem",qu="textStyle",Ku=/^(\d+)\.\s$/,Ju=z.create({name:"orderedList",addOptions(){return{itemTypeName:"listItem",HTMLAttributes:{},keepMarks:!1,keepAttributes:!1}},group:"block list",content(){return`${this.options.itemTypeName}+`},addAttributes(){return{start:{default:1,parseHTML:t=>t.hasAttribute("start")?parseInt(t.getAttribute("start")||"",10):1},type:{default:null,parseHTML:t=>t.getAttribute("type")}}},parseHTML(){return[{tag:"ol"}]},renderHTML({HTMLAttributes:t}){let{start:e,...n}=t;return e===1?["ol",N(this.options.HTMLAttributes,n),0]:["ol",N(this.options.HTMLAttributes,t),0]},addCommands(){return{toggleOrderedList:()=>({commands:t,chain:e})=>this.options.keepAttributes?e().toggleList(this.name,this.options.itemTypeName,this.options.keepMarks).updateAttributes(hb,this.editor.getAttributes(qu)).run():t.toggleList(this.name,this.options.itemTypeName,this.options.keepMarks)}},addKeyboardShortcuts(){return{"Mod-Shift-7":()=>this.editor.commands.toggleOrderedList()}},addInputRules(){let t=it({find:Ku,type:this.type,getAttributes:e=>({start:+e[1]}),joinPredicate:(e,n)=>n.childCount+n.attrs.start===+e[1]});return(this.options.keepMarks||this.options.keepAttributes)&&(t=it({find:Ku,type:this.type,keepMarks:this.options.keepMarks,keepAttributes:this.options.keepAttributes,getAttributes:e=>({start:+e[1],...this.editor.getAttributes(qu)}),joinPredicate:(e,n)=>n.childCount+n.attrs.start===+e[1],editor:this.editor})),[t]}});var Gu=z.create({name:"paragraph",priority:1e3,addOptions(){return{HTMLAttributes:{}}},group:"block",content:"inline*",parseHTML(){return[{tag:"p"}]},renderHTML({HTMLAttributes:t}){return["p",N(this.options.HTMLAttributes,t),0]},addCommands(){return{setParagraph:()=>({commands:t})=>t.setNode(this.name)}},addKeyboardShortcuts(){return{"Mod-Alt-0":()=>this.editor.commands.setParagraph()}}});var pb=/(?:^|\s)(~~(?!\s+~~)((?:[^~]+))~~(?!\s+~~))$/,mb=/(?:^|\s)(~~(?!\s+~~)((?:[^~]+))~~(?!\s+~~))/g,Yu=he.create({name:"strike",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"s"},{tag:"del"},{tag:"strike"},{style:"text-decoration",consuming:!1,getAttrs:t=>t.includes("line-through")?{}:!1}]},renderHTML({HTMLAttributes:t}){return["s",N(this.options.HTMLAttributes,t),0]},addCommands(){return{setStrike:()=>({commands:t})=>t.setMark(this.name),toggleStrike:()=>({commands:t})=>t.toggleMark(this.name),unsetStrike:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-Shift-s":()=>this.editor.commands.toggleStrike()}},addInputRules(){return[Re({find:pb,type:this.type})]},addPasteRules(){return[Ce({find:mb,type:this.type})]}});var Qu=z.create({name:"text",group:"inline"});var gb=J.create({name:"starterKit",addExtensions(){let t=[];return this.options.bold!==!1&&t.push(Su.configure(this.options.bold)),this.options.blockquote!==!1&&t.push(Eu.configure(this.options.blockquote)),this.options.bulletList!==!1&&t.push(Tu.configure(this.options.bulletList)),this.options.code!==!1&&t.push(Au.configure(this.options.code)),this.options.codeBlock!==!1&&t.push(Ou.configure(this.options.codeBlock)),this.options.document!==!1&&t.push(Ru.configure(this.options.document)),this.options.dropcursor!==!1&&t.push(Lu.configure(this.options.dropcursor)),this.options.gapcursor!==!1&&t.push(Du.configure(this.options.gapcursor)),this.options.hardBreak!==!1&&t.push(Pu.configure(this.options.hardBreak)),this.options.heading!==!1&&t.push(Hu.configure(this.options.heading)),this.options.history!==!1&&t.push($u.configure(this.options.history)),this.options.horizontalRule!==!1&&t.push(ju.configure(this.options.horizontalRule)),this.options.italic!==!1&&t.push(Uu.configure(this.options.italic)),this.options.listItem!==!1&&t.push(Wu.configure(this.options.listItem)),this.options.orderedList!==!1&&t.push(Ju.configure(this.options.orderedList)),this.options.paragraph!==!1&&t.push(Gu.configure(this.options.paragraph)),this.options.strike!==!1&&t.push(Yu.configure(this.options.strike)),this.options.text!==!1&&t.push(Qu.configure(this.options.text)),t}});var yb=J.create({name:"placeholder",addOptions(){return{emptyEditorClass:"is-editor-empty",emptyNodeClass:"is-empty",placeholder:"Write something \u2026",showOnlyWhenEditable:!0,showOnlyCurrent:!0,includeChildren:!1}},addProseMirrorPlugins(){return[new B({key:new j("placeholder"),props:{decorations:({doc:t,selection:e})=>{let n=this.editor.isEditable||!this.options.showOnlyWhenEditable,{anchor:r}=e,i=[];if(!n)return null;let o=this.editor.isEmpty;return t.descendants((s,l)=>{let a=r>=l&&r<=l+s.nodeSize,c=!s.isLeaf&&Zr(s);if((a||!this.options.showOnlyCurrent)&&c){let d=[this.options.emptyNodeClass];o&&d.push(this.options.emptyEditorClass);let u=xe.node(l,l+s.nodeSize,{class:d.join(" "),"data-placeholder":typeof this.options.placeholder=="function"?this.options.placeholder({editor:this.editor,node:s,pos:l,hasAnchor:a}):this.options.placeholder});i.push(u)}return this.options.includeChildren}),oe.create(t,i)}}})]}});var bb="aaa1rp3bb0ott3vie4c1le2ogado5udhabi7c0ademy5centure6ountant0s9o1tor4d0s1ult4e0g1ro2tna4f0l1rica5g0akhan5ency5i0g1rbus3force5tel5kdn3l0ibaba4pay4lfinanz6state5y2sace3tom5m0azon4ericanexpress7family11x2fam3ica3sterdam8nalytics7droid5quan4z2o0l2partments8p0le4q0uarelle8r0ab1mco4chi3my2pa2t0e3s0da2ia2sociates9t0hleta5torney7u0ction5di0ble3o3spost5thor3o0s4w0s2x0a2z0ure5ba0by2idu3namex4d1k2r0celona5laycard4s5efoot5gains6seball5ketball8uhaus5yern5b0c1t1va3cg1n2d1e0ats2uty4er2rlin4st0buy5t2f1g1h0arti5i0ble3d1ke2ng0o3o1z2j1lack0friday9ockbuster8g1omberg7ue3m0s1w2n0pparibas9o0ats3ehringer8fa2m1nd2o0k0ing5sch2tik2on4t1utique6x2r0adesco6idgestone9oadway5ker3ther5ussels7s1t1uild0ers6siness6y1zz3v1w1y1z0h3ca0b1fe2l0l1vinklein9m0era3p2non3petown5ital0one8r0avan4ds2e0er0s4s2sa1e1h1ino4t0ering5holic7ba1n1re3c1d1enter4o1rn3f0a1d2g1h0anel2nel4rity4se2t2eap3intai5ristmas6ome4urch5i0priani6rcle4sco3tadel4i0c2y3k1l0aims4eaning6ick2nic1que6othing5ud3ub0med6m1n1o0ach3des3ffee4llege4ogne5m0mbank4unity6pany2re3uter5sec4ndos3struction8ulting7tact3ractors9oking4l1p2rsica5untry4pon0s4rses6pa2r0edit0card4union9icket5own3s1uise0s6u0isinella9v1w1x1y0mru3ou3z2dad1nce3ta1e1ing3sun4y2clk3ds2e0al0er2s3gree4livery5l1oitte5ta3mocrat6ntal2ist5si0gn4v2hl2iamonds6et2gital5rect0ory7scount3ver5h2y2j1k1m1np2o0cs1tor4g1mains5t1wnload7rive4tv2ubai3nlop4pont4rban5vag2r2z2earth3t2c0o2deka3u0cation8e1g1mail3erck5nergy4gineer0ing9terprises10pson4quipment8r0icsson6ni3s0q1tate5t1u0rovision8s2vents5xchange6pert3osed4ress5traspace10fage2il1rwinds6th3mily4n0s2rm0ers5shion4t3edex3edback6rrari3ero6i0delity5o2lm2nal1nce1ial7re0stone6mdale6sh0ing5t0ness6j1k1lickr3ghts4r2orist4wers5y2m1o0o0d1tball6rd1ex2sale4um3undation8x2r0ee1senius7l1ogans4ntier7tr2ujitsu5n0d2rniture7tbol5yi3ga0l0lery3o1up4me0s3p1rden4y2b0iz3d0n2e0a1nt0ing5orge5f1g0ee3h1i0ft0s3ves2ing5l0ass3e1obal2o4m0ail3bh2o1x2n1odaddy5ld0point6f2o0dyear5g0le4p1t1v2p1q1r0ainger5phics5tis4een3ipe3ocery4up4s1t1u0cci3ge2ide2tars5ru3w1y2hair2mburg5ngout5us3bo2dfc0bank7ealth0care8lp1sinki6re1mes5iphop4samitsu7tachi5v2k0t2m1n1ockey4ldings5iday5medepot5goods5s0ense7nda3rse3spital5t0ing5t0els3mail5use3w2r1sbc3t1u0ghes5yatt3undai7ibm2cbc2e1u2d1e0ee3fm2kano4l1m0amat4db2mo0bilien9n0c1dustries8finiti5o2g1k1stitute6urance4e4t0ernational10uit4vestments10o1piranga7q1r0ish4s0maili5t0anbul7t0au2v3jaguar4va3cb2e0ep2tzt3welry6io2ll2m0p2nj2o0bs1urg4t1y2p0morgan6rs3uegos4niper7kaufen5ddi3e0rryhotels6properties14fh2g1h1i0a1ds2m1ndle4tchen5wi3m1n1oeln3matsu5sher5p0mg2n2r0d1ed3uokgroup8w1y0oto4z2la0caixa5mborghini8er3nd0rover6xess5salle5t0ino3robe5w0yer5b1c1ds2ease3clerc5frak4gal2o2xus4gbt3i0dl2fe0insurance9style7ghting6ke2lly3mited4o2ncoln4k2ve1ing5k1lc1p2oan0s3cker3us3l1ndon4tte1o3ve3pl0financial11r1s1t0d0a3u0ndbeck6xe1ury5v1y2ma0drid4if1son4keup4n0agement7go3p1rket0ing3s4riott5shalls7ttel5ba2c0kinsey7d1e0d0ia3et2lbourne7me1orial6n0u2rckmsd7g1h1iami3crosoft7l1ni1t2t0subishi9k1l0b1s2m0a2n1o0bi0le4da2e1i1m1nash3ey2ster5rmon3tgage6scow4to0rcycles9v0ie4p1q1r1s0d2t0n1r2u0seum3ic4v1w1x1y1z2na0b1goya4me2vy3ba2c1e0c1t0bank4flix4work5ustar5w0s2xt0direct7us4f0l2g0o2hk2i0co2ke1on3nja3ssan1y5l1o0kia3rton4w0ruz3tv4p1r0a1w2tt2u1yc2z2obi1server7ffice5kinawa6layan0group9lo3m0ega4ne1g1l0ine5oo2pen3racle3nge4g0anic5igins6saka4tsuka4t2vh3pa0ge2nasonic7ris2s1tners4s1y3y2ccw3e0t2f0izer5g1h0armacy6d1ilips5one2to0graphy6s4ysio5ics1tet2ures6d1n0g1k2oneer5zza4k1l0ace2y0station9umbing5s3m1n0c2ohl2ker3litie5rn2st3r0axi3ess3ime3o0d0uctions8f1gressive8mo2perties3y5tection8u0dential9s1t1ub2w0c2y2qa1pon3uebec3st5racing4dio4e0ad1lestate6tor2y4cipes5d0stone5umbrella9hab3ise0n3t2liance6n0t0als5pair3ort3ublican8st0aurant8view0s5xroth6ich0ardli6oh3l1o1p2o0cks3deo3gers4om3s0vp3u0gby3hr2n2w0e2yukyu6sa0arland6fe0ty4kura4le1on3msclub4ung5ndvik0coromant12ofi4p1rl2s1ve2xo3b0i1s2c0b1haeffler7midt4olarships8ol3ule3warz5ience5ot3d1e0arch3t2cure1ity6ek2lect4ner3rvices6ven3w1x0y3fr2g1h0angrila6rp3ell3ia1ksha5oes2p0ping5uji3w3i0lk2na1gles5te3j1k0i0n2y0pe4l0ing4m0art3ile4n0cf3o0ccer3ial4ftbank4ware6hu2lar2utions7ng1y2y2pa0ce3ort2t3r0l2s1t0ada2ples4r1tebank4farm7c0group6ockholm6rage3e3ream4udio2y3yle4u0cks3pplies3y2ort5rf1gery5zuki5v1watch4iss4x1y0dney4stems6z2tab1ipei4lk2obao4rget4tamotors6r2too4x0i3c0i2d0k2eam2ch0nology8l1masek5nnis4va3f1g1h0d1eater2re6iaa2ckets5enda4ps2res2ol4j0maxx4x2k0maxx5l1m0all4n1o0day3kyo3ols3p1ray3shiba5tal3urs3wn2yota3s3r0ade1ing4ining5vel0ers0insurance16ust3v2t1ube2i1nes3shu4v0s2w1z2ua1bank3s2g1k1nicom3versity8o2ol2ps2s1y1z2va0cations7na1guard7c1e0gas3ntures6risign5m\xF6gensberater2ung14sicherung10t2g1i0ajes4deo3g1king4llas4n1p1rgin4sa1ion4va1o3laanderen9n1odka3lvo3te1ing3o2yage5u2wales2mart4ter4ng0gou5tch0es6eather0channel12bcam3er2site5d0ding5ibo2r3f1hoswho6ien2ki2lliamhill9n0dows4e1ners6me2olterskluwer11odside6rk0s2ld3w2s1tc1f3xbox3erox4ihuan4n2xx2yz3yachts4hoo3maxun5ndex5e1odobashi7ga2kohama6u0tube6t1un3za0ppos4ra3ero3ip2m1one3uerich6w2",vb="\u03B5\u03BB1\u03C52\u0431\u04331\u0435\u043B3\u0434\u0435\u0442\u04384\u0435\u044E2\u043A\u0430\u0442\u043E\u043B\u0438\u043A6\u043E\u043C3\u043C\u043A\u04342\u043E\u043D1\u0441\u043A\u0432\u04306\u043E\u043D\u043B\u0430\u0439\u043D5\u0440\u04333\u0440\u0443\u04412\u04442\u0441\u0430\u0439\u04423\u0440\u04313\u0443\u043A\u04403\u049B\u0430\u04373\u0570\u0561\u05753\u05D9\u05E9\u05E8\u05D0\u05DC5\u05E7\u05D5\u05DD3\u0627\u0628\u0648\u0638\u0628\u064A5\u0631\u0627\u0645\u0643\u06485\u0644\u0627\u0631\u062F\u06464\u0628\u062D\u0631\u064A\u06465\u062C\u0632\u0627\u0626\u06315\u0633\u0639\u0648\u062F\u064A\u06296\u0639\u0644\u064A\u0627\u06465\u0645\u063A\u0631\u06285\u0645\u0627\u0631\u0627\u062A5\u06CC\u0631\u0627\u06465\u0628\u0627\u0631\u062A2\u0632\u0627\u06314\u064A\u062A\u06433\u06BE\u0627\u0631\u062A5\u062A\u0648\u0646\u06334\u0633\u0648\u062F\u0627\u06463\u0631\u064A\u06295\u0634\u0628\u0643\u06294\u0639\u0631\u0627\u06422\u06282\u0645\u0627\u06464\u0641\u0644\u0633\u0637\u064A\u06466\u0642\u0637\u06313\u0643\u0627\u062B\u0648\u0644\u064A\u06436\u0648\u06453\u0645\u0635\u06312\u0644\u064A\u0633\u064A\u06275\u0648\u0631\u064A\u062A\u0627\u0646\u064A\u06277\u0642\u06394\u0647\u0645\u0631\u0627\u06475\u067E\u0627\u06A9\u0633\u062A\u0627\u06467\u0680\u0627\u0631\u062A4\u0915\u0949\u092E3\u0928\u0947\u091F3\u092D\u093E\u0930\u09240\u092E\u094D3\u094B\u09245\u0938\u0902\u0917\u0920\u09285\u09AC\u09BE\u0982\u09B2\u09BE5\u09AD\u09BE\u09B0\u09A42\u09F0\u09A44\u0A2D\u0A3E\u0A30\u0A244\u0AAD\u0ABE\u0AB0\u0AA44\u0B2D\u0B3E\u0B30\u0B244\u0B87\u0BA8\u0BCD\u0BA4\u0BBF\u0BAF\u0BBE6\u0BB2\u0B99\u0BCD\u0B95\u0BC86\u0B9A\u0BBF\u0B99\u0BCD\u0B95\u0BAA\u0BCD\u0BAA\u0BC2\u0BB0\u0BCD11\u0C2D\u0C3E\u0C30\u0C24\u0C4D5\u0CAD\u0CBE\u0CB0\u0CA44\u0D2D\u0D3E\u0D30\u0D24\u0D025\u0DBD\u0D82\u0D9A\u0DCF4\u0E04\u0E2D\u0E213\u0E44\u0E17\u0E223\u0EA5\u0EB2\u0EA73\u10D2\u10D42\u307F\u3093\u306A3\u30A2\u30DE\u30BE\u30F34\u30AF\u30E9\u30A6\u30C94\u30B0\u30FC\u30B0\u30EB4\u30B3\u30E02\u30B9\u30C8\u30A23\u30BB\u30FC\u30EB3\u30D5\u30A1\u30C3\u30B7\u30E7\u30F36\u30DD\u30A4\u30F3\u30C84\u4E16\u754C2\u4E2D\u4FE11\u56FD1\u570B1\u6587\u7F513\u4E9A\u9A6C\u900A3\u4F01\u4E1A2\u4F5B\u5C712\u4FE1\u606F2\u5065\u5EB72\u516B\u53662\u516C\u53F81\u76CA2\u53F0\u6E7E1\u70632\u5546\u57CE1\u5E971\u68072\u5609\u91CC0\u5927\u9152\u5E975\u5728\u7EBF2\u5927\u62FF2\u5929\u4E3B\u65593\u5A31\u4E502\u5BB6\u96FB2\u5E7F\u4E1C2\u5FAE\u535A2\u6148\u55842\u6211\u7231\u4F603\u624B\u673A2\u62DB\u80582\u653F\u52A11\u5E9C2\u65B0\u52A0\u57612\u95FB2\u65F6\u5C1A2\u66F8\u7C4D2\u673A\u67842\u6DE1\u9A6C\u95213\u6E38\u620F2\u6FB3\u95802\u70B9\u770B2\u79FB\u52A82\u7EC4\u7EC7\u673A\u67844\u7F51\u57401\u5E971\u7AD91\u7EDC2\u8054\u901A2\u8C37\u6B4C2\u8D2D\u72692\u901A\u8CA92\u96C6\u56E22\u96FB\u8A0A\u76C8\u79D14\u98DE\u5229\u6D663\u98DF\u54C12\u9910\u53852\u9999\u683C\u91CC\u62C93\u6E2F2\uB2F7\uB1371\uCEF42\uC0BC\uC1312\uD55C\uAD6D2",rl="numeric",il="ascii",ol="alpha",Bn="asciinumeric",Fn="alphanumeric",sl="domain",of="emoji",kb="scheme",xb="slashscheme",Qs="whitespace";function Cb(t,e){return t in e||(e[t]=[]),e[t]}function Lt(t,e,n){e[rl]&&(e[Bn]=!0,e[Fn]=!0),e[il]&&(e[Bn]=!0,e[ol]=!0),e[Bn]&&(e[Fn]=!0),e[ol]&&(e[Fn]=!0),e[Fn]&&(e[sl]=!0),e[of]&&(e[sl]=!0);for(let r in e){let i=Cb(r,n);i.indexOf(t)<0&&i.push(t)}}function Eb(t,e){let n={};for(let r in e)e[r].indexOf(t)>=0&&(n[r]=!0);return n}function pe(t=null){this.j={},this.jr=[],this.jd=null,this.t=t}pe.groups={};pe.prototype={accepts(){return!!this.t},go(t){let e=this,n=e.j[t];if(n)return n;for(let r=0;r<e.jr.length;r++){let i=e.jr[r][0],o=e.jr[r][1];if(o&&i.test(t))return o}return e.jd},has(t,e=!1){return e?t in this.j:!!this.go(t)},ta(t,e,n,r){for(let i=0;i<t.length;i++)this.tt(t[i],e,n,r)},tr(t,e,n,r){r=r||pe.groups;let i;return e&&e.j?i=e:(i=new pe(e),n&&r&&Lt(e,n,r)),this.jr.push([t,i]),i},ts(t,e,n,r){let i=this,o=t.length;if(!o)return i;for(let s=0;s<o-1;s++)i=i.tt(t[s]);return i.tt(t[o-1],e,n,r)},tt(t,e,n,r){r=r||pe.groups;let i=this;if(e&&e.j)return i.j[t]=e,e;let o=e,s,l=i.go(t);if(l?(s=new pe,Object.assign(s.j,l.j),s.jr.push.apply(s.jr,l.jr),s.jd=l.jd,s.t=l.t):s=new pe,o){if(r)if(s.t&&typeof s.t=="string"){let a=Object.assign(Eb(s.t,r),n);Lt(o,a,r)}else n&&Lt(o,n,r);s.t=o}return i.j[t]=s,s}};var A=(t,e,n,r,i)=>t.ta(e,n,r,i),V=(t,e,n,r,i)=>t.tr(e,n,r,i),Xu=(t,e,n,r,i)=>t.ts(e,n,r,i),b=(t,e,n,r,i)=>t.tt(e,n,r,i),Ke="WORD",ll="UWORD",sf="ASCIINUMERICAL",lf="ALPHANUMERICAL",Wn="LOCALHOST",al="TLD",cl="UTLD",li="SCHEME",rn="SLASH_SCHEME",ul="NUM",dl="WS",fl="NL",zn="OPENBRACE",Vn="CLOSEBRACE",ai="OPENBRACKET",ci="CLOSEBRACKET",di="OPENPAREN",ui="CLOSEPAREN",fi="OPENANGLEBRACKET",hi="CLOSEANGLEBRACKET",pi="FULLWIDTHLEFTPAREN",mi="FULLWIDTHRIGHTPAREN",gi="LEFTCORNERBRACKET",yi="RIGHTCORNERBRACKET",bi="LEFTWHITECORNERBRACKET",vi="RIGHTWHITECORNERBRACKET",ki="FULLWIDTHLESSTHAN",xi="FULLWIDTHGREATERTHAN",Ci="AMPERSAND",Ei="APOSTROPHE",Si="ASTERISK",st="AT",wi="BACKSLASH",Mi="BACKTICK",Ti="CARET",lt="COLON",hl="COMMA",Ai="DOLLAR",_e="DOT",Oi="EQUALS",pl="EXCLAMATION",Se="HYPHEN",$n="PERCENT",Ri="PIPE",Ni="PLUS",Li="POUND",jn="QUERY",ml="QUOTE",af="FULLWIDTHMIDDLEDOT",gl="SEMI",Ie="SLASH",Un="TILDE",_i="UNDERSCORE",cf="EMOJI",Ii="SYM",df=Object.freeze({__proto__:null,ALPHANUMERICAL:lf,AMPERSAND:Ci,APOSTROPHE:Ei,ASCIINUMERICAL:sf,ASTERISK:Si,AT:st,BACKSLASH:wi,BACKTICK:Mi,CARET:Ti,CLOSEANGLEBRACKET:hi,CLOSEBRACE:Vn,CLOSEBRACKET:ci,CLOSEPAREN:ui,COLON:lt,COMMA:hl,DOLLAR:Ai,DOT:_e,EMOJI:cf,EQUALS:Oi,EXCLAMATION:pl,FULLWIDTHGREATERTHAN:xi,FULLWIDTHLEFTPAREN:pi,FULLWIDTHLESSTHAN:ki,FULLWIDTHMIDDLEDOT:af,FULLWIDTHRIGHTPAREN:mi,HYPHEN:Se,LEFTCORNERBRACKET:gi,LEFTWHITECORNERBRACKET:bi,LOCALHOST:Wn,NL:fl,NUM:ul,OPENANGLEBRACKET:fi,OPENBRACE:zn,OPENBRACKET:ai,OPENPAREN:di,PERCENT:$n,PIPE:Ri,PLUS:Ni,POUND:Li,QUERY:jn,QUOTE:ml,RIGHTCORNERBRACKET:yi,RIGHTWHITECORNERBRACKET:vi,SCHEME:li,SEMI:gl,SLASH:Ie,SLASH_SCHEME:rn,SYM:Ii,TILDE:Un,TLD:al,UNDERSCORE:_i,UTLD:cl,UWORD:ll,WORD:Ke,WS:dl}),We=/[a-z]/,Hn=/\p{L}/u,Xs=/\p{Emoji}/u;var qe=/\d/,Zs=/\s/;var Zu="\r",el=`
-`,Sb="\uFE0F",wb="\u200D",tl="\uFFFC",ii=null,oi=null;function Mb(t=[]){let e={};pe.groups=e;let n=new pe;ii==null&&(ii=ef(bb)),oi==null&&(oi=ef(vb)),b(n,"'",Ei),b(n,"{",zn),b(n,"}",Vn),b(n,"[",ai),b(n,"]",ci),b(n,"(",di),b(n,")",ui),b(n,"<",fi),b(n,">",hi),b(n,"\uFF08",pi),b(n,"\uFF09",mi),b(n,"\u300C",gi),b(n,"\u300D",yi),b(n,"\u300E",bi),b(n,"\u300F",vi),b(n,"\uFF1C",ki),b(n,"\uFF1E",xi),b(n,"&",Ci),b(n,"*",Si),b(n,"@",st),b(n,"`",Mi),b(n,"^",Ti),b(n,":",lt),b(n,",",hl),b(n,"$",Ai),b(n,".",_e),b(n,"=",Oi),b(n,"!",pl),b(n,"-",Se),b(n,"%",$n),b(n,"|",Ri),b(n,"+",Ni),b(n,"#",Li),b(n,"?",jn),b(n,'"',ml),b(n,"/",Ie),b(n,";",gl),b(n,"~",Un),b(n,"_",_i),b(n,"\\",wi),b(n,"\u30FB",af);let r=V(n,qe,ul,{[rl]:!0});V(r,qe,r);let i=V(r,We,sf,{[Bn]:!0}),o=V(r,Hn,lf,{[Fn]:!0}),s=V(n,We,Ke,{[il]:!0});V(s,qe,i),V(s,We,s),V(i,qe,i),V(i,We,i);let l=V(n,Hn,ll,{[ol]:!0});V(l,We),V(l,qe,o),V(l,Hn,l),V(o,qe,o),V(o,We),V(o,Hn,o);let a=b(n,el,fl,{[Qs]:!0}),c=b(n,Zu,dl,{[Qs]:!0}),d=V(n,Zs,dl,{[Qs]:!0});b(n,tl,d),b(c,el,a),b(c,tl,d),V(c,Zs,d),b(d,Zu),b(d,el),V(d,Zs,d),b(d,tl,d);let u=V(n,Xs,cf,{[of]:!0});b(u,"#"),V(u,Xs,u),b(u,Sb,u);let f=b(u,wb);b(f,"#"),V(f,Xs,u);let h=[[We,s],[qe,i]],p=[[We,null],[Hn,l],[qe,o]];for(let m=0;m<ii.length;m++)ot(n,ii[m],al,Ke,h);for(let m=0;m<oi.length;m++)ot(n,oi[m],cl,ll,p);Lt(al,{tld:!0,ascii:!0},e),Lt(cl,{utld:!0,alpha:!0},e),ot(n,"file",li,Ke,h),ot(n,"mailto",li,Ke,h),ot(n,"http",rn,Ke,h),ot(n,"https",rn,Ke,h),ot(n,"ftp",rn,Ke,h),ot(n,"ftps",rn,Ke,h),Lt(li,{scheme:!0,ascii:!0},e),Lt(rn,{slashscheme:!0,ascii:!0},e),t=t.sort((m,g)=>m[0]>g[0]?1:-1);for(let m=0;m<t.length;m++){let g=t[m][0],k=t[m][1]?{[kb]:!0}:{[xb]:!0};g.indexOf("-")>=0?k[sl]=!0:We.test(g)?qe.test(g)?k[Bn]=!0:k[il]=!0:k[rl]=!0,Xu(n,g,g,k)}return Xu(n,"localhost",Wn,{ascii:!0}),n.jd=new pe(Ii),{start:n,tokens:Object.assign({groups:e},df)}}function uf(t,e){let n=Tb(e.replace(/[A-Z]/g,l=>l.toLowerCase())),r=n.length,i=[],o=0,s=0;for(;s<r;){let l=t,a=null,c=0,d=null,u=-1,f=-1;for(;s<r&&(a=l.go(n[s]));)l=a,l.accepts()?(u=0,f=0,d=l):u>=0&&(u+=n[s].length,f++),c+=n[s].length,o+=n[s].length,s++;o-=u,s-=f,c-=u,i.push({t:d.t,v:e.slice(o-c,o),s:o-c,e:o})}return i}function Tb(t){let e=[],n=t.length,r=0;for(;r<n;){let i=t.charCodeAt(r),o,s=i<55296||i>56319||r+1===n||(o=t.charCodeAt(r+1))<56320||o>57343?t[r]:t.slice(r,r+2);e.push(s),r+=s.length}return e}function ot(t,e,n,r,i){let o,s=e.length;for(let l=0;l<s-1;l++){let a=e[l];t.j[a]?o=t.j[a]:(o=new pe(r),o.jr=i.slice(),t.j[a]=o),t=o}return o=new pe(n),o.jr=i.slice(),t.j[e[s-1]]=o,o}function ef(t){let e=[],n=[],r=0,i="0123456789";for(;r<t.length;){let o=0;for(;i.indexOf(t[r+o])>=0;)o++;if(o>0){e.push(n.join(""));for(let s=parseInt(t.substring(r,r+o),10);s>0;s--)n.pop();r+=o}else n.push(t[r]),r++}return e}var qn={defaultProtocol:"http",events:null,format:tf,formatHref:tf,nl2br:!1,tagName:"a",target:null,rel:null,validate:!0,truncate:1/0,className:null,attributes:null,ignoreTags:[],render:null};function yl(t,e=null){let n=Object.assign({},qn);t&&(n=Object.assign(n,t instanceof yl?t.o:t));let r=n.ignoreTags,i=[];for(let o=0;o<r.length;o++)i.push(r[o].toUpperCase());this.o=n,e&&(this.defaultRender=e),this.ignoreTags=i}yl.prototype={o:qn,ignoreTags:[],defaultRender(t){return t},check(t){return this.get("validate",t.toString(),t)},get(t,e,n){let r=e!=null,i=this.o[t];return i&&(typeof i=="object"?(i=n.t in i?i[n.t]:qn[t],typeof i=="function"&&r&&(i=i(e,n))):typeof i=="function"&&r&&(i=i(e,n.t,n)),i)},getObj(t,e,n){let r=this.o[t];return typeof r=="function"&&e!=null&&(r=r(e,n.t,n)),r},render(t){let e=t.render(this);return(this.get("render",null,t)||this.defaultRender)(e,t.t,t)}};function tf(t){return t}function ff(t,e){this.t="token",this.v=t,this.tk=e}ff.prototype={isLink:!1,toString(){return this.v},toHref(t){return this.toString()},toFormattedString(t){let e=this.toString(),n=t.get("truncate",e,this),r=t.get("format",e,this);return n&&r.length>n?r.substring(0,n)+"\u2026":r},toFormattedHref(t){return t.get("formatHref",this.toHref(t.get("defaultProtocol")),this)},startIndex(){return this.tk[0].s},endIndex(){return this.tk[this.tk.length-1].e},toObject(t=qn.defaultProtocol){return{type:this.t,value:this.toString(),isLink:this.isLink,href:this.toHref(t),start:this.startIndex(),end:this.endIndex()}},toFormattedObject(t){return{type:this.t,value:this.toFormattedString(t),isLink:this.isLink,href:this.toFormattedHref(t),start:this.startIndex(),end:this.endIndex()}},validate(t){return t.get("validate",this.toString(),this)},render(t){let e=this,n=this.toHref(t.get("defaultProtocol")),r=t.get("formatHref",n,this),i=t.get("tagName",n,e),o=this.toFormattedString(t),s={},l=t.get("className",n,e),a=t.get("target",n,e),c=t.get("rel",n,e),d=t.getObj("attributes",n,e),u=t.getObj("events",n,e);return s.href=r,l&&(s.class=l),a&&(s.target=a),c&&(s.rel=c),d&&Object.assign(s,d),{tagName:i,attributes:s,content:o,eventListeners:u}}};function Di(t,e){class n extends ff{constructor(i,o){super(i,o),this.t=t}}for(let r in e)n.prototype[r]=e[r];return n.t=t,n}var nf=Di("email",{isLink:!0,toHref(){return"mailto:"+this.toString()}}),rf=Di("text"),Ab=Di("nl"),si=Di("url",{isLink:!0,toHref(t=qn.defaultProtocol){return this.hasProtocol()?this.v:`${t}://${this.v}`},hasProtocol(){let t=this.tk;return t.length>=2&&t[0].t!==Wn&&t[1].t===lt}});var Ee=t=>new pe(t);function Ob({groups:t}){let e=t.domain.concat([Ci,Si,st,wi,Mi,Ti,Ai,Oi,Se,ul,$n,Ri,Ni,Li,Ie,Ii,Un,_i]),n=[Ei,lt,hl,_e,pl,$n,jn,ml,gl,fi,hi,zn,Vn,ci,ai,di,ui,pi,mi,gi,yi,bi,vi,ki,xi],r=[Ci,Ei,Si,wi,Mi,Ti,Ai,Oi,Se,zn,Vn,$n,Ri,Ni,Li,jn,Ie,Ii,Un,_i],i=Ee(),o=b(i,Un);A(o,r,o),A(o,t.domain,o);let s=Ee(),l=Ee(),a=Ee();A(i,t.domain,s),A(i,t.scheme,l),A(i,t.slashscheme,a),A(s,r,o),A(s,t.domain,s);let c=b(s,st);b(o,st,c),b(l,st,c),b(a,st,c);let d=b(o,_e);A(d,r,o),A(d,t.domain,o);let u=Ee();A(c,t.domain,u),A(u,t.domain,u);let f=b(u,_e);A(f,t.domain,u);let h=Ee(nf);A(f,t.tld,h),A(f,t.utld,h),b(c,Wn,h);let p=b(u,Se);b(p,Se,p),A(p,t.domain,u),A(h,t.domain,u),b(h,_e,f),b(h,Se,p);let m=b(h,lt);A(m,t.numeric,nf);let g=b(s,Se),y=b(s,_e);b(g,Se,g),A(g,t.domain,s),A(y,r,o),A(y,t.domain,s);let k=Ee(si);A(y,t.tld,k),A(y,t.utld,k),A(k,t.domain,s),A(k,r,o),b(k,_e,y),b(k,Se,g),b(k,st,c);let E=b(k,lt),O=Ee(si);A(E,t.numeric,O);let T=Ee(si),I=Ee();A(T,e,T),A(T,n,I),A(I,e,T),A(I,n,I),b(k,Ie,T),b(O,Ie,T);let w=b(l,lt),R=b(a,lt),H=b(R,Ie),De=b(H,Ie);A(l,t.domain,s),b(l,_e,y),b(l,Se,g),A(a,t.domain,s),b(a,_e,y),b(a,Se,g),A(w,t.domain,T),b(w,Ie,T),b(w,jn,T),A(De,t.domain,T),A(De,e,T),b(De,Ie,T);let me=[[zn,Vn],[ai,ci],[di,ui],[fi,hi],[pi,mi],[gi,yi],[bi,vi],[ki,xi]];for(let Te=0;Te<me.length;Te++){let[Cl,Fi]=me[Te],Kn=b(T,Cl);b(I,Cl,Kn),b(Kn,Fi,T);let It=Ee(si);A(Kn,e,It);let on=Ee();A(Kn,n),A(It,e,It),A(It,n,on),A(on,e,It),A(on,n,on),b(It,Fi,T),b(on,Fi,T)}return b(i,Wn,k),b(i,fl,Ab),{start:i,tokens:df}}function Rb(t,e,n){let r=n.length,i=0,o=[],s=[];for(;i<r;){let l=t,a=null,c=null,d=0,u=null,f=-1;for(;i<r&&!(a=l.go(n[i].t));)s.push(n[i++]);for(;i<r&&(c=a||l.go(n[i].t));)a=null,l=c,l.accepts()?(f=0,u=l):f>=0&&f++,i++,d++;if(f<0)i-=d,i<r&&(s.push(n[i]),i++);else{s.length>0&&(o.push(nl(rf,e,s)),s=[]),i-=f,d-=f;let h=u.t,p=n.slice(i-d,i);o.push(nl(h,e,p))}}return s.length>0&&o.push(nl(rf,e,s)),o}function nl(t,e,n){let r=n[0].s,i=n[n.length-1].e,o=e.slice(r,i);return new t(o,n)}var Nb=typeof console!="undefined"&&console&&console.warn||(()=>{}),Lb="until manual call of linkify.init(). Register all schemes and plugins before invoking linkify the first time.",P={scanner:null,parser:null,tokenQueue:[],pluginQueue:[],customSchemes:[],initialized:!1};function hf(){return pe.groups={},P.scanner=null,P.parser=null,P.tokenQueue=[],P.pluginQueue=[],P.customSchemes=[],P.initialized=!1,P}function bl(t,e=!1){if(P.initialized&&Nb(`linkifyjs: already initialized - will not register custom scheme "${t}" ${Lb}`),!/^[0-9a-z]+(-[0-9a-z]+)*$/.test(t))throw new Error(`linkifyjs: incorrect scheme format.
+`);return!o||!s?!1:t.chain().command(({tr:a})=>(a.delete(r.pos-2,r.pos),!0)).exitCode().run()},ArrowDown:({editor:t})=>{if(!this.options.exitOnArrowDown)return!1;let{state:e}=t,{selection:n,doc:r}=e,{$from:i,empty:o}=n;if(!o||i.parent.type!==this.type||!(i.parentOffset===i.parent.nodeSize-2))return!1;let a=i.after();return a===void 0?!1:r.nodeAt(a)?t.commands.command(({tr:c})=>(c.setSelection(D.near(r.resolve(a))),!0)):t.commands.exitCode()}}},addInputRules(){return[wi({find:F0,type:this.type,getAttributes:t=>({language:t[1]})}),wi({find:_0,type:this.type,getAttributes:t=>({language:t[1]})})]},addProseMirrorPlugins(){return[new V({key:new G("codeBlockVSCodeHandler"),props:{handlePaste:(t,e)=>{if(!e.clipboardData||this.editor.isActive(this.type.name))return!1;let n=e.clipboardData.getData("text/plain"),r=e.clipboardData.getData("vscode-editor-data"),i=r?JSON.parse(r):void 0,o=i==null?void 0:i.mode;if(!n||!o)return!1;let{tr:s,schema:a}=t.state,l=a.text(n.replace(/\r\n?/g,`
+`));return s.replaceSelectionWith(this.type.create({language:o},l)),s.selection.$from.parent.type!==this.type&&s.setSelection(L.near(s.doc.resolve(Math.max(0,s.selection.from-2)))),s.setMeta("paste",!0),t.dispatch(s),!0}}})]}});var ld=ne.create({name:"doc",topNode:!0,content:"block+"});function Wy(t={}){return new V({view(e){return new cd(e,t)}})}var cd=class{constructor(e,n){var r;this.editorView=e,this.cursorPos=null,this.element=null,this.timeout=-1,this.width=(r=n.width)!==null&&r!==void 0?r:1,this.color=n.color===!1?void 0:n.color||"black",this.class=n.class,this.handlers=["dragover","dragend","drop","dragleave"].map(i=>{let o=s=>{this[i](s)};return e.dom.addEventListener(i,o),{name:i,handler:o}})}destroy(){this.handlers.forEach(({name:e,handler:n})=>this.editorView.dom.removeEventListener(e,n))}update(e,n){this.cursorPos!=null&&n.doc!=e.state.doc&&(this.cursorPos>e.state.doc.content.size?this.setCursor(null):this.updateOverlay())}setCursor(e){e!=this.cursorPos&&(this.cursorPos=e,e==null?(this.element.parentNode.removeChild(this.element),this.element=null):this.updateOverlay())}updateOverlay(){let e=this.editorView.state.doc.resolve(this.cursorPos),n=!e.parent.inlineContent,r,i=this.editorView.dom,o=i.getBoundingClientRect(),s=o.width/i.offsetWidth,a=o.height/i.offsetHeight;if(n){let u=e.nodeBefore,f=e.nodeAfter;if(u||f){let p=this.editorView.nodeDOM(this.cursorPos-(u?u.nodeSize:0));if(p){let h=p.getBoundingClientRect(),m=u?h.bottom:h.top;u&&f&&(m=(m+this.editorView.nodeDOM(this.cursorPos).getBoundingClientRect().top)/2);let g=this.width/2*a;r={left:h.left,right:h.right,top:m-g,bottom:m+g}}}}if(!r){let u=this.editorView.coordsAtPos(this.cursorPos),f=this.width/2*s;r={left:u.left-f,right:u.left+f,top:u.top,bottom:u.bottom}}let l=this.editorView.dom.offsetParent;this.element||(this.element=l.appendChild(document.createElement("div")),this.class&&(this.element.className=this.class),this.element.style.cssText="position: absolute; z-index: 50; pointer-events: none;",this.color&&(this.element.style.backgroundColor=this.color)),this.element.classList.toggle("prosemirror-dropcursor-block",n),this.element.classList.toggle("prosemirror-dropcursor-inline",!n);let c,d;if(!l||l==document.body&&getComputedStyle(l).position=="static")c=-pageXOffset,d=-pageYOffset;else{let u=l.getBoundingClientRect(),f=u.width/l.offsetWidth,p=u.height/l.offsetHeight;c=u.left-l.scrollLeft*f,d=u.top-l.scrollTop*p}this.element.style.left=(r.left-c)/s+"px",this.element.style.top=(r.top-d)/a+"px",this.element.style.width=(r.right-r.left)/s+"px",this.element.style.height=(r.bottom-r.top)/a+"px"}scheduleRemoval(e){clearTimeout(this.timeout),this.timeout=setTimeout(()=>this.setCursor(null),e)}dragover(e){if(!this.editorView.editable)return;let n=this.editorView.posAtCoords({left:e.clientX,top:e.clientY}),r=n&&n.inside>=0&&this.editorView.state.doc.nodeAt(n.inside),i=r&&r.type.spec.disableDropCursor,o=typeof i=="function"?i(this.editorView,n,e):i;if(n&&!o){let s=n.pos;if(this.editorView.dragging&&this.editorView.dragging.slice){let a=qo(this.editorView.state.doc,s,this.editorView.dragging.slice);a!=null&&(s=a)}this.setCursor(s),this.scheduleRemoval(5e3)}}dragend(){this.scheduleRemoval(20)}drop(){this.scheduleRemoval(20)}dragleave(e){this.editorView.dom.contains(e.relatedTarget)||this.setCursor(null)}};var dd=Y.create({name:"dropCursor",addOptions(){return{color:"currentColor",width:1,class:void 0}},addProseMirrorPlugins(){return[Wy(this.options)]}});var Se=class t extends D{constructor(e){super(e,e)}map(e,n){let r=e.resolve(n.map(this.head));return t.valid(r)?new t(r):D.near(r)}content(){return w.empty}eq(e){return e instanceof t&&e.head==this.head}toJSON(){return{type:"gapcursor",pos:this.head}}static fromJSON(e,n){if(typeof n.pos!="number")throw new RangeError("Invalid input for GapCursor.fromJSON");return new t(e.resolve(n.pos))}getBookmark(){return new ud(this.anchor)}static valid(e){let n=e.parent;if(n.isTextblock||!B0(e)||!z0(e))return!1;let r=n.type.spec.allowGapCursor;if(r!=null)return r;let i=n.contentMatchAt(e.index()).defaultType;return i&&i.isTextblock}static findGapCursorFrom(e,n,r=!1){e:for(;;){if(!r&&t.valid(e))return e;let i=e.pos,o=null;for(let s=e.depth;;s--){let a=e.node(s);if(n>0?e.indexAfter(s)<a.childCount:e.index(s)>0){o=a.child(n>0?e.indexAfter(s):e.index(s)-1);break}else if(s==0)return null;i+=n;let l=e.doc.resolve(i);if(t.valid(l))return l}for(;;){let s=n>0?o.firstChild:o.lastChild;if(!s){if(o.isAtom&&!o.isText&&!O.isSelectable(o)){e=e.doc.resolve(i+o.nodeSize*n),r=!1;continue e}break}o=s,i+=n;let a=e.doc.resolve(i);if(t.valid(a))return a}return null}}};Se.prototype.visible=!1;Se.findFrom=Se.findGapCursorFrom;D.jsonID("gapcursor",Se);var ud=class t{constructor(e){this.pos=e}map(e){return new t(e.map(this.pos))}resolve(e){let n=e.resolve(this.pos);return Se.valid(n)?new Se(n):D.near(n)}};function qy(t){return t.isAtom||t.spec.isolating||t.spec.createGapCursor}function B0(t){for(let e=t.depth;e>=0;e--){let n=t.index(e),r=t.node(e);if(n==0){if(r.type.spec.isolating)return!0;continue}for(let i=r.child(n-1);;i=i.lastChild){if(i.childCount==0&&!i.inlineContent||qy(i.type))return!0;if(i.inlineContent)return!1}}return!0}function z0(t){for(let e=t.depth;e>=0;e--){let n=t.indexAfter(e),r=t.node(e);if(n==r.childCount){if(r.type.spec.isolating)return!0;continue}for(let i=r.child(n);;i=i.firstChild){if(i.childCount==0&&!i.inlineContent||qy(i.type))return!0;if(i.inlineContent)return!1}}return!0}function Ky(){return new V({props:{decorations:j0,createSelectionBetween(t,e,n){return e.pos==n.pos&&Se.valid(n)?new Se(n):null},handleClick:U0,handleKeyDown:V0,handleDOMEvents:{beforeinput:$0}}})}var V0=Xm({ArrowLeft:ys("horiz",-1),ArrowRight:ys("horiz",1),ArrowUp:ys("vert",-1),ArrowDown:ys("vert",1)});function ys(t,e){let n=t=="vert"?e>0?"down":"up":e>0?"right":"left";return function(r,i,o){let s=r.selection,a=e>0?s.$to:s.$from,l=s.empty;if(s instanceof L){if(!o.endOfTextblock(n)||a.depth==0)return!1;l=!1,a=r.doc.resolve(e>0?a.after():a.before())}let c=Se.findGapCursorFrom(a,e,l);return c?(i&&i(r.tr.setSelection(new Se(c))),!0):!1}}function U0(t,e,n){if(!t||!t.editable)return!1;let r=t.state.doc.resolve(e);if(!Se.valid(r))return!1;let i=t.posAtCoords({left:n.clientX,top:n.clientY});return i&&i.inside>-1&&O.isSelectable(t.state.doc.nodeAt(i.inside))?!1:(t.dispatch(t.state.tr.setSelection(new Se(r))),!0)}function $0(t,e){if(e.inputType!="insertCompositionText"||!(t.state.selection instanceof Se))return!1;let{$from:n}=t.state.selection,r=n.parent.contentMatchAt(n.index()).findWrapping(t.state.schema.nodes.text);if(!r)return!1;let i=C.empty;for(let s=r.length-1;s>=0;s--)i=C.from(r[s].createAndFill(null,i));let o=t.state.tr.replace(n.pos,n.pos,new w(i,0,0));return o.setSelection(L.near(o.doc.resolve(n.pos+1))),t.dispatch(o),!1}function j0(t){if(!(t.selection instanceof Se))return null;let e=document.createElement("div");return e.className="ProseMirror-gapcursor",ve.create(t.doc,[tt.widget(t.selection.head,e,{key:"gapcursor"})])}var fd=Y.create({name:"gapCursor",addProseMirrorPlugins(){return[Ky()]},extendNodeSchema(t){var n;let e={name:t.name,options:t.options,storage:t.storage};return{allowGapCursor:(n=j(te(t,"allowGapCursor",e)))!=null?n:null}}});var pd=ne.create({name:"hardBreak",addOptions(){return{keepMarks:!0,HTMLAttributes:{}}},inline:!0,group:"inline",selectable:!1,linebreakReplacement:!0,parseHTML(){return[{tag:"br"}]},renderHTML({HTMLAttributes:t}){return["br",$(this.options.HTMLAttributes,t)]},renderText(){return`
+`},addCommands(){return{setHardBreak:()=>({commands:t,chain:e,state:n,editor:r})=>t.first([()=>t.exitCode(),()=>t.command(()=>{let{selection:i,storedMarks:o}=n;if(i.$from.parent.type.spec.isolating)return!1;let{keepMarks:s}=this.options,{splittableMarks:a}=r.extensionManager,l=o||i.$to.parentOffset&&i.$from.marks();return e().insertContent({type:this.name}).command(({tr:c,dispatch:d})=>{if(d&&l&&s){let u=l.filter(f=>a.includes(f.type.name));c.ensureMarks(u)}return!0}).run()})])}},addKeyboardShortcuts(){return{"Mod-Enter":()=>this.editor.commands.setHardBreak(),"Shift-Enter":()=>this.editor.commands.setHardBreak()}}});var hd=ne.create({name:"heading",addOptions(){return{levels:[1,2,3,4,5,6],HTMLAttributes:{}}},content:"inline*",group:"block",defining:!0,addAttributes(){return{level:{default:1,rendered:!1}}},parseHTML(){return this.options.levels.map(t=>({tag:`h${t}`,attrs:{level:t}}))},renderHTML({node:t,HTMLAttributes:e}){return[`h${this.options.levels.includes(t.attrs.level)?t.attrs.level:this.options.levels[0]}`,$(this.options.HTMLAttributes,e),0]},addCommands(){return{setHeading:t=>({commands:e})=>this.options.levels.includes(t.level)?e.setNode(this.name,t):!1,toggleHeading:t=>({commands:e})=>this.options.levels.includes(t.level)?e.toggleNode(this.name,"paragraph",t):!1}},addKeyboardShortcuts(){return this.options.levels.reduce((t,e)=>({...t,[`Mod-Alt-${e}`]:()=>this.editor.commands.toggleHeading({level:e})}),{})},addInputRules(){return this.options.levels.map(t=>wi({find:new RegExp(`^(#{${Math.min(...this.options.levels)},${t}})\\s$`),type:this.type,getAttributes:{level:t}}))}});var bs=200,ge=function(){};ge.prototype.append=function(e){return e.length?(e=ge.from(e),!this.length&&e||e.length<bs&&this.leafAppend(e)||this.length<bs&&e.leafPrepend(this)||this.appendInner(e)):this};ge.prototype.prepend=function(e){return e.length?ge.from(e).append(this):this};ge.prototype.appendInner=function(e){return new W0(this,e)};ge.prototype.slice=function(e,n){return e===void 0&&(e=0),n===void 0&&(n=this.length),e>=n?ge.empty:this.sliceInner(Math.max(0,e),Math.min(this.length,n))};ge.prototype.get=function(e){if(!(e<0||e>=this.length))return this.getInner(e)};ge.prototype.forEach=function(e,n,r){n===void 0&&(n=0),r===void 0&&(r=this.length),n<=r?this.forEachInner(e,n,r,0):this.forEachInvertedInner(e,n,r,0)};ge.prototype.map=function(e,n,r){n===void 0&&(n=0),r===void 0&&(r=this.length);var i=[];return this.forEach(function(o,s){return i.push(e(o,s))},n,r),i};ge.from=function(e){return e instanceof ge?e:e&&e.length?new Jy(e):ge.empty};var Jy=function(t){function e(r){t.call(this),this.values=r}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e;var n={length:{configurable:!0},depth:{configurable:!0}};return e.prototype.flatten=function(){return this.values},e.prototype.sliceInner=function(i,o){return i==0&&o==this.length?this:new e(this.values.slice(i,o))},e.prototype.getInner=function(i){return this.values[i]},e.prototype.forEachInner=function(i,o,s,a){for(var l=o;l<s;l++)if(i(this.values[l],a+l)===!1)return!1},e.prototype.forEachInvertedInner=function(i,o,s,a){for(var l=o-1;l>=s;l--)if(i(this.values[l],a+l)===!1)return!1},e.prototype.leafAppend=function(i){if(this.length+i.length<=bs)return new e(this.values.concat(i.flatten()))},e.prototype.leafPrepend=function(i){if(this.length+i.length<=bs)return new e(i.flatten().concat(this.values))},n.length.get=function(){return this.values.length},n.depth.get=function(){return 0},Object.defineProperties(e.prototype,n),e}(ge);ge.empty=new Jy([]);var W0=function(t){function e(n,r){t.call(this),this.left=n,this.right=r,this.length=n.length+r.length,this.depth=Math.max(n.depth,r.depth)+1}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.flatten=function(){return this.left.flatten().concat(this.right.flatten())},e.prototype.getInner=function(r){return r<this.left.length?this.left.get(r):this.right.get(r-this.left.length)},e.prototype.forEachInner=function(r,i,o,s){var a=this.left.length;if(i<a&&this.left.forEachInner(r,i,Math.min(o,a),s)===!1||o>a&&this.right.forEachInner(r,Math.max(i-a,0),Math.min(this.length,o)-a,s+a)===!1)return!1},e.prototype.forEachInvertedInner=function(r,i,o,s){var a=this.left.length;if(i>a&&this.right.forEachInvertedInner(r,i-a,Math.max(o,a)-a,s+a)===!1||o<a&&this.left.forEachInvertedInner(r,Math.min(i,a),o,s)===!1)return!1},e.prototype.sliceInner=function(r,i){if(r==0&&i==this.length)return this;var o=this.left.length;return i<=o?this.left.slice(r,i):r>=o?this.right.slice(r-o,i-o):this.left.slice(r,o).append(this.right.slice(0,i-o))},e.prototype.leafAppend=function(r){var i=this.right.leafAppend(r);if(i)return new e(this.left,i)},e.prototype.leafPrepend=function(r){var i=this.left.leafPrepend(r);if(i)return new e(i,this.right)},e.prototype.appendInner=function(r){return this.left.depth>=Math.max(this.right.depth,r.depth)+1?new e(this.left,new e(this.right,r)):new e(this,r)},e}(ge),md=ge;var q0=500,zn=class t{constructor(e,n){this.items=e,this.eventCount=n}popEvent(e,n){if(this.eventCount==0)return null;let r=this.items.length;for(;;r--)if(this.items.get(r-1).selection){--r;break}let i,o;n&&(i=this.remapping(r,this.items.length),o=i.maps.length);let s=e.tr,a,l,c=[],d=[];return this.items.forEach((u,f)=>{if(!u.step){i||(i=this.remapping(r,f+1),o=i.maps.length),o--,d.push(u);return}if(i){d.push(new Et(u.map));let p=u.step.map(i.slice(o)),h;p&&s.maybeStep(p).doc&&(h=s.mapping.maps[s.mapping.maps.length-1],c.push(new Et(h,void 0,void 0,c.length+d.length))),o--,h&&i.appendMap(h,o)}else s.maybeStep(u.step);if(u.selection)return a=i?u.selection.map(i.slice(o)):u.selection,l=new t(this.items.slice(0,r).append(d.reverse().concat(c)),this.eventCount-1),!1},this.items.length,0),{remaining:l,transform:s,selection:a}}addTransform(e,n,r,i){let o=[],s=this.eventCount,a=this.items,l=!i&&a.length?a.get(a.length-1):null;for(let d=0;d<e.steps.length;d++){let u=e.steps[d].invert(e.docs[d]),f=new Et(e.mapping.maps[d],u,n),p;(p=l&&l.merge(f))&&(f=p,d?o.pop():a=a.slice(0,a.length-1)),o.push(f),n&&(s++,n=void 0),i||(l=f)}let c=s-r.depth;return c>J0&&(a=K0(a,c),s-=c),new t(a.append(o),s)}remapping(e,n){let r=new di;return this.items.forEach((i,o)=>{let s=i.mirrorOffset!=null&&o-i.mirrorOffset>=e?r.maps.length-i.mirrorOffset:void 0;r.appendMap(i.map,s)},e,n),r}addMaps(e){return this.eventCount==0?this:new t(this.items.append(e.map(n=>new Et(n))),this.eventCount)}rebased(e,n){if(!this.eventCount)return this;let r=[],i=Math.max(0,this.items.length-n),o=e.mapping,s=e.steps.length,a=this.eventCount;this.items.forEach(f=>{f.selection&&a--},i);let l=n;this.items.forEach(f=>{let p=o.getMirror(--l);if(p==null)return;s=Math.min(s,p);let h=o.maps[p];if(f.step){let m=e.steps[p].invert(e.docs[p]),g=f.selection&&f.selection.map(o.slice(l+1,p));g&&a++,r.push(new Et(h,m,g))}else r.push(new Et(h))},i);let c=[];for(let f=n;f<s;f++)c.push(new Et(o.maps[f]));let d=this.items.slice(0,i).append(c).append(r),u=new t(d,a);return u.emptyItemCount()>q0&&(u=u.compress(this.items.length-r.length)),u}emptyItemCount(){let e=0;return this.items.forEach(n=>{n.step||e++}),e}compress(e=this.items.length){let n=this.remapping(0,e),r=n.maps.length,i=[],o=0;return this.items.forEach((s,a)=>{if(a>=e)i.push(s),s.selection&&o++;else if(s.step){let l=s.step.map(n.slice(r)),c=l&&l.getMap();if(r--,c&&n.appendMap(c,r),l){let d=s.selection&&s.selection.map(n.slice(r));d&&o++;let u=new Et(c.invert(),l,d),f,p=i.length-1;(f=i.length&&i[p].merge(u))?i[p]=f:i.push(u)}}else s.map&&r--},this.items.length,0),new t(md.from(i.reverse()),o)}};zn.empty=new zn(md.empty,0);function K0(t,e){let n;return t.forEach((r,i)=>{if(r.selection&&e--==0)return n=i,!1}),t.slice(n)}var Et=class t{constructor(e,n,r,i){this.map=e,this.step=n,this.selection=r,this.mirrorOffset=i}merge(e){if(this.step&&e.step&&!e.selection){let n=e.step.merge(this.step);if(n)return new t(n.getMap().invert(),n,this.selection)}}},xt=class{constructor(e,n,r,i,o){this.done=e,this.undone=n,this.prevRanges=r,this.prevTime=i,this.prevComposition=o}},J0=20;function G0(t,e,n,r){let i=n.getMeta(Bn),o;if(i)return i.historyState;n.getMeta(Q0)&&(t=new xt(t.done,t.undone,null,0,-1));let s=n.getMeta("appendedTransaction");if(n.steps.length==0)return t;if(s&&s.getMeta(Bn))return s.getMeta(Bn).redo?new xt(t.done.addTransform(n,void 0,r,Es(e)),t.undone,Gy(n.mapping.maps),t.prevTime,t.prevComposition):new xt(t.done,t.undone.addTransform(n,void 0,r,Es(e)),null,t.prevTime,t.prevComposition);if(n.getMeta("addToHistory")!==!1&&!(s&&s.getMeta("addToHistory")===!1)){let a=n.getMeta("composition"),l=t.prevTime==0||!s&&t.prevComposition!=a&&(t.prevTime<(n.time||0)-r.newGroupDelay||!Y0(n,t.prevRanges)),c=s?gd(t.prevRanges,n.mapping):Gy(n.mapping.maps);return new xt(t.done.addTransform(n,l?e.selection.getBookmark():void 0,r,Es(e)),zn.empty,c,n.time,a==null?t.prevComposition:a)}else return(o=n.getMeta("rebased"))?new xt(t.done.rebased(n,o),t.undone.rebased(n,o),gd(t.prevRanges,n.mapping),t.prevTime,t.prevComposition):new xt(t.done.addMaps(n.mapping.maps),t.undone.addMaps(n.mapping.maps),gd(t.prevRanges,n.mapping),t.prevTime,t.prevComposition)}function Y0(t,e){if(!e)return!1;if(!t.docChanged)return!0;let n=!1;return t.mapping.maps[0].forEach((r,i)=>{for(let o=0;o<e.length;o+=2)r<=e[o+1]&&i>=e[o]&&(n=!0)}),n}function Gy(t){let e=[];for(let n=t.length-1;n>=0&&e.length==0;n--)t[n].forEach((r,i,o,s)=>e.push(o,s));return e}function gd(t,e){if(!t)return null;let n=[];for(let r=0;r<t.length;r+=2){let i=e.map(t[r],1),o=e.map(t[r+1],-1);i<=o&&n.push(i,o)}return n}function X0(t,e,n){let r=Es(e),i=Bn.get(e).spec.config,o=(n?t.undone:t.done).popEvent(e,r);if(!o)return null;let s=o.selection.resolve(o.transform.doc),a=(n?t.done:t.undone).addTransform(o.transform,e.selection.getBookmark(),i,r),l=new xt(n?a:o.remaining,n?o.remaining:a,null,0,-1);return o.transform.setSelection(s).setMeta(Bn,{redo:n,historyState:l})}var yd=!1,Yy=null;function Es(t){let e=t.plugins;if(Yy!=e){yd=!1,Yy=e;for(let n=0;n<e.length;n++)if(e[n].spec.historyPreserveItems){yd=!0;break}}return yd}var Bn=new G("history"),Q0=new G("closeHistory");function Xy(t={}){return t={depth:t.depth||100,newGroupDelay:t.newGroupDelay||500},new V({key:Bn,state:{init(){return new xt(zn.empty,zn.empty,null,0,-1)},apply(e,n,r){return G0(n,r,e,t)}},config:t,props:{handleDOMEvents:{beforeinput(e,n){let r=n.inputType,i=r=="historyUndo"?bd:r=="historyRedo"?Ed:null;return i?(n.preventDefault(),i(e.state,e.dispatch)):!1}}}})}function xs(t,e){return(n,r)=>{let i=Bn.getState(n);if(!i||(t?i.undone:i.done).eventCount==0)return!1;if(r){let o=X0(i,n,t);o&&r(e?o.scrollIntoView():o)}return!0}}var bd=xs(!1,!0),Ed=xs(!0,!0),KB=xs(!1,!1),JB=xs(!0,!1);var xd=Y.create({name:"history",addOptions(){return{depth:100,newGroupDelay:500}},addCommands(){return{undo:()=>({state:t,dispatch:e})=>bd(t,e),redo:()=>({state:t,dispatch:e})=>Ed(t,e)}},addProseMirrorPlugins(){return[Xy(this.options)]},addKeyboardShortcuts(){return{"Mod-z":()=>this.editor.commands.undo(),"Shift-Mod-z":()=>this.editor.commands.redo(),"Mod-y":()=>this.editor.commands.redo(),"Mod-\u044F":()=>this.editor.commands.undo(),"Shift-Mod-\u044F":()=>this.editor.commands.redo()}}});var Cd=ne.create({name:"horizontalRule",addOptions(){return{HTMLAttributes:{}}},group:"block",parseHTML(){return[{tag:"hr"}]},renderHTML({HTMLAttributes:t}){return["hr",$(this.options.HTMLAttributes,t)]},addCommands(){return{setHorizontalRule:()=>({chain:t,state:e})=>{if(!Uy(e,e.schema.nodes[this.name]))return!1;let{selection:n}=e,{$from:r,$to:i}=n,o=t();return r.parentOffset===0?o.insertContentAt({from:Math.max(r.pos-1,0),to:i.pos},{type:this.name}):py(n)?o.insertContentAt(i.pos,{type:this.name}):o.insertContent({type:this.name}),o.command(({tr:s,dispatch:a})=>{var l;if(a){let{$to:c}=s.selection,d=c.end();if(c.nodeAfter)c.nodeAfter.isTextblock?s.setSelection(L.create(s.doc,c.pos+1)):c.nodeAfter.isBlock?s.setSelection(O.create(s.doc,c.pos)):s.setSelection(L.create(s.doc,c.pos));else{let u=(l=c.parent.type.contentMatch.defaultType)==null?void 0:l.create();u&&(s.insert(d,u),s.setSelection(L.create(s.doc,d+1)))}s.scrollIntoView()}return!0}).run()}}},addInputRules(){return[Vy({find:/^(?:---|—-|___\s|\*\*\*\s)$/,type:this.type})]}});var Z0=/(?:^|\s)(\*(?!\s+\*)((?:[^*]+))\*(?!\s+\*))$/,ek=/(?:^|\s)(\*(?!\s+\*)((?:[^*]+))\*(?!\s+\*))/g,tk=/(?:^|\s)(_(?!\s+_)((?:[^_]+))_(?!\s+_))$/,nk=/(?:^|\s)(_(?!\s+_)((?:[^_]+))_(?!\s+_))/g,vd=Re.create({name:"italic",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"em"},{tag:"i",getAttrs:t=>t.style.fontStyle!=="normal"&&null},{style:"font-style=normal",clearMark:t=>t.type.name===this.name},{style:"font-style=italic"}]},renderHTML({HTMLAttributes:t}){return["em",$(this.options.HTMLAttributes,t),0]},addCommands(){return{setItalic:()=>({commands:t})=>t.setMark(this.name),toggleItalic:()=>({commands:t})=>t.toggleMark(this.name),unsetItalic:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-i":()=>this.editor.commands.toggleItalic(),"Mod-I":()=>this.editor.commands.toggleItalic()}},addInputRules(){return[bt({find:Z0,type:this.type}),bt({find:tk,type:this.type})]},addPasteRules(){return[nt({find:ek,type:this.type}),nt({find:nk,type:this.type})]}});var Td=ne.create({name:"listItem",addOptions(){return{HTMLAttributes:{},bulletListTypeName:"bulletList",orderedListTypeName:"orderedList"}},content:"paragraph block*",defining:!0,parseHTML(){return[{tag:"li"}]},renderHTML({HTMLAttributes:t}){return["li",$(this.options.HTMLAttributes,t),0]},addKeyboardShortcuts(){return{Enter:()=>this.editor.commands.splitListItem(this.name),Tab:()=>this.editor.commands.sinkListItem(this.name),"Shift-Tab":()=>this.editor.commands.liftListItem(this.name)}}});var rk="listItem",Qy="textStyle",Zy=/^(\d+)\.\s$/,Sd=ne.create({name:"orderedList",addOptions(){return{itemTypeName:"listItem",HTMLAttributes:{},keepMarks:!1,keepAttributes:!1}},group:"block list",content(){return`${this.options.itemTypeName}+`},addAttributes(){return{start:{default:1,parseHTML:t=>t.hasAttribute("start")?parseInt(t.getAttribute("start")||"",10):1},type:{default:null,parseHTML:t=>t.getAttribute("type")}}},parseHTML(){return[{tag:"ol"}]},renderHTML({HTMLAttributes:t}){let{start:e,...n}=t;return e===1?["ol",$(this.options.HTMLAttributes,n),0]:["ol",$(this.options.HTMLAttributes,t),0]},addCommands(){return{toggleOrderedList:()=>({commands:t,chain:e})=>this.options.keepAttributes?e().toggleList(this.name,this.options.itemTypeName,this.options.keepMarks).updateAttributes(rk,this.editor.getAttributes(Qy)).run():t.toggleList(this.name,this.options.itemTypeName,this.options.keepMarks)}},addKeyboardShortcuts(){return{"Mod-Shift-7":()=>this.editor.commands.toggleOrderedList()}},addInputRules(){let t=nn({find:Zy,type:this.type,getAttributes:e=>({start:+e[1]}),joinPredicate:(e,n)=>n.childCount+n.attrs.start===+e[1]});return(this.options.keepMarks||this.options.keepAttributes)&&(t=nn({find:Zy,type:this.type,keepMarks:this.options.keepMarks,keepAttributes:this.options.keepAttributes,getAttributes:e=>({start:+e[1],...this.editor.getAttributes(Qy)}),joinPredicate:(e,n)=>n.childCount+n.attrs.start===+e[1],editor:this.editor})),[t]}});var kd=ne.create({name:"paragraph",priority:1e3,addOptions(){return{HTMLAttributes:{}}},group:"block",content:"inline*",parseHTML(){return[{tag:"p"}]},renderHTML({HTMLAttributes:t}){return["p",$(this.options.HTMLAttributes,t),0]},addCommands(){return{setParagraph:()=>({commands:t})=>t.setNode(this.name)}},addKeyboardShortcuts(){return{"Mod-Alt-0":()=>this.editor.commands.setParagraph()}}});var ik=/(?:^|\s)(~~(?!\s+~~)((?:[^~]+))~~(?!\s+~~))$/,ok=/(?:^|\s)(~~(?!\s+~~)((?:[^~]+))~~(?!\s+~~))/g,Md=Re.create({name:"strike",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"s"},{tag:"del"},{tag:"strike"},{style:"text-decoration",consuming:!1,getAttrs:t=>t.includes("line-through")?{}:!1}]},renderHTML({HTMLAttributes:t}){return["s",$(this.options.HTMLAttributes,t),0]},addCommands(){return{setStrike:()=>({commands:t})=>t.setMark(this.name),toggleStrike:()=>({commands:t})=>t.toggleMark(this.name),unsetStrike:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-Shift-s":()=>this.editor.commands.toggleStrike()}},addInputRules(){return[bt({find:ik,type:this.type})]},addPasteRules(){return[nt({find:ok,type:this.type})]}});var wd=ne.create({name:"text",group:"inline"});var eb=Y.create({name:"starterKit",addExtensions(){let t=[];return this.options.bold!==!1&&t.push(id.configure(this.options.bold)),this.options.blockquote!==!1&&t.push(rd.configure(this.options.blockquote)),this.options.bulletList!==!1&&t.push(od.configure(this.options.bulletList)),this.options.code!==!1&&t.push(sd.configure(this.options.code)),this.options.codeBlock!==!1&&t.push(ad.configure(this.options.codeBlock)),this.options.document!==!1&&t.push(ld.configure(this.options.document)),this.options.dropcursor!==!1&&t.push(dd.configure(this.options.dropcursor)),this.options.gapcursor!==!1&&t.push(fd.configure(this.options.gapcursor)),this.options.hardBreak!==!1&&t.push(pd.configure(this.options.hardBreak)),this.options.heading!==!1&&t.push(hd.configure(this.options.heading)),this.options.history!==!1&&t.push(xd.configure(this.options.history)),this.options.horizontalRule!==!1&&t.push(Cd.configure(this.options.horizontalRule)),this.options.italic!==!1&&t.push(vd.configure(this.options.italic)),this.options.listItem!==!1&&t.push(Td.configure(this.options.listItem)),this.options.orderedList!==!1&&t.push(Sd.configure(this.options.orderedList)),this.options.paragraph!==!1&&t.push(kd.configure(this.options.paragraph)),this.options.strike!==!1&&t.push(Md.configure(this.options.strike)),this.options.text!==!1&&t.push(wd.configure(this.options.text)),t}});var tb=Y.create({name:"placeholder",addOptions(){return{emptyEditorClass:"is-editor-empty",emptyNodeClass:"is-empty",placeholder:"Write something \u2026",showOnlyWhenEditable:!0,showOnlyCurrent:!0,includeChildren:!1}},addProseMirrorPlugins(){return[new V({key:new G("placeholder"),props:{decorations:({doc:t,selection:e})=>{let n=this.editor.isEditable||!this.options.showOnlyWhenEditable,{anchor:r}=e,i=[];if(!n)return null;let o=this.editor.isEmpty;return t.descendants((s,a)=>{let l=r>=a&&r<=a+s.nodeSize,c=!s.isLeaf&&Ar(s);if((l||!this.options.showOnlyCurrent)&&c){let d=[this.options.emptyNodeClass];o&&d.push(this.options.emptyEditorClass);let u=tt.node(a,a+s.nodeSize,{class:d.join(" "),"data-placeholder":typeof this.options.placeholder=="function"?this.options.placeholder({editor:this.editor,node:s,pos:a,hasAnchor:l}):this.options.placeholder});i.push(u)}return this.options.includeChildren}),ve.create(t,i)}}})]}});var sk="aaa1rp3bb0ott3vie4c1le2ogado5udhabi7c0ademy5centure6ountant0s9o1tor4d0s1ult4e0g1ro2tna4f0l1rica5g0akhan5ency5i0g1rbus3force5tel5kdn3l0ibaba4pay4lfinanz6state5y2sace3tom5m0azon4ericanexpress7family11x2fam3ica3sterdam8nalytics7droid5quan4z2o0l2partments8p0le4q0uarelle8r0ab1mco4chi3my2pa2t0e3s0da2ia2sociates9t0hleta5torney7u0ction5di0ble3o3spost5thor3o0s4w0s2x0a2z0ure5ba0by2idu3namex4d1k2r0celona5laycard4s5efoot5gains6seball5ketball8uhaus5yern5b0c1t1va3cg1n2d1e0ats2uty4er2rlin4st0buy5t2f1g1h0arti5i0ble3d1ke2ng0o3o1z2j1lack0friday9ockbuster8g1omberg7ue3m0s1w2n0pparibas9o0ats3ehringer8fa2m1nd2o0k0ing5sch2tik2on4t1utique6x2r0adesco6idgestone9oadway5ker3ther5ussels7s1t1uild0ers6siness6y1zz3v1w1y1z0h3ca0b1fe2l0l1vinklein9m0era3p2non3petown5ital0one8r0avan4ds2e0er0s4s2sa1e1h1ino4t0ering5holic7ba1n1re3c1d1enter4o1rn3f0a1d2g1h0anel2nel4rity4se2t2eap3intai5ristmas6ome4urch5i0priani6rcle4sco3tadel4i0c2y3k1l0aims4eaning6ick2nic1que6othing5ud3ub0med6m1n1o0ach3des3ffee4llege4ogne5m0mbank4unity6pany2re3uter5sec4ndos3struction8ulting7tact3ractors9oking4l1p2rsica5untry4pon0s4rses6pa2r0edit0card4union9icket5own3s1uise0s6u0isinella9v1w1x1y0mru3ou3z2dad1nce3ta1e1ing3sun4y2clk3ds2e0al0er2s3gree4livery5l1oitte5ta3mocrat6ntal2ist5si0gn4v2hl2iamonds6et2gital5rect0ory7scount3ver5h2y2j1k1m1np2o0cs1tor4g1mains5t1wnload7rive4tv2ubai3nlop4pont4rban5vag2r2z2earth3t2c0o2deka3u0cation8e1g1mail3erck5nergy4gineer0ing9terprises10pson4quipment8r0icsson6ni3s0q1tate5t1u0rovision8s2vents5xchange6pert3osed4ress5traspace10fage2il1rwinds6th3mily4n0s2rm0ers5shion4t3edex3edback6rrari3ero6i0delity5o2lm2nal1nce1ial7re0stone6mdale6sh0ing5t0ness6j1k1lickr3ghts4r2orist4wers5y2m1o0o0d1tball6rd1ex2sale4um3undation8x2r0ee1senius7l1ogans4ntier7tr2ujitsu5n0d2rniture7tbol5yi3ga0l0lery3o1up4me0s3p1rden4y2b0iz3d0n2e0a1nt0ing5orge5f1g0ee3h1i0ft0s3ves2ing5l0ass3e1obal2o4m0ail3bh2o1x2n1odaddy5ld0point6f2o0dyear5g0le4p1t1v2p1q1r0ainger5phics5tis4een3ipe3ocery4up4s1t1u0cci3ge2ide2tars5ru3w1y2hair2mburg5ngout5us3bo2dfc0bank7ealth0care8lp1sinki6re1mes5iphop4samitsu7tachi5v2k0t2m1n1ockey4ldings5iday5medepot5goods5s0ense7nda3rse3spital5t0ing5t0els3mail5use3w2r1sbc3t1u0ghes5yatt3undai7ibm2cbc2e1u2d1e0ee3fm2kano4l1m0amat4db2mo0bilien9n0c1dustries8finiti5o2g1k1stitute6urance4e4t0ernational10uit4vestments10o1piranga7q1r0ish4s0maili5t0anbul7t0au2v3jaguar4va3cb2e0ep2tzt3welry6io2ll2m0p2nj2o0bs1urg4t1y2p0morgan6rs3uegos4niper7kaufen5ddi3e0rryhotels6properties14fh2g1h1i0a1ds2m1ndle4tchen5wi3m1n1oeln3matsu5sher5p0mg2n2r0d1ed3uokgroup8w1y0oto4z2la0caixa5mborghini8er3nd0rover6xess5salle5t0ino3robe5w0yer5b1c1ds2ease3clerc5frak4gal2o2xus4gbt3i0dl2fe0insurance9style7ghting6ke2lly3mited4o2ncoln4k2ve1ing5k1lc1p2oan0s3cker3us3l1ndon4tte1o3ve3pl0financial11r1s1t0d0a3u0ndbeck6xe1ury5v1y2ma0drid4if1son4keup4n0agement7go3p1rket0ing3s4riott5shalls7ttel5ba2c0kinsey7d1e0d0ia3et2lbourne7me1orial6n0u2rckmsd7g1h1iami3crosoft7l1ni1t2t0subishi9k1l0b1s2m0a2n1o0bi0le4da2e1i1m1nash3ey2ster5rmon3tgage6scow4to0rcycles9v0ie4p1q1r1s0d2t0n1r2u0seum3ic4v1w1x1y1z2na0b1goya4me2vy3ba2c1e0c1t0bank4flix4work5ustar5w0s2xt0direct7us4f0l2g0o2hk2i0co2ke1on3nja3ssan1y5l1o0kia3rton4w0ruz3tv4p1r0a1w2tt2u1yc2z2obi1server7ffice5kinawa6layan0group9lo3m0ega4ne1g1l0ine5oo2pen3racle3nge4g0anic5igins6saka4tsuka4t2vh3pa0ge2nasonic7ris2s1tners4s1y3y2ccw3e0t2f0izer5g1h0armacy6d1ilips5one2to0graphy6s4ysio5ics1tet2ures6d1n0g1k2oneer5zza4k1l0ace2y0station9umbing5s3m1n0c2ohl2ker3litie5rn2st3r0axi3ess3ime3o0d0uctions8f1gressive8mo2perties3y5tection8u0dential9s1t1ub2w0c2y2qa1pon3uebec3st5racing4dio4e0ad1lestate6tor2y4cipes5d0stone5umbrella9hab3ise0n3t2liance6n0t0als5pair3ort3ublican8st0aurant8view0s5xroth6ich0ardli6oh3l1o1p2o0cks3deo3gers4om3s0vp3u0gby3hr2n2w0e2yukyu6sa0arland6fe0ty4kura4le1on3msclub4ung5ndvik0coromant12ofi4p1rl2s1ve2xo3b0i1s2c0b1haeffler7midt4olarships8ol3ule3warz5ience5ot3d1e0arch3t2cure1ity6ek2lect4ner3rvices6ven3w1x0y3fr2g1h0angrila6rp3ell3ia1ksha5oes2p0ping5uji3w3i0lk2na1gles5te3j1k0i0n2y0pe4l0ing4m0art3ile4n0cf3o0ccer3ial4ftbank4ware6hu2lar2utions7ng1y2y2pa0ce3ort2t3r0l2s1t0ada2ples4r1tebank4farm7c0group6ockholm6rage3e3ream4udio2y3yle4u0cks3pplies3y2ort5rf1gery5zuki5v1watch4iss4x1y0dney4stems6z2tab1ipei4lk2obao4rget4tamotors6r2too4x0i3c0i2d0k2eam2ch0nology8l1masek5nnis4va3f1g1h0d1eater2re6iaa2ckets5enda4ps2res2ol4j0maxx4x2k0maxx5l1m0all4n1o0day3kyo3ols3p1ray3shiba5tal3urs3wn2yota3s3r0ade1ing4ining5vel0ers0insurance16ust3v2t1ube2i1nes3shu4v0s2w1z2ua1bank3s2g1k1nicom3versity8o2ol2ps2s1y1z2va0cations7na1guard7c1e0gas3ntures6risign5m\xF6gensberater2ung14sicherung10t2g1i0ajes4deo3g1king4llas4n1p1rgin4sa1ion4va1o3laanderen9n1odka3lvo3te1ing3o2yage5u2wales2mart4ter4ng0gou5tch0es6eather0channel12bcam3er2site5d0ding5ibo2r3f1hoswho6ien2ki2lliamhill9n0dows4e1ners6me2olterskluwer11odside6rk0s2ld3w2s1tc1f3xbox3erox4ihuan4n2xx2yz3yachts4hoo3maxun5ndex5e1odobashi7ga2kohama6u0tube6t1un3za0ppos4ra3ero3ip2m1one3uerich6w2",ak="\u03B5\u03BB1\u03C52\u0431\u04331\u0435\u043B3\u0434\u0435\u0442\u04384\u0435\u044E2\u043A\u0430\u0442\u043E\u043B\u0438\u043A6\u043E\u043C3\u043C\u043A\u04342\u043E\u043D1\u0441\u043A\u0432\u04306\u043E\u043D\u043B\u0430\u0439\u043D5\u0440\u04333\u0440\u0443\u04412\u04442\u0441\u0430\u0439\u04423\u0440\u04313\u0443\u043A\u04403\u049B\u0430\u04373\u0570\u0561\u05753\u05D9\u05E9\u05E8\u05D0\u05DC5\u05E7\u05D5\u05DD3\u0627\u0628\u0648\u0638\u0628\u064A5\u0631\u0627\u0645\u0643\u06485\u0644\u0627\u0631\u062F\u06464\u0628\u062D\u0631\u064A\u06465\u062C\u0632\u0627\u0626\u06315\u0633\u0639\u0648\u062F\u064A\u06296\u0639\u0644\u064A\u0627\u06465\u0645\u063A\u0631\u06285\u0645\u0627\u0631\u0627\u062A5\u06CC\u0631\u0627\u06465\u0628\u0627\u0631\u062A2\u0632\u0627\u06314\u064A\u062A\u06433\u06BE\u0627\u0631\u062A5\u062A\u0648\u0646\u06334\u0633\u0648\u062F\u0627\u06463\u0631\u064A\u06295\u0634\u0628\u0643\u06294\u0639\u0631\u0627\u06422\u06282\u0645\u0627\u06464\u0641\u0644\u0633\u0637\u064A\u06466\u0642\u0637\u06313\u0643\u0627\u062B\u0648\u0644\u064A\u06436\u0648\u06453\u0645\u0635\u06312\u0644\u064A\u0633\u064A\u06275\u0648\u0631\u064A\u062A\u0627\u0646\u064A\u06277\u0642\u06394\u0647\u0645\u0631\u0627\u06475\u067E\u0627\u06A9\u0633\u062A\u0627\u06467\u0680\u0627\u0631\u062A4\u0915\u0949\u092E3\u0928\u0947\u091F3\u092D\u093E\u0930\u09240\u092E\u094D3\u094B\u09245\u0938\u0902\u0917\u0920\u09285\u09AC\u09BE\u0982\u09B2\u09BE5\u09AD\u09BE\u09B0\u09A42\u09F0\u09A44\u0A2D\u0A3E\u0A30\u0A244\u0AAD\u0ABE\u0AB0\u0AA44\u0B2D\u0B3E\u0B30\u0B244\u0B87\u0BA8\u0BCD\u0BA4\u0BBF\u0BAF\u0BBE6\u0BB2\u0B99\u0BCD\u0B95\u0BC86\u0B9A\u0BBF\u0B99\u0BCD\u0B95\u0BAA\u0BCD\u0BAA\u0BC2\u0BB0\u0BCD11\u0C2D\u0C3E\u0C30\u0C24\u0C4D5\u0CAD\u0CBE\u0CB0\u0CA44\u0D2D\u0D3E\u0D30\u0D24\u0D025\u0DBD\u0D82\u0D9A\u0DCF4\u0E04\u0E2D\u0E213\u0E44\u0E17\u0E223\u0EA5\u0EB2\u0EA73\u10D2\u10D42\u307F\u3093\u306A3\u30A2\u30DE\u30BE\u30F34\u30AF\u30E9\u30A6\u30C94\u30B0\u30FC\u30B0\u30EB4\u30B3\u30E02\u30B9\u30C8\u30A23\u30BB\u30FC\u30EB3\u30D5\u30A1\u30C3\u30B7\u30E7\u30F36\u30DD\u30A4\u30F3\u30C84\u4E16\u754C2\u4E2D\u4FE11\u56FD1\u570B1\u6587\u7F513\u4E9A\u9A6C\u900A3\u4F01\u4E1A2\u4F5B\u5C712\u4FE1\u606F2\u5065\u5EB72\u516B\u53662\u516C\u53F81\u76CA2\u53F0\u6E7E1\u70632\u5546\u57CE1\u5E971\u68072\u5609\u91CC0\u5927\u9152\u5E975\u5728\u7EBF2\u5927\u62FF2\u5929\u4E3B\u65593\u5A31\u4E502\u5BB6\u96FB2\u5E7F\u4E1C2\u5FAE\u535A2\u6148\u55842\u6211\u7231\u4F603\u624B\u673A2\u62DB\u80582\u653F\u52A11\u5E9C2\u65B0\u52A0\u57612\u95FB2\u65F6\u5C1A2\u66F8\u7C4D2\u673A\u67842\u6DE1\u9A6C\u95213\u6E38\u620F2\u6FB3\u95802\u70B9\u770B2\u79FB\u52A82\u7EC4\u7EC7\u673A\u67844\u7F51\u57401\u5E971\u7AD91\u7EDC2\u8054\u901A2\u8C37\u6B4C2\u8D2D\u72692\u901A\u8CA92\u96C6\u56E22\u96FB\u8A0A\u76C8\u79D14\u98DE\u5229\u6D663\u98DF\u54C12\u9910\u53852\u9999\u683C\u91CC\u62C93\u6E2F2\uB2F7\uB1371\uCEF42\uC0BC\uC1312\uD55C\uAD6D2",Pd="numeric",Dd="ascii",Hd="alpha",Oi="asciinumeric",Ri="alphanumeric",Fd="domain",lb="emoji",lk="scheme",ck="slashscheme",Ad="whitespace";function dk(t,e){return t in e||(e[t]=[]),e[t]}function Vn(t,e,n){e[Pd]&&(e[Oi]=!0,e[Ri]=!0),e[Dd]&&(e[Oi]=!0,e[Hd]=!0),e[Oi]&&(e[Ri]=!0),e[Hd]&&(e[Ri]=!0),e[Ri]&&(e[Fd]=!0),e[lb]&&(e[Fd]=!0);for(let r in e){let i=dk(r,n);i.indexOf(t)<0&&i.push(t)}}function uk(t,e){let n={};for(let r in e)e[r].indexOf(t)>=0&&(n[r]=!0);return n}function Fe(t=null){this.j={},this.jr=[],this.jd=null,this.t=t}Fe.groups={};Fe.prototype={accepts(){return!!this.t},go(t){let e=this,n=e.j[t];if(n)return n;for(let r=0;r<e.jr.length;r++){let i=e.jr[r][0],o=e.jr[r][1];if(o&&i.test(t))return o}return e.jd},has(t,e=!1){return e?t in this.j:!!this.go(t)},ta(t,e,n,r){for(let i=0;i<t.length;i++)this.tt(t[i],e,n,r)},tr(t,e,n,r){r=r||Fe.groups;let i;return e&&e.j?i=e:(i=new Fe(e),n&&r&&Vn(e,n,r)),this.jr.push([t,i]),i},ts(t,e,n,r){let i=this,o=t.length;if(!o)return i;for(let s=0;s<o-1;s++)i=i.tt(t[s]);return i.tt(t[o-1],e,n,r)},tt(t,e,n,r){r=r||Fe.groups;let i=this;if(e&&e.j)return i.j[t]=e,e;let o=e,s,a=i.go(t);if(a?(s=new Fe,Object.assign(s.j,a.j),s.jr.push.apply(s.jr,a.jr),s.jd=a.jd,s.t=a.t):s=new Fe,o){if(r)if(s.t&&typeof s.t=="string"){let l=Object.assign(uk(s.t,r),n);Vn(o,l,r)}else n&&Vn(o,n,r);s.t=o}return i.j[t]=s,s}};var _=(t,e,n,r,i)=>t.ta(e,n,r,i),se=(t,e,n,r,i)=>t.tr(e,n,r,i),nb=(t,e,n,r,i)=>t.ts(e,n,r,i),T=(t,e,n,r,i)=>t.tt(e,n,r,i),Dt="WORD",_d="UWORD",cb="ASCIINUMERICAL",db="ALPHANUMERICAL",Hi="LOCALHOST",Bd="TLD",zd="UTLD",Ss="SCHEME",Rr="SLASH_SCHEME",Ud="NUM",Vd="WS",$d="NL",Li="OPENBRACE",Ni="CLOSEBRACE",ks="OPENBRACKET",Ms="CLOSEBRACKET",ws="OPENPAREN",As="CLOSEPAREN",Rs="OPENANGLEBRACKET",Os="CLOSEANGLEBRACKET",Ls="FULLWIDTHLEFTPAREN",Ns="FULLWIDTHRIGHTPAREN",Is="LEFTCORNERBRACKET",Ps="RIGHTCORNERBRACKET",Ds="LEFTWHITECORNERBRACKET",Hs="RIGHTWHITECORNERBRACKET",Fs="FULLWIDTHLESSTHAN",_s="FULLWIDTHGREATERTHAN",Bs="AMPERSAND",zs="APOSTROPHE",Vs="ASTERISK",on="AT",Us="BACKSLASH",$s="BACKTICK",js="CARET",sn="COLON",jd="COMMA",Ws="DOLLAR",Ct="DOT",qs="EQUALS",Wd="EXCLAMATION",it="HYPHEN",Ii="PERCENT",Ks="PIPE",Js="PLUS",Gs="POUND",Pi="QUERY",qd="QUOTE",ub="FULLWIDTHMIDDLEDOT",Kd="SEMI",vt="SLASH",Di="TILDE",Ys="UNDERSCORE",fb="EMOJI",Xs="SYM",pb=Object.freeze({__proto__:null,ALPHANUMERICAL:db,AMPERSAND:Bs,APOSTROPHE:zs,ASCIINUMERICAL:cb,ASTERISK:Vs,AT:on,BACKSLASH:Us,BACKTICK:$s,CARET:js,CLOSEANGLEBRACKET:Os,CLOSEBRACE:Ni,CLOSEBRACKET:Ms,CLOSEPAREN:As,COLON:sn,COMMA:jd,DOLLAR:Ws,DOT:Ct,EMOJI:fb,EQUALS:qs,EXCLAMATION:Wd,FULLWIDTHGREATERTHAN:_s,FULLWIDTHLEFTPAREN:Ls,FULLWIDTHLESSTHAN:Fs,FULLWIDTHMIDDLEDOT:ub,FULLWIDTHRIGHTPAREN:Ns,HYPHEN:it,LEFTCORNERBRACKET:Is,LEFTWHITECORNERBRACKET:Ds,LOCALHOST:Hi,NL:$d,NUM:Ud,OPENANGLEBRACKET:Rs,OPENBRACE:Li,OPENBRACKET:ks,OPENPAREN:ws,PERCENT:Ii,PIPE:Ks,PLUS:Js,POUND:Gs,QUERY:Pi,QUOTE:qd,RIGHTCORNERBRACKET:Ps,RIGHTWHITECORNERBRACKET:Hs,SCHEME:Ss,SEMI:Kd,SLASH:vt,SLASH_SCHEME:Rr,SYM:Xs,TILDE:Di,TLD:Bd,UNDERSCORE:Ys,UTLD:zd,UWORD:_d,WORD:Dt,WS:Vd}),It=/[a-z]/,Ai=/\p{L}/u,Rd=/\p{Emoji}/u;var Pt=/\d/,Od=/\s/;var rb="\r",Ld=`
+`,fk="\uFE0F",pk="\u200D",Nd="\uFFFC",Cs=null,vs=null;function hk(t=[]){let e={};Fe.groups=e;let n=new Fe;Cs==null&&(Cs=ib(sk)),vs==null&&(vs=ib(ak)),T(n,"'",zs),T(n,"{",Li),T(n,"}",Ni),T(n,"[",ks),T(n,"]",Ms),T(n,"(",ws),T(n,")",As),T(n,"<",Rs),T(n,">",Os),T(n,"\uFF08",Ls),T(n,"\uFF09",Ns),T(n,"\u300C",Is),T(n,"\u300D",Ps),T(n,"\u300E",Ds),T(n,"\u300F",Hs),T(n,"\uFF1C",Fs),T(n,"\uFF1E",_s),T(n,"&",Bs),T(n,"*",Vs),T(n,"@",on),T(n,"`",$s),T(n,"^",js),T(n,":",sn),T(n,",",jd),T(n,"$",Ws),T(n,".",Ct),T(n,"=",qs),T(n,"!",Wd),T(n,"-",it),T(n,"%",Ii),T(n,"|",Ks),T(n,"+",Js),T(n,"#",Gs),T(n,"?",Pi),T(n,'"',qd),T(n,"/",vt),T(n,";",Kd),T(n,"~",Di),T(n,"_",Ys),T(n,"\\",Us),T(n,"\u30FB",ub);let r=se(n,Pt,Ud,{[Pd]:!0});se(r,Pt,r);let i=se(r,It,cb,{[Oi]:!0}),o=se(r,Ai,db,{[Ri]:!0}),s=se(n,It,Dt,{[Dd]:!0});se(s,Pt,i),se(s,It,s),se(i,Pt,i),se(i,It,i);let a=se(n,Ai,_d,{[Hd]:!0});se(a,It),se(a,Pt,o),se(a,Ai,a),se(o,Pt,o),se(o,It),se(o,Ai,o);let l=T(n,Ld,$d,{[Ad]:!0}),c=T(n,rb,Vd,{[Ad]:!0}),d=se(n,Od,Vd,{[Ad]:!0});T(n,Nd,d),T(c,Ld,l),T(c,Nd,d),se(c,Od,d),T(d,rb),T(d,Ld),se(d,Od,d),T(d,Nd,d);let u=se(n,Rd,fb,{[lb]:!0});T(u,"#"),se(u,Rd,u),T(u,fk,u);let f=T(u,pk);T(f,"#"),se(f,Rd,u);let p=[[It,s],[Pt,i]],h=[[It,null],[Ai,a],[Pt,o]];for(let m=0;m<Cs.length;m++)rn(n,Cs[m],Bd,Dt,p);for(let m=0;m<vs.length;m++)rn(n,vs[m],zd,_d,h);Vn(Bd,{tld:!0,ascii:!0},e),Vn(zd,{utld:!0,alpha:!0},e),rn(n,"file",Ss,Dt,p),rn(n,"mailto",Ss,Dt,p),rn(n,"http",Rr,Dt,p),rn(n,"https",Rr,Dt,p),rn(n,"ftp",Rr,Dt,p),rn(n,"ftps",Rr,Dt,p),Vn(Ss,{scheme:!0,ascii:!0},e),Vn(Rr,{slashscheme:!0,ascii:!0},e),t=t.sort((m,g)=>m[0]>g[0]?1:-1);for(let m=0;m<t.length;m++){let g=t[m][0],E=t[m][1]?{[lk]:!0}:{[ck]:!0};g.indexOf("-")>=0?E[Fd]=!0:It.test(g)?Pt.test(g)?E[Oi]=!0:E[Dd]=!0:E[Pd]=!0,nb(n,g,g,E)}return nb(n,"localhost",Hi,{ascii:!0}),n.jd=new Fe(Xs),{start:n,tokens:Object.assign({groups:e},pb)}}function hb(t,e){let n=mk(e.replace(/[A-Z]/g,a=>a.toLowerCase())),r=n.length,i=[],o=0,s=0;for(;s<r;){let a=t,l=null,c=0,d=null,u=-1,f=-1;for(;s<r&&(l=a.go(n[s]));)a=l,a.accepts()?(u=0,f=0,d=a):u>=0&&(u+=n[s].length,f++),c+=n[s].length,o+=n[s].length,s++;o-=u,s-=f,c-=u,i.push({t:d.t,v:e.slice(o-c,o),s:o-c,e:o})}return i}function mk(t){let e=[],n=t.length,r=0;for(;r<n;){let i=t.charCodeAt(r),o,s=i<55296||i>56319||r+1===n||(o=t.charCodeAt(r+1))<56320||o>57343?t[r]:t.slice(r,r+2);e.push(s),r+=s.length}return e}function rn(t,e,n,r,i){let o,s=e.length;for(let a=0;a<s-1;a++){let l=e[a];t.j[l]?o=t.j[l]:(o=new Fe(r),o.jr=i.slice(),t.j[l]=o),t=o}return o=new Fe(n),o.jr=i.slice(),t.j[e[s-1]]=o,o}function ib(t){let e=[],n=[],r=0,i="0123456789";for(;r<t.length;){let o=0;for(;i.indexOf(t[r+o])>=0;)o++;if(o>0){e.push(n.join(""));for(let s=parseInt(t.substring(r,r+o),10);s>0;s--)n.pop();r+=o}else n.push(t[r]),r++}return e}var Fi={defaultProtocol:"http",events:null,format:ob,formatHref:ob,nl2br:!1,tagName:"a",target:null,rel:null,validate:!0,truncate:1/0,className:null,attributes:null,ignoreTags:[],render:null};function Jd(t,e=null){let n=Object.assign({},Fi);t&&(n=Object.assign(n,t instanceof Jd?t.o:t));let r=n.ignoreTags,i=[];for(let o=0;o<r.length;o++)i.push(r[o].toUpperCase());this.o=n,e&&(this.defaultRender=e),this.ignoreTags=i}Jd.prototype={o:Fi,ignoreTags:[],defaultRender(t){return t},check(t){return this.get("validate",t.toString(),t)},get(t,e,n){let r=e!=null,i=this.o[t];return i&&(typeof i=="object"?(i=n.t in i?i[n.t]:Fi[t],typeof i=="function"&&r&&(i=i(e,n))):typeof i=="function"&&r&&(i=i(e,n.t,n)),i)},getObj(t,e,n){let r=this.o[t];return typeof r=="function"&&e!=null&&(r=r(e,n.t,n)),r},render(t){let e=t.render(this);return(this.get("render",null,t)||this.defaultRender)(e,t.t,t)}};function ob(t){return t}function mb(t,e){this.t="token",this.v=t,this.tk=e}mb.prototype={isLink:!1,toString(){return this.v},toHref(t){return this.toString()},toFormattedString(t){let e=this.toString(),n=t.get("truncate",e,this),r=t.get("format",e,this);return n&&r.length>n?r.substring(0,n)+"\u2026":r},toFormattedHref(t){return t.get("formatHref",this.toHref(t.get("defaultProtocol")),this)},startIndex(){return this.tk[0].s},endIndex(){return this.tk[this.tk.length-1].e},toObject(t=Fi.defaultProtocol){return{type:this.t,value:this.toString(),isLink:this.isLink,href:this.toHref(t),start:this.startIndex(),end:this.endIndex()}},toFormattedObject(t){return{type:this.t,value:this.toFormattedString(t),isLink:this.isLink,href:this.toFormattedHref(t),start:this.startIndex(),end:this.endIndex()}},validate(t){return t.get("validate",this.toString(),this)},render(t){let e=this,n=this.toHref(t.get("defaultProtocol")),r=t.get("formatHref",n,this),i=t.get("tagName",n,e),o=this.toFormattedString(t),s={},a=t.get("className",n,e),l=t.get("target",n,e),c=t.get("rel",n,e),d=t.getObj("attributes",n,e),u=t.getObj("events",n,e);return s.href=r,a&&(s.class=a),l&&(s.target=l),c&&(s.rel=c),d&&Object.assign(s,d),{tagName:i,attributes:s,content:o,eventListeners:u}}};function Qs(t,e){class n extends mb{constructor(i,o){super(i,o),this.t=t}}for(let r in e)n.prototype[r]=e[r];return n.t=t,n}var sb=Qs("email",{isLink:!0,toHref(){return"mailto:"+this.toString()}}),ab=Qs("text"),gk=Qs("nl"),Ts=Qs("url",{isLink:!0,toHref(t=Fi.defaultProtocol){return this.hasProtocol()?this.v:`${t}://${this.v}`},hasProtocol(){let t=this.tk;return t.length>=2&&t[0].t!==Hi&&t[1].t===sn}});var rt=t=>new Fe(t);function yk({groups:t}){let e=t.domain.concat([Bs,Vs,on,Us,$s,js,Ws,qs,it,Ud,Ii,Ks,Js,Gs,vt,Xs,Di,Ys]),n=[zs,sn,jd,Ct,Wd,Ii,Pi,qd,Kd,Rs,Os,Li,Ni,Ms,ks,ws,As,Ls,Ns,Is,Ps,Ds,Hs,Fs,_s],r=[Bs,zs,Vs,Us,$s,js,Ws,qs,it,Li,Ni,Ii,Ks,Js,Gs,Pi,vt,Xs,Di,Ys],i=rt(),o=T(i,Di);_(o,r,o),_(o,t.domain,o);let s=rt(),a=rt(),l=rt();_(i,t.domain,s),_(i,t.scheme,a),_(i,t.slashscheme,l),_(s,r,o),_(s,t.domain,s);let c=T(s,on);T(o,on,c),T(a,on,c),T(l,on,c);let d=T(o,Ct);_(d,r,o),_(d,t.domain,o);let u=rt();_(c,t.domain,u),_(u,t.domain,u);let f=T(u,Ct);_(f,t.domain,u);let p=rt(sb);_(f,t.tld,p),_(f,t.utld,p),T(c,Hi,p);let h=T(u,it);T(h,it,h),_(h,t.domain,u),_(p,t.domain,u),T(p,Ct,f),T(p,it,h);let m=T(p,sn);_(m,t.numeric,sb);let g=T(s,it),y=T(s,Ct);T(g,it,g),_(g,t.domain,s),_(y,r,o),_(y,t.domain,s);let E=rt(Ts);_(y,t.tld,E),_(y,t.utld,E),_(E,t.domain,s),_(E,r,o),T(E,Ct,y),T(E,it,g),T(E,on,c);let x=T(E,sn),k=rt(Ts);_(x,t.numeric,k);let S=rt(Ts),R=rt();_(S,e,S),_(S,n,R),_(R,e,S),_(R,n,R),T(E,vt,S),T(k,vt,S);let M=T(a,sn),I=T(l,sn),H=T(I,vt),b=T(H,vt);_(a,t.domain,s),T(a,Ct,y),T(a,it,g),_(l,t.domain,s),T(l,Ct,y),T(l,it,g),_(M,t.domain,S),T(M,vt,S),T(M,Pi,S),_(b,t.domain,S),_(b,e,S),T(b,vt,S);let Q=[[Li,Ni],[ks,Ms],[ws,As],[Rs,Os],[Ls,Ns],[Is,Ps],[Ds,Hs],[Fs,_s]];for(let A=0;A<Q.length;A++){let[P,B]=Q[A],Z=T(S,P);T(R,P,Z),T(Z,B,S);let K=rt(Ts);_(Z,e,K);let z=rt();_(Z,n),_(K,e,K),_(K,n,z),_(z,e,K),_(z,n,z),T(K,B,S),T(z,B,S)}return T(i,Hi,E),T(i,$d,gk),{start:i,tokens:pb}}function bk(t,e,n){let r=n.length,i=0,o=[],s=[];for(;i<r;){let a=t,l=null,c=null,d=0,u=null,f=-1;for(;i<r&&!(l=a.go(n[i].t));)s.push(n[i++]);for(;i<r&&(c=l||a.go(n[i].t));)l=null,a=c,a.accepts()?(f=0,u=a):f>=0&&f++,i++,d++;if(f<0)i-=d,i<r&&(s.push(n[i]),i++);else{s.length>0&&(o.push(Id(ab,e,s)),s=[]),i-=f,d-=f;let p=u.t,h=n.slice(i-d,i);o.push(Id(p,e,h))}}return s.length>0&&o.push(Id(ab,e,s)),o}function Id(t,e,n){let r=n[0].s,i=n[n.length-1].e,o=e.slice(r,i);return new t(o,n)}var Ek=typeof console!="undefined"&&console&&console.warn||(()=>{}),xk="until manual call of linkify.init(). Register all schemes and plugins before invoking linkify the first time.",X={scanner:null,parser:null,tokenQueue:[],pluginQueue:[],customSchemes:[],initialized:!1};function gb(){return Fe.groups={},X.scanner=null,X.parser=null,X.tokenQueue=[],X.pluginQueue=[],X.customSchemes=[],X.initialized=!1,X}function Gd(t,e=!1){if(X.initialized&&Ek(`linkifyjs: already initialized - will not register custom scheme "${t}" ${xk}`),!/^[0-9a-z]+(-[0-9a-z]+)*$/.test(t))throw new Error(`linkifyjs: incorrect scheme format.
 1. Must only contain digits, lowercase ASCII letters or "-"
 2. Cannot start or end with "-"
-3. "-" cannot repeat`);P.customSchemes.push([t,e])}function _b(){P.scanner=Mb(P.customSchemes);for(let t=0;t<P.tokenQueue.length;t++)P.tokenQueue[t][1]({scanner:P.scanner});P.parser=Ob(P.scanner.tokens);for(let t=0;t<P.pluginQueue.length;t++)P.pluginQueue[t][1]({scanner:P.scanner,parser:P.parser});return P.initialized=!0,P}function Pi(t){return P.initialized||_b(),Rb(P.parser.start,t,uf(P.scanner.start,t))}Pi.scan=uf;function vl(t,e=null,n=null){if(e&&typeof e=="object"){if(n)throw Error(`linkifyjs: Invalid link type ${e}; must be a string`);n=e,e=null}let r=new yl(n),i=Pi(t),o=[];for(let s=0;s<i.length;s++){let l=i[s];l.isLink&&(!e||l.t===e)&&r.check(l)&&o.push(l.toFormattedObject(r))}return o}var kl="[\0- \xA0\u1680\u180E\u2000-\u2029\u205F\u3000]",Ib=new RegExp(kl),Db=new RegExp(`${kl}$`),Pb=new RegExp(kl,"g");function Hb(t){return t.length===1?t[0].isLink:t.length===3&&t[1].isLink?["()","[]"].includes(t[0].value+t[2].value):!1}function Fb(t){return new B({key:new j("autolink"),appendTransaction:(e,n,r)=>{let i=e.some(c=>c.docChanged)&&!n.doc.eq(r.doc),o=e.some(c=>c.getMeta("preventAutolink"));if(!i||o)return;let{tr:s}=r,l=gu(n.doc,[...e]);if(vu(l).forEach(({newRange:c})=>{let d=yu(r.doc,c,h=>h.isTextblock),u,f;if(d.length>1)u=d[0],f=r.doc.textBetween(u.pos,u.pos+u.node.nodeSize,void 0," ");else if(d.length){let h=r.doc.textBetween(c.from,c.to," "," ");if(!Db.test(h))return;u=d[0],f=r.doc.textBetween(u.pos,c.to,void 0," ")}if(u&&f){let h=f.split(Ib).filter(Boolean);if(h.length<=0)return!1;let p=h[h.length-1],m=u.pos+f.lastIndexOf(p);if(!p)return!1;let g=Pi(p).map(y=>y.toObject(t.defaultProtocol));if(!Hb(g))return!1;g.filter(y=>y.isLink).map(y=>({...y,from:m+y.start+1,to:m+y.end+1})).filter(y=>r.schema.marks.code?!r.doc.rangeHasMark(y.from,y.to,r.schema.marks.code):!0).filter(y=>t.validate(y.value)).filter(y=>t.shouldAutoLink(y.value)).forEach(y=>{Xr(y.from,y.to,r.doc).some(k=>k.mark.type===t.type)||s.addMark(y.from,y.to,t.type.create({href:y.href}))})}}),!!s.steps.length)return s}})}function Bb(t){return new B({key:new j("handleClickLink"),props:{handleClick:(e,n,r)=>{var i,o;if(r.button!==0||!e.editable)return!1;let s=r.target,l=[];for(;s.nodeName!=="DIV";)l.push(s),s=s.parentNode;if(!l.find(f=>f.nodeName==="A"))return!1;let a=bu(e.state,t.type.name),c=r.target,d=(i=c==null?void 0:c.href)!==null&&i!==void 0?i:a.href,u=(o=c==null?void 0:c.target)!==null&&o!==void 0?o:a.target;return c&&d?(window.open(d,u),!0):!1}}})}function zb(t){return new B({key:new j("handlePasteLink"),props:{handlePaste:(e,n,r)=>{let{state:i}=e,{selection:o}=i,{empty:s}=o;if(s)return!1;let l="";r.content.forEach(c=>{l+=c.textContent});let a=vl(l,{defaultProtocol:t.defaultProtocol}).find(c=>c.isLink&&c.value===l);return!l||!a?!1:t.editor.commands.setMark(t.type,{href:a.href})}}})}function _t(t,e){let n=["http","https","ftp","ftps","mailto","tel","callto","sms","cid","xmpp"];return e&&e.forEach(r=>{let i=typeof r=="string"?r:r.scheme;i&&n.push(i)}),!t||t.replace(Pb,"").match(new RegExp(`^(?:(?:${n.join("|")}):|[^a-z]|[a-z0-9+.-]+(?:[^a-z+.-:]|$))`,"i"))}var Vb=he.create({name:"link",priority:1e3,keepOnSplit:!1,exitable:!0,onCreate(){this.options.validate&&!this.options.shouldAutoLink&&(this.options.shouldAutoLink=this.options.validate,console.warn("The `validate` option is deprecated. Rename to the `shouldAutoLink` option instead.")),this.options.protocols.forEach(t=>{if(typeof t=="string"){bl(t);return}bl(t.scheme,t.optionalSlashes)})},onDestroy(){hf()},inclusive(){return this.options.autolink},addOptions(){return{openOnClick:!0,linkOnPaste:!0,autolink:!0,protocols:[],defaultProtocol:"http",HTMLAttributes:{target:"_blank",rel:"noopener noreferrer nofollow",class:null},isAllowedUri:(t,e)=>!!_t(t,e.protocols),validate:t=>!!t,shouldAutoLink:t=>!!t}},addAttributes(){return{href:{default:null,parseHTML(t){return t.getAttribute("href")}},target:{default:this.options.HTMLAttributes.target},rel:{default:this.options.HTMLAttributes.rel},class:{default:this.options.HTMLAttributes.class}}},parseHTML(){return[{tag:"a[href]",getAttrs:t=>{let e=t.getAttribute("href");return!e||!this.options.isAllowedUri(e,{defaultValidate:n=>!!_t(n,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?!1:null}}]},renderHTML({HTMLAttributes:t}){return this.options.isAllowedUri(t.href,{defaultValidate:e=>!!_t(e,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?["a",N(this.options.HTMLAttributes,t),0]:["a",N(this.options.HTMLAttributes,{...t,href:""}),0]},addCommands(){return{setLink:t=>({chain:e})=>{let{href:n}=t;return this.options.isAllowedUri(n,{defaultValidate:r=>!!_t(r,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?e().setMark(this.name,t).setMeta("preventAutolink",!0).run():!1},toggleLink:t=>({chain:e})=>{let{href:n}=t;return this.options.isAllowedUri(n,{defaultValidate:r=>!!_t(r,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?e().toggleMark(this.name,t,{extendEmptyMarkRange:!0}).setMeta("preventAutolink",!0).run():!1},unsetLink:()=>({chain:t})=>t().unsetMark(this.name,{extendEmptyMarkRange:!0}).setMeta("preventAutolink",!0).run()}},addPasteRules(){return[Ce({find:t=>{let e=[];if(t){let{protocols:n,defaultProtocol:r}=this.options,i=vl(t).filter(o=>o.isLink&&this.options.isAllowedUri(o.value,{defaultValidate:s=>!!_t(s,n),protocols:n,defaultProtocol:r}));i.length&&i.forEach(o=>e.push({text:o.value,data:{href:o.href},index:o.start}))}return e},type:this.type,getAttributes:t=>{var e;return{href:(e=t.data)===null||e===void 0?void 0:e.href}}})]},addProseMirrorPlugins(){let t=[],{protocols:e,defaultProtocol:n}=this.options;return this.options.autolink&&t.push(Fb({type:this.type,defaultProtocol:this.options.defaultProtocol,validate:r=>this.options.isAllowedUri(r,{defaultValidate:i=>!!_t(i,e),protocols:e,defaultProtocol:n}),shouldAutoLink:this.options.shouldAutoLink})),this.options.openOnClick===!0&&t.push(Bb({type:this.type})),this.options.linkOnPaste&&t.push(zb({editor:this.editor,defaultProtocol:this.options.defaultProtocol,type:this.type})),t}});var $b=he.create({name:"underline",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"u"},{style:"text-decoration",consuming:!1,getAttrs:t=>t.includes("underline")?{}:!1}]},renderHTML({HTMLAttributes:t}){return["u",N(this.options.HTMLAttributes,t),0]},addCommands(){return{setUnderline:()=>({commands:t})=>t.setMark(this.name),toggleUnderline:()=>({commands:t})=>t.toggleMark(this.name),unsetUnderline:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-u":()=>this.editor.commands.toggleUnderline(),"Mod-U":()=>this.editor.commands.toggleUnderline()}}});var xl=null;async function gf(t={}){let e=Object.keys(t!=null?t:{}).length>0,n=xl&&!e?xl:null,r=n!=null?n:new ln(e?t:void 0);n||(xl=r,globalThis.formgenRelationships=r),Ra(r),Da(r);let i=Array.from(document.querySelectorAll("[data-formgen-auto-init]")),o=[];for(let s of i){ko(s);let l=ua(s);for(let a of l){let c=fa(a),d=jb(c),u=Ub(a,c);Wb(a,u),r.get(a)||r.register(a,{field:u,endpoint:d}),u.renderer==="chips"&&a instanceof HTMLSelectElement&&a.multiple&&Na(a),u.renderer==="typeahead"&&a instanceof HTMLSelectElement&&!a.multiple&&Pa(a),Qb(a,u,s,r),Xb(a,u,s,r),Zb(a,u,r),Yb(u)&&o.push(r.resolve(a))}}return o.length>0&&await Promise.all(o),r}function jb(t){let e={};t.endpointUrl&&(e.url=t.endpointUrl),t.endpointMethod&&(e.method=t.endpointMethod.toUpperCase()),t.endpointLabelField&&(e.labelField=t.endpointLabelField),t.endpointValueField&&(e.valueField=t.endpointValueField),t.endpointResultsPath&&(e.resultsPath=t.endpointResultsPath),t.endpointMode&&(e.mode=t.endpointMode),t.endpointSearchParam&&(e.searchParam=t.endpointSearchParam),t.endpointSubmitAs&&(e.submitAs=t.endpointSubmitAs);let n=Hi(t,"endpointParams");n&&(e.params=n);let r=Hi(t,"endpointDynamicParams");r&&(e.dynamicParams=r);let i=Hi(t,"endpointMapping");i&&(i.value||i.label)&&(e.mapping=i);let o=Hi(t,"endpointAuth");return o&&(o.source||o.header||o.strategy)&&(e.auth=o),e}function Ub(t,e){var i,o;let n={name:(o=(i=t.getAttribute("name"))!=null?i:t.getAttribute("id"))!=null?o:void 0};e.relationshipType&&(n.relationship=e.relationshipType),e.relationshipCardinality&&(n.cardinality=e.relationshipCardinality),e.endpointSubmitAs==="json"?n.submitAs="json":e.endpointSubmitAs&&(n.submitAs="default"),e.endpointCacheKey&&(n.cacheKey=e.endpointCacheKey),e.endpointRenderer&&(n.renderer=e.endpointRenderer),e.endpointRefresh&&(n.refreshMode=e.endpointRefresh==="manual"?"manual":"auto"),e.endpointRefreshOn&&(n.refreshOn=e.endpointRefreshOn.split(",").map(s=>s.trim()).filter(Boolean)),e.endpointMode==="search"&&(n.mode="search"),e.endpointThrottle&&(n.throttleMs=mf(e.endpointThrottle)),e.endpointDebounce&&(n.debounceMs=mf(e.endpointDebounce)),e.endpointSearchParam&&(n.searchParam=e.endpointSearchParam),e.relationshipCurrent&&(n.current=Jb(e.relationshipCurrent)),e.icon&&(n.icon=e.icon),e.iconSource&&(n.iconSource=e.iconSource),e.iconRaw&&(n.iconRaw=e.iconRaw),n.required=t.hasAttribute("required")||e.validationRequired==="true";let r=e.validationLabel||e.endpointFieldLabel||t.getAttribute("aria-label")||t.getAttribute("placeholder")||t.getAttribute("name")||t.id||void 0;if(r&&(n.label=r),e.validationRules)try{let s=JSON.parse(e.validationRules);Array.isArray(s)&&(n.validations=s.filter(t0))}catch{}return n.refreshMode||(n.refreshMode="auto"),n.mode||(n.mode="default"),n}function Wb(t,e){var n;if(!(!e||e.current==null)&&t.dataset.relationshipCurrentApplied!=="true"){if(t instanceof HTMLSelectElement){let r=pf(e.current,t.multiple);qb(t,r)&&(Kb(t,e.submitAs),t.dataset.relationshipCurrentApplied="true");return}if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement){let r=pf(e.current,!1);r.length>0&&(t.value=(n=r[0])!=null?n:"",t.dataset.relationshipCurrentApplied="true")}}}function pf(t,e){var r;if(t==null)return[];if(Array.isArray(t))return e?t.filter(Boolean).map(String):[String((r=t[0])!=null?r:"")].filter(Boolean);let n=String(t);return n?[n]:[]}function qb(t,e){let n=t.multiple?Array.from(new Set(e)):e.slice(0,1),r=new Set(n.filter(Boolean)),i=!1;return Array.from(t.options).forEach(o=>{let s=r.has(o.value);o.selected!==s&&(o.selected=s,i=!0),s&&r.delete(o.value)}),r.forEach(o=>{if(!o)return;let s=document.createElement("option");s.value=o,s.textContent=o,s.selected=!0,t.appendChild(s),i=!0}),!t.multiple&&n.length===0&&t.value!==""&&(t.value="",i=!0),i}function Kb(t,e){if(e==="json"){Je(t);return}t.multiple&&ut(t)}function Jb(t){let e=t.trim();if(!e)return null;try{let n=JSON.parse(e);return Array.isArray(n)?n.map(r=>String(r)):n==null?null:String(n)}catch{return e.includes(",")?e.split(",").map(r=>r.trim()).filter(Boolean):e}}function Hi(t,e){let n={},r=e.toLowerCase();return Object.entries(t).forEach(([i,o])=>{if(!o)return;if(i===e||i.toLowerCase()===r){n[""]=o;return}if(!i.startsWith(e))return;let s=i.slice(e.length);if(!s)return;let l=Gb(s);l&&(n[l]=o)}),Object.keys(n).length>0?n:void 0}function Gb(t){let e=t.trim();if(!e)return"";let n=e.split(""),r=[];return n.forEach((i,o)=>{/[A-Z]/.test(i)?(o!==0&&r.push("-"),r.push(i.toLowerCase())):r.push(i)}),r.join("").replace(/^-+/,"")}function Yb(t){return!(t.refreshMode==="manual"||t.mode==="search")}function Qb(t,e,n,r){var l,a;if(!e.refreshOn||e.refreshOn.length===0||e.refreshMode==="manual"||t.dataset.relationshipRefreshBound==="true")return;t.dataset.relationshipRefreshBound="true";let i=t.closest("form"),o=(a=(l=i!=null?i:n)!=null?l:t.ownerDocument)!=null?a:document,s=()=>{r.resolve(t).catch(()=>{})};e.refreshOn.forEach(c=>{e0(o,c).forEach(u=>{let f=u instanceof HTMLInputElement&&u.type==="text"?"input":"change";u.addEventListener(f,s)})})}function Xb(t,e,n,r){var d,u,f,h;if(e.refreshMode!=="manual"||t.dataset.relationshipManualBound==="true")return;t.dataset.relationshipManualBound="true";let i=(d=e.name)!=null?d:t.getAttribute("id");if(!i)return;let o=r0(i),s=(f=(u=n.ownerDocument)!=null?u:t.ownerDocument)!=null?f:document,l=new Set;[`[data-endpoint-refresh-target="${o}"]`,`[data-endpoint-refresh-for="${o}"]`].forEach(p=>{s.querySelectorAll(p).forEach(m=>l.add(m))});let c=(h=t.closest("[data-relationship-type]"))!=null?h:t.parentElement;c==null||c.querySelectorAll("[data-endpoint-refresh-trigger]").forEach(p=>l.add(p)),l.forEach(p=>{p.dataset.relationshipRefreshListener!=="true"&&(p.dataset.relationshipRefreshListener="true",p.addEventListener("click",m=>{m.preventDefault(),r.resolve(t).catch(()=>{})}))})}function Zb(t,e,n){var d,u;if(e.mode!=="search"||t.dataset.relationshipSearchBound==="true")return;t.dataset.relationshipSearchBound="true";let r=n.getConfig(),i=(d=e.throttleMs)!=null?d:r.searchThrottleMs,o=(u=e.debounceMs)!=null?u:r.searchDebounceMs,s=()=>{n.resolve(t).catch(()=>{})},l=()=>s();o>0&&(l=Ta(l,o)),i>0&&(l=Ma(l,i));let a=()=>{if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement){let f=t.value.trim();t.setAttribute("data-endpoint-search-value",f)}},c=()=>{a(),l()};t.addEventListener("input",c),(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement)&&t.addEventListener("change",c)}function e0(t,e){var l;let n=[],r=new Set,i=t instanceof HTMLFormElement?t:t instanceof HTMLElement?t.closest("form"):null,o=t instanceof Document?t:(l=t.ownerDocument)!=null?l:document,s=[];return i?Array.from(i.elements).forEach(c=>{c instanceof HTMLElement&&s.push(c)}):s.push(...Array.from(o.querySelectorAll("[name], [data-field-name], [id]"))),s.forEach(a=>{if(r.has(a))return;let c=a.dataset.fieldName;if(c&&c===e){n.push(a),r.add(a);return}let d=a.name;if(n0(d,e)){n.push(a),r.add(a);return}let u=a.id;u&&u===e&&(n.push(a),r.add(a))}),n}function t0(t){if(!t||typeof t!="object")return!1;let e=t;return typeof e.kind=="string"&&e.kind.length>0}function n0(t,e){if(!t)return!1;if(t===e||t===`${e}[]`||t.endsWith(`[${e}]`)||t.endsWith(`[${e}][]`))return!0;let n=t.replace(/[^a-z0-9]/gi,"").toLowerCase(),r=e.replace(/[^a-z0-9]/gi,"").toLowerCase();return n.endsWith(r)}function r0(t){return typeof CSS!="undefined"&&typeof CSS.escape=="function"?CSS.escape(t):t.replace(/(["\\])/g,"\\$1")}function mf(t){if(!t)return;let e=Number(t);return Number.isFinite(e)?e:void 0}var yf="formgen:relationship:success",bf="formgen:relationship:error",vf="formgen:relationship:loading",kf="formgen:relationship:validation";function i0(t){let[e,n]=Xi(null),[r,i]=Xi({options:[],loading:!1,error:null,validation:null});return Zi(()=>{if(!t)return;let o=!1,s=null;return(async()=>{let a=await gf();if(o)return;s=a,n(a);let c=t.getAttribute("data-state");if(c==="ready"&&t instanceof HTMLSelectElement){let d=Array.from(t.options).filter(u=>u.value!=="").map(u=>({value:u.value,label:u.textContent||u.value}));i({options:d,loading:!1,error:null,validation:null})}else if(c==="error")i(d=>({...d,loading:!1,error:new Error("Failed to load options")}));else if(c!=="loading"){i(d=>({...d,loading:!0}));try{await a.resolve(t)}catch(d){o||i(u=>({...u,error:d,loading:!1}))}}})().catch(a=>{o||i(c=>({...c,error:a,loading:!1}))}),()=>{o=!0}},[t]),Zi(()=>{if(!t||!e)return;let o=c=>{c.detail.element===t&&i(u=>({...u,loading:!0,error:null}))},s=c=>{let d=c.detail;d.element===t&&i(u=>{var f;return{...u,options:(f=d.options)!=null?f:[],loading:!1,error:null}})},l=c=>{let d=c.detail;d.element===t&&i(u=>{var f;return{...u,loading:!1,error:(f=d.error)!=null?f:null}})},a=c=>{let d=c.detail;d.element===t&&i(u=>{var f;return{...u,validation:(f=d.validation)!=null?f:null}})};return t.addEventListener(vf,o),t.addEventListener(yf,s),t.addEventListener(bf,l),t.addEventListener(kf,a),()=>{t.removeEventListener(vf,o),t.removeEventListener(yf,s),t.removeEventListener(bf,l),t.removeEventListener(kf,a)}},[t,e]),{options:r.options,loading:r.loading,error:r.error,validation:r.validation,refresh:async()=>{!t||!e||await e.resolve(t)}}}return Mf(o0);})();
+3. "-" cannot repeat`);X.customSchemes.push([t,e])}function Ck(){X.scanner=hk(X.customSchemes);for(let t=0;t<X.tokenQueue.length;t++)X.tokenQueue[t][1]({scanner:X.scanner});X.parser=yk(X.scanner.tokens);for(let t=0;t<X.pluginQueue.length;t++)X.pluginQueue[t][1]({scanner:X.scanner,parser:X.parser});return X.initialized=!0,X}function Zs(t){return X.initialized||Ck(),bk(X.parser.start,t,hb(X.scanner.start,t))}Zs.scan=hb;function ea(t,e=null,n=null){if(e&&typeof e=="object"){if(n)throw Error(`linkifyjs: Invalid link type ${e}; must be a string`);n=e,e=null}let r=new Jd(n),i=Zs(t),o=[];for(let s=0;s<i.length;s++){let a=i[s];a.isLink&&(!e||a.t===e)&&r.check(a)&&o.push(a.toFormattedObject(r))}return o}var Yd="[\0- \xA0\u1680\u180E\u2000-\u2029\u205F\u3000]",yb=new RegExp(Yd),bb=new RegExp(`${Yd}$`),Eb=new RegExp(Yd,"g");function vk(t){return t.length===1?t[0].isLink:t.length===3&&t[1].isLink?["()","[]"].includes(t[0].value+t[2].value):!1}function xb(t){return new V({key:new G("autolink"),appendTransaction:(e,n,r)=>{let i=e.some(c=>c.docChanged)&&!n.doc.eq(r.doc),o=e.some(c=>c.getMeta("preventAutolink"));if(!i||o)return;let{tr:s}=r,a=oy(n.doc,[...e]);if(fy(a).forEach(({newRange:c})=>{let d=ay(r.doc,c,p=>p.isTextblock),u,f;if(d.length>1)u=d[0],f=r.doc.textBetween(u.pos,u.pos+u.node.nodeSize,void 0," ");else if(d.length){let p=r.doc.textBetween(c.from,c.to," "," ");if(!bb.test(p))return;u=d[0],f=r.doc.textBetween(u.pos,c.to,void 0," ")}if(u&&f){let p=f.split(yb).filter(Boolean);if(p.length<=0)return!1;let h=p[p.length-1],m=u.pos+f.lastIndexOf(h);if(!h)return!1;let g=Zs(h).map(y=>y.toObject(t.defaultProtocol));if(!vk(g))return!1;g.filter(y=>y.isLink).map(y=>({...y,from:m+y.start+1,to:m+y.end+1})).filter(y=>r.schema.marks.code?!r.doc.rangeHasMark(y.from,y.to,r.schema.marks.code):!0).filter(y=>t.validate(y.value)).filter(y=>t.shouldAutoLink(y.value)).forEach(y=>{Mr(y.from,y.to,r.doc).some(E=>E.mark.type===t.type)||s.addMark(y.from,y.to,t.type.create({href:y.href}))})}}),!!s.steps.length)return s}})}function Cb(t){return new V({key:new G("handleClickLink"),props:{handleClick:(e,n,r)=>{var d,u;if(r.button!==0||!e.editable)return!1;let i=r.target,o=[];for(;i.nodeName!=="DIV";)o.push(i),i=i.parentNode;if(!o.find(f=>f.nodeName==="A"))return!1;let s=Xc(e.state,t.type.name),a=r.target,l=(d=a==null?void 0:a.href)!=null?d:s.href,c=(u=a==null?void 0:a.target)!=null?u:s.target;return a&&l?(window.open(l,c),!0):!1}}})}function vb(t){return new V({key:new G("handlePasteLink"),props:{handlePaste:(e,n,r)=>{let{state:i}=e,{selection:o}=i,{empty:s}=o;if(s)return!1;let a="";r.content.forEach(c=>{a+=c.textContent});let l=ea(a,{defaultProtocol:t.defaultProtocol}).find(c=>c.isLink&&c.value===a);return!a||!l?!1:t.editor.commands.setMark(t.type,{href:l.href})}}})}function Un(t,e){let n=["http","https","ftp","ftps","mailto","tel","callto","sms","cid","xmpp"];return e&&e.forEach(r=>{let i=typeof r=="string"?r:r.scheme;i&&n.push(i)}),!t||t.replace(Eb,"").match(new RegExp(`^(?:(?:${n.join("|")}):|[^a-z]|[a-z0-9+.-]+(?:[^a-z+.-:]|$))`,"i"))}var Tb=Re.create({name:"link",priority:1e3,keepOnSplit:!1,exitable:!0,onCreate(){this.options.validate&&!this.options.shouldAutoLink&&(this.options.shouldAutoLink=this.options.validate,console.warn("The `validate` option is deprecated. Rename to the `shouldAutoLink` option instead.")),this.options.protocols.forEach(t=>{if(typeof t=="string"){Gd(t);return}Gd(t.scheme,t.optionalSlashes)})},onDestroy(){gb()},inclusive(){return this.options.autolink},addOptions(){return{openOnClick:!0,linkOnPaste:!0,autolink:!0,protocols:[],defaultProtocol:"http",HTMLAttributes:{target:"_blank",rel:"noopener noreferrer nofollow",class:null},isAllowedUri:(t,e)=>!!Un(t,e.protocols),validate:t=>!!t,shouldAutoLink:t=>!!t}},addAttributes(){return{href:{default:null,parseHTML(t){return t.getAttribute("href")}},target:{default:this.options.HTMLAttributes.target},rel:{default:this.options.HTMLAttributes.rel},class:{default:this.options.HTMLAttributes.class}}},parseHTML(){return[{tag:"a[href]",getAttrs:t=>{let e=t.getAttribute("href");return!e||!this.options.isAllowedUri(e,{defaultValidate:n=>!!Un(n,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?!1:null}}]},renderHTML({HTMLAttributes:t}){return this.options.isAllowedUri(t.href,{defaultValidate:e=>!!Un(e,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?["a",$(this.options.HTMLAttributes,t),0]:["a",$(this.options.HTMLAttributes,{...t,href:""}),0]},addCommands(){return{setLink:t=>({chain:e})=>{let{href:n}=t;return this.options.isAllowedUri(n,{defaultValidate:r=>!!Un(r,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?e().setMark(this.name,t).setMeta("preventAutolink",!0).run():!1},toggleLink:t=>({chain:e})=>{let{href:n}=t;return this.options.isAllowedUri(n,{defaultValidate:r=>!!Un(r,this.options.protocols),protocols:this.options.protocols,defaultProtocol:this.options.defaultProtocol})?e().toggleMark(this.name,t,{extendEmptyMarkRange:!0}).setMeta("preventAutolink",!0).run():!1},unsetLink:()=>({chain:t})=>t().unsetMark(this.name,{extendEmptyMarkRange:!0}).setMeta("preventAutolink",!0).run()}},addPasteRules(){return[nt({find:t=>{let e=[];if(t){let{protocols:n,defaultProtocol:r}=this.options,i=ea(t).filter(o=>o.isLink&&this.options.isAllowedUri(o.value,{defaultValidate:s=>!!Un(s,n),protocols:n,defaultProtocol:r}));i.length&&i.forEach(o=>e.push({text:o.value,data:{href:o.href},index:o.start}))}return e},type:this.type,getAttributes:t=>{var e;return{href:(e=t.data)==null?void 0:e.href}}})]},addProseMirrorPlugins(){let t=[],{protocols:e,defaultProtocol:n}=this.options;return this.options.autolink&&t.push(xb({type:this.type,defaultProtocol:this.options.defaultProtocol,validate:r=>this.options.isAllowedUri(r,{defaultValidate:i=>!!Un(i,e),protocols:e,defaultProtocol:n}),shouldAutoLink:this.options.shouldAutoLink})),this.options.openOnClick===!0&&t.push(Cb({type:this.type})),this.options.linkOnPaste&&t.push(vb({editor:this.editor,defaultProtocol:this.options.defaultProtocol,type:this.type})),t}});var Sb=Re.create({name:"underline",addOptions(){return{HTMLAttributes:{}}},parseHTML(){return[{tag:"u"},{style:"text-decoration",consuming:!1,getAttrs:t=>t.includes("underline")?{}:!1}]},renderHTML({HTMLAttributes:t}){return["u",$(this.options.HTMLAttributes,t),0]},addCommands(){return{setUnderline:()=>({commands:t})=>t.setMark(this.name),toggleUnderline:()=>({commands:t})=>t.toggleMark(this.name),unsetUnderline:()=>({commands:t})=>t.unsetMark(this.name)}},addKeyboardShortcuts(){return{"Mod-u":()=>this.editor.commands.toggleUnderline(),"Mod-U":()=>this.editor.commands.toggleUnderline()}}});var Or=null,ta=null,kb=new WeakMap;async function Ob(t={}){let n=Or!==null&&Object.keys(t!=null?t:{}).length===0?{}:Ol(Rl(),t!=null?t:{}),r=Object.keys(n).length>0,i=Or&&!r?Or:null,o=r?vu(n):void 0,s=i!=null?i:new $r(o);i||(Or=s,globalThis.formgenRelationships=s),Jf(s),rp(s);let a=Array.from(document.querySelectorAll("[data-formgen-auto-init]")),l=[];for(let c of a)l.push(...Lb(c,s,n));return l.length>0&&await Promise.all(l),n.links&&n.links.length>0&&(ta==null||ta(),ta=vl(n.links,(c,d,u)=>{$n(c,{values:{[d]:u}},s)})),s}function Tk(t,e){var n,r;if(!(!e||e.current==null)&&t.dataset.relationshipCurrentApplied!=="true"){if(t instanceof HTMLSelectElement){let i=Oa(e.current,t.multiple);Nb(t,i)&&(Ib(t,e.submitAs),t.dataset.relationshipCurrentApplied="true");return}if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement){let i=Oa(e.current,!1);i.length>0&&(t.value=(r=(n=i[0])==null?void 0:n.value)!=null?r:"",t.dataset.relationshipCurrentApplied="true")}}}function Lb(t,e,n){let r=Sk(t,e);ll(t),pl(t,{onItemAdded:s=>{let a=Lb(s,e,n);a.length>0&&Promise.all(a)}}),kl(t),wl(t);let i=[],o=wa(t);for(let s of o){let a=Gn(s),l=Xn(a),c=Qn(s,a);Tk(s,c),e.get(s)||e.register(s,{field:c,endpoint:l}),c.renderer==="chips"&&s instanceof HTMLSelectElement&&s.multiple&&Gf(s,e),c.renderer==="typeahead"&&s instanceof HTMLSelectElement&&!s.multiple&&ip(s,e),Jk(s,c,t,e,r),Yk(s,c,t,e,r),Gk(s,c,e,r),Xk(s,c,e,r),kk(c,l)&&i.push(e.resolve(s))}return i}function Sk(t,e){let n=kb.get(e);n||(n=new WeakMap,kb.set(e,n));let r=n.get(t);if(r&&!r.disposed)return r;let i={cleanups:new Set,disposed:!1};return n.set(t,i),e.registerRootCleanup(t,()=>{if(!i.disposed){i.disposed=!0;for(let o of i.cleanups)o();i.cleanups.clear(),rf(t),hl(t),cl(t),n==null||n.delete(t)}}),i}function Nb(t,e){let n=new Map;for(let o of e)if(!(!o.value||n.has(o.value))&&(n.set(o.value,o),!t.multiple))break;let r=new Set(n.keys()),i=!1;return Array.from(t.options).forEach(o=>{let s=r.has(o.value);if(o.selected!==s&&(o.selected=s,i=!0),s){let a=n.get(o.value);a&&o.textContent!==a.label&&(o.textContent=a.label,i=!0),r.delete(o.value)}}),r.forEach(o=>{var a,l;if(!o)return;let s=document.createElement("option");s.value=o,s.textContent=(l=(a=n.get(o))==null?void 0:a.label)!=null?l:o,s.selected=!0,t.appendChild(s),i=!0}),!t.multiple&&n.size===0&&t.value!==""&&(t.value="",i=!0),i}function Ib(t,e){if(e==="json"){Bt(t);return}t.multiple&&cn(t)}function kk(t,e){return!e.url||t.refreshMode==="manual"?!1:t.mode==="search"?Mk(t.current):!0}function Mk(t){return no(t).length>0}var na=new WeakMap;function wk(t=document,e={}){var s;let n=Ok(t),r=(s=e.registry)!=null?s:Or,i=!1,o={root:n,getValues(a={}){var l,c;return i?{}:Mb(n,{includeHidden:(c=(l=a.includeHidden)!=null?l:e.includeHidden)!=null?c:!0})},setValues(a){i||$n(n,{values:a},r)},reset(){var l;if(i)return;Lk(n);let a=Mb(n,{includeHidden:(l=e.includeHidden)!=null?l:!0});$n(n,{values:a},r),$n(n,{errors:wb(n)},r)},setErrors(a){i||$n(n,{errors:a},r)},applyServerErrors(a,l){if(i)return null;let c=xl(Np(n),a,l);return c&&Object.keys(c.fields).length>0&&o.setErrors(c.fields),c},clearErrors(a){if(i)return;let l=wb(n,a);$n(n,{errors:l},r)},onChange(a){var d;if(i)return()=>{};let l=u=>{a(o.getValues(),u)};n.addEventListener("input",l,!0),n.addEventListener("change",l,!0);let c=()=>{n.removeEventListener("input",l,!0),n.removeEventListener("change",l,!0)};return(d=na.get(o))==null||d.push(c),c},focus(a){if(i)return!1;let l=Dk(n,a);return!l||typeof l.focus!="function"?!1:(l.focus(),!0)},destroy(){var l;if(i)return;i=!0,((l=na.get(o))!=null?l:[]).forEach(c=>c()),na.delete(o),r==null||r.destroy(n)}};return na.set(o,[]),o}var Ak={attach:wk,hydrate:Rk};typeof globalThis!="undefined"&&(globalThis.Formgen=Ak);function Rk(t=document,e={}){$n(t,e,Or)}function $n(t,e,n){let r=t!=null?t:document,i=Hk(r);if(i.length===0)return;let o=Rb(e.values),s=Rb(e.errors);i.forEach(a=>{Bk(a,o,n),$k(a,s,n)})}function Ok(t){if(typeof t=="string"){let e=document.querySelector(t);if(!e)throw new Error(`formgen: controller root not found for selector ${t}`);return e}return t!=null?t:document}function Mb(t,e){let n={};return oa(t).forEach(r=>{if(!Nk(r,e))return;let i=Xd(r);if(!i)return;let o=Ik(r);o!==void 0&&Pk(n,Lr(an(i)),o)}),n}function oa(t){let e=new Set;return(t instanceof HTMLInputElement||t instanceof HTMLSelectElement||t instanceof HTMLTextAreaElement)&&e.add(t),t.querySelectorAll("input, select, textarea").forEach(n=>e.add(n)),Array.from(e)}function Lk(t){oa(t).forEach(e=>{if(e instanceof HTMLInputElement){let n=e.type.toLowerCase();n==="checkbox"||n==="radio"?e.checked=e.defaultChecked:n!=="file"&&(e.value=e.defaultValue);return}if(e instanceof HTMLTextAreaElement){e.value=e.defaultValue;return}Array.from(e.options).forEach(n=>{n.selected=n.defaultSelected}),!e.multiple&&e.selectedIndex<0&&e.options.length>0&&(e.selectedIndex=0)})}function Nk(t,e){if(t.disabled)return!1;if(t instanceof HTMLInputElement){if(t.closest("[data-relationship-hidden]"))return!1;let n=t.type.toLowerCase();if(["button","submit","reset","file"].includes(n)||n==="hidden"&&e.includeHidden===!1)return!1}return!!Xd(t)}function Xd(t){return(t.getAttribute("name")||t.getAttribute(zr)||t.getAttribute("data-field-path")||t.dataset.fieldName||t.id||"").trim()}function Ik(t){if(t instanceof HTMLSelectElement)return t.multiple?Array.from(t.selectedOptions).map(e=>e.value):t.value;if(t instanceof HTMLInputElement){let e=t.type.toLowerCase();if(e==="checkbox")return t.name.endsWith("[]")?t.checked?[t.value]:void 0:t.checked;if(e==="radio")return t.checked?t.value:void 0}return t.value}function Pk(t,e,n){let r=_i(e);if(r.length===0)return;let i=t;r.forEach((o,s)=>{if(s===r.length-1){let a=Object.prototype.hasOwnProperty.call(i,o)?i[o]:void 0;Array.isArray(a)&&Array.isArray(n)?i[o]=a.concat(n):i[o]=n;return}(!Object.prototype.hasOwnProperty.call(i,o)||!i[o]||typeof i[o]!="object"||Array.isArray(i[o]))&&(i[o]={}),i=i[o]})}function wb(t,e){let n={};return(e&&e.length>0?e:oa(t).map(Xd)).forEach(i=>{let o=i.trim(),s=Lr(an(o));_i(s).length>0&&(n[s]=[])}),n}function Dk(t,e){let n=new Set,r=e.trim();if(!r)return null;let i=Lr(an(r));if(_i(i).length===0)return null;[r,i,ra(i),`${ra(i)}[]`].forEach(o=>{o&&n.add(o)});for(let o of oa(t))if(Db(o).some(a=>n.has(a)))return o;return null}function Hk(t){let e=new Set,n=(t instanceof Document,t);return t instanceof HTMLElement&&Ab(t)&&e.add(t),n.querySelectorAll("input, select, textarea, [data-field-name], [data-field-path]").forEach(i=>{Ab(i)&&e.add(i)}),wa(n).forEach(i=>e.add(i)),Array.from(e)}function Ab(t){return t instanceof HTMLInputElement?t.type==="hidden"?t.hasAttribute("data-relationship-hidden")||t.hasAttribute("data-relationship-json")?!1:!!(t.dataset.fieldName||t.dataset.fieldPath):!0:t instanceof HTMLSelectElement||t instanceof HTMLTextAreaElement}function Rb(t){let e=new Map;if(!t)return e;let n=new Map;return Pb(t,n,""),n.forEach((r,i)=>{Fk(e,i,r)}),e}function Pb(t,e,n){Object.entries(t).forEach(([r,i])=>{let o=r.trim();if(!o)return;let s=n?`${n}.${o}`:o;if(_i(s).length!==0){if(i&&typeof i=="object"&&!Array.isArray(i)){Pb(i,e,s);return}e.set(s,i)}})}function Fk(t,e,n){let r=new Set,i=e.trim();if(!i)return;let o=an(i),s=Lr(o),a=ra(s);[i,o,s,a].forEach(l=>{l&&r.add(l)}),Array.isArray(n)&&[i,o,s,a].forEach(l=>{l&&r.add(`${l}[]`)}),r.forEach(l=>{l&&t.set(l,n)})}function an(t){return t.endsWith("[]")?t.slice(0,-2):t}function Lr(t){return t.includes("[")?t.replace(/\[\]/g,"").replace(/\[([^\]]+)\]/g,".$1").replace(/^\./,""):t.replace(/\[\]/g,"")}var _k=new Set(["__proto__","constructor","prototype"]);function _i(t){let n=Lr(an(t)).split(".").map(r=>r.trim()).filter(Boolean);return n.length===0||n.some(r=>_k.has(r))?[]:n}function ra(t){if(!t.includes("."))return t;let e=t.split(".").filter(Boolean);if(e.length===0)return t;let[n,...r]=e;return`${n}${r.map(i=>`[${i}]`).join("")}`}function Db(t){let e=new Set;return[t.getAttribute("name"),t.getAttribute(zr),t.dataset.fieldName,t.getAttribute("data-field-path"),t.id].forEach(r=>{if(!r)return;let i=Lr(an(r));_i(i).length!==0&&(e.add(r),e.add(an(r)),e.add(i),e.add(an(i)),e.add(ra(i)))}),Array.from(e).filter(Boolean)}function Hb(t,e){let n=Db(e);for(let r of n)if(t.has(r))return{found:!0,value:t.get(r)};return{found:!1,value:void 0}}function Bk(t,e,n){var s;let r=Hb(e,t);if(!r.found)return;let i=jk(r.value,t);if(t instanceof HTMLSelectElement){let a=Array.isArray(i)?i:i!=null?[i]:[],l=Nb(t,a.map(d=>({value:d,label:d}))),c=t.getAttribute("data-relationship-submit-mode")==="json"||t.dataset.endpointSubmitAs==="json"?"json":void 0;l&&(Ib(t,c),ye(t,{kind:"selection",origin:"hydrate",selectedValues:Array.from(t.selectedOptions).map(d=>d.value).filter(d=>d!=="")}),t.dispatchEvent(new Event("change",{bubbles:!0})))}else t instanceof HTMLInputElement?zk(t,r.value,i):t instanceof HTMLTextAreaElement&&(Array.isArray(i)?t.value=(s=i[0])!=null?s:"":i==null?t.value="":t.value=i,t.dispatchEvent(new Event("input",{bubbles:!0})));Wk(t,i);let o=n==null?void 0:n.get(t);o&&o.setCurrentValue(i)}function zk(t,e,n){var i;let r=t.type.toLowerCase();if(r==="checkbox"){t.checked=Vk(t,e,n),t.dispatchEvent(new Event("input",{bubbles:!0})),t.dispatchEvent(new Event("change",{bubbles:!0}));return}if(r==="radio"){t.checked=Uk(t,e,n),t.dispatchEvent(new Event("change",{bubbles:!0}));return}Array.isArray(n)?t.value=(i=n[0])!=null?i:"":n==null?t.value="":t.value=n,t.dispatchEvent(new Event("input",{bubbles:!0}))}function Vk(t,e,n){if(Array.isArray(e))return e.map(ia).includes(t.value);if(Array.isArray(n))return n.includes(t.value);if(typeof e=="boolean")return e;if(typeof e=="number")return e!==0;if(typeof e=="string"){let r=e.trim().toLowerCase();if(["true","1","yes","on"].includes(r))return!0;if(["false","0","no","off",""].includes(r))return!1}return n!=null&&n===t.value}function Uk(t,e,n){return Array.isArray(e)?e.map(ia).includes(t.value):Array.isArray(n)?n.includes(t.value):n!=null&&n===t.value}function $k(t,e,n){let r=Hb(e,t);if(!r.found)return;let i=Kk(r.value),o=n==null?void 0:n.get(t);if(i.length===0){je(t),t.removeAttribute("data-validation-state"),t.removeAttribute("data-validation-message"),o==null||o.setServerValidation(void 0);return}let s=i[0];ht(t,s),t.setAttribute("data-validation-state","invalid"),t.setAttribute("data-validation-message",i.join("; ")),o==null||o.setServerValidation({valid:!1,messages:i,errors:i.map(a=>({code:"server",message:a}))})}function jk(t,e){var r;if(t==null)return null;if(Array.isArray(t)){let i=t.map(o=>ia(o)).filter(o=>typeof o=="string"&&o!=="");return e instanceof HTMLSelectElement&&e.multiple?i:(r=i[0])!=null?r:null}let n=ia(t);return n!=null?n:null}function ia(t){if(t==null)return null;if(typeof t=="object"){let e=t;for(let n of["value","id","slug"]){let r=e[n];if(r!=null)return String(r)}return null}return String(t)}function Wk(t,e){if(e==null||Array.isArray(e)&&e.length===0){t.removeAttribute("data-relationship-current");return}let n=qk(e);n&&t.setAttribute("data-relationship-current",n)}function qk(t){if(t==null)return;if(Array.isArray(t)){if(t.length===0)return;try{return JSON.stringify(t)}catch{return}}return String(t).trim()||void 0}function Kk(t){if(Array.isArray(t))return t.map(e=>typeof e=="string"?e.trim():"").filter(e=>e.length>0);if(typeof t=="string"){let e=t.trim();return e.includes(";")?e.split(";").map(n=>n.trim()).filter(n=>n.length>0):e?[e]:[]}return[]}function Jk(t,e,n,r,i){var c,d;if(!e.refreshOn||e.refreshOn.length===0||e.refreshMode==="manual"||t.dataset.relationshipRefreshBound==="true")return;t.dataset.relationshipRefreshBound="true";let o=t.closest("form"),s=(d=(c=o!=null?o:n)!=null?c:t.ownerDocument)!=null?d:document,a=()=>{r.resolve(t).catch(()=>{})},l=[];e.refreshOn.forEach(u=>{Qk(s,u).forEach(p=>{let h=p instanceof HTMLInputElement&&p.type==="text"?"input":"change";p.addEventListener(h,a),l.push({target:p,eventType:h})})}),i.cleanups.add(()=>{for(let u of l)u.target.removeEventListener(u.eventType,a);delete t.dataset.relationshipRefreshBound})}function Gk(t,e,n,r){var s;let i=(s=e.refreshIntervalMs)!=null?s:0;if(!(i>0)||e.mode==="search"||t.dataset.relationshipIntervalBound==="true")return;t.dataset.relationshipIntervalBound="true";let o=setInterval(()=>{var l;if(!t.isConnected){clearInterval(o);return}((l=t.ownerDocument)!=null?l:document).visibilityState==="hidden"||t.getAttribute("data-state")==="loading"||n.refresh(t).catch(()=>{})},i);r.cleanups.add(()=>{clearInterval(o),delete t.dataset.relationshipIntervalBound})}function Yk(t,e,n,r,i){var f,p,h,m;if(e.refreshMode!=="manual")return;let o=(f=e.name)!=null?f:t.getAttribute("id");if(!o||t.dataset.relationshipManualBound==="true")return;t.dataset.relationshipManualBound="true";let s=eM(o),a=(h=(p=n.ownerDocument)!=null?p:t.ownerDocument)!=null?h:document,l=new Set;[`[data-endpoint-refresh-target="${s}"]`,`[data-endpoint-refresh-for="${s}"]`].forEach(g=>{a.querySelectorAll(g).forEach(y=>l.add(y))});let d=(m=t.closest("[data-relationship-type]"))!=null?m:t.parentElement;d==null||d.querySelectorAll("[data-endpoint-refresh-trigger]").forEach(g=>l.add(g));let u=[];l.forEach(g=>{if(g.dataset.relationshipRefreshListener==="true")return;g.dataset.relationshipRefreshListener="true";let y=E=>{E.preventDefault(),r.resolve(t).catch(()=>{})};g.addEventListener("click",y),u.push({trigger:g,handler:y})}),i.cleanups.add(()=>{for(let g of u)g.trigger.removeEventListener("click",g.handler),delete g.trigger.dataset.relationshipRefreshListener;delete t.dataset.relationshipManualBound})}function Xk(t,e,n,r){var u,f;if(e.mode!=="search"||t.dataset.relationshipSearchBound==="true")return;t.dataset.relationshipSearchBound="true";let i=n.getConfig(),o=(u=e.throttleMs)!=null?u:i.searchThrottleMs,s=(f=e.debounceMs)!=null?f:i.searchDebounceMs,l=nf(()=>{n.resolve(t).catch(()=>{})},s);l=tf(l,o);let c=()=>{if(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement){let p=t.value.trim();t.setAttribute("data-endpoint-search-value",p)}},d=()=>{c(),l()};t.addEventListener("input",d),(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement)&&t.addEventListener("change",d),r.cleanups.add(()=>{t.removeEventListener("input",d),(t instanceof HTMLInputElement||t instanceof HTMLTextAreaElement)&&t.removeEventListener("change",d),l.cancel(),delete t.dataset.relationshipSearchBound})}function Qk(t,e){var a;let n=[],r=new Set,i=t instanceof HTMLFormElement?t:t instanceof HTMLElement?t.closest("form"):null,o=t instanceof Document?t:(a=t.ownerDocument)!=null?a:document,s=[];return i?Array.from(i.elements).forEach(c=>{c instanceof HTMLElement&&s.push(c)}):s.push(...Array.from(o.querySelectorAll("[name], [data-field-name], [id]"))),s.forEach(l=>{if(r.has(l))return;let c=l.dataset.fieldName;if(c&&c===e){n.push(l),r.add(l);return}let d=l.name;if(Zk(d,e)){n.push(l),r.add(l);return}let u=l.id;u&&u===e&&(n.push(l),r.add(l))}),n}function Zk(t,e){if(!t)return!1;if(t===e||t===`${e}[]`||t.endsWith(`[${e}]`)||t.endsWith(`[${e}][]`))return!0;let n=t.replace(/[^a-z0-9]/gi,"").toLowerCase(),r=e.replace(/[^a-z0-9]/gi,"").toLowerCase();return n.endsWith(r)}function eM(t){return typeof CSS!="undefined"&&typeof CSS.escape=="function"?CSS.escape(t):t.replace(/(["\\])/g,"\\$1")}var Fb="formgen:relationship:success",_b="formgen:relationship:error",Bb="formgen:relationship:loading",zb="formgen:relationship:validation";function tM(t){let[e,n]=ga(null),[r,i]=ga({options:[],loading:!1,error:null,validation:null});return ya(()=>{if(!t)return;let o=!1,s=null;return(async()=>{let l=await Ob();if(o)return;s=l,n(l);let c=t.getAttribute("data-state");if(c==="ready"&&t instanceof HTMLSelectElement){let d=Array.from(t.options).filter(u=>u.value!=="").map(u=>({value:u.value,label:u.textContent||u.value}));i({options:d,loading:!1,error:null,validation:null})}else if(c==="error")i(d=>({...d,loading:!1,error:new Error("Failed to load options")}));else if(c!=="loading"){i(d=>({...d,loading:!0}));try{await l.resolve(t)}catch(d){o||i(u=>({...u,error:d,loading:!1}))}}})().catch(l=>{o||i(c=>({...c,error:l,loading:!1}))}),()=>{o=!0}},[t]),ya(()=>{if(!t||!e)return;let o=c=>{c.detail.element===t&&i(u=>({...u,loading:!0,error:null}))},s=c=>{let d=c.detail;d.element===t&&i(u=>{var f;return{...u,options:(f=d.options)!=null?f:[],loading:!1,error:null}})},a=c=>{let d=c.detail;d.element===t&&i(u=>{var f;return{...u,loading:!1,error:(f=d.error)!=null?f:null}})},l=c=>{let d=c.detail;d.element===t&&i(u=>{var f;return{...u,validation:(f=d.validation)!=null?f:null}})};return t.addEventListener(Bb,o),t.addEventListener(Fb,s),t.addEventListener(_b,a),t.addEventListener(zb,l),()=>{t.removeEventListener(Bb,o),t.removeEventListener(Fb,s),t.removeEventListener(_b,a),t.removeEventListener(zb,l)}},[t,e]),{options:r.options,loading:r.loading,error:r.error,validation:r.validation,refresh:async()=>{!t||!e||await e.resolve(t)}}}return Kb(nM);})();
 //# sourceMappingURL=preact.min.js.map
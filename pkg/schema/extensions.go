@@ -0,0 +1,145 @@
+package schema
+
+import "strings"
+
+// DefaultExtensionNamespace is the vendor extension prefix go-formgen reads UI
+// metadata from. Custom namespaces are rewritten onto it before model building.
+const DefaultExtensionNamespace = "x-formgen"
+
+// ExtensionNamespaceOrder returns the namespaces consulted when reading UI
+// extensions, highest precedence first. DefaultExtensionNamespace is appended
+// as the lowest-precedence fallback unless it is listed explicitly.
+func ExtensionNamespaceOrder(namespaces []string) []string {
+	order := make([]string, 0, len(namespaces)+1)
+	seen := make(map[string]struct{}, len(namespaces)+1)
+	for _, namespace := range namespaces {
+		namespace = strings.TrimSpace(namespace)
+		if namespace == "" {
+			continue
+		}
+		if _, ok := seen[namespace]; ok {
+			continue
+		}
+		seen[namespace] = struct{}{}
+		order = append(order, namespace)
+	}
+	if _, ok := seen[DefaultExtensionNamespace]; !ok {
+		order = append(order, DefaultExtensionNamespace)
+	}
+	return order
+}
+
+// CanonicalExtensions rewrites extensions declared under any of the supplied
+// namespaces onto DefaultExtensionNamespace. Object forms (`x-acme-ui: {...}`)
+// are merged key by key and flat forms (`x-acme-ui-widget`,
+// `x-acme-ui.widget`) are renamed, with earlier namespaces winning over later
+// ones. Keys outside the namespaces are copied unchanged. The input map is
+// never mutated; it is returned as-is when no custom namespace is configured.
+func CanonicalExtensions(ext map[string]any, namespaces []string) map[string]any {
+	if len(ext) == 0 {
+		return ext
+	}
+	order := ExtensionNamespaceOrder(namespaces)
+	if len(order) == 1 {
+		return ext
+	}
+
+	result := make(map[string]any, len(ext))
+	for key, value := range ext {
+		if _, _, ok := matchExtensionNamespace(key, order); !ok {
+			result[key] = value
+		}
+	}
+	for idx := len(order) - 1; idx >= 0; idx-- {
+		namespace := order[idx]
+		for key, value := range ext {
+			owner, suffix, ok := matchExtensionNamespace(key, order)
+			if !ok || owner != namespace {
+				continue
+			}
+			if suffix != "" {
+				result[DefaultExtensionNamespace+suffix] = value
+				continue
+			}
+			nested, isMap := value.(map[string]any)
+			if !isMap {
+				result[DefaultExtensionNamespace] = value
+				continue
+			}
+			merged := cloneExtensionMap(nested)
+			if existing, ok := result[DefaultExtensionNamespace].(map[string]any); ok {
+				merged = cloneExtensionMap(existing)
+				for nestedKey, nestedValue := range nested {
+					merged[nestedKey] = nestedValue
+				}
+			}
+			result[DefaultExtensionNamespace] = merged
+		}
+	}
+	return result
+}
+
+// CanonicalSchemaExtensions applies CanonicalExtensions to the schema and all
+// of its nested properties, items, and composition branches.
+func CanonicalSchemaExtensions(input Schema, namespaces []string) Schema {
+	if len(ExtensionNamespaceOrder(namespaces)) == 1 {
+		return input
+	}
+	output := input
+	output.Extensions = CanonicalExtensions(input.Extensions, namespaces)
+	if len(input.Properties) > 0 {
+		output.Properties = make(map[string]Schema, len(input.Properties))
+		for name, property := range input.Properties {
+			output.Properties[name] = CanonicalSchemaExtensions(property, namespaces)
+		}
+	}
+	if input.Items != nil {
+		items := CanonicalSchemaExtensions(*input.Items, namespaces)
+		output.Items = &items
+	}
+	output.OneOf = canonicalSchemaList(input.OneOf, namespaces)
+	output.AnyOf = canonicalSchemaList(input.AnyOf, namespaces)
+	output.AllOf = canonicalSchemaList(input.AllOf, namespaces)
+	return output
+}
+
+func canonicalSchemaList(list []Schema, namespaces []string) []Schema {
+	if len(list) == 0 {
+		return list
+	}
+	output := make([]Schema, len(list))
+	for idx, item := range list {
+		output[idx] = CanonicalSchemaExtensions(item, namespaces)
+	}
+	return output
+}
+
+// matchExtensionNamespace returns the longest namespace owning key along with
+// the remaining separator-prefixed suffix ("" for the bare object form).
+func matchExtensionNamespace(key string, order []string) (string, string, bool) {
+	var (
+		owner  string
+		suffix string
+		found  bool
+	)
+	for _, namespace := range order {
+		if found && len(namespace) <= len(owner) {
+			continue
+		}
+		switch {
+		case key == namespace:
+			owner, suffix, found = namespace, "", true
+		case strings.HasPrefix(key, namespace+"-"), strings.HasPrefix(key, namespace+"."):
+			owner, suffix, found = namespace, key[len(namespace):], true
+		}
+	}
+	return owner, suffix, found
+}
+
+func cloneExtensionMap(in map[string]any) map[string]any {
+	out := make(map[string]any, len(in))
+	for key, value := range in {
+		out[key] = value
+	}
+	return out
+}
@@ -2,8 +2,10 @@ package schema
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
+	"sync"
 )
 
 // NormalizeOptions supplies optional hints to adapters during normalization.
@@ -27,19 +29,124 @@ type Form struct {
 	Summary     string
 	Description string
 	Schema      Schema
+	Parameters  []Parameter
 	Responses   map[string]Schema
 	Extensions  map[string]any
+	// Security lists alternative requirements for calling the endpoint; any
+	// one must be satisfied. An empty requirement permits anonymous access.
+	Security []SecurityRequirement
+	// SecuritySchemes describes the schemes named in Security.
+	SecuritySchemes map[string]SecurityScheme
+	// MediaType is the request body media type Schema was taken from.
+	MediaType string
+	// MediaTypes holds every request body variant keyed by media type when the
+	// operation declares more than one. See WithMediaType.
+	MediaTypes map[string]Schema
+	// Tags groups the form with related ones (OpenAPI operation tags).
+	Tags []string
+
+	// LazyResponses builds the response schemas on first use when Responses
+	// is nil. Set it with WithLazyResponses and read through ResponseSchemas.
+	LazyResponses func() map[string]Schema `json:"-"`
+}
+
+// WithLazyResponses returns a copy of the form whose response schemas are
+// built by resolve on the first ResponseSchemas call. Adapters use it to skip
+// converting responses of forms that are never built.
+func (f Form) WithLazyResponses(resolve func() map[string]Schema) Form {
+	f.Responses = nil
+	f.LazyResponses = sync.OnceValue(resolve)
+	return f
+}
+
+// ResponseSchemas returns the response schemas keyed by status code, resolving
+// lazy ones (see WithLazyResponses) once. Read responses through it rather
+// than the Responses field.
+func (f Form) ResponseSchemas() map[string]Schema {
+	if f.Responses != nil || f.LazyResponses == nil {
+		return f.Responses
+	}
+	return f.LazyResponses()
+}
+
+// WithMediaType returns a copy of the form whose Schema is the request body
+// variant declared for mediaType. Matching ignores case and media type
+// parameters (e.g. "; charset=utf-8"). Selecting the current media type always
+// succeeds.
+func (f Form) WithMediaType(mediaType string) (Form, error) {
+	want := normalizeMediaType(mediaType)
+	if want == "" || want == normalizeMediaType(f.MediaType) {
+		return f, nil
+	}
+	for candidate, variant := range f.MediaTypes {
+		if normalizeMediaType(candidate) == want {
+			f.Schema = variant
+			f.MediaType = candidate
+			return f, nil
+		}
+	}
+	available := make([]string, 0, len(f.MediaTypes))
+	for candidate := range f.MediaTypes {
+		available = append(available, candidate)
+	}
+	if len(available) == 0 && f.MediaType != "" {
+		available = append(available, f.MediaType)
+	}
+	sort.Strings(available)
+	return f, fmt.Errorf("schema: form %q does not accept media type %q (available: %s)", f.ID, mediaType, strings.Join(available, ", "))
+}
+
+func normalizeMediaType(mediaType string) string {
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+	return strings.ToLower(strings.TrimSpace(mediaType))
+}
+
+// SecurityRequirement maps scheme names to the scopes they require. Every
+// scheme in a requirement must be satisfied.
+type SecurityRequirement map[string][]string
+
+// SecurityScheme captures how a client authenticates with a named scheme.
+type SecurityScheme struct {
+	// Type is the OpenAPI scheme type: http, apiKey, oauth2, openIdConnect, or
+	// mutualTLS.
+	Type string
+	// Scheme is the HTTP authorization scheme (e.g. bearer, basic).
+	Scheme       string
+	BearerFormat string
+	// In and Name locate apiKey credentials (header, query, or cookie).
+	In          string
+	Name        string
+	Description string
+}
+
+// Parameter locations understood by form model builders.
+const (
+	ParameterInPath   = "path"
+	ParameterInQuery  = "query"
+	ParameterInHeader = "header"
+)
+
+// Parameter describes a non-body operation input (path, query, or header).
+type Parameter struct {
+	Name        string
+	In          string
+	Required    bool
+	Description string
+	Schema      Schema
 }
 
 // Schema represents the canonical schema IR consumed by form model builders.
 type Schema struct {
-	Ref              string
-	Type             string
-	Format           string
-	Title            string
-	Description      string
-	Default          any
-	ReadOnly         bool
+	Ref         string
+	Type        string
+	Format      string
+	Title       string
+	Description string
+	Default     any
+	ReadOnly    bool
+	// Nullable reports that null is an accepted value (OpenAPI 3.0
+	// nullable, or "null" in a type array or anyOf).
+	Nullable         bool
 	Enum             []any
 	Const            any
 	Required         []string
@@ -58,6 +165,22 @@ type Schema struct {
 	MaxItems         *int
 	Pattern          string
 	Extensions       map[string]any `json:"Extensions,omitempty"`
+	// Encoding carries the request body media type `encoding` entry for a
+	// top-level property of a multipart or urlencoded body.
+	Encoding *Encoding `json:"Encoding,omitempty"`
+}
+
+// Encoding describes how a single body property is serialized as a multipart
+// or urlencoded part.
+type Encoding struct {
+	// ContentType is the part content type; it may list several types or use
+	// wildcards (e.g. "image/png, image/jpeg").
+	ContentType string
+	// Headers lists the names of additional part headers, sorted.
+	Headers       []string
+	Style         string
+	Explode       *bool
+	AllowReserved bool
 }
 
 // SchemaIR is the normalized schema set produced by adapters.
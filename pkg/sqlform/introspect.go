@@ -0,0 +1,203 @@
+package sqlform
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Introspection reads the standard information_schema views and targets
+// PostgreSQL-compatible databases. Other databases (or ORM model registries)
+// can assemble []Table directly and call Build.
+const (
+	columnsQuery = `SELECT table_schema, table_name, column_name, data_type, is_nullable,
+	COALESCE(column_default, ''), COALESCE(character_maximum_length, 0),
+	COALESCE(is_identity, 'NO'), COALESCE(is_generated, 'NEVER')
+FROM information_schema.columns
+ORDER BY table_schema, table_name, ordinal_position`
+
+	tablesQuery = `SELECT table_schema, table_name
+FROM information_schema.tables
+WHERE table_type = 'BASE TABLE'`
+
+	keysQuery = `SELECT tc.table_schema, tc.table_name, tc.constraint_name, tc.constraint_type,
+	kcu.column_name, COALESCE(ccu.table_schema, ''), COALESCE(ccu.table_name, ''), COALESCE(ccu.column_name, '')
+FROM information_schema.table_constraints tc
+JOIN information_schema.key_column_usage kcu
+	ON kcu.constraint_schema = tc.constraint_schema AND kcu.constraint_name = tc.constraint_name
+LEFT JOIN information_schema.referential_constraints rc
+	ON rc.constraint_schema = tc.constraint_schema AND rc.constraint_name = tc.constraint_name
+LEFT JOIN information_schema.key_column_usage ccu
+	ON ccu.constraint_schema = rc.unique_constraint_schema AND ccu.constraint_name = rc.unique_constraint_name
+	AND ccu.ordinal_position = kcu.position_in_unique_constraint
+WHERE tc.constraint_type IN ('PRIMARY KEY', 'FOREIGN KEY')
+ORDER BY tc.table_schema, tc.table_name, tc.constraint_name, kcu.ordinal_position`
+)
+
+var systemSchemas = map[string]bool{
+	"information_schema": true,
+	"pg_catalog":         true,
+	"pg_toast":           true,
+}
+
+// IntrospectOption customises Introspect.
+type IntrospectOption func(*introspectOptions)
+
+type introspectOptions struct {
+	schemas map[string]bool
+	tables  map[string]bool
+}
+
+// WithSchemas limits introspection to the named schemas. By default every
+// non-system schema is read.
+func WithSchemas(schemas ...string) IntrospectOption {
+	return func(o *introspectOptions) {
+		o.schemas = nameSet(o.schemas, schemas)
+	}
+}
+
+// WithTables limits introspection to the named tables.
+func WithTables(tables ...string) IntrospectOption {
+	return func(o *introspectOptions) {
+		o.tables = nameSet(o.tables, tables)
+	}
+}
+
+func nameSet(set map[string]bool, names []string) map[string]bool {
+	if set == nil {
+		set = map[string]bool{}
+	}
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			set[name] = true
+		}
+	}
+	return set
+}
+
+// Introspect reads base tables, their columns, primary keys, and foreign keys
+// from db. Tables are returned sorted by schema and name; views are skipped.
+func Introspect(ctx context.Context, db *sql.DB, opts ...IntrospectOption) ([]Table, error) {
+	if db == nil {
+		return nil, errors.New("sqlform: database is nil")
+	}
+	cfg := introspectOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	include := func(schemaName, table string) bool {
+		if cfg.schemas != nil {
+			if !cfg.schemas[schemaName] {
+				return false
+			}
+		} else if systemSchemas[schemaName] || strings.HasPrefix(schemaName, "pg_") {
+			return false
+		}
+		return cfg.tables == nil || cfg.tables[table]
+	}
+
+	tables := map[string]*Table{}
+	err := query(ctx, db, tablesQuery, func(rows *sql.Rows) error {
+		var schemaName, name string
+		if err := rows.Scan(&schemaName, &name); err != nil {
+			return err
+		}
+		if include(schemaName, name) {
+			tables[schemaName+"."+name] = &Table{Schema: schemaName, Name: name}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sqlform: read tables: %w", err)
+	}
+
+	err = query(ctx, db, columnsQuery, func(rows *sql.Rows) error {
+		var (
+			schemaName, tableName, nullable, identity, generated string
+			column                                               Column
+			maxLength                                            int64
+		)
+		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.DataType, &nullable,
+			&column.Default, &maxLength, &identity, &generated); err != nil {
+			return err
+		}
+		table, ok := tables[schemaName+"."+tableName]
+		if !ok {
+			return nil
+		}
+		column.Nullable = strings.EqualFold(nullable, "YES")
+		column.MaxLength = int(maxLength)
+		column.Generated = strings.EqualFold(identity, "YES") || !strings.EqualFold(generated, "NEVER")
+		table.Columns = append(table.Columns, column)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sqlform: read columns: %w", err)
+	}
+
+	err = query(ctx, db, keysQuery, func(rows *sql.Rows) error {
+		var schemaName, tableName, constraint, kind, column, refSchema, refTable, refColumn string
+		if err := rows.Scan(&schemaName, &tableName, &constraint, &kind, &column, &refSchema, &refTable, &refColumn); err != nil {
+			return err
+		}
+		table, ok := tables[schemaName+"."+tableName]
+		if !ok {
+			return nil
+		}
+		switch kind {
+		case "PRIMARY KEY":
+			for i := range table.Columns {
+				if table.Columns[i].Name == column {
+					table.Columns[i].PrimaryKey = true
+				}
+			}
+		case "FOREIGN KEY":
+			if refTable == "" {
+				return nil
+			}
+			n := len(table.ForeignKeys)
+			if n == 0 || table.ForeignKeys[n-1].Name != constraint {
+				table.ForeignKeys = append(table.ForeignKeys, ForeignKey{Name: constraint, RefSchema: refSchema, RefTable: refTable})
+				n++
+			}
+			fk := &table.ForeignKeys[n-1]
+			fk.Columns = append(fk.Columns, column)
+			fk.RefColumns = append(fk.RefColumns, refColumn)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sqlform: read keys: %w", err)
+	}
+
+	out := make([]Table, 0, len(tables))
+	for _, table := range tables {
+		out = append(out, *table)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Schema != out[j].Schema {
+			return out[i].Schema < out[j].Schema
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+func query(ctx context.Context, db *sql.DB, statement string, scan func(*sql.Rows) error) error {
+	rows, err := db.QueryContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,398 @@
+// Package sqlform builds CRUD forms from database tables, for quick admin
+// screens over an existing schema without writing OpenAPI. Table metadata
+// either comes from Introspect, which reads information_schema, or is
+// assembled by the caller (for example from ORM model metadata):
+//
+//	tables, err := sqlform.Introspect(ctx, db, sqlform.WithSchemas("public"))
+//	forms, err := sqlform.BuildAll(tables, sqlform.WithEndpoint("/admin/{table}"))
+//
+// Each table becomes a schema.Form built by the regular model builder.
+// Columns keep their ordinal order and map by data type: integer types to
+// integer, numeric and floating types to number, boolean to boolean, date,
+// time, and timestamp types to the matching string formats, uuid to a uuid
+// string, text to a textarea, json/jsonb to the JSON editor, and anything
+// else to string. Character lengths become maxLength. NOT NULL columns
+// without a default are required; generated columns (identity, serial, or
+// computed) are omitted because the database fills them.
+//
+// Single-column foreign keys become belongsTo relationships on the column,
+// with an options endpoint built from WithRelationshipEndpoint that lists the
+// referenced table and submits the referenced column.
+package sqlform
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/schema"
+	"github.com/goliatone/go-formgen/pkg/widgets"
+)
+
+const (
+	extensionNamespace     = "x-formgen"
+	relationshipsExtension = "x-relationships"
+	endpointExtension      = "x-endpoint"
+	defaultMethod          = "POST"
+	defaultEndpoint        = "/{table}"
+	defaultOptionsEndpoint = "/api/{table}"
+	defaultLabelColumn     = "name"
+)
+
+// Table describes a database table. Columns keep ordinal order.
+type Table struct {
+	Schema      string
+	Name        string
+	Comment     string
+	Columns     []Column
+	ForeignKeys []ForeignKey
+}
+
+// Column describes a table column. DataType is the database type name as
+// reported by information_schema (e.g. "character varying", "bigint").
+// Default holds the raw default expression, empty when the column has none.
+type Column struct {
+	Name       string
+	DataType   string
+	Nullable   bool
+	Default    string
+	MaxLength  int
+	PrimaryKey bool
+	Generated  bool
+	Comment    string
+}
+
+// ForeignKey links Columns to RefColumns of RefTable, position by position.
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	RefSchema  string
+	RefTable   string
+	RefColumns []string
+}
+
+// Option customises how tables are converted.
+type Option func(*options)
+
+type options struct {
+	endpoint        string
+	optionsEndpoint string
+	labelColumn     string
+	method          string
+	builder         model.Builder
+}
+
+// WithEndpoint sets the form action template; "{table}" expands to the table
+// name. Defaults to "/{table}".
+func WithEndpoint(template string) Option {
+	return func(o *options) {
+		o.endpoint = strings.TrimSpace(template)
+	}
+}
+
+// WithMethod sets the HTTP method. Defaults to POST.
+func WithMethod(method string) Option {
+	return func(o *options) {
+		o.method = strings.ToUpper(strings.TrimSpace(method))
+	}
+}
+
+// WithRelationshipEndpoint sets the options endpoint template used for
+// foreign keys; "{table}" expands to the referenced table. Defaults to
+// "/api/{table}".
+func WithRelationshipEndpoint(template string) Option {
+	return func(o *options) {
+		o.optionsEndpoint = strings.TrimSpace(template)
+	}
+}
+
+// WithLabelColumn sets the referenced-table column shown as option labels
+// for foreign keys. Defaults to "name".
+func WithLabelColumn(column string) Option {
+	return func(o *options) {
+		o.labelColumn = strings.TrimSpace(column)
+	}
+}
+
+// WithBuilder overrides the model builder used by Build and BuildAll.
+// Defaults to model.NewBuilder().
+func WithBuilder(builder model.Builder) Option {
+	return func(o *options) {
+		if builder != nil {
+			o.builder = builder
+		}
+	}
+}
+
+// Build converts table into a form model. The operation id is "create"
+// followed by the PascalCase table name (user_accounts becomes
+// "createUserAccounts").
+func Build(table Table, opts ...Option) (model.FormModel, error) {
+	cfg := resolveOptions(opts)
+	form, err := formFor(table, cfg)
+	if err != nil {
+		return model.FormModel{}, err
+	}
+	return buildForm(form, cfg)
+}
+
+// BuildAll converts every table, keyed by table name. Tables in different
+// schemas sharing a name are keyed as schema.table.
+func BuildAll(tables []Table, opts ...Option) (map[string]model.FormModel, error) {
+	cfg := resolveOptions(opts)
+	counts := make(map[string]int, len(tables))
+	for _, table := range tables {
+		counts[table.Name]++
+	}
+	out := make(map[string]model.FormModel, len(tables))
+	for _, table := range tables {
+		form, err := formFor(table, cfg)
+		if err != nil {
+			return nil, err
+		}
+		built, err := buildForm(form, cfg)
+		if err != nil {
+			return nil, err
+		}
+		key := table.Name
+		if counts[table.Name] > 1 && table.Schema != "" {
+			key = table.Schema + "." + table.Name
+		}
+		out[key] = built
+	}
+	return out, nil
+}
+
+// Form converts table into the normalized schema form the model builder
+// consumes.
+func Form(table Table, opts ...Option) (schema.Form, error) {
+	return formFor(table, resolveOptions(opts))
+}
+
+func resolveOptions(opts []Option) options {
+	cfg := options{
+		endpoint:        defaultEndpoint,
+		optionsEndpoint: defaultOptionsEndpoint,
+		labelColumn:     defaultLabelColumn,
+		method:          defaultMethod,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return cfg
+}
+
+func buildForm(form schema.Form, cfg options) (model.FormModel, error) {
+	builder := cfg.builder
+	if builder == nil {
+		builder = model.NewBuilder()
+	}
+	result, err := builder.Build(form)
+	if err != nil {
+		return model.FormModel{}, fmt.Errorf("sqlform: build form model for %s: %w", form.ID, err)
+	}
+	if err := builder.Decorate(&result); err != nil {
+		return model.FormModel{}, fmt.Errorf("sqlform: decorate form model for %s: %w", form.ID, err)
+	}
+	return result, nil
+}
+
+func formFor(table Table, cfg options) (schema.Form, error) {
+	name := strings.TrimSpace(table.Name)
+	if name == "" {
+		return schema.Form{}, errors.New("sqlform: table name is required")
+	}
+
+	references := make(map[string]ForeignKey, len(table.ForeignKeys))
+	for _, fk := range table.ForeignKeys {
+		// Composite keys cannot be expressed as a single-field relationship.
+		if len(fk.Columns) == 1 && len(fk.RefColumns) == 1 && fk.RefTable != "" {
+			references[fk.Columns[0]] = fk
+		}
+	}
+
+	body := schema.Schema{Type: "object", Properties: map[string]schema.Schema{}}
+	order := 0
+	for _, column := range table.Columns {
+		if column.Generated || isGeneratedDefault(column.Default) {
+			continue
+		}
+		if _, exists := body.Properties[column.Name]; exists {
+			return schema.Form{}, fmt.Errorf("sqlform: %s.%s: duplicate column", name, column.Name)
+		}
+		prop := columnSchema(column)
+		if fk, ok := references[column.Name]; ok {
+			applyForeignKey(&prop, column, fk, cfg)
+		}
+		formgenExtension(&prop)["order"] = order
+		order++
+		body.Properties[column.Name] = prop
+		if !column.Nullable && strings.TrimSpace(column.Default) == "" {
+			body.Required = append(body.Required, column.Name)
+		}
+	}
+	if len(body.Properties) == 0 {
+		return schema.Form{}, fmt.Errorf("sqlform: %s: no editable columns", name)
+	}
+
+	return schema.Form{
+		ID:          "create" + pascalCase(name),
+		Method:      cfg.method,
+		Endpoint:    strings.ReplaceAll(cfg.endpoint, "{table}", name),
+		Summary:     humanize(name),
+		Description: table.Comment,
+		Schema:      body,
+	}, nil
+}
+
+func columnSchema(column Column) schema.Schema {
+	dataType := strings.ToLower(strings.TrimSpace(column.DataType))
+	prop := schema.Schema{Type: "string", Description: column.Comment}
+	switch {
+	case isIntegerType(dataType):
+		prop.Type = "integer"
+	case dataType == "numeric" || dataType == "decimal" || dataType == "real" || dataType == "money" ||
+		strings.HasPrefix(dataType, "double") || strings.HasPrefix(dataType, "float"):
+		prop.Type = "number"
+	case dataType == "boolean" || dataType == "bool":
+		prop.Type = "boolean"
+	case dataType == "date":
+		prop.Format = "date"
+	case strings.HasPrefix(dataType, "timestamp") || dataType == "datetime":
+		prop.Format = "date-time"
+	case strings.HasPrefix(dataType, "time"):
+		prop.Format = "time"
+	case dataType == "uuid":
+		prop.Format = "uuid"
+	case dataType == "json" || dataType == "jsonb":
+		formgenExtension(&prop)["widget"] = widgets.WidgetJSONEditor
+	case dataType == "text" || strings.HasSuffix(dataType, "text"):
+		formgenExtension(&prop)["widget"] = "textarea"
+	case dataType == "bytea" || strings.HasSuffix(dataType, "blob") || strings.HasSuffix(dataType, "binary"):
+		prop.Format = "byte"
+	}
+	if column.MaxLength > 0 && prop.Type == "string" && prop.Format == "" {
+		maxLength := column.MaxLength
+		prop.MaxLength = &maxLength
+	}
+	if value, ok := parseDefault(prop.Type, column.Default); ok {
+		prop.Default = value
+	}
+	return prop
+}
+
+func isIntegerType(dataType string) bool {
+	switch dataType {
+	case "smallint", "integer", "int", "bigint", "tinyint", "mediumint",
+		"int2", "int4", "int8", "serial", "smallserial", "bigserial":
+		return true
+	}
+	return false
+}
+
+func applyForeignKey(prop *schema.Schema, column Column, fk ForeignKey, cfg options) {
+	if prop.Extensions == nil {
+		prop.Extensions = map[string]any{}
+	}
+	prop.Extensions[relationshipsExtension] = map[string]any{
+		"type":        "belongsTo",
+		"target":      fk.RefTable,
+		"foreignKey":  column.Name,
+		"cardinality": "one",
+	}
+	prop.Extensions[endpointExtension] = map[string]any{
+		"url":        strings.ReplaceAll(cfg.optionsEndpoint, "{table}", fk.RefTable),
+		"method":     "GET",
+		"labelField": cfg.labelColumn,
+		"valueField": fk.RefColumns[0],
+	}
+}
+
+// isGeneratedDefault reports defaults that draw from a sequence, which marks
+// serial-style surrogate keys.
+func isGeneratedDefault(def string) bool {
+	def = strings.ToLower(strings.TrimSpace(def))
+	return strings.HasPrefix(def, "nextval(") || def == "auto_increment"
+}
+
+// parseDefault converts literal column defaults ("0", "'draft'::text",
+// "true"); expressions such as now() are left to the database.
+func parseDefault(kind, raw string) (any, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.EqualFold(raw, "null") {
+		return nil, false
+	}
+	if cast := strings.Index(raw, "::"); cast > 0 {
+		raw = raw[:cast]
+	}
+	raw = strings.Trim(raw, "()")
+	quoted := len(raw) >= 2 && raw[0] == '\'' && raw[len(raw)-1] == '\''
+	if quoted {
+		raw = strings.ReplaceAll(raw[1:len(raw)-1], "''", "'")
+	}
+	switch kind {
+	case "integer":
+		value, err := strconv.ParseInt(raw, 10, 64)
+		return value, err == nil
+	case "number":
+		value, err := strconv.ParseFloat(raw, 64)
+		return value, err == nil
+	case "boolean":
+		switch strings.ToLower(raw) {
+		case "true", "1":
+			return true, true
+		case "false", "0":
+			return false, true
+		}
+		return nil, false
+	}
+	if !quoted {
+		return nil, false
+	}
+	return raw, true
+}
+
+func formgenExtension(prop *schema.Schema) map[string]any {
+	if prop.Extensions == nil {
+		prop.Extensions = map[string]any{}
+	}
+	ext, ok := prop.Extensions[extensionNamespace].(map[string]any)
+	if !ok {
+		ext = map[string]any{}
+		prop.Extensions[extensionNamespace] = ext
+	}
+	return ext
+}
+
+func pascalCase(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '_' || r == '-' || r == ' ' || r == '.' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func humanize(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' })
+	if len(words) == 0 {
+		return name
+	}
+	first := []rune(words[0])
+	first[0] = unicode.ToUpper(first[0])
+	words[0] = string(first)
+	return strings.Join(words, " ")
+}
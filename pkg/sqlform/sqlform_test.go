@@ -0,0 +1,176 @@
+package sqlform_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/sqlform"
+)
+
+// fakeDriver answers the information_schema queries with canned rows keyed
+// by the first table the statement reads from.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{query: query}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type fakeStmt struct{ query string }
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return 0 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, driver.ErrSkip
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	switch {
+	case strings.Contains(s.query, "FROM information_schema.tables"):
+		return &fakeRows{rows: [][]driver.Value{
+			{"public", "owners"},
+			{"public", "pets"},
+			{"pg_catalog", "pg_class"},
+		}}, nil
+	case strings.Contains(s.query, "FROM information_schema.columns"):
+		return &fakeRows{rows: [][]driver.Value{
+			{"public", "owners", "id", "integer", "NO", "", int64(0), "YES", "NEVER"},
+			{"public", "owners", "name", "text", "NO", "", int64(0), "NO", "NEVER"},
+			{"public", "pets", "id", "bigint", "NO", "nextval('pets_id_seq'::regclass)", int64(0), "NO", "NEVER"},
+			{"public", "pets", "name", "character varying", "NO", "", int64(80), "NO", "NEVER"},
+			{"public", "pets", "status", "character varying", "NO", "'draft'::character varying", int64(20), "NO", "NEVER"},
+			{"public", "pets", "weight", "numeric", "YES", "", int64(0), "NO", "NEVER"},
+			{"public", "pets", "born_on", "date", "YES", "", int64(0), "NO", "NEVER"},
+			{"public", "pets", "attributes", "jsonb", "YES", "", int64(0), "NO", "NEVER"},
+			{"public", "pets", "owner_id", "integer", "NO", "", int64(0), "NO", "NEVER"},
+			{"public", "pets", "slug", "text", "NO", "", int64(0), "NO", "ALWAYS"},
+		}}, nil
+	case strings.Contains(s.query, "FROM information_schema.table_constraints"):
+		return &fakeRows{rows: [][]driver.Value{
+			{"public", "owners", "owners_pkey", "PRIMARY KEY", "id", "", "", ""},
+			{"public", "pets", "pets_owner_id_fkey", "FOREIGN KEY", "owner_id", "public", "owners", "id"},
+			{"public", "pets", "pets_pkey", "PRIMARY KEY", "id", "", "", ""},
+		}}, nil
+	}
+	return nil, fmt.Errorf("unexpected query %q", s.query)
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	next int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+func init() {
+	sql.Register("sqlform-fake", fakeDriver{})
+}
+
+func TestIntrospectBuildsForms(t *testing.T) {
+	db, err := sql.Open("sqlform-fake", "")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+
+	tables, err := sqlform.Introspect(context.Background(), db)
+	if err != nil {
+		t.Fatalf("introspect: %v", err)
+	}
+	if len(tables) != 2 || tables[0].Name != "owners" || tables[1].Name != "pets" {
+		t.Fatalf("expected owners and pets without system tables, got %+v", tables)
+	}
+	pets := tables[1]
+	if len(pets.ForeignKeys) != 1 || pets.ForeignKeys[0].RefTable != "owners" || !pets.Columns[0].PrimaryKey {
+		t.Fatalf("unexpected pets keys %+v", pets)
+	}
+
+	forms, err := sqlform.BuildAll(tables, sqlform.WithEndpoint("/admin/{table}"))
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	form := forms["pets"]
+	if form.OperationID != "createPets" || form.Endpoint != "/admin/pets" || form.Method != "POST" {
+		t.Fatalf("unexpected form header %+v", form)
+	}
+	if len(forms["owners"].Fields) != 1 {
+		t.Fatalf("expected identity column to be omitted, got %+v", forms["owners"].Fields)
+	}
+
+	var names []string
+	fields := map[string]model.Field{}
+	for _, f := range form.Fields {
+		names = append(names, f.Name)
+		fields[f.Name] = f
+	}
+	if got := strings.Join(names, ","); got != "name,status,weight,born_on,attributes,owner_id" {
+		t.Fatalf("expected ordinal order without generated columns, got %s", got)
+	}
+	name := fields["name"]
+	if !name.Required || len(name.Validations) != 1 || name.Validations[0].Kind != model.ValidationRuleMaxLength || name.Validations[0].Params["value"] != "80" {
+		t.Fatalf("expected required name with maxLength 80, got %+v", name)
+	}
+	if status := fields["status"]; status.Required || status.Default != "draft" {
+		t.Fatalf("expected defaulted column to be optional with a default, got %+v", status)
+	}
+	if weight := fields["weight"]; weight.Type != model.FieldTypeNumber || weight.Required {
+		t.Fatalf("unexpected weight field %+v", weight)
+	}
+	if born := fields["born_on"]; born.Format != "date" {
+		t.Fatalf("unexpected born_on field %+v", born)
+	}
+	if attrs := fields["attributes"]; attrs.UIHints["widget"] != "json-editor" {
+		t.Fatalf("expected json editor widget, got %+v", attrs.UIHints)
+	}
+	owner := fields["owner_id"]
+	if owner.Type != model.FieldTypeInteger || !owner.Required || owner.Relationship == nil {
+		t.Fatalf("unexpected owner_id field %+v", owner)
+	}
+	if owner.Relationship.Target != "owners" || owner.Relationship.Kind != model.RelationshipBelongsTo {
+		t.Fatalf("unexpected relationship %+v", owner.Relationship)
+	}
+}
+
+func TestFormRejectsInvalidTables(t *testing.T) {
+	cases := map[string]sqlform.Table{
+		"table name is required": {},
+		"no editable columns":    {Name: "audit", Columns: []sqlform.Column{{Name: "id", DataType: "bigint", Generated: true}}},
+		"duplicate column": {Name: "pets", Columns: []sqlform.Column{
+			{Name: "name", DataType: "text"},
+			{Name: "name", DataType: "text"},
+		}},
+	}
+	for want, table := range cases {
+		if _, err := sqlform.Form(table); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected error containing %q, got %v", want, err)
+		}
+	}
+	if _, err := sqlform.Introspect(context.Background(), nil); err == nil {
+		t.Fatal("expected nil database to fail")
+	}
+}
@@ -0,0 +1,568 @@
+// Package structschema builds forms from annotated Go structs, for tools that
+// have request structs but no OpenAPI document. Struct fields become schema
+// properties named after their `json` tag; a `formgen` tag carries
+// presentation hints and a go-playground style `validate` tag carries
+// constraints:
+//
+//	type CreatePet struct {
+//		Name  string   `json:"name" formgen:"label=Pet name,placeholder=Rex" validate:"required,max=64"`
+//		Email string   `json:"email" validate:"omitempty,email"`
+//		Kind  string   `json:"kind" formgen:"widget=select" validate:"oneof=cat dog"`
+//		Tags  []string `json:"tags" validate:"max=5,dive,min=2"`
+//	}
+//
+// The struct is converted to a schema.Form and built by the regular model
+// builder, so labels, format hints, ordering, and x-formgen semantics match
+// forms generated from OpenAPI. Fields keep their declaration order.
+//
+// Supported formgen keys are label, placeholder, help, description, widget,
+// format, default, enum (pipe separated), order, and the flags required,
+// readonly, and sensitive; any other key=value (or bare flag) is passed
+// through as an x-formgen UI hint such as section, group, or inputType. Values
+// containing commas can be single quoted: label='Name, full'. A tag of "-"
+// skips the field.
+//
+// Supported validate rules are required, min, max, len, gt, gte, lt, lte,
+// oneof, email, url, uri, and uuid; min/max style rules map to lengths for
+// strings, item counts for slices, and bounds for numbers. Rules after dive
+// apply to slice items. Unknown rules are ignored, so the tag can be shared
+// with a server-side validator.
+package structschema
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/schema"
+)
+
+const (
+	// TagName is the struct tag carrying presentation hints.
+	TagName = "formgen"
+	// ValidateTagName is the struct tag carrying constraints.
+	ValidateTagName = "validate"
+
+	extensionNamespace = "x-formgen"
+	defaultMethod      = "POST"
+)
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// Option customises how a struct is converted.
+type Option func(*options)
+
+type options struct {
+	operationID string
+	endpoint    string
+	method      string
+	summary     string
+	description string
+	builder     model.Builder
+}
+
+// WithOperationID sets the form operation id. Defaults to the struct type
+// name with a lower-case first letter (CreatePet becomes "createPet").
+func WithOperationID(id string) Option {
+	return func(o *options) {
+		o.operationID = strings.TrimSpace(id)
+	}
+}
+
+// WithEndpoint sets the form action. Defaults to "/" plus the operation id.
+func WithEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.endpoint = strings.TrimSpace(endpoint)
+	}
+}
+
+// WithMethod sets the HTTP method. Defaults to POST.
+func WithMethod(method string) Option {
+	return func(o *options) {
+		o.method = strings.ToUpper(strings.TrimSpace(method))
+	}
+}
+
+// WithSummary sets the form title and description.
+func WithSummary(summary, description string) Option {
+	return func(o *options) {
+		o.summary = summary
+		o.description = description
+	}
+}
+
+// WithBuilder overrides the model builder used by Build. Defaults to
+// model.NewBuilder().
+func WithBuilder(builder model.Builder) Option {
+	return func(o *options) {
+		if builder != nil {
+			o.builder = builder
+		}
+	}
+}
+
+// Build converts v (a struct or pointer to struct, which may be nil) into a
+// form model.
+func Build(v any, opts ...Option) (model.FormModel, error) {
+	cfg := resolveOptions(opts)
+	form, err := formFor(v, cfg)
+	if err != nil {
+		return model.FormModel{}, err
+	}
+	builder := cfg.builder
+	if builder == nil {
+		builder = model.NewBuilder()
+	}
+	result, err := builder.Build(form)
+	if err != nil {
+		return model.FormModel{}, fmt.Errorf("structschema: build form model: %w", err)
+	}
+	if err := builder.Decorate(&result); err != nil {
+		return model.FormModel{}, fmt.Errorf("structschema: decorate form model: %w", err)
+	}
+	return result, nil
+}
+
+// Form converts v (a struct or pointer to struct, which may be nil) into the
+// normalized schema form the model builder consumes.
+func Form(v any, opts ...Option) (schema.Form, error) {
+	return formFor(v, resolveOptions(opts))
+}
+
+func resolveOptions(opts []Option) options {
+	var cfg options
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return cfg
+}
+
+func formFor(v any, cfg options) (schema.Form, error) {
+	if v == nil {
+		return schema.Form{}, errors.New("structschema: value is nil")
+	}
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return schema.Form{}, fmt.Errorf("structschema: expected struct, got %s", t)
+	}
+
+	id := cfg.operationID
+	if id == "" {
+		if t.Name() == "" {
+			return schema.Form{}, errors.New("structschema: operation id is required for anonymous struct types")
+		}
+		id = lowerFirst(t.Name())
+	}
+	endpoint := cfg.endpoint
+	if endpoint == "" {
+		endpoint = "/" + id
+	}
+	method := cfg.method
+	if method == "" {
+		method = defaultMethod
+	}
+
+	c := converter{visiting: map[reflect.Type]bool{}}
+	body, err := c.objectSchema(t, "")
+	if err != nil {
+		return schema.Form{}, err
+	}
+	return schema.Form{
+		ID:          id,
+		Method:      method,
+		Endpoint:    endpoint,
+		Summary:     cfg.summary,
+		Description: cfg.description,
+		Schema:      body,
+	}, nil
+}
+
+type converter struct {
+	visiting map[reflect.Type]bool
+}
+
+func (c *converter) objectSchema(t reflect.Type, path string) (schema.Schema, error) {
+	if c.visiting[t] {
+		return schema.Schema{}, fmt.Errorf("structschema: %s: recursive type %s is not supported", displayPath(path), t)
+	}
+	c.visiting[t] = true
+	defer delete(c.visiting, t)
+
+	out := schema.Schema{Type: "object", Properties: map[string]schema.Schema{}}
+	order := 0
+	if err := c.collectFields(t, path, &out, &order); err != nil {
+		return schema.Schema{}, err
+	}
+	return out, nil
+}
+
+// collectFields adds the properties of t to out, promoting the fields of
+// embedded structs the way encoding/json does.
+func (c *converter) collectFields(t reflect.Type, path string, out *schema.Schema, order *int) error {
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		name, tagged, skip := jsonName(sf)
+		if skip || sf.Tag.Get(TagName) == "-" {
+			continue
+		}
+		if sf.Anonymous && !tagged {
+			embedded := sf.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if err := c.collectFields(embedded, path, out, order); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		fieldPath := joinPath(path, name)
+		if _, exists := out.Properties[name]; exists {
+			return fmt.Errorf("structschema: %s: duplicate field name", fieldPath)
+		}
+		prop, required, err := c.fieldSchema(sf, fieldPath)
+		if err != nil {
+			return err
+		}
+		ext := formgenExtension(&prop)
+		if _, ok := ext["order"]; !ok {
+			ext["order"] = *order
+		}
+		*order++
+		out.Properties[name] = prop
+		if required {
+			out.Required = append(out.Required, name)
+		}
+	}
+	return nil
+}
+
+func (c *converter) fieldSchema(sf reflect.StructField, path string) (schema.Schema, bool, error) {
+	prop, err := c.typeSchema(sf.Type, path)
+	if err != nil {
+		return schema.Schema{}, false, err
+	}
+	required, err := applyValidateTag(&prop, sf.Tag.Get(ValidateTagName))
+	if err != nil {
+		return schema.Schema{}, false, fmt.Errorf("structschema: %s: %w", path, err)
+	}
+	tagRequired, err := applyFormgenTag(&prop, sf.Tag.Get(TagName))
+	if err != nil {
+		return schema.Schema{}, false, fmt.Errorf("structschema: %s: %w", path, err)
+	}
+	return prop, required || tagRequired, nil
+}
+
+func (c *converter) typeSchema(t reflect.Type, path string) (schema.Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return schema.Schema{Type: "string", Format: "date-time"}, nil
+	}
+	if t.Kind() != reflect.String && reflect.PointerTo(t).Implements(textMarshalerType) {
+		return schema.Schema{Type: "string"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return schema.Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return schema.Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schema.Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return schema.Schema{Type: "number"}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return schema.Schema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := c.typeSchema(t.Elem(), path+"[]")
+		if err != nil {
+			return schema.Schema{}, err
+		}
+		return schema.Schema{Type: "array", Items: &items}, nil
+	case reflect.Struct:
+		return c.objectSchema(t, path)
+	default:
+		return schema.Schema{}, fmt.Errorf("structschema: %s: unsupported type %s", displayPath(path), t)
+	}
+}
+
+// applyFormgenTag applies the formgen tag and reports whether it marks the
+// field as required.
+func applyFormgenTag(prop *schema.Schema, tag string) (bool, error) {
+	entries, err := splitTag(tag)
+	if err != nil {
+		return false, err
+	}
+	required := false
+	for _, entry := range entries {
+		key, value, hasValue := strings.Cut(entry, "=")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch key {
+		case "":
+			continue
+		case "required":
+			required = true
+		case "readonly":
+			prop.ReadOnly = true
+		case "sensitive":
+			formgenExtension(prop)["sensitive"] = true
+		case "description":
+			prop.Description = value
+		case "format":
+			prop.Format = value
+		case "help":
+			formgenExtension(prop)["helpText"] = value
+		case "order":
+			order, err := strconv.Atoi(value)
+			if err != nil {
+				return false, fmt.Errorf("invalid order %q", value)
+			}
+			formgenExtension(prop)["order"] = order
+		case "default":
+			parsed, err := parseScalar(prop.Type, value)
+			if err != nil {
+				return false, fmt.Errorf("invalid default: %w", err)
+			}
+			prop.Default = parsed
+		case "enum":
+			enum, err := parseEnum(prop.Type, strings.Split(value, "|"))
+			if err != nil {
+				return false, fmt.Errorf("invalid enum: %w", err)
+			}
+			prop.Enum = enum
+		default:
+			if hasValue {
+				formgenExtension(prop)[key] = value
+			} else {
+				formgenExtension(prop)[key] = true
+			}
+		}
+	}
+	return required, nil
+}
+
+// applyValidateTag maps validate rules onto schema constraints and reports
+// whether the field is required.
+func applyValidateTag(prop *schema.Schema, tag string) (bool, error) {
+	if strings.TrimSpace(tag) == "" {
+		return false, nil
+	}
+	rules := strings.Split(tag, ",")
+	target := prop
+	required := false
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "dive" {
+			if target.Items == nil {
+				return false, errors.New("validate rule dive requires a slice")
+			}
+			target = target.Items
+			continue
+		}
+		if strings.Contains(rule, "|") {
+			// Alternatives cannot be expressed as a single constraint.
+			continue
+		}
+		key, value, _ := strings.Cut(rule, "=")
+		var err error
+		switch key {
+		case "required":
+			if target == prop {
+				required = true
+			}
+		case "min", "gte":
+			err = applyBound(target, value, true, false)
+		case "max", "lte":
+			err = applyBound(target, value, false, false)
+		case "gt":
+			err = applyBound(target, value, true, true)
+		case "lt":
+			err = applyBound(target, value, false, true)
+		case "len":
+			if err = applyBound(target, value, true, false); err == nil {
+				err = applyBound(target, value, false, false)
+			}
+		case "oneof":
+			target.Enum, err = parseEnum(target.Type, strings.Fields(value))
+		case "email":
+			target.Format = "email"
+		case "url", "uri", "http_url":
+			target.Format = "uri"
+		case "uuid", "uuid4":
+			target.Format = "uuid"
+		}
+		if err != nil {
+			return false, fmt.Errorf("invalid validate rule %q: %w", rule, err)
+		}
+	}
+	return required, nil
+}
+
+// applyBound sets a lower or upper limit appropriate for the schema type.
+func applyBound(prop *schema.Schema, raw string, lower, exclusive bool) error {
+	switch prop.Type {
+	case "integer", "number":
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		if lower {
+			prop.Minimum = &value
+			prop.ExclusiveMinimum = exclusive
+		} else {
+			prop.Maximum = &value
+			prop.ExclusiveMaximum = exclusive
+		}
+		return nil
+	case "string", "array":
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		if exclusive && lower {
+			value++
+		} else if exclusive {
+			value--
+		}
+		if value < 0 {
+			value = 0
+		}
+		switch {
+		case prop.Type == "string" && lower:
+			prop.MinLength = &value
+		case prop.Type == "string":
+			prop.MaxLength = &value
+		case lower:
+			prop.MinItems = &value
+		default:
+			prop.MaxItems = &value
+		}
+		return nil
+	}
+	return fmt.Errorf("not supported for %s fields", prop.Type)
+}
+
+func parseEnum(kind string, raw []string) ([]any, error) {
+	values := make([]any, 0, len(raw))
+	for _, item := range raw {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		value, err := parseScalar(kind, item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func parseScalar(kind, raw string) (any, error) {
+	switch kind {
+	case "integer":
+		return strconv.ParseInt(raw, 10, 64)
+	case "number":
+		return strconv.ParseFloat(raw, 64)
+	case "boolean":
+		return strconv.ParseBool(raw)
+	case "string":
+		return raw, nil
+	}
+	return nil, fmt.Errorf("not supported for %s fields", kind)
+}
+
+// splitTag splits a formgen tag on commas outside single-quoted values and
+// strips the quotes.
+func splitTag(tag string) ([]string, error) {
+	var (
+		entries []string
+		current strings.Builder
+		quoted  bool
+	)
+	for _, r := range tag {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			entries = append(entries, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote in %s tag %q", TagName, tag)
+	}
+	return append(entries, current.String()), nil
+}
+
+func formgenExtension(prop *schema.Schema) map[string]any {
+	if prop.Extensions == nil {
+		prop.Extensions = map[string]any{}
+	}
+	ext, ok := prop.Extensions[extensionNamespace].(map[string]any)
+	if !ok {
+		ext = map[string]any{}
+		prop.Extensions[extensionNamespace] = ext
+	}
+	return ext
+}
+
+// jsonName returns the property name for sf following encoding/json rules,
+// whether the json tag named it explicitly, and whether it is skipped.
+func jsonName(sf reflect.StructField) (string, bool, bool) {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name != "" {
+		return name, true, false
+	}
+	return sf.Name, false, false
+}
+
+func lowerFirst(value string) string {
+	r, size := utf8.DecodeRuneInString(value)
+	return string(unicode.ToLower(r)) + value[size:]
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "struct"
+	}
+	return path
+}
@@ -0,0 +1,129 @@
+package structschema_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/structschema"
+)
+
+type Audit struct {
+	CreatedBy string `json:"created_by" formgen:"readonly"`
+}
+
+type Address struct {
+	Street string `json:"street" validate:"required"`
+	City   string `json:"city"`
+}
+
+type CreatePet struct {
+	Audit
+	Name     string    `json:"name" formgen:"label='Pet name, full',placeholder=Rex,help=Shown on the tag" validate:"required,max=64"`
+	Email    string    `json:"email" validate:"omitempty,email"`
+	Kind     string    `json:"kind" formgen:"widget=select,default=cat" validate:"oneof=cat dog"`
+	Age      *int      `json:"age" validate:"gte=0,lt=40"`
+	Tags     []string  `json:"tags" validate:"max=5,dive,min=2"`
+	Born     time.Time `json:"born"`
+	Secret   string    `json:"secret" formgen:"sensitive,section=advanced"`
+	Address  Address   `json:"address"`
+	Internal string    `json:"-"`
+	Skipped  string    `json:"skipped" formgen:"-"`
+}
+
+func TestBuildConvertsAnnotatedStruct(t *testing.T) {
+	form, err := structschema.Build(&CreatePet{}, structschema.WithEndpoint("/pets"))
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if form.OperationID != "createPet" || form.Endpoint != "/pets" || form.Method != "POST" {
+		t.Fatalf("unexpected form header %+v", form)
+	}
+
+	var names []string
+	fields := map[string]model.Field{}
+	for _, field := range form.Fields {
+		names = append(names, field.Name)
+		fields[field.Name] = field
+	}
+	if got := strings.Join(names, ","); got != "created_by,name,email,kind,age,tags,born,secret,address" {
+		t.Fatalf("expected declaration order without skipped fields, got %s", got)
+	}
+
+	name := fields["name"]
+	if !name.Required || name.Label != "Pet name, full" || name.Placeholder != "Rex" || name.Metadata["helpText"] != "Shown on the tag" {
+		t.Fatalf("unexpected name field %+v", name)
+	}
+	if !hasRule(name, model.ValidationRuleMaxLength, "64") {
+		t.Fatalf("expected maxLength rule, got %+v", name.Validations)
+	}
+	if fields["email"].UIHints["inputType"] != "email" || fields["email"].Required {
+		t.Fatalf("unexpected email field %+v", fields["email"])
+	}
+	kind := fields["kind"]
+	if kind.UIHints["widget"] != "select" || kind.Default != "cat" || len(kind.Enum) != 2 {
+		t.Fatalf("unexpected kind field %+v", kind)
+	}
+	age := fields["age"]
+	if age.Type != model.FieldTypeInteger || !hasRule(age, model.ValidationRuleMin, "0") || !hasRule(age, model.ValidationRuleMax, "40") {
+		t.Fatalf("unexpected age field %+v", age)
+	}
+	tags := fields["tags"]
+	if tags.Type != model.FieldTypeArray || !hasRule(tags, model.ValidationRuleMaxItems, "5") || tags.Items == nil || !hasRule(*tags.Items, model.ValidationRuleMinLength, "2") {
+		t.Fatalf("unexpected tags field %+v", tags)
+	}
+	if fields["born"].UIHints["inputType"] != "datetime-local" {
+		t.Fatalf("expected time.Time as date-time, got %+v", fields["born"])
+	}
+	if !fields["secret"].Sensitive || fields["secret"].UIHints["section"] != "advanced" {
+		t.Fatalf("unexpected secret field %+v", fields["secret"])
+	}
+	if !fields["created_by"].Readonly {
+		t.Fatalf("expected embedded field to be promoted as readonly, got %+v", fields["created_by"])
+	}
+	address := fields["address"]
+	if address.Type != model.FieldTypeObject || len(address.Nested) != 2 || !address.Nested[0].Required {
+		t.Fatalf("unexpected address field %+v", address)
+	}
+}
+
+func TestBuildRejectsUnsupportedStructs(t *testing.T) {
+	type node struct {
+		Next *node `json:"next"`
+	}
+	cases := []struct {
+		name  string
+		value any
+		opts  []structschema.Option
+		want  string
+	}{
+		{name: "not a struct", value: 42, want: "expected struct"},
+		{name: "anonymous", value: struct{ A string }{}, want: "operation id is required"},
+		{name: "recursive", value: node{}, want: "recursive type"},
+		{name: "map", value: struct{ M map[string]int }{}, opts: []structschema.Option{structschema.WithOperationID("m")}, want: "unsupported type"},
+		{name: "bad bound", value: struct {
+			B bool `validate:"min=1"`
+		}{}, opts: []structschema.Option{structschema.WithOperationID("b")}, want: "invalid validate rule"},
+		{name: "bad default", value: struct {
+			N int `formgen:"default=x"`
+		}{}, opts: []structschema.Option{structschema.WithOperationID("n")}, want: "invalid default"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := structschema.Form(tc.value, tc.opts...)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("expected error containing %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func hasRule(field model.Field, kind, value string) bool {
+	for _, rule := range field.Validations {
+		if rule.Kind == kind && rule.Params["value"] == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,224 @@
+package submission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/formtoken"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+const (
+	defaultMinSubmitTime = 3 * time.Second
+	defaultMaxFormAge    = 24 * time.Hour
+)
+
+var (
+	// ErrSpamDetected matches every error returned by SpamGuard checks.
+	ErrSpamDetected = errors.New("submission: spam detected")
+	// ErrSpamHoneypot reports a filled honeypot input.
+	ErrSpamHoneypot = errors.New("submission: honeypot field filled")
+	// ErrSpamTooFast reports a submission sent sooner than the minimum
+	// submit time after rendering.
+	ErrSpamTooFast = errors.New("submission: form submitted too quickly")
+	// ErrSpamToken reports a missing, forged, or expired submit token.
+	ErrSpamToken = errors.New("submission: submit token invalid")
+	// ErrSpamCaptcha reports a missing or rejected CAPTCHA response.
+	ErrSpamCaptcha = errors.New("submission: captcha rejected")
+)
+
+// CaptchaVerifier checks a CAPTCHA response token with the provider.
+type CaptchaVerifier func(ctx context.Context, response string) error
+
+// SpamGuard issues render.AntiSpam options for a form and verifies the
+// submissions that come back: the honeypot must be empty, the signed submit
+// token must be at least MinSubmitTime old and not older than MaxFormAge, and
+// a configured CAPTCHA response must pass its verifier.
+type SpamGuard struct {
+	signer        *formtoken.Signer
+	honeypot      string
+	honeypotLabel string
+	tokenField    string
+	minSubmitTime time.Duration
+	maxFormAge    time.Duration
+	now           func() time.Time
+	captcha       *render.CaptchaSlot
+	verifyCaptcha CaptchaVerifier
+}
+
+// SpamOption customises a SpamGuard.
+type SpamOption func(*SpamGuard)
+
+// WithHoneypot sets the honeypot input name and label. Pick a name that does
+// not clash with real fields; an empty name disables the honeypot.
+func WithHoneypot(name, label string) SpamOption {
+	return func(g *SpamGuard) {
+		g.honeypot = strings.TrimSpace(name)
+		g.honeypotLabel = strings.TrimSpace(label)
+	}
+}
+
+// WithMinSubmitTime rejects submissions sent sooner than d after rendering.
+// Defaults to three seconds; zero keeps only the token signature and age
+// checks.
+func WithMinSubmitTime(d time.Duration) SpamOption {
+	return func(g *SpamGuard) {
+		if d >= 0 {
+			g.minSubmitTime = d
+		}
+	}
+}
+
+// WithMaxFormAge rejects tokens older than d. Defaults to 24 hours.
+func WithMaxFormAge(d time.Duration) SpamOption {
+	return func(g *SpamGuard) {
+		if d > 0 {
+			g.maxFormAge = d
+		}
+	}
+}
+
+// WithSubmitTokenField overrides render.DefaultSubmitTokenField.
+func WithSubmitTokenField(name string) SpamOption {
+	return func(g *SpamGuard) {
+		if name = strings.TrimSpace(name); name != "" {
+			g.tokenField = name
+		}
+	}
+}
+
+// WithCaptcha renders slot before the form actions and requires its response
+// field to pass verify on submit. A nil verify renders the slot without
+// checking it.
+func WithCaptcha(slot render.CaptchaSlot, verify CaptchaVerifier) SpamOption {
+	return func(g *SpamGuard) {
+		g.captcha = &slot
+		g.verifyCaptcha = verify
+	}
+}
+
+// WithSpamClock overrides the time source used to stamp and check tokens.
+func WithSpamClock(now func() time.Time) SpamOption {
+	return func(g *SpamGuard) {
+		if now != nil {
+			g.now = now
+		}
+	}
+}
+
+// NewSpamGuard constructs a SpamGuard. key signs submit tokens and must be at
+// least formtoken.MinKeyLength bytes.
+func NewSpamGuard(key []byte, options ...SpamOption) (*SpamGuard, error) {
+	g := &SpamGuard{
+		honeypot:      render.DefaultHoneypotField,
+		tokenField:    render.DefaultSubmitTokenField,
+		minSubmitTime: defaultMinSubmitTime,
+		maxFormAge:    defaultMaxFormAge,
+		now:           time.Now,
+	}
+	for _, opt := range options {
+		if opt != nil {
+			opt(g)
+		}
+	}
+	signer, err := formtoken.New(key,
+		formtoken.WithFieldName(g.tokenField),
+		formtoken.WithTTL(g.maxFormAge),
+		formtoken.WithClock(g.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("submission: spam guard: %w", err)
+	}
+	g.signer = signer
+	return g, nil
+}
+
+// Fields lists the input names the guard adds to the form. Strip them (or
+// parse with WithUnknownFields(UnknownIgnore)) so they are not reported as
+// unknown fields.
+func (g *SpamGuard) Fields() []string {
+	fields := []string{g.tokenField}
+	if g.honeypot != "" {
+		fields = append(fields, g.honeypot)
+	}
+	if g.captcha != nil && strings.TrimSpace(g.captcha.ResponseField) != "" {
+		fields = append(fields, strings.TrimSpace(g.captcha.ResponseField))
+	}
+	return fields
+}
+
+// AntiSpam issues fresh render options for operationID; set the result on
+// render.RenderOptions.AntiSpam for every render.
+func (g *SpamGuard) AntiSpam(operationID string) (*render.AntiSpam, error) {
+	token, err := g.signer.Issue(operationID, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("submission: spam guard: %w", err)
+	}
+	options := &render.AntiSpam{
+		Honeypot:         g.honeypot,
+		HoneypotLabel:    g.honeypotLabel,
+		SubmitToken:      token,
+		SubmitTokenField: g.tokenField,
+	}
+	if g.captcha != nil {
+		slot := *g.captcha
+		options.Captcha = &slot
+	}
+	return options, nil
+}
+
+// Check verifies submitted form values for operationID. Errors match
+// ErrSpamDetected and the specific reason (ErrSpamHoneypot, ErrSpamTooFast,
+// ErrSpamToken, ErrSpamCaptcha); handlers should answer with a generic
+// failure rather than reveal which check tripped.
+func (g *SpamGuard) Check(ctx context.Context, operationID string, values url.Values) error {
+	if g.honeypot != "" && strings.TrimSpace(values.Get(g.honeypot)) != "" {
+		return spamError(ErrSpamHoneypot, nil)
+	}
+	claims, err := g.signer.Verify(values.Get(g.tokenField), operationID, "")
+	if err != nil {
+		return spamError(ErrSpamToken, err)
+	}
+	if elapsed := g.now().Sub(claims.IssuedAt); elapsed < g.minSubmitTime {
+		return spamError(ErrSpamTooFast, fmt.Errorf("submitted after %s", elapsed))
+	}
+	if g.captcha != nil && g.verifyCaptcha != nil {
+		response := strings.TrimSpace(values.Get(strings.TrimSpace(g.captcha.ResponseField)))
+		if response == "" {
+			return spamError(ErrSpamCaptcha, errors.New("response missing"))
+		}
+		if err := g.verifyCaptcha(ctx, response); err != nil {
+			return spamError(ErrSpamCaptcha, err)
+		}
+	}
+	return nil
+}
+
+// CheckRequest runs Check against an already parsed form body. Like
+// IdempotencyKey it never reads the body, so call it after ParseRequest; a
+// request without a parsed form body fails the token check.
+func (g *SpamGuard) CheckRequest(req *http.Request, operationID string) error {
+	if req == nil {
+		return spamError(ErrSpamToken, formtoken.ErrTokenMissing)
+	}
+	values := req.PostForm
+	if req.MultipartForm != nil {
+		values = url.Values(req.MultipartForm.Value)
+	}
+	if values == nil {
+		values = url.Values{}
+	}
+	return g.Check(req.Context(), operationID, values)
+}
+
+func spamError(reason, cause error) error {
+	if cause == nil {
+		return fmt.Errorf("%w: %w", ErrSpamDetected, reason)
+	}
+	return fmt.Errorf("%w: %w: %w", ErrSpamDetected, reason, cause)
+}
@@ -0,0 +1,113 @@
+package submission_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+var spamKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestSpamGuardChecks(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	guard, err := submission.NewSpamGuard(spamKey,
+		submission.WithSpamClock(func() time.Time { return now }),
+		submission.WithCaptcha(render.CaptchaSlot{Provider: "turnstile", ResponseField: "cf-turnstile-response"},
+			func(_ context.Context, response string) error {
+				if response != "ok" {
+					return errors.New("provider rejected")
+				}
+				return nil
+			}),
+	)
+	if err != nil {
+		t.Fatalf("new guard: %v", err)
+	}
+	options, err := guard.AntiSpam("createComment")
+	if err != nil {
+		t.Fatalf("anti spam: %v", err)
+	}
+	if options.Honeypot != render.DefaultHoneypotField || options.SubmitToken == "" || options.Captcha == nil {
+		t.Fatalf("unexpected options %+v", options)
+	}
+
+	values := func(mutate func(url.Values)) url.Values {
+		v := url.Values{
+			render.DefaultSubmitTokenField: {options.SubmitToken},
+			render.DefaultHoneypotField:    {""},
+			"cf-turnstile-response":        {"ok"},
+		}
+		if mutate != nil {
+			mutate(v)
+		}
+		return v
+	}
+
+	if err := guard.Check(context.Background(), "createComment", values(nil)); !errors.Is(err, submission.ErrSpamTooFast) {
+		t.Fatalf("expected too-fast error, got %v", err)
+	}
+
+	now = now.Add(5 * time.Second)
+	if err := guard.Check(context.Background(), "createComment", values(nil)); err != nil {
+		t.Fatalf("expected submission to pass, got %v", err)
+	}
+
+	for name, tc := range map[string]struct {
+		operation string
+		mutate    func(url.Values)
+		want      error
+	}{
+		"honeypot":      {"createComment", func(v url.Values) { v.Set(render.DefaultHoneypotField, "http://spam") }, submission.ErrSpamHoneypot},
+		"missing token": {"createComment", func(v url.Values) { v.Del(render.DefaultSubmitTokenField) }, submission.ErrSpamToken},
+		"other form":    {"createPost", nil, submission.ErrSpamToken},
+		"captcha":       {"createComment", func(v url.Values) { v.Set("cf-turnstile-response", "bad") }, submission.ErrSpamCaptcha},
+	} {
+		err := guard.Check(context.Background(), tc.operation, values(tc.mutate))
+		if !errors.Is(err, tc.want) || !errors.Is(err, submission.ErrSpamDetected) {
+			t.Fatalf("%s: expected %v, got %v", name, tc.want, err)
+		}
+	}
+
+	now = now.Add(25 * time.Hour)
+	if err := guard.Check(context.Background(), "createComment", values(nil)); !errors.Is(err, submission.ErrSpamToken) {
+		t.Fatalf("expected expired token error, got %v", err)
+	}
+}
+
+func TestSpamGuardCheckRequestAfterParse(t *testing.T) {
+	issued := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := issued
+	guard, err := submission.NewSpamGuard(spamKey, submission.WithSpamClock(func() time.Time { return clock }))
+	if err != nil {
+		t.Fatalf("new guard: %v", err)
+	}
+	options, err := guard.AntiSpam("createAvatar")
+	if err != nil {
+		t.Fatalf("anti spam: %v", err)
+	}
+	clock = issued.Add(time.Minute)
+
+	body := url.Values{"caption": {"hi"}, render.DefaultSubmitTokenField: {options.SubmitToken}, render.DefaultHoneypotField: {""}}
+	req := httptest.NewRequest("POST", "/avatars", strings.NewReader(body.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	result, err := submission.ParseRequest(uploadForm(), req, submission.WithUnknownFields(submission.UnknownIgnore))
+	if err != nil {
+		t.Fatalf("parse request: %v", err)
+	}
+	if len(result.Issues) != 0 {
+		t.Fatalf("expected guard fields to be ignored, got %+v", result.Issues)
+	}
+	if err := guard.CheckRequest(req, "createAvatar"); err != nil {
+		t.Fatalf("expected request to pass, got %v", err)
+	}
+	if got := guard.Fields(); len(got) != 2 || got[0] != render.DefaultSubmitTokenField || got[1] != render.DefaultHoneypotField {
+		t.Fatalf("unexpected guard fields %v", got)
+	}
+}
@@ -189,6 +189,19 @@ func TestValidateProducesDeterministicIssueCodes(t *testing.T) {
 	}
 }
 
+func TestValidateChecksNumberPrecision(t *testing.T) {
+	form := model.FormModel{Fields: []model.Field{
+		{Name: "price", Type: model.FieldTypeNumber, UIHints: map[string]string{"precision": "2"}},
+		{Name: "rate", Type: model.FieldTypeNumber, UIHints: map[string]string{"precision": "1"}},
+	}}
+
+	result := submission.ParseValues(form, url.Values{"price": {"0.30"}, "rate": {"0.25"}})
+	issues := append(result.Issues, submission.Validate(form, result.Values)...)
+	if len(issues) != 1 || issues[0].Code != submission.CodePrecision || issues[0].Path != "rate" {
+		t.Fatalf("expected one precision issue for rate, got %+v", issues)
+	}
+}
+
 func TestValidateProducesArrayCardinalityIssueCodes(t *testing.T) {
 	form := model.FormModel{Fields: []model.Field{
 		{
@@ -267,6 +280,23 @@ func TestValidateProducesArrayCardinalityIssueCodes(t *testing.T) {
 	}
 }
 
+func TestValidateTreatsMissingArraysAsEmpty(t *testing.T) {
+	form := model.FormModel{Fields: []model.Field{
+		{Name: "tags", Type: model.FieldTypeArray, Items: &model.Field{Name: "tag", Type: model.FieldTypeString}},
+		{Name: "ids", Type: model.FieldTypeArray, Required: true, Items: &model.Field{Name: "id", Type: model.FieldTypeString}},
+	}}
+
+	issues := submission.Validate(form, submission.Values{})
+	got := issueCodes(issues)
+	want := []submission.IssueCode{submission.CodeRequired}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("issue codes mismatch (-want +got):\n%s", diff)
+	}
+	if issues[0].Path != "ids" {
+		t.Fatalf("expected required issue for ids, got %+v", issues[0])
+	}
+}
+
 func TestRawObjectDetectionAndParsing(t *testing.T) {
 	raw := model.Field{Name: "settings", Type: model.FieldTypeObject}
 	if !submission.IsRawObjectField(raw) {
@@ -325,3 +355,77 @@ func issueCodes(issues []submission.Issue) []submission.IssueCode {
 	}
 	return out
 }
+
+func TestValidateEnforcesEnumFilter(t *testing.T) {
+	form := model.FormModel{Fields: []model.Field{
+		{Name: "region", Type: model.FieldTypeString, Enum: []any{"US", "EU"}},
+		{Name: "currency", Type: model.FieldTypeString, Enum: []any{"USD", "EUR", "GBP"}, Metadata: map[string]string{
+			model.EnumFilterMetadataKey: `{"when":"region == 'EU'","allow":["EUR","GBP"]}`,
+		}},
+		{Name: "shipping", Type: model.FieldTypeObject, Nested: []model.Field{
+			{Name: "carriers", Type: model.FieldTypeArray, Items: &model.Field{Type: model.FieldTypeString}, Enum: []any{"ups", "dhl", "royalmail"}, Metadata: map[string]string{
+				model.EnumFilterMetadataKey: `{"when":"region == 'EU'","allow":["dhl","royalmail"]}`,
+			}},
+		}},
+	}}
+
+	issues := submission.Validate(form, submission.Values{
+		"region":   "EU",
+		"currency": "USD",
+		"shipping": map[string]any{"carriers": []any{"dhl", "ups"}},
+	})
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, got %+v", issues)
+	}
+	if issues[0].Code != submission.CodeEnum || issues[0].Path != "currency" {
+		t.Fatalf("unexpected currency issue %+v", issues[0])
+	}
+	if issues[1].Code != submission.CodeEnum || issues[1].Path != "shipping.carriers[1]" {
+		t.Fatalf("unexpected carriers issue %+v", issues[1])
+	}
+
+	issues = submission.Validate(form, submission.Values{
+		"region":   "US",
+		"currency": "USD",
+		"shipping": map[string]any{"carriers": []any{"ups"}},
+	})
+	if len(issues) != 0 {
+		t.Fatalf("expected filter to be inactive, got %+v", issues)
+	}
+}
+
+func TestValidateEnforcesTransitions(t *testing.T) {
+	form := model.FormModel{Fields: []model.Field{
+		{Name: "status", Type: model.FieldTypeString, Enum: []any{"draft", "in_review", "published"}, Metadata: map[string]string{
+			model.TransitionsMetadataKey: `{"draft":["in_review"],"in_review":["published","draft"]}`,
+		}},
+	}}
+
+	issues := submission.Validate(form, submission.Values{"status": "published"},
+		submission.WithCurrentValues(submission.Values{"status": "draft"}))
+	if len(issues) != 1 || issues[0].Code != submission.CodeTransition || issues[0].Path != "status" {
+		t.Fatalf("expected transition issue, got %+v", issues)
+	}
+	if issues[0].Message != `status cannot change from "draft" to "published"` {
+		t.Fatalf("unexpected message %q", issues[0].Message)
+	}
+
+	for _, tc := range []struct {
+		current, next string
+	}{
+		{"draft", "in_review"},
+		{"draft", "draft"},
+		{"in_review", "draft"},
+		{"", "published"},
+	} {
+		issues = submission.Validate(form, submission.Values{"status": tc.next},
+			submission.WithCurrentValues(submission.Values{"status": tc.current}))
+		if len(issues) != 0 {
+			t.Fatalf("expected %q -> %q to be allowed, got %+v", tc.current, tc.next, issues)
+		}
+	}
+
+	if issues := submission.Validate(form, submission.Values{"status": "published"}); len(issues) != 0 {
+		t.Fatalf("expected transitions to be skipped without current values, got %+v", issues)
+	}
+}
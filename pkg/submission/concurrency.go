@@ -0,0 +1,97 @@
+package submission
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// CodeVersionConflict marks a submission made against a stale record version.
+const CodeVersionConflict IssueCode = "versionConflict"
+
+// ErrVersionConflict matches every VersionConflictError. Stores that detect a
+// stale write themselves (for example an UPDATE ... WHERE version = ? that
+// touched no rows) can return it, or wrap it, so handlers treat both paths the
+// same way.
+var ErrVersionConflict = errors.New("submission: version conflict")
+
+// VersionConflictError reports that the submitted version no longer matches
+// the stored record, i.e. someone else saved it after the form was loaded.
+// Handlers typically answer 409 Conflict and re-render the form with the
+// current record and Issue as a form error.
+type VersionConflictError struct {
+	Field     string
+	Submitted any
+	Current   any
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s: %s %v does not match stored %v", ErrVersionConflict, e.Field, e.Submitted, e.Current)
+}
+
+// Is reports whether target is ErrVersionConflict.
+func (e *VersionConflictError) Is(target error) bool {
+	return target == ErrVersionConflict
+}
+
+// Issue returns a form-level issue describing the conflict, suitable for
+// IssuesToFieldAndFormErrors.
+func (e *VersionConflictError) Issue() Issue {
+	return issue(CodeVersionConflict, "", "This record was changed by someone else after you opened it. Review the latest version and try again.", e.Submitted)
+}
+
+// CheckVersion compares the version submitted in values (the field declared
+// with `x-formgen: {concurrency: {field: ...}}`) against current, the version
+// stored for the record. It returns a *VersionConflictError when they differ
+// or the version is missing, and nil when they match or the form declares no
+// concurrency field. Values are compared by their string form, with times in
+// RFC 3339, matching what renderers emit in the hidden input.
+func CheckVersion(form model.FormModel, values Values, current any) error {
+	concurrency, ok := model.FormConcurrency(form)
+	if !ok {
+		return nil
+	}
+	submitted, _ := lookupVersion(values, concurrency.Field)
+	if submitted != nil && current != nil && versionString(submitted) == versionString(current) {
+		return nil
+	}
+	return &VersionConflictError{Field: concurrency.Field, Submitted: submitted, Current: current}
+}
+
+func lookupVersion(values Values, path string) (any, bool) {
+	if value, ok := values[path]; ok {
+		return value, true
+	}
+	var current any = map[string]any(values)
+	for _, segment := range strings.Split(path, ".") {
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = obj[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func versionString(value any) string {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed.UTC().Format(time.RFC3339Nano)
+	case *time.Time:
+		if typed != nil {
+			return typed.UTC().Format(time.RFC3339Nano)
+		}
+		return ""
+	case string:
+		if parsed, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(typed)); err == nil {
+			return parsed.UTC().Format(time.RFC3339Nano)
+		}
+		return strings.TrimSpace(typed)
+	}
+	return fmt.Sprint(value)
+}
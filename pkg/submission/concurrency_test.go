@@ -0,0 +1,51 @@
+package submission_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+func TestCheckVersion(t *testing.T) {
+	form := model.FormModel{
+		OperationID: "updateArticle",
+		Metadata:    map[string]string{model.ConcurrencyMetadataKey: `{"field":"meta.updated_at"}`},
+		Fields: []model.Field{
+			{Name: "title", Type: model.FieldTypeString},
+			{Name: "meta", Type: model.FieldTypeObject, Nested: []model.Field{{Name: "updated_at", Type: model.FieldTypeString}}},
+		},
+	}
+	stored := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	values := submission.ParseMap(form, map[string]any{"title": "Hi", "meta.updated_at": "2026-03-01T13:30:00+01:00"})
+	if err := submission.CheckVersion(form, values.Values, stored); err != nil {
+		t.Fatalf("expected matching version, got %v", err)
+	}
+
+	err := submission.CheckVersion(form, values.Values, stored.Add(time.Minute))
+	var conflict *submission.VersionConflictError
+	if !errors.Is(err, submission.ErrVersionConflict) || !errors.As(err, &conflict) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+	if conflict.Field != "meta.updated_at" {
+		t.Fatalf("unexpected conflict %+v", conflict)
+	}
+	fields, formErrors := submission.IssuesToFieldAndFormErrors(form, []submission.Issue{conflict.Issue()})
+	if len(fields) != 0 || len(formErrors) != 1 {
+		t.Fatalf("expected a form-level error, got %v %v", fields, formErrors)
+	}
+
+	if err := submission.CheckVersion(form, submission.Values{"title": "Hi"}, stored); !errors.Is(err, submission.ErrVersionConflict) {
+		t.Fatalf("expected missing version to conflict, got %v", err)
+	}
+	if err := submission.CheckVersion(model.FormModel{}, nil, 3); err != nil {
+		t.Fatalf("expected no check without a concurrency field, got %v", err)
+	}
+	if wrapped := fmt.Errorf("save article: %w", submission.ErrVersionConflict); !errors.Is(wrapped, submission.ErrVersionConflict) {
+		t.Fatalf("expected wrapped sentinel to match")
+	}
+}
@@ -0,0 +1,232 @@
+package submission
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// Encrypter seals the values of sensitive fields before submissions are
+// persisted outside the request, e.g. in draft stores or audit logs. Encrypt
+// returns the id of the key it used so Decrypt can pick the same key after
+// rotation.
+type Encrypter interface {
+	Encrypt(plaintext []byte) (ciphertext []byte, keyID string, err error)
+	Decrypt(ciphertext []byte, keyID string) ([]byte, error)
+}
+
+// EncryptedValue replaces a sensitive value in the output of
+// EncryptSensitive. It marshals to {"keyId": ..., "ciphertext": <base64>}, and
+// DecryptSensitive accepts either the struct or that decoded JSON object.
+type EncryptedValue struct {
+	KeyID      string `json:"keyId"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptSensitive returns a copy of values in which every value belonging to
+// a field marked Sensitive (at any depth, including array items) is
+// JSON-encoded, sealed with enc, and replaced by an EncryptedValue. Other
+// values are copied unchanged.
+func EncryptSensitive(form model.FormModel, values Values, enc Encrypter) (Values, error) {
+	if enc == nil {
+		return nil, errors.New("submission: encrypter is nil")
+	}
+	out, err := transformSensitive(form.Fields, map[string]any(values), "", func(path string, value any) (any, error) {
+		plaintext, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("submission: encode %s: %w", path, err)
+		}
+		ciphertext, keyID, err := enc.Encrypt(plaintext)
+		if err != nil {
+			return nil, fmt.Errorf("submission: encrypt %s: %w", path, err)
+		}
+		return EncryptedValue{KeyID: keyID, Ciphertext: ciphertext}, nil
+	})
+	if err != nil || out == nil {
+		return nil, err
+	}
+	return Values(out), nil
+}
+
+// DecryptSensitive reverses EncryptSensitive. Sensitive values that are not
+// encrypted are returned as-is, so drafts written before encryption was
+// enabled still load. Decrypted values come back in their JSON shape (numbers
+// as float64); run them through ParseJSON or CoerceValue when the typed form
+// is needed.
+func DecryptSensitive(form model.FormModel, values Values, enc Encrypter) (Values, error) {
+	if enc == nil {
+		return nil, errors.New("submission: encrypter is nil")
+	}
+	out, err := transformSensitive(form.Fields, map[string]any(values), "", func(path string, value any) (any, error) {
+		sealed, ok := asEncryptedValue(value)
+		if !ok {
+			return value, nil
+		}
+		plaintext, err := enc.Decrypt(sealed.Ciphertext, sealed.KeyID)
+		if err != nil {
+			return nil, fmt.Errorf("submission: decrypt %s: %w", path, err)
+		}
+		var decoded any
+		if err := json.Unmarshal(plaintext, &decoded); err != nil {
+			return nil, fmt.Errorf("submission: decode %s: %w", path, err)
+		}
+		return decoded, nil
+	})
+	if err != nil || out == nil {
+		return nil, err
+	}
+	return Values(out), nil
+}
+
+func transformSensitive(fields []model.Field, values map[string]any, parent string, fn func(string, any) (any, error)) (map[string]any, error) {
+	if values == nil {
+		return nil, nil
+	}
+	out := make(map[string]any, len(values))
+	for key, value := range values {
+		out[key] = value
+	}
+	for _, field := range sensitiveCandidates(fields) {
+		value, ok := out[field.Name]
+		if !ok || value == nil {
+			continue
+		}
+		transformed, err := transformSensitiveValue(field, value, joinPath(parent, field.Name), fn)
+		if err != nil {
+			return nil, err
+		}
+		out[field.Name] = transformed
+	}
+	return out, nil
+}
+
+func transformSensitiveValue(field model.Field, value any, path string, fn func(string, any) (any, error)) (any, error) {
+	if field.Sensitive {
+		return fn(path, value)
+	}
+	switch typed := value.(type) {
+	case map[string]any:
+		return transformSensitive(append(append([]model.Field(nil), field.Nested...), oneOfFields(field)...), typed, path, fn)
+	case []any:
+		if field.Items == nil {
+			return value, nil
+		}
+		items := make([]any, len(typed))
+		for i, item := range typed {
+			transformed, err := transformSensitiveValue(*field.Items, item, fmt.Sprintf("%s[%d]", path, i), fn)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = transformed
+		}
+		return items, nil
+	}
+	return value, nil
+}
+
+// sensitiveCandidates skips unnamed fields, which cannot key a value.
+func sensitiveCandidates(fields []model.Field) []model.Field {
+	out := make([]model.Field, 0, len(fields))
+	for _, field := range fields {
+		if field.Name != "" {
+			out = append(out, field)
+		}
+	}
+	return out
+}
+
+// oneOfFields flattens the properties of every oneOf branch; values only
+// carry the keys of the branch that was submitted.
+func oneOfFields(field model.Field) []model.Field {
+	var out []model.Field
+	for _, branch := range field.OneOf {
+		out = append(out, branch.Nested...)
+	}
+	return out
+}
+
+func asEncryptedValue(value any) (EncryptedValue, bool) {
+	switch typed := value.(type) {
+	case EncryptedValue:
+		return typed, true
+	case *EncryptedValue:
+		if typed != nil {
+			return *typed, true
+		}
+	case map[string]any:
+		keyID, keyOK := typed["keyId"].(string)
+		encoded, dataOK := typed["ciphertext"].(string)
+		if !keyOK || !dataOK || len(typed) != 2 {
+			return EncryptedValue{}, false
+		}
+		ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return EncryptedValue{}, false
+		}
+		return EncryptedValue{KeyID: keyID, Ciphertext: ciphertext}, true
+	}
+	return EncryptedValue{}, false
+}
+
+// AESGCMEncrypter is an Encrypter backed by AES-GCM. It encrypts with the
+// primary key and decrypts with any key it was given, which allows rotation.
+type AESGCMEncrypter struct {
+	primary string
+	aeads   map[string]cipher.AEAD
+}
+
+// NewAESGCMEncrypter creates an AES-GCM Encrypter whose primary key is keyID.
+// Keys must be 16, 24, or 32 bytes.
+func NewAESGCMEncrypter(keyID string, key []byte) (*AESGCMEncrypter, error) {
+	enc := &AESGCMEncrypter{primary: keyID, aeads: make(map[string]cipher.AEAD)}
+	if err := enc.AddKey(keyID, key); err != nil {
+		return nil, err
+	}
+	return enc, nil
+}
+
+// AddKey registers a decryption-only key, typically a retired primary key.
+func (e *AESGCMEncrypter) AddKey(keyID string, key []byte) error {
+	if keyID == "" {
+		return errors.New("submission: encryption key id is empty")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("submission: encryption key %q: %w", keyID, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return fmt.Errorf("submission: encryption key %q: %w", keyID, err)
+	}
+	e.aeads[keyID] = aead
+	return nil
+}
+
+// Encrypt seals plaintext with the primary key, prefixing the random nonce.
+func (e *AESGCMEncrypter) Encrypt(plaintext []byte) ([]byte, string, error) {
+	aead := e.aeads[e.primary]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, "", err
+	}
+	return aead.Seal(nonce, nonce, plaintext, []byte(e.primary)), e.primary, nil
+}
+
+// Decrypt opens ciphertext produced by Encrypt with the key named keyID.
+func (e *AESGCMEncrypter) Decrypt(ciphertext []byte, keyID string) ([]byte, error) {
+	aead, ok := e.aeads[keyID]
+	if !ok {
+		return nil, fmt.Errorf("submission: unknown encryption key %q", keyID)
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("submission: ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, []byte(keyID))
+}
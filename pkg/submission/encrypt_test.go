@@ -0,0 +1,127 @@
+package submission_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+func sensitiveForm() model.FormModel {
+	return model.FormModel{
+		Fields: []model.Field{
+			{Name: "email", Type: model.FieldTypeString},
+			{Name: "password", Type: model.FieldTypeString, Sensitive: true},
+			{
+				Name: "billing",
+				Type: model.FieldTypeObject,
+				Nested: []model.Field{
+					{Name: "card", Type: model.FieldTypeString, Sensitive: true},
+					{Name: "zip", Type: model.FieldTypeString},
+				},
+			},
+			{
+				Name: "contacts",
+				Type: model.FieldTypeArray,
+				Items: &model.Field{
+					Type:   model.FieldTypeObject,
+					Nested: []model.Field{{Name: "ssn", Type: model.FieldTypeInteger, Sensitive: true}},
+				},
+			},
+		},
+	}
+}
+
+func TestEncryptSensitiveRoundTrip(t *testing.T) {
+	enc, err := submission.NewAESGCMEncrypter("k1", bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatalf("new encrypter: %v", err)
+	}
+	form := sensitiveForm()
+	values := submission.Values{
+		"email":    "ada@example.com",
+		"password": "hunter2",
+		"billing":  map[string]any{"card": "4111", "zip": "10001"},
+		"contacts": []any{map[string]any{"ssn": int64(123456789)}},
+	}
+
+	sealed, err := submission.EncryptSensitive(form, values, enc)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	if values["password"] != "hunter2" {
+		t.Fatalf("expected input values to stay untouched")
+	}
+	stored, err := json.Marshal(sealed)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	for _, plaintext := range []string{"hunter2", "4111", "123456789"} {
+		if bytes.Contains(stored, []byte(plaintext)) {
+			t.Fatalf("expected %q to be encrypted, got %s", plaintext, stored)
+		}
+	}
+	for _, plaintext := range []string{"ada@example.com", "10001"} {
+		if !bytes.Contains(stored, []byte(plaintext)) {
+			t.Fatalf("expected %q to stay readable, got %s", plaintext, stored)
+		}
+	}
+
+	var loaded submission.Values
+	if err := json.Unmarshal(stored, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	opened, err := submission.DecryptSensitive(form, loaded, enc)
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	want := submission.Values{
+		"email":    "ada@example.com",
+		"password": "hunter2",
+		"billing":  map[string]any{"card": "4111", "zip": "10001"},
+		"contacts": []any{map[string]any{"ssn": float64(123456789)}},
+	}
+	if !reflect.DeepEqual(opened, want) {
+		t.Fatalf("decrypted = %#v, want %#v", opened, want)
+	}
+}
+
+func TestDecryptSensitiveKeyRotationAndPlaintext(t *testing.T) {
+	form := sensitiveForm()
+	oldKey, newKey := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+
+	old, err := submission.NewAESGCMEncrypter("old", oldKey)
+	if err != nil {
+		t.Fatalf("new encrypter: %v", err)
+	}
+	sealed, err := submission.EncryptSensitive(form, submission.Values{"password": "hunter2"}, old)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+
+	rotated, err := submission.NewAESGCMEncrypter("new", newKey)
+	if err != nil {
+		t.Fatalf("new encrypter: %v", err)
+	}
+	if _, err := submission.DecryptSensitive(form, sealed, rotated); err == nil {
+		t.Fatalf("expected unknown key error")
+	}
+	if err := rotated.AddKey("old", oldKey); err != nil {
+		t.Fatalf("add key: %v", err)
+	}
+	opened, err := submission.DecryptSensitive(form, sealed, rotated)
+	if err != nil || opened["password"] != "hunter2" {
+		t.Fatalf("expected rotated key to decrypt, got %v (%v)", opened, err)
+	}
+
+	legacy, err := submission.DecryptSensitive(form, submission.Values{"password": "plain"}, rotated)
+	if err != nil || legacy["password"] != "plain" {
+		t.Fatalf("expected unencrypted values to pass through, got %v (%v)", legacy, err)
+	}
+	if _, err := submission.EncryptSensitive(form, submission.Values{}, nil); err == nil {
+		t.Fatalf("expected nil encrypter error")
+	}
+}
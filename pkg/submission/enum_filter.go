@@ -0,0 +1,58 @@
+package submission
+
+import (
+	"fmt"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/visibility"
+	"github.com/goliatone/go-formgen/pkg/visibility/expr"
+)
+
+// validateEnumFilters enforces enum filters declared through the UI schema
+// `enumFilter` option. Filters are evaluated against the whole submission so
+// their conditions can reference sibling and parent fields. Values already
+// rejected by the static enum are left to validateEnum.
+func validateEnumFilters(fields []model.Field, obj map[string]any, path string, ctx visibility.Context) []Issue {
+	var issues []Issue
+	for _, field := range fields {
+		value, exists := obj[field.Name]
+		if !exists || isEmpty(value) {
+			continue
+		}
+		fieldPath := joinPath(path, field.Name)
+		if filter, ok := model.FieldEnumFilter(field); ok {
+			issues = append(issues, validateEnumFilter(field, filter, value, fieldPath, ctx)...)
+		}
+		if nested, ok := value.(map[string]any); ok && field.Type == model.FieldTypeObject {
+			issues = append(issues, validateEnumFilters(field.Nested, nested, fieldPath, ctx)...)
+		}
+	}
+	return issues
+}
+
+func validateEnumFilter(field model.Field, filter model.EnumFilter, value any, path string, ctx visibility.Context) []Issue {
+	active, err := expr.New().Eval(path, filter.When, ctx)
+	if err != nil || !active {
+		return nil
+	}
+	if items, ok := value.([]any); ok {
+		var issues []Issue
+		for i, item := range items {
+			issues = append(issues, checkEnumFilterValue(field, filter, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return issues
+	}
+	return checkEnumFilterValue(field, filter, value, path)
+}
+
+func checkEnumFilterValue(field model.Field, filter model.EnumFilter, value any, path string) []Issue {
+	if value == nil || len(validateEnum(field, value, path)) > 0 {
+		return nil
+	}
+	for _, allowed := range filter.Allow {
+		if enumValueEqual(allowed, value) {
+			return nil
+		}
+	}
+	return []Issue{issue(CodeEnum, path, makeMessage(field, path, "is not allowed for the current selection"), value)}
+}
@@ -0,0 +1,31 @@
+package submission
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// IdempotencyKey returns the idempotency key sent with a submission: the
+// Idempotency-Key header when present, otherwise the named hidden field from
+// an already parsed form body (an empty field uses
+// render.DefaultIdempotencyKeyField). It never reads the body, so call it
+// after ParseRequest. Handlers should record processed keys (with the
+// response they produced) and replay that response for a repeated key instead
+// of applying the submission twice.
+func IdempotencyKey(req *http.Request, field string) string {
+	if req == nil {
+		return ""
+	}
+	if key := strings.TrimSpace(req.Header.Get(render.IdempotencyKeyHeader)); key != "" {
+		return key
+	}
+	if strings.TrimSpace(field) == "" {
+		field = render.DefaultIdempotencyKeyField
+	}
+	if req.PostForm != nil {
+		return strings.TrimSpace(req.PostForm.Get(field))
+	}
+	return ""
+}
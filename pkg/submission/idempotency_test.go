@@ -0,0 +1,31 @@
+package submission_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+func TestIdempotencyKeyPrefersHeaderOverParsedField(t *testing.T) {
+	form := uploadForm()
+	req := httptest.NewRequest("POST", "/avatars", strings.NewReader("caption=hi&_idempotency_key=from-field"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if got := submission.IdempotencyKey(req, ""); got != "" {
+		t.Fatalf("expected no key before parsing, got %q", got)
+	}
+	if _, err := submission.ParseRequest(form, req, submission.WithUnknownFields(submission.UnknownIgnore)); err != nil {
+		t.Fatalf("parse request: %v", err)
+	}
+	if got := submission.IdempotencyKey(req, ""); got != "from-field" {
+		t.Fatalf("field key = %q, want from-field", got)
+	}
+
+	req.Header.Set(render.IdempotencyKeyHeader, " from-header ")
+	if got := submission.IdempotencyKey(req, ""); got != "from-header" {
+		t.Fatalf("header key = %q, want from-header", got)
+	}
+}
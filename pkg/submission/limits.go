@@ -0,0 +1,292 @@
+package submission
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	defaultLimitBodyBytes     = int64(10 << 20)
+	defaultLimitArrayItems    = 1000
+	minLimitBoundedArrayItems = 100
+	defaultLimitFields        = 10000
+	defaultLimitFieldSlack    = 64
+	defaultLimitDepthSlack    = 8
+	defaultLimitFreeFormDepth = 32
+)
+
+// ErrLimitExceeded matches every *LimitError via errors.Is.
+var ErrLimitExceeded = errors.New("submission: limit exceeded")
+
+// LimitKind names the guard a submission tripped.
+type LimitKind string
+
+const (
+	LimitBodyBytes  LimitKind = "bodyBytes"
+	LimitFields     LimitKind = "fields"
+	LimitArrayItems LimitKind = "arrayItems"
+	LimitDepth      LimitKind = "depth"
+)
+
+// LimitError rejects a submission that exceeds a Limits guard. It is returned
+// before any value is coerced, so handlers can answer 413 (body size) or 400
+// without inspecting the payload.
+type LimitError struct {
+	Kind  LimitKind
+	Limit int64
+	// Path is the submitted key (or JSON path) that crossed the limit; empty
+	// for body size and field count.
+	Path string
+}
+
+func (e *LimitError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("submission: %s limit %d exceeded at %q", e.Kind, e.Limit, e.Path)
+	}
+	return fmt.Sprintf("submission: %s limit %d exceeded", e.Kind, e.Limit)
+}
+
+// Is reports whether target is ErrLimitExceeded.
+func (e *LimitError) Is(target error) bool {
+	return target == ErrLimitExceeded
+}
+
+// Limits bounds a submission before it is parsed. Zero values fall back to
+// DefaultLimits for the form; negative values disable the guard.
+type Limits struct {
+	// MaxBodyBytes caps the request body read by ParseRequest.
+	MaxBodyBytes int64
+	// MaxFields caps the number of submitted values (repeated keys and JSON
+	// array elements count individually).
+	MaxFields int
+	// MaxArrayItems caps the length of any submitted array, including the
+	// largest index used in bracket paths such as items[9].
+	MaxArrayItems int
+	// MaxDepth caps path nesting; each object key and array index is a level.
+	MaxDepth int
+}
+
+// WithLimits sets the guards applied by ParseRequest and ParseJSON.
+func WithLimits(limits Limits) Option {
+	return func(opts *Options) {
+		opts.Limits = limits
+	}
+}
+
+// DefaultLimits derives guards from the form's own constraints:
+//   - the array limit is twice the largest declared maxItems (at least 100,
+//     1000 when an array is unbounded), so ordinary overflows still surface as
+//     maxItems validation issues;
+//   - the field limit is the number of values the form can hold plus slack for
+//     hidden inputs;
+//   - the depth limit is the model depth plus slack (32 when a free-form
+//     object accepts arbitrary JSON);
+//   - the body limit is 10 MiB, or the 64 MiB multipart default when the form
+//     has file inputs.
+func DefaultLimits(form model.FormModel) Limits {
+	var shape limitShape
+	shape.walk(form.Fields)
+	if shape.maxItems == 0 {
+		shape.maxItems = defaultLimitArrayItems
+	}
+
+	limits := Limits{
+		MaxBodyBytes:  defaultLimitBodyBytes,
+		MaxFields:     int(min(capacity(form.Fields), defaultLimitFields)) + defaultLimitFieldSlack,
+		MaxArrayItems: shape.maxItems,
+		MaxDepth:      model.Stats(form).MaxDepth + defaultLimitDepthSlack,
+	}
+	if shape.files {
+		limits.MaxBodyBytes = defaultMultipartMaxBodyBytes
+	}
+	if shape.freeForm {
+		limits.MaxDepth = max(limits.MaxDepth, defaultLimitFreeFormDepth)
+	}
+	return limits
+}
+
+// resolve fills zero values from the form defaults.
+func (l Limits) resolve(form model.FormModel) Limits {
+	if l.MaxBodyBytes != 0 && l.MaxFields != 0 && l.MaxArrayItems != 0 && l.MaxDepth != 0 {
+		return l
+	}
+	defaults := DefaultLimits(form)
+	if l.MaxBodyBytes == 0 {
+		l.MaxBodyBytes = defaults.MaxBodyBytes
+	}
+	if l.MaxFields == 0 {
+		l.MaxFields = defaults.MaxFields
+	}
+	if l.MaxArrayItems == 0 {
+		l.MaxArrayItems = defaults.MaxArrayItems
+	}
+	if l.MaxDepth == 0 {
+		l.MaxDepth = defaults.MaxDepth
+	}
+	return l
+}
+
+type limitShape struct {
+	maxItems int
+	files    bool
+	freeForm bool
+}
+
+func (s *limitShape) walk(fields []model.Field) {
+	for _, field := range fields {
+		switch field.Type {
+		case model.FieldTypeArray:
+			s.maxItems = max(s.maxItems, arrayLimit(field))
+		case model.FieldTypeObject:
+			if len(field.Nested) == 0 {
+				s.freeForm = true
+			}
+		}
+		if field.Format == "binary" || field.Format == "byte" || field.UIHints["inputType"] == "file" {
+			s.files = true
+		}
+		s.walk(field.Nested)
+		if field.Items != nil {
+			s.walk([]model.Field{*field.Items})
+		}
+	}
+}
+
+// capacity counts the values the fields can hold, saturating at the default
+// field limit so nested unbounded arrays cannot overflow.
+func capacity(fields []model.Field) int64 {
+	var total int64
+	for _, field := range fields {
+		total += fieldCapacity(field)
+		if total >= defaultLimitFields {
+			return defaultLimitFields
+		}
+	}
+	return total
+}
+
+func fieldCapacity(field model.Field) int64 {
+	switch {
+	case field.Type == model.FieldTypeArray:
+		items := arrayLimit(field)
+		per := int64(1)
+		if field.Items != nil {
+			per = max(fieldCapacity(*field.Items), 1)
+		}
+		return min(int64(items)*per, defaultLimitFields)
+	case len(field.Nested) > 0:
+		return capacity(field.Nested)
+	default:
+		return 1
+	}
+}
+
+func arrayLimit(field model.Field) int {
+	for _, rule := range field.Validations {
+		if rule.Kind == model.ValidationRuleMaxItems {
+			if limit, ok := ruleInt(rule); ok && limit > 0 {
+				return max(2*limit, minLimitBoundedArrayItems)
+			}
+		}
+	}
+	return defaultLimitArrayItems
+}
+
+func bodyLimitError(err error, limit int64) error {
+	var maxBytes *http.MaxBytesError
+	if errors.As(err, &maxBytes) {
+		return &LimitError{Kind: LimitBodyBytes, Limit: limit}
+	}
+	return err
+}
+
+// readLimited reads body, failing with a LimitError once it exceeds limit.
+// Non-positive limits read the whole body.
+func readLimited(body io.Reader, limit int64) ([]byte, error) {
+	if limit <= 0 {
+		return io.ReadAll(body)
+	}
+	data, err := io.ReadAll(io.LimitReader(body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, &LimitError{Kind: LimitBodyBytes, Limit: limit}
+	}
+	return data, nil
+}
+
+// checkValueLimits guards urlencoded and multipart values.
+func checkValueLimits(limits Limits, values url.Values) error {
+	fields := 0
+	for key, list := range values {
+		fields += max(len(list), 1)
+		if limits.MaxFields > 0 && fields > limits.MaxFields {
+			return &LimitError{Kind: LimitFields, Limit: int64(limits.MaxFields)}
+		}
+		segments := parsePath(key)
+		if limits.MaxDepth > 0 && len(segments) > limits.MaxDepth {
+			return &LimitError{Kind: LimitDepth, Limit: int64(limits.MaxDepth), Path: key}
+		}
+		if limits.MaxArrayItems <= 0 {
+			continue
+		}
+		for i, segment := range segments {
+			items := 0
+			switch {
+			case segment.Index != nil:
+				items = *segment.Index + 1
+			case segment.Append || i == len(segments)-1:
+				items = len(list)
+			}
+			if items > limits.MaxArrayItems {
+				return &LimitError{Kind: LimitArrayItems, Limit: int64(limits.MaxArrayItems), Path: key}
+			}
+		}
+	}
+	return nil
+}
+
+// checkJSONLimits guards a decoded JSON object.
+func checkJSONLimits(limits Limits, payload map[string]any) error {
+	fields := 0
+	return walkJSONLimits(limits, payload, "", 0, &fields)
+}
+
+func walkJSONLimits(limits Limits, value any, path string, depth int, fields *int) error {
+	if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+		return &LimitError{Kind: LimitDepth, Limit: int64(limits.MaxDepth), Path: path}
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			if err := walkJSONLimits(limits, child, childPath, depth+1, fields); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if limits.MaxArrayItems > 0 && len(v) > limits.MaxArrayItems {
+			return &LimitError{Kind: LimitArrayItems, Limit: int64(limits.MaxArrayItems), Path: path}
+		}
+		for i, child := range v {
+			if err := walkJSONLimits(limits, child, fmt.Sprintf("%s[%d]", path, i), depth+1, fields); err != nil {
+				return err
+			}
+		}
+	default:
+		*fields++
+		if limits.MaxFields > 0 && *fields > limits.MaxFields {
+			return &LimitError{Kind: LimitFields, Limit: int64(limits.MaxFields)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package submission_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+func boundedForm() model.FormModel {
+	return model.FormModel{
+		Fields: []model.Field{
+			{Name: "title", Type: model.FieldTypeString},
+			{
+				Name:        "tags",
+				Type:        model.FieldTypeArray,
+				Items:       &model.Field{Name: "tag", Type: model.FieldTypeString},
+				Validations: []model.ValidationRule{{Kind: model.ValidationRuleMaxItems, Params: map[string]string{"value": "80"}}},
+			},
+		},
+	}
+}
+
+func TestDefaultLimitsDeriveFromForm(t *testing.T) {
+	got := submission.DefaultLimits(boundedForm())
+	want := submission.Limits{
+		MaxBodyBytes:  10 << 20,
+		MaxFields:     1 + 160 + 64,
+		MaxArrayItems: 160,
+		MaxDepth:      2 + 8,
+	}
+	if got != want {
+		t.Fatalf("DefaultLimits(bounded) = %+v, want %+v", got, want)
+	}
+
+	open := submission.DefaultLimits(testForm())
+	if open.MaxArrayItems != 1000 || open.MaxDepth != 32 {
+		t.Fatalf("expected unbounded array and free-form object defaults, got %+v", open)
+	}
+	if uploads := submission.DefaultLimits(uploadForm()); uploads.MaxBodyBytes != 64<<20 {
+		t.Fatalf("expected multipart body default for file forms, got %+v", uploads)
+	}
+}
+
+func TestParseRequestRejectsOversizedSubmissions(t *testing.T) {
+	form := boundedForm()
+	tooManyTags := url.Values{"tags": make([]string, 161)}
+
+	cases := []struct {
+		name        string
+		contentType string
+		body        string
+		limits      submission.Limits
+		want        submission.LimitError
+	}{
+		{
+			name:        "body bytes urlencoded",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "title=" + strings.Repeat("a", 64),
+			limits:      submission.Limits{MaxBodyBytes: 32},
+			want:        submission.LimitError{Kind: submission.LimitBodyBytes, Limit: 32},
+		},
+		{
+			name:        "body bytes json",
+			contentType: "application/json",
+			body:        `{"title":"` + strings.Repeat("a", 64) + `"}`,
+			limits:      submission.Limits{MaxBodyBytes: 32},
+			want:        submission.LimitError{Kind: submission.LimitBodyBytes, Limit: 32},
+		},
+		{
+			name:        "array items from repeated keys",
+			contentType: "application/x-www-form-urlencoded",
+			body:        tooManyTags.Encode(),
+			want:        submission.LimitError{Kind: submission.LimitArrayItems, Limit: 160, Path: "tags"},
+		},
+		{
+			name:        "array items from bracket index",
+			contentType: "application/x-www-form-urlencoded",
+			body:        url.Values{"tags[5000]": {"x"}}.Encode(),
+			want:        submission.LimitError{Kind: submission.LimitArrayItems, Limit: 160, Path: "tags[5000]"},
+		},
+		{
+			name:        "fields",
+			contentType: "application/x-www-form-urlencoded",
+			body:        manyKeys(10),
+			limits:      submission.Limits{MaxFields: 5},
+			want:        submission.LimitError{Kind: submission.LimitFields, Limit: 5},
+		},
+		{
+			name:        "depth",
+			contentType: "application/x-www-form-urlencoded",
+			body:        url.Values{"a.b.c.d.e.f.g.h.i.j.k": {"x"}}.Encode(),
+			want:        submission.LimitError{Kind: submission.LimitDepth, Limit: 10, Path: "a.b.c.d.e.f.g.h.i.j.k"},
+		},
+		{
+			name:        "json depth",
+			contentType: "application/json",
+			body:        `{"a":{"b":{"c":[1]}}}`,
+			limits:      submission.Limits{MaxDepth: 3},
+			want:        submission.LimitError{Kind: submission.LimitDepth, Limit: 3, Path: "a.b.c[0]"},
+		},
+		{
+			name:        "json array items",
+			contentType: "application/json",
+			body:        `{"tags":["a","b","c"]}`,
+			limits:      submission.Limits{MaxArrayItems: 2},
+			want:        submission.LimitError{Kind: submission.LimitArrayItems, Limit: 2, Path: "tags"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+			_, err := submission.ParseRequest(form, req, submission.WithLimits(tc.limits))
+			if !errors.Is(err, submission.ErrLimitExceeded) {
+				t.Fatalf("expected limit error, got %v", err)
+			}
+			var limitErr *submission.LimitError
+			if !errors.As(err, &limitErr) || *limitErr != tc.want {
+				t.Fatalf("limit error = %+v, want %+v", limitErr, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseRequestWithinLimitsAndDisabledGuards(t *testing.T) {
+	form := boundedForm()
+	body := url.Values{"title": {"Hello"}, "tags": make([]string, 100)}.Encode()
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	result, err := submission.ParseRequest(form, req)
+	if err != nil {
+		t.Fatalf("parse request: %v", err)
+	}
+	issues := submission.Validate(form, result.Values)
+	if len(issues) != 1 || issues[0].Code != submission.CodeMaxItems {
+		t.Fatalf("expected overflow within the guard to surface as a maxItems issue, got %+v", issues)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(manyKeys(500)))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, err = submission.ParseRequest(form, req,
+		submission.WithUnknownFields(submission.UnknownIgnore),
+		submission.WithLimits(submission.Limits{MaxFields: -1}),
+	)
+	if err != nil {
+		t.Fatalf("expected disabled field guard to accept the request, got %v", err)
+	}
+}
+
+func manyKeys(n int) string {
+	values := url.Values{}
+	for i := range n {
+		values.Set(fmt.Sprintf("extra%d", i), "x")
+	}
+	return values.Encode()
+}
@@ -0,0 +1,210 @@
+package submission
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+const defaultFileContentType = "application/octet-stream"
+
+// File is an upload written as a multipart file part. Body is streamed, and
+// closed once copied when it implements io.Closer.
+type File struct {
+	Filename    string
+	ContentType string
+	Body        io.Reader
+}
+
+// MultipartBody streams values and files as a multipart/form-data body laid
+// out for form, returning the body and its Content-Type header value. Parts are
+// produced on demand through an io.Pipe, so files are never buffered in
+// memory; the caller must read the body to completion or close it.
+func MultipartBody(form model.FormModel, values Values, files map[string][]File) (io.ReadCloser, string) {
+	reader, writer := io.Pipe()
+	mw := multipart.NewWriter(writer)
+	go func() {
+		err := WriteMultipart(mw, form, values, files)
+		if err == nil {
+			err = mw.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+	return reader, mw.FormDataContentType()
+}
+
+// WriteMultipart writes values and files to mw following the form's part
+// encodings. Parts follow the form's field order; keys the form does not
+// declare are written afterwards in sorted order. Scalars become text parts,
+// arrays repeat the part name per item, and objects (or fields whose
+// encoding.contentType is JSON) are written as JSON parts. File parts use the
+// File content type, then a single declared encoding.contentType, then
+// application/octet-stream. The writer is not closed.
+func WriteMultipart(mw *multipart.Writer, form model.FormModel, values Values, files map[string][]File) error {
+	if mw == nil {
+		return fmt.Errorf("submission: multipart writer is nil")
+	}
+	fields := make(map[string]model.Field, len(form.Fields))
+	names := make([]string, 0, len(form.Fields)+len(values)+len(files))
+	for _, field := range form.Fields {
+		if field.Name == "" {
+			continue
+		}
+		fields[field.Name] = field
+		names = append(names, field.Name)
+	}
+	var extra []string
+	for name := range values {
+		if _, ok := fields[name]; !ok {
+			extra = append(extra, name)
+		}
+	}
+	for name := range files {
+		if _, ok := fields[name]; ok {
+			continue
+		}
+		if _, ok := values[name]; !ok {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	names = append(names, extra...)
+
+	for _, name := range names {
+		field := fields[name]
+		if value, ok := values[name]; ok && value != nil {
+			if err := writeValueParts(mw, field, name, value); err != nil {
+				return err
+			}
+		}
+		for _, file := range files[name] {
+			if err := writeFilePart(mw, field, name, file); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeValueParts(mw *multipart.Writer, field model.Field, name string, value any) error {
+	encoding, _ := field.Encoding()
+	if isJSONContentType(encoding.ContentType) {
+		return writeJSONPart(mw, name, encoding.ContentType, value)
+	}
+	switch typed := value.(type) {
+	case map[string]any, Values:
+		return writeJSONPart(mw, name, "", typed)
+	case []any:
+		for _, item := range typed {
+			if err := writeValueParts(mw, model.Field{}, name, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []string:
+		for _, item := range typed {
+			if err := writeTextPart(mw, name, encoding.ContentType, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if field.Type == model.FieldTypeObject {
+			return writeJSONPart(mw, name, "", typed)
+		}
+		return writeTextPart(mw, name, encoding.ContentType, fmt.Sprint(typed))
+	}
+}
+
+func writeTextPart(mw *multipart.Writer, name, contentType, value string) error {
+	part, err := mw.CreatePart(partHeader(name, "", singleContentType(contentType)))
+	if err != nil {
+		return fmt.Errorf("submission: create part %q: %w", name, err)
+	}
+	if _, err := io.WriteString(part, value); err != nil {
+		return fmt.Errorf("submission: write part %q: %w", name, err)
+	}
+	return nil
+}
+
+func writeJSONPart(mw *multipart.Writer, name, contentType string, value any) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("submission: encode part %q: %w", name, err)
+	}
+	if contentType = singleContentType(contentType); contentType == "" {
+		contentType = "application/json"
+	}
+	part, err := mw.CreatePart(partHeader(name, "", contentType))
+	if err != nil {
+		return fmt.Errorf("submission: create part %q: %w", name, err)
+	}
+	if _, err := part.Write(payload); err != nil {
+		return fmt.Errorf("submission: write part %q: %w", name, err)
+	}
+	return nil
+}
+
+func writeFilePart(mw *multipart.Writer, field model.Field, name string, file File) error {
+	if closer, ok := file.Body.(io.Closer); ok {
+		defer closer.Close()
+	}
+	if file.Body == nil {
+		return fmt.Errorf("submission: file part %q has no body", name)
+	}
+	contentType := strings.TrimSpace(file.ContentType)
+	if contentType == "" {
+		encoding, _ := field.Encoding()
+		contentType = singleContentType(encoding.ContentType)
+	}
+	if contentType == "" {
+		contentType = defaultFileContentType
+	}
+	part, err := mw.CreatePart(partHeader(name, file.Filename, contentType))
+	if err != nil {
+		return fmt.Errorf("submission: create part %q: %w", name, err)
+	}
+	if _, err := io.Copy(part, file.Body); err != nil {
+		return fmt.Errorf("submission: write part %q: %w", name, err)
+	}
+	return nil
+}
+
+func partHeader(name, filename, contentType string) textproto.MIMEHeader {
+	params := map[string]string{"name": name}
+	if filename != "" {
+		params["filename"] = filename
+	}
+	header := make(textproto.MIMEHeader, 2)
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", params))
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	return header
+}
+
+// singleContentType returns contentType when it names exactly one concrete
+// media type; lists and wildcards describe what a part may contain and cannot
+// label a written part.
+func singleContentType(contentType string) string {
+	contentType = strings.TrimSpace(contentType)
+	if contentType == "" || strings.Contains(contentType, ",") || strings.Contains(contentType, "*") {
+		return ""
+	}
+	return contentType
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(singleContentType(contentType))
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,136 @@
+package submission_test
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/submission"
+)
+
+type writtenPart struct {
+	Name        string
+	Filename    string
+	ContentType string
+	Body        string
+}
+
+func uploadForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "uploadAvatar",
+		Fields: []model.Field{
+			{Name: "caption", Type: model.FieldTypeString},
+			{Name: "tags", Type: model.FieldTypeArray, Items: &model.Field{Name: "tagsItem", Type: model.FieldTypeString}},
+			{Name: "meta", Type: model.FieldTypeObject, Nested: []model.Field{{Name: "source", Type: model.FieldTypeString}}},
+			{Name: "file", Type: model.FieldTypeString, Format: "binary", Metadata: map[string]string{"encoding.contentType": "image/png"}},
+			{Name: "attachments", Type: model.FieldTypeArray, Items: &model.Field{Name: "attachmentsItem", Type: model.FieldTypeString, Format: "binary"}},
+		},
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestMultipartBodyStreamsPartsInFormOrder(t *testing.T) {
+	avatar := &closeRecorder{Reader: strings.NewReader("png-bytes")}
+	body, contentType := submission.MultipartBody(uploadForm(), submission.Values{
+		"extra":   "kept",
+		"caption": "Hello",
+		"tags":    []any{"a", "b"},
+		"meta":    map[string]any{"source": "camera"},
+	}, map[string][]submission.File{
+		"file": {{Filename: "avatar.png", Body: avatar}},
+		"attachments": {
+			{Filename: "one.txt", ContentType: "text/plain", Body: strings.NewReader("one")},
+			{Filename: "two.bin", Body: strings.NewReader("two")},
+		},
+	})
+	defer body.Close()
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("unexpected content type %q: %v", contentType, err)
+	}
+	reader := multipart.NewReader(body, params["boundary"])
+	var parts []writtenPart
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("next part: %v", err)
+		}
+		payload, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		parts = append(parts, writtenPart{
+			Name:        part.FormName(),
+			Filename:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Body:        string(payload),
+		})
+	}
+
+	want := []writtenPart{
+		{Name: "caption", Body: "Hello"},
+		{Name: "tags", Body: "a"},
+		{Name: "tags", Body: "b"},
+		{Name: "meta", ContentType: "application/json", Body: `{"source":"camera"}`},
+		{Name: "file", Filename: "avatar.png", ContentType: "image/png", Body: "png-bytes"},
+		{Name: "attachments", Filename: "one.txt", ContentType: "text/plain", Body: "one"},
+		{Name: "attachments", Filename: "two.bin", ContentType: "application/octet-stream", Body: "two"},
+		{Name: "extra", Body: "kept"},
+	}
+	if diff := cmp.Diff(want, parts); diff != "" {
+		t.Fatalf("parts mismatch (-want +got):\n%s", diff)
+	}
+	if !avatar.closed {
+		t.Fatal("expected file body to be closed after copying")
+	}
+}
+
+func TestMultipartBodyRoundTripsThroughParseRequest(t *testing.T) {
+	form := uploadForm()
+	body, contentType := submission.MultipartBody(form, submission.Values{
+		"caption": "Hello",
+		"tags":    []any{"a", "b"},
+	}, nil)
+	defer body.Close()
+
+	req := httptest.NewRequest("POST", "/avatars", body)
+	req.Header.Set("Content-Type", contentType)
+	result, err := submission.ParseRequest(form, req)
+	if err != nil {
+		t.Fatalf("parse request: %v", err)
+	}
+	want := submission.Values{"caption": "Hello", "tags": []any{"a", "b"}}
+	if diff := cmp.Diff(want, result.Values); diff != "" {
+		t.Fatalf("values mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMultipartBodyReportsMissingFileBody(t *testing.T) {
+	body, _ := submission.MultipartBody(uploadForm(), nil, map[string][]submission.File{
+		"file": {{Filename: "empty.png"}},
+	})
+	defer body.Close()
+
+	if _, err := io.ReadAll(body); err == nil || !strings.Contains(err.Error(), "has no body") {
+		t.Fatalf("expected missing body error, got %v", err)
+	}
+}
@@ -14,41 +14,63 @@ import (
 	"github.com/goliatone/go-formgen/pkg/model"
 )
 
-// ParseRequest parses a submitted HTTP request using its content type.
+// ParseRequest parses a submitted HTTP request using its content type. The
+// body and its shape are checked against the configured Limits (DefaultLimits
+// for the form unless WithLimits overrides them) before any value is parsed;
+// violations return a *LimitError.
 func ParseRequest(form model.FormModel, req *http.Request, options ...Option) (Result, error) {
 	if req == nil {
 		return Result{}, fmt.Errorf("submission: request is nil")
 	}
+	cfg := applyOptions(options)
+	limits := cfg.Limits.resolve(form)
 	contentType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
 	switch strings.ToLower(contentType) {
 	case "application/json":
-		body, err := io.ReadAll(req.Body)
+		body, err := readLimited(req.Body, limits.MaxBodyBytes)
 		if err != nil {
 			return Result{}, err
 		}
 		return ParseJSON(form, body, options...)
 	case "multipart/form-data":
-		cfg := applyOptions(options)
-		req.Body = http.MaxBytesReader(nil, req.Body, cfg.MaxBodyBytes)
+		maxBody := cfg.MaxBodyBytes
+		if limits.MaxBodyBytes > 0 {
+			maxBody = min(maxBody, limits.MaxBodyBytes)
+		}
+		req.Body = http.MaxBytesReader(nil, req.Body, maxBody)
 		// #nosec G120 -- MaxBytesReader caps the total multipart body before parsing.
 		if err := req.ParseMultipartForm(cfg.MaxMemory); err != nil {
-			return Result{}, err
+			return Result{}, bodyLimitError(err, maxBody)
 		}
 		values := url.Values{}
+		counted := url.Values{}
 		if req.MultipartForm != nil {
 			for key, list := range req.MultipartForm.Value {
 				values[key] = append([]string(nil), list...)
+				counted[key] = list
+			}
+			for key, files := range req.MultipartForm.File {
+				counted[key] = append(counted[key], make([]string, len(files))...)
 			}
 		}
+		if err := checkValueLimits(limits, counted); err != nil {
+			return Result{}, err
+		}
 		return ParseValues(form, values, options...), nil
 	case "application/x-www-form-urlencoded", "":
+		if req.PostForm == nil && req.Body != nil && limits.MaxBodyBytes > 0 {
+			req.Body = http.MaxBytesReader(nil, req.Body, limits.MaxBodyBytes)
+		}
 		if err := req.ParseForm(); err != nil {
+			return Result{}, bodyLimitError(err, limits.MaxBodyBytes)
+		}
+		if err := checkValueLimits(limits, req.PostForm); err != nil {
 			return Result{}, err
 		}
 		return ParseValues(form, req.PostForm, options...), nil
 	default:
 		if strings.HasSuffix(strings.ToLower(contentType), "+json") {
-			body, err := io.ReadAll(req.Body)
+			body, err := readLimited(req.Body, limits.MaxBodyBytes)
 			if err != nil {
 				return Result{}, err
 			}
@@ -58,7 +80,8 @@ func ParseRequest(form model.FormModel, req *http.Request, options ...Option) (R
 	}
 }
 
-// ParseJSON parses an application/json body into submitted Values.
+// ParseJSON parses an application/json body into submitted Values. Field
+// count, array length, and depth limits apply as in ParseRequest.
 func ParseJSON(form model.FormModel, body []byte, options ...Option) (Result, error) {
 	var payload any
 	if err := strictDecodeJSON(bytes.NewReader(body), &payload); err != nil {
@@ -74,6 +97,9 @@ func ParseJSON(form model.FormModel, body []byte, options ...Option) (Result, er
 			Issues: []Issue{issue(CodeType, "", "JSON body must be an object", payload)},
 		}, nil
 	}
+	if err := checkJSONLimits(applyOptions(options).Limits.resolve(form), obj); err != nil {
+		return Result{}, err
+	}
 	return ParseMap(form, obj, options...), nil
 }
 
@@ -0,0 +1,38 @@
+package submission
+
+import (
+	"fmt"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+)
+
+// validateTransitions rejects submitted values that move a field to a state
+// its declared transitions do not allow from the stored value. Fields missing
+// from either side are skipped.
+func validateTransitions(fields []model.Field, obj, current map[string]any, path string) []Issue {
+	var issues []Issue
+	for _, field := range fields {
+		value, exists := obj[field.Name]
+		if !exists || isEmpty(value) {
+			continue
+		}
+		fieldPath := joinPath(path, field.Name)
+		if nested, ok := value.(map[string]any); ok && field.Type == model.FieldTypeObject {
+			if stored, ok := current[field.Name].(map[string]any); ok {
+				issues = append(issues, validateTransitions(field.Nested, nested, stored, fieldPath)...)
+			}
+			continue
+		}
+		transitions, ok := model.FieldTransitions(field)
+		if !ok {
+			continue
+		}
+		from := model.TransitionState(current[field.Name])
+		to := model.TransitionState(value)
+		if transitions.Allows(from, to) {
+			continue
+		}
+		issues = append(issues, issue(CodeTransition, fieldPath, makeMessage(field, fieldPath, fmt.Sprintf("cannot change from %q to %q", from, to)), value))
+	}
+	return issues
+}
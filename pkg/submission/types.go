@@ -42,6 +42,10 @@ type Options struct {
 	EmptyStrings  EmptyStringPolicy
 	MaxMemory     int64
 	MaxBodyBytes  int64
+	Limits        Limits
+	// Current holds the stored record values. When set, Validate rejects
+	// changes that break field transitions.
+	Current Values
 }
 
 // Option mutates Options.
@@ -105,6 +109,14 @@ func WithMultipartMaxBodyBytes(maxBodyBytes int64) Option {
 	}
 }
 
+// WithCurrentValues supplies the stored record so Validate can check
+// transitions declared on status-like fields (`x-formgen: {transitions: ...}`).
+func WithCurrentValues(current Values) Option {
+	return func(opts *Options) {
+		opts.Current = current
+	}
+}
+
 // Result contains parsed values and any non-fatal parse issues.
 type Result struct {
 	Values Values
@@ -128,12 +140,14 @@ const (
 	CodeEnum         IssueCode = "enum"
 	CodeMin          IssueCode = "min"
 	CodeMax          IssueCode = "max"
+	CodePrecision    IssueCode = "precision"
 	CodeMinLength    IssueCode = "minLength"
 	CodeMaxLength    IssueCode = "maxLength"
 	CodePattern      IssueCode = "pattern"
 	CodeMinItems     IssueCode = "minItems"
 	CodeMaxItems     IssueCode = "maxItems"
 	CodeObject       IssueCode = "object"
+	CodeTransition   IssueCode = "transition"
 )
 
 // Issue describes a parser or validation problem.
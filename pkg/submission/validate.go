@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/visibility"
 )
 
 // Validate checks parsed values against FormModel field constraints.
@@ -18,6 +19,10 @@ func Validate(form model.FormModel, values Values, options ...Option) []Issue {
 		value, exists := values[field.Name]
 		issues = append(issues, validateField(field, value, exists, field.Name, opts)...)
 	}
+	issues = append(issues, validateEnumFilters(form.Fields, values, "", visibility.Context{Values: values})...)
+	if opts.Current != nil {
+		issues = append(issues, validateTransitions(form.Fields, values, opts.Current, "")...)
+	}
 	return issues
 }
 
@@ -29,7 +34,8 @@ func validateField(field model.Field, value any, exists bool, path string, opts
 		return nil
 	}
 	if isEmpty(value) {
-		if field.Type != model.FieldTypeArray {
+		// Absent or null arrays are treated as empty rather than mistyped.
+		if field.Type != model.FieldTypeArray || value == nil {
 			if field.Required {
 				return []Issue{issue(CodeRequired, path, makeMessage(field, path, "is required"), value)}
 			}
@@ -88,6 +94,9 @@ func validateNumberField(field model.Field, value any, path string) []Issue {
 	var issues []Issue
 	issues = append(issues, validateEnum(field, num, path)...)
 	issues = append(issues, validateNumberRules(field, num, path)...)
+	if precision, ok := model.FieldPrecision(field); ok && model.DecimalPlaces(num) > precision {
+		issues = append(issues, issue(CodePrecision, path, makeMessage(field, path, fmt.Sprintf("must have at most %d decimal places", precision)), value))
+	}
 	return issues
 }
 
@@ -0,0 +1,118 @@
+// Package turbo adapts rendered forms to Hotwire Turbo for server-rendered
+// apps that use Turbo instead of the bundled runtime. Forms are rendered
+// inside a <turbo-frame> (render.RenderOptions.Turbo) and rejected
+// submissions are answered with the re-rendered frame, either as a Turbo
+// Stream or as frame-wrapped HTML that Turbo matches by id.
+package turbo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+)
+
+// StreamContentType is the media type of Turbo Stream responses. Turbo adds
+// it to the Accept header of form submissions.
+const StreamContentType = "text/vnd.turbo-stream.html"
+
+// Action names a Turbo Stream action.
+type Action string
+
+const (
+	ActionAppend  Action = "append"
+	ActionPrepend Action = "prepend"
+	ActionReplace Action = "replace"
+	ActionUpdate  Action = "update"
+	ActionRemove  Action = "remove"
+	ActionBefore  Action = "before"
+	ActionAfter   Action = "after"
+)
+
+// Stream returns a <turbo-stream> element applying action to the element with
+// the target id. content is trusted markup placed inside the <template>; it is
+// omitted for ActionRemove.
+func Stream(action Action, target string, content []byte) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<turbo-stream action="%s" target="%s">`, html.EscapeString(string(action)), html.EscapeString(target))
+	if action != ActionRemove {
+		b.WriteString("<template>")
+		b.Write(content)
+		b.WriteString("</template>")
+	}
+	b.WriteString("</turbo-stream>")
+	return b.Bytes()
+}
+
+// AcceptsStream reports whether the request advertises Turbo Stream support.
+func AcceptsStream(r *http.Request) bool {
+	if r == nil {
+		return false
+	}
+	for _, value := range r.Header.Values("Accept") {
+		for _, part := range strings.Split(value, ",") {
+			mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err == nil && mediaType == StreamContentType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WriteStream writes the stream elements as a Turbo Stream response.
+func WriteStream(w http.ResponseWriter, status int, streams ...[]byte) error {
+	w.Header().Set("Content-Type", StreamContentType+"; charset=utf-8")
+	w.WriteHeader(status)
+	for _, stream := range streams {
+		if _, err := w.Write(stream); err != nil {
+			return fmt.Errorf("turbo: write stream: %w", err)
+		}
+	}
+	return nil
+}
+
+// RespondInvalid re-renders form inside its Turbo frame with the values and
+// errors carried by opts and writes it with 422 Unprocessable Entity, the
+// status Turbo requires to render a rejected submission. Requests accepting
+// Turbo Streams receive a replace stream targeting the frame, rendered without
+// assets the page already loaded; other requests receive the full HTML, from
+// which Turbo extracts the matching frame (and which still works without
+// Turbo). A nil opts.Turbo uses the default frame id.
+func RespondInvalid(ctx context.Context, w http.ResponseWriter, r *http.Request, renderer render.Renderer, form model.FormModel, opts render.RenderOptions) error {
+	if renderer == nil {
+		return fmt.Errorf("turbo: renderer is nil")
+	}
+	turbo := render.TurboOptions{}
+	if opts.Turbo != nil {
+		turbo = *opts.Turbo
+	}
+	if strings.TrimSpace(turbo.FrameID) == "" {
+		turbo.FrameID = render.TurboFrameID(form.OperationID)
+	}
+	opts.Turbo = &turbo
+
+	stream := AcceptsStream(r)
+	if stream {
+		opts.OmitAssets = true
+	}
+	output, err := renderer.Render(ctx, form, opts)
+	if err != nil {
+		return fmt.Errorf("turbo: render form: %w", err)
+	}
+	if stream {
+		return WriteStream(w, http.StatusUnprocessableEntity, Stream(ActionReplace, turbo.FrameID, output))
+	}
+	w.Header().Set("Content-Type", renderer.ContentType())
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	if _, err := w.Write(output); err != nil {
+		return fmt.Errorf("turbo: write response: %w", err)
+	}
+	return nil
+}
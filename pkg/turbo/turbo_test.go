@@ -0,0 +1,125 @@
+package turbo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
+	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
+	"github.com/goliatone/go-formgen/pkg/testsupport"
+	"github.com/goliatone/go-formgen/pkg/turbo"
+)
+
+func petForm() model.FormModel {
+	return model.FormModel{
+		OperationID: "createPet",
+		Endpoint:    "/pets",
+		Method:      "POST",
+		Fields: []model.Field{
+			{Name: "name", Type: model.FieldTypeString, Required: true, Label: "Name"},
+		},
+	}
+}
+
+func TestTurboFrameID(t *testing.T) {
+	cases := map[string]string{
+		"createPet":     "formgen-create-pet",
+		"CreatePet":     "formgen-create-pet",
+		"update_pet":    "formgen-update-pet",
+		"pet.v2-create": "formgen-pet-v2-create",
+		"":              "formgen",
+	}
+	for input, want := range cases {
+		if got := render.TurboFrameID(input); got != want {
+			t.Errorf("TurboFrameID(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	got := string(turbo.Stream(turbo.ActionReplace, `a"b`, []byte("<p>hi</p>")))
+	want := `<turbo-stream action="replace" target="a&#34;b"><template><p>hi</p></template></turbo-stream>`
+	if got != want {
+		t.Fatalf("Stream() = %s, want %s", got, want)
+	}
+
+	removed := string(turbo.Stream(turbo.ActionRemove, "flash", []byte("ignored")))
+	if removed != `<turbo-stream action="remove" target="flash"></turbo-stream>` {
+		t.Fatalf("remove stream = %s", removed)
+	}
+}
+
+func TestAcceptsStream(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/pets", nil)
+	if turbo.AcceptsStream(req) {
+		t.Fatalf("expected plain request to not accept streams")
+	}
+	req.Header.Set("Accept", "text/vnd.turbo-stream.html, text/html, application/xhtml+xml")
+	if !turbo.AcceptsStream(req) {
+		t.Fatalf("expected turbo request to accept streams")
+	}
+}
+
+func TestRespondInvalid(t *testing.T) {
+	renderer, err := vanilla.New()
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	opts := render.RenderOptions{
+		Values: map[string]any{"name": "Rex"},
+		Errors: map[string][]string{"name": {"name is taken"}},
+	}
+
+	t.Run("stream", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/pets", nil)
+		req.Header.Set("Accept", turbo.StreamContentType+", text/html")
+		rec := httptest.NewRecorder()
+
+		if err := turbo.RespondInvalid(testsupport.Context(), rec, req, renderer, petForm(), opts); err != nil {
+			t.Fatalf("respond: %v", err)
+		}
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("status = %d, want 422", rec.Code)
+		}
+		if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, turbo.StreamContentType) {
+			t.Fatalf("content type = %q", ct)
+		}
+		body := rec.Body.String()
+		for _, want := range []string{
+			`<turbo-stream action="replace" target="formgen-create-pet">`,
+			`<turbo-frame id="formgen-create-pet">`,
+			"name is taken",
+		} {
+			if !strings.Contains(body, want) {
+				t.Fatalf("expected %q in body: %s", want, body)
+			}
+		}
+		if strings.Contains(body, "<style") || strings.Contains(body, "<script") {
+			t.Fatalf("expected stream response without assets: %s", body)
+		}
+	})
+
+	t.Run("html", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/pets", nil)
+		rec := httptest.NewRecorder()
+		custom := opts
+		custom.Turbo = &render.TurboOptions{FrameID: "new_pet"}
+
+		if err := turbo.RespondInvalid(testsupport.Context(), rec, req, renderer, petForm(), custom); err != nil {
+			t.Fatalf("respond: %v", err)
+		}
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("status = %d, want 422", rec.Code)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != renderer.ContentType() {
+			t.Fatalf("content type = %q", ct)
+		}
+		body := rec.Body.String()
+		if strings.Contains(body, "<turbo-stream") || !strings.Contains(body, `<turbo-frame id="new_pet">`) {
+			t.Fatalf("expected frame-wrapped html: %s", body)
+		}
+	})
+}
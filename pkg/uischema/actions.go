@@ -0,0 +1,62 @@
+package uischema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+)
+
+// ActionTypeArchive renders a button that sends a soft delete (DELETE) or a
+// status PATCH to a companion operation after a confirmation prompt.
+const ActionTypeArchive = "archive"
+
+// OperationLookup returns the method and endpoint of an operation in the
+// document the form was built from.
+type OperationLookup func(operationID string) (method, endpoint string, ok bool)
+
+// ResolveActionOperations fills Method and Endpoint for actions that name a
+// companion Operation, leaving explicit values untouched. Unknown operations
+// are reported as errors so typos surface at build time.
+func ResolveActionOperations(form *pkgmodel.FormModel, lookup OperationLookup) error {
+	if form == nil || lookup == nil {
+		return nil
+	}
+	raw := strings.TrimSpace(form.Metadata[actionsMetadataKey])
+	if raw == "" {
+		return nil
+	}
+	var actions []ActionConfig
+	if err := json.Unmarshal([]byte(raw), &actions); err != nil {
+		return fmt.Errorf("uischema: decode actions for operation %q: %w", form.OperationID, err)
+	}
+	changed := false
+	for i := range actions {
+		action := &actions[i]
+		operation := strings.TrimSpace(action.Operation)
+		if operation == "" || (action.Endpoint != "" && action.Method != "") {
+			continue
+		}
+		method, endpoint, ok := lookup(operation)
+		if !ok {
+			return fmt.Errorf("uischema: action %q of operation %q references unknown operation %q", action.Label, form.OperationID, operation)
+		}
+		if action.Endpoint == "" {
+			action.Endpoint = endpoint
+		}
+		if action.Method == "" {
+			action.Method = strings.ToUpper(method)
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	payload, err := json.Marshal(actions)
+	if err != nil {
+		return fmt.Errorf("uischema: marshal actions for operation %q: %w", form.OperationID, err)
+	}
+	form.Metadata[actionsMetadataKey] = string(payload)
+	return nil
+}
@@ -0,0 +1,47 @@
+package uischema_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+)
+
+func TestResolveActionOperations(t *testing.T) {
+	form := pkgmodel.FormModel{
+		OperationID: "updatePet",
+		Metadata: map[string]string{
+			"actions": `[{"label":"Save","type":"submit"},` +
+				`{"label":"Archive","type":"archive","operation":"deletePet"},` +
+				`{"label":"Retire","type":"archive","operation":"deletePet","method":"PATCH","endpoint":"/pets/{id}/status"}]`,
+		},
+	}
+	lookup := func(operationID string) (string, string, bool) {
+		if operationID == "deletePet" {
+			return "delete", "/pets/{id}", true
+		}
+		return "", "", false
+	}
+
+	if err := uischema.ResolveActionOperations(&form, lookup); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	var actions []uischema.ActionConfig
+	if err := json.Unmarshal([]byte(form.Metadata["actions"]), &actions); err != nil {
+		t.Fatalf("decode actions: %v", err)
+	}
+	if actions[1].Method != "DELETE" || actions[1].Endpoint != "/pets/{id}" {
+		t.Fatalf("expected derived endpoint, got %+v", actions[1])
+	}
+	if actions[2].Method != "PATCH" || actions[2].Endpoint != "/pets/{id}/status" {
+		t.Fatalf("expected explicit endpoint to win, got %+v", actions[2])
+	}
+
+	form.Metadata["actions"] = `[{"label":"Archive","type":"archive","operation":"removePet"}]`
+	err := uischema.ResolveActionOperations(&form, lookup)
+	if err == nil || !strings.Contains(err.Error(), `unknown operation "removePet"`) {
+		t.Fatalf("expected unknown operation error, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package uischema
+
+import (
+	"strconv"
+	"strings"
+
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+)
+
+const (
+	layoutGridColumnsKey    = "layout.gridColumns"
+	autoLayoutMetadataKey   = "layout.auto"
+	defaultAutoLayoutLength = 255
+)
+
+// AutoLayout is an optional decorator that lays out forms which have no UI
+// schema layout. Short scalar fields are paired two per row, long text,
+// objects, and collections span the full width, relationship selects are
+// grouped after the main fields, and booleans are clustered at the end three
+// per row. Forms that already carry sections or grid spans are left
+// untouched, so the decorator can be registered alongside UI schema files.
+type AutoLayout struct {
+	longTextLength int
+}
+
+// AutoLayoutOption customises the AutoLayout heuristics.
+type AutoLayoutOption func(*AutoLayout)
+
+// WithAutoLayoutLongText sets the maxLength above which string fields are
+// treated as long text and given the full row. Defaults to 255.
+func WithAutoLayoutLongText(maxLength int) AutoLayoutOption {
+	return func(a *AutoLayout) {
+		if maxLength > 0 {
+			a.longTextLength = maxLength
+		}
+	}
+}
+
+// NewAutoLayout constructs the auto-layout decorator.
+func NewAutoLayout(options ...AutoLayoutOption) *AutoLayout {
+	layout := &AutoLayout{longTextLength: defaultAutoLayoutLength}
+	for _, opt := range options {
+		if opt != nil {
+			opt(layout)
+		}
+	}
+	return layout
+}
+
+type autoLayoutKind int
+
+const (
+	autoLayoutShort autoLayoutKind = iota
+	autoLayoutWide
+	autoLayoutRelationship
+	autoLayoutBoolean
+)
+
+// Decorate implements model.Decorator.
+func (a *AutoLayout) Decorate(form *pkgmodel.FormModel) error {
+	if a == nil || form == nil || len(form.Fields) == 0 || hasExplicitLayout(*form) {
+		return nil
+	}
+
+	columns := 12
+	if value, err := strconv.Atoi(strings.TrimSpace(form.UIHints[layoutGridColumnsKey])); err == nil && value > 0 {
+		columns = value
+	}
+	spans := map[autoLayoutKind]int{
+		autoLayoutShort:        max(columns/2, 1),
+		autoLayoutWide:         columns,
+		autoLayoutRelationship: max(columns/2, 1),
+		autoLayoutBoolean:      max(columns/3, 1),
+	}
+
+	var main, relationships, booleans []pkgmodel.Field
+	for _, field := range form.Fields {
+		kind := a.classify(field)
+		field.UIHints = ensureUIHints(field.UIHints)
+		field.UIHints[layoutSpanKey] = strconv.Itoa(spans[kind])
+		switch kind {
+		case autoLayoutRelationship:
+			relationships = append(relationships, field)
+		case autoLayoutBoolean:
+			booleans = append(booleans, field)
+		default:
+			main = append(main, field)
+		}
+	}
+
+	fields := make([]pkgmodel.Field, 0, len(form.Fields))
+	fields = append(fields, main...)
+	fields = append(fields, relationships...)
+	fields = append(fields, booleans...)
+	form.Fields = fields
+
+	form.UIHints = ensureUIHints(form.UIHints)
+	if _, ok := form.UIHints[layoutGridColumnsKey]; !ok {
+		form.UIHints[layoutGridColumnsKey] = strconv.Itoa(columns)
+	}
+	form.Metadata = ensureMetadata(form.Metadata)
+	form.Metadata[autoLayoutMetadataKey] = "true"
+	return nil
+}
+
+func (a *AutoLayout) classify(field pkgmodel.Field) autoLayoutKind {
+	if field.Relationship != nil {
+		if field.Type == pkgmodel.FieldTypeArray {
+			return autoLayoutWide
+		}
+		return autoLayoutRelationship
+	}
+	switch field.Type {
+	case pkgmodel.FieldTypeBoolean:
+		return autoLayoutBoolean
+	case pkgmodel.FieldTypeObject, pkgmodel.FieldTypeArray:
+		return autoLayoutWide
+	}
+	if len(field.OneOf) > 0 || isLongTextWidget(field) {
+		return autoLayoutWide
+	}
+	if field.Type == pkgmodel.FieldTypeString && len(field.Enum) == 0 && len(field.Options) == 0 {
+		for _, rule := range field.Validations {
+			if rule.Kind != pkgmodel.ValidationRuleMaxLength {
+				continue
+			}
+			if limit, err := strconv.Atoi(rule.Params["value"]); err == nil && limit > a.longTextLength {
+				return autoLayoutWide
+			}
+		}
+	}
+	return autoLayoutShort
+}
+
+func isLongTextWidget(field pkgmodel.Field) bool {
+	if strings.EqualFold(field.Format, "textarea") || strings.EqualFold(strings.TrimSpace(field.UIHints["input"]), "textarea") {
+		return true
+	}
+	for _, widget := range []string{field.Metadata["admin.widget"], field.Metadata["widget"], field.UIHints["widget"]} {
+		switch strings.ToLower(strings.TrimSpace(widget)) {
+		case "textarea", "code-editor", "json-editor", "wysiwyg", "rich-text", "rich_text", "key-value":
+			return true
+		}
+	}
+	return false
+}
+
+// hasExplicitLayout reports whether a UI schema (or extension hints) already
+// placed the form's fields.
+func hasExplicitLayout(form pkgmodel.FormModel) bool {
+	if strings.TrimSpace(form.Metadata[layoutSectionsKey]) != "" {
+		return true
+	}
+	for _, field := range form.Fields {
+		if strings.TrimSpace(field.Metadata[layoutSectionKey]) != "" {
+			return true
+		}
+		for key := range field.UIHints {
+			if key == layoutSpanKey || strings.HasPrefix(key, layoutSpanKey+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package uischema_test
+
+import (
+	"testing"
+
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+)
+
+func autoLayoutForm() pkgmodel.FormModel {
+	return pkgmodel.FormModel{
+		OperationID: "createArticle",
+		Fields: []pkgmodel.Field{
+			{Name: "published", Type: pkgmodel.FieldTypeBoolean},
+			{Name: "title", Type: pkgmodel.FieldTypeString},
+			{Name: "author_id", Type: pkgmodel.FieldTypeString, Relationship: &pkgmodel.Relationship{Kind: pkgmodel.RelationshipBelongsTo}},
+			{Name: "body", Type: pkgmodel.FieldTypeString, Validations: []pkgmodel.ValidationRule{
+				{Kind: pkgmodel.ValidationRuleMaxLength, Params: map[string]string{"value": "5000"}},
+			}},
+			{Name: "slug", Type: pkgmodel.FieldTypeString},
+			{Name: "featured", Type: pkgmodel.FieldTypeBoolean},
+			{Name: "tags", Type: pkgmodel.FieldTypeArray, Items: &pkgmodel.Field{Type: pkgmodel.FieldTypeString}},
+		},
+	}
+}
+
+func TestAutoLayout_GroupsAndSpansFields(t *testing.T) {
+	form := autoLayoutForm()
+	if err := uischema.NewAutoLayout().Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+
+	want := []struct {
+		name string
+		span string
+	}{
+		{"title", "6"},
+		{"body", "12"},
+		{"slug", "6"},
+		{"tags", "12"},
+		{"author_id", "6"},
+		{"published", "4"},
+		{"featured", "4"},
+	}
+	if len(form.Fields) != len(want) {
+		t.Fatalf("unexpected field count: %d", len(form.Fields))
+	}
+	for idx, expected := range want {
+		field := form.Fields[idx]
+		if field.Name != expected.name || field.UIHints["layout.span"] != expected.span {
+			t.Fatalf("field %d: want %s span %s, got %s span %s", idx, expected.name, expected.span, field.Name, field.UIHints["layout.span"])
+		}
+	}
+	if form.UIHints["layout.gridColumns"] != "12" || form.Metadata["layout.auto"] != "true" {
+		t.Fatalf("expected grid columns and auto marker, got hints %v metadata %v", form.UIHints, form.Metadata)
+	}
+}
+
+func TestAutoLayout_SkipsFormsWithExplicitLayout(t *testing.T) {
+	form := autoLayoutForm()
+	form.Fields[1].UIHints = map[string]string{"layout.span.md": "8"}
+	if err := uischema.NewAutoLayout().Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+	if form.Fields[0].Name != "published" || form.Fields[0].UIHints != nil || form.Metadata["layout.auto"] != "" {
+		t.Fatalf("auto layout should not touch forms with explicit layout: %+v", form.Fields[0])
+	}
+}
+
+func TestAutoLayout_LongTextThreshold(t *testing.T) {
+	form := autoLayoutForm()
+	if err := uischema.NewAutoLayout(uischema.WithAutoLayoutLongText(10000)).Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+	for _, field := range form.Fields {
+		if field.Name == "body" && field.UIHints["layout.span"] != "6" {
+			t.Fatalf("body should be treated as short text, got span %s", field.UIHints["layout.span"])
+		}
+	}
+}
@@ -9,10 +9,14 @@ import (
 	"strings"
 
 	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/visibility"
+	"github.com/goliatone/go-formgen/pkg/visibility/expr"
 )
 
 const (
 	layoutSectionKey          = "layout.section"
+	implicitSectionKey        = "layout.section.implicit"
+	flattenHintKey            = "flatten"
 	layoutOrderKey            = "layout.order"
 	layoutSpanKey             = "layout.span"
 	layoutStartKey            = "layout.start"
@@ -23,6 +27,7 @@ const (
 	actionsMetadataKey        = "actions"
 	behaviorNamesMetadataKey  = "behavior.names"
 	behaviorConfigMetadataKey = "behavior.config"
+	enumFilterBehaviorName    = "enumFilter"
 )
 
 var supportedGridBreakpoints = map[string]struct{}{
@@ -90,6 +95,14 @@ func applyFormConfig(form *pkgmodel.FormModel, op Operation) error {
 		form.UIHints = ensureUIHints(form.UIHints)
 		form.UIHints["layout.gutter"] = op.Form.Layout.Gutter
 	}
+	if op.Form.Layout.StickyActions {
+		form.UIHints = ensureUIHints(form.UIHints)
+		form.UIHints["layout.stickyActions"] = "true"
+	}
+	if op.Form.Layout.SectionNav {
+		form.UIHints = ensureUIHints(form.UIHints)
+		form.UIHints["layout.sectionNav"] = "true"
+	}
 	if len(op.Form.Actions) > 0 {
 		form.Metadata = ensureMetadata(form.Metadata)
 		payload, err := json.Marshal(op.Form.Actions)
@@ -104,6 +117,7 @@ func applyFormConfig(form *pkgmodel.FormModel, op Operation) error {
 		if err != nil {
 			return err
 		}
+		clearImplicitSections(form)
 		form.Metadata = ensureMetadata(form.Metadata)
 		form.Metadata[layoutSectionsKey] = exported
 	}
@@ -111,6 +125,20 @@ func applyFormConfig(form *pkgmodel.FormModel, op Operation) error {
 	return nil
 }
 
+// clearImplicitSections drops the sections the builder derived from schema
+// titles so explicitly configured sections fully replace them.
+func clearImplicitSections(form *pkgmodel.FormModel) {
+	for idx := range form.Fields {
+		field := &form.Fields[idx]
+		if field.Metadata[implicitSectionKey] != "true" {
+			continue
+		}
+		delete(field.Metadata, implicitSectionKey)
+		delete(field.Metadata, layoutSectionKey)
+		delete(field.UIHints, flattenHintKey)
+	}
+}
+
 func buildSectionsMetadata(op Operation) (string, error) {
 	sections := make([]sectionMetadata, 0, len(op.Sections))
 	seen := make(map[string]struct{}, len(op.Sections))
@@ -145,6 +173,12 @@ func buildSectionsMetadata(op Operation) (string, error) {
 			Metadata:       cloneStringMap(section.Metadata),
 			UIHints:        cloneStringMap(section.UIHints),
 		}
+		if section.Advanced {
+			if entry.UIHints == nil {
+				entry.UIHints = make(map[string]string)
+			}
+			entry.UIHints[pkgmodel.AdvancedHintKey] = "true"
+		}
 		sections = append(sections, entry)
 	}
 
@@ -219,6 +253,9 @@ func applyFieldConfig(form *pkgmodel.FormModel, op Operation) error {
 		applyFieldCopy(field, cfg)
 		mergeFieldMaps(field, cfg)
 
+		if err := applyEnumFilter(field, cfg); err != nil {
+			return fmt.Errorf("uischema: operation %q (file %s) field %q: %w", op.ID, op.Source, cfg.OriginalPath, err)
+		}
 		if err := applyBehaviorMetadata(field, cfg); err != nil {
 			return fmt.Errorf("uischema: operation %q (file %s) field %q: %w", op.ID, op.Source, cfg.OriginalPath, err)
 		}
@@ -339,6 +376,9 @@ func applyFieldCopy(field *pkgmodel.Field, cfg FieldConfig) {
 	if cfg.CSSClass != "" {
 		applyUIHint(field, "cssClass", cfg.CSSClass)
 	}
+	if cfg.Advanced {
+		applyUIHint(field, pkgmodel.AdvancedHintKey, "true")
+	}
 	applyComponentOptions(field, cfg.ComponentOptions)
 	applyIconCopy(field, cfg)
 }
@@ -669,6 +709,29 @@ func appendResidualSectionFields(resolved []string, ordered []string, used map[s
 	return resolved
 }
 
+// applyEnumFilter records the enum filter in field metadata so the server-side
+// validator can enforce it. The client side is handled by the enumFilter
+// behavior, which collectBehaviorDefinitions adds for the same config.
+func applyEnumFilter(field *pkgmodel.Field, cfg FieldConfig) error {
+	if cfg.EnumFilter == nil {
+		return nil
+	}
+	when := strings.TrimSpace(cfg.EnumFilter.When)
+	if when == "" {
+		return fmt.Errorf("enumFilter.when is required")
+	}
+	if _, err := expr.New().Eval(field.Name, when, visibility.Context{}); err != nil {
+		return fmt.Errorf("enumFilter.when: %w", err)
+	}
+	payload, err := json.Marshal(pkgmodel.EnumFilter{When: when, Allow: cfg.EnumFilter.Allow})
+	if err != nil {
+		return fmt.Errorf("marshal enumFilter: %w", err)
+	}
+	field.Metadata = ensureMetadata(field.Metadata)
+	field.Metadata[pkgmodel.EnumFilterMetadataKey] = string(payload)
+	return nil
+}
+
 type behaviorMetadataPayload struct {
 	names  string
 	config string
@@ -753,6 +816,17 @@ func collectBehaviorDefinitions(cfg FieldConfig) (map[string]any, error) {
 		merged = mergeBehaviorMaps(merged, defs)
 	}
 
+	if cfg.EnumFilter != nil {
+		if _, exists := merged[enumFilterBehaviorName]; !exists {
+			merged = mergeBehaviorMaps(merged, map[string]any{
+				enumFilterBehaviorName: map[string]any{
+					"when":  strings.TrimSpace(cfg.EnumFilter.When),
+					"allow": cfg.EnumFilter.Allow,
+				},
+			})
+		}
+	}
+
 	return merged, nil
 }
 
@@ -2,7 +2,9 @@ package uischema_test
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
+	"testing/fstest"
 
 	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
 	"github.com/goliatone/go-formgen/pkg/uischema"
@@ -266,6 +268,112 @@ func TestDecorator_FieldOrderPresets(t *testing.T) {
 	}
 }
 
+func TestDecorator_ReplacesImplicitSections(t *testing.T) {
+	store, err := uischema.LoadFS(fstest.MapFS{"order.json": {Data: []byte(`{
+  "operations": {
+    "createOrder": {
+      "sections": [{"id": "details", "title": "Details"}],
+      "fields": {"notes": {"section": "details"}}
+    }
+  }
+}`)}})
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	form := pkgmodel.FormModel{
+		OperationID: "createOrder",
+		Metadata:    map[string]string{"layout.sections": `[{"id":"billing","title":"Billing address","order":0}]`},
+		Fields: []pkgmodel.Field{
+			{
+				Name:     "billing",
+				Type:     pkgmodel.FieldTypeObject,
+				Nested:   []pkgmodel.Field{{Name: "street", Type: pkgmodel.FieldTypeString}},
+				Metadata: map[string]string{"layout.section": "billing", "layout.section.implicit": "true"},
+				UIHints:  map[string]string{"flatten": "true"},
+			},
+			{Name: "notes", Type: pkgmodel.FieldTypeString},
+		},
+	}
+	if err := uischema.NewDecorator(store).Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+
+	billing := form.Fields[0]
+	if billing.Metadata["layout.section"] != "" || billing.UIHints["flatten"] != "" {
+		t.Fatalf("implicit section should be cleared: %+v %+v", billing.Metadata, billing.UIHints)
+	}
+	if form.Fields[1].Metadata["layout.section"] != "details" {
+		t.Fatalf("explicit section should apply: %+v", form.Fields[1].Metadata)
+	}
+	if !strings.Contains(form.Metadata["layout.sections"], `"details"`) {
+		t.Fatalf("sections metadata should come from the UI schema: %s", form.Metadata["layout.sections"])
+	}
+}
+
+func TestDecorator_LongFormLayoutHints(t *testing.T) {
+	store, err := uischema.LoadFS(fstest.MapFS{"order.yaml": {Data: []byte(`operations:
+  createOrder:
+    form:
+      layout:
+        stickyActions: true
+        sectionNav: true
+`)}})
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	form := pkgmodel.FormModel{OperationID: "createOrder"}
+	if err := uischema.NewDecorator(store).Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+	if form.UIHints["layout.stickyActions"] != "true" || form.UIHints["layout.sectionNav"] != "true" {
+		t.Fatalf("expected long-form layout hints, got %+v", form.UIHints)
+	}
+}
+
+func TestDecorator_AdvancedFieldsAndSections(t *testing.T) {
+	store, err := uischema.LoadFS(fstest.MapFS{"webhook.yaml": {Data: []byte(`operations:
+  createWebhook:
+    sections:
+      - id: main
+        title: Main
+      - id: delivery
+        title: Delivery
+        advanced: true
+    fields:
+      url:
+        section: main
+      secret:
+        section: main
+        advanced: true
+      retries:
+        section: delivery
+`)}})
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	form := pkgmodel.FormModel{
+		OperationID: "createWebhook",
+		Fields:      []pkgmodel.Field{{Name: "url"}, {Name: "secret"}, {Name: "retries"}},
+	}
+	if err := uischema.NewDecorator(store).Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+	sections := pkgmodel.AdvancedSections(form)
+	if !sections["delivery"] || sections["main"] {
+		t.Fatalf("expected only delivery to be advanced, got %v", sections)
+	}
+	var advanced []string
+	for _, field := range form.Fields {
+		if pkgmodel.IsAdvancedField(field, sections) {
+			advanced = append(advanced, field.Name)
+		}
+	}
+	if strings.Join(advanced, ",") != "secret,retries" {
+		t.Fatalf("expected secret and retries to be advanced, got %v", advanced)
+	}
+}
+
 func TestDecorator_UnknownField(t *testing.T) {
 	store := loadStore(t, "invalid_unknown")
 	decorator := uischema.NewDecorator(store)
@@ -454,3 +562,51 @@ func mustField(t *testing.T, fields []pkgmodel.Field, name string) pkgmodel.Fiel
 	t.Fatalf("field %s not found", name)
 	return pkgmodel.Field{}
 }
+
+func TestDecorator_EnumFilter(t *testing.T) {
+	store := loadStore(t, "enum_filter")
+	decorator := uischema.NewDecorator(store)
+
+	form := pkgmodel.FormModel{
+		OperationID: "createOrder",
+		Fields: []pkgmodel.Field{
+			{Name: "region", Type: pkgmodel.FieldTypeString, Enum: []any{"US", "EU"}},
+			{Name: "currency", Type: pkgmodel.FieldTypeString, Enum: []any{"USD", "EUR", "GBP"}},
+		},
+	}
+	if err := decorator.Decorate(&form); err != nil {
+		t.Fatalf("decorate: %v", err)
+	}
+
+	field := mustField(t, form.Fields, "currency")
+	filter, ok := pkgmodel.FieldEnumFilter(field)
+	if !ok {
+		t.Fatalf("expected enum filter metadata, got %#v", field.Metadata)
+	}
+	if filter.When != "region == 'EU'" || len(filter.Allow) != 2 || filter.Allow[0] != "EUR" {
+		t.Fatalf("unexpected enum filter %#v", filter)
+	}
+	if got := field.Metadata["behavior.names"]; got != "enumFilter" {
+		t.Fatalf("expected enumFilter behavior, got %q", got)
+	}
+	if got := field.Metadata["behavior.config"]; got != `{"allow":["EUR","GBP"],"when":"region == 'EU'"}` {
+		t.Fatalf("unexpected behavior config %q", got)
+	}
+}
+
+func TestDecorator_EnumFilterRejectsInvalidCondition(t *testing.T) {
+	store, err := uischema.LoadFS(fstest.MapFS{
+		"schema.json": {Data: []byte(`{"operations":{"createOrder":{"fields":{"currency":{"enumFilter":{"when":"region = 'EU'","allow":["EUR"]}}}}}}`)},
+	})
+	if err != nil {
+		t.Fatalf("load store: %v", err)
+	}
+	form := pkgmodel.FormModel{
+		OperationID: "createOrder",
+		Fields:      []pkgmodel.Field{{Name: "currency", Type: pkgmodel.FieldTypeString}},
+	}
+	err = uischema.NewDecorator(store).Decorate(&form)
+	if err == nil || !strings.Contains(err.Error(), "enumFilter.when") {
+		t.Fatalf("expected enumFilter.when error, got %v", err)
+	}
+}
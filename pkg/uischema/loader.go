@@ -20,6 +20,7 @@ func LoadFS(fsys fs.FS) (*Store, error) {
 		return store, nil
 	}
 
+	var files []parsedFile
 	err := fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return walkErr
@@ -45,27 +46,38 @@ func LoadFS(fsys fs.FS) (*Store, error) {
 		if err != nil {
 			return err
 		}
+		files = append(files, parsedFile{path: path, doc: doc, presets: presets})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		for opID, raw := range doc.Operations {
+	// Section presets are store-wide, so every file is read before operations
+	// are expanded.
+	sectionPresets := make(map[string]SectionPreset)
+	for _, file := range files {
+		if err := collectSectionPresets(sectionPresets, file.doc.SectionPresets, file.path, file.presets); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, file := range files {
+		for opID, raw := range file.doc.Operations {
 			id := strings.TrimSpace(opID)
 			if id == "" {
-				return fmt.Errorf("uischema: file %s defines an empty operation id", path)
+				return nil, fmt.Errorf("uischema: file %s defines an empty operation id", file.path)
 			}
 			if _, exists := store.operations[id]; exists {
-				return fmt.Errorf("uischema: duplicate operation %q (file %s)", id, path)
+				return nil, fmt.Errorf("uischema: duplicate operation %q (file %s)", id, file.path)
 			}
 
-			op, err := normaliseOperation(raw, id, path, presets)
+			op, err := normaliseOperation(raw, id, file.path, file.presets, sectionPresets)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			store.operations[id] = op
 		}
-
-		return nil
-	})
-	if err != nil {
-		return nil, err
 	}
 
 	return store, nil
@@ -87,9 +99,16 @@ func (s *Store) Empty() bool {
 
 type documentFile struct {
 	FieldOrderPresets map[string][]string      `json:"fieldOrderPresets" yaml:"fieldOrderPresets"`
+	SectionPresets    map[string]SectionPreset `json:"sectionPresets" yaml:"sectionPresets"`
 	Operations        map[string]operationFile `json:"operations" yaml:"operations"`
 }
 
+type parsedFile struct {
+	path    string
+	doc     documentFile
+	presets map[string][]string
+}
+
 type operationFile struct {
 	Form     FormConfig             `json:"form" yaml:"form"`
 	Sections []SectionConfig        `json:"sections" yaml:"sections"`
@@ -113,7 +132,7 @@ func parseDocument(data []byte, source string) (documentFile, error) {
 	return documentFile{}, fmt.Errorf("uischema: parse %s: invalid JSON or YAML", source)
 }
 
-func normaliseOperation(raw operationFile, id, source string, presets map[string][]string) (Operation, error) {
+func normaliseOperation(raw operationFile, id, source string, presets map[string][]string, sectionPresets map[string]SectionPreset) (Operation, error) {
 	op := Operation{
 		ID:                id,
 		Source:            source,
@@ -123,6 +142,11 @@ func normaliseOperation(raw operationFile, id, source string, presets map[string
 		FieldOrderPresets: clonePresetMap(presets),
 	}
 
+	presetFields, err := expandSectionPresets(op.Sections, sectionPresets, id, source)
+	if err != nil {
+		return Operation{}, err
+	}
+
 	normaliseFormExtensions(&op.Form)
 	for idx := range op.Sections {
 		normaliseSectionExtensions(&op.Sections[idx])
@@ -142,6 +166,15 @@ func normaliseOperation(raw operationFile, id, source string, presets map[string
 		op.Fields[normalised] = cloned
 	}
 
+	// Preset fields fill in paths the operation does not configure itself.
+	for path, cfg := range presetFields {
+		if _, exists := op.Fields[path]; exists {
+			continue
+		}
+		normaliseFieldExtensions(&cfg)
+		op.Fields[path] = cfg
+	}
+
 	return op, nil
 }
 
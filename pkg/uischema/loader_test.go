@@ -5,7 +5,9 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/goliatone/go-formgen/pkg/uischema"
 )
@@ -117,6 +119,54 @@ func TestLoadFS_FieldOrderPresets(t *testing.T) {
 	}
 }
 
+func TestLoadFS_SectionPresets(t *testing.T) {
+	store := loadStore(t, "section_presets")
+	op, ok := store.Operation("createOrder")
+	if !ok {
+		t.Fatalf("operation createOrder not found")
+	}
+
+	if len(op.Sections) != 3 {
+		t.Fatalf("expected 3 sections, got %d", len(op.Sections))
+	}
+	billing, shipping, audit := op.Sections[0], op.Sections[1], op.Sections[2]
+	if billing.Title != "Billing address" || shipping.Title != "Address" {
+		t.Fatalf("section titles should prefer explicit values: %q / %q", billing.Title, shipping.Title)
+	}
+	if inline := shipping.OrderPreset.Inline(); len(inline) != 3 || inline[0] != "shipping.street" || inline[2] != "*" {
+		t.Fatalf("named order preset should resolve and gain the field prefix, got %#v", inline)
+	}
+	if audit.Fieldset == nil || !*audit.Fieldset {
+		t.Fatalf("audit section should inherit fieldset from preset")
+	}
+
+	for path, section := range map[string]string{
+		"billing.street":   "billing",
+		"billing.postcode": "billing",
+		"shipping.city":    "shipping",
+		"created_at":       "audit",
+	} {
+		cfg, ok := op.Fields[path]
+		if !ok || cfg.Section != section {
+			t.Fatalf("field %q should expand into section %q, got %+v", path, section, cfg)
+		}
+	}
+	if got := op.Fields["created_at"]; got.Grid == nil || got.Grid.Span != 6 {
+		t.Fatalf("preset field config should be kept: %+v", got)
+	}
+	if got := op.Fields["updated_at"]; got.Label != "Last change" || got.Grid != nil {
+		t.Fatalf("operation field config should replace the preset entry: %+v", got)
+	}
+}
+
+func TestLoadFS_SectionPresetsRejectUnknownPreset(t *testing.T) {
+	fsys := fstest.MapFS{"ops.json": {Data: []byte(`{"operations":{"op":{"sections":[{"id":"a","preset":"missing"}]}}}`)}}
+	_, err := uischema.LoadFS(fsys)
+	if err == nil || !strings.Contains(err.Error(), `unknown section preset "missing"`) {
+		t.Fatalf("expected unknown preset error, got %v", err)
+	}
+}
+
 func TestLoadFS_I18nKeys(t *testing.T) {
 	store := loadStore(t, "i18n_keys")
 	op, ok := store.Operation("createThing")
@@ -0,0 +1,238 @@
+package uischema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ScaffoldFormatYAML renders scaffolds as YAML documents.
+	ScaffoldFormatYAML = "yaml"
+	// ScaffoldFormatJSON renders scaffolds as indented JSON documents.
+	ScaffoldFormatJSON = "json"
+
+	scaffoldGeneralSection = "general"
+)
+
+// ScaffoldDocument is a starter UI schema file generated from a form model.
+// It loads through LoadFS unchanged, so teams can decorate it incrementally.
+type ScaffoldDocument struct {
+	Operations map[string]ScaffoldOperation `json:"operations" yaml:"operations"`
+}
+
+// ScaffoldOperation lists the guessed sections and every field of a form.
+type ScaffoldOperation struct {
+	Form     ScaffoldForm      `json:"form" yaml:"form"`
+	Sections []ScaffoldSection `json:"sections,omitempty" yaml:"sections,omitempty"`
+	Fields   ScaffoldFields    `json:"fields" yaml:"fields"`
+}
+
+// ScaffoldForm carries the form-level starter configuration.
+type ScaffoldForm struct {
+	Title  string         `json:"title,omitempty" yaml:"title,omitempty"`
+	Layout ScaffoldLayout `json:"layout" yaml:"layout"`
+}
+
+// ScaffoldLayout is the starter grid configuration.
+type ScaffoldLayout struct {
+	GridColumns int `json:"gridColumns" yaml:"gridColumns"`
+}
+
+// ScaffoldSection is a section guessed from the form structure.
+type ScaffoldSection struct {
+	ID       string `json:"id" yaml:"id"`
+	Title    string `json:"title" yaml:"title"`
+	Order    int    `json:"order" yaml:"order"`
+	Fieldset bool   `json:"fieldset,omitempty" yaml:"fieldset,omitempty"`
+}
+
+// ScaffoldField is a single field entry keyed by its UI schema path.
+type ScaffoldField struct {
+	Path    string      `json:"-" yaml:"-"`
+	Section string      `json:"section,omitempty" yaml:"section,omitempty"`
+	Order   int         `json:"order" yaml:"order"`
+	Label   string      `json:"label,omitempty" yaml:"label,omitempty"`
+	Widget  string      `json:"widget,omitempty" yaml:"widget,omitempty"`
+	Grid    *GridConfig `json:"grid,omitempty" yaml:"grid,omitempty"`
+}
+
+// ScaffoldFields keeps field entries in form order when serialised, unlike a
+// plain map whose keys would be sorted alphabetically.
+type ScaffoldFields []ScaffoldField
+
+// MarshalJSON encodes the fields as an object keyed by path in form order.
+func (f ScaffoldFields) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for idx, field := range f {
+		if idx > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field.Path)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML encodes the fields as a mapping keyed by path in form order.
+func (f ScaffoldFields) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, field := range f {
+		var value yaml.Node
+		if err := value.Encode(field); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: field.Path}, &value)
+	}
+	return node, nil
+}
+
+// Scaffold derives a starter UI schema document for form. Top-level object
+// fields become their own fieldset sections and the remaining top-level
+// fields share a "general" section. Every field, including nested object and
+// array item children, is listed with its current position among siblings.
+func Scaffold(form pkgmodel.FormModel) ScaffoldDocument {
+	op := ScaffoldOperation{
+		Form: ScaffoldForm{
+			Title:  strings.TrimSpace(form.Summary),
+			Layout: ScaffoldLayout{GridColumns: 12},
+		},
+	}
+
+	hasGeneral := false
+	for _, field := range form.Fields {
+		if !isSectionField(field) {
+			hasGeneral = true
+			break
+		}
+	}
+	if hasGeneral {
+		op.Sections = append(op.Sections, ScaffoldSection{ID: scaffoldGeneralSection, Title: "General", Order: 0})
+	}
+	for _, field := range form.Fields {
+		if !isSectionField(field) {
+			continue
+		}
+		op.Sections = append(op.Sections, ScaffoldSection{
+			ID:       field.Name,
+			Title:    scaffoldLabel(field),
+			Order:    len(op.Sections),
+			Fieldset: true,
+		})
+	}
+
+	for idx, field := range form.Fields {
+		section := scaffoldGeneralSection
+		if isSectionField(field) {
+			section = field.Name
+		}
+		op.Fields = append(op.Fields, ScaffoldField{
+			Path:    field.Name,
+			Section: section,
+			Order:   idx,
+			Label:   scaffoldLabel(field),
+		})
+		op.Fields = appendScaffoldChildren(op.Fields, field, field.Name)
+	}
+
+	return ScaffoldDocument{Operations: map[string]ScaffoldOperation{form.OperationID: op}}
+}
+
+// Marshal renders the document as YAML (default) or JSON.
+func (d ScaffoldDocument) Marshal(format string) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", ScaffoldFormatYAML, "yml":
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(d); err != nil {
+			return nil, fmt.Errorf("uischema: marshal scaffold: %w", err)
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, fmt.Errorf("uischema: marshal scaffold: %w", err)
+		}
+		return buf.Bytes(), nil
+	case ScaffoldFormatJSON:
+		payload, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("uischema: marshal scaffold: %w", err)
+		}
+		return append(payload, '\n'), nil
+	default:
+		return nil, fmt.Errorf("uischema: unsupported scaffold format %q", format)
+	}
+}
+
+// Validate checks that the document loads like a UI schema file and decorates
+// form without errors (unknown fields, unknown sections, invalid grid spans).
+// form is copied first and left untouched.
+func (d ScaffoldDocument) Validate(form pkgmodel.FormModel) error {
+	payload, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("uischema: marshal scaffold: %w", err)
+	}
+	doc, err := parseDocument(payload, "scaffold")
+	if err != nil {
+		return err
+	}
+	store := &Store{operations: make(map[string]Operation, len(doc.Operations))}
+	for id, raw := range doc.Operations {
+		op, err := normaliseOperation(raw, id, "scaffold", nil, nil)
+		if err != nil {
+			return err
+		}
+		store.operations[id] = op
+	}
+
+	var clone pkgmodel.FormModel
+	encoded, err := json.Marshal(form)
+	if err != nil {
+		return fmt.Errorf("uischema: copy form: %w", err)
+	}
+	if err := json.Unmarshal(encoded, &clone); err != nil {
+		return fmt.Errorf("uischema: copy form: %w", err)
+	}
+	return NewDecorator(store).Decorate(&clone)
+}
+
+func appendScaffoldChildren(fields ScaffoldFields, field pkgmodel.Field, path string) ScaffoldFields {
+	for idx, child := range field.Nested {
+		childPath := path + "." + child.Name
+		fields = append(fields, ScaffoldField{Path: childPath, Order: idx, Label: scaffoldLabel(child)})
+		fields = appendScaffoldChildren(fields, child, childPath)
+	}
+	if field.Items != nil {
+		for idx, child := range field.Items.Nested {
+			childPath := path + "[]." + child.Name
+			fields = append(fields, ScaffoldField{Path: childPath, Order: idx, Label: scaffoldLabel(child)})
+			fields = appendScaffoldChildren(fields, child, childPath)
+		}
+	}
+	return fields
+}
+
+func isSectionField(field pkgmodel.Field) bool {
+	return field.Type == pkgmodel.FieldTypeObject && len(field.Nested) > 0
+}
+
+func scaffoldLabel(field pkgmodel.Field) string {
+	if label := strings.TrimSpace(field.Label); label != "" {
+		return label
+	}
+	return field.Name
+}
@@ -0,0 +1,80 @@
+package uischema_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+)
+
+func scaffoldForm() pkgmodel.FormModel {
+	return pkgmodel.FormModel{
+		OperationID: "createBook",
+		Summary:     "Create book",
+		Fields: []pkgmodel.Field{
+			{Name: "title", Type: pkgmodel.FieldTypeString, Label: "Title"},
+			{Name: "author", Type: pkgmodel.FieldTypeObject, Label: "Author", Nested: []pkgmodel.Field{
+				{Name: "name", Type: pkgmodel.FieldTypeString, Label: "Name"},
+				{Name: "email", Type: pkgmodel.FieldTypeString, Label: "Email"},
+			}},
+			{Name: "isbn", Type: pkgmodel.FieldTypeString, Label: "ISBN"},
+			{Name: "links", Type: pkgmodel.FieldTypeArray, Label: "Links", Items: &pkgmodel.Field{
+				Name: "linksItem", Type: pkgmodel.FieldTypeObject, Nested: []pkgmodel.Field{
+					{Name: "url", Type: pkgmodel.FieldTypeString, Label: "URL"},
+				},
+			}},
+		},
+	}
+}
+
+func TestScaffold_RoundTripsThroughLoader(t *testing.T) {
+	for _, format := range []string{uischema.ScaffoldFormatYAML, uischema.ScaffoldFormatJSON} {
+		t.Run(format, func(t *testing.T) {
+			payload, err := uischema.Scaffold(scaffoldForm()).Marshal(format)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			store, err := uischema.LoadFS(fstest.MapFS{"createBook." + format: {Data: payload}})
+			if err != nil {
+				t.Fatalf("load scaffold: %v\n%s", err, payload)
+			}
+			op, ok := store.Operation("createBook")
+			if !ok {
+				t.Fatalf("scaffold operation missing")
+			}
+			if len(op.Sections) != 2 || op.Sections[0].ID != "general" || op.Sections[1].ID != "author" {
+				t.Fatalf("unexpected sections: %+v", op.Sections)
+			}
+			for _, path := range []string{"title", "author", "author.name", "author.email", "isbn", "links", "links.items.url"} {
+				if _, ok := op.Fields[path]; !ok {
+					t.Fatalf("scaffold missing field %q: %v", path, op.Fields)
+				}
+			}
+
+			form := scaffoldForm()
+			if err := uischema.NewDecorator(store).Decorate(&form); err != nil {
+				t.Fatalf("decorate with scaffold: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaffold_PreservesFieldOrderInOutput(t *testing.T) {
+	payload, err := uischema.Scaffold(scaffoldForm()).Marshal("yaml")
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	output := string(payload)
+	title := strings.Index(output, "      title:")
+	author := strings.Index(output, "      author:")
+	isbn := strings.Index(output, "      isbn:")
+	if title < 0 || author < 0 || isbn < 0 || !(title < author && author < isbn) {
+		t.Fatalf("expected fields in form order:\n%s", output)
+	}
+	if _, err := uischema.Scaffold(scaffoldForm()).Marshal("toml"); err == nil {
+		t.Fatalf("expected unsupported format error")
+	}
+}
@@ -0,0 +1,145 @@
+package uischema
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+)
+
+// SectionPreset is a reusable section declared under the top-level
+// `sectionPresets` block of any UI schema file. Sections reference it with
+// `preset: <name>` and receive both the section configuration and the field
+// entries, which are assigned to the referencing section automatically.
+type SectionPreset struct {
+	Section SectionConfig          `json:"section" yaml:"section"`
+	Fields  map[string]FieldConfig `json:"fields" yaml:"fields"`
+}
+
+// collectSectionPresets validates the presets declared by one file and adds
+// them to the store-wide set. Named order presets are resolved against the
+// declaring file so references keep working from other files.
+func collectSectionPresets(target map[string]SectionPreset, raw map[string]SectionPreset, source string, orderPresets map[string][]string) error {
+	for name, preset := range raw {
+		trimmed := strings.TrimSpace(name)
+		if trimmed == "" {
+			return fmt.Errorf("uischema: file %s defines a sectionPresets entry with an empty name", source)
+		}
+		if _, exists := target[trimmed]; exists {
+			return fmt.Errorf("uischema: duplicate section preset %q (file %s)", trimmed, source)
+		}
+		if strings.TrimSpace(preset.Section.Preset) != "" {
+			return fmt.Errorf("uischema: file %s section preset %q cannot reference another preset", source, trimmed)
+		}
+		if preset.Section.OrderPreset.Defined() && !preset.Section.OrderPreset.HasInline() {
+			pattern, err := preset.Section.OrderPreset.Pattern(orderPresets)
+			if err != nil {
+				return fmt.Errorf("uischema: file %s section preset %q: %w", source, trimmed, err)
+			}
+			if err := preset.Section.OrderPreset.assignInline(pattern); err != nil {
+				return fmt.Errorf("uischema: file %s section preset %q: %w", source, trimmed, err)
+			}
+		}
+		fields := make(map[string]FieldConfig, len(preset.Fields))
+		for key, cfg := range preset.Fields {
+			if NormalizeFieldPath(key) == "" {
+				return fmt.Errorf("uischema: file %s section preset %q field key %q normalises to empty path", source, trimmed, key)
+			}
+			fields[key] = cfg
+		}
+		preset.Fields = fields
+		target[trimmed] = preset
+	}
+	return nil
+}
+
+// expandSectionPresets merges referenced presets into sections in place and
+// returns the preset field entries keyed by normalised path. Values set on the
+// section itself take precedence over the preset.
+func expandSectionPresets(sections []SectionConfig, presets map[string]SectionPreset, opID, source string) (map[string]FieldConfig, error) {
+	var fields map[string]FieldConfig
+	for idx, section := range sections {
+		name := strings.TrimSpace(section.Preset)
+		if name == "" {
+			continue
+		}
+		preset, ok := presets[name]
+		if !ok {
+			return nil, fmt.Errorf("uischema: operation %q (file %s) section %q references unknown section preset %q", opID, source, section.ID, name)
+		}
+		base := preset.Section
+		prefix := strings.Trim(strings.TrimSpace(section.FieldPrefix), ".")
+		if prefix != "" && base.OrderPreset.HasInline() {
+			base.OrderPreset = base.OrderPreset.withPrefix(prefix)
+		}
+		merged := mergeSectionConfig(base, section)
+		if strings.TrimSpace(merged.ID) == "" {
+			merged.ID = name
+		}
+		sections[idx] = merged
+
+		if fields == nil {
+			fields = make(map[string]FieldConfig)
+		}
+		for key, cfg := range preset.Fields {
+			original := key
+			if prefix != "" {
+				original = prefix + "." + key
+			}
+			path := NormalizeFieldPath(original)
+			if _, exists := fields[path]; exists {
+				return nil, fmt.Errorf("uischema: operation %q (file %s) section presets define duplicate field path %q", opID, source, path)
+			}
+			cloned := cloneFieldConfig(cfg)
+			if strings.TrimSpace(cloned.Section) == "" {
+				cloned.Section = merged.ID
+			}
+			cloned.OriginalPath = original
+			fields[path] = cloned
+		}
+	}
+	return fields, nil
+}
+
+func mergeSectionConfig(base, override SectionConfig) SectionConfig {
+	out := base
+	out.ID = override.ID
+	out.Preset = override.Preset
+	out.FieldPrefix = override.FieldPrefix
+	if override.Title != "" {
+		out.Title = override.Title
+	}
+	if override.TitleKey != "" {
+		out.TitleKey = override.TitleKey
+	}
+	if override.Description != "" {
+		out.Description = override.Description
+	}
+	if override.DescriptionKey != "" {
+		out.DescriptionKey = override.DescriptionKey
+	}
+	if override.Order != nil {
+		out.Order = override.Order
+	}
+	if override.Fieldset != nil {
+		out.Fieldset = override.Fieldset
+	}
+	out.Advanced = base.Advanced || override.Advanced
+	if override.OrderPreset.Defined() {
+		out.OrderPreset = override.OrderPreset
+	}
+	out.XFormgen = mergeAnyMaps(base.XFormgen, override.XFormgen)
+	out.XAdmin = mergeAnyMaps(base.XAdmin, override.XAdmin)
+	out.Metadata = mergeStringMap(cloneStringMap(base.Metadata), override.Metadata)
+	out.UIHints = mergeStringMap(cloneStringMap(base.UIHints), override.UIHints)
+	return out
+}
+
+func mergeAnyMaps(base, override map[string]any) map[string]any {
+	if len(base) == 0 && len(override) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(base)+len(override))
+	maps.Copy(out, base)
+	maps.Copy(out, override)
+	return out
+}
@@ -42,6 +42,12 @@ type FormConfig struct {
 type LayoutConfig struct {
 	GridColumns int    `json:"gridColumns" yaml:"gridColumns"`
 	Gutter      string `json:"gutter" yaml:"gutter"`
+	// StickyActions keeps the action buttons in a bar pinned to the bottom of
+	// the viewport, for long forms.
+	StickyActions bool `json:"stickyActions,omitempty" yaml:"stickyActions,omitempty"`
+	// SectionNav lists the sections in a side navigation that highlights the
+	// section in view.
+	SectionNav bool `json:"sectionNav,omitempty" yaml:"sectionNav,omitempty"`
 }
 
 // ActionConfig serialises call-to-action buttons rendered alongside the form.
@@ -52,17 +58,33 @@ type ActionConfig struct {
 	Href     string `json:"href,omitempty" yaml:"href,omitempty"`
 	Type     string `json:"type,omitempty" yaml:"type,omitempty"`
 	Icon     string `json:"icon,omitempty" yaml:"icon,omitempty"`
+	// Operation names a companion operation (a DELETE or a status PATCH) whose
+	// method and endpoint fill Method and Endpoint when they are empty. Used by
+	// ActionTypeArchive.
+	Operation string `json:"operation,omitempty" yaml:"operation,omitempty"`
+	Endpoint  string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Method    string `json:"method,omitempty" yaml:"method,omitempty"`
+	// Confirm is the confirmation prompt shown before an archive request.
+	Confirm string `json:"confirm,omitempty" yaml:"confirm,omitempty"`
+	// Payload is sent as the JSON request body, e.g. {"status": "archived"}.
+	Payload map[string]any `json:"payload,omitempty" yaml:"payload,omitempty"`
+	// Redirect is followed after a successful archive request unless a
+	// formgen:archive-success listener cancels the event.
+	Redirect string `json:"redirect,omitempty" yaml:"redirect,omitempty"`
 }
 
 // SectionConfig groups related fields into cards/fieldsets.
 type SectionConfig struct {
 	ID             string            `json:"id" yaml:"id"`
+	Preset         string            `json:"preset,omitempty" yaml:"preset,omitempty"`
+	FieldPrefix    string            `json:"fieldPrefix,omitempty" yaml:"fieldPrefix,omitempty"`
 	Title          string            `json:"title" yaml:"title"`
 	TitleKey       string            `json:"titleKey,omitempty" yaml:"titleKey,omitempty"`
 	Description    string            `json:"description" yaml:"description"`
 	DescriptionKey string            `json:"descriptionKey,omitempty" yaml:"descriptionKey,omitempty"`
 	Order          *int              `json:"order,omitempty" yaml:"order,omitempty"`
 	Fieldset       *bool             `json:"fieldset,omitempty" yaml:"fieldset,omitempty"`
+	Advanced       bool              `json:"advanced,omitempty" yaml:"advanced,omitempty"`
 	OrderPreset    OrderPreset       `json:"orderPreset" yaml:"orderPreset,omitempty"`
 	XFormgen       map[string]any    `json:"x-formgen,omitempty" yaml:"x-formgen,omitempty"`
 	XAdmin         map[string]any    `json:"x-admin,omitempty" yaml:"x-admin,omitempty"`
@@ -90,7 +112,9 @@ type FieldConfig struct {
 	IconSource       string            `json:"iconSource,omitempty" yaml:"iconSource,omitempty"`
 	IconRaw          string            `json:"iconRaw,omitempty" yaml:"iconRaw,omitempty"`
 	Behaviors        map[string]any    `json:"behaviors,omitempty" yaml:"behaviors,omitempty"`
+	EnumFilter       *EnumFilterConfig `json:"enumFilter,omitempty" yaml:"enumFilter,omitempty"`
 	CSSClass         string            `json:"cssClass,omitempty" yaml:"cssClass,omitempty"`
+	Advanced         bool              `json:"advanced,omitempty" yaml:"advanced,omitempty"`
 	XFormgen         map[string]any    `json:"x-formgen,omitempty" yaml:"x-formgen,omitempty"`
 	XAdmin           map[string]any    `json:"x-admin,omitempty" yaml:"x-admin,omitempty"`
 	UIHints          map[string]string `json:"uiHints,omitempty" yaml:"uiHints,omitempty"`
@@ -98,6 +122,14 @@ type FieldConfig struct {
 	OriginalPath     string            `json:"-" yaml:"-"`
 }
 
+// EnumFilterConfig narrows a static enum to the Allow values while the When
+// expression holds. When uses the visibility/expr syntax and reads the other
+// form values, e.g. `region == 'EU'`.
+type EnumFilterConfig struct {
+	When  string `json:"when" yaml:"when"`
+	Allow []any  `json:"allow" yaml:"allow"`
+}
+
 // GridConfig describes a field's presence in the layout grid.
 type GridConfig struct {
 	Span        int                             `json:"span,omitempty" yaml:"span,omitempty"`
@@ -296,6 +328,21 @@ func (p *OrderPreset) UnmarshalYAML(value *yaml.Node) error {
 	}
 }
 
+// withPrefix returns a copy of an inline preset with every field entry nested
+// under prefix; the `*` wildcard is kept as-is.
+func (p OrderPreset) withPrefix(prefix string) OrderPreset {
+	out := p
+	out.inline = make([]string, len(p.inline))
+	for idx, entry := range p.inline {
+		if entry == "*" {
+			out.inline[idx] = entry
+			continue
+		}
+		out.inline[idx] = prefix + "." + entry
+	}
+	return out
+}
+
 func (p *OrderPreset) assignInline(values []string) error {
 	if len(values) == 0 {
 		return fmt.Errorf("orderPreset: inline pattern cannot be empty")
@@ -0,0 +1,480 @@
+package uischema
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed uischema.schema.json
+var jsonSchemaDocument []byte
+
+const jsonSchemaURL = "https://github.com/goliatone/go-formgen/pkg/uischema/uischema.schema.json"
+
+var (
+	compiledSchema       *jsonschema.Schema
+	compiledSchemaErr    error
+	compileSchemaOnce    sync.Once
+	schemaMessagePrinter = message.NewPrinter(language.English)
+	yamlErrorLineRegex   = regexp.MustCompile(`line (\d+)`)
+)
+
+// JSONSchema returns the published JSON Schema (draft 2020-12) for UI schema
+// documents. Editors can reference it through a top-level "$schema" key.
+func JSONSchema() []byte {
+	return bytes.Clone(jsonSchemaDocument)
+}
+
+// Diagnostic is a single validation finding. Line is 1-based and zero when
+// the finding does not point at a location; Pointer is the JSON pointer of
+// the offending value inside the file.
+type Diagnostic struct {
+	File    string `json:"file"`
+	Line    int    `json:"line,omitempty"`
+	Pointer string `json:"pointer,omitempty"`
+	Message string `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	location := d.File
+	if d.Line > 0 {
+		location = fmt.Sprintf("%s:%d", d.File, d.Line)
+	}
+	if d.Pointer == "" {
+		return fmt.Sprintf("%s: %s", location, d.Message)
+	}
+	return fmt.Sprintf("%s: %s (at %s)", location, d.Message, d.Pointer)
+}
+
+// ValidationReport lists the files checked and the diagnostics found, ordered
+// by file and line.
+type ValidationReport struct {
+	Files       []string     `json:"files"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// Err joins the diagnostics, or returns nil when there are none.
+func (r ValidationReport) Err() error {
+	errs := make([]error, 0, len(r.Diagnostics))
+	for _, diagnostic := range r.Diagnostics {
+		errs = append(errs, errors.New(diagnostic.String()))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateOption customises Validate.
+type ValidateOption func(*validateConfig)
+
+type validateConfig struct {
+	forms map[string]pkgmodel.FormModel
+}
+
+// WithFormModels checks field paths against the supplied forms, keyed by
+// operation id. Without it Validate cannot know which fields exist and only
+// checks the documents themselves; with it, operations missing from forms
+// are reported too.
+func WithFormModels(forms ...pkgmodel.FormModel) ValidateOption {
+	return func(cfg *validateConfig) {
+		for _, form := range forms {
+			if id := strings.TrimSpace(form.OperationID); id != "" {
+				cfg.forms[id] = form
+			}
+		}
+	}
+}
+
+type validatedFile struct {
+	path  string
+	doc   documentFile
+	lines map[string]int
+}
+
+// Validate checks every JSON/YAML file in fsys against the published JSON
+// Schema and then cross-checks what a schema cannot express: section ids must
+// be unique and referenced sections must exist, section and order presets must
+// be declared, and grid spans and starts must fit the layout columns. With
+// WithFormModels it also reports field paths that do not exist in the form.
+// Diagnostics carry file and line; the returned error covers only failures to
+// read fsys.
+func Validate(fsys fs.FS, options ...ValidateOption) (ValidationReport, error) {
+	if fsys == nil {
+		return ValidationReport{}, errors.New("uischema: validate requires a filesystem")
+	}
+	cfg := &validateConfig{forms: make(map[string]pkgmodel.FormModel)}
+	for _, opt := range options {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	schema, err := compileJSONSchema()
+	if err != nil {
+		return ValidationReport{}, err
+	}
+
+	var report ValidationReport
+	var files []validatedFile
+	err = fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if entry.IsDir() || !isSchemaFile(path) {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return fmt.Errorf("uischema: read %s: %w", path, err)
+		}
+		report.Files = append(report.Files, path)
+		file, diagnostics := validateSchemaFile(schema, path, data)
+		report.Diagnostics = append(report.Diagnostics, diagnostics...)
+		if file != nil {
+			files = append(files, *file)
+		}
+		return nil
+	})
+	if err != nil {
+		return ValidationReport{}, err
+	}
+
+	report.Diagnostics = append(report.Diagnostics, crossCheck(files, cfg)...)
+	sort.SliceStable(report.Diagnostics, func(i, j int) bool {
+		a, b := report.Diagnostics[i], report.Diagnostics[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Message < b.Message
+	})
+	return report, nil
+}
+
+func compileJSONSchema() (*jsonschema.Schema, error) {
+	compileSchemaOnce.Do(func() {
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(jsonSchemaDocument))
+		if err != nil {
+			compiledSchemaErr = fmt.Errorf("uischema: decode JSON Schema: %w", err)
+			return
+		}
+		compiler := jsonschema.NewCompiler()
+		if err := compiler.AddResource(jsonSchemaURL, doc); err != nil {
+			compiledSchemaErr = fmt.Errorf("uischema: add JSON Schema: %w", err)
+			return
+		}
+		compiledSchema, compiledSchemaErr = compiler.Compile(jsonSchemaURL)
+		if compiledSchemaErr != nil {
+			compiledSchemaErr = fmt.Errorf("uischema: compile JSON Schema: %w", compiledSchemaErr)
+		}
+	})
+	return compiledSchema, compiledSchemaErr
+}
+
+// validateSchemaFile parses one file, maps JSON pointers to lines, and runs
+// the JSON Schema. The typed document is returned for cross-checks only when
+// the file is structurally valid.
+func validateSchemaFile(schema *jsonschema.Schema, path string, data []byte) (*validatedFile, []Diagnostic) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, []Diagnostic{{File: path, Message: "file is empty"}}
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, []Diagnostic{{File: path, Line: yamlErrorLine(err), Message: "invalid JSON or YAML: " + strings.TrimPrefix(err.Error(), "yaml: ")}}
+	}
+	lines := make(map[string]int)
+	indexNodeLines(&root, "", lines)
+
+	var decoded any
+	if err := root.Decode(&decoded); err != nil {
+		return nil, []Diagnostic{{File: path, Message: "invalid JSON or YAML: " + err.Error()}}
+	}
+	payload, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, []Diagnostic{{File: path, Message: "document cannot be represented as JSON: " + err.Error()}}
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(payload))
+	if err != nil {
+		return nil, []Diagnostic{{File: path, Message: "document cannot be represented as JSON: " + err.Error()}}
+	}
+
+	if err := schema.Validate(instance); err != nil {
+		var verr *jsonschema.ValidationError
+		if !errors.As(err, &verr) {
+			return nil, []Diagnostic{{File: path, Message: err.Error()}}
+		}
+		return nil, schemaDiagnostics(path, verr, lines)
+	}
+
+	var doc documentFile
+	if err := root.Decode(&doc); err != nil {
+		return nil, []Diagnostic{{File: path, Message: err.Error()}}
+	}
+	return &validatedFile{path: path, doc: doc, lines: lines}, nil
+}
+
+// schemaDiagnostics reports the leaves of a validation error; the wrapping
+// "validation failed" nodes add nothing a reader can act on.
+func schemaDiagnostics(path string, verr *jsonschema.ValidationError, lines map[string]int) []Diagnostic {
+	var out []Diagnostic
+	seen := make(map[string]bool)
+	var walk func(*jsonschema.ValidationError)
+	walk = func(err *jsonschema.ValidationError) {
+		if len(err.Causes) > 0 {
+			for _, cause := range err.Causes {
+				walk(cause)
+			}
+			return
+		}
+		pointer := instancePointer(err.InstanceLocation)
+		message := err.ErrorKind.LocalizedString(schemaMessagePrinter)
+		if key := pointer + "\x00" + message; !seen[key] {
+			seen[key] = true
+			out = append(out, Diagnostic{File: path, Line: lineFor(lines, pointer), Pointer: pointer, Message: message})
+		}
+	}
+	walk(verr)
+	return out
+}
+
+func instancePointer(tokens []string) string {
+	if len(tokens) == 0 {
+		return ""
+	}
+	return pointerOf(tokens...)
+}
+
+func indexNodeLines(node *yaml.Node, pointer string, lines map[string]int) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			indexNodeLines(child, pointer, lines)
+		}
+		return
+	case yaml.AliasNode:
+		if node.Alias != nil {
+			indexNodeLines(node.Alias, pointer, lines)
+		}
+		return
+	}
+	if _, exists := lines[pointer]; !exists {
+		lines[pointer] = node.Line
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			child := pointer + "/" + escapePointerToken(key.Value)
+			// Point at the key so "unknown property" findings land on it.
+			lines[child] = key.Line
+			indexNodeLines(value, child, lines)
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			indexNodeLines(item, pointer+"/"+strconv.Itoa(i), lines)
+		}
+	}
+}
+
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// lineFor returns the line of pointer or of its closest indexed ancestor.
+func lineFor(lines map[string]int, pointer string) int {
+	for {
+		if line, ok := lines[pointer]; ok {
+			return line
+		}
+		idx := strings.LastIndex(pointer, "/")
+		if idx < 0 {
+			return 0
+		}
+		pointer = pointer[:idx]
+	}
+}
+
+func yamlErrorLine(err error) int {
+	if match := yamlErrorLineRegex.FindStringSubmatch(err.Error()); match != nil {
+		line, _ := strconv.Atoi(match[1])
+		return line
+	}
+	return 0
+}
+
+func pointerOf(tokens ...string) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		sb.WriteByte('/')
+		sb.WriteString(escapePointerToken(token))
+	}
+	return sb.String()
+}
+
+func crossCheck(files []validatedFile, cfg *validateConfig) []Diagnostic {
+	var out []Diagnostic
+	report := func(file validatedFile, message string, tokens ...string) {
+		pointer := pointerOf(tokens...)
+		out = append(out, Diagnostic{File: file.path, Line: lineFor(file.lines, pointer), Pointer: pointer, Message: message})
+	}
+
+	sectionPresets := make(map[string]SectionPreset)
+	orderPresets := make(map[string]bool)
+	for _, file := range files {
+		for name := range file.doc.FieldOrderPresets {
+			orderPresets[strings.TrimSpace(name)] = true
+		}
+		for name, preset := range file.doc.SectionPresets {
+			trimmed := strings.TrimSpace(name)
+			if _, exists := sectionPresets[trimmed]; exists {
+				report(file, fmt.Sprintf("duplicate section preset %q", trimmed), "sectionPresets", name)
+				continue
+			}
+			sectionPresets[trimmed] = preset
+		}
+	}
+
+	seenOperations := make(map[string]string)
+	for _, file := range files {
+		for name, preset := range file.doc.SectionPresets {
+			if ref := preset.Section.OrderPreset.Reference(); ref != "" && !orderPresets[ref] {
+				report(file, fmt.Sprintf("orderPreset references unknown preset %q", ref), "sectionPresets", name, "section", "orderPreset")
+			}
+		}
+		for _, opID := range sortedKeys(file.doc.Operations) {
+			id := strings.TrimSpace(opID)
+			if previous, exists := seenOperations[id]; exists {
+				report(file, fmt.Sprintf("operation %q is already defined in %s", id, previous), "operations", opID)
+				continue
+			}
+			seenOperations[id] = file.path
+			out = append(out, checkOperation(file, opID, file.doc.Operations[opID], sectionPresets, orderPresets, cfg)...)
+		}
+	}
+	return out
+}
+
+func checkOperation(file validatedFile, opID string, op operationFile, sectionPresets map[string]SectionPreset, orderPresets map[string]bool, cfg *validateConfig) []Diagnostic {
+	var out []Diagnostic
+	report := func(message string, tokens ...string) {
+		pointer := pointerOf(append([]string{"operations", opID}, tokens...)...)
+		out = append(out, Diagnostic{File: file.path, Line: lineFor(file.lines, pointer), Pointer: pointer, Message: message})
+	}
+
+	columns := op.Form.Layout.GridColumns
+	if columns <= 0 {
+		columns = 12
+	}
+
+	sections := make(map[string]bool, len(op.Sections))
+	for idx, section := range op.Sections {
+		index := strconv.Itoa(idx)
+		id := strings.TrimSpace(section.ID)
+		if name := strings.TrimSpace(section.Preset); name != "" {
+			if _, ok := sectionPresets[name]; !ok {
+				report(fmt.Sprintf("section references unknown section preset %q", name), "sections", index, "preset")
+				continue
+			}
+			if id == "" {
+				id = name
+			}
+		}
+		if sections[id] {
+			report(fmt.Sprintf("duplicate section id %q", id), "sections", index, "id")
+			continue
+		}
+		sections[id] = true
+		if ref := section.OrderPreset.Reference(); ref != "" && !orderPresets[ref] {
+			report(fmt.Sprintf("orderPreset references unknown preset %q", ref), "sections", index, "orderPreset")
+		}
+	}
+	fieldKeys := make(map[string]string, len(op.Fields))
+	for _, key := range sortedKeys(op.Fields) {
+		path := NormalizeFieldPath(key)
+		if other, exists := fieldKeys[path]; exists {
+			report(fmt.Sprintf("field %q duplicates %q", key, other), "fields", key)
+			continue
+		}
+		fieldKeys[path] = key
+	}
+
+	var knownPaths map[string]*pkgmodel.Field
+	if len(cfg.forms) > 0 {
+		form, ok := cfg.forms[strings.TrimSpace(opID)]
+		if !ok {
+			report(fmt.Sprintf("operation %q does not exist", opID))
+		} else {
+			knownPaths = make(map[string]*pkgmodel.Field)
+			collectFieldRefs(form.Fields, "", knownPaths, make(map[string]int))
+		}
+	}
+
+	for _, key := range sortedKeys(op.Fields) {
+		fieldCfg := op.Fields[key]
+		if knownPaths != nil {
+			if _, ok := knownPaths[NormalizeFieldPath(key)]; !ok {
+				report(fmt.Sprintf("unknown field %q", key), "fields", key)
+			}
+		}
+		if section := strings.TrimSpace(fieldCfg.Section); section != "" && len(op.Sections) > 0 && !sections[section] {
+			report(fmt.Sprintf("field %q references unknown section %q", key, section), "fields", key, "section")
+		}
+		if fieldCfg.Grid == nil {
+			continue
+		}
+		if fieldCfg.Grid.Span > columns {
+			report(fmt.Sprintf("grid span %d exceeds layout columns %d", fieldCfg.Grid.Span, columns), "fields", key, "grid", "span")
+		}
+		if fieldCfg.Grid.Start > columns {
+			report(fmt.Sprintf("grid start %d exceeds layout columns %d", fieldCfg.Grid.Start, columns), "fields", key, "grid", "start")
+		}
+		for _, breakpoint := range sortedKeys(fieldCfg.Grid.Breakpoints) {
+			bp := fieldCfg.Grid.Breakpoints[breakpoint]
+			if bp.Span > columns {
+				report(fmt.Sprintf("grid span %d exceeds layout columns %d at breakpoint %q", bp.Span, columns, breakpoint), "fields", key, "grid", "breakpoints", breakpoint, "span")
+			}
+			if bp.Start > columns {
+				report(fmt.Sprintf("grid start %d exceeds layout columns %d at breakpoint %q", bp.Start, columns, breakpoint), "fields", key, "grid", "breakpoints", breakpoint, "start")
+			}
+		}
+	}
+
+	for idx, section := range op.Sections {
+		pattern := section.OrderPreset.Inline()
+		if knownPaths == nil || len(pattern) == 0 || strings.TrimSpace(section.Preset) != "" {
+			continue
+		}
+		for entryIdx, entry := range pattern {
+			path := NormalizeFieldPath(strings.TrimSpace(entry))
+			if path == "" || strings.TrimSpace(entry) == "*" {
+				continue
+			}
+			if _, ok := knownPaths[path]; !ok {
+				report(fmt.Sprintf("orderPreset references unknown field %q", entry), "sections", strconv.Itoa(idx), "orderPreset", strconv.Itoa(entryIdx))
+			}
+		}
+	}
+	return out
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
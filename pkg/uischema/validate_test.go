@@ -0,0 +1,155 @@
+package uischema_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	pkgmodel "github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/uischema"
+)
+
+func TestValidate_Valid(t *testing.T) {
+	report, err := uischema.Validate(os.DirFS("testdata/basic"))
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if len(report.Files) == 0 {
+		t.Fatalf("expected files to be checked")
+	}
+	if err := report.Err(); err != nil {
+		t.Fatalf("expected no diagnostics, got:\n%v", err)
+	}
+}
+
+func TestValidate_SchemaDiagnosticsCarryLines(t *testing.T) {
+	fsys := fstest.MapFS{
+		"forms.yaml": {Data: []byte(`operations:
+  createArticle:
+    form:
+      actions:
+        - label: Archive
+          type: archive
+        - label: Go
+          type: link
+    fields:
+      title:
+        labl: Title
+        behaviors:
+          autoResize:
+            minRows: two
+`)},
+	}
+
+	report, err := uischema.Validate(fsys)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+
+	want := []string{
+		"forms.yaml:5: missing property 'operation' (at /operations/createArticle/form/actions/0)",
+		"forms.yaml:8: value must be one of 'submit', 'reset', 'button', 'archive' (at /operations/createArticle/form/actions/1/type)",
+		"forms.yaml:10: additional properties 'labl' not allowed (at /operations/createArticle/fields/title)",
+		"forms.yaml:14: got string, want integer (at /operations/createArticle/fields/title/behaviors/autoResize/minRows)",
+	}
+	got := diagnosticStrings(report)
+	for _, expected := range want {
+		if !containsString(got, expected) {
+			t.Fatalf("missing diagnostic %q in:\n%s", expected, strings.Join(got, "\n"))
+		}
+	}
+}
+
+func TestValidate_CrossChecks(t *testing.T) {
+	fsys := fstest.MapFS{
+		"schema.json": {Data: []byte(`{
+  "operations": {
+    "createArticle": {
+      "form": {"layout": {"gridColumns": 6}},
+      "sections": [
+        {"id": "main"},
+        {"id": "main"},
+        {"preset": "missing"}
+      ],
+      "fields": {
+        "title": {"section": "main", "grid": {"span": 8}},
+        "summary": {"section": "sidebar", "grid": {"breakpoints": {"md": {"start": 7}}}},
+        "ghost": {"section": "main"}
+      }
+    }
+  }
+}`)},
+	}
+
+	forms := uischema.WithFormModels(pkgmodel.FormModel{
+		OperationID: "createArticle",
+		Fields: []pkgmodel.Field{
+			{Name: "title"},
+			{Name: "summary"},
+		},
+	})
+	report, err := uischema.Validate(fsys, forms)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+
+	want := []string{
+		`schema.json:7: duplicate section id "main" (at /operations/createArticle/sections/1/id)`,
+		`schema.json:8: section references unknown section preset "missing" (at /operations/createArticle/sections/2/preset)`,
+		`schema.json:11: grid span 8 exceeds layout columns 6 (at /operations/createArticle/fields/title/grid/span)`,
+		`schema.json:12: field "summary" references unknown section "sidebar" (at /operations/createArticle/fields/summary/section)`,
+		`schema.json:12: grid start 7 exceeds layout columns 6 at breakpoint "md" (at /operations/createArticle/fields/summary/grid/breakpoints/md/start)`,
+		`schema.json:13: unknown field "ghost" (at /operations/createArticle/fields/ghost)`,
+	}
+	got := diagnosticStrings(report)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d diagnostics, got %d:\n%s", len(want), len(got), strings.Join(got, "\n"))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("diagnostic %d: expected %q, got %q", i, want[i], got[i])
+		}
+	}
+}
+
+func TestValidate_UnknownOperationWithForms(t *testing.T) {
+	fsys := fstest.MapFS{
+		"schema.json": {Data: []byte(`{"operations": {"deleteEverything": {"fields": {}}}}`)},
+	}
+	report, err := uischema.Validate(fsys, uischema.WithFormModels(pkgmodel.FormModel{OperationID: "createArticle"}))
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if got := diagnosticStrings(report); len(got) != 1 || !strings.Contains(got[0], `operation "deleteEverything" does not exist`) {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func TestJSONSchema_IsValidJSON(t *testing.T) {
+	var doc map[string]any
+	if err := json.Unmarshal(uischema.JSONSchema(), &doc); err != nil {
+		t.Fatalf("decode schema: %v", err)
+	}
+	if doc["$schema"] != "https://json-schema.org/draft/2020-12/schema" {
+		t.Fatalf("unexpected $schema: %v", doc["$schema"])
+	}
+}
+
+func diagnosticStrings(report uischema.ValidationReport) []string {
+	out := make([]string, 0, len(report.Diagnostics))
+	for _, diagnostic := range report.Diagnostics {
+		out = append(out, diagnostic.String())
+	}
+	return out
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
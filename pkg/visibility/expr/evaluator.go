@@ -170,8 +170,14 @@ func (l *tokenLexer) scanString() (token, bool, error) {
 			continue
 		}
 		if c == quote {
-			raw := string(quote) + l.input[start:l.pos-1] + string(quote)
-			value, err := strconv.Unquote(raw)
+			body := l.input[start : l.pos-1]
+			if quote == '\'' {
+				// strconv.Unquote reads single quotes as rune literals, so
+				// re-quote the body as a Go string first.
+				body = strings.ReplaceAll(body, `\'`, `'`)
+				body = strings.ReplaceAll(body, `"`, `\"`)
+			}
+			value, err := strconv.Unquote(`"` + body + `"`)
 			if err != nil {
 				return token{}, false, fmt.Errorf("visibility/expr: invalid string literal: %w", err)
 			}
@@ -158,3 +158,19 @@ func TestEvaluatorBooleanComposition(t *testing.T) {
 		t.Fatalf("expected true for disjunction")
 	}
 }
+
+func TestEvaluatorSingleQuotedStrings(t *testing.T) {
+	t.Parallel()
+
+	eval := New()
+
+	ok, err := eval.Eval("currency", `region == 'EU' && note != 'say "hi"'`, visibility.Context{
+		Values: map[string]any{"region": "EU", "note": "other"},
+	})
+	if err != nil {
+		t.Fatalf("Eval returned error: %v", err)
+	}
+	if !ok {
+		t.Fatalf("expected true")
+	}
+}
@@ -16,6 +16,9 @@ const (
 	WidgetCodeEditor = "code-editor"
 	WidgetJSONEditor = "json-editor"
 	WidgetKeyValue   = "key-value"
+	WidgetDateRange  = "daterange"
+	WidgetDuration   = "duration"
+	WidgetTimezone   = "timezone"
 )
 
 // Matcher decides whether a widget renderer should handle the supplied field.
@@ -168,6 +171,8 @@ func (r *Registry) registerBuiltins() {
 	r.Register(WidgetChips, 80, isChipsWidget)
 	r.Register(WidgetSelect, 70, isSelectWidget)
 	r.Register(WidgetCodeEditor, 60, isCodeEditorWidget)
+	r.Register(WidgetDuration, 55, isDurationWidget)
+	r.Register(WidgetTimezone, 55, isTimezoneWidget)
 	r.Register(WidgetJSONEditor, 50, isJSONEditorWidget)
 	r.Register(WidgetKeyValue, 40, isKeyValueWidget)
 }
@@ -205,6 +210,14 @@ func isCodeEditorWidget(field model.Field) bool {
 	return format == "json" || format == "yaml" || format == "toml"
 }
 
+func isDurationWidget(field model.Field) bool {
+	return field.Type == model.FieldTypeString && strings.EqualFold(strings.TrimSpace(field.Format), "duration")
+}
+
+func isTimezoneWidget(field model.Field) bool {
+	return field.Type == model.FieldTypeString && strings.EqualFold(strings.TrimSpace(field.Format), "timezone")
+}
+
 func isJSONEditorWidget(field model.Field) bool {
 	return field.Type == model.FieldTypeObject && field.Relationship == nil && len(field.Nested) == 0
 }
@@ -75,6 +75,22 @@ func TestResolve_Builtins(t *testing.T) {
 			},
 			expect: WidgetCodeEditor,
 		},
+		{
+			name: "duration format",
+			field: model.Field{
+				Type:   model.FieldTypeString,
+				Format: "duration",
+			},
+			expect: WidgetDuration,
+		},
+		{
+			name: "timezone format",
+			field: model.Field{
+				Type:   model.FieldTypeString,
+				Format: "timezone",
+			},
+			expect: WidgetTimezone,
+		},
 		{
 			name: "json editor bare object",
 			field: model.Field{
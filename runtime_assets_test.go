@@ -1,10 +1,14 @@
 package formgen
 
 import (
+	"context"
 	"io/fs"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/goliatone/go-formgen/pkg/model"
+	"github.com/goliatone/go-formgen/pkg/render"
 	"github.com/goliatone/go-formgen/pkg/renderers/vanilla"
 )
 
@@ -38,6 +42,51 @@ func TestRuntimeAssetsFSBehaviorsBundleIncludesAutoResize(t *testing.T) {
 	}
 }
 
+func TestRuntimeAssetsFSContainsClientBundle(t *testing.T) {
+	data, err := fs.ReadFile(RuntimeAssetsFS(), "formgen-client.min.js")
+	if err != nil {
+		t.Fatalf("expected client bundle to be readable: %v", err)
+	}
+	if !strings.Contains(string(data), "FormgenClient") || !strings.Contains(string(data), "renderForm") {
+		t.Fatalf("expected client bundle to expose FormgenClient.renderForm")
+	}
+}
+
+func TestRuntimeAssetsFSServesClientValidationScript(t *testing.T) {
+	renderer, err := vanilla.New(vanilla.WithClientValidation())
+	if err != nil {
+		t.Fatalf("new renderer: %v", err)
+	}
+	form := model.FormModel{
+		OperationID: "createPet",
+		Endpoint:    "/pets",
+		Method:      "POST",
+		Fields:      []model.Field{{Name: "name", Type: model.FieldTypeString, Required: true}},
+	}
+	output, err := renderer.Render(context.Background(), form, render.RenderOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	scripts := regexp.MustCompile(`<script[^>]+src="/runtime/([^"]+)"`).FindAllStringSubmatch(string(output), -1)
+	var validate bool
+	for _, script := range scripts {
+		data, err := fs.ReadFile(RuntimeAssetsFS(), script[1])
+		if err != nil {
+			t.Fatalf("rendered script /runtime/%s is missing from RuntimeAssetsFS: %v", script[1], err)
+		}
+		if script[1] == "formgen-validate.min.js" {
+			validate = true
+			if !strings.Contains(string(data), "FormgenValidate") || !strings.Contains(string(data), "validateForm") {
+				t.Fatalf("expected validate bundle to expose FormgenValidate.validateForm")
+			}
+		}
+	}
+	if !validate {
+		t.Fatalf("expected client validation to load formgen-validate.min.js, got scripts %v", scripts)
+	}
+}
+
 func assertBundleExposesFormgenController(t *testing.T, data []byte) {
 	t.Helper()
 	bundle := string(data)
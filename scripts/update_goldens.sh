@@ -12,6 +12,7 @@ export UPDATE_GOLDENS=1
 GOLDEN_PACKAGES=(
   ./pkg/renderers/vanilla
   ./pkg/renderers/preact
+  ./pkg/renderers/react
   ./pkg/orchestrator
 )
 